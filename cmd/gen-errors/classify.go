@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import "strings"
+
+// Status is the wire mapping a client sees for an error code: the gRPC
+// status code name and the HTTP status Connect serves it as.
+type Status struct {
+	GRPC string
+	HTTP int
+}
+
+// httpStatus is the Connect protocol's gRPC-code → HTTP-status table, the
+// one the web handler's connect responses use.
+var httpStatus = map[string]int{
+	"CANCELED":            499,
+	"UNKNOWN":             500,
+	"INVALID_ARGUMENT":    400,
+	"DEADLINE_EXCEEDED":   504,
+	"NOT_FOUND":           404,
+	"ALREADY_EXISTS":      409,
+	"PERMISSION_DENIED":   403,
+	"RESOURCE_EXHAUSTED":  429,
+	"FAILED_PRECONDITION": 400,
+	"ABORTED":             409,
+	"OUT_OF_RANGE":        400,
+	"UNIMPLEMENTED":       501,
+	"INTERNAL":            500,
+	"UNAVAILABLE":         503,
+	"DATA_LOSS":           500,
+	"UNAUTHENTICATED":     401,
+}
+
+// overrides pins codes whose handlers translate them differently from what
+// their name suggests. Keep in sync with the translating handler.
+var overrides = map[string]string{
+	// internal/grpc/auth_handlers.go::authFailureToStatus
+	"PLAYER_SESSION_NOT_FOUND": "UNAUTHENTICATED",
+	"PLAYER_SESSION_EXPIRED":   "UNAUTHENTICATED",
+	"SESSION_NOT_FOUND":        "UNAUTHENTICATED",
+}
+
+// suffixRules classify a code by its trailing words. Codes are
+// SCREAMING_SNAKE with the failure kind last (LOCATION_NOT_FOUND,
+// EXIT_ACCESS_DENIED). The longest matching suffix wins, so
+// SCENE_TURN_QUEUE_EMPTY is a FAILED_PRECONDITION rather than an EMPTY
+// input; anything unmatched — chiefly the *_FAILED wrappers around
+// infrastructure errors — is INTERNAL.
+var suffixRules = []struct {
+	suffixes []string
+	grpc     string
+}{
+	{[]string{"NOT_FOUND", "UNKNOWN_USER", "NO_SUCH"}, "NOT_FOUND"},
+	{[]string{"ACCESS_DENIED", "PERMISSION_DENIED", "DENIED", "FORBIDDEN", "NOT_AUTHORIZED", "UNAUTHORIZED", "NOT_OWNER", "NOT_YOURS"}, "PERMISSION_DENIED"},
+	{[]string{"UNAUTHENTICATED", "INVALID_CREDENTIALS", "BAD_CREDENTIALS"}, "UNAUTHENTICATED"},
+	{[]string{"ALREADY_EXISTS", "EXISTS", "DUPLICATE", "TAKEN", "ALREADY_QUEUED", "ALREADY_MEMBER", "CONFLICT"}, "ALREADY_EXISTS"},
+	{[]string{"INVALID", "MALFORMED", "REQUIRED", "MISSING", "EMPTY", "TOO_LARGE", "TOO_LONG", "TOO_SHORT", "BAD_REQUEST", "INVALID_ARGUMENT"}, "INVALID_ARGUMENT"},
+	{[]string{"TIMEOUT", "DEADLINE_EXCEEDED"}, "DEADLINE_EXCEEDED"},
+	{[]string{"CANCELLED", "CANCELED"}, "CANCELED"},
+	{[]string{"RATE_LIMITED", "LIMIT", "LIMIT_EXCEEDED", "QUOTA_EXCEEDED", "EXHAUSTED"}, "RESOURCE_EXHAUSTED"},
+	{[]string{"UNAVAILABLE", "UNCONFIGURED", "NOT_CONFIGURED"}, "UNAVAILABLE"},
+	{[]string{"NOT_IMPLEMENTED", "UNIMPLEMENTED", "UNSUPPORTED"}, "UNIMPLEMENTED"},
+	{[]string{"LOCKED", "EXPIRED", "NOT_ACTIVE", "ENDED", "CLOSED", "PRECONDITION", "WRONG_STATE", "NOT_QUEUED", "QUEUE_EMPTY"}, "FAILED_PRECONDITION"},
+}
+
+// Classify returns the wire status a client should expect for code.
+func Classify(code string) Status {
+	grpc, ok := overrides[code]
+	if !ok {
+		grpc = classifyBySuffix(code)
+	}
+	return Status{GRPC: grpc, HTTP: httpStatus[grpc]}
+}
+
+func classifyBySuffix(code string) string {
+	grpc, best := "INTERNAL", 0
+	for _, r := range suffixRules {
+		for _, s := range r.suffixes {
+			if len(s) > best && (code == s || strings.HasSuffix(code, "_"+s)) {
+				grpc, best = r.grpc, len(s)
+			}
+		}
+	}
+	return grpc
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		code string
+		want Status
+	}{
+		{"LOCATION_NOT_FOUND", Status{"NOT_FOUND", 404}},
+		{"EXIT_ACCESS_DENIED", Status{"PERMISSION_DENIED", 403}},
+		{"CHANNEL_NAME_TAKEN", Status{"ALREADY_EXISTS", 409}},
+		{"SCENE_ID_INVALID", Status{"INVALID_ARGUMENT", 400}},
+		{"NATS_PUBLISH_TIMEOUT", Status{"DEADLINE_EXCEEDED", 504}},
+		{"SCENE_TURN_QUEUE_EMPTY", Status{"FAILED_PRECONDITION", 400}},
+		{"SCENE_TURN_NOT_YOURS", Status{"PERMISSION_DENIED", 403}},
+		{"WORLD_WRITE_FAILED", Status{"INTERNAL", 500}},
+		{"SESSION_NOT_FOUND", Status{"UNAUTHENTICATED", 401}},
+		{"NOT_FOUND", Status{"NOT_FOUND", 404}},
+		// Suffixes match whole words only.
+		{"FOUNDRY_FAILED", Status{"INTERNAL", 500}},
+		{"SUBSCRIPTIONEXISTS", Status{"INTERNAL", 500}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := Classify(tt.code); got != tt.want {
+				t.Errorf("Classify(%q) = %+v, want %+v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEveryRuleMapsToAnHTTPStatus(t *testing.T) {
+	for _, r := range suffixRules {
+		if _, ok := httpStatus[r.grpc]; !ok {
+			t.Errorf("rule %v maps to %q, which has no HTTP status", r.suffixes, r.grpc)
+		}
+	}
+	for code, grpc := range overrides {
+		if _, ok := httpStatus[grpc]; !ok {
+			t.Errorf("override %s maps to %q, which has no HTTP status", code, grpc)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Command gen-errors generates the error code catalog client developers use
+// to handle HoloMUSH errors without grepping Go source.
+//
+// It scans the module for oops.Code(...) call sites — string literals and
+// package-level string constants — and writes every code with its gRPC and
+// HTTP status mapping and the message templates it is raised with, as JSON
+// for tooling and as a reference page for the docs site:
+//
+//	go run ./cmd/gen-errors            # rewrite both outputs in place
+//	go run ./cmd/gen-errors -check     # CI: fail if either output is stale
+//
+// Like cmd/inv-render, -check renders in memory and compares against the
+// on-disk files without mutating them.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	root := flag.String("root", ".", "module root to scan (must contain go.mod)")
+	jsonPath := flag.String("json", "site/public/reference/error-catalog.json", "JSON catalog output path")
+	mdPath := flag.String("md", "site/src/content/docs/reference/error-catalog.md", "markdown reference output path")
+	check := flag.Bool("check", false, "verify the outputs are up to date without writing (exit 1 on drift)")
+	flag.Parse()
+
+	if err := run(*root, *jsonPath, *mdPath, *check); err != nil {
+		fmt.Fprintln(os.Stderr, "gen-errors:", err)
+		os.Exit(1)
+	}
+}
+
+func run(root, jsonPath, mdPath string, check bool) error {
+	modulePath, err := readModulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return err
+	}
+	sites, dynamic, err := Scan(os.DirFS(root), modulePath)
+	if err != nil {
+		return err
+	}
+	catalog := BuildCatalog(sites)
+	jsonOut, err := RenderJSON(catalog)
+	if err != nil {
+		return err
+	}
+	outputs := []struct {
+		path    string
+		content []byte
+	}{
+		{jsonPath, jsonOut},
+		{mdPath, RenderMarkdown(catalog)},
+	}
+
+	for _, o := range outputs {
+		current, err := os.ReadFile(o.path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("read %s: %w", o.path, err)
+		}
+		if bytes.Equal(current, o.content) {
+			continue
+		}
+		if check {
+			return fmt.Errorf("%s is out of date — run `task errors:catalog` and commit the result", o.path)
+		}
+		if err := os.WriteFile(o.path, o.content, 0o644); err != nil { //nolint:gosec // G306: the catalog is a committed doc, 0644 by repo convention
+			return fmt.Errorf("write %s: %w", o.path, err)
+		}
+		fmt.Printf("gen-errors: wrote %s\n", o.path)
+	}
+	if !check {
+		fmt.Printf("gen-errors: %d codes (%d dynamic call sites skipped)\n", len(catalog.Codes), dynamic)
+	}
+	return nil
+}
+
+// readModulePath returns the module path declared in the go.mod at p.
+func readModulePath(p string) (string, error) {
+	data, err := os.ReadFile(p) //nolint:gosec // G304: path is the operator-supplied module root
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", p, err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("%s: no module directive", p)
+}
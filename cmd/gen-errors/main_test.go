@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeModule(t *testing.T) (root, jsonPath, mdPath string) {
+	t.Helper()
+	root = t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/mod\n\ngo 1.26\n",
+		"internal/world/world.go": `package world
+
+import "github.com/samber/oops"
+
+func f(id string) error {
+	return oops.Code("LOCATION_NOT_FOUND").Errorf("location %s | not found", id)
+}
+`,
+	}
+	for name, body := range files {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root, filepath.Join(root, "catalog.json"), filepath.Join(root, "catalog.md")
+}
+
+func TestRunWriteThenCheckRoundTrips(t *testing.T) {
+	root, jsonPath, mdPath := writeModule(t)
+
+	if err := run(root, jsonPath, mdPath, true); err == nil {
+		t.Fatal("run(check) before the first write = nil, want a drift error")
+	}
+	if err := run(root, jsonPath, mdPath, false); err != nil {
+		t.Fatalf("run(write): %v", err)
+	}
+
+	raw, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c Catalog
+	if err := json.Unmarshal(raw, &c); err != nil {
+		t.Fatalf("catalog is not valid JSON: %v", err)
+	}
+	want := Entry{
+		Code: "LOCATION_NOT_FOUND", GRPCCode: "NOT_FOUND", HTTPStatus: 404,
+		Templates: []string{"location %s | not found"},
+		Packages:  []string{"example.com/mod/internal/world"},
+	}
+	if len(c.Codes) != 1 || c.Version != catalogVersion || !reflect.DeepEqual(c.Codes[0], want) {
+		t.Errorf("catalog = %+v, want version %d with one entry %+v", c, catalogVersion, want)
+	}
+
+	md, err := os.ReadFile(mdPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(md), "| `LOCATION_NOT_FOUND` | `NOT_FOUND` | 404 | `location %s \\| not found` |") {
+		t.Errorf("markdown is missing the escaped code row:\n%s", md)
+	}
+
+	if err := run(root, jsonPath, mdPath, true); err != nil {
+		t.Errorf("run(check) on fresh outputs = %v, want nil", err)
+	}
+}
+
+func TestRunCheckDetectsStaleOutputWithoutWriting(t *testing.T) {
+	root, jsonPath, mdPath := writeModule(t)
+	if err := run(root, jsonPath, mdPath, false); err != nil {
+		t.Fatalf("run(write): %v", err)
+	}
+	if err := os.WriteFile(mdPath, []byte("STALE"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := run(root, jsonPath, mdPath, true)
+	if err == nil || !strings.Contains(err.Error(), "out of date") {
+		t.Fatalf("run(check) on stale markdown = %v, want out-of-date error", err)
+	}
+	got, _ := os.ReadFile(mdPath)
+	if string(got) != "STALE" {
+		t.Error("-check mutated the on-disk file")
+	}
+}
+
+func TestCodeSpanFencesBackticks(t *testing.T) {
+	if got, want := codeSpan("use `scene turn`"), "`` use `scene turn` ``"; got != want {
+		t.Errorf("codeSpan = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// catalogVersion is the JSON catalog's schema version. Bump it on any
+// breaking change to the Entry shape.
+const catalogVersion = 1
+
+// Catalog is the JSON document client developers consume.
+type Catalog struct {
+	Version int     `json:"version"`
+	Codes   []Entry `json:"codes"`
+}
+
+// Entry is one error code: its wire mapping, the message templates it is
+// raised with, and the packages that raise it.
+type Entry struct {
+	Code       string   `json:"code"`
+	GRPCCode   string   `json:"grpc_code"`
+	HTTPStatus int      `json:"http_status"`
+	Templates  []string `json:"templates"`
+	Packages   []string `json:"packages"`
+}
+
+// BuildCatalog classifies each scanned site. sites must already be sorted
+// by code (Scan's contract) so the output is deterministic.
+func BuildCatalog(sites []Site) Catalog {
+	c := Catalog{Version: catalogVersion, Codes: make([]Entry, 0, len(sites))}
+	for _, s := range sites {
+		st := Classify(s.Code)
+		templates := s.Templates
+		if templates == nil {
+			templates = []string{}
+		}
+		c.Codes = append(c.Codes, Entry{
+			Code:       s.Code,
+			GRPCCode:   st.GRPC,
+			HTTPStatus: st.HTTP,
+			Templates:  templates,
+			Packages:   s.Packages,
+		})
+	}
+	return c
+}
+
+// RenderJSON renders the catalog as indented JSON with a trailing newline.
+// HTML escaping is off so templates read as written in the Go source.
+func RenderJSON(c Catalog) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(c); err != nil {
+		return nil, fmt.Errorf("encode catalog: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderMarkdown renders the catalog as the docs-site reference page. The
+// whole file is generated; edit the header here, not in the output.
+func RenderMarkdown(c Catalog) []byte {
+	var b strings.Builder
+	b.WriteString(`---
+title: "Error Code Catalog"
+---
+
+<!-- Generated by cmd/gen-errors — do not edit. Run ` + "`task errors:catalog`" + ` to refresh. -->
+
+Every error HoloMUSH raises carries a stable code such as ` + "`LOCATION_NOT_FOUND`" + `
+or ` + "`EXIT_ACCESS_DENIED`" + `. Match on the code, not the message: codes are part
+of the API, messages are for people and may change.
+
+This page lists every code in the server and bundled plugins, the gRPC
+status it maps to, the HTTP status a Connect client sees, and the message
+templates it is raised with (Go ` + "`fmt`" + ` verbs such as ` + "`%s`" + ` mark
+interpolated values). The same data is published as machine-readable JSON
+at [/reference/error-catalog.json](/reference/error-catalog.json).
+
+The status mapping follows each code's naming convention (` + "`*_NOT_FOUND`" + ` →
+` + "`NOT_FOUND`" + `, ` + "`*_DENIED`" + ` → ` + "`PERMISSION_DENIED`" + `, and so on). A handler may
+still translate a code more specifically, so treat the status as the
+expected class of failure and the code as the precise one.
+
+`)
+	fmt.Fprintf(&b, "## Codes (%d)\n\n", len(c.Codes))
+	b.WriteString("| Code | gRPC | HTTP | Message templates |\n")
+	b.WriteString("| ---- | ---- | ---- | ----------------- |\n")
+	for _, e := range c.Codes {
+		tmpls := make([]string, 0, len(e.Templates))
+		for _, t := range e.Templates {
+			if strings.TrimSpace(t) != "" {
+				tmpls = append(tmpls, codeSpan(t))
+			}
+		}
+		msg := strings.Join(tmpls, "; ")
+		if msg == "" {
+			msg = "—"
+		}
+		fmt.Fprintf(&b, "| `%s` | `%s` | %d | %s |\n", e.Code, e.GRPCCode, e.HTTPStatus, msg)
+	}
+	return []byte(b.String())
+}
+
+// codeSpan renders s as an inline code span safe for a markdown table
+// cell: whitespace runs collapse, pipes are escaped, and the backtick
+// fence is longer than any backtick run inside s.
+func codeSpan(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.ReplaceAll(s, "|", `\|`)
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		return fence + " " + s + " " + fence
+	}
+	return fence + s + fence
+}
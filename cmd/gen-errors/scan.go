@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// oopsImportPath is the import path whose Code builder the scanner follows.
+const oopsImportPath = "github.com/samber/oops"
+
+// skipDirs are directory names the scanner never descends into: VCS and
+// tooling state, fixtures, the docs site, and gorules (a separate module).
+var skipDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"testdata":     true,
+	"site":         true,
+	"gorules":      true,
+}
+
+// messageMethods maps an oops builder terminal method to the index of its
+// format-string argument; the argument's literal value is the code's human
+// template.
+var messageMethods = map[string]int{
+	"Errorf": 0,
+	"New":    0,
+	"Wrapf":  1,
+}
+
+// Site is every place a single error code is raised, aggregated across the
+// tree.
+type Site struct {
+	Code      string
+	Templates []string // distinct message templates, sorted
+	Packages  []string // distinct import paths raising the code, sorted
+}
+
+// Scan walks fsys for non-test, non-generated Go files and collects every
+// oops.Code("...") call. modulePath is the module's import path, used to
+// name the package each code is raised in. A code passed as an identifier
+// is resolved when it names a package-level string constant; any other
+// dynamic code is counted in dynamic and otherwise skipped.
+func Scan(fsys fs.FS, modulePath string) (sites []Site, dynamic int, err error) {
+	byDir, err := parseTree(fsys)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byCode := make(map[string]*Site)
+	dirs := make([]string, 0, len(byDir))
+	for dir := range byDir {
+		dirs = append(dirs, dir)
+	}
+	slices.Sort(dirs)
+	for _, dir := range dirs {
+		files := byDir[dir]
+		consts := stringConsts(files)
+		pkg := modulePath
+		if dir != "." {
+			pkg = modulePath + "/" + dir
+		}
+		for _, f := range files {
+			oopsName := importName(f, oopsImportPath)
+			if oopsName == "" {
+				continue
+			}
+			c := &collector{oopsName: oopsName, consts: consts}
+			ast.Inspect(f, c.visit)
+			dynamic += c.dynamic
+			for code, templates := range c.found {
+				s := byCode[code]
+				if s == nil {
+					s = &Site{Code: code}
+					byCode[code] = s
+				}
+				s.Templates = append(s.Templates, templates...)
+				s.Packages = append(s.Packages, pkg)
+			}
+		}
+	}
+
+	sites = make([]Site, 0, len(byCode))
+	for _, s := range byCode {
+		slices.Sort(s.Templates)
+		s.Templates = slices.Compact(s.Templates)
+		slices.Sort(s.Packages)
+		s.Packages = slices.Compact(s.Packages)
+		sites = append(sites, *s)
+	}
+	slices.SortFunc(sites, func(a, b Site) int { return strings.Compare(a.Code, b.Code) })
+	return sites, dynamic, nil
+}
+
+// parseTree parses every candidate file under fsys, grouped by directory.
+func parseTree(fsys fs.FS) (map[string][]*ast.File, error) {
+	fset := token.NewFileSet()
+	byDir := make(map[string][]*ast.File)
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != "." && skipDirs[d.Name()] {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") || strings.HasSuffix(p, "_test.go") {
+			return nil
+		}
+		src, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", p, err)
+		}
+		f, err := parser.ParseFile(fset, p, src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", p, err)
+		}
+		if ast.IsGenerated(f) {
+			return nil
+		}
+		dir := path.Dir(p)
+		byDir[dir] = append(byDir[dir], f)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+	return byDir, nil
+}
+
+// importName returns the local name f uses for importPath, or "" when f
+// does not import it.
+func importName(f *ast.File, importPath string) string {
+	for _, imp := range f.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err != nil || p != importPath {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return path.Base(importPath)
+	}
+	return ""
+}
+
+// stringConsts returns the package-level string constants declared across
+// files, so oops.Code(codeFoo) resolves to codeFoo's value.
+func stringConsts(files []*ast.File) map[string]string {
+	out := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok || len(vs.Names) != len(vs.Values) {
+					continue
+				}
+				for i, name := range vs.Names {
+					if v, ok := stringLit(vs.Values[i]); ok {
+						out[name.Name] = v
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// collector gathers the codes raised in one file and the message templates
+// chained onto them.
+type collector struct {
+	oopsName string
+	consts   map[string]string
+	found    map[string][]string // code → templates (may be empty)
+	dynamic  int
+}
+
+func (c *collector) visit(n ast.Node) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return true
+	}
+	if code, isCode, resolved := c.codeOf(call); isCode {
+		if !resolved {
+			c.dynamic++
+			return true
+		}
+		c.record(code)
+		return true
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return true
+	}
+	argIdx, ok := messageMethods[sel.Sel.Name]
+	if !ok || len(call.Args) <= argIdx {
+		return true
+	}
+	template, ok := stringLit(call.Args[argIdx])
+	if !ok {
+		return true
+	}
+	// Walk down the builder chain (.With(...).Tags(...) ...) to the
+	// oops.Code call it started from, if any.
+	for x := sel.X; ; {
+		inner, ok := x.(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		if code, isCode, resolved := c.codeOf(inner); isCode {
+			if resolved {
+				c.record(code, template)
+			}
+			break
+		}
+		innerSel, ok := inner.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		x = innerSel.X
+	}
+	return true
+}
+
+func (c *collector) record(code string, templates ...string) {
+	if c.found == nil {
+		c.found = make(map[string][]string)
+	}
+	c.found[code] = append(c.found[code], templates...)
+}
+
+// codeOf reports whether call is <oops>.Code(arg) and, if so, the code
+// when arg is a string literal or a known string constant.
+func (c *collector) codeOf(call *ast.CallExpr) (code string, isCode, resolved bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Code" || len(call.Args) != 1 {
+		return "", false, false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != c.oopsName {
+		return "", false, false
+	}
+	if v, ok := stringLit(call.Args[0]); ok {
+		return v, true, true
+	}
+	if id, ok := call.Args[0].(*ast.Ident); ok {
+		if v, ok := c.consts[id.Name]; ok {
+			return v, true, true
+		}
+	}
+	return "", true, false
+}
+
+// stringLit returns the value of a string literal expression.
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	v, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
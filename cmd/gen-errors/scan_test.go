@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+package main
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+const scanFixture = `package world
+
+import "github.com/samber/oops"
+
+const codeExitDenied = "EXIT_ACCESS_DENIED"
+
+func a(id string) error {
+	return oops.Code("LOCATION_NOT_FOUND").With("id", id).Errorf("location %s not found", id)
+}
+
+func b(err error) error {
+	return oops.Code("LOCATION_NOT_FOUND").Wrapf(err, "load location")
+}
+
+func c() error {
+	return oops.Code(codeExitDenied).Errorf("exit locked")
+}
+
+func d(err error) error {
+	return oops.Code("WORLD_WRITE_FAILED").Wrap(err)
+}
+
+func e(code string) error {
+	return oops.Code(code).Errorf("dynamic")
+}
+`
+
+func TestScanCollectsCodesTemplatesAndPackages(t *testing.T) {
+	fsys := fstest.MapFS{
+		"internal/world/world.go": {Data: []byte(scanFixture)},
+		"internal/other/other.go": {Data: []byte(`package other
+
+import errs "github.com/samber/oops"
+
+func f() error { return errs.Code("LOCATION_NOT_FOUND").New("gone") }
+`)},
+		// Tests, generated files, and skipped trees are never scanned.
+		"internal/world/world_test.go": {Data: []byte(`package world
+
+import "github.com/samber/oops"
+
+var _ = oops.Code("TEST_ONLY").Errorf("x")
+`)},
+		"pkg/proto/gen.pb.go": {Data: []byte(`// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package proto
+
+import "github.com/samber/oops"
+
+var _ = oops.Code("GENERATED_ONLY").Errorf("x")
+`)},
+		"gorules/rule.go": {Data: []byte(`package gorules
+
+import "github.com/samber/oops"
+
+var _ = oops.Code("OTHER_MODULE").Errorf("x")
+`)},
+	}
+
+	sites, dynamic, err := Scan(fsys, "example.com/mod")
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	want := []Site{
+		{Code: "EXIT_ACCESS_DENIED", Templates: []string{"exit locked"}, Packages: []string{"example.com/mod/internal/world"}},
+		{
+			Code:      "LOCATION_NOT_FOUND",
+			Templates: []string{"gone", "load location", "location %s not found"},
+			Packages:  []string{"example.com/mod/internal/other", "example.com/mod/internal/world"},
+		},
+		{Code: "WORLD_WRITE_FAILED", Templates: nil, Packages: []string{"example.com/mod/internal/world"}},
+	}
+	if !reflect.DeepEqual(sites, want) {
+		t.Errorf("Scan sites =\n%+v\nwant\n%+v", sites, want)
+	}
+	if dynamic != 1 {
+		t.Errorf("dynamic = %d, want 1", dynamic)
+	}
+}
+
+func TestScanSkipsFilesWithoutOopsImport(t *testing.T) {
+	fsys := fstest.MapFS{
+		"x/x.go": {Data: []byte(`package x
+
+type oops struct{}
+
+func (oops) Code(string) error { return nil }
+
+var _ = oops{}.Code("NOT_AN_OOPS_CODE")
+`)},
+	}
+	sites, _, err := Scan(fsys, "example.com/mod")
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(sites) != 0 {
+		t.Errorf("Scan found %+v in a file that does not import oops", sites)
+	}
+}
+
+func TestScanReportsParseErrors(t *testing.T) {
+	fsys := fstest.MapFS{"x/x.go": {Data: []byte("package x\nfunc {")}}
+	if _, _, err := Scan(fsys, "example.com/mod"); err == nil {
+		t.Error("Scan on an unparsable file = nil error, want error")
+	}
+}
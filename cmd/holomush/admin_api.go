@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/holomush/holomush/internal/httpapi"
+)
+
+// adminAPIRoute serves the admin dashboard API on core's metrics/health
+// HTTP server. That server starts before the subsystems, so the route is
+// registered empty and the gRPC subsystem binds the handler in Prepare once
+// its dependencies exist; until then every request gets 503.
+type adminAPIRoute struct {
+	handler atomic.Pointer[httpapi.Handler]
+}
+
+// bind makes h serve the route.
+func (a *adminAPIRoute) bind(h *httpapi.Handler) {
+	a.handler.Store(h)
+}
+
+// ServeHTTP implements http.Handler.
+func (a *adminAPIRoute) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h := a.handler.Load()
+	if h == nil {
+		http.Error(w, "admin API not ready", http.StatusServiceUnavailable)
+		return
+	}
+	h.ServeHTTP(w, r)
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/httpapi"
+	sessionmocks "github.com/holomush/holomush/internal/session/mocks"
+)
+
+func TestAdminAPIRouteUnavailableUntilBound(t *testing.T) {
+	route := &adminAPIRoute{}
+	serve := func() int {
+		rr := httptest.NewRecorder()
+		route.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, httpapi.PathPrefix+"sessions", nil))
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusServiceUnavailable, serve())
+
+	route.bind(httpapi.NewHandler(httpapi.Config{
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		Sessions:       sessionmocks.NewMockStore(t),
+		Engine:         policytest.DenyAllEngine(),
+	}))
+
+	assert.Equal(t, http.StatusUnauthorized, serve(), "a bound route MUST delegate to the handler")
+}
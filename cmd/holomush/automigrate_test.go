@@ -35,6 +35,14 @@ func (m *autoMigrateMockMigrator) Up() error {
 	return m.upError
 }
 
+func (m *autoMigrateMockMigrator) Version() (uint, bool, error) {
+	return 0, false, nil
+}
+
+func (m *autoMigrateMockMigrator) LatestVersion() (uint, error) {
+	return 0, nil
+}
+
 func (m *autoMigrateMockMigrator) Close() error {
 	m.closeCalled = true
 	return m.closeError
@@ -240,6 +248,20 @@ func TestAutoMigrate_MigratorCreationError(t *testing.T) {
 	assert.Contains(t, err.Error(), "migration", "Error should mention migration")
 }
 
+func TestAutoMigrateFlag(t *testing.T) {
+	cmd := NewCoreCmd()
+	assert.Nil(t, autoMigrateFlag(cmd), "without the flag the env var decides")
+
+	require.NoError(t, cmd.Flags().Set("auto-migrate", "false"))
+	getter := autoMigrateFlag(cmd)
+	require.NotNil(t, getter)
+	assert.False(t, getter())
+
+	t.Setenv("HOLOMUSH_DB_AUTO_MIGRATE", "false")
+	require.NoError(t, cmd.Flags().Set("auto-migrate", "true"))
+	assert.True(t, autoMigrateFlag(cmd)(), "the flag wins over HOLOMUSH_DB_AUTO_MIGRATE")
+}
+
 func TestParseAutoMigrate(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	"github.com/holomush/holomush/internal/access/policy"
+	policystore "github.com/holomush/holomush/internal/access/policy/store"
+	"github.com/holomush/holomush/internal/health"
+	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/internal/xdg"
+)
+
+// Names of the dependency checks, shared by /readyz and `holomush check`.
+const (
+	checkStartup    = "startup"
+	checkDatabase   = "database"
+	checkMigrations = "migrations"
+	checkPlugins    = "plugins"
+	checkPolicies   = "policies"
+	checkSubsystems = "subsystems"
+)
+
+// newCoreHealthSuite builds the checks a running core serves at /readyz.
+// pool is empty until the database subsystem has started; every check that
+// needs it fails until then, as does the startup check until the readiness
+// gate has passed. Plugin load status and the policy bundle are read from
+// the subsystems' own health reporters.
+func newCoreHealthSuite(registry *lifecycle.ReadinessRegistry, startupComplete *atomic.Bool, pool *atomic.Pointer[pgxpool.Pool]) (*health.Suite, error) {
+	expected, err := store.LatestMigrationVersion()
+	if err != nil {
+		return nil, oops.Code("HEALTH_SETUP_FAILED").Wrap(err)
+	}
+	suite := health.NewSuite()
+	suite.Add(checkStartup, func(context.Context) error {
+		if !startupComplete.Load() {
+			return oops.Code("HEALTH_STARTING").Errorf("core is still starting")
+		}
+		return nil
+	})
+	suite.Add(checkDatabase, health.Database(func() health.Pinger {
+		if p := pool.Load(); p != nil {
+			return p
+		}
+		return nil
+	}))
+	suite.Add(checkMigrations, health.Migrations(func(ctx context.Context) (uint, bool, error) {
+		p := pool.Load()
+		if p == nil {
+			return 0, false, oops.Code("HEALTH_DATABASE_UNAVAILABLE").Errorf("database is not connected")
+		}
+		return store.SchemaVersion(ctx, p)
+	}, expected))
+	suite.Add(checkPlugins, health.Subsystem(registry, lifecycle.SubsystemPlugins))
+	suite.Add(checkPolicies, health.Subsystem(registry, lifecycle.SubsystemABAC))
+	suite.Add(checkSubsystems, health.Subsystems(registry))
+	return suite, nil
+}
+
+// NewCheckCmd returns `holomush check`: an offline preflight of the
+// dependencies core needs before it can serve.
+func NewCheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check that the database, schema, plugins and policies are ready for core",
+		Long: `Run the startup dependency checks without starting a server:
+
+  database    DATABASE_URL accepts connections
+  migrations  the schema is at the version this binary expects and not dirty
+  plugins     every plugin under <data-dir>/plugins has a valid manifest and entry point
+  policies    every seed access policy is installed
+
+A running core serves the same verdict at /readyz on its metrics address,
+with /healthz for liveness. Exits non-zero when any check fails, so the
+command can gate a deploy or serve as an orchestrator exec probe.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runCheck(cmd)
+		},
+	}
+	cmd.Flags().String("data-dir", "", "data directory holding plugins/ (default: XDG data dir)")
+	cmd.Flags().Duration("timeout", health.DefaultCheckTimeout, "time limit for each check")
+	cmd.Flags().Bool("json", false, "output the report as JSON")
+	return cmd
+}
+
+func runCheck(cmd *cobra.Command) error {
+	dataDir, _ := cmd.Flags().GetString("data-dir")  //nolint:errcheck // flag defined above
+	timeout, _ := cmd.Flags().GetDuration("timeout") //nolint:errcheck // flag defined above
+	asJSON, _ := cmd.Flags().GetBool("json")         //nolint:errcheck // flag defined above
+	ctx := cmd.Context()
+
+	pluginsDir, err := checkPluginsDir(dataDir)
+	if err != nil {
+		return err
+	}
+	expected, err := store.LatestMigrationVersion()
+	if err != nil {
+		return oops.Code("HEALTH_SETUP_FAILED").Wrap(err)
+	}
+
+	var pool *pgxpool.Pool
+	if url, urlErr := getDatabaseURL(); urlErr == nil {
+		if pool, err = pgxpool.New(ctx, url); err != nil {
+			return oops.Code("HEALTH_SETUP_FAILED").Wrap(err)
+		}
+		defer pool.Close()
+	}
+
+	suite := health.NewSuite(health.WithTimeout(timeout))
+	suite.Add(checkDatabase, health.Database(func() health.Pinger {
+		if pool == nil {
+			return nil
+		}
+		return pool
+	}))
+	suite.Add(checkMigrations, health.Migrations(func(ctx context.Context) (uint, bool, error) {
+		if pool == nil {
+			return 0, false, oops.Code("CONFIG_INVALID").Errorf("DATABASE_URL environment variable is required")
+		}
+		return store.SchemaVersion(ctx, pool)
+	}, expected))
+	suite.Add(checkPlugins, pluginManifestsCheck(pluginsDir))
+	suite.Add(checkPolicies, func(ctx context.Context) error {
+		if pool == nil {
+			return oops.Code("CONFIG_INVALID").Errorf("DATABASE_URL environment variable is required")
+		}
+		return health.PolicyBundle(policyNames{policystore.NewPostgresStore(pool)}, seedPolicyNames())(ctx)
+	})
+
+	report := suite.Run(ctx)
+	if err := writeCheckReport(cmd.OutOrStdout(), report, asJSON); err != nil {
+		return err
+	}
+	if failed := report.Failed(); len(failed) > 0 {
+		return oops.Code("HEALTH_CHECK_FAILED").With("failed", len(failed)).
+			Errorf("%d of %d check(s) failed", len(failed), len(report.Checks))
+	}
+	return nil
+}
+
+// checkPluginsDir resolves the plugins directory the way the plugin
+// subsystem does.
+func checkPluginsDir(dataDir string) (string, error) {
+	if dataDir != "" {
+		return filepath.Join(dataDir, "plugins"), nil
+	}
+	baseDir, err := xdg.DataDir()
+	if err != nil {
+		return "", oops.Code("PLUGINS_DIR_FAILED").With("operation", "get plugins directory").Wrap(err)
+	}
+	return filepath.Join(baseDir, "plugins"), nil
+}
+
+// pluginManifestsCheck runs `plugin validate` over every plugin directory
+// under dir. Core loads plugins strictly, so one invalid plugin fails
+// startup; a missing directory means no plugins and passes.
+func pluginManifestsCheck(dir string) health.CheckFunc {
+	return func(context.Context) error {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return oops.Code("HEALTH_PLUGINS_UNREADABLE").With("dir", dir).Wrap(err)
+		}
+		var broken []string
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			pluginDir := filepath.Join(dir, entry.Name())
+			if _, statErr := os.Stat(filepath.Join(pluginDir, manifestFileName)); os.IsNotExist(statErr) {
+				continue
+			}
+			report, validateErr := validatePluginPath(pluginDir)
+			if validateErr != nil || report.errorCount() > 0 {
+				broken = append(broken, entry.Name())
+			}
+		}
+		if len(broken) > 0 {
+			return oops.Code("HEALTH_PLUGINS_INVALID").With("plugins", broken).
+				Errorf("invalid plugin(s): %s; run 'holomush plugin validate' on each for details", strings.Join(broken, ", "))
+		}
+		return nil
+	}
+}
+
+// policyNames adapts the policy store to health.PolicyNameLister.
+type policyNames struct {
+	store *policystore.PostgresStore
+}
+
+func (p policyNames) PolicyNames(ctx context.Context) ([]string, error) {
+	policies, err := p.store.List(ctx, policystore.ListOptions{})
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	names := make([]string, len(policies))
+	for i, sp := range policies {
+		names[i] = sp.Name
+	}
+	return names, nil
+}
+
+func seedPolicyNames() []string {
+	seeds := policy.SeedPolicies()
+	names := make([]string, len(seeds))
+	for i, s := range seeds {
+		names[i] = s.Name
+	}
+	return names
+}
+
+//nolint:errcheck // CLI output errors intentionally ignored - no recovery possible
+func writeCheckReport(out io.Writer, report health.Report, asJSON bool) error {
+	if asJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return oops.Code("HEALTH_CHECK_FAILED").Wrap(err)
+		}
+		fmt.Fprintln(out, string(data))
+		return nil
+	}
+	for _, r := range report.Checks {
+		took := r.Duration.Round(time.Millisecond)
+		if r.OK {
+			fmt.Fprintf(out, "ok    %-11s (%s)\n", r.Name, took)
+			continue
+		}
+		fmt.Fprintf(out, "FAIL  %-11s %s\n", r.Name, r.Error)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build !integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/health"
+	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// TestNewCheckCmdStructure verifies `holomush check` is wired under the root
+// with its flags.
+func TestNewCheckCmdStructure(t *testing.T) {
+	root := NewRootCmd()
+	cmd, _, err := root.Find([]string{"check"})
+	require.NoError(t, err)
+	assert.Equal(t, "check", cmd.Name())
+	for _, flag := range []string{"data-dir", "timeout", "json"} {
+		assert.NotNil(t, cmd.Flags().Lookup(flag), "check has a --%s flag", flag)
+	}
+}
+
+// TestCheckFailsWithoutDatabase verifies the preflight reports every
+// database-backed check as failed, and exits non-zero, when DATABASE_URL is
+// unset, while the plugin check still runs.
+func TestCheckFailsWithoutDatabase(t *testing.T) {
+	t.Setenv("DATABASE_URL", "")
+	var out bytes.Buffer
+	cmd := NewCheckCmd()
+	cmd.SetArgs([]string{"--data-dir", t.TempDir()})
+	cmd.SetOut(&out)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	err := cmd.Execute()
+	errutil.AssertErrorCode(t, err, "HEALTH_CHECK_FAILED")
+	assert.Contains(t, out.String(), "FAIL  database")
+	assert.Contains(t, out.String(), "FAIL  migrations")
+	assert.Contains(t, out.String(), "FAIL  policies")
+	assert.Contains(t, out.String(), "ok    plugins")
+}
+
+func writePlugin(t *testing.T, dir, name, manifest string) {
+	t.Helper()
+	pluginDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(pluginDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, manifestFileName), []byte(manifest), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "main.lua"), []byte("function on_event(event)\nend\n"), 0o600))
+}
+
+// TestPluginManifestsCheck verifies the offline plugin check names every
+// plugin that would fail to load and ignores directories without a manifest.
+func TestPluginManifestsCheck(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, pluginManifestsCheck(filepath.Join(t.TempDir(), "missing"))(ctx), "no plugins directory means no plugins")
+
+	dir := t.TempDir()
+	writePlugin(t, dir, "good", "name: good\nversion: 1.0.0\ntype: lua\nlua-plugin: { entry: main.lua }\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "notes"), 0o755))
+	require.NoError(t, pluginManifestsCheck(dir)(ctx))
+
+	writePlugin(t, dir, "broken", "name: broken\nversion: not-a-version\ntype: lua\n")
+	err := pluginManifestsCheck(dir)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_PLUGINS_INVALID")
+	assert.Contains(t, err.Error(), "broken")
+	assert.NotContains(t, err.Error(), "good")
+}
+
+type stubHealth lifecycle.HealthStatus
+
+func (s stubHealth) HealthStatus() lifecycle.HealthStatus { return lifecycle.HealthStatus(s) }
+
+// TestCoreHealthSuiteTracksStartup verifies /readyz stays unready until the
+// readiness gate passes and every subsystem it names has registered.
+func TestCoreHealthSuiteTracksStartup(t *testing.T) {
+	registry := lifecycle.NewReadinessRegistry()
+	started := &atomic.Bool{}
+	suite, err := newCoreHealthSuite(registry, started, &atomic.Pointer[pgxpool.Pool]{})
+	require.NoError(t, err)
+
+	report := suite.Run(context.Background())
+	assert.False(t, report.Ready)
+	failed := map[string]bool{}
+	for _, r := range report.Failed() {
+		failed[r.Name] = true
+	}
+	assert.Equal(t, map[string]bool{
+		checkStartup: true, checkDatabase: true, checkMigrations: true, checkPlugins: true, checkPolicies: true,
+	}, failed, "subsystems passes vacuously before anything registers")
+
+	started.Store(true)
+	registry.Register(lifecycle.SubsystemPlugins, stubHealth{Tier: lifecycle.HealthWarm})
+	registry.Register(lifecycle.SubsystemABAC, stubHealth{Tier: lifecycle.HealthDead, Reason: "policy load failed"})
+	report = suite.Run(context.Background())
+	for _, r := range report.Checks {
+		switch r.Name {
+		case checkStartup, checkPlugins:
+			assert.True(t, r.OK, r.Name)
+		case checkPolicies, checkSubsystems:
+			assert.False(t, r.OK, r.Name)
+		}
+	}
+}
+
+// TestWriteCheckReport verifies the text report marks passes and failures.
+func TestWriteCheckReport(t *testing.T) {
+	var out bytes.Buffer
+	report := health.Report{Checks: []health.Result{
+		{Name: "database", OK: true, Duration: 3 * time.Millisecond},
+		{Name: "migrations", Error: errors.New("schema is at version 69, expected 70").Error()},
+	}}
+	require.NoError(t, writeCheckReport(&out, report, false))
+	assert.Equal(t, "ok    database    (3ms)\nFAIL  migrations  schema is at version 69, expected 70\n", out.String())
+
+	out.Reset()
+	require.NoError(t, writeCheckReport(&out, report, true))
+	assert.Contains(t, out.String(), `"ready": false`)
+}
@@ -28,13 +28,14 @@ const auditDLQScanTimeout = 30 * time.Second
 // operate the durable audit trail from the operator host; unlike the crypto
 // commands they need NO admin UDS — the DLQ tools read the EVENTS_AUDIT_DLQ
 // JetStream stream and write the events_audit Postgres table directly
-// (CLUSTER-04, OQ-5).
+// (CLUSTER-04, OQ-5), and verify reads access_audit_log directly.
 func NewAuditCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "audit",
 		Short: "Audit-trail operator commands (NATS + Postgres, no admin UDS)",
 	}
 	cmd.AddCommand(newAuditDLQCmd())
+	cmd.AddCommand(newAuditVerifyCmd())
 	return cmd
 }
 
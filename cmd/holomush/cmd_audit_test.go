@@ -4,8 +4,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -14,9 +17,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	accessaudit "github.com/holomush/holomush/internal/audit"
 	"github.com/holomush/holomush/internal/config"
 	"github.com/holomush/holomush/internal/eventbus/audit"
 	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/pkg/errutil"
 )
 
 func TestRootHasAuditSubcommand(t *testing.T) {
@@ -148,3 +153,61 @@ event_bus:
 	assert.Equal(t, "coregame", coreCfg.GameID,
 		"config.Load(..., \"core\") must select core.game_id, not event_bus.game_id or the YAML root")
 }
+
+type fakeChainVerifier struct {
+	partitions []string
+	reports    map[string]*accessaudit.ChainReport
+}
+
+func (f *fakeChainVerifier) Partitions(context.Context) ([]string, error) {
+	return f.partitions, nil
+}
+
+func (f *fakeChainVerifier) Verify(_ context.Context, partition string) (*accessaudit.ChainReport, error) {
+	report, ok := f.reports[partition]
+	if !ok {
+		return nil, errors.New("no such partition")
+	}
+	return report, nil
+}
+
+func TestAuditVerifySubcommandResolves(t *testing.T) {
+	resolved, _, err := NewRootCmd().Find([]string{"audit", "verify"})
+	require.NoError(t, err)
+	assert.Equal(t, "verify", resolved.Name())
+}
+
+func TestRunAuditVerify(t *testing.T) {
+	intact := &accessaudit.ChainReport{Partition: "access_audit_log_2026_02", Entries: 3, HeadSeq: 3, HeadHash: []byte{0xab, 0xcd}}
+	broken := &accessaudit.ChainReport{
+		Partition: "access_audit_log_2026_03", Entries: 5, HeadSeq: 6,
+		Problems: []accessaudit.ChainProblem{{Kind: accessaudit.ChainTruncated, Seq: 6, Detail: "chain head is at 6 but the last entry is 5"}},
+	}
+	verifier := &fakeChainVerifier{
+		partitions: []string{intact.Partition, broken.Partition},
+		reports:    map[string]*accessaudit.ChainReport{intact.Partition: intact, broken.Partition: broken},
+	}
+
+	t.Run("reports every partition and fails on a broken chain", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runAuditVerify(context.Background(), verifier, "", false, &out)
+		errutil.AssertErrorCode(t, err, "AUDIT_CHAIN_BROKEN")
+		assert.Contains(t, out.String(), "access_audit_log_2026_02   ok: 3 entries, 0 unchained, head 3 abcd")
+		assert.Contains(t, out.String(), "truncated")
+		assert.Contains(t, out.String(), "verify: 2 partition(s) checked")
+	})
+
+	t.Run("verifies only the named partition", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, runAuditVerify(context.Background(), verifier, intact.Partition, true, &out))
+		var reports []accessaudit.ChainReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &reports))
+		require.Len(t, reports, 1)
+		assert.Equal(t, intact.Partition, reports[0].Partition)
+	})
+
+	t.Run("a verify error fails the command", func(t *testing.T) {
+		err := runAuditVerify(context.Background(), verifier, "access_audit_log_2026_04", false, io.Discard)
+		errutil.AssertErrorCode(t, err, "AUDIT_VERIFY_FAILED")
+	})
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	accessaudit "github.com/holomush/holomush/internal/audit"
+)
+
+// newAuditVerifyCmd returns `holomush audit verify`: a hash-chain check of
+// the access_audit_log partitions.
+func newAuditVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the access audit log hash chains",
+		Long: `Verify the access audit log hash chains.
+
+Every access_audit_log entry records the hash of the entry before it in
+the same monthly partition. verify walks each chain, recomputes every
+hash, and reports gaps (deleted entries), duplicates, broken links, edited
+entries, and chains whose recorded head is ahead of the remaining rows
+(a deleted tail or dropped partition).
+
+Each partition's head hash is printed. Record it somewhere outside the
+database: a chain rewritten end to end by someone with write access is
+only caught by comparing against a head published earlier.
+
+Entries written before chaining was introduced are counted as unchained
+and cannot be verified.
+
+Exits non-zero when any chain has problems.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			partition, _ := cmd.Flags().GetString("partition") //nolint:errcheck // flag defined above
+			asJSON, _ := cmd.Flags().GetBool("json")           //nolint:errcheck // flag defined above
+
+			pool, err := openOutboxPool(cmd.Context())
+			if err != nil {
+				return err
+			}
+			defer pool.Close()
+			db := stdlib.OpenDBFromPool(pool)
+			defer db.Close() //nolint:errcheck // pool.Close releases the connections
+
+			return runAuditVerify(cmd.Context(), accessaudit.NewChainVerifier(db), partition, asJSON, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().String("partition", "", "verify one partition (access_audit_log_YYYY_MM) instead of all")
+	cmd.Flags().Bool("json", false, "output the reports as JSON")
+	return cmd
+}
+
+// auditChainVerifier is the slice of accessaudit.ChainVerifier verify uses.
+type auditChainVerifier interface {
+	Partitions(ctx context.Context) ([]string, error)
+	Verify(ctx context.Context, partition string) (*accessaudit.ChainReport, error)
+}
+
+// runAuditVerify verifies partition, or every partition when it is empty,
+// and writes the reports to w.
+func runAuditVerify(ctx context.Context, v auditChainVerifier, partition string, asJSON bool, w io.Writer) error {
+	partitions := []string{partition}
+	if partition == "" {
+		var err error
+		if partitions, err = v.Partitions(ctx); err != nil {
+			return oops.Code("AUDIT_VERIFY_FAILED").Wrap(err)
+		}
+	}
+
+	reports := make([]*accessaudit.ChainReport, 0, len(partitions))
+	broken := 0
+	for _, name := range partitions {
+		report, err := v.Verify(ctx, name)
+		if err != nil {
+			return oops.Code("AUDIT_VERIFY_FAILED").With("partition", name).Wrap(err)
+		}
+		if !report.Intact() {
+			broken++
+		}
+		reports = append(reports, report)
+	}
+
+	if err := writeAuditVerifyReports(w, reports, asJSON); err != nil {
+		return err
+	}
+	if broken > 0 {
+		return oops.Code("AUDIT_CHAIN_BROKEN").With("partitions", broken).
+			Errorf("%d audit chain(s) failed verification", broken)
+	}
+	return nil
+}
+
+func writeAuditVerifyReports(w io.Writer, reports []*accessaudit.ChainReport, asJSON bool) error {
+	if asJSON {
+		data, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return oops.Code("AUDIT_VERIFY_FAILED").Wrap(err)
+		}
+		fmt.Fprintln(w, string(data)) //nolint:errcheck // display output
+		return nil
+	}
+	for _, r := range reports {
+		status := "ok"
+		if !r.Intact() {
+			status = fmt.Sprintf("%d problem(s)", len(r.Problems))
+		}
+		fmt.Fprintf(w, "%-26s %s: %d entries, %d unchained, head %d %x\n", //nolint:errcheck // display output
+			r.Partition, status, r.Entries, r.Unchained, r.HeadSeq, r.HeadHash)
+		for _, p := range r.Problems {
+			fmt.Fprintf(w, "  %-14s seq %d %s %s\n", p.Kind, p.Seq, p.RowID, p.Detail) //nolint:errcheck // display output
+		}
+	}
+	fmt.Fprintf(w, "verify: %d partition(s) checked\n", len(reports)) //nolint:errcheck // display output
+	return nil
+}
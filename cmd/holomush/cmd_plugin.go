@@ -6,15 +6,19 @@ package main
 import "github.com/spf13/cobra"
 
 // NewPluginCmd is the `holomush plugin` parent command. Subcommands
-// (validate, events) attach via NewPluginValidateCmd / NewPluginEventsCmd
-// added in subsequent tasks.
+// (validate, events, replay, install, enable) attach via
+// NewPluginValidateCmd / NewPluginEventsCmd / NewPluginReplayCmd /
+// NewPluginInstallCmd / NewPluginEnableCmd.
 func NewPluginCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "plugin",
 		Short: "Plugin authoring and inspection commands",
-		Long:  "Inspect and validate plugin manifests, list declared event types, and run author-time checks.",
+		Long:  "Inspect and validate plugin manifests, list declared event types, run author-time checks, replay recorded events offline, and install signed plugin bundles.",
 	}
 	cmd.AddCommand(NewPluginValidateCmd())
 	cmd.AddCommand(NewPluginEventsCmd())
+	cmd.AddCommand(NewPluginReplayCmd())
+	cmd.AddCommand(NewPluginInstallCmd())
+	cmd.AddCommand(NewPluginEnableCmd())
 	return cmd
 }
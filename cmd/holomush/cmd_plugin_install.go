@@ -0,0 +1,425 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	"github.com/holomush/holomush/internal/config"
+	plugins "github.com/holomush/holomush/internal/plugin"
+)
+
+// Plugin bundle limits. A bundle is fully buffered so its signature can be
+// checked before a single byte is unpacked.
+const (
+	maxPluginBundleBytes   = 64 << 20
+	maxPluginExtractBytes  = 256 << 20
+	maxPluginBundleEntries = 4096
+	pluginFetchTimeout     = 2 * time.Minute
+)
+
+// stagedPluginsDirName is the sibling of plugins/ that install writes to.
+// Plugins there are not loaded until `plugin enable` moves them across.
+const stagedPluginsDirName = "plugins-staged"
+
+// pluginNamePattern matches the manifest name rule, so a name given to
+// enable can only ever resolve to a direct child of the plugin dirs.
+var pluginNamePattern = regexp.MustCompile(`^[a-z](-?[a-z0-9])*$`)
+
+// pluginInstallConfig holds configuration for plugin install and enable.
+type pluginInstallConfig struct {
+	DataDir     string   `koanf:"data_dir"`
+	TrustedKeys []string `koanf:"trusted_keys"`
+	Signature   string   `koanf:"signature"`
+}
+
+// NewPluginInstallCmd is `holomush plugin install <url|path>`.
+func NewPluginInstallCmd() *cobra.Command {
+	cfg := &pluginInstallConfig{}
+	cmd := &cobra.Command{
+		Use:   "install <url|path>",
+		Short: "Verify a signed plugin bundle and stage it for enablement",
+		Long: `Verify a signed plugin bundle and stage it for enablement.
+
+A bundle is a gzipped tar archive with plugin.yaml at its root alongside
+the entry point and any assets. It must come with a detached Ed25519
+signature over the archive bytes, raw or base64, read from <bundle>.sig
+unless --signature names another file or URL. For example:
+
+  openssl pkeyutl -sign -rawin -inkey author.pem -in plugin.tar.gz -out plugin.tar.gz.sig
+
+The signature must verify against one of the trusted keys: PEM public key
+files listed under plugin_install.trusted_keys in the config file or with
+--trusted-keys. Nothing is unpacked before the signature verifies.
+
+The unpacked plugin is then checked the way 'holomush plugin validate'
+checks a directory, and staged under plugins-staged/<name> next to the
+plugins directory. Staged plugins are not loaded; run
+'holomush plugin enable <name>' to move one into place.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Load(configFile, cmd, cfg, "plugin_install"); err != nil {
+				return err
+			}
+			return runPluginInstall(cmd.Context(), cfg, args[0], cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&cfg.DataDir, "data-dir", "", "data directory holding plugins/ (default: XDG data dir)")
+	cmd.Flags().StringSliceVar(&cfg.TrustedKeys, "trusted-keys", nil, "PEM Ed25519 public key files trusted to sign plugins")
+	cmd.Flags().StringVar(&cfg.Signature, "signature", "", "detached signature file or URL (default: <bundle>.sig)")
+	return cmd
+}
+
+// NewPluginEnableCmd is `holomush plugin enable <name>`.
+func NewPluginEnableCmd() *cobra.Command {
+	cfg := &pluginInstallConfig{}
+	cmd := &cobra.Command{
+		Use:   "enable <name>",
+		Short: "Move a staged plugin into the plugins directory",
+		Long: `Move a staged plugin into the plugins directory.
+
+The staged copy is validated again, then moved to plugins/<name>. An
+installed plugin of the same name is kept as plugins-staged/<name>.previous
+so it can be restored by hand. Core picks the plugin up the next time it
+loads plugins.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.Load(configFile, cmd, cfg, "plugin_install"); err != nil {
+				return err
+			}
+			return runPluginEnable(cfg, args[0], cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&cfg.DataDir, "data-dir", "", "data directory holding plugins/ (default: XDG data dir)")
+	return cmd
+}
+
+// pluginDirs resolves the live and staged plugin directories.
+func pluginDirs(dataDir string) (live, staged string, err error) {
+	live, err = checkPluginsDir(dataDir)
+	if err != nil {
+		return "", "", err
+	}
+	return live, filepath.Join(filepath.Dir(live), stagedPluginsDirName), nil
+}
+
+func runPluginInstall(ctx context.Context, cfg *pluginInstallConfig, source string, out io.Writer) error {
+	keys, err := loadTrustedPluginKeys(cfg.TrustedKeys)
+	if err != nil {
+		return err
+	}
+	_, stagedRoot, err := pluginDirs(cfg.DataDir)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := fetchPluginArtifact(ctx, source)
+	if err != nil {
+		return err
+	}
+	sigSource := cfg.Signature
+	if sigSource == "" {
+		sigSource = source + ".sig"
+	}
+	sig, err := fetchPluginArtifact(ctx, sigSource)
+	if err != nil {
+		return err
+	}
+	signer, err := verifyPluginBundle(keys, bundle, sig)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(stagedRoot, 0o750); err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("dir", stagedRoot).Wrap(err)
+	}
+	tmp, err := os.MkdirTemp(stagedRoot, ".install-*")
+	if err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("dir", stagedRoot).Wrap(err)
+	}
+	defer os.RemoveAll(tmp) //nolint:errcheck // best-effort cleanup; empty after a successful rename
+
+	if err := extractPluginBundle(bundle, tmp); err != nil {
+		return err
+	}
+	m, err := validateInstalledPlugin(tmp, out)
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(stagedRoot, m.Name)
+	if err := os.RemoveAll(dest); err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("dir", dest).Wrap(err)
+	}
+	if err := os.Rename(tmp, dest); err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("dir", dest).Wrap(err)
+	}
+
+	digest := sha256.Sum256(bundle)
+	//nolint:errcheck // display output
+	fmt.Fprintf(out, "staged %s %s at %s\n  bundle sha256 %x\n  signed by key %s\nrun 'holomush plugin enable %s' to enable it\n",
+		m.Name, m.Version, dest, digest, signer, m.Name)
+	return nil
+}
+
+func runPluginEnable(cfg *pluginInstallConfig, name string, out io.Writer) error {
+	if !pluginNamePattern.MatchString(name) {
+		return oops.Code("PLUGIN_ENABLE_INVALID_NAME").With("name", name).Errorf("invalid plugin name %q", name)
+	}
+	liveRoot, stagedRoot, err := pluginDirs(cfg.DataDir)
+	if err != nil {
+		return err
+	}
+	staged := filepath.Join(stagedRoot, name)
+	if _, err := os.Stat(staged); err != nil {
+		return oops.Code("PLUGIN_ENABLE_NOT_STAGED").With("name", name).
+			Errorf("no staged plugin %q; run 'holomush plugin install' first", name)
+	}
+	m, err := validateInstalledPlugin(staged, out)
+	if err != nil {
+		return err
+	}
+	if m.Name != name {
+		return oops.Code("PLUGIN_ENABLE_INVALID_NAME").With("name", name).With("manifest_name", m.Name).
+			Errorf("staged plugin %q declares name %q", name, m.Name)
+	}
+
+	if err := os.MkdirAll(liveRoot, 0o750); err != nil {
+		return oops.Code("PLUGIN_ENABLE_FAILED").With("dir", liveRoot).Wrap(err)
+	}
+	live := filepath.Join(liveRoot, name)
+	if _, err := os.Stat(live); err == nil {
+		previous := staged + ".previous"
+		if err := os.RemoveAll(previous); err != nil {
+			return oops.Code("PLUGIN_ENABLE_FAILED").With("dir", previous).Wrap(err)
+		}
+		if err := os.Rename(live, previous); err != nil {
+			return oops.Code("PLUGIN_ENABLE_FAILED").With("dir", live).Wrap(err)
+		}
+		fmt.Fprintf(out, "kept the installed %s as %s\n", name, previous) //nolint:errcheck // display output
+	}
+	if err := os.Rename(staged, live); err != nil {
+		return oops.Code("PLUGIN_ENABLE_FAILED").With("dir", live).Wrap(err)
+	}
+	fmt.Fprintf(out, "enabled %s %s; core loads it on its next start\n", m.Name, m.Version) //nolint:errcheck // display output
+	return nil
+}
+
+// loadTrustedPluginKeys reads PEM-encoded Ed25519 public keys from paths.
+func loadTrustedPluginKeys(paths []string) ([]ed25519.PublicKey, error) {
+	if len(paths) == 0 {
+		return nil, oops.Code("PLUGIN_INSTALL_NO_TRUSTED_KEYS").
+			Errorf("no trusted plugin keys configured; set plugin_install.trusted_keys or pass --trusted-keys")
+	}
+	keys := make([]ed25519.PublicKey, 0, len(paths))
+	for _, path := range paths {
+		raw, err := os.ReadFile(path) //nolint:gosec // G304: operator-configured key path
+		if err != nil {
+			return nil, oops.Code("PLUGIN_INSTALL_KEY_INVALID").With("path", path).Wrap(err)
+		}
+		block, _ := pem.Decode(raw)
+		if block == nil {
+			return nil, oops.Code("PLUGIN_INSTALL_KEY_INVALID").With("path", path).Errorf("no PEM block in %s", path)
+		}
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, oops.Code("PLUGIN_INSTALL_KEY_INVALID").With("path", path).Wrap(err)
+		}
+		key, ok := pub.(ed25519.PublicKey)
+		if !ok {
+			return nil, oops.Code("PLUGIN_INSTALL_KEY_INVALID").With("path", path).
+				Errorf("%s is a %T, not an Ed25519 public key", path, pub)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// pluginKeyFingerprint is the first 16 hex digits of the key's SHA-256.
+func pluginKeyFingerprint(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// verifyPluginBundle checks sig, raw or base64, over bundle against keys and
+// returns the fingerprint of the key that signed it.
+func verifyPluginBundle(keys []ed25519.PublicKey, bundle, sig []byte) (string, error) {
+	if len(sig) != ed25519.SignatureSize {
+		decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sig)))
+		if err != nil || len(decoded) != ed25519.SignatureSize {
+			return "", oops.Code("PLUGIN_INSTALL_SIGNATURE_INVALID").
+				Errorf("signature is neither a raw nor a base64 Ed25519 signature")
+		}
+		sig = decoded
+	}
+	for _, key := range keys {
+		if ed25519.Verify(key, bundle, sig) {
+			return pluginKeyFingerprint(key), nil
+		}
+	}
+	return "", oops.Code("PLUGIN_INSTALL_SIGNATURE_INVALID").
+		Errorf("bundle signature does not verify against any trusted key")
+}
+
+// fetchPluginArtifact reads an http(s) URL or a local path, refusing
+// anything larger than maxPluginBundleBytes.
+func fetchPluginArtifact(ctx context.Context, source string) ([]byte, error) {
+	var body io.Reader
+	if u, err := url.Parse(source); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		ctx, cancel := context.WithTimeout(ctx, pluginFetchTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+		if err != nil {
+			return nil, oops.Code("PLUGIN_INSTALL_FETCH_FAILED").With("source", source).Wrap(err)
+		}
+		resp, err := http.DefaultClient.Do(req) //nolint:gosec // G107: operator-supplied bundle URL is the point of this command
+		if err != nil {
+			return nil, oops.Code("PLUGIN_INSTALL_FETCH_FAILED").With("source", source).Wrap(err)
+		}
+		defer resp.Body.Close() //nolint:errcheck // read-only response body
+		if resp.StatusCode != http.StatusOK {
+			return nil, oops.Code("PLUGIN_INSTALL_FETCH_FAILED").With("source", source).With("status", resp.StatusCode).
+				Errorf("fetch %s: %s", source, resp.Status)
+		}
+		body = resp.Body
+	} else {
+		f, err := os.Open(source) //nolint:gosec // G304: operator-supplied bundle path is the point of this command
+		if err != nil {
+			return nil, oops.Code("PLUGIN_INSTALL_FETCH_FAILED").With("source", source).Wrap(err)
+		}
+		defer f.Close() //nolint:errcheck // read-only file
+		body = f
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxPluginBundleBytes+1))
+	if err != nil {
+		return nil, oops.Code("PLUGIN_INSTALL_FETCH_FAILED").With("source", source).Wrap(err)
+	}
+	if len(data) > maxPluginBundleBytes {
+		return nil, oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").With("source", source).
+			Errorf("%s exceeds %d bytes", source, maxPluginBundleBytes)
+	}
+	return data, nil
+}
+
+// extractPluginBundle unpacks a gzipped tar into dir. Only regular files
+// and directories with local paths are accepted; links, devices, and
+// entries escaping dir fail the whole bundle.
+func extractPluginBundle(bundle []byte, dir string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(bundle))
+	if err != nil {
+		return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").Wrap(err)
+	}
+	defer gz.Close() //nolint:errcheck // in-memory reader
+
+	tr := tar.NewReader(gz)
+	var total int64
+	for entries := 0; ; entries++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").Wrap(err)
+		}
+		if entries >= maxPluginBundleEntries {
+			return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").Errorf("bundle has more than %d entries", maxPluginBundleEntries)
+		}
+		name := filepath.FromSlash(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").With("entry", hdr.Name).
+				Errorf("bundle entry %q escapes the plugin directory", hdr.Name)
+		}
+		target := filepath.Join(dir, name)
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o750); err != nil {
+				return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("entry", hdr.Name).Wrap(err)
+			}
+		case tar.TypeReg:
+			total += hdr.Size
+			if total > maxPluginExtractBytes {
+				return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").Errorf("bundle unpacks to more than %d bytes", maxPluginExtractBytes)
+			}
+			if err := writeBundleFile(tr, target, hdr); err != nil {
+				return err
+			}
+		default:
+			return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").With("entry", hdr.Name).
+				Errorf("bundle entry %q is not a regular file or directory", hdr.Name)
+		}
+	}
+}
+
+// writeBundleFile writes one regular tar entry, keeping only the owner
+// execute bit from its mode so binary plugins stay runnable.
+func writeBundleFile(r io.Reader, target string, hdr *tar.Header) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("entry", hdr.Name).Wrap(err)
+	}
+	mode := os.FileMode(0o640)
+	if hdr.Mode&0o100 != 0 {
+		mode = 0o750
+	}
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode) //nolint:gosec // G304: target is checked local to the staging dir
+	if err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("entry", hdr.Name).Wrap(err)
+	}
+	if _, err := io.CopyN(f, r, hdr.Size); err != nil {
+		_ = f.Close() //nolint:errcheck // already failing
+		return oops.Code("PLUGIN_INSTALL_BUNDLE_INVALID").With("entry", hdr.Name).Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return oops.Code("PLUGIN_INSTALL_STAGE_FAILED").With("entry", hdr.Name).Wrap(err)
+	}
+	return nil
+}
+
+// validateInstalledPlugin runs the plugin validate checks over dir, writes
+// any findings to out, and returns the parsed manifest when there are no
+// errors.
+func validateInstalledPlugin(dir string, out io.Writer) (*plugins.Manifest, error) {
+	report, err := validatePluginPath(dir)
+	if err != nil {
+		return nil, oops.Code("PLUGIN_INSTALL_INVALID").With("dir", dir).Wrap(err)
+	}
+	if err := report.write(out); err != nil {
+		return nil, err
+	}
+	if n := report.errorCount(); n > 0 {
+		return nil, oops.Code("PLUGIN_INSTALL_INVALID").With("errors", n).
+			Errorf("plugin validation failed with %d error(s)", n)
+	}
+	raw, err := os.ReadFile(filepath.Join(dir, manifestFileName)) //nolint:gosec // G304: manifest inside the staging dir
+	if err != nil {
+		return nil, oops.Code("PLUGIN_INSTALL_INVALID").With("dir", dir).Wrap(err)
+	}
+	m, err := plugins.ParseManifest(raw)
+	if err != nil {
+		return nil, oops.Code("PLUGIN_INSTALL_INVALID").With("dir", dir).Wrap(err)
+	}
+	return m, nil
+}
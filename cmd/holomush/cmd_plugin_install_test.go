@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+const installTestManifest = `name: hello
+version: 1.0.0
+type: lua
+lua-plugin: { entry: main.lua }
+events: [say]
+`
+
+func installTestFiles() map[string]string {
+	return map[string]string{
+		"plugin.yaml": installTestManifest,
+		"main.lua":    "function on_event(event) end\n",
+	}
+}
+
+// buildPluginBundle returns a gzipped tar of files, in name order, plus any
+// extra headers appended verbatim.
+func buildPluginBundle(t *testing.T, files map[string]string, extra ...*tar.Header) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range sortedKeys(files) {
+		body := files[name]
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(body)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(body))
+		require.NoError(t, err)
+	}
+	for _, hdr := range extra {
+		require.NoError(t, tw.WriteHeader(hdr))
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// writeTrustedKey generates a signing key and writes its public half as PEM.
+func writeTrustedKey(t *testing.T, dir, name string) (string, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path, priv
+}
+
+type installFixture struct {
+	cfg     *pluginInstallConfig
+	priv    ed25519.PrivateKey
+	scratch string
+}
+
+func newInstallFixture(t *testing.T) *installFixture {
+	t.Helper()
+	scratch := t.TempDir()
+	keyPath, priv := writeTrustedKey(t, scratch, "trusted.pem")
+	return &installFixture{
+		cfg:     &pluginInstallConfig{DataDir: t.TempDir(), TrustedKeys: []string{keyPath}},
+		priv:    priv,
+		scratch: scratch,
+	}
+}
+
+// writeBundle writes bundle and its signature by key to the scratch dir
+// and returns the bundle path.
+func (f *installFixture) writeBundle(t *testing.T, bundle []byte, key ed25519.PrivateKey) string {
+	t.Helper()
+	path := filepath.Join(f.scratch, "hello.tar.gz")
+	require.NoError(t, os.WriteFile(path, bundle, 0o600))
+	require.NoError(t, os.WriteFile(path+".sig", ed25519.Sign(key, bundle), 0o600))
+	return path
+}
+
+func (f *installFixture) stagedDir(name string) string {
+	return filepath.Join(f.cfg.DataDir, stagedPluginsDirName, name)
+}
+
+func (f *installFixture) liveDir(name string) string {
+	return filepath.Join(f.cfg.DataDir, "plugins", name)
+}
+
+func TestPluginInstallAndEnableSubcommandsResolve(t *testing.T) {
+	for _, sub := range []string{"install", "enable"} {
+		resolved, _, err := NewRootCmd().Find([]string{"plugin", sub})
+		require.NoError(t, err)
+		assert.Equal(t, sub, resolved.Name())
+	}
+}
+
+func TestRunPluginInstall(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stages a bundle signed by a trusted key", func(t *testing.T) {
+		f := newInstallFixture(t)
+		path := f.writeBundle(t, buildPluginBundle(t, installTestFiles()), f.priv)
+
+		var out bytes.Buffer
+		require.NoError(t, runPluginInstall(ctx, f.cfg, path, &out))
+		assert.Contains(t, out.String(), "staged hello 1.0.0")
+		assert.Contains(t, out.String(), "holomush plugin enable hello")
+		assert.FileExists(t, filepath.Join(f.stagedDir("hello"), "main.lua"))
+		assert.NoDirExists(t, f.liveDir("hello"), "install must not enable the plugin")
+	})
+
+	t.Run("accepts a base64 signature from --signature", func(t *testing.T) {
+		f := newInstallFixture(t)
+		bundle := buildPluginBundle(t, installTestFiles())
+		path := f.writeBundle(t, bundle, f.priv)
+		sigPath := filepath.Join(f.scratch, "detached.b64")
+		require.NoError(t, os.WriteFile(sigPath, []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(f.priv, bundle))+"\n"), 0o600))
+		f.cfg.Signature = sigPath
+
+		require.NoError(t, runPluginInstall(ctx, f.cfg, path, io.Discard))
+		assert.DirExists(t, f.stagedDir("hello"))
+	})
+
+	t.Run("downloads a bundle and signature over HTTP", func(t *testing.T) {
+		f := newInstallFixture(t)
+		bundle := buildPluginBundle(t, installTestFiles())
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/hello.tar.gz":
+				_, _ = w.Write(bundle)
+			case "/hello.tar.gz.sig":
+				_, _ = w.Write(ed25519.Sign(f.priv, bundle))
+			default:
+				http.NotFound(w, r)
+			}
+		}))
+		defer srv.Close()
+
+		require.NoError(t, runPluginInstall(ctx, f.cfg, srv.URL+"/hello.tar.gz", io.Discard))
+		assert.DirExists(t, f.stagedDir("hello"))
+
+		err := runPluginInstall(ctx, f.cfg, srv.URL+"/missing.tar.gz", io.Discard)
+		errutil.AssertErrorCode(t, err, "PLUGIN_INSTALL_FETCH_FAILED")
+	})
+
+	rejections := []struct {
+		name     string
+		bundle   func(t *testing.T) []byte
+		wrongKey bool
+		wantCode string
+	}{
+		{
+			name:     "rejects a bundle signed by an untrusted key",
+			bundle:   func(t *testing.T) []byte { return buildPluginBundle(t, installTestFiles()) },
+			wrongKey: true,
+			wantCode: "PLUGIN_INSTALL_SIGNATURE_INVALID",
+		},
+		{
+			name: "rejects an entry escaping the plugin directory",
+			bundle: func(t *testing.T) []byte {
+				files := installTestFiles()
+				files["../escape.lua"] = "x"
+				return buildPluginBundle(t, files)
+			},
+			wantCode: "PLUGIN_INSTALL_BUNDLE_INVALID",
+		},
+		{
+			name: "rejects a symlink entry",
+			bundle: func(t *testing.T) []byte {
+				return buildPluginBundle(t, installTestFiles(),
+					&tar.Header{Name: "link", Linkname: "/etc/passwd", Typeflag: tar.TypeSymlink})
+			},
+			wantCode: "PLUGIN_INSTALL_BUNDLE_INVALID",
+		},
+		{
+			name: "rejects a plugin that fails validation",
+			bundle: func(t *testing.T) []byte {
+				return buildPluginBundle(t, map[string]string{"plugin.yaml": installTestManifest})
+			},
+			wantCode: "PLUGIN_INSTALL_INVALID",
+		},
+	}
+	for _, tt := range rejections {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newInstallFixture(t)
+			key := f.priv
+			if tt.wrongKey {
+				_, key = writeTrustedKey(t, f.scratch, "other.pem")
+			}
+			path := f.writeBundle(t, tt.bundle(t), key)
+
+			err := runPluginInstall(ctx, f.cfg, path, io.Discard)
+			errutil.AssertErrorCode(t, err, tt.wantCode)
+			entries, readErr := os.ReadDir(filepath.Join(f.cfg.DataDir, stagedPluginsDirName))
+			if readErr == nil {
+				assert.Empty(t, entries, "a rejected bundle must leave nothing staged")
+			}
+		})
+	}
+
+	t.Run("requires a trusted key", func(t *testing.T) {
+		f := newInstallFixture(t)
+		path := f.writeBundle(t, buildPluginBundle(t, installTestFiles()), f.priv)
+		f.cfg.TrustedKeys = nil
+
+		err := runPluginInstall(ctx, f.cfg, path, io.Discard)
+		errutil.AssertErrorCode(t, err, "PLUGIN_INSTALL_NO_TRUSTED_KEYS")
+	})
+}
+
+func TestRunPluginEnable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves the staged plugin into place and keeps the previous copy", func(t *testing.T) {
+		f := newInstallFixture(t)
+		path := f.writeBundle(t, buildPluginBundle(t, installTestFiles()), f.priv)
+		require.NoError(t, runPluginInstall(ctx, f.cfg, path, io.Discard))
+		require.NoError(t, runPluginEnable(f.cfg, "hello", io.Discard))
+		assert.FileExists(t, filepath.Join(f.liveDir("hello"), "main.lua"))
+		assert.NoDirExists(t, f.stagedDir("hello"))
+
+		files := installTestFiles()
+		files["main.lua"] = "function on_event(event) return end\n"
+		path = f.writeBundle(t, buildPluginBundle(t, files), f.priv)
+		require.NoError(t, runPluginInstall(ctx, f.cfg, path, io.Discard))
+		var out bytes.Buffer
+		require.NoError(t, runPluginEnable(f.cfg, "hello", &out))
+		assert.Contains(t, out.String(), "hello.previous")
+
+		got, err := os.ReadFile(filepath.Join(f.liveDir("hello"), "main.lua"))
+		require.NoError(t, err)
+		assert.Equal(t, files["main.lua"], string(got))
+		assert.FileExists(t, filepath.Join(f.stagedDir("hello.previous"), "main.lua"))
+	})
+
+	t.Run("requires a staged plugin", func(t *testing.T) {
+		f := newInstallFixture(t)
+		errutil.AssertErrorCode(t, runPluginEnable(f.cfg, "hello", io.Discard), "PLUGIN_ENABLE_NOT_STAGED")
+	})
+
+	t.Run("rejects names that are not plugin names", func(t *testing.T) {
+		f := newInstallFixture(t)
+		errutil.AssertErrorCode(t, runPluginEnable(f.cfg, "../hello", io.Discard), "PLUGIN_ENABLE_INVALID_NAME")
+	})
+}
@@ -0,0 +1,331 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	plugins "github.com/holomush/holomush/internal/plugin"
+	"github.com/holomush/holomush/internal/plugin/hostfunc"
+	pluginlua "github.com/holomush/holomush/internal/plugin/lua"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// NewPluginReplayCmd is `holomush plugin replay <plugin-dir>`. It loads a
+// Lua plugin into an in-process host with no server behind it, delivers a
+// recorded event stream, and captures what the plugin emits, so plugin
+// authors can iterate against golden files without a running server.
+func NewPluginReplayCmd() *cobra.Command {
+	var eventsPath, goldenPath string
+	var update bool
+
+	cmd := &cobra.Command{
+		Use:   "replay <plugin-dir>",
+		Short: "Replay recorded events through a plugin and diff its emits against a golden file",
+		Long: `Replay recorded events through a Lua plugin without a running server.
+
+--events is a JSON Lines file. Each line is either a fixture event:
+
+  {"id": "e1", "stream": "location.01ABC", "type": "say",
+   "actor_kind": "character", "actor_id": "01XYZ",
+   "timestamp": "2026-01-02T15:04:05Z", "payload": {"message": "hi"}}
+
+or a frame exported by ` + "`holomush admin read-stream --output json`" + `. Only
+"event" frames are replayed; metadata-only frames carry no payload and are
+skipped. Frames do not record the actor, so they replay as system events.
+
+Events are delivered in order. Events the plugin's manifest does not
+subscribe to (events list and event_filter) are recorded as filtered, as
+the live host would drop them. Host capabilities that need server state
+(kv, world queries, settings) are unavailable and fail as they would
+against an unconfigured server.
+
+Without --golden the captured output is printed. With --golden it is
+compared against the file and the command exits non-zero with a diff when
+they differ; --update rewrites the golden file instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if update && goldenPath == "" {
+				return fmt.Errorf("--update requires --golden")
+			}
+			events, err := readReplayEvents(eventsPath)
+			if err != nil {
+				return err
+			}
+			results, err := replayPlugin(cmd.Context(), args[0], events)
+			if err != nil {
+				return err
+			}
+			got, err := marshalReplayResults(results)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			switch {
+			case goldenPath == "":
+				_, err = out.Write(got)
+				return err
+			case update:
+				if err := os.WriteFile(goldenPath, got, 0o600); err != nil {
+					return err
+				}
+				_, err = fmt.Fprintf(out, "updated %s\n", goldenPath)
+				return err
+			}
+			return compareGolden(out, goldenPath, got)
+		},
+	}
+	cmd.Flags().StringVar(&eventsPath, "events", "", "JSON Lines file of recorded events to replay")
+	cmd.Flags().StringVar(&goldenPath, "golden", "", "golden file to compare the captured output against")
+	cmd.Flags().BoolVar(&update, "update", false, "rewrite the golden file with the captured output")
+	if err := cmd.MarkFlagRequired("events"); err != nil {
+		// MarkFlagRequired only fails if the flag name is wrong — programmer error.
+		panic(fmt.Sprintf("cmd_plugin_replay: MarkFlagRequired: %v", err))
+	}
+	return cmd
+}
+
+// replayRecord is one line of a replay events file. It decodes both the
+// fixture shape and the read-stream JSON frame shape; FrameType tells them
+// apart.
+type replayRecord struct {
+	// Fixture fields.
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	ActorKind string          `json:"actor_kind"`
+	ActorID   string          `json:"actor_id"`
+	Payload   json.RawMessage `json:"payload"`
+
+	// Shared by both shapes.
+	Stream    string `json:"stream"`
+	Timestamp string `json:"timestamp"`
+
+	// Read-stream frame fields. Frame payloads are base64 bytes.
+	FrameType    string `json:"frame_type"`
+	EventType    string `json:"event_type"`
+	MetadataOnly bool   `json:"metadata_only"`
+}
+
+// readReplayEvents parses a replay events file. Blank lines are ignored.
+// Events without an id are numbered by their line.
+func readReplayEvents(path string) ([]pluginsdk.Event, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: author-supplied fixture path is the point of this command
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck // read-only file
+
+	var events []pluginsdk.Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var rec replayRecord
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		event, ok, err := rec.event()
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if !ok {
+			continue
+		}
+		if event.ID == "" {
+			event.ID = fmt.Sprintf("line-%d", line)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// event converts a record to the event a plugin receives. ok is false for
+// read-stream frames that carry no replayable event.
+func (r replayRecord) event() (event pluginsdk.Event, ok bool, err error) {
+	event = pluginsdk.Event{ID: r.ID, Stream: r.Stream, Type: pluginsdk.EventType(r.Type), ActorID: r.ActorID}
+	if r.Timestamp != "" {
+		ts, err := time.Parse(time.RFC3339Nano, r.Timestamp)
+		if err != nil {
+			return pluginsdk.Event{}, false, fmt.Errorf("timestamp: %w", err)
+		}
+		event.Timestamp = ts.UnixMilli()
+	}
+
+	if r.FrameType != "" {
+		if r.FrameType != "event" || r.MetadataOnly {
+			return pluginsdk.Event{}, false, nil
+		}
+		event.Type = pluginsdk.EventType(r.EventType)
+		event.ActorKind = pluginsdk.ActorSystem
+		if len(r.Payload) > 0 {
+			var payload []byte
+			if err := json.Unmarshal(r.Payload, &payload); err != nil {
+				return pluginsdk.Event{}, false, fmt.Errorf("payload: %w", err)
+			}
+			event.Payload = string(payload)
+		}
+		return event, true, nil
+	}
+
+	if event.Type == "" {
+		return pluginsdk.Event{}, false, fmt.Errorf("event has no type")
+	}
+	if event.ActorKind, ok = parseActorKind(r.ActorKind); !ok {
+		return pluginsdk.Event{}, false, fmt.Errorf("unknown actor_kind %q (allowed: character, system, plugin)", r.ActorKind)
+	}
+	if len(r.Payload) > 0 {
+		// Compact so handlers see the payload as the server would send it,
+		// however the fixture author formatted it.
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, r.Payload); err != nil {
+			return pluginsdk.Event{}, false, fmt.Errorf("payload: %w", err)
+		}
+		event.Payload = compact.String()
+	}
+	return event, true, nil
+}
+
+// parseActorKind maps an actor kind wire name to its ActorKind. An empty
+// name is a character, the common case for recorded player input.
+func parseActorKind(name string) (pluginsdk.ActorKind, bool) {
+	if name == "" {
+		return pluginsdk.ActorCharacter, true
+	}
+	for _, k := range []pluginsdk.ActorKind{pluginsdk.ActorCharacter, pluginsdk.ActorSystem, pluginsdk.ActorPlugin} {
+		if k.String() == name {
+			return k, true
+		}
+	}
+	return 0, false
+}
+
+// replayResult is what one replayed event produced. Exactly one of
+// Filtered, Error, and Emits is meaningful.
+type replayResult struct {
+	Event    string       `json:"event"`
+	Type     string       `json:"type"`
+	Filtered bool         `json:"filtered,omitempty"`
+	Error    string       `json:"error,omitempty"`
+	Emits    []replayEmit `json:"emits,omitempty"`
+}
+
+// replayEmit is one event the plugin emitted. Payload is kept as JSON when
+// it parses, so golden files diff field by field.
+type replayEmit struct {
+	Stream    string          `json:"stream"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+	Sensitive bool            `json:"sensitive,omitempty"`
+}
+
+// replayPlugin loads the Lua plugin in dir and delivers events to it in
+// order. Handler errors are recorded in the result rather than stopping the
+// replay, so error paths can be pinned in golden files too.
+func replayPlugin(ctx context.Context, dir string, events []pluginsdk.Event) ([]replayResult, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, manifestFileName)) //nolint:gosec // G304: author-supplied plugin path is the point of this command
+	if err != nil {
+		return nil, err
+	}
+	m, err := plugins.ParseManifest(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+	if m.Type != plugins.TypeLua {
+		return nil, fmt.Errorf("plugin %s is a %s plugin; replay supports Lua plugins only", m.Name, m.Type)
+	}
+
+	host := pluginlua.NewHostWithFunctions(hostfunc.New(nil))
+	defer host.Close(ctx) //nolint:errcheck // nothing to recover at CLI exit
+	if err := host.Load(ctx, m, dir); err != nil {
+		return nil, fmt.Errorf("load plugin: %w", err)
+	}
+
+	filter := m.SubscriptionFilter()
+	results := make([]replayResult, 0, len(events))
+	for _, event := range events {
+		result := replayResult{Event: event.ID, Type: string(event.Type)}
+		if len(m.Events) == 0 || !filter.Matches(event) {
+			result.Filtered = true
+			results = append(results, result)
+			continue
+		}
+		emits, err := host.DeliverEvent(ctx, m.Name, event)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		for _, e := range emits {
+			result.Emits = append(result.Emits, newReplayEmit(e))
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func newReplayEmit(e pluginsdk.EmitEvent) replayEmit {
+	out := replayEmit{Stream: e.Stream, Type: string(e.Type), Sensitive: e.Sensitive}
+	switch {
+	case e.Payload == "":
+	case json.Valid([]byte(e.Payload)):
+		out.Payload = json.RawMessage(e.Payload)
+	default:
+		quoted, _ := json.Marshal(e.Payload) //nolint:errcheck // marshaling a string cannot fail
+		out.Payload = quoted
+	}
+	return out
+}
+
+// marshalReplayResults renders results in the golden file format: indented
+// JSON with a trailing newline.
+func marshalReplayResults(results []replayResult) ([]byte, error) {
+	b, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// compareGolden reports "OK" when got matches the golden file, and
+// otherwise writes a unified diff and returns an error.
+func compareGolden(w io.Writer, goldenPath string, got []byte) error {
+	want, err := os.ReadFile(goldenPath) //nolint:gosec // G304: author-supplied golden path is the point of this command
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(want, got) {
+		_, err = fmt.Fprintln(w, "OK")
+		return err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(want)),
+		B:        difflib.SplitLines(string(got)),
+		FromFile: goldenPath,
+		ToFile:   "replay",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, strings.TrimRight(diff, "\n")+"\n"); err != nil {
+		return err
+	}
+	return fmt.Errorf("replay output differs from %s; rerun with --update to accept it", goldenPath)
+}
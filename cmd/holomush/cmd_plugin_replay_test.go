@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+const replayManifest = `
+name: echo
+version: 1.0.0
+type: lua
+lua-plugin: { entry: main.lua }
+events: [say]
+event_filter:
+  actor_kinds: [character]
+`
+
+const replayMainLua = `
+function on_event(event)
+  if event.payload == '{"message":"boom"}' then
+    error("boom")
+  end
+  return {
+    { subject = event.stream, type = "echo", payload = event.payload },
+  }
+end
+`
+
+// writeReplayFixture lays out a plugin dir and an events file, returning
+// both paths.
+func writeReplayFixture(t *testing.T, events string) (pluginDir, eventsPath string) {
+	t.Helper()
+	pluginDir = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "plugin.yaml"), []byte(replayManifest), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "main.lua"), []byte(replayMainLua), 0o600))
+	eventsPath = filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(eventsPath, []byte(events), 0o600))
+	return pluginDir, eventsPath
+}
+
+const replayEvents = `{"id": "e1", "stream": "location.1", "type": "say", "payload": {"message": "hi"}}
+
+{"id": "e2", "stream": "location.1", "type": "pose", "payload": {"message": "waves"}}
+{"id": "e3", "stream": "location.1", "type": "say", "actor_kind": "system", "payload": {"message": "tick"}}
+{"stream": "location.1", "type": "say", "payload": {"message": "boom"}}
+`
+
+const replayGolden = `[
+  {
+    "event": "e1",
+    "type": "say",
+    "emits": [
+      {
+        "stream": "location.1",
+        "type": "echo",
+        "payload": {
+          "message": "hi"
+        }
+      }
+    ]
+  },
+  {
+    "event": "e2",
+    "type": "pose",
+    "filtered": true
+  },
+  {
+    "event": "e3",
+    "type": "say",
+    "filtered": true
+  },
+  {
+    "event": "line-5",
+    "type": "say",
+    "error": `
+
+func TestPluginReplayPrintsCapturedEmits(t *testing.T) {
+	pluginDir, eventsPath := writeReplayFixture(t, replayEvents)
+
+	out, code := runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath})
+	require.Equal(t, 0, code, "output:\n%s", out)
+	assert.Contains(t, out, replayGolden)
+	assert.Contains(t, out, "boom")
+}
+
+func TestPluginReplayGoldenRoundTrip(t *testing.T) {
+	pluginDir, eventsPath := writeReplayFixture(t, replayEvents)
+	golden := filepath.Join(t.TempDir(), "echo.golden.json")
+
+	out, code := runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath, "--golden", golden, "--update"})
+	require.Equal(t, 0, code, "output:\n%s", out)
+	assert.Contains(t, out, "updated "+golden)
+
+	out, code = runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath, "--golden", golden})
+	require.Equal(t, 0, code, "output:\n%s", out)
+	assert.Contains(t, out, "OK")
+
+	// Changing the plugin's behavior breaks the golden with a diff.
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "main.lua"),
+		[]byte(`function on_event(event) return nil end`), 0o600))
+	out, code = runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath, "--golden", golden})
+	require.NotEqual(t, 0, code)
+	assert.Contains(t, out, "--- "+golden)
+	assert.Contains(t, out, `-        "type": "echo",`)
+	assert.Contains(t, out, "rerun with --update")
+}
+
+func TestPluginReplayRejectsUpdateWithoutGolden(t *testing.T) {
+	pluginDir, eventsPath := writeReplayFixture(t, replayEvents)
+
+	out, code := runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath, "--update"})
+	require.NotEqual(t, 0, code)
+	assert.Contains(t, out, "--update requires --golden")
+}
+
+func TestPluginReplayRejectsNonLuaPlugins(t *testing.T) {
+	pluginDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(pluginDir, "plugin.yaml"),
+		[]byte("name: bin\nversion: 1.0.0\ntype: binary\nbinary-plugin: { executable: bin }\n"), 0o600))
+	eventsPath := filepath.Join(t.TempDir(), "events.jsonl")
+	require.NoError(t, os.WriteFile(eventsPath, nil, 0o600))
+
+	out, code := runCmd(t, []string{"plugin", "replay", pluginDir, "--events", eventsPath})
+	require.NotEqual(t, 0, code)
+	assert.Contains(t, out, "replay supports Lua plugins only")
+}
+
+func TestReadReplayEventsAcceptsReadStreamFrames(t *testing.T) {
+	payload := base64.StdEncoding.EncodeToString([]byte(`{"message":"hi"}`))
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(
+		`{"frame_type":"started","request_id":"r1"}
+{"frame_type":"event","stream":"location.1","event_type":"say","timestamp":"2026-01-02T15:04:05Z","payload":"`+payload+`"}
+{"frame_type":"event","stream":"location.1","event_type":"whisper","metadata_only":true,"no_plaintext_reason":"NO_PLAINTEXT_REASON_SENSITIVE"}
+{"frame_type":"finished","events_scanned":2}
+`), 0o600))
+
+	events, err := readReplayEvents(path)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, pluginsdk.Event{
+		ID:        "line-2",
+		Stream:    "location.1",
+		Type:      "say",
+		Timestamp: 1767366245000,
+		ActorKind: pluginsdk.ActorSystem,
+		Payload:   `{"message":"hi"}`,
+	}, events[0])
+}
+
+func TestReadReplayEventsRejectsBadRecords(t *testing.T) {
+	tests := []struct {
+		name, line, wantErr string
+	}{
+		{"malformed JSON", `{"type":`, "events.jsonl:1"},
+		{"missing type", `{"id":"e1"}`, "event has no type"},
+		{"unknown actor kind", `{"type":"say","actor_kind":"robot"}`, `unknown actor_kind "robot"`},
+		{"bad timestamp", `{"type":"say","timestamp":"yesterday"}`, "timestamp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "events.jsonl")
+			require.NoError(t, os.WriteFile(path, []byte(tt.line+"\n"), 0o600))
+
+			_, err := readReplayEvents(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
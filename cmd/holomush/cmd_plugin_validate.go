@@ -5,45 +5,345 @@ package main
 
 import (
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/yuin/gopher-lua/ast"
+	"github.com/yuin/gopher-lua/parse"
 
 	plugins "github.com/holomush/holomush/internal/plugin"
 )
 
-// NewPluginValidateCmd is `holomush plugin validate <manifest-path>`.
-// Author-time manifest validator that runs ValidateCrypto +
-// ResolveCryptoRefs (self-refs only, since at author time we don't
-// have the full registry).
+// manifestFileName is the manifest every plugin directory carries.
+const manifestFileName = "plugin.yaml"
+
+// NewPluginValidateCmd is `holomush plugin validate <plugin-dir|manifest-path>`.
+// Author-time validator that runs before deploy. Given a manifest path it
+// checks the manifest alone: JSON schema, ParseManifest, ValidateCrypto and
+// ResolveCryptoRefs (self-refs only, since at author time we don't have the
+// full registry). Given a plugin directory it additionally verifies the
+// entry point (Lua handlers, binary executable, setting content dir) and,
+// for Lua plugins, lints host capabilities used in source against the
+// manifest's requires list.
 func NewPluginValidateCmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "validate <manifest-path>",
-		Short: "Validate a plugin manifest (grammar + crypto.emits rules)",
-		Args:  cobra.ExactArgs(1),
+		Use:   "validate <plugin-dir|manifest-path>",
+		Short: "Validate a plugin before deploy (manifest, entry point, capabilities)",
+		Long: `Validate a plugin before deploy.
+
+With a manifest path, checks the manifest against the plugin schema and the
+grammar + crypto.emits rules. With a plugin directory, also checks that the
+entry point exists and exposes the handlers the manifest needs, and that
+every host capability the Lua source reaches for is declared under requires.
+
+Every problem is reported, not just the first; the command exits non-zero
+when any error is found. Warnings do not fail validation.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			raw, err := os.ReadFile(args[0])
+			report, err := validatePluginPath(args[0])
 			if err != nil {
 				return err
 			}
-			m, err := plugins.ParseManifest(raw)
-			if err != nil {
-				return fmt.Errorf("parse: %w", err)
+			out := cmd.OutOrStdout()
+			if err := report.write(out); err != nil {
+				return err
 			}
-			err = plugins.ValidateCrypto(m)
-			if err != nil {
-				return fmt.Errorf("validate: %w", err)
+			if n := report.errorCount(); n > 0 {
+				return fmt.Errorf("plugin validation failed with %d error(s)", n)
 			}
-			selfReg := map[string][]plugins.CryptoEmit{}
-			if m.Crypto != nil {
-				selfReg[m.Name] = m.Crypto.Emits
+			_, err = fmt.Fprintln(out, "OK")
+			return err
+		},
+	}
+}
+
+// validateSeverity grades a validation finding.
+type validateSeverity string
+
+const (
+	severityError   validateSeverity = "error"
+	severityWarning validateSeverity = "warning"
+)
+
+// validateFinding is one problem found by plugin validate, with an
+// optional hint telling the author how to fix it.
+type validateFinding struct {
+	Severity validateSeverity
+	Where    string
+	Message  string
+	Hint     string
+}
+
+// validateReport collects every finding for one plugin.
+type validateReport struct {
+	findings []validateFinding
+}
+
+func (r *validateReport) errorf(where, hint, format string, args ...any) {
+	r.findings = append(r.findings, validateFinding{
+		Severity: severityError, Where: where, Message: fmt.Sprintf(format, args...), Hint: hint,
+	})
+}
+
+func (r *validateReport) warnf(where, hint, format string, args ...any) {
+	r.findings = append(r.findings, validateFinding{
+		Severity: severityWarning, Where: where, Message: fmt.Sprintf(format, args...), Hint: hint,
+	})
+}
+
+func (r *validateReport) errorCount() int {
+	n := 0
+	for _, f := range r.findings {
+		if f.Severity == severityError {
+			n++
+		}
+	}
+	return n
+}
+
+// write prints findings as "<severity>: <where>: <message>" lines, each
+// followed by an indented hint when one is set.
+func (r *validateReport) write(w io.Writer) error {
+	for _, f := range r.findings {
+		line := string(f.Severity) + ": "
+		if f.Where != "" {
+			line += f.Where + ": "
+		}
+		if _, err := fmt.Fprintln(w, line+f.Message); err != nil {
+			return err
+		}
+		if f.Hint != "" {
+			if _, err := fmt.Fprintln(w, "  hint: "+f.Hint); err != nil {
+				return err
 			}
-			err = plugins.ResolveCryptoRefs(m, selfReg)
-			if err != nil {
-				return fmt.Errorf("resolve: %w", err)
+		}
+	}
+	return nil
+}
+
+// validatePluginPath validates a plugin directory or a bare manifest file.
+// The returned error is reserved for I/O failures that stop validation
+// before any check can run; problems with the plugin itself are findings.
+func validatePluginPath(path string) (*validateReport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	dir, manifestPath := "", path
+	if info.IsDir() {
+		dir, manifestPath = path, filepath.Join(path, manifestFileName)
+	}
+	raw, err := os.ReadFile(manifestPath) //nolint:gosec // G304: author-supplied plugin path is the point of this command
+	if err != nil {
+		return nil, err
+	}
+
+	report := &validateReport{}
+	m := validateManifest(report, manifestPath, raw)
+	if m == nil || dir == "" {
+		return report, nil
+	}
+	switch m.Type {
+	case plugins.TypeLua:
+		validateLuaPlugin(report, dir, m)
+	case plugins.TypeBinary:
+		validateBinaryPlugin(report, dir, m)
+	case plugins.TypeSetting:
+		validateSettingPlugin(report, dir, m)
+	}
+	return report, nil
+}
+
+// validateManifest runs the manifest-only checks. It returns the parsed
+// manifest, or nil when the manifest cannot be parsed and the directory
+// checks have nothing to work from.
+func validateManifest(report *validateReport, manifestPath string, raw []byte) *plugins.Manifest {
+	if err := plugins.ValidateSchema(raw); err != nil {
+		report.errorf(manifestPath, "compare the manifest against schemas/plugin.schema.json",
+			"schema: %s", plugins.FormatSchemaError(err))
+	}
+	m, err := plugins.ParseManifest(raw)
+	if err != nil {
+		report.errorf(manifestPath, "", "parse: %v", err)
+		return nil
+	}
+	if err := plugins.ValidateCrypto(m); err != nil {
+		report.errorf(manifestPath, "", "validate: %v", err)
+		return m
+	}
+	selfReg := map[string][]plugins.CryptoEmit{}
+	if m.Crypto != nil {
+		selfReg[m.Name] = m.Crypto.Emits
+	}
+	if err := plugins.ResolveCryptoRefs(m, selfReg); err != nil {
+		report.errorf(manifestPath, "", "resolve: %v", err)
+	}
+	return m
+}
+
+// validateLuaPlugin checks the Lua entry file parses and defines the
+// handlers the manifest needs, then lints host-capability use across every
+// .lua file in the plugin directory.
+func validateLuaPlugin(report *validateReport, dir string, m *plugins.Manifest) {
+	if m.LuaPlugin == nil {
+		return // ParseManifest already rejects this
+	}
+	entryPath := filepath.Join(dir, m.LuaPlugin.Entry)
+	src, err := os.ReadFile(entryPath) //nolint:gosec // G304: entry is resolved inside the author-supplied plugin dir
+	if err != nil {
+		report.errorf(entryPath, "lua-plugin.entry is resolved relative to the plugin directory",
+			"cannot read Lua entry: %v", err)
+		return
+	}
+	chunk, err := parse.Parse(strings.NewReader(string(src)), m.LuaPlugin.Entry)
+	if err != nil {
+		report.errorf(entryPath, "", "Lua syntax error: %v", err)
+		return
+	}
+	globals := luaGlobalFunctions(chunk)
+	if len(m.Events) > 0 && !globals["on_event"] {
+		report.errorf(entryPath, "define a global function on_event(event)",
+			"manifest subscribes to events %v but the entry defines no on_event handler", m.Events)
+	}
+	if len(m.Commands) > 0 && !globals["on_command"] && !globals["on_event"] {
+		report.errorf(entryPath, "define a global function on_command(ctx)",
+			"manifest declares commands but the entry defines neither on_command nor on_event")
+	}
+
+	used, err := luaCapabilityRefs(dir)
+	if err != nil {
+		report.errorf(dir, "", "scan Lua sources: %v", err)
+		return
+	}
+	declared := map[string]bool{}
+	for _, c := range m.RequiredCapabilities() {
+		declared[c] = true
+	}
+	for _, token := range sortedKeys(used) {
+		if !declared[token] {
+			report.errorf(used[token], fmt.Sprintf("add `- capability: %s` under requires:", token),
+				"uses host capability %q but the manifest does not require it; _G[%q] is nil at runtime", token, token)
+		}
+	}
+	for _, token := range m.RequiredCapabilities() {
+		if _, ok := used[token]; !ok {
+			report.warnf(filepath.Join(dir, manifestFileName), "drop it from requires: if the plugin no longer needs it",
+				"requires capability %q but no Lua source references _G[%q]", token, token)
+		}
+	}
+}
+
+// luaGlobalFunctions returns the names of global functions a chunk defines
+// at top level, via either `function name()` or `name = function()`.
+func luaGlobalFunctions(chunk []ast.Stmt) map[string]bool {
+	out := map[string]bool{}
+	for _, stmt := range chunk {
+		switch s := stmt.(type) {
+		case *ast.FuncDefStmt:
+			if id, ok := s.Name.Func.(*ast.IdentExpr); ok && s.Name.Receiver == nil {
+				out[id.Value] = true
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range s.Lhs {
+				id, ok := lhs.(*ast.IdentExpr)
+				if !ok || i >= len(s.Rhs) {
+					continue
+				}
+				if _, isFn := s.Rhs[i].(*ast.FunctionExpr); isFn {
+					out[id.Value] = true
+				}
 			}
-			_, err = fmt.Fprintln(cmd.OutOrStdout(), "OK")
+		}
+	}
+	return out
+}
+
+// luaCapabilityRefPattern matches the ways Lua source reaches a host
+// capability global: _G["world.query"], _G['kv'] and _G.kv.
+var luaCapabilityRefPattern = regexp.MustCompile(`_G\s*(?:\[\s*["']([^"']+)["']\s*\]|\.([A-Za-z_][A-Za-z0-9_]*))`)
+
+// luaCapabilityRefs maps each host-capability token referenced by a .lua
+// file under dir to the first "file:line" that references it. Globals that
+// are not capability tokens are ignored.
+func luaCapabilityRefs(dir string) (map[string]string, error) {
+	used := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
 			return err
-		},
+		}
+		if d.IsDir() || filepath.Ext(path) != ".lua" {
+			return nil
+		}
+		src, err := os.ReadFile(path) //nolint:gosec // G304: walking the author-supplied plugin dir
+		if err != nil {
+			return err
+		}
+		for i, line := range strings.Split(string(src), "\n") {
+			line, _, _ = strings.Cut(line, "--") // skip comments
+			for _, match := range luaCapabilityRefPattern.FindAllStringSubmatch(line, -1) {
+				token := match[1] + match[2]
+				if _, ok := plugins.CapabilityServiceNames[token]; !ok {
+					continue
+				}
+				if _, seen := used[token]; !seen {
+					used[token] = fmt.Sprintf("%s:%d", path, i+1)
+				}
+			}
+		}
+		return nil
+	})
+	return used, err
+}
+
+// validateBinaryPlugin checks the declared executable exists and can run.
+func validateBinaryPlugin(report *validateReport, dir string, m *plugins.Manifest) {
+	if m.BinaryPlugin == nil {
+		return // ParseManifest already rejects this
+	}
+	exe := filepath.Join(dir, m.BinaryPlugin.Executable)
+	info, err := os.Stat(exe)
+	switch {
+	case err != nil:
+		report.errorf(exe, "build the plugin binary before deploying; binary-plugin.executable is relative to the plugin directory",
+			"cannot stat executable: %v", err)
+	case !info.Mode().IsRegular():
+		report.errorf(exe, "", "executable is not a regular file")
+	case info.Mode().Perm()&0o111 == 0:
+		report.errorf(exe, "chmod +x the plugin binary", "executable is not marked executable (mode %s)", info.Mode().Perm())
+	}
+}
+
+// validateSettingPlugin checks the setting's content and world dirs exist.
+func validateSettingPlugin(report *validateReport, dir string, m *plugins.Manifest) {
+	if m.Setting == nil {
+		return // ParseManifest already rejects this
+	}
+	for _, d := range []struct{ field, rel string }{
+		{"content_dir", m.Setting.ContentDir},
+		{"world_dir", m.Setting.WorldDir},
+	} {
+		if d.rel == "" {
+			continue
+		}
+		p := filepath.Join(dir, d.rel)
+		if info, err := os.Stat(p); err != nil || !info.IsDir() {
+			report.errorf(p, fmt.Sprintf("setting.%s is relative to the plugin directory", d.field),
+				"setting.%s does not name a directory", d.field)
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
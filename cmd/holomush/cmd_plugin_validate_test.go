@@ -120,3 +120,167 @@ crypto:
 		})
 	}
 }
+
+func TestPluginValidateDirectoryScenarios(t *testing.T) {
+	const luaManifest = `
+name: dir-plugin
+version: 1.0.0
+type: lua
+lua-plugin: { entry: main.lua }
+events: [say]
+requires:
+  - capability: world.query
+`
+	tests := []struct {
+		name            string
+		files           map[string]string
+		executable      bool // for binary plugins: chmod +x the executable
+		wantSuccess     bool
+		wantOutContains []string
+	}{
+		{
+			name: "accepts a Lua plugin whose handlers and capabilities line up",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+				"main.lua": `local world_query = _G["world.query"]
+function on_event(event)
+  world_query.QueryLocation({location_id = event.stream})
+end
+`,
+			},
+			wantSuccess:     true,
+			wantOutContains: []string{"OK"},
+		},
+		{
+			name: "rejects a missing Lua entry file",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+			},
+			wantOutContains: []string{"cannot read Lua entry", "main.lua"},
+		},
+		{
+			name: "rejects a Lua syntax error",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+				"main.lua":    "function on_event(event\n",
+			},
+			wantOutContains: []string{"Lua syntax error"},
+		},
+		{
+			name: "rejects event subscriptions without an on_event handler",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+				"main.lua":    "local world_query = _G[\"world.query\"]\nlocal function on_event(event) end\n",
+			},
+			wantOutContains: []string{"defines no on_event handler", "hint: define a global function on_event"},
+		},
+		{
+			name: "rejects a capability used in source but not required",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+				"main.lua":    "local world_query = _G[\"world.query\"]\n",
+				"lib/store.lua": `-- _G["property"] in a comment does not count
+local kv = _G.kv
+return kv
+`,
+				"handlers.lua": "on_event = function(event) end\n",
+			},
+			wantOutContains: []string{
+				`uses host capability "kv" but the manifest does not require it`,
+				"store.lua:2",
+				"hint: add `- capability: kv` under requires:",
+			},
+		},
+		{
+			name: "warns on a required capability the source never uses",
+			files: map[string]string{
+				"plugin.yaml": luaManifest,
+				"main.lua":    "function on_event(event) end\n",
+			},
+			wantSuccess: true,
+			wantOutContains: []string{
+				`warning: `,
+				`requires capability "world.query" but no Lua source references`,
+				"OK",
+			},
+		},
+		{
+			name: "rejects a binary plugin whose executable is not executable",
+			files: map[string]string{
+				"plugin.yaml": `
+name: bin-plugin
+version: 1.0.0
+type: binary
+binary-plugin: { executable: bin-plugin }
+`,
+				"bin-plugin": "#!/bin/sh\n",
+			},
+			wantOutContains: []string{"not marked executable", "hint: chmod +x"},
+		},
+		{
+			name: "accepts a binary plugin with an executable",
+			files: map[string]string{
+				"plugin.yaml": `
+name: bin-plugin
+version: 1.0.0
+type: binary
+binary-plugin: { executable: bin-plugin }
+`,
+				"bin-plugin": "#!/bin/sh\n",
+			},
+			executable:      true,
+			wantSuccess:     true,
+			wantOutContains: []string{"OK"},
+		},
+		{
+			name: "rejects a setting whose content dir is missing",
+			files: map[string]string{
+				"plugin.yaml": `
+name: a-setting
+version: 1.0.0
+type: setting
+setting:
+  display_name: A Setting
+  description: ""
+  content_dir: content
+  world_dir: ""
+  theme: ""
+  starting_location: The Void
+`,
+			},
+			wantOutContains: []string{"setting.content_dir does not name a directory"},
+		},
+		{
+			name: "reports schema violations alongside other findings",
+			files: map[string]string{
+				"plugin.yaml": luaManifest + "load_priority: 5\n",
+			},
+			wantOutContains: []string{"schema:", "load_priority", "cannot read Lua entry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			if tt.executable {
+				require.NoError(t, os.Chmod(filepath.Join(dir, "bin-plugin"), 0o700)) //nolint:gosec // G302: test binary must be executable
+			}
+
+			out, code := runCmd(t, []string{"plugin", "validate", dir})
+			if tt.wantSuccess {
+				require.Equal(t, 0, code, "expected validate to exit 0; output:\n%s", out)
+			} else {
+				require.NotEqual(t, 0, code, "expected nonzero exit code; output:\n%s", out)
+				assert.Contains(t, out, "error: ")
+			}
+			for _, s := range tt.wantOutContains {
+				assert.Contains(t, out, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	"github.com/holomush/holomush/internal/access/policy/policycheck"
+	policystore "github.com/holomush/holomush/internal/access/policy/store"
+)
+
+// Policy bases `policy test` can evaluate a staged bundle on top of.
+const (
+	policyBaseLive = "live"
+	policyBaseSeed = "seed"
+	policyBaseNone = "none"
+)
+
+// NewPolicyCmd is the `holomush policy` parent command.
+func NewPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Access policy operator commands",
+	}
+	cmd.AddCommand(newPolicyTestCmd())
+	return cmd
+}
+
+// newPolicyTestCmd is `holomush policy test <suite.yaml>`.
+func newPolicyTestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test <suite.yaml>",
+		Short: "Run a policy test suite against the live or a staged policy set",
+		Long: `Run a table of access requests through the policy engine and check each
+produces the expected effect (allow, deny, forbid or default_deny).
+
+The suite declares the attributes of every entity the cases name; nothing is
+read from the world. Policies come from --base (the live enabled policies in
+DATABASE_URL, the shipped seed policies, or none), with the policies in
+--policies replacing base policies of the same name. Failed cases print the
+policies that applied and whether their conditions held; --explain prints
+that for every case.
+
+Exits non-zero when any case fails.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPolicyTest(cmd, args[0])
+		},
+	}
+	cmd.Flags().String("base", policyBaseLive, "policies the staged bundle applies on top of: live, seed or none")
+	cmd.Flags().String("policies", "", "staged policy bundle (YAML list of name/dsl)")
+	cmd.Flags().Bool("explain", false, "explain every decision, not only failures")
+	return cmd
+}
+
+func runPolicyTest(cmd *cobra.Command, suitePath string) error {
+	base, _ := cmd.Flags().GetString("base")           //nolint:errcheck // flag defined above
+	bundlePath, _ := cmd.Flags().GetString("policies") //nolint:errcheck // flag defined above
+	explain, _ := cmd.Flags().GetBool("explain")       //nolint:errcheck // flag defined above
+
+	suite, err := policycheck.LoadSuite(suitePath)
+	if err != nil {
+		return err
+	}
+
+	policies, err := loadPolicyBase(cmd.Context(), base)
+	if err != nil {
+		return err
+	}
+	if bundlePath != "" {
+		staged, err := policycheck.LoadBundle(bundlePath)
+		if err != nil {
+			return err
+		}
+		policies = policycheck.Overlay(policies, staged)
+	}
+
+	report, err := policycheck.Run(cmd.Context(), suite, policies)
+	if err != nil {
+		return err
+	}
+	if err := report.Write(cmd.OutOrStdout(), explain); err != nil {
+		return err
+	}
+	if n := report.Failed(); n > 0 {
+		return fmt.Errorf("policy test failed: %d of %d case(s) failed", n, len(report.Results))
+	}
+	return nil
+}
+
+// loadPolicyBase returns the policy set named by --base.
+func loadPolicyBase(ctx context.Context, base string) ([]*policystore.StoredPolicy, error) {
+	switch base {
+	case policyBaseNone:
+		return nil, nil
+	case policyBaseSeed:
+		return policycheck.SeedBundle(), nil
+	case policyBaseLive:
+		url, err := getDatabaseURL()
+		if err != nil {
+			return nil, err
+		}
+		pool, err := pgxpool.New(ctx, url)
+		if err != nil {
+			return nil, oops.Code("POLICY_TEST_POOL_FAILED").Wrap(err)
+		}
+		defer pool.Close()
+		policies, err := policystore.NewPostgresStore(pool).ListEnabled(ctx)
+		if err != nil {
+			return nil, oops.Code("POLICY_TEST_LIST_FAILED").Wrap(err)
+		}
+		return policies, nil
+	default:
+		return nil, oops.Code("EX_USAGE").
+			Errorf("--base must be %s, %s or %s, got %q", policyBaseLive, policyBaseSeed, policyBaseNone, base)
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyTestSuite = `
+entities:
+  character:01ALICE:
+    character:
+      roles: [builder]
+cases:
+  - name: builders may edit
+    subject: character:01ALICE
+    action: write
+    resource: location:01HALL
+    expect: allow
+`
+
+func writePolicyTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestPolicyTestScenarios(t *testing.T) {
+	suite := writePolicyTestFile(t, "suite.yaml", policyTestSuite)
+	builders := writePolicyTestFile(t, "bundle.yaml", `
+policies:
+  - name: builders-edit
+    dsl: 'permit(principal is character, action in ["write"], resource is location) when { "builder" in principal.character.roles };'
+`)
+
+	tests := []struct {
+		name            string
+		args            []string
+		wantSuccess     bool
+		wantOutContains []string
+	}{
+		{
+			name:            "passes a suite against a staged bundle",
+			args:            []string{"policy", "test", suite, "--base", "none", "--policies", builders},
+			wantSuccess:     true,
+			wantOutContains: []string{"PASS  builders may edit", "1 passed, 0 failed"},
+		},
+		{
+			name:            "explains every decision on request",
+			args:            []string{"policy", "test", suite, "--base", "none", "--policies", builders, "--explain"},
+			wantSuccess:     true,
+			wantOutContains: []string{"- permit builders-edit: conditions met"},
+		},
+		{
+			name:            "fails and explains when no policy allows the case",
+			args:            []string{"policy", "test", suite, "--base", "none"},
+			wantOutContains: []string{"FAIL  builders may edit", "no policy targets this request", "1 of 1 case(s) failed"},
+		},
+		{
+			name:            "rejects an unknown base",
+			args:            []string{"policy", "test", suite, "--base", "staging"},
+			wantOutContains: []string{"--base must be live, seed or none"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, code := runCmd(t, tt.args)
+			if tt.wantSuccess {
+				require.Equal(t, 0, code, "expected policy test to exit 0; output:\n%s", out)
+			} else {
+				require.NotEqual(t, 0, code, "expected nonzero exit code; output:\n%s", out)
+			}
+			for _, s := range tt.wantOutContains {
+				assert.Contains(t, out, s)
+			}
+		})
+	}
+}
@@ -16,15 +16,21 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/holomush/holomush/internal/access"
+	accesspolicy "github.com/holomush/holomush/internal/access/policy"
 	abacsetup "github.com/holomush/holomush/internal/access/setup"
 	"github.com/holomush/holomush/internal/admin/policy"
 	socket "github.com/holomush/holomush/internal/admin/socket"
+	accessaudit "github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/oidc"
 	authsetup "github.com/holomush/holomush/internal/auth/setup"
 	"github.com/holomush/holomush/internal/bootstrap"
 	bootstrapsetup "github.com/holomush/holomush/internal/bootstrap/setup"
@@ -38,19 +44,25 @@ import (
 	"github.com/holomush/holomush/internal/eventbus/audit/chain"
 	"github.com/holomush/holomush/internal/eventbus/crypto/dek"
 	"github.com/holomush/holomush/internal/eventbus/natsconn"
+	"github.com/holomush/holomush/internal/gameclock"
 	holoGRPC "github.com/holomush/holomush/internal/grpc"
+	"github.com/holomush/holomush/internal/health"
+	"github.com/holomush/holomush/internal/httpapi"
 	"github.com/holomush/holomush/internal/idgen"
 	"github.com/holomush/holomush/internal/lifecycle"
 	"github.com/holomush/holomush/internal/logging"
 	"github.com/holomush/holomush/internal/plugin/cryptowiring"
 	pluginsetup "github.com/holomush/holomush/internal/plugin/setup"
+	"github.com/holomush/holomush/internal/presence"
 	"github.com/holomush/holomush/internal/session"
 	sessionsetup "github.com/holomush/holomush/internal/session/setup"
 	"github.com/holomush/holomush/internal/store"
 	"github.com/holomush/holomush/internal/telemetry"
 	tlscerts "github.com/holomush/holomush/internal/tls"
+	"github.com/holomush/holomush/internal/world/invariant"
 	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
 	worldsetup "github.com/holomush/holomush/internal/world/setup"
+	"github.com/holomush/holomush/internal/world/worldcache"
 	"github.com/holomush/holomush/internal/xdg"
 	"github.com/holomush/holomush/pkg/errutil"
 	pluginv1 "github.com/holomush/holomush/pkg/proto/holomush/plugin/v1"
@@ -75,6 +87,20 @@ type coreConfig struct {
 	AutoGenKEK            bool          `koanf:"auto_gen_kek"`
 	LuaTimeout            time.Duration `koanf:"lua_timeout"`
 	LuaRegistryMaxSize    int           `koanf:"lua_registry_max_size"`
+	WorldCacheSize        int           `koanf:"world_cache_size"`
+	WorldCacheTTL         time.Duration `koanf:"world_cache_ttl"`
+	WorldReplicaMaxLag    time.Duration `koanf:"world_replica_max_lag"`
+	ObjectMaxNesting      int           `koanf:"object_max_nesting_depth"`
+	WorldInvariantSample  float64       `koanf:"world_invariant_sample_rate"`
+	AuditMode             string        `koanf:"audit_mode"`
+	PolicyReadFailMode    string        `koanf:"policy_read_fail_mode"`
+	CommandRateBurst      int           `koanf:"command_rate_burst"`
+	CommandRateSustained  float64       `koanf:"command_rate_sustained"`
+	AFKAfter              time.Duration `koanf:"afk_after"`
+
+	// Database tunes the connection pool. It is read from the top-level
+	// "database" section rather than "core".
+	Database store.PoolConfig `koanf:"-"`
 }
 
 // Validate checks that the configuration is valid.
@@ -94,7 +120,87 @@ func (cfg *coreConfig) Validate() error {
 	if cfg.LuaRegistryMaxSize <= 0 {
 		return oops.Code("CONFIG_INVALID").Errorf("plugin-lua-registry-max must be positive, got %d", cfg.LuaRegistryMaxSize)
 	}
-	return nil
+	if cfg.WorldCacheSize < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("world-cache-size must not be negative, got %d", cfg.WorldCacheSize)
+	}
+	if cfg.WorldCacheSize > 0 && cfg.WorldCacheTTL <= 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("world-cache-ttl must be positive when the world cache is enabled, got %s", cfg.WorldCacheTTL)
+	}
+	if cfg.WorldReplicaMaxLag < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("world-replica-max-lag must not be negative, got %s", cfg.WorldReplicaMaxLag)
+	}
+	if cfg.ObjectMaxNesting < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("object-max-nesting-depth must not be negative, got %d", cfg.ObjectMaxNesting)
+	}
+	if cfg.WorldInvariantSample < 0 || cfg.WorldInvariantSample > 1 {
+		return oops.Code("CONFIG_INVALID").Errorf("world-invariant-sample-rate must be between 0 and 1, got %g", cfg.WorldInvariantSample)
+	}
+	if _, err := accessaudit.ParseMode(cfg.AuditMode); cfg.AuditMode != "" && err != nil {
+		return oops.Code("CONFIG_INVALID").Errorf("audit-mode must be 'minimal', 'denials_only', or 'all', got %q", cfg.AuditMode)
+	}
+	if _, err := accesspolicy.ParseFailMode(cfg.PolicyReadFailMode); err != nil {
+		return oops.Code("CONFIG_INVALID").Errorf("policy-read-fail-mode must be 'closed' or 'open', got %q", cfg.PolicyReadFailMode)
+	}
+	if cfg.CommandRateBurst < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("command-rate-burst must not be negative, got %d", cfg.CommandRateBurst)
+	}
+	if cfg.CommandRateBurst > 0 && cfg.CommandRateSustained <= 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("command-rate-sustained must be positive when rate limiting is enabled, got %g", cfg.CommandRateSustained)
+	}
+	if cfg.AFKAfter < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("afk-after must not be negative, got %s", cfg.AFKAfter)
+	}
+	return cfg.Database.Validate()
+}
+
+// auditMode returns the ABAC audit mode; empty means denials_only.
+func (cfg *coreConfig) auditMode() accessaudit.Mode {
+	mode, err := accessaudit.ParseMode(cfg.AuditMode)
+	if err != nil {
+		return accessaudit.ModeDenialsOnly
+	}
+	return mode
+}
+
+// readFailMode returns how ABAC answers reads during a policy store outage;
+// empty means closed.
+func (cfg *coreConfig) readFailMode() accesspolicy.FailMode {
+	mode, err := accesspolicy.ParseFailMode(cfg.PolicyReadFailMode)
+	if err != nil {
+		return accesspolicy.FailClosed
+	}
+	return mode
+}
+
+// worldCacheConfig returns the world entity cache configuration, or nil when
+// the cache is disabled.
+func (cfg *coreConfig) worldCacheConfig() *worldcache.Config {
+	if cfg.WorldCacheSize <= 0 {
+		return nil
+	}
+	return &worldcache.Config{MaxEntries: cfg.WorldCacheSize, TTL: cfg.WorldCacheTTL}
+}
+
+// worldInvariantConfig returns the world repository invariant checking
+// configuration, or nil when no writes are sampled.
+func (cfg *coreConfig) worldInvariantConfig() *invariant.Config {
+	if cfg.WorldInvariantSample <= 0 {
+		return nil
+	}
+	return &invariant.Config{SampleRate: cfg.WorldInvariantSample}
+}
+
+// worldReplicaConfig returns the world read-replica configuration for the
+// replica at url, or nil when no replica is configured.
+func (cfg *coreConfig) worldReplicaConfig(url string) *worldsetup.ReplicaConfig {
+	if url == "" {
+		return nil
+	}
+	return &worldsetup.ReplicaConfig{
+		URL:     url,
+		Routing: worldpostgres.ReplicaConfig{MaxLag: cfg.WorldReplicaMaxLag},
+		Pool:    cfg.Database,
+	}
 }
 
 // Default values for core command flags.
@@ -105,6 +211,11 @@ const (
 	defaultLogFormat            = "json"
 	defaultPluginLuaTimeout     = 1 * time.Second
 	defaultPluginLuaRegistryMax = 65536
+	defaultWorldCacheTTL        = worldcache.DefaultTTL
+	defaultWorldReplicaMaxLag   = worldpostgres.DefaultReplicaMaxLag
+	defaultAuditMode            = string(accessaudit.ModeDenialsOnly)
+	defaultPolicyReadFailMode   = string(accesspolicy.FailClosed)
+	defaultCommandRateSustained = command.DefaultSustainedRate
 )
 
 // NewCoreCmd creates the core subcommand.
@@ -117,19 +228,27 @@ func NewCoreCmd() *cobra.Command {
 		Long: `Start the core process which runs the game engine,
 manages plugins, and handles game state.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			if err := config.Load(configFile, cmd, cfg, "core"); err != nil {
+			loader, err := config.NewLoader(configFile, cmd)
+			if err != nil {
+				return err
+			}
+			if err := loader.Unmarshal("core", cfg); err != nil {
+				return err
+			}
+			cfg.Database = store.DefaultPoolConfig()
+			if err := loader.Unmarshal("database", &cfg.Database); err != nil {
 				return err
 			}
 			var gameConfig config.GameConfig
-			if err := config.Load(configFile, cmd, &gameConfig, "game"); err != nil {
+			if err := loader.Unmarshal("game", &gameConfig); err != nil {
 				return err
 			}
 			authConfig := config.DefaultAuthConfig()
-			if err := config.Load(configFile, cmd, &authConfig, "auth"); err != nil {
+			if err := loader.Unmarshal("auth", &authConfig); err != nil {
 				return err
 			}
 			eventBusConfig := eventbus.Config{}
-			if err := config.Load(configFile, cmd, &eventBusConfig, "event_bus"); err != nil {
+			if err := loader.Unmarshal("event_bus", &eventBusConfig); err != nil {
 				return err
 			}
 			// Validate BEFORE Defaults() — Validate is documented as
@@ -145,15 +264,18 @@ manages plugins, and handles game state.`,
 				return err
 			}
 			cryptoConfig := config.DefaultCryptoConfig()
-			if err := config.Load(configFile, cmd, &cryptoConfig, "crypto"); err != nil {
+			if err := loader.Unmarshal("crypto", &cryptoConfig); err != nil {
 				return err
 			}
 			logConfig := config.DefaultLoggingConfig()
-			if err := config.Load(configFile, cmd, &logConfig, "logging"); err != nil {
+			if err := loader.Unmarshal("logging", &logConfig); err != nil {
 				return err
 			}
 			applyLogSinkFlags(cmd, &logConfig)
-			return runCoreWithDeps(cmd.Context(), cfg, gameConfig, authConfig, eventBusConfig, cryptoConfig, logConfig, cmd, nil)
+			return runCoreWithDeps(cmd.Context(), cfg, gameConfig, authConfig, eventBusConfig, cryptoConfig, logConfig, cmd, &CoreDeps{
+				ConfigLoader:      loader,
+				AutoMigrateGetter: autoMigrateFlag(cmd),
+			})
 		},
 	}
 
@@ -175,11 +297,34 @@ manages plugins, and handles game state.`,
 		"generate a KEK file if absent on first boot (passphrase still required)")
 	cmd.Flags().DurationVar(&cfg.LuaTimeout, "plugin-lua-timeout", defaultPluginLuaTimeout, "per-invocation CPU deadline for Lua plugins")
 	cmd.Flags().IntVar(&cfg.LuaRegistryMaxSize, "plugin-lua-registry-max", defaultPluginLuaRegistryMax, "max Lua registry size per plugin state")
+	cmd.Flags().IntVar(&cfg.WorldCacheSize, "world-cache-size", 0, "max cached world entities for look/movement reads (0 = disabled)")
+	cmd.Flags().DurationVar(&cfg.WorldCacheTTL, "world-cache-ttl", defaultWorldCacheTTL, "how long a cached world entity is served before re-reading it")
+	cmd.Flags().DurationVar(&cfg.WorldReplicaMaxLag, "world-replica-max-lag", defaultWorldReplicaMaxLag, "max replay lag before world reads leave the DATABASE_REPLICA_URL replica")
+	cmd.Flags().IntVar(&cfg.ObjectMaxNesting, "object-max-nesting-depth", worldpostgres.DefaultMaxNestingDepth, "how deeply objects may nest inside containers (0 = default)")
+	cmd.Flags().Float64Var(&cfg.WorldInvariantSample, "world-invariant-sample-rate", invariant.DefaultSampleRate, "fraction of world writes re-read and checked against world invariants (0 = off)")
+	cmd.Flags().StringVar(&cfg.AuditMode, "audit-mode", defaultAuditMode, "ABAC decision audit mode (minimal, denials_only, or all)")
+	cmd.Flags().StringVar(&cfg.PolicyReadFailMode, "policy-read-fail-mode", defaultPolicyReadFailMode, "how ABAC answers reads while the policy store is unavailable (closed, or open to allow and audit them; writes always fail closed)")
+	cmd.Flags().IntVar(&cfg.CommandRateBurst, "command-rate-burst", 0, "commands a session may send in a burst before throttling (0 = rate limiting disabled)")
+	cmd.Flags().Float64Var(&cfg.CommandRateSustained, "command-rate-sustained", defaultCommandRateSustained, "sustained commands per second per session once the burst is spent")
+	cmd.Flags().DurationVar(&cfg.AFKAfter, "afk-after", presence.DefaultAFKAfter, "idle time before a character is marked AFK (0 = default)")
+	cmd.Flags().Bool("auto-migrate", true,
+		"run pending database migrations at startup; when off, an outdated schema refuses to boot (default: HOLOMUSH_DB_AUTO_MIGRATE)")
 	registerLogSinkFlags(cmd)
 
 	return cmd
 }
 
+// autoMigrateFlag returns a getter for an explicit --auto-migrate, which
+// wins over HOLOMUSH_DB_AUTO_MIGRATE, or nil when the flag was not given so
+// CoreDeps falls back to parseAutoMigrate.
+func autoMigrateFlag(cmd *cobra.Command) func() bool {
+	if !cmd.Flags().Changed("auto-migrate") {
+		return nil
+	}
+	enabled, _ := cmd.Flags().GetBool("auto-migrate") //nolint:errcheck // flag type is known/registered
+	return func() bool { return enabled }
+}
+
 // registerLogSinkFlags registers the six per-sink logging flags shared by the
 // core and gateway commands. The flags are bound into config.LoggingConfig via
 // the "logging" config section in each command's RunE.
@@ -288,6 +433,7 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 	// gameIDProvider below instead of a hand-sequenced pre-start (07-09).
 	dbSub := store.NewSubsystem(store.SubsystemConfig{
 		DatabaseURL: databaseURL,
+		Pool:        cfg.Database,
 	})
 
 	// gameIDProvider is THE single gameID resolution + override site
@@ -342,6 +488,10 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 	if err != nil {
 		return err
 	}
+	gameClock, err := newGameClock(gameConfig.Clock)
+	if err != nil {
+		return err
+	}
 
 	// --- 6. ReadinessRegistry + observability ---
 	registry := lifecycle.NewReadinessRegistry()
@@ -350,10 +500,23 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		return startupComplete.Load() && registry.AllReady()
 	}
 
+	// healthPool is published once the database subsystem has started so
+	// /readyz can ping it and read the schema version.
+	healthPool := &atomic.Pointer[pgxpool.Pool]{}
+
+	// adminAPI is bound by the gRPC subsystem once its readers exist.
+	adminAPI := &adminAPIRoute{}
+
 	var obsServer ObservabilityServer
 	if cfg.MetricsAddr != "" {
 		obsServer = deps.ObservabilityServerFactory(cfg.MetricsAddr, obsReadiness)
 		obsServer.MustRegister(command.CommandExecutions, command.CommandDuration, command.AliasExpansions)
+		healthSuite, healthErr := newCoreHealthSuite(registry, startupComplete, healthPool)
+		if healthErr != nil {
+			return healthErr
+		}
+		health.Register(obsServer, healthSuite)
+		obsServer.Handle(httpapi.PathPrefix, adminAPI)
 		obsErrChan, obsErr := obsServer.Start()
 		if obsErr != nil {
 			return oops.Code("OBSERVABILITY_START_FAILED").With("addr", cfg.MetricsAddr).Wrap(obsErr)
@@ -397,17 +560,29 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		DB:              dbSub,
 		Registry:        registry,
 		CryptoOperators: cryptoConfig.Operators,
+		Impersonators:   authConfig.Impersonators,
+		AuditMode:       cfg.auditMode(),
+		ReadFailMode:    cfg.readFailMode(),
 	})
 
 	authSub := authsetup.NewAuthSubsystem(authsetup.AuthSubsystemConfig{
 		DB:                   dbSub,
 		MaxSessionsPerPlayer: authConfig.MaxPlayerSessionsPerPlayer,
+		OIDCProviders:        oidcProviders(authConfig.OIDC),
+		OIDCPolicy:           auth.OIDCPolicy{RequirePasswordFallback: authConfig.OIDC.RequirePasswordFallback},
+		PlayerDataPolicy:     auth.PlayerDataPolicy{TombstoneName: authConfig.AccountDeletion.TombstoneName},
+		Audit:                authAuditBridge{abac: abacSub},
+		Grants:               authGrantBridge{abac: abacSub},
 	})
 
 	worldSub := worldsetup.NewWorldSubsystem(worldsetup.WorldSubsystemConfig{
-		DB:     dbSub,
-		ABAC:   abacSub,
-		GameID: gameIDProvider,
+		DB:              dbSub,
+		ABAC:            abacSub,
+		GameID:          gameIDProvider,
+		Cache:           cfg.worldCacheConfig(),
+		Replica:         cfg.worldReplicaConfig(deps.DatabaseReplicaURLGetter()),
+		MaxNestingDepth: cfg.ObjectMaxNesting,
+		Invariants:      cfg.worldInvariantConfig(),
 	})
 
 	sessionSub := sessionsetup.NewSessionSubsystem(sessionsetup.SessionSubsystemConfig{
@@ -436,6 +611,9 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		LuaTimeout:         cfg.LuaTimeout,
 		LuaRegistryMaxSize: cfg.LuaRegistryMaxSize,
 		VerbRegistry:       verbRegistry,
+		Currencies:         gameConfig.Currencies,
+		PageFallback:       gameConfig.PageOfflineFallback,
+		Clock:              gameClock,
 	})
 
 	bootstrapSub := bootstrapsetup.NewBootstrapSubsystem(bootstrapsetup.BootstrapSubsystemConfig{
@@ -517,6 +695,7 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 	// scraped — the same "silently unscraped" defect the cluster_*/invalidation_*
 	// metrics above avoid by routing to obsServer.Registerer().
 	audit.RegisterMetrics(metricsReg)
+	eventbus.RegisterMetrics(metricsReg)
 	clusterPillMetrics := cluster.NewPillMetrics(metricsReg)
 	clusterSkewMetrics := cluster.NewSkewMetrics(metricsReg)
 	clusterSelfTimeoutMetrics := cluster.NewSelfTimeoutMetrics(metricsReg)
@@ -571,6 +750,14 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		// Config.Validate() at Start before the projection accepts traffic.
 		RetainWindow:  eventBusConfig.Audit.RetainWindow,
 		PurgeInterval: eventBusConfig.Audit.PurgeInterval,
+		// Movement-churn compaction (opt-in). Zero values defer to the
+		// audit subsystem's CompactionConfig defaults.
+		Compaction: audit.CompactionConfig{
+			Enabled:    eventBusConfig.Audit.Compaction.Enabled,
+			Types:      eventBusConfig.Audit.Compaction.Types,
+			KeepRecent: eventBusConfig.Audit.Compaction.KeepRecent,
+			MinRun:     eventBusConfig.Audit.Compaction.MinRun,
+		},
 	})
 
 	// Phase 7 INV-CRYPTO-45: build the codec.KeySelector ONCE at boot. The
@@ -672,6 +859,18 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		return ownerMap, pcm
 	})
 
+	// Command rate limiting is opt-in: the limiter's bypass check costs an
+	// ABAC evaluation per command. A limiter built here can be retuned by
+	// config reload; turning it on from 0 needs a restart.
+	var rateLimiter *command.RateLimiter
+	if cfg.CommandRateBurst > 0 {
+		rateLimiter = command.NewRateLimiter(command.RateLimiterConfig{
+			BurstCapacity: cfg.CommandRateBurst,
+			SustainedRate: cfg.CommandRateSustained,
+		})
+		defer rateLimiter.Close()
+	}
+
 	grpcSub := newGRPCSubsystem(grpcSubsystemConfig{
 		DB:             dbSub,
 		ABAC:           abacSub,
@@ -696,6 +895,9 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		// passes into PluginConsumerManager. history.NewReader gets it
 		// via newHistoryReader's WithCodecSelector branch.
 		KeySelector: pluginCodecKeySelector,
+		RateLimiter: rateLimiter,
+		AFKAfter:    cfg.AFKAfter,
+		AdminAPI:    adminAPI,
 	})
 
 	// --- Crypto subsystems (T22 / holomush-jxo8.6.21; generalized holomush-jxo8.7.8) ---
@@ -865,6 +1067,7 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		stopCoordinatorOnBootFailure(ctx, coordHolderPtr)
 		return orchErr
 	}
+	healthPool.Store(dbSub.Pool())
 	defer func() {
 		// The timeout MUST be constructed inside this closure, not at the
 		// defer site: Go evaluates deferred call arguments at registration
@@ -920,7 +1123,20 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 	go monitorServerErrors(ctx, cancel, controlErrChan, "control-grpc")
 	slog.InfoContext(ctx, "control gRPC server started", "addr", cfg.ControlAddr)
 
-	// --- 11. Signal handling ---
+	// --- 11. Config reload ---
+	if deps.ConfigLoader != nil {
+		subscribeCoreReload(deps.ConfigLoader, cfg, coreReloadTargets{
+			ABAC:        abacSub,
+			Plugins:     pluginSub,
+			Audit:       auditSub,
+			RateLimiter: rateLimiter,
+		})
+		reloadCtx, stopReload := context.WithCancel(ctx)
+		defer stopReload()
+		watchConfig(reloadCtx, deps.ConfigLoader)
+	}
+
+	// --- 12. Signal handling ---
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	defer signal.Stop(sigChan)
@@ -944,7 +1160,7 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 		slog.InfoContext(ctx, "context cancelled, shutting down")
 	}
 
-	// --- 12. Graceful shutdown ---
+	// --- 13. Graceful shutdown ---
 	slog.InfoContext(ctx, "shutting down...")
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -961,6 +1177,52 @@ func runCoreWithDeps(ctx context.Context, cfg *coreConfig, gameConfig config.Gam
 	return nil
 }
 
+// oidcProviders converts the auth.oidc YAML section into verifier provider
+// configs. Nil when no providers are configured, which leaves OIDC disabled.
+func oidcProviders(cfg config.OIDCConfig) []oidc.ProviderConfig {
+	if len(cfg.Providers) == 0 {
+		return nil
+	}
+	providers := make([]oidc.ProviderConfig, 0, len(cfg.Providers))
+	for _, p := range cfg.Providers {
+		providers = append(providers, oidc.ProviderConfig{
+			Name:     p.Name,
+			Issuer:   p.Issuer,
+			ClientID: p.ClientID,
+			JWKSURL:  p.JWKSURL,
+		})
+	}
+	return providers
+}
+
+// newGameClock builds the game clock from the game.clock YAML section.
+// Returns CONFIG_INVALID for a malformed epoch and GAMECLOCK_INVALID for a
+// calendar the clock cannot use.
+func newGameClock(cfg config.GameClockConfig) (*gameclock.Clock, error) {
+	clockCfg := gameclock.Config{
+		StartYear: cfg.StartYear,
+		Ratio:     cfg.Ratio,
+		DawnHour:  cfg.DawnHour,
+		DuskHour:  cfg.DuskHour,
+		DawnText:  cfg.DawnText,
+		DuskText:  cfg.DuskText,
+	}
+	if cfg.Epoch != "" {
+		epoch, err := time.Parse(time.RFC3339, cfg.Epoch)
+		if err != nil {
+			return nil, oops.Code("CONFIG_INVALID").With("field", "game.clock.epoch").Wrap(err)
+		}
+		clockCfg.Epoch = epoch
+	}
+	for _, m := range cfg.Months {
+		clockCfg.Months = append(clockCfg.Months, gameclock.Month{Name: m.Name, Days: m.Days})
+	}
+	for _, season := range cfg.Seasons {
+		clockCfg.Seasons = append(clockCfg.Seasons, gameclock.Season{Name: season.Name, StartMonth: season.StartMonth})
+	}
+	return gameclock.New(clockCfg)
+}
+
 // parseSessionConfig parses and validates session TTL, reaper interval, lease TTL,
 // and boot grace from cfg, applying defaults when values are empty. Returns an error
 // if parsing fails or any duration is not positive.
@@ -1072,6 +1334,32 @@ func (b *sessionBridge) SessionStore() session.Access {
 	return b.sub.Store()
 }
 
+// authAuditBridge adapts the ABAC subsystem's audit logger to
+// authsetup.AuditProvider, so authentication events share the access audit
+// log.
+type authAuditBridge struct {
+	abac *abacsetup.ABACSubsystem
+}
+
+func (b authAuditBridge) Auditor() auth.Auditor {
+	logger := b.abac.AuditLogger()
+	if logger == nil {
+		return nil
+	}
+	return logger
+}
+
+// authGrantBridge adapts the ABAC subsystem's attribute resolver to
+// authsetup.GrantProvider, so impersonation checks read the same player
+// grants as every other capability check.
+type authGrantBridge struct {
+	abac *abacsetup.ABACSubsystem
+}
+
+func (b authGrantBridge) GrantResolver() access.SubjectResolver {
+	return b.abac.Resolver()
+}
+
 // adminDepsBridge adapts auth subsystem + database subsystem to pluginsetup.AdminDepsProvider.
 type adminDepsBridge struct {
 	auth *authsetup.AuthSubsystem
@@ -1085,7 +1373,11 @@ func (b *adminDepsBridge) AdminDeps() handlers.AdminDeps {
 		Hasher:         b.auth.Hasher(),
 		PlayerSessions: b.auth.PlayerSessionStore(),
 		ResetRepo:      b.auth.ResetRepo(),
+		SecurityLog:    b.auth.SecurityLog(),
+		SecurityEvents: b.auth.AuthService(),
+		Bans:           b.auth.Bans(),
 		CharLister:     bootstrapsetup.NewCharRepoAdapter(pool, worldpostgres.NewCharacterRepository(pool)),
+		ErrorVerbosity: store.NewPostgresSessionStore(pool),
 	}
 }
 
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	abacsetup "github.com/holomush/holomush/internal/access/setup"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventbus/audit"
+	pluginsetup "github.com/holomush/holomush/internal/plugin/setup"
+)
+
+// coreReloadTargets are the running core subsystems that take new settings
+// from a config reload. RateLimiter is nil when command rate limiting was
+// disabled at boot.
+type coreReloadTargets struct {
+	ABAC        *abacsetup.ABACSubsystem
+	Plugins     *pluginsetup.PluginSubsystem
+	Audit       *audit.Subsystem
+	RateLimiter *command.RateLimiter
+}
+
+// subscribeCoreReload registers the core's reloadable settings with loader:
+// the ABAC audit mode and read fail mode, command rate limits, Lua plugin
+// limits, and the event audit retention window. Everything else in the core section (listen
+// addresses, data dir, cache sizes) still needs a restart. boot is the
+// configuration the core started with.
+func subscribeCoreReload(loader *config.Loader, boot *coreConfig, t coreReloadTargets) {
+	loader.Subscribe("audit-mode", config.ConfigSubscriberFunc(func(ctx context.Context, cfg *config.Loader) error {
+		core, err := reloadCoreConfig(cfg, boot)
+		if err != nil {
+			return err
+		}
+		t.ABAC.SetAuditMode(core.auditMode())
+		slog.InfoContext(ctx, "audit mode applied", "mode", core.auditMode())
+		return nil
+	}))
+
+	loader.Subscribe("policy-fail-mode", config.ConfigSubscriberFunc(func(ctx context.Context, cfg *config.Loader) error {
+		core, err := reloadCoreConfig(cfg, boot)
+		if err != nil {
+			return err
+		}
+		t.ABAC.SetReadFailMode(core.readFailMode())
+		slog.InfoContext(ctx, "policy read fail mode applied", "mode", core.readFailMode())
+		return nil
+	}))
+
+	loader.Subscribe("rate-limit", config.ConfigSubscriberFunc(func(ctx context.Context, cfg *config.Loader) error {
+		core, err := reloadCoreConfig(cfg, boot)
+		if err != nil {
+			return err
+		}
+		switch {
+		case t.RateLimiter == nil && core.CommandRateBurst > 0:
+			slog.WarnContext(ctx, "command rate limiting was disabled at startup; restart to enable it",
+				"command_rate_burst", core.CommandRateBurst)
+		case t.RateLimiter != nil && core.CommandRateBurst == 0:
+			slog.WarnContext(ctx, "command rate limiting was enabled at startup; restart to disable it")
+		case t.RateLimiter != nil:
+			t.RateLimiter.SetLimits(core.CommandRateBurst, core.CommandRateSustained)
+		}
+		return nil
+	}))
+
+	loader.Subscribe("plugin-limits", config.ConfigSubscriberFunc(func(_ context.Context, cfg *config.Loader) error {
+		core, err := reloadCoreConfig(cfg, boot)
+		if err != nil {
+			return err
+		}
+		t.Plugins.SetLuaLimits(core.LuaTimeout, core.LuaRegistryMaxSize)
+		return nil
+	}))
+
+	loader.Subscribe("event-retention", config.ConfigSubscriberFunc(func(_ context.Context, cfg *config.Loader) error {
+		var busCfg eventbus.Config
+		if err := cfg.Unmarshal("event_bus", &busCfg); err != nil {
+			return err
+		}
+		return t.Audit.SetRetainWindow(busCfg.Audit.RetainWindow)
+	}))
+}
+
+// reloadCoreConfig decodes the core section over a copy of boot and
+// validates the result, so a bad edit is rejected before any subsystem sees
+// it. Starting from boot keeps flag defaults whose names differ from their
+// config keys (plugin-lua-timeout sets lua_timeout); a key deleted from the
+// file therefore keeps its current value rather than reverting.
+func reloadCoreConfig(loader *config.Loader, boot *coreConfig) (*coreConfig, error) {
+	cfg := *boot
+	if err := loader.Unmarshal("core", &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	accessaudit "github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestReloadCoreConfigRejectsInvalidEdit(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte("core:\n  audit_mode: all\n"), 0o600))
+
+	loader, err := config.NewLoader(cfgFile, nil)
+	require.NoError(t, err)
+	boot := &coreConfig{
+		GRPCAddr:           "localhost:9000",
+		ControlAddr:        "127.0.0.1:9001",
+		LogFormat:          "json",
+		LuaTimeout:         1 * time.Second,
+		LuaRegistryMaxSize: 65536,
+	}
+	core, err := reloadCoreConfig(loader, boot)
+	require.NoError(t, err)
+	assert.Equal(t, accessaudit.ModeAll, core.auditMode())
+	assert.Equal(t, 1*time.Second, core.LuaTimeout, "unset keys keep their startup values")
+	assert.Empty(t, boot.AuditMode, "the startup config is not modified")
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte("core:\n  audit_mode: everything\n"), 0o600))
+	require.NoError(t, loader.Reload(context.Background()))
+	_, err = reloadCoreConfig(loader, boot)
+	errutil.AssertErrorCode(t, err, "CONFIG_INVALID")
+}
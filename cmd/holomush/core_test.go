@@ -21,7 +21,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	accessaudit "github.com/holomush/holomush/internal/audit"
 	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/internal/world/invariant"
+	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
+	worldsetup "github.com/holomush/holomush/internal/world/setup"
+	"github.com/holomush/holomush/internal/world/worldcache"
 	"github.com/holomush/holomush/pkg/errutil"
 )
 
@@ -786,6 +792,27 @@ func TestParseSessionConfigUsesExplicitValues(t *testing.T) {
 	assert.Equal(t, 250, cfg.SessionMaxHistory)
 }
 
+// TestNewGameClockConvertsConfig verifies that the game.clock section
+// reaches the clock and that bad values are rejected.
+func TestNewGameClockConvertsConfig(t *testing.T) {
+	clock, err := newGameClock(config.GameClockConfig{
+		Epoch:     "2025-06-01T00:00:00Z",
+		StartYear: 40,
+		Ratio:     1,
+		Months:    []config.GameMonthConfig{{Name: "Thaw", Days: 10}, {Name: "Frost", Days: 10}},
+		Seasons:   []config.GameSeasonConfig{{Name: "cold", StartMonth: 2}},
+	})
+	require.NoError(t, err)
+	m := clock.At(time.Date(2025, 6, 12, 7, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2 Frost, year 40", m.Date())
+	assert.Equal(t, "cold", m.Season)
+
+	_, err = newGameClock(config.GameClockConfig{Epoch: "yesterday"})
+	errutil.AssertErrorCode(t, err, "CONFIG_INVALID")
+	_, err = newGameClock(config.GameClockConfig{DawnHour: 13})
+	errutil.AssertErrorCode(t, err, "GAMECLOCK_INVALID")
+}
+
 // TestParseSessionConfigRejectsInvalidTTL verifies that a malformed TTL value
 // returns an error.
 func TestParseSessionConfigRejectsInvalidTTL(t *testing.T) {
@@ -993,3 +1020,97 @@ func TestResolveLogLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestCoreConfig_ValidateRejectsInvalidWorldCache(t *testing.T) {
+	base := coreConfig{
+		GRPCAddr:           "localhost:9000",
+		ControlAddr:        "127.0.0.1:9001",
+		LogFormat:          "json",
+		LuaTimeout:         1 * time.Second,
+		LuaRegistryMaxSize: 65536,
+	}
+
+	cases := []struct {
+		name string
+		mut  func(c *coreConfig)
+	}{
+		{"WorldCacheSize<0", func(c *coreConfig) { c.WorldCacheSize = -1 }},
+		{"WorldCacheTTL=0 with cache enabled", func(c *coreConfig) { c.WorldCacheSize = 100 }},
+		{"WorldReplicaMaxLag<0", func(c *coreConfig) { c.WorldReplicaMaxLag = -time.Second }},
+		{"ObjectMaxNesting<0", func(c *coreConfig) { c.ObjectMaxNesting = -1 }},
+		{"WorldInvariantSample<0", func(c *coreConfig) { c.WorldInvariantSample = -0.1 }},
+		{"WorldInvariantSample>1", func(c *coreConfig) { c.WorldInvariantSample = 1.5 }},
+		{"Database.MinConns>MaxConns", func(c *coreConfig) { c.Database = store.PoolConfig{MaxConns: 2, MinConns: 3} }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := base
+			tc.mut(&cfg)
+			err := cfg.Validate()
+			require.Error(t, err)
+			errutil.AssertErrorCode(t, err, "CONFIG_INVALID")
+		})
+	}
+
+	require.NoError(t, base.Validate(), "a disabled cache needs no TTL")
+}
+
+func TestCoreConfig_ValidateRejectsInvalidReloadableSettings(t *testing.T) {
+	base := coreConfig{
+		GRPCAddr:           "localhost:9000",
+		ControlAddr:        "127.0.0.1:9001",
+		LogFormat:          "json",
+		LuaTimeout:         1 * time.Second,
+		LuaRegistryMaxSize: 65536,
+	}
+
+	cases := []struct {
+		name string
+		mut  func(c *coreConfig)
+	}{
+		{"AuditMode unknown", func(c *coreConfig) { c.AuditMode = "everything" }},
+		{"PolicyReadFailMode unknown", func(c *coreConfig) { c.PolicyReadFailMode = "ajar" }},
+		{"CommandRateBurst<0", func(c *coreConfig) { c.CommandRateBurst = -1 }},
+		{"CommandRateSustained=0 with limiting enabled", func(c *coreConfig) { c.CommandRateBurst = 10 }},
+		{"AFKAfter<0", func(c *coreConfig) { c.AFKAfter = -time.Minute }},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := base
+			tc.mut(&cfg)
+			err := cfg.Validate()
+			require.Error(t, err)
+			errutil.AssertErrorCode(t, err, "CONFIG_INVALID")
+		})
+	}
+
+	require.NoError(t, base.Validate(), "empty audit mode and disabled rate limiting are valid")
+	assert.Equal(t, accessaudit.ModeDenialsOnly, base.auditMode())
+}
+
+func TestCoreConfig_WorldCacheConfig(t *testing.T) {
+	cfg := coreConfig{WorldCacheTTL: time.Minute}
+	assert.Nil(t, cfg.worldCacheConfig(), "size 0 disables the cache")
+
+	cfg.WorldCacheSize = 500
+	assert.Equal(t, &worldcache.Config{MaxEntries: 500, TTL: time.Minute}, cfg.worldCacheConfig())
+}
+
+func TestCoreConfig_WorldInvariantConfig(t *testing.T) {
+	cfg := coreConfig{}
+	assert.Nil(t, cfg.worldInvariantConfig(), "rate 0 checks nothing")
+
+	cfg.WorldInvariantSample = 0.25
+	assert.Equal(t, &invariant.Config{SampleRate: 0.25}, cfg.worldInvariantConfig())
+}
+
+func TestCoreConfig_WorldReplicaConfig(t *testing.T) {
+	cfg := coreConfig{WorldReplicaMaxLag: 2 * time.Second, Database: store.DefaultPoolConfig()}
+	assert.Nil(t, cfg.worldReplicaConfig(""), "no replica URL disables routing")
+
+	assert.Equal(t, &worldsetup.ReplicaConfig{
+		URL:     "postgres://replica/holomush",
+		Routing: worldpostgres.ReplicaConfig{MaxLag: 2 * time.Second},
+		Pool:    store.DefaultPoolConfig(),
+	}, cfg.worldReplicaConfig("postgres://replica/holomush"))
+}
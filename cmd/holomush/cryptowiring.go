@@ -266,6 +266,7 @@ func buildCryptoWiring(in cryptoWiringInputs) (*cryptoWiring, error) {
 		if auditErr != nil {
 			slog.WarnContext(ctx, "admin handlers: TOTP audit service construction failed", "error", auditErr)
 		} else {
+			builtAudit.SetSecurityRecorder(in.Auth.SecurityLog())
 			totpAuditSvc = builtAudit
 		}
 	}
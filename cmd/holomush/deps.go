@@ -7,12 +7,14 @@ import (
 	"context"
 	cryptotls "crypto/tls"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/holomush/holomush/internal/bootstrap"
+	"github.com/holomush/holomush/internal/config"
 	"github.com/holomush/holomush/internal/control"
 	holoGRPC "github.com/holomush/holomush/internal/grpcclient"
 	"github.com/holomush/holomush/internal/observability"
@@ -57,6 +59,11 @@ type CoreDeps struct {
 	// Default: reads from DATABASE_URL environment variable
 	DatabaseURLGetter func() string
 
+	// DatabaseReplicaURLGetter returns the read-replica database URL; empty
+	// disables replica routing for world reads.
+	// Default: reads from DATABASE_REPLICA_URL environment variable
+	DatabaseReplicaURLGetter func() string
+
 	// MigratorFactory creates a database migrator.
 	// Default: store.NewMigrator
 	MigratorFactory func(databaseURL string) (bootstrap.AutoMigrator, error)
@@ -64,6 +71,12 @@ type CoreDeps struct {
 	// AutoMigrateGetter returns whether auto-migration is enabled.
 	// Default: parseAutoMigrate (reads HOLOMUSH_DB_AUTO_MIGRATE env var)
 	AutoMigrateGetter func() bool
+
+	// ConfigLoader is the loader the configuration was read from. Running
+	// subsystems subscribe to it and pick up changes on SIGHUP or when the
+	// config file changes.
+	// Default: nil (no hot reload)
+	ConfigLoader *config.Loader
 }
 
 // applyDefaults fills nil fields with their default implementations.
@@ -92,6 +105,11 @@ func (d *CoreDeps) applyDefaults() {
 			return os.Getenv("DATABASE_URL")
 		}
 	}
+	if d.DatabaseReplicaURLGetter == nil {
+		d.DatabaseReplicaURLGetter = func() string {
+			return os.Getenv("DATABASE_REPLICA_URL")
+		}
+	}
 	if d.MigratorFactory == nil {
 		d.MigratorFactory = func(url string) (bootstrap.AutoMigrator, error) {
 			return store.NewMigrator(url)
@@ -128,6 +146,11 @@ type GatewayDeps struct {
 	// ListenerFactory creates a network listener.
 	// Default: net.Listen
 	ListenerFactory func(network, address string) (net.Listener, error)
+
+	// ConfigLoader is the loader the configuration was read from; the
+	// telnet banner follows it on SIGHUP or when the config file changes.
+	// Default: nil (no hot reload)
+	ConfigLoader *config.Loader
 }
 
 // ControlServer interface wraps the methods used from control.GRPCServer.
@@ -146,6 +169,9 @@ type ObservabilityServer interface {
 	// self-registering metric constructors land on the scraped registry rather
 	// than prometheus.DefaultRegisterer (which /metrics does not serve).
 	Registerer() prometheus.Registerer
+	// Handle adds an HTTP route (such as the /healthz and /readyz probes)
+	// before Start.
+	Handle(pattern string, handler http.Handler)
 }
 
 // GRPCClient interface wraps the methods used from holoGRPC.Client.
@@ -156,7 +182,10 @@ type GRPCClient interface {
 	GetCommandHistory(ctx context.Context, req *corev1.GetCommandHistoryRequest) (*corev1.GetCommandHistoryResponse, error)
 	// Auth RPCs (two-phase login)
 	AuthenticatePlayer(ctx context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error)
+	AuthenticateWebSession(ctx context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error)
+	RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error)
 	SelectCharacter(ctx context.Context, req *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error)
+	ResumeSession(ctx context.Context, req *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error)
 	CreatePlayer(ctx context.Context, req *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error)
 	CreateCharacter(ctx context.Context, req *corev1.CreateCharacterRequest) (*corev1.CreateCharacterResponse, error)
 	ListCharacters(ctx context.Context, req *corev1.ListCharactersRequest) (*corev1.ListCharactersResponse, error)
@@ -178,6 +207,9 @@ type GRPCClient interface {
 	ListAvailableCommands(ctx context.Context, req *corev1.ListAvailableCommandsRequest) (*corev1.ListAvailableCommandsResponse, error)
 	// Liveness RPCs
 	RefreshConnection(ctx context.Context, req *corev1.RefreshConnectionRequest) (*corev1.RefreshConnectionResponse, error)
+	// Moderation RPCs
+	ReportInputFlood(ctx context.Context, req *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error)
+	CheckAddressBan(ctx context.Context, req *corev1.CheckAddressBanRequest) (*corev1.CheckAddressBanResponse, error)
 	// Content RPCs
 	GetContent(ctx context.Context, req *contentv1.GetContentRequest) (*contentv1.GetContentResponse, error)
 	ListContent(ctx context.Context, req *contentv1.ListContentRequest) (*contentv1.ListContentResponse, error)
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
 	"testing"
 	"time"
@@ -83,6 +84,10 @@ func (m *mockObservabilityServer) MustRegister(_ ...prometheus.Collector) {
 	// No-op for testing - metrics registration is not needed in unit tests
 }
 
+func (m *mockObservabilityServer) Handle(string, http.Handler) {
+	// No-op for testing - probe routes are exercised by the health package tests
+}
+
 func (m *mockObservabilityServer) Registerer() prometheus.Registerer {
 	// A fresh registry per call keeps unit tests free of duplicate-registration
 	// panics; the production server returns its own /metrics-served registry.
@@ -121,10 +126,22 @@ func (m *mockGRPCClient) AuthenticatePlayer(_ context.Context, _ *corev1.Authent
 	return nil, nil
 }
 
+func (m *mockGRPCClient) AuthenticateWebSession(_ context.Context, _ *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error) {
+	return nil, nil
+}
+
+func (m *mockGRPCClient) RefreshWebSession(_ context.Context, _ *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	return nil, nil
+}
+
 func (m *mockGRPCClient) SelectCharacter(_ context.Context, _ *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error) {
 	return nil, nil
 }
 
+func (m *mockGRPCClient) ResumeSession(_ context.Context, _ *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error) {
+	return nil, nil
+}
+
 func (m *mockGRPCClient) CreatePlayer(_ context.Context, _ *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error) {
 	return nil, nil
 }
@@ -193,6 +210,14 @@ func (m *mockGRPCClient) RefreshConnection(_ context.Context, _ *corev1.RefreshC
 	return &corev1.RefreshConnectionResponse{}, nil
 }
 
+func (m *mockGRPCClient) ReportInputFlood(_ context.Context, _ *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error) {
+	return &corev1.ReportInputFloodResponse{}, nil
+}
+
+func (m *mockGRPCClient) CheckAddressBan(_ context.Context, _ *corev1.CheckAddressBanRequest) (*corev1.CheckAddressBanResponse, error) {
+	return &corev1.CheckAddressBanResponse{}, nil
+}
+
 func (m *mockGRPCClient) GetContent(_ context.Context, _ *contentv1.GetContentRequest) (*contentv1.GetContentResponse, error) {
 	return nil, nil
 }
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/world"
+	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
+)
+
+// NewFsckCmd returns `holomush fsck`: a world consistency check that reports
+// referential damage and optionally repairs it.
+func NewFsckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fsck",
+		Short: "Check the world for orphaned and dangling references (Postgres)",
+		Long: `Scan the world for damage the schema cannot always prevent: exits whose
+source or destination location is gone, objects whose location, holder, or
+container is gone, characters in missing locations, objects that contain each
+other in a loop, and properties whose parent entity is gone.
+
+With --repair the safe repairs are applied in one transaction: orphaned exits
+and properties are deleted, and misplaced objects and characters are moved to
+--fallback-location. Repairs run as world commands, so each one emits its
+world-change envelope. A failed repair rolls back every repair.
+
+Running servers do not see repairs in their world cache until the affected
+entities are next written or the cache entries expire; prefer repairing while
+the game is offline.
+
+Exits non-zero when unrepaired issues remain.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runFsck(cmd)
+		},
+	}
+	cmd.Flags().Bool("repair", false, "apply safe repairs in a single transaction")
+	cmd.Flags().String("fallback-location", "", "location ID that receives misplaced objects and characters")
+	cmd.Flags().Bool("json", false, "output the report as JSON")
+	cmd.Flags().String("game", "main", "game id whose feed receives repair envelopes")
+	return cmd
+}
+
+func runFsck(cmd *cobra.Command) error {
+	repair, _ := cmd.Flags().GetBool("repair")                   //nolint:errcheck // flag defined above
+	fallbackStr, _ := cmd.Flags().GetString("fallback-location") //nolint:errcheck // flag defined above
+	asJSON, _ := cmd.Flags().GetBool("json")                     //nolint:errcheck // flag defined above
+	game, _ := cmd.Flags().GetString("game")                     //nolint:errcheck // flag defined above
+
+	opts := world.CheckOptions{Repair: repair}
+	if fallbackStr != "" {
+		fallback, err := ulid.Parse(fallbackStr)
+		if err != nil {
+			return oops.Code("WORLD_FSCK_INVALID_FALLBACK").With("fallback_location", fallbackStr).Wrap(err)
+		}
+		opts.FallbackLocationID = &fallback
+	}
+
+	pool, err := openOutboxPool(cmd.Context())
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	svc := world.NewService(world.ServiceConfig{
+		LocationRepo:  worldpostgres.NewLocationRepository(pool),
+		ExitRepo:      worldpostgres.NewExitRepository(pool),
+		ObjectRepo:    worldpostgres.NewObjectRepository(pool),
+		SceneRepo:     worldpostgres.NewSceneRepository(pool),
+		CharacterRepo: worldpostgres.NewCharacterRepository(pool),
+		PropertyRepo:  worldpostgres.NewPropertyRepository(pool),
+		Engine:        systemOnlyEngine{},
+		Transactor:    worldpostgres.NewTransactor(pool),
+		OutboxWriter:  worldpostgres.NewOutboxStore(pool),
+		GameID:        game,
+	})
+	checker := world.NewChecker(worldpostgres.NewConsistencyStore(pool), svc)
+
+	result, checkErr := checker.Check(cmd.Context(), opts)
+	if result != nil {
+		if err := writeFsckReport(cmd, result, asJSON); err != nil {
+			return err
+		}
+	}
+	if checkErr != nil {
+		return oops.Code("WORLD_FSCK_CMD_FAILED").Wrap(checkErr)
+	}
+	if unrepaired := len(result.Issues) - result.Repaired; unrepaired > 0 {
+		return oops.Code("WORLD_FSCK_ISSUES_FOUND").With("issues", unrepaired).
+			Errorf("%d world consistency issue(s) found", unrepaired)
+	}
+	return nil
+}
+
+func writeFsckReport(cmd *cobra.Command, result *world.CheckResult, asJSON bool) error {
+	out := cmd.OutOrStdout()
+	if asJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return oops.Code("WORLD_FSCK_CMD_FAILED").Wrap(err)
+		}
+		fmt.Fprintln(out, string(data)) //nolint:errcheck // display output
+		return nil
+	}
+	for _, issue := range result.Issues {
+		status := "repair: " + issue.Repair
+		if issue.Repaired {
+			status = "repaired: " + issue.Repair
+		}
+		fmt.Fprintf(out, "%-30s %s (%s)\n", issue.Kind, issue.Detail, status) //nolint:errcheck // display output
+	}
+	fmt.Fprintf(out, "fsck: %d issue(s), %d repaired\n", len(result.Issues), result.Repaired) //nolint:errcheck // display output
+	return nil
+}
+
+// systemOnlyEngine authorizes the checker's system-subject repairs and denies
+// everything else. fsck runs with direct database access, so there is no
+// player to evaluate policy for.
+type systemOnlyEngine struct{}
+
+func (systemOnlyEngine) Evaluate(ctx context.Context, req types.AccessRequest) (types.Decision, error) {
+	if req.Subject == access.SubjectSystem && access.IsSystemContext(ctx) {
+		return types.NewDecision(types.EffectSystemBypass, "fsck system repair", ""), nil
+	}
+	return types.NewDecision(types.EffectDefaultDeny, "fsck allows only system repairs", ""), nil
+}
+
+func (systemOnlyEngine) CanPerformAction(context.Context, string, string, string, string) (bool, error) {
+	return false, nil
+}
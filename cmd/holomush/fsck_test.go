@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build !integration
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// TestNewFsckCmdStructure verifies `holomush fsck` is wired under the root
+// with its repair and reporting flags.
+func TestNewFsckCmdStructure(t *testing.T) {
+	root := NewRootCmd()
+	cmd, _, err := root.Find([]string{"fsck"})
+	require.NoError(t, err)
+	assert.Equal(t, "fsck", cmd.Name())
+	for _, flag := range []string{"repair", "fallback-location", "json", "game"} {
+		assert.NotNil(t, cmd.Flags().Lookup(flag), "fsck has a --%s flag", flag)
+	}
+}
+
+// TestFsckRejectsInvalidFallback verifies a malformed fallback location is
+// rejected before any database connection is attempted.
+func TestFsckRejectsInvalidFallback(t *testing.T) {
+	cmd := NewFsckCmd()
+	cmd.SetArgs([]string{"--repair", "--fallback-location", "not-a-ulid"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ulid")
+}
+
+// TestSystemOnlyEngine verifies fsck's engine allows only system repairs.
+func TestSystemOnlyEngine(t *testing.T) {
+	req := types.AccessRequest{Subject: access.SubjectSystem, Action: "delete", Resource: "exit:01ABC"}
+
+	decision, err := systemOnlyEngine{}.Evaluate(access.WithSystemSubject(context.Background()), req)
+	require.NoError(t, err)
+	assert.True(t, decision.IsAllowed())
+
+	decision, err = systemOnlyEngine{}.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, decision.IsAllowed(), "system subject without system context is denied")
+
+	req.Subject = access.CharacterSubject("01ABC")
+	decision, err = systemOnlyEngine{}.Evaluate(access.WithSystemSubject(context.Background()), req)
+	require.NoError(t, err)
+	assert.False(t, decision.IsAllowed())
+}
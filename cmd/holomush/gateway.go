@@ -25,6 +25,7 @@ import (
 	tlscerts "github.com/holomush/holomush/internal/tls"
 	"github.com/holomush/holomush/internal/web"
 	"github.com/holomush/holomush/internal/xdg"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
 )
 
 // gatewayConfig holds configuration for the gateway command.
@@ -42,6 +43,9 @@ type gatewayConfig struct {
 	TelnetIdleTimeout    time.Duration `koanf:"telnet_idle_timeout"`
 	TelnetWriteTimeout   time.Duration `koanf:"telnet_write_timeout"`
 	TelnetPreAuthTimeout time.Duration `koanf:"telnet_pre_auth_timeout"`
+	TelnetBanner         string        `koanf:"telnet_banner"`
+	TelnetInputBurst     int           `koanf:"telnet_input_burst"`
+	TelnetInputRate      float64       `koanf:"telnet_input_rate"`
 }
 
 // Validate checks that the configuration is valid.
@@ -70,6 +74,12 @@ func (cfg *gatewayConfig) Validate() error {
 	if cfg.TelnetPreAuthTimeout <= 0 {
 		return oops.Code("CONFIG_INVALID").Errorf("telnet-pre-auth-timeout must be positive, got %s", cfg.TelnetPreAuthTimeout)
 	}
+	if cfg.TelnetInputBurst < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("telnet-input-burst must not be negative, got %d", cfg.TelnetInputBurst)
+	}
+	if cfg.TelnetInputRate < 0 {
+		return oops.Code("CONFIG_INVALID").Errorf("telnet-input-rate must not be negative, got %g", cfg.TelnetInputRate)
+	}
 	return nil
 }
 
@@ -97,15 +107,19 @@ func newGatewayCmd() *cobra.Command {
 		Long: `Start the gateway process which handles incoming connections
 from telnet and web clients, forwarding commands to the core process.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			if err := config.Load(configFile, cmd, cfg, "gateway"); err != nil {
+			loader, err := config.NewLoader(configFile, cmd)
+			if err != nil {
+				return err
+			}
+			if err := loader.Unmarshal("gateway", cfg); err != nil {
 				return err
 			}
 			logConfig := config.DefaultLoggingConfig()
-			if err := config.Load(configFile, cmd, &logConfig, "logging"); err != nil {
+			if err := loader.Unmarshal("logging", &logConfig); err != nil {
 				return err
 			}
 			applyLogSinkFlags(cmd, &logConfig)
-			return runGatewayWithDeps(cmd.Context(), cfg, logConfig, cmd, nil)
+			return runGatewayWithDeps(cmd.Context(), cfg, logConfig, cmd, &GatewayDeps{ConfigLoader: loader})
 		},
 	}
 
@@ -122,6 +136,9 @@ from telnet and web clients, forwarding commands to the core process.`,
 	cmd.Flags().DurationVar(&cfg.TelnetIdleTimeout, "telnet-idle-timeout", defaultTelnetIdleTimeout, "per-connection idle read timeout")
 	cmd.Flags().DurationVar(&cfg.TelnetWriteTimeout, "telnet-write-timeout", defaultTelnetWriteTimeout, "per-send write deadline")
 	cmd.Flags().DurationVar(&cfg.TelnetPreAuthTimeout, "telnet-pre-auth-timeout", defaultTelnetPreAuthTimeout, "disconnect unauthenticated clients after this duration")
+	cmd.Flags().StringVar(&cfg.TelnetBanner, "telnet-banner", telnet.DefaultBanner, "first line sent to new telnet connections")
+	cmd.Flags().IntVar(&cfg.TelnetInputBurst, "telnet-input-burst", telnet.DefaultLimits.InputBurst, "commands a telnet connection may send back to back before flood protection engages")
+	cmd.Flags().Float64Var(&cfg.TelnetInputRate, "telnet-input-rate", telnet.DefaultLimits.InputRate, "sustained commands per second allowed per telnet connection")
 	registerLogSinkFlags(cmd)
 
 	return cmd
@@ -282,6 +299,7 @@ func runGatewayWithDeps(ctx context.Context, cfg *gatewayConfig, logConfig confi
 		obsServer.MustRegister(
 			telnet.ConnectionsActive, telnet.ConnectionsRefusedTotal,
 			telnet.PreAuthTimeoutsTotal, telnet.IdleTimeoutsTotal,
+			telnet.InputFloodActionsTotal, telnet.OutputSquelchedTotal,
 		)
 		var obsErrChan <-chan error
 		obsErrChan, err = obsServer.Start()
@@ -360,8 +378,19 @@ func runGatewayWithDeps(ctx context.Context, cfg *gatewayConfig, logConfig confi
 		IdleReadTimeout: cfg.TelnetIdleTimeout,
 		WriteTimeout:    cfg.TelnetWriteTimeout,
 		PreAuthTimeout:  cfg.TelnetPreAuthTimeout,
+		InputBurst:      cfg.TelnetInputBurst,
+		InputRate:       cfg.TelnetInputRate,
+	}
+	banner := telnet.NewBanner(cfg.TelnetBanner)
+	go runTelnetAcceptLoop(ctx, telnetListener, grpcClient, cancel, slots, limits,
+		withBanner(banner), withConnectionGate(coreBanGate{client: grpcClient}))
+
+	if deps.ConfigLoader != nil {
+		subscribeGatewayReload(deps.ConfigLoader, cfg, banner)
+		reloadCtx, stopReload := context.WithCancel(ctx)
+		defer stopReload()
+		watchConfig(reloadCtx, deps.ConfigLoader)
 	}
-	go runTelnetAcceptLoop(ctx, telnetListener, grpcClient, cancel, slots, limits)
 
 	telemetry.EmitStartupSpan(ctx, "holomush-gateway", version, bootStart)
 
@@ -454,7 +483,7 @@ func (b *acceptBackoff) wait() time.Duration {
 	return b.current
 }
 
-// acceptLoopHooks bundles test-only observability seams. Production callers
+// acceptLoopHooks bundles optional accept-loop seams. Production callers
 // pass no options; tests use withOnSlotReleased to receive a deterministic
 // signal when a handler goroutine exits and frees its semaphore slot.
 //
@@ -465,6 +494,8 @@ func (b *acceptBackoff) wait() time.Duration {
 // bead holomush-rfzb).
 type acceptLoopHooks struct {
 	onSlotReleased func()
+	gate           telnet.ConnectionGate
+	banner         *telnet.Banner
 }
 
 type acceptLoopOption func(*acceptLoopHooks)
@@ -475,6 +506,68 @@ func withOnSlotReleased(cb func()) acceptLoopOption {
 	return func(h *acceptLoopHooks) { h.onSlotReleased = cb }
 }
 
+// connectionGateTimeout bounds a ConnectionGate check so a slow ban lookup
+// cannot hold a connection slot indefinitely.
+const connectionGateTimeout = 5 * time.Second
+
+// withConnectionGate refuses connections from addresses the gate reports as
+// banned before the handler shows any prompt. The gateway has no database
+// access, so it installs a coreBanGate that asks core; core also enforces
+// the same bans at login from the client address the handler forwards.
+func withConnectionGate(gate telnet.ConnectionGate) acceptLoopOption {
+	return func(h *acceptLoopHooks) { h.gate = gate }
+}
+
+// coreBanGate is a telnet.ConnectionGate that checks the ban list through
+// core's CheckAddressBan RPC.
+type coreBanGate struct {
+	client GRPCClient
+}
+
+// AddressBanned implements telnet.ConnectionGate.
+func (g coreBanGate) AddressBanned(ctx context.Context, ipAddress string) (string, bool, error) {
+	if ipAddress == "" {
+		return "", false, nil
+	}
+	resp, err := g.client.CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{IpAddress: ipAddress})
+	if err != nil {
+		return "", false, oops.With("ip_address", ipAddress).Wrap(err)
+	}
+	return resp.GetReason(), resp.GetBanned(), nil
+}
+
+// withBanner shares banner with every handler so a config reload changes
+// the greeting for connections accepted afterwards.
+func withBanner(banner *telnet.Banner) acceptLoopOption {
+	return func(h *acceptLoopHooks) { h.banner = banner }
+}
+
+// admitConnection consults gate, if any, and reports whether conn may
+// proceed. A refused connection has already been closed. Gate errors admit
+// the connection: login-time enforcement in core still applies.
+func admitConnection(ctx context.Context, gate telnet.ConnectionGate, conn net.Conn, limits telnet.Limits) bool {
+	if gate == nil {
+		return true
+	}
+	ip := ""
+	if host, _, err := net.SplitHostPort(conn.RemoteAddr().String()); err == nil {
+		ip = host
+	}
+	gateCtx, gateCancel := context.WithTimeout(ctx, connectionGateTimeout)
+	defer gateCancel()
+	reason, banned, err := gate.AddressBanned(gateCtx, ip)
+	if err != nil {
+		slog.WarnContext(ctx, "telnet connection gate failed, admitting connection",
+			"remote_addr", conn.RemoteAddr().String(), "error", err)
+		return true
+	}
+	if banned {
+		telnet.RefuseBanned(conn, reason, limits.WriteTimeout)
+		return false
+	}
+	return true
+}
+
 // runTelnetAcceptLoop accepts telnet connections with exponential backoff on errors.
 // slots bounds the number of concurrent handler goroutines; a full slots channel
 // triggers immediate refusal via RefuseOverCapacity. The cancel function is called
@@ -536,6 +629,11 @@ func runTelnetAcceptLoop(
 		case slots <- struct{}{}:
 			telnet.IncConnectionsActive()
 			handler := telnet.NewGatewayHandler(conn, client, limits)
+			handler.EnableCharsetNegotiation()
+			handler.EnableCompression()
+			handler.EnableTerminalTypeNegotiation()
+			handler.SetBanner(hooks.banner)
+			handler.SetConnectScreens(client)
 			go func() {
 				defer func() {
 					<-slots
@@ -544,6 +642,9 @@ func runTelnetAcceptLoop(
 						hooks.onSlotReleased()
 					}
 				}()
+				if !admitConnection(ctx, hooks.gate, conn, limits) {
+					return
+				}
 				handler.Handle(ctx)
 			}()
 		default:
@@ -33,6 +33,8 @@ var coreOnlyFiles = map[string]struct{}{
 	"migrate_test.go":                 {},
 	"cmd_plugin_events.go":            {},
 	"cmd_plugin_validate.go":          {},
+	"cmd_plugin_replay.go":            {},
+	"cmd_plugin_install.go":           {},
 	// 07-09 item 5: the bootstrap orphan boot gate's definition + tests
 	// moved to internal/bootstrap/setup (behind the Bootstrap -> Database
 	// edge); the two bootstrap-orphan files no longer exist here.
@@ -110,6 +112,31 @@ var coreOnlyFiles = map[string]struct{}{
 	// internal/world/{outbox,postgres} by design. No admin UDS, no crypto/abac.
 	"world_genesis.go":      {},
 	"world_genesis_test.go": {},
+	// `holomush fsck` CLI is a host-shell operator tool (like
+	// world_genesis.go), not the gateway. It scans the world tables and
+	// repairs through world.Service with the injected postgres repositories;
+	// imports internal/world{,/postgres} + internal/access by design.
+	"fsck.go":      {},
+	"fsck_test.go": {},
+	// `holomush policy test` CLI is a host-shell operator tool (like
+	// fsck.go), not the gateway. It reads the live policy set from Postgres
+	// and evaluates suites through the policy engine; imports
+	// internal/access/policy/{policycheck,store} by design.
+	"cmd_policy.go":      {},
+	"cmd_policy_test.go": {},
+	// `holomush check` CLI is a host-shell operator tool (like fsck.go),
+	// not the gateway, and check.go also builds the core's /readyz suite.
+	// It pings Postgres, reads the schema version, and lists installed
+	// policies; imports internal/store + internal/access/policy{,/store} by
+	// design.
+	"check.go":      {},
+	"check_test.go": {},
+	// `holomush import-characters` CLI is a host-shell operator tool (like
+	// fsck.go), not the gateway. It creates players and characters from a
+	// legacy export through the character genesis service and world.Service;
+	// imports internal/{auth,world}{,/postgres} + internal/access by design.
+	"import_characters.go":      {},
+	"import_characters_test.go": {},
 	// 07-09 item 6: the crypto-operator allow-list validation's definition +
 	// tests moved to internal/access/setup (ABACSubsystem's own Start,
 	// against its own pool); the two crypto-operator-validation files no
@@ -130,6 +157,16 @@ var coreOnlyFiles = map[string]struct{}{
 	// same domain imports. Core process's own orchestrator wiring under
 	// test, not the gateway.
 	"core_subsystems_test.go": {},
+	// Config hot reload: the core's subscribers retune the ABAC, plugin,
+	// command, and event-audit subsystems in place. reload.go holds the
+	// gateway-safe half (watchConfig, the telnet banner).
+	"core_reload.go":      {},
+	"core_reload_test.go": {},
+	// The admin dashboard API route on the core's metrics server; the
+	// gateway never serves it. The test builds an httpapi.Handler over the
+	// auth/session/policy mocks.
+	"admin_api.go":      {},
+	"admin_api_test.go": {},
 }
 
 // gatewayForbiddenPackages is the single, shared policy list read by both
@@ -6,7 +6,9 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"os/signal"
@@ -25,6 +27,7 @@ import (
 	"github.com/holomush/holomush/internal/telnet"
 	tlscerts "github.com/holomush/holomush/internal/tls"
 	"github.com/holomush/holomush/pkg/errutil"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
 )
 
 func TestGatewayCommand_Flags(t *testing.T) {
@@ -480,6 +483,14 @@ func TestGatewayCommand_TelnetDoSLimitDefaults(t *testing.T) {
 	preAuth, err := cmd.Flags().GetDuration("telnet-pre-auth-timeout")
 	require.NoError(t, err)
 	assert.Equal(t, 2*time.Minute, preAuth, "default pre-auth timeout per spec")
+
+	burst, err := cmd.Flags().GetInt("telnet-input-burst")
+	require.NoError(t, err)
+	assert.Equal(t, telnet.DefaultLimits.InputBurst, burst)
+
+	rate, err := cmd.Flags().GetFloat64("telnet-input-rate")
+	require.NoError(t, err)
+	assert.Equal(t, telnet.DefaultLimits.InputRate, rate)
 }
 
 func TestGatewayConfig_ValidateRejectsNonPositiveTelnetLimits(t *testing.T) {
@@ -505,6 +516,8 @@ func TestGatewayConfig_ValidateRejectsNonPositiveTelnetLimits(t *testing.T) {
 		{"TelnetIdleTimeout=0", func(c *gatewayConfig) { c.TelnetIdleTimeout = 0 }},
 		{"TelnetWriteTimeout=0", func(c *gatewayConfig) { c.TelnetWriteTimeout = 0 }},
 		{"TelnetPreAuthTimeout=0", func(c *gatewayConfig) { c.TelnetPreAuthTimeout = 0 }},
+		{"TelnetInputBurst<0", func(c *gatewayConfig) { c.TelnetInputBurst = -1 }},
+		{"TelnetInputRate<0", func(c *gatewayConfig) { c.TelnetInputRate = -1 }},
 	}
 	for _, tc := range cases {
 		t.Run(tc.field, func(t *testing.T) {
@@ -1063,3 +1076,95 @@ func TestAcceptLoopReleasesSlotOnHandlerExit(t *testing.T) {
 	_ = ln.Close()
 	<-loopDone
 }
+
+// stubConnectionGate bans a single address.
+type stubConnectionGate struct {
+	banned string
+}
+
+func (g stubConnectionGate) AddressBanned(_ context.Context, ipAddress string) (string, bool, error) {
+	return "spam", ipAddress == g.banned, nil
+}
+
+// TestAcceptLoopRefusesBannedAddress verifies that a connection gate refuses
+// a banned address with the ban reason and frees the slot it took.
+func TestAcceptLoopRefusesBannedAddress(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = ln.Close() }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	released := make(chan struct{}, 4)
+	loopDone := make(chan struct{})
+	go func() {
+		runTelnetAcceptLoop(
+			ctx, ln, &mockGRPCClient{}, cancel, make(chan struct{}, 1), telnet.DefaultLimits,
+			withConnectionGate(stubConnectionGate{banned: "127.0.0.1"}),
+			withOnSlotReleased(func() {
+				select {
+				case released <- struct{}{}:
+				default:
+				}
+			}),
+		)
+		close(loopDone)
+	}()
+
+	c, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	defer func() { _ = c.Close() }()
+
+	_ = c.SetReadDeadline(time.Now().Add(2 * time.Second))
+	got, _ := io.ReadAll(c)
+	assert.Equal(t, "You are banned from this server: spam\r\n", string(got))
+
+	select {
+	case <-released:
+	case <-time.After(10 * time.Second):
+		t.Fatal("refused connection did not release its slot within 10s")
+	}
+
+	cancel()
+	_ = ln.Close()
+	<-loopDone
+}
+
+// banCheckClient answers CheckAddressBan from a fixed response.
+type banCheckClient struct {
+	mockGRPCClient
+	resp *corev1.CheckAddressBanResponse
+	err  error
+	reqs []*corev1.CheckAddressBanRequest
+}
+
+func (c *banCheckClient) CheckAddressBan(_ context.Context, req *corev1.CheckAddressBanRequest) (*corev1.CheckAddressBanResponse, error) {
+	c.reqs = append(c.reqs, req)
+	return c.resp, c.err
+}
+
+// TestCoreBanGate verifies the gateway's connection gate asks core about
+// the client address and relays the ban reason.
+func TestCoreBanGate(t *testing.T) {
+	client := &banCheckClient{resp: &corev1.CheckAddressBanResponse{Banned: true, Reason: "spam"}}
+	gate := coreBanGate{client: client}
+
+	reason, banned, err := gate.AddressBanned(context.Background(), "203.0.113.7")
+	require.NoError(t, err)
+	assert.True(t, banned)
+	assert.Equal(t, "spam", reason)
+	require.Len(t, client.reqs, 1)
+	assert.Equal(t, "203.0.113.7", client.reqs[0].GetIpAddress())
+
+	// An unknown address has nothing to check.
+	_, banned, err = gate.AddressBanned(context.Background(), "")
+	require.NoError(t, err)
+	assert.False(t, banned)
+	assert.Len(t, client.reqs, 1)
+
+	client.err = errors.New("core unavailable")
+	_, banned, err = gate.AddressBanned(context.Background(), "203.0.113.7")
+	require.Error(t, err)
+	assert.False(t, banned)
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/auth"
+	authpostgres "github.com/holomush/holomush/internal/auth/postgres"
+	"github.com/holomush/holomush/internal/bootstrap"
+	bootstrapsetup "github.com/holomush/holomush/internal/bootstrap/setup"
+	"github.com/holomush/holomush/internal/charimport"
+	"github.com/holomush/holomush/internal/world"
+	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
+)
+
+// NewImportCharactersCmd returns `holomush import-characters`: a bulk import
+// of players and characters from another game's export.
+func NewImportCharactersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-characters <file>",
+		Short: "Import players and characters from another game (Postgres)",
+		Long: `Import player accounts and their characters from a CSV or JSON export
+of another game, such as a PennMUSH or TinyMUX database, so its players can
+log in with the accounts and passwords they already have.
+
+Each account carries a username, an optional email, and its password hash
+from the old game; each character a name, a description, and attributes.
+argon2id and bcrypt hashes are accepted. bcrypt hashes are replaced with
+argon2id the first time the player logs in.
+
+The whole file is validated first, including for duplicate usernames,
+emails, and character names, and nothing is imported if any record is
+invalid. Running the same import again is safe: accounts it created and
+characters they already own are left unchanged. An account or character name
+already held by someone else is skipped and reported.
+
+Imported characters start in --location, or the game's starting location.
+Exits non-zero when any record was skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportCharacters(cmd, args[0])
+		},
+	}
+	cmd.Flags().String("format", "", "file format, csv or json (default: from the file extension)")
+	cmd.Flags().String("source", charimport.DefaultSource, "label for the game being imported, recorded with each account")
+	cmd.Flags().Bool("dry-run", false, "validate and report what would be imported without writing anything")
+	cmd.Flags().String("location", "", "location ID imported characters start in (default: the starting location)")
+	cmd.Flags().String("game", "main", "game id whose feed receives character creation envelopes")
+	return cmd
+}
+
+func runImportCharacters(cmd *cobra.Command, path string) error {
+	formatStr, _ := cmd.Flags().GetString("format")     //nolint:errcheck // flag defined above
+	source, _ := cmd.Flags().GetString("source")        //nolint:errcheck // flag defined above
+	dryRun, _ := cmd.Flags().GetBool("dry-run")         //nolint:errcheck // flag defined above
+	locationStr, _ := cmd.Flags().GetString("location") //nolint:errcheck // flag defined above
+	game, _ := cmd.Flags().GetString("game")            //nolint:errcheck // flag defined above
+
+	format, err := importFormat(path, formatStr)
+	if err != nil {
+		return err
+	}
+	var locationID *ulid.ULID
+	if locationStr != "" {
+		id, err := ulid.Parse(locationStr)
+		if err != nil {
+			return oops.Code("IMPORT_INVALID_LOCATION").With("location", locationStr).Wrap(err)
+		}
+		locationID = &id
+	}
+
+	players, err := readImportFile(path, format)
+	if err != nil {
+		return err
+	}
+	if problems := charimport.Validate(players); len(problems) > 0 {
+		errOut := cmd.ErrOrStderr()
+		for _, p := range problems {
+			fmt.Fprintln(errOut, p) //nolint:errcheck // display output
+		}
+		return oops.Code("IMPORT_INVALID").With("problems", len(problems)).
+			Errorf("%d problem(s) in %s; nothing was imported", len(problems), path)
+	}
+
+	ctx := cmd.Context()
+	pool, err := openOutboxPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	if locationID == nil {
+		locationID, err = startingLocation(ctx, bootstrap.NewPostgresMetadataStore(pool))
+		if err != nil {
+			return err
+		}
+	}
+
+	charRepo := worldpostgres.NewCharacterRepository(pool)
+	transactor := worldpostgres.NewTransactor(pool)
+	outbox := worldpostgres.NewOutboxStore(pool)
+	genesis, err := auth.NewCharacterGenesisService(charRepo, transactor,
+		worldpostgres.NewBindingRepository(pool), outbox, worldpostgres.NewReapingGuard(pool))
+	if err != nil {
+		return oops.Code("IMPORT_FAILED").Wrap(err)
+	}
+	svc := world.NewService(world.ServiceConfig{
+		CharacterRepo:       charRepo,
+		PropertyRepo:        worldpostgres.NewPropertyRepository(pool),
+		PropertySchemaStore: worldpostgres.NewPropertySchemaRepository(pool),
+		Engine:              importEngine{},
+		Transactor:          transactor,
+		OutboxWriter:        outbox,
+		GameID:              game,
+	})
+	// Typed attributes are checked against the staff declarations; a bad
+	// declaration only leaves its own name untyped, as at server start.
+	if err := svc.LoadPropertySchemas(ctx); err != nil {
+		slog.WarnContext(ctx, "some property schemas were not loaded", "error", err)
+	}
+	importer, err := charimport.NewImporter(charimport.Config{
+		Players:    authpostgres.NewPlayerRepository(pool),
+		Characters: bootstrapsetup.NewCharRepoAdapter(pool, charRepo),
+		Genesis:    genesis,
+		Properties: svc,
+		Transactor: transactor,
+		Ledger:     charimport.NewPostgresLedger(pool),
+	})
+	if err != nil {
+		return oops.Code("IMPORT_FAILED").Wrap(err)
+	}
+
+	report, importErr := importer.Import(access.WithSystemSubject(ctx), players, charimport.Options{
+		Source:     source,
+		LocationID: locationID,
+		DryRun:     dryRun,
+	})
+	writeImportReport(cmd.OutOrStdout(), report, dryRun)
+	if importErr != nil {
+		return importErr
+	}
+	if skipped := report.Players.Skipped + report.Characters.Skipped; skipped > 0 {
+		return oops.Code("IMPORT_CONFLICTS").With("skipped", skipped).
+			Errorf("%d record(s) skipped", skipped)
+	}
+	return nil
+}
+
+// importFormat is the --format value, or the format the file extension
+// names.
+func importFormat(path, flag string) (charimport.Format, error) {
+	if flag == "" {
+		flag = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch format := charimport.Format(strings.ToLower(flag)); format {
+	case charimport.FormatCSV, charimport.FormatJSON:
+		return format, nil
+	}
+	return "", oops.Code("IMPORT_FORMAT_INVALID").
+		With("format", flag).
+		Errorf("cannot tell the format of %s: pass --format csv or --format json", path)
+}
+
+func readImportFile(path string, format charimport.Format) ([]*charimport.Player, error) {
+	f, err := os.Open(path) //nolint:gosec // operator-supplied import file
+	if err != nil {
+		return nil, oops.Code("IMPORT_READ_FAILED").With("path", path).Wrap(err)
+	}
+	defer f.Close() //nolint:errcheck // read-only file
+	players, err := charimport.Parse(f, format)
+	if err != nil {
+		return nil, oops.With("path", path).Wrap(err)
+	}
+	return players, nil
+}
+
+// startingLocation reads the location new characters start in from the
+// bootstrap metadata.
+func startingLocation(ctx context.Context, store *bootstrap.PostgresMetadataStore) (*ulid.ULID, error) {
+	value, found, err := store.Get(ctx, "starting_location_id")
+	if err != nil {
+		return nil, oops.Code("IMPORT_FAILED").Wrap(err)
+	}
+	if !found {
+		return nil, oops.Code("IMPORT_INVALID_LOCATION").
+			Errorf("the game has no starting location yet; start the server once or pass --location")
+	}
+	id, err := ulid.Parse(value)
+	if err != nil {
+		return nil, oops.Code("IMPORT_INVALID_LOCATION").With("starting_location_id", value).Wrap(err)
+	}
+	return &id, nil
+}
+
+func writeImportReport(out io.Writer, report *charimport.Report, dryRun bool) {
+	prefix := ""
+	if dryRun {
+		prefix = "dry run: "
+	}
+	for _, o := range report.Outcomes {
+		what := "player " + o.Username
+		if o.Character != "" {
+			what = "character " + o.Character + " (" + o.Username + ")"
+		}
+		line := fmt.Sprintf("%s%-9s %s", prefix, o.Action, what)
+		if o.Reason != "" {
+			line += ": " + o.Reason
+		}
+		fmt.Fprintln(out, line) //nolint:errcheck // display output
+	}
+	//nolint:errcheck // display output
+	fmt.Fprintf(out, "%simport: players %d created, %d unchanged, %d skipped; characters %d created, %d unchanged, %d skipped\n",
+		prefix,
+		report.Players.Created, report.Players.Unchanged, report.Players.Skipped,
+		report.Characters.Created, report.Characters.Unchanged, report.Characters.Skipped)
+}
+
+// importEngine lets an imported character set attributes on itself and
+// denies everything else. The import runs with direct database access, so
+// there is no player to evaluate policy for; acting as the character makes
+// it the owner of its attributes, as on the legacy server.
+type importEngine struct{}
+
+func (importEngine) Evaluate(ctx context.Context, req types.AccessRequest) (types.Decision, error) {
+	id, ok := strings.CutPrefix(req.Subject, access.SubjectCharacter)
+	if ok && id != "" && access.IsSystemContext(ctx) && req.Action == "write" && req.Resource == access.CharacterResource(id) {
+		return types.NewDecision(types.EffectSystemBypass, "character import", ""), nil
+	}
+	return types.NewDecision(types.EffectDefaultDeny, "import allows only attributes on imported characters", ""), nil
+}
+
+func (importEngine) CanPerformAction(context.Context, string, string, string, string) (bool, error) {
+	return false, nil
+}
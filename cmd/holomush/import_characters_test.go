@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build !integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/charimport"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// TestNewImportCharactersCmdStructure verifies `holomush import-characters`
+// is wired under the root with its flags.
+func TestNewImportCharactersCmdStructure(t *testing.T) {
+	root := NewRootCmd()
+	cmd, _, err := root.Find([]string{"import-characters"})
+	require.NoError(t, err)
+	assert.Equal(t, "import-characters", cmd.Name())
+	for _, flag := range []string{"format", "source", "dry-run", "location", "game"} {
+		assert.NotNil(t, cmd.Flags().Lookup(flag), "import-characters has a --%s flag", flag)
+	}
+}
+
+func TestImportFormat(t *testing.T) {
+	format, err := importFormat("players.CSV", "")
+	require.NoError(t, err)
+	assert.Equal(t, charimport.FormatCSV, format)
+
+	format, err = importFormat("players.txt", "json")
+	require.NoError(t, err)
+	assert.Equal(t, charimport.FormatJSON, format)
+
+	_, err = importFormat("players.txt", "")
+	errutil.AssertErrorCode(t, err, "IMPORT_FORMAT_INVALID")
+}
+
+// TestImportCharactersRejectsInvalidFile verifies validation problems are
+// listed and stop the import before any database connection is attempted.
+func TestImportCharactersRejectsInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "players.csv")
+	require.NoError(t, os.WriteFile(path, []byte("username,password_hash\n9lives,plaintext\n"), 0o600))
+
+	var stderr bytes.Buffer
+	cmd := NewImportCharactersCmd()
+	cmd.SetArgs([]string{path})
+	cmd.SetErr(&stderr)
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	err := cmd.Execute()
+	errutil.AssertErrorCode(t, err, "IMPORT_INVALID")
+	assert.Contains(t, stderr.String(), "line 2 (9lives): invalid username")
+	assert.Contains(t, stderr.String(), "line 2 (9lives): unsupported password hash format")
+}
+
+func TestImportCharactersRejectsInvalidLocation(t *testing.T) {
+	cmd := NewImportCharactersCmd()
+	cmd.SetArgs([]string{"--location", "not-a-ulid", "players.json"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+	errutil.AssertErrorCode(t, cmd.Execute(), "IMPORT_INVALID_LOCATION")
+}
+
+func TestWriteImportReport(t *testing.T) {
+	report := &charimport.Report{
+		Outcomes: []charimport.Outcome{
+			{Username: "bob", Action: charimport.ActionCreated},
+			{Username: "bob", Character: "Roberta", Action: charimport.ActionSkipped, Reason: "name taken"},
+		},
+		Players:    charimport.Tally{Created: 1},
+		Characters: charimport.Tally{Skipped: 1},
+	}
+	var out bytes.Buffer
+	writeImportReport(&out, report, true)
+	assert.Equal(t,
+		"dry run: created   player bob\n"+
+			"dry run: skipped   character Roberta (bob): name taken\n"+
+			"dry run: import: players 1 created, 0 unchanged, 0 skipped; characters 0 created, 0 unchanged, 1 skipped\n",
+		out.String())
+}
+
+// TestImportEngine verifies the import's engine allows only an imported
+// character writing to itself.
+func TestImportEngine(t *testing.T) {
+	ctx := access.WithSystemSubject(context.Background())
+	req := types.AccessRequest{
+		Subject:  access.CharacterSubject("01ABC"),
+		Action:   "write",
+		Resource: access.CharacterResource("01ABC"),
+	}
+
+	decision, err := importEngine{}.Evaluate(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, decision.IsAllowed())
+
+	decision, err = importEngine{}.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, decision.IsAllowed(), "outside the import context")
+
+	for _, denied := range []types.AccessRequest{
+		{Subject: req.Subject, Action: "write", Resource: access.CharacterResource("01XYZ")},
+		{Subject: req.Subject, Action: "delete", Resource: req.Resource},
+		{Subject: access.SubjectSystem, Action: "write", Resource: req.Resource},
+		{Subject: "character:", Action: "write", Resource: req.Resource},
+	} {
+		decision, err = importEngine{}.Evaluate(ctx, denied)
+		require.NoError(t, err)
+		assert.False(t, decision.IsAllowed(), "%+v", denied)
+	}
+}
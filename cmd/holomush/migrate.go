@@ -25,6 +25,7 @@ type migrator interface {
 	Down() error
 	Steps(n int) error
 	Version() (uint, bool, error)
+	LatestVersion() (uint, error)
 	Force(version int) error
 	Close() error
 	PendingMigrations() ([]uint, error)
@@ -208,11 +209,23 @@ func runMigrateStatusLogic(out io.Writer, migrator migrator) error {
 		return oops.With("operation", "get version").Wrap(err)
 	}
 
+	expected, err := migrator.LatestVersion()
+	if err != nil {
+		return oops.With("operation", "get expected version").Wrap(err)
+	}
+
 	fmt.Fprintf(out, "Current version: %d\n", version)
-	if dirty {
+	fmt.Fprintf(out, "Expected version: %d\n", expected)
+	switch {
+	case dirty:
 		fmt.Fprintln(out, "Status: DIRTY (migration failed, manual intervention required)")
 		fmt.Fprintln(out, "Use 'holomush migrate force VERSION' to reset")
-	} else {
+	case version < expected:
+		fmt.Fprintln(out, "Status: BEHIND (the server will not start until migrated)")
+		fmt.Fprintln(out, "Use 'holomush migrate up' to apply pending migrations")
+	case version > expected:
+		fmt.Fprintln(out, "Status: AHEAD (schema is newer than this binary)")
+	default:
 		fmt.Fprintln(out, "Status: OK")
 	}
 	return nil
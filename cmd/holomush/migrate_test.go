@@ -40,6 +40,11 @@ type migrateLogicMock struct {
 	appliedMigrations []uint
 	pendingErr        error
 	appliedErr        error
+	latest            uint
+}
+
+func (m *migrateLogicMock) LatestVersion() (uint, error) {
+	return m.latest, nil
 }
 
 func (m *migrateLogicMock) Up() error {
@@ -361,17 +366,40 @@ func TestGetDatabaseURL(t *testing.T) {
 
 func TestMigrateStatusLogic_Clean(t *testing.T) {
 	var buf bytes.Buffer
-	mock := &migrateLogicMock{version: 7, dirty: false}
+	mock := &migrateLogicMock{version: 7, dirty: false, latest: 7}
 
 	err := runMigrateStatusLogic(&buf, mock)
 
 	require.NoError(t, err)
 	output := buf.String()
 	assert.Contains(t, output, "Current version: 7")
+	assert.Contains(t, output, "Expected version: 7")
 	assert.Contains(t, output, "Status: OK")
 	assert.NotContains(t, output, "DIRTY")
 }
 
+func TestMigrateStatusLogic_Behind(t *testing.T) {
+	var buf bytes.Buffer
+	mock := &migrateLogicMock{version: 5, latest: 7}
+
+	err := runMigrateStatusLogic(&buf, mock)
+
+	require.NoError(t, err)
+	output := buf.String()
+	assert.Contains(t, output, "Status: BEHIND")
+	assert.Contains(t, output, "migrate up")
+}
+
+func TestMigrateStatusLogic_Ahead(t *testing.T) {
+	var buf bytes.Buffer
+	mock := &migrateLogicMock{version: 9, latest: 7}
+
+	err := runMigrateStatusLogic(&buf, mock)
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "Status: AHEAD")
+}
+
 func TestMigrateStatusLogic_Dirty(t *testing.T) {
 	var buf bytes.Buffer
 	mock := &migrateLogicMock{version: 5, dirty: true}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+
+	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/internal/telnet"
+)
+
+// subscribeGatewayReload registers the gateway's reloadable settings with
+// loader. Only the telnet banner is reloadable; listeners and timeouts need a
+// restart. boot is the configuration the gateway started with; as with
+// reloadCoreConfig, the section is decoded over a copy of it.
+func subscribeGatewayReload(loader *config.Loader, boot *gatewayConfig, banner *telnet.Banner) {
+	loader.Subscribe("telnet-banner", config.ConfigSubscriberFunc(func(_ context.Context, cfg *config.Loader) error {
+		gw := *boot
+		gw.CORSOrigins = slices.Clone(boot.CORSOrigins)
+		if err := cfg.Unmarshal("gateway", &gw); err != nil {
+			return err
+		}
+		if err := gw.Validate(); err != nil {
+			return err
+		}
+		banner.Set(gw.TelnetBanner)
+		return nil
+	}))
+}
+
+// watchConfig reloads loader whenever its config file changes or the process
+// receives SIGHUP, until ctx is cancelled. Failures are logged; the process
+// keeps running on its current settings.
+func watchConfig(ctx context.Context, loader *config.Loader) {
+	if err := loader.Watch(ctx); err != nil {
+		slog.WarnContext(ctx, "config file watch unavailable; reload with SIGHUP", "error", err)
+	}
+
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hupChan)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hupChan:
+				slog.InfoContext(ctx, "received SIGHUP, reloading configuration")
+				if err := loader.Reload(ctx); err != nil {
+					slog.WarnContext(ctx, "config reload failed", "error", err)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/internal/telnet"
+)
+
+func TestGatewayReloadUpdatesTelnetBanner(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(cfgFile, []byte("gateway:\n  telnet_banner: \"Welcome to Crossroads\"\n"), 0o600))
+
+	loader, err := config.NewLoader(cfgFile, newGatewayCmd())
+	require.NoError(t, err)
+	boot := validGatewayConfig()
+	require.NoError(t, loader.Unmarshal("gateway", boot))
+	banner := telnet.NewBanner(boot.TelnetBanner)
+	assert.Equal(t, "Welcome to Crossroads", banner.Text())
+
+	subscribeGatewayReload(loader, boot, banner)
+	require.NoError(t, os.WriteFile(cfgFile, []byte("gateway:\n  telnet_banner: \"Closed for maintenance\"\n"), 0o600))
+	require.NoError(t, loader.Reload(context.Background()))
+
+	assert.Equal(t, "Closed for maintenance", banner.Text())
+}
+
+func validGatewayConfig() *gatewayConfig {
+	return &gatewayConfig{
+		TelnetAddr:           ":4201",
+		CoreAddr:             "localhost:9000",
+		ControlAddr:          "127.0.0.1:9002",
+		LogFormat:            "json",
+		TelnetMaxConns:       1000,
+		TelnetIdleTimeout:    5 * time.Minute,
+		TelnetWriteTimeout:   30 * time.Second,
+		TelnetPreAuthTimeout: 2 * time.Minute,
+	}
+}
@@ -44,6 +44,10 @@ WebAssembly plugins, and dual protocol support (telnet + web).`,
 	cmd.AddCommand(NewAuditCmd())
 	cmd.AddCommand(NewOutboxCmd())
 	cmd.AddCommand(NewWorldCmd())
+	cmd.AddCommand(NewFsckCmd())
+	cmd.AddCommand(NewImportCharactersCmd())
+	cmd.AddCommand(NewCheckCmd())
+	cmd.AddCommand(NewPolicyCmd())
 
 	return cmd
 }
@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	cryptotls "crypto/tls"
+	"database/sql"
 	"io"
 	"log/slog"
 	"net"
@@ -18,16 +19,21 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/nats-io/nats.go/jetstream"
 	"github.com/prometheus/client_golang/prometheus"
 
 	abacsetup "github.com/holomush/holomush/internal/access/setup"
+	"github.com/holomush/holomush/internal/ambient"
+	accessaudit "github.com/holomush/holomush/internal/audit"
 	"github.com/holomush/holomush/internal/auth"
 	authpostgres "github.com/holomush/holomush/internal/auth/postgres"
 	authsetup "github.com/holomush/holomush/internal/auth/setup"
 	bootstrapsetup "github.com/holomush/holomush/internal/bootstrap/setup"
 	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/command/handlers"
 	"github.com/holomush/holomush/internal/config"
+	"github.com/holomush/holomush/internal/connhistory"
 	"github.com/holomush/holomush/internal/content"
 	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/eventbus"
@@ -38,24 +44,37 @@ import (
 	"github.com/holomush/holomush/internal/eventbus/crypto/dek"
 	"github.com/holomush/holomush/internal/eventbus/history"
 	"github.com/holomush/holomush/internal/eventbus/history/source"
+	"github.com/holomush/holomush/internal/gameclock"
 	holoGRPC "github.com/holomush/holomush/internal/grpc"
 	holoFocus "github.com/holomush/holomush/internal/grpc/focus"
 	"github.com/holomush/holomush/internal/grpc/focus/scenepolicy"
+	"github.com/holomush/holomush/internal/grpc/streamrelay"
+	"github.com/holomush/holomush/internal/httpapi"
+	"github.com/holomush/holomush/internal/jobs"
 	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/internal/motd"
 	"github.com/holomush/holomush/internal/naming"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/internal/paging"
 	plugins "github.com/holomush/holomush/internal/plugin"
 	"github.com/holomush/holomush/internal/plugin/cryptowiring"
 	pluginsetup "github.com/holomush/holomush/internal/plugin/setup"
+	"github.com/holomush/holomush/internal/preferences"
 	"github.com/holomush/holomush/internal/presence"
+	"github.com/holomush/holomush/internal/scheduler"
 	"github.com/holomush/holomush/internal/session"
 	sessionsetup "github.com/holomush/holomush/internal/session/setup"
 	"github.com/holomush/holomush/internal/settings"
 	"github.com/holomush/holomush/internal/store"
 	"github.com/holomush/holomush/internal/sysbroadcast"
 	"github.com/holomush/holomush/internal/telnet"
+	"github.com/holomush/holomush/internal/webhook"
 	"github.com/holomush/holomush/internal/world"
 	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
+	"github.com/holomush/holomush/internal/world/propevents"
 	worldsetup "github.com/holomush/holomush/internal/world/setup"
+	"github.com/holomush/holomush/internal/world/verbevents"
+	"github.com/holomush/holomush/internal/world/zoneevents"
 	contentv1 "github.com/holomush/holomush/pkg/proto/holomush/content/v1"
 	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
 	pluginv1 "github.com/holomush/holomush/pkg/proto/holomush/plugin/v1"
@@ -84,6 +103,10 @@ type grpcSubsystemConfig struct {
 	// seam exists so tests can shorten the interval to make the
 	// neither-reaper-runs-after-Prepare-alone test deterministic.
 	GuestReaperInterval time.Duration
+	// AFKAfter is the idle time after which a character is marked AFK and
+	// an afk event is emitted to its location. Defaults to 15 minutes when
+	// zero (presence.ActivityConfig default).
+	AFKAfter time.Duration
 	// TLSConfig is a given value for callers that already hold a resolved
 	// TLS config at construction (test literals). TLSProvider, resolved
 	// once at the top of Start, is the production path — it wins when
@@ -129,6 +152,11 @@ type grpcSubsystemConfig struct {
 	// fall back to identity decoding.
 	KeySelector codec.KeySelector
 
+	// RateLimiter throttles commands per session. Nil (the default, when
+	// command_rate_burst is 0) disables command rate limiting. The caller
+	// owns it and closes it after the subsystem stops.
+	RateLimiter *command.RateLimiter
+
 	// CryptoWiring is the memoized cryptoWiring builder (cryptowiring.go).
 	// grpcSubsystem is the one consumer in package main, so it may hold
 	// func() (*cryptoWiring, error) directly rather than a narrow
@@ -144,6 +172,10 @@ type grpcSubsystemConfig struct {
 	// the Coordinator's shutdown (07-09 item 4 — replaces the ad-hoc
 	// runCore-level defer with orchestrator-ordered Stop).
 	CoordHolder *coordHolder
+
+	// AdminAPI, when set, is bound to the admin dashboard API in Prepare.
+	// Publish throughput is then metered on the wrapped publisher.
+	AdminAPI *adminAPIRoute
 }
 
 // grpcSubsystem is the terminal subsystem that wires the gRPC server.
@@ -157,6 +189,19 @@ type grpcSubsystem struct {
 	reaperCancel  context.CancelFunc
 	guestReaper   *auth.GuestReaper
 	sessionReaper *session.Reaper
+	activity      *presence.ActivityTracker
+	scheduler     *scheduler.Scheduler
+	jobs          *jobs.Queue
+	webhooks      *webhook.Dispatcher
+	motd          *motd.Service
+	ambient       *ambient.Service
+	daylight      *gameclock.Announcer
+	npcs          *npc.Service
+	paging        *paging.Service
+	preferences   *preferences.Service
+	streamRelay   *streamrelay.NATSRelay
+	// auditDB backs the admin API's denial reader; closed in Stop.
+	auditDB *sql.DB
 }
 
 // sceneMuteNotifyCacheTTL bounds how long a character's {globalNotifyEnabled,
@@ -234,12 +279,17 @@ func cryptoActiveFor(cfg grpcSubsystemConfig) bool { return cfg.RekeyManager !=
 // When a DEK manager (RekeyManager) is configured, the publisher is DEK-aware
 // so Sensitive=true events take the encrypt branch (publisher.go:208); when
 // nil, the publisher stays plaintext-only and the subsystem still starts
-// (degraded-but-safe for KEK-less deployments).
-func publisherOptionsFor(cfg grpcSubsystemConfig) []eventbus.PublishOption {
+// (degraded-but-safe for KEK-less deployments). A non-nil encrypted set also
+// encrypts every event on the contexts it holds (private scenes).
+func publisherOptionsFor(cfg grpcSubsystemConfig, encrypted *eventbus.EncryptedContextSet) []eventbus.PublishOption {
 	if cfg.RekeyManager == nil {
 		return nil
 	}
-	return []eventbus.PublishOption{eventbus.WithDEKManager(cfg.RekeyManager)}
+	opts := []eventbus.PublishOption{eventbus.WithDEKManager(cfg.RekeyManager)}
+	if encrypted != nil {
+		opts = append(opts, eventbus.WithEncryptedContexts(encrypted))
+	}
+	return opts
 }
 
 // subscriberOptionsFor returns the SubscribeOptions for the live subscriber.
@@ -342,7 +392,13 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 		return oops.Code("GRPC_EVENTBUS_MISSING").
 			Errorf("gRPC subsystem requires EventBus subsystem for plugin emit routing")
 	}
-	rawPublisher := s.cfg.EventBus.Publisher(publisherOptionsFor(s.cfg)...)
+	// Private scenes are encrypted at rest; without a KEK nothing is, so
+	// the set only exists when crypto is active.
+	var encryptedContexts *eventbus.EncryptedContextSet
+	if cryptoActiveFor(s.cfg) {
+		encryptedContexts = eventbus.NewEncryptedContextSet(dek.NewEncryptedContextRepo(pool))
+	}
+	rawPublisher := s.cfg.EventBus.Publisher(publisherOptionsFor(s.cfg, encryptedContexts)...)
 	if rawPublisher == nil {
 		return oops.Code("GRPC_EVENTBUS_NOT_STARTED").
 			Errorf("EventBus publisher is nil; subsystem not started")
@@ -352,11 +408,51 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// Outbound webhooks see every event published through the wrapped
+	// publisher, so everything below is tapped; the delivery workers
+	// launch in Activate.
+	if s.webhooks = s.cfg.Plugins.Webhooks(); s.webhooks != nil {
+		publisher = s.webhooks.Tap(publisher)
+	}
+	// NPC behaviors react to what characters do in their locations, so
+	// they see the same events; the tick loop launches in Activate.
+	if s.npcs = s.cfg.Plugins.NPCs(); s.npcs != nil {
+		publisher = s.npcs.Tap(publisher)
+	}
+	// The admin dashboard API reads publish throughput from this wrapper,
+	// so it counts every event published below.
+	if s.cfg.AdminAPI != nil {
+		throughput := eventbus.NewThroughputMeter(publisher, 0)
+		publisher = throughput
+		s.auditDB = stdlib.OpenDBFromPool(pool)
+		s.cfg.AdminAPI.bind(httpapi.NewHandler(httpapi.Config{
+			PlayerSessions: authPlayerSessionRepo,
+			Sessions:       sessionStore,
+			Engine:         policyEngine,
+			Denials:        accessaudit.NewPostgresReader(s.auditDB),
+			Plugins:        pluginManager,
+			Throughput:     throughput,
+			Entities:       worldpostgres.NewEntityCounter(pool),
+		}))
+	}
+	// Encryption at rest is decided outermost, so the webhook and NPC taps
+	// above already see a private scene's events as Sensitive and skip them.
+	if encryptedContexts != nil {
+		publisher = eventbus.NewEncryptedContextPublisher(publisher, encryptedContexts, s.cfg.RekeyManager)
+	}
 
-	pluginManager.ConfigureEventEmitter(
-		publisher,
+	emitterOpts := []plugins.EmitterOption{
 		plugins.WithGameID(s.cfg.EventBus.GameID),
-	)
+		plugins.WithPayloadLimits(s.cfg.EventBus.Config().Payload),
+	}
+	// Location and scene posting rules are checked on every plugin event
+	// before it reaches the publisher; approved held posts go out over the
+	// same wrapped publisher.
+	if postingRules := s.cfg.Plugins.Posting(); postingRules != nil {
+		emitterOpts = append(emitterOpts, plugins.WithPostingGate(postingRules))
+		s.cfg.Plugins.ConfigurePosting(publisher)
+	}
+	pluginManager.ConfigureEventEmitter(publisher, emitterOpts...)
 
 	// bus is the one game-id source shared by every closure below — the
 	// presence emitter, the SessionAdmin broadcast backing, and (Task 3)
@@ -371,6 +467,72 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	// subsystem started, so this cannot be a host construction-time option.
 	s.cfg.Plugins.ConfigureSystemBroadcaster(publisher, func() string { return bus.GameID() })
 
+	// Server-side dice rolls and stat checks for holomush.roll,
+	// holomush.check, and holomush.contest publish over the same wrapped
+	// publisher, for the same late-binding reason.
+	s.cfg.Plugins.ConfigureDiceRoller(publisher, func() string { return bus.GameID() })
+
+	// Scheduled world events publish over the same wrapped publisher; the
+	// tick loop launches in Activate alongside the reapers.
+	s.cfg.Plugins.ConfigureScheduler(publisher, func() string { return bus.GameID() })
+	s.scheduler = s.cfg.Plugins.Scheduler()
+
+	// Login notices and announcement broadcasts publish over the same
+	// wrapped publisher; the announcement tick loop launches in Activate.
+	s.cfg.Plugins.ConfigureMOTD(publisher, func() string { return bus.GameID() })
+	s.motd = s.cfg.Plugins.MOTD()
+
+	// Ambient location lines publish over the same wrapped publisher and
+	// are only sent where the session store shows someone present; the
+	// tick loop launches in Activate.
+	s.cfg.Plugins.ConfigureAmbient(publisher, func() string { return bus.GameID() }, sessionStore)
+	s.ambient = s.cfg.Plugins.Ambient()
+
+	// Dawn and dusk reach outdoor locations over the same wrapped
+	// publisher; every replica announces them under the same event IDs, so
+	// the bus keeps one copy. The loop launches in Activate.
+	s.cfg.Plugins.ConfigureDaylight(publisher, func() string { return bus.GameID() })
+	s.daylight = s.cfg.Plugins.Daylight()
+
+	// NPCs wander and speak over the same wrapped publisher; their
+	// behaviors run in the plugin Manager.
+	s.cfg.Plugins.ConfigureNPCs(publisher, func() string { return bus.GameID() })
+
+	// Economy transactions announce currency_transfer events over the same
+	// wrapped publisher.
+	s.cfg.Plugins.ConfigureEconomy(publisher, func() string { return bus.GameID() })
+
+	// Pages and their receipts travel over the same wrapped publisher;
+	// pages held for offline characters are delivered at login.
+	s.cfg.Plugins.ConfigurePaging(publisher, func() string { return bus.GameID() })
+	s.paging = s.cfg.Plugins.Paging()
+
+	// Client preference changes, and the snapshot a fresh session starts
+	// from, reach transports over the same wrapped publisher.
+	s.cfg.Plugins.ConfigurePreferences(publisher, func() string { return bus.GameID() })
+	s.preferences = s.cfg.Plugins.Preferences()
+
+	// Report status notifications reach reporters over the same wrapped
+	// publisher.
+	s.cfg.Plugins.ConfigureReports(publisher, func() string { return bus.GameID() })
+
+	// Background jobs tell their submitters they finished over the same
+	// wrapped publisher; the workers launch in Activate. The world check
+	// is the built-in job kind: a report-only consistency scan.
+	s.cfg.Plugins.ConfigureJobs(publisher, func() string { return bus.GameID() })
+	// Dead letters requeue through the plugin manager and alert staff on
+	// the moderation channel.
+	s.cfg.Plugins.ConfigureDeadLetters(publisher, func() string { return bus.GameID() })
+	if s.jobs = s.cfg.Plugins.Jobs(); s.jobs != nil {
+		if err := s.jobs.Register(jobs.KindWorldCheck, jobs.NewWorldCheckHandler(s.cfg.World.Checker())); err != nil {
+			return oops.Code("JOB_REGISTER_FAILED").With("kind", jobs.KindWorldCheck).Wrap(err)
+		}
+	}
+
+	// Walks through exits announce their departure, arrival, and
+	// cancellation over the same wrapped publisher.
+	s.cfg.Plugins.ConfigureTraversal(publisher, func() string { return bus.GameID() })
+
 	// 1. Create the presence emitter (arrive/leave/session_ended) over the
 	// SAME wrapped publisher CoreServer.emitCommandResponse uses (never
 	// rawPublisher — the audit projection fails closed without the
@@ -378,10 +540,33 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	// as the qualification source (FINDING-5).
 	presenceEmitter := presence.NewEmitter(publisher, s.cfg.EventBus.GameID)
 
+	// Committed world property writes (name, description, lock, owner)
+	// publish property_changed on the entity's location stream for
+	// reactive plugins.
+	worldService.SetPropertyChangeHook(propevents.NewPublisher(publisher, s.cfg.EventBus.GameID))
+
+	// Zone broadcasts (weather, ambience, announcements) fan out as one
+	// zone_broadcast event per member location stream.
+	worldService.SetLocationBroadcaster(zoneevents.NewPublisher(publisher, s.cfg.EventBus.GameID))
+
+	// Object verbs ("push button") hand a verb:<name> event to the plugin
+	// bound to the verb.
+	worldService.SetObjectVerbDispatcher(verbevents.NewDispatcher(pluginManager))
+
 	// Wire game-session fanout into the auth service so evictions emit
 	// session_ended events for child game sessions before FK cascade removes them.
 	authService.ConfigureGameSessionFanout(presenceEmitter, sessionStore)
 
+	// Idle tracking emits afk/back through the same presence emitter, to
+	// the location the session is at when the event fires; its sweep loop
+	// launches in Activate alongside the reapers. A character whose session
+	// ends is no longer tracked.
+	s.activity = presence.NewActivityTracker(presence.ActivityConfig{
+		AFKAfter: s.cfg.AFKAfter,
+		Locator:  sessionStore,
+	}, presenceEmitter)
+	presenceEmitter.OnSessionEnded(s.activity.Forget)
+
 	// 2. Create gRPC server with TLS credentials.
 	// Install GRPCServiceProxy as UnknownServiceHandler so plugin-provided
 	// gRPC services are automatically forwarded through the service registry.
@@ -448,12 +633,23 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	// (MarkReaping + DeleteGuestPlayer, own pool). Both guest-deletion paths — the
 	// reaper and failed-guest cleanup — route through it.
 	reapPlayerRepo := authpostgres.NewPlayerRepository(pool)
+	// Reaping deletes characters outside the world service, so with the world
+	// cache enabled its deletes and transaction MUST go through the cache to
+	// evict the reaped characters and the objects they held.
+	var (
+		reapDeleter    auth.ReapingCharacterDeleter = charRepo
+		reapTransactor auth.GenesisTransactor       = transactor
+	)
+	if cache := s.cfg.World.Cache(); cache != nil {
+		reapDeleter = cache.Characters(charRepo)
+		reapTransactor = cache.Transactor(transactor)
+	}
 	reapingService, reapErr := auth.NewCharacterReapingService(
-		charRepo, // version-scanning ListByPlayer (R6-1)
-		charRepo, // guarded tombstone-delta Delete
+		charRepo,    // version-scanning ListByPlayer (R6-1)
+		reapDeleter, // guarded tombstone-delta Delete
 		worldpostgres.NewPropertyRepository(pool),
 		bindingRepo, // hard-deletes guest bindings in-tx (RESTRICT FK, guest teardown)
-		transactor,
+		reapTransactor,
 		worldpostgres.NewOutboxStore(pool),
 		reapPlayerRepo, // DeleteGuestPlayer (own pool, ordered after tombstones)
 		reapPlayerRepo, // MarkReaping (R6-2 anti-TOCTOU)
@@ -462,6 +658,17 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 		return oops.Code("CHARACTER_REAPING_SERVICE_FAILED").Wrap(reapErr)
 	}
 
+	// 5a''. Player data export and account deletion. Deletion anonymizes
+	// characters through the world service (so the envelope and any cache
+	// eviction follow the normal write path) rather than deleting them, since
+	// the events they authored are immutable.
+	authService.ConfigurePlayerData(auth.PlayerDataStores{
+		Characters: charRepo,
+		Properties: worldpostgres.NewPropertyRepository(pool),
+		Events:     authpostgres.NewAuthoredEventRepository(pool),
+		Anonymizer: worldService,
+	})
+
 	// 5b. Create guest service for gRPC-based guest login (web client). Guest
 	// creation commits the player first (own pool), then routes character +
 	// binding + envelope through the genesis service. Failed-guest cleanup routes
@@ -506,13 +713,17 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	if frErr != nil {
 		return oops.Code("FOCUS_REDIRECTS_INVALID").Wrap(frErr)
 	}
-	cmdDispatcher, cmdDispErr := command.NewDispatcher(
-		cmdRegistry, policyEngine,
+	dispOpts := []command.DispatcherOption{
 		command.WithAliasCache(aliasCache),
 		command.WithPluginDeliverer(pluginManager),
 		command.WithFocusReader(command.NewStoreFocusReader(sessionStore)),
 		command.WithFocusRedirects(focusRedirects),
-	)
+		command.WithVerbFallback(handlers.NewObjectVerbFallback(worldService)),
+	}
+	if s.cfg.RateLimiter != nil {
+		dispOpts = append(dispOpts, command.WithRateLimiter(s.cfg.RateLimiter))
+	}
+	cmdDispatcher, cmdDispErr := command.NewDispatcher(cmdRegistry, policyEngine, dispOpts...)
 	if cmdDispErr != nil {
 		return oops.Code("COMMAND_DISPATCHER_FAILED").Wrap(cmdDispErr)
 	}
@@ -602,13 +813,25 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 		historyAuthGuard, historyDEKMgr, historyAuditEm,
 		s.cfg.KeySelector, alwaysSensitive, cryptoKeysLookupForFence, violationEmitterForFence)
 
+	connHistory, err := connhistory.NewService(connhistory.NewPostgresStore(pool), policyEngine, slog.Default())
+	if err != nil {
+		return oops.Code("GRPC_CONNHISTORY_INIT_FAILED").Wrap(err)
+	}
+
 	coreServerOpts := []holoGRPC.CoreServerOption{
 		// The wrapped publisher (RenderingPublisher) is used here — NEVER
 		// rawPublisher — so command_response/command_error events carry the
 		// App-Rendering header the audit projection requires (round 7, MEDIUM).
 		holoGRPC.WithEventPublisher(publisher, s.cfg.EventBus.GameID),
 		holoGRPC.WithWorldQuerier(worldService),
+		holoGRPC.WithCharacterVisibility(worldService),
+		holoGRPC.WithActivityTracker(s.activity),
+		holoGRPC.WithConnectionRecorder(connHistory),
 		holoGRPC.WithAuthService(authService),
+		holoGRPC.WithIdentityService(authService),
+		holoGRPC.WithWebSessions(authService),
+		holoGRPC.WithImpersonation(authService),
+		holoGRPC.WithAddressBans(s.cfg.Auth.Bans()),
 		holoGRPC.WithResetService(resetService),
 		holoGRPC.WithCharacterService(characterService),
 		holoGRPC.WithPlayerSessionRepo(authPlayerSessionRepo),
@@ -645,7 +868,24 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 	}
 	if s.cfg.StreamRegistry != nil {
 		coreServerOpts = append(coreServerOpts, holoGRPC.WithStreamRegistry(s.cfg.StreamRegistry))
+		s.startStreamRelay(ctx, bus)
+	}
+	if s.motd != nil {
+		coreServerOpts = append(coreServerOpts, holoGRPC.WithLoginNotices(s.motd))
+	}
+	if s.npcs != nil {
+		coreServerOpts = append(coreServerOpts, holoGRPC.WithNPCPresence(s.npcs))
+	}
+	if s.paging != nil {
+		coreServerOpts = append(coreServerOpts, holoGRPC.WithHeldPages(s.paging), holoGRPC.WithIgnoreChecker(s.paging))
 	}
+	if s.preferences != nil {
+		coreServerOpts = append(coreServerOpts, holoGRPC.WithClientPreferences(s.preferences))
+	}
+	systemBroadcaster := sysbroadcast.NewBroadcaster(publisher, func() string { return bus.GameID() })
+	coreServerOpts = append(coreServerOpts,
+		holoGRPC.WithModerationEvents(systemBroadcaster),
+		holoGRPC.WithImpersonationNotices(authService, systemBroadcaster))
 
 	// 8a. Create focus.Coordinator.
 	gameSettings := settings.NewGameSettings(&settings.SystemInfoAdapter{
@@ -829,6 +1069,7 @@ func (s *grpcSubsystem) Prepare(ctx context.Context) error {
 				slog.WarnContext(reaperCtx, "reaper: session_ended event failed",
 					"session_id", info.ID, "error", endErr)
 			}
+			authService.RecordSessionExpired(reaperCtx, info.PlayerID, info.ID)
 			if info.IsGuest {
 				guestAuth.ReleaseGuest(info.CharacterName)
 			}
@@ -885,6 +1126,30 @@ func (s *grpcSubsystem) Activate(ctx context.Context) error {
 
 	go s.sessionReaper.Run(s.reaperCtx)
 	go s.guestReaper.Run(s.reaperCtx)
+	if s.activity != nil {
+		go s.activity.Run(s.reaperCtx)
+	}
+	if s.scheduler != nil {
+		go s.scheduler.Run(s.reaperCtx)
+	}
+	if s.jobs != nil {
+		go s.jobs.Run(s.reaperCtx)
+	}
+	if s.webhooks != nil {
+		go s.webhooks.Run(s.reaperCtx)
+	}
+	if s.motd != nil {
+		go s.motd.Run(s.reaperCtx)
+	}
+	if s.ambient != nil {
+		go s.ambient.Run(s.reaperCtx)
+	}
+	if s.daylight != nil {
+		go s.daylight.Run(s.reaperCtx)
+	}
+	if s.npcs != nil {
+		go s.npcs.Run(s.reaperCtx)
+	}
 
 	// Bind TCP listener.
 	var err error
@@ -965,9 +1230,44 @@ func (s *grpcSubsystem) Stop(ctx context.Context) error {
 			slog.WarnContext(ctx, "invalidation.Coordinator stop error", "error", stopErr)
 		}
 	}
+	if s.auditDB != nil {
+		if err := s.auditDB.Close(); err != nil {
+			slog.DebugContext(ctx, "error closing admin API audit reader", "error", err)
+		}
+		s.auditDB = nil
+	}
+	if s.streamRelay != nil {
+		s.cfg.StreamRegistry.SetRelay(nil)
+		if stopErr := s.streamRelay.Stop(); stopErr != nil {
+			slog.WarnContext(ctx, "session stream relay stop error", "error", stopErr)
+		}
+		s.streamRelay = nil
+	}
 	return nil
 }
 
+// startStreamRelay connects the session stream registry to the other core
+// replicas over the EventBus NATS connection, so a stream add or remove for
+// a session whose Subscribe stream lives on another replica still reaches
+// it. A failure degrades to local-only delivery, which is exact for a
+// single-replica deployment; it is logged rather than failing boot.
+func (s *grpcSubsystem) startStreamRelay(ctx context.Context, bus *eventbus.Subsystem) {
+	if s.streamRelay != nil {
+		return
+	}
+	conn := bus.Conn()
+	if conn == nil {
+		return
+	}
+	relay := streamrelay.NewNATSRelay(conn, bus.GameID())
+	if err := relay.Start(s.cfg.StreamRegistry.DeliverRelayed); err != nil {
+		slog.WarnContext(ctx, "session stream relay unavailable; stream updates stay process-local", "error", err)
+		return
+	}
+	s.cfg.StreamRegistry.SetRelay(relay)
+	s.streamRelay = relay
+}
+
 // sessionStoreMovementHook implements world.MovementHook by delegating to
 // session.Store.UpdateLocationOnMove. Wired at gRPC subsystem startup so
 // character moves propagate LocationID + LocationArrivedAt to active sessions
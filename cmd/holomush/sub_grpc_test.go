@@ -34,12 +34,18 @@ type (
 // Verifies: INV-CRYPTO-117
 func TestPublisherOptionsIncludeDEKManagerWhenRekeySet(t *testing.T) {
 	cfg := grpcSubsystemConfig{RekeyManager: &stubDEKManager{}}
-	require.Len(t, publisherOptionsFor(cfg), 1, "RekeyManager set ⇒ exactly the WithDEKManager option")
+	require.Len(t, publisherOptionsFor(cfg, nil), 1, "RekeyManager set ⇒ exactly the WithDEKManager option")
+}
+
+func TestPublisherOptionsIncludeEncryptedContextsWhenSetGiven(t *testing.T) {
+	cfg := grpcSubsystemConfig{RekeyManager: &stubDEKManager{}}
+	require.Len(t, publisherOptionsFor(cfg, eventbus.NewEncryptedContextSet(nil)), 2,
+		"encrypted set ⇒ WithDEKManager plus WithEncryptedContexts")
 }
 
 // Verifies: INV-CRYPTO-117
 func TestPublisherOptionsEmptyWhenRekeyNil(t *testing.T) {
-	require.Empty(t, publisherOptionsFor(grpcSubsystemConfig{RekeyManager: nil}),
+	require.Empty(t, publisherOptionsFor(grpcSubsystemConfig{RekeyManager: nil}, eventbus.NewEncryptedContextSet(nil)),
 		"no KEK ⇒ plaintext-only publisher, no DEK option")
 }
 
@@ -17,6 +17,11 @@ import (
 // to authorize break-glass operations.
 const CapabilityCryptoOperator = "crypto.operator"
 
+// CapabilityImpersonate is the grant string that lets support staff open an
+// impersonation session as another player (auth.Service.Impersonate). Held
+// by players in the auth.impersonators config allow-list.
+const CapabilityImpersonate = "support.impersonate"
+
 // PlayerGrantsAttribute is the bag-key under which PlayerAttributeProvider
 // publishes a player's grant set into the Subject attribute bag. This
 // constant is the contract between HasPlayerGrant (consumer) and the
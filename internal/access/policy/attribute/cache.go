@@ -99,12 +99,24 @@ func getCacheFromContext(ctx context.Context) *attributeCache {
 	return newAttributeCache(100)
 }
 
-// withCache returns a new context with a cache attached
+// withCache returns a context with a cache attached. A cache already attached
+// by WithSharedCache is reused so a batch of evaluations shares lookups.
 func withCache(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(cacheContextKey).(*attributeCache); ok {
+		return ctx
+	}
 	cache := newAttributeCache(100)
 	return context.WithValue(ctx, cacheContextKey, cache)
 }
 
+// WithSharedCache returns a context whose attribute cache is shared by every
+// resolution made with it. Batch evaluation uses it so the subject's
+// attributes are resolved once for the whole batch rather than per request.
+// The cache lives as long as the context; do not hold it across requests.
+func WithSharedCache(ctx context.Context) context.Context {
+	return withCache(ctx)
+}
+
 // isInResolution checks if resolution is already in progress
 func isInResolution(ctx context.Context) bool {
 	if inResolution, ok := ctx.Value(inResolutionKey).(bool); ok {
@@ -110,15 +110,14 @@ func (p *CharacterProvider) resolve(ctx context.Context, entityID string) (map[s
 			Wrapf(err, "failed to fetch character")
 	}
 
-	// Resolve roles from role resolver
-	var roles []string
+	// Resolve assigned roles from role resolver and expand them through the
+	// role hierarchy, so an admin also matches policies written for builders.
+	var assigned []string
 	if p.roleResolver != nil {
 		subjectID := access.CharacterSubject(char.ID.String())
-		roles = p.roleResolver.GetRoles(ctx, subjectID)
-	}
-	if len(roles) == 0 {
-		roles = []string{access.RolePlayer}
+		assigned = p.roleResolver.GetRoles(ctx, subjectID)
 	}
+	roles := access.EffectiveRoles(assigned)
 
 	// Map character fields to attributes
 	attrs := map[string]any{
@@ -127,6 +126,7 @@ func (p *CharacterProvider) resolve(ctx context.Context, entityID string) (map[s
 		"name":        char.Name,
 		"description": char.Description,
 		"roles":       roles,
+		"visibility":  string(char.Visibility.Normalize()),
 	}
 
 	// Handle optional location — expose as both "location_id" (raw) and "location" (for seed policies).
@@ -188,6 +188,7 @@ func (p *CharacterProvider) Schema() *types.NamespaceSchema {
 			"name":         types.AttrTypeString,
 			"description":  types.AttrTypeString,
 			"roles":        types.AttrTypeStringList,
+			"visibility":   types.AttrTypeString,
 			"location_id":  types.AttrTypeString,
 			"location":     types.AttrTypeString,
 			"has_location": types.AttrTypeBool,
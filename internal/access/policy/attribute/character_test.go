@@ -54,8 +54,8 @@ func (m *mockCharacterRepository) Delete(_ context.Context, _ ulid.ULID, _ int)
 	return nil, errors.New("not implemented")
 }
 
-func (m *mockCharacterRepository) GetByLocation(_ context.Context, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, errors.New("not implemented")
+func (m *mockCharacterRepository) GetByLocation(_ context.Context, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, errors.New("not implemented")
 }
 
 func (m *mockCharacterRepository) UpdateLocation(_ context.Context, _ ulid.ULID, _ *ulid.ULID, _ int) (*wmodel.MutationDelta, error) {
@@ -66,6 +66,18 @@ func (m *mockCharacterRepository) UpdatePreferences(_ context.Context, _ ulid.UL
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterRepository) SetTags(_ context.Context, _ ulid.ULID, _ []string, _ int) (*wmodel.MutationDelta, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCharacterRepository) FindByName(_ context.Context, _ string) (*world.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCharacterRepository) ListByTag(_ context.Context, _ string) ([]*world.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCharacterRepository) IsOwnedByPlayer(_ context.Context, _, _ ulid.ULID) (bool, error) {
 	return false, errors.New("not implemented")
 }
@@ -92,6 +104,7 @@ func TestCharacterProviderSchema(t *testing.T) {
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["name"])
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["description"])
 	assert.Equal(t, types.AttrTypeStringList, schema.Attributes["roles"])
+	assert.Equal(t, types.AttrTypeString, schema.Attributes["visibility"])
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["location_id"])
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["location"])
 	assert.Equal(t, types.AttrTypeBool, schema.Attributes["has_location"])
@@ -136,6 +149,7 @@ func TestCharacterProvider_ResolveSubject(t *testing.T) {
 				"name":         "TestChar",
 				"description":  "A test character",
 				"roles":        []string{"player"},
+				"visibility":   "visible",
 				"location_id":  locationID.String(),
 				"location":     locationID.String(),
 				"has_location": true,
@@ -168,6 +182,7 @@ func TestCharacterProvider_ResolveSubject(t *testing.T) {
 				"name":         "NoLocChar",
 				"description":  "",
 				"roles":        []string{"player"},
+				"visibility":   "visible",
 				"has_location": false,
 				// nil kindLookup → is_guest omitted, witness false (ADR holomush-ti1b).
 				"has_is_guest": false,
@@ -380,22 +395,22 @@ func TestCharacterProvider_RoleResolution(t *testing.T) {
 			expectedRoles: []string{"player"},
 		},
 		{
-			name: "admin role from resolver",
+			name: "admin role from resolver inherits the hierarchy",
 			roleResolver: &mockRoleResolver{
 				roles: map[string][]string{
 					"character:" + charID.String(): {"admin"},
 				},
 			},
-			expectedRoles: []string{"admin"},
+			expectedRoles: []string{"player", "builder", "gm", "admin"},
 		},
 		{
-			name: "builder role from resolver",
+			name: "builder role from resolver inherits player",
 			roleResolver: &mockRoleResolver{
 				roles: map[string][]string{
 					"character:" + charID.String(): {"builder"},
 				},
 			},
-			expectedRoles: []string{"builder"},
+			expectedRoles: []string{"player", "builder"},
 		},
 		{
 			name: "multiple roles from resolver",
@@ -404,7 +419,16 @@ func TestCharacterProvider_RoleResolution(t *testing.T) {
 					"character:" + charID.String(): {"builder", "admin"},
 				},
 			},
-			expectedRoles: []string{"builder", "admin"},
+			expectedRoles: []string{"player", "builder", "gm", "admin"},
+		},
+		{
+			name: "custom role from resolver inherits nothing",
+			roleResolver: &mockRoleResolver{
+				roles: map[string][]string{
+					"character:" + charID.String(): {"staff"},
+				},
+			},
+			expectedRoles: []string{"player", "staff"},
 		},
 	}
 
@@ -470,6 +494,7 @@ func TestCharacterProvider_ResolveResource(t *testing.T) {
 				"name":         "ResourceChar",
 				"description":  "Character as resource",
 				"roles":        []string{"player"},
+				"visibility":   "visible",
 				"location_id":  locationID.String(),
 				"location":     locationID.String(),
 				"has_location": true,
@@ -42,10 +42,30 @@ func (m *mockLocationRepository) Delete(_ context.Context, _ ulid.ULID, _ int) (
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockLocationRepository) GetMany(_ context.Context, _ []ulid.ULID) ([]*world.Location, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockLocationRepository) ListByType(_ context.Context, _ world.LocationType) ([]*world.Location, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockLocationRepository) ListByZone(_ context.Context, _ string) ([]*world.Location, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockLocationRepository) ListChildren(_ context.Context, _ ulid.ULID) ([]*world.Location, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockLocationRepository) ListByTag(_ context.Context, _ string) ([]*world.Location, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockLocationRepository) SetTags(_ context.Context, _ ulid.ULID, _ []string, _ int) (*wmodel.MutationDelta, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockLocationRepository) GetShadowedBy(_ context.Context, _ ulid.ULID) ([]*world.Location, error) {
 	return nil, errors.New("not implemented")
 }
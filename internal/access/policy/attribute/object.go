@@ -108,6 +108,7 @@ func (p *ObjectProvider) ResolveResource(ctx context.Context, resourceID string)
 		"name":         obj.Name,
 		"description":  obj.Description,
 		"is_container": obj.IsContainer,
+		"locked":       obj.Locked,
 	}
 
 	if obj.OwnerID != nil {
@@ -266,6 +267,7 @@ func (p *ObjectProvider) Schema() *types.NamespaceSchema {
 			"location":               types.AttrTypeString,
 			"has_location":           types.AttrTypeBool,
 			"is_container":           types.AttrTypeBool,
+			"locked":                 types.AttrTypeBool,
 			"held_by_character_id":   types.AttrTypeString,
 			"is_held":                types.AttrTypeBool,
 			"contained_in_object_id": types.AttrTypeString,
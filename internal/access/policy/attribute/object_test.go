@@ -58,6 +58,14 @@ func (m *mockObjectRepository) Move(_ context.Context, _ ulid.ULID, _ world.Cont
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockObjectRepository) ListByTag(_ context.Context, _ string) ([]*world.Object, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockObjectRepository) SetTags(_ context.Context, _ ulid.ULID, _ []string, _ int) (*wmodel.MutationDelta, error) {
+	return nil, errors.New("not implemented")
+}
+
 // newObjectInLocation builds a test Object directly in a location.
 // Uses the unexported field-injection path via SetContainment to bypass NewObject's name validation.
 func newObjectInLocation(t *testing.T, id, locationID ulid.ULID, name string) *world.Object {
@@ -122,6 +130,7 @@ func TestObjectProviderSchema(t *testing.T) {
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["location"])
 	assert.Equal(t, types.AttrTypeBool, schema.Attributes["has_location"])
 	assert.Equal(t, types.AttrTypeBool, schema.Attributes["is_container"])
+	assert.Equal(t, types.AttrTypeBool, schema.Attributes["locked"])
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["held_by_character_id"])
 	assert.Equal(t, types.AttrTypeBool, schema.Attributes["is_held"])
 	assert.Equal(t, types.AttrTypeString, schema.Attributes["contained_in_object_id"])
@@ -185,6 +194,7 @@ func TestObjectProviderResolveResource(t *testing.T) {
 				"location":               locID.String(),
 				"has_location":           true,
 				"is_container":           false,
+				"locked":                 false,
 				"held_by_character_id":   "",
 				"is_held":                false,
 				"contained_in_object_id": "",
@@ -198,11 +208,13 @@ func TestObjectProviderResolveResource(t *testing.T) {
 				m.getFunc = func(_ context.Context, _ ulid.ULID) (*world.Object, error) {
 					obj := newObjectInLocation(t, objID, locID, "Chest")
 					obj.IsContainer = true
+					obj.Locked = true
 					return obj, nil
 				}
 			},
 			expectSubset: map[string]any{
 				"is_container": true,
+				"locked":       true,
 				"has_owner":    false,
 				"owner_id":     "",
 				"location":     locID.String(),
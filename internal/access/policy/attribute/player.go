@@ -37,6 +37,20 @@ func WithPlayerKindLookup(fn PlayerKindLookup) PlayerAttributeProviderOption {
 	}
 }
 
+// WithImpersonators grants CapabilityImpersonate to the listed player IDs.
+// Like the operator allow-list, the set is captured at construction and is
+// read-only thereafter.
+func WithImpersonators(playerIDs []string) PlayerAttributeProviderOption {
+	return func(p *PlayerAttributeProvider) {
+		for _, id := range playerIDs {
+			if id == "" {
+				continue
+			}
+			p.impersonators[id] = struct{}{}
+		}
+	}
+}
+
 // PlayerAttributeProvider exposes player-level attributes for ABAC subject
 // resolution. v1 schema: player.id, player.grants, player.is_guest,
 // player.has_is_guest. The grant set is captured at construction time from the
@@ -50,6 +64,9 @@ type PlayerAttributeProvider struct {
 	// Membership is captured at construction; no method on this type writes
 	// to this map (INV-B6).
 	operators map[string]struct{}
+	// impersonators is the set of player ULIDs that hold
+	// CapabilityImpersonate. Captured at construction like operators.
+	impersonators map[string]struct{}
 	// kindLookup is an optional func that resolves is_guest for a player ID.
 	// When nil the provider omits the is_guest key (has_is_guest=false) per
 	// the omit-don't-sentinel invariant (ADR holomush-ti1b).
@@ -69,7 +86,7 @@ func NewPlayerAttributeProvider(operatorPlayerIDs []string, opts ...PlayerAttrib
 		}
 		set[id] = struct{}{}
 	}
-	p := &PlayerAttributeProvider{operators: set}
+	p := &PlayerAttributeProvider{operators: set, impersonators: map[string]struct{}{}}
 	for _, opt := range opts {
 		opt(p)
 	}
@@ -145,7 +162,10 @@ func (p *PlayerAttributeProvider) ResolveSubject(ctx context.Context, subjectID
 
 	grants := []string{}
 	if _, ok := p.operators[idStr]; ok {
-		grants = []string{access.CapabilityCryptoOperator}
+		grants = append(grants, access.CapabilityCryptoOperator)
+	}
+	if _, ok := p.impersonators[idStr]; ok {
+		grants = append(grants, access.CapabilityImpersonate)
 	}
 
 	attrs := map[string]any{
@@ -90,6 +90,19 @@ func TestPlayerProviderResolveSubjectNonOperator(t *testing.T) {
 	assert.Empty(t, grants)
 }
 
+func TestPlayerProviderResolveSubjectImpersonator(t *testing.T) {
+	p := NewPlayerAttributeProvider([]string{testOperatorULID},
+		WithImpersonators([]string{testOperatorULID, testNonOperatorULID, ""}))
+
+	attrs, err := p.ResolveSubject(context.Background(), "player:"+testOperatorULID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{access.CapabilityCryptoOperator, access.CapabilityImpersonate}, attrs["grants"])
+
+	attrs, err = p.ResolveSubject(context.Background(), "player:"+testNonOperatorULID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{access.CapabilityImpersonate}, attrs["grants"])
+}
+
 func TestPlayerProviderResolveSubjectNonPlayerNamespace(t *testing.T) {
 	p := NewPlayerAttributeProvider([]string{testOperatorULID})
 
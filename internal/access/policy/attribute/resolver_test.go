@@ -477,6 +477,33 @@ func TestResolverResolvePerRequestCache(t *testing.T) {
 	assert.Equal(t, "admin", bags.Resource["character.role"])
 }
 
+func TestResolverResolveSharedCache(t *testing.T) {
+	registry := NewSchemaRegistry()
+	resolver := NewResolver(registry)
+
+	provider := newResolverMockAttributeProvider("character")
+	provider.subjectData["character:01ABC"] = map[string]any{"role": "admin"}
+	require.NoError(t, resolver.RegisterProvider(provider))
+
+	resolveBoth := func(ctx context.Context) {
+		for _, resource := range []string{"character:01DEF", "character:01GHI"} {
+			_, err := resolver.Resolve(ctx, types.AccessRequest{
+				Subject: "character:01ABC", Action: "read", Resource: resource,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// Without a shared cache each Resolve starts fresh.
+	resolveBoth(context.Background())
+	assert.Equal(t, 2, provider.callCount["subject:character:01ABC"])
+
+	// A shared cache resolves the common subject once for the batch.
+	resolveBoth(WithSharedCache(context.Background()))
+	assert.Equal(t, 3, provider.callCount["subject:character:01ABC"])
+	assert.Equal(t, 2, provider.callCount["resource:character:01DEF"])
+}
+
 func TestResolverResolveReEntranceGuard(t *testing.T) {
 	registry := NewSchemaRegistry()
 	resolver := NewResolver(registry)
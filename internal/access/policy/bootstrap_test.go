@@ -345,6 +345,7 @@ func TestBootstrapSetsCorrectPolicyEffect(t *testing.T) {
 
 	expectedForbids := map[string]bool{
 		"seed:property-restricted-excluded":                    true,
+		"seed:object-locked-owner-only":                        true,
 		"seed:deny-audit-read-character":                       true,
 		"seed:deny-audit-read-plugin":                          true,
 		"seed:deny-events-system-crypto-totp-read-character":   true,
@@ -362,7 +363,7 @@ func TestBootstrapSetsCorrectPolicyEffect(t *testing.T) {
 				"unexpected forbid policy: %q", created.Name)
 		}
 	}
-	assert.Equal(t, 9, forbidCount, "expected 9 forbid policies (+2 phase-5 sub-epic A events.*.system.crypto_totp.* denies + 2 phase-5 sub-epic D events.*.system.crypto_policy.* denies + 2 phase-5 sub-epic E events.*.system.* broad denies)")
+	assert.Equal(t, 10, forbidCount, "expected 10 forbid policies (+1 object-locked-owner-only, +2 phase-5 sub-epic A events.*.system.crypto_totp.* denies + 2 phase-5 sub-epic D events.*.system.crypto_policy.* denies + 2 phase-5 sub-epic E events.*.system.* broad denies)")
 }
 
 func TestBootstrapNilSeedVersionNotUpgraded(t *testing.T) {
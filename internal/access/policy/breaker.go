@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access/policy/attribute"
+)
+
+// FailMode is how the engine answers a request it cannot evaluate because
+// the policy store is unavailable.
+type FailMode string
+
+// Fail modes.
+const (
+	// FailClosed denies the request. It is the only mode for writes.
+	FailClosed FailMode = "closed"
+	// FailOpen allows the request and records it in the audit log in
+	// every audit mode. Operators may choose it for reads.
+	FailOpen FailMode = "open"
+)
+
+// ParseFailMode parses a configured fail mode; empty means FailClosed.
+func ParseFailMode(s string) (FailMode, error) {
+	switch FailMode(s) {
+	case "", FailClosed:
+		return FailClosed, nil
+	case FailOpen:
+		return FailOpen, nil
+	default:
+		return "", fmt.Errorf("fail mode must be %q or %q, got %q", FailClosed, FailOpen, s)
+	}
+}
+
+// ActionCategory groups actions that share a fail mode.
+type ActionCategory string
+
+// Action categories.
+const (
+	ActionCategoryRead  ActionCategory = "read"
+	ActionCategoryWrite ActionCategory = "write"
+)
+
+// readActions are the actions covered by the read fail mode. Everything
+// else — including execute and the read_* variants that expose restricted
+// history or connections — is a write and always fails closed.
+var readActions = map[string]struct{}{
+	"read":                     {},
+	"list":                     {},
+	"list_characters":          {},
+	"list_objects":             {},
+	"list_presence":            {},
+	"list_character_directory": {},
+}
+
+// CategorizeAction returns the fail-mode category of action.
+func CategorizeAction(action string) ActionCategory {
+	if _, ok := readActions[action]; ok {
+		return ActionCategoryRead
+	}
+	return ActionCategoryWrite
+}
+
+// BreakerConfig configures the policy store circuit breaker.
+type BreakerConfig struct {
+	// FailureThreshold is how many consecutive snapshot failures open the
+	// circuit.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a single
+	// request probes the store with a cache reload.
+	OpenDuration time.Duration
+	// ReadFailMode applies to read actions while the store is unavailable.
+	// Writes always fail closed.
+	ReadFailMode FailMode
+}
+
+// Default breaker parameters.
+const (
+	DefaultBreakerFailureThreshold = 3
+	DefaultBreakerOpenDuration     = 15 * time.Second
+)
+
+// DefaultBreakerConfig returns a fail-closed breaker configuration.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureThreshold: DefaultBreakerFailureThreshold,
+		OpenDuration:     DefaultBreakerOpenDuration,
+		ReadFailMode:     FailClosed,
+	}
+}
+
+// Validate returns an error if any config field is invalid.
+func (c BreakerConfig) Validate() error {
+	if c.FailureThreshold <= 0 {
+		return fmt.Errorf("FailureThreshold must be positive, got %d", c.FailureThreshold)
+	}
+	if c.OpenDuration <= 0 {
+		return fmt.Errorf("OpenDuration must be positive, got %v", c.OpenDuration)
+	}
+	if _, err := ParseFailMode(string(c.ReadFailMode)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// snapshotSource is the subset of Cache the breaker guards.
+type snapshotSource interface {
+	Snapshot(ctx context.Context) (*Snapshot, error)
+	Invalidate(ctx context.Context) error
+}
+
+// Breaker guards the engine's policy snapshot reads. After FailureThreshold
+// consecutive failures it opens and requests stop touching the cache. Once
+// OpenDuration has passed, the next request probes the store by reloading
+// the cache: success closes the circuit, failure re-opens it. A reload is
+// needed because a failed invalidation leaves the cache's read barrier
+// failing until the next successful one.
+type Breaker struct {
+	mu            sync.Mutex
+	config        BreakerConfig
+	state         attribute.CircuitState
+	failures      int
+	openedAt      time.Time
+	probeInFlight bool
+
+	readFailMode atomic.Value // FailMode
+}
+
+// NewBreaker creates a closed breaker. An invalid config is rejected.
+func NewBreaker(config BreakerConfig) (*Breaker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, oops.Code("POLICY_BREAKER_CONFIG_INVALID").Wrap(err)
+	}
+	b := &Breaker{config: config, state: attribute.CircuitStateClosed}
+	b.SetReadFailMode(config.ReadFailMode)
+	return b, nil
+}
+
+// SetReadFailMode switches the read fail mode, e.g. on a config reload.
+// An empty mode means FailClosed.
+func (b *Breaker) SetReadFailMode(mode FailMode) {
+	if mode == "" {
+		mode = FailClosed
+	}
+	b.readFailMode.Store(mode)
+}
+
+// FailMode returns the fail mode for action.
+func (b *Breaker) FailMode(action string) FailMode {
+	if CategorizeAction(action) != ActionCategoryRead {
+		return FailClosed
+	}
+	mode, _ := b.readFailMode.Load().(FailMode)
+	return mode
+}
+
+// State returns the current circuit state.
+func (b *Breaker) State() attribute.CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.maybeTransitionToHalfOpen()
+	return b.state
+}
+
+// snapshot returns src's snapshot through the breaker. While the circuit is
+// open it fails fast with POLICY_CIRCUIT_OPEN; the one request admitted
+// when half-open reloads src first. Context cancellation is not counted as
+// a store failure.
+func (b *Breaker) snapshot(ctx context.Context, src snapshotSource) (*Snapshot, error) {
+	probe, admitted := b.admit()
+	if !admitted {
+		return nil, oops.Code("POLICY_CIRCUIT_OPEN").Errorf("policy store circuit open")
+	}
+
+	if probe {
+		if err := src.Invalidate(ctx); err != nil {
+			if ctx.Err() != nil {
+				// A cancelled caller says nothing about the store; let
+				// the next request probe instead.
+				b.releaseProbe()
+			} else {
+				b.recordProbe(ctx, false)
+			}
+			return nil, fmt.Errorf("policy breaker probe: %w", err)
+		}
+		b.recordProbe(ctx, true)
+	}
+
+	snap, err := src.Snapshot(ctx)
+	switch {
+	case err == nil:
+		b.recordSuccess()
+	case ctx.Err() == nil:
+		b.recordFailure(ctx)
+	}
+	return snap, err
+}
+
+// admit reports whether a request may read the cache and whether it is the
+// half-open probe.
+func (b *Breaker) admit() (probe, admitted bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.maybeTransitionToHalfOpen()
+	switch b.state {
+	case attribute.CircuitStateClosed:
+		return false, true
+	case attribute.CircuitStateHalfOpen:
+		if b.probeInFlight {
+			return false, false
+		}
+		b.probeInFlight = true
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+// maybeTransitionToHalfOpen moves an open circuit to half-open once
+// OpenDuration has elapsed. Must be called with b.mu held.
+func (b *Breaker) maybeTransitionToHalfOpen() {
+	if b.state == attribute.CircuitStateOpen && time.Since(b.openedAt) >= b.config.OpenDuration {
+		b.setState(attribute.CircuitStateHalfOpen)
+		b.probeInFlight = false
+	}
+}
+
+func (b *Breaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == attribute.CircuitStateClosed {
+		b.failures = 0
+	}
+}
+
+func (b *Breaker) recordFailure(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state != attribute.CircuitStateClosed {
+		return
+	}
+	b.failures++
+	if b.failures >= b.config.FailureThreshold {
+		b.open()
+		slog.ErrorContext(ctx,
+			"policy store circuit breaker opened",
+			"consecutive_failures", b.failures,
+			"open_duration", b.config.OpenDuration,
+		)
+		policyBreakerTrips.Inc()
+	}
+}
+
+func (b *Breaker) recordProbe(ctx context.Context, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeInFlight = false
+	if ok {
+		b.setState(attribute.CircuitStateClosed)
+		b.failures = 0
+		slog.InfoContext(ctx, "policy store circuit breaker closed after successful probe")
+		return
+	}
+	b.open()
+	slog.WarnContext(ctx, "policy store circuit breaker re-opened after failed probe")
+}
+
+// releaseProbe gives up the half-open probe without changing the state.
+func (b *Breaker) releaseProbe() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probeInFlight = false
+}
+
+// open opens the circuit. Must be called with b.mu held.
+func (b *Breaker) open() {
+	b.setState(attribute.CircuitStateOpen)
+	b.openedAt = time.Now()
+}
+
+// setState updates the state and its gauge. Must be called with b.mu held.
+func (b *Breaker) setState(state attribute.CircuitState) {
+	b.state = state
+	policyBreakerState.Set(float64(state))
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policy
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/attribute"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestParseFailModeAndCategories(t *testing.T) {
+	mode, err := ParseFailMode("")
+	require.NoError(t, err)
+	assert.Equal(t, FailClosed, mode)
+	mode, err = ParseFailMode("open")
+	require.NoError(t, err)
+	assert.Equal(t, FailOpen, mode)
+	_, err = ParseFailMode("ajar")
+	require.Error(t, err)
+
+	assert.Equal(t, ActionCategoryRead, CategorizeAction("read"))
+	assert.Equal(t, ActionCategoryRead, CategorizeAction("list_presence"))
+	assert.Equal(t, ActionCategoryWrite, CategorizeAction("write"))
+	assert.Equal(t, ActionCategoryWrite, CategorizeAction("read_unrestricted_history"))
+	assert.Equal(t, ActionCategoryWrite, CategorizeAction("execute"))
+
+	_, err = NewBreaker(BreakerConfig{FailureThreshold: 0, OpenDuration: time.Second})
+	errutil.AssertErrorCode(t, err, "POLICY_BREAKER_CONFIG_INVALID")
+}
+
+func TestBreakerFailModeNeverOpensWrites(t *testing.T) {
+	b, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: time.Second, ReadFailMode: FailOpen})
+	require.NoError(t, err)
+	assert.Equal(t, FailOpen, b.FailMode("read"))
+	assert.Equal(t, FailClosed, b.FailMode("write"))
+
+	b.SetReadFailMode("")
+	assert.Equal(t, FailClosed, b.FailMode("read"))
+}
+
+// failedCache returns a cache whose last invalidation failed, so every
+// Snapshot returns the store error until a reload succeeds.
+func failedCache(t *testing.T, ms *mockPolicyStore) *Cache {
+	t.Helper()
+	cache := NewCache(ms, testCompiler())
+	ms.err = assert.AnError
+	require.Error(t, cache.Invalidate(context.Background()))
+	return cache
+}
+
+func TestBreakerOpensThenProbeRecovers(t *testing.T) {
+	ms := &mockPolicyStore{policies: testPolicies()}
+	cache := failedCache(t, ms)
+	b, err := NewBreaker(BreakerConfig{FailureThreshold: 2, OpenDuration: 20 * time.Millisecond})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := b.snapshot(ctx, cache)
+		require.Error(t, err)
+	}
+	assert.Equal(t, attribute.CircuitStateOpen, b.State())
+
+	calls := ms.calls.Load()
+	_, err = b.snapshot(ctx, cache)
+	errutil.AssertErrorCode(t, err, "POLICY_CIRCUIT_OPEN")
+	assert.Equal(t, calls, ms.calls.Load(), "an open circuit does not touch the store")
+
+	ms.err = nil
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, attribute.CircuitStateHalfOpen, b.State())
+	snap, err := b.snapshot(ctx, cache)
+	require.NoError(t, err, "the probe reloads the cache")
+	assert.Len(t, snap.Policies, len(testPolicies()))
+	assert.Equal(t, attribute.CircuitStateClosed, b.State())
+}
+
+func TestBreakerFailedProbeReopens(t *testing.T) {
+	ms := &mockPolicyStore{}
+	cache := failedCache(t, ms)
+	b, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: 20 * time.Millisecond})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = b.snapshot(ctx, cache)
+	require.Error(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	_, err = b.snapshot(ctx, cache)
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, attribute.CircuitStateOpen, b.State())
+}
+
+func TestBreakerIgnoresCancelledContext(t *testing.T) {
+	ms := &mockPolicyStore{}
+	cache := failedCache(t, ms)
+	b, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = b.snapshot(ctx, cache)
+	require.Error(t, err)
+	assert.Equal(t, attribute.CircuitStateClosed, b.State())
+}
+
+func TestEngineFailModes(t *testing.T) {
+	writer := &mockAuditWriter{}
+	auditLogger := audit.NewLogger(audit.ModeDenialsOnly, writer, filepath.Join(t.TempDir(), "wal.jsonl"))
+	t.Cleanup(func() { _ = auditLogger.Close() })
+
+	ms := &mockPolicyStore{}
+	engine := NewEngine(attribute.NewResolver(attribute.NewSchemaRegistry()), failedCache(t, ms), nil, auditLogger)
+	breaker, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute, ReadFailMode: FailOpen})
+	require.NoError(t, err)
+	engine.SetBreaker(breaker)
+	ctx := context.Background()
+
+	decision, err := engine.Evaluate(ctx, types.AccessRequest{Subject: "character:x", Action: "write", Resource: "location:y"})
+	require.Error(t, err, "writes fail closed")
+	assert.False(t, decision.IsAllowed())
+
+	decision, err = engine.Evaluate(ctx, types.AccessRequest{Subject: "character:x", Action: "read", Resource: "location:y"})
+	require.NoError(t, err)
+	assert.True(t, decision.IsAllowed(), "reads fail open")
+	assert.Equal(t, "infra:fail-open", decision.PolicyID())
+
+	entries := writer.getEntries()
+	require.NotEmpty(t, entries)
+	last := entries[len(entries)-1]
+	assert.Equal(t, "infra:fail-open", last.ID, "fail-open allows are audited in denials_only mode")
+	assert.Equal(t, types.EffectAllow, last.Effect)
+
+	breaker.SetReadFailMode(FailClosed)
+	_, err = engine.Evaluate(ctx, types.AccessRequest{Subject: "character:x", Action: "read", Resource: "location:y"})
+	errutil.AssertErrorCode(t, err, "POLICY_CIRCUIT_OPEN")
+}
+
+func TestEngineDegradedModeFailsOpenForReads(t *testing.T) {
+	engine, writer := createTestEngine(t, nil)
+	breaker, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute, ReadFailMode: FailOpen})
+	require.NoError(t, err)
+	engine.SetBreaker(breaker)
+	engine.EnterDegradedMode("test")
+	t.Cleanup(engine.ClearDegradedMode)
+	ctx := context.Background()
+
+	decision, err := engine.Evaluate(ctx, types.AccessRequest{Subject: "character:x", Action: "read", Resource: "location:y"})
+	require.NoError(t, err)
+	assert.True(t, decision.IsAllowed())
+	assert.Contains(t, decision.Reason(), "degraded_mode")
+
+	decision, err = engine.Evaluate(ctx, types.AccessRequest{Subject: "character:x", Action: "write", Resource: "location:y"})
+	require.NoError(t, err)
+	assert.False(t, decision.IsAllowed())
+	assert.Equal(t, "infra:degraded-mode", writer.getEntries()[len(writer.getEntries())-1].ID)
+
+	_, err = engine.Evaluate(ctx, types.AccessRequest{Subject: "bad", Action: "read", Resource: "location:y"})
+	errutil.AssertErrorCode(t, err, "INVALID_ENTITY_REF")
+}
+
+func TestBreakerCancelledProbeLeavesCircuitHalfOpen(t *testing.T) {
+	ms := &mockPolicyStore{}
+	cache := failedCache(t, ms)
+	b, err := NewBreaker(BreakerConfig{FailureThreshold: 1, OpenDuration: 20 * time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = b.snapshot(context.Background(), cache)
+	require.Error(t, err)
+	time.Sleep(30 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = b.snapshot(ctx, cache)
+	require.Error(t, err)
+	assert.Equal(t, attribute.CircuitStateHalfOpen, b.State())
+
+	ms.err = nil
+	_, err = b.snapshot(context.Background(), cache)
+	require.NoError(t, err, "the next request probes in its place")
+	assert.Equal(t, attribute.CircuitStateClosed, b.State())
+}
@@ -26,6 +26,10 @@ type CachedPolicy struct {
 type Snapshot struct {
 	Policies  []CachedPolicy
 	CreatedAt time.Time
+	// Generation increases by one on every successful Reload. Consumers that
+	// derive state from the policy set (e.g. the engine's DecisionCache)
+	// compare it to detect bundle changes.
+	Generation uint64
 }
 
 // readBarrier is a one-shot broadcast result for the read barrier.
@@ -58,8 +62,9 @@ type Cache struct {
 	compiler *Compiler
 	cfg      cacheConfig
 
-	mu       sync.RWMutex
-	snapshot *Snapshot
+	mu         sync.RWMutex
+	snapshot   *Snapshot
+	generation uint64
 
 	// Read barrier: readers wait on barrier.done before reading snapshot.
 	// A closed done channel = ready (fast path). An open channel = reload in progress.
@@ -116,8 +121,9 @@ func (pc *Cache) Snapshot(ctx context.Context) (*Snapshot, error) {
 	pc.mu.RUnlock()
 
 	copied := &Snapshot{
-		Policies:  make([]CachedPolicy, len(snap.Policies)),
-		CreatedAt: snap.CreatedAt,
+		Policies:   make([]CachedPolicy, len(snap.Policies)),
+		CreatedAt:  snap.CreatedAt,
+		Generation: snap.Generation,
 	}
 	copy(copied.Policies, snap.Policies)
 	return copied, nil
@@ -163,6 +169,8 @@ func (pc *Cache) Reload(ctx context.Context) error {
 	}
 
 	pc.mu.Lock()
+	pc.generation++
+	snap.Generation = pc.generation
 	pc.snapshot = snap
 	pc.mu.Unlock()
 
@@ -211,6 +211,9 @@ func collectFromCondition(c *dsl.Condition) []attrRefInfo {
 			})
 		}
 
+	case c.Role != nil:
+		refs = append(refs, attrRefInfo{namespace: "principal", key: "character.roles"})
+
 	case c.Contains != nil:
 		if len(c.Contains.Path) > 0 {
 			refs = append(refs, attrRefInfo{
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policy
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// Decision cache defaults used by the production ABAC stack.
+const (
+	DefaultDecisionCacheTTL      = 2 * time.Second
+	DefaultDecisionCacheCapacity = 10000
+)
+
+// decisionKey identifies a cached decision. The attribute hash covers every
+// resolved bag (subject, resource, action, environment), so any attribute
+// change — including the environment clock — produces a different key and
+// a stale decision can never be served for changed inputs.
+type decisionKey struct {
+	subject  string
+	action   string
+	resource string
+	attrs    [sha256.Size]byte
+}
+
+// newDecisionKey builds the cache key for a request and its resolved bags.
+// fmt prints maps with sorted keys, so equal bags hash identically.
+func newDecisionKey(req types.AccessRequest, bags *types.AttributeBags) decisionKey {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "subject=%#v\nresource=%#v\naction=%#v\nenvironment=%#v",
+		bags.Subject, bags.Resource, bags.Action, bags.Environment)
+	key := decisionKey{subject: req.Subject, action: req.Action, resource: req.Resource}
+	h.Sum(key.attrs[:0])
+	return key
+}
+
+type decisionEntry struct {
+	key      decisionKey
+	decision types.Decision
+	expires  time.Time
+}
+
+// DecisionCache memoizes policy evaluation results for a short TTL. Entries
+// are bound to the policy snapshot generation they were computed under:
+// the first lookup or store against a newer generation purges the cache, so
+// a policy bundle change invalidates every cached decision at once.
+//
+// A nil *DecisionCache is valid and caches nothing.
+type DecisionCache struct {
+	ttl      time.Duration
+	capacity int
+	now      func() time.Time
+
+	mu         sync.Mutex
+	generation uint64
+	items      map[decisionKey]*list.Element
+	lru        *list.List
+}
+
+// NewDecisionCache creates a DecisionCache holding at most capacity entries,
+// each valid for ttl. Non-positive values fall back to the defaults.
+func NewDecisionCache(ttl time.Duration, capacity int) *DecisionCache {
+	if ttl <= 0 {
+		ttl = DefaultDecisionCacheTTL
+	}
+	if capacity <= 0 {
+		capacity = DefaultDecisionCacheCapacity
+	}
+	return &DecisionCache{
+		ttl:      ttl,
+		capacity: capacity,
+		now:      time.Now,
+		items:    make(map[decisionKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// Len returns the number of cached decisions, including expired entries not
+// yet evicted.
+func (c *DecisionCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Purge drops every cached decision.
+func (c *DecisionCache) Purge() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purgeLocked()
+}
+
+// InvalidateEntities drops every cached decision whose subject or resource
+// is one of refs (e.g. "object:01ABC") and returns how many were dropped.
+// World writes call it, through Engine.InvalidateAccess, when they change
+// state an attribute provider reads. Keys already hash the resolved
+// attributes; this is the explicit path, so a revocation retires the
+// entity's decisions when it commits instead of relying on every input it
+// touched showing up in the bags.
+func (c *DecisionCache) InvalidateEntities(refs ...string) int {
+	if c == nil || len(refs) == 0 {
+		return 0
+	}
+	stale := make(map[string]struct{}, len(refs))
+	for _, ref := range refs {
+		stale[ref] = struct{}{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	dropped := 0
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if entry, ok := elem.Value.(*decisionEntry); ok {
+			_, subject := stale[entry.key.subject]
+			_, resource := stale[entry.key.resource]
+			if subject || resource {
+				c.removeLocked(elem)
+				dropped++
+			}
+		}
+		elem = next
+	}
+	decisionCacheEntityEvictions.Add(float64(dropped))
+	return dropped
+}
+
+// get returns the decision cached for key under the given policy generation.
+func (c *DecisionCache) get(key decisionKey, generation uint64) (types.Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observeGenerationLocked(generation)
+	elem, ok := c.items[key]
+	if !ok {
+		decisionCacheMisses.Inc()
+		return types.Decision{}, false
+	}
+	entry, ok := elem.Value.(*decisionEntry)
+	if !ok || !c.now().Before(entry.expires) {
+		c.removeLocked(elem)
+		decisionCacheMisses.Inc()
+		return types.Decision{}, false
+	}
+	c.lru.MoveToFront(elem)
+	decisionCacheHits.Inc()
+	return entry.decision, true
+}
+
+// put stores a decision computed under the given policy generation. Results
+// computed against a snapshot older than the cache's generation are dropped.
+func (c *DecisionCache) put(key decisionKey, generation uint64, decision types.Decision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observeGenerationLocked(generation)
+	if generation != c.generation {
+		return
+	}
+	// Attribute bags are per-request; the engine re-attaches the caller's.
+	decision.SetAttributes(nil)
+	expires := c.now().Add(c.ttl)
+	if elem, ok := c.items[key]; ok {
+		if entry, isEntry := elem.Value.(*decisionEntry); isEntry {
+			entry.decision = decision
+			entry.expires = expires
+			c.lru.MoveToFront(elem)
+			return
+		}
+		c.removeLocked(elem)
+	}
+	c.items[key] = c.lru.PushFront(&decisionEntry{key: key, decision: decision, expires: expires})
+	for c.lru.Len() > c.capacity {
+		c.removeLocked(c.lru.Back())
+	}
+}
+
+// observeGenerationLocked purges the cache when a newer policy generation
+// is seen. Older generations (a request that loaded its snapshot before a
+// concurrent reload) leave the cache untouched.
+func (c *DecisionCache) observeGenerationLocked(generation uint64) {
+	if generation <= c.generation {
+		return
+	}
+	c.generation = generation
+	if c.lru.Len() > 0 {
+		c.purgeLocked()
+	}
+}
+
+func (c *DecisionCache) purgeLocked() {
+	if c.lru.Len() > 0 {
+		decisionCacheInvalidations.Inc()
+	}
+	c.items = make(map[decisionKey]*list.Element)
+	c.lru.Init()
+}
+
+func (c *DecisionCache) removeLocked(elem *list.Element) {
+	if entry, ok := elem.Value.(*decisionEntry); ok {
+		delete(c.items, entry.key)
+	}
+	c.lru.Remove(elem)
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/attribute"
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+func testDecisionKey(subject string, roles ...string) decisionKey {
+	bags := types.NewAttributeBags()
+	bags.Subject["roles"] = roles
+	return newDecisionKey(types.AccessRequest{Subject: subject, Action: "read", Resource: "location:01XYZ"}, bags)
+}
+
+func TestDecisionKeyCoversAttributes(t *testing.T) {
+	assert.Equal(t, testDecisionKey("character:01ABC", "admin"), testDecisionKey("character:01ABC", "admin"))
+	assert.NotEqual(t, testDecisionKey("character:01ABC", "admin"), testDecisionKey("character:01ABC", "player"))
+	assert.NotEqual(t, testDecisionKey("character:01ABC", "admin"), testDecisionKey("character:01DEF", "admin"))
+}
+
+func TestDecisionCacheExpiresEntries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	dc := NewDecisionCache(time.Second, 10)
+	dc.now = func() time.Time { return now }
+	key := testDecisionKey("character:01ABC", "admin")
+	allow := types.NewDecision(types.EffectAllow, "permit policy satisfied", "policy-1")
+
+	dc.put(key, 1, allow)
+	got, ok := dc.get(key, 1)
+	require.True(t, ok)
+	assert.Equal(t, types.EffectAllow, got.Effect())
+
+	now = now.Add(time.Second)
+	_, ok = dc.get(key, 1)
+	assert.False(t, ok, "entry must expire after the TTL")
+	assert.Zero(t, dc.Len())
+}
+
+func TestDecisionCachePurgesOnNewGeneration(t *testing.T) {
+	dc := NewDecisionCache(time.Minute, 10)
+	key := testDecisionKey("character:01ABC", "admin")
+	dc.put(key, 1, types.NewDecision(types.EffectAllow, "permit policy satisfied", "policy-1"))
+
+	before := testutil.ToFloat64(decisionCacheInvalidations)
+	_, ok := dc.get(key, 2)
+	assert.False(t, ok)
+	assert.Zero(t, dc.Len())
+	assert.InDelta(t, before+1, testutil.ToFloat64(decisionCacheInvalidations), 0.001)
+
+	// A result computed against the superseded snapshot is not stored.
+	dc.put(key, 1, types.NewDecision(types.EffectAllow, "permit policy satisfied", "policy-1"))
+	assert.Zero(t, dc.Len())
+}
+
+func TestDecisionCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	dc := NewDecisionCache(time.Minute, 2)
+	deny := types.NewDecision(types.EffectDefaultDeny, "no policies satisfied", "")
+	a, b, c := testDecisionKey("character:A"), testDecisionKey("character:B"), testDecisionKey("character:C")
+
+	dc.put(a, 1, deny)
+	dc.put(b, 1, deny)
+	_, ok := dc.get(a, 1)
+	require.True(t, ok)
+	dc.put(c, 1, deny)
+
+	assert.Equal(t, 2, dc.Len())
+	_, ok = dc.get(b, 1)
+	assert.False(t, ok, "least recently used entry should be evicted")
+	_, ok = dc.get(a, 1)
+	assert.True(t, ok)
+}
+
+func TestDecisionCacheNilIsDisabled(t *testing.T) {
+	var dc *DecisionCache
+	dc.Purge()
+	assert.Zero(t, dc.Len())
+}
+
+func TestEngineDecisionCacheServesRepeatEvaluations(t *testing.T) {
+	provider := &mockAttributeProvider{
+		namespace:  "character",
+		subjectMap: map[string]any{"roles": []string{"admin"}},
+	}
+	engine := createTestEngineWithPolicies(t, []string{
+		`permit(principal is character, action in ["say"], resource is location) when { "admin" in principal.character.roles };`,
+	}, []attribute.AttributeProvider{provider})
+	dc := NewDecisionCache(time.Minute, 100)
+	engine.SetDecisionCache(dc)
+	req := types.AccessRequest{Subject: "character:01ABC", Action: "say", Resource: "location:01XYZ"}
+
+	hits := testutil.ToFloat64(decisionCacheHits)
+	misses := testutil.ToFloat64(decisionCacheMisses)
+
+	first, err := engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	second, err := engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, types.EffectAllow, first.Effect())
+	assert.Equal(t, first.Effect(), second.Effect())
+	assert.Equal(t, first.Policies(), second.Policies())
+	assert.NotNil(t, second.Attributes(), "cached decisions carry the request's attributes")
+	assert.InDelta(t, misses+1, testutil.ToFloat64(decisionCacheMisses), 0.001)
+	assert.InDelta(t, hits+1, testutil.ToFloat64(decisionCacheHits), 0.001)
+
+	// Changed attributes miss the cache and are evaluated afresh.
+	provider.subjectMap = map[string]any{"roles": []string{"player"}}
+	third, err := engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, types.EffectDefaultDeny, third.Effect())
+}
+
+func TestEngineDecisionCacheInvalidatedByPolicyReload(t *testing.T) {
+	ms := &mockPolicyStore{policies: testPolicies()}
+	cache := NewCache(ms, testCompiler())
+	require.NoError(t, cache.Reload(context.Background()))
+	engine := createTestEngineWithPolicies(t, nil, nil)
+	engine.cache = cache
+	dc := NewDecisionCache(time.Minute, 100)
+	engine.SetDecisionCache(dc)
+
+	snap, err := cache.Snapshot(context.Background())
+	require.NoError(t, err)
+	req := types.AccessRequest{Subject: "character:01ABC", Action: "read", Resource: "location:01XYZ"}
+	bags := types.NewAttributeBags()
+	dc.put(newDecisionKey(req, bags), snap.Generation, types.NewDecision(types.EffectAllow, "permit policy satisfied", "pol-1"))
+	require.Equal(t, 1, dc.Len())
+
+	require.NoError(t, cache.Reload(context.Background()))
+	reloaded, err := cache.Snapshot(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, reloaded.Generation, snap.Generation)
+	_, err = engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+
+	_, ok := dc.get(newDecisionKey(req, bags), snap.Generation)
+	assert.False(t, ok, "reload must invalidate decisions cached under the previous generation")
+}
+
+func TestDecisionCacheInvalidateEntities(t *testing.T) {
+	dc := NewDecisionCache(time.Minute, 10)
+	allow := types.NewDecision(types.EffectAllow, "permit policy satisfied", "policy-1")
+	bags := types.NewAttributeBags()
+	key := func(subject, resource string) decisionKey {
+		return newDecisionKey(types.AccessRequest{Subject: subject, Action: "write", Resource: resource}, bags)
+	}
+	asSubject := key("character:01ABC", "location:01XYZ")
+	asResource := key("character:01DEF", "character:01ABC")
+	object := key("character:01DEF", "object:01OBJ")
+	for _, k := range []decisionKey{asSubject, asResource, object} {
+		dc.put(k, 1, allow)
+	}
+
+	before := testutil.ToFloat64(decisionCacheEntityEvictions)
+	assert.Equal(t, 2, dc.InvalidateEntities("character:01ABC"), "a ref matches as subject and as resource")
+	assert.InDelta(t, before+2, testutil.ToFloat64(decisionCacheEntityEvictions), 0.001)
+	_, ok := dc.get(object, 1)
+	assert.True(t, ok, "unrelated entities keep their decisions")
+	_, ok = dc.get(asSubject, 1)
+	assert.False(t, ok)
+
+	assert.Zero(t, dc.InvalidateEntities())
+	var disabled *DecisionCache
+	assert.Zero(t, disabled.InvalidateEntities("object:01OBJ"))
+}
+
+func TestEngineInvalidateAccessEvictsCachedDecision(t *testing.T) {
+	provider := &mockAttributeProvider{
+		namespace:  "character",
+		subjectMap: map[string]any{"roles": []string{"admin"}},
+	}
+	engine := createTestEngineWithPolicies(t, []string{
+		`permit(principal is character, action in ["say"], resource is location) when { "admin" in principal.character.roles };`,
+	}, []attribute.AttributeProvider{provider})
+	dc := NewDecisionCache(time.Minute, 100)
+	engine.SetDecisionCache(dc)
+	req := types.AccessRequest{Subject: "character:01ABC", Action: "say", Resource: "location:01XYZ"}
+
+	_, err := engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, dc.Len())
+
+	engine.InvalidateAccess(context.Background(), "location:01XYZ")
+	assert.Zero(t, dc.Len())
+
+	engine.SetDecisionCache(nil)
+	engine.InvalidateAccess(context.Background(), "location:01XYZ")
+}
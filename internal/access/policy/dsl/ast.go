@@ -123,7 +123,8 @@ type Conjunction struct {
 // The parser tries alternatives in order (PEG ordered choice) with
 // MaxLookahead for full backtracking:
 //  1. Unique-prefix forms: negation (!), parenthesized, if-then-else
-//  2. has (starts with attribute_root keyword + "has")
+//  2. has (starts with attribute_root keyword + "has"), then role
+//     membership ("principal" "in" "role")
 //  3. Expression-starting forms ordered most-specific first:
 //     contains > like > in-list > in-expr > comparison
 //  4. Bare boolean literal (fallback)
@@ -133,6 +134,7 @@ type Condition struct {
 	Parenthesized *ConditionBlock    `parser:"| '(' @@ ')'" json:"parenthesized,omitempty"`
 	IfThenElse    *IfThenElse        `parser:"| @@" json:"if_then_else,omitempty"`
 	Has           *HasCondition      `parser:"| @@" json:"has,omitempty"`
+	Role          *RoleCondition     `parser:"| @@" json:"role,omitempty"`
 	Contains      *ContainsCondition `parser:"| @@" json:"contains,omitempty"`
 	Like          *LikeCondition     `parser:"| @@" json:"like,omitempty"`
 	InList        *InListCondition   `parser:"| @@" json:"in_list,omitempty"`
@@ -165,6 +167,17 @@ type HasCondition struct {
 	Path []string       `parser:"@Ident (Dot @Ident)*" json:"path"`
 }
 
+// RoleCondition tests whether the principal holds a role, either assigned
+// directly or inherited through the role hierarchy
+// ("principal" "in" "role" string_literal).
+type RoleCondition struct {
+	Pos       lexer.Position `parser:"" json:"-"`
+	Principal string         `parser:"'principal'" json:"-"`
+	In        string         `parser:"'in'" json:"-"`
+	Keyword   string         `parser:"'role'" json:"-"`
+	Role      string         `parser:"@String" json:"role"`
+}
+
 // ContainsCondition represents a containsAll or containsAny list method call.
 // The attribute reference is inlined (root + path) rather than using Expr,
 // because AttrRef would greedily consume "containsAll" as a path segment.
@@ -306,6 +319,8 @@ func (c *Condition) String() string {
 		return c.IfThenElse.String()
 	case c.Has != nil:
 		return c.Has.String()
+	case c.Role != nil:
+		return c.Role.String()
 	case c.Contains != nil:
 		return c.Contains.String()
 	case c.Like != nil:
@@ -345,6 +360,10 @@ func (hc *HasCondition) String() string {
 	return hc.Root + " has " + strings.Join(hc.Path, ".")
 }
 
+func (rc *RoleCondition) String() string {
+	return `principal in role "` + rc.Role + `"`
+}
+
 func (cc *ContainsCondition) String() string {
 	prefix := cc.Root
 	if len(cc.Path) > 0 {
@@ -4,12 +4,17 @@
 package dsl
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/gobwas/glob"
+	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/access/policy/types"
 )
 
+// principalRolesKey is the principal bag key holding a character's roles.
+const principalRolesKey = "character.roles"
+
 // EvalContext provides attribute bags and configuration for evaluation.
 type EvalContext struct {
 	Bags      *types.AttributeBags
@@ -100,6 +105,9 @@ func evalCondition(ctx *EvalContext, c *Condition, depth int) bool {
 	case c.Has != nil:
 		return evalHas(ctx, c.Has)
 
+	case c.Role != nil:
+		return evalRole(ctx, c.Role)
+
 	case c.Contains != nil:
 		return evalContains(ctx, c.Contains)
 
@@ -224,6 +232,21 @@ func evalHas(ctx *EvalContext, has *HasCondition) bool {
 	return exists
 }
 
+// evalRole checks whether the principal holds the role through the role
+// hierarchy. Principals without a character.roles attribute (systems,
+// plugins) hold no roles, so the condition is false for them.
+func evalRole(ctx *EvalContext, rc *RoleCondition) bool {
+	bag := getBag(ctx, "principal")
+	if bag == nil {
+		return false
+	}
+	roles := toStringSlice(bag[principalRolesKey])
+	if roles == nil {
+		return false
+	}
+	return slices.Contains(access.EffectiveRoles(roles), rc.Role)
+}
+
 // evalContains evaluates containsAll or containsAny.
 func evalContains(ctx *EvalContext, cc *ContainsCondition) bool {
 	bag := getBag(ctx, cc.Root)
@@ -330,6 +330,41 @@ func TestEvaluateConditions_Has(t *testing.T) {
 	}
 }
 
+func TestEvaluateConditions_Role(t *testing.T) {
+	withRoles := func(roles any) *types.AttributeBags {
+		b := newBags()
+		b.Subject["character.roles"] = roles
+		return b
+	}
+	role := func(name string) *ConditionBlock {
+		return mkSingleCond(&Condition{Role: &RoleCondition{Role: name}})
+	}
+
+	tests := []struct {
+		name     string
+		cond     *ConditionBlock
+		bags     *types.AttributeBags
+		expected bool
+	}{
+		{"assigned role", role("builder"), withRoles([]string{"player", "builder"}), true},
+		{"inherited role", role("builder"), withRoles([]string{"admin"}), true},
+		{"higher role not held", role("gm"), withRoles([]string{"builder"}), false},
+		{"custom role", role("staff"), withRoles([]string{"staff"}), true},
+		{"custom role grants no system roles", role("builder"), withRoles([]string{"staff"}), false},
+		{"every character is a player", role("player"), withRoles([]string{}), true},
+		{"roles decoded from JSON", role("gm"), withRoles([]any{"admin"}), true},
+		{"no roles attribute", role("player"), newBags(), false},
+		{"malformed roles attribute", role("player"), withRoles("admin"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := defaultCtx(tt.bags)
+			assert.Equal(t, tt.expected, EvaluateConditions(ctx, tt.cond))
+		})
+	}
+}
+
 // --- Like Tests ---
 
 func TestEvaluateConditions_Like(t *testing.T) {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/alecthomas/participle/v2"
 	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
 )
 
 // MaxNestingDepth is the maximum allowed nesting depth for conditions.
@@ -81,6 +83,11 @@ func validateCondition(c *Condition, depth int) error {
 		return validateCondition(c.IfThenElse.Else, depth+1)
 	case c.Has != nil:
 		return validateHasPaths(c.Has.Path)
+	case c.Role != nil:
+		if !access.ValidRoleName(c.Role.Role) {
+			return fmt.Errorf("invalid role name %q: use lowercase letters, digits, '_' or '-'", c.Role.Role)
+		}
+		return nil
 	case c.Comparison != nil:
 		return validateExprs(c.Comparison.Left, c.Comparison.Right)
 	case c.Like != nil:
@@ -249,6 +249,21 @@ func TestParseReservedWordAsAttributeRejected(t *testing.T) {
 	assert.Error(t, err, "reserved word as attribute should be rejected")
 }
 
+func TestParseRoleCondition(t *testing.T) {
+	policy, err := dsl.Parse(`permit(principal is character, action in ["write"], resource is location) when { principal in role "builder" };`)
+	require.NoError(t, err)
+	cond := policy.Conditions.Disjunctions[0].Conditions[0]
+	require.NotNil(t, cond.Role)
+	assert.Equal(t, "builder", cond.Role.Role)
+	assert.Equal(t, `principal in role "builder"`, cond.String())
+}
+
+func TestParseInvalidRoleNameRejected(t *testing.T) {
+	_, err := dsl.Parse(`permit(principal, action, resource) when { principal in role "Game Master" };`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid role name")
+}
+
 func TestNewParserBuilds(t *testing.T) {
 	parser, err := dsl.NewParser()
 	require.NoError(t, err, "NewParser should build without error")
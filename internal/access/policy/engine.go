@@ -27,10 +27,18 @@ type Engine struct {
 	sessions SessionResolver
 	audit    *audit.Logger
 	degraded atomic.Bool
+
+	// decisions memoizes evaluation results; nil disables caching.
+	decisions *DecisionCache
+
+	// breaker guards policy snapshot reads and picks the fail mode during a
+	// policy store outage; nil means snapshot failures always fail closed.
+	breaker *Breaker
 }
 
 // Compile-time check that Engine implements AccessPolicyEngine.
 var _ types.AccessPolicyEngine = (*Engine)(nil)
+var _ types.BatchEvaluator = (*Engine)(nil)
 
 // degradedCount tracks how many Engine instances are in degraded mode process-wide.
 // The gauge reflects degradedCount > 0, ensuring accuracy when multiple engines exist.
@@ -77,6 +85,29 @@ func NewEngine(resolver *attribute.Resolver, cache *Cache, sessions SessionResol
 	}
 }
 
+// SetDecisionCache enables decision caching for subsequent Evaluate calls.
+// Passing nil disables it. Must be called before the engine serves requests.
+func (e *Engine) SetDecisionCache(dc *DecisionCache) {
+	e.decisions = dc
+}
+
+// SetBreaker routes policy snapshot reads through b and applies its fail
+// modes while the policy store is unavailable. Passing nil disables it.
+// Must be called before the engine serves requests.
+func (e *Engine) SetBreaker(b *Breaker) {
+	e.breaker = b
+}
+
+// InvalidateAccess drops cached decisions for the given subject or resource
+// references. It satisfies world.AccessInvalidator, which the world service
+// calls after writes that change ABAC attributes (ownership, location, lock
+// state, deletion). A no-op when decision caching is disabled.
+func (e *Engine) InvalidateAccess(ctx context.Context, refs ...string) {
+	if dropped := e.decisions.InvalidateEntities(refs...); dropped > 0 {
+		slog.DebugContext(ctx, "evicted cached access decisions", "refs", refs, "dropped", dropped)
+	}
+}
+
 // Evaluate evaluates an access request against the policy engine.
 // This implementation covers Steps 1-10 (full evaluation algorithm).
 func (e *Engine) Evaluate(ctx context.Context, req types.AccessRequest) (types.Decision, error) {
@@ -124,8 +155,10 @@ func (e *Engine) Evaluate(ctx context.Context, req types.AccessRequest) (types.D
 		return decision, nil
 	}
 
-	// Step 3: Degraded mode check — AFTER system bypass so system ops still work
-	if e.degraded.Load() {
+	// Step 3: Degraded mode check — AFTER system bypass so system ops still work.
+	// Reads in fail-open mode continue to Step 7 so they are validated and
+	// their session resolved before being allowed.
+	if e.degraded.Load() && !e.failsOpen(req.Action) {
 		slog.ErrorContext(
 			ctx, "CRITICAL: ABAC engine in degraded mode — denying all requests",
 			"subject", req.Subject,
@@ -265,54 +298,84 @@ func (e *Engine) Evaluate(ctx context.Context, req types.AccessRequest) (types.D
 	}
 
 	// Step 7: Load snapshot and filter policies
-	snap, snapErr := e.cache.Snapshot(ctx)
+	if e.degraded.Load() && e.failsOpen(req.Action) {
+		return e.failOpen(ctx, req, start, "degraded_mode"), nil
+	}
+	snap, snapErr := e.snapshot(ctx)
 	if snapErr != nil {
+		if e.failsOpen(req.Action) {
+			errutil.LogErrorContext(
+				ctx, "policy snapshot unavailable — read fails open",
+				snapErr,
+				"subject", req.Subject,
+				"action", req.Action,
+				"resource", req.Resource,
+			)
+			return e.failOpen(ctx, req, start, "policy store unavailable"), nil
+		}
 		return types.NewDecision(types.EffectDefaultDeny, "policy cache unavailable", "infra:cache"),
 			oops.With("subject", req.Subject).With("action", req.Action).With("resource", req.Resource).Wrap(snapErr)
 	}
-	candidates := e.findApplicablePolicies(req, snap.Policies)
+	// Step 7b: Decision cache — an identical request with identical resolved
+	// attributes under the same policy generation reuses the prior result.
+	var (
+		decision types.Decision
+		cacheKey decisionKey
+		cached   bool
+	)
+	if e.decisions != nil {
+		cacheKey = newDecisionKey(req, bags)
+		decision, cached = e.decisions.get(cacheKey, snap.Generation)
+	}
 
-	if len(candidates) == 0 {
-		decision := types.NewDecision(types.EffectDefaultDeny, "no applicable policies", "")
-		decision.SetAttributes(bags)
-		if valErr := decision.Validate(); valErr != nil {
-			return decision, oops.Wrapf(valErr, "decision validation failed")
-		}
+	if !cached {
+		candidates := e.findApplicablePolicies(req, snap.Policies)
 
-		event := audit.Event{
-			ID:         "",
-			Name:       "",
-			Source:     audit.SourceEngine,
-			Component:  "abac",
-			Subject:    req.Subject,
-			Action:     req.Action,
-			Resource:   req.Resource,
-			Effect:     types.EffectDefaultDeny,
-			DurationUS: time.Since(start).Microseconds(),
-			Timestamp:  time.Now(),
-		}
-		if auditErr := e.audit.Log(ctx, event); auditErr != nil {
-			slog.WarnContext(ctx, "audit log failed", "error", auditErr)
-			audit.RecordEngineAuditFailure()
+		if len(candidates) == 0 {
+			decision := types.NewDecision(types.EffectDefaultDeny, "no applicable policies", "")
+			decision.SetAttributes(bags)
+			if valErr := decision.Validate(); valErr != nil {
+				return decision, oops.Wrapf(valErr, "decision validation failed")
+			}
+
+			event := audit.Event{
+				ID:         "",
+				Name:       "",
+				Source:     audit.SourceEngine,
+				Component:  "abac",
+				Subject:    req.Subject,
+				Action:     req.Action,
+				Resource:   req.Resource,
+				Effect:     types.EffectDefaultDeny,
+				DurationUS: time.Since(start).Microseconds(),
+				Timestamp:  time.Now(),
+			}
+			if auditErr := e.audit.Log(ctx, event); auditErr != nil {
+				slog.WarnContext(ctx, "audit log failed", "error", auditErr)
+				audit.RecordEngineAuditFailure()
+			}
+
+			return decision, nil
 		}
 
-		return decision, nil
-	}
+		// Step 8: Evaluate conditions for each candidate policy
+		satisfied := make([]types.PolicyMatch, 0, len(candidates))
+		for _, candidate := range candidates {
+			met := e.evaluatePolicy(candidate, bags)
+			satisfied = append(satisfied, types.PolicyMatch{
+				PolicyID:      candidate.ID,
+				PolicyName:    candidate.Name,
+				Effect:        candidate.Compiled.Effect.ToEffect(),
+				ConditionsMet: met,
+			})
+		}
 
-	// Step 8: Evaluate conditions for each candidate policy
-	satisfied := make([]types.PolicyMatch, 0, len(candidates))
-	for _, candidate := range candidates {
-		met := e.evaluatePolicy(candidate, bags)
-		satisfied = append(satisfied, types.PolicyMatch{
-			PolicyID:      candidate.ID,
-			PolicyName:    candidate.Name,
-			Effect:        candidate.Compiled.Effect.ToEffect(),
-			ConditionsMet: met,
-		})
+		// Step 9: Deny-overrides combination
+		decision = e.combineDecisions(satisfied)
+		if e.decisions != nil {
+			e.decisions.put(cacheKey, snap.Generation, decision)
+		}
 	}
-
-	// Step 9: Deny-overrides combination
-	decision := e.combineDecisions(satisfied)
 	decision.SetAttributes(bags)
 	if err := decision.Validate(); err != nil {
 		return decision, oops.Wrapf(err, "decision validation failed")
@@ -341,6 +404,67 @@ func (e *Engine) Evaluate(ctx context.Context, req types.AccessRequest) (types.D
 	return decision, nil
 }
 
+// snapshot reads the policy snapshot, through the breaker when one is set.
+func (e *Engine) snapshot(ctx context.Context) (*Snapshot, error) {
+	if e.breaker == nil {
+		return e.cache.Snapshot(ctx)
+	}
+	return e.breaker.snapshot(ctx, e.cache)
+}
+
+// failsOpen reports whether action is allowed while the policy store is
+// unavailable. Without a breaker everything fails closed.
+func (e *Engine) failsOpen(action string) bool {
+	return e.breaker != nil && e.breaker.FailMode(action) == FailOpen
+}
+
+// failOpen allows a request the engine could not evaluate. The decision is
+// audited in every audit mode so each fail-open grant stays traceable.
+func (e *Engine) failOpen(ctx context.Context, req types.AccessRequest, start time.Time, reason string) types.Decision {
+	slog.WarnContext(
+		ctx, "ABAC read allowed by fail-open mode",
+		"reason", reason,
+		"subject", req.Subject,
+		"action", req.Action,
+		"resource", req.Resource,
+	)
+	decision := types.NewDecision(types.EffectAllow, reason+" (fail-open)", "infra:fail-open")
+	event := audit.Event{
+		ID:         "infra:fail-open",
+		Name:       "",
+		Message:    reason,
+		Source:     audit.SourceEngine,
+		Component:  "abac",
+		Subject:    req.Subject,
+		Action:     req.Action,
+		Resource:   req.Resource,
+		Effect:     types.EffectAllow,
+		DurationUS: time.Since(start).Microseconds(),
+		Timestamp:  time.Now(),
+		AlwaysLog:  true,
+	}
+	if auditErr := e.audit.Log(ctx, event); auditErr != nil {
+		slog.WarnContext(ctx, "audit log failed", "error", auditErr)
+		audit.RecordEngineAuditFailure()
+	}
+	failOpenDecisions.Inc()
+	RecordEvaluationMetrics(time.Since(start), decision.Effect())
+	return decision
+}
+
+// EvaluateBatch evaluates each request with the full Evaluate algorithm,
+// sharing one attribute cache across the batch so attributes common to the
+// requests (typically the subject's) are resolved once. Every request is
+// audited individually. Results are in request order.
+func (e *Engine) EvaluateBatch(ctx context.Context, requests []types.AccessRequest) []types.BatchResult {
+	ctx = attribute.WithSharedCache(ctx)
+	results := make([]types.BatchResult, len(requests))
+	for i, req := range requests {
+		results[i].Decision, results[i].Err = e.Evaluate(ctx, req)
+	}
+	return results
+}
+
 func (e *Engine) evaluatePolicy(policy CachedPolicy, bags *types.AttributeBags) bool {
 	evalCtx := &dsl.EvalContext{
 		Bags:      bags,
@@ -445,7 +569,7 @@ func (e *Engine) CanPerformAction(ctx context.Context, subject, action, resource
 	}
 
 	// Step 5: Get compiled policies from the cache snapshot
-	snap, snapErr := e.cache.Snapshot(ctx)
+	snap, snapErr := e.snapshot(ctx)
 	if snapErr != nil {
 		return false, oops.With("subject", subject).With("action", action).With("resourceType", resourceType).Wrap(snapErr)
 	}
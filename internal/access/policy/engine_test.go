@@ -303,6 +303,26 @@ func TestEngineNonSystemNonSession(t *testing.T) {
 	assert.NotNil(t, decision.Attributes(), "attributes should be populated")
 }
 
+func TestEngineEvaluateBatch(t *testing.T) {
+	engine, _ := createTestEngine(t, &mockSessionResolver{})
+
+	reqs := []types.AccessRequest{
+		{Subject: "character:01ABC", Action: "read", Resource: "location:01XYZ"},
+		{Subject: "system", Action: "read", Resource: "location:01XYZ"},
+		{Subject: "character:01ABC", Action: "read", Resource: "location:01DEF"},
+	}
+
+	results := engine.EvaluateBatch(context.Background(), reqs)
+	require.Len(t, results, len(reqs))
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, types.EffectDefaultDeny, results[0].Decision.Effect())
+	// A failing request does not affect its neighbours.
+	errutil.AssertErrorCode(t, results[1].Err, "SYSTEM_SUBJECT_REJECTED")
+	require.NoError(t, results[2].Err)
+	assert.Equal(t, types.EffectDefaultDeny, results[2].Decision.Effect())
+}
+
 func TestEngine_AllDecisionsValidate(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -48,12 +48,56 @@ var (
 		Help: "Total number of accesses to unregistered attributes (legacy, see abac_rejected_provider_attributes_total)",
 	}, []string{"namespace", "key"})
 
+	// decisionCacheHits counts Evaluate() calls served from the DecisionCache.
+	decisionCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_decision_cache_hits_total",
+		Help: "Total number of ABAC decisions served from the decision cache",
+	})
+
+	// decisionCacheMisses counts DecisionCache lookups that required a full evaluation.
+	decisionCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_decision_cache_misses_total",
+		Help: "Total number of ABAC decision cache misses",
+	})
+
+	// decisionCacheInvalidations counts DecisionCache purges (policy reloads or explicit Purge calls).
+	decisionCacheInvalidations = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_decision_cache_invalidations_total",
+		Help: "Total number of ABAC decision cache invalidations",
+	})
+
+	// decisionCacheEntityEvictions counts decisions dropped because a world
+	// write changed their subject or resource.
+	decisionCacheEntityEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_decision_cache_entity_evictions_total",
+		Help: "Total number of ABAC cached decisions evicted by entity invalidation",
+	})
+
 	// circuitBreakerTripsCounter counts circuit breaker trips per provider.
 	// Not yet used - will be wired when circuit breaker is implemented.
 	circuitBreakerTripsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "abac_provider_circuit_breaker_trips_total",
 		Help: "Total number of circuit breaker trips for attribute providers",
 	}, []string{"provider"})
+
+	// policyBreakerState reports the policy store circuit breaker state.
+	policyBreakerState = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "abac_policy_breaker_state",
+		Help: "Policy store circuit breaker state (0=closed, 1=open, 2=half-open)",
+	})
+
+	// policyBreakerTrips counts policy store circuit breaker trips.
+	policyBreakerTrips = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_policy_breaker_trips_total",
+		Help: "Total number of policy store circuit breaker trips",
+	})
+
+	// failOpenDecisions counts reads allowed because the policy store was
+	// unavailable and the read fail mode is open.
+	failOpenDecisions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "abac_fail_open_decisions_total",
+		Help: "Total number of ABAC reads allowed by the fail-open mode during a policy store outage",
+	})
 )
 
 // RecordEvaluationMetrics records metrics for a completed evaluation.
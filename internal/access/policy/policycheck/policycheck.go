@@ -0,0 +1,480 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package policycheck runs operator-written policy test suites. A suite is a
+// YAML table of access requests (subject, action, resource) with the effect
+// each is expected to produce, plus the entity attributes the requests are
+// evaluated against. Cases run through the real policy engine over a fixed
+// policy set — the live enabled policies or a staged bundle — so a grid can
+// regression-test its access rules before and after changing them.
+package policycheck
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+
+	"github.com/holomush/holomush/internal/access/policy"
+	"github.com/holomush/holomush/internal/access/policy/attribute"
+	"github.com/holomush/holomush/internal/access/policy/store"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+)
+
+// Expected outcomes a case may assert.
+const (
+	// ExpectAllow passes when the request is allowed.
+	ExpectAllow = "allow"
+	// ExpectDeny passes on any denial, explicit or default.
+	ExpectDeny = "deny"
+	// ExpectForbid passes only when a forbid policy denied the request.
+	ExpectForbid = "forbid"
+	// ExpectDefaultDeny passes only when no permit policy was satisfied and
+	// no forbid policy applied.
+	ExpectDefaultDeny = "default_deny"
+)
+
+// Suite is a policy test suite as written by an operator.
+type Suite struct {
+	// Entities maps an entity reference ("character:01ABC") to its
+	// attributes, grouped by namespace exactly as the policy DSL reads them:
+	// entities["character:01ABC"]["character"]["roles"] is
+	// principal.character.roles when that character is the subject.
+	Entities map[string]map[string]map[string]any `yaml:"entities"`
+	// Environment maps a namespace to the environment attributes it
+	// provides, read as env.<namespace>.<key>.
+	Environment map[string]map[string]any `yaml:"environment"`
+	// Cases are the requests to evaluate.
+	Cases []Case `yaml:"cases"`
+}
+
+// Case is one table row: a request and the outcome it must produce.
+type Case struct {
+	Name     string `yaml:"name"`
+	Subject  string `yaml:"subject"`
+	Action   string `yaml:"action"`
+	Resource string `yaml:"resource"`
+	// Attributes are per-call action attributes, as a caller would pass to
+	// types.NewAccessRequest.
+	Attributes map[string]any `yaml:"attributes"`
+	// Expect is one of allow, deny, forbid or default_deny.
+	Expect string `yaml:"expect"`
+	// Policy optionally names the policy that must decide the request.
+	Policy string `yaml:"policy"`
+}
+
+// Bundle is a staged policy set, in the same name/dsl shape plugin
+// manifests use for their policies.
+type Bundle struct {
+	Policies []BundlePolicy `yaml:"policies"`
+}
+
+// BundlePolicy is one staged policy.
+type BundlePolicy struct {
+	Name string `yaml:"name"`
+	DSL  string `yaml:"dsl"`
+}
+
+// LoadSuite reads and validates a suite file.
+func LoadSuite(path string) (*Suite, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // operator-supplied suite path
+	if err != nil {
+		return nil, oops.Code("POLICYCHECK_SUITE_READ_FAILED").With("path", path).Wrap(err)
+	}
+	suite, err := ParseSuite(data)
+	if err != nil {
+		return nil, oops.With("path", path).Wrap(err)
+	}
+	return suite, nil
+}
+
+// ParseSuite decodes and validates a suite document.
+func ParseSuite(data []byte) (*Suite, error) {
+	var suite Suite
+	if err := yaml.Unmarshal(data, &suite); err != nil {
+		return nil, oops.Code("POLICYCHECK_SUITE_INVALID").Wrap(err)
+	}
+	if err := suite.Validate(); err != nil {
+		return nil, err
+	}
+	return &suite, nil
+}
+
+// Validate checks every case is complete and names a known expectation.
+// Unnamed cases are named after their request.
+func (s *Suite) Validate() error {
+	if len(s.Cases) == 0 {
+		return oops.Code("POLICYCHECK_SUITE_INVALID").Errorf("suite has no cases")
+	}
+	for i := range s.Cases {
+		c := &s.Cases[i]
+		if c.Subject == "" || c.Action == "" || c.Resource == "" {
+			return oops.Code("POLICYCHECK_SUITE_INVALID").
+				With("case", i).
+				Errorf("case %d: subject, action and resource are required", i)
+		}
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("%s %s %s", c.Subject, c.Action, c.Resource)
+		}
+		switch c.Expect {
+		case ExpectAllow, ExpectDeny, ExpectForbid, ExpectDefaultDeny:
+		default:
+			return oops.Code("POLICYCHECK_SUITE_INVALID").
+				With("case", c.Name).
+				Errorf("case %q: expect must be allow, deny, forbid or default_deny, got %q", c.Name, c.Expect)
+		}
+	}
+	return nil
+}
+
+// LoadBundle reads a staged policy bundle and returns it as stored policies
+// ready for Run. A policy's ID is its name.
+func LoadBundle(path string) ([]*store.StoredPolicy, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // operator-supplied bundle path
+	if err != nil {
+		return nil, oops.Code("POLICYCHECK_BUNDLE_READ_FAILED").With("path", path).Wrap(err)
+	}
+	var bundle Bundle
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return nil, oops.Code("POLICYCHECK_BUNDLE_INVALID").With("path", path).Wrap(err)
+	}
+	policies := make([]*store.StoredPolicy, 0, len(bundle.Policies))
+	for i, p := range bundle.Policies {
+		if p.Name == "" || strings.TrimSpace(p.DSL) == "" {
+			return nil, oops.Code("POLICYCHECK_BUNDLE_INVALID").
+				With("path", path).
+				Errorf("policy[%d]: name and dsl are required", i)
+		}
+		policies = append(policies, &store.StoredPolicy{ID: p.Name, Name: p.Name, DSLText: p.DSL, Enabled: true})
+	}
+	return policies, nil
+}
+
+// SeedBundle returns the shipped seed policies as stored policies, for
+// staging a bundle on top of the defaults a fresh grid starts with.
+func SeedBundle() []*store.StoredPolicy {
+	seeds := policy.SeedPolicies()
+	policies := make([]*store.StoredPolicy, 0, len(seeds))
+	for _, s := range seeds {
+		policies = append(policies, &store.StoredPolicy{ID: s.Name, Name: s.Name, DSLText: s.DSLText, Enabled: true})
+	}
+	return policies
+}
+
+// Overlay returns base with every policy in staged replacing the base
+// policy of the same name; staged policies with new names are appended.
+func Overlay(base, staged []*store.StoredPolicy) []*store.StoredPolicy {
+	byName := make(map[string]int, len(base))
+	merged := make([]*store.StoredPolicy, 0, len(base)+len(staged))
+	for _, p := range base {
+		byName[p.Name] = len(merged)
+		merged = append(merged, p)
+	}
+	for _, p := range staged {
+		if i, ok := byName[p.Name]; ok {
+			merged[i] = p
+			continue
+		}
+		byName[p.Name] = len(merged)
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+// Result is the outcome of one case.
+type Result struct {
+	Case     Case
+	Decision types.Decision
+	// Err is set when the engine could not evaluate the request.
+	Err    error
+	Passed bool
+	// Decider is the name of the policy that decided the request, empty for
+	// a default deny.
+	Decider string
+}
+
+// Report is the outcome of a suite run, in case order.
+type Report struct {
+	Results []Result
+}
+
+// Failed returns the number of failed cases.
+func (r *Report) Failed() int {
+	n := 0
+	for _, res := range r.Results {
+		if !res.Passed {
+			n++
+		}
+	}
+	return n
+}
+
+// Run evaluates every case in suite against policies. The policies are
+// compiled the same way the policy cache compiles them; a policy that fails
+// to compile fails the run rather than silently dropping out of the set.
+// The suite's entities and environment stand in for the attribute
+// providers, and session subjects never resolve.
+func Run(ctx context.Context, suite *Suite, policies []*store.StoredPolicy) (*Report, error) {
+	resolver, err := fixtureResolver(suite)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := policy.NewCache(staticStore{policies: policies}, policy.NewCompiler(types.NewAttributeSchema()))
+	if err := cache.Reload(ctx); err != nil {
+		return nil, oops.Code("POLICYCHECK_COMPILE_FAILED").Wrap(err)
+	}
+	names := make(map[string]string, len(policies))
+	for _, p := range policies {
+		names[p.ID] = p.Name
+	}
+
+	// The run is a dry evaluation: decisions are not audited anywhere.
+	auditLogger := audit.NewLogger(audit.ModeMinimal, discardWriter{}, os.DevNull)
+	defer auditLogger.Close() //nolint:errcheck // discardWriter never fails
+	engine := policy.NewEngine(resolver, cache, noSessions{}, auditLogger)
+
+	report := &Report{Results: make([]Result, 0, len(suite.Cases))}
+	for _, c := range suite.Cases {
+		report.Results = append(report.Results, runCase(ctx, engine, names, c))
+	}
+	return report, nil
+}
+
+func runCase(ctx context.Context, engine *policy.Engine, names map[string]string, c Case) Result {
+	res := Result{Case: c}
+	attrs, err := normalizeMap(c.Attributes)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	req, err := types.NewAccessRequest(c.Subject, c.Action, c.Resource, attrs)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	res.Decision, res.Err = engine.Evaluate(ctx, req)
+	if res.Err != nil {
+		return res
+	}
+	res.Decider = names[res.Decision.PolicyID()]
+	res.Passed = effectMatches(c.Expect, res.Decision.Effect()) &&
+		(c.Policy == "" || c.Policy == res.Decider)
+	return res
+}
+
+func effectMatches(expect string, effect types.Effect) bool {
+	switch expect {
+	case ExpectAllow:
+		return effect == types.EffectAllow
+	case ExpectDeny:
+		return effect == types.EffectDeny || effect == types.EffectDefaultDeny
+	case ExpectForbid:
+		return effect == types.EffectDeny
+	case ExpectDefaultDeny:
+		return effect == types.EffectDefaultDeny
+	default:
+		return false
+	}
+}
+
+// fixtureResolver builds an attribute resolver whose providers serve the
+// suite's entities and environment. Each namespace's schema is inferred
+// from the fixture values so the resolver keeps every declared key.
+func fixtureResolver(suite *Suite) (*attribute.Resolver, error) {
+	entities := make(map[string]map[string]map[string]any, len(suite.Entities))
+	schemas := make(map[string]map[string]types.AttrType)
+	for ref, namespaces := range suite.Entities {
+		entities[ref] = make(map[string]map[string]any, len(namespaces))
+		for ns, attrs := range namespaces {
+			normalized, err := normalizeAttrs(schemas, ns, attrs)
+			if err != nil {
+				return nil, oops.With("entity", ref).Wrap(err)
+			}
+			entities[ref][ns] = normalized
+		}
+	}
+
+	resolver := attribute.NewResolver(attribute.NewSchemaRegistry())
+	for _, ns := range sortedKeys(schemas) {
+		provider := &fixtureProvider{
+			namespace: ns,
+			entities:  entities,
+			schema:    &types.NamespaceSchema{Attributes: schemas[ns]},
+		}
+		if err := resolver.RegisterProvider(provider); err != nil {
+			return nil, oops.Code("POLICYCHECK_SUITE_INVALID").With("namespace", ns).Wrap(err)
+		}
+	}
+
+	envSchemas := make(map[string]map[string]types.AttrType)
+	for _, ns := range sortedKeys(suite.Environment) {
+		attrs, err := normalizeAttrs(envSchemas, ns, suite.Environment[ns])
+		if err != nil {
+			return nil, oops.With("environment", ns).Wrap(err)
+		}
+		provider := &fixtureEnvProvider{
+			namespace: ns,
+			attrs:     attrs,
+			schema:    &types.NamespaceSchema{Attributes: envSchemas[ns]},
+		}
+		if err := resolver.RegisterEnvironmentProvider(provider); err != nil {
+			return nil, oops.Code("POLICYCHECK_SUITE_INVALID").With("namespace", ns).Wrap(err)
+		}
+	}
+	return resolver, nil
+}
+
+// normalizeAttrs converts attrs to engine value types and records each
+// key's type in schemas[ns], rejecting a key whose type differs between
+// entities.
+func normalizeAttrs(schemas map[string]map[string]types.AttrType, ns string, attrs map[string]any) (map[string]any, error) {
+	if schemas[ns] == nil {
+		schemas[ns] = make(map[string]types.AttrType)
+	}
+	out := make(map[string]any, len(attrs))
+	for key, raw := range attrs {
+		value, attrType, err := normalizeValue(raw)
+		if err != nil {
+			return nil, oops.Code("POLICYCHECK_SUITE_INVALID").
+				With("namespace", ns).With("key", key).Wrap(err)
+		}
+		if prev, ok := schemas[ns][key]; ok && prev != attrType {
+			return nil, oops.Code("POLICYCHECK_SUITE_INVALID").
+				With("namespace", ns).With("key", key).
+				Errorf("attribute %s.%s is %s in one entity and %s in another", ns, key, prev, attrType)
+		}
+		schemas[ns][key] = attrType
+		out[key] = value
+	}
+	return out, nil
+}
+
+func normalizeMap(attrs map[string]any) (map[string]any, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]any, len(attrs))
+	for key, raw := range attrs {
+		value, _, err := normalizeValue(raw)
+		if err != nil {
+			return nil, oops.Code("POLICYCHECK_SUITE_INVALID").With("key", key).Wrap(err)
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// normalizeValue maps a decoded YAML value onto the engine's attribute
+// types. Numbers become float64, as attribute providers MUST return them.
+func normalizeValue(raw any) (any, types.AttrType, error) {
+	switch v := raw.(type) {
+	case string:
+		return v, types.AttrTypeString, nil
+	case bool:
+		return v, types.AttrTypeBool, nil
+	case int:
+		return float64(v), types.AttrTypeFloat, nil
+	case int64:
+		return float64(v), types.AttrTypeFloat, nil
+	case uint64:
+		return float64(v), types.AttrTypeFloat, nil
+	case float64:
+		return v, types.AttrTypeFloat, nil
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, 0, oops.Errorf("list attributes must contain only strings, got %T", item)
+			}
+			list = append(list, s)
+		}
+		return list, types.AttrTypeStringList, nil
+	default:
+		return nil, 0, oops.Errorf("unsupported attribute value of type %T", raw)
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fixtureProvider serves one namespace of the suite's entity attributes
+// for both subjects and resources.
+type fixtureProvider struct {
+	namespace string
+	entities  map[string]map[string]map[string]any
+	schema    *types.NamespaceSchema
+}
+
+func (p *fixtureProvider) Namespace() string { return p.namespace }
+
+func (p *fixtureProvider) ResolveSubject(_ context.Context, subjectID string) (map[string]any, error) {
+	return p.entities[subjectID][p.namespace], nil
+}
+
+func (p *fixtureProvider) ResolveResource(_ context.Context, resourceID string) (map[string]any, error) {
+	return p.entities[resourceID][p.namespace], nil
+}
+
+func (p *fixtureProvider) Schema() *types.NamespaceSchema { return p.schema }
+
+// fixtureEnvProvider serves one namespace of the suite's environment.
+type fixtureEnvProvider struct {
+	namespace string
+	attrs     map[string]any
+	schema    *types.NamespaceSchema
+}
+
+func (p *fixtureEnvProvider) Namespace() string { return p.namespace }
+
+func (p *fixtureEnvProvider) Resolve(context.Context) (map[string]any, error) {
+	return p.attrs, nil
+}
+
+func (p *fixtureEnvProvider) Schema() *types.NamespaceSchema { return p.schema }
+
+// staticStore feeds a fixed policy set to the policy cache. The cache only
+// ever lists enabled policies; every other PolicyStore method is left to the
+// nil embedded interface and is never reached.
+type staticStore struct {
+	store.PolicyStore
+	policies []*store.StoredPolicy
+}
+
+func (s staticStore) ListEnabled(context.Context) ([]*store.StoredPolicy, error) {
+	enabled := make([]*store.StoredPolicy, 0, len(s.policies))
+	for _, p := range s.policies {
+		if p.Enabled {
+			enabled = append(enabled, p)
+		}
+	}
+	return enabled, nil
+}
+
+// noSessions rejects every session subject: a suite names characters
+// directly.
+type noSessions struct{}
+
+func (noSessions) ResolveSession(_ context.Context, sessionID string) (string, error) {
+	return "", oops.Code("SESSION_INVALID").With("session_id", sessionID).
+		Errorf("policy suites cannot resolve sessions; use a character subject")
+}
+
+// discardWriter drops audit events.
+type discardWriter struct{}
+
+func (discardWriter) WriteSync(context.Context, audit.Event) error { return nil }
+func (discardWriter) WriteAsync(audit.Event) error                 { return nil }
+func (discardWriter) Close() error                                 { return nil }
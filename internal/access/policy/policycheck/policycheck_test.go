@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policycheck_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/policycheck"
+	"github.com/holomush/holomush/internal/access/policy/store"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+const testSuite = `
+entities:
+  character:01ALICE:
+    character:
+      location: "01HALL"
+      roles: [builder]
+      level: 3
+  character:01BOB:
+    character:
+      location: "01CELLAR"
+      roles: []
+      level: 1
+  location:01HALL:
+    location:
+      id: "01HALL"
+environment:
+  world:
+    maintenance: false
+cases:
+  - name: builders may edit their location
+    subject: character:01ALICE
+    action: write
+    resource: location:01HALL
+    expect: allow
+    policy: builders-edit
+  - subject: character:01BOB
+    action: write
+    resource: location:01HALL
+    expect: default_deny
+  - name: deletes are forbidden outside maintenance
+    subject: character:01ALICE
+    action: delete
+    resource: location:01HALL
+    expect: forbid
+`
+
+func testPolicies() []*store.StoredPolicy {
+	return []*store.StoredPolicy{
+		{ID: "p1", Name: "builders-edit", Enabled: true,
+			DSLText: `permit(principal is character, action in ["write", "delete"], resource is location) when { "builder" in principal.character.roles && principal.character.level >= 2 };`},
+		{ID: "p2", Name: "no-delete", Enabled: true,
+			DSLText: `forbid(principal is character, action in ["delete"], resource is location) when { env.world.maintenance == false };`},
+	}
+}
+
+func TestRunEvaluatesCasesThroughTheEngine(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(testSuite))
+	require.NoError(t, err)
+
+	report, err := policycheck.Run(context.Background(), suite, testPolicies())
+	require.NoError(t, err)
+
+	require.Len(t, report.Results, 3)
+	for _, res := range report.Results {
+		require.NoError(t, res.Err, res.Case.Name)
+		assert.True(t, res.Passed, res.Case.Name)
+	}
+	assert.Equal(t, "character:01BOB write location:01HALL", report.Results[1].Case.Name, "unnamed cases are named after the request")
+	assert.Equal(t, "no-delete", report.Results[2].Decider)
+	assert.Zero(t, report.Failed())
+}
+
+func TestRunReportsFailuresWithExplanation(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(`
+entities:
+  character:01BOB:
+    character:
+      roles: []
+      level: 1
+cases:
+  - name: bob may edit
+    subject: character:01BOB
+    action: write
+    resource: location:01HALL
+    expect: allow
+`))
+	require.NoError(t, err)
+
+	report, err := policycheck.Run(context.Background(), suite, testPolicies())
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Failed())
+
+	var out bytes.Buffer
+	require.NoError(t, report.Write(&out, false))
+	assert.Contains(t, out.String(), "FAIL  bob may edit")
+	assert.Contains(t, out.String(), "expect:  allow")
+	assert.Contains(t, out.String(), "got:     default_deny")
+	assert.Contains(t, out.String(), "- permit builders-edit: conditions not met")
+	assert.Contains(t, out.String(), "0 passed, 1 failed")
+}
+
+func TestRunChecksTheDecidingPolicy(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(`
+entities:
+  character:01ALICE:
+    character:
+      roles: [builder]
+      level: 3
+cases:
+  - subject: character:01ALICE
+    action: write
+    resource: location:01HALL
+    expect: allow
+    policy: some-other-policy
+`))
+	require.NoError(t, err)
+
+	report, err := policycheck.Run(context.Background(), suite, testPolicies())
+	require.NoError(t, err)
+	assert.False(t, report.Results[0].Passed)
+	assert.Equal(t, "builders-edit", report.Results[0].Decider)
+}
+
+func TestRunSessionSubjectsDoNotResolve(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(`
+cases:
+  - subject: session:01SESS
+    action: write
+    resource: location:01HALL
+    expect: default_deny
+`))
+	require.NoError(t, err)
+
+	report, err := policycheck.Run(context.Background(), suite, testPolicies())
+	require.NoError(t, err)
+	assert.True(t, report.Results[0].Passed)
+	assert.Equal(t, "session invalid", report.Results[0].Decision.Reason())
+}
+
+func TestRunFailsOnUncompilablePolicy(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(testSuite))
+	require.NoError(t, err)
+
+	_, err = policycheck.Run(context.Background(), suite, []*store.StoredPolicy{
+		{ID: "bad", Name: "bad", Enabled: true, DSLText: "permit(nonsense"},
+	})
+	errutil.AssertErrorCode(t, err, "POLICYCHECK_COMPILE_FAILED")
+}
+
+func TestParseSuiteRejectsInvalidCases(t *testing.T) {
+	tests := []struct {
+		name  string
+		suite string
+	}{
+		{"no cases", "cases: []"},
+		{"missing resource", "cases: [{subject: character:01A, action: read, expect: allow}]"},
+		{"unknown expectation", "cases: [{subject: character:01A, action: read, resource: location:01B, expect: maybe}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policycheck.ParseSuite([]byte(tt.suite))
+			errutil.AssertErrorCode(t, err, "POLICYCHECK_SUITE_INVALID")
+		})
+	}
+}
+
+func TestRunRejectsConflictingAttributeTypes(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(`
+entities:
+  character:01A:
+    character: {level: 1}
+  character:01B:
+    character: {level: "high"}
+cases:
+  - {subject: character:01A, action: read, resource: location:01B, expect: deny}
+`))
+	require.NoError(t, err)
+
+	_, err = policycheck.Run(context.Background(), suite, nil)
+	errutil.AssertErrorCode(t, err, "POLICYCHECK_SUITE_INVALID")
+}
+
+func TestLoadBundleAndOverlay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+policies:
+  - name: builders-edit
+    dsl: 'permit(principal is character, action in ["write"], resource is location);'
+  - name: extra
+    dsl: 'permit(principal is character, action in ["read"], resource is location);'
+`), 0o600))
+
+	staged, err := policycheck.LoadBundle(path)
+	require.NoError(t, err)
+	require.Len(t, staged, 2)
+
+	merged := policycheck.Overlay(testPolicies(), staged)
+	require.Len(t, merged, 3)
+	assert.Equal(t, staged[0], merged[0], "a staged policy replaces the base policy of the same name")
+	assert.Equal(t, "no-delete", merged[1].Name)
+	assert.Equal(t, "extra", merged[2].Name)
+}
+
+func TestLoadBundleRejectsIncompletePolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bundle.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("policies: [{name: empty}]"), 0o600))
+
+	_, err := policycheck.LoadBundle(path)
+	errutil.AssertErrorCode(t, err, "POLICYCHECK_BUNDLE_INVALID")
+}
+
+func TestSeedBundleCompiles(t *testing.T) {
+	suite, err := policycheck.ParseSuite([]byte(`
+cases:
+  - {subject: character:01A, action: enter, resource: location:01B, expect: allow}
+`))
+	require.NoError(t, err)
+
+	report, err := policycheck.Run(context.Background(), suite, policycheck.SeedBundle())
+	require.NoError(t, err)
+	assert.Zero(t, report.Failed())
+}
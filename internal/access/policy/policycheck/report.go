@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policycheck
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// Write prints one line per case and, for failed cases (or every case when
+// explain is set), the explanation of the decision: each applicable policy,
+// its effect, and whether its conditions held. It ends with a summary line.
+func (r *Report) Write(w io.Writer, explain bool) error {
+	var b strings.Builder
+	for _, res := range r.Results {
+		status := "PASS"
+		if !res.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "%s  %s\n", status, res.Case.Name)
+		if res.Passed && !explain {
+			continue
+		}
+		writeExplanation(&b, res)
+	}
+	fmt.Fprintf(&b, "%d passed, %d failed\n", len(r.Results)-r.Failed(), r.Failed())
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeExplanation(b *strings.Builder, res Result) {
+	c := res.Case
+	fmt.Fprintf(b, "      request: %s %s %s\n", c.Subject, c.Action, c.Resource)
+	if res.Err != nil {
+		fmt.Fprintf(b, "      error:   %v\n", res.Err)
+		return
+	}
+	expected := c.Expect
+	if c.Policy != "" {
+		expected += " by " + c.Policy
+	}
+	got := res.Decision.Effect().String()
+	if res.Decider != "" {
+		got += " by " + res.Decider
+	}
+	fmt.Fprintf(b, "      expect:  %s\n", expected)
+	fmt.Fprintf(b, "      got:     %s (%s)\n", got, res.Decision.Reason())
+	matches := res.Decision.Policies()
+	if len(matches) == 0 {
+		b.WriteString("      no policy targets this request\n")
+		return
+	}
+	for _, m := range matches {
+		verdict := "conditions not met"
+		if m.ConditionsMet {
+			verdict = "conditions met"
+		}
+		fmt.Fprintf(b, "      - %s %s: %s\n", policyVerb(m.Effect), m.PolicyName, verdict)
+	}
+}
+
+// policyVerb renders a policy's declared effect as written in the DSL.
+func policyVerb(effect types.Effect) string {
+	if effect == types.EffectDeny {
+		return string(types.PolicyEffectForbid)
+	}
+	return string(types.PolicyEffectPermit)
+}
@@ -0,0 +1,321 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policytest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// Scenario is an in-process types.AccessPolicyEngine for service tests. It
+// is configured with a small rule DSL instead of per-test stub engines:
+//
+//	s := policytest.NewScenario(t)
+//	s.Allow("character:01A", "character:01B").To("read", "write").On("location:*")
+//	s.Allow("character:01A").To("moderate").On("scene:01S").Until(deadline)
+//	s.Deny("character:01B").To("write").On("location:01L")
+//	s.Allow("character:01C").To("enter").On("location:*").WhenAttr("role", "builder")
+//
+// Deny rules win over allow rules, mirroring forbid-overrides-permit in the
+// real engine; a request no rule matches gets EffectDefaultDeny. Every
+// Evaluate call is recorded so tests can assert what the code under test
+// asked for. Scenario is safe for concurrent use.
+type Scenario struct {
+	t testing.TB
+
+	mu    sync.Mutex
+	now   time.Time
+	rules []*Rule
+	calls []types.AccessRequest
+}
+
+// Rule is one allow or deny entry of a Scenario. A rule matches every
+// combination of its subjects, actions and resources (a grant matrix), and
+// can be narrowed to a time window or to request attributes. Builder methods
+// return the rule so they chain.
+type Rule struct {
+	s *Scenario
+
+	deny      bool
+	name      string
+	subjects  []string
+	actions   []string
+	resources []string
+	from      time.Time
+	until     time.Time
+	when      []func(types.AccessRequest) bool
+}
+
+// NewScenario creates a Scenario with no rules (denies everything). Its
+// clock starts at the current time; see SetNow and Advance.
+func NewScenario(t testing.TB) *Scenario {
+	t.Helper()
+	return &Scenario{t: t, now: time.Now()}
+}
+
+// Allow starts an allow rule for the given subjects. The rule matches
+// nothing until To and On name its actions and resources.
+func (s *Scenario) Allow(subjects ...string) *Rule {
+	return s.add(false, subjects)
+}
+
+// Deny starts a deny rule for the given subjects. A matching deny rule
+// overrides any allow rule.
+func (s *Scenario) Deny(subjects ...string) *Rule {
+	return s.add(true, subjects)
+}
+
+// AllowCommands grants Layer 1 command execution for the named commands,
+// the Scenario counterpart of GrantEngine.GrantCommandExecution.
+func (s *Scenario) AllowCommands(subject string, commands ...string) *Rule {
+	resources := make([]string, 0, len(commands))
+	for _, cmd := range commands {
+		resources = append(resources, "command:"+cmd)
+	}
+	return s.Allow(subject).To("execute").On(resources...)
+}
+
+func (s *Scenario) add(deny bool, subjects []string) *Rule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &Rule{s: s, deny: deny, subjects: subjects}
+	r.name = fmt.Sprintf("test-scenario:%d", len(s.rules))
+	s.rules = append(s.rules, r)
+	return r
+}
+
+// To sets the actions the rule covers.
+func (r *Rule) To(actions ...string) *Rule {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.actions = append(r.actions, actions...)
+	return r
+}
+
+// On sets the resources the rule covers. A resource ending in "*" matches
+// any resource with that prefix ("location:*"); "*" alone matches all.
+func (r *Rule) On(resources ...string) *Rule {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.resources = append(r.resources, resources...)
+	return r
+}
+
+// Named sets the policy ID reported in the rule's decisions.
+func (r *Rule) Named(policyID string) *Rule {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.name = policyID
+	return r
+}
+
+// Until limits the rule to Scenario times before t.
+func (r *Rule) Until(t time.Time) *Rule {
+	return r.Between(time.Time{}, t)
+}
+
+// Between limits the rule to Scenario times in [from, until). A zero bound
+// is open.
+func (r *Rule) Between(from, until time.Time) *Rule {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.from, r.until = from, until
+	return r
+}
+
+// When limits the rule to requests cond accepts. Several conditions must
+// all hold.
+func (r *Rule) When(cond func(types.AccessRequest) bool) *Rule {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.when = append(r.when, cond)
+	return r
+}
+
+// WhenAttr limits the rule to requests whose caller-supplied attribute key
+// equals want.
+func (r *Rule) WhenAttr(key string, want any) *Rule {
+	return r.When(func(req types.AccessRequest) bool {
+		got, ok := req.Attributes[key]
+		return ok && reflect.DeepEqual(got, want)
+	})
+}
+
+// SetNow sets the Scenario clock that time-bounded rules are checked
+// against.
+func (s *Scenario) SetNow(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// Advance moves the Scenario clock forward by d.
+func (s *Scenario) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(d)
+}
+
+// Evaluate implements types.AccessPolicyEngine.
+func (s *Scenario) Evaluate(_ context.Context, req types.AccessRequest) (types.Decision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, req)
+
+	var allow *Rule
+	for _, r := range s.rules {
+		if !r.matches(req, s.now) {
+			continue
+		}
+		if r.deny {
+			return types.NewDecision(types.EffectDeny, "test-scenario-deny", r.name), nil
+		}
+		if allow == nil {
+			allow = r
+		}
+	}
+	if allow != nil {
+		return types.NewDecision(types.EffectAllow, "test-scenario-allow", allow.name), nil
+	}
+	return types.NewDecision(types.EffectDefaultDeny, "test-no-grant", ""), nil
+}
+
+// CanPerformAction implements types.AccessPolicyEngine. It reports whether
+// an allow rule in effect now covers subject+action on some resource of
+// resourceType. Attribute conditions and deny rules are not consulted: the
+// pre-flight only asks whether the action could ever be allowed.
+func (s *Scenario) CanPerformAction(_ context.Context, subject, action, resourceType, _ string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.rules {
+		if r.deny || !r.active(s.now) || !matchAny(r.subjects, subject) || !matchAny(r.actions, action) {
+			continue
+		}
+		for _, res := range r.resources {
+			if res == "*" || res == resourceType || strings.HasPrefix(res, resourceType+":") {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Calls returns a copy of every request passed to Evaluate, in order.
+func (s *Scenario) Calls() []types.AccessRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]types.AccessRequest(nil), s.calls...)
+}
+
+// Evaluations counts the Evaluate calls for subject+action+resource.
+func (s *Scenario) Evaluations(subject, action, resource string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, c := range s.calls {
+		if c.Subject == subject && c.Action == action && c.Resource == resource {
+			n++
+		}
+	}
+	return n
+}
+
+// ResetCalls forgets the recorded Evaluate calls; rules are kept.
+func (s *Scenario) ResetCalls() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = nil
+}
+
+// AssertEvaluated fails the test unless Evaluate was called at least once
+// for subject+action+resource.
+func (s *Scenario) AssertEvaluated(subject, action, resource string) bool {
+	s.t.Helper()
+	if s.Evaluations(subject, action, resource) > 0 {
+		return true
+	}
+	s.t.Errorf("policytest: expected Evaluate(%s, %s, %s); got calls:\n%s",
+		subject, action, resource, s.formatCalls())
+	return false
+}
+
+// AssertNotEvaluated fails the test if Evaluate was called for
+// subject+action+resource.
+func (s *Scenario) AssertNotEvaluated(subject, action, resource string) bool {
+	s.t.Helper()
+	if n := s.Evaluations(subject, action, resource); n > 0 {
+		s.t.Errorf("policytest: expected no Evaluate(%s, %s, %s); got %d", subject, action, resource, n)
+		return false
+	}
+	return true
+}
+
+// AssertNoEvaluations fails the test if Evaluate was called at all, for
+// paths that must be rejected before the engine is consulted.
+func (s *Scenario) AssertNoEvaluations() bool {
+	s.t.Helper()
+	if len(s.Calls()) > 0 {
+		s.t.Errorf("policytest: expected no Evaluate calls; got:\n%s", s.formatCalls())
+		return false
+	}
+	return true
+}
+
+func (s *Scenario) formatCalls() string {
+	calls := s.Calls()
+	if len(calls) == 0 {
+		return "  (none)"
+	}
+	lines := make([]string, 0, len(calls))
+	for _, c := range calls {
+		lines = append(lines, fmt.Sprintf("  Evaluate(%s, %s, %s)", c.Subject, c.Action, c.Resource))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r *Rule) matches(req types.AccessRequest, now time.Time) bool {
+	if !r.active(now) ||
+		!matchAny(r.subjects, req.Subject) ||
+		!matchAny(r.actions, req.Action) ||
+		!matchAny(r.resources, req.Resource) {
+		return false
+	}
+	for _, cond := range r.when {
+		if !cond(req) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Rule) active(now time.Time) bool {
+	if !r.from.IsZero() && now.Before(r.from) {
+		return false
+	}
+	return r.until.IsZero() || now.Before(r.until)
+}
+
+// matchAny reports whether value matches one of patterns. A pattern ending
+// in "*" matches by prefix.
+func matchAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(value, prefix) {
+				return true
+			}
+			continue
+		}
+		if p == value {
+			return true
+		}
+	}
+	return false
+}
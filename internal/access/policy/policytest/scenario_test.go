@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package policytest_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+func evaluate(t *testing.T, engine types.AccessPolicyEngine, subject, action, resource string, attrs map[string]any) types.Decision {
+	t.Helper()
+	req, err := types.NewAccessRequest(subject, action, resource, attrs)
+	require.NoError(t, err)
+	decision, err := engine.Evaluate(context.Background(), req)
+	require.NoError(t, err)
+	return decision
+}
+
+func TestScenarioGrantMatrix(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.Allow("character:01A", "character:01B").To("read", "write").On("location:01L", "object:*")
+
+	for _, subject := range []string{"character:01A", "character:01B"} {
+		for _, action := range []string{"read", "write"} {
+			assert.True(t, evaluate(t, s, subject, action, "location:01L", nil).IsAllowed(), subject+" "+action)
+			assert.True(t, evaluate(t, s, subject, action, "object:01O", nil).IsAllowed(), subject+" "+action)
+		}
+	}
+	d := evaluate(t, s, "character:01C", "read", "location:01L", nil)
+	assert.Equal(t, types.EffectDefaultDeny, d.Effect())
+	assert.False(t, evaluate(t, s, "character:01A", "delete", "location:01L", nil).IsAllowed())
+	assert.False(t, evaluate(t, s, "character:01A", "read", "location:02L", nil).IsAllowed())
+}
+
+func TestScenarioDenyOverridesAllow(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.Allow("character:01A").To("read").On("*")
+	s.Deny("character:01A").To("read").On("location:01L").Named("test:forbid")
+
+	assert.True(t, evaluate(t, s, "character:01A", "read", "location:02L", nil).IsAllowed())
+	d := evaluate(t, s, "character:01A", "read", "location:01L", nil)
+	assert.Equal(t, types.EffectDeny, d.Effect())
+	assert.Equal(t, "test:forbid", d.PolicyID())
+}
+
+func TestScenarioTimeBoundedGrants(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := policytest.NewScenario(t)
+	s.SetNow(start)
+	s.Allow("character:01A").To("moderate").On("scene:01S").Until(start.Add(time.Hour))
+	s.Allow("character:01B").To("moderate").On("scene:01S").Between(start.Add(time.Hour), start.Add(2*time.Hour))
+
+	assert.True(t, evaluate(t, s, "character:01A", "moderate", "scene:01S", nil).IsAllowed())
+	assert.False(t, evaluate(t, s, "character:01B", "moderate", "scene:01S", nil).IsAllowed())
+
+	s.Advance(time.Hour)
+	assert.False(t, evaluate(t, s, "character:01A", "moderate", "scene:01S", nil).IsAllowed(), "until is exclusive")
+	assert.True(t, evaluate(t, s, "character:01B", "moderate", "scene:01S", nil).IsAllowed())
+
+	ok, err := s.CanPerformAction(context.Background(), "character:01A", "moderate", "scene", "")
+	require.NoError(t, err)
+	assert.False(t, ok, "expired grants do not pass the pre-flight")
+
+	s.Advance(time.Hour)
+	assert.False(t, evaluate(t, s, "character:01B", "moderate", "scene:01S", nil).IsAllowed())
+}
+
+func TestScenarioAttributeRules(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.Allow("character:01A").To("enter").On("location:*").WhenAttr("role", "builder")
+	s.Allow("character:01A").To("leave").On("location:*").When(func(req types.AccessRequest) bool {
+		n, ok := req.Attributes["count"].(int)
+		return ok && n < 3
+	})
+
+	assert.True(t, evaluate(t, s, "character:01A", "enter", "location:01L", map[string]any{"role": "builder"}).IsAllowed())
+	assert.False(t, evaluate(t, s, "character:01A", "enter", "location:01L", map[string]any{"role": "player"}).IsAllowed())
+	assert.False(t, evaluate(t, s, "character:01A", "enter", "location:01L", nil).IsAllowed())
+	assert.True(t, evaluate(t, s, "character:01A", "leave", "location:01L", map[string]any{"count": 2}).IsAllowed())
+	assert.False(t, evaluate(t, s, "character:01A", "leave", "location:01L", map[string]any{"count": 3}).IsAllowed())
+}
+
+func TestScenarioCanPerformAction(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.AllowCommands("character:01A", "dig", "link")
+	s.Allow("character:01A").To("read").On("stream")
+
+	for _, tt := range []struct {
+		action, resourceType string
+		want                 bool
+	}{
+		{"execute", "command", true},
+		{"read", "stream", true},
+		{"execute", "location", false},
+		{"write", "stream", false},
+	} {
+		ok, err := s.CanPerformAction(context.Background(), "character:01A", tt.action, tt.resourceType, "")
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, ok, tt.action+" "+tt.resourceType)
+	}
+	assert.True(t, evaluate(t, s, "character:01A", "execute", "command:link", nil).IsAllowed())
+}
+
+func TestScenarioRecordsCalls(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.AssertNoEvaluations()
+
+	evaluate(t, s, "character:01A", "read", "location:01L", nil)
+	evaluate(t, s, "character:01A", "read", "location:01L", nil)
+	evaluate(t, s, "character:01B", "write", "object:01O", nil)
+
+	assert.True(t, s.AssertEvaluated("character:01A", "read", "location:01L"))
+	assert.True(t, s.AssertNotEvaluated("character:01A", "write", "location:01L"))
+	assert.Equal(t, 2, s.Evaluations("character:01A", "read", "location:01L"))
+	calls := s.Calls()
+	require.Len(t, calls, 3)
+	assert.Equal(t, "object:01O", calls[2].Resource)
+
+	s.ResetCalls()
+	assert.Empty(t, s.Calls())
+}
+
+// failureRecorder is a testing.TB that records Errorf instead of failing
+// the enclosing test.
+type failureRecorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestScenarioAssertionsReportFailures(t *testing.T) {
+	inner := &failureRecorder{TB: t}
+	s := policytest.NewScenario(inner)
+	evaluate(t, s, "character:01A", "read", "location:01L", nil)
+
+	assert.False(t, s.AssertEvaluated("character:01A", "write", "location:01L"))
+	assert.False(t, s.AssertNotEvaluated("character:01A", "read", "location:01L"))
+	assert.False(t, s.AssertNoEvaluations())
+	require.Len(t, inner.errors, 3)
+	assert.Contains(t, inner.errors[0], "Evaluate(character:01A, read, location:01L)",
+		"a missed assertion lists the calls that were made")
+}
+
+func TestScenarioConcurrentEvaluate(t *testing.T) {
+	s := policytest.NewScenario(t)
+	s.Allow("character:*").To("read").On("location:01L")
+
+	var wg sync.WaitGroup
+	for range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := types.NewAccessRequest("character:01A", "read", "location:01L", nil)
+			if err != nil {
+				return
+			}
+			_, _ = s.Evaluate(context.Background(), req)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 16, s.Evaluations("character:01A", "read", "location:01L"))
+}
@@ -11,12 +11,17 @@ type SeedPolicy struct {
 	SeedVersion int
 }
 
-// SeedPolicies returns the complete set of 50 seed policies (41 permit, 9 forbid).
+// SeedPolicies returns the complete set of 64 seed policies (54 permit, 10 forbid).
 // The initial 18 (T22) minus 2 removed command policies, plus 5 gap-fill policies (T22b: G1-G5),
-// 1 phase-2 command policy, 2 system bootstrap policies, and 1 plugin host-capability
+// 1 phase-2 command policy, 2 system bootstrap policies, 1 plugin host-capability
 // scope policy (eykuh.3; world.mutation own-location), 11 holomush-kplrr plugin
 // host-capability default-permit seeds, 1 holomush-xakba plugin instance-level stream read,
-// and 1 character-directory seed (INV-ACCESS-9).
+// 1 character-directory seed (INV-ACCESS-9), 2 object-ownership seeds (lock/unlock),
+// 1 connection-history seed (self and staff), 4 character-visibility seeds,
+// 2 help-topic staff seeds (topic edits and the helpedit command), 2
+// message-of-the-day seeds (staff edits and the motd command), 2 economy
+// seeds (staff mint/burn and the money command), and 2 zone seeds (builder
+// and staff broadcasts and the zone command).
 // Default deny behavior is provided by EffectDefaultDeny (no matching policy = denied).
 // See ADR 087 for rationale on default-deny instead of explicit forbid for system properties.
 //
@@ -86,8 +91,8 @@ func SeedPolicies() []SeedPolicy {
 		{
 			Name:        "seed:player-basic-commands",
 			Description: "Characters can execute core compiled-in and unimplemented commands",
-			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name in ["quit", "look", "go", "who"] };`,
-			SeedVersion: 5,
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name in ["quit", "look", "go", "stop", "who"] };`,
+			SeedVersion: 6,
 		},
 		{
 			Name:        "seed:builder-location-write",
@@ -101,6 +106,83 @@ func SeedPolicies() []SeedPolicy {
 			DSLText:     `permit(principal is character, action in ["write", "delete"], resource is object) when { "builder" in principal.character.roles };`,
 			SeedVersion: 3,
 		},
+
+		// Object ownership. Owner rights (lock, unlock, transfer) come only from
+		// owner_id; location rights (builder write, co-location) never grant
+		// them. A locked object additionally refuses write/delete — which covers
+		// moving it — to everyone but its owner and admins, overriding the
+		// builder permit above.
+		{
+			Name:        "seed:object-owner-manage",
+			Description: "Object owners can lock, unlock, and transfer ownership of their objects",
+			DSLText:     `permit(principal is character, action in ["lock", "unlock", "transfer"], resource is object) when { resource.object.owner_id == principal.character.id };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:object-locked-owner-only",
+			Description: "Locked objects can only be modified, moved, or deleted by their owner or an admin",
+			DSLText:     `forbid(principal is character, action in ["write", "delete"], resource is object) when { resource.object.locked == true && resource.object.owner_id != principal.character.id && !("admin" in principal.character.roles) };`,
+			SeedVersion: 1,
+		},
+		// Object verbs. Anyone in the same location as an object may trigger
+		// its verbs (a held object resolves to its holder's location), and
+		// characters may list the objects around them to name one. An
+		// object's owner, and builders while it is unlocked, may define its
+		// verbs. The verb command itself is open to all characters; the
+		// object checks decide what it may do.
+		{
+			Name:        "seed:object-verb-trigger",
+			Description: "Characters can trigger verbs on co-located objects",
+			DSLText:     `permit(principal is character, action in ["trigger_verb"], resource is object) when { resource.object.location == principal.character.location };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:object-verb-define",
+			Description: "Object owners, and builders on unlocked objects, can define object verbs",
+			DSLText:     `permit(principal is character, action in ["define_verb"], resource is object) when { resource.object.owner_id == principal.character.id || ("builder" in principal.character.roles && resource.object.locked == false) };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-location-list-objects",
+			Description: "Characters can list objects in their current location",
+			DSLText:     `permit(principal is character, action in ["list_objects"], resource is location) when { resource.location.id == principal.character.location };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-verb-command",
+			Description: "Characters can execute the verb command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "verb" };`,
+			SeedVersion: 1,
+		},
+		// Description layers. A character wears and takes off the objects it
+		// holds and may list what it is carrying. Characters may put effects
+		// on their own descriptions and see their own private effects, and
+		// so may game masters (and, through the role hierarchy, admins) on
+		// anyone.
+		{
+			Name:        "seed:object-wear",
+			Description: "Characters can wear and take off the objects they hold",
+			DSLText:     `permit(principal is character, action in ["wear"], resource is object) when { resource.object.held_by_character_id == principal.character.id };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:character-list-own-objects",
+			Description: "Characters can list the objects they hold",
+			DSLText:     `permit(principal is character, action in ["list_objects"], resource is character) when { resource.character.id == principal.character.id };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:character-effects-self-or-gm",
+			Description: "Characters manage and see their own description effects; game masters manage and see everyone's",
+			DSLText:     `permit(principal is character, action in ["apply_effect", "view_private_effects"], resource is character) when { resource.character.id == principal.character.id || principal in role "gm" };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-appearance-commands",
+			Description: "Characters can execute the wear, remove, effect, and appearance commands",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name in ["wear", "remove", "effect", "appearance"] };`,
+			SeedVersion: 1,
+		},
 		{
 			Name:        "seed:admin-full-access",
 			Description: "Admins have full access to everything",
@@ -312,6 +394,281 @@ func SeedPolicies() []SeedPolicy {
 			SeedVersion: 1,
 		},
 
+		// --- Connection history (internal/connhistory) ---
+		//
+		// A character's connection records and last-seen time are readable by
+		// the character itself and by staff. Admins are covered by
+		// seed:admin-full-access.
+		{
+			Name:        "seed:character-connections-self-or-staff",
+			Description: "Characters can read their own connection history and last-seen time; staff can read anyone's",
+			DSLText:     `permit(principal is character, action in ["read_connections"], resource is character) when { resource.character.id == principal.character.id || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Perspective history (internal/eventbus/history.Service) ---
+		//
+		// A character may replay what it could have perceived; staff may
+		// replay any character's perspective. Admins are covered by
+		// seed:admin-full-access.
+		{
+			Name:        "seed:character-perspective-self-or-staff",
+			Description: "Characters can replay history from their own perspective; staff can replay anyone's",
+			DSLText:     `permit(principal is character, action in ["read_perspective"], resource is character) when { resource.character.id == principal.character.id || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Character visibility (world.Service.SetCharacterVisibility) ---
+		//
+		// Staff may take their own character dark or invisible with the
+		// compiled-in visibility command. An invisible character is hidden from
+		// players only, so builders and staff see it; a dark character is seen
+		// by staff alone. Admins are covered by seed:admin-full-access.
+		{
+			Name:        "seed:staff-set-own-visibility",
+			Description: "Staff can change their own character's visibility",
+			DSLText:     `permit(principal is character, action in ["set_visibility"], resource is character) when { resource.character.id == principal.character.id && "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:staff-visibility-command",
+			Description: "Staff can execute the visibility command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "visibility" && "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:staff-see-dark",
+			Description: "Staff can see dark characters",
+			DSLText:     `permit(principal is character, action in ["see_dark"], resource is character) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:builder-staff-see-invisible",
+			Description: "Builders and staff can see characters invisible to players",
+			DSLText:     `permit(principal is character, action in ["see_invisible"], resource is character) when { "builder" in principal.character.roles || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Help topics (internal/help) ---
+		//
+		// Help is readable by everyone without a policy check; staff write and
+		// delete topics through the compiled-in helpedit command. Admins are
+		// covered by seed:admin-full-access.
+		{
+			Name:        "seed:staff-help-edit",
+			Description: "Staff can write and delete help topics",
+			DSLText:     `permit(principal is character, action in ["write", "delete"], resource is help) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:staff-helpedit-command",
+			Description: "Staff can execute the helpedit command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "helpedit" && "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Message of the day (internal/motd) ---
+		//
+		// Everyone may run the motd command to reread the message of the day;
+		// its edit subcommands check write/delete on motd:<area>, granted to
+		// staff. Admins are covered by seed:admin-full-access.
+		{
+			Name:        "seed:staff-motd-edit",
+			Description: "Staff can edit connect screens, the message of the day, and announcements",
+			DSLText:     `permit(principal is character, action in ["write", "delete"], resource is motd) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-motd-command",
+			Description: "Characters can execute the motd command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "motd" };`,
+			SeedVersion: 1,
+		},
+
+		// --- Economy (internal/economy) ---
+		//
+		// Everyone may run the money command to check balances and pay other
+		// characters from their own account; minting and burning check
+		// mint/burn on currency:<code>, granted to staff. Admins are covered by
+		// seed:admin-full-access.
+		{
+			Name:        "seed:staff-currency-issue",
+			Description: "Staff can mint and burn currency",
+			DSLText:     `permit(principal is character, action in ["mint", "burn"], resource is currency) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-money-command",
+			Description: "Characters can execute the money command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "money" };`,
+			SeedVersion: 1,
+		},
+
+		// --- Zones (world.Service.BroadcastToZone) ---
+		// broadcast on zone:<id>, granted to builders (ambience) and staff
+		// (announcements). Tagging a location into a zone is a location write,
+		// covered by seed:builder-location-write.
+		{
+			Name:        "seed:builder-zone-broadcast",
+			Description: "Builders and staff can broadcast to a zone",
+			DSLText:     `permit(principal is character, action in ["broadcast"], resource is zone) when { "builder" in principal.character.roles || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:builder-zone-command",
+			Description: "Builders and staff can execute the zone command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "zone" && ("builder" in principal.character.roles || "staff" in principal.character.roles) };`,
+			SeedVersion: 1,
+		},
+
+		// --- Tags (world.Service.TagLocation, FindByTag) ---
+		// read on tag:<name> lets a character see a tag and find what
+		// carries it; operators hide a tag with a forbid on its exact
+		// resource. Tagging also needs write on the entity itself, so
+		// builders and staff can tag only what they can already edit.
+		{
+			Name:        "seed:character-tag-read",
+			Description: "Characters can see tags and find tagged entities",
+			DSLText:     `permit(principal is character, action in ["read"], resource is tag);`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:builder-tag-write",
+			Description: "Builders and staff can add and remove tags",
+			DSLText:     `permit(principal is character, action in ["write"], resource is tag) when { "builder" in principal.character.roles || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Property schemas (world.Service.DeclarePropertySchema) ---
+		// Declaring a property's value type changes what every later
+		// write to that name accepts, so it is staff-only. Plugins
+		// declare theirs in the manifest. Admins are covered by
+		// seed:admin-full-access.
+		{
+			Name:        "seed:staff-property-schema-write",
+			Description: "Staff can declare and remove property value types",
+			DSLText:     `permit(principal is character, action in ["write"], resource is property_schema) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Exit traversal (traversal.Service) ---
+		// Walking an exit is covered by seed:player-exit-use and the go/stop
+		// entries in seed:player-basic-commands. Setting an exit's delay and
+		// cost is an exit write, covered by seed:builder-exit-write.
+		{
+			Name:        "seed:builder-exit-command",
+			Description: "Builders can execute the exit command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "exit" && "builder" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+
+		// --- Paging (paging.Service) ---
+		// Pages go straight to the recipient's character stream, which the
+		// host publishes to; block lists are the caller's own, so executing
+		// the commands is the only check.
+		{
+			Name:        "seed:player-paging-commands",
+			Description: "Characters can execute the page, ignore, unignore, gag, and ungag commands",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name in ["page", "ignore", "unignore", "gag", "ungag"] };`,
+			SeedVersion: 1,
+		},
+
+		// --- Player reports (report.Service) ---
+		// Everyone may file reports and read their own; reading the queue
+		// and claiming, annotating, or resolving a report checks triage on
+		// report:queue, granted to staff. Admins are covered by
+		// seed:admin-full-access.
+		{
+			Name:        "seed:staff-report-triage",
+			Description: "Staff can triage player reports",
+			DSLText:     `permit(principal is character, action in ["triage"], resource is report) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-report-command",
+			Description: "Characters can execute the report command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "report" };`,
+			SeedVersion: 1,
+		},
+
+		// --- NPCs (npc.Service) ---
+		// Spawning and editing an NPC needs write on its location, which
+		// seed:builder-location-write already grants builders; this only
+		// opens the command itself.
+		{
+			Name:        "seed:builder-npc-command",
+			Description: "Builders and staff can execute the npc command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "npc" && ("builder" in principal.character.roles || "staff" in principal.character.roles) };`,
+			SeedVersion: 1,
+		},
+
+		// --- Posting rules (posting.Service) ---
+		// moderate on a location or scene sets who may say and pose there
+		// and works its moderation queue. Scene owners moderate their own
+		// scenes through the core-scenes moderate-own-scene policy.
+		{
+			Name:        "seed:builder-location-moderate",
+			Description: "Builders and staff can moderate posting in locations",
+			DSLText:     `permit(principal is character, action in ["moderate"], resource is location) when { "builder" in principal.character.roles || "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:staff-scene-moderate",
+			Description: "Staff can moderate posting in any scene",
+			DSLText:     `permit(principal is character, action in ["moderate"], resource is scene) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-posting-command",
+			Description: "Characters can execute the posting command; changes are checked against moderate",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "posting" };`,
+			SeedVersion: 1,
+		},
+
+		// --- Account recovery (recovery.Service) ---
+		// Anyone, guests included, may file a recovery request and check or
+		// redeem their code; listing, reading, approving, and denying
+		// requests checks review on recovery:queue, granted to staff.
+		{
+			Name:        "seed:staff-recovery-review",
+			Description: "Staff can review account recovery requests",
+			DSLText:     `permit(principal is character, action in ["review"], resource is recovery) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:player-recover-command",
+			Description: "Characters, guests included, can execute the recover command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "recover" };`,
+			SeedVersion: 1,
+		},
+
+		// --- Location capacity (world.Service.AdmitCharacter) ---
+		// exceed_capacity on a location lets a subject move characters into
+		// it while it is full, granted to staff. Setting a capacity is a
+		// location write, covered by seed:builder-location-write.
+		{
+			Name:        "seed:staff-exceed-capacity",
+			Description: "Staff can enter and move characters into full locations",
+			DSLText:     `permit(principal is character, action in ["exceed_capacity"], resource is location) when { "staff" in principal.character.roles };`,
+			SeedVersion: 1,
+		},
+		{
+			Name:        "seed:builder-capacity-command",
+			Description: "Builders and staff can execute the capacity command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "capacity" && ("builder" in principal.character.roles || "staff" in principal.character.roles) };`,
+			SeedVersion: 1,
+		},
+
+		// --- Account security log (auth.Service.ListSecurityEvents) ---
+		// Anyone may read their own account's log; the command checks write
+		// on player before reading another player's.
+		{
+			Name:        "seed:player-security-command",
+			Description: "Characters can execute the security command",
+			DSLText:     `permit(principal is character, action in ["execute"], resource is command) when { resource.command.name == "security" };`,
+			SeedVersion: 1,
+		},
+
 		// --- Plugin host-capability scope policies (eykuh.3; INV-PLUGIN-50) ---
 		//
 		// world.mutation own-location: a plugin (subject plugin:<name>) may write
@@ -5,6 +5,7 @@ package policy
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/holomush/holomush/internal/access"
@@ -96,8 +97,11 @@ func objectProvider(resourceAttrs map[string]any) *mockAttributeProvider {
 		resourceMap: resourceAttrs,
 		schema: &types.NamespaceSchema{
 			Attributes: map[string]types.AttrType{
-				"id":       types.AttrTypeString,
-				"location": types.AttrTypeString,
+				"id":                   types.AttrTypeString,
+				"location":             types.AttrTypeString,
+				"owner_id":             types.AttrTypeString,
+				"locked":               types.AttrTypeBool,
+				"held_by_character_id": types.AttrTypeString,
 			},
 		},
 	}
@@ -203,6 +207,492 @@ func TestSeedSmokePlayerColocatedObject(t *testing.T) {
 	assert.True(t, decision.IsAllowed(), "player should read co-located object; got: %s — %s", decision.Effect(), decision.Reason())
 }
 
+func TestSeedSmokeObjectOwnership(t *testing.T) {
+	locID := "01LOC000OOOOOOOOOOOOOOOOOO"
+	owner := "01CHAROWNER"
+
+	tests := []struct {
+		name    string
+		subject map[string]any
+		action  string
+		locked  bool
+		allowed bool
+	}{
+		{"owner locks", map[string]any{"id": owner, "roles": []string{"player"}}, "lock", false, true},
+		{"owner transfers", map[string]any{"id": owner, "roles": []string{"player"}}, "transfer", true, true},
+		{"co-located player cannot lock", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, "lock", false, false},
+		{"builder cannot unlock another's object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "unlock", true, false},
+		{"builder writes an unlocked object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "write", false, true},
+		{"builder cannot write a locked object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "write", true, false},
+		{"builder cannot delete a locked object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "delete", true, false},
+		{"owning builder writes own locked object", map[string]any{"id": owner, "roles": []string{"builder"}}, "write", true, true},
+		{"admin writes a locked object", map[string]any{"id": "01CHARADMIN", "roles": []string{"admin"}}, "write", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(tt.subject, nil),
+				objectProvider(map[string]any{"id": "01OBJ001", "location": locID, "owner_id": owner, "locked": tt.locked}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  "character:" + tt.subject["id"].(string),
+				Action:   tt.action,
+				Resource: "object:01OBJ001",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeObjectVerbs(t *testing.T) {
+	locID := "01LOC000VVVVVVVVVVVVVVVVVV"
+	owner := "01CHAROWNER"
+
+	tests := []struct {
+		name    string
+		subject map[string]any
+		object  map[string]any
+		action  string
+		allowed bool
+	}{
+		{"co-located player triggers", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID},
+			map[string]any{"location": locID}, "trigger_verb", true},
+		{"unlocatable object cannot be triggered", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID},
+			map[string]any{}, "trigger_verb", false},
+		{"distant player cannot trigger", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": "01LOCELSEWHERE"},
+			map[string]any{"location": locID}, "trigger_verb", false},
+		{"owner defines", map[string]any{"id": owner, "roles": []string{"player"}},
+			map[string]any{"location": locID, "locked": true}, "define_verb", true},
+		{"co-located player cannot define", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID},
+			map[string]any{"location": locID, "locked": false}, "define_verb", false},
+		{"builder defines on an unlocked object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}},
+			map[string]any{"location": locID, "locked": false}, "define_verb", true},
+		{"builder cannot define on a locked object", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}},
+			map[string]any{"location": locID, "locked": true}, "define_verb", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := map[string]any{"id": "01OBJ001", "owner_id": owner}
+			for k, v := range tt.object {
+				obj[k] = v
+			}
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(tt.subject, nil),
+				objectProvider(obj),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  "character:" + tt.subject["id"].(string),
+				Action:   tt.action,
+				Resource: "object:01OBJ001",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeDescriptionLayers(t *testing.T) {
+	target := "01CHARTARGET00000000000000"
+	locID := "01LOC000VVVVVVVVVVVVVVVVVV"
+
+	t.Run("character effects", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			subject map[string]any
+			action  string
+			allowed bool
+		}{
+			{"player applies own effects", map[string]any{"id": target, "roles": []string{"player"}}, "apply_effect", true},
+			{"player sees own private effects", map[string]any{"id": target, "roles": []string{"player"}}, "view_private_effects", true},
+			{"player lists own objects", map[string]any{"id": target, "roles": []string{"player"}}, "list_objects", true},
+			{"co-located player cannot apply effects", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, "apply_effect", false},
+			{"co-located player cannot see private effects", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, "view_private_effects", false},
+			{"co-located player cannot list objects", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, "list_objects", false},
+			{"builder cannot apply effects", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "apply_effect", false},
+			{"gm applies effects", map[string]any{"id": "01CHARGM", "roles": []string{"gm"}}, "apply_effect", true},
+			{"gm sees private effects", map[string]any{"id": "01CHARGM", "roles": []string{"gm"}}, "view_private_effects", true},
+			{"admin applies effects", map[string]any{"id": "01CHARADMIN", "roles": []string{"admin"}}, "apply_effect", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				engine := createSeedEngine(t, []attribute.AttributeProvider{
+					characterProvider(tt.subject, map[string]any{"id": target, "roles": []string{"player"}, "location": locID}),
+				})
+				decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+					Subject:  access.CharacterSubject(tt.subject["id"].(string)),
+					Action:   tt.action,
+					Resource: access.CharacterResource(target),
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+			})
+		}
+	})
+
+	t.Run("wearing objects", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			subject map[string]any
+			allowed bool
+		}{
+			{"holder wears", map[string]any{"id": target, "roles": []string{"player"}, "location": locID}, true},
+			{"co-located player cannot wear another's object", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, false},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				engine := createSeedEngine(t, []attribute.AttributeProvider{
+					characterProvider(tt.subject, nil),
+					objectProvider(map[string]any{"id": "01OBJCLOAK", "location": locID, "held_by_character_id": target}),
+				})
+				decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+					Subject:  access.CharacterSubject(tt.subject["id"].(string)),
+					Action:   "wear",
+					Resource: access.ObjectResource("01OBJCLOAK"),
+				})
+				require.NoError(t, err)
+				assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+			})
+		}
+	})
+}
+
+func TestSeedSmokeCharacterConnections(t *testing.T) {
+	target := "01CHARTARGET00000000000000"
+	locID := "01LOC000CCCCCCCCCCCCCCCCCC"
+
+	tests := []struct {
+		name    string
+		subject map[string]any
+		allowed bool
+	}{
+		{"self", map[string]any{"id": target, "roles": []string{"player"}, "location": locID}, true},
+		{"co-located player", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, false},
+		{"builder", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, false},
+		{"staff", map[string]any{"id": "01CHARSTAFF", "roles": []string{"staff"}}, true},
+		{"admin", map[string]any{"id": "01CHARADMIN", "roles": []string{"admin"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(tt.subject, map[string]any{"id": target, "roles": []string{"player"}, "location": locID}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject(tt.subject["id"].(string)),
+				Action:   "read_connections",
+				Resource: access.CharacterResource(target),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeCharacterPerspective(t *testing.T) {
+	target := "01CHARTARGET00000000000000"
+	locID := "01LOC000PPPPPPPPPPPPPPPPPP"
+
+	tests := []struct {
+		name    string
+		subject map[string]any
+		allowed bool
+	}{
+		{"self", map[string]any{"id": target, "roles": []string{"player"}, "location": locID}, true},
+		{"co-located player", map[string]any{"id": "01CHAROTHER", "roles": []string{"player"}, "location": locID}, false},
+		{"builder", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, false},
+		{"staff", map[string]any{"id": "01CHARSTAFF", "roles": []string{"staff"}}, true},
+		{"admin", map[string]any{"id": "01CHARADMIN", "roles": []string{"admin"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(tt.subject, map[string]any{"id": target, "roles": []string{"player"}, "location": locID}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject(tt.subject["id"].(string)),
+				Action:   "read_perspective",
+				Resource: access.CharacterResource(target),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeCharacterVisibility(t *testing.T) {
+	target := "01CHARHIDDEN00000000000000"
+	locID := "01LOC000VVVVVVVVVVVVVVVVVV"
+
+	tests := []struct {
+		name    string
+		subject map[string]any
+		action  string
+		allowed bool
+	}{
+		{"player cannot see dark", map[string]any{"id": "01CHARPLAYER", "roles": []string{"player"}, "location": locID}, "see_dark", false},
+		{"player cannot see invisible", map[string]any{"id": "01CHARPLAYER", "roles": []string{"player"}, "location": locID}, "see_invisible", false},
+		{"builder sees invisible", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "see_invisible", true},
+		{"builder cannot see dark", map[string]any{"id": "01CHARBUILD", "roles": []string{"builder"}}, "see_dark", false},
+		{"staff sees dark", map[string]any{"id": "01CHARSTAFF", "roles": []string{"staff"}}, "see_dark", true},
+		{"staff sees invisible", map[string]any{"id": "01CHARSTAFF", "roles": []string{"staff"}}, "see_invisible", true},
+		{"staff sets own visibility", map[string]any{"id": target, "roles": []string{"staff"}}, "set_visibility", true},
+		{"staff cannot set another's visibility", map[string]any{"id": "01CHARSTAFF", "roles": []string{"staff"}}, "set_visibility", false},
+		{"player cannot set own visibility", map[string]any{"id": target, "roles": []string{"player"}}, "set_visibility", false},
+		{"admin sets another's visibility", map[string]any{"id": "01CHARADMIN", "roles": []string{"admin"}}, "set_visibility", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(tt.subject, map[string]any{"id": target, "roles": []string{"staff"}, "location": locID}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject(tt.subject["id"].(string)),
+				Action:   tt.action,
+				Resource: access.CharacterResource(target),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeHelpTopicEditing(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"staff writes topic", []string{"staff"}, "write", access.HelpResource("combat"), true},
+		{"staff deletes topic", []string{"staff"}, "delete", access.HelpResource("combat"), true},
+		{"staff executes helpedit", []string{"staff"}, "execute", "command:helpedit", true},
+		{"builder cannot write topic", []string{"builder"}, "write", access.HelpResource("combat"), false},
+		{"player cannot execute helpedit", []string{"player"}, "execute", "command:helpedit", false},
+		{"staff cannot execute other admin commands", []string{"staff"}, "execute", "command:shutdown", false},
+		{"admin writes topic", []string{"admin"}, "write", access.HelpResource("combat"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARHELP", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARHELP"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeMOTDEditing(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"staff writes message", []string{"staff"}, "write", access.MOTDResource("message"), true},
+		{"staff deletes screen", []string{"staff"}, "delete", access.MOTDResource("screen"), true},
+		{"builder cannot write announcement", []string{"builder"}, "write", access.MOTDResource("announcement"), false},
+		{"player cannot write message", []string{"player"}, "write", access.MOTDResource("message"), false},
+		{"player executes motd", []string{"player"}, "execute", "command:motd", true},
+		{"admin writes screen", []string{"admin"}, "write", access.MOTDResource("screen"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARMOTD", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARMOTD"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeReportTriage(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"staff triages queue", []string{"staff"}, "triage", access.ReportResource("queue"), true},
+		{"builder cannot triage", []string{"builder"}, "triage", access.ReportResource("queue"), false},
+		{"player cannot triage", []string{"player"}, "triage", access.ReportResource("queue"), false},
+		{"player executes report", []string{"player"}, "execute", "command:report", true},
+		{"admin triages queue", []string{"admin"}, "triage", access.ReportResource("queue"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARREPORT", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARREPORT"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeRecoveryReview(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"staff reviews queue", []string{"staff"}, "review", access.RecoveryResource("queue"), true},
+		{"builder cannot review", []string{"builder"}, "review", access.RecoveryResource("queue"), false},
+		{"player cannot review", []string{"player"}, "review", access.RecoveryResource("queue"), false},
+		{"guest executes recover", []string{"guest"}, "execute", "command:recover", true},
+		{"admin reviews queue", []string{"admin"}, "review", access.RecoveryResource("queue"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARRECOVER", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARRECOVER"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeCurrencyIssue(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"staff mints", []string{"staff"}, "mint", access.CurrencyResource("credits"), true},
+		{"staff burns", []string{"staff"}, "burn", access.CurrencyResource("gold"), true},
+		{"builder cannot mint", []string{"builder"}, "mint", access.CurrencyResource("credits"), false},
+		{"player cannot mint", []string{"player"}, "mint", access.CurrencyResource("credits"), false},
+		{"player cannot burn", []string{"player"}, "burn", access.CurrencyResource("credits"), false},
+		{"player executes money", []string{"player"}, "execute", "command:money", true},
+		{"admin mints", []string{"admin"}, "mint", access.CurrencyResource("credits"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARECON", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARECON"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeZoneBroadcast(t *testing.T) {
+	tests := []struct {
+		name     string
+		roles    []string
+		action   string
+		resource string
+		allowed  bool
+	}{
+		{"builder broadcasts", []string{"builder"}, "broadcast", access.ZoneResource("harbor"), true},
+		{"staff broadcasts", []string{"staff"}, "broadcast", access.ZoneResource("harbor"), true},
+		{"player cannot broadcast", []string{"player"}, "broadcast", access.ZoneResource("harbor"), false},
+		{"builder executes zone", []string{"builder"}, "execute", "command:zone", true},
+		{"player cannot execute zone", []string{"player"}, "execute", "command:zone", false},
+		{"admin broadcasts", []string{"admin"}, "broadcast", access.ZoneResource("harbor"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARZONE", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": strings.TrimPrefix(tt.resource, "command:")}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARZONE"),
+				Action:   tt.action,
+				Resource: tt.resource,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokeExitCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		roles   []string
+		allowed bool
+	}{
+		{"builder executes exit", []string{"builder"}, true},
+		{"admin executes exit", []string{"admin"}, true},
+		{"player cannot execute exit", []string{"player"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHAREXIT", "roles": tt.roles}, nil),
+				commandProvider(map[string]any{"name": "exit"}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHAREXIT"),
+				Action:   "execute",
+				Resource: "command:exit",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.allowed, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
+func TestSeedSmokePagingCommands(t *testing.T) {
+	for _, name := range []string{"page", "ignore", "unignore", "gag", "ungag"} {
+		t.Run(name, func(t *testing.T) {
+			engine := createSeedEngine(t, []attribute.AttributeProvider{
+				characterProvider(map[string]any{"id": "01CHARPAGE", "roles": []string{"player"}}, nil),
+				commandProvider(map[string]any{"name": name}),
+			})
+			decision, err := engine.Evaluate(context.Background(), types.AccessRequest{
+				Subject:  access.CharacterSubject("01CHARPAGE"),
+				Action:   "execute",
+				Resource: "command:" + name,
+			})
+			require.NoError(t, err)
+			assert.True(t, decision.IsAllowed(), "got: %s — %s", decision.Effect(), decision.Reason())
+		})
+	}
+}
+
 func TestSeedSmokePlayerStreamEmit(t *testing.T) {
 	locID := "01LOC000DDDDDDDDDDDDDDDDDD"
 
@@ -312,7 +802,7 @@ func TestSeedSmokePlayerExitUse(t *testing.T) {
 }
 
 func TestSeedSmoke_PlayerBasicCommands(t *testing.T) {
-	commands := []string{"quit", "look", "go", "who"}
+	commands := []string{"quit", "look", "go", "stop", "who"}
 	for _, cmd := range commands {
 		t.Run(cmd, func(t *testing.T) {
 			engine := createSeedEngine(t, []attribute.AttributeProvider{
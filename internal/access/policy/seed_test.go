@@ -21,8 +21,30 @@ func TestSeedPoliciesCount(t *testing.T) {
 	// scene reads/writes are now gated solely by the core-scenes plugin's
 	// read-scene-as-* / write-scene-as-participant policies. Phase-1 channels
 	// added seed:plugin-stream-subscribe (48 → 49) — the instance-level write
-	// analogue of seed:plugin-stream-read (HIGH-3).
-	assert.Len(t, seeds, 49, "expected 49 seed policies (40 permit, 9 forbid)")
+	// analogue of seed:plugin-stream-read (HIGH-3). Object locks added
+	// seed:object-owner-manage and seed:object-locked-owner-only (49 → 51).
+	// Connection history added seed:character-connections-self-or-staff (51 → 52).
+	// Help topics added seed:staff-help-edit and seed:staff-helpedit-command (52 → 54).
+	// Character visibility added four staff/builder permits (54 → 58).
+	// Message of the day added seed:staff-motd-edit and seed:player-motd-command (58 → 60).
+	// Economy added seed:staff-currency-issue and seed:player-money-command (60 → 62).
+	// Zones added seed:builder-zone-broadcast and seed:builder-zone-command (62 → 64).
+	// Object verbs added seed:object-verb-trigger, seed:object-verb-define,
+	// seed:player-location-list-objects, and seed:player-verb-command (64 → 68).
+	// Description layers added seed:object-wear, seed:character-list-own-objects,
+	// seed:character-effects-self-or-gm, and seed:player-appearance-commands (68 → 72).
+	// Exit traversal added seed:builder-exit-command (72 → 73).
+	// Perspective history added seed:character-perspective-self-or-staff (73 → 74).
+	// Paging added seed:player-paging-commands (74 → 75).
+	// Entity tags added seed:character-tag-read and seed:builder-tag-write (75 → 77).
+	// Property schemas added seed:staff-property-schema-write (77 → 78).
+	// Player reports added seed:staff-report-triage and seed:player-report-command (78 → 80).
+	// NPCs added seed:builder-npc-command (80 → 81).
+	// Posting rules added seed:builder-location-moderate, seed:staff-scene-moderate, and seed:player-posting-command (81 → 84).
+	// Account recovery added seed:staff-recovery-review and seed:player-recover-command (84 → 86).
+	// Location capacity added seed:staff-exceed-capacity and seed:builder-capacity-command (86 → 88).
+	// The account security log added seed:player-security-command (88 → 89).
+	assert.Len(t, seeds, 89, "expected 89 seed policies (79 permit, 10 forbid)")
 }
 
 func TestSeedPoliciesAllNamesHaveSeedPrefix(t *testing.T) {
@@ -79,8 +101,8 @@ func TestSeedPoliciesEffectDistribution(t *testing.T) {
 			forbidCount++
 		}
 	}
-	assert.Equal(t, 40, permitCount, "expected 40 permit policies (+11 holomush-kplrr plugin host-capability default-permit seeds, +1 holomush-xakba plugin instance-level stream read, +1 phase-1 channels plugin instance-level stream write HIGH-3, +1 character-directory INV-ACCESS-9, −1 holomush-8m01u removed vestigial seed:player-scene-participant, −1 holomush-sjtlz removed vestigial seed:player-scene-read)")
-	assert.Equal(t, 9, forbidCount, "expected 9 forbid policies (+2 phase-5 sub-epic A events.*.system.crypto_totp.* denies + 2 phase-5 sub-epic D events.*.system.crypto_policy.* denies + 2 phase-5 sub-epic E events.*.system.* broad denies)")
+	assert.Equal(t, 79, permitCount, "expected 79 permit policies (+1 player-security-command, +2 staff-exceed-capacity/builder-capacity-command, +2 staff-recovery-review/player-recover-command, +3 builder-location-moderate/staff-scene-moderate/player-posting-command, +1 builder-npc-command, +2 staff-report-triage/player-report-command, +1 staff-property-schema-write, +2 character-tag-read/builder-tag-write, +1 player-paging-commands, +1 character-perspective-self-or-staff, +1 builder-exit-command, +4 object-wear/character-list-own-objects/character-effects-self-or-gm/player-appearance-commands, +4 object-verb-trigger/object-verb-define/player-location-list-objects/player-verb-command, +2 builder-zone-broadcast/builder-zone-command, +2 staff-currency-issue/player-money-command, +2 staff-motd-edit/player-motd-command, +4 character visibility, +2 staff-help-edit/staff-helpedit-command, +1 character-connections-self-or-staff, +1 object-owner-manage, +11 holomush-kplrr plugin host-capability default-permit seeds, +1 holomush-xakba plugin instance-level stream read, +1 phase-1 channels plugin instance-level stream write HIGH-3, +1 character-directory INV-ACCESS-9, −1 holomush-8m01u removed vestigial seed:player-scene-participant, −1 holomush-sjtlz removed vestigial seed:player-scene-read)")
+	assert.Equal(t, 10, forbidCount, "expected 10 forbid policies (+1 object-locked-owner-only, +2 phase-5 sub-epic A events.*.system.crypto_totp.* denies + 2 phase-5 sub-epic D events.*.system.crypto_policy.* denies + 2 phase-5 sub-epic E events.*.system.* broad denies)")
 }
 
 func TestSeedPoliciesExpectedNames(t *testing.T) {
@@ -97,6 +119,17 @@ func TestSeedPoliciesExpectedNames(t *testing.T) {
 		"seed:player-basic-commands",
 		"seed:builder-location-write",
 		"seed:builder-object-write",
+		// Object ownership (lock/unlock/transfer)
+		"seed:object-owner-manage",
+		"seed:object-verb-trigger",
+		"seed:object-verb-define",
+		"seed:player-location-list-objects",
+		"seed:player-verb-command",
+		"seed:object-wear",
+		"seed:character-list-own-objects",
+		"seed:character-effects-self-or-gm",
+		"seed:player-appearance-commands",
+		"seed:object-locked-owner-only",
 		"seed:admin-full-access",
 		"seed:property-public-read",
 		"seed:property-private-read",
@@ -134,6 +167,52 @@ func TestSeedPoliciesExpectedNames(t *testing.T) {
 		"seed:deny-events-system-read-plugin",
 		// Phase-5 iwzt history-scope-privacy staff override policy (INV-PRIVACY-6)
 		"seed:staff-read-unrestricted-history",
+		// Connection history
+		"seed:character-connections-self-or-staff",
+		// Perspective history
+		"seed:character-perspective-self-or-staff",
+		// Character visibility
+		"seed:staff-set-own-visibility",
+		"seed:staff-visibility-command",
+		"seed:staff-see-dark",
+		"seed:builder-staff-see-invisible",
+		// Help topics
+		"seed:staff-help-edit",
+		"seed:staff-helpedit-command",
+		// Message of the day
+		"seed:staff-motd-edit",
+		"seed:player-motd-command",
+		// Economy
+		"seed:staff-currency-issue",
+		"seed:player-money-command",
+		"seed:builder-zone-broadcast",
+		"seed:builder-zone-command",
+		// Tags
+		"seed:character-tag-read",
+		"seed:builder-tag-write",
+		// Property schemas
+		"seed:staff-property-schema-write",
+		// Exit traversal
+		"seed:builder-exit-command",
+		// Paging
+		"seed:player-paging-commands",
+		// Player reports
+		"seed:staff-report-triage",
+		"seed:player-report-command",
+		// NPCs
+		"seed:builder-npc-command",
+		// Posting rules
+		"seed:builder-location-moderate",
+		"seed:staff-scene-moderate",
+		"seed:player-posting-command",
+		// Account recovery
+		"seed:staff-recovery-review",
+		"seed:player-recover-command",
+		// Location capacity
+		"seed:staff-exceed-capacity",
+		"seed:builder-capacity-command",
+		// Account security log
+		"seed:player-security-command",
 		// Plugin host-capability scope policy (eykuh.3; INV-PLUGIN-50)
 		"seed:plugin-world-mutation-own-location",
 		// Plugin host-capability default-permit seeds (holomush-kplrr; INV-PLUGIN-50)
@@ -166,6 +245,7 @@ func TestSeedPoliciesExpectedNames(t *testing.T) {
 func TestSeedPoliciesForbidPoliciesAreExpected(t *testing.T) {
 	expectedForbids := map[string]bool{
 		"seed:property-restricted-excluded":                    true,
+		"seed:object-locked-owner-only":                        true,
 		"seed:deny-audit-read-character":                       true,
 		"seed:deny-audit-read-plugin":                          true,
 		"seed:deny-events-system-crypto-totp-read-character":   true,
@@ -483,3 +483,33 @@ type AccessPolicyEngine interface {
 	// Returns (false, nil) for default-deny; (false, err) on infrastructure failure.
 	CanPerformAction(ctx context.Context, subject, action, resourceType, scope string) (bool, error)
 }
+
+// BatchResult is the outcome of one request in a batch evaluation. Err follows
+// the Evaluate contract: a non-nil Err is a denial.
+type BatchResult struct {
+	Decision Decision
+	Err      error
+}
+
+// BatchEvaluator is implemented by engines that can evaluate several requests
+// in one call, sharing attribute resolution between them. It is optional:
+// use EvaluateBatch rather than asserting for it directly.
+type BatchEvaluator interface {
+	// EvaluateBatch evaluates every request and returns one result per
+	// request, in request order.
+	EvaluateBatch(ctx context.Context, requests []AccessRequest) []BatchResult
+}
+
+// EvaluateBatch evaluates requests with engine's batch API when it has one,
+// falling back to one Evaluate call per request otherwise. Results are in
+// request order.
+func EvaluateBatch(ctx context.Context, engine AccessPolicyEngine, requests []AccessRequest) []BatchResult {
+	if batcher, ok := engine.(BatchEvaluator); ok {
+		return batcher.EvaluateBatch(ctx, requests)
+	}
+	results := make([]BatchResult, len(requests))
+	for i, req := range requests {
+		results[i].Decision, results[i].Err = engine.Evaluate(ctx, req)
+	}
+	return results
+}
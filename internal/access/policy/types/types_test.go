@@ -4,6 +4,8 @@
 package types
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -473,3 +475,61 @@ func TestNewAccessRequestRejectsReservedAttributeKeyViaIsReservedActionKey(t *te
 	require.Error(t, err)
 	errutil.AssertErrorCode(t, err, "ACCESS_REQUEST_RESERVED_ATTRIBUTE")
 }
+
+// sequentialEngine is an AccessPolicyEngine without a batch API that allows
+// reads and records every Evaluate call.
+type sequentialEngine struct {
+	evaluated []string
+}
+
+func (e *sequentialEngine) Evaluate(_ context.Context, req AccessRequest) (Decision, error) {
+	e.evaluated = append(e.evaluated, req.Resource)
+	if req.Action != "read" {
+		return Decision{}, errors.New("unsupported action")
+	}
+	return NewDecision(EffectAllow, "read allowed", "test-policy"), nil
+}
+
+func (e *sequentialEngine) CanPerformAction(_ context.Context, _, _, _, _ string) (bool, error) {
+	return false, nil
+}
+
+// batchingEngine adds a batch API on top of sequentialEngine.
+type batchingEngine struct {
+	sequentialEngine
+	batches int
+}
+
+func (e *batchingEngine) EvaluateBatch(ctx context.Context, reqs []AccessRequest) []BatchResult {
+	e.batches++
+	results := make([]BatchResult, len(reqs))
+	for i, req := range reqs {
+		results[i].Decision, results[i].Err = e.Evaluate(ctx, req)
+	}
+	return results
+}
+
+func TestEvaluateBatch(t *testing.T) {
+	reqs := []AccessRequest{
+		{Subject: "character:01ABC", Action: "read", Resource: "location:01A"},
+		{Subject: "character:01ABC", Action: "write", Resource: "location:01B"},
+	}
+
+	t.Run("falls back to sequential Evaluate", func(t *testing.T) {
+		engine := &sequentialEngine{}
+		results := EvaluateBatch(context.Background(), engine, reqs)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].Decision.IsAllowed())
+		require.NoError(t, results[0].Err)
+		require.Error(t, results[1].Err)
+		assert.Equal(t, []string{"location:01A", "location:01B"}, engine.evaluated)
+	})
+
+	t.Run("uses the engine batch API when present", func(t *testing.T) {
+		engine := &batchingEngine{}
+		results := EvaluateBatch(context.Background(), engine, reqs)
+		require.Len(t, results, 2)
+		assert.Equal(t, 1, engine.batches)
+		assert.True(t, results[0].Decision.IsAllowed())
+	})
+}
@@ -31,6 +31,33 @@ const (
 	ResourceKV        = "kv:"
 	// ResourceCharacterDirectory is the singleton character-directory resource (no instance id).
 	ResourceCharacterDirectory = "character_directory:"
+	// ResourceAdminView identifies a read-only operational view served by the
+	// admin dashboard API (e.g. "admin_view:sessions").
+	ResourceAdminView = "admin_view:"
+	// ResourceHelp identifies an in-game help topic by its name (e.g.
+	// "help:combat").
+	ResourceHelp = "help:"
+	// ResourceMOTD identifies a piece of login text: the message of the day,
+	// the connect screens, or the announcements (e.g. "motd:message").
+	ResourceMOTD = "motd:"
+	// ResourceCurrency identifies an economy currency by its code (e.g.
+	// "currency:credits").
+	ResourceCurrency = "currency:"
+	// ResourceZone identifies a zone of locations by its ID (e.g.
+	// "zone:harbor").
+	ResourceZone = "zone:"
+	// ResourceTag identifies a world entity tag by name (e.g.
+	// "tag:newbie-area").
+	ResourceTag = "tag:"
+	// ResourcePropertySchema identifies the declared value type of a
+	// property name (e.g. "property_schema:strength").
+	ResourcePropertySchema = "property_schema:"
+	// ResourceReport identifies an area of player reports; staff triage
+	// checks "report:queue".
+	ResourceReport = "report:"
+	// ResourceRecovery identifies an area of account recovery; staff
+	// review checks "recovery:queue".
+	ResourceRecovery = "recovery:"
 )
 
 // Session error code constants.
@@ -58,6 +85,15 @@ var knownPrefixes = []string{
 	ResourceScene,
 	ResourceKV,
 	ResourceCharacterDirectory,
+	ResourceAdminView,
+	ResourceHelp,
+	ResourceMOTD,
+	ResourceCurrency,
+	ResourceZone,
+	ResourceTag,
+	ResourcePropertySchema,
+	ResourceReport,
+	ResourceRecovery,
 }
 
 // PluginSubject returns a properly formatted plugin subject identifier.
@@ -173,6 +209,90 @@ func StreamResource(streamID string) string {
 // There is no per-instance variant: the character directory is server-wide.
 func CharacterDirectoryResource() string { return ResourceCharacterDirectory + "all" }
 
+// AdminViewResource returns a properly formatted admin view resource identifier.
+// Panics if view is empty, since an empty view would create an invalid reference.
+func AdminViewResource(view string) string {
+	if view == "" {
+		panic("access.AdminViewResource: empty view would create invalid resource reference")
+	}
+	return ResourceAdminView + view
+}
+
+// HelpResource returns a properly formatted help topic resource identifier.
+// Panics if name is empty, since an empty name would create an invalid reference.
+func HelpResource(name string) string {
+	if name == "" {
+		panic("access.HelpResource: empty name would create invalid resource reference")
+	}
+	return ResourceHelp + name
+}
+
+// MOTDResource returns a properly formatted login-text resource identifier.
+// Panics if area is empty, since an empty area would create an invalid reference.
+func MOTDResource(area string) string {
+	if area == "" {
+		panic("access.MOTDResource: empty area would create invalid resource reference")
+	}
+	return ResourceMOTD + area
+}
+
+// CurrencyResource returns a properly formatted currency resource identifier.
+// Panics if code is empty, since an empty code would create an invalid reference.
+func CurrencyResource(code string) string {
+	if code == "" {
+		panic("access.CurrencyResource: empty code would create invalid resource reference")
+	}
+	return ResourceCurrency + code
+}
+
+// ZoneResource returns a properly formatted zone resource identifier.
+// Panics if id is empty, since an empty id would create an invalid reference.
+func ZoneResource(id string) string {
+	if id == "" {
+		panic("access.ZoneResource: empty id would create invalid resource reference")
+	}
+	return ResourceZone + id
+}
+
+// TagResource returns a properly formatted tag resource identifier.
+// Panics if name is empty, since an empty name would create an invalid reference.
+func TagResource(name string) string {
+	if name == "" {
+		panic("access.TagResource: empty name would create invalid resource reference")
+	}
+	return ResourceTag + name
+}
+
+// PropertySchemaResource returns a properly formatted property schema
+// resource identifier.
+// Panics if name is empty, since an empty name would create an invalid reference.
+func PropertySchemaResource(name string) string {
+	if name == "" {
+		panic("access.PropertySchemaResource: empty name would create invalid resource reference")
+	}
+	return ResourcePropertySchema + name
+}
+
+// ReportResource returns a properly formatted player report resource
+// identifier.
+// Panics if area is empty, since an empty area would create an invalid reference.
+func ReportResource(area string) string {
+	if area == "" {
+		panic("access.ReportResource: empty area would create invalid resource reference")
+	}
+	return ResourceReport + area
+}
+
+// RecoveryResource returns a properly formatted account recovery resource
+// identifier.
+// Panics if area is empty, since an empty area would create an invalid reference.
+func RecoveryResource(area string) string {
+	if area == "" {
+		panic("access.RecoveryResource: empty area would create invalid resource reference")
+	}
+	return ResourceRecovery + area
+}
+
 // KVResource returns a properly formatted key-value store resource identifier.
 // Panics if namespace or key is empty, since either would create an invalid reference.
 func KVResource(namespace, key string) string {
@@ -352,6 +352,96 @@ func TestSceneResourcePanicsOnEmptyID(t *testing.T) {
 	})
 }
 
+func TestAdminViewResource(t *testing.T) {
+	assert.Equal(t, "admin_view:sessions", access.AdminViewResource("sessions"))
+}
+
+func TestAdminViewResourcePanicsOnEmptyView(t *testing.T) {
+	assert.PanicsWithValue(t, "access.AdminViewResource: empty view would create invalid resource reference", func() {
+		access.AdminViewResource("")
+	})
+}
+
+func TestHelpResource(t *testing.T) {
+	assert.Equal(t, "help:combat", access.HelpResource("combat"))
+}
+
+func TestHelpResourcePanicsOnEmptyName(t *testing.T) {
+	assert.PanicsWithValue(t, "access.HelpResource: empty name would create invalid resource reference", func() {
+		access.HelpResource("")
+	})
+}
+
+func TestMOTDResource(t *testing.T) {
+	assert.Equal(t, "motd:message", access.MOTDResource("message"))
+}
+
+func TestMOTDResourcePanicsOnEmptyArea(t *testing.T) {
+	assert.PanicsWithValue(t, "access.MOTDResource: empty area would create invalid resource reference", func() {
+		access.MOTDResource("")
+	})
+}
+
+func TestCurrencyResource(t *testing.T) {
+	assert.Equal(t, "currency:credits", access.CurrencyResource("credits"))
+}
+
+func TestCurrencyResourcePanicsOnEmptyCode(t *testing.T) {
+	assert.PanicsWithValue(t, "access.CurrencyResource: empty code would create invalid resource reference", func() {
+		access.CurrencyResource("")
+	})
+}
+
+func TestZoneResource(t *testing.T) {
+	assert.Equal(t, "zone:harbor", access.ZoneResource("harbor"))
+}
+
+func TestZoneResourcePanicsOnEmptyID(t *testing.T) {
+	assert.PanicsWithValue(t, "access.ZoneResource: empty id would create invalid resource reference", func() {
+		access.ZoneResource("")
+	})
+}
+
+func TestTagResource(t *testing.T) {
+	assert.Equal(t, "tag:newbie-area", access.TagResource("newbie-area"))
+}
+
+func TestTagResourcePanicsOnEmptyName(t *testing.T) {
+	assert.PanicsWithValue(t, "access.TagResource: empty name would create invalid resource reference", func() {
+		access.TagResource("")
+	})
+}
+
+func TestPropertySchemaResource(t *testing.T) {
+	assert.Equal(t, "property_schema:strength", access.PropertySchemaResource("strength"))
+}
+
+func TestPropertySchemaResourcePanicsOnEmptyName(t *testing.T) {
+	assert.PanicsWithValue(t, "access.PropertySchemaResource: empty name would create invalid resource reference", func() {
+		access.PropertySchemaResource("")
+	})
+}
+
+func TestReportResource(t *testing.T) {
+	assert.Equal(t, "report:queue", access.ReportResource("queue"))
+}
+
+func TestReportResourcePanicsOnEmptyArea(t *testing.T) {
+	assert.PanicsWithValue(t, "access.ReportResource: empty area would create invalid resource reference", func() {
+		access.ReportResource("")
+	})
+}
+
+func TestRecoveryResource(t *testing.T) {
+	assert.Equal(t, "recovery:queue", access.RecoveryResource("queue"))
+}
+
+func TestRecoveryResourcePanicsOnEmptyArea(t *testing.T) {
+	assert.PanicsWithValue(t, "access.RecoveryResource: empty area would create invalid resource reference", func() {
+		access.RecoveryResource("")
+	})
+}
+
 func TestCommandResource(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -585,6 +675,51 @@ func TestKnownPrefixes_AllConstantsCovered(t *testing.T) {
 			constant: access.ResourceKV,
 			desc:     "ResourceKV",
 		},
+		{
+			name:     "resource admin view prefix",
+			constant: access.ResourceAdminView,
+			desc:     "ResourceAdminView",
+		},
+		{
+			name:     "resource help prefix",
+			constant: access.ResourceHelp,
+			desc:     "ResourceHelp",
+		},
+		{
+			name:     "resource motd prefix",
+			constant: access.ResourceMOTD,
+			desc:     "ResourceMOTD",
+		},
+		{
+			name:     "resource currency prefix",
+			constant: access.ResourceCurrency,
+			desc:     "ResourceCurrency",
+		},
+		{
+			name:     "resource zone prefix",
+			constant: access.ResourceZone,
+			desc:     "ResourceZone",
+		},
+		{
+			name:     "resource tag prefix",
+			constant: access.ResourceTag,
+			desc:     "ResourceTag",
+		},
+		{
+			name:     "resource property schema prefix",
+			constant: access.ResourcePropertySchema,
+			desc:     "ResourcePropertySchema",
+		},
+		{
+			name:     "resource report prefix",
+			constant: access.ResourceReport,
+			desc:     "ResourceReport",
+		},
+		{
+			name:     "resource recovery prefix",
+			constant: access.ResourceRecovery,
+			desc:     "ResourceRecovery",
+		},
 	}
 
 	// Verify each constant is in the internal knownPrefixes list
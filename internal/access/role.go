@@ -3,16 +3,85 @@
 
 package access
 
+import (
+	"regexp"
+	"slices"
+)
+
 const (
 	// RolePlayer is the default role for all characters.
 	RolePlayer = "player"
 	// RoleBuilder grants world-building permissions.
 	RoleBuilder = "builder"
+	// RoleGM grants game-master permissions for running scenes and events.
+	RoleGM = "gm"
 	// RoleAdmin grants full access to everything.
 	RoleAdmin = "admin"
 )
 
+// roleParents maps each system role to the role it inherits from. A
+// character holding a role also holds every role up the chain, so policies
+// granting builders access also cover GMs and admins.
+var roleParents = map[string]string{
+	RoleBuilder: RolePlayer,
+	RoleGM:      RoleBuilder,
+	RoleAdmin:   RoleGM,
+}
+
+// roleNamePattern restricts role names to lowercase identifiers so they
+// read unambiguously in policy text and audit logs.
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
 // SystemRoles returns all system roles in privilege order (lowest first).
 func SystemRoles() []string {
-	return []string{RolePlayer, RoleBuilder, RoleAdmin}
+	return []string{RolePlayer, RoleBuilder, RoleGM, RoleAdmin}
+}
+
+// IsSystemRole reports whether role is one of the built-in roles.
+func IsSystemRole(role string) bool {
+	return slices.Contains(SystemRoles(), role)
+}
+
+// ValidRoleName reports whether role is a well-formed role name. Custom
+// roles (e.g. "staff") are allowed alongside the system roles; they take
+// part in policies but inherit nothing.
+func ValidRoleName(role string) bool {
+	return roleNamePattern.MatchString(role)
+}
+
+// InheritedRoles returns the roles role inherits from, nearest first. Custom
+// roles inherit nothing.
+func InheritedRoles(role string) []string {
+	var inherited []string
+	for parent, ok := roleParents[role]; ok; parent, ok = roleParents[parent] {
+		inherited = append(inherited, parent)
+	}
+	return inherited
+}
+
+// EffectiveRoles expands assigned roles with everything they inherit. Every
+// character holds RolePlayer. System roles come first in privilege order,
+// followed by custom roles in their assigned order, without duplicates.
+func EffectiveRoles(assigned []string) []string {
+	held := make(map[string]bool, len(assigned)+len(roleParents))
+	held[RolePlayer] = true
+	for _, role := range assigned {
+		held[role] = true
+		for _, parent := range InheritedRoles(role) {
+			held[parent] = true
+		}
+	}
+
+	effective := make([]string, 0, len(held))
+	for _, role := range SystemRoles() {
+		if held[role] {
+			effective = append(effective, role)
+		}
+	}
+	for _, role := range assigned {
+		if !IsSystemRole(role) && !slices.Contains(effective, role) {
+			effective = append(effective, role)
+		}
+	}
+	return effective
 }
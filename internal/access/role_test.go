@@ -17,6 +17,7 @@ func TestRoleConstants(t *testing.T) {
 	}{
 		{"player role", RolePlayer, "player"},
 		{"builder role", RoleBuilder, "builder"},
+		{"gm role", RoleGM, "gm"},
 		{"admin role", RoleAdmin, "admin"},
 	}
 
@@ -29,5 +30,51 @@ func TestRoleConstants(t *testing.T) {
 
 func TestSystemRoles(t *testing.T) {
 	roles := SystemRoles()
-	assert.Equal(t, []string{"player", "builder", "admin"}, roles)
+	assert.Equal(t, []string{"player", "builder", "gm", "admin"}, roles)
+}
+
+func TestInheritedRoles(t *testing.T) {
+	assert.Equal(t, []string{"gm", "builder", "player"}, InheritedRoles(RoleAdmin))
+	assert.Equal(t, []string{"builder", "player"}, InheritedRoles(RoleGM))
+	assert.Equal(t, []string{"player"}, InheritedRoles(RoleBuilder))
+	assert.Empty(t, InheritedRoles(RolePlayer))
+	assert.Empty(t, InheritedRoles("staff"))
+}
+
+func TestEffectiveRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		assigned []string
+		expected []string
+	}{
+		{"none assigned", nil, []string{"player"}},
+		{"builder inherits player", []string{"builder"}, []string{"player", "builder"}},
+		{"admin inherits the whole chain", []string{"admin"}, []string{"player", "builder", "gm", "admin"}},
+		{"custom roles inherit nothing", []string{"staff"}, []string{"player", "staff"}},
+		{
+			"system roles ordered before custom roles",
+			[]string{"staff", "gm", "builder", "staff"},
+			[]string{"player", "builder", "gm", "staff"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, EffectiveRoles(tt.assigned))
+		})
+	}
+}
+
+func TestValidRoleName(t *testing.T) {
+	for _, role := range append(SystemRoles(), "staff", "scene_runner", "tier-2") {
+		assert.True(t, ValidRoleName(role), role)
+	}
+	for _, role := range []string{"", "Admin", "2fa", "has space", "role:admin", "a23456789012345678901234567890123"} {
+		assert.False(t, ValidRoleName(role), role)
+	}
+}
+
+func TestIsSystemRole(t *testing.T) {
+	assert.True(t, IsSystemRole(RoleGM))
+	assert.False(t, IsSystemRole("staff"))
 }
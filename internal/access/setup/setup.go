@@ -31,6 +31,7 @@ type ABACStack struct {
 	Cache           *policy.Cache
 	Poller          *policy.Poller
 	HealthTracker   *lifecycle.HealthTracker
+	Breaker         *policy.Breaker
 	PolicyStore     *policystore.PostgresStore
 	Resolver        *attribute.Resolver
 	AuditLogger     *audit.Logger
@@ -88,11 +89,18 @@ type ABACConfig struct {
 	ParentLocationResolver attribute.ParentLocationResolver
 	RoleStore              store.RoleStore
 	AuditMode              audit.Mode
+	// ReadFailMode is how read actions are answered while the policy store
+	// is unavailable. Empty means policy.FailClosed; writes always fail
+	// closed.
+	ReadFailMode policy.FailMode
 	// CryptoOperators is the list of player IDs (ULIDs) holding the
 	// crypto.operator capability. Passed to PlayerAttributeProvider at
 	// construction. Empty / nil → no operators (break-glass disabled).
 	// Sub-epic B (Phase 5).
 	CryptoOperators []string
+	// Impersonators is the list of player IDs (ULIDs) holding the
+	// support.impersonate capability. Empty / nil → nobody may impersonate.
+	Impersonators []string
 	// PlayerKindLookup is an optional func that resolves whether a player is
 	// an ephemeral guest. When nil the PlayerAttributeProvider omits the
 	// is_guest key (has_is_guest=false) per the omit-don't-sentinel rule
@@ -102,8 +110,8 @@ type ABACConfig struct {
 }
 
 // BuildABACStack constructs and wires all ABAC components in the correct dependency order:
-// policy store, cache (with initial reload), attribute resolver and providers, policy engine,
-// audit logger, health tracker, poller, and policy installer. If cfg.AuditMode is empty it
+// policy store, cache (with initial reload), attribute resolver and providers, policy engine
+// and its circuit breaker, audit logger, health tracker, poller, and policy installer. If cfg.AuditMode is empty it
 // defaults to denials-only.
 // codecov:ignore — tested by integration and E2E tests
 func BuildABACStack(ctx context.Context, cfg ABACConfig) (*ABACStack, error) {
@@ -239,6 +247,9 @@ func BuildABACStack(ctx context.Context, cfg ABACConfig) (*ABACStack, error) {
 	if cfg.PlayerKindLookup != nil {
 		playerOpts = append(playerOpts, attribute.WithPlayerKindLookup(cfg.PlayerKindLookup))
 	}
+	if len(cfg.Impersonators) > 0 {
+		playerOpts = append(playerOpts, attribute.WithImpersonators(cfg.Impersonators))
+	}
 	playerProvider := attribute.NewPlayerAttributeProvider(cfg.CryptoOperators, playerOpts...)
 	if err := resolver.RegisterProvider(playerProvider); err != nil {
 		return nil, eb.Wrapf(err, "register player provider")
@@ -287,8 +298,12 @@ func BuildABACStack(ctx context.Context, cfg ABACConfig) (*ABACStack, error) {
 	auditLogger := audit.NewLogger(cfg.AuditMode, writer, "")
 
 	// 14. Replay WAL (non-fatal)
-	if err := auditLogger.ReplayWAL(ctx); err != nil {
-		slog.WarnContext(ctx, "audit WAL replay failed (non-fatal)", "error", err)
+	if report, err := auditLogger.ReplayWAL(ctx); err != nil {
+		slog.WarnContext(ctx, "audit WAL replay failed (non-fatal)",
+			"recovered", report.Recovered,
+			"skipped_duplicate", report.SkippedDuplicate,
+			"failed", report.Failed,
+			"error", err)
 	}
 
 	// 15. Session resolver (no-op — fails closed)
@@ -296,6 +311,19 @@ func BuildABACStack(ctx context.Context, cfg ABACConfig) (*ABACStack, error) {
 
 	// 16. Engine
 	engine := policy.NewEngine(resolver, cache, sessionRes, auditLogger)
+	engine.SetDecisionCache(policy.NewDecisionCache(policy.DefaultDecisionCacheTTL, policy.DefaultDecisionCacheCapacity))
+
+	// 16a. Policy store circuit breaker and fail modes
+	breakerCfg := policy.DefaultBreakerConfig()
+	if cfg.ReadFailMode != "" {
+		breakerCfg.ReadFailMode = cfg.ReadFailMode
+	}
+	breaker, err := policy.NewBreaker(breakerCfg)
+	if err != nil {
+		_ = sqlDB.Close() //nolint:errcheck // best-effort cleanup; config error takes precedence
+		return nil, eb.Wrapf(err, "create policy breaker")
+	}
+	engine.SetBreaker(breaker)
 
 	// 17. Health tracker for policy cache
 	healthTracker := lifecycle.NewHealthTracker(lifecycle.TrackerConfig{
@@ -349,6 +377,7 @@ func BuildABACStack(ctx context.Context, cfg ABACConfig) (*ABACStack, error) {
 		Cache:           cache,
 		Poller:          poller,
 		HealthTracker:   healthTracker,
+		Breaker:         breaker,
 		PolicyStore:     ps,
 		Resolver:        resolver,
 		AuditLogger:     auditLogger,
@@ -12,6 +12,7 @@ import (
 	"github.com/samber/oops"
 
 	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy"
 	"github.com/holomush/holomush/internal/access/policy/attribute"
 	policystore "github.com/holomush/holomush/internal/access/policy/store"
 	"github.com/holomush/holomush/internal/access/policy/types"
@@ -35,6 +36,9 @@ type ABACSubsystemConfig struct {
 	DB        PoolProvider
 	Registry  *lifecycle.ReadinessRegistry
 	AuditMode audit.Mode
+	// ReadFailMode is how read actions are answered while the policy store
+	// is unavailable. Empty means fail closed.
+	ReadFailMode policy.FailMode
 	// CryptoOperators is the RAW configured list of player IDs (ULIDs) —
 	// straight from crypto.operators config, not yet cross-checked against
 	// the players table. Start validates it (validateCryptoOperators,
@@ -42,6 +46,10 @@ type ABACSubsystemConfig struct {
 	// validated/deduplicated slice to ABACConfig / PlayerAttributeProvider.
 	// Empty / nil → no operators (break-glass disabled). Sub-epic B (Phase 5).
 	CryptoOperators []string
+	// Impersonators is the configured list of player IDs (ULIDs) allowed to
+	// open impersonation sessions, from auth.impersonators config. Passed
+	// through unvalidated; PlayerAttributeProvider skips empty entries.
+	Impersonators []string
 }
 
 // ABACSubsystem manages the ABAC policy engine, cache, and health tracker.
@@ -103,7 +111,9 @@ func (s *ABACSubsystem) Prepare(ctx context.Context) error {
 		ParentLocationResolver: postgres.NewParentLocationResolver(pool),
 		RoleStore:              roleStore,
 		AuditMode:              s.cfg.AuditMode,
+		ReadFailMode:           s.cfg.ReadFailMode,
 		CryptoOperators:        operators,
+		Impersonators:          s.cfg.Impersonators,
 		PlayerKindLookup: func(ctx context.Context, playerID string) (bool, error) {
 			id, err := ulid.Parse(playerID)
 			if err != nil {
@@ -174,6 +184,26 @@ func (s *ABACSubsystem) Stop(_ context.Context) error {
 	return nil
 }
 
+// SetAuditMode switches the live audit logger to mode, e.g. on a config
+// reload, and keeps it as the mode a rebuilt stack starts with. Before
+// Prepare only the configured mode changes.
+func (s *ABACSubsystem) SetAuditMode(mode audit.Mode) {
+	s.cfg.AuditMode = mode
+	if s.stack != nil && s.stack.AuditLogger != nil {
+		s.stack.AuditLogger.SetMode(mode)
+	}
+}
+
+// SetReadFailMode switches the live policy breaker's read fail mode, e.g.
+// on a config reload, and keeps it as the mode a rebuilt stack starts with.
+// Before Prepare only the configured mode changes.
+func (s *ABACSubsystem) SetReadFailMode(mode policy.FailMode) {
+	s.cfg.ReadFailMode = mode
+	if s.stack != nil && s.stack.Breaker != nil {
+		s.stack.Breaker.SetReadFailMode(mode)
+	}
+}
+
 // Engine returns the ABAC policy engine. Panics if called before Prepare().
 func (s *ABACSubsystem) Engine() types.AccessPolicyEngine {
 	if s.stack == nil {
@@ -229,7 +229,11 @@ func (c *ColdReader) buildSQL(q ColdQuery) (string, []any, error) { //nolint:goc
 		fmt.Fprintf(&sb, " AND timestamp <= $%d", len(args))
 	}
 
-	sb.WriteString(" ORDER BY timestamp ASC, js_seq ASC")
+	// Order by the store-assigned JetStream sequence, never by timestamp:
+	// timestamps are stamped by the publishing node's wall clock, so two
+	// core nodes with skewed clocks would otherwise interleave out of
+	// publish order. Since/Until stay timestamp filters only.
+	sb.WriteString(" ORDER BY js_seq ASC")
 
 	return sb.String(), args, nil
 }
@@ -136,7 +136,7 @@ func TestColdReader_BuildSQL_MultiSubject(t *testing.T) {
 	assert.Equal(t, 2, orCount, "three subjects need two OR connectors")
 }
 
-func TestColdReader_BuildSQL_OrderByTimestampAsc(t *testing.T) {
+func TestColdReader_BuildSQL_OrderByJsSeqAsc(t *testing.T) {
 	cr := newColdReaderForTest()
 	q := ColdQuery{
 		Subjects: []eventbus.Subject{"events.game.>"},
@@ -144,8 +144,9 @@ func TestColdReader_BuildSQL_OrderByTimestampAsc(t *testing.T) {
 	sqlStr, _, err := cr.buildSQL(q)
 	require.NoError(t, err)
 
-	// Must end with ascending order
-	assert.Contains(t, sqlStr, "ORDER BY timestamp ASC, js_seq ASC")
+	// Ordered by the store-assigned sequence; timestamps never order rows.
+	assert.Contains(t, sqlStr, "ORDER BY js_seq ASC")
+	assert.NotContains(t, sqlStr, "ORDER BY timestamp")
 }
 
 func TestColdReader_BuildSQL_FiltersByDekRefNotNull(t *testing.T) {
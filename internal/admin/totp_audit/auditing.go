@@ -15,6 +15,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/auth"
 	"github.com/holomush/holomush/internal/eventbus"
 	"github.com/holomush/holomush/internal/totp"
 )
@@ -30,6 +31,10 @@ type AuditingService struct {
 	gameID string
 	clock  totp.Clock
 	logger *slog.Logger
+
+	// security, when set, mirrors enrollment changes into the player's
+	// account security event log.
+	security auth.SecurityRecorder
 }
 
 // NewAuditingService constructs an AuditingService. inner / pub / clock
@@ -60,6 +65,21 @@ func NewAuditingService(
 	return &AuditingService{inner: inner, pub: pub, gameID: gameID, clock: clock, logger: logger}, nil
 }
 
+// SetSecurityRecorder mirrors 2FA enrollment changes into the player's
+// account security event log. Passing nil disables it.
+func (a *AuditingService) SetSecurityRecorder(r auth.SecurityRecorder) {
+	a.security = r
+}
+
+// recordSecurity writes a 2FA change to the security event log when a
+// recorder is configured.
+func (a *AuditingService) recordSecurity(ctx context.Context, pid ulid.ULID, eventType auth.SecurityEventType, detail string) {
+	if a.security == nil {
+		return
+	}
+	a.security.Record(ctx, pid, eventType, auth.SecurityOrigin{}, detail)
+}
+
 // emit publishes one audit event. Per INV-CRYPTO-81, Publish failure is
 // logged via slog.Warn and does NOT roll back the inner Service's PG
 // state.
@@ -152,6 +172,9 @@ func (a *AuditingService) ClearTOTP(ctx context.Context, pid ulid.ULID, by totp.
 			ClearedBy: by,
 		},
 	)
+	if res.WasEnrolled {
+		a.recordSecurity(ctx, pid, auth.SecurityEventTwoFactorDisabled, string(by))
+	}
 	return res, nil
 }
 
@@ -183,6 +206,9 @@ func (a *AuditingService) RecoverAndClear(ctx context.Context, pid ulid.ULID, co
 			ClearedBy: totp.ClearReasonRecoveryCode,
 		},
 	)
+	if res.WasEnrolled {
+		a.recordSecurity(ctx, pid, auth.SecurityEventTwoFactorDisabled, string(totp.ClearReasonRecoveryCode))
+	}
 	return res, nil
 }
 
@@ -207,21 +233,25 @@ func (a *AuditingService) PrepareBootstrap(ctx context.Context, pid ulid.ULID) (
 	return res, nil
 }
 
-// CommitBootstrap delegates to the inner Service. No audit event is emitted.
+// CommitBootstrap delegates to the inner Service. No audit event is emitted; a
+// configured security recorder logs the enrollment.
 func (a *AuditingService) CommitBootstrap(ctx context.Context, prep totp.BootstrapPreparation) (totp.BootstrapResult, error) {
 	res, err := a.inner.CommitBootstrap(ctx, prep)
 	if err != nil {
 		return res, oops.Wrap(err)
 	}
+	a.recordSecurity(ctx, res.AuditPlayerID, auth.SecurityEventTwoFactorEnabled, "bootstrap")
 	return res, nil
 }
 
-// BootstrapEnroll delegates to the inner Service. No audit event is emitted.
+// BootstrapEnroll delegates to the inner Service. No audit event is emitted; a
+// configured security recorder logs the enrollment.
 func (a *AuditingService) BootstrapEnroll(ctx context.Context, pid ulid.ULID) (totp.BootstrapResult, error) {
 	res, err := a.inner.BootstrapEnroll(ctx, pid)
 	if err != nil {
 		return res, oops.Wrap(err)
 	}
+	a.recordSecurity(ctx, res.AuditPlayerID, auth.SecurityEventTwoFactorEnabled, "bootstrap")
 	return res, nil
 }
 
@@ -234,21 +264,25 @@ func (a *AuditingService) PrepareEnroll(ctx context.Context, pid ulid.ULID) (tot
 	return res, nil
 }
 
-// CommitEnroll delegates to the inner Service. No audit event is emitted.
+// CommitEnroll delegates to the inner Service. No audit event is emitted; a
+// configured security recorder logs the enrollment.
 func (a *AuditingService) CommitEnroll(ctx context.Context, prep totp.EnrollPreparation) (totp.EnrollResult, error) {
 	res, err := a.inner.CommitEnroll(ctx, prep)
 	if err != nil {
 		return res, oops.Wrap(err)
 	}
+	a.recordSecurity(ctx, res.AuditPlayerID, auth.SecurityEventTwoFactorEnabled, "enroll")
 	return res, nil
 }
 
-// Enroll delegates to the inner Service. No audit event is emitted.
+// Enroll delegates to the inner Service. No audit event is emitted; a
+// configured security recorder logs the enrollment.
 func (a *AuditingService) Enroll(ctx context.Context, pid ulid.ULID) (totp.EnrollResult, error) {
 	res, err := a.inner.Enroll(ctx, pid)
 	if err != nil {
 		return res, oops.Wrap(err)
 	}
+	a.recordSecurity(ctx, res.AuditPlayerID, auth.SecurityEventTwoFactorEnabled, "enroll")
 	return res, nil
 }
 
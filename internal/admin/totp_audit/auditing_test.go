@@ -18,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	totpaudit "github.com/holomush/holomush/internal/admin/totp_audit"
+	"github.com/holomush/holomush/internal/auth"
 	"github.com/holomush/holomush/internal/eventbus"
 	"github.com/holomush/holomush/internal/totp"
 )
@@ -189,6 +190,41 @@ func TestAuditingServiceClearTOTPEmitsCleared(t *testing.T) {
 	assert.Equal(t, totp.ClearReason("admin_reset"), got.ClearedBy)
 }
 
+// recordingSecurity captures security events mirrored by the decorator.
+type recordingSecurity struct {
+	types []auth.SecurityEventType
+}
+
+func (r *recordingSecurity) Record(_ context.Context, _ ulid.ULID, eventType auth.SecurityEventType, _ auth.SecurityOrigin, _ string) {
+	r.types = append(r.types, eventType)
+}
+
+func TestAuditingServiceMirrorsEnrollmentChangesToSecurityLog(t *testing.T) {
+	pid := ulid.Make()
+	ts := &fakeTOTPService{
+		enrollRes:   totp.EnrollResult{AuditPlayerID: pid},
+		clearResult: totp.ClearResult{AuditPlayerID: pid, WasEnrolled: true},
+	}
+	a := newAuditing(t, ts, &fakePublisher{}, slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil)))
+	sec := &recordingSecurity{}
+	a.SetSecurityRecorder(sec)
+
+	_, err := a.Enroll(context.Background(), pid)
+	require.NoError(t, err)
+	_, err = a.ClearTOTP(context.Background(), pid, totp.ClearReasonAdminReset)
+	require.NoError(t, err)
+
+	// A no-op clear (nothing enrolled) is not a 2FA change.
+	ts.clearResult.WasEnrolled = false
+	_, err = a.ClearTOTP(context.Background(), pid, totp.ClearReasonAdminReset)
+	require.NoError(t, err)
+
+	assert.Equal(t, []auth.SecurityEventType{
+		auth.SecurityEventTwoFactorEnabled,
+		auth.SecurityEventTwoFactorDisabled,
+	}, sec.types)
+}
+
 func TestAuditingServiceRecoverAndClearEmitsBoth(t *testing.T) {
 	ts := &fakeTOTPService{recoverResult: totp.RecoverAndClearResult{
 		RecoveryCodeID:  ulid.Make(),
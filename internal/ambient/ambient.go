@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package ambient sends per-location atmosphere: lines such as "A gull
+// cries somewhere overhead." that a location emits now and then while
+// players are there. Builders give a location a schedule (how often a line
+// may fire, and optionally the hours it is active) and a set of weighted
+// lines; the service picks one at random each time the schedule comes due.
+//
+// Schedules and lines live in their own tables. Each schedule records when
+// it next fires, and replicas claim due schedules atomically, so a line is
+// sent once cluster-wide. Lines go out as ambient events on the location
+// stream, marked low priority so clients may dim or fold them.
+package ambient
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Limits and defaults.
+const (
+	// MaxLineLength bounds a line's text in bytes.
+	MaxLineLength = 500
+	// MaxLinesPerLocation bounds how many lines one location may hold.
+	MaxLinesPerLocation = 50
+	// MaxWeight bounds a line's weight.
+	MaxWeight = 1000
+	// MinInterval is the shortest gap a schedule may ask for between lines.
+	MinInterval = 30 * time.Second
+	// MaxInterval is the longest gap a schedule may ask for between lines.
+	MaxInterval = 24 * time.Hour
+	// DefaultMinInterval and DefaultMaxInterval are the gaps a location
+	// gets when its first line is added without a schedule.
+	DefaultMinInterval = 5 * time.Minute
+	DefaultMaxInterval = 15 * time.Minute
+)
+
+// ErrNotFound is returned when a schedule or line does not exist.
+var ErrNotFound = errors.New("ambient item not found")
+
+// ActiveHours is a daily window, in minutes after midnight UTC, during which
+// a schedule fires. A window whose End is before its Start wraps past
+// midnight (e.g. 22:00–04:00). The zero window, Start equal to End, is
+// always active.
+type ActiveHours struct {
+	Start int
+	End   int
+}
+
+// minutesPerDay bounds ActiveHours values.
+const minutesPerDay = 24 * 60
+
+// ParseActiveHours parses "HH:MM-HH:MM". An empty string, "always", or
+// "any" yields the always-active window.
+func ParseActiveHours(s string) (ActiveHours, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	if s == "" || s == "always" || s == "any" {
+		return ActiveHours{}, nil
+	}
+	from, until, ok := strings.Cut(s, "-")
+	if !ok {
+		return ActiveHours{}, oops.Code("AMBIENT_INVALID").
+			With("active_hours", s).
+			Errorf("active hours must look like 20:00-06:00")
+	}
+	start, err := parseClock(from)
+	if err != nil {
+		return ActiveHours{}, err
+	}
+	end, err := parseClock(until)
+	if err != nil {
+		return ActiveHours{}, err
+	}
+	return ActiveHours{Start: start, End: end}, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, oops.Code("AMBIENT_INVALID").
+			With("time", s).
+			Errorf("%q is not a time of day (HH:MM)", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Always reports whether the window covers the whole day.
+func (h ActiveHours) Always() bool {
+	return h.Start == h.End
+}
+
+// Contains reports whether t falls inside the window.
+func (h ActiveHours) Contains(t time.Time) bool {
+	if h.Always() {
+		return true
+	}
+	t = t.UTC()
+	m := t.Hour()*60 + t.Minute()
+	if h.Start < h.End {
+		return m >= h.Start && m < h.End
+	}
+	return m >= h.Start || m < h.End
+}
+
+// Validate returns AMBIENT_INVALID when either bound is outside the day.
+func (h ActiveHours) Validate() error {
+	if h.Start < 0 || h.Start >= minutesPerDay || h.End < 0 || h.End >= minutesPerDay {
+		return oops.Code("AMBIENT_INVALID").
+			With("start", h.Start).
+			With("end", h.End).
+			Errorf("active hours must fall within the day")
+	}
+	return nil
+}
+
+// String renders the window as "HH:MM-HH:MM", or "always".
+func (h ActiveHours) String() string {
+	if h.Always() {
+		return "always"
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", h.Start/60, h.Start%60, h.End/60, h.End%60)
+}
+
+// Schedule controls when a location emits its ambient lines. After each
+// firing the next one is due a random interval in [MinInterval,
+// MaxInterval] later.
+type Schedule struct {
+	LocationID  ulid.ULID
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	ActiveHours ActiveHours
+	NextAt      time.Time
+	UpdatedBy   string
+	UpdatedAt   time.Time
+}
+
+// Validate returns AMBIENT_INVALID when the intervals are out of range or
+// inverted, or the active hours are malformed.
+func (s *Schedule) Validate() error {
+	switch {
+	case s.MinInterval < MinInterval:
+		return oops.Code("AMBIENT_INVALID").
+			With("min_interval", s.MinInterval.String()).
+			Errorf("minimum interval must be at least %s", MinInterval)
+	case s.MaxInterval > MaxInterval:
+		return oops.Code("AMBIENT_INVALID").
+			With("max_interval", s.MaxInterval.String()).
+			Errorf("maximum interval must be at most %s", MaxInterval)
+	case s.MaxInterval < s.MinInterval:
+		return oops.Code("AMBIENT_INVALID").
+			With("min_interval", s.MinInterval.String()).
+			With("max_interval", s.MaxInterval.String()).
+			Errorf("maximum interval must not be shorter than the minimum")
+	}
+	return s.ActiveHours.Validate()
+}
+
+// Line is one ambient message. A line's chance of being picked is its
+// Weight over the sum of the location's weights.
+type Line struct {
+	ID         ulid.ULID
+	LocationID ulid.ULID
+	Text       string
+	Weight     int
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+// ValidateLine returns AMBIENT_INVALID when text is blank, too long, or not
+// UTF-8, or weight is out of range.
+func ValidateLine(text string, weight int) error {
+	switch {
+	case strings.TrimSpace(text) == "":
+		return oops.Code("AMBIENT_INVALID").Errorf("ambient line must not be empty")
+	case len(text) > MaxLineLength:
+		return oops.Code("AMBIENT_INVALID").
+			With("length", len(text)).
+			Errorf("ambient line exceeds %d bytes", MaxLineLength)
+	case !utf8.ValidString(text):
+		return oops.Code("AMBIENT_INVALID").Errorf("ambient line must be valid UTF-8")
+	case weight < 1 || weight > MaxWeight:
+		return oops.Code("AMBIENT_INVALID").
+			With("weight", weight).
+			Errorf("weight must be between 1 and %d", MaxWeight)
+	}
+	return nil
+}
+
+// Pick chooses a line at random, weighted by Weight. intn returns a
+// uniform value in [0, n). Pick returns nil when lines is empty.
+func Pick(lines []*Line, intn func(n int) int) *Line {
+	total := 0
+	for _, l := range lines {
+		total += l.Weight
+	}
+	if total <= 0 {
+		return nil
+	}
+	r := intn(total)
+	for _, l := range lines {
+		if r < l.Weight {
+			return l
+		}
+		r -= l.Weight
+	}
+	return lines[len(lines)-1]
+}
+
+// nextInterval returns a random gap in [min, max].
+func nextInterval(minGap, maxGap time.Duration, int63n func(n int64) int64) time.Duration {
+	if maxGap <= minGap {
+		return minGap
+	}
+	return minGap + time.Duration(int63n(int64(maxGap-minGap)+1))
+}
+
+// cryptoIntN returns a cryptographically secure random int in [0, n).
+func cryptoIntN(n int) int {
+	return int(cryptoInt64N(int64(n)))
+}
+
+// cryptoInt64N returns a cryptographically secure random int64 in [0, n).
+func cryptoInt64N(n int64) int64 {
+	v, err := rand.Int(rand.Reader, big.NewInt(n))
+	if err != nil {
+		// crypto/rand failure is a system-level problem; panic is appropriate.
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return v.Int64()
+}
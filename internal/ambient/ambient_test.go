@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ambient
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestParseActiveHours(t *testing.T) {
+	tests := []struct {
+		in   string
+		want ActiveHours
+		str  string
+	}{
+		{"", ActiveHours{}, "always"},
+		{"Always", ActiveHours{}, "always"},
+		{"08:00-17:30", ActiveHours{Start: 480, End: 1050}, "08:00-17:30"},
+		{" 22:00 - 04:00 ", ActiveHours{Start: 1320, End: 240}, "22:00-04:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseActiveHours(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.str, got.String())
+		})
+	}
+
+	for _, bad := range []string{"8am-5pm", "08:00", "25:00-01:00"} {
+		_, err := ParseActiveHours(bad)
+		errutil.AssertErrorCode(t, err, "AMBIENT_INVALID")
+	}
+}
+
+func TestActiveHoursContains(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2026, 3, 1, h, m, 0, 0, time.UTC) }
+	day := ActiveHours{Start: 8 * 60, End: 17 * 60}
+	night := ActiveHours{Start: 22 * 60, End: 4 * 60}
+
+	assert.True(t, ActiveHours{}.Contains(at(3, 0)))
+	assert.True(t, day.Contains(at(8, 0)))
+	assert.True(t, day.Contains(at(16, 59)))
+	assert.False(t, day.Contains(at(17, 0)))
+	assert.False(t, day.Contains(at(7, 59)))
+	assert.True(t, night.Contains(at(23, 0)))
+	assert.True(t, night.Contains(at(3, 59)))
+	assert.False(t, night.Contains(at(12, 0)))
+	assert.True(t, day.Contains(at(10, 0).In(time.FixedZone("x", -5*3600))), "hours are UTC")
+}
+
+func TestScheduleValidate(t *testing.T) {
+	valid := Schedule{MinInterval: time.Minute, MaxInterval: time.Hour}
+	require.NoError(t, valid.Validate())
+
+	for name, sched := range map[string]Schedule{
+		"too frequent": {MinInterval: time.Second, MaxInterval: time.Hour},
+		"too sparse":   {MinInterval: time.Minute, MaxInterval: 48 * time.Hour},
+		"inverted":     {MinInterval: time.Hour, MaxInterval: time.Minute},
+		"bad hours":    {MinInterval: time.Minute, MaxInterval: time.Hour, ActiveHours: ActiveHours{Start: 2000}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			errutil.AssertErrorCode(t, sched.Validate(), "AMBIENT_INVALID")
+		})
+	}
+}
+
+func TestValidateLine(t *testing.T) {
+	require.NoError(t, ValidateLine("A gull cries overhead.", 1))
+	errutil.AssertErrorCode(t, ValidateLine("  ", 1), "AMBIENT_INVALID")
+	errutil.AssertErrorCode(t, ValidateLine(strings.Repeat("x", MaxLineLength+1), 1), "AMBIENT_INVALID")
+	errutil.AssertErrorCode(t, ValidateLine("\xff", 1), "AMBIENT_INVALID")
+	errutil.AssertErrorCode(t, ValidateLine("ok", 0), "AMBIENT_INVALID")
+	errutil.AssertErrorCode(t, ValidateLine("ok", MaxWeight+1), "AMBIENT_INVALID")
+}
+
+func TestPickHonorsWeights(t *testing.T) {
+	a := &Line{Text: "a", Weight: 1}
+	b := &Line{Text: "b", Weight: 3}
+	lines := []*Line{a, b}
+
+	assert.Nil(t, Pick(nil, func(int) int { return 0 }))
+	assert.Same(t, a, Pick(lines, func(int) int { return 0 }))
+	assert.Same(t, b, Pick(lines, func(int) int { return 1 }))
+	assert.Same(t, b, Pick(lines, func(int) int { return 3 }))
+
+	var gotTotal int
+	Pick(lines, func(n int) int { gotTotal = n; return 0 })
+	assert.Equal(t, 4, gotTotal)
+}
+
+func TestNextIntervalStaysInRange(t *testing.T) {
+	assert.Equal(t, time.Minute, nextInterval(time.Minute, time.Minute, func(int64) int64 { panic("unused") }))
+	lo := nextInterval(time.Minute, 2*time.Minute, func(int64) int64 { return 0 })
+	assert.Equal(t, time.Minute, lo)
+	hi := nextInterval(time.Minute, 2*time.Minute, func(n int64) int64 { return n - 1 })
+	assert.Equal(t, 2*time.Minute, hi)
+}
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ambient
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const (
+	scheduleColumns = `location_id, min_interval_ns, max_interval_ns, active_start, active_end, next_at, updated_by, updated_at`
+	lineColumns     = `id, location_id, text, weight, created_by, created_at`
+)
+
+// pgForeignKeyViolation is the SQLSTATE for a foreign key violation.
+const pgForeignKeyViolation = "23503"
+
+// PostgresStore implements Repository against the ambient_schedules and
+// ambient_lines tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// GetSchedule returns the location's schedule.
+func (s *PostgresStore) GetSchedule(ctx context.Context, locationID ulid.ULID) (*Schedule, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+scheduleColumns+` FROM ambient_schedules WHERE location_id = $1`,
+		locationID.String())
+	sched, err := scanSchedule(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("AMBIENT_NOT_FOUND").
+			With("location_id", locationID.String()).
+			Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "get_schedule").
+			With("location_id", locationID.String()).
+			Wrap(err)
+	}
+	return sched, nil
+}
+
+// SaveSchedule upserts sched.
+func (s *PostgresStore) SaveSchedule(ctx context.Context, sched *Schedule) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO ambient_schedules (`+scheduleColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (location_id) DO UPDATE SET
+			min_interval_ns = EXCLUDED.min_interval_ns,
+			max_interval_ns = EXCLUDED.max_interval_ns,
+			active_start    = EXCLUDED.active_start,
+			active_end      = EXCLUDED.active_end,
+			next_at         = EXCLUDED.next_at,
+			updated_by      = EXCLUDED.updated_by,
+			updated_at      = EXCLUDED.updated_at
+	`, sched.LocationID.String(), int64(sched.MinInterval), int64(sched.MaxInterval),
+		sched.ActiveHours.Start, sched.ActiveHours.End, pgnanos.From(sched.NextAt),
+		sched.UpdatedBy, pgnanos.From(sched.UpdatedAt))
+	if err != nil {
+		return oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "save_schedule").
+			With("location_id", sched.LocationID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// DeleteSchedule removes the location's schedule; its lines cascade.
+func (s *PostgresStore) DeleteSchedule(ctx context.Context, locationID ulid.ULID) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM ambient_schedules WHERE location_id = $1`, locationID.String())
+	if err != nil {
+		return oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "delete_schedule").
+			With("location_id", locationID.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("AMBIENT_NOT_FOUND").
+			With("location_id", locationID.String()).
+			Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+// AddLine inserts l.
+func (s *PostgresStore) AddLine(ctx context.Context, l *Line) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO ambient_lines (`+lineColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, l.ID.String(), l.LocationID.String(), l.Text, l.Weight, l.CreatedBy, pgnanos.From(l.CreatedAt))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		return oops.Code("AMBIENT_NOT_FOUND").
+			With("location_id", l.LocationID.String()).
+			Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "add_line").
+			With("line_id", l.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// ListLines returns the location's lines in creation order.
+func (s *PostgresStore) ListLines(ctx context.Context, locationID ulid.ULID) ([]*Line, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+lineColumns+`
+		  FROM ambient_lines
+		 WHERE location_id = $1
+		 ORDER BY created_at, id
+	`, locationID.String())
+	if err != nil {
+		return nil, oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "list_lines").
+			With("location_id", locationID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+	var out []*Line
+	for rows.Next() {
+		l, err := scanLine(rows)
+		if err != nil {
+			return nil, oops.Code("AMBIENT_STORE_FAILED").With("operation", "list_lines").Wrap(err)
+		}
+		out = append(out, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AMBIENT_STORE_FAILED").With("operation", "list_lines").Wrap(err)
+	}
+	return out, nil
+}
+
+// DeleteLine removes the location's line with id.
+func (s *PostgresStore) DeleteLine(ctx context.Context, locationID, id ulid.ULID) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM ambient_lines WHERE id = $1 AND location_id = $2`,
+		id.String(), locationID.String())
+	if err != nil {
+		return oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "delete_line").
+			With("line_id", id.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("AMBIENT_NOT_FOUND").
+			With("line_id", id.String()).
+			Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+// ClaimDue leases and returns the schedules due at now.
+func (s *PostgresStore) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) ([]*Schedule, error) {
+	rows, err := s.pool.Query(ctx, `
+		UPDATE ambient_schedules
+		   SET next_at = $2
+		 WHERE next_at <= $1
+		RETURNING `+scheduleColumns, pgnanos.From(now), pgnanos.From(now.Add(lease)))
+	if err != nil {
+		return nil, oops.Code("AMBIENT_STORE_FAILED").With("operation", "claim_due").Wrap(err)
+	}
+	defer rows.Close()
+	var out []*Schedule
+	for rows.Next() {
+		sched, err := scanSchedule(rows)
+		if err != nil {
+			return nil, oops.Code("AMBIENT_STORE_FAILED").With("operation", "claim_due").Wrap(err)
+		}
+		out = append(out, sched)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AMBIENT_STORE_FAILED").With("operation", "claim_due").Wrap(err)
+	}
+	return out, nil
+}
+
+// Reschedule sets the location's next fire time.
+func (s *PostgresStore) Reschedule(ctx context.Context, locationID ulid.ULID, next time.Time) error {
+	_, err := s.pool.Exec(ctx, `UPDATE ambient_schedules SET next_at = $2 WHERE location_id = $1`,
+		locationID.String(), pgnanos.From(next))
+	if err != nil {
+		return oops.Code("AMBIENT_STORE_FAILED").
+			With("operation", "reschedule").
+			With("location_id", locationID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+func scanSchedule(row pgx.Row) (*Schedule, error) {
+	var (
+		sched     Schedule
+		id        string
+		minGap    int64
+		maxGap    int64
+		nextAt    pgnanos.Time
+		updatedAt pgnanos.Time
+	)
+	if err := row.Scan(&id, &minGap, &maxGap, &sched.ActiveHours.Start, &sched.ActiveHours.End,
+		&nextAt, &sched.UpdatedBy, &updatedAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("location_id", id).Wrap(err)
+	}
+	sched.LocationID = parsed
+	sched.MinInterval = time.Duration(minGap)
+	sched.MaxInterval = time.Duration(maxGap)
+	sched.NextAt = nextAt.Time()
+	sched.UpdatedAt = updatedAt.Time()
+	return &sched, nil
+}
+
+func scanLine(row pgx.Row) (*Line, error) {
+	var (
+		l         Line
+		id        string
+		locID     string
+		createdAt pgnanos.Time
+	)
+	if err := row.Scan(&id, &locID, &l.Text, &l.Weight, &l.CreatedBy, &createdAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("line_id", id).Wrap(err)
+	}
+	loc, err := ulid.Parse(locID)
+	if err != nil {
+		return nil, oops.With("location_id", locID).Wrap(err)
+	}
+	l.ID = parsed
+	l.LocationID = loc
+	l.CreatedAt = createdAt.Time()
+	return &l, nil
+}
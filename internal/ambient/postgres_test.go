@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package ambient_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/ambient"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createLocation(t *testing.T, pool *pgxpool.Pool) ulid.ULID {
+	t.Helper()
+	ctx := context.Background()
+	id := idgen.New()
+	_, err := pool.Exec(ctx, `
+		INSERT INTO locations (id, name, description, type, replay_policy, created_at)
+		VALUES ($1, 'Shore', 'Test', 'persistent', 'last:0', (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT)
+	`, id.String())
+	require.NoError(t, err)
+	return id
+}
+
+func TestPostgresStoreSchedulesAndLines(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := ambient.NewPostgresStore(pool)
+	locID := createLocation(t, pool)
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := st.GetSchedule(ctx, locID)
+	errutil.AssertErrorCode(t, err, "AMBIENT_NOT_FOUND")
+	err = st.AddLine(ctx, &ambient.Line{ID: idgen.New(), LocationID: locID, Text: "x", Weight: 1,
+		CreatedBy: "character:test", CreatedAt: now})
+	assert.ErrorIs(t, err, ambient.ErrNotFound, "lines need a schedule")
+
+	sched := &ambient.Schedule{LocationID: locID, MinInterval: time.Minute, MaxInterval: time.Hour,
+		ActiveHours: ambient.ActiveHours{Start: 1200, End: 300}, NextAt: now.Add(time.Minute),
+		UpdatedBy: "character:test", UpdatedAt: now}
+	require.NoError(t, st.SaveSchedule(ctx, sched))
+	got, err := st.GetSchedule(ctx, locID)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, got.MaxInterval)
+	assert.Equal(t, sched.ActiveHours, got.ActiveHours)
+	assert.True(t, sched.NextAt.Equal(got.NextAt))
+
+	first := &ambient.Line{ID: idgen.New(), LocationID: locID, Text: "Waves break.", Weight: 3,
+		CreatedBy: "character:test", CreatedAt: now}
+	second := &ambient.Line{ID: idgen.New(), LocationID: locID, Text: "A gull cries.", Weight: 1,
+		CreatedBy: "character:test", CreatedAt: now.Add(time.Second)}
+	require.NoError(t, st.AddLine(ctx, first))
+	require.NoError(t, st.AddLine(ctx, second))
+	lines, err := st.ListLines(ctx, locID)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, first.ID, lines[0].ID)
+	assert.Equal(t, 3, lines[0].Weight)
+
+	require.NoError(t, st.DeleteLine(ctx, locID, first.ID))
+	errutil.AssertErrorCode(t, st.DeleteLine(ctx, locID, first.ID), "AMBIENT_NOT_FOUND")
+
+	require.NoError(t, st.DeleteSchedule(ctx, locID))
+	lines, err = st.ListLines(ctx, locID)
+	require.NoError(t, err)
+	assert.Empty(t, lines, "lines go with their schedule")
+	errutil.AssertErrorCode(t, st.DeleteSchedule(ctx, locID), "AMBIENT_NOT_FOUND")
+}
+
+func TestPostgresStoreClaimDue(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := ambient.NewPostgresStore(pool)
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	due := createLocation(t, pool)
+	later := createLocation(t, pool)
+	for id, next := range map[ulid.ULID]time.Time{due: now.Add(-time.Second), later: now.Add(time.Hour)} {
+		require.NoError(t, st.SaveSchedule(ctx, &ambient.Schedule{LocationID: id, MinInterval: time.Minute,
+			MaxInterval: time.Minute, NextAt: next, UpdatedBy: "character:test", UpdatedAt: now}))
+	}
+
+	claimed, err := st.ClaimDue(ctx, now, time.Minute)
+	require.NoError(t, err)
+	var ids []ulid.ULID
+	for _, s := range claimed {
+		ids = append(ids, s.LocationID)
+	}
+	assert.Contains(t, ids, due)
+	assert.NotContains(t, ids, later)
+
+	again, err := st.ClaimDue(ctx, now, time.Minute)
+	require.NoError(t, err)
+	for _, s := range again {
+		assert.NotEqual(t, due, s.LocationID, "a claimed schedule is leased")
+	}
+
+	require.NoError(t, st.Reschedule(ctx, due, now.Add(-time.Second)))
+	again, err = st.ClaimDue(ctx, now, time.Minute)
+	require.NoError(t, err)
+	ids = ids[:0]
+	for _, s := range again {
+		ids = append(ids, s.LocationID)
+	}
+	assert.Contains(t, ids, due)
+}
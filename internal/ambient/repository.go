@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ambient
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists ambient schedules and lines.
+type Repository interface {
+	// GetSchedule returns the location's schedule. Returns an error
+	// wrapping ErrNotFound when it has none.
+	GetSchedule(ctx context.Context, locationID ulid.ULID) (*Schedule, error)
+	// SaveSchedule creates or replaces the location's schedule, including
+	// its NextAt.
+	SaveSchedule(ctx context.Context, s *Schedule) error
+	// DeleteSchedule removes the location's schedule and every line it
+	// holds. Returns an error wrapping ErrNotFound when it has none.
+	DeleteSchedule(ctx context.Context, locationID ulid.ULID) error
+
+	// AddLine stores a new line. The location must have a schedule.
+	AddLine(ctx context.Context, l *Line) error
+	// ListLines returns the location's lines ordered by creation.
+	ListLines(ctx context.Context, locationID ulid.ULID) ([]*Line, error)
+	// DeleteLine removes the line with id from the location. Returns an
+	// error wrapping ErrNotFound when the location has no such line.
+	DeleteLine(ctx context.Context, locationID, id ulid.ULID) error
+
+	// ClaimDue moves NextAt to now+lease on every schedule due at now and
+	// returns them. The claim is atomic, so each firing is returned to
+	// exactly one caller across replicas; the lease keeps a schedule whose
+	// claimant died from stalling forever.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) ([]*Schedule, error)
+	// Reschedule sets the location's NextAt.
+	Reschedule(ctx context.Context, locationID ulid.ULID, next time.Time) error
+}
@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ambient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// ABAC actions checked on the location before its ambience is read or
+// changed. Anyone who may edit a location may edit its ambience.
+const (
+	ActionRead  = "read"
+	ActionWrite = "write"
+)
+
+// Tick timing.
+const (
+	// TickInterval is how often Run looks for schedules that are due.
+	TickInterval = 10 * time.Second
+	// ClaimLease is how far ClaimDue pushes a claimed schedule out, so a
+	// replica that dies mid-tick delays that location's next line rather
+	// than silencing it.
+	ClaimLease = 5 * time.Minute
+)
+
+// Sessions is the subset of the session store the service uses to tell
+// whether anyone is in a location to hear its ambience.
+type Sessions interface {
+	ListActiveByLocation(ctx context.Context, locationID ulid.ULID) ([]*session.Info, error)
+}
+
+// Service manages ambient schedules and lines and sends the lines. Edits
+// require write access to the location and are written to the structured
+// log as audit records.
+//
+// Sending needs an event publisher and the session store, which are bound
+// after construction with SetDelivery once the event bus is up. Until then
+// Tick is a no-op.
+type Service struct {
+	repo   Repository
+	engine types.AccessPolicyEngine
+	logger *slog.Logger
+	now    func() time.Time
+	intn   func(n int) int
+	int63n func(n int64) int64
+
+	mu       sync.RWMutex
+	pub      eventbus.Publisher
+	gameID   func() string
+	sessions Sessions
+}
+
+// NewService creates a Service. repo and engine are required; a nil logger
+// uses slog.Default().
+func NewService(repo Repository, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("ambient repository is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		repo:   repo,
+		engine: engine,
+		logger: logger,
+		now:    time.Now,
+		intn:   cryptoIntN,
+		int63n: cryptoInt64N,
+	}, nil
+}
+
+// SetDelivery binds the publisher ambient lines are sent through and the
+// session store that tells whether a location is occupied. gameID supplies
+// the game id that qualifies event subjects.
+func (s *Service) SetDelivery(pub eventbus.Publisher, gameID func() string, sessions Sessions) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+	s.sessions = sessions
+}
+
+func (s *Service) delivery() (eventbus.Publisher, func() string, Sessions) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil || s.sessions == nil {
+		return nil, nil, nil
+	}
+	return s.pub, s.gameID, s.sessions
+}
+
+// Schedule returns the location's schedule on behalf of subject. Returns
+// AMBIENT_NOT_FOUND (wrapping ErrNotFound) when it has no ambience.
+func (s *Service) Schedule(ctx context.Context, subject string, locationID ulid.ULID) (*Schedule, error) {
+	if err := s.checkAccess(ctx, subject, ActionRead, locationID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetSchedule(ctx, locationID)
+}
+
+// SetSchedule creates or replaces the location's schedule on behalf of
+// subject. The next line is due a random interval from now. Returns
+// AMBIENT_INVALID for out-of-range intervals or hours and
+// AMBIENT_ACCESS_DENIED when subject may not edit the location.
+func (s *Service) SetSchedule(ctx context.Context, subject string, locationID ulid.ULID, minGap, maxGap time.Duration, hours ActiveHours) (*Schedule, error) {
+	now := s.now().UTC()
+	sched := &Schedule{
+		LocationID:  locationID,
+		MinInterval: minGap,
+		MaxInterval: maxGap,
+		ActiveHours: hours,
+		UpdatedBy:   subject,
+		UpdatedAt:   now,
+	}
+	if err := sched.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, locationID); err != nil {
+		return nil, err
+	}
+	sched.NextAt = now.Add(nextInterval(minGap, maxGap, s.int63n))
+	if err := s.repo.SaveSchedule(ctx, sched); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "ambient schedule set",
+		"event", "ambient_schedule_set",
+		"subject", subject,
+		"location_id", locationID.String(),
+		"min_interval", minGap.String(),
+		"max_interval", maxGap.String(),
+		"active_hours", hours.String(),
+	)
+	return sched, nil
+}
+
+// Clear removes the location's schedule and all its lines on behalf of
+// subject.
+func (s *Service) Clear(ctx context.Context, subject string, locationID ulid.ULID) error {
+	if err := s.checkAccess(ctx, subject, ActionWrite, locationID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteSchedule(ctx, locationID); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "ambient cleared",
+		"event", "ambient_cleared",
+		"subject", subject,
+		"location_id", locationID.String(),
+	)
+	return nil
+}
+
+// AddLine adds a line to the location on behalf of subject. A location with
+// no schedule gets the default one, always active. Returns AMBIENT_INVALID
+// for a bad line or when the location already holds MaxLinesPerLocation.
+func (s *Service) AddLine(ctx context.Context, subject string, locationID ulid.ULID, text string, weight int) (*Line, error) {
+	if err := ValidateLine(text, weight); err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, locationID); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetSchedule(ctx, locationID); errors.Is(err, ErrNotFound) {
+		if _, err := s.SetSchedule(ctx, subject, locationID, DefaultMinInterval, DefaultMaxInterval, ActiveHours{}); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	existing, err := s.repo.ListLines(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxLinesPerLocation {
+		return nil, oops.Code("AMBIENT_INVALID").
+			With("location_id", locationID.String()).
+			Errorf("a location may hold at most %d ambient lines", MaxLinesPerLocation)
+	}
+	now := s.now().UTC()
+	line := &Line{
+		ID:         idgen.New(),
+		LocationID: locationID,
+		Text:       text,
+		Weight:     weight,
+		CreatedBy:  subject,
+		CreatedAt:  now,
+	}
+	if err := s.repo.AddLine(ctx, line); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "ambient line added",
+		"event", "ambient_line_added",
+		"subject", subject,
+		"location_id", locationID.String(),
+		"line_id", line.ID.String(),
+		"weight", weight,
+	)
+	return line, nil
+}
+
+// Lines returns the location's lines on behalf of subject, in the order
+// they were added.
+func (s *Service) Lines(ctx context.Context, subject string, locationID ulid.ULID) ([]*Line, error) {
+	if err := s.checkAccess(ctx, subject, ActionRead, locationID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListLines(ctx, locationID)
+}
+
+// RemoveLine removes a line from the location on behalf of subject. Returns
+// AMBIENT_NOT_FOUND (wrapping ErrNotFound) when the location has no line
+// with lineID.
+func (s *Service) RemoveLine(ctx context.Context, subject string, locationID, lineID ulid.ULID) error {
+	if err := s.checkAccess(ctx, subject, ActionWrite, locationID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteLine(ctx, locationID, lineID); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "ambient line removed",
+		"event", "ambient_line_removed",
+		"subject", subject,
+		"location_id", locationID.String(),
+		"line_id", lineID.String(),
+	)
+	return nil
+}
+
+// Tick fires every schedule that is due. A due location outside its active
+// hours, with nobody present, or with no lines stays quiet; either way its
+// next firing is set a fresh random interval out. Schedules are claimed
+// before they fire, so each fires once even with several replicas ticking.
+func (s *Service) Tick(ctx context.Context) error {
+	pub, gameID, sessions := s.delivery()
+	if pub == nil {
+		return nil
+	}
+	now := s.now().UTC()
+	due, err := s.repo.ClaimDue(ctx, now, ClaimLease)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, sched := range due {
+		if err := s.fire(ctx, pub, gameID, sessions, sched, now); err != nil {
+			errs = append(errs, oops.With("location_id", sched.LocationID.String()).Wrap(err))
+		}
+		next := now.Add(nextInterval(sched.MinInterval, sched.MaxInterval, s.int63n))
+		if err := s.repo.Reschedule(ctx, sched.LocationID, next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fire sends one of the schedule's lines to its location when the location
+// is active and occupied.
+func (s *Service) fire(ctx context.Context, pub eventbus.Publisher, gameID func() string, sessions Sessions, sched *Schedule, now time.Time) error {
+	if !sched.ActiveHours.Contains(now) {
+		return nil
+	}
+	present, err := sessions.ListActiveByLocation(ctx, sched.LocationID)
+	if err != nil {
+		return oops.Code("AMBIENT_PRESENCE_FAILED").Wrap(err)
+	}
+	if len(present) == 0 {
+		return nil
+	}
+	lines, err := s.repo.ListLines(ctx, sched.LocationID)
+	if err != nil {
+		return err
+	}
+	line := Pick(lines, s.intn)
+	if line == nil {
+		return nil
+	}
+	if err := publishLine(ctx, pub, gameID, line); err != nil {
+		return err
+	}
+	s.logger.DebugContext(ctx, "ambient line sent",
+		"location_id", sched.LocationID.String(),
+		"line_id", line.ID.String(),
+		"present", len(present),
+	)
+	return nil
+}
+
+// Run calls Tick every TickInterval until ctx is cancelled. Tick errors are
+// logged and do not stop the loop.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Tick(ctx); err != nil {
+				s.logger.WarnContext(ctx, "ambient tick failed", "error", err)
+			}
+		}
+	}
+}
+
+// publishLine sends line as an ambient event on its location's stream.
+func publishLine(ctx context.Context, pub eventbus.Publisher, gameID func() string, line *Line) error {
+	payload, err := json.Marshal(eventvocab.AmbientPayload{
+		LocationID: line.LocationID.String(),
+		LineID:     line.ID.String(),
+		Text:       line.Text,
+		Priority:   eventvocab.AmbientPriorityLow,
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_ambient_payload").Wrap(err)
+	}
+	stream := world.LocationStream(line.LocationID)
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeAmbient))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeAmbient)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, payload)); err != nil {
+		return oops.Code("AMBIENT_PUBLISH_FAILED").With("stream", stream).Wrap(err)
+	}
+	return nil
+}
+
+// checkAccess evaluates action on the location for subject. It fails
+// closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject, action string, locationID ulid.ULID) error {
+	resource := access.LocationResource(locationID.String())
+	req, err := types.NewAccessRequest(subject, action, resource, nil)
+	if err != nil {
+		return oops.Code("AMBIENT_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("AMBIENT_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "ambient access denied",
+			"event", "ambient_access_denied",
+			"subject", subject,
+			"action", action,
+			"location_id", locationID.String(),
+			"reason", decision.Reason(),
+		)
+		return oops.Code("AMBIENT_ACCESS_DENIED").
+			With("location_id", locationID.String()).
+			Errorf("not permitted to %s this location's ambience", action)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
@@ -0,0 +1,385 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ambient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu        sync.Mutex
+	schedules map[ulid.ULID]*Schedule
+	lines     map[ulid.ULID]*Line
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{schedules: map[ulid.ULID]*Schedule{}, lines: map[ulid.ULID]*Line{}}
+}
+
+func (m *memRepository) GetSchedule(_ context.Context, locationID ulid.ULID) (*Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sched, ok := m.schedules[locationID]
+	if !ok {
+		return nil, oops.Code("AMBIENT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	stored := *sched
+	return &stored, nil
+}
+
+func (m *memRepository) SaveSchedule(_ context.Context, sched *Schedule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *sched
+	m.schedules[sched.LocationID] = &stored
+	return nil
+}
+
+func (m *memRepository) DeleteSchedule(_ context.Context, locationID ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.schedules[locationID]; !ok {
+		return oops.Code("AMBIENT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	delete(m.schedules, locationID)
+	for id, l := range m.lines {
+		if l.LocationID == locationID {
+			delete(m.lines, id)
+		}
+	}
+	return nil
+}
+
+func (m *memRepository) AddLine(_ context.Context, l *Line) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.schedules[l.LocationID]; !ok {
+		return oops.Code("AMBIENT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	stored := *l
+	m.lines[l.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) ListLines(_ context.Context, locationID ulid.ULID) ([]*Line, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Line
+	for _, l := range m.lines {
+		if l.LocationID == locationID {
+			out = append(out, l)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].CreatedAt.Equal(out[j].CreatedAt) {
+			return out[i].CreatedAt.Before(out[j].CreatedAt)
+		}
+		return out[i].ID.Compare(out[j].ID) < 0
+	})
+	return out, nil
+}
+
+func (m *memRepository) DeleteLine(_ context.Context, locationID, id ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.lines[id]
+	if !ok || l.LocationID != locationID {
+		return oops.Code("AMBIENT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	delete(m.lines, id)
+	return nil
+}
+
+func (m *memRepository) ClaimDue(_ context.Context, now time.Time, lease time.Duration) ([]*Schedule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Schedule
+	for _, sched := range m.schedules {
+		if !sched.NextAt.After(now) {
+			sched.NextAt = now.Add(lease)
+			stored := *sched
+			out = append(out, &stored)
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) Reschedule(_ context.Context, locationID ulid.ULID, next time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if sched, ok := m.schedules[locationID]; ok {
+		sched.NextAt = next
+	}
+	return nil
+}
+
+func (m *memRepository) nextAt(locationID ulid.ULID) time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.schedules[locationID].NextAt
+}
+
+// fakeSessions reports the sessions present in each location.
+type fakeSessions struct {
+	present map[ulid.ULID]int
+	err     error
+}
+
+func (f *fakeSessions) ListActiveByLocation(_ context.Context, locationID ulid.ULID) ([]*session.Info, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make([]*session.Info, f.present[locationID])
+	for i := range out {
+		out[i] = &session.Info{LocationID: locationID}
+	}
+	return out, nil
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) events() []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventbus.Event(nil), f.published...)
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo     *memRepository
+	engine   *policytest.GrantEngine
+	sessions *fakeSessions
+	pub      *fakePublisher
+	logs     *bytes.Buffer
+	clock    time.Time
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	ts := &testService{
+		Service:  svc,
+		repo:     repo,
+		engine:   engine,
+		sessions: &fakeSessions{present: map[ulid.ULID]int{}},
+		pub:      &fakePublisher{},
+		logs:     &logs,
+		clock:    time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	svc.now = func() time.Time { return ts.clock }
+	svc.int63n = func(int64) int64 { return 0 }
+	svc.intn = func(int) int { return 0 }
+	svc.SetDelivery(ts.pub, mainGameID, ts.sessions)
+	return ts
+}
+
+// builder returns a subject granted read and write on locationID.
+func (ts *testService) builder(locationID ulid.ULID) string {
+	subject := access.CharacterSubject(idgen.New().String())
+	ts.engine.Grant(subject, ActionRead, access.LocationResource(locationID.String()))
+	ts.engine.Grant(subject, ActionWrite, access.LocationResource(locationID.String()))
+	return subject
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	_, err := NewService(nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceManagesScheduleAndLines(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	outsider := access.CharacterSubject(idgen.New().String())
+
+	_, err := ts.AddLine(ctx, outsider, locID, "Waves break on the rocks.", 1)
+	errutil.AssertErrorCode(t, err, "AMBIENT_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"ambient_access_denied"`)
+
+	subject := ts.builder(locID)
+	first, err := ts.AddLine(ctx, subject, locID, "Waves break on the rocks.", 3)
+	require.NoError(t, err)
+	ts.clock = ts.clock.Add(time.Second)
+	_, err = ts.AddLine(ctx, subject, locID, "A gull cries overhead.", 1)
+	require.NoError(t, err)
+	assert.Contains(t, ts.logs.String(), `"event":"ambient_line_added"`)
+
+	sched, err := ts.Schedule(ctx, subject, locID)
+	require.NoError(t, err, "the first line creates a default schedule")
+	assert.Equal(t, DefaultMinInterval, sched.MinInterval)
+	assert.Equal(t, DefaultMaxInterval, sched.MaxInterval)
+	assert.True(t, sched.ActiveHours.Always())
+
+	hours, err := ParseActiveHours("20:00-06:00")
+	require.NoError(t, err)
+	sched, err = ts.SetSchedule(ctx, subject, locID, time.Minute, 10*time.Minute, hours)
+	require.NoError(t, err)
+	assert.Equal(t, ts.clock.Add(time.Minute), sched.NextAt)
+	_, err = ts.SetSchedule(ctx, subject, locID, time.Second, time.Minute, ActiveHours{})
+	errutil.AssertErrorCode(t, err, "AMBIENT_INVALID")
+
+	lines, err := ts.Lines(ctx, subject, locID)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, first.ID, lines[0].ID)
+
+	require.NoError(t, ts.RemoveLine(ctx, subject, locID, first.ID))
+	errutil.AssertErrorCode(t, ts.RemoveLine(ctx, subject, locID, first.ID), "AMBIENT_NOT_FOUND")
+
+	require.NoError(t, ts.Clear(ctx, subject, locID))
+	_, err = ts.Schedule(ctx, subject, locID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	lines, err = ts.Lines(ctx, subject, locID)
+	require.NoError(t, err)
+	assert.Empty(t, lines, "clearing removes the lines too")
+}
+
+func TestServiceCapsLinesPerLocation(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+
+	for range MaxLinesPerLocation {
+		_, err := ts.AddLine(ctx, subject, locID, "line", 1)
+		require.NoError(t, err)
+	}
+	_, err := ts.AddLine(ctx, subject, locID, "one too many", 1)
+	errutil.AssertErrorCode(t, err, "AMBIENT_INVALID")
+}
+
+func TestTickSendsLineOnlyWhenOccupied(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+	line, err := ts.AddLine(ctx, subject, locID, "Waves break on the rocks.", 1)
+	require.NoError(t, err)
+	_, err = ts.SetSchedule(ctx, subject, locID, time.Minute, time.Minute, ActiveHours{})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Tick(ctx))
+	assert.Empty(t, ts.pub.events(), "nothing is due yet")
+
+	ts.clock = ts.clock.Add(time.Minute)
+	require.NoError(t, ts.Tick(ctx))
+	assert.Empty(t, ts.pub.events(), "nobody is present")
+	assert.Equal(t, ts.clock.Add(time.Minute), ts.repo.nextAt(locID), "a quiet firing still reschedules")
+
+	ts.sessions.present[locID] = 2
+	ts.clock = ts.clock.Add(time.Minute)
+	require.NoError(t, ts.Tick(ctx))
+	events := ts.pub.events()
+	require.Len(t, events, 1)
+	ev := events[0]
+	assert.Equal(t, eventbus.Subject("events.main.location."+locID.String()), ev.Subject)
+	assert.Equal(t, eventbus.Type(eventvocab.EventTypeAmbient), ev.Type)
+	assert.Equal(t, eventbus.ActorKindSystem, ev.Actor.Kind)
+	var payload eventvocab.AmbientPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, eventvocab.AmbientPayload{
+		LocationID: locID.String(),
+		LineID:     line.ID.String(),
+		Text:       "Waves break on the rocks.",
+		Priority:   eventvocab.AmbientPriorityLow,
+	}, payload)
+
+	require.NoError(t, ts.Tick(ctx))
+	assert.Len(t, ts.pub.events(), 1, "a schedule fires once per interval")
+}
+
+func TestTickRespectsActiveHours(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+	ts.sessions.present[locID] = 1
+	_, err := ts.AddLine(ctx, subject, locID, "Crickets chirp.", 1)
+	require.NoError(t, err)
+	night, err := ParseActiveHours("20:00-06:00")
+	require.NoError(t, err)
+	_, err = ts.SetSchedule(ctx, subject, locID, time.Minute, time.Minute, night)
+	require.NoError(t, err)
+
+	ts.clock = ts.clock.Add(time.Minute) // 12:01 UTC
+	require.NoError(t, ts.Tick(ctx))
+	assert.Empty(t, ts.pub.events(), "outside active hours")
+
+	ts.clock = time.Date(2026, 5, 1, 21, 0, 0, 0, time.UTC)
+	require.NoError(t, ts.Tick(ctx))
+	assert.Len(t, ts.pub.events(), 1)
+}
+
+func TestTickIsNoOpUntilDeliveryBound(t *testing.T) {
+	svc, err := NewService(newMemRepository(), policytest.AllowAllEngine(), nil)
+	require.NoError(t, err)
+	require.NoError(t, svc.Tick(context.Background()))
+}
+
+func TestTickReportsFailuresAndStillReschedules(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+	ts.sessions.present[locID] = 1
+	_, err := ts.AddLine(ctx, subject, locID, "Rain patters.", 1)
+	require.NoError(t, err)
+	_, err = ts.SetSchedule(ctx, subject, locID, time.Minute, time.Minute, ActiveHours{})
+	require.NoError(t, err)
+
+	ts.pub.err = errors.New("bus down")
+	ts.clock = ts.clock.Add(time.Minute)
+	errutil.AssertErrorCode(t, ts.Tick(ctx), "AMBIENT_PUBLISH_FAILED")
+	assert.Equal(t, ts.clock.Add(time.Minute), ts.repo.nextAt(locID))
+
+	ts.pub.err = nil
+	ts.sessions.err = errors.New("store down")
+	ts.clock = ts.clock.Add(time.Minute)
+	errutil.AssertErrorCode(t, ts.Tick(ctx), "AMBIENT_PRESENCE_FAILED")
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	jsoncanonicalizer "github.com/cyberphone/json-canonicalization/go/src/webpki.org/jsoncanonicalizer"
+	"github.com/samber/oops"
+)
+
+// chainedRow is the stored content of one access_audit_log row, as hashed
+// into its chain. Field values are the column values, so the verifier can
+// rebuild it from a SELECT. The timestamp is hashed as a string: JCS
+// serializes numbers as IEEE doubles, which cannot hold epoch nanoseconds.
+type chainedRow struct {
+	ID          string          `json:"id"`
+	TimestampNS int64           `json:"timestamp_ns,string"`
+	Subject     string          `json:"subject"`
+	Action      string          `json:"action"`
+	Resource    string          `json:"resource"`
+	Effect      string          `json:"effect"`
+	EventID     string          `json:"event_id"`
+	EventName   string          `json:"event_name"`
+	Message     string          `json:"message"`
+	Source      string          `json:"source"`
+	Component   string          `json:"component"`
+	Attributes  json.RawMessage `json:"attributes"`
+	DurationUS  int64           `json:"duration_us"`
+}
+
+// newChainedRow builds the row written for event under id.
+func newChainedRow(id string, event *Event) (chainedRow, error) {
+	attributesJSON, err := json.Marshal(event.Attributes)
+	if err != nil {
+		return chainedRow{}, oops.Wrap(err)
+	}
+	return chainedRow{
+		ID:          id,
+		TimestampNS: event.Timestamp.UnixNano(), // pgnanos-exempt: SQL-cast boundary for BIGINT timestamp column
+		Subject:     event.Subject,
+		Action:      event.Action,
+		Resource:    event.Resource,
+		Effect:      event.Effect.String(),
+		EventID:     event.ID,
+		EventName:   event.Name,
+		Message:     event.Message,
+		Source:      string(event.Source),
+		Component:   event.Component,
+		Attributes:  attributesJSON,
+		DurationUS:  event.DurationUS,
+	}, nil
+}
+
+// chainLink is a row's position in its partition's chain.
+type chainLink struct {
+	Partition string `json:"partition"`
+	Seq       int64  `json:"seq"`
+	PrevHash  []byte `json:"prev_hash"`
+}
+
+// entryHash returns SHA-256 over the RFC 8785 canonical JSON of row and its
+// link. Canonicalization makes the hash independent of how JSONB re-encodes
+// the attributes on the way back out. An empty PrevHash (the first row of a
+// partition) is hashed as null.
+func entryHash(link chainLink, row chainedRow) ([]byte, error) {
+	if len(link.PrevHash) == 0 {
+		link.PrevHash = nil
+	}
+	raw, err := json.Marshal(struct {
+		chainLink
+		Row chainedRow `json:"row"`
+	}{link, row})
+	if err != nil {
+		return nil, oops.Code("AUDIT_CHAIN_HASH_FAILED").Wrap(err)
+	}
+	canonical, err := jsoncanonicalizer.Transform(raw)
+	if err != nil {
+		return nil, oops.Code("AUDIT_CHAIN_HASH_FAILED").Wrap(err)
+	}
+	sum := sha256.Sum256(canonical)
+	return sum[:], nil
+}
+
+// chainPartition names the chain an entry timestamped t joins: its monthly
+// access_audit_log partition.
+func chainPartition(t time.Time) string {
+	name, _, _ := partitionRange(t.UTC())
+	return name
+}
+
+// chainHead is the newest link of one partition's chain.
+type chainHead struct {
+	seq   int64
+	hash  []byte
+	dirty bool
+}
+
+// chainAppender appends rows to access_audit_log inside one transaction,
+// holding the row lock on each partition's access_audit_chain_heads entry
+// until the transaction ends so concurrent writers, on this replica or
+// another, extend the chain one at a time.
+type chainAppender struct {
+	tx    *sql.Tx
+	heads map[string]*chainHead
+}
+
+func newChainAppender(tx *sql.Tx) *chainAppender {
+	return &chainAppender{tx: tx, heads: make(map[string]*chainHead)}
+}
+
+// lock takes the head locks for partitions in name order, so two writers
+// spanning the same months cannot deadlock.
+func (a *chainAppender) lock(ctx context.Context, partitions ...string) error {
+	slices.Sort(partitions)
+	for _, partition := range slices.Compact(partitions) {
+		if _, ok := a.heads[partition]; ok {
+			continue
+		}
+		if _, err := a.tx.ExecContext(ctx,
+			`INSERT INTO access_audit_chain_heads (partition_name) VALUES ($1) ON CONFLICT DO NOTHING`,
+			partition); err != nil {
+			return oops.Code("AUDIT_CHAIN_LOCK_FAILED").With("partition", partition).Wrap(err)
+		}
+		head := &chainHead{}
+		if err := a.tx.QueryRowContext(ctx,
+			`SELECT seq, entry_hash FROM access_audit_chain_heads WHERE partition_name = $1 FOR UPDATE`,
+			partition).Scan(&head.seq, &head.hash); err != nil {
+			return oops.Code("AUDIT_CHAIN_LOCK_FAILED").With("partition", partition).Wrap(err)
+		}
+		a.heads[partition] = head
+	}
+	return nil
+}
+
+// append writes row as the next link of its partition's chain. inserted is
+// false, and the chain is left as it was, when a row with the same
+// (id, timestamp) already exists.
+func (a *chainAppender) append(ctx context.Context, row chainedRow) (bool, error) {
+	partition := chainPartition(time.Unix(0, row.TimestampNS))
+	if err := a.lock(ctx, partition); err != nil {
+		return false, err
+	}
+	head := a.heads[partition]
+
+	link := chainLink{Partition: partition, Seq: head.seq + 1, PrevHash: head.hash}
+	hash, err := entryHash(link, row)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := a.tx.ExecContext(ctx, insertAuditQuery,
+		row.ID, row.Subject, row.Action, row.Resource, row.Effect, row.EventID, row.EventName,
+		row.Message, row.Source, row.Component, []byte(row.Attributes), row.DurationUS, row.TimestampNS,
+		link.Seq, link.PrevHash, hash,
+	)
+	if err != nil {
+		return false, oops.With("subject", row.Subject).
+			With("action", row.Action).
+			With("resource", row.Resource).
+			Wrap(err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, oops.Wrap(err)
+	}
+	if n == 0 {
+		return false, nil
+	}
+	head.seq, head.hash, head.dirty = link.Seq, hash, true
+	return true, nil
+}
+
+// flush records the heads that moved. Call it last, before Commit.
+func (a *chainAppender) flush(ctx context.Context) error {
+	for partition, head := range a.heads {
+		if !head.dirty {
+			continue
+		}
+		if _, err := a.tx.ExecContext(ctx,
+			`UPDATE access_audit_chain_heads SET seq = $2, entry_hash = $3 WHERE partition_name = $1`,
+			partition, head.seq, head.hash); err != nil {
+			return oops.Code("AUDIT_CHAIN_HEAD_UPDATE_FAILED").With("partition", partition).Wrap(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+const testChainPartition = "access_audit_log_2026_03"
+
+func testChainRow(t *testing.T, id string) chainedRow {
+	t.Helper()
+	row, err := newChainedRow(id, &Event{
+		ID:         "seed-staff-teleport",
+		Name:       "staff teleport",
+		Source:     SourceEngine,
+		Component:  "abac",
+		Subject:    "character:01ABC",
+		Action:     "teleport",
+		Resource:   "character:01XYZ",
+		Effect:     types.EffectAllow,
+		Attributes: map[string]any{"role": "admin", "reason": "stuck"},
+		DurationUS: 150,
+		Timestamp:  time.Date(2026, 3, 14, 12, 0, 0, 123, time.UTC),
+	})
+	require.NoError(t, err)
+	return row
+}
+
+// buildChain links rows the way chainAppender does.
+func buildChain(t *testing.T, rows ...chainedRow) []storedLink {
+	t.Helper()
+	links := make([]storedLink, 0, len(rows))
+	var prev []byte
+	for i, row := range rows {
+		seq := int64(i + 1)
+		hash, err := entryHash(chainLink{Partition: testChainPartition, Seq: seq, PrevHash: prev}, row)
+		require.NoError(t, err)
+		links = append(links, storedLink{row: row, seq: seq, prevHash: prev, entryHash: hash})
+		prev = hash
+	}
+	return links
+}
+
+func checkLinks(t *testing.T, links []storedLink, head *chainHead) *ChainReport {
+	t.Helper()
+	checker := newChainChecker(testChainPartition)
+	for _, l := range links {
+		require.NoError(t, checker.add(l))
+	}
+	return checker.finish(head)
+}
+
+func headOf(links []storedLink) *chainHead {
+	last := links[len(links)-1]
+	return &chainHead{seq: last.seq, hash: last.entryHash}
+}
+
+func problemKinds(r *ChainReport) []ChainProblemKind {
+	kinds := make([]ChainProblemKind, 0, len(r.Problems))
+	for _, p := range r.Problems {
+		kinds = append(kinds, p.Kind)
+	}
+	return kinds
+}
+
+func TestEntryHash(t *testing.T) {
+	row := testChainRow(t, "01AAA")
+	link := chainLink{Partition: testChainPartition, Seq: 1}
+	base, err := entryHash(link, row)
+	require.NoError(t, err)
+	assert.Len(t, base, 32)
+
+	t.Run("is stable across attribute key order", func(t *testing.T) {
+		reordered := row
+		reordered.Attributes = json.RawMessage(`{ "reason": "stuck", "role": "admin" }`)
+		got, err := entryHash(link, reordered)
+		require.NoError(t, err)
+		assert.Equal(t, base, got)
+	})
+
+	t.Run("treats an empty prev hash as none", func(t *testing.T) {
+		got, err := entryHash(chainLink{Partition: testChainPartition, Seq: 1, PrevHash: []byte{}}, row)
+		require.NoError(t, err)
+		assert.Equal(t, base, got)
+	})
+
+	changes := map[string]func(*chainLink, *chainedRow){
+		"subject":   func(_ *chainLink, r *chainedRow) { r.Subject = "character:01DEF" },
+		"effect":    func(_ *chainLink, r *chainedRow) { r.Effect = "deny" },
+		"timestamp": func(_ *chainLink, r *chainedRow) { r.TimestampNS++ },
+		"attribute": func(_ *chainLink, r *chainedRow) {
+			r.Attributes = json.RawMessage(`{"role":"player","reason":"stuck"}`)
+		},
+		"seq":       func(l *chainLink, _ *chainedRow) { l.Seq = 2 },
+		"partition": func(l *chainLink, _ *chainedRow) { l.Partition = "access_audit_log_2026_04" },
+		"prev hash": func(l *chainLink, _ *chainedRow) { l.PrevHash = base },
+	}
+	for name, change := range changes {
+		t.Run("changes with "+name, func(t *testing.T) {
+			l, r := link, row
+			change(&l, &r)
+			got, err := entryHash(l, r)
+			require.NoError(t, err)
+			assert.NotEqual(t, base, got)
+		})
+	}
+}
+
+func TestParsePartitionName(t *testing.T) {
+	start, end, err := parsePartitionName(testChainPartition)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), end)
+	assert.Equal(t, testChainPartition, chainPartition(start.Add(time.Hour)))
+
+	for _, bad := range []string{"", "events_audit_2026_03", "access_audit_log_2026_13", "access_audit_log_2026_3", "access_audit_log_2026_03; drop"} {
+		_, _, err := parsePartitionName(bad)
+		errutil.AssertErrorCode(t, err, "AUDIT_CHAIN_PARTITION_INVALID")
+	}
+}
+
+func TestChainChecker(t *testing.T) {
+	rows := []chainedRow{testChainRow(t, "01AAA"), testChainRow(t, "01BBB"), testChainRow(t, "01CCC"), testChainRow(t, "01DDD")}
+
+	t.Run("an untouched chain is intact", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		report := checkLinks(t, links, headOf(links))
+		assert.True(t, report.Intact(), "problems: %v", report.Problems)
+		assert.Equal(t, int64(4), report.Entries)
+		assert.Equal(t, int64(4), report.HeadSeq)
+		assert.Equal(t, links[3].entryHash, report.HeadHash)
+	})
+
+	t.Run("an empty partition without a head is intact", func(t *testing.T) {
+		assert.True(t, checkLinks(t, nil, nil).Intact())
+	})
+
+	t.Run("an edited row is a single hash mismatch", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		head := headOf(links)
+		links[1].row.Effect = "deny"
+		report := checkLinks(t, links, head)
+		assert.Equal(t, []ChainProblemKind{ChainHashMismatch}, problemKinds(report))
+		assert.Equal(t, "01BBB", report.Problems[0].RowID)
+	})
+
+	t.Run("a deleted middle row is a gap", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		head := headOf(links)
+		links = append(links[:1], links[2:]...)
+		report := checkLinks(t, links, head)
+		assert.Equal(t, []ChainProblemKind{ChainGap}, problemKinds(report))
+		assert.Equal(t, int64(2), report.Problems[0].Seq)
+	})
+
+	t.Run("a deleted tail is truncation", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		head := headOf(links)
+		report := checkLinks(t, links[:2], head)
+		assert.Equal(t, []ChainProblemKind{ChainTruncated}, problemKinds(report))
+	})
+
+	t.Run("a dropped partition is truncation", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		report := checkLinks(t, nil, headOf(links))
+		assert.Equal(t, []ChainProblemKind{ChainTruncated}, problemKinds(report))
+	})
+
+	t.Run("a deleted tail with a rewound head is a head mismatch", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		report := checkLinks(t, links[:3], &chainHead{seq: 3, hash: links[2].prevHash})
+		assert.Equal(t, []ChainProblemKind{ChainHeadMismatch}, problemKinds(report))
+	})
+
+	t.Run("a repeated sequence number is a duplicate", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		head := headOf(links)
+		links = append(links[:2], append([]storedLink{links[1]}, links[2:]...)...)
+		report := checkLinks(t, links, head)
+		assert.Contains(t, problemKinds(report), ChainDuplicate)
+	})
+
+	t.Run("a relinked row is a broken link", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		head := headOf(links)
+		// Replace row 3 with a forged row carrying a valid hash over a wrong
+		// prev_hash, as a writer recomputing only one row would produce.
+		forged := links[2]
+		forged.prevHash = links[0].entryHash
+		hash, err := entryHash(chainLink{Partition: testChainPartition, Seq: 3, PrevHash: forged.prevHash}, forged.row)
+		require.NoError(t, err)
+		forged.entryHash = hash
+		links[2] = forged
+		report := checkLinks(t, links, head)
+		assert.Equal(t, []ChainProblemKind{ChainBrokenLink, ChainBrokenLink}, problemKinds(report))
+	})
+
+	t.Run("chained rows without a head are a head mismatch", func(t *testing.T) {
+		links := buildChain(t, rows...)
+		report := checkLinks(t, links, nil)
+		assert.Equal(t, []ChainProblemKind{ChainHeadMismatch}, problemKinds(report))
+	})
+}
@@ -9,6 +9,10 @@
 // decisions with sync/async writes and WAL (Write-Ahead Log) fallback for
 // resilience. It supports three logging modes and provides PostgreSQL storage.
 //
+// Authentication events (logins, logouts, session expiries, password and
+// 2FA changes) flow through the same Logger with Source SourceAuth, so one
+// table answers both "who was denied what" and "who signed in from where".
+//
 // # Audit Modes
 //
 //   - ModeMinimal: Logs denials and default denials only (sync)
@@ -28,7 +32,22 @@
 //
 // When sync writes fail, events are written to a WAL file at
 // $XDG_STATE_HOME/holomush/audit-wal.jsonl. The ReplayWAL method can be
-// used to recover events after outages.
+// used to recover events after outages. Every sync-path event carries an
+// IdempotencyKey stamped before the first write attempt, and replay upserts
+// on it, so an entry that reached the database before the outage is skipped
+// rather than written twice. ReplayWAL returns a ReplayReport counting
+// recovered, skipped-duplicate, and failed entries.
+//
+// # Tamper Evidence
+//
+// PostgresWriter hash-chains access_audit_log rows per monthly partition:
+// each row stores its sequence number, the previous row's hash, and a
+// SHA-256 over its own canonical (RFC 8785) content and position. The
+// newest link of each chain is kept in access_audit_chain_heads and moved
+// under its row lock, so writers on every replica extend a chain one at a
+// time. ChainVerifier recomputes the chains and reports gaps, duplicates,
+// broken links, edited rows, and deleted tails; `holomush audit verify`
+// runs it. Rows written before chaining existed are counted as unchained.
 //
 // # Metrics
 //
@@ -60,7 +79,8 @@
 //	logger.Log(ctx, event)
 //
 //	// Replay WAL after recovery
-//	if err := logger.ReplayWAL(ctx); err != nil {
-//		slog.Error("WAL replay failed", "error", err)
+//	report, err := logger.ReplayWAL(ctx)
+//	if err != nil {
+//		slog.Error("WAL replay failed", "error", err, "failed", report.Failed)
 //	}
 package audit
@@ -12,9 +12,12 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/idgen"
 	"github.com/holomush/holomush/internal/xdg"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -31,6 +34,18 @@ const (
 	ModeAll         Mode = "all"          // everything
 )
 
+// ParseMode validates s as an audit logging mode.
+func ParseMode(s string) (Mode, error) {
+	switch mode := Mode(s); mode {
+	case ModeMinimal, ModeDenialsOnly, ModeAll:
+		return mode, nil
+	default:
+		return "", oops.Code("AUDIT_INVALID_MODE").
+			With("mode", s).
+			Errorf("audit mode must be one of %q, %q, or %q", ModeMinimal, ModeDenialsOnly, ModeAll)
+	}
+}
+
 // Event represents a single access control decision to be logged.
 type Event struct {
 	// Identity of the decision
@@ -50,6 +65,40 @@ type Event struct {
 	Attributes map[string]any `json:"attributes"`
 	DurationUS int64          `json:"duration_us"`
 	Timestamp  time.Time      `json:"timestamp"`
+
+	// IdempotencyKey identifies this entry across write attempts. Logger
+	// stamps it before the first sync write so an entry that reached the
+	// database but also fell back to the WAL (e.g. a commit whose ack was
+	// lost) is recognized as a duplicate on ReplayWAL. Writers use it as the
+	// row id when set.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
+	// AlwaysLog writes the event synchronously in every mode, like a system
+	// bypass. The engine sets it on allows that skipped policy evaluation
+	// (fail-open reads during a policy store outage).
+	AlwaysLog bool `json:"-"`
+}
+
+// AttributeImpersonator is the Attributes key holding the staff player
+// acting through an impersonation session. Logger.Log stamps it from
+// core.ImpersonatorFromContext, so an entry written under such a session
+// names both the impersonated subject and the staff member.
+const AttributeImpersonator = "impersonator_id"
+
+// stampImpersonator copies the impersonating player carried on ctx into the
+// event's attributes. The caller's attribute map is never mutated.
+func stampImpersonator(ctx context.Context, event Event) Event {
+	impersonator, ok := core.ImpersonatorFromContext(ctx)
+	if !ok {
+		return event
+	}
+	attrs := make(map[string]any, len(event.Attributes)+1)
+	for k, v := range event.Attributes {
+		attrs[k] = v
+	}
+	attrs[AttributeImpersonator] = impersonator
+	event.Attributes = attrs
+	return event
 }
 
 // Writer is the interface for writing audit events to a backend.
@@ -59,6 +108,26 @@ type Writer interface {
 	Close() error
 }
 
+// DedupWriter is implemented by writers that can insert an event with upsert
+// semantics keyed on Event.IdempotencyKey. inserted is false when a row with
+// the same key already exists. ReplayWAL prefers it over WriteSync so
+// entries that partially succeeded before an outage are not written twice.
+type DedupWriter interface {
+	WriteDedup(ctx context.Context, event Event) (inserted bool, err error)
+}
+
+// ReplayReport summarizes a ReplayWAL run.
+type ReplayReport struct {
+	Recovered        int // entries written to the backend
+	SkippedDuplicate int // entries already present in the backend or repeated in the WAL
+	Failed           int // entries kept in the WAL for the next replay
+}
+
+// Total returns the number of WAL entries the replay examined.
+func (r ReplayReport) Total() int {
+	return r.Recovered + r.SkippedDuplicate + r.Failed
+}
+
 var (
 	channelFullCounter = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "abac_audit_channel_full_total",
@@ -93,7 +162,7 @@ func RecordEngineAuditFailure() {
 
 // Logger routes audit events based on mode and effect.
 type Logger struct {
-	mode      Mode
+	mode      atomic.Pointer[Mode]
 	writer    Writer
 	walPath   string
 	walFile   *os.File
@@ -120,12 +189,12 @@ func NewLogger(mode Mode, writer Writer, walPath string) *Logger {
 	}
 
 	logger := &Logger{
-		mode:      mode,
 		writer:    writer,
 		walPath:   walPath,
 		asyncChan: make(chan Event, 1000), // buffered channel
 		stopChan:  make(chan struct{}),
 	}
+	logger.mode.Store(&mode)
 
 	// Start async consumer goroutine
 	logger.wg.Add(1)
@@ -134,6 +203,17 @@ func NewLogger(mode Mode, writer Writer, walPath string) *Logger {
 	return logger
 }
 
+// Mode returns the logger's current mode.
+func (l *Logger) Mode() Mode {
+	return *l.mode.Load()
+}
+
+// SetMode switches the logger's mode. Events already queued for async write
+// are still written; the new mode applies from the next Log call.
+func (l *Logger) SetMode(mode Mode) {
+	l.mode.Store(&mode)
+}
+
 // Log routes an audit event based on the configured mode and effect.
 // Returns AUDIT_LOGGER_CLOSED if Close() has already started shutdown —
 // callers must not rely on a stale Logger after Close() returns.
@@ -151,14 +231,23 @@ func (l *Logger) Log(ctx context.Context, event Event) error {
 			Errorf("audit logger is closed")
 	default:
 	}
+	event = stampImpersonator(ctx, event)
 
 	// Determine if event should be logged based on mode and effect
 	shouldLog, useSync := l.shouldLog(event.Effect)
+	if event.AlwaysLog {
+		shouldLog, useSync = true, true
+	}
 	if !shouldLog {
 		return nil
 	}
 
 	if useSync {
+		// Stamp the idempotency key before the first attempt so the DB row
+		// and any WAL fallback copy share it.
+		if event.IdempotencyKey == "" {
+			event.IdempotencyKey = idgen.New().String()
+		}
 		// Synchronous write for denials, default_deny, system_bypass
 		if err := l.writer.WriteSync(ctx, event); err != nil {
 			// Fallback to WAL
@@ -235,7 +324,7 @@ func (l *Logger) Log(ctx context.Context, event Event) error {
 // shouldLog determines if an event should be logged based on mode and effect.
 // Returns (shouldLog bool, useSync bool).
 func (l *Logger) shouldLog(effect types.Effect) (shouldLog, useSync bool) {
-	switch l.mode {
+	switch l.Mode() {
 	case ModeMinimal:
 		// Log: deny, default_deny, system_bypass (elevated privilege always traceable)
 		switch effect {
@@ -360,6 +449,7 @@ type PartialReplayError struct {
 	FailedCount   int
 	TotalCount    int
 	ReplayedCount int
+	SkippedCount  int
 }
 
 func (e *PartialReplayError) Error() string {
@@ -367,30 +457,38 @@ func (e *PartialReplayError) Error() string {
 }
 
 // ReplayWAL reads all entries from the WAL and writes them to the writer.
-// On full success, truncates the WAL file. On partial failure, rewrites the
-// WAL with only the entries that failed so they can be retried next time.
-// A *PartialReplayError return means the WAL was safely rewritten and retry is safe.
-func (l *Logger) ReplayWAL(ctx context.Context) error {
+// Entries are deduplicated by IdempotencyKey: a key repeated within the WAL,
+// or one the writer (via DedupWriter) reports as already stored, is skipped
+// rather than written twice. On full success, truncates the WAL file. On
+// partial failure, rewrites the WAL with only the entries that failed so they
+// can be retried next time. The returned report is populated in both cases;
+// a *PartialReplayError return means the WAL was safely rewritten and retry
+// is safe.
+func (l *Logger) ReplayWAL(ctx context.Context) (ReplayReport, error) {
 	l.walMu.Lock()
 	defer l.walMu.Unlock()
 
+	var report ReplayReport
+
 	// Check if WAL exists
 	if _, err := os.Stat(l.walPath); os.IsNotExist(err) {
-		return nil // No WAL to replay
+		return report, nil // No WAL to replay
 	}
 
 	// Read WAL file
 	data, err := os.ReadFile(l.walPath)
 	if err != nil {
-		return oops.With("path", l.walPath).Wrap(err)
+		return report, oops.With("path", l.walPath).Wrap(err)
 	}
 
 	if len(data) == 0 {
-		return nil // Empty WAL
+		return report, nil // Empty WAL
 	}
 
+	dedup, _ := l.writer.(DedupWriter)
+	seen := make(map[string]struct{})
+
 	// Parse and replay events; collect failed events for WAL rewrite.
-	replayed := 0
 	var failedLines []string
 	for _, line := range splitLines(string(data)) {
 		if line == "" {
@@ -406,7 +504,16 @@ func (l *Logger) ReplayWAL(ctx context.Context) error {
 			continue
 		}
 
-		if err := l.writer.WriteSync(ctx, event); err != nil {
+		if event.IdempotencyKey != "" {
+			if _, dup := seen[event.IdempotencyKey]; dup {
+				report.SkippedDuplicate++
+				continue
+			}
+			seen[event.IdempotencyKey] = struct{}{}
+		}
+
+		inserted, err := l.replayEvent(ctx, dedup, event)
+		if err != nil {
 			slog.ErrorContext(ctx, "failed to replay WAL event", "error", err, "event", event)
 			failuresCounter.WithLabelValues("wal_replay_failed").Inc()
 			// Re-marshal so the event is preserved in the WAL for retry.
@@ -418,8 +525,13 @@ func (l *Logger) ReplayWAL(ctx context.Context) error {
 			}
 			continue
 		}
-		replayed++
+		if !inserted {
+			report.SkippedDuplicate++
+			continue
+		}
+		report.Recovered++
 	}
+	report.Failed = len(failedLines)
 
 	if len(failedLines) > 0 {
 		// Rewrite WAL atomically with only the failed entries.
@@ -429,25 +541,52 @@ func (l *Logger) ReplayWAL(ctx context.Context) error {
 			buf = append(buf, []byte(fl+"\n")...)
 		}
 		if werr := os.WriteFile(tmpPath, buf, 0o600); werr != nil {
-			return oops.With("path", tmpPath).Wrap(werr)
+			return report, oops.With("path", tmpPath).Wrap(werr)
 		}
 		if rerr := os.Rename(tmpPath, l.walPath); rerr != nil {
-			return oops.With("path", l.walPath).Wrap(rerr)
+			return report, oops.With("path", l.walPath).Wrap(rerr)
 		}
 		walEntriesGauge.Set(float64(len(failedLines)))
 		slog.WarnContext(ctx, "partially replayed WAL entries; WAL rewritten with failed entries",
-			"replayed", replayed, "failed", len(failedLines))
-		return &PartialReplayError{FailedCount: len(failedLines), TotalCount: replayed + len(failedLines), ReplayedCount: replayed}
+			"recovered", report.Recovered,
+			"skipped_duplicate", report.SkippedDuplicate,
+			"failed", report.Failed)
+		return report, &PartialReplayError{
+			FailedCount:   report.Failed,
+			TotalCount:    report.Total(),
+			ReplayedCount: report.Recovered,
+			SkippedCount:  report.SkippedDuplicate,
+		}
 	}
 
 	// All entries replayed — truncate the WAL.
 	if err := os.Truncate(l.walPath, 0); err != nil {
-		return oops.With("path", l.walPath).Wrap(err)
+		return report, oops.With("path", l.walPath).Wrap(err)
 	}
 
 	walEntriesGauge.Set(0)
-	slog.InfoContext(ctx, "replayed WAL entries", "count", replayed)
-	return nil
+	slog.InfoContext(ctx, "replayed WAL entries",
+		"recovered", report.Recovered,
+		"skipped_duplicate", report.SkippedDuplicate,
+		"failed", report.Failed)
+	return report, nil
+}
+
+// replayEvent writes one WAL entry, using upsert semantics when the writer
+// supports them. Writers without DedupWriter report every success as an
+// insert.
+func (l *Logger) replayEvent(ctx context.Context, dedup DedupWriter, event Event) (bool, error) {
+	if dedup != nil && event.IdempotencyKey != "" {
+		inserted, err := dedup.WriteDedup(ctx, event)
+		if err != nil {
+			return false, oops.With("idempotency_key", event.IdempotencyKey).Wrap(err)
+		}
+		return inserted, nil
+	}
+	if err := l.writer.WriteSync(ctx, event); err != nil {
+		return false, oops.Wrap(err)
+	}
+	return true, nil
 }
 
 // Close gracefully shuts down the logger.
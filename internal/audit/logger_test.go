@@ -18,6 +18,7 @@ import (
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 
 	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/pkg/errutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -183,6 +184,31 @@ func TestAuditLoggerDenialsOnlyModeAllowNotLogged(t *testing.T) {
 	assert.Empty(t, writer.getAsyncWrites())
 }
 
+func TestAuditLoggerMinimalModeAlwaysLogAllowLoggedSync(t *testing.T) {
+	writer := &mockWriter{}
+	logger := NewLogger(ModeMinimal, writer, "")
+	defer logger.Close()
+
+	entry := Event{
+		Subject:    "character:01ABC",
+		Action:     "read",
+		Resource:   "location:01XYZ",
+		Effect:     types.EffectAllow,
+		ID:         "infra:fail-open",
+		Attributes: map[string]any{},
+		Timestamp:  time.Now(),
+		AlwaysLog:  true,
+	}
+
+	require.NoError(t, logger.Log(context.Background(), entry))
+
+	// Allows that skipped evaluation are logged like system bypasses
+	syncWrites := writer.getSyncWrites()
+	require.Len(t, syncWrites, 1)
+	assert.Equal(t, "infra:fail-open", syncWrites[0].ID)
+	assert.Empty(t, writer.getAsyncWrites())
+}
+
 func TestAuditLoggerDenialsOnlyModeDenyLoggedSync(t *testing.T) {
 	writer := &mockWriter{}
 	logger := NewLogger(ModeDenialsOnly, writer, "")
@@ -332,7 +358,7 @@ func TestAuditLoggerReplayWAL(t *testing.T) {
 	logger2 := NewLogger(ModeMinimal, writer2, walPath)
 	defer logger2.Close()
 
-	err := logger2.ReplayWAL(context.Background())
+	_, err := logger2.ReplayWAL(context.Background())
 	require.NoError(t, err)
 
 	syncWrites := writer2.getSyncWrites()
@@ -536,6 +562,31 @@ func TestAuditLoggerEventContainsAllFields(t *testing.T) {
 	assert.Equal(t, "builder", logged.Attributes["role"])
 }
 
+func TestAuditLoggerStampsImpersonatorFromContext(t *testing.T) {
+	writer := &mockWriter{}
+	logger := NewLogger(ModeMinimal, writer, "")
+	defer logger.Close()
+
+	attrs := map[string]any{"role": "player"}
+	ctx := core.WithImpersonator(context.Background(), "01STAFF0000000000000000000")
+	err := logger.Log(ctx, Event{
+		Subject:    "player:01TARGET",
+		Action:     "write",
+		Resource:   "object:01DEF",
+		Effect:     types.EffectDeny,
+		Attributes: attrs,
+		Timestamp:  time.Now(),
+	})
+	require.NoError(t, err)
+
+	syncWrites := writer.getSyncWrites()
+	require.Len(t, syncWrites, 1)
+	assert.Equal(t, "player:01TARGET", syncWrites[0].Subject)
+	assert.Equal(t, "01STAFF0000000000000000000", syncWrites[0].Attributes[AttributeImpersonator])
+	assert.Equal(t, "player", syncWrites[0].Attributes["role"])
+	assert.NotContains(t, attrs, AttributeImpersonator, "caller's attribute map must not be mutated")
+}
+
 // selectiveFailWriter fails WriteSync for specific event IDs.
 type selectiveFailWriter struct {
 	mu           sync.Mutex
@@ -585,7 +636,7 @@ func TestAuditLoggerReplayWALPartialFailure(t *testing.T) {
 	logger2 := NewLogger(ModeMinimal, writer2, walPath)
 	defer logger2.Close()
 
-	err := logger2.ReplayWAL(context.Background())
+	_, err := logger2.ReplayWAL(context.Background())
 	require.Error(t, err)
 
 	var partialErr *PartialReplayError
@@ -632,7 +683,7 @@ func TestAuditLoggerReplayWALAllFail(t *testing.T) {
 	logger2 := NewLogger(ModeMinimal, writer2, walPath)
 	defer logger2.Close()
 
-	err := logger2.ReplayWAL(context.Background())
+	_, err := logger2.ReplayWAL(context.Background())
 	require.Error(t, err)
 
 	var partialErr *PartialReplayError
@@ -692,3 +743,162 @@ func TestEventHasSourceComponentMessageFields(t *testing.T) {
 	assert.Equal(t, SourcePlugin, event.Source)
 	assert.Equal(t, "core-channels", event.Component)
 }
+
+// dedupWriter is a mockWriter that also implements DedupWriter, treating
+// keys in stored as already persisted.
+type dedupWriter struct {
+	mockWriter
+	stored map[string]bool
+}
+
+func (d *dedupWriter) WriteDedup(_ context.Context, event Event) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stored[event.IdempotencyKey] {
+		return false, nil
+	}
+	d.stored[event.IdempotencyKey] = true
+	d.syncWrites = append(d.syncWrites, event)
+	return true, nil
+}
+
+func writeWAL(t *testing.T, walPath string, events ...Event) {
+	t.Helper()
+	var buf []byte
+	for _, e := range events {
+		raw, err := json.Marshal(e)
+		require.NoError(t, err)
+		buf = append(buf, raw...)
+		buf = append(buf, '\n')
+	}
+	require.NoError(t, os.WriteFile(walPath, buf, 0o600))
+}
+
+func TestAuditLoggerLogStampsIdempotencyKeyOnWALFallback(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), "audit-wal.jsonl")
+	logger := NewLogger(ModeMinimal, &mockWriter{failSync: true}, walPath)
+
+	require.NoError(t, logger.Log(context.Background(), Event{
+		Subject: "character:01ABC", Action: "read", Resource: "loc:1",
+		Effect: types.EffectDeny, Timestamp: time.Now(),
+	}))
+	require.NoError(t, logger.Close())
+
+	data, err := os.ReadFile(walPath)
+	require.NoError(t, err)
+	var walEvent Event
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(string(data))), &walEvent))
+	assert.NotEmpty(t, walEvent.IdempotencyKey)
+}
+
+func TestAuditLoggerLogPreservesCallerIdempotencyKey(t *testing.T) {
+	writer := &mockWriter{}
+	logger := NewLogger(ModeMinimal, writer, filepath.Join(t.TempDir(), "audit-wal.jsonl"))
+	defer logger.Close()
+
+	require.NoError(t, logger.Log(context.Background(), Event{
+		Subject: "character:01ABC", Action: "read", Resource: "loc:1",
+		Effect: types.EffectDeny, Timestamp: time.Now(), IdempotencyKey: "caller-key",
+	}))
+
+	writes := writer.getSyncWrites()
+	require.Len(t, writes, 1)
+	assert.Equal(t, "caller-key", writes[0].IdempotencyKey)
+}
+
+func TestAuditLoggerReplayWALSkipsEntriesAlreadyStored(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), "audit-wal.jsonl")
+	ts := time.Now()
+	writeWAL(t, walPath,
+		Event{ID: "p-1", Effect: types.EffectDeny, Timestamp: ts, IdempotencyKey: "k-1"},
+		Event{ID: "p-2", Effect: types.EffectDeny, Timestamp: ts, IdempotencyKey: "k-2"},
+	)
+
+	writer := &dedupWriter{stored: map[string]bool{"k-1": true}}
+	logger := NewLogger(ModeMinimal, writer, walPath)
+	defer logger.Close()
+
+	report, err := logger.ReplayWAL(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ReplayReport{Recovered: 1, SkippedDuplicate: 1}, report)
+
+	writes := writer.getSyncWrites()
+	require.Len(t, writes, 1)
+	assert.Equal(t, "p-2", writes[0].ID)
+}
+
+func TestAuditLoggerReplayWALSkipsKeysRepeatedWithinWAL(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), "audit-wal.jsonl")
+	entry := Event{ID: "p-1", Effect: types.EffectDeny, Timestamp: time.Now(), IdempotencyKey: "k-1"}
+	writeWAL(t, walPath, entry, entry)
+
+	writer := &mockWriter{}
+	logger := NewLogger(ModeMinimal, writer, walPath)
+	defer logger.Close()
+
+	report, err := logger.ReplayWAL(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, ReplayReport{Recovered: 1, SkippedDuplicate: 1}, report)
+	assert.Len(t, writer.getSyncWrites(), 1)
+}
+
+func TestAuditLoggerReplayWALReportsFailuresInPartialReport(t *testing.T) {
+	walPath := filepath.Join(t.TempDir(), "audit-wal.jsonl")
+	ts := time.Now()
+	writeWAL(t, walPath,
+		Event{ID: "policy-ok", Effect: types.EffectDeny, Timestamp: ts, IdempotencyKey: "k-1"},
+		Event{ID: "policy-fail", Effect: types.EffectDeny, Timestamp: ts, IdempotencyKey: "k-2"},
+	)
+
+	writer := &selectiveFailWriter{failEventIDs: map[string]bool{"policy-fail": true}}
+	logger := NewLogger(ModeMinimal, writer, walPath)
+	defer logger.Close()
+
+	report, err := logger.ReplayWAL(context.Background())
+	var partialErr *PartialReplayError
+	require.ErrorAs(t, err, &partialErr)
+	assert.Equal(t, ReplayReport{Recovered: 1, Failed: 1}, report)
+	assert.Equal(t, 2, report.Total())
+}
+
+func TestAuditLoggerReplayWALWithoutWALReturnsEmptyReport(t *testing.T) {
+	logger := NewLogger(ModeMinimal, &mockWriter{}, filepath.Join(t.TempDir(), "missing.jsonl"))
+	defer logger.Close()
+
+	report, err := logger.ReplayWAL(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, report.Total())
+}
+
+func TestAuditLoggerSetModeAppliesToNextLog(t *testing.T) {
+	writer := &mockWriter{}
+	logger := NewLogger(ModeDenialsOnly, writer, "")
+	defer logger.Close()
+
+	entry := Event{
+		Subject:   "character:01ABC",
+		Action:    "read",
+		Resource:  "location:01XYZ",
+		Effect:    types.EffectAllow,
+		Timestamp: time.Now(),
+	}
+	require.NoError(t, logger.Log(context.Background(), entry))
+
+	logger.SetMode(ModeAll)
+	assert.Equal(t, ModeAll, logger.Mode())
+	require.NoError(t, logger.Log(context.Background(), entry))
+
+	require.Eventually(t, func() bool { return len(writer.getAsyncWrites()) == 1 },
+		time.Second, 10*time.Millisecond, "only the allow logged after SetMode is written")
+}
+
+func TestParseMode(t *testing.T) {
+	for _, mode := range []Mode{ModeMinimal, ModeDenialsOnly, ModeAll} {
+		got, err := ParseMode(string(mode))
+		require.NoError(t, err)
+		assert.Equal(t, mode, got)
+	}
+
+	_, err := ParseMode("verbose")
+	errutil.AssertErrorCode(t, err, "AUDIT_INVALID_MODE")
+}
@@ -6,7 +6,6 @@ package audit
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -44,44 +43,64 @@ func NewPostgresWriter(db *sql.DB) *PostgresWriter {
 	return writer
 }
 
-// WriteSync performs a synchronous write to the database.
-func (w *PostgresWriter) WriteSync(ctx context.Context, event Event) error {
-	query := `
+// insertAuditQuery inserts one access_audit_log row with its chain link.
+// ON CONFLICT makes a re-insert of the same (id, timestamp) — a WAL replay
+// of an entry that already reached the database — a no-op instead of a
+// duplicate row.
+const insertAuditQuery = `
 		INSERT INTO access_audit_log (
 			id, subject, action, resource, effect, event_id, event_name,
-			message, source, component, attributes, duration_us, timestamp
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			message, source, component, attributes, duration_us, timestamp,
+			seq, prev_hash, entry_hash
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (id, timestamp) DO NOTHING
 	`
 
-	attributesJSON, err := json.Marshal(event.Attributes)
+// rowID returns the access_audit_log id for event: its idempotency key when
+// stamped, otherwise a fresh id.
+func rowID(event *Event) string {
+	if event.IdempotencyKey != "" {
+		return event.IdempotencyKey
+	}
+	return idgen.New().String()
+}
+
+// WriteSync performs a synchronous write to the database.
+func (w *PostgresWriter) WriteSync(ctx context.Context, event Event) error {
+	_, err := w.WriteDedup(ctx, event)
+	return err
+}
+
+// WriteDedup implements DedupWriter: it inserts event keyed on its
+// idempotency key and reports whether a new row was written. The row is
+// appended to its partition's hash chain in the same transaction.
+func (w *PostgresWriter) WriteDedup(ctx context.Context, event Event) (bool, error) {
+	row, err := newChainedRow(rowID(&event), &event)
 	if err != nil {
-		return oops.Wrap(err)
+		return false, err
 	}
 
-	_, err = w.db.ExecContext(
-		ctx, query,
-		idgen.New().String(),
-		event.Subject,
-		event.Action,
-		event.Resource,
-		event.Effect.String(),
-		event.ID,
-		event.Name,
-		event.Message,
-		string(event.Source),
-		event.Component,
-		attributesJSON,
-		event.DurationUS,
-		event.Timestamp.UnixNano(), // pgnanos-exempt: SQL-cast boundary for BIGINT timestamp column
-	)
+	tx, err := w.db.BeginTx(ctx, nil)
 	if err != nil {
-		return oops.With("subject", event.Subject).
-			With("action", event.Action).
-			With("resource", event.Resource).
-			Wrap(err)
+		return false, oops.Wrap(err)
 	}
+	defer func() {
+		//nolint:errcheck // Rollback error is expected when transaction commits successfully
+		_ = tx.Rollback()
+	}()
 
-	return nil
+	chain := newChainAppender(tx)
+	inserted, err := chain.append(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	if err := chain.flush(ctx); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, oops.Wrap(err)
+	}
+	return inserted, nil
 }
 
 // WriteAsync queues an event for asynchronous batch writing.
@@ -147,12 +166,27 @@ func (w *PostgresWriter) batchConsumer() {
 	}
 }
 
-// writeBatch writes multiple events in a single transaction.
+// writeBatch writes multiple events in a single transaction. A failed
+// insert aborts the transaction, so the batch is written whole or not at
+// all and never leaves a hole in a chain.
 func (w *PostgresWriter) writeBatch(ctx context.Context, events []Event) error {
 	if len(events) == 0 {
 		return nil
 	}
 
+	rows := make([]chainedRow, 0, len(events))
+	partitions := make([]string, 0, len(events))
+	for i := range events {
+		event := &events[i]
+		row, err := newChainedRow(rowID(event), event)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to marshal attributes", "error", err, "event", event)
+			continue
+		}
+		rows = append(rows, row)
+		partitions = append(partitions, chainPartition(event.Timestamp))
+	}
+
 	tx, err := w.db.BeginTx(ctx, nil)
 	if err != nil {
 		return oops.Wrap(err)
@@ -162,49 +196,18 @@ func (w *PostgresWriter) writeBatch(ctx context.Context, events []Event) error {
 		_ = tx.Rollback()
 	}()
 
-	stmt, err := tx.PrepareContext(ctx, `
-		INSERT INTO access_audit_log (
-			id, subject, action, resource, effect, event_id, event_name,
-			message, source, component, attributes, duration_us, timestamp
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
-	`)
-	if err != nil {
-		return oops.Wrap(err)
+	chain := newChainAppender(tx)
+	if err := chain.lock(ctx, partitions...); err != nil {
+		return err
 	}
-	defer func() {
-		//nolint:errcheck // Close error is not critical - statement will be closed when transaction ends
-		_ = stmt.Close()
-	}()
-
-	for i := range events {
-		event := &events[i]
-		attributesJSON, err := json.Marshal(event.Attributes)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to marshal attributes", "error", err, "event", event)
-			continue
-		}
-
-		_, err = stmt.ExecContext(
-			ctx,
-			idgen.New().String(),
-			event.Subject,
-			event.Action,
-			event.Resource,
-			event.Effect.String(),
-			event.ID,
-			event.Name,
-			event.Message,
-			string(event.Source),
-			event.Component,
-			attributesJSON,
-			event.DurationUS,
-			event.Timestamp.UnixNano(), // pgnanos-exempt: SQL-cast boundary for BIGINT timestamp column
-		)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to insert audit event", "error", err, "event", event)
-			// Continue with other events
+	for _, row := range rows {
+		if _, err := chain.append(ctx, row); err != nil {
+			return err
 		}
 	}
+	if err := chain.flush(ctx); err != nil {
+		return err
+	}
 
 	if err := tx.Commit(); err != nil {
 		return oops.Wrap(err)
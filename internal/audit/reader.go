@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// MaxRecentDenials caps the number of rows RecentDenials returns regardless of
+// the requested limit.
+const MaxRecentDenials = 500
+
+// recentDenialsQuery reads the newest deny/default_deny rows. The effect
+// predicate matches idx_audit_log_denied so the scan stays index-only on the
+// partial index.
+const recentDenialsQuery = `
+		SELECT id, subject, action, resource, effect, event_id, event_name,
+		       message, source, component, attributes, duration_us, timestamp
+		FROM access_audit_log
+		WHERE effect IN ('deny', 'default_deny')
+		ORDER BY timestamp DESC
+		LIMIT $1
+	`
+
+// PostgresReader reads access_audit_log rows for operational views.
+type PostgresReader struct {
+	db *sql.DB
+}
+
+// NewPostgresReader creates a PostgresReader with the given database connection.
+func NewPostgresReader(db *sql.DB) *PostgresReader {
+	return &PostgresReader{db: db}
+}
+
+// RecentDenials returns up to limit of the newest denial events, newest first.
+// limit is clamped to [1, MaxRecentDenials].
+func (r *PostgresReader) RecentDenials(ctx context.Context, limit int) ([]Event, error) {
+	limit = max(1, min(limit, MaxRecentDenials))
+
+	rows, err := r.db.QueryContext(ctx, recentDenialsQuery, limit)
+	if err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").
+			With("operation", "recent_denials").
+			Wrap(err)
+	}
+	defer rows.Close() //nolint:errcheck // read-only cursor; rows.Err is checked below
+
+	events := make([]Event, 0, limit)
+	for rows.Next() {
+		var (
+			event          Event
+			effect         string
+			eventID        sql.NullString
+			eventName      sql.NullString
+			source         string
+			attributesJSON []byte
+			durationUS     sql.NullInt64
+			timestampNS    int64
+		)
+		if err := rows.Scan(
+			&event.IdempotencyKey, &event.Subject, &event.Action, &event.Resource,
+			&effect, &eventID, &eventName, &event.Message, &source,
+			&event.Component, &attributesJSON, &durationUS, &timestampNS,
+		); err != nil {
+			return nil, oops.Code("AUDIT_QUERY_FAILED").
+				With("operation", "recent_denials").
+				Wrap(err)
+		}
+		event.Effect = parseEffect(effect)
+		event.ID = eventID.String
+		event.Name = eventName.String
+		event.Source = EventSource(source)
+		event.DurationUS = durationUS.Int64
+		event.Timestamp = time.Unix(0, timestampNS).UTC()
+		if len(attributesJSON) > 0 {
+			if err := json.Unmarshal(attributesJSON, &event.Attributes); err != nil {
+				return nil, oops.Code("AUDIT_QUERY_FAILED").
+					With("operation", "recent_denials").
+					With("row_id", event.IdempotencyKey).
+					Wrap(err)
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").
+			With("operation", "recent_denials").
+			Wrap(err)
+	}
+	return events, nil
+}
+
+// parseEffect maps a stored effect column value back to types.Effect. The
+// column carries a CHECK constraint, so unknown values only arise from schema
+// drift; they fail closed to EffectDefaultDeny.
+func parseEffect(s string) types.Effect {
+	for e := types.EffectDefaultDeny; e.Valid(); e++ {
+		if e.String() == s {
+			return e
+		}
+	}
+	return types.EffectDefaultDeny
+}
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+func TestParseEffectRoundTripsEveryEffect(t *testing.T) {
+	for _, e := range []types.Effect{
+		types.EffectDefaultDeny, types.EffectAllow, types.EffectDeny, types.EffectSystemBypass,
+	} {
+		assert.Equal(t, e, parseEffect(e.String()), e.String())
+	}
+}
+
+func TestParseEffectFailsClosedOnUnknownValue(t *testing.T) {
+	assert.Equal(t, types.EffectDefaultDeny, parseEffect("maybe"))
+}
@@ -39,6 +39,7 @@ type PartitionManager interface {
 
 // RetentionWorker runs periodic retention maintenance on audit logs.
 type RetentionWorker struct {
+	cfgMu   sync.RWMutex
 	cfg     RetentionConfig
 	manager PartitionManager
 	logger  *slog.Logger
@@ -81,10 +82,23 @@ func NewRetentionWorker(cfg RetentionConfig, manager PartitionManager, opts ...O
 	return w
 }
 
+// SetRetainWindows replaces how long denial and allow records are kept. The
+// next retention cycle uses the new windows; PurgeInterval cannot change
+// once the worker has started.
+func (w *RetentionWorker) SetRetainWindows(denials, allows time.Duration) {
+	w.cfgMu.Lock()
+	defer w.cfgMu.Unlock()
+	w.cfg.RetainDenials = denials
+	w.cfg.RetainAllows = allows
+}
+
 // RunOnce executes a single retention cycle. All operations are attempted
 // even if earlier ones fail; errors are combined.
 func (w *RetentionWorker) RunOnce(ctx context.Context) error {
 	now := w.clock()
+	w.cfgMu.RLock()
+	cfg := w.cfg
+	w.cfgMu.RUnlock()
 	var errs []error
 
 	// Ensure partitions exist for the next 3 months
@@ -94,7 +108,7 @@ func (w *RetentionWorker) RunOnce(ctx context.Context) error {
 	}
 
 	// Purge expired allow records
-	purged, err := w.manager.PurgeExpiredAllows(ctx, now.Add(-w.cfg.RetainAllows))
+	purged, err := w.manager.PurgeExpiredAllows(ctx, now.Add(-cfg.RetainAllows))
 	if err != nil {
 		w.logger.ErrorContext(ctx, "purge expired allows failed", "error", err)
 		errs = append(errs, err)
@@ -103,7 +117,7 @@ func (w *RetentionWorker) RunOnce(ctx context.Context) error {
 	}
 
 	// Detach expired partitions
-	detached, err := w.manager.DetachExpiredPartitions(ctx, now.Add(-w.cfg.RetainDenials))
+	detached, err := w.manager.DetachExpiredPartitions(ctx, now.Add(-cfg.RetainDenials))
 	if err != nil {
 		w.logger.ErrorContext(ctx, "detach expired partitions failed", "error", err)
 		errs = append(errs, err)
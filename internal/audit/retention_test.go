@@ -207,6 +207,20 @@ func TestRetentionWorkerRunOnceDetachesExpiredPartitions(t *testing.T) {
 	assert.Equal(t, expectedCutoff, mock.lastDetachTime)
 }
 
+func TestRetentionWorkerSetRetainWindowsAppliesToNextCycle(t *testing.T) {
+	mock := &mockPartitionManager{}
+
+	now := time.Date(2026, 2, 12, 8, 0, 0, 0, time.UTC)
+	worker := NewRetentionWorker(DefaultRetentionConfig(), mock)
+	worker.clock = func() time.Time { return now }
+
+	worker.SetRetainWindows(30*24*time.Hour, 24*time.Hour)
+	require.NoError(t, worker.RunOnce(context.Background()))
+
+	assert.Equal(t, now.Add(-30*24*time.Hour), mock.lastDetachTime)
+	assert.Equal(t, now.Add(-24*time.Hour), mock.lastPurgeTime)
+}
+
 func TestRetentionWorkerStartStopLifecycle(t *testing.T) {
 	cfg := RetentionConfig{
 		RetainDenials: 90 * 24 * time.Hour,
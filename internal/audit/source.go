@@ -12,7 +12,7 @@ package audit
 type EventSource string
 
 // EventSource constants. These are the only values the engine, plugin
-// dispatcher, and system paths use. Additional values are additive and
+// dispatcher, system, and auth paths use. Additional values are additive and
 // MAY be introduced by adding a new constant without breaking existing
 // consumers — nothing switches on this value.
 const (
@@ -27,4 +27,9 @@ const (
 	// SourceSystem is stamped on events produced by system-bypass paths
 	// (operator overrides, reaper operations, bootstrap seeding).
 	SourceSystem EventSource = "system"
+
+	// SourceAuth is stamped on authentication events mirrored from the
+	// account security log: logins and their failures, logouts, session
+	// terminations and expiries, password changes, and 2FA changes.
+	SourceAuth EventSource = "auth"
 )
@@ -15,6 +15,7 @@ func TestEventSourceConstantsHaveExpectedStringValues(t *testing.T) {
 	assert.Equal(t, "engine", string(audit.SourceEngine))
 	assert.Equal(t, "plugin", string(audit.SourcePlugin))
 	assert.Equal(t, "system", string(audit.SourceSystem))
+	assert.Equal(t, "auth", string(audit.SourceAuth))
 }
 
 func TestEventSourceIsADefinedTypeDistinctFromString(t *testing.T) {
@@ -0,0 +1,310 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// ChainProblemKind classifies one finding of ChainVerifier.Verify.
+type ChainProblemKind string
+
+// Chain problem kinds.
+const (
+	// ChainGap means one or more sequence numbers are missing: rows were
+	// deleted from the middle of the chain.
+	ChainGap ChainProblemKind = "gap"
+	// ChainDuplicate means a sequence number appears more than once.
+	ChainDuplicate ChainProblemKind = "duplicate"
+	// ChainBrokenLink means a row's prev_hash is not the previous row's
+	// entry_hash.
+	ChainBrokenLink ChainProblemKind = "broken_link"
+	// ChainHashMismatch means a row's content no longer hashes to its
+	// entry_hash: the row was edited.
+	ChainHashMismatch ChainProblemKind = "hash_mismatch"
+	// ChainTruncated means the chain head records more rows than remain:
+	// the newest rows, or the whole partition, were removed.
+	ChainTruncated ChainProblemKind = "truncated"
+	// ChainHeadMismatch means the chain head does not describe the last row,
+	// or is missing for a partition with chained rows.
+	ChainHeadMismatch ChainProblemKind = "head_mismatch"
+)
+
+// ChainProblem is one integrity finding in a partition's chain.
+type ChainProblem struct {
+	Kind ChainProblemKind `json:"kind"`
+	// Seq is the sequence number the problem was found at.
+	Seq int64 `json:"seq"`
+	// RowID is the access_audit_log id of the offending row, when there is
+	// one.
+	RowID  string `json:"row_id,omitempty"`
+	Detail string `json:"detail"`
+}
+
+// ChainReport is the result of verifying one partition's chain.
+type ChainReport struct {
+	Partition string `json:"partition"`
+	// Entries counts the chained rows checked.
+	Entries int64 `json:"entries"`
+	// Unchained counts rows without chain columns, written before chaining
+	// was introduced. They cannot be verified.
+	Unchained int64 `json:"unchained"`
+	// HeadSeq and HeadHash are the recorded chain head. Publishing HeadHash
+	// somewhere outside the database lets a later check prove the chain was
+	// not rewritten wholesale.
+	HeadSeq  int64          `json:"head_seq"`
+	HeadHash []byte         `json:"head_hash,omitempty"`
+	Problems []ChainProblem `json:"problems,omitempty"`
+}
+
+// Intact reports whether the chain verified without problems.
+func (r *ChainReport) Intact() bool {
+	return len(r.Problems) == 0
+}
+
+// ChainVerifier checks access_audit_log hash chains against their stored
+// rows and heads.
+type ChainVerifier struct {
+	db *sql.DB
+}
+
+// NewChainVerifier creates a ChainVerifier with the given database connection.
+func NewChainVerifier(db *sql.DB) *ChainVerifier {
+	return &ChainVerifier{db: db}
+}
+
+// Partitions returns the names of every partition with a chain head or
+// attached to access_audit_log, in name order. A partition with a head but
+// no table was dropped; Verify reports it as truncated.
+func (v *ChainVerifier) Partitions(ctx context.Context) ([]string, error) {
+	rows, err := v.db.QueryContext(ctx, `
+		SELECT partition_name FROM access_audit_chain_heads
+		UNION
+		SELECT c.relname FROM pg_inherits i
+		  JOIN pg_class c ON c.oid = i.inhrelid
+		  JOIN pg_class p ON p.oid = i.inhparent
+		 WHERE p.relname = 'access_audit_log'
+		ORDER BY 1`)
+	if err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_partitions").Wrap(err)
+	}
+	defer rows.Close() //nolint:errcheck // read-only cursor; rows.Err is checked below
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_partitions").Wrap(err)
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_partitions").Wrap(err)
+	}
+	return names, nil
+}
+
+// chainRowsQuery reads a partition's rows through the parent table by
+// timestamp range, so the partition name never reaches the SQL text.
+const chainRowsQuery = `
+		SELECT id, subject, action, resource, effect, event_id, event_name,
+		       message, source, component, attributes, duration_us, timestamp,
+		       seq, prev_hash, entry_hash
+		FROM access_audit_log
+		WHERE timestamp >= $1 AND timestamp < $2 AND seq IS NOT NULL
+		ORDER BY seq, id
+	`
+
+// Verify walks partition's chain in sequence order, recomputing each row's
+// hash, and compares the end of the chain with its recorded head. The head
+// and rows are read in one repeatable-read snapshot, so writes committed
+// during the walk are not mistaken for tampering.
+//
+// Returns AUDIT_CHAIN_PARTITION_INVALID for a name that is not a monthly
+// access_audit_log partition.
+func (v *ChainVerifier) Verify(ctx context.Context, partition string) (*ChainReport, error) {
+	start, end, err := parsePartitionName(partition)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := v.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_snapshot").With("partition", partition).Wrap(err)
+	}
+	defer func() {
+		//nolint:errcheck // read-only transaction; nothing to commit
+		_ = tx.Rollback()
+	}()
+
+	var head *chainHead
+	var headHash []byte
+	var headSeq int64
+	err = tx.QueryRowContext(ctx,
+		`SELECT seq, entry_hash FROM access_audit_chain_heads WHERE partition_name = $1`,
+		partition).Scan(&headSeq, &headHash)
+	switch {
+	case err == nil:
+		head = &chainHead{seq: headSeq, hash: headHash}
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_head").With("partition", partition).Wrap(err)
+	}
+
+	// pgnanos-exempt: SQL-cast boundary for BIGINT timestamp column
+	startNS, endNS := start.UnixNano(), end.UnixNano()
+
+	checker := newChainChecker(partition)
+	rows, err := tx.QueryContext(ctx, chainRowsQuery, startNS, endNS)
+	if err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_rows").With("partition", partition).Wrap(err)
+	}
+	defer rows.Close() //nolint:errcheck // read-only cursor; rows.Err is checked below
+
+	for rows.Next() {
+		var (
+			stored         storedLink
+			eventID        sql.NullString
+			eventName      sql.NullString
+			message        sql.NullString
+			source         sql.NullString
+			component      sql.NullString
+			attributesJSON []byte
+			durationUS     sql.NullInt64
+		)
+		if err := rows.Scan(
+			&stored.row.ID, &stored.row.Subject, &stored.row.Action, &stored.row.Resource,
+			&stored.row.Effect, &eventID, &eventName, &message, &source, &component,
+			&attributesJSON, &durationUS, &stored.row.TimestampNS,
+			&stored.seq, &stored.prevHash, &stored.entryHash,
+		); err != nil {
+			return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_rows").With("partition", partition).Wrap(err)
+		}
+		stored.row.EventID = eventID.String
+		stored.row.EventName = eventName.String
+		stored.row.Message = message.String
+		stored.row.Source = source.String
+		stored.row.Component = component.String
+		stored.row.Attributes = attributesJSON
+		stored.row.DurationUS = durationUS.Int64
+		if err := checker.add(stored); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_rows").With("partition", partition).Wrap(err)
+	}
+
+	report := checker.finish(head)
+	if err := tx.QueryRowContext(ctx,
+		`SELECT count(*) FROM access_audit_log WHERE timestamp >= $1 AND timestamp < $2 AND seq IS NULL`,
+		startNS, endNS).Scan(&report.Unchained); err != nil {
+		return nil, oops.Code("AUDIT_QUERY_FAILED").With("operation", "chain_unchained").With("partition", partition).Wrap(err)
+	}
+	return report, nil
+}
+
+// parsePartitionName inverts partitionRange.
+func parsePartitionName(partition string) (start, end time.Time, err error) {
+	var year, month int
+	if _, scanErr := fmt.Sscanf(partition, "access_audit_log_%04d_%02d", &year, &month); scanErr != nil || month < 1 || month > 12 {
+		return time.Time{}, time.Time{}, oops.Code("AUDIT_CHAIN_PARTITION_INVALID").
+			With("partition", partition).
+			Errorf("not an access_audit_log partition: %q", partition)
+	}
+	name, start, end := partitionRange(time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC))
+	if name != partition {
+		return time.Time{}, time.Time{}, oops.Code("AUDIT_CHAIN_PARTITION_INVALID").
+			With("partition", partition).
+			Errorf("not an access_audit_log partition: %q", partition)
+	}
+	return start, end, nil
+}
+
+// storedLink is one chained row as read back from access_audit_log.
+type storedLink struct {
+	row       chainedRow
+	seq       int64
+	prevHash  []byte
+	entryHash []byte
+}
+
+// chainChecker verifies a partition's rows fed to it in (seq, id) order.
+type chainChecker struct {
+	report   ChainReport
+	lastSeq  int64
+	lastHash []byte
+}
+
+func newChainChecker(partition string) *chainChecker {
+	return &chainChecker{report: ChainReport{Partition: partition}}
+}
+
+func (c *chainChecker) problem(kind ChainProblemKind, seq int64, rowID, format string, args ...any) {
+	c.report.Problems = append(c.report.Problems, ChainProblem{
+		Kind:   kind,
+		Seq:    seq,
+		RowID:  rowID,
+		Detail: fmt.Sprintf(format, args...),
+	})
+}
+
+// add checks s against the row before it. Each later row is linked to the
+// stored entry_hash of the one before, so an edited row is reported once
+// as a hash_mismatch rather than breaking every link after it.
+func (c *chainChecker) add(s storedLink) error {
+	c.report.Entries++
+	expected := c.lastSeq + 1
+	switch {
+	case s.seq < expected:
+		c.problem(ChainDuplicate, s.seq, s.row.ID, "sequence number %d appears again", s.seq)
+	case s.seq > expected:
+		c.problem(ChainGap, expected, s.row.ID, "sequence numbers %d-%d are missing", expected, s.seq-1)
+	case !bytes.Equal(s.prevHash, c.lastHash):
+		c.problem(ChainBrokenLink, s.seq, s.row.ID, "prev_hash %x does not match the previous entry %x", s.prevHash, c.lastHash)
+	}
+
+	link := chainLink{Partition: c.report.Partition, Seq: s.seq, PrevHash: s.prevHash}
+	hash, err := entryHash(link, s.row)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, s.entryHash) {
+		c.problem(ChainHashMismatch, s.seq, s.row.ID, "content hashes to %x, stored entry_hash is %x", hash, s.entryHash)
+	}
+
+	if s.seq > c.lastSeq {
+		c.lastSeq = s.seq
+	}
+	c.lastHash = s.entryHash
+	return nil
+}
+
+// finish compares the end of the chain with head, which is nil when the
+// partition has no head row, and returns the report.
+func (c *chainChecker) finish(head *chainHead) *ChainReport {
+	switch {
+	case head == nil:
+		if c.report.Entries > 0 {
+			c.problem(ChainHeadMismatch, c.lastSeq, "", "partition has chained rows but no chain head")
+		}
+	case head.seq > c.lastSeq:
+		c.problem(ChainTruncated, c.lastSeq+1, "", "chain head is at %d but the last entry is %d", head.seq, c.lastSeq)
+	case head.seq < c.lastSeq:
+		c.problem(ChainHeadMismatch, c.lastSeq, "", "chain head is at %d but the last entry is %d", head.seq, c.lastSeq)
+	case !bytes.Equal(head.hash, c.lastHash):
+		c.problem(ChainHeadMismatch, head.seq, "", "chain head hash %x does not match the last entry %x", head.hash, c.lastHash)
+	}
+	if head != nil {
+		c.report.HeadSeq = head.seq
+		c.report.HeadHash = head.hash
+	}
+	return &c.report
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/core"
 	gamesession "github.com/holomush/holomush/internal/session"
 )
@@ -40,6 +41,29 @@ type Service struct {
 	// (cause=evicted) for child game sessions belonging to trimmed PlayerSessions.
 	presence     PresenceEmitter
 	gameSessions gamesession.Store
+
+	// Optional: when set, logins, logouts, and their failures are recorded
+	// in the player's security event log.
+	securityLog *SecurityLog
+
+	// Optional: when set, logins from banned addresses or to banned
+	// players are refused with AUTH_LOGIN_BANNED.
+	loginGate LoginGate
+
+	// Optional: when both are set, players can sign in with linked OpenID
+	// Connect identities (LoginWithOIDC).
+	oidcVerifier IDTokenVerifier
+	identities   IdentityRepository
+	oidcPolicy   OIDCPolicy
+
+	// Optional: when set, ExportPlayerData and DeletePlayer are available.
+	playerData       PlayerDataStores
+	playerDataPolicy PlayerDataPolicy
+
+	// Optional: when both are set, staff holding the impersonate grant can
+	// open impersonation sessions (Impersonate).
+	impersonationGrants access.SubjectResolver
+	impersonations      ImpersonationRepository
 }
 
 // ServiceOption is a functional option for Service.
@@ -132,6 +156,61 @@ func (s *Service) ConfigureGameSessionFanout(pres PresenceEmitter, gameSessions
 	}
 }
 
+// SetSecurityLog enables the per-player security event log. Passing nil
+// disables it; ListSecurityEvents then returns AUTH_SECURITY_LOG_DISABLED.
+func (s *Service) SetSecurityLog(log *SecurityLog) {
+	s.securityLog = log
+}
+
+// recordSecurityEvent writes to the security event log when one is
+// configured. Best-effort: SecurityLog.Record never fails the caller.
+func (s *Service) recordSecurityEvent(ctx context.Context, playerID ulid.ULID, eventType SecurityEventType, origin SecurityOrigin, detail string) {
+	if s.securityLog == nil {
+		return
+	}
+	s.securityLog.Record(ctx, playerID, eventType, origin, detail)
+}
+
+// recordUnknownLogin audits a failed login for an unknown username when the
+// security event log is configured.
+func (s *Service) recordUnknownLogin(ctx context.Context, origin SecurityOrigin) {
+	if s.securityLog == nil {
+		return
+	}
+	s.securityLog.RecordUnknownLogin(ctx, origin)
+}
+
+// RecordSessionExpired audits the expiry of one of playerID's game sessions
+// when the security event log is configured. Called by the session reaper.
+func (s *Service) RecordSessionExpired(ctx context.Context, playerID ulid.ULID, sessionID string) {
+	if s.securityLog == nil {
+		return
+	}
+	s.securityLog.RecordSessionExpired(ctx, playerID, sessionID)
+}
+
+// ListSecurityEvents returns up to limit of playerID's security events,
+// newest first. Only the owning player and admins may read a log; anyone
+// else gets SECURITY_EVENTS_ACCESS_DENIED. A limit <= 0 uses
+// DefaultSecurityEventLimit.
+func (s *Service) ListSecurityEvents(ctx context.Context, viewer SecurityViewer, playerID ulid.ULID, limit int) ([]*SecurityEvent, error) {
+	if s.securityLog == nil {
+		return nil, oops.Code("AUTH_SECURITY_LOG_DISABLED").Errorf("security event log is not configured")
+	}
+	if !viewer.CanView(playerID) {
+		s.logger.WarnContext(
+			ctx, "security event log access denied",
+			"event", "security_events_access_denied",
+			"viewer_player_id", viewer.PlayerID.String(),
+			"player_id", playerID.String(),
+		)
+		return nil, oops.Code("SECURITY_EVENTS_ACCESS_DENIED").
+			With("player_id", playerID.String()).
+			Errorf("not permitted to view this player's security events")
+	}
+	return s.securityLog.List(ctx, playerID, limit)
+}
+
 // dummyPasswordHash is used when a user doesn't exist to prevent timing attacks.
 // We still run password verification to make response time consistent.
 // This is NOT a real credential - it's a fake hash that will never match any password.
@@ -165,28 +244,49 @@ const dummyPasswordHash = "$argon2id$v=19$m=65536,t=1,p=4$AAAAAAAAAAAAAAAAAAAAAA
 // success. The caller is responsible for returning the raw token to the client
 // exactly once; only the hash is persisted server-side.
 func (s *Service) AuthenticatePlayer(ctx context.Context, username, password, userAgent, ipAddress string) (string, *Player, error) {
-	player, err := s.ValidateCredentials(ctx, username, password)
+	origin := SecurityOrigin{IPAddress: ipAddress, UserAgent: userAgent}
+	if err := s.checkLoginAddress(ctx, ipAddress); err != nil {
+		return "", nil, err
+	}
+	player, err := s.validateCredentials(ctx, username, password, origin)
 	if err != nil {
 		// ValidateCredentials already produces oops errors with codes
 		// (AUTH_INVALID_CREDENTIALS, AUTH_ACCOUNT_LOCKED, AUTH_LOGIN_FAILED);
 		// preserve them verbatim so callers can discriminate on code.
 		return "", nil, err
 	}
+	rawToken, err := s.startSession(ctx, player, userAgent, ipAddress, origin)
+	if err != nil {
+		return "", nil, err
+	}
+	return rawToken, player, nil
+}
 
+// startSession creates a PlayerSession for an authenticated player, applying
+// the session cap and its eviction fanout, and returns the raw session token.
+func (s *Service) startSession(ctx context.Context, player *Player, userAgent, ipAddress string, origin SecurityOrigin) (string, error) {
 	rawToken, tokenHash, err := GenerateSessionToken()
 	if err != nil {
-		return "", nil, oops.Code("AUTH_LOGIN_FAILED").
+		return "", oops.Code("AUTH_LOGIN_FAILED").
 			With("operation", "generate session token").
 			Wrap(err)
 	}
 
 	session, err := NewPlayerSession(player.ID, tokenHash, userAgent, ipAddress, PlayerSessionTTL)
 	if err != nil {
-		return "", nil, oops.Code("AUTH_LOGIN_FAILED").
+		return "", oops.Code("AUTH_LOGIN_FAILED").
 			With("operation", "create player session").
 			Wrap(err)
 	}
+	if err := s.persistSession(ctx, player, session, origin); err != nil {
+		return "", err
+	}
+	return rawToken, nil
+}
 
+// persistSession stores a new PlayerSession under the session cap and emits
+// the eviction fanout for any sessions the cap trimmed.
+func (s *Service) persistSession(ctx context.Context, player *Player, session *PlayerSession, origin SecurityOrigin) error {
 	// Snapshot candidate child game sessions before the atomic TX so we can
 	// emit session_ended for children of actually-trimmed PlayerSessions.
 	// TOCTOU acknowledged (Design Decision #10): the snapshot is best-effort.
@@ -206,7 +306,7 @@ func (s *Service) AuthenticatePlayer(ctx context.Context, username, password, us
 
 	trimmedIDs, err := s.playerSessions.CreateWithCap(ctx, session, s.maxSessionsPerPlayer)
 	if err != nil {
-		return "", nil, oops.Code("AUTH_LOGIN_FAILED").
+		return oops.Code("AUTH_LOGIN_FAILED").
 			With("operation", "persist player session with cap").
 			Wrap(err)
 	}
@@ -218,6 +318,9 @@ func (s *Service) AuthenticatePlayer(ctx context.Context, username, password, us
 			"trimmed_count", len(trimmedIDs),
 			"cap", s.maxSessionsPerPlayer,
 		)
+		for range trimmedIDs {
+			s.recordSecurityEvent(ctx, player.ID, SecurityEventSessionTerminated, origin, "evicted by session cap")
+		}
 	}
 
 	// Emit HandleDisconnect (leave on location) + session_ended (cause=evicted)
@@ -266,7 +369,7 @@ func (s *Service) AuthenticatePlayer(ctx context.Context, username, password, us
 		}
 	}
 
-	return rawToken, player, nil
+	return nil
 }
 
 // Logout invalidates a player session by token hash.
@@ -290,6 +393,12 @@ func (s *Service) Logout(ctx context.Context, tokenHash string) (ulid.ULID, erro
 			With("session_id", session.ID.String()).
 			Wrap(err)
 	}
+	origin := SecurityOrigin{IPAddress: session.IPAddress, UserAgent: session.UserAgent}
+	if session.IsImpersonation() {
+		s.recordImpersonationEvent(ctx, session, SecurityEventImpersonationEnded, origin, "logout")
+	} else {
+		s.recordSecurityEvent(ctx, session.PlayerID, SecurityEventSessionTerminated, origin, "logout")
+	}
 
 	return session.PlayerID, nil
 }
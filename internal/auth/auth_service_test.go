@@ -114,6 +114,7 @@ func testPlayerWithCredentials(t *testing.T, playerRepo *mocks.MockPlayerReposit
 	// Every AuthenticatePlayer call triggers GetByUsername -> Verify -> Update.
 	playerRepo.On("GetByUsername", mock.Anything, username).Return(player, nil)
 	hasher.On("Verify", "password", player.PasswordHash).Return(true, nil)
+	hasher.On("NeedsUpgrade", player.PasswordHash).Return(false)
 	playerRepo.On("Update", mock.Anything, mock.AnythingOfType("*auth.Player")).Return(nil)
 	return player
 }
@@ -440,6 +441,7 @@ func TestAuthenticatePlayerEmitsSessionEndedForEvictedSessionChildren(t *testing
 	}
 	playerRepo.On("GetByUsername", ctx, "dave").Return(player, nil)
 	hasher.On("Verify", "password", player.PasswordHash).Return(true, nil)
+	hasher.On("NeedsUpgrade", player.PasswordHash).Return(false)
 	playerRepo.On("Update", ctx, mock.AnythingOfType("*auth.Player")).Return(nil)
 
 	// Two active PlayerSessions exist at the cap.
@@ -512,6 +514,7 @@ func TestAuthenticatePlayerSkipsChildSessionsNotInTrimmedSet(t *testing.T) {
 	}
 	playerRepo.On("GetByUsername", ctx, "eve").Return(player, nil)
 	hasher.On("Verify", "password", player.PasswordHash).Return(true, nil)
+	hasher.On("NeedsUpgrade", player.PasswordHash).Return(false)
 	playerRepo.On("Update", ctx, mock.AnythingOfType("*auth.Player")).Return(nil)
 
 	evictedPSID := ulid.Make()
@@ -594,6 +597,7 @@ func TestAuthenticatePlayerEvictionFanoutContinuesOnEndSessionError(t *testing.T
 	}
 	playerRepo.On("GetByUsername", ctx, "frank").Return(player, nil)
 	hasher.On("Verify", "password", player.PasswordHash).Return(true, nil)
+	hasher.On("NeedsUpgrade", player.PasswordHash).Return(false)
 	playerRepo.On("Update", ctx, mock.AnythingOfType("*auth.Player")).Return(nil)
 
 	evictedPSID := ulid.Make()
@@ -20,6 +20,7 @@
 //   - AuthService - login, logout, session management
 //   - CharacterService - character creation with validation
 //   - PasswordResetService - password reset flow
+//   - SecurityLog - per-player security event log with suspicious-login alerts
 //
 // Services are created with New*Service constructors that validate dependencies.
 package auth
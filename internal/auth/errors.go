@@ -7,3 +7,15 @@ import "errors"
 
 // ErrNotFound is returned when a requested entity does not exist.
 var ErrNotFound = errors.New("not found")
+
+// ErrDuplicateIdentity is returned by IdentityRepository.Create when the
+// provider account or the player's provider slot is already linked.
+var ErrDuplicateIdentity = errors.New("identity already linked")
+
+// ErrRefreshTokenConflict is returned by PlayerSessionRepository.RotateTokens
+// when the session's refresh token changed since it was read.
+var ErrRefreshTokenConflict = errors.New("refresh token already rotated")
+
+// ErrTooManyResets is returned by PasswordResetRepository.CreateWithLimit
+// when the player already holds the maximum number of outstanding resets.
+var ErrTooManyResets = errors.New("too many outstanding password resets")
@@ -7,11 +7,13 @@ import (
 	"crypto/rand"
 	"crypto/subtle"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/samber/oops"
 	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // Argon2id parameters based on OWASP Password Storage Cheat Sheet recommendations.
@@ -43,7 +45,8 @@ type PasswordHasher interface {
 	// Hash produces an argon2id hash of the password.
 	Hash(password string) (string, error)
 
-	// Verify checks if the password matches the hash.
+	// Verify checks if the password matches the hash, which is argon2id or,
+	// for accounts imported from another game, bcrypt.
 	// Returns (true, nil) on match, (false, nil) on mismatch, or error on invalid hash.
 	Verify(password, hash string) (bool, error)
 
@@ -89,8 +92,14 @@ func (h *Argon2idHasher) Hash(password string) (string, error) {
 	return encoded, nil
 }
 
-// Verify checks if the password matches the hash.
+// Verify checks if the password matches the hash. bcrypt hashes, carried
+// over by a character import, are verified too; NeedsUpgrade reports them so
+// the caller can rehash on a successful login.
 func (h *Argon2idHasher) Verify(password, encodedHash string) (bool, error) {
+	if IsBcryptHash(encodedHash) {
+		return verifyBcrypt(password, encodedHash)
+	}
+
 	// Parse the hash
 	parts := strings.Split(encodedHash, "$")
 	if len(parts) != 6 {
@@ -147,3 +156,21 @@ func (h *Argon2idHasher) Verify(password, encodedHash string) (bool, error) {
 func (h *Argon2idHasher) NeedsUpgrade(hash string) bool {
 	return !strings.HasPrefix(hash, "$argon2id$")
 }
+
+// IsBcryptHash reports whether hash is in the modular crypt format of
+// bcrypt ($2a$, $2b$, or $2y$).
+func IsBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func verifyBcrypt(password, hash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		return false, nil
+	default:
+		return false, oops.Code("AUTH_INVALID_HASH").Wrap(err)
+	}
+}
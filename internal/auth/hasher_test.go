@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 
 	"github.com/holomush/holomush/internal/auth"
 )
@@ -121,3 +122,38 @@ func TestVerifyBcryptUpgrade(t *testing.T) {
 		assert.False(t, hasher.NeedsUpgrade(hash))
 	})
 }
+
+func TestVerifyBcryptHash(t *testing.T) {
+	hasher := auth.NewArgon2idHasher()
+	hashed, err := bcrypt.GenerateFromPassword([]byte("legacy-secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	hash := string(hashed)
+
+	t.Run("matches the password", func(t *testing.T) {
+		ok, err := hasher.Verify("legacy-secret", hash)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		ok, err := hasher.Verify("wrong", hash)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("accepts the $2y$ prefix", func(t *testing.T) {
+		ok, err := hasher.Verify("legacy-secret", "$2y$"+strings.TrimPrefix(hash, "$2a$"))
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("malformed bcrypt hash returns error", func(t *testing.T) {
+		_, err := hasher.Verify("legacy-secret", "$2a$10$short")
+		assert.Error(t, err)
+	})
+
+	t.Run("is reported for upgrade", func(t *testing.T) {
+		assert.True(t, auth.IsBcryptHash(hash))
+		assert.True(t, hasher.NeedsUpgrade(hash))
+	})
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// OIDCIdentity is the verified identity an OpenID Connect ID token asserts.
+type OIDCIdentity struct {
+	// Provider is the configured name of the issuing provider ("google").
+	Provider string
+	// Subject is the provider's stable, opaque user identifier (the sub
+	// claim). It is the only claim used to match a linked identity.
+	Subject string
+	// Email is informational and never used for matching: providers let
+	// users change it, and not every provider verifies it.
+	Email         string
+	EmailVerified bool
+}
+
+// IDTokenVerifier validates a raw OIDC ID token (signature, issuer,
+// audience, expiry) and returns the identity it asserts. Implemented by
+// oidc.Verifier.
+type IDTokenVerifier interface {
+	VerifyIDToken(ctx context.Context, rawToken string) (*OIDCIdentity, error)
+}
+
+// LinkedIdentity connects an external identity provider account to a
+// Player.
+type LinkedIdentity struct {
+	Provider string
+	Subject  string
+	PlayerID ulid.ULID
+	Email    string
+	LinkedAt time.Time
+}
+
+// NewLinkedIdentity links identity to playerID.
+func NewLinkedIdentity(playerID ulid.ULID, identity *OIDCIdentity) (*LinkedIdentity, error) {
+	if playerID.IsZero() {
+		return nil, oops.Code("AUTH_OIDC_INVALID").Errorf("player ID is required")
+	}
+	if identity == nil || strings.TrimSpace(identity.Provider) == "" || strings.TrimSpace(identity.Subject) == "" {
+		return nil, oops.Code("AUTH_OIDC_INVALID").Errorf("identity provider and subject are required")
+	}
+	return &LinkedIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		PlayerID: playerID,
+		Email:    identity.Email,
+		LinkedAt: time.Now().UTC(),
+	}, nil
+}
+
+// IdentityRepository persists linked identities.
+type IdentityRepository interface {
+	// Create stores a link. Returns ErrDuplicateIdentity when the provider
+	// account is already linked to any player, or the player already has an
+	// identity from that provider.
+	Create(ctx context.Context, identity *LinkedIdentity) error
+
+	// Get returns the link for a provider account. Returns ErrNotFound if it
+	// is not linked.
+	Get(ctx context.Context, provider, subject string) (*LinkedIdentity, error)
+
+	// ListByPlayer returns the player's links, oldest first.
+	ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*LinkedIdentity, error)
+
+	// Delete removes the player's link to provider. Returns ErrNotFound if
+	// there is none.
+	Delete(ctx context.Context, playerID ulid.ULID, provider string) error
+}
+
+// OIDCPolicy is the operator policy for OIDC sign-in.
+type OIDCPolicy struct {
+	// RequirePasswordFallback keeps every linked account reachable without
+	// its identity provider. When set, an account can only sign in with an
+	// identity while it has a local password. When unset, the last identity
+	// of an account with no password cannot be unlinked. Linking always
+	// re-verifies the local password either way.
+	RequirePasswordFallback bool
+}
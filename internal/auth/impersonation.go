@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Impersonation limits.
+const (
+	// DefaultImpersonationDuration is how long an impersonation session lasts
+	// when the caller does not ask for a duration.
+	DefaultImpersonationDuration = 30 * time.Minute
+	// MaxImpersonationDuration is the hard upper bound on an impersonation
+	// session. The session is never refreshed past it.
+	MaxImpersonationDuration = time.Hour
+	// MaxImpersonationReasonLength bounds the stated reason, in characters.
+	MaxImpersonationReasonLength = 256
+)
+
+// Impersonation records one staff impersonation of a player. It outlives the
+// session it opened so the player can be told about it at their next login.
+type Impersonation struct {
+	ID              ulid.ULID
+	ImpersonatorID  ulid.ULID
+	PlayerID        ulid.ULID
+	PlayerSessionID ulid.ULID
+	Reason          string
+	StartedAt       time.Time
+	ExpiresAt       time.Time
+	// NotifiedAt is when the player was told; zero until then.
+	NotifiedAt time.Time
+}
+
+// ImpersonationRepository persists impersonation records.
+type ImpersonationRepository interface {
+	// Create stores a new impersonation record.
+	Create(ctx context.Context, imp *Impersonation) error
+
+	// TakePending marks every impersonation of playerID not yet announced as
+	// notified at now and returns them, oldest first. A record is returned
+	// by at most one call.
+	TakePending(ctx context.Context, playerID ulid.ULID, now time.Time) ([]*Impersonation, error)
+
+	// HoldsStaffRole reports whether any of playerID's characters holds a
+	// role other than the default player role.
+	HoldsStaffRole(ctx context.Context, playerID ulid.ULID) (bool, error)
+}
+
+// privilegedGrants are the player grants that put their holder out of reach
+// of impersonation: a session as that player would hand the staff member
+// capabilities they were not granted.
+var privilegedGrants = []string{access.CapabilityCryptoOperator, access.CapabilityImpersonate}
+
+// ImpersonationOptions describes an impersonation request.
+type ImpersonationOptions struct {
+	// Reason is why the staff member needs to act as the player. Required;
+	// it is recorded in the audit trail and shown to the player.
+	Reason string
+	// Duration is how long the session lasts. Zero uses
+	// DefaultImpersonationDuration; it may not exceed
+	// MaxImpersonationDuration.
+	Duration time.Duration
+	// Origin is where the staff member's request came from.
+	Origin SecurityOrigin
+}
+
+// SetImpersonation enables Impersonate. grants resolves the staff member's
+// player grants; records stores the impersonation history. Passing nil for
+// either disables impersonation.
+func (s *Service) SetImpersonation(grants access.SubjectResolver, records ImpersonationRepository) {
+	s.impersonationGrants = grants
+	s.impersonations = records
+}
+
+// Impersonate opens a session as targetPlayerID on behalf of the staff
+// member who owns adminSession. The staff member must hold
+// access.CapabilityImpersonate; the target may hold neither a privileged
+// player grant nor a staff role on any of their characters. The new session is flagged with the staff
+// player (PlayerSession.ImpersonatorID), lasts at most
+// MaxImpersonationDuration and is never refreshed past that, does not count
+// against the target's session cap, and cannot itself be used to
+// impersonate. The start is recorded in both players' security logs and the
+// target is told at their next login.
+//
+// Returns the raw session token and the new session. Errors:
+//
+//   - IMPERSONATION_DISABLED when SetImpersonation has not been called;
+//   - IMPERSONATION_INVALID_SESSION for a missing or expired admin session;
+//   - IMPERSONATION_NESTED when adminSession is itself an impersonation;
+//   - IMPERSONATION_REASON_REQUIRED / IMPERSONATION_INVALID_REASON for an
+//     empty or over-long reason;
+//   - IMPERSONATION_INVALID_DURATION for a negative or over-long duration;
+//   - IMPERSONATION_SELF when the target is the staff member;
+//   - IMPERSONATION_CHECK_FAILED when the grant cannot be resolved;
+//   - IMPERSONATION_NOT_PERMITTED without the grant;
+//   - IMPERSONATION_TARGET_NOT_FOUND for an unknown target;
+//   - IMPERSONATION_TARGET_CHECK_FAILED when the target's grants or roles
+//     cannot be resolved;
+//   - IMPERSONATION_TARGET_PRIVILEGED when the target holds a privileged
+//     grant or a staff role;
+//   - IMPERSONATION_FAILED when the session cannot be stored.
+func (s *Service) Impersonate(ctx context.Context, adminSession *PlayerSession, targetPlayerID ulid.ULID, opts ImpersonationOptions) (string, *PlayerSession, error) {
+	if s.impersonationGrants == nil || s.impersonations == nil {
+		return "", nil, oops.Code("IMPERSONATION_DISABLED").Errorf("impersonation is not configured")
+	}
+	if adminSession == nil || adminSession.IsExpired() {
+		return "", nil, oops.Code("IMPERSONATION_INVALID_SESSION").Errorf("an active session is required to impersonate")
+	}
+	if adminSession.IsImpersonation() {
+		return "", nil, oops.Code("IMPERSONATION_NESTED").
+			With("session_id", adminSession.ID.String()).
+			Errorf("cannot impersonate from an impersonation session")
+	}
+	staffID := adminSession.PlayerID
+	if opts.Reason == "" {
+		return "", nil, oops.Code("IMPERSONATION_REASON_REQUIRED").Errorf("a reason is required to impersonate")
+	}
+	if utf8.RuneCountInString(opts.Reason) > MaxImpersonationReasonLength {
+		return "", nil, oops.Code("IMPERSONATION_INVALID_REASON").
+			With("max_length", MaxImpersonationReasonLength).
+			Errorf("reason is longer than %d characters", MaxImpersonationReasonLength)
+	}
+	duration := opts.Duration
+	if duration == 0 {
+		duration = DefaultImpersonationDuration
+	}
+	if duration < 0 || duration > MaxImpersonationDuration {
+		return "", nil, oops.Code("IMPERSONATION_INVALID_DURATION").
+			With("duration", opts.Duration.String()).
+			Errorf("duration must be between 0 and %s", MaxImpersonationDuration)
+	}
+	if staffID.Compare(targetPlayerID) == 0 {
+		return "", nil, oops.Code("IMPERSONATION_SELF").Errorf("cannot impersonate yourself")
+	}
+
+	permitted, err := access.HasPlayerGrant(ctx, s.impersonationGrants, staffID.String(), access.CapabilityImpersonate)
+	if err != nil {
+		return "", nil, oops.Code("IMPERSONATION_CHECK_FAILED").
+			With("player_id", staffID.String()).
+			Wrap(err)
+	}
+	if !permitted {
+		s.logger.WarnContext(
+			ctx, "impersonation denied",
+			"event", "impersonation_denied",
+			"impersonator_id", staffID.String(),
+			"player_id", targetPlayerID.String(),
+		)
+		if s.securityLog != nil {
+			s.securityLog.RecordImpersonationDenied(ctx, staffID, targetPlayerID, opts.Origin)
+		}
+		return "", nil, oops.Code("IMPERSONATION_NOT_PERMITTED").
+			With("player_id", staffID.String()).
+			Errorf("not permitted to impersonate players")
+	}
+
+	target, err := s.players.GetByID(ctx, targetPlayerID)
+	if err != nil {
+		return "", nil, oops.Code("IMPERSONATION_TARGET_NOT_FOUND").
+			With("target_player_id", targetPlayerID.String()).
+			Wrap(err)
+	}
+	privileged, err := s.isPrivilegedPlayer(ctx, target.ID)
+	if err != nil {
+		return "", nil, oops.Code("IMPERSONATION_TARGET_CHECK_FAILED").
+			With("target_player_id", target.ID.String()).
+			Wrap(err)
+	}
+	if privileged {
+		s.logger.WarnContext(
+			ctx, "impersonation denied",
+			"event", "impersonation_denied",
+			"reason", "privileged_target",
+			"impersonator_id", staffID.String(),
+			"player_id", target.ID.String(),
+		)
+		if s.securityLog != nil {
+			s.securityLog.RecordImpersonationDenied(ctx, staffID, target.ID, opts.Origin)
+		}
+		return "", nil, oops.Code("IMPERSONATION_TARGET_PRIVILEGED").
+			With("target_player_id", target.ID.String()).
+			Errorf("cannot impersonate a player with staff roles or privileged grants")
+	}
+
+	rawToken, tokenHash, err := GenerateSessionToken()
+	if err != nil {
+		return "", nil, oops.Code("IMPERSONATION_FAILED").
+			With("operation", "generate session token").
+			Wrap(err)
+	}
+	session, err := NewPlayerSession(target.ID, tokenHash, opts.Origin.UserAgent, opts.Origin.IPAddress, duration)
+	if err != nil {
+		return "", nil, oops.Code("IMPERSONATION_FAILED").
+			With("operation", "create player session").
+			Wrap(err)
+	}
+	session.ImpersonatorID = staffID
+	// Plain Create: an impersonation session must not evict the player's
+	// own sessions through the cap.
+	if err := s.playerSessions.Create(ctx, session); err != nil {
+		return "", nil, oops.Code("IMPERSONATION_FAILED").
+			With("operation", "persist player session").
+			Wrap(err)
+	}
+
+	record := &Impersonation{
+		ID:              idgen.New(),
+		ImpersonatorID:  staffID,
+		PlayerID:        target.ID,
+		PlayerSessionID: session.ID,
+		Reason:          opts.Reason,
+		StartedAt:       session.CreatedAt,
+		ExpiresAt:       session.ExpiresAt,
+	}
+	if err := s.impersonations.Create(ctx, record); err != nil {
+		// Without a record the player would never hear about it; do not
+		// leave the session behind.
+		if delErr := s.playerSessions.Delete(ctx, session.ID); delErr != nil {
+			s.logger.ErrorContext(
+				ctx, "impersonation session not removed after record failure",
+				"session_id", session.ID.String(),
+				"error", delErr.Error(),
+			)
+		}
+		return "", nil, oops.Code("IMPERSONATION_FAILED").
+			With("operation", "record impersonation").
+			Wrap(err)
+	}
+
+	s.recordImpersonationEvent(ctx, session, SecurityEventImpersonationStarted, opts.Origin, opts.Reason)
+	s.logger.InfoContext(
+		ctx, "impersonation started",
+		"event", "impersonation_started",
+		"impersonator_id", staffID.String(),
+		"player_id", target.ID.String(),
+		"player_session_id", session.ID.String(),
+		"expires_at", session.ExpiresAt,
+	)
+	return rawToken, session, nil
+}
+
+// isPrivilegedPlayer reports whether playerID holds one of privilegedGrants
+// or a staff role on any of their characters.
+func (s *Service) isPrivilegedPlayer(ctx context.Context, playerID ulid.ULID) (bool, error) {
+	for _, grant := range privilegedGrants {
+		held, err := access.HasPlayerGrant(ctx, s.impersonationGrants, playerID.String(), grant)
+		if err != nil {
+			return false, err
+		}
+		if held {
+			return true, nil
+		}
+	}
+	held, err := s.impersonations.HoldsStaffRole(ctx, playerID)
+	if err != nil {
+		return false, oops.With("operation", "check staff roles").Wrap(err)
+	}
+	return held, nil
+}
+
+// TakeImpersonationNotices returns the impersonations of playerID the player
+// has not been told about yet and marks them told. Returns nil when
+// impersonation is not configured.
+func (s *Service) TakeImpersonationNotices(ctx context.Context, playerID ulid.ULID) ([]*Impersonation, error) {
+	if s.impersonations == nil {
+		return nil, nil
+	}
+	pending, err := s.impersonations.TakePending(ctx, playerID, time.Now())
+	if err != nil {
+		return nil, oops.Code("IMPERSONATION_NOTICES_FAILED").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return pending, nil
+}
+
+// recordImpersonationEvent writes eventType to both the impersonated
+// player's and the staff member's security logs. The context carries the
+// staff member as impersonator, so the audit copies name both identities.
+func (s *Service) recordImpersonationEvent(ctx context.Context, session *PlayerSession, eventType SecurityEventType, origin SecurityOrigin, detail string) {
+	ctx = core.WithImpersonator(ctx, session.ImpersonatorID.String())
+	s.recordSecurityEvent(ctx, session.PlayerID, eventType, origin,
+		"by staff player "+session.ImpersonatorID.String()+": "+detail)
+	s.recordSecurityEvent(ctx, session.ImpersonatorID, eventType, origin,
+		"as player "+session.PlayerID.String()+": "+detail)
+}
@@ -0,0 +1,309 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeGrantResolver publishes the impersonate grant for the listed staff
+// players and the crypto operator grant for the listed operators.
+type fakeGrantResolver struct {
+	staff     map[string]bool
+	operators map[string]bool
+	err       error
+}
+
+func (f *fakeGrantResolver) ResolveSubjectAttributes(_ context.Context, subjectID, _ string) (*types.AttributeBags, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	bags := types.NewAttributeBags()
+	grants := []string{}
+	playerID := strings.TrimPrefix(subjectID, access.SubjectPlayer)
+	if f.staff[playerID] {
+		grants = append(grants, access.CapabilityImpersonate)
+	}
+	if f.operators[playerID] {
+		grants = append(grants, access.CapabilityCryptoOperator)
+	}
+	bags.Subject[access.PlayerGrantsAttribute] = grants
+	return bags, nil
+}
+
+// memImpersonations is an in-memory auth.ImpersonationRepository.
+type memImpersonations struct {
+	mu         sync.Mutex
+	records    []*auth.Impersonation
+	staffRoles map[ulid.ULID]bool
+	createErr  error
+	rolesErr   error
+}
+
+func (m *memImpersonations) Create(_ context.Context, imp *auth.Impersonation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.records = append(m.records, imp)
+	return nil
+}
+
+func (m *memImpersonations) TakePending(_ context.Context, playerID ulid.ULID, now time.Time) ([]*auth.Impersonation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*auth.Impersonation
+	for _, r := range m.records {
+		if r.PlayerID == playerID && r.NotifiedAt.IsZero() {
+			r.NotifiedAt = now
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (m *memImpersonations) HoldsStaffRole(_ context.Context, playerID ulid.ULID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.rolesErr != nil {
+		return false, m.rolesErr
+	}
+	return m.staffRoles[playerID], nil
+}
+
+type impersonationFixture struct {
+	svc     *auth.Service
+	players interface {
+		On(string, ...any) *mock.Call
+	}
+	sessions interface {
+		On(string, ...any) *mock.Call
+	}
+	records      *memImpersonations
+	events       *memSecurityEvents
+	auditor      *recordingAuditor
+	staffSession *auth.PlayerSession
+	target       *auth.Player
+}
+
+func newImpersonationFixture(t *testing.T) *impersonationFixture {
+	t.Helper()
+	svc, playerRepo, sessionRepo, _ := newTestAuthServiceWithCap(t, 2)
+	log, events, _ := newTestSecurityLog(t)
+	auditor := &recordingAuditor{}
+	log.SetAuditor(auditor)
+	svc.SetSecurityLog(log)
+
+	staffSession, err := auth.NewPlayerSession(ulid.Make(), "staff-hash", "ua", "198.51.100.7", time.Hour)
+	require.NoError(t, err)
+	records := &memImpersonations{}
+	svc.SetImpersonation(&fakeGrantResolver{staff: map[string]bool{staffSession.PlayerID.String(): true}}, records)
+
+	return &impersonationFixture{
+		svc:          svc,
+		players:      playerRepo,
+		sessions:     sessionRepo,
+		records:      records,
+		events:       events,
+		auditor:      auditor,
+		staffSession: staffSession,
+		target:       &auth.Player{ID: ulid.Make(), Username: "target"},
+	}
+}
+
+func TestImpersonateOpensFlaggedSession(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	f.players.On("GetByID", mock.Anything, f.target.ID).Return(f.target, nil)
+	f.sessions.On("Create", mock.Anything, mock.AnythingOfType("*auth.PlayerSession")).Return(nil)
+
+	token, session, err := f.svc.Impersonate(ctx, f.staffSession, f.target.ID, auth.ImpersonationOptions{
+		Reason: "ticket 42: stuck in a room",
+		Origin: auth.SecurityOrigin{IPAddress: "198.51.100.7"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, f.target.ID, session.PlayerID)
+	assert.Equal(t, f.staffSession.PlayerID, session.ImpersonatorID)
+	assert.True(t, session.IsImpersonation())
+	assert.WithinDuration(t, time.Now().Add(auth.DefaultImpersonationDuration), session.ExpiresAt, 5*time.Second)
+
+	require.Len(t, f.records.records, 1)
+	record := f.records.records[0]
+	assert.Equal(t, session.ID, record.PlayerSessionID)
+	assert.Equal(t, "ticket 42: stuck in a room", record.Reason)
+
+	assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventImpersonationStarted, auth.SecurityEventImpersonationStarted},
+		f.events.types(), "both players get the event")
+	staff := f.staffSession.PlayerID.String()
+	assert.Equal(t, []string{staff, staff}, f.auditor.impersonators,
+		"audit copies carry the staff member behind the session")
+}
+
+func TestImpersonateRequiresGrant(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	f.svc.SetImpersonation(&fakeGrantResolver{}, f.records)
+
+	_, _, err := f.svc.Impersonate(ctx, f.staffSession, f.target.ID, auth.ImpersonationOptions{Reason: "curious"})
+	errutil.AssertErrorCode(t, err, "IMPERSONATION_NOT_PERMITTED")
+	assert.Empty(t, f.records.records)
+	assert.Equal(t, []string{"impersonation_denied"}, f.auditor.actions())
+	assert.Equal(t, types.EffectDeny, f.auditor.events[0].Effect)
+}
+
+func TestImpersonateRefusesPrivilegedTargets(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(f *impersonationFixture)
+	}{
+		{"impersonate grant", func(f *impersonationFixture) {
+			f.svc.SetImpersonation(&fakeGrantResolver{staff: map[string]bool{
+				f.staffSession.PlayerID.String(): true,
+				f.target.ID.String():             true,
+			}}, f.records)
+		}},
+		{"crypto operator grant", func(f *impersonationFixture) {
+			f.svc.SetImpersonation(&fakeGrantResolver{
+				staff:     map[string]bool{f.staffSession.PlayerID.String(): true},
+				operators: map[string]bool{f.target.ID.String(): true},
+			}, f.records)
+		}},
+		{"staff role", func(f *impersonationFixture) {
+			f.records.staffRoles = map[ulid.ULID]bool{f.target.ID: true}
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newImpersonationFixture(t)
+			tt.setup(f)
+			f.players.On("GetByID", mock.Anything, f.target.ID).Return(f.target, nil)
+
+			_, _, err := f.svc.Impersonate(ctx, f.staffSession, f.target.ID, auth.ImpersonationOptions{Reason: "r"})
+			errutil.AssertErrorCode(t, err, "IMPERSONATION_TARGET_PRIVILEGED")
+			assert.Empty(t, f.records.records)
+			assert.Equal(t, []string{"impersonation_denied"}, f.auditor.actions())
+		})
+	}
+}
+
+func TestImpersonateFailsClosedWhenTargetRolesAreUnknown(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	f.records.rolesErr = errors.New("db down")
+	f.players.On("GetByID", mock.Anything, f.target.ID).Return(f.target, nil)
+
+	_, _, err := f.svc.Impersonate(ctx, f.staffSession, f.target.ID, auth.ImpersonationOptions{Reason: "r"})
+	errutil.AssertErrorCode(t, err, "IMPERSONATION_TARGET_CHECK_FAILED")
+	assert.Empty(t, f.records.records)
+}
+
+func TestImpersonateRejectsBadRequests(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	expired := *f.staffSession
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	nested := *f.staffSession
+	nested.ImpersonatorID = ulid.Make()
+
+	tests := []struct {
+		name    string
+		session *auth.PlayerSession
+		target  ulid.ULID
+		opts    auth.ImpersonationOptions
+		code    string
+	}{
+		{"no session", nil, f.target.ID, auth.ImpersonationOptions{Reason: "r"}, "IMPERSONATION_INVALID_SESSION"},
+		{"expired session", &expired, f.target.ID, auth.ImpersonationOptions{Reason: "r"}, "IMPERSONATION_INVALID_SESSION"},
+		{"nested", &nested, f.target.ID, auth.ImpersonationOptions{Reason: "r"}, "IMPERSONATION_NESTED"},
+		{"no reason", f.staffSession, f.target.ID, auth.ImpersonationOptions{}, "IMPERSONATION_REASON_REQUIRED"},
+		{"long reason", f.staffSession, f.target.ID,
+			auth.ImpersonationOptions{Reason: strings.Repeat("x", auth.MaxImpersonationReasonLength+1)}, "IMPERSONATION_INVALID_REASON"},
+		{"over the limit", f.staffSession, f.target.ID,
+			auth.ImpersonationOptions{Reason: "r", Duration: auth.MaxImpersonationDuration + time.Minute}, "IMPERSONATION_INVALID_DURATION"},
+		{"negative duration", f.staffSession, f.target.ID,
+			auth.ImpersonationOptions{Reason: "r", Duration: -time.Minute}, "IMPERSONATION_INVALID_DURATION"},
+		{"self", f.staffSession, f.staffSession.PlayerID, auth.ImpersonationOptions{Reason: "r"}, "IMPERSONATION_SELF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := f.svc.Impersonate(ctx, tt.session, tt.target, tt.opts)
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestImpersonateDisabledAndCheckFailure(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	staff, err := auth.NewPlayerSession(ulid.Make(), "hash", "", "", time.Hour)
+	require.NoError(t, err)
+
+	_, _, err = svc.Impersonate(ctx, staff, ulid.Make(), auth.ImpersonationOptions{Reason: "r"})
+	errutil.AssertErrorCode(t, err, "IMPERSONATION_DISABLED")
+
+	svc.SetImpersonation(&fakeGrantResolver{err: errors.New("resolver down")}, &memImpersonations{})
+	_, _, err = svc.Impersonate(ctx, staff, ulid.Make(), auth.ImpersonationOptions{Reason: "r"})
+	errutil.AssertErrorCode(t, err, "IMPERSONATION_CHECK_FAILED")
+}
+
+func TestImpersonateRemovesSessionWhenRecordFails(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	f.records.createErr = errors.New("db down")
+	f.players.On("GetByID", mock.Anything, f.target.ID).Return(f.target, nil)
+	f.sessions.On("Create", mock.Anything, mock.AnythingOfType("*auth.PlayerSession")).Return(nil)
+	f.sessions.On("Delete", mock.Anything, mock.AnythingOfType("ulid.ULID")).Return(nil).Once()
+
+	_, _, err := f.svc.Impersonate(ctx, f.staffSession, f.target.ID, auth.ImpersonationOptions{Reason: "r"})
+	errutil.AssertErrorCode(t, err, "IMPERSONATION_FAILED")
+	assert.Empty(t, f.events.types())
+}
+
+func TestLogoutEndsImpersonationForBothPlayers(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	session, err := auth.NewPlayerSession(f.target.ID, "imp-hash", "", "", time.Hour)
+	require.NoError(t, err)
+	session.ImpersonatorID = f.staffSession.PlayerID
+	f.sessions.On("GetByTokenHash", mock.Anything, "imp-hash").Return(session, nil)
+	f.sessions.On("Delete", mock.Anything, session.ID).Return(nil)
+
+	_, err = f.svc.Logout(ctx, "imp-hash")
+	require.NoError(t, err)
+	assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventImpersonationEnded, auth.SecurityEventImpersonationEnded},
+		f.events.types())
+}
+
+func TestTakeImpersonationNoticesReturnsEachOnce(t *testing.T) {
+	ctx := context.Background()
+	f := newImpersonationFixture(t)
+	f.records.records = []*auth.Impersonation{{ID: ulid.Make(), PlayerID: f.target.ID, Reason: "r"}}
+
+	notices, err := f.svc.TakeImpersonationNotices(ctx, f.target.ID)
+	require.NoError(t, err)
+	require.Len(t, notices, 1)
+	assert.False(t, notices[0].NotifiedAt.IsZero())
+
+	notices, err = f.svc.TakeImpersonationNotices(ctx, f.target.ID)
+	require.NoError(t, err)
+	assert.Empty(t, notices)
+}
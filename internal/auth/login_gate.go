@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/oops"
+)
+
+// ErrLoginBanned is wrapped by AuthenticatePlayer errors (code
+// AUTH_LOGIN_BANNED) when a LoginGate refuses the login. The ban reason is
+// attached as the "reason" attribute.
+var ErrLoginBanned = errors.New("login banned")
+
+// LoginGate decides whether a login may proceed. Implemented by
+// bans.Service.
+type LoginGate interface {
+	// AddressBanned reports whether logins from ipAddress are refused and
+	// why. An empty address is never banned.
+	AddressBanned(ctx context.Context, ipAddress string) (reason string, banned bool, err error)
+
+	// PlayerBanned reports whether logins as username are refused and why.
+	PlayerBanned(ctx context.Context, username string) (reason string, banned bool, err error)
+}
+
+// SetLoginGate enables ban enforcement in AuthenticatePlayer. Passing nil
+// disables it.
+func (s *Service) SetLoginGate(gate LoginGate) {
+	s.loginGate = gate
+}
+
+// checkLoginAddress refuses logins from a banned address. It runs before
+// credentials are checked so a banned client cannot probe passwords. Gate
+// failures fail closed.
+func (s *Service) checkLoginAddress(ctx context.Context, ipAddress string) error {
+	if s.loginGate == nil {
+		return nil
+	}
+	reason, banned, err := s.loginGate.AddressBanned(ctx, ipAddress)
+	if err != nil {
+		return oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "check address ban").
+			Wrap(err)
+	}
+	if banned {
+		return bannedError(reason)
+	}
+	return nil
+}
+
+// checkLoginPlayer refuses logins to a banned player. validateCredentials
+// calls it after the password and lockout checks so the response does not
+// reveal which usernames are banned to someone without the password.
+func (s *Service) checkLoginPlayer(ctx context.Context, player *Player, origin SecurityOrigin) error {
+	if s.loginGate == nil {
+		return nil
+	}
+	reason, banned, err := s.loginGate.PlayerBanned(ctx, player.Username)
+	if err != nil {
+		return oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "check player ban").
+			Wrap(err)
+	}
+	if banned {
+		s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "banned")
+		return bannedError(reason)
+	}
+	return nil
+}
+
+func bannedError(reason string) error {
+	return oops.Code("AUTH_LOGIN_BANNED").
+		With("reason", reason).
+		Wrap(ErrLoginBanned)
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubLoginGate bans one address and one username.
+type stubLoginGate struct {
+	address  string
+	username string
+	err      error
+}
+
+func (g stubLoginGate) AddressBanned(_ context.Context, ipAddress string) (string, bool, error) {
+	if g.err != nil {
+		return "", false, g.err
+	}
+	return "abuse", ipAddress != "" && ipAddress == g.address, nil
+}
+
+func (g stubLoginGate) PlayerBanned(_ context.Context, username string) (string, bool, error) {
+	return "griefing", username == g.username, nil
+}
+
+func TestAuthenticatePlayerRefusesBannedAddressBeforeCredentials(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	svc.SetLoginGate(stubLoginGate{address: "203.0.113.5"})
+
+	// No repository or hasher expectations: the ban short-circuits before
+	// the credential check.
+	_, _, err := svc.AuthenticatePlayer(context.Background(), "alice", "password", "ua", "203.0.113.5")
+	errutil.AssertErrorCode(t, err, "AUTH_LOGIN_BANNED")
+	errutil.AssertErrorContext(t, err, "reason", "abuse")
+	assert.ErrorIs(t, err, auth.ErrLoginBanned)
+}
+
+func TestAuthenticatePlayerRefusesBannedPlayerAfterCredentials(t *testing.T) {
+	ctx := context.Background()
+	svc, playerRepo, _, hasher := newTestAuthServiceWithCap(t, 0)
+	log, events, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+	svc.SetLoginGate(stubLoginGate{username: "alice"})
+	player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash"}
+	playerRepo.On("GetByUsername", mock.Anything, "alice").Return(player, nil)
+	hasher.On("Verify", "password", player.PasswordHash).Return(true, nil)
+
+	_, _, err := svc.AuthenticatePlayer(ctx, "alice", "password", "ua", "198.51.100.1")
+	errutil.AssertErrorCode(t, err, "AUTH_LOGIN_BANNED")
+	assert.ErrorIs(t, err, auth.ErrLoginBanned)
+
+	require.Equal(t, []auth.SecurityEventType{auth.SecurityEventLoginFailed}, events.types())
+	assert.Equal(t, player.ID, events.events[0].PlayerID)
+	assert.Equal(t, "banned", events.events[0].Detail)
+}
+
+func TestAuthenticatePlayerAllowsUnbannedLogin(t *testing.T) {
+	ctx := context.Background()
+	svc, playerRepo, sessionRepo, hasher := newTestAuthServiceWithCap(t, 0)
+	svc.SetLoginGate(stubLoginGate{address: "203.0.113.5", username: "mallory"})
+	testPlayerWithCredentials(t, playerRepo, hasher, "alice")
+	sessionRepo.On("CreateWithCap", ctx, mock.AnythingOfType("*auth.PlayerSession"), 0).
+		Return([]ulid.ULID(nil), nil).Once()
+
+	_, _, err := svc.AuthenticatePlayer(ctx, "alice", "password", "ua", "198.51.100.1")
+	require.NoError(t, err)
+}
+
+func TestAuthenticatePlayerFailsClosedWhenGateErrors(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	svc.SetLoginGate(stubLoginGate{err: errors.New("db down")})
+
+	_, _, err := svc.AuthenticatePlayer(context.Background(), "alice", "password", "ua", "198.51.100.1")
+	errutil.AssertErrorCode(t, err, "AUTH_LOGIN_FAILED")
+	assert.NotErrorIs(t, err, auth.ErrLoginBanned)
+}
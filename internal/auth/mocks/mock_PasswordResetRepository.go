@@ -134,6 +134,54 @@ func (_c *MockPasswordResetRepository_Create_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// CreateWithLimit provides a mock function with given fields: ctx, reset, maxOutstanding
+func (_m *MockPasswordResetRepository) CreateWithLimit(ctx context.Context, reset *auth.PasswordReset, maxOutstanding int) error {
+	ret := _m.Called(ctx, reset, maxOutstanding)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithLimit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.PasswordReset, int) error); ok {
+		r0 = rf(ctx, reset, maxOutstanding)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPasswordResetRepository_CreateWithLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithLimit'
+type MockPasswordResetRepository_CreateWithLimit_Call struct {
+	*mock.Call
+}
+
+// CreateWithLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reset *auth.PasswordReset
+//   - maxOutstanding int
+func (_e *MockPasswordResetRepository_Expecter) CreateWithLimit(ctx interface{}, reset interface{}, maxOutstanding interface{}) *MockPasswordResetRepository_CreateWithLimit_Call {
+	return &MockPasswordResetRepository_CreateWithLimit_Call{Call: _e.mock.On("CreateWithLimit", ctx, reset, maxOutstanding)}
+}
+
+func (_c *MockPasswordResetRepository_CreateWithLimit_Call) Run(run func(ctx context.Context, reset *auth.PasswordReset, maxOutstanding int)) *MockPasswordResetRepository_CreateWithLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*auth.PasswordReset), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_CreateWithLimit_Call) Return(_a0 error) *MockPasswordResetRepository_CreateWithLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPasswordResetRepository_CreateWithLimit_Call) RunAndReturn(run func(context.Context, *auth.PasswordReset, int) error) *MockPasswordResetRepository_CreateWithLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, id
 func (_m *MockPasswordResetRepository) Delete(ctx context.Context, id ulid.ULID) error {
 	ret := _m.Called(ctx, id)
@@ -462,6 +462,65 @@ func (_c *MockPlayerSessionRepository_GetByID_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetByRefreshTokenHash provides a mock function with given fields: ctx, refreshHash
+func (_m *MockPlayerSessionRepository) GetByRefreshTokenHash(ctx context.Context, refreshHash string) (*auth.PlayerSession, error) {
+	ret := _m.Called(ctx, refreshHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByRefreshTokenHash")
+	}
+
+	var r0 *auth.PlayerSession
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*auth.PlayerSession, error)); ok {
+		return rf(ctx, refreshHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *auth.PlayerSession); ok {
+		r0 = rf(ctx, refreshHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.PlayerSession)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refreshHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPlayerSessionRepository_GetByRefreshTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByRefreshTokenHash'
+type MockPlayerSessionRepository_GetByRefreshTokenHash_Call struct {
+	*mock.Call
+}
+
+// GetByRefreshTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - refreshHash string
+func (_e *MockPlayerSessionRepository_Expecter) GetByRefreshTokenHash(ctx interface{}, refreshHash interface{}) *MockPlayerSessionRepository_GetByRefreshTokenHash_Call {
+	return &MockPlayerSessionRepository_GetByRefreshTokenHash_Call{Call: _e.mock.On("GetByRefreshTokenHash", ctx, refreshHash)}
+}
+
+func (_c *MockPlayerSessionRepository_GetByRefreshTokenHash_Call) Run(run func(ctx context.Context, refreshHash string)) *MockPlayerSessionRepository_GetByRefreshTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_GetByRefreshTokenHash_Call) Return(_a0 *auth.PlayerSession, _a1 error) *MockPlayerSessionRepository_GetByRefreshTokenHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_GetByRefreshTokenHash_Call) RunAndReturn(run func(context.Context, string) (*auth.PlayerSession, error)) *MockPlayerSessionRepository_GetByRefreshTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByTokenHash provides a mock function with given fields: ctx, tokenHash
 func (_m *MockPlayerSessionRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*auth.PlayerSession, error) {
 	ret := _m.Called(ctx, tokenHash)
@@ -628,6 +687,150 @@ func (_c *MockPlayerSessionRepository_RefreshTTL_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// RotateTokens provides a mock function with given fields: ctx, session, presentedRefreshHash
+func (_m *MockPlayerSessionRepository) RotateTokens(ctx context.Context, session *auth.PlayerSession, presentedRefreshHash string) error {
+	ret := _m.Called(ctx, session, presentedRefreshHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *auth.PlayerSession, string) error); ok {
+		r0 = rf(ctx, session, presentedRefreshHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlayerSessionRepository_RotateTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateTokens'
+type MockPlayerSessionRepository_RotateTokens_Call struct {
+	*mock.Call
+}
+
+// RotateTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *auth.PlayerSession
+//   - presentedRefreshHash string
+func (_e *MockPlayerSessionRepository_Expecter) RotateTokens(ctx interface{}, session interface{}, presentedRefreshHash interface{}) *MockPlayerSessionRepository_RotateTokens_Call {
+	return &MockPlayerSessionRepository_RotateTokens_Call{Call: _e.mock.On("RotateTokens", ctx, session, presentedRefreshHash)}
+}
+
+func (_c *MockPlayerSessionRepository_RotateTokens_Call) Run(run func(ctx context.Context, session *auth.PlayerSession, presentedRefreshHash string)) *MockPlayerSessionRepository_RotateTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*auth.PlayerSession), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_RotateTokens_Call) Return(_a0 error) *MockPlayerSessionRepository_RotateTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_RotateTokens_Call) RunAndReturn(run func(context.Context, *auth.PlayerSession, string) error) *MockPlayerSessionRepository_RotateTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDeviceLabel provides a mock function with given fields: ctx, id, label
+func (_m *MockPlayerSessionRepository) SetDeviceLabel(ctx context.Context, id ulid.ULID, label string) error {
+	ret := _m.Called(ctx, id, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDeviceLabel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, string) error); ok {
+		r0 = rf(ctx, id, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlayerSessionRepository_SetDeviceLabel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDeviceLabel'
+type MockPlayerSessionRepository_SetDeviceLabel_Call struct {
+	*mock.Call
+}
+
+// SetDeviceLabel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id ulid.ULID
+//   - label string
+func (_e *MockPlayerSessionRepository_Expecter) SetDeviceLabel(ctx interface{}, id interface{}, label interface{}) *MockPlayerSessionRepository_SetDeviceLabel_Call {
+	return &MockPlayerSessionRepository_SetDeviceLabel_Call{Call: _e.mock.On("SetDeviceLabel", ctx, id, label)}
+}
+
+func (_c *MockPlayerSessionRepository_SetDeviceLabel_Call) Run(run func(ctx context.Context, id ulid.ULID, label string)) *MockPlayerSessionRepository_SetDeviceLabel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_SetDeviceLabel_Call) Return(_a0 error) *MockPlayerSessionRepository_SetDeviceLabel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_SetDeviceLabel_Call) RunAndReturn(run func(context.Context, ulid.ULID, string) error) *MockPlayerSessionRepository_SetDeviceLabel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTokenHash provides a mock function with given fields: ctx, id, tokenHash
+func (_m *MockPlayerSessionRepository) SetTokenHash(ctx context.Context, id ulid.ULID, tokenHash string) error {
+	ret := _m.Called(ctx, id, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTokenHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, string) error); ok {
+		r0 = rf(ctx, id, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPlayerSessionRepository_SetTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTokenHash'
+type MockPlayerSessionRepository_SetTokenHash_Call struct {
+	*mock.Call
+}
+
+// SetTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id ulid.ULID
+//   - tokenHash string
+func (_e *MockPlayerSessionRepository_Expecter) SetTokenHash(ctx interface{}, id interface{}, tokenHash interface{}) *MockPlayerSessionRepository_SetTokenHash_Call {
+	return &MockPlayerSessionRepository_SetTokenHash_Call{Call: _e.mock.On("SetTokenHash", ctx, id, tokenHash)}
+}
+
+func (_c *MockPlayerSessionRepository_SetTokenHash_Call) Run(run func(ctx context.Context, id ulid.ULID, tokenHash string)) *MockPlayerSessionRepository_SetTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_SetTokenHash_Call) Return(_a0 error) *MockPlayerSessionRepository_SetTokenHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPlayerSessionRepository_SetTokenHash_Call) RunAndReturn(run func(context.Context, ulid.ULID, string) error) *MockPlayerSessionRepository_SetTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockPlayerSessionRepository creates a new instance of MockPlayerSessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockPlayerSessionRepository(t interface {
@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+const (
+	// keyRefreshInterval is the minimum gap between key set fetches for one
+	// provider, so tokens naming unknown key IDs cannot make the server hammer
+	// the provider.
+	keyRefreshInterval = time.Minute
+
+	// maxKeySetBytes bounds a key set or discovery document response.
+	maxKeySetBytes = 1 << 20
+)
+
+// provider is one configured issuer and its cached signing keys.
+type provider struct {
+	cfg    ProviderConfig
+	client *http.Client
+
+	mu        sync.Mutex
+	jwksURL   string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	// fetching is closed when the key set fetch in flight finishes; nil
+	// when none is. The fetch itself runs without mu held.
+	fetching chan struct{}
+}
+
+func newProvider(cfg ProviderConfig, client *http.Client) *provider {
+	return &provider{cfg: cfg, client: client, jwksURL: cfg.JWKSURL}
+}
+
+// key returns the signing key with kid, fetching the provider's key set when
+// kid is not cached. A token without a kid is accepted only when the key set
+// has exactly one key. Callers that arrive while a fetch is in flight wait
+// for it rather than starting another.
+func (p *provider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	if key, ok := p.lookup(kid); ok {
+		p.mu.Unlock()
+		return key, nil
+	}
+	if wait := p.fetching; wait != nil {
+		p.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, oops.Code("OIDC_KEYS_UNAVAILABLE").With("provider", p.cfg.Name).Wrap(ctx.Err())
+		}
+		return p.cached(kid)
+	}
+	if !p.fetchedAt.IsZero() && time.Since(p.fetchedAt) < keyRefreshInterval {
+		p.mu.Unlock()
+		return nil, p.unknownKey(kid)
+	}
+	done := make(chan struct{})
+	p.fetching = done
+	p.fetchedAt = time.Now()
+	jwksURL := p.jwksURL
+	p.mu.Unlock()
+
+	keys, jwksURL, err := p.fetchKeys(ctx, jwksURL)
+
+	p.mu.Lock()
+	if err == nil {
+		p.keys, p.jwksURL = keys, jwksURL
+	}
+	p.fetching = nil
+	close(done)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return p.cached(kid)
+}
+
+// cached returns the signing key with kid from the cached key set.
+func (p *provider) cached(kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, p.unknownKey(kid)
+}
+
+func (p *provider) unknownKey(kid string) error {
+	return oops.Code("OIDC_TOKEN_INVALID").
+		With("provider", p.cfg.Name).
+		With("kid", kid).
+		Errorf("id token signing key is unknown")
+}
+
+// lookup finds kid in the cached key set. p.mu must be held.
+func (p *provider) lookup(kid string) (crypto.PublicKey, bool) {
+	if kid == "" {
+		if len(p.keys) != 1 {
+			return nil, false
+		}
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// fetchKeys downloads the provider's key set, first discovering its URL
+// when jwksURL is empty. It returns the keys and the URL they came from.
+// It does not touch the cache, so it runs without p.mu held.
+func (p *provider) fetchKeys(ctx context.Context, jwksURL string) (map[string]crypto.PublicKey, string, error) {
+	if jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		url := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := p.fetchJSON(ctx, url, &discovery); err != nil {
+			return nil, "", err
+		}
+		if discovery.JWKSURI == "" {
+			return nil, "", oops.Code("OIDC_KEYS_UNAVAILABLE").
+				With("provider", p.cfg.Name).
+				Errorf("provider discovery document has no jwks_uri")
+		}
+		jwksURL = discovery.JWKSURI
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.fetchJSON(ctx, jwksURL, &set); err != nil {
+		return nil, "", err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			// Skip keys of types this verifier does not accept rather than
+			// failing the whole set.
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, jwksURL, nil
+}
+
+func (p *provider) fetchJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return oops.Code("OIDC_KEYS_UNAVAILABLE").With("provider", p.cfg.Name).With("url", url).Wrap(err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return oops.Code("OIDC_KEYS_UNAVAILABLE").With("provider", p.cfg.Name).With("url", url).Wrap(err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // read-only response body
+	if resp.StatusCode != http.StatusOK {
+		return oops.Code("OIDC_KEYS_UNAVAILABLE").
+			With("provider", p.cfg.Name).
+			With("url", url).
+			With("status", resp.StatusCode).
+			Errorf("fetching provider keys returned %s", resp.Status)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxKeySetBytes)).Decode(v); err != nil {
+		return oops.Code("OIDC_KEYS_UNAVAILABLE").With("provider", p.cfg.Name).With("url", url).Wrap(err)
+	}
+	return nil
+}
+
+// jsonWebKey is the subset of RFC 7517 needed for RSA and P-256 keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return nil, oops.Errorf("rsa exponent out of range")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, oops.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		if len(x) != 32 || len(y) != 32 {
+			return nil, oops.Errorf("malformed P-256 coordinates")
+		}
+		// ParseUncompressedPublicKey rejects points not on the curve.
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		return key, nil
+	}
+	return nil, oops.Errorf("unsupported key type %q", k.Kty)
+}
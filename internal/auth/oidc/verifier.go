@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package oidc verifies OpenID Connect ID tokens from configured identity
+// providers (Google, or any issuer publishing a JWKS) for auth.Service's
+// OIDC sign-in. Only the signed-token checks live here; account linking is
+// in package auth.
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+)
+
+// clockSkew is the leeway applied to exp, nbf, and iat.
+const clockSkew = time.Minute
+
+// ProviderConfig describes one trusted identity provider.
+type ProviderConfig struct {
+	// Name labels linked identities ("google", "discord"). It MUST stay
+	// stable: renaming a provider orphans its links.
+	Name string
+	// Issuer is the exact iss claim the provider's tokens carry.
+	Issuer string
+	// ClientID is this server's OAuth client ID; tokens must be issued to it.
+	ClientID string
+	// JWKSURL is where the provider publishes its signing keys. When empty,
+	// it is discovered from the issuer's /.well-known/openid-configuration.
+	JWKSURL string
+}
+
+// Verifier checks ID tokens against a fixed set of providers, matched by
+// their iss claim. It implements auth.IDTokenVerifier.
+type Verifier struct {
+	providers map[string]*provider
+	now       func() time.Time
+}
+
+var _ auth.IDTokenVerifier = (*Verifier)(nil)
+
+// NewVerifier creates a Verifier for providers. A nil client uses one with
+// a 10s timeout.
+func NewVerifier(providers []ProviderConfig, client *http.Client) (*Verifier, error) {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	v := &Verifier{providers: make(map[string]*provider, len(providers)), now: time.Now}
+	names := make(map[string]bool, len(providers))
+	for _, cfg := range providers {
+		if cfg.Name == "" || cfg.Issuer == "" || cfg.ClientID == "" {
+			return nil, oops.Code("OIDC_CONFIG_INVALID").
+				With("provider", cfg.Name).
+				Errorf("oidc provider needs a name, issuer, and client_id")
+		}
+		if names[cfg.Name] || v.providers[cfg.Issuer] != nil {
+			return nil, oops.Code("OIDC_CONFIG_INVALID").
+				With("provider", cfg.Name).
+				Errorf("oidc provider %q or its issuer is configured twice", cfg.Name)
+		}
+		names[cfg.Name] = true
+		v.providers[cfg.Issuer] = newProvider(cfg, client)
+	}
+	return v, nil
+}
+
+// tokenHeader is the JOSE header of a JWS.
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// claims are the ID token claims the verifier reads.
+type claims struct {
+	Issuer        string    `json:"iss"`
+	Subject       string    `json:"sub"`
+	Audience      audience  `json:"aud"`
+	AuthorizedBy  string    `json:"azp"`
+	Expiry        numericTS `json:"exp"`
+	IssuedAt      numericTS `json:"iat"`
+	NotBefore     numericTS `json:"nbf"`
+	Email         string    `json:"email"`
+	EmailVerified flexBool  `json:"email_verified"`
+}
+
+// VerifyIDToken checks rawToken's signature against its issuer's published
+// keys, then its audience and validity window, and returns the identity it
+// asserts.
+func (v *Verifier) VerifyIDToken(ctx context.Context, rawToken string) (*auth.OIDCIdentity, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 {
+		return nil, oops.Code("OIDC_TOKEN_MALFORMED").Errorf("id token is not a signed JWT")
+	}
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, oops.Code("OIDC_TOKEN_MALFORMED").With("segment", "header").Wrap(err)
+	}
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return nil, oops.Code("OIDC_TOKEN_MALFORMED").With("segment", "claims").Wrap(err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, oops.Code("OIDC_TOKEN_MALFORMED").With("segment", "signature").Wrap(err)
+	}
+
+	// The issuer is read before the signature is checked only to pick whose
+	// keys to check it with; an unknown issuer is rejected outright.
+	p, ok := v.providers[c.Issuer]
+	if !ok {
+		return nil, oops.Code("OIDC_UNKNOWN_ISSUER").
+			With("issuer", c.Issuer).
+			Errorf("id token issuer is not a configured provider")
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+	if err := p.checkClaims(c, v.now()); err != nil {
+		return nil, err
+	}
+	return &auth.OIDCIdentity{
+		Provider:      p.cfg.Name,
+		Subject:       c.Subject,
+		Email:         c.Email,
+		EmailVerified: bool(c.EmailVerified),
+	}, nil
+}
+
+func (p *provider) checkClaims(c claims, now time.Time) error {
+	if c.Subject == "" {
+		return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token has no subject")
+	}
+	if !c.Audience.contains(p.cfg.ClientID) {
+		return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token was not issued to this server")
+	}
+	if len(c.Audience) > 1 && c.AuthorizedBy != p.cfg.ClientID {
+		return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token azp does not match this server")
+	}
+	if c.Expiry.IsZero() || !now.Before(c.Expiry.Add(clockSkew)) {
+		return oops.Code("OIDC_TOKEN_EXPIRED").Errorf("id token has expired")
+	}
+	if !c.NotBefore.IsZero() && now.Add(clockSkew).Before(c.NotBefore.Time) {
+		return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token is not yet valid")
+	}
+	if !c.IssuedAt.IsZero() && now.Add(clockSkew).Before(c.IssuedAt.Time) {
+		return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token was issued in the future")
+	}
+	return nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signingInput))
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if ok && rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) == nil {
+			return nil
+		}
+	case "ES256":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if ok && len(signature) == 64 {
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+			if ecdsa.Verify(pub, digest[:], r, s) {
+				return nil
+			}
+		}
+	default:
+		return oops.Code("OIDC_TOKEN_INVALID").
+			With("alg", alg).
+			Errorf("id token algorithm %q is not accepted", alg)
+	}
+	return oops.Code("OIDC_TOKEN_INVALID").Errorf("id token signature is invalid")
+}
+
+func decodeSegment(segment string, v any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err //nolint:wrapcheck // callers wrap with the segment name
+	}
+	return json.Unmarshal(raw, v) //nolint:wrapcheck // callers wrap with the segment name
+}
+
+// audience accepts the aud claim as a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, (*[]string)(a)) //nolint:wrapcheck // surfaced by decodeSegment's caller
+	}
+	var single string
+	if err := json.Unmarshal(data, &single); err != nil {
+		return err //nolint:wrapcheck // surfaced by decodeSegment's caller
+	}
+	*a = audience{single}
+	return nil
+}
+
+func (a audience) contains(clientID string) bool {
+	for _, aud := range a {
+		if aud == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// numericTS is a JWT NumericDate (seconds since the epoch).
+type numericTS struct{ time.Time }
+
+func (n *numericTS) UnmarshalJSON(data []byte) error {
+	var seconds json.Number
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err //nolint:wrapcheck // surfaced by decodeSegment's caller
+	}
+	f, err := seconds.Float64()
+	if err != nil {
+		return err //nolint:wrapcheck // surfaced by decodeSegment's caller
+	}
+	n.Time = time.Unix(int64(f), 0)
+	return nil
+}
+
+// flexBool accepts email_verified as a JSON boolean or the string "true",
+// which some providers send.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err //nolint:wrapcheck // surfaced by decodeSegment's caller
+	}
+	switch t := v.(type) {
+	case bool:
+		*b = flexBool(t)
+	case string:
+		*b = flexBool(t == "true")
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+const (
+	testIssuer   = "https://accounts.example.com"
+	testClientID = "holomush-client"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type testKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return testKeys{rsa: rsaKey, ec: ecKey}
+}
+
+func b64(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+
+// serveJWKS publishes keys and counts fetches.
+func serveJWKS(t *testing.T, keys testKeys) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var fetches atomic.Int32
+	ecPub, err := keys.ec.PublicKey.Bytes()
+	require.NoError(t, err)
+	set := map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": b64(keys.rsa.N.Bytes()), "e": b64(big.NewInt(int64(keys.rsa.E)).Bytes())},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": b64(ecPub[1:33]), "y": b64(ecPub[33:])},
+		{"kty": "oct", "kid": "ignored", "k": "c2VjcmV0"},
+	}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &fetches
+}
+
+func sign(t *testing.T, keys testKeys, alg, kid string, claims map[string]any) string {
+	t.Helper()
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	input := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(input))
+
+	var sig []byte
+	switch alg {
+	case "RS256":
+		sig, err = rsa.SignPKCS1v15(rand.Reader, keys.rsa, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, keys.ec, digest[:])
+		require.NoError(t, err)
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	return input + "." + b64(sig)
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"iss":            testIssuer,
+		"sub":            "user-123",
+		"aud":            testClientID,
+		"exp":            testNow.Add(time.Hour).Unix(),
+		"iat":            testNow.Add(-time.Minute).Unix(),
+		"email":          "player@example.com",
+		"email_verified": true,
+	}
+}
+
+func newTestVerifier(t *testing.T, jwksURL string) *Verifier {
+	t.Helper()
+	v, err := NewVerifier([]ProviderConfig{{
+		Name: "example", Issuer: testIssuer, ClientID: testClientID, JWKSURL: jwksURL,
+	}}, nil)
+	require.NoError(t, err)
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+func TestVerifyIDTokenAcceptsValidTokens(t *testing.T) {
+	keys := newTestKeys(t)
+	srv, fetches := serveJWKS(t, keys)
+	v := newTestVerifier(t, srv.URL)
+
+	for _, tc := range []struct{ alg, kid string }{{"RS256", "rsa-1"}, {"ES256", "ec-1"}} {
+		t.Run(tc.alg, func(t *testing.T) {
+			identity, err := v.VerifyIDToken(context.Background(), sign(t, keys, tc.alg, tc.kid, validClaims()))
+			require.NoError(t, err)
+			assert.Equal(t, "example", identity.Provider)
+			assert.Equal(t, "user-123", identity.Subject)
+			assert.Equal(t, "player@example.com", identity.Email)
+			assert.True(t, identity.EmailVerified)
+		})
+	}
+	assert.Equal(t, int32(1), fetches.Load(), "the key set is cached")
+}
+
+func TestVerifyIDTokenRejectsBadTokens(t *testing.T) {
+	keys := newTestKeys(t)
+	srv, _ := serveJWKS(t, keys)
+	v := newTestVerifier(t, srv.URL)
+	other := newTestKeys(t)
+
+	with := func(key string, value any) map[string]any {
+		c := validClaims()
+		c[key] = value
+		return c
+	}
+	tests := []struct {
+		name  string
+		token string
+		code  string
+	}{
+		{"not a jwt", "abc.def", "OIDC_TOKEN_MALFORMED"},
+		{"unknown issuer", sign(t, keys, "RS256", "rsa-1", with("iss", "https://evil.example.com")), "OIDC_UNKNOWN_ISSUER"},
+		{"wrong key", sign(t, other, "RS256", "rsa-1", validClaims()), "OIDC_TOKEN_INVALID"},
+		{"unknown kid", sign(t, keys, "RS256", "missing", validClaims()), "OIDC_TOKEN_INVALID"},
+		{"alg none", sign(t, keys, "none", "rsa-1", validClaims()), "OIDC_TOKEN_INVALID"},
+		{"alg mismatched with key", sign(t, keys, "ES256", "rsa-1", validClaims()), "OIDC_TOKEN_INVALID"},
+		{"other audience", sign(t, keys, "RS256", "rsa-1", with("aud", "someone-else")), "OIDC_TOKEN_INVALID"},
+		{"multiple audiences without azp", sign(t, keys, "RS256", "rsa-1", with("aud", []string{testClientID, "other"})), "OIDC_TOKEN_INVALID"},
+		{"expired", sign(t, keys, "RS256", "rsa-1", with("exp", testNow.Add(-time.Hour).Unix())), "OIDC_TOKEN_EXPIRED"},
+		{"not yet valid", sign(t, keys, "RS256", "rsa-1", with("nbf", testNow.Add(time.Hour).Unix())), "OIDC_TOKEN_INVALID"},
+		{"no subject", sign(t, keys, "RS256", "rsa-1", with("sub", "")), "OIDC_TOKEN_INVALID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.VerifyIDToken(context.Background(), tt.token)
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestVerifyIDTokenAcceptsAudienceArrayWithAZP(t *testing.T) {
+	keys := newTestKeys(t)
+	srv, _ := serveJWKS(t, keys)
+	v := newTestVerifier(t, srv.URL)
+
+	claims := validClaims()
+	claims["aud"] = []string{testClientID, "other"}
+	claims["azp"] = testClientID
+	claims["email_verified"] = "true"
+	identity, err := v.VerifyIDToken(context.Background(), sign(t, keys, "RS256", "rsa-1", claims))
+	require.NoError(t, err)
+	assert.True(t, identity.EmailVerified)
+}
+
+func TestVerifyIDTokenDiscoversKeySet(t *testing.T) {
+	keys := newTestKeys(t)
+	jwks, _ := serveJWKS(t, keys)
+	discovery := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/.well-known/openid-configuration", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(map[string]string{"jwks_uri": jwks.URL})
+	}))
+	t.Cleanup(discovery.Close)
+
+	v, err := NewVerifier([]ProviderConfig{{Name: "example", Issuer: discovery.URL, ClientID: testClientID}}, nil)
+	require.NoError(t, err)
+	v.now = func() time.Time { return testNow }
+
+	claims := validClaims()
+	claims["iss"] = discovery.URL
+	_, err = v.VerifyIDToken(context.Background(), sign(t, keys, "ES256", "ec-1", claims))
+	require.NoError(t, err)
+}
+
+func TestVerifyIDTokenThrottlesKeyRefresh(t *testing.T) {
+	keys := newTestKeys(t)
+	srv, fetches := serveJWKS(t, keys)
+	v := newTestVerifier(t, srv.URL)
+
+	for range 3 {
+		_, err := v.VerifyIDToken(context.Background(), sign(t, keys, "RS256", "rotated", validClaims()))
+		errutil.AssertErrorCode(t, err, "OIDC_TOKEN_INVALID")
+	}
+	assert.Equal(t, int32(1), fetches.Load())
+}
+
+func TestVerifyIDTokenFetchesKeysWithoutHoldingTheLock(t *testing.T) {
+	keys := newTestKeys(t)
+	jwks, fetches := serveJWKS(t, keys)
+	started, release := make(chan struct{}), make(chan struct{})
+	var once atomic.Bool
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if once.CompareAndSwap(false, true) {
+			close(started)
+		}
+		<-release
+		jwks.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(slow.Close)
+	v := newTestVerifier(t, slow.URL)
+
+	token := sign(t, keys, "RS256", "rsa-1", validClaims())
+	const callers = 4
+	errs := make(chan error, callers)
+	for range callers {
+		go func() {
+			_, err := v.VerifyIDToken(context.Background(), token)
+			errs <- err
+		}()
+	}
+	<-started
+	p := v.providers[testIssuer]
+	require.True(t, p.mu.TryLock(), "the key set fetch must not hold the provider lock")
+	p.mu.Unlock()
+	close(release)
+
+	for range callers {
+		require.NoError(t, <-errs)
+	}
+	assert.Equal(t, int32(1), fetches.Load(), "concurrent callers share one fetch")
+}
+
+func TestNewVerifierRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		providers []ProviderConfig
+	}{
+		{"missing client id", []ProviderConfig{{Name: "google", Issuer: testIssuer}}},
+		{"duplicate name", []ProviderConfig{
+			{Name: "google", Issuer: testIssuer, ClientID: "a"},
+			{Name: "google", Issuer: "https://other.example.com", ClientID: "b"},
+		}},
+		{"duplicate issuer", []ProviderConfig{
+			{Name: "google", Issuer: testIssuer, ClientID: "a"},
+			{Name: "other", Issuer: testIssuer, ClientID: "b"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVerifier(tt.providers, nil)
+			errutil.AssertErrorCode(t, err, "OIDC_CONFIG_INVALID")
+		})
+	}
+}
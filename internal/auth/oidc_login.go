@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// SetOIDC enables sign-in with linked OpenID Connect identities. Passing a
+// nil verifier or repository disables it; the OIDC methods then return
+// AUTH_OIDC_DISABLED.
+func (s *Service) SetOIDC(verifier IDTokenVerifier, identities IdentityRepository, policy OIDCPolicy) {
+	if verifier == nil || identities == nil {
+		s.oidcVerifier, s.identities = nil, nil
+		return
+	}
+	s.oidcVerifier = verifier
+	s.identities = identities
+	s.oidcPolicy = policy
+}
+
+func (s *Service) oidcEnabled() error {
+	if s.oidcVerifier == nil || s.identities == nil {
+		return oops.Code("AUTH_OIDC_DISABLED").Errorf("OIDC sign-in is not configured")
+	}
+	return nil
+}
+
+// LoginWithOIDC signs in the player linked to the identity rawIDToken
+// asserts and creates a PlayerSession, exactly as AuthenticatePlayer does
+// for a password login. Bans, lockouts, and the session cap apply. The
+// identity must already be linked with LinkIdentity: an unknown identity
+// never creates an account.
+//
+// Returns the raw session token and the Player on success.
+func (s *Service) LoginWithOIDC(ctx context.Context, rawIDToken, userAgent, ipAddress string) (string, *Player, error) {
+	if err := s.oidcEnabled(); err != nil {
+		return "", nil, err
+	}
+	origin := SecurityOrigin{IPAddress: ipAddress, UserAgent: userAgent}
+	if err := s.checkLoginAddress(ctx, ipAddress); err != nil {
+		return "", nil, err
+	}
+
+	identity, err := s.verifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return "", nil, err
+	}
+	link, err := s.identities.Get(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return "", nil, oops.Code("AUTH_OIDC_NOT_LINKED").
+				With("provider", identity.Provider).
+				Errorf("this %s account is not linked to a player", identity.Provider)
+		}
+		return "", nil, oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "get linked identity").
+			Wrap(err)
+	}
+	player, err := s.players.GetByID(ctx, link.PlayerID)
+	if err != nil {
+		return "", nil, oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "get player by id").
+			With("player_id", link.PlayerID.String()).
+			Wrap(err)
+	}
+
+	if player.IsLocked() {
+		s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "account locked")
+		return "", nil, oops.Code("AUTH_ACCOUNT_LOCKED").
+			With("locked_until", player.LockedUntil).
+			Errorf("account is temporarily locked")
+	}
+	if err := s.checkLoginPlayer(ctx, player, origin); err != nil {
+		return "", nil, err
+	}
+	if s.oidcPolicy.RequirePasswordFallback && player.PasswordHash == "" {
+		s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "oidc requires a local password")
+		return "", nil, oops.Code("AUTH_OIDC_PASSWORD_REQUIRED").
+			Errorf("this server requires a local password before signing in with %s", identity.Provider)
+	}
+
+	player.RecordSuccess()
+	if err := s.players.Update(ctx, player); err != nil {
+		s.logger.WarnContext(
+			ctx,
+			"best-effort player update failed",
+			"event", "player_update_failed",
+			"player_id", player.ID.String(),
+			"operation", "record_success",
+			"error", err.Error(),
+		)
+	}
+	s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginSucceeded, origin, "oidc:"+identity.Provider)
+
+	rawToken, err := s.startSession(ctx, player, userAgent, ipAddress, origin)
+	if err != nil {
+		return "", nil, err
+	}
+	return rawToken, player, nil
+}
+
+// LinkIdentity links the identity rawIDToken asserts to playerID. The caller
+// MUST have authenticated playerID (an active session), and password must be
+// the player's current password: a session alone is not enough to add a
+// sign-in method. An account without a password must set one first. The
+// link is recorded in the player's security log.
+func (s *Service) LinkIdentity(ctx context.Context, playerID ulid.ULID, rawIDToken, password string, origin SecurityOrigin) (*LinkedIdentity, error) {
+	if err := s.oidcEnabled(); err != nil {
+		return nil, err
+	}
+	player, err := s.players.GetByID(ctx, playerID)
+	if err != nil {
+		return nil, oops.Code("AUTH_OIDC_LINK_FAILED").
+			With("operation", "get player by id").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	if player.IsGuest {
+		return nil, oops.Code("AUTH_OIDC_INVALID").Errorf("guest accounts cannot link an identity")
+	}
+	if err := s.verifyLinkPassword(ctx, player, password, origin); err != nil {
+		return nil, err
+	}
+
+	identity, err := s.verifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+	link, err := NewLinkedIdentity(player.ID, identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.identities.Create(ctx, link); err != nil {
+		if errors.Is(err, ErrDuplicateIdentity) {
+			return nil, oops.Code("AUTH_OIDC_ALREADY_LINKED").
+				With("provider", link.Provider).
+				Wrap(err)
+		}
+		return nil, oops.Code("AUTH_OIDC_LINK_FAILED").
+			With("operation", "create linked identity").
+			Wrap(err)
+	}
+
+	s.logger.InfoContext(
+		ctx, "oidc identity linked",
+		"event", "oidc_identity_linked",
+		"player_id", player.ID.String(),
+		"provider", link.Provider,
+	)
+	s.recordSecurityEvent(ctx, player.ID, SecurityEventIdentityLinked, origin, "oidc:"+link.Provider)
+	return link, nil
+}
+
+// UnlinkIdentity removes playerID's link to provider. Without the password
+// fallback policy, the last identity of an account that has no password is
+// kept so the account stays reachable. The removal is recorded in the
+// player's security log.
+func (s *Service) UnlinkIdentity(ctx context.Context, playerID ulid.ULID, provider string, origin SecurityOrigin) error {
+	if err := s.oidcEnabled(); err != nil {
+		return err
+	}
+	if !s.oidcPolicy.RequirePasswordFallback {
+		if err := s.checkNotLastLogin(ctx, playerID); err != nil {
+			return err
+		}
+	}
+	if err := s.identities.Delete(ctx, playerID, provider); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code("AUTH_OIDC_NOT_LINKED").
+				With("provider", provider).
+				Errorf("no %s account is linked", provider)
+		}
+		return oops.Code("AUTH_OIDC_UNLINK_FAILED").
+			With("operation", "delete linked identity").
+			Wrap(err)
+	}
+
+	s.logger.InfoContext(
+		ctx, "oidc identity unlinked",
+		"event", "oidc_identity_unlinked",
+		"player_id", playerID.String(),
+		"provider", provider,
+	)
+	s.recordSecurityEvent(ctx, playerID, SecurityEventIdentityUnlinked, origin, "oidc:"+provider)
+	return nil
+}
+
+// ListIdentities returns the identities linked to playerID, oldest first.
+func (s *Service) ListIdentities(ctx context.Context, playerID ulid.ULID) ([]*LinkedIdentity, error) {
+	if err := s.oidcEnabled(); err != nil {
+		return nil, err
+	}
+	links, err := s.identities.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, oops.Code("AUTH_OIDC_LIST_FAILED").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return links, nil
+}
+
+func (s *Service) verifyIDToken(ctx context.Context, rawIDToken string) (*OIDCIdentity, error) {
+	identity, err := s.oidcVerifier.VerifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		s.logger.InfoContext(ctx, "oidc id token rejected",
+			"event", "oidc_token_rejected",
+			"error", err.Error())
+		return nil, oops.Code("AUTH_OIDC_INVALID_TOKEN").Wrap(err)
+	}
+	return identity, nil
+}
+
+// verifyLinkPassword checks password against player's local password.
+// A wrong password counts toward the account lockout exactly as a failed
+// AuthenticatePlayer does, so linking cannot be used to guess passwords
+// without limit, and a locked account cannot link.
+func (s *Service) verifyLinkPassword(ctx context.Context, player *Player, password string, origin SecurityOrigin) error {
+	if player.PasswordHash == "" {
+		return oops.Code("AUTH_OIDC_PASSWORD_REQUIRED").
+			Errorf("this server requires a local password before linking an identity")
+	}
+	valid := false
+	if len(password) <= MaxPasswordLength {
+		var err error
+		valid, err = s.hasher.Verify(password, player.PasswordHash)
+		if err != nil {
+			return oops.Code("AUTH_OIDC_LINK_FAILED").
+				With("operation", "verify password").
+				Wrap(err)
+		}
+	}
+	if !valid {
+		player.RecordFailure()
+		if err := s.players.Update(ctx, player); err != nil {
+			s.logger.WarnContext(
+				ctx,
+				"best-effort player update failed",
+				"event", "player_update_failed",
+				"player_id", player.ID.String(),
+				"operation", "record_failure",
+				"error", err.Error(),
+			)
+		}
+		s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "invalid password (identity link)")
+		return oops.Code("AUTH_INVALID_CREDENTIALS").Errorf("invalid password")
+	}
+	// Checked after verification, as in AuthenticatePlayer, to keep the
+	// timing of locked and unlocked accounts alike.
+	if player.IsLocked() {
+		return oops.Code("AUTH_ACCOUNT_LOCKED").
+			With("locked_until", player.LockedUntil).
+			Errorf("account is temporarily locked")
+	}
+	return nil
+}
+
+// checkNotLastLogin refuses to remove the only way into an account that has
+// no local password.
+func (s *Service) checkNotLastLogin(ctx context.Context, playerID ulid.ULID) error {
+	player, err := s.players.GetByID(ctx, playerID)
+	if err != nil {
+		return oops.Code("AUTH_OIDC_UNLINK_FAILED").
+			With("operation", "get player by id").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	if player.PasswordHash != "" {
+		return nil
+	}
+	links, err := s.identities.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return oops.Code("AUTH_OIDC_UNLINK_FAILED").
+			With("operation", "list linked identities").
+			Wrap(err)
+	}
+	if len(links) <= 1 {
+		return oops.Code("AUTH_OIDC_LAST_LOGIN").
+			Errorf("set a password before unlinking your only sign-in method")
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubVerifier accepts tokens it was told about.
+type stubVerifier map[string]*auth.OIDCIdentity
+
+func (v stubVerifier) VerifyIDToken(_ context.Context, rawToken string) (*auth.OIDCIdentity, error) {
+	identity, ok := v[rawToken]
+	if !ok {
+		return nil, errors.New("bad signature")
+	}
+	return identity, nil
+}
+
+// memIdentities is an in-memory auth.IdentityRepository with the
+// database's uniqueness rules.
+type memIdentities struct {
+	mu    sync.Mutex
+	links []*auth.LinkedIdentity
+}
+
+func (m *memIdentities) Create(_ context.Context, identity *auth.LinkedIdentity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range m.links {
+		if (l.Provider == identity.Provider && l.Subject == identity.Subject) ||
+			(l.PlayerID == identity.PlayerID && l.Provider == identity.Provider) {
+			return auth.ErrDuplicateIdentity
+		}
+	}
+	m.links = append(m.links, identity)
+	return nil
+}
+
+func (m *memIdentities) Get(_ context.Context, provider, subject string) (*auth.LinkedIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range m.links {
+		if l.Provider == provider && l.Subject == subject {
+			return l, nil
+		}
+	}
+	return nil, auth.ErrNotFound
+}
+
+func (m *memIdentities) ListByPlayer(_ context.Context, playerID ulid.ULID) ([]*auth.LinkedIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*auth.LinkedIdentity
+	for _, l := range m.links {
+		if l.PlayerID == playerID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (m *memIdentities) Delete(_ context.Context, playerID ulid.ULID, provider string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := slices.IndexFunc(m.links, func(l *auth.LinkedIdentity) bool {
+		return l.PlayerID == playerID && l.Provider == provider
+	})
+	if i < 0 {
+		return auth.ErrNotFound
+	}
+	m.links = slices.Delete(m.links, i, i+1)
+	return nil
+}
+
+var googleIdentity = &auth.OIDCIdentity{Provider: "google", Subject: "g-123", Email: "alice@example.com", EmailVerified: true}
+
+func TestLoginWithOIDCStartsSessionForLinkedPlayer(t *testing.T) {
+	ctx := context.Background()
+	svc, playerRepo, sessionRepo, _ := newTestAuthServiceWithCap(t, 0)
+	log, events, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+	identities := &memIdentities{}
+	svc.SetOIDC(stubVerifier{"good": googleIdentity}, identities, auth.OIDCPolicy{})
+
+	player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash", FailedAttempts: 2}
+	link, err := auth.NewLinkedIdentity(player.ID, googleIdentity)
+	require.NoError(t, err)
+	require.NoError(t, identities.Create(ctx, link))
+
+	playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+	playerRepo.On("Update", mock.Anything, player).Return(nil)
+	sessionRepo.On("CreateWithCap", mock.Anything, mock.AnythingOfType("*auth.PlayerSession"), 0).
+		Return([]ulid.ULID(nil), nil).Once()
+
+	token, got, err := svc.LoginWithOIDC(ctx, "good", "browser", "198.51.100.7")
+	require.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, player.ID, got.ID)
+	assert.Zero(t, got.FailedAttempts)
+	require.Equal(t, []auth.SecurityEventType{auth.SecurityEventLoginSucceeded}, events.types())
+	assert.Equal(t, "oidc:google", events.events[0].Detail)
+}
+
+func TestLoginWithOIDCRejections(t *testing.T) {
+	ctx := context.Background()
+	locked := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		token  string
+		player *auth.Player
+		policy auth.OIDCPolicy
+		gate   auth.LoginGate
+		code   string
+	}{
+		{name: "invalid token", token: "forged", code: "AUTH_OIDC_INVALID_TOKEN"},
+		{name: "unlinked identity", token: "good", code: "AUTH_OIDC_NOT_LINKED"},
+		{
+			name: "locked account", token: "good",
+			player: &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash", LockedUntil: &locked},
+			code:   "AUTH_ACCOUNT_LOCKED",
+		},
+		{
+			name: "banned player", token: "good",
+			player: &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash"},
+			gate:   stubLoginGate{username: "alice"},
+			code:   "AUTH_LOGIN_BANNED",
+		},
+		{
+			name: "password fallback required", token: "good",
+			player: &auth.Player{ID: ulid.Make(), Username: "alice"},
+			policy: auth.OIDCPolicy{RequirePasswordFallback: true},
+			code:   "AUTH_OIDC_PASSWORD_REQUIRED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, playerRepo, _, _ := newTestAuthServiceWithCap(t, 0)
+			identities := &memIdentities{}
+			svc.SetOIDC(stubVerifier{"good": googleIdentity}, identities, tt.policy)
+			if tt.gate != nil {
+				svc.SetLoginGate(tt.gate)
+			}
+			if tt.player != nil {
+				link, err := auth.NewLinkedIdentity(tt.player.ID, googleIdentity)
+				require.NoError(t, err)
+				require.NoError(t, identities.Create(ctx, link))
+				playerRepo.On("GetByID", mock.Anything, tt.player.ID).Return(tt.player, nil)
+			}
+
+			_, _, err := svc.LoginWithOIDC(ctx, tt.token, "browser", "198.51.100.7")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestOIDCMethodsRequireConfiguration(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+
+	_, _, err := svc.LoginWithOIDC(context.Background(), "good", "", "")
+	errutil.AssertErrorCode(t, err, "AUTH_OIDC_DISABLED")
+	_, err = svc.LinkIdentity(context.Background(), ulid.Make(), "good", "", auth.SecurityOrigin{})
+	errutil.AssertErrorCode(t, err, "AUTH_OIDC_DISABLED")
+}
+
+func TestLinkIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("links with the current password", func(t *testing.T) {
+		svc, playerRepo, _, hasher := newTestAuthServiceWithCap(t, 0)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityLog(log)
+		identities := &memIdentities{}
+		svc.SetOIDC(stubVerifier{"good": googleIdentity}, identities, auth.OIDCPolicy{})
+		player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash"}
+		playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+		hasher.On("Verify", "right", "hash").Return(true, nil)
+
+		origin := auth.SecurityOrigin{IPAddress: "198.51.100.7"}
+		link, err := svc.LinkIdentity(ctx, player.ID, "good", "right", origin)
+		require.NoError(t, err)
+		assert.Equal(t, "google", link.Provider)
+		assert.Equal(t, "g-123", link.Subject)
+		require.Equal(t, []auth.SecurityEventType{auth.SecurityEventIdentityLinked}, events.types())
+		assert.Equal(t, "oidc:google", events.events[0].Detail)
+		assert.Equal(t, "198.51.100.7", events.events[0].IPAddress)
+
+		links, err := svc.ListIdentities(ctx, player.ID)
+		require.NoError(t, err)
+		assert.Len(t, links, 1)
+
+		_, err = svc.LinkIdentity(ctx, player.ID, "good", "right", origin)
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_ALREADY_LINKED")
+	})
+
+	t.Run("a session alone is not enough", func(t *testing.T) {
+		for _, policy := range []auth.OIDCPolicy{{}, {RequirePasswordFallback: true}} {
+			svc, playerRepo, _, hasher := newTestAuthServiceWithCap(t, 0)
+			svc.SetOIDC(stubVerifier{"good": googleIdentity}, &memIdentities{}, policy)
+			player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash"}
+			playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+			playerRepo.On("Update", mock.Anything, player).Return(nil).Once()
+			hasher.On("Verify", "wrong", "hash").Return(false, nil).Once()
+			hasher.On("Verify", "right", "hash").Return(true, nil).Once()
+
+			_, err := svc.LinkIdentity(ctx, player.ID, "good", "wrong", auth.SecurityOrigin{})
+			errutil.AssertErrorCode(t, err, "AUTH_INVALID_CREDENTIALS")
+			assert.Equal(t, 1, player.FailedAttempts, "a wrong password counts toward lockout")
+			_, err = svc.LinkIdentity(ctx, player.ID, "good", "right", auth.SecurityOrigin{})
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("an account without a password must set one first", func(t *testing.T) {
+		svc, playerRepo, _, _ := newTestAuthServiceWithCap(t, 0)
+		svc.SetOIDC(stubVerifier{"good": googleIdentity}, &memIdentities{}, auth.OIDCPolicy{})
+		player := &auth.Player{ID: ulid.Make(), Username: "alice"}
+		playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+
+		_, err := svc.LinkIdentity(ctx, player.ID, "good", "", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_PASSWORD_REQUIRED")
+	})
+
+	t.Run("wrong passwords lock the account", func(t *testing.T) {
+		svc, playerRepo, _, hasher := newTestAuthServiceWithCap(t, 0)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityLog(log)
+		svc.SetOIDC(stubVerifier{"good": googleIdentity}, &memIdentities{}, auth.OIDCPolicy{RequirePasswordFallback: true})
+		player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash", FailedAttempts: auth.LockoutThreshold - 1}
+		playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+		playerRepo.On("Update", mock.Anything, player).Return(nil).Once()
+		hasher.On("Verify", "wrong", "hash").Return(false, nil).Once()
+		hasher.On("Verify", "right", "hash").Return(true, nil).Once()
+
+		_, err := svc.LinkIdentity(ctx, player.ID, "good", "wrong", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_INVALID_CREDENTIALS")
+		assert.True(t, player.IsLocked())
+		assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventLoginFailed}, events.types())
+
+		_, err = svc.LinkIdentity(ctx, player.ID, "good", "right", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_ACCOUNT_LOCKED")
+	})
+
+	t.Run("guests cannot link", func(t *testing.T) {
+		svc, playerRepo, _, _ := newTestAuthServiceWithCap(t, 0)
+		svc.SetOIDC(stubVerifier{"good": googleIdentity}, &memIdentities{}, auth.OIDCPolicy{})
+		guest := &auth.Player{ID: ulid.Make(), Username: "Guest", IsGuest: true}
+		playerRepo.On("GetByID", mock.Anything, guest.ID).Return(guest, nil)
+
+		_, err := svc.LinkIdentity(ctx, guest.ID, "good", "", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_INVALID")
+	})
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes a link", func(t *testing.T) {
+		svc, playerRepo, _, _ := newTestAuthServiceWithCap(t, 0)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityLog(log)
+		identities := &memIdentities{}
+		svc.SetOIDC(stubVerifier{}, identities, auth.OIDCPolicy{})
+		player := &auth.Player{ID: ulid.Make(), Username: "alice", PasswordHash: "hash"}
+		playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+		link, err := auth.NewLinkedIdentity(player.ID, googleIdentity)
+		require.NoError(t, err)
+		require.NoError(t, identities.Create(ctx, link))
+
+		require.NoError(t, svc.UnlinkIdentity(ctx, player.ID, "google", auth.SecurityOrigin{}))
+		require.Equal(t, []auth.SecurityEventType{auth.SecurityEventIdentityUnlinked}, events.types())
+		assert.Equal(t, "oidc:google", events.events[0].Detail)
+
+		err = svc.UnlinkIdentity(ctx, player.ID, "google", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_NOT_LINKED")
+		assert.Len(t, events.types(), 1, "a failed unlink records nothing")
+	})
+
+	t.Run("keeps the only login of a passwordless account", func(t *testing.T) {
+		svc, playerRepo, _, _ := newTestAuthServiceWithCap(t, 0)
+		identities := &memIdentities{}
+		svc.SetOIDC(stubVerifier{}, identities, auth.OIDCPolicy{})
+		player := &auth.Player{ID: ulid.Make(), Username: "alice"}
+		playerRepo.On("GetByID", mock.Anything, player.ID).Return(player, nil)
+		link, err := auth.NewLinkedIdentity(player.ID, googleIdentity)
+		require.NoError(t, err)
+		require.NoError(t, identities.Create(ctx, link))
+
+		err = svc.UnlinkIdentity(ctx, player.ID, "google", auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_LAST_LOGIN")
+	})
+}
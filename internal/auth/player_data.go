@@ -0,0 +1,385 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// DefaultTombstoneName is the name a deleted player's characters take when
+// PlayerDataPolicy.TombstoneName is empty.
+const DefaultTombstoneName = "Deleted Character"
+
+// MaxAuthoredEventExport caps the authored events carried in one export.
+// An archive that hits the cap sets AuthoredEventsTruncated.
+const MaxAuthoredEventExport = 50000
+
+// deletedUsernamePrefix prefixes the username a deleted player row keeps.
+const deletedUsernamePrefix = "deleted_"
+
+// PlayerDataPolicy is the operator policy for account deletion.
+type PlayerDataPolicy struct {
+	// TombstoneName replaces the name of every character of a deleted
+	// player. Events are immutable, so the characters the player authored
+	// them as are kept as tombstone identities rather than deleted; history
+	// then reads as written by TombstoneName. It MUST be a valid character
+	// name. Empty uses DefaultTombstoneName.
+	TombstoneName string
+}
+
+// PlayerPropertyLister lists an entity's properties for the data export.
+// Satisfied by the world property repository.
+type PlayerPropertyLister interface {
+	ListByParent(ctx context.Context, parentType string, parentID ulid.ULID) ([]*world.EntityProperty, error)
+}
+
+// AuthoredEvent is one audited event written by a player or one of their
+// characters.
+type AuthoredEvent struct {
+	ID        ulid.ULID `json:"id"`
+	Subject   string    `json:"subject"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	// Payload is the event's cleartext JSON payload; nil when Encrypted.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Encrypted marks an event stored under a data encryption key, whose
+	// payload the export does not carry.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// AuthoredEventLister lists the audited events whose actor is the player or
+// one of characterIDs, oldest first. Satisfied by the postgres
+// AuthoredEventRepository.
+type AuthoredEventLister interface {
+	ListByActors(ctx context.Context, playerID ulid.ULID, characterIDs []ulid.ULID, limit int) ([]AuthoredEvent, error)
+}
+
+// CharacterAnonymizer replaces a character's identity with a tombstone.
+// Satisfied by world.Service.
+type CharacterAnonymizer interface {
+	AnonymizeCharacter(ctx context.Context, subjectID string, characterID ulid.ULID, tombstoneName string) error
+}
+
+// PlayerDataStores are the world-side dependencies of ExportPlayerData and
+// DeletePlayer.
+type PlayerDataStores struct {
+	Characters ReapingCharacterLister
+	Properties PlayerPropertyLister
+	Events     AuthoredEventLister
+	Anonymizer CharacterAnonymizer
+}
+
+// complete reports whether every store is set.
+func (p PlayerDataStores) complete() bool {
+	return p.Characters != nil && p.Properties != nil && p.Events != nil && p.Anonymizer != nil
+}
+
+// PlayerDataArchive is a machine-readable export of everything the server
+// holds about a player. It is designed to be encoded with encoding/json.
+type PlayerDataArchive struct {
+	ExportedAt     time.Time                 `json:"exported_at"`
+	Account        PlayerDataAccount         `json:"account"`
+	Identities     []PlayerDataIdentity      `json:"linked_identities"`
+	Characters     []PlayerDataCharacter     `json:"characters"`
+	SecurityEvents []PlayerDataSecurityEvent `json:"security_events"`
+	AuthoredEvents []AuthoredEvent           `json:"authored_events"`
+	// AuthoredEventsTruncated reports that AuthoredEvents stopped at
+	// MaxAuthoredEventExport.
+	AuthoredEventsTruncated bool `json:"authored_events_truncated,omitempty"`
+}
+
+// PlayerDataAccount is the account section of a PlayerDataArchive. The
+// password hash is deliberately absent.
+type PlayerDataAccount struct {
+	ID            ulid.ULID         `json:"id"`
+	Username      string            `json:"username"`
+	Email         *string           `json:"email,omitempty"`
+	EmailVerified bool              `json:"email_verified"`
+	Preferences   PlayerPreferences `json:"preferences"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// PlayerDataIdentity is a linked identity provider account.
+type PlayerDataIdentity struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"`
+	Email    string    `json:"email,omitempty"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+// PlayerDataCharacter is one of the player's characters with its properties.
+type PlayerDataCharacter struct {
+	ID          ulid.ULID            `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	CreatedAt   time.Time            `json:"created_at"`
+	Properties  []PlayerDataProperty `json:"properties"`
+}
+
+// PlayerDataProperty is a character property.
+type PlayerDataProperty struct {
+	Name       string    `json:"name"`
+	Value      *string   `json:"value,omitempty"`
+	Visibility string    `json:"visibility"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// PlayerDataSecurityEvent is an entry of the player's security event log.
+type PlayerDataSecurityEvent struct {
+	Type      SecurityEventType `json:"type"`
+	IPAddress string            `json:"ip_address,omitempty"`
+	UserAgent string            `json:"user_agent,omitempty"`
+	Detail    string            `json:"detail,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ConfigurePlayerData sets the world-side stores ExportPlayerData and
+// DeletePlayer read and write through. Called after construction, once the
+// world service exists (sub_grpc.go). If any store is nil, both operations
+// are left unconfigured and return AUTH_PLAYER_DATA_DISABLED.
+func (s *Service) ConfigurePlayerData(stores PlayerDataStores) {
+	if stores.complete() {
+		s.playerData = stores
+	}
+}
+
+// SetPlayerDataPolicy sets the account deletion policy. A TombstoneName that
+// is not a valid character name is rejected with AUTH_INVALID_PLAYER_DATA_POLICY.
+func (s *Service) SetPlayerDataPolicy(policy PlayerDataPolicy) error {
+	if policy.TombstoneName == "" {
+		policy.TombstoneName = DefaultTombstoneName
+	}
+	if err := world.ValidateCharacterName(policy.TombstoneName); err != nil {
+		return oops.Code("AUTH_INVALID_PLAYER_DATA_POLICY").
+			With("tombstone_name", policy.TombstoneName).
+			Wrap(err)
+	}
+	s.playerDataPolicy = policy
+	return nil
+}
+
+// tombstoneName is the configured tombstone name, or the default.
+func (s *Service) tombstoneName() string {
+	if s.playerDataPolicy.TombstoneName == "" {
+		return DefaultTombstoneName
+	}
+	return s.playerDataPolicy.TombstoneName
+}
+
+// ExportPlayerData assembles a PlayerDataArchive for playerID: the account
+// (without its password hash), linked identities, characters with their
+// properties, the security event log, and the events the player or their
+// characters authored. Identities and security events are included when
+// those subsystems are configured.
+func (s *Service) ExportPlayerData(ctx context.Context, playerID ulid.ULID) (*PlayerDataArchive, error) {
+	if !s.playerData.complete() {
+		return nil, oops.Code("AUTH_PLAYER_DATA_DISABLED").Errorf("player data stores are not configured")
+	}
+	player, err := s.getPlayerForData(ctx, playerID, "PLAYER_DATA_EXPORT_FAILED")
+	if err != nil {
+		return nil, err
+	}
+	archive := &PlayerDataArchive{
+		ExportedAt: time.Now().UTC(),
+		Account: PlayerDataAccount{
+			ID:            player.ID,
+			Username:      player.Username,
+			Email:         player.Email,
+			EmailVerified: player.EmailVerified,
+			Preferences:   player.Preferences,
+			CreatedAt:     player.CreatedAt,
+		},
+		Identities:     []PlayerDataIdentity{},
+		Characters:     []PlayerDataCharacter{},
+		SecurityEvents: []PlayerDataSecurityEvent{},
+		AuthoredEvents: []AuthoredEvent{},
+	}
+
+	if s.identities != nil {
+		links, err := s.identities.ListByPlayer(ctx, playerID)
+		if err != nil {
+			return nil, exportErr(playerID, "list linked identities", err)
+		}
+		for _, l := range links {
+			archive.Identities = append(archive.Identities, PlayerDataIdentity{
+				Provider: l.Provider, Subject: l.Subject, Email: l.Email, LinkedAt: l.LinkedAt,
+			})
+		}
+	}
+
+	chars, err := s.playerData.Characters.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, exportErr(playerID, "list characters", err)
+	}
+	charIDs := make([]ulid.ULID, 0, len(chars))
+	for _, c := range chars {
+		props, err := s.playerData.Properties.ListByParent(ctx, "character", c.ID)
+		if err != nil {
+			return nil, exportErr(playerID, "list character properties", err)
+		}
+		entry := PlayerDataCharacter{
+			ID: c.ID, Name: c.Name, Description: c.Description, CreatedAt: c.CreatedAt,
+			Properties: make([]PlayerDataProperty, 0, len(props)),
+		}
+		for _, p := range props {
+			entry.Properties = append(entry.Properties, PlayerDataProperty{
+				Name: p.Name, Value: p.Value, Visibility: p.Visibility, UpdatedAt: p.UpdatedAt,
+			})
+		}
+		archive.Characters = append(archive.Characters, entry)
+		charIDs = append(charIDs, c.ID)
+	}
+
+	if s.securityLog != nil {
+		events, err := s.securityLog.List(ctx, playerID, MaxSecurityEventLimit)
+		if err != nil {
+			return nil, exportErr(playerID, "list security events", err)
+		}
+		for _, e := range events {
+			archive.SecurityEvents = append(archive.SecurityEvents, PlayerDataSecurityEvent{
+				Type: e.Type, IPAddress: e.IPAddress, UserAgent: e.UserAgent, Detail: e.Detail, CreatedAt: e.CreatedAt,
+			})
+		}
+	}
+
+	authored, err := s.playerData.Events.ListByActors(ctx, playerID, charIDs, MaxAuthoredEventExport+1)
+	if err != nil {
+		return nil, exportErr(playerID, "list authored events", err)
+	}
+	if len(authored) > MaxAuthoredEventExport {
+		authored = authored[:MaxAuthoredEventExport]
+		archive.AuthoredEventsTruncated = true
+	}
+	archive.AuthoredEvents = append(archive.AuthoredEvents, authored...)
+
+	s.logger.InfoContext(ctx, "player data exported",
+		"event", "player_data_exported",
+		"player_id", playerID.String(),
+		"characters", len(archive.Characters),
+		"authored_events", len(archive.AuthoredEvents),
+	)
+	return archive, nil
+}
+
+// DeletePlayer deletes a registered player's account without breaking event
+// history. Events are immutable, so instead of hard-deleting rows that
+// events reference it:
+//
+//  1. ends every PlayerSession (cascading to game sessions);
+//  2. anonymizes each character to the policy's tombstone name, dropping its
+//     description, location, and properties;
+//  3. unlinks identity provider accounts and purges the security event log;
+//  4. scrubs the player row: a placeholder username, no email, password, or
+//     preferences.
+//
+// Historical events keep their actor IDs, which now resolve to tombstones.
+// Guests are refused with PLAYER_DELETE_GUEST; the guest reaper removes them.
+// A failure part-way leaves the earlier steps applied; calling DeletePlayer
+// again resumes.
+func (s *Service) DeletePlayer(ctx context.Context, playerID ulid.ULID) error {
+	if !s.playerData.complete() {
+		return oops.Code("AUTH_PLAYER_DATA_DISABLED").Errorf("player data stores are not configured")
+	}
+	player, err := s.getPlayerForData(ctx, playerID, "PLAYER_DELETE_FAILED")
+	if err != nil {
+		return err
+	}
+	if player.IsGuest {
+		return oops.Code("PLAYER_DELETE_GUEST").
+			With("player_id", playerID.String()).
+			Errorf("guest players are removed by the guest reaper")
+	}
+
+	if err := s.playerSessions.DeleteByPlayer(ctx, playerID); err != nil {
+		return deleteErr(playerID, "delete player sessions", err)
+	}
+
+	chars, err := s.playerData.Characters.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return deleteErr(playerID, "list characters", err)
+	}
+	tombstone := s.tombstoneName()
+	for _, c := range chars {
+		if err := s.playerData.Anonymizer.AnonymizeCharacter(ctx, reapingActor, c.ID, tombstone); err != nil {
+			return deleteErr(playerID, "anonymize character", err)
+		}
+	}
+
+	if s.identities != nil {
+		links, err := s.identities.ListByPlayer(ctx, playerID)
+		if err != nil {
+			return deleteErr(playerID, "list linked identities", err)
+		}
+		for _, l := range links {
+			if err := s.identities.Delete(ctx, playerID, l.Provider); err != nil {
+				return deleteErr(playerID, "unlink identity", err)
+			}
+		}
+	}
+	if s.securityLog != nil {
+		if err := s.securityLog.Purge(ctx, playerID); err != nil {
+			return deleteErr(playerID, "purge security events", err)
+		}
+	}
+
+	player.Username = deletedUsernamePrefix + strings.ToLower(playerID.String()[10:])
+	player.PasswordHash = ""
+	player.Email = nil
+	player.EmailVerified = false
+	player.FailedAttempts = 0
+	player.LockedUntil = nil
+	player.DefaultCharacterID = nil
+	player.Preferences = PlayerPreferences{}
+	player.UpdatedAt = time.Now().UTC()
+	if err := s.players.Update(ctx, player); err != nil {
+		return deleteErr(playerID, "scrub player", err)
+	}
+
+	s.logger.InfoContext(ctx, "player deleted",
+		"event", "player_deleted",
+		"player_id", playerID.String(),
+		"characters_anonymized", len(chars),
+	)
+	return nil
+}
+
+// getPlayerForData loads playerID, mapping a missing player to
+// PLAYER_NOT_FOUND and any other failure to failCode.
+func (s *Service) getPlayerForData(ctx context.Context, playerID ulid.ULID, failCode string) (*Player, error) {
+	player, err := s.players.GetByID(ctx, playerID)
+	if err != nil {
+		code := failCode
+		if errors.Is(err, ErrNotFound) {
+			code = "PLAYER_NOT_FOUND"
+		}
+		return nil, oops.Code(code).
+			With("operation", "get player").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return player, nil
+}
+
+func exportErr(playerID ulid.ULID, op string, err error) error {
+	return oops.Code("PLAYER_DATA_EXPORT_FAILED").
+		With("operation", op).
+		With("player_id", playerID.String()).
+		Wrap(err)
+}
+
+func deleteErr(playerID ulid.ULID, op string, err error) error {
+	return oops.Code("PLAYER_DELETE_FAILED").
+		With("operation", op).
+		With("player_id", playerID.String()).
+		Wrap(err)
+}
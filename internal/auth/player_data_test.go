@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// --- player data fakes ---
+
+type fakeDataProps map[ulid.ULID][]*world.EntityProperty
+
+func (f fakeDataProps) ListByParent(_ context.Context, parentType string, parentID ulid.ULID) ([]*world.EntityProperty, error) {
+	if parentType != "character" {
+		return nil, errors.New("unexpected parent type " + parentType)
+	}
+	return f[parentID], nil
+}
+
+type fakeAuthoredEvents struct {
+	events  []auth.AuthoredEvent
+	gotIDs  []ulid.ULID
+	gotLim  int
+	listErr error
+}
+
+func (f *fakeAuthoredEvents) ListByActors(_ context.Context, _ ulid.ULID, characterIDs []ulid.ULID, limit int) ([]auth.AuthoredEvent, error) {
+	f.gotIDs = characterIDs
+	f.gotLim = limit
+	if f.listErr != nil {
+		return nil, f.listErr
+	}
+	return f.events[:min(limit, len(f.events))], nil
+}
+
+type anonymizeCall struct {
+	subject string
+	id      ulid.ULID
+	name    string
+}
+
+type fakeAnonymizer struct {
+	calls []anonymizeCall
+	err   error
+}
+
+func (f *fakeAnonymizer) AnonymizeCharacter(_ context.Context, subjectID string, characterID ulid.ULID, tombstoneName string) error {
+	f.calls = append(f.calls, anonymizeCall{subjectID, characterID, tombstoneName})
+	return f.err
+}
+
+type playerDataFixture struct {
+	svc        *auth.Service
+	players    *mocks.MockPlayerRepository
+	sessions   *mocks.MockPlayerSessionRepository
+	player     *auth.Player
+	chars      []*world.Character
+	events     *fakeAuthoredEvents
+	anonymizer *fakeAnonymizer
+	secEvents  *memSecurityEvents
+	identities *memIdentities
+}
+
+func newPlayerDataFixture(t *testing.T) *playerDataFixture {
+	t.Helper()
+	svc, players, sessions, _ := newTestAuthServiceWithCap(t, 0)
+	email := "alice@example.com"
+	player := &auth.Player{
+		ID: ulid.Make(), Username: "alice", PasswordHash: "hash", Email: &email,
+		Preferences: auth.PlayerPreferences{Theme: "dark"},
+	}
+	players.On("GetByID", mock.Anything, player.ID).Return(player, nil).Maybe()
+
+	chars := []*world.Character{reapChar(t, 1), reapChar(t, 2)}
+	desc := "red"
+	props := fakeDataProps{chars[0].ID: {{Name: "eyes", Value: &desc, Visibility: "public"}}}
+	events := &fakeAuthoredEvents{events: []auth.AuthoredEvent{
+		{ID: ulid.Make(), Type: "say", Payload: json.RawMessage(`{"text":"hi"}`)},
+		{ID: ulid.Make(), Type: "pose", Encrypted: true},
+	}}
+	anonymizer := &fakeAnonymizer{}
+	svc.ConfigurePlayerData(auth.PlayerDataStores{
+		Characters: &fakeReapLister{chars: chars},
+		Properties: props,
+		Events:     events,
+		Anonymizer: anonymizer,
+	})
+
+	log, secEvents, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+	log.Record(context.Background(), player.ID, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{IPAddress: "198.51.100.1"}, "")
+	identities := &memIdentities{links: []*auth.LinkedIdentity{
+		{Provider: "google", Subject: "g-123", PlayerID: player.ID, LinkedAt: time.Now()},
+	}}
+	svc.SetOIDC(stubVerifier{}, identities, auth.OIDCPolicy{})
+
+	return &playerDataFixture{
+		svc: svc, players: players, sessions: sessions, player: player, chars: chars,
+		events: events, anonymizer: anonymizer, secEvents: secEvents, identities: identities,
+	}
+}
+
+func TestExportPlayerDataAssemblesArchive(t *testing.T) {
+	f := newPlayerDataFixture(t)
+
+	archive, err := f.svc.ExportPlayerData(context.Background(), f.player.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice", archive.Account.Username)
+	assert.Equal(t, "dark", archive.Account.Preferences.Theme)
+	require.Len(t, archive.Identities, 1)
+	assert.Equal(t, "g-123", archive.Identities[0].Subject)
+	require.Len(t, archive.Characters, 2)
+	require.Len(t, archive.Characters[0].Properties, 1)
+	assert.Equal(t, "eyes", archive.Characters[0].Properties[0].Name)
+	assert.Empty(t, archive.Characters[1].Properties)
+	require.Len(t, archive.SecurityEvents, 1)
+	assert.Equal(t, "198.51.100.1", archive.SecurityEvents[0].IPAddress)
+	assert.Len(t, archive.AuthoredEvents, 2)
+	assert.False(t, archive.AuthoredEventsTruncated)
+	assert.Equal(t, []ulid.ULID{f.chars[0].ID, f.chars[1].ID}, f.events.gotIDs)
+	assert.Equal(t, auth.MaxAuthoredEventExport+1, f.events.gotLim)
+
+	data, err := json.Marshal(archive)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "hash", "the password hash is never exported")
+	assert.Contains(t, string(data), `"payload":{"text":"hi"}`)
+}
+
+func TestExportPlayerDataFlagsTruncatedEvents(t *testing.T) {
+	f := newPlayerDataFixture(t)
+	f.events.events = make([]auth.AuthoredEvent, auth.MaxAuthoredEventExport+1)
+
+	archive, err := f.svc.ExportPlayerData(context.Background(), f.player.ID)
+	require.NoError(t, err)
+	assert.Len(t, archive.AuthoredEvents, auth.MaxAuthoredEventExport)
+	assert.True(t, archive.AuthoredEventsTruncated)
+}
+
+func TestExportPlayerDataErrors(t *testing.T) {
+	t.Run("unconfigured stores", func(t *testing.T) {
+		svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+		_, err := svc.ExportPlayerData(context.Background(), ulid.Make())
+		errutil.AssertErrorCode(t, err, "AUTH_PLAYER_DATA_DISABLED")
+	})
+
+	t.Run("unknown player", func(t *testing.T) {
+		f := newPlayerDataFixture(t)
+		missing := ulid.Make()
+		f.players.On("GetByID", mock.Anything, missing).Return(nil, auth.ErrNotFound)
+		_, err := f.svc.ExportPlayerData(context.Background(), missing)
+		errutil.AssertErrorCode(t, err, "PLAYER_NOT_FOUND")
+	})
+
+	t.Run("event listing failure", func(t *testing.T) {
+		f := newPlayerDataFixture(t)
+		f.events.listErr = errors.New("db down")
+		_, err := f.svc.ExportPlayerData(context.Background(), f.player.ID)
+		errutil.AssertErrorCode(t, err, "PLAYER_DATA_EXPORT_FAILED")
+	})
+}
+
+func TestDeletePlayerAnonymizesAndScrubs(t *testing.T) {
+	ctx := context.Background()
+	f := newPlayerDataFixture(t)
+	require.NoError(t, f.svc.SetPlayerDataPolicy(auth.PlayerDataPolicy{TombstoneName: "Former Player"}))
+	f.sessions.On("DeleteByPlayer", ctx, f.player.ID).Return(nil).Once()
+	var scrubbed *auth.Player
+	f.players.On("Update", ctx, mock.AnythingOfType("*auth.Player")).
+		Run(func(args mock.Arguments) { scrubbed = args.Get(1).(*auth.Player) }).
+		Return(nil).Once()
+
+	require.NoError(t, f.svc.DeletePlayer(ctx, f.player.ID))
+
+	assert.Equal(t, []anonymizeCall{
+		{"system", f.chars[0].ID, "Former Player"},
+		{"system", f.chars[1].ID, "Former Player"},
+	}, f.anonymizer.calls)
+	assert.Empty(t, f.identities.links, "identities are unlinked")
+	assert.Empty(t, f.secEvents.types(), "the security log is purged")
+
+	require.NotNil(t, scrubbed)
+	assert.Equal(t, f.player.ID, scrubbed.ID, "the row survives so references stay valid")
+	require.NoError(t, auth.ValidateUsername(scrubbed.Username))
+	assert.Contains(t, scrubbed.Username, "deleted_")
+	assert.Empty(t, scrubbed.PasswordHash)
+	assert.Nil(t, scrubbed.Email)
+	assert.Equal(t, auth.PlayerPreferences{}, scrubbed.Preferences)
+}
+
+func TestDeletePlayerUsesDefaultTombstoneName(t *testing.T) {
+	ctx := context.Background()
+	f := newPlayerDataFixture(t)
+	f.sessions.On("DeleteByPlayer", ctx, f.player.ID).Return(nil)
+	f.players.On("Update", ctx, mock.AnythingOfType("*auth.Player")).Return(nil)
+
+	require.NoError(t, f.svc.DeletePlayer(ctx, f.player.ID))
+	require.NotEmpty(t, f.anonymizer.calls)
+	assert.Equal(t, auth.DefaultTombstoneName, f.anonymizer.calls[0].name)
+}
+
+func TestDeletePlayerRefusesGuests(t *testing.T) {
+	f := newPlayerDataFixture(t)
+	f.player.IsGuest = true
+
+	err := f.svc.DeletePlayer(context.Background(), f.player.ID)
+	errutil.AssertErrorCode(t, err, "PLAYER_DELETE_GUEST")
+	assert.Empty(t, f.anonymizer.calls)
+}
+
+func TestDeletePlayerStopsBeforeScrubWhenAnonymizeFails(t *testing.T) {
+	ctx := context.Background()
+	f := newPlayerDataFixture(t)
+	f.sessions.On("DeleteByPlayer", ctx, f.player.ID).Return(nil)
+	f.anonymizer.err = errors.New("concurrent edit")
+
+	err := f.svc.DeletePlayer(ctx, f.player.ID)
+	errutil.AssertErrorCode(t, err, "PLAYER_DELETE_FAILED")
+	assert.Len(t, f.anonymizer.calls, 1)
+	assert.Len(t, f.identities.links, 1, "later steps do not run")
+	f.players.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSetPlayerDataPolicyRejectsInvalidTombstoneName(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	err := svc.SetPlayerDataPolicy(auth.PlayerDataPolicy{TombstoneName: "Player 42"})
+	errutil.AssertErrorCode(t, err, "AUTH_INVALID_PLAYER_DATA_POLICY")
+	require.NoError(t, svc.SetPlayerDataPolicy(auth.PlayerDataPolicy{}))
+}
+
+func TestSecurityLogPurgeRemovesPlayerEvents(t *testing.T) {
+	log, events, _ := newTestSecurityLog(t)
+	keep, drop := ulid.Make(), ulid.Make()
+	log.Record(context.Background(), keep, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{}, "")
+	log.Record(context.Background(), drop, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{}, "")
+
+	require.NoError(t, log.Purge(context.Background(), drop))
+	require.Len(t, events.events, 1)
+	assert.Equal(t, keep, events.events[0].PlayerID)
+}
@@ -9,7 +9,10 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
@@ -26,8 +29,22 @@ const (
 // PlayerSessionTTL is the default time-to-live for a player session.
 const PlayerSessionTTL = 24 * time.Hour
 
+// AccessTokenTTL is the lifetime of the access token of a session that
+// holds a refresh token. The session itself keeps the sliding
+// PlayerSessionTTL; each refresh extends it.
+const AccessTokenTTL = 15 * time.Minute
+
+// MaxDeviceLabelLength bounds a session's device label, in characters.
+const MaxDeviceLabelLength = 64
+
 // PlayerSession represents a durable authenticated session for a player.
 // It persists across connections and uses a sliding 24h TTL.
+//
+// Web sessions also hold a refresh token. Their access token (TokenHash)
+// expires after AccessTokenTTL, and the client trades the refresh token for
+// a new access and refresh token pair. Sessions without a refresh token
+// (telnet, guests) have a zero AccessExpiresAt: their access token lives as
+// long as the session.
 type PlayerSession struct {
 	ID        ulid.ULID
 	PlayerID  ulid.ULID
@@ -37,6 +54,21 @@ type PlayerSession struct {
 	ExpiresAt time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+
+	// RefreshTokenHash is the hash of the current refresh token, or empty.
+	RefreshTokenHash string
+	// PreviousRefreshHash is the hash of the refresh token the last rotation
+	// retired. Presenting it again is reuse and revokes the session.
+	PreviousRefreshHash string
+	// AccessExpiresAt is when the access token expires; zero for sessions
+	// without a refresh token.
+	AccessExpiresAt time.Time
+	// DeviceLabel is the player's name for the device holding the session.
+	DeviceLabel string
+	// ImpersonatorID is the staff player acting through this session
+	// (Service.Impersonate); zero for every ordinary session. ExpiresAt of
+	// an impersonation session is a hard limit that refreshes never extend.
+	ImpersonatorID ulid.ULID
 }
 
 // NewPlayerSession creates a validated PlayerSession.
@@ -71,13 +103,79 @@ func (s *PlayerSession) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
 }
 
+// AccessExpired returns true if the session's access token has passed its
+// expiry. Sessions without a refresh token never expire their access token
+// separately.
+func (s *PlayerSession) AccessExpired() bool {
+	return !s.AccessExpiresAt.IsZero() && time.Now().After(s.AccessExpiresAt)
+}
+
+// IsImpersonation reports whether the session was opened by a staff
+// player impersonating its owner.
+func (s *PlayerSession) IsImpersonation() bool {
+	return !s.ImpersonatorID.IsZero()
+}
+
+// slideExpiry returns the expiry a refresh at now moves the session to: ttl
+// from now, except that an impersonation session never passes the expiry
+// it was created with.
+func (s *PlayerSession) slideExpiry(now time.Time, ttl time.Duration) time.Time {
+	expires := now.Add(ttl)
+	if s.IsImpersonation() && expires.After(s.ExpiresAt) {
+		return s.ExpiresAt
+	}
+	return expires
+}
+
+// HasRefreshToken reports whether the session was started with a refresh
+// token.
+func (s *PlayerSession) HasRefreshToken() bool {
+	return s.RefreshTokenHash != ""
+}
+
+// RotateTokens replaces the session's access and refresh tokens, retiring
+// the current refresh token into PreviousRefreshHash. The access token
+// expires after AccessTokenTTL and the session's expiry slides forward by
+// PlayerSessionTTL. It is also how a new session is given its first refresh
+// token.
+func (s *PlayerSession) RotateTokens(accessHash, refreshHash string) error {
+	if accessHash == "" || refreshHash == "" {
+		return oops.Code("SESSION_INVALID_HASH").Errorf("token hash cannot be empty")
+	}
+	now := time.Now()
+	s.PreviousRefreshHash = s.RefreshTokenHash
+	s.TokenHash = accessHash
+	s.RefreshTokenHash = refreshHash
+	s.AccessExpiresAt = now.Add(AccessTokenTTL)
+	s.ExpiresAt = s.slideExpiry(now, PlayerSessionTTL)
+	s.UpdatedAt = now
+	return nil
+}
+
+// NormalizeDeviceLabel trims label and checks it is short enough and free
+// of control characters. An empty label clears the session's label.
+func NormalizeDeviceLabel(label string) (string, error) {
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > MaxDeviceLabelLength {
+		return "", oops.Code("SESSION_INVALID_DEVICE_LABEL").
+			With("max_length", MaxDeviceLabelLength).
+			Errorf("device label must be at most %d characters", MaxDeviceLabelLength)
+	}
+	if strings.IndexFunc(label, unicode.IsControl) >= 0 {
+		return "", oops.Code("SESSION_INVALID_DEVICE_LABEL").
+			Errorf("device label cannot contain control characters")
+	}
+	return label, nil
+}
+
 // Refresh extends the session's expiry by ttl from now and updates UpdatedAt.
+// An impersonation session keeps its original expiry when that is sooner.
 func (s *PlayerSession) Refresh(ttl time.Duration) error {
 	if ttl <= 0 {
 		return oops.Code("SESSION_INVALID_TTL").Errorf("ttl must be positive")
 	}
 	now := time.Now()
-	s.ExpiresAt = now.Add(ttl)
+	s.ExpiresAt = s.slideExpiry(now, ttl)
 	s.UpdatedAt = now
 	return nil
 }
@@ -109,6 +207,26 @@ type PlayerSessionRepository interface {
 	// GetByTokenHash retrieves a session by its token hash.
 	GetByTokenHash(ctx context.Context, tokenHash string) (*PlayerSession, error)
 
+	// GetByRefreshTokenHash retrieves the non-expired session whose current
+	// or previous refresh token has the given hash. Callers compare the hash
+	// against RefreshTokenHash to tell a refresh from reuse of a retired
+	// token. Returns ErrNotFound if no session matches.
+	GetByRefreshTokenHash(ctx context.Context, refreshHash string) (*PlayerSession, error)
+
+	// RotateTokens stores the session's rotated tokens and expiries, provided
+	// its refresh token is still presentedRefreshHash. Returns
+	// ErrRefreshTokenConflict if another rotation won, or ErrNotFound if the
+	// session no longer exists.
+	RotateTokens(ctx context.Context, session *PlayerSession, presentedRefreshHash string) error
+
+	// SetDeviceLabel sets the device label of a session. Returns ErrNotFound
+	// if no row exists.
+	SetDeviceLabel(ctx context.Context, id ulid.ULID, label string) error
+
+	// SetTokenHash replaces the access token hash of a session, revoking the
+	// token it held. Returns ErrNotFound if no row exists.
+	SetTokenHash(ctx context.Context, id ulid.ULID, tokenHash string) error
+
 	// GetByID retrieves a session by its ULID primary key. Returns ErrNotFound
 	// if no row exists.
 	GetByID(ctx context.Context, id ulid.ULID) (*PlayerSession, error)
@@ -135,7 +253,8 @@ type PlayerSessionRepository interface {
 	// DeleteExpired removes all expired sessions and returns the count of deleted records.
 	DeleteExpired(ctx context.Context) (int64, error)
 
-	// RefreshTTL extends the expiry of a session by ttl from now.
+	// RefreshTTL extends the expiry of a session by ttl from now. The
+	// expiry of an impersonation session is never extended.
 	RefreshTTL(ctx context.Context, id ulid.ULID, ttl time.Duration) error
 }
 
@@ -4,6 +4,7 @@
 package auth_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -124,6 +125,21 @@ func TestPlayerSession_Refresh(t *testing.T) {
 		assert.False(t, session.IsExpired())
 	})
 
+	t.Run("never extends an impersonation session", func(t *testing.T) {
+		hardLimit := time.Now().Add(10 * time.Minute)
+		session := &auth.PlayerSession{
+			ExpiresAt:      hardLimit,
+			ImpersonatorID: ulid.Make(),
+		}
+		assert.True(t, session.IsImpersonation())
+
+		require.NoError(t, session.Refresh(auth.PlayerSessionTTL))
+		assert.Equal(t, hardLimit, session.ExpiresAt)
+
+		require.NoError(t, session.Refresh(time.Minute))
+		assert.True(t, session.ExpiresAt.Before(hardLimit), "a shorter ttl still shortens it")
+	})
+
 	t.Run("rejects zero TTL", func(t *testing.T) {
 		session := &auth.PlayerSession{ExpiresAt: time.Now().Add(time.Hour)}
 		err := session.Refresh(0)
@@ -139,6 +155,65 @@ func TestPlayerSession_Refresh(t *testing.T) {
 	})
 }
 
+func TestPlayerSession_AccessExpired(t *testing.T) {
+	t.Run("false without a refresh token", func(t *testing.T) {
+		session := &auth.PlayerSession{ExpiresAt: time.Now().Add(time.Hour)}
+		assert.False(t, session.AccessExpired())
+		assert.False(t, session.HasRefreshToken())
+	})
+
+	t.Run("true when AccessExpiresAt is in past", func(t *testing.T) {
+		session := &auth.PlayerSession{
+			ExpiresAt:       time.Now().Add(time.Hour),
+			AccessExpiresAt: time.Now().Add(-time.Minute),
+		}
+		assert.True(t, session.AccessExpired())
+		assert.False(t, session.IsExpired())
+	})
+}
+
+func TestPlayerSession_RotateTokens(t *testing.T) {
+	t.Run("retires the current refresh token and slides expiry", func(t *testing.T) {
+		session := &auth.PlayerSession{
+			TokenHash:        "access-1",
+			RefreshTokenHash: "refresh-1",
+			ExpiresAt:        time.Now().Add(time.Minute),
+		}
+
+		before := time.Now()
+		require.NoError(t, session.RotateTokens("access-2", "refresh-2"))
+
+		assert.Equal(t, "access-2", session.TokenHash)
+		assert.Equal(t, "refresh-2", session.RefreshTokenHash)
+		assert.Equal(t, "refresh-1", session.PreviousRefreshHash)
+		assert.True(t, session.HasRefreshToken())
+		assert.False(t, session.AccessExpiresAt.Before(before.Add(auth.AccessTokenTTL)))
+		assert.False(t, session.ExpiresAt.Before(before.Add(auth.PlayerSessionTTL)))
+	})
+
+	t.Run("rejects empty hashes", func(t *testing.T) {
+		session := &auth.PlayerSession{}
+		errutil.AssertErrorCode(t, session.RotateTokens("access", ""), "SESSION_INVALID_HASH")
+		errutil.AssertErrorCode(t, session.RotateTokens("", "refresh"), "SESSION_INVALID_HASH")
+	})
+}
+
+func TestNormalizeDeviceLabel(t *testing.T) {
+	label, err := auth.NormalizeDeviceLabel("  Work laptop ")
+	require.NoError(t, err)
+	assert.Equal(t, "Work laptop", label)
+
+	label, err = auth.NormalizeDeviceLabel("")
+	require.NoError(t, err)
+	assert.Empty(t, label)
+
+	_, err = auth.NormalizeDeviceLabel(strings.Repeat("x", auth.MaxDeviceLabelLength+1))
+	errutil.AssertErrorCode(t, err, "SESSION_INVALID_DEVICE_LABEL")
+
+	_, err = auth.NormalizeDeviceLabel("bad\x1b[2Jlabel")
+	errutil.AssertErrorCode(t, err, "SESSION_INVALID_DEVICE_LABEL")
+}
+
 func TestPlayerSessionTTL(t *testing.T) {
 	t.Run("TTL is 24 hours", func(t *testing.T) {
 		assert.Equal(t, 24*time.Hour, auth.PlayerSessionTTL)
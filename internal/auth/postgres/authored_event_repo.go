@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/pgnanos"
+	eventbusv1 "github.com/holomush/holomush/pkg/proto/holomush/eventbus/v1"
+)
+
+// identityCodec is the events_audit.codec value of cleartext rows.
+const identityCodec = "identity"
+
+// AuthoredEventRepository implements auth.AuthoredEventLister over the
+// events_audit table. It only reads: audit rows are append-only.
+type AuthoredEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuthoredEventRepository creates a new AuthoredEventRepository.
+func NewAuthoredEventRepository(pool *pgxpool.Pool) *AuthoredEventRepository {
+	return &AuthoredEventRepository{pool: pool}
+}
+
+// ListByActors returns up to limit audited events whose actor is the player
+// or one of characterIDs, oldest first. Cleartext payloads are carried when
+// they are valid JSON; encrypted rows are returned with Encrypted set and no
+// payload.
+func (r *AuthoredEventRepository) ListByActors(ctx context.Context, playerID ulid.ULID, characterIDs []ulid.ULID, limit int) ([]auth.AuthoredEvent, error) {
+	charIDs := make([][]byte, len(characterIDs))
+	for i, id := range characterIDs {
+		charIDs[i] = id.Bytes()
+	}
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, subject, type, timestamp, envelope, codec
+		FROM events_audit
+		WHERE (actor_kind = 'player' AND actor_id = $1)
+		   OR (actor_kind = 'character' AND actor_id = ANY($2))
+		ORDER BY event_ms, id
+		LIMIT $3
+	`, playerID.Bytes(), charIDs, limit)
+	if err != nil {
+		return nil, oops.Code("AUTHORED_EVENT_LIST_FAILED").
+			With("operation", "list events_audit by actor").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var events []auth.AuthoredEvent
+	for rows.Next() {
+		var (
+			idBytes  []byte
+			event    auth.AuthoredEvent
+			ts       pgnanos.Time
+			envelope []byte
+			codec    string
+		)
+		if err := rows.Scan(&idBytes, &event.Subject, &event.Type, &ts, &envelope, &codec); err != nil {
+			return nil, oops.Code("AUTHORED_EVENT_SCAN_FAILED").
+				With("operation", "scan events_audit row").
+				Wrap(err)
+		}
+		if len(idBytes) != len(event.ID) {
+			return nil, oops.Code("AUTHORED_EVENT_INVALID_ID").
+				With("len", len(idBytes)).
+				Errorf("events_audit.id must be 16 bytes")
+		}
+		copy(event.ID[:], idBytes)
+		event.Timestamp = ts.Time()
+		if codec != identityCodec {
+			event.Encrypted = true
+		} else {
+			var env eventbusv1.Event
+			if err := proto.Unmarshal(envelope, &env); err != nil {
+				return nil, oops.Code("AUTHORED_EVENT_DECODE_FAILED").
+					With("event_id", event.ID.String()).
+					Wrap(err)
+			}
+			if json.Valid(env.GetPayload()) {
+				event.Payload = json.RawMessage(env.GetPayload())
+			}
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("AUTHORED_EVENT_LIST_FAILED").
+			With("operation", "iterate events_audit rows").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return events, nil
+}
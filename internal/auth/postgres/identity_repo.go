@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// IdentityRepository implements auth.IdentityRepository using PostgreSQL.
+type IdentityRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewIdentityRepository creates a new IdentityRepository.
+func NewIdentityRepository(pool *pgxpool.Pool) *IdentityRepository {
+	return &IdentityRepository{pool: pool}
+}
+
+// Create stores a new linked identity.
+func (r *IdentityRepository) Create(ctx context.Context, identity *auth.LinkedIdentity) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO player_identities (provider, subject, player_id, email, linked_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`,
+		identity.Provider,
+		identity.Subject,
+		identity.PlayerID.String(),
+		identity.Email,
+		pgnanos.From(identity.LinkedAt),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return oops.Code("AUTH_OIDC_ALREADY_LINKED").
+				With("provider", identity.Provider).
+				With("player_id", identity.PlayerID.String()).
+				Wrap(auth.ErrDuplicateIdentity)
+		}
+		return oops.Code("IDENTITY_CREATE_FAILED").
+			With("operation", "insert player_identity").
+			With("provider", identity.Provider).
+			With("player_id", identity.PlayerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Get returns the link for a provider account.
+func (r *IdentityRepository) Get(ctx context.Context, provider, subject string) (*auth.LinkedIdentity, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT provider, subject, player_id, email, linked_at
+		FROM player_identities
+		WHERE provider = $1 AND subject = $2
+	`, provider, subject)
+	identity, err := scanIdentity(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("IDENTITY_NOT_FOUND").
+			With("provider", provider).
+			Wrap(auth.ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("IDENTITY_QUERY_FAILED").
+			With("operation", "get player_identity").
+			With("provider", provider).
+			Wrap(err)
+	}
+	return identity, nil
+}
+
+// ListByPlayer returns the player's links, oldest first.
+func (r *IdentityRepository) ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*auth.LinkedIdentity, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT provider, subject, player_id, email, linked_at
+		FROM player_identities
+		WHERE player_id = $1
+		ORDER BY linked_at, provider
+	`, playerID.String())
+	if err != nil {
+		return nil, oops.Code("IDENTITY_QUERY_FAILED").
+			With("operation", "list player_identities").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var identities []*auth.LinkedIdentity
+	for rows.Next() {
+		identity, err := scanIdentity(rows)
+		if err != nil {
+			return nil, oops.Code("IDENTITY_QUERY_FAILED").
+				With("operation", "scan player_identity").
+				With("player_id", playerID.String()).
+				Wrap(err)
+		}
+		identities = append(identities, identity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("IDENTITY_QUERY_FAILED").
+			With("operation", "iterate player_identities").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return identities, nil
+}
+
+// Delete removes the player's link to provider.
+func (r *IdentityRepository) Delete(ctx context.Context, playerID ulid.ULID, provider string) error {
+	tag, err := r.pool.Exec(ctx, `
+		DELETE FROM player_identities WHERE player_id = $1 AND provider = $2
+	`, playerID.String(), provider)
+	if err != nil {
+		return oops.Code("IDENTITY_DELETE_FAILED").
+			With("operation", "delete player_identity").
+			With("player_id", playerID.String()).
+			With("provider", provider).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("IDENTITY_NOT_FOUND").
+			With("player_id", playerID.String()).
+			With("provider", provider).
+			Wrap(auth.ErrNotFound)
+	}
+	return nil
+}
+
+func scanIdentity(row pgx.Row) (*auth.LinkedIdentity, error) {
+	var (
+		identity auth.LinkedIdentity
+		playerID string
+		linkedAt pgnanos.Time
+	)
+	if err := row.Scan(&identity.Provider, &identity.Subject, &playerID, &identity.Email, &linkedAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(playerID)
+	if err != nil {
+		return nil, oops.With("player_id", playerID).Wrap(err)
+	}
+	identity.PlayerID = parsed
+	identity.LinkedAt = linkedAt.Time()
+	return &identity, nil
+}
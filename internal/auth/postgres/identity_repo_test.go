@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/postgres"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestIdentityRepository_LinkLookupUnlink(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewIdentityRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "identity_link_test")
+
+	link, err := auth.NewLinkedIdentity(playerID, &auth.OIDCIdentity{
+		Provider: "google", Subject: "1234567890", Email: "player@example.com",
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, link))
+
+	got, err := repo.Get(ctx, "google", "1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, playerID, got.PlayerID)
+	assert.Equal(t, "player@example.com", got.Email)
+	assert.True(t, link.LinkedAt.Equal(got.LinkedAt))
+
+	links, err := repo.ListByPlayer(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+
+	require.NoError(t, repo.Delete(ctx, playerID, "google"))
+	_, err = repo.Get(ctx, "google", "1234567890")
+	assert.ErrorIs(t, err, auth.ErrNotFound)
+
+	err = repo.Delete(ctx, playerID, "google")
+	assert.ErrorIs(t, err, auth.ErrNotFound)
+}
+
+func TestIdentityRepository_RejectsDuplicateLinks(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewIdentityRepository(testPool)
+	first := createTestPlayer(ctx, t, "identity_dup_first")
+	second := createTestPlayer(ctx, t, "identity_dup_second")
+
+	link, err := auth.NewLinkedIdentity(first, &auth.OIDCIdentity{Provider: "discord", Subject: "42"})
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, link))
+
+	t.Run("same provider account on another player", func(t *testing.T) {
+		dup, err := auth.NewLinkedIdentity(second, &auth.OIDCIdentity{Provider: "discord", Subject: "42"})
+		require.NoError(t, err)
+		err = repo.Create(ctx, dup)
+		assert.ErrorIs(t, err, auth.ErrDuplicateIdentity)
+		errutil.AssertErrorCode(t, err, "AUTH_OIDC_ALREADY_LINKED")
+	})
+
+	t.Run("second account from the same provider", func(t *testing.T) {
+		dup, err := auth.NewLinkedIdentity(first, &auth.OIDCIdentity{Provider: "discord", Subject: "43"})
+		require.NoError(t, err)
+		assert.ErrorIs(t, repo.Create(ctx, dup), auth.ErrDuplicateIdentity)
+	})
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// ImpersonationRepository implements auth.ImpersonationRepository using PostgreSQL.
+type ImpersonationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewImpersonationRepository creates a new ImpersonationRepository.
+func NewImpersonationRepository(pool *pgxpool.Pool) *ImpersonationRepository {
+	return &ImpersonationRepository{pool: pool}
+}
+
+// Create stores a new impersonation record.
+func (r *ImpersonationRepository) Create(ctx context.Context, imp *auth.Impersonation) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO player_impersonations (
+			id, impersonator_id, player_id, player_session_id, reason, started_at, expires_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		imp.ID.String(),
+		imp.ImpersonatorID.String(),
+		imp.PlayerID.String(),
+		imp.PlayerSessionID.String(),
+		imp.Reason,
+		pgnanos.From(imp.StartedAt),
+		pgnanos.From(imp.ExpiresAt),
+	)
+	if err != nil {
+		return oops.Code("IMPERSONATION_CREATE_FAILED").
+			With("operation", "insert player_impersonation").
+			With("player_id", imp.PlayerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// TakePending marks the player's unannounced impersonations notified at now
+// and returns them, oldest first. The UPDATE claims each row exactly once,
+// so two concurrent logins cannot both announce it.
+func (r *ImpersonationRepository) TakePending(ctx context.Context, playerID ulid.ULID, now time.Time) ([]*auth.Impersonation, error) {
+	rows, err := r.pool.Query(ctx, `
+		UPDATE player_impersonations
+		SET notified_at = $2
+		WHERE player_id = $1 AND notified_at IS NULL
+		RETURNING id, impersonator_id, player_id, player_session_id, reason, started_at, expires_at, notified_at
+	`, playerID.String(), pgnanos.From(now))
+	if err != nil {
+		return nil, oops.Code("IMPERSONATION_TAKE_FAILED").
+			With("operation", "claim player_impersonations").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*auth.Impersonation
+	for rows.Next() {
+		imp, err := r.scanImpersonation(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, imp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("IMPERSONATION_TAKE_FAILED").
+			With("operation", "iterate player_impersonations").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	// RETURNING has no ORDER BY; sort here. ULIDs order by creation time.
+	slices.SortFunc(out, func(a, b *auth.Impersonation) int { return a.ID.Compare(b.ID) })
+	return out, nil
+}
+
+// HoldsStaffRole reports whether any of the player's characters holds a
+// role other than access.RolePlayer.
+func (r *ImpersonationRepository) HoldsStaffRole(ctx context.Context, playerID ulid.ULID) (bool, error) {
+	var held bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			  FROM character_roles cr
+			  JOIN characters c ON cr.character_id = c.id
+			 WHERE c.player_id = $1
+			   AND cr.role    <> $2
+		)
+	`, playerID.String(), access.RolePlayer).Scan(&held)
+	if err != nil {
+		return false, oops.Code("IMPERSONATION_ROLE_CHECK_FAILED").
+			With("operation", "query character_roles").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return held, nil
+}
+
+// scanImpersonation scans a single row into an Impersonation.
+func (r *ImpersonationRepository) scanImpersonation(row pgx.Row) (*auth.Impersonation, error) {
+	var (
+		ids                  [4]string
+		imp                  auth.Impersonation
+		startedAt, expiresAt pgnanos.Time
+		notifiedAt           *pgnanos.Time
+	)
+	err := row.Scan(&ids[0], &ids[1], &ids[2], &ids[3], &imp.Reason, &startedAt, &expiresAt, &notifiedAt)
+	if err != nil {
+		return nil, oops.Code("IMPERSONATION_SCAN_FAILED").
+			With("operation", "scan player_impersonation").
+			Wrap(err)
+	}
+	parsed := make([]ulid.ULID, len(ids))
+	for i, raw := range ids {
+		id, err := ulid.Parse(raw)
+		if err != nil {
+			return nil, oops.Code("IMPERSONATION_INVALID_ID").
+				With("operation", "parse impersonation id").
+				With("id", raw).
+				Wrap(err)
+		}
+		parsed[i] = id
+	}
+	imp.ID, imp.ImpersonatorID, imp.PlayerID, imp.PlayerSessionID = parsed[0], parsed[1], parsed[2], parsed[3]
+	imp.StartedAt = startedAt.Time()
+	imp.ExpiresAt = expiresAt.Time()
+	if notifiedAt != nil {
+		imp.NotifiedAt = notifiedAt.Time()
+	}
+	return &imp, nil
+}
+
+// Compile-time interface check.
+var _ auth.ImpersonationRepository = (*ImpersonationRepository)(nil)
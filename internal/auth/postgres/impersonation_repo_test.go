@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/postgres"
+)
+
+func TestImpersonationRepository_TakePendingClaimsOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewImpersonationRepository(testPool)
+	staffID := createTestPlayer(ctx, t, "imp_staff_test")
+	playerID := createTestPlayer(ctx, t, "imp_target_test")
+
+	started := time.Now().Add(-time.Minute)
+	first := &auth.Impersonation{
+		ID: ulid.Make(), ImpersonatorID: staffID, PlayerID: playerID, PlayerSessionID: ulid.Make(),
+		Reason: "ticket 1", StartedAt: started, ExpiresAt: started.Add(30 * time.Minute),
+	}
+	second := &auth.Impersonation{
+		ID: ulid.Make(), ImpersonatorID: staffID, PlayerID: playerID, PlayerSessionID: ulid.Make(),
+		Reason: "ticket 2", StartedAt: time.Now(), ExpiresAt: time.Now().Add(time.Hour),
+	}
+	require.NoError(t, repo.Create(ctx, first))
+	require.NoError(t, repo.Create(ctx, second))
+
+	now := time.Now()
+	got, err := repo.TakePending(ctx, playerID, now)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, first.ID, got[0].ID, "oldest first")
+	assert.Equal(t, staffID, got[0].ImpersonatorID)
+	assert.Equal(t, "ticket 1", got[0].Reason)
+	assert.True(t, first.StartedAt.Equal(got[0].StartedAt))
+	assert.True(t, now.Equal(got[1].NotifiedAt))
+
+	got, err = repo.TakePending(ctx, playerID, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, got, "a record is announced once")
+
+	got, err = repo.TakePending(ctx, staffID, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, got, "only the impersonated player is told")
+}
+
+func TestImpersonationRepository_RejectsSelfImpersonation(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewImpersonationRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "imp_self_test")
+
+	err := repo.Create(ctx, &auth.Impersonation{
+		ID: ulid.Make(), ImpersonatorID: playerID, PlayerID: playerID, PlayerSessionID: ulid.Make(),
+		Reason: "r", StartedAt: time.Now(), ExpiresAt: time.Now().Add(time.Minute),
+	})
+	require.Error(t, err)
+}
+
+func TestImpersonationRepository_HoldsStaffRole(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewImpersonationRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "imp_roles_test")
+	charID := ulid.Make()
+	_, err := testPool.Exec(ctx,
+		`INSERT INTO characters (id, player_id, name) VALUES ($1, $2, $3)`,
+		charID.String(), playerID.String(), "Imp Roles Char")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = testPool.Exec(ctx, `DELETE FROM characters WHERE id = $1`, charID.String())
+	})
+
+	addRole := func(role string) {
+		t.Helper()
+		_, err := testPool.Exec(ctx,
+			`INSERT INTO character_roles (character_id, role) VALUES ($1, $2)`,
+			charID.String(), role)
+		require.NoError(t, err)
+	}
+
+	addRole(access.RolePlayer)
+	held, err := repo.HoldsStaffRole(ctx, playerID)
+	require.NoError(t, err)
+	assert.False(t, held, "the player role is not a staff role")
+
+	addRole(access.RoleBuilder)
+	held, err = repo.HoldsStaffRole(ctx, playerID)
+	require.NoError(t, err)
+	assert.True(t, held)
+}
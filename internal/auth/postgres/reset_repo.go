@@ -28,12 +28,21 @@ func NewPasswordResetRepository(pool *pgxpool.Pool) *PasswordResetRepository {
 	return &PasswordResetRepository{pool: pool}
 }
 
+// passwordResetColumns lists the columns scanReset reads, in order.
+const passwordResetColumns = `id, player_id, token_hash, expires_at, used_at, created_at`
+
+const insertPasswordResetSQL = `
+	INSERT INTO password_resets (id, player_id, token_hash, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+`
+
+func passwordResetInsertArgs(reset *auth.PasswordReset) []any {
+	return []any{reset.ID.String(), reset.PlayerID.String(), reset.TokenHash, pgnanos.From(reset.ExpiresAt), pgnanos.From(reset.CreatedAt)}
+}
+
 // Create stores a new password reset request.
 func (r *PasswordResetRepository) Create(ctx context.Context, reset *auth.PasswordReset) error {
-	_, err := r.pool.Exec(ctx, `
-		INSERT INTO password_resets (id, player_id, token_hash, expires_at, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-	`, reset.ID.String(), reset.PlayerID.String(), reset.TokenHash, pgnanos.From(reset.ExpiresAt), pgnanos.From(reset.CreatedAt))
+	_, err := r.pool.Exec(ctx, insertPasswordResetSQL, passwordResetInsertArgs(reset)...)
 	if err != nil {
 		return oops.Code("RESET_CREATE_FAILED").
 			With("operation", "insert password_reset").
@@ -43,10 +52,67 @@ func (r *PasswordResetRepository) Create(ctx context.Context, reset *auth.Passwo
 	return nil
 }
 
+// CreateWithLimit stores a new password reset request unless the player
+// already holds maxOutstanding unused, unexpired resets.
+//
+// The count and the insert run in one transaction under a transaction-scoped
+// advisory lock keyed on the player, so two concurrent requests cannot both
+// see the player under the limit and both insert. The two-key form of the
+// lock keeps it apart from the player_sessions lock on the same player.
+func (r *PasswordResetRepository) CreateWithLimit(ctx context.Context, reset *auth.PasswordReset, maxOutstanding int) error {
+	if maxOutstanding <= 0 {
+		return r.Create(ctx, reset)
+	}
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return oops.Code("RESET_TX_BEGIN_FAILED").
+			With("player_id", reset.PlayerID.String()).
+			Wrap(err)
+	}
+	// Rollback is a no-op once Commit succeeds.
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('password_resets'), hashtext($1))`, reset.PlayerID.String()); err != nil {
+		return oops.Code("RESET_LOCK_FAILED").
+			With("player_id", reset.PlayerID.String()).
+			Wrap(err)
+	}
+
+	var outstanding int
+	if err := tx.QueryRow(ctx, `
+		SELECT count(*) FROM password_resets
+		WHERE player_id = $1 AND used_at IS NULL AND expires_at >= $2
+	`, reset.PlayerID.String(), pgnanos.From(time.Now())).Scan(&outstanding); err != nil {
+		return oops.Code("RESET_COUNT_FAILED").
+			With("operation", "count outstanding password_resets").
+			With("player_id", reset.PlayerID.String()).
+			Wrap(err)
+	}
+	if outstanding >= maxOutstanding {
+		return oops.Code("RESET_LIMIT_REACHED").
+			With("player_id", reset.PlayerID.String()).
+			With("outstanding", outstanding).
+			Wrap(auth.ErrTooManyResets)
+	}
+
+	if _, err := tx.Exec(ctx, insertPasswordResetSQL, passwordResetInsertArgs(reset)...); err != nil {
+		return oops.Code("RESET_CREATE_FAILED").
+			With("operation", "insert password_reset").
+			With("player_id", reset.PlayerID.String()).
+			Wrap(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return oops.Code("RESET_TX_COMMIT_FAILED").
+			With("player_id", reset.PlayerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
 // GetByPlayer retrieves the most recent reset request for a player.
 func (r *PasswordResetRepository) GetByPlayer(ctx context.Context, playerID ulid.ULID) (*auth.PasswordReset, error) {
 	row := r.pool.QueryRow(ctx, `
-		SELECT id, player_id, token_hash, expires_at, created_at
+		SELECT `+passwordResetColumns+`
 		FROM password_resets
 		WHERE player_id = $1
 		ORDER BY created_at DESC
@@ -70,7 +136,7 @@ func (r *PasswordResetRepository) GetByPlayer(ctx context.Context, playerID ulid
 // GetByTokenHash retrieves a reset request by its token hash.
 func (r *PasswordResetRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*auth.PasswordReset, error) {
 	row := r.pool.QueryRow(ctx, `
-		SELECT id, player_id, token_hash, expires_at, created_at
+		SELECT `+passwordResetColumns+`
 		FROM password_resets
 		WHERE token_hash = $1
 	`, tokenHash)
@@ -85,17 +151,25 @@ func (r *PasswordResetRepository) GetByTokenHash(ctx context.Context, tokenHash
 	return reset, nil
 }
 
-// ConsumeByTokenHash atomically deletes and returns the reset request matching
-// the given token hash. Uses DELETE ... RETURNING so exactly one concurrent
-// caller observes the row; the others receive ErrNotFound. This is the
-// atomicity guarantee ResetPassword relies on to prevent token reuse across
-// a validate-then-delete race window.
+// ConsumeByTokenHash atomically marks the unused reset request matching the
+// given token hash as used and returns it. Both updates run in one statement:
+// the row lock on the consumed request means exactly one concurrent caller
+// sees used_at IS NULL; the others receive ErrNotFound. The same statement
+// marks the player's other unused requests used, unless the consumed one
+// has expired, so no sibling token outlives the reset that succeeded.
 func (r *PasswordResetRepository) ConsumeByTokenHash(ctx context.Context, tokenHash string) (*auth.PasswordReset, error) {
 	row := r.pool.QueryRow(ctx, `
-		DELETE FROM password_resets
-		WHERE token_hash = $1
-		RETURNING id, player_id, token_hash, expires_at, created_at
-	`, tokenHash)
+		WITH consumed AS (
+			UPDATE password_resets SET used_at = $2
+			WHERE token_hash = $1 AND used_at IS NULL
+			RETURNING `+passwordResetColumns+`
+		), siblings AS (
+			UPDATE password_resets SET used_at = $2
+			WHERE player_id IN (SELECT player_id FROM consumed WHERE expires_at >= $2)
+			  AND token_hash <> $1 AND used_at IS NULL
+		)
+		SELECT `+passwordResetColumns+` FROM consumed
+	`, tokenHash, pgnanos.From(time.Now()))
 
 	reset, err := r.scanReset(row)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -164,10 +238,11 @@ func (r *PasswordResetRepository) scanReset(row pgx.Row) (*auth.PasswordReset, e
 		playerIDStr string
 		tokenHash   string
 		expiresAt   pgnanos.Time
+		usedAt      pgnanos.Time
 		createdAt   pgnanos.Time
 	)
 
-	err := row.Scan(&idStr, &playerIDStr, &tokenHash, &expiresAt, &createdAt)
+	err := row.Scan(&idStr, &playerIDStr, &tokenHash, &expiresAt, &usedAt, &createdAt)
 	if err != nil {
 		// Propagate pgx.ErrNoRows unchanged for callers to handle with context.
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -199,6 +274,7 @@ func (r *PasswordResetRepository) scanReset(row pgx.Row) (*auth.PasswordReset, e
 		PlayerID:  playerID,
 		TokenHash: tokenHash,
 		ExpiresAt: expiresAt.Time(),
+		UsedAt:    usedAt.Time(),
 		CreatedAt: createdAt.Time(),
 	}, nil
 }
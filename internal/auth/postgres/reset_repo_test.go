@@ -174,6 +174,103 @@ func TestPasswordResetRepository_GetByTokenHash(t *testing.T) {
 	})
 }
 
+// newTestReset creates a reset for playerID expiring after ttl.
+func newTestReset(ctx context.Context, t *testing.T, repo *postgres.PasswordResetRepository, playerID ulid.ULID, ttl time.Duration) *auth.PasswordReset {
+	t.Helper()
+	reset := &auth.PasswordReset{
+		ID:        ulid.Make(),
+		PlayerID:  playerID,
+		TokenHash: "reset_hash_" + ulid.Make().String(),
+		ExpiresAt: time.Now().Add(ttl).UTC(),
+		CreatedAt: time.Now().UTC(),
+	}
+	require.NoError(t, repo.Create(ctx, reset))
+	return reset
+}
+
+func TestPasswordResetRepository_CreateWithLimit(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewPasswordResetRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "reset_limit_test")
+
+	newReset := func() *auth.PasswordReset {
+		return &auth.PasswordReset{
+			ID:        ulid.Make(),
+			PlayerID:  playerID,
+			TokenHash: "limit_hash_" + ulid.Make().String(),
+			ExpiresAt: time.Now().Add(time.Hour).UTC(),
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	require.NoError(t, repo.CreateWithLimit(ctx, newReset(), 2))
+	require.NoError(t, repo.CreateWithLimit(ctx, newReset(), 2))
+
+	err := repo.CreateWithLimit(ctx, newReset(), 2)
+	errutil.AssertErrorCode(t, err, "RESET_LIMIT_REACHED")
+	assert.ErrorIs(t, err, auth.ErrTooManyResets)
+
+	// Expired and used resets do not count against the limit.
+	newTestReset(ctx, t, repo, playerID, -time.Hour)
+	used := newTestReset(ctx, t, repo, playerID, time.Hour)
+	_, err = repo.ConsumeByTokenHash(ctx, used.TokenHash)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateWithLimit(ctx, newReset(), 2), "consuming a reset invalidates its siblings")
+}
+
+func TestPasswordResetRepository_ConsumeByTokenHash(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewPasswordResetRepository(testPool)
+
+	t.Run("marks the reset and its siblings used", func(t *testing.T) {
+		playerID := createTestPlayer(ctx, t, "reset_consume_test")
+		otherID := createTestPlayer(ctx, t, "reset_consume_other")
+		reset := newTestReset(ctx, t, repo, playerID, time.Hour)
+		sibling := newTestReset(ctx, t, repo, playerID, time.Hour)
+		other := newTestReset(ctx, t, repo, otherID, time.Hour)
+
+		consumed, err := repo.ConsumeByTokenHash(ctx, reset.TokenHash)
+		require.NoError(t, err)
+		assert.Equal(t, reset.ID, consumed.ID)
+		assert.True(t, consumed.IsUsed())
+
+		stored, err := repo.GetByTokenHash(ctx, reset.TokenHash)
+		require.NoError(t, err, "a used reset is kept")
+		assert.True(t, stored.IsUsed())
+
+		stored, err = repo.GetByTokenHash(ctx, sibling.TokenHash)
+		require.NoError(t, err)
+		assert.True(t, stored.IsUsed())
+
+		stored, err = repo.GetByTokenHash(ctx, other.TokenHash)
+		require.NoError(t, err)
+		assert.False(t, stored.IsUsed(), "another player's reset is untouched")
+
+		_, err = repo.ConsumeByTokenHash(ctx, reset.TokenHash)
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+		_, err = repo.ConsumeByTokenHash(ctx, sibling.TokenHash)
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+	})
+
+	t.Run("an expired reset leaves its siblings alone", func(t *testing.T) {
+		playerID := createTestPlayer(ctx, t, "reset_consume_expired_test")
+		expired := newTestReset(ctx, t, repo, playerID, -time.Hour)
+		live := newTestReset(ctx, t, repo, playerID, time.Hour)
+
+		_, err := repo.ConsumeByTokenHash(ctx, expired.TokenHash)
+		require.NoError(t, err)
+
+		stored, err := repo.GetByTokenHash(ctx, live.TokenHash)
+		require.NoError(t, err)
+		assert.False(t, stored.IsUsed())
+	})
+
+	t.Run("returns ErrNotFound for non-existent hash", func(t *testing.T) {
+		_, err := repo.ConsumeByTokenHash(ctx, "nonexistent_hash")
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+	})
+}
+
 func TestPasswordResetRepository_Delete(t *testing.T) {
 	ctx := context.Background()
 	repo := postgres.NewPasswordResetRepository(testPool)
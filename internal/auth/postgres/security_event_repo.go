@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// SecurityEventRepository implements auth.SecurityEventRepository using PostgreSQL.
+type SecurityEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSecurityEventRepository creates a new SecurityEventRepository.
+func NewSecurityEventRepository(pool *pgxpool.Pool) *SecurityEventRepository {
+	return &SecurityEventRepository{pool: pool}
+}
+
+// Create stores a new security event.
+func (r *SecurityEventRepository) Create(ctx context.Context, event *auth.SecurityEvent) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO player_security_events (
+			id, player_id, event_type, ip_address, user_agent, detail, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`,
+		event.ID.String(),
+		event.PlayerID.String(),
+		string(event.Type),
+		event.IPAddress,
+		event.UserAgent,
+		event.Detail,
+		pgnanos.From(event.CreatedAt),
+	)
+	if err != nil {
+		return oops.Code("SECURITY_EVENT_CREATE_FAILED").
+			With("operation", "insert player_security_event").
+			With("player_id", event.PlayerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// ListByPlayer returns up to limit events for the player, newest first.
+func (r *SecurityEventRepository) ListByPlayer(ctx context.Context, playerID ulid.ULID, limit int) ([]*auth.SecurityEvent, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, player_id, event_type, ip_address, user_agent, detail, created_at
+		FROM player_security_events
+		WHERE player_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, playerID.String(), limit)
+	if err != nil {
+		return nil, oops.Code("SECURITY_EVENT_LIST_FAILED").
+			With("operation", "list player_security_events").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var events []*auth.SecurityEvent
+	for rows.Next() {
+		event, err := r.scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("SECURITY_EVENT_LIST_FAILED").
+			With("operation", "iterate player_security_events").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return events, nil
+}
+
+// Count returns the number of events matching filter. Empty Type and
+// IPAddress and a zero Since match every event for the player.
+func (r *SecurityEventRepository) Count(ctx context.Context, filter auth.SecurityEventFilter) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM player_security_events
+		WHERE player_id = $1
+		  AND ($2 = '' OR event_type = $2)
+		  AND ($3 = '' OR ip_address = $3)
+		  AND created_at >= $4
+	`, filter.PlayerID.String(), string(filter.Type), filter.IPAddress, pgnanos.From(filter.Since)).Scan(&count)
+	if err != nil {
+		return 0, oops.Code("SECURITY_EVENT_COUNT_FAILED").
+			With("operation", "count player_security_events").
+			With("player_id", filter.PlayerID.String()).
+			Wrap(err)
+	}
+	return count, nil
+}
+
+// DeleteByPlayer removes every event for the player.
+func (r *SecurityEventRepository) DeleteByPlayer(ctx context.Context, playerID ulid.ULID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM player_security_events WHERE player_id = $1`, playerID.String())
+	if err != nil {
+		return oops.Code("SECURITY_EVENT_DELETE_FAILED").
+			With("operation", "delete player_security_events").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// scanEvent scans a single row into a SecurityEvent.
+func (r *SecurityEventRepository) scanEvent(row pgx.Row) (*auth.SecurityEvent, error) {
+	var (
+		idStr       string
+		playerIDStr string
+		eventType   string
+		event       auth.SecurityEvent
+		createdAt   pgnanos.Time
+	)
+	err := row.Scan(&idStr, &playerIDStr, &eventType, &event.IPAddress, &event.UserAgent, &event.Detail, &createdAt)
+	if err != nil {
+		return nil, oops.Code("SECURITY_EVENT_SCAN_FAILED").
+			With("operation", "scan player_security_event").
+			Wrap(err)
+	}
+
+	id, err := ulid.Parse(idStr)
+	if err != nil {
+		return nil, oops.Code("SECURITY_EVENT_INVALID_ID").
+			With("operation", "parse security event id").
+			With("id", idStr).
+			Wrap(err)
+	}
+	playerID, err := ulid.Parse(playerIDStr)
+	if err != nil {
+		return nil, oops.Code("SECURITY_EVENT_INVALID_PLAYER_ID").
+			With("operation", "parse player id").
+			With("player_id", playerIDStr).
+			Wrap(err)
+	}
+
+	event.ID = id
+	event.PlayerID = playerID
+	event.Type = auth.SecurityEventType(eventType)
+	event.CreatedAt = createdAt.Time()
+	return &event, nil
+}
+
+// Compile-time interface check.
+var _ auth.SecurityEventRepository = (*SecurityEventRepository)(nil)
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/postgres"
+)
+
+func TestSecurityEventRepository_CreateAndList(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewSecurityEventRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "secevent_list_test")
+
+	first, err := auth.NewSecurityEvent(playerID, auth.SecurityEventLoginFailed,
+		auth.SecurityOrigin{IPAddress: "198.51.100.1", UserAgent: "telnet"}, "invalid password")
+	require.NoError(t, err)
+	first.CreatedAt = time.Now().Add(-time.Minute)
+	require.NoError(t, repo.Create(ctx, first))
+
+	second, err := auth.NewSecurityEvent(playerID, auth.SecurityEventLoginSucceeded,
+		auth.SecurityOrigin{IPAddress: "198.51.100.1"}, "")
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, second))
+
+	events, err := repo.ListByPlayer(ctx, playerID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, second.ID, events[0].ID, "newest first")
+	assert.Equal(t, first.ID, events[1].ID)
+	assert.Equal(t, auth.SecurityEventLoginFailed, events[1].Type)
+	assert.Equal(t, "telnet", events[1].UserAgent)
+	assert.Equal(t, "invalid password", events[1].Detail)
+	assert.True(t, first.CreatedAt.Equal(events[1].CreatedAt))
+
+	events, err = repo.ListByPlayer(ctx, playerID, 1)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestSecurityEventRepository_Count(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewSecurityEventRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "secevent_count_test")
+
+	for _, ip := range []string{"198.51.100.1", "198.51.100.1", "203.0.113.9"} {
+		event, err := auth.NewSecurityEvent(playerID, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{IPAddress: ip}, "")
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, event))
+	}
+	old, err := auth.NewSecurityEvent(playerID, auth.SecurityEventLoginFailed, auth.SecurityOrigin{}, "")
+	require.NoError(t, err)
+	old.CreatedAt = time.Now().Add(-time.Hour)
+	require.NoError(t, repo.Create(ctx, old))
+
+	tests := []struct {
+		name   string
+		filter auth.SecurityEventFilter
+		want   int
+	}{
+		{"all events", auth.SecurityEventFilter{PlayerID: playerID}, 4},
+		{"by type", auth.SecurityEventFilter{PlayerID: playerID, Type: auth.SecurityEventLoginSucceeded}, 3},
+		{"by type and ip", auth.SecurityEventFilter{PlayerID: playerID, Type: auth.SecurityEventLoginSucceeded, IPAddress: "198.51.100.1"}, 2},
+		{"since excludes older", auth.SecurityEventFilter{PlayerID: playerID, Type: auth.SecurityEventLoginFailed, Since: time.Now().Add(-time.Minute)}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.Count(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSecurityEventRepository_DeleteByPlayer(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewSecurityEventRepository(testPool)
+	playerID := createTestPlayer(ctx, t, "secevent_delete_test")
+	otherID := createTestPlayer(ctx, t, "secevent_delete_other")
+
+	for _, id := range []ulid.ULID{playerID, otherID} {
+		event, err := auth.NewSecurityEvent(id, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{IPAddress: "198.51.100.1"}, "")
+		require.NoError(t, err)
+		require.NoError(t, repo.Create(ctx, event))
+	}
+
+	require.NoError(t, repo.DeleteByPlayer(ctx, playerID))
+
+	events, err := repo.ListByPlayer(ctx, playerID, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	events, err = repo.ListByPlayer(ctx, otherID, 10)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
@@ -43,6 +43,12 @@ func ValidatePassword(password string) error {
 // It uses the same constant-time verification as Login to prevent timing attacks.
 // Returns the authenticated Player on success.
 func (s *Service) ValidateCredentials(ctx context.Context, username, password string) (*Player, error) {
+	return s.validateCredentials(ctx, username, password, SecurityOrigin{})
+}
+
+// validateCredentials is ValidateCredentials with the client origin recorded
+// in the security event log for the outcome against an existing player.
+func (s *Service) validateCredentials(ctx context.Context, username, password string, origin SecurityOrigin) (*Player, error) {
 	// SECURITY: reject oversized passwords before any hashing work. Argon2id
 	// hashes the full input with 64 MB of memory, so accepting multi-MB inputs
 	// allows trivial memory/CPU exhaustion. Return the generic invalid-credentials
@@ -76,6 +82,7 @@ func (s *Service) ValidateCredentials(ctx context.Context, username, password st
 	valid, verifyErr := s.hasher.Verify(password, targetHash)
 	if verifyErr != nil {
 		if !playerExists {
+			s.recordUnknownLogin(ctx, origin)
 			return nil, oops.Code("AUTH_INVALID_CREDENTIALS").Errorf("invalid username or password")
 		}
 		return nil, oops.Code("AUTH_LOGIN_FAILED").
@@ -96,19 +103,28 @@ func (s *Service) ValidateCredentials(ctx context.Context, username, password st
 					"error", err.Error(),
 				)
 			}
+			s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "invalid password")
+		} else {
+			s.recordUnknownLogin(ctx, origin)
 		}
 		return nil, oops.Code("AUTH_INVALID_CREDENTIALS").Errorf("invalid username or password")
 	}
 
 	// SECURITY: Check lockout AFTER password verification to maintain constant time.
 	if player.IsLocked() {
+		s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginFailed, origin, "account locked")
 		return nil, oops.Code("AUTH_ACCOUNT_LOCKED").
 			With("locked_until", player.LockedUntil).
 			Errorf("account is temporarily locked")
 	}
+	if err := s.checkLoginPlayer(ctx, player, origin); err != nil {
+		return nil, err
+	}
 
-	// Success - reset failure counter
+	// Success - reset failure counter, and move a legacy hash to argon2id
+	// while the password is at hand; both are saved by the update below.
 	player.RecordSuccess()
+	s.upgradePasswordHash(ctx, player, password)
 
 	if err := s.players.Update(ctx, player); err != nil {
 		s.logger.WarnContext(
@@ -120,10 +136,34 @@ func (s *Service) ValidateCredentials(ctx context.Context, username, password st
 			"error", err.Error(),
 		)
 	}
+	s.recordSecurityEvent(ctx, player.ID, SecurityEventLoginSucceeded, origin, "")
 
 	return player, nil
 }
 
+// upgradePasswordHash rehashes the password of a player whose stored hash is
+// in a legacy format, such as a bcrypt hash brought over by a character
+// import. A hashing failure keeps the old hash; the next login tries again.
+func (s *Service) upgradePasswordHash(ctx context.Context, player *Player, password string) {
+	if !s.hasher.NeedsUpgrade(player.PasswordHash) {
+		return
+	}
+	hash, err := s.hasher.Hash(password)
+	if err != nil {
+		s.logger.WarnContext(ctx, "password hash upgrade failed",
+			"event", "password_hash_upgrade_failed",
+			"player_id", player.ID.String(),
+			"error", err.Error(),
+		)
+		return
+	}
+	player.PasswordHash = hash
+	s.logger.InfoContext(ctx, "password hash upgraded",
+		"event", "password_hash_upgraded",
+		"player_id", player.ID.String(),
+	)
+}
+
 // CreatePlayer creates a new player account with the given credentials.
 // Validates the username and password, checks for username availability,
 // hashes the password, and persists the new player.
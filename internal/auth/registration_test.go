@@ -103,6 +103,7 @@ func TestService_ValidateCredentials(t *testing.T) {
 
 		playerRepo.On("GetByUsername", ctx, "testuser").Return(player, nil)
 		hasher.On("Verify", "password123", player.PasswordHash).Return(true, nil)
+		hasher.On("NeedsUpgrade", player.PasswordHash).Return(false)
 		playerRepo.On("Update", ctx, mock.AnythingOfType("*auth.Player")).Return(nil)
 
 		result, err := svc.ValidateCredentials(ctx, "testuser", "password123")
@@ -111,6 +112,46 @@ func TestService_ValidateCredentials(t *testing.T) {
 		assert.Equal(t, playerID, result.ID)
 	})
 
+	t.Run("legacy hash is upgraded on success", func(t *testing.T) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		hasher := mocks.NewMockPasswordHasher(t)
+		svc, err := auth.NewAuthService(playerRepo, mocks.NewMockPlayerSessionRepository(t), hasher)
+		require.NoError(t, err)
+
+		legacy := "$2a$10$N9qo8uLOickgx2ZMRZoMyeIvNq.Uf3hE9tQALNP1Qn9sNp5x5x5x5"
+		player := &auth.Player{ID: ulid.Make(), Username: "testuser", PasswordHash: legacy}
+		playerRepo.On("GetByUsername", ctx, "testuser").Return(player, nil)
+		hasher.On("Verify", "password123", legacy).Return(true, nil)
+		hasher.On("NeedsUpgrade", legacy).Return(true)
+		hasher.On("Hash", "password123").Return("$argon2id$upgraded", nil)
+		playerRepo.On("Update", ctx, mock.MatchedBy(func(p *auth.Player) bool {
+			return p.PasswordHash == "$argon2id$upgraded"
+		})).Return(nil)
+
+		_, err = svc.ValidateCredentials(ctx, "testuser", "password123")
+		require.NoError(t, err)
+	})
+
+	t.Run("failed hash upgrade keeps the legacy hash", func(t *testing.T) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		hasher := mocks.NewMockPasswordHasher(t)
+		svc, err := auth.NewAuthService(playerRepo, mocks.NewMockPlayerSessionRepository(t), hasher)
+		require.NoError(t, err)
+
+		legacy := "$2a$10$N9qo8uLOickgx2ZMRZoMyeIvNq.Uf3hE9tQALNP1Qn9sNp5x5x5x5"
+		player := &auth.Player{ID: ulid.Make(), Username: "testuser", PasswordHash: legacy}
+		playerRepo.On("GetByUsername", ctx, "testuser").Return(player, nil)
+		hasher.On("Verify", "password123", legacy).Return(true, nil)
+		hasher.On("NeedsUpgrade", legacy).Return(true)
+		hasher.On("Hash", "password123").Return("", errors.New("out of memory"))
+		playerRepo.On("Update", ctx, mock.MatchedBy(func(p *auth.Player) bool {
+			return p.PasswordHash == legacy
+		})).Return(nil)
+
+		_, err = svc.ValidateCredentials(ctx, "testuser", "password123")
+		require.NoError(t, err)
+	})
+
 	t.Run("invalid password returns error", func(t *testing.T) {
 		playerRepo := mocks.NewMockPlayerRepository(t)
 		hasher := mocks.NewMockPasswordHasher(t)
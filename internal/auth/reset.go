@@ -21,14 +21,22 @@ import (
 const (
 	ResetTokenBytes  = 32        // 32 bytes = 64 hex chars
 	ResetTokenExpiry = time.Hour // 1 hour expiry
+	// MaxOutstandingResets bounds how many unused, unexpired resets a player
+	// may hold from RequestReset at once.
+	MaxOutstandingResets = 3
 )
 
-// PasswordReset represents a password reset request.
+// PasswordReset represents a password reset request. Only the SHA-256 hash
+// of its token is stored.
 type PasswordReset struct {
 	ID        ulid.ULID
 	PlayerID  ulid.ULID
 	TokenHash string
 	ExpiresAt time.Time
+	// UsedAt is when the token was consumed, or zero while it is unused. A
+	// used reset is kept until it expires so a replayed token is recognised
+	// as reuse rather than as an unknown token.
+	UsedAt    time.Time
 	CreatedAt time.Time
 }
 
@@ -60,6 +68,11 @@ func (r *PasswordReset) IsExpired() bool {
 	return time.Now().After(r.ExpiresAt)
 }
 
+// IsUsed returns true if the reset token has been consumed.
+func (r *PasswordReset) IsUsed() bool {
+	return !r.UsedAt.IsZero()
+}
+
 // IsExpiredAt returns true if the reset token would be expired at the given time.
 // Useful for testing with deterministic time values.
 func (r *PasswordReset) IsExpiredAt(t time.Time) bool {
@@ -110,19 +123,29 @@ type PasswordResetRepository interface {
 	// Create stores a new password reset request.
 	Create(ctx context.Context, reset *PasswordReset) error
 
+	// CreateWithLimit stores a new password reset request unless the player
+	// already holds maxOutstanding unused, unexpired resets, in which case it
+	// returns an error wrapping ErrTooManyResets. The count and the insert
+	// are serialized per player, so concurrent requests cannot overshoot the
+	// limit. A maxOutstanding value <= 0 disables the limit.
+	CreateWithLimit(ctx context.Context, reset *PasswordReset, maxOutstanding int) error
+
 	// GetByPlayer retrieves the most recent reset request for a player,
 	// ordered by CreatedAt descending. Multiple reset tokens may exist
-	// concurrently; all are deleted only after successful password reset.
+	// concurrently.
 	GetByPlayer(ctx context.Context, playerID ulid.ULID) (*PasswordReset, error)
 
-	// GetByTokenHash retrieves a reset request by its token hash.
+	// GetByTokenHash retrieves a reset request by its token hash, used or
+	// not.
 	GetByTokenHash(ctx context.Context, tokenHash string) (*PasswordReset, error)
 
-	// ConsumeByTokenHash atomically deletes and returns the reset request
-	// matching the token hash. Exactly one caller succeeds for a given token;
-	// concurrent or subsequent callers receive ErrNotFound. This closes the
-	// validate-then-delete race in ResetPassword by making token consumption
-	// atomic with validation (implemented as DELETE ... RETURNING).
+	// ConsumeByTokenHash atomically marks the unused reset request matching
+	// the token hash as used, marks the player's other unused requests used
+	// too, and returns the consumed request. Exactly one caller succeeds for
+	// a given token; concurrent or subsequent callers receive ErrNotFound, as
+	// do callers with an unknown token. An expired request is consumed, but
+	// its siblings are left alone: an expired token must not cancel a
+	// reset the player asked for since.
 	ConsumeByTokenHash(ctx context.Context, tokenHash string) (*PasswordReset, error)
 
 	// Delete removes a password reset request.
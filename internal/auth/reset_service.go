@@ -20,6 +20,7 @@ type PasswordResetService struct {
 	sessions   PlayerSessionRepository
 	hasher     PasswordHasher
 	logger     *slog.Logger
+	security   SecurityRecorder // optional: nil disables security event recording
 }
 
 // NewPasswordResetService creates a new PasswordResetService with a no-op logger.
@@ -84,10 +85,20 @@ func NewPasswordResetServiceWithLogger(
 	}, nil
 }
 
+// SetSecurityRecorder enables recording of completed password resets and
+// reset token reuse in the player's security event log. Passing nil disables
+// it.
+func (s *PasswordResetService) SetSecurityRecorder(r SecurityRecorder) {
+	s.security = r
+}
+
 // RequestReset requests a password reset for a player by email.
 // If the player exists, generates a reset token and stores the hash.
 // Returns the plaintext token for sending via email (email sending is NOT this service's job).
 // If the player doesn't exist, returns success anyway (empty token) to prevent email enumeration.
+// A player may hold at most MaxOutstandingResets unused, unexpired resets;
+// past that it returns RESET_LIMIT_REACHED, which callers must not reveal to
+// the requester any more than an unknown email.
 func (s *PasswordResetService) RequestReset(ctx context.Context, email string) (string, error) {
 	player, err := s.playerRepo.GetByEmail(ctx, email)
 	if err != nil {
@@ -115,9 +126,21 @@ func (s *PasswordResetService) RequestReset(ctx context.Context, email string) (
 			Wrap(err)
 	}
 
-	if err := s.resetRepo.Create(ctx, reset); err != nil {
+	if err := s.resetRepo.CreateWithLimit(ctx, reset, MaxOutstandingResets); err != nil {
+		if errors.Is(err, ErrTooManyResets) {
+			s.logger.WarnContext(
+				ctx,
+				"password reset limit reached",
+				"event", "reset_limit_reached",
+				"player_id", player.ID.String(),
+				"limit", MaxOutstandingResets,
+			)
+			return "", oops.Code("RESET_LIMIT_REACHED").
+				With("player_id", player.ID.String()).
+				Wrap(err)
+		}
 		return "", oops.Code("RESET_REQUEST_FAILED").
-			With("operation", "Create").
+			With("operation", "CreateWithLimit").
 			Wrap(err)
 	}
 
@@ -125,7 +148,8 @@ func (s *PasswordResetService) RequestReset(ctx context.Context, email string) (
 }
 
 // ValidateToken validates a reset token and returns the associated player ID.
-// Returns an error if the token is invalid, expired, or not found.
+// Returns an error if the token is invalid, expired, or not found. A token
+// that was already used is invalid, and the attempt is recorded as reuse.
 func (s *PasswordResetService) ValidateToken(ctx context.Context, token string) (ulid.ULID, error) {
 	if token == "" {
 		return ulid.ULID{}, oops.Code("RESET_TOKEN_EMPTY").Errorf("reset token cannot be empty")
@@ -145,6 +169,11 @@ func (s *PasswordResetService) ValidateToken(ctx context.Context, token string)
 			Wrap(err)
 	}
 
+	if reset.IsUsed() {
+		s.recordReuse(ctx, reset)
+		return ulid.ULID{}, oops.Code("RESET_TOKEN_INVALID").Errorf("reset token already used")
+	}
+
 	// Check if expired
 	if reset.IsExpired() {
 		return ulid.ULID{}, oops.Code("RESET_TOKEN_EXPIRED").Errorf("reset token has expired")
@@ -154,8 +183,10 @@ func (s *PasswordResetService) ValidateToken(ctx context.Context, token string)
 }
 
 // ResetPassword resets a player's password using a valid reset token.
-// Validates the token, hashes the new password, updates the player's password,
-// and deletes all reset tokens for the player.
+// Validates the token, hashes the new password, and updates the player's
+// password. Consuming the token marks it and the player's other reset tokens
+// used; used tokens are kept until they expire so that presenting one again
+// is recorded as reuse.
 func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPassword string) error {
 	// SECURITY: enforce the same length policy as registration and login.
 	// A valid reset token must not be a backdoor to set a weak (or oversized)
@@ -169,12 +200,13 @@ func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPass
 		return err
 	}
 
-	// SECURITY: consume the token atomically (DELETE ... RETURNING) so that
-	// exactly one caller receives the player_id and proceeds. A previous
-	// implementation did ValidateToken -> UpdatePassword -> DeleteByPlayer as
-	// separate operations; two concurrent requests with the same token could
-	// both observe it as valid before either deletion landed. Atomic consume
-	// closes that race: losers of the DELETE see ErrNotFound.
+	// SECURITY: consume the token atomically (one UPDATE ... RETURNING that
+	// also invalidates the player's other tokens) so that exactly one caller
+	// receives the player_id and proceeds. A previous implementation did
+	// ValidateToken -> UpdatePassword -> DeleteByPlayer as separate
+	// operations; two concurrent requests with the same token could both
+	// observe it as valid before either deletion landed. Atomic consume
+	// closes that race: losers see ErrNotFound.
 	if token == "" {
 		return oops.Code("RESET_TOKEN_EMPTY").Errorf("reset token cannot be empty")
 	}
@@ -182,6 +214,9 @@ func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPass
 	reset, err := s.resetRepo.ConsumeByTokenHash(ctx, hash)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
+			if s.checkReuse(ctx, hash) {
+				return oops.Code("RESET_TOKEN_INVALID").Errorf("reset token already used")
+			}
 			return oops.Code("RESET_TOKEN_INVALID").Errorf("reset token not found")
 		}
 		return oops.Code("RESET_VALIDATE_FAILED").
@@ -208,6 +243,10 @@ func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPass
 			Wrap(err)
 	}
 
+	if s.security != nil {
+		s.security.Record(ctx, playerID, SecurityEventPasswordChanged, SecurityOrigin{}, "reset token")
+	}
+
 	// Invalidate all active sessions for the player.
 	// This is best-effort — if it fails, the password was still updated successfully.
 	// TODO: Consider making session invalidation mandatory (return error on failure).
@@ -224,19 +263,45 @@ func (s *PasswordResetService) ResetPassword(ctx context.Context, token, newPass
 		)
 	}
 
-	// Delete any sibling reset tokens for the player (the consumed one is
-	// already gone). Best-effort cleanup — failure here does not fail the
-	// reset, since the token actually used has been atomically consumed.
-	if err := s.resetRepo.DeleteByPlayer(ctx, playerID); err != nil {
-		s.logger.WarnContext(
-			ctx,
-			"best-effort token cleanup failed",
-			"event", "token_cleanup_failed",
-			"player_id", playerID.String(),
-			"operation", "delete_tokens",
-			"error", err.Error(),
-		)
+	return nil
+}
+
+// checkReuse reports whether tokenHash names a reset that was already used,
+// recording the attempt if so. Lookup failures are logged and read as not
+// reused: the caller rejects the token either way.
+func (s *PasswordResetService) checkReuse(ctx context.Context, tokenHash string) bool {
+	reset, err := s.resetRepo.GetByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			s.logger.WarnContext(
+				ctx,
+				"reset token reuse check failed",
+				"event", "reset_reuse_check_failed",
+				"operation", "GetByTokenHash",
+				"error", err.Error(),
+			)
+		}
+		return false
+	}
+	if !reset.IsUsed() {
+		return false
 	}
+	s.recordReuse(ctx, reset)
+	return true
+}
 
-	return nil
+// recordReuse logs an attempt to use the already-used reset and records it
+// in the player's security event log.
+func (s *PasswordResetService) recordReuse(ctx context.Context, reset *PasswordReset) {
+	s.logger.WarnContext(
+		ctx,
+		"used password reset token presented again",
+		"event", "reset_token_reused",
+		"player_id", reset.PlayerID.String(),
+		"reset_id", reset.ID.String(),
+		"used_at", reset.UsedAt.UTC().Format(time.RFC3339),
+	)
+	if s.security != nil {
+		s.security.Record(ctx, reset.PlayerID, SecurityEventPasswordResetReused, SecurityOrigin{}, "reset token")
+	}
 }
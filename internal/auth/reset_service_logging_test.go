@@ -34,6 +34,22 @@ func (m *mockSessionRepoForReset) GetByTokenHash(_ context.Context, _ string) (*
 	return nil, auth.ErrNotFound
 }
 
+func (m *mockSessionRepoForReset) GetByRefreshTokenHash(_ context.Context, _ string) (*auth.PlayerSession, error) {
+	return nil, auth.ErrNotFound
+}
+
+func (m *mockSessionRepoForReset) RotateTokens(_ context.Context, _ *auth.PlayerSession, _ string) error {
+	return nil
+}
+
+func (m *mockSessionRepoForReset) SetDeviceLabel(_ context.Context, _ ulid.ULID, _ string) error {
+	return nil
+}
+
+func (m *mockSessionRepoForReset) SetTokenHash(_ context.Context, _ ulid.ULID, _ string) error {
+	return nil
+}
+
 func (m *mockSessionRepoForReset) Delete(_ context.Context, _ ulid.ULID) error {
 	return nil
 }
@@ -66,17 +82,24 @@ func (m *mockSessionRepoForReset) DeleteOldestForPlayer(_ context.Context, _ uli
 	return nil, nil
 }
 
-// mockResetRepoLogging is a mock that can fail on DeleteByPlayer for testing logging.
+// mockResetRepoLogging is a mock that can fail on GetByTokenHash for testing logging.
 type mockResetRepoLogging struct {
 	reset          *auth.PasswordReset
-	deleteByPlayer error
+	getByTokenHash error
 }
 
 func (m *mockResetRepoLogging) Create(_ context.Context, _ *auth.PasswordReset) error {
 	return nil
 }
 
+func (m *mockResetRepoLogging) CreateWithLimit(_ context.Context, _ *auth.PasswordReset, _ int) error {
+	return nil
+}
+
 func (m *mockResetRepoLogging) GetByTokenHash(_ context.Context, _ string) (*auth.PasswordReset, error) {
+	if m.getByTokenHash != nil {
+		return nil, m.getByTokenHash
+	}
 	if m.reset == nil {
 		return nil, auth.ErrNotFound
 	}
@@ -94,7 +117,7 @@ func (m *mockResetRepoLogging) ConsumeByTokenHash(_ context.Context, _ string) (
 }
 
 func (m *mockResetRepoLogging) DeleteByPlayer(_ context.Context, _ ulid.ULID) error {
-	return m.deleteByPlayer
+	return nil
 }
 
 func (m *mockResetRepoLogging) DeleteExpired(_ context.Context) (int64, error) {
@@ -159,19 +182,14 @@ func (m *mockPlayerRepoForReset) DeleteGuestPlayer(_ context.Context, _ ulid.ULI
 	return nil
 }
 
-func TestPasswordResetServiceLogsDeleteByPlayerFailureOnReset(t *testing.T) {
-	// Setup: valid reset token exists, password update succeeds, but DeleteByPlayer fails
-	playerID := ulid.Make()
-	token, hash, err := auth.GenerateResetToken()
-	require.NoError(t, err)
-
-	reset, err := auth.NewPasswordReset(playerID, hash, time.Now().Add(time.Hour))
+func TestPasswordResetServiceLogsReuseCheckFailure(t *testing.T) {
+	// Setup: the token is not consumable, and the lookup that tells reuse
+	// apart from an unknown token fails
+	token, _, err := auth.GenerateResetToken()
 	require.NoError(t, err)
 
-	deleteErr := errors.New("cleanup connection refused")
 	resetRepo := &mockResetRepoLogging{
-		reset:          reset,
-		deleteByPlayer: deleteErr,
+		getByTokenHash: errors.New("lookup connection refused"),
 	}
 	playerRepo := &mockPlayerRepoForReset{}
 	sessionRepo := &mockSessionRepoForReset{}
@@ -184,10 +202,10 @@ func TestPasswordResetServiceLogsDeleteByPlayerFailureOnReset(t *testing.T) {
 	svc, err := auth.NewPasswordResetServiceWithLogger(playerRepo, resetRepo, sessionRepo, hasher, logger)
 	require.NoError(t, err)
 
-	// Reset password - this succeeds but DeleteByPlayer fails
+	// The token is still rejected; the failed lookup is only logged
 	err = svc.ResetPassword(context.Background(), token, "newpassword123")
-	require.NoError(t, err) // Reset should succeed despite cleanup failure
-	assert.True(t, playerRepo.passwordUpdated)
+	require.Error(t, err)
+	assert.False(t, playerRepo.passwordUpdated)
 
 	// Parse and verify log output
 	var entry logEntry
@@ -195,7 +213,7 @@ func TestPasswordResetServiceLogsDeleteByPlayerFailureOnReset(t *testing.T) {
 	require.NoError(t, err, "should have logged JSON entry")
 
 	assert.Equal(t, "WARN", entry.Level)
-	assert.Contains(t, entry.Msg, "best-effort")
-	assert.Equal(t, "delete_tokens", entry.Operation)
-	assert.Contains(t, entry.Error, "cleanup connection refused")
+	assert.Equal(t, "reset_reuse_check_failed", entry.Event)
+	assert.Equal(t, "GetByTokenHash", entry.Operation)
+	assert.Contains(t, entry.Error, "lookup connection refused")
 }
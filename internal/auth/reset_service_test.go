@@ -93,7 +93,7 @@ func TestPasswordResetService_RequestReset(t *testing.T) {
 		player := &auth.Player{ID: playerID, Email: &email}
 
 		playerRepo.On("GetByEmail", ctx, email).Return(player, nil)
-		resetRepo.On("Create", ctx, mock.AnythingOfType("*auth.PasswordReset")).Return(nil)
+		resetRepo.On("CreateWithLimit", ctx, mock.AnythingOfType("*auth.PasswordReset"), auth.MaxOutstandingResets).Return(nil)
 
 		token, err := svc.RequestReset(ctx, email)
 		require.NoError(t, err)
@@ -116,8 +116,8 @@ func TestPasswordResetService_RequestReset(t *testing.T) {
 		require.NoError(t, err)
 		assert.Empty(t, token) // No token returned for non-existent player
 
-		// resetRepo.Create should NOT be called
-		resetRepo.AssertNotCalled(t, "Create")
+		// resetRepo.CreateWithLimit should NOT be called
+		resetRepo.AssertNotCalled(t, "CreateWithLimit")
 	})
 
 	t.Run("propagates repository errors", func(t *testing.T) {
@@ -150,13 +150,34 @@ func TestPasswordResetService_RequestReset(t *testing.T) {
 		player := &auth.Player{ID: playerID, Email: &email}
 
 		playerRepo.On("GetByEmail", ctx, email).Return(player, nil)
-		resetRepo.On("Create", ctx, mock.AnythingOfType("*auth.PasswordReset")).Return(assert.AnError)
+		resetRepo.On("CreateWithLimit", ctx, mock.AnythingOfType("*auth.PasswordReset"), auth.MaxOutstandingResets).Return(assert.AnError)
 
 		token, err := svc.RequestReset(ctx, email)
 		require.Error(t, err)
 		assert.Empty(t, token)
 		errutil.AssertErrorCode(t, err, "RESET_REQUEST_FAILED")
 	})
+
+	t.Run("refuses a player at the outstanding reset limit", func(t *testing.T) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		resetRepo := mocks.NewMockPasswordResetRepository(t)
+		sessionRepo := mocks.NewMockPlayerSessionRepository(t)
+		hasher := mocks.NewMockPasswordHasher(t)
+		svc, err := auth.NewPasswordResetService(playerRepo, resetRepo, sessionRepo, hasher)
+		require.NoError(t, err)
+
+		email := "test@example.com"
+		player := &auth.Player{ID: ulid.Make(), Email: &email}
+
+		playerRepo.On("GetByEmail", ctx, email).Return(player, nil)
+		resetRepo.On("CreateWithLimit", ctx, mock.AnythingOfType("*auth.PasswordReset"), auth.MaxOutstandingResets).
+			Return(oops.Wrap(auth.ErrTooManyResets))
+
+		token, err := svc.RequestReset(ctx, email)
+		assert.Empty(t, token)
+		errutil.AssertErrorCode(t, err, "RESET_LIMIT_REACHED")
+		assert.ErrorIs(t, err, auth.ErrTooManyResets)
+	})
 }
 
 func TestPasswordResetService_ValidateToken(t *testing.T) {
@@ -217,6 +238,36 @@ func TestPasswordResetService_ValidateToken(t *testing.T) {
 		errutil.AssertErrorCode(t, err, "RESET_TOKEN_EXPIRED")
 	})
 
+	t.Run("rejects a used token and records the reuse", func(t *testing.T) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		resetRepo := mocks.NewMockPasswordResetRepository(t)
+		sessionRepo := mocks.NewMockPlayerSessionRepository(t)
+		hasher := mocks.NewMockPasswordHasher(t)
+		svc, err := auth.NewPasswordResetService(playerRepo, resetRepo, sessionRepo, hasher)
+		require.NoError(t, err)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityRecorder(log)
+
+		token, tokenHash, err := auth.GenerateResetToken()
+		require.NoError(t, err)
+		playerID := ulid.Make()
+		reset := &auth.PasswordReset{
+			ID:        ulid.Make(),
+			PlayerID:  playerID,
+			TokenHash: tokenHash,
+			ExpiresAt: time.Now().Add(time.Hour),
+			UsedAt:    time.Now().Add(-time.Minute),
+		}
+
+		resetRepo.On("GetByTokenHash", ctx, tokenHash).Return(reset, nil)
+
+		resultPlayerID, err := svc.ValidateToken(ctx, token)
+		assert.Equal(t, ulid.ULID{}, resultPlayerID)
+		errutil.AssertErrorCode(t, err, "RESET_TOKEN_INVALID")
+		require.Equal(t, []auth.SecurityEventType{auth.SecurityEventPasswordResetReused}, events.types())
+		assert.Equal(t, playerID, events.events[0].PlayerID)
+	})
+
 	t.Run("returns error for non-existent token", func(t *testing.T) {
 		playerRepo := mocks.NewMockPlayerRepository(t)
 		resetRepo := mocks.NewMockPasswordResetRepository(t)
@@ -297,7 +348,6 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		hasher.On("Hash", newPassword).Return(hashedPassword, nil)
 		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil)
 		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
 
 		err = svc.ResetPassword(ctx, token, newPassword)
 		require.NoError(t, err)
@@ -305,6 +355,30 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		sessionRepo.AssertExpectations(t)
 	})
 
+	t.Run("records password change in security log", func(t *testing.T) {
+		playerRepo := mocks.NewMockPlayerRepository(t)
+		resetRepo := mocks.NewMockPasswordResetRepository(t)
+		sessionRepo := mocks.NewMockPlayerSessionRepository(t)
+		hasher := mocks.NewMockPasswordHasher(t)
+		svc, err := auth.NewPasswordResetService(playerRepo, resetRepo, sessionRepo, hasher)
+		require.NoError(t, err)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityRecorder(log)
+
+		token, tokenHash, err := auth.GenerateResetToken()
+		require.NoError(t, err)
+		playerID := ulid.Make()
+		reset := &auth.PasswordReset{ID: ulid.Make(), PlayerID: playerID, TokenHash: tokenHash, ExpiresAt: time.Now().Add(time.Hour)}
+
+		resetRepo.On("ConsumeByTokenHash", ctx, tokenHash).Return(reset, nil)
+		hasher.On("Hash", "newSecurePassword123").Return("hashed", nil)
+		playerRepo.On("UpdatePassword", ctx, playerID, "hashed").Return(nil)
+		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
+
+		require.NoError(t, svc.ResetPassword(ctx, token, "newSecurePassword123"))
+		assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventPasswordChanged}, events.types())
+	})
+
 	t.Run("returns error for invalid token", func(t *testing.T) {
 		playerRepo := mocks.NewMockPlayerRepository(t)
 		resetRepo := mocks.NewMockPasswordResetRepository(t)
@@ -317,6 +391,7 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		newPassword := "newSecurePassword123"
 
 		resetRepo.On("ConsumeByTokenHash", ctx, mock.AnythingOfType("string")).Return(nil, auth.ErrNotFound)
+		resetRepo.On("GetByTokenHash", ctx, mock.AnythingOfType("string")).Return(nil, auth.ErrNotFound)
 
 		resetErr := svc.ResetPassword(ctx, token, newPassword)
 		require.Error(t, resetErr)
@@ -344,7 +419,7 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		}
 		newPassword := "newSecurePassword123"
 
-		// Consume still burns the token (atomic DELETE ... RETURNING) even when
+		// Consume still burns the token (atomic UPDATE ... RETURNING) even when
 		// it is expired — the token is one-shot regardless of expiry.
 		resetRepo.On("ConsumeByTokenHash", ctx, tokenHash).Return(reset, nil)
 
@@ -468,40 +543,6 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		errutil.AssertErrorCode(t, err, "RESET_PASSWORD_FAILED")
 	})
 
-	t.Run("propagates reset deletion errors but still succeeds", func(t *testing.T) {
-		// Password was updated, so even if deletion fails, the reset is complete
-		playerRepo := mocks.NewMockPlayerRepository(t)
-		resetRepo := mocks.NewMockPasswordResetRepository(t)
-		sessionRepo := mocks.NewMockPlayerSessionRepository(t)
-		hasher := mocks.NewMockPasswordHasher(t)
-		svc, err := auth.NewPasswordResetService(playerRepo, resetRepo, sessionRepo, hasher)
-		require.NoError(t, err)
-
-		// Generate a real token
-		token, tokenHash, err := auth.GenerateResetToken()
-		require.NoError(t, err)
-
-		playerID := ulid.Make()
-		reset := &auth.PasswordReset{
-			ID:        ulid.Make(),
-			PlayerID:  playerID,
-			TokenHash: tokenHash,
-			ExpiresAt: time.Now().Add(time.Hour),
-		}
-		newPassword := "newSecurePassword123"
-		hashedPassword := "$argon2id$v=19$m=65536,t=1,p=4$salt$hash"
-
-		resetRepo.On("ConsumeByTokenHash", ctx, tokenHash).Return(reset, nil)
-		hasher.On("Hash", newPassword).Return(hashedPassword, nil)
-		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil)
-		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(assert.AnError)
-
-		// Password was updated successfully, so we return success even if token cleanup fails
-		err = svc.ResetPassword(ctx, token, newPassword)
-		require.NoError(t, err)
-	})
-
 	t.Run("token cannot be reused after successful reset", func(t *testing.T) {
 		playerRepo := mocks.NewMockPlayerRepository(t)
 		resetRepo := mocks.NewMockPasswordResetRepository(t)
@@ -509,6 +550,8 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		hasher := mocks.NewMockPasswordHasher(t)
 		svc, err := auth.NewPasswordResetService(playerRepo, resetRepo, sessionRepo, hasher)
 		require.NoError(t, err)
+		log, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityRecorder(log)
 
 		// Generate a real token
 		token, tokenHash, err := auth.GenerateResetToken()
@@ -530,17 +573,24 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 		hasher.On("Hash", newPassword1).Return(hashedPassword, nil)
 		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil)
 		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
 
 		err = svc.ResetPassword(ctx, token, newPassword1)
 		require.NoError(t, err)
 
-		// Second reset with same token fails - token was atomically consumed
+		// Second reset with same token fails - token was atomically consumed,
+		// and the used row it left behind marks the attempt as reuse
+		used := *reset
+		used.UsedAt = time.Now()
 		resetRepo.On("ConsumeByTokenHash", ctx, tokenHash).Return(nil, auth.ErrNotFound).Once()
+		resetRepo.On("GetByTokenHash", ctx, tokenHash).Return(&used, nil).Once()
 
 		err = svc.ResetPassword(ctx, token, newPassword2)
 		require.Error(t, err)
 		errutil.AssertErrorCode(t, err, "RESET_TOKEN_INVALID")
+		assert.Equal(t, []auth.SecurityEventType{
+			auth.SecurityEventPasswordChanged,
+			auth.SecurityEventPasswordResetReused,
+		}, events.types())
 	})
 
 	t.Run("concurrent reset with same token: exactly one caller succeeds", func(t *testing.T) {
@@ -569,7 +619,7 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 
 		// Simulate atomicity: exactly one ConsumeByTokenHash call wins (returns
 		// the record), all subsequent callers see ErrNotFound. A real database
-		// provides this via DELETE ... RETURNING; here a single atomic CAS
+		// provides this via UPDATE ... RETURNING; here a single atomic CAS
 		// computes the whole return pair in one closure.
 		var consumed atomic.Bool
 		resetRepo.EXPECT().ConsumeByTokenHash(ctx, tokenHash).RunAndReturn(
@@ -581,11 +631,13 @@ func TestPasswordResetService_ResetPassword(t *testing.T) {
 			},
 		).Maybe()
 
+		// Losers look the token up to tell reuse from an unknown token.
+		resetRepo.EXPECT().GetByTokenHash(ctx, tokenHash).Return(nil, auth.ErrNotFound).Maybe()
+
 		// Only the winning caller reaches Hash / UpdatePassword / cleanups.
 		hasher.On("Hash", mock.AnythingOfType("string")).Return(hashedPassword, nil).Once()
 		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil).Once()
 		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil).Once()
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(nil).Once()
 
 		const goroutines = 8
 		errs := make([]error, goroutines)
@@ -650,7 +702,6 @@ func TestPasswordResetService_ResetPassword_InvalidatesSessions(t *testing.T) {
 		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil)
 		// Expect session invalidation with the correct playerID
 		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
 
 		err = svc.ResetPassword(ctx, token, newPassword)
 		require.NoError(t, err)
@@ -685,7 +736,6 @@ func TestPasswordResetService_ResetPassword_InvalidatesSessions(t *testing.T) {
 		playerRepo.On("UpdatePassword", ctx, playerID, hashedPassword).Return(nil)
 		// Session invalidation fails
 		sessionRepo.On("DeleteByPlayer", ctx, playerID).Return(assert.AnError)
-		resetRepo.On("DeleteByPlayer", ctx, playerID).Return(nil)
 
 		// Reset should still succeed even though session invalidation failed
 		err = svc.ResetPassword(ctx, token, newPassword)
@@ -206,6 +206,15 @@ func TestPasswordReset_IsExpiredAt(t *testing.T) {
 	})
 }
 
+func TestPasswordReset_IsUsed(t *testing.T) {
+	reset, err := auth.NewPasswordReset(ulid.Make(), "somehash", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, reset.IsUsed(), "a new reset is unused")
+
+	reset.UsedAt = time.Now()
+	assert.True(t, reset.IsUsed())
+}
+
 func TestNewPasswordReset(t *testing.T) {
 	validPlayerID := ulid.Make()
 	validHash := "abc123def456"
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+)
+
+// Auditor is the audit pipeline security events are mirrored into, so
+// authentication events share one queryable stream with access decisions.
+// *audit.Logger satisfies it.
+type Auditor interface {
+	Log(ctx context.Context, event audit.Event) error
+}
+
+// auditComponent is the Component stamped on every auth audit event.
+const auditComponent = "auth"
+
+// Audit-only actions. These have no row in the player's security log:
+// expiry is not an account action the player needs to review, and a failed
+// login for an unknown username has no player to attach it to.
+const (
+	auditActionSessionExpired      = "session_expired"
+	auditActionLoginUnknown        = "login_failed"
+	auditActionImpersonationDenied = "impersonation_denied"
+)
+
+// SetAuditor mirrors every security event into a. Passing nil stops
+// mirroring. Mirroring goes through the logger's mode like any other audit
+// event: failed logins are denials and always recorded; the rest are
+// allows, recorded only in "all" mode.
+func (l *SecurityLog) SetAuditor(a Auditor) {
+	l.auditor = a
+}
+
+// RecordSessionExpired audits the expiry of playerID's game session
+// sessionID. A zero playerID (a guest) is not audited.
+func (l *SecurityLog) RecordSessionExpired(ctx context.Context, playerID ulid.ULID, sessionID string) {
+	if playerID.Compare(ulid.ULID{}) == 0 {
+		return
+	}
+	l.audit(ctx, access.PlayerSubject(playerID.String()), auditActionSessionExpired, types.EffectAllow,
+		SecurityOrigin{}, "session "+sessionID)
+}
+
+// RecordUnknownLogin audits a failed login for a username that matches no
+// player. The username itself is not recorded: a password typed into the
+// wrong field would otherwise land in the audit log.
+func (l *SecurityLog) RecordUnknownLogin(ctx context.Context, origin SecurityOrigin) {
+	l.audit(ctx, "", auditActionLoginUnknown, types.EffectDeny, origin, "unknown username")
+}
+
+// RecordImpersonationDenied audits a refused attempt by staffID to
+// impersonate playerID. The target's security log is left alone: the attempt
+// never touched their account.
+func (l *SecurityLog) RecordImpersonationDenied(ctx context.Context, staffID, playerID ulid.ULID, origin SecurityOrigin) {
+	l.audit(ctx, access.PlayerSubject(staffID.String()), auditActionImpersonationDenied, types.EffectDeny,
+		origin, "impersonate player "+playerID.String())
+}
+
+// auditEvent mirrors a recorded security event into the audit pipeline.
+func (l *SecurityLog) auditEvent(ctx context.Context, event *SecurityEvent) {
+	effect := types.EffectAllow
+	if event.Type == SecurityEventLoginFailed {
+		effect = types.EffectDeny
+	}
+	l.audit(ctx, access.PlayerSubject(event.PlayerID.String()), string(event.Type), effect,
+		SecurityOrigin{IPAddress: event.IPAddress, UserAgent: event.UserAgent}, event.Detail)
+}
+
+// audit writes one auth audit event. Best-effort like Record: a failure is
+// logged and never fails the account action.
+func (l *SecurityLog) audit(ctx context.Context, subject, action string, effect types.Effect, origin SecurityOrigin, detail string) {
+	if l.auditor == nil {
+		return
+	}
+	attrs := map[string]any{}
+	if origin.IPAddress != "" {
+		attrs["ip_address"] = origin.IPAddress
+	}
+	if origin.UserAgent != "" {
+		attrs["user_agent"] = origin.UserAgent
+	}
+	err := l.auditor.Log(ctx, audit.Event{
+		ID:         auditComponent + "." + action,
+		Name:       action,
+		Message:    detail,
+		Source:     audit.SourceAuth,
+		Component:  auditComponent,
+		Subject:    subject,
+		Action:     action,
+		Resource:   subject,
+		Effect:     effect,
+		Attributes: attrs,
+		Timestamp:  time.Now(),
+	})
+	if err != nil {
+		l.logger.WarnContext(
+			ctx, "security event not audited",
+			"event", "security_audit_failed",
+			"subject", subject,
+			"action", action,
+			"error", err.Error(),
+		)
+	}
+}
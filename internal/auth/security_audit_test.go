@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/core"
+)
+
+// recordingAuditor captures every audit event it is asked to log, and the
+// impersonator carried by each call's context.
+type recordingAuditor struct {
+	mu            sync.Mutex
+	events        []audit.Event
+	impersonators []string
+	err           error
+}
+
+func (a *recordingAuditor) Log(ctx context.Context, event audit.Event) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.events = append(a.events, event)
+	impersonator, _ := core.ImpersonatorFromContext(ctx)
+	a.impersonators = append(a.impersonators, impersonator)
+	return a.err
+}
+
+func (a *recordingAuditor) actions() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := make([]string, len(a.events))
+	for i, e := range a.events {
+		out[i] = e.Action
+	}
+	return out
+}
+
+func TestSecurityLogMirrorsEventsIntoAudit(t *testing.T) {
+	ctx := context.Background()
+	log, events, _ := newTestSecurityLog(t)
+	auditor := &recordingAuditor{}
+	log.SetAuditor(auditor)
+	playerID := ulid.Make()
+
+	log.Record(ctx, playerID, auth.SecurityEventLoginFailed,
+		auth.SecurityOrigin{IPAddress: "198.51.100.1", UserAgent: "ua"}, "invalid password")
+	log.Record(ctx, playerID, auth.SecurityEventPasswordChanged, auth.SecurityOrigin{}, "reset token")
+
+	require.Len(t, auditor.events, 2)
+	failed := auditor.events[0]
+	assert.Equal(t, audit.SourceAuth, failed.Source)
+	assert.Equal(t, "auth", failed.Component)
+	assert.Equal(t, "auth.login_failed", failed.ID)
+	assert.Equal(t, "player:"+playerID.String(), failed.Subject)
+	assert.Equal(t, "login_failed", failed.Action)
+	assert.Equal(t, types.EffectDeny, failed.Effect)
+	assert.Equal(t, "invalid password", failed.Message)
+	assert.Equal(t, map[string]any{"ip_address": "198.51.100.1", "user_agent": "ua"}, failed.Attributes)
+
+	changed := auditor.events[1]
+	assert.Equal(t, types.EffectAllow, changed.Effect)
+	assert.Empty(t, changed.Attributes)
+	assert.Len(t, events.types(), 2, "mirroring does not replace the player's own log")
+}
+
+func TestSecurityLogAuditsEvenWhenStoreFails(t *testing.T) {
+	ctx := context.Background()
+	log, events, _ := newTestSecurityLog(t)
+	events.createErr = errors.New("db down")
+	auditor := &recordingAuditor{}
+	log.SetAuditor(auditor)
+
+	log.Record(ctx, ulid.Make(), auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{}, "")
+	assert.Equal(t, []string{"login_succeeded"}, auditor.actions())
+}
+
+func TestSecurityLogSwallowsAuditErrors(t *testing.T) {
+	ctx := context.Background()
+	log, events, _ := newTestSecurityLog(t)
+	log.SetAuditor(&recordingAuditor{err: errors.New("audit down")})
+
+	assert.NotPanics(t, func() {
+		log.Record(ctx, ulid.Make(), auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{}, "")
+	})
+	assert.Len(t, events.types(), 1)
+}
+
+func TestSecurityLogRecordSessionExpired(t *testing.T) {
+	ctx := context.Background()
+	log, events, _ := newTestSecurityLog(t)
+	auditor := &recordingAuditor{}
+	log.SetAuditor(auditor)
+	playerID := ulid.Make()
+
+	log.RecordSessionExpired(ctx, playerID, "sess-1")
+	log.RecordSessionExpired(ctx, ulid.ULID{}, "guest-sess")
+
+	require.Len(t, auditor.events, 1, "guest sessions have no player to audit")
+	assert.Equal(t, "session_expired", auditor.events[0].Action)
+	assert.Equal(t, "player:"+playerID.String(), auditor.events[0].Subject)
+	assert.Equal(t, "session sess-1", auditor.events[0].Message)
+	assert.Empty(t, events.types(), "expiry is audit-only")
+}
+
+func TestAuthenticatePlayerAuditsUnknownUsername(t *testing.T) {
+	ctx := context.Background()
+	svc, playerRepo, _, hasher := newTestAuthServiceWithCap(t, 0)
+	log, events, _ := newTestSecurityLog(t)
+	auditor := &recordingAuditor{}
+	log.SetAuditor(auditor)
+	svc.SetSecurityLog(log)
+	playerRepo.On("GetByUsername", mock.Anything, "nobody").Return(nil, auth.ErrNotFound)
+	hasher.On("Verify", "guess", mock.Anything).Return(false, nil)
+
+	_, _, err := svc.AuthenticatePlayer(ctx, "nobody", "guess", "ua", "203.0.113.5")
+	require.Error(t, err)
+
+	require.Len(t, auditor.events, 1)
+	event := auditor.events[0]
+	assert.Equal(t, "login_failed", event.Action)
+	assert.Equal(t, types.EffectDeny, event.Effect)
+	assert.Empty(t, event.Subject)
+	assert.Equal(t, "203.0.113.5", event.Attributes["ip_address"])
+	assert.NotContains(t, event.Message, "nobody", "the attempted username is not recorded")
+	assert.Empty(t, events.types())
+}
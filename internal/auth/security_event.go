@@ -0,0 +1,387 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// SecurityEventType classifies an entry in a player's security event log.
+type SecurityEventType string
+
+// Security event types.
+const (
+	SecurityEventLoginSucceeded    SecurityEventType = "login_succeeded"
+	SecurityEventLoginFailed       SecurityEventType = "login_failed"
+	SecurityEventPasswordChanged   SecurityEventType = "password_changed"
+	SecurityEventSessionTerminated SecurityEventType = "session_terminated"
+	SecurityEventTwoFactorEnabled  SecurityEventType = "two_factor_enabled"
+	SecurityEventTwoFactorDisabled SecurityEventType = "two_factor_disabled"
+	// Impersonation events are recorded on both the staff member and the
+	// player they impersonated.
+	SecurityEventImpersonationStarted SecurityEventType = "impersonation_started"
+	SecurityEventImpersonationEnded   SecurityEventType = "impersonation_ended"
+	// Recovery events track a staff-approved account recovery: a request
+	// filed for the account, and staff approving it.
+	SecurityEventRecoveryRequested SecurityEventType = "recovery_requested"
+	SecurityEventRecoveryApproved  SecurityEventType = "recovery_approved"
+	// SecurityEventPasswordResetReused records an attempt to use a password
+	// reset token that was already used, or that another reset invalidated.
+	SecurityEventPasswordResetReused SecurityEventType = "password_reset_reused"
+	// Identity events record an OpenID Connect identity linked to or
+	// unlinked from the account; the detail names the provider.
+	SecurityEventIdentityLinked   SecurityEventType = "identity_linked"
+	SecurityEventIdentityUnlinked SecurityEventType = "identity_unlinked"
+)
+
+// Valid reports whether t is a known security event type.
+func (t SecurityEventType) Valid() bool {
+	switch t {
+	case SecurityEventLoginSucceeded, SecurityEventLoginFailed,
+		SecurityEventPasswordChanged, SecurityEventSessionTerminated,
+		SecurityEventTwoFactorEnabled, SecurityEventTwoFactorDisabled,
+		SecurityEventImpersonationStarted, SecurityEventImpersonationEnded,
+		SecurityEventRecoveryRequested, SecurityEventRecoveryApproved,
+		SecurityEventPasswordResetReused,
+		SecurityEventIdentityLinked, SecurityEventIdentityUnlinked:
+		return true
+	}
+	return false
+}
+
+// Security event log limits.
+const (
+	DefaultSecurityEventLimit = 50
+	MaxSecurityEventLimit     = 200
+	// MaxSecurityEventDetailLength bounds the free-form detail column.
+	MaxSecurityEventDetailLength = 512
+)
+
+// Alert thresholds for repeated login failures.
+const (
+	FailedLoginAlertThreshold = 5
+	FailedLoginAlertWindow    = 15 * time.Minute
+)
+
+// SecurityOrigin is where an account action came from. Both fields are
+// optional: in-game and CLI paths do not know the client address.
+type SecurityOrigin struct {
+	IPAddress string
+	UserAgent string
+}
+
+// SecurityEvent is one entry in a player's security event log.
+type SecurityEvent struct {
+	ID        ulid.ULID
+	PlayerID  ulid.ULID
+	Type      SecurityEventType
+	IPAddress string
+	UserAgent string
+	Detail    string
+	CreatedAt time.Time
+}
+
+// NewSecurityEvent creates a validated SecurityEvent. Detail is truncated to
+// at most MaxSecurityEventDetailLength bytes on a rune boundary.
+func NewSecurityEvent(playerID ulid.ULID, eventType SecurityEventType, origin SecurityOrigin, detail string) (*SecurityEvent, error) {
+	if playerID.Compare(ulid.ULID{}) == 0 {
+		return nil, oops.Code("SECURITY_EVENT_INVALID_PLAYER").Errorf("player ID cannot be zero")
+	}
+	if !eventType.Valid() {
+		return nil, oops.Code("SECURITY_EVENT_INVALID_TYPE").
+			With("type", string(eventType)).
+			Errorf("unknown security event type %q", eventType)
+	}
+	detail = truncateDetail(detail, MaxSecurityEventDetailLength)
+	return &SecurityEvent{
+		ID:        idgen.New(),
+		PlayerID:  playerID,
+		Type:      eventType,
+		IPAddress: origin.IPAddress,
+		UserAgent: origin.UserAgent,
+		Detail:    detail,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// truncateDetail shortens detail to at most n bytes without splitting a
+// UTF-8 rune.
+func truncateDetail(detail string, n int) string {
+	if len(detail) <= n {
+		return detail
+	}
+	for n > 0 && !utf8.RuneStart(detail[n]) {
+		n--
+	}
+	return detail[:n]
+}
+
+// SecurityEventFilter selects events for SecurityEventRepository.Count.
+// PlayerID is required; zero-valued optional fields match everything.
+type SecurityEventFilter struct {
+	PlayerID  ulid.ULID
+	Type      SecurityEventType
+	IPAddress string
+	Since     time.Time
+}
+
+// SecurityEventRepository persists player security events.
+type SecurityEventRepository interface {
+	// Create stores a new security event.
+	Create(ctx context.Context, event *SecurityEvent) error
+
+	// ListByPlayer returns up to limit events for the player, newest first.
+	ListByPlayer(ctx context.Context, playerID ulid.ULID, limit int) ([]*SecurityEvent, error)
+
+	// Count returns the number of events matching filter.
+	Count(ctx context.Context, filter SecurityEventFilter) (int, error)
+
+	// DeleteByPlayer removes every event for the player.
+	DeleteByPlayer(ctx context.Context, playerID ulid.ULID) error
+}
+
+// SecurityAlertKind names the suspicious pattern a SecurityAlert reports.
+type SecurityAlertKind string
+
+// Security alert kinds.
+const (
+	// SecurityAlertNewIP fires when a player with login history logs in from
+	// an address they have never logged in from before.
+	SecurityAlertNewIP SecurityAlertKind = "new_ip_login"
+	// SecurityAlertRepeatedFailures fires when a player's failed logins reach
+	// FailedLoginAlertThreshold within FailedLoginAlertWindow.
+	SecurityAlertRepeatedFailures SecurityAlertKind = "repeated_login_failures"
+)
+
+// SecurityAlert describes a suspicious pattern detected in a player's
+// security event log. Event is the entry that triggered it.
+type SecurityAlert struct {
+	Kind     SecurityAlertKind
+	PlayerID ulid.ULID
+	Event    *SecurityEvent
+}
+
+// Notifier delivers security alerts to the player or staff.
+type Notifier interface {
+	NotifySecurityAlert(ctx context.Context, alert SecurityAlert) error
+}
+
+// LogNotifier is a Notifier that writes alerts to the structured log. It is
+// the default until an out-of-band channel (e.g. email) is configured.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier creates a LogNotifier. A nil logger uses slog.Default().
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LogNotifier{logger: logger}
+}
+
+// NotifySecurityAlert logs alert at WARN.
+func (n *LogNotifier) NotifySecurityAlert(ctx context.Context, alert SecurityAlert) error {
+	attrs := []any{
+		"event", "security_alert",
+		"kind", string(alert.Kind),
+		"player_id", alert.PlayerID.String(),
+	}
+	if alert.Event != nil {
+		attrs = append(attrs, "ip_address", alert.Event.IPAddress, "security_event_id", alert.Event.ID.String())
+	}
+	n.logger.WarnContext(ctx, "account security alert", attrs...)
+	return nil
+}
+
+// SecurityRecorder records account security events. *SecurityLog satisfies
+// it; services that touch credentials, sessions, or 2FA take it as an
+// optional dependency.
+type SecurityRecorder interface {
+	Record(ctx context.Context, playerID ulid.ULID, eventType SecurityEventType, origin SecurityOrigin, detail string)
+}
+
+// SecurityLog records security events and raises alerts on suspicious
+// patterns. Recording is best-effort: a failure is logged and never fails the
+// account action that triggered it.
+type SecurityLog struct {
+	events   SecurityEventRepository
+	notifier Notifier
+	logger   *slog.Logger
+
+	// Optional: when set, every event is mirrored into the audit pipeline.
+	auditor Auditor
+}
+
+// NewSecurityLog creates a SecurityLog. events is required. A nil notifier
+// disables alerts; a nil logger uses slog.Default().
+func NewSecurityLog(events SecurityEventRepository, notifier Notifier, logger *slog.Logger) (*SecurityLog, error) {
+	if events == nil {
+		return nil, oops.Errorf("security event repository is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SecurityLog{events: events, notifier: notifier, logger: logger}, nil
+}
+
+// Record stores a security event for playerID, mirrors it into the audit
+// pipeline when an Auditor is set, and evaluates the alert rules. The audit
+// copy is written even when storing the event fails.
+func (l *SecurityLog) Record(ctx context.Context, playerID ulid.ULID, eventType SecurityEventType, origin SecurityOrigin, detail string) {
+	event, err := NewSecurityEvent(playerID, eventType, origin, detail)
+	if err != nil {
+		l.warn(ctx, "invalid security event", playerID, eventType, err)
+		return
+	}
+	l.auditEvent(ctx, event)
+
+	// The new-IP rule runs before the insert, so the login being recorded
+	// does not count as history for its own address; the failure rule runs
+	// after, so the threshold includes this failure.
+	var alert *SecurityAlert
+	if eventType == SecurityEventLoginSucceeded {
+		alert = l.checkNewIP(ctx, event)
+	}
+
+	if err := l.events.Create(ctx, event); err != nil {
+		l.warn(ctx, "security event not recorded", playerID, eventType, err)
+		return
+	}
+
+	if eventType == SecurityEventLoginFailed {
+		alert = l.checkRepeatedFailures(ctx, event)
+	}
+	if alert != nil {
+		l.notify(ctx, *alert)
+	}
+}
+
+// List returns up to limit of playerID's events, newest first. A limit <= 0
+// uses DefaultSecurityEventLimit; larger limits are clamped to
+// MaxSecurityEventLimit.
+func (l *SecurityLog) List(ctx context.Context, playerID ulid.ULID, limit int) ([]*SecurityEvent, error) {
+	if limit <= 0 {
+		limit = DefaultSecurityEventLimit
+	}
+	limit = min(limit, MaxSecurityEventLimit)
+	events, err := l.events.ListByPlayer(ctx, playerID, limit)
+	if err != nil {
+		return nil, oops.Code("SECURITY_EVENTS_LIST_FAILED").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return events, nil
+}
+
+// Purge removes every event in playerID's log. Used by account deletion, since
+// the log holds the player's addresses and user agents.
+func (l *SecurityLog) Purge(ctx context.Context, playerID ulid.ULID) error {
+	if err := l.events.DeleteByPlayer(ctx, playerID); err != nil {
+		return oops.Code("SECURITY_EVENTS_PURGE_FAILED").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// checkNewIP alerts when a player who has logged in before does so from an
+// address with no prior successful login. Logins with no known address
+// never alert.
+func (l *SecurityLog) checkNewIP(ctx context.Context, event *SecurityEvent) *SecurityAlert {
+	if l.notifier == nil || event.IPAddress == "" {
+		return nil
+	}
+	total, err := l.events.Count(ctx, SecurityEventFilter{
+		PlayerID: event.PlayerID,
+		Type:     SecurityEventLoginSucceeded,
+	})
+	if err != nil {
+		l.warn(ctx, "new-ip check failed", event.PlayerID, event.Type, err)
+		return nil
+	}
+	if total == 0 {
+		return nil // first login: nothing to compare against
+	}
+	fromIP, err := l.events.Count(ctx, SecurityEventFilter{
+		PlayerID:  event.PlayerID,
+		Type:      SecurityEventLoginSucceeded,
+		IPAddress: event.IPAddress,
+	})
+	if err != nil {
+		l.warn(ctx, "new-ip check failed", event.PlayerID, event.Type, err)
+		return nil
+	}
+	if fromIP > 0 {
+		return nil
+	}
+	return &SecurityAlert{Kind: SecurityAlertNewIP, PlayerID: event.PlayerID, Event: event}
+}
+
+// checkRepeatedFailures alerts once when failures in the window reach the
+// threshold; further failures in the same window stay quiet.
+func (l *SecurityLog) checkRepeatedFailures(ctx context.Context, event *SecurityEvent) *SecurityAlert {
+	if l.notifier == nil {
+		return nil
+	}
+	failures, err := l.events.Count(ctx, SecurityEventFilter{
+		PlayerID: event.PlayerID,
+		Type:     SecurityEventLoginFailed,
+		Since:    event.CreatedAt.Add(-FailedLoginAlertWindow),
+	})
+	if err != nil {
+		l.warn(ctx, "failed-login check failed", event.PlayerID, event.Type, err)
+		return nil
+	}
+	if failures != FailedLoginAlertThreshold {
+		return nil
+	}
+	return &SecurityAlert{Kind: SecurityAlertRepeatedFailures, PlayerID: event.PlayerID, Event: event}
+}
+
+func (l *SecurityLog) notify(ctx context.Context, alert SecurityAlert) {
+	if err := l.notifier.NotifySecurityAlert(ctx, alert); err != nil {
+		l.logger.WarnContext(
+			ctx, "security alert delivery failed",
+			"event", "security_alert_failed",
+			"kind", string(alert.Kind),
+			"player_id", alert.PlayerID.String(),
+			"error", err.Error(),
+		)
+	}
+}
+
+func (l *SecurityLog) warn(ctx context.Context, msg string, playerID ulid.ULID, eventType SecurityEventType, err error) {
+	l.logger.WarnContext(
+		ctx, msg,
+		"event", "security_event_failed",
+		"player_id", playerID.String(),
+		"security_event_type", string(eventType),
+		"error", err.Error(),
+	)
+}
+
+// SecurityViewer identifies who is reading a security event log. Admin is
+// set by the caller after its own authorization check.
+type SecurityViewer struct {
+	PlayerID ulid.ULID
+	Admin    bool
+}
+
+// CanView reports whether the viewer may read playerID's security events:
+// the owning player and admins may, nobody else.
+func (v SecurityViewer) CanView(playerID ulid.ULID) bool {
+	if v.Admin {
+		return true
+	}
+	return v.PlayerID.Compare(ulid.ULID{}) != 0 && v.PlayerID.Compare(playerID) == 0
+}
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memSecurityEvents is an in-memory auth.SecurityEventRepository.
+type memSecurityEvents struct {
+	mu        sync.Mutex
+	events    []*auth.SecurityEvent
+	createErr error
+}
+
+func (m *memSecurityEvents) Create(_ context.Context, event *auth.SecurityEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.events = append(m.events, event)
+	return nil
+}
+
+func (m *memSecurityEvents) ListByPlayer(_ context.Context, playerID ulid.ULID, limit int) ([]*auth.SecurityEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*auth.SecurityEvent
+	for i := len(m.events) - 1; i >= 0 && len(out) < limit; i-- {
+		if m.events[i].PlayerID == playerID {
+			out = append(out, m.events[i])
+		}
+	}
+	return out, nil
+}
+
+func (m *memSecurityEvents) Count(_ context.Context, f auth.SecurityEventFilter) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, e := range m.events {
+		if e.PlayerID != f.PlayerID ||
+			(f.Type != "" && e.Type != f.Type) ||
+			(f.IPAddress != "" && e.IPAddress != f.IPAddress) ||
+			e.CreatedAt.Before(f.Since) {
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+func (m *memSecurityEvents) DeleteByPlayer(_ context.Context, playerID ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	kept := m.events[:0]
+	for _, e := range m.events {
+		if e.PlayerID != playerID {
+			kept = append(kept, e)
+		}
+	}
+	m.events = kept
+	return nil
+}
+
+func (m *memSecurityEvents) types() []auth.SecurityEventType {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]auth.SecurityEventType, len(m.events))
+	for i, e := range m.events {
+		out[i] = e.Type
+	}
+	return out
+}
+
+// recordingNotifier captures every alert it is asked to deliver.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []auth.SecurityAlert
+}
+
+func (n *recordingNotifier) NotifySecurityAlert(_ context.Context, alert auth.SecurityAlert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func (n *recordingNotifier) kinds() []auth.SecurityAlertKind {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	out := make([]auth.SecurityAlertKind, len(n.alerts))
+	for i, a := range n.alerts {
+		out[i] = a.Kind
+	}
+	return out
+}
+
+func newTestSecurityLog(t *testing.T) (*auth.SecurityLog, *memSecurityEvents, *recordingNotifier) {
+	t.Helper()
+	events := &memSecurityEvents{}
+	notifier := &recordingNotifier{}
+	log, err := auth.NewSecurityLog(events, notifier, nil)
+	require.NoError(t, err)
+	return log, events, notifier
+}
+
+func TestNewSecurityEventValidatesInput(t *testing.T) {
+	_, err := auth.NewSecurityEvent(ulid.ULID{}, auth.SecurityEventLoginFailed, auth.SecurityOrigin{}, "")
+	errutil.AssertErrorCode(t, err, "SECURITY_EVENT_INVALID_PLAYER")
+
+	_, err = auth.NewSecurityEvent(ulid.Make(), "login_maybe", auth.SecurityOrigin{}, "")
+	errutil.AssertErrorCode(t, err, "SECURITY_EVENT_INVALID_TYPE")
+
+	event, err := auth.NewSecurityEvent(ulid.Make(), auth.SecurityEventPasswordChanged,
+		auth.SecurityOrigin{IPAddress: "203.0.113.7"}, strings.Repeat("x", 1000))
+	require.NoError(t, err)
+	assert.Len(t, event.Detail, auth.MaxSecurityEventDetailLength)
+	assert.Equal(t, "203.0.113.7", event.IPAddress)
+
+	// A multi-byte rune straddling the limit is dropped whole.
+	event, err = auth.NewSecurityEvent(ulid.Make(), auth.SecurityEventPasswordChanged,
+		auth.SecurityOrigin{}, "x"+strings.Repeat("é", auth.MaxSecurityEventDetailLength))
+	require.NoError(t, err)
+	assert.Len(t, event.Detail, auth.MaxSecurityEventDetailLength-1)
+	assert.True(t, utf8.ValidString(event.Detail))
+}
+
+func TestNewSecurityLogRequiresRepository(t *testing.T) {
+	_, err := auth.NewSecurityLog(nil, nil, nil)
+	require.Error(t, err)
+}
+
+func TestSecurityLogAlertsOnLoginFromNewIP(t *testing.T) {
+	ctx := context.Background()
+	log, _, notifier := newTestSecurityLog(t)
+	playerID := ulid.Make()
+	home := auth.SecurityOrigin{IPAddress: "198.51.100.1"}
+
+	// First login establishes history and does not alert.
+	log.Record(ctx, playerID, auth.SecurityEventLoginSucceeded, home, "")
+	log.Record(ctx, playerID, auth.SecurityEventLoginSucceeded, home, "")
+	assert.Empty(t, notifier.kinds())
+
+	log.Record(ctx, playerID, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{IPAddress: "203.0.113.9"}, "")
+	assert.Equal(t, []auth.SecurityAlertKind{auth.SecurityAlertNewIP}, notifier.kinds())
+	assert.Equal(t, playerID, notifier.alerts[0].PlayerID)
+	assert.Equal(t, "203.0.113.9", notifier.alerts[0].Event.IPAddress)
+
+	// Logins without a known address never alert.
+	log.Record(ctx, playerID, auth.SecurityEventLoginSucceeded, auth.SecurityOrigin{}, "")
+	assert.Len(t, notifier.kinds(), 1)
+}
+
+func TestSecurityLogAlertsOnceWhenFailuresReachThreshold(t *testing.T) {
+	ctx := context.Background()
+	log, events, notifier := newTestSecurityLog(t)
+	playerID := ulid.Make()
+
+	for range auth.FailedLoginAlertThreshold + 2 {
+		log.Record(ctx, playerID, auth.SecurityEventLoginFailed, auth.SecurityOrigin{}, "invalid password")
+	}
+	assert.Equal(t, []auth.SecurityAlertKind{auth.SecurityAlertRepeatedFailures}, notifier.kinds())
+	assert.Len(t, events.types(), auth.FailedLoginAlertThreshold+2)
+}
+
+func TestSecurityLogRecordSwallowsRepositoryErrors(t *testing.T) {
+	ctx := context.Background()
+	log, events, notifier := newTestSecurityLog(t)
+	events.createErr = errors.New("db down")
+
+	assert.NotPanics(t, func() {
+		log.Record(ctx, ulid.Make(), auth.SecurityEventLoginFailed, auth.SecurityOrigin{}, "")
+	})
+	assert.Empty(t, notifier.kinds())
+}
+
+func TestSecurityLogListClampsLimit(t *testing.T) {
+	ctx := context.Background()
+	log, _, _ := newTestSecurityLog(t)
+	playerID := ulid.Make()
+	for range auth.DefaultSecurityEventLimit + 5 {
+		log.Record(ctx, playerID, auth.SecurityEventSessionTerminated, auth.SecurityOrigin{}, "logout")
+	}
+
+	got, err := log.List(ctx, playerID, 0)
+	require.NoError(t, err)
+	assert.Len(t, got, auth.DefaultSecurityEventLimit)
+
+	got, err = log.List(ctx, playerID, 3)
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+}
+
+func TestListSecurityEventsEnforcesOwnership(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	log, _, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+
+	owner := ulid.Make()
+	log.Record(ctx, owner, auth.SecurityEventPasswordChanged, auth.SecurityOrigin{}, "reset token")
+
+	got, err := svc.ListSecurityEvents(ctx, auth.SecurityViewer{PlayerID: owner}, owner, 0)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, auth.SecurityEventPasswordChanged, got[0].Type)
+
+	got, err = svc.ListSecurityEvents(ctx, auth.SecurityViewer{PlayerID: ulid.Make(), Admin: true}, owner, 0)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = svc.ListSecurityEvents(ctx, auth.SecurityViewer{PlayerID: ulid.Make()}, owner, 0)
+	errutil.AssertErrorCode(t, err, "SECURITY_EVENTS_ACCESS_DENIED")
+
+	_, err = svc.ListSecurityEvents(ctx, auth.SecurityViewer{}, owner, 0)
+	errutil.AssertErrorCode(t, err, "SECURITY_EVENTS_ACCESS_DENIED")
+}
+
+func TestListSecurityEventsRequiresConfiguredLog(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+	owner := ulid.Make()
+
+	_, err := svc.ListSecurityEvents(context.Background(), auth.SecurityViewer{PlayerID: owner}, owner, 0)
+	errutil.AssertErrorCode(t, err, "AUTH_SECURITY_LOG_DISABLED")
+}
+
+func TestAuthenticatePlayerRecordsSecurityEvents(t *testing.T) {
+	ctx := context.Background()
+	svc, playerRepo, sessionRepo, hasher := newTestAuthServiceWithCap(t, 2)
+	log, events, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+	player := testPlayerWithCredentials(t, playerRepo, hasher, "alice")
+	hasher.On("Verify", "wrong", player.PasswordHash).Return(false, nil)
+	sessionRepo.On("CreateWithCap", ctx, mock.AnythingOfType("*auth.PlayerSession"), 2).
+		Return([]ulid.ULID{ulid.Make()}, nil).Once()
+
+	_, _, err := svc.AuthenticatePlayer(ctx, "alice", "wrong", "ua", "198.51.100.1")
+	require.Error(t, err)
+	_, _, err = svc.AuthenticatePlayer(ctx, "alice", "password", "ua", "198.51.100.1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []auth.SecurityEventType{
+		auth.SecurityEventLoginFailed,
+		auth.SecurityEventLoginSucceeded,
+		auth.SecurityEventSessionTerminated,
+	}, events.types())
+	assert.Equal(t, "198.51.100.1", events.events[1].IPAddress)
+	assert.Equal(t, "evicted by session cap", events.events[2].Detail)
+}
+
+func TestLogoutRecordsSessionTerminated(t *testing.T) {
+	ctx := context.Background()
+	svc, _, sessionRepo, _ := newTestAuthServiceWithCap(t, 0)
+	log, events, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(log)
+
+	session := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make(), IPAddress: "198.51.100.1"}
+	sessionRepo.On("GetByTokenHash", ctx, "hash").Return(session, nil)
+	sessionRepo.On("Delete", ctx, session.ID).Return(nil)
+
+	_, err := svc.Logout(ctx, "hash")
+	require.NoError(t, err)
+	require.Equal(t, []auth.SecurityEventType{auth.SecurityEventSessionTerminated}, events.types())
+	assert.Equal(t, session.PlayerID, events.events[0].PlayerID)
+	assert.Equal(t, "logout", events.events[0].Detail)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/session"
 )
 
@@ -38,23 +39,56 @@ func ValidateSessionOwnership(
 	playerToken string,
 	sessionID string,
 ) (*session.Info, error) {
+	_, info, err := validateSessionOwnership(ctx, playerSessions, sessions, playerToken, sessionID)
+	return info, err
+}
+
+// ValidateSessionActor is ValidateSessionOwnership for callers that act on
+// the session's behalf. When the token belongs to an impersonation session
+// the returned context carries the staff player behind it
+// (core.WithImpersonator), so audit records and published events made with
+// it name both identities. Otherwise ctx is returned unchanged.
+func ValidateSessionActor(
+	ctx context.Context,
+	playerSessions PlayerSessionRepository,
+	sessions session.Store,
+	playerToken string,
+	sessionID string,
+) (context.Context, *session.Info, error) {
+	ps, info, err := validateSessionOwnership(ctx, playerSessions, sessions, playerToken, sessionID)
+	if err != nil {
+		return ctx, nil, err
+	}
+	if ps.IsImpersonation() {
+		ctx = core.WithImpersonator(ctx, ps.ImpersonatorID.String())
+	}
+	return ctx, info, nil
+}
+
+func validateSessionOwnership(
+	ctx context.Context,
+	playerSessions PlayerSessionRepository,
+	sessions session.Store,
+	playerToken string,
+	sessionID string,
+) (*PlayerSession, *session.Info, error) {
 	if playerToken == "" {
-		return nil, oops.Code(sessionNotFoundErr).
+		return nil, nil, oops.Code(sessionNotFoundErr).
 			With("reason", "empty_token").Errorf("session not found")
 	}
 
 	ps, err := playerSessions.GetByTokenHash(ctx, HashSessionToken(playerToken))
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return nil, oops.Code(sessionNotFoundErr).
+			return nil, nil, oops.Code(sessionNotFoundErr).
 				With("reason", "token_unknown").Errorf("session not found")
 		}
-		return nil, oops.Code(sessionNotFoundErr).
+		return nil, nil, oops.Code(sessionNotFoundErr).
 			With("reason", "token_lookup_failed").Wrap(err)
 	}
 
-	if ps.IsExpired() {
-		return nil, oops.Code(sessionNotFoundErr).
+	if ps.IsExpired() || ps.AccessExpired() {
+		return nil, nil, oops.Code(sessionNotFoundErr).
 			With("reason", "token_expired").
 			With("player_id", ps.PlayerID.String()).
 			Errorf("session not found")
@@ -63,12 +97,12 @@ func ValidateSessionOwnership(
 	info, err := sessions.Get(ctx, sessionID)
 	if err != nil {
 		if isSessionNotFound(err) {
-			return nil, oops.Code(sessionNotFoundErr).
+			return nil, nil, oops.Code(sessionNotFoundErr).
 				With("reason", "session_missing").
 				With("player_id", ps.PlayerID.String()).
 				Errorf("session not found")
 		}
-		return nil, oops.Code(sessionNotFoundErr).
+		return nil, nil, oops.Code(sessionNotFoundErr).
 			With("reason", "session_lookup_failed").Wrap(err)
 	}
 
@@ -79,13 +113,13 @@ func ValidateSessionOwnership(
 			"session_id", sessionID,
 			"session_owner", info.PlayerID.String(),
 		)
-		return nil, oops.Code(sessionNotFoundErr).
+		return nil, nil, oops.Code(sessionNotFoundErr).
 			With("reason", "ownership_mismatch").
 			With("player_id", ps.PlayerID.String()).
 			Errorf("session not found")
 	}
 
-	return info, nil
+	return ps, info, nil
 }
 
 // isSessionNotFound reports whether err is a "session not found" error
@@ -20,6 +20,7 @@ import (
 
 	"github.com/holomush/holomush/internal/auth"
 	"github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/session"
 	sessionmocks "github.com/holomush/holomush/internal/session/mocks"
 	"github.com/holomush/holomush/pkg/errutil"
@@ -79,6 +80,22 @@ func TestValidateSessionOwnershipRejectsExpiredToken(t *testing.T) {
 	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
 }
 
+func TestValidateSessionOwnershipRejectsExpiredAccessToken(t *testing.T) {
+	ctx := context.Background()
+	players := mocks.NewMockPlayerSessionRepository(t)
+	store := sessionmocks.NewMockStore(t)
+
+	ps := newValidPlayerSessionFixture(t)
+	ps.RefreshTokenHash = "refresh"
+	ps.AccessExpiresAt = time.Now().Add(-time.Minute)
+	players.EXPECT().GetByTokenHash(ctx, auth.HashSessionToken("tok-access")).
+		Return(ps, nil)
+
+	_, err := auth.ValidateSessionOwnership(ctx, players, store, "tok-access", "sess-1")
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+}
+
 func TestValidateSessionOwnershipRejectsMissingSession(t *testing.T) {
 	ctx := context.Background()
 	players := mocks.NewMockPlayerSessionRepository(t)
@@ -129,6 +146,34 @@ func TestValidateSessionOwnershipSucceedsForOwner(t *testing.T) {
 	assert.Equal(t, owned, got)
 }
 
+func TestValidateSessionActorStampsImpersonator(t *testing.T) {
+	ctx := context.Background()
+	players := mocks.NewMockPlayerSessionRepository(t)
+	store := sessionmocks.NewMockStore(t)
+
+	ps := newValidPlayerSessionFixture(t)
+	owned := &session.Info{ID: "sess-A", PlayerID: ps.PlayerID}
+	players.EXPECT().GetByTokenHash(ctx, auth.HashSessionToken("tok-A")).Return(ps, nil)
+	store.EXPECT().Get(ctx, "sess-A").Return(owned, nil)
+
+	actorCtx, got, err := auth.ValidateSessionActor(ctx, players, store, "tok-A", "sess-A")
+	require.NoError(t, err)
+	assert.Equal(t, owned, got)
+	_, ok := core.ImpersonatorFromContext(actorCtx)
+	assert.False(t, ok, "an ordinary session carries no impersonator")
+
+	imp := newValidPlayerSessionFixture(t)
+	imp.ImpersonatorID = playerBID
+	players.EXPECT().GetByTokenHash(ctx, auth.HashSessionToken("tok-imp")).Return(imp, nil)
+	store.EXPECT().Get(ctx, "sess-A").Return(owned, nil)
+
+	actorCtx, _, err = auth.ValidateSessionActor(ctx, players, store, "tok-imp", "sess-A")
+	require.NoError(t, err)
+	impersonator, ok := core.ImpersonatorFromContext(actorCtx)
+	assert.True(t, ok)
+	assert.Equal(t, playerBID.String(), impersonator)
+}
+
 func TestValidateSessionOwnershipCollapsesTokenLookupErrors(t *testing.T) {
 	ctx := context.Background()
 	players := mocks.NewMockPlayerSessionRepository(t)
@@ -11,8 +11,11 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/oidc"
 	authpostgres "github.com/holomush/holomush/internal/auth/postgres"
+	"github.com/holomush/holomush/internal/bans"
 	"github.com/holomush/holomush/internal/lifecycle"
 	"github.com/holomush/holomush/internal/store"
 )
@@ -23,6 +26,20 @@ type PoolProvider interface {
 	Pool() *pgxpool.Pool
 }
 
+// AuditProvider provides the audit pipeline authentication events are
+// mirrored into. Implemented by a bridge over the ABAC subsystem without
+// requiring a direct import. A nil Auditor disables mirroring.
+type AuditProvider interface {
+	Auditor() auth.Auditor
+}
+
+// GrantProvider provides the resolver player grants are read from.
+// Implemented by a bridge over the ABAC subsystem without requiring a direct
+// import.
+type GrantProvider interface {
+	GrantResolver() access.SubjectResolver
+}
+
 // AuthSubsystemConfig configures the auth subsystem.
 type AuthSubsystemConfig struct {
 	DB PoolProvider
@@ -33,6 +50,25 @@ type AuthSubsystemConfig struct {
 	// persisted; the sessions.player_session_id FK cascade then removes the
 	// evicted session's game sessions and terminates their Subscribe streams.
 	MaxSessionsPerPlayer int
+
+	// OIDCProviders enables sign-in with linked identity provider accounts.
+	// Empty disables OIDC.
+	OIDCProviders []oidc.ProviderConfig
+
+	// OIDCPolicy is the account-linking policy applied when OIDC is enabled.
+	OIDCPolicy auth.OIDCPolicy
+
+	// PlayerDataPolicy is the account deletion policy.
+	PlayerDataPolicy auth.PlayerDataPolicy
+
+	// Audit, when set, mirrors security events into the audit log with
+	// source "auth". The subsystem then depends on SubsystemABAC.
+	Audit AuditProvider
+
+	// Grants, when set, enables staff impersonation: players holding
+	// access.CapabilityImpersonate may open impersonation sessions. The
+	// subsystem then depends on SubsystemABAC.
+	Grants GrantProvider
 }
 
 // AuthSubsystem manages authentication services and repositories.
@@ -44,6 +80,8 @@ type AuthSubsystem struct {
 	hasher             auth.PasswordHasher
 	authService        *auth.Service
 	resetService       *auth.PasswordResetService
+	securityLog        *auth.SecurityLog
+	bans               *bans.Service
 }
 
 // NewAuthSubsystem creates an AuthSubsystem configured with cfg.
@@ -55,8 +93,12 @@ func NewAuthSubsystem(cfg AuthSubsystemConfig) *AuthSubsystem {
 // ID returns SubsystemAuth.
 func (s *AuthSubsystem) ID() lifecycle.SubsystemID { return lifecycle.SubsystemAuth }
 
-// DependsOn returns [SubsystemDatabase].
+// DependsOn returns [SubsystemDatabase], plus SubsystemABAC when security
+// events are mirrored into the audit log or impersonation is enabled.
 func (s *AuthSubsystem) DependsOn() []lifecycle.SubsystemID {
+	if s.cfg.Audit != nil || s.cfg.Grants != nil {
+		return []lifecycle.SubsystemID{lifecycle.SubsystemDatabase, lifecycle.SubsystemABAC}
+	}
 	return []lifecycle.SubsystemID{lifecycle.SubsystemDatabase}
 }
 
@@ -88,11 +130,45 @@ func (s *AuthSubsystem) Prepare(ctx context.Context) error {
 		return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
 	}
 	authSvc.SetMaxSessionsPerPlayer(s.cfg.MaxSessionsPerPlayer)
+	if err := authSvc.SetPlayerDataPolicy(s.cfg.PlayerDataPolicy); err != nil {
+		return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
+	}
+
+	securityLog, err := auth.NewSecurityLog(
+		authpostgres.NewSecurityEventRepository(pool),
+		auth.NewLogNotifier(slog.Default()),
+		slog.Default(),
+	)
+	if err != nil {
+		return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
+	}
+	if s.cfg.Audit != nil {
+		securityLog.SetAuditor(s.cfg.Audit.Auditor())
+	}
+	authSvc.SetSecurityLog(securityLog)
+	if s.cfg.Grants != nil {
+		authSvc.SetImpersonation(s.cfg.Grants.GrantResolver(), authpostgres.NewImpersonationRepository(pool))
+	}
+
+	banSvc, err := bans.NewService(bans.NewPostgresStore(pool), slog.Default())
+	if err != nil {
+		return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
+	}
+	authSvc.SetLoginGate(banSvc)
+
+	if len(s.cfg.OIDCProviders) > 0 {
+		verifier, err := oidc.NewVerifier(s.cfg.OIDCProviders, nil)
+		if err != nil {
+			return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
+		}
+		authSvc.SetOIDC(verifier, authpostgres.NewIdentityRepository(pool), s.cfg.OIDCPolicy)
+	}
 
 	resetSvc, err := auth.NewPasswordResetServiceWithLogger(playerRepo, resetRepo, playerSessionStore, hasher, slog.Default())
 	if err != nil {
 		return oops.Code("AUTH_SETUP_FAILED").Wrap(err)
 	}
+	resetSvc.SetSecurityRecorder(securityLog)
 
 	// Commit all fields atomically only after every dependency built cleanly.
 	s.playerRepo = playerRepo
@@ -101,6 +177,8 @@ func (s *AuthSubsystem) Prepare(ctx context.Context) error {
 	s.hasher = hasher
 	s.authService = authSvc
 	s.resetService = resetSvc
+	s.securityLog = securityLog
+	s.bans = banSvc
 
 	slog.InfoContext(ctx, "auth subsystem prepared")
 	return nil
@@ -154,6 +232,22 @@ func (s *AuthSubsystem) AuthService() *auth.Service {
 	return s.authService
 }
 
+// SecurityLog returns the player security event log. Panics if called before Prepare().
+func (s *AuthSubsystem) SecurityLog() *auth.SecurityLog {
+	if s.securityLog == nil {
+		panic("auth/setup: SecurityLog() called before Prepare()")
+	}
+	return s.securityLog
+}
+
+// Bans returns the ban list service. Panics if called before Prepare().
+func (s *AuthSubsystem) Bans() *bans.Service {
+	if s.bans == nil {
+		panic("auth/setup: Bans() called before Prepare()")
+	}
+	return s.bans
+}
+
 // ResetService returns the password reset service. Panics if called before Prepare().
 func (s *AuthSubsystem) ResetService() *auth.PasswordResetService {
 	if s.resetService == nil {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
 	"github.com/holomush/holomush/internal/auth/setup"
 	"github.com/holomush/holomush/internal/lifecycle"
 )
@@ -25,7 +27,27 @@ func TestAuthSubsystemDependsOnDatabase(t *testing.T) {
 	assert.Equal(t, []lifecycle.SubsystemID{lifecycle.SubsystemDatabase}, sub.DependsOn())
 }
 
+type nilAuditProvider struct{}
+
+func (nilAuditProvider) Auditor() auth.Auditor { return nil }
+
+func TestAuthSubsystemDependsOnABACWhenAuditing(t *testing.T) {
+	sub := setup.NewAuthSubsystem(setup.AuthSubsystemConfig{Audit: nilAuditProvider{}})
+	assert.Equal(t, []lifecycle.SubsystemID{lifecycle.SubsystemDatabase, lifecycle.SubsystemABAC}, sub.DependsOn())
+}
+
+type nilGrantProvider struct{}
+
+func (nilGrantProvider) GrantResolver() access.SubjectResolver { return nil }
+
+func TestAuthSubsystemDependsOnABACWhenImpersonationEnabled(t *testing.T) {
+	sub := setup.NewAuthSubsystem(setup.AuthSubsystemConfig{Grants: nilGrantProvider{}})
+	assert.Equal(t, []lifecycle.SubsystemID{lifecycle.SubsystemDatabase, lifecycle.SubsystemABAC}, sub.DependsOn())
+}
+
 func TestAuthSubsystemServicePanicsBeforeStart(t *testing.T) {
 	sub := setup.NewAuthSubsystem(setup.AuthSubsystemConfig{})
 	assert.Panics(t, func() { sub.AuthService() })
+	assert.Panics(t, func() { sub.SecurityLog() })
+	assert.Panics(t, func() { sub.Bans() })
 }
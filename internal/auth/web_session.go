@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// WebDevice describes the client starting a web session.
+type WebDevice struct {
+	UserAgent string
+	IPAddress string
+	// Label is the player's name for the device ("Work laptop"). Optional.
+	Label string
+}
+
+// WebSessionTokens are the raw tokens of a web session. The caller returns
+// them to the client exactly once; only their hashes are persisted.
+type WebSessionTokens struct {
+	SessionID        ulid.ULID
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// tokenPair is a freshly generated access and refresh token with their
+// hashes.
+type tokenPair struct {
+	access, accessHash   string
+	refresh, refreshHash string
+}
+
+func generateTokenPair() (tokenPair, error) {
+	var p tokenPair
+	var err error
+	if p.access, p.accessHash, err = GenerateSessionToken(); err != nil {
+		return tokenPair{}, err
+	}
+	if p.refresh, p.refreshHash, err = GenerateSessionToken(); err != nil {
+		return tokenPair{}, err
+	}
+	return p, nil
+}
+
+func (p tokenPair) tokens(session *PlayerSession) *WebSessionTokens {
+	return &WebSessionTokens{
+		SessionID:        session.ID,
+		AccessToken:      p.access,
+		AccessExpiresAt:  session.AccessExpiresAt,
+		RefreshToken:     p.refresh,
+		RefreshExpiresAt: session.ExpiresAt,
+	}
+}
+
+// AuthenticateWebSession validates credentials exactly as AuthenticatePlayer
+// does, but starts a session holding a refresh token: the access token
+// expires after AccessTokenTTL, and RefreshWebSession trades the refresh
+// token for a new pair. Bans, lockouts, and the session cap apply.
+//
+// Returns the raw tokens and the authenticated Player on success.
+func (s *Service) AuthenticateWebSession(ctx context.Context, username, password string, device WebDevice) (*WebSessionTokens, *Player, error) {
+	label, err := NormalizeDeviceLabel(device.Label)
+	if err != nil {
+		return nil, nil, err
+	}
+	origin := SecurityOrigin{IPAddress: device.IPAddress, UserAgent: device.UserAgent}
+	if err := s.checkLoginAddress(ctx, device.IPAddress); err != nil {
+		return nil, nil, err
+	}
+	player, err := s.validateCredentials(ctx, username, password, origin)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pair, err := generateTokenPair()
+	if err != nil {
+		return nil, nil, oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "generate session tokens").
+			Wrap(err)
+	}
+	session, err := NewPlayerSession(player.ID, pair.accessHash, device.UserAgent, device.IPAddress, PlayerSessionTTL)
+	if err != nil {
+		return nil, nil, oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "create player session").
+			Wrap(err)
+	}
+	session.DeviceLabel = label
+	if err := session.RotateTokens(pair.accessHash, pair.refreshHash); err != nil {
+		return nil, nil, oops.Code("AUTH_LOGIN_FAILED").
+			With("operation", "issue refresh token").
+			Wrap(err)
+	}
+	if err := s.persistSession(ctx, player, session, origin); err != nil {
+		return nil, nil, err
+	}
+	return pair.tokens(session), player, nil
+}
+
+// RefreshWebSession trades a refresh token for a new access and refresh
+// token pair, sliding the session's expiry forward.
+//
+// Refresh tokens are single-use. Presenting one a rotation already retired
+// means two parties hold it, so the session is revoked, a
+// session_terminated security event is recorded, and AUTH_REFRESH_REUSED is
+// returned; the legitimate client must sign in again. Two concurrent
+// refreshes with the same token count as reuse too, so clients must
+// serialize their refreshes.
+//
+// Unknown and expired refresh tokens return AUTH_REFRESH_INVALID.
+func (s *Service) RefreshWebSession(ctx context.Context, refreshToken string, origin SecurityOrigin) (*WebSessionTokens, error) {
+	if refreshToken == "" {
+		return nil, oops.Code("AUTH_REFRESH_INVALID").Errorf("refresh token is invalid or expired")
+	}
+	presented := HashSessionToken(refreshToken)
+	session, err := s.playerSessions.GetByRefreshTokenHash(ctx, presented)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, oops.Code("AUTH_REFRESH_INVALID").Errorf("refresh token is invalid or expired")
+		}
+		return nil, oops.Code("AUTH_REFRESH_FAILED").
+			With("operation", "get session by refresh token").
+			Wrap(err)
+	}
+	if session.RefreshTokenHash != presented {
+		return nil, s.revokeReusedSession(ctx, session, origin)
+	}
+
+	pair, err := generateTokenPair()
+	if err != nil {
+		return nil, oops.Code("AUTH_REFRESH_FAILED").
+			With("operation", "generate session tokens").
+			Wrap(err)
+	}
+	if err := session.RotateTokens(pair.accessHash, pair.refreshHash); err != nil {
+		return nil, oops.Code("AUTH_REFRESH_FAILED").
+			With("operation", "rotate session tokens").
+			Wrap(err)
+	}
+	if err := s.playerSessions.RotateTokens(ctx, session, presented); err != nil {
+		switch {
+		case errors.Is(err, ErrRefreshTokenConflict):
+			return nil, s.revokeReusedSession(ctx, session, origin)
+		case errors.Is(err, ErrNotFound):
+			return nil, oops.Code("AUTH_REFRESH_INVALID").Errorf("refresh token is invalid or expired")
+		}
+		return nil, oops.Code("AUTH_REFRESH_FAILED").
+			With("operation", "persist rotated tokens").
+			With("session_id", session.ID.String()).
+			Wrap(err)
+	}
+	return pair.tokens(session), nil
+}
+
+// revokeReusedSession ends a session whose retired refresh token was
+// presented again and returns the error RefreshWebSession reports.
+func (s *Service) revokeReusedSession(ctx context.Context, session *PlayerSession, origin SecurityOrigin) error {
+	s.logger.WarnContext(
+		ctx, "refresh token reuse detected; revoking session",
+		"event", "refresh_token_reused",
+		"player_id", session.PlayerID.String(),
+		"session_id", session.ID.String(),
+		"ip_address", origin.IPAddress,
+	)
+	if err := s.playerSessions.Delete(ctx, session.ID); err != nil {
+		return oops.Code("AUTH_REFRESH_FAILED").
+			With("operation", "revoke session after refresh token reuse").
+			With("session_id", session.ID.String()).
+			Wrap(err)
+	}
+	s.recordSecurityEvent(ctx, session.PlayerID, SecurityEventSessionTerminated, origin, "refresh token reuse detected")
+	return oops.Code("AUTH_REFRESH_REUSED").
+		With("session_id", session.ID.String()).
+		Errorf("refresh token was already used; the session has been revoked")
+}
+
+// ListSessions returns playerID's active sessions, newest first, so the
+// player can review the devices they are signed in on.
+func (s *Service) ListSessions(ctx context.Context, playerID ulid.ULID) ([]*PlayerSession, error) {
+	sessions, err := s.playerSessions.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, oops.Code("AUTH_SESSION_LIST_FAILED").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs playerID out of one of their sessions. A session that
+// does not exist or belongs to another player returns SESSION_NOT_FOUND, so
+// session IDs cannot be probed.
+func (s *Service) RevokeSession(ctx context.Context, playerID, sessionID ulid.ULID, origin SecurityOrigin) error {
+	if _, err := s.ownedSession(ctx, playerID, sessionID); err != nil {
+		return err
+	}
+	if err := s.playerSessions.Delete(ctx, sessionID); err != nil {
+		return oops.Code("AUTH_SESSION_REVOKE_FAILED").
+			With("session_id", sessionID.String()).
+			Wrap(err)
+	}
+	s.recordSecurityEvent(ctx, playerID, SecurityEventSessionTerminated, origin, "revoked by player")
+	return nil
+}
+
+// LabelSession sets the device label of one of playerID's sessions. An
+// empty label clears it. Ownership failures return SESSION_NOT_FOUND as in
+// RevokeSession.
+func (s *Service) LabelSession(ctx context.Context, playerID, sessionID ulid.ULID, label string) error {
+	label, err := NormalizeDeviceLabel(label)
+	if err != nil {
+		return err
+	}
+	if _, err := s.ownedSession(ctx, playerID, sessionID); err != nil {
+		return err
+	}
+	if err := s.playerSessions.SetDeviceLabel(ctx, sessionID, label); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code(sessionNotFoundErr).Errorf("session not found")
+		}
+		return oops.Code("AUTH_SESSION_LABEL_FAILED").
+			With("session_id", sessionID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// ownedSession loads sessionID and checks playerID owns it. Missing and
+// foreign sessions both return SESSION_NOT_FOUND; foreign ones are logged
+// at WARN for security monitoring.
+func (s *Service) ownedSession(ctx context.Context, playerID, sessionID ulid.ULID) (*PlayerSession, error) {
+	session, err := s.playerSessions.GetByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, oops.Code(sessionNotFoundErr).Errorf("session not found")
+		}
+		return nil, oops.Code("AUTH_SESSION_LOOKUP_FAILED").
+			With("session_id", sessionID.String()).
+			Wrap(err)
+	}
+	if session.PlayerID != playerID {
+		s.logger.WarnContext(
+			ctx, "session management: cross-player attempt",
+			"event", "session_ownership_mismatch",
+			"player_id", playerID.String(),
+			"target_owner", session.PlayerID.String(),
+			"session_id", sessionID.String(),
+		)
+		return nil, oops.Code(sessionNotFoundErr).Errorf("session not found")
+	}
+	return session, nil
+}
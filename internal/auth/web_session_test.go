@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// webSessionFixture returns a live web session whose current refresh token
+// is refreshToken.
+func webSessionFixture(playerID ulid.ULID, refreshToken string) *auth.PlayerSession {
+	now := time.Now()
+	return &auth.PlayerSession{
+		ID:                  ulid.Make(),
+		PlayerID:            playerID,
+		TokenHash:           auth.HashSessionToken("access"),
+		RefreshTokenHash:    auth.HashSessionToken(refreshToken),
+		PreviousRefreshHash: auth.HashSessionToken("retired"),
+		AccessExpiresAt:     now.Add(auth.AccessTokenTTL),
+		ExpiresAt:           now.Add(auth.PlayerSessionTTL),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+}
+
+func TestAuthenticateWebSessionIssuesRefreshToken(t *testing.T) {
+	svc, playerRepo, sessions, hasher := newTestAuthServiceWithCap(t, 0)
+	player := testPlayerWithCredentials(t, playerRepo, hasher, "alice")
+
+	var stored *auth.PlayerSession
+	sessions.EXPECT().CreateWithCap(mock.Anything, mock.Anything, 0).
+		Run(func(_ context.Context, session *auth.PlayerSession, _ int) { stored = session }).
+		Return(nil, nil)
+
+	tokens, got, err := svc.AuthenticateWebSession(context.Background(), "alice", "password", auth.WebDevice{
+		UserAgent: "Firefox",
+		IPAddress: "203.0.113.7",
+		Label:     " Work laptop ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, player.ID, got.ID)
+
+	require.NotNil(t, stored)
+	assert.Equal(t, stored.ID, tokens.SessionID)
+	assert.Equal(t, auth.HashSessionToken(tokens.AccessToken), stored.TokenHash)
+	assert.Equal(t, auth.HashSessionToken(tokens.RefreshToken), stored.RefreshTokenHash)
+	assert.Empty(t, stored.PreviousRefreshHash)
+	assert.NotEqual(t, tokens.AccessToken, tokens.RefreshToken)
+	assert.Equal(t, "Work laptop", stored.DeviceLabel)
+	assert.Equal(t, stored.AccessExpiresAt, tokens.AccessExpiresAt)
+	assert.Equal(t, stored.ExpiresAt, tokens.RefreshExpiresAt)
+	assert.True(t, tokens.AccessExpiresAt.Before(tokens.RefreshExpiresAt))
+}
+
+func TestAuthenticateWebSessionRejectsInvalidLabelBeforeCredentials(t *testing.T) {
+	svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+
+	_, _, err := svc.AuthenticateWebSession(context.Background(), "alice", "password", auth.WebDevice{Label: "bad\nlabel"})
+	errutil.AssertErrorCode(t, err, "SESSION_INVALID_DEVICE_LABEL")
+}
+
+func TestRefreshWebSessionRotatesTokens(t *testing.T) {
+	svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+	session := webSessionFixture(ulid.Make(), "refresh-1")
+	presented := auth.HashSessionToken("refresh-1")
+
+	sessions.EXPECT().GetByRefreshTokenHash(mock.Anything, presented).Return(session, nil)
+	sessions.EXPECT().RotateTokens(mock.Anything, session, presented).Return(nil)
+
+	tokens, err := svc.RefreshWebSession(context.Background(), "refresh-1", auth.SecurityOrigin{})
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, tokens.SessionID)
+	assert.Equal(t, auth.HashSessionToken(tokens.AccessToken), session.TokenHash)
+	assert.Equal(t, auth.HashSessionToken(tokens.RefreshToken), session.RefreshTokenHash)
+	assert.Equal(t, presented, session.PreviousRefreshHash, "the presented token is retired")
+}
+
+func TestRefreshWebSessionRevokesOnReuse(t *testing.T) {
+	svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+	secLog, events, _ := newTestSecurityLog(t)
+	svc.SetSecurityLog(secLog)
+
+	session := webSessionFixture(ulid.Make(), "refresh-2")
+	retired := auth.HashSessionToken("retired")
+	sessions.EXPECT().GetByRefreshTokenHash(mock.Anything, retired).Return(session, nil)
+	sessions.EXPECT().Delete(mock.Anything, session.ID).Return(nil)
+
+	_, err := svc.RefreshWebSession(context.Background(), "retired", auth.SecurityOrigin{IPAddress: "198.51.100.9"})
+	errutil.AssertErrorCode(t, err, "AUTH_REFRESH_REUSED")
+	assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventSessionTerminated}, events.types())
+}
+
+func TestRefreshWebSessionTreatsLostRotationAsReuse(t *testing.T) {
+	svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+	session := webSessionFixture(ulid.Make(), "refresh-1")
+	presented := auth.HashSessionToken("refresh-1")
+
+	sessions.EXPECT().GetByRefreshTokenHash(mock.Anything, presented).Return(session, nil)
+	sessions.EXPECT().RotateTokens(mock.Anything, session, presented).
+		Return(oops.Code("PLAYER_SESSION_ROTATE_CONFLICT").Wrap(auth.ErrRefreshTokenConflict))
+	sessions.EXPECT().Delete(mock.Anything, session.ID).Return(nil)
+
+	_, err := svc.RefreshWebSession(context.Background(), "refresh-1", auth.SecurityOrigin{})
+	errutil.AssertErrorCode(t, err, "AUTH_REFRESH_REUSED")
+}
+
+func TestRefreshWebSessionRejectsUnknownTokens(t *testing.T) {
+	svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+
+	_, err := svc.RefreshWebSession(context.Background(), "", auth.SecurityOrigin{})
+	errutil.AssertErrorCode(t, err, "AUTH_REFRESH_INVALID")
+
+	sessions.EXPECT().GetByRefreshTokenHash(mock.Anything, auth.HashSessionToken("unknown")).
+		Return(nil, oops.Code("PLAYER_SESSION_NOT_FOUND").Wrap(auth.ErrNotFound))
+	_, err = svc.RefreshWebSession(context.Background(), "unknown", auth.SecurityOrigin{})
+	errutil.AssertErrorCode(t, err, "AUTH_REFRESH_INVALID")
+
+	sessions.EXPECT().GetByRefreshTokenHash(mock.Anything, auth.HashSessionToken("broken")).
+		Return(nil, errors.New("connection lost"))
+	_, err = svc.RefreshWebSession(context.Background(), "broken", auth.SecurityOrigin{})
+	errutil.AssertErrorCode(t, err, "AUTH_REFRESH_FAILED")
+}
+
+func TestListSessions(t *testing.T) {
+	svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+	playerID := ulid.Make()
+	want := []*auth.PlayerSession{webSessionFixture(playerID, "a"), webSessionFixture(playerID, "b")}
+
+	sessions.EXPECT().ListByPlayer(mock.Anything, playerID).Return(want, nil)
+	got, err := svc.ListSessions(context.Background(), playerID)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	other := ulid.Make()
+	sessions.EXPECT().ListByPlayer(mock.Anything, other).Return(nil, errors.New("connection lost"))
+	_, err = svc.ListSessions(context.Background(), other)
+	errutil.AssertErrorCode(t, err, "AUTH_SESSION_LIST_FAILED")
+}
+
+func TestRevokeSession(t *testing.T) {
+	playerID := ulid.Make()
+
+	t.Run("owner revokes and the event is logged", func(t *testing.T) {
+		svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+		secLog, events, _ := newTestSecurityLog(t)
+		svc.SetSecurityLog(secLog)
+		session := webSessionFixture(playerID, "r")
+
+		sessions.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+		sessions.EXPECT().Delete(mock.Anything, session.ID).Return(nil)
+
+		require.NoError(t, svc.RevokeSession(context.Background(), playerID, session.ID, auth.SecurityOrigin{}))
+		assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventSessionTerminated}, events.types())
+	})
+
+	t.Run("another player's session is not found", func(t *testing.T) {
+		svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+		session := webSessionFixture(ulid.Make(), "r")
+
+		sessions.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+		err := svc.RevokeSession(context.Background(), playerID, session.ID, auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+	})
+
+	t.Run("missing session is not found", func(t *testing.T) {
+		svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+		id := ulid.Make()
+
+		sessions.EXPECT().GetByID(mock.Anything, id).Return(nil, auth.ErrNotFound)
+
+		err := svc.RevokeSession(context.Background(), playerID, id, auth.SecurityOrigin{})
+		errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+	})
+}
+
+func TestLabelSession(t *testing.T) {
+	playerID := ulid.Make()
+
+	t.Run("owner relabels", func(t *testing.T) {
+		svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+		session := webSessionFixture(playerID, "r")
+
+		sessions.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+		sessions.EXPECT().SetDeviceLabel(mock.Anything, session.ID, "Phone").Return(nil)
+
+		require.NoError(t, svc.LabelSession(context.Background(), playerID, session.ID, "  Phone "))
+	})
+
+	t.Run("another player's session is not found", func(t *testing.T) {
+		svc, _, sessions, _ := newTestAuthServiceWithCap(t, 0)
+		session := webSessionFixture(ulid.Make(), "r")
+
+		sessions.EXPECT().GetByID(mock.Anything, session.ID).Return(session, nil)
+
+		err := svc.LabelSession(context.Background(), playerID, session.ID, "Phone")
+		errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+	})
+
+	t.Run("invalid label", func(t *testing.T) {
+		svc, _, _, _ := newTestAuthServiceWithCap(t, 0)
+
+		err := svc.LabelSession(context.Background(), playerID, ulid.Make(), "tab\there")
+		errutil.AssertErrorCode(t, err, "SESSION_INVALID_DEVICE_LABEL")
+	})
+}
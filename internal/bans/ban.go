@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package bans keeps the server ban list and enforces it. A ban matches
+// connections or logins by exact IP, CIDR range, hostname glob, or player
+// username glob, and may expire.
+package bans
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Target is the kind of value a ban's pattern matches.
+type Target string
+
+// Ban targets.
+const (
+	TargetIP       Target = "ip"
+	TargetCIDR     Target = "cidr"
+	TargetHostname Target = "hostname"
+	TargetPlayer   Target = "player"
+)
+
+// Valid reports whether t is a known ban target.
+func (t Target) Valid() bool {
+	switch t {
+	case TargetIP, TargetCIDR, TargetHostname, TargetPlayer:
+		return true
+	}
+	return false
+}
+
+// Ban list limits.
+const (
+	MaxPatternLength = 253 // longest DNS name
+	MaxReasonLength  = 512
+)
+
+// ErrNotFound is returned when a ban does not exist.
+var ErrNotFound = errors.New("ban not found")
+
+// Ban is one entry in the ban list. Pattern is stored normalized: IPs and
+// CIDR ranges in canonical form, hostnames and usernames lowercased. A nil
+// ExpiresAt means the ban is permanent.
+type Ban struct {
+	ID        ulid.ULID
+	Target    Target
+	Pattern   string
+	Reason    string
+	IssuedBy  string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+// NewBan creates a validated Ban with its pattern normalized. A zero
+// duration makes the ban permanent.
+func NewBan(target Target, pattern, reason, issuedBy string, duration time.Duration) (*Ban, error) {
+	if !target.Valid() {
+		return nil, oops.Code("BAN_INVALID").
+			With("target", string(target)).
+			Errorf("unknown ban target %q: use ip, cidr, hostname, or player", target)
+	}
+	normalized, err := normalizePattern(target, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if duration < 0 {
+		return nil, oops.Code("BAN_INVALID").Errorf("ban duration cannot be negative")
+	}
+	if len(reason) > MaxReasonLength {
+		reason = reason[:MaxReasonLength]
+	}
+
+	now := time.Now().UTC()
+	ban := &Ban{
+		ID:        idgen.New(),
+		Target:    target,
+		Pattern:   normalized,
+		Reason:    strings.TrimSpace(reason),
+		IssuedBy:  issuedBy,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		expires := now.Add(duration)
+		ban.ExpiresAt = &expires
+	}
+	return ban, nil
+}
+
+// Active reports whether the ban is in force at now.
+func (b *Ban) Active(now time.Time) bool {
+	return b.ExpiresAt == nil || now.Before(*b.ExpiresAt)
+}
+
+// MatchesAddress reports whether the ban matches a connection from addr,
+// whose reverse-DNS name is hostname ("" when unknown). Player bans never
+// match an address.
+func (b *Ban) MatchesAddress(addr netip.Addr, hostname string) bool {
+	switch b.Target {
+	case TargetIP:
+		banned, err := netip.ParseAddr(b.Pattern)
+		return err == nil && addr.IsValid() && banned == addr.Unmap()
+	case TargetCIDR:
+		prefix, err := netip.ParsePrefix(b.Pattern)
+		return err == nil && addr.IsValid() && prefix.Contains(addr.Unmap())
+	case TargetHostname:
+		return hostname != "" && globMatch(b.Pattern, strings.TrimSuffix(strings.ToLower(hostname), "."))
+	}
+	return false
+}
+
+// MatchesPlayer reports whether the ban matches a login as username.
+func (b *Ban) MatchesPlayer(username string) bool {
+	return b.Target == TargetPlayer && username != "" && globMatch(b.Pattern, strings.ToLower(username))
+}
+
+// normalizePattern validates pattern for target and returns its canonical
+// form. Hostname and player patterns accept the * and ? wildcards.
+func normalizePattern(target Target, pattern string) (string, error) {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		return "", oops.Code("BAN_INVALID").Errorf("ban pattern cannot be empty")
+	}
+	if len(pattern) > MaxPatternLength {
+		return "", oops.Code("BAN_INVALID").
+			Errorf("ban pattern cannot exceed %d characters", MaxPatternLength)
+	}
+
+	switch target {
+	case TargetIP:
+		addr, err := netip.ParseAddr(pattern)
+		if err != nil {
+			return "", oops.Code("BAN_INVALID").With("pattern", pattern).
+				Errorf("%q is not an IP address", pattern)
+		}
+		return addr.Unmap().String(), nil
+	case TargetCIDR:
+		prefix, err := netip.ParsePrefix(pattern)
+		if err != nil {
+			return "", oops.Code("BAN_INVALID").With("pattern", pattern).
+				Errorf("%q is not a CIDR range", pattern)
+		}
+		return prefix.Masked().String(), nil
+	default:
+		pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+		if strings.Trim(pattern, "*?.") == "" {
+			return "", oops.Code("BAN_INVALID").With("pattern", pattern).
+				Errorf("ban pattern %q would match everything", pattern)
+		}
+		for _, r := range pattern {
+			if !isPatternRune(r) {
+				return "", oops.Code("BAN_INVALID").With("pattern", pattern).
+					Errorf("ban pattern %q contains invalid character %q", pattern, r)
+			}
+		}
+		return pattern, nil
+	}
+}
+
+// isPatternRune reports whether r may appear in a hostname or username
+// pattern. Excluding path.Match's metacharacters ([, ], \) keeps globMatch
+// to plain * and ? semantics.
+func isPatternRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		return true
+	case r == '-', r == '.', r == '_', r == '*', r == '?':
+		return true
+	}
+	return false
+}
+
+// globMatch matches a normalized pattern against a lowercased value.
+func globMatch(pattern, value string) bool {
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// Repository persists the ban list.
+type Repository interface {
+	// Create stores a new ban. It returns BAN_EXISTS when an active ban with
+	// the same target and pattern exists; an expired one is replaced.
+	Create(ctx context.Context, ban *Ban) error
+
+	// Delete removes a ban by ID and returns it. Returns ErrNotFound if it
+	// does not exist.
+	Delete(ctx context.Context, id ulid.ULID) (*Ban, error)
+
+	// ListActive returns the bans in force at now, oldest first.
+	ListActive(ctx context.Context, now time.Time) ([]*Ban, error)
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package bans
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestNewBanNormalizesPattern(t *testing.T) {
+	tests := []struct {
+		target  Target
+		pattern string
+		want    string
+	}{
+		{TargetIP, " 192.0.2.7 ", "192.0.2.7"},
+		{TargetIP, "::ffff:192.0.2.7", "192.0.2.7"},
+		{TargetCIDR, "192.0.2.77/24", "192.0.2.0/24"},
+		{TargetCIDR, "2001:db8::1/32", "2001:db8::/32"},
+		{TargetHostname, "*.Example.COM.", "*.example.com"},
+		{TargetPlayer, "Griefer?", "griefer?"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.target)+"/"+tt.pattern, func(t *testing.T) {
+			ban, err := NewBan(tt.target, tt.pattern, "reason", "Wizard", 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ban.Pattern)
+			assert.Nil(t, ban.ExpiresAt)
+		})
+	}
+}
+
+func TestNewBanRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   Target
+		pattern  string
+		duration time.Duration
+	}{
+		{"unknown target", "email", "x", 0},
+		{"empty pattern", TargetPlayer, "  ", 0},
+		{"bad ip", TargetIP, "192.0.2", 0},
+		{"bad cidr", TargetCIDR, "192.0.2.0", 0},
+		{"match everything", TargetHostname, "*.*", 0},
+		{"bracket glob", TargetPlayer, "[a-z]*", 0},
+		{"too long", TargetHostname, strings.Repeat("a", MaxPatternLength+1), 0},
+		{"negative duration", TargetPlayer, "bob", -time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBan(tt.target, tt.pattern, "", "", tt.duration)
+			errutil.AssertErrorCode(t, err, "BAN_INVALID")
+		})
+	}
+}
+
+func TestNewBanTruncatesReasonAndSetsExpiry(t *testing.T) {
+	ban, err := NewBan(TargetPlayer, "bob", strings.Repeat("x", MaxReasonLength+10), "Wizard", time.Hour)
+	require.NoError(t, err)
+	assert.Len(t, ban.Reason, MaxReasonLength)
+	require.NotNil(t, ban.ExpiresAt)
+	assert.Equal(t, time.Hour, ban.ExpiresAt.Sub(ban.CreatedAt))
+	assert.True(t, ban.Active(ban.CreatedAt))
+	assert.False(t, ban.Active(*ban.ExpiresAt))
+}
+
+func TestBanMatchesAddress(t *testing.T) {
+	mustBan := func(target Target, pattern string) *Ban {
+		ban, err := NewBan(target, pattern, "", "", 0)
+		require.NoError(t, err)
+		return ban
+	}
+	addr := netip.MustParseAddr("192.0.2.7")
+	mapped := netip.MustParseAddr("::ffff:192.0.2.7")
+
+	assert.True(t, mustBan(TargetIP, "192.0.2.7").MatchesAddress(addr, ""))
+	assert.True(t, mustBan(TargetIP, "192.0.2.7").MatchesAddress(mapped, ""))
+	assert.False(t, mustBan(TargetIP, "192.0.2.8").MatchesAddress(addr, ""))
+	assert.True(t, mustBan(TargetCIDR, "192.0.2.0/24").MatchesAddress(mapped, ""))
+	assert.False(t, mustBan(TargetCIDR, "198.51.100.0/24").MatchesAddress(addr, ""))
+
+	host := mustBan(TargetHostname, "*.dialup.example")
+	assert.True(t, host.MatchesAddress(addr, "Pool-1.DIALUP.example."))
+	assert.False(t, host.MatchesAddress(addr, "dialup.example"))
+	assert.False(t, host.MatchesAddress(addr, ""))
+
+	assert.False(t, mustBan(TargetPlayer, "bob").MatchesAddress(addr, "bob"))
+}
+
+func TestBanMatchesPlayer(t *testing.T) {
+	ban, err := NewBan(TargetPlayer, "troll*", "", "", 0)
+	require.NoError(t, err)
+	assert.True(t, ban.MatchesPlayer("Troll42"))
+	assert.False(t, ban.MatchesPlayer("atroll"))
+	assert.False(t, ban.MatchesPlayer(""))
+
+	ip, err := NewBan(TargetIP, "192.0.2.7", "", "", 0)
+	require.NoError(t, err)
+	assert.False(t, ip.MatchesPlayer("192.0.2.7"))
+}
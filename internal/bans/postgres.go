@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package bans
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const banColumns = `id, target, pattern, reason, issued_by, created_at, expires_at`
+
+// PostgresStore implements Repository against the bans table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts ban. A row with the same target and pattern is only
+// overwritten when it has already expired, so the conflict update matches
+// no row while the existing ban is in force.
+func (s *PostgresStore) Create(ctx context.Context, ban *Ban) error {
+	var expiresAt *pgnanos.Time
+	if ban.ExpiresAt != nil {
+		t := pgnanos.From(*ban.ExpiresAt)
+		expiresAt = &t
+	}
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO bans (id, target, pattern, reason, issued_by, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (target, pattern) DO UPDATE
+		   SET id = EXCLUDED.id,
+		       reason = EXCLUDED.reason,
+		       issued_by = EXCLUDED.issued_by,
+		       created_at = EXCLUDED.created_at,
+		       expires_at = EXCLUDED.expires_at
+		 WHERE bans.expires_at IS NOT NULL AND bans.expires_at <= EXCLUDED.created_at
+	`, ban.ID.String(), string(ban.Target), ban.Pattern, ban.Reason, ban.IssuedBy,
+		pgnanos.From(ban.CreatedAt), expiresAt)
+	if err != nil {
+		return oops.Code("BAN_STORE_FAILED").
+			With("operation", "create").
+			With("pattern", ban.Pattern).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("BAN_EXISTS").
+			With("target", string(ban.Target)).
+			With("pattern", ban.Pattern).
+			Errorf("%s %q is already banned", ban.Target, ban.Pattern)
+	}
+	return nil
+}
+
+// Delete removes the ban with id and returns it.
+func (s *PostgresStore) Delete(ctx context.Context, id ulid.ULID) (*Ban, error) {
+	row := s.pool.QueryRow(ctx, `DELETE FROM bans WHERE id = $1 RETURNING `+banColumns, id.String())
+	ban, err := scanBan(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("BAN_NOT_FOUND").
+			With("ban_id", id.String()).
+			Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("BAN_STORE_FAILED").
+			With("operation", "delete").
+			With("ban_id", id.String()).
+			Wrap(err)
+	}
+	return ban, nil
+}
+
+// ListActive returns bans without an expiry or expiring after now, oldest
+// first.
+func (s *PostgresStore) ListActive(ctx context.Context, now time.Time) ([]*Ban, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+banColumns+`
+		  FROM bans
+		 WHERE expires_at IS NULL OR expires_at > $1
+		 ORDER BY created_at, id
+	`, pgnanos.From(now))
+	if err != nil {
+		return nil, oops.Code("BAN_STORE_FAILED").With("operation", "list_active").Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*Ban
+	for rows.Next() {
+		ban, err := scanBan(rows)
+		if err != nil {
+			return nil, oops.Code("BAN_STORE_FAILED").With("operation", "list_active").Wrap(err)
+		}
+		out = append(out, ban)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("BAN_STORE_FAILED").With("operation", "list_active").Wrap(err)
+	}
+	return out, nil
+}
+
+func scanBan(row pgx.Row) (*Ban, error) {
+	var (
+		ban       Ban
+		id        string
+		target    string
+		createdAt pgnanos.Time
+		expiresAt *pgnanos.Time
+	)
+	if err := row.Scan(&id, &target, &ban.Pattern, &ban.Reason, &ban.IssuedBy,
+		&createdAt, &expiresAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("ban_id", id).Wrap(err)
+	}
+	ban.ID = parsed
+	ban.Target = Target(target)
+	ban.CreatedAt = createdAt.Time()
+	if expiresAt != nil {
+		t := expiresAt.Time()
+		ban.ExpiresAt = &t
+	}
+	return &ban, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package bans_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/bans"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestPostgresStoreRoundTrip(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := bans.NewPostgresStore(pool)
+
+	permanent, err := bans.NewBan(bans.TargetCIDR, "192.0.2.77/24", "spam", "Wizard", 0)
+	require.NoError(t, err)
+	require.NoError(t, st.Create(ctx, permanent))
+
+	temporary, err := bans.NewBan(bans.TargetPlayer, "griefer*", "griefing", "Wizard", time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, st.Create(ctx, temporary))
+
+	dup, err := bans.NewBan(bans.TargetCIDR, "192.0.2.0/24", "again", "Wizard", 0)
+	require.NoError(t, err)
+	errutil.AssertErrorCode(t, st.Create(ctx, dup), "BAN_EXISTS")
+
+	active, err := st.ListActive(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, active, 2)
+	assert.Equal(t, permanent.ID, active[0].ID)
+	assert.Equal(t, "192.0.2.0/24", active[0].Pattern)
+	assert.Nil(t, active[0].ExpiresAt)
+	require.NotNil(t, active[1].ExpiresAt)
+	assert.Equal(t, temporary.ExpiresAt.UnixNano(), active[1].ExpiresAt.UnixNano())
+
+	active, err = st.ListActive(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+
+	removed, err := st.Delete(ctx, permanent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "spam", removed.Reason)
+
+	_, err = st.Delete(ctx, permanent.ID)
+	errutil.AssertErrorCode(t, err, "BAN_NOT_FOUND")
+	_, err = st.Delete(ctx, idgen.New())
+	assert.ErrorIs(t, err, bans.ErrNotFound)
+}
+
+func TestPostgresStoreReplacesExpiredBan(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := bans.NewPostgresStore(pool)
+
+	expired, err := bans.NewBan(bans.TargetHostname, "*.expired.example", "old", "Wizard", time.Nanosecond)
+	require.NoError(t, err)
+	require.NoError(t, st.Create(ctx, expired))
+	time.Sleep(time.Millisecond)
+
+	renewed, err := bans.NewBan(bans.TargetHostname, "*.expired.example", "new", "Wizard", 0)
+	require.NoError(t, err)
+	require.NoError(t, st.Create(ctx, renewed))
+
+	_, err = st.Delete(ctx, expired.ID)
+	assert.ErrorIs(t, err, bans.ErrNotFound)
+	got, err := st.Delete(ctx, renewed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new", got.Reason)
+}
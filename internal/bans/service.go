@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package bans
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// hostnameLookupTimeout bounds the reverse-DNS lookup made for hostname
+// bans so a slow resolver cannot stall a login.
+const hostnameLookupTimeout = 2 * time.Second
+
+// Resolver looks up the names for an address. *net.Resolver satisfies it.
+type Resolver interface {
+	LookupAddr(ctx context.Context, addr string) ([]string, error)
+}
+
+// AddRequest describes a ban to add. A zero Duration makes it permanent.
+type AddRequest struct {
+	Target   Target
+	Pattern  string
+	Reason   string
+	IssuedBy string
+	Duration time.Duration
+}
+
+// Service manages the ban list and answers enforcement checks. Every change
+// is written to the structured log as an audit record, as is every refused
+// connection or login.
+type Service struct {
+	repo     Repository
+	resolver Resolver
+	logger   *slog.Logger
+	now      func() time.Time
+}
+
+// NewService creates a Service. repo is required; a nil logger uses
+// slog.Default(). Hostname bans are checked against net.DefaultResolver
+// until SetResolver replaces it.
+func NewService(repo Repository, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("ban repository is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		repo:     repo,
+		resolver: net.DefaultResolver,
+		logger:   logger,
+		now:      time.Now,
+	}, nil
+}
+
+// SetResolver replaces the reverse-DNS resolver used for hostname bans.
+// Passing nil disables hostname matching.
+func (s *Service) SetResolver(r Resolver) {
+	s.resolver = r
+}
+
+// Add validates and stores a ban.
+func (s *Service) Add(ctx context.Context, req AddRequest) (*Ban, error) {
+	ban, err := NewBan(req.Target, req.Pattern, req.Reason, req.IssuedBy, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, ban); err != nil {
+		return nil, err
+	}
+
+	attrs := []any{
+		"event", "ban_added",
+		"ban_id", ban.ID.String(),
+		"target", string(ban.Target),
+		"pattern", ban.Pattern,
+		"reason", ban.Reason,
+		"issued_by", ban.IssuedBy,
+	}
+	if ban.ExpiresAt != nil {
+		attrs = append(attrs, "expires_at", ban.ExpiresAt.Format(time.RFC3339))
+	}
+	s.logger.InfoContext(ctx, "ban added", attrs...)
+	return ban, nil
+}
+
+// List returns the bans currently in force, oldest first.
+func (s *Service) List(ctx context.Context) ([]*Ban, error) {
+	active, err := s.repo.ListActive(ctx, s.now())
+	if err != nil {
+		return nil, oops.Code("BANS_LIST_FAILED").Wrap(err)
+	}
+	return active, nil
+}
+
+// Remove deletes a ban and returns it. removedBy is recorded in the audit
+// log.
+func (s *Service) Remove(ctx context.Context, id ulid.ULID, removedBy string) (*Ban, error) {
+	ban, err := s.repo.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(
+		ctx, "ban removed",
+		"event", "ban_removed",
+		"ban_id", ban.ID.String(),
+		"target", string(ban.Target),
+		"pattern", ban.Pattern,
+		"removed_by", removedBy,
+	)
+	return ban, nil
+}
+
+// CheckAddress returns the ban matching a connection from ipAddress, or nil.
+// IP and CIDR bans are checked first; the reverse-DNS lookup for hostname
+// bans only runs when one is active. An empty or unparseable address matches
+// nothing.
+func (s *Service) CheckAddress(ctx context.Context, ipAddress string) (*Ban, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return nil, nil //nolint:nilerr // no usable address: nothing to match
+	}
+	active, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hostnameBans := false
+	for _, ban := range active {
+		if ban.MatchesAddress(addr, "") {
+			s.logEnforced(ctx, ban, "address", ipAddress)
+			return ban, nil
+		}
+		hostnameBans = hostnameBans || ban.Target == TargetHostname
+	}
+	if !hostnameBans {
+		return nil, nil
+	}
+
+	for _, hostname := range s.lookupHostnames(ctx, addr) {
+		for _, ban := range active {
+			if ban.MatchesAddress(addr, hostname) {
+				s.logEnforced(ctx, ban, "address", ipAddress)
+				return ban, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// CheckPlayer returns the ban matching a login as username, or nil.
+func (s *Service) CheckPlayer(ctx context.Context, username string) (*Ban, error) {
+	active, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ban := range active {
+		if ban.MatchesPlayer(username) {
+			s.logEnforced(ctx, ban, "username", username)
+			return ban, nil
+		}
+	}
+	return nil, nil
+}
+
+// AddressBanned reports whether ipAddress is banned and why. It adapts
+// CheckAddress to the gate interfaces in auth and telnet.
+func (s *Service) AddressBanned(ctx context.Context, ipAddress string) (reason string, banned bool, err error) {
+	ban, err := s.CheckAddress(ctx, ipAddress)
+	if err != nil || ban == nil {
+		return "", false, err
+	}
+	return ban.Reason, true, nil
+}
+
+// PlayerBanned reports whether username is banned and why. It adapts
+// CheckPlayer to auth.LoginGate.
+func (s *Service) PlayerBanned(ctx context.Context, username string) (reason string, banned bool, err error) {
+	ban, err := s.CheckPlayer(ctx, username)
+	if err != nil || ban == nil {
+		return "", false, err
+	}
+	return ban.Reason, true, nil
+}
+
+// lookupHostnames returns the reverse-DNS names for addr. Lookup failures
+// are logged and treated as no names: a hostname ban cannot match an
+// address that does not resolve.
+func (s *Service) lookupHostnames(ctx context.Context, addr netip.Addr) []string {
+	if s.resolver == nil {
+		return nil
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, hostnameLookupTimeout)
+	defer cancel()
+	names, err := s.resolver.LookupAddr(lookupCtx, addr.String())
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			s.logger.DebugContext(ctx, "ban hostname lookup failed",
+				"address", addr.String(), "error", err)
+		}
+		return nil
+	}
+	return names
+}
+
+func (s *Service) logEnforced(ctx context.Context, ban *Ban, key, value string) {
+	s.logger.WarnContext(
+		ctx, "ban enforced",
+		"event", "ban_enforced",
+		"ban_id", ban.ID.String(),
+		"target", string(ban.Target),
+		"pattern", ban.Pattern,
+		key, value,
+	)
+}
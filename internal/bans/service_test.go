@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package bans
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu      sync.Mutex
+	bans    []*Ban
+	listErr error
+}
+
+func (m *memRepository) Create(_ context.Context, ban *Ban) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, b := range m.bans {
+		if b.Target == ban.Target && b.Pattern == ban.Pattern {
+			if b.Active(ban.CreatedAt) {
+				return oops.Code("BAN_EXISTS").Errorf("already banned")
+			}
+			m.bans[i] = ban
+			return nil
+		}
+	}
+	m.bans = append(m.bans, ban)
+	return nil
+}
+
+func (m *memRepository) Delete(_ context.Context, id ulid.ULID) (*Ban, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, b := range m.bans {
+		if b.ID == id {
+			m.bans = append(m.bans[:i], m.bans[i+1:]...)
+			return b, nil
+		}
+	}
+	return nil, oops.Code("BAN_NOT_FOUND").Wrap(ErrNotFound)
+}
+
+func (m *memRepository) ListActive(_ context.Context, now time.Time) ([]*Ban, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.listErr != nil {
+		return nil, m.listErr
+	}
+	var out []*Ban
+	for _, b := range m.bans {
+		if b.Active(now) {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+// stubResolver answers reverse lookups from a fixed table and counts calls.
+type stubResolver struct {
+	names map[string][]string
+	calls int
+}
+
+func (r *stubResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	r.calls++
+	if names, ok := r.names[addr]; ok {
+		return names, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func newTestService(t *testing.T) (*Service, *memRepository, *stubResolver, *bytes.Buffer) {
+	t.Helper()
+	repo := &memRepository{}
+	var logs bytes.Buffer
+	svc, err := NewService(repo, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	resolver := &stubResolver{names: map[string][]string{}}
+	svc.SetResolver(resolver)
+	return svc, repo, resolver, &logs
+}
+
+func TestNewServiceRequiresRepository(t *testing.T) {
+	_, err := NewService(nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceAddListRemoveAudits(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, logs := newTestService(t)
+
+	ban, err := svc.Add(ctx, AddRequest{
+		Target: TargetCIDR, Pattern: "192.0.2.0/24", Reason: "spam", IssuedBy: "Wizard", Duration: time.Hour,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, logs.String(), `"event":"ban_added"`)
+	assert.Contains(t, logs.String(), `"issued_by":"Wizard"`)
+
+	_, err = svc.Add(ctx, AddRequest{Target: TargetCIDR, Pattern: "192.0.2.9/24"})
+	errutil.AssertErrorCode(t, err, "BAN_EXISTS")
+
+	active, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+
+	removed, err := svc.Remove(ctx, ban.ID, "Admin")
+	require.NoError(t, err)
+	assert.Equal(t, ban.ID, removed.ID)
+	assert.Contains(t, logs.String(), `"event":"ban_removed"`)
+	assert.Contains(t, logs.String(), `"removed_by":"Admin"`)
+
+	_, err = svc.Remove(ctx, ban.ID, "Admin")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceListIgnoresExpiredBans(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _ := newTestService(t)
+	_, err := svc.Add(ctx, AddRequest{Target: TargetPlayer, Pattern: "bob", Duration: time.Minute})
+	require.NoError(t, err)
+
+	svc.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	active, err := svc.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, active)
+}
+
+func TestServiceCheckAddress(t *testing.T) {
+	ctx := context.Background()
+	svc, _, resolver, logs := newTestService(t)
+	_, err := svc.Add(ctx, AddRequest{Target: TargetIP, Pattern: "192.0.2.7", Reason: "abuse"})
+	require.NoError(t, err)
+
+	reason, banned, err := svc.AddressBanned(ctx, "192.0.2.7")
+	require.NoError(t, err)
+	assert.True(t, banned)
+	assert.Equal(t, "abuse", reason)
+	assert.Contains(t, logs.String(), `"event":"ban_enforced"`)
+
+	// No hostname bans: no reverse lookup is made.
+	_, banned, err = svc.AddressBanned(ctx, "198.51.100.1")
+	require.NoError(t, err)
+	assert.False(t, banned)
+	assert.Zero(t, resolver.calls)
+
+	_, banned, err = svc.AddressBanned(ctx, "")
+	require.NoError(t, err)
+	assert.False(t, banned)
+}
+
+func TestServiceCheckAddressMatchesHostnameBans(t *testing.T) {
+	ctx := context.Background()
+	svc, _, resolver, _ := newTestService(t)
+	resolver.names["198.51.100.1"] = []string{"pool-1.dialup.example."}
+	_, err := svc.Add(ctx, AddRequest{Target: TargetHostname, Pattern: "*.dialup.example", Reason: "open proxy"})
+	require.NoError(t, err)
+
+	reason, banned, err := svc.AddressBanned(ctx, "198.51.100.1")
+	require.NoError(t, err)
+	assert.True(t, banned)
+	assert.Equal(t, "open proxy", reason)
+
+	// Lookup failure: nothing matches.
+	_, banned, err = svc.AddressBanned(ctx, "203.0.113.1")
+	require.NoError(t, err)
+	assert.False(t, banned)
+	assert.Equal(t, 2, resolver.calls)
+
+	svc.SetResolver(nil)
+	_, banned, err = svc.AddressBanned(ctx, "198.51.100.1")
+	require.NoError(t, err)
+	assert.False(t, banned)
+}
+
+func TestServicePlayerBanned(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _ := newTestService(t)
+	_, err := svc.Add(ctx, AddRequest{Target: TargetPlayer, Pattern: "troll*", Reason: "griefing"})
+	require.NoError(t, err)
+
+	reason, banned, err := svc.PlayerBanned(ctx, "TrollFace")
+	require.NoError(t, err)
+	assert.True(t, banned)
+	assert.Equal(t, "griefing", reason)
+
+	_, banned, err = svc.PlayerBanned(ctx, "alice")
+	require.NoError(t, err)
+	assert.False(t, banned)
+}
+
+func TestServiceChecksSurfaceRepositoryErrors(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _, _ := newTestService(t)
+	repo.listErr = errors.New("db down")
+
+	_, _, err := svc.AddressBanned(ctx, "192.0.2.7")
+	errutil.AssertErrorCode(t, err, "BANS_LIST_FAILED")
+	_, _, err = svc.PlayerBanned(ctx, "alice")
+	errutil.AssertErrorCode(t, err, "BANS_LIST_FAILED")
+}
@@ -12,9 +12,14 @@ import (
 	plugins "github.com/holomush/holomush/internal/plugin"
 )
 
-// AutoMigrator runs database migrations.
+// AutoMigrator runs database migrations and reports the schema version.
 type AutoMigrator interface {
 	Up() error
+	// Version returns the database's schema version and whether a
+	// migration failed partway through it.
+	Version() (version uint, dirty bool, err error)
+	// LatestVersion returns the schema version this binary expects.
+	LatestVersion() (uint, error)
 	Close() error
 }
 
@@ -42,15 +47,11 @@ func (b *MigrationBootstrapper) Priority() int {
 	return plugins.BootstrapPrioritySchema
 }
 
-// Bootstrap runs database migrations if enabled.
+// Bootstrap gates startup on the database schema version. A dirty schema,
+// or one newer than this binary knows, always refuses to boot. Otherwise
+// migrations run when enabled; when disabled, a schema behind the binary
+// refuses to boot rather than running against tables it does not match.
 func (b *MigrationBootstrapper) Bootstrap(ctx context.Context, _ *plugins.Manifest, _ string) error {
-	if !b.enabled {
-		slog.InfoContext(ctx, "auto-migration disabled, skipping")
-		return nil
-	}
-
-	slog.InfoContext(ctx, "running auto-migration")
-
 	migrator, err := b.migratorFactory(b.databaseURL)
 	if err != nil {
 		return oops.Code("MIGRATION_INIT_FAILED").With("operation", "create migrator").Wrap(err)
@@ -62,6 +63,40 @@ func (b *MigrationBootstrapper) Bootstrap(ctx context.Context, _ *plugins.Manife
 		}
 	}()
 
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return oops.Code("SCHEMA_VERSION_CHECK_FAILED").With("operation", "read schema version").Wrap(err)
+	}
+	expected, err := migrator.LatestVersion()
+	if err != nil {
+		return oops.Code("SCHEMA_VERSION_CHECK_FAILED").With("operation", "read expected version").Wrap(err)
+	}
+
+	if dirty {
+		return oops.Code("SCHEMA_DIRTY").
+			With("version", version).
+			Errorf("migration %d failed partway through; repair the database, then run 'holomush migrate force <version>'", version)
+	}
+	if version > expected {
+		return oops.Code("SCHEMA_TOO_NEW").
+			With("version", version).
+			With("expected", expected).
+			Errorf("database schema is at version %d but this binary expects %d; run a newer holomush", version, expected)
+	}
+
+	if !b.enabled {
+		if version < expected {
+			return oops.Code("SCHEMA_OUTDATED").
+				With("version", version).
+				With("expected", expected).
+				Errorf("database schema is at version %d but this binary expects %d; run 'holomush migrate up' or start with --auto-migrate", version, expected)
+		}
+		slog.InfoContext(ctx, "auto-migration disabled, schema is current", "version", version)
+		return nil
+	}
+
+	slog.InfoContext(ctx, "running auto-migration", "from_version", version, "to_version", expected)
+
 	if err := migrator.Up(); err != nil {
 		return oops.Code("AUTO_MIGRATION_FAILED").With("operation", "run migrations").Wrap(err)
 	}
@@ -16,9 +16,13 @@ import (
 )
 
 type mockMigrator struct {
-	upCalled bool
-	upErr    error
-	closeErr error
+	upCalled   bool
+	upErr      error
+	closeErr   error
+	version    uint
+	dirty      bool
+	versionErr error
+	latest     uint
 }
 
 func (m *mockMigrator) Up() error {
@@ -26,6 +30,14 @@ func (m *mockMigrator) Up() error {
 	return m.upErr
 }
 
+func (m *mockMigrator) Version() (uint, bool, error) {
+	return m.version, m.dirty, m.versionErr
+}
+
+func (m *mockMigrator) LatestVersion() (uint, error) {
+	return m.latest, nil
+}
+
 func (m *mockMigrator) Close() error {
 	return m.closeErr
 }
@@ -75,6 +87,50 @@ func TestMigrationBootstrapper(t *testing.T) {
 			expectCode:     "AUTO_MIGRATION_FAILED",
 			expectUpCalled: true,
 		},
+		{
+			name:           "migrates an outdated schema when enabled",
+			enabled:        true,
+			migrator:       &mockMigrator{version: 59, latest: 61},
+			expectUpCalled: true,
+		},
+		{
+			name:           "boots a current schema when disabled",
+			enabled:        false,
+			migrator:       &mockMigrator{version: 61, latest: 61},
+			expectUpCalled: false,
+		},
+		{
+			name:           "refuses an outdated schema when disabled",
+			enabled:        false,
+			migrator:       &mockMigrator{version: 59, latest: 61},
+			expectErr:      true,
+			expectCode:     "SCHEMA_OUTDATED",
+			expectUpCalled: false,
+		},
+		{
+			name:           "refuses a dirty schema even when enabled",
+			enabled:        true,
+			migrator:       &mockMigrator{version: 60, dirty: true, latest: 61},
+			expectErr:      true,
+			expectCode:     "SCHEMA_DIRTY",
+			expectUpCalled: false,
+		},
+		{
+			name:           "refuses a schema newer than the binary",
+			enabled:        true,
+			migrator:       &mockMigrator{version: 62, latest: 61},
+			expectErr:      true,
+			expectCode:     "SCHEMA_TOO_NEW",
+			expectUpCalled: false,
+		},
+		{
+			name:           "returns error when the version cannot be read",
+			enabled:        true,
+			migrator:       &mockMigrator{versionErr: errors.New("connection lost")},
+			expectErr:      true,
+			expectCode:     "SCHEMA_VERSION_CHECK_FAILED",
+			expectUpCalled: false,
+		},
 		{
 			name:           "ignores Close() error",
 			enabled:        true,
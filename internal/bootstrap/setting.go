@@ -225,12 +225,14 @@ type exitSeed struct {
 	Aliases []string `yaml:"aliases"`
 }
 
-// seedWorld creates locations and exits from world YAML seed files.
+// seedWorld creates locations and exits from world YAML seed files. It is a
+// bulk import, so its writes do not emit property_changed events.
 func (b *SettingBootstrapper) seedWorld(ctx context.Context, manifest *plugins.Manifest, pluginDir string) error {
 	if b.worldService == nil {
 		b.logger.WarnContext(ctx, "world service not configured, skipping world seed")
 		return nil
 	}
+	ctx = world.WithoutPropertyChangeEvents(ctx)
 
 	worldDir := filepath.Join(pluginDir, manifest.Setting.WorldDir)
 
@@ -0,0 +1,296 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package builder implements the classic MUSH builder verbs — @dig, @open,
+// @link, and @describe — over the world service. Each verb is parsed from
+// its traditional syntax ("@dig North Hall=north;n,south;s") and carried out
+// as world service calls; a verb that creates several entities does so in
+// one world transaction, so a failure part-way leaves nothing behind.
+// Authorization is the world service's: the builder needs write access to
+// the locations and exits it touches.
+package builder
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// World is the world access the builder needs. *world.Service satisfies it.
+type World interface {
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
+	FindLocationByName(ctx context.Context, subjectID, name string) (*world.Location, error)
+	CreateLocation(ctx context.Context, subjectID string, loc *world.Location) error
+	UpdateLocation(ctx context.Context, subjectID string, loc *world.Location) error
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+	CreateExit(ctx context.Context, subjectID string, exit *world.Exit) error
+	UpdateExit(ctx context.Context, subjectID string, exit *world.Exit) error
+	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*world.Character, error)
+	UpdateCharacterDescription(ctx context.Context, subjectID string, characterID ulid.ULID, description string) error
+	GetObjectsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Object, error)
+	GetObjectsHeldBy(ctx context.Context, subjectID string, characterID ulid.ULID) ([]*world.Object, error)
+	UpdateObject(ctx context.Context, subjectID string, obj *world.Object) error
+}
+
+// Actor is the character running a builder verb and where they stand.
+// Exits are opened from, and "here" refers to, LocationID.
+type Actor struct {
+	CharacterID ulid.ULID
+	LocationID  ulid.ULID
+}
+
+func (a Actor) subject() string {
+	return access.CharacterSubject(a.CharacterID.String())
+}
+
+// DigResult is what @dig created. Exit and Return are nil when not asked for.
+type DigResult struct {
+	Location *world.Location
+	Exit     *world.Exit
+	Return   *world.Exit
+}
+
+// OpenResult is what @open created. Return is nil when not asked for.
+type OpenResult struct {
+	Exit        *world.Exit
+	Destination *world.Location
+	Return      *world.Exit
+}
+
+// Builder carries out the builder verbs.
+type Builder struct {
+	world World
+}
+
+// New creates a Builder over w.
+func New(w World) *Builder {
+	return &Builder{world: w}
+}
+
+// Dig creates the location cmd names, owned by the actor, and any exits
+// to and from it, all in one transaction. Exits need the actor to be in a
+// location; returns BUILD_NO_LOCATION otherwise.
+func (b *Builder) Dig(ctx context.Context, actor Actor, cmd DigCommand) (*DigResult, error) {
+	if cmd.Exit != nil && actor.LocationID.IsZero() {
+		return nil, noLocationError()
+	}
+	owner := actor.CharacterID
+	result := &DigResult{Location: &world.Location{
+		Name:    cmd.Location,
+		Type:    world.LocationTypePersistent,
+		OwnerID: &owner,
+	}}
+	err := b.world.InTransaction(ctx, func(txCtx context.Context) error {
+		if err := b.world.CreateLocation(txCtx, actor.subject(), result.Location); err != nil {
+			return err
+		}
+		var err error
+		if cmd.Exit != nil {
+			if result.Exit, err = b.createExit(txCtx, actor, *cmd.Exit, actor.LocationID, result.Location.ID); err != nil {
+				return err
+			}
+		}
+		if cmd.Return != nil {
+			if result.Return, err = b.createExit(txCtx, actor, *cmd.Return, result.Location.ID, actor.LocationID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	return result, nil
+}
+
+// Open creates an exit from the actor's location to cmd.Destination, and
+// the return exit when asked, in one transaction. Returns
+// BUILD_NO_LOCATION when the actor is nowhere and
+// BUILD_DESTINATION_NOT_FOUND when the destination does not resolve.
+func (b *Builder) Open(ctx context.Context, actor Actor, cmd OpenCommand) (*OpenResult, error) {
+	if actor.LocationID.IsZero() {
+		return nil, noLocationError()
+	}
+	result := &OpenResult{}
+	err := b.world.InTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if result.Destination, err = b.resolveLocation(txCtx, actor, cmd.Destination); err != nil {
+			return err
+		}
+		if result.Exit, err = b.createExit(txCtx, actor, cmd.Exit, actor.LocationID, result.Destination.ID); err != nil {
+			return err
+		}
+		if cmd.Return != nil {
+			if result.Return, err = b.createExit(txCtx, actor, *cmd.Return, result.Destination.ID, actor.LocationID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	return result, nil
+}
+
+// Link points an exit of the actor's location at cmd.Destination and
+// returns the updated exit and its new destination. Returns
+// BUILD_EXIT_NOT_FOUND when there is no such exit here and
+// BUILD_DESTINATION_NOT_FOUND when the destination does not resolve.
+func (b *Builder) Link(ctx context.Context, actor Actor, cmd LinkCommand) (*world.Exit, *world.Location, error) {
+	if actor.LocationID.IsZero() {
+		return nil, nil, noLocationError()
+	}
+	exit, err := b.findExit(ctx, actor, cmd.Exit)
+	if err != nil {
+		return nil, nil, err
+	}
+	destination, err := b.resolveLocation(ctx, actor, cmd.Destination)
+	if err != nil {
+		return nil, nil, err
+	}
+	exit.ToLocationID = destination.ID
+	if err := b.world.UpdateExit(ctx, actor.subject(), exit); err != nil {
+		return nil, nil, oops.Wrap(err)
+	}
+	return exit, destination, nil
+}
+
+// Describe sets the description of cmd.Target and returns the name of what
+// was described. The target is "here" (the actor's location), "me" (the
+// actor), or an object in the location or held by the actor. Returns
+// BUILD_TARGET_NOT_FOUND when nothing matches.
+func (b *Builder) Describe(ctx context.Context, actor Actor, cmd DescribeCommand) (string, error) {
+	switch {
+	case strings.EqualFold(cmd.Target, "me"):
+		char, err := b.world.GetCharacter(ctx, actor.subject(), actor.CharacterID)
+		if err != nil {
+			return "", oops.Wrap(err)
+		}
+		if err := b.world.UpdateCharacterDescription(ctx, actor.subject(), actor.CharacterID, cmd.Text); err != nil {
+			return "", oops.Wrap(err)
+		}
+		return char.Name, nil
+	case strings.EqualFold(cmd.Target, "here"):
+		if actor.LocationID.IsZero() {
+			return "", noLocationError()
+		}
+		loc, err := b.world.GetLocation(ctx, actor.subject(), actor.LocationID)
+		if err != nil {
+			return "", oops.Wrap(err)
+		}
+		loc.Description = cmd.Text
+		if err := b.world.UpdateLocation(ctx, actor.subject(), loc); err != nil {
+			return "", oops.Wrap(err)
+		}
+		return loc.Name, nil
+	}
+	obj, err := b.findObject(ctx, actor, cmd.Target)
+	if err != nil {
+		return "", err
+	}
+	obj.Description = cmd.Text
+	if err := b.world.UpdateObject(ctx, actor.subject(), obj); err != nil {
+		return "", oops.Wrap(err)
+	}
+	return obj.Name, nil
+}
+
+// createExit creates a one-way exit named by spec. Each side of a dug or
+// opened passage is its own exit so both keep their aliases.
+func (b *Builder) createExit(ctx context.Context, actor Actor, spec ExitSpec, from, to ulid.ULID) (*world.Exit, error) {
+	exit := &world.Exit{
+		FromLocationID: from,
+		ToLocationID:   to,
+		Name:           spec.Name,
+		Aliases:        spec.Aliases,
+		Visibility:     world.VisibilityAll,
+	}
+	if err := b.world.CreateExit(ctx, actor.subject(), exit); err != nil {
+		return nil, oops.Wrap(err)
+	}
+	return exit, nil
+}
+
+// resolveLocation resolves a destination: "here", "#<id>", or a location
+// name.
+func (b *Builder) resolveLocation(ctx context.Context, actor Actor, destination string) (*world.Location, error) {
+	var (
+		loc *world.Location
+		err error
+	)
+	switch {
+	case strings.EqualFold(destination, "here"):
+		loc, err = b.world.GetLocation(ctx, actor.subject(), actor.LocationID)
+	case strings.HasPrefix(destination, "#"):
+		id, parseErr := ulid.Parse(destination[1:])
+		if parseErr != nil {
+			return nil, destinationNotFoundError(destination)
+		}
+		loc, err = b.world.GetLocation(ctx, actor.subject(), id)
+	default:
+		loc, err = b.world.FindLocationByName(ctx, actor.subject(), destination)
+	}
+	if errors.Is(err, world.ErrNotFound) {
+		return nil, destinationNotFoundError(destination)
+	}
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	return loc, nil
+}
+
+// findExit finds the exit of the actor's location called name, by name or
+// alias, among the exits the actor can see.
+func (b *Builder) findExit(ctx context.Context, actor Actor, name string) (*world.Exit, error) {
+	exits, err := b.world.GetVisibleExits(ctx, actor.subject(), actor.LocationID, actor.CharacterID)
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	for _, exit := range exits {
+		if exit.MatchesName(name) {
+			return exit, nil
+		}
+	}
+	return nil, oops.Code("BUILD_EXIT_NOT_FOUND").With("exit", name).Errorf("no exit called %s here", name)
+}
+
+// findObject finds the object called name that the actor holds or that is
+// in their location, preferring what they hold.
+func (b *Builder) findObject(ctx context.Context, actor Actor, name string) (*world.Object, error) {
+	held, err := b.world.GetObjectsHeldBy(ctx, actor.subject(), actor.CharacterID)
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	candidates := held
+	if !actor.LocationID.IsZero() {
+		here, err := b.world.GetObjectsByLocation(ctx, actor.subject(), actor.LocationID)
+		if err != nil {
+			return nil, oops.Wrap(err)
+		}
+		candidates = append(candidates, here...)
+	}
+	for _, obj := range candidates {
+		if strings.EqualFold(obj.Name, name) {
+			return obj, nil
+		}
+	}
+	return nil, oops.Code("BUILD_TARGET_NOT_FOUND").With("target", name).Errorf("nothing called %s here", name)
+}
+
+func noLocationError() error {
+	return oops.Code("BUILD_NO_LOCATION").Errorf("not in a location")
+}
+
+func destinationNotFoundError(destination string) error {
+	return oops.Code("BUILD_DESTINATION_NOT_FOUND").
+		With("destination", destination).
+		Errorf("no location %s", destination)
+}
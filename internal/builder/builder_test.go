@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package builder_test
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/builder"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeWorld is an in-memory builder.World. InTransaction restores the
+// locations and exits when fn fails, the way a rollback would.
+type fakeWorld struct {
+	locations  map[ulid.ULID]world.Location
+	exits      map[ulid.ULID]world.Exit
+	objects    map[ulid.ULID]*world.Object
+	characters map[ulid.ULID]*world.Character
+	held       map[ulid.ULID][]*world.Object
+	txs        int
+}
+
+func newFakeWorld() *fakeWorld {
+	return &fakeWorld{
+		locations:  map[ulid.ULID]world.Location{},
+		exits:      map[ulid.ULID]world.Exit{},
+		objects:    map[ulid.ULID]*world.Object{},
+		characters: map[ulid.ULID]*world.Character{},
+		held:       map[ulid.ULID][]*world.Object{},
+	}
+}
+
+func (w *fakeWorld) addLocation(name string) ulid.ULID {
+	id := ulid.Make()
+	w.locations[id] = world.Location{ID: id, Name: name, Type: world.LocationTypePersistent}
+	return id
+}
+
+func (w *fakeWorld) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	w.txs++
+	locations, exits := maps.Clone(w.locations), maps.Clone(w.exits)
+	if err := fn(ctx); err != nil {
+		w.locations, w.exits = locations, exits
+		return err
+	}
+	return nil
+}
+
+func (w *fakeWorld) GetLocation(_ context.Context, _ string, id ulid.ULID) (*world.Location, error) {
+	loc, ok := w.locations[id]
+	if !ok {
+		return nil, oops.Code("LOCATION_NOT_FOUND").Wrap(world.ErrNotFound)
+	}
+	return &loc, nil
+}
+
+func (w *fakeWorld) FindLocationByName(_ context.Context, _, name string) (*world.Location, error) {
+	for _, loc := range w.locations {
+		if strings.EqualFold(loc.Name, name) {
+			return &loc, nil
+		}
+	}
+	return nil, oops.Code("LOCATION_NOT_FOUND").Wrap(world.ErrNotFound)
+}
+
+func (w *fakeWorld) CreateLocation(_ context.Context, _ string, loc *world.Location) error {
+	loc.ID = ulid.Make()
+	if err := loc.Validate(); err != nil {
+		return oops.Code("LOCATION_INVALID").Wrap(err)
+	}
+	w.locations[loc.ID] = *loc
+	return nil
+}
+
+func (w *fakeWorld) UpdateLocation(_ context.Context, _ string, loc *world.Location) error {
+	w.locations[loc.ID] = *loc
+	return nil
+}
+
+func (w *fakeWorld) GetVisibleExits(_ context.Context, _ string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error) {
+	var out []*world.Exit
+	for _, e := range w.exits {
+		if e.FromLocationID == locationID {
+			out = append(out, &e)
+		}
+	}
+	return world.FilterVisibleExits(out, world.ExitObserver{CharacterID: observerCharID}), nil
+}
+
+func (w *fakeWorld) CreateExit(_ context.Context, _ string, exit *world.Exit) error {
+	exit.ID = ulid.Make()
+	if err := exit.Validate(); err != nil {
+		return oops.Code("EXIT_INVALID").Wrap(err)
+	}
+	for _, e := range w.exits {
+		if e.FromLocationID == exit.FromLocationID && e.MatchesName(exit.Name) {
+			return oops.Code(world.CodeExitNameConflict).Wrap(world.ErrExitNameConflict)
+		}
+	}
+	w.exits[exit.ID] = *exit
+	return nil
+}
+
+func (w *fakeWorld) UpdateExit(_ context.Context, _ string, exit *world.Exit) error {
+	w.exits[exit.ID] = *exit
+	return nil
+}
+
+func (w *fakeWorld) GetCharacter(_ context.Context, _ string, id ulid.ULID) (*world.Character, error) {
+	return w.characters[id], nil
+}
+
+func (w *fakeWorld) UpdateCharacterDescription(_ context.Context, _ string, id ulid.ULID, description string) error {
+	w.characters[id].Description = description
+	return nil
+}
+
+func (w *fakeWorld) GetObjectsByLocation(_ context.Context, _ string, locationID ulid.ULID) ([]*world.Object, error) {
+	var out []*world.Object
+	for _, obj := range w.objects {
+		if id := obj.LocationID(); id != nil && *id == locationID {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+func (w *fakeWorld) GetObjectsHeldBy(_ context.Context, _ string, characterID ulid.ULID) ([]*world.Object, error) {
+	return w.held[characterID], nil
+}
+
+func (w *fakeWorld) UpdateObject(_ context.Context, _ string, obj *world.Object) error {
+	w.objects[obj.ID] = obj
+	return nil
+}
+
+func (w *fakeWorld) exitsFrom(locationID ulid.ULID) []world.Exit {
+	var out []world.Exit
+	for _, e := range w.exits {
+		if e.FromLocationID == locationID {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func TestDigCreatesLocationAndBothExits(t *testing.T) {
+	ctx := context.Background()
+	w := newFakeWorld()
+	here := w.addLocation("Foyer")
+	actor := builder.Actor{CharacterID: ulid.Make(), LocationID: here}
+
+	cmd, err := builder.ParseDig("North Hall=north;n,south;s")
+	require.NoError(t, err)
+	result, err := builder.New(w).Dig(ctx, actor, cmd)
+	require.NoError(t, err)
+
+	assert.Equal(t, "North Hall", result.Location.Name)
+	require.NotNil(t, result.Location.OwnerID)
+	assert.Equal(t, actor.CharacterID, *result.Location.OwnerID, "the builder owns what they dig")
+	assert.Equal(t, here, result.Exit.FromLocationID)
+	assert.Equal(t, result.Location.ID, result.Exit.ToLocationID)
+	assert.Equal(t, []string{"n"}, result.Exit.Aliases)
+	assert.Equal(t, result.Location.ID, result.Return.FromLocationID)
+	assert.Equal(t, here, result.Return.ToLocationID)
+	assert.Equal(t, []string{"s"}, result.Return.Aliases)
+	assert.Len(t, w.locations, 2)
+	assert.Len(t, w.exits, 2)
+	assert.Equal(t, 1, w.txs, "one transaction for the whole dig")
+}
+
+func TestDigRollsBackWhenAnExitFails(t *testing.T) {
+	ctx := context.Background()
+	w := newFakeWorld()
+	here := w.addLocation("Foyer")
+	actor := builder.Actor{CharacterID: ulid.Make(), LocationID: here}
+	b := builder.New(w)
+
+	_, err := b.Dig(ctx, actor, builder.DigCommand{Location: "Cellar", Exit: &builder.ExitSpec{Name: "down"}})
+	require.NoError(t, err)
+
+	_, err = b.Dig(ctx, actor, builder.DigCommand{Location: "Pit", Exit: &builder.ExitSpec{Name: "down"}})
+	errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+	assert.Len(t, w.locations, 2, "the pit is not left behind without its exit")
+	assert.Len(t, w.exits, 1)
+
+	_, err = b.Dig(ctx, builder.Actor{CharacterID: actor.CharacterID}, builder.DigCommand{Location: "Pit", Exit: &builder.ExitSpec{Name: "down"}})
+	errutil.AssertErrorCode(t, err, "BUILD_NO_LOCATION")
+	_, err = b.Dig(ctx, builder.Actor{CharacterID: actor.CharacterID}, builder.DigCommand{Location: "Limbo Annex"})
+	require.NoError(t, err, "an unlinked room can be dug from nowhere")
+}
+
+func TestOpenResolvesDestinations(t *testing.T) {
+	ctx := context.Background()
+	w := newFakeWorld()
+	here := w.addLocation("Foyer")
+	garden := w.addLocation("Garden")
+	actor := builder.Actor{CharacterID: ulid.Make(), LocationID: here}
+	b := builder.New(w)
+
+	result, err := b.Open(ctx, actor, builder.OpenCommand{
+		Exit: builder.ExitSpec{Name: "east", Aliases: []string{"e"}}, Destination: "garden",
+		Return: &builder.ExitSpec{Name: "west"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, garden, result.Destination.ID)
+	assert.Equal(t, garden, result.Exit.ToLocationID)
+	assert.Equal(t, here, result.Return.ToLocationID)
+
+	result, err = b.Open(ctx, actor, builder.OpenCommand{Exit: builder.ExitSpec{Name: "gate"}, Destination: "#" + garden.String()})
+	require.NoError(t, err)
+	assert.Equal(t, "Garden", result.Destination.Name)
+
+	for _, destination := range []string{"Nowhere", "#nope", "#" + ulid.Make().String()} {
+		_, err = b.Open(ctx, actor, builder.OpenCommand{Exit: builder.ExitSpec{Name: "hatch"}, Destination: destination})
+		errutil.AssertErrorCode(t, err, "BUILD_DESTINATION_NOT_FOUND")
+	}
+
+	_, err = b.Open(ctx, actor, builder.OpenCommand{
+		Exit: builder.ExitSpec{Name: "arch"}, Destination: "Garden", Return: &builder.ExitSpec{Name: "east"},
+	})
+	require.NoError(t, err, "the return side is checked at the destination, not here")
+	_, err = b.Open(ctx, actor, builder.OpenCommand{
+		Exit: builder.ExitSpec{Name: "door"}, Destination: "Garden", Return: &builder.ExitSpec{Name: "West"},
+	})
+	errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+	for _, e := range w.exitsFrom(here) {
+		assert.NotEqual(t, "door", e.Name, "a failed return rolls the exit back")
+	}
+}
+
+func TestLinkRetargetsExit(t *testing.T) {
+	ctx := context.Background()
+	w := newFakeWorld()
+	here := w.addLocation("Foyer")
+	w.addLocation("Garden")
+	square := w.addLocation("Town Square")
+	actor := builder.Actor{CharacterID: ulid.Make(), LocationID: here}
+	b := builder.New(w)
+	_, err := b.Open(ctx, actor, builder.OpenCommand{Exit: builder.ExitSpec{Name: "out", Aliases: []string{"o"}}, Destination: "Garden"})
+	require.NoError(t, err)
+
+	exit, destination, err := b.Link(ctx, actor, builder.LinkCommand{Exit: "O", Destination: "town square"})
+	require.NoError(t, err)
+	assert.Equal(t, square, destination.ID)
+	assert.Equal(t, square, w.exits[exit.ID].ToLocationID)
+
+	_, _, err = b.Link(ctx, actor, builder.LinkCommand{Exit: "up", Destination: "Garden"})
+	errutil.AssertErrorCode(t, err, "BUILD_EXIT_NOT_FOUND")
+	_, _, err = b.Link(ctx, actor, builder.LinkCommand{Exit: "out", Destination: "Nowhere"})
+	errutil.AssertErrorCode(t, err, "BUILD_DESTINATION_NOT_FOUND")
+
+	// An exit the builder cannot see is not theirs to relink.
+	hidden := w.exits[exit.ID]
+	hidden.Visibility = world.VisibilityList
+	hidden.VisibleTo = []ulid.ULID{ulid.Make()}
+	w.exits[exit.ID] = hidden
+	_, _, err = b.Link(ctx, actor, builder.LinkCommand{Exit: "out", Destination: "Garden"})
+	errutil.AssertErrorCode(t, err, "BUILD_EXIT_NOT_FOUND")
+}
+
+func TestDescribeTargets(t *testing.T) {
+	ctx := context.Background()
+	w := newFakeWorld()
+	here := w.addLocation("Foyer")
+	charID := ulid.Make()
+	w.characters[charID] = &world.Character{ID: charID, Name: "Alice"}
+	lamp := &world.Object{ID: ulid.Make(), Name: "Brass Lamp"}
+	require.NoError(t, lamp.SetContainment(world.InLocation(here)))
+	w.objects[lamp.ID] = lamp
+	actor := builder.Actor{CharacterID: charID, LocationID: here}
+	b := builder.New(w)
+
+	name, err := b.Describe(ctx, actor, builder.DescribeCommand{Target: "HERE", Text: "A drafty hall."})
+	require.NoError(t, err)
+	assert.Equal(t, "Foyer", name)
+	assert.Equal(t, "A drafty hall.", w.locations[here].Description)
+
+	name, err = b.Describe(ctx, actor, builder.DescribeCommand{Target: "me", Text: "Tall."})
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", name)
+	assert.Equal(t, "Tall.", w.characters[charID].Description)
+
+	name, err = b.Describe(ctx, actor, builder.DescribeCommand{Target: "brass lamp", Text: "Dented."})
+	require.NoError(t, err)
+	assert.Equal(t, "Brass Lamp", name)
+	assert.Equal(t, "Dented.", w.objects[lamp.ID].Description)
+
+	_, err = b.Describe(ctx, actor, builder.DescribeCommand{Target: "sofa", Text: "Soft."})
+	errutil.AssertErrorCode(t, err, "BUILD_TARGET_NOT_FOUND")
+}
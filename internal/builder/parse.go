@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// Usage lines for the builder verbs, in the "@" form players type.
+const (
+	DigUsage      = "@dig <name>[=<exit>[,<return exit>]]"
+	OpenUsage     = "@open <exit>=<destination>[,<return exit>]"
+	LinkUsage     = "@link <exit>=<destination>"
+	DescribeUsage = "@describe <here|me|object>=<text>"
+)
+
+// ExitSpec names an exit to create: "north;n;nor" is the exit north with
+// the aliases n and nor.
+type ExitSpec struct {
+	Name    string
+	Aliases []string
+}
+
+// String renders the spec back in "name;alias" form.
+func (e ExitSpec) String() string {
+	return strings.Join(append([]string{e.Name}, e.Aliases...), ";")
+}
+
+// DigCommand is a parsed @dig: a new location, optionally joined to the
+// builder's location by Exit, and back by Return.
+type DigCommand struct {
+	Location string
+	Exit     *ExitSpec
+	Return   *ExitSpec
+}
+
+// OpenCommand is a parsed @open: an exit from the builder's location to
+// Destination, optionally with a Return exit back.
+type OpenCommand struct {
+	Exit        ExitSpec
+	Destination string
+	Return      *ExitSpec
+}
+
+// LinkCommand is a parsed @link: point the exit named Exit at Destination.
+type LinkCommand struct {
+	Exit        string
+	Destination string
+}
+
+// DescribeCommand is a parsed @describe: set the description of Target
+// ("here", "me", or an object) to Text. An empty Text clears it.
+type DescribeCommand struct {
+	Target string
+	Text   string
+}
+
+// ParseDig parses the arguments of "@dig <name>[=<exit>[,<return exit>]]".
+// Returns BUILD_INVALID_SYNTAX when the name or an exit is missing.
+func ParseDig(args string) (DigCommand, error) {
+	name, exits, hasExits := strings.Cut(args, "=")
+	cmd := DigCommand{Location: strings.TrimSpace(name)}
+	if cmd.Location == "" {
+		return DigCommand{}, syntaxError(DigUsage)
+	}
+	if !hasExits {
+		return cmd, nil
+	}
+	forward, back, hasReturn := strings.Cut(exits, ",")
+	exit, ok := parseExitSpec(forward)
+	if !ok {
+		return DigCommand{}, syntaxError(DigUsage)
+	}
+	cmd.Exit = &exit
+	if hasReturn {
+		ret, ok := parseExitSpec(back)
+		if !ok {
+			return DigCommand{}, syntaxError(DigUsage)
+		}
+		cmd.Return = &ret
+	}
+	return cmd, nil
+}
+
+// ParseOpen parses the arguments of
+// "@open <exit>=<destination>[,<return exit>]". Returns
+// BUILD_INVALID_SYNTAX when the exit or destination is missing.
+func ParseOpen(args string) (OpenCommand, error) {
+	spec, rest, ok := strings.Cut(args, "=")
+	if !ok {
+		return OpenCommand{}, syntaxError(OpenUsage)
+	}
+	exit, ok := parseExitSpec(spec)
+	if !ok {
+		return OpenCommand{}, syntaxError(OpenUsage)
+	}
+	destination, back, hasReturn := strings.Cut(rest, ",")
+	cmd := OpenCommand{Exit: exit, Destination: strings.TrimSpace(destination)}
+	if cmd.Destination == "" {
+		return OpenCommand{}, syntaxError(OpenUsage)
+	}
+	if hasReturn {
+		ret, ok := parseExitSpec(back)
+		if !ok {
+			return OpenCommand{}, syntaxError(OpenUsage)
+		}
+		cmd.Return = &ret
+	}
+	return cmd, nil
+}
+
+// ParseLink parses the arguments of "@link <exit>=<destination>".
+// Returns BUILD_INVALID_SYNTAX when either side is missing.
+func ParseLink(args string) (LinkCommand, error) {
+	exit, destination, ok := strings.Cut(args, "=")
+	cmd := LinkCommand{Exit: strings.TrimSpace(exit), Destination: strings.TrimSpace(destination)}
+	if !ok || cmd.Exit == "" || cmd.Destination == "" {
+		return LinkCommand{}, syntaxError(LinkUsage)
+	}
+	return cmd, nil
+}
+
+// ParseDescribe parses the arguments of "@describe <here|me|object>=<text>".
+// Returns BUILD_INVALID_SYNTAX when the target or "=" is missing.
+func ParseDescribe(args string) (DescribeCommand, error) {
+	target, text, ok := strings.Cut(args, "=")
+	cmd := DescribeCommand{Target: strings.TrimSpace(target), Text: strings.TrimSpace(text)}
+	if !ok || cmd.Target == "" {
+		return DescribeCommand{}, syntaxError(DescribeUsage)
+	}
+	return cmd, nil
+}
+
+// parseExitSpec parses "name;alias;alias", dropping empty aliases.
+func parseExitSpec(s string) (ExitSpec, bool) {
+	var spec ExitSpec
+	for _, part := range strings.Split(s, ";") {
+		part = strings.TrimSpace(part)
+		switch {
+		case part == "":
+		case spec.Name == "":
+			spec.Name = part
+		default:
+			spec.Aliases = append(spec.Aliases, part)
+		}
+	}
+	return spec, spec.Name != ""
+}
+
+func syntaxError(usage string) error {
+	return oops.Code("BUILD_INVALID_SYNTAX").With("usage", usage).Errorf("usage: %s", usage)
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/builder"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestParseDig(t *testing.T) {
+	cmd, err := builder.ParseDig(" North Hall = north;n;nor , south;s ")
+	require.NoError(t, err)
+	assert.Equal(t, "North Hall", cmd.Location)
+	require.NotNil(t, cmd.Exit)
+	assert.Equal(t, builder.ExitSpec{Name: "north", Aliases: []string{"n", "nor"}}, *cmd.Exit)
+	require.NotNil(t, cmd.Return)
+	assert.Equal(t, "south;s", cmd.Return.String())
+
+	cmd, err = builder.ParseDig("Closet")
+	require.NoError(t, err)
+	assert.Equal(t, builder.DigCommand{Location: "Closet"}, cmd)
+
+	cmd, err = builder.ParseDig("Closet=in")
+	require.NoError(t, err)
+	assert.Equal(t, "in", cmd.Exit.Name)
+	assert.Nil(t, cmd.Return)
+
+	for _, args := range []string{"", "=north", "Closet=", "Closet=;", "Closet=in,", "Closet=,out"} {
+		_, err := builder.ParseDig(args)
+		errutil.AssertErrorCode(t, err, "BUILD_INVALID_SYNTAX")
+	}
+}
+
+func TestParseOpen(t *testing.T) {
+	cmd, err := builder.ParseOpen("east;e=#01H000000000000000000000A1,west;w")
+	require.NoError(t, err)
+	assert.Equal(t, "east;e", cmd.Exit.String())
+	assert.Equal(t, "#01H000000000000000000000A1", cmd.Destination)
+	assert.Equal(t, "west;w", cmd.Return.String())
+
+	cmd, err = builder.ParseOpen("door=Garden")
+	require.NoError(t, err)
+	assert.Equal(t, "Garden", cmd.Destination)
+	assert.Nil(t, cmd.Return)
+
+	for _, args := range []string{"", "door", "door=", "=Garden", "door=Garden,"} {
+		_, err := builder.ParseOpen(args)
+		errutil.AssertErrorCode(t, err, "BUILD_INVALID_SYNTAX")
+	}
+}
+
+func TestParseLinkAndDescribe(t *testing.T) {
+	link, err := builder.ParseLink("north = Town Square")
+	require.NoError(t, err)
+	assert.Equal(t, builder.LinkCommand{Exit: "north", Destination: "Town Square"}, link)
+	for _, args := range []string{"", "north", "north=", "=Square"} {
+		_, err := builder.ParseLink(args)
+		errutil.AssertErrorCode(t, err, "BUILD_INVALID_SYNTAX")
+	}
+
+	desc, err := builder.ParseDescribe("here=A drafty hall. It smells of = signs.")
+	require.NoError(t, err)
+	assert.Equal(t, builder.DescribeCommand{Target: "here", Text: "A drafty hall. It smells of = signs."}, desc)
+	desc, err = builder.ParseDescribe("lamp=")
+	require.NoError(t, err)
+	assert.Empty(t, desc.Text, "an empty description clears it")
+	for _, args := range []string{"", "here", "=text"} {
+		_, err := builder.ParseDescribe(args)
+		errutil.AssertErrorCode(t, err, "BUILD_INVALID_SYNTAX")
+	}
+}
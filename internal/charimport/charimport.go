@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package charimport moves a game's players and characters over from
+// another server, such as PennMUSH or TinyMUX, through
+// `holomush import-characters`.
+//
+// An import file lists legacy accounts with their email, their password
+// hash from the old server, and their characters with descriptions and
+// attributes. Parse reads it as CSV or JSON and Validate checks every record
+// and looks for duplicates within the file before anything is written.
+// Password hashes are kept, not reset: argon2id hashes are stored as they
+// are and bcrypt hashes in any of their prefixes are stored as bcrypt,
+// which login verifies and then upgrades to argon2id.
+//
+// Importer writes the records. Re-running an import is safe: players it
+// created before are found through the legacy_imports ledger and
+// characters they already have are left alone. A record that collides with
+// an account or character the import did not create is skipped and
+// reported, never merged.
+package charimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Format is the encoding of an import file.
+type Format string
+
+// Import file formats.
+const (
+	// FormatCSV is one row per character, with a header. See ParseCSV.
+	FormatCSV Format = "csv"
+	// FormatJSON is an object with a "players" array. See ParseJSON.
+	FormatJSON Format = "json"
+)
+
+// CSV columns. Any other column must be named attr:<name> and carries that
+// character attribute.
+const (
+	columnUsername     = "username"
+	columnEmail        = "email"
+	columnPasswordHash = "password_hash"
+	columnCharacter    = "character"
+	columnDescription  = "description"
+	attrColumnPrefix   = "attr:"
+)
+
+// Player is a legacy account to import.
+type Player struct {
+	Username     string      `json:"username"`
+	Email        string      `json:"email,omitempty"`
+	PasswordHash string      `json:"password_hash"`
+	Characters   []Character `json:"characters,omitempty"`
+
+	// Line is where the record starts in the file: the CSV row or the
+	// 1-based index in the JSON players array. It is set by Parse and used
+	// in problem reports.
+	Line int `json:"-"`
+}
+
+// Character is a legacy character owned by a Player.
+type Character struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+}
+
+// Problem is a record Validate rejected.
+type Problem struct {
+	Line      int
+	Username  string
+	Character string
+	Message   string
+}
+
+func (p Problem) String() string {
+	where := fmt.Sprintf("line %d", p.Line)
+	if p.Username != "" {
+		where += " (" + p.Username
+		if p.Character != "" {
+			where += "/" + p.Character
+		}
+		where += ")"
+	}
+	return where + ": " + p.Message
+}
+
+// Parse reads an import file in format.
+func Parse(r io.Reader, format Format) ([]*Player, error) {
+	switch format {
+	case FormatCSV:
+		return ParseCSV(r)
+	case FormatJSON:
+		return ParseJSON(r)
+	}
+	return nil, oops.Code("IMPORT_FORMAT_INVALID").
+		With("format", string(format)).
+		Errorf("unknown import format %q: must be csv or json", format)
+}
+
+// ParseCSV reads one character per row under a header naming the columns
+// username, password_hash, and optionally email, character, description,
+// and attr:<name> for each attribute. Rows for the same username form one
+// player; its email and password hash may be repeated or left blank after
+// the first row. A row with no character imports the account alone.
+func ParseCSV(r io.Reader) ([]*Player, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 0
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, oops.Code("IMPORT_PARSE_FAILED").Errorf("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, oops.Code("IMPORT_PARSE_FAILED").Wrap(err)
+	}
+	columns, err := csvColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var players []*Player
+	byName := map[string]*Player{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, oops.Code("IMPORT_PARSE_FAILED").Wrap(err)
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		username := field(columnUsername)
+		key := strings.ToLower(username)
+		p, seen := byName[key]
+		if !seen || username == "" {
+			p = &Player{Username: username, Line: line}
+			players = append(players, p)
+			byName[key] = p
+		}
+		if err := mergeCSVAccount(p, field(columnEmail), field(columnPasswordHash), line); err != nil {
+			return nil, err
+		}
+
+		name := field(columnCharacter)
+		if name == "" {
+			continue
+		}
+		c := Character{Name: name, Description: field(columnDescription)}
+		for col, i := range columns {
+			attr, ok := strings.CutPrefix(col, attrColumnPrefix)
+			if !ok || strings.TrimSpace(row[i]) == "" {
+				continue
+			}
+			if c.Attributes == nil {
+				c.Attributes = map[string]string{}
+			}
+			c.Attributes[attr] = strings.TrimSpace(row[i])
+		}
+		p.Characters = append(p.Characters, c)
+	}
+	return players, nil
+}
+
+func csvColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		switch {
+		case name == columnUsername, name == columnEmail, name == columnPasswordHash,
+			name == columnCharacter, name == columnDescription:
+		case strings.HasPrefix(name, attrColumnPrefix) && len(name) > len(attrColumnPrefix):
+		default:
+			return nil, oops.Code("IMPORT_PARSE_FAILED").
+				With("column", name).
+				Errorf("unknown CSV column %q: attribute columns are named attr:<name>", name)
+		}
+		if _, dup := columns[name]; dup {
+			return nil, oops.Code("IMPORT_PARSE_FAILED").With("column", name).Errorf("CSV column %q appears twice", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{columnUsername, columnPasswordHash} {
+		if _, ok := columns[required]; !ok {
+			return nil, oops.Code("IMPORT_PARSE_FAILED").
+				With("column", required).
+				Errorf("the CSV header has no %s column", required)
+		}
+	}
+	return columns, nil
+}
+
+// mergeCSVAccount fills in p's email and hash from a row, refusing a row
+// that disagrees with an earlier one for the same username.
+func mergeCSVAccount(p *Player, email, hash string, line int) error {
+	for _, f := range []struct {
+		name      string
+		dst       *string
+		val       string
+		sensitive bool
+	}{
+		{columnEmail, &p.Email, email, false},
+		{columnPasswordHash, &p.PasswordHash, hash, true},
+	} {
+		switch {
+		case f.val == "", *f.dst == f.val:
+		case *f.dst == "":
+			*f.dst = f.val
+		default:
+			err := oops.Code("IMPORT_PARSE_FAILED").With("line", line).With("username", p.Username)
+			if !f.sensitive {
+				err = err.With("value", f.val)
+			}
+			return err.Errorf("line %d: %s for %s differs from line %d", line, f.name, p.Username, p.Line)
+		}
+	}
+	return nil
+}
+
+// jsonFile is the shape of a JSON import file.
+type jsonFile struct {
+	Players []*Player `json:"players"`
+}
+
+// ParseJSON reads an object whose "players" array holds Player records.
+// Unknown fields are rejected so a misspelled key is not silently dropped.
+func ParseJSON(r io.Reader) ([]*Player, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, oops.Code("IMPORT_PARSE_FAILED").Wrap(err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var f jsonFile
+	if err := dec.Decode(&f); err != nil {
+		return nil, oops.Code("IMPORT_PARSE_FAILED").Wrap(err)
+	}
+	for i, p := range f.Players {
+		if p == nil {
+			return nil, oops.Code("IMPORT_PARSE_FAILED").With("index", i+1).Errorf("player %d is null", i+1)
+		}
+		p.Line = i + 1
+		p.Username = strings.TrimSpace(p.Username)
+		p.Email = strings.TrimSpace(p.Email)
+		p.PasswordHash = strings.TrimSpace(p.PasswordHash)
+		for j := range p.Characters {
+			p.Characters[j].Name = strings.TrimSpace(p.Characters[j].Name)
+			p.Characters[j].Description = strings.TrimSpace(p.Characters[j].Description)
+		}
+	}
+	return f.Players, nil
+}
+
+// Validate checks every record, normalizes character names and adapts
+// password hashes in place, and reports each record that cannot be
+// imported. Usernames, emails, and character names must also be unique
+// within the file, case-insensitively. Nothing is imported from a file
+// with problems.
+func Validate(players []*Player) []Problem {
+	var problems []Problem
+	report := func(p *Player, character, format string, args ...any) {
+		problems = append(problems, Problem{
+			Line: p.Line, Username: p.Username, Character: character,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	usernames := map[string]*Player{}
+	emails := map[string]*Player{}
+	characters := map[string]*Player{}
+
+	for _, p := range players {
+		if err := auth.ValidateUsername(p.Username); err != nil {
+			report(p, "", "invalid username: %s", err)
+		} else if first, dup := usernames[strings.ToLower(p.Username)]; dup {
+			report(p, "", "duplicate username; first seen on line %d", first.Line)
+		} else {
+			usernames[strings.ToLower(p.Username)] = p
+		}
+
+		if p.Email != "" {
+			if !validEmail(p.Email) {
+				report(p, "", "invalid email %q", p.Email)
+			} else if first, dup := emails[strings.ToLower(p.Email)]; dup {
+				report(p, "", "duplicate email %s; first seen on line %d", p.Email, first.Line)
+			} else {
+				emails[strings.ToLower(p.Email)] = p
+			}
+		}
+
+		if hash, err := AdaptHash(p.PasswordHash); err != nil {
+			report(p, "", "%s", err)
+		} else {
+			p.PasswordHash = hash
+		}
+
+		for i := range p.Characters {
+			c := &p.Characters[i]
+			c.Name = world.NormalizeCharacterName(c.Name)
+			if err := world.ValidateCharacterName(c.Name); err != nil {
+				report(p, c.Name, "invalid character name: %s", err)
+				continue
+			}
+			if first, dup := characters[strings.ToLower(c.Name)]; dup {
+				report(p, c.Name, "duplicate character name; first seen on line %d", first.Line)
+			} else {
+				characters[strings.ToLower(c.Name)] = p
+			}
+			if err := world.ValidateDescription(c.Description); err != nil {
+				report(p, c.Name, "invalid description: %s", err)
+			}
+			for _, name := range sortedKeys(c.Attributes) {
+				if err := world.ValidateName(name); err != nil {
+					report(p, c.Name, "invalid attribute name %q: %s", name, err)
+				}
+			}
+		}
+	}
+	return problems
+}
+
+func validEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// testBcryptHash is a bcrypt hash of "hunter2" at the minimum cost.
+var testBcryptHash = func() string {
+	h, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		panic(err)
+	}
+	return string(h)
+}()
+
+func TestParseCSVGroupsRowsByUsername(t *testing.T) {
+	in := "username,email,password_hash,character,description,attr:species,attr:faction\n" +
+		"bob,bob@example.com," + testBcryptHash + ",Bob,A tall man.,human,\n" +
+		"Bob,,,Roberta,,elf,Guild\n" +
+		"carol,," + testBcryptHash + ",,,,\n"
+
+	players, err := ParseCSV(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+
+	assert.Equal(t, "bob", players[0].Username)
+	assert.Equal(t, "bob@example.com", players[0].Email)
+	assert.Equal(t, testBcryptHash, players[0].PasswordHash)
+	assert.Equal(t, 2, players[0].Line)
+	assert.Equal(t, []Character{
+		{Name: "Bob", Description: "A tall man.", Attributes: map[string]string{"species": "human"}},
+		{Name: "Roberta", Attributes: map[string]string{"species": "elf", "faction": "Guild"}},
+	}, players[0].Characters)
+
+	assert.Equal(t, "carol", players[1].Username)
+	assert.Empty(t, players[1].Characters, "a row with no character imports the account alone")
+}
+
+func TestParseCSVRejectsBadInput(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"empty file", ""},
+		{"unknown column", "username,password_hash,level\n"},
+		{"bare attr prefix", "username,password_hash,attr:\n"},
+		{"missing password_hash", "username,email\n"},
+		{"repeated column", "username,password_hash,username\n"},
+		{"conflicting email", "username,email,password_hash\nbob,a@example.com,x\nbob,b@example.com,x\n"},
+		{"conflicting hash", "username,password_hash\nbob,x\nbob,y\n"},
+		{"ragged row", "username,password_hash\nbob\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.in))
+			errutil.AssertErrorCode(t, err, "IMPORT_PARSE_FAILED")
+		})
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	in := `{"players": [
+		{"username": " bob ", "email": "bob@example.com", "password_hash": "h",
+		 "characters": [{"name": "Bob", "description": "Tall.", "attributes": {"species": "human"}}]},
+		{"username": "carol", "password_hash": "h"}
+	]}`
+	players, err := ParseJSON(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+	assert.Equal(t, "bob", players[0].Username)
+	assert.Equal(t, 1, players[0].Line)
+	assert.Equal(t, 2, players[1].Line)
+	assert.Equal(t, map[string]string{"species": "human"}, players[0].Characters[0].Attributes)
+
+	_, err = ParseJSON(strings.NewReader(`{"players": [{"username": "bob", "pasword_hash": "h"}]}`))
+	errutil.AssertErrorCode(t, err, "IMPORT_PARSE_FAILED")
+	_, err = ParseJSON(strings.NewReader(`{"players": [null]}`))
+	errutil.AssertErrorCode(t, err, "IMPORT_PARSE_FAILED")
+}
+
+func TestParseRejectsUnknownFormat(t *testing.T) {
+	_, err := Parse(strings.NewReader(""), Format("xml"))
+	errutil.AssertErrorCode(t, err, "IMPORT_FORMAT_INVALID")
+}
+
+func TestValidateNormalizesAndAdaptsRecords(t *testing.T) {
+	players := []*Player{{
+		Username:     "bob",
+		PasswordHash: "$2y$" + strings.TrimPrefix(testBcryptHash, "$2a$"),
+		Characters:   []Character{{Name: "  bOB  the   tall "}},
+		Line:         2,
+	}}
+	assert.Empty(t, Validate(players))
+	assert.Equal(t, "Bob The Tall", players[0].Characters[0].Name)
+	assert.True(t, strings.HasPrefix(players[0].PasswordHash, "$2b$"))
+}
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	players := []*Player{
+		{Line: 2, Username: "bob", Email: "bob@example.com", PasswordHash: testBcryptHash,
+			Characters: []Character{{Name: "Bob"}}},
+		{Line: 3, Username: "BOB", Email: "BOB@example.com", PasswordHash: testBcryptHash,
+			Characters: []Character{{Name: "bob"}}},
+		{Line: 4, Username: "9lives", Email: "not an email", PasswordHash: "XXabcdef",
+			Characters: []Character{
+				{Name: "R2D2"},
+				{Name: "Carol", Description: "bad\x00desc", Attributes: map[string]string{"": "x"}},
+			}},
+	}
+	var got []string
+	for _, p := range Validate(players) {
+		got = append(got, p.String())
+	}
+	assert.Equal(t, []string{
+		"line 3 (BOB): duplicate username; first seen on line 2",
+		"line 3 (BOB): duplicate email BOB@example.com; first seen on line 2",
+		"line 3 (BOB/Bob): duplicate character name; first seen on line 2",
+		"line 4 (9lives): invalid username: username must start with a letter and contain only letters, numbers, and underscores",
+		`line 4 (9lives): invalid email "not an email"`,
+		"line 4 (9lives): unsupported password hash format (8 characters, no $scheme$ prefix): only bcrypt and argon2id hashes can be imported",
+		"line 4 (9lives/R2d2): invalid character name: name: must contain letters and spaces only",
+		"line 4 (9lives/Carol): invalid description: description: cannot contain control characters (except newline/tab)",
+		`line 4 (9lives/Carol): invalid attribute name "": name: cannot be empty`,
+	}, got)
+}
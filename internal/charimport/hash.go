@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/holomush/holomush/internal/auth"
+)
+
+// AdaptHash checks a legacy password hash and returns the form to store.
+// argon2id hashes in PHC form are stored as they are. bcrypt hashes are
+// stored with the $2y$ prefix of PHP and Perl exports rewritten to $2b$,
+// the same algorithm, and are replaced with argon2id the first time the
+// player logs in. Any other format cannot be verified and is rejected.
+func AdaptHash(hash string) (string, error) {
+	switch {
+	case hash == "":
+		return "", oops.Code("IMPORT_HASH_UNSUPPORTED").Errorf("password hash is missing")
+	case strings.HasPrefix(hash, "$argon2id$"):
+		if err := checkArgon2id(hash); err != nil {
+			return "", err
+		}
+		return hash, nil
+	case auth.IsBcryptHash(hash):
+		if strings.HasPrefix(hash, "$2y$") {
+			hash = "$2b$" + strings.TrimPrefix(hash, "$2y$")
+		}
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			return "", oops.Code("IMPORT_HASH_INVALID").Errorf("malformed bcrypt hash: %v", err)
+		}
+		return hash, nil
+	}
+	return "", oops.Code("IMPORT_HASH_UNSUPPORTED").
+		With("scheme", hashScheme(hash)).
+		Errorf("unsupported password hash format %s: only bcrypt and argon2id hashes can be imported", hashScheme(hash))
+}
+
+// checkArgon2id parses an argon2id hash the way auth.Argon2idHasher.Verify
+// does, without computing it.
+func checkArgon2id(hash string) error {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: expected 6 fields, got %d", len(parts))
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: bad version %q", parts[2])
+	}
+	var memory, iterations, threads uint32
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: bad parameters %q", parts[3])
+	}
+	if threads == 0 || threads > 255 || iterations == 0 {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: bad parameters %q", parts[3])
+	}
+	if _, err := base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: salt is not base64")
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return oops.Code("IMPORT_HASH_INVALID").Errorf("malformed argon2id hash: key is not base64")
+	}
+	return nil
+}
+
+// hashScheme names a hash's format for an error message without echoing
+// the hash itself.
+func hashScheme(hash string) string {
+	if scheme, _, ok := strings.Cut(strings.TrimPrefix(hash, "$"), "$"); ok && strings.HasPrefix(hash, "$") {
+		return "$" + scheme + "$"
+	}
+	return fmt.Sprintf("(%d characters, no $scheme$ prefix)", len(hash))
+}
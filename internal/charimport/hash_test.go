@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestAdaptHashKeepsVerifiableHashes(t *testing.T) {
+	hasher := auth.NewArgon2idHasher()
+	argon, err := hasher.Hash("hunter2")
+	require.NoError(t, err)
+	php := "$2y$" + strings.TrimPrefix(testBcryptHash, "$2a$")
+
+	for _, tt := range []struct {
+		name, in, want string
+	}{
+		{"argon2id", argon, argon},
+		{"bcrypt 2a", testBcryptHash, testBcryptHash},
+		{"bcrypt 2y", php, "$2b$" + strings.TrimPrefix(php, "$2y$")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AdaptHash(tt.in)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			ok, err := hasher.Verify("hunter2", got)
+			require.NoError(t, err)
+			assert.True(t, ok, "the stored hash still verifies the legacy password")
+		})
+	}
+}
+
+func TestAdaptHashRejectsOtherHashes(t *testing.T) {
+	for _, tt := range []struct {
+		name, in, code string
+	}{
+		{"missing", "", "IMPORT_HASH_UNSUPPORTED"},
+		{"pennmush sha1", "XX3f2e8d1e0c", "IMPORT_HASH_UNSUPPORTED"},
+		{"md5 crypt", "$1$saltsalt$abcdefghijklmnopqrstuv", "IMPORT_HASH_UNSUPPORTED"},
+		{"argon2i", "$argon2i$v=19$m=65536,t=1,p=4$c2FsdA$a2V5", "IMPORT_HASH_UNSUPPORTED"},
+		{"truncated bcrypt", "$2b$10$short", "IMPORT_HASH_INVALID"},
+		{"argon2id missing key", "$argon2id$v=19$m=65536,t=1,p=4$c2FsdA", "IMPORT_HASH_INVALID"},
+		{"argon2id bad params", "$argon2id$v=19$m=65536,t=1,p=0$c2FsdA$a2V5", "IMPORT_HASH_INVALID"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := AdaptHash(tt.in)
+			errutil.AssertErrorCode(t, err, tt.code)
+			if tt.in != "" {
+				assert.NotContains(t, err.Error(), tt.in, "errors never echo the hash")
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// DefaultSource labels an import when the operator does not name one.
+const DefaultSource = "legacy"
+
+// bindReason is recorded on the player-character binding of an imported
+// character, as for a character a player creates themselves.
+const bindReason = "initial_bind"
+
+// PlayerStore looks up and creates player accounts. This is the ISP
+// interface for the importer; *authpostgres.PlayerRepository satisfies it.
+type PlayerStore interface {
+	GetByUsername(ctx context.Context, username string) (*auth.Player, error)
+	GetByEmail(ctx context.Context, email string) (*auth.Player, error)
+	Create(ctx context.Context, player *auth.Player) error
+}
+
+// CharacterLookup finds existing characters. This is the ISP interface for
+// the importer; *setup.CharRepoAdapter satisfies it.
+type CharacterLookup interface {
+	ExistsByName(ctx context.Context, name string) (bool, error)
+	ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*world.Character, error)
+}
+
+// PropertyWriter sets a property on a world entity. This is the ISP
+// interface for the importer; *world.Service satisfies it.
+type PropertyWriter interface {
+	SetProperty(ctx context.Context, subjectID, parentType string, parentID ulid.ULID, name string, value *string) error
+}
+
+// Ledger records which player each imported legacy account became.
+type Ledger interface {
+	// Lookup returns the player imported for key from source, if any.
+	Lookup(ctx context.Context, source, key string) (ulid.ULID, bool, error)
+	// Record notes that key from source was imported as playerID. Recording
+	// a key again is a no-op.
+	Record(ctx context.Context, source, key string, playerID ulid.ULID, at time.Time) error
+}
+
+// Config holds the importer's dependencies.
+type Config struct {
+	Players    PlayerStore
+	Characters CharacterLookup
+	Genesis    auth.CharacterGenesis
+	Properties PropertyWriter
+	// Transactor opens the world transaction a character and its
+	// attributes are written in.
+	Transactor world.Transactor
+	Ledger     Ledger
+}
+
+// Options controls one import run.
+type Options struct {
+	// Source labels the game being imported, so accounts from two games
+	// with the same usernames are told apart. Defaults to DefaultSource.
+	Source string
+	// LocationID is where imported characters start. Nil leaves them
+	// nowhere until they first connect.
+	LocationID *ulid.ULID
+	// DryRun reports what the import would do without writing anything.
+	DryRun bool
+}
+
+// Action is what an import did with one player or character.
+type Action string
+
+// Import actions.
+const (
+	ActionCreated   Action = "created"
+	ActionUnchanged Action = "unchanged"
+	ActionSkipped   Action = "skipped"
+)
+
+// Outcome is what happened to one record. Character is empty for the
+// player's own outcome.
+type Outcome struct {
+	Username  string
+	Character string
+	Action    Action
+	// Reason explains a skip.
+	Reason string
+}
+
+// Tally counts outcomes by action.
+type Tally struct {
+	Created   int
+	Unchanged int
+	Skipped   int
+}
+
+func (t *Tally) add(a Action) {
+	switch a {
+	case ActionCreated:
+		t.Created++
+	case ActionUnchanged:
+		t.Unchanged++
+	case ActionSkipped:
+		t.Skipped++
+	}
+}
+
+// Report is the result of an import run.
+type Report struct {
+	Outcomes   []Outcome
+	Players    Tally
+	Characters Tally
+}
+
+func (r *Report) add(o Outcome) {
+	r.Outcomes = append(r.Outcomes, o)
+	if o.Character == "" {
+		r.Players.add(o.Action)
+	} else {
+		r.Characters.add(o.Action)
+	}
+}
+
+// Importer writes validated import records.
+type Importer struct {
+	players    PlayerStore
+	characters CharacterLookup
+	genesis    auth.CharacterGenesis
+	properties PropertyWriter
+	transactor world.Transactor
+	ledger     Ledger
+	now        func() time.Time
+}
+
+// NewImporter creates an Importer. Returns an error if any dependency is
+// nil.
+func NewImporter(cfg Config) (*Importer, error) {
+	switch {
+	case cfg.Players == nil:
+		return nil, oops.Errorf("player store is required")
+	case cfg.Characters == nil:
+		return nil, oops.Errorf("character lookup is required")
+	case cfg.Genesis == nil:
+		return nil, oops.Errorf("character genesis service is required")
+	case cfg.Properties == nil:
+		return nil, oops.Errorf("property writer is required")
+	case cfg.Transactor == nil:
+		return nil, oops.Errorf("transactor is required")
+	case cfg.Ledger == nil:
+		return nil, oops.Errorf("import ledger is required")
+	}
+	return &Importer{
+		players:    cfg.Players,
+		characters: cfg.Characters,
+		genesis:    cfg.Genesis,
+		properties: cfg.Properties,
+		transactor: cfg.Transactor,
+		ledger:     cfg.Ledger,
+		now:        time.Now,
+	}, nil
+}
+
+// Import writes players, which must have passed Validate. Each player
+// is matched first against the ledger, then by username: an existing
+// account with the same password hash is one an earlier run created before
+// it could record it, and is adopted; any other existing account is a
+// conflict. A character the player already owns is left as it is,
+// attributes included, and a character name held by anyone else is a
+// conflict. Conflicts are reported as skips and the run goes on; a storage
+// failure stops it, returning the report so far.
+//
+// A character and its attributes are written in one transaction. The
+// player account is written before its characters and is not rolled back
+// if they fail; the next run picks it up from the ledger.
+func (i *Importer) Import(ctx context.Context, players []*Player, opts Options) (*Report, error) {
+	source := strings.TrimSpace(opts.Source)
+	if source == "" {
+		source = DefaultSource
+	}
+	report := &Report{}
+	for _, p := range players {
+		playerID, outcome, err := i.importPlayer(ctx, source, p, opts.DryRun)
+		if err != nil {
+			return report, err
+		}
+		report.add(outcome)
+		for _, c := range p.Characters {
+			co := Outcome{Username: p.Username, Character: c.Name}
+			if outcome.Action == ActionSkipped {
+				co.Action, co.Reason = ActionSkipped, "its account was skipped"
+			} else if co.Action, co.Reason, err = i.importCharacter(ctx, playerID, c, opts); err != nil {
+				return report, err
+			}
+			report.add(co)
+		}
+	}
+	return report, nil
+}
+
+// importPlayer finds or creates the account for p. The returned ID is zero
+// for a player a dry run would create.
+func (i *Importer) importPlayer(ctx context.Context, source string, p *Player, dryRun bool) (ulid.ULID, Outcome, error) {
+	outcome := Outcome{Username: p.Username}
+	key := strings.ToLower(p.Username)
+
+	id, found, err := i.ledger.Lookup(ctx, source, key)
+	if err != nil {
+		return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+	}
+	if found {
+		outcome.Action = ActionUnchanged
+		return id, outcome, nil
+	}
+
+	existing, err := i.players.GetByUsername(ctx, p.Username)
+	switch {
+	case err == nil && existing.PasswordHash == p.PasswordHash:
+		if !dryRun {
+			if err := i.ledger.Record(ctx, source, key, existing.ID, i.now()); err != nil {
+				return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+			}
+		}
+		outcome.Action = ActionUnchanged
+		return existing.ID, outcome, nil
+	case err == nil:
+		outcome.Action, outcome.Reason = ActionSkipped, "an account with this username already exists"
+		return ulid.ULID{}, outcome, nil
+	case !errors.Is(err, auth.ErrNotFound):
+		return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+	}
+
+	var email *string
+	if p.Email != "" {
+		holder, err := i.players.GetByEmail(ctx, p.Email)
+		switch {
+		case err == nil:
+			outcome.Action, outcome.Reason = ActionSkipped, "email is already used by account "+holder.Username
+			return ulid.ULID{}, outcome, nil
+		case !errors.Is(err, auth.ErrNotFound):
+			return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+		}
+		email = &p.Email
+	}
+
+	outcome.Action = ActionCreated
+	if dryRun {
+		return ulid.ULID{}, outcome, nil
+	}
+	player, err := auth.NewPlayer(p.Username, email, p.PasswordHash)
+	if err != nil {
+		return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+	}
+	if err := i.players.Create(ctx, player); err != nil {
+		return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+	}
+	if err := i.ledger.Record(ctx, source, key, player.ID, i.now()); err != nil {
+		return ulid.ULID{}, outcome, oops.Code("IMPORT_FAILED").With("username", p.Username).Wrap(err)
+	}
+	return player.ID, outcome, nil
+}
+
+// importCharacter creates c for the player unless it already exists.
+func (i *Importer) importCharacter(ctx context.Context, playerID ulid.ULID, c Character, opts Options) (Action, string, error) {
+	if !playerID.IsZero() {
+		owned, err := i.characters.ListByPlayer(ctx, playerID)
+		if err != nil {
+			return "", "", oops.Code("IMPORT_FAILED").With("character", c.Name).Wrap(err)
+		}
+		for _, o := range owned {
+			if strings.EqualFold(o.Name, c.Name) {
+				return ActionUnchanged, "", nil
+			}
+		}
+	}
+	taken, err := i.characters.ExistsByName(ctx, c.Name)
+	if err != nil {
+		return "", "", oops.Code("IMPORT_FAILED").With("character", c.Name).Wrap(err)
+	}
+	if taken {
+		return ActionSkipped, "another player has a character with this name", nil
+	}
+	if opts.DryRun {
+		return ActionCreated, "", nil
+	}
+
+	char, err := world.NewCharacter(playerID, c.Name)
+	if err != nil {
+		return "", "", oops.Code("IMPORT_FAILED").With("character", c.Name).Wrap(err)
+	}
+	char.Description = c.Description
+	char.LocationID = opts.LocationID
+	err = i.transactor.InTransaction(ctx, func(txCtx context.Context) error {
+		if err := i.genesis.Create(txCtx, char, bindReason); err != nil {
+			return oops.Wrap(err)
+		}
+		// Attributes belong to the character they are set on, as they did
+		// on the legacy server, so its player can change them in game.
+		subject := access.CharacterSubject(char.ID.String())
+		for _, name := range sortedKeys(c.Attributes) {
+			value := c.Attributes[name]
+			if err := i.properties.SetProperty(txCtx, subject, "character", char.ID, name, &value); err != nil {
+				return oops.With("attribute", name).Wrap(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", "", oops.Code("IMPORT_FAILED").With("character", c.Name).Wrap(err)
+	}
+	return ActionCreated, "", nil
+}
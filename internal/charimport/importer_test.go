@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeWorld stands in for the player, character, property, and ledger
+// stores. A transaction that fails restores the characters and
+// properties it wrote.
+type fakeWorld struct {
+	players    []*auth.Player
+	characters []*world.Character
+	properties []fakeProperty
+	ledger     map[string]ulid.ULID
+
+	propertyErr error
+	bindReasons []string
+}
+
+type fakeProperty struct {
+	subject, parentType string
+	parentID            ulid.ULID
+	name, value         string
+}
+
+func newFakeWorld() *fakeWorld {
+	return &fakeWorld{ledger: map[string]ulid.ULID{}}
+}
+
+func (w *fakeWorld) GetByUsername(_ context.Context, username string) (*auth.Player, error) {
+	for _, p := range w.players {
+		if strings.EqualFold(p.Username, username) {
+			return p, nil
+		}
+	}
+	return nil, oops.Code("PLAYER_NOT_FOUND").Wrap(auth.ErrNotFound)
+}
+
+func (w *fakeWorld) GetByEmail(_ context.Context, email string) (*auth.Player, error) {
+	for _, p := range w.players {
+		if p.Email != nil && strings.EqualFold(*p.Email, email) {
+			return p, nil
+		}
+	}
+	return nil, oops.Code("PLAYER_NOT_FOUND").Wrap(auth.ErrNotFound)
+}
+
+func (w *fakeWorld) Create(_ context.Context, p *auth.Player) error {
+	w.players = append(w.players, p)
+	return nil
+}
+
+func (w *fakeWorld) ExistsByName(_ context.Context, name string) (bool, error) {
+	for _, c := range w.characters {
+		if strings.EqualFold(c.Name, name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (w *fakeWorld) ListByPlayer(_ context.Context, playerID ulid.ULID) ([]*world.Character, error) {
+	var out []*world.Character
+	for _, c := range w.characters {
+		if c.PlayerID == playerID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (w *fakeWorld) SetProperty(_ context.Context, subjectID, parentType string, parentID ulid.ULID, name string, value *string) error {
+	if w.propertyErr != nil {
+		return w.propertyErr
+	}
+	w.properties = append(w.properties, fakeProperty{subjectID, parentType, parentID, name, *value})
+	return nil
+}
+
+func (w *fakeWorld) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	chars, props := len(w.characters), len(w.properties)
+	if err := fn(ctx); err != nil {
+		w.characters, w.properties = w.characters[:chars], w.properties[:props]
+		return err
+	}
+	return nil
+}
+
+func (w *fakeWorld) Lookup(_ context.Context, source, key string) (ulid.ULID, bool, error) {
+	id, ok := w.ledger[source+"/"+key]
+	return id, ok, nil
+}
+
+func (w *fakeWorld) Record(_ context.Context, source, key string, playerID ulid.ULID, _ time.Time) error {
+	if _, ok := w.ledger[source+"/"+key]; !ok {
+		w.ledger[source+"/"+key] = playerID
+	}
+	return nil
+}
+
+// fakeGenesis writes characters into a fakeWorld.
+type fakeGenesis struct{ w *fakeWorld }
+
+func (g fakeGenesis) Create(_ context.Context, c *world.Character, bindReason string) error {
+	g.w.characters = append(g.w.characters, c)
+	g.w.bindReasons = append(g.w.bindReasons, bindReason)
+	return nil
+}
+
+func newTestImporter(t *testing.T, w *fakeWorld) *Importer {
+	t.Helper()
+	imp, err := NewImporter(Config{
+		Players:    w,
+		Characters: w,
+		Genesis:    fakeGenesis{w},
+		Properties: w,
+		Transactor: w,
+		Ledger:     w,
+	})
+	require.NoError(t, err)
+	return imp
+}
+
+func importFixture() []*Player {
+	return []*Player{{
+		Username:     "bob",
+		Email:        "bob@example.com",
+		PasswordHash: testBcryptHash,
+		Characters: []Character{
+			{Name: "Bob", Description: "A tall man.", Attributes: map[string]string{"species": "human", "faction": "Guild"}},
+			{Name: "Roberta"},
+		},
+	}}
+}
+
+func TestNewImporterRequiresDependencies(t *testing.T) {
+	_, err := NewImporter(Config{})
+	require.Error(t, err)
+}
+
+func TestImportCreatesPlayersAndCharacters(t *testing.T) {
+	w := newFakeWorld()
+	imp := newTestImporter(t, w)
+	start := idgen.New()
+
+	report, err := imp.Import(context.Background(), importFixture(), Options{Source: "pennmush", LocationID: &start})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Created: 1}, report.Players)
+	assert.Equal(t, Tally{Created: 2}, report.Characters)
+
+	require.Len(t, w.players, 1)
+	bob := w.players[0]
+	assert.Equal(t, "bob", bob.Username)
+	assert.Equal(t, testBcryptHash, bob.PasswordHash)
+	assert.Equal(t, "bob@example.com", *bob.Email)
+	assert.Equal(t, bob.ID, w.ledger["pennmush/bob"])
+
+	require.Len(t, w.characters, 2)
+	char := w.characters[0]
+	assert.Equal(t, bob.ID, char.PlayerID)
+	assert.Equal(t, "A tall man.", char.Description)
+	assert.Equal(t, &start, char.LocationID)
+	assert.Equal(t, []string{"initial_bind", "initial_bind"}, w.bindReasons)
+
+	subject := access.CharacterSubject(char.ID.String())
+	assert.Equal(t, []fakeProperty{
+		{subject, "character", char.ID, "faction", "Guild"},
+		{subject, "character", char.ID, "species", "human"},
+	}, w.properties)
+}
+
+func TestImportIsIdempotent(t *testing.T) {
+	w := newFakeWorld()
+	imp := newTestImporter(t, w)
+	_, err := imp.Import(context.Background(), importFixture(), Options{})
+	require.NoError(t, err)
+
+	// The player logs in and the hash is upgraded; the ledger still finds it.
+	w.players[0].PasswordHash = "$argon2id$upgraded"
+	report, err := imp.Import(context.Background(), importFixture(), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Unchanged: 1}, report.Players)
+	assert.Equal(t, Tally{Unchanged: 2}, report.Characters)
+	assert.Len(t, w.players, 1)
+	assert.Len(t, w.characters, 2)
+	assert.Len(t, w.properties, 2)
+}
+
+func TestImportAdoptsUnrecordedPlayerWithSameHash(t *testing.T) {
+	w := newFakeWorld()
+	bob, err := auth.NewPlayer("Bob", nil, testBcryptHash)
+	require.NoError(t, err)
+	w.players = append(w.players, bob)
+
+	report, err := newTestImporter(t, w).Import(context.Background(), importFixture(), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Unchanged: 1}, report.Players)
+	assert.Equal(t, Tally{Created: 2}, report.Characters)
+	assert.Equal(t, bob.ID, w.ledger[DefaultSource+"/bob"])
+	assert.Len(t, w.players, 1)
+}
+
+func TestImportSkipsConflicts(t *testing.T) {
+	w := newFakeWorld()
+	other, err := auth.NewPlayer("someone", nil, "$argon2id$other")
+	require.NoError(t, err)
+	w.players = append(w.players, other)
+	w.characters = append(w.characters, &world.Character{ID: idgen.New(), PlayerID: other.ID, Name: "Roberta"})
+
+	players := importFixture()
+	players = append(players,
+		&Player{Username: "someone", PasswordHash: testBcryptHash, Characters: []Character{{Name: "Sam"}}},
+		&Player{Username: "carol", Email: "BOB@example.com", PasswordHash: testBcryptHash})
+
+	report, err := newTestImporter(t, w).Import(context.Background(), players, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Created: 1, Skipped: 2}, report.Players)
+	assert.Equal(t, Tally{Created: 1, Skipped: 2}, report.Characters)
+
+	var skipped []string
+	for _, o := range report.Outcomes {
+		if o.Action == ActionSkipped {
+			skipped = append(skipped, o.Username+"/"+o.Character+": "+o.Reason)
+		}
+	}
+	assert.Equal(t, []string{
+		"bob/Roberta: another player has a character with this name",
+		"someone/: an account with this username already exists",
+		"someone/Sam: its account was skipped",
+		"carol/: email is already used by account bob",
+	}, skipped)
+}
+
+func TestImportDryRunWritesNothing(t *testing.T) {
+	w := newFakeWorld()
+	report, err := newTestImporter(t, w).Import(context.Background(), importFixture(), Options{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Created: 1}, report.Players)
+	assert.Equal(t, Tally{Created: 2}, report.Characters)
+	assert.Empty(t, w.players)
+	assert.Empty(t, w.characters)
+	assert.Empty(t, w.ledger)
+}
+
+func TestImportRollsBackCharacterWhenAttributeFails(t *testing.T) {
+	w := newFakeWorld()
+	w.propertyErr = errors.New("disk full")
+
+	report, err := newTestImporter(t, w).Import(context.Background(), importFixture(), Options{})
+	errutil.AssertErrorCode(t, err, "IMPORT_FAILED")
+	assert.Equal(t, Tally{Created: 1}, report.Players)
+	assert.Empty(t, w.characters, "the character is not kept without its attributes")
+
+	// The account survives and the next run finishes the job.
+	w.propertyErr = nil
+	report, err = newTestImporter(t, w).Import(context.Background(), importFixture(), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Tally{Unchanged: 1}, report.Players)
+	assert.Equal(t, Tally{Created: 2}, report.Characters)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package charimport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// PostgresLedger implements Ledger against the legacy_imports table.
+type PostgresLedger struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresLedger returns a PostgresLedger backed by pool.
+func NewPostgresLedger(pool *pgxpool.Pool) *PostgresLedger {
+	return &PostgresLedger{pool: pool}
+}
+
+// Lookup returns the player imported for key from source, if any.
+func (l *PostgresLedger) Lookup(ctx context.Context, source, key string) (ulid.ULID, bool, error) {
+	var id string
+	err := l.pool.QueryRow(ctx, `
+		SELECT player_id FROM legacy_imports WHERE source = $1 AND legacy_key = $2
+	`, source, key).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ulid.ULID{}, false, nil
+	}
+	if err != nil {
+		return ulid.ULID{}, false, oops.Code("IMPORT_LEDGER_FAILED").
+			With("operation", "lookup").
+			With("source", source).
+			With("legacy_key", key).
+			Wrap(err)
+	}
+	playerID, err := ulid.Parse(id)
+	if err != nil {
+		return ulid.ULID{}, false, oops.Code("IMPORT_LEDGER_FAILED").
+			With("operation", "parse player id").
+			With("player_id", id).
+			Wrap(err)
+	}
+	return playerID, true, nil
+}
+
+// Record notes that key from source was imported as playerID. Recording a
+// key again keeps the first player.
+func (l *PostgresLedger) Record(ctx context.Context, source, key string, playerID ulid.ULID, at time.Time) error {
+	if _, err := l.pool.Exec(ctx, `
+		INSERT INTO legacy_imports (source, legacy_key, player_id, imported_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (source, legacy_key) DO NOTHING
+	`, source, key, playerID.String(), pgnanos.From(at)); err != nil {
+		return oops.Code("IMPORT_LEDGER_FAILED").
+			With("operation", "record").
+			With("source", source).
+			With("legacy_key", key).
+			Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package charimport_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	authpostgres "github.com/holomush/holomush/internal/auth/postgres"
+	"github.com/holomush/holomush/internal/charimport"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestPostgresLedger(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	ledger := charimport.NewPostgresLedger(pool)
+	players := authpostgres.NewPlayerRepository(pool)
+
+	first, err := auth.NewPlayer("ledger_"+idgen.New().String()[20:], nil, "$2b$10$abcdefghijklmnopqrstuu")
+	require.NoError(t, err)
+	require.NoError(t, players.Create(ctx, first))
+	second, err := auth.NewPlayer("ledger_"+idgen.New().String()[20:], nil, "$2b$10$abcdefghijklmnopqrstuu")
+	require.NoError(t, err)
+	require.NoError(t, players.Create(ctx, second))
+
+	_, found, err := ledger.Lookup(ctx, "pennmush", "bob")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, ledger.Record(ctx, "pennmush", "bob", first.ID, time.Now()))
+	require.NoError(t, ledger.Record(ctx, "pennmush", "bob", second.ID, time.Now()))
+	id, found, err := ledger.Lookup(ctx, "pennmush", "bob")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, first.ID, id, "recording a key again keeps the first player")
+
+	_, found, err = ledger.Lookup(ctx, "tinymux", "bob")
+	require.NoError(t, err)
+	assert.False(t, found, "keys are per source")
+
+	_, err = pool.Exec(ctx, `DELETE FROM players WHERE id = $1`, first.ID.String())
+	require.NoError(t, err)
+	_, found, err = ledger.Lookup(ctx, "pennmush", "bob")
+	require.NoError(t, err)
+	assert.False(t, found, "deleting the player forgets its import")
+}
@@ -61,7 +61,11 @@ func CheckCommandExecution(ctx context.Context, engine types.AccessPolicyEngine,
 			"subject", subject,
 			"reason", decision.Reason(),
 			"policy_id", decision.PolicyID())
-		return ErrPermissionDenied(cmdName, "execute")
+		// The decision rides along in context for verbose error output;
+		// the code stays PERMISSION_DENIED.
+		return oops.With("reason", decision.Reason()).
+			With("policy_id", decision.PolicyID()).
+			Wrap(ErrPermissionDenied(cmdName, "execute"))
 	}
 	return nil
 }
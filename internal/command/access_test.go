@@ -96,6 +96,16 @@ func TestCheckCommandExecutionInvalidRequest(t *testing.T) {
 	assert.ErrorIs(t, err, command.ErrCapabilityCheckFailed)
 }
 
+func TestCheckCommandExecutionDenialCarriesDecision(t *testing.T) {
+	err := command.CheckCommandExecution(context.Background(), policytest.NewGrantEngine(), "character:01ABC", "boot")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	oopsErr, ok := oops.AsOops(err)
+	require.True(t, ok)
+	assert.Equal(t, "test-no-grant", oopsErr.Context()["reason"])
+	assert.Contains(t, oopsErr.Context(), "policy_id")
+}
+
 // --- CheckCapabilityPreFlight tests ---
 
 func TestCheckCapabilityPreFlight(t *testing.T) {
@@ -35,6 +35,18 @@ type PluginCommandDeliverer interface {
 	EmitPluginEvent(ctx context.Context, pluginName string, event pluginsdk.EmitEvent) error
 }
 
+// VerbFallback handles input whose first word names no registered command by
+// treating it as a verb on an object, e.g. "push button". TryVerb reports
+// whether it handled the input; when it did not, the dispatcher answers
+// with ErrUnknownCommand as usual.
+type VerbFallback interface {
+	TryVerb(ctx context.Context, exec *CommandExecution, verb, args string) (bool, error)
+}
+
+// verbFallbackSource labels object-verb invocations in command metrics and
+// spans.
+const verbFallbackSource = "object_verb"
+
 // Dispatcher handles command parsing, capability checks, and execution.
 type Dispatcher struct {
 	registry        *Registry
@@ -45,6 +57,7 @@ type Dispatcher struct {
 	focusReader     FocusReader            // optional, can be nil; enables focus-redirect
 	focusRedirects  FocusRedirectTable     // optional, can be nil; verb→kind→target
 	auditLogger     *audit.Logger          // optional, can be nil; when nil, plugin-audit flush is skipped
+	verbFallback    VerbFallback           // optional, can be nil; when nil, unknown commands are never object verbs
 	optErr          error                  // error from applying options
 }
 
@@ -84,6 +97,15 @@ func WithFocusRedirects(t FocusRedirectTable) DispatcherOption {
 	}
 }
 
+// WithVerbFallback configures the dispatcher to offer input that names no
+// registered command to fb as an object verb. If not provided, such input
+// is always an unknown command.
+func WithVerbFallback(fb VerbFallback) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.verbFallback = fb
+	}
+}
+
 // WithAuditLogger configures the dispatcher to flush plugin-emitted audit
 // events through the given audit logger. If not provided, plugin audit
 // events are silently dropped — useful for tests that do not care about
@@ -267,6 +289,23 @@ func (d *Dispatcher) Dispatch(ctx context.Context, input string, exec *CommandEx
 
 	// Look up command
 	entry, ok := d.registry.Get(parsed.Name)
+	// An unknown command may be an object verb ("push button"). Verbs are not
+	// commands, so the execute and capability layers below do not apply; the
+	// world service authorizes each trigger with the trigger_verb action on
+	// the object instead. Rate limiting above already counted the input.
+	if !ok && d.verbFallback != nil {
+		handled, verbErr := d.verbFallback.TryVerb(ctx, exec, parsed.Name, parsed.Args)
+		if handled {
+			metrics.SetCommandSource(verbFallbackSource)
+			span.SetAttributes(attribute.String("command.source", verbFallbackSource))
+			if verbErr != nil {
+				metrics.SetStatus(StatusError)
+				return verbErr
+			}
+			metrics.SetStatus(StatusSuccess)
+			return nil
+		}
+	}
 	if !ok {
 		metrics.SetStatus(StatusNotFound)
 		err = ErrUnknownCommand(parsed.Name)
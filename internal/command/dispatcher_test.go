@@ -144,6 +144,74 @@ func TestDispatcherUnknownCommand(t *testing.T) {
 	assert.Equal(t, CodeUnknownCommand, oopsErr.Code())
 }
 
+type stubVerbFallback struct {
+	handled bool
+	err     error
+	verbs   []string
+	args    []string
+}
+
+func (f *stubVerbFallback) TryVerb(_ context.Context, _ *CommandExecution, verb, args string) (bool, error) {
+	f.verbs = append(f.verbs, verb)
+	f.args = append(f.args, args)
+	return f.handled, f.err
+}
+
+func TestDispatcherVerbFallback(t *testing.T) {
+	newExec := func() *CommandExecution {
+		return NewTestExecution(CommandExecutionConfig{
+			CharacterID: ulid.Make(),
+			Output:      &bytes.Buffer{},
+			Services:    stubServices(),
+		})
+	}
+
+	t.Run("handled verb bypasses unknown command", func(t *testing.T) {
+		fb := &stubVerbFallback{handled: true}
+		// A grant engine with no grants: verbs skip the command layers.
+		dispatcher, err := NewDispatcher(NewRegistry(), policytest.NewGrantEngine(), WithVerbFallback(fb))
+		require.NoError(t, err)
+
+		require.NoError(t, dispatcher.Dispatch(context.Background(), "push brass button twice", newExec()))
+		assert.Equal(t, []string{"push"}, fb.verbs)
+		assert.Equal(t, []string{"brass button twice"}, fb.args)
+	})
+
+	t.Run("handled verb error is returned", func(t *testing.T) {
+		fb := &stubVerbFallback{handled: true, err: WorldError("Which one?", nil)}
+		dispatcher, err := NewDispatcher(NewRegistry(), policytest.AllowAllEngine(), WithVerbFallback(fb))
+		require.NoError(t, err)
+
+		dispErr := dispatcher.Dispatch(context.Background(), "push button", newExec())
+		errutil.AssertErrorCode(t, dispErr, CodeWorldError)
+	})
+
+	t.Run("unhandled input is an unknown command", func(t *testing.T) {
+		fb := &stubVerbFallback{}
+		dispatcher, err := NewDispatcher(NewRegistry(), policytest.AllowAllEngine(), WithVerbFallback(fb))
+		require.NoError(t, err)
+
+		dispErr := dispatcher.Dispatch(context.Background(), "push button", newExec())
+		errutil.AssertErrorCode(t, dispErr, CodeUnknownCommand)
+		assert.Len(t, fb.verbs, 1)
+	})
+
+	t.Run("registered commands never reach the fallback", func(t *testing.T) {
+		reg := NewRegistry()
+		require.NoError(t, reg.Register(CommandEntry{
+			Name:    "push",
+			handler: func(_ context.Context, _ *CommandExecution) error { return nil },
+			Source:  "test",
+		}))
+		fb := &stubVerbFallback{handled: true}
+		dispatcher, err := NewDispatcher(reg, policytest.AllowAllEngine(), WithVerbFallback(fb))
+		require.NoError(t, err)
+
+		require.NoError(t, dispatcher.Dispatch(context.Background(), "push button", newExec()))
+		assert.Empty(t, fb.verbs)
+	})
+}
+
 func TestDispatcherPermissionDenied(t *testing.T) {
 	reg := NewRegistry()
 	mockAccess := policytest.NewGrantEngine()
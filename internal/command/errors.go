@@ -174,22 +174,28 @@ func ErrNilServices() error {
 // evaluation failure codes. Using an allowlist instead of suffix matching
 // ensures unknown codes fall through to the default warning log.
 var entityAccessEvalFailedCodes = map[string]struct{}{
-	"LOCATION_ACCESS_EVALUATION_FAILED":  {},
-	"EXIT_ACCESS_EVALUATION_FAILED":      {},
-	"OBJECT_ACCESS_EVALUATION_FAILED":    {},
-	"CHARACTER_ACCESS_EVALUATION_FAILED": {},
-	"SCENE_ACCESS_EVALUATION_FAILED":     {},
-	"PROPERTY_ACCESS_EVALUATION_FAILED":  {},
+	"LOCATION_ACCESS_EVALUATION_FAILED":        {},
+	"EXIT_ACCESS_EVALUATION_FAILED":            {},
+	"OBJECT_ACCESS_EVALUATION_FAILED":          {},
+	"CHARACTER_ACCESS_EVALUATION_FAILED":       {},
+	"SCENE_ACCESS_EVALUATION_FAILED":           {},
+	"PROPERTY_ACCESS_EVALUATION_FAILED":        {},
+	"ZONE_ACCESS_EVALUATION_FAILED":            {},
+	"TAG_ACCESS_EVALUATION_FAILED":             {},
+	"PROPERTY_SCHEMA_ACCESS_EVALUATION_FAILED": {},
 }
 
 // entityAccessDeniedCodes is the explicit set of entity-scoped access denied codes.
 var entityAccessDeniedCodes = map[string]struct{}{
-	"LOCATION_ACCESS_DENIED":  {},
-	"EXIT_ACCESS_DENIED":      {},
-	"OBJECT_ACCESS_DENIED":    {},
-	"CHARACTER_ACCESS_DENIED": {},
-	"SCENE_ACCESS_DENIED":     {},
-	"PROPERTY_ACCESS_DENIED":  {},
+	"LOCATION_ACCESS_DENIED":        {},
+	"EXIT_ACCESS_DENIED":            {},
+	"OBJECT_ACCESS_DENIED":          {},
+	"CHARACTER_ACCESS_DENIED":       {},
+	"SCENE_ACCESS_DENIED":           {},
+	"PROPERTY_ACCESS_DENIED":        {},
+	"ZONE_ACCESS_DENIED":            {},
+	"TAG_ACCESS_DENIED":             {},
+	"PROPERTY_SCHEMA_ACCESS_DENIED": {},
 }
 
 // PlayerMessage extracts a player-facing message from an error.
@@ -0,0 +1,422 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	wearCommandName       = "wear"
+	wearUsage             = "wear [<object>] | desc <object> = <text>"
+	wearDescUsage         = "wear desc <object> = <text>"
+	removeCommandName     = "remove"
+	removeUsage           = "remove <object>"
+	effectCommandName     = "effect"
+	effectSetUsage        = "effect set <character>/<effect> [<duration>] [private] = <text>"
+	effectRemoveUsage     = "effect remove <character>/<effect>"
+	appearanceCommandName = "appearance"
+)
+
+// AppearanceAdmin reads and changes the layers of a character's description:
+// the objects they wear and the effects on them. This is the ISP interface
+// for the wear, remove, effect, and appearance commands; *world.Service
+// satisfies it.
+type AppearanceAdmin interface {
+	GetObjectsHeldBy(ctx context.Context, subjectID string, characterID ulid.ULID) ([]*world.Object, error)
+	GetObjectsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Object, error)
+	UpdateObject(ctx context.Context, subjectID string, obj *world.Object) error
+	WearObject(ctx context.Context, subjectID string, id ulid.ULID) error
+	TakeOffObject(ctx context.Context, subjectID string, id ulid.ULID) error
+	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error)
+	VisibleCharacters(ctx context.Context, observerID ulid.ULID, chars []*world.Character) []*world.Character
+	ApplyDescriptionEffect(ctx context.Context, subjectID string, characterID ulid.ULID, effect world.DescriptionEffect) error
+	RemoveDescriptionEffect(ctx context.Context, subjectID string, characterID ulid.ULID, name string) error
+	DescribeCharacter(ctx context.Context, subjectID string, characterID ulid.ULID) (*world.CharacterDescription, error)
+}
+
+// NewWearHandler creates a command handler that lists what the caller is
+// wearing, puts on an object they hold, or sets an object's wear
+// description.
+func NewWearHandler(admin AppearanceAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleWear(ctx, exec, admin)
+	}
+}
+
+func handleWear(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	if sub, rest, _ := strings.Cut(args, " "); strings.EqualFold(sub, "desc") {
+		return handleWearDesc(ctx, exec, admin, subject, strings.TrimSpace(rest))
+	}
+
+	held, err := admin.GetObjectsHeldBy(ctx, subject, exec.CharacterID())
+	if err != nil {
+		return appearanceError(wearCommandName, err)
+	}
+	if args == "" {
+		writeOutput(ctx, exec, wearCommandName, wornListing(held))
+		return nil
+	}
+	obj, err := matchObjectName(held, args, "in your inventory")
+	if err != nil {
+		return err
+	}
+	if obj.Worn() {
+		writeOutputf(ctx, exec, wearCommandName, "You are already wearing %s.\n", obj.Name)
+		return nil
+	}
+	if err := admin.WearObject(ctx, subject, obj.ID); err != nil {
+		return appearanceError(wearCommandName, err)
+	}
+	writeOutputf(ctx, exec, wearCommandName, "You put on %s.\n", obj.Name)
+	return nil
+}
+
+// wornListing describes the objects in held that are being worn, in the
+// order they were put on.
+func wornListing(held []*world.Object) string {
+	worn := slices.DeleteFunc(slices.Clone(held), func(o *world.Object) bool { return !o.Worn() })
+	if len(worn) == 0 {
+		return "You are not wearing anything."
+	}
+	slices.SortFunc(worn, func(a, b *world.Object) int {
+		return cmp.Or(a.WornAt.Compare(b.WornAt), a.ID.Compare(b.ID))
+	})
+	var sb strings.Builder
+	sb.WriteString("You are wearing:")
+	for _, obj := range worn {
+		fmt.Fprintf(&sb, "\n  %s", obj.Name)
+		if obj.WearDescription == "" {
+			sb.WriteString(" (no wear description)")
+		}
+	}
+	return sb.String()
+}
+
+// handleWearDesc sets the wear description of an object the caller holds or
+// one in their location. An empty text clears it.
+func handleWearDesc(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin, subject, args string) error {
+	name, text, found := strings.Cut(args, "=")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(wearCommandName, wearDescUsage)
+	}
+	objs, err := admin.GetObjectsHeldBy(ctx, subject, exec.CharacterID())
+	if err != nil {
+		return appearanceError(wearCommandName, err)
+	}
+	if !exec.LocationID().IsZero() {
+		here, err := admin.GetObjectsByLocation(ctx, subject, exec.LocationID())
+		if err != nil {
+			return appearanceError(wearCommandName, err)
+		}
+		objs = append(objs, here...)
+	}
+	obj, err := matchObjectName(objs, name, "here or in your inventory")
+	if err != nil {
+		return err
+	}
+	obj.WearDescription = strings.TrimSpace(text)
+	if err := admin.UpdateObject(ctx, subject, obj); err != nil {
+		return appearanceError(wearCommandName, err)
+	}
+	if obj.WearDescription == "" {
+		writeOutputf(ctx, exec, wearCommandName, "Cleared the wear description of %s.\n", obj.Name)
+		return nil
+	}
+	writeOutputf(ctx, exec, wearCommandName, "Set the wear description of %s.\n", obj.Name)
+	return nil
+}
+
+// NewRemoveHandler creates a command handler that takes off an object the
+// caller is wearing.
+func NewRemoveHandler(admin AppearanceAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleRemove(ctx, exec, admin)
+	}
+}
+
+func handleRemove(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin) error {
+	name := strings.TrimSpace(exec.Args)
+	if name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(removeCommandName, removeUsage)
+	}
+	subject := access.CharacterSubject(exec.CharacterID().String())
+	held, err := admin.GetObjectsHeldBy(ctx, subject, exec.CharacterID())
+	if err != nil {
+		return appearanceError(removeCommandName, err)
+	}
+	worn := slices.DeleteFunc(slices.Clone(held), func(o *world.Object) bool { return !o.Worn() })
+	obj, err := matchObjectName(worn, name, "among what you are wearing")
+	if err != nil {
+		return err
+	}
+	if err := admin.TakeOffObject(ctx, subject, obj.ID); err != nil {
+		return appearanceError(removeCommandName, err)
+	}
+	writeOutputf(ctx, exec, removeCommandName, "You take off %s.\n", obj.Name)
+	return nil
+}
+
+// NewEffectHandler creates a command handler that lists, applies, and
+// removes the description effects on a character in the caller's location.
+func NewEffectHandler(admin AppearanceAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleEffect(ctx, exec, admin)
+	}
+}
+
+func handleEffect(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	sub, rest, _ := strings.Cut(args, " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "set":
+		return handleEffectSet(ctx, exec, admin, subject, rest)
+	case "remove":
+		return handleEffectRemove(ctx, exec, admin, subject, rest)
+	default:
+		return handleEffectList(ctx, exec, admin, subject, args)
+	}
+}
+
+func handleEffectList(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin, subject, name string) error {
+	char, err := findAppearanceCharacter(ctx, exec, admin, subject, name)
+	if err != nil {
+		return err
+	}
+	desc, err := admin.DescribeCharacter(ctx, subject, char.ID)
+	if err != nil {
+		return appearanceError(effectCommandName, err)
+	}
+	effects := desc.Effects()
+	if len(effects) == 0 {
+		writeOutputf(ctx, exec, effectCommandName, "%s has no effects.\n", desc.Name)
+		return nil
+	}
+	now := time.Now()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Effects on %s:", desc.Name)
+	for _, e := range effects {
+		fmt.Fprintf(&sb, "\n  %s - %s", e.Source, e.Text)
+		var notes []string
+		if e.Private {
+			notes = append(notes, "private")
+		}
+		if !e.ExpiresAt.IsZero() {
+			notes = append(notes, "expires in "+e.ExpiresAt.Sub(now).Round(time.Second).String())
+		}
+		if len(notes) > 0 {
+			fmt.Fprintf(&sb, " (%s)", strings.Join(notes, ", "))
+		}
+	}
+	writeOutput(ctx, exec, effectCommandName, sb.String())
+	return nil
+}
+
+func handleEffectSet(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin, subject, args string) error {
+	target, text, found := strings.Cut(args, "=")
+	charName, spec, ok := splitCharacterEffect(target)
+	if !found || !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(effectCommandName, effectSetUsage)
+	}
+	effect, err := parseEffectSpec(spec)
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(effectCommandName, effectSetUsage)
+	}
+	effect.Text = strings.TrimSpace(text)
+	char, err := findAppearanceCharacter(ctx, exec, admin, subject, charName)
+	if err != nil {
+		return err
+	}
+	if err := admin.ApplyDescriptionEffect(ctx, subject, char.ID, effect); err != nil {
+		return appearanceError(effectCommandName, err)
+	}
+	writeOutputf(ctx, exec, effectCommandName, "%s is now %s.\n", char.Name, effect.Name)
+	return nil
+}
+
+func handleEffectRemove(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin, subject, args string) error {
+	charName, name, ok := splitCharacterEffect(args)
+	if !ok || strings.ContainsRune(name, ' ') {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(effectCommandName, effectRemoveUsage)
+	}
+	char, err := findAppearanceCharacter(ctx, exec, admin, subject, charName)
+	if err != nil {
+		return err
+	}
+	if err := admin.RemoveDescriptionEffect(ctx, subject, char.ID, name); err != nil {
+		return appearanceError(effectCommandName, err)
+	}
+	writeOutputf(ctx, exec, effectCommandName, "Removed %s from %s.\n", name, char.Name)
+	return nil
+}
+
+// splitCharacterEffect splits "<character>/<effect...>" on its first slash.
+func splitCharacterEffect(s string) (charName, effect string, ok bool) {
+	charName, effect, found := strings.Cut(s, "/")
+	charName = strings.TrimSpace(charName)
+	effect = strings.ToLower(strings.TrimSpace(effect))
+	return charName, effect, found && charName != "" && effect != ""
+}
+
+// parseEffectSpec parses "<effect> [<duration>] [private]", where duration is
+// time.ParseDuration syntax such as "30m" or "2h".
+func parseEffectSpec(spec string) (world.DescriptionEffect, error) {
+	fields := strings.Fields(spec)
+	effect := world.DescriptionEffect{Name: fields[0]}
+	for _, f := range fields[1:] {
+		if f == "private" && !effect.Private {
+			effect.Private = true
+			continue
+		}
+		d, err := time.ParseDuration(f)
+		if err != nil || d <= 0 || !effect.ExpiresAt.IsZero() {
+			return world.DescriptionEffect{}, oops.Errorf("invalid effect option %q", f)
+		}
+		effect.ExpiresAt = time.Now().Add(d)
+	}
+	return effect, nil
+}
+
+// NewAppearanceHandler creates a command handler that shows a character's
+// layered description: their own, then what they wear, then the effects on
+// them.
+func NewAppearanceHandler(admin AppearanceAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleAppearance(ctx, exec, admin)
+	}
+}
+
+func handleAppearance(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin) error {
+	subject := access.CharacterSubject(exec.CharacterID().String())
+	char, err := findAppearanceCharacter(ctx, exec, admin, subject, strings.TrimSpace(exec.Args))
+	if err != nil {
+		return err
+	}
+	desc, err := admin.DescribeCharacter(ctx, subject, char.ID)
+	if err != nil {
+		return appearanceError(appearanceCommandName, err)
+	}
+	text := desc.Text()
+	if text == "" {
+		text = "You see nothing special."
+	}
+	writeOutputf(ctx, exec, appearanceCommandName, "%s\n%s\n", desc.Name, text)
+	return nil
+}
+
+// findAppearanceCharacter resolves name to the caller ("" or "me") or to a
+// character the caller can see in their location: an exact
+// (case-insensitive) name match wins, then a unique name prefix.
+func findAppearanceCharacter(ctx context.Context, exec *command.CommandExecution, admin AppearanceAdmin, subject, name string) (*world.Character, error) {
+	if name == "" || strings.EqualFold(name, "me") {
+		return &world.Character{ID: exec.CharacterID(), Name: exec.CharacterName()}, nil
+	}
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("You are not in a location.", nil)
+	}
+	chars, err := world.CollectPages(ctx, func(ctx context.Context, opts world.ListOptions) (world.Page[*world.Character], error) {
+		return admin.GetCharactersByLocation(ctx, subject, exec.LocationID(), opts)
+	})
+	if err != nil {
+		return nil, appearanceError(appearanceCommandName, err)
+	}
+	chars = admin.VisibleCharacters(ctx, exec.CharacterID(), chars)
+	lower := strings.ToLower(name)
+	var prefixed []*world.Character
+	for _, c := range chars {
+		charName := strings.ToLower(c.Name)
+		if charName == lower {
+			return c, nil
+		}
+		if strings.HasPrefix(charName, lower) {
+			prefixed = append(prefixed, c)
+		}
+	}
+	switch len(prefixed) {
+	case 0:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError(fmt.Sprintf("I don't see %q here.", name), nil)
+	case 1:
+		return prefixed[0], nil
+	default:
+		names := make([]string, len(prefixed))
+		for i, c := range prefixed {
+			names[i] = c.Name
+		}
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("Which one? I see: "+strings.Join(names, ", ")+".", nil)
+	}
+}
+
+// appearanceError surfaces the world service's wear and effect validation
+// and lookup failures to the player and maps policy denials to the
+// permission error; anything else falls through to the generic player
+// message. Like objectVerbError, the cause is not wrapped.
+func appearanceError(cmd string, err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "DESCRIPTION_EFFECT_INVALID", "OBJECT_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "DESCRIPTION_EFFECT_LIMIT":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("They already carry %d effects.", world.MaxDescriptionEffects), nil)
+	case "DESCRIPTION_EFFECT_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("They have no such effect.", nil)
+	case "OBJECT_NOT_HELD":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You must be holding that to wear it.", nil)
+	case "OBJECT_NOT_WORN":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You are not wearing that.", nil)
+	case "OBJECT_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That object is no longer here.", nil)
+	case world.CodeObjectLocked:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That object is locked by its owner.", nil)
+	case world.CodeConcurrentEdit:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That changed while you were editing it. Try again.", nil)
+	case "OBJECT_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(cmd, "object")
+	case "CHARACTER_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(cmd, "character")
+	case "LOCATION_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(cmd, "location")
+	}
+	return err
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubAppearanceAdmin is a test implementation of AppearanceAdmin.
+type stubAppearanceAdmin struct {
+	held       []*world.Object
+	here       []*world.Object
+	characters []*world.Character
+	hidden     map[ulid.ULID]bool
+	desc       *world.CharacterDescription
+
+	updated   []*world.Object
+	worn      []ulid.ULID
+	takenOff  []ulid.ULID
+	applied   []world.DescriptionEffect
+	appliedTo []ulid.ULID
+	removed   []string
+	described []ulid.ULID
+	err       error
+}
+
+func (s *stubAppearanceAdmin) GetObjectsHeldBy(_ context.Context, _ string, _ ulid.ULID) ([]*world.Object, error) {
+	return s.held, nil
+}
+
+func (s *stubAppearanceAdmin) GetObjectsByLocation(_ context.Context, _ string, _ ulid.ULID) ([]*world.Object, error) {
+	return s.here, nil
+}
+
+func (s *stubAppearanceAdmin) UpdateObject(_ context.Context, _ string, obj *world.Object) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.updated = append(s.updated, obj)
+	return nil
+}
+
+func (s *stubAppearanceAdmin) WearObject(_ context.Context, _ string, id ulid.ULID) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.worn = append(s.worn, id)
+	return nil
+}
+
+func (s *stubAppearanceAdmin) TakeOffObject(_ context.Context, _ string, id ulid.ULID) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.takenOff = append(s.takenOff, id)
+	return nil
+}
+
+func (s *stubAppearanceAdmin) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{Items: s.characters}, nil
+}
+
+func (s *stubAppearanceAdmin) VisibleCharacters(_ context.Context, _ ulid.ULID, chars []*world.Character) []*world.Character {
+	var visible []*world.Character
+	for _, c := range chars {
+		if !s.hidden[c.ID] {
+			visible = append(visible, c)
+		}
+	}
+	return visible
+}
+
+func (s *stubAppearanceAdmin) ApplyDescriptionEffect(_ context.Context, _ string, characterID ulid.ULID, effect world.DescriptionEffect) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.applied = append(s.applied, effect)
+	s.appliedTo = append(s.appliedTo, characterID)
+	return nil
+}
+
+func (s *stubAppearanceAdmin) RemoveDescriptionEffect(_ context.Context, _ string, _ ulid.ULID, name string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.removed = append(s.removed, name)
+	return nil
+}
+
+func (s *stubAppearanceAdmin) DescribeCharacter(_ context.Context, _ string, characterID ulid.ULID) (*world.CharacterDescription, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.described = append(s.described, characterID)
+	return s.desc, nil
+}
+
+var (
+	appearanceCharID     = ulid.Make()
+	appearanceLocationID = ulid.Make()
+)
+
+func heldObject(name string, wornAt time.Time) *world.Object {
+	obj, _ := world.NewObject(name, world.HeldBy(appearanceCharID))
+	obj.WornAt = wornAt
+	return obj
+}
+
+func runAppearanceCommand(t *testing.T, handler func(AppearanceAdmin) command.CommandHandler, admin AppearanceAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   appearanceCharID,
+		CharacterName: "Alice",
+		LocationID:    appearanceLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := handler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestWearListsWornObjects(t *testing.T) {
+	now := time.Now()
+	cloak := heldObject("Cloak", now)
+	cloak.WearDescription = "A grey cloak hangs from their shoulders."
+	admin := &stubAppearanceAdmin{held: []*world.Object{cloak, heldObject("Pack", time.Time{}), heldObject("Boots", now.Add(-time.Hour))}}
+
+	out, err := runAppearanceCommand(t, NewWearHandler, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "You are wearing:\n  Boots (no wear description)\n  Cloak\n", out)
+
+	out, err = runAppearanceCommand(t, NewWearHandler, &stubAppearanceAdmin{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "You are not wearing anything.\n", out)
+}
+
+func TestWearAndRemove(t *testing.T) {
+	pack := heldObject("Pack", time.Time{})
+	cloak := heldObject("Cloak", time.Now())
+	admin := &stubAppearanceAdmin{held: []*world.Object{pack, cloak}}
+
+	out, err := runAppearanceCommand(t, NewWearHandler, admin, "pa")
+	require.NoError(t, err)
+	assert.Equal(t, "You put on Pack.\n", out)
+	assert.Equal(t, []ulid.ULID{pack.ID}, admin.worn)
+
+	out, err = runAppearanceCommand(t, NewWearHandler, admin, "cloak")
+	require.NoError(t, err)
+	assert.Equal(t, "You are already wearing Cloak.\n", out)
+	assert.Len(t, admin.worn, 1)
+
+	out, err = runAppearanceCommand(t, NewRemoveHandler, admin, "cloak")
+	require.NoError(t, err)
+	assert.Equal(t, "You take off Cloak.\n", out)
+	assert.Equal(t, []ulid.ULID{cloak.ID}, admin.takenOff)
+
+	_, err = runAppearanceCommand(t, NewRemoveHandler, admin, "pack")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+
+	_, err = runAppearanceCommand(t, NewRemoveHandler, admin, "")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestWearDesc(t *testing.T) {
+	cloak := heldObject("Cloak", time.Time{})
+	statue, _ := world.NewObject("Statue", world.InLocation(appearanceLocationID))
+	admin := &stubAppearanceAdmin{held: []*world.Object{cloak}, here: []*world.Object{statue}}
+
+	out, err := runAppearanceCommand(t, NewWearHandler, admin, "desc cloak = A grey cloak hangs from their shoulders.")
+	require.NoError(t, err)
+	assert.Equal(t, "Set the wear description of Cloak.\n", out)
+	require.Len(t, admin.updated, 1)
+	assert.Equal(t, "A grey cloak hangs from their shoulders.", admin.updated[0].WearDescription)
+
+	out, err = runAppearanceCommand(t, NewWearHandler, admin, "desc statue =")
+	require.NoError(t, err)
+	assert.Equal(t, "Cleared the wear description of Statue.\n", out)
+
+	_, err = runAppearanceCommand(t, NewWearHandler, admin, "desc cloak")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestEffectSetAndRemove(t *testing.T) {
+	bob := &world.Character{ID: ulid.Make(), Name: "Bob"}
+	admin := &stubAppearanceAdmin{characters: []*world.Character{bob}}
+
+	out, err := runAppearanceCommand(t, NewEffectHandler, admin, "set bob/Glowing 1h private = A faint glow surrounds him.")
+	require.NoError(t, err)
+	assert.Equal(t, "Bob is now glowing.\n", out)
+	require.Len(t, admin.applied, 1)
+	effect := admin.applied[0]
+	assert.Equal(t, "glowing", effect.Name)
+	assert.Equal(t, "A faint glow surrounds him.", effect.Text)
+	assert.True(t, effect.Private)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), effect.ExpiresAt, time.Minute)
+	assert.Equal(t, bob.ID, admin.appliedTo[0])
+
+	_, err = runAppearanceCommand(t, NewEffectHandler, admin, "set me/soaked = Dripping wet.")
+	require.NoError(t, err)
+	assert.Equal(t, appearanceCharID, admin.appliedTo[1])
+	assert.True(t, admin.applied[1].ExpiresAt.IsZero())
+
+	out, err = runAppearanceCommand(t, NewEffectHandler, admin, "remove bob/glowing")
+	require.NoError(t, err)
+	assert.Equal(t, "Removed glowing from Bob.\n", out)
+	assert.Equal(t, []string{"glowing"}, admin.removed)
+
+	for _, args := range []string{"set bob = text", "set bob/glowing soon = text", "set bob/glowing 1h 2h = text", "remove bob", "remove bob/glowing now"} {
+		_, err = runAppearanceCommand(t, NewEffectHandler, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestEffectTargetsOnlyVisibleCharacters(t *testing.T) {
+	bob := &world.Character{ID: ulid.Make(), Name: "Bob"}
+	admin := &stubAppearanceAdmin{characters: []*world.Character{bob}, hidden: map[ulid.ULID]bool{bob.ID: true}}
+
+	_, err := runAppearanceCommand(t, NewEffectHandler, admin, "set bob/glowing = Glowing.")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Empty(t, admin.applied)
+}
+
+func TestEffectList(t *testing.T) {
+	admin := &stubAppearanceAdmin{desc: &world.CharacterDescription{
+		Name: "Alice",
+		Layers: []world.DescriptionLayer{
+			{Kind: world.LayerBase, Text: "A tall figure."},
+			{Kind: world.LayerEffect, Source: "scarred", Text: "A hidden scar.", Private: true},
+			{Kind: world.LayerEffect, Source: "soaked", Text: "Dripping wet.", ExpiresAt: time.Now().Add(time.Hour + 500*time.Millisecond)},
+		},
+	}}
+
+	out, err := runAppearanceCommand(t, NewEffectHandler, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Effects on Alice:\n  scarred - A hidden scar. (private)\n  soaked - Dripping wet. (expires in 1h0m0s)\n", out)
+	assert.Equal(t, []ulid.ULID{appearanceCharID}, admin.described)
+
+	admin.desc = &world.CharacterDescription{Name: "Alice"}
+	out, err = runAppearanceCommand(t, NewEffectHandler, admin, "me")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice has no effects.\n", out)
+}
+
+func TestAppearance(t *testing.T) {
+	bob := &world.Character{ID: ulid.Make(), Name: "Bob"}
+	admin := &stubAppearanceAdmin{
+		characters: []*world.Character{bob},
+		desc: &world.CharacterDescription{Name: "Bob", Layers: []world.DescriptionLayer{
+			{Kind: world.LayerBase, Text: "A stocky sailor."},
+			{Kind: world.LayerWorn, Source: "Cloak", Text: "A grey cloak hangs from his shoulders."},
+		}},
+	}
+
+	out, err := runAppearanceCommand(t, NewAppearanceHandler, admin, "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "Bob\nA stocky sailor.\nA grey cloak hangs from his shoulders.\n", out)
+	assert.Equal(t, []ulid.ULID{bob.ID}, admin.described)
+
+	admin.desc = &world.CharacterDescription{Name: "Alice"}
+	out, err = runAppearanceCommand(t, NewAppearanceHandler, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice\nYou see nothing special.\n", out)
+}
+
+func TestAppearanceErrors(t *testing.T) {
+	bob := &world.Character{ID: ulid.Make(), Name: "Bob"}
+	cloak := heldObject("Cloak", time.Time{})
+	admin := &stubAppearanceAdmin{characters: []*world.Character{bob}, held: []*world.Object{cloak}}
+
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"invalid effect", oops.Code("DESCRIPTION_EFFECT_INVALID").Wrap(&world.ValidationError{Field: "text", Message: "bad"}), command.CodeWorldError},
+		{"effect limit", oops.Code("DESCRIPTION_EFFECT_LIMIT").Errorf("too many"), command.CodeWorldError},
+		{"effect not found", oops.Code("DESCRIPTION_EFFECT_NOT_FOUND").Errorf("no effect"), command.CodeWorldError},
+		{"character denied", oops.Code("CHARACTER_ACCESS_DENIED").Wrap(world.ErrPermissionDenied), command.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin.err = tt.err
+			_, err := runAppearanceCommand(t, NewEffectHandler, admin, "set bob/glowing = Glowing.")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+
+	admin.err = oops.Code("OBJECT_NOT_HELD").Errorf("not held")
+	_, err := runAppearanceCommand(t, NewWearHandler, admin, "cloak")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+
+	admin.err = oops.Code(world.CodeObjectLocked).Wrap(world.ErrPermissionDenied)
+	_, err = runAppearanceCommand(t, NewWearHandler, admin, "desc cloak = Grey.")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+
+	admin.err = oops.Code("OBJECT_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	_, err = runAppearanceCommand(t, NewWearHandler, admin, "cloak")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestRegisterAdminAppearance(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("wear")
+	assert.False(t, found, "wear requires the Appearance dependency")
+
+	deps.Appearance = &stubAppearanceAdmin{}
+	RegisterAdmin(reg, deps)
+	for _, name := range []string{"wear", "remove", "effect", "appearance"} {
+		_, found = reg.Get(name)
+		assert.True(t, found, name)
+	}
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/bans"
+	"github.com/holomush/holomush/internal/command"
+)
+
+const (
+	banCommandName = "ban"
+	banUsage       = "ban list | add <ip|cidr|hostname|player> <pattern> [--for <duration>] = <reason> | remove <id>"
+	banAddUsage    = "ban add <ip|cidr|hostname|player> <pattern> [--for <duration>] = <reason>"
+)
+
+// BanAdmin manages the server ban list. This is the ISP interface for the
+// ban admin command; *bans.Service satisfies it.
+type BanAdmin interface {
+	Add(ctx context.Context, req bans.AddRequest) (*bans.Ban, error)
+	List(ctx context.Context) ([]*bans.Ban, error)
+	Remove(ctx context.Context, id ulid.ULID, removedBy string) (*bans.Ban, error)
+}
+
+// NewBanHandler creates a command handler that routes ban subcommands.
+func NewBanHandler(admin BanAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleBan(ctx, exec, admin)
+	}
+}
+
+func handleBan(ctx context.Context, exec *command.CommandExecution, admin BanAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "list":
+		return handleBanList(ctx, exec, admin)
+	case "add":
+		return handleBanAdd(ctx, exec, admin, rest)
+	case "remove":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(banCommandName, "ban remove <id>")
+		}
+		return handleBanRemove(ctx, exec, admin, rest)
+	default:
+		writeOutput(ctx, exec, banCommandName, "Usage: "+banUsage)
+		return nil
+	}
+}
+
+func handleBanList(ctx context.Context, exec *command.CommandExecution, admin BanAdmin) error {
+	active, err := admin.List(ctx)
+	if err != nil {
+		return banError(err)
+	}
+	if len(active) == 0 {
+		writeOutput(ctx, exec, banCommandName, "No active bans.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Active bans:")
+	for _, ban := range active {
+		expires := "never"
+		if ban.ExpiresAt != nil {
+			expires = formatScheduleTime(*ban.ExpiresAt)
+		}
+		fmt.Fprintf(&sb, "\n  %s  %-8s %-24s expires %s  by %s",
+			ban.ID, string(ban.Target), ban.Pattern, expires, ban.IssuedBy)
+		if ban.Reason != "" {
+			fmt.Fprintf(&sb, "\n    %s", ban.Reason)
+		}
+	}
+	writeOutput(ctx, exec, banCommandName, sb.String())
+	return nil
+}
+
+func handleBanAdd(ctx context.Context, exec *command.CommandExecution, admin BanAdmin, args string) error {
+	req, err := parseBanAdd(args)
+	if err != nil {
+		return err
+	}
+	req.IssuedBy = exec.CharacterName()
+
+	ban, err := admin.Add(ctx, req)
+	if err != nil {
+		return banError(err)
+	}
+	expires := "permanently"
+	if ban.ExpiresAt != nil {
+		expires = "until " + formatScheduleTime(*ban.ExpiresAt)
+	}
+	writeOutputf(ctx, exec, banCommandName, "Banned %s %s %s (id %s).\n",
+		string(ban.Target), ban.Pattern, expires, ban.ID)
+	return nil
+}
+
+// parseBanAdd parses "<target> <pattern> [--for <duration>] = <reason>".
+// The reason is optional but the = is not, so a stray word cannot be taken
+// for the pattern.
+func parseBanAdd(args string) (bans.AddRequest, error) {
+	head, reason, ok := strings.Cut(args, "=")
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return bans.AddRequest{}, command.ErrInvalidArgs(banCommandName, banAddUsage)
+	}
+
+	var req bans.AddRequest
+	fields := strings.Fields(head)
+	switch {
+	case len(fields) == 2:
+	case len(fields) == 4 && fields[2] == "--for":
+		duration, err := parseBanDuration(fields[3])
+		if err != nil {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return bans.AddRequest{}, command.ErrInvalidArgs(banCommandName, banAddUsage)
+		}
+		req.Duration = duration
+	default:
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return bans.AddRequest{}, command.ErrInvalidArgs(banCommandName, banAddUsage)
+	}
+	req.Target = bans.Target(strings.ToLower(fields[0]))
+	req.Pattern = fields[1]
+	req.Reason = strings.TrimSpace(reason)
+	return req, nil
+}
+
+// parseBanDuration accepts time.ParseDuration syntax plus a whole number of
+// days ("7d"), since bans are usually measured in days.
+func parseBanDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, oops.Errorf("invalid day count %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, oops.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+func handleBanRemove(ctx context.Context, exec *command.CommandExecution, admin BanAdmin, arg string) error {
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%q is not a ban ID; see ban list.", arg), nil)
+	}
+	ban, err := admin.Remove(ctx, id, exec.CharacterName())
+	if err != nil {
+		return banError(err)
+	}
+	writeOutputf(ctx, exec, banCommandName, "Removed ban on %s %s.\n", string(ban.Target), ban.Pattern)
+	return nil
+}
+
+// banError surfaces the ban list's validation and lookup failures to staff
+// verbatim; anything else falls through to the generic player message. The
+// cause is not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func banError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "BAN_INVALID", "BAN_EXISTS":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "BAN_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No such ban; see ban list.", nil)
+	}
+	return err
+}
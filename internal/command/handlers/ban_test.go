@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/bans"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubBanAdmin is a test implementation of BanAdmin.
+type stubBanAdmin struct {
+	bans    []*bans.Ban
+	added   []bans.AddRequest
+	removed []string
+}
+
+func (s *stubBanAdmin) Add(_ context.Context, req bans.AddRequest) (*bans.Ban, error) {
+	ban, err := bans.NewBan(req.Target, req.Pattern, req.Reason, req.IssuedBy, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+	s.added = append(s.added, req)
+	s.bans = append(s.bans, ban)
+	return ban, nil
+}
+
+func (s *stubBanAdmin) List(_ context.Context) ([]*bans.Ban, error) {
+	return s.bans, nil
+}
+
+func (s *stubBanAdmin) Remove(_ context.Context, id ulid.ULID, removedBy string) (*bans.Ban, error) {
+	for i, b := range s.bans {
+		if b.ID == id {
+			s.bans = append(s.bans[:i], s.bans[i+1:]...)
+			s.removed = append(s.removed, removedBy)
+			return b, nil
+		}
+	}
+	return nil, oops.Code("BAN_NOT_FOUND").Wrap(bans.ErrNotFound)
+}
+
+func runBan(t *testing.T, admin BanAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewBanHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestBanAddParsesArgs(t *testing.T) {
+	admin := &stubBanAdmin{}
+
+	out, err := runBan(t, admin, "add CIDR 192.0.2.0/24 --for 7d = spam wave")
+	require.NoError(t, err)
+	require.Len(t, admin.added, 1)
+	assert.Equal(t, bans.AddRequest{
+		Target:   bans.TargetCIDR,
+		Pattern:  "192.0.2.0/24",
+		Reason:   "spam wave",
+		IssuedBy: "Admin",
+		Duration: 7 * 24 * time.Hour,
+	}, admin.added[0])
+	assert.Contains(t, out, "Banned cidr 192.0.2.0/24 until ")
+
+	out, err = runBan(t, admin, "add player troll* =")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Banned player troll* permanently")
+}
+
+func TestBanAddRejectsMalformedArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{"missing equals", "add ip 192.0.2.7 spam"},
+		{"missing pattern", "add ip = spam"},
+		{"bad duration", "add ip 192.0.2.7 --for soon = spam"},
+		{"zero days", "add ip 192.0.2.7 --for 0d = spam"},
+		{"extra tokens", "add ip 192.0.2.7 now = spam"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := &stubBanAdmin{}
+			_, err := runBan(t, admin, tt.args)
+			errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+			assert.Empty(t, admin.added)
+		})
+	}
+}
+
+func TestBanAddSurfacesValidationMessage(t *testing.T) {
+	_, err := runBan(t, &stubBanAdmin{}, "add ip 192.0.2 = typo")
+	require.Error(t, err)
+	assert.Equal(t, `"192.0.2" is not an IP address`, command.PlayerMessage(err))
+}
+
+func TestBanListAndRemove(t *testing.T) {
+	admin := &stubBanAdmin{}
+	out, err := runBan(t, admin, "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No active bans.")
+
+	_, err = runBan(t, admin, "add hostname *.proxy.example = open proxies")
+	require.NoError(t, err)
+	id := admin.bans[0].ID.String()
+
+	out, err = runBan(t, admin, "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, id)
+	assert.Contains(t, out, "*.proxy.example")
+	assert.Contains(t, out, "expires never")
+	assert.Contains(t, out, "open proxies")
+
+	out, err = runBan(t, admin, "remove "+id)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Removed ban on hostname *.proxy.example.")
+	assert.Equal(t, []string{"Admin"}, admin.removed)
+
+	_, err = runBan(t, admin, "remove "+id)
+	require.Error(t, err)
+	assert.Equal(t, "No such ban; see ban list.", command.PlayerMessage(err))
+
+	_, err = runBan(t, admin, "remove not-an-id")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "is not a ban ID")
+}
+
+func TestBanWithoutSubcommandShowsUsage(t *testing.T) {
+	out, err := runBan(t, &stubBanAdmin{}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: ban list")
+}
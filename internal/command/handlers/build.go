@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/builder"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	buildCommandName = "build"
+	buildUsage       = "build dig | open | link | describe (or @dig, @open, @link, @describe)"
+)
+
+// BuildAdmin carries out the builder verbs. This is the ISP interface for
+// the build command; *builder.Builder satisfies it.
+type BuildAdmin interface {
+	Dig(ctx context.Context, actor builder.Actor, cmd builder.DigCommand) (*builder.DigResult, error)
+	Open(ctx context.Context, actor builder.Actor, cmd builder.OpenCommand) (*builder.OpenResult, error)
+	Link(ctx context.Context, actor builder.Actor, cmd builder.LinkCommand) (*world.Exit, *world.Location, error)
+	Describe(ctx context.Context, actor builder.Actor, cmd builder.DescribeCommand) (string, error)
+}
+
+// NewBuildHandler creates a command handler for the classic builder verbs.
+// The "@" system alias maps "@dig ..." to "build dig ...".
+func NewBuildHandler(admin BuildAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleBuild(ctx, exec, admin)
+	}
+}
+
+func handleBuild(ctx context.Context, exec *command.CommandExecution, admin BuildAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	actor := builder.Actor{CharacterID: exec.CharacterID(), LocationID: exec.LocationID()}
+
+	switch strings.ToLower(sub) {
+	case "dig":
+		cmd, err := builder.ParseDig(rest)
+		if err != nil {
+			return buildError(err)
+		}
+		result, err := admin.Dig(ctx, actor, cmd)
+		if err != nil {
+			return buildError(err)
+		}
+		lines := []string{fmt.Sprintf("Dug %s (#%s).", result.Location.Name, result.Location.ID)}
+		if result.Exit != nil {
+			lines = append(lines, fmt.Sprintf("Opened %s to %s.", exitLabel(result.Exit), result.Location.Name))
+		}
+		if result.Return != nil {
+			lines = append(lines, fmt.Sprintf("Opened %s back from %s.", exitLabel(result.Return), result.Location.Name))
+		}
+		writeOutput(ctx, exec, buildCommandName, strings.Join(lines, "\n"))
+	case "open":
+		cmd, err := builder.ParseOpen(rest)
+		if err != nil {
+			return buildError(err)
+		}
+		result, err := admin.Open(ctx, actor, cmd)
+		if err != nil {
+			return buildError(err)
+		}
+		msg := fmt.Sprintf("Opened %s to %s.", exitLabel(result.Exit), result.Destination.Name)
+		if result.Return != nil {
+			msg += fmt.Sprintf("\nOpened %s back from %s.", exitLabel(result.Return), result.Destination.Name)
+		}
+		writeOutput(ctx, exec, buildCommandName, msg)
+	case "link":
+		cmd, err := builder.ParseLink(rest)
+		if err != nil {
+			return buildError(err)
+		}
+		exit, destination, err := admin.Link(ctx, actor, cmd)
+		if err != nil {
+			return buildError(err)
+		}
+		writeOutputf(ctx, exec, buildCommandName, "Linked %s to %s.\n", exit.Name, destination.Name)
+	case "describe", "desc":
+		cmd, err := builder.ParseDescribe(rest)
+		if err != nil {
+			return buildError(err)
+		}
+		name, err := admin.Describe(ctx, actor, cmd)
+		if err != nil {
+			return buildError(err)
+		}
+		writeOutputf(ctx, exec, buildCommandName, "Described %s.\n", name)
+	default:
+		writeOutput(ctx, exec, buildCommandName, "Usage: "+buildUsage)
+	}
+	return nil
+}
+
+// exitLabel renders an exit with its aliases, e.g. "north (n)".
+func exitLabel(exit *world.Exit) string {
+	if len(exit.Aliases) == 0 {
+		return exit.Name
+	}
+	return exit.Name + " (" + strings.Join(exit.Aliases, ", ") + ")"
+}
+
+// buildError surfaces the builder's lookup failures and the world
+// service's validation failures to the player and maps policy denials to
+// the permission error; anything else falls through to the generic player
+// message.
+func buildError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	errCtx := oopsErr.Context()
+	var msg string
+	switch code := oopsErr.Code(); code {
+	case "BUILD_INVALID_SYNTAX":
+		usage, _ := errCtx["usage"].(string)
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(buildCommandName, usage)
+	case "BUILD_NO_LOCATION":
+		msg = "You are not in a location."
+	case "BUILD_DESTINATION_NOT_FOUND":
+		destination, _ := errCtx["destination"].(string)
+		msg = "No location called " + destination + "."
+	case "BUILD_EXIT_NOT_FOUND":
+		exit, _ := errCtx["exit"].(string)
+		msg = "There is no exit called " + exit + " here."
+	case "BUILD_TARGET_NOT_FOUND":
+		target, _ := errCtx["target"].(string)
+		msg = "You see no " + target + " here."
+	case world.CodeExitNameConflict:
+		var conflict *world.ExitNameConflictError
+		if errors.As(err, &conflict) {
+			msg = fmt.Sprintf("There is already an exit called %s there.", conflict.Name)
+		} else {
+			msg = "There is already an exit by that name there."
+		}
+	case "LOCATION_INVALID", "EXIT_INVALID", "OBJECT_INVALID", world.CodeConcurrentEdit:
+		msg = err.Error()
+	case "LOCATION_ACCESS_DENIED", "EXIT_ACCESS_DENIED", "OBJECT_ACCESS_DENIED", "CHARACTER_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(buildCommandName, strings.ToLower(strings.TrimSuffix(fmt.Sprint(code), "_ACCESS_DENIED")))
+	default:
+		return err
+	}
+	//nolint:wrapcheck // WorldError creates a structured oops error
+	return command.WorldError(msg, nil)
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/builder"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubBuildAdmin is a test implementation of BuildAdmin. It records the
+// parsed commands and echoes them back as results, or fails with err.
+type stubBuildAdmin struct {
+	digs      []builder.DigCommand
+	opens     []builder.OpenCommand
+	links     []builder.LinkCommand
+	describes []builder.DescribeCommand
+	actor     builder.Actor
+	err       error
+}
+
+func specExit(spec *builder.ExitSpec) *world.Exit {
+	if spec == nil {
+		return nil
+	}
+	return &world.Exit{Name: spec.Name, Aliases: spec.Aliases}
+}
+
+func (s *stubBuildAdmin) Dig(_ context.Context, actor builder.Actor, cmd builder.DigCommand) (*builder.DigResult, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.actor = actor
+	s.digs = append(s.digs, cmd)
+	return &builder.DigResult{
+		Location: &world.Location{ID: ulid.MustParse("01H000000000000000000000A1"), Name: cmd.Location},
+		Exit:     specExit(cmd.Exit),
+		Return:   specExit(cmd.Return),
+	}, nil
+}
+
+func (s *stubBuildAdmin) Open(_ context.Context, _ builder.Actor, cmd builder.OpenCommand) (*builder.OpenResult, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.opens = append(s.opens, cmd)
+	return &builder.OpenResult{
+		Exit:        specExit(&cmd.Exit),
+		Destination: &world.Location{Name: cmd.Destination},
+		Return:      specExit(cmd.Return),
+	}, nil
+}
+
+func (s *stubBuildAdmin) Link(_ context.Context, _ builder.Actor, cmd builder.LinkCommand) (*world.Exit, *world.Location, error) {
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	s.links = append(s.links, cmd)
+	return &world.Exit{Name: cmd.Exit}, &world.Location{Name: cmd.Destination}, nil
+}
+
+func (s *stubBuildAdmin) Describe(_ context.Context, _ builder.Actor, cmd builder.DescribeCommand) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	s.describes = append(s.describes, cmd)
+	return cmd.Target, nil
+}
+
+func runBuild(t *testing.T, admin BuildAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   pageCharID,
+		LocationID:    ulid.MustParse("01H000000000000000000000A0"),
+		CharacterName: "Alice",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewBuildHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestBuildDig(t *testing.T) {
+	admin := &stubBuildAdmin{}
+	out, err := runBuild(t, admin, "dig North Hall=north;n,south;s")
+	require.NoError(t, err)
+	assert.Equal(t, "Dug North Hall (#01H000000000000000000000A1).\n"+
+		"Opened north (n) to North Hall.\n"+
+		"Opened south (s) back from North Hall.\n", out)
+	require.Len(t, admin.digs, 1)
+	assert.Equal(t, pageCharID, admin.actor.CharacterID)
+	assert.Equal(t, ulid.MustParse("01H000000000000000000000A0"), admin.actor.LocationID)
+
+	out, err = runBuild(t, admin, "DIG Closet")
+	require.NoError(t, err)
+	assert.Equal(t, "Dug Closet (#01H000000000000000000000A1).\n", out)
+
+	_, err = runBuild(t, admin, "dig =north")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	oopsErr, ok := oops.AsOops(err)
+	require.True(t, ok)
+	assert.Equal(t, builder.DigUsage, oopsErr.Context()["usage"])
+}
+
+func TestBuildOpenLinkDescribe(t *testing.T) {
+	admin := &stubBuildAdmin{}
+
+	out, err := runBuild(t, admin, "open gate;g=Town Square,out")
+	require.NoError(t, err)
+	assert.Equal(t, "Opened gate (g) to Town Square.\nOpened out back from Town Square.\n", out)
+
+	out, err = runBuild(t, admin, "link north=Garden")
+	require.NoError(t, err)
+	assert.Equal(t, "Linked north to Garden.\n", out)
+
+	out, err = runBuild(t, admin, "desc here=A drafty hall.")
+	require.NoError(t, err)
+	assert.Equal(t, "Described here.\n", out)
+	assert.Equal(t, []builder.DescribeCommand{{Target: "here", Text: "A drafty hall."}}, admin.describes)
+
+	out, err = runBuild(t, admin, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: build dig")
+}
+
+func TestBuildErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no location", oops.Code("BUILD_NO_LOCATION").Errorf("x"), "You are not in a location."},
+		{"destination", oops.Code("BUILD_DESTINATION_NOT_FOUND").With("destination", "Nowhere").Errorf("x"), "No location called Nowhere."},
+		{"exit", oops.Code("BUILD_EXIT_NOT_FOUND").With("exit", "up").Errorf("x"), "There is no exit called up here."},
+		{"target", oops.Code("BUILD_TARGET_NOT_FOUND").With("target", "sofa").Errorf("x"), "You see no sofa here."},
+		{"name conflict", oops.Code(world.CodeExitNameConflict).Wrap(&world.ExitNameConflictError{Name: "north"}), "There is already an exit called north there."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runBuild(t, &stubBuildAdmin{err: tt.err}, "link north=Nowhere")
+			assert.Equal(t, tt.want, worldErrorMessage(t, err))
+		})
+	}
+
+	_, err := runBuild(t, &stubBuildAdmin{err: oops.Code("EXIT_ACCESS_DENIED").Errorf("denied")}, "open door=Garden")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	_, err = runBuild(t, &stubBuildAdmin{err: oops.Code("WORLD_TRANSACTION_UNAVAILABLE").Errorf("x")}, "dig Hall")
+	errutil.AssertErrorCode(t, err, "WORLD_TRANSACTION_UNAVAILABLE")
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	capacityCommandName = "capacity"
+	capacityUsage       = "capacity | set <n> | clear | overflow <location id> | overflow clear"
+)
+
+// CapacityAdmin caps how many characters a location holds and names where
+// arrivals go once it is full. This is the ISP interface for the capacity
+// command; *world.Service satisfies it.
+type CapacityAdmin interface {
+	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
+	UpdateLocation(ctx context.Context, subjectID string, loc *world.Location) error
+}
+
+// NewCapacityHandler creates a command handler that shows and changes the
+// maximum occupancy and overflow location of the caller's location.
+func NewCapacityHandler(admin CapacityAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleCapacity(ctx, exec, admin)
+	}
+}
+
+func handleCapacity(ctx context.Context, exec *command.CommandExecution, admin CapacityAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "":
+		return handleCapacityShow(ctx, exec, admin, subject)
+	case "set":
+		n, err := strconv.Atoi(rest)
+		if err != nil || n <= 0 {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(capacityCommandName, "capacity set <n>, where n is at least 1")
+		}
+		return handleCapacityUpdate(ctx, exec, admin, subject, func(loc *world.Location) string {
+			loc.MaxOccupancy = n
+			return fmt.Sprintf("This location now holds at most %d characters.", n)
+		})
+	case "clear":
+		return handleCapacityUpdate(ctx, exec, admin, subject, func(loc *world.Location) string {
+			loc.MaxOccupancy = 0
+			return "This location no longer has a capacity."
+		})
+	case "overflow":
+		return handleCapacityOverflow(ctx, exec, admin, subject, rest)
+	default:
+		writeOutput(ctx, exec, capacityCommandName, "Usage: "+capacityUsage)
+		return nil
+	}
+}
+
+// currentCapacityLocation loads the caller's location.
+func currentCapacityLocation(ctx context.Context, exec *command.CommandExecution, admin CapacityAdmin, subject string) (*world.Location, error) {
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("You are not in a location.", nil)
+	}
+	loc, err := admin.GetLocation(ctx, subject, exec.LocationID())
+	if err != nil {
+		return nil, capacityError(err)
+	}
+	return loc, nil
+}
+
+func handleCapacityShow(ctx context.Context, exec *command.CommandExecution, admin CapacityAdmin, subject string) error {
+	loc, err := currentCapacityLocation(ctx, exec, admin, subject)
+	if err != nil {
+		return err
+	}
+	if loc.MaxOccupancy == 0 {
+		writeOutput(ctx, exec, capacityCommandName, "This location has no capacity.")
+		return nil
+	}
+	msg := fmt.Sprintf("This location holds at most %d characters.", loc.MaxOccupancy)
+	if loc.OverflowID != nil {
+		overflow := loc.OverflowID.String()
+		if o, err := admin.GetLocation(ctx, subject, *loc.OverflowID); err == nil {
+			overflow = fmt.Sprintf("%s (%s)", o.Name, o.ID)
+		}
+		msg += " Arrivals overflow to " + overflow + "."
+	}
+	writeOutput(ctx, exec, capacityCommandName, msg)
+	return nil
+}
+
+func handleCapacityOverflow(ctx context.Context, exec *command.CommandExecution, admin CapacityAdmin, subject, arg string) error {
+	if arg == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(capacityCommandName, "capacity overflow <location id> | overflow clear")
+	}
+	if strings.EqualFold(arg, "clear") {
+		return handleCapacityUpdate(ctx, exec, admin, subject, func(loc *world.Location) string {
+			loc.OverflowID = nil
+			return "Arrivals are turned away once this location is full."
+		})
+	}
+	id, err := ulid.Parse(strings.ToUpper(strings.TrimPrefix(arg, "#")))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%q is not a location ID.", arg), nil)
+	}
+	return handleCapacityUpdate(ctx, exec, admin, subject, func(loc *world.Location) string {
+		loc.OverflowID = &id
+		return fmt.Sprintf("Arrivals overflow to %s once this location is full.", id)
+	})
+}
+
+// handleCapacityUpdate applies change to the caller's location, saves it,
+// and reports the message change returns.
+func handleCapacityUpdate(ctx context.Context, exec *command.CommandExecution, admin CapacityAdmin, subject string, change func(*world.Location) string) error {
+	loc, err := currentCapacityLocation(ctx, exec, admin, subject)
+	if err != nil {
+		return err
+	}
+	msg := change(loc)
+	if err := admin.UpdateLocation(ctx, subject, loc); err != nil {
+		return capacityError(err)
+	}
+	writeOutput(ctx, exec, capacityCommandName, msg)
+	return nil
+}
+
+// capacityError surfaces the world service's location validation and
+// overflow lookup failures to the builder and maps policy denials to the
+// permission error; anything else falls through to the generic player
+// message. The cause is not wrapped: oops resolves the innermost code,
+// which would mask WORLD_ERROR.
+func capacityError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "LOCATION_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "LOCATION_OVERFLOW_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no location with that ID.", nil)
+	case "LOCATION_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(capacityCommandName, "location")
+	}
+	return err
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubCapacityAdmin is a test implementation of CapacityAdmin.
+type stubCapacityAdmin struct {
+	locations map[ulid.ULID]*world.Location
+	updated   []*world.Location
+	err       error
+}
+
+func (s *stubCapacityAdmin) GetLocation(_ context.Context, _ string, id ulid.ULID) (*world.Location, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	loc, ok := s.locations[id]
+	if !ok {
+		return nil, oops.Code("LOCATION_NOT_FOUND").Wrap(world.ErrNotFound)
+	}
+	clone := *loc
+	return &clone, nil
+}
+
+func (s *stubCapacityAdmin) UpdateLocation(_ context.Context, _ string, loc *world.Location) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.updated = append(s.updated, loc)
+	return nil
+}
+
+var (
+	capacityLocationID = ulid.Make()
+	capacityOverflowID = ulid.Make()
+)
+
+func newStubCapacityAdmin() *stubCapacityAdmin {
+	return &stubCapacityAdmin{locations: map[ulid.ULID]*world.Location{
+		capacityLocationID: {ID: capacityLocationID, Name: "Booth", Type: world.LocationTypePersistent},
+		capacityOverflowID: {ID: capacityOverflowID, Name: "Lobby", Type: world.LocationTypePersistent},
+	}}
+}
+
+func runCapacity(t *testing.T, admin CapacityAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Alice",
+		LocationID:    capacityLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewCapacityHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestCapacityShow(t *testing.T) {
+	admin := newStubCapacityAdmin()
+
+	out, err := runCapacity(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "This location has no capacity.\n", out)
+
+	booth := admin.locations[capacityLocationID]
+	booth.MaxOccupancy = 4
+	out, err = runCapacity(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "This location holds at most 4 characters.\n", out)
+
+	booth.OverflowID = &capacityOverflowID
+	out, err = runCapacity(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "This location holds at most 4 characters. Arrivals overflow to Lobby ("+capacityOverflowID.String()+").\n", out)
+}
+
+func TestCapacitySetAndClear(t *testing.T) {
+	admin := newStubCapacityAdmin()
+
+	out, err := runCapacity(t, admin, "set 6")
+	require.NoError(t, err)
+	assert.Equal(t, "This location now holds at most 6 characters.\n", out)
+	require.Len(t, admin.updated, 1)
+	assert.Equal(t, 6, admin.updated[0].MaxOccupancy)
+
+	out, err = runCapacity(t, admin, "clear")
+	require.NoError(t, err)
+	assert.Equal(t, "This location no longer has a capacity.\n", out)
+	assert.Zero(t, admin.updated[1].MaxOccupancy)
+
+	for _, args := range []string{"set", "set 0", "set -2", "set many"} {
+		_, err := runCapacity(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestCapacityOverflow(t *testing.T) {
+	admin := newStubCapacityAdmin()
+
+	out, err := runCapacity(t, admin, "overflow #"+capacityOverflowID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Arrivals overflow to "+capacityOverflowID.String()+" once this location is full.\n", out)
+	require.Len(t, admin.updated, 1)
+	assert.Equal(t, &capacityOverflowID, admin.updated[0].OverflowID)
+
+	out, err = runCapacity(t, admin, "overflow clear")
+	require.NoError(t, err)
+	assert.Equal(t, "Arrivals are turned away once this location is full.\n", out)
+	assert.Nil(t, admin.updated[1].OverflowID)
+
+	_, err = runCapacity(t, admin, "overflow lobby")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runCapacity(t, admin, "overflow")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestCapacityErrors(t *testing.T) {
+	admin := newStubCapacityAdmin()
+
+	admin.err = oops.Code("LOCATION_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	_, err := runCapacity(t, admin, "set 3")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	admin.err = nil
+	failing := &failingCapacityAdmin{stubCapacityAdmin: admin, err: oops.Code("LOCATION_OVERFLOW_NOT_FOUND").Wrap(world.ErrNotFound)}
+	_, err = runCapacity(t, failing, "overflow "+ulid.Make().String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Equal(t, "There is no location with that ID.", command.PlayerMessage(err))
+
+	failing.err = oops.Code("LOCATION_INVALID").Errorf("overflow_id: a location cannot overflow to itself")
+	_, err = runCapacity(t, failing, "overflow "+capacityLocationID.String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "itself")
+}
+
+// failingCapacityAdmin loads locations but refuses every update with err.
+type failingCapacityAdmin struct {
+	*stubCapacityAdmin
+	err error
+}
+
+func (f *failingCapacityAdmin) UpdateLocation(context.Context, string, *world.Location) error {
+	return f.err
+}
+
+func TestCapacityUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runCapacity(t, newStubCapacityAdmin(), "shrink")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+capacityUsage+"\n", out)
+}
+
+func TestRegisterAdminCapacity(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("capacity")
+	assert.False(t, found, "capacity requires the Capacity dependency")
+
+	deps.Capacity = newStubCapacityAdmin()
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("capacity")
+	assert.True(t, found)
+}
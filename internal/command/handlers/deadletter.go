@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/plugin/deadletter"
+)
+
+const (
+	deadLetterCommandName = "deadletter"
+	deadLetterUsage       = "deadletter list [plugin] | show <id> | requeue <id> | discard <id> | purge <plugin> | purge all"
+)
+
+// DeadLetterAdmin inspects and resolves plugin dead letters. This is the
+// ISP interface for the deadletter admin command; *deadletter.Queue
+// satisfies it.
+type DeadLetterAdmin interface {
+	Get(ctx context.Context, id ulid.ULID) (deadletter.Letter, error)
+	List(ctx context.Context, filter deadletter.ListFilter) ([]deadletter.Letter, error)
+	// Requeue returns the updated letter alongside the error when the
+	// plugin failed again, and a zero letter for any other error.
+	Requeue(ctx context.Context, id ulid.ULID) (deadletter.Letter, error)
+	Discard(ctx context.Context, id ulid.ULID) (deadletter.Letter, error)
+	Purge(ctx context.Context, plugin string) (int, error)
+}
+
+// NewDeadLetterHandler creates a command handler that routes deadletter
+// subcommands.
+func NewDeadLetterHandler(admin DeadLetterAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleDeadLetter(ctx, exec, admin)
+	}
+}
+
+func handleDeadLetter(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "list":
+		if strings.ContainsAny(rest, " \t") {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(deadLetterCommandName, "deadletter list [plugin]")
+		}
+		return handleDeadLetterList(ctx, exec, admin, rest)
+	case "show", "requeue", "discard":
+		id, err := ulid.Parse(strings.ToUpper(rest))
+		if err != nil {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(deadLetterCommandName, "deadletter "+sub+" <id>")
+		}
+		switch sub {
+		case "show":
+			return handleDeadLetterShow(ctx, exec, admin, id)
+		case "requeue":
+			return handleDeadLetterRequeue(ctx, exec, admin, id)
+		default:
+			return handleDeadLetterDiscard(ctx, exec, admin, id)
+		}
+	case "purge":
+		if rest == "" || strings.ContainsAny(rest, " \t") {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(deadLetterCommandName, "deadletter purge <plugin> | purge all")
+		}
+		return handleDeadLetterPurge(ctx, exec, admin, rest)
+	default:
+		writeOutput(ctx, exec, deadLetterCommandName, "Usage: "+deadLetterUsage)
+		return nil
+	}
+}
+
+func handleDeadLetterList(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin, plugin string) error {
+	list, err := admin.List(ctx, deadletter.ListFilter{Plugin: plugin})
+	if err != nil {
+		return deadLetterError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, deadLetterCommandName, "No dead letters.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Dead letters (newest first):")
+	for _, letter := range list {
+		reason, _, _ := strings.Cut(letter.Reason, "\n")
+		fmt.Fprintf(&sb, "\n  %s %-16s %-24s x%d  %s  %s",
+			letter.ID, letter.Plugin, string(letter.Event.Type), letter.Attempts,
+			formatScheduleTime(letter.LastFailedAt), reason)
+	}
+	writeOutput(ctx, exec, deadLetterCommandName, sb.String())
+	return nil
+}
+
+func handleDeadLetterShow(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin, id ulid.ULID) error {
+	letter, err := admin.Get(ctx, id)
+	if err != nil {
+		return deadLetterError(err)
+	}
+
+	ev := letter.Event
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Dead letter: %s\n", letter.ID)
+	fmt.Fprintf(&sb, "Plugin: %s\n", letter.Plugin)
+	fmt.Fprintf(&sb, "Event: %s %s on %s\n", ev.ID, ev.Type, ev.Stream)
+	fmt.Fprintf(&sb, "Actor: %s %s\n", ev.ActorKind, ev.ActorID)
+	fmt.Fprintf(&sb, "Event time: %s\n", formatScheduleTime(time.UnixMilli(ev.Timestamp)))
+	fmt.Fprintf(&sb, "Attempts: %d\n", letter.Attempts)
+	fmt.Fprintf(&sb, "First failed: %s\n", formatScheduleTime(letter.CreatedAt))
+	fmt.Fprintf(&sb, "Last failed: %s\n", formatScheduleTime(letter.LastFailedAt))
+	fmt.Fprintf(&sb, "Reason: %s\n", letter.Reason)
+	if ev.Payload != "" {
+		fmt.Fprintf(&sb, "Payload: %s", ev.Payload)
+	}
+	writeOutput(ctx, exec, deadLetterCommandName, strings.TrimRight(sb.String(), "\n"))
+	return nil
+}
+
+func handleDeadLetterRequeue(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin, id ulid.ULID) error {
+	letter, err := admin.Requeue(ctx, id)
+	if err != nil {
+		// Requeue returns the letter only when it was delivered and failed
+		// again. Its code is not checked: oops resolves the innermost code,
+		// which may be the plugin's.
+		if letter.ID != (ulid.ULID{}) {
+			reason, _, _ := strings.Cut(letter.Reason, "\n")
+			writeOutputf(ctx, exec, deadLetterCommandName,
+				"Plugin %s failed again (attempt %d): %s\nThe dead letter is kept.\n",
+				letter.Plugin, letter.Attempts, reason)
+			return nil
+		}
+		return deadLetterError(err)
+	}
+	writeOutputf(ctx, exec, deadLetterCommandName,
+		"Delivered dead letter %s to %s; it has been removed.\n", letter.ID, letter.Plugin)
+	return nil
+}
+
+func handleDeadLetterDiscard(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin, id ulid.ULID) error {
+	letter, err := admin.Discard(ctx, id)
+	if err != nil {
+		return deadLetterError(err)
+	}
+	writeOutputf(ctx, exec, deadLetterCommandName,
+		"Discarded dead letter %s (%s event for %s).\n", letter.ID, letter.Event.Type, letter.Plugin)
+	return nil
+}
+
+func handleDeadLetterPurge(ctx context.Context, exec *command.CommandExecution, admin DeadLetterAdmin, target string) error {
+	plugin := target
+	if target == "all" {
+		plugin = ""
+	}
+	n, err := admin.Purge(ctx, plugin)
+	if err != nil {
+		return deadLetterError(err)
+	}
+	if plugin == "" {
+		writeOutputf(ctx, exec, deadLetterCommandName, "Purged %d dead letters.\n", n)
+		return nil
+	}
+	writeOutputf(ctx, exec, deadLetterCommandName, "Purged %d dead letters for %s.\n", n, plugin)
+	return nil
+}
+
+// deadLetterError surfaces the queue's lookup and availability failures to
+// staff verbatim; anything else falls through to the generic player
+// message. The cause is not wrapped: oops resolves the innermost code,
+// which would mask WORLD_ERROR.
+func deadLetterError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "DEAD_LETTER_NOT_FOUND", "DEAD_LETTER_INVALID", "DEAD_LETTER_REQUEUE_UNAVAILABLE":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/plugin/deadletter"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// stubDeadLetterAdmin is a test implementation of DeadLetterAdmin.
+type stubDeadLetterAdmin struct {
+	letters    map[ulid.ULID]deadletter.Letter
+	filters    []deadletter.ListFilter
+	purged     []string
+	requeueErr error
+}
+
+func newStubDeadLetterAdmin(list ...deadletter.Letter) *stubDeadLetterAdmin {
+	s := &stubDeadLetterAdmin{letters: make(map[ulid.ULID]deadletter.Letter)}
+	for _, l := range list {
+		s.letters[l.ID] = l
+	}
+	return s
+}
+
+func (s *stubDeadLetterAdmin) Get(_ context.Context, id ulid.ULID) (deadletter.Letter, error) {
+	l, ok := s.letters[id]
+	if !ok {
+		return deadletter.Letter{}, oops.Code("DEAD_LETTER_NOT_FOUND").Errorf("no dead letter %s", id)
+	}
+	return l, nil
+}
+
+func (s *stubDeadLetterAdmin) List(_ context.Context, filter deadletter.ListFilter) ([]deadletter.Letter, error) {
+	s.filters = append(s.filters, filter)
+	list := make([]deadletter.Letter, 0, len(s.letters))
+	for _, l := range s.letters {
+		list = append(list, l)
+	}
+	return list, nil
+}
+
+func (s *stubDeadLetterAdmin) Requeue(_ context.Context, id ulid.ULID) (deadletter.Letter, error) {
+	l, err := s.Take(id)
+	if err != nil {
+		return deadletter.Letter{}, err
+	}
+	if s.requeueErr != nil {
+		l.Attempts++
+		l.Reason = s.requeueErr.Error()
+		s.letters[id] = l
+		return l, oops.Code("DEAD_LETTER_REQUEUE_FAILED").Wrap(s.requeueErr)
+	}
+	return l, nil
+}
+
+func (s *stubDeadLetterAdmin) Discard(_ context.Context, id ulid.ULID) (deadletter.Letter, error) {
+	return s.Take(id)
+}
+
+func (s *stubDeadLetterAdmin) Take(id ulid.ULID) (deadletter.Letter, error) {
+	l, ok := s.letters[id]
+	if !ok {
+		return deadletter.Letter{}, oops.Code("DEAD_LETTER_NOT_FOUND").Errorf("no dead letter %s", id)
+	}
+	delete(s.letters, id)
+	return l, nil
+}
+
+func (s *stubDeadLetterAdmin) Purge(_ context.Context, plugin string) (int, error) {
+	s.purged = append(s.purged, plugin)
+	return len(s.letters), nil
+}
+
+func runDeadLetter(t *testing.T, admin DeadLetterAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewDeadLetterHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func sceneDeadLetter() deadletter.Letter {
+	failed := time.Date(2026, 10, 17, 11, 0, 5, 0, time.UTC)
+	return deadletter.Letter{
+		ID:     ulid.MustParse("01HZ00000000000000000000D1"),
+		Plugin: "core-scenes",
+		Event: pluginsdk.Event{
+			ID:        "01HZ00000000000000000000E1",
+			Stream:    "location.01HZ00000000000000000000L1",
+			Type:      "say",
+			Timestamp: failed.Add(-time.Second).UnixMilli(),
+			ActorKind: pluginsdk.ActorCharacter,
+			ActorID:   "01HZ00000000000000000000C1",
+			Payload:   `{"text":"hello"}`,
+		},
+		Reason:       "attempt to index a nil value\nstack traceback: ...",
+		Attempts:     3,
+		CreatedAt:    failed,
+		LastFailedAt: failed,
+	}
+}
+
+func TestDeadLetterList(t *testing.T) {
+	admin := newStubDeadLetterAdmin(sceneDeadLetter())
+
+	out, err := runDeadLetter(t, admin, "list core-scenes")
+	require.NoError(t, err)
+	assert.Contains(t, out, "01HZ00000000000000000000D1 core-scenes")
+	assert.Contains(t, out, "x3  2026-10-17 11:00:05 UTC  attempt to index a nil value")
+	assert.NotContains(t, out, "stack traceback")
+	require.Len(t, admin.filters, 1)
+	assert.Equal(t, "core-scenes", admin.filters[0].Plugin)
+
+	out, err = runDeadLetter(t, newStubDeadLetterAdmin(), "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No dead letters.")
+
+	_, err = runDeadLetter(t, admin, "list a b")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestDeadLetterShow(t *testing.T) {
+	letter := sceneDeadLetter()
+	out, err := runDeadLetter(t, newStubDeadLetterAdmin(letter), "show "+letter.ID.String())
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "Plugin: core-scenes")
+	assert.Contains(t, out, "Event: 01HZ00000000000000000000E1 say on location.01HZ00000000000000000000L1")
+	assert.Contains(t, out, "Actor: character 01HZ00000000000000000000C1")
+	assert.Contains(t, out, "Event time: 2026-10-17 11:00:04 UTC")
+	assert.Contains(t, out, "Attempts: 3")
+	assert.Contains(t, out, "stack traceback")
+	assert.Contains(t, out, `Payload: {"text":"hello"}`)
+
+	_, err = runDeadLetter(t, newStubDeadLetterAdmin(), "show nope")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+
+	_, err = runDeadLetter(t, newStubDeadLetterAdmin(), "show "+ulid.Make().String())
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "no dead letter")
+}
+
+func TestDeadLetterRequeue(t *testing.T) {
+	letter := sceneDeadLetter()
+	admin := newStubDeadLetterAdmin(letter)
+
+	out, err := runDeadLetter(t, admin, "requeue "+letter.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Delivered dead letter 01HZ00000000000000000000D1 to core-scenes")
+	assert.Empty(t, admin.letters)
+
+	admin = newStubDeadLetterAdmin(letter)
+	admin.requeueErr = oops.Code("LUA_RUNTIME_ERROR").Wrap(errors.New("still broken"))
+	out, err = runDeadLetter(t, admin, "requeue "+letter.ID.String())
+	require.NoError(t, err, "a repeat failure is reported, not an error")
+	assert.Contains(t, out, "Plugin core-scenes failed again (attempt 4): still broken")
+	assert.Contains(t, out, "The dead letter is kept.")
+	assert.Len(t, admin.letters, 1)
+}
+
+func TestDeadLetterDiscardAndPurge(t *testing.T) {
+	letter := sceneDeadLetter()
+	admin := newStubDeadLetterAdmin(letter)
+
+	out, err := runDeadLetter(t, admin, "discard "+letter.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Discarded dead letter 01HZ00000000000000000000D1 (say event for core-scenes).")
+
+	out, err = runDeadLetter(t, admin, "purge core-scenes")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged 0 dead letters for core-scenes.")
+
+	out, err = runDeadLetter(t, admin, "purge all")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged 0 dead letters.")
+	assert.Equal(t, []string{"core-scenes", ""}, admin.purged)
+
+	_, err = runDeadLetter(t, admin, "purge")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestDeadLetterUsage(t *testing.T) {
+	out, err := runDeadLetter(t, newStubDeadLetterAdmin(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: "+deadLetterUsage)
+}
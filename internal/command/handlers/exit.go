@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	exitCommandName = "exit"
+	exitUsage       = "exit <exit> | delay <exit> = <duration> | cost <exit> = <amount> | lock <exit> = <key id> [consume] | unlock <exit>"
+	exitDelayUsage  = "exit delay <exit> = <duration>"
+	exitCostUsage   = "exit cost <exit> = <amount>"
+	exitLockUsage   = "exit lock <exit> = <key id> [consume]"
+	exitUnlockUsage = "exit unlock <exit>"
+)
+
+// ExitAdmin reads and updates the exits of a location. This is the ISP
+// interface for the exit command; *world.Service satisfies it.
+type ExitAdmin interface {
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+	UpdateExit(ctx context.Context, subjectID string, exit *world.Exit) error
+}
+
+// NewExitHandler creates a command handler that shows and sets how long an
+// exit of the caller's location takes to walk, what it costs, and which key
+// object unlocks it.
+func NewExitHandler(admin ExitAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleExit(ctx, exec, admin)
+	}
+}
+
+func handleExit(ctx context.Context, exec *command.CommandExecution, admin ExitAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	sub, rest, _ := strings.Cut(args, " ")
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "":
+		writeOutput(ctx, exec, exitCommandName, "Usage: "+exitUsage)
+		return nil
+	case "delay":
+		name, value, ok := cutExitAssignment(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(exitCommandName, exitDelayUsage)
+		}
+		delay, err := parseTraversalDelay(value)
+		if err != nil {
+			return err
+		}
+		return handleExitSet(ctx, exec, admin, subject, name, func(e *world.Exit) { e.TraversalDelay = delay })
+	case "cost":
+		name, value, ok := cutExitAssignment(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(exitCommandName, exitCostUsage)
+		}
+		cost, err := strconv.Atoi(value)
+		if err != nil {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(exitCommandName, exitCostUsage)
+		}
+		return handleExitSet(ctx, exec, admin, subject, name, func(e *world.Exit) { e.TraversalCost = cost })
+	case "lock":
+		name, value, ok := cutExitAssignment(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(exitCommandName, exitLockUsage)
+		}
+		lockData, err := parseKeyLock(value)
+		if err != nil {
+			return err
+		}
+		return handleExitLock(ctx, exec, admin, subject, name, true, lockData)
+	case "unlock":
+		name := strings.TrimSpace(rest)
+		if name == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(exitCommandName, exitUnlockUsage)
+		}
+		return handleExitLock(ctx, exec, admin, subject, name, false, nil)
+	default:
+		exit, err := findLocalExit(ctx, exec, admin, subject, args)
+		if err != nil {
+			return err
+		}
+		writeOutput(ctx, exec, exitCommandName, describeTraversal(exit))
+		return nil
+	}
+}
+
+// cutExitAssignment splits "<exit> = <value>".
+func cutExitAssignment(args string) (name, value string, ok bool) {
+	name, value, found := strings.Cut(args, "=")
+	name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+	return name, value, found && name != "" && value != ""
+}
+
+// parseTraversalDelay parses a walk time such as 2s or 1m30s; 0 and none
+// make the exit instant.
+func parseTraversalDelay(value string) (time.Duration, error) {
+	if value == "0" || strings.EqualFold(value, "none") {
+		return 0, nil
+	}
+	delay, err := time.ParseDuration(value)
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return 0, command.ErrInvalidArgs(exitCommandName, exitDelayUsage)
+	}
+	return delay, nil
+}
+
+// parseKeyLock parses "<key id> [consume]" into the lock data of a key lock.
+func parseKeyLock(value string) (map[string]any, error) {
+	fields := strings.Fields(value)
+	if len(fields) > 2 || (len(fields) == 2 && !strings.EqualFold(fields[1], "consume")) {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return nil, command.ErrInvalidArgs(exitCommandName, exitLockUsage)
+	}
+	keyID, err := ulid.Parse(strings.TrimPrefix(fields[0], "#"))
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return nil, command.ErrInvalidArgs(exitCommandName, exitLockUsage)
+	}
+	return map[string]any{
+		world.LockDataKeyID:   keyID.String(),
+		world.LockDataConsume: len(fields) == 2,
+	}, nil
+}
+
+// findLocalExit resolves name to an exit of the caller's location.
+func findLocalExit(ctx context.Context, exec *command.CommandExecution, admin ExitAdmin, subject, name string) (*world.Exit, error) {
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("You are not in a location.", nil)
+	}
+	exits, err := admin.GetVisibleExits(ctx, subject, exec.LocationID(), exec.CharacterID())
+	if err != nil {
+		return nil, exitError(err)
+	}
+	i := slices.IndexFunc(exits, func(e *world.Exit) bool { return e.MatchesName(name) })
+	if i < 0 {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("There is no exit called "+name+" here.", nil)
+	}
+	return exits[i], nil
+}
+
+func handleExitSet(ctx context.Context, exec *command.CommandExecution, admin ExitAdmin, subject, name string, set func(*world.Exit)) error {
+	exit, err := findLocalExit(ctx, exec, admin, subject, name)
+	if err != nil {
+		return err
+	}
+	set(exit)
+	if err := admin.UpdateExit(ctx, subject, exit); err != nil {
+		return exitError(err)
+	}
+	writeOutput(ctx, exec, exitCommandName, describeTraversal(exit))
+	return nil
+}
+
+// handleExitLock locks the exit with a key, or unlocks it when locked is
+// false.
+func handleExitLock(ctx context.Context, exec *command.CommandExecution, admin ExitAdmin, subject, name string, locked bool, lockData map[string]any) error {
+	exit, err := findLocalExit(ctx, exec, admin, subject, name)
+	if err != nil {
+		return err
+	}
+	if err := exit.SetLocked(locked, world.LockTypeKey, lockData); err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	if err := admin.UpdateExit(ctx, subject, exit); err != nil {
+		return exitError(err)
+	}
+	writeOutput(ctx, exec, exitCommandName, describeTraversal(exit))
+	return nil
+}
+
+func describeTraversal(exit *world.Exit) string {
+	return describeCost(exit) + describeLock(exit)
+}
+
+// describeLock reports the key that opens a key-locked exit, or nothing for
+// an exit without a key lock.
+func describeLock(exit *world.Exit) string {
+	keyID, consume, ok := exit.KeyLock()
+	switch {
+	case !ok:
+		return ""
+	case consume:
+		return " It is locked; key " + keyID.String() + " opens it and is used up."
+	default:
+		return " It is locked; key " + keyID.String() + " opens it."
+	}
+}
+
+func describeCost(exit *world.Exit) string {
+	switch {
+	case exit.TraversalDelay == 0 && exit.TraversalCost == 0:
+		return "Going " + exit.Name + " is instant and free."
+	case exit.TraversalCost == 0:
+		return "Going " + exit.Name + " takes " + exit.TraversalDelay.String() + "."
+	case exit.TraversalDelay == 0:
+		return "Going " + exit.Name + " is instant and costs " + strconv.Itoa(exit.TraversalCost) + "."
+	default:
+		return "Going " + exit.Name + " takes " + exit.TraversalDelay.String() +
+			" and costs " + strconv.Itoa(exit.TraversalCost) + "."
+	}
+}
+
+// exitError surfaces the world service's exit validation failures to the
+// player and maps policy denials to the permission error; anything else
+// falls through to the generic player message.
+func exitError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "EXIT_INVALID", world.CodeConcurrentEdit:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "EXIT_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(exitCommandName, "exit")
+	case "LOCATION_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(exitCommandName, "location")
+	}
+	return err
+}
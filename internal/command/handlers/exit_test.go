@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubExitAdmin is a test implementation of ExitAdmin.
+type stubExitAdmin struct {
+	exits     []*world.Exit
+	updated   []*world.Exit
+	updateErr error
+}
+
+func (s *stubExitAdmin) GetVisibleExits(context.Context, string, ulid.ULID, ulid.ULID) ([]*world.Exit, error) {
+	return s.exits, nil
+}
+
+func (s *stubExitAdmin) UpdateExit(_ context.Context, _ string, exit *world.Exit) error {
+	if s.updateErr != nil {
+		return s.updateErr
+	}
+	if err := exit.Validate(); err != nil {
+		return oops.Code("EXIT_INVALID").Wrap(err)
+	}
+	s.updated = append(s.updated, exit)
+	return nil
+}
+
+func newStubExitAdmin(t *testing.T, locID ulid.ULID) *stubExitAdmin {
+	t.Helper()
+	north, err := world.NewExit(locID, ulid.Make(), "north")
+	require.NoError(t, err)
+	north.Aliases = []string{"n"}
+	return &stubExitAdmin{exits: []*world.Exit{north}}
+}
+
+func runExit(t *testing.T, admin ExitAdmin, locID ulid.ULID, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Builder",
+		LocationID:    locID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewExitHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestExitShowsTraversal(t *testing.T) {
+	locID := ulid.Make()
+	admin := newStubExitAdmin(t, locID)
+
+	out, err := runExit(t, admin, locID, "n")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north is instant and free.\n", out)
+
+	admin.exits[0].TraversalDelay = 2 * time.Second
+	admin.exits[0].TraversalCost = 5
+	out, err = runExit(t, admin, locID, "north")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north takes 2s and costs 5.\n", out)
+
+	_, err = runExit(t, admin, locID, "west")
+	require.Error(t, err)
+	assert.Equal(t, "There is no exit called west here.", command.PlayerMessage(err))
+}
+
+func TestExitSetsDelayAndCost(t *testing.T) {
+	locID := ulid.Make()
+	admin := newStubExitAdmin(t, locID)
+
+	out, err := runExit(t, admin, locID, "delay north = 1m30s")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north takes 1m30s.\n", out)
+	require.Len(t, admin.updated, 1)
+	assert.Equal(t, 90*time.Second, admin.updated[0].TraversalDelay)
+
+	out, err = runExit(t, admin, locID, "cost north = 3")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north takes 1m30s and costs 3.\n", out)
+
+	out, err = runExit(t, admin, locID, "delay n = none")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north is instant and costs 3.\n", out)
+}
+
+func TestExitRejectsBadValues(t *testing.T) {
+	locID := ulid.Make()
+	admin := newStubExitAdmin(t, locID)
+
+	_, err := runExit(t, admin, locID, "delay north = soon")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runExit(t, admin, locID, "cost north = lots")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runExit(t, admin, locID, "cost north")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+
+	_, err = runExit(t, admin, locID, "delay north = 1h")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "traversal_delay")
+	assert.Empty(t, admin.updated)
+}
+
+func TestExitLocksWithKey(t *testing.T) {
+	locID := ulid.Make()
+	admin := newStubExitAdmin(t, locID)
+	keyID := ulid.Make()
+
+	out, err := runExit(t, admin, locID, "lock north = "+keyID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Going north is instant and free. It is locked; key "+keyID.String()+" opens it.\n", out)
+	got, consume, ok := admin.updated[0].KeyLock()
+	require.True(t, ok)
+	assert.Equal(t, keyID, got)
+	assert.False(t, consume)
+
+	out, err = runExit(t, admin, locID, "lock n = #"+keyID.String()+" consume")
+	require.NoError(t, err)
+	assert.Contains(t, out, "opens it and is used up.")
+	_, consume, _ = admin.updated[1].KeyLock()
+	assert.True(t, consume)
+
+	out, err = runExit(t, admin, locID, "unlock north")
+	require.NoError(t, err)
+	assert.Equal(t, "Going north is instant and free.\n", out)
+	assert.False(t, admin.updated[2].Locked)
+
+	_, err = runExit(t, admin, locID, "lock north = brass-key")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runExit(t, admin, locID, "lock north = "+keyID.String()+" twice")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runExit(t, admin, locID, "unlock")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestExitRequiresWriteAccess(t *testing.T) {
+	locID := ulid.Make()
+	admin := newStubExitAdmin(t, locID)
+	admin.updateErr = oops.Code("EXIT_ACCESS_DENIED").Errorf("denied")
+
+	_, err := runExit(t, admin, locID, "cost north = 3")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestExitOutsideALocation(t *testing.T) {
+	_, err := runExit(t, &stubExitAdmin{}, ulid.ULID{}, "north")
+	require.Error(t, err)
+	assert.Equal(t, "You are not in a location.", command.PlayerMessage(err))
+}
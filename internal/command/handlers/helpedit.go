@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/help"
+)
+
+const (
+	helpEditCommandName = "helpedit"
+	helpEditUsage       = "helpedit list [category] | set | title | category | alias <topic> = <value> | delete <topic>"
+)
+
+// HelpAdmin edits help topics. This is the ISP interface for the helpedit
+// command; *help.Service satisfies it.
+type HelpAdmin interface {
+	Topic(ctx context.Context, name string) (*help.Topic, error)
+	List(ctx context.Context, category string) ([]*help.Topic, error)
+	Save(ctx context.Context, subject string, topic *help.Topic) error
+	Delete(ctx context.Context, subject, name string) error
+}
+
+// NewHelpEditHandler creates a command handler that routes helpedit
+// subcommands.
+func NewHelpEditHandler(admin HelpAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleHelpEdit(ctx, exec, admin)
+	}
+}
+
+func handleHelpEdit(ctx context.Context, exec *command.CommandExecution, admin HelpAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch sub {
+	case "list":
+		return handleHelpEditList(ctx, exec, admin, rest)
+	case "delete":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(helpEditCommandName, "helpedit delete <topic>")
+		}
+		if err := admin.Delete(ctx, subject, rest); err != nil {
+			return helpEditError(err)
+		}
+		writeOutputf(ctx, exec, helpEditCommandName, "Deleted help topic %s.\n", help.NormalizeName(rest))
+		return nil
+	case "set", "title", "category", "alias":
+		name, value, ok := strings.Cut(rest, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(helpEditCommandName, "helpedit "+sub+" <topic> = <value>")
+		}
+		return handleHelpEditField(ctx, exec, admin, subject, sub, name, strings.TrimSpace(value))
+	default:
+		writeOutput(ctx, exec, helpEditCommandName, "Usage: "+helpEditUsage)
+		return nil
+	}
+}
+
+func handleHelpEditList(ctx context.Context, exec *command.CommandExecution, admin HelpAdmin, category string) error {
+	topics, err := admin.List(ctx, category)
+	if err != nil {
+		return helpEditError(err)
+	}
+	if len(topics) == 0 {
+		writeOutput(ctx, exec, helpEditCommandName, "No help topics.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Help topics:")
+	for _, t := range topics {
+		fmt.Fprintf(&sb, "\n  %-12s %-24s %s", t.Category, t.Name, t.Title)
+		if len(t.Aliases) > 0 {
+			fmt.Fprintf(&sb, " (also: %s)", strings.Join(t.Aliases, ", "))
+		}
+	}
+	writeOutput(ctx, exec, helpEditCommandName, sb.String())
+	return nil
+}
+
+// handleHelpEditField changes one field of the topic that name resolves to.
+// "set" creates the topic when nothing resolves; the other fields need an
+// existing topic, since a topic cannot be saved without a body.
+func handleHelpEditField(
+	ctx context.Context, exec *command.CommandExecution, admin HelpAdmin,
+	subject, field, name, value string,
+) error {
+	topic, err := admin.Topic(ctx, name)
+	switch {
+	case errors.Is(err, help.ErrNotFound) && field == "set":
+		topic = &help.Topic{Name: name}
+	case err != nil:
+		return helpEditError(err)
+	}
+
+	switch field {
+	case "set":
+		topic.Body = value
+	case "title":
+		topic.Title = value
+	case "category":
+		topic.Category = value
+	case "alias":
+		topic.Aliases = nil
+		for _, alias := range strings.Split(value, ",") {
+			if alias = strings.TrimSpace(alias); alias != "" {
+				topic.Aliases = append(topic.Aliases, alias)
+			}
+		}
+	}
+
+	if err := admin.Save(ctx, subject, topic); err != nil {
+		return helpEditError(err)
+	}
+	writeOutputf(ctx, exec, helpEditCommandName, "Saved help topic %s.\n", topic.Name)
+	return nil
+}
+
+// helpEditError surfaces the help service's validation, conflict, and
+// lookup failures to staff verbatim; anything else falls through to the
+// generic player message. The cause is not wrapped: oops resolves the
+// innermost code, which would mask WORLD_ERROR.
+func helpEditError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "HELP_INVALID_TOPIC":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "HELP_NAME_CONFLICT":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That name or alias is already used by another help topic.", nil)
+	case "HELP_TOPIC_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No such help topic; see helpedit list.", nil)
+	case "HELP_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(helpEditCommandName, "help")
+	}
+	return err
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/help"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubHelpAdmin is a test implementation of HelpAdmin. It validates saved
+// topics like the real service so normalization is visible to the handler.
+type stubHelpAdmin struct {
+	topics   map[string]*help.Topic
+	subjects []string
+	saveErr  error
+}
+
+func newStubHelpAdmin() *stubHelpAdmin {
+	return &stubHelpAdmin{topics: map[string]*help.Topic{}}
+}
+
+func (s *stubHelpAdmin) Topic(_ context.Context, name string) (*help.Topic, error) {
+	name = help.NormalizeName(name)
+	for _, t := range s.topics {
+		if t.Name == name {
+			copied := *t
+			return &copied, nil
+		}
+		for _, alias := range t.Aliases {
+			if alias == name {
+				copied := *t
+				return &copied, nil
+			}
+		}
+	}
+	return nil, oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(help.ErrNotFound)
+}
+
+func (s *stubHelpAdmin) List(_ context.Context, category string) ([]*help.Topic, error) {
+	var out []*help.Topic
+	for _, t := range s.topics {
+		if category == "" || t.Category == category {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubHelpAdmin) Save(_ context.Context, subject string, topic *help.Topic) error {
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	if err := topic.Validate(); err != nil {
+		return err
+	}
+	s.subjects = append(s.subjects, subject)
+	stored := *topic
+	s.topics[topic.Name] = &stored
+	return nil
+}
+
+func (s *stubHelpAdmin) Delete(_ context.Context, subject, name string) error {
+	name = help.NormalizeName(name)
+	if _, ok := s.topics[name]; !ok {
+		return oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(help.ErrNotFound)
+	}
+	s.subjects = append(s.subjects, subject)
+	delete(s.topics, name)
+	return nil
+}
+
+var helpEditCharID = ulid.Make()
+
+func runHelpEdit(t *testing.T, admin HelpAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   helpEditCharID,
+		CharacterName: "Staffer",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewHelpEditHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestHelpEditSetCreatesAndReplaces(t *testing.T) {
+	admin := newStubHelpAdmin()
+
+	out, err := runHelpEdit(t, admin, "set Combat = Swing first. = ask later")
+	require.NoError(t, err)
+	assert.Equal(t, "Saved help topic combat.\n", out)
+	require.Contains(t, admin.topics, "combat")
+	assert.Equal(t, "Swing first. = ask later", admin.topics["combat"].Body)
+	assert.Equal(t, []string{access.CharacterSubject(helpEditCharID.String())}, admin.subjects)
+
+	_, err = runHelpEdit(t, admin, "title combat = Combat Basics")
+	require.NoError(t, err)
+	_, err = runHelpEdit(t, admin, "set combat = Parry second.")
+	require.NoError(t, err)
+	assert.Equal(t, "Parry second.", admin.topics["combat"].Body)
+	assert.Equal(t, "Combat Basics", admin.topics["combat"].Title, "set keeps the other fields")
+}
+
+func TestHelpEditFieldsViaAlias(t *testing.T) {
+	admin := newStubHelpAdmin()
+	_, err := runHelpEdit(t, admin, "set combat = Swing first.")
+	require.NoError(t, err)
+
+	_, err = runHelpEdit(t, admin, "alias combat = Fight, fighting , ")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fight", "fighting"}, admin.topics["combat"].Aliases)
+
+	_, err = runHelpEdit(t, admin, "category fight = Rules")
+	require.NoError(t, err)
+	assert.Equal(t, "rules", admin.topics["combat"].Category)
+	assert.Len(t, admin.topics, 1)
+}
+
+func TestHelpEditFieldRequiresExistingTopic(t *testing.T) {
+	_, err := runHelpEdit(t, newStubHelpAdmin(), "title combat = Combat")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestHelpEditListAndDelete(t *testing.T) {
+	admin := newStubHelpAdmin()
+
+	out, err := runHelpEdit(t, admin, "list")
+	require.NoError(t, err)
+	assert.Equal(t, "No help topics.\n", out)
+
+	_, err = runHelpEdit(t, admin, "set combat = Swing first.")
+	require.NoError(t, err)
+	out, err = runHelpEdit(t, admin, "list general")
+	require.NoError(t, err)
+	assert.Contains(t, out, "combat")
+
+	out, err = runHelpEdit(t, admin, "delete Combat")
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted help topic combat.\n", out)
+	assert.Empty(t, admin.topics)
+
+	_, err = runHelpEdit(t, admin, "delete combat")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestHelpEditInvalidArgs(t *testing.T) {
+	for _, args := range []string{"set combat", "title = x", "delete"} {
+		_, err := runHelpEdit(t, newStubHelpAdmin(), args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+
+	out, err := runHelpEdit(t, newStubHelpAdmin(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: helpedit")
+}
+
+func TestHelpEditErrorMapping(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"invalid", oops.Code("HELP_INVALID_TOPIC").Errorf("body is required"), command.CodeWorldError},
+		{"conflict", oops.Code("HELP_NAME_CONFLICT").Wrap(help.ErrNameConflict), command.CodeWorldError},
+		{"denied", oops.Code("HELP_ACCESS_DENIED").Errorf("not permitted"), command.CodePermissionDenied},
+		{"store failure passes through", oops.Code("HELP_STORE_FAILED").Errorf("db down"), "HELP_STORE_FAILED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := newStubHelpAdmin()
+			admin.saveErr = tt.err
+			_, err := runHelpEdit(t, admin, "set combat = x")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestRegisterAdminHelpEdit(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("helpedit")
+	assert.False(t, found, "helpedit requires the Help dependency")
+
+	deps.Help = newStubHelpAdmin()
+	RegisterAdmin(reg, deps)
+	entry, found := reg.Get("helpedit")
+	require.True(t, found)
+	assert.Equal(t, []command.Capability{{Action: "write", Resource: "help", Scope: command.ScopeGlobal}}, entry.GetCapabilities())
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/jobs"
+)
+
+const (
+	jobCommandName = "job"
+	jobUsage       = "job kinds | list [active] | status <id> | start <kind> [json params] | cancel <id>"
+)
+
+// JobAdmin submits and tracks background jobs. This is the ISP interface
+// for the job admin command; *jobs.Queue satisfies it.
+type JobAdmin interface {
+	Kinds() []string
+	Submit(ctx context.Context, req jobs.SubmitRequest) (jobs.Job, error)
+	Get(ctx context.Context, id ulid.ULID) (jobs.Job, error)
+	List(ctx context.Context, filter jobs.ListFilter) ([]jobs.Job, error)
+	Cancel(ctx context.Context, id ulid.ULID) (jobs.Job, error)
+}
+
+// NewJobHandler creates a command handler that routes job subcommands.
+func NewJobHandler(admin JobAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleJob(ctx, exec, admin)
+	}
+}
+
+func handleJob(ctx context.Context, exec *command.CommandExecution, admin JobAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "kinds":
+		return handleJobKinds(ctx, exec, admin)
+	case "list":
+		if rest != "" && rest != "active" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(jobCommandName, "job list [active]")
+		}
+		return handleJobList(ctx, exec, admin, rest == "active")
+	case "status", "cancel":
+		id, err := parseJobID(sub, rest)
+		if err != nil {
+			return err
+		}
+		if sub == "status" {
+			return handleJobStatus(ctx, exec, admin, id)
+		}
+		return handleJobCancel(ctx, exec, admin, id)
+	case "start":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(jobCommandName, "job start <kind> [json params]")
+		}
+		return handleJobStart(ctx, exec, admin, rest)
+	default:
+		writeOutput(ctx, exec, jobCommandName, "Usage: "+jobUsage)
+		return nil
+	}
+}
+
+func parseJobID(sub, arg string) (ulid.ULID, error) {
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return ulid.ULID{}, command.ErrInvalidArgs(jobCommandName, "job "+sub+" <id>")
+	}
+	return id, nil
+}
+
+func handleJobKinds(ctx context.Context, exec *command.CommandExecution, admin JobAdmin) error {
+	kinds := admin.Kinds()
+	if len(kinds) == 0 {
+		writeOutput(ctx, exec, jobCommandName, "No job kinds are registered.")
+		return nil
+	}
+	writeOutput(ctx, exec, jobCommandName, "Job kinds: "+strings.Join(kinds, ", "))
+	return nil
+}
+
+func handleJobList(ctx context.Context, exec *command.CommandExecution, admin JobAdmin, active bool) error {
+	var filter jobs.ListFilter
+	if active {
+		filter.Statuses = []jobs.Status{jobs.StatusQueued, jobs.StatusRunning}
+	}
+	list, err := admin.List(ctx, filter)
+	if err != nil {
+		return jobError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, jobCommandName, "No jobs.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Jobs (newest first):")
+	for _, job := range list {
+		fmt.Fprintf(&sb, "\n  %s %-16s %-9s %3d%%  submitted %s",
+			job.ID, job.Kind, string(job.Status), job.Progress, formatScheduleTime(job.CreatedAt))
+	}
+	writeOutput(ctx, exec, jobCommandName, sb.String())
+	return nil
+}
+
+func handleJobStatus(ctx context.Context, exec *command.CommandExecution, admin JobAdmin, id ulid.ULID) error {
+	job, err := admin.Get(ctx, id)
+	if err != nil {
+		return jobError(err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Job: %s\n", job.ID)
+	fmt.Fprintf(&sb, "Kind: %s\n", job.Kind)
+	if string(job.Params) != "{}" {
+		fmt.Fprintf(&sb, "Params: %s\n", job.Params)
+	}
+	fmt.Fprintf(&sb, "Status: %s", job.Status)
+	if job.CancelRequested && job.Status == jobs.StatusRunning {
+		sb.WriteString(" (cancelling)")
+	}
+	sb.WriteString("\n")
+	if job.Status == jobs.StatusRunning || job.Progress > 0 {
+		fmt.Fprintf(&sb, "Progress: %d%%", job.Progress)
+		if job.ProgressMessage != "" {
+			fmt.Fprintf(&sb, " - %s", job.ProgressMessage)
+		}
+		sb.WriteString("\n")
+	}
+	if job.SubmittedBy != "" {
+		fmt.Fprintf(&sb, "Submitted by: %s\n", job.SubmittedBy)
+	}
+	fmt.Fprintf(&sb, "Submitted: %s\n", formatScheduleTime(job.CreatedAt))
+	if job.StartedAt != nil {
+		fmt.Fprintf(&sb, "Started: %s (attempt %d)\n", formatScheduleTime(*job.StartedAt), job.Attempts)
+	}
+	if job.FinishedAt != nil {
+		fmt.Fprintf(&sb, "Finished: %s\n", formatScheduleTime(*job.FinishedAt))
+	}
+	if job.Error != "" {
+		fmt.Fprintf(&sb, "Error: %s\n", job.Error)
+	}
+	if job.Result != "" {
+		fmt.Fprintf(&sb, "Result:\n%s", job.Result)
+	}
+	writeOutput(ctx, exec, jobCommandName, strings.TrimRight(sb.String(), "\n"))
+	return nil
+}
+
+func handleJobStart(ctx context.Context, exec *command.CommandExecution, admin JobAdmin, args string) error {
+	kind, params, _ := strings.Cut(args, " ")
+	job, err := admin.Submit(ctx, jobs.SubmitRequest{
+		Kind:        kind,
+		Params:      json.RawMessage(strings.TrimSpace(params)),
+		SubmittedBy: access.CharacterSubject(exec.CharacterID().String()),
+	})
+	if err != nil {
+		return jobError(err)
+	}
+	writeOutputf(ctx, exec, jobCommandName,
+		"Started job %s (%s). You will be notified when it finishes; check on it with: job status %s\n",
+		job.ID, job.Kind, job.ID)
+	return nil
+}
+
+func handleJobCancel(ctx context.Context, exec *command.CommandExecution, admin JobAdmin, id ulid.ULID) error {
+	job, err := admin.Cancel(ctx, id)
+	if err != nil {
+		return jobError(err)
+	}
+	if job.Status == jobs.StatusCancelled {
+		writeOutputf(ctx, exec, jobCommandName, "Job %s cancelled.\n", job.ID)
+		return nil
+	}
+	writeOutputf(ctx, exec, jobCommandName, "Job %s is stopping; it will be cancelled shortly.\n", job.ID)
+	return nil
+}
+
+// jobError surfaces the queue's validation and lookup failures to staff
+// verbatim; anything else falls through to the generic player message.
+// The cause is not wrapped: oops resolves the innermost code, which would
+// mask WORLD_ERROR.
+func jobError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "JOB_INVALID", "JOB_UNKNOWN_KIND", "JOB_NOT_FOUND", "JOB_FINISHED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/jobs"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubJobAdmin is a test implementation of JobAdmin.
+type stubJobAdmin struct {
+	jobs      map[ulid.ULID]jobs.Job
+	submitted []jobs.SubmitRequest
+	filters   []jobs.ListFilter
+	err       error
+}
+
+func newStubJobAdmin(list ...jobs.Job) *stubJobAdmin {
+	s := &stubJobAdmin{jobs: make(map[ulid.ULID]jobs.Job)}
+	for _, j := range list {
+		s.jobs[j.ID] = j
+	}
+	return s
+}
+
+func (s *stubJobAdmin) Kinds() []string { return []string{"export", jobs.KindWorldCheck} }
+
+func (s *stubJobAdmin) Submit(_ context.Context, req jobs.SubmitRequest) (jobs.Job, error) {
+	if s.err != nil {
+		return jobs.Job{}, s.err
+	}
+	s.submitted = append(s.submitted, req)
+	return jobs.Job{ID: ulid.MustParse("01HZ00000000000000000000J1"), Kind: req.Kind, Status: jobs.StatusQueued}, nil
+}
+
+func (s *stubJobAdmin) Get(_ context.Context, id ulid.ULID) (jobs.Job, error) {
+	job, ok := s.jobs[id]
+	if !ok {
+		return jobs.Job{}, oops.Code("JOB_NOT_FOUND").Errorf("no job %s", id)
+	}
+	return job, nil
+}
+
+func (s *stubJobAdmin) List(_ context.Context, filter jobs.ListFilter) ([]jobs.Job, error) {
+	s.filters = append(s.filters, filter)
+	list := make([]jobs.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		list = append(list, j)
+	}
+	return list, nil
+}
+
+func (s *stubJobAdmin) Cancel(_ context.Context, id ulid.ULID) (jobs.Job, error) {
+	job, ok := s.jobs[id]
+	if !ok {
+		return jobs.Job{}, oops.Code("JOB_NOT_FOUND").Errorf("no job %s", id)
+	}
+	if job.Status.Terminal() {
+		return jobs.Job{}, oops.Code("JOB_FINISHED").Errorf("job %s has already %s", id, job.Status)
+	}
+	job.CancelRequested = true
+	if job.Status == jobs.StatusQueued {
+		job.Status = jobs.StatusCancelled
+	}
+	s.jobs[id] = job
+	return job, nil
+}
+
+func runJob(t *testing.T, admin JobAdmin, charID ulid.ULID, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   charID,
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewJobHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func runningExportJob() jobs.Job {
+	started := time.Date(2026, 10, 17, 11, 0, 5, 0, time.UTC)
+	return jobs.Job{
+		ID:              ulid.MustParse("01HZ00000000000000000000J2"),
+		Kind:            "export",
+		Params:          json.RawMessage(`{"scene":"42"}`),
+		Status:          jobs.StatusRunning,
+		Progress:        40,
+		ProgressMessage: "writing logs",
+		Attempts:        1,
+		SubmittedBy:     access.CharacterSubject("01HZ0000000000000000000001"),
+		CreatedAt:       time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC),
+		StartedAt:       &started,
+	}
+}
+
+func TestJobStartSubmitsAsCharacter(t *testing.T) {
+	admin := newStubJobAdmin()
+	charID := ulid.Make()
+
+	out, err := runJob(t, admin, charID, `start export {"scene":"42"}`)
+	require.NoError(t, err)
+
+	require.Len(t, admin.submitted, 1)
+	assert.Equal(t, "export", admin.submitted[0].Kind)
+	assert.JSONEq(t, `{"scene":"42"}`, string(admin.submitted[0].Params))
+	assert.Equal(t, access.CharacterSubject(charID.String()), admin.submitted[0].SubmittedBy)
+	assert.Contains(t, out, "Started job 01HZ00000000000000000000J1 (export).")
+	assert.Contains(t, out, "job status 01HZ00000000000000000000J1")
+}
+
+func TestJobStartWithoutParams(t *testing.T) {
+	admin := newStubJobAdmin()
+
+	_, err := runJob(t, admin, ulid.Make(), "start world_check")
+	require.NoError(t, err)
+	require.Len(t, admin.submitted, 1)
+	assert.Empty(t, admin.submitted[0].Params)
+}
+
+func TestJobStartSurfacesValidationMessage(t *testing.T) {
+	admin := newStubJobAdmin()
+	admin.err = oops.Code("JOB_UNKNOWN_KIND").Errorf(`unknown job kind "import"`)
+
+	_, err := runJob(t, admin, ulid.Make(), "start import")
+	require.Error(t, err)
+	assert.Equal(t, `unknown job kind "import"`, command.PlayerMessage(err))
+}
+
+func TestJobStatusShowsProgress(t *testing.T) {
+	job := runningExportJob()
+	out, err := runJob(t, newStubJobAdmin(job), ulid.Make(), "status "+job.ID.String())
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "Kind: export")
+	assert.Contains(t, out, `Params: {"scene":"42"}`)
+	assert.Contains(t, out, "Status: running")
+	assert.Contains(t, out, "Progress: 40% - writing logs")
+	assert.Contains(t, out, "Started: 2026-10-17 11:00:05 UTC (attempt 1)")
+}
+
+func TestJobStatusShowsOutcome(t *testing.T) {
+	job := runningExportJob()
+	finished := time.Date(2026, 10, 17, 11, 3, 0, 0, time.UTC)
+	job.Status = jobs.StatusSucceeded
+	job.Progress = 100
+	job.FinishedAt = &finished
+	job.Result = "wrote 12 logs"
+
+	out, err := runJob(t, newStubJobAdmin(job), ulid.Make(), "status "+job.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Status: succeeded")
+	assert.Contains(t, out, "Finished: 2026-10-17 11:03:00 UTC")
+	assert.Contains(t, out, "Result:\nwrote 12 logs")
+}
+
+func TestJobStatusRejectsBadIDs(t *testing.T) {
+	_, err := runJob(t, newStubJobAdmin(), ulid.Make(), "status nope")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+
+	_, err = runJob(t, newStubJobAdmin(), ulid.Make(), "status "+ulid.Make().String())
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "no job")
+}
+
+func TestJobListFiltersActive(t *testing.T) {
+	admin := newStubJobAdmin(runningExportJob())
+
+	out, err := runJob(t, admin, ulid.Make(), "list active")
+	require.NoError(t, err)
+	assert.Contains(t, out, "01HZ00000000000000000000J2 export")
+	assert.Contains(t, out, "running")
+	require.Len(t, admin.filters, 1)
+	assert.Equal(t, []jobs.Status{jobs.StatusQueued, jobs.StatusRunning}, admin.filters[0].Statuses)
+
+	out, err = runJob(t, newStubJobAdmin(), ulid.Make(), "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No jobs.")
+
+	_, err = runJob(t, admin, ulid.Make(), "list everything")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestJobCancel(t *testing.T) {
+	running := runningExportJob()
+	queued := runningExportJob()
+	queued.ID = ulid.MustParse("01HZ00000000000000000000J3")
+	queued.Status = jobs.StatusQueued
+	admin := newStubJobAdmin(running, queued)
+
+	out, err := runJob(t, admin, ulid.Make(), "cancel "+queued.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Job 01HZ00000000000000000000J3 cancelled.")
+
+	out, err = runJob(t, admin, ulid.Make(), "cancel "+running.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "is stopping")
+
+	_, err = runJob(t, admin, ulid.Make(), "cancel "+queued.ID.String())
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "has already cancelled")
+}
+
+func TestJobKindsAndUsage(t *testing.T) {
+	out, err := runJob(t, newStubJobAdmin(), ulid.Make(), "kinds")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Job kinds: export, world_check")
+
+	out, err = runJob(t, newStubJobAdmin(), ulid.Make(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: "+jobUsage)
+}
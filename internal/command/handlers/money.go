@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/economy"
+)
+
+const (
+	moneyCommandName = "money"
+	moneyUsage       = "money | history [count] | pay <character> = <amount> [currency] [for <memo>] | mint|burn <character> = <amount> [currency] [for <memo>]"
+	moneyPayUsage    = "money pay <character> = <amount> [currency] [for <memo>]"
+	moneyIssueUsage  = "money mint|burn <character> = <amount> [currency] [for <memo>]"
+)
+
+// EconomyAdmin reads balances and moves money. This is the ISP interface
+// for the money command; *economy.Service satisfies it.
+type EconomyAdmin interface {
+	Currencies() []string
+	Balances(ctx context.Context, characterID ulid.ULID) ([]economy.Balance, error)
+	History(ctx context.Context, characterID ulid.ULID, limit int) ([]*economy.Transaction, error)
+	FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (economy.CharacterRef, error)
+	Transfer(ctx context.Context, subject string, req economy.TransferRequest) (*economy.Transaction, error)
+	Mint(ctx context.Context, subject string, req economy.IssueRequest) (*economy.Transaction, error)
+	Burn(ctx context.Context, subject string, req economy.IssueRequest) (*economy.Transaction, error)
+}
+
+// NewMoneyHandler creates a command handler that shows the caller's
+// balances and history, pays other characters, and routes the staff mint
+// and burn subcommands.
+func NewMoneyHandler(admin EconomyAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleMoney(ctx, exec, admin)
+	}
+}
+
+func handleMoney(ctx context.Context, exec *command.CommandExecution, admin EconomyAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "":
+		return handleMoneyBalances(ctx, exec, admin)
+	case "history":
+		return handleMoneyHistory(ctx, exec, admin, rest)
+	case "pay":
+		return handleMoneyPay(ctx, exec, admin, rest)
+	case "mint", "burn":
+		return handleMoneyIssue(ctx, exec, admin, sub, rest)
+	default:
+		writeOutput(ctx, exec, moneyCommandName, "Usage: "+moneyUsage)
+		return nil
+	}
+}
+
+func handleMoneyBalances(ctx context.Context, exec *command.CommandExecution, admin EconomyAdmin) error {
+	balances, err := admin.Balances(ctx, exec.CharacterID())
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	var sb strings.Builder
+	sb.WriteString("You have:")
+	for _, b := range balances {
+		fmt.Fprintf(&sb, "\n  %s", economy.FormatAmount(b.Amount, b.Currency))
+	}
+	writeOutput(ctx, exec, moneyCommandName, sb.String())
+	return nil
+}
+
+func handleMoneyHistory(ctx context.Context, exec *command.CommandExecution, admin EconomyAdmin, arg string) error {
+	limit := 0
+	if arg != "" {
+		n, err := strconv.Atoi(arg)
+		if err != nil || n <= 0 || n > economy.MaxHistoryLimit {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(moneyCommandName, fmt.Sprintf("money history [1-%d]", economy.MaxHistoryLimit))
+		}
+		limit = n
+	}
+	history, err := admin.History(ctx, exec.CharacterID(), limit)
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	if len(history) == 0 {
+		writeOutput(ctx, exec, moneyCommandName, "No transactions yet.")
+		return nil
+	}
+
+	own := economy.CharacterAccount(exec.CharacterID())
+	var sb strings.Builder
+	sb.WriteString("Recent transactions:")
+	for _, tx := range history {
+		fmt.Fprintf(&sb, "\n  %s  %s", formatScheduleTime(tx.CreatedAt), describeMoneyTransaction(tx, own))
+	}
+	writeOutput(ctx, exec, moneyCommandName, sb.String())
+	return nil
+}
+
+// describeMoneyTransaction renders tx from the side of account own, e.g.
+// "-30 credits to Bob (sword), balance 70 credits".
+func describeMoneyTransaction(tx *economy.Transaction, own economy.Account) string {
+	mine, _ := tx.Entry(own)
+	var other economy.Entry
+	for _, e := range tx.Entries {
+		if e.Account != own {
+			other = e
+		}
+	}
+	var line string
+	switch {
+	case tx.Kind == economy.KindMint:
+		line = fmt.Sprintf("+%s issued", economy.FormatAmount(tx.Amount, tx.Currency))
+	case tx.Kind == economy.KindBurn:
+		line = fmt.Sprintf("-%s removed", economy.FormatAmount(tx.Amount, tx.Currency))
+	case mine.Delta < 0:
+		line = fmt.Sprintf("-%s to %s", economy.FormatAmount(tx.Amount, tx.Currency), moneyEntryName(other))
+	default:
+		line = fmt.Sprintf("+%s from %s", economy.FormatAmount(tx.Amount, tx.Currency), moneyEntryName(other))
+	}
+	if tx.Memo != "" {
+		line += " (" + tx.Memo + ")"
+	}
+	return line + ", balance " + economy.FormatAmount(mine.BalanceAfter, tx.Currency)
+}
+
+func moneyEntryName(e economy.Entry) string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return "a deleted character"
+}
+
+func handleMoneyPay(ctx context.Context, exec *command.CommandExecution, admin EconomyAdmin, args string) error {
+	name, amount, currency, memo, ok := parseMoneyArgs(args)
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(moneyCommandName, moneyPayUsage)
+	}
+	to, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	from := economy.CharacterRef{ID: exec.CharacterID(), Name: exec.CharacterName()}
+	_, err = admin.Transfer(ctx, access.CharacterSubject(from.ID.String()), economy.TransferRequest{
+		From: from, To: to, Currency: currency, Amount: amount, Memo: memo,
+	})
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	// The currency_transfer event on the payer's own stream confirms the
+	// payment, so there is nothing more to say here.
+	return nil
+}
+
+func handleMoneyIssue(ctx context.Context, exec *command.CommandExecution, admin EconomyAdmin, action, args string) error {
+	name, amount, currency, memo, ok := parseMoneyArgs(args)
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(moneyCommandName, moneyIssueUsage)
+	}
+	target, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	subject := access.CharacterSubject(exec.CharacterID().String())
+	req := economy.IssueRequest{Character: target, Currency: currency, Amount: amount, Memo: memo}
+
+	var tx *economy.Transaction
+	verb := "Minted %s to %s."
+	if action == economy.ActionBurn {
+		tx, err = admin.Burn(ctx, subject, req)
+		verb = "Burned %s from %s."
+	} else {
+		tx, err = admin.Mint(ctx, subject, req)
+	}
+	if err != nil {
+		return moneyError(err, admin)
+	}
+	entry, _ := tx.Entry(economy.CharacterAccount(target.ID))
+	writeOutputf(ctx, exec, moneyCommandName, verb+" Their balance is %s.\n",
+		economy.FormatAmount(tx.Amount, tx.Currency), target.Name,
+		economy.FormatAmount(entry.BalanceAfter, tx.Currency))
+	return nil
+}
+
+// parseMoneyArgs parses "<character> = <amount> [currency] [for <memo>]".
+func parseMoneyArgs(args string) (name string, amount int64, currency, memo string, ok bool) {
+	name, rhs, found := strings.Cut(args, "=")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		return "", 0, "", "", false
+	}
+	fields := strings.Fields(rhs)
+	if len(fields) == 0 {
+		return "", 0, "", "", false
+	}
+	amount, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil || amount <= 0 {
+		return "", 0, "", "", false
+	}
+	fields = fields[1:]
+	if len(fields) > 0 && !strings.EqualFold(fields[0], "for") {
+		currency = fields[0]
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		if !strings.EqualFold(fields[0], "for") || len(fields) == 1 {
+			return "", 0, "", "", false
+		}
+		memo = strings.Join(fields[1:], " ")
+	}
+	return name, amount, currency, memo, true
+}
+
+// moneyError surfaces the economy service's validation and lookup failures
+// to the player verbatim; anything else falls through to the generic
+// player message. The cause is not wrapped: oops resolves the innermost
+// code, which would mask WORLD_ERROR.
+func moneyError(err error, admin EconomyAdmin) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "ECONOMY_INVALID_AMOUNT", "ECONOMY_INVALID_MEMO", "ECONOMY_INVALID_CURRENCY":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "ECONOMY_INVALID_TRANSFER":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You cannot pay yourself.", nil)
+	case "ECONOMY_UNKNOWN_CURRENCY":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no such currency. Currencies: "+strings.Join(admin.Currencies(), ", ")+".", nil)
+	case "ECONOMY_INSUFFICIENT_FUNDS":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is not enough money in the account.", nil)
+	case "ECONOMY_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No character by that name.", nil)
+	case "ECONOMY_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(moneyCommandName, "currency")
+	}
+	return err
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/economy"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubEconomyAdmin is a test implementation of EconomyAdmin.
+type stubEconomyAdmin struct {
+	characters map[string]economy.CharacterRef
+	balances   []economy.Balance
+	history    []*economy.Transaction
+	limit      int
+	transfers  []economy.TransferRequest
+	issues     []economy.IssueRequest
+	subjects   []string
+	err        error
+}
+
+func newStubEconomyAdmin() *stubEconomyAdmin {
+	return &stubEconomyAdmin{characters: map[string]economy.CharacterRef{
+		"bob": {ID: ulid.Make(), Name: "Bob"},
+	}}
+}
+
+func (s *stubEconomyAdmin) Currencies() []string { return []string{"credits", "gold"} }
+
+func (s *stubEconomyAdmin) Balances(context.Context, ulid.ULID) ([]economy.Balance, error) {
+	return s.balances, nil
+}
+
+func (s *stubEconomyAdmin) History(_ context.Context, _ ulid.ULID, limit int) ([]*economy.Transaction, error) {
+	s.limit = limit
+	return s.history, nil
+}
+
+func (s *stubEconomyAdmin) FindCharacter(_ context.Context, _ ulid.ULID, name string) (economy.CharacterRef, error) {
+	if ref, ok := s.characters[strings.ToLower(name)]; ok {
+		return ref, nil
+	}
+	return economy.CharacterRef{}, oops.Code("ECONOMY_NOT_FOUND").Wrap(economy.ErrNotFound)
+}
+
+func (s *stubEconomyAdmin) Transfer(_ context.Context, subject string, req economy.TransferRequest) (*economy.Transaction, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.subjects = append(s.subjects, subject)
+	s.transfers = append(s.transfers, req)
+	return economy.NewTransaction(ulid.Make(), economy.KindTransfer, economy.CharacterAccount(req.From.ID),
+		economy.CharacterAccount(req.To.ID), "credits", req.Amount, req.Memo, subject, time.Now())
+}
+
+func (s *stubEconomyAdmin) Mint(_ context.Context, subject string, req economy.IssueRequest) (*economy.Transaction, error) {
+	return s.issue(economy.KindMint, economy.Treasury, economy.CharacterAccount(req.Character.ID), subject, req)
+}
+
+func (s *stubEconomyAdmin) Burn(_ context.Context, subject string, req economy.IssueRequest) (*economy.Transaction, error) {
+	return s.issue(economy.KindBurn, economy.CharacterAccount(req.Character.ID), economy.Treasury, subject, req)
+}
+
+func (s *stubEconomyAdmin) issue(kind economy.Kind, from, to economy.Account, subject string, req economy.IssueRequest) (*economy.Transaction, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.subjects = append(s.subjects, subject)
+	s.issues = append(s.issues, req)
+	tx, err := economy.NewTransaction(ulid.Make(), kind, from, to, "credits", req.Amount, req.Memo, subject, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	tx.Entries[0].BalanceAfter = 7
+	tx.Entries[1].BalanceAfter = 7
+	return tx, nil
+}
+
+var moneyCharID = ulid.Make()
+
+func runMoney(t *testing.T, admin EconomyAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   moneyCharID,
+		CharacterName: "Alice",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewMoneyHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestMoneyBalances(t *testing.T) {
+	admin := newStubEconomyAdmin()
+	admin.balances = []economy.Balance{{Currency: "credits", Amount: 70}, {Currency: "gold", Amount: 0}}
+
+	out, err := runMoney(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "You have:\n  70 credits\n  0 gold\n", out)
+}
+
+func TestMoneyPay(t *testing.T) {
+	admin := newStubEconomyAdmin()
+
+	out, err := runMoney(t, admin, "pay bob = 30 gold for the old sword")
+	require.NoError(t, err)
+	assert.Empty(t, out, "the transfer event confirms the payment")
+	require.Len(t, admin.transfers, 1)
+	assert.Equal(t, economy.TransferRequest{
+		From:     economy.CharacterRef{ID: moneyCharID, Name: "Alice"},
+		To:       admin.characters["bob"],
+		Currency: "gold",
+		Amount:   30,
+		Memo:     "the old sword",
+	}, admin.transfers[0])
+	assert.Equal(t, []string{access.CharacterSubject(moneyCharID.String())}, admin.subjects)
+
+	_, err = runMoney(t, admin, "pay Bob = 5 for rent")
+	require.NoError(t, err)
+	assert.Empty(t, admin.transfers[1].Currency)
+	assert.Equal(t, "rent", admin.transfers[1].Memo)
+}
+
+func TestMoneyPayRejectsMalformedArgs(t *testing.T) {
+	admin := newStubEconomyAdmin()
+	for _, args := range []string{"pay", "pay bob", "pay = 5", "pay bob = ", "pay bob = five", "pay bob = -5", "pay bob = 5 gold rent", "pay bob = 5 for"} {
+		_, err := runMoney(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+	assert.Empty(t, admin.transfers)
+}
+
+func TestMoneyPayErrors(t *testing.T) {
+	admin := newStubEconomyAdmin()
+
+	_, err := runMoney(t, admin, "pay nobody = 5")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "No character by that name")
+
+	admin.err = oops.Code("ECONOMY_INSUFFICIENT_FUNDS").Wrap(economy.ErrInsufficientFunds)
+	_, err = runMoney(t, admin, "pay bob = 500")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "not enough money")
+
+	admin.err = oops.Code("ECONOMY_UNKNOWN_CURRENCY").Errorf("unknown")
+	_, err = runMoney(t, admin, "pay bob = 5 zorkmids")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "Currencies: credits, gold.")
+}
+
+func TestMoneyMintAndBurn(t *testing.T) {
+	admin := newStubEconomyAdmin()
+
+	out, err := runMoney(t, admin, "mint Bob = 7 for prize")
+	require.NoError(t, err)
+	assert.Equal(t, "Minted 7 credits to Bob. Their balance is 7 credits.\n", out)
+	require.Len(t, admin.issues, 1)
+	assert.Equal(t, economy.IssueRequest{Character: admin.characters["bob"], Amount: 7, Memo: "prize"}, admin.issues[0])
+
+	out, err = runMoney(t, admin, "burn bob = 3")
+	require.NoError(t, err)
+	assert.Equal(t, "Burned 3 credits from Bob. Their balance is 7 credits.\n", out)
+
+	admin.err = oops.Code("ECONOMY_ACCESS_DENIED").Errorf("denied")
+	_, err = runMoney(t, admin, "mint bob = 1")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestMoneyHistory(t *testing.T) {
+	admin := newStubEconomyAdmin()
+
+	out, err := runMoney(t, admin, "history")
+	require.NoError(t, err)
+	assert.Equal(t, "No transactions yet.\n", out)
+	assert.Zero(t, admin.limit)
+
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	own := economy.CharacterAccount(moneyCharID)
+	bob := economy.CharacterAccount(admin.characters["bob"].ID)
+	admin.history = []*economy.Transaction{
+		{Kind: economy.KindTransfer, Currency: "credits", Amount: 30, Memo: "sword", CreatedAt: at, Entries: []economy.Entry{
+			{Account: own, Delta: -30, BalanceAfter: 70, Name: "Alice"},
+			{Account: bob, Delta: 30, BalanceAfter: 30, Name: "Bob"},
+		}},
+		{Kind: economy.KindMint, Currency: "credits", Amount: 100, CreatedAt: at, Entries: []economy.Entry{
+			{Account: economy.Treasury, Delta: -100},
+			{Account: own, Delta: 100, BalanceAfter: 100, Name: "Alice"},
+		}},
+	}
+	out, err = runMoney(t, admin, "history 5")
+	require.NoError(t, err)
+	assert.Equal(t, 5, admin.limit)
+	assert.Equal(t, "Recent transactions:\n"+
+		"  2026-03-01 12:00:00 UTC  -30 credits to Bob (sword), balance 70 credits\n"+
+		"  2026-03-01 12:00:00 UTC  +100 credits issued, balance 100 credits\n", out)
+
+	_, err = runMoney(t, admin, "history lots")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestMoneyUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runMoney(t, newStubEconomyAdmin(), "steal bob")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+moneyUsage+"\n", out)
+}
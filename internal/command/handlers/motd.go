@@ -0,0 +1,374 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/motd"
+)
+
+const (
+	motdCommandName   = "motd"
+	motdUsage         = "motd | ack [<id>] | set <text> | clear | screen list|show|set|delete | announce [--ack] [--in <duration>] [--for <duration>] = <text> | wall [--ack] [--for <duration>] = <text> | announcements | cancel <id>"
+	motdScreenUsage   = "motd screen list | show <name> | set <name> = <text> | delete <name>"
+	motdAnnounceUsage = "motd announce [--ack] [--in <duration>] [--for <duration>] = <text>"
+	motdWallUsage     = "motd wall [--ack] [--for <duration>] = <text>"
+)
+
+// MOTDAdmin reads and edits connect screens, the message of the day, and
+// announcements. This is the ISP interface for the motd command;
+// *motd.Service satisfies it.
+type MOTDAdmin interface {
+	Current(ctx context.Context) (*motd.Notice, error)
+	SetMessage(ctx context.Context, subject, body string) error
+	ClearMessage(ctx context.Context, subject string) error
+	Screens(ctx context.Context) ([]*motd.Screen, error)
+	Screen(ctx context.Context, name string) (*motd.Screen, error)
+	SaveScreen(ctx context.Context, subject, name, body string) error
+	DeleteScreen(ctx context.Context, subject, name string) error
+	Announce(ctx context.Context, subject, body string, startsAt, endsAt time.Time, requiresAck bool) (*motd.Announcement, error)
+	Wall(ctx context.Context, subject, body string, endsAt time.Time, requiresAck bool) (*motd.Announcement, error)
+	Announcements(ctx context.Context) ([]*motd.Announcement, error)
+	CancelAnnouncement(ctx context.Context, subject string, id ulid.ULID) error
+	Acknowledge(ctx context.Context, playerID, id ulid.ULID) error
+	Pending(ctx context.Context, playerID ulid.ULID) ([]*motd.Announcement, error)
+	AckCounts(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error)
+	Locale(ctx context.Context, playerID ulid.ULID) string
+}
+
+// NewMOTDHandler creates a command handler that shows the message of the
+// day and routes the motd edit subcommands.
+func NewMOTDHandler(admin MOTDAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleMOTD(ctx, exec, admin)
+	}
+}
+
+func handleMOTD(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch sub {
+	case "":
+		return handleMOTDShow(ctx, exec, admin)
+	case "set":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(motdCommandName, "motd set <text>")
+		}
+		if err := admin.SetMessage(ctx, subject, rest); err != nil {
+			return motdError(err)
+		}
+		writeOutput(ctx, exec, motdCommandName, "Message of the day set.")
+		return nil
+	case "clear":
+		if err := admin.ClearMessage(ctx, subject); err != nil {
+			return motdError(err)
+		}
+		writeOutput(ctx, exec, motdCommandName, "Message of the day cleared.")
+		return nil
+	case "screen":
+		return handleMOTDScreen(ctx, exec, admin, subject, rest)
+	case "ack":
+		return handleMOTDAck(ctx, exec, admin, rest)
+	case "announce":
+		return handleMOTDAnnounce(ctx, exec, admin, subject, rest)
+	case "wall":
+		return handleMOTDWall(ctx, exec, admin, subject, rest)
+	case "announcements":
+		return handleMOTDAnnouncements(ctx, exec, admin)
+	case "cancel":
+		return handleMOTDCancel(ctx, exec, admin, subject, rest)
+	default:
+		writeOutput(ctx, exec, motdCommandName, "Usage: "+motdUsage)
+		return nil
+	}
+}
+
+func handleMOTDShow(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin) error {
+	notice, err := admin.Current(ctx)
+	if err != nil {
+		return motdError(err)
+	}
+	if notice.Empty() {
+		writeOutput(ctx, exec, motdCommandName, "There is no message of the day.")
+		return nil
+	}
+	writeOutput(ctx, exec, motdCommandName, motd.NoticeText(notice, admin.Locale(ctx, exec.PlayerID())))
+	return nil
+}
+
+func handleMOTDScreen(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin, subject, args string) error {
+	sub, rest, _ := strings.Cut(args, " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "list":
+		screens, err := admin.Screens(ctx)
+		if err != nil {
+			return motdError(err)
+		}
+		if len(screens) == 0 {
+			writeOutput(ctx, exec, motdCommandName, "No connect screens; the built-in banner is shown.")
+			return nil
+		}
+		var sb strings.Builder
+		sb.WriteString("Connect screens:")
+		for _, s := range screens {
+			fmt.Fprintf(&sb, "\n  %-24s updated %s by %s", s.Name, formatScheduleTime(s.UpdatedAt), s.UpdatedBy)
+		}
+		writeOutput(ctx, exec, motdCommandName, sb.String())
+		return nil
+	case "show":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(motdCommandName, "motd screen show <name>")
+		}
+		screen, err := admin.Screen(ctx, rest)
+		if err != nil {
+			return motdError(err)
+		}
+		writeOutput(ctx, exec, motdCommandName, screen.Body)
+		return nil
+	case "set":
+		name, body, ok := strings.Cut(rest, "=")
+		name = strings.TrimSpace(name)
+		body = strings.TrimSpace(body)
+		if !ok || name == "" || body == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(motdCommandName, "motd screen set <name> = <text>")
+		}
+		if err := admin.SaveScreen(ctx, subject, name, body); err != nil {
+			return motdError(err)
+		}
+		writeOutputf(ctx, exec, motdCommandName, "Saved connect screen %s.\n", motd.NormalizeScreenName(name))
+		return nil
+	case "delete":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(motdCommandName, "motd screen delete <name>")
+		}
+		if err := admin.DeleteScreen(ctx, subject, rest); err != nil {
+			return motdError(err)
+		}
+		writeOutputf(ctx, exec, motdCommandName, "Deleted connect screen %s.\n", motd.NormalizeScreenName(rest))
+		return nil
+	default:
+		writeOutput(ctx, exec, motdCommandName, "Usage: "+motdScreenUsage)
+		return nil
+	}
+}
+
+func handleMOTDAnnounce(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin, subject, args string) error {
+	delay, length, requiresAck, body, err := parseMOTDAnnounce(args, motdAnnounceUsage)
+	if err != nil {
+		return err
+	}
+	var startsAt, endsAt time.Time
+	if delay > 0 {
+		startsAt = time.Now().Add(delay)
+	}
+	if length > 0 {
+		start := startsAt
+		if start.IsZero() {
+			start = time.Now()
+		}
+		endsAt = start.Add(length)
+	}
+
+	a, err := admin.Announce(ctx, subject, body, startsAt, endsAt, requiresAck)
+	if err != nil {
+		return motdError(err)
+	}
+	writeOutputf(ctx, exec, motdCommandName, "Announcement %s runs %s to %s.\n",
+		a.ID, formatScheduleTime(a.StartsAt), formatScheduleTime(a.EndsAt))
+	return nil
+}
+
+func handleMOTDWall(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin, subject, args string) error {
+	delay, length, requiresAck, body, err := parseMOTDAnnounce(args, motdWallUsage)
+	if err != nil {
+		return err
+	}
+	if delay > 0 {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(motdCommandName, motdWallUsage)
+	}
+	var endsAt time.Time
+	if length > 0 {
+		endsAt = time.Now().Add(length)
+	}
+
+	a, err := admin.Wall(ctx, subject, body, endsAt, requiresAck)
+	if err != nil {
+		return motdError(err)
+	}
+	writeOutputf(ctx, exec, motdCommandName, "Announcement %s sent; shown at login until %s.\n",
+		a.ID, formatScheduleTime(a.EndsAt))
+	return nil
+}
+
+// parseMOTDAnnounce parses "[--ack] [--in <duration>] [--for <duration>] =
+// <text>", reporting usage on failure. Durations take parseBanDuration
+// syntax, so "2h" and "7d" both work.
+func parseMOTDAnnounce(args, usage string) (delay, length time.Duration, requiresAck bool, body string, err error) {
+	head, body, ok := strings.Cut(args, "=")
+	body = strings.TrimSpace(body)
+	if !ok || body == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return 0, 0, false, "", command.ErrInvalidArgs(motdCommandName, usage)
+	}
+
+	fields := strings.Fields(head)
+	for i := 0; i < len(fields); i++ {
+		if fields[i] == "--ack" {
+			requiresAck = true
+			continue
+		}
+		if i+1 == len(fields) {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return 0, 0, false, "", command.ErrInvalidArgs(motdCommandName, usage)
+		}
+		d, parseErr := parseBanDuration(fields[i+1])
+		if parseErr != nil {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return 0, 0, false, "", command.ErrInvalidArgs(motdCommandName, usage)
+		}
+		switch fields[i] {
+		case "--in":
+			delay = d
+		case "--for":
+			length = d
+		default:
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return 0, 0, false, "", command.ErrInvalidArgs(motdCommandName, usage)
+		}
+		i++
+	}
+	return delay, length, requiresAck, body, nil
+}
+
+// handleMOTDAck acknowledges an announcement for the calling player, or
+// lists the ones still waiting when no ID is given. Anyone may acknowledge.
+func handleMOTDAck(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin, arg string) error {
+	playerID := exec.PlayerID()
+	if playerID == (ulid.ULID{}) {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("Only players can acknowledge announcements.", nil)
+	}
+	if arg == "" {
+		pending, err := admin.Pending(ctx, playerID)
+		if err != nil {
+			return motdError(err)
+		}
+		if len(pending) == 0 {
+			writeOutput(ctx, exec, motdCommandName, "No announcements are waiting for your acknowledgment.")
+			return nil
+		}
+		var sb strings.Builder
+		sb.WriteString("Waiting for your acknowledgment:")
+		for _, a := range pending {
+			fmt.Fprintf(&sb, "\n  %s  %s", a.ID, a.Body)
+		}
+		writeOutput(ctx, exec, motdCommandName, sb.String())
+		return nil
+	}
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%q is not an announcement ID; see motd ack.", arg), nil)
+	}
+	if err := admin.Acknowledge(ctx, playerID, id); err != nil {
+		return motdError(err)
+	}
+	writeOutputf(ctx, exec, motdCommandName, "Acknowledged announcement %s.\n", id)
+	return nil
+}
+
+func handleMOTDAnnouncements(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin) error {
+	list, err := admin.Announcements(ctx)
+	if err != nil {
+		return motdError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, motdCommandName, "No announcements scheduled.")
+		return nil
+	}
+
+	var ackIDs []ulid.ULID
+	for _, a := range list {
+		if a.RequiresAck {
+			ackIDs = append(ackIDs, a.ID)
+		}
+	}
+	var acks map[ulid.ULID]int
+	if len(ackIDs) > 0 {
+		if acks, err = admin.AckCounts(ctx, ackIDs); err != nil {
+			return motdError(err)
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Announcements:")
+	for _, a := range list {
+		fmt.Fprintf(&sb, "\n  %s  %s to %s  by %s",
+			a.ID, formatScheduleTime(a.StartsAt), formatScheduleTime(a.EndsAt), a.CreatedBy)
+		if a.RequiresAck {
+			fmt.Fprintf(&sb, "  [ack required, %d acknowledged]", acks[a.ID])
+		}
+		fmt.Fprintf(&sb, "\n    %s", a.Body)
+	}
+	writeOutput(ctx, exec, motdCommandName, sb.String())
+	return nil
+}
+
+func handleMOTDCancel(ctx context.Context, exec *command.CommandExecution, admin MOTDAdmin, subject, arg string) error {
+	if arg == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(motdCommandName, "motd cancel <id>")
+	}
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%q is not an announcement ID; see motd announcements.", arg), nil)
+	}
+	if err := admin.CancelAnnouncement(ctx, subject, id); err != nil {
+		return motdError(err)
+	}
+	writeOutputf(ctx, exec, motdCommandName, "Cancelled announcement %s.\n", id)
+	return nil
+}
+
+// motdError surfaces the motd service's validation and lookup failures to
+// staff verbatim; anything else falls through to the generic player
+// message. The cause is not wrapped: oops resolves the innermost code,
+// which would mask WORLD_ERROR.
+func motdError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "MOTD_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "MOTD_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("Nothing by that name or ID; see motd screen list or motd announcements.", nil)
+	case "MOTD_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(motdCommandName, "motd")
+	}
+	return err
+}
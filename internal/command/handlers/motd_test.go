@@ -0,0 +1,400 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/motd"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubMOTDAdmin is a test implementation of MOTDAdmin.
+type stubMOTDAdmin struct {
+	message       *motd.Message
+	screens       map[string]*motd.Screen
+	announcements []*motd.Announcement
+	subjects      []string
+	err           error
+	startsAt      time.Time
+	endsAt        time.Time
+	walled        bool
+	acks          map[ulid.ULID][]ulid.ULID
+}
+
+func newStubMOTDAdmin() *stubMOTDAdmin {
+	return &stubMOTDAdmin{screens: map[string]*motd.Screen{}, acks: map[ulid.ULID][]ulid.ULID{}}
+}
+
+func (s *stubMOTDAdmin) Current(context.Context) (*motd.Notice, error) {
+	return &motd.Notice{Message: s.message, Announcements: s.announcements}, nil
+}
+
+func (s *stubMOTDAdmin) SetMessage(_ context.Context, subject, body string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.subjects = append(s.subjects, subject)
+	s.message = &motd.Message{Body: body, UpdatedBy: subject}
+	return nil
+}
+
+func (s *stubMOTDAdmin) ClearMessage(_ context.Context, subject string) error {
+	if s.message == nil {
+		return oops.Code("MOTD_NOT_FOUND").Wrap(motd.ErrNotFound)
+	}
+	s.subjects = append(s.subjects, subject)
+	s.message = nil
+	return nil
+}
+
+func (s *stubMOTDAdmin) Screens(context.Context) ([]*motd.Screen, error) {
+	var out []*motd.Screen
+	for _, screen := range s.screens {
+		out = append(out, screen)
+	}
+	return out, nil
+}
+
+func (s *stubMOTDAdmin) Screen(_ context.Context, name string) (*motd.Screen, error) {
+	if screen, ok := s.screens[motd.NormalizeScreenName(name)]; ok {
+		return screen, nil
+	}
+	return nil, oops.Code("MOTD_NOT_FOUND").Wrap(motd.ErrNotFound)
+}
+
+func (s *stubMOTDAdmin) SaveScreen(_ context.Context, subject, name, body string) error {
+	if s.err != nil {
+		return s.err
+	}
+	name = motd.NormalizeScreenName(name)
+	if err := motd.ValidateScreenName(name); err != nil {
+		return err
+	}
+	s.subjects = append(s.subjects, subject)
+	s.screens[name] = &motd.Screen{Name: name, Body: body, UpdatedBy: subject}
+	return nil
+}
+
+func (s *stubMOTDAdmin) DeleteScreen(_ context.Context, subject, name string) error {
+	name = motd.NormalizeScreenName(name)
+	if _, ok := s.screens[name]; !ok {
+		return oops.Code("MOTD_NOT_FOUND").Wrap(motd.ErrNotFound)
+	}
+	s.subjects = append(s.subjects, subject)
+	delete(s.screens, name)
+	return nil
+}
+
+func (s *stubMOTDAdmin) Announce(_ context.Context, subject, body string, startsAt, endsAt time.Time, requiresAck bool) (*motd.Announcement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.startsAt, s.endsAt = startsAt, endsAt
+	now := time.Now()
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if endsAt.IsZero() {
+		endsAt = startsAt.Add(motd.DefaultAnnouncementDuration)
+	}
+	a := &motd.Announcement{ID: ulid.Make(), Body: body, StartsAt: startsAt, EndsAt: endsAt, CreatedBy: subject, RequiresAck: requiresAck}
+	s.announcements = append(s.announcements, a)
+	return a, nil
+}
+
+func (s *stubMOTDAdmin) Wall(ctx context.Context, subject, body string, endsAt time.Time, requiresAck bool) (*motd.Announcement, error) {
+	s.walled = true
+	return s.Announce(ctx, subject, body, time.Time{}, endsAt, requiresAck)
+}
+
+func (s *stubMOTDAdmin) Acknowledge(_ context.Context, playerID, id ulid.ULID) error {
+	for _, a := range s.announcements {
+		if a.ID != id {
+			continue
+		}
+		if !a.RequiresAck {
+			return oops.Code("MOTD_INVALID").Errorf("announcement %s does not need acknowledging", id)
+		}
+		s.acks[id] = append(s.acks[id], playerID)
+		return nil
+	}
+	return oops.Code("MOTD_NOT_FOUND").Wrap(motd.ErrNotFound)
+}
+
+func (s *stubMOTDAdmin) Pending(_ context.Context, playerID ulid.ULID) ([]*motd.Announcement, error) {
+	var out []*motd.Announcement
+	for _, a := range s.announcements {
+		if a.RequiresAck && !slices.Contains(s.acks[a.ID], playerID) {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubMOTDAdmin) AckCounts(_ context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error) {
+	out := map[ulid.ULID]int{}
+	for _, id := range ids {
+		if n := len(s.acks[id]); n > 0 {
+			out[id] = n
+		}
+	}
+	return out, nil
+}
+
+func (s *stubMOTDAdmin) Locale(context.Context, ulid.ULID) string {
+	return "en"
+}
+
+func (s *stubMOTDAdmin) Announcements(context.Context) ([]*motd.Announcement, error) {
+	return s.announcements, nil
+}
+
+func (s *stubMOTDAdmin) CancelAnnouncement(_ context.Context, subject string, id ulid.ULID) error {
+	for i, a := range s.announcements {
+		if a.ID == id {
+			s.subjects = append(s.subjects, subject)
+			s.announcements = append(s.announcements[:i], s.announcements[i+1:]...)
+			return nil
+		}
+	}
+	return oops.Code("MOTD_NOT_FOUND").Wrap(motd.ErrNotFound)
+}
+
+var (
+	motdCharID   = ulid.Make()
+	motdPlayerID = ulid.Make()
+)
+
+func runMOTD(t *testing.T, admin MOTDAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   motdCharID,
+		PlayerID:      motdPlayerID,
+		CharacterName: "Staffer",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewMOTDHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestMOTDShow(t *testing.T) {
+	admin := newStubMOTDAdmin()
+
+	out, err := runMOTD(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "There is no message of the day.\n", out)
+
+	admin.message = &motd.Message{Body: "Welcome %xhback%xn!", UpdatedAt: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)}
+	out, err = runMOTD(t, admin, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Message of the Day")
+	assert.Contains(t, out, "Welcome back!")
+	assert.NotContains(t, out, "\x1b[")
+}
+
+func TestMOTDSetAndClear(t *testing.T) {
+	admin := newStubMOTDAdmin()
+
+	out, err := runMOTD(t, admin, "set Welcome = everyone")
+	require.NoError(t, err)
+	assert.Equal(t, "Message of the day set.\n", out)
+	require.NotNil(t, admin.message)
+	assert.Equal(t, "Welcome = everyone", admin.message.Body)
+	assert.Equal(t, []string{access.CharacterSubject(motdCharID.String())}, admin.subjects)
+
+	out, err = runMOTD(t, admin, "clear")
+	require.NoError(t, err)
+	assert.Equal(t, "Message of the day cleared.\n", out)
+	assert.Nil(t, admin.message)
+
+	_, err = runMOTD(t, admin, "clear")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestMOTDScreens(t *testing.T) {
+	admin := newStubMOTDAdmin()
+
+	out, err := runMOTD(t, admin, "screen list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No connect screens")
+
+	out, err = runMOTD(t, admin, "screen set Winter = %xcSnow%xn")
+	require.NoError(t, err)
+	assert.Equal(t, "Saved connect screen winter.\n", out)
+	assert.Equal(t, "%xcSnow%xn", admin.screens["winter"].Body)
+
+	out, err = runMOTD(t, admin, "screen list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "winter")
+
+	out, err = runMOTD(t, admin, "screen show winter")
+	require.NoError(t, err)
+	assert.Equal(t, "%xcSnow%xn\n", out, "show prints the source so staff can edit it")
+
+	out, err = runMOTD(t, admin, "screen delete Winter")
+	require.NoError(t, err)
+	assert.Equal(t, "Deleted connect screen winter.\n", out)
+	assert.Empty(t, admin.screens)
+
+	_, err = runMOTD(t, admin, "screen show winter")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runMOTD(t, admin, "screen set bad name = x")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestMOTDAnnounce(t *testing.T) {
+	admin := newStubMOTDAdmin()
+
+	out, err := runMOTD(t, admin, "announce = Reboot soon")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Announcement ")
+	assert.True(t, admin.startsAt.IsZero(), "no --in starts now")
+	assert.True(t, admin.endsAt.IsZero(), "no --for uses the default length")
+
+	before := time.Now()
+	_, err = runMOTD(t, admin, "announce --in 1h --for 2d = Event")
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(time.Hour), admin.startsAt, time.Minute)
+	assert.Equal(t, 48*time.Hour, admin.endsAt.Sub(admin.startsAt))
+
+	_, err = runMOTD(t, admin, "announce --for 30m = Short")
+	require.NoError(t, err)
+	assert.True(t, admin.startsAt.IsZero())
+	assert.WithinDuration(t, before.Add(30*time.Minute), admin.endsAt, time.Minute)
+
+	out, err = runMOTD(t, admin, "announcements")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Reboot soon")
+	assert.Contains(t, out, "Event")
+
+	id := admin.announcements[0].ID
+	out, err = runMOTD(t, admin, "cancel "+id.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Cancelled announcement "+id.String()+".\n", out)
+	assert.Len(t, admin.announcements, 2)
+
+	_, err = runMOTD(t, admin, "cancel "+id.String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runMOTD(t, admin, "cancel nope")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestMOTDWallAndAck(t *testing.T) {
+	admin := newStubMOTDAdmin()
+
+	out, err := runMOTD(t, admin, "ack")
+	require.NoError(t, err)
+	assert.Equal(t, "No announcements are waiting for your acknowledgment.\n", out)
+
+	before := time.Now()
+	out, err = runMOTD(t, admin, "wall --ack --for 7d = New conduct policy")
+	require.NoError(t, err)
+	assert.True(t, admin.walled)
+	require.Len(t, admin.announcements, 1)
+	policy := admin.announcements[0]
+	assert.True(t, policy.RequiresAck)
+	assert.WithinDuration(t, before.Add(7*24*time.Hour), admin.endsAt, time.Minute)
+	assert.Contains(t, out, "Announcement "+policy.ID.String()+" sent")
+
+	_, err = runMOTD(t, admin, "announce --ack --in 1h = Later")
+	require.NoError(t, err)
+	_, err = runMOTD(t, admin, "wall = Reboot now")
+	require.NoError(t, err)
+	reboot := admin.announcements[2]
+	assert.False(t, reboot.RequiresAck)
+
+	out, err = runMOTD(t, admin, "ack")
+	require.NoError(t, err)
+	assert.Contains(t, out, policy.ID.String())
+	assert.NotContains(t, out, reboot.ID.String())
+
+	out, err = runMOTD(t, admin, "ack "+strings.ToLower(policy.ID.String()))
+	require.NoError(t, err)
+	assert.Equal(t, "Acknowledged announcement "+policy.ID.String()+".\n", out)
+	assert.Equal(t, []ulid.ULID{motdPlayerID}, admin.acks[policy.ID])
+
+	out, err = runMOTD(t, admin, "announcements")
+	require.NoError(t, err)
+	assert.Contains(t, out, "[ack required, 1 acknowledged]")
+	assert.Contains(t, out, "[ack required, 0 acknowledged]")
+
+	_, err = runMOTD(t, admin, "ack "+reboot.ID.String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runMOTD(t, admin, "ack nope")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestMOTDInvalidArgs(t *testing.T) {
+	for _, args := range []string{
+		"set", "screen show", "screen set winter", "screen set = x", "screen delete",
+		"announce", "announce Reboot", "announce --in = x", "announce --at 1h = x", "announce --for soon = x", "cancel",
+		"wall", "wall Reboot", "wall --in 1h = x", "wall --for = x",
+	} {
+		_, err := runMOTD(t, newStubMOTDAdmin(), args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+
+	out, err := runMOTD(t, newStubMOTDAdmin(), "bogus")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: motd")
+	out, err = runMOTD(t, newStubMOTDAdmin(), "screen")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: motd screen")
+}
+
+func TestMOTDErrorMapping(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"invalid", oops.Code("MOTD_INVALID").Errorf("text is required"), command.CodeWorldError},
+		{"denied", oops.Code("MOTD_ACCESS_DENIED").Errorf("not permitted"), command.CodePermissionDenied},
+		{"store failure passes through", oops.Code("MOTD_STORE_FAILED").Errorf("db down"), "MOTD_STORE_FAILED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := newStubMOTDAdmin()
+			admin.err = tt.err
+			_, err := runMOTD(t, admin, "set hi")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestRegisterAdminMOTD(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("motd")
+	assert.False(t, found, "motd requires the MOTD dependency")
+
+	deps.MOTD = newStubMOTDAdmin()
+	RegisterAdmin(reg, deps)
+	entry, found := reg.Get("motd")
+	require.True(t, found)
+	assert.Empty(t, entry.GetCapabilities(), "reading the motd needs no capability; edits are checked by the service")
+}
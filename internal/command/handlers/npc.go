@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/npc"
+)
+
+const (
+	npcCommandName   = "npc"
+	npcUsage         = "npc [list] | spawn <name>[=<description>] | despawn <name> | show <name> | behavior <name>=[<plugin>:<event>[,<event>...] ...] | wander <name>=<min>-<max> [<zone>] | wander <name>=off"
+	npcSpawnUsage    = "npc spawn <name>[=<description>]"
+	npcBehaviorUsage = "npc behavior <name>=[<plugin>:<event>[,<event>...] ...]"
+	npcWanderUsage   = "npc wander <name>=<min>-<max> [<zone>] | npc wander <name>=off"
+)
+
+// NPCAdmin spawns, edits, and lists non-player characters. This is the ISP
+// interface for the npc command; *npc.Service satisfies it.
+type NPCAdmin interface {
+	Spawn(ctx context.Context, subject, name, description string, locationID ulid.ULID) (*npc.NPC, error)
+	Despawn(ctx context.Context, subject, name string) error
+	Find(ctx context.Context, subject, name string) (*npc.NPC, error)
+	List(ctx context.Context) ([]*npc.NPC, error)
+	SetBehaviors(ctx context.Context, subject, name string, behaviors []npc.Behavior) (*npc.NPC, error)
+	SetWander(ctx context.Context, subject, name string, w npc.Wander) (*npc.NPC, error)
+}
+
+// NewNPCHandler creates a command handler that spawns NPCs in the caller's
+// location, despawns them, and sets their behaviors and wandering.
+func NewNPCHandler(admin NPCAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleNPC(ctx, exec, admin)
+	}
+}
+
+func handleNPC(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "", "list":
+		return handleNPCList(ctx, exec, admin)
+	case "spawn":
+		return handleNPCSpawn(ctx, exec, admin, subject, rest)
+	case "despawn":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(npcCommandName, "npc despawn <name>")
+		}
+		if err := admin.Despawn(ctx, subject, rest); err != nil {
+			return npcError(err)
+		}
+		writeOutputf(ctx, exec, npcCommandName, "Despawned %s.\n", rest)
+		return nil
+	case "show":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(npcCommandName, "npc show <name>")
+		}
+		return handleNPCShow(ctx, exec, admin, subject, rest)
+	case "behavior", "behaviour":
+		return handleNPCBehavior(ctx, exec, admin, subject, rest)
+	case "wander":
+		return handleNPCWander(ctx, exec, admin, subject, rest)
+	default:
+		writeOutput(ctx, exec, npcCommandName, "Usage: "+npcUsage)
+		return nil
+	}
+}
+
+func handleNPCList(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin) error {
+	npcs, err := admin.List(ctx)
+	if err != nil {
+		return npcError(err)
+	}
+	if len(npcs) == 0 {
+		writeOutput(ctx, exec, npcCommandName, "There are no NPCs.")
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "NPCs (%d):", len(npcs))
+	for _, n := range npcs {
+		fmt.Fprintf(&sb, "\n  %s in %s, %s", n.Name, n.LocationID, n.Wander)
+	}
+	writeOutput(ctx, exec, npcCommandName, sb.String())
+	return nil
+}
+
+func handleNPCSpawn(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin, subject, args string) error {
+	name, description, _ := strings.Cut(args, "=")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(npcCommandName, npcSpawnUsage)
+	}
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You are not in a location.", nil)
+	}
+	n, err := admin.Spawn(ctx, subject, name, strings.TrimSpace(description), exec.LocationID())
+	if err != nil {
+		return npcError(err)
+	}
+	writeOutputf(ctx, exec, npcCommandName, "Spawned %s (%s) here.\n", n.Name, n.ID)
+	return nil
+}
+
+func handleNPCShow(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin, subject, name string) error {
+	n, err := admin.Find(ctx, subject, name)
+	if err != nil {
+		return npcError(err)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%s)", n.Name, n.ID)
+	fmt.Fprintf(&sb, "\n  Location: %s", n.LocationID)
+	fmt.Fprintf(&sb, "\n  Owner: %s", n.Owner)
+	fmt.Fprintf(&sb, "\n  Wander: %s", n.Wander)
+	if len(n.Behaviors) == 0 {
+		sb.WriteString("\n  Behaviors: none")
+	} else {
+		sb.WriteString("\n  Behaviors: " + formatBehaviors(n.Behaviors))
+	}
+	if n.Description != "" {
+		sb.WriteString("\n" + n.Description)
+	}
+	writeOutput(ctx, exec, npcCommandName, sb.String())
+	return nil
+}
+
+func handleNPCBehavior(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin, subject, args string) error {
+	name, spec, found := strings.Cut(args, "=")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(npcCommandName, npcBehaviorUsage)
+	}
+	behaviors, err := parseBehaviors(spec)
+	if err != nil {
+		return err
+	}
+	n, err := admin.SetBehaviors(ctx, subject, name, behaviors)
+	if err != nil {
+		return npcError(err)
+	}
+	if len(behaviors) == 0 {
+		writeOutputf(ctx, exec, npcCommandName, "%s has no behaviors now.\n", n.Name)
+		return nil
+	}
+	writeOutputf(ctx, exec, npcCommandName, "%s now reacts with %s.\n", n.Name, formatBehaviors(behaviors))
+	return nil
+}
+
+// parseBehaviors parses "barkeep:say,pose greeter:arrive" into one behavior
+// per plugin. An empty spec detaches every plugin.
+func parseBehaviors(spec string) ([]npc.Behavior, error) {
+	fields := strings.Fields(spec)
+	behaviors := make([]npc.Behavior, 0, len(fields))
+	for _, f := range fields {
+		plugin, triggers, found := strings.Cut(f, ":")
+		if !found || plugin == "" || triggers == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return nil, command.ErrInvalidArgs(npcCommandName, npcBehaviorUsage)
+		}
+		behaviors = append(behaviors, npc.Behavior{
+			Plugin:   strings.ToLower(plugin),
+			Triggers: strings.Split(strings.ToLower(triggers), ","),
+		})
+	}
+	return behaviors, nil
+}
+
+func formatBehaviors(behaviors []npc.Behavior) string {
+	parts := make([]string, 0, len(behaviors))
+	for _, b := range behaviors {
+		parts = append(parts, b.Plugin+" on "+strings.Join(b.Triggers, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func handleNPCWander(ctx context.Context, exec *command.CommandExecution, admin NPCAdmin, subject, args string) error {
+	name, spec, found := strings.Cut(args, "=")
+	name = strings.TrimSpace(name)
+	if !found || name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(npcCommandName, npcWanderUsage)
+	}
+	w, err := parseWander(spec)
+	if err != nil {
+		return err
+	}
+	n, err := admin.SetWander(ctx, subject, name, w)
+	if err != nil {
+		return npcError(err)
+	}
+	if !w.Enabled() {
+		writeOutputf(ctx, exec, npcCommandName, "%s stays put now.\n", n.Name)
+		return nil
+	}
+	writeOutputf(ctx, exec, npcCommandName, "%s now wanders %s.\n", n.Name, w)
+	return nil
+}
+
+// parseWander parses "5m-15m [zone]" or "off".
+func parseWander(spec string) (npc.Wander, error) {
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) == 1 && fields[0] == "off" {
+		return npc.Wander{}, nil
+	}
+	if len(fields) == 0 || len(fields) > 2 {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return npc.Wander{}, command.ErrInvalidArgs(npcCommandName, npcWanderUsage)
+	}
+	from, until, found := strings.Cut(fields[0], "-")
+	minGap, minErr := time.ParseDuration(from)
+	maxGap, maxErr := time.ParseDuration(until)
+	if !found || minErr != nil || maxErr != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return npc.Wander{}, command.ErrInvalidArgs(npcCommandName, npcWanderUsage)
+	}
+	w := npc.Wander{MinInterval: minGap, MaxInterval: maxGap}
+	if len(fields) == 2 {
+		w.Zone = fields[1]
+	}
+	return w, nil
+}
+
+// npcError surfaces the NPC service's validation and lookup failures to the
+// player and maps policy denials to the permission error; anything else
+// falls through to the generic player message. The cause is not wrapped:
+// oops resolves the innermost code, which would mask WORLD_ERROR.
+func npcError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "NPC_INVALID", "NPC_NAME_TAKEN":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "NPC_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no NPC by that name.", nil)
+	case "NPC_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(npcCommandName, "location")
+	}
+	return err
+}
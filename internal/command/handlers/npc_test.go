@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubNPCAdmin is a test implementation of NPCAdmin.
+type stubNPCAdmin struct {
+	npcs      []*npc.NPC
+	spawned   []string
+	spawnedAt []ulid.ULID
+	despawned []string
+	behaviors []npc.Behavior
+	wander    npc.Wander
+	subjects  []string
+	err       error
+}
+
+func (s *stubNPCAdmin) Spawn(_ context.Context, subject, name, description string, locationID ulid.ULID) (*npc.NPC, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.subjects = append(s.subjects, subject)
+	s.spawned = append(s.spawned, name+"|"+description)
+	s.spawnedAt = append(s.spawnedAt, locationID)
+	return &npc.NPC{ID: npcTestID, Name: name, Description: description, LocationID: locationID}, nil
+}
+
+func (s *stubNPCAdmin) Despawn(_ context.Context, _, name string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.despawned = append(s.despawned, name)
+	return nil
+}
+
+func (s *stubNPCAdmin) Find(_ context.Context, _, name string) (*npc.NPC, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &npc.NPC{
+		ID: npcTestID, Name: name, Description: "A grizzled harbourmaster.", LocationID: zoneLocationID,
+		Owner: "character:owner", Behaviors: []npc.Behavior{{Plugin: "barkeep", Triggers: []string{"say"}}},
+	}, nil
+}
+
+func (s *stubNPCAdmin) List(context.Context) ([]*npc.NPC, error) {
+	return s.npcs, s.err
+}
+
+func (s *stubNPCAdmin) SetBehaviors(_ context.Context, _, name string, behaviors []npc.Behavior) (*npc.NPC, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.behaviors = behaviors
+	return &npc.NPC{ID: npcTestID, Name: name, Behaviors: behaviors}, nil
+}
+
+func (s *stubNPCAdmin) SetWander(_ context.Context, _, name string, w npc.Wander) (*npc.NPC, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.wander = w
+	return &npc.NPC{ID: npcTestID, Name: name, Wander: w}, nil
+}
+
+var npcTestID = ulid.Make()
+
+func runNPC(t *testing.T, admin NPCAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		LocationID:    zoneLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewNPCHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestNPCSpawnAndDespawn(t *testing.T) {
+	admin := &stubNPCAdmin{}
+
+	out, err := runNPC(t, admin, "spawn Old Tom = A grizzled harbourmaster.")
+	require.NoError(t, err)
+	assert.Equal(t, "Spawned Old Tom ("+npcTestID.String()+") here.\n", out)
+	assert.Equal(t, []string{"Old Tom|A grizzled harbourmaster."}, admin.spawned)
+	assert.Equal(t, []ulid.ULID{zoneLocationID}, admin.spawnedAt)
+	assert.Equal(t, []string{access.CharacterSubject(zoneCharID.String())}, admin.subjects)
+
+	out, err = runNPC(t, admin, "despawn Old Tom")
+	require.NoError(t, err)
+	assert.Equal(t, "Despawned Old Tom.\n", out)
+	assert.Equal(t, []string{"Old Tom"}, admin.despawned)
+
+	for _, args := range []string{"spawn", "spawn =desc", "despawn", "show"} {
+		_, err := runNPC(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestNPCListAndShow(t *testing.T) {
+	admin := &stubNPCAdmin{}
+
+	out, err := runNPC(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "There are no NPCs.\n", out)
+
+	admin.npcs = []*npc.NPC{{Name: "Old Tom", LocationID: zoneLocationID}}
+	out, err = runNPC(t, admin, "list")
+	require.NoError(t, err)
+	assert.Equal(t, "NPCs (1):\n  Old Tom in "+zoneLocationID.String()+", stays put\n", out)
+
+	out, err = runNPC(t, admin, "show old tom")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Behaviors: barkeep on say")
+	assert.Contains(t, out, "Wander: stays put")
+	assert.Contains(t, out, "A grizzled harbourmaster.")
+}
+
+func TestNPCBehavior(t *testing.T) {
+	admin := &stubNPCAdmin{}
+
+	out, err := runNPC(t, admin, "behavior Old Tom = barkeep:say,Pose greeter:arrive")
+	require.NoError(t, err)
+	assert.Equal(t, "Old Tom now reacts with barkeep on say, pose; greeter on arrive.\n", out)
+	assert.Equal(t, []npc.Behavior{
+		{Plugin: "barkeep", Triggers: []string{"say", "pose"}},
+		{Plugin: "greeter", Triggers: []string{"arrive"}},
+	}, admin.behaviors)
+
+	out, err = runNPC(t, admin, "behavior Old Tom =")
+	require.NoError(t, err)
+	assert.Equal(t, "Old Tom has no behaviors now.\n", out)
+	assert.Empty(t, admin.behaviors)
+
+	for _, args := range []string{"behavior Old Tom", "behavior = barkeep:say", "behavior Old Tom = barkeep"} {
+		_, err := runNPC(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestNPCWander(t *testing.T) {
+	admin := &stubNPCAdmin{}
+
+	out, err := runNPC(t, admin, "wander Old Tom = 5m-15m Harbor")
+	require.NoError(t, err)
+	assert.Equal(t, npc.Wander{MinInterval: 5 * time.Minute, MaxInterval: 15 * time.Minute, Zone: "harbor"}, admin.wander)
+	assert.Equal(t, "Old Tom now wanders every 5m0s-15m0s in harbor.\n", out)
+
+	out, err = runNPC(t, admin, "wander Old Tom = off")
+	require.NoError(t, err)
+	assert.Equal(t, "Old Tom stays put now.\n", out)
+	assert.False(t, admin.wander.Enabled())
+
+	for _, args := range []string{"wander Old Tom", "wander Old Tom = 5m", "wander Old Tom = soon-later", "wander Old Tom = 1m-2m a b"} {
+		_, err := runNPC(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestNPCErrors(t *testing.T) {
+	admin := &stubNPCAdmin{}
+
+	admin.err = oops.Code("NPC_ACCESS_DENIED").Errorf("denied")
+	_, err := runNPC(t, admin, "spawn Old Tom")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	admin.err = oops.Code("NPC_NAME_TAKEN").Errorf(`an NPC named "Old Tom" already exists`)
+	_, err = runNPC(t, admin, "spawn Old Tom")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "already exists")
+
+	admin.err = oops.Code("NPC_NOT_FOUND").Wrap(npc.ErrNotFound)
+	_, err = runNPC(t, admin, "despawn Nobody")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "no NPC by that name")
+}
+
+func TestNPCUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runNPC(t, &stubNPCAdmin{}, "dance")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+npcUsage+"\n", out)
+}
+
+func TestRegisterAdminNPCAndWho(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("npc")
+	assert.False(t, found, "npc requires the NPCs dependency")
+	_, found = reg.Get("who")
+	assert.False(t, found, "who requires the Who dependency")
+
+	deps.NPCs = &stubNPCAdmin{}
+	deps.Who = &stubWhoDirectory{}
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("npc")
+	assert.True(t, found)
+	_, found = reg.Get("who")
+	assert.True(t, found)
+}
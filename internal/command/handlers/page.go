@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/paging"
+)
+
+const (
+	pageCommandName = "page"
+	pageUsage       = "page [<character>=]<message>"
+)
+
+// PagingAdmin sends pages and keeps the caller's ignore and gag lists. This
+// is the ISP interface for the page, ignore, unignore, gag, and ungag
+// commands; *paging.Service satisfies it.
+type PagingAdmin interface {
+	Send(ctx context.Context, req paging.SendRequest) (*paging.Receipt, error)
+	Block(ctx context.Context, owner ulid.ULID, name string, mode paging.BlockMode) (paging.CharacterRef, error)
+	Unblock(ctx context.Context, owner ulid.ULID, name string, mode paging.BlockMode) (paging.CharacterRef, error)
+	Blocks(ctx context.Context, owner ulid.ULID) ([]paging.Block, error)
+}
+
+// NewPageHandler creates a command handler that pages another character,
+// or the character last paged when no name is given.
+func NewPageHandler(admin PagingAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handlePage(ctx, exec, admin)
+	}
+}
+
+// NewBlockHandler creates a command handler that puts a character on the
+// caller's ignore or gag list, or lists both without arguments. name is
+// the command it is registered as.
+func NewBlockHandler(admin PagingAdmin, name string, mode paging.BlockMode) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleBlock(ctx, exec, admin, name, mode)
+	}
+}
+
+// NewUnblockHandler creates a command handler that takes a character off
+// the caller's ignore or gag list. name is the command it is registered as.
+func NewUnblockHandler(admin PagingAdmin, name string, mode paging.BlockMode) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleUnblock(ctx, exec, admin, name, mode)
+	}
+}
+
+func handlePage(ctx context.Context, exec *command.CommandExecution, admin PagingAdmin) error {
+	// The message is passed through as typed; the service trims it and
+	// reads a leading ":" or ";" as a pose.
+	target, message, found := strings.Cut(exec.Args, "=")
+	if !found {
+		target, message = "", exec.Args
+	}
+	target = strings.TrimSpace(target)
+	if strings.TrimSpace(message) == "" || (found && target == "") {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(pageCommandName, pageUsage)
+	}
+	_, err := admin.Send(ctx, paging.SendRequest{
+		From:    paging.CharacterRef{ID: exec.CharacterID(), Name: exec.CharacterName()},
+		To:      target,
+		Message: message,
+	})
+	if err != nil {
+		return pagingError(err, pageCommandName)
+	}
+	// The page_receipt event on the sender's own stream echoes the page and
+	// says whether it was delivered or held, so there is nothing to add.
+	return nil
+}
+
+func handleBlock(ctx context.Context, exec *command.CommandExecution, admin PagingAdmin, name string, mode paging.BlockMode) error {
+	target := strings.TrimSpace(exec.Args)
+	if target == "" {
+		return listBlocks(ctx, exec, admin, name)
+	}
+	ref, err := admin.Block(ctx, exec.CharacterID(), target, mode)
+	if err != nil {
+		return pagingError(err, name)
+	}
+	if mode == paging.BlockGag {
+		writeOutputf(ctx, exec, name, "You are now gagging %s. Their pages will be dropped without telling them.\n", ref.Name)
+		return nil
+	}
+	writeOutputf(ctx, exec, name, "You are now ignoring %s. They will be told you are not accepting their pages.\n", ref.Name)
+	return nil
+}
+
+func handleUnblock(ctx context.Context, exec *command.CommandExecution, admin PagingAdmin, name string, mode paging.BlockMode) error {
+	target := strings.TrimSpace(exec.Args)
+	if target == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(name, name+" <character>")
+	}
+	ref, err := admin.Unblock(ctx, exec.CharacterID(), target, mode)
+	if err != nil {
+		return pagingError(err, name)
+	}
+	writeOutputf(ctx, exec, name, "You are no longer %s %s.\n", blockingWord(mode), ref.Name)
+	return nil
+}
+
+func listBlocks(ctx context.Context, exec *command.CommandExecution, admin PagingAdmin, name string) error {
+	blocks, err := admin.Blocks(ctx, exec.CharacterID())
+	if err != nil {
+		return pagingError(err, name)
+	}
+	var ignored, gagged []string
+	for _, b := range blocks {
+		entry := b.Character.Name
+		if b.Staff {
+			entry += " (staff, not enforced)"
+		}
+		if b.Mode == paging.BlockGag {
+			gagged = append(gagged, entry)
+		} else {
+			ignored = append(ignored, entry)
+		}
+	}
+	if len(ignored) == 0 && len(gagged) == 0 {
+		writeOutput(ctx, exec, name, "You are not ignoring or gagging anyone.")
+		return nil
+	}
+	var sb strings.Builder
+	if len(ignored) > 0 {
+		fmt.Fprintf(&sb, "Ignoring: %s", strings.Join(ignored, ", "))
+	}
+	if len(gagged) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "Gagging: %s", strings.Join(gagged, ", "))
+	}
+	writeOutput(ctx, exec, name, sb.String())
+	return nil
+}
+
+func blockedWord(mode paging.BlockMode) string {
+	if mode == paging.BlockGag {
+		return "gagged"
+	}
+	return "ignored"
+}
+
+func blockingWord(mode paging.BlockMode) string {
+	if mode == paging.BlockGag {
+		return "gagging"
+	}
+	return "ignoring"
+}
+
+// pagingError surfaces the paging service's validation and lookup failures
+// to the player; anything else falls through to the generic player
+// message. The cause is not wrapped: oops resolves the innermost code,
+// which would mask WORLD_ERROR.
+func pagingError(err error, name string) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	recipient, _ := oopsErr.Context()["recipient_name"].(string)
+	var msg string
+	switch oopsErr.Code() {
+	case "PAGE_INVALID_MESSAGE":
+		msg = fmt.Sprintf("A page must be 1 to %d bytes of text.", paging.MaxMessageLength)
+	case "PAGE_NO_LAST_PAGED":
+		msg = "You have not paged anyone yet. Use: " + pageUsage
+	case "PAGE_NOT_FOUND":
+		msg = "No character by that name."
+	case "PAGE_SELF":
+		msg = fmt.Sprintf("You cannot %s yourself.", name)
+	case "PAGE_REFUSED":
+		msg = recipient + " is not accepting pages from you."
+	case "PAGE_RECIPIENT_OFFLINE":
+		msg = recipient + " is not connected."
+	case "PAGE_MAILBOX_FULL":
+		msg = "Their page mailbox is full. Try again once they have logged in."
+	case "PAGE_STAFF_PROTECTED":
+		target, _ := oopsErr.Context()["character_name"].(string)
+		mode, _ := oopsErr.Context()["mode"].(string)
+		msg = fmt.Sprintf("%s is staff and cannot be %s.", target, blockedWord(paging.BlockMode(mode)))
+	case "PAGE_NOT_BLOCKED":
+		target, _ := oopsErr.Context()["character_name"].(string)
+		mode, _ := oopsErr.Context()["mode"].(string)
+		msg = fmt.Sprintf("You are not %s %s.", blockingWord(paging.BlockMode(mode)), target)
+	case "PAGE_UNAVAILABLE":
+		msg = "Paging is not available right now. Please try again."
+	default:
+		return err
+	}
+	//nolint:wrapcheck // WorldError creates a structured oops error
+	return command.WorldError(msg, nil)
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/paging"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+var pageCharID = ulid.Make()
+
+// stubPagingAdmin is a test implementation of PagingAdmin.
+type stubPagingAdmin struct {
+	characters map[string]paging.CharacterRef
+	blocks     []paging.Block
+	sent       []paging.SendRequest
+	blocked    map[string]paging.BlockMode
+	err        error
+}
+
+func newStubPagingAdmin() *stubPagingAdmin {
+	return &stubPagingAdmin{
+		characters: map[string]paging.CharacterRef{"bob": {ID: ulid.Make(), Name: "Bob"}},
+		blocked:    map[string]paging.BlockMode{},
+	}
+}
+
+func (s *stubPagingAdmin) find(name string) (paging.CharacterRef, error) {
+	if ref, ok := s.characters[strings.ToLower(name)]; ok {
+		return ref, nil
+	}
+	return paging.CharacterRef{}, oops.Code("PAGE_NOT_FOUND").Wrap(paging.ErrNotFound)
+}
+
+func (s *stubPagingAdmin) Send(_ context.Context, req paging.SendRequest) (*paging.Receipt, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.sent = append(s.sent, req)
+	return &paging.Receipt{MessageID: ulid.Make(), Status: paging.StatusDelivered}, nil
+}
+
+func (s *stubPagingAdmin) Block(_ context.Context, _ ulid.ULID, name string, mode paging.BlockMode) (paging.CharacterRef, error) {
+	if s.err != nil {
+		return paging.CharacterRef{}, s.err
+	}
+	ref, err := s.find(name)
+	if err != nil {
+		return paging.CharacterRef{}, err
+	}
+	s.blocked[ref.Name] = mode
+	return ref, nil
+}
+
+func (s *stubPagingAdmin) Unblock(_ context.Context, _ ulid.ULID, name string, mode paging.BlockMode) (paging.CharacterRef, error) {
+	ref, err := s.find(name)
+	if err != nil {
+		return paging.CharacterRef{}, err
+	}
+	if s.blocked[ref.Name] != mode {
+		return paging.CharacterRef{}, oops.Code("PAGE_NOT_BLOCKED").
+			With("character_name", ref.Name).
+			With("mode", string(mode)).
+			Errorf("not blocked")
+	}
+	delete(s.blocked, ref.Name)
+	return ref, nil
+}
+
+func (s *stubPagingAdmin) Blocks(context.Context, ulid.ULID) ([]paging.Block, error) {
+	return s.blocks, nil
+}
+
+func runPaging(t *testing.T, handler command.CommandHandler, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   pageCharID,
+		CharacterName: "Alice",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := handler(context.Background(), exec)
+	return buf.String(), err
+}
+
+func worldErrorMessage(t *testing.T, err error) string {
+	t.Helper()
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	oopsErr, ok := oops.AsOops(err)
+	require.True(t, ok)
+	msg, _ := oopsErr.Context()["message"].(string)
+	return msg
+}
+
+func TestPageSendsToNamedAndLastPagedCharacter(t *testing.T) {
+	admin := newStubPagingAdmin()
+	handler := NewPageHandler(admin)
+
+	out, err := runPaging(t, handler, "Bob = :waves.")
+	require.NoError(t, err)
+	assert.Empty(t, out, "the receipt event confirms the page")
+
+	_, err = runPaging(t, handler, "still there?")
+	require.NoError(t, err)
+
+	from := paging.CharacterRef{ID: pageCharID, Name: "Alice"}
+	assert.Equal(t, []paging.SendRequest{
+		{From: from, To: "Bob", Message: " :waves."},
+		{From: from, To: "", Message: "still there?"},
+	}, admin.sent)
+}
+
+func TestPageRejectsMalformedArgs(t *testing.T) {
+	admin := newStubPagingAdmin()
+	for _, args := range []string{"", "   ", "=hello", "bob=", "bob=   "} {
+		_, err := runPaging(t, NewPageHandler(admin), args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+	assert.Empty(t, admin.sent)
+}
+
+func TestPageErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no last paged", oops.Code("PAGE_NO_LAST_PAGED").Errorf("x"), "You have not paged anyone yet. Use: page [<character>=]<message>"},
+		{"not found", oops.Code("PAGE_NOT_FOUND").Wrap(paging.ErrNotFound), "No character by that name."},
+		{"self", oops.Code("PAGE_SELF").Errorf("x"), "You cannot page yourself."},
+		{"refused", oops.Code("PAGE_REFUSED").With("recipient_name", "Bob").Errorf("x"), "Bob is not accepting pages from you."},
+		{"offline", oops.Code("PAGE_RECIPIENT_OFFLINE").With("recipient_name", "Bob").Errorf("x"), "Bob is not connected."},
+		{"mailbox full", oops.Code("PAGE_MAILBOX_FULL").Wrap(paging.ErrMailboxFull), "Their page mailbox is full. Try again once they have logged in."},
+		{"unavailable", oops.Code("PAGE_UNAVAILABLE").Errorf("x"), "Paging is not available right now. Please try again."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := newStubPagingAdmin()
+			admin.err = tt.err
+			_, err := runPaging(t, NewPageHandler(admin), "bob=hi")
+			assert.Equal(t, tt.want, worldErrorMessage(t, err))
+		})
+	}
+
+	admin := newStubPagingAdmin()
+	admin.err = oops.Code("PAGE_STORE_FAILED").Errorf("boom")
+	_, err := runPaging(t, NewPageHandler(admin), "bob=hi")
+	errutil.AssertErrorCode(t, err, "PAGE_STORE_FAILED")
+}
+
+func TestIgnoreAndGag(t *testing.T) {
+	admin := newStubPagingAdmin()
+
+	out, err := runPaging(t, NewBlockHandler(admin, "ignore", paging.BlockIgnore), "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "You are now ignoring Bob. They will be told you are not accepting their pages.\n", out)
+	assert.Equal(t, paging.BlockIgnore, admin.blocked["Bob"])
+
+	out, err = runPaging(t, NewBlockHandler(admin, "gag", paging.BlockGag), "Bob")
+	require.NoError(t, err)
+	assert.Equal(t, "You are now gagging Bob. Their pages will be dropped without telling them.\n", out)
+
+	_, err = runPaging(t, NewUnblockHandler(admin, "unignore", paging.BlockIgnore), "bob")
+	assert.Equal(t, "You are not ignoring Bob.", worldErrorMessage(t, err))
+
+	out, err = runPaging(t, NewUnblockHandler(admin, "ungag", paging.BlockGag), "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "You are no longer gagging Bob.\n", out)
+	assert.Empty(t, admin.blocked)
+
+	_, err = runPaging(t, NewBlockHandler(admin, "ignore", paging.BlockIgnore), "nobody")
+	assert.Equal(t, "No character by that name.", worldErrorMessage(t, err))
+
+	admin.err = oops.Code("PAGE_STAFF_PROTECTED").
+		With("character_name", "Mod").
+		With("mode", string(paging.BlockGag)).
+		Errorf("cannot gag staff")
+	_, err = runPaging(t, NewBlockHandler(admin, "gag", paging.BlockGag), "mod")
+	assert.Equal(t, "Mod is staff and cannot be gagged.", worldErrorMessage(t, err))
+	admin.err = nil
+
+	_, err = runPaging(t, NewUnblockHandler(admin, "unignore", paging.BlockIgnore), "")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestIgnoreListsBlocks(t *testing.T) {
+	admin := newStubPagingAdmin()
+
+	out, err := runPaging(t, NewBlockHandler(admin, "ignore", paging.BlockIgnore), "")
+	require.NoError(t, err)
+	assert.Equal(t, "You are not ignoring or gagging anyone.\n", out)
+
+	admin.blocks = []paging.Block{
+		{Character: paging.CharacterRef{Name: "Bob"}, Mode: paging.BlockIgnore},
+		{Character: paging.CharacterRef{Name: "Carol"}, Mode: paging.BlockGag},
+		{Character: paging.CharacterRef{Name: "Dave"}, Mode: paging.BlockIgnore},
+		{Character: paging.CharacterRef{Name: "Mod"}, Mode: paging.BlockIgnore, Staff: true},
+	}
+	out, err = runPaging(t, NewBlockHandler(admin, "gag", paging.BlockGag), "")
+	require.NoError(t, err)
+	assert.Equal(t, "Ignoring: Bob, Dave, Mod (staff, not enforced)\nGagging: Carol\n", out)
+}
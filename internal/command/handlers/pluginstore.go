@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+)
+
+const (
+	pluginStoreCommandName = "pluginstore"
+	pluginStoreUsage       = "pluginstore usage | keys <plugin> [prefix] | show <plugin> <key> | delete <plugin> <key> | purge <plugin>"
+)
+
+// PluginStorageAdmin inspects and clears plugin key-value storage. This is
+// the ISP interface for the pluginstore admin command; *kvstore.Service
+// satisfies it.
+type PluginStorageAdmin interface {
+	Quota() kvstore.Quota
+	Usage(ctx context.Context, plugin string) (kvstore.Usage, error)
+	AllUsage(ctx context.Context) ([]kvstore.Usage, error)
+	List(ctx context.Context, plugin, prefix string, limit int) ([]kvstore.Entry, error)
+	Get(ctx context.Context, plugin, key string) (kvstore.Entry, error)
+	Delete(ctx context.Context, plugin, key string) error
+	Purge(ctx context.Context, plugin string) (int, error)
+}
+
+// NewPluginStoreHandler creates a command handler that routes pluginstore
+// subcommands.
+func NewPluginStoreHandler(admin PluginStorageAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handlePluginStore(ctx, exec, admin)
+	}
+}
+
+func handlePluginStore(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	// Keys may contain spaces, so everything after the plugin is the key.
+	plugin, key, _ := strings.Cut(rest, " ")
+	key = strings.TrimSpace(key)
+
+	switch sub {
+	case "usage":
+		if rest != "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(pluginStoreCommandName, "pluginstore usage")
+		}
+		return handlePluginStoreUsage(ctx, exec, admin)
+	case "keys":
+		if plugin == "" || strings.ContainsAny(key, " \t") {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(pluginStoreCommandName, "pluginstore keys <plugin> [prefix]")
+		}
+		return handlePluginStoreKeys(ctx, exec, admin, plugin, key)
+	case "show", "delete":
+		if plugin == "" || key == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(pluginStoreCommandName, "pluginstore "+sub+" <plugin> <key>")
+		}
+		if sub == "show" {
+			return handlePluginStoreShow(ctx, exec, admin, plugin, key)
+		}
+		return handlePluginStoreDelete(ctx, exec, admin, plugin, key)
+	case "purge":
+		if plugin == "" || key != "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(pluginStoreCommandName, "pluginstore purge <plugin>")
+		}
+		return handlePluginStorePurge(ctx, exec, admin, plugin)
+	default:
+		writeOutput(ctx, exec, pluginStoreCommandName, "Usage: "+pluginStoreUsage)
+		return nil
+	}
+}
+
+func handlePluginStoreUsage(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin) error {
+	usages, err := admin.AllUsage(ctx)
+	if err != nil {
+		return pluginStoreError(err)
+	}
+	if len(usages) == 0 {
+		writeOutput(ctx, exec, pluginStoreCommandName, "No plugin has stored anything.")
+		return nil
+	}
+
+	quota := admin.Quota()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Plugin storage (quota %d keys, %d bytes per plugin):", quota.MaxKeys, quota.MaxBytes)
+	for _, usage := range usages {
+		fmt.Fprintf(&sb, "\n  %-24s %6d keys %10d bytes", usage.Plugin, usage.Keys, usage.Bytes)
+	}
+	writeOutput(ctx, exec, pluginStoreCommandName, sb.String())
+	return nil
+}
+
+func handlePluginStoreKeys(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin, plugin, prefix string) error {
+	usage, err := admin.Usage(ctx, plugin)
+	if err != nil {
+		return pluginStoreError(err)
+	}
+	entries, err := admin.List(ctx, plugin, prefix, kvstore.MaxListLimit)
+	if err != nil {
+		return pluginStoreError(err)
+	}
+	if len(entries) == 0 {
+		if prefix != "" {
+			writeOutputf(ctx, exec, pluginStoreCommandName, "%s has no keys starting with %q.\n", plugin, prefix)
+			return nil
+		}
+		writeOutputf(ctx, exec, pluginStoreCommandName, "%s has no stored keys.\n", plugin)
+		return nil
+	}
+
+	quota := admin.Quota()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Keys of %s (%d of %d keys, %d of %d bytes):",
+		plugin, usage.Keys, quota.MaxKeys, usage.Bytes, quota.MaxBytes)
+	for _, entry := range entries {
+		fmt.Fprintf(&sb, "\n  %-40s %8d bytes  %s", entry.Key, len(entry.Value), formatScheduleTime(entry.UpdatedAt))
+	}
+	if len(entries) == kvstore.MaxListLimit {
+		fmt.Fprintf(&sb, "\nShowing the first %d; give a prefix to narrow the list.", kvstore.MaxListLimit)
+	}
+	writeOutput(ctx, exec, pluginStoreCommandName, sb.String())
+	return nil
+}
+
+func handlePluginStoreShow(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin, plugin, key string) error {
+	entry, err := admin.Get(ctx, plugin, key)
+	if err != nil {
+		return pluginStoreError(err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Plugin: %s\n", entry.Plugin)
+	fmt.Fprintf(&sb, "Key: %s\n", entry.Key)
+	fmt.Fprintf(&sb, "Updated: %s\n", formatScheduleTime(entry.UpdatedAt))
+	fmt.Fprintf(&sb, "Size: %d bytes\n", len(entry.Value))
+	// Values are opaque to the host; only text is shown.
+	if utf8.Valid(entry.Value) {
+		fmt.Fprintf(&sb, "Value: %s", entry.Value)
+	} else {
+		sb.WriteString("Value: (binary)")
+	}
+	writeOutput(ctx, exec, pluginStoreCommandName, sb.String())
+	return nil
+}
+
+func handlePluginStoreDelete(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin, plugin, key string) error {
+	if err := admin.Delete(ctx, plugin, key); err != nil {
+		return pluginStoreError(err)
+	}
+	writeOutputf(ctx, exec, pluginStoreCommandName, "Deleted %s from %s.\n", key, plugin)
+	return nil
+}
+
+func handlePluginStorePurge(ctx context.Context, exec *command.CommandExecution, admin PluginStorageAdmin, plugin string) error {
+	n, err := admin.Purge(ctx, plugin)
+	if err != nil {
+		return pluginStoreError(err)
+	}
+	writeOutputf(ctx, exec, pluginStoreCommandName, "Purged %d keys of %s.\n", n, plugin)
+	return nil
+}
+
+// pluginStoreError surfaces lookup and validation failures to staff
+// verbatim; anything else falls through to the generic player message. The
+// cause is not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func pluginStoreError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "KV_NOT_FOUND", "KV_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
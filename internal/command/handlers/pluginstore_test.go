@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubPluginStorageAdmin is a test implementation of PluginStorageAdmin.
+type stubPluginStorageAdmin struct {
+	entries map[string][]kvstore.Entry
+	limits  []int
+	purged  []string
+}
+
+func newStubPluginStorageAdmin(entries ...kvstore.Entry) *stubPluginStorageAdmin {
+	s := &stubPluginStorageAdmin{entries: make(map[string][]kvstore.Entry)}
+	for _, e := range entries {
+		s.entries[e.Plugin] = append(s.entries[e.Plugin], e)
+	}
+	return s
+}
+
+func (s *stubPluginStorageAdmin) Quota() kvstore.Quota {
+	return kvstore.Quota{MaxKeys: 1000, MaxBytes: 1048576}
+}
+
+func (s *stubPluginStorageAdmin) Usage(_ context.Context, plugin string) (kvstore.Usage, error) {
+	usage := kvstore.Usage{Plugin: plugin}
+	for _, e := range s.entries[plugin] {
+		usage.Keys++
+		usage.Bytes += e.Size()
+	}
+	return usage, nil
+}
+
+func (s *stubPluginStorageAdmin) AllUsage(ctx context.Context) ([]kvstore.Usage, error) {
+	var out []kvstore.Usage
+	for plugin := range s.entries {
+		usage, _ := s.Usage(ctx, plugin)
+		out = append(out, usage)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Plugin < out[j].Plugin })
+	return out, nil
+}
+
+func (s *stubPluginStorageAdmin) List(_ context.Context, plugin, prefix string, limit int) ([]kvstore.Entry, error) {
+	s.limits = append(s.limits, limit)
+	var out []kvstore.Entry
+	for _, e := range s.entries[plugin] {
+		if strings.HasPrefix(e.Key, prefix) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubPluginStorageAdmin) Get(_ context.Context, plugin, key string) (kvstore.Entry, error) {
+	for _, e := range s.entries[plugin] {
+		if e.Key == key {
+			return e, nil
+		}
+	}
+	return kvstore.Entry{}, oops.Code("KV_NOT_FOUND").Wrapf(kvstore.ErrNotFound, "%s has no key %q", plugin, key)
+}
+
+func (s *stubPluginStorageAdmin) Delete(ctx context.Context, plugin, key string) error {
+	if _, err := s.Get(ctx, plugin, key); err != nil {
+		return err
+	}
+	kept := s.entries[plugin][:0]
+	for _, e := range s.entries[plugin] {
+		if e.Key != key {
+			kept = append(kept, e)
+		}
+	}
+	s.entries[plugin] = kept
+	return nil
+}
+
+func (s *stubPluginStorageAdmin) Purge(_ context.Context, plugin string) (int, error) {
+	s.purged = append(s.purged, plugin)
+	n := len(s.entries[plugin])
+	delete(s.entries, plugin)
+	return n, nil
+}
+
+func runPluginStore(t *testing.T, admin PluginStorageAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewPluginStoreHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func shopEntries() []kvstore.Entry {
+	updated := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	return []kvstore.Entry{
+		{Plugin: "shop", Key: "stock:sword", Value: []byte("3"), UpdatedAt: updated},
+		{Plugin: "shop", Key: "owner note", Value: []byte("closed on Sundays"), UpdatedAt: updated},
+		{Plugin: "quests", Key: "blob", Value: []byte{0xff, 0xfe}, UpdatedAt: updated},
+	}
+}
+
+func TestPluginStoreUsage(t *testing.T) {
+	out, err := runPluginStore(t, newStubPluginStorageAdmin(shopEntries()...), "usage")
+	require.NoError(t, err)
+	assert.Contains(t, out, "quota 1000 keys, 1048576 bytes per plugin")
+	assert.Regexp(t, `quests\s+1 keys\s+6 bytes`, out)
+	assert.Regexp(t, `shop\s+2 keys\s+39 bytes`, out)
+
+	out, err = runPluginStore(t, newStubPluginStorageAdmin(), "usage")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No plugin has stored anything.")
+}
+
+func TestPluginStoreKeys(t *testing.T) {
+	admin := newStubPluginStorageAdmin(shopEntries()...)
+
+	out, err := runPluginStore(t, admin, "keys shop")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Keys of shop (2 of 1000 keys, 39 of 1048576 bytes):")
+	assert.Regexp(t, `stock:sword\s+1 bytes  2026-10-18 09:30:00 UTC`, out)
+	assert.Contains(t, out, "owner note")
+	assert.Equal(t, []int{kvstore.MaxListLimit}, admin.limits)
+
+	out, err = runPluginStore(t, admin, "keys shop stock:")
+	require.NoError(t, err)
+	assert.NotContains(t, out, "owner note")
+
+	out, err = runPluginStore(t, admin, "keys shop nothing:")
+	require.NoError(t, err)
+	assert.Contains(t, out, `shop has no keys starting with "nothing:".`)
+
+	out, err = runPluginStore(t, admin, "keys idle")
+	require.NoError(t, err)
+	assert.Contains(t, out, "idle has no stored keys.")
+
+	_, err = runPluginStore(t, admin, "keys")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestPluginStoreShow(t *testing.T) {
+	admin := newStubPluginStorageAdmin(shopEntries()...)
+
+	out, err := runPluginStore(t, admin, "show shop owner note")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Key: owner note")
+	assert.Contains(t, out, "Updated: 2026-10-18 09:30:00 UTC")
+	assert.Contains(t, out, "Size: 17 bytes")
+	assert.Contains(t, out, "Value: closed on Sundays")
+
+	out, err = runPluginStore(t, admin, "show quests blob")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Value: (binary)")
+
+	_, err = runPluginStore(t, admin, "show shop stock:bow")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), `shop has no key "stock:bow"`)
+
+	_, err = runPluginStore(t, admin, "show shop")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestPluginStoreDeleteAndPurge(t *testing.T) {
+	admin := newStubPluginStorageAdmin(shopEntries()...)
+
+	out, err := runPluginStore(t, admin, "delete shop stock:sword")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Deleted stock:sword from shop.")
+	assert.Len(t, admin.entries["shop"], 1)
+
+	out, err = runPluginStore(t, admin, "purge shop")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Purged 1 keys of shop.")
+	assert.Equal(t, []string{"shop"}, admin.purged)
+
+	_, err = runPluginStore(t, admin, "purge")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runPluginStore(t, admin, "purge shop quests")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestPluginStoreUsageText(t *testing.T) {
+	out, err := runPluginStore(t, newStubPluginStorageAdmin(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: "+pluginStoreUsage)
+}
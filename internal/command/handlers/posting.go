@@ -0,0 +1,230 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/posting"
+)
+
+const (
+	postingCommandName = "posting"
+	postingUsage       = "posting [#<scene>] | mode [#<scene>] everyone|participants|moderated | voice [#<scene>] <character> | unvoice [#<scene>] <character> | queue [#<scene>] | approve <post> | reject <post>"
+)
+
+// PostingAdmin reads and changes location and scene posting rules and
+// decides held posts. This is the ISP interface for the posting command;
+// *posting.Service satisfies it.
+type PostingAdmin interface {
+	Rule(ctx context.Context, target posting.Target) (*posting.Rule, error)
+	HeldCount(ctx context.Context, target posting.Target) (int, error)
+	SetMode(ctx context.Context, subject string, target posting.Target, mode posting.Mode) (*posting.Rule, error)
+	FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (posting.CharacterRef, error)
+	Voice(ctx context.Context, subject string, target posting.Target, character posting.CharacterRef) (*posting.Rule, error)
+	Unvoice(ctx context.Context, subject string, target posting.Target, characterID ulid.ULID) (*posting.Rule, error)
+	Held(ctx context.Context, subject string, target posting.Target) ([]*posting.HeldPost, error)
+	Approve(ctx context.Context, subject string, id ulid.ULID) (*posting.HeldPost, error)
+	Reject(ctx context.Context, subject string, id ulid.ULID) (*posting.HeldPost, error)
+}
+
+// NewPostingHandler creates a command handler that shows and sets who may
+// say and pose in the caller's location or a scene, and works the
+// moderation queue.
+func NewPostingHandler(admin PostingAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handlePosting(ctx, exec, admin)
+	}
+}
+
+func handlePosting(ctx context.Context, exec *command.CommandExecution, admin PostingAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	sub, rest, _ := strings.Cut(args, " ")
+	sub = strings.ToLower(sub)
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch sub {
+	case "approve", "reject":
+		return handlePostingDecision(ctx, exec, admin, subject, sub, rest)
+	case "mode", "voice", "unvoice", "queue":
+	default:
+		if sub != "" && !strings.HasPrefix(sub, "#") {
+			writeOutput(ctx, exec, postingCommandName, "Usage: "+postingUsage)
+			return nil
+		}
+		sub, rest = "", args
+	}
+
+	target, rest, err := postingTarget(exec, rest)
+	if err != nil {
+		return err
+	}
+	switch sub {
+	case "mode":
+		mode, err := posting.ParseMode(rest)
+		if err != nil {
+			return postingError(err)
+		}
+		if _, err := admin.SetMode(ctx, subject, target, mode); err != nil {
+			return postingError(err)
+		}
+		writeOutputf(ctx, exec, postingCommandName, "Posting in this %s is now %s.\n", target.Kind, mode)
+		return nil
+	case "voice", "unvoice":
+		return handlePostingVoice(ctx, exec, admin, subject, sub, target, rest)
+	case "queue":
+		return handlePostingQueue(ctx, exec, admin, subject, target)
+	default:
+		if rest != "" {
+			writeOutput(ctx, exec, postingCommandName, "Usage: "+postingUsage)
+			return nil
+		}
+		return handlePostingShow(ctx, exec, admin, target)
+	}
+}
+
+// postingTarget takes a leading #<scene id> from args, or falls back to the
+// caller's location, and returns the target and the remaining args.
+func postingTarget(exec *command.CommandExecution, args string) (posting.Target, string, error) {
+	if strings.HasPrefix(args, "#") {
+		ref, rest, _ := strings.Cut(args, " ")
+		id, err := ulid.Parse(strings.TrimPrefix(ref, "#"))
+		if err != nil {
+			//nolint:wrapcheck // WorldError creates a structured oops error
+			return posting.Target{}, "", command.WorldError("That is not a scene id.", nil)
+		}
+		return posting.SceneTarget(id), strings.TrimSpace(rest), nil
+	}
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return posting.Target{}, "", command.WorldError("You are not in a location.", nil)
+	}
+	return posting.LocationTarget(exec.LocationID()), args, nil
+}
+
+func handlePostingShow(ctx context.Context, exec *command.CommandExecution, admin PostingAdmin, target posting.Target) error {
+	rule, err := admin.Rule(ctx, target)
+	if err != nil {
+		return postingError(err)
+	}
+	held, err := admin.HeldCount(ctx, target)
+	if err != nil {
+		return postingError(err)
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Posting in this %s: %s", target.Kind, rule.Mode)
+	if len(rule.Participants) == 0 {
+		sb.WriteString("\n  Participants: none")
+	} else {
+		names := make([]string, 0, len(rule.Participants))
+		for _, c := range rule.Participants {
+			names = append(names, c.Name)
+		}
+		sb.WriteString("\n  Participants: " + strings.Join(names, ", "))
+	}
+	if held > 0 {
+		fmt.Fprintf(&sb, "\n  Held posts: %d", held)
+	}
+	writeOutput(ctx, exec, postingCommandName, sb.String())
+	return nil
+}
+
+func handlePostingVoice(ctx context.Context, exec *command.CommandExecution, admin PostingAdmin, subject, action string, target posting.Target, name string) error {
+	if name == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(postingCommandName, "posting "+action+" [#<scene>] <character>")
+	}
+	character, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return postingError(err)
+	}
+	if action == "voice" {
+		if _, err := admin.Voice(ctx, subject, target, character); err != nil {
+			return postingError(err)
+		}
+		writeOutputf(ctx, exec, postingCommandName, "%s has the floor.\n", character.Name)
+		return nil
+	}
+	if _, err := admin.Unvoice(ctx, subject, target, character.ID); err != nil {
+		return postingError(err)
+	}
+	writeOutputf(ctx, exec, postingCommandName, "%s no longer has the floor.\n", character.Name)
+	return nil
+}
+
+func handlePostingQueue(ctx context.Context, exec *command.CommandExecution, admin PostingAdmin, subject string, target posting.Target) error {
+	posts, err := admin.Held(ctx, subject, target)
+	if err != nil {
+		return postingError(err)
+	}
+	if len(posts) == 0 {
+		writeOutput(ctx, exec, postingCommandName, "No posts are waiting.")
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Held posts (%d):", len(posts))
+	for _, p := range posts {
+		name := p.ActorName
+		if name == "" {
+			name = p.ActorID.String()
+		}
+		fmt.Fprintf(&sb, "\n  %s %s: %s", p.ID, name, p.Text())
+	}
+	writeOutput(ctx, exec, postingCommandName, sb.String())
+	return nil
+}
+
+func handlePostingDecision(ctx context.Context, exec *command.CommandExecution, admin PostingAdmin, subject, action, arg string) error {
+	id, err := ulid.Parse(arg)
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(postingCommandName, "posting "+action+" <post>")
+	}
+	var p *posting.HeldPost
+	if action == "approve" {
+		p, err = admin.Approve(ctx, subject, id)
+	} else {
+		p, err = admin.Reject(ctx, subject, id)
+	}
+	if err != nil {
+		return postingError(err)
+	}
+	verb := "Approved"
+	if action == "reject" {
+		verb = "Rejected"
+	}
+	writeOutputf(ctx, exec, postingCommandName, "%s the post by %s.\n", verb, p.ActorName)
+	return nil
+}
+
+// postingError maps posting service errors to player-facing ones.
+func postingError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "POSTING_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "POSTING_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no held post with that id.", nil)
+	case "POSTING_CHARACTER_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no character by that name.", nil)
+	case "POSTING_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(postingCommandName, "posting")
+	}
+	return err
+}
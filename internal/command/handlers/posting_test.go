@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/posting"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubPostingAdmin is a test implementation of PostingAdmin.
+type stubPostingAdmin struct {
+	rule     posting.Rule
+	held     []*posting.HeldPost
+	targets  []posting.Target
+	voiced   []posting.CharacterRef
+	unvoiced []ulid.ULID
+	approved []ulid.ULID
+	rejected []ulid.ULID
+	err      error
+}
+
+func (s *stubPostingAdmin) Rule(_ context.Context, target posting.Target) (*posting.Rule, error) {
+	s.targets = append(s.targets, target)
+	if s.err != nil {
+		return nil, s.err
+	}
+	r := s.rule
+	if r.Mode == "" {
+		r.Mode = posting.ModeEveryone
+	}
+	return &r, nil
+}
+
+func (s *stubPostingAdmin) HeldCount(context.Context, posting.Target) (int, error) {
+	return len(s.held), nil
+}
+
+func (s *stubPostingAdmin) SetMode(_ context.Context, _ string, target posting.Target, mode posting.Mode) (*posting.Rule, error) {
+	s.targets = append(s.targets, target)
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.rule.Mode = mode
+	return &s.rule, nil
+}
+
+func (s *stubPostingAdmin) FindCharacter(_ context.Context, _ ulid.ULID, name string) (posting.CharacterRef, error) {
+	if strings.EqualFold(name, "nobody") {
+		return posting.CharacterRef{}, oops.Code("POSTING_CHARACTER_NOT_FOUND").Wrap(posting.ErrNotFound)
+	}
+	return posting.CharacterRef{ID: postingTestCharID, Name: "Alice"}, nil
+}
+
+func (s *stubPostingAdmin) Voice(_ context.Context, _ string, target posting.Target, c posting.CharacterRef) (*posting.Rule, error) {
+	s.targets = append(s.targets, target)
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.voiced = append(s.voiced, c)
+	return &s.rule, nil
+}
+
+func (s *stubPostingAdmin) Unvoice(_ context.Context, _ string, target posting.Target, id ulid.ULID) (*posting.Rule, error) {
+	s.targets = append(s.targets, target)
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.unvoiced = append(s.unvoiced, id)
+	return &s.rule, nil
+}
+
+func (s *stubPostingAdmin) Held(_ context.Context, _ string, target posting.Target) ([]*posting.HeldPost, error) {
+	s.targets = append(s.targets, target)
+	return s.held, s.err
+}
+
+func (s *stubPostingAdmin) Approve(_ context.Context, _ string, id ulid.ULID) (*posting.HeldPost, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.approved = append(s.approved, id)
+	return &posting.HeldPost{ID: id, ActorName: "Bob"}, nil
+}
+
+func (s *stubPostingAdmin) Reject(_ context.Context, _ string, id ulid.ULID) (*posting.HeldPost, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.rejected = append(s.rejected, id)
+	return &posting.HeldPost{ID: id, ActorName: "Bob"}, nil
+}
+
+var (
+	postingTestCharID = ulid.Make()
+	postingTestPostID = ulid.Make()
+	postingTestScene  = ulid.Make()
+)
+
+func runPosting(t *testing.T, admin PostingAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		LocationID:    zoneLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewPostingHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestPostingShow(t *testing.T) {
+	admin := &stubPostingAdmin{}
+
+	out, err := runPosting(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Posting in this location: everyone\n  Participants: none\n", out)
+	assert.Equal(t, []posting.Target{posting.LocationTarget(zoneLocationID)}, admin.targets)
+
+	admin.rule = posting.Rule{Mode: posting.ModeModerated, Participants: []posting.CharacterRef{{Name: "Alice"}, {Name: "Bob"}}}
+	admin.held = []*posting.HeldPost{{}}
+	out, err = runPosting(t, admin, "#"+postingTestScene.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Posting in this scene: moderated\n  Participants: Alice, Bob\n  Held posts: 1\n", out)
+	assert.Equal(t, posting.SceneTarget(postingTestScene), admin.targets[1])
+}
+
+func TestPostingModeAndVoice(t *testing.T) {
+	admin := &stubPostingAdmin{}
+
+	out, err := runPosting(t, admin, "mode #"+postingTestScene.String()+" Participants")
+	require.NoError(t, err)
+	assert.Equal(t, "Posting in this scene is now participants.\n", out)
+	assert.Equal(t, posting.ModeParticipants, admin.rule.Mode)
+	assert.Equal(t, []posting.Target{posting.SceneTarget(postingTestScene)}, admin.targets)
+
+	out, err = runPosting(t, admin, "voice alice")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice has the floor.\n", out)
+	assert.Equal(t, []posting.CharacterRef{{ID: postingTestCharID, Name: "Alice"}}, admin.voiced)
+
+	out, err = runPosting(t, admin, "unvoice alice")
+	require.NoError(t, err)
+	assert.Equal(t, "Alice no longer has the floor.\n", out)
+	assert.Equal(t, []ulid.ULID{postingTestCharID}, admin.unvoiced)
+
+	_, err = runPosting(t, admin, "mode loud")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runPosting(t, admin, "voice")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runPosting(t, admin, "voice nobody")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runPosting(t, admin, "mode #not-a-scene moderated")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestPostingQueueApproveReject(t *testing.T) {
+	admin := &stubPostingAdmin{}
+
+	out, err := runPosting(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Equal(t, "No posts are waiting.\n", out)
+
+	admin.held = []*posting.HeldPost{{
+		ID:        postingTestPostID,
+		ActorName: "Bob",
+		Payload:   []byte(`{"actor_display_name":"Bob","text":"Hello."}`),
+	}}
+	out, err = runPosting(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Equal(t, "Held posts (1):\n  "+postingTestPostID.String()+" Bob: Hello.\n", out)
+
+	out, err = runPosting(t, admin, "approve "+postingTestPostID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Approved the post by Bob.\n", out)
+	assert.Equal(t, []ulid.ULID{postingTestPostID}, admin.approved)
+
+	out, err = runPosting(t, admin, "reject "+postingTestPostID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Rejected the post by Bob.\n", out)
+	assert.Equal(t, []ulid.ULID{postingTestPostID}, admin.rejected)
+
+	_, err = runPosting(t, admin, "approve soon")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestPostingErrors(t *testing.T) {
+	admin := &stubPostingAdmin{}
+
+	admin.err = oops.Code("POSTING_ACCESS_DENIED").Errorf("denied")
+	_, err := runPosting(t, admin, "mode moderated")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	admin.err = oops.Code("POSTING_NOT_FOUND").Wrap(posting.ErrNotFound)
+	_, err = runPosting(t, admin, "approve "+postingTestPostID.String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "no held post")
+}
+
+func TestPostingUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runPosting(t, &stubPostingAdmin{}, "shout")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+postingUsage+"\n", out)
+}
+
+func TestRegisterAdminPosting(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("posting")
+	assert.False(t, found, "posting requires the Posting dependency")
+
+	deps.Posting = &stubPostingAdmin{}
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("posting")
+	assert.True(t, found)
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/preferences"
+)
+
+const (
+	prefsCommandName = "prefs"
+	prefsUsage       = "prefs [<name>=<value> | reset <name>]"
+)
+
+// PreferencesAdmin reads and changes the caller's client preferences. This
+// is the ISP interface for the prefs command; *preferences.Service
+// satisfies it.
+type PreferencesAdmin interface {
+	Get(ctx context.Context, playerID ulid.ULID) preferences.Preferences
+	Set(ctx context.Context, req preferences.SetRequest) (preferences.Preferences, error)
+	Reset(ctx context.Context, req preferences.SetRequest) (preferences.Preferences, error)
+}
+
+// NewPrefsHandler creates a command handler that lists the caller's client
+// preferences, changes one, or puts one back to its default.
+func NewPrefsHandler(admin PreferencesAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handlePrefs(ctx, exec, admin)
+	}
+}
+
+func handlePrefs(ctx context.Context, exec *command.CommandExecution, admin PreferencesAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	if args == "" {
+		writeOutput(ctx, exec, prefsCommandName, renderPrefs(admin.Get(ctx, exec.PlayerID())))
+		return nil
+	}
+
+	req := preferences.SetRequest{PlayerID: exec.PlayerID(), CharacterID: exec.CharacterID()}
+	var (
+		prefs preferences.Preferences
+		err   error
+	)
+	if sub, rest, _ := strings.Cut(args, " "); strings.EqualFold(sub, "reset") && !strings.Contains(args, "=") {
+		req.Name = strings.TrimSpace(rest)
+		if req.Name == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(prefsCommandName, prefsUsage)
+		}
+		prefs, err = admin.Reset(ctx, req)
+	} else {
+		name, value, found := strings.Cut(args, "=")
+		req.Name, req.Value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if !found || req.Name == "" || req.Value == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(prefsCommandName, prefsUsage)
+		}
+		prefs, err = admin.Set(ctx, req)
+	}
+	if err != nil {
+		return prefsError(err)
+	}
+	def, err := preferences.Lookup(req.Name)
+	if err != nil {
+		return prefsError(err)
+	}
+	writeOutputf(ctx, exec, prefsCommandName, "Preference %s is now %s.\n", def.Name, def.Value(prefs))
+	return nil
+}
+
+// renderPrefs lists every preference with its current value.
+func renderPrefs(prefs preferences.Preferences) string {
+	defs := preferences.Definitions()
+	width := 0
+	for _, d := range defs {
+		width = max(width, len(d.Name))
+	}
+	var sb strings.Builder
+	sb.WriteString("Your preferences:")
+	for _, d := range defs {
+		fmt.Fprintf(&sb, "\n  %-*s  %-16s %s", width, d.Name, d.Value(prefs), d.Description)
+	}
+	sb.WriteString("\nChange one with: " + prefsUsage)
+	return sb.String()
+}
+
+// prefsError surfaces the preference service's validation failures to the
+// player; anything else falls through to the generic player message. The
+// cause is not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func prefsError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	name, _ := oopsErr.Context()["name"].(string)
+	var msg string
+	switch oopsErr.Code() {
+	case "PREFERENCE_UNKNOWN":
+		msg = fmt.Sprintf("There is no preference named %q. Preferences: %s.",
+			name, strings.Join(preferences.Names(), ", "))
+	case "PREFERENCE_INVALID_VALUE":
+		msg = fmt.Sprintf("That is not a valid %s: %s.", name, oopsErr.Error())
+	default:
+		return err
+	}
+	//nolint:wrapcheck // WorldError creates a structured oops error
+	return command.WorldError(msg, nil)
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/preferences"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+var (
+	prefsPlayerID = ulid.Make()
+	prefsCharID   = ulid.Make()
+)
+
+// stubPreferencesAdmin is a test implementation of PreferencesAdmin.
+type stubPreferencesAdmin struct {
+	prefs  preferences.Preferences
+	sets   []preferences.SetRequest
+	resets []preferences.SetRequest
+	err    error
+}
+
+func (s *stubPreferencesAdmin) Get(context.Context, ulid.ULID) preferences.Preferences {
+	return s.prefs
+}
+
+func (s *stubPreferencesAdmin) Set(_ context.Context, req preferences.SetRequest) (preferences.Preferences, error) {
+	if s.err != nil {
+		return preferences.Preferences{}, s.err
+	}
+	s.sets = append(s.sets, req)
+	s.prefs.ScreenWidth = 100
+	return s.prefs, nil
+}
+
+func (s *stubPreferencesAdmin) Reset(_ context.Context, req preferences.SetRequest) (preferences.Preferences, error) {
+	if s.err != nil {
+		return preferences.Preferences{}, s.err
+	}
+	s.resets = append(s.resets, req)
+	s.prefs = preferences.Defaults()
+	return s.prefs, nil
+}
+
+func runPrefs(t *testing.T, admin PreferencesAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   prefsCharID,
+		CharacterName: "Alice",
+		PlayerID:      prefsPlayerID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewPrefsHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestPrefsListsPreferences(t *testing.T) {
+	admin := &stubPreferencesAdmin{prefs: preferences.Defaults()}
+	admin.prefs.Timezone = "America/New_York"
+
+	out, err := runPrefs(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, `Your preferences:
+  ansi      on               Show colors and text styles
+  width     78               Columns to wrap output to
+  timezone  America/New_York Time zone times are shown in
+  pagesize  20               Rows shown per page of a long listing
+  locale    en               Language host messages are shown in
+  bell      off              Ring the terminal bell when you are paged
+  announce  on               Show staff announcements as they are broadcast
+Change one with: prefs [<name>=<value> | reset <name>]
+`, out)
+}
+
+func TestPrefsSetsAndResets(t *testing.T) {
+	admin := &stubPreferencesAdmin{prefs: preferences.Defaults()}
+
+	out, err := runPrefs(t, admin, " Width = 100 ")
+	require.NoError(t, err)
+	assert.Equal(t, "Preference width is now 100.\n", out)
+	assert.Equal(t, []preferences.SetRequest{
+		{PlayerID: prefsPlayerID, CharacterID: prefsCharID, Name: "Width", Value: "100"},
+	}, admin.sets)
+
+	out, err = runPrefs(t, admin, "reset width")
+	require.NoError(t, err)
+	assert.Equal(t, "Preference width is now 78.\n", out)
+	assert.Equal(t, []preferences.SetRequest{
+		{PlayerID: prefsPlayerID, CharacterID: prefsCharID, Name: "width"},
+	}, admin.resets)
+}
+
+func TestPrefsRejectsMalformedArgs(t *testing.T) {
+	admin := &stubPreferencesAdmin{}
+	for _, args := range []string{"ansi", "=on", "ansi=", "reset", "reset  "} {
+		_, err := runPrefs(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+	assert.Empty(t, admin.sets)
+	assert.Empty(t, admin.resets)
+}
+
+func TestPrefsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			"unknown",
+			oops.Code("PREFERENCE_UNKNOWN").With("name", "colour").Errorf("x"),
+			`There is no preference named "colour". Preferences: announce, ansi, bell, locale, pagesize, timezone, width.`,
+		},
+		{
+			"invalid value",
+			oops.With("name", "width").Wrap(oops.Code("PREFERENCE_INVALID_VALUE").Errorf("expected a number from 20 to 250, got %q", "wide")),
+			`That is not a valid width: expected a number from 20 to 250, got "wide".`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := &stubPreferencesAdmin{err: tt.err}
+			_, err := runPrefs(t, admin, "width=wide")
+			assert.Equal(t, tt.want, worldErrorMessage(t, err))
+		})
+	}
+
+	admin := &stubPreferencesAdmin{err: oops.Code("PREFERENCE_WRITE_FAILED").Errorf("boom")}
+	_, err := runPrefs(t, admin, "ansi=off")
+	errutil.AssertErrorCode(t, err, "PREFERENCE_WRITE_FAILED")
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/recovery"
+)
+
+const (
+	recoverCommandName = "recover"
+	recoverUsage       = "recover request <account> = <evidence> | status <code> | reset <code> = <new password> | queue | show <id> | approve <id> [= <note>] | deny <id> = <note>"
+)
+
+// RecoveryAdmin files account recovery requests, redeems approved ones, and
+// runs the staff review queue. This is the ISP interface for the recover
+// command; *recovery.Service satisfies it.
+type RecoveryAdmin interface {
+	File(ctx context.Context, req recovery.FileRequest) (*recovery.Receipt, error)
+	Status(ctx context.Context, code string) (*recovery.Request, error)
+	Redeem(ctx context.Context, code, newPassword string) error
+	Queue(ctx context.Context, subject string) ([]*recovery.Request, error)
+	Show(ctx context.Context, subject string, id ulid.ULID) (*recovery.Request, error)
+	Approve(ctx context.Context, reviewer recovery.Reviewer, id ulid.ULID, note string) (*recovery.Request, error)
+	Deny(ctx context.Context, reviewer recovery.Reviewer, id ulid.ULID, note string) (*recovery.Request, error)
+}
+
+// NewRecoverHandler creates a command handler for staff-approved account
+// recovery: filing and redeeming requests, and the staff review
+// subcommands.
+func NewRecoverHandler(admin RecoveryAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleRecover(ctx, exec, admin)
+	}
+}
+
+func handleRecover(ctx context.Context, exec *command.CommandExecution, admin RecoveryAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+	reviewer := recovery.Reviewer{
+		PlayerID:  exec.PlayerID(),
+		Character: recovery.CharacterRef{ID: exec.CharacterID(), Name: exec.CharacterName()},
+	}
+
+	switch strings.ToLower(sub) {
+	case "request":
+		account, evidence, ok := cutRecoverText(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(recoverCommandName, "recover request <account> = <evidence>")
+		}
+		receipt, err := admin.File(ctx, recovery.FileRequest{Filer: reviewer.Character, Username: account, Evidence: evidence})
+		if err != nil {
+			return recoverError(err)
+		}
+		writeOutputf(ctx, exec, recoverCommandName,
+			"Recovery request %s filed. Staff will review it.\n"+
+				"Your recovery code is: %s\n"+
+				"Write it down now; it will not be shown again. Check on the request with "+
+				"recover status <code>, and once it is approved set a new password with "+
+				"recover reset <code> = <new password> or the web password reset form.\n",
+			receipt.ID, receipt.Code)
+		return nil
+	case "status":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(recoverCommandName, "recover status <code>")
+		}
+		r, err := admin.Status(ctx, rest)
+		if err != nil {
+			return recoverError(err)
+		}
+		writeOutput(ctx, exec, recoverCommandName, recoverStatusText(r))
+		return nil
+	case "reset":
+		code, password, ok := cutRecoverText(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(recoverCommandName, "recover reset <code> = <new password>")
+		}
+		if err := admin.Redeem(ctx, code, password); err != nil {
+			return recoverError(err)
+		}
+		writeOutput(ctx, exec, recoverCommandName,
+			"Your password has been changed. Connect with your account name and the new password.")
+		return nil
+	case "queue":
+		return handleRecoverQueue(ctx, exec, admin, subject)
+	case "show":
+		id, err := parseRecoverID(rest, "recover show <id>")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Show(ctx, subject, id)
+		if err != nil {
+			return recoverError(err)
+		}
+		writeOutput(ctx, exec, recoverCommandName, formatRecoveryRequest(r))
+		return nil
+	case "approve":
+		idArg, note, _ := strings.Cut(rest, "=")
+		id, err := parseRecoverID(strings.TrimSpace(idArg), "recover approve <id> [= <note>]")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Approve(ctx, reviewer, id, strings.TrimSpace(note))
+		if err != nil {
+			return recoverError(err)
+		}
+		writeOutputf(ctx, exec, recoverCommandName,
+			"Approved recovery request %s for %s. Its recovery code now resets the password for %d hours.\n",
+			r.ID, r.Username, int(recovery.ResetExpiry.Hours()))
+		return nil
+	case "deny":
+		idArg, note, ok := cutRecoverText(rest)
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(recoverCommandName, "recover deny <id> = <note>")
+		}
+		id, err := parseRecoverID(idArg, "recover deny <id> = <note>")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Deny(ctx, reviewer, id, note)
+		if err != nil {
+			return recoverError(err)
+		}
+		writeOutputf(ctx, exec, recoverCommandName, "Denied recovery request %s for %s.\n", r.ID, r.Username)
+		return nil
+	}
+	writeOutput(ctx, exec, recoverCommandName, "Usage: "+recoverUsage)
+	return nil
+}
+
+func handleRecoverQueue(ctx context.Context, exec *command.CommandExecution, admin RecoveryAdmin, subject string) error {
+	list, err := admin.Queue(ctx, subject)
+	if err != nil {
+		return recoverError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, recoverCommandName, "No recovery requests are waiting.")
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Recovery requests (%d):", len(list))
+	for _, r := range list {
+		fmt.Fprintf(&sb, "\n  %s  %s  for %s, filed by %s\n    %s",
+			r.ID, formatScheduleTime(r.CreatedAt), r.Username, r.Filer.Name, reportExcerpt(r.Evidence))
+	}
+	writeOutput(ctx, exec, recoverCommandName, sb.String())
+	return nil
+}
+
+// formatRecoveryRequest renders one request in full for staff.
+func formatRecoveryRequest(r *recovery.Request) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Recovery request %s (%s)\n", r.ID, r.Status)
+	fmt.Fprintf(&sb, "Account: %s\n", r.Username)
+	fmt.Fprintf(&sb, "Filed by %s, %s\n", r.Filer.Name, formatScheduleTime(r.CreatedAt))
+	if r.Reviewer.Name != "" {
+		fmt.Fprintf(&sb, "Reviewed by %s, %s", r.Reviewer.Name, formatScheduleTime(r.ReviewedAt))
+		if r.Note != "" {
+			sb.WriteString(": " + r.Note)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+	sb.WriteString(r.Evidence)
+	return sb.String()
+}
+
+// recoverStatusText is what the holder of a recovery code is told about
+// their request.
+func recoverStatusText(r *recovery.Request) string {
+	switch r.Status {
+	case recovery.StatusApproved:
+		return fmt.Sprintf("Recovery request %s has been approved. Set a new password with recover reset <code> = <new password>.", r.ID)
+	case recovery.StatusDenied:
+		return fmt.Sprintf("Recovery request %s was denied: %s", r.ID, r.Note)
+	default:
+		return fmt.Sprintf("Recovery request %s is waiting for staff review.", r.ID)
+	}
+}
+
+// cutRecoverText splits "<head> = <text>" into its trimmed halves.
+func cutRecoverText(args string) (string, string, bool) {
+	head, text, ok := strings.Cut(args, "=")
+	head, text = strings.TrimSpace(head), strings.TrimSpace(text)
+	return head, text, ok && head != "" && text != ""
+}
+
+func parseRecoverID(arg, usage string) (ulid.ULID, error) {
+	if arg == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return ulid.ULID{}, command.ErrInvalidArgs(recoverCommandName, usage)
+	}
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return ulid.ULID{}, command.WorldError(fmt.Sprintf("%q is not a recovery request ID; see recover queue.", arg), nil)
+	}
+	return id, nil
+}
+
+// recoverError surfaces the recovery and reset services' validation,
+// lookup, and review failures to the player as readable errors.
+func recoverError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "RECOVERY_INVALID", "RECOVERY_DECIDED", "RECOVERY_SELF_REVIEW", "AUTH_INVALID_PASSWORD":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "RECOVERY_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No recovery request matches that.", nil)
+	case "RECOVERY_NOT_APPROVED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That request has not been approved; check it with recover status <code>.", nil)
+	case "RECOVERY_RATE_LIMITED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf(
+			"You have filed %d recovery requests today; wait a day before filing another.",
+			recovery.MaxRequestsPerFiler), nil)
+	case "RESET_TOKEN_INVALID", "RESET_TOKEN_EXPIRED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That recovery code has already been used or has expired.", nil)
+	case "RECOVERY_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(recoverCommandName, "recover")
+	}
+	return err
+}
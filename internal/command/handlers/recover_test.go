@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/recovery"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubRecoveryAdmin is a test implementation of RecoveryAdmin.
+type stubRecoveryAdmin struct {
+	filed     []recovery.FileRequest
+	redeemed  []string
+	request   recovery.Request
+	pending   []*recovery.Request
+	reviewers []recovery.Reviewer
+	notes     []string
+	err       error
+}
+
+func (s *stubRecoveryAdmin) File(_ context.Context, req recovery.FileRequest) (*recovery.Receipt, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.filed = append(s.filed, req)
+	return &recovery.Receipt{ID: recoverTestRequestID, Code: "c0de"}, nil
+}
+
+func (s *stubRecoveryAdmin) Status(context.Context, string) (*recovery.Request, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	r := s.request
+	return &r, nil
+}
+
+func (s *stubRecoveryAdmin) Redeem(_ context.Context, code, _ string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.redeemed = append(s.redeemed, code)
+	return nil
+}
+
+func (s *stubRecoveryAdmin) Queue(context.Context, string) ([]*recovery.Request, error) {
+	return s.pending, s.err
+}
+
+func (s *stubRecoveryAdmin) Show(context.Context, string, ulid.ULID) (*recovery.Request, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	r := s.request
+	return &r, nil
+}
+
+func (s *stubRecoveryAdmin) Approve(_ context.Context, reviewer recovery.Reviewer, _ ulid.ULID, note string) (*recovery.Request, error) {
+	return s.review(reviewer, note, recovery.StatusApproved)
+}
+
+func (s *stubRecoveryAdmin) Deny(_ context.Context, reviewer recovery.Reviewer, _ ulid.ULID, note string) (*recovery.Request, error) {
+	return s.review(reviewer, note, recovery.StatusDenied)
+}
+
+func (s *stubRecoveryAdmin) review(reviewer recovery.Reviewer, note string, status recovery.Status) (*recovery.Request, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.reviewers = append(s.reviewers, reviewer)
+	s.notes = append(s.notes, note)
+	r := s.request
+	r.Status = status
+	return &r, nil
+}
+
+var (
+	recoverTestRequestID = ulid.Make()
+	recoverTestPlayerID  = ulid.Make()
+)
+
+func runRecover(t *testing.T, admin RecoveryAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		PlayerID:      recoverTestPlayerID,
+		LocationID:    zoneLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewRecoverHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestRecoverRequestShowsCodeOnce(t *testing.T) {
+	admin := &stubRecoveryAdmin{}
+
+	out, err := runRecover(t, admin, "request bob = I played Bob in 2019.")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Recovery request "+recoverTestRequestID.String()+" filed.")
+	assert.Contains(t, out, "Your recovery code is: c0de\n")
+	require.Len(t, admin.filed, 1)
+	assert.Equal(t, recovery.FileRequest{
+		Filer:    recovery.CharacterRef{ID: zoneCharID, Name: "Alice"},
+		Username: "bob",
+		Evidence: "I played Bob in 2019.",
+	}, admin.filed[0])
+
+	_, err = runRecover(t, admin, "request bob")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestRecoverStatusAndReset(t *testing.T) {
+	admin := &stubRecoveryAdmin{request: recovery.Request{ID: recoverTestRequestID, Status: recovery.StatusPending}}
+
+	out, err := runRecover(t, admin, "status c0de")
+	require.NoError(t, err)
+	assert.Equal(t, "Recovery request "+recoverTestRequestID.String()+" is waiting for staff review.\n", out)
+
+	admin.request.Status, admin.request.Note = recovery.StatusDenied, "Not enough detail."
+	out, err = runRecover(t, admin, "status c0de")
+	require.NoError(t, err)
+	assert.Contains(t, out, "was denied: Not enough detail.")
+
+	out, err = runRecover(t, admin, "reset c0de = hunter2hunter2")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Your password has been changed.")
+	assert.Equal(t, []string{"c0de"}, admin.redeemed)
+
+	_, err = runRecover(t, admin, "reset c0de")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runRecover(t, admin, "status")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestRecoverQueueAndReview(t *testing.T) {
+	filedAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	request := recovery.Request{
+		ID:        recoverTestRequestID,
+		Username:  "bob",
+		Filer:     recovery.CharacterRef{Name: "Guest-1"},
+		Evidence:  "I played Bob in 2019.",
+		Status:    recovery.StatusPending,
+		CreatedAt: filedAt,
+	}
+	admin := &stubRecoveryAdmin{}
+
+	out, err := runRecover(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Equal(t, "No recovery requests are waiting.\n", out)
+
+	admin.pending = []*recovery.Request{&request}
+	out, err = runRecover(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Recovery requests (1):")
+	assert.Contains(t, out, "for bob, filed by Guest-1")
+
+	admin.request = request
+	out, err = runRecover(t, admin, "show "+recoverTestRequestID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Account: bob\n")
+	assert.Contains(t, out, "I played Bob in 2019.")
+
+	out, err = runRecover(t, admin, "approve "+recoverTestRequestID.String()+" = Knew the alts.")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Approved recovery request "+recoverTestRequestID.String()+" for bob.")
+	assert.Equal(t, recovery.Reviewer{
+		PlayerID:  recoverTestPlayerID,
+		Character: recovery.CharacterRef{ID: zoneCharID, Name: "Alice"},
+	}, admin.reviewers[0])
+	assert.Equal(t, "Knew the alts.", admin.notes[0])
+
+	out, err = runRecover(t, admin, "deny "+recoverTestRequestID.String()+" = No match.")
+	require.NoError(t, err)
+	assert.Equal(t, "Denied recovery request "+recoverTestRequestID.String()+" for bob.\n", out)
+	assert.Equal(t, "No match.", admin.notes[1])
+
+	_, err = runRecover(t, admin, "deny "+recoverTestRequestID.String())
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	_, err = runRecover(t, admin, "show soon")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestRecoverErrors(t *testing.T) {
+	admin := &stubRecoveryAdmin{}
+
+	admin.err = oops.Code("RECOVERY_ACCESS_DENIED").Errorf("denied")
+	_, err := runRecover(t, admin, "queue")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	admin.err = oops.Code("RECOVERY_RATE_LIMITED").Wrap(recovery.ErrRateLimited)
+	_, err = runRecover(t, admin, "request bob = Mine.")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "wait a day")
+
+	admin.err = oops.Code("RECOVERY_NOT_APPROVED").Errorf("not approved")
+	_, err = runRecover(t, admin, "reset c0de = hunter2hunter2")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "has not been approved")
+
+	admin.err = oops.Code("RECOVERY_NOT_FOUND").Wrap(recovery.ErrNotFound)
+	_, err = runRecover(t, admin, "status c0de")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestRecoverUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runRecover(t, &stubRecoveryAdmin{}, "help")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+recoverUsage+"\n", out)
+}
+
+func TestRegisterAdminRecover(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("recover")
+	assert.False(t, found, "recover requires the Recovery dependency")
+
+	deps.Recovery = &stubRecoveryAdmin{}
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("recover")
+	assert.True(t, found)
+}
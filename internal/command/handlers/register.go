@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/paging"
 )
 
 // RegisterAdmin registers admin command handlers that require auth dependencies.
@@ -81,6 +82,1192 @@ Requires read access to the plugin resource at global scope.`,
 			Source: "core",
 		})
 	}
+
+	if deps.Scheduler != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "schedule",
+			Handler: NewScheduleHandler(deps.Scheduler),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Manage scheduled world events",
+			Usage: "schedule list | info | add | enable | disable | remove",
+			HelpText: `## Schedule
+
+Register recurring world events — weather broadcasts, shop restocks, zone
+resets — that fire on cron schedules. Times are UTC.
+
+### Usage
+
+- ` + "`schedule list`" + ` - List jobs with their state and next run
+- ` + "`schedule info <name>`" + ` - Show a job, its last result, and its next runs
+- ` + "`schedule add <name> = <schedule> | emit <subject> <message>`" + ` - Publish a system message on a subject
+- ` + "`schedule add <name> = <schedule> | plugin <plugin> [payload]`" + ` - Deliver a scheduled event to a plugin
+- ` + "`schedule enable <name>`" + ` / ` + "`schedule disable <name>`" + ` - Resume or pause a job
+- ` + "`schedule remove <name>`" + ` - Delete a job
+
+Add ` + "`--jitter <duration>`" + ` after the name to delay each run by a random
+amount up to that duration. A schedule is a five-field cron expression
+(` + "`*/15 * * * *`" + `) or one of ` + "`@hourly`" + `, ` + "`@daily`" + `, ` + "`@weekly`" + `,
+` + "`@monthly`" + `, ` + "`@yearly`" + `, ` + "`@every <duration>`" + `.
+
+### Examples
+
+- ` + "`schedule add weather --jitter 5m = @hourly | emit system A light rain begins to fall.`" + `
+- ` + "`schedule add restock = 0 6 * * * | plugin shops`" + `
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Jobs != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "job",
+			Handler: NewJobHandler(deps.Jobs),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Run and track long-running admin jobs",
+			Usage: "job kinds | list | status | start | cancel",
+			HelpText: `## Job
+
+Run long-running admin operations in the background. A job keeps running
+after you disconnect, survives a server restart, and sends you a message
+when it finishes. Times are UTC.
+
+### Usage
+
+- ` + "`job kinds`" + ` - List the kinds of job this server can run
+- ` + "`job list [active]`" + ` - List recent jobs, or only queued and running ones
+- ` + "`job status <id>`" + ` - Show a job's progress and outcome
+- ` + "`job start <kind> [json params]`" + ` - Queue a job
+- ` + "`job cancel <id>`" + ` - Cancel a queued job, or stop a running one
+
+Finished jobs are kept for seven days.
+
+### Examples
+
+- ` + "`job start world_check`" + ` - Scan the world for broken exits, misplaced objects, and other consistency issues
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.DeadLetters != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "deadletter",
+			Handler: NewDeadLetterHandler(deps.DeadLetters),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Inspect, requeue and purge plugin dead letters",
+			Usage: "deadletter list | show | requeue | discard | purge",
+			HelpText: `## Deadletter
+
+When a plugin keeps failing to handle an event, the event is kept as a
+dead letter instead of being dropped, and staff on the moderation channel
+are alerted. Fix the plugin, then requeue its dead letters. Times are UTC.
+
+### Usage
+
+- ` + "`deadletter list [plugin]`" + ` - List dead letters, newest first
+- ` + "`deadletter show <id>`" + ` - Show the event, its failure reason, and its payload
+- ` + "`deadletter requeue <id>`" + ` - Deliver the event to its plugin again
+- ` + "`deadletter discard <id>`" + ` - Delete a dead letter without delivering it
+- ` + "`deadletter purge <plugin>`" + ` - Delete every dead letter of a plugin
+- ` + "`deadletter purge all`" + ` - Delete every dead letter
+
+A requeued event that fails again stays in the queue with its new reason.
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.PluginStorage != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "pluginstore",
+			Handler: NewPluginStoreHandler(deps.PluginStorage),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Inspect and clear plugin key-value storage",
+			Usage: "pluginstore usage | keys | show | delete | purge",
+			HelpText: `## Pluginstore
+
+Plugins keep their own data, such as shop stock or quest progress, in a
+private key-value store held to a quota of keys and bytes per plugin. Use
+this command to see what a plugin has stored and to clear data a plugin
+left behind. Times are UTC.
+
+### Usage
+
+- ` + "`pluginstore usage`" + ` - List every plugin that stores data, with its key count and size
+- ` + "`pluginstore keys <plugin> [prefix]`" + ` - List a plugin's keys, optionally those starting with prefix
+- ` + "`pluginstore show <plugin> <key>`" + ` - Show one stored value
+- ` + "`pluginstore delete <plugin> <key>`" + ` - Delete one key
+- ` + "`pluginstore purge <plugin>`" + ` - Delete every key of a plugin
+
+A plugin whose quota is full cannot store new data until keys are deleted.
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Webhooks != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "webhook",
+			Handler: NewWebhookHandler(deps.Webhooks),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Send game events to external HTTP endpoints",
+			Usage: webhookUsage,
+			HelpText: `## Webhook
+
+Webhooks send game events to external tools, such as a Discord bridge or
+a dashboard, as JSON POSTs. Each webhook subscribes to event types: an
+exact type such as ` + "`character_created`" + `, a prefix such as ` + "`scene.*`" + `,
+or ` + "`*`" + ` for every event. Encrypted events are never sent.
+
+Every request carries an ` + "`X-Holomush-Signature`" + ` header: ` + "`sha256=`" + ` and the
+hex HMAC-SHA256 of ` + "`<X-Holomush-Timestamp>.<body>`" + ` under the webhook's
+secret. The secret is shown once, when the webhook is added.
+
+A delivery that does not get a 2xx response is retried with exponential
+backoff, up to 8 attempts. Deliveries over a webhook's rate limit wait
+in the queue. Times are UTC.
+
+### Usage
+
+- ` + "`webhook list`" + ` - List webhooks and their subscriptions
+- ` + "`webhook add <name> <url> [--rate <per minute>] = <type>[, <type>...]`" + ` - Add a webhook (default 60 per minute)
+- ` + "`webhook enable <name>`" + ` - Resume deliveries to a webhook
+- ` + "`webhook disable <name>`" + ` - Pause deliveries; queued ones wait
+- ` + "`webhook remove <name>`" + ` - Delete a webhook and its delivery log
+- ` + "`webhook log [name]`" + ` - Show recent deliveries, newest first
+
+### Examples
+
+- ` + "`webhook add discord https://bridge.example.com/hook = scene.*, character_created`" + `
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Roles != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "role",
+			Handler: NewRoleHandler(deps.Roles),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Grant and revoke character roles",
+			Usage: "role list | grant | revoke | hierarchy",
+			HelpText: `## Role
+
+Grant and revoke the roles that access policies check. The system roles form
+a hierarchy: admin inherits gm, gm inherits builder, and builder inherits
+player. Every character holds player. Other role names (such as ` + "`staff`" + `)
+can be granted too; they inherit nothing.
+
+### Usage
+
+- ` + "`role list <character>`" + ` - Show a character's roles and who granted them
+- ` + "`role grant <character> <role>`" + ` - Grant a role
+- ` + "`role revoke <character> <role>`" + ` - Revoke a directly granted role
+- ` + "`role hierarchy`" + ` - Show which roles inherit which
+
+Changes apply to the character's next action. The last admin cannot lose the
+admin role. Every change is written to the audit log.
+
+### Examples
+
+- ` + "`role grant Alice builder`" + `
+- ` + "`role revoke Bob gm`" + `
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Bans != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "ban",
+			Handler: NewBanHandler(deps.Bans),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Manage the server ban list",
+			Usage: "ban list | add | remove",
+			HelpText: `## Ban
+
+Refuse logins from an IP address, CIDR range, or hostname, or to a player
+account. Bans are checked at login; player bans are only applied after the
+password is verified.
+
+### Usage
+
+- ` + "`ban list`" + ` - List active bans with their IDs
+- ` + "`ban add <ip|cidr|hostname|player> <pattern> = <reason>`" + ` - Add a permanent ban
+- ` + "`ban add <target> <pattern> --for <duration> = <reason>`" + ` - Add a ban that expires
+- ` + "`ban remove <id>`" + ` - Lift a ban
+
+Hostname and player patterns accept ` + "`*`" + ` and ` + "`?`" + ` wildcards and are
+case-insensitive. Durations use Go syntax (` + "`12h`" + `, ` + "`90m`" + `) or whole days
+(` + "`7d`" + `). Every change is written to the audit log.
+
+### Examples
+
+- ` + "`ban add cidr 192.0.2.0/24 --for 7d = spam wave`" + `
+- ` + "`ban add hostname *.proxy.example = open proxies`" + `
+- ` + "`ban add player troll* = harassment`" + `
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Help != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "helpedit",
+			Handler: NewHelpEditHandler(deps.Help),
+			Capabilities: []command.Capability{
+				{Action: "write", Resource: "help", Scope: command.ScopeGlobal},
+			},
+			Help:  "Edit in-game help topics",
+			Usage: "helpedit list | set | title | category | alias | delete",
+			HelpText: `## Help Edit
+
+Write the help topics players read with ` + "`help <topic>`" + `. A topic has a
+name, a title, a category, a body, and any number of aliases that also find
+it. Names and aliases are case-insensitive.
+
+### Usage
+
+- ` + "`helpedit list [category]`" + ` - List topics, optionally in one category
+- ` + "`helpedit set <topic> = <body>`" + ` - Create a topic or replace its body
+- ` + "`helpedit title <topic> = <title>`" + ` - Set the title shown above the body
+- ` + "`helpedit category <topic> = <category>`" + ` - File the topic under a category
+- ` + "`helpedit alias <topic> = <alias>, <alias>`" + ` - Replace the topic's aliases
+- ` + "`helpedit delete <topic>`" + ` - Delete a topic and its aliases
+
+Bodies accept format codes: ` + "`%r`" + ` starts a new line and ` + "`%xh`" + ` ...
+` + "`%xn`" + ` highlights text. A topic given by alias edits the topic the alias
+belongs to. Every change is written to the audit log.
+
+### Examples
+
+- ` + "`helpedit set combat = Attack with %xhattack <target>%xn.%rSee also: wounds.`" + `
+- ` + "`helpedit category combat = rules`" + `
+- ` + "`helpedit alias combat = fight, fighting`" + `
+
+### Permissions
+
+Requires write access to help topics; granted to staff by default.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Visibility != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "visibility",
+			Handler: NewVisibilityHandler(deps.Visibility),
+			Capabilities: []command.Capability{
+				{Action: "set_visibility", Resource: "character"},
+			},
+			Help:  "Go dark or invisible",
+			Usage: "visibility [visible|dark|invisible]",
+			HelpText: `## Visibility
+
+Hide your character from other players. A hidden character is left out of
+room contents and the presence list, and its arrivals, departures, and idle
+changes are not shown to anyone who cannot see it.
+
+### Usage
+
+- ` + "`visibility`" + ` - Show your current visibility
+- ` + "`visibility visible`" + ` - Be seen by everyone
+- ` + "`visibility invisible`" + ` - Be seen only by builders and staff
+- ` + "`visibility dark`" + ` - Be seen only by staff
+
+You always see yourself, and admins see everyone.
+
+### Permissions
+
+Requires the set_visibility action on your own character; granted to staff
+by default.`,
+			Source: "core",
+		})
+	}
+
+	if deps.MOTD != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "motd",
+			Handler: NewMOTDHandler(deps.MOTD),
+			Help:    "Read or edit the message of the day",
+			Usage:   "motd | ack | set | clear | screen | announce | wall | announcements | cancel",
+			HelpText: `## Message of the Day
+
+Read the message of the day and any running announcements. Both are shown
+when you log in; the message is marked new when it changed since your last
+visit.
+
+### Usage
+
+- ` + "`motd`" + ` - Show the message of the day and running announcements
+- ` + "`motd ack`" + ` - List announcements waiting for your acknowledgment
+- ` + "`motd ack <id>`" + ` - Acknowledge an announcement
+
+### Staff
+
+- ` + "`motd set <text>`" + ` - Replace the message of the day
+- ` + "`motd clear`" + ` - Remove the message of the day
+- ` + "`motd screen list`" + ` - List the connect screens
+- ` + "`motd screen show <name>`" + ` - Show a connect screen's source text
+- ` + "`motd screen set <name> = <text>`" + ` - Create or replace a connect screen
+- ` + "`motd screen delete <name>`" + ` - Delete a connect screen
+- ` + "`motd announce [--ack] [--in <duration>] [--for <duration>] = <text>`" + ` - Schedule an announcement
+- ` + "`motd wall [--ack] [--for <duration>] = <text>`" + ` - Announce to everyone now
+- ` + "`motd announcements`" + ` - List running and upcoming announcements
+- ` + "`motd cancel <id>`" + ` - Cancel an announcement
+
+A new connection is shown one connect screen, picked at random, or the
+built-in banner when there are none. An announcement is broadcast to
+everyone online when it starts and is shown at login until it ends; it
+starts now and runs a day unless ` + "`--in`" + ` or ` + "`--for`" + ` say
+otherwise. ` + "`motd wall`" + ` broadcasts at once instead of at the next
+check, so players who are offline see it at their next login. Durations
+accept ` + "`90m`" + `, ` + "`2h`" + `, or ` + "`7d`" + `.
+
+` + "`--ack`" + ` asks each player to acknowledge the announcement, for policy
+changes; it keeps appearing at their login until they do, for up to 30 days
+unless ` + "`--for`" + ` says otherwise. ` + "`motd announcements`" + ` shows how many
+have acknowledged.
+
+Text accepts format codes: ` + "`%r`" + ` starts a new line and ` + "`%xh`" + ` ...
+` + "`%xn`" + ` highlights text. Every change is written to the audit log.
+
+### Examples
+
+- ` + "`motd set Welcome back! The %xhwinter event%xn starts Friday.`" + `
+- ` + "`motd screen set winter = %xc*** Winter on HoloMUSH ***%xn`" + `
+- ` + "`motd announce --in 1h --for 30m = Server restart at the top of the hour.`" + `
+- ` + "`motd wall --ack = The conduct policy has changed; please read it before your next scene.`" + `
+
+### Permissions
+
+Anyone may read the message of the day and acknowledge announcements.
+Editing and announcing require write or delete access to motd; granted to
+staff by default.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Economy != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "money",
+			Handler: NewMoneyHandler(deps.Economy),
+			Help:    "Check your balance and pay other characters",
+			Usage:   "money | history | pay | mint | burn",
+			HelpText: `## Money
+
+Check what you hold and pay other characters. Every payment is recorded in
+a ledger, and both sides are told when money moves.
+
+### Usage
+
+- ` + "`money`" + ` - Show your balance in each currency
+- ` + "`money history [count]`" + ` - Show your recent transactions
+- ` + "`money pay <character> = <amount> [currency] [for <memo>]`" + ` - Pay another character
+
+### Staff
+
+- ` + "`money mint <character> = <amount> [currency] [for <memo>]`" + ` - Create money and give it to a character
+- ` + "`money burn <character> = <amount> [currency] [for <memo>]`" + ` - Take money from a character and destroy it
+
+Amounts are whole numbers. Without a currency the game's default currency
+is used. You can only pay out of your own balance, and never below zero.
+Every transaction is written to the audit log.
+
+### Examples
+
+- ` + "`money pay Bob = 30 for the old sword`" + `
+- ` + "`money pay Bob = 5 gold`" + `
+- ` + "`money mint Alice = 100 for the winter event prize`" + `
+
+### Permissions
+
+Anyone may check their balance and pay others. Minting and burning require
+the mint or burn action on the currency; granted to staff by default.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Paging != nil {
+		registerPaging(mustRegister, deps.Paging)
+	}
+
+	if deps.Preferences != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    prefsCommandName,
+			Handler: NewPrefsHandler(deps.Preferences),
+			Help:    "View and change your client preferences",
+			Usage:   prefsUsage,
+			HelpText: `## Prefs
+
+View and change how the game talks to your client: colors, screen width,
+time zone, how much of a long listing to show at once, and notifications.
+Preferences belong to your player, so every character you play shares them.
+
+### Usage
+
+- ` + "`prefs`" + ` - List your preferences and their values
+- ` + "`prefs <name>=<value>`" + ` - Change a preference
+- ` + "`prefs reset <name>`" + ` - Put a preference back to its default
+
+### Preferences
+
+- ` + "`ansi`" + ` - Show colors and text styles (` + "`on`" + ` or ` + "`off`" + `; default on)
+- ` + "`width`" + ` - Columns to wrap output to (20 to 250; default 78)
+- ` + "`timezone`" + ` - Time zone times are shown in, such as ` + "`America/New_York`" + ` (default UTC)
+- ` + "`pagesize`" + ` - Rows shown per page of a long listing (5 to 200; default 20)
+- ` + "`bell`" + ` - Ring the terminal bell when you are paged (default off)
+- ` + "`announce`" + ` - Show staff announcements as they are broadcast (default on)
+
+### Examples
+
+- ` + "`prefs ansi=off`" + `
+- ` + "`prefs timezone=Europe/London`" + `
+- ` + "`prefs reset width`" + ``,
+			Source: "core",
+		})
+	}
+
+	if deps.ErrorVerbosity != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    verboseCommandName,
+			Handler: NewVerboseHandler(deps.ErrorVerbosity),
+			Capabilities: []command.Capability{
+				{Action: "admin", Resource: "server", Scope: command.ScopeGlobal},
+			},
+			Help:  "Show error codes and policy decisions when commands fail",
+			Usage: verboseUsage,
+			HelpText: `## Verbose
+
+Turn error diagnostics on or off for this session. With verbose errors on,
+a failed command shows its player message followed by a diagnostics line:
+the error code, the command, and — for permission failures — the policy
+that decided and its reason. Players never see this line.
+
+The setting belongs to this session only. Other sessions keep their own
+setting, and a new session starts with verbose errors off.
+
+### Usage
+
+- ` + "`verbose`" + ` - Show whether verbose errors are on
+- ` + "`verbose on`" + ` - Show diagnostics with errors
+- ` + "`verbose off`" + ` - Show only the player message
+
+### Examples
+
+- ` + "`verbose on`" + ` then ` + "`dig Vault`" + ` shows
+  ` + "`[code=LOCATION_ACCESS_DENIED policy_id=... reason=...]`" + ` under a denial
+
+### Permissions
+
+Requires admin action on the server resource at global scope.`,
+			Source: "core",
+		})
+	}
+
+	if deps.Zones != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "zone",
+			Handler: NewZoneHandler(deps.Zones),
+			Help:    "Group locations into zones and broadcast to them",
+			Usage:   "zone | set | clear | list | emit",
+			HelpText: `## Zone
+
+Group locations into zones, such as a harbor district or a stretch of
+coast, and send one message to every location in a zone at once.
+
+### Usage
+
+- ` + "`zone`" + ` - Show the zone of your current location
+- ` + "`zone set <zone>`" + ` - Put your current location in a zone
+- ` + "`zone clear`" + ` - Take your current location out of its zone
+- ` + "`zone list <zone>`" + ` - List the locations in a zone
+- ` + "`zone emit <zone>[/<kind>] = <text>`" + ` - Broadcast to every location in a zone
+
+Zone names are lowercase words such as ` + "`harbor`" + ` or ` + "`north_coast`" + `.
+The optional kind labels the broadcast, such as ` + "`weather`" + ` or
+` + "`announcement`" + `, so clients and plugins can tell broadcasts apart.
+Broadcasts to one zone are rate limited.
+
+### Examples
+
+- ` + "`zone set harbor`" + `
+- ` + "`zone emit harbor/weather = Rain sweeps in off the bay.`" + `
+
+### Permissions
+
+Setting a zone requires write access to the location. Broadcasting requires
+the broadcast action on the zone. Both are granted to builders and staff by
+default.`,
+			Source: "core",
+		})
+	}
+	if deps.Capacity != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "capacity",
+			Handler: NewCapacityHandler(deps.Capacity),
+			Help:    "Cap how many characters a location holds",
+			Usage:   "capacity | set | clear | overflow",
+			HelpText: `## Capacity
+
+Cap how many characters your current location holds, for small rooms and
+crowded venues, and name where arrivals go once it is full.
+
+### Usage
+
+- ` + "`capacity`" + ` - Show the capacity and overflow of your current location
+- ` + "`capacity set <n>`" + ` - Let at most n characters into your current location
+- ` + "`capacity clear`" + ` - Remove the cap
+- ` + "`capacity overflow <location id>`" + ` - Send arrivals to another location while this one is full
+- ` + "`capacity overflow clear`" + ` - Turn arrivals away while this one is full
+
+Every character in the location counts, connected or not. A character
+walking toward a full location arrives in its overflow location if that has
+room, and is refused otherwise. Staff may enter a full location anyway.
+
+### Examples
+
+- ` + "`capacity set 4`" + `
+- ` + "`capacity overflow 01HX3Y5N8Q2R7T9V0W1Z2A3B4C`" + `
+
+### Permissions
+
+Requires write access to the location, granted to builders and staff by
+default.`,
+			Source: "core",
+		})
+	}
+	if deps.NPCs != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "npc",
+			Handler: NewNPCHandler(deps.NPCs),
+			Help:    "Spawn and script non-player characters",
+			Usage:   "npc [list] | spawn | despawn | show | behavior | wander",
+			HelpText: `## NPC
+
+Place non-player characters (NPCs) such as a harbourmaster or a street
+vendor in the world. NPCs show in location descriptions and in ` + "`who`" + `,
+marked apart from players. Plugins attached to an NPC react for it, and an
+NPC can wander through open exits on its own.
+
+### Usage
+
+- ` + "`npc`" + ` - List every NPC
+- ` + "`npc spawn <name>[=<description>]`" + ` - Spawn an NPC in your location
+- ` + "`npc despawn <name>`" + ` - Remove an NPC
+- ` + "`npc show <name>`" + ` - Show an NPC's location, behaviors, and wandering
+- ` + "`npc behavior <name>=<plugin>:<event>[,<event>...] ...`" + ` - Attach plugins
+- ` + "`npc behavior <name>=`" + ` - Detach every plugin
+- ` + "`npc wander <name>=<min>-<max> [<zone>]`" + ` - Wander every min to max
+- ` + "`npc wander <name>=off`" + ` - Stop wandering
+
+NPC names are unique, ignoring case. A plugin attached to an NPC receives an
+` + "`npc:<event>`" + ` event whenever a player's event of that type, such as
+` + "`say`" + ` or ` + "`pose`" + `, happens in the NPC's location, and may reply.
+A wandering NPC takes a random unlocked exit anyone can see, staying within
+the zone when one is given.
+
+### Examples
+
+- ` + "`npc spawn Old Tom=A grizzled harbourmaster.`" + `
+- ` + "`npc behavior Old Tom=barkeep:say,pose`" + `
+- ` + "`npc wander Old Tom=5m-15m harbor`" + `
+
+### Permissions
+
+Requires write access to the NPC's location. Granted to builders and staff
+by default.`,
+			Source: "core",
+		})
+	}
+	if deps.Posting != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "posting",
+			Handler: NewPostingHandler(deps.Posting),
+			Help:    "Control who may speak in a location or scene",
+			Usage:   "posting [#<scene>] | mode | voice | unvoice | queue | approve | reject",
+			HelpText: `## Posting
+
+Restrict who may say and pose in your location or in a scene. In
+` + "`everyone`" + ` mode, the default, anyone may speak. In ` + "`participants`" + `
+mode only characters given the floor may. In ` + "`moderated`" + ` mode
+anyone else's lines are held until a moderator approves or rejects them.
+Moderators always pass. OOC, emits, and whispers are never restricted.
+
+### Usage
+
+- ` + "`posting [#<scene>]`" + ` - Show the mode, participants, and held posts
+- ` + "`posting mode [#<scene>] everyone|participants|moderated`" + ` - Set the mode
+- ` + "`posting voice [#<scene>] <character>`" + ` - Give a character the floor
+- ` + "`posting unvoice [#<scene>] <character>`" + ` - Take the floor away
+- ` + "`posting queue [#<scene>]`" + ` - List held posts, oldest first
+- ` + "`posting approve <post>`" + ` - Publish a held post as its author wrote it
+- ` + "`posting reject <post>`" + ` - Discard a held post
+
+Without ` + "`#<scene>`" + ` the command applies to your location.
+
+### Examples
+
+- ` + "`posting mode moderated`" + `
+- ` + "`posting voice Alice`" + `
+- ` + "`posting mode #01HXYZ... participants`" + `
+
+### Permissions
+
+Anyone may view the mode. Changing it or working the queue requires the
+moderate action on the location or scene, granted to builders and staff
+for locations, and to staff and the owner for a scene.`,
+			Source: "core",
+		})
+	}
+	if deps.SecurityEvents != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    securityCommandName,
+			Handler: NewSecurityHandler(deps.SecurityEvents, deps.PlayerRepo),
+			Help:    "Show your account's security log",
+			Usage:   securityUsage,
+			HelpText: `## Security
+
+Show recent security events on your account, newest first: logins and
+failed logins with the address they came from, password changes, ended
+sessions, two-factor changes, impersonation, and account recovery. Check
+it when you get a suspicious-login alert.
+
+### Usage
+
+- ` + "`security`" + ` - Show your account's security log
+- ` + "`security <player>`" + ` - Show another player's security log (staff)
+
+### Permissions
+
+Anyone with an account may read their own log. Reading another player's
+requires write access to the player resource at global scope.`,
+			Source: "core",
+		})
+	}
+	if deps.Recovery != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "recover",
+			Handler: NewRecoverHandler(deps.Recovery),
+			Help:    "Recover an account with staff help when password and email are lost",
+			Usage:   "recover request | status | reset | queue | show | approve | deny",
+			HelpText: `## Recover
+
+Get back into your account when you have lost both its password and the
+email on it. Connect as a guest and file a request naming the account,
+with evidence that it is yours: your characters' names, when you last
+played, who you played with. You are given a recovery code, shown once.
+Staff review the request; once they approve it, the code sets a new
+password, here or through the web password reset form.
+
+### Usage
+
+- ` + "`recover request <account> = <evidence>`" + ` - File a request and get a recovery code
+- ` + "`recover status <code>`" + ` - See whether staff have decided your request
+- ` + "`recover reset <code> = <new password>`" + ` - Set a new password once approved
+
+### Staff
+
+- ` + "`recover queue`" + ` - List pending requests, oldest first
+- ` + "`recover show <id>`" + ` - Read a request and its evidence
+- ` + "`recover approve <id> [= <note>]`" + ` - Approve it; its code resets the password for 72 hours
+- ` + "`recover deny <id> = <note>`" + ` - Turn it down; the note is shown to the code holder
+
+A character may file 3 requests a day and an account may have 3 a day,
+one pending at a time. Filing is recorded in the account's security log,
+as is an approval. Staff may not review requests for their own account.
+Every step is written to the audit log; evidence and codes are not.
+
+### Examples
+
+- ` + "`recover request Ember = My characters are Ember and Ash; I last played in March in the Harbor scene with Juniper.`" + `
+- ` + "`recover approve 01JC8Z3M5Q9W0VYB2T7XK4RN6D = Confirmed with Juniper's player.`" + `
+
+### Permissions
+
+Anyone, guests included, may file, check, and redeem requests. The staff
+subcommands require the review action on recovery; granted to staff by
+default.`,
+			Source: "core",
+		})
+	}
+	if deps.Who != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "who",
+			Handler: NewWhoHandler(deps.Who, deps.WhoVisibility, npcLister(deps.NPCs), deps.WhoCharacters, deps.Connections),
+			Help:    "List who is online",
+			Usage:   whoUsage,
+			HelpText: `## Who
+
+List the characters online, followed by the non-player characters (NPCs)
+in the world, each marked ` + "`[NPC]`" + `. Characters you cannot see, such as
+dark or invisible staff, are left out.
+
+With a character name, say whether that character is online. For your own
+characters, and for staff, this also shows when the character was last
+seen and its recent connections.
+
+### Usage
+
+- ` + "`who`" + ` - List who is online
+- ` + "`who <character>`" + ` - Show whether a character is online`,
+			Source: "core",
+		})
+	}
+	if deps.Clock != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    timeCommandName,
+			Handler: NewTimeHandler(deps.Clock),
+			Help:    "Show the in-character date and time",
+			Usage:   "time",
+			HelpText: `## Time
+
+Show the in-character date, time of day, and season. The game clock runs
+faster than real time, so a day in the game passes in a few real hours.
+Outdoor locations see the sun rise and set.
+
+### Usage
+
+- ` + "`time`" + ` - Show the in-character date and time`,
+			Source: "core",
+		})
+	}
+	if deps.Traversal != nil {
+		registerTraversal(mustRegister, deps.Traversal)
+	}
+	if deps.Exits != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "exit",
+			Handler: NewExitHandler(deps.Exits),
+			Help:    "Set how long an exit takes to walk, what it costs, and its key",
+			Usage:   "exit <exit> | delay | cost | lock | unlock",
+			HelpText: `## Exit
+
+Make an exit of your location take time to walk, cost something to use, or
+open only for characters carrying its key.
+
+### Usage
+
+- ` + "`exit <exit>`" + ` - Show how long an exit takes and what it costs
+- ` + "`exit delay <exit> = <duration>`" + ` - Set the walk time; ` + "`none`" + ` makes it instant
+- ` + "`exit cost <exit> = <amount>`" + ` - Set the cost; ` + "`0`" + ` makes it free
+- ` + "`exit lock <exit> = <key id> [consume]`" + ` - Lock the exit with a key object
+- ` + "`exit unlock <exit>`" + ` - Remove the lock
+
+Durations use units such as ` + "`2s`" + ` or ` + "`1m30s`" + `, up to ten minutes.
+What a cost spends, such as stamina, is up to the game; without a cost hook
+exits are free to walk. A bidirectional exit's return side is set
+separately, from the other location.
+
+A locked exit lets through only a character holding the key object, either
+directly or inside something they carry, up to three containers deep. With
+` + "`consume`" + `, the key is used up on the way through. Everyone in the
+room sees a failed attempt at a locked exit.
+
+### Examples
+
+- ` + "`exit delay trail = 30s`" + `
+- ` + "`exit cost cliff = 5`" + `
+- ` + "`exit lock gate = 01JB8Z4V6E3Q9XK2T5M7N0PRSA consume`" + `
+
+### Permissions
+
+Requires write access to the exit; granted to builders by default.`,
+			Source: "core",
+		})
+	}
+	if deps.Builder != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "build",
+			Handler: NewBuildHandler(deps.Builder),
+			Help:    "Dig rooms, open and link exits, and describe things",
+			Usage:   "build dig | open | link | describe",
+			HelpText: `## Build
+
+The classic builder verbs. Each is also available with the ` + "`@`" + ` sigil,
+so ` + "`@dig`" + ` is ` + "`build dig`" + `. Exits are written ` + "`name;alias;alias`" + `.
+
+### Usage
+
+- ` + "`@dig <name>[=<exit>[,<return exit>]]`" + ` - Create a room, optionally with exits from here to it and back
+- ` + "`@open <exit>=<destination>[,<return exit>]`" + ` - Open an exit from here, optionally with one back
+- ` + "`@link <exit>=<destination>`" + ` - Point an exit of this room somewhere else
+- ` + "`@describe <here|me|object>=<text>`" + ` - Set a description; empty text clears it
+
+A destination is ` + "`here`" + `, a location ID such as ` + "`#01HXYZ...`" + `, or a
+location name. A dig or open that creates several things creates all of
+them or none: if a return exit's name is taken, the room and the first exit
+are not left behind. You own the rooms you dig.
+
+### Examples
+
+- ` + "`@dig North Hall=north;n,south;s`" + `
+- ` + "`@open gate;g=Town Square,out`" + `
+- ` + "`@link north=#01HXYZ123ABC`" + `
+- ` + "`@describe here=A drafty hall.`" + `
+
+### Permissions
+
+Requires write access to the locations and exits involved; granted to
+builders by default.`,
+			Source: "core",
+		})
+	}
+	if deps.Verbs != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "verb",
+			Handler: NewVerbHandler(deps.Verbs),
+			Help:    "List and define the verbs objects respond to",
+			Usage:   "verb <object> | set | remove",
+			HelpText: `## Verb
+
+Objects can carry verbs, such as ` + "`push`" + ` on a button, each handled by a
+plugin. Type the verb and the object's name to use it: ` + "`push button`" + `
+hands the button's push verb to its plugin, along with anything you type
+after the object's name.
+
+### Usage
+
+- ` + "`verb <object>`" + ` - List the verbs on an object here
+- ` + "`verb set <object>/<verb> = <plugin> [description]`" + ` - Add or replace a verb
+- ` + "`verb remove <object>/<verb>`" + ` - Remove a verb
+
+Verb names are lowercase words such as ` + "`push`" + ` or ` + "`ring_bell`" + `.
+
+### Examples
+
+- ` + "`verb set brass button/push = harbor-bell Rings the harbor bell.`" + `
+- ` + "`push button twice`" + `
+
+### Permissions
+
+Anyone in the same location as an object may use its verbs. An object's
+owner may define its verbs, and so may builders while it is unlocked.`,
+			Source: "core",
+		})
+	}
+	if deps.Appearance != nil {
+		registerAppearance(mustRegister, deps.Appearance)
+	}
+	if deps.Reports != nil {
+		mustRegister(command.CommandEntryConfig{
+			Name:    "report",
+			Handler: NewReportHandler(deps.Reports),
+			Help:    "Report a bug, typo, or harassment to staff",
+			Usage:   "report | <bug|typo|harassment> <text> | show | queue | claim | note | resolve",
+			HelpText: `## Report
+
+Tell staff about a bug, a typo, or someone harassing you. Your report
+records where you are and any scene you are in, so you need not explain
+where it happened. You are told when a staff member picks it up and again
+when it is resolved, with what was done.
+
+### Usage
+
+- ` + "`report bug <text>`" + ` - Report something that is broken
+- ` + "`report typo <text>`" + ` - Report a spelling or wording mistake
+- ` + "`report harassment <text>`" + ` - Report another player's behaviour
+- ` + "`report`" + ` - List the reports you have filed
+- ` + "`report show <id>`" + ` - Show one of your reports
+
+### Staff
+
+- ` + "`report queue [all]`" + ` - List open reports, or every report
+- ` + "`report claim <id>`" + ` - Take a report to work on
+- ` + "`report note <id> = <text>`" + ` - Add a staff note to a report
+- ` + "`report resolve <id> = <text>`" + ` - Close a report and tell the reporter
+
+You may have up to 10 open reports at a time. Reporters see their report's
+status and resolution but not staff notes. Every change is written to the
+audit log; report text is not.
+
+### Examples
+
+- ` + "`report typo The fountain's description says 'teh'.`" + `
+- ` + "`report harassment Bob keeps following me between rooms after I asked him to stop.`" + `
+- ` + "`report resolve 01JC8Z3M5Q9W0VYB2T7XK4RN6D = Fixed the description.`" + `
+
+### Permissions
+
+Anyone may file and read their own reports. The staff subcommands require
+the triage action on report; granted to staff by default.`,
+			Source: "core",
+		})
+	}
+}
+
+// registerTraversal registers the commands that walk characters through
+// exits.
+func registerTraversal(mustRegister func(command.CommandEntryConfig), admin TraversalAdmin) {
+	mustRegister(command.CommandEntryConfig{
+		Name:    "go",
+		Handler: NewGoHandler(admin),
+		Help:    "Walk through an exit",
+		Usage:   goUsage,
+		HelpText: `## Go
+
+Walk through an exit of your location. Some exits take time to walk: you
+set off at once, everyone here sees you leave, and you arrive when the walk
+is over. Until then you are still here and can change your mind.
+
+### Usage
+
+- ` + "`go <exit>`" + ` - Walk through an exit, by name or alias
+- ` + "`go`" + ` - Show where you are heading and when you will arrive
+
+Use ` + "`stop`" + ` to call off a walk. Some exits cost something to use,
+such as stamina; the cost is spent when you set off.
+
+### Examples
+
+- ` + "`go north`" + `
+- ` + "`go n`" + ``,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "stop",
+		Handler: NewStopHandler(admin),
+		Help:    "Stop walking through an exit",
+		Usage:   stopCommandName,
+		HelpText: `## Stop
+
+Call off a walk started with ` + "`go`" + `. You stay where you are. A cost
+spent setting off is not refunded.
+
+### Usage
+
+- ` + "`stop`" + ` - Stop walking`,
+		Source: "core",
+	})
+}
+
+// registerPaging registers the commands that send pages and manage who
+// may send them.
+func registerPaging(mustRegister func(command.CommandEntryConfig), admin PagingAdmin) {
+	mustRegister(command.CommandEntryConfig{
+		Name:    pageCommandName,
+		Handler: NewPageHandler(admin),
+		Help:    "Send a private message to a character anywhere",
+		Usage:   pageUsage,
+		HelpText: `## Page
+
+Send a private message to a character wherever they are. Start the message
+with ` + "`:`" + ` to pose it, or ` + "`;`" + ` to pose it without a space
+after your name. Without a name, the page goes to the character you last
+paged.
+
+If they are not connected, the page waits in their mailbox and is delivered
+when they next log in, unless the game turns that off. You are told when it
+is delivered. Pages are encrypted at rest.
+
+### Usage
+
+- ` + "`page <character>=<message>`" + ` - Page a character
+- ` + "`page <message>`" + ` - Page the character you last paged
+
+### Examples
+
+- ` + "`page Bob=Meet me at the docks?`" + `
+- ` + "`p Bob=:waves.`" + `
+- ` + "`page See you there.`" + `
+
+See ` + "`ignore`" + ` and ` + "`gag`" + ` to stop pages from someone.`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "ignore",
+		Handler: NewBlockHandler(admin, "ignore", paging.BlockIgnore),
+		Help:    "Refuse pages from a character",
+		Usage:   "ignore [<character>]",
+		HelpText: `## Ignore
+
+Refuse pages from a character. They are told you are not accepting their
+pages. Use ` + "`gag`" + ` to drop them without telling them instead.
+
+Either way, their say, pose, emit, whisper and page messages are hidden
+from you. Staff cannot be ignored.
+
+### Usage
+
+- ` + "`ignore`" + ` - List who you are ignoring and gagging
+- ` + "`ignore <character>`" + ` - Refuse pages from a character
+- ` + "`unignore <character>`" + ` - Accept their pages again`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "unignore",
+		Handler: NewUnblockHandler(admin, "unignore", paging.BlockIgnore),
+		Help:    "Accept pages from a character you ignored",
+		Usage:   "unignore <character>",
+		HelpText: `## Unignore
+
+Accept pages again from a character you ignored.
+
+### Usage
+
+- ` + "`unignore <character>`" + ``,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "gag",
+		Handler: NewBlockHandler(admin, "gag", paging.BlockGag),
+		Help:    "Silently drop pages from a character",
+		Usage:   "gag [<character>]",
+		HelpText: `## Gag
+
+Silently drop pages from a character. Their pages look sent to them, but
+you never see them and nothing is held for you. Their say, pose, emit and
+whisper messages are hidden from you as well. Staff cannot be gagged.
+
+### Usage
+
+- ` + "`gag`" + ` - List who you are ignoring and gagging
+- ` + "`gag <character>`" + ` - Drop pages from a character
+- ` + "`ungag <character>`" + ` - Receive their pages again`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "ungag",
+		Handler: NewUnblockHandler(admin, "ungag", paging.BlockGag),
+		Help:    "Receive pages from a character you gagged",
+		Usage:   "ungag <character>",
+		HelpText: `## Ungag
+
+Receive pages again from a character you gagged.
+
+### Usage
+
+- ` + "`ungag <character>`" + ``,
+		Source: "core",
+	})
+}
+
+// registerAppearance registers the commands that manage the layers of a
+// character's description.
+func registerAppearance(mustRegister func(command.CommandEntryConfig), admin AppearanceAdmin) {
+	mustRegister(command.CommandEntryConfig{
+		Name:    "wear",
+		Handler: NewWearHandler(admin),
+		Help:    "Put on an object you are holding",
+		Usage:   wearUsage,
+		HelpText: `## Wear
+
+Put on an object you are holding. While you wear it, its wear description is
+added to your own when others look at you.
+
+### Usage
+
+- ` + "`wear`" + ` - List what you are wearing
+- ` + "`wear <object>`" + ` - Put on an object in your inventory
+- ` + "`wear desc <object> = <text>`" + ` - Set how an object looks when worn
+- ` + "`wear desc <object> =`" + ` - Clear an object's wear description
+
+Use ` + "`remove`" + ` to take an object off. Dropping or giving away a worn
+object takes it off as well.
+
+### Permissions
+
+You may wear anything you hold. Setting a wear description requires write
+access to the object.`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "remove",
+		Handler: NewRemoveHandler(admin),
+		Help:    "Take off an object you are wearing",
+		Usage:   removeUsage,
+		HelpText: `## Remove
+
+Take off an object you are wearing. You keep holding it.
+
+### Usage
+
+- ` + "`remove <object>`" + ` - Take off a worn object`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "effect",
+		Handler: NewEffectHandler(admin),
+		Help:    "List and apply temporary description effects",
+		Usage:   "effect [<character>] | set | remove",
+		HelpText: `## Effect
+
+Effects are temporary additions to a character's description, such as
+` + "`glowing`" + ` from a spell or ` + "`soaked`" + ` from the rain. An effect
+can expire after a duration, and a private effect is shown only to the
+character and staff.
+
+### Usage
+
+- ` + "`effect [<character>]`" + ` - List the effects on a character (default: you)
+- ` + "`effect set <character>/<effect> [<duration>] [private] = <text>`" + ` - Apply or replace an effect
+- ` + "`effect remove <character>/<effect>`" + ` - Remove an effect
+
+Effect names are lowercase words such as ` + "`glowing`" + ` or
+` + "`soaked_through`" + `. Durations use units such as ` + "`30m`" + ` or
+` + "`2h`" + `; without one the effect lasts until removed.
+
+### Examples
+
+- ` + "`effect set me/soaked 1h = Rainwater drips from their hair.`" + `
+- ` + "`effect set Alaric/glowing private = A faint glow surrounds him.`" + `
+
+### Permissions
+
+You may apply effects to yourself. Applying them to others, and seeing
+their private effects, is granted to game masters and staff.`,
+		Source: "core",
+	})
+	mustRegister(command.CommandEntryConfig{
+		Name:    "appearance",
+		Handler: NewAppearanceHandler(admin),
+		Help:    "Show how a character looks",
+		Usage:   "appearance [<character>]",
+		HelpText: `## Appearance
+
+Show a character's full description: their own description, then what they
+are wearing, then the effects on them.
+
+### Usage
+
+- ` + "`appearance`" + ` - Show how you look
+- ` + "`appearance <character>`" + ` - Show how a character here looks`,
+		Source: "core",
+	})
 }
 
 // RegisterAll registers the compiled-in command handlers with the registry.
@@ -142,3 +1329,12 @@ Requires admin action on the server resource at global scope.`,
 		Source: "core",
 	})
 }
+
+// npcLister returns admin as an NPCLister, or a nil interface when admin is
+// nil so who leaves NPCs out rather than calling a nil service.
+func npcLister(admin NPCAdmin) NPCLister {
+	if admin == nil {
+		return nil
+	}
+	return admin
+}
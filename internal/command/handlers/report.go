@@ -0,0 +1,276 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/report"
+)
+
+const (
+	reportCommandName = "report"
+	reportUsage       = "report | <bug|typo|harassment> <text> | show <id> | queue [all] | claim <id> | note <id> = <text> | resolve <id> = <text>"
+
+	// reportExcerptLength bounds the report text shown on one list line.
+	reportExcerptLength = 50
+)
+
+// ReportAdmin files reports and runs the staff triage queue. This is the
+// ISP interface for the report command; *report.Service satisfies it.
+type ReportAdmin interface {
+	File(ctx context.Context, req report.FileRequest) (*report.Report, error)
+	Mine(ctx context.Context, reporterID ulid.ULID) ([]*report.Report, error)
+	Show(ctx context.Context, viewerID, id ulid.ULID) (*report.Report, error)
+	Queue(ctx context.Context, subject string, includeResolved bool) ([]*report.Report, error)
+	Claim(ctx context.Context, staff report.CharacterRef, id ulid.ULID) (*report.Report, error)
+	AddNote(ctx context.Context, staff report.CharacterRef, id ulid.ULID, body string) (*report.Note, error)
+	Resolve(ctx context.Context, staff report.CharacterRef, id ulid.ULID, resolution string) (*report.Report, error)
+}
+
+// NewReportHandler creates a command handler that files player reports,
+// lists the caller's own, and routes the staff triage subcommands.
+func NewReportHandler(admin ReportAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleReport(ctx, exec, admin)
+	}
+}
+
+func handleReport(ctx context.Context, exec *command.CommandExecution, admin ReportAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	caller := report.CharacterRef{ID: exec.CharacterID(), Name: exec.CharacterName()}
+
+	switch strings.ToLower(sub) {
+	case "":
+		return handleReportMine(ctx, exec, admin, caller)
+	case "show":
+		id, err := parseReportID(rest, "report show <id>")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Show(ctx, caller.ID, id)
+		if err != nil {
+			return reportError(err)
+		}
+		writeOutput(ctx, exec, reportCommandName, formatReport(r))
+		return nil
+	case "queue":
+		return handleReportQueue(ctx, exec, admin, caller, rest)
+	case "claim":
+		id, err := parseReportID(rest, "report claim <id>")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Claim(ctx, caller, id)
+		if err != nil {
+			return reportError(err)
+		}
+		writeOutputf(ctx, exec, reportCommandName, "You have claimed %s report %s.\n", r.Category, r.ID)
+		return nil
+	case "note":
+		id, body, err := parseReportIDAndText(rest, "report note <id> = <text>")
+		if err != nil {
+			return err
+		}
+		if _, err := admin.AddNote(ctx, caller, id, body); err != nil {
+			return reportError(err)
+		}
+		writeOutputf(ctx, exec, reportCommandName, "Note added to report %s.\n", id)
+		return nil
+	case "resolve":
+		id, resolution, err := parseReportIDAndText(rest, "report resolve <id> = <text>")
+		if err != nil {
+			return err
+		}
+		r, err := admin.Resolve(ctx, caller, id, resolution)
+		if err != nil {
+			return reportError(err)
+		}
+		writeOutputf(ctx, exec, reportCommandName, "Resolved %s report %s; %s has been told.\n",
+			r.Category, r.ID, r.Reporter.Name)
+		return nil
+	}
+
+	category, err := report.ParseCategory(sub)
+	if err != nil {
+		writeOutput(ctx, exec, reportCommandName, "Usage: "+reportUsage)
+		return nil
+	}
+	text := strings.TrimSpace(strings.TrimPrefix(rest, "="))
+	if text == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(reportCommandName, "report "+string(category)+" <text>")
+	}
+	r, err := admin.File(ctx, report.FileRequest{
+		Reporter:   caller,
+		Category:   category,
+		Text:       text,
+		LocationID: exec.LocationID(),
+	})
+	if err != nil {
+		return reportError(err)
+	}
+	writeOutputf(ctx, exec, reportCommandName,
+		"Filed %s report %s. You will be told when staff pick it up and when it is resolved.\n", r.Category, r.ID)
+	return nil
+}
+
+func handleReportMine(ctx context.Context, exec *command.CommandExecution, admin ReportAdmin, caller report.CharacterRef) error {
+	list, err := admin.Mine(ctx, caller.ID)
+	if err != nil {
+		return reportError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, reportCommandName, "You have filed no reports. Usage: "+reportUsage)
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString("Your reports:")
+	for _, r := range list {
+		fmt.Fprintf(&sb, "\n  %s  %-10s %-8s %s  %s",
+			r.ID, r.Category, r.Status, formatScheduleTime(r.CreatedAt), reportExcerpt(r.Text))
+	}
+	writeOutput(ctx, exec, reportCommandName, sb.String())
+	return nil
+}
+
+func handleReportQueue(ctx context.Context, exec *command.CommandExecution, admin ReportAdmin, caller report.CharacterRef, arg string) error {
+	if arg != "" && !strings.EqualFold(arg, "all") {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(reportCommandName, "report queue [all]")
+	}
+	includeResolved := arg != ""
+	list, err := admin.Queue(ctx, access.CharacterSubject(caller.ID.String()), includeResolved)
+	if err != nil {
+		return reportError(err)
+	}
+	if len(list) == 0 {
+		writeOutput(ctx, exec, reportCommandName, "The report queue is empty.")
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString("Report queue:")
+	for _, r := range list {
+		status := string(r.Status)
+		if r.Status == report.StatusClaimed {
+			status += " by " + r.ClaimedBy.Name
+		}
+		fmt.Fprintf(&sb, "\n  %s  %-10s %s  from %s at %s  [%s]\n    %s",
+			r.ID, r.Category, formatScheduleTime(r.CreatedAt), r.Reporter.Name, reportPlace(r), status,
+			reportExcerpt(r.Text))
+	}
+	writeOutput(ctx, exec, reportCommandName, sb.String())
+	return nil
+}
+
+// formatReport renders one report in full. Notes are only present when the
+// service returned them, which it does for staff.
+func formatReport(r *report.Report) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Report %s (%s, %s)\n", r.ID, r.Category, r.Status)
+	fmt.Fprintf(&sb, "Filed by %s at %s, %s\n", r.Reporter.Name, reportPlace(r), formatScheduleTime(r.CreatedAt))
+	if r.SceneID != (ulid.ULID{}) {
+		fmt.Fprintf(&sb, "Scene: %s\n", r.SceneID)
+	}
+	if r.ClaimedBy.Name != "" {
+		fmt.Fprintf(&sb, "Claimed by %s, %s\n", r.ClaimedBy.Name, formatScheduleTime(r.ClaimedAt))
+	}
+	if r.Status == report.StatusResolved {
+		fmt.Fprintf(&sb, "Resolved by %s, %s: %s\n", r.ResolvedBy.Name, formatScheduleTime(r.ResolvedAt), r.Resolution)
+	}
+	sb.WriteString("\n")
+	sb.WriteString(r.Text)
+	if len(r.Notes) > 0 {
+		sb.WriteString("\n\nStaff notes:")
+		for _, n := range r.Notes {
+			fmt.Fprintf(&sb, "\n  %s  %s: %s", formatScheduleTime(n.CreatedAt), n.Author.Name, n.Body)
+		}
+	}
+	return sb.String()
+}
+
+// reportPlace names where a report was filed.
+func reportPlace(r *report.Report) string {
+	switch {
+	case r.LocationName != "":
+		return r.LocationName
+	case r.LocationID != (ulid.ULID{}):
+		return "location " + r.LocationID.String()
+	default:
+		return "no location"
+	}
+}
+
+// reportExcerpt is the first line of text, cut to reportExcerptLength
+// runes.
+func reportExcerpt(text string) string {
+	text, _, _ = strings.Cut(text, "\n")
+	if utf8.RuneCountInString(text) <= reportExcerptLength {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:reportExcerptLength-1]) + "…"
+}
+
+func parseReportID(arg, usage string) (ulid.ULID, error) {
+	if arg == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return ulid.ULID{}, command.ErrInvalidArgs(reportCommandName, usage)
+	}
+	id, err := ulid.Parse(strings.ToUpper(arg))
+	if err != nil {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return ulid.ULID{}, command.WorldError(fmt.Sprintf("%q is not a report ID; see report.", arg), nil)
+	}
+	return id, nil
+}
+
+func parseReportIDAndText(args, usage string) (ulid.ULID, string, error) {
+	idArg, text, ok := strings.Cut(args, "=")
+	idArg, text = strings.TrimSpace(idArg), strings.TrimSpace(text)
+	if !ok || idArg == "" || text == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return ulid.ULID{}, "", command.ErrInvalidArgs(reportCommandName, usage)
+	}
+	id, err := parseReportID(idArg, usage)
+	if err != nil {
+		return ulid.ULID{}, "", err
+	}
+	return id, text, nil
+}
+
+// reportError surfaces the report service's validation, lookup, and triage
+// failures to the player as readable errors.
+func reportError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "REPORT_INVALID", "REPORT_CLAIMED", "REPORT_RESOLVED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "REPORT_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No report with that ID; see report.", nil)
+	case "REPORT_LIMIT":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf(
+			"You already have %d open reports; wait until staff resolve one before filing another.",
+			report.MaxOpenReports), nil)
+	case "REPORT_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(reportCommandName, "report")
+	}
+	return err
+}
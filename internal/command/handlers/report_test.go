@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/report"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubReportAdmin is a test implementation of ReportAdmin.
+type stubReportAdmin struct {
+	reports  map[ulid.ULID]*report.Report
+	filed    []report.FileRequest
+	subjects []string
+	denied   bool
+	err      error
+}
+
+func newStubReportAdmin() *stubReportAdmin {
+	return &stubReportAdmin{reports: map[ulid.ULID]*report.Report{}}
+}
+
+func (s *stubReportAdmin) add(r *report.Report) *report.Report {
+	s.reports[r.ID] = r
+	return r
+}
+
+func (s *stubReportAdmin) triage() error {
+	if s.denied {
+		return oops.Code("REPORT_ACCESS_DENIED").Errorf("not permitted to triage reports")
+	}
+	return nil
+}
+
+func (s *stubReportAdmin) get(id ulid.ULID) (*report.Report, error) {
+	r, ok := s.reports[id]
+	if !ok {
+		return nil, oops.Code("REPORT_NOT_FOUND").Wrap(report.ErrNotFound)
+	}
+	return r, nil
+}
+
+func (s *stubReportAdmin) File(_ context.Context, req report.FileRequest) (*report.Report, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.filed = append(s.filed, req)
+	r, err := report.NewReport(ulid.Make(), req.Reporter, req.Category, req.Text, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	r.LocationID = req.LocationID
+	return s.add(r), nil
+}
+
+func (s *stubReportAdmin) Mine(_ context.Context, reporterID ulid.ULID) ([]*report.Report, error) {
+	var out []*report.Report
+	for _, r := range s.reports {
+		if r.Reporter.ID == reporterID {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubReportAdmin) Show(_ context.Context, _, id ulid.ULID) (*report.Report, error) {
+	return s.get(id)
+}
+
+func (s *stubReportAdmin) Queue(_ context.Context, subject string, includeResolved bool) ([]*report.Report, error) {
+	if err := s.triage(); err != nil {
+		return nil, err
+	}
+	s.subjects = append(s.subjects, subject)
+	var out []*report.Report
+	for _, r := range s.reports {
+		if includeResolved || r.Status != report.StatusResolved {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubReportAdmin) Claim(_ context.Context, staff report.CharacterRef, id ulid.ULID) (*report.Report, error) {
+	if err := s.triage(); err != nil {
+		return nil, err
+	}
+	r, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != report.StatusOpen {
+		return nil, oops.Code("REPORT_CLAIMED").Errorf("report %s is already claimed by %s", id, r.ClaimedBy.Name)
+	}
+	r.Status, r.ClaimedBy, r.ClaimedAt = report.StatusClaimed, staff, time.Now()
+	return r, nil
+}
+
+func (s *stubReportAdmin) AddNote(_ context.Context, staff report.CharacterRef, id ulid.ULID, body string) (*report.Note, error) {
+	if err := s.triage(); err != nil {
+		return nil, err
+	}
+	r, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	note := &report.Note{ID: ulid.Make(), ReportID: id, Author: staff, Body: body, CreatedAt: time.Now()}
+	r.Notes = append(r.Notes, note)
+	return note, nil
+}
+
+func (s *stubReportAdmin) Resolve(_ context.Context, staff report.CharacterRef, id ulid.ULID, resolution string) (*report.Report, error) {
+	if err := s.triage(); err != nil {
+		return nil, err
+	}
+	r, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	r.Status, r.Resolution, r.ResolvedBy, r.ResolvedAt = report.StatusResolved, resolution, staff, time.Now()
+	return r, nil
+}
+
+var (
+	reportCharID     = ulid.Make()
+	reportLocationID = ulid.Make()
+)
+
+func runReport(t *testing.T, admin ReportAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   reportCharID,
+		CharacterName: "Alice",
+		LocationID:    reportLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewReportHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestReportFile(t *testing.T) {
+	admin := newStubReportAdmin()
+
+	out, err := runReport(t, admin, "typo teh fountain")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Filed typo report ")
+	require.Len(t, admin.filed, 1)
+	assert.Equal(t, report.FileRequest{
+		Reporter:   report.CharacterRef{ID: reportCharID, Name: "Alice"},
+		Category:   report.CategoryTypo,
+		Text:       "teh fountain",
+		LocationID: reportLocationID,
+	}, admin.filed[0])
+
+	_, err = runReport(t, admin, "Harassment = Bob keeps following me")
+	require.NoError(t, err)
+	require.Len(t, admin.filed, 2)
+	assert.Equal(t, report.CategoryHarassment, admin.filed[1].Category)
+	assert.Equal(t, "Bob keeps following me", admin.filed[1].Text)
+
+	admin.err = oops.Code("REPORT_LIMIT").Wrap(report.ErrTooManyOpen)
+	_, err = runReport(t, admin, "bug again")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestReportListsOwnReports(t *testing.T) {
+	admin := newStubReportAdmin()
+
+	out, err := runReport(t, admin, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "You have filed no reports.")
+
+	_, err = runReport(t, admin, "bug the door sticks")
+	require.NoError(t, err)
+	out, err = runReport(t, admin, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Your reports:")
+	assert.Contains(t, out, "the door sticks")
+	assert.Contains(t, out, "open")
+}
+
+func TestReportShow(t *testing.T) {
+	admin := newStubReportAdmin()
+	r := admin.add(&report.Report{
+		ID:           ulid.Make(),
+		Reporter:     report.CharacterRef{ID: reportCharID, Name: "Alice"},
+		Category:     report.CategoryBug,
+		Text:         "the door sticks",
+		LocationID:   reportLocationID,
+		LocationName: "Fountain Square",
+		SceneID:      ulid.Make(),
+		Status:       report.StatusResolved,
+		ClaimedBy:    report.CharacterRef{Name: "Sam"},
+		Resolution:   "planed the door",
+		ResolvedBy:   report.CharacterRef{Name: "Sam"},
+		Notes:        []*report.Note{{Author: report.CharacterRef{Name: "Sam"}, Body: "swollen hinge"}},
+	})
+
+	out, err := runReport(t, admin, "show "+r.ID.String())
+	require.NoError(t, err)
+	assert.Contains(t, out, "Report "+r.ID.String()+" (bug, resolved)")
+	assert.Contains(t, out, "Filed by Alice at Fountain Square")
+	assert.Contains(t, out, "Scene: "+r.SceneID.String())
+	assert.Contains(t, out, "Claimed by Sam")
+	assert.Contains(t, out, "Resolved by Sam")
+	assert.Contains(t, out, "planed the door")
+	assert.Contains(t, out, "Staff notes:")
+	assert.Contains(t, out, "Sam: swollen hinge")
+
+	_, err = runReport(t, admin, "show "+ulid.Make().String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	_, err = runReport(t, admin, "show nope")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestReportTriage(t *testing.T) {
+	admin := newStubReportAdmin()
+	r := admin.add(&report.Report{
+		ID:       ulid.Make(),
+		Reporter: report.CharacterRef{ID: ulid.Make(), Name: "Bob"},
+		Category: report.CategoryTypo,
+		Text:     "teh",
+		Status:   report.StatusOpen,
+	})
+
+	out, err := runReport(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Report queue:")
+	assert.Contains(t, out, "from Bob at no location")
+	assert.Equal(t, []string{access.CharacterSubject(reportCharID.String())}, admin.subjects)
+
+	out, err = runReport(t, admin, "claim "+r.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "You have claimed typo report "+r.ID.String()+".\n", out)
+	assert.Equal(t, "Alice", r.ClaimedBy.Name)
+
+	out, err = runReport(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Contains(t, out, "[claimed by Alice]")
+
+	_, err = runReport(t, admin, "claim "+r.ID.String())
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+
+	out, err = runReport(t, admin, "note "+r.ID.String()+" = checked the room")
+	require.NoError(t, err)
+	assert.Equal(t, "Note added to report "+r.ID.String()+".\n", out)
+	require.Len(t, r.Notes, 1)
+	assert.Equal(t, "checked the room", r.Notes[0].Body)
+
+	out, err = runReport(t, admin, "resolve "+r.ID.String()+" = fixed the typo")
+	require.NoError(t, err)
+	assert.Equal(t, "Resolved typo report "+r.ID.String()+"; Bob has been told.\n", out)
+	assert.Equal(t, "fixed the typo", r.Resolution)
+
+	out, err = runReport(t, admin, "queue")
+	require.NoError(t, err)
+	assert.Equal(t, "The report queue is empty.\n", out)
+	out, err = runReport(t, admin, "queue all")
+	require.NoError(t, err)
+	assert.Contains(t, out, "resolved")
+}
+
+func TestReportTriageDenied(t *testing.T) {
+	admin := newStubReportAdmin()
+	admin.denied = true
+
+	_, err := runReport(t, admin, "queue")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+	_, err = runReport(t, admin, "claim "+ulid.Make().String())
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestReportInvalidArgs(t *testing.T) {
+	for _, args := range []string{
+		"bug", "typo =", "show", "claim", "note", "note " + ulid.Make().String(), "note = x",
+		"resolve", "resolve " + ulid.Make().String() + " =", "queue soon",
+	} {
+		_, err := runReport(t, newStubReportAdmin(), args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+
+	out, err := runReport(t, newStubReportAdmin(), "idea more dragons")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: report")
+}
+
+func TestReportExcerpt(t *testing.T) {
+	assert.Equal(t, "short", reportExcerpt("short\nsecond line"))
+	long := reportExcerpt(string(make([]rune, 80)))
+	assert.Equal(t, reportExcerptLength, len([]rune(long)))
+	assert.Equal(t, '…', []rune(long)[reportExcerptLength-1])
+}
@@ -47,7 +47,39 @@ type AdminDeps struct {
 	PlayerSessions auth.PlayerSessionRepository
 	ResetRepo      auth.PasswordResetRepository
 	CharLister     CharacterLister
-	PluginLister   PluginLister // optional: nil disables plugin admin commands
+	PluginLister   PluginLister          // optional: nil disables plugin admin commands
+	Scheduler      ScheduleAdmin         // optional: nil disables the schedule command
+	Jobs           JobAdmin              // optional: nil disables the job command
+	DeadLetters    DeadLetterAdmin       // optional: nil disables the deadletter command
+	PluginStorage  PluginStorageAdmin    // optional: nil disables the pluginstore command
+	Webhooks       WebhookAdmin          // optional: nil disables the webhook command
+	Bans           BanAdmin              // optional: nil disables the ban command
+	Help           HelpAdmin             // optional: nil disables the helpedit command
+	Visibility     VisibilityAdmin       // optional: nil disables the visibility command
+	MOTD           MOTDAdmin             // optional: nil disables the motd command
+	Economy        EconomyAdmin          // optional: nil disables the money command
+	Paging         PagingAdmin           // optional: nil disables the page, ignore, unignore, gag, and ungag commands
+	Zones          ZoneAdmin             // optional: nil disables the zone command
+	Capacity       CapacityAdmin         // optional: nil disables the capacity command
+	Traversal      TraversalAdmin        // optional: nil disables the go and stop commands
+	Exits          ExitAdmin             // optional: nil disables the exit command
+	Builder        BuildAdmin            // optional: nil disables the build command
+	Verbs          ObjectVerbAdmin       // optional: nil disables the verb command
+	Roles          RoleAdmin             // optional: nil disables the role command
+	Appearance     AppearanceAdmin       // optional: nil disables the wear, remove, effect, and appearance commands
+	Reports        ReportAdmin           // optional: nil disables the report command
+	Preferences    PreferencesAdmin      // optional: nil disables the prefs command
+	ErrorVerbosity ErrorVerbosityAdmin   // optional: nil disables the verbose command
+	NPCs           NPCAdmin              // optional: nil disables the npc command and leaves NPCs out of who
+	Posting        PostingAdmin          // optional: nil disables the posting command
+	Recovery       RecoveryAdmin         // optional: nil disables the recover command
+	Who            WhoDirectory          // optional: nil disables the who command
+	WhoVisibility  WhoVisibility         // optional: nil lists dark and invisible characters in who
+	WhoCharacters  WhoCharacters         // optional: nil disables who <character>
+	Connections    ConnectionHistory     // optional: nil leaves last-seen times out of who <character>
+	Clock          GameClock             // optional: nil disables the time command
+	SecurityLog    auth.SecurityRecorder // optional: nil skips security event recording
+	SecurityEvents SecurityEventLister   // optional: nil disables the security command
 }
 
 type resetArgs struct {
@@ -178,6 +210,10 @@ func handleResetPassword(ctx context.Context, exec *command.CommandExecution, de
 		//nolint:wrapcheck // ErrResetPasswordFailed wraps the cause with oops
 		return command.ErrResetPasswordFailed(err)
 	}
+	if deps.SecurityLog != nil {
+		deps.SecurityLog.Record(ctx, player.ID, auth.SecurityEventPasswordChanged, auth.SecurityOrigin{},
+			"admin reset by "+exec.CharacterName())
+	}
 
 	// Best-effort invalidation — track failures for accurate audit logging.
 	var warnings []string
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/roles"
+)
+
+const (
+	roleCommandName = "role"
+	roleUsage       = "role list <character> | grant <character> <role> | revoke <character> <role> | hierarchy"
+)
+
+// RoleAdmin grants and revokes character roles. This is the ISP interface
+// for the role admin command; *roles.Service satisfies it.
+type RoleAdmin interface {
+	FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (roles.CharacterRef, error)
+	Assignments(ctx context.Context, characterID ulid.ULID) ([]roles.Assignment, error)
+	Grant(ctx context.Context, character roles.CharacterRef, role, grantedBy string) (*roles.Assignment, error)
+	Revoke(ctx context.Context, character roles.CharacterRef, role, revokedBy string) error
+}
+
+// NewRoleHandler creates a command handler that routes role subcommands.
+func NewRoleHandler(admin RoleAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleRole(ctx, exec, admin)
+	}
+}
+
+func handleRole(ctx context.Context, exec *command.CommandExecution, admin RoleAdmin) error {
+	fields := strings.Fields(exec.Args)
+	if len(fields) == 0 {
+		writeOutput(ctx, exec, roleCommandName, "Usage: "+roleUsage)
+		return nil
+	}
+
+	switch sub, args := fields[0], fields[1:]; {
+	case sub == "hierarchy" && len(args) == 0:
+		writeOutput(ctx, exec, roleCommandName, roleHierarchyText())
+		return nil
+	case sub == "list" && len(args) == 1:
+		return handleRoleList(ctx, exec, admin, args[0])
+	case sub == "grant" && len(args) == 2:
+		return handleRoleGrant(ctx, exec, admin, args[0], args[1])
+	case sub == "revoke" && len(args) == 2:
+		return handleRoleRevoke(ctx, exec, admin, args[0], args[1])
+	case sub == "list" || sub == "grant" || sub == "revoke":
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(roleCommandName, roleUsage)
+	default:
+		writeOutput(ctx, exec, roleCommandName, "Usage: "+roleUsage)
+		return nil
+	}
+}
+
+// roleHierarchyText describes which system roles inherit which.
+func roleHierarchyText() string {
+	var sb strings.Builder
+	sb.WriteString("Role hierarchy (each role also holds the roles listed after it):")
+	system := access.SystemRoles()
+	for i := len(system) - 1; i >= 0; i-- {
+		inherited := access.InheritedRoles(system[i])
+		if len(inherited) == 0 {
+			fmt.Fprintf(&sb, "\n  %-8s held by every character", system[i])
+			continue
+		}
+		fmt.Fprintf(&sb, "\n  %-8s %s", system[i], strings.Join(inherited, ", "))
+	}
+	sb.WriteString("\nOther roles inherit nothing.")
+	return sb.String()
+}
+
+func handleRoleList(ctx context.Context, exec *command.CommandExecution, admin RoleAdmin, name string) error {
+	character, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return roleError(err, name, "")
+	}
+	assigned, err := admin.Assignments(ctx, character.ID)
+	if err != nil {
+		return roleError(err, character.Name, "")
+	}
+
+	names := make([]string, len(assigned))
+	for i, a := range assigned {
+		names[i] = a.Role
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Roles for %s: %s", character.Name, strings.Join(access.EffectiveRoles(names), ", "))
+	if len(assigned) == 0 {
+		sb.WriteString("\n  No roles granted.")
+	}
+	for _, a := range assigned {
+		fmt.Fprintf(&sb, "\n  %-10s", a.Role)
+		if a.GrantedBy != "" {
+			fmt.Fprintf(&sb, " granted by %s", a.GrantedBy)
+		}
+		if !a.GrantedAt.IsZero() {
+			fmt.Fprintf(&sb, " on %s", formatScheduleTime(a.GrantedAt))
+		}
+	}
+	writeOutput(ctx, exec, roleCommandName, sb.String())
+	return nil
+}
+
+func handleRoleGrant(ctx context.Context, exec *command.CommandExecution, admin RoleAdmin, name, role string) error {
+	character, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return roleError(err, name, role)
+	}
+	granted, err := admin.Grant(ctx, character, role, exec.CharacterName())
+	if err != nil {
+		return roleError(err, character.Name, role)
+	}
+	writeOutputf(ctx, exec, roleCommandName, "Granted the %s role to %s.\n", granted.Role, character.Name)
+	return nil
+}
+
+func handleRoleRevoke(ctx context.Context, exec *command.CommandExecution, admin RoleAdmin, name, role string) error {
+	character, err := admin.FindCharacter(ctx, exec.CharacterID(), name)
+	if err != nil {
+		return roleError(err, name, role)
+	}
+	if err := admin.Revoke(ctx, character, role, exec.CharacterName()); err != nil {
+		return roleError(err, character.Name, role)
+	}
+	writeOutputf(ctx, exec, roleCommandName, "Revoked the %s role from %s.\n", strings.ToLower(role), character.Name)
+	return nil
+}
+
+// roleError surfaces the role service's validation and lookup failures to
+// staff; anything else falls through to the generic player message. The
+// cause is not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func roleError(err error, name, role string) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	role = strings.ToLower(role)
+	switch oopsErr.Code() {
+	case "ROLE_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "ROLE_CHARACTER_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("No character named %q.", name), nil)
+	case "ROLE_EXISTS":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%s already holds the %s role.", name, role), nil)
+	case "ROLE_NOT_ASSIGNED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%s does not hold the %s role directly; see role list %s.", name, role, name), nil)
+	case "ROLE_LAST_ADMIN":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("%s is the last admin; grant admin to another character first.", name), nil)
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/roles"
+)
+
+// stubRoleAdmin is a test implementation of RoleAdmin.
+type stubRoleAdmin struct {
+	characters  []roles.CharacterRef
+	assignments []roles.Assignment
+	revokedBy   []string
+	revokeErr   error
+}
+
+func (s *stubRoleAdmin) FindCharacter(_ context.Context, _ ulid.ULID, name string) (roles.CharacterRef, error) {
+	for _, c := range s.characters {
+		if strings.EqualFold(c.Name, name) {
+			return c, nil
+		}
+	}
+	return roles.CharacterRef{}, oops.Code("ROLE_CHARACTER_NOT_FOUND").Wrap(roles.ErrCharacterNotFound)
+}
+
+func (s *stubRoleAdmin) Assignments(_ context.Context, characterID ulid.ULID) ([]roles.Assignment, error) {
+	var out []roles.Assignment
+	for _, a := range s.assignments {
+		if a.CharacterID == characterID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *stubRoleAdmin) Grant(_ context.Context, character roles.CharacterRef, role, grantedBy string) (*roles.Assignment, error) {
+	if role == "player" {
+		return nil, oops.Code("ROLE_INVALID").Errorf("every character holds the player role; it cannot be granted or revoked")
+	}
+	for _, a := range s.assignments {
+		if a.CharacterID == character.ID && a.Role == role {
+			return nil, oops.Code("ROLE_EXISTS").Errorf("already assigned")
+		}
+	}
+	a := roles.Assignment{
+		CharacterID: character.ID,
+		Role:        role,
+		GrantedBy:   grantedBy,
+		GrantedAt:   time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}
+	s.assignments = append(s.assignments, a)
+	return &a, nil
+}
+
+func (s *stubRoleAdmin) Revoke(_ context.Context, character roles.CharacterRef, role, revokedBy string) error {
+	if s.revokeErr != nil {
+		return s.revokeErr
+	}
+	for i, a := range s.assignments {
+		if a.CharacterID == character.ID && a.Role == role {
+			s.assignments = append(s.assignments[:i], s.assignments[i+1:]...)
+			s.revokedBy = append(s.revokedBy, revokedBy)
+			return nil
+		}
+	}
+	return oops.Code("ROLE_NOT_ASSIGNED").Wrap(roles.ErrNotFound)
+}
+
+func runRole(t *testing.T, admin RoleAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewRoleHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestRoleGrantListRevoke(t *testing.T) {
+	alice := roles.CharacterRef{ID: ulid.Make(), Name: "Alice"}
+	admin := &stubRoleAdmin{characters: []roles.CharacterRef{alice}}
+
+	out, err := runRole(t, admin, "list alice")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Roles for Alice: player")
+	assert.Contains(t, out, "No roles granted.")
+
+	out, err = runRole(t, admin, "grant alice gm")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Granted the gm role to Alice.")
+
+	out, err = runRole(t, admin, "list Alice")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Roles for Alice: player, builder, gm")
+	assert.Contains(t, out, "granted by Admin on 2026-10-18 12:00:00 UTC")
+
+	_, err = runRole(t, admin, "grant alice gm")
+	require.Error(t, err)
+	assert.Equal(t, "Alice already holds the gm role.", command.PlayerMessage(err))
+
+	out, err = runRole(t, admin, "revoke alice gm")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Revoked the gm role from Alice.")
+	assert.Equal(t, []string{"Admin"}, admin.revokedBy)
+
+	_, err = runRole(t, admin, "revoke alice builder")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "Alice does not hold the builder role directly")
+}
+
+func TestRoleSurfacesServiceErrors(t *testing.T) {
+	alice := roles.CharacterRef{ID: ulid.Make(), Name: "Alice"}
+
+	_, err := runRole(t, &stubRoleAdmin{}, "grant bob gm")
+	require.Error(t, err)
+	assert.Equal(t, `No character named "bob".`, command.PlayerMessage(err))
+
+	_, err = runRole(t, &stubRoleAdmin{characters: []roles.CharacterRef{alice}}, "grant alice player")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "every character holds the player role")
+
+	last := &stubRoleAdmin{
+		characters: []roles.CharacterRef{alice},
+		revokeErr:  oops.Code("ROLE_LAST_ADMIN").Wrap(roles.ErrLastAdmin),
+	}
+	_, err = runRole(t, last, "revoke alice admin")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "Alice is the last admin")
+}
+
+func TestRoleHierarchy(t *testing.T) {
+	out, err := runRole(t, &stubRoleAdmin{}, "hierarchy")
+	require.NoError(t, err)
+	assert.Contains(t, out, "admin    gm, builder, player")
+	assert.Contains(t, out, "player   held by every character")
+}
+
+func TestRoleUsage(t *testing.T) {
+	out, err := runRole(t, &stubRoleAdmin{}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: role list")
+
+	_, err = runRole(t, &stubRoleAdmin{}, "grant alice")
+	require.Error(t, err)
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/scheduler"
+)
+
+const (
+	scheduleCommandName = "schedule"
+	scheduleUsage       = "schedule list | info <name> | add <name> [--jitter <dur>] = <schedule> | <action> | enable <name> | disable <name> | remove <name>"
+	scheduleAddUsage    = "schedule add <name> [--jitter <duration>] = <schedule> | emit <subject> <message>  or  ... | plugin <plugin> [payload]"
+	// scheduleInfoRuns is how many upcoming runs schedule info shows.
+	scheduleInfoRuns = 5
+)
+
+// ScheduleAdmin manages scheduled world events. This is the ISP interface
+// for the schedule admin command; *scheduler.Scheduler satisfies it.
+type ScheduleAdmin interface {
+	Register(ctx context.Context, spec scheduler.JobSpec) (scheduler.Job, error)
+	Get(ctx context.Context, name string) (scheduler.Job, error)
+	List(ctx context.Context) ([]scheduler.Job, error)
+	Enable(ctx context.Context, name string) error
+	Disable(ctx context.Context, name string) error
+	Remove(ctx context.Context, name string) error
+	NextRuns(ctx context.Context, name string, n int) ([]time.Time, error)
+}
+
+// NewScheduleHandler creates a command handler that routes schedule
+// subcommands.
+func NewScheduleHandler(admin ScheduleAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleSchedule(ctx, exec, admin)
+	}
+}
+
+func handleSchedule(ctx context.Context, exec *command.CommandExecution, admin ScheduleAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "list":
+		return handleScheduleList(ctx, exec, admin)
+	case "info":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(scheduleCommandName, "schedule info <name>")
+		}
+		return handleScheduleInfo(ctx, exec, admin, rest)
+	case "add":
+		return handleScheduleAdd(ctx, exec, admin, rest)
+	case "enable", "disable", "remove":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(scheduleCommandName, "schedule "+sub+" <name>")
+		}
+		return handleScheduleChange(ctx, exec, admin, sub, rest)
+	default:
+		writeOutput(ctx, exec, scheduleCommandName, "Usage: "+scheduleUsage)
+		return nil
+	}
+}
+
+func handleScheduleList(ctx context.Context, exec *command.CommandExecution, admin ScheduleAdmin) error {
+	jobs, err := admin.List(ctx)
+	if err != nil {
+		return scheduleError(err)
+	}
+	if len(jobs) == 0 {
+		writeOutput(ctx, exec, scheduleCommandName, "No scheduled jobs.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Scheduled jobs:")
+	for _, job := range jobs {
+		state := "enabled"
+		if !job.Enabled {
+			state = "disabled"
+		}
+		fmt.Fprintf(&sb, "\n  %-20s %-8s %-8s %-16s next %s",
+			job.Name, string(job.Action), state, job.Schedule, formatScheduleTime(job.NextRunAt))
+	}
+	writeOutput(ctx, exec, scheduleCommandName, sb.String())
+	return nil
+}
+
+func handleScheduleInfo(ctx context.Context, exec *command.CommandExecution, admin ScheduleAdmin, name string) error {
+	job, err := admin.Get(ctx, name)
+	if err != nil {
+		return scheduleError(err)
+	}
+	runs, err := admin.NextRuns(ctx, name, scheduleInfoRuns)
+	if err != nil {
+		return scheduleError(err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Job: %s\n", job.Name)
+	fmt.Fprintf(&sb, "Schedule: %s\n", job.Schedule)
+	fmt.Fprintf(&sb, "Enabled: %t\n", job.Enabled)
+	switch job.Action {
+	case scheduler.ActionEmit:
+		fmt.Fprintf(&sb, "Action: emit on %s\n", job.Subject)
+	case scheduler.ActionPlugin:
+		fmt.Fprintf(&sb, "Action: plugin %s\n", job.Plugin)
+	}
+	if job.Message != "" {
+		fmt.Fprintf(&sb, "Message: %s\n", job.Message)
+	}
+	if job.Jitter > 0 {
+		fmt.Fprintf(&sb, "Jitter: up to %s\n", job.Jitter)
+	}
+	if job.CreatedBy != "" {
+		fmt.Fprintf(&sb, "Created by: %s\n", job.CreatedBy)
+	}
+	if job.LastRunAt != nil {
+		fmt.Fprintf(&sb, "Last run: %s\n", formatScheduleTime(*job.LastRunAt))
+	}
+	if job.LastError != "" {
+		fmt.Fprintf(&sb, "Last error: %s\n", job.LastError)
+	}
+	sb.WriteString("Next runs:")
+	for _, run := range runs {
+		fmt.Fprintf(&sb, "\n  %s", formatScheduleTime(run))
+	}
+	writeOutput(ctx, exec, scheduleCommandName, sb.String())
+	return nil
+}
+
+func handleScheduleAdd(ctx context.Context, exec *command.CommandExecution, admin ScheduleAdmin, args string) error {
+	spec, err := parseScheduleAdd(args)
+	if err != nil {
+		return err
+	}
+	spec.CreatedBy = exec.CharacterName()
+
+	job, err := admin.Register(ctx, spec)
+	if err != nil {
+		return scheduleError(err)
+	}
+	writeOutputf(ctx, exec, scheduleCommandName, "Scheduled %s; next run %s.\n",
+		job.Name, formatScheduleTime(job.NextRunAt))
+	return nil
+}
+
+// parseScheduleAdd parses "<name> [--jitter <dur>] = <schedule> | <action>".
+func parseScheduleAdd(args string) (scheduler.JobSpec, error) {
+	head, tail, ok := strings.Cut(args, "=")
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return scheduler.JobSpec{}, command.ErrInvalidArgs(scheduleCommandName, scheduleAddUsage)
+	}
+	schedule, action, ok := strings.Cut(tail, "|")
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return scheduler.JobSpec{}, command.ErrInvalidArgs(scheduleCommandName, scheduleAddUsage)
+	}
+
+	var spec scheduler.JobSpec
+	fields := strings.Fields(head)
+	switch {
+	case len(fields) == 1:
+	case len(fields) == 3 && fields[1] == "--jitter":
+		jitter, err := time.ParseDuration(fields[2])
+		if err != nil {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return scheduler.JobSpec{}, command.ErrInvalidArgs(scheduleCommandName, scheduleAddUsage)
+		}
+		spec.Jitter = jitter
+	default:
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return scheduler.JobSpec{}, command.ErrInvalidArgs(scheduleCommandName, scheduleAddUsage)
+	}
+	spec.Name = fields[0]
+	spec.Schedule = strings.TrimSpace(schedule)
+
+	kind, rest, _ := strings.Cut(strings.TrimSpace(action), " ")
+	target, message, _ := strings.Cut(strings.TrimSpace(rest), " ")
+	spec.Action = scheduler.Action(kind)
+	spec.Message = strings.TrimSpace(message)
+	switch spec.Action {
+	case scheduler.ActionEmit:
+		spec.Subject = target
+	case scheduler.ActionPlugin:
+		spec.Plugin = target
+	}
+	return spec, nil
+}
+
+func handleScheduleChange(ctx context.Context, exec *command.CommandExecution, admin ScheduleAdmin, sub, name string) error {
+	var err error
+	switch sub {
+	case "enable":
+		err = admin.Enable(ctx, name)
+	case "disable":
+		err = admin.Disable(ctx, name)
+	default:
+		err = admin.Remove(ctx, name)
+	}
+	if err != nil {
+		return scheduleError(err)
+	}
+	// enable/disable/remove all take a plain "d" for the past tense.
+	writeOutputf(ctx, exec, scheduleCommandName, "Job %s %sd.\n", name, sub)
+	return nil
+}
+
+// scheduleError surfaces the scheduler's validation and lookup failures to
+// staff verbatim; anything else falls through to the generic player message.
+// The cause is not wrapped: oops resolves the innermost code, which would
+// mask WORLD_ERROR.
+func scheduleError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "SCHEDULE_INVALID", "SCHEDULE_EXISTS", "SCHEDULE_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
+
+func formatScheduleTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05 UTC")
+}
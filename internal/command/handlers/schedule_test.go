@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/scheduler"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubScheduleAdmin is a test implementation of ScheduleAdmin.
+type stubScheduleAdmin struct {
+	jobs       map[string]scheduler.Job
+	registered []scheduler.JobSpec
+	toggled    []string
+	err        error
+}
+
+func newStubScheduleAdmin(jobs ...scheduler.Job) *stubScheduleAdmin {
+	s := &stubScheduleAdmin{jobs: make(map[string]scheduler.Job)}
+	for _, j := range jobs {
+		s.jobs[j.Name] = j
+	}
+	return s
+}
+
+func (s *stubScheduleAdmin) Register(_ context.Context, spec scheduler.JobSpec) (scheduler.Job, error) {
+	if s.err != nil {
+		return scheduler.Job{}, s.err
+	}
+	s.registered = append(s.registered, spec)
+	return scheduler.Job{JobSpec: spec, Enabled: true, NextRunAt: time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC)}, nil
+}
+
+func (s *stubScheduleAdmin) Get(_ context.Context, name string) (scheduler.Job, error) {
+	job, ok := s.jobs[name]
+	if !ok {
+		return scheduler.Job{}, oops.Code("SCHEDULE_NOT_FOUND").Errorf("no job named %q", name)
+	}
+	return job, nil
+}
+
+func (s *stubScheduleAdmin) List(_ context.Context) ([]scheduler.Job, error) {
+	jobs := make([]scheduler.Job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	return jobs, nil
+}
+
+func (s *stubScheduleAdmin) toggle(op, name string) error {
+	if _, ok := s.jobs[name]; !ok {
+		return oops.Code("SCHEDULE_NOT_FOUND").Errorf("no job named %q", name)
+	}
+	s.toggled = append(s.toggled, op+" "+name)
+	return nil
+}
+
+func (s *stubScheduleAdmin) Enable(_ context.Context, name string) error {
+	return s.toggle("enable", name)
+}
+
+func (s *stubScheduleAdmin) Disable(_ context.Context, name string) error {
+	return s.toggle("disable", name)
+}
+
+func (s *stubScheduleAdmin) Remove(_ context.Context, name string) error {
+	return s.toggle("remove", name)
+}
+
+func (s *stubScheduleAdmin) NextRuns(_ context.Context, name string, n int) ([]time.Time, error) {
+	job := s.jobs[name]
+	runs := make([]time.Time, n)
+	for i := range runs {
+		runs[i] = job.NextRunAt.Add(time.Duration(i) * time.Hour)
+	}
+	return runs, nil
+}
+
+func runSchedule(t *testing.T, admin ScheduleAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewScheduleHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func weatherJob() scheduler.Job {
+	return scheduler.Job{
+		JobSpec: scheduler.JobSpec{
+			Name:      "weather",
+			Schedule:  "@hourly",
+			Action:    scheduler.ActionEmit,
+			Subject:   "system",
+			Message:   "Rain falls.",
+			Jitter:    5 * time.Minute,
+			CreatedBy: "Admin",
+		},
+		Enabled:   true,
+		NextRunAt: time.Date(2026, 10, 17, 11, 2, 0, 0, time.UTC),
+		LastError: "bus down",
+	}
+}
+
+func TestScheduleAddParsesEmitJob(t *testing.T) {
+	admin := newStubScheduleAdmin()
+
+	out, err := runSchedule(t, admin, "add weather --jitter 5m = */30 * * * * | emit system A light rain begins to fall.")
+	require.NoError(t, err)
+
+	require.Len(t, admin.registered, 1)
+	assert.Equal(t, scheduler.JobSpec{
+		Name:      "weather",
+		Schedule:  "*/30 * * * *",
+		Action:    scheduler.ActionEmit,
+		Subject:   "system",
+		Message:   "A light rain begins to fall.",
+		Jitter:    5 * time.Minute,
+		CreatedBy: "Admin",
+	}, admin.registered[0])
+	assert.Contains(t, out, "Scheduled weather; next run 2026-10-17 11:00:00 UTC.")
+}
+
+func TestScheduleAddParsesPluginJob(t *testing.T) {
+	admin := newStubScheduleAdmin()
+
+	_, err := runSchedule(t, admin, `add restock = @daily | plugin shops {"zone":"market"}`)
+	require.NoError(t, err)
+
+	require.Len(t, admin.registered, 1)
+	spec := admin.registered[0]
+	assert.Equal(t, scheduler.ActionPlugin, spec.Action)
+	assert.Equal(t, "shops", spec.Plugin)
+	assert.Equal(t, `{"zone":"market"}`, spec.Message)
+	assert.Empty(t, spec.Subject)
+}
+
+func TestScheduleAddRejectsMalformedArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{"missing equals", "add weather @hourly | emit system hi"},
+		{"missing pipe", "add weather = @hourly emit system hi"},
+		{"extra name tokens", "add weather now = @hourly | emit system hi"},
+		{"bad jitter", "add weather --jitter soon = @hourly | emit system hi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := newStubScheduleAdmin()
+			_, err := runSchedule(t, admin, tt.args)
+			require.Error(t, err)
+			errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+			assert.Empty(t, admin.registered)
+		})
+	}
+}
+
+func TestScheduleAddSurfacesValidationMessage(t *testing.T) {
+	admin := newStubScheduleAdmin()
+	admin.err = oops.Code("SCHEDULE_INVALID").Errorf("cron expression must have 5 fields, got 2")
+
+	_, err := runSchedule(t, admin, "add weather = * * | emit system hi")
+	require.Error(t, err)
+	assert.Equal(t, "cron expression must have 5 fields, got 2", command.PlayerMessage(err))
+}
+
+func TestScheduleListShowsJobs(t *testing.T) {
+	out, err := runSchedule(t, newStubScheduleAdmin(weatherJob()), "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "weather")
+	assert.Contains(t, out, "@hourly")
+	assert.Contains(t, out, "next 2026-10-17 11:02:00 UTC")
+
+	out, err = runSchedule(t, newStubScheduleAdmin(), "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No scheduled jobs.")
+}
+
+func TestScheduleInfoShowsDetailsAndNextRuns(t *testing.T) {
+	out, err := runSchedule(t, newStubScheduleAdmin(weatherJob()), "info weather")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "Action: emit on system")
+	assert.Contains(t, out, "Jitter: up to 5m0s")
+	assert.Contains(t, out, "Last error: bus down")
+	assert.Contains(t, out, "2026-10-17 11:02:00 UTC")
+	assert.Contains(t, out, "2026-10-17 15:02:00 UTC")
+}
+
+func TestScheduleChangeSubcommands(t *testing.T) {
+	tests := []struct {
+		args string
+		want string
+	}{
+		{"enable weather", "Job weather enabled."},
+		{"disable weather", "Job weather disabled."},
+		{"remove weather", "Job weather removed."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			out, err := runSchedule(t, newStubScheduleAdmin(weatherJob()), tt.args)
+			require.NoError(t, err)
+			assert.Contains(t, out, tt.want)
+		})
+	}
+}
+
+func TestScheduleChangeUnknownJobShowsMessage(t *testing.T) {
+	_, err := runSchedule(t, newStubScheduleAdmin(), "disable missing")
+	require.Error(t, err)
+	assert.Equal(t, `no job named "missing"`, command.PlayerMessage(err))
+}
+
+func TestScheduleWithoutSubcommandShowsUsage(t *testing.T) {
+	out, err := runSchedule(t, newStubScheduleAdmin(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: schedule list")
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/command"
+)
+
+const (
+	securityCommandName = "security"
+	securityUsage       = "security [<player>]"
+)
+
+// capSecurityEventsOthers lets staff read another player's security log,
+// the same grant resetpassword needs to set a password.
+var capSecurityEventsOthers = command.Capability{Action: "write", Resource: "player", Scope: command.ScopeGlobal}
+
+// SecurityEventLister reads a player's security event log. This is the ISP
+// interface for the security command; *auth.Service satisfies it.
+type SecurityEventLister interface {
+	ListSecurityEvents(ctx context.Context, viewer auth.SecurityViewer, playerID ulid.ULID, limit int) ([]*auth.SecurityEvent, error)
+}
+
+// NewSecurityHandler creates a command handler that shows the caller's
+// account security log, or with a player name, that player's for staff.
+func NewSecurityHandler(events SecurityEventLister, players auth.PlayerRepository) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleSecurity(ctx, exec, events, players)
+	}
+}
+
+func handleSecurity(ctx context.Context, exec *command.CommandExecution, events SecurityEventLister, players auth.PlayerRepository) error {
+	target := strings.TrimSpace(exec.Args)
+	if strings.ContainsAny(target, " \t") {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(securityCommandName, securityUsage)
+	}
+
+	viewer := auth.SecurityViewer{PlayerID: exec.PlayerID()}
+	playerID := exec.PlayerID()
+	if target == "" {
+		if playerID.IsZero() {
+			//nolint:wrapcheck // WorldError creates a structured oops error
+			return command.WorldError("The security log belongs to an account, and this session has none.", nil)
+		}
+	} else {
+		subject := access.CharacterSubject(exec.CharacterID().String())
+		capability := capSecurityEventsOthers
+		allowed, err := exec.Services().Engine().CanPerformAction(ctx, subject, capability.Action, capability.Resource, capability.EffectiveScope())
+		if err != nil {
+			return oops.With("command", securityCommandName).Wrap(err)
+		}
+		if !allowed {
+			//nolint:wrapcheck // ErrInsufficientCapability creates a structured oops error
+			return command.ErrInsufficientCapability(securityCommandName, capability)
+		}
+		player, err := players.GetByUsername(ctx, target)
+		if err != nil {
+			if errors.Is(err, auth.ErrNotFound) {
+				//nolint:wrapcheck // ErrTargetNotFound creates a structured oops error
+				return command.ErrTargetNotFound(target)
+			}
+			return oops.With("username", target).Wrap(err)
+		}
+		viewer.Admin = true
+		playerID = player.ID
+	}
+
+	list, err := events.ListSecurityEvents(ctx, viewer, playerID, auth.DefaultSecurityEventLimit)
+	if err != nil {
+		if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "AUTH_SECURITY_LOG_DISABLED" {
+			//nolint:wrapcheck // WorldError creates a structured oops error
+			return command.WorldError("The security log is not enabled on this game.", nil)
+		}
+		return oops.With("player_id", playerID.String()).Wrap(err)
+	}
+	writeOutput(ctx, exec, securityCommandName, formatSecurityEvents(list))
+	return nil
+}
+
+// formatSecurityEvents renders events newest first, one per line.
+func formatSecurityEvents(events []*auth.SecurityEvent) string {
+	if len(events) == 0 {
+		return "No security events recorded."
+	}
+	var b strings.Builder
+	b.WriteString("Recent security events, newest first:")
+	for _, e := range events {
+		b.WriteString("\n  ")
+		b.WriteString(e.CreatedAt.UTC().Format(time.DateTime))
+		b.WriteString("  ")
+		b.WriteString(strings.ReplaceAll(string(e.Type), "_", " "))
+		if e.IPAddress != "" {
+			b.WriteString(" from ")
+			b.WriteString(e.IPAddress)
+		}
+		if e.Detail != "" {
+			b.WriteString(" (")
+			b.WriteString(e.Detail)
+			b.WriteString(")")
+		}
+	}
+	return b.String()
+}
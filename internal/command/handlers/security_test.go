@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/auth"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubSecurityEventLister is a test implementation of SecurityEventLister.
+type stubSecurityEventLister struct {
+	events   []*auth.SecurityEvent
+	err      error
+	viewer   auth.SecurityViewer
+	playerID ulid.ULID
+}
+
+func (s *stubSecurityEventLister) ListSecurityEvents(_ context.Context, viewer auth.SecurityViewer, playerID ulid.ULID, _ int) ([]*auth.SecurityEvent, error) {
+	s.viewer, s.playerID = viewer, playerID
+	return s.events, s.err
+}
+
+func runSecurity(t *testing.T, lister SecurityEventLister, players auth.PlayerRepository, engine types.AccessPolicyEngine, playerID ulid.ULID, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Alice",
+		PlayerID:      playerID,
+		Args:          args,
+		Output:        &buf,
+		Services:      command.NewTestServices(command.ServicesConfig{Engine: engine}),
+	})
+	err := NewSecurityHandler(lister, players)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestSecurityShowsOwnLog(t *testing.T) {
+	playerID := ulid.Make()
+	lister := &stubSecurityEventLister{events: []*auth.SecurityEvent{
+		{Type: auth.SecurityEventLoginFailed, IPAddress: "203.0.113.7", CreatedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{Type: auth.SecurityEventSessionTerminated, Detail: "logout", CreatedAt: time.Date(2026, 2, 28, 9, 30, 0, 0, time.UTC)},
+	}}
+
+	out, err := runSecurity(t, lister, authmocks.NewMockPlayerRepository(t), policytest.DenyAllEngine(), playerID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "Recent security events, newest first:\n"+
+		"  2026-03-01 12:00:00  login failed from 203.0.113.7\n"+
+		"  2026-02-28 09:30:00  session terminated (logout)\n", out)
+	assert.Equal(t, auth.SecurityViewer{PlayerID: playerID}, lister.viewer)
+	assert.Equal(t, playerID, lister.playerID)
+}
+
+func TestSecurityWithoutAccountFails(t *testing.T) {
+	_, err := runSecurity(t, &stubSecurityEventLister{}, authmocks.NewMockPlayerRepository(t), policytest.DenyAllEngine(), ulid.ULID{}, "")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestSecurityOtherPlayerRequiresCapability(t *testing.T) {
+	_, err := runSecurity(t, &stubSecurityEventLister{}, authmocks.NewMockPlayerRepository(t), policytest.DenyAllEngine(), ulid.Make(), "bob")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestSecurityStaffReadsOtherPlayer(t *testing.T) {
+	bob := &auth.Player{ID: ulid.Make(), Username: "bob"}
+	players := authmocks.NewMockPlayerRepository(t)
+	players.EXPECT().GetByUsername(mock.Anything, "bob").Return(bob, nil)
+	lister := &stubSecurityEventLister{}
+
+	out, err := runSecurity(t, lister, players, policytest.AllowAllEngine(), ulid.Make(), "bob")
+	require.NoError(t, err)
+	assert.Equal(t, "No security events recorded.\n", out)
+	assert.True(t, lister.viewer.Admin)
+	assert.Equal(t, bob.ID, lister.playerID)
+}
+
+func TestSecurityReportsDisabledLog(t *testing.T) {
+	lister := &stubSecurityEventLister{err: oops.Code("AUTH_SECURITY_LOG_DISABLED").Errorf("security event log is not configured")}
+	_, err := runSecurity(t, lister, authmocks.NewMockPlayerRepository(t), policytest.DenyAllEngine(), ulid.Make(), "")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestRegisterAdminSecurity(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get(securityCommandName)
+	assert.False(t, found, "security requires the SecurityEvents dependency")
+
+	deps.SecurityEvents = &stubSecurityEventLister{}
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get(securityCommandName)
+	assert.True(t, found)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/gameclock"
+)
+
+const timeCommandName = "time"
+
+// GameClock reads the in-character time. This is the ISP interface for the
+// time command; *gameclock.Clock satisfies it.
+type GameClock interface {
+	Now() gameclock.Moment
+}
+
+// periodPhrases reads each part of the day as it follows a clock time.
+var periodPhrases = map[gameclock.Period]string{
+	gameclock.PeriodNight:     "at night",
+	gameclock.PeriodDawn:      "at dawn",
+	gameclock.PeriodMorning:   "in the morning",
+	gameclock.PeriodAfternoon: "in the afternoon",
+	gameclock.PeriodDusk:      "at dusk",
+	gameclock.PeriodEvening:   "in the evening",
+}
+
+// NewTimeHandler creates a command handler that shows the in-character
+// date, time of day, and season.
+func NewTimeHandler(clock GameClock) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		now := clock.Now()
+		msg := "It is " + now.Clock() + " " + periodPhrases[now.Period] + " on " + now.Date() + "."
+		if now.Season != "" {
+			msg += " It is " + now.Season + "."
+		}
+		writeOutput(ctx, exec, timeCommandName, msg)
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/gameclock"
+)
+
+// stubClock always reads the same moment.
+type stubClock gameclock.Moment
+
+func (s stubClock) Now() gameclock.Moment { return gameclock.Moment(s) }
+
+func runTime(t *testing.T, now gameclock.Moment) string {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		Output:        &buf,
+	})
+	require.NoError(t, NewTimeHandler(stubClock(now))(context.Background(), exec))
+	return buf.String()
+}
+
+func TestTimeShowsDateTimeAndSeason(t *testing.T) {
+	out := runTime(t, gameclock.Moment{
+		Year: 12, Month: 3, MonthName: "March", Day: 3, Hour: 9, Minute: 41,
+		Season: "spring", Period: gameclock.PeriodMorning,
+	})
+	assert.Equal(t, "It is 09:41 in the morning on 3 March, year 12. It is spring.\n", out)
+}
+
+func TestTimeWithoutSeasons(t *testing.T) {
+	out := runTime(t, gameclock.Moment{
+		Year: 1, Month: 1, MonthName: "Thaw", Day: 1, Hour: 18, Period: gameclock.PeriodDusk,
+	})
+	assert.Equal(t, "It is 18:00 at dusk on 1 Thaw, year 1.\n", out)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world/traversal"
+)
+
+const (
+	goCommandName   = "go"
+	goUsage         = "go <exit>"
+	stopCommandName = "stop"
+)
+
+// TraversalAdmin walks characters through exits. This is the ISP interface
+// for the go and stop commands; *traversal.Service satisfies it.
+type TraversalAdmin interface {
+	Go(ctx context.Context, req traversal.Request) (traversal.Move, error)
+	Stop(ctx context.Context, characterID ulid.ULID) (traversal.Move, error)
+	Pending(characterID ulid.ULID) (traversal.Move, bool)
+}
+
+// NewGoHandler creates a command handler that walks the caller through an
+// exit of their location. Without an exit it reports the walk under way.
+func NewGoHandler(admin TraversalAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleGo(ctx, exec, admin)
+	}
+}
+
+// NewStopHandler creates a command handler that calls off the caller's
+// pending walk.
+func NewStopHandler(admin TraversalAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleStop(ctx, exec, admin)
+	}
+}
+
+func handleGo(ctx context.Context, exec *command.CommandExecution, admin TraversalAdmin) error {
+	name := strings.TrimSpace(exec.Args)
+	if name == "" {
+		move, ok := admin.Pending(exec.CharacterID())
+		if !ok {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(goCommandName, goUsage)
+		}
+		writeOutputf(ctx, exec, goCommandName, "You are heading %s, arriving in %s.\n",
+			move.ExitName, formatWalkTime(time.Until(move.ArrivesAt())))
+		return nil
+	}
+
+	move, err := admin.Go(ctx, traversal.Request{
+		CharacterID:   exec.CharacterID(),
+		CharacterName: exec.CharacterName(),
+		Exit:          name,
+	})
+	if err != nil {
+		return traversalError(err)
+	}
+	if move.Pending() {
+		writeOutputf(ctx, exec, goCommandName, "You head %s. It will take %s; type stop to stay here.\n",
+			move.ExitName, formatWalkTime(move.Delay))
+		return nil
+	}
+	writeOutputf(ctx, exec, goCommandName, "You go %s.\n", move.ExitName)
+	return nil
+}
+
+func handleStop(ctx context.Context, exec *command.CommandExecution, admin TraversalAdmin) error {
+	move, err := admin.Stop(ctx, exec.CharacterID())
+	if err != nil {
+		return traversalError(err)
+	}
+	writeOutputf(ctx, exec, stopCommandName, "You stop heading %s.\n", move.ExitName)
+	return nil
+}
+
+// formatWalkTime renders a walk's duration to the second, never less than
+// one second.
+func formatWalkTime(d time.Duration) string {
+	return max(d.Round(time.Second), time.Second).String()
+}
+
+// traversalError surfaces exit lookup and traversal failures to the player;
+// anything else falls through to the generic player message. The cause is
+// not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func traversalError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "EXIT_NOT_FOUND", "EXIT_ACCESS_DENIED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You can't go that way.", nil)
+	case "EXIT_LOCKED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That way is locked.", nil)
+	case "LOCATION_FULL":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("There is no room for you there.", nil)
+	case "CHARACTER_NOT_IN_LOCATION":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You are not in a location.", nil)
+	case "TRAVERSAL_IN_PROGRESS", "TRAVERSAL_NOT_PENDING", "TRAVERSAL_REFUSED":
+		// A refusal carries the cost hook's own explanation.
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/traversal"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubTraversal is a test implementation of TraversalAdmin.
+type stubTraversal struct {
+	move     traversal.Move
+	err      error
+	pending  bool
+	requests []traversal.Request
+	stopped  []ulid.ULID
+}
+
+func (s *stubTraversal) Go(_ context.Context, req traversal.Request) (traversal.Move, error) {
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return traversal.Move{}, s.err
+	}
+	return s.move, nil
+}
+
+func (s *stubTraversal) Stop(_ context.Context, characterID ulid.ULID) (traversal.Move, error) {
+	s.stopped = append(s.stopped, characterID)
+	if s.err != nil {
+		return traversal.Move{}, s.err
+	}
+	return s.move, nil
+}
+
+func (s *stubTraversal) Pending(ulid.ULID) (traversal.Move, bool) {
+	return s.move, s.pending
+}
+
+func runTraversal(t *testing.T, handler command.CommandHandler, charID ulid.ULID, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   charID,
+		CharacterName: "Alice",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := handler(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestGoThroughInstantExit(t *testing.T) {
+	admin := &stubTraversal{move: traversal.Move{ExitName: "north"}}
+	charID := ulid.Make()
+
+	out, err := runTraversal(t, NewGoHandler(admin), charID, " north ")
+	require.NoError(t, err)
+	assert.Equal(t, "You go north.\n", out)
+	require.Len(t, admin.requests, 1)
+	assert.Equal(t, traversal.Request{CharacterID: charID, CharacterName: "Alice", Exit: "north"}, admin.requests[0])
+}
+
+func TestGoThroughSlowExit(t *testing.T) {
+	admin := &stubTraversal{move: traversal.Move{ExitName: "trail", Delay: 2500 * time.Millisecond}}
+
+	out, err := runTraversal(t, NewGoHandler(admin), ulid.Make(), "trail")
+	require.NoError(t, err)
+	assert.Equal(t, "You head trail. It will take 3s; type stop to stay here.\n", out)
+}
+
+func TestGoWithoutExitReportsPendingWalk(t *testing.T) {
+	admin := &stubTraversal{}
+	_, err := runTraversal(t, NewGoHandler(admin), ulid.Make(), "")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+
+	admin.pending = true
+	admin.move = traversal.Move{ExitName: "trail", Delay: time.Minute, StartedAt: time.Now()}
+	out, err := runTraversal(t, NewGoHandler(admin), ulid.Make(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "You are heading trail, arriving in")
+	assert.Empty(t, admin.requests)
+}
+
+func TestGoSurfacesTraversalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no such exit", oops.Code("EXIT_NOT_FOUND").Wrap(world.ErrNotFound), "You can't go that way."},
+		{"not allowed", oops.Code("EXIT_ACCESS_DENIED").Errorf("denied"), "You can't go that way."},
+		{"locked", oops.Code("EXIT_LOCKED").Errorf(`exit "gate" is locked`), "That way is locked."},
+		{"full", oops.Code("LOCATION_FULL").Errorf("location is full"), "There is no room for you there."},
+		{"already walking", oops.Code("TRAVERSAL_IN_PROGRESS").Errorf("you are already heading trail; type stop to stay here"), "you are already heading trail; type stop to stay here"},
+		{"cost refused", oops.Code("TRAVERSAL_REFUSED").Wrap(errors.New("you are too tired to climb")), "you are too tired to climb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin := &stubTraversal{err: tt.err}
+			_, err := runTraversal(t, NewGoHandler(admin), ulid.Make(), "north")
+			require.Error(t, err)
+			assert.Equal(t, tt.want, command.PlayerMessage(err))
+		})
+	}
+}
+
+func TestStopCancelsWalk(t *testing.T) {
+	admin := &stubTraversal{move: traversal.Move{ExitName: "trail"}}
+	charID := ulid.Make()
+
+	out, err := runTraversal(t, NewStopHandler(admin), charID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "You stop heading trail.\n", out)
+	assert.Equal(t, []ulid.ULID{charID}, admin.stopped)
+
+	admin.err = oops.Code("TRAVERSAL_NOT_PENDING").Errorf("you are not going anywhere")
+	_, err = runTraversal(t, NewStopHandler(admin), charID, "")
+	require.Error(t, err)
+	assert.Equal(t, "you are not going anywhere", command.PlayerMessage(err))
+}
@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	verbCommandName = "verb"
+	verbUsage       = "verb <object> | set <object>/<verb> = <plugin> [description] | remove <object>/<verb>"
+	verbSetUsage    = "verb set <object>/<verb> = <plugin> [description]"
+	verbRemoveUsage = "verb remove <object>/<verb>"
+)
+
+// ObjectVerbAdmin lists, defines, and triggers verbs on the objects in a
+// location. This is the ISP interface for the verb command and the verb
+// fallback; *world.Service satisfies it.
+type ObjectVerbAdmin interface {
+	GetObjectsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Object, error)
+	DefineObjectVerb(ctx context.Context, subjectID string, id ulid.ULID, verb world.ObjectVerb) error
+	RemoveObjectVerb(ctx context.Context, subjectID string, id ulid.ULID, name string) error
+	TriggerObjectVerb(ctx context.Context, subjectID string, inv world.VerbInvocation) error
+}
+
+// NewVerbHandler creates a command handler that lists the verbs on an object
+// in the caller's location and defines or removes them.
+func NewVerbHandler(admin ObjectVerbAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleVerb(ctx, exec, admin)
+	}
+}
+
+func handleVerb(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin) error {
+	args := strings.TrimSpace(exec.Args)
+	sub, rest, _ := strings.Cut(args, " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "":
+		writeOutput(ctx, exec, verbCommandName, "Usage: "+verbUsage)
+		return nil
+	case "set":
+		return handleVerbSet(ctx, exec, admin, subject, rest)
+	case "remove":
+		return handleVerbRemove(ctx, exec, admin, subject, rest)
+	default:
+		return handleVerbList(ctx, exec, admin, subject, args)
+	}
+}
+
+func handleVerbList(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin, subject, name string) error {
+	obj, err := findVerbObject(ctx, exec, admin, subject, name)
+	if err != nil {
+		return err
+	}
+	if len(obj.Verbs) == 0 {
+		writeOutputf(ctx, exec, verbCommandName, "%s has no verbs.\n", obj.Name)
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Verbs on %s:", obj.Name)
+	for _, v := range obj.Verbs {
+		fmt.Fprintf(&sb, "\n  %s (%s)", v.Name, v.Plugin)
+		if v.Description != "" {
+			fmt.Fprintf(&sb, " - %s", v.Description)
+		}
+	}
+	writeOutput(ctx, exec, verbCommandName, sb.String())
+	return nil
+}
+
+func handleVerbSet(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin, subject, args string) error {
+	target, binding, found := strings.Cut(args, "=")
+	objName, verbName, ok := splitObjectVerb(target)
+	plugin, description, _ := strings.Cut(strings.TrimSpace(binding), " ")
+	if !found || !ok || plugin == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(verbCommandName, verbSetUsage)
+	}
+	obj, err := findVerbObject(ctx, exec, admin, subject, objName)
+	if err != nil {
+		return err
+	}
+	verb := world.ObjectVerb{
+		Name:        verbName,
+		Plugin:      strings.ToLower(plugin),
+		Description: strings.TrimSpace(description),
+	}
+	if err := admin.DefineObjectVerb(ctx, subject, obj.ID, verb); err != nil {
+		return objectVerbError(err)
+	}
+	writeOutputf(ctx, exec, verbCommandName, "%s now has verb %s, handled by %s.\n", obj.Name, verb.Name, verb.Plugin)
+	return nil
+}
+
+func handleVerbRemove(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin, subject, args string) error {
+	objName, verbName, ok := splitObjectVerb(args)
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(verbCommandName, verbRemoveUsage)
+	}
+	obj, err := findVerbObject(ctx, exec, admin, subject, objName)
+	if err != nil {
+		return err
+	}
+	if err := admin.RemoveObjectVerb(ctx, subject, obj.ID, verbName); err != nil {
+		return objectVerbError(err)
+	}
+	writeOutputf(ctx, exec, verbCommandName, "Removed verb %s from %s.\n", verbName, obj.Name)
+	return nil
+}
+
+// splitObjectVerb splits "<object>/<verb>" on its last slash.
+func splitObjectVerb(s string) (objName, verbName string, ok bool) {
+	i := strings.LastIndex(s, "/")
+	if i < 0 {
+		return "", "", false
+	}
+	objName = strings.TrimSpace(s[:i])
+	verbName = strings.ToLower(strings.TrimSpace(s[i+1:]))
+	return objName, verbName, objName != "" && verbName != ""
+}
+
+// findVerbObject resolves name to one object in the caller's location.
+func findVerbObject(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin, subject, name string) (*world.Object, error) {
+	objs, err := locationObjects(ctx, exec, admin, subject)
+	if err != nil {
+		return nil, err
+	}
+	return matchObjectName(objs, name, "here")
+}
+
+// matchObjectName picks the object in objs that name refers to: an exact
+// (case-insensitive) name match wins, then a unique name prefix. where
+// completes the not-found message ("I don't see "x" here.").
+func matchObjectName(objs []*world.Object, name, where string) (*world.Object, error) {
+	lower := strings.ToLower(name)
+	var prefixed []*world.Object
+	for _, obj := range objs {
+		objName := strings.ToLower(obj.Name)
+		if objName == lower {
+			return obj, nil
+		}
+		if strings.HasPrefix(objName, lower) {
+			prefixed = append(prefixed, obj)
+		}
+	}
+	switch len(prefixed) {
+	case 0:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError(fmt.Sprintf("I don't see %q %s.", name, where), nil)
+	case 1:
+		return prefixed[0], nil
+	default:
+		return nil, ambiguousObjects(prefixed)
+	}
+}
+
+func locationObjects(ctx context.Context, exec *command.CommandExecution, admin ObjectVerbAdmin, subject string) ([]*world.Object, error) {
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("You are not in a location.", nil)
+	}
+	objs, err := admin.GetObjectsByLocation(ctx, subject, exec.LocationID())
+	if err != nil {
+		return nil, objectVerbError(err)
+	}
+	return objs, nil
+}
+
+func ambiguousObjects(objs []*world.Object) error {
+	names := make([]string, len(objs))
+	for i, obj := range objs {
+		names[i] = obj.Name
+	}
+	//nolint:wrapcheck // WorldError creates a structured oops error
+	return command.WorldError("Which one? I see: "+strings.Join(names, ", ")+".", nil)
+}
+
+// objectVerbError surfaces the world service's verb validation and lookup
+// failures to the player and maps policy denials to the permission error;
+// anything else falls through to the generic player message. The cause is
+// not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func objectVerbError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "OBJECT_VERB_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "OBJECT_VERB_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That object has no such verb.", nil)
+	case "OBJECT_VERB_LIMIT":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(fmt.Sprintf("That object already has %d verbs.", world.MaxObjectVerbs), nil)
+	case "OBJECT_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That object is no longer here.", nil)
+	case world.CodeConcurrentEdit:
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("That object changed while you were editing it. Try again.", nil)
+	case "OBJECT_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(verbCommandName, "object")
+	case "LOCATION_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(verbCommandName, "location")
+	}
+	return err
+}
+
+// ObjectVerbFallback resolves input that names no command as a verb on an
+// object in the caller's location, so "push button twice" triggers the push
+// verb on the button with "twice" as its arguments.
+type ObjectVerbFallback struct {
+	admin ObjectVerbAdmin
+}
+
+var _ command.VerbFallback = (*ObjectVerbFallback)(nil)
+
+// NewObjectVerbFallback creates an ObjectVerbFallback over admin.
+func NewObjectVerbFallback(admin ObjectVerbAdmin) *ObjectVerbFallback {
+	return &ObjectVerbFallback{admin: admin}
+}
+
+// TryVerb triggers verb on the object args names, if an object in the
+// caller's location carries that verb. The object is named by its full name
+// at the start of args (the longest such name wins) or, failing that, by a
+// unique object whose name has a word starting with args' first word.
+// Anything after the object's name is passed to the verb. It reports false
+// when no object here carries the verb under that name, so the dispatcher
+// can answer with its usual unknown-command error.
+func (f *ObjectVerbFallback) TryVerb(ctx context.Context, exec *command.CommandExecution, verb, args string) (bool, error) {
+	verb = strings.ToLower(verb)
+	args = strings.TrimSpace(args)
+	if args == "" || world.ValidateVerbName(verb) != nil || exec.LocationID().IsZero() {
+		return false, nil
+	}
+	subject := access.CharacterSubject(exec.CharacterID().String())
+	objs, err := f.admin.GetObjectsByLocation(ctx, subject, exec.LocationID())
+	if err != nil {
+		// Listing is best effort: without it there is nothing to match, and
+		// the caller gets the unknown-command error it would have had anyway.
+		return false, nil //nolint:nilerr // an unlistable location carries no verbs for this caller
+	}
+	var carriers []*world.Object
+	for _, obj := range objs {
+		if _, ok := obj.Verb(verb); ok {
+			carriers = append(carriers, obj)
+		}
+	}
+	obj, rest, err := matchVerbTarget(carriers, args)
+	if err != nil {
+		return true, err
+	}
+	if obj == nil {
+		return false, nil
+	}
+	err = f.admin.TriggerObjectVerb(ctx, subject, world.VerbInvocation{
+		ObjectID:      obj.ID,
+		Verb:          verb,
+		Args:          rest,
+		CharacterID:   exec.CharacterID(),
+		CharacterName: exec.CharacterName(),
+		LocationID:    exec.LocationID(),
+	})
+	if err != nil {
+		return true, objectVerbError(err)
+	}
+	return true, nil
+}
+
+// matchVerbTarget picks the object args names and returns the rest of args.
+// It returns a nil object when nothing matches.
+func matchVerbTarget(objs []*world.Object, args string) (*world.Object, string, error) {
+	var best *world.Object
+	for _, obj := range objs {
+		n := len(obj.Name)
+		if len(args) < n || !strings.EqualFold(args[:n], obj.Name) || (len(args) > n && args[n] != ' ') {
+			continue
+		}
+		if best == nil || n > len(best.Name) {
+			best = obj
+		}
+	}
+	if best != nil {
+		return best, strings.TrimSpace(args[len(best.Name):]), nil
+	}
+
+	word, rest, _ := strings.Cut(args, " ")
+	word = strings.ToLower(word)
+	var matches []*world.Object
+	for _, obj := range objs {
+		for _, w := range strings.Fields(strings.ToLower(obj.Name)) {
+			if strings.HasPrefix(w, word) {
+				matches = append(matches, obj)
+				break
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, "", nil
+	case 1:
+		return matches[0], strings.TrimSpace(rest), nil
+	default:
+		return nil, "", ambiguousObjects(matches)
+	}
+}
@@ -0,0 +1,280 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubObjectVerbAdmin is a test implementation of ObjectVerbAdmin.
+type stubObjectVerbAdmin struct {
+	objects   []*world.Object
+	defined   []world.ObjectVerb
+	removed   []string
+	triggered []world.VerbInvocation
+	listErr   error
+	err       error
+}
+
+func (s *stubObjectVerbAdmin) GetObjectsByLocation(_ context.Context, _ string, _ ulid.ULID) ([]*world.Object, error) {
+	if s.listErr != nil {
+		return nil, s.listErr
+	}
+	return s.objects, nil
+}
+
+func (s *stubObjectVerbAdmin) DefineObjectVerb(_ context.Context, _ string, _ ulid.ULID, verb world.ObjectVerb) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.defined = append(s.defined, verb)
+	return nil
+}
+
+func (s *stubObjectVerbAdmin) RemoveObjectVerb(_ context.Context, _ string, _ ulid.ULID, name string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.removed = append(s.removed, name)
+	return nil
+}
+
+func (s *stubObjectVerbAdmin) TriggerObjectVerb(_ context.Context, _ string, inv world.VerbInvocation) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.triggered = append(s.triggered, inv)
+	return nil
+}
+
+var (
+	verbCharID     = ulid.Make()
+	verbLocationID = ulid.Make()
+)
+
+func newStubObjectVerbAdmin(objs ...*world.Object) *stubObjectVerbAdmin {
+	return &stubObjectVerbAdmin{objects: objs}
+}
+
+func verbObject(name string, verbs ...world.ObjectVerb) *world.Object {
+	return &world.Object{ID: ulid.Make(), Name: name, Verbs: verbs}
+}
+
+func newVerbExecution(args string, buf *bytes.Buffer) *command.CommandExecution {
+	return command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   verbCharID,
+		CharacterName: "Alice",
+		LocationID:    verbLocationID,
+		Args:          args,
+		Output:        buf,
+	})
+}
+
+func runVerb(t *testing.T, admin ObjectVerbAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	err := NewVerbHandler(admin)(context.Background(), newVerbExecution(args, &buf))
+	return buf.String(), err
+}
+
+func tryVerb(t *testing.T, admin ObjectVerbAdmin, verb, args string) (bool, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	return NewObjectVerbFallback(admin).TryVerb(context.Background(), newVerbExecution("", &buf), verb, args)
+}
+
+var pushVerb = world.ObjectVerb{Name: "push", Plugin: "harbor-bell", Description: "rings the harbor bell"}
+
+func TestVerbList(t *testing.T) {
+	admin := newStubObjectVerbAdmin(
+		verbObject("Brass Button", pushVerb, world.ObjectVerb{Name: "polish", Plugin: "harbor-bell"}),
+		verbObject("Rope"),
+	)
+
+	out, err := runVerb(t, admin, "brass")
+	require.NoError(t, err)
+	assert.Equal(t, "Verbs on Brass Button:\n  push (harbor-bell) - rings the harbor bell\n  polish (harbor-bell)\n", out)
+
+	out, err = runVerb(t, admin, "rope")
+	require.NoError(t, err)
+	assert.Equal(t, "Rope has no verbs.\n", out)
+
+	_, err = runVerb(t, admin, "anchor")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestVerbListPrefersExactNameOverPrefix(t *testing.T) {
+	admin := newStubObjectVerbAdmin(verbObject("Button"), verbObject("Button Box", pushVerb))
+
+	out, err := runVerb(t, admin, "button")
+	require.NoError(t, err)
+	assert.Equal(t, "Button has no verbs.\n", out)
+
+	_, err = runVerb(t, admin, "butt")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "Which one?")
+}
+
+func TestVerbSetAndRemove(t *testing.T) {
+	admin := newStubObjectVerbAdmin(verbObject("Brass Button"))
+
+	out, err := runVerb(t, admin, "set brass button/Push = Harbor-Bell rings the harbor bell")
+	require.NoError(t, err)
+	assert.Equal(t, "Brass Button now has verb push, handled by harbor-bell.\n", out)
+	assert.Equal(t, []world.ObjectVerb{pushVerb}, admin.defined)
+
+	out, err = runVerb(t, admin, "remove brass button/push")
+	require.NoError(t, err)
+	assert.Equal(t, "Removed verb push from Brass Button.\n", out)
+	assert.Equal(t, []string{"push"}, admin.removed)
+
+	for _, args := range []string{"set", "set brass button = harbor-bell", "set brass button/push =", "set /push = harbor-bell", "remove brass button"} {
+		_, err := runVerb(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestVerbUsage(t *testing.T) {
+	out, err := runVerb(t, newStubObjectVerbAdmin(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+verbUsage+"\n", out)
+}
+
+func TestVerbErrors(t *testing.T) {
+	admin := newStubObjectVerbAdmin(verbObject("Brass Button", pushVerb))
+
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"invalid verb", oops.Code("OBJECT_VERB_INVALID").Wrap(&world.ValidationError{Field: "verb", Message: "bad"}), command.CodeWorldError},
+		{"verb not found", oops.Code("OBJECT_VERB_NOT_FOUND").Errorf("no verb"), command.CodeWorldError},
+		{"verb limit", oops.Code("OBJECT_VERB_LIMIT").Errorf("too many"), command.CodeWorldError},
+		{"concurrent edit", oops.Code(world.CodeConcurrentEdit).Errorf("stale"), command.CodeWorldError},
+		{"object denied", oops.Code("OBJECT_ACCESS_DENIED").Wrap(world.ErrPermissionDenied), command.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admin.err = tt.err
+			_, err := runVerb(t, admin, "set brass button/push = harbor-bell")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+
+	admin.err = nil
+	admin.listErr = oops.Code("LOCATION_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	_, err := runVerb(t, admin, "brass button")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestObjectVerbFallbackTriggersVerb(t *testing.T) {
+	button := verbObject("Brass Button", pushVerb)
+	admin := newStubObjectVerbAdmin(verbObject("Brass Bell"), button)
+
+	handled, err := tryVerb(t, admin, "Push", "brass button twice")
+	require.NoError(t, err)
+	assert.True(t, handled)
+	require.Len(t, admin.triggered, 1)
+	assert.Equal(t, world.VerbInvocation{
+		ObjectID:      button.ID,
+		Verb:          "push",
+		Args:          "twice",
+		CharacterID:   verbCharID,
+		CharacterName: "Alice",
+		LocationID:    verbLocationID,
+	}, admin.triggered[0])
+
+	handled, err = tryVerb(t, admin, "push", "button")
+	require.NoError(t, err)
+	assert.True(t, handled, "a word of the object's name is enough")
+	assert.Equal(t, button.ID, admin.triggered[1].ObjectID)
+	assert.Empty(t, admin.triggered[1].Args)
+}
+
+func TestObjectVerbFallbackPrefersLongestFullName(t *testing.T) {
+	short := verbObject("Button", pushVerb)
+	long := verbObject("Button Box", pushVerb)
+	admin := newStubObjectVerbAdmin(short, long)
+
+	handled, err := tryVerb(t, admin, "push", "button box lid")
+	require.NoError(t, err)
+	assert.True(t, handled)
+	assert.Equal(t, long.ID, admin.triggered[0].ObjectID)
+	assert.Equal(t, "lid", admin.triggered[0].Args)
+
+	handled, err = tryVerb(t, admin, "push", "but")
+	assert.True(t, handled)
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "Which one?")
+}
+
+func TestObjectVerbFallbackNotHandled(t *testing.T) {
+	admin := newStubObjectVerbAdmin(verbObject("Brass Button", pushVerb), verbObject("Rope"))
+
+	tests := []struct {
+		name string
+		verb string
+		args string
+	}{
+		{"no arguments", "push", ""},
+		{"invalid verb name", "push!", "button"},
+		{"no object carries the verb", "pull", "button"},
+		{"object without the verb", "push", "rope"},
+		{"no object by that name", "push", "anchor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled, err := tryVerb(t, admin, tt.verb, tt.args)
+			require.NoError(t, err)
+			assert.False(t, handled)
+		})
+	}
+	assert.Empty(t, admin.triggered)
+
+	admin.listErr = oops.Code("LOCATION_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	handled, err := tryVerb(t, admin, "push", "button")
+	require.NoError(t, err)
+	assert.False(t, handled, "an unlistable location is not handled")
+}
+
+func TestObjectVerbFallbackMapsTriggerErrors(t *testing.T) {
+	admin := newStubObjectVerbAdmin(verbObject("Brass Button", pushVerb))
+	admin.err = oops.Code("OBJECT_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+
+	handled, err := tryVerb(t, admin, "push", "button")
+	assert.True(t, handled)
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestRegisterAdminVerb(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("verb")
+	assert.False(t, found, "verb requires the Verbs dependency")
+
+	deps.Verbs = newStubObjectVerbAdmin()
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("verb")
+	assert.True(t, found)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/session"
+)
+
+const (
+	verboseCommandName = "verbose"
+	verboseUsage       = "verbose [on | off]"
+)
+
+// ErrorVerbosityAdmin reads and changes a session's error verbosity. This
+// is the ISP interface for the verbose command; *store.PostgresSessionStore
+// satisfies it.
+type ErrorVerbosityAdmin interface {
+	Get(ctx context.Context, id string) (*session.Info, error)
+	SetErrorVerbosity(ctx context.Context, sessionID, verbosity string) error
+}
+
+// NewVerboseHandler creates a command handler that shows or changes whether
+// the caller's session sees error diagnostics.
+func NewVerboseHandler(admin ErrorVerbosityAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleVerbose(ctx, exec, admin)
+	}
+}
+
+func handleVerbose(ctx context.Context, exec *command.CommandExecution, admin ErrorVerbosityAdmin) error {
+	if exec.SessionID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("Error verbosity belongs to a game session, and this command has none.", nil)
+	}
+	sessionID := exec.SessionID().String()
+
+	var want command.ErrorVerbosity
+	switch strings.ToLower(strings.TrimSpace(exec.Args)) {
+	case "":
+		info, err := admin.Get(ctx, sessionID)
+		if err != nil {
+			return oops.With("session_id", sessionID).Wrap(err)
+		}
+		writeOutput(ctx, exec, verboseCommandName, verboseStatus(command.ParseErrorVerbosity(info.ErrorVerbosity)))
+		return nil
+	case "on", string(command.ErrorsVerbose):
+		want = command.ErrorsVerbose
+	case "off", string(command.ErrorsTerse):
+		want = command.ErrorsTerse
+	default:
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(verboseCommandName, verboseUsage)
+	}
+
+	if err := admin.SetErrorVerbosity(ctx, sessionID, string(want)); err != nil {
+		return oops.With("session_id", sessionID).Wrap(err)
+	}
+	writeOutput(ctx, exec, verboseCommandName, verboseStatus(want))
+	return nil
+}
+
+// verboseStatus describes the session's verbosity to its owner.
+func verboseStatus(v command.ErrorVerbosity) string {
+	if v == command.ErrorsVerbose {
+		return "Verbose errors are on for this session: failed commands show their error code and policy decision."
+	}
+	return "Verbose errors are off for this session."
+}
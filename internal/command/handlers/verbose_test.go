@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubErrorVerbosityAdmin is a test implementation of ErrorVerbosityAdmin.
+type stubErrorVerbosityAdmin struct {
+	verbosity string
+	sets      []string
+	err       error
+}
+
+func (s *stubErrorVerbosityAdmin) Get(_ context.Context, id string) (*session.Info, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &session.Info{ID: id, ErrorVerbosity: s.verbosity}, nil
+}
+
+func (s *stubErrorVerbosityAdmin) SetErrorVerbosity(_ context.Context, _, verbosity string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sets = append(s.sets, verbosity)
+	s.verbosity = verbosity
+	return nil
+}
+
+func runVerbose(t *testing.T, admin ErrorVerbosityAdmin, sessionID ulid.ULID, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Alice",
+		SessionID:     sessionID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewVerboseHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestVerboseShowsCurrentSetting(t *testing.T) {
+	admin := &stubErrorVerbosityAdmin{}
+	out, err := runVerbose(t, admin, ulid.Make(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "Verbose errors are off for this session.\n", out)
+
+	admin.verbosity = "verbose"
+	out, err = runVerbose(t, admin, ulid.Make(), "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Verbose errors are on for this session")
+	assert.Empty(t, admin.sets)
+}
+
+func TestVerboseTogglesSetting(t *testing.T) {
+	admin := &stubErrorVerbosityAdmin{}
+
+	out, err := runVerbose(t, admin, ulid.Make(), "ON")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Verbose errors are on")
+
+	out, err = runVerbose(t, admin, ulid.Make(), "terse")
+	require.NoError(t, err)
+	assert.Equal(t, "Verbose errors are off for this session.\n", out)
+
+	assert.Equal(t, []string{"verbose", "terse"}, admin.sets)
+}
+
+func TestVerboseRejectsUnknownLevel(t *testing.T) {
+	admin := &stubErrorVerbosityAdmin{}
+	_, err := runVerbose(t, admin, ulid.Make(), "loud")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	assert.Empty(t, admin.sets)
+}
+
+func TestVerboseRequiresSession(t *testing.T) {
+	_, err := runVerbose(t, &stubErrorVerbosityAdmin{}, ulid.ULID{}, "on")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+}
+
+func TestVerbosePropagatesStoreFailure(t *testing.T) {
+	admin := &stubErrorVerbosityAdmin{err: errors.New("db down")}
+	_, err := runVerbose(t, admin, ulid.Make(), "on")
+	require.Error(t, err)
+	assert.Equal(t, "Something went wrong. Try again.", command.PlayerMessage(err))
+}
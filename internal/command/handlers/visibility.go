@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	visibilityCommandName = "visibility"
+	visibilityUsage       = "visibility [visible|dark|invisible]"
+)
+
+// VisibilityAdmin reads and changes a character's visibility. This is the
+// ISP interface for the visibility command; *world.Service satisfies it.
+type VisibilityAdmin interface {
+	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*world.Character, error)
+	SetCharacterVisibility(ctx context.Context, subjectID string, characterID ulid.ULID, v world.CharacterVisibility) error
+}
+
+// visibilityDescriptions tell the caller who can still see them.
+var visibilityDescriptions = map[world.CharacterVisibility]string{
+	world.CharacterVisible:   "You are visible to everyone.",
+	world.CharacterDark:      "You are dark: only staff can see you.",
+	world.CharacterInvisible: "You are invisible to players: only builders and staff can see you.",
+}
+
+// NewVisibilityHandler creates a command handler that shows or changes the
+// caller's own visibility.
+func NewVisibilityHandler(admin VisibilityAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleVisibility(ctx, exec, admin)
+	}
+}
+
+func handleVisibility(ctx context.Context, exec *command.CommandExecution, admin VisibilityAdmin) error {
+	arg := strings.ToLower(strings.TrimSpace(exec.Args))
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	if arg == "" {
+		char, err := admin.GetCharacter(ctx, subject, exec.CharacterID())
+		if err != nil {
+			return visibilityError(err)
+		}
+		writeOutput(ctx, exec, visibilityCommandName, visibilityDescriptions[char.Visibility.Normalize()])
+		return nil
+	}
+
+	v, err := world.ParseCharacterVisibility(arg)
+	if err != nil {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(visibilityCommandName, visibilityUsage)
+	}
+	if err := admin.SetCharacterVisibility(ctx, subject, exec.CharacterID(), v); err != nil {
+		return visibilityError(err)
+	}
+	writeOutput(ctx, exec, visibilityCommandName, visibilityDescriptions[v])
+	return nil
+}
+
+// visibilityError maps a policy denial to the player-facing permission
+// error; anything else falls through to the generic player message.
+func visibilityError(err error) error {
+	if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "CHARACTER_ACCESS_DENIED" {
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(visibilityCommandName, "character")
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubVisibilityAdmin is a test implementation of VisibilityAdmin holding a
+// single character's visibility.
+type stubVisibilityAdmin struct {
+	visibility world.CharacterVisibility
+	subjects   []string
+	setErr     error
+}
+
+func (s *stubVisibilityAdmin) GetCharacter(_ context.Context, _ string, id ulid.ULID) (*world.Character, error) {
+	return &world.Character{ID: id, Visibility: s.visibility}, nil
+}
+
+func (s *stubVisibilityAdmin) SetCharacterVisibility(_ context.Context, subject string, _ ulid.ULID, v world.CharacterVisibility) error {
+	if s.setErr != nil {
+		return s.setErr
+	}
+	s.subjects = append(s.subjects, subject)
+	s.visibility = v
+	return nil
+}
+
+var visibilityCharID = ulid.Make()
+
+func runVisibility(t *testing.T, admin VisibilityAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   visibilityCharID,
+		CharacterName: "Staffer",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewVisibilityHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestVisibilityShowsCurrentState(t *testing.T) {
+	out, err := runVisibility(t, &stubVisibilityAdmin{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "You are visible to everyone.\n", out, "unset visibility reads as visible")
+
+	out, err = runVisibility(t, &stubVisibilityAdmin{visibility: world.CharacterDark}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "dark")
+}
+
+func TestVisibilitySetsOwnCharacter(t *testing.T) {
+	admin := &stubVisibilityAdmin{}
+
+	out, err := runVisibility(t, admin, " Invisible ")
+	require.NoError(t, err)
+	assert.Equal(t, world.CharacterInvisible, admin.visibility)
+	assert.Contains(t, out, "only builders and staff")
+	assert.Equal(t, []string{access.CharacterSubject(visibilityCharID.String())}, admin.subjects)
+}
+
+func TestVisibilityInvalidArgs(t *testing.T) {
+	admin := &stubVisibilityAdmin{}
+	_, err := runVisibility(t, admin, "hidden")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	assert.Empty(t, admin.subjects)
+}
+
+func TestVisibilityErrorMapping(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code string
+	}{
+		{"denied", oops.Code("CHARACTER_ACCESS_DENIED").Errorf("not permitted"), command.CodePermissionDenied},
+		{"update failure passes through", oops.Code("CHARACTER_UPDATE_FAILED").Errorf("db down"), "CHARACTER_UPDATE_FAILED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runVisibility(t, &stubVisibilityAdmin{setErr: tt.err}, "dark")
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestRegisterAdminVisibility(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("visibility")
+	assert.False(t, found, "visibility requires the Visibility dependency")
+
+	deps.Visibility = &stubVisibilityAdmin{}
+	RegisterAdmin(reg, deps)
+	entry, found := reg.Get("visibility")
+	require.True(t, found)
+	assert.Equal(t, []command.Capability{{Action: "set_visibility", Resource: "character"}}, entry.GetCapabilities())
+}
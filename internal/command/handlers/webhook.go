@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/webhook"
+)
+
+const (
+	webhookCommandName = "webhook"
+	webhookUsage       = "webhook list | add <name> <url> = <event types> | enable <name> | disable <name> | remove <name> | log [name]"
+	webhookAddUsage    = "webhook add <name> <url> [--rate <per minute>] = <event type>[, <event type>...]"
+)
+
+// WebhookAdmin manages outbound webhooks. This is the ISP interface for the
+// webhook admin command; *webhook.Dispatcher satisfies it.
+type WebhookAdmin interface {
+	Register(ctx context.Context, endpoint webhook.Endpoint) (webhook.Endpoint, error)
+	List(ctx context.Context) ([]webhook.Endpoint, error)
+	SetEnabled(ctx context.Context, name string, enabled bool) (webhook.Endpoint, error)
+	Remove(ctx context.Context, name string) (webhook.Endpoint, error)
+	Deliveries(ctx context.Context, name string, limit int) ([]webhook.Delivery, error)
+}
+
+// NewWebhookHandler creates a command handler that routes webhook
+// subcommands.
+func NewWebhookHandler(admin WebhookAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleWebhook(ctx, exec, admin)
+	}
+}
+
+func handleWebhook(ctx context.Context, exec *command.CommandExecution, admin WebhookAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+
+	switch sub {
+	case "list":
+		return handleWebhookList(ctx, exec, admin)
+	case "add":
+		return handleWebhookAdd(ctx, exec, admin, rest)
+	case "enable", "disable", "remove":
+		if rest == "" || strings.ContainsAny(rest, " \t") {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(webhookCommandName, "webhook "+sub+" <name>")
+		}
+		return handleWebhookChange(ctx, exec, admin, sub, rest)
+	case "log":
+		if strings.ContainsAny(rest, " \t") {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(webhookCommandName, "webhook log [name]")
+		}
+		return handleWebhookLog(ctx, exec, admin, rest)
+	default:
+		writeOutput(ctx, exec, webhookCommandName, "Usage: "+webhookUsage)
+		return nil
+	}
+}
+
+func handleWebhookList(ctx context.Context, exec *command.CommandExecution, admin WebhookAdmin) error {
+	endpoints, err := admin.List(ctx)
+	if err != nil {
+		return webhookError(err)
+	}
+	if len(endpoints) == 0 {
+		writeOutput(ctx, exec, webhookCommandName, "No webhooks.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Webhooks:")
+	for _, e := range endpoints {
+		state := "enabled"
+		if !e.Enabled {
+			state = "disabled"
+		}
+		fmt.Fprintf(&sb, "\n  %-20s %-8s %4d/min  %s  %s",
+			e.Name, state, e.RatePerMinute, e.URL, strings.Join(e.Events, ", "))
+	}
+	writeOutput(ctx, exec, webhookCommandName, sb.String())
+	return nil
+}
+
+func handleWebhookAdd(ctx context.Context, exec *command.CommandExecution, admin WebhookAdmin, args string) error {
+	endpoint, err := parseWebhookAdd(args)
+	if err != nil {
+		return err
+	}
+	endpoint.CreatedBy = exec.CharacterName()
+
+	registered, err := admin.Register(ctx, endpoint)
+	if err != nil {
+		return webhookError(err)
+	}
+	writeOutputf(ctx, exec, webhookCommandName,
+		"Registered webhook %s for %s.\nSigning secret (shown only now): %s\n",
+		registered.Name, strings.Join(registered.Events, ", "), registered.Secret)
+	return nil
+}
+
+// parseWebhookAdd parses "<name> <url> [--rate <n>] = <type>[, <type>...]".
+func parseWebhookAdd(args string) (webhook.Endpoint, error) {
+	head, tail, ok := strings.Cut(args, "=")
+	if !ok {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return webhook.Endpoint{}, command.ErrInvalidArgs(webhookCommandName, webhookAddUsage)
+	}
+	var endpoint webhook.Endpoint
+	fields := strings.Fields(head)
+	switch {
+	case len(fields) == 2:
+	case len(fields) == 4 && fields[2] == "--rate":
+		rate, err := strconv.Atoi(fields[3])
+		if err != nil || rate <= 0 {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return webhook.Endpoint{}, command.ErrInvalidArgs(webhookCommandName, webhookAddUsage)
+		}
+		endpoint.RatePerMinute = rate
+	default:
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return webhook.Endpoint{}, command.ErrInvalidArgs(webhookCommandName, webhookAddUsage)
+	}
+	endpoint.Name = fields[0]
+	endpoint.URL = fields[1]
+	for _, pattern := range strings.Split(tail, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			endpoint.Events = append(endpoint.Events, pattern)
+		}
+	}
+	return endpoint, nil
+}
+
+func handleWebhookChange(ctx context.Context, exec *command.CommandExecution, admin WebhookAdmin, sub, name string) error {
+	switch sub {
+	case "remove":
+		if _, err := admin.Remove(ctx, name); err != nil {
+			return webhookError(err)
+		}
+		writeOutputf(ctx, exec, webhookCommandName, "Removed webhook %s and its delivery log.\n", name)
+	default:
+		if _, err := admin.SetEnabled(ctx, name, sub == "enable"); err != nil {
+			return webhookError(err)
+		}
+		writeOutputf(ctx, exec, webhookCommandName, "Webhook %s %sd.\n", name, sub)
+	}
+	return nil
+}
+
+func handleWebhookLog(ctx context.Context, exec *command.CommandExecution, admin WebhookAdmin, name string) error {
+	deliveries, err := admin.Deliveries(ctx, name, 0)
+	if err != nil {
+		return webhookError(err)
+	}
+	if len(deliveries) == 0 {
+		writeOutput(ctx, exec, webhookCommandName, "No deliveries.")
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Deliveries (newest first):")
+	for _, d := range deliveries {
+		code := "-"
+		if d.LastStatusCode != 0 {
+			code = strconv.Itoa(d.LastStatusCode)
+		}
+		fmt.Fprintf(&sb, "\n  %s %-24s %-9s x%d  %3s  %s",
+			d.ID, d.EventType, string(d.Status), d.Attempts, code, formatScheduleTime(d.CreatedAt))
+		if d.LastError != "" {
+			reason, _, _ := strings.Cut(d.LastError, "\n")
+			fmt.Fprintf(&sb, "  %s", reason)
+		}
+	}
+	writeOutput(ctx, exec, webhookCommandName, sb.String())
+	return nil
+}
+
+// webhookError surfaces the dispatcher's validation and lookup failures to
+// staff verbatim; anything else falls through to the generic player
+// message. The cause is not wrapped: oops resolves the innermost code,
+// which would mask WORLD_ERROR.
+func webhookError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "WEBHOOK_INVALID", "WEBHOOK_NOT_FOUND", "WEBHOOK_EXISTS":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	}
+	return err
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/webhook"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubWebhookAdmin is a test implementation of WebhookAdmin.
+type stubWebhookAdmin struct {
+	endpoints  []webhook.Endpoint
+	deliveries []webhook.Delivery
+	registered []webhook.Endpoint
+	logNames   []string
+}
+
+func (s *stubWebhookAdmin) Register(_ context.Context, endpoint webhook.Endpoint) (webhook.Endpoint, error) {
+	if endpoint.RatePerMinute == 0 {
+		endpoint.RatePerMinute = webhook.DefaultRatePerMinute
+	}
+	if err := endpoint.Validate(); err != nil {
+		return webhook.Endpoint{}, err
+	}
+	if s.find(endpoint.Name) >= 0 {
+		return webhook.Endpoint{}, oops.Code("WEBHOOK_EXISTS").Errorf("a webhook named %q already exists", endpoint.Name)
+	}
+	endpoint.Secret = "c0ffee"
+	endpoint.Enabled = true
+	s.registered = append(s.registered, endpoint)
+	s.endpoints = append(s.endpoints, endpoint)
+	return endpoint, nil
+}
+
+func (s *stubWebhookAdmin) List(_ context.Context) ([]webhook.Endpoint, error) {
+	return s.endpoints, nil
+}
+
+func (s *stubWebhookAdmin) SetEnabled(_ context.Context, name string, enabled bool) (webhook.Endpoint, error) {
+	i := s.find(name)
+	if i < 0 {
+		return webhook.Endpoint{}, oops.Code("WEBHOOK_NOT_FOUND").Errorf("no webhook named %s", name)
+	}
+	s.endpoints[i].Enabled = enabled
+	return s.endpoints[i], nil
+}
+
+func (s *stubWebhookAdmin) Remove(_ context.Context, name string) (webhook.Endpoint, error) {
+	i := s.find(name)
+	if i < 0 {
+		return webhook.Endpoint{}, oops.Code("WEBHOOK_NOT_FOUND").Errorf("no webhook named %s", name)
+	}
+	endpoint := s.endpoints[i]
+	s.endpoints = slices.Delete(s.endpoints, i, i+1)
+	return endpoint, nil
+}
+
+func (s *stubWebhookAdmin) Deliveries(_ context.Context, name string, _ int) ([]webhook.Delivery, error) {
+	s.logNames = append(s.logNames, name)
+	return s.deliveries, nil
+}
+
+func (s *stubWebhookAdmin) find(name string) int {
+	return slices.IndexFunc(s.endpoints, func(e webhook.Endpoint) bool { return e.Name == name })
+}
+
+func runWebhook(t *testing.T, admin WebhookAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   ulid.Make(),
+		CharacterName: "Admin",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewWebhookHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestWebhookAdd(t *testing.T) {
+	admin := &stubWebhookAdmin{}
+
+	out, err := runWebhook(t, admin, "add discord https://bridge.example.com/hook --rate 30 = scene.*, character_created")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Registered webhook discord for scene.*, character_created.")
+	assert.Contains(t, out, "Signing secret (shown only now): c0ffee")
+	require.Len(t, admin.registered, 1)
+	assert.Equal(t, "https://bridge.example.com/hook", admin.registered[0].URL)
+	assert.Equal(t, 30, admin.registered[0].RatePerMinute)
+	assert.Equal(t, "Admin", admin.registered[0].CreatedBy)
+
+	for _, args := range []string{
+		"add discord https://bridge.example.com/hook",
+		"add discord = scene.*",
+		"add discord https://bridge.example.com/hook --rate fast = scene.*",
+		"add discord https://bridge.example.com/hook --rate 0 = scene.*",
+	} {
+		_, err = runWebhook(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+
+	_, err = runWebhook(t, admin, "add discord https://bridge.example.com/hook = say")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "already exists")
+
+	_, err = runWebhook(t, admin, "add other ftp://bridge.example.com = say")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "http or https")
+}
+
+func TestWebhookListAndChange(t *testing.T) {
+	admin := &stubWebhookAdmin{}
+	out, err := runWebhook(t, admin, "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No webhooks.")
+
+	_, err = runWebhook(t, admin, "add discord https://bridge.example.com/hook = scene.*")
+	require.NoError(t, err)
+
+	out, err = runWebhook(t, admin, "disable discord")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Webhook discord disabled.")
+
+	out, err = runWebhook(t, admin, "list")
+	require.NoError(t, err)
+	assert.Contains(t, out, "discord")
+	assert.Contains(t, out, "disabled")
+	assert.Contains(t, out, "60/min")
+
+	out, err = runWebhook(t, admin, "remove discord")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Removed webhook discord and its delivery log.")
+
+	_, err = runWebhook(t, admin, "enable discord")
+	require.Error(t, err)
+	assert.Contains(t, command.PlayerMessage(err), "no webhook named discord")
+
+	_, err = runWebhook(t, admin, "enable")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestWebhookLog(t *testing.T) {
+	created := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	admin := &stubWebhookAdmin{deliveries: []webhook.Delivery{{
+		ID:             ulid.MustParse("01HZ00000000000000000000W1"),
+		EventType:      "scene.pose",
+		Status:         webhook.StatusPending,
+		Attempts:       2,
+		LastStatusCode: 503,
+		LastError:      "HTTP 503: unavailable\nmore detail",
+		CreatedAt:      created,
+	}}}
+
+	out, err := runWebhook(t, admin, "log discord")
+	require.NoError(t, err)
+	assert.Contains(t, out, "01HZ00000000000000000000W1 scene.pose")
+	assert.Contains(t, out, "pending")
+	assert.Contains(t, out, "x2  503  2026-10-18 09:30:00 UTC  HTTP 503: unavailable")
+	assert.NotContains(t, out, "more detail")
+	assert.Equal(t, []string{"discord"}, admin.logNames)
+
+	out, err = runWebhook(t, &stubWebhookAdmin{}, "log")
+	require.NoError(t, err)
+	assert.Contains(t, out, "No deliveries.")
+}
+
+func TestWebhookUsage(t *testing.T) {
+	out, err := runWebhook(t, &stubWebhookAdmin{}, "")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Usage: webhook list")
+}
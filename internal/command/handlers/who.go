@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/connhistory"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	whoCommandName = "who"
+	whoUsage       = "who [<character>]"
+	// whoConnectionLimit is how many recent connections who <character>
+	// lists.
+	whoConnectionLimit = 5
+)
+
+// WhoDirectory lists the sessions online. This is the ISP interface for the
+// who command; the session store satisfies it.
+type WhoDirectory interface {
+	ListActive(ctx context.Context) ([]*session.Info, error)
+}
+
+// WhoVisibility reports whether one character can perceive another, so who
+// leaves out dark and invisible characters. *world.Service satisfies it.
+type WhoVisibility interface {
+	CharacterVisibleTo(ctx context.Context, observerID, targetID ulid.ULID) (bool, error)
+}
+
+// NPCLister lists every NPC for who. *npc.Service satisfies it.
+type NPCLister interface {
+	List(ctx context.Context) ([]*npc.NPC, error)
+}
+
+// WhoCharacters finds a character by name as the observer perceives it, so
+// who <character> never reveals a character the observer cannot see.
+// *world.Service satisfies it.
+type WhoCharacters interface {
+	FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*world.Character, error)
+}
+
+// ConnectionHistory reads a character's connections under the
+// read_connections check. *connhistory.Service satisfies it.
+type ConnectionHistory interface {
+	GetLastSeen(ctx context.Context, subject string, characterID ulid.ULID) (*connhistory.LastSeen, error)
+	ListConnections(ctx context.Context, subject string, characterID ulid.ULID, limit int) ([]*connhistory.Connection, error)
+}
+
+// NewWhoHandler creates a command handler that lists the characters online
+// and, when npcs is non-nil, the NPCs in the world, marked apart. A nil
+// visibility lists every online character. With characters, who
+// <character> reports whether that character is online and, with
+// connections, when it was last seen to those allowed to know.
+func NewWhoHandler(sessions WhoDirectory, visibility WhoVisibility, npcs NPCLister, characters WhoCharacters, connections ConnectionHistory) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		if name := strings.TrimSpace(exec.Args); name != "" {
+			if characters == nil {
+				//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+				return command.ErrInvalidArgs(whoCommandName, "who")
+			}
+			return handleWhoCharacter(ctx, exec, sessions, characters, connections, name)
+		}
+		return handleWho(ctx, exec, sessions, visibility, npcs)
+	}
+}
+
+func handleWho(ctx context.Context, exec *command.CommandExecution, sessions WhoDirectory, visibility WhoVisibility, npcs NPCLister) error {
+	active, err := sessions.ListActive(ctx)
+	if err != nil {
+		return oops.With("operation", "list_sessions").Wrap(err)
+	}
+	names := visibleCharacterNames(ctx, exec.CharacterID(), active, visibility)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Online (%d):", len(names))
+	for _, name := range names {
+		sb.WriteString("\n  " + name)
+	}
+	if npcs != nil {
+		list, err := npcs.List(ctx)
+		if err != nil {
+			// NPCs are scenery: the online list is still worth showing.
+			slog.WarnContext(ctx, "who: failed to list NPCs", "error", err)
+		} else if len(list) > 0 {
+			fmt.Fprintf(&sb, "\nNPCs (%d):", len(list))
+			for _, n := range list {
+				sb.WriteString("\n  " + n.Name + " [NPC]")
+			}
+		}
+	}
+	writeOutput(ctx, exec, whoCommandName, sb.String())
+	return nil
+}
+
+// visibleCharacterNames returns the names of the characters in active that
+// observerID can see, once each, sorted. A failed visibility check hides
+// the character (fail closed), as the location presence list does.
+func visibleCharacterNames(ctx context.Context, observerID ulid.ULID, active []*session.Info, visibility WhoVisibility) []string {
+	seen := make(map[ulid.ULID]bool, len(active))
+	names := make([]string, 0, len(active))
+	for _, info := range active {
+		if seen[info.CharacterID] {
+			continue
+		}
+		seen[info.CharacterID] = true
+		if visibility != nil && info.CharacterID != observerID {
+			visible, err := visibility.CharacterVisibleTo(ctx, observerID, info.CharacterID)
+			if err != nil {
+				slog.WarnContext(ctx, "who: visibility check failed, hiding character",
+					"observer_id", observerID.String(), "character_id", info.CharacterID.String(), "error", err)
+				continue
+			}
+			if !visible {
+				continue
+			}
+		}
+		names = append(names, info.CharacterName)
+	}
+	sort.Slice(names, func(i, j int) bool { return strings.ToLower(names[i]) < strings.ToLower(names[j]) })
+	return names
+}
+
+// handleWhoCharacter reports whether the character named name is online.
+// The name resolves as the caller perceives it, so a dark or invisible
+// character is reported as not found, the same as one that does not exist.
+func handleWhoCharacter(ctx context.Context, exec *command.CommandExecution, sessions WhoDirectory, characters WhoCharacters, connections ConnectionHistory, name string) error {
+	char, err := characters.FindCharacterByName(ctx, exec.CharacterID(), name)
+	if err != nil {
+		if errors.Is(err, world.ErrNotFound) {
+			//nolint:wrapcheck // ErrTargetNotFound creates a structured oops error
+			return command.ErrTargetNotFound(name)
+		}
+		return oops.With("operation", "find_character").With("name", name).Wrap(err)
+	}
+	active, err := sessions.ListActive(ctx)
+	if err != nil {
+		return oops.With("operation", "list_sessions").Wrap(err)
+	}
+	online := slices.ContainsFunc(active, func(info *session.Info) bool { return info.CharacterID == char.ID })
+
+	var sb strings.Builder
+	if online {
+		fmt.Fprintf(&sb, "%s is online.", char.Name)
+	} else {
+		fmt.Fprintf(&sb, "%s is offline.", char.Name)
+	}
+	if connections != nil {
+		writeConnectionHistory(ctx, &sb, connections, exec.CharacterID(), char.ID)
+	}
+	writeOutput(ctx, exec, whoCommandName, sb.String())
+	return nil
+}
+
+// writeConnectionHistory appends when characterID was last seen and its
+// recent connections, for callers the read_connections policy admits: the
+// character itself and staff. Anyone else gets nothing more, and neither
+// does anyone when the history cannot be read; it is an extra, not the
+// answer.
+func writeConnectionHistory(ctx context.Context, sb *strings.Builder, connections ConnectionHistory, observerID, characterID ulid.ULID) {
+	subject := access.CharacterSubject(observerID.String())
+	seen, err := connections.GetLastSeen(ctx, subject, characterID)
+	if err != nil {
+		switch {
+		case errors.Is(err, connhistory.ErrNotFound):
+			sb.WriteString("\nNo connections recorded.")
+		case !isConnectionHistoryDenied(err):
+			slog.WarnContext(ctx, "who: failed to read last seen",
+				"character_id", characterID.String(), "error", err)
+		}
+		return
+	}
+	if seen.Connected {
+		fmt.Fprintf(sb, "\nConnected since %s (%s).", formatScheduleTime(seen.At), seen.ClientType)
+	} else {
+		fmt.Fprintf(sb, "\nLast seen %s (%s).", formatScheduleTime(seen.At), seen.ClientType)
+	}
+
+	conns, err := connections.ListConnections(ctx, subject, characterID, whoConnectionLimit)
+	if err != nil {
+		slog.WarnContext(ctx, "who: failed to list connections",
+			"character_id", characterID.String(), "error", err)
+		return
+	}
+	sb.WriteString("\nRecent connections, newest first:")
+	now := time.Now()
+	for _, c := range conns {
+		length := c.Duration(now).Round(time.Second).String()
+		if c.Open() {
+			length += ", open"
+		}
+		fmt.Fprintf(sb, "\n  %s  %s  %s", c.ConnectedAt.UTC().Format(time.DateTime), c.ClientType, length)
+	}
+}
+
+// isConnectionHistoryDenied reports whether err is the read_connections
+// check refusing the caller.
+func isConnectionHistoryDenied(err error) bool {
+	oopsErr, ok := oops.AsOops(err)
+	return ok && oopsErr.Code() == "CONNHISTORY_ACCESS_DENIED"
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/connhistory"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubWhoDirectory is a test implementation of WhoDirectory.
+type stubWhoDirectory struct {
+	sessions []*session.Info
+	err      error
+}
+
+func (s *stubWhoDirectory) ListActive(context.Context) ([]*session.Info, error) {
+	return s.sessions, s.err
+}
+
+// stubWhoVisibility hides the characters in hidden and fails for failing.
+type stubWhoVisibility struct {
+	hidden  map[ulid.ULID]bool
+	failing ulid.ULID
+}
+
+func (s *stubWhoVisibility) CharacterVisibleTo(_ context.Context, _, targetID ulid.ULID) (bool, error) {
+	if targetID == s.failing {
+		return false, errors.New("engine down")
+	}
+	return !s.hidden[targetID], nil
+}
+
+// stubWhoCharacters finds the characters in visible by name; any other
+// name, hidden or unknown, is not found.
+type stubWhoCharacters struct {
+	visible map[string]*world.Character
+}
+
+func (s *stubWhoCharacters) FindCharacterByName(_ context.Context, _ ulid.ULID, name string) (*world.Character, error) {
+	if c, ok := s.visible[name]; ok {
+		return c, nil
+	}
+	return nil, oops.Code("CHARACTER_NOT_FOUND").Wrap(world.ErrNotFound)
+}
+
+// stubConnectionHistory returns seen and conns, or err, and records the
+// subject it was asked for.
+type stubConnectionHistory struct {
+	seen    *connhistory.LastSeen
+	conns   []*connhistory.Connection
+	err     error
+	subject string
+}
+
+func (s *stubConnectionHistory) GetLastSeen(_ context.Context, subject string, _ ulid.ULID) (*connhistory.LastSeen, error) {
+	s.subject = subject
+	return s.seen, s.err
+}
+
+func (s *stubConnectionHistory) ListConnections(_ context.Context, _ string, _ ulid.ULID, _ int) ([]*connhistory.Connection, error) {
+	return s.conns, s.err
+}
+
+func runWho(t *testing.T, dir WhoDirectory, visibility WhoVisibility, npcs NPCLister) (string, error) {
+	t.Helper()
+	return runWhoArgs(t, "", dir, visibility, npcs, nil, nil)
+}
+
+func runWhoArgs(t *testing.T, args string, dir WhoDirectory, visibility WhoVisibility, npcs NPCLister,
+	characters WhoCharacters, connections ConnectionHistory,
+) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewWhoHandler(dir, visibility, npcs, characters, connections)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestWhoListsVisibleCharactersAndNPCs(t *testing.T) {
+	bob, dark, flaky := ulid.Make(), ulid.Make(), ulid.Make()
+	dir := &stubWhoDirectory{sessions: []*session.Info{
+		{CharacterID: bob, CharacterName: "bob"},
+		{CharacterID: zoneCharID, CharacterName: "Alice"},
+		{CharacterID: bob, CharacterName: "bob"}, // second session
+		{CharacterID: dark, CharacterName: "Shade"},
+		{CharacterID: flaky, CharacterName: "Flaky"},
+	}}
+	visibility := &stubWhoVisibility{hidden: map[ulid.ULID]bool{dark: true, zoneCharID: true}, failing: flaky}
+	npcs := &stubNPCAdmin{npcs: []*npc.NPC{{Name: "Old Tom"}}}
+
+	out, err := runWho(t, dir, visibility, npcs)
+	require.NoError(t, err)
+	assert.Equal(t, "Online (2):\n  Alice\n  bob\nNPCs (1):\n  Old Tom [NPC]\n", out,
+		"one line per character, the caller always included, hidden and unverifiable characters left out")
+}
+
+func TestWhoWithoutNPCsOrVisibility(t *testing.T) {
+	dir := &stubWhoDirectory{sessions: []*session.Info{{CharacterID: zoneCharID, CharacterName: "Alice"}}}
+
+	out, err := runWho(t, dir, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Online (1):\n  Alice\n", out)
+
+	out, err = runWho(t, dir, nil, &stubNPCAdmin{err: errors.New("store down")})
+	require.NoError(t, err, "a failed NPC lookup still lists who is online")
+	assert.Equal(t, "Online (1):\n  Alice\n", out)
+
+	dir.err = errors.New("store down")
+	_, err = runWho(t, dir, nil, nil)
+	require.Error(t, err)
+}
+
+func TestWhoCharacterReportsLastSeenToPermittedCallers(t *testing.T) {
+	bob := ulid.Make()
+	characters := &stubWhoCharacters{visible: map[string]*world.Character{"bob": {ID: bob, Name: "Bob"}}}
+	dir := &stubWhoDirectory{}
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ended := at.Add(90 * time.Minute)
+	connections := &stubConnectionHistory{
+		seen: &connhistory.LastSeen{CharacterID: bob, At: ended, ClientType: "telnet"},
+		conns: []*connhistory.Connection{
+			{CharacterID: bob, ClientType: "telnet", ConnectedAt: at, DisconnectedAt: &ended},
+		},
+	}
+
+	out, err := runWhoArgs(t, "bob", dir, nil, nil, characters, connections)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob is offline.\nLast seen 2026-03-01 13:30:00 UTC (telnet).\n"+
+		"Recent connections, newest first:\n  2026-03-01 12:00:00  telnet  1h30m0s\n", out)
+	assert.Equal(t, access.CharacterSubject(zoneCharID.String()), connections.subject,
+		"the history is read as the caller, so the read_connections policy decides")
+}
+
+func TestWhoCharacterWithholdsHistoryFromOthers(t *testing.T) {
+	bob := ulid.Make()
+	characters := &stubWhoCharacters{visible: map[string]*world.Character{"bob": {ID: bob, Name: "Bob"}}}
+	dir := &stubWhoDirectory{sessions: []*session.Info{{CharacterID: bob, CharacterName: "Bob"}}}
+
+	denied := &stubConnectionHistory{err: oops.Code("CONNHISTORY_ACCESS_DENIED").Errorf("denied")}
+	out, err := runWhoArgs(t, "bob", dir, nil, nil, characters, denied)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob is online.\n", out, "a caller the policy refuses sees only whether Bob is online")
+
+	none := &stubConnectionHistory{err: oops.Code("CONNHISTORY_NOT_FOUND").Wrap(connhistory.ErrNotFound)}
+	out, err = runWhoArgs(t, "bob", dir, nil, nil, characters, none)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob is online.\nNo connections recorded.\n", out)
+
+	out, err = runWhoArgs(t, "bob", dir, nil, nil, characters, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob is online.\n", out)
+}
+
+func TestWhoCharacterDoesNotRevealHiddenCharacters(t *testing.T) {
+	characters := &stubWhoCharacters{}
+	connections := &stubConnectionHistory{}
+
+	_, err := runWhoArgs(t, "shade", &stubWhoDirectory{}, nil, nil, characters, connections)
+	errutil.AssertErrorCode(t, err, command.CodeTargetNotFound)
+	assert.Empty(t, connections.subject, "no history is read for a character the caller cannot see")
+
+	_, err = runWhoArgs(t, "bob", &stubWhoDirectory{}, nil, nil, nil, nil)
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	zoneCommandName = "zone"
+	zoneUsage       = "zone | set <zone> | clear | list <zone> | emit <zone>[/<kind>] = <text>"
+	zoneEmitUsage   = "zone emit <zone>[/<kind>] = <text>"
+)
+
+// ZoneAdmin tags locations into zones and broadcasts to them. This is the
+// ISP interface for the zone command; *world.Service satisfies it.
+type ZoneAdmin interface {
+	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
+	UpdateLocation(ctx context.Context, subjectID string, loc *world.Location) error
+	ListZoneLocations(ctx context.Context, subjectID, zoneID string) ([]*world.Location, error)
+	BroadcastToZone(ctx context.Context, subjectID, zoneID string, b world.ZoneBroadcast) (int, error)
+}
+
+// NewZoneHandler creates a command handler that shows and changes the zone
+// of the caller's location, lists a zone's locations, and broadcasts to
+// every location in a zone.
+func NewZoneHandler(admin ZoneAdmin) command.CommandHandler {
+	return func(ctx context.Context, exec *command.CommandExecution) error {
+		return handleZone(ctx, exec, admin)
+	}
+}
+
+func handleZone(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin) error {
+	sub, rest, _ := strings.Cut(strings.TrimSpace(exec.Args), " ")
+	rest = strings.TrimSpace(rest)
+	subject := access.CharacterSubject(exec.CharacterID().String())
+
+	switch strings.ToLower(sub) {
+	case "":
+		return handleZoneShow(ctx, exec, admin, subject)
+	case "set":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(zoneCommandName, "zone set <zone>")
+		}
+		return handleZoneAssign(ctx, exec, admin, subject, strings.ToLower(rest))
+	case "clear":
+		return handleZoneAssign(ctx, exec, admin, subject, "")
+	case "list":
+		if rest == "" {
+			//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+			return command.ErrInvalidArgs(zoneCommandName, "zone list <zone>")
+		}
+		return handleZoneList(ctx, exec, admin, subject, strings.ToLower(rest))
+	case "emit":
+		return handleZoneEmit(ctx, exec, admin, subject, rest)
+	default:
+		writeOutput(ctx, exec, zoneCommandName, "Usage: "+zoneUsage)
+		return nil
+	}
+}
+
+// currentZoneLocation loads the caller's location.
+func currentZoneLocation(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin, subject string) (*world.Location, error) {
+	if exec.LocationID().IsZero() {
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return nil, command.WorldError("You are not in a location.", nil)
+	}
+	loc, err := admin.GetLocation(ctx, subject, exec.LocationID())
+	if err != nil {
+		return nil, zoneError(err)
+	}
+	return loc, nil
+}
+
+func handleZoneShow(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin, subject string) error {
+	loc, err := currentZoneLocation(ctx, exec, admin, subject)
+	if err != nil {
+		return err
+	}
+	if loc.ZoneID == "" {
+		writeOutput(ctx, exec, zoneCommandName, "This location is not in a zone.")
+		return nil
+	}
+	writeOutputf(ctx, exec, zoneCommandName, "This location is in zone %s.\n", loc.ZoneID)
+	return nil
+}
+
+func handleZoneAssign(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin, subject, zoneID string) error {
+	loc, err := currentZoneLocation(ctx, exec, admin, subject)
+	if err != nil {
+		return err
+	}
+	loc.ZoneID = zoneID
+	if err := admin.UpdateLocation(ctx, subject, loc); err != nil {
+		return zoneError(err)
+	}
+	if zoneID == "" {
+		writeOutput(ctx, exec, zoneCommandName, "This location is no longer in a zone.")
+		return nil
+	}
+	writeOutputf(ctx, exec, zoneCommandName, "This location is now in zone %s.\n", zoneID)
+	return nil
+}
+
+func handleZoneList(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin, subject, zoneID string) error {
+	locs, err := admin.ListZoneLocations(ctx, subject, zoneID)
+	if err != nil {
+		return zoneError(err)
+	}
+	if len(locs) == 0 {
+		writeOutputf(ctx, exec, zoneCommandName, "No locations are in zone %s.\n", zoneID)
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Locations in zone %s:", zoneID)
+	for _, loc := range locs {
+		fmt.Fprintf(&sb, "\n  %s (%s)", loc.Name, loc.ID)
+	}
+	writeOutput(ctx, exec, zoneCommandName, sb.String())
+	return nil
+}
+
+func handleZoneEmit(ctx context.Context, exec *command.CommandExecution, admin ZoneAdmin, subject, args string) error {
+	target, text, found := strings.Cut(args, "=")
+	target = strings.ToLower(strings.TrimSpace(target))
+	text = strings.TrimSpace(text)
+	if !found || target == "" || text == "" {
+		//nolint:wrapcheck // ErrInvalidArgs creates a structured oops error
+		return command.ErrInvalidArgs(zoneCommandName, zoneEmitUsage)
+	}
+	zoneID, kind, _ := strings.Cut(target, "/")
+	reached, err := admin.BroadcastToZone(ctx, subject, strings.TrimSpace(zoneID), world.ZoneBroadcast{
+		Kind: strings.TrimSpace(kind),
+		Text: text,
+	})
+	if err != nil {
+		return zoneError(err)
+	}
+	noun := "locations"
+	if reached == 1 {
+		noun = "location"
+	}
+	writeOutputf(ctx, exec, zoneCommandName, "Broadcast to %d %s in zone %s.\n", reached, noun, zoneID)
+	return nil
+}
+
+// zoneError surfaces the world service's zone validation, lookup, and rate
+// limit failures to the player and maps policy denials to the permission
+// error; anything else falls through to the generic player message. The
+// cause is not wrapped: oops resolves the innermost code, which would mask
+// WORLD_ERROR.
+func zoneError(err error) error {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return err
+	}
+	switch oopsErr.Code() {
+	case "ZONE_INVALID", "LOCATION_INVALID":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError(err.Error(), nil)
+	case "ZONE_NOT_FOUND":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("No locations are in that zone.", nil)
+	case "ZONE_RATE_LIMITED":
+		//nolint:wrapcheck // WorldError creates a structured oops error
+		return command.WorldError("You are broadcasting to that zone too often. Try again shortly.", nil)
+	case "ZONE_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(zoneCommandName, "zone")
+	case "LOCATION_ACCESS_DENIED":
+		//nolint:wrapcheck // ErrPermissionDenied creates a structured oops error
+		return command.ErrPermissionDenied(zoneCommandName, "location")
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/command"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// stubZoneAdmin is a test implementation of ZoneAdmin.
+type stubZoneAdmin struct {
+	location   *world.Location
+	updated    []*world.Location
+	zone       []*world.Location
+	broadcasts []world.ZoneBroadcast
+	zones      []string
+	subjects   []string
+	err        error
+}
+
+func (s *stubZoneAdmin) GetLocation(_ context.Context, subject string, _ ulid.ULID) (*world.Location, error) {
+	s.subjects = append(s.subjects, subject)
+	if s.err != nil {
+		return nil, s.err
+	}
+	loc := *s.location
+	return &loc, nil
+}
+
+func (s *stubZoneAdmin) UpdateLocation(_ context.Context, _ string, loc *world.Location) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.updated = append(s.updated, loc)
+	return nil
+}
+
+func (s *stubZoneAdmin) ListZoneLocations(_ context.Context, _, zoneID string) ([]*world.Location, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.zones = append(s.zones, zoneID)
+	return s.zone, nil
+}
+
+func (s *stubZoneAdmin) BroadcastToZone(_ context.Context, _, zoneID string, b world.ZoneBroadcast) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.zones = append(s.zones, zoneID)
+	s.broadcasts = append(s.broadcasts, b)
+	return len(s.zone), nil
+}
+
+var (
+	zoneCharID     = ulid.Make()
+	zoneLocationID = ulid.Make()
+)
+
+func newStubZoneAdmin() *stubZoneAdmin {
+	return &stubZoneAdmin{location: &world.Location{ID: zoneLocationID, Name: "Pier", Type: world.LocationTypePersistent}}
+}
+
+func runZone(t *testing.T, admin ZoneAdmin, args string) (string, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	exec := command.NewTestExecution(command.CommandExecutionConfig{
+		CharacterID:   zoneCharID,
+		CharacterName: "Alice",
+		LocationID:    zoneLocationID,
+		Args:          args,
+		Output:        &buf,
+	})
+	err := NewZoneHandler(admin)(context.Background(), exec)
+	return buf.String(), err
+}
+
+func TestZoneShow(t *testing.T) {
+	admin := newStubZoneAdmin()
+
+	out, err := runZone(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "This location is not in a zone.\n", out)
+	assert.Equal(t, []string{access.CharacterSubject(zoneCharID.String())}, admin.subjects)
+
+	admin.location.ZoneID = "harbor"
+	out, err = runZone(t, admin, "")
+	require.NoError(t, err)
+	assert.Equal(t, "This location is in zone harbor.\n", out)
+}
+
+func TestZoneSetAndClear(t *testing.T) {
+	admin := newStubZoneAdmin()
+
+	out, err := runZone(t, admin, "set Harbor")
+	require.NoError(t, err)
+	assert.Equal(t, "This location is now in zone harbor.\n", out)
+	require.Len(t, admin.updated, 1)
+	assert.Equal(t, "harbor", admin.updated[0].ZoneID)
+
+	admin.location.ZoneID = "harbor"
+	out, err = runZone(t, admin, "clear")
+	require.NoError(t, err)
+	assert.Equal(t, "This location is no longer in a zone.\n", out)
+	assert.Empty(t, admin.updated[1].ZoneID)
+
+	_, err = runZone(t, admin, "set")
+	errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+}
+
+func TestZoneList(t *testing.T) {
+	admin := newStubZoneAdmin()
+
+	out, err := runZone(t, admin, "list harbor")
+	require.NoError(t, err)
+	assert.Equal(t, "No locations are in zone harbor.\n", out)
+
+	admin.zone = []*world.Location{admin.location}
+	out, err = runZone(t, admin, "list harbor")
+	require.NoError(t, err)
+	assert.Equal(t, "Locations in zone harbor:\n  Pier ("+zoneLocationID.String()+")\n", out)
+}
+
+func TestZoneEmit(t *testing.T) {
+	admin := newStubZoneAdmin()
+	admin.zone = []*world.Location{admin.location, admin.location}
+
+	out, err := runZone(t, admin, "emit harbor/weather = Rain sweeps in off the bay.")
+	require.NoError(t, err)
+	assert.Equal(t, "Broadcast to 2 locations in zone harbor.\n", out)
+	assert.Equal(t, []string{"harbor"}, admin.zones)
+	assert.Equal(t, []world.ZoneBroadcast{{Kind: "weather", Text: "Rain sweeps in off the bay."}}, admin.broadcasts)
+
+	_, err = runZone(t, admin, "emit harbor = The bells ring.")
+	require.NoError(t, err)
+	assert.Empty(t, admin.broadcasts[1].Kind)
+
+	for _, args := range []string{"emit", "emit harbor", "emit = text", "emit harbor ="} {
+		_, err := runZone(t, admin, args)
+		errutil.AssertErrorCode(t, err, command.CodeInvalidArgs)
+	}
+}
+
+func TestZoneErrors(t *testing.T) {
+	admin := newStubZoneAdmin()
+
+	admin.err = oops.Code("ZONE_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	_, err := runZone(t, admin, "emit harbor = Hello.")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+
+	admin.err = oops.Code("ZONE_RATE_LIMITED").Errorf("too many")
+	_, err = runZone(t, admin, "emit harbor = Hello.")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+	assert.Contains(t, err.Error(), "too often")
+
+	admin.err = oops.Code("ZONE_NOT_FOUND").Errorf("empty")
+	_, err = runZone(t, admin, "emit harbor = Hello.")
+	errutil.AssertErrorCode(t, err, command.CodeWorldError)
+
+	admin.err = oops.Code("LOCATION_ACCESS_DENIED").Wrap(world.ErrPermissionDenied)
+	_, err = runZone(t, admin, "set harbor")
+	errutil.AssertErrorCode(t, err, command.CodePermissionDenied)
+}
+
+func TestZoneUnknownSubcommandShowsUsage(t *testing.T) {
+	out, err := runZone(t, newStubZoneAdmin(), "destroy harbor")
+	require.NoError(t, err)
+	assert.Equal(t, "Usage: "+zoneUsage+"\n", out)
+}
+
+func TestRegisterAdminZone(t *testing.T) {
+	reg := command.NewRegistry()
+	deps := AdminDeps{
+		PlayerRepo:     authmocks.NewMockPlayerRepository(t),
+		Hasher:         authmocks.NewMockPasswordHasher(t),
+		PlayerSessions: authmocks.NewMockPlayerSessionRepository(t),
+		ResetRepo:      authmocks.NewMockPasswordResetRepository(t),
+		CharLister:     &mockCharLister{},
+	}
+	RegisterAdmin(reg, deps)
+	_, found := reg.Get("zone")
+	assert.False(t, found, "zone requires the Zones dependency")
+
+	deps.Zones = newStubZoneAdmin()
+	RegisterAdmin(reg, deps)
+	_, found = reg.Get("zone")
+	assert.True(t, found)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// ErrorVerbosity selects how much of a failed command's error a session is
+// shown. It is stored per session, so a staff member can turn diagnostics on
+// in one client without changing what their other sessions see.
+type ErrorVerbosity string
+
+// Error verbosity levels.
+const (
+	// ErrorsTerse shows only the player-facing message. It is the default.
+	ErrorsTerse ErrorVerbosity = "terse"
+	// ErrorsVerbose adds the error code and the policy decision behind it
+	// for staff debugging.
+	ErrorsVerbose ErrorVerbosity = "verbose"
+)
+
+// ParseErrorVerbosity maps a stored or typed verbosity to its level. Empty
+// and unrecognized values are terse, so a session never shows diagnostics
+// it did not ask for.
+func ParseErrorVerbosity(s string) ErrorVerbosity {
+	if ErrorVerbosity(strings.ToLower(strings.TrimSpace(s))) == ErrorsVerbose {
+		return ErrorsVerbose
+	}
+	return ErrorsTerse
+}
+
+// diagnosticKeys are the oops context keys shown in verbose mode, in display
+// order. Only keys that describe the decision are listed; causes, subjects,
+// and internal identifiers stay in the server log.
+var diagnosticKeys = []string{
+	"command",
+	"policy_id",
+	"reason",
+	"capability",
+	"required_action",
+	"required_resource",
+	"required_scope",
+}
+
+// PresentError renders err for a session at the given verbosity. It is the
+// single place command errors become text: terse output is [PlayerMessage],
+// and verbose output appends a diagnostics line built from the error's code
+// and context. Neither level includes err.Error(), so wrapped causes never
+// reach the player.
+func PresentError(err error, v ErrorVerbosity) string {
+	msg := PlayerMessage(err)
+	if v != ErrorsVerbose {
+		return msg
+	}
+	if diag := diagnostics(err); diag != "" {
+		return msg + "\n" + diag
+	}
+	return msg
+}
+
+// diagnostics formats err's code and decision context as one line, or ""
+// when err carries neither.
+func diagnostics(err error) string {
+	oopsErr, ok := oops.AsOops(err)
+	if !ok {
+		return ""
+	}
+	var parts []string
+	if code, ok := oopsErr.Code().(string); ok && code != "" {
+		parts = append(parts, "code="+code)
+	}
+	ctx := oopsErr.Context()
+	for _, key := range diagnosticKeys {
+		val, ok := ctx[key]
+		if !ok {
+			continue
+		}
+		s := fmt.Sprint(val)
+		if s == "" {
+			continue
+		}
+		if strings.ContainsAny(s, " \t\"") {
+			s = fmt.Sprintf("%q", s)
+		}
+		parts = append(parts, key+"="+s)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package command
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrorVerbosity(t *testing.T) {
+	assert.Equal(t, ErrorsVerbose, ParseErrorVerbosity("verbose"))
+	assert.Equal(t, ErrorsVerbose, ParseErrorVerbosity(" VERBOSE "))
+	assert.Equal(t, ErrorsTerse, ParseErrorVerbosity("terse"))
+	assert.Equal(t, ErrorsTerse, ParseErrorVerbosity(""))
+	assert.Equal(t, ErrorsTerse, ParseErrorVerbosity("loud"))
+}
+
+func TestPresentError(t *testing.T) {
+	denied := oops.Code("LOCATION_ACCESS_DENIED").
+		With("reason", "no permit for write").
+		With("policy_id", "seed:builder-write").
+		With("subject", "character:01ABC").
+		Wrap(errors.New("permission denied"))
+
+	tests := []struct {
+		name string
+		err  error
+		v    ErrorVerbosity
+		want string
+	}{
+		{
+			name: "terse denial is the player message",
+			err:  denied,
+			v:    ErrorsTerse,
+			want: "You don't have permission to do that.",
+		},
+		{
+			name: "verbose denial adds code and decision",
+			err:  denied,
+			v:    ErrorsVerbose,
+			want: "You don't have permission to do that.\n" +
+				`[code=LOCATION_ACCESS_DENIED policy_id=seed:builder-write reason="no permit for write"]`,
+		},
+		{
+			name: "verbose command error includes the command",
+			err:  ErrInvalidArgs("dig", "dig <name>"),
+			v:    ErrorsVerbose,
+			want: "Usage: dig <name>\n[code=INVALID_ARGS command=dig]",
+		},
+		{
+			name: "verbose infrastructure error hides the cause",
+			err:  oops.Code("STORE_FAILED").Wrap(errors.New("dial tcp 10.0.0.5:5432: refused")),
+			v:    ErrorsVerbose,
+			want: "Something went wrong. Try again.\n[code=STORE_FAILED]",
+		},
+		{
+			name: "verbose plain error has no diagnostics",
+			err:  errors.New("boom"),
+			v:    ErrorsVerbose,
+			want: "Something went wrong. Try again.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PresentError(tt.err, tt.v))
+		})
+	}
+}
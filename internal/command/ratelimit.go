@@ -102,25 +102,7 @@ func NewRateLimiterWithRegistry(cfg RateLimiterConfig, reg prometheus.Registerer
 }
 
 func newRateLimiter(cfg RateLimiterConfig, reg prometheus.Registerer) *RateLimiter {
-	burstCapacity := cfg.BurstCapacity
-	if burstCapacity <= 0 {
-		// Use default when not specified
-		burstCapacity = DefaultBurstCapacity
-	}
-	// Ensure minimum burst capacity
-	if burstCapacity < MinBurstCapacity {
-		burstCapacity = MinBurstCapacity
-	}
-
-	sustainedRate := cfg.SustainedRate
-	if sustainedRate <= 0 {
-		// Use default when not specified
-		sustainedRate = DefaultSustainedRate
-	}
-	// Ensure minimum sustained rate
-	if sustainedRate < MinSustainedRate {
-		sustainedRate = MinSustainedRate
-	}
+	burstCapacity, sustainedRate := normalizeLimits(cfg.BurstCapacity, cfg.SustainedRate)
 
 	cleanupInterval := cfg.CleanupInterval
 	if cleanupInterval <= 0 {
@@ -156,6 +138,40 @@ func newRateLimiter(cfg RateLimiterConfig, reg prometheus.Registerer) *RateLimit
 	return rl
 }
 
+// normalizeLimits applies the defaults and minimums documented on
+// RateLimiterConfig.
+func normalizeLimits(burstCapacity int, sustainedRate float64) (int, float64) {
+	if burstCapacity <= 0 {
+		// Use default when not specified
+		burstCapacity = DefaultBurstCapacity
+	}
+	// Ensure minimum burst capacity
+	if burstCapacity < MinBurstCapacity {
+		burstCapacity = MinBurstCapacity
+	}
+
+	if sustainedRate <= 0 {
+		// Use default when not specified
+		sustainedRate = DefaultSustainedRate
+	}
+	// Ensure minimum sustained rate
+	if sustainedRate < MinSustainedRate {
+		sustainedRate = MinSustainedRate
+	}
+	return burstCapacity, sustainedRate
+}
+
+// SetLimits replaces the burst capacity and sustained rate, normalized the
+// same way as RateLimiterConfig. Tracked sessions keep their current tokens,
+// capped at the new burst capacity on their next command.
+func (rl *RateLimiter) SetLimits(burstCapacity int, sustainedRate float64) {
+	burstCapacity, sustainedRate = normalizeLimits(burstCapacity, sustainedRate)
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.burstCapacity = burstCapacity
+	rl.sustainedRate = sustainedRate
+}
+
 // Allow checks if a command is allowed for the given session.
 // Returns (allowed, cooldownMs) where:
 //   - allowed: true if the command should be executed
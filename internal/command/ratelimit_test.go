@@ -257,6 +257,39 @@ func TestRateLimiter_Cleanup(t *testing.T) {
 	})
 }
 
+func TestRateLimiter_SetLimits(t *testing.T) {
+	t.Run("new burst capacity caps existing sessions", func(t *testing.T) {
+		rl := NewRateLimiter(RateLimiterConfig{
+			BurstCapacity: 10,
+			SustainedRate: 0.1,
+		})
+		defer rl.Close()
+
+		session := ulid.Make()
+		allowed, _ := rl.Allow(session)
+		require.True(t, allowed)
+
+		rl.SetLimits(2, 0.1)
+		assert.Equal(t, 2, rl.burstCapacity)
+
+		for range 2 {
+			allowed, _ = rl.Allow(session)
+			assert.True(t, allowed)
+		}
+		allowed, _ = rl.Allow(session)
+		assert.False(t, allowed, "tokens above the new burst capacity are dropped")
+	})
+
+	t.Run("normalizes like the config", func(t *testing.T) {
+		rl := NewRateLimiter(RateLimiterConfig{})
+		defer rl.Close()
+
+		rl.SetLimits(0, 0.01)
+		assert.Equal(t, DefaultBurstCapacity, rl.burstCapacity)
+		assert.Equal(t, MinSustainedRate, rl.sustainedRate)
+	})
+}
+
 func TestRateLimiter_Concurrency(_ *testing.T) {
 	rl := NewRateLimiter(RateLimiterConfig{
 		BurstCapacity: 100,
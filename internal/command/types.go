@@ -42,6 +42,9 @@ type WorldService interface {
 	// GetExitsByLocation retrieves all exits from a location after checking read authorization.
 	GetExitsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Exit, error)
 
+	// GetVisibleExits retrieves the exits from a location that observerCharID can see.
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+
 	// CreateExit creates a new exit after checking write authorization.
 	CreateExit(ctx context.Context, subjectID string, exit *world.Exit) error
 
@@ -72,8 +75,8 @@ type WorldService interface {
 	// FindLocationByName searches for a location by name after checking read authorization.
 	FindLocationByName(ctx context.Context, subjectID, name string) (*world.Location, error)
 
-	// GetCharactersByLocation returns characters at a location after checking authorization.
-	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error)
+	// GetCharactersByLocation returns a page of the characters at a location after checking authorization.
+	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error)
 
 	// GetObjectsByLocation returns objects at a location after checking authorization.
 	GetObjectsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Object, error)
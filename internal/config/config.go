@@ -1,7 +1,8 @@
 // SPDX-License-Identifier: Apache-2.0
 // Copyright 2026 HoloMUSH Contributors
 
-// Package config loads HoloMUSH configuration from YAML files and CLI flags.
+// Package config loads HoloMUSH configuration from YAML files, the
+// environment, and CLI flags, and reloads it while the server runs.
 package config
 
 import (
@@ -9,16 +10,10 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
-	"strings"
 	"time"
 
-	"github.com/knadh/koanf/parsers/yaml"
-	"github.com/knadh/koanf/providers/file"
-	"github.com/knadh/koanf/providers/posflag"
-	"github.com/knadh/koanf/v2"
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
-	"github.com/spf13/pflag"
 
 	"github.com/holomush/holomush/internal/xdg"
 )
@@ -28,6 +23,53 @@ type GameConfig struct {
 	GuestStartLocation   string   `koanf:"guest_start_location"`
 	DisabledCommands     []string `koanf:"disabled_commands"`
 	PluginTrustAllowlist []string `koanf:"plugin_trust_allowlist"`
+	// Currencies lists the game's currency codes; the first is the default
+	// used when a payment names none. Empty means a single "credits".
+	Currencies []string `koanf:"currencies"`
+	// PageOfflineFallback is what happens to a page sent to a character
+	// who is not connected: "mail" (the default when empty) holds it until
+	// their next login, "none" refuses it.
+	PageOfflineFallback string `koanf:"page_offline_fallback"`
+	// Clock configures the in-character game clock and calendar.
+	Clock GameClockConfig `koanf:"clock"`
+}
+
+// GameClockConfig holds the "game.clock" YAML section. Zero values take the
+// game clock's defaults: a Gregorian calendar starting 2026-01-01 UTC,
+// running four times as fast as real time, with dawn at 06:00 and dusk at
+// 18:00.
+type GameClockConfig struct {
+	// Epoch is the real time (RFC 3339) at which the calendar reads
+	// midnight on the first day of StartYear.
+	Epoch string `koanf:"epoch"`
+	// StartYear is the in-character year at Epoch.
+	StartYear int `koanf:"start_year"`
+	// Ratio is how many in-character seconds pass per real second.
+	Ratio float64 `koanf:"ratio"`
+	// Months lists the months of the year in order.
+	Months []GameMonthConfig `koanf:"months"`
+	// Seasons lists the seasons, each by the month it starts in.
+	Seasons []GameSeasonConfig `koanf:"seasons"`
+	// DawnHour and DuskHour are the hours the sun rises and sets.
+	DawnHour int `koanf:"dawn_hour"`
+	DuskHour int `koanf:"dusk_hour"`
+	// DawnText and DuskText are the lines outdoor locations see at dawn
+	// and dusk.
+	DawnText string `koanf:"dawn_text"`
+	DuskText string `koanf:"dusk_text"`
+}
+
+// GameMonthConfig is one entry of "game.clock.months".
+type GameMonthConfig struct {
+	Name string `koanf:"name"`
+	Days int    `koanf:"days"`
+}
+
+// GameSeasonConfig is one entry of "game.clock.seasons". StartMonth is
+// 1-based.
+type GameSeasonConfig struct {
+	Name       string `koanf:"name"`
+	StartMonth int    `koanf:"start_month"`
 }
 
 // AuthConfig holds authentication-related configuration read by the core
@@ -39,6 +81,49 @@ type AuthConfig struct {
 	// remove all game sessions and terminate their Subscribe streams.
 	// A value <= 0 disables the cap (test configurations only).
 	MaxPlayerSessionsPerPlayer int `koanf:"max_player_sessions_per_player"`
+
+	// OIDC configures sign-in with linked identity provider accounts.
+	// OIDC sign-in is disabled when no providers are listed.
+	OIDC OIDCConfig `koanf:"oidc"`
+
+	// AccountDeletion configures how deleted player accounts are anonymized.
+	AccountDeletion AccountDeletionConfig `koanf:"account_deletion"`
+
+	// Impersonators is the allow-list of player IDs (ULIDs) of support
+	// staff who may open impersonation sessions as other players. Every
+	// impersonation is time-limited, audited under both identities, and
+	// announced to the impersonated player at their next login. Empty
+	// disables impersonation.
+	Impersonators []string `koanf:"impersonators"`
+}
+
+// AccountDeletionConfig holds the "auth.account_deletion" YAML section.
+type AccountDeletionConfig struct {
+	// TombstoneName replaces the names of a deleted player's characters, so
+	// the events they authored remain attributed to a tombstone rather than
+	// the player. Letters and spaces only; empty uses "Deleted Character".
+	TombstoneName string `koanf:"tombstone_name"`
+}
+
+// OIDCConfig holds the "auth.oidc" YAML section.
+type OIDCConfig struct {
+	// Providers lists the trusted identity providers.
+	Providers []OIDCProviderConfig `koanf:"providers"`
+	// RequirePasswordFallback requires every account signing in through a
+	// provider to also have a local password. Unlinking is then always
+	// allowed. Linking an identity re-enters the password either way.
+	RequirePasswordFallback bool `koanf:"require_password_fallback"`
+}
+
+// OIDCProviderConfig describes one identity provider.
+type OIDCProviderConfig struct {
+	// Name labels linked identities ("google", "discord") and MUST NOT change
+	// once players have linked accounts.
+	Name     string `koanf:"name"`
+	Issuer   string `koanf:"issuer"`
+	ClientID string `koanf:"client_id"`
+	// JWKSURL is optional; when empty it is discovered from the issuer.
+	JWKSURL string `koanf:"jwks_url"`
 }
 
 // DefaultMaxPlayerSessionsPerPlayer is the default concurrent session cap
@@ -230,9 +315,12 @@ func DefaultLoggingConfig() LoggingConfig {
 	}
 }
 
-// Load reads configuration from a YAML file and overlays explicitly-set CLI flags.
+// Load reads one section of configuration from the YAML file, the
+// environment, and explicitly-set CLI flags. It is a one-shot NewLoader +
+// Unmarshal; processes that hot-reload keep the Loader instead.
 //
-// Precedence (lowest to highest): YAML config file -> CLI flags.
+// Precedence (lowest to highest): YAML config file -> HOLOMUSH_* environment
+// variables (see EnvPrefix) -> CLI flags.
 //
 // If configPath is non-empty, that file is loaded (error if missing).
 // If configPath is empty, the default XDG config path is tried (silent if missing).
@@ -242,38 +330,11 @@ func DefaultLoggingConfig() LoggingConfig {
 // The section parameter selects which top-level YAML key to unmarshal
 // (e.g., "core", "gateway", "game").
 func Load(configPath string, cmd *cobra.Command, target any, section string) error {
-	k := koanf.New(".")
-
-	// Step 1: Resolve and load YAML file.
-	path, _, err := resolveConfigPath(configPath)
+	l, err := NewLoader(configPath, cmd)
 	if err != nil {
 		return err
 	}
-
-	if path != "" {
-		if err := k.Load(file.Provider(path), yaml.Parser()); err != nil {
-			return oops.Code("CONFIG_PARSE_FAILED").With("path", path).Wrap(err)
-		}
-	}
-
-	// Step 2: Overlay explicitly-set CLI flags.
-	// The callback normalizes flag names (hyphens -> underscores) and prefixes
-	// them with the section so they land in the correct koanf namespace.
-	// Passing k to ProviderWithFlag ensures only explicitly-set flags override.
-	if err := k.Load(posflag.ProviderWithFlag(cmd.Flags(), ".", k,
-		func(f *pflag.Flag) (string, interface{}) {
-			key := section + "." + strings.ReplaceAll(f.Name, "-", "_")
-			return key, posflag.FlagVal(cmd.Flags(), f)
-		}), nil); err != nil {
-		return oops.Code("CONFIG_FLAG_FAILED").Wrap(err)
-	}
-
-	// Step 3: Unmarshal the section into the target struct.
-	if err := k.UnmarshalWithConf(section, target, koanf.UnmarshalConf{Tag: "koanf"}); err != nil {
-		return oops.Code("CONFIG_UNMARSHAL_FAILED").With("section", section).Wrap(err)
-	}
-
-	return nil
+	return l.Unmarshal(section, target)
 }
 
 // resolveConfigPath determines which config file to load.
@@ -181,6 +181,43 @@ func TestLoadParsesAuthConfigMaxPlayerSessionsPerPlayer(t *testing.T) {
 	assert.Equal(t, 3, cfg.MaxPlayerSessionsPerPlayer)
 }
 
+func TestLoadParsesAuthConfigOIDC(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "config.yaml")
+	yaml := "auth:\n" +
+		"  oidc:\n" +
+		"    require_password_fallback: true\n" +
+		"    providers:\n" +
+		"      - name: google\n" +
+		"        issuer: https://accounts.google.com\n" +
+		"        client_id: abc.apps.googleusercontent.com\n"
+	require.NoError(t, os.WriteFile(cfgFile, []byte(yaml), 0o600))
+
+	cfg := DefaultAuthConfig()
+	err := Load(cfgFile, &cobra.Command{Use: "test"}, &cfg, "auth")
+	require.NoError(t, err)
+	assert.True(t, cfg.OIDC.RequirePasswordFallback)
+	require.Len(t, cfg.OIDC.Providers, 1)
+	assert.Equal(t, OIDCProviderConfig{
+		Name:     "google",
+		Issuer:   "https://accounts.google.com",
+		ClientID: "abc.apps.googleusercontent.com",
+	}, cfg.OIDC.Providers[0])
+	assert.Equal(t, DefaultMaxPlayerSessionsPerPlayer, cfg.MaxPlayerSessionsPerPlayer)
+}
+
+func TestLoadParsesAuthConfigAccountDeletion(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, "config.yaml")
+	yaml := "auth:\n  account_deletion:\n    tombstone_name: Former Player\n"
+	require.NoError(t, os.WriteFile(cfgFile, []byte(yaml), 0o600))
+
+	cfg := DefaultAuthConfig()
+	err := Load(cfgFile, &cobra.Command{Use: "test"}, &cfg, "auth")
+	require.NoError(t, err)
+	assert.Equal(t, "Former Player", cfg.AccountDeletion.TombstoneName)
+}
+
 func TestLoadParsesPluginTrustAllowlist(t *testing.T) {
 	dir := t.TempDir()
 	cfgFile := filepath.Join(dir, "config.yaml")
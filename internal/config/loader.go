@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package config
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/knadh/koanf/maps"
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/providers/posflag"
+	"github.com/knadh/koanf/v2"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// EnvPrefix marks environment variables that override config file keys.
+// The rest of the name is the key path with sections separated by a double
+// underscore: HOLOMUSH_CORE__AUDIT_MODE sets core.audit_mode and
+// HOLOMUSH_EVENT_BUS__AUDIT__RETAIN_WINDOW sets event_bus.audit.retain_window.
+// Variables without a double underscore (HOLOMUSH_KEK_FILE and friends) are
+// not config keys and are ignored.
+const EnvPrefix = "HOLOMUSH_"
+
+const envKeySeparator = "__"
+
+// ConfigSubscriber is a running subsystem that applies configuration changes
+// without a restart.
+//
+//nolint:revive // stutters, but stays unambiguous where subsystems implement it
+type ConfigSubscriber interface {
+	// ApplyConfig reads the sections it cares about from cfg and applies
+	// them. It runs after every successful reload whether or not those
+	// sections changed, so it must be idempotent. An error leaves the
+	// subsystem on its previous settings; the other subscribers still run.
+	ApplyConfig(ctx context.Context, cfg *Loader) error
+}
+
+// ConfigSubscriberFunc adapts a function to ConfigSubscriber.
+//
+//nolint:revive // named after ConfigSubscriber
+type ConfigSubscriberFunc func(ctx context.Context, cfg *Loader) error
+
+// ApplyConfig calls f.
+func (f ConfigSubscriberFunc) ApplyConfig(ctx context.Context, cfg *Loader) error {
+	return f(ctx, cfg)
+}
+
+type subscription struct {
+	name string
+	sub  ConfigSubscriber
+}
+
+// Loader is the central configuration source for a process. It reads the
+// YAML config file, overlays HOLOMUSH_* environment variables (see
+// EnvPrefix), and overlays explicitly-set CLI flags when a section is
+// unmarshalled.
+//
+// Precedence (lowest to highest): YAML config file -> environment -> CLI flags.
+//
+// Reload re-reads the file and environment and hands the result to every
+// subscriber; Watch calls Reload whenever the file changes. Flags are fixed
+// for the life of the process, so a key set by flag keeps its flag value
+// across reloads.
+type Loader struct {
+	path  string
+	flags *pflag.FlagSet
+
+	mu   sync.RWMutex
+	base *koanf.Koanf
+	subs []subscription
+
+	// reloadMu serializes Reload so subscribers never see two reloads
+	// interleave.
+	reloadMu sync.Mutex
+}
+
+// NewLoader resolves the config file and performs the initial read.
+//
+// If configPath is non-empty, that file is loaded (error if missing).
+// If configPath is empty, the default XDG config path is tried (silent if
+// missing). cmd supplies the CLI flags; nil means no flag overlay.
+func NewLoader(configPath string, cmd *cobra.Command) (*Loader, error) {
+	path, _, err := resolveConfigPath(configPath)
+	if err != nil {
+		return nil, err
+	}
+	l := &Loader{path: path}
+	if cmd != nil {
+		l.flags = cmd.Flags()
+	}
+	base, err := l.read()
+	if err != nil {
+		return nil, err
+	}
+	l.base = base
+	return l, nil
+}
+
+// Path returns the config file the loader reads, or "" when none was found.
+func (l *Loader) Path() string {
+	return l.path
+}
+
+// Unmarshal decodes one top-level section (e.g., "core", "gateway", "game")
+// into target, overlaying explicitly-set CLI flags onto that section.
+func (l *Loader) Unmarshal(section string, target any) error {
+	l.mu.RLock()
+	k := l.base.Copy()
+	l.mu.RUnlock()
+
+	// The callback normalizes flag names (hyphens -> underscores) and
+	// prefixes them with the section so they land in the correct koanf
+	// namespace. Passing k to ProviderWithFlag ensures only explicitly-set
+	// flags override.
+	if l.flags != nil {
+		if err := k.Load(posflag.ProviderWithFlag(l.flags, ".", k,
+			func(f *pflag.Flag) (string, interface{}) {
+				key := section + "." + strings.ReplaceAll(f.Name, "-", "_")
+				return key, posflag.FlagVal(l.flags, f)
+			}), nil); err != nil {
+			return oops.Code("CONFIG_FLAG_FAILED").Wrap(err)
+		}
+	}
+
+	if err := k.UnmarshalWithConf(section, target, koanf.UnmarshalConf{Tag: "koanf"}); err != nil {
+		return oops.Code("CONFIG_UNMARSHAL_FAILED").With("section", section).Wrap(err)
+	}
+	return nil
+}
+
+// Subscribe registers sub to be called, in registration order, after each
+// successful reload. name identifies the subscriber in logs.
+func (l *Loader) Subscribe(name string, sub ConfigSubscriber) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.subs = append(l.subs, subscription{name: name, sub: sub})
+}
+
+// Reload re-reads the config file and environment, then runs every
+// subscriber. A file that no longer parses is reported and the previous
+// configuration stays in effect. Subscriber errors are logged and joined
+// into the returned error; one failing subscriber does not stop the rest.
+func (l *Loader) Reload(ctx context.Context) error {
+	l.reloadMu.Lock()
+	defer l.reloadMu.Unlock()
+
+	base, err := l.read()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	l.base = base
+	subs := append([]subscription(nil), l.subs...)
+	l.mu.Unlock()
+
+	var errs []error
+	for _, s := range subs {
+		if err := s.sub.ApplyConfig(ctx, l); err != nil {
+			slog.WarnContext(ctx, "config reload not applied",
+				"subscriber", s.name, "error", err)
+			errs = append(errs, oops.Code("CONFIG_APPLY_FAILED").With("subscriber", s.name).Wrap(err))
+		}
+	}
+	slog.InfoContext(ctx, "configuration reloaded",
+		"path", l.path, "subscribers", len(subs), "failed", len(errs))
+	return errors.Join(errs...)
+}
+
+// Watch reloads the configuration whenever the config file changes, until
+// ctx is cancelled. It returns at once, with nothing to watch, when no
+// config file was found. Reload failures are logged, not returned: a bad
+// edit must not take a running server down.
+func (l *Loader) Watch(ctx context.Context) error {
+	if l.path == "" {
+		slog.InfoContext(ctx, "no config file, configuration will not be watched")
+		return nil
+	}
+
+	fp := file.Provider(l.path)
+	changed := make(chan struct{}, 1)
+	if err := fp.Watch(func(_ any, err error) {
+		if err != nil {
+			//nolint:sloglint // fsnotify callback has no request context
+			slog.Warn("config file watch error", "path", l.path, "error", err)
+			return
+		}
+		// Coalesce bursts of events (editors often write a file in several
+		// steps) into one pending reload.
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}); err != nil {
+		return oops.Code("CONFIG_WATCH_FAILED").With("path", l.path).Wrap(err)
+	}
+
+	go func() {
+		defer func() {
+			_ = fp.Unwatch() //nolint:errcheck // best-effort on shutdown
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+				if err := l.Reload(ctx); err != nil {
+					slog.WarnContext(ctx, "config reload failed", "path", l.path, "error", err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// read loads the config file (if any) and the environment overlay into a
+// fresh koanf instance.
+func (l *Loader) read() (*koanf.Koanf, error) {
+	k := koanf.New(".")
+	if l.path != "" {
+		if err := k.Load(file.Provider(l.path), yaml.Parser()); err != nil {
+			return nil, oops.Code("CONFIG_PARSE_FAILED").With("path", l.path).Wrap(err)
+		}
+	}
+	if err := k.Load(envProvider{environ: os.Environ()}, nil); err != nil {
+		return nil, oops.Code("CONFIG_ENV_FAILED").Wrap(err)
+	}
+	return k, nil
+}
+
+// envProvider is a koanf.Provider over HOLOMUSH_SECTION__KEY environment
+// variables.
+type envProvider struct {
+	environ []string
+}
+
+// ReadBytes is not supported; koanf calls Read when no parser is given.
+func (envProvider) ReadBytes() ([]byte, error) {
+	return nil, errors.New("env provider does not support ReadBytes")
+}
+
+// Read returns the matching variables as a nested map keyed by config path.
+func (p envProvider) Read() (map[string]any, error) {
+	flat := make(map[string]any)
+	for _, kv := range p.environ {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, EnvPrefix) {
+			continue
+		}
+		path := strings.TrimPrefix(name, EnvPrefix)
+		if !strings.Contains(path, envKeySeparator) {
+			continue
+		}
+		flat[strings.ToLower(strings.ReplaceAll(path, envKeySeparator, "."))] = value
+	}
+	return maps.Unflatten(flat, "."), nil
+}
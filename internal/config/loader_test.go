@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func writeConfig(t *testing.T, path, body string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+}
+
+func TestLoadEnvOverridesConfigFile(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, cfgFile, "server:\n  addr: \"0.0.0.0:9000\"\n  log_format: \"text\"\n")
+	t.Setenv("HOLOMUSH_SERVER__ADDR", "10.0.0.1:9000")
+
+	cfg := &testConfig{}
+	require.NoError(t, Load(cfgFile, newTestCmd(cfg), cfg, "server"))
+
+	assert.Equal(t, "10.0.0.1:9000", cfg.Addr)
+	assert.Equal(t, "text", cfg.LogFormat)
+}
+
+func TestLoadCLIFlagsOverrideEnv(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOLOMUSH_SERVER__ADDR", "10.0.0.1:9000")
+
+	cfg := &testConfig{}
+	cmd := newTestCmd(cfg)
+	require.NoError(t, cmd.Flags().Set("addr", "127.0.0.1:7000"))
+	require.NoError(t, Load("", cmd, cfg, "server"))
+
+	assert.Equal(t, "127.0.0.1:7000", cfg.Addr)
+}
+
+func TestEnvProviderMapsNestedKeysAndSkipsNonConfigVars(t *testing.T) {
+	got, err := envProvider{environ: []string{
+		"HOLOMUSH_EVENT_BUS__AUDIT__RETAIN_WINDOW=720h",
+		"HOLOMUSH_CORE__AUDIT_MODE=all",
+		"HOLOMUSH_KEK_FILE=/etc/kek",
+		"PATH=/usr/bin",
+	}}.Read()
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"event_bus": map[string]any{"audit": map[string]any{"retain_window": "720h"}},
+		"core":      map[string]any{"audit_mode": "all"},
+	}, got)
+}
+
+func TestLoaderReloadNotifiesSubscribersWithNewValues(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, cfgFile, "server:\n  addr: \"a:1\"\n")
+
+	loader, err := NewLoader(cfgFile, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cfgFile, loader.Path())
+
+	var seen []string
+	loader.Subscribe("server", ConfigSubscriberFunc(func(_ context.Context, cfg *Loader) error {
+		var sc testConfig
+		if err := cfg.Unmarshal("server", &sc); err != nil {
+			return err
+		}
+		seen = append(seen, sc.Addr)
+		return nil
+	}))
+
+	writeConfig(t, cfgFile, "server:\n  addr: \"b:2\"\n")
+	require.NoError(t, loader.Reload(context.Background()))
+	assert.Equal(t, []string{"b:2"}, seen)
+}
+
+func TestLoaderReloadKeepsPreviousConfigWhenFileIsBroken(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, cfgFile, "server:\n  addr: \"a:1\"\n")
+
+	loader, err := NewLoader(cfgFile, nil)
+	require.NoError(t, err)
+	called := false
+	loader.Subscribe("server", ConfigSubscriberFunc(func(context.Context, *Loader) error {
+		called = true
+		return nil
+	}))
+
+	writeConfig(t, cfgFile, "server:\n  addr: [unclosed\n")
+	err = loader.Reload(context.Background())
+	errutil.AssertErrorCode(t, err, "CONFIG_PARSE_FAILED")
+	assert.False(t, called, "subscribers must not run on a failed read")
+
+	var sc testConfig
+	require.NoError(t, loader.Unmarshal("server", &sc))
+	assert.Equal(t, "a:1", sc.Addr)
+}
+
+func TestLoaderReloadRunsEverySubscriberDespiteFailures(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	loader, err := NewLoader("", nil)
+	require.NoError(t, err)
+
+	var ran []string
+	loader.Subscribe("first", ConfigSubscriberFunc(func(context.Context, *Loader) error {
+		ran = append(ran, "first")
+		return errors.New("rejected")
+	}))
+	loader.Subscribe("second", ConfigSubscriberFunc(func(context.Context, *Loader) error {
+		ran = append(ran, "second")
+		return nil
+	}))
+
+	err = loader.Reload(context.Background())
+	errutil.AssertErrorCode(t, err, "CONFIG_APPLY_FAILED")
+	errutil.AssertErrorContext(t, err, "subscriber", "first")
+	assert.Equal(t, []string{"first", "second"}, ran)
+}
+
+func TestLoaderWatchReloadsOnFileChange(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, cfgFile, "server:\n  addr: \"a:1\"\n")
+
+	loader, err := NewLoader(cfgFile, nil)
+	require.NoError(t, err)
+
+	addrs := make(chan string, 8)
+	loader.Subscribe("server", ConfigSubscriberFunc(func(_ context.Context, cfg *Loader) error {
+		var sc testConfig
+		if err := cfg.Unmarshal("server", &sc); err != nil {
+			return err
+		}
+		addrs <- sc.Addr
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, loader.Watch(ctx))
+
+	writeConfig(t, cfgFile, "server:\n  addr: \"b:2\"\n")
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case addr := <-addrs:
+			if addr == "b:2" {
+				return
+			}
+		case <-deadline:
+			t.Fatal("watch did not reload after the file changed")
+		}
+	}
+}
+
+func TestLoaderWatchWithoutConfigFileIsNoop(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	loader, err := NewLoader("", nil)
+	require.NoError(t, err)
+	assert.Empty(t, loader.Path())
+	assert.NoError(t, loader.Watch(context.Background()))
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package connhistory records when characters connect and disconnect. Each
+// client connection a character's session attaches is kept as one record,
+// which backs the last-seen time and the per-character connection history.
+package connhistory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Connection history limits.
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 200
+)
+
+// ErrNotFound is returned when a character has no recorded connections.
+var ErrNotFound = errors.New("no connection recorded")
+
+// Connection is one client connection of a character. ID is the connection
+// ULID the gateway supplies; ClientType is the kind of client ("terminal",
+// "comms_hub", "telnet"). A nil DisconnectedAt means no disconnect was
+// recorded: the connection is open, or the server stopped before it closed.
+type Connection struct {
+	ID             ulid.ULID
+	CharacterID    ulid.ULID
+	SessionID      string
+	ClientType     string
+	ConnectedAt    time.Time
+	DisconnectedAt *time.Time
+}
+
+// Open reports whether no disconnect has been recorded for the connection.
+func (c *Connection) Open() bool {
+	return c.DisconnectedAt == nil
+}
+
+// Duration returns how long the connection lasted, or for an open
+// connection how long it has lasted as of now.
+func (c *Connection) Duration(now time.Time) time.Duration {
+	end := now
+	if c.DisconnectedAt != nil {
+		end = *c.DisconnectedAt
+	}
+	if end.Before(c.ConnectedAt) {
+		return 0
+	}
+	return end.Sub(c.ConnectedAt)
+}
+
+// LastSeen summarizes a character's most recent connection. At is when it
+// disconnected, or when it connected while Connected is true.
+type LastSeen struct {
+	CharacterID ulid.ULID
+	At          time.Time
+	Connected   bool
+	ClientType  string
+}
+
+// lastSeenFrom builds the LastSeen summary of conn.
+func lastSeenFrom(conn *Connection) *LastSeen {
+	seen := &LastSeen{
+		CharacterID: conn.CharacterID,
+		At:          conn.ConnectedAt,
+		Connected:   conn.Open(),
+		ClientType:  conn.ClientType,
+	}
+	if conn.DisconnectedAt != nil {
+		seen.At = *conn.DisconnectedAt
+	}
+	return seen
+}
+
+// Repository persists connection records.
+type Repository interface {
+	// Start records an opened connection. Starting a connection ID that is
+	// already recorded reopens it and keeps its original connect time, so a
+	// gateway resubscribing on the same connection does not split it.
+	Start(ctx context.Context, conn *Connection) error
+
+	// End records that the connection with id closed at. Ending an unknown
+	// or already-closed connection is a no-op.
+	End(ctx context.Context, id ulid.ULID, at time.Time) error
+
+	// Latest returns characterID's most recent connection. Returns
+	// ErrNotFound when none is recorded.
+	Latest(ctx context.Context, characterID ulid.ULID) (*Connection, error)
+
+	// List returns up to limit of characterID's connections, newest first.
+	List(ctx context.Context, characterID ulid.ULID, limit int) ([]*Connection, error)
+}
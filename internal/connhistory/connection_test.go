@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package connhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+func TestConnectionDuration(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	conn := &Connection{ID: idgen.New(), ConnectedAt: start}
+
+	assert.True(t, conn.Open())
+	assert.Equal(t, 10*time.Minute, conn.Duration(start.Add(10*time.Minute)))
+	assert.Zero(t, conn.Duration(start.Add(-time.Minute)), "clock skew never yields a negative duration")
+
+	end := start.Add(90 * time.Second)
+	conn.DisconnectedAt = &end
+	assert.False(t, conn.Open())
+	assert.Equal(t, 90*time.Second, conn.Duration(start.Add(time.Hour)))
+}
+
+func TestLastSeenFrom(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	conn := &Connection{ID: idgen.New(), CharacterID: idgen.New(), ClientType: "telnet", ConnectedAt: start}
+
+	seen := lastSeenFrom(conn)
+	assert.True(t, seen.Connected)
+	assert.Equal(t, start, seen.At)
+	assert.Equal(t, conn.CharacterID, seen.CharacterID)
+	assert.Equal(t, "telnet", seen.ClientType)
+
+	end := start.Add(time.Hour)
+	conn.DisconnectedAt = &end
+	seen = lastSeenFrom(conn)
+	assert.False(t, seen.Connected)
+	assert.Equal(t, end, seen.At)
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package connhistory
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const connectionColumns = `id, character_id, session_id, client_type, connected_at, disconnected_at`
+
+// PostgresStore implements Repository against the character_connections
+// table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Start inserts conn, or clears the disconnect time of an existing row with
+// the same ID.
+func (s *PostgresStore) Start(ctx context.Context, conn *Connection) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO character_connections (id, character_id, session_id, client_type, connected_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET disconnected_at = NULL
+	`, conn.ID.String(), conn.CharacterID.String(), conn.SessionID, conn.ClientType,
+		pgnanos.From(conn.ConnectedAt))
+	if err != nil {
+		return oops.Code("CONNHISTORY_STORE_FAILED").
+			With("operation", "start").
+			With("connection_id", conn.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// End sets the disconnect time of an open connection.
+func (s *PostgresStore) End(ctx context.Context, id ulid.ULID, at time.Time) error {
+	_, err := s.pool.Exec(ctx, `
+		UPDATE character_connections
+		   SET disconnected_at = $2
+		 WHERE id = $1 AND disconnected_at IS NULL
+	`, id.String(), pgnanos.From(at))
+	if err != nil {
+		return oops.Code("CONNHISTORY_STORE_FAILED").
+			With("operation", "end").
+			With("connection_id", id.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Latest returns the connection with the newest connect time.
+func (s *PostgresStore) Latest(ctx context.Context, characterID ulid.ULID) (*Connection, error) {
+	row := s.pool.QueryRow(ctx, `
+		SELECT `+connectionColumns+`
+		  FROM character_connections
+		 WHERE character_id = $1
+		 ORDER BY connected_at DESC, id DESC
+		 LIMIT 1
+	`, characterID.String())
+	conn, err := scanConnection(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("CONNHISTORY_NOT_FOUND").
+			With("character_id", characterID.String()).
+			Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("CONNHISTORY_STORE_FAILED").
+			With("operation", "latest").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return conn, nil
+}
+
+// List returns up to limit connections, newest first.
+func (s *PostgresStore) List(ctx context.Context, characterID ulid.ULID, limit int) ([]*Connection, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+connectionColumns+`
+		  FROM character_connections
+		 WHERE character_id = $1
+		 ORDER BY connected_at DESC, id DESC
+		 LIMIT $2
+	`, characterID.String(), limit)
+	if err != nil {
+		return nil, oops.Code("CONNHISTORY_STORE_FAILED").With("operation", "list").Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*Connection
+	for rows.Next() {
+		conn, err := scanConnection(rows)
+		if err != nil {
+			return nil, oops.Code("CONNHISTORY_STORE_FAILED").With("operation", "list").Wrap(err)
+		}
+		out = append(out, conn)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("CONNHISTORY_STORE_FAILED").With("operation", "list").Wrap(err)
+	}
+	return out, nil
+}
+
+func scanConnection(row pgx.Row) (*Connection, error) {
+	var (
+		conn           Connection
+		id             string
+		characterID    string
+		connectedAt    pgnanos.Time
+		disconnectedAt *pgnanos.Time
+	)
+	if err := row.Scan(&id, &characterID, &conn.SessionID, &conn.ClientType,
+		&connectedAt, &disconnectedAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("connection_id", id).Wrap(err)
+	}
+	conn.ID = parsed
+	if conn.CharacterID, err = ulid.Parse(characterID); err != nil {
+		return nil, oops.With("character_id", characterID).Wrap(err)
+	}
+	conn.ConnectedAt = connectedAt.Time()
+	if disconnectedAt != nil {
+		t := disconnectedAt.Time()
+		conn.DisconnectedAt = &t
+	}
+	return &conn, nil
+}
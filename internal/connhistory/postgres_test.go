@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package connhistory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/connhistory"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// createCharacter inserts a bare character row for the connection FK.
+func createCharacter(t *testing.T, pool *pgxpool.Pool) ulid.ULID {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO characters (id, name) VALUES ($1, $2)`, id.String(), "ConnTest"+id.String()[20:])
+	require.NoError(t, err)
+	return id
+}
+
+func TestPostgresStoreRoundTrip(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := connhistory.NewPostgresStore(pool)
+	charID := createCharacter(t, pool)
+
+	_, err := st.Latest(ctx, charID)
+	errutil.AssertErrorCode(t, err, "CONNHISTORY_NOT_FOUND")
+	assert.ErrorIs(t, err, connhistory.ErrNotFound)
+
+	start := time.Now().Add(-time.Hour)
+	first := &connhistory.Connection{
+		ID: idgen.New(), CharacterID: charID, SessionID: "sess-1", ClientType: "telnet", ConnectedAt: start,
+	}
+	require.NoError(t, st.Start(ctx, first))
+	end := start.Add(20 * time.Minute)
+	require.NoError(t, st.End(ctx, first.ID, end))
+	// A second End does not move the recorded disconnect.
+	require.NoError(t, st.End(ctx, first.ID, end.Add(time.Minute)))
+
+	second := &connhistory.Connection{
+		ID: idgen.New(), CharacterID: charID, SessionID: "sess-2", ClientType: "terminal", ConnectedAt: start.Add(30 * time.Minute),
+	}
+	require.NoError(t, st.Start(ctx, second))
+
+	latest, err := st.Latest(ctx, charID)
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, latest.ID)
+	assert.True(t, latest.Open())
+
+	conns, err := st.List(ctx, charID, 10)
+	require.NoError(t, err)
+	require.Len(t, conns, 2)
+	assert.Equal(t, second.ID, conns[0].ID)
+	assert.Equal(t, first.ID, conns[1].ID)
+	require.NotNil(t, conns[1].DisconnectedAt)
+	assert.Equal(t, end.UnixNano(), conns[1].DisconnectedAt.UnixNano())
+	assert.Equal(t, "telnet", conns[1].ClientType)
+
+	conns, err = st.List(ctx, charID, 1)
+	require.NoError(t, err)
+	assert.Len(t, conns, 1)
+}
+
+func TestPostgresStoreStartReopensConnection(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := connhistory.NewPostgresStore(pool)
+	charID := createCharacter(t, pool)
+
+	start := time.Now().Add(-time.Hour)
+	conn := &connhistory.Connection{
+		ID: idgen.New(), CharacterID: charID, SessionID: "sess-1", ClientType: "comms_hub", ConnectedAt: start,
+	}
+	require.NoError(t, st.Start(ctx, conn))
+	require.NoError(t, st.End(ctx, conn.ID, time.Now()))
+
+	conn.ConnectedAt = time.Now()
+	require.NoError(t, st.Start(ctx, conn))
+
+	latest, err := st.Latest(ctx, charID)
+	require.NoError(t, err)
+	assert.True(t, latest.Open())
+	assert.Equal(t, start.UnixNano(), latest.ConnectedAt.UnixNano(), "resubscribe keeps the original connect time")
+}
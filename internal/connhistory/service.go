@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package connhistory
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+)
+
+// ActionReadConnections is the ABAC action checked before a character's
+// connection history or last-seen time is returned. The seed policy
+// seed:character-connections-self-or-staff grants it to the character
+// itself and to staff.
+const ActionReadConnections = "read_connections"
+
+// Service records character connections and serves the history back under
+// ABAC checks.
+type Service struct {
+	repo   Repository
+	engine types.AccessPolicyEngine
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewService creates a Service. repo and engine are required; a nil logger
+// uses slog.Default().
+func NewService(repo Repository, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("connection history repository is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, engine: engine, logger: logger, now: time.Now}, nil
+}
+
+// RecordConnect records that characterID's session attached a connection.
+// Best-effort: a store failure is logged and never fails the connection.
+func (s *Service) RecordConnect(ctx context.Context, characterID ulid.ULID, connectionID ulid.ULID, sessionID, clientType string, at time.Time) {
+	conn := &Connection{
+		ID:          connectionID,
+		CharacterID: characterID,
+		SessionID:   sessionID,
+		ClientType:  clientType,
+		ConnectedAt: at,
+	}
+	if err := s.repo.Start(ctx, conn); err != nil {
+		s.logger.WarnContext(ctx, "failed to record character connection",
+			"character_id", characterID.String(),
+			"connection_id", connectionID.String(),
+			"error", err,
+		)
+	}
+}
+
+// RecordDisconnect records that a connection closed now. Best-effort like
+// RecordConnect.
+func (s *Service) RecordDisconnect(ctx context.Context, connectionID ulid.ULID) {
+	if err := s.repo.End(ctx, connectionID, s.now()); err != nil {
+		s.logger.WarnContext(ctx, "failed to record character disconnection",
+			"connection_id", connectionID.String(),
+			"error", err,
+		)
+	}
+}
+
+// GetLastSeen returns when characterID was last seen. subject is the ABAC
+// subject asking (e.g. access.CharacterSubject). Returns
+// CONNHISTORY_ACCESS_DENIED when subject may not read the character's
+// connections and CONNHISTORY_NOT_FOUND (wrapping ErrNotFound) when the
+// character has never connected.
+func (s *Service) GetLastSeen(ctx context.Context, subject string, characterID ulid.ULID) (*LastSeen, error) {
+	if err := s.checkAccess(ctx, subject, characterID); err != nil {
+		return nil, err
+	}
+	conn, err := s.repo.Latest(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return lastSeenFrom(conn), nil
+}
+
+// ListConnections returns up to limit of characterID's connections, newest
+// first, under the same access check as GetLastSeen. A limit <= 0 uses
+// DefaultListLimit; limits above MaxListLimit are capped.
+func (s *Service) ListConnections(ctx context.Context, subject string, characterID ulid.ULID, limit int) ([]*Connection, error) {
+	if err := s.checkAccess(ctx, subject, characterID); err != nil {
+		return nil, err
+	}
+	switch {
+	case limit <= 0:
+		limit = DefaultListLimit
+	case limit > MaxListLimit:
+		limit = MaxListLimit
+	}
+	conns, err := s.repo.List(ctx, characterID, limit)
+	if err != nil {
+		return nil, oops.Code("CONNHISTORY_LIST_FAILED").Wrap(err)
+	}
+	return conns, nil
+}
+
+// checkAccess evaluates read_connections on characterID for subject. It
+// fails closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject string, characterID ulid.ULID) error {
+	resource := access.CharacterResource(characterID.String())
+	req, err := types.NewAccessRequest(subject, ActionReadConnections, resource, nil)
+	if err != nil {
+		return oops.Code("CONNHISTORY_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("CONNHISTORY_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "connection history access denied",
+			"event", "connection_history_access_denied",
+			"subject", subject,
+			"character_id", characterID.String(),
+			"reason", decision.Reason(),
+		)
+		return oops.Code("CONNHISTORY_ACCESS_DENIED").
+			With("character_id", characterID.String()).
+			Errorf("not permitted to view this character's connections")
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package connhistory
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu       sync.Mutex
+	conns    map[ulid.ULID]*Connection
+	startErr error
+	lastList int
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{conns: map[ulid.ULID]*Connection{}}
+}
+
+func (m *memRepository) Start(_ context.Context, conn *Connection) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.startErr != nil {
+		return m.startErr
+	}
+	if existing, ok := m.conns[conn.ID]; ok {
+		existing.DisconnectedAt = nil
+		return nil
+	}
+	stored := *conn
+	m.conns[conn.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) End(_ context.Context, id ulid.ULID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if conn, ok := m.conns[id]; ok && conn.DisconnectedAt == nil {
+		conn.DisconnectedAt = &at
+	}
+	return nil
+}
+
+func (m *memRepository) Latest(ctx context.Context, characterID ulid.ULID) (*Connection, error) {
+	conns, _ := m.List(ctx, characterID, 1)
+	if len(conns) == 0 {
+		return nil, oops.Code("CONNHISTORY_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	return conns[0], nil
+}
+
+func (m *memRepository) List(_ context.Context, characterID ulid.ULID, limit int) ([]*Connection, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastList = limit
+	var out []*Connection
+	for _, conn := range m.conns {
+		if conn.CharacterID == characterID {
+			out = append(out, conn)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ConnectedAt.After(out[j].ConnectedAt) })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func newTestService(t *testing.T) (*Service, *memRepository, *policytest.GrantEngine, *bytes.Buffer) {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	return svc, repo, engine, &logs
+}
+
+func TestNewServiceRequiresRepositoryAndEngine(t *testing.T) {
+	_, err := NewService(nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceRecordsConnectAndDisconnect(t *testing.T) {
+	ctx := context.Background()
+	svc, _, engine, _ := newTestService(t)
+	charID := idgen.New()
+	subject := access.CharacterSubject(charID.String())
+	engine.Grant(subject, ActionReadConnections, access.CharacterResource(charID.String()))
+
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(45 * time.Minute)
+	svc.now = func() time.Time { return end }
+
+	first := idgen.New()
+	svc.RecordConnect(ctx, charID, first, "sess-1", "telnet", start)
+	seen, err := svc.GetLastSeen(ctx, subject, charID)
+	require.NoError(t, err)
+	assert.True(t, seen.Connected)
+	assert.Equal(t, start, seen.At)
+
+	svc.RecordDisconnect(ctx, first)
+	seen, err = svc.GetLastSeen(ctx, subject, charID)
+	require.NoError(t, err)
+	assert.False(t, seen.Connected)
+	assert.Equal(t, end, seen.At)
+	assert.Equal(t, "telnet", seen.ClientType)
+
+	second := idgen.New()
+	svc.RecordConnect(ctx, charID, second, "sess-2", "terminal", end.Add(time.Hour))
+	conns, err := svc.ListConnections(ctx, subject, charID, 0)
+	require.NoError(t, err)
+	require.Len(t, conns, 2)
+	assert.Equal(t, second, conns[0].ID)
+	assert.Equal(t, first, conns[1].ID)
+	assert.Equal(t, 45*time.Minute, conns[1].Duration(end.Add(2*time.Hour)))
+}
+
+func TestServiceGetLastSeenNeverConnected(t *testing.T) {
+	svc, _, engine, _ := newTestService(t)
+	charID := idgen.New()
+	subject := access.CharacterSubject(charID.String())
+	engine.Grant(subject, ActionReadConnections, access.CharacterResource(charID.String()))
+
+	_, err := svc.GetLastSeen(context.Background(), subject, charID)
+	errutil.AssertErrorCode(t, err, "CONNHISTORY_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceDeniesWithoutGrant(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, logs := newTestService(t)
+	charID := idgen.New()
+	other := access.CharacterSubject(idgen.New().String())
+	svc.RecordConnect(ctx, charID, idgen.New(), "sess-1", "telnet", time.Now())
+
+	_, err := svc.GetLastSeen(ctx, other, charID)
+	errutil.AssertErrorCode(t, err, "CONNHISTORY_ACCESS_DENIED")
+	_, err = svc.ListConnections(ctx, other, charID, 10)
+	errutil.AssertErrorCode(t, err, "CONNHISTORY_ACCESS_DENIED")
+	assert.Contains(t, logs.String(), `"event":"connection_history_access_denied"`)
+}
+
+func TestServiceFailsClosedOnEngineError(t *testing.T) {
+	repo := newMemRepository()
+	svc, err := NewService(repo, policytest.NewErrorEngine(errors.New("engine down")), nil)
+	require.NoError(t, err)
+
+	_, err = svc.GetLastSeen(context.Background(), access.CharacterSubject(idgen.New().String()), idgen.New())
+	errutil.AssertErrorCode(t, err, "CONNHISTORY_ACCESS_EVALUATION_FAILED")
+}
+
+func TestServiceListConnectionsClampsLimit(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, engine, _ := newTestService(t)
+	charID := idgen.New()
+	subject := access.CharacterSubject(charID.String())
+	engine.Grant(subject, ActionReadConnections, access.CharacterResource(charID.String()))
+
+	_, err := svc.ListConnections(ctx, subject, charID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultListLimit, repo.lastList)
+
+	_, err = svc.ListConnections(ctx, subject, charID, MaxListLimit+1)
+	require.NoError(t, err)
+	assert.Equal(t, MaxListLimit, repo.lastList)
+}
+
+func TestServiceRecordConnectIsBestEffort(t *testing.T) {
+	svc, repo, _, logs := newTestService(t)
+	repo.startErr = errors.New("db down")
+
+	svc.RecordConnect(context.Background(), idgen.New(), idgen.New(), "sess-1", "telnet", time.Now())
+	assert.Contains(t, logs.String(), "failed to record character connection")
+}
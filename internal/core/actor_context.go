@@ -41,3 +41,22 @@ func OwningPlayerFromContext(ctx context.Context) (string, bool) {
 	playerID, ok := ctx.Value(owningPlayerContextKey{}).(string)
 	return playerID, ok
 }
+
+type impersonatorContextKey struct{}
+
+// WithImpersonator returns a child context carrying the ULID of the staff
+// player acting through an impersonation session (auth.Service.Impersonate).
+// It is stamped where a request is authenticated against such a session, so
+// audit entries and published events written under the request are
+// attributed to the staff member as well as to the impersonated player.
+func WithImpersonator(ctx context.Context, playerID string) context.Context {
+	return context.WithValue(ctx, impersonatorContextKey{}, playerID)
+}
+
+// ImpersonatorFromContext returns the impersonating staff player ULID carried
+// on ctx by WithImpersonator. The boolean is false outside an impersonation
+// session.
+func ImpersonatorFromContext(ctx context.Context) (string, bool) {
+	playerID, ok := ctx.Value(impersonatorContextKey{}).(string)
+	return playerID, ok && playerID != ""
+}
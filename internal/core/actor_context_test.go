@@ -44,3 +44,21 @@ func TestOwningPlayerFromContextReturnsFalseWhenAbsent(t *testing.T) {
 	assert.False(t, ok)
 	assert.Empty(t, got)
 }
+
+func TestWithImpersonatorRoundTripsTheStampedPlayerID(t *testing.T) {
+	t.Parallel()
+	playerID := core.NewULID().String()
+	ctx := core.WithImpersonator(context.Background(), playerID)
+
+	got, ok := core.ImpersonatorFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, playerID, got)
+}
+
+func TestImpersonatorFromContextReturnsFalseWhenAbsentOrEmpty(t *testing.T) {
+	t.Parallel()
+	_, ok := core.ImpersonatorFromContext(context.Background())
+	assert.False(t, ok)
+	_, ok = core.ImpersonatorFromContext(core.WithImpersonator(context.Background(), ""))
+	assert.False(t, ok)
+}
@@ -76,6 +76,95 @@ func registerBuiltinTypes(r *VerbRegistry, hostVersion string) error {
 		// Registered so RenderingPublisher does not block with EMIT_UNKNOWN_VERB.
 		{Type: "session_ended", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
 
+		// Idle activity — emitted by presence.ActivityTracker on the
+		// character's location stream when it crosses the AFK threshold
+		// and again on its next command.
+		{Type: "afk", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+		{Type: "back", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Dice rolls — emitted by game.DiceService on the roller's location
+		// or scene stream with the server-rolled results.
+		{Type: "roll", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Stat checks — emitted by game.CheckService on the location or
+		// scene IC stream, and to the GM's character stream when hidden.
+		{Type: "check", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// World property changes — emitted on the entity's location stream
+		// after a committed world write changes a property, so plugins can
+		// react (a door watching "locked") without polling.
+		{
+			Type: "property_changed", Category: "state", Format: "delta", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_STATE, Source: "builtin",
+			MetadataKeys: []MetadataKey{
+				{Key: "parent_type", ValueType: "string"},
+				{Key: "parent_id", ValueType: "string"},
+				{Key: "key", ValueType: "string"},
+				{Key: "old_hash", ValueType: "string"},
+				{Key: "new_hash", ValueType: "string"},
+			},
+		},
+
+		// Login notice — published by motd.Service on the character's own
+		// stream when it logs in. Telnet shows the payload's ansi rendering;
+		// the web client reads the structured fields.
+		{Type: "motd", Category: "system", Format: "motd", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, Source: "builtin"},
+
+		// Economy transactions — published by economy.Service on the stream
+		// of each character a transfer, mint, or burn moved money for.
+		// Plugins subscribe to build shops; players see the payload's text.
+		{Type: "currency_transfer", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Zone broadcasts — published by world.Service.BroadcastToZone on the
+		// stream of every location in the zone. Players see the payload's
+		// text; plugins and clients can filter on its kind.
+		{Type: "zone_broadcast", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Ambient lines — published by ambient.Service on a location's
+		// stream while players are there. Rendered as narrative; the
+		// payload's low priority lets clients dim or fold them.
+		{Type: "ambient", Category: "system", Format: "narrative", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, Source: "builtin"},
+
+		// Background job outcomes — published by jobs.Queue on the stream of
+		// the character that submitted the job once it succeeds, fails, or is
+		// cancelled. Players see the payload's text.
+		{Type: "job_finished", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Exit traversal phases — published by traversal.Service as a
+		// character walks through an exit: depart, cancel, and a locked
+		// attempt on the stream of the location being left, arrive on the
+		// destination's stream.
+		{Type: "traversal_depart", Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+		{Type: "traversal_cancel", Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+		{Type: "traversal_arrive", Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+		{Type: "traversal_locked", Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Paging — published by paging.Service: the page on the recipient's
+		// stream and its delivery receipt on the sender's. Both are sensitive
+		// and encrypted at rest; players see the payload's text.
+		{Type: "page", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+		{Type: "page_receipt", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Player reports — published by report.Service on the reporter's
+		// stream when staff claim or resolve their report. Sensitive, since
+		// a harassment report's resolution is private; players see the
+		// payload's text.
+		{Type: "report_status", Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Client preferences — published by preferences.Service on the
+		// player's character stream at login and after each change. State
+		// only: transports apply the payload rather than showing it.
+		{Type: "preferences_changed", Category: "state", Format: "snapshot", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_STATE, Source: "builtin"},
+
+		// NPC presence — published by npc.Service on a location's stream
+		// when an NPC is spawned or despawned there, or wanders out of or
+		// into it. Players see the payload's text.
+		{Type: "npc", Category: "movement", Format: "narrative", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
+		// Daylight — published by gameclock.Announcer on the stream of every
+		// outdoor-tagged location at each IC dawn and dusk. Players see the
+		// payload's text; clients can read its kind, date, and clock.
+		{Type: "daylight", Category: "system", Format: "narrative", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, Source: "builtin"},
+
 		// Crypto audit (host-emit, persistence-only). DisplayTarget=AUDIT_ONLY
 		// so the gRPC Subscribe handler drops these before send; the audit
 		// projection persists them like any other event. Restores INV-CRYPTO-81
@@ -61,6 +61,13 @@ var ActorSystemID = SystemActorULID.String()
 // value so a plugin `wall` and a host announcement land on the same subject.
 const SystemBroadcastSubject = "system"
 
+// ModerationSubject is the reserved stream for moderation events staff review
+// — today, gateway input flood escalations reported through
+// CoreServer.ReportInputFlood and plugin dead-letter alerts from
+// internal/plugin/deadletter. Like SystemBroadcastSubject it is qualified to
+// events.<game_id>.moderation by internal/sysbroadcast.Broadcaster.
+const ModerationSubject = "moderation"
+
 // IsSentinelULID returns true iff id is a system actor sentinel ULID:
 // first 15 bytes zero, last byte in [0x01, 0xFF]. Used by IdentityRegistry
 // bootstrap (sentinel-collision detection on plugin row load) and by
@@ -52,6 +52,27 @@ func TestHostEventTypesMatchPluginSDKReExports(t *testing.T) {
 		{"host and sdk agree on move event type string", eventvocab.EventTypeMove, pluginsdk.HostEventTypeMove},
 		{"host and sdk agree on location_state event type string", eventvocab.EventTypeLocationState, pluginsdk.HostEventTypeLocationState},
 		{"host and sdk agree on exit_update event type string", eventvocab.EventTypeExitUpdate, pluginsdk.HostEventTypeExitUpdate},
+		{"host and sdk agree on afk event type string", eventvocab.EventTypeAFK, pluginsdk.HostEventTypeAFK},
+		{"host and sdk agree on back event type string", eventvocab.EventTypeBack, pluginsdk.HostEventTypeBack},
+		{"host and sdk agree on roll event type string", eventvocab.EventTypeRoll, pluginsdk.HostEventTypeRoll},
+		{"host and sdk agree on check event type string", eventvocab.EventTypeCheck, pluginsdk.HostEventTypeCheck},
+		{"host and sdk agree on scheduled event type string", eventvocab.EventTypeScheduled, pluginsdk.HostEventTypeScheduled},
+		{"host and sdk agree on property_changed event type string", eventvocab.EventTypePropertyChanged, pluginsdk.HostEventTypePropertyChanged},
+		{"host and sdk agree on motd event type string", eventvocab.EventTypeMOTD, pluginsdk.HostEventTypeMOTD},
+		{"host and sdk agree on currency_transfer event type string", eventvocab.EventTypeCurrencyTransfer, pluginsdk.HostEventTypeCurrencyTransfer},
+		{"host and sdk agree on zone_broadcast event type string", eventvocab.EventTypeZoneBroadcast, pluginsdk.HostEventTypeZoneBroadcast},
+		{"host and sdk agree on ambient event type string", eventvocab.EventTypeAmbient, pluginsdk.HostEventTypeAmbient},
+		{"host and sdk agree on job_finished event type string", eventvocab.EventTypeJobFinished, pluginsdk.HostEventTypeJobFinished},
+		{"host and sdk agree on traversal_depart event type string", eventvocab.EventTypeTraversalDepart, pluginsdk.HostEventTypeTraversalDepart},
+		{"host and sdk agree on traversal_cancel event type string", eventvocab.EventTypeTraversalCancel, pluginsdk.HostEventTypeTraversalCancel},
+		{"host and sdk agree on traversal_arrive event type string", eventvocab.EventTypeTraversalArrive, pluginsdk.HostEventTypeTraversalArrive},
+		{"host and sdk agree on traversal_locked event type string", eventvocab.EventTypeTraversalLocked, pluginsdk.HostEventTypeTraversalLocked},
+		{"host and sdk agree on page event type string", eventvocab.EventTypePage, pluginsdk.HostEventTypePage},
+		{"host and sdk agree on page_receipt event type string", eventvocab.EventTypePageReceipt, pluginsdk.HostEventTypePageReceipt},
+		{"host and sdk agree on report_status event type string", eventvocab.EventTypeReportStatus, pluginsdk.HostEventTypeReportStatus},
+		{"host and sdk agree on preferences_changed event type string", eventvocab.EventTypePreferencesChanged, pluginsdk.HostEventTypePreferencesChanged},
+		{"host and sdk agree on npc event type string", eventvocab.EventTypeNPC, pluginsdk.HostEventTypeNPC},
+		{"host and sdk agree on daylight event type string", eventvocab.EventTypeDaylight, pluginsdk.HostEventTypeDaylight},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package economy keeps per-character balances in one or more currencies.
+// Every change is a Transaction recorded as balanced double-entry ledger
+// rows: a transfer debits one character and credits another, and staff
+// mint and burn move money between a character and the treasury, the one
+// account allowed to go negative. Balances are updated in the same
+// database transaction as the ledger, so a balance is never out of step
+// with its history and concurrent spends cannot overdraw an account.
+//
+// Each committed transaction is announced to the characters involved as a
+// currency_transfer event, which plugins can subscribe to when building
+// shops and other trade on top of the ledger.
+package economy
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// Limits and defaults.
+const (
+	// DefaultCurrency is the only currency when the game configures none.
+	DefaultCurrency = "credits"
+	// MaxCurrencyLength bounds currency codes in bytes.
+	MaxCurrencyLength = 32
+	// MaxMemoLength bounds transaction memos in bytes.
+	MaxMemoLength = 200
+	// DefaultHistoryLimit is how many transactions History returns when no
+	// limit is given.
+	DefaultHistoryLimit = 20
+	// MaxHistoryLimit bounds one History call.
+	MaxHistoryLimit = 200
+)
+
+// Sentinel errors.
+var (
+	// ErrNotFound is returned when a character does not exist.
+	ErrNotFound = errors.New("economy character not found")
+	// ErrInsufficientFunds is returned when a debit would take a character's
+	// balance below zero.
+	ErrInsufficientFunds = errors.New("insufficient funds")
+)
+
+var currencyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NormalizeCurrency returns code trimmed and lower-cased.
+func NormalizeCurrency(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// ValidateCurrency reports whether code is a well-formed, normalized
+// currency code: a lowercase letter followed by letters, digits, or
+// underscores. Returns ECONOMY_INVALID_CURRENCY otherwise.
+func ValidateCurrency(code string) error {
+	if len(code) > MaxCurrencyLength || !currencyPattern.MatchString(code) {
+		return oops.Code("ECONOMY_INVALID_CURRENCY").
+			With("currency", code).
+			Errorf("currency %q must be a lowercase letter followed by letters, digits, or underscores (at most %d)",
+				code, MaxCurrencyLength)
+	}
+	return nil
+}
+
+// Kind classifies a transaction.
+type Kind string
+
+// Transaction kinds.
+const (
+	// KindTransfer moves money from one character to another.
+	KindTransfer Kind = "transfer"
+	// KindMint creates money: the treasury pays a character.
+	KindMint Kind = "mint"
+	// KindBurn destroys money: a character pays the treasury.
+	KindBurn Kind = "burn"
+)
+
+// Account names one side of a ledger entry: a character or the treasury.
+type Account string
+
+// Treasury is the account money is minted from and burned into. It has no
+// balance row and may go negative; its ledger entries are the running
+// record of money in circulation.
+const Treasury Account = "treasury"
+
+// characterAccountPrefix names character accounts by their ABAC subject,
+// e.g. "character:01ABC".
+const characterAccountPrefix = access.SubjectCharacter
+
+// CharacterAccount returns the account of the character with id.
+func CharacterAccount(id ulid.ULID) Account {
+	return Account(characterAccountPrefix + id.String())
+}
+
+// CharacterID returns the character an account belongs to, and false for
+// the treasury.
+func (a Account) CharacterID() (ulid.ULID, bool) {
+	raw, ok := strings.CutPrefix(string(a), characterAccountPrefix)
+	if !ok {
+		return ulid.ULID{}, false
+	}
+	id, err := ulid.Parse(raw)
+	if err != nil {
+		return ulid.ULID{}, false
+	}
+	return id, true
+}
+
+// Balance is how much of one currency a character holds.
+type Balance struct {
+	Currency string
+	Amount   int64
+}
+
+// Entry is one side of a transaction. Delta is negative for the account
+// paying and positive for the account paid. BalanceAfter is the character's
+// balance once the transaction applied; it is zero for the treasury.
+type Entry struct {
+	Account      Account
+	Delta        int64
+	BalanceAfter int64
+	// Name is the character's name when read back from history; it is
+	// empty for the treasury and for characters since deleted.
+	Name string
+}
+
+// Transaction is one atomic movement of money. Its entries always sum to
+// zero.
+type Transaction struct {
+	ID       ulid.ULID
+	Kind     Kind
+	Currency string
+	Amount   int64
+	Memo     string
+	// InitiatedBy is the access subject that asked for the transaction
+	// (e.g. "character:01ABC").
+	InitiatedBy string
+	CreatedAt   time.Time
+	// Entries holds the debit first and the credit second.
+	Entries []Entry
+}
+
+// NewTransaction builds a transaction moving amount of currency from one
+// account to another. Returns ECONOMY_INVALID_AMOUNT for a non-positive
+// amount, ECONOMY_INVALID_TRANSFER when from and to are the same account,
+// and ECONOMY_INVALID_MEMO for a memo that is too long or not UTF-8.
+func NewTransaction(id ulid.ULID, kind Kind, from, to Account, currency string, amount int64, memo, initiatedBy string, at time.Time) (*Transaction, error) {
+	if err := ValidateCurrency(currency); err != nil {
+		return nil, err
+	}
+	if amount <= 0 {
+		return nil, oops.Code("ECONOMY_INVALID_AMOUNT").
+			With("amount", amount).
+			Errorf("amount must be a positive whole number")
+	}
+	if from == to {
+		return nil, oops.Code("ECONOMY_INVALID_TRANSFER").
+			With("account", string(from)).
+			Errorf("cannot transfer money to the same account")
+	}
+	memo = strings.TrimSpace(memo)
+	if len(memo) > MaxMemoLength || !utf8.ValidString(memo) {
+		return nil, oops.Code("ECONOMY_INVALID_MEMO").
+			Errorf("memo must be valid text of at most %d bytes", MaxMemoLength)
+	}
+	return &Transaction{
+		ID:          id,
+		Kind:        kind,
+		Currency:    currency,
+		Amount:      amount,
+		Memo:        memo,
+		InitiatedBy: initiatedBy,
+		CreatedAt:   at.UTC(),
+		Entries: []Entry{
+			{Account: from, Delta: -amount},
+			{Account: to, Delta: amount},
+		},
+	}, nil
+}
+
+// From returns the account paying.
+func (t *Transaction) From() Account { return t.Entries[0].Account }
+
+// To returns the account paid.
+func (t *Transaction) To() Account { return t.Entries[1].Account }
+
+// Entry returns the entry for account, and false when the account is not
+// part of the transaction.
+func (t *Transaction) Entry(account Account) (Entry, bool) {
+	for _, e := range t.Entries {
+		if e.Account == account {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// CharacterRef identifies a character by ID and display name.
+type CharacterRef struct {
+	ID   ulid.ULID
+	Name string
+}
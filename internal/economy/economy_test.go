@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package economy
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestValidateCurrency(t *testing.T) {
+	for _, code := range []string{"credits", "gold", "guild_marks", "c2"} {
+		assert.NoError(t, ValidateCurrency(code), code)
+	}
+	for _, code := range []string{"", "Credits", "2gold", "gold coins", "gold-coins", strings.Repeat("a", MaxCurrencyLength+1)} {
+		errutil.AssertErrorCode(t, ValidateCurrency(code), "ECONOMY_INVALID_CURRENCY")
+	}
+}
+
+func TestNormalizeCurrency(t *testing.T) {
+	assert.Equal(t, "gold", NormalizeCurrency("  Gold "))
+}
+
+func TestAccountCharacterID(t *testing.T) {
+	id := idgen.New()
+	got, ok := CharacterAccount(id).CharacterID()
+	require.True(t, ok)
+	assert.Equal(t, id, got)
+
+	_, ok = Treasury.CharacterID()
+	assert.False(t, ok)
+	_, ok = Account("character:not-a-ulid").CharacterID()
+	assert.False(t, ok)
+}
+
+func TestNewTransactionBuildsBalancedEntries(t *testing.T) {
+	from, to := CharacterAccount(idgen.New()), CharacterAccount(idgen.New())
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tx, err := NewTransaction(idgen.New(), KindTransfer, from, to, "credits", 25, "  rent ", "character:x", at)
+	require.NoError(t, err)
+	assert.Equal(t, "rent", tx.Memo)
+	assert.Equal(t, from, tx.From())
+	assert.Equal(t, to, tx.To())
+	var sum int64
+	for _, e := range tx.Entries {
+		sum += e.Delta
+	}
+	assert.Zero(t, sum)
+
+	e, ok := tx.Entry(from)
+	require.True(t, ok)
+	assert.Equal(t, int64(-25), e.Delta)
+	_, ok = tx.Entry(Treasury)
+	assert.False(t, ok)
+}
+
+func TestNewTransactionRejectsBadInput(t *testing.T) {
+	a, b := CharacterAccount(idgen.New()), CharacterAccount(idgen.New())
+	now := time.Now()
+	tests := []struct {
+		name     string
+		from, to Account
+		currency string
+		amount   int64
+		memo     string
+		code     string
+	}{
+		{"zero amount", a, b, "credits", 0, "", "ECONOMY_INVALID_AMOUNT"},
+		{"negative amount", a, b, "credits", -5, "", "ECONOMY_INVALID_AMOUNT"},
+		{"same account", a, a, "credits", 5, "", "ECONOMY_INVALID_TRANSFER"},
+		{"bad currency", a, b, "Gold Coins", 5, "", "ECONOMY_INVALID_CURRENCY"},
+		{"long memo", a, b, "credits", 5, strings.Repeat("x", MaxMemoLength+1), "ECONOMY_INVALID_MEMO"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTransaction(idgen.New(), KindTransfer, tt.from, tt.to, tt.currency, tt.amount, tt.memo, "character:x", now)
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package economy
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// pgForeignKeyViolation is the SQLSTATE raised when a balance row names a
+// character that does not exist.
+const pgForeignKeyViolation = "23503"
+
+// PostgresStore implements Repository against the economy_balances,
+// economy_transactions, and economy_ledger_entries tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Apply records tx and updates balances in one database transaction.
+// Balance rows are locked in account order, so concurrent transactions
+// touching the same characters cannot deadlock.
+func (s *PostgresStore) Apply(ctx context.Context, tx *Transaction) error {
+	dbtx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return oops.Code("ECONOMY_STORE_FAILED").With("operation", "begin").Wrap(err)
+	}
+	defer dbtx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	order := make([]int, len(tx.Entries))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return tx.Entries[order[a]].Account < tx.Entries[order[b]].Account
+	})
+	for _, i := range order {
+		if err := applyEntry(ctx, dbtx, tx, &tx.Entries[i]); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dbtx.Exec(ctx, `
+		INSERT INTO economy_transactions (id, kind, currency, amount, memo, initiated_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, tx.ID.String(), string(tx.Kind), tx.Currency, tx.Amount, tx.Memo, tx.InitiatedBy,
+		pgnanos.From(tx.CreatedAt)); err != nil {
+		return oops.Code("ECONOMY_STORE_FAILED").
+			With("operation", "insert_transaction").
+			With("transaction_id", tx.ID.String()).
+			Wrap(err)
+	}
+	for i, e := range tx.Entries {
+		var balanceAfter *int64
+		if _, ok := e.Account.CharacterID(); ok {
+			balanceAfter = &tx.Entries[i].BalanceAfter
+		}
+		if _, err := dbtx.Exec(ctx, `
+			INSERT INTO economy_ledger_entries (transaction_id, account, currency, delta, balance_after)
+			VALUES ($1, $2, $3, $4, $5)
+		`, tx.ID.String(), string(e.Account), tx.Currency, e.Delta, balanceAfter); err != nil {
+			return oops.Code("ECONOMY_STORE_FAILED").
+				With("operation", "insert_entry").
+				With("transaction_id", tx.ID.String()).
+				Wrap(err)
+		}
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.Code("ECONOMY_STORE_FAILED").With("operation", "commit").Wrap(err)
+	}
+	return nil
+}
+
+// applyEntry moves a character's balance by e.Delta and stores the result
+// in e.BalanceAfter. Treasury entries touch no balance.
+func applyEntry(ctx context.Context, dbtx pgx.Tx, tx *Transaction, e *Entry) error {
+	charID, ok := e.Account.CharacterID()
+	if !ok {
+		return nil
+	}
+	if e.Delta < 0 {
+		// The guard in WHERE makes the check and the debit one statement, so
+		// two concurrent spends cannot both pass it.
+		err := dbtx.QueryRow(ctx, `
+			UPDATE economy_balances
+			   SET amount = amount + $3, updated_at = $4
+			 WHERE character_id = $1 AND currency = $2 AND amount + $3 >= 0
+			RETURNING amount
+		`, charID.String(), tx.Currency, e.Delta, pgnanos.From(tx.CreatedAt)).Scan(&e.BalanceAfter)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.Code("ECONOMY_INSUFFICIENT_FUNDS").
+				With("character_id", charID.String()).
+				With("currency", tx.Currency).
+				Wrap(ErrInsufficientFunds)
+		}
+		if err != nil {
+			return oops.Code("ECONOMY_STORE_FAILED").
+				With("operation", "debit").
+				With("character_id", charID.String()).
+				Wrap(err)
+		}
+		return nil
+	}
+	err := dbtx.QueryRow(ctx, `
+		INSERT INTO economy_balances (character_id, currency, amount, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (character_id, currency)
+		DO UPDATE SET amount = economy_balances.amount + EXCLUDED.amount, updated_at = EXCLUDED.updated_at
+		RETURNING amount
+	`, charID.String(), tx.Currency, e.Delta, pgnanos.From(tx.CreatedAt)).Scan(&e.BalanceAfter)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return oops.Code("ECONOMY_NOT_FOUND").
+				With("character_id", charID.String()).
+				Wrap(ErrNotFound)
+		}
+		return oops.Code("ECONOMY_STORE_FAILED").
+			With("operation", "credit").
+			With("character_id", charID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Balances returns the character's balances ordered by currency.
+func (s *PostgresStore) Balances(ctx context.Context, characterID ulid.ULID) ([]Balance, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT currency, amount
+		  FROM economy_balances
+		 WHERE character_id = $1
+		 ORDER BY currency
+	`, characterID.String())
+	if err != nil {
+		return nil, oops.Code("ECONOMY_STORE_FAILED").
+			With("operation", "balances").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+	var out []Balance
+	for rows.Next() {
+		var b Balance
+		if err := rows.Scan(&b.Currency, &b.Amount); err != nil {
+			return nil, oops.Code("ECONOMY_STORE_FAILED").With("operation", "balances").Wrap(err)
+		}
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("ECONOMY_STORE_FAILED").With("operation", "balances").Wrap(err)
+	}
+	return out, nil
+}
+
+// History returns up to limit of the character's transactions, newest
+// first, each with both of its entries.
+func (s *PostgresStore) History(ctx context.Context, characterID ulid.ULID, limit int) ([]*Transaction, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT t.id, t.kind, t.currency, t.amount, t.memo, t.initiated_by, t.created_at
+		  FROM economy_transactions t
+		  JOIN economy_ledger_entries e ON e.transaction_id = t.id
+		 WHERE e.account = $1
+		 ORDER BY t.created_at DESC, t.id DESC
+		 LIMIT $2
+	`, string(CharacterAccount(characterID)), limit)
+	if err != nil {
+		return nil, oops.Code("ECONOMY_STORE_FAILED").
+			With("operation", "history").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	txs, err := collectTransactions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+	if err := s.loadEntries(ctx, txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+func collectTransactions(rows pgx.Rows) ([]*Transaction, error) {
+	defer rows.Close()
+	var out []*Transaction
+	for rows.Next() {
+		var (
+			tx        Transaction
+			id        string
+			kind      string
+			createdAt pgnanos.Time
+		)
+		if err := rows.Scan(&id, &kind, &tx.Currency, &tx.Amount, &tx.Memo, &tx.InitiatedBy, &createdAt); err != nil {
+			return nil, oops.Code("ECONOMY_STORE_FAILED").With("operation", "history").Wrap(err)
+		}
+		parsed, err := ulid.Parse(id)
+		if err != nil {
+			return nil, oops.Code("ECONOMY_STORE_FAILED").With("transaction_id", id).Wrap(err)
+		}
+		tx.ID = parsed
+		tx.Kind = Kind(kind)
+		tx.CreatedAt = createdAt.Time()
+		out = append(out, &tx)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("ECONOMY_STORE_FAILED").With("operation", "history").Wrap(err)
+	}
+	return out, nil
+}
+
+// loadEntries fills in the entries of txs, debit first, with the names of
+// the characters involved.
+func (s *PostgresStore) loadEntries(ctx context.Context, txs []*Transaction) error {
+	ids := make([]string, len(txs))
+	byID := make(map[string]*Transaction, len(txs))
+	for i, tx := range txs {
+		ids[i] = tx.ID.String()
+		byID[ids[i]] = tx
+	}
+	rows, err := s.pool.Query(ctx, `
+		SELECT e.transaction_id, e.account, e.delta, COALESCE(e.balance_after, 0), COALESCE(c.name, '')
+		  FROM economy_ledger_entries e
+		  LEFT JOIN characters c ON e.account = 'character:' || c.id
+		 WHERE e.transaction_id = ANY($1)
+		 ORDER BY e.transaction_id, e.delta
+	`, ids)
+	if err != nil {
+		return oops.Code("ECONOMY_STORE_FAILED").With("operation", "history_entries").Wrap(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			txID    string
+			account string
+			e       Entry
+		)
+		if err := rows.Scan(&txID, &account, &e.Delta, &e.BalanceAfter, &e.Name); err != nil {
+			return oops.Code("ECONOMY_STORE_FAILED").With("operation", "history_entries").Wrap(err)
+		}
+		e.Account = Account(account)
+		if tx, ok := byID[txID]; ok {
+			tx.Entries = append(tx.Entries, e)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return oops.Code("ECONOMY_STORE_FAILED").With("operation", "history_entries").Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package economy_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/economy"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createCharacter(t *testing.T, pool *pgxpool.Pool, name string) economy.CharacterRef {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO characters (id, name) VALUES ($1, $2)`, id.String(), name)
+	require.NoError(t, err)
+	return economy.CharacterRef{ID: id, Name: name}
+}
+
+func newTx(t *testing.T, kind economy.Kind, from, to economy.Account, amount int64) *economy.Transaction {
+	t.Helper()
+	tx, err := economy.NewTransaction(idgen.New(), kind, from, to, "credits", amount, "", "character:test", time.Now())
+	require.NoError(t, err)
+	return tx
+}
+
+func TestPostgresStoreApplyAndHistory(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := economy.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	bob := createCharacter(t, pool, "Bob-"+idgen.New().String())
+	aliceAcct, bobAcct := economy.CharacterAccount(alice.ID), economy.CharacterAccount(bob.ID)
+
+	mint := newTx(t, economy.KindMint, economy.Treasury, aliceAcct, 100)
+	require.NoError(t, st.Apply(ctx, mint))
+	e, _ := mint.Entry(aliceAcct)
+	assert.Equal(t, int64(100), e.BalanceAfter)
+
+	pay := newTx(t, economy.KindTransfer, aliceAcct, bobAcct, 40)
+	require.NoError(t, st.Apply(ctx, pay))
+	e, _ = pay.Entry(aliceAcct)
+	assert.Equal(t, int64(60), e.BalanceAfter)
+	e, _ = pay.Entry(bobAcct)
+	assert.Equal(t, int64(40), e.BalanceAfter)
+
+	overdraft := newTx(t, economy.KindTransfer, bobAcct, aliceAcct, 41)
+	err := st.Apply(ctx, overdraft)
+	errutil.AssertErrorCode(t, err, "ECONOMY_INSUFFICIENT_FUNDS")
+	assert.ErrorIs(t, err, economy.ErrInsufficientFunds)
+
+	balances, err := st.Balances(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []economy.Balance{{Currency: "credits", Amount: 40}}, balances)
+
+	history, err := st.History(ctx, alice.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, pay.ID, history[0].ID)
+	assert.Equal(t, mint.ID, history[1].ID)
+	require.Len(t, history[0].Entries, 2)
+	assert.Equal(t, aliceAcct, history[0].From())
+	assert.Equal(t, bobAcct, history[0].To())
+	assert.Equal(t, economy.Treasury, history[1].From())
+	e, _ = history[0].Entry(bobAcct)
+	assert.Equal(t, bob.Name, e.Name)
+
+	history, err = st.History(ctx, bob.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1, "the failed overdraft leaves no ledger rows")
+}
+
+func TestPostgresStoreApplyRejectsUnknownCharacter(t *testing.T) {
+	pool := newTestPool(t)
+	st := economy.NewPostgresStore(pool)
+	tx := newTx(t, economy.KindMint, economy.Treasury, economy.CharacterAccount(idgen.New()), 5)
+
+	err := st.Apply(context.Background(), tx)
+	errutil.AssertErrorCode(t, err, "ECONOMY_NOT_FOUND")
+	assert.ErrorIs(t, err, economy.ErrNotFound)
+}
+
+func TestPostgresStoreConcurrentSpendsCannotOverdraw(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := economy.NewPostgresStore(pool)
+	payer := createCharacter(t, pool, "Payer-"+idgen.New().String())
+	payee := createCharacter(t, pool, "Payee-"+idgen.New().String())
+	payerAcct := economy.CharacterAccount(payer.ID)
+	require.NoError(t, st.Apply(ctx, newTx(t, economy.KindMint, economy.Treasury, payerAcct, 10)))
+
+	txs := make([]*economy.Transaction, 5)
+	for i := range txs {
+		txs[i] = newTx(t, economy.KindTransfer, payerAcct, economy.CharacterAccount(payee.ID), 4)
+	}
+	var wg sync.WaitGroup
+	results := make([]error, len(txs))
+	for i, tx := range txs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = st.Apply(ctx, tx)
+		}()
+	}
+	wg.Wait()
+
+	var ok int
+	for _, err := range results {
+		if err == nil {
+			ok++
+		} else {
+			assert.ErrorIs(t, err, economy.ErrInsufficientFunds)
+		}
+	}
+	assert.Equal(t, 2, ok)
+	balances, err := st.Balances(ctx, payer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []economy.Balance{{Currency: "credits", Amount: 2}}, balances)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package economy
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists balances and the ledger.
+type Repository interface {
+	// Apply records tx and updates the balances of the characters in it,
+	// atomically. It fills in each character entry's BalanceAfter. When a
+	// debit would take a character below zero nothing is written and the
+	// error wraps ErrInsufficientFunds.
+	Apply(ctx context.Context, tx *Transaction) error
+	// Balances returns the character's balances ordered by currency.
+	// Currencies the character has never held are absent.
+	Balances(ctx context.Context, characterID ulid.ULID) ([]Balance, error)
+	// History returns up to limit transactions the character took part in,
+	// newest first, with entry names filled in.
+	History(ctx context.Context, characterID ulid.ULID, limit int) ([]*Transaction, error)
+}
@@ -0,0 +1,436 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package economy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// ABAC actions checked on currency:<code> before money is created or
+// destroyed. The seed policy seed:staff-currency-issue grants both to staff.
+const (
+	ActionMint = "mint"
+	ActionBurn = "burn"
+)
+
+// Characters resolves character names the way the acting character sees
+// the world, so money never reaches, or reveals, a character hidden from
+// them. *world.Service satisfies it.
+type Characters interface {
+	FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*world.Character, error)
+}
+
+// TransferRequest asks for amount of Currency to move from one character
+// to another. An empty Currency means the default currency.
+type TransferRequest struct {
+	From     CharacterRef
+	To       CharacterRef
+	Currency string
+	Amount   int64
+	Memo     string
+}
+
+// IssueRequest asks for amount of Currency to be minted to or burned from
+// a character. An empty Currency means the default currency.
+type IssueRequest struct {
+	Character CharacterRef
+	Currency  string
+	Amount    int64
+	Memo      string
+}
+
+// Service moves money between characters. Balances and history are not
+// access-checked here: callers decide whose they show. A transfer may only
+// spend the subject's own money; minting and burning are checked against
+// currency:<code>. Every transaction is written to the structured log as
+// an audit record.
+//
+// Transfer events need an event publisher, which is bound after
+// construction with SetPublisher once the event bus is up. Until then
+// transactions commit without announcing themselves.
+type Service struct {
+	repo       Repository
+	characters Characters
+	engine     types.AccessPolicyEngine
+	currencies []string
+	logger     *slog.Logger
+	now        func() time.Time
+
+	mu     sync.RWMutex
+	pub    eventbus.Publisher
+	gameID func() string
+}
+
+// NewService creates a Service. repo, characters, and engine are required;
+// a nil logger uses slog.Default(). currencies lists the currencies the game uses, the
+// first being the default; none means DefaultCurrency alone. Returns
+// ECONOMY_INVALID_CURRENCY for a malformed or repeated code.
+func NewService(repo Repository, characters Characters, engine types.AccessPolicyEngine, currencies []string, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("economy repository is required")
+	}
+	if characters == nil {
+		return nil, oops.Errorf("character lookup is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	codes := make([]string, 0, len(currencies))
+	seen := make(map[string]bool, len(currencies))
+	for _, c := range currencies {
+		code := NormalizeCurrency(c)
+		if err := ValidateCurrency(code); err != nil {
+			return nil, err
+		}
+		if seen[code] {
+			return nil, oops.Code("ECONOMY_INVALID_CURRENCY").
+				With("currency", code).
+				Errorf("currency %q is listed twice", code)
+		}
+		seen[code] = true
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		codes = []string{DefaultCurrency}
+	}
+	return &Service{repo: repo, characters: characters, engine: engine, currencies: codes, logger: logger, now: time.Now}, nil
+}
+
+// SetPublisher binds the publisher used for transfer events. gameID
+// supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// Currencies returns the game's currencies, the default first.
+func (s *Service) Currencies() []string {
+	return append([]string(nil), s.currencies...)
+}
+
+// DefaultCurrency returns the currency used when none is named.
+func (s *Service) DefaultCurrency() string {
+	return s.currencies[0]
+}
+
+// Currency resolves a currency code given by a player: empty means the
+// default, and anything else must be one of the game's currencies. Returns
+// ECONOMY_UNKNOWN_CURRENCY otherwise.
+func (s *Service) Currency(code string) (string, error) {
+	code = NormalizeCurrency(code)
+	if code == "" {
+		return s.DefaultCurrency(), nil
+	}
+	for _, c := range s.currencies {
+		if c == code {
+			return code, nil
+		}
+	}
+	return "", oops.Code("ECONOMY_UNKNOWN_CURRENCY").
+		With("currency", code).
+		Errorf("there is no currency called %q", code)
+}
+
+// Balances returns every currency's balance for the character, in the
+// game's currency order, with zero for currencies it has never held.
+// Balances in currencies the game no longer lists follow, by code.
+func (s *Service) Balances(ctx context.Context, characterID ulid.ULID) ([]Balance, error) {
+	held, err := s.repo.Balances(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+	amounts := make(map[string]int64, len(held))
+	for _, b := range held {
+		amounts[b.Currency] = b.Amount
+	}
+	out := make([]Balance, 0, len(s.currencies)+len(held))
+	for _, c := range s.currencies {
+		out = append(out, Balance{Currency: c, Amount: amounts[c]})
+		delete(amounts, c)
+	}
+	for _, b := range held {
+		if _, retired := amounts[b.Currency]; retired {
+			out = append(out, b)
+		}
+	}
+	return out, nil
+}
+
+// History returns up to limit of the character's transactions, newest
+// first. A limit outside 1..MaxHistoryLimit uses DefaultHistoryLimit.
+func (s *Service) History(ctx context.Context, characterID ulid.ULID, limit int) ([]*Transaction, error) {
+	if limit <= 0 || limit > MaxHistoryLimit {
+		limit = DefaultHistoryLimit
+	}
+	return s.repo.History(ctx, characterID, limit)
+}
+
+// FindCharacter looks up the character named name, ignoring case, as
+// observerID sees it. Returns ECONOMY_NOT_FOUND (wrapping ErrNotFound) when
+// there is none or observerID cannot see it.
+func (s *Service) FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (CharacterRef, error) {
+	char, err := s.characters.FindCharacterByName(ctx, observerID, name)
+	if errors.Is(err, world.ErrNotFound) {
+		return CharacterRef{}, oops.Code("ECONOMY_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return CharacterRef{}, oops.Code("ECONOMY_STORE_FAILED").
+			With("operation", "find_character").
+			With("name", name).
+			Wrap(err)
+	}
+	return CharacterRef{ID: char.ID, Name: char.Name}, nil
+}
+
+// Transfer moves money from req.From to req.To on behalf of subject, who
+// must be req.From: a character may only spend its own money. Returns
+// ECONOMY_ACCESS_DENIED otherwise and ECONOMY_INSUFFICIENT_FUNDS (wrapping
+// ErrInsufficientFunds) when req.From cannot cover the amount.
+func (s *Service) Transfer(ctx context.Context, subject string, req TransferRequest) (*Transaction, error) {
+	currency, err := s.Currency(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if subject != access.CharacterSubject(req.From.ID.String()) {
+		s.logger.WarnContext(ctx, "economy transfer denied",
+			"event", "economy_transfer_denied",
+			"subject", subject,
+			"from_character_id", req.From.ID.String(),
+		)
+		return nil, oops.Code("ECONOMY_ACCESS_DENIED").
+			With("subject", subject).
+			Errorf("not permitted to spend another character's money")
+	}
+	tx, err := NewTransaction(idgen.New(), KindTransfer, CharacterAccount(req.From.ID), CharacterAccount(req.To.ID),
+		currency, req.Amount, req.Memo, subject, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, tx); err != nil {
+		return nil, err
+	}
+	s.announce(ctx, tx, req.From, req.To)
+	return tx, nil
+}
+
+// Mint creates money and pays it to req.Character on behalf of subject.
+// Returns ECONOMY_ACCESS_DENIED when subject may not mint the currency.
+func (s *Service) Mint(ctx context.Context, subject string, req IssueRequest) (*Transaction, error) {
+	return s.issue(ctx, subject, ActionMint, req)
+}
+
+// Burn takes money from req.Character and destroys it on behalf of
+// subject. Returns ECONOMY_ACCESS_DENIED when subject may not burn the
+// currency and ECONOMY_INSUFFICIENT_FUNDS (wrapping ErrInsufficientFunds)
+// when the character holds less than the amount.
+func (s *Service) Burn(ctx context.Context, subject string, req IssueRequest) (*Transaction, error) {
+	return s.issue(ctx, subject, ActionBurn, req)
+}
+
+func (s *Service) issue(ctx context.Context, subject, action string, req IssueRequest) (*Transaction, error) {
+	currency, err := s.Currency(req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	kind, from, to := KindMint, Treasury, CharacterAccount(req.Character.ID)
+	if action == ActionBurn {
+		kind, from, to = KindBurn, to, from
+	}
+	tx, err := NewTransaction(idgen.New(), kind, from, to, currency, req.Amount, req.Memo, subject, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, action, currency); err != nil {
+		return nil, err
+	}
+	if err := s.apply(ctx, tx); err != nil {
+		return nil, err
+	}
+	if kind == KindMint {
+		s.announce(ctx, tx, CharacterRef{}, req.Character)
+	} else {
+		s.announce(ctx, tx, req.Character, CharacterRef{})
+	}
+	return tx, nil
+}
+
+func (s *Service) apply(ctx context.Context, tx *Transaction) error {
+	if err := s.repo.Apply(ctx, tx); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "economy transaction",
+		"event", "economy_transaction",
+		"subject", tx.InitiatedBy,
+		"transaction_id", tx.ID.String(),
+		"kind", string(tx.Kind),
+		"from", string(tx.From()),
+		"to", string(tx.To()),
+		"currency", tx.Currency,
+		"amount", tx.Amount,
+		"memo", tx.Memo,
+	)
+	return nil
+}
+
+// announce publishes a currency_transfer event to each character side of
+// a committed transaction. The money has already moved, so a publish
+// failure is logged rather than returned.
+func (s *Service) announce(ctx context.Context, tx *Transaction, from, to CharacterRef) {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return
+	}
+	base := eventvocab.CurrencyTransferPayload{
+		TransactionID: tx.ID.String(),
+		Kind:          string(tx.Kind),
+		Currency:      tx.Currency,
+		Amount:        tx.Amount,
+		Memo:          tx.Memo,
+	}
+	if _, ok := tx.From().CharacterID(); ok {
+		base.FromCharacterID = from.ID.String()
+	}
+	if _, ok := tx.To().CharacterID(); ok {
+		base.ToCharacterID = to.ID.String()
+	}
+	for _, e := range tx.Entries {
+		charID, ok := e.Account.CharacterID()
+		if !ok {
+			continue
+		}
+		payload := base
+		payload.Balance = e.BalanceAfter
+		payload.Text = transferText(tx, e, from, to)
+		if err := s.publish(ctx, pub, gameID, tx, charID, payload); err != nil {
+			s.logger.WarnContext(ctx, "economy transfer event not published",
+				"transaction_id", tx.ID.String(),
+				"character_id", charID.String(),
+				"error", err,
+			)
+		}
+	}
+}
+
+func (s *Service) publish(ctx context.Context, pub eventbus.Publisher, gameID func() string, tx *Transaction,
+	characterID ulid.ULID, payload eventvocab.CurrencyTransferPayload,
+) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return oops.With("operation", "marshal_currency_transfer_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+characterID.String())
+	if err != nil {
+		return oops.With("character_id", characterID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeCurrencyTransfer))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeCurrencyTransfer)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	if tx.Kind == KindTransfer {
+		if payer, ok := tx.From().CharacterID(); ok {
+			actor = eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: payer}
+		}
+	}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, data)); err != nil {
+		return oops.Code("ECONOMY_PUBLISH_FAILED").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// transferText is the line the character on side e of tx is shown.
+func transferText(tx *Transaction, e Entry, from, to CharacterRef) string {
+	amount := FormatAmount(tx.Amount, tx.Currency)
+	var text string
+	switch {
+	case tx.Kind == KindMint:
+		text = fmt.Sprintf("You receive %s.", amount)
+	case tx.Kind == KindBurn:
+		text = fmt.Sprintf("%s is removed from your account.", amount)
+	case e.Delta < 0:
+		text = fmt.Sprintf("You pay %s to %s.", amount, to.Name)
+	default:
+		text = fmt.Sprintf("%s pays you %s.", from.Name, amount)
+	}
+	if tx.Memo != "" {
+		text += " (" + tx.Memo + ")"
+	}
+	return text + fmt.Sprintf(" Balance: %s.", FormatAmount(e.BalanceAfter, tx.Currency))
+}
+
+// FormatAmount renders an amount with its currency, e.g. "25 credits".
+func FormatAmount(amount int64, currency string) string {
+	return fmt.Sprintf("%d %s", amount, currency)
+}
+
+// checkAccess evaluates action on currency:<code> for subject. It fails
+// closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject, action, currency string) error {
+	resource := access.CurrencyResource(currency)
+	req, err := types.NewAccessRequest(subject, action, resource, nil)
+	if err != nil {
+		return oops.Code("ECONOMY_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("ECONOMY_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "economy issue denied",
+			"event", "economy_issue_denied",
+			"subject", subject,
+			"action", action,
+			"currency", currency,
+			"reason", decision.Reason(),
+		)
+		return oops.Code("ECONOMY_ACCESS_DENIED").
+			With("currency", currency).
+			Errorf("not permitted to %s %s", action, currency)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
@@ -0,0 +1,411 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package economy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository. It also stands in for the
+// world's character lookup, with hidden characters seen only by themselves.
+type memRepository struct {
+	mu         sync.Mutex
+	balances   map[ulid.ULID]map[string]int64
+	characters map[string]CharacterRef
+	hidden     map[ulid.ULID]bool
+	ledger     []*Transaction
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{
+		balances:   map[ulid.ULID]map[string]int64{},
+		characters: map[string]CharacterRef{},
+		hidden:     map[ulid.ULID]bool{},
+	}
+}
+
+func (m *memRepository) addCharacter(name string) CharacterRef {
+	ref := CharacterRef{ID: idgen.New(), Name: name}
+	m.characters[strings.ToLower(name)] = ref
+	return ref
+}
+
+func (m *memRepository) Apply(_ context.Context, tx *Transaction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	next := map[ulid.ULID]int64{}
+	for _, e := range tx.Entries {
+		id, ok := e.Account.CharacterID()
+		if !ok {
+			continue
+		}
+		balance := m.balances[id][tx.Currency] + e.Delta
+		if balance < 0 {
+			return oops.Code("ECONOMY_INSUFFICIENT_FUNDS").Wrap(ErrInsufficientFunds)
+		}
+		next[id] = balance
+	}
+	for i, e := range tx.Entries {
+		id, ok := e.Account.CharacterID()
+		if !ok {
+			continue
+		}
+		if m.balances[id] == nil {
+			m.balances[id] = map[string]int64{}
+		}
+		m.balances[id][tx.Currency] = next[id]
+		tx.Entries[i].BalanceAfter = next[id]
+	}
+	stored := *tx
+	stored.Entries = append([]Entry(nil), tx.Entries...)
+	m.ledger = append(m.ledger, &stored)
+	return nil
+}
+
+func (m *memRepository) Balances(_ context.Context, characterID ulid.ULID) ([]Balance, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Balance
+	for currency, amount := range m.balances[characterID] {
+		out = append(out, Balance{Currency: currency, Amount: amount})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Currency < out[j].Currency })
+	return out, nil
+}
+
+func (m *memRepository) History(_ context.Context, characterID ulid.ULID, limit int) ([]*Transaction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Transaction
+	for i := len(m.ledger) - 1; i >= 0 && len(out) < limit; i-- {
+		if _, ok := m.ledger[i].Entry(CharacterAccount(characterID)); ok {
+			out = append(out, m.ledger[i])
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) FindCharacterByName(_ context.Context, observerID ulid.ULID, name string) (*world.Character, error) {
+	ref, ok := m.characters[strings.ToLower(name)]
+	if !ok || (m.hidden[ref.ID] && ref.ID != observerID) {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").Wrap(world.ErrNotFound)
+	}
+	return &world.Character{ID: ref.ID, Name: ref.Name}, nil
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo   *memRepository
+	engine *policytest.GrantEngine
+	pub    *fakePublisher
+	logs   *bytes.Buffer
+}
+
+func newTestService(t *testing.T, currencies ...string) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, repo, engine, currencies, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, mainGameID)
+	return &testService{Service: svc, repo: repo, engine: engine, pub: pub, logs: &logs}
+}
+
+func subjectOf(ref CharacterRef) string {
+	return access.CharacterSubject(ref.ID.String())
+}
+
+func decodeTransferPayload(t *testing.T, ev eventbus.Event) eventvocab.CurrencyTransferPayload {
+	t.Helper()
+	var p eventvocab.CurrencyTransferPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &p))
+	return p
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	repo := newMemRepository()
+	_, err := NewService(nil, repo, policytest.AllowAllEngine(), nil, nil)
+	require.Error(t, err)
+	_, err = NewService(repo, nil, policytest.AllowAllEngine(), nil, nil)
+	require.Error(t, err)
+	_, err = NewService(repo, repo, nil, nil, nil)
+	require.Error(t, err)
+}
+
+func TestNewServiceCurrencies(t *testing.T) {
+	repo := newMemRepository()
+	svc, err := NewService(repo, repo, policytest.AllowAllEngine(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultCurrency}, svc.Currencies())
+
+	svc, err = NewService(repo, repo, policytest.AllowAllEngine(), []string{" Gold", "silver"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gold", "silver"}, svc.Currencies())
+	assert.Equal(t, "gold", svc.DefaultCurrency())
+
+	_, err = NewService(repo, repo, policytest.AllowAllEngine(), []string{"gold", "GOLD"}, nil)
+	errutil.AssertErrorCode(t, err, "ECONOMY_INVALID_CURRENCY")
+	_, err = NewService(repo, repo, policytest.AllowAllEngine(), []string{"gold coins"}, nil)
+	errutil.AssertErrorCode(t, err, "ECONOMY_INVALID_CURRENCY")
+}
+
+func TestServiceCurrency(t *testing.T) {
+	ts := newTestService(t, "gold", "silver")
+
+	got, err := ts.Currency("")
+	require.NoError(t, err)
+	assert.Equal(t, "gold", got)
+	got, err = ts.Currency("Silver")
+	require.NoError(t, err)
+	assert.Equal(t, "silver", got)
+	_, err = ts.Currency("credits")
+	errutil.AssertErrorCode(t, err, "ECONOMY_UNKNOWN_CURRENCY")
+}
+
+func TestServiceMintTransferAndBurn(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	staff := ts.repo.addCharacter("Staff")
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+
+	_, err := ts.Mint(ctx, subjectOf(staff), IssueRequest{Character: alice, Amount: 100})
+	errutil.AssertErrorCode(t, err, "ECONOMY_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"economy_issue_denied"`)
+
+	ts.engine.Grant(subjectOf(staff), ActionMint, access.CurrencyResource(DefaultCurrency))
+	tx, err := ts.Mint(ctx, subjectOf(staff), IssueRequest{Character: alice, Amount: 100, Memo: "starting funds"})
+	require.NoError(t, err)
+	assert.Equal(t, KindMint, tx.Kind)
+	assert.Equal(t, Treasury, tx.From())
+	assert.Contains(t, ts.logs.String(), `"event":"economy_transaction"`)
+
+	tx, err = ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Amount: 30, Memo: "sword"})
+	require.NoError(t, err)
+	assert.Equal(t, KindTransfer, tx.Kind)
+
+	balances, err := ts.Balances(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []Balance{{Currency: DefaultCurrency, Amount: 70}}, balances)
+	balances, err = ts.Balances(ctx, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []Balance{{Currency: DefaultCurrency, Amount: 30}}, balances)
+
+	_, err = ts.Burn(ctx, subjectOf(staff), IssueRequest{Character: bob, Amount: 10})
+	errutil.AssertErrorCode(t, err, "ECONOMY_ACCESS_DENIED")
+	ts.engine.Grant(subjectOf(staff), ActionBurn, access.CurrencyResource(DefaultCurrency))
+	tx, err = ts.Burn(ctx, subjectOf(staff), IssueRequest{Character: bob, Amount: 10})
+	require.NoError(t, err)
+	assert.Equal(t, Treasury, tx.To())
+
+	history, err := ts.History(ctx, bob.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, KindBurn, history[0].Kind)
+	assert.Equal(t, KindTransfer, history[1].Kind)
+}
+
+func TestServiceTransferPublishesToBothSides(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+	ts.repo.balances[alice.ID] = map[string]int64{DefaultCurrency: 50}
+
+	tx, err := ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Amount: 20, Memo: "rent"})
+	require.NoError(t, err)
+
+	require.Len(t, ts.pub.published, 2)
+	byStream := map[string]eventbus.Event{}
+	for _, ev := range ts.pub.published {
+		assert.Equal(t, eventbus.Type(eventvocab.EventTypeCurrencyTransfer), ev.Type)
+		assert.Equal(t, eventbus.ActorKindCharacter, ev.Actor.Kind)
+		assert.Equal(t, alice.ID, ev.Actor.ID)
+		byStream[string(ev.Subject)] = ev
+	}
+
+	payer := decodeTransferPayload(t, byStream["events.main.character."+alice.ID.String()])
+	assert.Equal(t, eventvocab.CurrencyTransferPayload{
+		TransactionID:   tx.ID.String(),
+		Kind:            "transfer",
+		FromCharacterID: alice.ID.String(),
+		ToCharacterID:   bob.ID.String(),
+		Currency:        DefaultCurrency,
+		Amount:          20,
+		Memo:            "rent",
+		Balance:         30,
+		Text:            "You pay 20 credits to Bob. (rent) Balance: 30 credits.",
+	}, payer)
+
+	payee := decodeTransferPayload(t, byStream["events.main.character."+bob.ID.String()])
+	assert.Equal(t, int64(20), payee.Balance)
+	assert.Equal(t, "Alice pays you 20 credits. (rent) Balance: 20 credits.", payee.Text)
+}
+
+func TestServiceMintPublishesToRecipientOnly(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	staff := ts.repo.addCharacter("Staff")
+	alice := ts.repo.addCharacter("Alice")
+	ts.engine.Grant(subjectOf(staff), ActionMint, access.CurrencyResource(DefaultCurrency))
+
+	_, err := ts.Mint(ctx, subjectOf(staff), IssueRequest{Character: alice, Amount: 5})
+	require.NoError(t, err)
+
+	require.Len(t, ts.pub.published, 1)
+	ev := ts.pub.published[0]
+	assert.Equal(t, "events.main.character."+alice.ID.String(), string(ev.Subject))
+	assert.Equal(t, eventbus.ActorKindSystem, ev.Actor.Kind)
+	assert.Equal(t, core.SystemActorULID, ev.Actor.ID)
+	p := decodeTransferPayload(t, ev)
+	assert.Empty(t, p.FromCharacterID)
+	assert.Equal(t, alice.ID.String(), p.ToCharacterID)
+	assert.Equal(t, "You receive 5 credits. Balance: 5 credits.", p.Text)
+}
+
+func TestServiceTransferSpendsOnlyOwnMoney(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+	ts.repo.balances[alice.ID] = map[string]int64{DefaultCurrency: 50}
+
+	_, err := ts.Transfer(ctx, subjectOf(bob), TransferRequest{From: alice, To: bob, Amount: 50})
+	errutil.AssertErrorCode(t, err, "ECONOMY_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"economy_transfer_denied"`)
+	assert.Equal(t, int64(50), ts.repo.balances[alice.ID][DefaultCurrency])
+	assert.Empty(t, ts.pub.published)
+}
+
+func TestServiceTransferRejectsOverdraft(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+	ts.repo.balances[alice.ID] = map[string]int64{DefaultCurrency: 10}
+
+	_, err := ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Amount: 11})
+	errutil.AssertErrorCode(t, err, "ECONOMY_INSUFFICIENT_FUNDS")
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	assert.Empty(t, ts.repo.ledger)
+	assert.Empty(t, ts.pub.published)
+}
+
+func TestServiceTransferValidatesInput(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "gold")
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+
+	_, err := ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Currency: "credits", Amount: 1})
+	errutil.AssertErrorCode(t, err, "ECONOMY_UNKNOWN_CURRENCY")
+	_, err = ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: alice, Amount: 1})
+	errutil.AssertErrorCode(t, err, "ECONOMY_INVALID_TRANSFER")
+	_, err = ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Amount: 0})
+	errutil.AssertErrorCode(t, err, "ECONOMY_INVALID_AMOUNT")
+}
+
+func TestServicePublishFailureDoesNotFailTransaction(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	alice := ts.repo.addCharacter("Alice")
+	bob := ts.repo.addCharacter("Bob")
+	ts.repo.balances[alice.ID] = map[string]int64{DefaultCurrency: 10}
+	ts.pub.err = errors.New("bus down")
+
+	_, err := ts.Transfer(ctx, subjectOf(alice), TransferRequest{From: alice, To: bob, Amount: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), ts.repo.balances[bob.ID][DefaultCurrency])
+	assert.Contains(t, ts.logs.String(), "economy transfer event not published")
+}
+
+func TestServiceWithoutPublisherStillCommits(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemRepository()
+	svc, err := NewService(repo, repo, policytest.AllowAllEngine(), nil, nil)
+	require.NoError(t, err)
+	alice := repo.addCharacter("Alice")
+
+	_, err = svc.Mint(ctx, subjectOf(alice), IssueRequest{Character: alice, Amount: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), repo.balances[alice.ID][DefaultCurrency])
+}
+
+func TestServiceBalancesListsConfiguredCurrenciesFirst(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "gold", "silver")
+	alice := ts.repo.addCharacter("Alice")
+	ts.repo.balances[alice.ID] = map[string]int64{"silver": 4, "copper": 9}
+
+	balances, err := ts.Balances(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []Balance{
+		{Currency: "gold", Amount: 0},
+		{Currency: "silver", Amount: 4},
+		{Currency: "copper", Amount: 9},
+	}, balances)
+}
+
+func TestFindCharacterHidesWhatTheObserverCannotSee(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	alice := ts.repo.addCharacter("Alice")
+	shade := ts.repo.addCharacter("Shade")
+	ts.repo.hidden[shade.ID] = true
+
+	got, err := ts.FindCharacter(ctx, shade.ID, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, alice, got)
+
+	_, err = ts.FindCharacter(ctx, alice.ID, "shade")
+	errutil.AssertErrorCode(t, err, "ECONOMY_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	got, err = ts.FindCharacter(ctx, shade.ID, "SHADE")
+	require.NoError(t, err)
+	assert.Equal(t, shade, got)
+}
@@ -0,0 +1,478 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// Default compaction policy values.
+const (
+	// DefaultCompactionKeepRecent is how many of a subject's newest rows
+	// compaction never touches, whatever their type. Clients page back from
+	// the tail, so their cursors keep resolving.
+	DefaultCompactionKeepRecent = 200
+
+	// DefaultCompactionMinRun is the shortest run of consecutive compactable
+	// rows worth replacing with a checkpoint. Shorter runs stay raw: they
+	// are part of the story, not churn.
+	DefaultCompactionMinRun = 50
+
+	// compactionScanLimit bounds how many rows one subject's pass reads
+	// below its kept tail. A subject with a longer history is worked back a
+	// slice per cycle.
+	compactionScanLimit = 50_000
+)
+
+// DefaultCompactionTypes returns the event types compaction collapses when
+// the operator names none: movement, which an NPC walking a patrol emits
+// hundreds of times a day.
+func DefaultCompactionTypes() []string {
+	return []string{
+		string(eventvocab.EventTypeMove),
+		string(eventvocab.EventTypeArrive),
+		string(eventvocab.EventTypeLeave),
+	}
+}
+
+// CompactionConfig is the policy for collapsing long runs of low-value
+// events_audit rows into summary checkpoints. Compaction is off unless
+// Enabled; it runs on the retention worker's PurgeInterval.
+type CompactionConfig struct {
+	// Enabled turns compaction on. It deletes raw history, so operators
+	// opt in.
+	Enabled bool
+
+	// Types lists the event types that may be collapsed. Any other type
+	// breaks a run, so a say between two patrols keeps both sides apart.
+	// Empty resolves to DefaultCompactionTypes.
+	Types []string
+
+	// KeepRecent is how many of each subject's newest rows stay raw. Zero
+	// resolves to DefaultCompactionKeepRecent.
+	KeepRecent int
+
+	// MinRun is the shortest run replaced by a checkpoint. Zero resolves to
+	// DefaultCompactionMinRun.
+	MinRun int
+}
+
+// Defaults fills any zero-valued fields with defaults.
+func (c CompactionConfig) Defaults() CompactionConfig {
+	if len(c.Types) == 0 {
+		c.Types = DefaultCompactionTypes()
+	}
+	if c.KeepRecent == 0 {
+		c.KeepRecent = DefaultCompactionKeepRecent
+	}
+	if c.MinRun == 0 {
+		c.MinRun = DefaultCompactionMinRun
+	}
+	return c
+}
+
+// Validate rejects a policy that would compact more than it means to: a
+// negative KeepRecent, or a MinRun under two, which would swap single
+// events for checkpoints of one.
+func (c CompactionConfig) Validate() error {
+	if c.KeepRecent < 0 {
+		return oops.Code("AUDIT_CONFIG_INVALID").
+			With("keep_recent", c.KeepRecent).
+			Errorf("audit compaction keep_recent must not be negative")
+	}
+	if c.MinRun < 2 {
+		return oops.Code("AUDIT_CONFIG_INVALID").
+			With("min_run", c.MinRun).
+			Errorf("audit compaction min_run must be at least 2")
+	}
+	if slices.Contains(c.Types, "") {
+		return oops.Code("AUDIT_CONFIG_INVALID").
+			Errorf("audit compaction types must not contain an empty type")
+	}
+	return nil
+}
+
+// Checkpoint stands in for a run of events_audit rows that compaction
+// deleted.
+type Checkpoint struct {
+	ID        ulid.ULID
+	Subject   string
+	FirstSeq  uint64
+	LastSeq   uint64
+	FirstAt   time.Time
+	LastAt    time.Time
+	Count     int
+	Summary   CheckpointSummary
+	CreatedAt time.Time
+}
+
+// CheckpointSummary counts the events a checkpoint replaced by event type
+// and by actor. Actors are keyed "<kind>:<ULID>", or "<kind>" when the row
+// carried no actor ID.
+type CheckpointSummary struct {
+	Types  map[string]int `json:"types"`
+	Actors map[string]int `json:"actors"`
+}
+
+// CompactionResult reports what one compaction cycle did.
+type CompactionResult struct {
+	// Subjects is how many subjects held enough compactable rows to scan.
+	Subjects int
+	// Checkpoints is how many checkpoints were written.
+	Checkpoints int
+	// EventsRemoved is how many raw rows those checkpoints replaced.
+	EventsRemoved int64
+	// CheckpointsPruned is how many checkpoints aged out of the retain
+	// window.
+	CheckpointsPruned int64
+}
+
+// EventsAuditCompactor collapses long runs of low-value events_audit rows
+// into events_audit_checkpoints rows (migration 000076).
+//
+// A run is a stretch of consecutive rows on one subject, in js_seq order,
+// whose type is in the policy and that carry no DEK reference. Anything else
+// ends the run, so compaction never reaches across a narrative event and
+// never touches encrypted history, whose crypto-shred bookkeeping keys on
+// the row. A subject's newest KeepRecent rows are left alone. Each run is
+// collapsed in its own transaction; a run whose rows changed underneath it
+// (a concurrent retention drop or a second compactor) is skipped and
+// retried on the next cycle.
+type EventsAuditCompactor struct {
+	pool   *pgxpool.Pool
+	policy CompactionConfig
+	logger *slog.Logger
+	clock  func() time.Time
+
+	mu           sync.RWMutex
+	retainWindow time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewEventsAuditCompactor constructs a compactor over pool. policy must
+// already have Defaults applied. retainWindow bounds how long checkpoints
+// are kept, matching the events_audit partitions they summarize.
+func NewEventsAuditCompactor(pool *pgxpool.Pool, policy CompactionConfig, retainWindow time.Duration) *EventsAuditCompactor {
+	return &EventsAuditCompactor{
+		pool:         pool,
+		policy:       policy,
+		logger:       slog.Default(),
+		clock:        time.Now,
+		retainWindow: retainWindow,
+	}
+}
+
+// SetRetainWindow replaces how long checkpoints are kept. The next cycle
+// uses the new window.
+func (c *EventsAuditCompactor) SetRetainWindow(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retainWindow = d
+}
+
+// Start runs a compaction cycle every interval until Stop. The first cycle
+// runs one interval after Start, for the same reason the retention worker
+// skips its first run: a red deploy must not delete history.
+func (c *EventsAuditCompactor) Start(ctx context.Context, interval time.Duration) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.wg.Add(1)
+	go c.run(ctx, interval)
+}
+
+// Stop stops the compaction loop and waits for an in-flight cycle.
+func (c *EventsAuditCompactor) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+}
+
+func (c *EventsAuditCompactor) run(ctx context.Context, interval time.Duration) {
+	defer c.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := c.RunOnce(ctx); err != nil {
+				c.logger.ErrorContext(ctx, "audit compaction cycle failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce prunes expired checkpoints and compacts every subject with enough
+// compactable rows. A failing subject does not stop the others; errors are
+// combined.
+func (c *EventsAuditCompactor) RunOnce(ctx context.Context) (CompactionResult, error) {
+	var (
+		result CompactionResult
+		errs   []error
+	)
+	c.mu.RLock()
+	cutoff := c.clock().Add(-c.retainWindow)
+	c.mu.RUnlock()
+
+	tag, err := c.pool.Exec(ctx,
+		`DELETE FROM events_audit_checkpoints WHERE last_timestamp < $1`, pgnanos.From(cutoff))
+	if err != nil {
+		errs = append(errs, oops.Code("AUDIT_COMPACTION_FAILED").Wrap(err))
+	} else {
+		result.CheckpointsPruned = tag.RowsAffected()
+	}
+
+	subjects, err := c.candidateSubjects(ctx)
+	if err != nil {
+		return result, errors.Join(append(errs, err)...)
+	}
+	result.Subjects = len(subjects)
+	for _, subject := range subjects {
+		checkpoints, removed, err := c.compactSubject(ctx, subject)
+		result.Checkpoints += checkpoints
+		result.EventsRemoved += removed
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if result.Checkpoints > 0 || result.CheckpointsPruned > 0 {
+		c.logger.InfoContext(ctx, "compacted events_audit",
+			"subjects", result.Subjects,
+			"checkpoints", result.Checkpoints,
+			"events_removed", result.EventsRemoved,
+			"checkpoints_pruned", result.CheckpointsPruned)
+	}
+	return result, errors.Join(errs...)
+}
+
+// candidateSubjects lists the subjects holding at least MinRun compactable
+// rows. Whether those rows fall below the kept tail is settled per subject.
+func (c *EventsAuditCompactor) candidateSubjects(ctx context.Context) ([]string, error) {
+	rows, err := c.pool.Query(ctx, `
+		SELECT subject FROM events_audit
+		WHERE type = ANY($1) AND dek_ref IS NULL
+		GROUP BY subject
+		HAVING count(*) >= $2`,
+		c.policy.Types, c.policy.MinRun)
+	if err != nil {
+		return nil, oops.Code("AUDIT_COMPACTION_FAILED").Wrap(err)
+	}
+	subjects, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, oops.Code("AUDIT_COMPACTION_FAILED").Wrap(err)
+	}
+	return subjects, nil
+}
+
+// compactionRow is the slice of an events_audit row compaction needs.
+type compactionRow struct {
+	id        []byte
+	eventMS   int64
+	eventType string
+	actor     string
+	seq       int64
+	timestamp pgnanos.Time
+	eligible  bool
+}
+
+// compactSubject collapses the runs in one subject's history below its
+// kept tail.
+func (c *EventsAuditCompactor) compactSubject(ctx context.Context, subject string) (int, int64, error) {
+	rows, err := c.pool.Query(ctx, `
+		SELECT id, event_ms, type, actor_kind, actor_id, js_seq, timestamp,
+		       type = ANY($2) AND dek_ref IS NULL
+		FROM events_audit
+		WHERE subject = $1
+		ORDER BY js_seq DESC
+		OFFSET $3 LIMIT $4`,
+		subject, c.policy.Types, c.policy.KeepRecent, compactionScanLimit)
+	if err != nil {
+		return 0, 0, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	scanned, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (compactionRow, error) {
+		var (
+			r         compactionRow
+			actorKind string
+			actorID   []byte
+		)
+		if err := row.Scan(&r.id, &r.eventMS, &r.eventType, &actorKind, &actorID,
+			&r.seq, &r.timestamp, &r.eligible); err != nil {
+			return r, err //nolint:wrapcheck // wrapped once below
+		}
+		r.actor = actorKey(actorKind, actorID)
+		return r, nil
+	})
+	if err != nil {
+		return 0, 0, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	slices.Reverse(scanned)
+
+	var (
+		checkpoints int
+		removed     int64
+	)
+	for _, run := range compactionRuns(scanned, c.policy.MinRun) {
+		ok, err := c.collapse(ctx, subject, run)
+		if err != nil {
+			return checkpoints, removed, err
+		}
+		if ok {
+			checkpoints++
+			removed += int64(len(run))
+		}
+	}
+	return checkpoints, removed, nil
+}
+
+// collapse replaces one run with a checkpoint. It reports false, without
+// error, when some of the run's rows were already gone.
+func (c *EventsAuditCompactor) collapse(ctx context.Context, subject string, run []compactionRow) (bool, error) {
+	ids := make([][]byte, len(run))
+	eventMS := make([]int64, len(run))
+	for i, r := range run {
+		ids[i], eventMS[i] = r.id, r.eventMS
+	}
+	summary, err := json.Marshal(summarizeRun(run))
+	if err != nil {
+		return false, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	first, last := run[0], run[len(run)-1]
+
+	tx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return false, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }() //nolint:errcheck // no-op after Commit
+
+	tag, err := tx.Exec(ctx, `
+		DELETE FROM events_audit
+		WHERE subject = $1 AND id = ANY($2) AND event_ms = ANY($3)`,
+		subject, ids, eventMS)
+	if err != nil {
+		return false, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	if tag.RowsAffected() != int64(len(run)) {
+		c.logger.WarnContext(ctx, "skipped audit compaction run whose rows changed",
+			"subject", subject, "first_js_seq", first.seq, "expected", len(run), "deleted", tag.RowsAffected())
+		return false, nil
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO events_audit_checkpoints
+		  (id, subject, first_js_seq, last_js_seq, first_timestamp, last_timestamp, event_count, summary, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		idgen.New().String(), subject, first.seq, last.seq, first.timestamp, last.timestamp,
+		len(run), summary, pgnanos.From(c.clock())); err != nil {
+		return false, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	return true, nil
+}
+
+// Checkpoints returns subject's checkpoints oldest first.
+func (c *EventsAuditCompactor) Checkpoints(ctx context.Context, subject string) ([]Checkpoint, error) {
+	rows, err := c.pool.Query(ctx, `
+		SELECT id, subject, first_js_seq, last_js_seq, first_timestamp, last_timestamp,
+		       event_count, summary, created_at
+		FROM events_audit_checkpoints
+		WHERE subject = $1
+		ORDER BY first_js_seq`, subject)
+	if err != nil {
+		return nil, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	out, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Checkpoint, error) {
+		var (
+			cp                Checkpoint
+			id                string
+			firstSeq, lastSeq int64
+			firstAt, lastAt   pgnanos.Time
+			createdAt         pgnanos.Time
+			summary           []byte
+		)
+		if err := row.Scan(&id, &cp.Subject, &firstSeq, &lastSeq, &firstAt, &lastAt,
+			&cp.Count, &summary, &createdAt); err != nil {
+			return cp, err //nolint:wrapcheck // wrapped once below
+		}
+		parsed, err := ulid.Parse(id)
+		if err != nil {
+			return cp, err //nolint:wrapcheck // wrapped once below
+		}
+		if err := json.Unmarshal(summary, &cp.Summary); err != nil {
+			return cp, err //nolint:wrapcheck // wrapped once below
+		}
+		cp.ID = parsed
+		cp.FirstSeq = uint64(firstSeq) //nolint:gosec // G115: js_seq is always a positive JetStream sequence
+		cp.LastSeq = uint64(lastSeq)   //nolint:gosec // G115: js_seq is always a positive JetStream sequence
+		cp.FirstAt, cp.LastAt, cp.CreatedAt = firstAt.Time(), lastAt.Time(), createdAt.Time()
+		return cp, nil
+	})
+	if err != nil {
+		return nil, oops.Code("AUDIT_COMPACTION_FAILED").With("subject", subject).Wrap(err)
+	}
+	return out, nil
+}
+
+// compactionRuns splits rows, in js_seq order, into maximal runs of
+// consecutive eligible rows and returns those at least minRun long.
+func compactionRuns(rows []compactionRow, minRun int) [][]compactionRow {
+	var (
+		runs  [][]compactionRow
+		start = -1
+	)
+	flush := func(end int) {
+		if start >= 0 && end-start >= minRun {
+			runs = append(runs, rows[start:end])
+		}
+		start = -1
+	}
+	for i, r := range rows {
+		switch {
+		case !r.eligible:
+			flush(i)
+		case start < 0:
+			start = i
+		}
+	}
+	flush(len(rows))
+	return runs
+}
+
+// summarizeRun counts a run's events by type and by actor.
+func summarizeRun(run []compactionRow) CheckpointSummary {
+	s := CheckpointSummary{Types: map[string]int{}, Actors: map[string]int{}}
+	for _, r := range run {
+		s.Types[r.eventType]++
+		s.Actors[r.actor]++
+	}
+	return s
+}
+
+// actorKey names an actor in a checkpoint summary.
+func actorKey(kind string, id []byte) string {
+	if len(id) != len(ulid.ULID{}) {
+		return kind
+	}
+	var u ulid.ULID
+	copy(u[:], id)
+	return kind + ":" + u.String()
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// compactionPool returns a migrated pool with the current month's
+// events_audit partition in place.
+func compactionPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	pool := auditIdemPool(t)
+	require.NoError(t, NewEventsAuditPartitionManager(pool, 90*24*time.Hour).EnsurePartitions(context.Background(), 1))
+	return pool
+}
+
+// seedHistory writes one events_audit row per pattern rune on subject, in
+// js_seq order starting at 1: 'm' is a move by actor, 's' a say, and 'e' an
+// encrypted move. It returns the rows' IDs.
+func seedHistory(t *testing.T, pool *pgxpool.Pool, subject string, actor ulid.ULID, pattern string) []ulid.ULID {
+	t.Helper()
+	base := time.Now().UTC().Add(-time.Hour)
+	ids := make([]ulid.ULID, 0, len(pattern))
+	for i, ch := range pattern {
+		at := base.Add(time.Duration(i) * time.Second)
+		id := ulid.MustNew(ulid.Timestamp(at), ulid.DefaultEntropy())
+		eventType, dekRef := "move", (*int64)(nil)
+		switch ch {
+		case 's':
+			eventType = "say"
+		case 'e':
+			ref := int64(1)
+			dekRef = &ref
+		}
+		_, err := pool.Exec(context.Background(), `
+			INSERT INTO events_audit
+			  (id, subject, type, timestamp, actor_kind, actor_id, envelope, schema_ver, codec, js_seq, rendering, dek_ref, event_ms)
+			VALUES ($1, $2, $3, $4, 'character', $5, '\x00', 1, 'identity', $6, '{}', $7, $8)`,
+			id.Bytes(), subject, eventType, at.UnixNano(), actor.Bytes(), i+1, dekRef,
+			time.UnixMilli(int64(id.Time())).UnixNano())
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func auditSeqs(t *testing.T, pool *pgxpool.Pool, subject string) []int64 {
+	t.Helper()
+	rows, err := pool.Query(context.Background(),
+		`SELECT js_seq FROM events_audit WHERE subject = $1 ORDER BY js_seq`, subject)
+	require.NoError(t, err)
+	defer rows.Close()
+	var out []int64
+	for rows.Next() {
+		var seq int64
+		require.NoError(t, rows.Scan(&seq))
+		out = append(out, seq)
+	}
+	require.NoError(t, rows.Err())
+	return out
+}
+
+func TestCompactorCollapsesRunsAndKeepsRecentTail(t *testing.T) {
+	pool := compactionPool(t)
+	ctx := context.Background()
+	subject := "events.main.location." + idgen.New().String()
+	guard := idgen.New()
+	// seq 1-6 moves, 7 say, 8-9 moves, 10 encrypted move, 11-16 moves,
+	// 17-20 the kept tail.
+	seedHistory(t, pool, subject, guard, "mmmmmmsmmemmmmmmmmmm")
+
+	c := NewEventsAuditCompactor(pool, CompactionConfig{Enabled: true, KeepRecent: 4, MinRun: 3}.Defaults(), 90*24*time.Hour)
+	result, err := c.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Checkpoints)
+	assert.Equal(t, int64(12), result.EventsRemoved)
+
+	assert.Equal(t, []int64{7, 8, 9, 10, 17, 18, 19, 20}, auditSeqs(t, pool, subject),
+		"the say, the short run, the encrypted row and the kept tail stay raw")
+
+	cps, err := c.Checkpoints(ctx, subject)
+	require.NoError(t, err)
+	require.Len(t, cps, 2)
+	assert.Equal(t, uint64(1), cps[0].FirstSeq)
+	assert.Equal(t, uint64(6), cps[0].LastSeq)
+	assert.Equal(t, 6, cps[0].Count)
+	assert.True(t, cps[0].FirstAt.Before(cps[0].LastAt))
+	assert.Equal(t, map[string]int{"move": 6}, cps[0].Summary.Types)
+	assert.Equal(t, map[string]int{"character:" + guard.String(): 6}, cps[0].Summary.Actors)
+	assert.Equal(t, uint64(11), cps[1].FirstSeq)
+	assert.Equal(t, uint64(16), cps[1].LastSeq)
+
+	again, err := c.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, again.Checkpoints, "a compacted history has nothing left to collapse")
+}
+
+func TestCompactorLeavesOtherTypesAndSubjectsAlone(t *testing.T) {
+	pool := compactionPool(t)
+	ctx := context.Background()
+	chatty := "events.main.location." + idgen.New().String()
+	quiet := "events.main.location." + idgen.New().String()
+	seedHistory(t, pool, chatty, idgen.New(), "ssssssssss")
+	seedHistory(t, pool, quiet, idgen.New(), "mm")
+
+	c := NewEventsAuditCompactor(pool, CompactionConfig{Enabled: true, KeepRecent: 1, MinRun: 3}.Defaults(), 90*24*time.Hour)
+	result, err := c.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, result.Checkpoints)
+	assert.Len(t, auditSeqs(t, pool, chatty), 10)
+	assert.Len(t, auditSeqs(t, pool, quiet), 2)
+}
+
+func TestCompactorPrunesCheckpointsPastRetainWindow(t *testing.T) {
+	pool := compactionPool(t)
+	ctx := context.Background()
+	subject := "events.main.location." + idgen.New().String()
+	seedHistory(t, pool, subject, idgen.New(), "mmmmm")
+
+	c := NewEventsAuditCompactor(pool, CompactionConfig{Enabled: true, KeepRecent: 1, MinRun: 2}.Defaults(), 90*24*time.Hour)
+	_, err := c.RunOnce(ctx)
+	require.NoError(t, err)
+	cps, err := c.Checkpoints(ctx, subject)
+	require.NoError(t, err)
+	require.Len(t, cps, 1)
+
+	c.SetRetainWindow(time.Minute)
+	result, err := c.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, result.CheckpointsPruned, int64(1))
+	cps, err = c.Checkpoints(ctx, subject)
+	require.NoError(t, err)
+	assert.Empty(t, cps)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rowsOf builds a js_seq-ordered history from a pattern: 'm' is an eligible
+// move, 's' an ineligible say.
+func rowsOf(pattern string) []compactionRow {
+	rows := make([]compactionRow, len(pattern))
+	for i, ch := range pattern {
+		rows[i] = compactionRow{seq: int64(i + 1), eventType: "move", actor: "character", eligible: ch == 'm'}
+		if ch != 'm' {
+			rows[i].eventType = "say"
+		}
+	}
+	return rows
+}
+
+func runSeqs(runs [][]compactionRow) [][2]int64 {
+	out := make([][2]int64, 0, len(runs))
+	for _, run := range runs {
+		out = append(out, [2]int64{run[0].seq, run[len(run)-1].seq})
+	}
+	return out
+}
+
+func TestCompactionRunsSplitOnIneligibleRows(t *testing.T) {
+	t.Parallel()
+	runs := compactionRuns(rowsOf("mmmmsmmsmmmmm"), 3)
+	assert.Equal(t, [][2]int64{{1, 4}, {9, 13}}, runSeqs(runs),
+		"a say breaks the run and the two-move stretch is too short")
+}
+
+func TestCompactionRunsEmptyAndShortHistories(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, compactionRuns(nil, 2))
+	assert.Empty(t, compactionRuns(rowsOf("ssss"), 2))
+	assert.Empty(t, compactionRuns(rowsOf("msmsm"), 2))
+	assert.Equal(t, [][2]int64{{1, 2}}, runSeqs(compactionRuns(rowsOf("mm"), 2)))
+}
+
+func TestSummarizeRunCountsTypesAndActors(t *testing.T) {
+	t.Parallel()
+	guard := actorKey("character", ulid.Make().Bytes())
+	run := []compactionRow{
+		{eventType: "move", actor: guard},
+		{eventType: "leave", actor: guard},
+		{eventType: "move", actor: guard},
+		{eventType: "arrive", actor: "system"},
+	}
+	s := summarizeRun(run)
+	assert.Equal(t, map[string]int{"move": 2, "leave": 1, "arrive": 1}, s.Types)
+	assert.Equal(t, map[string]int{guard: 3, "system": 1}, s.Actors)
+}
+
+func TestActorKey(t *testing.T) {
+	t.Parallel()
+	id := ulid.Make()
+	assert.Equal(t, "character:"+id.String(), actorKey("character", id.Bytes()))
+	assert.Equal(t, "system", actorKey("system", nil))
+	require.Equal(t, "plugin", actorKey("plugin", []byte{1, 2}), "a malformed ID is dropped")
+}
@@ -33,6 +33,7 @@ const (
 	headerSchemaVersion = "App-Schema-Version"
 	headerActorKind     = "App-Actor-Kind"
 	headerActorID       = "App-Actor-ID"
+	headerImpersonator  = "App-Impersonator-ID"
 	headerRendering     = "App-Rendering"
 )
 
@@ -415,6 +416,18 @@ func writeAuditRow(ctx context.Context, pool *pgxpool.Pool, subject string, msg
 		actorID = b
 	}
 
+	// App-Impersonator-ID names the staff player behind an impersonation
+	// session; absent for every other event. Like App-Actor-ID it MUST
+	// parse when present.
+	var impersonatorID []byte
+	if v := h.Get(headerImpersonator); v != "" {
+		parsed, parseErr := ulid.Parse(v)
+		if parseErr != nil {
+			return oops.Code("AUDIT_BAD_IMPERSONATOR_ID").With("value", v).Wrap(parseErr)
+		}
+		impersonatorID = parsed.Bytes()
+	}
+
 	idBytes, err := decodeULIDString(msgID)
 	if err != nil {
 		return oops.Code("AUDIT_BAD_MSG_ID").With("msg_id", msgID).Wrap(err)
@@ -434,8 +447,8 @@ func writeAuditRow(ctx context.Context, pool *pgxpool.Pool, subject string, msg
 		INSERT INTO events_audit (
 			id, subject, type, timestamp, actor_kind, actor_id,
 			envelope, schema_ver, codec, js_seq, rendering,
-			dek_ref, dek_version, event_ms
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			dek_ref, dek_version, event_ms, impersonator_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		ON CONFLICT (id, event_ms) DO NOTHING`,
 		idBytes,
 		subject,
@@ -451,6 +464,7 @@ func writeAuditRow(ctx context.Context, pool *pgxpool.Pool, subject string, msg
 		dekRef,
 		dekVer,
 		eventMS,
+		impersonatorID,
 	)
 	if err != nil {
 		return oops.Code("AUDIT_INSERT_FAILED").Wrap(err)
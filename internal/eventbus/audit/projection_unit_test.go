@@ -151,6 +151,20 @@ func TestPersistRejectsMalformedActorID(t *testing.T) {
 	errutil.AssertErrorCode(t, err, "AUDIT_BAD_ACTOR_ID")
 }
 
+func TestPersistRejectsMalformedImpersonatorID(t *testing.T) {
+	p := newTestProjection()
+	h := validHeaders(t)
+	h.Set(headerImpersonator, "not-a-ulid")
+	msg := &stubMsg{
+		headers: h,
+		subject: "events.main.test",
+		meta:    &jetstream.MsgMetadata{Sequence: jetstream.SequencePair{Stream: 1}},
+	}
+	err := p.persist(msg)
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "AUDIT_BAD_IMPERSONATOR_ID")
+}
+
 func TestPersistPropagatesMetadataError(t *testing.T) {
 	p := newTestProjection()
 	h := validHeaders(t)
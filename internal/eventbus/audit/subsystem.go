@@ -121,6 +121,10 @@ type Config struct {
 	// PurgeInterval is how often the periodic RetentionWorker runs its
 	// Detach/Drop cycle. Zero resolves to DefaultPurgeInterval.
 	PurgeInterval time.Duration
+
+	// Compaction collapses long runs of low-value rows (movement churn)
+	// into summary checkpoints on the PurgeInterval. Off unless Enabled.
+	Compaction CompactionConfig
 }
 
 // Defaults fills any zero-valued fields with defaults.
@@ -147,6 +151,7 @@ func (c Config) Defaults() Config {
 		c.PurgeInterval = DefaultPurgeInterval
 	}
 	c.DLQ = c.DLQ.Defaults()
+	c.Compaction = c.Compaction.Defaults()
 	return c
 }
 
@@ -167,6 +172,9 @@ func (c Config) Validate() error {
 			With("purge_interval", c.PurgeInterval).
 			Errorf("audit purge_interval must be positive")
 	}
+	if c.Compaction.Enabled {
+		return c.Compaction.Validate()
+	}
 	return nil
 }
 
@@ -227,6 +235,9 @@ type Subsystem struct {
 	partitionManager *EventsAuditPartitionManager
 	pluginMgr        *PluginConsumerManager
 	retentionWorker  *retaudit.RetentionWorker
+	// compactor is the periodic events_audit compaction loop, started by
+	// Activate when Compaction.Enabled.
+	compactor *EventsAuditCompactor
 	// lateInit is called once from Prepare (before newProjection) so the
 	// owner map and per-plugin consumer manager can be built from plugin
 	// manifests that are only available after SubsystemPlugins has
@@ -263,6 +274,33 @@ func (s *Subsystem) SetLateInitProvider(p func() (*OwnerMap, *PluginConsumerMana
 	s.lateInit = p
 }
 
+// SetRetainWindow replaces how long events_audit history is kept, e.g. on a
+// config reload. Zero restores DefaultRetainWindow; a negative window is
+// rejected for the same reason Validate rejects it. The running retention
+// worker and compactor pick the window up on their next cycle; the partition
+// manager's backward coverage keeps the window it was prepared with.
+func (s *Subsystem) SetRetainWindow(d time.Duration) error {
+	if d == 0 {
+		d = DefaultRetainWindow
+	}
+	if d < 0 {
+		return oops.Code("AUDIT_CONFIG_INVALID").
+			With("retain_window", d).
+			Errorf("audit retain_window must be positive")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg.RetainWindow = d
+	if s.retentionWorker != nil {
+		rc := s.cfg.retentionConfig()
+		s.retentionWorker.SetRetainWindows(rc.RetainDenials, rc.RetainAllows)
+	}
+	if s.compactor != nil {
+		s.compactor.SetRetainWindow(d)
+	}
+	return nil
+}
+
 // DependsOn returns the subsystems that must be started first:
 // database (events_audit target table), eventbus (JetStream source),
 // and plugins (needed so per-plugin audit consumers can resolve their
@@ -423,6 +461,13 @@ func (s *Subsystem) Activate(_ context.Context) error {
 	worker := retaudit.NewRetentionWorker(s.cfg.retentionConfig(), s.partitionManager, retaudit.WithSkipFirstRun())
 	_ = worker.Start(workerCtx) //nolint:errcheck // Start always returns nil
 	s.retentionWorker = worker
+	// Movement-churn compaction shares the retention cadence and, like it,
+	// first runs one PurgeInterval after boot.
+	if s.cfg.Compaction.Enabled {
+		compactor := NewEventsAuditCompactor(s.poolProv.Pool(), s.cfg.Compaction, s.cfg.RetainWindow)
+		compactor.Start(workerCtx, s.cfg.PurgeInterval)
+		s.compactor = compactor
+	}
 	return nil
 }
 
@@ -463,6 +508,10 @@ func (s *Subsystem) Stop(ctx context.Context) error {
 		s.retentionWorker.Stop()
 		s.retentionWorker = nil
 	}
+	if s.compactor != nil {
+		s.compactor.Stop()
+		s.compactor = nil
+	}
 	// Drain per-plugin consumers before the host projection so a plugin
 	// cannot keep dispatching while the host projection is tearing down.
 	var pluginErr error
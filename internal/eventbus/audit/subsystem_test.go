@@ -127,6 +127,37 @@ func TestConfigValidateRejectsNonPositivePurgeInterval(t *testing.T) {
 		"a zero purge_interval is also rejected")
 }
 
+func TestConfigDefaultsFillsCompactionPolicy(t *testing.T) {
+	t.Parallel()
+	c := audit.Config{}.Defaults()
+	assert.False(t, c.Compaction.Enabled, "compaction is opt-in")
+	assert.Equal(t, audit.DefaultCompactionTypes(), c.Compaction.Types)
+	assert.Equal(t, audit.DefaultCompactionKeepRecent, c.Compaction.KeepRecent)
+	assert.Equal(t, audit.DefaultCompactionMinRun, c.Compaction.MinRun)
+}
+
+func TestConfigValidateRejectsBadCompactionPolicy(t *testing.T) {
+	t.Parallel()
+	for name, policy := range map[string]audit.CompactionConfig{
+		"negative keep_recent": {Enabled: true, KeepRecent: -1},
+		"min_run of one":       {Enabled: true, MinRun: 1},
+		"empty type":           {Enabled: true, Types: []string{"move", ""}},
+	} {
+		c := audit.Config{Compaction: policy}.Defaults()
+		errutil.AssertErrorCode(t, c.Validate(), "AUDIT_CONFIG_INVALID")
+		c.Compaction.Enabled = false
+		require.NoError(t, c.Validate(), "%s: a disabled policy is not checked", name)
+	}
+}
+
+func TestSetRetainWindowRejectsNegative(t *testing.T) {
+	t.Parallel()
+	s := audit.NewSubsystem(stubJS{}, stubPool{}, audit.Config{})
+	require.NoError(t, s.SetRetainWindow(30*24*time.Hour))
+	require.NoError(t, s.SetRetainWindow(0), "zero restores the default")
+	errutil.AssertErrorCode(t, s.SetRetainWindow(-time.Hour), "AUDIT_CONFIG_INVALID")
+}
+
 func TestPrepareWithNilJSReturnsDepNotStartedError(t *testing.T) {
 	t.Parallel()
 	s := audit.NewSubsystem(stubJS{}, stubPool{}, audit.Config{})
@@ -101,6 +101,10 @@ type Config struct {
 	// Crypto gates the Phase 3a sensitivity-aware crypto path.
 	// See spec §11.1 phase 3.
 	Crypto CryptoConfig `koanf:"crypto"`
+
+	// Payload bounds event payload sizes at publish time and selects EVENTS
+	// stream compression.
+	Payload PayloadConfig `koanf:"payload"`
 }
 
 // AuditRetentionConfig carries operator overrides for events_audit retention
@@ -115,6 +119,26 @@ type AuditRetentionConfig struct {
 	// PurgeInterval overrides how often the retention worker runs its
 	// Detach/Drop cycle. Zero → default 24h.
 	PurgeInterval time.Duration `koanf:"purge_interval"`
+	// Compaction collapses long runs of movement churn into summary
+	// checkpoints on the same cadence. Off unless enabled.
+	Compaction AuditCompactionConfig `koanf:"compaction"`
+}
+
+// AuditCompactionConfig carries operator overrides for events_audit
+// compaction. Zero values defer to the audit subsystem's defaults (move,
+// arrive and leave events; keep the newest 200 rows per subject; collapse
+// runs of 50 or more).
+type AuditCompactionConfig struct {
+	// Enabled turns compaction on. It deletes raw history, so it is off by
+	// default.
+	Enabled bool `koanf:"enabled"`
+	// Types lists the event types that may be collapsed.
+	Types []string `koanf:"types"`
+	// KeepRecent is how many of each subject's newest rows stay raw.
+	KeepRecent int `koanf:"keep_recent"`
+	// MinRun is the shortest run of consecutive compactable rows replaced
+	// by a checkpoint.
+	MinRun int `koanf:"min_run"`
 }
 
 // TLSConfig carries an optional TLS block for external-mode connections
@@ -217,5 +241,5 @@ func (c Config) Validate() error {
 			With("mode", string(c.Mode)).
 			Errorf("event_bus mode is external but url is empty")
 	}
-	return nil
+	return c.Payload.validate()
 }
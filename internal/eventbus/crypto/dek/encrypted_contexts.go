@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package dek
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// EncryptedContextRepo is the SQL persistence layer for
+// crypto_encrypted_contexts: the contexts whose every event is stored
+// encrypted at rest. It satisfies eventbus.EncryptedContextStore.
+type EncryptedContextRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewEncryptedContextRepo wraps a pgxpool.Pool.
+func NewEncryptedContextRepo(pool *pgxpool.Pool) *EncryptedContextRepo {
+	return &EncryptedContextRepo{pool: pool}
+}
+
+// IsEncrypted reports whether ctxID has been opted into encryption at rest.
+func (r *EncryptedContextRepo) IsEncrypted(ctx context.Context, ctxID ContextID) (bool, error) {
+	var encrypted bool
+	err := r.pool.QueryRow(ctx, `
+        SELECT EXISTS (
+          SELECT 1 FROM crypto_encrypted_contexts
+           WHERE context_type = $1 AND context_id = $2
+        )
+    `, ctxID.Type, ctxID.ID).Scan(&encrypted)
+	if err != nil {
+		return false, oops.Code("DEK_ENCRYPTED_CONTEXT_SELECT_FAILED").
+			With("context_type", ctxID.Type).
+			With("context_id", ctxID.ID).
+			Wrap(err)
+	}
+	return encrypted, nil
+}
+
+// MarkEncrypted opts ctxID into encryption at rest. Idempotent: marking an
+// already-encrypted context keeps its original enabled_at.
+func (r *EncryptedContextRepo) MarkEncrypted(ctx context.Context, ctxID ContextID) error {
+	_, err := r.pool.Exec(ctx, `
+        INSERT INTO crypto_encrypted_contexts (context_type, context_id, enabled_at)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (context_type, context_id) DO NOTHING
+    `, ctxID.Type, ctxID.ID, pgnanos.From(time.Now()))
+	if err != nil {
+		return oops.Code("DEK_ENCRYPTED_CONTEXT_INSERT_FAILED").
+			With("context_type", ctxID.Type).
+			With("context_id", ctxID.ID).
+			Wrap(err)
+	}
+	return nil
+}
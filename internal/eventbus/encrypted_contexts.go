@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus/crypto/dek"
+)
+
+// PayloadKeyEncryptedAtRest is the JSON payload field a producer sets to
+// true on the FIRST event of a context — a private scene's creation notice —
+// to opt that context into encryption at rest. The opt-in event itself and
+// every later event on the context are stored encrypted. Mirrored for
+// plugins as pluginsdk.PayloadKeyEncryptedAtRest.
+const PayloadKeyEncryptedAtRest = "encrypted_at_rest"
+
+// encryptableContextTypes are the DEK context types that may opt into
+// encryption at rest. Only contexts with a participant set can authorize
+// readers; encrypting a location would blank it for everyone in the room.
+var encryptableContextTypes = map[string]struct{}{
+	"scene": {},
+}
+
+// EncryptedContexts reports whether every event on a DEK context's streams
+// MUST be stored encrypted, independent of the per-event Sensitive flag.
+// Private scenes opt in at creation; the publisher consults the policy and
+// promotes matching events onto the DEK crypto branch, so readers outside
+// the participant set fall back to the existing MetadataOnly /
+// NoPlaintextReason delivery path.
+type EncryptedContexts interface {
+	RequiresEncryption(ctx context.Context, ctxID dek.ContextID) (bool, error)
+}
+
+// WithEncryptedContexts wires a per-context encryption policy. nil keeps the
+// pre-existing behavior: only events emitted with Sensitive=true encrypt.
+// A policy without a DEK manager turns every matching publish into
+// EVENTBUS_SENSITIVE_EVENT_NO_DEK_MANAGER — fail-closed, never plaintext.
+func WithEncryptedContexts(ec EncryptedContexts) PublishOption {
+	return func(p *JetStreamPublisher) { p.encrypted = ec }
+}
+
+// EncryptedContextStore persists which contexts are encrypted at rest, so
+// the mark survives restarts and is shared by every core process.
+// *dek.EncryptedContextRepo satisfies it.
+type EncryptedContextStore interface {
+	IsEncrypted(ctx context.Context, ctxID dek.ContextID) (bool, error)
+	MarkEncrypted(ctx context.Context, ctxID dek.ContextID) error
+}
+
+// EncryptedContextSet is the EncryptedContexts implementation: a
+// per-process cache over an optional EncryptedContextStore. Each context's
+// answer is read from the store once and cached for the life of the
+// process. Caching "not encrypted" is safe because contexts opt in only on
+// their first event, before any process can have published on them. Safe
+// for concurrent use.
+type EncryptedContextSet struct {
+	store EncryptedContextStore
+
+	mu    sync.RWMutex
+	known map[dek.ContextID]bool
+}
+
+// NewEncryptedContextSet returns a set backed by store. A nil store keeps
+// the set in memory only: no context requires encryption until Enable is
+// called for it, and nothing survives a restart.
+func NewEncryptedContextSet(store EncryptedContextStore) *EncryptedContextSet {
+	return &EncryptedContextSet{store: store, known: make(map[dek.ContextID]bool)}
+}
+
+// Enable marks ctxID as encrypted at rest, persisting the mark before it
+// takes effect. Idempotent. There is no inverse: events already stored
+// encrypted stay encrypted.
+func (s *EncryptedContextSet) Enable(ctx context.Context, ctxID dek.ContextID) error {
+	if s.store != nil {
+		if err := s.store.MarkEncrypted(ctx, ctxID); err != nil {
+			return oops.With("context_type", ctxID.Type).
+				With("context_id", ctxID.ID).
+				Wrap(err)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.known[ctxID] = true
+	return nil
+}
+
+// RequiresEncryption implements EncryptedContexts.
+func (s *EncryptedContextSet) RequiresEncryption(ctx context.Context, ctxID dek.ContextID) (bool, error) {
+	s.mu.RLock()
+	encrypted, ok := s.known[ctxID]
+	s.mu.RUnlock()
+	if ok || s.store == nil {
+		return encrypted, nil
+	}
+
+	encrypted, err := s.store.IsEncrypted(ctx, ctxID)
+	if err != nil {
+		return false, oops.With("context_type", ctxID.Type).
+			With("context_id", ctxID.ID).
+			Wrap(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// An Enable that raced the read wins.
+	if !s.known[ctxID] {
+		s.known[ctxID] = encrypted
+	}
+	return s.known[ctxID], nil
+}
+
+// RequestContextDEK opts ctxID into encryption at rest and genesises its DEK
+// seeded with initial, so the first publish does not race DEK creation.
+// Called at private-scene creation. The set is only marked after the DEK
+// exists: a failed genesis leaves the context unencrypted and surfaces
+// EVENTBUS_CONTEXT_DEK_REQUEST_FAILED to the caller.
+func RequestContextDEK(
+	ctx context.Context,
+	set *EncryptedContextSet,
+	mgr DEKManager,
+	ctxID dek.ContextID,
+	initial []dek.Participant,
+) error {
+	if set == nil || mgr == nil {
+		return oops.Code("EVENTBUS_CONTEXT_DEK_UNAVAILABLE").
+			With("context_type", ctxID.Type).
+			With("context_id", ctxID.ID).
+			Errorf("encrypted context set and DEK manager are both required")
+	}
+	if _, err := mgr.GetOrCreate(ctx, ctxID, initial); err != nil {
+		return oops.Code("EVENTBUS_CONTEXT_DEK_REQUEST_FAILED").
+			With("context_type", ctxID.Type).
+			With("context_id", ctxID.ID).
+			Wrap(err)
+	}
+	return set.Enable(ctx, ctxID)
+}
+
+// EncryptedContextPublisher applies encryption at rest ahead of the rest of
+// the publisher chain. It MUST be the outermost wrapper: publisher taps
+// (outbound webhooks, NPC behaviors) skip Sensitive events, and they only
+// see an encrypted context's events as Sensitive if the promotion has
+// already happened. An event carrying PayloadKeyEncryptedAtRest opts its
+// context in (RequestContextDEK) before it is published; a failed opt-in,
+// or one for a context type other than scene, fails the publish, so a
+// private scene is never created in the clear.
+type EncryptedContextPublisher struct {
+	next Publisher
+	set  *EncryptedContextSet
+	mgr  DEKManager
+}
+
+// NewEncryptedContextPublisher wraps next. next, set, and mgr MUST NOT be
+// nil; deployments without a KEK do not encrypt at rest and leave the
+// publisher chain unwrapped.
+func NewEncryptedContextPublisher(next Publisher, set *EncryptedContextSet, mgr DEKManager) *EncryptedContextPublisher {
+	if next == nil || set == nil || mgr == nil {
+		panic("eventbus.NewEncryptedContextPublisher: next, set, and mgr are required")
+	}
+	return &EncryptedContextPublisher{next: next, set: set, mgr: mgr}
+}
+
+// Publish implements Publisher.
+func (p *EncryptedContextPublisher) Publish(ctx context.Context, event Event) error {
+	if requestsEncryptionAtRest(event) {
+		ctxID, err := contextIDFromSubject(event.Subject)
+		if err != nil {
+			return oops.Code("EVENTBUS_ENCRYPTION_REQUEST_INVALID").
+				With("subject", string(event.Subject)).
+				Wrap(err)
+		}
+		if _, ok := encryptableContextTypes[ctxID.Type]; !ok {
+			return oops.Code("EVENTBUS_ENCRYPTION_REQUEST_INVALID").
+				With("subject", string(event.Subject)).
+				Errorf("context type %q cannot be encrypted at rest", ctxID.Type)
+		}
+		if err := RequestContextDEK(ctx, p.set, p.mgr, ctxID, nil); err != nil {
+			return err
+		}
+	}
+
+	promote, err := promoteSensitive(ctx, p.set, event)
+	if err != nil {
+		return err
+	}
+	if promote {
+		event.Sensitive = true
+	}
+	return p.next.Publish(ctx, event) //nolint:wrapcheck // transparent decorator: the inner publisher's error is the caller's
+}
+
+// requestsEncryptionAtRest reports whether event's JSON payload sets
+// PayloadKeyEncryptedAtRest to true.
+func requestsEncryptionAtRest(event Event) bool {
+	if !bytes.Contains(event.Payload, []byte(`"`+PayloadKeyEncryptedAtRest+`"`)) {
+		return false
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(event.Payload, &fields); err != nil {
+		return false
+	}
+	var requested bool
+	if err := json.Unmarshal(fields[PayloadKeyEncryptedAtRest], &requested); err != nil {
+		return false
+	}
+	return requested
+}
+
+// promoteSensitive reports whether event must take the DEK crypto branch
+// because its subject's context is encrypted at rest. Subjects that do not
+// name a DEK context (too few tokens) are never promoted.
+func promoteSensitive(ctx context.Context, ec EncryptedContexts, event Event) (bool, error) {
+	if event.Sensitive || ec == nil {
+		return false, nil
+	}
+	ctxID, err := contextIDFromSubject(event.Subject)
+	if err != nil {
+		return false, nil //nolint:nilerr // not a DEK context subject; nothing to promote
+	}
+	required, err := ec.RequiresEncryption(ctx, ctxID)
+	if err != nil {
+		return false, oops.Code("EVENTBUS_ENCRYPTION_POLICY_FAILED").
+			With("subject", string(event.Subject)).
+			Wrap(err)
+	}
+	return required, nil
+}
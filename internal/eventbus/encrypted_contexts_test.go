@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventbus/codec"
+	"github.com/holomush/holomush/internal/eventbus/crypto/dek"
+	"github.com/holomush/holomush/internal/eventbus/eventbustest"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+const privateSceneSubject = "events.main.scene.01HXXXSCENEID000000000"
+
+var privateSceneCtx = dek.ContextID{Type: "scene", ID: "01HXXXSCENEID000000000"}
+
+// erroringDEKManager fails every GetOrCreate.
+type erroringDEKManager struct{}
+
+func (erroringDEKManager) GetOrCreate(_ context.Context, _ dek.ContextID, _ []dek.Participant) (codec.Key, error) {
+	return codec.Key{}, errors.New("kek unavailable")
+}
+
+// erroringPolicy fails every RequiresEncryption lookup.
+type erroringPolicy struct{}
+
+func (erroringPolicy) RequiresEncryption(_ context.Context, _ dek.ContextID) (bool, error) {
+	return false, errors.New("policy store down")
+}
+
+// fakeEncryptedContextStore is an in-memory EncryptedContextStore that
+// counts lookups.
+type fakeEncryptedContextStore struct {
+	marked    map[dek.ContextID]bool
+	lookups   int
+	lookupErr error
+	markErr   error
+}
+
+func newFakeEncryptedContextStore() *fakeEncryptedContextStore {
+	return &fakeEncryptedContextStore{marked: make(map[dek.ContextID]bool)}
+}
+
+func (f *fakeEncryptedContextStore) IsEncrypted(_ context.Context, ctxID dek.ContextID) (bool, error) {
+	f.lookups++
+	return f.marked[ctxID], f.lookupErr
+}
+
+func (f *fakeEncryptedContextStore) MarkEncrypted(_ context.Context, ctxID dek.ContextID) error {
+	if f.markErr != nil {
+		return f.markErr
+	}
+	f.marked[ctxID] = true
+	return nil
+}
+
+// recordingPublisher records the events published through it.
+type recordingPublisher struct {
+	events []eventbus.Event
+}
+
+func (r *recordingPublisher) Publish(_ context.Context, event eventbus.Event) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func TestEncryptedContextSet(t *testing.T) {
+	t.Run("reports unknown context as not encrypted", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+		got, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.NoError(t, err)
+		assert.False(t, got)
+	})
+
+	t.Run("reports enabled context as encrypted", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+		require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+		got, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.NoError(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("persists enabled context before marking it", func(t *testing.T) {
+		store := newFakeEncryptedContextStore()
+		set := eventbus.NewEncryptedContextSet(store)
+		require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+		assert.True(t, store.marked[privateSceneCtx])
+
+		store.markErr = errors.New("db down")
+		other := dek.ContextID{Type: "scene", ID: "01HXXXOTHERSCENE0000000"}
+		require.Error(t, set.Enable(context.Background(), other))
+		store.markErr = nil
+		got, err := set.RequiresEncryption(context.Background(), other)
+		require.NoError(t, err)
+		assert.False(t, got, "a mark that failed to persist MUST NOT take effect")
+	})
+
+	t.Run("reads each context from the store once", func(t *testing.T) {
+		store := newFakeEncryptedContextStore()
+		store.marked[privateSceneCtx] = true
+		set := eventbus.NewEncryptedContextSet(store)
+		openScene := dek.ContextID{Type: "scene", ID: "01HXXXOPENSCENE00000000"}
+
+		for range 3 {
+			got, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+			require.NoError(t, err)
+			assert.True(t, got)
+			got, err = set.RequiresEncryption(context.Background(), openScene)
+			require.NoError(t, err)
+			assert.False(t, got)
+		}
+		assert.Equal(t, 2, store.lookups)
+	})
+
+	t.Run("does not cache a failed store read", func(t *testing.T) {
+		store := newFakeEncryptedContextStore()
+		store.lookupErr = errors.New("db down")
+		set := eventbus.NewEncryptedContextSet(store)
+
+		_, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.Error(t, err)
+
+		store.lookupErr = nil
+		store.marked[privateSceneCtx] = true
+		got, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.NoError(t, err)
+		assert.True(t, got)
+	})
+}
+
+func TestRequestContextDEK(t *testing.T) {
+	t.Run("marks the context encrypted after genesis", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+		mgr := newStubDEKManagerWithKey(t, codec.Key{ID: 1, Version: 1, Bytes: testKey32Bytes(t)})
+
+		require.NoError(t, eventbus.RequestContextDEK(context.Background(), set, mgr, privateSceneCtx, nil))
+
+		got, err := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.NoError(t, err)
+		assert.True(t, got)
+	})
+
+	t.Run("leaves the context unencrypted when genesis fails", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+
+		err := eventbus.RequestContextDEK(context.Background(), set, erroringDEKManager{}, privateSceneCtx, nil)
+		errutil.AssertErrorCode(t, err, "EVENTBUS_CONTEXT_DEK_REQUEST_FAILED")
+
+		got, lookupErr := set.RequiresEncryption(context.Background(), privateSceneCtx)
+		require.NoError(t, lookupErr)
+		assert.False(t, got)
+	})
+
+	t.Run("rejects a nil DEK manager", func(t *testing.T) {
+		err := eventbus.RequestContextDEK(context.Background(), eventbus.NewEncryptedContextSet(nil), nil, privateSceneCtx, nil)
+		errutil.AssertErrorCode(t, err, "EVENTBUS_CONTEXT_DEK_UNAVAILABLE")
+	})
+}
+
+func TestPublisherEncryptsNonSensitiveEventOnEncryptedContext(t *testing.T) {
+	mgr := newStubDEKManagerWithKey(t, codec.Key{ID: 7, Version: 2, Bytes: testKey32Bytes(t)})
+	set := eventbus.NewEncryptedContextSet(nil)
+	require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher(eventbus.WithDEKManager(mgr), eventbus.WithEncryptedContexts(set))
+
+	require.NoError(t, pub.Publish(context.Background(), goodEvent(privateSceneSubject)))
+	embedded.AwaitStreamLastSeq(t, 1, 0)
+
+	msgs := embedded.RawMessagesOnSubject(t, privateSceneSubject, 1, 0)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "xchacha20poly1305-v1", msgs[0].Header.Get(eventbus.HeaderCodec))
+	assert.Equal(t, "7", msgs[0].Header.Get(eventbus.HeaderDekRef))
+	assert.Equal(t, "2", msgs[0].Header.Get(eventbus.HeaderDekVersion))
+}
+
+func TestPublisherLeavesOtherContextsOnIdentityCodec(t *testing.T) {
+	mgr := newStubDEKManagerWithKey(t, codec.Key{ID: 7, Version: 2, Bytes: testKey32Bytes(t)})
+	set := eventbus.NewEncryptedContextSet(nil)
+	require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher(eventbus.WithDEKManager(mgr), eventbus.WithEncryptedContexts(set))
+
+	subject := "events.main.scene.01HXXXOPENSCENE00000000"
+	require.NoError(t, pub.Publish(context.Background(), goodEvent(eventbus.Subject(subject))))
+	embedded.AwaitStreamLastSeq(t, 1, 0)
+
+	msgs := embedded.RawMessagesOnSubject(t, subject, 1, 0)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "identity", msgs[0].Header.Get(eventbus.HeaderCodec))
+	assert.Empty(t, msgs[0].Header.Get(eventbus.HeaderDekRef))
+}
+
+func TestPublisherEncryptedContextWithoutDEKManagerFailsClosed(t *testing.T) {
+	set := eventbus.NewEncryptedContextSet(nil)
+	require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher(eventbus.WithEncryptedContexts(set))
+
+	err := pub.Publish(context.Background(), goodEvent(privateSceneSubject))
+	errutil.AssertErrorCode(t, err, "EVENTBUS_SENSITIVE_EVENT_NO_DEK_MANAGER")
+}
+
+func TestPublisherEncryptionPolicyErrorFailsPublish(t *testing.T) {
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher(eventbus.WithEncryptedContexts(erroringPolicy{}))
+
+	err := pub.Publish(context.Background(), goodEvent(privateSceneSubject))
+	errutil.AssertErrorCode(t, err, "EVENTBUS_ENCRYPTION_POLICY_FAILED")
+}
+
+func TestEncryptedContextPublisher(t *testing.T) {
+	key := codec.Key{ID: 1, Version: 1, Bytes: testKey32Bytes(t)}
+
+	t.Run("promotes events on an encrypted context before the next publisher", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+		require.NoError(t, set.Enable(context.Background(), privateSceneCtx))
+		next := &recordingPublisher{}
+		pub := eventbus.NewEncryptedContextPublisher(next, set, newStubDEKManagerWithKey(t, key))
+
+		require.NoError(t, pub.Publish(context.Background(), goodEvent(privateSceneSubject)))
+		require.NoError(t, pub.Publish(context.Background(), goodEvent("events.main.scene.01HXXXOPENSCENE00000000")))
+
+		require.Len(t, next.events, 2)
+		assert.True(t, next.events[0].Sensitive, "a tap behind this publisher MUST see the event as Sensitive")
+		assert.False(t, next.events[1].Sensitive)
+	})
+
+	t.Run("opts a scene in from its first event", func(t *testing.T) {
+		store := newFakeEncryptedContextStore()
+		set := eventbus.NewEncryptedContextSet(store)
+		next := &recordingPublisher{}
+		pub := eventbus.NewEncryptedContextPublisher(next, set, newStubDEKManagerWithKey(t, key))
+
+		created := goodEvent(privateSceneSubject)
+		created.Payload = []byte(`{"kind":"scene.lifecycle.created","encrypted_at_rest":true}`)
+		require.NoError(t, pub.Publish(context.Background(), created))
+
+		assert.True(t, store.marked[privateSceneCtx])
+		require.Len(t, next.events, 1)
+		assert.True(t, next.events[0].Sensitive, "the opt-in event itself MUST be encrypted")
+	})
+
+	t.Run("ignores a false opt-in", func(t *testing.T) {
+		store := newFakeEncryptedContextStore()
+		next := &recordingPublisher{}
+		pub := eventbus.NewEncryptedContextPublisher(next, eventbus.NewEncryptedContextSet(store), newStubDEKManagerWithKey(t, key))
+
+		created := goodEvent(privateSceneSubject)
+		created.Payload = []byte(`{"encrypted_at_rest":false}`)
+		require.NoError(t, pub.Publish(context.Background(), created))
+
+		assert.Empty(t, store.marked)
+		require.Len(t, next.events, 1)
+		assert.False(t, next.events[0].Sensitive)
+	})
+
+	t.Run("rejects an opt-in for a context without participants", func(t *testing.T) {
+		next := &recordingPublisher{}
+		pub := eventbus.NewEncryptedContextPublisher(next, eventbus.NewEncryptedContextSet(nil), newStubDEKManagerWithKey(t, key))
+
+		event := goodEvent("events.main.location.01HXXXLOCATION000000000")
+		event.Payload = []byte(`{"encrypted_at_rest":true}`)
+		err := pub.Publish(context.Background(), event)
+
+		errutil.AssertErrorCode(t, err, "EVENTBUS_ENCRYPTION_REQUEST_INVALID")
+		assert.Empty(t, next.events)
+	})
+
+	t.Run("fails the publish when the opt-in fails", func(t *testing.T) {
+		set := eventbus.NewEncryptedContextSet(nil)
+		next := &recordingPublisher{}
+		pub := eventbus.NewEncryptedContextPublisher(next, set, erroringDEKManager{})
+
+		created := goodEvent(privateSceneSubject)
+		created.Payload = []byte(`{"encrypted_at_rest":true}`)
+		err := pub.Publish(context.Background(), created)
+
+		errutil.AssertErrorCode(t, err, "EVENTBUS_CONTEXT_DEK_REQUEST_FAILED")
+		assert.Empty(t, next.events, "a private scene MUST NOT be announced in the clear")
+	})
+}
+
+func TestPayloadKeyEncryptedAtRestMatchesSDK(t *testing.T) {
+	assert.Equal(t, eventbus.PayloadKeyEncryptedAtRest, pluginsdk.PayloadKeyEncryptedAtRest)
+}
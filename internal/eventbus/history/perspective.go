@@ -0,0 +1,475 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+)
+
+// ActionReadPerspective is the ABAC action checked before Service.Query
+// replays history from a character's point of view. The seed policy
+// seed:character-perspective-self-or-staff grants it to the character
+// itself and to staff.
+const ActionReadPerspective = "read_perspective"
+
+// Perspective query bounds.
+const (
+	// DefaultPerspectiveLimit is the number of events Query returns when
+	// StreamRange.Limit is zero.
+	DefaultPerspectiveLimit = 100
+	// MaxPerspectiveLimit caps StreamRange.Limit.
+	MaxPerspectiveLimit = 1000
+	// DefaultPerspectiveLookback is how far before a range Query looks for
+	// the movement that put the character where they were when it starts.
+	DefaultPerspectiveLookback = 24 * time.Hour
+	// maxPerspectiveScan bounds the events read from any one stream while
+	// reconstructing a perspective.
+	maxPerspectiveScan = 10000
+)
+
+// Event types Query reads to reconstruct where a character was. Movement
+// between locations is recorded by the world outbox on the character's own
+// stream; scene membership by the core-scenes notices on the scene's IC
+// stream.
+const (
+	eventTypeCharacterMoved = "character_moved"
+	eventTypeSceneJoin      = "core-scenes:scene_join_ic"
+	eventTypeSceneLeave     = "core-scenes:scene_leave_ic"
+)
+
+// StreamRange selects the history Query replays: the events on Stream, a
+// domain-relative stream name such as "location.<id>", "character.<id>", or
+// "scene.<id>.ic", published between NotBefore and NotAfter. A zero bound is
+// open. Limit caps the events returned; zero means
+// DefaultPerspectiveLimit.
+type StreamRange struct {
+	Stream    string
+	NotBefore time.Time
+	NotAfter  time.Time
+	Limit     int
+}
+
+// PerspectiveOption configures a Service at construction.
+type PerspectiveOption func(*Service)
+
+// WithPerspectiveLookback replaces DefaultPerspectiveLookback. A character
+// whose last movement before a range is older than the lookback is taken to
+// be absent when the range starts.
+func WithPerspectiveLookback(d time.Duration) PerspectiveOption {
+	return func(s *Service) { s.lookback = d }
+}
+
+// WithPerspectiveLogger replaces slog.Default for access-denied warnings.
+func WithPerspectiveLogger(logger *slog.Logger) PerspectiveOption {
+	return func(s *Service) { s.logger = logger }
+}
+
+// Service replays history as one character perceived it, for recaps of
+// "what did my character see". Events are read through an
+// eventbus.HistoryReader (normally *Reader) and filtered by where the
+// character was at the moment each was published:
+//
+//   - a location's events are kept while the character was in the
+//     location, reconstructed from their arrive and leave events there and
+//     from the movement events on their own stream;
+//   - a scene's events are kept while the character was a member,
+//     reconstructed from the scene's join and leave notices;
+//   - the character's own stream, which carries whispers and pages sent to
+//     them, is kept whole, and no other character's stream is kept at all.
+//
+// Events the character caused are always kept.
+type Service struct {
+	reader   eventbus.HistoryReader
+	engine   types.AccessPolicyEngine
+	gameID   func() string
+	lookback time.Duration
+	logger   *slog.Logger
+}
+
+// NewService creates a perspective Service over reader. engine authorizes
+// each Query; gameID supplies the game id that qualifies stream names.
+func NewService(reader eventbus.HistoryReader, engine types.AccessPolicyEngine, gameID func() string, opts ...PerspectiveOption) (*Service, error) {
+	if reader == nil {
+		return nil, oops.Errorf("history reader is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if gameID == nil {
+		return nil, oops.Errorf("game id source is required")
+	}
+	s := &Service{
+		reader:   reader,
+		engine:   engine,
+		gameID:   gameID,
+		lookback: DefaultPerspectiveLookback,
+		logger:   slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Query returns the events in r that the character with charID could have
+// perceived, oldest first. subject is the ABAC subject asking (e.g.
+// access.CharacterSubject).
+//
+// Returns PERSPECTIVE_ACCESS_DENIED when subject may not read the
+// character's perspective, PERSPECTIVE_INVALID_RANGE for a malformed range,
+// and PERSPECTIVE_UNSUPPORTED_STREAM for a stream whose audience Query
+// cannot reconstruct.
+func (s *Service) Query(ctx context.Context, subject string, charID ulid.ULID, r StreamRange) ([]eventbus.Event, error) {
+	if err := s.checkAccess(ctx, subject, charID); err != nil {
+		return nil, err
+	}
+	if err := validateRange(r); err != nil {
+		return nil, err
+	}
+	limit := r.Limit
+	switch {
+	case limit <= 0:
+		limit = DefaultPerspectiveLimit
+	case limit > MaxPerspectiveLimit:
+		limit = MaxPerspectiveLimit
+	}
+
+	domain, id, facet := splitStream(r.Stream)
+	switch {
+	case domain == "character" && facet == "":
+		if id != charID.String() {
+			return []eventbus.Event{}, nil
+		}
+		events, err := s.read(ctx, charID, r.Stream, r.NotBefore, r.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+		return capEvents(events, limit), nil
+	case domain == "location" && facet == "":
+		return s.queryLocation(ctx, charID, id, r, limit)
+	case domain == "scene" && (facet == "ic" || facet == "ooc"):
+		return s.queryScene(ctx, charID, id, r, limit)
+	default:
+		return nil, oops.Code("PERSPECTIVE_UNSUPPORTED_STREAM").
+			With("stream", r.Stream).
+			Errorf("cannot reconstruct who perceived stream %q", r.Stream)
+	}
+}
+
+// queryLocation keeps the events on a location's stream published while
+// the character was there.
+func (s *Service) queryLocation(ctx context.Context, charID ulid.ULID, locationID string, r StreamRange, limit int) ([]eventbus.Event, error) {
+	from := s.lookbackStart(r.NotBefore)
+	located, err := s.read(ctx, charID, r.Stream, from, r.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	moves, err := s.read(ctx, charID, "character."+charID.String(), from, r.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	var tl timeline
+	for _, ev := range located {
+		if in, ok := locationPresenceChange(ev, charID, locationID); ok {
+			tl.add(ev.Timestamp, in)
+		}
+	}
+	for _, ev := range moves {
+		if in, ok := movementChange(ev, charID, locationID); ok {
+			tl.add(ev.Timestamp, in)
+		}
+	}
+	return filterPerceived(located, &tl, charID, r.NotBefore, limit), nil
+}
+
+// queryScene keeps the events on a scene's stream published while the
+// character was a member. Membership is read from the IC stream, which
+// carries the join and leave notices for both facets.
+func (s *Service) queryScene(ctx context.Context, charID ulid.ULID, sceneID string, r StreamRange, limit int) ([]eventbus.Event, error) {
+	from := s.lookbackStart(r.NotBefore)
+	icStream := "scene." + sceneID + ".ic"
+	notices, err := s.read(ctx, charID, icStream, from, r.NotAfter)
+	if err != nil {
+		return nil, err
+	}
+	var tl timeline
+	for _, ev := range notices {
+		if in, ok := sceneMembershipChange(ev, charID); ok {
+			tl.add(ev.Timestamp, in)
+		}
+	}
+
+	events := notices
+	if r.Stream != icStream {
+		if events, err = s.read(ctx, charID, r.Stream, from, r.NotAfter); err != nil {
+			return nil, err
+		}
+	}
+	return filterPerceived(events, &tl, charID, r.NotBefore, limit), nil
+}
+
+// lookbackStart returns where to start reading movement for a range
+// starting at notBefore; an open range reads from the beginning.
+func (s *Service) lookbackStart(notBefore time.Time) time.Time {
+	if notBefore.IsZero() {
+		return time.Time{}
+	}
+	return notBefore.Add(-s.lookback)
+}
+
+// read returns the events on stream between notBefore and notAfter, oldest
+// first, read on the character's behalf. At most maxPerspectiveScan events
+// are read.
+func (s *Service) read(ctx context.Context, charID ulid.ULID, stream string, notBefore, notAfter time.Time) ([]eventbus.Event, error) {
+	gameID := s.gameID()
+	if gameID == "" {
+		gameID = "main"
+	}
+	subject, err := eventbus.Qualify(gameID, stream)
+	if err != nil {
+		return nil, oops.Code("PERSPECTIVE_INVALID_RANGE").With("stream", stream).Wrap(err)
+	}
+	hs, err := s.reader.QueryHistory(ctx, eventbus.HistoryQuery{
+		Subject:   subject,
+		NotBefore: notBefore,
+		NotAfter:  notAfter,
+		Direction: eventbus.DirectionForward,
+		PageSize:  MaxPageSize,
+		Caller:    eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: charID},
+		Identity:  eventbus.SessionIdentity{Kind: eventbus.IdentityKindCharacter, CharacterID: charID.String()},
+	})
+	if err != nil {
+		return nil, oops.Code("PERSPECTIVE_READ_FAILED").With("stream", stream).Wrap(err)
+	}
+	defer func() {
+		if cerr := hs.Close(); cerr != nil {
+			s.logger.DebugContext(ctx, "perspective: closing history stream", "stream", stream, "error", cerr)
+		}
+	}()
+
+	var events []eventbus.Event
+	for len(events) < maxPerspectiveScan {
+		ev, err := hs.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, oops.Code("PERSPECTIVE_READ_FAILED").With("stream", stream).Wrap(err)
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// checkAccess evaluates read_perspective on charID for subject. It fails
+// closed: engine errors deny.
+func (s *Service) checkAccess(ctx context.Context, subject string, charID ulid.ULID) error {
+	resource := access.CharacterResource(charID.String())
+	req, err := types.NewAccessRequest(subject, ActionReadPerspective, resource, nil)
+	if err != nil {
+		return oops.Code("PERSPECTIVE_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("PERSPECTIVE_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(ctx, "perspective history access denied",
+			"event", "perspective_access_denied",
+			"subject", subject,
+			"character_id", charID.String(),
+			"reason", decision.Reason(),
+		)
+		return oops.Code("PERSPECTIVE_ACCESS_DENIED").
+			With("character_id", charID.String()).
+			Errorf("not permitted to view this character's history")
+	}
+	return nil
+}
+
+func validateRange(r StreamRange) error {
+	if r.Stream == "" {
+		return oops.Code("PERSPECTIVE_INVALID_RANGE").Errorf("stream is required")
+	}
+	if !r.NotBefore.IsZero() && !r.NotAfter.IsZero() && r.NotBefore.After(r.NotAfter) {
+		return oops.Code("PERSPECTIVE_INVALID_RANGE").
+			With("not_before", r.NotBefore).
+			With("not_after", r.NotAfter).
+			Errorf("range starts after it ends")
+	}
+	return nil
+}
+
+// splitStream splits a domain-relative stream name into its domain, id,
+// and optional facet: "scene.<id>.ic" is ("scene", "<id>", "ic").
+func splitStream(stream string) (domain, id, facet string) {
+	parts := strings.SplitN(stream, ".", 3)
+	switch len(parts) {
+	case 3:
+		return parts[0], parts[1], parts[2]
+	case 2:
+		return parts[0], parts[1], ""
+	default:
+		return stream, "", ""
+	}
+}
+
+// filterPerceived keeps the events at or after notBefore that the
+// character caused or that were published while tl has them present, up
+// to limit.
+func filterPerceived(events []eventbus.Event, tl *timeline, charID ulid.ULID, notBefore time.Time, limit int) []eventbus.Event {
+	tl.sort()
+	out := make([]eventbus.Event, 0, min(len(events), limit))
+	for _, ev := range events {
+		if len(out) == limit {
+			break
+		}
+		if ev.Timestamp.Before(notBefore) {
+			continue
+		}
+		if ev.Actor.ID == charID || tl.present(ev.Timestamp) {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
+
+func capEvents(events []eventbus.Event, limit int) []eventbus.Event {
+	if len(events) > limit {
+		return events[:limit]
+	}
+	if events == nil {
+		return []eventbus.Event{}
+	}
+	return events
+}
+
+// timeline records when a character entered and left one place.
+type timeline struct {
+	changes []presenceChange
+}
+
+type presenceChange struct {
+	at time.Time
+	in bool
+}
+
+func (t *timeline) add(at time.Time, in bool) {
+	t.changes = append(t.changes, presenceChange{at: at, in: in})
+}
+
+func (t *timeline) sort() {
+	sort.SliceStable(t.changes, func(i, j int) bool { return t.changes[i].at.Before(t.changes[j].at) })
+}
+
+// present reports whether the character was there at the instant at: the
+// latest change at or before it put them there. Before any change they
+// were absent.
+func (t *timeline) present(at time.Time) bool {
+	i := sort.Search(len(t.changes), func(i int) bool { return t.changes[i].at.After(at) })
+	return i > 0 && t.changes[i-1].in
+}
+
+// locationPresenceChange reports how an event on a location's stream
+// changed the character's presence there: connecting in the location and
+// arriving by walking put them there, leaving takes them away.
+func locationPresenceChange(ev eventbus.Event, charID ulid.ULID, locationID string) (in, ok bool) {
+	switch eventvocab.EventType(ev.Type) {
+	case eventvocab.EventTypeArrive:
+		return true, ev.Actor.ID == charID
+	case eventvocab.EventTypeLeave:
+		return false, ev.Actor.ID == charID
+	case eventvocab.EventTypeTraversalArrive:
+		var p eventvocab.TraversalPayload
+		if json.Unmarshal(ev.Payload, &p) != nil || p.CharacterID != charID.String() {
+			return false, false
+		}
+		return p.ToLocationID == locationID, true
+	}
+	return false, false
+}
+
+// movePayload is the subset of a move event's payload (world.MovePayload)
+// that places a character.
+type movePayload struct {
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	ToType     string `json:"to_type"`
+	ToID       string `json:"to_id"`
+}
+
+// characterMovedEnvelope is the subset of the world outbox envelope for a
+// character_moved event that places the character.
+type characterMovedEnvelope struct {
+	Payload struct {
+		CharacterID  string `json:"character_id"`
+		ToLocationID string `json:"to_location_id"`
+	} `json:"payload"`
+}
+
+// movementChange reports how a movement event on the character's stream
+// changed their presence in the location: moving there puts them there,
+// moving anywhere else takes them away.
+func movementChange(ev eventbus.Event, charID ulid.ULID, locationID string) (in, ok bool) {
+	switch string(ev.Type) {
+	case string(eventvocab.EventTypeMove):
+		var p movePayload
+		if json.Unmarshal(ev.Payload, &p) != nil || p.EntityType != "character" || p.EntityID != charID.String() {
+			return false, false
+		}
+		return p.ToType == "location" && p.ToID == locationID, true
+	case eventTypeCharacterMoved:
+		var env characterMovedEnvelope
+		if json.Unmarshal(ev.Payload, &env) != nil || env.Payload.CharacterID != charID.String() {
+			return false, false
+		}
+		return env.Payload.ToLocationID == locationID, true
+	}
+	return false, false
+}
+
+// sceneNotice is the subset of a scene join or leave notice naming the
+// member.
+type sceneNotice struct {
+	ActorID string `json:"actor_id"`
+}
+
+// sceneMembershipChange reports how a scene notice changed the
+// character's membership.
+func sceneMembershipChange(ev eventbus.Event, charID ulid.ULID) (in, ok bool) {
+	switch string(ev.Type) {
+	case eventTypeSceneJoin:
+		in = true
+	case eventTypeSceneLeave:
+	default:
+		return false, false
+	}
+	var p sceneNotice
+	if json.Unmarshal(ev.Payload, &p) != nil {
+		return false, false
+	}
+	if strings.TrimPrefix(p.ActorID, access.SubjectCharacter) != charID.String() {
+		return false, false
+	}
+	return in, true
+}
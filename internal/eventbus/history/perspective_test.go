@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// sliceHistory is an eventbus.HistoryReader over a fixed event log.
+type sliceHistory struct {
+	events  []eventbus.Event
+	queries []eventbus.HistoryQuery
+}
+
+func (h *sliceHistory) QueryHistory(_ context.Context, q eventbus.HistoryQuery) (eventbus.HistoryStream, error) {
+	h.queries = append(h.queries, q)
+	var matched []eventbus.Event
+	for _, ev := range h.events {
+		if ev.Subject != q.Subject {
+			continue
+		}
+		if !q.NotBefore.IsZero() && ev.Timestamp.Before(q.NotBefore) {
+			continue
+		}
+		if !q.NotAfter.IsZero() && ev.Timestamp.After(q.NotAfter) {
+			continue
+		}
+		matched = append(matched, ev)
+	}
+	return &sliceStream{events: matched}, nil
+}
+
+type sliceStream struct {
+	events []eventbus.Event
+}
+
+func (s *sliceStream) Next(context.Context) (eventbus.Event, error) {
+	if len(s.events) == 0 {
+		return eventbus.Event{}, io.EOF
+	}
+	ev := s.events[0]
+	s.events = s.events[1:]
+	return ev, nil
+}
+
+func (s *sliceStream) Close() error { return nil }
+
+// perspectiveLog builds a time-ordered event log one minute apart.
+type perspectiveLog struct {
+	t      *testing.T
+	next   time.Time
+	events []eventbus.Event
+}
+
+func newPerspectiveLog(t *testing.T) *perspectiveLog {
+	return &perspectiveLog{t: t, next: time.Date(2026, 10, 18, 20, 0, 0, 0, time.UTC)}
+}
+
+func (l *perspectiveLog) add(stream, typ string, actor ulid.ULID, payload any) eventbus.Event {
+	l.t.Helper()
+	sub, err := eventbus.Qualify("main", stream)
+	require.NoError(l.t, err)
+	raw, err := json.Marshal(payload)
+	require.NoError(l.t, err)
+	ev := eventbus.Event{
+		ID:        ulid.Make(),
+		Subject:   sub,
+		Type:      eventbus.Type(typ),
+		Timestamp: l.next,
+		Actor:     eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: actor},
+		Payload:   raw,
+	}
+	l.next = l.next.Add(time.Minute)
+	l.events = append(l.events, ev)
+	return ev
+}
+
+func (l *perspectiveLog) say(stream string, actor ulid.ULID, text string) eventbus.Event {
+	return l.add(stream, "core-communication:say", actor, map[string]string{"text": text})
+}
+
+func (l *perspectiveLog) move(charID ulid.ULID, to string) eventbus.Event {
+	return l.add("character."+charID.String(), string(eventvocab.EventTypeMove), ulid.ULID{}, map[string]string{
+		"entity_type": "character",
+		"entity_id":   charID.String(),
+		"to_type":     "location",
+		"to_id":       to,
+	})
+}
+
+func newPerspectiveService(t *testing.T, log *perspectiveLog, grants ...[3]string) (*Service, *sliceHistory) {
+	t.Helper()
+	engine := policytest.NewGrantEngine()
+	for _, g := range grants {
+		engine.Grant(g[0], g[1], g[2])
+	}
+	reader := &sliceHistory{events: log.events}
+	svc, err := NewService(reader, engine, func() string { return "main" })
+	require.NoError(t, err)
+	return svc, reader
+}
+
+func selfGrant(charID ulid.ULID) [3]string {
+	return [3]string{access.CharacterSubject(charID.String()), ActionReadPerspective, access.CharacterResource(charID.String())}
+}
+
+func texts(t *testing.T, events []eventbus.Event) []string {
+	t.Helper()
+	out := make([]string, 0, len(events))
+	for _, ev := range events {
+		var p map[string]string
+		if json.Unmarshal(ev.Payload, &p) == nil && p["text"] != "" {
+			out = append(out, p["text"])
+			continue
+		}
+		out = append(out, string(ev.Type))
+	}
+	return out
+}
+
+func TestPerspectiveLocationFollowsMovement(t *testing.T) {
+	alice, bob := ulid.Make(), ulid.Make()
+	tavern, street := ulid.Make().String(), ulid.Make().String()
+	room := "location." + tavern
+
+	log := newPerspectiveLog(t)
+	log.say(room, bob, "before anyone came")
+	log.add(room, string(eventvocab.EventTypeArrive), alice, map[string]string{"character_name": "Alice"})
+	log.say(room, bob, "welcome, Alice")
+	log.move(alice, street)
+	log.say(room, bob, "she left")
+	log.add(room, string(eventvocab.EventTypeTraversalArrive), alice, eventvocab.TraversalPayload{
+		CharacterID: alice.String(), FromLocationID: street, ToLocationID: tavern,
+	})
+	log.say(room, bob, "back again")
+	log.add(room, string(eventvocab.EventTypeLeave), alice, map[string]string{"reason": "quit"})
+	log.say(room, bob, "after she logged out")
+
+	svc, _ := newPerspectiveService(t, log, selfGrant(alice))
+	events, err := svc.Query(context.Background(), access.CharacterSubject(alice.String()), alice, StreamRange{Stream: room})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"arrive", "welcome, Alice", "traversal_arrive", "back again", "leave"}, texts(t, events))
+}
+
+func TestPerspectiveRangeStartUsesEarlierMovement(t *testing.T) {
+	alice, bob := ulid.Make(), ulid.Make()
+	tavern := ulid.Make().String()
+	room := "location." + tavern
+
+	log := newPerspectiveLog(t)
+	log.move(alice, tavern)
+	log.say(room, bob, "too early")
+	from := log.next
+	log.say(room, bob, "in range")
+
+	svc, reader := newPerspectiveService(t, log, selfGrant(alice))
+	events, err := svc.Query(context.Background(), access.CharacterSubject(alice.String()), alice, StreamRange{Stream: room, NotBefore: from})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"in range"}, texts(t, events))
+
+	require.NotEmpty(t, reader.queries)
+	assert.Equal(t, from.Add(-DefaultPerspectiveLookback), reader.queries[0].NotBefore, "movement is read from before the range")
+	assert.Equal(t, alice, reader.queries[0].Caller.ID)
+}
+
+func TestPerspectiveLimit(t *testing.T) {
+	alice, bob := ulid.Make(), ulid.Make()
+	room := "location." + ulid.Make().String()
+
+	log := newPerspectiveLog(t)
+	log.add(room, string(eventvocab.EventTypeArrive), alice, map[string]string{})
+	for range 5 {
+		log.say(room, bob, "chatter")
+	}
+
+	svc, _ := newPerspectiveService(t, log, selfGrant(alice))
+	events, err := svc.Query(context.Background(), access.CharacterSubject(alice.String()), alice, StreamRange{Stream: room, Limit: 3})
+	require.NoError(t, err)
+	assert.Len(t, events, 3)
+}
+
+func TestPerspectiveCharacterStreams(t *testing.T) {
+	alice, bob := ulid.Make(), ulid.Make()
+
+	log := newPerspectiveLog(t)
+	log.add("character."+alice.String(), "core-communication:whisper", bob, map[string]string{"text": "psst, Alice"})
+	log.add("character."+bob.String(), "core-communication:whisper", alice, map[string]string{"text": "psst, Bob"})
+
+	svc, _ := newPerspectiveService(t, log, selfGrant(alice))
+	ctx := context.Background()
+	subject := access.CharacterSubject(alice.String())
+
+	events, err := svc.Query(ctx, subject, alice, StreamRange{Stream: "character." + alice.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"psst, Alice"}, texts(t, events))
+
+	events, err = svc.Query(ctx, subject, alice, StreamRange{Stream: "character." + bob.String()})
+	require.NoError(t, err)
+	assert.Empty(t, events, "another character's stream was never perceived")
+}
+
+func TestPerspectiveSceneMembership(t *testing.T) {
+	alice, bob := ulid.Make(), ulid.Make()
+	scene := ulid.Make().String()
+	ic, ooc := "scene."+scene+".ic", "scene."+scene+".ooc"
+
+	log := newPerspectiveLog(t)
+	log.say(ic, bob, "opening pose")
+	log.add(ic, eventTypeSceneJoin, alice, map[string]string{"actor_id": alice.String(), "scene_id": scene})
+	log.say(ic, bob, "mid-scene pose")
+	log.say(ooc, bob, "brb")
+	log.add(ic, eventTypeSceneLeave, alice, map[string]string{"actor_id": alice.String(), "scene_id": scene, "reason": "left"})
+	log.say(ic, bob, "closing pose")
+	log.say(ooc, bob, "gg")
+
+	svc, _ := newPerspectiveService(t, log, selfGrant(alice))
+	ctx := context.Background()
+	subject := access.CharacterSubject(alice.String())
+
+	events, err := svc.Query(ctx, subject, alice, StreamRange{Stream: ic})
+	require.NoError(t, err)
+	assert.Equal(t, []string{eventTypeSceneJoin, "mid-scene pose", eventTypeSceneLeave}, texts(t, events))
+
+	events, err = svc.Query(ctx, subject, alice, StreamRange{Stream: ooc})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"brb"}, texts(t, events))
+}
+
+func TestPerspectiveAccessAndValidation(t *testing.T) {
+	alice, staff := ulid.Make(), ulid.Make()
+	room := "location." + ulid.Make().String()
+	log := newPerspectiveLog(t)
+	svc, _ := newPerspectiveService(t, log, selfGrant(alice),
+		[3]string{access.CharacterSubject(staff.String()), ActionReadPerspective, access.CharacterResource(alice.String())})
+	ctx := context.Background()
+
+	_, err := svc.Query(ctx, access.CharacterSubject(ulid.Make().String()), alice, StreamRange{Stream: room})
+	errutil.AssertErrorCode(t, err, "PERSPECTIVE_ACCESS_DENIED")
+
+	_, err = svc.Query(ctx, access.CharacterSubject(staff.String()), alice, StreamRange{Stream: room})
+	require.NoError(t, err, "staff may recap another character")
+
+	subject := access.CharacterSubject(alice.String())
+	_, err = svc.Query(ctx, subject, alice, StreamRange{})
+	errutil.AssertErrorCode(t, err, "PERSPECTIVE_INVALID_RANGE")
+	now := time.Now()
+	_, err = svc.Query(ctx, subject, alice, StreamRange{Stream: room, NotBefore: now, NotAfter: now.Add(-time.Hour)})
+	errutil.AssertErrorCode(t, err, "PERSPECTIVE_INVALID_RANGE")
+	_, err = svc.Query(ctx, subject, alice, StreamRange{Stream: "channel.public"})
+	errutil.AssertErrorCode(t, err, "PERSPECTIVE_UNSUPPORTED_STREAM")
+}
+
+func TestNewPerspectiveServiceRequiresDependencies(t *testing.T) {
+	engine := policytest.NewGrantEngine()
+	gameID := func() string { return "main" }
+
+	_, err := NewService(nil, engine, gameID)
+	require.Error(t, err)
+	_, err = NewService(&sliceHistory{}, nil, gameID)
+	require.Error(t, err)
+	_, err = NewService(&sliceHistory{}, engine, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// latencyBuckets spans 100µs .. ~6.5s. The embedded server stores and
+// delivers in well under a millisecond when idle, so the low buckets carry
+// the detail; the high ones catch stalls.
+var latencyBuckets = prometheus.ExponentialBuckets(0.0001, 2, 17)
+
+// PublishDuration records how long a successful Publish took, from entry
+// until JetStream acknowledged the event as stored: validation, encoding,
+// optional encryption, and the publish round trip (the emit → store leg of
+// the event path).
+var PublishDuration = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Namespace: "holomush",
+		Subsystem: "eventbus",
+		Name:      "publish_duration_seconds",
+		Help:      "Time from Publish until JetStream acknowledged the event as stored.",
+		Buckets:   latencyBuckets,
+	},
+)
+
+// DeliveryLatency records, for every event a session stream hands to its
+// consumer, the time since JetStream stored it (the store → fan-out →
+// deliver leg of the event path). Events replayed to a reconnecting
+// session were stored long ago and land in the top buckets.
+var DeliveryLatency = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Namespace: "holomush",
+		Subsystem: "eventbus",
+		Name:      "delivery_latency_seconds",
+		Help:      "Time from JetStream storing an event until a session stream delivered it.",
+		Buckets:   latencyBuckets,
+	},
+)
+
+// observeDeliveryLatency records msg's store → deliver latency. Messages
+// without JetStream metadata are skipped.
+func observeDeliveryLatency(msg jetstream.Msg) {
+	meta, err := msg.Metadata()
+	if err != nil || meta == nil || meta.Timestamp.IsZero() {
+		return
+	}
+	DeliveryLatency.Observe(time.Since(meta.Timestamp).Seconds())
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventbus/eventbustest"
+)
+
+func histogramSampleCount(t *testing.T, h prometheus.Histogram) uint64 {
+	t.Helper()
+	var pb dto.Metric
+	require.NoError(t, h.Write(&pb))
+	return pb.GetHistogram().GetSampleCount()
+}
+
+func TestEventPathHistogramsObservePublishAndDelivery(t *testing.T) {
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher()
+	sub := embedded.Bus.Subscriber()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	subject := eventbus.Subject("events.main.location.metrics")
+	stream, err := sub.OpenSession(ctx, freshSessionID(), testIdentity(), []eventbus.Subject{subject}, time.Time{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = stream.Close() })
+
+	published := histogramSampleCount(t, eventbus.PublishDuration)
+	delivered := histogramSampleCount(t, eventbus.DeliveryLatency)
+
+	require.NoError(t, pub.Publish(ctx, newTestEnvelope(subject, []byte("hello"))))
+	assert.Equal(t, published+1, histogramSampleCount(t, eventbus.PublishDuration))
+
+	d, err := stream.Next(ctx)
+	require.NoError(t, err)
+	require.NoError(t, d.Ack())
+	assert.Equal(t, delivered+1, histogramSampleCount(t, eventbus.DeliveryLatency))
+}
+
+func TestPublishDurationSkipsRejectedEvents(t *testing.T) {
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher()
+
+	before := histogramSampleCount(t, eventbus.PublishDuration)
+	evt := newTestEnvelope("events.main.location.metrics", []byte("x"))
+	evt.Type = ""
+	require.Error(t, pub.Publish(context.Background(), evt))
+	assert.Equal(t, before, histogramSampleCount(t, eventbus.PublishDuration))
+}
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/samber/oops"
+)
+
+// MaxConfigurablePayloadSize caps PayloadConfig limits. NATS rejects messages
+// over its max_payload (1 MiB by default) and the payload shares that budget
+// with the proto envelope and headers, so limits stop short of it.
+const MaxConfigurablePayloadSize = 960 * 1024
+
+// Payload compression modes for PayloadConfig.Compression.
+const (
+	// PayloadCompressionNone stores EVENTS stream blocks uncompressed.
+	PayloadCompressionNone = "none"
+	// PayloadCompressionS2 stores EVENTS stream blocks S2-compressed.
+	PayloadCompressionS2 = "s2"
+)
+
+// PayloadConfig bounds event payload sizes and selects how the EVENTS
+// stream stores them.
+type PayloadConfig struct {
+	// MaxSize is the payload limit in bytes for event types without an
+	// override. Zero resolves to MaxPayloadSize.
+	MaxSize int `koanf:"max_size"`
+	// MaxSizeByType overrides MaxSize per event type (e.g. "say": 4096), so
+	// chat-style events can be held far below the global limit.
+	MaxSizeByType map[string]int `koanf:"max_size_by_type"`
+	// Compression selects JetStream stream compression ("none" or "s2").
+	// Compression is transparent: publishers and consumers see the same
+	// bytes, and large payloads take less space on disk. Empty means none.
+	// Memory-storage streams ignore it.
+	Compression string `koanf:"compression"`
+}
+
+// Limit returns the payload limit in bytes for events of type t.
+func (c PayloadConfig) Limit(t Type) int {
+	if limit, ok := c.MaxSizeByType[string(t)]; ok && limit > 0 {
+		return limit
+	}
+	if c.MaxSize > 0 {
+		return c.MaxSize
+	}
+	return MaxPayloadSize
+}
+
+// Check returns a *PayloadTooLargeError when payload exceeds the limit for
+// events of type t, and nil otherwise. Rejections are counted in
+// PayloadRejectedTotal.
+func (c PayloadConfig) Check(t Type, payload []byte) error {
+	limit := c.Limit(t)
+	if len(payload) <= limit {
+		return nil
+	}
+	PayloadRejectedTotal.WithLabelValues(string(t)).Inc()
+	return &PayloadTooLargeError{Type: t, Size: len(payload), Limit: limit}
+}
+
+// validate rejects limits that are negative or exceed
+// MaxConfigurablePayloadSize, and unknown compression modes.
+func (c PayloadConfig) validate() error {
+	if err := validatePayloadLimit("max_size", c.MaxSize); err != nil {
+		return err
+	}
+	for typ, limit := range c.MaxSizeByType {
+		if err := validatePayloadLimit("max_size_by_type."+typ, limit); err != nil {
+			return err
+		}
+	}
+	switch c.Compression {
+	case "", PayloadCompressionNone, PayloadCompressionS2:
+		return nil
+	default:
+		return oops.Code("EVENTBUS_CONFIG_INVALID").
+			With("compression", c.Compression).
+			Errorf("event_bus payload compression %q is not recognized (none|s2)", c.Compression)
+	}
+}
+
+func validatePayloadLimit(field string, limit int) error {
+	if limit < 0 || limit > MaxConfigurablePayloadSize {
+		return oops.Code("EVENTBUS_CONFIG_INVALID").
+			With("field", "payload."+field).
+			With("value", limit).
+			Errorf("event_bus payload.%s must be between 0 and %d bytes", field, MaxConfigurablePayloadSize)
+	}
+	return nil
+}
+
+// streamCompression maps Compression onto the JetStream stream setting.
+func (c PayloadConfig) streamCompression() jetstream.StoreCompression {
+	if c.Compression == PayloadCompressionS2 {
+		return jetstream.S2Compression
+	}
+	return jetstream.NoCompression
+}
+
+// PayloadTooLargeError reports an event payload over its configured limit.
+// It matches ErrPayloadTooLarge via errors.Is; use errors.As to read the
+// offending size.
+type PayloadTooLargeError struct {
+	Type  Type
+	Size  int
+	Limit int
+}
+
+// Error implements error.
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("eventbus: %s payload is %d bytes, limit is %d", e.Type, e.Size, e.Limit)
+}
+
+// Unwrap returns ErrPayloadTooLarge.
+func (e *PayloadTooLargeError) Unwrap() error { return ErrPayloadTooLarge }
+
+// PayloadSizeBytes records the payload size of every event that passes the
+// size check on the publish path.
+var PayloadSizeBytes = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Namespace: "holomush",
+		Subsystem: "eventbus",
+		Name:      "payload_size_bytes",
+		Help:      "Size of published event payloads in bytes.",
+		// 64 B .. 1 MiB.
+		Buckets: prometheus.ExponentialBuckets(64, 4, 8),
+	},
+)
+
+// PayloadRejectedTotal counts events rejected for exceeding their payload
+// limit, by event type.
+var PayloadRejectedTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "holomush",
+		Subsystem: "eventbus",
+		Name:      "payload_rejected_total",
+		Help:      "Events rejected because their payload exceeded the configured size limit.",
+	},
+	[]string{"event_type"},
+)
+
+// RegisterMetrics registers eventbus Prometheus collectors with reg.
+// Duplicate registrations are silently ignored; other registration
+// errors panic. Matches the pattern used by internal/lifecycle/metrics.go.
+func RegisterMetrics(reg prometheus.Registerer) {
+	for _, c := range []prometheus.Collector{PayloadSizeBytes, PayloadRejectedTotal, PublishDuration, DeliveryLatency} {
+		if err := reg.Register(c); err != nil {
+			var are prometheus.AlreadyRegisteredError
+			if errors.As(err, &are) {
+				_ = are // already registered — no-op
+			} else {
+				panic(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus_test
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestPayloadLimitDefaultsToMaxPayloadSize(t *testing.T) {
+	assert.Equal(t, eventbus.MaxPayloadSize, eventbus.PayloadConfig{}.Limit("say"))
+}
+
+func TestPayloadLimitPrefersPerTypeOverride(t *testing.T) {
+	cfg := eventbus.PayloadConfig{MaxSize: 8192, MaxSizeByType: map[string]int{"say": 1024}}
+	assert.Equal(t, 1024, cfg.Limit("say"))
+	assert.Equal(t, 8192, cfg.Limit("pose"))
+}
+
+func TestPayloadCheckReturnsTypedError(t *testing.T) {
+	cfg := eventbus.PayloadConfig{MaxSizeByType: map[string]int{"say": 16}}
+	require.NoError(t, cfg.Check("say", make([]byte, 16)))
+
+	before := testutil.ToFloat64(eventbus.PayloadRejectedTotal.WithLabelValues("say"))
+	err := cfg.Check("say", make([]byte, 17))
+	require.ErrorIs(t, err, eventbus.ErrPayloadTooLarge)
+
+	var tooLarge *eventbus.PayloadTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, eventbus.Type("say"), tooLarge.Type)
+	assert.Equal(t, 17, tooLarge.Size)
+	assert.Equal(t, 16, tooLarge.Limit)
+	assert.Equal(t, before+1, testutil.ToFloat64(eventbus.PayloadRejectedTotal.WithLabelValues("say")))
+}
+
+func TestConfigValidateRejectsBadPayloadLimits(t *testing.T) {
+	for name, payload := range map[string]eventbus.PayloadConfig{
+		"negative max":      {MaxSize: -1},
+		"max above cap":     {MaxSize: eventbus.MaxConfigurablePayloadSize + 1},
+		"negative per type": {MaxSizeByType: map[string]int{"say": -5}},
+		"unknown codec":     {Compression: "gzip"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			errutil.AssertErrorCode(t, eventbus.Config{Payload: payload}.Validate(), "EVENTBUS_CONFIG_INVALID")
+		})
+	}
+}
+
+func TestConfigValidateAcceptsPayloadConfig(t *testing.T) {
+	cfg := eventbus.Config{Payload: eventbus.PayloadConfig{
+		MaxSize:       eventbus.MaxConfigurablePayloadSize,
+		MaxSizeByType: map[string]int{"say": 4096},
+		Compression:   eventbus.PayloadCompressionS2,
+	}}
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestRegisterMetricsIsIdempotent(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	eventbus.RegisterMetrics(reg)
+	assert.NotPanics(t, func() { eventbus.RegisterMetrics(reg) })
+}
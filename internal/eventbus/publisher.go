@@ -21,6 +21,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/eventbus/codec"
 	"github.com/holomush/holomush/internal/eventbus/crypto/aad"
 	"github.com/holomush/holomush/internal/eventbus/crypto/dek"
@@ -47,6 +48,11 @@ const (
 	HeaderActorKind = "App-Actor-Kind"
 	// HeaderActorID is the (optional) actor id, set only when non-zero.
 	HeaderActorID = "App-Actor-ID"
+	// HeaderImpersonatorID is the staff player acting through an
+	// impersonation session, set only when the publishing context carries
+	// one (core.WithImpersonator). The actor stays the impersonated
+	// identity; this header adds the second one.
+	HeaderImpersonatorID = "App-Impersonator-ID"
 	// HeaderDekRef carries the crypto_keys.id (decimal string) for events
 	// encrypted with a non-identity codec. Empty for codec=identity. Maps
 	// 1:1 to events_audit.dek_ref (BIGINT) via the audit projection.
@@ -138,6 +144,12 @@ type JetStreamPublisher struct {
 	// WithDEKManager; bootstrap supplies it when a KEK is configured
 	// (RekeyManager present) — not gated on CryptoConfig.Enabled.
 	dekMgr DEKManager
+
+	// encrypted promotes every event on an opted-in context (a private
+	// scene) to Sensitive. nil → only per-event Sensitive flags encrypt.
+	// Wired via WithEncryptedContexts; EncryptedContextPublisher applies
+	// the same promotion earlier in the publisher chain.
+	encrypted EncryptedContexts
 }
 
 // NewJetStreamPublisher constructs a Publisher backed by the given JetStream
@@ -159,6 +171,7 @@ func NewJetStreamPublisher(js jetstream.JetStream, cfg Config, opts ...PublishOp
 // Publish implements Publisher. See JetStreamPublisher for the invariants
 // it enforces.
 func (p *JetStreamPublisher) Publish(ctx context.Context, event Event) error {
+	start := time.Now()
 	if p.js == nil {
 		return oops.Code("EVENTBUS_PUBLISHER_NOT_READY").Errorf("JetStream context is nil")
 	}
@@ -174,11 +187,21 @@ func (p *JetStreamPublisher) Publish(ctx context.Context, event Event) error {
 	if _, err := NewType(string(event.Type)); err != nil {
 		return err
 	}
-	if len(event.Payload) > MaxPayloadSize {
+	if err := p.cfg.Payload.Check(event.Type, event.Payload); err != nil {
 		return oops.Code("EVENTBUS_PAYLOAD_TOO_LARGE").
+			With("event_type", string(event.Type)).
 			With("payload_size", len(event.Payload)).
-			With("max_payload_size", MaxPayloadSize).
-			Wrap(ErrPayloadTooLarge)
+			With("max_payload_size", p.cfg.Payload.Limit(event.Type)).
+			Wrap(err)
+	}
+	PayloadSizeBytes.Observe(float64(len(event.Payload)))
+
+	promote, err := promoteSensitive(ctx, p.encrypted, event)
+	if err != nil {
+		return err
+	}
+	if promote {
+		event.Sensitive = true
 	}
 
 	// Build the envelope with cleartext fields. event.Payload stays as the
@@ -312,6 +335,9 @@ func (p *JetStreamPublisher) Publish(ctx context.Context, event Event) error {
 	if event.Actor.ID != (ulid.ULID{}) {
 		msg.Header.Set(HeaderActorID, event.Actor.ID.String())
 	}
+	if impersonator, ok := core.ImpersonatorFromContext(ctx); ok {
+		msg.Header.Set(HeaderImpersonatorID, impersonator)
+	}
 	mergeCallerHeaders(msg.Header, event)
 	// OTEL trace context; no-op when the caller has no active span.
 	telemetry.InjectHeaders(ctx, msg.Header)
@@ -328,6 +354,7 @@ func (p *JetStreamPublisher) Publish(ctx context.Context, event Event) error {
 			With("subject", string(event.Subject)).
 			Wrap(err)
 	}
+	PublishDuration.Observe(time.Since(start).Seconds())
 	return nil
 }
 
@@ -338,16 +365,17 @@ func (p *JetStreamPublisher) Publish(ctx context.Context, event Event) error {
 // invariant for App-Rendering is enforced architecturally: only
 // RenderingPublisher holds the proto serialization path.
 var reservedHeaderKeys = map[string]struct{}{
-	HeaderMsgID:         {},
-	HeaderCodec:         {},
-	HeaderSchemaVersion: {},
-	HeaderEventType:     {},
-	HeaderActorKind:     {},
-	HeaderActorID:       {},
-	HeaderDekRef:        {},
-	HeaderDekVersion:    {},
-	"traceparent":       {},
-	"tracestate":        {},
+	HeaderMsgID:          {},
+	HeaderCodec:          {},
+	HeaderSchemaVersion:  {},
+	HeaderEventType:      {},
+	HeaderActorKind:      {},
+	HeaderActorID:        {},
+	HeaderImpersonatorID: {},
+	HeaderDekRef:         {},
+	HeaderDekVersion:     {},
+	"traceparent":        {},
+	"tracestate":         {},
 }
 
 // mergeCallerHeaders copies ev.Headers into msgHeader enforcing the
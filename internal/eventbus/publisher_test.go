@@ -163,6 +163,22 @@ func TestPublisherRejectsOversizedPayload(t *testing.T) {
 	require.ErrorIs(t, err, eventbus.ErrPayloadTooLarge)
 }
 
+func TestPublisherEnforcesPerTypePayloadLimit(t *testing.T) {
+	embedded := eventbustest.New(t)
+	p := eventbus.NewJetStreamPublisher(embedded.JS, eventbus.Config{
+		Payload: eventbus.PayloadConfig{MaxSizeByType: map[string]int{"scene.pose": 8}},
+	})
+	ev := goodEvent("events.main.test")
+	ev.Payload = []byte(`{"text":"too long"}`)
+	err := p.Publish(context.Background(), ev)
+	errutil.AssertErrorCode(t, err, "EVENTBUS_PAYLOAD_TOO_LARGE")
+
+	var tooLarge *eventbus.PayloadTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, len(ev.Payload), tooLarge.Size)
+	assert.Equal(t, 8, tooLarge.Limit)
+}
+
 func TestPublisherReturnsCodecSelectError(t *testing.T) {
 	embedded := eventbustest.New(t)
 	sentinel := errors.New("selector boom")
@@ -435,6 +451,31 @@ func TestPublisherMergesHeadersIntoNatsMsg(t *testing.T) {
 	assert.NotEmpty(t, msgs[0].Header.Get("Nats-Msg-Id"))
 }
 
+func TestPublisherStampsImpersonatorHeaderFromContext(t *testing.T) {
+	embedded := eventbustest.New(t)
+	pub := embedded.Bus.Publisher()
+
+	staffID := core.NewULID().String()
+	ctx := core.WithImpersonator(context.Background(), staffID)
+	ev := eventbus.NewEvent(
+		eventbus.Subject("events.main.character.01IMP"),
+		eventbus.Type("core-communication:say"),
+		eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: core.NewULID()},
+		[]byte(`{"message":"hi"}`),
+	)
+	require.NoError(t, pub.Publish(ctx, ev))
+	require.NoError(t, pub.Publish(context.Background(), eventbus.NewEvent(
+		ev.Subject, ev.Type, ev.Actor, ev.Payload)))
+	embedded.AwaitStreamLastSeq(t, 2, 0)
+
+	msgs := embedded.RawMessagesOnSubject(t, "events.main.character.01IMP", 10, 0)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, staffID, msgs[0].Header.Get(eventbus.HeaderImpersonatorID))
+	assert.Equal(t, ev.Actor.ID.String(), msgs[0].Header.Get(eventbus.HeaderActorID),
+		"the actor stays the impersonated identity")
+	assert.Empty(t, msgs[1].Header.Get(eventbus.HeaderImpersonatorID))
+}
+
 func TestPublisherCollidingHeaderPanicsInTests(t *testing.T) {
 	embedded := eventbustest.New(t)
 	pub := embedded.Bus.Publisher()
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesiredStreamConfigCompression(t *testing.T) {
+	s := NewSubsystem(Config{})
+	assert.Equal(t, jetstream.NoCompression, s.desiredStreamConfig().Compression)
+
+	s = NewSubsystem(Config{Payload: PayloadConfig{Compression: PayloadCompressionS2}})
+	assert.Equal(t, jetstream.S2Compression, s.desiredStreamConfig().Compression)
+}
+
+func TestStreamConfigMismatchReportsCompression(t *testing.T) {
+	desired := NewSubsystem(Config{Payload: PayloadConfig{Compression: PayloadCompressionS2}}).desiredStreamConfig()
+	got := desired
+	got.Compression = jetstream.NoCompression
+	assert.Equal(t, "compression", streamConfigMismatch(desired, got))
+	assert.Empty(t, streamConfigMismatch(desired, desired))
+}
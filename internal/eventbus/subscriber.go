@@ -321,6 +321,7 @@ func (j *jetStreamSessionStream) Next(ctx context.Context) (Delivery, error) {
 		if err != nil {
 			return nil, err
 		}
+		observeDeliveryLatency(msg)
 		return &jetStreamDelivery{msg: msg, event: event, metadataOnly: metaOnly}, nil
 	}
 }
@@ -351,6 +351,7 @@ func (s *Subsystem) desiredStreamConfig() jetstream.StreamConfig {
 		MaxAge:      s.cfg.StreamMaxAge,
 		Duplicates:  s.cfg.DupeWindow,
 		AllowDirect: true,
+		Compression: s.cfg.Payload.streamCompression(),
 	}
 }
 
@@ -387,7 +388,7 @@ func (s *Subsystem) verifyStream(ctx context.Context, desired jetstream.StreamCo
 // streamConfigMismatch returns the name of the first owned config field that
 // differs between desired and got, or "" if they match. It compares every field
 // this subsystem declares as its contract in desiredStreamConfig (Subjects,
-// Retention, Storage, MaxAge, Duplicates, AllowDirect) — server-managed fields
+// Retention, Storage, MaxAge, Duplicates, AllowDirect, Compression) — server-managed fields
 // (e.g. Replicas on a real cluster, placement) are not this subsystem's contract
 // to enforce. Storage is durability-critical: a stream provisioned with Memory
 // storage instead of File would silently pass provision:false verification and
@@ -406,6 +407,8 @@ func streamConfigMismatch(desired, got jetstream.StreamConfig) string {
 		return "duplicates"
 	case desired.AllowDirect != got.AllowDirect:
 		return "allow_direct"
+	case desired.Compression != got.Compression:
+		return "compression"
 	default:
 		return ""
 	}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultThroughputWindow is the sliding window ThroughputMeter reports over
+// when constructed with a non-positive window.
+const defaultThroughputWindow = time.Minute
+
+// Throughput is a sliding-window summary of successful publishes.
+type Throughput struct {
+	Window    time.Duration `json:"window_ns"`
+	Events    uint64        `json:"events"`
+	PerSecond float64       `json:"per_second"`
+}
+
+// ThroughputMeter is a Publisher decorator counting successful publishes in
+// one-second buckets over a sliding window. Failed publishes are not counted:
+// the meter reports what reached the stream, not what was attempted.
+type ThroughputMeter struct {
+	inner  Publisher
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	buckets []uint64
+	stamps  []int64 // unix second each bucket was last reset for
+}
+
+// NewThroughputMeter wraps inner. window is rounded down to whole seconds;
+// non-positive values use one minute. inner MUST NOT be nil.
+func NewThroughputMeter(inner Publisher, window time.Duration) *ThroughputMeter {
+	if inner == nil {
+		panic("eventbus.NewThroughputMeter: inner publisher is nil")
+	}
+	if window < time.Second {
+		window = defaultThroughputWindow
+	}
+	n := int(window / time.Second)
+	return &ThroughputMeter{
+		inner:   inner,
+		window:  time.Duration(n) * time.Second,
+		now:     time.Now,
+		buckets: make([]uint64, n),
+		stamps:  make([]int64, n),
+	}
+}
+
+// Publish delegates to the inner publisher and counts the event on success.
+func (m *ThroughputMeter) Publish(ctx context.Context, event Event) error {
+	if err := m.inner.Publish(ctx, event); err != nil {
+		return err
+	}
+	m.record()
+	return nil
+}
+
+func (m *ThroughputMeter) record() {
+	sec := m.now().Unix()
+	i := int(sec % int64(len(m.buckets)))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stamps[i] != sec {
+		m.stamps[i] = sec
+		m.buckets[i] = 0
+	}
+	m.buckets[i]++
+}
+
+// Snapshot returns the publishes counted in the current window.
+func (m *ThroughputMeter) Snapshot() Throughput {
+	sec := m.now().Unix()
+	oldest := sec - int64(len(m.buckets)) + 1
+
+	m.mu.Lock()
+	var total uint64
+	for i, stamp := range m.stamps {
+		if stamp >= oldest && stamp <= sec {
+			total += m.buckets[i]
+		}
+	}
+	m.mu.Unlock()
+
+	return Throughput{
+		Window:    m.window,
+		Events:    total,
+		PerSecond: float64(total) / m.window.Seconds(),
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build !integration
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingPublisher struct{}
+
+func (failingPublisher) Publish(_ context.Context, _ Event) error { return errors.New("stream down") }
+
+func newTestMeter(inner Publisher, window time.Duration) (*ThroughputMeter, *time.Time) {
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := NewThroughputMeter(inner, window)
+	m.now = func() time.Time { return clock }
+	return m, &clock
+}
+
+func TestThroughputMeterCountsSuccessfulPublishesInWindow(t *testing.T) {
+	m, clock := newTestMeter(internalFakePublisher{}, 10*time.Second)
+
+	for range 5 {
+		require.NoError(t, m.Publish(context.Background(), Event{}))
+	}
+	*clock = clock.Add(3 * time.Second)
+	for range 5 {
+		require.NoError(t, m.Publish(context.Background(), Event{}))
+	}
+
+	got := m.Snapshot()
+	assert.Equal(t, 10*time.Second, got.Window)
+	assert.Equal(t, uint64(10), got.Events)
+	assert.InDelta(t, 1.0, got.PerSecond, 0.0001)
+}
+
+func TestThroughputMeterDropsEventsOlderThanWindow(t *testing.T) {
+	m, clock := newTestMeter(internalFakePublisher{}, 10*time.Second)
+
+	require.NoError(t, m.Publish(context.Background(), Event{}))
+	*clock = clock.Add(10 * time.Second)
+	require.NoError(t, m.Publish(context.Background(), Event{}))
+
+	assert.Equal(t, uint64(1), m.Snapshot().Events)
+}
+
+func TestThroughputMeterDoesNotCountFailedPublishes(t *testing.T) {
+	m, _ := newTestMeter(failingPublisher{}, 10*time.Second)
+
+	require.Error(t, m.Publish(context.Background(), Event{}))
+	assert.Zero(t, m.Snapshot().Events)
+}
+
+func TestNewThroughputMeterDefaultsSubSecondWindow(t *testing.T) {
+	m := NewThroughputMeter(internalFakePublisher{}, 0)
+	assert.Equal(t, defaultThroughputWindow, m.Snapshot().Window)
+}
+
+func TestNewThroughputMeterPanicsOnNilInner(t *testing.T) {
+	assert.Panics(t, func() { NewThroughputMeter(nil, time.Minute) })
+}
@@ -140,7 +140,7 @@ type RenderingMetadata struct {
 // See spec §1d.
 type Event struct {
 	ID        ulid.ULID
-	Seq       uint64 // JetStream stream sequence; populated by both tier readers and by the subscriber. Host-internal — never serialized in any public proto envelope.
+	Seq       uint64 // JetStream stream sequence, assigned at append time; the ordering key (Timestamp is wall-clock and never orders events). Populated by both tier readers and by the subscriber. Host-internal — never serialized in any public proto envelope.
 	Subject   Subject
 	Type      Type
 	Timestamp time.Time
@@ -11,6 +11,8 @@
 package eventvocab
 
 import (
+	"encoding/json"
+
 	"github.com/samber/oops"
 )
 
@@ -61,14 +63,92 @@ const (
 
 	// Session lifecycle (host-owned)
 	EventTypeSessionEnded EventType = "session_ended"
+
+	// Idle activity (host-owned)
+	EventTypeAFK  EventType = "afk"
+	EventTypeBack EventType = "back"
+
+	// Dice rolls and stat checks (host-owned)
+	EventTypeRoll  EventType = "roll"
+	EventTypeCheck EventType = "check"
+
+	// Scheduled jobs (host-owned, delivered to plugins)
+	EventTypeScheduled EventType = "scheduled"
+
+	// World property changes (host-owned, for reactive plugins)
+	EventTypePropertyChanged EventType = "property_changed"
+
+	// Login notice (host-owned): message of the day and announcements
+	EventTypeMOTD EventType = "motd"
+
+	// Economy transactions (host-owned): transfers, mints, and burns
+	EventTypeCurrencyTransfer EventType = "currency_transfer"
+
+	// Zone broadcasts (host-owned): weather, ambience, and announcements
+	EventTypeZoneBroadcast EventType = "zone_broadcast"
+
+	// Ambient location lines (host-owned): low-priority atmosphere
+	EventTypeAmbient EventType = "ambient"
+
+	// Background job outcomes (host-owned): sent to the submitter
+	EventTypeJobFinished EventType = "job_finished"
+
+	// Exit traversal phases (host-owned): a walk through an exit starting,
+	// being called off, and reaching its destination, and an attempt on an
+	// exit locked against the character
+	EventTypeTraversalDepart EventType = "traversal_depart"
+	EventTypeTraversalCancel EventType = "traversal_cancel"
+	EventTypeTraversalArrive EventType = "traversal_arrive"
+	EventTypeTraversalLocked EventType = "traversal_locked"
+
+	// Paging (host-owned): a private message to a character and the
+	// receipt its sender gets back
+	EventTypePage        EventType = "page"
+	EventTypePageReceipt EventType = "page_receipt"
+
+	// Player reports (host-owned): a report's triage status changing,
+	// sent to the character who filed it
+	EventTypeReportStatus EventType = "report_status"
+
+	// Client preferences (host-owned): a player's preferences at login and
+	// after each change, for transports to apply
+	EventTypePreferencesChanged EventType = "preferences_changed"
+
+	// Non-player characters (host-owned): an NPC appearing, leaving,
+	// or wandering between locations
+	EventTypeNPC EventType = "npc"
+
+	// Daylight (host-owned): dawn and dusk in outdoor locations, on the
+	// game clock
+	EventTypeDaylight EventType = "daylight"
 )
 
+// VerbEventTypePrefix prefixes the type of the event an object verb hands
+// its plugin: triggering "push" delivers a "verb:push" event.
+const VerbEventTypePrefix = "verb:"
+
+// VerbEventType returns the event type delivered when verb is triggered.
+func VerbEventType(verb string) EventType {
+	return EventType(VerbEventTypePrefix + verb)
+}
+
+// NPCEventTypePrefix prefixes the type of the event an NPC behavior hands
+// its plugin: a player's "say" near the NPC delivers an "npc:say" event.
+const NPCEventTypePrefix = "npc:"
+
+// NPCEventType returns the event type delivered to an NPC behavior when an
+// event of trigger type is sent to the NPC's location.
+func NPCEventType(trigger string) EventType {
+	return EventType(NPCEventTypePrefix + trigger)
+}
+
 // LocationStatePayload is the JSON payload for location_state events, providing
 // a full snapshot of the character's current location.
 type LocationStatePayload struct {
 	Location LocationStateInfo   `json:"location"`
 	Exits    []LocationStateExit `json:"exits"`
 	Present  []LocationStateChar `json:"present"`
+	NPCs     []LocationStateNPC  `json:"npcs,omitempty"`
 }
 
 // LocationStateInfo describes the location in a location_state event.
@@ -97,18 +177,319 @@ type LocationStateChar struct {
 	Idle        bool   `json:"idle"`
 }
 
+// LocationStateNPC describes a non-player character in the current
+// location. NPCs are listed apart from Present so clients can mark them.
+type LocationStateNPC struct {
+	NPCID string `json:"npc_id"`
+	Name  string `json:"name"`
+}
+
+// IdlePayload is the JSON payload for afk and back events. IdleSeconds is the
+// idle duration at the transition: time since last activity for afk, total
+// time spent idle for back.
+type IdlePayload struct {
+	CharacterID   string `json:"character_id"`
+	CharacterName string `json:"character_name"`
+	IdleSeconds   int64  `json:"idle_seconds"`
+}
+
+// RollPayload is the JSON payload for roll events. The host rolls every die;
+// Dice records each individual result in roll order, so observers can verify
+// Total without trusting the roller.
+type RollPayload struct {
+	CharacterID string    `json:"character_id"`
+	Expression  string    `json:"expression"`
+	Dice        []RollDie `json:"dice"`
+	Modifier    int       `json:"modifier"`
+	Total       int       `json:"total"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+// RollDie is one die result within a RollPayload. Exploded marks a die added
+// by an exploding roll; Dropped marks a die excluded by keep-highest or
+// keep-lowest.
+type RollDie struct {
+	Value    int  `json:"value"`
+	Exploded bool `json:"exploded,omitempty"`
+	Dropped  bool `json:"dropped,omitempty"`
+}
+
+// CheckPayload is the JSON payload for check events: a stat check against a
+// difficulty (one roll) or a contested check (two rolls, Winner set unless
+// tied). ActorID and Text carry the roller and a rendered summary, the
+// shape scene logs replay. A hidden check's public notice has Hidden set
+// and omits Rolls, Outcome, and Winner; the full result goes only to the
+// GM's character stream.
+type CheckPayload struct {
+	ActorID    string      `json:"actor_id"`
+	Text       string      `json:"text"`
+	Expression string      `json:"expression"`
+	Rolls      []CheckRoll `json:"rolls,omitempty"`
+	Difficulty int         `json:"difficulty,omitempty"`
+	Outcome    string      `json:"outcome,omitempty"`
+	Winner     string      `json:"winner,omitempty"`
+	Hidden     bool        `json:"hidden,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+}
+
+// CheckRoll is one character's roll within a CheckPayload. Total is the
+// kept dice plus Stat.
+type CheckRoll struct {
+	CharacterID string    `json:"character_id"`
+	Attribute   string    `json:"attribute"`
+	Stat        int       `json:"stat"`
+	Dice        []RollDie `json:"dice"`
+	Total       int       `json:"total"`
+}
+
+// Check outcomes carried in CheckPayload.Outcome.
+const (
+	CheckOutcomeSuccess = "success"
+	CheckOutcomeFailure = "failure"
+	CheckOutcomeWin     = "win"
+	CheckOutcomeTie     = "tie"
+)
+
+// ScheduledPayload is the JSON payload of the scheduled event the scheduler
+// delivers to a plugin when one of its jobs fires.
+type ScheduledPayload struct {
+	Job         string `json:"job"`
+	Message     string `json:"message,omitempty"`
+	ScheduledAt int64  `json:"scheduled_at"` // Unix milliseconds
+}
+
+// PropertyChangedPayload is the JSON payload for property_changed events.
+// Values are carried as SHA-256 hex digests, not plaintext, so a description
+// never leaks onto a stream; a plugin compares a hash against one it computed
+// or re-reads the property when it needs the value. An empty hash means the
+// property had no value (e.g. an object with no owner).
+type PropertyChangedPayload struct {
+	ParentType string `json:"parent_type"`
+	ParentID   string `json:"parent_id"`
+	Key        string `json:"key"`
+	OldHash    string `json:"old_hash,omitempty"`
+	NewHash    string `json:"new_hash,omitempty"`
+}
+
+// MOTDPayload is the JSON payload for motd events, published on a
+// character's stream when it logs in. Text is the plain rendering and ANSI
+// the styled one telnet shows; the remaining fields carry the same notice
+// in structured form for the web client. Body and announcement bodies are
+// plain text with format codes already applied.
+type MOTDPayload struct {
+	Text          string             `json:"text"`
+	ANSI          string             `json:"ansi"`
+	Body          string             `json:"body,omitempty"`
+	UpdatedAt     int64              `json:"updated_at,omitempty"` // Unix milliseconds
+	Unread        bool               `json:"unread"`
+	Announcements []MOTDAnnouncement `json:"announcements,omitempty"`
+}
+
+// MOTDAnnouncement is one active announcement within a MOTDPayload.
+// RequiresAck marks an announcement the player has yet to acknowledge.
+type MOTDAnnouncement struct {
+	ID          string `json:"id"`
+	Body        string `json:"body"`
+	StartsAt    int64  `json:"starts_at"` // Unix milliseconds
+	EndsAt      int64  `json:"ends_at"`   // Unix milliseconds
+	RequiresAck bool   `json:"requires_ack,omitempty"`
+}
+
+// CurrencyTransferPayload is the JSON payload for currency_transfer events,
+// published on the stream of each character a committed economy transaction
+// moved money for. Kind is "transfer", "mint", or "burn"; the character ID
+// on the treasury side of a mint or burn is empty. Balance is the stream
+// owner's balance in Currency after the transaction, and Text is the line
+// shown to that character.
+type CurrencyTransferPayload struct {
+	TransactionID   string `json:"transaction_id"`
+	Kind            string `json:"kind"`
+	FromCharacterID string `json:"from_character_id,omitempty"`
+	ToCharacterID   string `json:"to_character_id,omitempty"`
+	Currency        string `json:"currency"`
+	Amount          int64  `json:"amount"`
+	Memo            string `json:"memo,omitempty"`
+	Balance         int64  `json:"balance"`
+	Text            string `json:"text"`
+}
+
+// ObjectVerbPayload is the JSON payload of the verb:<name> event delivered
+// to the plugin bound to an object verb when a character triggers it. Args
+// is the rest of the command line (e.g. "twice" in "push button twice").
+type ObjectVerbPayload struct {
+	ObjectID      string `json:"object_id"`
+	ObjectName    string `json:"object_name"`
+	Verb          string `json:"verb"`
+	Args          string `json:"args,omitempty"`
+	CharacterID   string `json:"character_id"`
+	CharacterName string `json:"character_name,omitempty"`
+	LocationID    string `json:"location_id"`
+}
+
+// ZoneBroadcastPayload is the JSON payload for zone_broadcast events,
+// published on the stream of every location in the zone by
+// world.Service.BroadcastToZone. Kind is the broadcaster's optional label
+// (e.g. "weather") and Text is the message shown in each location.
+type ZoneBroadcastPayload struct {
+	ZoneID string `json:"zone_id"`
+	Kind   string `json:"kind,omitempty"`
+	Text   string `json:"text"`
+}
+
+// AmbientPayload is the JSON payload for ambient events, published on a
+// location's stream by the ambient service while players are present.
+// Priority is always AmbientPriorityLow: clients may dim, fold, or mute
+// ambient lines without losing anything a player must see.
+type AmbientPayload struct {
+	LocationID string `json:"location_id"`
+	LineID     string `json:"line_id"`
+	Text       string `json:"text"`
+	Priority   string `json:"priority"`
+}
+
+// AmbientPriorityLow marks an event clients may de-emphasize.
+const AmbientPriorityLow = "low"
+
+// NPCPayload is the JSON payload for npc events, published on a location's
+// stream by the NPC service when an NPC is spawned there or despawned, or
+// wanders out of or into it. Exit names the exit taken when wandering, and
+// Text is the line shown in the location.
+type NPCPayload struct {
+	NPCID      string `json:"npc_id"`
+	Name       string `json:"name"`
+	LocationID string `json:"location_id"`
+	Action     string `json:"action"`
+	Exit       string `json:"exit,omitempty"`
+	Text       string `json:"text"`
+}
+
+// DaylightPayload is the JSON payload for daylight events, published on
+// the stream of every outdoor-tagged location by the game clock's announcer
+// at each IC dawn and dusk. Kind is "dawn" or "dusk", Date and Clock give
+// the IC date and time of day, and Text is the line shown in the location.
+type DaylightPayload struct {
+	LocationID string `json:"location_id"`
+	Kind       string `json:"kind"`
+	Season     string `json:"season,omitempty"`
+	Date       string `json:"date"`
+	Clock      string `json:"clock"`
+	Text       string `json:"text"`
+}
+
+// NPC actions carried in NPCPayload.Action.
+const (
+	NPCActionSpawn   = "spawn"
+	NPCActionDespawn = "despawn"
+	NPCActionDepart  = "depart"
+	NPCActionArrive  = "arrive"
+)
+
+// NPCStimulusPayload is the JSON payload of the npc:<type> event delivered
+// to a plugin attached to an NPC when an event of that type is sent to the
+// NPC's location. Event carries the triggering event's own payload.
+type NPCStimulusPayload struct {
+	NPCID      string          `json:"npc_id"`
+	NPCName    string          `json:"npc_name"`
+	LocationID string          `json:"location_id"`
+	EventID    string          `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	ActorID    string          `json:"actor_id"`
+	Event      json.RawMessage `json:"event,omitempty"`
+}
+
+// JobFinishedPayload is the JSON payload for job_finished events, published
+// on the submitting character's stream when a background job started with
+// jobs.Queue.Submit reaches a terminal status. Status is "succeeded",
+// "failed", or "cancelled"; Result and Error carry the job's outcome, and
+// Text is the line shown to the submitter.
+type JobFinishedPayload struct {
+	JobID  string `json:"job_id"`
+	Kind   string `json:"kind"`
+	Status string `json:"status"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+	Text   string `json:"text"`
+}
+
+// TraversalPayload is the JSON payload for the traversal_depart,
+// traversal_cancel, traversal_arrive, and traversal_locked events
+// traversal.Service publishes as a character walks through an exit. Depart,
+// cancel, and locked go to the stream of the location being left, arrive to
+// the stream of the destination. DelayMS is how long the walk takes; zero
+// for an instant exit.
+type TraversalPayload struct {
+	CharacterID    string `json:"character_id"`
+	CharacterName  string `json:"character_name,omitempty"`
+	ExitID         string `json:"exit_id"`
+	ExitName       string `json:"exit_name"`
+	FromLocationID string `json:"from_location_id"`
+	ToLocationID   string `json:"to_location_id"`
+	DelayMS        int64  `json:"delay_ms,omitempty"`
+}
+
 // ExitUpdatePayload is the JSON payload for exit_update events, providing a
 // delta update to the exits in the current location.
 type ExitUpdatePayload struct {
 	Exits []LocationStateExit `json:"exits"`
 }
 
-// PagePayload is the JSON payload for page events (private messages between characters).
+// PagePayload is the JSON payload for page events (private messages between
+// characters), published by paging.Service on the recipient's stream.
+// Message is what the sender typed, without the pose prefix; Text is the
+// line shown to the recipient. Held is set on a page delivered at login
+// after waiting in the recipient's mailbox, and SentAt (Unix milliseconds)
+// is when it was sent.
 type PagePayload struct {
+	MessageID  string `json:"message_id,omitempty"`
 	SenderID   string `json:"sender_id"`
 	SenderName string `json:"sender_name"`
 	Message    string `json:"message"`
 	IsPose     bool   `json:"is_pose"`
+	Text       string `json:"text,omitempty"`
+	Held       bool   `json:"held,omitempty"`
+	SentAt     int64  `json:"sent_at,omitempty"`
+}
+
+// PageReceiptPayload is the JSON payload for page_receipt events, published
+// on the sender's stream for each page. Status is "delivered" when the page
+// reached the recipient's stream and "held" when it waits in their mailbox
+// for their next login; a held page gets a second, delivered receipt then.
+// Text is the line shown to the sender.
+type PageReceiptPayload struct {
+	MessageID     string `json:"message_id"`
+	RecipientID   string `json:"recipient_id"`
+	RecipientName string `json:"recipient_name"`
+	Status        string `json:"status"`
+	Text          string `json:"text"`
+}
+
+// ReportStatusPayload is the JSON payload for report_status events,
+// published on the reporter's stream when staff claim or resolve their
+// report. Status is "claimed" or "resolved"; Resolution is set once it is
+// resolved. Text is the line shown to the reporter.
+type ReportStatusPayload struct {
+	ReportID   string `json:"report_id"`
+	Category   string `json:"category"`
+	Status     string `json:"status"`
+	Resolution string `json:"resolution,omitempty"`
+	Text       string `json:"text"`
+}
+
+// PreferencesChangedPayload is the JSON payload for preferences_changed
+// events, published by preferences.Service on the stream of the character
+// a player is playing when they log in and whenever they change a
+// preference. It carries the complete preferences, defaults included, so a
+// transport can apply them without a lookup. Changed names the preference
+// that changed; it is empty on the login snapshot.
+type PreferencesChangedPayload struct {
+	ANSI                bool   `json:"ansi"`
+	ScreenWidth         int    `json:"screen_width"`
+	Timezone            string `json:"timezone"`
+	PageSize            int    `json:"page_size"`
+	Locale              string `json:"locale"`
+	NotifyBell          bool   `json:"notify_bell"`
+	NotifyAnnouncements bool   `json:"notify_announcements"`
+	Changed             string `json:"changed,omitempty"`
 }
 
 // WhisperPayload is the JSON payload for whisper events (location-scoped private messages).
@@ -31,6 +31,29 @@ func TestEventTypeArriveIsTheArriveWireString(t *testing.T) {
 		{"location_state constant is the location_state wire string", eventvocab.EventTypeLocationState, "location_state"},
 		{"exit_update constant is the exit_update wire string", eventvocab.EventTypeExitUpdate, "exit_update"},
 		{"session_ended constant is the session_ended wire string", eventvocab.EventTypeSessionEnded, "session_ended"},
+		{"afk constant is the afk wire string", eventvocab.EventTypeAFK, "afk"},
+		{"back constant is the back wire string", eventvocab.EventTypeBack, "back"},
+		{"roll constant is the roll wire string", eventvocab.EventTypeRoll, "roll"},
+		{"check constant is the check wire string", eventvocab.EventTypeCheck, "check"},
+		{"scheduled constant is the scheduled wire string", eventvocab.EventTypeScheduled, "scheduled"},
+		{"property_changed constant is the property_changed wire string", eventvocab.EventTypePropertyChanged, "property_changed"},
+		{"motd constant is the motd wire string", eventvocab.EventTypeMOTD, "motd"},
+		{"currency_transfer constant is the currency_transfer wire string", eventvocab.EventTypeCurrencyTransfer, "currency_transfer"},
+		{"zone_broadcast constant is the zone_broadcast wire string", eventvocab.EventTypeZoneBroadcast, "zone_broadcast"},
+		{"ambient constant is the ambient wire string", eventvocab.EventTypeAmbient, "ambient"},
+		{"job_finished constant is the job_finished wire string", eventvocab.EventTypeJobFinished, "job_finished"},
+		{"traversal_depart constant is the traversal_depart wire string", eventvocab.EventTypeTraversalDepart, "traversal_depart"},
+		{"traversal_cancel constant is the traversal_cancel wire string", eventvocab.EventTypeTraversalCancel, "traversal_cancel"},
+		{"traversal_arrive constant is the traversal_arrive wire string", eventvocab.EventTypeTraversalArrive, "traversal_arrive"},
+		{"traversal_locked constant is the traversal_locked wire string", eventvocab.EventTypeTraversalLocked, "traversal_locked"},
+		{"page constant is the page wire string", eventvocab.EventTypePage, "page"},
+		{"page_receipt constant is the page_receipt wire string", eventvocab.EventTypePageReceipt, "page_receipt"},
+		{"report_status constant is the report_status wire string", eventvocab.EventTypeReportStatus, "report_status"},
+		{"preferences_changed constant is the preferences_changed wire string", eventvocab.EventTypePreferencesChanged, "preferences_changed"},
+		{"npc constant is the npc wire string", eventvocab.EventTypeNPC, "npc"},
+		{"daylight constant is the daylight wire string", eventvocab.EventTypeDaylight, "daylight"},
+		{"verb event type is the verb-prefixed wire string", eventvocab.VerbEventType("push"), "verb:push"},
+		{"npc event type is the npc-prefixed wire string", eventvocab.NPCEventType("say"), "npc:say"},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,497 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// StatPropertyPrefix prefixes the character int properties that hold stats:
+// the "strength" attribute is read from the "stat.strength" property.
+const StatPropertyPrefix = "stat."
+
+// DefaultCheckDie is the die expression rolled for a check before the stat
+// is added.
+const DefaultCheckDie = "1d20"
+
+// attributeNamePattern bounds attribute names to the identifier shape
+// property names use.
+var attributeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// CheckWorld is the subset of world.Service a CheckService reads character
+// names, locations, and stats from.
+type CheckWorld interface {
+	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*world.Character, error)
+	PropertyValues(ctx context.Context, subjectID, parentType string, parentID ulid.ULID) (*world.PropertyValues, error)
+}
+
+// CheckRoll is one character's side of a check.
+type CheckRoll struct {
+	CharacterID ulid.ULID
+	Attribute   string
+	// Stat is the attribute value added to the die roll.
+	Stat int
+	// Roll is the die roll before Stat is added.
+	Roll Result
+	// Total is Roll.Total plus Stat.
+	Total int
+}
+
+// CheckResult is the outcome of a check against a difficulty.
+type CheckResult struct {
+	CheckRoll
+	Difficulty int
+	Success    bool
+	// Margin is Total minus Difficulty; negative on failure.
+	Margin int
+}
+
+// ContestSide names a character and the attribute they roll in a contest.
+type ContestSide struct {
+	CharacterID ulid.ULID
+	Attribute   string
+}
+
+// ContestResult is the outcome of a contested check.
+type ContestResult struct {
+	Challenger CheckRoll
+	Defender   CheckRoll
+	// Winner is the character with the higher total; zero on a tie.
+	Winner ulid.ULID
+}
+
+// Tie reports whether neither side won.
+func (r ContestResult) Tie() bool {
+	return r.Winner == (ulid.ULID{})
+}
+
+// checkRequest holds the per-call options of a check.
+type checkRequest struct {
+	stream string
+	gm     ulid.ULID
+	reason string
+}
+
+// CheckOption configures a single Roll or Contest call.
+type CheckOption func(*checkRequest)
+
+// OnStream announces the check on stream, "location.<id>" or
+// "scene.<id>", instead of the rolling character's location. Scene checks
+// are published on the scene's IC stream so they appear in the scene log.
+func OnStream(stream string) CheckOption {
+	return func(r *checkRequest) {
+		r.stream = stream
+	}
+}
+
+// HiddenFor makes the check GM-only: the full result is sent to gmID's
+// character stream and the announcement stream only learns that a hidden
+// check was made.
+func HiddenFor(gmID ulid.ULID) CheckOption {
+	return func(r *checkRequest) {
+		r.gm = gmID
+	}
+}
+
+// WithCheckReason attaches free text shown with the check, e.g.
+// "climbing the wall".
+func WithCheckReason(reason string) CheckOption {
+	return func(r *checkRequest) {
+		r.reason = reason
+	}
+}
+
+// CheckService resolves stat checks and contested checks. Stats come from
+// character int properties (see StatPropertyPrefix), read as the requesting
+// subject so property visibility applies. Dice are rolled on the server and
+// every result is published as a check event before it is returned, like
+// DiceService.
+type CheckService struct {
+	world  CheckWorld
+	pub    eventbus.Publisher
+	gameID func() string
+	src    Source
+	die    Expression
+}
+
+// CheckServiceOption configures a CheckService.
+type CheckServiceOption func(*CheckService)
+
+// WithCheckSource overrides the random source. Intended for tests;
+// production uses CryptoSource.
+func WithCheckSource(src Source) CheckServiceOption {
+	return func(s *CheckService) {
+		s.src = src
+	}
+}
+
+// WithCheckDie overrides the die expression rolled for every check, e.g.
+// "3d6" for a bell-curve system. The expression's own modifier is kept and
+// the stat is added on top.
+func WithCheckDie(expr Expression) CheckServiceOption {
+	return func(s *CheckService) {
+		s.die = expr
+	}
+}
+
+// NewCheckService constructs a CheckService reading characters from w and
+// publishing through pub.
+//
+// Panics when w, pub, or gameID is nil, matching NewDiceService.
+func NewCheckService(w CheckWorld, pub eventbus.Publisher, gameID func() string, opts ...CheckServiceOption) *CheckService {
+	if w == nil {
+		panic("game.NewCheckService: nil CheckWorld")
+	}
+	if pub == nil || eventbus.IsNilPublisher(pub) {
+		panic("game.NewCheckService: nil Publisher")
+	}
+	if gameID == nil {
+		panic("game.NewCheckService: nil gameID")
+	}
+	die, err := ParseExpression(DefaultCheckDie)
+	if err != nil {
+		panic(fmt.Sprintf("game.NewCheckService: default die: %v", err))
+	}
+	s := &CheckService{world: w, pub: pub, gameID: gameID, src: CryptoSource{}, die: die}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Roll makes an attribute check for charID against difficulty. The check
+// succeeds when the die roll plus the character's stat meets or beats
+// difficulty.
+func (s *CheckService) Roll(ctx context.Context, subject string, charID ulid.ULID, attribute string, difficulty int, opts ...CheckOption) (CheckResult, error) {
+	if difficulty < -MaxModifier || difficulty > MaxModifier {
+		return CheckResult{}, oops.Code("CHECK_INVALID_REQUEST").
+			With("difficulty", difficulty).
+			Errorf("difficulty must be within ±%d", MaxModifier)
+	}
+	req, err := newCheckRequest(opts)
+	if err != nil {
+		return CheckResult{}, err
+	}
+	char, err := s.character(ctx, subject, charID)
+	if err != nil {
+		return CheckResult{}, err
+	}
+	if req.stream, err = defaultStream(req.stream, char); err != nil {
+		return CheckResult{}, err
+	}
+
+	roll, err := s.roll(ctx, subject, charID, attribute)
+	if err != nil {
+		return CheckResult{}, err
+	}
+	result := CheckResult{
+		CheckRoll:  roll,
+		Difficulty: difficulty,
+		Success:    roll.Total >= difficulty,
+		Margin:     roll.Total - difficulty,
+	}
+
+	outcome := eventvocab.CheckOutcomeFailure
+	if result.Success {
+		outcome = eventvocab.CheckOutcomeSuccess
+	}
+	payload := eventvocab.CheckPayload{
+		ActorID:    charID.String(),
+		Expression: s.die.String(),
+		Rolls:      []eventvocab.CheckRoll{checkRollPayload(roll)},
+		Difficulty: difficulty,
+		Outcome:    outcome,
+		Reason:     req.reason,
+		Text: fmt.Sprintf("%s rolls %s (%s%+d): %d vs %d — %s.",
+			char.Name, attribute, s.die, roll.Stat, roll.Total, difficulty, outcome),
+	}
+	hiddenText := fmt.Sprintf("%s makes a hidden %s check.", char.Name, attribute)
+	if err := s.publish(ctx, req, charID, payload, hiddenText); err != nil {
+		return CheckResult{}, err
+	}
+
+	slog.InfoContext(ctx, "stat check",
+		"character_id", charID.String(),
+		"stream", req.stream,
+		"attribute", attribute,
+		"stat", roll.Stat,
+		"total", roll.Total,
+		"difficulty", difficulty,
+		"outcome", outcome,
+		"hidden", !req.gm.IsZero(),
+		"reason", req.reason)
+	return result, nil
+}
+
+// Contest makes an opposed check between two characters. Each rolls the
+// check die plus their own attribute; the higher total wins. The check is
+// announced at the challenger's location unless OnStream is given.
+func (s *CheckService) Contest(ctx context.Context, subject string, challenger, defender ContestSide, opts ...CheckOption) (ContestResult, error) {
+	if challenger.CharacterID == defender.CharacterID {
+		return ContestResult{}, oops.Code("CHECK_INVALID_REQUEST").
+			With("character_id", challenger.CharacterID.String()).
+			Errorf("a character cannot contest themselves")
+	}
+	req, err := newCheckRequest(opts)
+	if err != nil {
+		return ContestResult{}, err
+	}
+	challengerChar, err := s.character(ctx, subject, challenger.CharacterID)
+	if err != nil {
+		return ContestResult{}, err
+	}
+	defenderChar, err := s.character(ctx, subject, defender.CharacterID)
+	if err != nil {
+		return ContestResult{}, err
+	}
+	if req.stream, err = defaultStream(req.stream, challengerChar); err != nil {
+		return ContestResult{}, err
+	}
+
+	// Read both stats before rolling so a missing stat cannot leave a
+	// half-resolved contest behind.
+	challengerStat, err := s.stat(ctx, subject, challenger.CharacterID, challenger.Attribute)
+	if err != nil {
+		return ContestResult{}, err
+	}
+	defenderStat, err := s.stat(ctx, subject, defender.CharacterID, defender.Attribute)
+	if err != nil {
+		return ContestResult{}, err
+	}
+	result := ContestResult{}
+	if result.Challenger, err = s.rollStat(challenger.CharacterID, challenger.Attribute, challengerStat); err != nil {
+		return ContestResult{}, err
+	}
+	if result.Defender, err = s.rollStat(defender.CharacterID, defender.Attribute, defenderStat); err != nil {
+		return ContestResult{}, err
+	}
+
+	outcome := eventvocab.CheckOutcomeTie
+	summary := "tie"
+	switch {
+	case result.Challenger.Total > result.Defender.Total:
+		result.Winner = challenger.CharacterID
+		outcome = eventvocab.CheckOutcomeWin
+		summary = challengerChar.Name + " wins"
+	case result.Defender.Total > result.Challenger.Total:
+		result.Winner = defender.CharacterID
+		outcome = eventvocab.CheckOutcomeWin
+		summary = defenderChar.Name + " wins"
+	}
+
+	payload := eventvocab.CheckPayload{
+		ActorID:    challenger.CharacterID.String(),
+		Expression: s.die.String(),
+		Rolls: []eventvocab.CheckRoll{
+			checkRollPayload(result.Challenger),
+			checkRollPayload(result.Defender),
+		},
+		Outcome: outcome,
+		Reason:  req.reason,
+		Text: fmt.Sprintf("%s (%s) %d vs %s (%s) %d — %s.",
+			challengerChar.Name, challenger.Attribute, result.Challenger.Total,
+			defenderChar.Name, defender.Attribute, result.Defender.Total, summary),
+	}
+	if !result.Tie() {
+		payload.Winner = result.Winner.String()
+	}
+	hiddenText := fmt.Sprintf("%s and %s make a hidden contested check.", challengerChar.Name, defenderChar.Name)
+	if err := s.publish(ctx, req, challenger.CharacterID, payload, hiddenText); err != nil {
+		return ContestResult{}, err
+	}
+
+	slog.InfoContext(ctx, "contested check",
+		"challenger_id", challenger.CharacterID.String(),
+		"defender_id", defender.CharacterID.String(),
+		"stream", req.stream,
+		"challenger_total", result.Challenger.Total,
+		"defender_total", result.Defender.Total,
+		"winner_id", payload.Winner,
+		"hidden", !req.gm.IsZero(),
+		"reason", req.reason)
+	return result, nil
+}
+
+func newCheckRequest(opts []CheckOption) (checkRequest, error) {
+	var req checkRequest
+	for _, opt := range opts {
+		opt(&req)
+	}
+	if req.stream != "" && !validRollStream(req.stream) {
+		return req, oops.Code("CHECK_INVALID_STREAM").
+			With("stream", req.stream).
+			Errorf("check stream must be location.<id> or scene.<id>")
+	}
+	if len(req.reason) > MaxRollReasonLength {
+		return req, oops.Code("CHECK_INVALID_REQUEST").
+			With("reason_length", len(req.reason)).
+			Errorf("check reason exceeds %d bytes", MaxRollReasonLength)
+	}
+	return req, nil
+}
+
+// defaultStream returns stream, or the character's location stream when
+// stream is empty.
+func defaultStream(stream string, char *world.Character) (string, error) {
+	if stream != "" {
+		return stream, nil
+	}
+	if char.LocationID == nil {
+		return "", oops.Code("CHECK_INVALID_STREAM").
+			With("character_id", char.ID.String()).
+			Errorf("character has no location to announce the check in")
+	}
+	return "location." + char.LocationID.String(), nil
+}
+
+func (s *CheckService) character(ctx context.Context, subject string, id ulid.ULID) (*world.Character, error) {
+	if id.IsZero() {
+		return nil, oops.Code("CHECK_INVALID_REQUEST").Errorf("check requires a character")
+	}
+	char, err := s.world.GetCharacter(ctx, subject, id)
+	if err != nil {
+		return nil, oops.With("character_id", id.String()).Wrap(err)
+	}
+	return char, nil
+}
+
+// roll reads attribute for charID and rolls the check die against it.
+func (s *CheckService) roll(ctx context.Context, subject string, charID ulid.ULID, attribute string) (CheckRoll, error) {
+	stat, err := s.stat(ctx, subject, charID, attribute)
+	if err != nil {
+		return CheckRoll{}, err
+	}
+	return s.rollStat(charID, attribute, stat)
+}
+
+// stat reads the character's attribute value.
+func (s *CheckService) stat(ctx context.Context, subject string, charID ulid.ULID, attribute string) (int, error) {
+	if !attributeNamePattern.MatchString(attribute) {
+		return 0, oops.Code("CHECK_INVALID_REQUEST").
+			With("attribute", attribute).
+			Errorf("attribute must be a lowercase identifier")
+	}
+	values, err := s.world.PropertyValues(ctx, subject, "character", charID)
+	if err != nil {
+		return 0, oops.With("character_id", charID.String()).Wrap(err)
+	}
+	name := StatPropertyPrefix + attribute
+	n, err := values.GetInt(name)
+	if errors.Is(err, world.ErrNotFound) {
+		return 0, oops.Code("CHECK_STAT_NOT_FOUND").
+			With("character_id", charID.String()).
+			With("attribute", attribute).
+			Errorf("character has no %s stat", attribute)
+	}
+	if err != nil {
+		return 0, oops.With("character_id", charID.String()).With("property", name).Wrap(err)
+	}
+	if n < -MaxModifier || n > MaxModifier {
+		return 0, oops.Code("CHECK_STAT_INVALID").
+			With("character_id", charID.String()).
+			With("attribute", attribute).
+			With("stat", n).
+			Errorf("stat %s is outside ±%d", attribute, MaxModifier)
+	}
+	return int(n), nil
+}
+
+func (s *CheckService) rollStat(charID ulid.ULID, attribute string, stat int) (CheckRoll, error) {
+	result, err := s.die.Roll(s.src)
+	if err != nil {
+		return CheckRoll{}, oops.Code("CHECK_ROLL_FAILED").With("expression", s.die.String()).Wrap(err)
+	}
+	return CheckRoll{
+		CharacterID: charID,
+		Attribute:   attribute,
+		Stat:        stat,
+		Roll:        result,
+		Total:       result.Total + stat,
+	}, nil
+}
+
+// publish announces a check. A visible check publishes payload on the
+// request stream. A hidden one publishes payload to the GM's character
+// stream and a notice carrying only hiddenText on the request stream.
+func (s *CheckService) publish(ctx context.Context, req checkRequest, actorID ulid.ULID, payload eventvocab.CheckPayload, hiddenText string) error {
+	stream := checkStream(req.stream)
+	if req.gm.IsZero() {
+		return s.publishOn(ctx, stream, actorID, payload)
+	}
+
+	full := payload
+	full.Hidden = true
+	if err := s.publishOn(ctx, "character."+req.gm.String(), actorID, full); err != nil {
+		return err
+	}
+	notice := eventvocab.CheckPayload{
+		ActorID:    payload.ActorID,
+		Text:       hiddenText,
+		Expression: payload.Expression,
+		Hidden:     true,
+		Reason:     payload.Reason,
+	}
+	return s.publishOn(ctx, stream, actorID, notice)
+}
+
+func (s *CheckService) publishOn(ctx context.Context, stream string, actorID ulid.ULID, payload eventvocab.CheckPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return oops.With("operation", "marshal_check_payload").Wrap(err)
+	}
+	gameID := s.gameID()
+	if gameID == "" {
+		gameID = "main"
+	}
+	sub, err := eventbus.Qualify(gameID, stream)
+	if err != nil {
+		return oops.Code("CHECK_INVALID_STREAM").With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeCheck))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeCheck)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: actorID}
+	if err := s.pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, data)); err != nil {
+		return oops.Code("CHECK_PUBLISH_FAILED").With("stream", stream).Wrap(err)
+	}
+	return nil
+}
+
+// checkStream maps a validated request stream to the stream the check is
+// published on: scene checks go to the scene's IC facet, which the scene
+// log replays.
+func checkStream(stream string) string {
+	if strings.HasPrefix(stream, "scene.") {
+		return stream + ".ic"
+	}
+	return stream
+}
+
+func checkRollPayload(r CheckRoll) eventvocab.CheckRoll {
+	dice := make([]eventvocab.RollDie, len(r.Roll.Dice))
+	for i, d := range r.Roll.Dice {
+		dice[i] = eventvocab.RollDie{Value: d.Value, Exploded: d.Exploded, Dropped: d.Dropped}
+	}
+	return eventvocab.CheckRoll{
+		CharacterID: r.CharacterID.String(),
+		Attribute:   r.Attribute,
+		Stat:        r.Stat,
+		Dice:        dice,
+		Total:       r.Total,
+	}
+}
@@ -0,0 +1,290 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// checkWorld serves characters from a map and stats through a real
+// world.Service backed by a mock property repository, so property
+// visibility and typed reads behave as in production.
+type checkWorld struct {
+	*world.Service
+	chars map[ulid.ULID]*world.Character
+}
+
+func (w *checkWorld) GetCharacter(_ context.Context, _ string, id ulid.ULID) (*world.Character, error) {
+	char, ok := w.chars[id]
+	if !ok {
+		return nil, world.ErrNotFound
+	}
+	return char, nil
+}
+
+const checkSubject = "character:01JCHECKSUBJECTA1AAAAAAAAAA"
+
+// newCheckWorld builds a checkWorld whose characters all stand in locID
+// and hold the given stats, keyed by character then attribute.
+func newCheckWorld(t *testing.T, locID ulid.ULID, stats map[ulid.ULID]map[string]string) *checkWorld {
+	t.Helper()
+	engine := policytest.NewGrantEngine()
+	props := make(map[ulid.ULID][]*world.EntityProperty, len(stats))
+	chars := make(map[ulid.ULID]*world.Character, len(stats))
+	for charID, attrs := range stats {
+		loc := locID
+		chars[charID] = &world.Character{ID: charID, Name: "char-" + charID.String()[20:], LocationID: &loc}
+		for attr, value := range attrs {
+			p := &world.EntityProperty{ID: idgen.New(), Name: StatPropertyPrefix + attr, Value: &value}
+			engine.Grant(checkSubject, "read", access.PropertyResource(p.ID.String()))
+			props[charID] = append(props[charID], p)
+		}
+	}
+	repo := worldtest.NewMockPropertyRepository(t)
+	repo.EXPECT().ListByParent(mock.Anything, "character", mock.Anything).
+		RunAndReturn(func(_ context.Context, _ string, id ulid.ULID) ([]*world.EntityProperty, error) {
+			return props[id], nil
+		}).Maybe()
+	svc := world.NewService(world.ServiceConfig{PropertyRepo: repo, Engine: engine})
+	return &checkWorld{Service: svc, chars: chars}
+}
+
+func decodeCheck(t *testing.T, ev eventbus.Event) eventvocab.CheckPayload {
+	t.Helper()
+	require.Equal(t, eventbus.Type(eventvocab.EventTypeCheck), ev.Type)
+	var payload eventvocab.CheckPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	return payload
+}
+
+func TestNewCheckServicePanicsOnNilDependencies(t *testing.T) {
+	w := newCheckWorld(t, idgen.New(), nil)
+	assert.Panics(t, func() { NewCheckService(nil, &fakePublisher{}, mainGameID) })
+	assert.Panics(t, func() { NewCheckService(w, nil, mainGameID) })
+	assert.Panics(t, func() { NewCheckService(w, &fakePublisher{}, nil) })
+}
+
+func TestCheckServiceRoll(t *testing.T) {
+	locID := idgen.New()
+	charID := idgen.New()
+	w := newCheckWorld(t, locID, map[ulid.ULID]map[string]string{charID: {"strength": "3"}})
+
+	tests := []struct {
+		name    string
+		face    int
+		success bool
+		margin  int
+		outcome string
+	}{
+		{"meets difficulty", 9, true, 0, eventvocab.CheckOutcomeSuccess},
+		{"below difficulty", 8, false, -1, eventvocab.CheckOutcomeFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &fakePublisher{}
+			svc := NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: []int{tt.face}}))
+
+			got, err := svc.Roll(context.Background(), checkSubject, charID, "strength", 12, WithCheckReason("the gate"))
+			require.NoError(t, err)
+			assert.Equal(t, 3, got.Stat)
+			assert.Equal(t, tt.face+3, got.Total)
+			assert.Equal(t, tt.success, got.Success)
+			assert.Equal(t, tt.margin, got.Margin)
+
+			events := pub.events()
+			require.Len(t, events, 1)
+			assert.Equal(t, eventbus.Subject("events.main.location."+locID.String()), events[0].Subject)
+			payload := decodeCheck(t, events[0])
+			assert.Equal(t, charID.String(), payload.ActorID)
+			assert.Equal(t, tt.outcome, payload.Outcome)
+			assert.Equal(t, 12, payload.Difficulty)
+			assert.Equal(t, "the gate", payload.Reason)
+			require.Len(t, payload.Rolls, 1)
+			assert.Equal(t, tt.face+3, payload.Rolls[0].Total)
+			assert.Contains(t, payload.Text, strconv.Itoa(tt.face+3)+" vs 12 — "+tt.outcome)
+		})
+	}
+}
+
+func TestCheckServiceRollOnSceneUsesICStream(t *testing.T) {
+	charID := idgen.New()
+	sceneID := idgen.New()
+	w := newCheckWorld(t, idgen.New(), map[ulid.ULID]map[string]string{charID: {"wits": "1"}})
+	pub := &fakePublisher{}
+	svc := NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: []int{10}}))
+
+	_, err := svc.Roll(context.Background(), checkSubject, charID, "wits", 5, OnStream("scene."+sceneID.String()))
+	require.NoError(t, err)
+	events := pub.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, eventbus.Subject("events.main.scene."+sceneID.String()+".ic"), events[0].Subject)
+}
+
+func TestCheckServiceHiddenRoll(t *testing.T) {
+	locID := idgen.New()
+	charID := idgen.New()
+	gmID := idgen.New()
+	w := newCheckWorld(t, locID, map[ulid.ULID]map[string]string{charID: {"stealth": "4"}})
+	pub := &fakePublisher{}
+	svc := NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: []int{15}}))
+
+	_, err := svc.Roll(context.Background(), checkSubject, charID, "stealth", 15, HiddenFor(gmID))
+	require.NoError(t, err)
+
+	events := pub.events()
+	require.Len(t, events, 2)
+	assert.Equal(t, eventbus.Subject("events.main.character."+gmID.String()), events[0].Subject)
+	full := decodeCheck(t, events[0])
+	assert.True(t, full.Hidden)
+	assert.Equal(t, eventvocab.CheckOutcomeSuccess, full.Outcome)
+	require.Len(t, full.Rolls, 1)
+
+	assert.Equal(t, eventbus.Subject("events.main.location."+locID.String()), events[1].Subject)
+	notice := decodeCheck(t, events[1])
+	assert.True(t, notice.Hidden)
+	assert.Empty(t, notice.Rolls, "the public notice carries no numbers")
+	assert.Empty(t, notice.Outcome)
+	assert.Contains(t, notice.Text, "hidden stealth check")
+}
+
+func TestCheckServiceContest(t *testing.T) {
+	locID := idgen.New()
+	alice := idgen.New()
+	bob := idgen.New()
+	w := newCheckWorld(t, locID, map[ulid.ULID]map[string]string{
+		alice: {"strength": "2"},
+		bob:   {"dexterity": "5"},
+	})
+
+	tests := []struct {
+		name    string
+		faces   []int
+		winner  ulid.ULID
+		outcome string
+	}{
+		{"challenger wins", []int{15, 10}, alice, eventvocab.CheckOutcomeWin},
+		{"defender wins", []int{10, 15}, bob, eventvocab.CheckOutcomeWin},
+		{"tie", []int{13, 10}, ulid.ULID{}, eventvocab.CheckOutcomeTie},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &fakePublisher{}
+			svc := NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: tt.faces}))
+
+			got, err := svc.Contest(context.Background(), checkSubject,
+				ContestSide{CharacterID: alice, Attribute: "strength"},
+				ContestSide{CharacterID: bob, Attribute: "dexterity"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.faces[0]+2, got.Challenger.Total)
+			assert.Equal(t, tt.faces[1]+5, got.Defender.Total)
+			assert.Equal(t, tt.winner, got.Winner)
+
+			events := pub.events()
+			require.Len(t, events, 1)
+			payload := decodeCheck(t, events[0])
+			assert.Equal(t, tt.outcome, payload.Outcome)
+			require.Len(t, payload.Rolls, 2)
+			if got.Tie() {
+				assert.Empty(t, payload.Winner)
+			} else {
+				assert.Equal(t, tt.winner.String(), payload.Winner)
+			}
+		})
+	}
+}
+
+func TestCheckServiceRejectsInvalidChecks(t *testing.T) {
+	charID := idgen.New()
+	other := idgen.New()
+	w := newCheckWorld(t, idgen.New(), map[ulid.ULID]map[string]string{
+		charID: {"strength": "3", "luck": "99999", "legacy": "lots"},
+		other:  {},
+	})
+	ctx := context.Background()
+	newSvc := func(pub *fakePublisher) *CheckService {
+		return NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: []int{10, 10}}))
+	}
+
+	tests := []struct {
+		name string
+		run  func(*CheckService) error
+		code string
+	}{
+		{"unknown attribute", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "charisma", 10)
+			return err
+		}, "CHECK_STAT_NOT_FOUND"},
+		{"malformed attribute", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "Str ength", 10)
+			return err
+		}, "CHECK_INVALID_REQUEST"},
+		{"stat out of range", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "luck", 10)
+			return err
+		}, "CHECK_STAT_INVALID"},
+		{"stat not an int", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "legacy", 10)
+			return err
+		}, "PROPERTY_VALUE_INVALID"},
+		{"difficulty out of range", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "strength", MaxModifier+1)
+			return err
+		}, "CHECK_INVALID_REQUEST"},
+		{"bad stream", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, charID, "strength", 10, OnStream("channel.x"))
+			return err
+		}, "CHECK_INVALID_STREAM"},
+		{"zero character", func(s *CheckService) error {
+			_, err := s.Roll(ctx, checkSubject, ulid.ULID{}, "strength", 10)
+			return err
+		}, "CHECK_INVALID_REQUEST"},
+		{"self contest", func(s *CheckService) error {
+			side := ContestSide{CharacterID: charID, Attribute: "strength"}
+			_, err := s.Contest(ctx, checkSubject, side, side)
+			return err
+		}, "CHECK_INVALID_REQUEST"},
+		{"defender missing stat", func(s *CheckService) error {
+			_, err := s.Contest(ctx, checkSubject,
+				ContestSide{CharacterID: charID, Attribute: "strength"},
+				ContestSide{CharacterID: other, Attribute: "strength"})
+			return err
+		}, "CHECK_STAT_NOT_FOUND"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &fakePublisher{}
+			err := tt.run(newSvc(pub))
+			errutil.AssertErrorCode(t, err, tt.code)
+			assert.Empty(t, pub.events(), "a rejected check publishes nothing")
+		})
+	}
+}
+
+func TestCheckServicePublishFailure(t *testing.T) {
+	charID := idgen.New()
+	w := newCheckWorld(t, idgen.New(), map[ulid.ULID]map[string]string{charID: {"strength": "3"}})
+	pub := &fakePublisher{err: errors.New("bus down")}
+	svc := NewCheckService(w, pub, mainGameID, WithCheckSource(&seqSource{faces: []int{10}}))
+
+	_, err := svc.Roll(context.Background(), checkSubject, charID, "strength", 10)
+	errutil.AssertErrorCode(t, err, "CHECK_PUBLISH_FAILED")
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package game holds server-authoritative game mechanics shared by commands
+// and plugins. Every random outcome is produced here, on the host, so a
+// client or plugin can request a roll but never supply its result.
+package game
+
+import (
+	"crypto/rand"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// Dice expression limits. They bound the work a single roll can do and the
+// size of the resulting event payload.
+const (
+	// MaxDice is the largest number of dice one expression may roll.
+	MaxDice = 100
+	// MaxSides is the largest die an expression may roll.
+	MaxSides = 1000
+	// MaxModifier is the largest absolute flat modifier.
+	MaxModifier = 10000
+	// MaxExplosions caps how many extra dice one exploding die may add, so a
+	// run of maximum results cannot grow the pool without bound.
+	MaxExplosions = 20
+)
+
+// expressionRegex matches a normalized dice expression:
+// [count]d<sides>[!][kh<n>|kl<n>][+<mod>|-<mod>].
+var expressionRegex = regexp.MustCompile(`^(\d*)d(\d+)(!?)(?:(kh|kl)(\d+))?([+-]\d+)?$`)
+
+// Expression is a parsed dice expression such as "3d6+2", "4d6kh3", or
+// "2d10!". Keep is the number of dice kept after rolling; zero keeps every
+// die.
+type Expression struct {
+	Count      int
+	Sides      int
+	Explode    bool
+	Keep       int
+	KeepLowest bool
+	Modifier   int
+}
+
+// ParseExpression parses a dice expression. Whitespace is ignored and the
+// expression is case-insensitive; a missing count means one die. Returns an
+// error with code DICE_INVALID_EXPRESSION when the expression is malformed
+// or exceeds the package limits.
+func ParseExpression(s string) (Expression, error) {
+	norm := strings.ToLower(strings.Join(strings.Fields(s), ""))
+	m := expressionRegex.FindStringSubmatch(norm)
+	if m == nil {
+		return Expression{}, invalidExpression(s, "expected a form like 3d6+2, 4d6kh3, or 2d10!")
+	}
+
+	expr := Expression{Count: 1, Explode: m[3] == "!"}
+	var err error
+	if m[1] != "" {
+		if expr.Count, err = strconv.Atoi(m[1]); err != nil {
+			return Expression{}, invalidExpression(s, "dice count is not a number")
+		}
+	}
+	if expr.Sides, err = strconv.Atoi(m[2]); err != nil {
+		return Expression{}, invalidExpression(s, "die size is not a number")
+	}
+	if m[4] != "" {
+		if expr.Keep, err = strconv.Atoi(m[5]); err != nil {
+			return Expression{}, invalidExpression(s, "keep count is not a number")
+		}
+		if expr.Keep == 0 {
+			// Keep 0 means "keep every die" on Expression; an explicit kh0
+			// or kl0 is a typo, not a request to keep nothing.
+			return Expression{}, invalidExpression(s, "keep count must be between 1 and the dice count")
+		}
+		expr.KeepLowest = m[4] == "kl"
+	}
+	if m[6] != "" {
+		if expr.Modifier, err = strconv.Atoi(m[6]); err != nil {
+			return Expression{}, invalidExpression(s, "modifier is not a number")
+		}
+	}
+
+	if err := expr.Validate(); err != nil {
+		return Expression{}, err
+	}
+	return expr, nil
+}
+
+// Validate reports whether e is within the package limits. Returns an error
+// with code DICE_INVALID_EXPRESSION otherwise.
+func (e Expression) Validate() error {
+	switch {
+	case e.Count < 1 || e.Count > MaxDice:
+		return invalidExpression(e.String(), "dice count must be between 1 and "+strconv.Itoa(MaxDice))
+	case e.Sides < 1 || e.Sides > MaxSides:
+		return invalidExpression(e.String(), "die size must be between 1 and "+strconv.Itoa(MaxSides))
+	case e.Explode && e.Sides < 2:
+		return invalidExpression(e.String(), "exploding dice need at least 2 sides")
+	case e.Keep < 0 || e.Keep > e.Count:
+		return invalidExpression(e.String(), "keep count must be between 1 and the dice count, or 0 to keep every die")
+	case e.Modifier < -MaxModifier || e.Modifier > MaxModifier:
+		return invalidExpression(e.String(), "modifier must be between -"+strconv.Itoa(MaxModifier)+" and "+strconv.Itoa(MaxModifier))
+	}
+	return nil
+}
+
+// String returns the canonical form of e, e.g. "4d6!kh3+2".
+func (e Expression) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(e.Count))
+	b.WriteByte('d')
+	b.WriteString(strconv.Itoa(e.Sides))
+	if e.Explode {
+		b.WriteByte('!')
+	}
+	if e.Keep > 0 {
+		if e.KeepLowest {
+			b.WriteString("kl")
+		} else {
+			b.WriteString("kh")
+		}
+		b.WriteString(strconv.Itoa(e.Keep))
+	}
+	if e.Modifier > 0 {
+		b.WriteByte('+')
+	}
+	if e.Modifier != 0 {
+		b.WriteString(strconv.Itoa(e.Modifier))
+	}
+	return b.String()
+}
+
+func invalidExpression(expr, reason string) error {
+	return oops.Code("DICE_INVALID_EXPRESSION").
+		With("expression", expr).
+		Errorf("invalid dice expression %q: %s", expr, reason)
+}
+
+// Source produces uniformly distributed random integers in [0, n).
+type Source interface {
+	Intn(n int) (int, error)
+}
+
+// CryptoSource is a Source backed by crypto/rand.
+type CryptoSource struct{}
+
+// Intn returns a uniformly distributed integer in [0, n).
+func (CryptoSource) Intn(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, oops.Code("DICE_RNG_FAILED").Wrap(err)
+	}
+	return int(v.Int64()), nil
+}
+
+// Die is one rolled die. Exploded marks a die added because the previous die
+// rolled its maximum; Dropped marks a die excluded by a keep clause.
+type Die struct {
+	Value    int
+	Exploded bool
+	Dropped  bool
+}
+
+// Result is the outcome of rolling an Expression. Dice lists every die in
+// roll order, including exploded and dropped dice.
+type Result struct {
+	Expression Expression
+	Dice       []Die
+	Total      int
+}
+
+// Roll rolls e using src. Each die that rolls its maximum on an exploding
+// expression adds another die, up to MaxExplosions per original die. Keep
+// clauses select from the whole pool, exploded dice included.
+func (e Expression) Roll(src Source) (Result, error) {
+	if err := e.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	dice := make([]Die, 0, e.Count)
+	for range e.Count {
+		exploded := false
+		for n := 0; n <= MaxExplosions; n++ {
+			v, err := src.Intn(e.Sides)
+			if err != nil {
+				return Result{}, err
+			}
+			dice = append(dice, Die{Value: v + 1, Exploded: exploded})
+			if !e.Explode || v+1 < e.Sides {
+				break
+			}
+			exploded = true
+		}
+	}
+
+	if e.Keep > 0 {
+		applyKeep(dice, e.Keep, e.KeepLowest)
+	}
+
+	total := e.Modifier
+	for _, d := range dice {
+		if !d.Dropped {
+			total += d.Value
+		}
+	}
+	return Result{Expression: e, Dice: dice, Total: total}, nil
+}
+
+// applyKeep marks every die outside the keep set as dropped. With
+// keepLowest the lowest keep dice are kept instead of the highest. Ties are
+// broken by roll order so the result is deterministic for a given roll.
+func applyKeep(dice []Die, keep int, keepLowest bool) {
+	order := make([]int, len(dice))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if keepLowest {
+			return dice[order[a]].Value < dice[order[b]].Value
+		}
+		return dice[order[a]].Value > dice[order[b]].Value
+	})
+	for _, i := range order[keep:] {
+		dice[i].Dropped = true
+	}
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+)
+
+// MaxRollReasonLength bounds the free-text reason attached to a roll.
+const MaxRollReasonLength = 200
+
+// rollStreamPrefixes are the domain-relative streams a roll may be announced
+// on: the roller's location or the scene they are playing in.
+var rollStreamPrefixes = []string{"location.", "scene."}
+
+// RollRequest asks the DiceService to roll on behalf of a character.
+type RollRequest struct {
+	// CharacterID is the character the roll is attributed to.
+	CharacterID ulid.ULID
+	// Stream is the domain-relative stream the roll is announced on,
+	// "location.<id>" or "scene.<id>".
+	Stream string
+	// Expression is the dice expression, e.g. "3d6+2".
+	Expression string
+	// Reason is optional free text shown with the roll, e.g. "perception".
+	Reason string
+}
+
+// DiceService rolls dice on the server and publishes each result as a roll
+// event. Results are never accepted from callers: the published event, which
+// the event store retains like any other stream event, is the authoritative
+// record of the roll, and every roll is also logged for audit.
+type DiceService struct {
+	pub    eventbus.Publisher
+	gameID func() string
+	src    Source
+}
+
+// DiceOption configures a DiceService.
+type DiceOption func(*DiceService)
+
+// WithSource overrides the random source. Intended for tests; production
+// uses CryptoSource.
+func WithSource(src Source) DiceOption {
+	return func(s *DiceService) {
+		s.src = src
+	}
+}
+
+// NewDiceService constructs a DiceService publishing through pub and
+// qualifying subjects with the game id returned by gameID.
+//
+// Panics when pub or gameID is nil, mirroring presence.NewEmitter's
+// construction-time failure discipline.
+func NewDiceService(pub eventbus.Publisher, gameID func() string, opts ...DiceOption) *DiceService {
+	if pub == nil || eventbus.IsNilPublisher(pub) {
+		panic("game.NewDiceService: nil Publisher")
+	}
+	if gameID == nil {
+		panic("game.NewDiceService: nil gameID")
+	}
+	s := &DiceService{pub: pub, gameID: gameID, src: CryptoSource{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Roll parses req.Expression, rolls it, and publishes a roll event on
+// req.Stream attributed to req.CharacterID. The result is returned only
+// after the event is published, so a caller never sees a roll that other
+// players did not.
+func (s *DiceService) Roll(ctx context.Context, req RollRequest) (Result, error) {
+	if req.CharacterID == (ulid.ULID{}) {
+		return Result{}, oops.Code("DICE_INVALID_REQUEST").Errorf("roll requires a character")
+	}
+	if !validRollStream(req.Stream) {
+		return Result{}, oops.Code("DICE_INVALID_STREAM").
+			With("stream", req.Stream).
+			Errorf("roll stream must be location.<id> or scene.<id>")
+	}
+	if len(req.Reason) > MaxRollReasonLength {
+		return Result{}, oops.Code("DICE_INVALID_REQUEST").
+			With("reason_length", len(req.Reason)).
+			Errorf("roll reason exceeds %d bytes", MaxRollReasonLength)
+	}
+
+	expr, err := ParseExpression(req.Expression)
+	if err != nil {
+		return Result{}, err
+	}
+	result, err := expr.Roll(s.src)
+	if err != nil {
+		return Result{}, oops.Code("DICE_ROLL_FAILED").With("expression", expr.String()).Wrap(err)
+	}
+
+	ev, err := s.buildEvent(req, result)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := s.pub.Publish(ctx, ev); err != nil {
+		return Result{}, oops.Code("DICE_PUBLISH_FAILED").
+			With("stream", req.Stream).
+			Wrap(err)
+	}
+
+	slog.InfoContext(ctx, "dice roll",
+		"event_id", ev.ID.String(),
+		"character_id", req.CharacterID.String(),
+		"stream", req.Stream,
+		"expression", expr.String(),
+		"total", result.Total,
+		"reason", req.Reason)
+	return result, nil
+}
+
+func (s *DiceService) buildEvent(req RollRequest, result Result) (eventbus.Event, error) {
+	var zero eventbus.Event
+
+	dice := make([]eventvocab.RollDie, len(result.Dice))
+	for i, d := range result.Dice {
+		dice[i] = eventvocab.RollDie{Value: d.Value, Exploded: d.Exploded, Dropped: d.Dropped}
+	}
+	payload, err := json.Marshal(eventvocab.RollPayload{
+		CharacterID: req.CharacterID.String(),
+		Expression:  result.Expression.String(),
+		Dice:        dice,
+		Modifier:    result.Expression.Modifier,
+		Total:       result.Total,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return zero, oops.With("operation", "marshal_roll_payload").Wrap(err)
+	}
+
+	gameID := s.gameID()
+	if gameID == "" {
+		gameID = "main"
+	}
+	sub, err := eventbus.Qualify(gameID, req.Stream)
+	if err != nil {
+		return zero, oops.Code("DICE_INVALID_STREAM").With("stream", req.Stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeRoll))
+	if err != nil {
+		return zero, oops.With("type", string(eventvocab.EventTypeRoll)).Wrap(err)
+	}
+
+	actor := eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: req.CharacterID}
+	return eventbus.NewEvent(sub, typ, actor, payload), nil
+}
+
+// validRollStream reports whether stream is a location or scene stream with
+// a well-formed entity ID.
+func validRollStream(stream string) bool {
+	for _, prefix := range rollStreamPrefixes {
+		if id, ok := strings.CutPrefix(stream, prefix); ok {
+			_, err := ulid.Parse(id)
+			return err == nil
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakePublisher is a hand-rolled eventbus.Publisher recording every
+// published event, modeled on internal/presence's test fake.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) events() []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventbus.Event(nil), f.published...)
+}
+
+func mainGameID() string { return "main" }
+
+func TestNewDiceServicePanicsOnNilDependencies(t *testing.T) {
+	var nilPub *fakePublisher
+	assert.Panics(t, func() { NewDiceService(nil, mainGameID) })
+	assert.Panics(t, func() { NewDiceService(nilPub, mainGameID) })
+	assert.Panics(t, func() { NewDiceService(&fakePublisher{}, nil) })
+}
+
+func TestDiceServiceRollPublishesRollEvent(t *testing.T) {
+	pub := &fakePublisher{}
+	svc := NewDiceService(pub, mainGameID, WithSource(&seqSource{faces: []int{3, 1, 5, 3}}))
+	charID := idgen.New()
+	locID := idgen.New()
+
+	got, err := svc.Roll(context.Background(), RollRequest{
+		CharacterID: charID,
+		Stream:      "location." + locID.String(),
+		Expression:  "4d6kh3+1",
+		Reason:      "strength",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 12, got.Total)
+
+	events := pub.events()
+	require.Len(t, events, 1)
+	ev := events[0]
+	assert.Equal(t, eventbus.Subject("events.main.location."+locID.String()), ev.Subject)
+	assert.Equal(t, eventbus.Type(eventvocab.EventTypeRoll), ev.Type)
+	assert.Equal(t, eventbus.ActorKindCharacter, ev.Actor.Kind)
+	assert.Equal(t, charID, ev.Actor.ID)
+
+	var payload eventvocab.RollPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, eventvocab.RollPayload{
+		CharacterID: charID.String(),
+		Expression:  "4d6kh3+1",
+		Dice: []eventvocab.RollDie{
+			{Value: 3}, {Value: 1, Dropped: true}, {Value: 5}, {Value: 3},
+		},
+		Modifier: 1,
+		Total:    12,
+		Reason:   "strength",
+	}, payload)
+}
+
+func TestDiceServiceRollAcceptsSceneStream(t *testing.T) {
+	pub := &fakePublisher{}
+	svc := NewDiceService(pub, func() string { return "" }, WithSource(&seqSource{faces: []int{20}}))
+	sceneID := idgen.New()
+
+	_, err := svc.Roll(context.Background(), RollRequest{
+		CharacterID: idgen.New(),
+		Stream:      "scene." + sceneID.String(),
+		Expression:  "d20",
+	})
+	require.NoError(t, err)
+
+	events := pub.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, eventbus.Subject("events.main.scene."+sceneID.String()), events[0].Subject,
+		"an empty game id falls back to main, matching presence.Emitter")
+}
+
+func TestDiceServiceRollRejectsInvalidRequests(t *testing.T) {
+	valid := RollRequest{
+		CharacterID: idgen.New(),
+		Stream:      "location." + idgen.New().String(),
+		Expression:  "1d6",
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(*RollRequest)
+		wantCode string
+	}{
+		{name: "missing character", mutate: func(r *RollRequest) { r.CharacterID = ulid.ULID{} }, wantCode: "DICE_INVALID_REQUEST"},
+		{name: "character stream", mutate: func(r *RollRequest) { r.Stream = "character." + idgen.New().String() }, wantCode: "DICE_INVALID_STREAM"},
+		{name: "malformed location id", mutate: func(r *RollRequest) { r.Stream = "location.nope" }, wantCode: "DICE_INVALID_STREAM"},
+		{name: "empty stream", mutate: func(r *RollRequest) { r.Stream = "" }, wantCode: "DICE_INVALID_STREAM"},
+		{name: "reason too long", mutate: func(r *RollRequest) { r.Reason = strings.Repeat("x", MaxRollReasonLength+1) }, wantCode: "DICE_INVALID_REQUEST"},
+		{name: "bad expression", mutate: func(r *RollRequest) { r.Expression = "lots" }, wantCode: "DICE_INVALID_EXPRESSION"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &fakePublisher{}
+			svc := NewDiceService(pub, mainGameID)
+			req := valid
+			tt.mutate(&req)
+
+			_, err := svc.Roll(context.Background(), req)
+			errutil.AssertErrorCode(t, err, tt.wantCode)
+			assert.Empty(t, pub.events(), "a rejected roll must not publish")
+		})
+	}
+}
+
+func TestDiceServiceRollWrapsSourceFailure(t *testing.T) {
+	pub := &fakePublisher{}
+	svc := NewDiceService(pub, mainGameID, WithSource(&seqSource{err: errors.New("entropy gone")}))
+
+	_, err := svc.Roll(context.Background(), RollRequest{
+		CharacterID: idgen.New(),
+		Stream:      "location." + idgen.New().String(),
+		Expression:  "1d6",
+	})
+	errutil.AssertErrorCode(t, err, "DICE_ROLL_FAILED")
+	assert.Empty(t, pub.events())
+}
+
+func TestDiceServiceRollWrapsPublishFailure(t *testing.T) {
+	pub := &fakePublisher{err: errors.New("bus unavailable")}
+	svc := NewDiceService(pub, mainGameID)
+
+	_, err := svc.Roll(context.Background(), RollRequest{
+		CharacterID: idgen.New(),
+		Stream:      "location." + idgen.New().String(),
+		Expression:  "1d6",
+	})
+	errutil.AssertErrorCode(t, err, "DICE_PUBLISH_FAILED")
+}
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package game
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// seqSource is a Source returning a fixed sequence of die faces (1-based),
+// so tests can state rolls the way a player reads them.
+type seqSource struct {
+	faces []int
+	err   error
+}
+
+func (s *seqSource) Intn(n int) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	if len(s.faces) == 0 {
+		return 0, errors.New("seqSource exhausted")
+	}
+	face := s.faces[0]
+	s.faces = s.faces[1:]
+	if face < 1 || face > n {
+		return 0, errors.New("seqSource face out of range")
+	}
+	return face - 1, nil
+}
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Expression
+	}{
+		{name: "plain", input: "3d6", want: Expression{Count: 3, Sides: 6}},
+		{name: "implicit count", input: "d20", want: Expression{Count: 1, Sides: 20}},
+		{name: "positive modifier", input: "3d6+2", want: Expression{Count: 3, Sides: 6, Modifier: 2}},
+		{name: "negative modifier", input: "1d8-1", want: Expression{Count: 1, Sides: 8, Modifier: -1}},
+		{name: "keep highest", input: "4d6kh3", want: Expression{Count: 4, Sides: 6, Keep: 3}},
+		{name: "keep lowest", input: "2d20kl1", want: Expression{Count: 2, Sides: 20, Keep: 1, KeepLowest: true}},
+		{name: "exploding", input: "2d10!", want: Expression{Count: 2, Sides: 10, Explode: true}},
+		{name: "everything", input: "5d6!kh3+4", want: Expression{Count: 5, Sides: 6, Explode: true, Keep: 3, Modifier: 4}},
+		{name: "whitespace and case", input: " 3 D6 + 2 ", want: Expression{Count: 3, Sides: 6, Modifier: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseExpression(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseExpressionRejectsInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "empty", input: ""},
+		{name: "no sides", input: "3d"},
+		{name: "garbage", input: "roll me"},
+		{name: "zero dice", input: "0d6"},
+		{name: "too many dice", input: "101d6"},
+		{name: "zero sides", input: "1d0"},
+		{name: "too many sides", input: "1d1001"},
+		{name: "exploding d1", input: "3d1!"},
+		{name: "keep more than rolled", input: "2d6kh3"},
+		{name: "keep highest zero", input: "2d6kh0"},
+		{name: "keep lowest zero", input: "2d6kl0"},
+		{name: "modifier too large", input: "1d6+10001"},
+		{name: "two modifiers", input: "1d6+1+1"},
+		{name: "overflowing count", input: "99999999999999999999d6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExpression(tt.input)
+			errutil.AssertErrorCode(t, err, "DICE_INVALID_EXPRESSION")
+		})
+	}
+}
+
+func TestExpressionStringIsCanonical(t *testing.T) {
+	for _, input := range []string{"3d6", "1d20", "4d6kh3", "2d20kl1", "2d10!", "5d6!kh3+4", "1d8-1"} {
+		t.Run(input, func(t *testing.T) {
+			expr, err := ParseExpression(input)
+			require.NoError(t, err)
+			assert.Equal(t, input, expr.String())
+		})
+	}
+	expr, err := ParseExpression("D20")
+	require.NoError(t, err)
+	assert.Equal(t, "1d20", expr.String())
+}
+
+func TestExpressionRollSumsDiceAndModifier(t *testing.T) {
+	expr := Expression{Count: 3, Sides: 6, Modifier: 2}
+
+	got, err := expr.Roll(&seqSource{faces: []int{1, 4, 6}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{{Value: 1}, {Value: 4}, {Value: 6}}, got.Dice)
+	assert.Equal(t, 13, got.Total)
+	assert.Equal(t, expr, got.Expression)
+}
+
+func TestExpressionRollKeepHighestDropsLowest(t *testing.T) {
+	expr := Expression{Count: 4, Sides: 6, Keep: 3}
+
+	got, err := expr.Roll(&seqSource{faces: []int{3, 1, 5, 3}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{{Value: 3}, {Value: 1, Dropped: true}, {Value: 5}, {Value: 3}}, got.Dice)
+	assert.Equal(t, 11, got.Total)
+}
+
+func TestExpressionRollKeepLowestDropsHighest(t *testing.T) {
+	expr := Expression{Count: 2, Sides: 20, Keep: 1, KeepLowest: true}
+
+	got, err := expr.Roll(&seqSource{faces: []int{17, 4}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{{Value: 17, Dropped: true}, {Value: 4}}, got.Dice)
+	assert.Equal(t, 4, got.Total)
+}
+
+func TestExpressionRollKeepBreaksTiesByRollOrder(t *testing.T) {
+	expr := Expression{Count: 3, Sides: 6, Keep: 1}
+
+	got, err := expr.Roll(&seqSource{faces: []int{5, 5, 2}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{{Value: 5}, {Value: 5, Dropped: true}, {Value: 2, Dropped: true}}, got.Dice)
+}
+
+func TestExpressionRollExplodesOnMaximum(t *testing.T) {
+	expr := Expression{Count: 2, Sides: 6, Explode: true}
+
+	got, err := expr.Roll(&seqSource{faces: []int{6, 6, 2, 3}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{
+		{Value: 6},
+		{Value: 6, Exploded: true},
+		{Value: 2, Exploded: true},
+		{Value: 3},
+	}, got.Dice)
+	assert.Equal(t, 17, got.Total)
+}
+
+func TestExpressionRollKeepSelectsFromExplodedPool(t *testing.T) {
+	expr := Expression{Count: 2, Sides: 6, Explode: true, Keep: 2}
+
+	got, err := expr.Roll(&seqSource{faces: []int{6, 4, 1}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Die{{Value: 6}, {Value: 4, Exploded: true}, {Value: 1, Dropped: true}}, got.Dice)
+	assert.Equal(t, 10, got.Total)
+}
+
+func TestExpressionRollCapsExplosions(t *testing.T) {
+	faces := make([]int, MaxExplosions+1)
+	for i := range faces {
+		faces[i] = 2
+	}
+	expr := Expression{Count: 1, Sides: 2, Explode: true}
+
+	got, err := expr.Roll(&seqSource{faces: faces})
+	require.NoError(t, err)
+
+	assert.Len(t, got.Dice, MaxExplosions+1)
+	assert.Equal(t, 2*(MaxExplosions+1), got.Total)
+}
+
+func TestExpressionRollPropagatesSourceError(t *testing.T) {
+	_, err := Expression{Count: 1, Sides: 6}.Roll(&seqSource{err: errors.New("entropy gone")})
+	assert.Error(t, err)
+}
+
+func TestExpressionRollRejectsInvalidExpression(t *testing.T) {
+	_, err := Expression{Count: 0, Sides: 6}.Roll(CryptoSource{})
+	errutil.AssertErrorCode(t, err, "DICE_INVALID_EXPRESSION")
+}
+
+func TestCryptoSourceStaysInRange(t *testing.T) {
+	expr := Expression{Count: MaxDice, Sides: 6}
+	got, err := expr.Roll(CryptoSource{})
+	require.NoError(t, err)
+	for _, d := range got.Dice {
+		assert.GreaterOrEqual(t, d.Value, 1)
+		assert.LessOrEqual(t, d.Value, 6)
+	}
+}
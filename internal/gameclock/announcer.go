@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package gameclock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// OutdoorTag marks the locations that see dawn and dusk.
+const OutdoorTag = "outdoor"
+
+// Locations finds the locations carrying a tag. *world.Service satisfies
+// it.
+type Locations interface {
+	FindByTag(ctx context.Context, subjectID, tag string, kind world.TagKind) ([]world.TaggedEntity, error)
+}
+
+// Announcer sends a daylight event to every outdoor-tagged location at each
+// IC dawn and dusk.
+//
+// Every replica runs an Announcer, and each computes the same transitions
+// from the clock. The event for a location and transition has an ID derived
+// from the two, so the event bus's duplicate window drops the copies the
+// other replicas publish and each location sees each dawn once.
+//
+// Sending needs an event publisher, bound after construction with
+// SetPublisher once the event bus is up. Until then Announce is a no-op.
+type Announcer struct {
+	clock     *Clock
+	locations Locations
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu     sync.RWMutex
+	pub    eventbus.Publisher
+	gameID func() string
+}
+
+// NewAnnouncer creates an Announcer for clock that finds outdoor locations
+// through locations. A nil logger uses slog.Default().
+func NewAnnouncer(clock *Clock, locations Locations, logger *slog.Logger) *Announcer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Announcer{clock: clock, locations: locations, logger: logger, now: time.Now}
+}
+
+// SetPublisher binds the publisher daylight events are sent through. gameID
+// supplies the game id that qualifies event subjects.
+func (a *Announcer) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.pub = pub
+	a.gameID = gameID
+}
+
+func (a *Announcer) publisher() (eventbus.Publisher, func() string) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if a.pub == nil || eventbus.IsNilPublisher(a.pub) || a.gameID == nil {
+		return nil, nil
+	}
+	return a.pub, a.gameID
+}
+
+// Announce sends tr to every outdoor location. A location that fails does
+// not stop the others; their errors are joined.
+func (a *Announcer) Announce(ctx context.Context, tr Transition) error {
+	pub, gameID := a.publisher()
+	if pub == nil {
+		return nil
+	}
+	outdoor, err := a.locations.FindByTag(access.WithSystemSubject(ctx), access.SubjectSystem, OutdoorTag, world.TagKindLocation)
+	if err != nil {
+		return oops.With("tag", OutdoorTag).Wrap(err)
+	}
+	var errs []error
+	for _, loc := range outdoor {
+		if err := publishDaylight(ctx, pub, gameID, tr, a.clock.text(tr), loc.ID); err != nil {
+			errs = append(errs, oops.With("location_id", loc.ID.String()).Wrap(err))
+		}
+	}
+	a.logger.DebugContext(ctx, "daylight announced",
+		"kind", string(tr.Kind),
+		"ic_time", tr.Moment.String(),
+		"locations", len(outdoor),
+	)
+	return errors.Join(errs...)
+}
+
+// Run announces each dawn and dusk as it comes until ctx is cancelled.
+// Announce errors are logged and do not stop the loop. Transitions that
+// passed while no replica was running are not announced late.
+func (a *Announcer) Run(ctx context.Context) {
+	for {
+		tr := a.clock.NextTransition(a.now())
+		timer := time.NewTimer(tr.At.Sub(a.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if err := a.Announce(ctx, tr); err != nil {
+			a.logger.WarnContext(ctx, "daylight announcement failed", "kind", string(tr.Kind), "error", err)
+		}
+	}
+}
+
+// publishDaylight sends tr as a daylight event on the location's stream.
+func publishDaylight(ctx context.Context, pub eventbus.Publisher, gameID func() string, tr Transition, text string, locationID ulid.ULID) error {
+	payload, err := json.Marshal(eventvocab.DaylightPayload{
+		LocationID: locationID.String(),
+		Kind:       string(tr.Kind),
+		Season:     tr.Moment.Season,
+		Date:       tr.Moment.Date(),
+		Clock:      tr.Moment.Clock(),
+		Text:       text,
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_daylight_payload").Wrap(err)
+	}
+	stream := world.LocationStream(locationID)
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeDaylight))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeDaylight)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}
+	ev := eventbus.NewEvent(sub, typ, actor, payload)
+	ev.ID = transitionEventID(tr, locationID)
+	ev.Timestamp = tr.At
+	if err := pub.Publish(ctx, ev); err != nil {
+		return oops.Code("GAMECLOCK_PUBLISH_FAILED").With("stream", stream).Wrap(err)
+	}
+	return nil
+}
+
+// transitionEventID derives the ID of the daylight event for tr in a
+// location: its time is the transition's, and its entropy a hash of the
+// transition and location, so every replica publishes the same ID.
+func transitionEventID(tr Transition, locationID ulid.ULID) ulid.ULID {
+	sum := sha256.Sum256([]byte(string(tr.Kind) + "/" + strconv.FormatInt(tr.minute, 10) + "/" + locationID.String()))
+	var id ulid.ULID
+	// Transition times fall well inside the ULID time range.
+	_ = id.SetTime(ulid.Timestamp(tr.At))
+	copy(id[6:], sum[:10])
+	return id
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
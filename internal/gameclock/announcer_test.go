@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package gameclock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeLocations returns the same tagged locations for every query and
+// records what it was asked.
+type fakeLocations struct {
+	found   []world.TaggedEntity
+	err     error
+	subject string
+	tag     string
+	kind    world.TagKind
+}
+
+func (f *fakeLocations) FindByTag(_ context.Context, subjectID, tag string, kind world.TagKind) ([]world.TaggedEntity, error) {
+	f.subject, f.tag, f.kind = subjectID, tag, kind
+	return f.found, f.err
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) events() []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]eventbus.Event(nil), f.published...)
+}
+
+func mainGameID() string { return "main" }
+
+func TestAnnounceSendsDaylightToOutdoorLocations(t *testing.T) {
+	c := newDefaultClock(t)
+	field, beach := ulid.Make(), ulid.Make()
+	locs := &fakeLocations{found: []world.TaggedEntity{
+		{Kind: world.TagKindLocation, ID: field, Name: "Field"},
+		{Kind: world.TagKindLocation, ID: beach, Name: "Beach"},
+	}}
+	pub := &fakePublisher{}
+	a := NewAnnouncer(c, locs, nil)
+	a.SetPublisher(pub, mainGameID)
+
+	dusk := c.NextTransition(DefaultEpoch.Add(2 * time.Hour))
+	require.NoError(t, a.Announce(context.Background(), dusk))
+
+	assert.Equal(t, access.SubjectSystem, locs.subject)
+	assert.Equal(t, OutdoorTag, locs.tag)
+	assert.Equal(t, world.TagKindLocation, locs.kind)
+
+	events := pub.events()
+	require.Len(t, events, 2)
+	ev := events[0]
+	assert.Equal(t, "events.main."+world.LocationStream(field), string(ev.Subject))
+	assert.Equal(t, string(eventvocab.EventTypeDaylight), string(ev.Type))
+	assert.Equal(t, dusk.At, ev.Timestamp)
+	var payload eventvocab.DaylightPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, eventvocab.DaylightPayload{
+		LocationID: field.String(),
+		Kind:       "dusk",
+		Season:     "winter",
+		Date:       "1 January, year 1",
+		Clock:      "18:00",
+		Text:       DefaultDuskText,
+	}, payload)
+	assert.Equal(t, "events.main."+world.LocationStream(beach), string(events[1].Subject))
+}
+
+func TestAnnounceIDsMatchAcrossReplicas(t *testing.T) {
+	c := newDefaultClock(t)
+	loc := ulid.Make()
+	locs := &fakeLocations{found: []world.TaggedEntity{{Kind: world.TagKindLocation, ID: loc}}}
+	first, second := &fakePublisher{}, &fakePublisher{}
+	a := NewAnnouncer(c, locs, nil)
+	a.SetPublisher(first, mainGameID)
+	b := NewAnnouncer(c, locs, nil)
+	b.SetPublisher(second, mainGameID)
+
+	dawn := c.NextTransition(DefaultEpoch)
+	require.NoError(t, a.Announce(context.Background(), dawn))
+	require.NoError(t, b.Announce(context.Background(), dawn))
+	require.NoError(t, b.Announce(context.Background(), c.NextTransition(dawn.At)))
+
+	got := append(first.events(), second.events()...)
+	require.Len(t, got, 3)
+	assert.Equal(t, got[0].ID, got[1].ID, "replicas publish the same ID, so the bus drops the copy")
+	assert.NotEqual(t, got[0].ID, got[2].ID, "each transition has its own ID")
+	assert.Equal(t, dawn.At.UnixMilli(), int64(got[0].ID.Time()))
+}
+
+func TestAnnounceWithoutPublisherIsNoop(t *testing.T) {
+	locs := &fakeLocations{err: errors.New("must not be called")}
+	a := NewAnnouncer(newDefaultClock(t), locs, nil)
+	require.NoError(t, a.Announce(context.Background(), Transition{Kind: TransitionDawn}))
+	assert.Empty(t, locs.tag)
+}
+
+func TestAnnouncePublishFailureIsReported(t *testing.T) {
+	c := newDefaultClock(t)
+	locs := &fakeLocations{found: []world.TaggedEntity{{Kind: world.TagKindLocation, ID: ulid.Make()}}}
+	a := NewAnnouncer(c, locs, nil)
+	a.SetPublisher(&fakePublisher{err: errors.New("bus down")}, mainGameID)
+
+	err := a.Announce(context.Background(), c.NextTransition(DefaultEpoch))
+	errutil.AssertErrorCode(t, err, "GAMECLOCK_PUBLISH_FAILED")
+}
@@ -0,0 +1,364 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package gameclock keeps the game's in-character (IC) time: a clock that
+// runs at a configurable multiple of real time over a calendar of named
+// months and seasons, with a dawn and a dusk each IC day.
+//
+// The clock holds no state. Every IC moment is computed from the real time,
+// the configured epoch, and the ratio, so every replica reads the same time
+// without coordinating, and a restart picks up where the clock would have
+// been. Descriptions read the time through substitution tokens such as
+// %time% and %season% (see Moment.Expand), and the Announcer sends a line to
+// every outdoor location at dawn and dusk.
+package gameclock
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// Calendar defaults, applied by New to zero-valued Config fields.
+const (
+	// DefaultRatio runs the IC clock four times as fast as real time: one IC
+	// day passes every six real hours.
+	DefaultRatio = 4.0
+	// DefaultDawnHour and DefaultDuskHour are the IC hours the sun rises and
+	// sets.
+	DefaultDawnHour = 6
+	DefaultDuskHour = 18
+	// DefaultDawnText and DefaultDuskText are the lines outdoor locations
+	// see at dawn and dusk.
+	DefaultDawnText = "The sun rises."
+	DefaultDuskText = "The sun sets."
+)
+
+// Calendar limits.
+const (
+	// MaxRatio bounds how fast the IC clock may run: one IC day a minute.
+	MaxRatio = 1440
+	// MaxMonths bounds how many months an IC year may have.
+	MaxMonths = 48
+	// MaxMonthDays bounds how many days an IC month may have.
+	MaxMonthDays = 100
+)
+
+const (
+	hoursPerDay   = 24
+	minutesPerDay = hoursPerDay * 60
+)
+
+// DefaultEpoch is the real time the calendar starts at when none is
+// configured.
+var DefaultEpoch = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Month is one month of the IC calendar.
+type Month struct {
+	Name string
+	Days int
+}
+
+// Season is one season of the IC calendar. It starts on the first day of
+// StartMonth (1-based) and lasts until the next season starts, wrapping
+// into the following year.
+type Season struct {
+	Name       string
+	StartMonth int
+}
+
+// DefaultMonths returns the Gregorian months, without leap days.
+func DefaultMonths() []Month {
+	return []Month{
+		{"January", 31}, {"February", 28}, {"March", 31}, {"April", 30},
+		{"May", 31}, {"June", 30}, {"July", 31}, {"August", 31},
+		{"September", 30}, {"October", 31}, {"November", 30}, {"December", 31},
+	}
+}
+
+// DefaultSeasons returns the meteorological seasons of the northern
+// hemisphere over DefaultMonths. Names are lower case so they read well
+// mid-sentence in descriptions.
+func DefaultSeasons() []Season {
+	return []Season{
+		{"spring", 3}, {"summer", 6}, {"autumn", 9}, {"winter", 12},
+	}
+}
+
+// Config describes the IC calendar and how fast it runs.
+type Config struct {
+	// Epoch is the real time at which the calendar reads midnight on the
+	// first day of the first month of StartYear. Zero uses DefaultEpoch.
+	Epoch time.Time
+	// StartYear is the IC year at Epoch. Zero starts at year 1.
+	StartYear int
+	// Ratio is how many IC seconds pass per real second. Zero uses
+	// DefaultRatio.
+	Ratio float64
+	// Months lists the months of an IC year in order. Empty uses
+	// DefaultMonths and, when Seasons is also empty, DefaultSeasons.
+	Months []Month
+	// Seasons lists the seasons. Empty means none unless Months is empty
+	// too; %season% then renders as nothing.
+	Seasons []Season
+	// DawnHour and DuskHour are the IC hours the sun rises and sets. Zero
+	// uses DefaultDawnHour and DefaultDuskHour.
+	DawnHour int
+	DuskHour int
+	// DawnText and DuskText are the lines sent to outdoor locations at dawn
+	// and dusk. Empty uses DefaultDawnText and DefaultDuskText.
+	DawnText string
+	DuskText string
+}
+
+// withDefaults returns c with its zero-valued fields defaulted.
+func (c Config) withDefaults() Config {
+	if c.Epoch.IsZero() {
+		c.Epoch = DefaultEpoch
+	}
+	if c.StartYear == 0 {
+		c.StartYear = 1
+	}
+	if c.Ratio == 0 {
+		c.Ratio = DefaultRatio
+	}
+	if len(c.Months) == 0 {
+		c.Months = DefaultMonths()
+		if len(c.Seasons) == 0 {
+			c.Seasons = DefaultSeasons()
+		}
+	}
+	if c.DawnHour == 0 {
+		c.DawnHour = DefaultDawnHour
+	}
+	if c.DuskHour == 0 {
+		c.DuskHour = DefaultDuskHour
+	}
+	if c.DawnText == "" {
+		c.DawnText = DefaultDawnText
+	}
+	if c.DuskText == "" {
+		c.DuskText = DefaultDuskText
+	}
+	return c
+}
+
+// Validate reports whether c, after defaults, describes a usable calendar.
+// Returns GAMECLOCK_INVALID otherwise.
+func (c Config) Validate() error {
+	c = c.withDefaults()
+	invalid := oops.Code("GAMECLOCK_INVALID")
+	if c.Ratio < 0 || c.Ratio > MaxRatio || math.IsNaN(c.Ratio) {
+		return invalid.With("ratio", c.Ratio).Errorf("ratio must be above 0 and at most %d", MaxRatio)
+	}
+	if len(c.Months) > MaxMonths {
+		return invalid.Errorf("a year may have at most %d months", MaxMonths)
+	}
+	monthNames := map[string]bool{}
+	for i, m := range c.Months {
+		name := strings.TrimSpace(m.Name)
+		if name == "" {
+			return invalid.With("month", i+1).Errorf("month %d has no name", i+1)
+		}
+		if monthNames[strings.ToLower(name)] {
+			return invalid.With("month", name).Errorf("month %q is listed twice", name)
+		}
+		monthNames[strings.ToLower(name)] = true
+		if m.Days < 1 || m.Days > MaxMonthDays {
+			return invalid.With("month", name).Errorf("month %q must have 1 to %d days", name, MaxMonthDays)
+		}
+	}
+	starts := map[int]bool{}
+	for _, s := range c.Seasons {
+		if strings.TrimSpace(s.Name) == "" {
+			return invalid.Errorf("a season has no name")
+		}
+		if s.StartMonth < 1 || s.StartMonth > len(c.Months) {
+			return invalid.With("season", s.Name).Errorf("season %q must start in a month from 1 to %d", s.Name, len(c.Months))
+		}
+		if starts[s.StartMonth] {
+			return invalid.With("season", s.Name).Errorf("two seasons start in month %d", s.StartMonth)
+		}
+		starts[s.StartMonth] = true
+	}
+	// Dawn and dusk bracket noon, leaving room for a morning and an
+	// afternoon between them and a night after dusk.
+	if c.DawnHour < 1 || c.DawnHour > 11 {
+		return invalid.With("dawn_hour", c.DawnHour).Errorf("dawn must be from hour 1 to 11")
+	}
+	if c.DuskHour < 12 || c.DuskHour > 22 {
+		return invalid.With("dusk_hour", c.DuskHour).Errorf("dusk must be from hour 12 to 22")
+	}
+	return nil
+}
+
+// Period is a part of the IC day.
+type Period string
+
+// Periods of the IC day, in order from midnight.
+const (
+	PeriodNight     Period = "night"
+	PeriodDawn      Period = "dawn"
+	PeriodMorning   Period = "morning"
+	PeriodAfternoon Period = "afternoon"
+	PeriodDusk      Period = "dusk"
+	PeriodEvening   Period = "evening"
+)
+
+// eveningEndHour is the IC hour evening gives way to night, unless dusk
+// falls later.
+const eveningEndHour = 22
+
+// Moment is a point in IC time.
+type Moment struct {
+	Year      int
+	Month     int // 1-based
+	MonthName string
+	Day       int // 1-based
+	Hour      int
+	Minute    int
+	Season    string
+	Period    Period
+}
+
+// Clock returns the time of day as "15:04".
+func (m Moment) Clock() string {
+	return fmt.Sprintf("%02d:%02d", m.Hour, m.Minute)
+}
+
+// Date returns the date as "3 March, year 12".
+func (m Moment) Date() string {
+	return fmt.Sprintf("%d %s, year %d", m.Day, m.MonthName, m.Year)
+}
+
+// String returns the date and time of day.
+func (m Moment) String() string {
+	return m.Date() + ", " + m.Clock()
+}
+
+// Clock converts real time to IC time. It is safe for concurrent use.
+type Clock struct {
+	cfg      Config
+	seasons  []Season // sorted by StartMonth
+	yearDays int
+	now      func() time.Time
+}
+
+// New creates a Clock for cfg, defaulting its zero-valued fields. Returns
+// GAMECLOCK_INVALID when cfg does not describe a usable calendar.
+func New(cfg Config) (*Clock, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg = cfg.withDefaults()
+	seasons := slices.Clone(cfg.Seasons)
+	slices.SortFunc(seasons, func(a, b Season) int { return a.StartMonth - b.StartMonth })
+	yearDays := 0
+	for _, m := range cfg.Months {
+		yearDays += m.Days
+	}
+	return &Clock{cfg: cfg, seasons: seasons, yearDays: yearDays, now: time.Now}, nil
+}
+
+// Config returns the clock's configuration with defaults applied.
+func (c *Clock) Config() Config {
+	return c.cfg
+}
+
+// Now returns the current IC time.
+func (c *Clock) Now() Moment {
+	return c.At(c.now())
+}
+
+// At returns the IC time at real time t. Times before the epoch read as the
+// epoch: the calendar does not run backwards.
+func (c *Clock) At(t time.Time) Moment {
+	return c.moment(c.minuteAt(t))
+}
+
+// Expand replaces the substitution tokens in text with the current IC time.
+// See Moment.Expand.
+func (c *Clock) Expand(text string) string {
+	if !strings.Contains(text, "%") {
+		return text
+	}
+	return c.Now().Expand(text)
+}
+
+// minuteAt returns the IC minute, counted from the epoch, at real time t.
+func (c *Clock) minuteAt(t time.Time) int64 {
+	elapsed := t.Sub(c.cfg.Epoch)
+	if elapsed <= 0 {
+		return 0
+	}
+	return int64(math.Floor(float64(elapsed) * c.cfg.Ratio / float64(time.Minute)))
+}
+
+// realTime returns the real time IC minute minute starts at.
+func (c *Clock) realTime(minute int64) time.Time {
+	return c.cfg.Epoch.Add(time.Duration(math.Ceil(float64(minute) * float64(time.Minute) / c.cfg.Ratio)))
+}
+
+// moment returns the IC time minute minutes after the epoch.
+func (c *Clock) moment(minute int64) Moment {
+	day := minute / minutesPerDay
+	of := int(minute % minutesPerDay)
+	m := Moment{
+		Year:   c.cfg.StartYear + int(day/int64(c.yearDays)),
+		Hour:   of / 60,
+		Minute: of % 60,
+	}
+	dayOfYear := int(day % int64(c.yearDays))
+	for i, month := range c.cfg.Months {
+		if dayOfYear < month.Days {
+			m.Month, m.MonthName, m.Day = i+1, month.Name, dayOfYear+1
+			break
+		}
+		dayOfYear -= month.Days
+	}
+	m.Season = c.season(m.Month)
+	m.Period = c.period(m.Hour)
+	return m
+}
+
+// season returns the name of the season month falls in.
+func (c *Clock) season(month int) string {
+	if len(c.seasons) == 0 {
+		return ""
+	}
+	// Before the first season's start the previous year's last season
+	// still runs.
+	name := c.seasons[len(c.seasons)-1].Name
+	for _, s := range c.seasons {
+		if s.StartMonth > month {
+			break
+		}
+		name = s.Name
+	}
+	return name
+}
+
+// period returns the part of the day hour falls in. Dawn and dusk each last
+// the hour they start.
+func (c *Clock) period(hour int) Period {
+	switch {
+	case hour < c.cfg.DawnHour:
+		return PeriodNight
+	case hour == c.cfg.DawnHour:
+		return PeriodDawn
+	case hour < 12:
+		return PeriodMorning
+	case hour < c.cfg.DuskHour:
+		return PeriodAfternoon
+	case hour == c.cfg.DuskHour:
+		return PeriodDusk
+	case hour < eveningEndHour:
+		return PeriodEvening
+	default:
+		return PeriodNight
+	}
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package gameclock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func newDefaultClock(t *testing.T) *Clock {
+	t.Helper()
+	c, err := New(Config{})
+	require.NoError(t, err)
+	return c
+}
+
+func TestAtRunsAtTheRatio(t *testing.T) {
+	c := newDefaultClock(t)
+
+	tests := []struct {
+		name string
+		real time.Duration
+		want Moment
+	}{
+		{
+			name: "epoch",
+			want: Moment{Year: 1, Month: 1, MonthName: "January", Day: 1, Season: "winter", Period: PeriodNight},
+		},
+		{
+			name: "dawn on the first day",
+			real: 90 * time.Minute,
+			want: Moment{Year: 1, Month: 1, MonthName: "January", Day: 1, Hour: 6, Season: "winter", Period: PeriodDawn},
+		},
+		{
+			name: "six real hours is one IC day",
+			real: 6*time.Hour + 15*time.Second,
+			want: Moment{Year: 1, Month: 1, MonthName: "January", Day: 2, Minute: 1, Season: "winter", Period: PeriodNight},
+		},
+		{
+			name: "spring starts in March",
+			real: 59 * 6 * time.Hour,
+			want: Moment{Year: 1, Month: 3, MonthName: "March", Day: 1, Season: "spring", Period: PeriodNight},
+		},
+		{
+			name: "the year turns after 365 IC days",
+			real: 365*6*time.Hour + 3*time.Hour,
+			want: Moment{Year: 2, Month: 1, MonthName: "January", Day: 1, Hour: 12, Season: "winter", Period: PeriodAfternoon},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.At(DefaultEpoch.Add(tt.real)))
+		})
+	}
+
+	assert.Equal(t, c.At(DefaultEpoch), c.At(DefaultEpoch.Add(-time.Hour)), "the calendar does not run before its epoch")
+}
+
+func TestCustomCalendar(t *testing.T) {
+	epoch := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	c, err := New(Config{
+		Epoch:     epoch,
+		StartYear: 312,
+		Ratio:     1,
+		Months:    []Month{{"Thaw", 10}, {"Bloom", 10}, {"Frost", 10}},
+		Seasons:   []Season{{"warm", 2}, {"cold", 3}},
+	})
+	require.NoError(t, err)
+
+	m := c.At(epoch.Add(5*24*time.Hour + 9*time.Hour + 30*time.Minute))
+	assert.Equal(t, Moment{Year: 312, Month: 1, MonthName: "Thaw", Day: 6, Hour: 9, Minute: 30, Season: "cold", Period: PeriodMorning}, m,
+		"the months before the first season's start belong to the previous year's last season")
+	assert.Equal(t, "warm", c.At(epoch.Add(12*24*time.Hour)).Season)
+	assert.Equal(t, 313, c.At(epoch.Add(30*24*time.Hour)).Year)
+}
+
+func TestCustomMonthsHaveNoDefaultSeasons(t *testing.T) {
+	c, err := New(Config{Months: []Month{{"Only", 30}}})
+	require.NoError(t, err)
+	assert.Empty(t, c.Now().Season)
+}
+
+func TestPeriods(t *testing.T) {
+	c := newDefaultClock(t)
+	want := map[int]Period{
+		0: PeriodNight, 5: PeriodNight, 6: PeriodDawn, 7: PeriodMorning, 11: PeriodMorning,
+		12: PeriodAfternoon, 17: PeriodAfternoon, 18: PeriodDusk, 19: PeriodEvening,
+		21: PeriodEvening, 22: PeriodNight, 23: PeriodNight,
+	}
+	for hour, period := range want {
+		assert.Equal(t, period, c.period(hour), "hour %d", hour)
+	}
+}
+
+func TestValidateRejectsBadCalendars(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"negative ratio", Config{Ratio: -1}},
+		{"ratio too fast", Config{Ratio: MaxRatio + 1}},
+		{"unnamed month", Config{Months: []Month{{" ", 30}}}},
+		{"duplicate month", Config{Months: []Month{{"Rain", 30}, {"rain", 30}}}},
+		{"empty month", Config{Months: []Month{{"Rain", 0}}}},
+		{"season past the last month", Config{Months: []Month{{"Rain", 30}}, Seasons: []Season{{"wet", 2}}}},
+		{"two seasons start together", Config{Seasons: []Season{{"wet", 1}, {"dry", 1}}}},
+		{"unnamed season", Config{Seasons: []Season{{"", 1}}}},
+		{"dawn after noon", Config{DawnHour: 12}},
+		{"dusk before noon", Config{DuskHour: 11}},
+		{"dusk at midnight", Config{DuskHour: 23}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			errutil.AssertErrorCode(t, err, "GAMECLOCK_INVALID")
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	m := Moment{Year: 3, Month: 12, MonthName: "December", Day: 24, Hour: 18, Minute: 5, Season: "winter", Period: PeriodDusk}
+
+	assert.Equal(t, "A winter dusk settles. 24 December, year 3 at 18:05; December snow. 100%% sure, %weather%.",
+		m.Expand("A %season% %time% settles. %date% at %clock%; %month% snow. 100%% sure, %weather%."))
+	assert.Equal(t, "no tokens", m.Expand("no tokens"))
+}
+
+func TestClockExpandUsesTheCurrentTime(t *testing.T) {
+	c := newDefaultClock(t)
+	c.now = func() time.Time { return DefaultEpoch.Add(3 * time.Hour) }
+	assert.Equal(t, "It is afternoon at 12:00.", c.Expand("It is %time% at %clock%."))
+}
+
+func TestNextTransition(t *testing.T) {
+	c := newDefaultClock(t)
+
+	dawn := c.NextTransition(DefaultEpoch)
+	assert.Equal(t, TransitionDawn, dawn.Kind)
+	assert.Equal(t, DefaultEpoch.Add(90*time.Minute), dawn.At)
+	assert.Equal(t, 6, dawn.Moment.Hour)
+
+	dusk := c.NextTransition(dawn.At)
+	assert.Equal(t, TransitionDusk, dusk.Kind, "a transition is strictly after the time given")
+	assert.Equal(t, DefaultEpoch.Add(270*time.Minute), dusk.At)
+
+	next := c.NextTransition(dusk.At.Add(time.Minute))
+	assert.Equal(t, TransitionDawn, next.Kind)
+	assert.Equal(t, DefaultEpoch.Add(450*time.Minute), next.At)
+	assert.Equal(t, 2, next.Moment.Day)
+}
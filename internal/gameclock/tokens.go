@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package gameclock
+
+import "strings"
+
+// Substitution tokens Expand replaces.
+const (
+	// TokenTime is the part of the day, such as "morning" or "dusk".
+	TokenTime = "%time%"
+	// TokenSeason is the season's name, such as "winter".
+	TokenSeason = "%season%"
+	// TokenMonth is the month's name.
+	TokenMonth = "%month%"
+	// TokenDate is the date, as "3 March, year 12".
+	TokenDate = "%date%"
+	// TokenClock is the time of day, as "15:04".
+	TokenClock = "%clock%"
+)
+
+// Expand replaces the substitution tokens in text with m, so a description
+// can read "The %season% wind is cold this %time%." Tokens are matched
+// exactly and in lower case; anything else between percent signs is left
+// as written.
+func (m Moment) Expand(text string) string {
+	if !strings.Contains(text, "%") {
+		return text
+	}
+	return strings.NewReplacer(
+		TokenTime, string(m.Period),
+		TokenSeason, m.Season,
+		TokenMonth, m.MonthName,
+		TokenDate, m.Date(),
+		TokenClock, m.Clock(),
+	).Replace(text)
+}
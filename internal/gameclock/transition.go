@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package gameclock
+
+import "time"
+
+// TransitionKind is the kind of change of daylight a Transition marks.
+type TransitionKind string
+
+// Transition kinds.
+const (
+	TransitionDawn TransitionKind = "dawn"
+	TransitionDusk TransitionKind = "dusk"
+)
+
+// Transition is a dawn or dusk.
+type Transition struct {
+	Kind TransitionKind
+	// At is the real time the transition happens.
+	At time.Time
+	// Moment is the IC time of the transition.
+	Moment Moment
+	// minute is the IC minute of the transition, counted from the epoch. It
+	// names the transition uniquely.
+	minute int64
+}
+
+// NextTransition returns the first dawn or dusk strictly after real time
+// after.
+func (c *Clock) NextTransition(after time.Time) Transition {
+	first := c.minuteAt(after) / minutesPerDay
+	// The current day's dawn or dusk, or failing those the next day's dawn,
+	// is later than after, so the loop ends by the second day.
+	for day := first; ; day++ {
+		for _, t := range []struct {
+			kind TransitionKind
+			hour int
+		}{{TransitionDawn, c.cfg.DawnHour}, {TransitionDusk, c.cfg.DuskHour}} {
+			minute := day*minutesPerDay + int64(t.hour)*60
+			at := c.realTime(minute)
+			if at.After(after) {
+				return Transition{Kind: t.kind, At: at, Moment: c.moment(minute), minute: minute}
+			}
+		}
+	}
+}
+
+// text returns the line outdoor locations see at tr.
+func (c *Clock) text(tr Transition) string {
+	if tr.Kind == TransitionDawn {
+		return c.cfg.DawnText
+	}
+	return c.cfg.DuskText
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/samber/oops"
+
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// AddressBanChecker reports whether a client address is banned and why.
+// Satisfied by *bans.Service.
+type AddressBanChecker interface {
+	AddressBanned(ctx context.Context, ipAddress string) (reason string, banned bool, err error)
+}
+
+// WithAddressBans wires the ban list into CheckAddressBan. Nil (the default)
+// reports every address as not banned.
+func WithAddressBans(c AddressBanChecker) CoreServerOption {
+	return func(s *CoreServer) { s.addressBans = c }
+}
+
+// CheckAddressBan reports whether a gateway's connecting client is banned.
+// The gateway refuses a banned client before showing a prompt; login
+// enforces the same bans regardless.
+func (s *CoreServer) CheckAddressBan(ctx context.Context, req *corev1.CheckAddressBanRequest) (*corev1.CheckAddressBanResponse, error) {
+	if req.GetIpAddress() == "" {
+		return nil, oops.Code("INVALID_ARGUMENT").Errorf("ip_address is required")
+	}
+	resp := &corev1.CheckAddressBanResponse{Meta: responseMeta(req.GetMeta().GetRequestId())}
+	if s.addressBans == nil {
+		return resp, nil
+	}
+	reason, banned, err := s.addressBans.AddressBanned(ctx, req.GetIpAddress())
+	if err != nil {
+		return nil, oops.With("operation", "check address ban").Wrap(err)
+	}
+	if banned {
+		resp.Banned, resp.Reason = true, reason
+	}
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// stubAddressBans bans a single address and fails with err.
+type stubAddressBans struct {
+	banned string
+	err    error
+}
+
+func (s stubAddressBans) AddressBanned(_ context.Context, ipAddress string) (string, bool, error) {
+	return "spam", ipAddress == s.banned, s.err
+}
+
+func TestCheckAddressBan(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := (&CoreServer{}).CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{})
+	errutil.AssertErrorCode(t, err, "INVALID_ARGUMENT")
+
+	// Unconfigured: nothing is banned.
+	resp, err := (&CoreServer{}).CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{IpAddress: "203.0.113.7"})
+	require.NoError(t, err)
+	assert.False(t, resp.GetBanned())
+
+	s := &CoreServer{}
+	WithAddressBans(stubAddressBans{banned: "203.0.113.7"})(s)
+	resp, err = s.CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{IpAddress: "203.0.113.7"})
+	require.NoError(t, err)
+	assert.True(t, resp.GetBanned())
+	assert.Equal(t, "spam", resp.GetReason())
+
+	resp, err = s.CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{IpAddress: "198.51.100.1"})
+	require.NoError(t, err)
+	assert.False(t, resp.GetBanned())
+	assert.Empty(t, resp.GetReason())
+
+	WithAddressBans(stubAddressBans{err: errors.New("db down")})(s)
+	_, err = s.CheckAddressBan(ctx, &corev1.CheckAddressBanRequest{IpAddress: "203.0.113.7"})
+	require.Error(t, err)
+}
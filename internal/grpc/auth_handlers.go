@@ -12,6 +12,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -190,6 +191,33 @@ func (s *CoreServer) resolvePlayerSession(ctx context.Context, rawToken string)
 	return resolvePlayerSessionWithRepo(ctx, s.playerSessionRepo, rawToken)
 }
 
+// clientAddrMetadataKey carries the end-user IP address a gateway is acting
+// for. Mirrors grpcclient.ClientAddrMetadataKey; the two MUST stay in sync.
+const clientAddrMetadataKey = "x-holomush-client-addr"
+
+// clientAddrFromContext returns the gateway-forwarded client address, or ""
+// when the caller sent none.
+func clientAddrFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if vals := md.Get(clientAddrMetadataKey); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+// bannedLoginMessage is the user-facing text for a login refused by a ban.
+func bannedLoginMessage(err error) string {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		if reason, _ := oopsErr.Context()["reason"].(string); reason != "" {
+			return "You are banned from this server: " + reason
+		}
+	}
+	return "You are banned from this server."
+}
+
 // AuthenticatePlayer validates credentials and returns a player session token for character selection.
 func (s *CoreServer) AuthenticatePlayer(ctx context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error) {
 	slog.DebugContext(ctx, "grpc: AuthenticatePlayer", "username", req.GetUsername())
@@ -210,7 +238,13 @@ func (s *CoreServer) AuthenticatePlayer(ctx context.Context, req *corev1.Authent
 	// AuthenticatePlayer validates credentials, enforces the per-player session
 	// cap (evicting the oldest session if needed), and persists a new
 	// PlayerSession in a single service call.
-	rawToken, player, authErr := s.authService.AuthenticatePlayer(ctx, req.Username, req.Password, "", "")
+	rawToken, player, authErr := s.authService.AuthenticatePlayer(ctx, req.Username, req.Password, "", clientAddrFromContext(ctx))
+	if errors.Is(authErr, auth.ErrLoginBanned) {
+		return &corev1.AuthenticatePlayerResponse{
+			Success:      false,
+			ErrorMessage: bannedLoginMessage(authErr),
+		}, nil
+	}
 	if authErr != nil {
 		//nolint:nilerr // intentional: return user-facing error in response body
 		return &corev1.AuthenticatePlayerResponse{
@@ -347,10 +381,11 @@ func (s *CoreServer) SelectCharacter(ctx context.Context, req *corev1.SelectChar
 		}
 
 		return &corev1.SelectCharacterResponse{
-			Success:       true,
-			SessionId:     existingSession.ID,
-			CharacterName: selectedChar.Name,
-			Reattached:    true,
+			Success:        true,
+			SessionId:      existingSession.ID,
+			CharacterName:  selectedChar.Name,
+			Reattached:     true,
+			ReconnectToken: s.reconnectTokenFor(ctx, req, playerSession, existingSession.ID),
 		}, nil
 	}
 
@@ -397,6 +432,10 @@ func (s *CoreServer) SelectCharacter(ctx context.Context, req *corev1.SelectChar
 		return nil, oops.Code("SESSION_CREATE_FAILED").Wrap(err)
 	}
 
+	// Every client renders output, so every fresh session starts from the
+	// player's preferences.
+	s.publishClientPreferences(ctx, playerSession.PlayerID, charID)
+
 	// Emit arrive event (best-effort). Skipped for comms_hub client type:
 	// scenes-workspace sessions must not announce the character on the grid
 	// (spec 2026-06-07 §V2).
@@ -405,13 +444,21 @@ func (s *CoreServer) SelectCharacter(ctx context.Context, req *corev1.SelectChar
 		if err := s.presence.EmitArrive(ctx, char); err != nil {
 			slog.WarnContext(ctx, "arrive event failed", "error", err)
 		}
+		s.publishLoginNotice(ctx, playerSession.PlayerID, charID)
+		if !playerSession.IsImpersonation() {
+			s.deliverImpersonationNotice(ctx, playerSession.PlayerID, charID)
+			// Held pages are delivered once; staff acting as the player
+			// must not consume them.
+			s.deliverHeldPages(ctx, charID)
+		}
 	}
 
 	return &corev1.SelectCharacterResponse{
-		Success:       true,
-		SessionId:     sessionID.String(),
-		CharacterName: selectedChar.Name,
-		Reattached:    false,
+		Success:        true,
+		SessionId:      sessionID.String(),
+		CharacterName:  selectedChar.Name,
+		Reattached:     false,
+		ReconnectToken: s.reconnectTokenFor(ctx, req, playerSession, sessionID.String()),
 	}, nil
 }
 
@@ -814,20 +861,21 @@ func (s *CoreServer) ListPlayerSessions(ctx context.Context, req *corev1.ListPla
 	// Best-effort TTL refresh — active session management keeps the session alive.
 	s.playerSessionRepo.RefreshTTL(ctx, caller.ID, auth.PlayerSessionTTL) //nolint:errcheck // best-effort
 
-	sessions, err := s.playerSessionRepo.ListByPlayer(ctx, caller.PlayerID)
+	sessions, err := s.listPlayerSessions(ctx, caller.PlayerID)
 	if err != nil {
-		return nil, oops.Code("LIST_PLAYER_SESSIONS_FAILED").Wrap(err)
+		return nil, err
 	}
 
 	out := make([]*corev1.PlayerSessionInfo, 0, len(sessions))
 	for _, ps := range sessions {
 		out = append(out, &corev1.PlayerSessionInfo{
-			Id:         ps.ID.String(),
-			CreatedAt:  timestamppb.New(ps.CreatedAt),
-			LastActive: timestamppb.New(ps.UpdatedAt),
-			UserAgent:  ps.UserAgent,
-			IpAddress:  ps.IPAddress,
-			IsCurrent:  ps.ID.Compare(caller.ID) == 0,
+			Id:          ps.ID.String(),
+			CreatedAt:   timestamppb.New(ps.CreatedAt),
+			LastActive:  timestamppb.New(ps.UpdatedAt),
+			UserAgent:   ps.UserAgent,
+			IpAddress:   ps.IPAddress,
+			IsCurrent:   ps.ID.Compare(caller.ID) == 0,
+			DeviceLabel: ps.DeviceLabel,
 		})
 	}
 	return &corev1.ListPlayerSessionsResponse{Sessions: out}, nil
@@ -836,7 +884,8 @@ func (s *CoreServer) ListPlayerSessions(ctx context.Context, req *corev1.ListPla
 // RevokePlayerSession deletes a specific PlayerSession owned by the caller.
 // Attempts to revoke another player's session return SESSION_NOT_FOUND (same
 // enumeration-prevention pattern as the post-auth ownership fixes). Cross-
-// player attempts log WARN for security auditing.
+// player attempts log WARN for security auditing. Impersonation sessions may
+// not revoke the player's sessions and get the same answer.
 func (s *CoreServer) RevokePlayerSession(ctx context.Context, req *corev1.RevokePlayerSessionRequest) (*corev1.RevokePlayerSessionResponse, error) {
 	if s.playerSessionRepo == nil {
 		return &corev1.RevokePlayerSessionResponse{Success: false, ErrorMessage: "session not found"}, nil
@@ -847,6 +896,10 @@ func (s *CoreServer) RevokePlayerSession(ctx context.Context, req *corev1.Revoke
 		//nolint:nilerr // intentional: enumeration-safe - all auth failures collapse to "session not found"
 		return &corev1.RevokePlayerSessionResponse{Success: false, ErrorMessage: "session not found"}, nil
 	}
+	if caller.IsImpersonation() {
+		warnImpersonationRefused(ctx, "RevokePlayerSession", caller)
+		return &corev1.RevokePlayerSessionResponse{Success: false, ErrorMessage: "session not found"}, nil
+	}
 	// Best-effort TTL refresh — active session management keeps the session alive.
 	s.playerSessionRepo.RefreshTTL(ctx, caller.ID, auth.PlayerSessionTTL) //nolint:errcheck // best-effort
 
@@ -856,6 +909,19 @@ func (s *CoreServer) RevokePlayerSession(ctx context.Context, req *corev1.Revoke
 		return &corev1.RevokePlayerSessionResponse{Success: false, ErrorMessage: "session not found"}, nil
 	}
 
+	if s.webSessions != nil {
+		// The service checks ownership, collapsing foreign sessions to
+		// SESSION_NOT_FOUND, and records the revoke in the security log.
+		origin := auth.SecurityOrigin{IPAddress: clientAddrFromContext(ctx)}
+		if err := s.webSessions.RevokeSession(ctx, caller.PlayerID, targetID, origin); err != nil {
+			if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "SESSION_NOT_FOUND" {
+				return &corev1.RevokePlayerSessionResponse{Success: false, ErrorMessage: "session not found"}, nil
+			}
+			return nil, oops.With("target_id", targetID.String()).Wrap(err)
+		}
+		return &corev1.RevokePlayerSessionResponse{Success: true}, nil
+	}
+
 	target, err := s.playerSessionRepo.GetByID(ctx, targetID)
 	if err != nil {
 		//nolint:nilerr // intentional: enumeration-safe
@@ -879,7 +945,7 @@ func (s *CoreServer) RevokePlayerSession(ctx context.Context, req *corev1.Revoke
 
 // RevokeOtherPlayerSessions bulk-revokes all PlayerSessions owned by the
 // caller except the current one. Useful after a suspected compromise or
-// password reset.
+// password reset. Impersonation sessions are refused.
 func (s *CoreServer) RevokeOtherPlayerSessions(ctx context.Context, req *corev1.RevokeOtherPlayerSessionsRequest) (*corev1.RevokeOtherPlayerSessionsResponse, error) {
 	if s.playerSessionRepo == nil {
 		return &corev1.RevokeOtherPlayerSessionsResponse{Success: false}, nil
@@ -890,6 +956,10 @@ func (s *CoreServer) RevokeOtherPlayerSessions(ctx context.Context, req *corev1.
 		//nolint:nilerr // intentional: enumeration-safe auth-failure response
 		return &corev1.RevokeOtherPlayerSessionsResponse{Success: false}, nil
 	}
+	if caller.IsImpersonation() {
+		warnImpersonationRefused(ctx, "RevokeOtherPlayerSessions", caller)
+		return &corev1.RevokeOtherPlayerSessionsResponse{Success: false}, nil
+	}
 	// Best-effort TTL refresh — active session management keeps the session alive.
 	s.playerSessionRepo.RefreshTTL(ctx, caller.ID, auth.PlayerSessionTTL) //nolint:errcheck // best-effort
 
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/holomush/holomush/internal/access/policy/policytest"
@@ -196,6 +197,30 @@ func TestAuthenticatePlayer_ErrorPaths(t *testing.T) {
 	}
 }
 
+func TestAuthenticatePlayerForwardsClientAddrAndReportsBan(t *testing.T) {
+	authSvc := newMockAuthService(t)
+	var gotIP string
+	authSvc.authenticatePlayerFunc = func(_ context.Context, _, _, _, ipAddress string) (string, *auth.Player, error) {
+		gotIP = ipAddress
+		return "", nil, samberOops.Code("AUTH_LOGIN_BANNED").With("reason", "spam").Wrap(auth.ErrLoginBanned)
+	}
+	server := &CoreServer{
+		authService:       authSvc,
+		playerSessionRepo: authmocks.NewMockPlayerSessionRepository(t),
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(clientAddrMetadataKey, "203.0.113.5"))
+	resp, err := server.AuthenticatePlayer(ctx, &corev1.AuthenticatePlayerRequest{
+		Username: "alice",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Equal(t, "203.0.113.5", gotIP)
+	assert.Equal(t, "You are banned from this server: spam", resp.ErrorMessage)
+}
+
 // --- SelectCharacter ---
 
 // TestSelectCharacter covers the core SelectCharacter RPC scenarios: fresh
@@ -2037,6 +2062,23 @@ func TestRevokePlayerSession_RejectsPaths(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "rejects an impersonation session",
+			setup: func(t *testing.T) (*CoreServer, *corev1.RevokePlayerSessionRequest) {
+				t.Helper()
+				callerPS := makePlayerSession(ulid.Make())
+				callerPS.ImpersonatorID = ulid.Make()
+				sessionRepo := authmocks.NewMockPlayerSessionRepository(t)
+				sessionRepo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken(validToken)).Return(callerPS, nil)
+				// No GetByID or Delete expectation — the staff member behind
+				// the session may not end the player's sessions.
+				server := &CoreServer{playerSessionRepo: sessionRepo}
+				return server, &corev1.RevokePlayerSessionRequest{
+					PlayerSessionToken: validToken,
+					TargetSessionId:    ulid.Make().String(),
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2126,6 +2168,25 @@ func TestRevokeOtherPlayerSessionsRejectsInvalidToken(t *testing.T) {
 	assert.False(t, resp.Success)
 }
 
+func TestRevokeOtherPlayerSessionsRejectsImpersonation(t *testing.T) {
+	ctx := context.Background()
+	callerPS := makePlayerSession(ulid.Make())
+	callerPS.ImpersonatorID = ulid.Make()
+
+	sessionRepo := authmocks.NewMockPlayerSessionRepository(t)
+	sessionRepo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken(validToken)).Return(callerPS, nil)
+	// No ListByPlayer or Delete expectation — nothing may be revoked.
+
+	server := &CoreServer{playerSessionRepo: sessionRepo}
+
+	resp, err := server.RevokeOtherPlayerSessions(ctx, &corev1.RevokeOtherPlayerSessionsRequest{
+		PlayerSessionToken: validToken,
+	})
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Zero(t, resp.RevokedCount)
+}
+
 func TestRevokeOtherPlayerSessionsSucceedsWithNoOtherSessions(t *testing.T) {
 	ctx := context.Background()
 	playerID := ulid.Make()
@@ -2603,6 +2664,58 @@ func TestSelectCharacterArriveEventEmission(t *testing.T) {
 	}
 }
 
+func TestSelectCharacterLeavesHeldPagesForImpersonation(t *testing.T) {
+	tests := []struct {
+		name         string
+		impersonated bool
+		wantCalls    int
+	}{
+		{"player session delivers held pages", false, 1},
+		{"impersonation session leaves them held", true, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			playerID := ulid.Make()
+			charID := ulid.Make()
+			locID := ulid.Make()
+
+			ps := makePlayerSession(playerID)
+			if tt.impersonated {
+				ps.ImpersonatorID = ulid.Make()
+			}
+			sessionRepo := setupSessionRepo(t, ps)
+			charRepo := authmocks.NewMockCharacterRepository(t)
+			charRepo.EXPECT().ListByPlayer(mock.Anything, playerID).
+				Return([]*world.Character{
+					{ID: charID, PlayerID: playerID, Name: "Alice", LocationID: &locID},
+				}, nil)
+
+			sessionStore, pool := sessiontest.NewStoreWithPool(t)
+			sessiontest.SeedPlayerSession(t, pool, ps)
+
+			pages := &stubHeldPages{}
+			server := &CoreServer{
+				presence:          newTestPresenceEmitter(newTestEventStore()),
+				sessionStore:      sessionStore,
+				playerSessionRepo: sessionRepo,
+				charRepo:          charRepo,
+				newSessionID:      core.NewULID,
+			}
+			WithHeldPages(pages)(server)
+
+			resp, err := server.SelectCharacter(ctx, &corev1.SelectCharacterRequest{
+				PlayerSessionToken: validToken,
+				CharacterId:        charID.String(),
+			})
+			require.NoError(t, err)
+			require.True(t, resp.Success)
+			assert.Len(t, pages.calls, tt.wantCalls)
+		})
+	}
+}
+
 // TestSelectCharacterGridPresent asserts the GridPresent field rule
 // (holomush-5rh.8.9): comms_hub sessions are grid-absent; terminal sessions
 // are grid-present. The EXISTS predicate in ListActiveByLocation is the
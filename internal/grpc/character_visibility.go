@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+)
+
+// CharacterVisibilityChecker reports whether one character can perceive
+// another: false when the target is dark or invisible and the observer lacks
+// the matching see_dark / see_invisible right. Satisfied by *world.Service.
+type CharacterVisibilityChecker interface {
+	CharacterVisibleTo(ctx context.Context, observerID, targetID ulid.ULID) (bool, error)
+}
+
+// WithCharacterVisibility wires dark/invisible character hiding into
+// ListFocusPresence and the location-stream fan-out of movement and idle
+// events. Nil (the default) hides nobody.
+func WithCharacterVisibility(c CharacterVisibilityChecker) CoreServerOption {
+	return func(s *CoreServer) { s.characterVisibility = c }
+}
+
+// presenceEventTypes are the location-stream events that announce a
+// character's comings and goings. They are dropped for observers who cannot
+// see the acting character.
+var presenceEventTypes = map[eventbus.Type]struct{}{
+	eventbus.Type(eventvocab.EventTypeArrive): {},
+	eventbus.Type(eventvocab.EventTypeLeave):  {},
+	eventbus.Type(eventvocab.EventTypeMove):   {},
+	eventbus.Type(eventvocab.EventTypeAFK):    {},
+	eventbus.Type(eventvocab.EventTypeBack):   {},
+
+	eventbus.Type(eventvocab.EventTypeTraversalDepart): {},
+	eventbus.Type(eventvocab.EventTypeTraversalCancel): {},
+	eventbus.Type(eventvocab.EventTypeTraversalArrive): {},
+	eventbus.Type(eventvocab.EventTypeTraversalLocked): {},
+}
+
+// canSeeCharacter reports whether observerID can perceive targetID. A nil
+// checker sees everyone; a failed check hides the target (fail closed), since
+// revealing a dark character is the outcome the state exists to prevent.
+func (s *CoreServer) canSeeCharacter(ctx context.Context, observerID, targetID ulid.ULID) bool {
+	if s.characterVisibility == nil || observerID == targetID {
+		return true
+	}
+	visible, err := s.characterVisibility.CharacterVisibleTo(ctx, observerID, targetID)
+	if err != nil {
+		slog.WarnContext(ctx, "character visibility check failed, hiding character",
+			"observer_id", observerID.String(), "character_id", targetID.String(), "error", err)
+		return false
+	}
+	return visible
+}
+
+// hidesPresenceEvent reports whether event announces the movement of a
+// character the observer cannot see, on a location stream.
+func (s *CoreServer) hidesPresenceEvent(ctx context.Context, observerID ulid.ULID, event eventbus.Event) bool {
+	if s.characterVisibility == nil || event.Actor.Kind != eventbus.ActorKindCharacter {
+		return false
+	}
+	if _, ok := presenceEventTypes[event.Type]; !ok {
+		return false
+	}
+	if !isLocationStream(string(event.Subject)) {
+		return false
+	}
+	return !s.canSeeCharacter(ctx, observerID, event.Actor.ID)
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+)
+
+// stubVisibilityChecker hides the characters in hidden and fails for err.
+type stubVisibilityChecker struct {
+	hidden map[ulid.ULID]bool
+	err    error
+}
+
+func (s *stubVisibilityChecker) CharacterVisibleTo(_ context.Context, _, targetID ulid.ULID) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	return !s.hidden[targetID], nil
+}
+
+func TestHidesPresenceEvent(t *testing.T) {
+	observer := ulid.MustParse("01H000000000000000000000C1")
+	darkChar := ulid.MustParse("01H000000000000000000000C2")
+	locStream := eventbus.Subject(dotStyleLocation("01H000000000000000000000A1"))
+	checker := &stubVisibilityChecker{hidden: map[ulid.ULID]bool{darkChar: true}}
+
+	presence := func(typ eventvocab.EventType, subject eventbus.Subject, actor ulid.ULID) eventbus.Event {
+		return eventbus.Event{
+			Type:    eventbus.Type(typ),
+			Subject: subject,
+			Actor:   eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: actor},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		checker CharacterVisibilityChecker
+		event   eventbus.Event
+		want    bool
+	}{
+		{"hidden arrival", checker, presence(eventvocab.EventTypeArrive, locStream, darkChar), true},
+		{"hidden afk", checker, presence(eventvocab.EventTypeAFK, locStream, darkChar), true},
+		{"visible arrival", checker, presence(eventvocab.EventTypeArrive, locStream, ulid.Make()), false},
+		{"own arrival", checker, presence(eventvocab.EventTypeArrive, locStream, observer), false},
+		{"non-presence event", checker, presence(eventvocab.EventTypeSystem, locStream, darkChar), false},
+		{"character stream", checker, presence(eventvocab.EventTypeArrive, eventbus.Subject(dotStyleCharacter(observer.String())), darkChar), false},
+		{"no checker", nil, presence(eventvocab.EventTypeArrive, locStream, darkChar), false},
+		{"check failure hides", &stubVisibilityChecker{err: errors.New("db down")}, presence(eventvocab.EventTypeLeave, locStream, darkChar), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &CoreServer{characterVisibility: tc.checker}
+			assert.Equal(t, tc.want, s.hidesPresenceEvent(context.Background(), observer, tc.event))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ClientPreferencesPublisher sends a player's client preferences — ANSI,
+// screen width, time zone, and the rest — to a character's stream.
+// Satisfied by *preferences.Service.
+type ClientPreferencesPublisher interface {
+	PublishPreferences(ctx context.Context, playerID, characterID ulid.ULID) error
+}
+
+// WithClientPreferences wires client preferences into SelectCharacter: a
+// fresh session of any client type gets a preferences_changed snapshot on
+// its character stream, ahead of anything rendered for it. Nil (the
+// default) sends nothing and transports use their own defaults.
+func WithClientPreferences(p ClientPreferencesPublisher) CoreServerOption {
+	return func(s *CoreServer) { s.clientPreferences = p }
+}
+
+// publishClientPreferences sends the preferences snapshot for a fresh
+// session. Failures are logged, never surfaced: transports fall back to
+// their defaults.
+func (s *CoreServer) publishClientPreferences(ctx context.Context, playerID, characterID ulid.ULID) {
+	if s.clientPreferences == nil {
+		return
+	}
+	if err := s.clientPreferences.PublishPreferences(ctx, playerID, characterID); err != nil {
+		slog.WarnContext(ctx, "client preferences snapshot failed",
+			"player_id", playerID.String(),
+			"character_id", characterID.String(),
+			"error", err)
+	}
+}
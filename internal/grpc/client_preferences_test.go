@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubClientPreferences records each PublishPreferences call and fails
+// with err.
+type stubClientPreferences struct {
+	calls [][2]ulid.ULID
+	err   error
+}
+
+func (s *stubClientPreferences) PublishPreferences(_ context.Context, playerID, characterID ulid.ULID) error {
+	s.calls = append(s.calls, [2]ulid.ULID{playerID, characterID})
+	return s.err
+}
+
+func TestPublishClientPreferences(t *testing.T) {
+	playerID := ulid.MustParse("01H000000000000000000000P1")
+	charID := ulid.MustParse("01H000000000000000000000C1")
+
+	// Unconfigured: nothing to call, nothing to panic on.
+	(&CoreServer{}).publishClientPreferences(context.Background(), playerID, charID)
+
+	prefs := &stubClientPreferences{}
+	s := &CoreServer{}
+	WithClientPreferences(prefs)(s)
+	s.publishClientPreferences(context.Background(), playerID, charID)
+	assert.Equal(t, [][2]ulid.ULID{{playerID, charID}}, prefs.calls)
+
+	// A failed snapshot is logged and swallowed so login proceeds.
+	prefs.err = errors.New("bus down")
+	s.publishClientPreferences(context.Background(), playerID, charID)
+	assert.Len(t, prefs.calls, 2)
+}
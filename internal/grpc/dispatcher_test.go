@@ -295,6 +295,46 @@ func TestDispatcher_HandleCommand_UnknownCommand(t *testing.T) {
 	require.NoError(t, json.Unmarshal(charEvents[0].Payload, &crp))
 }
 
+func TestDispatcher_HandleCommand_VerboseErrors(t *testing.T) {
+	charID := core.NewULID()
+	sessionID := core.NewULID()
+	store := newTestEventStore()
+
+	server := newDispatcherTestServer(t, store)
+
+	ctx := context.Background()
+	require.NoError(t, server.sessionStore.Set(ctx, sessionID.String(), &session.Info{
+		ID:            sessionID.String(),
+		CharacterID:   charID,
+		CharacterName: "Tester",
+		LocationID:    core.NewULID(),
+		Status:        session.StatusActive,
+	}))
+	verbosity, ok := server.sessionStore.(interface {
+		SetErrorVerbosity(ctx context.Context, sessionID, verbosity string) error
+	})
+	require.True(t, ok, "session store must support error verbosity")
+	require.NoError(t, verbosity.SetErrorVerbosity(ctx, sessionID.String(), string(command.ErrorsVerbose)))
+
+	resp, err := server.HandleCommand(ctx, &corev1.HandleCommandRequest{
+		Meta:               &corev1.RequestMeta{RequestId: "verbose-test", Timestamp: timestamppb.Now()},
+		SessionId:          sessionID.String(),
+		Command:            "unknowncommand args",
+		PlayerSessionToken: testPlayerSessionToken,
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+
+	charEvents, err := store.Replay(ctx, "character."+charID.String(), ulid.ULID{}, 100)
+	require.NoError(t, err)
+	require.NotEmpty(t, charEvents)
+	assert.Equal(t, eventbus.Type(eventvocab.EventTypeCommandError), charEvents[0].Type)
+
+	var crp eventvocab.CommandResponsePayload
+	require.NoError(t, json.Unmarshal(charEvents[0].Payload, &crp))
+	assert.Equal(t, "Unknown command. Try 'help'.\n[code=UNKNOWN_COMMAND command=unknowncommand]", crp.Text)
+}
+
 func TestDispatcher_HandleCommand_Quit(t *testing.T) {
 	charID := core.NewULID()
 	sessionID := core.NewULID()
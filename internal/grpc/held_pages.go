@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// HeldPageDeliverer sends the pages held for a character while it was
+// offline to its stream. Satisfied by *paging.Service.
+type HeldPageDeliverer interface {
+	DeliverHeld(ctx context.Context, characterID ulid.ULID) error
+}
+
+// WithHeldPages wires the page mailbox into SelectCharacter: a fresh grid
+// session gets the pages that waited for it on its character stream, after
+// the login notice. Nil (the default) delivers nothing.
+func WithHeldPages(d HeldPageDeliverer) CoreServerOption {
+	return func(s *CoreServer) { s.heldPages = d }
+}
+
+// deliverHeldPages sends the pages held for a fresh session. Failures are
+// logged, never surfaced: undelivered pages stay in the mailbox for the
+// next login.
+func (s *CoreServer) deliverHeldPages(ctx context.Context, characterID ulid.ULID) {
+	if s.heldPages == nil {
+		return
+	}
+	if err := s.heldPages.DeliverHeld(ctx, characterID); err != nil {
+		slog.WarnContext(ctx, "held page delivery failed",
+			"character_id", characterID.String(),
+			"error", err)
+	}
+}
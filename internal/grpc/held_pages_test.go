@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubHeldPages records each DeliverHeld call and fails with err.
+type stubHeldPages struct {
+	calls []ulid.ULID
+	err   error
+}
+
+func (s *stubHeldPages) DeliverHeld(_ context.Context, characterID ulid.ULID) error {
+	s.calls = append(s.calls, characterID)
+	return s.err
+}
+
+func TestDeliverHeldPages(t *testing.T) {
+	charID := ulid.MustParse("01H000000000000000000000C1")
+
+	// Unconfigured: nothing to call, nothing to panic on.
+	(&CoreServer{}).deliverHeldPages(context.Background(), charID)
+
+	pages := &stubHeldPages{}
+	s := &CoreServer{}
+	WithHeldPages(pages)(s)
+	s.deliverHeldPages(context.Background(), charID)
+	assert.Equal(t, []ulid.ULID{charID}, pages.calls)
+
+	// A failed delivery is logged and swallowed so login proceeds.
+	pages.err = errors.New("bus down")
+	s.deliverHeldPages(context.Background(), charID)
+	assert.Len(t, pages.calls, 2)
+}
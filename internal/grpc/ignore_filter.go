@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+)
+
+// IgnoreChecker reports whether a character ignores or gags another.
+// Satisfied by *paging.Service, whose block lists exempt staff.
+type IgnoreChecker interface {
+	Ignores(ctx context.Context, observerID, senderID ulid.ULID) (bool, error)
+}
+
+// WithIgnoreChecker wires character ignore lists into the event fan-out:
+// speech from a character the session's character ignores is dropped
+// before it reaches the client. Nil (the default) hides nothing.
+func WithIgnoreChecker(c IgnoreChecker) CoreServerOption {
+	return func(s *CoreServer) { s.ignores = c }
+}
+
+// ignorableEventTypes are the event types an ignore list hides: speech
+// addressed to the room or to the character. Matched on the unqualified
+// name, so "core-communication:say" is covered by "say".
+var ignorableEventTypes = map[string]struct{}{
+	"say":                            {},
+	"pose":                           {},
+	"ooc":                            {},
+	"emit":                           {},
+	"whisper":                        {},
+	"whisper_notice":                 {},
+	"whisper_overheard":              {},
+	string(eventvocab.EventTypePage): {},
+}
+
+// ignoresEvent reports whether event is speech from a character the
+// observer ignores. The check is a preference, not access control, so it
+// fails open: on a lookup error the event is delivered.
+func (s *CoreServer) ignoresEvent(ctx context.Context, observerID ulid.ULID, event eventbus.Event) bool {
+	if s.ignores == nil || event.Actor.Kind != eventbus.ActorKindCharacter || event.Actor.ID == observerID {
+		return false
+	}
+	eventType := string(event.Type)
+	if i := strings.LastIndexByte(eventType, ':'); i >= 0 {
+		eventType = eventType[i+1:]
+	}
+	if _, ok := ignorableEventTypes[eventType]; !ok {
+		return false
+	}
+	ignored, err := s.ignores.Ignores(ctx, observerID, event.Actor.ID)
+	if err != nil {
+		slog.DebugContext(ctx, "subscribe: ignore check failed; delivering event (fail-open)",
+			"observer_id", observerID.String(), "actor_id", event.Actor.ID.String(), "error", err)
+		return false
+	}
+	return ignored
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/eventbus"
+)
+
+// stubIgnoreChecker ignores the senders in ignored and fails for err.
+type stubIgnoreChecker struct {
+	ignored map[ulid.ULID]bool
+	err     error
+}
+
+func (s *stubIgnoreChecker) Ignores(_ context.Context, _, senderID ulid.ULID) (bool, error) {
+	if s.err != nil {
+		return false, s.err
+	}
+	return s.ignored[senderID], nil
+}
+
+func TestIgnoresEvent(t *testing.T) {
+	observer := ulid.MustParse("01H000000000000000000000C1")
+	pest := ulid.MustParse("01H000000000000000000000C2")
+	checker := &stubIgnoreChecker{ignored: map[ulid.ULID]bool{pest: true}}
+
+	speech := func(typ string, kind eventbus.ActorKind, actor ulid.ULID) eventbus.Event {
+		return eventbus.Event{
+			Type:  eventbus.Type(typ),
+			Actor: eventbus.Actor{Kind: kind, ID: actor},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		checker IgnoreChecker
+		event   eventbus.Event
+		want    bool
+	}{
+		{"ignored say", checker, speech("core-communication:say", eventbus.ActorKindCharacter, pest), true},
+		{"ignored page", checker, speech("page", eventbus.ActorKindCharacter, pest), true},
+		{"other speaker", checker, speech("core-communication:pose", eventbus.ActorKindCharacter, ulid.Make()), false},
+		{"own speech", checker, speech("core-communication:say", eventbus.ActorKindCharacter, observer), false},
+		{"non-speech event", checker, speech("arrive", eventbus.ActorKindCharacter, pest), false},
+		{"system actor", checker, speech("core-communication:say", eventbus.ActorKindSystem, pest), false},
+		{"no checker", nil, speech("core-communication:say", eventbus.ActorKindCharacter, pest), false},
+		{"check failure delivers", &stubIgnoreChecker{err: errors.New("db down")}, speech("say", eventbus.ActorKindCharacter, pest), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &CoreServer{ignores: tc.checker}
+			assert.Equal(t, tc.want, s.ignoresEvent(context.Background(), observer, tc.event))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// Sanitized user-facing messages for ImpersonatePlayer.
+const (
+	msgImpersonationNotConfigured = "impersonation not configured"
+	msgImpersonationFailed        = "impersonation failed"
+	msgImpersonationNotPermitted  = "not permitted to impersonate players"
+	msgImpersonationNested        = "cannot impersonate from an impersonation session"
+	msgImpersonationSelf          = "cannot impersonate yourself"
+	msgImpersonationReason        = "a reason of at most 256 characters is required"
+	msgImpersonationDuration      = "duration must be between 0 and 1 hour"
+	msgImpersonationNoTarget      = "player not found"
+	msgImpersonationPrivileged    = "cannot impersonate staff or privileged players"
+	// msgImpersonationRestricted answers account changes attempted from an
+	// impersonation session.
+	msgImpersonationRestricted = "not available while impersonating a player"
+)
+
+// Impersonator opens impersonation sessions. Satisfied by *auth.Service.
+type Impersonator interface {
+	Impersonate(ctx context.Context, adminSession *auth.PlayerSession, targetPlayerID ulid.ULID, opts auth.ImpersonationOptions) (string, *auth.PlayerSession, error)
+}
+
+// WithImpersonation wires staff impersonation into ImpersonatePlayer. Nil
+// (the default) answers with "not configured".
+func WithImpersonation(imp Impersonator) CoreServerOption {
+	return func(s *CoreServer) { s.impersonator = imp }
+}
+
+// ImpersonatePlayer opens a session as another player for a staff member
+// holding the support.impersonate grant. The grant check, limits, and audit
+// trail are auth.Service.Impersonate's; this handler only resolves the
+// caller and sanitizes the outcome.
+func (s *CoreServer) ImpersonatePlayer(ctx context.Context, req *corev1.ImpersonatePlayerRequest) (*corev1.ImpersonatePlayerResponse, error) {
+	slog.DebugContext(ctx, "grpc: ImpersonatePlayer", "target_player_id", req.GetTargetPlayerId())
+
+	if s.impersonator == nil {
+		return &corev1.ImpersonatePlayerResponse{Success: false, ErrorMessage: msgImpersonationNotConfigured}, nil
+	}
+
+	adminSession, err := s.resolvePlayerSession(ctx, req.GetPlayerSessionToken())
+	if err != nil {
+		if isPlayerSessionAuthError(err) {
+			return &corev1.ImpersonatePlayerResponse{
+				Success: false, ErrorMessage: "invalid or expired player session",
+			}, nil
+		}
+		return nil, err
+	}
+
+	targetID, err := ulid.Parse(req.GetTargetPlayerId())
+	if err != nil {
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.ImpersonatePlayerResponse{Success: false, ErrorMessage: msgImpersonationNoTarget}, nil
+	}
+
+	rawToken, session, err := s.impersonator.Impersonate(ctx, adminSession, targetID, auth.ImpersonationOptions{
+		Reason:   req.GetReason(),
+		Duration: time.Duration(req.GetDurationSeconds()) * time.Second,
+		Origin:   auth.SecurityOrigin{IPAddress: clientAddrFromContext(ctx)},
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "impersonation refused",
+			"player_id", adminSession.PlayerID.String(),
+			"target_player_id", targetID.String(),
+			"error", err)
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.ImpersonatePlayerResponse{Success: false, ErrorMessage: sanitizeImpersonationError(err)}, nil
+	}
+
+	characters, err := s.buildCharacterSummaries(ctx, session.PlayerID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to build character summaries", "error", err)
+	}
+
+	var defaultCharID string
+	if s.playerRepo != nil {
+		if target, getErr := s.playerRepo.GetByID(ctx, session.PlayerID); getErr == nil && target.DefaultCharacterID != nil {
+			defaultCharID = target.DefaultCharacterID.String()
+		}
+	}
+
+	return &corev1.ImpersonatePlayerResponse{
+		Success:            true,
+		PlayerSessionToken: rawToken,
+		SessionTtlSeconds:  secondsUntil(session.ExpiresAt),
+		Characters:         characters,
+		DefaultCharacterId: defaultCharID,
+	}, nil
+}
+
+// sanitizeImpersonationError maps an Impersonate failure to a fixed
+// user-facing message.
+func sanitizeImpersonationError(err error) string {
+	oopsErr, isOops := oops.AsOops(err)
+	if !isOops {
+		return msgImpersonationFailed
+	}
+	switch oopsErr.Code() {
+	case "IMPERSONATION_DISABLED":
+		return msgImpersonationNotConfigured
+	case "IMPERSONATION_NOT_PERMITTED":
+		return msgImpersonationNotPermitted
+	case "IMPERSONATION_NESTED":
+		return msgImpersonationNested
+	case "IMPERSONATION_SELF":
+		return msgImpersonationSelf
+	case "IMPERSONATION_REASON_REQUIRED", "IMPERSONATION_INVALID_REASON":
+		return msgImpersonationReason
+	case "IMPERSONATION_INVALID_DURATION":
+		return msgImpersonationDuration
+	case "IMPERSONATION_TARGET_NOT_FOUND":
+		return msgImpersonationNoTarget
+	case "IMPERSONATION_TARGET_PRIVILEGED":
+		return msgImpersonationPrivileged
+	}
+	return msgImpersonationFailed
+}
+
+// warnImpersonationRefused logs an account change refused because the
+// caller is an impersonation session.
+func warnImpersonationRefused(ctx context.Context, method string, ps *auth.PlayerSession) {
+	slog.WarnContext(ctx, "account change refused for impersonation session",
+		"method", method,
+		"player_id", ps.PlayerID.String(),
+		"impersonator_id", ps.ImpersonatorID.String(),
+		"player_session_id", ps.ID.String())
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// stubImpersonator records the last Impersonate call and answers with fixed
+// results.
+type stubImpersonator struct {
+	token   string
+	session *auth.PlayerSession
+	err     error
+
+	admin  *auth.PlayerSession
+	target ulid.ULID
+	opts   auth.ImpersonationOptions
+}
+
+func (s *stubImpersonator) Impersonate(_ context.Context, adminSession *auth.PlayerSession, targetPlayerID ulid.ULID, opts auth.ImpersonationOptions) (string, *auth.PlayerSession, error) {
+	s.admin, s.target, s.opts = adminSession, targetPlayerID, opts
+	return s.token, s.session, s.err
+}
+
+func TestImpersonatePlayer(t *testing.T) {
+	staff := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make(), ExpiresAt: time.Now().Add(time.Hour)}
+	targetID := ulid.Make()
+	sessionRepo := func(t *testing.T) *authmocks.MockPlayerSessionRepository {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken("staff")).Return(staff, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, staff.ID, auth.PlayerSessionTTL).Return(nil)
+		return repo
+	}
+	req := &corev1.ImpersonatePlayerRequest{
+		PlayerSessionToken: "staff",
+		TargetPlayerId:     targetID.String(),
+		Reason:             "ticket 42",
+		DurationSeconds:    600,
+	}
+
+	t.Run("not configured", func(t *testing.T) {
+		resp, err := (&CoreServer{}).ImpersonatePlayer(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgImpersonationNotConfigured, resp.GetErrorMessage())
+	})
+
+	t.Run("opens a session as the target", func(t *testing.T) {
+		imp := &stubImpersonator{
+			token:   "imp-token",
+			session: &auth.PlayerSession{ID: ulid.Make(), PlayerID: targetID, ExpiresAt: time.Now().Add(10*time.Minute + time.Second)},
+		}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithImpersonation(imp)(s)
+
+		resp, err := s.ImpersonatePlayer(context.Background(), req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, "imp-token", resp.GetPlayerSessionToken())
+		assert.Equal(t, int64(600), resp.GetSessionTtlSeconds())
+		assert.Equal(t, staff, imp.admin)
+		assert.Equal(t, targetID, imp.target)
+		assert.Equal(t, "ticket 42", imp.opts.Reason)
+		assert.Equal(t, 10*time.Minute, imp.opts.Duration)
+	})
+
+	t.Run("rejects an unknown session", func(t *testing.T) {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, mock.Anything).
+			Return(nil, oops.Code("PLAYER_SESSION_NOT_FOUND").Errorf("not found"))
+		s := &CoreServer{playerSessionRepo: repo}
+		WithImpersonation(&stubImpersonator{})(s)
+
+		resp, err := s.ImpersonatePlayer(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, "invalid or expired player session", resp.GetErrorMessage())
+	})
+
+	t.Run("rejects a malformed target", func(t *testing.T) {
+		imp := &stubImpersonator{}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithImpersonation(imp)(s)
+
+		resp, err := s.ImpersonatePlayer(context.Background(), &corev1.ImpersonatePlayerRequest{
+			PlayerSessionToken: "staff", TargetPlayerId: "nope", Reason: "ticket 42",
+		})
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgImpersonationNoTarget, resp.GetErrorMessage())
+		assert.Nil(t, imp.admin, "Impersonate MUST NOT run for a malformed target")
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"no grant", oops.Code("IMPERSONATION_NOT_PERMITTED").Errorf("denied"), msgImpersonationNotPermitted},
+		{"nested", oops.Code("IMPERSONATION_NESTED").Errorf("nested"), msgImpersonationNested},
+		{"self", oops.Code("IMPERSONATION_SELF").Errorf("self"), msgImpersonationSelf},
+		{"no reason", oops.Code("IMPERSONATION_REASON_REQUIRED").Errorf("reason"), msgImpersonationReason},
+		{"too long", oops.Code("IMPERSONATION_INVALID_DURATION").Errorf("duration"), msgImpersonationDuration},
+		{"unknown target", oops.Code("IMPERSONATION_TARGET_NOT_FOUND").Errorf("missing"), msgImpersonationNoTarget},
+		{"privileged target", oops.Code("IMPERSONATION_TARGET_PRIVILEGED").Errorf("staff"), msgImpersonationPrivileged},
+		{"unexpected", errors.New("db down"), msgImpersonationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+			WithImpersonation(&stubImpersonator{err: tt.err})(s)
+			resp, err := s.ImpersonatePlayer(context.Background(), req)
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/auth"
+)
+
+// ImpersonationNoticeSource hands out the impersonations a player has not
+// been told about, each exactly once. Satisfied by *auth.Service.
+type ImpersonationNoticeSource interface {
+	TakeImpersonationNotices(ctx context.Context, playerID ulid.ULID) ([]*auth.Impersonation, error)
+}
+
+// NoticeBroadcaster publishes a system message to a stream. Satisfied by
+// *sysbroadcast.Broadcaster.
+type NoticeBroadcaster interface {
+	Broadcast(ctx context.Context, subject, message string) error
+}
+
+// WithImpersonationNotices wires impersonation notices into SelectCharacter:
+// the first fresh grid session a player opens after staff impersonated them
+// gets a system message on its character stream saying when and why. Nil
+// for either argument (the default) sends nothing.
+func WithImpersonationNotices(src ImpersonationNoticeSource, pub NoticeBroadcaster) CoreServerOption {
+	return func(s *CoreServer) {
+		if src != nil && pub != nil {
+			s.impersonationNotices = src
+			s.noticeBroadcaster = pub
+		}
+	}
+}
+
+// deliverImpersonationNotice tells the player about impersonations of their
+// account since they last logged in. Callers skip impersonation sessions, so
+// the staff member cannot consume the notice meant for the player. Failures
+// are logged, never surfaced; a notice taken but not published is lost, and
+// the security log still holds the record.
+func (s *CoreServer) deliverImpersonationNotice(ctx context.Context, playerID, characterID ulid.ULID) {
+	if s.impersonationNotices == nil {
+		return
+	}
+	notices, err := s.impersonationNotices.TakeImpersonationNotices(ctx, playerID)
+	if err != nil {
+		slog.WarnContext(ctx, "impersonation notices failed",
+			"player_id", playerID.String(),
+			"error", err)
+		return
+	}
+	if len(notices) == 0 {
+		return
+	}
+	if err := s.noticeBroadcaster.Broadcast(ctx, "character."+characterID.String(), formatImpersonationNotice(notices)); err != nil {
+		slog.WarnContext(ctx, "impersonation notice not delivered",
+			"player_id", playerID.String(),
+			"character_id", characterID.String(),
+			"count", len(notices),
+			"error", err)
+	}
+}
+
+// formatImpersonationNotice renders one message covering every notice.
+func formatImpersonationNotice(notices []*auth.Impersonation) string {
+	var sb strings.Builder
+	if len(notices) == 1 {
+		sb.WriteString("A staff member signed in to your account while you were away:")
+	} else {
+		fmt.Fprintf(&sb, "Staff members signed in to your account %d times while you were away:", len(notices))
+	}
+	for _, n := range notices {
+		fmt.Fprintf(&sb, "\n  %s for %s: %s",
+			n.StartedAt.UTC().Format(time.DateTime+" MST"),
+			n.ExpiresAt.Sub(n.StartedAt).Round(time.Minute),
+			n.Reason)
+	}
+	sb.WriteString("\nDetails are in your account security log.")
+	return sb.String()
+}
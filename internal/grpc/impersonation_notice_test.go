@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+)
+
+// stubImpersonationNotices returns its notices once, then none.
+type stubImpersonationNotices struct {
+	notices []*auth.Impersonation
+	err     error
+}
+
+func (s *stubImpersonationNotices) TakeImpersonationNotices(_ context.Context, _ ulid.ULID) ([]*auth.Impersonation, error) {
+	out := s.notices
+	s.notices = nil
+	return out, s.err
+}
+
+// stubNoticeBroadcaster records each Broadcast call.
+type stubNoticeBroadcaster struct {
+	subjects []string
+	messages []string
+}
+
+func (s *stubNoticeBroadcaster) Broadcast(_ context.Context, subject, message string) error {
+	s.subjects = append(s.subjects, subject)
+	s.messages = append(s.messages, message)
+	return nil
+}
+
+func TestDeliverImpersonationNotice(t *testing.T) {
+	ctx := context.Background()
+	playerID := ulid.MustParse("01H000000000000000000000P1")
+	charID := ulid.MustParse("01H000000000000000000000C1")
+	started := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	// Unconfigured: nothing to call, nothing to panic on.
+	(&CoreServer{}).deliverImpersonationNotice(ctx, playerID, charID)
+
+	src := &stubImpersonationNotices{notices: []*auth.Impersonation{
+		{PlayerID: playerID, Reason: "ticket 42", StartedAt: started, ExpiresAt: started.Add(30 * time.Minute)},
+	}}
+	pub := &stubNoticeBroadcaster{}
+	s := &CoreServer{}
+	WithImpersonationNotices(src, pub)(s)
+
+	s.deliverImpersonationNotice(ctx, playerID, charID)
+	require.Len(t, pub.messages, 1)
+	assert.Equal(t, "character."+charID.String(), pub.subjects[0])
+	assert.Contains(t, pub.messages[0], "A staff member signed in to your account")
+	assert.Contains(t, pub.messages[0], "2026-03-04 05:06:07 UTC for 30m0s: ticket 42")
+
+	// Nothing pending: no message.
+	s.deliverImpersonationNotice(ctx, playerID, charID)
+	assert.Len(t, pub.messages, 1)
+
+	// A failing source is logged and swallowed so login proceeds.
+	src.err = errors.New("db down")
+	s.deliverImpersonationNotice(ctx, playerID, charID)
+	assert.Len(t, pub.messages, 1)
+}
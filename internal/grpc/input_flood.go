@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/core"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// ModerationPublisher publishes a system message to a domain-relative
+// subject. Satisfied by *sysbroadcast.Broadcaster.
+type ModerationPublisher interface {
+	Broadcast(ctx context.Context, subject, message string) error
+}
+
+// WithModerationEvents wires ReportInputFlood to publish its moderation
+// events on core.ModerationSubject. Nil (the default) validates and logs the
+// report but publishes nothing.
+func WithModerationEvents(p ModerationPublisher) CoreServerOption {
+	return func(s *CoreServer) { s.moderation = p }
+}
+
+// inputFloodActionLabels renders each escalation step for the moderation
+// message and logs.
+var inputFloodActionLabels = map[corev1.InputFloodAction]string{
+	corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN:       "warned",
+	corev1.InputFloodAction_INPUT_FLOOD_ACTION_THROTTLE:   "throttled",
+	corev1.InputFloodAction_INPUT_FLOOD_ACTION_DISCONNECT: "disconnected",
+}
+
+// ReportInputFlood records a gateway's input flood escalation against a
+// connection and publishes a moderation event naming the character.
+// Ownership failures collapse to SESSION_NOT_FOUND (enumeration-safe,
+// I-SEC-1). A failed publish is logged, never surfaced: the gateway has
+// already acted and has nothing to retry.
+func (s *CoreServer) ReportInputFlood(ctx context.Context, req *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error) {
+	if req.GetSessionId() == "" || req.GetConnectionId() == "" {
+		return nil, oops.Code("INVALID_ARGUMENT").Errorf("session_id and connection_id are required")
+	}
+	label, ok := inputFloodActionLabels[req.GetAction()]
+	if !ok {
+		return nil, oops.Code("INVALID_ARGUMENT").With("action", req.GetAction().String()).
+			Errorf("action must be a known input flood action")
+	}
+	info, err := auth.ValidateSessionOwnership(
+		ctx, s.playerSessionRepo, s.sessionStore,
+		req.GetPlayerSessionToken(), req.GetSessionId(),
+	)
+	if err != nil {
+		slog.DebugContext(ctx, "report input flood ownership validation failed",
+			"session_id", req.GetSessionId(), "error", err)
+		return nil, oops.Code("SESSION_NOT_FOUND").
+			With("session_id", req.GetSessionId()).Errorf("session not found")
+	}
+
+	slog.WarnContext(ctx, "input flood reported",
+		"session_id", req.GetSessionId(),
+		"connection_id", req.GetConnectionId(),
+		"character_id", info.CharacterID.String(),
+		"action", label,
+		"dropped_commands", req.GetDroppedCommands())
+
+	if s.moderation != nil {
+		msg := fmt.Sprintf("Input flood: %s was %s (%d commands dropped).",
+			info.CharacterName, label, req.GetDroppedCommands())
+		if pubErr := s.moderation.Broadcast(ctx, core.ModerationSubject, msg); pubErr != nil {
+			slog.WarnContext(ctx, "input flood moderation event failed",
+				"session_id", req.GetSessionId(), "error", pubErr)
+		}
+	}
+	return &corev1.ReportInputFloodResponse{Meta: responseMeta(req.GetMeta().GetRequestId())}, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/testsupport/sessiontest"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// stubModeration records each Broadcast call and fails with err.
+type stubModeration struct {
+	subjects []string
+	messages []string
+	err      error
+}
+
+func (s *stubModeration) Broadcast(_ context.Context, subject, message string) error {
+	s.subjects = append(s.subjects, subject)
+	s.messages = append(s.messages, message)
+	return s.err
+}
+
+// TestReportInputFlood covers the ReportInputFlood RPC handler: argument
+// validation, ownership collapse, and the moderation event on success.
+// Backed by a real Postgres session store (sessiontest).
+// Verifies: I-SEC-1
+func TestReportInputFlood(t *testing.T) {
+	const sessionID = "sess-1"
+
+	tests := []struct {
+		name         string
+		reqSessionID string
+		connID       string
+		action       corev1.InputFloodAction
+		publishErr   error
+		wantCode     string // expected top-level oops code; "" → success
+	}{
+		{name: "empty session_id is rejected", connID: "conn", action: corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN, wantCode: "INVALID_ARGUMENT"},
+		{name: "empty connection_id is rejected", reqSessionID: sessionID, action: corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN, wantCode: "INVALID_ARGUMENT"},
+		{name: "unspecified action is rejected", reqSessionID: sessionID, connID: "conn", wantCode: "INVALID_ARGUMENT"},
+		{name: "ownership failure collapses to SESSION_NOT_FOUND (I-SEC-1)", reqSessionID: "missing", connID: "conn", action: corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN, wantCode: "SESSION_NOT_FOUND"},
+		{name: "owned session publishes a moderation event", reqSessionID: sessionID, connID: "conn", action: corev1.InputFloodAction_INPUT_FLOOD_ACTION_DISCONNECT},
+		{name: "publish failure is swallowed", reqSessionID: sessionID, connID: "conn", action: corev1.InputFloodAction_INPUT_FLOOD_ACTION_THROTTLE, publishErr: errors.New("bus down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := sessiontest.NewStore(t)
+			info := mkActiveAt(sessionID, ulid.Make(), ulid.Make())
+			info.CharacterName = "Flooder"
+			require.NoError(t, store.Set(ctx, sessionID, info))
+
+			moderation := &stubModeration{err: tt.publishErr}
+			s := &CoreServer{
+				sessionStore:      store,
+				playerSessionRepo: newFakePlayerSessionRepo(ownedPlayerID),
+			}
+			WithModerationEvents(moderation)(s)
+			resp, err := s.ReportInputFlood(ctx, &corev1.ReportInputFloodRequest{
+				SessionId:          tt.reqSessionID,
+				ConnectionId:       tt.connID,
+				PlayerSessionToken: testPlayerSessionToken,
+				Action:             tt.action,
+				DroppedCommands:    12,
+			})
+
+			if tt.wantCode != "" {
+				require.Error(t, err)
+				o, ok := oops.AsOops(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.wantCode, o.Code())
+				assert.Empty(t, moderation.messages)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, []string{core.ModerationSubject}, moderation.subjects)
+			require.Len(t, moderation.messages, 1)
+			assert.Contains(t, moderation.messages[0], "Flooder")
+			assert.Contains(t, moderation.messages[0], "12 commands dropped")
+		})
+	}
+}
@@ -152,6 +152,11 @@ func (s *CoreServer) ListFocusPresence(ctx context.Context, req *corev1.ListFocu
 			continue
 		}
 		seen[sess.CharacterID] = struct{}{}
+		// Dark and invisible characters are left out for callers who cannot
+		// see them.
+		if !s.canSeeCharacter(ctx, info.CharacterID, sess.CharacterID) {
+			continue
+		}
 		uniqueIDs = append(uniqueIDs, sess.CharacterID)
 	}
 
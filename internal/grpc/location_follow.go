@@ -49,6 +49,11 @@ type locationFollower struct {
 	// (internal/web/translate.go:42-60), so a nil registry guarantees every
 	// synthetic emit silently disappears at the gateway boundary.
 	verbRegistry *core.VerbRegistry
+	// activity reports AFK state for the present list. Optional: nil
+	// reports every present character as not idle.
+	activity ActivityTracker
+	// npcs lists the NPCs in a location. Optional: nil lists none.
+	npcs NPCPresence
 }
 
 // handleEvent checks if the event is a character move for the tracked character.
@@ -208,12 +213,14 @@ func (lf *locationFollower) buildLocationState(ctx context.Context, locationID u
 		locInfo = eventvocab.LocationStateInfo{
 			ID:          loc.ID.String(),
 			Name:        loc.Name,
-			Description: loc.Description,
+			Description: lf.renderDescription(ctx, loc.Description),
 		}
 	}
 
 	var exitList []eventvocab.LocationStateExit
-	if exits, err := lf.worldQuerier.GetExitsByLocation(sysCtx, systemSubjectID, locationID); err == nil {
+	// Exits are filtered to the followed character's view so owner-only and
+	// list-restricted exits never reach a client that cannot use them.
+	if exits, err := lf.worldQuerier.GetVisibleExits(sysCtx, systemSubjectID, locationID, lf.characterID); err == nil {
 		exitList = convertExits(exits)
 	}
 
@@ -231,7 +238,7 @@ func (lf *locationFollower) buildLocationState(ctx context.Context, locationID u
 				present = append(present, eventvocab.LocationStateChar{
 					CharacterID: sess.CharacterID.String(),
 					Name:        sess.CharacterName,
-					Idle:        false,
+					Idle:        lf.activity != nil && lf.activity.IsAFK(sess.CharacterID),
 				})
 			}
 		}
@@ -241,6 +248,7 @@ func (lf *locationFollower) buildLocationState(ctx context.Context, locationID u
 		Location: locInfo,
 		Exits:    exitList,
 		Present:  present,
+		NPCs:     npcsAt(ctx, lf.npcs, locationID),
 	}
 
 	payloadJSON, err := json.Marshal(payload)
@@ -274,6 +282,23 @@ func (lf *locationFollower) buildLocationState(ctx context.Context, locationID u
 	}, nil
 }
 
+// renderDescription resolves the entity references in a location
+// description to the names the followed character may see. Unlike the
+// location lookup, this runs as the character, not the system, so a
+// reference to something the character may not read stays hidden. A failed
+// lookup degrades to the references' fallbacks rather than showing raw
+// references or dropping the description.
+func (lf *locationFollower) renderDescription(ctx context.Context, description string) string {
+	subject := access.CharacterSubject(lf.characterID.String())
+	text, err := lf.worldQuerier.RenderTextRefs(ctx, subject, description)
+	if err != nil {
+		slog.WarnContext(ctx, "location_state: failed to render description references",
+			"character_id", lf.characterID.String(), "error", err)
+		return world.RenderTextRefFallbacks(description)
+	}
+	return text
+}
+
 // buildLocationStateRendering looks up the location_state verb registration
 // and constructs the RenderingMetadata proto. Mirrors what RenderingPublisher
 // does for bus-routed events (internal/eventbus/rendering_publisher.go:58-73).
@@ -6,6 +6,7 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/oklog/ulid/v2"
@@ -17,9 +18,11 @@ import (
 	"github.com/holomush/holomush/internal/session"
 	"github.com/holomush/holomush/internal/testsupport/sessiontest"
 
+	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/eventbus"
 	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/npc"
 	"github.com/holomush/holomush/internal/world"
 	"github.com/holomush/holomush/pkg/errutil"
 	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
@@ -46,14 +49,37 @@ type mockWorldQuerier struct {
 	locErr   error
 	exits    []*world.Exit
 	exitsErr error
+	// exitsObserver records the observer passed to the last GetVisibleExits.
+	exitsObserver ulid.ULID
+	// rendered maps description text to what RenderTextRefs returns; text
+	// not in the map renders unchanged.
+	rendered  map[string]string
+	renderErr error
+	// renderSubject records the subject passed to the last RenderTextRefs.
+	renderSubject string
 }
 
 func (m *mockWorldQuerier) GetLocation(_ context.Context, _ string, _ ulid.ULID) (*world.Location, error) {
 	return m.location, m.locErr
 }
 
-func (m *mockWorldQuerier) GetExitsByLocation(_ context.Context, _ string, _ ulid.ULID) ([]*world.Exit, error) {
-	return m.exits, m.exitsErr
+func (m *mockWorldQuerier) GetVisibleExits(_ context.Context, _ string, _, observerCharID ulid.ULID) ([]*world.Exit, error) {
+	if m.exitsErr != nil {
+		return nil, m.exitsErr
+	}
+	m.exitsObserver = observerCharID
+	return m.exits, nil
+}
+
+func (m *mockWorldQuerier) RenderTextRefs(_ context.Context, subjectID, text string) (string, error) {
+	m.renderSubject = subjectID
+	if m.renderErr != nil {
+		return "", m.renderErr
+	}
+	if out, ok := m.rendered[text]; ok {
+		return out, nil
+	}
+	return text, nil
 }
 
 // capturingStream captures sent events for assertion.
@@ -255,10 +281,11 @@ func TestLocationFollower_BuildLocationState(t *testing.T) {
 		Streams:    []string{},
 	})
 
-	lf := &locationFollower{worldQuerier: wq, sessionStore: ss, verbRegistry: testVerbRegistry(t)}
+	lf := &locationFollower{characterID: charID, worldQuerier: wq, sessionStore: ss, verbRegistry: testVerbRegistry(t)}
 	ev, err := lf.buildLocationState(context.Background(), locID)
 	require.NoError(t, err)
 	require.NotNil(t, ev)
+	assert.Equal(t, charID, wq.exitsObserver, "exits are filtered to the followed character's view")
 
 	ef := ev.GetEvent()
 	assert.Equal(t, string(eventvocab.EventTypeLocationState), ef.GetType())
@@ -285,6 +312,66 @@ func TestLocationFollower_BuildLocationState(t *testing.T) {
 	assert.Equal(t, charID.String(), payload.Present[0].CharacterID)
 }
 
+func TestLocationFollower_BuildLocationStateRendersDescriptionRefs(t *testing.T) {
+	locID := ulid.Make()
+	charID := ulid.Make()
+	fountain := ulid.Make()
+	raw := "A square around #[object:" + fountain.String() + "]."
+
+	wq := &mockWorldQuerier{
+		location: &world.Location{ID: locID, Name: "Square", Description: raw},
+		rendered: map[string]string{raw: "A square around the Old Fountain."},
+	}
+	lf := &locationFollower{characterID: charID, worldQuerier: wq, verbRegistry: testVerbRegistry(t)}
+	ev, err := lf.buildLocationState(context.Background(), locID)
+	require.NoError(t, err)
+
+	var payload eventvocab.LocationStatePayload
+	require.NoError(t, json.Unmarshal(ev.GetEvent().GetPayload(), &payload))
+	assert.Equal(t, "A square around the Old Fountain.", payload.Location.Description)
+	assert.Equal(t, access.CharacterSubject(charID.String()), wq.renderSubject,
+		"references resolve as the followed character, not the system")
+
+	wq.renderErr = errors.New("engine down")
+	ev, err = lf.buildLocationState(context.Background(), locID)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(ev.GetEvent().GetPayload(), &payload))
+	assert.Equal(t, "A square around something.", payload.Location.Description,
+		"a failed lookup falls back rather than leaking raw references")
+}
+
+// fakeNPCPresence lists a fixed set of NPCs per location.
+type fakeNPCPresence struct {
+	at  map[ulid.ULID][]*npc.NPC
+	err error
+}
+
+func (f *fakeNPCPresence) ListAt(_ context.Context, locationID ulid.ULID) ([]*npc.NPC, error) {
+	return f.at[locationID], f.err
+}
+
+func TestLocationFollower_BuildLocationStateListsNPCs(t *testing.T) {
+	locID := ulid.Make()
+	tom := &npc.NPC{ID: ulid.Make(), Name: "Old Tom", LocationID: locID}
+	presence := &fakeNPCPresence{at: map[ulid.ULID][]*npc.NPC{locID: {tom}}}
+	wq := &mockWorldQuerier{location: &world.Location{ID: locID, Name: "Harbour"}}
+	lf := &locationFollower{characterID: ulid.Make(), worldQuerier: wq, verbRegistry: testVerbRegistry(t), npcs: presence}
+
+	ev, err := lf.buildLocationState(context.Background(), locID)
+	require.NoError(t, err)
+	var payload eventvocab.LocationStatePayload
+	require.NoError(t, json.Unmarshal(ev.GetEvent().GetPayload(), &payload))
+	assert.Equal(t, []eventvocab.LocationStateNPC{{NPCID: tom.ID.String(), Name: "Old Tom"}}, payload.NPCs)
+	assert.Empty(t, payload.Present, "NPCs are not listed as present characters")
+
+	presence.err = errors.New("store down")
+	ev, err = lf.buildLocationState(context.Background(), locID)
+	require.NoError(t, err, "a failed NPC lookup still sends the snapshot")
+	payload = eventvocab.LocationStatePayload{}
+	require.NoError(t, json.Unmarshal(ev.GetEvent().GetPayload(), &payload))
+	assert.Empty(t, payload.NPCs)
+}
+
 // recordingUpdater captures add/remove stream calls so tests can assert
 // that switchLocationSubscription invokes the filter updater correctly.
 type recordingUpdater struct {
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// LoginNoticePublisher sends a player's login notice — the message of the
+// day and running announcements — to a character's stream. Satisfied by
+// *motd.Service.
+type LoginNoticePublisher interface {
+	PublishLoginNotice(ctx context.Context, playerID, characterID ulid.ULID) error
+}
+
+// WithLoginNotices wires the message of the day into SelectCharacter: a
+// fresh grid session gets the notice on its character stream, delivered by
+// the Subscribe that follows. Nil (the default) sends nothing.
+func WithLoginNotices(p LoginNoticePublisher) CoreServerOption {
+	return func(s *CoreServer) { s.loginNotices = p }
+}
+
+// publishLoginNotice sends the login notice for a fresh session. Failures
+// are logged, never surfaced: a missing message of the day must not block
+// login.
+func (s *CoreServer) publishLoginNotice(ctx context.Context, playerID, characterID ulid.ULID) {
+	if s.loginNotices == nil {
+		return
+	}
+	if err := s.loginNotices.PublishLoginNotice(ctx, playerID, characterID); err != nil {
+		slog.WarnContext(ctx, "login notice failed",
+			"player_id", playerID.String(),
+			"character_id", characterID.String(),
+			"error", err)
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubLoginNotices records each PublishLoginNotice call and fails with err.
+type stubLoginNotices struct {
+	calls [][2]ulid.ULID
+	err   error
+}
+
+func (s *stubLoginNotices) PublishLoginNotice(_ context.Context, playerID, characterID ulid.ULID) error {
+	s.calls = append(s.calls, [2]ulid.ULID{playerID, characterID})
+	return s.err
+}
+
+func TestPublishLoginNotice(t *testing.T) {
+	playerID := ulid.MustParse("01H000000000000000000000P1")
+	charID := ulid.MustParse("01H000000000000000000000C1")
+
+	// Unconfigured: nothing to call, nothing to panic on.
+	(&CoreServer{}).publishLoginNotice(context.Background(), playerID, charID)
+
+	notices := &stubLoginNotices{}
+	s := &CoreServer{}
+	WithLoginNotices(notices)(s)
+	s.publishLoginNotice(context.Background(), playerID, charID)
+	assert.Equal(t, [][2]ulid.ULID{{playerID, charID}}, notices.calls)
+
+	// A failing notice is logged and swallowed so login proceeds.
+	notices.err = errors.New("bus down")
+	s.publishLoginNotice(context.Background(), playerID, charID)
+	assert.Len(t, notices.calls, 2)
+}
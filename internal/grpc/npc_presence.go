@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/npc"
+)
+
+// NPCPresence lists the non-player characters in a location. Satisfied by
+// *npc.Service.
+type NPCPresence interface {
+	ListAt(ctx context.Context, locationID ulid.ULID) ([]*npc.NPC, error)
+}
+
+// WithNPCPresence wires NPCs into location_state, listed apart from the
+// characters present. Nil (the default) lists none.
+func WithNPCPresence(p NPCPresence) CoreServerOption {
+	return func(s *CoreServer) { s.npcs = p }
+}
+
+// npcsAt returns the location_state entries for the NPCs in locationID. A
+// failed lookup is logged and lists none: NPCs are scenery, and the rest of
+// the snapshot is still worth sending.
+func npcsAt(ctx context.Context, p NPCPresence, locationID ulid.ULID) []eventvocab.LocationStateNPC {
+	if p == nil {
+		return nil
+	}
+	npcs, err := p.ListAt(ctx, locationID)
+	if err != nil {
+		slog.WarnContext(ctx, "location_state: failed to list NPCs at location",
+			"location_id", locationID.String(), "error", err)
+		return nil
+	}
+	out := make([]eventvocab.LocationStateNPC, 0, len(npcs))
+	for _, n := range npcs {
+		out = append(out, eventvocab.LocationStateNPC{NPCID: n.ID.String(), Name: n.Name})
+	}
+	return out
+}
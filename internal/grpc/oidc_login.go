@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/holomush/holomush/internal/auth"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// Sanitized user-facing messages for OIDC sign-in and identity linking.
+const (
+	msgOIDCNotConfigured    = "OIDC sign-in not configured"
+	msgOIDCSignInFailed     = "sign-in failed"
+	msgOIDCLinkFailed       = "identity link failed"
+	msgOIDCTokenRejected    = "identity token was rejected"
+	msgOIDCKeysUnavailable  = "identity provider is unavailable; try again later"
+	msgOIDCNotLinked        = "this identity is not linked to a player"
+	msgOIDCAlreadyLinked    = "this identity is already linked to a player"
+	msgOIDCPasswordRequired = "a local password is required first"
+	msgOIDCGuest            = "guest accounts cannot link an identity"
+	msgOIDCBadPassword      = "invalid password"
+	msgOIDCAccountLocked    = "account is temporarily locked"
+	msgOIDCUnlinkFailed     = "identity unlink failed"
+	msgOIDCListFailed       = "could not list linked identities"
+	msgOIDCLastLogin        = "set a password before unlinking your only sign-in method"
+)
+
+// IdentityServiceProvider defines the auth.Service methods used by the OIDC
+// handlers. Satisfied by *auth.Service.
+type IdentityServiceProvider interface {
+	LoginWithOIDC(ctx context.Context, rawIDToken, userAgent, ipAddress string) (string, *auth.Player, error)
+	LinkIdentity(ctx context.Context, playerID ulid.ULID, rawIDToken, password string, origin auth.SecurityOrigin) (*auth.LinkedIdentity, error)
+	UnlinkIdentity(ctx context.Context, playerID ulid.ULID, provider string, origin auth.SecurityOrigin) error
+	ListIdentities(ctx context.Context, playerID ulid.ULID) ([]*auth.LinkedIdentity, error)
+}
+
+// WithIdentityService wires OIDC sign-in and identity linking into
+// AuthenticateWithOIDC and LinkIdentity. Nil (the default) answers both
+// with "not configured".
+func WithIdentityService(svc IdentityServiceProvider) CoreServerOption {
+	return func(s *CoreServer) { s.identities = svc }
+}
+
+// AuthenticateWithOIDC signs in the player linked to the identity an OIDC
+// ID token asserts and creates a PlayerSession, exactly as
+// AuthenticatePlayer does for a password.
+func (s *CoreServer) AuthenticateWithOIDC(ctx context.Context, req *corev1.AuthenticateWithOIDCRequest) (*corev1.AuthenticateWithOIDCResponse, error) {
+	slog.DebugContext(ctx, "grpc: AuthenticateWithOIDC")
+
+	if s.identities == nil {
+		return &corev1.AuthenticateWithOIDCResponse{Success: false, ErrorMessage: msgOIDCNotConfigured}, nil
+	}
+
+	rawToken, player, authErr := s.identities.LoginWithOIDC(ctx, req.GetIdToken(), "", clientAddrFromContext(ctx))
+	if errors.Is(authErr, auth.ErrLoginBanned) {
+		return &corev1.AuthenticateWithOIDCResponse{Success: false, ErrorMessage: bannedLoginMessage(authErr)}, nil
+	}
+	if authErr != nil {
+		slog.WarnContext(ctx, "oidc sign-in failed", "error", authErr)
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.AuthenticateWithOIDCResponse{
+			Success:      false,
+			ErrorMessage: sanitizeOIDCError(authErr, msgOIDCSignInFailed),
+		}, nil
+	}
+
+	characters, err := s.buildCharacterSummaries(ctx, player.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to build character summaries", "error", err)
+	}
+
+	var defaultCharID string
+	if player.DefaultCharacterID != nil {
+		defaultCharID = player.DefaultCharacterID.String()
+	}
+
+	return &corev1.AuthenticateWithOIDCResponse{
+		Success:            true,
+		PlayerSessionToken: rawToken,
+		Characters:         characters,
+		DefaultCharacterId: defaultCharID,
+		SessionTtlSeconds:  int64(auth.PlayerSessionTTL.Seconds()),
+	}, nil
+}
+
+// LinkIdentity links the identity an OIDC ID token asserts to the player
+// that owns the caller's player session. Impersonation sessions are refused:
+// a linked identity would outlast the impersonation.
+func (s *CoreServer) LinkIdentity(ctx context.Context, req *corev1.LinkIdentityRequest) (*corev1.LinkIdentityResponse, error) {
+	slog.DebugContext(ctx, "grpc: LinkIdentity")
+
+	if s.identities == nil {
+		return &corev1.LinkIdentityResponse{Success: false, ErrorMessage: msgOIDCNotConfigured}, nil
+	}
+
+	playerSession, err := s.resolvePlayerSession(ctx, req.GetPlayerSessionToken())
+	if err != nil {
+		if isPlayerSessionAuthError(err) {
+			return &corev1.LinkIdentityResponse{
+				Success: false, ErrorMessage: "invalid or expired player session",
+			}, nil
+		}
+		return nil, err
+	}
+	if playerSession.IsImpersonation() {
+		warnImpersonationRefused(ctx, "LinkIdentity", playerSession)
+		return &corev1.LinkIdentityResponse{Success: false, ErrorMessage: msgImpersonationRestricted}, nil
+	}
+
+	origin := auth.SecurityOrigin{IPAddress: clientAddrFromContext(ctx)}
+	link, err := s.identities.LinkIdentity(ctx, playerSession.PlayerID, req.GetIdToken(), req.GetPassword(), origin)
+	if err != nil {
+		slog.WarnContext(ctx, "oidc identity link failed",
+			"player_id", playerSession.PlayerID.String(),
+			"error", err)
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.LinkIdentityResponse{
+			Success:      false,
+			ErrorMessage: sanitizeOIDCError(err, msgOIDCLinkFailed),
+		}, nil
+	}
+
+	return &corev1.LinkIdentityResponse{Success: true, Provider: link.Provider}, nil
+}
+
+// UnlinkIdentity removes the caller's linked identity for a provider.
+// Impersonation sessions are refused.
+func (s *CoreServer) UnlinkIdentity(ctx context.Context, req *corev1.UnlinkIdentityRequest) (*corev1.UnlinkIdentityResponse, error) {
+	slog.DebugContext(ctx, "grpc: UnlinkIdentity", "provider", req.GetProvider())
+
+	if s.identities == nil {
+		return &corev1.UnlinkIdentityResponse{Success: false, ErrorMessage: msgOIDCNotConfigured}, nil
+	}
+
+	playerSession, err := s.resolvePlayerSession(ctx, req.GetPlayerSessionToken())
+	if err != nil {
+		if isPlayerSessionAuthError(err) {
+			return &corev1.UnlinkIdentityResponse{
+				Success: false, ErrorMessage: "invalid or expired player session",
+			}, nil
+		}
+		return nil, err
+	}
+	if playerSession.IsImpersonation() {
+		warnImpersonationRefused(ctx, "UnlinkIdentity", playerSession)
+		return &corev1.UnlinkIdentityResponse{Success: false, ErrorMessage: msgImpersonationRestricted}, nil
+	}
+
+	origin := auth.SecurityOrigin{IPAddress: clientAddrFromContext(ctx)}
+	if err := s.identities.UnlinkIdentity(ctx, playerSession.PlayerID, req.GetProvider(), origin); err != nil {
+		slog.WarnContext(ctx, "oidc identity unlink failed",
+			"player_id", playerSession.PlayerID.String(),
+			"provider", req.GetProvider(),
+			"error", err)
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.UnlinkIdentityResponse{
+			Success:      false,
+			ErrorMessage: sanitizeOIDCError(err, msgOIDCUnlinkFailed),
+		}, nil
+	}
+
+	return &corev1.UnlinkIdentityResponse{Success: true}, nil
+}
+
+// ListIdentities returns the identities linked to the caller's player. The
+// provider subject is never included.
+func (s *CoreServer) ListIdentities(ctx context.Context, req *corev1.ListIdentitiesRequest) (*corev1.ListIdentitiesResponse, error) {
+	slog.DebugContext(ctx, "grpc: ListIdentities")
+
+	if s.identities == nil {
+		return &corev1.ListIdentitiesResponse{Success: false, ErrorMessage: msgOIDCNotConfigured}, nil
+	}
+
+	playerSession, err := s.resolvePlayerSession(ctx, req.GetPlayerSessionToken())
+	if err != nil {
+		if isPlayerSessionAuthError(err) {
+			return &corev1.ListIdentitiesResponse{
+				Success: false, ErrorMessage: "invalid or expired player session",
+			}, nil
+		}
+		return nil, err
+	}
+
+	links, err := s.identities.ListIdentities(ctx, playerSession.PlayerID)
+	if err != nil {
+		slog.WarnContext(ctx, "oidc identity list failed",
+			"player_id", playerSession.PlayerID.String(),
+			"error", err)
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.ListIdentitiesResponse{
+			Success:      false,
+			ErrorMessage: sanitizeOIDCError(err, msgOIDCListFailed),
+		}, nil
+	}
+
+	out := make([]*corev1.LinkedIdentityInfo, 0, len(links))
+	for _, link := range links {
+		out = append(out, &corev1.LinkedIdentityInfo{
+			Provider: link.Provider,
+			Email:    link.Email,
+			LinkedAt: timestamppb.New(link.LinkedAt),
+		})
+	}
+	return &corev1.ListIdentitiesResponse{Success: true, Identities: out}, nil
+}
+
+// sanitizeOIDCError maps an OIDC sign-in or linking failure to a fixed
+// user-facing message, or fallback for anything unexpected. Token
+// verification failures keep the verifier's own OIDC_* code, so they are
+// matched by prefix.
+func sanitizeOIDCError(err error, fallback string) string {
+	oopsErr, isOops := oops.AsOops(err)
+	if !isOops {
+		return fallback
+	}
+	code, _ := oopsErr.Code().(string)
+	switch code {
+	case "AUTH_OIDC_DISABLED":
+		return msgOIDCNotConfigured
+	case "AUTH_OIDC_NOT_LINKED":
+		return msgOIDCNotLinked
+	case "AUTH_OIDC_ALREADY_LINKED":
+		return msgOIDCAlreadyLinked
+	case "AUTH_OIDC_PASSWORD_REQUIRED":
+		return msgOIDCPasswordRequired
+	case "AUTH_OIDC_LAST_LOGIN":
+		return msgOIDCLastLogin
+	case "AUTH_OIDC_INVALID":
+		return msgOIDCGuest
+	case "AUTH_INVALID_CREDENTIALS":
+		return msgOIDCBadPassword
+	case "AUTH_ACCOUNT_LOCKED":
+		return msgOIDCAccountLocked
+	case "OIDC_KEYS_UNAVAILABLE":
+		return msgOIDCKeysUnavailable
+	case "AUTH_OIDC_INVALID_TOKEN":
+		return msgOIDCTokenRejected
+	}
+	if strings.HasPrefix(code, "OIDC_") {
+		return msgOIDCTokenRejected
+	}
+	return fallback
+}
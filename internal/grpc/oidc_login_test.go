@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/holomush/holomush/internal/auth"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// stubIdentities records the last OIDC call and answers with fixed results.
+type stubIdentities struct {
+	token    string
+	player   *auth.Player
+	link     *auth.LinkedIdentity
+	links    []*auth.LinkedIdentity
+	err      error
+	ip       string
+	playerID ulid.ULID
+	password string
+	provider string
+}
+
+func (s *stubIdentities) LoginWithOIDC(_ context.Context, _, _, ipAddress string) (string, *auth.Player, error) {
+	s.ip = ipAddress
+	return s.token, s.player, s.err
+}
+
+func (s *stubIdentities) LinkIdentity(_ context.Context, playerID ulid.ULID, _, password string, origin auth.SecurityOrigin) (*auth.LinkedIdentity, error) {
+	s.playerID, s.password, s.ip = playerID, password, origin.IPAddress
+	return s.link, s.err
+}
+
+func (s *stubIdentities) UnlinkIdentity(_ context.Context, playerID ulid.ULID, provider string, origin auth.SecurityOrigin) error {
+	s.playerID, s.provider, s.ip = playerID, provider, origin.IPAddress
+	return s.err
+}
+
+func (s *stubIdentities) ListIdentities(_ context.Context, playerID ulid.ULID) ([]*auth.LinkedIdentity, error) {
+	s.playerID = playerID
+	return s.links, s.err
+}
+
+func TestAuthenticateWithOIDC(t *testing.T) {
+	charID := ulid.Make()
+	player := &auth.Player{ID: ulid.Make(), DefaultCharacterID: &charID}
+
+	t.Run("not configured", func(t *testing.T) {
+		resp, err := (&CoreServer{}).AuthenticateWithOIDC(context.Background(), &corev1.AuthenticateWithOIDCRequest{IdToken: "tok"})
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgOIDCNotConfigured, resp.GetErrorMessage())
+	})
+
+	t.Run("signs in and forwards the client address", func(t *testing.T) {
+		ids := &stubIdentities{token: "raw-token", player: player}
+		s := &CoreServer{}
+		WithIdentityService(ids)(s)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientAddrMetadataKey, "203.0.113.7"))
+
+		resp, err := s.AuthenticateWithOIDC(ctx, &corev1.AuthenticateWithOIDCRequest{IdToken: "tok"})
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, "raw-token", resp.GetPlayerSessionToken())
+		assert.Equal(t, charID.String(), resp.GetDefaultCharacterId())
+		assert.Equal(t, int64(auth.PlayerSessionTTL.Seconds()), resp.GetSessionTtlSeconds())
+		assert.Equal(t, "203.0.113.7", ids.ip)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"banned", oops.With("reason", "spam").Wrap(auth.ErrLoginBanned), "You are banned from this server: spam"},
+		{"not linked", oops.Code("AUTH_OIDC_NOT_LINKED").Errorf("not linked"), msgOIDCNotLinked},
+		{"locked", oops.Code("AUTH_ACCOUNT_LOCKED").Errorf("locked"), msgOIDCAccountLocked},
+		{"expired token", oops.Code("AUTH_OIDC_INVALID_TOKEN").Wrap(oops.Code("OIDC_TOKEN_EXPIRED").Errorf("expired")), msgOIDCTokenRejected},
+		{"provider down", oops.Code("OIDC_KEYS_UNAVAILABLE").Errorf("fetch failed"), msgOIDCKeysUnavailable},
+		{"unexpected", errors.New("db down"), msgOIDCSignInFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{}
+			WithIdentityService(&stubIdentities{err: tt.err})(s)
+			resp, err := s.AuthenticateWithOIDC(context.Background(), &corev1.AuthenticateWithOIDCRequest{IdToken: "tok"})
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+}
+
+func TestLinkIdentity(t *testing.T) {
+	ps := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make()}
+	sessionRepo := func(t *testing.T) *authmocks.MockPlayerSessionRepository {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken("session")).Return(ps, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, ps.ID, auth.PlayerSessionTTL).Return(nil)
+		return repo
+	}
+	req := &corev1.LinkIdentityRequest{PlayerSessionToken: "session", IdToken: "tok", Password: "hunter22"}
+
+	t.Run("links to the session's player", func(t *testing.T) {
+		ids := &stubIdentities{link: &auth.LinkedIdentity{Provider: "google"}}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithIdentityService(ids)(s)
+
+		resp, err := s.LinkIdentity(context.Background(), req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, "google", resp.GetProvider())
+		assert.Equal(t, ps.PlayerID, ids.playerID)
+		assert.Equal(t, "hunter22", ids.password)
+	})
+
+	t.Run("rejects an unknown session", func(t *testing.T) {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, mock.Anything).
+			Return(nil, oops.Code("PLAYER_SESSION_NOT_FOUND").Errorf("not found"))
+		s := &CoreServer{playerSessionRepo: repo}
+		WithIdentityService(&stubIdentities{})(s)
+
+		resp, err := s.LinkIdentity(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, "invalid or expired player session", resp.GetErrorMessage())
+	})
+
+	t.Run("refuses an impersonation session", func(t *testing.T) {
+		imp := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make(), ImpersonatorID: ulid.Make()}
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, mock.Anything).Return(imp, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, imp.ID, auth.PlayerSessionTTL).Return(nil)
+		ids := &stubIdentities{}
+		s := &CoreServer{playerSessionRepo: repo}
+		WithIdentityService(ids)(s)
+
+		resp, err := s.LinkIdentity(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgImpersonationRestricted, resp.GetErrorMessage())
+		assert.True(t, ids.playerID.IsZero(), "the identity service is not called")
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"already linked", oops.Code("AUTH_OIDC_ALREADY_LINKED").Errorf("dup"), msgOIDCAlreadyLinked},
+		{"wrong password", oops.Code("AUTH_INVALID_CREDENTIALS").Errorf("bad"), msgOIDCBadPassword},
+		{"no password", oops.Code("AUTH_OIDC_PASSWORD_REQUIRED").Errorf("none"), msgOIDCPasswordRequired},
+		{"guest", oops.Code("AUTH_OIDC_INVALID").Errorf("guest"), msgOIDCGuest},
+		{"unexpected", errors.New("db down"), msgOIDCLinkFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+			WithIdentityService(&stubIdentities{err: tt.err})(s)
+			resp, err := s.LinkIdentity(context.Background(), req)
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+}
+
+func TestUnlinkIdentity(t *testing.T) {
+	ps := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make()}
+	sessionRepo := func(t *testing.T, ps *auth.PlayerSession) *authmocks.MockPlayerSessionRepository {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken("session")).Return(ps, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, ps.ID, auth.PlayerSessionTTL).Return(nil)
+		return repo
+	}
+	req := &corev1.UnlinkIdentityRequest{PlayerSessionToken: "session", Provider: "google"}
+
+	t.Run("not configured", func(t *testing.T) {
+		resp, err := (&CoreServer{}).UnlinkIdentity(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, msgOIDCNotConfigured, resp.GetErrorMessage())
+	})
+
+	t.Run("unlinks from the session's player", func(t *testing.T) {
+		ids := &stubIdentities{}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t, ps)}
+		WithIdentityService(ids)(s)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientAddrMetadataKey, "203.0.113.7"))
+
+		resp, err := s.UnlinkIdentity(ctx, req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, ps.PlayerID, ids.playerID)
+		assert.Equal(t, "google", ids.provider)
+		assert.Equal(t, "203.0.113.7", ids.ip)
+	})
+
+	t.Run("refuses an impersonation session", func(t *testing.T) {
+		imp := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make(), ImpersonatorID: ulid.Make()}
+		ids := &stubIdentities{}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t, imp)}
+		WithIdentityService(ids)(s)
+
+		resp, err := s.UnlinkIdentity(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgImpersonationRestricted, resp.GetErrorMessage())
+		assert.Empty(t, ids.provider, "the identity service is not called")
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"not linked", oops.Code("AUTH_OIDC_NOT_LINKED").Errorf("none"), msgOIDCNotLinked},
+		{"last login", oops.Code("AUTH_OIDC_LAST_LOGIN").Errorf("last"), msgOIDCLastLogin},
+		{"unexpected", errors.New("db down"), msgOIDCUnlinkFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{playerSessionRepo: sessionRepo(t, ps)}
+			WithIdentityService(&stubIdentities{err: tt.err})(s)
+			resp, err := s.UnlinkIdentity(context.Background(), req)
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+}
+
+func TestListIdentities(t *testing.T) {
+	ps := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make()}
+	sessionRepo := func(t *testing.T) *authmocks.MockPlayerSessionRepository {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken("session")).Return(ps, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, ps.ID, auth.PlayerSessionTTL).Return(nil)
+		return repo
+	}
+	req := &corev1.ListIdentitiesRequest{PlayerSessionToken: "session"}
+
+	t.Run("lists the session's identities without subjects", func(t *testing.T) {
+		linkedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		ids := &stubIdentities{links: []*auth.LinkedIdentity{
+			{Provider: "google", Subject: "g-123", Email: "alice@example.com", LinkedAt: linkedAt},
+		}}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithIdentityService(ids)(s)
+
+		resp, err := s.ListIdentities(context.Background(), req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, ps.PlayerID, ids.playerID)
+		require.Len(t, resp.GetIdentities(), 1)
+		got := resp.GetIdentities()[0]
+		assert.Equal(t, "google", got.GetProvider())
+		assert.Equal(t, "alice@example.com", got.GetEmail())
+		assert.True(t, linkedAt.Equal(got.GetLinkedAt().AsTime()))
+	})
+
+	t.Run("sanitizes failures", func(t *testing.T) {
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithIdentityService(&stubIdentities{err: errors.New("db down")})(s)
+
+		resp, err := s.ListIdentities(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, msgOIDCListFailed, resp.GetErrorMessage())
+	})
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// invalidReconnectTokenMessage is the single user-facing message for every
+// rejected reconnect token, so a caller cannot tell an unknown token from a
+// used one or an ended session (enumeration-safe, I-SEC-1).
+const invalidReconnectTokenMessage = "invalid or expired reconnect token"
+
+// issueReconnectToken stores a fresh reconnect token for the game session and
+// returns it, replacing any token issued before.
+func (s *CoreServer) issueReconnectToken(ctx context.Context, sessionID string) (string, error) {
+	token, hash, err := auth.GenerateSessionToken()
+	if err != nil {
+		return "", oops.With("session_id", sessionID).Wrap(err)
+	}
+	if err := s.sessionStore.SetReconnectTokenHash(ctx, sessionID, hash); err != nil {
+		return "", oops.Code("RECONNECT_TOKEN_STORE_FAILED").With("session_id", sessionID).Wrap(err)
+	}
+	return token, nil
+}
+
+// reconnectTokenFor issues a reconnect token when the SelectCharacter caller
+// asked for one. Web sessions holding a refresh token renew by rotating it
+// and never get one. Issuing is best-effort: the game session is already
+// usable, so a failure only costs the client its ability to resume.
+func (s *CoreServer) reconnectTokenFor(ctx context.Context, req *corev1.SelectCharacterRequest, playerSession *auth.PlayerSession, sessionID string) string {
+	if !req.GetIssueReconnectToken() || playerSession.HasRefreshToken() {
+		return ""
+	}
+	token, err := s.issueReconnectToken(ctx, sessionID)
+	if err != nil {
+		slog.WarnContext(ctx, "select_character: reconnect token not issued",
+			"session_id", sessionID, "error", err)
+		return ""
+	}
+	return token
+}
+
+// ResumeSession redeems a reconnect token for the game session holding it.
+// The reconnect token and the player session's access token are both
+// rotated, so the dropped connection's tokens stop working and a token
+// resumes at most once. The game session itself is left as is: the client's
+// next Subscribe reattaches a detached session and replays what it missed.
+func (s *CoreServer) ResumeSession(ctx context.Context, req *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error) {
+	slog.DebugContext(ctx, "grpc: ResumeSession")
+
+	rejected := &corev1.ResumeSessionResponse{
+		Meta:         responseMeta(req.GetMeta().GetRequestId()),
+		Success:      false,
+		ErrorMessage: invalidReconnectTokenMessage,
+	}
+	if req.GetReconnectToken() == "" {
+		return rejected, nil
+	}
+	if s.playerSessionRepo == nil {
+		return &corev1.ResumeSessionResponse{
+			Meta:         responseMeta(req.GetMeta().GetRequestId()),
+			Success:      false,
+			ErrorMessage: "player session service not configured",
+		}, nil
+	}
+
+	reconnectToken, reconnectHash, err := auth.GenerateSessionToken()
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	info, err := s.sessionStore.RotateReconnectToken(ctx, auth.HashSessionToken(req.GetReconnectToken()), reconnectHash)
+	if err != nil {
+		if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "SESSION_NOT_FOUND" {
+			return rejected, nil
+		}
+		return nil, oops.Code("RECONNECT_TOKEN_ROTATE_FAILED").Wrap(err)
+	}
+
+	playerSession, err := s.playerSessionRepo.GetByID(ctx, info.PlayerSessionID)
+	if err != nil {
+		if isPlayerSessionAuthError(err) {
+			return rejected, nil
+		}
+		return nil, oops.Code("PLAYER_SESSION_LOOKUP_FAILED").With("session_id", info.ID).Wrap(err)
+	}
+	if playerSession.IsExpired() || playerSession.HasRefreshToken() {
+		slog.InfoContext(ctx, "resume_session: player session cannot resume",
+			"session_id", info.ID, "player_session_id", playerSession.ID.String(),
+			"expired", playerSession.IsExpired())
+		return rejected, nil
+	}
+
+	playerToken, playerHash, err := auth.GenerateSessionToken()
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	if err := s.playerSessionRepo.SetTokenHash(ctx, playerSession.ID, playerHash); err != nil {
+		if isPlayerSessionAuthError(err) {
+			return rejected, nil
+		}
+		return nil, oops.Code("PLAYER_SESSION_TOKEN_ROTATE_FAILED").With("session_id", info.ID).Wrap(err)
+	}
+	// Best-effort TTL refresh, matching resolvePlayerSession.
+	s.playerSessionRepo.RefreshTTL(ctx, playerSession.ID, auth.PlayerSessionTTL) //nolint:errcheck // best-effort
+
+	slog.InfoContext(ctx, "resume_session: session resumed",
+		"session_id", info.ID, "character_id", info.CharacterID.String())
+
+	return &corev1.ResumeSessionResponse{
+		Meta:               responseMeta(req.GetMeta().GetRequestId()),
+		Success:            true,
+		SessionId:          info.ID,
+		CharacterName:      info.CharacterName,
+		PlayerSessionToken: playerToken,
+		ReconnectToken:     reconnectToken,
+	}, nil
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/auth"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/session"
+	sessionmocks "github.com/holomush/holomush/internal/session/mocks"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+const testReconnectToken = "unit-test-reconnect-token"
+
+// resumableSession returns the game session a reconnect token resumes.
+func resumableSession(playerSessionID ulid.ULID) *session.Info {
+	return &session.Info{
+		ID:              "sess-resume",
+		CharacterID:     ulid.Make(),
+		CharacterName:   "Alice",
+		PlayerSessionID: playerSessionID,
+		Status:          session.StatusDetached,
+	}
+}
+
+func TestResumeSessionRotatesBothTokens(t *testing.T) {
+	ctx := context.Background()
+	ps := makePlayerSession(ulid.Make())
+	info := resumableSession(ps.ID)
+
+	var rotatedReconnectHash, rotatedPlayerHash string
+	store := sessionmocks.NewMockStore(t)
+	store.EXPECT().RotateReconnectToken(mock.Anything, auth.HashSessionToken(testReconnectToken), mock.AnythingOfType("string")).
+		Run(func(_ context.Context, _, newHash string) { rotatedReconnectHash = newHash }).
+		Return(info, nil)
+	repo := authmocks.NewMockPlayerSessionRepository(t)
+	repo.EXPECT().GetByID(mock.Anything, ps.ID).Return(ps, nil)
+	repo.EXPECT().SetTokenHash(mock.Anything, ps.ID, mock.AnythingOfType("string")).
+		Run(func(_ context.Context, _ ulid.ULID, tokenHash string) { rotatedPlayerHash = tokenHash }).
+		Return(nil)
+	repo.EXPECT().RefreshTTL(mock.Anything, ps.ID, auth.PlayerSessionTTL).Return(nil)
+
+	server := &CoreServer{sessionStore: store, playerSessionRepo: repo}
+	resp, err := server.ResumeSession(ctx, &corev1.ResumeSessionRequest{ReconnectToken: testReconnectToken})
+	require.NoError(t, err)
+	require.True(t, resp.GetSuccess(), resp.GetErrorMessage())
+	assert.Equal(t, info.ID, resp.GetSessionId())
+	assert.Equal(t, "Alice", resp.GetCharacterName())
+	assert.Equal(t, rotatedReconnectHash, auth.HashSessionToken(resp.GetReconnectToken()))
+	assert.Equal(t, rotatedPlayerHash, auth.HashSessionToken(resp.GetPlayerSessionToken()))
+	assert.NotEqual(t, testReconnectToken, resp.GetReconnectToken())
+	assert.NotEqual(t, ps.TokenHash, rotatedPlayerHash, "the dropped connection's player token is revoked")
+}
+
+func TestResumeSessionRejectsUnusableTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		setup func(t *testing.T, store *sessionmocks.MockStore, repo *authmocks.MockPlayerSessionRepository)
+	}{
+		{
+			name:  "empty token",
+			setup: func(*testing.T, *sessionmocks.MockStore, *authmocks.MockPlayerSessionRepository) {},
+		},
+		{
+			name:  "unknown or already used token",
+			token: testReconnectToken,
+			setup: func(_ *testing.T, store *sessionmocks.MockStore, _ *authmocks.MockPlayerSessionRepository) {
+				store.EXPECT().RotateReconnectToken(mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, oops.Code("SESSION_NOT_FOUND").Errorf("session not found"))
+			},
+		},
+		{
+			name:  "player session gone",
+			token: testReconnectToken,
+			setup: func(_ *testing.T, store *sessionmocks.MockStore, repo *authmocks.MockPlayerSessionRepository) {
+				info := resumableSession(ulid.Make())
+				store.EXPECT().RotateReconnectToken(mock.Anything, mock.Anything, mock.Anything).Return(info, nil)
+				repo.EXPECT().GetByID(mock.Anything, info.PlayerSessionID).
+					Return(nil, oops.Code("PLAYER_SESSION_NOT_FOUND").Wrap(auth.ErrNotFound))
+			},
+		},
+		{
+			name:  "player session expired",
+			token: testReconnectToken,
+			setup: func(_ *testing.T, store *sessionmocks.MockStore, repo *authmocks.MockPlayerSessionRepository) {
+				ps := makePlayerSession(ulid.Make())
+				ps.ExpiresAt = time.Now().Add(-time.Minute)
+				store.EXPECT().RotateReconnectToken(mock.Anything, mock.Anything, mock.Anything).Return(resumableSession(ps.ID), nil)
+				repo.EXPECT().GetByID(mock.Anything, ps.ID).Return(ps, nil)
+			},
+		},
+		{
+			name:  "player session holds a refresh token",
+			token: testReconnectToken,
+			setup: func(_ *testing.T, store *sessionmocks.MockStore, repo *authmocks.MockPlayerSessionRepository) {
+				ps := makePlayerSession(ulid.Make())
+				ps.RefreshTokenHash = "refresh-hash"
+				store.EXPECT().RotateReconnectToken(mock.Anything, mock.Anything, mock.Anything).Return(resumableSession(ps.ID), nil)
+				repo.EXPECT().GetByID(mock.Anything, ps.ID).Return(ps, nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := sessionmocks.NewMockStore(t)
+			repo := authmocks.NewMockPlayerSessionRepository(t)
+			tt.setup(t, store, repo)
+
+			server := &CoreServer{sessionStore: store, playerSessionRepo: repo}
+			resp, err := server.ResumeSession(context.Background(), &corev1.ResumeSessionRequest{ReconnectToken: tt.token})
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, invalidReconnectTokenMessage, resp.GetErrorMessage())
+			assert.Empty(t, resp.GetPlayerSessionToken())
+			assert.Empty(t, resp.GetReconnectToken())
+		})
+	}
+}
+
+func TestResumeSessionStoreFailure(t *testing.T) {
+	store := sessionmocks.NewMockStore(t)
+	store.EXPECT().RotateReconnectToken(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused"))
+
+	server := &CoreServer{sessionStore: store, playerSessionRepo: authmocks.NewMockPlayerSessionRepository(t)}
+	_, err := server.ResumeSession(context.Background(), &corev1.ResumeSessionRequest{ReconnectToken: testReconnectToken})
+	errutil.AssertErrorCode(t, err, "RECONNECT_TOKEN_ROTATE_FAILED")
+}
+
+func TestSelectCharacterIssuesReconnectToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		issue        bool
+		refreshToken bool
+		wantToken    bool
+	}{
+		{name: "issued on request", issue: true, wantToken: true},
+		{name: "not requested", issue: false},
+		{name: "refresh-token sessions never get one", issue: true, refreshToken: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			playerID := ulid.Make()
+			charID := ulid.Make()
+			ps := makePlayerSession(playerID)
+			if tt.refreshToken {
+				ps.RefreshTokenHash = "refresh-hash"
+			}
+			charRepo := authmocks.NewMockCharacterRepository(t)
+			charRepo.EXPECT().ListByPlayer(mock.Anything, playerID).
+				Return([]*world.Character{{ID: charID, PlayerID: playerID, Name: "Alice"}}, nil)
+
+			existing := &session.Info{ID: "sess-existing", CharacterID: charID, Status: session.StatusDetached}
+			var storedHash string
+			store := sessionmocks.NewMockStore(t)
+			store.EXPECT().FindByCharacter(mock.Anything, charID).Return(existing, nil)
+			store.EXPECT().UpdateStatus(mock.Anything, existing.ID, session.StatusActive, mock.Anything, mock.Anything).Return(nil)
+			if tt.wantToken {
+				store.EXPECT().SetReconnectTokenHash(mock.Anything, existing.ID, mock.AnythingOfType("string")).
+					Run(func(_ context.Context, _, tokenHash string) { storedHash = tokenHash }).
+					Return(nil)
+			}
+
+			server := &CoreServer{
+				sessionStore:      store,
+				playerSessionRepo: setupSessionRepo(t, ps),
+				charRepo:          charRepo,
+			}
+			resp, err := server.SelectCharacter(ctx, &corev1.SelectCharacterRequest{
+				PlayerSessionToken:  validToken,
+				CharacterId:         charID.String(),
+				IssueReconnectToken: tt.issue,
+			})
+			require.NoError(t, err)
+			require.True(t, resp.GetSuccess())
+			if tt.wantToken {
+				assert.Equal(t, storedHash, auth.HashSessionToken(resp.GetReconnectToken()))
+			} else {
+				assert.Empty(t, resp.GetReconnectToken())
+			}
+		})
+	}
+}
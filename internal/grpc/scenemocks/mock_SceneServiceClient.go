@@ -28,6 +28,80 @@ func (_m *MockSceneServiceClient) EXPECT() *MockSceneServiceClient_Expecter {
 	return &MockSceneServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// CancelScheduledScene provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) CancelScheduledScene(ctx context.Context, in *scenev1.CancelScheduledSceneRequest, opts ...grpc.CallOption) (*scenev1.CancelScheduledSceneResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelScheduledScene")
+	}
+
+	var r0 *scenev1.CancelScheduledSceneResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.CancelScheduledSceneRequest, ...grpc.CallOption) (*scenev1.CancelScheduledSceneResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.CancelScheduledSceneRequest, ...grpc.CallOption) *scenev1.CancelScheduledSceneResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.CancelScheduledSceneResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.CancelScheduledSceneRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_CancelScheduledScene_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledScene'
+type MockSceneServiceClient_CancelScheduledScene_Call struct {
+	*mock.Call
+}
+
+// CancelScheduledScene is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.CancelScheduledSceneRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) CancelScheduledScene(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_CancelScheduledScene_Call {
+	return &MockSceneServiceClient_CancelScheduledScene_Call{Call: _e.mock.On("CancelScheduledScene",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_CancelScheduledScene_Call) Run(run func(ctx context.Context, in *scenev1.CancelScheduledSceneRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_CancelScheduledScene_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.CancelScheduledSceneRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_CancelScheduledScene_Call) Return(_a0 *scenev1.CancelScheduledSceneResponse, _a1 error) *MockSceneServiceClient_CancelScheduledScene_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_CancelScheduledScene_Call) RunAndReturn(run func(context.Context, *scenev1.CancelScheduledSceneRequest, ...grpc.CallOption) (*scenev1.CancelScheduledSceneResponse, error)) *MockSceneServiceClient_CancelScheduledScene_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CastPublishSceneVote provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) CastPublishSceneVote(ctx context.Context, in *scenev1.CastPublishSceneVoteRequest, opts ...grpc.CallOption) (*scenev1.CastPublishSceneVoteResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -546,6 +620,80 @@ func (_c *MockSceneServiceClient_ExportSceneLog_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ExportTranscript provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) ExportTranscript(ctx context.Context, in *scenev1.ExportTranscriptRequest, opts ...grpc.CallOption) (*scenev1.ExportTranscriptResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTranscript")
+	}
+
+	var r0 *scenev1.ExportTranscriptResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ExportTranscriptRequest, ...grpc.CallOption) (*scenev1.ExportTranscriptResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ExportTranscriptRequest, ...grpc.CallOption) *scenev1.ExportTranscriptResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.ExportTranscriptResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.ExportTranscriptRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_ExportTranscript_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTranscript'
+type MockSceneServiceClient_ExportTranscript_Call struct {
+	*mock.Call
+}
+
+// ExportTranscript is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.ExportTranscriptRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) ExportTranscript(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_ExportTranscript_Call {
+	return &MockSceneServiceClient_ExportTranscript_Call{Call: _e.mock.On("ExportTranscript",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_ExportTranscript_Call) Run(run func(ctx context.Context, in *scenev1.ExportTranscriptRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_ExportTranscript_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.ExportTranscriptRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ExportTranscript_Call) Return(_a0 *scenev1.ExportTranscriptResponse, _a1 error) *MockSceneServiceClient_ExportTranscript_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ExportTranscript_Call) RunAndReturn(run func(context.Context, *scenev1.ExportTranscriptRequest, ...grpc.CallOption) (*scenev1.ExportTranscriptResponse, error)) *MockSceneServiceClient_ExportTranscript_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExtendScenePublishVoteAttempts provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) ExtendScenePublishVoteAttempts(ctx context.Context, in *scenev1.ExtendScenePublishVoteAttemptsRequest, opts ...grpc.CallOption) (*scenev1.ExtendScenePublishVoteAttemptsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -990,6 +1138,80 @@ func (_c *MockSceneServiceClient_GetSceneNotifyPref_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetSceneTurns provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) GetSceneTurns(ctx context.Context, in *scenev1.GetSceneTurnsRequest, opts ...grpc.CallOption) (*scenev1.GetSceneTurnsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSceneTurns")
+	}
+
+	var r0 *scenev1.GetSceneTurnsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.GetSceneTurnsRequest, ...grpc.CallOption) (*scenev1.GetSceneTurnsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.GetSceneTurnsRequest, ...grpc.CallOption) *scenev1.GetSceneTurnsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.GetSceneTurnsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.GetSceneTurnsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_GetSceneTurns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSceneTurns'
+type MockSceneServiceClient_GetSceneTurns_Call struct {
+	*mock.Call
+}
+
+// GetSceneTurns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.GetSceneTurnsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) GetSceneTurns(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_GetSceneTurns_Call {
+	return &MockSceneServiceClient_GetSceneTurns_Call{Call: _e.mock.On("GetSceneTurns",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_GetSceneTurns_Call) Run(run func(ctx context.Context, in *scenev1.GetSceneTurnsRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_GetSceneTurns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.GetSceneTurnsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_GetSceneTurns_Call) Return(_a0 *scenev1.GetSceneTurnsResponse, _a1 error) *MockSceneServiceClient_GetSceneTurns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_GetSceneTurns_Call) RunAndReturn(run func(context.Context, *scenev1.GetSceneTurnsRequest, ...grpc.CallOption) (*scenev1.GetSceneTurnsResponse, error)) *MockSceneServiceClient_GetSceneTurns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InviteToScene provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) InviteToScene(ctx context.Context, in *scenev1.InviteToSceneRequest, opts ...grpc.CallOption) (*scenev1.InviteToSceneResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1656,6 +1878,80 @@ func (_c *MockSceneServiceClient_ListScenes_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListUpcomingScenes provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) ListUpcomingScenes(ctx context.Context, in *scenev1.ListUpcomingScenesRequest, opts ...grpc.CallOption) (*scenev1.ListUpcomingScenesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUpcomingScenes")
+	}
+
+	var r0 *scenev1.ListUpcomingScenesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ListUpcomingScenesRequest, ...grpc.CallOption) (*scenev1.ListUpcomingScenesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ListUpcomingScenesRequest, ...grpc.CallOption) *scenev1.ListUpcomingScenesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.ListUpcomingScenesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.ListUpcomingScenesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_ListUpcomingScenes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUpcomingScenes'
+type MockSceneServiceClient_ListUpcomingScenes_Call struct {
+	*mock.Call
+}
+
+// ListUpcomingScenes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.ListUpcomingScenesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) ListUpcomingScenes(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_ListUpcomingScenes_Call {
+	return &MockSceneServiceClient_ListUpcomingScenes_Call{Call: _e.mock.On("ListUpcomingScenes",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_ListUpcomingScenes_Call) Run(run func(ctx context.Context, in *scenev1.ListUpcomingScenesRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_ListUpcomingScenes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.ListUpcomingScenesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ListUpcomingScenes_Call) Return(_a0 *scenev1.ListUpcomingScenesResponse, _a1 error) *MockSceneServiceClient_ListUpcomingScenes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ListUpcomingScenes_Call) RunAndReturn(run func(context.Context, *scenev1.ListUpcomingScenesRequest, ...grpc.CallOption) (*scenev1.ListUpcomingScenesResponse, error)) *MockSceneServiceClient_ListUpcomingScenes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MuteScene provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) MuteScene(ctx context.Context, in *scenev1.MuteSceneRequest, opts ...grpc.CallOption) (*scenev1.MuteSceneResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1878,6 +2174,154 @@ func (_c *MockSceneServiceClient_ResumeScene_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// RsvpScheduledScene provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) RsvpScheduledScene(ctx context.Context, in *scenev1.RsvpScheduledSceneRequest, opts ...grpc.CallOption) (*scenev1.RsvpScheduledSceneResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RsvpScheduledScene")
+	}
+
+	var r0 *scenev1.RsvpScheduledSceneResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.RsvpScheduledSceneRequest, ...grpc.CallOption) (*scenev1.RsvpScheduledSceneResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.RsvpScheduledSceneRequest, ...grpc.CallOption) *scenev1.RsvpScheduledSceneResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.RsvpScheduledSceneResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.RsvpScheduledSceneRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_RsvpScheduledScene_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RsvpScheduledScene'
+type MockSceneServiceClient_RsvpScheduledScene_Call struct {
+	*mock.Call
+}
+
+// RsvpScheduledScene is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.RsvpScheduledSceneRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) RsvpScheduledScene(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_RsvpScheduledScene_Call {
+	return &MockSceneServiceClient_RsvpScheduledScene_Call{Call: _e.mock.On("RsvpScheduledScene",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_RsvpScheduledScene_Call) Run(run func(ctx context.Context, in *scenev1.RsvpScheduledSceneRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_RsvpScheduledScene_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.RsvpScheduledSceneRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_RsvpScheduledScene_Call) Return(_a0 *scenev1.RsvpScheduledSceneResponse, _a1 error) *MockSceneServiceClient_RsvpScheduledScene_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_RsvpScheduledScene_Call) RunAndReturn(run func(context.Context, *scenev1.RsvpScheduledSceneRequest, ...grpc.CallOption) (*scenev1.RsvpScheduledSceneResponse, error)) *MockSceneServiceClient_RsvpScheduledScene_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleScene provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) ScheduleScene(ctx context.Context, in *scenev1.ScheduleSceneRequest, opts ...grpc.CallOption) (*scenev1.ScheduleSceneResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleScene")
+	}
+
+	var r0 *scenev1.ScheduleSceneResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ScheduleSceneRequest, ...grpc.CallOption) (*scenev1.ScheduleSceneResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.ScheduleSceneRequest, ...grpc.CallOption) *scenev1.ScheduleSceneResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.ScheduleSceneResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.ScheduleSceneRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_ScheduleScene_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleScene'
+type MockSceneServiceClient_ScheduleScene_Call struct {
+	*mock.Call
+}
+
+// ScheduleScene is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.ScheduleSceneRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) ScheduleScene(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_ScheduleScene_Call {
+	return &MockSceneServiceClient_ScheduleScene_Call{Call: _e.mock.On("ScheduleScene",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_ScheduleScene_Call) Run(run func(ctx context.Context, in *scenev1.ScheduleSceneRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_ScheduleScene_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.ScheduleSceneRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ScheduleScene_Call) Return(_a0 *scenev1.ScheduleSceneResponse, _a1 error) *MockSceneServiceClient_ScheduleScene_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_ScheduleScene_Call) RunAndReturn(run func(context.Context, *scenev1.ScheduleSceneRequest, ...grpc.CallOption) (*scenev1.ScheduleSceneResponse, error)) *MockSceneServiceClient_ScheduleScene_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetSceneNotifyPref provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) SetSceneNotifyPref(ctx context.Context, in *scenev1.SetSceneNotifyPrefRequest, opts ...grpc.CallOption) (*scenev1.SetSceneNotifyPrefResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2174,6 +2618,80 @@ func (_c *MockSceneServiceClient_UpdateScene_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateSceneTurns provides a mock function with given fields: ctx, in, opts
+func (_m *MockSceneServiceClient) UpdateSceneTurns(ctx context.Context, in *scenev1.UpdateSceneTurnsRequest, opts ...grpc.CallOption) (*scenev1.UpdateSceneTurnsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSceneTurns")
+	}
+
+	var r0 *scenev1.UpdateSceneTurnsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.UpdateSceneTurnsRequest, ...grpc.CallOption) (*scenev1.UpdateSceneTurnsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *scenev1.UpdateSceneTurnsRequest, ...grpc.CallOption) *scenev1.UpdateSceneTurnsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenev1.UpdateSceneTurnsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *scenev1.UpdateSceneTurnsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneServiceClient_UpdateSceneTurns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSceneTurns'
+type MockSceneServiceClient_UpdateSceneTurns_Call struct {
+	*mock.Call
+}
+
+// UpdateSceneTurns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *scenev1.UpdateSceneTurnsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockSceneServiceClient_Expecter) UpdateSceneTurns(ctx interface{}, in interface{}, opts ...interface{}) *MockSceneServiceClient_UpdateSceneTurns_Call {
+	return &MockSceneServiceClient_UpdateSceneTurns_Call{Call: _e.mock.On("UpdateSceneTurns",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockSceneServiceClient_UpdateSceneTurns_Call) Run(run func(ctx context.Context, in *scenev1.UpdateSceneTurnsRequest, opts ...grpc.CallOption)) *MockSceneServiceClient_UpdateSceneTurns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*scenev1.UpdateSceneTurnsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSceneServiceClient_UpdateSceneTurns_Call) Return(_a0 *scenev1.UpdateSceneTurnsResponse, _a1 error) *MockSceneServiceClient_UpdateSceneTurns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneServiceClient_UpdateSceneTurns_Call) RunAndReturn(run func(context.Context, *scenev1.UpdateSceneTurnsRequest, ...grpc.CallOption) (*scenev1.UpdateSceneTurnsResponse, error)) *MockSceneServiceClient_UpdateSceneTurns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WatchScene provides a mock function with given fields: ctx, in, opts
 func (_m *MockSceneServiceClient) WatchScene(ctx context.Context, in *scenev1.WatchSceneRequest, opts ...grpc.CallOption) (*scenev1.WatchSceneResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -137,7 +137,8 @@ func streamClosedFrame(msg string) *corev1.SubscribeResponse {
 // location_state payloads during event streaming. Satisfied by *world.Service.
 type WorldQuerier interface {
 	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
-	GetExitsByLocation(ctx context.Context, subjectID string, locationID ulid.ULID) ([]*world.Exit, error)
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+	RenderTextRefs(ctx context.Context, subjectID, text string) (string, error)
 }
 
 // SessionStreamContributor collects plugin-contributed stream names for a session.
@@ -229,6 +230,86 @@ type CoreServer struct {
 	// the downgraded frame is already content-free (INV-SCENE-62). Set via
 	// WithSceneMuteChecker.
 	sceneMute SceneMuteChecker
+
+	// activity records command activity for idle/AFK tracking and reports
+	// AFK state into location_state presence. Nil disables both. Set via
+	// WithActivityTracker.
+	activity ActivityTracker
+
+	// connections records each Subscribe connection for last-seen and
+	// connection history. Nil disables recording. Set via
+	// WithConnectionRecorder.
+	connections ConnectionRecorder
+
+	// characterVisibility hides dark and invisible characters from presence
+	// lists and location-stream movement events. Nil hides nobody. Set via
+	// WithCharacterVisibility.
+	characterVisibility CharacterVisibilityChecker
+
+	// npcs lists non-player characters into location_state. Nil lists
+	// none. Set via WithNPCPresence.
+	npcs NPCPresence
+
+	// ignores hides speech from characters the session's character ignores
+	// or gags. Nil hides nothing. Set via WithIgnoreChecker.
+	ignores IgnoreChecker
+
+	// loginNotices sends the message of the day to a character's stream
+	// when a fresh session is created. Nil sends nothing. Set via
+	// WithLoginNotices.
+	loginNotices LoginNoticePublisher
+
+	// heldPages delivers pages that waited in a character's mailbox when a
+	// fresh session is created. Nil delivers nothing. Set via WithHeldPages.
+	heldPages HeldPageDeliverer
+
+	// clientPreferences sends a player's client preferences to a
+	// character's stream when a fresh session is created. Nil sends
+	// nothing. Set via WithClientPreferences.
+	clientPreferences ClientPreferencesPublisher
+
+	// moderation publishes input flood escalations for staff review. Nil
+	// publishes nothing. Set via WithModerationEvents.
+	moderation ModerationPublisher
+
+	// impersonationNotices and noticeBroadcaster tell a player about staff
+	// impersonations of their account when they next start a fresh session.
+	// Nil sends nothing. Set via WithImpersonationNotices.
+	impersonationNotices ImpersonationNoticeSource
+	noticeBroadcaster    NoticeBroadcaster
+
+	// identities signs players in and links identities with OIDC ID
+	// tokens. Nil answers both RPCs with "not configured". Set via
+	// WithIdentityService.
+	identities IdentityServiceProvider
+
+	// addressBans answers gateway connection checks against the ban list.
+	// Nil reports no address as banned. Set via WithAddressBans.
+	addressBans AddressBanChecker
+
+	// webSessions issues and refreshes refresh-token web sessions and
+	// manages a player's sessions. Nil leaves the web session RPCs
+	// unconfigured. Set via WithWebSessions.
+	webSessions WebSessionProvider
+
+	// impersonator opens staff impersonation sessions. Nil answers
+	// ImpersonatePlayer with "not configured". Set via WithImpersonation.
+	impersonator Impersonator
+}
+
+// ActivityTracker is the narrow idle-tracking surface CoreServer needs.
+// Satisfied by *presence.ActivityTracker.
+type ActivityTracker interface {
+	Touch(ctx context.Context, char core.CharacterRef)
+	IsAFK(charID ulid.ULID) bool
+}
+
+// ConnectionRecorder is the narrow connection-history surface CoreServer
+// needs. Both calls are best-effort and never fail the stream. Satisfied by
+// *connhistory.Service.
+type ConnectionRecorder interface {
+	RecordConnect(ctx context.Context, characterID, connectionID ulid.ULID, sessionID, clientType string, at time.Time)
+	RecordDisconnect(ctx context.Context, connectionID ulid.ULID)
 }
 
 // CoreServerOption configures a CoreServer.
@@ -348,6 +429,19 @@ func WithSceneMuteChecker(c SceneMuteChecker) CoreServerOption {
 	return func(s *CoreServer) { s.sceneMute = c }
 }
 
+// WithActivityTracker wires idle tracking: every accepted command touches
+// the session's character, and location_state presence reports AFK state.
+func WithActivityTracker(t ActivityTracker) CoreServerOption {
+	return func(s *CoreServer) { s.activity = t }
+}
+
+// WithConnectionRecorder wires connection history: every Subscribe that
+// registers a connection is recorded, and its close is recorded when the
+// stream ends.
+func WithConnectionRecorder(r ConnectionRecorder) CoreServerOption {
+	return func(s *CoreServer) { s.connections = r }
+}
+
 // NewCoreServer creates a new Core gRPC server.
 func NewCoreServer(pres *presence.Emitter, sessionStore session.Store, dispatcher *command.Dispatcher, cmdServices *command.Services, opts ...CoreServerOption) *CoreServer {
 	s := &CoreServer{
@@ -400,7 +494,9 @@ func (s *CoreServer) HandleCommand(ctx context.Context, req *corev1.HandleComman
 		"command", req.Command,
 	)
 
-	info, err := auth.ValidateSessionOwnership(
+	// An impersonation session's context carries the staff player behind
+	// it, so the command's audit records and events name both identities.
+	ctx, info, err := auth.ValidateSessionActor(
 		ctx,
 		s.playerSessionRepo,
 		s.sessionStore,
@@ -421,6 +517,14 @@ func (s *CoreServer) HandleCommand(ctx context.Context, req *corev1.HandleComman
 		}, nil
 	}
 
+	if s.activity != nil {
+		s.activity.Touch(ctx, core.CharacterRef{
+			ID:         info.CharacterID,
+			Name:       info.CharacterName,
+			LocationID: info.LocationID,
+		})
+	}
+
 	// Record command in session history (best-effort)
 	if appendErr := s.sessionStore.AppendCommand(ctx, req.SessionId, req.Command, info.MaxHistory); appendErr != nil {
 		slog.WarnContext(
@@ -439,10 +543,12 @@ func (s *CoreServer) HandleCommand(ctx context.Context, req *corev1.HandleComman
 			"command", req.Command,
 			"error", err,
 		)
+		// The raw error stays in the log above; the client gets it through
+		// the same presentation layer as command_error events.
 		return &corev1.HandleCommandResponse{
 			Meta:    responseMeta(requestID),
 			Success: false,
-			Error:   err.Error(),
+			Error:   command.PresentError(err, command.ParseErrorVerbosity(info.ErrorVerbosity)),
 		}, nil
 	}
 
@@ -543,11 +649,12 @@ func (s *CoreServer) executeViaDispatcher(ctx context.Context, info *session.Inf
 
 	if dispatchErr != nil {
 		// User-facing errors are delivered as command_response events, not
-		// RPC-level failures. Emit the player message and return nil so
-		// HandleCommand returns Success=true.
+		// RPC-level failures. Emit the message at the session's error
+		// verbosity and return nil so HandleCommand returns Success=true.
 		if isUserFacingError(dispatchErr) {
 			if buf.Len() == 0 {
-				if emitErr := s.emitCommandResponse(ctx, char, command.PlayerMessage(dispatchErr), true); emitErr != nil {
+				text := command.PresentError(dispatchErr, command.ParseErrorVerbosity(info.ErrorVerbosity))
+				if emitErr := s.emitCommandResponse(ctx, char, text, true); emitErr != nil {
 					return oops.Wrap(emitErr)
 				}
 			}
@@ -909,6 +1016,9 @@ func (s *CoreServer) Subscribe(req *corev1.SubscribeRequest, stream grpc.ServerS
 				Wrap(addErr)
 		}
 		addSpan.End()
+		if s.connections != nil {
+			s.connections.RecordConnect(ctx, info.CharacterID, connID, req.GetSessionId(), conn.ClientType, conn.ConnectedAt)
+		}
 		defer func() {
 			cleanupCtx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
 			defer cancel()
@@ -920,6 +1030,9 @@ func (s *CoreServer) Subscribe(req *corev1.SubscribeRequest, stream grpc.ServerS
 					"error", rmErr,
 				)
 			}
+			if s.connections != nil {
+				s.connections.RecordDisconnect(cleanupCtx, connID)
+			}
 		}()
 		// Per-Connection routing (INV-SCENE-24): register in the per-Connection
 		// map immediately after AddConnection so T14-T18 coordinators can
@@ -1121,6 +1234,8 @@ func (s *CoreServer) Subscribe(req *corev1.SubscribeRequest, stream grpc.ServerS
 		locStreamName: locStreamName,
 		updateFilters: s.makeFilterUpdater(busStream, filterSet),
 		verbRegistry:  s.verbRegistry,
+		activity:      s.activity,
+		npcs:          s.npcs,
 	}
 	syntheticCtx, syntheticSpan := tracer.Start(ctx, "subscribe.send_synthetic")
 	if sendErr := lf.sendSynthetic(syntheticCtx, stream); sendErr != nil {
@@ -1334,6 +1449,45 @@ func (s *CoreServer) dispatchDelivery(
 		return nil
 	}
 
+	// Dark and invisible characters: their arrivals, departures, and idle
+	// changes are dropped for observers who cannot see them. Like the floor
+	// drop above, the delivery is acked so JetStream does not redeliver it.
+	if s.hidesPresenceEvent(ctx, currentInfo.CharacterID, event) {
+		slog.DebugContext(ctx, "subscribe: dropped presence event of hidden character",
+			"session_id", info.ID, "event_id", event.ID.String(), "event_type", string(event.Type))
+		if ackErr := delivery.Ack(); ackErr != nil {
+			slog.WarnContext(ctx, "subscribe: ack failed on hidden-character drop; will redeliver",
+				"session_id", info.ID, "event_id", event.ID.String(), "error", ackErr)
+		}
+		return nil
+	}
+
+	// Ignore lists: speech from a character the observer ignores or gags
+	// is dropped here rather than left to the client, and acked like the
+	// drops above.
+	if s.ignoresEvent(ctx, currentInfo.CharacterID, event) {
+		slog.DebugContext(ctx, "subscribe: dropped event from ignored character",
+			"session_id", info.ID, "event_id", event.ID.String(), "event_type", string(event.Type))
+		if ackErr := delivery.Ack(); ackErr != nil {
+			slog.WarnContext(ctx, "subscribe: ack failed on ignored-character drop; will redeliver",
+				"session_id", info.ID, "event_id", event.ID.String(), "error", ackErr)
+		}
+		return nil
+	}
+
+	// Whisper notices: the sender, recipients, and overhearers of a
+	// whisper already have it on their character streams, so the room's
+	// "X whispers to Y." notice reaches only the rest of the room.
+	if isWhisperPartyNotice(ctx, currentInfo.CharacterID, event) {
+		slog.DebugContext(ctx, "subscribe: dropped whisper notice for a party to the whisper",
+			"session_id", info.ID, "event_id", event.ID.String())
+		if ackErr := delivery.Ack(); ackErr != nil {
+			slog.WarnContext(ctx, "subscribe: ack failed on whisper-notice drop; will redeliver",
+				"session_id", info.ID, "event_id", event.ID.String(), "error", ackErr)
+		}
+		return nil
+	}
+
 	// E9.5 badge downgrade (INV-SCENE-62): a scene event delivered to a
 	// member connection that is NOT focused on that scene becomes a
 	// content-free SCENE_ACTIVITY ping. The event content (which may be
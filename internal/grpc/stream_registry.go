@@ -5,12 +5,14 @@ package grpc
 
 import (
 	"context"
+	"log/slog"
 	"sync"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
 	"github.com/holomush/holomush/internal/grpc/focus"
+	"github.com/holomush/holomush/internal/grpc/streamrelay"
 	"github.com/holomush/holomush/internal/session"
 )
 
@@ -40,6 +42,15 @@ type SessionStreamRegistry struct {
 	// Phase 5 per-Connection routing path (D5). Co-exists with channels;
 	// session-wide Send still broadcasts via channels.
 	connections map[string]map[ulid.ULID]chan<- sessionStreamUpdate
+	// relay forwards updates to the other core replicas, whose Subscribe
+	// streams this process cannot see. Nil in single-process deployments.
+	relay StreamRelay
+}
+
+// StreamRelay forwards a session stream update to the other core replicas.
+// Satisfied by *streamrelay.NATSRelay.
+type StreamRelay interface {
+	Forward(u streamrelay.Update) error
 }
 
 // NewSessionStreamRegistry creates an empty registry.
@@ -123,8 +134,29 @@ func (r *SessionStreamRegistry) DeregisterConnection(sessionID string, connectio
 // channel. INV-SCENE-23.
 // Returns CONNECTION_NOT_REGISTERED if the conn isn't registered;
 // CONTROL_CHANNEL_FULL if the buffer is exhausted.
+//
+// With a relay set, a connection that is not registered here is assumed to
+// live on another replica: the update is forwarded and nil returned. A
+// connection ID is unique cluster-wide, so at most one replica delivers it.
 func (r *SessionStreamRegistry) SendToConnection(
 	sessionID string, connectionID ulid.ULID, update sessionStreamUpdate,
+) error {
+	err := r.sendToConnectionLocal(sessionID, connectionID, update)
+	relay := r.currentRelay()
+	if relay == nil || !isCode(err, "CONNECTION_NOT_REGISTERED") {
+		return err
+	}
+	if fwdErr := relay.Forward(toRelayUpdate(sessionID, connectionID.String(), update)); fwdErr != nil {
+		return oops.Code("SESSION_STREAM_RELAY_FAILED").
+			With("session_id", sessionID).
+			With("connection_id", connectionID.String()).
+			Wrap(fwdErr)
+	}
+	return nil
+}
+
+func (r *SessionStreamRegistry) sendToConnectionLocal(
+	sessionID string, connectionID ulid.ULID, update sessionStreamUpdate,
 ) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -157,7 +189,34 @@ func (r *SessionStreamRegistry) SendToConnection(
 // Returns SESSION_NOT_FOUND if no active Subscribe exists for the session.
 // Returns CONTROL_CHANNEL_FULL if any subscriber's channel buffer is exhausted
 // (the update is still delivered to other subscribers).
+//
+// With a relay set, the update is also forwarded to the other replicas,
+// since the same session may hold Subscribe streams on several of them. A
+// local SESSION_NOT_FOUND is then not an error: the session's streams are
+// elsewhere. If forwarding fails on a local miss the update reached no one
+// and SESSION_STREAM_RELAY_FAILED is returned; if it fails after a local
+// delivery the failure is only logged.
 func (r *SessionStreamRegistry) Send(sessionID string, update sessionStreamUpdate) error {
+	err := r.sendLocal(sessionID, update)
+	relay := r.currentRelay()
+	if relay == nil {
+		return err
+	}
+	fwdErr := relay.Forward(toRelayUpdate(sessionID, "", update))
+	if !isCode(err, "SESSION_NOT_FOUND") {
+		if fwdErr != nil {
+			slog.Warn("session stream relay forward failed",
+				"session_id", sessionID, "stream", update.stream, "error", fwdErr)
+		}
+		return err
+	}
+	if fwdErr != nil {
+		return oops.Code("SESSION_STREAM_RELAY_FAILED").With("session_id", sessionID).Wrap(fwdErr)
+	}
+	return nil
+}
+
+func (r *SessionStreamRegistry) sendLocal(sessionID string, update sessionStreamUpdate) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	subs, ok := r.channels[sessionID]
@@ -175,6 +234,59 @@ func (r *SessionStreamRegistry) Send(sessionID string, update sessionStreamUpdat
 	return lastErr
 }
 
+// SetRelay installs the cross-replica relay. Passing nil reverts to
+// local-only delivery.
+func (r *SessionStreamRegistry) SetRelay(relay StreamRelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.relay = relay
+}
+
+func (r *SessionStreamRegistry) currentRelay() StreamRelay {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.relay
+}
+
+// DeliverRelayed applies an update received from another replica to the
+// subscribers registered in this process. It never forwards again, and a
+// session or connection with no local subscriber is the expected case on
+// all but one replica, so misses are ignored.
+func (r *SessionStreamRegistry) DeliverRelayed(u streamrelay.Update) {
+	update := sessionStreamUpdate{stream: u.Stream, add: u.Add, replayMode: session.ReplayMode(u.ReplayMode)}
+	var err error
+	if u.ConnectionID == "" {
+		err = r.sendLocal(u.SessionID, update)
+	} else {
+		connectionID, parseErr := ulid.Parse(u.ConnectionID)
+		if parseErr != nil {
+			slog.Warn("dropping relayed stream update with invalid connection id",
+				"session_id", u.SessionID, "connection_id", u.ConnectionID, "error", parseErr)
+			return
+		}
+		err = r.sendToConnectionLocal(u.SessionID, connectionID, update)
+	}
+	if err != nil && !isCode(err, "SESSION_NOT_FOUND") && !isCode(err, "CONNECTION_NOT_REGISTERED") {
+		slog.Warn("relayed stream update not delivered",
+			"session_id", u.SessionID, "stream", u.Stream, "error", err)
+	}
+}
+
+func toRelayUpdate(sessionID, connectionID string, update sessionStreamUpdate) streamrelay.Update {
+	return streamrelay.Update{
+		SessionID:    sessionID,
+		ConnectionID: connectionID,
+		Stream:       update.stream,
+		Add:          update.add,
+		ReplayMode:   int(update.replayMode),
+	}
+}
+
+func isCode(err error, code string) bool {
+	oopsErr, ok := oops.AsOops(err)
+	return ok && oopsErr.Code() == code
+}
+
 // AddStream implements plugins.StreamRegistry. Subscribes a session to a stream.
 func (r *SessionStreamRegistry) AddStream(_ context.Context, sessionID, stream string) error {
 	return r.Send(sessionID, sessionStreamUpdate{stream: stream, add: true, replayMode: focus.ReplayModeFromCursor})
@@ -5,6 +5,7 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/holomush/holomush/internal/grpc/focus"
+	"github.com/holomush/holomush/internal/grpc/streamrelay"
 	"github.com/holomush/holomush/internal/session"
 	"github.com/holomush/holomush/pkg/errutil"
 )
@@ -325,3 +327,106 @@ func TestSessionStreamRegistryDeregisterConnectionGuardsByChannelIdentity(t *tes
 	require.Error(t, err)
 	errutil.AssertErrorCode(t, err, "CONNECTION_NOT_REGISTERED")
 }
+
+// recordingRelay captures forwarded updates and can be made to fail.
+type recordingRelay struct {
+	forwarded []streamrelay.Update
+	err       error
+}
+
+func (f *recordingRelay) Forward(u streamrelay.Update) error {
+	f.forwarded = append(f.forwarded, u)
+	return f.err
+}
+
+func TestSendWithRelayForwardsWhenSessionIsNotLocal(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	relay := &recordingRelay{}
+	r.SetRelay(relay)
+
+	err := r.Send("remote", sessionStreamUpdate{stream: "scene:1", add: true, replayMode: focus.ReplayModeLiveOnly})
+	require.NoError(t, err)
+	require.Len(t, relay.forwarded, 1)
+	assert.Equal(t, streamrelay.Update{
+		SessionID: "remote", Stream: "scene:1", Add: true, ReplayMode: int(focus.ReplayModeLiveOnly),
+	}, relay.forwarded[0])
+}
+
+func TestSendWithRelayDeliversLocallyAndStillForwards(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	relay := &recordingRelay{err: errors.New("nats down")}
+	r.SetRelay(relay)
+	ch := make(chan sessionStreamUpdate, 1)
+	r.Register("sess-1", ch)
+
+	// A relay failure after a local delivery is logged, not returned.
+	require.NoError(t, r.Send("sess-1", sessionStreamUpdate{stream: "channel:abc", add: true}))
+	assert.Equal(t, "channel:abc", (<-ch).stream)
+	assert.Len(t, relay.forwarded, 1)
+}
+
+func TestSendWithRelayFailureOnLocalMissReturnsRelayFailed(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	r.SetRelay(&recordingRelay{err: errors.New("nats down")})
+
+	err := r.Send("remote", sessionStreamUpdate{stream: "channel:abc", add: true})
+	errutil.AssertErrorCode(t, err, "SESSION_STREAM_RELAY_FAILED")
+}
+
+func TestSetRelayNilRestoresLocalOnlyDelivery(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	r.SetRelay(&recordingRelay{})
+	r.SetRelay(nil)
+
+	err := r.Send("remote", sessionStreamUpdate{stream: "channel:abc", add: true})
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+}
+
+func TestSendToConnectionWithRelayForwardsOnlyOnLocalMiss(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	relay := &recordingRelay{}
+	r.SetRelay(relay)
+	local := ulid.Make()
+	ch := make(chan sessionStreamUpdate, 1)
+	r.RegisterConnection("sess-1", local, ch)
+
+	require.NoError(t, r.SendToConnection("sess-1", local, sessionStreamUpdate{stream: "scene:1", add: true}))
+	assert.Empty(t, relay.forwarded, "a locally registered connection must not be relayed")
+	<-ch
+
+	remote := ulid.Make()
+	require.NoError(t, r.SendToConnection("sess-1", remote, sessionStreamUpdate{stream: "scene:2", add: false}))
+	require.Len(t, relay.forwarded, 1)
+	assert.Equal(t, remote.String(), relay.forwarded[0].ConnectionID)
+	assert.False(t, relay.forwarded[0].Add)
+
+	relay.err = errors.New("nats down")
+	err := r.SendToConnection("sess-1", ulid.Make(), sessionStreamUpdate{stream: "scene:3", add: true})
+	errutil.AssertErrorCode(t, err, "SESSION_STREAM_RELAY_FAILED")
+}
+
+func TestDeliverRelayedAppliesLocallyWithoutForwarding(t *testing.T) {
+	r := NewSessionStreamRegistry()
+	relay := &recordingRelay{}
+	r.SetRelay(relay)
+	sessionCh := make(chan sessionStreamUpdate, 1)
+	r.Register("sess-1", sessionCh)
+	connID := ulid.Make()
+	connCh := make(chan sessionStreamUpdate, 1)
+	r.RegisterConnection("sess-1", connID, connCh)
+
+	r.DeliverRelayed(streamrelay.Update{
+		SessionID: "sess-1", Stream: "channel:abc", Add: true, ReplayMode: int(focus.ReplayModeLiveOnly),
+	})
+	got := <-sessionCh
+	assert.Equal(t, sessionStreamUpdate{stream: "channel:abc", add: true, replayMode: focus.ReplayModeLiveOnly}, got)
+
+	r.DeliverRelayed(streamrelay.Update{SessionID: "sess-1", ConnectionID: connID.String(), Stream: "scene:1"})
+	assert.Equal(t, "scene:1", (<-connCh).stream)
+
+	// Misses and malformed connection IDs are dropped silently.
+	r.DeliverRelayed(streamrelay.Update{SessionID: "elsewhere", Stream: "channel:abc", Add: true})
+	r.DeliverRelayed(streamrelay.Update{SessionID: "sess-1", ConnectionID: "not-a-ulid", Stream: "scene:1"})
+	assert.Empty(t, relay.forwarded)
+	assert.Empty(t, connCh)
+}
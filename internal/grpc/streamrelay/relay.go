@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package streamrelay carries session stream control updates between core
+// replicas. A session's Subscribe stream lives in exactly one process, but
+// the plugin call or focus change that adds or removes one of its streams
+// may be handled by another; the relay broadcasts each update on the
+// cluster's internal.> subject space and every replica applies it to the
+// subscribers it holds locally.
+//
+// Delivery is fire-and-forget core NATS, like the cluster heartbeats: a
+// replica that misses an update converges when the client next resubscribes.
+package streamrelay
+
+import (
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus/natsconn"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Update is one session stream change on the wire. ConnectionID is set for
+// a per-connection update (routed to exactly that connection) and empty
+// for a session-wide one (applied to every subscriber of the session).
+type Update struct {
+	Origin       string `json:"origin"`
+	SessionID    string `json:"session_id"`
+	ConnectionID string `json:"connection_id,omitempty"`
+	Stream       string `json:"stream"`
+	Add          bool   `json:"add"`
+	ReplayMode   int    `json:"replay_mode"`
+}
+
+// Subject returns the subject every replica of clusterID publishes and
+// subscribes to.
+func Subject(clusterID string) string {
+	return "internal." + clusterID + ".session_stream"
+}
+
+// NATSRelay publishes updates to, and receives them from, the other
+// replicas of a cluster. Updates a replica publishes itself are dropped on
+// receipt: the sender already applied them locally.
+type NATSRelay struct {
+	conn    natsconn.Conn
+	subject string
+	origin  string
+
+	mu  sync.Mutex
+	sub *nats.Subscription
+}
+
+// NewNATSRelay returns a relay for clusterID over conn. Each relay gets a
+// fresh origin ID, so two relays in one process (tests) still see each
+// other's updates.
+func NewNATSRelay(conn natsconn.Conn, clusterID string) *NATSRelay {
+	return &NATSRelay{
+		conn:    conn,
+		subject: Subject(clusterID),
+		origin:  idgen.New().String(),
+	}
+}
+
+// Start subscribes to peer updates and hands each to deliver; the
+// subscription is live on the server when Start returns. deliver runs
+// on the NATS callback goroutine and MUST NOT block.
+func (r *NATSRelay) Start(deliver func(Update)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sub != nil {
+		return oops.Code("SESSION_STREAM_RELAY_ALREADY_STARTED").Errorf("relay already started")
+	}
+	sub, err := r.conn.Subscribe(r.subject, func(msg *nats.Msg) {
+		var u Update
+		if err := json.Unmarshal(msg.Data, &u); err != nil {
+			slog.Warn("streamrelay: dropping malformed update", "subject", msg.Subject, "error", err)
+			return
+		}
+		if u.Origin == r.origin {
+			return
+		}
+		deliver(u)
+	})
+	if err != nil {
+		return oops.Code("SESSION_STREAM_RELAY_SUBSCRIBE_FAILED").With("subject", r.subject).Wrap(err)
+	}
+	// Round-trip the SUB to the server so updates published by peers after
+	// Start returns are guaranteed to reach this replica.
+	if err := r.conn.Flush(); err != nil {
+		if sub != nil {
+			_ = sub.Unsubscribe()
+		}
+		return oops.Code("SESSION_STREAM_RELAY_SUBSCRIBE_FAILED").With("subject", r.subject).Wrap(err)
+	}
+	r.sub = sub
+	return nil
+}
+
+// Forward publishes u to the other replicas.
+func (r *NATSRelay) Forward(u Update) error {
+	u.Origin = r.origin
+	data, err := json.Marshal(u)
+	if err != nil {
+		return oops.Code("SESSION_STREAM_RELAY_MARSHAL_FAILED").Wrap(err)
+	}
+	if err := r.conn.Publish(r.subject, data); err != nil {
+		return oops.Code("SESSION_STREAM_RELAY_PUBLISH_FAILED").
+			With("subject", r.subject).With("session_id", u.SessionID).Wrap(err)
+	}
+	return nil
+}
+
+// Stop unsubscribes. It is safe to call on a relay that never started.
+func (r *NATSRelay) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sub == nil {
+		return nil
+	}
+	err := r.sub.Unsubscribe()
+	r.sub = nil
+	if err != nil {
+		return oops.Code("SESSION_STREAM_RELAY_UNSUBSCRIBE_FAILED").Wrap(err)
+	}
+	return nil
+}
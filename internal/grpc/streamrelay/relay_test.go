@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package streamrelay_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus/natsconn/natsmock"
+	"github.com/holomush/holomush/internal/grpc/streamrelay"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// startEmbeddedNATS boots an in-process NATS server and returns a function
+// that opens a fresh client connection to it — one per simulated replica.
+func startEmbeddedNATS(t *testing.T) func() *nats.Conn {
+	t.Helper()
+	srv, err := natsserver.NewServer(&natsserver.Options{
+		ServerName: "holomush-streamrelay-test",
+		DontListen: true,
+		NoSigs:     true,
+	})
+	require.NoError(t, err)
+	go srv.Start()
+	t.Cleanup(func() {
+		srv.Shutdown()
+		srv.WaitForShutdown()
+	})
+	require.True(t, srv.ReadyForConnections(10*time.Second), "embedded NATS never became ready")
+	return func() *nats.Conn {
+		nc, err := nats.Connect("", nats.InProcessServer(srv))
+		require.NoError(t, err)
+		t.Cleanup(nc.Close)
+		return nc
+	}
+}
+
+func TestNATSRelayDeliversToPeersButNotToSelf(t *testing.T) {
+	connect := startEmbeddedNATS(t)
+	a := streamrelay.NewNATSRelay(connect(), "game-1")
+	b := streamrelay.NewNATSRelay(connect(), "game-1")
+	other := streamrelay.NewNATSRelay(connect(), "game-2")
+
+	gotA := make(chan streamrelay.Update, 1)
+	gotB := make(chan streamrelay.Update, 1)
+	gotOther := make(chan streamrelay.Update, 1)
+	require.NoError(t, a.Start(func(u streamrelay.Update) { gotA <- u }))
+	require.NoError(t, b.Start(func(u streamrelay.Update) { gotB <- u }))
+	require.NoError(t, other.Start(func(u streamrelay.Update) { gotOther <- u }))
+	t.Cleanup(func() { _ = a.Stop(); _ = b.Stop(); _ = other.Stop() })
+
+	sent := streamrelay.Update{SessionID: "sess-1", ConnectionID: "conn-1", Stream: "scene:1", Add: true, ReplayMode: 1}
+	require.NoError(t, a.Forward(sent))
+
+	select {
+	case u := <-gotB:
+		assert.Equal(t, sent.SessionID, u.SessionID)
+		assert.Equal(t, sent.ConnectionID, u.ConnectionID)
+		assert.Equal(t, sent.Stream, u.Stream)
+		assert.True(t, u.Add)
+		assert.Equal(t, 1, u.ReplayMode)
+		assert.NotEmpty(t, u.Origin, "Forward stamps the sender's origin")
+	case <-time.After(5 * time.Second):
+		t.Fatal("peer relay never received the update")
+	}
+
+	// The sender already applied the update locally, and another cluster
+	// shares nothing with this one; neither may see it.
+	select {
+	case u := <-gotA:
+		t.Fatalf("sender received its own update: %+v", u)
+	case u := <-gotOther:
+		t.Fatalf("other cluster received the update: %+v", u)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestNATSRelayStartTwiceFails(t *testing.T) {
+	connect := startEmbeddedNATS(t)
+	r := streamrelay.NewNATSRelay(connect(), "game-1")
+	require.NoError(t, r.Start(func(streamrelay.Update) {}))
+	t.Cleanup(func() { _ = r.Stop() })
+
+	errutil.AssertErrorCode(t, r.Start(func(streamrelay.Update) {}), "SESSION_STREAM_RELAY_ALREADY_STARTED")
+}
+
+func TestNATSRelayStopIsIdempotent(t *testing.T) {
+	connect := startEmbeddedNATS(t)
+	r := streamrelay.NewNATSRelay(connect(), "game-1")
+	require.NoError(t, r.Stop(), "Stop before Start")
+	require.NoError(t, r.Start(func(streamrelay.Update) {}))
+	require.NoError(t, r.Stop())
+	require.NoError(t, r.Stop())
+}
+
+func TestNATSRelayDropsMalformedPayloads(t *testing.T) {
+	conn := &natsmock.Conn{}
+	r := streamrelay.NewNATSRelay(conn, "game-1")
+	delivered := 0
+	require.NoError(t, r.Start(func(streamrelay.Update) { delivered++ }))
+	require.Len(t, conn.Subscribed, 1)
+	assert.Equal(t, streamrelay.Subject("game-1"), conn.Subscribed[0].Subject)
+
+	conn.Subscribed[0].Handler(&nats.Msg{Subject: conn.Subscribed[0].Subject, Data: []byte("{not json")})
+	assert.Zero(t, delivered)
+}
+
+func TestNATSRelaySurfacesSubscribeAndPublishErrors(t *testing.T) {
+	boom := errors.New("boom")
+	conn := &natsmock.Conn{
+		SubscribeHook: func(string, nats.MsgHandler) (*nats.Subscription, error) { return nil, boom },
+		PublishHook:   func(string, []byte) error { return boom },
+	}
+	r := streamrelay.NewNATSRelay(conn, "game-1")
+
+	errutil.AssertErrorCode(t, r.Start(func(streamrelay.Update) {}), "SESSION_STREAM_RELAY_SUBSCRIBE_FAILED")
+	errutil.AssertErrorCode(t, r.Forward(streamrelay.Update{SessionID: "s"}), "SESSION_STREAM_RELAY_PUBLISH_FAILED")
+}
+
+func TestSubjectIsUnderTheInternalNamespace(t *testing.T) {
+	assert.Equal(t, "internal.game-1.session_stream", streamrelay.Subject("game-1"))
+}
@@ -588,3 +588,67 @@ func TestSubscribe_RegistersByConnectionID(t *testing.T) {
 	assert.True(t, registry.HasConnection(sessionID, connB),
 		"Subscribe MUST register connB via RegisterConnection")
 }
+
+// recordingConnectionRecorder captures ConnectionRecorder calls.
+type recordingConnectionRecorder struct {
+	mu           sync.Mutex
+	connected    map[ulid.ULID]ulid.ULID // connection → character
+	clientTypes  map[ulid.ULID]string
+	disconnected []ulid.ULID
+}
+
+func (r *recordingConnectionRecorder) RecordConnect(_ context.Context, characterID, connectionID ulid.ULID, _, clientType string, _ time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.connected[connectionID] = characterID
+	r.clientTypes[connectionID] = clientType
+}
+
+func (r *recordingConnectionRecorder) RecordDisconnect(_ context.Context, connectionID ulid.ULID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.disconnected = append(r.disconnected, connectionID)
+}
+
+func TestSubscribe_RecordsConnectionHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	srv, registry := setupSubscribeTestServer(t)
+	recorder := &recordingConnectionRecorder{
+		connected:   map[ulid.ULID]ulid.ULID{},
+		clientTypes: map[ulid.ULID]string{},
+	}
+	srv.connections = recorder
+	info, err := srv.sessionStore.Get(ctx, "sess-rbcid")
+	require.NoError(t, err)
+
+	connID := ulid.Make()
+	subCtx, cancelSub := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = srv.Subscribe(&corev1.SubscribeRequest{
+			SessionId:          "sess-rbcid",
+			PlayerSessionToken: testPlayerSessionToken,
+			ConnectionId:       connID.String(),
+			ClientType:         "telnet",
+		}, &fakeSubscribeStream{ctx: subCtx})
+	}()
+	waitForRegistrations(t, registry, "sess-rbcid", 1)
+
+	recorder.mu.Lock()
+	assert.Equal(t, info.CharacterID, recorder.connected[connID])
+	assert.Equal(t, "telnet", recorder.clientTypes[connID])
+	assert.Empty(t, recorder.disconnected, "connection is still open")
+	recorder.mu.Unlock()
+
+	cancelSub()
+	<-done
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	assert.Equal(t, []ulid.ULID{connID}, recorder.disconnected)
+}
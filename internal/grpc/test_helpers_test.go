@@ -68,6 +68,22 @@ func (f *fakePlayerSessionRepo) GetByTokenHash(_ context.Context, tokenHash stri
 	}, nil
 }
 
+func (f *fakePlayerSessionRepo) GetByRefreshTokenHash(_ context.Context, _ string) (*auth.PlayerSession, error) {
+	panic("fakePlayerSessionRepo: GetByRefreshTokenHash not implemented")
+}
+
+func (f *fakePlayerSessionRepo) RotateTokens(_ context.Context, _ *auth.PlayerSession, _ string) error {
+	panic("fakePlayerSessionRepo: RotateTokens not implemented")
+}
+
+func (f *fakePlayerSessionRepo) SetDeviceLabel(_ context.Context, _ ulid.ULID, _ string) error {
+	panic("fakePlayerSessionRepo: SetDeviceLabel not implemented")
+}
+
+func (f *fakePlayerSessionRepo) SetTokenHash(_ context.Context, _ ulid.ULID, _ string) error {
+	panic("fakePlayerSessionRepo: SetTokenHash not implemented")
+}
+
 func (f *fakePlayerSessionRepo) GetByID(_ context.Context, _ ulid.ULID) (*auth.PlayerSession, error) {
 	panic("fakePlayerSessionRepo: GetByID not implemented")
 }
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/auth"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// Sanitized user-facing messages for web session sign-in and refresh.
+const (
+	msgWebSessionInvalidLabel = "device label is too long or contains control characters"
+	msgWebSessionExpired      = "session expired; sign in again"
+	msgWebSessionRevoked      = "session was revoked; sign in again"
+)
+
+// WebSessionProvider defines the auth.Service methods for web sessions:
+// refresh-token sign-in and device management. Satisfied by *auth.Service.
+type WebSessionProvider interface {
+	AuthenticateWebSession(ctx context.Context, username, password string, device auth.WebDevice) (*auth.WebSessionTokens, *auth.Player, error)
+	RefreshWebSession(ctx context.Context, refreshToken string, origin auth.SecurityOrigin) (*auth.WebSessionTokens, error)
+	ListSessions(ctx context.Context, playerID ulid.ULID) ([]*auth.PlayerSession, error)
+	RevokeSession(ctx context.Context, playerID, sessionID ulid.ULID, origin auth.SecurityOrigin) error
+}
+
+// WithWebSessions wires refresh-token web sessions into
+// AuthenticateWebSession and RefreshWebSession, and routes
+// ListPlayerSessions and RevokePlayerSession through the service so revokes
+// reach the player's security log. Nil (the default) answers the web
+// session RPCs with "not configured" and manages sessions through the
+// repository alone.
+func WithWebSessions(p WebSessionProvider) CoreServerOption {
+	return func(s *CoreServer) { s.webSessions = p }
+}
+
+// AuthenticateWebSession validates credentials and starts a web session
+// holding a refresh token. Failures mirror AuthenticatePlayer.
+func (s *CoreServer) AuthenticateWebSession(ctx context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error) {
+	slog.DebugContext(ctx, "grpc: AuthenticateWebSession", "username", req.GetUsername())
+
+	if s.webSessions == nil {
+		return &corev1.AuthenticateWebSessionResponse{
+			Success:      false,
+			ErrorMessage: "authentication not configured",
+		}, nil
+	}
+
+	tokens, player, authErr := s.webSessions.AuthenticateWebSession(ctx, req.GetUsername(), req.GetPassword(), auth.WebDevice{
+		UserAgent: req.GetUserAgent(),
+		IPAddress: clientAddrFromContext(ctx),
+		Label:     req.GetDeviceLabel(),
+	})
+	if errors.Is(authErr, auth.ErrLoginBanned) {
+		return &corev1.AuthenticateWebSessionResponse{
+			Success:      false,
+			ErrorMessage: bannedLoginMessage(authErr),
+		}, nil
+	}
+	if authErr != nil {
+		msg := "invalid username or password"
+		if oopsErr, ok := oops.AsOops(authErr); ok && oopsErr.Code() == "SESSION_INVALID_DEVICE_LABEL" {
+			msg = msgWebSessionInvalidLabel
+		}
+		//nolint:nilerr // intentional: return user-facing error in response body
+		return &corev1.AuthenticateWebSessionResponse{Success: false, ErrorMessage: msg}, nil
+	}
+
+	characters, err := s.buildCharacterSummaries(ctx, player.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to build character summaries", "error", err)
+	}
+
+	var defaultCharID string
+	if player.DefaultCharacterID != nil {
+		defaultCharID = player.DefaultCharacterID.String()
+	}
+
+	return &corev1.AuthenticateWebSessionResponse{
+		Success:            true,
+		AccessToken:        tokens.AccessToken,
+		AccessTtlSeconds:   secondsUntil(tokens.AccessExpiresAt),
+		RefreshToken:       tokens.RefreshToken,
+		RefreshTtlSeconds:  secondsUntil(tokens.RefreshExpiresAt),
+		Characters:         characters,
+		DefaultCharacterId: defaultCharID,
+	}, nil
+}
+
+// RefreshWebSession trades a refresh token for a new token pair. Unknown,
+// expired, and reused refresh tokens are answered in the response body;
+// anything else is an error, so the caller can tell "sign in again" from
+// "try again".
+func (s *CoreServer) RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	if s.webSessions == nil {
+		return &corev1.RefreshWebSessionResponse{
+			Success:      false,
+			ErrorMessage: "authentication not configured",
+		}, nil
+	}
+
+	tokens, err := s.webSessions.RefreshWebSession(ctx, req.GetRefreshToken(), auth.SecurityOrigin{
+		IPAddress: clientAddrFromContext(ctx),
+		UserAgent: req.GetUserAgent(),
+	})
+	if err != nil {
+		if oopsErr, ok := oops.AsOops(err); ok {
+			switch oopsErr.Code() {
+			case "AUTH_REFRESH_INVALID":
+				return &corev1.RefreshWebSessionResponse{Success: false, ErrorMessage: msgWebSessionExpired}, nil
+			case "AUTH_REFRESH_REUSED":
+				return &corev1.RefreshWebSessionResponse{Success: false, ErrorMessage: msgWebSessionRevoked}, nil
+			}
+		}
+		return nil, oops.With("operation", "refresh web session").Wrap(err)
+	}
+
+	return &corev1.RefreshWebSessionResponse{
+		Success:           true,
+		AccessToken:       tokens.AccessToken,
+		AccessTtlSeconds:  secondsUntil(tokens.AccessExpiresAt),
+		RefreshToken:      tokens.RefreshToken,
+		RefreshTtlSeconds: secondsUntil(tokens.RefreshExpiresAt),
+	}, nil
+}
+
+// listPlayerSessions returns playerID's sessions through the web session
+// service when configured, else straight from the repository.
+func (s *CoreServer) listPlayerSessions(ctx context.Context, playerID ulid.ULID) ([]*auth.PlayerSession, error) {
+	if s.webSessions != nil {
+		sessions, err := s.webSessions.ListSessions(ctx, playerID)
+		if err != nil {
+			return nil, oops.With("player_id", playerID.String()).Wrap(err)
+		}
+		return sessions, nil
+	}
+	sessions, err := s.playerSessionRepo.ListByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, oops.Code("LIST_PLAYER_SESSIONS_FAILED").Wrap(err)
+	}
+	return sessions, nil
+}
+
+// secondsUntil is the whole seconds from now until t, never negative.
+func secondsUntil(t time.Time) int64 {
+	if d := time.Until(t); d > 0 {
+		return int64(d / time.Second)
+	}
+	return 0
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/holomush/holomush/internal/auth"
+	authmocks "github.com/holomush/holomush/internal/auth/mocks"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// stubWebSessions records the last web session call and answers with fixed
+// results.
+type stubWebSessions struct {
+	tokens   *auth.WebSessionTokens
+	player   *auth.Player
+	sessions []*auth.PlayerSession
+	err      error
+
+	device   auth.WebDevice
+	origin   auth.SecurityOrigin
+	refresh  string
+	playerID ulid.ULID
+	revoked  ulid.ULID
+}
+
+func (s *stubWebSessions) AuthenticateWebSession(_ context.Context, _, _ string, device auth.WebDevice) (*auth.WebSessionTokens, *auth.Player, error) {
+	s.device = device
+	return s.tokens, s.player, s.err
+}
+
+func (s *stubWebSessions) RefreshWebSession(_ context.Context, refreshToken string, origin auth.SecurityOrigin) (*auth.WebSessionTokens, error) {
+	s.refresh, s.origin = refreshToken, origin
+	return s.tokens, s.err
+}
+
+func (s *stubWebSessions) ListSessions(_ context.Context, playerID ulid.ULID) ([]*auth.PlayerSession, error) {
+	s.playerID = playerID
+	return s.sessions, s.err
+}
+
+func (s *stubWebSessions) RevokeSession(_ context.Context, playerID, sessionID ulid.ULID, _ auth.SecurityOrigin) error {
+	s.playerID, s.revoked = playerID, sessionID
+	return s.err
+}
+
+func TestAuthenticateWebSession(t *testing.T) {
+	charID := ulid.Make()
+	player := &auth.Player{ID: ulid.Make(), DefaultCharacterID: &charID}
+
+	t.Run("not configured", func(t *testing.T) {
+		resp, err := (&CoreServer{}).AuthenticateWebSession(context.Background(), &corev1.AuthenticateWebSessionRequest{Username: "u"})
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, "authentication not configured", resp.GetErrorMessage())
+	})
+
+	t.Run("issues both tokens and records the device", func(t *testing.T) {
+		now := time.Now()
+		ws := &stubWebSessions{player: player, tokens: &auth.WebSessionTokens{
+			AccessToken:      "access",
+			AccessExpiresAt:  now.Add(15*time.Minute + time.Second),
+			RefreshToken:     "refresh",
+			RefreshExpiresAt: now.Add(30*24*time.Hour + time.Second),
+		}}
+		s := &CoreServer{}
+		WithWebSessions(ws)(s)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(clientAddrMetadataKey, "203.0.113.7"))
+
+		resp, err := s.AuthenticateWebSession(ctx, &corev1.AuthenticateWebSessionRequest{
+			Username: "u", Password: "p", UserAgent: "Firefox", DeviceLabel: "Laptop",
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, "access", resp.GetAccessToken())
+		assert.Equal(t, int64(15*60), resp.GetAccessTtlSeconds())
+		assert.Equal(t, "refresh", resp.GetRefreshToken())
+		assert.Equal(t, int64(30*24*60*60), resp.GetRefreshTtlSeconds())
+		assert.Equal(t, charID.String(), resp.GetDefaultCharacterId())
+		assert.Equal(t, auth.WebDevice{UserAgent: "Firefox", IPAddress: "203.0.113.7", Label: "Laptop"}, ws.device)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"banned", oops.With("reason", "spam").Wrap(auth.ErrLoginBanned), "You are banned from this server: spam"},
+		{"bad label", oops.Code("SESSION_INVALID_DEVICE_LABEL").Errorf("too long"), msgWebSessionInvalidLabel},
+		{"bad credentials", oops.Code("AUTH_INVALID_CREDENTIALS").Errorf("bad"), "invalid username or password"},
+		{"unexpected", errors.New("db down"), "invalid username or password"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{}
+			WithWebSessions(&stubWebSessions{err: tt.err})(s)
+			resp, err := s.AuthenticateWebSession(context.Background(), &corev1.AuthenticateWebSessionRequest{Username: "u"})
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+}
+
+func TestRefreshWebSession(t *testing.T) {
+	t.Run("rotates the token pair", func(t *testing.T) {
+		ws := &stubWebSessions{tokens: &auth.WebSessionTokens{
+			AccessToken:     "access-2",
+			AccessExpiresAt: time.Now().Add(time.Minute + time.Second),
+			RefreshToken:    "refresh-2",
+		}}
+		s := &CoreServer{}
+		WithWebSessions(ws)(s)
+
+		resp, err := s.RefreshWebSession(context.Background(), &corev1.RefreshWebSessionRequest{
+			RefreshToken: "refresh-1", UserAgent: "Firefox",
+		})
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, "access-2", resp.GetAccessToken())
+		assert.Equal(t, int64(60), resp.GetAccessTtlSeconds())
+		assert.Equal(t, "refresh-2", resp.GetRefreshToken())
+		assert.Zero(t, resp.GetRefreshTtlSeconds(), "a past expiry MUST NOT yield a negative TTL")
+		assert.Equal(t, "refresh-1", ws.refresh)
+		assert.Equal(t, "Firefox", ws.origin.UserAgent)
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"expired", oops.Code("AUTH_REFRESH_INVALID").Errorf("unknown"), msgWebSessionExpired},
+		{"reused", oops.Code("AUTH_REFRESH_REUSED").Errorf("reused"), msgWebSessionRevoked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CoreServer{}
+			WithWebSessions(&stubWebSessions{err: tt.err})(s)
+			resp, err := s.RefreshWebSession(context.Background(), &corev1.RefreshWebSessionRequest{RefreshToken: "r"})
+			require.NoError(t, err)
+			assert.False(t, resp.GetSuccess())
+			assert.Equal(t, tt.want, resp.GetErrorMessage())
+		})
+	}
+
+	t.Run("store failure is an error", func(t *testing.T) {
+		s := &CoreServer{}
+		WithWebSessions(&stubWebSessions{err: errors.New("db down")})(s)
+		_, err := s.RefreshWebSession(context.Background(), &corev1.RefreshWebSessionRequest{RefreshToken: "r"})
+		require.Error(t, err)
+	})
+}
+
+func TestRevokePlayerSessionThroughWebSessions(t *testing.T) {
+	caller := &auth.PlayerSession{ID: ulid.Make(), PlayerID: ulid.Make(), ExpiresAt: time.Now().Add(time.Hour)}
+	targetID := ulid.Make()
+	sessionRepo := func(t *testing.T) *authmocks.MockPlayerSessionRepository {
+		repo := authmocks.NewMockPlayerSessionRepository(t)
+		repo.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken("session")).Return(caller, nil)
+		repo.EXPECT().RefreshTTL(mock.Anything, caller.ID, auth.PlayerSessionTTL).Return(nil)
+		return repo
+	}
+	req := &corev1.RevokePlayerSessionRequest{PlayerSessionToken: "session", TargetSessionId: targetID.String()}
+
+	t.Run("revokes through the service", func(t *testing.T) {
+		ws := &stubWebSessions{}
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithWebSessions(ws)(s)
+
+		resp, err := s.RevokePlayerSession(context.Background(), req)
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+		assert.Equal(t, caller.PlayerID, ws.playerID)
+		assert.Equal(t, targetID, ws.revoked)
+	})
+
+	t.Run("foreign session is not found", func(t *testing.T) {
+		s := &CoreServer{playerSessionRepo: sessionRepo(t)}
+		WithWebSessions(&stubWebSessions{err: oops.Code("SESSION_NOT_FOUND").Errorf("not found")})(s)
+
+		resp, err := s.RevokePlayerSession(context.Background(), req)
+		require.NoError(t, err)
+		assert.False(t, resp.GetSuccess())
+		assert.Equal(t, "session not found", resp.GetErrorMessage())
+	})
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/eventbus"
+)
+
+// whisperNoticeType is the unqualified type of the room notice a whisper
+// leaves behind ("X whispers to Y."), matched like ignorableEventTypes.
+const whisperNoticeType = "whisper_notice"
+
+// whisperNoticePayload is the part of a whisper notice the fan-out reads.
+// PartyIDs lists the characters who got the whisper itself: the sender and
+// the recipients. Overhearers are not listed, so they see the notice like
+// the rest of the room.
+type whisperNoticePayload struct {
+	PartyIDs []string `json:"party_ids"`
+}
+
+// isWhisperPartyNotice reports whether event is a whisper notice for a
+// whisper the observer took part in. Those characters got the whisper on
+// their own stream, so the notice is dropped for them and reaches only the
+// rest of the room. A notice that cannot be
+// decoded is delivered: it carries no whisper content.
+func isWhisperPartyNotice(ctx context.Context, observerID ulid.ULID, event eventbus.Event) bool {
+	eventType := string(event.Type)
+	if i := strings.LastIndexByte(eventType, ':'); i >= 0 {
+		eventType = eventType[i+1:]
+	}
+	if eventType != whisperNoticeType || !isLocationStream(string(event.Subject)) {
+		return false
+	}
+	var payload whisperNoticePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.DebugContext(ctx, "subscribe: whisper notice payload undecodable; delivering",
+			"event_id", event.ID.String(), "error", err)
+		return false
+	}
+	return slices.Contains(payload.PartyIDs, observerID.String())
+}
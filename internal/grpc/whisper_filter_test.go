@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/internal/eventbus"
+)
+
+func TestIsWhisperPartyNotice(t *testing.T) {
+	sender := ulid.MustParse("01H000000000000000000000C1")
+	target := ulid.MustParse("01H000000000000000000000C2")
+	bystander := ulid.MustParse("01H000000000000000000000C4")
+
+	const room = "events.test.location.01H000000000000000000000A1"
+	payload := `{"sender_name":"Alice","target_name":"Bob","party_ids":["` +
+		sender.String() + `","` + target.String() + `"],"notice":"Alice whispers to Bob."}`
+	notice := func(typ, subject, payload string) eventbus.Event {
+		return eventbus.Event{Type: eventbus.Type(typ), Subject: eventbus.Subject(subject), Payload: []byte(payload)}
+	}
+
+	cases := []struct {
+		name     string
+		observer ulid.ULID
+		event    eventbus.Event
+		want     bool
+	}{
+		{"sender", sender, notice("core-communication:whisper_notice", room, payload), true},
+		{"target", target, notice("core-communication:whisper_notice", room, payload), true},
+		{"bystander", bystander, notice("core-communication:whisper_notice", room, payload), false},
+		{"no party list", target, notice("core-communication:whisper_notice", room, `{"notice":"Alice whispers to Bob."}`), false},
+		{"undecodable payload", target, notice("core-communication:whisper_notice", room, `not json`), false},
+		{"other event type", target, notice("core-communication:say", room, payload), false},
+		{"character stream", target, notice("core-communication:whisper_notice", "events.test.character."+target.String(), payload), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isWhisperPartyNotice(context.Background(), tc.observer, tc.event))
+		})
+	}
+}
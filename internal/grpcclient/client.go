@@ -179,6 +179,69 @@ func (c *Client) AuthenticatePlayer(ctx context.Context, req *corev1.Authenticat
 	return resp, nil
 }
 
+// AuthenticateWebSession validates credentials and starts a web session with a refresh token.
+func (c *Client) AuthenticateWebSession(ctx context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error) {
+	resp, err := c.client.AuthenticateWebSession(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "AuthenticateWebSession").Wrap(err)
+	}
+	return resp, nil
+}
+
+// RefreshWebSession trades a web session's refresh token for new tokens.
+func (c *Client) RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	resp, err := c.client.RefreshWebSession(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "RefreshWebSession").Wrap(err)
+	}
+	return resp, nil
+}
+
+// AuthenticateWithOIDC signs in with an OIDC ID token and returns a player token.
+func (c *Client) AuthenticateWithOIDC(ctx context.Context, req *corev1.AuthenticateWithOIDCRequest) (*corev1.AuthenticateWithOIDCResponse, error) {
+	resp, err := c.client.AuthenticateWithOIDC(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "AuthenticateWithOIDC").Wrap(err)
+	}
+	return resp, nil
+}
+
+// LinkIdentity links an OIDC identity to the player session's account.
+func (c *Client) LinkIdentity(ctx context.Context, req *corev1.LinkIdentityRequest) (*corev1.LinkIdentityResponse, error) {
+	resp, err := c.client.LinkIdentity(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "LinkIdentity").Wrap(err)
+	}
+	return resp, nil
+}
+
+// UnlinkIdentity removes one of the player session's linked OIDC identities.
+func (c *Client) UnlinkIdentity(ctx context.Context, req *corev1.UnlinkIdentityRequest) (*corev1.UnlinkIdentityResponse, error) {
+	resp, err := c.client.UnlinkIdentity(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "UnlinkIdentity").Wrap(err)
+	}
+	return resp, nil
+}
+
+// ListIdentities lists the OIDC identities linked to the player session's account.
+func (c *Client) ListIdentities(ctx context.Context, req *corev1.ListIdentitiesRequest) (*corev1.ListIdentitiesResponse, error) {
+	resp, err := c.client.ListIdentities(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "ListIdentities").Wrap(err)
+	}
+	return resp, nil
+}
+
+// ImpersonatePlayer opens a staff impersonation session as another player.
+func (c *Client) ImpersonatePlayer(ctx context.Context, req *corev1.ImpersonatePlayerRequest) (*corev1.ImpersonatePlayerResponse, error) {
+	resp, err := c.client.ImpersonatePlayer(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "ImpersonatePlayer").Wrap(err)
+	}
+	return resp, nil
+}
+
 // SelectCharacter selects a character and creates or reattaches a game session.
 func (c *Client) SelectCharacter(ctx context.Context, req *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error) {
 	resp, err := c.client.SelectCharacter(ctx, req)
@@ -188,6 +251,15 @@ func (c *Client) SelectCharacter(ctx context.Context, req *corev1.SelectCharacte
 	return resp, nil
 }
 
+// ResumeSession redeems a reconnect token for the game session holding it.
+func (c *Client) ResumeSession(ctx context.Context, req *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error) {
+	resp, err := c.client.ResumeSession(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "ResumeSession").Wrap(err)
+	}
+	return resp, nil
+}
+
 // CreatePlayer creates a new player account.
 func (c *Client) CreatePlayer(ctx context.Context, req *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error) {
 	resp, err := c.client.CreatePlayer(ctx, req)
@@ -361,6 +433,24 @@ func (c *Client) RefreshConnection(ctx context.Context, req *corev1.RefreshConne
 	return resp, nil
 }
 
+// ReportInputFlood reports a gateway input flood escalation on a connection.
+func (c *Client) ReportInputFlood(ctx context.Context, req *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error) {
+	resp, err := c.client.ReportInputFlood(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "ReportInputFlood").Wrap(err)
+	}
+	return resp, nil
+}
+
+// CheckAddressBan reports whether a connecting client's address is banned.
+func (c *Client) CheckAddressBan(ctx context.Context, req *corev1.CheckAddressBanRequest) (*corev1.CheckAddressBanResponse, error) {
+	resp, err := c.client.CheckAddressBan(ctx, req)
+	if err != nil {
+		return nil, oops.Code("RPC_FAILED").With("method", "CheckAddressBan").Wrap(err)
+	}
+	return resp, nil
+}
+
 // GetContent retrieves a single content item by key from the content service.
 func (c *Client) GetContent(ctx context.Context, req *contentv1.GetContentRequest) (*contentv1.GetContentResponse, error) {
 	resp, err := c.contentClient.GetContent(ctx, req)
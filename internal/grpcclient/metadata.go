@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpcclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientAddrMetadataKey is the gRPC metadata key a gateway uses to tell core
+// the IP address of the end-user connection it is acting for. Core trusts it
+// only because gateways authenticate with mTLS.
+//
+// internal/grpc reads this key by literal; the two MUST stay in sync.
+const ClientAddrMetadataKey = "x-holomush-client-addr"
+
+// WithClientAddr returns ctx carrying ipAddress as outgoing client-address
+// metadata. An empty address leaves ctx unchanged.
+func WithClientAddr(ctx context.Context, ipAddress string) context.Context {
+	if ipAddress == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, ClientAddrMetadataKey, ipAddress)
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package grpcclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithClientAddr(t *testing.T) {
+	ctx := WithClientAddr(context.Background(), "203.0.113.5")
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"203.0.113.5"}, md.Get(ClientAddrMetadataKey))
+
+	bare := context.Background()
+	assert.Equal(t, bare, WithClientAddr(bare, ""))
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package health
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/lifecycle"
+)
+
+// Pinger is the connectivity probe of a database pool; *pgxpool.Pool
+// satisfies it.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Database checks that the database answers a ping. pool is called on every
+// run so a check can be registered before the pool exists; a nil pool fails
+// the check.
+func Database(pool func() Pinger) CheckFunc {
+	return func(ctx context.Context) error {
+		p := pool()
+		if p == nil {
+			return oops.Code("HEALTH_DATABASE_UNAVAILABLE").Errorf("database is not connected")
+		}
+		if err := p.Ping(ctx); err != nil {
+			return oops.Code("HEALTH_DATABASE_UNAVAILABLE").Wrap(err)
+		}
+		return nil
+	}
+}
+
+// SchemaVersionFunc reads the applied schema version and dirty flag;
+// store.SchemaVersion bound to a pool satisfies it.
+type SchemaVersionFunc func(ctx context.Context) (version uint, dirty bool, err error)
+
+// Migrations checks that the database schema is exactly at expected — the
+// same gate core startup enforces — and that no migration failed partway.
+func Migrations(current SchemaVersionFunc, expected uint) CheckFunc {
+	return func(ctx context.Context) error {
+		version, dirty, err := current(ctx)
+		if err != nil {
+			return oops.Code("HEALTH_MIGRATIONS_UNKNOWN").Wrap(err)
+		}
+		switch {
+		case dirty:
+			return oops.Code("HEALTH_MIGRATIONS_DIRTY").With("version", version).
+				Errorf("migration %d failed partway through", version)
+		case version < expected:
+			return oops.Code("HEALTH_MIGRATIONS_PENDING").With("version", version).With("expected", expected).
+				Errorf("schema is at version %d, expected %d; run 'holomush migrate up'", version, expected)
+		case version > expected:
+			return oops.Code("HEALTH_MIGRATIONS_AHEAD").With("version", version).With("expected", expected).
+				Errorf("schema is at version %d, newer than this binary's %d", version, expected)
+		}
+		return nil
+	}
+}
+
+// StatusSource reports per-subsystem health; *lifecycle.ReadinessRegistry
+// satisfies it.
+type StatusSource interface {
+	Status() map[lifecycle.SubsystemID]lifecycle.HealthStatus
+}
+
+// Subsystem checks that one subsystem has registered with src and is at a
+// servable tier (Warm or Degraded). A subsystem that has not registered yet
+// has not finished starting, so it fails.
+func Subsystem(src StatusSource, id lifecycle.SubsystemID) CheckFunc {
+	return func(context.Context) error {
+		status, ok := src.Status()[id]
+		if !ok {
+			return oops.Code("HEALTH_SUBSYSTEM_NOT_STARTED").With("subsystem", id.String()).
+				Errorf("%s has not started", id)
+		}
+		if !status.Tier.IsReady() {
+			return oops.Code("HEALTH_SUBSYSTEM_UNHEALTHY").With("subsystem", id.String()).
+				Errorf("%s is %s: %s", id, status.Tier, status.Reason)
+		}
+		return nil
+	}
+}
+
+// Subsystems checks that every subsystem registered with src is at a
+// servable tier, naming the ones that are not.
+func Subsystems(src StatusSource) CheckFunc {
+	return func(context.Context) error {
+		var unhealthy []string
+		for id, status := range src.Status() {
+			if !status.Tier.IsReady() {
+				unhealthy = append(unhealthy, id.String()+" ("+status.Tier.String()+")")
+			}
+		}
+		if len(unhealthy) > 0 {
+			slices.Sort(unhealthy)
+			return oops.Code("HEALTH_SUBSYSTEM_UNHEALTHY").
+				Errorf("not ready: %s", strings.Join(unhealthy, ", "))
+		}
+		return nil
+	}
+}
+
+// PolicyNameLister lists the names of the access policies stored in the
+// database, enabled or not.
+type PolicyNameLister interface {
+	PolicyNames(ctx context.Context) ([]string, error)
+}
+
+// PolicyBundle checks that every policy in required is stored. Server
+// startup installs the seed bundle, so a missing seed means the server has
+// never started against this database or the bundle was tampered with.
+func PolicyBundle(lister PolicyNameLister, required []string) CheckFunc {
+	return func(ctx context.Context) error {
+		names, err := lister.PolicyNames(ctx)
+		if err != nil {
+			return oops.Code("HEALTH_POLICIES_UNKNOWN").Wrap(err)
+		}
+		stored := make(map[string]bool, len(names))
+		for _, n := range names {
+			stored[n] = true
+		}
+		var missing []string
+		for _, n := range required {
+			if !stored[n] {
+				missing = append(missing, n)
+			}
+		}
+		if len(missing) > 0 {
+			return oops.Code("HEALTH_POLICIES_MISSING").With("missing", missing).
+				Errorf("%d of %d bundled policies are not installed (first: %s)", len(missing), len(required), missing[0])
+		}
+		return nil
+	}
+}
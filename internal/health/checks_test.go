@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (f pingerFunc) Ping(ctx context.Context) error { return f(ctx) }
+
+func TestDatabaseCheck(t *testing.T) {
+	ctx := context.Background()
+
+	err := Database(func() Pinger { return nil })(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_DATABASE_UNAVAILABLE")
+
+	err = Database(func() Pinger { return pingerFunc(func(context.Context) error { return errors.New("refused") }) })(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_DATABASE_UNAVAILABLE")
+
+	require.NoError(t, Database(func() Pinger { return pingerFunc(pass) })(ctx))
+}
+
+func TestMigrationsCheck(t *testing.T) {
+	schema := func(version uint, dirty bool, err error) SchemaVersionFunc {
+		return func(context.Context) (uint, bool, error) { return version, dirty, err }
+	}
+	tests := []struct {
+		name    string
+		current SchemaVersionFunc
+		code    string
+	}{
+		{"current", schema(70, false, nil), ""},
+		{"behind", schema(69, false, nil), "HEALTH_MIGRATIONS_PENDING"},
+		{"ahead", schema(71, false, nil), "HEALTH_MIGRATIONS_AHEAD"},
+		{"dirty", schema(70, true, nil), "HEALTH_MIGRATIONS_DIRTY"},
+		{"unreadable", schema(0, false, errors.New("refused")), "HEALTH_MIGRATIONS_UNKNOWN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Migrations(tt.current, 70)(context.Background())
+			if tt.code == "" {
+				require.NoError(t, err)
+				return
+			}
+			errutil.AssertErrorCode(t, err, tt.code)
+		})
+	}
+}
+
+type statusMap map[lifecycle.SubsystemID]lifecycle.HealthStatus
+
+func (m statusMap) Status() map[lifecycle.SubsystemID]lifecycle.HealthStatus { return m }
+
+func TestSubsystemCheck(t *testing.T) {
+	ctx := context.Background()
+	src := statusMap{
+		lifecycle.SubsystemABAC:    {Tier: lifecycle.HealthDegraded, Reason: "poll failed"},
+		lifecycle.SubsystemPlugins: {Tier: lifecycle.HealthDead, Reason: "not started"},
+	}
+
+	require.NoError(t, Subsystem(src, lifecycle.SubsystemABAC)(ctx), "degraded is still servable")
+
+	err := Subsystem(src, lifecycle.SubsystemPlugins)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_SUBSYSTEM_UNHEALTHY")
+	assert.Contains(t, err.Error(), "not started")
+
+	err = Subsystem(src, lifecycle.SubsystemDatabase)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_SUBSYSTEM_NOT_STARTED")
+}
+
+func TestSubsystemsCheck(t *testing.T) {
+	ctx := context.Background()
+	src := statusMap{
+		lifecycle.SubsystemABAC:    {Tier: lifecycle.HealthWarm},
+		lifecycle.SubsystemPlugins: {Tier: lifecycle.HealthStale},
+	}
+
+	err := Subsystems(src)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_SUBSYSTEM_UNHEALTHY")
+	assert.Contains(t, err.Error(), lifecycle.SubsystemPlugins.String())
+	assert.NotContains(t, err.Error(), lifecycle.SubsystemABAC.String())
+
+	require.NoError(t, Subsystems(statusMap{})(ctx))
+}
+
+type policyNames []string
+
+func (p policyNames) PolicyNames(context.Context) ([]string, error) { return p, nil }
+
+type failingPolicyNames struct{}
+
+func (failingPolicyNames) PolicyNames(context.Context) ([]string, error) {
+	return nil, errors.New("refused")
+}
+
+func TestPolicyBundleCheck(t *testing.T) {
+	ctx := context.Background()
+	required := []string{"seed:a", "seed:b"}
+
+	require.NoError(t, PolicyBundle(policyNames{"seed:a", "seed:b", "admin:extra"}, required)(ctx))
+
+	err := PolicyBundle(policyNames{"seed:a"}, required)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_POLICIES_MISSING")
+	assert.Contains(t, err.Error(), "seed:b")
+
+	err = PolicyBundle(failingPolicyNames{}, required)(ctx)
+	errutil.AssertErrorCode(t, err, "HEALTH_POLICIES_UNKNOWN")
+}
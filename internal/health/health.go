@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package health runs the dependency checks that decide whether a server is
+// ready to take traffic: database connectivity, applied migrations, plugin
+// load status, and the access policy bundle. The same Suite backs the
+// /readyz HTTP probe of a running core and the offline `holomush check`
+// command, so orchestrators and operators see the same verdict.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// DefaultCheckTimeout bounds a single check when the suite has no timeout
+// configured. A probe that hangs longer than this reports failure rather
+// than stalling the orchestrator's readiness request.
+const DefaultCheckTimeout = 5 * time.Second
+
+// CheckFunc reports whether one dependency is healthy. A nil error passes.
+type CheckFunc func(ctx context.Context) error
+
+// Check is a named dependency check.
+type Check struct {
+	Name string
+	Run  CheckFunc
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Name     string        `json:"name"`
+	OK       bool          `json:"ok"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// Report is the outcome of a suite run. Ready is true only when every check
+// passed; Checks keeps the order the checks were added in.
+type Report struct {
+	Ready  bool     `json:"ready"`
+	Checks []Result `json:"checks"`
+}
+
+// Failed returns the results of the checks that did not pass.
+func (r Report) Failed() []Result {
+	var failed []Result
+	for _, c := range r.Checks {
+		if !c.OK {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Option configures a Suite.
+type Option func(*Suite)
+
+// WithTimeout bounds each check. Non-positive values keep the default.
+func WithTimeout(d time.Duration) Option {
+	return func(s *Suite) {
+		if d > 0 {
+			s.timeout = d
+		}
+	}
+}
+
+// Suite is an ordered set of checks. It is safe for concurrent use; checks
+// added after a Run starts are picked up by the next Run.
+type Suite struct {
+	mu      sync.RWMutex
+	checks  []Check
+	timeout time.Duration
+}
+
+// NewSuite creates an empty suite.
+func NewSuite(opts ...Option) *Suite {
+	s := &Suite{timeout: DefaultCheckTimeout}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Add appends a named check. Panics on an empty name or nil func, which
+// indicates a wiring bug.
+func (s *Suite) Add(name string, fn CheckFunc) {
+	if name == "" || fn == nil {
+		panic("health: Add requires a name and a check func")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checks = append(s.checks, Check{Name: name, Run: fn})
+}
+
+// Run executes every check concurrently, each under the suite timeout, and
+// returns once all have finished or timed out. A check that ignores its
+// context is abandoned at the timeout and reported as failed; a check that
+// panics fails instead of taking down the caller.
+func (s *Suite) Run(ctx context.Context) Report {
+	s.mu.RLock()
+	checks := append([]Check(nil), s.checks...)
+	timeout := s.timeout
+	s.mu.RUnlock()
+
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = runCheck(ctx, c, timeout)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Ready: true, Checks: results}
+	for _, r := range results {
+		if !r.OK {
+			report.Ready = false
+		}
+	}
+	return report
+}
+
+func runCheck(ctx context.Context, c Check, timeout time.Duration) Result {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- oops.Code("HEALTH_CHECK_PANIC").With("check", c.Name).Errorf("check panicked: %v", r)
+			}
+		}()
+		done <- c.Run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = oops.Code("HEALTH_CHECK_TIMEOUT").With("check", c.Name).Wrap(ctx.Err())
+	}
+
+	res := Result{Name: c.Name, Duration: time.Since(start)}
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	res.OK = true
+	return res
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pass(context.Context) error { return nil }
+
+func TestSuiteRunReportsEveryCheckInOrder(t *testing.T) {
+	suite := NewSuite()
+	suite.Add("database", pass)
+	suite.Add("migrations", func(context.Context) error { return errors.New("behind") })
+	suite.Add("plugins", pass)
+
+	report := suite.Run(context.Background())
+	assert.False(t, report.Ready)
+	require.Len(t, report.Checks, 3)
+	assert.Equal(t, "database", report.Checks[0].Name)
+	assert.True(t, report.Checks[0].OK)
+	assert.Equal(t, "migrations", report.Checks[1].Name)
+	assert.False(t, report.Checks[1].OK)
+	assert.Equal(t, "behind", report.Checks[1].Error)
+	assert.True(t, report.Checks[2].OK)
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, "migrations", failed[0].Name)
+}
+
+func TestSuiteRunIsReadyWhenAllPass(t *testing.T) {
+	suite := NewSuite()
+	suite.Add("database", pass)
+
+	report := suite.Run(context.Background())
+	assert.True(t, report.Ready)
+	assert.Empty(t, report.Failed())
+
+	assert.True(t, NewSuite().Run(context.Background()).Ready, "an empty suite is vacuously ready")
+}
+
+func TestSuiteRunTimesOutHungCheck(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	suite := NewSuite(WithTimeout(20 * time.Millisecond))
+	suite.Add("hung", func(context.Context) error {
+		<-release // ignores its context
+		return nil
+	})
+
+	report := suite.Run(context.Background())
+	assert.False(t, report.Ready)
+	assert.Contains(t, report.Checks[0].Error, "deadline exceeded")
+}
+
+func TestSuiteRunRecoversPanickingCheck(t *testing.T) {
+	suite := NewSuite()
+	suite.Add("broken", func(context.Context) error { panic("boom") })
+	suite.Add("database", pass)
+
+	report := suite.Run(context.Background())
+	assert.False(t, report.Ready)
+	assert.Contains(t, report.Checks[0].Error, "boom")
+	assert.True(t, report.Checks[1].OK)
+}
+
+func TestSuiteAddRejectsIncompleteChecks(t *testing.T) {
+	suite := NewSuite()
+	assert.Panics(t, func() { suite.Add("", pass) })
+	assert.Panics(t, func() { suite.Add("database", nil) })
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Probe paths served by Register.
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// Mux is the route registration method of *http.ServeMux.
+type Mux interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+// Register mounts the liveness and readiness probes on mux.
+func Register(mux Mux, suite *Suite) {
+	mux.Handle(LivenessPath, LivenessHandler())
+	mux.Handle(ReadinessPath, ReadinessHandler(suite))
+}
+
+// LivenessHandler answers 200 while the process can serve HTTP at all. It
+// runs no checks: a failing dependency makes the server unready, not dead,
+// and restarting it would not fix the dependency.
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		//nolint:errcheck // health check write error is acceptable, client may disconnect
+		w.Write([]byte("ok\n"))
+	})
+}
+
+// ReadinessHandler runs suite on every request and answers 200 when every
+// check passes or 503 otherwise, with the Report as a JSON body.
+func ReadinessHandler(suite *Suite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := suite.Run(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		status := http.StatusOK
+		if !report.Ready {
+			status = http.StatusServiceUnavailable
+		}
+		w.WriteHeader(status)
+		//nolint:errcheck // health check write error is acceptable, client may disconnect
+		json.NewEncoder(w).Encode(report)
+	})
+}
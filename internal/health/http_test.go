@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterServesProbes(t *testing.T) {
+	healthy := true
+	suite := NewSuite()
+	suite.Add("database", func(context.Context) error {
+		if !healthy {
+			return errors.New("refused")
+		}
+		return nil
+	})
+	mux := http.NewServeMux()
+	Register(mux, suite)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rec := get(ReadinessPath)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.True(t, report.Ready)
+
+	healthy = false
+	rec = get(ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.False(t, report.Ready)
+	require.Len(t, report.Checks, 1)
+	assert.Equal(t, "refused", report.Checks[0].Error)
+
+	rec = get(LivenessPath)
+	assert.Equal(t, http.StatusOK, rec.Code, "a failing dependency does not fail liveness")
+	assert.Equal(t, "ok\n", rec.Body.String())
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// topicColumns selects a topic row with its sorted alias set; queries alias
+// help_topics as t.
+const topicColumns = `t.id, t.name, t.title, t.category, t.body, t.updated_by, t.created_at, t.updated_at,
+	COALESCE((SELECT array_agg(a.alias ORDER BY a.alias) FROM help_topic_aliases a WHERE a.topic_id = t.id), '{}')`
+
+// PostgresStore implements Repository against the help_topics and
+// help_topic_aliases tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Get returns the topic named name, or the topic carrying name as an alias.
+func (s *PostgresStore) Get(ctx context.Context, name string) (*Topic, error) {
+	row := s.pool.QueryRow(ctx, `
+		SELECT `+topicColumns+`
+		  FROM help_topics t
+		 WHERE t.name = $1
+		    OR t.id = (SELECT topic_id FROM help_topic_aliases WHERE alias = $1)
+		 ORDER BY (t.name = $1) DESC
+		 LIMIT 1
+	`, name)
+	topic, err := scanTopic(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("HELP_TOPIC_NOT_FOUND").With("topic", name).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", "get").With("topic", name).Wrap(err)
+	}
+	return topic, nil
+}
+
+// List returns the topics in category, or all topics when category is empty.
+func (s *PostgresStore) List(ctx context.Context, category string) ([]*Topic, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+topicColumns+`
+		  FROM help_topics t
+		 WHERE $1 = '' OR t.category = $1
+		 ORDER BY t.category, t.name
+	`, category)
+	if err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", "list").Wrap(err)
+	}
+	return collectTopics(rows, "list")
+}
+
+// Categories returns every category with its topic count.
+func (s *PostgresStore) Categories(ctx context.Context) ([]Category, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT category, count(*)
+		  FROM help_topics
+		 GROUP BY category
+		 ORDER BY category
+	`)
+	if err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", "categories").Wrap(err)
+	}
+	defer rows.Close()
+	var out []Category
+	for rows.Next() {
+		var c Category
+		if err := rows.Scan(&c.Name, &c.Topics); err != nil {
+			return nil, oops.Code("HELP_STORE_FAILED").With("operation", "categories").Wrap(err)
+		}
+		out = append(out, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", "categories").Wrap(err)
+	}
+	return out, nil
+}
+
+// Search ranks topics against query with websearch_to_tsquery, so players
+// can use quoted phrases and -exclusions.
+func (s *PostgresStore) Search(ctx context.Context, query string, limit int) ([]*Topic, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+topicColumns+`
+		  FROM help_topics t, websearch_to_tsquery('english', $1) q
+		 WHERE t.search_vector @@ q
+		 ORDER BY ts_rank(t.search_vector, q) DESC, t.name
+		 LIMIT $2
+	`, query, limit)
+	if err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", "search").Wrap(err)
+	}
+	return collectTopics(rows, "search")
+}
+
+// Save upserts topic by name and replaces its aliases in one transaction.
+// topic.ID and topic.CreatedAt are refreshed from the stored row.
+func (s *PostgresStore) Save(ctx context.Context, topic *Topic) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	var taken bool
+	if err := tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM help_topic_aliases a JOIN help_topics t ON t.id = a.topic_id
+			 WHERE a.alias = $1 AND t.name <> $1)
+	`, topic.Name).Scan(&taken); err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+	}
+	if taken {
+		return nameConflict(topic.Name)
+	}
+
+	var (
+		id        string
+		createdAt pgnanos.Time
+	)
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO help_topics (id, name, title, category, body, updated_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		ON CONFLICT (name) DO UPDATE
+		   SET title = EXCLUDED.title,
+		       category = EXCLUDED.category,
+		       body = EXCLUDED.body,
+		       updated_by = EXCLUDED.updated_by,
+		       updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at
+	`, topic.ID.String(), topic.Name, topic.Title, topic.Category, topic.Body, topic.UpdatedBy,
+		pgnanos.From(topic.UpdatedAt)).Scan(&id, &createdAt); err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").With("topic", topic.Name).Wrap(err)
+	}
+
+	if len(topic.Aliases) > 0 {
+		var clash string
+		err := tx.QueryRow(ctx, `
+			SELECT name FROM help_topics WHERE name = ANY($1) AND id <> $2 LIMIT 1
+		`, topic.Aliases, id).Scan(&clash)
+		if err == nil {
+			return nameConflict(clash)
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+		}
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM help_topic_aliases WHERE topic_id = $1`, id); err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+	}
+	for _, alias := range topic.Aliases {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO help_topic_aliases (alias, topic_id) VALUES ($1, $2)
+		`, alias, id); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return nameConflict(alias)
+			}
+			return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+	}
+
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "save").Wrap(err)
+	}
+	topic.ID = parsed
+	topic.CreatedAt = createdAt.Time()
+	return nil
+}
+
+// Delete removes the topic named name; its aliases cascade.
+func (s *PostgresStore) Delete(ctx context.Context, name string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM help_topics WHERE name = $1`, name)
+	if err != nil {
+		return oops.Code("HELP_STORE_FAILED").With("operation", "delete").With("topic", name).Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("HELP_TOPIC_NOT_FOUND").With("topic", name).Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+func nameConflict(name string) error {
+	return oops.Code("HELP_NAME_CONFLICT").With("name", name).Wrap(ErrNameConflict)
+}
+
+func collectTopics(rows pgx.Rows, operation string) ([]*Topic, error) {
+	defer rows.Close()
+	var out []*Topic
+	for rows.Next() {
+		topic, err := scanTopic(rows)
+		if err != nil {
+			return nil, oops.Code("HELP_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		out = append(out, topic)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("HELP_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return out, nil
+}
+
+func scanTopic(row pgx.Row) (*Topic, error) {
+	var (
+		t                    Topic
+		id                   string
+		createdAt, updatedAt pgnanos.Time
+	)
+	if err := row.Scan(&id, &t.Name, &t.Title, &t.Category, &t.Body, &t.UpdatedBy,
+		&createdAt, &updatedAt, &t.Aliases); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("id", id).Wrap(err)
+	}
+	t.ID = parsed
+	t.CreatedAt = createdAt.Time()
+	t.UpdatedAt = updatedAt.Time()
+	return &t, nil
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package help_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/help"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func saveTopic(t *testing.T, st *help.PostgresStore, topic *help.Topic) {
+	t.Helper()
+	require.NoError(t, topic.Validate())
+	if topic.ID.IsZero() {
+		topic.ID = idgen.New()
+	}
+	topic.UpdatedBy = "character:test"
+	topic.UpdatedAt = time.Now().UTC()
+	require.NoError(t, st.Save(context.Background(), topic))
+}
+
+func TestPostgresStoreRoundTrip(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := help.NewPostgresStore(pool)
+
+	_, err := st.Get(ctx, "roundtrip")
+	errutil.AssertErrorCode(t, err, "HELP_TOPIC_NOT_FOUND")
+	assert.ErrorIs(t, err, help.ErrNotFound)
+
+	topic := &help.Topic{Name: "roundtrip", Category: "rt", Body: "first body", Aliases: []string{"rt-b", "rt-a"}}
+	saveTopic(t, st, topic)
+	firstID := topic.ID
+
+	got, err := st.Get(ctx, "rt-a")
+	require.NoError(t, err)
+	assert.Equal(t, "roundtrip", got.Name)
+	assert.Equal(t, []string{"rt-a", "rt-b"}, got.Aliases)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	// Re-saving by name keeps the row identity and replaces the aliases.
+	replacement := &help.Topic{Name: "roundtrip", Category: "rt", Body: "second body", Aliases: []string{"rt-c"}}
+	saveTopic(t, st, replacement)
+	assert.Equal(t, firstID, replacement.ID)
+
+	got, err = st.Get(ctx, "roundtrip")
+	require.NoError(t, err)
+	assert.Equal(t, "second body", got.Body)
+	assert.Equal(t, []string{"rt-c"}, got.Aliases)
+	_, err = st.Get(ctx, "rt-a")
+	assert.ErrorIs(t, err, help.ErrNotFound)
+
+	list, err := st.List(ctx, "rt")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, st.Delete(ctx, "roundtrip"))
+	_, err = st.Get(ctx, "rt-c")
+	assert.ErrorIs(t, err, help.ErrNotFound)
+	errutil.AssertErrorCode(t, st.Delete(ctx, "roundtrip"), "HELP_TOPIC_NOT_FOUND")
+}
+
+func TestPostgresStoreRejectsNameConflicts(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := help.NewPostgresStore(pool)
+	saveTopic(t, st, &help.Topic{Name: "conflict one", Body: "x", Aliases: []string{"conflict alias"}})
+
+	err := st.Save(ctx, &help.Topic{ID: idgen.New(), Name: "conflict alias", Title: "t", Category: "general", Body: "y"})
+	errutil.AssertErrorCode(t, err, "HELP_NAME_CONFLICT")
+
+	err = st.Save(ctx, &help.Topic{ID: idgen.New(), Name: "conflict two", Title: "t", Category: "general", Body: "y",
+		Aliases: []string{"conflict one"}})
+	errutil.AssertErrorCode(t, err, "HELP_NAME_CONFLICT")
+	_, err = st.Get(ctx, "conflict two")
+	assert.ErrorIs(t, err, help.ErrNotFound, "failed save must roll back")
+
+	err = st.Save(ctx, &help.Topic{ID: idgen.New(), Name: "conflict three", Title: "t", Category: "general", Body: "y",
+		Aliases: []string{"conflict alias"}})
+	errutil.AssertErrorCode(t, err, "HELP_NAME_CONFLICT")
+}
+
+func TestPostgresStoreSearchAndCategories(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := help.NewPostgresStore(pool)
+	saveTopic(t, st, &help.Topic{Name: "search swords", Title: "Swords", Category: "search", Body: "Blades for fencing."})
+	saveTopic(t, st, &help.Topic{Name: "search shields", Title: "Shields", Category: "search", Body: "Blocking with a swords guard."})
+
+	got, err := st.Search(ctx, "swords", 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "search swords", got[0].Name, "title match outranks body match")
+
+	got, err = st.Search(ctx, "swords -blocking", 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	cats, err := st.Categories(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, cats, help.Category{Name: "search", Topics: 2})
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"strings"
+
+	"github.com/holomush/holomush/pkg/holo"
+)
+
+// Render formats a topic for display: a bold title, the body with its
+// %x format codes converted to ANSI by holo.Fmt.Parse, and a dim footer
+// naming the category and any aliases.
+func Render(t *Topic) holo.StyledText {
+	footer := "Category: " + t.Category
+	if len(t.Aliases) > 0 {
+		footer += " | Also: " + strings.Join(t.Aliases, ", ")
+	}
+	return holo.Fmt.Header(t.Title).
+		AppendText("\n\n").
+		Append(holo.Fmt.Parse(strings.TrimRight(t.Body, "\n"))).
+		AppendText("\n\n").
+		Append(holo.Fmt.Dim(footer))
+}
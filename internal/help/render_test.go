@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	topic := &Topic{
+		Name:     "combat",
+		Title:    "Combat Basics",
+		Category: "rules",
+		Body:     "Swing %xhfirst%xn.\n",
+		Aliases:  []string{"fight", "fighting"},
+	}
+
+	plain := Render(topic).RenderPlain()
+	assert.Equal(t, "Combat Basics\n\nSwing \x1b[1mfirst\x1b[0m.\n\nCategory: rules | Also: fight, fighting", plain)
+
+	ansi := Render(topic).RenderANSI()
+	assert.Contains(t, ansi, "\x1b[1mCombat Basics")
+}
+
+func TestRenderWithoutAliases(t *testing.T) {
+	plain := Render(&Topic{Title: "T", Category: "general", Body: "b"}).RenderPlain()
+	assert.Equal(t, "T\n\nb\n\nCategory: general", plain)
+}
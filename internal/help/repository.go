@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import "context"
+
+// Repository persists help topics.
+type Repository interface {
+	// Get returns the topic whose name or alias is name (already
+	// normalized). Returns an error wrapping ErrNotFound when none matches.
+	Get(ctx context.Context, name string) (*Topic, error)
+	// List returns the topics in category, or every topic when category is
+	// empty, ordered by category then name.
+	List(ctx context.Context, category string) ([]*Topic, error)
+	// Categories returns every category with its topic count, by name.
+	Categories(ctx context.Context) ([]Category, error)
+	// Search returns up to limit topics matching the full-text query, best
+	// match first.
+	Search(ctx context.Context, query string, limit int) ([]*Topic, error)
+	// Save creates the topic or replaces the one with the same name,
+	// including its alias set. Returns an error wrapping ErrNameConflict
+	// when the name or an alias belongs to another topic.
+	Save(ctx context.Context, topic *Topic) error
+	// Delete removes the topic named name and its aliases. Returns an error
+	// wrapping ErrNotFound when there is no such topic.
+	Delete(ctx context.Context, name string) error
+}
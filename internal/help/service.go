@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// ABAC actions checked on help:<name> before a topic is changed. The seed
+// policy seed:staff-help-edit grants both to staff.
+const (
+	ActionWrite  = "write"
+	ActionDelete = "delete"
+)
+
+// Service serves help topics to everyone and lets authorized staff edit
+// them. Reads are not access-checked: help is public. Every edit is
+// written to the structured log as an audit record.
+type Service struct {
+	repo   Repository
+	engine types.AccessPolicyEngine
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewService creates a Service. repo and engine are required; a nil logger
+// uses slog.Default().
+func NewService(repo Repository, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("help repository is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, engine: engine, logger: logger, now: time.Now}, nil
+}
+
+// Topic returns the topic whose name or alias matches name. Returns
+// HELP_TOPIC_NOT_FOUND (wrapping ErrNotFound) when there is none.
+func (s *Service) Topic(ctx context.Context, name string) (*Topic, error) {
+	name = NormalizeName(name)
+	if name == "" {
+		return nil, oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	return s.repo.Get(ctx, name)
+}
+
+// List returns the topics filed under category, or every topic when
+// category is empty.
+func (s *Service) List(ctx context.Context, category string) ([]*Topic, error) {
+	return s.repo.List(ctx, NormalizeName(category))
+}
+
+// Categories returns every category with its topic count.
+func (s *Service) Categories(ctx context.Context) ([]Category, error) {
+	return s.repo.Categories(ctx)
+}
+
+// Search returns up to limit topics matching query, best match first. A
+// limit <= 0 uses DefaultSearchLimit; limits above MaxSearchLimit are
+// capped. An empty query matches nothing.
+func (s *Service) Search(ctx context.Context, query string, limit int) ([]*Topic, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, nil
+	}
+	switch {
+	case limit <= 0:
+		limit = DefaultSearchLimit
+	case limit > MaxSearchLimit:
+		limit = MaxSearchLimit
+	}
+	return s.repo.Search(ctx, query, limit)
+}
+
+// Save creates or replaces a topic on behalf of subject. The topic is
+// validated and normalized in place; UpdatedBy and UpdatedAt are stamped
+// here. Returns HELP_ACCESS_DENIED when subject may not write the topic and
+// HELP_NAME_CONFLICT when its name or an alias belongs to another topic.
+func (s *Service) Save(ctx context.Context, subject string, topic *Topic) error {
+	if topic == nil {
+		return oops.Code("HELP_INVALID_TOPIC").Errorf("topic is nil")
+	}
+	if err := topic.Validate(); err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, topic.Name); err != nil {
+		return err
+	}
+	if topic.ID.IsZero() {
+		topic.ID = idgen.New()
+	}
+	topic.UpdatedBy = subject
+	topic.UpdatedAt = s.now().UTC()
+	if err := s.repo.Save(ctx, topic); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "help topic saved",
+		"event", "help_topic_saved",
+		"subject", subject,
+		"topic", topic.Name,
+		"category", topic.Category,
+		"aliases", topic.Aliases,
+	)
+	return nil
+}
+
+// Delete removes the topic named name (not an alias) on behalf of subject.
+func (s *Service) Delete(ctx context.Context, subject, name string) error {
+	name = NormalizeName(name)
+	if name == "" {
+		return oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	if err := s.checkAccess(ctx, subject, ActionDelete, name); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, name); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "help topic deleted",
+		"event", "help_topic_deleted",
+		"subject", subject,
+		"topic", name,
+	)
+	return nil
+}
+
+// checkAccess evaluates action on help:<name> for subject. It fails
+// closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject, action, name string) error {
+	resource := access.HelpResource(name)
+	req, err := types.NewAccessRequest(subject, action, resource, nil)
+	if err != nil {
+		return oops.Code("HELP_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("HELP_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "help edit denied",
+			"event", "help_edit_denied",
+			"subject", subject,
+			"action", action,
+			"topic", name,
+			"reason", decision.Reason(),
+		)
+		return oops.Code("HELP_ACCESS_DENIED").
+			With("topic", name).
+			Errorf("not permitted to %s help topics", action)
+	}
+	return nil
+}
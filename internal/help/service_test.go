@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository. Search matches topics whose
+// name, title, or body contains the query.
+type memRepository struct {
+	mu         sync.Mutex
+	topics     map[string]*Topic
+	lastSearch int
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{topics: map[string]*Topic{}}
+}
+
+func (m *memRepository) Get(_ context.Context, name string) (*Topic, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t, ok := m.topics[name]; ok {
+		return t, nil
+	}
+	for _, t := range m.topics {
+		for _, alias := range t.Aliases {
+			if alias == name {
+				return t, nil
+			}
+		}
+	}
+	return nil, oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(ErrNotFound)
+}
+
+func (m *memRepository) List(_ context.Context, category string) ([]*Topic, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Topic
+	for _, t := range m.topics {
+		if category == "" || t.Category == category {
+			out = append(out, t)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (m *memRepository) Categories(context.Context) ([]Category, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := map[string]int{}
+	for _, t := range m.topics {
+		counts[t.Category]++
+	}
+	out := make([]Category, 0, len(counts))
+	for name, n := range counts {
+		out = append(out, Category{Name: name, Topics: n})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (m *memRepository) Search(_ context.Context, query string, limit int) ([]*Topic, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastSearch = limit
+	var out []*Topic
+	for _, t := range m.topics {
+		if strings.Contains(t.Name+" "+t.Title+" "+t.Body, query) {
+			out = append(out, t)
+		}
+	}
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m *memRepository) Save(_ context.Context, topic *Topic) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.topics[topic.Name]; ok {
+		topic.ID = existing.ID
+	}
+	stored := *topic
+	m.topics[topic.Name] = &stored
+	return nil
+}
+
+func (m *memRepository) Delete(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.topics[name]; !ok {
+		return oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	delete(m.topics, name)
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *memRepository, *policytest.GrantEngine, *bytes.Buffer) {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	return svc, repo, engine, &logs
+}
+
+func TestNewServiceRequiresRepositoryAndEngine(t *testing.T) {
+	_, err := NewService(nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceSaveAndRead(t *testing.T) {
+	ctx := context.Background()
+	svc, _, engine, logs := newTestService(t)
+	subject := access.CharacterSubject(idgen.New().String())
+	engine.Grant(subject, ActionWrite, access.HelpResource("combat"))
+
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	topic := &Topic{Name: "Combat", Title: "Fighting", Category: "Rules", Body: "Swing first.", Aliases: []string{"Fight"}}
+	require.NoError(t, svc.Save(ctx, subject, topic))
+	assert.NotEqual(t, ulid.ULID{}, topic.ID)
+	assert.Equal(t, subject, topic.UpdatedBy)
+	assert.Equal(t, now, topic.UpdatedAt)
+	assert.Contains(t, logs.String(), `"event":"help_topic_saved"`)
+
+	got, err := svc.Topic(ctx, "  FIGHT ")
+	require.NoError(t, err)
+	assert.Equal(t, "combat", got.Name)
+	assert.Equal(t, "rules", got.Category)
+
+	list, err := svc.List(ctx, "Rules")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	cats, err := svc.Categories(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Category{{Name: "rules", Topics: 1}}, cats)
+}
+
+func TestServiceTopicNotFound(t *testing.T) {
+	svc, _, _, _ := newTestService(t)
+
+	_, err := svc.Topic(context.Background(), "nothing")
+	errutil.AssertErrorCode(t, err, "HELP_TOPIC_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = svc.Topic(context.Background(), "  ")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceSaveDeniedWithoutGrant(t *testing.T) {
+	svc, repo, _, logs := newTestService(t)
+	subject := access.CharacterSubject(idgen.New().String())
+
+	err := svc.Save(context.Background(), subject, &Topic{Name: "combat", Body: "x"})
+	errutil.AssertErrorCode(t, err, "HELP_ACCESS_DENIED")
+	assert.Empty(t, repo.topics)
+	assert.Contains(t, logs.String(), `"event":"help_edit_denied"`)
+}
+
+func TestServiceSaveValidatesBeforeAccessCheck(t *testing.T) {
+	svc, _, _, _ := newTestService(t)
+
+	err := svc.Save(context.Background(), "character:x", &Topic{Name: "combat"})
+	errutil.AssertErrorCode(t, err, "HELP_INVALID_TOPIC")
+	err = svc.Save(context.Background(), "character:x", nil)
+	errutil.AssertErrorCode(t, err, "HELP_INVALID_TOPIC")
+}
+
+func TestServiceDelete(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, engine, logs := newTestService(t)
+	subject := access.CharacterSubject(idgen.New().String())
+	repo.topics["combat"] = &Topic{Name: "combat"}
+
+	err := svc.Delete(ctx, subject, "Combat")
+	errutil.AssertErrorCode(t, err, "HELP_ACCESS_DENIED")
+
+	engine.Grant(subject, ActionDelete, access.HelpResource("combat"))
+	require.NoError(t, svc.Delete(ctx, subject, "Combat"))
+	assert.Empty(t, repo.topics)
+	assert.Contains(t, logs.String(), `"event":"help_topic_deleted"`)
+
+	err = svc.Delete(ctx, subject, "combat")
+	errutil.AssertErrorCode(t, err, "HELP_TOPIC_NOT_FOUND")
+}
+
+func TestServiceFailsClosedOnEngineError(t *testing.T) {
+	svc, err := NewService(newMemRepository(), policytest.NewErrorEngine(errors.New("engine down")), nil)
+	require.NoError(t, err)
+
+	err = svc.Save(context.Background(), "character:x", &Topic{Name: "combat", Body: "x"})
+	errutil.AssertErrorCode(t, err, "HELP_ACCESS_EVALUATION_FAILED")
+}
+
+func TestServiceSearchClampsLimit(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _, _ := newTestService(t)
+	repo.topics["combat"] = &Topic{Name: "combat", Title: "Combat", Body: "swing first."}
+
+	got, err := svc.Search(ctx, " swing ", 0)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, DefaultSearchLimit, repo.lastSearch)
+
+	_, err = svc.Search(ctx, "swing", MaxSearchLimit+1)
+	require.NoError(t, err)
+	assert.Equal(t, MaxSearchLimit, repo.lastSearch)
+
+	got, err = svc.Search(ctx, "   ", 5)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package help stores the in-game help topics players read with
+// `help <topic>` and staff edit with the helpedit command. Topics live in
+// the database, belong to a category, may be reached by aliases, and are
+// full-text searchable. Render turns a topic into holo styled text.
+package help
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Topic limits and defaults.
+const (
+	// DefaultCategory is the category of a topic saved without one.
+	DefaultCategory = "general"
+	// MaxNameLength bounds topic names, aliases, and category names.
+	MaxNameLength = 64
+	// MaxTitleLength bounds topic titles.
+	MaxTitleLength = 120
+	// MaxBodyLength bounds topic bodies in bytes.
+	MaxBodyLength = 16 * 1024
+	// MaxAliases bounds how many aliases one topic may carry.
+	MaxAliases = 16
+	// DefaultSearchLimit is the number of search results returned when the
+	// caller does not ask for a specific count.
+	DefaultSearchLimit = 10
+	// MaxSearchLimit caps search results.
+	MaxSearchLimit = 50
+)
+
+// ErrNotFound is returned when no topic has the requested name or alias.
+var ErrNotFound = errors.New("help topic not found")
+
+// ErrNameConflict is returned when saving a topic whose name or alias is
+// already taken by another topic's name or alias.
+var ErrNameConflict = errors.New("help topic name or alias already in use")
+
+// Topic is one help page.
+type Topic struct {
+	ID ulid.ULID
+	// Name is the canonical lookup key, normalized by NormalizeName.
+	Name     string
+	Title    string
+	Category string
+	// Body is the page text. It may carry %x format codes, which Render
+	// converts to ANSI.
+	Body string
+	// Aliases are alternative names that resolve to this topic, normalized
+	// and sorted.
+	Aliases   []string
+	UpdatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Category is a help category with the number of topics filed under it.
+type Category struct {
+	Name   string
+	Topics int
+}
+
+// NormalizeName lower-cases name and collapses runs of whitespace to a
+// single space, so "Combat  Basics" and "combat basics" name the same
+// topic.
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// Validate normalizes the topic's name, category, and aliases in place and
+// checks every field against the topic limits.
+func (t *Topic) Validate() error {
+	t.Name = NormalizeName(t.Name)
+	t.Category = NormalizeName(t.Category)
+	if t.Category == "" {
+		t.Category = DefaultCategory
+	}
+	t.Title = strings.TrimSpace(t.Title)
+	if t.Title == "" {
+		t.Title = t.Name
+	}
+	if err := validateName("name", t.Name); err != nil {
+		return err
+	}
+	if err := validateName("category", t.Category); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(t.Title) > MaxTitleLength {
+		return oops.Code("HELP_INVALID_TOPIC").With("field", "title").
+			Errorf("title must be at most %d characters", MaxTitleLength)
+	}
+	if strings.TrimSpace(t.Body) == "" {
+		return oops.Code("HELP_INVALID_TOPIC").With("field", "body").Errorf("body is required")
+	}
+	if len(t.Body) > MaxBodyLength {
+		return oops.Code("HELP_INVALID_TOPIC").With("field", "body").
+			Errorf("body must be at most %d bytes", MaxBodyLength)
+	}
+	aliases, err := normalizeAliases(t.Name, t.Aliases)
+	if err != nil {
+		return err
+	}
+	t.Aliases = aliases
+	return nil
+}
+
+// validateName checks a normalized name, alias, or category. The command
+// syntax splits on "=", "|" and ",", so none may appear in a name.
+func validateName(field, name string) error {
+	if name == "" {
+		return oops.Code("HELP_INVALID_TOPIC").With("field", field).Errorf("%s is required", field)
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return oops.Code("HELP_INVALID_TOPIC").With("field", field).
+			Errorf("%s must be at most %d characters", field, MaxNameLength)
+	}
+	for _, r := range name {
+		if unicode.IsControl(r) || strings.ContainsRune("=|,", r) {
+			return oops.Code("HELP_INVALID_TOPIC").With("field", field).
+				Errorf("%s %q contains a character that is not allowed", field, name)
+		}
+	}
+	return nil
+}
+
+// normalizeAliases normalizes, de-duplicates, and sorts aliases, dropping
+// any that equal the topic name.
+func normalizeAliases(name string, aliases []string) ([]string, error) {
+	seen := make(map[string]bool, len(aliases))
+	out := make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = NormalizeName(alias)
+		if alias == "" || alias == name || seen[alias] {
+			continue
+		}
+		if err := validateName("alias", alias); err != nil {
+			return nil, err
+		}
+		seen[alias] = true
+		out = append(out, alias)
+	}
+	if len(out) > MaxAliases {
+		return nil, oops.Code("HELP_INVALID_TOPIC").With("field", "aliases").
+			Errorf("a topic may have at most %d aliases", MaxAliases)
+	}
+	slices.Sort(out)
+	return out, nil
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package help
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "combat basics", NormalizeName("  Combat \t Basics "))
+	assert.Equal(t, "", NormalizeName("   "))
+}
+
+func TestTopicValidateNormalizesAndDefaults(t *testing.T) {
+	topic := &Topic{
+		Name:    "Combat  Basics",
+		Body:    "Swing first.",
+		Aliases: []string{"Fighting", "combat basics", "fighting", "attack", ""},
+	}
+	require.NoError(t, topic.Validate())
+	assert.Equal(t, "combat basics", topic.Name)
+	assert.Equal(t, "combat basics", topic.Title)
+	assert.Equal(t, DefaultCategory, topic.Category)
+	assert.Equal(t, []string{"attack", "fighting"}, topic.Aliases)
+}
+
+func TestTopicValidateRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		topic Topic
+		field string
+	}{
+		{"missing name", Topic{Body: "x"}, "name"},
+		{"long name", Topic{Name: strings.Repeat("a", MaxNameLength+1), Body: "x"}, "name"},
+		{"separator in name", Topic{Name: "a=b", Body: "x"}, "name"},
+		{"bad category", Topic{Name: "a", Category: "x|y", Body: "x"}, "category"},
+		{"long title", Topic{Name: "a", Title: strings.Repeat("t", MaxTitleLength+1), Body: "x"}, "title"},
+		{"empty body", Topic{Name: "a", Body: "  "}, "body"},
+		{"long body", Topic{Name: "a", Body: strings.Repeat("b", MaxBodyLength+1)}, "body"},
+		{"bad alias", Topic{Name: "a", Body: "x", Aliases: []string{"b,c"}}, "alias"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic := tt.topic
+			err := topic.Validate()
+			errutil.AssertErrorCode(t, err, "HELP_INVALID_TOPIC")
+			errutil.AssertErrorContext(t, err, "field", tt.field)
+		})
+	}
+}
+
+func TestTopicValidateCapsAliases(t *testing.T) {
+	topic := &Topic{Name: "a", Body: "x"}
+	for i := 0; i <= MaxAliases; i++ {
+		topic.Aliases = append(topic.Aliases, "alias "+strings.Repeat("z", i+1))
+	}
+	err := topic.Validate()
+	errutil.AssertErrorCode(t, err, "HELP_INVALID_TOPIC")
+	errutil.AssertErrorContext(t, err, "field", "aliases")
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package httpapi serves read-only HTTP JSON views of server state for the
+// admin dashboard. Every request authenticates as the character behind a
+// player session token, normally a web session's access token, plus game
+// session ID, and every view is gated by an ABAC "read" on its
+// admin_view:<name> resource, so an operator can grant individual views
+// without granting admin.
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// PathPrefix is the route prefix every admin view is served under.
+const PathPrefix = "/api/admin/v1/"
+
+// HeaderSessionID carries the game session the caller acts through. The
+// session's character is the ABAC subject, which is what lets the seeded
+// admin role policies apply.
+const HeaderSessionID = "X-Holomush-Session"
+
+// actionRead is the ABAC action every view evaluates.
+const actionRead = "read"
+
+// DenialReader reads recent audit denials. *audit.PostgresReader satisfies it.
+type DenialReader interface {
+	RecentDenials(ctx context.Context, limit int) ([]audit.Event, error)
+}
+
+// PluginLister lists loaded plugins. *plugins.Manager satisfies it.
+type PluginLister interface {
+	ListPlugins() []string
+}
+
+// ThroughputSource reports event publish throughput.
+// *eventbus.ThroughputMeter satisfies it.
+type ThroughputSource interface {
+	Snapshot() eventbus.Throughput
+}
+
+// EntityCounter reports world entity counts.
+// *postgres.EntityCounter satisfies it.
+type EntityCounter interface {
+	CountEntities(ctx context.Context) (world.EntityCounts, error)
+}
+
+// Config holds the dependencies of the admin API. PlayerSessions, Sessions,
+// and Engine are required. The remaining sources are optional: a view whose
+// source is nil responds 501 so a partially wired server still serves the
+// rest.
+type Config struct {
+	PlayerSessions auth.PlayerSessionRepository
+	Sessions       session.Store
+	Engine         types.AccessPolicyEngine
+
+	Denials    DenialReader
+	Plugins    PluginLister
+	Throughput ThroughputSource
+	Entities   EntityCounter
+}
+
+// Handler serves the admin API.
+type Handler struct {
+	cfg Config
+	mux *http.ServeMux
+}
+
+// NewHandler creates a Handler. Panics if a required dependency is nil, since
+// the API would otherwise fail open or crash on first request.
+func NewHandler(cfg Config) *Handler {
+	if cfg.PlayerSessions == nil || cfg.Sessions == nil || cfg.Engine == nil {
+		panic("httpapi.NewHandler: PlayerSessions, Sessions, and Engine are required")
+	}
+	h := &Handler{cfg: cfg, mux: http.NewServeMux()}
+	h.route("sessions", h.handleSessions)
+	h.route("denials", h.handleDenials)
+	h.route("plugins", h.handlePlugins)
+	h.route("throughput", h.handleThroughput)
+	h.route("world", h.handleWorld)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// viewFunc renders one view for an authenticated, authorized caller.
+type viewFunc func(w http.ResponseWriter, r *http.Request)
+
+// route registers view under PathPrefix+name behind authentication and the
+// per-view ABAC gate.
+func (h *Handler) route(name string, view viewFunc) {
+	resource := access.AdminViewResource(name)
+	h.mux.HandleFunc("GET "+PathPrefix+name, func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		subject, ok := h.authenticate(w, r)
+		if !ok {
+			return
+		}
+		if !h.authorize(ctx, w, subject, resource) {
+			return
+		}
+		view(w, r)
+	})
+}
+
+// authenticate resolves the request's ABAC subject. Every failure is a 401
+// with the same body so callers cannot probe which half of the credential
+// was wrong.
+func (h *Handler) authenticate(w http.ResponseWriter, r *http.Request) (string, bool) {
+	ctx := r.Context()
+	token := bearerToken(r)
+	sessionID := r.Header.Get(HeaderSessionID)
+	if token == "" || sessionID == "" {
+		writeError(w, http.StatusUnauthorized, "unauthenticated", "session token and session id are required")
+		return "", false
+	}
+
+	info, err := auth.ValidateSessionOwnership(ctx, h.cfg.PlayerSessions, h.cfg.Sessions, token, sessionID)
+	if err != nil {
+		slog.DebugContext(ctx, "httpapi: authentication failed", "session_id", sessionID, "error", err)
+		writeError(w, http.StatusUnauthorized, "unauthenticated", "invalid session")
+		return "", false
+	}
+	return access.CharacterSubject(info.CharacterID.String()), true
+}
+
+// authorize evaluates read on resource for subject. Engine errors fail
+// closed with 503 rather than 403 so the dashboard can distinguish an
+// outage from a missing grant.
+func (h *Handler) authorize(ctx context.Context, w http.ResponseWriter, subject, resource string) bool {
+	req, err := types.NewAccessRequest(subject, actionRead, resource, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "httpapi: build access request failed", "resource", resource, "error", err)
+		writeError(w, http.StatusInternalServerError, "internal", "internal error")
+		return false
+	}
+	decision, err := h.cfg.Engine.Evaluate(ctx, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "httpapi: access evaluation failed",
+			"subject", subject, "resource", resource, "error", err)
+		writeError(w, http.StatusServiceUnavailable, "access_unavailable", "access evaluation failed")
+		return false
+	}
+	if !decision.IsAllowed() {
+		writeError(w, http.StatusForbidden, "forbidden", "not permitted to read this view")
+		return false
+	}
+	return true
+}
+
+// bearerToken extracts the player session token from the Authorization
+// header.
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	h := r.Header.Get("Authorization")
+	if len(h) <= len(prefix) || !strings.EqualFold(h[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(h[len(prefix):])
+}
+
+// errorBody is the JSON shape of every error response.
+type errorBody struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	var body errorBody
+	body.Error.Code = code
+	body.Error.Message = message
+	writeJSON(w, status, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	//nolint:errcheck // response write error is acceptable, client may disconnect
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/auth/mocks"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/httpapi"
+	"github.com/holomush/holomush/internal/session"
+	sessionmocks "github.com/holomush/holomush/internal/session/mocks"
+	"github.com/holomush/holomush/internal/world"
+)
+
+const (
+	testToken     = "tok-admin"
+	testSessionID = "sess-admin"
+)
+
+var (
+	testPlayerID = ulid.MustParseStrict("01HZZZZZZZZZZZZZZZZZZZZZZZ")
+	testCharID   = ulid.MustParseStrict("01J00000000000000000CHARAA")
+)
+
+type fakeDenials struct {
+	events []audit.Event
+	limit  int
+	err    error
+}
+
+func (f *fakeDenials) RecentDenials(_ context.Context, limit int) ([]audit.Event, error) {
+	f.limit = limit
+	return f.events, f.err
+}
+
+type fakePlugins []string
+
+func (f fakePlugins) ListPlugins() []string { return f }
+
+type fakeThroughput eventbus.Throughput
+
+func (f fakeThroughput) Snapshot() eventbus.Throughput { return eventbus.Throughput(f) }
+
+type fakeEntities struct {
+	counts world.EntityCounts
+	err    error
+}
+
+func (f fakeEntities) CountEntities(_ context.Context) (world.EntityCounts, error) {
+	return f.counts, f.err
+}
+
+// newAuthedStores returns mocks under which testToken + testSessionID
+// resolve to testCharID.
+func newAuthedStores(t *testing.T) (*mocks.MockPlayerSessionRepository, *sessionmocks.MockStore) {
+	t.Helper()
+	players := mocks.NewMockPlayerSessionRepository(t)
+	store := sessionmocks.NewMockStore(t)
+
+	ps, err := auth.NewPlayerSession(testPlayerID, auth.HashSessionToken(testToken), "", "", time.Hour)
+	require.NoError(t, err)
+	players.EXPECT().GetByTokenHash(mock.Anything, auth.HashSessionToken(testToken)).Return(ps, nil).Maybe()
+	store.EXPECT().Get(mock.Anything, testSessionID).Return(&session.Info{
+		ID:          testSessionID,
+		CharacterID: testCharID,
+		PlayerID:    testPlayerID,
+	}, nil).Maybe()
+	return players, store
+}
+
+func newTestHandler(t *testing.T, engine types.AccessPolicyEngine, mutate func(*httpapi.Config)) (*httpapi.Handler, *sessionmocks.MockStore) {
+	t.Helper()
+	players, store := newAuthedStores(t)
+	cfg := httpapi.Config{PlayerSessions: players, Sessions: store, Engine: engine}
+	if mutate != nil {
+		mutate(&cfg)
+	}
+	return httpapi.NewHandler(cfg), store
+}
+
+func get(t *testing.T, h http.Handler, view string, authed bool) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, httpapi.PathPrefix+view, nil)
+	if authed {
+		req.Header.Set("Authorization", "Bearer "+testToken)
+		req.Header.Set(httpapi.HeaderSessionID, testSessionID)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) map[string]json.RawMessage {
+	t.Helper()
+	var body map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Error.Code
+}
+
+func TestNewHandlerPanicsWithoutRequiredDependencies(t *testing.T) {
+	assert.Panics(t, func() { httpapi.NewHandler(httpapi.Config{}) })
+}
+
+func TestHandlerRejectsRequestWithoutCredentials(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), nil)
+
+	rec := get(t, h, "sessions", false)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "unauthenticated", errorCode(t, rec))
+}
+
+func TestHandlerRejectsUnknownToken(t *testing.T) {
+	players := mocks.NewMockPlayerSessionRepository(t)
+	players.EXPECT().GetByTokenHash(mock.Anything, mock.Anything).Return(nil, auth.ErrNotFound)
+	h := httpapi.NewHandler(httpapi.Config{
+		PlayerSessions: players,
+		Sessions:       sessionmocks.NewMockStore(t),
+		Engine:         policytest.AllowAllEngine(),
+	})
+
+	rec := get(t, h, "sessions", true)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandlerDeniesViewWithoutGrant(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.DenyAllEngine(), nil)
+
+	rec := get(t, h, "sessions", true)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "forbidden", errorCode(t, rec))
+}
+
+func TestHandlerGatesEachViewSeparately(t *testing.T) {
+	engine := policytest.NewGrantEngine()
+	engine.Grant(access.CharacterSubject(testCharID.String()), "read", access.AdminViewResource("plugins"))
+	h, _ := newTestHandler(t, engine, func(cfg *httpapi.Config) {
+		cfg.Plugins = fakePlugins{"core-communication"}
+		cfg.Throughput = fakeThroughput{}
+	})
+
+	assert.Equal(t, http.StatusOK, get(t, h, "plugins", true).Code)
+	assert.Equal(t, http.StatusForbidden, get(t, h, "throughput", true).Code)
+}
+
+func TestHandlerFailsClosedOnEngineError(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.NewErrorEngine(errors.New("policy store down")), nil)
+
+	rec := get(t, h, "sessions", true)
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "access_unavailable", errorCode(t, rec))
+}
+
+func TestHandlerRejectsNonGetMethods(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), nil)
+
+	req := httptest.NewRequest(http.MethodPost, httpapi.PathPrefix+"sessions", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestSessionsViewListsActiveSessions(t *testing.T) {
+	h, store := newTestHandler(t, policytest.AllowAllEngine(), nil)
+	store.EXPECT().ListActive(mock.Anything).Return([]*session.Info{{
+		ID:             testSessionID,
+		CharacterID:    testCharID,
+		CharacterName:  "Alyssa",
+		Status:         session.StatusActive,
+		CommandHistory: []string{"say secret"},
+	}}, nil)
+
+	rec := get(t, h, "sessions", true)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"character_name":"Alyssa"`)
+	assert.NotContains(t, rec.Body.String(), "say secret", "command history must not leak")
+}
+
+func TestSessionsViewReportsStoreFailure(t *testing.T) {
+	h, store := newTestHandler(t, policytest.AllowAllEngine(), nil)
+	store.EXPECT().ListActive(mock.Anything).Return(nil, errors.New("db down"))
+
+	rec := get(t, h, "sessions", true)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestDenialsViewUsesLimitParameter(t *testing.T) {
+	denials := &fakeDenials{events: []audit.Event{{
+		Subject:    "character:01ABC",
+		Action:     "read",
+		Resource:   "location:01XYZ",
+		Effect:     types.EffectDeny,
+		Attributes: map[string]any{"secret": "value"},
+	}}}
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) { cfg.Denials = denials })
+
+	rec := get(t, h, "denials?limit=5", true)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 5, denials.limit)
+	assert.Contains(t, rec.Body.String(), `"effect":"deny"`)
+	assert.NotContains(t, rec.Body.String(), "secret", "attributes must not leak")
+}
+
+func TestDenialsViewRejectsInvalidLimit(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) { cfg.Denials = &fakeDenials{} })
+
+	for _, limit := range []string{"0", "abc", "100000"} {
+		rec := get(t, h, "denials?limit="+limit, true)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, limit)
+	}
+}
+
+func TestDenialsViewDefaultsLimit(t *testing.T) {
+	denials := &fakeDenials{}
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) { cfg.Denials = denials })
+
+	require.Equal(t, http.StatusOK, get(t, h, "denials", true).Code)
+	assert.Equal(t, 50, denials.limit)
+}
+
+func TestUnconfiguredViewReportsNotImplemented(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), nil)
+
+	for _, view := range []string{"denials", "plugins", "throughput", "world"} {
+		rec := get(t, h, view, true)
+		assert.Equal(t, http.StatusNotImplemented, rec.Code, view)
+		assert.Equal(t, "not_configured", errorCode(t, rec), view)
+	}
+}
+
+func TestPluginsViewListsLoadedPlugins(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) {
+		cfg.Plugins = fakePlugins{"core-communication", "core-scenes"}
+	})
+
+	rec := get(t, h, "plugins", true)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var plugins []map[string]string
+	require.NoError(t, json.Unmarshal(decode(t, rec)["plugins"], &plugins))
+	require.Len(t, plugins, 2)
+	assert.Equal(t, "core-scenes", plugins[1]["name"])
+	assert.Equal(t, "loaded", plugins[1]["status"])
+}
+
+func TestThroughputViewReportsSnapshot(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) {
+		cfg.Throughput = fakeThroughput{Window: time.Minute, Events: 120, PerSecond: 2}
+	})
+
+	rec := get(t, h, "throughput", true)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got eventbus.Throughput
+	require.NoError(t, json.Unmarshal(decode(t, rec)["throughput"], &got))
+	assert.Equal(t, uint64(120), got.Events)
+	assert.InDelta(t, 2.0, got.PerSecond, 0.0001)
+}
+
+func TestWorldViewReportsEntityCounts(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) {
+		cfg.Entities = fakeEntities{counts: world.EntityCounts{Locations: 12, Characters: 3}}
+	})
+
+	rec := get(t, h, "world", true)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var got world.EntityCounts
+	require.NoError(t, json.Unmarshal(decode(t, rec)["counts"], &got))
+	assert.Equal(t, int64(12), got.Locations)
+	assert.Equal(t, int64(3), got.Characters)
+}
+
+func TestWorldViewReportsCounterFailure(t *testing.T) {
+	h, _ := newTestHandler(t, policytest.AllowAllEngine(), func(cfg *httpapi.Config) {
+		cfg.Entities = fakeEntities{err: errors.New("db down")}
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, get(t, h, "world", true).Code)
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package httpapi
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/holomush/holomush/internal/audit"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// defaultDenialLimit is the number of denials returned without ?limit.
+const defaultDenialLimit = 50
+
+// sessionView is one connected session. Command history and last
+// page/whisper targets are deliberately omitted: they are player content,
+// not operational state.
+type sessionView struct {
+	ID            string     `json:"id"`
+	CharacterID   string     `json:"character_id"`
+	CharacterName string     `json:"character_name"`
+	PlayerID      string     `json:"player_id"`
+	LocationID    string     `json:"location_id"`
+	Status        string     `json:"status"`
+	IsGuest       bool       `json:"is_guest"`
+	GridPresent   bool       `json:"grid_present"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DetachedAt    *time.Time `json:"detached_at,omitempty"`
+}
+
+// denialView is one audit denial. Attributes are omitted: they can carry
+// resolved subject/resource attributes the dashboard has no need for.
+type denialView struct {
+	Timestamp time.Time `json:"timestamp"`
+	Subject   string    `json:"subject"`
+	Action    string    `json:"action"`
+	Resource  string    `json:"resource"`
+	Effect    string    `json:"effect"`
+	EventID   string    `json:"event_id"`
+	EventName string    `json:"event_name"`
+	Message   string    `json:"message"`
+	Source    string    `json:"source"`
+	Component string    `json:"component"`
+}
+
+// pluginView is one loaded plugin.
+type pluginView struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+func (h *Handler) handleSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	infos, err := h.cfg.Sessions.ListActive(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "httpapi: list active sessions failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "internal", "failed to list sessions")
+		return
+	}
+	out := make([]sessionView, 0, len(infos))
+	for _, info := range infos {
+		out = append(out, sessionView{
+			ID:            info.ID,
+			CharacterID:   info.CharacterID.String(),
+			CharacterName: info.CharacterName,
+			PlayerID:      info.PlayerID.String(),
+			LocationID:    info.LocationID.String(),
+			Status:        string(info.Status),
+			IsGuest:       info.IsGuest,
+			GridPresent:   info.GridPresent,
+			CreatedAt:     info.CreatedAt,
+			DetachedAt:    info.DetachedAt,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"sessions": out})
+}
+
+func (h *Handler) handleDenials(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.cfg.Denials == nil {
+		writeNotConfigured(w, "denials")
+		return
+	}
+	limit := defaultDenialLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 || n > audit.MaxRecentDenials {
+			writeError(w, http.StatusBadRequest, "invalid_limit",
+				"limit must be an integer between 1 and "+strconv.Itoa(audit.MaxRecentDenials))
+			return
+		}
+		limit = n
+	}
+
+	events, err := h.cfg.Denials.RecentDenials(ctx, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "httpapi: read recent denials failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "internal", "failed to read denials")
+		return
+	}
+	out := make([]denialView, 0, len(events))
+	for i := range events {
+		e := &events[i]
+		out = append(out, denialView{
+			Timestamp: e.Timestamp,
+			Subject:   e.Subject,
+			Action:    e.Action,
+			Resource:  e.Resource,
+			Effect:    e.Effect.String(),
+			EventID:   e.ID,
+			EventName: e.Name,
+			Message:   e.Message,
+			Source:    string(e.Source),
+			Component: e.Component,
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"denials": out})
+}
+
+func (h *Handler) handlePlugins(w http.ResponseWriter, _ *http.Request) {
+	if h.cfg.Plugins == nil {
+		writeNotConfigured(w, "plugins")
+		return
+	}
+	names := h.cfg.Plugins.ListPlugins()
+	out := make([]pluginView, 0, len(names))
+	for _, name := range names {
+		out = append(out, pluginView{Name: name, Status: "loaded"})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"plugins": out})
+}
+
+func (h *Handler) handleThroughput(w http.ResponseWriter, _ *http.Request) {
+	if h.cfg.Throughput == nil {
+		writeNotConfigured(w, "throughput")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]eventbus.Throughput{"throughput": h.cfg.Throughput.Snapshot()})
+}
+
+func (h *Handler) handleWorld(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if h.cfg.Entities == nil {
+		writeNotConfigured(w, "world")
+		return
+	}
+	counts, err := h.cfg.Entities.CountEntities(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "httpapi: count world entities failed", "error", err)
+		writeError(w, http.StatusInternalServerError, "internal", "failed to count world entities")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]world.EntityCounts{"counts": counts})
+}
+
+func writeNotConfigured(w http.ResponseWriter, view string) {
+	writeError(w, http.StatusNotImplemented, "not_configured", "view "+view+" is not configured on this server")
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// MaxParamsLength bounds the JSON params a job is submitted with.
+const MaxParamsLength = 16 * 1024
+
+// MaxResultLength bounds the stored result and error text of a job. Longer
+// outcomes are truncated; a handler producing bulk output should write it
+// somewhere durable and return a pointer to it.
+const MaxResultLength = 16 * 1024
+
+// MaxProgressMessageLength bounds the message attached to a progress report.
+const MaxProgressMessageLength = 256
+
+// kindPattern restricts job kinds to short identifiers staff can type.
+var kindPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// Status is where a job is in its lifecycle.
+type Status string
+
+const (
+	// StatusQueued jobs wait for a worker.
+	StatusQueued Status = "queued"
+	// StatusRunning jobs are held by a worker.
+	StatusRunning Status = "running"
+	// StatusSucceeded jobs finished without error.
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed jobs returned an error, panicked, or were abandoned by
+	// their worker too many times.
+	StatusFailed Status = "failed"
+	// StatusCancelled jobs were cancelled before or while running.
+	StatusCancelled Status = "cancelled"
+)
+
+// Terminal reports whether s is a final status.
+func (s Status) Terminal() bool {
+	return s == StatusSucceeded || s == StatusFailed || s == StatusCancelled
+}
+
+// Job is one submitted run of a registered kind.
+type Job struct {
+	ID     ulid.ULID
+	Kind   string
+	Params json.RawMessage
+	Status Status
+	// Progress is the last reported completion percentage, 0-100.
+	Progress int
+	// ProgressMessage is the message of the last progress report.
+	ProgressMessage string
+	// Result is the handler's summary of a succeeded job.
+	Result string
+	// Error explains a failed job.
+	Error string
+	// CancelRequested is set once Cancel has been called on a running job;
+	// the worker stops the handler at its next heartbeat.
+	CancelRequested bool
+	// Attempts counts how many times a worker has claimed the job.
+	Attempts int
+	// SubmittedBy is the subject that submitted the job, e.g.
+	// "character:<id>". Character submitters are sent a job_finished event.
+	SubmittedBy string
+	CreatedAt   time.Time
+	StartedAt   *time.Time
+	HeartbeatAt *time.Time
+	FinishedAt  *time.Time
+}
+
+// SubmitRequest describes a job to submit.
+type SubmitRequest struct {
+	// Kind names the registered handler that runs the job.
+	Kind string
+	// Params is the JSON object handed to the handler. Empty means {}.
+	Params json.RawMessage
+	// SubmittedBy identifies the submitter; see Job.SubmittedBy.
+	SubmittedBy string
+}
+
+// normalize validates the request and returns its params in canonical
+// form. Errors carry oops code JOB_INVALID.
+func (r SubmitRequest) normalize() (json.RawMessage, error) {
+	if !kindPattern.MatchString(r.Kind) {
+		return nil, oops.Code("JOB_INVALID").
+			With("kind", r.Kind).
+			Errorf("job kind must be 1-64 lowercase letters, digits or '_', starting with a letter")
+	}
+	params := json.RawMessage(strings.TrimSpace(string(r.Params)))
+	if len(params) == 0 {
+		return json.RawMessage(`{}`), nil
+	}
+	if len(params) > MaxParamsLength {
+		return nil, oops.Code("JOB_INVALID").
+			With("kind", r.Kind).
+			With("length", len(params)).
+			Errorf("job params must be at most %d bytes", MaxParamsLength)
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(params, &obj); err != nil || obj == nil {
+		return nil, oops.Code("JOB_INVALID").
+			With("kind", r.Kind).
+			Errorf("job params must be a JSON object")
+	}
+	return params, nil
+}
+
+// Progress lets a running handler report how far along it is.
+type Progress interface {
+	// Report records percent complete (clamped to 0-100) and a short
+	// message. Reports are cheap: only the latest is persisted, with the
+	// worker's next heartbeat.
+	Report(percent int, message string)
+}
+
+// Handler runs jobs of one kind.
+//
+// Run MUST return promptly once ctx is done: the context is cancelled when
+// the job is cancelled, when another worker has taken it over, and when the
+// server shuts down. A job interrupted by shutdown stays running and is
+// claimed again by a live replica, so handlers should be safe to re-run.
+type Handler interface {
+	Run(ctx context.Context, job Job, progress Progress) (result string, err error)
+}
+
+// HandlerFunc adapts a function to Handler.
+type HandlerFunc func(ctx context.Context, job Job, progress Progress) (string, error)
+
+// Run calls f.
+func (f HandlerFunc) Run(ctx context.Context, job Job, progress Progress) (string, error) {
+	return f(ctx, job, progress)
+}
+
+// ParamsValidator is implemented by handlers that check their params at
+// submission time, so a malformed request is rejected before it is queued.
+type ParamsValidator interface {
+	ValidateParams(params json.RawMessage) error
+}
+
+// reporter is the Progress handed to a running handler.
+type reporter struct {
+	mu      sync.Mutex
+	percent int
+	message string
+}
+
+func (r *reporter) Report(percent int, message string) {
+	percent = min(max(percent, 0), 100)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.percent = percent
+	r.message = truncate(message, MaxProgressMessageLength)
+}
+
+func (r *reporter) snapshot() (int, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.percent, r.message
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const jobColumns = `id, kind, params, status, progress, progress_message, result, error,
+	cancel_requested, attempts, submitted_by, created_at, started_at, heartbeat_at, finished_at`
+
+// PostgresStore implements Store against the jobs table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts a queued job.
+func (s *PostgresStore) Create(ctx context.Context, job Job) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO jobs (id, kind, params, status, submitted_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, job.ID[:], job.Kind, []byte(job.Params), string(job.Status), job.SubmittedBy,
+		pgnanos.From(job.CreatedAt))
+	if err != nil {
+		return oops.Code("JOB_STORE_FAILED").
+			With("operation", "create").
+			With("kind", job.Kind).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Get returns the job with id.
+func (s *PostgresStore) Get(ctx context.Context, id ulid.ULID) (Job, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+jobColumns+` FROM jobs WHERE id = $1`, id[:])
+	job, err := scanJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Job{}, errNotFound(id)
+	}
+	if err != nil {
+		return Job{}, oops.Code("JOB_STORE_FAILED").
+			With("operation", "get").
+			With("job_id", id.String()).
+			Wrap(err)
+	}
+	return job, nil
+}
+
+// List returns jobs matching filter, newest first.
+func (s *PostgresStore) List(ctx context.Context, filter ListFilter) ([]Job, error) {
+	statuses := make([]string, len(filter.Statuses))
+	for i, st := range filter.Statuses {
+		statuses[i] = string(st)
+	}
+	return s.query(ctx, "list", `
+		SELECT `+jobColumns+`
+		  FROM jobs
+		 WHERE cardinality($1::text[]) = 0 OR status = ANY($1)
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $2
+	`, statuses, filter.Limit)
+}
+
+// Claim marks the oldest claimable job running and returns it. SKIP LOCKED
+// lets concurrent workers on every replica claim distinct jobs without
+// waiting on each other.
+func (s *PostgresStore) Claim(ctx context.Context, kinds []string, now, staleBefore time.Time, maxAttempts int) (Job, bool, error) {
+	row := s.pool.QueryRow(ctx, `
+		UPDATE jobs
+		   SET status = 'running', attempts = attempts + 1,
+		       started_at = $2, heartbeat_at = $2
+		 WHERE id = (
+		       SELECT id FROM jobs
+		        WHERE kind = ANY($1)
+		          AND (status = 'queued'
+		               OR (status = 'running' AND heartbeat_at < $3
+		                   AND attempts < $4 AND NOT cancel_requested))
+		        ORDER BY created_at, id
+		        LIMIT 1
+		          FOR UPDATE SKIP LOCKED)
+		RETURNING `+jobColumns,
+		kinds, pgnanos.From(now), pgnanos.From(staleBefore), maxAttempts)
+	job, err := scanJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Job{}, false, nil
+	}
+	if err != nil {
+		return Job{}, false, oops.Code("JOB_STORE_FAILED").With("operation", "claim").Wrap(err)
+	}
+	return job, true, nil
+}
+
+// Heartbeat stamps now and the given progress on job while this worker
+// still holds it.
+func (s *PostgresStore) Heartbeat(ctx context.Context, job Job, progress int, message string, now time.Time) (bool, bool, error) {
+	var cancelRequested bool
+	err := s.pool.QueryRow(ctx, `
+		UPDATE jobs
+		   SET heartbeat_at = $1, progress = $2, progress_message = $3
+		 WHERE id = $4 AND status = 'running' AND attempts = $5
+		RETURNING cancel_requested
+	`, pgnanos.From(now), progress, message, job.ID[:], job.Attempts).Scan(&cancelRequested)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, oops.Code("JOB_STORE_FAILED").
+			With("operation", "heartbeat").
+			With("job_id", job.ID.String()).
+			Wrap(err)
+	}
+	return cancelRequested, true, nil
+}
+
+// Finish records job's terminal status while this worker still holds it.
+// A succeeded job's progress is set to 100.
+func (s *PostgresStore) Finish(ctx context.Context, job Job, status Status, result, errText string, now time.Time) (Job, bool, error) {
+	row := s.pool.QueryRow(ctx, `
+		UPDATE jobs
+		   SET status = $1, result = $2, error = $3, finished_at = $4, heartbeat_at = $4,
+		       progress = CASE WHEN $1 = 'succeeded' THEN 100 ELSE progress END
+		 WHERE id = $5 AND status = 'running' AND attempts = $6
+		RETURNING `+jobColumns,
+		string(status), result, errText, pgnanos.From(now), job.ID[:], job.Attempts)
+	done, err := scanJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Job{}, false, nil
+	}
+	if err != nil {
+		return Job{}, false, oops.Code("JOB_STORE_FAILED").
+			With("operation", "finish").
+			With("job_id", job.ID.String()).
+			Wrap(err)
+	}
+	return done, true, nil
+}
+
+// RequestCancel cancels a queued job outright and flags a running one.
+func (s *PostgresStore) RequestCancel(ctx context.Context, id ulid.ULID, now time.Time) (Job, error) {
+	row := s.pool.QueryRow(ctx, `
+		UPDATE jobs
+		   SET cancel_requested = TRUE,
+		       status = CASE WHEN status = 'queued' THEN 'cancelled' ELSE status END,
+		       finished_at = CASE WHEN status = 'queued' THEN $2 ELSE finished_at END
+		 WHERE id = $1 AND status IN ('queued', 'running')
+		RETURNING `+jobColumns,
+		id[:], pgnanos.From(now))
+	job, err := scanJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Absent, or already terminal: Get tells the two apart.
+		existing, getErr := s.Get(ctx, id)
+		if getErr != nil {
+			return Job{}, getErr
+		}
+		return Job{}, errFinished(existing)
+	}
+	if err != nil {
+		return Job{}, oops.Code("JOB_STORE_FAILED").
+			With("operation", "request_cancel").
+			With("job_id", id.String()).
+			Wrap(err)
+	}
+	return job, nil
+}
+
+// Abandon ends stale running jobs that will not be claimed again.
+func (s *PostgresStore) Abandon(ctx context.Context, staleBefore time.Time, maxAttempts int, now time.Time) ([]Job, error) {
+	return s.query(ctx, "abandon", `
+		UPDATE jobs
+		   SET status = CASE WHEN cancel_requested THEN 'cancelled' ELSE 'failed' END,
+		       error = CASE WHEN cancel_requested THEN error ELSE $4 END,
+		       finished_at = $3
+		 WHERE status = 'running' AND heartbeat_at < $1
+		   AND (cancel_requested OR attempts >= $2)
+		RETURNING `+jobColumns,
+		pgnanos.From(staleBefore), maxAttempts, pgnanos.From(now), abandonedError)
+}
+
+// Prune deletes jobs that finished before before.
+func (s *PostgresStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM jobs WHERE finished_at < $1`, pgnanos.From(before))
+	if err != nil {
+		return 0, oops.Code("JOB_STORE_FAILED").With("operation", "prune").Wrap(err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *PostgresStore) query(ctx context.Context, operation, sql string, args ...any) ([]Job, error) {
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, oops.Code("JOB_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+
+	var jobs []Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, oops.Code("JOB_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("JOB_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return jobs, nil
+}
+
+func scanJob(row pgx.Row) (Job, error) {
+	var (
+		job         Job
+		idBytes     []byte
+		params      []byte
+		status      string
+		createdAt   pgnanos.Time
+		startedAt   *pgnanos.Time
+		heartbeatAt *pgnanos.Time
+		finishedAt  *pgnanos.Time
+	)
+	if err := row.Scan(&idBytes, &job.Kind, &params, &status, &job.Progress,
+		&job.ProgressMessage, &job.Result, &job.Error, &job.CancelRequested,
+		&job.Attempts, &job.SubmittedBy, &createdAt, &startedAt, &heartbeatAt,
+		&finishedAt); err != nil {
+		return Job{}, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	copy(job.ID[:], idBytes)
+	job.Params = params
+	job.Status = Status(status)
+	job.CreatedAt = createdAt.Time()
+	job.StartedAt = optionalTime(startedAt)
+	job.HeartbeatAt = optionalTime(heartbeatAt)
+	job.FinishedAt = optionalTime(finishedAt)
+	return job, nil
+}
+
+func optionalTime(t *pgnanos.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.Time()
+	return &v
+}
+
+// abandonedError is the error recorded on a job that ran out of attempts
+// because its workers kept dying.
+const abandonedError = "job abandoned: its worker stopped responding"
+
+func errNotFound(id ulid.ULID) error {
+	return oops.Code("JOB_NOT_FOUND").
+		With("job_id", id.String()).
+		Errorf("no job %s", id)
+}
+
+func errFinished(job Job) error {
+	return oops.Code("JOB_FINISHED").
+		With("job_id", job.ID.String()).
+		With("status", string(job.Status)).
+		Errorf("job %s has already %s", job.ID, job.Status)
+}
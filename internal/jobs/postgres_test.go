@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/jobs"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// newStoredJob inserts a queued job of kind.
+func newStoredJob(t *testing.T, st *jobs.PostgresStore, kind string, createdAt time.Time) jobs.Job {
+	t.Helper()
+	job := jobs.Job{
+		ID:          idgen.New(),
+		Kind:        kind,
+		Params:      json.RawMessage(`{"zone": "north"}`),
+		Status:      jobs.StatusQueued,
+		SubmittedBy: submitter,
+		CreatedAt:   createdAt,
+	}
+	require.NoError(t, st.Create(context.Background(), job))
+	return job
+}
+
+func TestPostgresStoreRoundTripsJob(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := jobs.NewPostgresStore(pool)
+	created := time.Unix(0, 1_800_000_000_123_456_789)
+	job := newStoredJob(t, st, "pg_roundtrip", created)
+
+	got, err := st.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, got.ID)
+	assert.Equal(t, "pg_roundtrip", got.Kind)
+	assert.JSONEq(t, `{"zone": "north"}`, string(got.Params))
+	assert.Equal(t, jobs.StatusQueued, got.Status)
+	assert.Equal(t, submitter, got.SubmittedBy)
+	assert.True(t, created.Equal(got.CreatedAt))
+	assert.Nil(t, got.StartedAt)
+	assert.Nil(t, got.FinishedAt)
+
+	_, err = st.Get(ctx, idgen.New())
+	errutil.AssertErrorCode(t, err, "JOB_NOT_FOUND")
+}
+
+func TestPostgresStoreClaimHeartbeatFinish(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := jobs.NewPostgresStore(pool)
+	now := time.Now()
+	older := newStoredJob(t, st, "pg_lifecycle", now.Add(-time.Minute))
+	newer := newStoredJob(t, st, "pg_lifecycle", now)
+
+	claimed, ok, err := st.Claim(ctx, []string{"pg_lifecycle"}, now, now.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, older.ID, claimed.ID, "oldest first")
+	assert.Equal(t, jobs.StatusRunning, claimed.Status)
+	assert.Equal(t, 1, claimed.Attempts)
+	require.NotNil(t, claimed.HeartbeatAt)
+
+	cancelRequested, held, err := st.Heartbeat(ctx, claimed, 40, "halfway", now.Add(time.Second))
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.False(t, cancelRequested)
+	got, err := st.Get(ctx, claimed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 40, got.Progress)
+	assert.Equal(t, "halfway", got.ProgressMessage)
+
+	done, finished, err := st.Finish(ctx, claimed, jobs.StatusSucceeded, "all done", "", now.Add(2*time.Second))
+	require.NoError(t, err)
+	require.True(t, finished)
+	assert.Equal(t, jobs.StatusSucceeded, done.Status)
+	assert.Equal(t, 100, done.Progress)
+	assert.Equal(t, "all done", done.Result)
+	require.NotNil(t, done.FinishedAt)
+
+	// A finished job is no longer held.
+	_, held, err = st.Heartbeat(ctx, claimed, 50, "", now.Add(3*time.Second))
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	next, ok, err := st.Claim(ctx, []string{"pg_lifecycle"}, now, now.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, newer.ID, next.ID)
+
+	_, ok, err = st.Claim(ctx, []string{"pg_lifecycle"}, now, now.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	assert.False(t, ok, "a freshly heartbeated job is not claimable")
+}
+
+func TestPostgresStoreReclaimFencesOldWorker(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := jobs.NewPostgresStore(pool)
+	now := time.Now()
+	newStoredJob(t, st, "pg_reclaim", now)
+
+	first, ok, err := st.Claim(ctx, []string{"pg_reclaim"}, now, now.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	later := now.Add(5 * time.Minute)
+	second, ok, err := st.Claim(ctx, []string{"pg_reclaim"}, later, later.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	require.True(t, ok, "a stale running job is reclaimed")
+	assert.Equal(t, first.ID, second.ID)
+	assert.Equal(t, 2, second.Attempts)
+
+	_, finished, err := st.Finish(ctx, first, jobs.StatusFailed, "", "late", later)
+	require.NoError(t, err)
+	assert.False(t, finished, "the old worker's outcome is dropped")
+	_, held, err := st.Heartbeat(ctx, second, 10, "", later)
+	require.NoError(t, err)
+	assert.True(t, held)
+}
+
+func TestPostgresStoreRequestCancel(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := jobs.NewPostgresStore(pool)
+	now := time.Now()
+	queued := newStoredJob(t, st, "pg_cancel_queued", now)
+
+	cancelled, err := st.RequestCancel(ctx, queued.ID, now)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusCancelled, cancelled.Status)
+	require.NotNil(t, cancelled.FinishedAt)
+
+	_, err = st.RequestCancel(ctx, queued.ID, now)
+	errutil.AssertErrorCode(t, err, "JOB_FINISHED")
+	_, err = st.RequestCancel(ctx, idgen.New(), now)
+	errutil.AssertErrorCode(t, err, "JOB_NOT_FOUND")
+
+	newStoredJob(t, st, "pg_cancel_running", now)
+	running, ok, err := st.Claim(ctx, []string{"pg_cancel_running"}, now, now.Add(-time.Minute), 3)
+	require.NoError(t, err)
+	require.True(t, ok)
+	flagged, err := st.RequestCancel(ctx, running.ID, now)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusRunning, flagged.Status)
+	assert.True(t, flagged.CancelRequested)
+
+	cancelRequested, held, err := st.Heartbeat(ctx, running, 0, "", now)
+	require.NoError(t, err)
+	assert.True(t, held)
+	assert.True(t, cancelRequested)
+}
+
+func TestPostgresStoreAbandonAndPrune(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := jobs.NewPostgresStore(pool)
+	now := time.Now()
+	job := newStoredJob(t, st, "pg_abandon", now)
+	claimed, ok, err := st.Claim(ctx, []string{"pg_abandon"}, now, now.Add(-time.Minute), 1)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	later := now.Add(5 * time.Minute)
+	abandoned, err := st.Abandon(ctx, later.Add(-time.Minute), 1, later)
+	require.NoError(t, err)
+	var found bool
+	for _, a := range abandoned {
+		if a.ID == claimed.ID {
+			found = true
+			assert.Equal(t, jobs.StatusFailed, a.Status)
+			assert.NotEmpty(t, a.Error)
+		}
+	}
+	assert.True(t, found)
+
+	list, err := st.List(ctx, jobs.ListFilter{Statuses: []jobs.Status{jobs.StatusFailed}, Limit: jobs.MaxListLimit})
+	require.NoError(t, err)
+	var listed bool
+	for _, l := range list {
+		listed = listed || l.ID == job.ID
+	}
+	assert.True(t, listed)
+
+	pruned, err := st.Prune(ctx, later.Add(time.Second))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, pruned, 1)
+	_, err = st.Get(ctx, job.ID)
+	errutil.AssertErrorCode(t, err, "JOB_NOT_FOUND")
+}
@@ -0,0 +1,599 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package jobs runs long-running admin operations — world checks, imports,
+// mass property migrations, exports — in the background. Jobs are persisted
+// in PostgreSQL with their params, progress and outcome, so staff can start
+// one, disconnect, and check on it later; the submitting character is sent
+// a job_finished event when it ends.
+//
+// Every replica runs a small worker pool. A worker claims the oldest queued
+// job with FOR UPDATE SKIP LOCKED and heartbeats it while the handler runs,
+// persisting the latest progress report. A job whose heartbeat goes stale
+// belonged to a replica that died and is claimed again, up to a retry
+// limit; the claim count fences the old worker out.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Default Queue tuning.
+const (
+	defaultWorkers           = 2
+	defaultPollInterval      = 5 * time.Second
+	defaultHeartbeatInterval = 10 * time.Second
+	defaultStaleAfter        = time.Minute
+	defaultMaxAttempts       = 3
+	defaultRetention         = 7 * 24 * time.Hour
+
+	// DefaultListLimit is the number of jobs List returns when the filter
+	// sets no limit.
+	DefaultListLimit = 20
+	// MaxListLimit bounds ListFilter.Limit.
+	MaxListLimit = 200
+)
+
+var (
+	// errCancelRequested is the cancellation cause of a job whose cancel
+	// flag was seen on a heartbeat.
+	errCancelRequested = errors.New("job cancelled")
+	// errClaimLost is the cancellation cause of a job another worker took
+	// over after this worker's heartbeat went stale.
+	errClaimLost = errors.New("job claimed by another worker")
+)
+
+// ListFilter narrows List.
+type ListFilter struct {
+	// Statuses restricts the result to jobs in one of these statuses; empty
+	// means every status.
+	Statuses []Status
+	// Limit caps the result (default DefaultListLimit, at most MaxListLimit).
+	Limit int
+}
+
+// Store persists jobs. *PostgresStore satisfies it.
+type Store interface {
+	// Create inserts a queued job.
+	Create(ctx context.Context, job Job) error
+	// Get returns the job with id. Returns JOB_NOT_FOUND when absent.
+	Get(ctx context.Context, id ulid.ULID) (Job, error)
+	// List returns jobs matching filter, newest first. filter.Limit is
+	// already resolved by the caller.
+	List(ctx context.Context, filter ListFilter) ([]Job, error)
+	// Claim marks the oldest claimable job of one of kinds running,
+	// incrementing its attempts and stamping now as its start and heartbeat,
+	// and returns it. A job is claimable when it is queued, or running with
+	// a heartbeat before staleBefore, fewer than maxAttempts attempts, and
+	// no cancel request. ok is false when nothing is claimable.
+	Claim(ctx context.Context, kinds []string, now, staleBefore time.Time, maxAttempts int) (job Job, ok bool, err error)
+	// Heartbeat stamps now and the given progress on job, provided it is
+	// still running under job.Attempts. held is false when the claim was
+	// lost; cancelRequested reports the job's cancel flag.
+	Heartbeat(ctx context.Context, job Job, progress int, message string, now time.Time) (cancelRequested, held bool, err error)
+	// Finish records job's terminal status and outcome, provided it is
+	// still running under job.Attempts. finished is false when the claim
+	// was lost, in which case nothing is written.
+	Finish(ctx context.Context, job Job, status Status, result, errText string, now time.Time) (done Job, finished bool, err error)
+	// RequestCancel cancels a queued job outright and flags a running one
+	// for its worker to stop. Returns JOB_NOT_FOUND when absent and
+	// JOB_FINISHED when the job has already ended.
+	RequestCancel(ctx context.Context, id ulid.ULID, now time.Time) (Job, error)
+	// Abandon ends running jobs whose heartbeat is before staleBefore and
+	// which will not be claimed again: those flagged for cancellation become
+	// cancelled and those out of attempts become failed. Returns the ended
+	// jobs.
+	Abandon(ctx context.Context, staleBefore time.Time, maxAttempts int, now time.Time) ([]Job, error)
+	// Prune deletes jobs that finished before before and returns how many.
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
+
+// Config configures a Queue.
+type Config struct {
+	Workers           int              // concurrent jobs per replica (default: 2)
+	PollInterval      time.Duration    // how often idle workers look for jobs (default: 5s)
+	HeartbeatInterval time.Duration    // how often a running job is heartbeated (default: 10s)
+	StaleAfter        time.Duration    // heartbeat age at which a job is reclaimed (default: 1m; raised to 3x HeartbeatInterval if below it)
+	MaxAttempts       int              // claims before an abandoned job fails (default: 3)
+	Retention         time.Duration    // how long finished jobs are kept (default: 7 days)
+	Now               func() time.Time // clock override for tests (default: time.Now)
+}
+
+// Queue accepts job submissions and runs them on a worker pool.
+type Queue struct {
+	config Config
+	store  Store
+	wake   chan struct{}
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+	pub      eventbus.Publisher
+	gameID   func() string
+}
+
+// NewQueue creates a Queue over store. Zero config fields take their
+// defaults. Handlers are registered with Register, and the publisher for
+// job_finished events is bound later with SetPublisher because the event
+// bus is created after the command registry.
+//
+// Panics when store is nil, mirroring the construction-time failure
+// discipline of the other host services.
+func NewQueue(config Config, store Store) *Queue {
+	if store == nil {
+		panic("jobs.NewQueue: nil Store")
+	}
+	if config.Workers <= 0 {
+		config.Workers = defaultWorkers
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.HeartbeatInterval <= 0 {
+		config.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if config.StaleAfter <= 0 {
+		config.StaleAfter = defaultStaleAfter
+	}
+	if config.StaleAfter < 3*config.HeartbeatInterval {
+		config.StaleAfter = 3 * config.HeartbeatInterval
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaultRetention
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Queue{
+		config:   config,
+		store:    store,
+		wake:     make(chan struct{}, 1),
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register binds handler to kind. Only kinds registered on a replica are
+// claimed by its workers. Returns JOB_INVALID for a malformed kind and
+// JOB_KIND_EXISTS when kind is already registered.
+func (q *Queue) Register(kind string, handler Handler) error {
+	if !kindPattern.MatchString(kind) {
+		return oops.Code("JOB_INVALID").
+			With("kind", kind).
+			Errorf("job kind must be 1-64 lowercase letters, digits or '_', starting with a letter")
+	}
+	if handler == nil {
+		return oops.Code("JOB_INVALID").With("kind", kind).Errorf("nil handler")
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.handlers[kind]; ok {
+		return oops.Code("JOB_KIND_EXISTS").
+			With("kind", kind).
+			Errorf("job kind %q is already registered", kind)
+	}
+	q.handlers[kind] = handler
+	return nil
+}
+
+// Kinds returns the registered job kinds in sorted order.
+func (q *Queue) Kinds() []string {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return slices.Sorted(maps.Keys(q.handlers))
+}
+
+func (q *Queue) handler(kind string) (Handler, bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	h, ok := q.handlers[kind]
+	return h, ok
+}
+
+// SetPublisher binds the publisher used for job_finished events. gameID
+// supplies the game id that qualifies event subjects.
+func (q *Queue) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pub = pub
+	q.gameID = gameID
+}
+
+func (q *Queue) publisher() (eventbus.Publisher, func() string) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.pub == nil || eventbus.IsNilPublisher(q.pub) || q.gameID == nil {
+		return nil, nil
+	}
+	return q.pub, q.gameID
+}
+
+// Submit validates req and queues it. Returns JOB_UNKNOWN_KIND when no
+// handler is registered for the kind and JOB_INVALID when the params are
+// malformed or the handler's ParamsValidator rejects them.
+func (q *Queue) Submit(ctx context.Context, req SubmitRequest) (Job, error) {
+	params, err := req.normalize()
+	if err != nil {
+		return Job{}, err
+	}
+	h, ok := q.handler(req.Kind)
+	if !ok {
+		return Job{}, oops.Code("JOB_UNKNOWN_KIND").
+			With("kind", req.Kind).
+			Errorf("unknown job kind %q", req.Kind)
+	}
+	if v, ok := h.(ParamsValidator); ok {
+		if err := v.ValidateParams(params); err != nil {
+			return Job{}, oops.Code("JOB_INVALID").
+				With("kind", req.Kind).
+				Wrap(err)
+		}
+	}
+	job := Job{
+		ID:          idgen.New(),
+		Kind:        req.Kind,
+		Params:      params,
+		Status:      StatusQueued,
+		SubmittedBy: req.SubmittedBy,
+		CreatedAt:   q.config.Now(),
+	}
+	if err := q.store.Create(ctx, job); err != nil {
+		return Job{}, err
+	}
+	slog.InfoContext(ctx, "jobs: job submitted",
+		"job_id", job.ID.String(),
+		"kind", job.Kind,
+		"submitted_by", job.SubmittedBy)
+
+	// Nudge an idle local worker; other replicas find it on their next poll.
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// Get returns the job with id.
+func (q *Queue) Get(ctx context.Context, id ulid.ULID) (Job, error) {
+	return q.store.Get(ctx, id)
+}
+
+// List returns jobs matching filter, newest first. Returns JOB_INVALID when
+// the limit is out of range.
+func (q *Queue) List(ctx context.Context, filter ListFilter) ([]Job, error) {
+	if filter.Limit < 0 || filter.Limit > MaxListLimit {
+		return nil, oops.Code("JOB_INVALID").
+			With("limit", filter.Limit).
+			Errorf("limit must be between 1 and %d", MaxListLimit)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+	return q.store.List(ctx, filter)
+}
+
+// Cancel cancels a queued job, or asks the worker running it to stop. A
+// running job ends as cancelled once its handler returns.
+func (q *Queue) Cancel(ctx context.Context, id ulid.ULID) (Job, error) {
+	job, err := q.store.RequestCancel(ctx, id, q.config.Now())
+	if err != nil {
+		return Job{}, err
+	}
+	slog.InfoContext(ctx, "jobs: cancel requested",
+		"job_id", job.ID.String(),
+		"kind", job.Kind,
+		"status", string(job.Status))
+	if job.Status == StatusCancelled {
+		q.announce(ctx, job)
+	}
+	return job, nil
+}
+
+// Run starts the worker pool and the maintenance loop that ends abandoned
+// jobs and prunes old ones. Blocks until ctx is cancelled and every worker
+// has returned.
+func (q *Queue) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range q.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx)
+		}()
+	}
+	q.maintain(ctx)
+	wg.Wait()
+}
+
+func (q *Queue) work(ctx context.Context) {
+	for {
+		ran, err := q.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "jobs: claim failed", "error", err)
+		}
+		if ran {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.wake:
+		case <-time.After(q.config.PollInterval):
+		}
+	}
+}
+
+func (q *Queue) maintain(ctx context.Context) {
+	ticker := time.NewTicker(q.config.StaleAfter)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := q.Maintain(ctx); err != nil {
+				slog.WarnContext(ctx, "jobs: maintenance failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce claims one job and runs it to completion. ran is false when no
+// job of a registered kind was claimable. Exported so callers and tests can
+// drive the queue without the worker pool.
+func (q *Queue) RunOnce(ctx context.Context) (ran bool, err error) {
+	kinds := q.Kinds()
+	if len(kinds) == 0 {
+		return false, nil
+	}
+	now := q.config.Now()
+	job, ok, err := q.store.Claim(ctx, kinds, now, now.Add(-q.config.StaleAfter), q.config.MaxAttempts)
+	if err != nil || !ok {
+		return false, err
+	}
+	h, ok := q.handler(job.Kind)
+	if !ok {
+		// Claimed by kind, and handlers are never unregistered.
+		return true, oops.Code("JOB_UNKNOWN_KIND").With("kind", job.Kind).Errorf("no handler for claimed job")
+	}
+	q.execute(ctx, h, job)
+	return true, nil
+}
+
+// Maintain ends abandoned jobs and prunes finished jobs past retention.
+// Exported so callers and tests can drive it without the ticker.
+func (q *Queue) Maintain(ctx context.Context) error {
+	now := q.config.Now()
+	abandoned, err := q.store.Abandon(ctx, now.Add(-q.config.StaleAfter), q.config.MaxAttempts, now)
+	if err != nil {
+		return err
+	}
+	for _, job := range abandoned {
+		slog.WarnContext(ctx, "jobs: abandoned job ended",
+			"job_id", job.ID.String(),
+			"kind", job.Kind,
+			"status", string(job.Status),
+			"attempts", job.Attempts)
+		q.announce(ctx, job)
+	}
+	pruned, err := q.store.Prune(ctx, now.Add(-q.config.Retention))
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		slog.DebugContext(ctx, "jobs: pruned finished jobs", "count", pruned)
+	}
+	return nil
+}
+
+// execute runs job's handler alongside a heartbeat and records the outcome.
+func (q *Queue) execute(ctx context.Context, h Handler, job Job) {
+	slog.InfoContext(ctx, "jobs: job started",
+		"job_id", job.ID.String(),
+		"kind", job.Kind,
+		"attempt", job.Attempts)
+
+	runCtx, stop := context.WithCancelCause(ctx)
+	progress := &reporter{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		q.heartbeat(runCtx, stop, job, progress)
+	}()
+	result, runErr := runHandler(runCtx, h, job, progress)
+	stop(nil)
+	wg.Wait()
+
+	cause := context.Cause(runCtx)
+	if errors.Is(cause, errClaimLost) {
+		slog.WarnContext(ctx, "jobs: job claimed by another worker; dropping outcome",
+			"job_id", job.ID.String(), "kind", job.Kind)
+		return
+	}
+	status, errText := StatusSucceeded, ""
+	if runErr != nil {
+		switch {
+		case errors.Is(cause, errCancelRequested):
+			status = StatusCancelled
+		case ctx.Err() != nil:
+			// Shutdown interrupted the handler: leave the job running so a
+			// live replica reclaims it once the heartbeat goes stale.
+			slog.InfoContext(ctx, "jobs: job interrupted by shutdown",
+				"job_id", job.ID.String(), "kind", job.Kind)
+			return
+		default:
+			status, errText = StatusFailed, runErr.Error()
+		}
+	}
+
+	// The outcome is recorded even when shutdown began after the handler
+	// returned.
+	finishCtx := context.WithoutCancel(ctx)
+	done, finished, err := q.store.Finish(finishCtx, job, status,
+		truncate(result, MaxResultLength), truncate(errText, MaxResultLength), q.config.Now())
+	if err != nil {
+		slog.WarnContext(ctx, "jobs: failed to record job outcome",
+			"job_id", job.ID.String(), "kind", job.Kind, "error", err)
+		return
+	}
+	if !finished {
+		slog.WarnContext(ctx, "jobs: job claimed by another worker; dropping outcome",
+			"job_id", job.ID.String(), "kind", job.Kind)
+		return
+	}
+	slog.InfoContext(ctx, "jobs: job finished",
+		"job_id", done.ID.String(),
+		"kind", done.Kind,
+		"status", string(done.Status),
+		"error", done.Error)
+	q.announce(finishCtx, done)
+}
+
+// heartbeat persists progress every HeartbeatInterval until ctx is done,
+// stopping the run when the job is cancelled or its claim is lost.
+func (q *Queue) heartbeat(ctx context.Context, stop context.CancelCauseFunc, job Job, progress *reporter) {
+	ticker := time.NewTicker(q.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		percent, message := progress.snapshot()
+		cancelRequested, held, err := q.store.Heartbeat(ctx, job, percent, message, q.config.Now())
+		switch {
+		case err != nil:
+			if ctx.Err() == nil {
+				slog.WarnContext(ctx, "jobs: heartbeat failed",
+					"job_id", job.ID.String(), "kind", job.Kind, "error", err)
+			}
+		case !held:
+			stop(errClaimLost)
+			return
+		case cancelRequested:
+			stop(errCancelRequested)
+			return
+		}
+	}
+}
+
+// runHandler runs h, converting a panic into a failure so one bad job
+// cannot take down the worker.
+func runHandler(ctx context.Context, h Handler, job Job, progress Progress) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = oops.Code("JOB_PANICKED").
+				With("job_id", job.ID.String()).
+				With("kind", job.Kind).
+				Errorf("job handler panicked: %v", r)
+		}
+	}()
+	return h.Run(ctx, job, progress)
+}
+
+// announce publishes a job_finished event to the character that submitted
+// job. The outcome is already recorded, so a publish failure is logged
+// rather than returned.
+func (q *Queue) announce(ctx context.Context, job Job) {
+	id, ok := strings.CutPrefix(job.SubmittedBy, access.SubjectCharacter)
+	if !ok {
+		return
+	}
+	charID, err := ulid.Parse(id)
+	if err != nil {
+		return
+	}
+	pub, gameID := q.publisher()
+	if pub == nil {
+		return
+	}
+	if err := publishFinished(ctx, pub, gameID, charID, job); err != nil {
+		slog.WarnContext(ctx, "jobs: job_finished event not published",
+			"job_id", job.ID.String(),
+			"character_id", charID.String(),
+			"error", err)
+	}
+}
+
+func publishFinished(ctx context.Context, pub eventbus.Publisher, gameID func() string, characterID ulid.ULID, job Job) error {
+	data, err := json.Marshal(eventvocab.JobFinishedPayload{
+		JobID:  job.ID.String(),
+		Kind:   job.Kind,
+		Status: string(job.Status),
+		Result: job.Result,
+		Error:  job.Error,
+		Text:   FinishedText(job),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_job_finished_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+characterID.String())
+	if err != nil {
+		return oops.With("character_id", characterID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeJobFinished))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeJobFinished)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, data)); err != nil {
+		return oops.Code("JOB_PUBLISH_FAILED").
+			With("job_id", job.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// FinishedText is the one-line summary of a finished job shown to its
+// submitter.
+func FinishedText(job Job) string {
+	head := fmt.Sprintf("Job %s (%s)", job.ID, job.Kind)
+	switch job.Status {
+	case StatusSucceeded:
+		if line := firstLine(job.Result); line != "" {
+			return head + " succeeded: " + line
+		}
+		return head + " succeeded."
+	case StatusFailed:
+		return head + " failed: " + firstLine(job.Error)
+	case StatusCancelled:
+		return head + " was cancelled."
+	default:
+		return head + " is " + string(job.Status) + "."
+	}
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return truncate(line, 200)
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
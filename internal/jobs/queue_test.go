@@ -0,0 +1,649 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/jobs"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memStore is an in-memory jobs.Store.
+type memStore struct {
+	mu   sync.Mutex
+	jobs map[ulid.ULID]jobs.Job
+}
+
+func newMemStore() *memStore {
+	return &memStore{jobs: make(map[ulid.ULID]jobs.Job)}
+}
+
+func (m *memStore) Create(_ context.Context, job jobs.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[job.ID] = job
+	return nil
+}
+
+func (m *memStore) Get(_ context.Context, id ulid.ULID) (jobs.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return jobs.Job{}, oops.Code("JOB_NOT_FOUND").Errorf("not found")
+	}
+	return job, nil
+}
+
+func (m *memStore) List(_ context.Context, filter jobs.ListFilter) ([]jobs.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []jobs.Job
+	for _, job := range m.jobs {
+		if len(filter.Statuses) == 0 || slices.Contains(filter.Statuses, job.Status) {
+			out = append(out, job)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return submittedBefore(out[j], out[i]) })
+	if len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (m *memStore) Claim(_ context.Context, kinds []string, now, staleBefore time.Time, maxAttempts int) (jobs.Job, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var candidates []jobs.Job
+	for _, job := range m.jobs {
+		if !slices.Contains(kinds, job.Kind) {
+			continue
+		}
+		stale := job.Status == jobs.StatusRunning && job.HeartbeatAt.Before(staleBefore) &&
+			job.Attempts < maxAttempts && !job.CancelRequested
+		if job.Status == jobs.StatusQueued || stale {
+			candidates = append(candidates, job)
+		}
+	}
+	if len(candidates) == 0 {
+		return jobs.Job{}, false, nil
+	}
+	sort.Slice(candidates, func(i, j int) bool { return submittedBefore(candidates[i], candidates[j]) })
+	job := candidates[0]
+	job.Status = jobs.StatusRunning
+	job.Attempts++
+	job.StartedAt = &now
+	job.HeartbeatAt = &now
+	m.jobs[job.ID] = job
+	return job, true, nil
+}
+
+func (m *memStore) Heartbeat(_ context.Context, job jobs.Job, progress int, message string, now time.Time) (bool, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.jobs[job.ID]
+	if !ok || stored.Status != jobs.StatusRunning || stored.Attempts != job.Attempts {
+		return false, false, nil
+	}
+	stored.Progress = progress
+	stored.ProgressMessage = message
+	stored.HeartbeatAt = &now
+	m.jobs[job.ID] = stored
+	return stored.CancelRequested, true, nil
+}
+
+func (m *memStore) Finish(_ context.Context, job jobs.Job, status jobs.Status, result, errText string, now time.Time) (jobs.Job, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored, ok := m.jobs[job.ID]
+	if !ok || stored.Status != jobs.StatusRunning || stored.Attempts != job.Attempts {
+		return jobs.Job{}, false, nil
+	}
+	stored.Status = status
+	stored.Result = result
+	stored.Error = errText
+	stored.FinishedAt = &now
+	if status == jobs.StatusSucceeded {
+		stored.Progress = 100
+	}
+	m.jobs[job.ID] = stored
+	return stored, true, nil
+}
+
+func (m *memStore) RequestCancel(_ context.Context, id ulid.ULID, now time.Time) (jobs.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return jobs.Job{}, oops.Code("JOB_NOT_FOUND").Errorf("not found")
+	}
+	if job.Status.Terminal() {
+		return jobs.Job{}, oops.Code("JOB_FINISHED").Errorf("finished")
+	}
+	job.CancelRequested = true
+	if job.Status == jobs.StatusQueued {
+		job.Status = jobs.StatusCancelled
+		job.FinishedAt = &now
+	}
+	m.jobs[id] = job
+	return job, nil
+}
+
+func (m *memStore) Abandon(_ context.Context, staleBefore time.Time, maxAttempts int, now time.Time) ([]jobs.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []jobs.Job
+	for id, job := range m.jobs {
+		if job.Status != jobs.StatusRunning || !job.HeartbeatAt.Before(staleBefore) {
+			continue
+		}
+		switch {
+		case job.CancelRequested:
+			job.Status = jobs.StatusCancelled
+		case job.Attempts >= maxAttempts:
+			job.Status = jobs.StatusFailed
+			job.Error = "abandoned"
+		default:
+			continue
+		}
+		job.FinishedAt = &now
+		m.jobs[id] = job
+		out = append(out, job)
+	}
+	return out, nil
+}
+
+func (m *memStore) Prune(_ context.Context, before time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for id, job := range m.jobs {
+		if job.FinishedAt != nil && job.FinishedAt.Before(before) {
+			delete(m.jobs, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// submittedBefore orders jobs as the postgres store does: by creation time,
+// then ID.
+func submittedBefore(a, b jobs.Job) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID.Compare(b.ID) < 0
+}
+
+func (m *memStore) get(t *testing.T, id ulid.ULID) jobs.Job {
+	t.Helper()
+	job, err := m.Get(context.Background(), id)
+	require.NoError(t, err)
+	return job
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) events() []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.published)
+}
+
+func mainGameID() string { return "main" }
+
+// fakeClock is a settable test clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestQueue(t *testing.T, store *memStore, config jobs.Config) (*jobs.Queue, *fakeClock) {
+	t.Helper()
+	clock := &fakeClock{now: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)}
+	config.Now = clock.Now
+	return jobs.NewQueue(config, store), clock
+}
+
+func echoHandler(result string) jobs.Handler {
+	return jobs.HandlerFunc(func(context.Context, jobs.Job, jobs.Progress) (string, error) {
+		return result, nil
+	})
+}
+
+var submitter = access.CharacterSubject("01HZ0000000000000000000001")
+
+func TestNewQueuePanicsOnNilStore(t *testing.T) {
+	assert.Panics(t, func() { jobs.NewQueue(jobs.Config{}, nil) })
+}
+
+func TestRegister(t *testing.T) {
+	q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+
+	require.NoError(t, q.Register("export", echoHandler("ok")))
+	errutil.AssertErrorCode(t, q.Register("export", echoHandler("ok")), "JOB_KIND_EXISTS")
+	errutil.AssertErrorCode(t, q.Register("Bad Kind", echoHandler("ok")), "JOB_INVALID")
+	errutil.AssertErrorCode(t, q.Register("nil_handler", nil), "JOB_INVALID")
+	assert.Equal(t, []string{"export"}, q.Kinds())
+}
+
+func TestSubmit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("queues the job with normalized params", func(t *testing.T) {
+		store := newMemStore()
+		q, clock := newTestQueue(t, store, jobs.Config{})
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export", SubmittedBy: submitter})
+		require.NoError(t, err)
+		assert.Equal(t, jobs.StatusQueued, job.Status)
+		assert.JSONEq(t, `{}`, string(job.Params))
+		assert.Equal(t, clock.Now(), job.CreatedAt)
+		assert.Equal(t, job, store.get(t, job.ID))
+	})
+
+	t.Run("rejects unknown kinds", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		_, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+		errutil.AssertErrorCode(t, err, "JOB_UNKNOWN_KIND")
+	})
+
+	t.Run("rejects params that are not a JSON object", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		for _, params := range []string{`[1]`, `"x"`, `{`, `null`} {
+			_, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export", Params: json.RawMessage(params)})
+			errutil.AssertErrorCode(t, err, "JOB_INVALID")
+		}
+	})
+
+	t.Run("applies the handler's params validator", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		require.NoError(t, q.Register(jobs.KindWorldCheck, jobs.NewWorldCheckHandler(&fakeChecker{})))
+		_, err := q.Submit(ctx, jobs.SubmitRequest{Kind: jobs.KindWorldCheck, Params: json.RawMessage(`{"repair":true}`)})
+		errutil.AssertErrorCode(t, err, "JOB_INVALID")
+	})
+}
+
+func TestRunOnce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("runs the job and announces the outcome to its submitter", func(t *testing.T) {
+		store := newMemStore()
+		q, _ := newTestQueue(t, store, jobs.Config{})
+		pub := &fakePublisher{}
+		q.SetPublisher(pub, mainGameID)
+		require.NoError(t, q.Register("export", echoHandler("wrote 3 files")))
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export", SubmittedBy: submitter})
+		require.NoError(t, err)
+
+		ran, err := q.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.True(t, ran)
+
+		done := store.get(t, job.ID)
+		assert.Equal(t, jobs.StatusSucceeded, done.Status)
+		assert.Equal(t, "wrote 3 files", done.Result)
+		assert.Equal(t, 100, done.Progress)
+		assert.Equal(t, 1, done.Attempts)
+		require.NotNil(t, done.FinishedAt)
+
+		events := pub.events()
+		require.Len(t, events, 1)
+		assert.Equal(t, "events.main.character.01HZ0000000000000000000001", string(events[0].Subject))
+		assert.Equal(t, eventbus.Type(eventvocab.EventTypeJobFinished), events[0].Type)
+		var payload eventvocab.JobFinishedPayload
+		require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+		assert.Equal(t, job.ID.String(), payload.JobID)
+		assert.Equal(t, "succeeded", payload.Status)
+		assert.Equal(t, "Job "+job.ID.String()+" (export) succeeded: wrote 3 files", payload.Text)
+	})
+
+	t.Run("reports nothing to run when the queue is empty", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		ran, err := q.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.False(t, ran)
+	})
+
+	t.Run("records a handler error as a failure", func(t *testing.T) {
+		store := newMemStore()
+		q, _ := newTestQueue(t, store, jobs.Config{})
+		require.NoError(t, q.Register("export", jobs.HandlerFunc(func(context.Context, jobs.Job, jobs.Progress) (string, error) {
+			return "", errors.New("disk full")
+		})))
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+		require.NoError(t, err)
+
+		_, err = q.RunOnce(ctx)
+		require.NoError(t, err)
+		done := store.get(t, job.ID)
+		assert.Equal(t, jobs.StatusFailed, done.Status)
+		assert.Equal(t, "disk full", done.Error)
+	})
+
+	t.Run("records a handler panic as a failure", func(t *testing.T) {
+		store := newMemStore()
+		q, _ := newTestQueue(t, store, jobs.Config{})
+		require.NoError(t, q.Register("export", jobs.HandlerFunc(func(context.Context, jobs.Job, jobs.Progress) (string, error) {
+			panic("boom")
+		})))
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+		require.NoError(t, err)
+
+		_, err = q.RunOnce(ctx)
+		require.NoError(t, err)
+		done := store.get(t, job.ID)
+		assert.Equal(t, jobs.StatusFailed, done.Status)
+		assert.Contains(t, done.Error, "boom")
+	})
+
+	t.Run("skips kinds this queue has no handler for", func(t *testing.T) {
+		store := newMemStore()
+		q, _ := newTestQueue(t, store, jobs.Config{})
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		other := jobs.Job{ID: ulid.Make(), Kind: "import", Status: jobs.StatusQueued}
+		require.NoError(t, store.Create(ctx, other))
+
+		ran, err := q.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.False(t, ran)
+		assert.Equal(t, jobs.StatusQueued, store.get(t, other.ID).Status)
+	})
+
+	t.Run("does not announce jobs submitted by non-characters", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		pub := &fakePublisher{}
+		q.SetPublisher(pub, mainGameID)
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		_, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export", SubmittedBy: "system"})
+		require.NoError(t, err)
+
+		_, err = q.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, pub.events())
+	})
+}
+
+func TestRunningJobHeartbeatsProgressAndHonorsCancel(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(t, store, jobs.Config{HeartbeatInterval: time.Millisecond})
+
+	reported := make(chan struct{})
+	require.NoError(t, q.Register("export", jobs.HandlerFunc(func(ctx context.Context, _ jobs.Job, progress jobs.Progress) (string, error) {
+		progress.Report(150, "halfway there")
+		close(reported)
+		<-ctx.Done()
+		return "", ctx.Err()
+	})))
+	job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = q.RunOnce(ctx)
+	}()
+	<-reported
+	require.Eventually(t, func() bool {
+		return store.get(t, job.ID).ProgressMessage == "halfway there"
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, 100, store.get(t, job.ID).Progress, "progress is clamped to 100")
+
+	cancelled, err := q.Cancel(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, jobs.StatusRunning, cancelled.Status)
+	assert.True(t, cancelled.CancelRequested)
+
+	<-done
+	assert.Equal(t, jobs.StatusCancelled, store.get(t, job.ID).Status)
+}
+
+func TestRunningJobStopsWhenClaimIsLost(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(t, store, jobs.Config{HeartbeatInterval: time.Millisecond})
+
+	started := make(chan jobs.Job)
+	require.NoError(t, q.Register("export", jobs.HandlerFunc(func(ctx context.Context, job jobs.Job, _ jobs.Progress) (string, error) {
+		started <- job
+		<-ctx.Done()
+		return "", ctx.Err()
+	})))
+	_, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = q.RunOnce(ctx)
+	}()
+	job := <-started
+
+	// Another worker reclaims the job, bumping its attempts.
+	store.mu.Lock()
+	stolen := store.jobs[job.ID]
+	stolen.Attempts++
+	store.jobs[job.ID] = stolen
+	store.mu.Unlock()
+
+	<-done
+	got := store.get(t, job.ID)
+	assert.Equal(t, jobs.StatusRunning, got.Status, "the new owner's claim is untouched")
+	assert.Equal(t, 2, got.Attempts)
+}
+
+func TestShutdownLeavesJobForReclaim(t *testing.T) {
+	store := newMemStore()
+	q, clock := newTestQueue(t, store, jobs.Config{StaleAfter: time.Minute})
+
+	started := make(chan struct{})
+	require.NoError(t, q.Register("export", jobs.HandlerFunc(func(ctx context.Context, _ jobs.Job, _ jobs.Progress) (string, error) {
+		close(started)
+		<-ctx.Done()
+		return "", ctx.Err()
+	})))
+	job, err := q.Submit(context.Background(), jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = q.RunOnce(ctx)
+	}()
+	<-started
+	cancel()
+	<-done
+	assert.Equal(t, jobs.StatusRunning, store.get(t, job.ID).Status)
+
+	// Once its heartbeat is stale, another worker claims it again.
+	clock.Advance(2 * time.Minute)
+	q2 := jobs.NewQueue(jobs.Config{Now: clock.Now}, store)
+	require.NoError(t, q2.Register("export", echoHandler("resumed")))
+	ran, err := q2.RunOnce(context.Background())
+	require.NoError(t, err)
+	assert.True(t, ran)
+	done2 := store.get(t, job.ID)
+	assert.Equal(t, jobs.StatusSucceeded, done2.Status)
+	assert.Equal(t, 2, done2.Attempts)
+}
+
+func TestCancel(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cancels a queued job outright and announces it", func(t *testing.T) {
+		store := newMemStore()
+		q, _ := newTestQueue(t, store, jobs.Config{})
+		pub := &fakePublisher{}
+		q.SetPublisher(pub, mainGameID)
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export", SubmittedBy: submitter})
+		require.NoError(t, err)
+
+		cancelled, err := q.Cancel(ctx, job.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jobs.StatusCancelled, cancelled.Status)
+		require.Len(t, pub.events(), 1)
+
+		ran, err := q.RunOnce(ctx)
+		require.NoError(t, err)
+		assert.False(t, ran, "a cancelled job is never claimed")
+	})
+
+	t.Run("rejects finished jobs", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		require.NoError(t, q.Register("export", echoHandler("ok")))
+		job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+		require.NoError(t, err)
+		_, err = q.RunOnce(ctx)
+		require.NoError(t, err)
+
+		_, err = q.Cancel(ctx, job.ID)
+		errutil.AssertErrorCode(t, err, "JOB_FINISHED")
+	})
+
+	t.Run("rejects unknown jobs", func(t *testing.T) {
+		q, _ := newTestQueue(t, newMemStore(), jobs.Config{})
+		_, err := q.Cancel(ctx, ulid.Make())
+		errutil.AssertErrorCode(t, err, "JOB_NOT_FOUND")
+	})
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, clock := newTestQueue(t, store, jobs.Config{})
+	require.NoError(t, q.Register("export", echoHandler("ok")))
+	first, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+	_, err = q.RunOnce(ctx)
+	require.NoError(t, err)
+	clock.Advance(time.Second)
+	second, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+
+	all, err := q.List(ctx, jobs.ListFilter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, second.ID, all[0].ID, "newest first")
+
+	active, err := q.List(ctx, jobs.ListFilter{Statuses: []jobs.Status{jobs.StatusQueued, jobs.StatusRunning}})
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, second.ID, active[0].ID)
+	assert.NotEqual(t, first.ID, active[0].ID)
+
+	_, err = q.List(ctx, jobs.ListFilter{Limit: jobs.MaxListLimit + 1})
+	errutil.AssertErrorCode(t, err, "JOB_INVALID")
+}
+
+func TestMaintain(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, clock := newTestQueue(t, store, jobs.Config{StaleAfter: time.Minute, MaxAttempts: 2, Retention: time.Hour})
+	pub := &fakePublisher{}
+	q.SetPublisher(pub, mainGameID)
+
+	now := clock.Now()
+	stale := now.Add(-2 * time.Minute)
+	old := now.Add(-2 * time.Hour)
+	exhausted := jobs.Job{ID: ulid.Make(), Kind: "export", Status: jobs.StatusRunning, Attempts: 2, HeartbeatAt: &stale, SubmittedBy: submitter}
+	retryable := jobs.Job{ID: ulid.Make(), Kind: "export", Status: jobs.StatusRunning, Attempts: 1, HeartbeatAt: &stale}
+	expired := jobs.Job{ID: ulid.Make(), Kind: "export", Status: jobs.StatusSucceeded, FinishedAt: &old}
+	for _, job := range []jobs.Job{exhausted, retryable, expired} {
+		require.NoError(t, store.Create(ctx, job))
+	}
+
+	require.NoError(t, q.Maintain(ctx))
+
+	assert.Equal(t, jobs.StatusFailed, store.get(t, exhausted.ID).Status)
+	assert.Equal(t, jobs.StatusRunning, store.get(t, retryable.ID).Status, "left for a worker to reclaim")
+	_, err := store.Get(ctx, expired.ID)
+	errutil.AssertErrorCode(t, err, "JOB_NOT_FOUND")
+	require.Len(t, pub.events(), 1, "the abandoned job's submitter is told")
+}
+
+func TestRunProcessesSubmittedJobs(t *testing.T) {
+	store := newMemStore()
+	q := jobs.NewQueue(jobs.Config{PollInterval: time.Hour}, store)
+	require.NoError(t, q.Register("export", echoHandler("ok")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		q.Run(ctx)
+	}()
+
+	job, err := q.Submit(ctx, jobs.SubmitRequest{Kind: "export"})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return store.get(t, job.ID).Status == jobs.StatusSucceeded
+	}, 5*time.Second, time.Millisecond, "submission wakes an idle worker")
+
+	cancel()
+	<-done
+}
+
+func TestFinishedText(t *testing.T) {
+	id := ulid.MustParse("01HZ0000000000000000000009")
+	tests := []struct {
+		name string
+		job  jobs.Job
+		want string
+	}{
+		{"succeeded with result", jobs.Job{ID: id, Kind: "export", Status: jobs.StatusSucceeded, Result: "done\nmore"}, "Job 01HZ0000000000000000000009 (export) succeeded: done"},
+		{"succeeded without result", jobs.Job{ID: id, Kind: "export", Status: jobs.StatusSucceeded}, "Job 01HZ0000000000000000000009 (export) succeeded."},
+		{"failed", jobs.Job{ID: id, Kind: "export", Status: jobs.StatusFailed, Error: "disk full"}, "Job 01HZ0000000000000000000009 (export) failed: disk full"},
+		{"cancelled", jobs.Job{ID: id, Kind: "export", Status: jobs.StatusCancelled}, "Job 01HZ0000000000000000000009 (export) was cancelled."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, jobs.FinishedText(tt.job))
+		})
+	}
+}
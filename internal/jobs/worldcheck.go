@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// KindWorldCheck is the built-in job that scans the world for consistency
+// issues. It only reports: repairs move and delete world data, so they stay
+// with the offline `holomush fsck --repair`.
+const KindWorldCheck = "world_check"
+
+// maxReportedIssues bounds the issue lines in a world check result.
+const maxReportedIssues = 50
+
+// WorldChecker scans the world for consistency issues. *world.Checker
+// satisfies it.
+type WorldChecker interface {
+	Check(ctx context.Context, opts world.CheckOptions) (*world.CheckResult, error)
+}
+
+// worldCheckHandler runs KindWorldCheck jobs.
+type worldCheckHandler struct {
+	checker WorldChecker
+}
+
+// NewWorldCheckHandler returns the handler for KindWorldCheck jobs.
+func NewWorldCheckHandler(checker WorldChecker) Handler {
+	return &worldCheckHandler{checker: checker}
+}
+
+// ValidateParams rejects params: a world check takes none.
+func (h *worldCheckHandler) ValidateParams(params json.RawMessage) error {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(params, &obj); err != nil {
+		return oops.Wrap(err)
+	}
+	if len(obj) > 0 {
+		return oops.Errorf("%s takes no params", KindWorldCheck)
+	}
+	return nil
+}
+
+// Run scans the world and summarizes the issues found.
+func (h *worldCheckHandler) Run(ctx context.Context, _ Job, progress Progress) (string, error) {
+	progress.Report(0, "scanning world")
+	result, err := h.checker.Check(ctx, world.CheckOptions{})
+	if err != nil {
+		return "", err
+	}
+	return worldCheckSummary(result), nil
+}
+
+func worldCheckSummary(result *world.CheckResult) string {
+	if result.Clean() {
+		return "no consistency issues found"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d consistency issue(s) found; run `holomush fsck --repair` to repair", len(result.Issues))
+	for i, issue := range result.Issues {
+		if i == maxReportedIssues {
+			fmt.Fprintf(&b, "\n... and %d more", len(result.Issues)-maxReportedIssues)
+			break
+		}
+		fmt.Fprintf(&b, "\n%s: %s", issue.Kind, issue.Detail)
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package jobs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/jobs"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// fakeChecker returns a canned world check result.
+type fakeChecker struct {
+	result *world.CheckResult
+	err    error
+	opts   []world.CheckOptions
+}
+
+func (f *fakeChecker) Check(_ context.Context, opts world.CheckOptions) (*world.CheckResult, error) {
+	f.opts = append(f.opts, opts)
+	return f.result, f.err
+}
+
+// nopProgress discards progress reports.
+type nopProgress struct{}
+
+func (nopProgress) Report(int, string) {}
+
+func TestWorldCheckHandler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports a clean world without repairing", func(t *testing.T) {
+		checker := &fakeChecker{result: &world.CheckResult{}}
+		result, err := jobs.NewWorldCheckHandler(checker).Run(ctx, jobs.Job{}, nopProgress{})
+		require.NoError(t, err)
+		assert.Equal(t, "no consistency issues found", result)
+		require.Len(t, checker.opts, 1)
+		assert.False(t, checker.opts[0].Repair)
+	})
+
+	t.Run("lists the issues found", func(t *testing.T) {
+		checker := &fakeChecker{result: &world.CheckResult{Issues: []world.Issue{
+			{Kind: world.IssueOrphanedExit, EntityID: ulid.Make(), Detail: "exit A references missing location B"},
+		}}}
+		result, err := jobs.NewWorldCheckHandler(checker).Run(ctx, jobs.Job{}, nopProgress{})
+		require.NoError(t, err)
+		assert.Equal(t, "1 consistency issue(s) found; run `holomush fsck --repair` to repair\n"+
+			"orphaned_exit: exit A references missing location B", result)
+	})
+
+	t.Run("caps the issues listed", func(t *testing.T) {
+		issues := make([]world.Issue, 60)
+		for i := range issues {
+			issues[i] = world.Issue{Kind: world.IssueOrphanedProperty, Detail: fmt.Sprintf("property %d", i)}
+		}
+		checker := &fakeChecker{result: &world.CheckResult{Issues: issues}}
+		result, err := jobs.NewWorldCheckHandler(checker).Run(ctx, jobs.Job{}, nopProgress{})
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(result, "\n... and 10 more"), result)
+	})
+
+	t.Run("returns scan failures", func(t *testing.T) {
+		checker := &fakeChecker{err: errors.New("db down")}
+		_, err := jobs.NewWorldCheckHandler(checker).Run(ctx, jobs.Job{}, nopProgress{})
+		require.Error(t, err)
+	})
+
+	t.Run("takes no params", func(t *testing.T) {
+		h, ok := jobs.NewWorldCheckHandler(&fakeChecker{}).(jobs.ParamsValidator)
+		require.True(t, ok)
+		require.NoError(t, h.ValidateParams(json.RawMessage(`{}`)))
+		require.Error(t, h.ValidateParams(json.RawMessage(`{"repair":true}`)))
+	})
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import "github.com/holomush/holomush/pkg/holo"
+
+// Message IDs for the host text around a notice.
+const (
+	msgHeaderMessage       = "motd.header.message"
+	msgHeaderAnnouncements = "motd.header.announcements"
+	msgUpdated             = "motd.updated"
+	msgUpdatedUnread       = "motd.updated_unread"
+	msgAnnouncement        = "motd.announcement"
+	msgAckHint             = "motd.ack_hint"
+)
+
+// Messages is the catalog the host text of notices and announcements is
+// rendered from. It ships with English; translations are added with
+// Messages.Add and picked by each player's locale preference.
+var Messages = newMessages()
+
+func newMessages() *holo.Catalog {
+	c := holo.NewCatalog(holo.DefaultLocale)
+	if err := c.Add(holo.DefaultLocale, map[string]string{
+		msgHeaderMessage:       "Message of the Day",
+		msgHeaderAnnouncements: "Announcements",
+		msgUpdated:             "Updated {date}",
+		msgUpdatedUnread:       "Updated {date} - new since your last visit",
+		msgAnnouncement:        "Announcement: {body}",
+		msgAckHint:             " (acknowledge with: motd ack {id})",
+	}); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// msg renders id from Messages for locale.
+func msg(locale, id string, args holo.Args) holo.StyledText {
+	return holo.Fmt.Msg(Messages, locale, id, args)
+}
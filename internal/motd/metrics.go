@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// announcementDeliveries counts announcements delivered, by channel:
+// "broadcast" when one goes out to everyone online as it starts, "login"
+// each time one is shown in a player's login notice.
+var announcementDeliveries = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "holomush_motd_announcement_deliveries_total",
+		Help: "Total announcements delivered, by channel (broadcast or login)",
+	},
+	[]string{"channel"},
+)
+
+// announcementAcks counts players' first acknowledgments of announcements
+// that require one.
+var announcementAcks = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "holomush_motd_announcement_acks_total",
+		Help: "Total acknowledgments of announcements that require one",
+	},
+)
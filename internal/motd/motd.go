@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package motd serves the text players meet around login: the connect
+// screens a gateway shows before login, the message of the day shown after
+// it, and scheduled announcements. Staff edit all three with the motd
+// command.
+//
+// Connect screens and the message of the day live in the content store
+// under the "motd." key prefix, so gateways read them through the
+// ContentService before a player has authenticated. Each item carries its
+// ANSI and plain renderings in metadata, leaving the gateways no format
+// codes to parse. Announcements, the per-player "seen" markers that decide
+// whether the message is new to a player, and players' acknowledgments of
+// announcements that require one live in their own tables.
+package motd
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Content-store keys.
+const (
+	// ScreenKeyPrefix prefixes the key of every connect screen; the screen
+	// name follows it (e.g. "motd.screen.default").
+	ScreenKeyPrefix = "motd.screen."
+	// MessageKey is the key of the message of the day.
+	MessageKey = "motd.message"
+)
+
+// Metadata keys written with every screen and message.
+const (
+	// MetaANSI holds the body rendered with ANSI styles, for telnet.
+	MetaANSI = "ansi"
+	// MetaText holds the body rendered as plain text, for the web client.
+	MetaText = "text"
+	// MetaUpdatedBy holds the subject that last saved the item.
+	MetaUpdatedBy = "updated_by"
+)
+
+// Limits and defaults.
+const (
+	// MaxBodyLength bounds screen, message, and announcement bodies in bytes.
+	MaxBodyLength = 8 * 1024
+	// DefaultAnnouncementDuration is how long an announcement runs when no
+	// end is given.
+	DefaultAnnouncementDuration = 24 * time.Hour
+	// DefaultAckAnnouncementDuration is how long an announcement that
+	// requires acknowledgment runs when no end is given, long enough for
+	// occasional players to log in and see it.
+	DefaultAckAnnouncementDuration = 30 * 24 * time.Hour
+	// MaxAnnouncementDuration bounds how long one announcement may run.
+	MaxAnnouncementDuration = 90 * 24 * time.Hour
+)
+
+// ErrNotFound is returned when a screen or announcement does not exist.
+var ErrNotFound = errors.New("motd item not found")
+
+// screenNamePattern restricts screen names to short identifiers staff can
+// type.
+var screenNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// Screen is one connect screen. A gateway shows one of them, picked at
+// random, when a connection opens.
+type Screen struct {
+	Name string
+	// Body is the screen text as staff wrote it, %x format codes included.
+	Body      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Message is the message of the day.
+type Message struct {
+	// Body is the message as staff wrote it, %x format codes included.
+	Body      string
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Announcement is a message shown for a window of time: broadcast to
+// everyone online when it starts, then included in the login notice until
+// it ends.
+type Announcement struct {
+	ID        ulid.ULID
+	Body      string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy string
+	CreatedAt time.Time
+	// RequiresAck asks every player to acknowledge the announcement, as for
+	// a policy change. Once a player has, it leaves their login notice.
+	RequiresAck bool
+	// AnnouncedAt is when the start broadcast went out; nil until then.
+	AnnouncedAt *time.Time
+}
+
+// ActiveAt reports whether the announcement runs at t.
+func (a *Announcement) ActiveAt(t time.Time) bool {
+	return !t.Before(a.StartsAt) && t.Before(a.EndsAt)
+}
+
+// Notice is what a player sees after logging in.
+type Notice struct {
+	// Message is the message of the day; nil when none is set.
+	Message *Message
+	// Unread reports that Message changed since the player last saw it.
+	Unread bool
+	// Announcements are the announcements running now, soonest-ending
+	// first. A player's notice leaves out those they have acknowledged.
+	Announcements []*Announcement
+}
+
+// Empty reports whether the notice has nothing to show.
+func (n *Notice) Empty() bool {
+	return n.Message == nil && len(n.Announcements) == 0
+}
+
+// NormalizeScreenName lower-cases and trims a screen name.
+func NormalizeScreenName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// ValidateScreenName checks a normalized screen name. Errors carry oops code
+// MOTD_INVALID.
+func ValidateScreenName(name string) error {
+	if !screenNamePattern.MatchString(name) {
+		return oops.Code("MOTD_INVALID").
+			With("screen", name).
+			Errorf("screen name must be 1-32 lowercase letters, digits, '-' or '_'")
+	}
+	return nil
+}
+
+// validateBody checks a screen, message, or announcement body. Errors carry
+// oops code MOTD_INVALID.
+func validateBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return oops.Code("MOTD_INVALID").Errorf("text is required")
+	}
+	if len(body) > MaxBodyLength {
+		return oops.Code("MOTD_INVALID").
+			With("length", len(body)).
+			Errorf("text exceeds %d bytes", MaxBodyLength)
+	}
+	if !utf8.ValidString(body) {
+		return oops.Code("MOTD_INVALID").Errorf("text must be valid UTF-8")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestValidateScreenName(t *testing.T) {
+	for _, name := range []string{"default", "a", "winter-2026", "x_1"} {
+		assert.NoError(t, ValidateScreenName(name), name)
+	}
+	for _, name := range []string{"", "-lead", "Upper", "has space", strings.Repeat("a", 33)} {
+		errutil.AssertErrorCode(t, ValidateScreenName(name), "MOTD_INVALID")
+	}
+	assert.Equal(t, "winter", NormalizeScreenName("  Winter "))
+}
+
+func TestValidateBody(t *testing.T) {
+	assert.NoError(t, validateBody("Welcome!"))
+	errutil.AssertErrorCode(t, validateBody("  \n"), "MOTD_INVALID")
+	errutil.AssertErrorCode(t, validateBody(strings.Repeat("a", MaxBodyLength+1)), "MOTD_INVALID")
+	errutil.AssertErrorCode(t, validateBody("bad \xff"), "MOTD_INVALID")
+}
+
+func TestAnnouncementActiveAt(t *testing.T) {
+	start := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	a := &Announcement{StartsAt: start, EndsAt: start.Add(time.Hour)}
+
+	assert.False(t, a.ActiveAt(start.Add(-time.Second)))
+	assert.True(t, a.ActiveAt(start))
+	assert.True(t, a.ActiveAt(start.Add(59*time.Minute)))
+	assert.False(t, a.ActiveAt(start.Add(time.Hour)))
+}
+
+func TestNoticeEmpty(t *testing.T) {
+	assert.True(t, (&Notice{}).Empty())
+	assert.False(t, (&Notice{Message: &Message{Body: "hi"}}).Empty())
+	assert.False(t, (&Notice{Announcements: []*Announcement{{Body: "hi"}}}).Empty())
+}
@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const announcementColumns = `id, body, starts_at, ends_at, created_by, created_at, announced_at, requires_ack`
+
+// PostgresStore implements Repository against the motd_seen,
+// motd_announcements, and motd_announcement_acks tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// SeenAt returns when the player last saw the message of the day.
+func (s *PostgresStore) SeenAt(ctx context.Context, playerID ulid.ULID) (time.Time, bool, error) {
+	var seenAt pgnanos.Time
+	err := s.pool.QueryRow(ctx, `SELECT seen_at FROM motd_seen WHERE player_id = $1`,
+		playerID.String()).Scan(&seenAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, oops.Code("MOTD_STORE_FAILED").
+			With("operation", "seen_at").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return seenAt.Time(), true, nil
+}
+
+// MarkSeen records that the player saw the message of the day at at.
+func (s *PostgresStore) MarkSeen(ctx context.Context, playerID ulid.ULID, at time.Time) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO motd_seen (player_id, seen_at)
+		VALUES ($1, $2)
+		ON CONFLICT (player_id) DO UPDATE SET seen_at = EXCLUDED.seen_at
+	`, playerID.String(), pgnanos.From(at))
+	if err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "mark_seen").
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// CreateAnnouncement inserts a.
+func (s *PostgresStore) CreateAnnouncement(ctx context.Context, a *Announcement) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO motd_announcements (id, body, starts_at, ends_at, created_by, created_at, requires_ack)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, a.ID.String(), a.Body, pgnanos.From(a.StartsAt), pgnanos.From(a.EndsAt),
+		a.CreatedBy, pgnanos.From(a.CreatedAt), a.RequiresAck)
+	if err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "create_announcement").
+			With("announcement_id", a.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// ListAnnouncements returns the announcements that have not ended by now.
+func (s *PostgresStore) ListAnnouncements(ctx context.Context, now time.Time) ([]*Announcement, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+announcementColumns+`
+		  FROM motd_announcements
+		 WHERE ends_at > $1
+		 ORDER BY starts_at, id
+	`, pgnanos.From(now))
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "list_announcements").Wrap(err)
+	}
+	return collectAnnouncements(rows, "list_announcements")
+}
+
+// DeleteAnnouncement removes the announcement with id.
+func (s *PostgresStore) DeleteAnnouncement(ctx context.Context, id ulid.ULID) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM motd_announcements WHERE id = $1`, id.String())
+	if err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "delete_announcement").
+			With("announcement_id", id.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("MOTD_NOT_FOUND").
+			With("announcement_id", id.String()).
+			Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+// ClaimDue stamps and returns the running announcements not yet announced.
+func (s *PostgresStore) ClaimDue(ctx context.Context, now time.Time) ([]*Announcement, error) {
+	rows, err := s.pool.Query(ctx, `
+		UPDATE motd_announcements
+		   SET announced_at = $1
+		 WHERE announced_at IS NULL
+		   AND starts_at <= $1
+		   AND ends_at > $1
+		RETURNING `+announcementColumns, pgnanos.From(now))
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "claim_due").Wrap(err)
+	}
+	return collectAnnouncements(rows, "claim_due")
+}
+
+// Acknowledge records the player's acknowledgment of an announcement.
+func (s *PostgresStore) Acknowledge(ctx context.Context, announcementID, playerID ulid.ULID, at time.Time) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO motd_announcement_acks (announcement_id, player_id, acked_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (announcement_id, player_id) DO NOTHING
+	`, announcementID.String(), playerID.String(), pgnanos.From(at))
+	if err != nil {
+		return false, oops.Code("MOTD_STORE_FAILED").
+			With("operation", "acknowledge").
+			With("announcement_id", announcementID.String()).
+			With("player_id", playerID.String()).
+			Wrap(err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Acknowledged returns the IDs among ids the player has acknowledged.
+func (s *PostgresStore) Acknowledged(ctx context.Context, playerID ulid.ULID, ids []ulid.ULID) (map[ulid.ULID]bool, error) {
+	out := map[ulid.ULID]bool{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := s.pool.Query(ctx, `
+		SELECT announcement_id
+		  FROM motd_announcement_acks
+		 WHERE player_id = $1 AND announcement_id = ANY($2)
+	`, playerID.String(), idStrings(ids))
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "acknowledged").Wrap(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var raw string
+		if err := rows.Scan(&raw); err != nil {
+			return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "acknowledged").Wrap(err)
+		}
+		id, err := ulid.Parse(raw)
+		if err != nil {
+			return nil, oops.Code("MOTD_STORE_FAILED").With("announcement_id", raw).Wrap(err)
+		}
+		out[id] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "acknowledged").Wrap(err)
+	}
+	return out, nil
+}
+
+// AckCounts returns how many players acknowledged each of ids.
+func (s *PostgresStore) AckCounts(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error) {
+	out := map[ulid.ULID]int{}
+	if len(ids) == 0 {
+		return out, nil
+	}
+	rows, err := s.pool.Query(ctx, `
+		SELECT announcement_id, count(*)
+		  FROM motd_announcement_acks
+		 WHERE announcement_id = ANY($1)
+		 GROUP BY announcement_id
+	`, idStrings(ids))
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "ack_counts").Wrap(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			raw   string
+			count int
+		)
+		if err := rows.Scan(&raw, &count); err != nil {
+			return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "ack_counts").Wrap(err)
+		}
+		id, err := ulid.Parse(raw)
+		if err != nil {
+			return nil, oops.Code("MOTD_STORE_FAILED").With("announcement_id", raw).Wrap(err)
+		}
+		out[id] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "ack_counts").Wrap(err)
+	}
+	return out, nil
+}
+
+func idStrings(ids []ulid.ULID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = id.String()
+	}
+	return out
+}
+
+func collectAnnouncements(rows pgx.Rows, operation string) ([]*Announcement, error) {
+	defer rows.Close()
+	var out []*Announcement
+	for rows.Next() {
+		a, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, oops.Code("MOTD_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		out = append(out, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return out, nil
+}
+
+func scanAnnouncement(row pgx.Row) (*Announcement, error) {
+	var (
+		a           Announcement
+		id          string
+		startsAt    pgnanos.Time
+		endsAt      pgnanos.Time
+		createdAt   pgnanos.Time
+		announcedAt *pgnanos.Time
+	)
+	if err := row.Scan(&id, &a.Body, &startsAt, &endsAt, &a.CreatedBy,
+		&createdAt, &announcedAt, &a.RequiresAck); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("announcement_id", id).Wrap(err)
+	}
+	a.ID = parsed
+	a.StartsAt = startsAt.Time()
+	a.EndsAt = endsAt.Time()
+	a.CreatedAt = createdAt.Time()
+	if announcedAt != nil {
+		t := announcedAt.Time()
+		a.AnnouncedAt = &t
+	}
+	return &a, nil
+}
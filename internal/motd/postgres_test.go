@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package motd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/motd"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createPlayer(t *testing.T, pool *pgxpool.Pool) ulid.ULID {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO players (id, username, password_hash) VALUES ($1, $2, 'x')`,
+		id.String(), "motd-"+id.String())
+	require.NoError(t, err)
+	return id
+}
+
+func TestPostgresStoreSeenMarkers(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := motd.NewPostgresStore(pool)
+	playerID := createPlayer(t, pool)
+
+	_, seen, err := st.SeenAt(ctx, playerID)
+	require.NoError(t, err)
+	assert.False(t, seen)
+
+	first := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, st.MarkSeen(ctx, playerID, first))
+	second := first.Add(time.Hour)
+	require.NoError(t, st.MarkSeen(ctx, playerID, second))
+
+	got, seen, err := st.SeenAt(ctx, playerID)
+	require.NoError(t, err)
+	assert.True(t, seen)
+	assert.True(t, second.Equal(got))
+}
+
+func TestPostgresStoreAnnouncements(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := motd.NewPostgresStore(pool)
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	running := &motd.Announcement{ID: idgen.New(), Body: "running", StartsAt: now.Add(-time.Minute),
+		EndsAt: now.Add(time.Hour), CreatedBy: "character:test", CreatedAt: now}
+	later := &motd.Announcement{ID: idgen.New(), Body: "later", StartsAt: now.Add(time.Hour),
+		EndsAt: now.Add(2 * time.Hour), CreatedBy: "character:test", CreatedAt: now}
+	over := &motd.Announcement{ID: idgen.New(), Body: "over", StartsAt: now.Add(-2 * time.Hour),
+		EndsAt: now.Add(-time.Hour), CreatedBy: "character:test", CreatedAt: now}
+	for _, a := range []*motd.Announcement{running, later, over} {
+		require.NoError(t, st.CreateAnnouncement(ctx, a))
+	}
+
+	list, err := st.ListAnnouncements(ctx, now)
+	require.NoError(t, err)
+	var ids []ulid.ULID
+	for _, a := range list {
+		ids = append(ids, a.ID)
+	}
+	assert.Contains(t, ids, running.ID)
+	assert.Contains(t, ids, later.ID)
+	assert.NotContains(t, ids, over.ID)
+
+	claimed, err := st.ClaimDue(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, running.ID, claimed[0].ID)
+	require.NotNil(t, claimed[0].AnnouncedAt)
+
+	claimed, err = st.ClaimDue(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "an announcement is claimed once")
+
+	require.NoError(t, st.DeleteAnnouncement(ctx, later.ID))
+	err = st.DeleteAnnouncement(ctx, later.ID)
+	errutil.AssertErrorCode(t, err, "MOTD_NOT_FOUND")
+	assert.ErrorIs(t, err, motd.ErrNotFound)
+}
+
+func TestPostgresStoreAcknowledgments(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := motd.NewPostgresStore(pool)
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	alice, bob := createPlayer(t, pool), createPlayer(t, pool)
+
+	policy := &motd.Announcement{ID: idgen.New(), Body: "policy", StartsAt: now,
+		EndsAt: now.Add(time.Hour), CreatedBy: "character:test", CreatedAt: now, RequiresAck: true}
+	require.NoError(t, st.CreateAnnouncement(ctx, policy))
+
+	list, err := st.ListAnnouncements(ctx, now)
+	require.NoError(t, err)
+	for _, a := range list {
+		if a.ID == policy.ID {
+			assert.True(t, a.RequiresAck)
+		}
+	}
+
+	added, err := st.Acknowledge(ctx, policy.ID, alice, now)
+	require.NoError(t, err)
+	assert.True(t, added)
+	added, err = st.Acknowledge(ctx, policy.ID, alice, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, added, "a second acknowledgment is a no-op")
+
+	acked, err := st.Acknowledged(ctx, alice, []ulid.ULID{policy.ID})
+	require.NoError(t, err)
+	assert.True(t, acked[policy.ID])
+	acked, err = st.Acknowledged(ctx, bob, []ulid.ULID{policy.ID})
+	require.NoError(t, err)
+	assert.False(t, acked[policy.ID])
+
+	_, err = st.Acknowledge(ctx, policy.ID, bob, now)
+	require.NoError(t, err)
+	counts, err := st.AckCounts(ctx, []ulid.ULID{policy.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2, counts[policy.ID])
+
+	require.NoError(t, st.DeleteAnnouncement(ctx, policy.ID))
+	counts, err = st.AckCounts(ctx, []ulid.ULID{policy.ID})
+	require.NoError(t, err)
+	assert.Empty(t, counts, "acknowledgments go with their announcement")
+}
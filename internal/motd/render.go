@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/pkg/holo"
+)
+
+// sgrPattern matches the SGR escape sequences holo.Fmt.Parse writes for %x
+// codes.
+var sgrPattern = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// parseBody converts a body's %x format codes into styled text.
+func parseBody(body string) holo.StyledText {
+	return holo.Fmt.Parse(strings.TrimRight(body, "\n"))
+}
+
+// plain renders st as plain text. holo.Fmt.Parse emits format codes as raw
+// escape sequences, which RenderPlain keeps, so they are stripped here.
+func plain(st holo.StyledText) string {
+	return sgrPattern.ReplaceAllString(st.RenderPlain(), "")
+}
+
+// text renders catalog message id for locale as plain text.
+func text(locale, id string, args holo.Args) string {
+	return plain(msg(locale, id, args))
+}
+
+// AnnouncementText is the plain text broadcast when an announcement starts.
+// A broadcast goes to every player at once, so it is rendered in the
+// catalog's fallback locale.
+func AnnouncementText(a *Announcement) string {
+	locale := Messages.FallbackLocale()
+	return text(locale, msgAnnouncement, holo.Args{"body": plain(parseBody(a.Body))}) + ackHint(locale, a)
+}
+
+// ackHint tells players how to acknowledge a that asks for it.
+func ackHint(locale string, a *Announcement) string {
+	if !a.RequiresAck {
+		return ""
+	}
+	return text(locale, msgAckHint, holo.Args{"id": a.ID.String()})
+}
+
+// RenderNotice formats a login notice for display in locale: the message of
+// the day under a bold header with a dim line saying when it changed, then
+// any running announcements as a list.
+func RenderNotice(n *Notice, locale string) holo.StyledText {
+	var out holo.StyledText
+	if n.Message != nil {
+		updated := msgUpdated
+		if n.Unread {
+			updated = msgUpdatedUnread
+		}
+		footer := text(locale, updated, holo.Args{"date": n.Message.UpdatedAt.UTC().Format("2006-01-02")})
+		out = out.Append(holo.Fmt.Header(text(locale, msgHeaderMessage, nil))).
+			AppendText("\n\n").
+			Append(parseBody(n.Message.Body)).
+			AppendText("\n\n").
+			Append(holo.Fmt.Dim(footer))
+	}
+	if len(n.Announcements) > 0 {
+		if n.Message != nil {
+			out = out.AppendText("\n\n")
+		}
+		items := make([]string, 0, len(n.Announcements))
+		for _, a := range n.Announcements {
+			items = append(items, parseBody(a.Body).RenderPlain()+ackHint(locale, a))
+		}
+		out = out.Append(holo.Fmt.Header(text(locale, msgHeaderAnnouncements, nil))).
+			AppendText("\n").
+			Append(holo.Fmt.List(items))
+	}
+	return out
+}
+
+// NoticeText renders a notice as plain text in locale, for command output.
+func NoticeText(n *Notice, locale string) string {
+	return plain(RenderNotice(n, locale))
+}
+
+// BuildPayload builds the motd event payload for a login notice, carrying
+// both renderings of the whole notice in locale plus its parts for clients
+// that lay it out themselves.
+func BuildPayload(n *Notice, locale string) eventvocab.MOTDPayload {
+	rendered := RenderNotice(n, locale)
+	p := eventvocab.MOTDPayload{
+		Text:   plain(rendered),
+		ANSI:   rendered.RenderANSI(),
+		Unread: n.Unread,
+	}
+	if n.Message != nil {
+		p.Body = plain(parseBody(n.Message.Body))
+		p.UpdatedAt = unixMilli(n.Message.UpdatedAt)
+	}
+	for _, a := range n.Announcements {
+		p.Announcements = append(p.Announcements, eventvocab.MOTDAnnouncement{
+			ID:          a.ID.String(),
+			Body:        plain(parseBody(a.Body)),
+			StartsAt:    unixMilli(a.StartsAt),
+			EndsAt:      unixMilli(a.EndsAt),
+			RequiresAck: a.RequiresAck,
+		})
+	}
+	return p
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
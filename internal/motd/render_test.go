@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderNotice(t *testing.T) {
+	updated := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	n := &Notice{
+		Message:       &Message{Body: "Welcome %xhback%xn!\n", UpdatedAt: updated},
+		Unread:        true,
+		Announcements: []*Announcement{{Body: "Reboot at %xrnoon%xn"}},
+	}
+
+	text := plain(RenderNotice(n, "en"))
+	assert.Equal(t, "Message of the Day\n\nWelcome back!\n\n"+
+		"Updated 2026-06-01 - new since your last visit\n\n"+
+		"Announcements\n  - Reboot at noon", text)
+
+	ansi := RenderNotice(n, "en").RenderANSI()
+	assert.Contains(t, ansi, "\x1b[1mMessage of the Day")
+	assert.Contains(t, ansi, "\x1b[31mnoon")
+}
+
+func TestRenderNoticeAnnouncementsOnly(t *testing.T) {
+	n := &Notice{Announcements: []*Announcement{{Body: "Reboot"}}}
+	assert.Equal(t, "Announcements\n  - Reboot", NoticeText(n, "en"))
+}
+
+// withGermanMessages swaps in a catalog with a German translation for the
+// length of the test.
+func withGermanMessages(t *testing.T) {
+	t.Helper()
+	orig := Messages
+	Messages = newMessages()
+	require.NoError(t, Messages.Add("de", map[string]string{
+		msgHeaderMessage:       "Nachricht des Tages",
+		msgHeaderAnnouncements: "Ankündigungen",
+		msgUpdatedUnread:       "Aktualisiert am {date} - neu seit Ihrem letzten Besuch",
+		msgAckHint:             " (bestätigen mit: motd ack {id})",
+	}))
+	t.Cleanup(func() { Messages = orig })
+}
+
+func TestRenderNoticeUsesLocale(t *testing.T) {
+	withGermanMessages(t)
+	id := ulid.Make()
+	n := &Notice{
+		Message:       &Message{Body: "Willkommen!", UpdatedAt: time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)},
+		Unread:        true,
+		Announcements: []*Announcement{{ID: id, Body: "Neustart", RequiresAck: true}},
+	}
+
+	assert.Equal(t, "Nachricht des Tages\n\nWillkommen!\n\n"+
+		"Aktualisiert am 2026-06-01 - neu seit Ihrem letzten Besuch\n\n"+
+		"Ankündigungen\n  - Neustart (bestätigen mit: motd ack "+id.String()+")",
+		NoticeText(n, "de-AT"), "a regional locale falls back to its language")
+
+	n.Unread = false
+	assert.Contains(t, NoticeText(n, "de"), "Updated 2026-06-01",
+		"an untranslated message falls back to English")
+	assert.Contains(t, NoticeText(n, "fr"), "Message of the Day")
+}
+
+func TestBuildPayload(t *testing.T) {
+	updated := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	start := updated.Add(time.Hour)
+	n := &Notice{
+		Message: &Message{Body: "%xgHi%xn", UpdatedAt: updated},
+		Announcements: []*Announcement{
+			{Body: "Reboot", StartsAt: start, EndsAt: start.Add(time.Hour)},
+		},
+	}
+
+	p := BuildPayload(n, "en")
+	assert.Equal(t, "Hi", p.Body)
+	assert.Equal(t, updated.UnixMilli(), p.UpdatedAt)
+	assert.False(t, p.Unread)
+	assert.Contains(t, p.Text, "Message of the Day")
+	assert.NotContains(t, p.Text, "\x1b[")
+	assert.Contains(t, p.ANSI, "\x1b[32mHi")
+	assert.Len(t, p.Announcements, 1)
+	assert.Equal(t, "Reboot", p.Announcements[0].Body)
+	assert.Equal(t, start.UnixMilli(), p.Announcements[0].StartsAt)
+	assert.False(t, p.Announcements[0].RequiresAck)
+}
+
+func TestAnnouncementText(t *testing.T) {
+	assert.Equal(t, "Announcement: Reboot at noon", AnnouncementText(&Announcement{Body: "Reboot at %xhnoon%xn"}))
+
+	id := ulid.Make()
+	assert.Equal(t, "Announcement: New rules (acknowledge with: motd ack "+id.String()+")",
+		AnnouncementText(&Announcement{ID: id, Body: "New rules", RequiresAck: true}))
+}
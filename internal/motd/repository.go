@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists announcements, the per-player markers recording when
+// each player last saw the message of the day, and players' acknowledgments
+// of announcements. Screens and the message itself live in the content
+// store.
+type Repository interface {
+	// SeenAt returns when the player last saw the message of the day, and
+	// false when it never has.
+	SeenAt(ctx context.Context, playerID ulid.ULID) (time.Time, bool, error)
+	// MarkSeen records that the player saw the message of the day at at.
+	MarkSeen(ctx context.Context, playerID ulid.ULID, at time.Time) error
+
+	// CreateAnnouncement stores a new announcement.
+	CreateAnnouncement(ctx context.Context, a *Announcement) error
+	// ListAnnouncements returns the announcements that have not ended by
+	// now, running or still to come, ordered by start time.
+	ListAnnouncements(ctx context.Context, now time.Time) ([]*Announcement, error)
+	// DeleteAnnouncement removes an announcement. Returns an error wrapping
+	// ErrNotFound when there is none with that ID.
+	DeleteAnnouncement(ctx context.Context, id ulid.ULID) error
+	// ClaimDue stamps AnnouncedAt on every announcement running at now that
+	// has not been announced, and returns them. The claim is atomic, so each
+	// announcement is returned to exactly one caller across replicas.
+	ClaimDue(ctx context.Context, now time.Time) ([]*Announcement, error)
+
+	// Acknowledge records that the player acknowledged an announcement at
+	// at, and reports false when they already had.
+	Acknowledge(ctx context.Context, announcementID, playerID ulid.ULID, at time.Time) (bool, error)
+	// Acknowledged returns the IDs among ids the player has acknowledged.
+	Acknowledged(ctx context.Context, playerID ulid.ULID, ids []ulid.ULID) (map[ulid.ULID]bool, error)
+	// AckCounts returns how many players acknowledged each of ids; IDs
+	// nobody acknowledged are absent.
+	AckCounts(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error)
+}
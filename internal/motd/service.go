@@ -0,0 +1,677 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/content"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/sysbroadcast"
+)
+
+// ABAC actions checked on motd:<area> before anything is changed. The seed
+// policy seed:staff-motd-edit grants both to staff.
+const (
+	ActionWrite  = "write"
+	ActionDelete = "delete"
+)
+
+// Areas named in motd:<area> resource references.
+const (
+	AreaScreen       = "screen"
+	AreaMessage      = "message"
+	AreaAnnouncement = "announcement"
+)
+
+// LocaleSource supplies the message-catalog locale a player reads host text
+// in. *preferences.Service satisfies it.
+type LocaleSource interface {
+	Locale(ctx context.Context, playerID ulid.ULID) string
+}
+
+// TickInterval is how often Run checks for announcements that have started.
+const TickInterval = 30 * time.Second
+
+// Service manages connect screens, the message of the day, and
+// announcements. Reads are not access-checked: everything here is shown to
+// every player. Every edit is written to the structured log as an audit
+// record.
+//
+// The login notice and announcement broadcasts need an event publisher,
+// which is bound after construction with SetPublisher once the event bus is
+// up. Until then PublishLoginNotice and Tick are no-ops. Login notices are
+// rendered in each player's locale once SetLocales binds where to read it;
+// until then they use the catalog's fallback locale.
+type Service struct {
+	repo    Repository
+	content content.Store
+	engine  types.AccessPolicyEngine
+	logger  *slog.Logger
+	now     func() time.Time
+
+	mu      sync.RWMutex
+	pub     eventbus.Publisher
+	gameID  func() string
+	locales LocaleSource
+}
+
+// NewService creates a Service. repo, store, and engine are required; a nil
+// logger uses slog.Default().
+func NewService(repo Repository, store content.Store, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("motd repository is required")
+	}
+	if store == nil {
+		return nil, oops.Errorf("content store is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, content: store, engine: engine, logger: logger, now: time.Now}, nil
+}
+
+// SetPublisher binds the publisher used for login notices and announcement
+// broadcasts. gameID supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+// SetLocales binds where players' locale preferences are read from.
+func (s *Service) SetLocales(locales LocaleSource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.locales = locales
+}
+
+// Locale returns the locale to render host text for playerID in: their
+// preference when SetLocales has bound a source, otherwise the catalog's
+// fallback locale.
+func (s *Service) Locale(ctx context.Context, playerID ulid.ULID) string {
+	s.mu.RLock()
+	locales := s.locales
+	s.mu.RUnlock()
+	if locales == nil {
+		return Messages.FallbackLocale()
+	}
+	return locales.Locale(ctx, playerID)
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// Screens returns every connect screen ordered by name.
+func (s *Service) Screens(ctx context.Context) ([]*Screen, error) {
+	res, err := s.content.List(ctx, ScreenKeyPrefix, content.ListOptions{})
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "list_screens").Wrap(err)
+	}
+	screens := make([]*Screen, 0, len(res.Items))
+	for _, item := range res.Items {
+		screens = append(screens, screenFromItem(item))
+	}
+	sort.Slice(screens, func(i, j int) bool { return screens[i].Name < screens[j].Name })
+	return screens, nil
+}
+
+// Screen returns the connect screen named name. Returns MOTD_NOT_FOUND
+// (wrapping ErrNotFound) when there is none.
+func (s *Service) Screen(ctx context.Context, name string) (*Screen, error) {
+	name = NormalizeScreenName(name)
+	if err := ValidateScreenName(name); err != nil {
+		return nil, err
+	}
+	item, err := s.content.Get(ctx, ScreenKeyPrefix+name)
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").
+			With("operation", "get_screen").
+			With("screen", name).
+			Wrap(err)
+	}
+	if item == nil {
+		return nil, oops.Code("MOTD_NOT_FOUND").With("screen", name).Wrap(ErrNotFound)
+	}
+	return screenFromItem(item), nil
+}
+
+// SaveScreen creates or replaces the connect screen named name on behalf of
+// subject. Returns MOTD_INVALID for a bad name or body and
+// MOTD_ACCESS_DENIED when subject may not edit screens.
+func (s *Service) SaveScreen(ctx context.Context, subject, name, body string) error {
+	name = NormalizeScreenName(name)
+	if err := ValidateScreenName(name); err != nil {
+		return err
+	}
+	if err := validateBody(body); err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, AreaScreen); err != nil {
+		return err
+	}
+	if err := s.putItem(ctx, ScreenKeyPrefix+name, subject, body); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "motd screen saved",
+		"event", "motd_screen_saved",
+		"subject", subject,
+		"screen", name,
+	)
+	return nil
+}
+
+// DeleteScreen removes the connect screen named name on behalf of subject.
+func (s *Service) DeleteScreen(ctx context.Context, subject, name string) error {
+	name = NormalizeScreenName(name)
+	if err := ValidateScreenName(name); err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subject, ActionDelete, AreaScreen); err != nil {
+		return err
+	}
+	if err := s.deleteItem(ctx, ScreenKeyPrefix+name); err != nil {
+		return oops.With("screen", name).Wrap(err)
+	}
+	s.logger.InfoContext(ctx, "motd screen deleted",
+		"event", "motd_screen_deleted",
+		"subject", subject,
+		"screen", name,
+	)
+	return nil
+}
+
+// Message returns the message of the day, or nil when none is set.
+func (s *Service) Message(ctx context.Context) (*Message, error) {
+	item, err := s.content.Get(ctx, MessageKey)
+	if err != nil {
+		return nil, oops.Code("MOTD_STORE_FAILED").With("operation", "get_message").Wrap(err)
+	}
+	if item == nil {
+		return nil, nil
+	}
+	return &Message{
+		Body:      string(item.Body),
+		UpdatedBy: item.Metadata[MetaUpdatedBy],
+		UpdatedAt: item.UpdatedAt,
+	}, nil
+}
+
+// SetMessage replaces the message of the day on behalf of subject. Players
+// who have not seen the new message are told it is new at their next login.
+func (s *Service) SetMessage(ctx context.Context, subject, body string) error {
+	if err := validateBody(body); err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, AreaMessage); err != nil {
+		return err
+	}
+	if err := s.putItem(ctx, MessageKey, subject, body); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "motd message set",
+		"event", "motd_message_set",
+		"subject", subject,
+	)
+	return nil
+}
+
+// ClearMessage removes the message of the day on behalf of subject.
+func (s *Service) ClearMessage(ctx context.Context, subject string) error {
+	if err := s.checkAccess(ctx, subject, ActionDelete, AreaMessage); err != nil {
+		return err
+	}
+	if err := s.deleteItem(ctx, MessageKey); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "motd message cleared",
+		"event", "motd_message_cleared",
+		"subject", subject,
+	)
+	return nil
+}
+
+// Announce schedules an announcement on behalf of subject. A zero startsAt
+// starts it now; a zero endsAt ends it DefaultAnnouncementDuration after it
+// starts, or DefaultAckAnnouncementDuration when requiresAck asks players to
+// acknowledge it. Returns MOTD_INVALID when the window is empty, already
+// over, or longer than MaxAnnouncementDuration.
+func (s *Service) Announce(ctx context.Context, subject, body string, startsAt, endsAt time.Time, requiresAck bool) (*Announcement, error) {
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+	now := s.now().UTC()
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if endsAt.IsZero() {
+		endsAt = startsAt.Add(DefaultAnnouncementDuration)
+		if requiresAck {
+			endsAt = startsAt.Add(DefaultAckAnnouncementDuration)
+		}
+	}
+	switch {
+	case !endsAt.After(startsAt):
+		return nil, oops.Code("MOTD_INVALID").Errorf("announcement must end after it starts")
+	case !endsAt.After(now):
+		return nil, oops.Code("MOTD_INVALID").Errorf("announcement would already be over")
+	case endsAt.Sub(startsAt) > MaxAnnouncementDuration:
+		return nil, oops.Code("MOTD_INVALID").
+			Errorf("announcement may run at most %d days", int(MaxAnnouncementDuration/(24*time.Hour)))
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, AreaAnnouncement); err != nil {
+		return nil, err
+	}
+	a := &Announcement{
+		ID:          idgen.New(),
+		Body:        body,
+		StartsAt:    startsAt.UTC(),
+		EndsAt:      endsAt.UTC(),
+		CreatedBy:   subject,
+		CreatedAt:   now,
+		RequiresAck: requiresAck,
+	}
+	if err := s.repo.CreateAnnouncement(ctx, a); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "motd announcement scheduled",
+		"event", "motd_announcement_scheduled",
+		"subject", subject,
+		"announcement_id", a.ID.String(),
+		"starts_at", a.StartsAt,
+		"ends_at", a.EndsAt,
+		"requires_ack", a.RequiresAck,
+	)
+	return a, nil
+}
+
+// Wall starts an announcement now on behalf of subject and broadcasts it to
+// everyone online at once rather than at the next tick. Players who are
+// offline see it in their login notice until it ends. A failed broadcast is
+// logged, not returned: the announcement is saved and still reaches players
+// at login.
+func (s *Service) Wall(ctx context.Context, subject, body string, endsAt time.Time, requiresAck bool) (*Announcement, error) {
+	a, err := s.Announce(ctx, subject, body, time.Time{}, endsAt, requiresAck)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Tick(ctx); err != nil {
+		s.logger.WarnContext(ctx, "motd wall broadcast failed",
+			"announcement_id", a.ID.String(), "error", err)
+	}
+	return a, nil
+}
+
+// Announcements returns the announcements that have not ended, running or
+// still to come, ordered by start time.
+func (s *Service) Announcements(ctx context.Context) ([]*Announcement, error) {
+	return s.repo.ListAnnouncements(ctx, s.now())
+}
+
+// CancelAnnouncement removes an announcement on behalf of subject. Returns
+// MOTD_NOT_FOUND (wrapping ErrNotFound) when there is none with id.
+func (s *Service) CancelAnnouncement(ctx context.Context, subject string, id ulid.ULID) error {
+	if err := s.checkAccess(ctx, subject, ActionDelete, AreaAnnouncement); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteAnnouncement(ctx, id); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "motd announcement cancelled",
+		"event", "motd_announcement_cancelled",
+		"subject", subject,
+		"announcement_id", id.String(),
+	)
+	return nil
+}
+
+// AckCounts returns how many players acknowledged each of ids; IDs nobody
+// acknowledged are absent.
+func (s *Service) AckCounts(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error) {
+	return s.repo.AckCounts(ctx, ids)
+}
+
+// Acknowledge records that the player acknowledged the running announcement
+// with id. Acknowledging twice is not an error. Returns MOTD_NOT_FOUND
+// (wrapping ErrNotFound) when no announcement with id is running, and
+// MOTD_INVALID when it does not ask for acknowledgment.
+func (s *Service) Acknowledge(ctx context.Context, playerID, id ulid.ULID) error {
+	now := s.now()
+	all, err := s.repo.ListAnnouncements(ctx, now)
+	if err != nil {
+		return err
+	}
+	var a *Announcement
+	for _, candidate := range all {
+		if candidate.ID == id && candidate.ActiveAt(now) {
+			a = candidate
+		}
+	}
+	if a == nil {
+		return oops.Code("MOTD_NOT_FOUND").With("announcement_id", id.String()).Wrap(ErrNotFound)
+	}
+	if !a.RequiresAck {
+		return oops.Code("MOTD_INVALID").
+			With("announcement_id", id.String()).
+			Errorf("announcement %s does not need acknowledging", id)
+	}
+	added, err := s.repo.Acknowledge(ctx, id, playerID, now.UTC())
+	if err != nil {
+		return err
+	}
+	if added {
+		announcementAcks.Inc()
+		s.logger.InfoContext(ctx, "motd announcement acknowledged",
+			"event", "motd_announcement_acknowledged",
+			"player_id", playerID.String(),
+			"announcement_id", id.String(),
+		)
+	}
+	return nil
+}
+
+// Pending returns the running announcements the player still has to
+// acknowledge, soonest-ending first.
+func (s *Service) Pending(ctx context.Context, playerID ulid.ULID) ([]*Announcement, error) {
+	notice, err := s.current(ctx, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dropAcknowledged(ctx, playerID, notice); err != nil {
+		return nil, err
+	}
+	var pending []*Announcement
+	for _, a := range notice.Announcements {
+		if a.RequiresAck {
+			pending = append(pending, a)
+		}
+	}
+	return pending, nil
+}
+
+// dropAcknowledged removes from notice the announcements the player has
+// acknowledged.
+func (s *Service) dropAcknowledged(ctx context.Context, playerID ulid.ULID, notice *Notice) error {
+	var ids []ulid.ULID
+	for _, a := range notice.Announcements {
+		if a.RequiresAck {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	acked, err := s.repo.Acknowledged(ctx, playerID, ids)
+	if err != nil {
+		return err
+	}
+	notice.Announcements = slices.DeleteFunc(notice.Announcements, func(a *Announcement) bool {
+		return acked[a.ID]
+	})
+	return nil
+}
+
+// Current returns the message of the day and the announcements running now,
+// without touching any player's seen marker.
+func (s *Service) Current(ctx context.Context) (*Notice, error) {
+	return s.current(ctx, s.now())
+}
+
+// LoginNotice assembles what the player sees after logging in and records
+// that the player has now seen the current message of the day.
+// Announcements the player has acknowledged are left out.
+func (s *Service) LoginNotice(ctx context.Context, playerID ulid.ULID) (*Notice, error) {
+	now := s.now()
+	notice, err := s.current(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dropAcknowledged(ctx, playerID, notice); err != nil {
+		return nil, err
+	}
+	if notice.Message == nil {
+		return notice, nil
+	}
+	seenAt, seen, err := s.repo.SeenAt(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	notice.Unread = !seen || notice.Message.UpdatedAt.After(seenAt)
+	if err := s.repo.MarkSeen(ctx, playerID, now); err != nil {
+		return nil, err
+	}
+	return notice, nil
+}
+
+func (s *Service) current(ctx context.Context, now time.Time) (*Notice, error) {
+	msg, err := s.Message(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all, err := s.repo.ListAnnouncements(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	notice := &Notice{Message: msg}
+	for _, a := range all {
+		if a.ActiveAt(now) {
+			notice.Announcements = append(notice.Announcements, a)
+		}
+	}
+	sort.SliceStable(notice.Announcements, func(i, j int) bool {
+		return notice.Announcements[i].EndsAt.Before(notice.Announcements[j].EndsAt)
+	})
+	return notice, nil
+}
+
+// PublishLoginNotice sends the player's login notice to the character's
+// stream as a motd event. Nothing is sent when there is nothing to show or
+// no publisher is bound.
+func (s *Service) PublishLoginNotice(ctx context.Context, playerID, characterID ulid.ULID) error {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return nil
+	}
+	notice, err := s.LoginNotice(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if notice.Empty() {
+		return nil
+	}
+	payload, err := json.Marshal(BuildPayload(notice, s.Locale(ctx, playerID)))
+	if err != nil {
+		return oops.With("operation", "marshal_motd_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+characterID.String())
+	if err != nil {
+		return oops.With("character_id", characterID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeMOTD))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeMOTD)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, payload)); err != nil {
+		return oops.Code("MOTD_PUBLISH_FAILED").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	announcementDeliveries.WithLabelValues("login").Add(float64(len(notice.Announcements)))
+	return nil
+}
+
+// Tick broadcasts every announcement that has started since the last tick.
+// Each announcement is claimed before it is broadcast, so it goes out once
+// even with several replicas ticking.
+func (s *Service) Tick(ctx context.Context) error {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return nil
+	}
+	due, err := s.repo.ClaimDue(ctx, s.now())
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	b := sysbroadcast.NewBroadcaster(pub, gameID)
+	var errs []error
+	for _, a := range due {
+		if err := b.Broadcast(ctx, core.SystemBroadcastSubject, AnnouncementText(a)); err != nil {
+			errs = append(errs, oops.With("announcement_id", a.ID.String()).Wrap(err))
+			continue
+		}
+		announcementDeliveries.WithLabelValues("broadcast").Inc()
+		s.logger.InfoContext(ctx, "motd announcement broadcast",
+			"event", "motd_announcement_broadcast",
+			"announcement_id", a.ID.String(),
+		)
+	}
+	return errors.Join(errs...)
+}
+
+// Run calls Tick every TickInterval until ctx is cancelled. Tick errors are
+// logged and do not stop the loop.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Tick(ctx); err != nil {
+				s.logger.WarnContext(ctx, "motd announcement tick failed", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Service) putItem(ctx context.Context, key, subject, body string) error {
+	styled := parseBody(body)
+	item := &content.Item{
+		Key:         key,
+		ContentType: "text/plain",
+		Body:        []byte(body),
+		Metadata: map[string]string{
+			MetaANSI:      styled.RenderANSI(),
+			MetaText:      plain(styled),
+			MetaUpdatedBy: subject,
+		},
+		UpdatedAt: s.now().UTC(),
+	}
+	if err := s.content.Put(ctx, item); err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "put").
+			With("key", key).
+			Wrap(err)
+	}
+	return nil
+}
+
+func (s *Service) deleteItem(ctx context.Context, key string) error {
+	item, err := s.content.Get(ctx, key)
+	if err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "get").
+			With("key", key).
+			Wrap(err)
+	}
+	if item == nil {
+		return oops.Code("MOTD_NOT_FOUND").With("key", key).Wrap(ErrNotFound)
+	}
+	if err := s.content.Delete(ctx, key); err != nil {
+		return oops.Code("MOTD_STORE_FAILED").
+			With("operation", "delete").
+			With("key", key).
+			Wrap(err)
+	}
+	return nil
+}
+
+// checkAccess evaluates action on motd:<area> for subject. It fails
+// closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject, action, area string) error {
+	resource := access.MOTDResource(area)
+	req, err := types.NewAccessRequest(subject, action, resource, nil)
+	if err != nil {
+		return oops.Code("MOTD_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("MOTD_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "motd edit denied",
+			"event", "motd_edit_denied",
+			"subject", subject,
+			"action", action,
+			"area", area,
+			"reason", decision.Reason(),
+		)
+		return oops.Code("MOTD_ACCESS_DENIED").
+			With("area", area).
+			Errorf("not permitted to %s the %s", action, areaNoun(area))
+	}
+	return nil
+}
+
+func areaNoun(area string) string {
+	switch area {
+	case AreaScreen:
+		return "connect screens"
+	case AreaMessage:
+		return "message of the day"
+	default:
+		return "announcements"
+	}
+}
+
+func screenFromItem(item *content.Item) *Screen {
+	return &Screen{
+		Name:      strings.TrimPrefix(item.Key, ScreenKeyPrefix),
+		Body:      string(item.Body),
+		UpdatedBy: item.Metadata[MetaUpdatedBy],
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
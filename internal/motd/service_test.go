@@ -0,0 +1,599 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package motd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/content"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu            sync.Mutex
+	seen          map[ulid.ULID]time.Time
+	announcements map[ulid.ULID]*Announcement
+	acks          map[ulid.ULID]map[ulid.ULID]time.Time
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{
+		seen:          map[ulid.ULID]time.Time{},
+		announcements: map[ulid.ULID]*Announcement{},
+		acks:          map[ulid.ULID]map[ulid.ULID]time.Time{},
+	}
+}
+
+func (m *memRepository) SeenAt(_ context.Context, playerID ulid.ULID) (time.Time, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.seen[playerID]
+	return t, ok, nil
+}
+
+func (m *memRepository) MarkSeen(_ context.Context, playerID ulid.ULID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seen[playerID] = at
+	return nil
+}
+
+func (m *memRepository) CreateAnnouncement(_ context.Context, a *Announcement) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *a
+	m.announcements[a.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) ListAnnouncements(_ context.Context, now time.Time) ([]*Announcement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Announcement
+	for _, a := range m.announcements {
+		if a.EndsAt.After(now) {
+			out = append(out, a)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].StartsAt.Before(out[j].StartsAt) })
+	return out, nil
+}
+
+func (m *memRepository) DeleteAnnouncement(_ context.Context, id ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.announcements[id]; !ok {
+		return oops.Code("MOTD_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	delete(m.announcements, id)
+	return nil
+}
+
+func (m *memRepository) ClaimDue(_ context.Context, now time.Time) ([]*Announcement, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Announcement
+	for _, a := range m.announcements {
+		if a.AnnouncedAt == nil && a.ActiveAt(now) {
+			at := now
+			a.AnnouncedAt = &at
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) Acknowledge(_ context.Context, announcementID, playerID ulid.ULID, at time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.acks[announcementID] == nil {
+		m.acks[announcementID] = map[ulid.ULID]time.Time{}
+	}
+	if _, ok := m.acks[announcementID][playerID]; ok {
+		return false, nil
+	}
+	m.acks[announcementID][playerID] = at
+	return true, nil
+}
+
+func (m *memRepository) Acknowledged(_ context.Context, playerID ulid.ULID, ids []ulid.ULID) (map[ulid.ULID]bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := map[ulid.ULID]bool{}
+	for _, id := range ids {
+		if _, ok := m.acks[id][playerID]; ok {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) AckCounts(_ context.Context, ids []ulid.ULID) (map[ulid.ULID]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := map[ulid.ULID]int{}
+	for _, id := range ids {
+		if n := len(m.acks[id]); n > 0 {
+			out[id] = n
+		}
+	}
+	return out, nil
+}
+
+// memContent is an in-memory content.Store.
+type memContent struct {
+	mu    sync.Mutex
+	items map[string]*content.Item
+	now   time.Time
+}
+
+func newMemContent() *memContent {
+	return &memContent{items: map[string]*content.Item{}}
+}
+
+func (m *memContent) Get(_ context.Context, key string) (*content.Item, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.items[key], nil
+}
+
+func (m *memContent) List(_ context.Context, prefix string, _ content.ListOptions) (*content.ListResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := &content.ListResult{}
+	for key, item := range m.items {
+		if strings.HasPrefix(key, prefix) {
+			res.Items = append(res.Items, item)
+		}
+	}
+	return res, nil
+}
+
+func (m *memContent) Put(_ context.Context, item *content.Item) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *item
+	if !m.now.IsZero() {
+		stored.UpdatedAt = m.now
+	}
+	m.items[item.Key] = &stored
+	return nil
+}
+
+func (m *memContent) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.items, key)
+	return nil
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo    *memRepository
+	content *memContent
+	engine  *policytest.GrantEngine
+	logs    *bytes.Buffer
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	store := newMemContent()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, store, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	return &testService{Service: svc, repo: repo, content: store, engine: engine, logs: &logs}
+}
+
+func staffSubject() string {
+	return access.CharacterSubject(idgen.New().String())
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	_, err := NewService(nil, newMemContent(), policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), newMemContent(), nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceScreens(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+
+	err := ts.SaveScreen(ctx, subject, "default", "Welcome")
+	errutil.AssertErrorCode(t, err, "MOTD_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"motd_edit_denied"`)
+
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaScreen))
+	require.NoError(t, ts.SaveScreen(ctx, subject, " Default ", "%xhWelcome%xn"))
+	require.NoError(t, ts.SaveScreen(ctx, subject, "alt", "Hello"))
+	assert.Contains(t, ts.logs.String(), `"event":"motd_screen_saved"`)
+
+	item := ts.content.items[ScreenKeyPrefix+"default"]
+	require.NotNil(t, item)
+	assert.Equal(t, "%xhWelcome%xn", string(item.Body))
+	assert.Equal(t, "\x1b[1mWelcome\x1b[0m", item.Metadata[MetaANSI])
+	assert.Equal(t, "Welcome", item.Metadata[MetaText])
+	assert.Equal(t, subject, item.Metadata[MetaUpdatedBy])
+
+	screens, err := ts.Screens(ctx)
+	require.NoError(t, err)
+	require.Len(t, screens, 2)
+	assert.Equal(t, "alt", screens[0].Name)
+	assert.Equal(t, "default", screens[1].Name)
+
+	got, err := ts.Screen(ctx, "DEFAULT")
+	require.NoError(t, err)
+	assert.Equal(t, "%xhWelcome%xn", got.Body)
+
+	err = ts.DeleteScreen(ctx, subject, "alt")
+	errutil.AssertErrorCode(t, err, "MOTD_ACCESS_DENIED")
+	ts.engine.Grant(subject, ActionDelete, access.MOTDResource(AreaScreen))
+	require.NoError(t, ts.DeleteScreen(ctx, subject, "alt"))
+
+	_, err = ts.Screen(ctx, "alt")
+	errutil.AssertErrorCode(t, err, "MOTD_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, ts.DeleteScreen(ctx, subject, "alt"), ErrNotFound)
+}
+
+func TestServiceSaveScreenValidatesBeforeAccessCheck(t *testing.T) {
+	ts := newTestService(t)
+
+	errutil.AssertErrorCode(t, ts.SaveScreen(context.Background(), "character:x", "bad name", "x"), "MOTD_INVALID")
+	errutil.AssertErrorCode(t, ts.SaveScreen(context.Background(), "character:x", "ok", " "), "MOTD_INVALID")
+	assert.NotContains(t, ts.logs.String(), "motd_edit_denied")
+}
+
+func TestServiceMessage(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+
+	msg, err := ts.Message(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	errutil.AssertErrorCode(t, ts.SetMessage(ctx, subject, "Hi"), "MOTD_ACCESS_DENIED")
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaMessage))
+	require.NoError(t, ts.SetMessage(ctx, subject, "Hi"))
+
+	msg, err = ts.Message(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	assert.Equal(t, "Hi", msg.Body)
+	assert.Equal(t, subject, msg.UpdatedBy)
+
+	errutil.AssertErrorCode(t, ts.ClearMessage(ctx, subject), "MOTD_ACCESS_DENIED")
+	ts.engine.Grant(subject, ActionDelete, access.MOTDResource(AreaMessage))
+	require.NoError(t, ts.ClearMessage(ctx, subject))
+	assert.Contains(t, ts.logs.String(), `"event":"motd_message_cleared"`)
+	errutil.AssertErrorCode(t, ts.ClearMessage(ctx, subject), "MOTD_NOT_FOUND")
+}
+
+func TestServiceAnnounce(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+
+	_, err := ts.Announce(ctx, subject, "Reboot", time.Time{}, time.Time{}, false)
+	errutil.AssertErrorCode(t, err, "MOTD_ACCESS_DENIED")
+
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaAnnouncement))
+	a, err := ts.Announce(ctx, subject, "Reboot", time.Time{}, time.Time{}, false)
+	require.NoError(t, err)
+	assert.Equal(t, now, a.StartsAt)
+	assert.Equal(t, now.Add(DefaultAnnouncementDuration), a.EndsAt)
+	assert.Equal(t, subject, a.CreatedBy)
+	assert.Contains(t, ts.logs.String(), `"event":"motd_announcement_scheduled"`)
+
+	later := now.Add(time.Hour)
+	_, err = ts.Announce(ctx, subject, "Event", later, later.Add(2*time.Hour), false)
+	require.NoError(t, err)
+
+	list, err := ts.Announcements(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "Reboot", list[0].Body)
+
+	errutil.AssertErrorCode(t, ts.CancelAnnouncement(ctx, subject, a.ID), "MOTD_ACCESS_DENIED")
+	ts.engine.Grant(subject, ActionDelete, access.MOTDResource(AreaAnnouncement))
+	require.NoError(t, ts.CancelAnnouncement(ctx, subject, a.ID))
+	assert.ErrorIs(t, ts.CancelAnnouncement(ctx, subject, a.ID), ErrNotFound)
+}
+
+func TestServiceAnnounceRejectsBadWindows(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+
+	cases := map[string][2]time.Time{
+		"ends before start": {now.Add(time.Hour), now},
+		"already over":      {now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+		"too long":          {now, now.Add(MaxAnnouncementDuration + time.Hour)},
+	}
+	for name, window := range cases {
+		_, err := ts.Announce(ctx, "character:x", "x", window[0], window[1], false)
+		errutil.AssertErrorCode(t, err, "MOTD_INVALID")
+		assert.NotContains(t, ts.logs.String(), "motd_edit_denied", name)
+	}
+}
+
+func TestServiceLoginNotice(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	playerID := idgen.New()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+	ts.content.now = now
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaMessage))
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaAnnouncement))
+
+	notice, err := ts.LoginNotice(ctx, playerID)
+	require.NoError(t, err)
+	assert.True(t, notice.Empty())
+	_, seen, _ := ts.repo.SeenAt(ctx, playerID)
+	assert.False(t, seen, "nothing to see marks nothing seen")
+
+	require.NoError(t, ts.SetMessage(ctx, subject, "Hi"))
+	long, err := ts.Announce(ctx, subject, "long", time.Time{}, now.Add(3*time.Hour), false)
+	require.NoError(t, err)
+	short, err := ts.Announce(ctx, subject, "short", time.Time{}, now.Add(time.Hour), false)
+	require.NoError(t, err)
+	_, err = ts.Announce(ctx, subject, "future", now.Add(time.Hour), time.Time{}, false)
+	require.NoError(t, err)
+
+	notice, err = ts.LoginNotice(ctx, playerID)
+	require.NoError(t, err)
+	require.NotNil(t, notice.Message)
+	assert.True(t, notice.Unread)
+	require.Len(t, notice.Announcements, 2)
+	assert.Equal(t, short.ID, notice.Announcements[0].ID)
+	assert.Equal(t, long.ID, notice.Announcements[1].ID)
+
+	current, err := ts.Current(ctx)
+	require.NoError(t, err)
+	assert.Len(t, current.Announcements, 2)
+	assert.False(t, current.Unread, "Current is not per player")
+
+	ts.now = func() time.Time { return now.Add(time.Minute) }
+	notice, err = ts.LoginNotice(ctx, playerID)
+	require.NoError(t, err)
+	assert.False(t, notice.Unread, "seen at the previous login")
+
+	ts.content.now = now.Add(2 * time.Minute)
+	require.NoError(t, ts.SetMessage(ctx, subject, "Changed"))
+	ts.now = func() time.Time { return now.Add(3 * time.Minute) }
+	notice, err = ts.LoginNotice(ctx, playerID)
+	require.NoError(t, err)
+	assert.True(t, notice.Unread, "message changed since last seen")
+}
+
+func TestServicePublishLoginNotice(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	playerID, charID := idgen.New(), idgen.New()
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaMessage))
+	require.NoError(t, ts.SetMessage(ctx, subject, "Hi"))
+
+	// No publisher bound yet: nothing happens and nothing is marked seen.
+	require.NoError(t, ts.PublishLoginNotice(ctx, playerID, charID))
+	_, seen, _ := ts.repo.SeenAt(ctx, playerID)
+	assert.False(t, seen)
+
+	pub := &fakePublisher{}
+	ts.SetPublisher(pub, mainGameID)
+	require.NoError(t, ts.PublishLoginNotice(ctx, playerID, charID))
+
+	require.Len(t, pub.published, 1)
+	ev := pub.published[0]
+	assert.Equal(t, "events.main.character."+charID.String(), string(ev.Subject))
+	assert.Equal(t, string(eventvocab.EventTypeMOTD), string(ev.Type))
+	assert.Equal(t, eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}, ev.Actor)
+
+	var payload eventvocab.MOTDPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, "Hi", payload.Body)
+	assert.True(t, payload.Unread)
+	assert.Contains(t, payload.Text, "Message of the Day")
+
+	pub.err = errors.New("bus down")
+	errutil.AssertErrorCode(t, ts.PublishLoginNotice(ctx, playerID, charID), "MOTD_PUBLISH_FAILED")
+}
+
+type fixedLocales string
+
+func (l fixedLocales) Locale(context.Context, ulid.ULID) string { return string(l) }
+
+func TestServicePublishLoginNoticeUsesPlayerLocale(t *testing.T) {
+	withGermanMessages(t)
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaMessage))
+	require.NoError(t, ts.SetMessage(ctx, subject, "Hallo"))
+	pub := &fakePublisher{}
+	ts.SetPublisher(pub, mainGameID)
+
+	assert.Equal(t, "en", ts.Locale(ctx, idgen.New()), "no source bound: the fallback locale")
+	ts.SetLocales(fixedLocales("de"))
+	require.NoError(t, ts.PublishLoginNotice(ctx, idgen.New(), idgen.New()))
+
+	require.Len(t, pub.published, 1)
+	var payload eventvocab.MOTDPayload
+	require.NoError(t, json.Unmarshal(pub.published[0].Payload, &payload))
+	assert.Contains(t, payload.Text, "Nachricht des Tages")
+}
+
+func TestServicePublishLoginNoticeSkipsEmptyNotice(t *testing.T) {
+	ts := newTestService(t)
+	pub := &fakePublisher{}
+	ts.SetPublisher(pub, mainGameID)
+
+	require.NoError(t, ts.PublishLoginNotice(context.Background(), idgen.New(), idgen.New()))
+	assert.Empty(t, pub.published)
+}
+
+func TestServiceTickBroadcastsOnce(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaAnnouncement))
+
+	_, err := ts.Announce(ctx, subject, "Reboot at %xhnoon%xn", time.Time{}, time.Time{}, false)
+	require.NoError(t, err)
+	_, err = ts.Announce(ctx, subject, "Later", now.Add(time.Hour), time.Time{}, false)
+	require.NoError(t, err)
+
+	// Without a publisher nothing is claimed.
+	require.NoError(t, ts.Tick(ctx))
+
+	pub := &fakePublisher{}
+	ts.SetPublisher(pub, mainGameID)
+	require.NoError(t, ts.Tick(ctx))
+	require.NoError(t, ts.Tick(ctx))
+
+	require.Len(t, pub.published, 1)
+	ev := pub.published[0]
+	assert.Equal(t, string(eventvocab.EventTypeSystem), string(ev.Type))
+	assert.JSONEq(t, `{"message":"Announcement: Reboot at noon"}`, string(ev.Payload))
+
+	ts.now = func() time.Time { return now.Add(time.Hour) }
+	require.NoError(t, ts.Tick(ctx))
+	assert.Len(t, pub.published, 2)
+}
+
+func TestServiceWallBroadcastsImmediately(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+	pub := &fakePublisher{}
+	ts.SetPublisher(pub, mainGameID)
+
+	_, err := ts.Wall(ctx, subject, "Policy update", time.Time{}, true)
+	errutil.AssertErrorCode(t, err, "MOTD_ACCESS_DENIED")
+	assert.Empty(t, pub.published)
+
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaAnnouncement))
+	a, err := ts.Wall(ctx, subject, "Policy update", time.Time{}, true)
+	require.NoError(t, err)
+	assert.True(t, a.RequiresAck)
+	assert.Equal(t, now.Add(DefaultAckAnnouncementDuration), a.EndsAt)
+
+	require.Len(t, pub.published, 1)
+	assert.JSONEq(t,
+		`{"message":"Announcement: Policy update (acknowledge with: motd ack `+a.ID.String()+`)"}`,
+		string(pub.published[0].Payload))
+
+	require.NoError(t, ts.Tick(ctx))
+	assert.Len(t, pub.published, 1, "a wall is not broadcast again")
+}
+
+func TestServiceAcknowledge(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	subject := staffSubject()
+	playerID, otherID := idgen.New(), idgen.New()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+	ts.engine.Grant(subject, ActionWrite, access.MOTDResource(AreaAnnouncement))
+
+	policy, err := ts.Announce(ctx, subject, "New rules", time.Time{}, time.Time{}, true)
+	require.NoError(t, err)
+	reboot, err := ts.Announce(ctx, subject, "Reboot", time.Time{}, now.Add(time.Hour), false)
+	require.NoError(t, err)
+	future, err := ts.Announce(ctx, subject, "Later", now.Add(time.Hour), time.Time{}, true)
+	require.NoError(t, err)
+
+	pending, err := ts.Pending(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, policy.ID, pending[0].ID)
+
+	errutil.AssertErrorCode(t, ts.Acknowledge(ctx, playerID, reboot.ID), "MOTD_INVALID")
+	assert.ErrorIs(t, ts.Acknowledge(ctx, playerID, future.ID), ErrNotFound, "not running yet")
+	assert.ErrorIs(t, ts.Acknowledge(ctx, playerID, idgen.New()), ErrNotFound)
+
+	require.NoError(t, ts.Acknowledge(ctx, playerID, policy.ID))
+	require.NoError(t, ts.Acknowledge(ctx, playerID, policy.ID), "acknowledging twice is fine")
+	assert.Equal(t, 1, strings.Count(ts.logs.String(), `"event":"motd_announcement_acknowledged"`))
+
+	pending, err = ts.Pending(ctx, playerID)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	notice, err := ts.LoginNotice(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, notice.Announcements, 1, "acknowledged announcements drop out of the login notice")
+	assert.Equal(t, reboot.ID, notice.Announcements[0].ID)
+
+	notice, err = ts.LoginNotice(ctx, otherID)
+	require.NoError(t, err)
+	assert.Len(t, notice.Announcements, 2)
+
+	counts, err := ts.AckCounts(ctx, []ulid.ULID{policy.ID, future.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[ulid.ULID]int{policy.ID: 1}, counts)
+}
+
+func TestServiceFailsClosedOnEngineError(t *testing.T) {
+	svc, err := NewService(newMemRepository(), newMemContent(), policytest.NewErrorEngine(errors.New("engine down")), nil)
+	require.NoError(t, err)
+
+	err = svc.SetMessage(context.Background(), "character:x", "Hi")
+	errutil.AssertErrorCode(t, err, "MOTD_ACCESS_EVALUATION_FAILED")
+}
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package npc manages non-player characters: named figures such as a
+// harbourmaster or a street vendor that staff place in locations. An NPC is
+// not a player character — it has no account, no session, and no row in
+// the characters table — but it is seen in locations, listed by WHO, and
+// can act.
+//
+// An NPC acts in two ways. Behaviors attach plugins to it: when a player's
+// event of a listed type lands in the NPC's location, the service delivers
+// an npc:<type> event to each attached plugin and publishes what the plugin
+// emits in reply. Wandering moves the NPC through a random unlocked exit on
+// a schedule, optionally staying within one zone. Like ambient schedules,
+// wanderers are claimed atomically, so each move happens once cluster-wide.
+package npc
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Limits.
+const (
+	// MaxPerLocation bounds how many NPCs one location may hold.
+	MaxPerLocation = 20
+	// MaxBehaviors bounds how many plugins one NPC may have attached.
+	MaxBehaviors = 8
+	// MaxTriggers bounds how many event types one behavior may react to.
+	MaxTriggers = 16
+	// MinWanderInterval is the shortest gap a wanderer may ask for between
+	// moves.
+	MinWanderInterval = time.Minute
+	// MaxWanderInterval is the longest gap a wanderer may ask for between
+	// moves.
+	MaxWanderInterval = 24 * time.Hour
+)
+
+// ErrNotFound is returned when an NPC does not exist.
+var ErrNotFound = errors.New("npc not found")
+
+// pluginNamePattern matches a plugin name (see plugin manifest naming).
+var pluginNamePattern = regexp.MustCompile(`^[a-z](-?[a-z0-9])*$`)
+
+// triggerPattern matches an event type a behavior may react to, such as
+// "say" or "pose".
+var triggerPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// NPC is a non-player character placed in a location.
+type NPC struct {
+	ID          ulid.ULID
+	Name        string
+	Description string
+	LocationID  ulid.ULID
+	// Owner is the subject that spawned the NPC, e.g. "character:<id>".
+	Owner     string
+	Behaviors []Behavior
+	// Wander is the zero value when the NPC stays put.
+	Wander Wander
+	// NextWanderAt is when the NPC next moves; zero when it stays put.
+	NextWanderAt time.Time
+	CreatedAt    time.Time
+}
+
+// Behavior attaches a plugin to an NPC. The plugin receives an npc:<type>
+// event whenever a player's event of one of the Triggers types is sent to
+// the NPC's location.
+type Behavior struct {
+	Plugin   string   `json:"plugin"`
+	Triggers []string `json:"triggers"`
+}
+
+// Reacts reports whether the behavior listens for eventType.
+func (b Behavior) Reacts(eventType string) bool {
+	for _, t := range b.Triggers {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Wander controls how an NPC moves on its own. After each move the next is
+// due a random interval in [MinInterval, MaxInterval] later. A non-empty
+// Zone keeps the NPC within locations of that zone.
+type Wander struct {
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	Zone        string
+}
+
+// Enabled reports whether the NPC wanders at all.
+func (w Wander) Enabled() bool {
+	return w.MinInterval > 0
+}
+
+// Validate returns NPC_INVALID when the intervals are out of range or
+// inverted, or the zone id is malformed. The zero Wander is valid.
+func (w Wander) Validate() error {
+	if !w.Enabled() {
+		if w.MaxInterval != 0 || w.Zone != "" {
+			return oops.Code("NPC_INVALID").Errorf("a wander zone or maximum needs a minimum interval")
+		}
+		return nil
+	}
+	switch {
+	case w.MinInterval < MinWanderInterval:
+		return oops.Code("NPC_INVALID").
+			With("min_interval", w.MinInterval.String()).
+			Errorf("minimum wander interval must be at least %s", MinWanderInterval)
+	case w.MaxInterval > MaxWanderInterval:
+		return oops.Code("NPC_INVALID").
+			With("max_interval", w.MaxInterval.String()).
+			Errorf("maximum wander interval must be at most %s", MaxWanderInterval)
+	case w.MaxInterval < w.MinInterval:
+		return oops.Code("NPC_INVALID").
+			With("min_interval", w.MinInterval.String()).
+			With("max_interval", w.MaxInterval.String()).
+			Errorf("maximum wander interval must not be shorter than the minimum")
+	}
+	if w.Zone != "" {
+		if err := world.ValidateZoneID(w.Zone); err != nil {
+			return oops.Code("NPC_INVALID").With("zone", w.Zone).Wrap(err)
+		}
+	}
+	return nil
+}
+
+// String renders the wander settings, e.g. "every 5m0s-15m0s in docks", or
+// "stays put".
+func (w Wander) String() string {
+	if !w.Enabled() {
+		return "stays put"
+	}
+	s := "every " + w.MinInterval.String() + "-" + w.MaxInterval.String()
+	if w.Zone != "" {
+		s += " in " + w.Zone
+	}
+	return s
+}
+
+// ValidateName returns NPC_INVALID when name is not a valid object name or
+// has surrounding spaces.
+func ValidateName(name string) error {
+	if err := world.ValidateName(name); err != nil {
+		return oops.Code("NPC_INVALID").With("name", name).Wrap(err)
+	}
+	if name != strings.TrimSpace(name) {
+		return oops.Code("NPC_INVALID").
+			With("name", name).
+			Errorf("NPC name cannot have leading or trailing spaces")
+	}
+	return nil
+}
+
+// ValidateDescription returns NPC_INVALID when desc is not a valid
+// description.
+func ValidateDescription(desc string) error {
+	if err := world.ValidateDescription(desc); err != nil {
+		return oops.Code("NPC_INVALID").Wrap(err)
+	}
+	return nil
+}
+
+// ValidateBehaviors returns NPC_INVALID when there are too many behaviors,
+// a plugin name is malformed or repeated, or a behavior's triggers are
+// missing, malformed, or too many.
+func ValidateBehaviors(behaviors []Behavior) error {
+	if len(behaviors) > MaxBehaviors {
+		return oops.Code("NPC_INVALID").
+			With("count", len(behaviors)).
+			Errorf("an NPC may have at most %d behaviors", MaxBehaviors)
+	}
+	seen := make(map[string]bool, len(behaviors))
+	for _, b := range behaviors {
+		if !pluginNamePattern.MatchString(b.Plugin) {
+			return oops.Code("NPC_INVALID").
+				With("plugin", b.Plugin).
+				Errorf("%q is not a plugin name", b.Plugin)
+		}
+		if seen[b.Plugin] {
+			return oops.Code("NPC_INVALID").
+				With("plugin", b.Plugin).
+				Errorf("plugin %s is attached more than once", b.Plugin)
+		}
+		seen[b.Plugin] = true
+		if len(b.Triggers) == 0 || len(b.Triggers) > MaxTriggers {
+			return oops.Code("NPC_INVALID").
+				With("plugin", b.Plugin).
+				Errorf("a behavior needs between 1 and %d triggers", MaxTriggers)
+		}
+		for _, t := range b.Triggers {
+			if !triggerPattern.MatchString(t) {
+				return oops.Code("NPC_INVALID").
+					With("plugin", b.Plugin).
+					With("trigger", t).
+					Errorf("%q is not an event type", t)
+			}
+		}
+	}
+	return nil
+}
+
+// nextInterval returns a random gap in [min, max].
+func nextInterval(minGap, maxGap time.Duration, int63n func(n int64) int64) time.Duration {
+	if maxGap <= minGap {
+		return minGap
+	}
+	return minGap + time.Duration(int63n(int64(maxGap-minGap)+1))
+}
+
+// cryptoIntN returns a cryptographically secure random int in [0, n).
+func cryptoIntN(n int) int {
+	return int(cryptoInt64N(int64(n)))
+}
+
+// cryptoInt64N returns a cryptographically secure random int64 in [0, n).
+func cryptoInt64N(n int64) int64 {
+	v, err := rand.Int(rand.Reader, big.NewInt(n))
+	if err != nil {
+		// crypto/rand failure is a system-level problem; panic is appropriate.
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return v.Int64()
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package npc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestWanderValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		wander  Wander
+		wantErr bool
+	}{
+		{"stays put", Wander{}, false},
+		{"in range", Wander{MinInterval: time.Minute, MaxInterval: time.Hour}, false},
+		{"with zone", Wander{MinInterval: time.Minute, MaxInterval: time.Minute, Zone: "docks"}, false},
+		{"zone without interval", Wander{Zone: "docks"}, true},
+		{"too frequent", Wander{MinInterval: time.Second, MaxInterval: time.Minute}, true},
+		{"too rare", Wander{MinInterval: time.Minute, MaxInterval: 48 * time.Hour}, true},
+		{"inverted", Wander{MinInterval: time.Hour, MaxInterval: time.Minute}, true},
+		{"bad zone", Wander{MinInterval: time.Minute, MaxInterval: time.Minute, Zone: "Not A Zone"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wander.Validate()
+			if tt.wantErr {
+				errutil.AssertErrorCode(t, err, "NPC_INVALID")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWanderString(t *testing.T) {
+	assert.Equal(t, "stays put", Wander{}.String())
+	assert.Equal(t, "every 1m0s-5m0s in docks",
+		Wander{MinInterval: time.Minute, MaxInterval: 5 * time.Minute, Zone: "docks"}.String())
+}
+
+func TestValidateName(t *testing.T) {
+	require.NoError(t, ValidateName("Old Tom"))
+	errutil.AssertErrorCode(t, ValidateName(""), "NPC_INVALID")
+	errutil.AssertErrorCode(t, ValidateName(" Tom"), "NPC_INVALID")
+	errutil.AssertErrorCode(t, ValidateName("Tom\x07"), "NPC_INVALID")
+}
+
+func TestValidateBehaviors(t *testing.T) {
+	require.NoError(t, ValidateBehaviors(nil))
+	require.NoError(t, ValidateBehaviors([]Behavior{{Plugin: "barkeep", Triggers: []string{"say", "pose"}}}))
+
+	tests := []struct {
+		name      string
+		behaviors []Behavior
+	}{
+		{"bad plugin name", []Behavior{{Plugin: "Barkeep", Triggers: []string{"say"}}}},
+		{"repeated plugin", []Behavior{
+			{Plugin: "barkeep", Triggers: []string{"say"}},
+			{Plugin: "barkeep", Triggers: []string{"pose"}},
+		}},
+		{"no triggers", []Behavior{{Plugin: "barkeep"}}},
+		{"bad trigger", []Behavior{{Plugin: "barkeep", Triggers: []string{"npc:say"}}}},
+		{"too many triggers", []Behavior{{Plugin: "barkeep", Triggers: strings.Fields(strings.Repeat("say ", MaxTriggers+1))}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errutil.AssertErrorCode(t, ValidateBehaviors(tt.behaviors), "NPC_INVALID")
+		})
+	}
+
+	many := make([]Behavior, MaxBehaviors+1)
+	for i := range many {
+		many[i] = Behavior{Plugin: "p" + strings.Repeat("a", i+1), Triggers: []string{"say"}}
+	}
+	errutil.AssertErrorCode(t, ValidateBehaviors(many), "NPC_INVALID")
+}
+
+func TestBehaviorReacts(t *testing.T) {
+	b := Behavior{Plugin: "barkeep", Triggers: []string{"say", "arrive"}}
+	assert.True(t, b.Reacts("say"))
+	assert.False(t, b.Reacts("pose"))
+}
@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package npc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const npcColumns = `id, name, description, location_id, owner, behaviors,
+	wander_min_ns, wander_max_ns, wander_zone, next_wander_at, created_at`
+
+// SQLSTATE codes mapped to domain errors.
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
+// PostgresStore implements Repository against the npcs table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts n.
+func (s *PostgresStore) Create(ctx context.Context, n *NPC) error {
+	behaviors, err := marshalBehaviors(n.Behaviors)
+	if err != nil {
+		return err
+	}
+	_, err = s.pool.Exec(ctx, `
+		INSERT INTO npcs (`+npcColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, n.ID.String(), n.Name, n.Description, n.LocationID.String(), n.Owner, behaviors,
+		int64(n.Wander.MinInterval), int64(n.Wander.MaxInterval), n.Wander.Zone,
+		nullableNanos(n.NextWanderAt), pgnanos.From(n.CreatedAt))
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return oops.Code("NPC_NAME_TAKEN").
+				With("name", n.Name).
+				Errorf("an NPC named %q already exists", n.Name)
+		case pgForeignKeyViolation:
+			return oops.Code("NPC_NOT_FOUND").
+				With("location_id", n.LocationID.String()).
+				Wrap(ErrNotFound)
+		}
+	}
+	if err != nil {
+		return oops.Code("NPC_STORE_FAILED").
+			With("operation", "create").
+			With("npc_id", n.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Get returns the NPC with id.
+func (s *PostgresStore) Get(ctx context.Context, id ulid.ULID) (*NPC, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+npcColumns+` FROM npcs WHERE id = $1`, id.String())
+	n, err := scanNPC(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("NPC_NOT_FOUND").With("npc_id", id.String()).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("NPC_STORE_FAILED").
+			With("operation", "get").
+			With("npc_id", id.String()).
+			Wrap(err)
+	}
+	return n, nil
+}
+
+// GetByName returns the NPC named name, ignoring case.
+func (s *PostgresStore) GetByName(ctx context.Context, name string) (*NPC, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+npcColumns+` FROM npcs WHERE lower(name) = lower($1)`, name)
+	n, err := scanNPC(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("NPC_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("NPC_STORE_FAILED").
+			With("operation", "get_by_name").
+			With("name", name).
+			Wrap(err)
+	}
+	return n, nil
+}
+
+// List returns every NPC ordered by name.
+func (s *PostgresStore) List(ctx context.Context) ([]*NPC, error) {
+	return s.query(ctx, "list", `SELECT `+npcColumns+` FROM npcs ORDER BY lower(name)`)
+}
+
+// ListAt returns the location's NPCs ordered by name.
+func (s *PostgresStore) ListAt(ctx context.Context, locationID ulid.ULID) ([]*NPC, error) {
+	return s.query(ctx, "list_at",
+		`SELECT `+npcColumns+` FROM npcs WHERE location_id = $1 ORDER BY lower(name)`,
+		locationID.String())
+}
+
+// Delete removes the NPC with id.
+func (s *PostgresStore) Delete(ctx context.Context, id ulid.ULID) error {
+	return s.exec(ctx, "delete", id, `DELETE FROM npcs WHERE id = $1`, id.String())
+}
+
+// SetBehaviors replaces the NPC's behaviors.
+func (s *PostgresStore) SetBehaviors(ctx context.Context, id ulid.ULID, behaviors []Behavior) error {
+	raw, err := marshalBehaviors(behaviors)
+	if err != nil {
+		return err
+	}
+	return s.exec(ctx, "set_behaviors", id, `UPDATE npcs SET behaviors = $2 WHERE id = $1`, id.String(), raw)
+}
+
+// SetWander replaces the NPC's wander settings and next move time.
+func (s *PostgresStore) SetWander(ctx context.Context, id ulid.ULID, w Wander, next time.Time) error {
+	return s.exec(ctx, "set_wander", id, `
+		UPDATE npcs
+		   SET wander_min_ns = $2, wander_max_ns = $3, wander_zone = $4, next_wander_at = $5
+		 WHERE id = $1
+	`, id.String(), int64(w.MinInterval), int64(w.MaxInterval), w.Zone, nullableNanos(next))
+}
+
+// Move places the NPC in locationID.
+func (s *PostgresStore) Move(ctx context.Context, id, locationID ulid.ULID) error {
+	tag, err := s.pool.Exec(ctx, `UPDATE npcs SET location_id = $2 WHERE id = $1`,
+		id.String(), locationID.String())
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+		return oops.Code("NPC_NOT_FOUND").
+			With("location_id", locationID.String()).
+			Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return oops.Code("NPC_STORE_FAILED").
+			With("operation", "move").
+			With("npc_id", id.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("NPC_NOT_FOUND").With("npc_id", id.String()).Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+// ClaimDue leases and returns the wanderers due at now.
+func (s *PostgresStore) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) ([]*NPC, error) {
+	return s.query(ctx, "claim_due", `
+		UPDATE npcs
+		   SET next_wander_at = $2
+		 WHERE next_wander_at <= $1
+		RETURNING `+npcColumns, pgnanos.From(now), pgnanos.From(now.Add(lease)))
+}
+
+// Reschedule sets the NPC's next move time.
+func (s *PostgresStore) Reschedule(ctx context.Context, id ulid.ULID, next time.Time) error {
+	return s.exec(ctx, "reschedule", id, `UPDATE npcs SET next_wander_at = $2 WHERE id = $1`,
+		id.String(), nullableNanos(next))
+}
+
+// exec runs a single-row statement against the NPC with id, mapping no
+// affected row to NPC_NOT_FOUND.
+func (s *PostgresStore) exec(ctx context.Context, operation string, id ulid.ULID, sql string, args ...any) error {
+	tag, err := s.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return oops.Code("NPC_STORE_FAILED").
+			With("operation", operation).
+			With("npc_id", id.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("NPC_NOT_FOUND").With("npc_id", id.String()).Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+func (s *PostgresStore) query(ctx context.Context, operation, sql string, args ...any) ([]*NPC, error) {
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, oops.Code("NPC_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+	var out []*NPC
+	for rows.Next() {
+		n, err := scanNPC(rows)
+		if err != nil {
+			return nil, oops.Code("NPC_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		out = append(out, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("NPC_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return out, nil
+}
+
+func marshalBehaviors(behaviors []Behavior) ([]byte, error) {
+	if behaviors == nil {
+		behaviors = []Behavior{}
+	}
+	raw, err := json.Marshal(behaviors)
+	if err != nil {
+		return nil, oops.With("operation", "marshal_behaviors").Wrap(err)
+	}
+	return raw, nil
+}
+
+// nullableNanos maps the zero time to NULL.
+func nullableNanos(t time.Time) *pgnanos.Time {
+	if t.IsZero() {
+		return nil
+	}
+	n := pgnanos.From(t)
+	return &n
+}
+
+func scanNPC(row pgx.Row) (*NPC, error) {
+	var (
+		n         NPC
+		id        string
+		locID     string
+		behaviors []byte
+		minGap    int64
+		maxGap    int64
+		next      *pgnanos.Time
+		createdAt pgnanos.Time
+	)
+	if err := row.Scan(&id, &n.Name, &n.Description, &locID, &n.Owner, &behaviors,
+		&minGap, &maxGap, &n.Wander.Zone, &next, &createdAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	parsed, err := ulid.Parse(id)
+	if err != nil {
+		return nil, oops.With("npc_id", id).Wrap(err)
+	}
+	loc, err := ulid.Parse(locID)
+	if err != nil {
+		return nil, oops.With("location_id", locID).Wrap(err)
+	}
+	if err := json.Unmarshal(behaviors, &n.Behaviors); err != nil {
+		return nil, oops.With("npc_id", id).With("operation", "unmarshal_behaviors").Wrap(err)
+	}
+	n.ID = parsed
+	n.LocationID = loc
+	n.Wander.MinInterval = time.Duration(minGap)
+	n.Wander.MaxInterval = time.Duration(maxGap)
+	if next != nil {
+		n.NextWanderAt = next.Time()
+	}
+	n.CreatedAt = createdAt.Time()
+	return &n, nil
+}
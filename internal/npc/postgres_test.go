@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package npc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createLocation(t *testing.T, pool *pgxpool.Pool) ulid.ULID {
+	t.Helper()
+	ctx := context.Background()
+	id := idgen.New()
+	_, err := pool.Exec(ctx, `
+		INSERT INTO locations (id, name, description, type, replay_policy, created_at)
+		VALUES ($1, 'Harbour', 'Test', 'persistent', 'last:0', (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT)
+	`, id.String())
+	require.NoError(t, err)
+	return id
+}
+
+func TestPostgresStoreRoundTrip(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := npc.NewPostgresStore(pool)
+	home := createLocation(t, pool)
+	pier := createLocation(t, pool)
+	now := time.Now().UTC()
+
+	n := &npc.NPC{
+		ID:          idgen.New(),
+		Name:        "Harbour Tom",
+		Description: "A grizzled harbourmaster.",
+		LocationID:  home,
+		Owner:       "character:" + idgen.New().String(),
+		CreatedAt:   now,
+	}
+	require.NoError(t, s.Create(ctx, n))
+	dup := *n
+	dup.ID = idgen.New()
+	dup.Name = "harbour tom"
+	errutil.AssertErrorCode(t, s.Create(ctx, &dup), "NPC_NAME_TAKEN")
+	orphan := *n
+	orphan.ID = idgen.New()
+	orphan.Name = "Orphan"
+	orphan.LocationID = idgen.New()
+	errutil.AssertErrorCode(t, s.Create(ctx, &orphan), "NPC_NOT_FOUND")
+
+	got, err := s.GetByName(ctx, "HARBOUR TOM")
+	require.NoError(t, err)
+	assert.Equal(t, n.ID, got.ID)
+	assert.Equal(t, now, got.CreatedAt)
+	assert.Empty(t, got.Behaviors)
+	assert.True(t, got.NextWanderAt.IsZero())
+
+	behaviors := []npc.Behavior{{Plugin: "barkeep", Triggers: []string{"say"}}}
+	require.NoError(t, s.SetBehaviors(ctx, n.ID, behaviors))
+	w := npc.Wander{MinInterval: time.Minute, MaxInterval: time.Hour, Zone: "docks"}
+	require.NoError(t, s.SetWander(ctx, n.ID, w, now))
+	got, err = s.Get(ctx, n.ID)
+	require.NoError(t, err)
+	assert.Equal(t, behaviors, got.Behaviors)
+	assert.Equal(t, w, got.Wander)
+
+	due, err := s.ClaimDue(ctx, now, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	due, err = s.ClaimDue(ctx, now, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, due, "a claimed wanderer is leased")
+
+	require.NoError(t, s.Move(ctx, n.ID, pier))
+	errutil.AssertErrorCode(t, s.Move(ctx, n.ID, idgen.New()), "NPC_NOT_FOUND")
+	at, err := s.ListAt(ctx, pier)
+	require.NoError(t, err)
+	require.Len(t, at, 1)
+
+	_, err = pool.Exec(ctx, `DELETE FROM locations WHERE id = $1`, pier.String())
+	require.NoError(t, err)
+	_, err = s.Get(ctx, n.ID)
+	assert.ErrorIs(t, err, npc.ErrNotFound, "NPCs go with their location")
+	errutil.AssertErrorCode(t, s.Delete(ctx, n.ID), "NPC_NOT_FOUND")
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package npc
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists NPCs.
+type Repository interface {
+	// Create stores a new NPC. Returns NPC_NAME_TAKEN when another NPC
+	// already has the name, ignoring case, and an error wrapping
+	// ErrNotFound when the location does not exist.
+	Create(ctx context.Context, n *NPC) error
+	// Get returns the NPC with id. Returns an error wrapping ErrNotFound
+	// when there is none.
+	Get(ctx context.Context, id ulid.ULID) (*NPC, error)
+	// GetByName returns the NPC named name, ignoring case. Returns an error
+	// wrapping ErrNotFound when there is none.
+	GetByName(ctx context.Context, name string) (*NPC, error)
+	// List returns every NPC ordered by name.
+	List(ctx context.Context) ([]*NPC, error)
+	// ListAt returns the NPCs in the location ordered by name.
+	ListAt(ctx context.Context, locationID ulid.ULID) ([]*NPC, error)
+	// Delete removes the NPC with id. Returns an error wrapping ErrNotFound
+	// when there is none.
+	Delete(ctx context.Context, id ulid.ULID) error
+
+	// SetBehaviors replaces the NPC's behaviors.
+	SetBehaviors(ctx context.Context, id ulid.ULID, behaviors []Behavior) error
+	// SetWander replaces the NPC's wander settings and next move time; a
+	// zero next clears it.
+	SetWander(ctx context.Context, id ulid.ULID, w Wander, next time.Time) error
+	// Move places the NPC in locationID.
+	Move(ctx context.Context, id, locationID ulid.ULID) error
+
+	// ClaimDue moves NextWanderAt to now+lease on every wanderer due at now
+	// and returns them. The claim is atomic, so each move is returned to
+	// exactly one caller across replicas.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) ([]*NPC, error)
+	// Reschedule sets the NPC's NextWanderAt.
+	Reschedule(ctx context.Context, id ulid.ULID, next time.Time) error
+}
@@ -0,0 +1,607 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package npc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// ABAC actions checked on an NPC's location before the NPC is read or
+// changed. Anyone who may edit a location may place NPCs in it.
+const (
+	ActionRead  = "read"
+	ActionWrite = "write"
+)
+
+// Timing and queueing.
+const (
+	// TickInterval is how often Run looks for wanderers that are due.
+	TickInterval = 15 * time.Second
+	// ClaimLease is how far ClaimDue pushes a claimed wanderer out, so a
+	// replica that dies mid-tick delays that NPC's next move rather than
+	// stopping it.
+	ClaimLease = 5 * time.Minute
+	// StimulusQueueSize bounds how many events may wait for NPC behaviors.
+	// Events arriving while the queue is full are dropped.
+	StimulusQueueSize = 256
+	// deliveryTimeout bounds one behavior plugin's handling of a stimulus.
+	deliveryTimeout = 5 * time.Second
+)
+
+// World is the subset of world.Service the service uses to find where a
+// wanderer can go.
+type World interface {
+	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+}
+
+// Plugins delivers stimuli to behavior plugins and publishes what they emit
+// in reply. *plugins.Manager satisfies it.
+type Plugins interface {
+	DeliverEvent(ctx context.Context, pluginName string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error)
+	EmitPluginEvent(ctx context.Context, pluginName string, emit pluginsdk.EmitEvent) error
+}
+
+// Service spawns, despawns, and edits NPCs, and makes them act. Edits
+// require write access to the NPC's location and are written to the
+// structured log as audit records.
+//
+// Acting needs an event publisher and the plugin host, which are bound
+// after construction with SetDelivery once the event bus is up. Until then
+// Tick and stimuli are no-ops and spawns are silent.
+type Service struct {
+	repo   Repository
+	engine types.AccessPolicyEngine
+	world  World
+	logger *slog.Logger
+	now    func() time.Time
+	intn   func(n int) int
+	int63n func(n int64) int64
+
+	stimuli chan eventbus.Event
+
+	mu      sync.RWMutex
+	pub     eventbus.Publisher
+	gameID  func() string
+	plugins Plugins
+}
+
+// NewService creates a Service. repo, engine, and w are required; a nil
+// logger uses slog.Default().
+func NewService(repo Repository, engine types.AccessPolicyEngine, w World, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("npc repository is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if w == nil {
+		return nil, oops.Errorf("world service is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		repo:    repo,
+		engine:  engine,
+		world:   w,
+		logger:  logger,
+		now:     time.Now,
+		intn:    cryptoIntN,
+		int63n:  cryptoInt64N,
+		stimuli: make(chan eventbus.Event, StimulusQueueSize),
+	}, nil
+}
+
+// SetDelivery binds the publisher NPC events are sent through and the
+// plugin host behaviors are delivered to. gameID supplies the game id that
+// qualifies event subjects. A nil plugins disables behaviors but not
+// wandering.
+func (s *Service) SetDelivery(pub eventbus.Publisher, gameID func() string, plugins Plugins) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+	s.plugins = plugins
+}
+
+func (s *Service) delivery() (eventbus.Publisher, func() string, Plugins) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil, nil
+	}
+	return s.pub, s.gameID, s.plugins
+}
+
+// Spawn creates an NPC named name in the location on behalf of subject and
+// announces it there. Returns NPC_INVALID for a bad name or description or
+// a full location, NPC_NAME_TAKEN when the name is in use, and
+// NPC_ACCESS_DENIED when subject may not edit the location.
+func (s *Service) Spawn(ctx context.Context, subject, name, description string, locationID ulid.ULID) (*NPC, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	if err := ValidateDescription(description); err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, locationID); err != nil {
+		return nil, err
+	}
+	present, err := s.repo.ListAt(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(present) >= MaxPerLocation {
+		return nil, oops.Code("NPC_INVALID").
+			With("location_id", locationID.String()).
+			Errorf("a location may hold at most %d NPCs", MaxPerLocation)
+	}
+	n := &NPC{
+		ID:          idgen.New(),
+		Name:        name,
+		Description: description,
+		LocationID:  locationID,
+		Owner:       subject,
+		CreatedAt:   s.now().UTC(),
+	}
+	if err := s.repo.Create(ctx, n); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "npc spawned",
+		"event", "npc_spawned",
+		"subject", subject,
+		"npc_id", n.ID.String(),
+		"name", n.Name,
+		"location_id", locationID.String(),
+	)
+	s.announce(ctx, n, n.LocationID, eventvocab.NPCActionSpawn, "", n.Name+" appears.")
+	return n, nil
+}
+
+// Despawn removes the NPC named name on behalf of subject and announces its
+// departure. Returns NPC_NOT_FOUND (wrapping ErrNotFound) when there is no
+// such NPC.
+func (s *Service) Despawn(ctx context.Context, subject, name string) error {
+	n, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, n.LocationID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, n.ID); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "npc despawned",
+		"event", "npc_despawned",
+		"subject", subject,
+		"npc_id", n.ID.String(),
+		"name", n.Name,
+		"location_id", n.LocationID.String(),
+	)
+	s.announce(ctx, n, n.LocationID, eventvocab.NPCActionDespawn, "", n.Name+" leaves.")
+	return nil
+}
+
+// Find returns the NPC named name on behalf of subject, who must be able to
+// read its location.
+func (s *Service) Find(ctx context.Context, subject, name string) (*NPC, error) {
+	n, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionRead, n.LocationID); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// List returns every NPC ordered by name. NPCs are public figures, so the
+// list is not access-checked; it backs WHO.
+func (s *Service) List(ctx context.Context) ([]*NPC, error) {
+	return s.repo.List(ctx)
+}
+
+// ListAt returns the NPCs in the location ordered by name. Like List it is
+// not access-checked; it backs location descriptions.
+func (s *Service) ListAt(ctx context.Context, locationID ulid.ULID) ([]*NPC, error) {
+	return s.repo.ListAt(ctx, locationID)
+}
+
+// SetBehaviors replaces the behaviors of the NPC named name on behalf of
+// subject. An empty list detaches every plugin.
+func (s *Service) SetBehaviors(ctx context.Context, subject, name string, behaviors []Behavior) (*NPC, error) {
+	if err := ValidateBehaviors(behaviors); err != nil {
+		return nil, err
+	}
+	n, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, n.LocationID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetBehaviors(ctx, n.ID, behaviors); err != nil {
+		return nil, err
+	}
+	n.Behaviors = behaviors
+	plugins := make([]string, 0, len(behaviors))
+	for _, b := range behaviors {
+		plugins = append(plugins, b.Plugin)
+	}
+	s.logger.InfoContext(ctx, "npc behaviors set",
+		"event", "npc_behaviors_set",
+		"subject", subject,
+		"npc_id", n.ID.String(),
+		"plugins", strings.Join(plugins, ","),
+	)
+	return n, nil
+}
+
+// SetWander replaces the wander settings of the NPC named name on behalf of
+// subject. A wanderer's first move is due a random interval from now; the
+// zero Wander stops it.
+func (s *Service) SetWander(ctx context.Context, subject, name string, w Wander) (*NPC, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	n, err := s.repo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, subject, ActionWrite, n.LocationID); err != nil {
+		return nil, err
+	}
+	var next time.Time
+	if w.Enabled() {
+		next = s.now().UTC().Add(nextInterval(w.MinInterval, w.MaxInterval, s.int63n))
+	}
+	if err := s.repo.SetWander(ctx, n.ID, w, next); err != nil {
+		return nil, err
+	}
+	n.Wander = w
+	n.NextWanderAt = next
+	s.logger.InfoContext(ctx, "npc wander set",
+		"event", "npc_wander_set",
+		"subject", subject,
+		"npc_id", n.ID.String(),
+		"wander", w.String(),
+	)
+	return n, nil
+}
+
+// Tap returns a publisher that publishes through next and then offers each
+// published event to NPC behaviors. Offering never blocks or fails the
+// publish: when the stimulus queue is full the event is dropped.
+func (s *Service) Tap(next eventbus.Publisher) eventbus.Publisher {
+	if next == nil {
+		panic("npc.Service.Tap: nil publisher")
+	}
+	return &tapPublisher{next: next, service: s}
+}
+
+type tapPublisher struct {
+	next    eventbus.Publisher
+	service *Service
+}
+
+func (p *tapPublisher) Publish(ctx context.Context, event eventbus.Event) error {
+	if err := p.next.Publish(ctx, event); err != nil {
+		return err //nolint:wrapcheck // transparent decorator: the inner publisher's error is the caller's
+	}
+	p.service.offer(ctx, event)
+	return nil
+}
+
+// offer queues a player's event on a location stream for behaviors. Events
+// from anything but a character — including the replies NPC behaviors emit
+// as plugins — are ignored, so NPCs never react to one another in a loop.
+// Sensitive events are never shown to plugins.
+func (s *Service) offer(ctx context.Context, event eventbus.Event) {
+	if event.Actor.Kind != eventbus.ActorKindCharacter || event.Sensitive {
+		return
+	}
+	if _, ok := locationOf(event.Subject); !ok {
+		return
+	}
+	select {
+	case s.stimuli <- event:
+	default:
+		s.logger.WarnContext(ctx, "npc stimulus dropped: queue full",
+			"event_id", event.ID.String(),
+			"event_type", string(event.Type))
+	}
+}
+
+// React delivers event to the behaviors of every NPC in the event's
+// location that listen for its type, and publishes their replies. Plugin
+// failures are collected and do not stop other behaviors.
+func (s *Service) React(ctx context.Context, event eventbus.Event) error {
+	_, _, plugins := s.delivery()
+	if plugins == nil {
+		return nil
+	}
+	locationID, ok := locationOf(event.Subject)
+	if !ok {
+		return nil
+	}
+	npcs, err := s.repo.ListAt(ctx, locationID)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, n := range npcs {
+		for _, b := range n.Behaviors {
+			if !b.Reacts(string(event.Type)) {
+				continue
+			}
+			if err := s.deliver(ctx, plugins, n, b.Plugin, event); err != nil {
+				errs = append(errs, oops.Code("NPC_BEHAVIOR_FAILED").
+					With("npc_id", n.ID.String()).
+					With("plugin", b.Plugin).
+					Wrap(err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deliver hands plugin an npc:<type> stimulus attributed to the system
+// actor, then publishes its emits through the shared plugin emitter so
+// manifest validation applies exactly as on the subscriber path.
+func (s *Service) deliver(ctx context.Context, plugins Plugins, n *NPC, plugin string, event eventbus.Event) error {
+	stimulus := eventvocab.NPCStimulusPayload{
+		NPCID:      n.ID.String(),
+		NPCName:    n.Name,
+		LocationID: n.LocationID.String(),
+		EventID:    event.ID.String(),
+		EventType:  string(event.Type),
+		ActorID:    event.Actor.ID.String(),
+	}
+	if len(event.Payload) > 0 && json.Valid(event.Payload) {
+		stimulus.Event = event.Payload
+	}
+	payload, err := json.Marshal(stimulus)
+	if err != nil {
+		return oops.With("operation", "marshal_npc_stimulus").Wrap(err)
+	}
+	delivered := pluginsdk.Event{
+		ID:        core.NewULID().String(),
+		Stream:    world.LocationStream(n.LocationID),
+		Type:      pluginsdk.EventType(eventvocab.NPCEventType(string(event.Type))),
+		Timestamp: s.now().UnixMilli(),
+		ActorKind: pluginsdk.ActorSystem,
+		ActorID:   core.ActorSystemID,
+		Payload:   string(payload),
+	}
+
+	dctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	dctx = core.WithActor(dctx, core.Actor{Kind: core.ActorSystem, ID: core.ActorSystemID})
+
+	emits, err := plugins.DeliverEvent(dctx, plugin, delivered)
+	if err != nil {
+		return oops.With("operation", "deliver").Wrap(err)
+	}
+	for _, emit := range emits {
+		if err := plugins.EmitPluginEvent(dctx, plugin, emit); err != nil {
+			return oops.With("operation", "emit").With("stream", emit.Stream).Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Tick moves every wanderer that is due through a random exit, then sets
+// its next move a fresh random interval out. Wanderers are claimed before
+// they move, so each moves once even with several replicas ticking.
+func (s *Service) Tick(ctx context.Context) error {
+	pub, _, _ := s.delivery()
+	if pub == nil {
+		return nil
+	}
+	now := s.now().UTC()
+	due, err := s.repo.ClaimDue(ctx, now, ClaimLease)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, n := range due {
+		if !n.Wander.Enabled() {
+			if err := s.repo.Reschedule(ctx, n.ID, time.Time{}); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := s.wander(ctx, n); err != nil {
+			errs = append(errs, oops.With("npc_id", n.ID.String()).Wrap(err))
+		}
+		next := now.Add(nextInterval(n.Wander.MinInterval, n.Wander.MaxInterval, s.int63n))
+		if err := s.repo.Reschedule(ctx, n.ID, next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// wander moves n through a random exit anyone may see and pass, staying in
+// its zone when it has one. An NPC with nowhere to go stays put.
+func (s *Service) wander(ctx context.Context, n *NPC) error {
+	sysCtx := access.WithSystemSubject(ctx)
+	// An NPC has no character, so the zero observer sees only exits
+	// everyone may see.
+	exits, err := s.world.GetVisibleExits(sysCtx, access.SubjectSystem, n.LocationID, ulid.ULID{})
+	if err != nil {
+		return oops.Code("NPC_WANDER_FAILED").Wrap(err)
+	}
+	var open []*world.Exit
+	for _, e := range exits {
+		if e.Locked {
+			continue
+		}
+		if n.Wander.Zone != "" {
+			dest, err := s.world.GetLocation(sysCtx, access.SubjectSystem, e.ToLocationID)
+			if err != nil || dest.ZoneID != n.Wander.Zone {
+				continue
+			}
+		}
+		open = append(open, e)
+	}
+	if len(open) == 0 {
+		return nil
+	}
+	exit := open[s.intn(len(open))]
+	if err := s.repo.Move(ctx, n.ID, exit.ToLocationID); err != nil {
+		return err
+	}
+	from := n.LocationID
+	n.LocationID = exit.ToLocationID
+	s.announce(ctx, n, from, eventvocab.NPCActionDepart, exit.Name, n.Name+" leaves "+exit.Name+".")
+	s.announce(ctx, n, n.LocationID, eventvocab.NPCActionArrive, "", n.Name+" arrives.")
+	s.logger.DebugContext(ctx, "npc wandered",
+		"npc_id", n.ID.String(),
+		"from", from.String(),
+		"to", n.LocationID.String(),
+		"exit", exit.Name,
+	)
+	return nil
+}
+
+// Run moves wanderers every TickInterval and hands queued events to
+// behaviors until ctx is cancelled. Errors are logged and do not stop the
+// loop.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Tick(ctx); err != nil {
+				s.logger.WarnContext(ctx, "npc tick failed", "error", err)
+			}
+		case event := <-s.stimuli:
+			if err := s.React(ctx, event); err != nil {
+				s.logger.WarnContext(ctx, "npc behavior failed",
+					"event_id", event.ID.String(),
+					"event_type", string(event.Type),
+					"error", err)
+			}
+		}
+	}
+}
+
+// announce publishes an npc event on locationID's stream. Non-fatal: the
+// change it describes is already committed, so a failure is logged.
+func (s *Service) announce(ctx context.Context, n *NPC, locationID ulid.ULID, action, exit, text string) {
+	pub, gameID, _ := s.delivery()
+	if pub == nil {
+		return
+	}
+	if err := publishNPC(ctx, pub, gameID, locationID, eventvocab.NPCPayload{
+		NPCID:      n.ID.String(),
+		Name:       n.Name,
+		LocationID: locationID.String(),
+		Action:     action,
+		Exit:       exit,
+		Text:       text,
+	}); err != nil {
+		s.logger.WarnContext(ctx, "npc event failed",
+			"npc_id", n.ID.String(),
+			"action", action,
+			"error", err)
+	}
+}
+
+func publishNPC(ctx context.Context, pub eventbus.Publisher, gameID func() string, locationID ulid.ULID, p eventvocab.NPCPayload) error {
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return oops.With("operation", "marshal_npc_payload").Wrap(err)
+	}
+	stream := world.LocationStream(locationID)
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeNPC))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeNPC)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, payload)); err != nil {
+		return oops.Code("NPC_PUBLISH_FAILED").With("stream", stream).Wrap(err)
+	}
+	return nil
+}
+
+// locationOf returns the location a qualified location-stream subject
+// ("events.<game>.location.<id>") names.
+func locationOf(subject eventbus.Subject) (ulid.ULID, bool) {
+	parts := strings.Split(string(subject), ".")
+	if len(parts) != 4 || parts[0] != "events" || parts[2] != "location" {
+		return ulid.ULID{}, false
+	}
+	id, err := ulid.Parse(parts[3])
+	if err != nil {
+		return ulid.ULID{}, false
+	}
+	return id, true
+}
+
+// checkAccess evaluates action on the location for subject. It fails
+// closed: engine errors and infrastructure failures deny.
+func (s *Service) checkAccess(ctx context.Context, subject, action string, locationID ulid.ULID) error {
+	resource := access.LocationResource(locationID.String())
+	req, err := types.NewAccessRequest(subject, action, resource, nil)
+	if err != nil {
+		return oops.Code("NPC_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("NPC_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "npc access denied",
+			"event", "npc_access_denied",
+			"subject", subject,
+			"action", action,
+			"location_id", locationID.String(),
+			"reason", decision.Reason(),
+		)
+		return oops.Code("NPC_ACCESS_DENIED").
+			With("location_id", locationID.String()).
+			Errorf("not permitted to %s NPCs in this location", action)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
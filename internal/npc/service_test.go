@@ -0,0 +1,492 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package npc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu   sync.Mutex
+	npcs map[ulid.ULID]*NPC
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{npcs: map[ulid.ULID]*NPC{}}
+}
+
+func (m *memRepository) Create(_ context.Context, n *NPC) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.npcs {
+		if strings.EqualFold(existing.Name, n.Name) {
+			return oops.Code("NPC_NAME_TAKEN").Errorf("an NPC named %q already exists", n.Name)
+		}
+	}
+	stored := *n
+	m.npcs[n.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) Get(_ context.Context, id ulid.ULID) (*NPC, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.npcs[id]
+	if !ok {
+		return nil, oops.Code("NPC_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	stored := *n
+	return &stored, nil
+}
+
+func (m *memRepository) GetByName(_ context.Context, name string) (*NPC, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, n := range m.npcs {
+		if strings.EqualFold(n.Name, name) {
+			stored := *n
+			return &stored, nil
+		}
+	}
+	return nil, oops.Code("NPC_NOT_FOUND").Wrap(ErrNotFound)
+}
+
+func (m *memRepository) List(_ context.Context) ([]*NPC, error) {
+	return m.filter(func(*NPC) bool { return true }), nil
+}
+
+func (m *memRepository) ListAt(_ context.Context, locationID ulid.ULID) ([]*NPC, error) {
+	return m.filter(func(n *NPC) bool { return n.LocationID == locationID }), nil
+}
+
+func (m *memRepository) filter(keep func(*NPC) bool) []*NPC {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*NPC
+	for _, n := range m.npcs {
+		if keep(n) {
+			stored := *n
+			out = append(out, &stored)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return strings.ToLower(out[i].Name) < strings.ToLower(out[j].Name) })
+	return out
+}
+
+func (m *memRepository) Delete(_ context.Context, id ulid.ULID) error {
+	return m.update(id, func(*NPC) {
+		delete(m.npcs, id)
+	})
+}
+
+func (m *memRepository) SetBehaviors(_ context.Context, id ulid.ULID, behaviors []Behavior) error {
+	return m.update(id, func(n *NPC) { n.Behaviors = behaviors })
+}
+
+func (m *memRepository) SetWander(_ context.Context, id ulid.ULID, w Wander, next time.Time) error {
+	return m.update(id, func(n *NPC) {
+		n.Wander = w
+		n.NextWanderAt = next
+	})
+}
+
+func (m *memRepository) Move(_ context.Context, id, locationID ulid.ULID) error {
+	return m.update(id, func(n *NPC) { n.LocationID = locationID })
+}
+
+func (m *memRepository) ClaimDue(_ context.Context, now time.Time, lease time.Duration) ([]*NPC, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*NPC
+	for _, n := range m.npcs {
+		if !n.NextWanderAt.IsZero() && !n.NextWanderAt.After(now) {
+			n.NextWanderAt = now.Add(lease)
+			stored := *n
+			out = append(out, &stored)
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) Reschedule(_ context.Context, id ulid.ULID, next time.Time) error {
+	return m.update(id, func(n *NPC) { n.NextWanderAt = next })
+}
+
+func (m *memRepository) update(id ulid.ULID, fn func(*NPC)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n, ok := m.npcs[id]
+	if !ok {
+		return oops.Code("NPC_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	fn(n)
+	return nil
+}
+
+func (m *memRepository) stored(id ulid.ULID) NPC {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return *m.npcs[id]
+}
+
+// fakeWorld holds locations and the exits out of them.
+type fakeWorld struct {
+	locations map[ulid.ULID]*world.Location
+	exits     map[ulid.ULID][]*world.Exit
+}
+
+func (f *fakeWorld) GetLocation(_ context.Context, _ string, id ulid.ULID) (*world.Location, error) {
+	loc, ok := f.locations[id]
+	if !ok {
+		return nil, world.ErrNotFound
+	}
+	return loc, nil
+}
+
+func (f *fakeWorld) GetVisibleExits(_ context.Context, _ string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error) {
+	return world.FilterVisibleExits(f.exits[locationID], world.ExitObserver{CharacterID: observerCharID}), nil
+}
+
+// fakePlugins records deliveries and replies with canned emits.
+type fakePlugins struct {
+	delivered []pluginsdk.Event
+	to        []string
+	replies   []pluginsdk.EmitEvent
+	emitted   []pluginsdk.EmitEvent
+	err       error
+}
+
+func (f *fakePlugins) DeliverEvent(_ context.Context, plugin string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.delivered = append(f.delivered, event)
+	f.to = append(f.to, plugin)
+	return f.replies, nil
+}
+
+func (f *fakePlugins) EmitPluginEvent(_ context.Context, _ string, emit pluginsdk.EmitEvent) error {
+	f.emitted = append(f.emitted, emit)
+	return nil
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) payloads(t *testing.T) []eventvocab.NPCPayload {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []eventvocab.NPCPayload
+	for _, ev := range f.published {
+		require.Equal(t, eventbus.Type(eventvocab.EventTypeNPC), ev.Type)
+		var p eventvocab.NPCPayload
+		require.NoError(t, json.Unmarshal(ev.Payload, &p))
+		assert.Equal(t, eventbus.Subject("events.main.location."+p.LocationID), ev.Subject)
+		out = append(out, p)
+	}
+	return out
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo    *memRepository
+	engine  *policytest.GrantEngine
+	world   *fakeWorld
+	plugins *fakePlugins
+	pub     *fakePublisher
+	logs    *bytes.Buffer
+	clock   time.Time
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	w := &fakeWorld{locations: map[ulid.ULID]*world.Location{}, exits: map[ulid.ULID][]*world.Exit{}}
+	var logs bytes.Buffer
+	svc, err := NewService(repo, engine, w, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	ts := &testService{
+		Service: svc,
+		repo:    repo,
+		engine:  engine,
+		world:   w,
+		plugins: &fakePlugins{},
+		pub:     &fakePublisher{},
+		logs:    &logs,
+		clock:   time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	svc.now = func() time.Time { return ts.clock }
+	svc.int63n = func(int64) int64 { return 0 }
+	svc.intn = func(int) int { return 0 }
+	svc.SetDelivery(ts.pub, mainGameID, ts.plugins)
+	return ts
+}
+
+// builder returns a subject granted read and write on each location.
+func (ts *testService) builder(locationIDs ...ulid.ULID) string {
+	subject := access.CharacterSubject(idgen.New().String())
+	for _, id := range locationIDs {
+		ts.engine.Grant(subject, ActionRead, access.LocationResource(id.String()))
+		ts.engine.Grant(subject, ActionWrite, access.LocationResource(id.String()))
+	}
+	return subject
+}
+
+// link adds a location in zone and a public exit to it from from.
+func (ts *testService) link(from ulid.ULID, name, zone string) ulid.ULID {
+	to := idgen.New()
+	ts.world.locations[to] = &world.Location{ID: to, ZoneID: zone}
+	ts.world.exits[from] = append(ts.world.exits[from], &world.Exit{
+		ID: idgen.New(), FromLocationID: from, ToLocationID: to, Name: name, Visibility: world.VisibilityAll,
+	})
+	return to
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	w := &fakeWorld{}
+	_, err := NewService(nil, policytest.AllowAllEngine(), w, nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, w, nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), policytest.AllowAllEngine(), nil, nil)
+	require.Error(t, err)
+}
+
+func TestSpawnAndDespawn(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	outsider := access.CharacterSubject(idgen.New().String())
+
+	_, err := ts.Spawn(ctx, outsider, "Old Tom", "", locID)
+	errutil.AssertErrorCode(t, err, "NPC_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"npc_access_denied"`)
+
+	subject := ts.builder(locID)
+	tom, err := ts.Spawn(ctx, subject, "Old Tom", "A grizzled harbourmaster.", locID)
+	require.NoError(t, err)
+	assert.Equal(t, subject, tom.Owner)
+	assert.Contains(t, ts.logs.String(), `"event":"npc_spawned"`)
+
+	_, err = ts.Spawn(ctx, subject, "old tom", "", locID)
+	errutil.AssertErrorCode(t, err, "NPC_NAME_TAKEN")
+	_, err = ts.Spawn(ctx, subject, "", "", locID)
+	errutil.AssertErrorCode(t, err, "NPC_INVALID")
+
+	found, err := ts.Find(ctx, subject, "OLD TOM")
+	require.NoError(t, err)
+	assert.Equal(t, tom.ID, found.ID)
+	at, err := ts.ListAt(ctx, locID)
+	require.NoError(t, err)
+	require.Len(t, at, 1)
+
+	errutil.AssertErrorCode(t, ts.Despawn(ctx, outsider, "Old Tom"), "NPC_ACCESS_DENIED")
+	require.NoError(t, ts.Despawn(ctx, subject, "Old Tom"))
+	errutil.AssertErrorCode(t, ts.Despawn(ctx, subject, "Old Tom"), "NPC_NOT_FOUND")
+
+	payloads := ts.pub.payloads(t)
+	require.Len(t, payloads, 2)
+	assert.Equal(t, eventvocab.NPCPayload{
+		NPCID: tom.ID.String(), Name: "Old Tom", LocationID: locID.String(),
+		Action: eventvocab.NPCActionSpawn, Text: "Old Tom appears.",
+	}, payloads[0])
+	assert.Equal(t, eventvocab.NPCActionDespawn, payloads[1].Action)
+}
+
+func TestSpawnCapsNPCsPerLocation(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+
+	for i := range MaxPerLocation {
+		_, err := ts.Spawn(ctx, subject, "Guard "+strings.Repeat("a", i+1), "", locID)
+		require.NoError(t, err)
+	}
+	_, err := ts.Spawn(ctx, subject, "One Too Many", "", locID)
+	errutil.AssertErrorCode(t, err, "NPC_INVALID")
+}
+
+func TestSpawnSucceedsWhenAnnouncementFails(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	ts.pub.err = errors.New("bus down")
+
+	_, err := ts.Spawn(ctx, ts.builder(locID), "Old Tom", "", locID)
+	require.NoError(t, err, "the NPC is committed before it is announced")
+	assert.Contains(t, ts.logs.String(), "npc event failed")
+}
+
+func TestSetBehaviorsAndReact(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locID := idgen.New()
+	subject := ts.builder(locID)
+	tom, err := ts.Spawn(ctx, subject, "Old Tom", "", locID)
+	require.NoError(t, err)
+
+	_, err = ts.SetBehaviors(ctx, subject, "Old Tom", []Behavior{{Plugin: "Bad"}})
+	errutil.AssertErrorCode(t, err, "NPC_INVALID")
+	_, err = ts.SetBehaviors(ctx, subject, "Old Tom", []Behavior{{Plugin: "barkeep", Triggers: []string{"say"}}})
+	require.NoError(t, err)
+	assert.Contains(t, ts.logs.String(), `"event":"npc_behaviors_set"`)
+
+	ts.plugins.replies = []pluginsdk.EmitEvent{{Stream: world.LocationStream(locID), Type: "barkeep:reply"}}
+	speaker := idgen.New()
+	sub := eventbus.Subject("events.main.location." + locID.String())
+	say := eventbus.NewEvent(sub, "say", eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: speaker}, []byte(`{"message":"Ahoy"}`))
+	require.NoError(t, ts.React(ctx, say))
+	require.NoError(t, ts.React(ctx, eventbus.NewEvent(sub, "pose", say.Actor, nil)), "pose is not a trigger")
+
+	require.Len(t, ts.plugins.delivered, 1)
+	assert.Equal(t, []string{"barkeep"}, ts.plugins.to)
+	delivered := ts.plugins.delivered[0]
+	assert.Equal(t, pluginsdk.EventType("npc:say"), delivered.Type)
+	assert.Equal(t, pluginsdk.ActorSystem, delivered.ActorKind)
+	var stimulus eventvocab.NPCStimulusPayload
+	require.NoError(t, json.Unmarshal([]byte(delivered.Payload), &stimulus))
+	assert.Equal(t, tom.ID.String(), stimulus.NPCID)
+	assert.Equal(t, say.ID.String(), stimulus.EventID)
+	assert.Equal(t, speaker.String(), stimulus.ActorID)
+	assert.JSONEq(t, `{"message":"Ahoy"}`, string(stimulus.Event))
+	assert.Equal(t, ts.plugins.replies, ts.plugins.emitted, "the plugin's reply is published")
+
+	ts.plugins.err = errors.New("plugin crashed")
+	errutil.AssertErrorCode(t, ts.React(ctx, say), "NPC_BEHAVIOR_FAILED")
+}
+
+func TestTapOffersOnlyPlayerLocationEvents(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	pub := ts.Tap(ts.pub)
+	loc := eventbus.Subject("events.main.location." + idgen.New().String())
+	player := eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: idgen.New()}
+
+	require.NoError(t, pub.Publish(ctx, eventbus.NewEvent(loc, "say", player, nil)))
+	require.NoError(t, pub.Publish(ctx, eventbus.NewEvent(loc, "say", eventbus.Actor{Kind: eventbus.ActorKindPlugin, ID: idgen.New()}, nil)))
+	require.NoError(t, pub.Publish(ctx, eventbus.NewEvent("events.main.character."+eventbus.Subject(idgen.New().String()), "page", player, nil)))
+	secret := eventbus.NewEvent(loc, "say", player, nil)
+	secret.Sensitive = true
+	require.NoError(t, pub.Publish(ctx, secret))
+
+	assert.Len(t, ts.pub.published, 4, "every event is still published")
+	require.Len(t, ts.stimuli, 1)
+	assert.Equal(t, player, (<-ts.stimuli).Actor)
+
+	ts.pub.err = errors.New("bus down")
+	require.Error(t, pub.Publish(ctx, eventbus.NewEvent(loc, "say", player, nil)))
+	assert.Empty(t, ts.stimuli, "an unpublished event is not offered")
+}
+
+func TestTickWandersThroughOpenExits(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	home := idgen.New()
+	ts.world.locations[home] = &world.Location{ID: home, ZoneID: "docks"}
+	town := ts.link(home, "north", "town")
+	pier := ts.link(home, "east", "docks")
+	ts.world.exits[home] = append(ts.world.exits[home],
+		&world.Exit{Name: "locked", ToLocationID: idgen.New(), Locked: true, Visibility: world.VisibilityAll},
+		&world.Exit{Name: "hidden", ToLocationID: idgen.New(), Visibility: world.VisibilityOwner})
+	subject := ts.builder(home)
+	tom, err := ts.Spawn(ctx, subject, "Old Tom", "", home)
+	require.NoError(t, err)
+
+	_, err = ts.SetWander(ctx, subject, "Old Tom", Wander{MinInterval: time.Second})
+	errutil.AssertErrorCode(t, err, "NPC_INVALID")
+	_, err = ts.SetWander(ctx, subject, "Old Tom", Wander{MinInterval: time.Minute, MaxInterval: time.Minute, Zone: "docks"})
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Tick(ctx))
+	assert.Equal(t, home, ts.repo.stored(tom.ID).LocationID, "nothing is due yet")
+
+	ts.clock = ts.clock.Add(time.Minute)
+	require.NoError(t, ts.Tick(ctx))
+	moved := ts.repo.stored(tom.ID)
+	assert.Equal(t, pier, moved.LocationID, "the zone keeps Tom off the town exit")
+	assert.NotEqual(t, town, moved.LocationID)
+	assert.Equal(t, ts.clock.Add(time.Minute), moved.NextWanderAt)
+
+	payloads := ts.pub.payloads(t)
+	require.Len(t, payloads, 3)
+	assert.Equal(t, eventvocab.NPCPayload{
+		NPCID: tom.ID.String(), Name: "Old Tom", LocationID: home.String(),
+		Action: eventvocab.NPCActionDepart, Exit: "east", Text: "Old Tom leaves east.",
+	}, payloads[1])
+	assert.Equal(t, pier.String(), payloads[2].LocationID)
+	assert.Equal(t, eventvocab.NPCActionArrive, payloads[2].Action)
+
+	ts.clock = ts.clock.Add(time.Minute)
+	require.NoError(t, ts.Tick(ctx), "a dead end leaves the NPC put")
+	assert.Equal(t, pier, ts.repo.stored(tom.ID).LocationID)
+
+	_, err = ts.SetWander(ctx, access.SubjectSystem, "Old Tom", Wander{})
+	errutil.AssertErrorCode(t, err, "NPC_ACCESS_DENIED")
+}
+
+func TestTickIsNoOpUntilDeliveryBound(t *testing.T) {
+	svc, err := NewService(newMemRepository(), policytest.AllowAllEngine(), &fakeWorld{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, svc.Tick(context.Background()))
+	require.NoError(t, svc.React(context.Background(), eventbus.Event{}))
+}
+
+func TestLocationOf(t *testing.T) {
+	id := idgen.New()
+	got, ok := locationOf(eventbus.Subject("events.main.location." + id.String()))
+	require.True(t, ok)
+	assert.Equal(t, id, got)
+
+	for _, subject := range []string{
+		"events.main.character." + id.String(),
+		"events.main.location.not-a-ulid",
+		"events.main.location." + id.String() + ".extra",
+	} {
+		_, ok := locationOf(eventbus.Subject(subject))
+		assert.False(t, ok, subject)
+	}
+}
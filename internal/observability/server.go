@@ -177,6 +177,7 @@ type Server struct {
 	registry   *prometheus.Registry
 	metrics    *Metrics
 	isReady    ReadinessChecker
+	routes     map[string]http.Handler
 	running    atomic.Bool
 }
 
@@ -225,6 +226,16 @@ func (s *Server) Registerer() prometheus.Registerer {
 	return s.registry
 }
 
+// Handle adds an HTTP route to the endpoints the server serves, such as the
+// health package's /healthz and /readyz probes. It must be called before
+// Start; routes added later are not served.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	if s.routes == nil {
+		s.routes = make(map[string]http.Handler)
+	}
+	s.routes[pattern] = handler
+}
+
 // Start begins serving observability endpoints.
 // It returns an error channel that will receive any errors from the HTTP server
 // after it starts. The channel is closed when the server stops gracefully.
@@ -252,6 +263,10 @@ func (s *Server) Start() (<-chan error, error) {
 	mux.HandleFunc("/healthz/liveness", s.handleLiveness)
 	mux.HandleFunc("/healthz/readiness", s.handleReadiness)
 
+	for pattern, handler := range s.routes {
+		mux.Handle(pattern, handler)
+	}
+
 	httpSrv := &http.Server{
 		Handler:           mux,
 		ReadHeaderTimeout: 10 * time.Second,
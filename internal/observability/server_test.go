@@ -198,6 +198,27 @@ func TestServerReadinessReturns200WhenCheckerIsNil(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode, "expected status 200 with nil checker")
 }
 
+func TestServerServesAddedRoutes(t *testing.T) {
+	server := NewServer("127.0.0.1:0", nil)
+	server.Handle("/readyz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	_, err := server.Start()
+	require.NoError(t, err, "failed to start server")
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Stop(ctx)
+	}()
+
+	resp, err := http.Get("http://" + server.Addr() + "/readyz")
+	require.NoError(t, err, "failed to GET /readyz")
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "expected the added handler to answer")
+}
+
 func TestServerStartFailsWithInvalidAddress(t *testing.T) {
 	server := NewServer("not-a-valid-address:99999", nil)
 
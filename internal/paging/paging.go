@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package paging delivers private out-of-character messages between
+// characters anywhere in the game. A page to a connected character is
+// published on that character's stream; a page to one who is not
+// connected is, by default, held in their page mailbox and delivered when
+// they next log in. Every character keeps ignore and gag lists: an ignored
+// sender is told the page was refused, a gagged sender's pages are dropped
+// without telling them. Either list also hides the blocked character's
+// speech (say, pose, and the like) when the event fan-out asks Ignores.
+// Staff cannot be blocked, so players can always be reached by moderators.
+//
+// The sender gets a page_receipt event for each page saying whether it was
+// delivered or is waiting, and a second one when a held page is delivered.
+// Pages and receipts are sensitive and encrypted at rest.
+package paging
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// Limits.
+const (
+	// MaxMessageLength bounds one page in bytes.
+	MaxMessageLength = 2000
+	// MaxHeldPages bounds how many pages wait in one character's mailbox.
+	MaxHeldPages = 100
+)
+
+// StaffRoles are the roles whose holders cannot be ignored or gagged.
+var StaffRoles = []string{"staff", access.RoleAdmin}
+
+// Sentinel errors.
+var (
+	// ErrNotFound is returned when a character does not exist.
+	ErrNotFound = errors.New("paging character not found")
+	// ErrMailboxFull is returned when a character's mailbox already holds
+	// MaxHeldPages pages.
+	ErrMailboxFull = errors.New("page mailbox full")
+)
+
+// Fallback is what happens to a page sent to a character who is not
+// connected.
+type Fallback string
+
+// Offline fallbacks.
+const (
+	// FallbackMail holds the page until the recipient next logs in.
+	FallbackMail Fallback = "mail"
+	// FallbackNone refuses the page.
+	FallbackNone Fallback = "none"
+)
+
+// ParseFallback parses the game.page_offline_fallback setting; empty means
+// FallbackMail. Returns PAGE_INVALID_FALLBACK for anything else.
+func ParseFallback(s string) (Fallback, error) {
+	switch f := Fallback(strings.ToLower(strings.TrimSpace(s))); f {
+	case "":
+		return FallbackMail, nil
+	case FallbackMail, FallbackNone:
+		return f, nil
+	default:
+		return "", oops.Code("PAGE_INVALID_FALLBACK").
+			With("fallback", s).
+			Errorf("page offline fallback %q must be %q or %q", s, FallbackMail, FallbackNone)
+	}
+}
+
+// BlockMode is how a character blocks pages from another.
+type BlockMode string
+
+// Block modes.
+const (
+	// BlockIgnore refuses the sender's pages and tells them so.
+	BlockIgnore BlockMode = "ignore"
+	// BlockGag drops the sender's pages without telling them.
+	BlockGag BlockMode = "gag"
+)
+
+// Status is what became of a page.
+type Status string
+
+// Delivery statuses.
+const (
+	// StatusDelivered means the page reached the recipient's stream.
+	StatusDelivered Status = "delivered"
+	// StatusHeld means the page waits in the recipient's mailbox.
+	StatusHeld Status = "held"
+)
+
+// CharacterRef identifies a character by ID and display name.
+type CharacterRef struct {
+	ID   ulid.ULID
+	Name string
+}
+
+// Block is one entry on a character's ignore or gag list.
+type Block struct {
+	Character CharacterRef
+	Mode      BlockMode
+	CreatedAt time.Time
+	// Staff marks a character who has been given a staff role since they
+	// were blocked. The entry is kept but not enforced.
+	Staff bool
+}
+
+// Message is one page. Text is what the sender typed, without the pose
+// prefix. Pose marks a pose page (":waves"), and NoSpace one whose text
+// joins the sender's name directly (";'s phone rings").
+type Message struct {
+	ID      ulid.ULID
+	From    CharacterRef
+	To      CharacterRef
+	Text    string
+	Pose    bool
+	NoSpace bool
+	SentAt  time.Time
+}
+
+// NewMessage builds a page from from to to. raw is the message as typed:
+// a leading ":" makes a pose page and a leading ";" a pose page with no
+// space after the sender's name. Returns PAGE_INVALID_MESSAGE for an empty
+// message, one that is not UTF-8, or one longer than MaxMessageLength.
+func NewMessage(id ulid.ULID, from, to CharacterRef, raw string, at time.Time) (*Message, error) {
+	msg := &Message{ID: id, From: from, To: to, SentAt: at.UTC()}
+	text := strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(text, ":"):
+		msg.Pose = true
+		text = strings.TrimSpace(text[1:])
+	case strings.HasPrefix(text, ";"):
+		msg.Pose, msg.NoSpace = true, true
+		text = strings.TrimSpace(text[1:])
+	}
+	if text == "" || len(text) > MaxMessageLength || !utf8.ValidString(text) {
+		return nil, oops.Code("PAGE_INVALID_MESSAGE").
+			Errorf("a page must be text of 1 to %d bytes", MaxMessageLength)
+	}
+	msg.Text = text
+	return msg, nil
+}
+
+// RecipientText is the line the recipient is shown, e.g. "Alice pages: hi"
+// or "From afar, Alice waves."
+func (m *Message) RecipientText() string {
+	if m.Pose {
+		return "From afar, " + m.posed()
+	}
+	return fmt.Sprintf("%s pages: %s", m.From.Name, m.Text)
+}
+
+// SenderText is the line the sender is shown, e.g. "You paged Bob: hi" or
+// "Long distance to Bob: Alice waves."
+func (m *Message) SenderText() string {
+	if m.Pose {
+		return fmt.Sprintf("Long distance to %s: %s", m.To.Name, m.posed())
+	}
+	return fmt.Sprintf("You paged %s: %s", m.To.Name, m.Text)
+}
+
+func (m *Message) posed() string {
+	if m.NoSpace {
+		return m.From.Name + m.Text
+	}
+	return m.From.Name + " " + m.Text
+}
+
+// Receipt reports what became of a page.
+type Receipt struct {
+	MessageID ulid.ULID
+	To        CharacterRef
+	Status    Status
+}
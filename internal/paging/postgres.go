@@ -0,0 +1,243 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package paging
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// pgForeignKeyViolation is the SQLSTATE raised when a row names a
+// character that does not exist.
+const pgForeignKeyViolation = "23503"
+
+// PostgresStore implements Repository against the page_blocks and
+// page_mailbox tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// BlockMode returns how characterID blocks senderID, or "" when it does not
+// or senderID is staff.
+func (s *PostgresStore) BlockMode(ctx context.Context, characterID, senderID ulid.ULID) (BlockMode, error) {
+	var mode string
+	err := s.pool.QueryRow(ctx, `
+		SELECT mode FROM page_blocks
+		 WHERE character_id = $1 AND blocked_id = $2
+		   AND NOT EXISTS (SELECT 1 FROM character_roles r WHERE r.character_id = $2 AND r.role = ANY($3))
+	`, characterID.String(), senderID.String(), StaffRoles).Scan(&mode)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", oops.Code("PAGE_STORE_FAILED").
+			With("operation", "block_mode").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return BlockMode(mode), nil
+}
+
+// IsStaff reports whether characterID holds one of StaffRoles.
+func (s *PostgresStore) IsStaff(ctx context.Context, characterID ulid.ULID) (bool, error) {
+	var staff bool
+	err := s.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM character_roles WHERE character_id = $1 AND role = ANY($2))
+	`, characterID.String(), StaffRoles).Scan(&staff)
+	if err != nil {
+		return false, oops.Code("PAGE_STORE_FAILED").
+			With("operation", "is_staff").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return staff, nil
+}
+
+// SetBlock upserts characterID's entry for blockedID.
+func (s *PostgresStore) SetBlock(ctx context.Context, characterID, blockedID ulid.ULID, mode BlockMode, at time.Time) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO page_blocks (character_id, blocked_id, mode, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (character_id, blocked_id) DO UPDATE
+		   SET mode = EXCLUDED.mode, created_at = EXCLUDED.created_at
+	`, characterID.String(), blockedID.String(), string(mode), pgnanos.From(at))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return oops.Code("PAGE_NOT_FOUND").
+				With("character_id", characterID.String()).
+				With("blocked_id", blockedID.String()).
+				Wrap(ErrNotFound)
+		}
+		return oops.Code("PAGE_STORE_FAILED").
+			With("operation", "set_block").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// RemoveBlock deletes characterID's entry for blockedID when it has mode.
+func (s *PostgresStore) RemoveBlock(ctx context.Context, characterID, blockedID ulid.ULID, mode BlockMode) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+		DELETE FROM page_blocks WHERE character_id = $1 AND blocked_id = $2 AND mode = $3
+	`, characterID.String(), blockedID.String(), string(mode))
+	if err != nil {
+		return false, oops.Code("PAGE_STORE_FAILED").
+			With("operation", "remove_block").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Blocks returns characterID's block list ordered by name.
+func (s *PostgresStore) Blocks(ctx context.Context, characterID ulid.ULID) ([]Block, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT b.blocked_id, c.name, b.mode, b.created_at,
+		       EXISTS (SELECT 1 FROM character_roles r WHERE r.character_id = b.blocked_id AND r.role = ANY($2))
+		  FROM page_blocks b
+		  JOIN characters c ON c.id = b.blocked_id
+		 WHERE b.character_id = $1
+		 ORDER BY LOWER(c.name), b.blocked_id
+	`, characterID.String(), StaffRoles)
+	if err != nil {
+		return nil, oops.Code("PAGE_STORE_FAILED").
+			With("operation", "blocks").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+	var out []Block
+	for rows.Next() {
+		var (
+			b         Block
+			id, mode  string
+			createdAt pgnanos.Time
+		)
+		if err := rows.Scan(&id, &b.Character.Name, &mode, &createdAt, &b.Staff); err != nil {
+			return nil, oops.Code("PAGE_STORE_FAILED").With("operation", "blocks").Wrap(err)
+		}
+		parsed, err := ulid.Parse(id)
+		if err != nil {
+			return nil, oops.Code("PAGE_STORE_FAILED").With("character_id", id).Wrap(err)
+		}
+		b.Character.ID = parsed
+		b.Mode = BlockMode(mode)
+		b.CreatedAt = createdAt.Time()
+		out = append(out, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("PAGE_STORE_FAILED").With("operation", "blocks").Wrap(err)
+	}
+	return out, nil
+}
+
+// Hold inserts msg unless its recipient's mailbox is full. The limit is a
+// guard against flooding, not an exact quota: senders racing for the last
+// slot may overshoot it by a page or two.
+func (s *PostgresStore) Hold(ctx context.Context, msg *Message, limit int) error {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO page_mailbox (id, recipient_id, sender_id, sender_name, message, is_pose, no_space, sent_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		 WHERE (SELECT COUNT(*) FROM page_mailbox WHERE recipient_id = $2) < $9
+	`, msg.ID.String(), msg.To.ID.String(), msg.From.ID.String(), msg.From.Name, msg.Text,
+		msg.Pose, msg.NoSpace, pgnanos.From(msg.SentAt), limit)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return oops.Code("PAGE_NOT_FOUND").
+				With("recipient_id", msg.To.ID.String()).
+				Wrap(ErrNotFound)
+		}
+		return oops.Code("PAGE_STORE_FAILED").
+			With("operation", "hold").
+			With("recipient_id", msg.To.ID.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("PAGE_MAILBOX_FULL").
+			With("recipient_id", msg.To.ID.String()).
+			With("limit", limit).
+			Wrap(ErrMailboxFull)
+	}
+	return nil
+}
+
+// Held returns recipientID's waiting pages, oldest first.
+func (s *PostgresStore) Held(ctx context.Context, recipientID ulid.ULID) ([]*Message, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT m.id, COALESCE(m.sender_id, ''), m.sender_name, c.name, m.message, m.is_pose, m.no_space, m.sent_at
+		  FROM page_mailbox m
+		  JOIN characters c ON c.id = m.recipient_id
+		 WHERE m.recipient_id = $1
+		 ORDER BY m.sent_at, m.id
+	`, recipientID.String())
+	if err != nil {
+		return nil, oops.Code("PAGE_STORE_FAILED").
+			With("operation", "held").
+			With("recipient_id", recipientID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+	var out []*Message
+	for rows.Next() {
+		var (
+			msg          Message
+			id, senderID string
+			sentAt       pgnanos.Time
+		)
+		if err := rows.Scan(&id, &senderID, &msg.From.Name, &msg.To.Name, &msg.Text, &msg.Pose, &msg.NoSpace, &sentAt); err != nil {
+			return nil, oops.Code("PAGE_STORE_FAILED").With("operation", "held").Wrap(err)
+		}
+		parsed, err := ulid.Parse(id)
+		if err != nil {
+			return nil, oops.Code("PAGE_STORE_FAILED").With("message_id", id).Wrap(err)
+		}
+		msg.ID = parsed
+		if senderID != "" {
+			sender, err := ulid.Parse(senderID)
+			if err != nil {
+				return nil, oops.Code("PAGE_STORE_FAILED").With("sender_id", senderID).Wrap(err)
+			}
+			msg.From.ID = sender
+		}
+		msg.To.ID = recipientID
+		msg.SentAt = sentAt.Time()
+		out = append(out, &msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("PAGE_STORE_FAILED").With("operation", "held").Wrap(err)
+	}
+	return out, nil
+}
+
+// DeleteHeld removes the pages with ids from the mailbox.
+func (s *PostgresStore) DeleteHeld(ctx context.Context, ids []ulid.ULID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	raw := make([]string, len(ids))
+	for i, id := range ids {
+		raw[i] = id.String()
+	}
+	if _, err := s.pool.Exec(ctx, `DELETE FROM page_mailbox WHERE id = ANY($1)`, raw); err != nil {
+		return oops.Code("PAGE_STORE_FAILED").With("operation", "delete_held").Wrap(err)
+	}
+	return nil
+}
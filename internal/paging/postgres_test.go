@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package paging_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/paging"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createCharacter(t *testing.T, pool *pgxpool.Pool, name string) paging.CharacterRef {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO characters (id, name) VALUES ($1, $2)`, id.String(), name)
+	require.NoError(t, err)
+	return paging.CharacterRef{ID: id, Name: name}
+}
+
+func newMessage(t *testing.T, from, to paging.CharacterRef, text string, at time.Time) *paging.Message {
+	t.Helper()
+	msg, err := paging.NewMessage(idgen.New(), from, to, text, at)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestPostgresStoreBlocks(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := paging.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	bob := createCharacter(t, pool, "Bob-"+idgen.New().String())
+	carol := createCharacter(t, pool, "Carol-"+idgen.New().String())
+
+	mode, err := st.BlockMode(ctx, alice.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Empty(t, mode)
+
+	require.NoError(t, st.SetBlock(ctx, alice.ID, bob.ID, paging.BlockIgnore, time.Now()))
+	require.NoError(t, st.SetBlock(ctx, alice.ID, carol.ID, paging.BlockIgnore, time.Now()))
+	require.NoError(t, st.SetBlock(ctx, alice.ID, bob.ID, paging.BlockGag, time.Now()))
+	mode, err = st.BlockMode(ctx, alice.ID, bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, paging.BlockGag, mode, "setting a block replaces the other mode")
+	mode, err = st.BlockMode(ctx, bob.ID, alice.ID)
+	require.NoError(t, err)
+	assert.Empty(t, mode, "blocks are one-way")
+
+	blocks, err := st.Blocks(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, bob, blocks[0].Character)
+	assert.Equal(t, paging.BlockGag, blocks[0].Mode)
+	assert.Equal(t, carol, blocks[1].Character)
+
+	removed, err := st.RemoveBlock(ctx, alice.ID, bob.ID, paging.BlockIgnore)
+	require.NoError(t, err)
+	assert.False(t, removed, "the mode must match")
+	removed, err = st.RemoveBlock(ctx, alice.ID, bob.ID, paging.BlockGag)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	err = st.SetBlock(ctx, alice.ID, idgen.New(), paging.BlockIgnore, time.Now())
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_FOUND")
+	assert.ErrorIs(t, err, paging.ErrNotFound)
+}
+
+func TestPostgresStoreStaffBlocksAreNotEnforced(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := paging.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	mod := createCharacter(t, pool, "Mod-"+idgen.New().String())
+
+	staff, err := st.IsStaff(ctx, mod.ID)
+	require.NoError(t, err)
+	assert.False(t, staff)
+
+	require.NoError(t, st.SetBlock(ctx, alice.ID, mod.ID, paging.BlockIgnore, time.Now()))
+	_, err = pool.Exec(ctx, `INSERT INTO character_roles (character_id, role) VALUES ($1, 'staff')`, mod.ID.String())
+	require.NoError(t, err)
+
+	staff, err = st.IsStaff(ctx, mod.ID)
+	require.NoError(t, err)
+	assert.True(t, staff)
+	mode, err := st.BlockMode(ctx, alice.ID, mod.ID)
+	require.NoError(t, err)
+	assert.Empty(t, mode, "a staff sender is never blocked")
+	blocks, err := st.Blocks(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.True(t, blocks[0].Staff)
+}
+
+func TestPostgresStoreMailbox(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := paging.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	bob := createCharacter(t, pool, "Bob-"+idgen.New().String())
+	base := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	second := newMessage(t, alice, bob, ":waves.", base.Add(time.Minute))
+	first := newMessage(t, alice, bob, "hello", base)
+	require.NoError(t, st.Hold(ctx, second, 2))
+	require.NoError(t, st.Hold(ctx, first, 2))
+	err := st.Hold(ctx, newMessage(t, alice, bob, "too many", base), 2)
+	errutil.AssertErrorCode(t, err, "PAGE_MAILBOX_FULL")
+	assert.ErrorIs(t, err, paging.ErrMailboxFull)
+
+	held, err := st.Held(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, held, 2)
+	assert.Equal(t, first.ID, held[0].ID, "oldest first")
+	assert.Equal(t, alice, held[0].From)
+	assert.Equal(t, bob, held[0].To)
+	assert.Equal(t, "hello", held[0].Text)
+	assert.True(t, held[0].SentAt.Equal(base))
+	assert.True(t, held[1].Pose)
+	assert.Equal(t, "waves.", held[1].Text)
+
+	require.NoError(t, st.DeleteHeld(ctx, []ulid.ULID{first.ID}))
+	held, err = st.Held(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+
+	// A deleted sender's page keeps its name and loses its ID.
+	_, err = pool.Exec(ctx, `DELETE FROM characters WHERE id = $1`, alice.ID.String())
+	require.NoError(t, err)
+	held, err = st.Held(ctx, bob.ID)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+	assert.Equal(t, paging.CharacterRef{Name: alice.Name}, held[0].From)
+}
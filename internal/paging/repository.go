@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package paging
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists block lists and held pages.
+type Repository interface {
+	// BlockMode returns how characterID blocks pages from senderID, and ""
+	// when it does not or senderID holds one of StaffRoles.
+	BlockMode(ctx context.Context, characterID, senderID ulid.ULID) (BlockMode, error)
+	// IsStaff reports whether characterID holds one of StaffRoles.
+	IsStaff(ctx context.Context, characterID ulid.ULID) (bool, error)
+	// SetBlock puts blocked on characterID's list with mode, replacing any
+	// entry it already has.
+	SetBlock(ctx context.Context, characterID, blockedID ulid.ULID, mode BlockMode, at time.Time) error
+	// RemoveBlock takes blocked off characterID's list if it is there with
+	// mode, and reports whether it was.
+	RemoveBlock(ctx context.Context, characterID, blockedID ulid.ULID, mode BlockMode) (bool, error)
+	// Blocks returns characterID's ignore and gag lists ordered by name,
+	// with Staff set on entries for characters holding one of StaffRoles.
+	Blocks(ctx context.Context, characterID ulid.ULID) ([]Block, error)
+	// Hold stores msg in its recipient's mailbox. When the mailbox already
+	// holds limit pages nothing is written and the error wraps
+	// ErrMailboxFull.
+	Hold(ctx context.Context, msg *Message, limit int) error
+	// Held returns the pages waiting for recipientID, oldest first. The
+	// sender ID of a page whose sender has since been deleted is zero.
+	Held(ctx context.Context, recipientID ulid.ULID) ([]*Message, error)
+	// DeleteHeld removes delivered pages from the mailbox.
+	DeleteHeld(ctx context.Context, ids []ulid.ULID) error
+}
@@ -0,0 +1,541 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package paging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Characters resolves character names the way the sender sees the world,
+// so a page never reaches, or reveals, a character hidden from them.
+// *world.Service satisfies it.
+type Characters interface {
+	FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*world.Character, error)
+}
+
+// Sessions finds the session a character is connected with and remembers
+// whom it last paged. session.Access satisfies it.
+type Sessions interface {
+	// FindByCharacter returns the character's active or detached session,
+	// or SESSION_NOT_FOUND when it has none.
+	FindByCharacter(ctx context.Context, characterID ulid.ULID) (*session.Info, error)
+	UpdateLastPaged(ctx context.Context, sessionID string, name string) error
+}
+
+// SendRequest asks for a page from From to the character named To. An
+// empty To pages the character From last paged in this session. Message
+// is the text as typed; see NewMessage.
+type SendRequest struct {
+	From    CharacterRef
+	To      string
+	Message string
+}
+
+// blockCacheTTL bounds how long Ignores answers from a character's cached
+// block list. Changes made through this Service apply at once; changes made
+// on another replica apply within the TTL.
+const blockCacheTTL = 30 * time.Second
+
+// Service sends pages and keeps block lists. A character with an active or
+// detached session counts as connected: a detached session's stream is
+// replayed when it reattaches.
+//
+// Pages travel as events, so the service needs an event publisher, which
+// is bound after construction with SetPublisher once the event bus is up.
+// Until then Send returns PAGE_UNAVAILABLE and held pages stay held.
+type Service struct {
+	repo       Repository
+	characters Characters
+	sessions   Sessions
+	fallback Fallback
+	logger   *slog.Logger
+	now      func() time.Time
+
+	mu     sync.RWMutex
+	pub    eventbus.Publisher
+	gameID func() string
+
+	blocksMu  sync.Mutex
+	blocks    map[ulid.ULID]blockEntry
+	lastSweep time.Time
+}
+
+// blockEntry is a character's enforced block list, as fetched at fetchedAt.
+type blockEntry struct {
+	blocked   map[ulid.ULID]struct{}
+	fetchedAt time.Time
+}
+
+// NewService creates a Service. repo, characters, and sessions are
+// required; a nil logger uses slog.Default(). fallback is the
+// game.page_offline_fallback setting; see ParseFallback.
+func NewService(repo Repository, characters Characters, sessions Sessions, fallback string, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("paging repository is required")
+	}
+	if characters == nil {
+		return nil, oops.Errorf("character lookup is required")
+	}
+	if sessions == nil {
+		return nil, oops.Errorf("session access is required")
+	}
+	f, err := ParseFallback(fallback)
+	if err != nil {
+		return nil, err
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		repo:       repo,
+		characters: characters,
+		sessions:   sessions,
+		fallback:   f,
+		logger:     logger,
+		now:        time.Now,
+		blocks:     make(map[ulid.ULID]blockEntry),
+	}, nil
+}
+
+// SetPublisher binds the publisher used for page and page_receipt events.
+// gameID supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// Fallback returns what happens to pages for characters not connected.
+func (s *Service) Fallback() Fallback {
+	return s.fallback
+}
+
+// FindCharacter looks up the character named name, ignoring case, as
+// observerID sees it. Returns PAGE_NOT_FOUND (wrapping ErrNotFound) when
+// there is none or observerID cannot see it.
+func (s *Service) FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (CharacterRef, error) {
+	char, err := s.characters.FindCharacterByName(ctx, observerID, name)
+	if errors.Is(err, world.ErrNotFound) {
+		return CharacterRef{}, oops.Code("PAGE_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return CharacterRef{}, oops.Code("PAGE_STORE_FAILED").
+			With("operation", "find_character").
+			With("name", name).
+			Wrap(err)
+	}
+	return CharacterRef{ID: char.ID, Name: char.Name}, nil
+}
+
+// Send pages a character and tells the sender what became of it with a
+// page_receipt event. It returns the same receipt. Returns:
+//
+//   - PAGE_INVALID_MESSAGE for an empty or oversized message;
+//   - PAGE_NO_LAST_PAGED when To is empty and the sender has paged no one;
+//   - PAGE_NOT_FOUND (wrapping ErrNotFound) when there is no such character
+//     or the sender cannot see it;
+//   - PAGE_SELF when the sender pages themself;
+//   - PAGE_REFUSED when the recipient ignores the sender;
+//   - PAGE_RECIPIENT_OFFLINE when the recipient is not connected and the
+//     fallback is FallbackNone;
+//   - PAGE_MAILBOX_FULL (wrapping ErrMailboxFull) when the page would be
+//     held but the recipient's mailbox is full.
+//
+// A gagged sender is not told: their page is dropped, and the receipt
+// reads as though it had gone through.
+func (s *Service) Send(ctx context.Context, req SendRequest) (*Receipt, error) {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return nil, oops.Code("PAGE_UNAVAILABLE").Errorf("paging is not available right now")
+	}
+	name := req.To
+	if name == "" {
+		last, err := s.lastPaged(ctx, req.From.ID)
+		if err != nil {
+			return nil, err
+		}
+		name = last
+	}
+	to, err := s.FindCharacter(ctx, req.From.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	if to.ID == req.From.ID {
+		return nil, oops.Code("PAGE_SELF").Errorf("cannot page yourself")
+	}
+	msg, err := NewMessage(idgen.New(), req.From, to, req.Message, s.now())
+	if err != nil {
+		return nil, err
+	}
+	mode, err := s.repo.BlockMode(ctx, to.ID, req.From.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mode == BlockIgnore {
+		return nil, oops.Code("PAGE_REFUSED").
+			With("recipient_id", to.ID.String()).
+			With("recipient_name", to.Name).
+			Errorf("%s is not accepting pages from you", to.Name)
+	}
+	connected, err := s.connected(ctx, to.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !connected && s.fallback == FallbackNone {
+		return nil, oops.Code("PAGE_RECIPIENT_OFFLINE").
+			With("recipient_id", to.ID.String()).
+			With("recipient_name", to.Name).
+			Errorf("%s is not connected", to.Name)
+	}
+
+	receipt := &Receipt{MessageID: msg.ID, To: to, Status: StatusDelivered}
+	if !connected {
+		receipt.Status = StatusHeld
+	}
+	switch {
+	case mode == BlockGag:
+		s.logger.DebugContext(ctx, "page dropped by gag",
+			"message_id", msg.ID.String(),
+			"sender_id", req.From.ID.String(),
+			"recipient_id", to.ID.String(),
+		)
+	case connected:
+		if err := s.publishPage(ctx, pub, gameID, msg, false); err != nil {
+			return nil, err
+		}
+	default:
+		if err := s.repo.Hold(ctx, msg, MaxHeldPages); err != nil {
+			return nil, err
+		}
+	}
+
+	s.rememberLastPaged(ctx, req.From.ID, to.Name)
+	s.sendReceipt(ctx, pub, gameID, msg, receipt.Status, false)
+	return receipt, nil
+}
+
+// DeliverHeld publishes the pages waiting in characterID's mailbox, oldest
+// first, then removes them and sends each sender a delivered receipt. It
+// stops at the first page that fails to publish, leaving that page and the
+// ones after it held for the next login; a page may therefore arrive twice
+// if the mailbox cannot be cleared, but is never lost. Called on login.
+func (s *Service) DeliverHeld(ctx context.Context, characterID ulid.ULID) error {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return nil
+	}
+	held, err := s.repo.Held(ctx, characterID)
+	if err != nil {
+		return err
+	}
+	delivered := make([]ulid.ULID, 0, len(held))
+	var publishErr error
+	for _, msg := range held {
+		if publishErr = s.publishPage(ctx, pub, gameID, msg, true); publishErr != nil {
+			break
+		}
+		delivered = append(delivered, msg.ID)
+	}
+	if err := s.repo.DeleteHeld(ctx, delivered); err != nil {
+		return err
+	}
+	for _, msg := range held[:len(delivered)] {
+		if msg.From.ID != (ulid.ULID{}) {
+			s.sendReceipt(ctx, pub, gameID, msg, StatusDelivered, true)
+		}
+	}
+	return publishErr
+}
+
+// Block puts the character named name on owner's ignore or gag list,
+// replacing the other mode if it is already on one. Returns PAGE_NOT_FOUND
+// when there is no such character, PAGE_SELF when it is owner, and
+// PAGE_STAFF_PROTECTED when it holds one of StaffRoles.
+func (s *Service) Block(ctx context.Context, owner ulid.ULID, name string, mode BlockMode) (CharacterRef, error) {
+	target, err := s.FindCharacter(ctx, owner, name)
+	if err != nil {
+		return CharacterRef{}, err
+	}
+	if target.ID == owner {
+		return CharacterRef{}, oops.Code("PAGE_SELF").Errorf("cannot %s yourself", mode)
+	}
+	staff, err := s.repo.IsStaff(ctx, target.ID)
+	if err != nil {
+		return CharacterRef{}, err
+	}
+	if staff {
+		return CharacterRef{}, oops.Code("PAGE_STAFF_PROTECTED").
+			With("character_id", target.ID.String()).
+			With("character_name", target.Name).
+			With("mode", string(mode)).
+			Errorf("cannot %s staff", mode)
+	}
+	if err := s.repo.SetBlock(ctx, owner, target.ID, mode, s.now()); err != nil {
+		return CharacterRef{}, err
+	}
+	s.forgetBlocks(owner)
+	return target, nil
+}
+
+// Unblock takes the character named name off owner's ignore or gag list.
+// Returns PAGE_NOT_FOUND when there is no such character and
+// PAGE_NOT_BLOCKED when it is not on that list.
+func (s *Service) Unblock(ctx context.Context, owner ulid.ULID, name string, mode BlockMode) (CharacterRef, error) {
+	target, err := s.FindCharacter(ctx, owner, name)
+	if err != nil {
+		return CharacterRef{}, err
+	}
+	removed, err := s.repo.RemoveBlock(ctx, owner, target.ID, mode)
+	if err != nil {
+		return CharacterRef{}, err
+	}
+	s.forgetBlocks(owner)
+	if !removed {
+		return CharacterRef{}, oops.Code("PAGE_NOT_BLOCKED").
+			With("character_id", target.ID.String()).
+			With("character_name", target.Name).
+			With("mode", string(mode)).
+			Errorf("you are not %s %s", blockingVerb(mode), target.Name)
+	}
+	return target, nil
+}
+
+// Blocks returns owner's ignore and gag lists ordered by name.
+func (s *Service) Blocks(ctx context.Context, owner ulid.ULID) ([]Block, error) {
+	return s.repo.Blocks(ctx, owner)
+}
+
+// Ignores reports whether observerID ignores or gags senderID, so the event
+// fan-out can hide the sender's speech from the observer. Entries for
+// staff are not enforced. Answers come from a per-character cache
+// refreshed every blockCacheTTL; the repository is never queried while the
+// cache lock is held.
+func (s *Service) Ignores(ctx context.Context, observerID, senderID ulid.ULID) (bool, error) {
+	if observerID == senderID {
+		return false, nil
+	}
+	now := s.now()
+	s.blocksMu.Lock()
+	entry, ok := s.blocks[observerID]
+	s.blocksMu.Unlock()
+	if !ok || now.Sub(entry.fetchedAt) >= blockCacheTTL {
+		blocks, err := s.repo.Blocks(ctx, observerID)
+		if err != nil {
+			return false, err
+		}
+		entry = blockEntry{blocked: make(map[ulid.ULID]struct{}, len(blocks)), fetchedAt: now}
+		for _, b := range blocks {
+			if !b.Staff {
+				entry.blocked[b.Character.ID] = struct{}{}
+			}
+		}
+		s.storeBlocks(observerID, entry, now)
+	}
+	_, blocked := entry.blocked[senderID]
+	return blocked, nil
+}
+
+// storeBlocks caches entry for characterID, first dropping stale entries
+// at most once per TTL so characters who logged off do not accumulate.
+func (s *Service) storeBlocks(characterID ulid.ULID, entry blockEntry, now time.Time) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+	if now.Sub(s.lastSweep) >= blockCacheTTL {
+		for id, e := range s.blocks {
+			if now.Sub(e.fetchedAt) >= blockCacheTTL {
+				delete(s.blocks, id)
+			}
+		}
+		s.lastSweep = now
+	}
+	s.blocks[characterID] = entry
+}
+
+// forgetBlocks drops characterID's cached block list after it changed.
+func (s *Service) forgetBlocks(characterID ulid.ULID) {
+	s.blocksMu.Lock()
+	defer s.blocksMu.Unlock()
+	delete(s.blocks, characterID)
+}
+
+func blockingVerb(mode BlockMode) string {
+	if mode == BlockGag {
+		return "gagging"
+	}
+	return "ignoring"
+}
+
+// lastPaged returns the name characterID last paged in its session.
+func (s *Service) lastPaged(ctx context.Context, characterID ulid.ULID) (string, error) {
+	info, err := s.sessions.FindByCharacter(ctx, characterID)
+	if err != nil && !isSessionNotFound(err) {
+		return "", oops.Code("PAGE_SESSION_LOOKUP_FAILED").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	if info == nil || info.LastPaged == "" {
+		return "", oops.Code("PAGE_NO_LAST_PAGED").Errorf("you have not paged anyone yet")
+	}
+	return info.LastPaged, nil
+}
+
+// connected reports whether characterID has an active or detached session.
+func (s *Service) connected(ctx context.Context, characterID ulid.ULID) (bool, error) {
+	info, err := s.sessions.FindByCharacter(ctx, characterID)
+	if isSessionNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, oops.Code("PAGE_SESSION_LOOKUP_FAILED").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return info != nil, nil
+}
+
+func isSessionNotFound(err error) bool {
+	oopsErr, ok := oops.AsOops(err)
+	return ok && oopsErr.Code() == "SESSION_NOT_FOUND"
+}
+
+// rememberLastPaged records name as the character the sender last paged.
+// The page has already gone, so a failure is logged rather than returned.
+func (s *Service) rememberLastPaged(ctx context.Context, characterID ulid.ULID, name string) {
+	info, err := s.sessions.FindByCharacter(ctx, characterID)
+	if err != nil || info == nil {
+		return
+	}
+	if err := s.sessions.UpdateLastPaged(ctx, info.ID, name); err != nil {
+		s.logger.WarnContext(ctx, "last paged not recorded",
+			"session_id", info.ID,
+			"error", err,
+		)
+	}
+}
+
+// publishPage publishes msg on its recipient's stream. held marks a page
+// delivered from the mailbox.
+func (s *Service) publishPage(ctx context.Context, pub eventbus.Publisher, gameID func() string, msg *Message, held bool) error {
+	payload := eventvocab.PagePayload{
+		MessageID:  msg.ID.String(),
+		SenderName: msg.From.Name,
+		Message:    msg.Text,
+		IsPose:     msg.Pose,
+		Text:       msg.RecipientText(),
+		Held:       held,
+		SentAt:     msg.SentAt.UnixMilli(),
+	}
+	if msg.From.ID != (ulid.ULID{}) {
+		payload.SenderID = msg.From.ID.String()
+	}
+	if held {
+		payload.Text = fmt.Sprintf("[Sent %s] %s", formatSentAt(msg.SentAt), payload.Text)
+	}
+	return s.publish(ctx, pub, gameID, msg, msg.To.ID, eventvocab.EventTypePage, payload)
+}
+
+// sendReceipt tells msg's sender what became of it; held marks a page
+// delivered from the mailbox. The page has already been delivered or held,
+// so a failure is logged rather than returned.
+func (s *Service) sendReceipt(ctx context.Context, pub eventbus.Publisher, gameID func() string, msg *Message, status Status, held bool) {
+	payload := eventvocab.PageReceiptPayload{
+		MessageID:     msg.ID.String(),
+		RecipientID:   msg.To.ID.String(),
+		RecipientName: msg.To.Name,
+		Status:        string(status),
+		Text:          receiptText(msg, status, held),
+	}
+	if err := s.publish(ctx, pub, gameID, msg, msg.From.ID, eventvocab.EventTypePageReceipt, payload); err != nil {
+		s.logger.WarnContext(ctx, "page receipt not published",
+			"message_id", msg.ID.String(),
+			"sender_id", msg.From.ID.String(),
+			"error", err,
+		)
+	}
+}
+
+// receiptText is the line the sender of msg is shown for status. A page
+// delivered from the mailbox (held) was already echoed when it was stored,
+// so its receipt only says it arrived.
+func receiptText(msg *Message, status Status, held bool) string {
+	switch {
+	case status == StatusHeld:
+		return fmt.Sprintf("%s is not connected; your page will be delivered when they next log in. %s",
+			msg.To.Name, msg.SenderText())
+	case held:
+		return fmt.Sprintf("Your page to %s, sent %s, has been delivered.", msg.To.Name, formatSentAt(msg.SentAt))
+	default:
+		return msg.SenderText()
+	}
+}
+
+func formatSentAt(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
+
+// publish sends one sensitive event to characterID's stream. The sender is
+// the actor, so history shows who paged whom; a held page whose sender has
+// since been deleted is attributed to the system.
+func (s *Service) publish(ctx context.Context, pub eventbus.Publisher, gameID func() string, msg *Message,
+	characterID ulid.ULID, eventType eventvocab.EventType, payload any,
+) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return oops.With("operation", "marshal_page_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+characterID.String())
+	if err != nil {
+		return oops.With("character_id", characterID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventType))
+	if err != nil {
+		return oops.With("type", string(eventType)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: msg.From.ID}
+	if msg.From.ID == (ulid.ULID{}) {
+		actor = eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	}
+	ev := eventbus.NewEvent(sub, typ, actor, data)
+	ev.Sensitive = true
+	if err := pub.Publish(ctx, ev); err != nil {
+		return oops.Code("PAGE_PUBLISH_FAILED").
+			With("message_id", msg.ID.String()).
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
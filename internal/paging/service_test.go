@@ -0,0 +1,627 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package paging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository. It also stands in for the
+// world's character lookup, with hidden characters seen only by themselves.
+type memRepository struct {
+	mu         sync.Mutex
+	characters map[string]CharacterRef
+	hidden     map[ulid.ULID]bool
+	blocks     map[[2]ulid.ULID]Block
+	staff      map[ulid.ULID]bool
+	mailbox    []*Message
+	blockReads int
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{
+		characters: map[string]CharacterRef{},
+		hidden:     map[ulid.ULID]bool{},
+		blocks:     map[[2]ulid.ULID]Block{},
+		staff:      map[ulid.ULID]bool{},
+	}
+}
+
+func (m *memRepository) addCharacter(name string) CharacterRef {
+	ref := CharacterRef{ID: idgen.New(), Name: name}
+	m.characters[strings.ToLower(name)] = ref
+	return ref
+}
+
+func (m *memRepository) FindCharacterByName(_ context.Context, observerID ulid.ULID, name string) (*world.Character, error) {
+	ref, ok := m.characters[strings.ToLower(name)]
+	if !ok || (m.hidden[ref.ID] && ref.ID != observerID) {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").Wrap(world.ErrNotFound)
+	}
+	return &world.Character{ID: ref.ID, Name: ref.Name}, nil
+}
+
+func (m *memRepository) nameOf(id ulid.ULID) string {
+	for _, ref := range m.characters {
+		if ref.ID == id {
+			return ref.Name
+		}
+	}
+	return ""
+}
+
+func (m *memRepository) BlockMode(_ context.Context, characterID, senderID ulid.ULID) (BlockMode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.staff[senderID] {
+		return "", nil
+	}
+	return m.blocks[[2]ulid.ULID{characterID, senderID}].Mode, nil
+}
+
+func (m *memRepository) IsStaff(_ context.Context, characterID ulid.ULID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.staff[characterID], nil
+}
+
+func (m *memRepository) setStaff(characterID ulid.ULID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.staff[characterID] = true
+}
+
+func (m *memRepository) SetBlock(_ context.Context, characterID, blockedID ulid.ULID, mode BlockMode, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blocks[[2]ulid.ULID{characterID, blockedID}] = Block{
+		Character: CharacterRef{ID: blockedID, Name: m.nameOf(blockedID)}, Mode: mode, CreatedAt: at,
+	}
+	return nil
+}
+
+func (m *memRepository) RemoveBlock(_ context.Context, characterID, blockedID ulid.ULID, mode BlockMode) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := [2]ulid.ULID{characterID, blockedID}
+	if b, ok := m.blocks[key]; !ok || b.Mode != mode {
+		return false, nil
+	}
+	delete(m.blocks, key)
+	return true, nil
+}
+
+func (m *memRepository) Blocks(_ context.Context, characterID ulid.ULID) ([]Block, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blockReads++
+	var out []Block
+	for key, b := range m.blocks {
+		if key[0] == characterID {
+			b.Staff = m.staff[key[1]]
+			out = append(out, b)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Character.Name < out[j].Character.Name })
+	return out, nil
+}
+
+func (m *memRepository) Hold(_ context.Context, msg *Message, limit int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, held := range m.mailbox {
+		if held.To.ID == msg.To.ID {
+			n++
+		}
+	}
+	if n >= limit {
+		return oops.Code("PAGE_MAILBOX_FULL").Wrap(ErrMailboxFull)
+	}
+	stored := *msg
+	m.mailbox = append(m.mailbox, &stored)
+	return nil
+}
+
+func (m *memRepository) Held(_ context.Context, recipientID ulid.ULID) ([]*Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Message
+	for _, msg := range m.mailbox {
+		if msg.To.ID == recipientID {
+			out = append(out, msg)
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) DeleteHeld(_ context.Context, ids []ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	drop := map[ulid.ULID]bool{}
+	for _, id := range ids {
+		drop[id] = true
+	}
+	kept := m.mailbox[:0]
+	for _, msg := range m.mailbox {
+		if !drop[msg.ID] {
+			kept = append(kept, msg)
+		}
+	}
+	m.mailbox = kept
+	return nil
+}
+
+// fakeSessions keeps one session per connected character.
+type fakeSessions struct {
+	mu       sync.Mutex
+	sessions map[ulid.ULID]*session.Info
+	err      error
+}
+
+func (f *fakeSessions) connect(characterID ulid.ULID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sessions[characterID] = &session.Info{ID: idgen.New().String(), CharacterID: characterID}
+}
+
+func (f *fakeSessions) FindByCharacter(_ context.Context, characterID ulid.ULID) (*session.Info, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	info, ok := f.sessions[characterID]
+	if !ok {
+		return nil, oops.Code("SESSION_NOT_FOUND").Errorf("no session")
+	}
+	return info, nil
+}
+
+func (f *fakeSessions) UpdateLastPaged(_ context.Context, sessionID, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, info := range f.sessions {
+		if info.ID == sessionID {
+			info.LastPaged = name
+		}
+	}
+	return nil
+}
+
+// fakePublisher records every published event; failAfter > 0 fails every
+// publish after that many have succeeded.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	failAfter int
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failAfter > 0 && len(f.published) >= f.failAfter {
+		return errors.New("bus down")
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) events(eventType eventvocab.EventType) []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []eventbus.Event
+	for _, ev := range f.published {
+		if string(ev.Type) == string(eventType) {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo     *memRepository
+	sessions *fakeSessions
+	pub      *fakePublisher
+	alice    CharacterRef
+	bob      CharacterRef
+}
+
+func newTestService(t *testing.T, fallback string) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	sessions := &fakeSessions{sessions: map[ulid.ULID]*session.Info{}}
+	svc, err := NewService(repo, repo, sessions, fallback, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, mainGameID)
+	ts := &testService{Service: svc, repo: repo, sessions: sessions, pub: pub}
+	ts.alice = repo.addCharacter("Alice")
+	ts.bob = repo.addCharacter("Bob")
+	sessions.connect(ts.alice.ID)
+	return ts
+}
+
+func decodePage(t *testing.T, ev eventbus.Event) eventvocab.PagePayload {
+	t.Helper()
+	var p eventvocab.PagePayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &p))
+	return p
+}
+
+func decodeReceipt(t *testing.T, ev eventbus.Event) eventvocab.PageReceiptPayload {
+	t.Helper()
+	var p eventvocab.PageReceiptPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &p))
+	return p
+}
+
+func TestNewServiceValidatesDependencies(t *testing.T) {
+	sessions := &fakeSessions{}
+	repo := newMemRepository()
+	_, err := NewService(nil, repo, sessions, "", nil)
+	require.Error(t, err)
+	_, err = NewService(repo, nil, sessions, "", nil)
+	require.Error(t, err)
+	_, err = NewService(repo, repo, nil, "", nil)
+	require.Error(t, err)
+	_, err = NewService(repo, repo, sessions, "carrier-pigeon", nil)
+	errutil.AssertErrorCode(t, err, "PAGE_INVALID_FALLBACK")
+
+	svc, err := NewService(repo, repo, sessions, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, FallbackMail, svc.Fallback())
+	svc, err = NewService(repo, repo, sessions, " None ", nil)
+	require.NoError(t, err)
+	assert.Equal(t, FallbackNone, svc.Fallback())
+}
+
+func TestSendDeliversToConnectedRecipient(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.sessions.connect(ts.bob.ID)
+
+	receipt, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "bob", Message: "Hey there"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusDelivered, receipt.Status)
+	assert.Equal(t, ts.bob, receipt.To)
+
+	pages := ts.pub.events(eventvocab.EventTypePage)
+	require.Len(t, pages, 1)
+	assert.Equal(t, "events.main.character."+ts.bob.ID.String(), string(pages[0].Subject))
+	assert.True(t, pages[0].Sensitive, "pages are encrypted at rest")
+	assert.Equal(t, ts.alice.ID, pages[0].Actor.ID)
+	page := decodePage(t, pages[0])
+	assert.Equal(t, "Alice pages: Hey there", page.Text)
+	assert.Equal(t, "Hey there", page.Message)
+	assert.Equal(t, ts.alice.ID.String(), page.SenderID)
+	assert.False(t, page.Held)
+
+	receipts := ts.pub.events(eventvocab.EventTypePageReceipt)
+	require.Len(t, receipts, 1)
+	assert.Equal(t, "events.main.character."+ts.alice.ID.String(), string(receipts[0].Subject))
+	assert.True(t, receipts[0].Sensitive)
+	r := decodeReceipt(t, receipts[0])
+	assert.Equal(t, "delivered", r.Status)
+	assert.Equal(t, "You paged Bob: Hey there", r.Text)
+	assert.Equal(t, page.MessageID, r.MessageID)
+}
+
+func TestSendPosePages(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.sessions.connect(ts.bob.ID)
+
+	_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: ":waves."})
+	require.NoError(t, err)
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: ";'s phone rings."})
+	require.NoError(t, err)
+
+	pages := ts.pub.events(eventvocab.EventTypePage)
+	require.Len(t, pages, 2)
+	assert.Equal(t, "From afar, Alice waves.", decodePage(t, pages[0]).Text)
+	assert.True(t, decodePage(t, pages[0]).IsPose)
+	assert.Equal(t, "From afar, Alice's phone rings.", decodePage(t, pages[1]).Text)
+	receipts := ts.pub.events(eventvocab.EventTypePageReceipt)
+	assert.Equal(t, "Long distance to Bob: Alice waves.", decodeReceipt(t, receipts[0]).Text)
+}
+
+func TestSendRemembersLastPaged(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.sessions.connect(ts.bob.ID)
+
+	_, err := ts.Send(ctx, SendRequest{From: ts.alice, Message: "anyone?"})
+	errutil.AssertErrorCode(t, err, "PAGE_NO_LAST_PAGED")
+
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "bob", Message: "first"})
+	require.NoError(t, err)
+	receipt, err := ts.Send(ctx, SendRequest{From: ts.alice, Message: "second"})
+	require.NoError(t, err)
+	assert.Equal(t, ts.bob, receipt.To)
+}
+
+func TestSendHoldsPagesForOfflineRecipients(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "mail")
+
+	receipt, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "Call me"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusHeld, receipt.Status)
+	assert.Empty(t, ts.pub.events(eventvocab.EventTypePage), "nothing is published for an offline recipient")
+	require.Len(t, ts.repo.mailbox, 1)
+	r := decodeReceipt(t, ts.pub.events(eventvocab.EventTypePageReceipt)[0])
+	assert.Equal(t, "held", r.Status)
+	assert.Contains(t, r.Text, "Bob is not connected")
+
+	// Bob logs in: the page arrives, the mailbox empties, and Alice hears.
+	ts.sessions.connect(ts.bob.ID)
+	require.NoError(t, ts.DeliverHeld(ctx, ts.bob.ID))
+	assert.Empty(t, ts.repo.mailbox)
+	pages := ts.pub.events(eventvocab.EventTypePage)
+	require.Len(t, pages, 1)
+	page := decodePage(t, pages[0])
+	assert.True(t, page.Held)
+	assert.Equal(t, "[Sent 2026-10-18 12:00 UTC] Alice pages: Call me", page.Text)
+	receipts := ts.pub.events(eventvocab.EventTypePageReceipt)
+	require.Len(t, receipts, 2)
+	delivered := decodeReceipt(t, receipts[1])
+	assert.Equal(t, "delivered", delivered.Status)
+	assert.Equal(t, "Your page to Bob, sent 2026-10-18 12:00 UTC, has been delivered.", delivered.Text)
+}
+
+func TestSendRefusesOfflineRecipientsWithoutFallback(t *testing.T) {
+	ts := newTestService(t, "none")
+	_, err := ts.Send(context.Background(), SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_RECIPIENT_OFFLINE")
+	assert.Empty(t, ts.repo.mailbox)
+	assert.Empty(t, ts.pub.published)
+}
+
+func TestSendRejectsMailboxOverflow(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	for range MaxHeldPages {
+		_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "ping"})
+		require.NoError(t, err)
+	}
+	_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "ping"})
+	errutil.AssertErrorCode(t, err, "PAGE_MAILBOX_FULL")
+	assert.ErrorIs(t, err, ErrMailboxFull)
+}
+
+func TestSendValidation(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+
+	_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Alice", Message: "me"})
+	errutil.AssertErrorCode(t, err, "PAGE_SELF")
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Nobody", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: " : "})
+	errutil.AssertErrorCode(t, err, "PAGE_INVALID_MESSAGE")
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: strings.Repeat("x", MaxMessageLength+1)})
+	errutil.AssertErrorCode(t, err, "PAGE_INVALID_MESSAGE")
+	assert.Empty(t, ts.pub.published)
+}
+
+func TestSendWithoutPublisherIsUnavailable(t *testing.T) {
+	repo := newMemRepository()
+	svc, err := NewService(repo, repo, &fakeSessions{}, "", nil)
+	require.NoError(t, err)
+	_, err = svc.Send(context.Background(), SendRequest{To: "Bob", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_UNAVAILABLE")
+	require.NoError(t, svc.DeliverHeld(context.Background(), idgen.New()), "held pages wait for the publisher")
+}
+
+func TestSendSurfacesSessionLookupFailures(t *testing.T) {
+	ts := newTestService(t, "")
+	ts.sessions.err = errors.New("db down")
+	_, err := ts.Send(context.Background(), SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_SESSION_LOOKUP_FAILED")
+	assert.Empty(t, ts.repo.mailbox, "an unknown connection state must not be mistaken for offline")
+}
+
+func TestIgnoreRefusesAndGagDropsSilently(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.sessions.connect(ts.bob.ID)
+
+	_, err := ts.Block(ctx, ts.bob.ID, "alice", BlockIgnore)
+	require.NoError(t, err)
+	_, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_REFUSED")
+	assert.Empty(t, ts.pub.published)
+
+	// Gagging replaces the ignore: Alice now thinks her page went through.
+	_, err = ts.Block(ctx, ts.bob.ID, "alice", BlockGag)
+	require.NoError(t, err)
+	receipt, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusDelivered, receipt.Status)
+	assert.Empty(t, ts.pub.events(eventvocab.EventTypePage), "a gagged page never reaches the recipient")
+	require.Len(t, ts.pub.events(eventvocab.EventTypePageReceipt), 1)
+
+	// A gagged page to an offline recipient is not held either.
+	delete(ts.sessions.sessions, ts.bob.ID)
+	receipt, err = ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusHeld, receipt.Status)
+	assert.Empty(t, ts.repo.mailbox)
+}
+
+func TestBlockLists(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	carol := ts.repo.addCharacter("Carol")
+
+	_, err := ts.Block(ctx, ts.alice.ID, "Alice", BlockIgnore)
+	errutil.AssertErrorCode(t, err, "PAGE_SELF")
+	_, err = ts.Block(ctx, ts.alice.ID, "Nobody", BlockGag)
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_FOUND")
+
+	_, err = ts.Block(ctx, ts.alice.ID, "bob", BlockIgnore)
+	require.NoError(t, err)
+	_, err = ts.Block(ctx, ts.alice.ID, "carol", BlockGag)
+	require.NoError(t, err)
+	blocks, err := ts.Blocks(ctx, ts.alice.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, ts.bob.ID, blocks[0].Character.ID)
+	assert.Equal(t, BlockIgnore, blocks[0].Mode)
+	assert.Equal(t, carol.ID, blocks[1].Character.ID)
+	assert.Equal(t, BlockGag, blocks[1].Mode)
+
+	_, err = ts.Unblock(ctx, ts.alice.ID, "bob", BlockGag)
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_BLOCKED")
+	got, err := ts.Unblock(ctx, ts.alice.ID, "bob", BlockIgnore)
+	require.NoError(t, err)
+	assert.Equal(t, ts.bob, got)
+	blocks, err = ts.Blocks(ctx, ts.alice.ID)
+	require.NoError(t, err)
+	assert.Len(t, blocks, 1)
+}
+
+func TestStaffCannotBeBlocked(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.sessions.connect(ts.bob.ID)
+	ts.repo.setStaff(ts.alice.ID)
+
+	for _, mode := range []BlockMode{BlockIgnore, BlockGag} {
+		_, err := ts.Block(ctx, ts.bob.ID, "alice", mode)
+		errutil.AssertErrorCode(t, err, "PAGE_STAFF_PROTECTED")
+	}
+
+	// A block made before the promotion is kept but not enforced.
+	carol := ts.repo.addCharacter("Carol")
+	_, err := ts.Block(ctx, ts.bob.ID, "carol", BlockIgnore)
+	require.NoError(t, err)
+	ts.repo.setStaff(carol.ID)
+	_, err = ts.Send(ctx, SendRequest{From: carol, To: "Bob", Message: "please come to the OOC room"})
+	require.NoError(t, err)
+	assert.Len(t, ts.pub.events(eventvocab.EventTypePage), 1)
+
+	blocks, err := ts.Blocks(ctx, ts.bob.ID)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.True(t, blocks[0].Staff)
+	ignored, err := ts.Ignores(ctx, ts.bob.ID, carol.ID)
+	require.NoError(t, err)
+	assert.False(t, ignored)
+}
+
+func TestIgnoresCachesBlockLists(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	ts.now = func() time.Time { return now }
+
+	ignored, err := ts.Ignores(ctx, ts.bob.ID, ts.alice.ID)
+	require.NoError(t, err)
+	assert.False(t, ignored)
+
+	// Blocking through the service applies at once.
+	_, err = ts.Block(ctx, ts.bob.ID, "alice", BlockGag)
+	require.NoError(t, err)
+	ignored, err = ts.Ignores(ctx, ts.bob.ID, ts.alice.ID)
+	require.NoError(t, err)
+	assert.True(t, ignored, "gagging hides speech as well as pages")
+	reads := ts.repo.blockReads
+	for range 3 {
+		_, err = ts.Ignores(ctx, ts.bob.ID, ts.alice.ID)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, reads, ts.repo.blockReads, "answers come from the cache")
+
+	// A change made elsewhere applies once the cache expires.
+	_, err = ts.repo.RemoveBlock(ctx, ts.bob.ID, ts.alice.ID, BlockGag)
+	require.NoError(t, err)
+	ignored, err = ts.Ignores(ctx, ts.bob.ID, ts.alice.ID)
+	require.NoError(t, err)
+	assert.True(t, ignored)
+	now = now.Add(blockCacheTTL)
+	ignored, err = ts.Ignores(ctx, ts.bob.ID, ts.alice.ID)
+	require.NoError(t, err)
+	assert.False(t, ignored)
+
+	ignored, err = ts.Ignores(ctx, ts.bob.ID, ts.bob.ID)
+	require.NoError(t, err)
+	assert.False(t, ignored, "nobody ignores themself")
+}
+
+func TestDeliverHeldStopsAtFirstPublishFailure(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	for _, text := range []string{"one", "two", "three"} {
+		_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: text})
+		require.NoError(t, err)
+	}
+	// Three held receipts so far; let exactly one more event through.
+	ts.pub.failAfter = len(ts.pub.published) + 1
+
+	err := ts.DeliverHeld(ctx, ts.bob.ID)
+	errutil.AssertErrorCode(t, err, "PAGE_PUBLISH_FAILED")
+	require.Len(t, ts.repo.mailbox, 2, "undelivered pages stay held")
+	assert.Equal(t, "two", ts.repo.mailbox[0].Text)
+}
+
+func TestDeliverHeldFromDeletedSenderSkipsReceipt(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.repo.mailbox = append(ts.repo.mailbox, &Message{
+		ID: idgen.New(), From: CharacterRef{Name: "Ghost"}, To: ts.bob, Text: "boo",
+		SentAt: time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+	})
+
+	require.NoError(t, ts.DeliverHeld(ctx, ts.bob.ID))
+	pages := ts.pub.events(eventvocab.EventTypePage)
+	require.Len(t, pages, 1)
+	assert.Equal(t, eventbus.ActorKindSystem, pages[0].Actor.Kind)
+	assert.Equal(t, "[Sent 2026-10-01 09:30 UTC] Ghost pages: boo", decodePage(t, pages[0]).Text)
+	assert.Empty(t, ts.pub.events(eventvocab.EventTypePageReceipt))
+}
+
+func TestSendCannotReachAHiddenCharacter(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t, "")
+	ts.repo.hidden[ts.bob.ID] = true
+
+	_, err := ts.Send(ctx, SendRequest{From: ts.alice, To: "Bob", Message: "hi"})
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = ts.Block(ctx, ts.alice.ID, "bob", BlockIgnore)
+	errutil.AssertErrorCode(t, err, "PAGE_NOT_FOUND")
+	assert.Empty(t, ts.pub.published)
+
+	ref, err := ts.FindCharacter(ctx, ts.bob.ID, "bob")
+	require.NoError(t, err, "a hidden character still finds itself")
+	assert.Equal(t, ts.bob, ref)
+}
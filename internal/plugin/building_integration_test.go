@@ -70,8 +70,8 @@ func (m *mockWorldMutator) GetCharacter(_ context.Context, _ string, _ ulid.ULID
 	return nil, world.ErrNotFound
 }
 
-func (m *mockWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (m *mockWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (m *mockWorldMutator) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -31,14 +31,14 @@ func loadCorecommManifest(t *testing.T) *plugins.Manifest {
 }
 
 // TestCoreCommunicationSensitiveEventsAreEnforcedByManifest is the regression
-// for holomush-50zqs: core-communication declares page/whisper/pemit as
+// for holomush-50zqs: core-communication declares whisper/pemit as
 // sensitivity:always, but the host-side sensitivity fence only enforces that
 // when the manifest's crypto.emits event_type EXACTLY matches the wire event
 // type the plugin emits. The plugin emits plugin-qualified types
-// (core-communication:page — see verbs[].type and main.lua), so the manifest
+// (core-communication:whisper — see verbs[].type and main.lua), so the manifest
 // MUST declare the same qualified form. With the pre-fix bare event_type
-// ("page"), LookupEmitSensitivity returns SensitivityNever and the fence is a
-// silent no-op — page/whisper/pemit ship as plaintext despite the operator's
+// ("whisper"), LookupEmitSensitivity returns SensitivityNever and the fence is a
+// silent no-op — whisper/pemit ship as plaintext despite the operator's
 // always declaration.
 //
 // This asserts, against the REAL shipped manifest, that for each always-event
@@ -53,9 +53,9 @@ func TestCoreCommunicationSensitiveEventsAreEnforcedByManifest(t *testing.T) {
 
 	// Wire event types are plugin-qualified (verbs[].type / main.lua emit type).
 	alwaysWireTypes := []string{
-		"core-communication:page",
 		"core-communication:whisper",
 		"core-communication:pemit",
+		"core-communication:whisper_overheard",
 	}
 
 	for _, wireType := range alwaysWireTypes {
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package deadletter keeps the events a plugin failed to handle. When a
+// plugin's handle_event keeps returning errors, or runs past its delivery
+// timeout, the event subscriber captures the event here with the failure
+// reason instead of dropping it, and staff are alerted on the moderation
+// stream.
+//
+// Dead letters are persisted in PostgreSQL until staff deal with them:
+// Requeue delivers the event to its plugin again and removes it on
+// success, Discard and Purge delete without delivering.
+package deadletter
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// MaxReasonLength bounds the stored failure reason. Longer reasons are
+// truncated.
+const MaxReasonLength = 1024
+
+const (
+	// DefaultListLimit is the number of dead letters List returns when the
+	// filter sets no limit.
+	DefaultListLimit = 20
+	// MaxListLimit bounds ListFilter.Limit.
+	MaxListLimit = 200
+)
+
+// capturedTotal counts captured dead letters per plugin. Operators alert on
+// its rate; the moderation alert is throttled, this counter is not.
+var capturedTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "holomush_plugin_dead_letters_total",
+		Help: "Total plugin events captured to the dead-letter queue after failed delivery",
+	},
+	[]string{"plugin"},
+)
+
+// Letter is one event a plugin failed to handle.
+type Letter struct {
+	ID     ulid.ULID
+	Plugin string
+	// Event is the event as the plugin was given it. Its Cursor is not
+	// kept: it is a history pagination token, not part of the event.
+	Event pluginsdk.Event
+	// Reason is the most recent failure.
+	Reason string
+	// Attempts counts every delivery that failed, including failed
+	// requeues.
+	Attempts     int
+	CreatedAt    time.Time
+	LastFailedAt time.Time
+}
+
+// ListFilter narrows List.
+type ListFilter struct {
+	// Plugin restricts the result to one plugin's dead letters; empty means
+	// every plugin.
+	Plugin string
+	// Limit caps the result (default DefaultListLimit, at most MaxListLimit).
+	Limit int
+}
+
+// Store persists dead letters. *PostgresStore satisfies it.
+type Store interface {
+	// Create inserts letter.
+	Create(ctx context.Context, letter Letter) error
+	// Get returns the dead letter with id. Returns DEAD_LETTER_NOT_FOUND
+	// when absent.
+	Get(ctx context.Context, id ulid.ULID) (Letter, error)
+	// List returns dead letters matching filter, newest first.
+	// filter.Limit is already resolved by the caller.
+	List(ctx context.Context, filter ListFilter) ([]Letter, error)
+	// Take deletes the dead letter with id and returns it, so only one
+	// caller can act on it. Returns DEAD_LETTER_NOT_FOUND when absent.
+	Take(ctx context.Context, id ulid.ULID) (Letter, error)
+	// Purge deletes every dead letter of plugin, or every dead letter when
+	// plugin is empty, and returns how many.
+	Purge(ctx context.Context, plugin string) (int, error)
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package deadletter
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+const letterColumns = `id, plugin, event_id, event_stream, event_type, event_timestamp,
+	event_actor_kind, event_actor_id, event_payload, reason, attempts, created_at, last_failed_at`
+
+// PostgresStore implements Store against the plugin_dead_letters table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts letter.
+func (s *PostgresStore) Create(ctx context.Context, letter Letter) error {
+	ev := letter.Event
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO plugin_dead_letters (`+letterColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, letter.ID[:], letter.Plugin, ev.ID, ev.Stream, string(ev.Type), ev.Timestamp,
+		int16(ev.ActorKind), ev.ActorID, ev.Payload, letter.Reason, letter.Attempts,
+		pgnanos.From(letter.CreatedAt), pgnanos.From(letter.LastFailedAt))
+	if err != nil {
+		return oops.Code("DEAD_LETTER_STORE_FAILED").
+			With("operation", "create").
+			With("plugin", letter.Plugin).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Get returns the dead letter with id.
+func (s *PostgresStore) Get(ctx context.Context, id ulid.ULID) (Letter, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+letterColumns+` FROM plugin_dead_letters WHERE id = $1`, id[:])
+	return s.one(row, "get", id)
+}
+
+// List returns dead letters matching filter, newest first.
+func (s *PostgresStore) List(ctx context.Context, filter ListFilter) ([]Letter, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+letterColumns+`
+		  FROM plugin_dead_letters
+		 WHERE $1 = '' OR plugin = $1
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $2
+	`, filter.Plugin, filter.Limit)
+	if err != nil {
+		return nil, oops.Code("DEAD_LETTER_STORE_FAILED").With("operation", "list").Wrap(err)
+	}
+	defer rows.Close()
+
+	var letters []Letter
+	for rows.Next() {
+		letter, err := scanLetter(rows)
+		if err != nil {
+			return nil, oops.Code("DEAD_LETTER_STORE_FAILED").With("operation", "list").Wrap(err)
+		}
+		letters = append(letters, letter)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("DEAD_LETTER_STORE_FAILED").With("operation", "list").Wrap(err)
+	}
+	return letters, nil
+}
+
+// Take deletes the dead letter with id and returns it. The DELETE claims
+// the row, so of two concurrent requeues only one gets it.
+func (s *PostgresStore) Take(ctx context.Context, id ulid.ULID) (Letter, error) {
+	row := s.pool.QueryRow(ctx, `DELETE FROM plugin_dead_letters WHERE id = $1 RETURNING `+letterColumns, id[:])
+	return s.one(row, "take", id)
+}
+
+// Purge deletes every dead letter of plugin, or all of them when plugin is
+// empty.
+func (s *PostgresStore) Purge(ctx context.Context, plugin string) (int, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM plugin_dead_letters WHERE $1 = '' OR plugin = $1`, plugin)
+	if err != nil {
+		return 0, oops.Code("DEAD_LETTER_STORE_FAILED").
+			With("operation", "purge").
+			With("plugin", plugin).
+			Wrap(err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *PostgresStore) one(row pgx.Row, operation string, id ulid.ULID) (Letter, error) {
+	letter, err := scanLetter(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Letter{}, errNotFound(id)
+	}
+	if err != nil {
+		return Letter{}, oops.Code("DEAD_LETTER_STORE_FAILED").
+			With("operation", operation).
+			With("dead_letter_id", id.String()).
+			Wrap(err)
+	}
+	return letter, nil
+}
+
+func scanLetter(row pgx.Row) (Letter, error) {
+	var (
+		letter              Letter
+		idBytes             []byte
+		eventType           string
+		actorKind           int16
+		createdAt, failedAt pgnanos.Time
+	)
+	ev := &letter.Event
+	if err := row.Scan(&idBytes, &letter.Plugin, &ev.ID, &ev.Stream, &eventType,
+		&ev.Timestamp, &actorKind, &ev.ActorID, &ev.Payload, &letter.Reason,
+		&letter.Attempts, &createdAt, &failedAt); err != nil {
+		return Letter{}, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	copy(letter.ID[:], idBytes)
+	ev.Type = pluginsdk.EventType(eventType)
+	ev.ActorKind = pluginsdk.ActorKind(actorKind) //nolint:gosec // stored from a uint8
+	letter.CreatedAt = createdAt.Time()
+	letter.LastFailedAt = failedAt.Time()
+	return letter, nil
+}
+
+func errNotFound(id ulid.ULID) error {
+	return oops.Code("DEAD_LETTER_NOT_FOUND").
+		With("dead_letter_id", id.String()).
+		Errorf("no dead letter %s", id)
+}
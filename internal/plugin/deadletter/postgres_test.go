@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package deadletter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/plugin/deadletter"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// newStoredLetter inserts a dead letter of plugin.
+func newStoredLetter(t *testing.T, st *deadletter.PostgresStore, plugin string, createdAt time.Time) deadletter.Letter {
+	t.Helper()
+	letter := deadletter.Letter{
+		ID:     idgen.New(),
+		Plugin: plugin,
+		Event: pluginsdk.Event{
+			ID:        idgen.New().String(),
+			Stream:    "location.01LOC",
+			Type:      "say",
+			Timestamp: createdAt.UnixMilli(),
+			ActorKind: pluginsdk.ActorPlugin,
+			ActorID:   "01PLUGIN",
+			Payload:   `{"text":"hi"}`,
+		},
+		Reason:       "handler exploded",
+		Attempts:     3,
+		CreatedAt:    createdAt,
+		LastFailedAt: createdAt,
+	}
+	require.NoError(t, st.Create(context.Background(), letter))
+	return letter
+}
+
+func TestPostgresStoreRoundTripsLetter(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := deadletter.NewPostgresStore(pool)
+	want := newStoredLetter(t, st, "roundtrip", time.Now())
+
+	got, err := st.Get(ctx, want.ID)
+	require.NoError(t, err)
+	assert.Equal(t, want.Plugin, got.Plugin)
+	assert.Equal(t, want.Event, got.Event)
+	assert.Equal(t, want.Reason, got.Reason)
+	assert.Equal(t, want.Attempts, got.Attempts)
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt))
+
+	_, err = st.Get(ctx, idgen.New())
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_NOT_FOUND")
+}
+
+func TestPostgresStoreListFiltersByPluginNewestFirst(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := deadletter.NewPostgresStore(pool)
+	now := time.Now()
+	older := newStoredLetter(t, st, "list-a", now.Add(-time.Minute))
+	newer := newStoredLetter(t, st, "list-a", now)
+	newStoredLetter(t, st, "list-b", now)
+
+	got, err := st.List(ctx, deadletter.ListFilter{Plugin: "list-a", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, newer.ID, got[0].ID)
+	assert.Equal(t, older.ID, got[1].ID)
+
+	got, err = st.List(ctx, deadletter.ListFilter{Plugin: "list-a", Limit: 1})
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+}
+
+func TestPostgresStoreTakeClaimsOnce(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := deadletter.NewPostgresStore(pool)
+	letter := newStoredLetter(t, st, "take", time.Now())
+
+	got, err := st.Take(ctx, letter.ID)
+	require.NoError(t, err)
+	assert.Equal(t, letter.Event.ID, got.Event.ID)
+
+	_, err = st.Take(ctx, letter.ID)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_NOT_FOUND")
+}
+
+func TestPostgresStorePurge(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := deadletter.NewPostgresStore(pool)
+	newStoredLetter(t, st, "purge-a", time.Now())
+	newStoredLetter(t, st, "purge-a", time.Now())
+	kept := newStoredLetter(t, st, "purge-b", time.Now())
+
+	n, err := st.Purge(ctx, "purge-a")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	_, err = st.Get(ctx, kept.ID)
+	require.NoError(t, err)
+}
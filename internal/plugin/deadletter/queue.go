@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/idgen"
+	plugins "github.com/holomush/holomush/internal/plugin"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// Default Queue tuning.
+const (
+	defaultAlertInterval = time.Minute
+
+	// deliveryTimeout bounds one requeue delivery, matching the
+	// subscriber's per-event delivery budget.
+	deliveryTimeout = 5 * time.Second
+
+	// maxAlertReasonLength bounds the failure reason quoted in a
+	// moderation alert; the full reason is in the dead letter.
+	maxAlertReasonLength = 200
+)
+
+// PluginDeliverer delivers an event to a named plugin and publishes what the
+// plugin emits in response. *plugins.Manager satisfies it.
+type PluginDeliverer interface {
+	DeliverEvent(ctx context.Context, pluginName string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error)
+	EmitPluginEvent(ctx context.Context, pluginName string, event pluginsdk.EmitEvent) error
+}
+
+// Broadcaster publishes a system message on a domain-relative subject.
+// *sysbroadcast.Broadcaster satisfies it.
+type Broadcaster interface {
+	Broadcast(ctx context.Context, subject, message string) error
+}
+
+// Config configures a Queue.
+type Config struct {
+	AlertInterval time.Duration    // minimum gap between moderation alerts for one plugin (default: 1m)
+	Now           func() time.Time // clock override for tests (default: time.Now)
+}
+
+// alertState tracks the alert throttle for one plugin.
+type alertState struct {
+	last       time.Time
+	suppressed int
+}
+
+// Queue captures, inspects, requeues and purges dead letters.
+//
+// Requeue needs a PluginDeliverer and alerts need a Broadcaster; both are
+// bound after construction once the plugin manager and event bus are up.
+// Until then captures are stored and logged, and Requeue fails with
+// DEAD_LETTER_REQUEUE_UNAVAILABLE.
+type Queue struct {
+	config Config
+	store  Store
+
+	mu        sync.Mutex
+	deliverer PluginDeliverer
+	alerter   Broadcaster
+	alerts    map[string]*alertState
+}
+
+// NewQueue creates a Queue over store. Panics on a nil store.
+func NewQueue(config Config, store Store) *Queue {
+	if store == nil {
+		panic("deadletter.NewQueue: nil Store")
+	}
+	if config.AlertInterval <= 0 {
+		config.AlertInterval = defaultAlertInterval
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Queue{config: config, store: store, alerts: make(map[string]*alertState)}
+}
+
+// SetDeliverer binds the deliverer Requeue hands events to.
+func (q *Queue) SetDeliverer(d PluginDeliverer) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.deliverer = d
+}
+
+// SetAlerter binds the broadcaster captures alert staff through, on
+// core.ModerationSubject.
+func (q *Queue) SetAlerter(b Broadcaster) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.alerter = b
+}
+
+// Capture stores event as a dead letter of pluginName after attempts failed
+// deliveries, the last of which failed with reason, and alerts staff. It
+// satisfies plugins.DeadLetterSink. Alerts are throttled to one per plugin
+// per AlertInterval; the next alert counts the dead letters in between.
+func (q *Queue) Capture(ctx context.Context, pluginName string, event pluginsdk.Event, reason string, attempts int) error {
+	if pluginName == "" {
+		return oops.Code("DEAD_LETTER_INVALID").
+			With("event_id", event.ID).
+			Errorf("plugin name is required")
+	}
+	now := q.config.Now()
+	event.Cursor = nil
+	letter := Letter{
+		ID:           idgen.New(),
+		Plugin:       pluginName,
+		Event:        event,
+		Reason:       truncate(reason, MaxReasonLength),
+		Attempts:     max(attempts, 1),
+		CreatedAt:    now,
+		LastFailedAt: now,
+	}
+	if err := q.store.Create(ctx, letter); err != nil {
+		return err
+	}
+	capturedTotal.WithLabelValues(pluginName).Inc()
+	slog.WarnContext(ctx, "deadletter: plugin event captured",
+		"dead_letter_id", letter.ID.String(),
+		"plugin", pluginName,
+		"event_id", event.ID,
+		"stream", event.Stream,
+		"event_type", string(event.Type),
+		"attempts", letter.Attempts,
+		"reason", letter.Reason)
+	q.alert(ctx, letter)
+	return nil
+}
+
+// alert tells staff about letter unless the plugin was alerted on within
+// AlertInterval.
+func (q *Queue) alert(ctx context.Context, letter Letter) {
+	q.mu.Lock()
+	alerter := q.alerter
+	if alerter == nil {
+		q.mu.Unlock()
+		return
+	}
+	state, ok := q.alerts[letter.Plugin]
+	if !ok {
+		state = &alertState{}
+		q.alerts[letter.Plugin] = state
+	}
+	if !state.last.IsZero() && letter.CreatedAt.Sub(state.last) < q.config.AlertInterval {
+		state.suppressed++
+		q.mu.Unlock()
+		return
+	}
+	suppressed := state.suppressed
+	state.last, state.suppressed = letter.CreatedAt, 0
+	q.mu.Unlock()
+
+	if err := alerter.Broadcast(ctx, core.ModerationSubject, AlertText(letter, suppressed)); err != nil {
+		slog.WarnContext(ctx, "deadletter: alert failed",
+			"dead_letter_id", letter.ID.String(),
+			"plugin", letter.Plugin,
+			"error", err)
+	}
+}
+
+// AlertText renders the moderation alert for letter. suppressed is the
+// number of the plugin's dead letters not alerted on since the last alert.
+func AlertText(letter Letter, suppressed int) string {
+	reason, _, _ := strings.Cut(letter.Reason, "\n")
+	msg := fmt.Sprintf("Plugin %s failed to handle a %s event on %s after %d attempts: %s. Inspect it with: deadletter show %s",
+		letter.Plugin, letter.Event.Type, letter.Event.Stream, letter.Attempts,
+		truncate(reason, maxAlertReasonLength), letter.ID)
+	if suppressed > 0 {
+		msg += fmt.Sprintf(" (%d more dead letters from %s since the last alert)", suppressed, letter.Plugin)
+	}
+	return msg
+}
+
+// Get returns the dead letter with id.
+func (q *Queue) Get(ctx context.Context, id ulid.ULID) (Letter, error) {
+	return q.store.Get(ctx, id)
+}
+
+// List returns dead letters matching filter, newest first. Returns
+// DEAD_LETTER_INVALID when the limit is out of range.
+func (q *Queue) List(ctx context.Context, filter ListFilter) ([]Letter, error) {
+	if filter.Limit < 0 || filter.Limit > MaxListLimit {
+		return nil, oops.Code("DEAD_LETTER_INVALID").
+			With("limit", filter.Limit).
+			Errorf("limit must be between 1 and %d", MaxListLimit)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+	return q.store.List(ctx, filter)
+}
+
+// Requeue delivers dead letter id to its plugin again, attributed to the
+// event's original actor, and publishes what the plugin emits. The letter
+// is taken off the queue first, so two staff requeuing it at once cannot
+// deliver it twice; if the delivery fails it goes back with one more
+// attempt and the new reason, and Requeue returns
+// DEAD_LETTER_REQUEUE_FAILED with the updated letter. If it cannot be put
+// back the letter is lost; Requeue logs which event it was and returns the error
+// with a zero letter.
+func (q *Queue) Requeue(ctx context.Context, id ulid.ULID) (Letter, error) {
+	q.mu.Lock()
+	deliverer := q.deliverer
+	q.mu.Unlock()
+	if deliverer == nil {
+		return Letter{}, oops.Code("DEAD_LETTER_REQUEUE_UNAVAILABLE").
+			With("dead_letter_id", id.String()).
+			Errorf("plugin delivery is not available")
+	}
+
+	letter, err := q.store.Take(ctx, id)
+	if err != nil {
+		return Letter{}, err
+	}
+	deliverErr := deliver(ctx, deliverer, letter)
+	if deliverErr == nil {
+		slog.InfoContext(ctx, "deadletter: requeued",
+			"dead_letter_id", letter.ID.String(),
+			"plugin", letter.Plugin,
+			"event_id", letter.Event.ID,
+			"attempts", letter.Attempts)
+		return letter, nil
+	}
+
+	letter.Attempts++
+	letter.Reason = truncate(failureReason(deliverErr), MaxReasonLength)
+	letter.LastFailedAt = q.config.Now()
+	// Put it back even when the caller has gone away: the letter is
+	// already off the queue.
+	if err := q.store.Create(context.WithoutCancel(ctx), letter); err != nil {
+		slog.ErrorContext(ctx, "deadletter: requeue failed and the dead letter could not be restored",
+			"dead_letter_id", letter.ID.String(),
+			"plugin", letter.Plugin,
+			"event_id", letter.Event.ID,
+			"stream", letter.Event.Stream,
+			"event_type", string(letter.Event.Type),
+			"error", err)
+		return Letter{}, oops.Code("DEAD_LETTER_REQUEUE_FAILED").
+			With("dead_letter_id", letter.ID.String()).
+			With("plugin", letter.Plugin).
+			Wrap(errors.Join(deliverErr, err))
+	}
+	return letter, oops.Code("DEAD_LETTER_REQUEUE_FAILED").
+		With("dead_letter_id", letter.ID.String()).
+		With("plugin", letter.Plugin).
+		Wrap(deliverErr)
+}
+
+// deliver hands letter's event to its plugin and publishes the emits. A
+// failed emit is logged, as on the subscriber path; it is not the
+// plugin's failure.
+func deliver(ctx context.Context, deliverer PluginDeliverer, letter Letter) error {
+	dctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	dctx = core.WithActor(dctx, plugins.ActorFromEvent(letter.Event))
+
+	emits, err := deliverer.DeliverEvent(dctx, letter.Plugin, letter.Event)
+	if err != nil {
+		return err //nolint:wrapcheck // Requeue wraps with the dead letter context
+	}
+	for _, emit := range emits {
+		if err := deliverer.EmitPluginEvent(dctx, letter.Plugin, emit); err != nil {
+			slog.ErrorContext(ctx, "deadletter: failed to emit plugin event",
+				"dead_letter_id", letter.ID.String(),
+				"plugin", letter.Plugin,
+				"stream", emit.Stream,
+				"error", err)
+		}
+	}
+	return nil
+}
+
+// Discard deletes dead letter id without delivering it and returns it.
+func (q *Queue) Discard(ctx context.Context, id ulid.ULID) (Letter, error) {
+	letter, err := q.store.Take(ctx, id)
+	if err != nil {
+		return Letter{}, err
+	}
+	slog.InfoContext(ctx, "deadletter: discarded",
+		"dead_letter_id", letter.ID.String(),
+		"plugin", letter.Plugin,
+		"event_id", letter.Event.ID)
+	return letter, nil
+}
+
+// Purge deletes every dead letter of plugin, or every dead letter when
+// plugin is empty, and returns how many.
+func (q *Queue) Purge(ctx context.Context, plugin string) (int, error) {
+	n, err := q.store.Purge(ctx, plugin)
+	if err != nil {
+		return 0, err
+	}
+	slog.InfoContext(ctx, "deadletter: purged", "plugin", plugin, "count", n)
+	return n, nil
+}
+
+// failureReason describes a failed delivery for a dead letter: the error
+// text, or a fixed message when the delivery ran out of time.
+func failureReason(err error) string {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Sprintf("delivery timed out after %s", deliveryTimeout)
+	}
+	return err.Error()
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
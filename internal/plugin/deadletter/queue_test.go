@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package deadletter_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/plugin/deadletter"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// memStore is an in-memory deadletter.Store.
+type memStore struct {
+	mu        sync.Mutex
+	letters   map[ulid.ULID]deadletter.Letter
+	createErr error
+}
+
+func newMemStore() *memStore {
+	return &memStore{letters: make(map[ulid.ULID]deadletter.Letter)}
+}
+
+func (m *memStore) Create(_ context.Context, letter deadletter.Letter) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.letters[letter.ID] = letter
+	return nil
+}
+
+func (m *memStore) Get(_ context.Context, id ulid.ULID) (deadletter.Letter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	letter, ok := m.letters[id]
+	if !ok {
+		return deadletter.Letter{}, notFound()
+	}
+	return letter, nil
+}
+
+func (m *memStore) List(_ context.Context, filter deadletter.ListFilter) ([]deadletter.Letter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []deadletter.Letter
+	for _, letter := range m.letters {
+		if filter.Plugin == "" || letter.Plugin == filter.Plugin {
+			out = append(out, letter)
+		}
+	}
+	slices.SortFunc(out, func(a, b deadletter.Letter) int { return b.ID.Compare(a.ID) })
+	if len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (m *memStore) Take(_ context.Context, id ulid.ULID) (deadletter.Letter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	letter, ok := m.letters[id]
+	if !ok {
+		return deadletter.Letter{}, notFound()
+	}
+	delete(m.letters, id)
+	return letter, nil
+}
+
+func (m *memStore) Purge(_ context.Context, plugin string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for id, letter := range m.letters {
+		if plugin == "" || letter.Plugin == plugin {
+			delete(m.letters, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *memStore) only(t *testing.T) deadletter.Letter {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	require.Len(t, m.letters, 1)
+	for _, letter := range m.letters {
+		return letter
+	}
+	return deadletter.Letter{}
+}
+
+// notFound mirrors the Postgres store's lookup failure.
+func notFound() error {
+	return oops.Code("DEAD_LETTER_NOT_FOUND").Errorf("no dead letter")
+}
+
+// fakeDeliverer records deliveries and their actors.
+type fakeDeliverer struct {
+	err     error
+	emits   []pluginsdk.EmitEvent
+	events  []pluginsdk.Event
+	actors  []core.Actor
+	emitted []pluginsdk.EmitEvent
+}
+
+func (f *fakeDeliverer) DeliverEvent(ctx context.Context, _ string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error) {
+	f.events = append(f.events, event)
+	actor, _ := core.ActorFromContext(ctx)
+	f.actors = append(f.actors, actor)
+	return f.emits, f.err
+}
+
+func (f *fakeDeliverer) EmitPluginEvent(_ context.Context, _ string, event pluginsdk.EmitEvent) error {
+	f.emitted = append(f.emitted, event)
+	return nil
+}
+
+// fakeBroadcaster records alerts.
+type fakeBroadcaster struct {
+	subjects []string
+	messages []string
+}
+
+func (f *fakeBroadcaster) Broadcast(_ context.Context, subject, message string) error {
+	f.subjects = append(f.subjects, subject)
+	f.messages = append(f.messages, message)
+	return nil
+}
+
+// fakeClock is a settable clock.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestQueue(store *memStore) (*deadletter.Queue, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 5, 6, 7, 8, 9, 0, time.UTC)}
+	return deadletter.NewQueue(deadletter.Config{AlertInterval: time.Minute, Now: clock.Now}, store), clock
+}
+
+var testEvent = pluginsdk.Event{
+	ID:        "01EVENT",
+	Stream:    "location.01LOC",
+	Type:      "say",
+	Timestamp: 1700000000000,
+	ActorKind: pluginsdk.ActorCharacter,
+	ActorID:   "01CHAR",
+	Payload:   `{"text":"hi"}`,
+	Cursor:    []byte("cursor"),
+}
+
+func TestNewQueuePanicsOnNilStore(t *testing.T) {
+	assert.Panics(t, func() { deadletter.NewQueue(deadletter.Config{}, nil) })
+}
+
+func TestCaptureStoresAndAlerts(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, clock := newTestQueue(store)
+	alerts := &fakeBroadcaster{}
+	q.SetAlerter(alerts)
+
+	require.NoError(t, q.Capture(ctx, "core-scenes", testEvent, "boom\nstack trace", 3))
+
+	letter := store.only(t)
+	assert.Equal(t, "core-scenes", letter.Plugin)
+	assert.Equal(t, "boom\nstack trace", letter.Reason)
+	assert.Equal(t, 3, letter.Attempts)
+	assert.Equal(t, clock.now, letter.CreatedAt)
+	assert.Nil(t, letter.Event.Cursor, "the pagination cursor is not kept")
+	assert.Equal(t, testEvent.Payload, letter.Event.Payload)
+
+	require.Len(t, alerts.messages, 1)
+	assert.Equal(t, core.ModerationSubject, alerts.subjects[0])
+	assert.Equal(t, "Plugin core-scenes failed to handle a say event on location.01LOC after 3 attempts: boom. "+
+		"Inspect it with: deadletter show "+letter.ID.String(), alerts.messages[0])
+}
+
+func TestCaptureTruncatesReasonAndRejectsMissingPlugin(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(store)
+
+	err := q.Capture(ctx, "", testEvent, "boom", 1)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_INVALID")
+
+	require.NoError(t, q.Capture(ctx, "p", testEvent, strings.Repeat("x", deadletter.MaxReasonLength+10), 0))
+	letter := store.only(t)
+	assert.Len(t, letter.Reason, deadletter.MaxReasonLength)
+	assert.Equal(t, 1, letter.Attempts, "attempts is at least one")
+}
+
+func TestCaptureThrottlesAlertsPerPlugin(t *testing.T) {
+	ctx := context.Background()
+	q, clock := newTestQueue(newMemStore())
+	alerts := &fakeBroadcaster{}
+	q.SetAlerter(alerts)
+
+	require.NoError(t, q.Capture(ctx, "a", testEvent, "boom", 3))
+	require.NoError(t, q.Capture(ctx, "a", testEvent, "boom", 3))
+	require.NoError(t, q.Capture(ctx, "a", testEvent, "boom", 3))
+	require.NoError(t, q.Capture(ctx, "b", testEvent, "boom", 3))
+	assert.Len(t, alerts.messages, 2, "one alert per plugin per interval")
+
+	clock.now = clock.now.Add(time.Minute)
+	require.NoError(t, q.Capture(ctx, "a", testEvent, "boom", 3))
+	require.Len(t, alerts.messages, 3)
+	assert.Contains(t, alerts.messages[2], "(2 more dead letters from a since the last alert)")
+}
+
+func TestCaptureStoreFailure(t *testing.T) {
+	store := newMemStore()
+	store.createErr = errors.New("db down")
+	q, _ := newTestQueue(store)
+	alerts := &fakeBroadcaster{}
+	q.SetAlerter(alerts)
+
+	require.Error(t, q.Capture(context.Background(), "p", testEvent, "boom", 3))
+	assert.Empty(t, alerts.messages, "nothing stored, nothing to inspect")
+}
+
+func TestRequeueDeliversAndRemoves(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(store)
+	require.NoError(t, q.Capture(ctx, "p", testEvent, "boom", 3))
+	id := store.only(t).ID
+
+	_, err := q.Requeue(ctx, id)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_REQUEUE_UNAVAILABLE")
+	store.only(t)
+
+	deliverer := &fakeDeliverer{emits: []pluginsdk.EmitEvent{{Stream: "location.01LOC", Type: "say"}}}
+	q.SetDeliverer(deliverer)
+	letter, err := q.Requeue(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, letter.ID)
+	require.Len(t, deliverer.events, 1)
+	assert.Equal(t, testEvent.ID, deliverer.events[0].ID)
+	assert.Equal(t, core.Actor{Kind: core.ActorCharacter, ID: "01CHAR"}, deliverer.actors[0],
+		"attributed to the original actor")
+	assert.Len(t, deliverer.emitted, 1)
+
+	_, err = q.Get(ctx, id)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_NOT_FOUND")
+	_, err = q.Requeue(ctx, id)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_NOT_FOUND")
+}
+
+func TestRequeueFailurePutsLetterBack(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, clock := newTestQueue(store)
+	require.NoError(t, q.Capture(ctx, "p", testEvent, "boom", 3))
+	id := store.only(t).ID
+	clock.now = clock.now.Add(time.Hour)
+	q.SetDeliverer(&fakeDeliverer{err: errors.New("still broken")})
+
+	letter, err := q.Requeue(ctx, id)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_REQUEUE_FAILED")
+	assert.Equal(t, 4, letter.Attempts)
+
+	stored := store.only(t)
+	assert.Equal(t, id, stored.ID)
+	assert.Equal(t, "still broken", stored.Reason)
+	assert.Equal(t, 4, stored.Attempts)
+	assert.Equal(t, clock.now, stored.LastFailedAt)
+	assert.NotEqual(t, stored.CreatedAt, stored.LastFailedAt)
+}
+
+func TestRequeueTimeoutReason(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(store)
+	require.NoError(t, q.Capture(ctx, "p", testEvent, "boom", 1))
+	q.SetDeliverer(&fakeDeliverer{err: context.DeadlineExceeded})
+
+	_, err := q.Requeue(ctx, store.only(t).ID)
+	require.Error(t, err)
+	assert.Equal(t, "delivery timed out after 5s", store.only(t).Reason)
+}
+
+func TestListDiscardAndPurge(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	q, _ := newTestQueue(store)
+	for _, plugin := range []string{"a", "a", "b"} {
+		require.NoError(t, q.Capture(ctx, plugin, testEvent, "boom", 3))
+	}
+
+	_, err := q.List(ctx, deadletter.ListFilter{Limit: deadletter.MaxListLimit + 1})
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_INVALID")
+
+	all, err := q.List(ctx, deadletter.ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+	onlyA, err := q.List(ctx, deadletter.ListFilter{Plugin: "a"})
+	require.NoError(t, err)
+	assert.Len(t, onlyA, 2)
+
+	discarded, err := q.Discard(ctx, onlyA[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, onlyA[0].ID, discarded.ID)
+	_, err = q.Discard(ctx, onlyA[0].ID)
+	errutil.AssertErrorCode(t, err, "DEAD_LETTER_NOT_FOUND")
+
+	n, err := q.Purge(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	n, err = q.Purge(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
@@ -13,15 +13,36 @@ import (
 // pkg/plugin/event.go constants) and therefore filtered out of the
 // registered set before INV-PLUGIN-32 set-equality comparison. Per INV-PLUGIN-34.
 var hostOwnedEmitTypes = map[string]struct{}{
-	string(pluginsdk.HostEventTypeSystem):          {},
-	string(pluginsdk.HostEventTypeSessionEnded):    {},
-	string(pluginsdk.HostEventTypeCommandResponse): {},
-	string(pluginsdk.HostEventTypeCommandError):    {},
-	string(pluginsdk.HostEventTypeArrive):          {},
-	string(pluginsdk.HostEventTypeLeave):           {},
-	string(pluginsdk.HostEventTypeMove):            {},
-	string(pluginsdk.HostEventTypeLocationState):   {},
-	string(pluginsdk.HostEventTypeExitUpdate):      {},
+	string(pluginsdk.HostEventTypeSystem):             {},
+	string(pluginsdk.HostEventTypeSessionEnded):       {},
+	string(pluginsdk.HostEventTypeCommandResponse):    {},
+	string(pluginsdk.HostEventTypeCommandError):       {},
+	string(pluginsdk.HostEventTypeArrive):             {},
+	string(pluginsdk.HostEventTypeLeave):              {},
+	string(pluginsdk.HostEventTypeMove):               {},
+	string(pluginsdk.HostEventTypeLocationState):      {},
+	string(pluginsdk.HostEventTypeExitUpdate):         {},
+	string(pluginsdk.HostEventTypeAFK):                {},
+	string(pluginsdk.HostEventTypeBack):               {},
+	string(pluginsdk.HostEventTypeRoll):               {},
+	string(pluginsdk.HostEventTypeCheck):              {},
+	string(pluginsdk.HostEventTypeScheduled):          {},
+	string(pluginsdk.HostEventTypePropertyChanged):    {},
+	string(pluginsdk.HostEventTypeMOTD):               {},
+	string(pluginsdk.HostEventTypeCurrencyTransfer):   {},
+	string(pluginsdk.HostEventTypeZoneBroadcast):      {},
+	string(pluginsdk.HostEventTypeAmbient):            {},
+	string(pluginsdk.HostEventTypeJobFinished):        {},
+	string(pluginsdk.HostEventTypeTraversalDepart):    {},
+	string(pluginsdk.HostEventTypeTraversalCancel):    {},
+	string(pluginsdk.HostEventTypeTraversalArrive):    {},
+	string(pluginsdk.HostEventTypeTraversalLocked):    {},
+	string(pluginsdk.HostEventTypePage):               {},
+	string(pluginsdk.HostEventTypePageReceipt):        {},
+	string(pluginsdk.HostEventTypeReportStatus):       {},
+	string(pluginsdk.HostEventTypePreferencesChanged): {},
+	string(pluginsdk.HostEventTypeNPC):                {},
+	string(pluginsdk.HostEventTypeDaylight):           {},
 }
 
 // EmitTypeMismatch describes the diff between a plugin's manifest-declared
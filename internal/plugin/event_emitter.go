@@ -7,14 +7,16 @@ package plugins
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/command"
 	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/eventbus"
-	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/posting"
 	pluginsdk "github.com/holomush/holomush/pkg/plugin"
 )
 
@@ -51,6 +53,14 @@ type PluginEventEmitter struct {
 	lookupManifest ManifestLookup
 	resolveActor   ActorResolver
 	gameID         GameIDProvider
+	payloadLimits  eventbus.PayloadConfig
+	postingGate    PostingGate
+}
+
+// PostingGate decides whether a fully built event may be published.
+// *posting.Service satisfies it.
+type PostingGate interface {
+	Admit(ctx context.Context, event eventbus.Event) error
 }
 
 // EmitterOption customizes PluginEventEmitter construction.
@@ -62,6 +72,18 @@ func WithGameID(p GameIDProvider) EmitterOption {
 	return func(e *PluginEventEmitter) { e.gameID = p }
 }
 
+// WithPayloadLimits sets the payload size limits enforced before publish.
+// When unset, every event type is held to eventbus.MaxPayloadSize.
+func WithPayloadLimits(cfg eventbus.PayloadConfig) EmitterOption {
+	return func(e *PluginEventEmitter) { e.payloadLimits = cfg }
+}
+
+// WithPostingGate checks every event against location and scene posting
+// rules before it is published. When unset, no rules are enforced.
+func WithPostingGate(g PostingGate) EmitterOption {
+	return func(e *PluginEventEmitter) { e.postingGate = g }
+}
+
 // NewPluginEventEmitter wires a new shared host event emitter.
 //
 // publisher is the eventbus Publisher (typically obtained from
@@ -158,20 +180,28 @@ func (e *PluginEventEmitter) Emit(ctx context.Context, pluginName string, intent
 			New("plugin event publisher is not configured")
 	}
 
+	typ, err := eventbus.NewType(string(intent.Type))
+	if err != nil {
+		return oops.With("plugin", pluginName).With("subject", subjectRaw).Wrap(err)
+	}
+
+	// Size is checked before JSON validation so an oversized payload is
+	// rejected without being scanned.
 	payload := []byte(intent.Payload)
-	if pErr := eventvocab.ValidatePayload(payload); pErr != nil {
-		return oops.With("plugin", pluginName).With("subject", subjectRaw).Wrap(pErr)
+	if pErr := e.payloadLimits.Check(typ, payload); pErr != nil {
+		return oops.Code("EVENT_PAYLOAD_TOO_LARGE").
+			With("plugin", pluginName).
+			With("subject", subjectRaw).
+			With("event_type", string(typ)).
+			With("payload_size", len(payload)).
+			With("max_payload_size", e.payloadLimits.Limit(typ)).
+			Wrap(pErr)
 	}
 	if !json.Valid(payload) {
 		return oops.With("plugin", pluginName).With("subject", subjectRaw).
 			New("event payload must be valid JSON")
 	}
 
-	typ, err := eventbus.NewType(string(intent.Type))
-	if err != nil {
-		return oops.With("plugin", pluginName).With("subject", subjectRaw).Wrap(err)
-	}
-
 	gameID := "main"
 	if e.gameID != nil {
 		if g := e.gameID(); g != "" {
@@ -196,6 +226,11 @@ func (e *PluginEventEmitter) Emit(ctx context.Context, pluginName string, intent
 	// value").
 	event := eventbus.NewEvent(sub, typ, busActor, payload)
 	event.Sensitive = sensitive
+	if e.postingGate != nil {
+		if err := e.postingGate.Admit(ctx, event); err != nil {
+			return postingRefusal(pluginName, subjectRaw, err)
+		}
+	}
 	if err := e.publisher.Publish(ctx, event); err != nil {
 		return oops.With("plugin", pluginName).With("subject", subjectRaw).
 			With("qualified_subject", string(sub)).Wrap(err)
@@ -203,6 +238,19 @@ func (e *PluginEventEmitter) Emit(ctx context.Context, pluginName string, intent
 	return nil
 }
 
+// postingRefusal turns a posting-rule refusal into the message the poster
+// sees. The sentinel is wrapped bare so WORLD_ERROR stays the innermost code
+// (see command.PlayerMessage); other gate failures pass through.
+func postingRefusal(pluginName, subject string, err error) error {
+	switch {
+	case errors.Is(err, posting.ErrHeld):
+		return command.WorldError("Your post is held until a moderator approves it.", posting.ErrHeld)
+	case errors.Is(err, posting.ErrNotPermitted):
+		return command.WorldError("Only participants may speak here right now.", posting.ErrNotPermitted)
+	}
+	return oops.With("plugin", pluginName).With("subject", subject).Wrap(err)
+}
+
 // subjectNamespace extracts the plugin-level namespace token from a raw
 // EmitIntent.Subject. Accepts:
 //   - JetStream-native: "events.<game_id>.<namespace>.<...>"
@@ -17,11 +17,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/holomush/holomush/internal/command"
 	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/eventbus"
 	"github.com/holomush/holomush/internal/eventbus/eventbustest"
 	"github.com/holomush/holomush/internal/eventvocab"
 	plugins "github.com/holomush/holomush/internal/plugin"
+	"github.com/holomush/holomush/internal/posting"
 	"github.com/holomush/holomush/pkg/errutil"
 	pluginsdk "github.com/holomush/holomush/pkg/plugin"
 	eventbusv1 "github.com/holomush/holomush/pkg/proto/holomush/eventbus/v1"
@@ -469,6 +471,28 @@ func TestPluginEventEmitterRejectsOversizedPayloadWithoutPublishing(t *testing.T
 	assert.Empty(t, fetchAllMessages(t, bus.JS))
 }
 
+func TestPluginEventEmitterEnforcesPerTypePayloadLimit(t *testing.T) {
+	bus := eventbustest.New(t)
+	emitter := plugins.NewPluginEventEmitter(bus.Bus.Publisher(),
+		func(string) *plugins.Manifest { return sceneManifest() }, pluginActorResolver,
+		plugins.WithPayloadLimits(eventbus.PayloadConfig{
+			MaxSizeByType: map[string]int{string(eventvocab.EventTypeSystem): 16},
+		}))
+
+	payload := `{"text":"far too long for the limit"}`
+	err := emitter.Emit(context.Background(), "core-scenes", pluginsdk.EmitIntent{
+		Subject: "scene.01TEST",
+		Type:    pluginsdk.EventType(eventvocab.EventTypeSystem),
+		Payload: payload,
+	})
+	errutil.AssertErrorCode(t, err, "EVENT_PAYLOAD_TOO_LARGE")
+	var tooLarge *eventbus.PayloadTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, len(payload), tooLarge.Size)
+	assert.Equal(t, 16, tooLarge.Limit)
+	assert.Empty(t, fetchAllMessages(t, bus.JS))
+}
+
 func TestPluginEventEmitterRejectsInvalidJSONPayloadWithoutPublishing(t *testing.T) {
 	bus := eventbustest.New(t)
 	emitter := newEmitter(t, bus, func(string) *plugins.Manifest { return sceneManifest() }, pluginActorResolver)
@@ -623,3 +647,78 @@ func TestEmitManifestGateAllowsPluginCascade(t *testing.T) {
 type erroringPublisher struct{ err error }
 
 func (p *erroringPublisher) Publish(context.Context, eventbus.Event) error { return p.err }
+
+// stubPostingGate refuses every event with err and remembers what it saw.
+type stubPostingGate struct {
+	err  error
+	seen []eventbus.Event
+}
+
+func (g *stubPostingGate) Admit(_ context.Context, ev eventbus.Event) error {
+	g.seen = append(g.seen, ev)
+	return g.err
+}
+
+func TestPluginEventEmitterPostingGate(t *testing.T) {
+	manifest := &plugins.Manifest{
+		Name: "core-communication", Type: plugins.TypeLua, Emits: []string{"location"},
+		ActorKindsClaimable: []string{"plugin", "character"},
+	}
+	ctx := core.WithActor(context.Background(), core.Actor{
+		Kind: core.ActorCharacter,
+		ID:   "01HCHAR0000000000000000000",
+	})
+	intent := pluginsdk.EmitIntent{
+		Subject: "location.01HLOC0000000000000000000",
+		Type:    "core-communication:say",
+		Payload: `{"text":"hi"}`,
+	}
+	tests := []struct {
+		name     string
+		gateErr  error
+		wantCode string
+		wantMsg  string
+	}{
+		{name: "admitted"},
+		{
+			name:     "held",
+			gateErr:  oops.Code("POSTING_HELD").Wrap(posting.ErrHeld),
+			wantCode: command.CodeWorldError,
+			wantMsg:  "held until a moderator",
+		},
+		{
+			name:     "not permitted",
+			gateErr:  oops.Code("POSTING_NOT_PERMITTED").Wrap(posting.ErrNotPermitted),
+			wantCode: command.CodeWorldError,
+			wantMsg:  "Only participants",
+		},
+		{
+			name:     "check failed",
+			gateErr:  oops.Code("POSTING_CHECK_FAILED").Errorf("db down"),
+			wantCode: "POSTING_CHECK_FAILED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &recordingPublisher{}
+			gate := &stubPostingGate{err: tt.gateErr}
+			e := plugins.NewPluginEventEmitter(pub, func(string) *plugins.Manifest { return manifest },
+				actorFromCtxResolver, plugins.WithPostingGate(gate))
+
+			err := e.Emit(ctx, "core-communication", intent)
+			require.Len(t, gate.seen, 1)
+			assert.Equal(t, eventbus.Subject("events.main.location.01HLOC0000000000000000000"), gate.seen[0].Subject)
+			assert.Equal(t, eventbus.ActorKindCharacter, gate.seen[0].Actor.Kind)
+			if tt.gateErr == nil {
+				require.NoError(t, err)
+				assert.Len(t, pub.events, 1)
+				return
+			}
+			errutil.AssertErrorCode(t, err, tt.wantCode)
+			if tt.wantMsg != "" {
+				assert.Contains(t, command.PlayerMessage(err), tt.wantMsg)
+			}
+			assert.Empty(t, pub.events, "a refused event is not published")
+		})
+	}
+}
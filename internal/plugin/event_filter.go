@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package plugins
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/samber/oops"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// EventFilter is a server-side predicate on a plugin subscription. Events
+// that fail it are dropped by the Subscriber before delivery, so a plugin
+// only pays for the events it handles. Every non-empty field must match; an
+// empty filter matches every event on the stream.
+type EventFilter struct {
+	// EventTypes lists accepted event types. A qualified type
+	// ("core-communication:say") matches exactly; an unqualified one ("say")
+	// matches that name in any namespace.
+	EventTypes []string
+
+	// ActorKinds lists accepted actor kinds by wire name
+	// ("character", "system", "plugin").
+	ActorKinds []string
+
+	// Payload maps dotted JSON field paths ("message", "target.name") to the
+	// required value. String fields compare by their decoded text; numbers,
+	// booleans, and null by their JSON literal. Events whose payload is not a
+	// JSON object never match a non-empty Payload.
+	Payload map[string]string
+}
+
+// Validate reports whether f is well formed.
+func (f EventFilter) Validate() error {
+	for _, t := range f.EventTypes {
+		if strings.TrimSpace(t) == "" {
+			return oops.Code("EVENT_FILTER_INVALID").Errorf("event filter types must not be empty")
+		}
+	}
+	for _, k := range f.ActorKinds {
+		if _, ok := actorKindByName(k); !ok {
+			return oops.Code("EVENT_FILTER_INVALID").
+				With("actor_kind", k).
+				Errorf("event filter actor kind %q is unknown (allowed: character, system, plugin)", k)
+		}
+	}
+	for path := range f.Payload {
+		if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+			return oops.Code("EVENT_FILTER_INVALID").
+				With("path", path).
+				Errorf("event filter payload path %q is malformed", path)
+		}
+	}
+	return nil
+}
+
+// eventPredicate is the compiled form of an EventFilter.
+type eventPredicate struct {
+	types      map[string]bool
+	names      map[string]bool // unqualified types
+	actorKinds map[pluginsdk.ActorKind]bool
+	payload    map[string]string
+}
+
+// compile builds the predicate for a validated filter.
+func (f EventFilter) compile() eventPredicate {
+	var p eventPredicate
+	for _, t := range f.EventTypes {
+		if strings.Contains(t, ":") {
+			if p.types == nil {
+				p.types = make(map[string]bool)
+			}
+			p.types[t] = true
+		} else {
+			if p.names == nil {
+				p.names = make(map[string]bool)
+			}
+			p.names[t] = true
+		}
+	}
+	for _, k := range f.ActorKinds {
+		kind, _ := actorKindByName(k)
+		if p.actorKinds == nil {
+			p.actorKinds = make(map[pluginsdk.ActorKind]bool)
+		}
+		p.actorKinds[kind] = true
+	}
+	if len(f.Payload) > 0 {
+		p.payload = f.Payload
+	}
+	return p
+}
+
+// Matches reports whether event passes f. Stream membership is not part of
+// the filter, so callers that route by stream check it separately. f must
+// be valid.
+func (f EventFilter) Matches(event pluginsdk.Event) bool {
+	return f.compile().matches(event, func() map[string]any { return decodePayload(event.Payload) })
+}
+
+// matches reports whether event passes the predicate. payload lazily
+// decodes the event payload so it is parsed at most once per dispatch.
+func (p eventPredicate) matches(event pluginsdk.Event, payload func() map[string]any) bool {
+	if p.types != nil || p.names != nil {
+		eventType := string(event.Type)
+		_, name, qualified := strings.Cut(eventType, ":")
+		if !qualified {
+			name = eventType
+		}
+		if !p.types[eventType] && !p.names[name] {
+			return false
+		}
+	}
+	if p.actorKinds != nil && !p.actorKinds[event.ActorKind] {
+		return false
+	}
+	if p.payload != nil {
+		fields := payload()
+		if fields == nil {
+			return false
+		}
+		for path, want := range p.payload {
+			if !payloadFieldEquals(fields, path, want) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// decodePayload parses a JSON object payload, or returns nil.
+func decodePayload(raw string) map[string]any {
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return nil
+	}
+	return fields
+}
+
+func payloadFieldEquals(fields map[string]any, path, want string) bool {
+	var value any = fields
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		if value, ok = obj[key]; !ok {
+			return false
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v == want
+	case json.Number:
+		return v.String() == want
+	case map[string]any, []any:
+		return false
+	default:
+		// bool and nil compare by their JSON literal.
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(v); err != nil {
+			return false
+		}
+		return strings.TrimSpace(buf.String()) == want
+	}
+}
+
+func actorKindByName(name string) (pluginsdk.ActorKind, bool) {
+	switch name {
+	case "character":
+		return pluginsdk.ActorCharacter, true
+	case "system":
+		return pluginsdk.ActorSystem, true
+	case "plugin":
+		return pluginsdk.ActorPlugin, true
+	}
+	return 0, false
+}
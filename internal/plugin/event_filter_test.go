@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+func matchFilter(f EventFilter, event pluginsdk.Event) bool {
+	return f.Matches(event)
+}
+
+func TestEventFilterMatchesEventTypes(t *testing.T) {
+	say := pluginsdk.Event{Type: "core-communication:say"}
+	pose := pluginsdk.Event{Type: "core-communication:pose"}
+
+	assert.True(t, matchFilter(EventFilter{}, say), "empty filter matches everything")
+	assert.True(t, matchFilter(EventFilter{EventTypes: []string{"say"}}, say), "unqualified name matches any namespace")
+	assert.True(t, matchFilter(EventFilter{EventTypes: []string{"core-communication:say"}}, say))
+	assert.False(t, matchFilter(EventFilter{EventTypes: []string{"other:say"}}, say))
+	assert.False(t, matchFilter(EventFilter{EventTypes: []string{"say"}}, pose))
+	assert.True(t, matchFilter(EventFilter{EventTypes: []string{"say"}}, pluginsdk.Event{Type: "say"}))
+}
+
+func TestEventFilterMatchesActorKinds(t *testing.T) {
+	f := EventFilter{ActorKinds: []string{"character", "system"}}
+	assert.True(t, matchFilter(f, pluginsdk.Event{ActorKind: pluginsdk.ActorCharacter}))
+	assert.True(t, matchFilter(f, pluginsdk.Event{ActorKind: pluginsdk.ActorSystem}))
+	assert.False(t, matchFilter(f, pluginsdk.Event{ActorKind: pluginsdk.ActorPlugin}))
+}
+
+func TestEventFilterMatchesPayloadFields(t *testing.T) {
+	event := pluginsdk.Event{Payload: `{"mode":"ooc","count":3,"loud":true,"target":{"name":"Bob"},"tags":["a"]}`}
+
+	tests := []struct {
+		name    string
+		payload map[string]string
+		want    bool
+	}{
+		{"string field", map[string]string{"mode": "ooc"}, true},
+		{"number literal", map[string]string{"count": "3"}, true},
+		{"bool literal", map[string]string{"loud": "true"}, true},
+		{"nested path", map[string]string{"target.name": "Bob"}, true},
+		{"all fields must match", map[string]string{"mode": "ooc", "count": "4"}, false},
+		{"missing field", map[string]string{"absent": ""}, false},
+		{"path through scalar", map[string]string{"mode.x": "ooc"}, false},
+		{"object never matches", map[string]string{"target": "Bob"}, false},
+		{"array never matches", map[string]string{"tags": "a"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchFilter(EventFilter{Payload: tt.payload}, event))
+		})
+	}
+
+	assert.False(t, matchFilter(EventFilter{Payload: map[string]string{"mode": "ooc"}},
+		pluginsdk.Event{Payload: "not json"}), "non-object payload never matches")
+}
+
+func TestEventFilterValidate(t *testing.T) {
+	assert.NoError(t, EventFilter{
+		EventTypes: []string{"say"},
+		ActorKinds: []string{"character"},
+		Payload:    map[string]string{"target.name": "Bob"},
+	}.Validate())
+
+	for _, f := range []EventFilter{
+		{EventTypes: []string{" "}},
+		{ActorKinds: []string{"robot"}},
+		{Payload: map[string]string{"a..b": "x"}},
+		{Payload: map[string]string{".a": "x"}},
+	} {
+		errutil.AssertErrorCode(t, f.Validate(), "EVENT_FILTER_INVALID")
+	}
+}
@@ -65,8 +65,8 @@ func (*noopWorldMutator) GetCharacter(_ context.Context, _ string, _ ulid.ULID)
 	return nil, nil
 }
 
-func (*noopWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (*noopWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (*noopWorldMutator) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -48,6 +48,7 @@ func newPluginHostServiceServer(host *Host, manifest *plugins.Manifest) func([]g
 				Auditor:        host.Auditor(),
 				PluginName:     manifest.Name,
 				DeclaredAccess: hostcap.DeclaredAccessFromManifest(manifest),
+				Quota:          hostcap.NewCallQuota(hostcap.DefaultQuotaLimits),
 			},
 			hostcap.BinaryDefaultSet,
 			opts,
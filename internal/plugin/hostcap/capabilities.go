@@ -43,10 +43,17 @@ type PropertyDefinition = property.Definition
 type WorldQuerier interface {
 	GetLocation(ctx context.Context, id ulid.ULID) (*world.Location, error)
 	GetCharacter(ctx context.Context, id ulid.ULID) (*world.Character, error)
-	GetCharactersByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error)
+	GetCharactersByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error)
 	GetObject(ctx context.Context, id ulid.ULID) (*world.Object, error)
 }
 
+// ExitQuerier is the optional exit-listing half of the world read surface,
+// backing WorldQueryService.QueryExits. *hostfunc.WorldQuerierAdapter satisfies
+// it; a WorldQuerier without it answers QueryExits with Unimplemented.
+type ExitQuerier interface {
+	GetExits(ctx context.Context, locationID ulid.ULID) ([]*world.Exit, error)
+}
+
 // WorldMutator is the world write surface backing property mutation. Aliased to
 // world.Mutator (the full authorized world operation set).
 type WorldMutator = world.Mutator
@@ -118,6 +118,7 @@ var Descriptors = map[string]CapabilityDescriptor{
 		"QueryCharacter":          {Action: "read", Resource: "character", Class: ClassRead},
 		"QueryLocationCharacters": {Action: "read", Resource: "location", Class: ClassRead},
 		"QueryObject":             {Action: "read", Resource: "object", Class: ClassRead},
+		"QueryExits":              {Action: "read", Resource: "location", Class: ClassRead},
 		"FindLocation":            {Action: "read", Resource: "location", Class: ClassRead},
 	}},
 	"property": {Token: "property", Methods: map[string]MethodDescriptor{
@@ -34,6 +34,10 @@ import (
 //     an unmapped/undescribed host.v1 method, an empty plugin name, a nil
 //     declaration lookup, or a scope-eligible method with no wired extractor
 //     (INV-PLUGIN-52 — a host wiring defect, not a plugin permission failure).
+//
+// CAPABILITY_QUOTA_EXCEEDED is neither: the call was authorized but the plugin
+// exhausted its per-capability call quota, so it maps to
+// codes.ResourceExhausted and the plugin may retry later.
 var denialGRPCCode = map[string]codes.Code{
 	"CAPABILITY_NOT_DECLARED":               codes.PermissionDenied,
 	"ACCESS_CLASS_DENIED":                   codes.PermissionDenied,
@@ -45,6 +49,7 @@ var denialGRPCCode = map[string]codes.Code{
 	"CAPABILITY_PLUGIN_NAME_MISSING":        codes.Internal,
 	"CAPABILITY_DECLARATION_LOOKUP_MISSING": codes.Internal,
 	"SCOPE_NO_EXTRACTOR":                    codes.Internal,
+	"CAPABILITY_QUOTA_EXCEEDED":             codes.ResourceExhausted,
 }
 
 // capDeny builds a host-capability denial that carries BOTH the structured oops
@@ -101,6 +106,9 @@ type InterceptorDeps struct {
 	// capability token ("" => undifferentiated), and whether it declared the
 	// capability at all. A false second return is fail-closed denial.
 	DeclaredAccess func(plugin, capToken string) (string, bool)
+	// Quota bounds the plugin's call rate per capability token. nil means
+	// unlimited.
+	Quota *CallQuota
 }
 
 // DeclaredAccessFromManifest builds the InterceptorDeps.DeclaredAccess lookup
@@ -327,6 +335,11 @@ func NewCapabilityInterceptor(d InterceptorDeps) grpc.UnaryServerInterceptor {
 			return nil, capDeny(code, "denied by policy",
 				"capability", capToken, "method", method, "resource", resource)
 		}
+		if !d.Quota.Allow(capToken) {
+			return nil, capDeny("CAPABILITY_QUOTA_EXCEEDED",
+				"capability call quota exceeded",
+				"capability", capToken, "method", method)
+		}
 		return h(ctx, req)
 	}
 }
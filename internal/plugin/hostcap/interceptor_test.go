@@ -46,6 +46,7 @@ func TestCapabilityDenialsCarryGRPCStatus(t *testing.T) {
 		{"CAPABILITY_PLUGIN_NAME_MISSING", codes.Internal},
 		{"CAPABILITY_DECLARATION_LOOKUP_MISSING", codes.Internal},
 		{"SCOPE_NO_EXTRACTOR", codes.Internal},
+		{"CAPABILITY_QUOTA_EXCEEDED", codes.ResourceExhausted},
 	}
 	for _, tt := range tests {
 		t.Run(tt.code+" serializes as "+tt.wireCode.String(), func(t *testing.T) {
@@ -249,6 +250,38 @@ func TestInterceptorNonScopedCapabilityPermittedByPolicy(t *testing.T) {
 	require.NotNil(t, resp)
 }
 
+func TestInterceptorQuotaExhaustionDeniesCall(t *testing.T) {
+	ic := NewCapabilityInterceptor(InterceptorDeps{
+		Engine:         policytest.AllowAllEngine(),
+		PluginName:     "core-objects",
+		DeclaredAccess: func(_, _ string) (string, bool) { return "read", true },
+		Quota:          NewCallQuota(map[string]QuotaLimit{"kv": {Burst: 1, Rate: 0.001}}),
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/holomush.plugin.host.v1.KVService/Get"}
+
+	_, err := ic(ctxWithDispatch(t), &hostv1.GetRequest{}, info, okHandler)
+	require.NoError(t, err)
+
+	_, err = ic(ctxWithDispatch(t), &hostv1.GetRequest{}, info, okHandler)
+	errutil.AssertErrorCode(t, err, "CAPABILITY_QUOTA_EXCEEDED")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestInterceptorPolicyDenialDoesNotConsumeQuota(t *testing.T) {
+	quota := NewCallQuota(map[string]QuotaLimit{"kv": {Burst: 1, Rate: 0.001}})
+	denied := NewCapabilityInterceptor(InterceptorDeps{
+		Engine:         policytest.DenyAllEngine(),
+		PluginName:     "core-objects",
+		DeclaredAccess: func(_, _ string) (string, bool) { return "read", true },
+		Quota:          quota,
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/holomush.plugin.host.v1.KVService/Get"}
+	_, err := denied(ctxWithDispatch(t), &hostv1.GetRequest{}, info, okHandler)
+	errutil.AssertErrorCode(t, err, "CAPABILITY_ACCESS_DENIED")
+
+	require.True(t, quota.Allow("kv"), "a denied call must not spend the quota")
+}
+
 func TestInterceptorEmptyPluginNameFailsClosed(t *testing.T) {
 	// Defense-in-depth: an empty PluginName (a misconfiguration — production
 	// sources it from the schema-required manifest Name) must fail closed at the
@@ -54,8 +54,8 @@ func (fakePropertyWorldQuerier) GetCharacter(_ context.Context, _ ulid.ULID) (*w
 	return nil, nil
 }
 
-func (fakePropertyWorldQuerier) GetCharactersByLocation(_ context.Context, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (fakePropertyWorldQuerier) GetCharactersByLocation(_ context.Context, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (fakePropertyWorldQuerier) GetObject(_ context.Context, _ ulid.ULID) (*world.Object, error) {
@@ -73,8 +73,8 @@ func (fakePropertyWorldMutator) GetCharacter(_ context.Context, _ string, _ ulid
 	return nil, nil
 }
 
-func (fakePropertyWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (fakePropertyWorldMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (fakePropertyWorldMutator) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostcap
+
+import (
+	"sync"
+	"time"
+)
+
+// QuotaLimit is the token-bucket budget for one capability token: a plugin may
+// burst Burst calls, refilled at Rate calls per second.
+type QuotaLimit struct {
+	Burst int
+	Rate  float64
+}
+
+// DefaultQuotaLimits bounds the read capabilities a plugin may call in a loop
+// (world queries and property reads) so a runaway script cannot saturate the
+// world store. Capabilities absent from the map are unlimited.
+var DefaultQuotaLimits = map[string]QuotaLimit{
+	"world.query": {Burst: 100, Rate: 50},
+	"property":    {Burst: 100, Rate: 50},
+}
+
+// quotaBucket tracks the remaining calls for one capability token.
+type quotaBucket struct {
+	tokens    float64
+	lastCheck time.Time
+}
+
+// CallQuota enforces per-capability call quotas for a single plugin. The
+// capability interceptor holds one per plugin, so buckets are keyed only by
+// capability token. It is safe for concurrent use; a nil *CallQuota allows
+// every call.
+type CallQuota struct {
+	mu      sync.Mutex
+	limits  map[string]QuotaLimit
+	buckets map[string]*quotaBucket
+	now     func() time.Time
+}
+
+// NewCallQuota creates a call quota enforcing limits. Limits with a
+// non-positive Burst or Rate are ignored (unlimited).
+func NewCallQuota(limits map[string]QuotaLimit) *CallQuota {
+	return newCallQuota(limits, time.Now)
+}
+
+func newCallQuota(limits map[string]QuotaLimit, now func() time.Time) *CallQuota {
+	valid := make(map[string]QuotaLimit, len(limits))
+	for token, l := range limits {
+		if l.Burst > 0 && l.Rate > 0 {
+			valid[token] = l
+		}
+	}
+	return &CallQuota{
+		limits:  valid,
+		buckets: make(map[string]*quotaBucket),
+		now:     now,
+	}
+}
+
+// Allow consumes one call from capToken's budget and reports whether the call
+// may proceed. Tokens refill at the limit's rate, up to its burst.
+func (q *CallQuota) Allow(capToken string) bool {
+	if q == nil {
+		return true
+	}
+	limit, ok := q.limits[capToken]
+	if !ok {
+		return true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	bucket, exists := q.buckets[capToken]
+	if !exists {
+		bucket = &quotaBucket{tokens: float64(limit.Burst), lastCheck: now}
+		q.buckets[capToken] = bucket
+	}
+
+	bucket.tokens += now.Sub(bucket.lastCheck).Seconds() * limit.Rate
+	if bucket.tokens > float64(limit.Burst) {
+		bucket.tokens = float64(limit.Burst)
+	}
+	bucket.lastCheck = now
+
+	if bucket.tokens >= 1.0 {
+		bucket.tokens -= 1.0
+		return true
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostcap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallQuotaAllowsBurstThenDenies(t *testing.T) {
+	now := time.Unix(0, 0)
+	q := newCallQuota(map[string]QuotaLimit{"world.query": {Burst: 3, Rate: 1}}, func() time.Time { return now })
+
+	for i := range 3 {
+		assert.True(t, q.Allow("world.query"), "call %d within burst", i)
+	}
+	assert.False(t, q.Allow("world.query"))
+}
+
+func TestCallQuotaRefillsOverTime(t *testing.T) {
+	now := time.Unix(0, 0)
+	q := newCallQuota(map[string]QuotaLimit{"world.query": {Burst: 1, Rate: 2}}, func() time.Time { return now })
+
+	assert.True(t, q.Allow("world.query"))
+	assert.False(t, q.Allow("world.query"))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, q.Allow("world.query"))
+}
+
+func TestCallQuotaTracksCapabilitiesIndependently(t *testing.T) {
+	now := time.Unix(0, 0)
+	q := newCallQuota(map[string]QuotaLimit{
+		"world.query": {Burst: 1, Rate: 1},
+		"property":    {Burst: 1, Rate: 1},
+	}, func() time.Time { return now })
+
+	assert.True(t, q.Allow("world.query"))
+	assert.False(t, q.Allow("world.query"))
+	assert.True(t, q.Allow("property"))
+}
+
+func TestCallQuotaUnlimitedCapabilities(t *testing.T) {
+	q := NewCallQuota(map[string]QuotaLimit{"property": {Burst: 0, Rate: 1}})
+	for range 1000 {
+		assert.True(t, q.Allow("property"), "non-positive limits are ignored")
+		assert.True(t, q.Allow("kv"), "capabilities without a limit are unlimited")
+	}
+
+	var nilQuota *CallQuota
+	assert.True(t, nilQuota.Allow("world.query"))
+}
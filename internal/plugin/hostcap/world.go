@@ -26,7 +26,7 @@ import (
 var errNilWorldResult = errors.New("world querier returned a nil result without an error")
 
 // worldServer implements holomush.plugin.host.v1.WorldQueryService. It delegates
-// all four query operations to the plugin-subject-stamped WorldQuerier obtained
+// the query operations to the plugin-subject-stamped WorldQuerier obtained
 // from the HostCapabilities port, mirroring the existing Lua
 // holomush.query_location / query_character / query_location_characters /
 // query_object host functions so both runtimes share identical semantics
@@ -122,38 +122,46 @@ func (s *worldServer) QueryCharacter(ctx context.Context, req *hostv1.QueryChara
 }
 
 // QueryLocationCharacters returns the lightweight (id, name) set of characters
-// at a location with optional limit/offset pagination, mirroring the Lua
+// at a location one cursor-paginated page at a time, mirroring the Lua
 // holomush.query_location_characters(location_id, opts) host function. Returns
-// InvalidArgument for unparseable IDs; returns a generic Internal on unexpected
-// failures (no inner error detail leaks per grpc-errors.md).
+// InvalidArgument for unparseable IDs, a non-zero (removed) offset, or a
+// malformed cursor; returns a generic Internal on unexpected failures (no
+// inner error detail leaks per grpc-errors.md).
 func (s *worldServer) QueryLocationCharacters(ctx context.Context, req *hostv1.QueryLocationCharactersRequest) (*hostv1.QueryLocationCharactersResponse, error) {
 	id, err := ulid.Parse(req.GetLocationId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid location id")
 	}
 
+	if req.GetOffset() != 0 { //nolint:staticcheck // rejecting the deprecated field is the point
+		return nil, status.Errorf(codes.InvalidArgument, "offset is no longer supported; use cursor")
+	}
+
 	opts := world.ListOptions{
 		Limit:  int(req.GetLimit()),
-		Offset: int(req.GetOffset()),
+		Cursor: req.GetCursor(),
 	}
 	querier := s.host.WorldQuerier(s.pluginName)
 	if querier == nil {
 		return nil, status.Errorf(codes.Unimplemented, "world query not supported")
 	}
-	chars, err := querier.GetCharactersByLocation(ctx, id, opts)
+	page, err := querier.GetCharactersByLocation(ctx, id, opts)
 	if err != nil {
+		if errors.Is(err, world.ErrInvalidCursor) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
 		errutil.LogErrorContext(ctx, "world.query_location_characters failed", err, "plugin", s.pluginName)
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
 
-	summaries := make([]*hostv1.CharacterSummary, 0, len(chars))
-	for _, c := range chars {
+	summaries := make([]*hostv1.CharacterSummary, 0, len(page.Items))
+	for _, c := range page.Items {
 		summaries = append(summaries, &hostv1.CharacterSummary{
 			Id:   c.ID.String(),
 			Name: c.Name,
 		})
 	}
-	return &hostv1.QueryLocationCharactersResponse{Characters: summaries}, nil
+	return &hostv1.QueryLocationCharactersResponse{Characters: summaries, NextCursor: page.NextCursor}, nil
 }
 
 // QueryObject returns an object's identity, description, container flag,
@@ -209,6 +217,41 @@ func (s *worldServer) QueryObject(ctx context.Context, req *hostv1.QueryObjectRe
 	return resp, nil
 }
 
+// QueryExits returns the exits leaving a location, as seen by the acting
+// character, through the querier's optional ExitQuerier half. Returns
+// InvalidArgument for unparseable IDs and Unimplemented when the querier cannot
+// list exits; other failures are logged and replaced with a generic Internal
+// (no inner error detail leaks per grpc-errors.md).
+func (s *worldServer) QueryExits(ctx context.Context, req *hostv1.QueryExitsRequest) (*hostv1.QueryExitsResponse, error) {
+	id, err := ulid.Parse(req.GetLocationId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location id")
+	}
+
+	querier, ok := s.host.WorldQuerier(s.pluginName).(ExitQuerier)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "exit query not supported")
+	}
+	exits, err := querier.GetExits(ctx, id)
+	if err != nil {
+		errutil.LogErrorContext(ctx, "world.query_exits failed", err, "plugin", s.pluginName)
+		return nil, status.Errorf(codes.Internal, "internal error")
+	}
+
+	summaries := make([]*hostv1.ExitSummary, 0, len(exits))
+	for _, e := range exits {
+		summaries = append(summaries, &hostv1.ExitSummary{
+			Id:           e.ID.String(),
+			Name:         e.Name,
+			Aliases:      e.Aliases,
+			ToLocationId: e.ToLocationID.String(),
+			Locked:       e.Locked,
+			Visibility:   string(e.Visibility),
+		})
+	}
+	return &hostv1.QueryExitsResponse{Exits: summaries}, nil
+}
+
 // FindLocation resolves a location by display name within the calling plugin's
 // subject scope, mirroring the Lua holomush.find_location(name) host function
 // (worldMutator.FindLocationByName). Returns the matched location's id and
@@ -300,7 +343,8 @@ func (s *worldMutationServer) CreateLocation(ctx context.Context, req *hostv1.Cr
 // holomush.create_exit(from_id, to_id, name, opts) host function
 // (mutator.CreateExit). Returns the new exit's id and name. Returns
 // Unimplemented when the world mutator is not configured; returns
-// InvalidArgument for unparseable ULIDs; other inner errors are logged and
+// InvalidArgument for unparseable ULIDs and AlreadyExists when the name is
+// taken by another exit at the location; other inner errors are logged and
 // replaced with a generic Internal (no leak per grpc-errors.md).
 func (s *worldMutationServer) CreateExit(ctx context.Context, req *hostv1.CreateExitRequest) (*hostv1.CreateExitResponse, error) {
 	mutator := s.host.WorldMutator()
@@ -328,6 +372,10 @@ func (s *worldMutationServer) CreateExit(ctx context.Context, req *hostv1.Create
 	}
 	subject := access.PluginSubject(s.pluginName)
 	if err := mutator.CreateExit(ctx, subject, exit); err != nil {
+		var nameConflict *world.ExitNameConflictError
+		if errors.As(err, &nameConflict) {
+			return nil, status.Errorf(codes.AlreadyExists, "exit name %q is already in use at this location", nameConflict.Name)
+		}
 		errutil.LogErrorContext(ctx, "world.create_exit failed", err, "plugin", s.pluginName)
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
@@ -50,8 +50,8 @@ func (f *fakeMutator) GetCharacter(_ context.Context, _ string, _ ulid.ULID) (*w
 	return nil, nil
 }
 
-func (f *fakeMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (f *fakeMutator) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (f *fakeMutator) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -128,10 +128,15 @@ type fakeWorldQuerier struct {
 	characterErr    error
 
 	charactersByLocationResult []*world.Character
+	charactersByLocationNext   string
 	charactersByLocationErr    error
+	charactersByLocationOpts   world.ListOptions
 
 	objectResult *world.Object
 	objectErr    error
+
+	exitsResult []*world.Exit
+	exitsErr    error
 }
 
 func (f *fakeWorldQuerier) GetLocation(_ context.Context, _ ulid.ULID) (*world.Location, error) {
@@ -142,14 +147,20 @@ func (f *fakeWorldQuerier) GetCharacter(_ context.Context, _ ulid.ULID) (*world.
 	return f.characterResult, f.characterErr
 }
 
-func (f *fakeWorldQuerier) GetCharactersByLocation(_ context.Context, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return f.charactersByLocationResult, f.charactersByLocationErr
+func (f *fakeWorldQuerier) GetCharactersByLocation(_ context.Context, _ ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	f.charactersByLocationOpts = opts
+	page := world.Page[*world.Character]{Items: f.charactersByLocationResult, NextCursor: f.charactersByLocationNext}
+	return page, f.charactersByLocationErr
 }
 
 func (f *fakeWorldQuerier) GetObject(_ context.Context, _ ulid.ULID) (*world.Object, error) {
 	return f.objectResult, f.objectErr
 }
 
+func (f *fakeWorldQuerier) GetExits(_ context.Context, _ ulid.ULID) ([]*world.Exit, error) {
+	return f.exitsResult, f.exitsErr
+}
+
 // --- worldHostCaps -----------------------------------------------------------
 
 // worldHostCaps is a focused HostCapabilities stub for worldServer tests.
@@ -381,6 +392,8 @@ func TestWorldServerQueryLocationCharacters(t *testing.T) {
 		name       string
 		querier    *fakeWorldQuerier
 		locationID string
+		cursor     string
+		offset     int32
 		check      func(t *testing.T, caps *worldHostCaps, resp *hostv1.QueryLocationCharactersResponse, err error)
 	}{
 		{
@@ -395,6 +408,35 @@ func TestWorldServerQueryLocationCharacters(t *testing.T) {
 				assert.Equal(t, char.Name, resp.GetCharacters()[0].GetName())
 			},
 		},
+		{
+			name:       "passes the cursor through and returns the next one",
+			querier:    &fakeWorldQuerier{charactersByLocationResult: []*world.Character{char}, charactersByLocationNext: "next"},
+			locationID: validWorldULID,
+			cursor:     "prev",
+			check: func(t *testing.T, caps *worldHostCaps, resp *hostv1.QueryLocationCharactersResponse, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "prev", caps.querier.charactersByLocationOpts.Cursor)
+				assert.Equal(t, "next", resp.GetNextCursor())
+			},
+		},
+		{
+			name:       "returns InvalidArgument for the removed offset",
+			querier:    &fakeWorldQuerier{},
+			locationID: validWorldULID,
+			offset:     5,
+			check: func(t *testing.T, _ *worldHostCaps, _ *hostv1.QueryLocationCharactersResponse, err error) {
+				requireInvalidArgument(t, err)
+			},
+		},
+		{
+			name:       "returns InvalidArgument for a malformed cursor",
+			querier:    &fakeWorldQuerier{charactersByLocationErr: world.ErrInvalidCursor},
+			locationID: validWorldULID,
+			cursor:     "garbage",
+			check: func(t *testing.T, _ *worldHostCaps, _ *hostv1.QueryLocationCharactersResponse, err error) {
+				requireInvalidArgument(t, err)
+			},
+		},
 		{
 			name:       "returns opaque internal error on unexpected failure",
 			querier:    &fakeWorldQuerier{charactersByLocationErr: errors.New("secret")},
@@ -418,12 +460,86 @@ func TestWorldServerQueryLocationCharacters(t *testing.T) {
 			srv := hostcap.NewWorldQueryServer(hostcap.NewBase(caps, "core-scenes"))
 			resp, err := srv.QueryLocationCharacters(context.Background(), &hostv1.QueryLocationCharactersRequest{
 				LocationId: tc.locationID,
+				Cursor:     tc.cursor,
+				Offset:     tc.offset,
+			})
+			tc.check(t, caps, resp, err)
+		})
+	}
+}
+
+// ============================================================================
+// QueryExits
+// ============================================================================
+
+func TestWorldServerQueryExits(t *testing.T) {
+	exit := &world.Exit{
+		ID:           ulid.Make(),
+		ToLocationID: ulid.Make(),
+		Name:         "north",
+		Aliases:      []string{"n"},
+		Visibility:   world.VisibilityAll,
+		Locked:       true,
+	}
+	tests := []struct {
+		name       string
+		querier    *fakeWorldQuerier
+		locationID string
+		check      func(t *testing.T, caps *worldHostCaps, resp *hostv1.QueryExitsResponse, err error)
+	}{
+		{
+			name:       "maps exits to summaries and stamps the plugin subject",
+			querier:    &fakeWorldQuerier{exitsResult: []*world.Exit{exit}},
+			locationID: validWorldULID,
+			check: func(t *testing.T, caps *worldHostCaps, resp *hostv1.QueryExitsResponse, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "core-scenes", caps.lastQueriedPlugin)
+				require.Len(t, resp.GetExits(), 1)
+				got := resp.GetExits()[0]
+				assert.Equal(t, exit.ID.String(), got.GetId())
+				assert.Equal(t, "north", got.GetName())
+				assert.Equal(t, []string{"n"}, got.GetAliases())
+				assert.Equal(t, exit.ToLocationID.String(), got.GetToLocationId())
+				assert.True(t, got.GetLocked())
+				assert.Equal(t, string(world.VisibilityAll), got.GetVisibility())
+			},
+		},
+		{
+			name:       "returns opaque internal error on unexpected failure",
+			querier:    &fakeWorldQuerier{exitsErr: errors.New("secret")},
+			locationID: validWorldULID,
+			check: func(t *testing.T, _ *worldHostCaps, _ *hostv1.QueryExitsResponse, err error) {
+				requireOpaqueInternal(t, err)
+			},
+		},
+		{
+			name:       "returns InvalidArgument for an unparseable location id",
+			querier:    &fakeWorldQuerier{},
+			locationID: "not-a-ulid",
+			check: func(t *testing.T, _ *worldHostCaps, _ *hostv1.QueryExitsResponse, err error) {
+				requireInvalidArgument(t, err)
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			caps := newWorldCaps(tc.querier)
+			srv := hostcap.NewWorldQueryServer(hostcap.NewBase(caps, "core-scenes"))
+			resp, err := srv.QueryExits(context.Background(), &hostv1.QueryExitsRequest{
+				LocationId: tc.locationID,
 			})
 			tc.check(t, caps, resp, err)
 		})
 	}
 }
 
+func TestWorldServerQueryExitsWithoutQuerierIsUnimplemented(t *testing.T) {
+	srv := hostcap.NewWorldQueryServer(hostcap.NewBase(stubHostCaps{}, "core-scenes"))
+	_, err := srv.QueryExits(context.Background(), &hostv1.QueryExitsRequest{LocationId: validWorldULID})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
 // ============================================================================
 // QueryObject
 // ============================================================================
@@ -685,6 +801,22 @@ func TestWorldMutationServerCreateExitInternalErrorIsOpaque(t *testing.T) {
 	requireOpaqueInternal(t, err)
 }
 
+func TestWorldMutationServerCreateExitNameConflictIsAlreadyExists(t *testing.T) {
+	m := &fakeMutator{createExitErr: &world.ExitNameConflictError{Name: "north", ConflictingExitID: ulid.Make()}}
+	caps := newFakeBaseWithMutator(m)
+	srv := hostcap.NewWorldMutationServer(hostcap.NewBase(caps, "core-scenes"))
+	_, err := srv.CreateExit(context.Background(), &hostv1.CreateExitRequest{
+		FromId: ulid.Make().String(),
+		ToId:   ulid.Make().String(),
+		Name:   "north",
+	})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	assert.Contains(t, st.Message(), `"north"`)
+}
+
 func TestWorldMutationServerCreateObjectInternalErrorIsOpaque(t *testing.T) {
 	m := &fakeMutator{createObjectErr: errors.New("secret db detail")}
 	caps := newFakeBaseWithMutator(m)
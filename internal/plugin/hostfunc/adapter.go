@@ -5,12 +5,14 @@ package hostfunc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
 	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/world"
 )
 
@@ -23,10 +25,33 @@ import (
 type WorldService interface {
 	GetLocation(ctx context.Context, subjectID string, id ulid.ULID) (*world.Location, error)
 	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*world.Character, error)
-	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error)
+	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error)
 	GetObject(ctx context.Context, subjectID string, id ulid.ULID) (*world.Object, error)
 }
 
+// LocationBatcher is implemented by world services that can read several
+// locations with one authorization pass and one query. *world.Service
+// satisfies it; WorldQuerierAdapter.GetLocations falls back to per-ID
+// GetLocation calls for services that do not.
+type LocationBatcher interface {
+	GetLocations(ctx context.Context, subjectID string, ids []ulid.ULID) ([]*world.Location, error)
+}
+
+// ExitReader is implemented by world services that list the exits an
+// observer can see. *world.Service satisfies it; WorldQuerierAdapter.GetExits
+// fails for services that do not.
+type ExitReader interface {
+	GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*world.Exit, error)
+}
+
+// CharacterVisibilityFilter is implemented by world services that hide dark
+// and invisible characters. *world.Service satisfies it;
+// WorldQuerierAdapter.GetCharactersByLocation returns every character for
+// services that do not.
+type CharacterVisibilityFilter interface {
+	VisibleCharacters(ctx context.Context, observerID ulid.ULID, chars []*world.Character) []*world.Character
+}
+
 // WorldMutator defines the world service methods for mutations.
 // This is an alias for world.Mutator to maintain package separation.
 type WorldMutator = world.Mutator
@@ -92,6 +117,46 @@ func (a *WorldQuerierAdapter) GetLocation(ctx context.Context, id ulid.ULID) (*w
 	return loc, nil
 }
 
+// GetLocations retrieves the locations with the given IDs that the plugin may
+// read, in input order with duplicates removed. Denied and missing locations
+// are omitted. Returns errors with code PLUGIN_QUERY_FAILED on failure.
+//
+// This is the describer path: rendering a room's exits resolves every
+// destination in one call instead of one GetLocation round-trip per exit.
+func (a *WorldQuerierAdapter) GetLocations(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	if batcher, ok := a.service.(LocationBatcher); ok {
+		locs, err := batcher.GetLocations(ctx, a.SubjectID(), ids)
+		if err != nil {
+			return nil, oops.Code("PLUGIN_QUERY_FAILED").
+				With("plugin", a.pluginName).
+				With("entity_type", "locations").
+				Wrapf(err, "get locations")
+		}
+		if locs == nil {
+			return []*world.Location{}, nil
+		}
+		return locs, nil
+	}
+
+	locs := make([]*world.Location, 0, len(ids))
+	seen := make(map[ulid.ULID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		loc, err := a.GetLocation(ctx, id)
+		if errors.Is(err, world.ErrNotFound) || errors.Is(err, world.ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		locs = append(locs, loc)
+	}
+	return locs, nil
+}
+
 // GetCharacter retrieves a character by ID with plugin authorization.
 // Returns errors with code PLUGIN_QUERY_FAILED on failure.
 // See WorldQuerierAdapter documentation for defensive nil handling behavior.
@@ -116,13 +181,19 @@ func (a *WorldQuerierAdapter) GetCharacter(ctx context.Context, id ulid.ULID) (*
 	return char, nil
 }
 
-// GetCharactersByLocation retrieves characters at a location with pagination and plugin authorization.
+// GetCharactersByLocation returns a page of the characters at a location with plugin authorization.
 // Returns errors with code PLUGIN_QUERY_FAILED on failure.
-// If the service returns nil, normalizes to empty slice for consistency.
-func (a *WorldQuerierAdapter) GetCharactersByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error) {
-	chars, err := a.service.GetCharactersByLocation(ctx, a.SubjectID(), locationID, opts)
+// If the service returns nil Items, normalizes them to an empty slice for consistency.
+//
+// Characters the acting character (core.ActorFromContext) cannot see are
+// left out, so room descriptions never name a dark or invisible character to
+// an observer without the right to see it; a page can therefore hold fewer
+// than opts.Limit characters while NextCursor still points past them.
+// Without an acting character only visible characters are returned.
+func (a *WorldQuerierAdapter) GetCharactersByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	page, err := a.service.GetCharactersByLocation(ctx, a.SubjectID(), locationID, opts)
 	if err != nil {
-		return nil, oops.Code("PLUGIN_QUERY_FAILED").
+		return world.Page[*world.Character]{}, oops.Code("PLUGIN_QUERY_FAILED").
 			With("plugin", a.pluginName).
 			With("entity_type", "characters_by_location").
 			Wrapf(err, "get characters by location")
@@ -130,13 +201,56 @@ func (a *WorldQuerierAdapter) GetCharactersByLocation(ctx context.Context, locat
 	// Normalize nil slice to empty slice for consistent behavior.
 	// Unlike single-entity methods, nil is technically valid for slices,
 	// but we normalize for consistency and to detect potential service issues.
-	if chars == nil {
+	if page.Items == nil {
 		slog.DebugContext(ctx, "service returned nil slice, normalizing to empty",
 			"plugin", a.pluginName,
 			"location_id", locationID.String())
-		return []*world.Character{}, nil
+		page.Items = []*world.Character{}
+		return page, nil
+	}
+	if filter, ok := a.service.(CharacterVisibilityFilter); ok {
+		page.Items = filter.VisibleCharacters(ctx, actingCharacterID(ctx), page.Items)
 	}
-	return chars, nil
+	return page, nil
+}
+
+// GetExits retrieves the exits leaving a location with plugin authorization.
+// Exits the acting character (core.ActorFromContext) cannot see are left out;
+// without an acting character only exits visible to everyone are returned.
+// Returns errors with code PLUGIN_QUERY_FAILED on failure.
+func (a *WorldQuerierAdapter) GetExits(ctx context.Context, locationID ulid.ULID) ([]*world.Exit, error) {
+	reader, ok := a.service.(ExitReader)
+	if !ok {
+		return nil, oops.Code("PLUGIN_QUERY_FAILED").
+			With("plugin", a.pluginName).
+			With("entity_type", "exits").
+			Errorf("world service does not list exits")
+	}
+	exits, err := reader.GetVisibleExits(ctx, a.SubjectID(), locationID, actingCharacterID(ctx))
+	if err != nil {
+		return nil, oops.Code("PLUGIN_QUERY_FAILED").
+			With("plugin", a.pluginName).
+			With("entity_type", "exits").
+			Wrapf(err, "get exits")
+	}
+	if exits == nil {
+		return []*world.Exit{}, nil
+	}
+	return exits, nil
+}
+
+// actingCharacterID returns the character acting on ctx, or the zero ULID
+// when the actor is not a character or cannot be parsed.
+func actingCharacterID(ctx context.Context) ulid.ULID {
+	actor, ok := core.ActorFromContext(ctx)
+	if !ok || actor.Kind != core.ActorCharacter {
+		return ulid.ULID{}
+	}
+	id, err := ulid.Parse(actor.ID)
+	if err != nil {
+		return ulid.ULID{}
+	}
+	return id
 }
 
 // GetObject retrieves an object by ID with plugin authorization.
@@ -162,3 +276,9 @@ func (a *WorldQuerierAdapter) GetObject(ctx context.Context, id ulid.ULID) (*wor
 	}
 	return obj, nil
 }
+
+// Compile-time interface check.
+var (
+	_ LocationBatcher = (*world.Service)(nil)
+	_ ExitReader      = (*world.Service)(nil)
+)
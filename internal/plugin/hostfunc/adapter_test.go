@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/holomush/holomush/internal/core"
 	"github.com/holomush/holomush/internal/plugin/hostfunc"
 	"github.com/holomush/holomush/internal/world"
 	"github.com/holomush/holomush/pkg/errutil"
@@ -46,12 +47,12 @@ func (m *mockWorldService) GetCharacter(_ context.Context, subjectID string, _ u
 	return m.character, nil
 }
 
-func (m *mockWorldService) GetCharactersByLocation(_ context.Context, subjectID string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
+func (m *mockWorldService) GetCharactersByLocation(_ context.Context, subjectID string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
 	m.capturedSubjectID = subjectID
 	if m.err != nil {
-		return nil, m.err
+		return world.Page[*world.Character]{}, m.err
 	}
-	return m.characters, nil
+	return world.Page[*world.Character]{Items: m.characters}, nil
 }
 
 func (m *mockWorldService) GetObject(_ context.Context, subjectID string, _ ulid.ULID) (*world.Object, error) {
@@ -154,6 +155,135 @@ func TestWorldQuerierAdapter_GetLocation(t *testing.T) {
 	})
 }
 
+// batchWorldService adds the LocationBatcher fast path to mockWorldService.
+type batchWorldService struct {
+	mockWorldService
+	locations []*world.Location
+	batchIDs  []ulid.ULID
+}
+
+func (m *batchWorldService) GetLocations(_ context.Context, subjectID string, ids []ulid.ULID) ([]*world.Location, error) {
+	m.capturedSubjectID = subjectID
+	m.batchIDs = ids
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.locations, nil
+}
+
+func TestWorldQuerierAdapter_GetLocations(t *testing.T) {
+	ctx := context.Background()
+	first, second := ulid.Make(), ulid.Make()
+
+	t.Run("uses the batch API when the service has one", func(t *testing.T) {
+		want := []*world.Location{{ID: first}, {ID: second}}
+		svc := &batchWorldService{locations: want}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "test-plugin")
+
+		locs, err := adapter.GetLocations(ctx, []ulid.ULID{first, second})
+
+		require.NoError(t, err)
+		assert.Equal(t, want, locs)
+		assert.Equal(t, []ulid.ULID{first, second}, svc.batchIDs)
+		assert.Equal(t, "plugin:test-plugin", svc.capturedSubjectID)
+	})
+
+	t.Run("batch errors include code and context", func(t *testing.T) {
+		svc := &batchWorldService{mockWorldService: mockWorldService{err: errors.New("db down")}}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "my-plugin")
+
+		_, err := adapter.GetLocations(ctx, []ulid.ULID{first})
+
+		errutil.AssertErrorCode(t, err, "PLUGIN_QUERY_FAILED")
+		errutil.AssertErrorContext(t, err, "entity_type", "locations")
+	})
+
+	t.Run("falls back to per-ID lookups, skipping denied and missing", func(t *testing.T) {
+		svc := &mockWorldService{location: &world.Location{ID: first}}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "test-plugin")
+
+		locs, err := adapter.GetLocations(ctx, []ulid.ULID{first, first})
+		require.NoError(t, err)
+		assert.Len(t, locs, 1, "duplicate IDs are looked up once")
+
+		svc.err = world.ErrPermissionDenied
+		locs, err = adapter.GetLocations(ctx, []ulid.ULID{first})
+		require.NoError(t, err)
+		assert.Empty(t, locs)
+	})
+
+	t.Run("fallback propagates other errors", func(t *testing.T) {
+		svc := &mockWorldService{err: world.ErrAccessEvaluationFailed}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "test-plugin")
+
+		_, err := adapter.GetLocations(ctx, []ulid.ULID{first})
+		assert.ErrorIs(t, err, world.ErrAccessEvaluationFailed)
+	})
+}
+
+// exitWorldService adds the ExitReader to mockWorldService.
+type exitWorldService struct {
+	mockWorldService
+	exits      []*world.Exit
+	observerID ulid.ULID
+}
+
+func (m *exitWorldService) GetVisibleExits(_ context.Context, subjectID string, _, observerCharID ulid.ULID) ([]*world.Exit, error) {
+	m.capturedSubjectID = subjectID
+	m.observerID = observerCharID
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.exits, nil
+}
+
+func TestWorldQuerierAdapter_GetExits(t *testing.T) {
+	locID := ulid.Make()
+
+	t.Run("lists exits as seen by the acting character", func(t *testing.T) {
+		want := []*world.Exit{{ID: ulid.Make(), Name: "north"}}
+		observer := ulid.Make()
+		svc := &exitWorldService{exits: want}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "test-plugin")
+
+		ctx := core.WithActor(context.Background(), core.Actor{Kind: core.ActorCharacter, ID: observer.String()})
+		exits, err := adapter.GetExits(ctx, locID)
+
+		require.NoError(t, err)
+		assert.Equal(t, want, exits)
+		assert.Equal(t, "plugin:test-plugin", svc.capturedSubjectID)
+		assert.Equal(t, observer, svc.observerID)
+	})
+
+	t.Run("normalizes a nil slice to empty", func(t *testing.T) {
+		adapter := hostfunc.NewWorldQuerierAdapter(&exitWorldService{}, "test-plugin")
+
+		exits, err := adapter.GetExits(context.Background(), locID)
+
+		require.NoError(t, err)
+		assert.NotNil(t, exits)
+		assert.Empty(t, exits)
+	})
+
+	t.Run("errors include code and context", func(t *testing.T) {
+		svc := &exitWorldService{mockWorldService: mockWorldService{err: errors.New("db down")}}
+		adapter := hostfunc.NewWorldQuerierAdapter(svc, "my-plugin")
+
+		_, err := adapter.GetExits(context.Background(), locID)
+
+		errutil.AssertErrorCode(t, err, "PLUGIN_QUERY_FAILED")
+		errutil.AssertErrorContext(t, err, "entity_type", "exits")
+	})
+
+	t.Run("fails when the service cannot list exits", func(t *testing.T) {
+		adapter := hostfunc.NewWorldQuerierAdapter(&mockWorldService{}, "my-plugin")
+
+		_, err := adapter.GetExits(context.Background(), locID)
+
+		errutil.AssertErrorCode(t, err, "PLUGIN_QUERY_FAILED")
+	})
+}
+
 func TestWorldQuerierAdapter_GetCharacter(t *testing.T) {
 	ctx := context.Background()
 	charID := ulid.Make()
@@ -215,6 +345,53 @@ func TestWorldQuerierAdapter_GetCharacter(t *testing.T) {
 	})
 }
 
+// visibilityWorldService adds the CharacterVisibilityFilter to
+// mockWorldService: it hides every character in hidden from everyone but
+// the character itself.
+type visibilityWorldService struct {
+	mockWorldService
+	hidden     map[ulid.ULID]bool
+	observerID ulid.ULID
+}
+
+func (m *visibilityWorldService) VisibleCharacters(_ context.Context, observerID ulid.ULID, chars []*world.Character) []*world.Character {
+	m.observerID = observerID
+	visible := make([]*world.Character, 0, len(chars))
+	for _, c := range chars {
+		if !m.hidden[c.ID] || c.ID == observerID {
+			visible = append(visible, c)
+		}
+	}
+	return visible
+}
+
+func TestWorldQuerierAdapter_GetCharactersByLocationHidesCharactersFromActor(t *testing.T) {
+	locID := ulid.Make()
+	shown := &world.Character{ID: ulid.Make(), Name: "Shown", LocationID: &locID}
+	dark := &world.Character{ID: ulid.Make(), Name: "Dark", LocationID: &locID, Visibility: world.CharacterDark}
+	observer := ulid.Make()
+	svc := &visibilityWorldService{
+		mockWorldService: mockWorldService{characters: []*world.Character{shown, dark}},
+		hidden:           map[ulid.ULID]bool{dark.ID: true},
+	}
+	adapter := hostfunc.NewWorldQuerierAdapter(svc, "core-objects")
+
+	ctx := core.WithActor(context.Background(), core.Actor{Kind: core.ActorCharacter, ID: observer.String()})
+	chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []*world.Character{shown}, chars.Items)
+	assert.Equal(t, observer, svc.observerID)
+
+	ctx = core.WithActor(context.Background(), core.Actor{Kind: core.ActorCharacter, ID: dark.ID.String()})
+	chars, err = adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, chars.Items, 2, "a dark character still sees itself")
+
+	_, err = adapter.GetCharactersByLocation(context.Background(), locID, world.ListOptions{})
+	require.NoError(t, err)
+	assert.True(t, svc.observerID.IsZero(), "no acting character filters as an anonymous observer")
+}
+
 func TestWorldQuerierAdapter_GetCharactersByLocation(t *testing.T) {
 	ctx := context.Background()
 	locID := ulid.Make()
@@ -230,7 +407,7 @@ func TestWorldQuerierAdapter_GetCharactersByLocation(t *testing.T) {
 		chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
 
 		require.NoError(t, err)
-		assert.Equal(t, expectedChars, chars)
+		assert.Equal(t, expectedChars, chars.Items)
 		assert.Equal(t, "plugin:presence-plugin", svc.capturedSubjectID)
 	})
 
@@ -241,7 +418,7 @@ func TestWorldQuerierAdapter_GetCharactersByLocation(t *testing.T) {
 		chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
 
 		require.NoError(t, err)
-		assert.Empty(t, chars)
+		assert.Empty(t, chars.Items)
 	})
 
 	// Defensive nil check: If service returns nil slice, normalize to empty slice.
@@ -254,8 +431,8 @@ func TestWorldQuerierAdapter_GetCharactersByLocation(t *testing.T) {
 		chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
 
 		require.NoError(t, err)
-		assert.NotNil(t, chars, "nil slice should be normalized to empty slice")
-		assert.Empty(t, chars)
+		assert.NotNil(t, chars.Items, "nil slice should be normalized to empty slice")
+		assert.Empty(t, chars.Items)
 	})
 
 	t.Run("propagates errors", func(t *testing.T) {
@@ -265,7 +442,7 @@ func TestWorldQuerierAdapter_GetCharactersByLocation(t *testing.T) {
 
 		chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
 
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		assert.ErrorIs(t, err, expectedErr)
 	})
 
@@ -356,9 +533,9 @@ func (m *blockingMockWorldService) GetCharacter(ctx context.Context, _ string, _
 	return nil, ctx.Err()
 }
 
-func (m *blockingMockWorldService) GetCharactersByLocation(ctx context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
+func (m *blockingMockWorldService) GetCharactersByLocation(ctx context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
 	<-ctx.Done()
-	return nil, ctx.Err()
+	return world.Page[*world.Character]{}, ctx.Err()
 }
 
 func (m *blockingMockWorldService) GetObject(ctx context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -413,7 +590,7 @@ func TestWorldQuerierAdapter_ContextTimeout(t *testing.T) {
 
 		chars, err := adapter.GetCharactersByLocation(ctx, locID, world.ListOptions{})
 
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, context.DeadlineExceeded)
 		errutil.AssertErrorCode(t, err, "PLUGIN_QUERY_FAILED")
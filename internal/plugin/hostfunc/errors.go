@@ -46,6 +46,13 @@ func SanitizeErrorForPlugin(ctx PluginErrorContext, err error) string {
 	if errors.Is(err, world.ErrPermissionDenied) {
 		return "access denied"
 	}
+	if errors.Is(err, world.ErrInvalidCursor) {
+		return "invalid cursor"
+	}
+	var nameConflict *world.ExitNameConflictError
+	if errors.As(err, &nameConflict) {
+		return fmt.Sprintf("exit name %q is already in use at this location", nameConflict.Name)
+	}
 	if errors.Is(err, context.DeadlineExceeded) {
 		slog.Warn("plugin operation timed out",
 			"plugin", ctx.Plugin,
@@ -62,6 +62,18 @@ func TestSanitizeErrorForPluginReturnsPermissionDeniedForErrPermissionDenied(t *
 	assert.Equal(t, "access denied", result)
 }
 
+func TestSanitizeErrorForPluginNamesExitNameConflict(t *testing.T) {
+	ctx := PluginErrorContext{
+		Plugin:    "test-plugin",
+		Operation: "create_exit",
+		Subject:   "exit",
+		SubjectID: "north",
+	}
+	err := fmt.Errorf("create exit: %w", &world.ExitNameConflictError{Name: "North"})
+	result := SanitizeErrorForPlugin(ctx, err)
+	assert.Equal(t, `exit name "North" is already in use at this location`, result)
+}
+
 func TestSanitizeErrorForPluginReturnsTimeoutForDeadlineExceeded(t *testing.T) {
 	var buf bytes.Buffer
 	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
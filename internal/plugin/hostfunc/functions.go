@@ -54,6 +54,10 @@ type Functions struct {
 	settingsOps      SettingsOps
 	historyReader    HistoryReader
 	auditDecryptor   AuditDecryptor
+	diceRoller       DiceRoller
+	checkRoller      CheckRoller
+	helpTopics       HelpTopics
+	pluginStorage    PluginStorage
 	gameID           string
 	// pluginConfigs holds the merged (opaque) config per plugin, set by the
 	// Lua host before Register. nil/absent → empty holomush.config. Guarded by
@@ -156,6 +160,30 @@ func WithAuditDecryptor(d AuditDecryptor) Option {
 	return func(f *Functions) { f.auditDecryptor = d }
 }
 
+// WithDiceRoller sets the server-side dice roller for the holomush.roll host
+// function.
+func WithDiceRoller(r DiceRoller) Option {
+	return func(f *Functions) { f.diceRoller = r }
+}
+
+// WithCheckRoller sets the stat check service for the holomush.check and
+// holomush.contest host functions.
+func WithCheckRoller(c CheckRoller) Option {
+	return func(f *Functions) { f.checkRoller = c }
+}
+
+// WithHelpTopics sets the help topic reader for the holomush.help_* host
+// functions.
+func WithHelpTopics(h HelpTopics) Option {
+	return func(f *Functions) { f.helpTopics = h }
+}
+
+// WithPluginStorage sets the per-plugin key-value store for the
+// holomush.storage_* host functions.
+func WithPluginStorage(s PluginStorage) Option {
+	return func(f *Functions) { f.pluginStorage = s }
+}
+
 // SetAuditDecryptor injects the audit read-back decryptor after construction.
 // Same late-binding rationale as SetHistoryReader: the decryptor's OwnerMap +
 // crypto deps are assembled during gRPC subsystem Start, after plugin loading.
@@ -237,6 +265,36 @@ func (f *Functions) SetHistoryReader(hr HistoryReader) {
 	f.historyReader = hr
 }
 
+// SetDiceRoller sets the dice roller for the holomush.roll host function.
+// Same late-binding rationale as SetFocusOps: the roller publishes through
+// the event publisher built during gRPC subsystem Prepare, after plugin
+// loading.
+func (f *Functions) SetDiceRoller(r DiceRoller) {
+	f.diceRoller = r
+}
+
+// SetCheckRoller sets the stat check service for the holomush.check and
+// holomush.contest host functions. Same late-binding rationale as
+// SetDiceRoller.
+func (f *Functions) SetCheckRoller(c CheckRoller) {
+	f.checkRoller = c
+}
+
+// SetHelpTopics sets the help topic reader for the holomush.help_* host
+// functions. The help service is built by the
+// plugin subsystem after the Lua host, so it cannot be injected at
+// construction time.
+func (f *Functions) SetHelpTopics(h HelpTopics) {
+	f.helpTopics = h
+}
+
+// SetPluginStorage sets the per-plugin key-value store for the
+// holomush.storage_* host functions. Like the help service, the store is
+// built by the plugin subsystem after the Lua host.
+func (f *Functions) SetPluginStorage(s PluginStorage) {
+	f.pluginStorage = s
+}
+
 // SetCommandQuerier late-binds the shared command querier after the command
 // registry is built. The querier is constructed in PluginSubsystem.Start after
 // both s.cmdRegistry (line ~391) and s.aliasCache are populated — after
@@ -338,6 +396,22 @@ func (f *Functions) Register(ls *lua.LState, pluginName string, requires ...stri
 	// Register audit read-back decrypt functions.
 	RegisterAuditFuncs(ls, mod, pluginName, f.auditDecryptor)
 
+	// Register the server-side dice roller (holomush.roll). Rolls are produced
+	// and published by the host, never by the plugin.
+	RegisterDiceFunc(ls, mod, pluginName, f.diceRoller)
+
+	// Register server-side stat checks (holomush.check, holomush.contest).
+	// Like holomush.roll, the host rolls and publishes the result.
+	RegisterCheckFuncs(ls, mod, pluginName, f.checkRoller)
+
+	// Register the help topic readers (holomush.help_topic, help_list,
+	// help_search).
+	RegisterHelpFuncs(ls, mod, pluginName, f.helpTopics)
+
+	// Register the plugin's own key-value store (holomush.storage_get,
+	// storage_set, storage_list). The namespace is pluginName, bound here.
+	RegisterStorageFuncs(ls, mod, pluginName, f.pluginStorage)
+
 	// INV-PLUGIN-32: install a no-op register_emit_type in the per-delivery
 	// hostfunc surface. Lua plugins call register_emit_type at top level
 	// (idempotent registrations), and main.lua is re-executed on every
@@ -427,6 +501,16 @@ func (f *Functions) RegisteredFunctionsForAudit() []AuditEntry {
 		{Name: "holomush.query_stream_history"},
 		// Unconditionally registered by RegisterAuditFuncs.
 		{Name: "holomush.decrypt_own_audit_rows"},
+		// Unconditionally registered by RegisterDiceFunc.
+		{Name: "holomush.roll"},
+		// Unconditionally registered by RegisterHelpFuncs.
+		{Name: "holomush.help_topic"},
+		{Name: "holomush.help_list"},
+		{Name: "holomush.help_search"},
+		// Unconditionally registered by RegisterStorageFuncs.
+		{Name: "holomush.storage_get"},
+		{Name: "holomush.storage_set"},
+		{Name: "holomush.storage_list"},
 		// INV-PLUGIN-32 (jg9b.3): per-delivery no-op; Load-pass capturing variant
 		// is installed by RegisterWithEmitCapture.
 		{Name: "holomush.register_emit_type"},
@@ -46,8 +46,8 @@ func (m *mockWorldMutatorForConstructorTest) GetCharacter(_ context.Context, _ s
 	return nil, nil
 }
 
-func (m *mockWorldMutatorForConstructorTest) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (m *mockWorldMutatorForConstructorTest) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (m *mockWorldMutatorForConstructorTest) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
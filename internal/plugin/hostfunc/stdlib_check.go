@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/game"
+)
+
+// CheckRoller is the narrow seam the holomush.check and holomush.contest
+// hostfuncs delegate to. *game.CheckService satisfies it.
+type CheckRoller interface {
+	Roll(ctx context.Context, subject string, charID ulid.ULID, attribute string, difficulty int, opts ...game.CheckOption) (game.CheckResult, error)
+	Contest(ctx context.Context, subject string, challenger, defender game.ContestSide, opts ...game.CheckOption) (game.ContestResult, error)
+}
+
+// RegisterCheckFuncs adds holomush.check and holomush.contest to an existing
+// holomush module table. checker may be nil; calls then return
+// (nil, "checks not available"). checker is captured in the functions' Go
+// closures so plugin code cannot replace it.
+func RegisterCheckFuncs(ls *lua.LState, mod *lua.LTable, pluginName string, checker CheckRoller) {
+	ls.SetField(mod, "check", ls.NewFunction(func(l *lua.LState) int {
+		return checkImpl(l, pluginName, checker)
+	}))
+	ls.SetField(mod, "contest", ls.NewFunction(func(l *lua.LState) int {
+		return contestImpl(l, pluginName, checker)
+	}))
+}
+
+// checkImpl implements holomush.check(args). args is a table with fields:
+//
+//	attribute  string (required) — stat to roll, e.g. "strength"
+//	difficulty number (required) — total to meet or beat
+//	stream     string (optional) — "location.<id>" or "scene.<id>";
+//	                               defaults to the character's location
+//	reason     string (optional) — free text shown with the check
+//
+// The check is made for the acting character recovered from the
+// host-stamped dispatch context (INV-PLUGIN-22: NEVER from Lua args), and
+// stats are read as the plugin, so property visibility applies. On success
+// returns a table:
+//
+//	{ attribute = string, stat = number, roll = number, total = number,
+//	  difficulty = number, success = bool, margin = number,
+//	  dice = { { value = number, exploded = bool, dropped = bool }, ... } }
+//
+// On error returns (nil, error_string).
+func checkImpl(ls *lua.LState, pluginName string, checker CheckRoller) int {
+	args := ls.CheckTable(1)
+	ctx := luaContext(ls)
+
+	charID, msg := checkActor(ctx, pluginName, checker)
+	if msg != "" {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString(msg))
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	result, err := checker.Roll(ctx, access.PluginSubject(pluginName), charID,
+		lua.LVAsString(ls.GetField(args, "attribute")),
+		int(lua.LVAsNumber(ls.GetField(args, "difficulty"))),
+		checkOptions(ls, args)...)
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.check failed",
+			"plugin", pluginName, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString(checkErrorMessage(err)))
+		return 2
+	}
+
+	out := checkRollTable(ls, result.CheckRoll)
+	ls.SetField(out, "difficulty", lua.LNumber(result.Difficulty))
+	ls.SetField(out, "success", lua.LBool(result.Success))
+	ls.SetField(out, "margin", lua.LNumber(result.Margin))
+	ls.Push(out)
+	return 1
+}
+
+// contestImpl implements holomush.contest(args). args is a table with
+// fields:
+//
+//	attribute          string (required) — the acting character's stat
+//	defender           string (required) — the opposing character's ID
+//	defender_attribute string (optional) — the defender's stat; defaults
+//	                                       to attribute
+//	stream             string (optional) — as for holomush.check; defaults
+//	                                       to the acting character's location
+//	reason             string (optional) — free text shown with the contest
+//
+// The acting character is always the challenger. On success returns a
+// table:
+//
+//	{ challenger = <roll>, defender = <roll>, winner = string?, tie = bool }
+//
+// where each <roll> has the attribute, stat, roll, total, and dice fields
+// of a holomush.check result and winner is the winning character's ID, nil
+// on a tie. On error returns (nil, error_string).
+func contestImpl(ls *lua.LState, pluginName string, checker CheckRoller) int {
+	args := ls.CheckTable(1)
+	ctx := luaContext(ls)
+
+	charID, msg := checkActor(ctx, pluginName, checker)
+	if msg != "" {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString(msg))
+		return 2
+	}
+	defenderID, err := ulid.Parse(lua.LVAsString(ls.GetField(args, "defender")))
+	if err != nil {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("invalid defender ID"))
+		return 2
+	}
+	attribute := lua.LVAsString(ls.GetField(args, "attribute"))
+	defenderAttribute := lua.LVAsString(ls.GetField(args, "defender_attribute"))
+	if defenderAttribute == "" {
+		defenderAttribute = attribute
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	result, err := checker.Contest(ctx, access.PluginSubject(pluginName),
+		game.ContestSide{CharacterID: charID, Attribute: attribute},
+		game.ContestSide{CharacterID: defenderID, Attribute: defenderAttribute},
+		checkOptions(ls, args)...)
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.contest failed",
+			"plugin", pluginName, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString(checkErrorMessage(err)))
+		return 2
+	}
+
+	out := ls.NewTable()
+	ls.SetField(out, "challenger", checkRollTable(ls, result.Challenger))
+	ls.SetField(out, "defender", checkRollTable(ls, result.Defender))
+	ls.SetField(out, "tie", lua.LBool(result.Tie()))
+	if !result.Tie() {
+		ls.SetField(out, "winner", lua.LString(result.Winner.String()))
+	}
+	ls.Push(out)
+	return 1
+}
+
+// checkActor returns the acting character for a check, or the Lua-facing
+// message refusing the call.
+func checkActor(ctx context.Context, pluginName string, checker CheckRoller) (ulid.ULID, string) {
+	if checker == nil {
+		slog.WarnContext(ctx, "check host function called but no check service configured",
+			"plugin", pluginName)
+		return ulid.ULID{}, "checks not available"
+	}
+	actor, ok := core.ActorFromContext(ctx)
+	if !ok || actor.Kind != core.ActorCharacter {
+		return ulid.ULID{}, "permission denied"
+	}
+	charID, err := ulid.Parse(actor.ID)
+	if err != nil {
+		return ulid.ULID{}, "permission denied"
+	}
+	return charID, ""
+}
+
+// checkOptions reads the optional stream and reason fields shared by
+// holomush.check and holomush.contest.
+func checkOptions(ls *lua.LState, args *lua.LTable) []game.CheckOption {
+	var opts []game.CheckOption
+	if stream := lua.LVAsString(ls.GetField(args, "stream")); stream != "" {
+		opts = append(opts, game.OnStream(stream))
+	}
+	if reason := lua.LVAsString(ls.GetField(args, "reason")); reason != "" {
+		opts = append(opts, game.WithCheckReason(reason))
+	}
+	return opts
+}
+
+// checkRollTable converts one side of a check to its Lua table.
+func checkRollTable(ls *lua.LState, r game.CheckRoll) *lua.LTable {
+	dice := ls.NewTable()
+	for i, d := range r.Roll.Dice {
+		entry := ls.NewTable()
+		ls.SetField(entry, "value", lua.LNumber(d.Value))
+		ls.SetField(entry, "exploded", lua.LBool(d.Exploded))
+		ls.SetField(entry, "dropped", lua.LBool(d.Dropped))
+		dice.RawSetInt(i+1, entry)
+	}
+	out := ls.NewTable()
+	ls.SetField(out, "character_id", lua.LString(r.CharacterID.String()))
+	ls.SetField(out, "attribute", lua.LString(r.Attribute))
+	ls.SetField(out, "stat", lua.LNumber(r.Stat))
+	ls.SetField(out, "roll", lua.LNumber(r.Roll.Total))
+	ls.SetField(out, "total", lua.LNumber(r.Total))
+	ls.SetField(out, "dice", dice)
+	return out
+}
+
+// checkErrorMessage returns the Lua-facing message for a failed check.
+// Request and stat failures describe the caller's own input or the
+// character's sheet and are returned verbatim; anything else (world reads,
+// RNG, publish) is reduced to a generic message so inner error text does
+// not leak to the plugin.
+func checkErrorMessage(err error) string {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		code, _ := oopsErr.Code().(string)
+		if strings.HasPrefix(code, "CHECK_INVALID_") || strings.HasPrefix(code, "CHECK_STAT_") {
+			return err.Error()
+		}
+	}
+	return "check failed"
+}
@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/game"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/plugin/hostfunc"
+)
+
+// stubCheckRoller records check requests and returns canned results.
+type stubCheckRoller struct {
+	subject    string
+	charID     ulid.ULID
+	attribute  string
+	difficulty int
+	sides      [2]game.ContestSide
+	optCount   int
+	calls      int
+
+	check   game.CheckResult
+	contest game.ContestResult
+	err     error
+}
+
+func (s *stubCheckRoller) Roll(_ context.Context, subject string, charID ulid.ULID, attribute string, difficulty int, opts ...game.CheckOption) (game.CheckResult, error) {
+	s.calls++
+	s.subject, s.charID, s.attribute, s.difficulty, s.optCount = subject, charID, attribute, difficulty, len(opts)
+	return s.check, s.err
+}
+
+func (s *stubCheckRoller) Contest(_ context.Context, subject string, challenger, defender game.ContestSide, opts ...game.CheckOption) (game.ContestResult, error) {
+	s.calls++
+	s.subject, s.sides, s.optCount = subject, [2]game.ContestSide{challenger, defender}, len(opts)
+	return s.contest, s.err
+}
+
+// newCheckTestState registers holomush.check and holomush.contest over
+// checker with actor stamped on the VM context; a nil actor leaves the
+// context unstamped.
+func newCheckTestState(t *testing.T, checker hostfunc.CheckRoller, actor *core.Actor) *lua.LState {
+	t.Helper()
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	ctx := context.Background()
+	if actor != nil {
+		ctx = core.WithActor(ctx, *actor)
+	}
+	L.SetContext(ctx)
+	hostfunc.New(nil, hostfunc.WithCheckRoller(checker)).Register(L, "check-plugin")
+	return L
+}
+
+func TestCheckHostfuncChecksAsActingCharacter(t *testing.T) {
+	charID := idgen.New()
+	checker := &stubCheckRoller{check: game.CheckResult{
+		CheckRoll: game.CheckRoll{
+			CharacterID: charID,
+			Attribute:   "strength",
+			Stat:        3,
+			Roll:        game.Result{Dice: []game.Die{{Value: 12}}, Total: 12},
+			Total:       15,
+		},
+		Difficulty: 14,
+		Success:    true,
+		Margin:     1,
+	}}
+	L := newCheckTestState(t, checker, &core.Actor{Kind: core.ActorCharacter, ID: charID.String()})
+
+	err := L.DoString(`
+local r, errmsg = holomush.check({attribute = "strength", difficulty = 14, stream = "location.01ABC", reason = "climb"})
+assert(r ~= nil, "expected result, got err=" .. tostring(errmsg))
+assert(r.success == true, "success")
+assert(r.total == 15 and r.roll == 12 and r.stat == 3, "totals")
+assert(r.margin == 1 and r.difficulty == 14, "margin")
+assert(r.attribute == "strength", "attribute")
+assert(#r.dice == 1 and r.dice[1].value == 12, "dice")
+`)
+	require.NoError(t, err)
+	assert.Equal(t, 1, checker.calls)
+	assert.Equal(t, charID, checker.charID)
+	assert.Equal(t, "plugin:check-plugin", checker.subject)
+	assert.Equal(t, "strength", checker.attribute)
+	assert.Equal(t, 14, checker.difficulty)
+	assert.Equal(t, 2, checker.optCount, "stream and reason MUST be passed as options")
+}
+
+func TestContestHostfuncChallengesAsActingCharacter(t *testing.T) {
+	charID, defenderID := idgen.New(), idgen.New()
+	checker := &stubCheckRoller{contest: game.ContestResult{
+		Challenger: game.CheckRoll{CharacterID: charID, Attribute: "wits", Total: 9},
+		Defender:   game.CheckRoll{CharacterID: defenderID, Attribute: "composure", Total: 12},
+		Winner:     defenderID,
+	}}
+	L := newCheckTestState(t, checker, &core.Actor{Kind: core.ActorCharacter, ID: charID.String()})
+
+	require.NoError(t, L.DoString(`r, errmsg = holomush.contest({attribute = "wits", defender = "`+defenderID.String()+`", defender_attribute = "composure"})`))
+	r, ok := L.GetGlobal("r").(*lua.LTable)
+	require.True(t, ok, "expected result, got err=%s", lua.LVAsString(L.GetGlobal("errmsg")))
+	assert.Equal(t, defenderID.String(), lua.LVAsString(r.RawGetString("winner")))
+	assert.Equal(t, lua.LFalse, r.RawGetString("tie"))
+	assert.Equal(t, [2]game.ContestSide{
+		{CharacterID: charID, Attribute: "wits"},
+		{CharacterID: defenderID, Attribute: "composure"},
+	}, checker.sides)
+	assert.Zero(t, checker.optCount)
+}
+
+func TestContestHostfuncDefaultsDefenderAttribute(t *testing.T) {
+	charID, defenderID := idgen.New(), idgen.New()
+	checker := &stubCheckRoller{}
+	L := newCheckTestState(t, checker, &core.Actor{Kind: core.ActorCharacter, ID: charID.String()})
+
+	require.NoError(t, L.DoString(`r = holomush.contest({attribute = "strength", defender = "`+defenderID.String()+`"})`))
+	assert.Equal(t, "strength", checker.sides[1].Attribute)
+	r, ok := L.GetGlobal("r").(*lua.LTable)
+	require.True(t, ok)
+	assert.Equal(t, lua.LTrue, r.RawGetString("tie"))
+	assert.Equal(t, lua.LNil, r.RawGetString("winner"))
+}
+
+func TestCheckHostfuncsDenyWithoutCharacterActor(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor *core.Actor
+	}{
+		{name: "no actor"},
+		{name: "plugin actor", actor: &core.Actor{Kind: core.ActorPlugin, ID: idgen.New().String()}},
+		{name: "malformed character id", actor: &core.Actor{Kind: core.ActorCharacter, ID: "not-a-ulid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &stubCheckRoller{}
+			L := newCheckTestState(t, checker, tt.actor)
+
+			err := L.DoString(`
+local r, errmsg = holomush.check({attribute = "strength", difficulty = 10})
+assert(r == nil and errmsg == "permission denied", "check: " .. tostring(errmsg))
+r, errmsg = holomush.contest({attribute = "strength", defender = "01ARZ3NDEKTSV4RRFFQ69G5FAV"})
+assert(r == nil and errmsg == "permission denied", "contest: " .. tostring(errmsg))
+`)
+			require.NoError(t, err)
+			assert.Zero(t, checker.calls, "denied calls must not reach the check service")
+		})
+	}
+}
+
+func TestCheckHostfuncsReportUnconfiguredService(t *testing.T) {
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	hostfunc.New(nil).Register(L, "check-plugin")
+
+	err := L.DoString(`
+local r, errmsg = holomush.check({attribute = "strength", difficulty = 10})
+assert(r == nil and errmsg == "checks not available", "unexpected: " .. tostring(errmsg))
+`)
+	require.NoError(t, err)
+}
+
+func TestContestHostfuncRejectsMalformedDefender(t *testing.T) {
+	checker := &stubCheckRoller{}
+	L := newCheckTestState(t, checker, &core.Actor{Kind: core.ActorCharacter, ID: idgen.New().String()})
+
+	require.NoError(t, L.DoString(`r, errmsg = holomush.contest({attribute = "strength", defender = "bob"})`))
+	assert.Equal(t, lua.LNil, L.GetGlobal("r"))
+	assert.Equal(t, "invalid defender ID", lua.LVAsString(L.GetGlobal("errmsg")))
+	assert.Zero(t, checker.calls)
+}
+
+func TestCheckHostfuncErrorMessages(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantMsg string
+	}{
+		{
+			name:    "missing stat is returned verbatim",
+			err:     oops.Code("CHECK_STAT_NOT_FOUND").Errorf("character has no strength stat"),
+			wantMsg: "character has no strength stat",
+		},
+		{
+			name:    "invalid request is returned verbatim",
+			err:     oops.Code("CHECK_INVALID_REQUEST").Errorf("difficulty must be within ±10000"),
+			wantMsg: "difficulty must be within ±10000",
+		},
+		{
+			name:    "publish failure is generic",
+			err:     oops.Code("CHECK_PUBLISH_FAILED").Wrap(errors.New("nats: connection closed")),
+			wantMsg: "check failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &stubCheckRoller{err: tt.err}
+			L := newCheckTestState(t, checker, &core.Actor{Kind: core.ActorCharacter, ID: idgen.New().String()})
+
+			require.NoError(t, L.DoString(`r, errmsg = holomush.check({attribute = "strength", difficulty = 10})`))
+			assert.Equal(t, lua.LNil, L.GetGlobal("r"))
+			assert.Equal(t, tt.wantMsg, lua.LVAsString(L.GetGlobal("errmsg")))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/game"
+)
+
+// DiceRoller is the narrow seam the holomush.roll hostfunc delegates to.
+// *game.DiceService satisfies it.
+type DiceRoller interface {
+	Roll(ctx context.Context, req game.RollRequest) (game.Result, error)
+}
+
+// RegisterDiceFunc adds holomush.roll to an existing holomush module table.
+// roller may be nil; calls then return (nil, "dice not available"). roller is
+// captured in the function's Go closure so plugin code cannot replace it.
+func RegisterDiceFunc(ls *lua.LState, mod *lua.LTable, pluginName string, roller DiceRoller) {
+	ls.SetField(mod, "roll", ls.NewFunction(func(l *lua.LState) int {
+		return rollImpl(l, pluginName, roller)
+	}))
+}
+
+// rollImpl implements holomush.roll(args). args is a table with fields:
+//
+//	expression string (required) — dice expression, e.g. "3d6+2"
+//	stream     string (required) — "location.<id>" or "scene.<id>"
+//	reason     string (optional) — free text shown with the roll
+//
+// The roll is attributed to the acting character recovered from the
+// host-stamped dispatch context (INV-PLUGIN-22: NEVER from Lua args), so a
+// plugin can ask for a roll but cannot roll for someone else or supply the
+// result. On success returns a table:
+//
+//	{ expression = string, total = number, modifier = number,
+//	  dice = { { value = number, exploded = bool, dropped = bool }, ... } }
+//
+// On error returns (nil, error_string).
+func rollImpl(ls *lua.LState, pluginName string, roller DiceRoller) int {
+	args := ls.CheckTable(1)
+	ctx := luaContext(ls)
+
+	if roller == nil {
+		slog.WarnContext(ctx, "roll host function called but no dice roller configured",
+			"plugin", pluginName)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("dice not available"))
+		return 2
+	}
+
+	actor, ok := core.ActorFromContext(ctx)
+	if !ok || actor.Kind != core.ActorCharacter {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("permission denied"))
+		return 2
+	}
+	charID, err := ulid.Parse(actor.ID)
+	if err != nil {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("permission denied"))
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	result, err := roller.Roll(ctx, game.RollRequest{
+		CharacterID: charID,
+		Stream:      lua.LVAsString(ls.GetField(args, "stream")),
+		Expression:  lua.LVAsString(ls.GetField(args, "expression")),
+		Reason:      lua.LVAsString(ls.GetField(args, "reason")),
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.roll failed",
+			"plugin", pluginName, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString(rollErrorMessage(err)))
+		return 2
+	}
+
+	dice := ls.NewTable()
+	for i, d := range result.Dice {
+		entry := ls.NewTable()
+		ls.SetField(entry, "value", lua.LNumber(d.Value))
+		ls.SetField(entry, "exploded", lua.LBool(d.Exploded))
+		ls.SetField(entry, "dropped", lua.LBool(d.Dropped))
+		dice.RawSetInt(i+1, entry)
+	}
+	out := ls.NewTable()
+	ls.SetField(out, "expression", lua.LString(result.Expression.String()))
+	ls.SetField(out, "total", lua.LNumber(result.Total))
+	ls.SetField(out, "modifier", lua.LNumber(result.Expression.Modifier))
+	ls.SetField(out, "dice", dice)
+	ls.Push(out)
+	return 1
+}
+
+// rollErrorMessage returns the Lua-facing message for a failed roll.
+// Validation failures describe the caller's own input and are returned
+// verbatim; anything else (RNG, publish) is reduced to a generic message so
+// inner error text does not leak to the plugin.
+func rollErrorMessage(err error) string {
+	if oopsErr, ok := oops.AsOops(err); ok {
+		if code, _ := oopsErr.Code().(string); strings.HasPrefix(code, "DICE_INVALID_") {
+			return err.Error()
+		}
+	}
+	return "roll failed"
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/game"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/plugin/hostfunc"
+)
+
+// stubDiceRoller records roll requests and returns a canned result.
+type stubDiceRoller struct {
+	requests []game.RollRequest
+	result   game.Result
+	err      error
+}
+
+func (s *stubDiceRoller) Roll(_ context.Context, req game.RollRequest) (game.Result, error) {
+	s.requests = append(s.requests, req)
+	return s.result, s.err
+}
+
+// newDiceTestState registers holomush.roll over roller with actor stamped on
+// the VM context; a nil actor leaves the context unstamped.
+func newDiceTestState(t *testing.T, roller hostfunc.DiceRoller, actor *core.Actor) *lua.LState {
+	t.Helper()
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	ctx := context.Background()
+	if actor != nil {
+		ctx = core.WithActor(ctx, *actor)
+	}
+	L.SetContext(ctx)
+	hostfunc.New(nil, hostfunc.WithDiceRoller(roller)).Register(L, "dice-plugin")
+	return L
+}
+
+func TestRollHostfuncRollsAsActingCharacter(t *testing.T) {
+	charID := idgen.New()
+	roller := &stubDiceRoller{result: game.Result{
+		Expression: game.Expression{Count: 2, Sides: 6, Keep: 1, Modifier: 3},
+		Dice:       []game.Die{{Value: 5}, {Value: 2, Dropped: true}},
+		Total:      8,
+	}}
+	L := newDiceTestState(t, roller, &core.Actor{Kind: core.ActorCharacter, ID: charID.String()})
+
+	err := L.DoString(`
+local r, errmsg = holomush.roll({expression = "2d6kh1+3", stream = "location.01ABC", reason = "luck"})
+assert(r ~= nil, "expected result, got err=" .. tostring(errmsg))
+assert(r.total == 8, "total")
+assert(r.modifier == 3, "modifier")
+assert(r.expression == "2d6kh1+3", "expression")
+assert(#r.dice == 2, "dice count")
+assert(r.dice[1].value == 5 and not r.dice[1].dropped, "first die")
+assert(r.dice[2].value == 2 and r.dice[2].dropped, "second die")
+`)
+	require.NoError(t, err)
+	require.Len(t, roller.requests, 1)
+	assert.Equal(t, game.RollRequest{
+		CharacterID: charID,
+		Stream:      "location.01ABC",
+		Expression:  "2d6kh1+3",
+		Reason:      "luck",
+	}, roller.requests[0])
+}
+
+func TestRollHostfuncDeniesWithoutCharacterActor(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor *core.Actor
+	}{
+		{name: "no actor"},
+		{name: "plugin actor", actor: &core.Actor{Kind: core.ActorPlugin, ID: idgen.New().String()}},
+		{name: "malformed character id", actor: &core.Actor{Kind: core.ActorCharacter, ID: "not-a-ulid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roller := &stubDiceRoller{}
+			L := newDiceTestState(t, roller, tt.actor)
+
+			err := L.DoString(`
+local r, errmsg = holomush.roll({expression = "1d6", stream = "location.01ABC"})
+assert(r == nil, "expected nil result")
+assert(errmsg == "permission denied", "unexpected err: " .. tostring(errmsg))
+`)
+			require.NoError(t, err)
+			assert.Empty(t, roller.requests, "denied calls must not reach the roller")
+		})
+	}
+}
+
+func TestRollHostfuncReportsUnconfiguredRoller(t *testing.T) {
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	hostfunc.New(nil).Register(L, "dice-plugin")
+
+	err := L.DoString(`
+local r, errmsg = holomush.roll({expression = "1d6", stream = "location.01ABC"})
+assert(r == nil and errmsg == "dice not available", "unexpected: " .. tostring(errmsg))
+`)
+	require.NoError(t, err)
+}
+
+func TestRollHostfuncErrorMessages(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantMsg string
+	}{
+		{
+			name:    "validation error is returned verbatim",
+			err:     oops.Code("DICE_INVALID_EXPRESSION").Errorf("invalid dice expression \"x\""),
+			wantMsg: "invalid dice expression \"x\"",
+		},
+		{
+			name:    "publish failure is generic",
+			err:     oops.Code("DICE_PUBLISH_FAILED").Wrap(errors.New("nats: connection closed")),
+			wantMsg: "roll failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roller := &stubDiceRoller{err: tt.err}
+			L := newDiceTestState(t, roller, &core.Actor{Kind: core.ActorCharacter, ID: idgen.New().String()})
+
+			require.NoError(t, L.DoString(`r, errmsg = holomush.roll({expression = "x", stream = "location.01ABC"})`))
+			assert.Equal(t, lua.LNil, L.GetGlobal("r"))
+			assert.Equal(t, tt.wantMsg, lua.LVAsString(L.GetGlobal("errmsg")))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/help"
+)
+
+// HelpTopics is the narrow read seam the holomush.help_* hostfuncs delegate
+// to. *help.Service satisfies it.
+type HelpTopics interface {
+	Topic(ctx context.Context, name string) (*help.Topic, error)
+	List(ctx context.Context, category string) ([]*help.Topic, error)
+	Search(ctx context.Context, query string, limit int) ([]*help.Topic, error)
+}
+
+// RegisterHelpFuncs adds holomush.help_topic, holomush.help_list, and
+// holomush.help_search to an existing holomush module table. topics may be
+// nil; calls then return (nil, "help not available"). Help is public, so
+// none of them consults the acting character.
+func RegisterHelpFuncs(ls *lua.LState, mod *lua.LTable, pluginName string, topics HelpTopics) {
+	ls.SetField(mod, "help_topic", ls.NewFunction(func(l *lua.LState) int {
+		return helpTopicImpl(l, pluginName, topics)
+	}))
+	ls.SetField(mod, "help_list", ls.NewFunction(func(l *lua.LState) int {
+		return helpListImpl(l, pluginName, topics)
+	}))
+	ls.SetField(mod, "help_search", ls.NewFunction(func(l *lua.LState) int {
+		return helpSearchImpl(l, pluginName, topics)
+	}))
+}
+
+// helpTopicImpl implements holomush.help_topic(name). name matches a topic
+// name or alias, case-insensitively. On success returns a table:
+//
+//	{ name = string, title = string, category = string,
+//	  aliases = { string, ... }, text = string }
+//
+// text is the rendered page (title, body with format codes applied, and
+// footer) ready to send to the player. Returns (nil, "not found") when no
+// topic matches and (nil, error_string) on failure.
+func helpTopicImpl(ls *lua.LState, pluginName string, topics HelpTopics) int {
+	name := ls.CheckString(1)
+	ctx := luaContext(ls)
+
+	if topics == nil {
+		slog.WarnContext(ctx, "help_topic host function called but no help service configured",
+			"plugin", pluginName)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help not available"))
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	topic, err := topics.Topic(ctx, name)
+	if errors.Is(err, help.ErrNotFound) {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("not found"))
+		return 2
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.help_topic failed",
+			"plugin", pluginName, "topic", name, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help lookup failed"))
+		return 2
+	}
+
+	out := helpTopicTable(ls, topic)
+	aliases := ls.NewTable()
+	for i, alias := range topic.Aliases {
+		aliases.RawSetInt(i+1, lua.LString(alias))
+	}
+	ls.SetField(out, "aliases", aliases)
+	ls.SetField(out, "text", lua.LString(help.Render(topic).RenderANSI()))
+	ls.Push(out)
+	return 1
+}
+
+// helpListImpl implements holomush.help_list([category]). Returns an array of
+// { name, title, category } tables ordered by category then name, limited to
+// category when one is given.
+func helpListImpl(ls *lua.LState, pluginName string, topics HelpTopics) int {
+	category := ls.OptString(1, "")
+	ctx := luaContext(ls)
+
+	if topics == nil {
+		slog.WarnContext(ctx, "help_list host function called but no help service configured",
+			"plugin", pluginName)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help not available"))
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	listed, err := topics.List(ctx, category)
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.help_list failed",
+			"plugin", pluginName, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help list failed"))
+		return 2
+	}
+	ls.Push(helpTopicArray(ls, listed))
+	return 1
+}
+
+// helpSearchImpl implements holomush.help_search(query). Returns an array of
+// { name, title, category } tables, best match first, capped at
+// help.DefaultSearchLimit. An empty result is an empty table, not an error.
+func helpSearchImpl(ls *lua.LState, pluginName string, topics HelpTopics) int {
+	query := ls.CheckString(1)
+	ctx := luaContext(ls)
+
+	if topics == nil {
+		slog.WarnContext(ctx, "help_search host function called but no help service configured",
+			"plugin", pluginName)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help not available"))
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	found, err := topics.Search(ctx, query, help.DefaultSearchLimit)
+	if err != nil {
+		slog.WarnContext(ctx, "holomush.help_search failed",
+			"plugin", pluginName, "error", err)
+		ls.Push(lua.LNil)
+		ls.Push(lua.LString("help search failed"))
+		return 2
+	}
+
+	ls.Push(helpTopicArray(ls, found))
+	return 1
+}
+
+func helpTopicArray(ls *lua.LState, topics []*help.Topic) *lua.LTable {
+	out := ls.NewTable()
+	for i, topic := range topics {
+		out.RawSetInt(i+1, helpTopicTable(ls, topic))
+	}
+	return out
+}
+
+func helpTopicTable(ls *lua.LState, topic *help.Topic) *lua.LTable {
+	t := ls.NewTable()
+	ls.SetField(t, "name", lua.LString(topic.Name))
+	ls.SetField(t, "title", lua.LString(topic.Title))
+	ls.SetField(t, "category", lua.LString(topic.Category))
+	return t
+}
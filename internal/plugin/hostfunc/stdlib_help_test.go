@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/help"
+	"github.com/holomush/holomush/internal/plugin/hostfunc"
+)
+
+// stubHelpTopics serves one topic by name or alias and canned list and
+// search results.
+type stubHelpTopics struct {
+	topic        *help.Topic
+	results      []*help.Topic
+	err          error
+	lastLimit    int
+	lastCategory string
+}
+
+func (s *stubHelpTopics) Topic(_ context.Context, name string) (*help.Topic, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.topic != nil && (s.topic.Name == name || slices.Contains(s.topic.Aliases, name)) {
+		return s.topic, nil
+	}
+	return nil, oops.Code("HELP_TOPIC_NOT_FOUND").Wrap(help.ErrNotFound)
+}
+
+func (s *stubHelpTopics) List(_ context.Context, category string) ([]*help.Topic, error) {
+	s.lastCategory = category
+	return s.results, s.err
+}
+
+func (s *stubHelpTopics) Search(_ context.Context, _ string, limit int) ([]*help.Topic, error) {
+	s.lastLimit = limit
+	return s.results, s.err
+}
+
+func newHelpTestState(t *testing.T, topics hostfunc.HelpTopics) *lua.LState {
+	t.Helper()
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	L.SetContext(context.Background())
+	hostfunc.New(nil, hostfunc.WithHelpTopics(topics)).Register(L, "help-plugin")
+	return L
+}
+
+func TestHelpTopicHostfuncReturnsRenderedTopic(t *testing.T) {
+	topics := &stubHelpTopics{topic: &help.Topic{
+		Name: "combat", Title: "Combat Basics", Category: "rules", Body: "Swing first.",
+		Aliases: []string{"fight"},
+	}}
+	L := newHelpTestState(t, topics)
+
+	err := L.DoString(`
+local t, errmsg = holomush.help_topic("fight")
+assert(t ~= nil, "expected topic, got err=" .. tostring(errmsg))
+assert(t.name == "combat", "name")
+assert(t.title == "Combat Basics", "title")
+assert(t.category == "rules", "category")
+assert(#t.aliases == 1 and t.aliases[1] == "fight", "aliases")
+assert(string.find(t.text, "Swing first.", 1, true), "text carries body")
+assert(string.find(t.text, "Category: rules", 1, true), "text carries footer")
+`)
+	require.NoError(t, err)
+}
+
+func TestHelpTopicHostfuncNotFound(t *testing.T) {
+	L := newHelpTestState(t, &stubHelpTopics{})
+
+	err := L.DoString(`
+local t, errmsg = holomush.help_topic("nothing")
+assert(t == nil, "expected nil")
+assert(errmsg == "not found", "got " .. tostring(errmsg))
+`)
+	require.NoError(t, err)
+}
+
+func TestHelpHostfuncsHideBackendErrors(t *testing.T) {
+	L := newHelpTestState(t, &stubHelpTopics{err: errors.New("connection refused on 10.0.0.5")})
+
+	err := L.DoString(`
+local t, errmsg = holomush.help_topic("combat")
+assert(t == nil and errmsg == "help lookup failed", "got " .. tostring(errmsg))
+local r, serr = holomush.help_search("combat")
+assert(r == nil and serr == "help search failed", "got " .. tostring(serr))
+local l, lerr = holomush.help_list()
+assert(l == nil and lerr == "help list failed", "got " .. tostring(lerr))
+`)
+	require.NoError(t, err)
+}
+
+func TestHelpSearchHostfunc(t *testing.T) {
+	topics := &stubHelpTopics{results: []*help.Topic{
+		{Name: "combat", Title: "Combat Basics", Category: "rules"},
+		{Name: "wounds", Title: "Wounds", Category: "rules"},
+	}}
+	L := newHelpTestState(t, topics)
+
+	err := L.DoString(`
+local r, errmsg = holomush.help_search("swing")
+assert(r ~= nil, "expected results, got err=" .. tostring(errmsg))
+assert(#r == 2, "result count")
+assert(r[1].name == "combat" and r[1].title == "Combat Basics" and r[1].category == "rules", "first result")
+assert(r[2].name == "wounds", "second result")
+`)
+	require.NoError(t, err)
+	assert.Equal(t, help.DefaultSearchLimit, topics.lastLimit)
+}
+
+func TestHelpListHostfunc(t *testing.T) {
+	topics := &stubHelpTopics{results: []*help.Topic{
+		{Name: "combat", Title: "Combat Basics", Category: "rules"},
+	}}
+	L := newHelpTestState(t, topics)
+
+	err := L.DoString(`
+local r, errmsg = holomush.help_list("rules")
+assert(r ~= nil, "expected results, got err=" .. tostring(errmsg))
+assert(#r == 1 and r[1].name == "combat" and r[1].category == "rules", "listed topic")
+local all = holomush.help_list()
+assert(#all == 1, "unfiltered list")
+`)
+	require.NoError(t, err)
+	assert.Equal(t, "", topics.lastCategory)
+}
+
+func TestHelpHostfuncsUnavailableWithoutService(t *testing.T) {
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	L.SetContext(context.Background())
+	hostfunc.New(nil).Register(L, "help-plugin")
+
+	err := L.DoString(`
+local t, errmsg = holomush.help_topic("combat")
+assert(t == nil and errmsg == "help not available", "topic")
+local r, serr = holomush.help_search("combat")
+assert(r == nil and serr == "help not available", "search")
+local l, lerr = holomush.help_list()
+assert(l == nil and lerr == "help not available", "list")
+`)
+	require.NoError(t, err)
+}
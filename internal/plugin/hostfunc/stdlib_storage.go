@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/samber/oops"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+)
+
+// PluginStorage is the narrow seam the holomush.storage_* hostfuncs
+// delegate to. *kvstore.Service satisfies it. The plugin argument is always
+// the calling plugin's own name, bound here and never taken from Lua, so a
+// plugin can only reach its own keys.
+type PluginStorage interface {
+	Get(ctx context.Context, plugin, key string) (kvstore.Entry, error)
+	Set(ctx context.Context, plugin, key string, value []byte) error
+	Delete(ctx context.Context, plugin, key string) error
+	List(ctx context.Context, plugin, prefix string, limit int) ([]kvstore.Entry, error)
+}
+
+// RegisterStorageFuncs adds holomush.storage_get, holomush.storage_set, and
+// holomush.storage_list to an existing holomush module table. storage may be
+// nil; calls then return (nil, "storage not available").
+func RegisterStorageFuncs(ls *lua.LState, mod *lua.LTable, pluginName string, storage PluginStorage) {
+	ls.SetField(mod, "storage_get", ls.NewFunction(func(l *lua.LState) int {
+		return storageGetImpl(l, pluginName, storage)
+	}))
+	ls.SetField(mod, "storage_set", ls.NewFunction(func(l *lua.LState) int {
+		return storageSetImpl(l, pluginName, storage)
+	}))
+	ls.SetField(mod, "storage_list", ls.NewFunction(func(l *lua.LState) int {
+		return storageListImpl(l, pluginName, storage)
+	}))
+}
+
+// storageGetImpl implements holomush.storage_get(key). Returns the stored
+// string, or (nil, nil) when the key is not set, or (nil, error_string) on
+// failure.
+func storageGetImpl(ls *lua.LState, pluginName string, storage PluginStorage) int {
+	key := ls.CheckString(1)
+	ctx := luaContext(ls)
+
+	if storage == nil {
+		return storageUnavailable(ctx, ls, "storage_get", pluginName)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	entry, err := storage.Get(ctx, pluginName, key)
+	if errors.Is(err, kvstore.ErrNotFound) {
+		ls.Push(lua.LNil)
+		ls.Push(lua.LNil) // No error, just not found
+		return 2
+	}
+	if err != nil {
+		return storageFailed(ctx, ls, "storage_get", pluginName, key, err)
+	}
+	ls.Push(lua.LString(entry.Value))
+	ls.Push(lua.LNil)
+	return 2
+}
+
+// storageSetImpl implements holomush.storage_set(key, value). value is a
+// string; nil deletes the key. Returns true, or (nil, error_string) when
+// the key or value is invalid, the write would exceed the plugin's quota,
+// or the store fails.
+func storageSetImpl(ls *lua.LState, pluginName string, storage PluginStorage) int {
+	key := ls.CheckString(1)
+	remove := ls.Get(2) == lua.LNil
+	var value string
+	if !remove {
+		value = ls.CheckString(2)
+	}
+	ctx := luaContext(ls)
+
+	if storage == nil {
+		return storageUnavailable(ctx, ls, "storage_set", pluginName)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	var err error
+	if remove {
+		err = storage.Delete(ctx, pluginName, key)
+		if errors.Is(err, kvstore.ErrNotFound) {
+			err = nil
+		}
+	} else {
+		err = storage.Set(ctx, pluginName, key, []byte(value))
+	}
+	if err != nil {
+		return storageFailed(ctx, ls, "storage_set", pluginName, key, err)
+	}
+	ls.Push(lua.LTrue)
+	return 1
+}
+
+// storageListImpl implements holomush.storage_list([prefix [, limit]]).
+// Returns an array of the plugin's keys that start with prefix, in byte
+// order, at most limit of them (default kvstore.DefaultListLimit, at most
+// kvstore.MaxListLimit).
+func storageListImpl(ls *lua.LState, pluginName string, storage PluginStorage) int {
+	prefix := ls.OptString(1, "")
+	limit := ls.OptInt(2, 0)
+	ctx := luaContext(ls)
+
+	if storage == nil {
+		return storageUnavailable(ctx, ls, "storage_list", pluginName)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultPluginQueryTimeout)
+	defer cancel()
+
+	entries, err := storage.List(ctx, pluginName, prefix, limit)
+	if err != nil {
+		return storageFailed(ctx, ls, "storage_list", pluginName, prefix, err)
+	}
+	out := ls.NewTable()
+	for i, entry := range entries {
+		out.RawSetInt(i+1, lua.LString(entry.Key))
+	}
+	ls.Push(out)
+	return 1
+}
+
+func storageUnavailable(ctx context.Context, ls *lua.LState, fn, pluginName string) int {
+	slog.WarnContext(ctx, "storage host function called but no plugin storage configured",
+		"plugin", pluginName, "function", fn)
+	ls.Push(lua.LNil)
+	ls.Push(lua.LString("storage not available"))
+	return 2
+}
+
+// storageFailed pushes (nil, error_string). Invalid keys and values and
+// quota refusals are the plugin's to fix, so their messages are returned
+// as written; anything else is logged and reported generically.
+func storageFailed(ctx context.Context, ls *lua.LState, fn, pluginName, key string, err error) int {
+	ls.Push(lua.LNil)
+	if oopsErr, ok := oops.AsOops(err); ok {
+		switch oopsErr.Code() {
+		case "KV_INVALID", "KV_QUOTA_EXCEEDED":
+			ls.Push(lua.LString(err.Error()))
+			return 2
+		}
+	}
+	slog.WarnContext(ctx, "storage host function failed",
+		"plugin", pluginName, "function", fn, "key", key, "error", err)
+	ls.Push(lua.LString("storage failed"))
+	return 2
+}
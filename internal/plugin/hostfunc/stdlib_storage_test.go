@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package hostfunc_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	lua "github.com/yuin/gopher-lua"
+
+	"github.com/holomush/holomush/internal/plugin/hostfunc"
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+)
+
+// stubPluginStorage keeps entries per plugin in memory and records the
+// plugin each call was made for.
+type stubPluginStorage struct {
+	entries   map[string]map[string]string
+	err       error
+	plugins   []string
+	lastLimit int
+}
+
+func newStubPluginStorage() *stubPluginStorage {
+	return &stubPluginStorage{entries: map[string]map[string]string{}}
+}
+
+func (s *stubPluginStorage) Get(_ context.Context, plugin, key string) (kvstore.Entry, error) {
+	s.plugins = append(s.plugins, plugin)
+	if s.err != nil {
+		return kvstore.Entry{}, s.err
+	}
+	value, ok := s.entries[plugin][key]
+	if !ok {
+		return kvstore.Entry{}, oops.Code("KV_NOT_FOUND").Wrap(kvstore.ErrNotFound)
+	}
+	return kvstore.Entry{Plugin: plugin, Key: key, Value: []byte(value)}, nil
+}
+
+func (s *stubPluginStorage) Set(_ context.Context, plugin, key string, value []byte) error {
+	s.plugins = append(s.plugins, plugin)
+	if s.err != nil {
+		return s.err
+	}
+	if s.entries[plugin] == nil {
+		s.entries[plugin] = map[string]string{}
+	}
+	s.entries[plugin][key] = string(value)
+	return nil
+}
+
+func (s *stubPluginStorage) Delete(_ context.Context, plugin, key string) error {
+	s.plugins = append(s.plugins, plugin)
+	if _, ok := s.entries[plugin][key]; !ok {
+		return oops.Code("KV_NOT_FOUND").Wrap(kvstore.ErrNotFound)
+	}
+	delete(s.entries[plugin], key)
+	return nil
+}
+
+func (s *stubPluginStorage) List(_ context.Context, plugin, prefix string, limit int) ([]kvstore.Entry, error) {
+	s.plugins = append(s.plugins, plugin)
+	s.lastLimit = limit
+	if s.err != nil {
+		return nil, s.err
+	}
+	var out []kvstore.Entry
+	for key := range s.entries[plugin] {
+		if strings.HasPrefix(key, prefix) {
+			out = append(out, kvstore.Entry{Plugin: plugin, Key: key})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out, nil
+}
+
+func newStorageTestState(t *testing.T, storage hostfunc.PluginStorage) *lua.LState {
+	t.Helper()
+	L := lua.NewState()
+	t.Cleanup(L.Close)
+	L.SetContext(context.Background())
+	hostfunc.New(nil, hostfunc.WithPluginStorage(storage)).Register(L, "shop")
+	return L
+}
+
+func TestStorageHostfuncsRoundTrip(t *testing.T) {
+	storage := newStubPluginStorage()
+	L := newStorageTestState(t, storage)
+
+	err := L.DoString(`
+local ok, errmsg = holomush.storage_set("stock:sword", "3")
+assert(ok == true, "set: " .. tostring(errmsg))
+assert(holomush.storage_set("stock:axe", "1"))
+assert(holomush.storage_set("owner", "01CHAR"))
+
+local v, gerr = holomush.storage_get("stock:sword")
+assert(v == "3" and gerr == nil, "get")
+
+local missing, merr = holomush.storage_get("stock:bow")
+assert(missing == nil and merr == nil, "an unset key is nil without an error")
+
+local keys = holomush.storage_list("stock:")
+assert(#keys == 2 and keys[1] == "stock:axe" and keys[2] == "stock:sword", "list by prefix")
+
+assert(holomush.storage_set("stock:axe", nil))
+assert(holomush.storage_get("stock:axe") == nil, "nil deletes")
+assert(holomush.storage_set("stock:axe", nil), "deleting an unset key succeeds")
+`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"stock:sword": "3", "owner": "01CHAR"}, storage.entries["shop"])
+	for _, plugin := range storage.plugins {
+		assert.Equal(t, "shop", plugin, "every call is scoped to the calling plugin")
+	}
+}
+
+func TestStorageListPassesLimit(t *testing.T) {
+	storage := newStubPluginStorage()
+	L := newStorageTestState(t, storage)
+
+	require.NoError(t, L.DoString(`assert(#holomush.storage_list() == 0)`))
+	assert.Zero(t, storage.lastLimit, "no limit leaves the default to the store")
+	require.NoError(t, L.DoString(`holomush.storage_list("", 5)`))
+	assert.Equal(t, 5, storage.lastLimit)
+}
+
+func TestStorageSetReportsQuotaAndInvalidKeys(t *testing.T) {
+	storage := newStubPluginStorage()
+	L := newStorageTestState(t, storage)
+
+	storage.err = oops.Code("KV_QUOTA_EXCEEDED").Wrapf(kvstore.ErrQuotaExceeded, "limit of 1000 keys reached")
+	require.NoError(t, L.DoString(`
+local ok, errmsg = holomush.storage_set("k", "v")
+assert(ok == nil, "refused")
+assert(errmsg == "limit of 1000 keys reached: storage quota exceeded", errmsg)
+`))
+
+	storage.err = oops.Code("KV_INVALID").Errorf("key is required")
+	require.NoError(t, L.DoString(`
+local _, errmsg = holomush.storage_set("", "v")
+assert(errmsg == "key is required", errmsg)
+`))
+}
+
+func TestStorageHidesStoreFailures(t *testing.T) {
+	storage := newStubPluginStorage()
+	storage.err = oops.Code("KV_STORE_FAILED").Wrap(errors.New("connection refused to 10.0.0.5"))
+	L := newStorageTestState(t, storage)
+
+	require.NoError(t, L.DoString(`
+local v, errmsg = holomush.storage_get("k")
+assert(v == nil and errmsg == "storage failed", tostring(errmsg))
+local keys, lerr = holomush.storage_list()
+assert(keys == nil and lerr == "storage failed", tostring(lerr))
+`))
+}
+
+func TestStorageWithoutStoreReportsUnavailable(t *testing.T) {
+	L := newStorageTestState(t, nil)
+
+	require.NoError(t, L.DoString(`
+local v, errmsg = holomush.storage_get("k")
+assert(v == nil and errmsg == "storage not available", tostring(errmsg))
+local ok, serr = holomush.storage_set("k", "v")
+assert(ok == nil and serr == "storage not available", tostring(serr))
+`))
+}
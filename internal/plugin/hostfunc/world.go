@@ -89,8 +89,12 @@ func (f *Functions) queryCharacterFn(pluginName string) lua.LGFunction {
 // queryLocationCharactersFn returns a Lua function that queries characters at a location.
 // Lua signature: query_location_characters(location_id, [opts])
 // opts is an optional table with:
-//   - limit: max results (default: 100)
-//   - offset: number of results to skip (default: 0)
+//   - limit: max results (default: 100, capped at 1000)
+//   - cursor: next_cursor from the previous call; omit for the first page
+//
+// When more characters follow, the returned list carries a next_cursor
+// field to pass back as opts.cursor. The offset option was removed: an
+// offset shifts under concurrent movement, a cursor does not.
 func (f *Functions) queryLocationCharactersFn(pluginName string) lua.LGFunction {
 	return func(L *lua.LState) int {
 		if f.worldMutator == nil {
@@ -110,13 +114,16 @@ func (f *Functions) queryLocationCharactersFn(pluginName string) lua.LGFunction
 			if limitVal := optsTable.RawGetString("limit"); limitVal.Type() == lua.LTNumber {
 				opts.Limit = int(lua.LVAsNumber(limitVal))
 			}
-			if offsetVal := optsTable.RawGetString("offset"); offsetVal.Type() == lua.LTNumber {
-				opts.Offset = int(lua.LVAsNumber(offsetVal))
+			if cursorVal := optsTable.RawGetString("cursor"); cursorVal.Type() == lua.LTString {
+				opts.Cursor = cursorVal.String()
+			}
+			if optsTable.RawGetString("offset") != lua.LNil {
+				return pushError(L, "offset is no longer supported; pass next_cursor as opts.cursor")
 			}
 		}
 
 		return f.withQueryContext(L, pluginName, func(ctx context.Context, adapter *WorldQuerierAdapter) int {
-			chars, err := adapter.GetCharactersByLocation(ctx, id, opts)
+			page, err := adapter.GetCharactersByLocation(ctx, id, opts)
 			if err != nil {
 				if errors.Is(err, world.ErrNotFound) {
 					slog.Debug("query_location_characters: location not found",
@@ -130,12 +137,15 @@ func (f *Functions) queryLocationCharactersFn(pluginName string) lua.LGFunction
 			// For full character details (player_id, description, location_id),
 			// use query_character on individual character IDs.
 			characters := L.NewTable()
-			for i, char := range chars {
+			for i, char := range page.Items {
 				c := L.NewTable()
 				L.SetField(c, "id", lua.LString(char.ID.String()))
 				L.SetField(c, "name", lua.LString(char.Name))
 				characters.RawSetInt(i+1, c)
 			}
+			if page.NextCursor != "" {
+				L.SetField(characters, "next_cursor", lua.LString(page.NextCursor))
+			}
 
 			return pushSuccess(L, characters)
 		})
@@ -25,6 +25,8 @@ type mockWorldQuerier struct {
 	location   *world.Location
 	character  *world.Character
 	characters []*world.Character
+	nextCursor string
+	listOpts   world.ListOptions
 	object     *world.Object
 	err        error
 }
@@ -44,11 +46,12 @@ func (m *mockWorldQuerier) GetCharacter(_ context.Context, _ string, _ ulid.ULID
 	return m.character, nil
 }
 
-func (m *mockWorldQuerier) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
+func (m *mockWorldQuerier) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	m.listOpts = opts
 	if m.err != nil {
-		return nil, m.err
+		return world.Page[*world.Character]{}, m.err
 	}
-	return m.characters, nil
+	return world.Page[*world.Character]{Items: m.characters, NextCursor: m.nextCursor}, nil
 }
 
 func (m *mockWorldQuerier) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -262,6 +265,45 @@ func TestQueryLocationCharactersEmptyLocation(t *testing.T) {
 	assert.Equal(t, 0, tbl.Len(), "expected empty table")
 }
 
+func TestQueryLocationCharactersPagesWithCursor(t *testing.T) {
+	locationID := ulid.Make()
+	querier := &mockWorldQuerier{
+		characters: []*world.Character{{ID: ulid.Make(), Name: "Alice"}},
+		nextCursor: "next-page",
+	}
+	L := newWorldTestLuaState(t, querier)
+
+	err := L.DoString(`characters, err = holomush.query_location_characters("` + locationID.String() + `", {limit = 1, cursor = "prev-page"})`)
+	require.NoError(t, err)
+	assert.Equal(t, lua.LTNil, L.GetGlobal("err").Type())
+	assert.Equal(t, world.ListOptions{Limit: 1, Cursor: "prev-page"}, querier.listOpts)
+
+	tbl, ok := L.GetGlobal("characters").(*lua.LTable)
+	require.True(t, ok, "expected table result")
+	assert.Equal(t, 1, tbl.Len())
+	assert.Equal(t, "next-page", tbl.RawGetString("next_cursor").String())
+}
+
+func TestQueryLocationCharactersRejectsOffset(t *testing.T) {
+	locationID := ulid.Make()
+	L := newWorldTestLuaState(t, &mockWorldQuerier{})
+
+	err := L.DoString(`characters, err = holomush.query_location_characters("` + locationID.String() + `", {offset = 10})`)
+	require.NoError(t, err)
+	assert.Equal(t, lua.LTNil, L.GetGlobal("characters").Type())
+	assert.Contains(t, L.GetGlobal("err").String(), "cursor")
+}
+
+func TestQueryLocationCharactersInvalidCursor(t *testing.T) {
+	locationID := ulid.Make()
+	_, _, cursorErr := world.DecodeCursor("garbage")
+	L := newWorldTestLuaState(t, &mockWorldQuerier{err: cursorErr})
+
+	err := L.DoString(`characters, err = holomush.query_location_characters("` + locationID.String() + `", {cursor = "garbage"})`)
+	require.NoError(t, err)
+	assert.Equal(t, "invalid cursor", L.GetGlobal("err").String())
+}
+
 func TestQueryObject(t *testing.T) {
 	objID := ulid.Make()
 	locID := ulid.Make()
@@ -542,7 +584,7 @@ func (m *contextAwareWorldQuerier) GetCharacter(ctx context.Context, _ string, _
 	return &world.Character{ID: ulid.Make(), Name: "Test"}, nil
 }
 
-func (m *contextAwareWorldQuerier) GetCharactersByLocation(ctx context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
+func (m *contextAwareWorldQuerier) GetCharactersByLocation(ctx context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
 	if m.ctxChan != nil {
 		select {
 		case m.ctxChan <- ctx:
@@ -550,9 +592,9 @@ func (m *contextAwareWorldQuerier) GetCharactersByLocation(ctx context.Context,
 		}
 	}
 	if m.err != nil {
-		return nil, m.err
+		return world.Page[*world.Character]{}, m.err
 	}
-	return []*world.Character{}, nil
+	return world.Page[*world.Character]{Items: []*world.Character{}}, nil
 }
 
 func (m *contextAwareWorldQuerier) GetObject(ctx context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -50,11 +50,11 @@ func (m *mockWorldMutatorService) GetCharacter(_ context.Context, _ string, _ ul
 	return m.character, nil
 }
 
-func (m *mockWorldMutatorService) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) ([]*world.Character, error) {
+func (m *mockWorldMutatorService) GetCharactersByLocation(_ context.Context, _ string, _ ulid.ULID, _ world.ListOptions) (world.Page[*world.Character], error) {
 	if m.err != nil {
-		return nil, m.err
+		return world.Page[*world.Character]{}, m.err
 	}
-	return m.characters, nil
+	return world.Page[*world.Character]{Items: m.characters}, nil
 }
 
 func (m *mockWorldMutatorService) GetObject(_ context.Context, _ string, _ ulid.ULID) (*world.Object, error) {
@@ -880,12 +880,12 @@ func (m *mockWorldServiceWithExpectations) GetCharacter(ctx context.Context, sub
 	return args.Get(0).(*world.Character), args.Error(1)
 }
 
-func (m *mockWorldServiceWithExpectations) GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error) {
+func (m *mockWorldServiceWithExpectations) GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
 	args := m.Called(ctx, subjectID, locationID, opts)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return world.Page[*world.Character]{}, args.Error(1)
 	}
-	return args.Get(0).([]*world.Character), args.Error(1)
+	return world.Page[*world.Character]{Items: args.Get(0).([]*world.Character)}, args.Error(1)
 }
 
 func (m *mockWorldServiceWithExpectations) GetObject(ctx context.Context, subjectID string, id ulid.ULID) (*world.Object, error) {
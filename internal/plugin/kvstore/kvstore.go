@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package kvstore gives plugins a small persistent key-value store of their
+// own, so stateful plugins such as shops and quests keep their data out of
+// world properties.
+//
+// Every plugin has its own namespace, keyed by the plugin's name as the host
+// knows it; a plugin never names the namespace it reads or writes, so it
+// cannot reach another plugin's keys. Each namespace is held to a Quota on
+// key count and total size. Lua plugins use it through
+// holomush.storage_get, holomush.storage_set, and holomush.storage_list;
+// staff inspect and clear it with the pluginstore command.
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// Key and value limits.
+const (
+	// MaxKeyLength bounds a key, in bytes.
+	MaxKeyLength = 200
+	// MaxValueBytes bounds one value.
+	MaxValueBytes = 64 << 10
+)
+
+// Default quota, applied by NewService to zero-valued Config fields.
+const (
+	// DefaultMaxKeys is the number of keys a plugin may hold.
+	DefaultMaxKeys = 1000
+	// DefaultMaxBytes is the total size of a plugin's keys and values.
+	DefaultMaxBytes = 1 << 20
+)
+
+const (
+	// DefaultListLimit is the number of entries List returns when no limit
+	// is given.
+	DefaultListLimit = 100
+	// MaxListLimit bounds the limit given to List.
+	MaxListLimit = 1000
+)
+
+// ErrNotFound is wrapped by errors for a key that does not exist.
+var ErrNotFound = errors.New("key not found")
+
+// ErrQuotaExceeded is wrapped by errors for a write that would take a
+// plugin past its quota.
+var ErrQuotaExceeded = errors.New("storage quota exceeded")
+
+// Entry is one stored key.
+type Entry struct {
+	Plugin    string
+	Key       string
+	Value     []byte
+	UpdatedAt time.Time
+}
+
+// Size returns the bytes the entry counts against its plugin's quota: its
+// key and its value.
+func (e Entry) Size() int {
+	return len(e.Key) + len(e.Value)
+}
+
+// Quota bounds one plugin's namespace.
+type Quota struct {
+	MaxKeys  int
+	MaxBytes int
+}
+
+// Usage is how much of its quota one plugin uses.
+type Usage struct {
+	Plugin string
+	Keys   int
+	Bytes  int
+}
+
+// Store persists plugin entries. *PostgresStore satisfies it.
+type Store interface {
+	// Get returns plugin's entry for key. Returns KV_NOT_FOUND wrapping
+	// ErrNotFound when absent.
+	Get(ctx context.Context, plugin, key string) (Entry, error)
+	// Put creates or replaces entry, unless the plugin's usage afterwards
+	// would exceed quota; then it returns KV_QUOTA_EXCEEDED wrapping
+	// ErrQuotaExceeded and stores nothing. Concurrent puts for one plugin
+	// are serialized so they cannot overrun the quota together.
+	Put(ctx context.Context, entry Entry, quota Quota) error
+	// Delete removes plugin's entry for key. Returns KV_NOT_FOUND wrapping
+	// ErrNotFound when absent.
+	Delete(ctx context.Context, plugin, key string) error
+	// List returns up to limit of plugin's entries whose keys start with
+	// prefix, by key.
+	List(ctx context.Context, plugin, prefix string, limit int) ([]Entry, error)
+	// Usage returns plugin's usage; a plugin with no entries uses nothing.
+	Usage(ctx context.Context, plugin string) (Usage, error)
+	// AllUsage returns the usage of every plugin with entries, by plugin.
+	AllUsage(ctx context.Context) ([]Usage, error)
+	// Purge deletes every entry of plugin and returns how many.
+	Purge(ctx context.Context, plugin string) (int, error)
+}
+
+// Check returns KV_QUOTA_EXCEEDED when a write that takes a plugin from
+// before to after leaves it over q. A write that does not grow the plugin's
+// usage is always allowed, so a plugin over a lowered quota can still
+// shrink or delete its entries. Store implementations call it from Put.
+func (q Quota) Check(before, after Usage) error {
+	if after.Keys > q.MaxKeys && after.Keys > before.Keys {
+		return oops.Code("KV_QUOTA_EXCEEDED").
+			With("plugin", after.Plugin).
+			With("max_keys", q.MaxKeys).
+			Wrapf(ErrQuotaExceeded, "limit of %d keys reached", q.MaxKeys)
+	}
+	if after.Bytes > q.MaxBytes && after.Bytes > before.Bytes {
+		return oops.Code("KV_QUOTA_EXCEEDED").
+			With("plugin", after.Plugin).
+			With("max_bytes", q.MaxBytes).
+			With("bytes", after.Bytes).
+			Wrapf(ErrQuotaExceeded, "limit of %d bytes reached", q.MaxBytes)
+	}
+	return nil
+}
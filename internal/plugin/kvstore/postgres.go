@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package kvstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const entryColumns = `plugin, key, value, updated_at`
+
+// PostgresStore implements Store against the plugin_kv table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Get returns plugin's entry for key.
+func (s *PostgresStore) Get(ctx context.Context, plugin, key string) (Entry, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+entryColumns+` FROM plugin_kv WHERE plugin = $1 AND key = $2`, plugin, key)
+	entry, err := scanEntry(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Entry{}, errNotFound(plugin, key)
+	}
+	if err != nil {
+		return Entry{}, storeFailed("get", plugin, err)
+	}
+	return entry, nil
+}
+
+// Put creates or replaces entry within quota. The plugin's usage is read
+// and the entry written under a per-plugin transaction-scoped advisory
+// lock, so two concurrent puts cannot both fit the last of the quota.
+func (s *PostgresStore) Put(ctx context.Context, entry Entry, quota Quota) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return storeFailed("put", entry.Plugin, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('plugin_kv'), hashtext($1))`, entry.Plugin); err != nil {
+		return storeFailed("put", entry.Plugin, err)
+	}
+
+	// others is the plugin's usage without key; existing is key's current
+	// size, or -1 when key is new.
+	var others Usage
+	existing := -1
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FILTER (WHERE key <> $2),
+		       COALESCE(SUM(octet_length(key) + octet_length(value)) FILTER (WHERE key <> $2), 0),
+		       COALESCE(MAX(octet_length(key) + octet_length(value)) FILTER (WHERE key = $2), -1)
+		  FROM plugin_kv
+		 WHERE plugin = $1
+	`, entry.Plugin, entry.Key).Scan(&others.Keys, &others.Bytes, &existing)
+	if err != nil {
+		return storeFailed("put", entry.Plugin, err)
+	}
+
+	before := Usage{Plugin: entry.Plugin, Keys: others.Keys, Bytes: others.Bytes}
+	if existing >= 0 {
+		before.Keys++
+		before.Bytes += existing
+	}
+	after := Usage{Plugin: entry.Plugin, Keys: others.Keys + 1, Bytes: others.Bytes + entry.Size()}
+	if err := quota.Check(before, after); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO plugin_kv (`+entryColumns+`)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (plugin, key) DO UPDATE
+		   SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at
+	`, entry.Plugin, entry.Key, entry.Value, pgnanos.From(entry.UpdatedAt))
+	if err != nil {
+		return storeFailed("put", entry.Plugin, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return storeFailed("put", entry.Plugin, err)
+	}
+	return nil
+}
+
+// Delete removes plugin's entry for key.
+func (s *PostgresStore) Delete(ctx context.Context, plugin, key string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM plugin_kv WHERE plugin = $1 AND key = $2`, plugin, key)
+	if err != nil {
+		return storeFailed("delete", plugin, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errNotFound(plugin, key)
+	}
+	return nil
+}
+
+// List returns up to limit of plugin's entries under prefix, by key. Keys
+// sort bytewise so the order does not depend on the database collation.
+func (s *PostgresStore) List(ctx context.Context, plugin, prefix string, limit int) ([]Entry, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+entryColumns+`
+		  FROM plugin_kv
+		 WHERE plugin = $1 AND starts_with(key, $2)
+		 ORDER BY key COLLATE "C"
+		 LIMIT $3
+	`, plugin, prefix, limit)
+	if err != nil {
+		return nil, storeFailed("list", plugin, err)
+	}
+	defer rows.Close()
+
+	var entries []Entry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, storeFailed("list", plugin, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storeFailed("list", plugin, err)
+	}
+	return entries, nil
+}
+
+// Usage returns plugin's usage.
+func (s *PostgresStore) Usage(ctx context.Context, plugin string) (Usage, error) {
+	usage := Usage{Plugin: plugin}
+	err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*), COALESCE(SUM(octet_length(key) + octet_length(value)), 0)
+		  FROM plugin_kv
+		 WHERE plugin = $1
+	`, plugin).Scan(&usage.Keys, &usage.Bytes)
+	if err != nil {
+		return Usage{}, storeFailed("usage", plugin, err)
+	}
+	return usage, nil
+}
+
+// AllUsage returns the usage of every plugin with entries, by plugin.
+func (s *PostgresStore) AllUsage(ctx context.Context) ([]Usage, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT plugin, COUNT(*), SUM(octet_length(key) + octet_length(value))
+		  FROM plugin_kv
+		 GROUP BY plugin
+		 ORDER BY plugin
+	`)
+	if err != nil {
+		return nil, storeFailed("usage", "", err)
+	}
+	defer rows.Close()
+
+	var usages []Usage
+	for rows.Next() {
+		var usage Usage
+		if err := rows.Scan(&usage.Plugin, &usage.Keys, &usage.Bytes); err != nil {
+			return nil, storeFailed("usage", "", err)
+		}
+		usages = append(usages, usage)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, storeFailed("usage", "", err)
+	}
+	return usages, nil
+}
+
+// Purge deletes every entry of plugin.
+func (s *PostgresStore) Purge(ctx context.Context, plugin string) (int, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM plugin_kv WHERE plugin = $1`, plugin)
+	if err != nil {
+		return 0, storeFailed("purge", plugin, err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func scanEntry(row pgx.Row) (Entry, error) {
+	var (
+		entry     Entry
+		updatedAt pgnanos.Time
+	)
+	if err := row.Scan(&entry.Plugin, &entry.Key, &entry.Value, &updatedAt); err != nil {
+		return Entry{}, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	entry.UpdatedAt = updatedAt.Time()
+	return entry, nil
+}
+
+func storeFailed(operation, plugin string, err error) error {
+	return oops.Code("KV_STORE_FAILED").
+		With("operation", operation).
+		With("plugin", plugin).
+		Wrap(err)
+}
+
+func errNotFound(plugin, key string) error {
+	return oops.Code("KV_NOT_FOUND").
+		With("plugin", plugin).
+		With("key", key).
+		Wrapf(ErrNotFound, "%s has no key %q", plugin, key)
+}
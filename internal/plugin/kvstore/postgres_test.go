@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package kvstore_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestPostgresStoreRoundTripsEntry(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := kvstore.NewPostgresStore(pool)
+	quota := kvstore.Quota{MaxKeys: 10, MaxBytes: 1000}
+
+	want := kvstore.Entry{Plugin: "roundtrip", Key: "stock:sword", Value: []byte{0, 1, 2, 0xff}, UpdatedAt: time.Now()}
+	require.NoError(t, st.Put(ctx, want, quota))
+	got, err := st.Get(ctx, "roundtrip", "stock:sword")
+	require.NoError(t, err)
+	assert.Equal(t, want.Value, got.Value)
+	assert.True(t, want.UpdatedAt.Equal(got.UpdatedAt))
+
+	want.Value = []byte("replaced")
+	require.NoError(t, st.Put(ctx, want, quota))
+	got, err = st.Get(ctx, "roundtrip", "stock:sword")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("replaced"), got.Value)
+
+	_, err = st.Get(ctx, "roundtrip-other", "stock:sword")
+	errutil.AssertErrorCode(t, err, "KV_NOT_FOUND")
+
+	require.NoError(t, st.Delete(ctx, "roundtrip", "stock:sword"))
+	errutil.AssertErrorCode(t, st.Delete(ctx, "roundtrip", "stock:sword"), "KV_NOT_FOUND")
+}
+
+func TestPostgresStorePutEnforcesQuota(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := kvstore.NewPostgresStore(pool)
+	quota := kvstore.Quota{MaxKeys: 2, MaxBytes: 20}
+	put := func(key, value string) error {
+		return st.Put(ctx, kvstore.Entry{Plugin: "quota", Key: key, Value: []byte(value), UpdatedAt: time.Now()}, quota)
+	}
+
+	require.NoError(t, put("a", "123456789"))
+	require.NoError(t, put("b", "1"))
+	errutil.AssertErrorCode(t, put("c", ""), "KV_QUOTA_EXCEEDED")
+	errutil.AssertErrorCode(t, put("b", "1234567890"), "KV_QUOTA_EXCEEDED")
+	require.NoError(t, put("b", "123456789"), "a replacement within the byte quota is allowed")
+
+	usage, err := st.Usage(ctx, "quota")
+	require.NoError(t, err)
+	assert.Equal(t, kvstore.Usage{Plugin: "quota", Keys: 2, Bytes: 20}, usage)
+}
+
+func TestPostgresStorePutSerializesConcurrentWrites(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := kvstore.NewPostgresStore(pool)
+	quota := kvstore.Quota{MaxKeys: 5, MaxBytes: 1000}
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = st.Put(ctx, kvstore.Entry{Plugin: "concurrent", Key: fmt.Sprintf("k%02d", i), Value: []byte("v"), UpdatedAt: time.Now()}, quota)
+		}()
+	}
+	wg.Wait()
+
+	usage, err := st.Usage(ctx, "concurrent")
+	require.NoError(t, err)
+	assert.Equal(t, 5, usage.Keys, "concurrent writers cannot overrun the quota together")
+}
+
+func TestPostgresStoreListByPrefixInKeyOrder(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := kvstore.NewPostgresStore(pool)
+	quota := kvstore.Quota{MaxKeys: 10, MaxBytes: 1000}
+	for _, key := range []string{"quest:b", "quest:a", "Quest:c", "shop:a", "quest%"} {
+		require.NoError(t, st.Put(ctx, kvstore.Entry{Plugin: "list", Key: key, Value: []byte("v"), UpdatedAt: time.Now()}, quota))
+	}
+
+	got, err := st.List(ctx, "list", "quest:", 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "quest:a", got[0].Key)
+	assert.Equal(t, "quest:b", got[1].Key)
+
+	got, err = st.List(ctx, "list", "", 3)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, "Quest:c", got[0].Key, "keys sort bytewise")
+}
+
+func TestPostgresStoreAllUsageAndPurge(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := kvstore.NewPostgresStore(pool)
+	quota := kvstore.Quota{MaxKeys: 10, MaxBytes: 1000}
+	require.NoError(t, st.Put(ctx, kvstore.Entry{Plugin: "usage-a", Key: "k1", Value: []byte("abc"), UpdatedAt: time.Now()}, quota))
+	require.NoError(t, st.Put(ctx, kvstore.Entry{Plugin: "usage-a", Key: "k2", Value: []byte("de"), UpdatedAt: time.Now()}, quota))
+	require.NoError(t, st.Put(ctx, kvstore.Entry{Plugin: "usage-b", Key: "k", Value: []byte{}, UpdatedAt: time.Now()}, quota))
+
+	usages, err := st.AllUsage(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, usages, kvstore.Usage{Plugin: "usage-a", Keys: 2, Bytes: 9})
+	assert.Contains(t, usages, kvstore.Usage{Plugin: "usage-b", Keys: 1, Bytes: 1})
+
+	n, err := st.Purge(ctx, "usage-a")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	_, err = st.Get(ctx, "usage-b", "k")
+	require.NoError(t, err)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package kvstore
+
+import (
+	"context"
+	"log/slog"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/samber/oops"
+)
+
+// Config configures a Service.
+type Config struct {
+	MaxKeys  int              // keys each plugin may hold (default: DefaultMaxKeys)
+	MaxBytes int              // total key and value bytes per plugin (default: DefaultMaxBytes)
+	Now      func() time.Time // clock override for tests (default: time.Now)
+}
+
+// Service validates plugin reads and writes and holds each plugin to its
+// quota. It serves both the plugin host functions, which always pass the
+// calling plugin's own name, and the staff pluginstore command.
+type Service struct {
+	config Config
+	store  Store
+}
+
+// NewService creates a Service over store. Panics on a nil store.
+func NewService(config Config, store Store) *Service {
+	if store == nil {
+		panic("kvstore.NewService: nil Store")
+	}
+	if config.MaxKeys <= 0 {
+		config.MaxKeys = DefaultMaxKeys
+	}
+	if config.MaxBytes <= 0 {
+		config.MaxBytes = DefaultMaxBytes
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Service{config: config, store: store}
+}
+
+// Quota returns the quota every plugin is held to.
+func (s *Service) Quota() Quota {
+	return Quota{MaxKeys: s.config.MaxKeys, MaxBytes: s.config.MaxBytes}
+}
+
+// Get returns plugin's entry for key. Returns KV_NOT_FOUND wrapping
+// ErrNotFound when absent.
+func (s *Service) Get(ctx context.Context, plugin, key string) (Entry, error) {
+	if err := validate(plugin, key); err != nil {
+		return Entry{}, err
+	}
+	return s.store.Get(ctx, plugin, key)
+}
+
+// Set stores value under plugin's key, replacing any previous value.
+// Returns KV_INVALID for a bad key or an oversized value and
+// KV_QUOTA_EXCEEDED (wrapping ErrQuotaExceeded) when the write would take
+// the plugin past its quota.
+func (s *Service) Set(ctx context.Context, plugin, key string, value []byte) error {
+	if err := validate(plugin, key); err != nil {
+		return err
+	}
+	if len(value) > MaxValueBytes {
+		return oops.Code("KV_INVALID").
+			With("plugin", plugin).
+			With("key", key).
+			Errorf("value is %d bytes; the limit is %d", len(value), MaxValueBytes)
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	entry := Entry{Plugin: plugin, Key: key, Value: value, UpdatedAt: s.config.Now()}
+	if err := s.store.Put(ctx, entry, s.Quota()); err != nil {
+		if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "KV_QUOTA_EXCEEDED" {
+			slog.WarnContext(ctx, "kvstore: plugin write refused over quota",
+				"plugin", plugin, "key", key, "error", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete removes plugin's entry for key. Returns KV_NOT_FOUND wrapping
+// ErrNotFound when absent.
+func (s *Service) Delete(ctx context.Context, plugin, key string) error {
+	if err := validate(plugin, key); err != nil {
+		return err
+	}
+	return s.store.Delete(ctx, plugin, key)
+}
+
+// List returns up to limit of plugin's entries whose keys start with
+// prefix, by key. A limit <= 0 uses DefaultListLimit; limits above
+// MaxListLimit are capped.
+func (s *Service) List(ctx context.Context, plugin, prefix string, limit int) ([]Entry, error) {
+	if plugin == "" {
+		return nil, errPluginRequired()
+	}
+	switch {
+	case limit <= 0:
+		limit = DefaultListLimit
+	case limit > MaxListLimit:
+		limit = MaxListLimit
+	}
+	return s.store.List(ctx, plugin, prefix, limit)
+}
+
+// Usage returns how much of its quota plugin uses.
+func (s *Service) Usage(ctx context.Context, plugin string) (Usage, error) {
+	if plugin == "" {
+		return Usage{}, errPluginRequired()
+	}
+	return s.store.Usage(ctx, plugin)
+}
+
+// AllUsage returns the usage of every plugin that has stored anything.
+func (s *Service) AllUsage(ctx context.Context) ([]Usage, error) {
+	return s.store.AllUsage(ctx)
+}
+
+// Purge deletes every entry of plugin and returns how many.
+func (s *Service) Purge(ctx context.Context, plugin string) (int, error) {
+	if plugin == "" {
+		return 0, errPluginRequired()
+	}
+	n, err := s.store.Purge(ctx, plugin)
+	if err != nil {
+		return 0, err
+	}
+	slog.InfoContext(ctx, "kvstore: purged", "plugin", plugin, "count", n)
+	return n, nil
+}
+
+// validate checks the plugin name and key of a single-key operation. Keys
+// are non-empty UTF-8 of at most MaxKeyLength bytes without control
+// characters, so they list and display cleanly.
+func validate(plugin, key string) error {
+	if plugin == "" {
+		return errPluginRequired()
+	}
+	invalid := oops.Code("KV_INVALID").With("plugin", plugin)
+	switch {
+	case key == "":
+		return invalid.Errorf("key is required")
+	case len(key) > MaxKeyLength:
+		return invalid.Errorf("key is %d bytes; the limit is %d", len(key), MaxKeyLength)
+	case !utf8.ValidString(key):
+		return invalid.Errorf("key must be valid UTF-8")
+	}
+	for _, r := range key {
+		if unicode.IsControl(r) {
+			return invalid.With("key", key).Errorf("key must not contain control characters")
+		}
+	}
+	return nil
+}
+
+func errPluginRequired() error {
+	return oops.Code("KV_INVALID").Errorf("plugin name is required")
+}
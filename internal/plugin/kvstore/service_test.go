@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package kvstore_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/plugin/kvstore"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memStore is an in-memory kvstore.Store.
+type memStore struct {
+	mu      sync.Mutex
+	entries map[string]map[string]kvstore.Entry
+	limit   int // last List limit
+}
+
+func newMemStore() *memStore {
+	return &memStore{entries: make(map[string]map[string]kvstore.Entry)}
+}
+
+func (m *memStore) Get(_ context.Context, plugin, key string) (kvstore.Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[plugin][key]
+	if !ok {
+		return kvstore.Entry{}, oops.Code("KV_NOT_FOUND").Wrap(kvstore.ErrNotFound)
+	}
+	return entry, nil
+}
+
+func (m *memStore) Put(_ context.Context, entry kvstore.Entry, quota kvstore.Quota) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	before := m.usage(entry.Plugin)
+	after := before
+	if old, ok := m.entries[entry.Plugin][entry.Key]; ok {
+		after.Bytes -= old.Size()
+	} else {
+		after.Keys++
+	}
+	after.Bytes += entry.Size()
+	if err := quota.Check(before, after); err != nil {
+		return err
+	}
+	if m.entries[entry.Plugin] == nil {
+		m.entries[entry.Plugin] = make(map[string]kvstore.Entry)
+	}
+	m.entries[entry.Plugin][entry.Key] = entry
+	return nil
+}
+
+func (m *memStore) Delete(_ context.Context, plugin, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[plugin][key]; !ok {
+		return oops.Code("KV_NOT_FOUND").Wrap(kvstore.ErrNotFound)
+	}
+	delete(m.entries[plugin], key)
+	return nil
+}
+
+func (m *memStore) List(_ context.Context, plugin, prefix string, limit int) ([]kvstore.Entry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.limit = limit
+	var out []kvstore.Entry
+	for key, entry := range m.entries[plugin] {
+		if strings.HasPrefix(key, prefix) {
+			out = append(out, entry)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+func (m *memStore) Usage(_ context.Context, plugin string) (kvstore.Usage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.usage(plugin), nil
+}
+
+func (m *memStore) usage(plugin string) kvstore.Usage {
+	usage := kvstore.Usage{Plugin: plugin}
+	for _, entry := range m.entries[plugin] {
+		usage.Keys++
+		usage.Bytes += entry.Size()
+	}
+	return usage
+}
+
+func (m *memStore) AllUsage(_ context.Context) ([]kvstore.Usage, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []kvstore.Usage
+	for plugin, entries := range m.entries {
+		if len(entries) > 0 {
+			out = append(out, m.usage(plugin))
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Plugin < out[j].Plugin })
+	return out, nil
+}
+
+func (m *memStore) Purge(_ context.Context, plugin string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := len(m.entries[plugin])
+	delete(m.entries, plugin)
+	return n, nil
+}
+
+var testNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func newTestService(config kvstore.Config) (*kvstore.Service, *memStore) {
+	store := newMemStore()
+	config.Now = func() time.Time { return testNow }
+	return kvstore.NewService(config, store), store
+}
+
+func TestServiceSetAndGet(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(kvstore.Config{})
+
+	require.NoError(t, svc.Set(ctx, "shop", "stock:sword", []byte("3")))
+	entry, err := svc.Get(ctx, "shop", "stock:sword")
+	require.NoError(t, err)
+	assert.Equal(t, kvstore.Entry{Plugin: "shop", Key: "stock:sword", Value: []byte("3"), UpdatedAt: testNow}, entry)
+
+	require.NoError(t, svc.Set(ctx, "shop", "stock:sword", []byte("2")))
+	entry, err = svc.Get(ctx, "shop", "stock:sword")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), entry.Value)
+
+	_, err = svc.Get(ctx, "quests", "stock:sword")
+	assert.ErrorIs(t, err, kvstore.ErrNotFound, "plugins do not see each other's keys")
+	errutil.AssertErrorCode(t, err, "KV_NOT_FOUND")
+}
+
+func TestServiceSetStoresEmptyValues(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(kvstore.Config{})
+
+	require.NoError(t, svc.Set(ctx, "shop", "flag", nil))
+	entry, err := svc.Get(ctx, "shop", "flag")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, entry.Value)
+}
+
+func TestServiceRejectsInvalidKeysAndValues(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(kvstore.Config{})
+
+	tests := []struct {
+		name   string
+		plugin string
+		key    string
+		value  []byte
+	}{
+		{"no plugin", "", "k", nil},
+		{"empty key", "shop", "", nil},
+		{"long key", "shop", strings.Repeat("k", kvstore.MaxKeyLength+1), nil},
+		{"invalid UTF-8", "shop", "k\xff", nil},
+		{"control character", "shop", "a\nb", nil},
+		{"oversized value", "shop", "k", make([]byte, kvstore.MaxValueBytes+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errutil.AssertErrorCode(t, svc.Set(ctx, tt.plugin, tt.key, tt.value), "KV_INVALID")
+		})
+	}
+
+	require.NoError(t, svc.Set(ctx, "shop", strings.Repeat("k", kvstore.MaxKeyLength), make([]byte, kvstore.MaxValueBytes)),
+		"keys and values at the limit are accepted")
+}
+
+func TestServiceEnforcesKeyQuota(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(kvstore.Config{MaxKeys: 2})
+
+	require.NoError(t, svc.Set(ctx, "shop", "a", []byte("1")))
+	require.NoError(t, svc.Set(ctx, "shop", "b", []byte("1")))
+	err := svc.Set(ctx, "shop", "c", []byte("1"))
+	assert.ErrorIs(t, err, kvstore.ErrQuotaExceeded)
+	errutil.AssertErrorCode(t, err, "KV_QUOTA_EXCEEDED")
+
+	require.NoError(t, svc.Set(ctx, "shop", "a", []byte("2")), "replacing a key does not add one")
+	require.NoError(t, svc.Set(ctx, "quests", "c", []byte("1")), "each plugin has its own quota")
+	require.NoError(t, svc.Delete(ctx, "shop", "b"))
+	require.NoError(t, svc.Set(ctx, "shop", "c", []byte("1")), "deleting frees room")
+}
+
+func TestServiceEnforcesByteQuota(t *testing.T) {
+	ctx := context.Background()
+	svc, store := newTestService(kvstore.Config{MaxBytes: 10})
+
+	require.NoError(t, svc.Set(ctx, "shop", "k", []byte("123456789")))
+	err := svc.Set(ctx, "shop", "k2", []byte("x"))
+	errutil.AssertErrorCode(t, err, "KV_QUOTA_EXCEEDED")
+	err = svc.Set(ctx, "shop", "k", []byte("1234567890"))
+	errutil.AssertErrorCode(t, err, "KV_QUOTA_EXCEEDED")
+
+	usage, err := store.Usage(ctx, "shop")
+	require.NoError(t, err)
+	assert.Equal(t, kvstore.Usage{Plugin: "shop", Keys: 1, Bytes: 10}, usage, "a refused write stores nothing")
+}
+
+func TestServiceAllowsShrinkingOverALoweredQuota(t *testing.T) {
+	ctx := context.Background()
+	store := newMemStore()
+	big := kvstore.NewService(kvstore.Config{}, store)
+	require.NoError(t, big.Set(ctx, "shop", "k", []byte("0123456789")))
+
+	small := kvstore.NewService(kvstore.Config{MaxBytes: 5}, store)
+	errutil.AssertErrorCode(t, small.Set(ctx, "shop", "k", []byte("0123456789a")), "KV_QUOTA_EXCEEDED")
+	require.NoError(t, small.Set(ctx, "shop", "k", []byte("01234567")), "a write that shrinks usage is allowed")
+}
+
+func TestServiceList(t *testing.T) {
+	ctx := context.Background()
+	svc, store := newTestService(kvstore.Config{})
+	for _, key := range []string{"quest:2", "quest:1", "shop:1"} {
+		require.NoError(t, svc.Set(ctx, "rpg", key, []byte("v")))
+	}
+
+	entries, err := svc.List(ctx, "rpg", "quest:", 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "quest:1", entries[0].Key)
+	assert.Equal(t, "quest:2", entries[1].Key)
+	assert.Equal(t, kvstore.DefaultListLimit, store.limit)
+
+	_, err = svc.List(ctx, "rpg", "", kvstore.MaxListLimit+1)
+	require.NoError(t, err)
+	assert.Equal(t, kvstore.MaxListLimit, store.limit)
+
+	_, err = svc.List(ctx, "", "", 0)
+	errutil.AssertErrorCode(t, err, "KV_INVALID")
+}
+
+func TestServiceUsageAndPurge(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestService(kvstore.Config{})
+	require.NoError(t, svc.Set(ctx, "shop", "a", []byte("12")))
+	require.NoError(t, svc.Set(ctx, "shop", "b", []byte("3")))
+	require.NoError(t, svc.Set(ctx, "quests", "q", []byte("")))
+
+	usages, err := svc.AllUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []kvstore.Usage{
+		{Plugin: "quests", Keys: 1, Bytes: 1},
+		{Plugin: "shop", Keys: 2, Bytes: 5},
+	}, usages)
+
+	n, err := svc.Purge(ctx, "shop")
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	usage, err := svc.Usage(ctx, "shop")
+	require.NoError(t, err)
+	assert.Equal(t, kvstore.Usage{Plugin: "shop"}, usage)
+
+	errutil.AssertErrorCode(t, svc.Delete(ctx, "shop", "a"), "KV_NOT_FOUND")
+}
+
+func TestNewServicePanicsOnNilStore(t *testing.T) {
+	assert.Panics(t, func() { kvstore.NewService(kvstore.Config{}, nil) })
+}
+
+func TestServiceQuotaDefaults(t *testing.T) {
+	svc, _ := newTestService(kvstore.Config{})
+	assert.Equal(t, kvstore.Quota{MaxKeys: kvstore.DefaultMaxKeys, MaxBytes: kvstore.DefaultMaxBytes}, svc.Quota())
+}
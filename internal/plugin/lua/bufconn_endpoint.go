@@ -42,6 +42,7 @@ func newPluginEndpoint(adapter hostcap.HostCapabilities, manifest *plugins.Manif
 		Auditor:        adapter.Auditor(),
 		PluginName:     pluginName,
 		DeclaredAccess: hostcap.DeclaredAccessFromManifest(manifest),
+		Quota:          hostcap.NewCallQuota(hostcap.DefaultQuotaLimits),
 	})
 	// The server is served exclusively over an in-memory bufconn listener
 	// (plugins.NewInProcessConn below), never a network socket — there is no
@@ -57,8 +57,8 @@ func (m *recordingWorldMutator) GetCharacter(context.Context, string, ulid.ULID)
 	return nil, world.ErrNotFound
 }
 
-func (m *recordingWorldMutator) GetCharactersByLocation(context.Context, string, ulid.ULID, world.ListOptions) ([]*world.Character, error) {
-	return nil, nil
+func (m *recordingWorldMutator) GetCharactersByLocation(context.Context, string, ulid.ULID, world.ListOptions) (world.Page[*world.Character], error) {
+	return world.Page[*world.Character]{}, nil
 }
 
 func (m *recordingWorldMutator) GetObject(context.Context, string, ulid.ULID) (*world.Object, error) {
@@ -116,7 +116,7 @@ func (r *recordingSessionAdmin) snapshotBroadcasts() []string {
 // and _G["session.admin"] before the command handlers run. The crypto.emits
 // block mirrors the real manifest so (a) the INV-PLUGIN-32 Load capture pass
 // installs register_emit_type for main.lua's top-level calls, and (b) the host
-// emit fence permits the sensitivity:always page/whisper/pemit events that the
+// emit fence permits the sensitivity:always whisper/pemit events that the
 // handlers emit with sensitive=true.
 func corecommManifest() *plugins.Manifest {
 	return &plugins.Manifest{
@@ -134,10 +134,10 @@ func corecommManifest() *plugins.Manifest {
 				{EventType: "pose", Sensitivity: plugins.SensitivityNever},
 				{EventType: "ooc", Sensitivity: plugins.SensitivityNever},
 				{EventType: "emit", Sensitivity: plugins.SensitivityNever},
-				{EventType: "page", Sensitivity: plugins.SensitivityAlways},
 				{EventType: "whisper", Sensitivity: plugins.SensitivityAlways},
 				{EventType: "pemit", Sensitivity: plugins.SensitivityAlways},
 				{EventType: "whisper_notice", Sensitivity: plugins.SensitivityNever},
+				{EventType: "whisper_overheard", Sensitivity: plugins.SensitivityAlways},
 			},
 		},
 	}
@@ -152,6 +152,13 @@ func corecommManifest() *plugins.Manifest {
 // evaluation proceeds rather than failing closed.
 func loadCorecomm(t *testing.T) (*pluginlua.Host, *recordingSessionAccess, *recordingSessionAdmin) {
 	t.Helper()
+	return loadCorecommWith(t, corecommManifest())
+}
+
+// loadCorecommWith is loadCorecomm with a caller-supplied manifest, for tests
+// that set plugin config.
+func loadCorecommWith(t *testing.T, manifest *plugins.Manifest) (*pluginlua.Host, *recordingSessionAccess, *recordingSessionAdmin) {
+	t.Helper()
 
 	root := repoRoot(t)
 	pluginDir := filepath.Join(root, "plugins", "core-communication")
@@ -172,7 +179,7 @@ func loadCorecomm(t *testing.T) (*pluginlua.Host, *recordingSessionAccess, *reco
 	)
 	t.Cleanup(func() { closeHost(t, host) })
 
-	require.NoError(t, host.Load(context.Background(), corecommManifest(), pluginDir),
+	require.NoError(t, host.Load(context.Background(), manifest, pluginDir),
 		"loading the real core-communication plugin must succeed")
 
 	return host, sessions, admin
@@ -250,51 +257,152 @@ func TestCoreCommunicationWhisperDrivesBrokeredSession(t *testing.T) {
 	assert.Equal(t, "Bob", got)
 }
 
-// TestCoreCommunicationPageDrivesBrokeredSession proves the migrated `page`
-// handler reaches the brokered `session` capability: it resolves the target via
-// session_caps.FindByName (reading resp.session), emits a single sensitive page
-// to the target's character stream, and records the last-paged target through
-// the brokered SetLastWhispered RPC. Page does not require co-location.
-func TestCoreCommunicationPageDrivesBrokeredSession(t *testing.T) {
+// TestCoreCommunicationWhisperToSeveralTargets proves a whisper to a
+// comma-separated list reaches each recipient on its own character stream,
+// tells each who else it went to, and names every party on the room notice
+// so the fan-out can hide the notice from them.
+func TestCoreCommunicationWhisperToSeveralTargets(t *testing.T) {
 	host, sessions, _ := loadCorecomm(t)
 
 	senderID := ulid.Make().String()
 	senderSession := ulid.Make().String()
-	targetCharID := ulid.Make()
-
-	sessions.byName["bob"] = &session.Info{
-		ID:            ulid.Make().String(),
-		CharacterID:   targetCharID,
-		CharacterName: "Bob",
-		LocationID:    ulid.Make(), // a different location — page is cross-location
-	}
+	locationID := ulid.Make()
+	bobID, carolID := ulid.Make(), ulid.Make()
+	sessions.byName["bob"] = &session.Info{ID: ulid.Make().String(), CharacterID: bobID, CharacterName: "Bob", LocationID: locationID}
+	sessions.byName["carol"] = &session.Info{ID: ulid.Make().String(), CharacterID: carolID, CharacterName: "Carol", LocationID: locationID}
 
 	resp, err := host.DeliverCommand(corecommCtx(senderID), "core-communication", pluginsdk.CommandRequest{
-		Command:       "page",
-		Args:          "Bob=are you around?",
+		Command:       "whisper",
+		Args:          "bob, Carol, Bob=the vault is open",
 		CharacterID:   senderID,
 		CharacterName: "Alice",
-		LocationID:    ulid.Make().String(),
+		LocationID:    locationID.String(),
 		SessionID:     senderSession,
-		InvokedAs:     "page",
+		InvokedAs:     "whisper",
 	})
-	require.NoError(t, err, "page delivery must not error")
-	require.NotNil(t, resp)
-	require.Equal(t, pluginsdk.CommandOK, resp.Status,
-		"page must succeed once the brokered session lookup resolves; output: %q", resp.Output)
-	assert.Contains(t, resp.Output, "You paged Bob: are you around?")
+	require.NoError(t, err)
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, "output: %q", resp.Output)
+	assert.Contains(t, resp.Output, "You whisper to Bob and Carol: the vault is open")
 
-	require.Len(t, resp.Events, 1, "page emits exactly one sensitive event to the target")
-	page := resp.Events[0]
-	assert.Equal(t, "character."+targetCharID.String(), page.Stream,
-		"the page targets the resolved character (proves resp.session.character_id was read)")
-	assert.Equal(t, "core-communication:page", string(page.Type))
-	assert.True(t, page.Sensitive, "the page MUST be sensitive (crypto.emits: always)")
-	assert.Contains(t, page.Payload, "Alice pages: are you around?")
+	require.Len(t, resp.Events, 3, "one notice plus one whisper per distinct recipient")
+	notice := resp.Events[0]
+	assert.Equal(t, "core-communication:whisper_notice", string(notice.Type))
+	assert.NotContains(t, notice.Payload, "vault", "the notice carries no whisper content")
+	assert.Contains(t, notice.Payload, `"notice":"Alice whispers to Bob and Carol."`)
+	assert.Contains(t, notice.Payload, `"party_ids":["`+senderID+`","`+bobID.String()+`","`+carolID.String()+`"]`)
+
+	assert.Equal(t, "character."+bobID.String(), resp.Events[1].Stream)
+	assert.True(t, resp.Events[1].Sensitive)
+	assert.Contains(t, resp.Events[1].Payload, `"also_to":["Carol"]`)
+	assert.Equal(t, "character."+carolID.String(), resp.Events[2].Stream)
+	assert.Contains(t, resp.Events[2].Payload, `"also_to":["Bob"]`)
 
 	got, ok := sessions.lastWhisperedFor(senderSession)
-	require.True(t, ok, "page must record the last-paged target via the brokered SetLastWhispered RPC")
-	assert.Equal(t, "Bob", got)
+	require.True(t, ok)
+	assert.Equal(t, "Bob, Carol", got, "w <message> whispers to the same list again")
+}
+
+// TestCoreCommunicationWhisperRequiresEveryTargetPresent proves one absent
+// recipient refuses the whole whisper rather than leaking it to the rest.
+func TestCoreCommunicationWhisperRequiresEveryTargetPresent(t *testing.T) {
+	host, sessions, _ := loadCorecomm(t)
+
+	locationID := ulid.Make()
+	sessions.byName["bob"] = &session.Info{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Bob", LocationID: locationID}
+	sessions.byName["carol"] = &session.Info{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Carol", LocationID: ulid.Make()}
+
+	senderID := ulid.Make().String()
+	resp, err := host.DeliverCommand(corecommCtx(senderID), "core-communication", pluginsdk.CommandRequest{
+		Command:       "whisper",
+		Args:          "Bob,Carol=psst",
+		CharacterID:   senderID,
+		CharacterName: "Alice",
+		LocationID:    locationID.String(),
+		InvokedAs:     "whisper",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pluginsdk.CommandError, resp.Status)
+	assert.Equal(t, `You don't see anyone named "Carol" here.`, resp.Output)
+	assert.Empty(t, resp.Events)
+}
+
+// TestCoreCommunicationWhisperOverheard proves that with overhear_chance at
+// 100 every other grid-present character in the room gets a sensitive
+// fragment of the whisper, and that they stay off the notice's party list,
+// which the whole room can read.
+// Characters elsewhere, and sessions not on the grid, overhear nothing.
+func TestCoreCommunicationWhisperOverheard(t *testing.T) {
+	manifest := corecommManifest()
+	manifest.Config = map[string]plugins.ConfigParam{
+		"overhear_chance": {Type: "int", Default: "100"},
+		"whisper_notice":  {Type: "bool", Default: "true"},
+	}
+	host, sessions, _ := loadCorecommWith(t, manifest)
+
+	senderID := ulid.Make()
+	locationID := ulid.Make()
+	bob := &session.Info{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Bob", LocationID: locationID, GridPresent: true}
+	eve := &session.Info{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Eve", LocationID: locationID, GridPresent: true}
+	sessions.byName["bob"] = bob
+	sessions.active = []*session.Info{
+		{ID: ulid.Make().String(), CharacterID: senderID, CharacterName: "Alice", LocationID: locationID, GridPresent: true},
+		bob,
+		eve,
+		{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Faraway", LocationID: ulid.Make(), GridPresent: true},
+		{ID: ulid.Make().String(), CharacterID: ulid.Make(), CharacterName: "Webonly", LocationID: locationID},
+	}
+
+	resp, err := host.DeliverCommand(corecommCtx(senderID.String()), "core-communication", pluginsdk.CommandRequest{
+		Command:       "whisper",
+		Args:          "Bob=the vault code is four four two",
+		CharacterID:   senderID.String(),
+		CharacterName: "Alice",
+		LocationID:    locationID.String(),
+		InvokedAs:     "whisper",
+	})
+	require.NoError(t, err)
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, "output: %q", resp.Output)
+
+	require.Len(t, resp.Events, 3, "notice, Eve's overheard fragment, Bob's whisper")
+	notice := resp.Events[0]
+	assert.NotContains(t, notice.Payload, eve.CharacterID.String(), "the notice does not say who overheard")
+
+	overheard := resp.Events[1]
+	assert.Equal(t, "character."+eve.CharacterID.String(), overheard.Stream)
+	assert.Equal(t, "core-communication:whisper_overheard", string(overheard.Type))
+	assert.True(t, overheard.Sensitive, "an overheard fragment is whisper content")
+	assert.Contains(t, overheard.Payload, "...", "a multi-word whisper is only partly overheard")
+	assert.NotContains(t, overheard.Payload, "the vault code is four four two")
+
+	assert.Equal(t, "character."+bob.CharacterID.String(), resp.Events[2].Stream)
+}
+
+// TestCoreCommunicationWhisperNoticeDisabled proves whisper_notice=false
+// suppresses the room notice entirely.
+func TestCoreCommunicationWhisperNoticeDisabled(t *testing.T) {
+	manifest := corecommManifest()
+	manifest.Config = map[string]plugins.ConfigParam{
+		"whisper_notice": {Type: "bool", Default: "false"},
+	}
+	host, sessions, _ := loadCorecommWith(t, manifest)
+
+	locationID := ulid.Make()
+	bobID := ulid.Make()
+	sessions.byName["bob"] = &session.Info{ID: ulid.Make().String(), CharacterID: bobID, CharacterName: "Bob", LocationID: locationID}
+
+	senderID := ulid.Make().String()
+	resp, err := host.DeliverCommand(corecommCtx(senderID), "core-communication", pluginsdk.CommandRequest{
+		Command:       "whisper",
+		Args:          "Bob=psst",
+		CharacterID:   senderID,
+		CharacterName: "Alice",
+		LocationID:    locationID.String(),
+		InvokedAs:     "whisper",
+	})
+	require.NoError(t, err)
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, "output: %q", resp.Output)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, "character."+bobID.String(), resp.Events[0].Stream)
 }
 
 // TestCoreCommunicationPemitDrivesBrokeredSession proves the migrated `pemit`
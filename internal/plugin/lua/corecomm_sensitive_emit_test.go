@@ -15,11 +15,10 @@ import (
 
 // corecommAlwaysVerbs are the core-communication wire event types declared
 // sensitivity:always in plugins/core-communication/plugin.yaml crypto.emits.
-// Each is a private message (page/whisper/pemit) that MUST be emitted with a
+// Each is a private message (whisper/pemit) that MUST be emitted with a
 // per-event Sensitive=true claim, or the host fence rejects it fail-closed
 // (INV-PLUGIN-30). See holomush-50zqs.
 var corecommAlwaysVerbs = []string{
-	"core-communication:page",
 	"core-communication:whisper",
 	"core-communication:pemit",
 }
@@ -33,7 +32,7 @@ var sensitiveClaimPattern = regexp.MustCompile(`sensitive\s*=\s*true`)
 // event when the plugin emits it with Sensitive=true; absent the claim the
 // emit is rejected (EVENT_SENSITIVITY_REQUIRED). core-communication is a Lua
 // plugin, so the claim is the `sensitive = true` key on the emit table in
-// main.lua. This asserts each page/whisper/pemit emit table carries that claim.
+// main.lua. This asserts each whisper/pemit emit table carries that claim.
 //
 // The check is brace-scoped, not line-scoped: it isolates the innermost emit
 // table { ... } enclosing each `type = "<verb>"` key and asserts the claim
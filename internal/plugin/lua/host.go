@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/samber/oops"
@@ -52,7 +53,7 @@ type Host struct {
 	plugins         map[string]*luaPlugin
 	mu              sync.RWMutex
 	closed          bool
-	cpuTimeout      time.Duration // per-invocation deadline applied via context.WithTimeout
+	cpuTimeout      atomic.Int64 // per-invocation deadline (a time.Duration) applied via context.WithTimeout
 	configOverrides map[string]map[string]string
 	mergedConfigs   map[string]map[string]string
 	// dispatchAttrResolver resolves the acting character's host-vouched dispatch
@@ -84,7 +85,7 @@ type HostOption func(*Host)
 // (unchanged context inheritance). Recommend the caller pass a positive
 // duration in production; zero is allowed only for tests.
 func WithCPUTimeout(d time.Duration) HostOption {
-	return func(h *Host) { h.cpuTimeout = d }
+	return func(h *Host) { h.cpuTimeout.Store(int64(d)) }
 }
 
 // WithStateFactory replaces the default StateFactory. Used by callers
@@ -141,6 +142,26 @@ func (h *Host) SetPluginGrants(grants map[string][]string) {
 	h.pluginGrants = plugins.CloneGrants(grants)
 }
 
+// CPUTimeout returns the per-invocation deadline currently applied to plugin
+// calls. Zero means no cap.
+func (h *Host) CPUTimeout() time.Duration {
+	return time.Duration(h.cpuTimeout.Load())
+}
+
+// SetCPUTimeout replaces the per-invocation deadline set by WithCPUTimeout.
+// Unlike the Set* wiring methods it is safe to call while plugins are
+// dispatching: invocations already running keep the deadline they started
+// with, and the next invocation picks up d.
+func (h *Host) SetCPUTimeout(d time.Duration) {
+	h.cpuTimeout.Store(int64(d))
+}
+
+// SetRegistryMaxSize replaces the per-state Lua registry bound. Plugin states
+// are created per delivery, so the new bound applies from the next delivery.
+func (h *Host) SetRegistryMaxSize(n int) {
+	h.factory.SetRegistryMaxSize(n)
+}
+
 // grantedSubset returns the elements of requested that appear in the granted
 // set. When granted is nil or empty the result is nil (no caps injected on
 // an explicitly empty grant). This helper is used by both delivery paths that
@@ -274,6 +295,43 @@ func (h *Host) SetHistoryReader(hr plugins.HistoryReader) {
 	}
 }
 
+// SetDiceRoller injects the server-side dice roller into the underlying
+// hostfunc bridge so Lua plugins can call holomush.roll. Same startup-ordered
+// late-binding contract as SetHistoryReader.
+func (h *Host) SetDiceRoller(r hostfunc.DiceRoller) {
+	if h.hostFuncs != nil {
+		h.hostFuncs.SetDiceRoller(r)
+	}
+}
+
+// SetCheckRoller injects the stat check service into the underlying
+// hostfunc bridge so Lua plugins can call holomush.check and
+// holomush.contest. Same startup-ordered late-binding contract as
+// SetHistoryReader.
+func (h *Host) SetCheckRoller(c hostfunc.CheckRoller) {
+	if h.hostFuncs != nil {
+		h.hostFuncs.SetCheckRoller(c)
+	}
+}
+
+// SetHelpTopics injects the help topic reader into the underlying hostfunc
+// bridge so Lua plugins can call the holomush.help_* functions.
+// Same startup-ordered late-binding contract as SetHistoryReader.
+func (h *Host) SetHelpTopics(t hostfunc.HelpTopics) {
+	if h.hostFuncs != nil {
+		h.hostFuncs.SetHelpTopics(t)
+	}
+}
+
+// SetPluginStorage injects the per-plugin key-value store into the
+// underlying hostfunc bridge so Lua plugins can call the holomush.storage_*
+// functions. Same startup-ordered late-binding contract as SetHistoryReader.
+func (h *Host) SetPluginStorage(s hostfunc.PluginStorage) {
+	if h.hostFuncs != nil {
+		h.hostFuncs.SetPluginStorage(s)
+	}
+}
+
 // SetSessionAdmin injects the broadcast/disconnect backing into the host-cap
 // adapter so the brokered SessionAdminService serves real broadcasts
 // (holomush-eykuh.4.2). Called once during startup wiring, before any plugin
@@ -15,8 +15,9 @@ import (
 func (h *Host) invoke(parentCtx context.Context, L *lua.LState, plugin, handler string, p lua.P, args ...lua.LValue) error {
 	var ctx context.Context
 	var cancel context.CancelFunc
-	if h.cpuTimeout > 0 {
-		ctx, cancel = context.WithTimeout(parentCtx, h.cpuTimeout)
+	timeout := h.CPUTimeout()
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(parentCtx, timeout)
 	} else {
 		ctx, cancel = context.WithCancel(parentCtx)
 	}
@@ -46,7 +47,7 @@ func (h *Host) invoke(parentCtx context.Context, L *lua.LState, plugin, handler
 		return oops.Code("PLUGIN_LUA_TIMEOUT").
 			With("plugin", plugin).
 			With("handler", handler).
-			With("timeout", h.cpuTimeout).
+			With("timeout", timeout).
 			Wrap(ctx.Err())
 	}
 }
@@ -21,10 +21,10 @@ import (
 func newInvokeTestHost(t *testing.T, cpuTimeout time.Duration) *Host {
 	t.Helper()
 	h := &Host{
-		factory:    NewStateFactory(),
-		cpuTimeout: cpuTimeout,
-		plugins:    map[string]*luaPlugin{},
+		factory: NewStateFactory(),
+		plugins: map[string]*luaPlugin{},
 	}
+	h.cpuTimeout.Store(int64(cpuTimeout))
 	return h
 }
 
@@ -157,3 +157,28 @@ func TestInvokeReturnsLuaErrorAsOutcomeError(t *testing.T) {
 		testutil.ToFloat64(InvocationsTotal.WithLabelValues("test", "on_event", outcomeError)),
 		"Lua error must count as outcome=error")
 }
+
+func TestInvokeUsesTimeoutSetAtRuntime(t *testing.T) {
+	h := newInvokeTestHost(t, time.Minute)
+	L, err := h.factory.NewState(context.Background())
+	require.NoError(t, err)
+	defer L.Close()
+
+	require.NoError(t, L.DoString(`function handler() while true do end end`))
+	fn := L.GetGlobal("handler")
+
+	h.SetCPUTimeout(100 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, h.CPUTimeout())
+
+	start := time.Now()
+	err = h.invoke(context.Background(), L, "test", "on_event", lua.P{
+		Fn:      fn,
+		NRet:    0,
+		Protect: true,
+	})
+	elapsed := time.Since(start)
+
+	assert.Error(t, err)
+	assert.Less(t, elapsed, 400*time.Millisecond,
+		"invoke must use the replaced timeout, not the construction-time minute")
+}
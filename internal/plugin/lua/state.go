@@ -6,6 +6,7 @@ package lua
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/samber/oops"
 	lua "github.com/yuin/gopher-lua"
@@ -35,7 +36,7 @@ type StateFactory struct {
 	libraries []safeLibrary
 	// registryMaxSize bounds the Lua value registry per state. Zero means
 	// "use gopher-lua default" (unbounded growth).
-	registryMaxSize int
+	registryMaxSize atomic.Int64
 }
 
 // StateFactoryOption customizes StateFactory construction.
@@ -45,7 +46,13 @@ type StateFactoryOption func(*StateFactory)
 // state. Overflow causes gopher-lua to panic; CallByParam(Protect=true)
 // catches it and returns an error. Zero disables the cap.
 func WithRegistryMaxSize(n int) StateFactoryOption {
-	return func(f *StateFactory) { f.registryMaxSize = n }
+	return func(f *StateFactory) { f.registryMaxSize.Store(int64(n)) }
+}
+
+// SetRegistryMaxSize replaces the registry bound set by WithRegistryMaxSize.
+// States already created keep their bound; the next NewState uses n.
+func (f *StateFactory) SetRegistryMaxSize(n int) {
+	f.registryMaxSize.Store(int64(n))
 }
 
 // NewStateFactory creates a new state factory.
@@ -73,7 +80,7 @@ var unsafeBaseFunctions = []string{"dofile", "loadfile", "loadstring", "load"}
 func (f *StateFactory) NewState(_ context.Context) (*lua.LState, error) {
 	L := lua.NewState(lua.Options{
 		SkipOpenLibs:    true, // Don't load any libraries by default
-		RegistryMaxSize: f.registryMaxSize,
+		RegistryMaxSize: int(f.registryMaxSize.Load()),
 	})
 
 	for _, lib := range f.libraries {
@@ -631,6 +631,19 @@ func registerWorldQueryService(L *lua.LState, conn grpc.ClientConnInterface, plu
 		L.Push(lua.LNil)
 		return 2
 	}))
+	L.SetField(tbl, "QueryExits", L.NewFunction(func(L *lua.LState) int {
+		var req hostv1.QueryExitsRequest
+		if err := LuaTableToProto(L.CheckTable(1), &req); err != nil {
+			return pushBridgeError(L, err)
+		}
+		resp, err := client.QueryExits(luaContext(L), &req)
+		if err != nil {
+			return pushBridgeError(L, err)
+		}
+		L.Push(ProtoToLuaTable(L, resp))
+		L.Push(lua.LNil)
+		return 2
+	}))
 	L.SetField(tbl, "FindLocation", L.NewFunction(func(L *lua.LState) int {
 		var req hostv1.FindLocationRequest
 		if err := LuaTableToProto(L.CheckTable(1), &req); err != nil {
@@ -69,6 +69,51 @@ var ambientDecls = []ambientFn{
 		Module: "holomush", Name: "decrypt_own_audit_rows", Doc: "Decrypt audit rows the plugin owns. rows is an array of row tables.",
 		Params: []ambientParam{{"rows", "table"}}, Returns: []string{"table", "string?"},
 	},
+	// stdlib_dice.go rollImpl → single table arg {expression, stream, reason?}; returns (table, err?).
+	{
+		Module: "holomush", Name: "roll", Doc: "Roll dice on the server as the acting character and announce the result. Pass a table: {expression=string, stream=string, reason?=string}.",
+		Params: []ambientParam{{"args", "table"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_check.go checkImpl → single table arg {attribute, difficulty, stream?, reason?}; returns (table, err?).
+	{
+		Module: "holomush", Name: "check", Doc: "Make a stat check for the acting character against a difficulty and announce the result. Pass a table: {attribute=string, difficulty=integer, stream?=string, reason?=string}.",
+		Params: []ambientParam{{"args", "table"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_check.go contestImpl → single table arg {attribute, defender, defender_attribute?, stream?, reason?}; returns (table, err?).
+	{
+		Module: "holomush", Name: "contest", Doc: "Make a contested check between the acting character and a defender and announce the result. Pass a table: {attribute=string, defender=string, defender_attribute?=string, stream?=string, reason?=string}.",
+		Params: []ambientParam{{"args", "table"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_help.go helpTopicImpl → (name); returns (table, err?).
+	{
+		Module: "holomush", Name: "help_topic", Doc: "Look up a help topic by name or alias. Returns {name, title, category, aliases, text}; text is the rendered page.",
+		Params: []ambientParam{{"name", "string"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_help.go helpListImpl → (category?); returns (table, err?).
+	{
+		Module: "holomush", Name: "help_list", Doc: "List help topics, optionally in one category. Returns an array of {name, title, category} ordered by category then name.",
+		Params: []ambientParam{{"category", "string?"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_help.go helpSearchImpl → (query); returns (table, err?).
+	{
+		Module: "holomush", Name: "help_search", Doc: "Full-text search help topics. Returns an array of {name, title, category}, best match first.",
+		Params: []ambientParam{{"query", "string"}}, Returns: []string{"table", "string?"},
+	},
+	// stdlib_storage.go storageGetImpl → (key); returns (string?, err?); (nil, nil) when unset.
+	{
+		Module: "holomush", Name: "storage_get", Doc: "Read a key from the plugin's own persistent storage. Returns nil (and no error) when the key is not set.",
+		Params: []ambientParam{{"key", "string"}}, Returns: []string{"string?", "string?"},
+	},
+	// stdlib_storage.go storageSetImpl → (key, value?); returns (true) or (nil, err).
+	{
+		Module: "holomush", Name: "storage_set", Doc: "Write a key in the plugin's own persistent storage; a nil value deletes it. Fails when the key or value is invalid or the plugin's quota is full.",
+		Params: []ambientParam{{"key", "string"}, {"value", "string?"}}, Returns: []string{"boolean?", "string?"},
+	},
+	// stdlib_storage.go storageListImpl → (prefix?, limit?); returns (table, err?).
+	{
+		Module: "holomush", Name: "storage_list", Doc: "List the keys in the plugin's own persistent storage that start with prefix, in byte order (default 100, at most 1000).",
+		Params: []ambientParam{{"prefix", "string?"}, {"limit", "integer?"}}, Returns: []string{"table", "string?"},
+	},
 	// functions.go:326-330 → (event_type); returns true.
 	{
 		Module: "holomush", Name: "register_emit_type", Doc: "Declare a plugin-owned event type (Load-time; INV-PLUGIN-32).",
@@ -86,6 +86,7 @@ type Manager struct {
 	aliasSeeder         AliasSeeder
 	aliasCache          *command.AliasCache
 	verbRegistry        *core.VerbRegistry
+	propertyTypes       PropertyTypeRegistrar // optional, receives manifest property_types
 	eventEmitter        *PluginEventEmitter
 	loaded              map[string]*DiscoveredPlugin
 	inflight            map[string]*DiscoveredPlugin
@@ -149,6 +150,22 @@ func WithAttributeProviderUnregistrar(fn UnregisterPluginProviderFunc) ManagerOp
 	}
 }
 
+// PropertyTypeRegistrar receives the property value types plugins declare
+// in their manifests' property_types. Declaring is all-or-nothing per
+// plugin; a name another plugin or staff already declared fails the load.
+type PropertyTypeRegistrar interface {
+	DeclarePluginPropertyTypes(plugin string, specs []PropertyTypeSpec) error
+	RemovePluginPropertyTypes(plugin string)
+}
+
+// WithPropertyTypeRegistrar sets where manifest property_types are
+// declared on load. Without it, declared property types are ignored.
+func WithPropertyTypeRegistrar(reg PropertyTypeRegistrar) ManagerOption {
+	return func(m *Manager) {
+		m.propertyTypes = reg
+	}
+}
+
 // WithServiceRegistry configures the manager to use DAG-based dependency
 // resolution via the provided service registry.
 func WithServiceRegistry(reg *ServiceRegistry) ManagerOption {
@@ -1185,6 +1202,9 @@ func (m *Manager) loadPlugin(ctx context.Context, dp *DiscoveredPlugin, knownRes
 			delete(m.nameByID, pluginID)
 			delete(m.activeByName, dp.Manifest.Name)
 			m.mu.Unlock()
+			if m.propertyTypes != nil {
+				m.propertyTypes.RemovePluginPropertyTypes(dp.Manifest.Name)
+			}
 		}
 	}()
 
@@ -1316,6 +1336,21 @@ func (m *Manager) loadPlugin(ctx context.Context, dp *DiscoveredPlugin, knownRes
 		}
 	}
 
+	// Declare plugin-owned property value types. The deferred rollback
+	// above removes them again if a later step fails.
+	if m.propertyTypes != nil && len(dp.Manifest.PropertyTypes) > 0 {
+		if regErr := m.propertyTypes.DeclarePluginPropertyTypes(dp.Manifest.Name, dp.Manifest.PropertyTypes); regErr != nil {
+			m.verbRegistry.UnregisterBySource(dp.Manifest.Name)
+			m.unregisterPluginProviders(dp.Manifest.Name, dp.Manifest.ResourceTypes, len(dp.Manifest.ResourceTypes))
+			if unloadErr := host.Unload(ctx, dp.Manifest.Name); unloadErr != nil {
+				slog.ErrorContext(ctx, "failed to rollback plugin load after property type declaration failure",
+					"plugin", dp.Manifest.Name, "error", unloadErr)
+			}
+			return oops.In("manager").With("plugin", dp.Manifest.Name).
+				Wrapf(regErr, "declare plugin property types")
+		}
+	}
+
 	// Register plugin-provided services in the service registry.
 	// Registration failures are treated as hard errors — dependents resolved
 	// by ResolveDependencyOrder rely on the Provides contract being satisfied.
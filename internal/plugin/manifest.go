@@ -84,30 +84,51 @@ type FocusRedirect struct {
 // enum value on FocusRedirect.FocusKind.
 var knownFocusKinds = map[string]bool{"scene": true}
 
+// ManifestEventFilter narrows the events a plugin subscribes to beyond the
+// types listed in events. The host drops non-matching events before
+// delivery; see EventFilter.
+type ManifestEventFilter struct {
+	ActorKinds []string          `yaml:"actor_kinds,omitempty" json:"actor_kinds,omitempty" jsonschema:"description=Accepted actor kinds,enum=character,enum=system,enum=plugin"`
+	Payload    map[string]string `yaml:"payload,omitempty" json:"payload,omitempty" jsonschema:"description=Dotted payload field paths mapped to required values"`
+}
+
+// SubscriptionFilter returns the server-side filter for the plugin's event
+// subscriptions: the events list plus any event_filter predicates.
+func (m *Manifest) SubscriptionFilter() EventFilter {
+	f := EventFilter{EventTypes: m.Events}
+	if m.EventFilter != nil {
+		f.ActorKinds = m.EventFilter.ActorKinds
+		f.Payload = m.EventFilter.Payload
+	}
+	return f
+}
+
 // Manifest represents a plugin.yaml file.
 type Manifest struct {
-	Name         string            `yaml:"name" json:"name" jsonschema:"required,minLength=1,maxLength=64,pattern=^[a-z](-?[a-z0-9])*$"`
-	Version      string            `yaml:"version" json:"version" jsonschema:"required,minLength=1"`
-	Type         Type              `yaml:"type" json:"type" jsonschema:"required,enum=lua,enum=binary,enum=setting"`
-	Engine       string            `yaml:"engine,omitempty" json:"engine,omitempty" jsonschema:"description=HoloMUSH version constraint (e.g. >= 2.0.0)"`
-	Dependencies map[string]string `yaml:"dependencies,omitempty" json:"dependencies,omitempty" jsonschema:"description=Plugin dependencies with version constraints"`
-	Events       []string          `yaml:"events,omitempty" json:"events,omitempty"`
-	Emits        []string          `yaml:"emits,omitempty" json:"emits,omitempty"`
+	Name         string               `yaml:"name" json:"name" jsonschema:"required,minLength=1,maxLength=64,pattern=^[a-z](-?[a-z0-9])*$"`
+	Version      string               `yaml:"version" json:"version" jsonschema:"required,minLength=1"`
+	Type         Type                 `yaml:"type" json:"type" jsonschema:"required,enum=lua,enum=binary,enum=setting"`
+	Engine       string               `yaml:"engine,omitempty" json:"engine,omitempty" jsonschema:"description=HoloMUSH version constraint (e.g. >= 2.0.0)"`
+	Dependencies map[string]string    `yaml:"dependencies,omitempty" json:"dependencies,omitempty" jsonschema:"description=Plugin dependencies with version constraints"`
+	Events       []string             `yaml:"events,omitempty" json:"events,omitempty"`
+	EventFilter  *ManifestEventFilter `yaml:"event_filter,omitempty" json:"event_filter,omitempty" jsonschema:"description=Server-side predicates applied to subscribed events before delivery"`
+	Emits        []string             `yaml:"emits,omitempty" json:"emits,omitempty"`
 	// ActorKindsClaimable declares which Actor.Kind values the plugin may
 	// vouch for on emitted events. Default if absent: ["plugin"]. Allowed
 	// values: "plugin" (always required), "character". The "system" kind
 	// is rejected at load — plugins may never claim the host's system
 	// identity. See spec docs/superpowers/specs/2026-04-25-plugin-actor-claim-authentication-design.md §3.2.
-	ActorKindsClaimable []string         `yaml:"actor_kinds_claimable,omitempty" json:"actor_kinds_claimable,omitempty"`
-	Policies            []ManifestPolicy `yaml:"policies,omitempty" json:"policies,omitempty"`
-	Commands            []CommandSpec    `yaml:"commands,omitempty" json:"commands,omitempty" jsonschema:"description=Commands provided by this plugin"`
-	Verbs               []VerbSpec       `yaml:"verbs,omitempty" json:"verbs,omitempty" jsonschema:"description=Verb registrations contributed by this plugin"`
-	FocusRedirects      []FocusRedirect  `yaml:"focus_redirects,omitempty" json:"focus_redirects,omitempty" jsonschema:"description=Top-level verbs redirected to a target command when a connection has the given focus kind"`
-	Priority            *LoadPriority    `yaml:"priority,omitempty" json:"priority,omitempty" jsonschema:"description=Load priority (lower loads first)"`
-	SessionStreams      bool             `yaml:"session_streams,omitempty" json:"session_streams,omitempty" jsonschema:"description=Plugin contributes streams to session subscriptions via QuerySessionStreams"`
-	LuaPlugin           *LuaConfig       `yaml:"lua-plugin,omitempty" json:"lua-plugin,omitempty"`
-	BinaryPlugin        *BinaryConfig    `yaml:"binary-plugin,omitempty" json:"binary-plugin,omitempty"`
-	Setting             *SettingConfig   `yaml:"setting,omitempty" json:"setting,omitempty"`
+	ActorKindsClaimable []string           `yaml:"actor_kinds_claimable,omitempty" json:"actor_kinds_claimable,omitempty"`
+	Policies            []ManifestPolicy   `yaml:"policies,omitempty" json:"policies,omitempty"`
+	Commands            []CommandSpec      `yaml:"commands,omitempty" json:"commands,omitempty" jsonschema:"description=Commands provided by this plugin"`
+	Verbs               []VerbSpec         `yaml:"verbs,omitempty" json:"verbs,omitempty" jsonschema:"description=Verb registrations contributed by this plugin"`
+	FocusRedirects      []FocusRedirect    `yaml:"focus_redirects,omitempty" json:"focus_redirects,omitempty" jsonschema:"description=Top-level verbs redirected to a target command when a connection has the given focus kind"`
+	PropertyTypes       []PropertyTypeSpec `yaml:"property_types,omitempty" json:"property_types,omitempty" jsonschema:"description=Value types of the entity properties this plugin owns"`
+	Priority            *LoadPriority      `yaml:"priority,omitempty" json:"priority,omitempty" jsonschema:"description=Load priority (lower loads first)"`
+	SessionStreams      bool               `yaml:"session_streams,omitempty" json:"session_streams,omitempty" jsonschema:"description=Plugin contributes streams to session subscriptions via QuerySessionStreams"`
+	LuaPlugin           *LuaConfig         `yaml:"lua-plugin,omitempty" json:"lua-plugin,omitempty"`
+	BinaryPlugin        *BinaryConfig      `yaml:"binary-plugin,omitempty" json:"binary-plugin,omitempty"`
+	Setting             *SettingConfig     `yaml:"setting,omitempty" json:"setting,omitempty"`
 
 	// Deprecated: capabilities field is no longer supported. Use policies instead.
 	// This field exists only to detect old-format manifests and produce a clear error.
@@ -225,6 +246,20 @@ type VerbSpec struct {
 	DisplayTarget string `yaml:"display_target" json:"display_target" jsonschema:"required"`
 }
 
+// PropertyTypeSpec declares the value type of an entity property name the
+// plugin owns. The manager declares it in the world property schema
+// registry on load, so writes to the name are validated against it.
+type PropertyTypeSpec struct {
+	Name        string         `yaml:"name" json:"name" jsonschema:"required,minLength=1,maxLength=100"`
+	Type        string         `yaml:"type" json:"type" jsonschema:"required,enum=string,enum=int,enum=bool,enum=ref,enum=json"`
+	Schema      map[string]any `yaml:"schema,omitempty" json:"schema,omitempty" jsonschema:"description=JSON Schema constraining a json value"`
+	Description string         `yaml:"description,omitempty" json:"description,omitempty"`
+}
+
+var validPropertyTypes = map[string]bool{
+	"string": true, "int": true, "bool": true, "ref": true, "json": true,
+}
+
 var validVerbCategories = map[string]bool{
 	"communication": true, "movement": true, "state": true, "system": true, "command": true,
 }
@@ -537,6 +572,12 @@ func (m *Manifest) Validate() error {
 		}
 	}
 
+	if m.EventFilter != nil {
+		if err := m.SubscriptionFilter().Validate(); err != nil {
+			return oops.In("manifest").With("name", m.Name).Wrap(err)
+		}
+	}
+
 	// Validate session_streams: only lua and binary plugins can contribute session streams.
 	if m.SessionStreams && m.Type != TypeLua && m.Type != TypeBinary {
 		return oops.In("manifest").With("name", m.Name).With("type", m.Type).
@@ -726,9 +767,41 @@ func (m *Manifest) Validate() error {
 		return err
 	}
 
+	if err := validatePropertyTypes(m.Name, m.PropertyTypes); err != nil {
+		return err
+	}
+
 	return validateConfigSchema(m.Config)
 }
 
+// validatePropertyTypes checks each declared property type at parse time:
+// a name, a known type, a schema only on json types, and no name declared
+// twice. Whether the JSON Schema compiles, and whether another plugin or
+// staff already own the name, is checked when the plugin loads.
+func validatePropertyTypes(plugin string, specs []PropertyTypeSpec) error {
+	seen := make(map[string]bool, len(specs))
+	for i, pt := range specs {
+		if strings.TrimSpace(pt.Name) == "" {
+			return oops.In("manifest").With("plugin", plugin).With("property_type_index", i).
+				New("property type name must not be empty")
+		}
+		if !validPropertyTypes[pt.Type] {
+			return oops.In("manifest").With("plugin", plugin).With("property", pt.Name).
+				With("type", pt.Type).New("unknown property type")
+		}
+		if len(pt.Schema) > 0 && pt.Type != "json" {
+			return oops.In("manifest").With("plugin", plugin).With("property", pt.Name).
+				New("schema is only allowed on json property types")
+		}
+		if seen[pt.Name] {
+			return oops.In("manifest").With("plugin", plugin).With("property", pt.Name).
+				New("duplicate property type name")
+		}
+		seen[pt.Name] = true
+	}
+	return nil
+}
+
 // validateFocusRedirects checks each declared redirect at parse time: known
 // focus_kind, at least one non-empty verb, non-empty target_command. Target
 // existence and cross-plugin duplicate detection are load-time concerns
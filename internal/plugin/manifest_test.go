@@ -58,6 +58,45 @@ history_scope: grid
 	assert.Equal(t, []string{"scene", "notifications"}, manifest.Emits)
 }
 
+func TestManifestParsesEventFilter(t *testing.T) {
+	data := []byte(`
+name: filtered
+version: 1.0.0
+type: lua
+lua-plugin:
+  entry: main.lua
+events: [say]
+event_filter:
+  actor_kinds: [character]
+  payload:
+    target.name: Bob
+`)
+
+	manifest, err := plugins.ParseManifest(data)
+	require.NoError(t, err)
+	assert.Equal(t, plugins.EventFilter{
+		EventTypes: []string{"say"},
+		ActorKinds: []string{"character"},
+		Payload:    map[string]string{"target.name": "Bob"},
+	}, manifest.SubscriptionFilter())
+}
+
+func TestManifestRejectsUnknownEventFilterActorKind(t *testing.T) {
+	data := []byte(`
+name: filtered
+version: 1.0.0
+type: lua
+lua-plugin:
+  entry: main.lua
+event_filter:
+  actor_kinds: [robot]
+`)
+
+	_, err := plugins.ParseManifest(data)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "robot")
+}
+
 func TestManifestRejectsSettingPluginWithEmits(t *testing.T) {
 	data := []byte(`
 name: emit-setting
@@ -11,29 +11,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/holomush/holomush/internal/access"
-	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
 	"github.com/holomush/holomush/internal/plugin/pluginauthz"
 )
 
-// recordingEngine captures the AccessRequest it is handed and returns a fixed
-// decision, so a test can assert what resource AuthorizeStreamRead evaluates.
-type recordingEngine struct {
-	gotResource string
-	allow       bool
-}
-
-func (e *recordingEngine) Evaluate(_ context.Context, req types.AccessRequest) (types.Decision, error) {
-	e.gotResource = req.Resource
-	if e.allow {
-		return types.NewDecision(types.EffectAllow, "test", "test:permit"), nil
-	}
-	return types.NewDecision(types.EffectDefaultDeny, "test", ""), nil
-}
-
-func (e *recordingEngine) CanPerformAction(_ context.Context, _, _, _, _ string) (bool, error) {
-	return true, nil
-}
-
 // Verifies: INV-PLUGIN-50
 func TestAuthorizeStreamReadQualifiesRelativeStreamBeforeEvaluating(t *testing.T) {
 	// The plugin sends a DOMAIN-RELATIVE stream reference; the gate MUST qualify it
@@ -41,7 +22,8 @@ func TestAuthorizeStreamReadQualifiesRelativeStreamBeforeEvaluating(t *testing.T
 	// (keyed on the qualified resource.stream.name) can match. This is the bug
 	// holomush-xakba fixes: evaluating the un-qualified form let system reads slip
 	// past the forbid.
-	eng := &recordingEngine{allow: true}
+	eng := policytest.NewScenario(t)
+	eng.Allow(access.PluginSubject("p")).To("*").On("*")
 	dec, err := pluginauthz.AuthorizeStreamRead(context.Background(), pluginauthz.StreamReadInput{
 		Engine:     eng,
 		PluginName: "p",
@@ -51,14 +33,14 @@ func TestAuthorizeStreamReadQualifiesRelativeStreamBeforeEvaluating(t *testing.T
 	})
 	require.NoError(t, err)
 	assert.True(t, dec.Allowed)
-	assert.Equal(t, "stream:events.main.system.rekey.01CT000.01CID00", eng.gotResource,
-		"the ABAC resource must be the QUALIFIED stream so forbids can match")
+	// The ABAC resource must be the QUALIFIED stream so forbids can match.
+	eng.AssertEvaluated(access.PluginSubject("p"), "read", "stream:events.main.system.rekey.01CT000.01CID00")
 }
 
 // Verifies: INV-PLUGIN-50
 func TestAuthorizeStreamReadRejectsBeforeEngine(t *testing.T) {
-	// Inputs that must fail closed BEFORE the engine is consulted (the engine's
-	// gotResource must stay empty):
+	// Inputs that must fail closed BEFORE the engine is consulted (the engine
+	// must record no Evaluate call):
 	//   - unqualifiable refs (no gameID) → STREAM_QUALIFY_FAILED;
 	//   - wildcard subjects ('>' / '*') → a read-across-all-streams the
 	//     concrete-name system/audit/crypto forbids cannot match (fw118.4);
@@ -79,7 +61,8 @@ func TestAuthorizeStreamReadRejectsBeforeEngine(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			eng := &recordingEngine{allow: true}
+			eng := policytest.NewScenario(t)
+			eng.Allow(access.PluginSubject("p")).To("*").On("*")
 			_, err := pluginauthz.AuthorizeStreamRead(context.Background(), pluginauthz.StreamReadInput{
 				Engine:     eng,
 				PluginName: "p",
@@ -88,14 +71,14 @@ func TestAuthorizeStreamReadRejectsBeforeEngine(t *testing.T) {
 				Stream:     tc.stream,
 			})
 			require.Error(t, err, "%q must be rejected", tc.stream)
-			assert.Empty(t, eng.gotResource, "must be rejected before the engine is consulted")
+			eng.AssertNoEvaluations()
 		})
 	}
 }
 
 // Verifies: INV-PLUGIN-50
 func TestAuthorizeStreamReadDeniesWhenPolicyDenies(t *testing.T) {
-	eng := &recordingEngine{allow: false}
+	eng := policytest.NewScenario(t)
 	dec, err := pluginauthz.AuthorizeStreamRead(context.Background(), pluginauthz.StreamReadInput{
 		Engine:     eng,
 		PluginName: "p",
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
 	"github.com/holomush/holomush/internal/plugin/pluginauthz"
 	"github.com/holomush/holomush/pkg/errutil"
 )
@@ -56,7 +57,8 @@ func TestAuthorizePluginStreamContributionRejects(t *testing.T) {
 // accepts the calling plugin's own relative ref and QUALIFIES it before the ABAC
 // decision (review R2-A).
 func TestAuthorizeStreamSubscribePermitsOwnRelativeRef(t *testing.T) {
-	eng := &recordingEngine{allow: true}
+	eng := policytest.NewScenario(t)
+	eng.Allow(access.PluginSubject("core-channels")).To("*").On("*")
 	dec, err := pluginauthz.AuthorizeStreamSubscribe(context.Background(), pluginauthz.StreamSubscribeInput{
 		Engine:           eng,
 		PluginName:       "core-channels",
@@ -67,14 +69,15 @@ func TestAuthorizeStreamSubscribePermitsOwnRelativeRef(t *testing.T) {
 	})
 	require.NoError(t, err)
 	assert.True(t, dec.Allowed)
-	assert.Equal(t, "stream:events.main.channel.01CHAN0000000000000000000", eng.gotResource,
-		"the ABAC resource must be the QUALIFIED stream (R2-A) and the action write")
+	// The ABAC resource must be the QUALIFIED stream (R2-A) and the action write.
+	eng.AssertEvaluated(access.PluginSubject("core-channels"), "write", "stream:events.main.channel.01CHAN0000000000000000000")
 }
 
 // TestAuthorizeStreamSubscribeRejectsPreQualifiedSubject proves the guard rejects
 // a full events. subject with STREAM_NOT_RELATIVE BEFORE the engine (review R2-A).
 func TestAuthorizeStreamSubscribeRejectsPreQualifiedSubject(t *testing.T) {
-	eng := &recordingEngine{allow: true}
+	eng := policytest.NewScenario(t)
+	eng.Allow(access.PluginSubject("core-channels")).To("*").On("*")
 	_, err := pluginauthz.AuthorizeStreamSubscribe(context.Background(), pluginauthz.StreamSubscribeInput{
 		Engine:           eng,
 		PluginName:       "core-channels",
@@ -85,7 +88,7 @@ func TestAuthorizeStreamSubscribeRejectsPreQualifiedSubject(t *testing.T) {
 	})
 	require.Error(t, err)
 	errutil.AssertErrorCode(t, err, "STREAM_NOT_RELATIVE")
-	assert.Empty(t, eng.gotResource, "must be rejected before the engine is consulted")
+	eng.AssertNoEvaluations()
 }
 
 // TestAuthorizeStreamSubscribeRejectsForbiddenAndForeignInHandler proves that the
@@ -105,7 +108,9 @@ func TestAuthorizeStreamSubscribeRejectsForbiddenAndForeignInHandler(t *testing.
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			eng := &recordingEngine{allow: true} // engine would permit — proves in-handler fence denies
+			// The engine would permit — proves the in-handler fence denies.
+			eng := policytest.NewScenario(t)
+			eng.Allow(access.PluginSubject("core-channels")).To("*").On("*")
 			_, err := pluginauthz.AuthorizeStreamSubscribe(context.Background(), pluginauthz.StreamSubscribeInput{
 				Engine:           eng,
 				PluginName:       "core-channels",
@@ -116,7 +121,7 @@ func TestAuthorizeStreamSubscribeRejectsForbiddenAndForeignInHandler(t *testing.
 			})
 			require.Error(t, err)
 			errutil.AssertErrorCode(t, err, tc.wantCode)
-			assert.Empty(t, eng.gotResource, "in-handler fence must reject before the engine is consulted")
+			eng.AssertNoEvaluations()
 		})
 	}
 }
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package setup
+
+import (
+	"encoding/json"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	plugins "github.com/holomush/holomush/internal/plugin"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// worldPropertyTypes declares manifest property_types in the world
+// service's property schema registry, under the plugin's subject as
+// source.
+type worldPropertyTypes struct {
+	registry *world.PropertySchemaRegistry
+}
+
+var _ plugins.PropertyTypeRegistrar = worldPropertyTypes{}
+
+// DeclarePluginPropertyTypes implements plugins.PropertyTypeRegistrar.
+func (w worldPropertyTypes) DeclarePluginPropertyTypes(plugin string, specs []plugins.PropertyTypeSpec) error {
+	schemas := make([]world.PropertySchema, 0, len(specs))
+	for _, spec := range specs {
+		schema := world.PropertySchema{
+			Name:        spec.Name,
+			Type:        world.PropertyValueType(spec.Type),
+			Description: spec.Description,
+		}
+		if len(spec.Schema) > 0 {
+			raw, err := json.Marshal(spec.Schema)
+			if err != nil {
+				return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", spec.Name).Wrap(err)
+			}
+			schema.JSONSchema = raw
+		}
+		schemas = append(schemas, schema)
+	}
+	return w.registry.DeclareAll(access.PluginSubject(plugin), schemas)
+}
+
+// RemovePluginPropertyTypes implements plugins.PropertyTypeRegistrar.
+func (w worldPropertyTypes) RemovePluginPropertyTypes(plugin string) {
+	w.registry.RemoveSource(access.PluginSubject(plugin))
+}
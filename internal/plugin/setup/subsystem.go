@@ -19,22 +19,47 @@ import (
 
 	"github.com/holomush/holomush/internal/access/policy/attribute"
 	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/ambient"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/builder"
 	"github.com/holomush/holomush/internal/command"
 	"github.com/holomush/holomush/internal/command/commandquery"
 	"github.com/holomush/holomush/internal/command/handlers"
+	"github.com/holomush/holomush/internal/connhistory"
+	"github.com/holomush/holomush/internal/content"
 	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/economy"
 	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/game"
+	"github.com/holomush/holomush/internal/gameclock"
+	"github.com/holomush/holomush/internal/help"
+	"github.com/holomush/holomush/internal/jobs"
 	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/internal/motd"
+	"github.com/holomush/holomush/internal/npc"
+	"github.com/holomush/holomush/internal/paging"
 	plugins "github.com/holomush/holomush/internal/plugin"
+	"github.com/holomush/holomush/internal/plugin/deadletter"
 	"github.com/holomush/holomush/internal/plugin/goplugin"
 	"github.com/holomush/holomush/internal/plugin/hostcap"
 	"github.com/holomush/holomush/internal/plugin/hostfunc"
+	"github.com/holomush/holomush/internal/plugin/kvstore"
 	pluginlua "github.com/holomush/holomush/internal/plugin/lua"
 	"github.com/holomush/holomush/internal/plugin/pluginauthz"
+	"github.com/holomush/holomush/internal/posting"
+	"github.com/holomush/holomush/internal/preferences"
+	"github.com/holomush/holomush/internal/recovery"
+	"github.com/holomush/holomush/internal/report"
+	"github.com/holomush/holomush/internal/roles"
+	"github.com/holomush/holomush/internal/scheduler"
 	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/internal/settings"
 	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/internal/sysbroadcast"
 	tlscerts "github.com/holomush/holomush/internal/tls"
+	"github.com/holomush/holomush/internal/webhook"
 	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/traversal"
 	"github.com/holomush/holomush/internal/xdg"
 )
 
@@ -126,6 +151,16 @@ type PluginSubsystemConfig struct {
 	// MUST be declared in the target plugin's manifest config schema (else
 	// PLUGIN_CONFIG_UNKNOWN_KEY at load).
 	PluginConfigOverrides map[string]map[string]string
+	// Currencies are the game's currency codes (game.currencies); empty
+	// leaves the economy with only its default currency.
+	Currencies []string
+	// PageFallback is what happens to a page for a character who is not
+	// connected (game.page_offline_fallback): "mail" holds it until they
+	// log in, "none" refuses it. Empty means "mail".
+	PageFallback string
+	// Clock is the in-character game clock (game.clock). Nil disables the
+	// time command, description time tokens, and dawn and dusk.
+	Clock *gameclock.Clock
 }
 
 // PluginSubsystem manages the plugin Manager, Lua host, core plugin
@@ -143,6 +178,25 @@ type PluginSubsystem struct {
 	aliasPool         *pgxpool.Pool
 	aliasRepo         *store.PostgresAliasRepository
 	aliasCache        *command.AliasCache
+	scheduler         *scheduler.Scheduler // nil when no database is configured
+	jobs              *jobs.Queue          // nil when no database is configured
+	deadLetters       *deadletter.Queue    // nil when no database is configured
+	pluginStorage     *kvstore.Service     // nil when no database is configured
+	webhooks          *webhook.Dispatcher  // nil when no database is configured
+	help              *help.Service        // nil when no database is configured
+	motd              *motd.Service        // nil when no database is configured
+	ambient           *ambient.Service     // nil when no database is configured
+	economy           *economy.Service     // nil when no database is configured
+	paging            *paging.Service      // nil when no database or session store is configured
+	reports           *report.Service      // nil when no database is configured
+	roles             *roles.Service       // nil when no database is configured
+	npcs              *npc.Service         // nil when no database or world service is configured
+	posting           *posting.Service     // nil when no database is configured
+	connections       *connhistory.Service // nil when no database is configured
+	traversal         *traversal.Service   // nil when no world service is configured
+	daylight          *gameclock.Announcer // nil when no clock or world service is configured
+	preferences       *preferences.Service // nil when no player repository is configured
+	recovery          *recovery.Service    // nil when no database or auth repositories are configured
 }
 
 // NewPluginSubsystem creates a plugin subsystem configured with cfg.
@@ -294,6 +348,19 @@ func (s *PluginSubsystem) Prepare(ctx context.Context) error {
 			s.aliasPool = nil
 			s.aliasRepo = nil
 			s.aliasCache = nil
+			s.help = nil
+			s.motd = nil
+			s.ambient = nil
+			s.economy = nil
+			s.paging = nil
+			s.reports = nil
+			s.roles = nil
+			s.npcs = nil
+			s.posting = nil
+			s.recovery = nil
+			s.deadLetters = nil
+			s.pluginStorage = nil
+			s.webhooks = nil
 		}
 		if s.schemaProvisioner != nil {
 			s.schemaProvisioner.Close()
@@ -408,6 +475,128 @@ func (s *PluginSubsystem) Prepare(ctx context.Context) error {
 		s.aliasPool = aliasPool
 		s.aliasRepo = store.NewPostgresAliasRepository(aliasPool)
 		s.aliasCache = command.NewAliasCache()
+		// Scheduled world events share the alias pool; the dispatcher is
+		// bound later by ConfigureScheduler once the publisher exists.
+		s.scheduler = scheduler.NewScheduler(scheduler.Config{}, scheduler.NewPostgresStore(aliasPool))
+		// So do background jobs; handlers are registered by the gRPC
+		// subsystem and the workers launch in its Activate.
+		s.jobs = jobs.NewQueue(jobs.Config{}, jobs.NewPostgresStore(aliasPool))
+		// And plugin dead letters; requeue and staff alerts are bound by
+		// ConfigureDeadLetters once the publisher exists.
+		s.deadLetters = deadletter.NewQueue(deadletter.Config{}, deadletter.NewPostgresStore(aliasPool))
+		// And plugin key-value storage, which Lua plugins reach through
+		// holomush.storage_* and staff inspect with pluginstore.
+		s.pluginStorage = kvstore.NewService(kvstore.Config{}, kvstore.NewPostgresStore(aliasPool))
+		s.luaHost.SetPluginStorage(s.pluginStorage)
+		// And outbound webhooks; the gRPC subsystem taps its publisher with
+		// the dispatcher and launches the delivery workers in Activate.
+		s.webhooks = webhook.NewDispatcher(webhook.Config{}, webhook.NewPostgresStore(aliasPool))
+		// Help topics share it too; plugins read them through
+		// holomush.help_topic and staff edit them with helpedit.
+		helpService, helpErr := help.NewService(help.NewPostgresStore(aliasPool), s.cfg.ABAC.Engine(), slog.Default())
+		if helpErr != nil {
+			cleanupOnError()
+			return oops.Code("HELP_SERVICE_FAILED").Wrap(helpErr)
+		}
+		s.help = helpService
+		s.luaHost.SetHelpTopics(helpService)
+		// Connect screens and the message of the day live in the content
+		// store; announcements and seen markers in their own tables. The
+		// publisher is bound later by ConfigureMOTD.
+		motdService, motdErr := motd.NewService(motd.NewPostgresStore(aliasPool),
+			content.NewPostgresStore(aliasPool), s.cfg.ABAC.Engine(), slog.Default())
+		if motdErr != nil {
+			cleanupOnError()
+			return oops.Code("MOTD_SERVICE_FAILED").Wrap(motdErr)
+		}
+		s.motd = motdService
+		// Ambient location lines share the pool; the publisher and the
+		// session store that gates them on presence are bound later by
+		// ConfigureAmbient.
+		ambientService, ambientErr := ambient.NewService(ambient.NewPostgresStore(aliasPool),
+			s.cfg.ABAC.Engine(), slog.Default())
+		if ambientErr != nil {
+			cleanupOnError()
+			return oops.Code("AMBIENT_SERVICE_FAILED").Wrap(ambientErr)
+		}
+		s.ambient = ambientService
+		// Balances and the ledger share the pool as well; names resolve
+		// through the world as the payer sees it. The publisher for
+		// currency_transfer events is bound later by ConfigureEconomy.
+		if ws := s.cfg.World.Service(); ws != nil {
+			economyService, economyErr := economy.NewService(economy.NewPostgresStore(aliasPool), ws,
+				s.cfg.ABAC.Engine(), s.cfg.Currencies, slog.Default())
+			if economyErr != nil {
+				cleanupOnError()
+				return oops.Code("ECONOMY_SERVICE_FAILED").Wrap(economyErr)
+			}
+			s.economy = economyService
+		}
+		// Block lists and held pages share the pool too; the paging service
+		// needs the session store to tell who is connected and the world to
+		// resolve names as the sender sees them. Its publisher is bound later
+		// by ConfigurePaging.
+		if ws := s.cfg.World.Service(); sessionStore != nil && ws != nil {
+			pagingService, pagingErr := paging.NewService(paging.NewPostgresStore(aliasPool), ws,
+				sessionStore, s.cfg.PageFallback, slog.Default())
+			if pagingErr != nil {
+				cleanupOnError()
+				return oops.Code("PAGING_SERVICE_FAILED").Wrap(pagingErr)
+			}
+			s.paging = pagingService
+		}
+		// Player reports share the pool; the session store, when there is
+		// one, supplies the scene a reporter is posing in. The publisher for
+		// status notifications is bound later by ConfigureReports.
+		reportService, reportErr := report.NewService(report.NewPostgresStore(aliasPool),
+			sessionStore, s.cfg.ABAC.Engine(), slog.Default())
+		if reportErr != nil {
+			cleanupOnError()
+			return oops.Code("REPORT_SERVICE_FAILED").Wrap(reportErr)
+		}
+		s.reports = reportService
+		// Staff grant and revoke character roles with the role command;
+		// policies see the change on the next request.
+		if ws := s.cfg.World.Service(); ws != nil {
+			rolesService, rolesErr := roles.NewService(roles.NewPostgresStore(aliasPool), ws, slog.Default())
+			if rolesErr != nil {
+				cleanupOnError()
+				return oops.Code("ROLES_SERVICE_FAILED").Wrap(rolesErr)
+			}
+			s.roles = rolesService
+		}
+		// NPCs share the pool and walk the world's exits. The publisher
+		// and the plugin host their behaviors run in are bound later by
+		// ConfigureNPCs.
+		if ws := s.cfg.World.Service(); ws != nil {
+			npcService, npcErr := npc.NewService(npc.NewPostgresStore(aliasPool), s.cfg.ABAC.Engine(), ws, slog.Default())
+			if npcErr != nil {
+				cleanupOnError()
+				return oops.Code("NPC_SERVICE_FAILED").Wrap(npcErr)
+			}
+			s.npcs = npcService
+		}
+		// Posting rules share the pool. The event emitter consults them
+		// before every publish; the publisher approved posts go out through
+		// is bound later by ConfigurePosting.
+		if ws := s.cfg.World.Service(); ws != nil {
+			postingService, postingErr := posting.NewService(posting.NewPostgresStore(aliasPool), ws,
+				s.cfg.ABAC.Engine(), slog.Default())
+			if postingErr != nil {
+				cleanupOnError()
+				return oops.Code("POSTING_SERVICE_FAILED").Wrap(postingErr)
+			}
+			s.posting = postingService
+		}
+		// who <character> reads the connection history the gRPC server
+		// records, under the same read_connections check.
+		connectionsService, connectionsErr := connhistory.NewService(connhistory.NewPostgresStore(aliasPool),
+			s.cfg.ABAC.Engine(), slog.Default())
+		if connectionsErr != nil {
+			cleanupOnError()
+			return oops.Code("CONNHISTORY_SERVICE_FAILED").Wrap(connectionsErr)
+		}
+		s.connections = connectionsService
 	}
 
 	// 8. Create Manager, register hosts.
@@ -440,6 +629,9 @@ func (s *PluginSubsystem) Prepare(ctx context.Context) error {
 	if s.aliasRepo != nil && s.aliasCache != nil {
 		managerOpts = append(managerOpts, plugins.WithAliasSeeder(s.aliasRepo, s.aliasCache))
 	}
+	if ws := s.cfg.World.Service(); ws != nil {
+		managerOpts = append(managerOpts, plugins.WithPropertyTypeRegistrar(worldPropertyTypes{registry: ws.PropertySchemas()}))
+	}
 	mgr, mgrErr := plugins.NewManager(pluginsDir, managerOpts...)
 	if mgrErr != nil {
 		cleanupOnError()
@@ -479,6 +671,116 @@ func (s *PluginSubsystem) Prepare(ctx context.Context) error {
 	handlers.RegisterAll(s.cmdRegistry)
 	adminDeps := s.cfg.AdminDeps.AdminDeps()
 	adminDeps.PluginLister = s.manager
+	if s.scheduler != nil {
+		adminDeps.Scheduler = s.scheduler
+	}
+	if s.jobs != nil {
+		adminDeps.Jobs = s.jobs
+	}
+	if s.deadLetters != nil {
+		adminDeps.DeadLetters = s.deadLetters
+	}
+	if s.pluginStorage != nil {
+		adminDeps.PluginStorage = s.pluginStorage
+	}
+	if s.webhooks != nil {
+		adminDeps.Webhooks = s.webhooks
+	}
+	if s.help != nil {
+		adminDeps.Help = s.help
+	}
+	if s.motd != nil {
+		adminDeps.MOTD = s.motd
+	}
+	if s.economy != nil {
+		adminDeps.Economy = s.economy
+	}
+	if s.paging != nil {
+		adminDeps.Paging = s.paging
+	}
+	if s.reports != nil {
+		adminDeps.Reports = s.reports
+	}
+	if s.roles != nil {
+		adminDeps.Roles = s.roles
+	}
+	if s.npcs != nil {
+		adminDeps.NPCs = s.npcs
+	}
+	if s.posting != nil {
+		adminDeps.Posting = s.posting
+	}
+	if s.connections != nil {
+		adminDeps.Connections = s.connections
+	}
+	if sessionStore != nil {
+		adminDeps.Who = sessionStore
+	}
+	if adminDeps.PlayerRepo != nil {
+		// Client preferences live in the players.preferences host
+		// partition; the publisher for preferences_changed events is bound
+		// later by ConfigurePreferences.
+		prefsService, prefsErr := preferences.NewService(
+			settings.NewRepoPlayerSettingsStore(adminDeps.PlayerRepo), slog.Default())
+		if prefsErr != nil {
+			return oops.Code("PREFERENCES_SERVICE_FAILED").Wrap(prefsErr)
+		}
+		s.preferences = prefsService
+		adminDeps.Preferences = prefsService
+		if s.motd != nil {
+			// Login notices are rendered in each player's locale.
+			s.motd.SetLocales(prefsService)
+		}
+	}
+	if s.aliasPool != nil && adminDeps.PlayerRepo != nil && adminDeps.ResetRepo != nil &&
+		adminDeps.PlayerSessions != nil && adminDeps.Hasher != nil {
+		// Account recovery shares the pool; an approval issues an ordinary
+		// password reset, redeemed through the same reset service as an
+		// emailed token.
+		resets, resetsErr := auth.NewPasswordResetService(adminDeps.PlayerRepo, adminDeps.ResetRepo,
+			adminDeps.PlayerSessions, adminDeps.Hasher)
+		if resetsErr != nil {
+			cleanupOnError()
+			return oops.Code("RECOVERY_SERVICE_FAILED").Wrap(resetsErr)
+		}
+		recoveryService, recoveryErr := recovery.NewService(recovery.NewPostgresStore(s.aliasPool),
+			adminDeps.PlayerRepo, adminDeps.ResetRepo, resets, s.cfg.ABAC.Engine(), slog.Default())
+		if recoveryErr != nil {
+			cleanupOnError()
+			return oops.Code("RECOVERY_SERVICE_FAILED").Wrap(recoveryErr)
+		}
+		if adminDeps.SecurityLog != nil {
+			resets.SetSecurityRecorder(adminDeps.SecurityLog)
+			recoveryService.SetSecurityRecorder(adminDeps.SecurityLog)
+		}
+		s.recovery = recoveryService
+		adminDeps.Recovery = recoveryService
+	}
+	if ws := s.cfg.World.Service(); ws != nil {
+		adminDeps.Visibility = ws
+		adminDeps.Zones = ws
+		adminDeps.Capacity = ws
+		adminDeps.Verbs = ws
+		adminDeps.Appearance = ws
+		adminDeps.Exits = ws
+		adminDeps.WhoVisibility = ws
+		adminDeps.WhoCharacters = ws
+		adminDeps.Builder = builder.New(ws)
+		// Walks through exits; the publisher for their departure and
+		// arrival events is bound later by ConfigureTraversal.
+		s.traversal = traversal.NewService(ws)
+		adminDeps.Traversal = s.traversal
+		// Descriptions read the game clock through %time% and friends, and
+		// outdoor locations see dawn and dusk; the publisher for those is
+		// bound later by ConfigureDaylight.
+		if s.cfg.Clock != nil {
+			ws.SetTextTokens(s.cfg.Clock)
+			s.daylight = gameclock.NewAnnouncer(s.cfg.Clock, ws, slog.Default())
+		}
+	}
+	if s.cfg.Clock != nil {
+		adminDeps.Clock = s.cfg.Clock
+	}
 	handlers.RegisterAdmin(s.cmdRegistry, adminDeps)
 
 	// Register plugin-provided commands.
@@ -571,6 +873,24 @@ func (s *PluginSubsystem) Stop(_ context.Context) error {
 	}
 	s.aliasRepo = nil
 	s.aliasCache = nil
+	s.help = nil
+	s.motd = nil
+	s.ambient = nil
+	s.economy = nil
+	s.paging = nil
+	s.reports = nil
+	s.roles = nil
+	s.npcs = nil
+	s.posting = nil
+	s.recovery = nil
+	s.deadLetters = nil
+	s.pluginStorage = nil
+	s.webhooks = nil
+	if s.traversal != nil {
+		s.traversal.Close()
+		s.traversal = nil
+	}
+	s.daylight = nil
 	s.cmdRegistry = nil
 	s.commandQuerier = nil
 	s.health = nil
@@ -603,6 +923,269 @@ func (s *PluginSubsystem) ConfigureSystemBroadcaster(pub eventbus.Publisher, gam
 	s.luaHost.SetSessionAdmin(hostcap.NewSystemBroadcaster(pub, gameID))
 }
 
+// ConfigureDiceRoller wires the server-side dice roller and stat checks into
+// the Lua host so holomush.roll, holomush.check, and holomush.contest publish
+// their results over pub. Like ConfigureSystemBroadcaster it MUST be called
+// from the gRPC subsystem's Prepare once the publisher exists. No-op when the
+// Lua host is not yet built or pub/gameID is nil (the functions then report
+// dice or checks not available); checks also need the world service.
+func (s *PluginSubsystem) ConfigureDiceRoller(pub eventbus.Publisher, gameID func() string) {
+	if s.luaHost == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.luaHost.SetDiceRoller(game.NewDiceService(pub, gameID))
+	if ws := s.cfg.World.Service(); ws != nil {
+		s.luaHost.SetCheckRoller(game.NewCheckService(ws, pub, gameID))
+	}
+}
+
+// ConfigureScheduler binds the scheduled-job dispatcher: emit jobs publish
+// system events over pub, plugin jobs are delivered through the plugin
+// Manager. Like ConfigureSystemBroadcaster it MUST be called from the gRPC
+// subsystem's Prepare once the publisher exists. No-op when no database is
+// configured or pub/gameID is nil (due jobs then stay pending).
+func (s *PluginSubsystem) ConfigureScheduler(pub eventbus.Publisher, gameID func() string) {
+	if s.scheduler == nil || s.manager == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.scheduler.SetDispatcher(scheduler.NewActionDispatcher(sysbroadcast.NewBroadcaster(pub, gameID), s.manager))
+}
+
+// ConfigureMOTD binds the publisher the message-of-the-day service uses for
+// login notices and announcement broadcasts. Like ConfigureScheduler it MUST
+// be called from the gRPC subsystem's Prepare once the publisher exists.
+// No-op when no database is configured or pub/gameID is nil (login notices
+// and announcement broadcasts are then skipped).
+func (s *PluginSubsystem) ConfigureMOTD(pub eventbus.Publisher, gameID func() string) {
+	if s.motd == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.motd.SetPublisher(pub, gameID)
+}
+
+// ConfigureAmbient binds the publisher ambient lines are sent through and
+// the session store that tells the service which locations are occupied.
+// Like ConfigureMOTD it MUST be called from the gRPC subsystem's Prepare
+// once the publisher exists. No-op when no database is configured or any
+// argument is nil (schedules and lines are still editable; nothing is sent).
+func (s *PluginSubsystem) ConfigureAmbient(pub eventbus.Publisher, gameID func() string, sessions ambient.Sessions) {
+	if s.ambient == nil || pub == nil || gameID == nil || sessions == nil {
+		return
+	}
+	s.ambient.SetDelivery(pub, gameID, sessions)
+}
+
+// ConfigureNPCs binds the publisher NPCs act through and hands their
+// behaviors to the plugin Manager. Like ConfigureMOTD it MUST be called from
+// the gRPC subsystem's Prepare once the publisher exists. No-op when NPCs are
+// not configured or pub/gameID is nil (NPCs can still be spawned and edited;
+// they neither wander nor react).
+func (s *PluginSubsystem) ConfigureNPCs(pub eventbus.Publisher, gameID func() string) {
+	if s.npcs == nil || s.manager == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.npcs.SetDelivery(pub, gameID, s.manager)
+}
+
+// ConfigurePosting binds the publisher approved held posts are sent
+// through. Like ConfigureMOTD it MUST be called from the gRPC subsystem's
+// Prepare once the publisher exists. No-op when no database is configured
+// or pub is nil (rules are still enforced; approval fails).
+func (s *PluginSubsystem) ConfigurePosting(pub eventbus.Publisher) {
+	if s.posting == nil || pub == nil {
+		return
+	}
+	s.posting.SetPublisher(pub)
+}
+
+// ConfigureEconomy binds the publisher the economy service uses to announce
+// committed transactions to the characters involved. Like ConfigureMOTD it
+// MUST be called from the gRPC subsystem's Prepare once the publisher
+// exists. No-op when no database is configured or pub/gameID is nil
+// (transactions still commit; the events are skipped).
+func (s *PluginSubsystem) ConfigureEconomy(pub eventbus.Publisher, gameID func() string) {
+	if s.economy == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.economy.SetPublisher(pub, gameID)
+}
+
+// ConfigurePaging binds the publisher the paging service delivers pages and
+// receipts through. Like ConfigureMOTD it MUST be called from the gRPC
+// subsystem's Prepare once the publisher exists. No-op when paging is not
+// configured or pub/gameID is nil (the page command then reports paging as
+// unavailable).
+func (s *PluginSubsystem) ConfigurePaging(pub eventbus.Publisher, gameID func() string) {
+	if s.paging == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.paging.SetPublisher(pub, gameID)
+}
+
+// ConfigurePreferences binds the publisher the preferences service uses to
+// tell transports a player's preferences at login and after each change.
+// Like ConfigureMOTD it MUST be called from the gRPC subsystem's Prepare
+// once the publisher exists. No-op when no player repository is configured
+// or pub/gameID is nil (changes are still stored; the events are skipped).
+func (s *PluginSubsystem) ConfigurePreferences(pub eventbus.Publisher, gameID func() string) {
+	if s.preferences == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.preferences.SetPublisher(pub, gameID)
+}
+
+// ConfigureReports binds the publisher the report service uses to tell
+// reporters their reports were claimed or resolved. Like ConfigureMOTD it
+// MUST be called from the gRPC subsystem's Prepare once the publisher
+// exists. No-op when no database is configured or pub/gameID is nil
+// (triage still works; the notifications are skipped).
+func (s *PluginSubsystem) ConfigureReports(pub eventbus.Publisher, gameID func() string) {
+	if s.reports == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.reports.SetPublisher(pub, gameID)
+}
+
+// ConfigureJobs binds the publisher the background job queue uses to tell
+// submitters their jobs have finished. Like ConfigureMOTD it MUST be called
+// from the gRPC subsystem's Prepare once the publisher exists. No-op when no
+// database is configured or pub/gameID is nil (jobs still run; the events
+// are skipped).
+func (s *PluginSubsystem) ConfigureJobs(pub eventbus.Publisher, gameID func() string) {
+	if s.jobs == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.jobs.SetPublisher(pub, gameID)
+}
+
+// ConfigureDeadLetters binds the plugin manager the dead-letter queue
+// requeues through and the publisher it alerts staff on. Like ConfigureJobs
+// it MUST be called from the gRPC subsystem's Prepare once the publisher
+// exists. No-op when no database is configured or pub/gameID is nil (dead
+// letters are still captured; requeue is unavailable and alerts are only
+// logged).
+func (s *PluginSubsystem) ConfigureDeadLetters(pub eventbus.Publisher, gameID func() string) {
+	if s.deadLetters == nil || s.manager == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.deadLetters.SetDeliverer(s.manager)
+	s.deadLetters.SetAlerter(sysbroadcast.NewBroadcaster(pub, gameID))
+}
+
+// ConfigureTraversal binds the publisher the traversal service uses to
+// announce departures, arrivals, and stopped walks. Like ConfigureJobs it
+// MUST be called from the gRPC subsystem's Prepare once the publisher exists.
+// No-op when no world service is configured or pub/gameID is nil (characters
+// still move; the events are skipped).
+func (s *PluginSubsystem) ConfigureTraversal(pub eventbus.Publisher, gameID func() string) {
+	if s.traversal == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.traversal.SetPublisher(pub, gameID)
+}
+
+// ConfigureDaylight binds the publisher dawn and dusk are announced
+// through. Like ConfigureTraversal it MUST be called from the gRPC
+// subsystem's Prepare once the publisher exists. No-op when no clock or
+// world service is configured or pub/gameID is nil (the clock still runs;
+// dawn and dusk pass unannounced).
+func (s *PluginSubsystem) ConfigureDaylight(pub eventbus.Publisher, gameID func() string) {
+	if s.daylight == nil || pub == nil || gameID == nil {
+		return
+	}
+	s.daylight.SetPublisher(pub, gameID)
+}
+
+// Daylight returns the dawn and dusk announcer, or nil when no clock or
+// world service is configured.
+func (s *PluginSubsystem) Daylight() *gameclock.Announcer {
+	return s.daylight
+}
+
+// SetLuaLimits replaces the per-invocation CPU deadline and per-state registry
+// bound for Lua plugins, e.g. on a config reload. Each applies from the next
+// delivery; calls already running keep their limits. No-op before Prepare
+// builds the Lua host.
+func (s *PluginSubsystem) SetLuaLimits(timeout time.Duration, registryMaxSize int) {
+	if s.luaHost == nil {
+		return
+	}
+	s.luaHost.SetCPUTimeout(timeout)
+	s.luaHost.SetRegistryMaxSize(registryMaxSize)
+}
+
+// Scheduler returns the scheduled world events service, or nil when no
+// database is configured.
+func (s *PluginSubsystem) Scheduler() *scheduler.Scheduler {
+	return s.scheduler
+}
+
+// Jobs returns the background job queue, or nil when no database is
+// configured.
+func (s *PluginSubsystem) Jobs() *jobs.Queue {
+	return s.jobs
+}
+
+// DeadLetters returns the plugin dead-letter queue, the sink for event
+// deliveries a plugin keeps failing, or nil when no database is configured.
+func (s *PluginSubsystem) DeadLetters() *deadletter.Queue {
+	return s.deadLetters
+}
+
+// Webhooks returns the outbound webhook dispatcher, or nil when no
+// database is configured.
+func (s *PluginSubsystem) Webhooks() *webhook.Dispatcher {
+	return s.webhooks
+}
+
+// MOTD returns the message-of-the-day service, or nil when no database is
+// configured.
+func (s *PluginSubsystem) MOTD() *motd.Service {
+	return s.motd
+}
+
+// Economy returns the economy service, or nil when no database is
+// configured.
+func (s *PluginSubsystem) Economy() *economy.Service {
+	return s.economy
+}
+
+// Ambient returns the ambient location message service, or nil when no
+// database is configured.
+func (s *PluginSubsystem) Ambient() *ambient.Service {
+	return s.ambient
+}
+
+// NPCs returns the NPC service, or nil when no database or world service is
+// configured.
+func (s *PluginSubsystem) NPCs() *npc.Service {
+	return s.npcs
+}
+
+// Posting returns the posting rule service, or nil when no database is
+// configured.
+func (s *PluginSubsystem) Posting() *posting.Service {
+	return s.posting
+}
+
+// Paging returns the paging service, or nil when no database or session
+// store is configured.
+func (s *PluginSubsystem) Paging() *paging.Service {
+	return s.paging
+}
+
+// Preferences returns the client preferences service, or nil when no
+// player repository is configured.
+func (s *PluginSubsystem) Preferences() *preferences.Service {
+	return s.preferences
+}
+
+// Reports returns the player report service, or nil when no database is
+// configured.
+func (s *PluginSubsystem) Reports() *report.Service {
+	return s.reports
+}
+
 // CommandRegistry returns the command Registry. Panics if called before Prepare().
 func (s *PluginSubsystem) CommandRegistry() *command.Registry {
 	if s.cmdRegistry == nil {
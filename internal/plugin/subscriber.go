@@ -11,20 +11,45 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/oops"
+
 	"github.com/holomush/holomush/internal/core"
 	pluginsdk "github.com/holomush/holomush/pkg/plugin"
 )
 
+// eventDeliveryTimeout bounds one delivery of an event to a plugin.
+const eventDeliveryTimeout = 5 * time.Second
+
+// Default DeadLetterPolicy values.
+const (
+	defaultDeliveryAttempts = 3
+	defaultRetryDelay       = 100 * time.Millisecond
+)
+
 // EventEmitter publishes events from plugins.
 type EventEmitter interface {
 	EmitPluginEvent(ctx context.Context, pluginName string, event pluginsdk.EmitEvent) error
 }
 
+// DeadLetterSink keeps the events a plugin failed to handle.
+// *deadletter.Queue satisfies it.
+type DeadLetterSink interface {
+	// Capture stores event as undeliverable to pluginName after attempts
+	// failed deliveries, the last failing with reason.
+	Capture(ctx context.Context, pluginName string, event pluginsdk.Event, reason string, attempts int) error
+}
+
+// DeadLetterPolicy bounds redelivery before an event is dead-lettered.
+type DeadLetterPolicy struct {
+	MaxAttempts int           // deliveries of an event whose handler errors (default: 3)
+	RetryDelay  time.Duration // pause before the second delivery, doubling after each (default: 100ms)
+}
+
 // subscription tracks which events a plugin wants.
 type subscription struct {
 	pluginName string
 	stream     string
-	eventTypes map[string]bool // empty = all events
+	filter     eventPredicate
 }
 
 // Subscriber dispatches events to plugins.
@@ -32,6 +57,8 @@ type Subscriber struct {
 	host          Host
 	emitter       EventEmitter
 	subscriptions []subscription
+	deadLetters   DeadLetterSink
+	policy        DeadLetterPolicy
 	mu            sync.RWMutex
 	wg            sync.WaitGroup
 }
@@ -44,20 +71,49 @@ func NewSubscriber(host Host, emitter EventEmitter) *Subscriber {
 	}
 }
 
-// Subscribe registers a plugin to receive events.
+// Subscribe registers a plugin to receive events of the given types on
+// stream. An empty eventTypes receives every event.
 func (s *Subscriber) Subscribe(pluginName, stream string, eventTypes []string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.add(pluginName, stream, EventFilter{EventTypes: eventTypes}.compile())
+}
+
+// SubscribeFiltered registers a plugin to receive the events on stream that
+// pass filter. Filtering happens here, before delivery, so dropped events
+// never cross the plugin boundary.
+func (s *Subscriber) SubscribeFiltered(pluginName, stream string, filter EventFilter) error {
+	if err := filter.Validate(); err != nil {
+		return oops.With("plugin", pluginName).With("stream", stream).Wrap(err)
+	}
+	s.add(pluginName, stream, filter.compile())
+	return nil
+}
 
-	typeSet := make(map[string]bool)
-	for _, t := range eventTypes {
-		typeSet[t] = true
+// SetDeadLetters routes the events plugins fail to handle to sink. A
+// delivery whose handler errors is retried up to policy.MaxAttempts times
+// with backoff, then dead-lettered; one that times out is dead-lettered
+// at once. Deliveries canceled by shutdown before the handler failed are
+// not. Without a sink (the default) each event is delivered once and a
+// failure is only logged.
+func (s *Subscriber) SetDeadLetters(sink DeadLetterSink, policy DeadLetterPolicy) {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaultDeliveryAttempts
+	}
+	if policy.RetryDelay <= 0 {
+		policy.RetryDelay = defaultRetryDelay
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deadLetters = sink
+	s.policy = policy
+}
 
+func (s *Subscriber) add(pluginName, stream string, filter eventPredicate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.subscriptions = append(s.subscriptions, subscription{
 		pluginName: pluginName,
 		stream:     stream,
-		eventTypes: typeSet,
+		filter:     filter,
 	})
 }
 
@@ -89,11 +145,23 @@ func (s *Subscriber) dispatch(ctx context.Context, event pluginsdk.Event) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	// Decode the payload at most once, and only if a filter inspects it.
+	var (
+		fields  map[string]any
+		decoded bool
+	)
+	payload := func() map[string]any {
+		if !decoded {
+			fields, decoded = decodePayload(event.Payload), true
+		}
+		return fields
+	}
+
 	for _, sub := range s.subscriptions {
 		if sub.stream != event.Stream {
 			continue
 		}
-		if len(sub.eventTypes) > 0 && !sub.eventTypes[string(event.Type)] {
+		if !sub.filter.matches(event, payload) {
 			continue
 		}
 
@@ -105,57 +173,125 @@ func (s *Subscriber) deliverAsync(ctx context.Context, pluginName string, event
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
-		// Use timeout for plugin execution
-		tctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
-
-		// Stamp the host-vouched actor on the dispatch ctx BEFORE calling
-		// Host.DeliverEvent. This activates the actor-metadata channel for
-		// the host's outgoing metadata injection (host.go) and binary-plugin
-		// token issuance (per spec G7). The same ctx flows through to the
-		// post-deliver emit loop.
-		dispatchCtx := core.WithActor(tctx, actorFromIncomingEvent(event))
-
-		emits, err := s.host.DeliverEvent(dispatchCtx, pluginName, event)
-		if err != nil {
-			switch {
-			case errors.Is(err, context.DeadlineExceeded):
-				slog.Warn("plugin event delivery timed out",
-					"plugin", pluginName,
-					"event_id", event.ID,
-					"stream", event.Stream,
-					"event_type", string(event.Type),
-					"timeout", "5s")
-			case errors.Is(err, context.Canceled):
-				slog.Debug("plugin event delivery canceled",
-					"plugin", pluginName,
-					"event_id", event.ID)
-			default:
-				slog.Error("failed to deliver event to plugin",
-					"plugin", pluginName,
-					"event_id", event.ID,
-					"stream", event.Stream,
-					"event_type", string(event.Type),
-					"error", err)
+		s.mu.RLock()
+		sink, policy := s.deadLetters, s.policy
+		s.mu.RUnlock()
+		for attempt := 1; ; attempt++ {
+			err := s.deliver(ctx, pluginName, event)
+			if err == nil {
+				return
 			}
-			return
-		}
-
-		// Emit response events. Event type validation is the responsibility
-		// of the VerbRegistry, not the subscriber. The subscriber passes
-		// through any event type the plugin emits.
-		for _, emit := range emits {
-			if err := s.emitter.EmitPluginEvent(dispatchCtx, pluginName, emit); err != nil {
-				slog.ErrorContext(tctx, "failed to emit plugin event",
-					"plugin", pluginName,
-					"stream", emit.Stream,
-					"error", err)
+			logDeliveryFailure(pluginName, event, attempt, err)
+			if sink == nil || errors.Is(err, context.Canceled) {
+				return
+			}
+			// A timed-out handler is not retried: each retry would hold a
+			// worker for the full timeout again.
+			if errors.Is(err, context.DeadlineExceeded) || attempt >= policy.MaxAttempts ||
+				!sleepCtx(ctx, policy.RetryDelay<<(attempt-1)) {
+				s.deadLetter(ctx, sink, pluginName, event, err, attempt)
+				return
 			}
 		}
 	}()
 }
 
-func actorFromIncomingEvent(event pluginsdk.Event) core.Actor {
+// deliver makes one delivery of event to pluginName and publishes what the
+// plugin emits. A failed emit is logged, not returned: the plugin handled
+// the event.
+func (s *Subscriber) deliver(ctx context.Context, pluginName string, event pluginsdk.Event) error {
+	// Use timeout for plugin execution
+	tctx, cancel := context.WithTimeout(ctx, eventDeliveryTimeout)
+	defer cancel()
+
+	// Stamp the host-vouched actor on the dispatch ctx BEFORE calling
+	// Host.DeliverEvent. This activates the actor-metadata channel for
+	// the host's outgoing metadata injection (host.go) and binary-plugin
+	// token issuance (per spec G7). The same ctx flows through to the
+	// post-deliver emit loop.
+	dispatchCtx := core.WithActor(tctx, ActorFromEvent(event))
+
+	emits, err := s.host.DeliverEvent(dispatchCtx, pluginName, event)
+	if err != nil {
+		return err //nolint:wrapcheck // logged and dead-lettered by deliverAsync
+	}
+
+	// Emit response events. Event type validation is the responsibility
+	// of the VerbRegistry, not the subscriber. The subscriber passes
+	// through any event type the plugin emits.
+	for _, emit := range emits {
+		if err := s.emitter.EmitPluginEvent(dispatchCtx, pluginName, emit); err != nil {
+			slog.ErrorContext(tctx, "failed to emit plugin event",
+				"plugin", pluginName,
+				"stream", emit.Stream,
+				"error", err)
+		}
+	}
+	return nil
+}
+
+func logDeliveryFailure(pluginName string, event pluginsdk.Event, attempt int, err error) {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		slog.Warn("plugin event delivery timed out",
+			"plugin", pluginName,
+			"event_id", event.ID,
+			"stream", event.Stream,
+			"event_type", string(event.Type),
+			"attempt", attempt,
+			"timeout", eventDeliveryTimeout.String())
+	case errors.Is(err, context.Canceled):
+		slog.Debug("plugin event delivery canceled",
+			"plugin", pluginName,
+			"event_id", event.ID)
+	default:
+		slog.Error("failed to deliver event to plugin",
+			"plugin", pluginName,
+			"event_id", event.ID,
+			"stream", event.Stream,
+			"event_type", string(event.Type),
+			"attempt", attempt,
+			"error", err)
+	}
+}
+
+// deadLetter hands an event the plugin failed to handle to sink. The
+// capture outlives ctx so an event whose retries were cut short by
+// shutdown is still kept.
+func (s *Subscriber) deadLetter(ctx context.Context, sink DeadLetterSink, pluginName string, event pluginsdk.Event, err error, attempts int) {
+	reason := err.Error()
+	if errors.Is(err, context.DeadlineExceeded) {
+		reason = "delivery timed out after " + eventDeliveryTimeout.String()
+	}
+	cctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), eventDeliveryTimeout)
+	defer cancel()
+	if captureErr := sink.Capture(cctx, pluginName, event, reason, attempts); captureErr != nil {
+		slog.ErrorContext(cctx, "failed to dead-letter plugin event; event dropped",
+			"plugin", pluginName,
+			"event_id", event.ID,
+			"stream", event.Stream,
+			"event_type", string(event.Type),
+			"reason", reason,
+			"error", captureErr)
+	}
+}
+
+// sleepCtx waits for d, returning false if ctx ends first.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// ActorFromEvent returns the host-vouched actor of an event delivered to a
+// plugin. Callers stamp it on the delivery ctx with core.WithActor so the
+// plugin's emits are attributed to whoever caused the event.
+func ActorFromEvent(event pluginsdk.Event) core.Actor {
 	actor := core.Actor{ID: event.ActorID}
 	switch event.ActorKind {
 	case pluginsdk.ActorCharacter:
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package plugins_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	plugins "github.com/holomush/holomush/internal/plugin"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// scriptedHost fails its first deliveries with errs, in order, then
+// succeeds.
+type scriptedHost struct {
+	subscriberHost
+	errs []error
+}
+
+func (h *scriptedHost) DeliverEvent(_ context.Context, _ string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.delivered = append(h.delivered, event)
+	if len(h.errs) == 0 {
+		return h.response, nil
+	}
+	err := h.errs[0]
+	h.errs = h.errs[1:]
+	return nil, err
+}
+
+// captured is one DeadLetterSink.Capture call.
+type captured struct {
+	plugin   string
+	event    pluginsdk.Event
+	reason   string
+	attempts int
+}
+
+// recordingSink is a DeadLetterSink that records captures.
+type recordingSink struct {
+	mu       sync.Mutex
+	captures []captured
+	err      error
+}
+
+func (r *recordingSink) Capture(_ context.Context, pluginName string, event pluginsdk.Event, reason string, attempts int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.captures = append(r.captures, captured{pluginName, event, reason, attempts})
+	return r.err
+}
+
+func (r *recordingSink) all() []captured {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]captured(nil), r.captures...)
+}
+
+// deliverOne runs a single event through a subscriber with a dead-letter
+// sink and waits for delivery, retries and capture to finish.
+func deliverOne(t *testing.T, host plugins.Host, sink plugins.DeadLetterSink) {
+	t.Helper()
+	sub := plugins.NewSubscriber(host, &subscriberEmitter{})
+	sub.Subscribe("test-plugin", "location:123", nil)
+	if sink != nil {
+		sub.SetDeadLetters(sink, plugins.DeadLetterPolicy{MaxAttempts: 3, RetryDelay: time.Millisecond})
+	}
+	events := make(chan pluginsdk.Event, 1)
+	sub.Start(context.Background(), events)
+	events <- pluginsdk.Event{ID: "01EVT", Stream: "location:123", Type: "say", Payload: `{"text":"hi"}`}
+	close(events)
+	sub.Stop()
+}
+
+func TestSubscriberDeadLettersAfterRepeatedFailures(t *testing.T) {
+	failure := errors.New("handler exploded")
+	host := &scriptedHost{errs: []error{failure, failure, failure, failure}}
+	sink := &recordingSink{}
+
+	deliverOne(t, host, sink)
+
+	assert.Equal(t, 3, host.deliveredCount(), "MaxAttempts deliveries")
+	got := sink.all()
+	require.Len(t, got, 1)
+	assert.Equal(t, "test-plugin", got[0].plugin)
+	assert.Equal(t, "01EVT", got[0].event.ID)
+	assert.Equal(t, `{"text":"hi"}`, got[0].event.Payload)
+	assert.Equal(t, "handler exploded", got[0].reason)
+	assert.Equal(t, 3, got[0].attempts)
+}
+
+func TestSubscriberRetrySucceedsWithoutDeadLetter(t *testing.T) {
+	host := &scriptedHost{errs: []error{errors.New("transient")}}
+	sink := &recordingSink{}
+
+	deliverOne(t, host, sink)
+
+	assert.Equal(t, 2, host.deliveredCount())
+	assert.Empty(t, sink.all())
+}
+
+func TestSubscriberDeadLettersTimeoutWithoutRetry(t *testing.T) {
+	host := &scriptedHost{errs: []error{fmt.Errorf("lua: %w", context.DeadlineExceeded)}}
+	sink := &recordingSink{}
+
+	deliverOne(t, host, sink)
+
+	assert.Equal(t, 1, host.deliveredCount(), "a timed-out handler is not retried")
+	got := sink.all()
+	require.Len(t, got, 1)
+	assert.Equal(t, "delivery timed out after 5s", got[0].reason)
+	assert.Equal(t, 1, got[0].attempts)
+}
+
+func TestSubscriberDoesNotDeadLetterCanceledDelivery(t *testing.T) {
+	host := &scriptedHost{errs: []error{context.Canceled}}
+	sink := &recordingSink{}
+
+	deliverOne(t, host, sink)
+
+	assert.Equal(t, 1, host.deliveredCount())
+	assert.Empty(t, sink.all())
+}
+
+func TestSubscriberSurvivesDeadLetterCaptureFailure(t *testing.T) {
+	failure := errors.New("handler exploded")
+	host := &scriptedHost{errs: []error{failure, failure, failure}}
+	sink := &recordingSink{err: errors.New("db down")}
+
+	deliverOne(t, host, sink)
+
+	assert.Len(t, sink.all(), 1, "capture attempted once; its failure is logged")
+}
+
+func TestSubscriberWithoutSinkDeliversOnce(t *testing.T) {
+	failure := errors.New("handler exploded")
+	host := &scriptedHost{errs: []error{failure, failure}}
+
+	deliverOne(t, host, nil)
+
+	assert.Equal(t, 1, host.deliveredCount())
+}
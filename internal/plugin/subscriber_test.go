@@ -559,3 +559,31 @@ func (h *slowSubscriberHost) deliveredCount() int {
 	defer h.mu.Unlock()
 	return len(h.delivered)
 }
+
+func TestSubscriberAppliesServerSideFilter(t *testing.T) {
+	host := &subscriberHost{}
+	sub := plugins.NewSubscriber(host, &subscriberEmitter{})
+	require.NoError(t, sub.SubscribeFiltered("test-plugin", "location:123", plugins.EventFilter{
+		EventTypes: []string{"say"},
+		ActorKinds: []string{"character"},
+		Payload:    map[string]string{"mode": "ooc"},
+	}))
+
+	events := make(chan pluginsdk.Event, 4)
+	sub.Start(context.Background(), events)
+	events <- pluginsdk.Event{ID: "1", Stream: "location:123", Type: "core-communication:say", Payload: `{"mode":"ooc"}`}
+	events <- pluginsdk.Event{ID: "2", Stream: "location:123", Type: "core-communication:say", Payload: `{"mode":"ic"}`}
+	events <- pluginsdk.Event{ID: "3", Stream: "location:123", Type: "core-communication:say", Payload: `{"mode":"ooc"}`, ActorKind: pluginsdk.ActorPlugin}
+	events <- pluginsdk.Event{ID: "4", Stream: "location:123", Type: "core-communication:pose", Payload: `{"mode":"ooc"}`}
+	close(events)
+	sub.Stop()
+
+	require.Equal(t, 1, host.deliveredCount())
+	assert.Equal(t, "1", host.delivered[0].ID)
+}
+
+func TestSubscriberRejectsInvalidFilter(t *testing.T) {
+	sub := plugins.NewSubscriber(&subscriberHost{}, &subscriberEmitter{})
+	err := sub.SubscribeFiltered("test-plugin", "location:123", plugins.EventFilter{ActorKinds: []string{"robot"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package posting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const heldColumns = `id, target_kind, target_id, subject, event_type, actor_id,
+	actor_name, payload, sensitive, held_at`
+
+// PostgresStore implements Repository against the posting_rules and
+// held_posts tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// GetRule returns the rule for target.
+func (s *PostgresStore) GetRule(ctx context.Context, target Target) (*Rule, error) {
+	var (
+		r            = Rule{Target: target}
+		mode         string
+		participants []byte
+		updatedAt    pgnanos.Time
+	)
+	err := s.pool.QueryRow(ctx, `
+		SELECT mode, participants, set_by, updated_at
+		  FROM posting_rules
+		 WHERE target_kind = $1 AND target_id = $2
+	`, string(target.Kind), target.ID.String()).Scan(&mode, &participants, &r.SetBy, &updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("POSTING_NOT_FOUND").With("target", target.String()).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("POSTING_STORE_FAILED").
+			With("operation", "get_rule").
+			With("target", target.String()).
+			Wrap(err)
+	}
+	if err := json.Unmarshal(participants, &r.Participants); err != nil {
+		return nil, oops.With("target", target.String()).With("operation", "unmarshal_participants").Wrap(err)
+	}
+	r.Mode = Mode(mode)
+	r.UpdatedAt = updatedAt.Time()
+	return &r, nil
+}
+
+// PutRule creates or replaces the rule for r.Target.
+func (s *PostgresStore) PutRule(ctx context.Context, r *Rule) error {
+	list := r.Participants
+	if list == nil {
+		list = []CharacterRef{}
+	}
+	participants, err := json.Marshal(list)
+	if err != nil {
+		return oops.With("operation", "marshal_participants").Wrap(err)
+	}
+	_, err = s.pool.Exec(ctx, `
+		INSERT INTO posting_rules (target_kind, target_id, mode, participants, set_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (target_kind, target_id) DO UPDATE
+		   SET mode = EXCLUDED.mode,
+		       participants = EXCLUDED.participants,
+		       set_by = EXCLUDED.set_by,
+		       updated_at = EXCLUDED.updated_at
+	`, string(r.Target.Kind), r.Target.ID.String(), string(r.Mode), participants, r.SetBy,
+		pgnanos.From(r.UpdatedAt))
+	if err != nil {
+		return oops.Code("POSTING_STORE_FAILED").
+			With("operation", "put_rule").
+			With("target", r.Target.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Hold queues p unless max posts already wait for its target. The count and
+// insert are one statement, so concurrent posters cannot overfill the queue
+// by more than a race's worth.
+func (s *PostgresStore) Hold(ctx context.Context, p *HeldPost, max int) error {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO held_posts (`+heldColumns+`)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		 WHERE (SELECT count(*) FROM held_posts WHERE target_kind = $2 AND target_id = $3) < $11
+	`, p.ID.String(), string(p.Target.Kind), p.Target.ID.String(), string(p.Subject),
+		string(p.EventType), p.ActorID.String(), p.ActorName, p.Payload, p.Sensitive,
+		pgnanos.From(p.HeldAt), max)
+	if err != nil {
+		return oops.Code("POSTING_STORE_FAILED").
+			With("operation", "hold").
+			With("target", p.Target.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("POSTING_QUEUE_FULL").
+			With("target", p.Target.String()).
+			Errorf("the moderation queue is full (%d posts waiting)", max)
+	}
+	return nil
+}
+
+// GetHeld returns the held post with id.
+func (s *PostgresStore) GetHeld(ctx context.Context, id ulid.ULID) (*HeldPost, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+heldColumns+` FROM held_posts WHERE id = $1`, id.String())
+	p, err := scanHeld(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("POSTING_NOT_FOUND").With("post_id", id.String()).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("POSTING_STORE_FAILED").
+			With("operation", "get_held").
+			With("post_id", id.String()).
+			Wrap(err)
+	}
+	return p, nil
+}
+
+// ListHeld returns the posts waiting for target, oldest first.
+func (s *PostgresStore) ListHeld(ctx context.Context, target Target) ([]*HeldPost, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+heldColumns+`
+		  FROM held_posts
+		 WHERE target_kind = $1 AND target_id = $2
+		 ORDER BY held_at, id
+	`, string(target.Kind), target.ID.String())
+	if err != nil {
+		return nil, oops.Code("POSTING_STORE_FAILED").With("operation", "list_held").Wrap(err)
+	}
+	defer rows.Close()
+	var out []*HeldPost
+	for rows.Next() {
+		p, err := scanHeld(rows)
+		if err != nil {
+			return nil, oops.Code("POSTING_STORE_FAILED").With("operation", "list_held").Wrap(err)
+		}
+		out = append(out, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("POSTING_STORE_FAILED").With("operation", "list_held").Wrap(err)
+	}
+	return out, nil
+}
+
+// CountHeld returns how many posts are waiting for target.
+func (s *PostgresStore) CountHeld(ctx context.Context, target Target) (int, error) {
+	var n int
+	err := s.pool.QueryRow(ctx, `
+		SELECT count(*) FROM held_posts WHERE target_kind = $1 AND target_id = $2
+	`, string(target.Kind), target.ID.String()).Scan(&n)
+	if err != nil {
+		return 0, oops.Code("POSTING_STORE_FAILED").
+			With("operation", "count_held").
+			With("target", target.String()).
+			Wrap(err)
+	}
+	return n, nil
+}
+
+// DeleteHeld removes the held post with id.
+func (s *PostgresStore) DeleteHeld(ctx context.Context, id ulid.ULID) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM held_posts WHERE id = $1`, id.String())
+	if err != nil {
+		return oops.Code("POSTING_STORE_FAILED").
+			With("operation", "delete_held").
+			With("post_id", id.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("POSTING_NOT_FOUND").With("post_id", id.String()).Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+func scanHeld(row pgx.Row) (*HeldPost, error) {
+	var (
+		p         HeldPost
+		id        string
+		kind      string
+		targetID  string
+		subject   string
+		eventType string
+		actorID   string
+		heldAt    pgnanos.Time
+	)
+	if err := row.Scan(&id, &kind, &targetID, &subject, &eventType, &actorID,
+		&p.ActorName, &p.Payload, &p.Sensitive, &heldAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	var err error
+	if p.ID, err = ulid.Parse(id); err != nil {
+		return nil, oops.With("post_id", id).Wrap(err)
+	}
+	if p.Target.ID, err = ulid.Parse(targetID); err != nil {
+		return nil, oops.With("target_id", targetID).Wrap(err)
+	}
+	if p.ActorID, err = ulid.Parse(actorID); err != nil {
+		return nil, oops.With("actor_id", actorID).Wrap(err)
+	}
+	p.Target.Kind = TargetKind(kind)
+	p.Subject = eventbus.Subject(subject)
+	p.EventType = eventbus.Type(eventType)
+	p.HeldAt = heldAt.Time()
+	return &p, nil
+}
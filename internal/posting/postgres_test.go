@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package posting_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/posting"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func TestPostgresStoreRules(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := posting.NewPostgresStore(pool)
+	target := posting.SceneTarget(idgen.New())
+
+	_, err := s.GetRule(ctx, target)
+	assert.ErrorIs(t, err, posting.ErrNotFound)
+
+	now := time.Now().UTC()
+	r := &posting.Rule{
+		Target:       target,
+		Mode:         posting.ModeModerated,
+		Participants: []posting.CharacterRef{{ID: idgen.New(), Name: "Alice"}},
+		SetBy:        "character:" + idgen.New().String(),
+		UpdatedAt:    now,
+	}
+	require.NoError(t, s.PutRule(ctx, r))
+	got, err := s.GetRule(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+
+	r.Mode = posting.ModeEveryone
+	r.Participants = nil
+	require.NoError(t, s.PutRule(ctx, r))
+	got, err = s.GetRule(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, posting.ModeEveryone, got.Mode)
+	assert.Empty(t, got.Participants)
+}
+
+func TestPostgresStoreHeldPosts(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := posting.NewPostgresStore(pool)
+	target := posting.LocationTarget(idgen.New())
+	now := time.Now().UTC()
+
+	first := &posting.HeldPost{
+		ID:        idgen.New(),
+		Target:    target,
+		Subject:   eventbus.Subject("events.main.location." + target.ID.String()),
+		EventType: "core-communication:say",
+		ActorID:   idgen.New(),
+		ActorName: "Bob",
+		Payload:   []byte(`{"text":"Hello."}`),
+		Sensitive: true,
+		HeldAt:    now,
+	}
+	require.NoError(t, s.Hold(ctx, first, 2))
+	second := *first
+	second.ID = idgen.New()
+	second.HeldAt = now.Add(time.Second)
+	require.NoError(t, s.Hold(ctx, &second, 2))
+	third := *first
+	third.ID = idgen.New()
+	errutil.AssertErrorCode(t, s.Hold(ctx, &third, 2), "POSTING_QUEUE_FULL")
+
+	got, err := s.GetHeld(ctx, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, first, got)
+	held, err := s.ListHeld(ctx, target)
+	require.NoError(t, err)
+	require.Len(t, held, 2)
+	assert.Equal(t, first.ID, held[0].ID, "oldest first")
+	count, err := s.CountHeld(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	require.NoError(t, s.DeleteHeld(ctx, first.ID))
+	errutil.AssertErrorCode(t, s.DeleteHeld(ctx, first.ID), "POSTING_NOT_FOUND")
+	require.NoError(t, s.DeleteHeld(ctx, second.ID))
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package posting restricts who may speak in a location or scene. A rule on
+// a location or scene picks one of three modes: everyone may say and pose
+// (the default when there is no rule), only participants may, or
+// non-participants' lines are held in a queue until a moderator approves or
+// rejects them. Participants are the characters a moderator has given the
+// floor; moderators — anyone with the "moderate" action on the location or
+// scene — always pass.
+//
+// Rules are enforced where plugin events are published, so every path that
+// produces a say or pose (the communication commands, scene commands, and
+// the web client) is covered by one check.
+package posting
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/eventbus"
+)
+
+// Limits.
+const (
+	// MaxParticipants bounds how many characters may be given the floor in
+	// one location or scene.
+	MaxParticipants = 50
+	// MaxHeldPerTarget bounds how many posts may wait for a moderator in
+	// one location or scene. Posts beyond it are refused outright.
+	MaxHeldPerTarget = 200
+)
+
+// Sentinel errors. The service wraps them in coded errors; callers test
+// with errors.Is.
+var (
+	// ErrNotFound is returned when a rule, held post, or character does
+	// not exist.
+	ErrNotFound = errors.New("posting record not found")
+	// ErrNotPermitted is returned when a post is refused by the rule.
+	ErrNotPermitted = errors.New("posting not permitted")
+	// ErrHeld is returned when a post was queued for a moderator instead of
+	// being published.
+	ErrHeld = errors.New("post held for moderation")
+)
+
+// Mode is who may post in a location or scene.
+type Mode string
+
+// Modes.
+const (
+	// ModeEveryone lets anyone post. It is the mode of a target with no rule.
+	ModeEveryone Mode = "everyone"
+	// ModeParticipants refuses posts from anyone not given the floor.
+	ModeParticipants Mode = "participants"
+	// ModeModerated holds posts from anyone not given the floor until a
+	// moderator approves them.
+	ModeModerated Mode = "moderated"
+)
+
+// ParseMode returns the Mode named s, ignoring case. Returns POSTING_INVALID
+// for an unknown mode.
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(strings.ToLower(strings.TrimSpace(s))); m {
+	case ModeEveryone, ModeParticipants, ModeModerated:
+		return m, nil
+	}
+	return "", oops.Code("POSTING_INVALID").
+		With("mode", s).
+		Errorf("unknown posting mode %q: use everyone, participants, or moderated", s)
+}
+
+// TargetKind is the kind of place a rule applies to.
+type TargetKind string
+
+// Target kinds.
+const (
+	TargetLocation TargetKind = "location"
+	TargetScene    TargetKind = "scene"
+)
+
+// Target is the location or scene a rule applies to.
+type Target struct {
+	Kind TargetKind
+	ID   ulid.ULID
+}
+
+// LocationTarget returns the Target for a location.
+func LocationTarget(id ulid.ULID) Target {
+	return Target{Kind: TargetLocation, ID: id}
+}
+
+// SceneTarget returns the Target for a scene.
+func SceneTarget(id ulid.ULID) Target {
+	return Target{Kind: TargetScene, ID: id}
+}
+
+// Resource returns the ABAC resource the target is checked as.
+func (t Target) Resource() string {
+	if t.Kind == TargetScene {
+		return access.SceneResource(t.ID.String())
+	}
+	return access.LocationResource(t.ID.String())
+}
+
+// String returns "<kind>:<id>".
+func (t Target) String() string {
+	return fmt.Sprintf("%s:%s", t.Kind, t.ID)
+}
+
+// targetOf returns the target a qualified subject names: a location stream
+// ("events.<game>.location.<id>") or a scene stream
+// ("events.<game>.scene.<id>[.<facet>]").
+func targetOf(subject eventbus.Subject) (Target, bool) {
+	parts := strings.Split(string(subject), ".")
+	if len(parts) < 4 || parts[0] != "events" {
+		return Target{}, false
+	}
+	var kind TargetKind
+	switch {
+	case parts[2] == string(TargetLocation) && len(parts) == 4:
+		kind = TargetLocation
+	case parts[2] == string(TargetScene) && len(parts) <= 5:
+		kind = TargetScene
+	default:
+		return Target{}, false
+	}
+	id, err := ulid.Parse(parts[3])
+	if err != nil {
+		return Target{}, false
+	}
+	return Target{Kind: kind, ID: id}, true
+}
+
+// gatedTypes are the event types a rule applies to: in-character speech and
+// poses. OOC chatter, emits, and whispers are never held.
+var gatedTypes = map[eventbus.Type]bool{
+	"core-communication:say":  true,
+	"core-communication:pose": true,
+	"core-scenes:scene_say":   true,
+	"core-scenes:scene_pose":  true,
+}
+
+// Gated reports whether posts of eventType are subject to posting rules.
+func Gated(eventType eventbus.Type) bool {
+	return gatedTypes[eventType]
+}
+
+// CharacterRef identifies a character by id and its name when it was given
+// the floor.
+type CharacterRef struct {
+	ID   ulid.ULID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// Rule is the posting rule for one location or scene.
+type Rule struct {
+	Target Target
+	Mode   Mode
+	// Participants are the characters given the floor.
+	Participants []CharacterRef
+	// SetBy is the subject that last changed the rule, e.g.
+	// "character:<id>".
+	SetBy     string
+	UpdatedAt time.Time
+}
+
+// HasParticipant reports whether the character has been given the floor.
+func (r *Rule) HasParticipant(characterID ulid.ULID) bool {
+	return r.participant(characterID) >= 0
+}
+
+func (r *Rule) participant(characterID ulid.ULID) int {
+	return slices.IndexFunc(r.Participants, func(c CharacterRef) bool { return c.ID == characterID })
+}
+
+// HeldPost is a say or pose waiting for a moderator. It keeps everything
+// needed to publish the original event unchanged on approval.
+type HeldPost struct {
+	ID     ulid.ULID
+	Target Target
+	// Subject is the qualified subject the event was bound for.
+	Subject   eventbus.Subject
+	EventType eventbus.Type
+	ActorID   ulid.ULID
+	// ActorName is the poster's display name at the time of posting, kept
+	// for the moderation queue.
+	ActorName string
+	Payload   []byte
+	Sensitive bool
+	HeldAt    time.Time
+}
+
+// Text returns the spoken or posed text of the held post, for the
+// moderation queue.
+func (p *HeldPost) Text() string {
+	return content(p.Payload).GetText()
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package posting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestParseMode(t *testing.T) {
+	for in, want := range map[string]Mode{
+		"everyone":      ModeEveryone,
+		" Participants": ModeParticipants,
+		"MODERATED":     ModeModerated,
+	} {
+		got, err := ParseMode(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got)
+	}
+	_, err := ParseMode("quiet")
+	errutil.AssertErrorCode(t, err, "POSTING_INVALID")
+}
+
+func TestTargetOf(t *testing.T) {
+	id := idgen.New()
+	tests := []struct {
+		subject string
+		want    Target
+		ok      bool
+	}{
+		{"events.main.location." + id.String(), LocationTarget(id), true},
+		{"events.main.scene." + id.String(), SceneTarget(id), true},
+		{"events.main.scene." + id.String() + ".ic", SceneTarget(id), true},
+		{"events.main.location." + id.String() + ".extra", Target{}, false},
+		{"events.main.character." + id.String(), Target{}, false},
+		{"events.main.location.not-a-ulid", Target{}, false},
+		{"location." + id.String(), Target{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := targetOf(eventbus.Subject(tt.subject))
+		assert.Equal(t, tt.ok, ok, tt.subject)
+		assert.Equal(t, tt.want, got, tt.subject)
+	}
+}
+
+func TestTargetResource(t *testing.T) {
+	id := idgen.New()
+	assert.Equal(t, "location:"+id.String(), LocationTarget(id).Resource())
+	assert.Equal(t, "scene:"+id.String(), SceneTarget(id).Resource())
+	assert.Equal(t, "scene:"+id.String(), SceneTarget(id).String())
+}
+
+func TestGated(t *testing.T) {
+	assert.True(t, Gated("core-communication:say"))
+	assert.True(t, Gated("core-scenes:scene_pose"))
+	assert.False(t, Gated("core-communication:ooc"))
+	assert.False(t, Gated("core-scenes:scene_ooc"))
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package posting
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists posting rules and held posts.
+type Repository interface {
+	// GetRule returns the rule for target. Returns an error wrapping
+	// ErrNotFound when there is none.
+	GetRule(ctx context.Context, target Target) (*Rule, error)
+	// PutRule creates or replaces the rule for r.Target.
+	PutRule(ctx context.Context, r *Rule) error
+
+	// Hold queues p. Returns POSTING_QUEUE_FULL when max posts are already
+	// waiting for p.Target.
+	Hold(ctx context.Context, p *HeldPost, max int) error
+	// GetHeld returns the held post with id. Returns an error wrapping
+	// ErrNotFound when there is none.
+	GetHeld(ctx context.Context, id ulid.ULID) (*HeldPost, error)
+	// ListHeld returns the posts waiting for target, oldest first.
+	ListHeld(ctx context.Context, target Target) ([]*HeldPost, error)
+	// CountHeld returns how many posts are waiting for target.
+	CountHeld(ctx context.Context, target Target) (int, error)
+	// DeleteHeld removes the held post with id. Returns an error wrapping
+	// ErrNotFound when there is none, so concurrent moderators cannot both
+	// claim one post.
+	DeleteHeld(ctx context.Context, id ulid.ULID) error
+}
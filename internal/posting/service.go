@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package posting
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	commv1 "github.com/holomush/holomush/pkg/proto/holomush/comm/v1"
+)
+
+// ActionModerate is the ABAC action checked on a location or scene before
+// its rule or queue is read or changed. Moderators also bypass the rule.
+const ActionModerate = "moderate"
+
+// Characters resolves character names the way the acting character sees
+// the world, so the posting command never reveals a character hidden from
+// its caller. *world.Service satisfies it.
+type Characters interface {
+	FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*world.Character, error)
+}
+
+// Service keeps posting rules, decides whether a say or pose may be
+// published, and runs the moderation queue. Rule changes and queue
+// decisions require moderate on the location or scene and are written to
+// the structured log as audit records.
+//
+// Approving a held post needs an event publisher, bound after construction
+// with SetPublisher once the event bus is up.
+type Service struct {
+	repo       Repository
+	characters Characters
+	engine     types.AccessPolicyEngine
+	logger     *slog.Logger
+	now        func() time.Time
+
+	mu  sync.RWMutex
+	pub eventbus.Publisher
+}
+
+// NewService creates a Service. repo, characters, and engine are required;
+// a nil logger uses slog.Default().
+func NewService(repo Repository, characters Characters, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("posting repository is required")
+	}
+	if characters == nil {
+		return nil, oops.Errorf("character lookup is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, characters: characters, engine: engine, logger: logger, now: time.Now}, nil
+}
+
+// SetPublisher binds the publisher approved posts are sent through.
+func (s *Service) SetPublisher(pub eventbus.Publisher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+}
+
+func (s *Service) publisher() eventbus.Publisher {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) {
+		return nil
+	}
+	return s.pub
+}
+
+// Admit decides whether event may be published. Only a character's say or
+// pose into a location or scene with a restrictive rule is checked;
+// everything else is admitted. A refused post returns POSTING_NOT_PERMITTED
+// (wrapping ErrNotPermitted); a post queued for a moderator returns
+// POSTING_HELD (wrapping ErrHeld). Admit fails closed: when the rule cannot
+// be read the post is refused with POSTING_CHECK_FAILED.
+func (s *Service) Admit(ctx context.Context, event eventbus.Event) error {
+	if event.Actor.Kind != eventbus.ActorKindCharacter || !Gated(event.Type) {
+		return nil
+	}
+	target, ok := targetOf(event.Subject)
+	if !ok {
+		return nil
+	}
+	rule, err := s.repo.GetRule(ctx, target)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return oops.Code("POSTING_CHECK_FAILED").With("target", target.String()).Wrap(err)
+	}
+	if rule.Mode == ModeEveryone || rule.HasParticipant(event.Actor.ID) {
+		return nil
+	}
+	subject := access.CharacterSubject(event.Actor.ID.String())
+	if s.isModerator(ctx, subject, target) {
+		return nil
+	}
+	if rule.Mode == ModeParticipants {
+		return oops.Code("POSTING_NOT_PERMITTED").
+			With("target", target.String()).
+			With("actor_id", event.Actor.ID.String()).
+			Wrap(ErrNotPermitted)
+	}
+
+	held := &HeldPost{
+		ID:        idgen.New(),
+		Target:    target,
+		Subject:   event.Subject,
+		EventType: event.Type,
+		ActorID:   event.Actor.ID,
+		ActorName: content(event.Payload).GetActorDisplayName(),
+		Payload:   event.Payload,
+		Sensitive: event.Sensitive,
+		HeldAt:    s.now().UTC(),
+	}
+	if err := s.repo.Hold(ctx, held, MaxHeldPerTarget); err != nil {
+		return err
+	}
+	s.logger.InfoContext(ctx, "post held",
+		"event", "posting_post_held",
+		"subject", subject,
+		"post_id", held.ID.String(),
+		"target", target.String(),
+		"event_type", string(held.EventType),
+	)
+	return oops.Code("POSTING_HELD").
+		With("target", target.String()).
+		With("post_id", held.ID.String()).
+		Wrap(ErrHeld)
+}
+
+// Rule returns the rule for target, or an everyone rule when none is set.
+// Rules are not secret — anyone present can see who may speak — so Rule is
+// not access-checked.
+func (s *Service) Rule(ctx context.Context, target Target) (*Rule, error) {
+	r, err := s.repo.GetRule(ctx, target)
+	if errors.Is(err, ErrNotFound) {
+		return &Rule{Target: target, Mode: ModeEveryone}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// SetMode sets the mode of target's rule on behalf of subject, keeping its
+// participants.
+func (s *Service) SetMode(ctx context.Context, subject string, target Target, mode Mode) (*Rule, error) {
+	if _, err := ParseMode(string(mode)); err != nil {
+		return nil, err
+	}
+	return s.update(ctx, subject, target, "posting_mode_set", func(r *Rule) error {
+		r.Mode = mode
+		return nil
+	})
+}
+
+// FindCharacter looks up the character named name, ignoring case, as
+// observerID sees it. Returns POSTING_CHARACTER_NOT_FOUND (wrapping
+// ErrNotFound) when there is none or observerID cannot see it.
+func (s *Service) FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (CharacterRef, error) {
+	char, err := s.characters.FindCharacterByName(ctx, observerID, name)
+	if errors.Is(err, world.ErrNotFound) {
+		return CharacterRef{}, oops.Code("POSTING_CHARACTER_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return CharacterRef{}, oops.Code("POSTING_STORE_FAILED").
+			With("operation", "find_character").
+			With("name", name).
+			Wrap(err)
+	}
+	return CharacterRef{ID: char.ID, Name: char.Name}, nil
+}
+
+// Voice gives the character the floor in target on behalf of subject.
+// Voicing a participant again is a no-op.
+func (s *Service) Voice(ctx context.Context, subject string, target Target, character CharacterRef) (*Rule, error) {
+	return s.update(ctx, subject, target, "posting_voiced", func(r *Rule) error {
+		if r.HasParticipant(character.ID) {
+			return nil
+		}
+		if len(r.Participants) >= MaxParticipants {
+			return oops.Code("POSTING_INVALID").
+				With("target", target.String()).
+				Errorf("at most %d characters may be given the floor", MaxParticipants)
+		}
+		r.Participants = append(r.Participants, character)
+		return nil
+	})
+}
+
+// Unvoice takes the floor from the character in target on behalf of
+// subject. Returns POSTING_INVALID when the character is not a participant.
+func (s *Service) Unvoice(ctx context.Context, subject string, target Target, characterID ulid.ULID) (*Rule, error) {
+	return s.update(ctx, subject, target, "posting_unvoiced", func(r *Rule) error {
+		i := r.participant(characterID)
+		if i < 0 {
+			return oops.Code("POSTING_INVALID").
+				With("target", target.String()).
+				With("character_id", characterID.String()).
+				Errorf("that character has not been given the floor")
+		}
+		r.Participants = slices.Delete(r.Participants, i, i+1)
+		return nil
+	})
+}
+
+// update applies change to target's rule on behalf of subject, who must be
+// able to moderate it, and stores and audits the result.
+func (s *Service) update(ctx context.Context, subject string, target Target, auditEvent string, change func(*Rule) error) (*Rule, error) {
+	if err := s.checkModerate(ctx, subject, target); err != nil {
+		return nil, err
+	}
+	r, err := s.Rule(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if err := change(r); err != nil {
+		return nil, err
+	}
+	r.SetBy = subject
+	r.UpdatedAt = s.now().UTC()
+	if err := s.repo.PutRule(ctx, r); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "posting rule changed",
+		"event", auditEvent,
+		"subject", subject,
+		"target", target.String(),
+		"mode", string(r.Mode),
+		"participants", len(r.Participants),
+	)
+	return r, nil
+}
+
+// Held returns the posts waiting in target's queue, oldest first, on behalf
+// of subject, who must be able to moderate it.
+func (s *Service) Held(ctx context.Context, subject string, target Target) ([]*HeldPost, error) {
+	if err := s.checkModerate(ctx, subject, target); err != nil {
+		return nil, err
+	}
+	return s.repo.ListHeld(ctx, target)
+}
+
+// HeldCount returns how many posts wait in target's queue. Like Rule it is
+// not access-checked.
+func (s *Service) HeldCount(ctx context.Context, target Target) (int, error) {
+	return s.repo.CountHeld(ctx, target)
+}
+
+// Approve publishes the held post with id, unchanged and attributed to its
+// poster, on behalf of subject, who must be able to moderate its target.
+// The post is removed from the queue first, so two moderators cannot both
+// publish it; if publishing then fails the post is queued again.
+func (s *Service) Approve(ctx context.Context, subject string, id ulid.ULID) (*HeldPost, error) {
+	pub := s.publisher()
+	if pub == nil {
+		return nil, oops.Code("POSTING_PUBLISH_FAILED").
+			With("post_id", id.String()).
+			New("posting publisher is not configured")
+	}
+	p, err := s.claim(ctx, subject, id)
+	if err != nil {
+		return nil, err
+	}
+	event := eventbus.NewEvent(p.Subject, p.EventType,
+		eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: p.ActorID}, p.Payload)
+	event.Sensitive = p.Sensitive
+	if err := pub.Publish(ctx, event); err != nil {
+		if requeueErr := s.repo.Hold(ctx, p, MaxHeldPerTarget+1); requeueErr != nil {
+			s.logger.ErrorContext(ctx, "requeue of held post failed",
+				"post_id", p.ID.String(),
+				"target", p.Target.String(),
+				"error", requeueErr)
+		}
+		return nil, oops.Code("POSTING_PUBLISH_FAILED").
+			With("post_id", p.ID.String()).
+			With("target", p.Target.String()).
+			Wrap(err)
+	}
+	s.audit(ctx, "posting_post_approved", subject, p)
+	return p, nil
+}
+
+// Reject discards the held post with id on behalf of subject, who must be
+// able to moderate its target.
+func (s *Service) Reject(ctx context.Context, subject string, id ulid.ULID) (*HeldPost, error) {
+	p, err := s.claim(ctx, subject, id)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(ctx, "posting_post_rejected", subject, p)
+	return p, nil
+}
+
+// claim removes the held post with id from its queue after checking that
+// subject may moderate its target.
+func (s *Service) claim(ctx context.Context, subject string, id ulid.ULID) (*HeldPost, error) {
+	p, err := s.repo.GetHeld(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkModerate(ctx, subject, p.Target); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteHeld(ctx, id); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *Service) audit(ctx context.Context, event, subject string, p *HeldPost) {
+	s.logger.InfoContext(ctx, "held post decided",
+		"event", event,
+		"subject", subject,
+		"post_id", p.ID.String(),
+		"target", p.Target.String(),
+		"actor_id", p.ActorID.String(),
+	)
+}
+
+// isModerator reports whether subject may moderate target. Evaluation
+// errors count as not a moderator, so the rule still applies.
+func (s *Service) isModerator(ctx context.Context, subject string, target Target) bool {
+	req, err := types.NewAccessRequest(subject, ActionModerate, target.Resource(), nil)
+	if err != nil {
+		return false
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		s.logger.WarnContext(ctx, "posting moderator check failed",
+			"subject", subject,
+			"target", target.String(),
+			"error", err)
+		return false
+	}
+	return decision.IsAllowed()
+}
+
+// checkModerate evaluates moderate on target for subject. It fails closed:
+// engine errors and infrastructure failures deny.
+func (s *Service) checkModerate(ctx context.Context, subject string, target Target) error {
+	resource := target.Resource()
+	req, err := types.NewAccessRequest(subject, ActionModerate, resource, nil)
+	if err != nil {
+		return oops.Code("POSTING_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("POSTING_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(
+			ctx, "posting access denied",
+			"event", "posting_access_denied",
+			"subject", subject,
+			"target", target.String(),
+			"reason", decision.Reason(),
+		)
+		return oops.Code("POSTING_ACCESS_DENIED").
+			With("target", target.String()).
+			Errorf("not permitted to moderate this %s", target.Kind)
+	}
+	return nil
+}
+
+// content decodes a communication payload, returning an empty message when
+// the payload is not one.
+func content(payload []byte) *commv1.CommunicationContent {
+	var c commv1.CommunicationContent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, &c); err != nil {
+		return &commv1.CommunicationContent{}
+	}
+	return &c
+}
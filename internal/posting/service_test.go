@@ -0,0 +1,413 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package posting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository. It also stands in for the
+// world's character lookup, with hidden characters seen only by themselves.
+type memRepository struct {
+	mu         sync.Mutex
+	rules      map[Target]*Rule
+	held       map[ulid.ULID]*HeldPost
+	characters map[string]CharacterRef
+	hidden     map[ulid.ULID]bool
+	err        error
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{
+		rules:      map[Target]*Rule{},
+		held:       map[ulid.ULID]*HeldPost{},
+		characters: map[string]CharacterRef{},
+		hidden:     map[ulid.ULID]bool{},
+	}
+}
+
+func (m *memRepository) GetRule(_ context.Context, target Target) (*Rule, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, m.err
+	}
+	r, ok := m.rules[target]
+	if !ok {
+		return nil, oops.Code("POSTING_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	out := *r
+	out.Participants = append([]CharacterRef(nil), r.Participants...)
+	return &out, nil
+}
+
+func (m *memRepository) PutRule(_ context.Context, r *Rule) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stored := *r
+	stored.Participants = append([]CharacterRef(nil), r.Participants...)
+	m.rules[r.Target] = &stored
+	return nil
+}
+
+func (m *memRepository) FindCharacterByName(_ context.Context, observerID ulid.ULID, name string) (*world.Character, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.characters[strings.ToLower(name)]
+	if !ok || (m.hidden[c.ID] && c.ID != observerID) {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").Wrap(world.ErrNotFound)
+	}
+	return &world.Character{ID: c.ID, Name: c.Name}, nil
+}
+
+func (m *memRepository) Hold(_ context.Context, p *HeldPost, max int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for _, h := range m.held {
+		if h.Target == p.Target {
+			n++
+		}
+	}
+	if n >= max {
+		return oops.Code("POSTING_QUEUE_FULL").Errorf("the moderation queue is full")
+	}
+	stored := *p
+	m.held[p.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) GetHeld(_ context.Context, id ulid.ULID) (*HeldPost, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.held[id]
+	if !ok {
+		return nil, oops.Code("POSTING_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	out := *p
+	return &out, nil
+}
+
+func (m *memRepository) ListHeld(_ context.Context, target Target) ([]*HeldPost, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*HeldPost
+	for _, p := range m.held {
+		if p.Target == target {
+			stored := *p
+			out = append(out, &stored)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Compare(out[j].ID) < 0 })
+	return out, nil
+}
+
+func (m *memRepository) CountHeld(ctx context.Context, target Target) (int, error) {
+	held, err := m.ListHeld(ctx, target)
+	return len(held), err
+}
+
+func (m *memRepository) DeleteHeld(_ context.Context, id ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.held[id]; !ok {
+		return oops.Code("POSTING_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	delete(m.held, id)
+	return nil
+}
+
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+type testService struct {
+	*Service
+	repo   *memRepository
+	engine *policytest.GrantEngine
+	pub    *fakePublisher
+	logs   *bytes.Buffer
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, repo, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	svc.now = func() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub)
+	return &testService{Service: svc, repo: repo, engine: engine, pub: pub, logs: &logs}
+}
+
+// moderator returns a character granted moderate on target.
+func (ts *testService) moderator(target Target) (ulid.ULID, string) {
+	id := idgen.New()
+	subject := access.CharacterSubject(id.String())
+	ts.engine.Grant(subject, ActionModerate, target.Resource())
+	return id, subject
+}
+
+func post(subject eventbus.Subject, typ eventbus.Type, actor ulid.ULID) eventbus.Event {
+	payload := []byte(`{"actor_id":"` + actor.String() + `","actor_display_name":"Bob","text":"Hello."}`)
+	return eventbus.NewEvent(subject, typ, eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: actor}, payload)
+}
+
+func locationSubject(id ulid.ULID) eventbus.Subject {
+	return eventbus.Subject("events.main.location." + id.String())
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	repo := newMemRepository()
+	_, err := NewService(nil, repo, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(repo, nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(repo, repo, nil, nil)
+	require.Error(t, err)
+}
+
+func TestAdmitWithoutRuleAllowsEveryone(t *testing.T) {
+	ts := newTestService(t)
+	loc := idgen.New()
+	require.NoError(t, ts.Admit(context.Background(), post(locationSubject(loc), "core-communication:say", idgen.New())))
+}
+
+func TestAdmitParticipantsMode(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	loc := idgen.New()
+	target := LocationTarget(loc)
+	_, mod := ts.moderator(target)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+
+	_, err := ts.SetMode(ctx, mod, target, ModeParticipants)
+	require.NoError(t, err)
+	_, err = ts.Voice(ctx, mod, target, alice)
+	require.NoError(t, err)
+
+	require.NoError(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:say", alice.ID)))
+	err = ts.Admit(ctx, post(locationSubject(loc), "core-communication:pose", idgen.New()))
+	require.ErrorIs(t, err, ErrNotPermitted)
+	errutil.AssertErrorCode(t, err, "POSTING_NOT_PERMITTED")
+
+	modID, _ := ts.moderator(target)
+	require.NoError(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:say", modID)), "moderators always pass")
+	require.NoError(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:ooc", idgen.New())), "OOC is never restricted")
+
+	_, err = ts.Unvoice(ctx, mod, target, alice.ID)
+	require.NoError(t, err)
+	require.ErrorIs(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:say", alice.ID)), ErrNotPermitted)
+}
+
+func TestAdmitModeratedHoldsAndApproves(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	scene := idgen.New()
+	target := SceneTarget(scene)
+	_, mod := ts.moderator(target)
+	bob := idgen.New()
+	subject := eventbus.Subject("events.main.scene." + scene.String() + ".ic")
+
+	_, err := ts.SetMode(ctx, mod, target, ModeModerated)
+	require.NoError(t, err)
+	ev := post(subject, "core-scenes:scene_pose", bob)
+	ev.Sensitive = true
+	err = ts.Admit(ctx, ev)
+	require.ErrorIs(t, err, ErrHeld)
+	errutil.AssertErrorCode(t, err, "POSTING_HELD")
+	assert.Empty(t, ts.pub.published)
+
+	held, err := ts.Held(ctx, mod, target)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+	assert.Equal(t, "Bob", held[0].ActorName)
+	assert.Equal(t, "Hello.", held[0].Text())
+	count, err := ts.HeldCount(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	_, outsider := ts.moderator(LocationTarget(idgen.New()))
+	_, err = ts.Approve(ctx, outsider, held[0].ID)
+	errutil.AssertErrorCode(t, err, "POSTING_ACCESS_DENIED")
+
+	approved, err := ts.Approve(ctx, mod, held[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, bob, approved.ActorID)
+	require.Len(t, ts.pub.published, 1)
+	got := ts.pub.published[0]
+	assert.Equal(t, subject, got.Subject)
+	assert.Equal(t, eventbus.Type("core-scenes:scene_pose"), got.Type)
+	assert.Equal(t, eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: bob}, got.Actor)
+	assert.Equal(t, ev.Payload, got.Payload)
+	assert.True(t, got.Sensitive)
+	assert.Contains(t, ts.logs.String(), `"event":"posting_post_approved"`)
+
+	_, err = ts.Approve(ctx, mod, held[0].ID)
+	require.ErrorIs(t, err, ErrNotFound, "an approved post leaves the queue")
+}
+
+func TestRejectDiscardsHeldPost(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	loc := idgen.New()
+	target := LocationTarget(loc)
+	_, mod := ts.moderator(target)
+	_, err := ts.SetMode(ctx, mod, target, ModeModerated)
+	require.NoError(t, err)
+	require.ErrorIs(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:say", idgen.New())), ErrHeld)
+
+	held, err := ts.Held(ctx, mod, target)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+	_, err = ts.Reject(ctx, mod, held[0].ID)
+	require.NoError(t, err)
+	assert.Empty(t, ts.pub.published)
+	held, err = ts.Held(ctx, mod, target)
+	require.NoError(t, err)
+	assert.Empty(t, held)
+}
+
+func TestApproveRequeuesOnPublishFailure(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	loc := idgen.New()
+	target := LocationTarget(loc)
+	_, mod := ts.moderator(target)
+	_, err := ts.SetMode(ctx, mod, target, ModeModerated)
+	require.NoError(t, err)
+	require.ErrorIs(t, ts.Admit(ctx, post(locationSubject(loc), "core-communication:say", idgen.New())), ErrHeld)
+	held, err := ts.Held(ctx, mod, target)
+	require.NoError(t, err)
+
+	ts.pub.err = errors.New("bus down")
+	_, err = ts.Approve(ctx, mod, held[0].ID)
+	errutil.AssertErrorCode(t, err, "POSTING_PUBLISH_FAILED")
+	again, err := ts.Held(ctx, mod, target)
+	require.NoError(t, err)
+	assert.Len(t, again, 1, "a post that could not be published goes back in the queue")
+}
+
+func TestAdmitFailsClosedWhenRuleUnreadable(t *testing.T) {
+	ts := newTestService(t)
+	ts.repo.err = errors.New("db down")
+	err := ts.Admit(context.Background(), post(locationSubject(idgen.New()), "core-communication:say", idgen.New()))
+	errutil.AssertErrorCode(t, err, "POSTING_CHECK_FAILED")
+}
+
+func TestAdmitIgnoresNonCharacterActorsAndOtherStreams(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	ts.repo.err = errors.New("rules are never read")
+
+	ev := post(locationSubject(idgen.New()), "core-communication:say", idgen.New())
+	ev.Actor.Kind = eventbus.ActorKindPlugin
+	require.NoError(t, ts.Admit(ctx, ev))
+	require.NoError(t, ts.Admit(ctx, post("events.main.character."+eventbus.Subject(idgen.New().String()), "core-communication:say", idgen.New())))
+}
+
+func TestRuleChangesRequireModerate(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	target := LocationTarget(idgen.New())
+	outsider := access.CharacterSubject(idgen.New().String())
+
+	_, err := ts.SetMode(ctx, outsider, target, ModeModerated)
+	errutil.AssertErrorCode(t, err, "POSTING_ACCESS_DENIED")
+	_, err = ts.Voice(ctx, outsider, target, CharacterRef{ID: idgen.New()})
+	errutil.AssertErrorCode(t, err, "POSTING_ACCESS_DENIED")
+	_, err = ts.Held(ctx, outsider, target)
+	errutil.AssertErrorCode(t, err, "POSTING_ACCESS_DENIED")
+
+	r, err := ts.Rule(ctx, target)
+	require.NoError(t, err)
+	assert.Equal(t, ModeEveryone, r.Mode, "a target with no rule lets everyone post")
+}
+
+func TestVoiceAndUnvoice(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	target := LocationTarget(idgen.New())
+	_, mod := ts.moderator(target)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+
+	_, err := ts.Voice(ctx, mod, target, alice)
+	require.NoError(t, err)
+	r, err := ts.Voice(ctx, mod, target, alice)
+	require.NoError(t, err)
+	assert.Equal(t, []CharacterRef{alice}, r.Participants, "voicing twice is a no-op")
+	assert.Equal(t, ModeEveryone, r.Mode)
+	assert.Equal(t, mod, r.SetBy)
+
+	_, err = ts.Unvoice(ctx, mod, target, idgen.New())
+	errutil.AssertErrorCode(t, err, "POSTING_INVALID")
+
+	for len(r.Participants) < MaxParticipants {
+		r, err = ts.Voice(ctx, mod, target, CharacterRef{ID: idgen.New()})
+		require.NoError(t, err)
+	}
+	_, err = ts.Voice(ctx, mod, target, CharacterRef{ID: idgen.New()})
+	errutil.AssertErrorCode(t, err, "POSTING_INVALID")
+}
+
+func TestSetModeRejectsUnknownMode(t *testing.T) {
+	ts := newTestService(t)
+	target := LocationTarget(idgen.New())
+	_, mod := ts.moderator(target)
+	_, err := ts.SetMode(context.Background(), mod, target, "chaos")
+	errutil.AssertErrorCode(t, err, "POSTING_INVALID")
+}
+
+func TestFindCharacterHidesWhatTheObserverCannotSee(t *testing.T) {
+	ctx := context.Background()
+	repo := newMemRepository()
+	svc, err := NewService(repo, repo, policytest.AllowAllEngine(), nil)
+	require.NoError(t, err)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+	shade := CharacterRef{ID: idgen.New(), Name: "Shade"}
+	repo.characters["alice"], repo.characters["shade"] = alice, shade
+	repo.hidden[shade.ID] = true
+
+	got, err := svc.FindCharacter(ctx, shade.ID, "ALICE")
+	require.NoError(t, err)
+	assert.Equal(t, alice, got)
+
+	_, err = svc.FindCharacter(ctx, alice.ID, "shade")
+	errutil.AssertErrorCode(t, err, "POSTING_CHARACTER_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,299 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package preferences stores each player's client preferences — ANSI
+// color, screen width, time zone, pagination size, language, and
+// notification options — so transports and the formatter read them instead of assuming
+// every player has the same terminal.
+//
+// Preferences live in the host partition of the player's settings under
+// the "core.client." and "core.notify." keys. Every preference has a
+// default, so a player who has set nothing still gets a complete
+// Preferences value.
+package preferences
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/settings"
+	"github.com/holomush/holomush/pkg/holo"
+)
+
+// Defaults and bounds for the numeric preferences.
+const (
+	DefaultScreenWidth = 78
+	MinScreenWidth     = 20
+	MaxScreenWidth     = 250
+
+	DefaultPageSize = 20
+	MinPageSize     = 5
+	MaxPageSize     = 200
+
+	DefaultTimezone = "UTC"
+)
+
+// Preferences is a player's complete set of client preferences, with
+// defaults filled in for anything the player has not set.
+type Preferences struct {
+	// ANSI turns color and text attributes on.
+	ANSI bool `json:"ansi"`
+	// ScreenWidth is the column count output is wrapped to.
+	ScreenWidth int `json:"screen_width"`
+	// Timezone is the IANA zone name times are shown in.
+	Timezone string `json:"timezone"`
+	// PageSize is how many rows a paginated listing shows at once.
+	PageSize int `json:"page_size"`
+	// Locale is the normalized message-catalog locale host text is shown
+	// in, such as "pt-br".
+	Locale string `json:"locale"`
+	// NotifyBell rings the terminal bell on pages.
+	NotifyBell bool `json:"notify_bell"`
+	// NotifyAnnouncements shows staff announcements as they are broadcast.
+	NotifyAnnouncements bool `json:"notify_announcements"`
+}
+
+// Defaults returns the preferences of a player who has set none.
+func Defaults() Preferences {
+	return Preferences{
+		ANSI:                true,
+		ScreenWidth:         DefaultScreenWidth,
+		Timezone:            DefaultTimezone,
+		PageSize:            DefaultPageSize,
+		Locale:              holo.DefaultLocale,
+		NotifyBell:          false,
+		NotifyAnnouncements: true,
+	}
+}
+
+// Location returns the time zone to show times in. A zone that no longer
+// loads falls back to UTC.
+func (p Preferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Definition describes one preference: the name players use for it, the
+// settings key it is stored under, and how its value is parsed and
+// applied.
+type Definition struct {
+	// Name is the short name used by the prefs command, such as "ansi".
+	Name string
+	// Key is the host settings key the value is stored under.
+	Key string
+	// Description is the one-line summary shown in listings.
+	Description string
+
+	// parse validates a value as typed and returns its canonical form.
+	parse func(raw string) (string, error)
+	// apply reads the stored value into p, leaving the default in place
+	// when it is unset or no longer valid.
+	apply func(ctx context.Context, s settings.Settings, key string, p *Preferences)
+	// get formats the value p holds for display.
+	get func(p Preferences) string
+}
+
+// Value formats the value of this preference in p for display.
+func (d Definition) Value(p Preferences) string {
+	return d.get(p)
+}
+
+// definitions lists every preference in display order.
+var definitions = []Definition{
+	{
+		Name:        "ansi",
+		Key:         "core.client.ansi",
+		Description: "Show colors and text styles",
+		parse:       parseBool,
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.BoolN(ctx, key); ok {
+				p.ANSI = v
+			}
+		},
+		get: func(p Preferences) string { return formatBool(p.ANSI) },
+	},
+	{
+		Name:        "width",
+		Key:         "core.client.screen_width",
+		Description: "Columns to wrap output to",
+		parse:       parseIntRange(MinScreenWidth, MaxScreenWidth),
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.IntN(ctx, key); ok && v >= MinScreenWidth && v <= MaxScreenWidth {
+				p.ScreenWidth = v
+			}
+		},
+		get: func(p Preferences) string { return strconv.Itoa(p.ScreenWidth) },
+	},
+	{
+		Name:        "timezone",
+		Key:         "core.client.timezone",
+		Description: "Time zone times are shown in",
+		parse:       parseTimezone,
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.StringN(ctx, key); ok {
+				if _, err := time.LoadLocation(v); err == nil && v != "" {
+					p.Timezone = v
+				}
+			}
+		},
+		get: func(p Preferences) string { return p.Timezone },
+	},
+	{
+		Name:        "pagesize",
+		Key:         "core.client.page_size",
+		Description: "Rows shown per page of a long listing",
+		parse:       parseIntRange(MinPageSize, MaxPageSize),
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.IntN(ctx, key); ok && v >= MinPageSize && v <= MaxPageSize {
+				p.PageSize = v
+			}
+		},
+		get: func(p Preferences) string { return strconv.Itoa(p.PageSize) },
+	},
+	{
+		Name:        "locale",
+		Key:         "core.client.locale",
+		Description: "Language host messages are shown in",
+		parse:       parseLocale,
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.StringN(ctx, key); ok {
+				if locale, err := holo.NormalizeLocale(v); err == nil {
+					p.Locale = locale
+				}
+			}
+		},
+		get: func(p Preferences) string { return p.Locale },
+	},
+	{
+		Name:        "bell",
+		Key:         "core.notify.bell",
+		Description: "Ring the terminal bell when you are paged",
+		parse:       parseBool,
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.BoolN(ctx, key); ok {
+				p.NotifyBell = v
+			}
+		},
+		get: func(p Preferences) string { return formatBool(p.NotifyBell) },
+	},
+	{
+		Name:        "announce",
+		Key:         "core.notify.announcements",
+		Description: "Show staff announcements as they are broadcast",
+		parse:       parseBool,
+		apply: func(ctx context.Context, s settings.Settings, key string, p *Preferences) {
+			if v, ok := s.BoolN(ctx, key); ok {
+				p.NotifyAnnouncements = v
+			}
+		},
+		get: func(p Preferences) string { return formatBool(p.NotifyAnnouncements) },
+	},
+}
+
+// Definitions returns every preference in display order.
+func Definitions() []Definition {
+	out := make([]Definition, len(definitions))
+	copy(out, definitions)
+	return out
+}
+
+// Lookup finds a preference by its short name, case-insensitively.
+// Returns PREFERENCE_UNKNOWN when there is none.
+func Lookup(name string) (Definition, error) {
+	for _, d := range definitions {
+		if strings.EqualFold(d.Name, name) {
+			return d, nil
+		}
+	}
+	return Definition{}, oops.Code("PREFERENCE_UNKNOWN").
+		With("name", name).
+		Errorf("unknown preference %q; known: %s", name, strings.Join(Names(), ", "))
+}
+
+// Names returns the short names of every preference, sorted.
+func Names() []string {
+	names := make([]string, 0, len(definitions))
+	for _, d := range definitions {
+		names = append(names, d.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load reads a player's preferences from their settings, filling in
+// defaults for anything unset or no longer valid. Like Settings reads it
+// never fails.
+func Load(ctx context.Context, s settings.Settings) Preferences {
+	p := Defaults()
+	for _, d := range definitions {
+		d.apply(ctx, s, d.Key, &p)
+	}
+	return p
+}
+
+func parseBool(raw string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "on", "yes", "true", "1":
+		return "true", nil
+	case "off", "no", "false", "0":
+		return "false", nil
+	}
+	return "", oops.Code("PREFERENCE_INVALID_VALUE").
+		With("value", raw).
+		Errorf("expected on or off, got %q", raw)
+}
+
+func formatBool(v bool) string {
+	if v {
+		return "on"
+	}
+	return "off"
+}
+
+func parseIntRange(lo, hi int) func(raw string) (string, error) {
+	return func(raw string) (string, error) {
+		n, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || n < lo || n > hi {
+			return "", oops.Code("PREFERENCE_INVALID_VALUE").
+				With("value", raw).
+				Errorf("expected a number from %d to %d, got %q", lo, hi, raw)
+		}
+		return strconv.Itoa(n), nil
+	}
+}
+
+func parseTimezone(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if strings.EqualFold(name, "utc") {
+		return DefaultTimezone, nil
+	}
+	if name == "" || strings.EqualFold(name, "local") {
+		return "", oops.Code("PREFERENCE_INVALID_VALUE").
+			With("value", raw).
+			Errorf("expected an IANA time zone such as America/New_York, got %q", raw)
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", oops.Code("PREFERENCE_INVALID_VALUE").
+			With("value", raw).
+			Errorf("unknown time zone %q", raw)
+	}
+	return name, nil
+}
+
+func parseLocale(raw string) (string, error) {
+	locale, err := holo.NormalizeLocale(raw)
+	if err != nil {
+		return "", oops.Code("PREFERENCE_INVALID_VALUE").
+			With("value", raw).
+			Errorf("expected a language tag such as en or pt-BR, got %q", raw)
+	}
+	return locale, nil
+}
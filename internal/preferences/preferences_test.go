@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/settings"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestLoadFillsDefaults(t *testing.T) {
+	got := Load(context.Background(), settings.NewScopedForTest(nil))
+	assert.Equal(t, Defaults(), got)
+	assert.Equal(t, time.UTC, got.Location())
+}
+
+func TestLoadReadsStoredValues(t *testing.T) {
+	host := map[string]json.RawMessage{
+		"core.client.ansi":          json.RawMessage(`"false"`),
+		"core.client.screen_width":  json.RawMessage(`120`),
+		"core.client.timezone":      json.RawMessage(`"Europe/London"`),
+		"core.client.page_size":     json.RawMessage(`"50"`),
+		"core.client.locale":        json.RawMessage(`"pt_BR"`),
+		"core.notify.bell":          json.RawMessage(`true`),
+		"core.notify.announcements": json.RawMessage(`"false"`),
+	}
+	got := Load(context.Background(), settings.NewScopedForTest(host))
+	assert.Equal(t, Preferences{
+		ANSI:        false,
+		ScreenWidth: 120,
+		Timezone:    "Europe/London",
+		PageSize:    50,
+		Locale:      "pt-br",
+		NotifyBell:  true,
+	}, got)
+	assert.Equal(t, "Europe/London", got.Location().String())
+}
+
+func TestLoadIgnoresInvalidStoredValues(t *testing.T) {
+	host := map[string]json.RawMessage{
+		"core.client.ansi":         json.RawMessage(`"sometimes"`),
+		"core.client.screen_width": json.RawMessage(`5`),
+		"core.client.timezone":     json.RawMessage(`"Mars/Olympus_Mons"`),
+		"core.client.page_size":    json.RawMessage(`100000`),
+		"core.client.locale":       json.RawMessage(`"not a locale"`),
+	}
+	assert.Equal(t, Defaults(), Load(context.Background(), settings.NewScopedForTest(host)))
+}
+
+func TestDefinitionsParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"ansi", "OFF", "false"},
+		{"ansi", " yes ", "true"},
+		{"bell", "1", "true"},
+		{"width", "80", "80"},
+		{"width", "20", "20"},
+		{"pagesize", "200", "200"},
+		{"timezone", "America/New_York", "America/New_York"},
+		{"timezone", "utc", "UTC"},
+		{"locale", "pt_BR", "pt-br"},
+		{"locale", " de ", "de"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"="+tt.value, func(t *testing.T) {
+			def, err := Lookup(tt.name)
+			require.NoError(t, err)
+			got, err := def.parse(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDefinitionsRejectInvalidValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"ansi", "maybe"},
+		{"width", "19"},
+		{"width", "251"},
+		{"width", "wide"},
+		{"pagesize", "4"},
+		{"timezone", "Local"},
+		{"timezone", ""},
+		{"timezone", "Mars/Olympus_Mons"},
+		{"locale", ""},
+		{"locale", "english please"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"="+tt.value, func(t *testing.T) {
+			def, err := Lookup(tt.name)
+			require.NoError(t, err)
+			_, err = def.parse(tt.value)
+			errutil.AssertErrorCode(t, err, "PREFERENCE_INVALID_VALUE")
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	def, err := Lookup("ANSI")
+	require.NoError(t, err)
+	assert.Equal(t, "core.client.ansi", def.Key)
+	assert.Equal(t, "on", def.Value(Defaults()))
+
+	_, err = Lookup("colour")
+	errutil.AssertErrorCode(t, err, "PREFERENCE_UNKNOWN")
+}
+
+func TestDefinitionKeysAreValidHostKeys(t *testing.T) {
+	for _, def := range Definitions() {
+		assert.NoError(t, settings.ValidateNamespace(def.Key), def.Name)
+	}
+	assert.Equal(t, []string{"announce", "ansi", "bell", "locale", "pagesize", "timezone", "width"}, Names())
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/settings"
+)
+
+// SetRequest asks to change one of a player's preferences. CharacterID is
+// the character they are playing, whose stream gets the
+// preferences_changed event; zero skips the event. Value is the value as
+// typed; see Definition.
+type SetRequest struct {
+	PlayerID    ulid.ULID
+	CharacterID ulid.ULID
+	Name        string
+	Value       string
+}
+
+// Change describes a committed preference change.
+type Change struct {
+	PlayerID    ulid.ULID
+	CharacterID ulid.ULID
+	// Name is the short name of the preference that changed.
+	Name string
+	// Preferences is the player's complete preferences after the change.
+	Preferences Preferences
+}
+
+// Listener is told about each committed preference change. It runs on the
+// goroutine that made the change and MUST NOT block.
+type Listener interface {
+	PreferencesChanged(ctx context.Context, change Change)
+}
+
+// Service reads and changes player preferences. Reads never fail: a player
+// whose settings cannot be loaded gets the defaults.
+//
+// Changes are announced to in-process listeners and, once the event bus is
+// up and SetPublisher has been called, as a preferences_changed event on the
+// acting character's stream. Until then changes are stored but transports
+// only see them at the player's next login.
+type Service struct {
+	store  settings.PlayerSettingsStore
+	logger *slog.Logger
+
+	mu        sync.RWMutex
+	pub       eventbus.Publisher
+	gameID    func() string
+	listeners []Listener
+}
+
+// NewService creates a Service over the player settings store. store is
+// required; a nil logger uses slog.Default().
+func NewService(store settings.PlayerSettingsStore, logger *slog.Logger) (*Service, error) {
+	if store == nil {
+		return nil, oops.Errorf("player settings store is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{store: store, logger: logger}, nil
+}
+
+// SetPublisher binds the publisher used for preferences_changed events.
+// gameID supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+// Subscribe registers l to be told about every committed change.
+func (s *Service) Subscribe(l Listener) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, l)
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// Get returns the player's preferences with defaults filled in.
+func (s *Service) Get(ctx context.Context, playerID ulid.ULID) Preferences {
+	return Load(ctx, s.store.For(ctx, playerID))
+}
+
+// ANSI reports whether the player wants colors and text styles.
+func (s *Service) ANSI(ctx context.Context, playerID ulid.ULID) bool {
+	return s.Get(ctx, playerID).ANSI
+}
+
+// ScreenWidth returns the column count to wrap the player's output to.
+func (s *Service) ScreenWidth(ctx context.Context, playerID ulid.ULID) int {
+	return s.Get(ctx, playerID).ScreenWidth
+}
+
+// Location returns the time zone to show the player times in.
+func (s *Service) Location(ctx context.Context, playerID ulid.ULID) *time.Location {
+	return s.Get(ctx, playerID).Location()
+}
+
+// PageSize returns how many rows of a long listing to show the player at
+// once.
+func (s *Service) PageSize(ctx context.Context, playerID ulid.ULID) int {
+	return s.Get(ctx, playerID).PageSize
+}
+
+// Locale returns the message-catalog locale to show the player host text
+// in.
+func (s *Service) Locale(ctx context.Context, playerID ulid.ULID) string {
+	return s.Get(ctx, playerID).Locale
+}
+
+// Set changes one preference and returns the player's preferences after
+// the change. Returns:
+//
+//   - PREFERENCE_UNKNOWN when there is no preference by that name;
+//   - PREFERENCE_INVALID_VALUE when the value does not parse or is out of
+//     range;
+//   - PREFERENCE_WRITE_FAILED when the settings store refuses the write.
+//
+// The change is committed before anyone is told about it; a failure to
+// publish the event is logged, not returned.
+func (s *Service) Set(ctx context.Context, req SetRequest) (Preferences, error) {
+	def, err := Lookup(req.Name)
+	if err != nil {
+		return Preferences{}, err
+	}
+	value, err := def.parse(req.Value)
+	if err != nil {
+		return Preferences{}, oops.With("name", def.Name).Wrap(err)
+	}
+	return s.write(ctx, req, def, value)
+}
+
+// Reset puts one preference back to its default and returns the player's
+// preferences after the change. Errors are as for Set.
+func (s *Service) Reset(ctx context.Context, req SetRequest) (Preferences, error) {
+	def, err := Lookup(req.Name)
+	if err != nil {
+		return Preferences{}, err
+	}
+	value, err := def.parse(def.Value(Defaults()))
+	if err != nil {
+		return Preferences{}, oops.With("name", def.Name).Wrap(err)
+	}
+	return s.write(ctx, req, def, value)
+}
+
+func (s *Service) write(ctx context.Context, req SetRequest, def Definition, value string) (Preferences, error) {
+	scoped := s.store.For(ctx, req.PlayerID)
+	if err := scoped.Host().SetString(ctx, def.Key, value); err != nil {
+		return Preferences{}, oops.Code("PREFERENCE_WRITE_FAILED").
+			With("player_id", req.PlayerID.String()).
+			With("name", def.Name).
+			Wrap(err)
+	}
+	prefs := Load(ctx, scoped)
+	s.logger.InfoContext(ctx, "preference changed",
+		"player_id", req.PlayerID.String(),
+		"name", def.Name,
+		"value", def.Value(prefs))
+
+	change := Change{PlayerID: req.PlayerID, CharacterID: req.CharacterID, Name: def.Name, Preferences: prefs}
+	s.mu.RLock()
+	listeners := append([]Listener(nil), s.listeners...)
+	s.mu.RUnlock()
+	for _, l := range listeners {
+		l.PreferencesChanged(ctx, change)
+	}
+	if req.CharacterID != (ulid.ULID{}) {
+		if err := s.publish(ctx, req.CharacterID, prefs, def.Name); err != nil {
+			s.logger.WarnContext(ctx, "preferences_changed event failed",
+				"player_id", req.PlayerID.String(),
+				"character_id", req.CharacterID.String(),
+				"error", err)
+		}
+	}
+	return prefs, nil
+}
+
+// PublishPreferences sends the player's preferences to the character's
+// stream as a preferences_changed event with no Changed name, so the
+// transport a fresh session arrives on starts from them. No-op until
+// SetPublisher is called.
+func (s *Service) PublishPreferences(ctx context.Context, playerID, characterID ulid.ULID) error {
+	return s.publish(ctx, characterID, s.Get(ctx, playerID), "")
+}
+
+func (s *Service) publish(ctx context.Context, characterID ulid.ULID, prefs Preferences, changed string) error {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return nil
+	}
+	data, err := json.Marshal(eventvocab.PreferencesChangedPayload{
+		ANSI:                prefs.ANSI,
+		ScreenWidth:         prefs.ScreenWidth,
+		Timezone:            prefs.Timezone,
+		PageSize:            prefs.PageSize,
+		Locale:              prefs.Locale,
+		NotifyBell:          prefs.NotifyBell,
+		NotifyAnnouncements: prefs.NotifyAnnouncements,
+		Changed:             changed,
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_preferences_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+characterID.String())
+	if err != nil {
+		return oops.With("character_id", characterID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypePreferencesChanged))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypePreferencesChanged)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, data)); err != nil {
+		return oops.Code("PREFERENCE_PUBLISH_FAILED").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
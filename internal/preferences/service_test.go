@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package preferences
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/settings"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memStore is an in-memory PlayerSettingsStore: each player gets one
+// scoped view, so writes through one For handle are seen by the next.
+type memStore struct {
+	mu      sync.Mutex
+	players map[ulid.ULID]settings.Scoped
+	failErr error
+}
+
+func newMemStore() *memStore {
+	return &memStore{players: map[ulid.ULID]settings.Scoped{}}
+}
+
+func (m *memStore) For(_ context.Context, playerID ulid.ULID) settings.Scoped {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	scoped, ok := m.players[playerID]
+	if !ok {
+		scoped = settings.NewScopedForTest(nil)
+		m.players[playerID] = scoped
+	}
+	if m.failErr != nil {
+		return failingScoped{Scoped: scoped, err: m.failErr}
+	}
+	return scoped
+}
+
+func (m *memStore) SetString(ctx context.Context, playerID ulid.ULID, key, value string) error {
+	return m.For(ctx, playerID).Host().SetString(ctx, key, value)
+}
+
+// failingScoped refuses every host write with err.
+type failingScoped struct {
+	settings.Scoped
+	err error
+}
+
+func (f failingScoped) Host() settings.Writable {
+	return failingWritable{Writable: f.Scoped.Host(), err: f.err}
+}
+
+type failingWritable struct {
+	settings.Writable
+	err error
+}
+
+func (f failingWritable) SetString(context.Context, string, string) error { return f.err }
+
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+type recordingListener struct {
+	changes []Change
+}
+
+func (r *recordingListener) PreferencesChanged(_ context.Context, change Change) {
+	r.changes = append(r.changes, change)
+}
+
+func newTestService(t *testing.T) (*Service, *memStore) {
+	t.Helper()
+	store := newMemStore()
+	svc, err := NewService(store, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	return svc, store
+}
+
+func decodePayload(t *testing.T, ev eventbus.Event) eventvocab.PreferencesChangedPayload {
+	t.Helper()
+	var payload eventvocab.PreferencesChangedPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	return payload
+}
+
+func TestNewServiceRequiresStore(t *testing.T) {
+	_, err := NewService(nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceGetReturnsDefaultsForNewPlayer(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+	playerID := ulid.Make()
+
+	assert.Equal(t, Defaults(), svc.Get(ctx, playerID))
+	assert.True(t, svc.ANSI(ctx, playerID))
+	assert.Equal(t, DefaultScreenWidth, svc.ScreenWidth(ctx, playerID))
+	assert.Equal(t, DefaultPageSize, svc.PageSize(ctx, playerID))
+	assert.Equal(t, "en", svc.Locale(ctx, playerID))
+	assert.Equal(t, "UTC", svc.Location(ctx, playerID).String())
+}
+
+func TestServiceSetStoresAndAnnouncesChange(t *testing.T) {
+	svc, _ := newTestService(t)
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, func() string { return "" })
+	listener := &recordingListener{}
+	svc.Subscribe(listener)
+	ctx := context.Background()
+	playerID, charID := ulid.Make(), ulid.Make()
+
+	prefs, err := svc.Set(ctx, SetRequest{PlayerID: playerID, CharacterID: charID, Name: "Width", Value: "100"})
+	require.NoError(t, err)
+	assert.Equal(t, 100, prefs.ScreenWidth)
+	assert.Equal(t, 100, svc.ScreenWidth(ctx, playerID), "the change is stored")
+
+	require.Len(t, listener.changes, 1)
+	assert.Equal(t, Change{PlayerID: playerID, CharacterID: charID, Name: "width", Preferences: prefs}, listener.changes[0])
+
+	require.Len(t, pub.published, 1)
+	ev := pub.published[0]
+	assert.Equal(t, "events.main.character."+charID.String(), string(ev.Subject))
+	assert.Equal(t, string(eventvocab.EventTypePreferencesChanged), string(ev.Type))
+	assert.Equal(t, eventvocab.PreferencesChangedPayload{
+		ANSI: true, ScreenWidth: 100, Timezone: "UTC", PageSize: DefaultPageSize,
+		Locale: "en", NotifyAnnouncements: true, Changed: "width",
+	}, decodePayload(t, ev))
+}
+
+func TestServiceSetWithoutCharacterSkipsEvent(t *testing.T) {
+	svc, _ := newTestService(t)
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, func() string { return "game" })
+	ctx := context.Background()
+
+	_, err := svc.Set(ctx, SetRequest{PlayerID: ulid.Make(), Name: "ansi", Value: "off"})
+	require.NoError(t, err)
+	assert.Empty(t, pub.published)
+}
+
+func TestServiceSetSucceedsWhenPublishFails(t *testing.T) {
+	svc, _ := newTestService(t)
+	svc.SetPublisher(&fakePublisher{err: errors.New("bus down")}, func() string { return "game" })
+	ctx := context.Background()
+	playerID := ulid.Make()
+
+	_, err := svc.Set(ctx, SetRequest{PlayerID: playerID, CharacterID: ulid.Make(), Name: "ansi", Value: "off"})
+	require.NoError(t, err)
+	assert.False(t, svc.ANSI(ctx, playerID))
+}
+
+func TestServiceSetErrors(t *testing.T) {
+	svc, store := newTestService(t)
+	ctx := context.Background()
+	playerID := ulid.Make()
+
+	_, err := svc.Set(ctx, SetRequest{PlayerID: playerID, Name: "colour", Value: "on"})
+	errutil.AssertErrorCode(t, err, "PREFERENCE_UNKNOWN")
+
+	_, err = svc.Set(ctx, SetRequest{PlayerID: playerID, Name: "pagesize", Value: "1000"})
+	errutil.AssertErrorCode(t, err, "PREFERENCE_INVALID_VALUE")
+
+	store.failErr = errors.New("db down")
+	_, err = svc.Set(ctx, SetRequest{PlayerID: playerID, Name: "ansi", Value: "off"})
+	errutil.AssertErrorCode(t, err, "PREFERENCE_WRITE_FAILED")
+	store.failErr = nil
+	assert.Equal(t, Defaults(), svc.Get(ctx, playerID), "nothing was stored")
+}
+
+func TestServiceResetRestoresDefault(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+	playerID := ulid.Make()
+
+	_, err := svc.Set(ctx, SetRequest{PlayerID: playerID, Name: "timezone", Value: "Asia/Tokyo"})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", svc.Location(ctx, playerID).String())
+
+	prefs, err := svc.Reset(ctx, SetRequest{PlayerID: playerID, Name: "timezone"})
+	require.NoError(t, err)
+	assert.Equal(t, Defaults(), prefs)
+}
+
+func TestServicePublishPreferencesSendsSnapshot(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+	playerID, charID := ulid.Make(), ulid.Make()
+	_, err := svc.Set(ctx, SetRequest{PlayerID: playerID, Name: "bell", Value: "on"})
+	require.NoError(t, err)
+
+	// No publisher yet: nothing to send, nothing to fail.
+	require.NoError(t, svc.PublishPreferences(ctx, playerID, charID))
+
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, func() string { return "game" })
+	require.NoError(t, svc.PublishPreferences(ctx, playerID, charID))
+	require.Len(t, pub.published, 1)
+	assert.Equal(t, "events.game.character."+charID.String(), string(pub.published[0].Subject))
+	payload := decodePayload(t, pub.published[0])
+	assert.True(t, payload.NotifyBell)
+	assert.Empty(t, payload.Changed, "a snapshot names no change")
+
+	pub.err = errors.New("bus down")
+	errutil.AssertErrorCode(t, svc.PublishPreferences(ctx, playerID, charID), "PREFERENCE_PUBLISH_FAILED")
+}
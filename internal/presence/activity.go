@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package presence
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/session"
+)
+
+// DefaultAFKAfter is the idle time before a character is marked AFK when
+// ActivityConfig.AFKAfter is unset.
+const DefaultAFKAfter = 15 * time.Minute
+
+const defaultSweepInterval = 30 * time.Second
+
+// IdleEmitter publishes idle transitions. *Emitter satisfies it.
+type IdleEmitter interface {
+	EmitAFK(ctx context.Context, char core.CharacterRef, idleFor time.Duration) error
+	EmitBack(ctx context.Context, char core.CharacterRef, idleFor time.Duration) error
+}
+
+// CharacterLocator finds a character's session, and so where the character
+// is now. session.Store satisfies it.
+type CharacterLocator interface {
+	FindByCharacter(ctx context.Context, characterID ulid.ULID) (*session.Info, error)
+}
+
+// ActivityConfig configures an ActivityTracker.
+type ActivityConfig struct {
+	AFKAfter time.Duration    // idle time before a character is marked AFK (default: 15m)
+	Interval time.Duration    // how often Run sweeps for newly idle characters (default: 30s)
+	Now      func() time.Time // clock override for tests (default: time.Now)
+	// Locator reads the character's location when an afk or back event is
+	// emitted. Nil uses the location the character had at its last Touch,
+	// which is stale once a command has moved it.
+	Locator CharacterLocator
+}
+
+// IdleTransition describes a character crossing the AFK boundary.
+type IdleTransition struct {
+	Character core.CharacterRef
+	AFK       bool          // true on afk, false on back
+	IdleFor   time.Duration // idle duration at the transition
+}
+
+// IdleListener observes idle transitions. Listeners run synchronously after
+// the transition event is published and MUST NOT block; plugins that need
+// idle hooks for ambience or cleanup subscribe to the afk/back events on the
+// location stream instead.
+type IdleListener func(ctx context.Context, t IdleTransition)
+
+type activity struct {
+	char       core.CharacterRef
+	lastActive time.Time
+	afk        bool
+}
+
+// ActivityTracker records per-character activity and marks characters AFK
+// once they have been idle longer than ActivityConfig.AFKAfter. State is
+// in-process and rebuilt from command traffic after a restart: a character
+// is active from its first Touch.
+type ActivityTracker struct {
+	config  ActivityConfig
+	emitter IdleEmitter
+
+	mu        sync.Mutex
+	chars     map[ulid.ULID]*activity
+	listeners []IdleListener
+}
+
+// NewActivityTracker creates a tracker publishing transitions through
+// emitter. Zero config fields take their defaults.
+func NewActivityTracker(config ActivityConfig, emitter IdleEmitter) *ActivityTracker {
+	if config.AFKAfter <= 0 {
+		config.AFKAfter = DefaultAFKAfter
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaultSweepInterval
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &ActivityTracker{
+		config:  config,
+		emitter: emitter,
+		chars:   make(map[ulid.ULID]*activity),
+	}
+}
+
+// Subscribe registers l for every subsequent idle transition.
+func (t *ActivityTracker) Subscribe(l IdleListener) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.listeners = append(t.listeners, l)
+}
+
+// Touch records activity for char. A character currently AFK transitions
+// back and a back event is emitted to its location stream.
+func (t *ActivityTracker) Touch(ctx context.Context, char core.CharacterRef) {
+	now := t.config.Now()
+
+	t.mu.Lock()
+	a, ok := t.chars[char.ID]
+	if !ok {
+		t.chars[char.ID] = &activity{char: char, lastActive: now}
+		t.mu.Unlock()
+		return
+	}
+	wasAFK := a.afk
+	idleFor := now.Sub(a.lastActive)
+	a.char = char
+	a.lastActive = now
+	a.afk = false
+	t.mu.Unlock()
+
+	if wasAFK {
+		t.transition(ctx, IdleTransition{Character: char, AFK: false, IdleFor: idleFor})
+	}
+}
+
+// Forget drops tracking for charID, e.g. when its last session ends. No
+// event is emitted: the leave/session_ended events already cover it.
+func (t *ActivityTracker) Forget(charID ulid.ULID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.chars, charID)
+}
+
+// IdleDuration returns how long charID has been idle. ok is false for an
+// untracked character.
+func (t *ActivityTracker) IdleDuration(charID ulid.ULID) (idle time.Duration, ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	a, ok := t.chars[charID]
+	if !ok {
+		return 0, false
+	}
+	return t.config.Now().Sub(a.lastActive), true
+}
+
+// IsAFK reports whether charID is currently marked AFK.
+func (t *ActivityTracker) IsAFK(charID ulid.ULID) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	a, ok := t.chars[charID]
+	return ok && a.afk
+}
+
+// Run starts the sweep loop. Blocks until context is cancelled.
+func (t *ActivityTracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep marks every character idle past the AFK threshold as AFK and emits
+// an afk event for each. Exported so callers and tests can drive a sweep
+// without waiting for the ticker.
+func (t *ActivityTracker) Sweep(ctx context.Context) {
+	now := t.config.Now()
+
+	var transitions []IdleTransition
+	t.mu.Lock()
+	for _, a := range t.chars {
+		if a.afk {
+			continue
+		}
+		idleFor := now.Sub(a.lastActive)
+		if idleFor < t.config.AFKAfter {
+			continue
+		}
+		a.afk = true
+		transitions = append(transitions, IdleTransition{Character: a.char, AFK: true, IdleFor: idleFor})
+	}
+	t.mu.Unlock()
+
+	for _, tr := range transitions {
+		t.transition(ctx, tr)
+	}
+}
+
+// transition publishes tr and fans it out to listeners. Publish failures
+// are logged, not returned: idle state is advisory and MUST NOT fail the
+// command path that triggered a back transition.
+func (t *ActivityTracker) transition(ctx context.Context, tr IdleTransition) {
+	tr.Character.LocationID = t.locate(ctx, tr.Character)
+	if t.emitter != nil {
+		emit := t.emitter.EmitBack
+		if tr.AFK {
+			emit = t.emitter.EmitAFK
+		}
+		if err := emit(ctx, tr.Character, tr.IdleFor); err != nil {
+			slog.WarnContext(ctx, "activity: failed to emit idle transition",
+				"character_id", tr.Character.ID.String(),
+				"afk", tr.AFK,
+				"error", err)
+		}
+	}
+
+	t.mu.Lock()
+	listeners := append([]IdleListener(nil), t.listeners...)
+	t.mu.Unlock()
+	for _, l := range listeners {
+		l(ctx, tr)
+	}
+}
+
+// locate returns char's current location from the configured Locator,
+// falling back to the location recorded at its last Touch when there is no
+// Locator or the lookup fails.
+func (t *ActivityTracker) locate(ctx context.Context, char core.CharacterRef) ulid.ULID {
+	if t.config.Locator == nil {
+		return char.LocationID
+	}
+	info, err := t.config.Locator.FindByCharacter(ctx, char.ID)
+	if err != nil || info == nil || info.LocationID.IsZero() {
+		if err != nil {
+			slog.DebugContext(ctx, "activity: character location lookup failed; using last known location",
+				"character_id", char.ID.String(),
+				"error", err)
+		}
+		return char.LocationID
+	}
+	return info.LocationID
+}
@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package presence
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/session"
+)
+
+type idleCall struct {
+	afk     bool
+	char    core.CharacterRef
+	idleFor time.Duration
+}
+
+// fakeIdleEmitter records EmitAFK/EmitBack calls.
+type fakeIdleEmitter struct {
+	mu    sync.Mutex
+	calls []idleCall
+	err   error
+}
+
+func (f *fakeIdleEmitter) EmitAFK(_ context.Context, char core.CharacterRef, idleFor time.Duration) error {
+	return f.record(true, char, idleFor)
+}
+
+func (f *fakeIdleEmitter) EmitBack(_ context.Context, char core.CharacterRef, idleFor time.Duration) error {
+	return f.record(false, char, idleFor)
+}
+
+func (f *fakeIdleEmitter) record(afk bool, char core.CharacterRef, idleFor time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, idleCall{afk: afk, char: char, idleFor: idleFor})
+	return f.err
+}
+
+func (f *fakeIdleEmitter) recorded() []idleCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]idleCall(nil), f.calls...)
+}
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestTracker(emitter IdleEmitter) (*ActivityTracker, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	return NewActivityTracker(ActivityConfig{AFKAfter: 10 * time.Minute, Now: clock.Now}, emitter), clock
+}
+
+func testChar() core.CharacterRef {
+	return core.CharacterRef{ID: core.NewULID(), Name: "Alyssa", LocationID: core.NewULID()}
+}
+
+func TestNewActivityTrackerAppliesDefaults(t *testing.T) {
+	tr := NewActivityTracker(ActivityConfig{}, nil)
+	assert.Equal(t, DefaultAFKAfter, tr.config.AFKAfter)
+	assert.Equal(t, defaultSweepInterval, tr.config.Interval)
+	assert.NotNil(t, tr.config.Now)
+}
+
+func TestActivityTrackerSweepMarksIdleCharacterAFK(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(11 * time.Minute)
+	tr.Sweep(context.Background())
+
+	assert.True(t, tr.IsAFK(char.ID))
+	calls := em.recorded()
+	require.Len(t, calls, 1)
+	assert.True(t, calls[0].afk)
+	assert.Equal(t, char, calls[0].char)
+	assert.Equal(t, 11*time.Minute, calls[0].idleFor)
+}
+
+func TestActivityTrackerSweepLeavesRecentlyActiveCharacterAlone(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(9 * time.Minute)
+	tr.Sweep(context.Background())
+
+	assert.False(t, tr.IsAFK(char.ID))
+	assert.Empty(t, em.recorded())
+}
+
+func TestActivityTrackerSweepEmitsAFKOnlyOnce(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+
+	tr.Touch(context.Background(), testChar())
+	clock.Advance(11 * time.Minute)
+	tr.Sweep(context.Background())
+	clock.Advance(time.Minute)
+	tr.Sweep(context.Background())
+
+	assert.Len(t, em.recorded(), 1)
+}
+
+func TestActivityTrackerTouchAfterAFKEmitsBack(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(20 * time.Minute)
+	tr.Sweep(context.Background())
+	tr.Touch(context.Background(), char)
+
+	assert.False(t, tr.IsAFK(char.ID))
+	calls := em.recorded()
+	require.Len(t, calls, 2)
+	assert.False(t, calls[1].afk)
+	assert.Equal(t, 20*time.Minute, calls[1].idleFor)
+}
+
+func TestActivityTrackerTouchWhileActiveEmitsNothing(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(time.Minute)
+	tr.Touch(context.Background(), char)
+
+	assert.Empty(t, em.recorded())
+}
+
+func TestActivityTrackerIdleDurationReportsTimeSinceLastTouch(t *testing.T) {
+	tr, clock := newTestTracker(&fakeIdleEmitter{})
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(3 * time.Minute)
+
+	idle, ok := tr.IdleDuration(char.ID)
+	require.True(t, ok)
+	assert.Equal(t, 3*time.Minute, idle)
+}
+
+func TestActivityTrackerIdleDurationReportsUntrackedCharacter(t *testing.T) {
+	tr, _ := newTestTracker(&fakeIdleEmitter{})
+
+	_, ok := tr.IdleDuration(core.NewULID())
+	assert.False(t, ok)
+}
+
+func TestActivityTrackerForgetStopsTracking(t *testing.T) {
+	em := &fakeIdleEmitter{}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	tr.Touch(context.Background(), char)
+	tr.Forget(char.ID)
+	clock.Advance(time.Hour)
+	tr.Sweep(context.Background())
+
+	_, ok := tr.IdleDuration(char.ID)
+	assert.False(t, ok)
+	assert.Empty(t, em.recorded())
+}
+
+func TestActivityTrackerSubscribeNotifiesListenersOfTransitions(t *testing.T) {
+	tr, clock := newTestTracker(&fakeIdleEmitter{})
+	char := testChar()
+
+	var got []IdleTransition
+	tr.Subscribe(func(_ context.Context, tr IdleTransition) { got = append(got, tr) })
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(15 * time.Minute)
+	tr.Sweep(context.Background())
+	tr.Touch(context.Background(), char)
+
+	require.Len(t, got, 2)
+	assert.True(t, got[0].AFK)
+	assert.False(t, got[1].AFK)
+	assert.Equal(t, char.ID, got[1].Character.ID)
+}
+
+func TestActivityTrackerEmitFailureStillMarksAFKAndNotifies(t *testing.T) {
+	em := &fakeIdleEmitter{err: errors.New("bus down")}
+	tr, clock := newTestTracker(em)
+	char := testChar()
+
+	notified := false
+	tr.Subscribe(func(_ context.Context, _ IdleTransition) { notified = true })
+
+	tr.Touch(context.Background(), char)
+	clock.Advance(11 * time.Minute)
+	tr.Sweep(context.Background())
+
+	assert.True(t, tr.IsAFK(char.ID))
+	assert.True(t, notified)
+}
+
+// fakeLocator reports a fixed session for every character.
+type fakeLocator struct {
+	info *session.Info
+	err  error
+}
+
+func (f fakeLocator) FindByCharacter(_ context.Context, _ ulid.ULID) (*session.Info, error) {
+	return f.info, f.err
+}
+
+func TestActivityTrackerEmitsAtCurrentLocation(t *testing.T) {
+	moved := core.NewULID()
+	tests := []struct {
+		name    string
+		locator CharacterLocator
+		moved   bool
+	}{
+		{"no locator uses last touch", nil, false},
+		{"locator reports current room", fakeLocator{info: &session.Info{LocationID: moved}}, true},
+		{"lookup failure falls back", fakeLocator{err: errors.New("store down")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			em := &fakeIdleEmitter{}
+			clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+			tr := NewActivityTracker(ActivityConfig{AFKAfter: 10 * time.Minute, Now: clock.Now, Locator: tt.locator}, em)
+			char := testChar()
+
+			tr.Touch(context.Background(), char)
+			clock.Advance(11 * time.Minute)
+			tr.Sweep(context.Background())
+
+			calls := em.recorded()
+			require.Len(t, calls, 1)
+			want := char.LocationID
+			if tt.moved {
+				want = moved
+			}
+			assert.Equal(t, want, calls[0].char.LocationID)
+		})
+	}
+}
+
+func TestActivityTrackerRunStopsOnContextCancel(t *testing.T) {
+	tr := NewActivityTracker(ActivityConfig{Interval: time.Millisecond}, &fakeIdleEmitter{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan struct{})
+	go func() {
+		tr.Run(ctx)
+		close(done)
+	}()
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after context cancel")
+	}
+}
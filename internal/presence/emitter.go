@@ -12,6 +12,8 @@ package presence
 import (
 	"context"
 	"encoding/json"
+	"sync"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
@@ -41,6 +43,9 @@ type LeavePayload struct {
 type Emitter struct {
 	pub    eventbus.Publisher
 	gameID func() string
+
+	mu      sync.Mutex
+	onEnded []func(characterID ulid.ULID)
 }
 
 // NewEmitter constructs an Emitter over pub, qualifying subjects with the
@@ -168,3 +173,40 @@ func (e *Emitter) EmitLeave(ctx context.Context, char core.CharacterRef, reason
 
 	return nil
 }
+
+// EmitAFK publishes an afk event on the character's location stream.
+func (e *Emitter) EmitAFK(ctx context.Context, char core.CharacterRef, idleFor time.Duration) error {
+	return e.emitIdle(ctx, char, eventvocab.EventTypeAFK, idleFor)
+}
+
+// EmitBack publishes a back event on the character's location stream.
+func (e *Emitter) EmitBack(ctx context.Context, char core.CharacterRef, idleFor time.Duration) error {
+	return e.emitIdle(ctx, char, eventvocab.EventTypeBack, idleFor)
+}
+
+func (e *Emitter) emitIdle(ctx context.Context, char core.CharacterRef, typ eventvocab.EventType, idleFor time.Duration) error {
+	payload, err := json.Marshal(eventvocab.IdlePayload{
+		CharacterID:   char.ID.String(),
+		CharacterName: char.Name,
+		IdleSeconds:   int64(idleFor / time.Second),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_"+string(typ)+"_payload").Wrap(err)
+	}
+
+	ev, err := e.buildEvent(
+		"location."+char.LocationID.String(),
+		typ,
+		core.Actor{Kind: core.ActorCharacter, ID: char.ID.String()},
+		payload,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := e.pub.Publish(ctx, ev); err != nil {
+		return oops.With("operation", "publish_"+string(typ)+"_event").Wrap(err)
+	}
+
+	return nil
+}
@@ -6,9 +6,11 @@ package presence
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -165,3 +167,47 @@ func TestEmitArriveFallsBackToMainGameIDWhenGameIDFuncReturnsEmpty(t *testing.T)
 	assert.True(t, strings.HasPrefix(string(events[0].Subject), "events.main."),
 		"an empty gameID() must fall back to 'main'")
 }
+
+func TestEmitAFKPublishesAFKEventWithIdleSeconds(t *testing.T) {
+	pub := &fakePublisher{}
+	e := NewEmitter(pub, mainGameID)
+
+	locationID := core.NewULID()
+	char := core.CharacterRef{ID: core.NewULID(), Name: "Alyssa", LocationID: locationID}
+
+	require.NoError(t, e.EmitAFK(context.Background(), char, 15*time.Minute))
+
+	events := pub.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, eventbus.Type(eventvocab.EventTypeAFK), events[0].Type)
+	assert.Equal(t, eventbus.Subject("events.main.location."+locationID.String()), events[0].Subject)
+
+	var payload eventvocab.IdlePayload
+	require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	assert.Equal(t, char.ID.String(), payload.CharacterID)
+	assert.Equal(t, int64(900), payload.IdleSeconds)
+}
+
+func TestEmitBackPublishesBackEvent(t *testing.T) {
+	pub := &fakePublisher{}
+	e := NewEmitter(pub, mainGameID)
+
+	char := core.CharacterRef{ID: core.NewULID(), Name: "Alyssa", LocationID: core.NewULID()}
+
+	require.NoError(t, e.EmitBack(context.Background(), char, time.Minute))
+
+	events := pub.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, eventbus.Type(eventvocab.EventTypeBack), events[0].Type)
+}
+
+func TestEmitAFKWrapsPublishError(t *testing.T) {
+	pub := &fakePublisher{err: errors.New("publish failed")}
+	e := NewEmitter(pub, mainGameID)
+
+	char := core.CharacterRef{ID: core.NewULID(), Name: "Alyssa", LocationID: core.NewULID()}
+
+	err := e.EmitAFK(context.Background(), char, time.Minute)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "publish failed")
+}
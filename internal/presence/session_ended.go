@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
 	"github.com/holomush/holomush/internal/core"
@@ -76,6 +77,10 @@ func (e *Emitter) EmitSessionEnded(
 	appendCtx, cancel := context.WithTimeout(context.Background(), sessionTerminalCommitTimeout)
 	defer cancel()
 
+	// Callers end the session whether or not the event persists, so the
+	// hooks run either way.
+	defer e.runSessionEnded(char.ID)
+
 	if err := e.pub.Publish(appendCtx, ev); err != nil {
 		return oops.Code("SESSION_ENDED_APPEND_FAILED").
 			With("session_id", sessionID).
@@ -85,3 +90,21 @@ func (e *Emitter) EmitSessionEnded(
 
 	return nil
 }
+
+// OnSessionEnded registers fn to run with the character's ID each time
+// EmitSessionEnded is called, whatever ended the session: quit, logout, a
+// boot, or the reaper. fn runs synchronously and MUST NOT block.
+func (e *Emitter) OnSessionEnded(fn func(characterID ulid.ULID)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.onEnded = append(e.onEnded, fn)
+}
+
+func (e *Emitter) runSessionEnded(characterID ulid.ULID) {
+	e.mu.Lock()
+	hooks := append([]func(characterID ulid.ULID){}, e.onEnded...)
+	e.mu.Unlock()
+	for _, fn := range hooks {
+		fn(characterID)
+	}
+}
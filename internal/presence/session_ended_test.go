@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -96,6 +97,24 @@ func TestEmitSessionEndedReturnsErrorWhenPublisherFails(t *testing.T) {
 	errutil.AssertErrorCode(t, err, "SESSION_ENDED_APPEND_FAILED")
 }
 
+func TestEmitSessionEndedRunsHooksEvenWhenPublishFails(t *testing.T) {
+	for name, pub := range map[string]eventbus.Publisher{
+		"published":      &fakePublisher{},
+		"publish failed": &publishFailPublisher{err: errors.New("disk full")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			e := NewEmitter(pub, mainGameID)
+			var ended []ulid.ULID
+			e.OnSessionEnded(func(characterID ulid.ULID) { ended = append(ended, characterID) })
+
+			char := core.CharacterRef{ID: core.NewULID(), Name: "Testy", LocationID: core.NewULID()}
+			_ = e.EmitSessionEnded(context.Background(), char, core.NewULID().String(), core.SessionEndedCauseReaped, "expired")
+
+			assert.Equal(t, []ulid.ULID{char.ID}, ended)
+		})
+	}
+}
+
 // ctxRecordingPublisher records the context passed to Publish so tests can
 // verify the publish ctx is decoupled from the caller's ctx.
 type ctxRecordingPublisher struct {
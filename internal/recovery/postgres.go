@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package recovery
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// requestColumns selects a request from recovery_requests.
+const requestColumns = `id, COALESCE(player_id, ''), username, filer_id, filer_name, evidence, code_hash, status,
+	COALESCE(reviewed_by, ''), COALESCE(reviewed_by_name, ''), COALESCE(review_note, ''), reviewed_at, created_at`
+
+// PostgresStore implements Repository against the recovery_requests table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts r unless its filer or account is over limits. A second
+// pending request for an account is also refused by the
+// recovery_requests_one_pending index, for filings that race.
+func (s *PostgresStore) Create(ctx context.Context, r *Request, limits Limits) error {
+	since := pgnanos.From(limits.Since)
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO recovery_requests (id, player_id, username, filer_id, filer_name, evidence, code_hash, status, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9
+		 WHERE (SELECT COUNT(*) FROM recovery_requests WHERE filer_id = $4 AND created_at >= $10) < $11
+		   AND ($2::text IS NULL OR (
+		        (SELECT COUNT(*) FROM recovery_requests WHERE player_id = $2 AND created_at >= $10) < $12
+		        AND NOT EXISTS (SELECT 1 FROM recovery_requests WHERE player_id = $2 AND status = 'pending')))
+		ON CONFLICT DO NOTHING
+	`, r.ID.String(), optionalID(r.PlayerID), r.Username, r.Filer.ID.String(), r.Filer.Name, r.Evidence,
+		r.CodeHash, string(r.Status), pgnanos.From(r.CreatedAt), since, limits.PerFiler, limits.PerAccount)
+	if err != nil {
+		return oops.Code("RECOVERY_STORE_FAILED").
+			With("operation", "create").
+			With("filer_id", r.Filer.ID.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 1 {
+		return nil
+	}
+	var filed int
+	if err := s.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM recovery_requests WHERE filer_id = $1 AND created_at >= $2
+	`, r.Filer.ID.String(), since).Scan(&filed); err != nil {
+		return oops.Code("RECOVERY_STORE_FAILED").
+			With("operation", "count_filed").
+			With("filer_id", r.Filer.ID.String()).
+			Wrap(err)
+	}
+	if filed >= limits.PerFiler {
+		return oops.Code("RECOVERY_RATE_LIMITED").
+			With("filer_id", r.Filer.ID.String()).
+			With("limit", limits.PerFiler).
+			Wrap(ErrRateLimited)
+	}
+	return oops.Code("RECOVERY_ACCOUNT_BUSY").
+		With("player_id", r.PlayerID.String()).
+		Wrap(ErrAccountBusy)
+}
+
+// Get returns the request with id.
+func (s *PostgresStore) Get(ctx context.Context, id ulid.ULID) (*Request, error) {
+	r, err := scanRequest(s.pool.QueryRow(ctx, `
+		SELECT `+requestColumns+` FROM recovery_requests WHERE id = $1
+	`, id.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("RECOVERY_NOT_FOUND").With("request_id", id.String()).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("RECOVERY_STORE_FAILED").
+			With("operation", "get").
+			With("request_id", id.String()).
+			Wrap(err)
+	}
+	return r, nil
+}
+
+// GetByCodeHash returns the request whose code hashes to hash.
+func (s *PostgresStore) GetByCodeHash(ctx context.Context, hash string) (*Request, error) {
+	r, err := scanRequest(s.pool.QueryRow(ctx, `
+		SELECT `+requestColumns+` FROM recovery_requests WHERE code_hash = $1
+	`, hash))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("RECOVERY_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("RECOVERY_STORE_FAILED").With("operation", "get_by_code").Wrap(err)
+	}
+	return r, nil
+}
+
+// ListPending returns the pending requests, oldest first.
+func (s *PostgresStore) ListPending(ctx context.Context) ([]*Request, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT `+requestColumns+`
+		  FROM recovery_requests
+		 WHERE status = 'pending'
+		 ORDER BY created_at, id
+	`)
+	if err != nil {
+		return nil, oops.Code("RECOVERY_STORE_FAILED").With("operation", "list_pending").Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*Request
+	for rows.Next() {
+		r, err := scanRequest(rows)
+		if err != nil {
+			return nil, oops.Code("RECOVERY_STORE_FAILED").With("operation", "list_pending").Wrap(err)
+		}
+		out = append(out, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("RECOVERY_STORE_FAILED").With("operation", "list_pending").Wrap(err)
+	}
+	return out, nil
+}
+
+// Review decides the request when it is pending. When the update matches
+// no row the request is read back so the caller can see why.
+func (s *PostgresStore) Review(ctx context.Context, id ulid.ULID, status Status, reviewer CharacterRef, note string, at time.Time) (*Request, bool, error) {
+	r, err := scanRequest(s.pool.QueryRow(ctx, `
+		UPDATE recovery_requests
+		   SET status = $2, reviewed_by = $3, reviewed_by_name = $4, review_note = $5, reviewed_at = $6
+		 WHERE id = $1 AND status = 'pending'
+		RETURNING `+requestColumns,
+		id.String(), string(status), reviewer.ID.String(), reviewer.Name, note, pgnanos.From(at)))
+	if err == nil {
+		return r, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, oops.Code("RECOVERY_STORE_FAILED").
+			With("operation", "review").
+			With("request_id", id.String()).
+			Wrap(err)
+	}
+	r, err = s.Get(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return r, false, nil
+}
+
+// Reopen returns an approved request to pending.
+func (s *PostgresStore) Reopen(ctx context.Context, id ulid.ULID) error {
+	if _, err := s.pool.Exec(ctx, `
+		UPDATE recovery_requests
+		   SET status = 'pending', reviewed_by = NULL, reviewed_by_name = NULL, review_note = NULL, reviewed_at = NULL
+		 WHERE id = $1 AND status = 'approved'
+	`, id.String()); err != nil {
+		return oops.Code("RECOVERY_STORE_FAILED").
+			With("operation", "reopen").
+			With("request_id", id.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+func scanRequest(row pgx.Row) (*Request, error) {
+	var (
+		r                                 Request
+		id, playerID, filerID, reviewerID string
+		status                            string
+		createdAt                         pgnanos.Time
+		reviewedAt                        *pgnanos.Time
+	)
+	if err := row.Scan(&id, &playerID, &r.Username, &filerID, &r.Filer.Name, &r.Evidence, &r.CodeHash, &status,
+		&reviewerID, &r.Reviewer.Name, &r.Note, &reviewedAt, &createdAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	var err error
+	if r.ID, err = ulid.Parse(id); err != nil {
+		return nil, oops.With("request_id", id).Wrap(err)
+	}
+	for _, f := range []struct {
+		dst *ulid.ULID
+		src string
+	}{
+		{&r.PlayerID, playerID},
+		{&r.Filer.ID, filerID},
+		{&r.Reviewer.ID, reviewerID},
+	} {
+		if *f.dst, err = parseOptionalID(f.src); err != nil {
+			return nil, oops.With("request_id", id).Wrap(err)
+		}
+	}
+	r.Status = Status(status)
+	r.CreatedAt = createdAt.Time()
+	if reviewedAt != nil {
+		r.ReviewedAt = reviewedAt.Time()
+	}
+	return &r, nil
+}
+
+// optionalID returns nil for the zero ULID, so it is stored as NULL.
+func optionalID(id ulid.ULID) *string {
+	if id == (ulid.ULID{}) {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// parseOptionalID parses a ULID column read through COALESCE, where ""
+// stands for NULL.
+func parseOptionalID(s string) (ulid.ULID, error) {
+	if s == "" {
+		return ulid.ULID{}, nil
+	}
+	id, err := ulid.Parse(s)
+	if err != nil {
+		return ulid.ULID{}, oops.With("id", s).Wrap(err)
+	}
+	return id, nil
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package recovery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/recovery"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// createPlayer inserts a player for requests to name.
+func createPlayer(t *testing.T, pool *pgxpool.Pool) ulid.ULID {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO players (id, username, password_hash) VALUES ($1, $2, 'x')`,
+		id.String(), "recover-"+id.String())
+	require.NoError(t, err)
+	return id
+}
+
+func newRequest(playerID ulid.ULID, filer recovery.CharacterRef, at time.Time) *recovery.Request {
+	status := recovery.StatusPending
+	if playerID == (ulid.ULID{}) {
+		status = recovery.StatusUnmatched
+	}
+	id := idgen.New()
+	return &recovery.Request{
+		ID:        id,
+		PlayerID:  playerID,
+		Username:  "alice",
+		Filer:     filer,
+		Evidence:  "I played Alice.",
+		CodeHash:  "hash-" + id.String(),
+		Status:    status,
+		CreatedAt: at,
+	}
+}
+
+func limits(at time.Time) recovery.Limits {
+	return recovery.Limits{Since: at.Add(-recovery.RequestWindow), PerFiler: 2, PerAccount: 2}
+}
+
+func TestPostgresStoreCreateAndReview(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := recovery.NewPostgresStore(pool)
+	now := time.Now().UTC()
+	player := createPlayer(t, pool)
+	r := newRequest(player, recovery.CharacterRef{ID: idgen.New(), Name: "Guest-1"}, now)
+	require.NoError(t, s.Create(ctx, r, limits(now)))
+
+	got, err := s.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+	got, err = s.GetByCodeHash(ctx, r.CodeHash)
+	require.NoError(t, err)
+	assert.Equal(t, r.ID, got.ID)
+	pending, err := s.ListPending(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, requestIDs(pending), r.ID)
+
+	reviewer := recovery.CharacterRef{ID: idgen.New(), Name: "Wizard"}
+	reviewed, decided, err := s.Review(ctx, r.ID, recovery.StatusApproved, reviewer, "Checks out.", now)
+	require.NoError(t, err)
+	assert.True(t, decided)
+	assert.Equal(t, recovery.StatusApproved, reviewed.Status)
+	assert.Equal(t, reviewer, reviewed.Reviewer)
+	assert.Equal(t, "Checks out.", reviewed.Note)
+	assert.Equal(t, now, reviewed.ReviewedAt)
+
+	again, decided, err := s.Review(ctx, r.ID, recovery.StatusDenied, reviewer, "No.", now)
+	require.NoError(t, err)
+	assert.False(t, decided)
+	assert.Equal(t, recovery.StatusApproved, again.Status)
+
+	require.NoError(t, s.Reopen(ctx, r.ID))
+	got, err = s.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, recovery.StatusPending, got.Status)
+	assert.Empty(t, got.Reviewer.Name)
+	assert.True(t, got.ReviewedAt.IsZero())
+}
+
+func TestPostgresStoreCreateEnforcesLimits(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := recovery.NewPostgresStore(pool)
+	now := time.Now().UTC()
+	player := createPlayer(t, pool)
+	filer := recovery.CharacterRef{ID: idgen.New(), Name: "Guest-1"}
+
+	require.NoError(t, s.Create(ctx, newRequest(player, filer, now), limits(now)))
+	err := s.Create(ctx, newRequest(player, recovery.CharacterRef{ID: idgen.New(), Name: "Guest-2"}, now), limits(now))
+	require.ErrorIs(t, err, recovery.ErrAccountBusy, "one pending request per account")
+	errutil.AssertErrorCode(t, err, "RECOVERY_ACCOUNT_BUSY")
+
+	require.NoError(t, s.Create(ctx, newRequest(ulid.ULID{}, filer, now), limits(now)))
+	err = s.Create(ctx, newRequest(ulid.ULID{}, filer, now), limits(now))
+	require.ErrorIs(t, err, recovery.ErrRateLimited)
+	errutil.AssertErrorCode(t, err, "RECOVERY_RATE_LIMITED")
+
+	later := now.Add(recovery.RequestWindow + time.Second)
+	require.NoError(t, s.Create(ctx, newRequest(ulid.ULID{}, filer, later), limits(later)))
+}
+
+func TestPostgresStoreNotFound(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	s := recovery.NewPostgresStore(pool)
+	_, err := s.Get(ctx, idgen.New())
+	require.ErrorIs(t, err, recovery.ErrNotFound)
+	errutil.AssertErrorCode(t, err, "RECOVERY_NOT_FOUND")
+	_, err = s.GetByCodeHash(ctx, "missing")
+	require.ErrorIs(t, err, recovery.ErrNotFound)
+	_, _, err = s.Review(ctx, idgen.New(), recovery.StatusDenied, recovery.CharacterRef{ID: idgen.New()}, "No.", time.Now())
+	require.ErrorIs(t, err, recovery.ErrNotFound)
+}
+
+func requestIDs(list []*recovery.Request) []ulid.ULID {
+	ids := make([]ulid.ULID, 0, len(list))
+	for _, r := range list {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
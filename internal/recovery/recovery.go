@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package recovery lets a player who has lost both their password and the
+// email on their account get back in with staff help. The player, usually
+// connected as a guest, files a recovery request naming the account and
+// giving evidence that it is theirs: old character names, when they last
+// played, who they played with. They are given a recovery code, shown once.
+//
+// Staff review pending requests. Approving one turns its code into an
+// ordinary password reset token, so the player sets a new password with the
+// code through the in-game recover command or the web reset form, exactly
+// as they would with an emailed token. Denying one tells the player why
+// when they check the code's status.
+//
+// Filing is rate-limited per filing character and per account. A request
+// for an unknown, guest, or already-busy account is accepted exactly like
+// any other, so filing cannot be used to find out which accounts exist; it
+// is recorded but never reaches the staff queue. Every step is written to
+// the audit log and, for a real account, to its security event log.
+package recovery
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Limits.
+const (
+	// MaxEvidenceLength bounds a request's evidence in bytes.
+	MaxEvidenceLength = 4000
+	// MaxNoteLength bounds a reviewer's note in bytes.
+	MaxNoteLength = 1000
+	// RequestWindow is the period the per-filer and per-account request
+	// limits count over.
+	RequestWindow = 24 * time.Hour
+	// MaxRequestsPerFiler bounds how many requests one character may file
+	// in RequestWindow.
+	MaxRequestsPerFiler = 3
+	// MaxRequestsPerAccount bounds how many requests may be filed for one
+	// account in RequestWindow. An account also has at most one pending
+	// request at a time.
+	MaxRequestsPerAccount = 3
+	// ResetExpiry is how long the reset an approval issues stays valid.
+	ResetExpiry = 72 * time.Hour
+)
+
+// Sentinel errors.
+var (
+	// ErrNotFound is returned when a request does not exist.
+	ErrNotFound = errors.New("recovery request not found")
+	// ErrRateLimited is returned when the filing character has filed
+	// MaxRequestsPerFiler requests in RequestWindow.
+	ErrRateLimited = errors.New("too many recovery requests")
+	// ErrAccountBusy is returned by Repository.Create when the account
+	// already has a pending request or MaxRequestsPerAccount requests in
+	// RequestWindow. The service does not pass it on to the filer.
+	ErrAccountBusy = errors.New("account has a recent recovery request")
+)
+
+// Status is where a request is in review.
+type Status string
+
+// Request statuses.
+const (
+	// StatusUnmatched marks a request naming no recoverable account, or one
+	// filed while the account was busy. It is kept for the rate limits and
+	// the audit trail and is never reviewed.
+	StatusUnmatched Status = "unmatched"
+	// StatusPending means the request is waiting for staff.
+	StatusPending Status = "pending"
+	// StatusApproved means staff approved the request and a reset was
+	// issued.
+	StatusApproved Status = "approved"
+	// StatusDenied means staff turned the request down.
+	StatusDenied Status = "denied"
+)
+
+// CharacterRef identifies a character by ID and display name. The name is
+// kept with the request so it still reads correctly once a guest filer's
+// character is gone.
+type CharacterRef struct {
+	ID   ulid.ULID
+	Name string
+}
+
+// Reviewer is the staff member deciding a request. PlayerID is the account
+// behind Character, checked so nobody reviews a request for their own
+// account.
+type Reviewer struct {
+	PlayerID  ulid.ULID
+	Character CharacterRef
+}
+
+// Request is one recovery request. PlayerID is zero for an unmatched
+// request. Reviewer, Note, and ReviewedAt are set once staff decide it.
+type Request struct {
+	ID         ulid.ULID
+	PlayerID   ulid.ULID
+	Username   string
+	Filer      CharacterRef
+	Evidence   string
+	CodeHash   string
+	Status     Status
+	Reviewer   CharacterRef
+	Note       string
+	ReviewedAt time.Time
+	CreatedAt  time.Time
+}
+
+// Receipt is what the filer is given. Code is the plaintext recovery code;
+// only its hash is stored, so it cannot be shown again.
+type Receipt struct {
+	ID   ulid.ULID
+	Code string
+}
+
+// ValidateEvidence trims evidence and checks it is 1 to MaxEvidenceLength
+// bytes of UTF-8. Returns RECOVERY_INVALID otherwise.
+func ValidateEvidence(evidence string) (string, error) {
+	evidence = strings.TrimSpace(evidence)
+	if evidence == "" || len(evidence) > MaxEvidenceLength || !utf8.ValidString(evidence) {
+		return "", oops.Code("RECOVERY_INVALID").
+			Errorf("evidence must be text of 1 to %d bytes", MaxEvidenceLength)
+	}
+	return evidence, nil
+}
+
+// ValidateNote trims a reviewer's note and checks it is at most
+// MaxNoteLength bytes of UTF-8; required says whether it may be empty.
+// Returns RECOVERY_INVALID otherwise.
+func ValidateNote(note string, required bool) (string, error) {
+	note = strings.TrimSpace(note)
+	if (required && note == "") || len(note) > MaxNoteLength || !utf8.ValidString(note) {
+		return "", oops.Code("RECOVERY_INVALID").
+			Errorf("a note must be text of at most %d bytes", MaxNoteLength)
+	}
+	return note, nil
+}
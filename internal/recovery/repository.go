@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package recovery
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Limits bounds Repository.Create: requests created at or after Since count
+// against PerFiler for the filing character and PerAccount for the account.
+type Limits struct {
+	Since      time.Time
+	PerFiler   int
+	PerAccount int
+}
+
+// Repository persists recovery requests.
+type Repository interface {
+	// Create stores r. Nothing is written and the error wraps
+	// ErrRateLimited when r's filer has reached limits.PerFiler. For a
+	// request with a PlayerID, nothing is written and the error wraps
+	// ErrAccountBusy when the account has a pending request or has
+	// reached limits.PerAccount.
+	Create(ctx context.Context, r *Request, limits Limits) error
+	// Get returns the request with id. Returns an error wrapping
+	// ErrNotFound when there is none.
+	Get(ctx context.Context, id ulid.ULID) (*Request, error)
+	// GetByCodeHash returns the request whose recovery code hashes to
+	// hash. Returns an error wrapping ErrNotFound when there is none.
+	GetByCodeHash(ctx context.Context, hash string) (*Request, error)
+	// ListPending returns the pending requests, oldest first.
+	ListPending(ctx context.Context) ([]*Request, error)
+	// Review moves a pending request to status, recording who decided it,
+	// their note, and when. It reports whether this call decided it; when
+	// it did not, the returned request shows its current status. Returns
+	// an error wrapping ErrNotFound when there is no request with id.
+	Review(ctx context.Context, id ulid.ULID, status Status, reviewer CharacterRef, note string, at time.Time) (*Request, bool, error)
+	// Reopen returns an approved request to pending, clearing its review,
+	// when issuing its reset failed.
+	Reopen(ctx context.Context, id ulid.ULID) error
+}
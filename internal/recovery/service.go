@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package recovery
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// ActionReview is the ABAC action checked on recovery:queue before staff
+// list, read, approve, or deny recovery requests. The seed policy
+// seed:staff-recovery-review grants it to staff.
+const ActionReview = "review"
+
+// AreaQueue names the staff recovery queue in recovery:<area> resource
+// references.
+const AreaQueue = "queue"
+
+// Players looks up the account a request names.
+// auth.PlayerRepository satisfies it.
+type Players interface {
+	// GetByUsername returns the player with username, case-insensitively,
+	// or an error wrapping auth.ErrNotFound.
+	GetByUsername(ctx context.Context, username string) (*auth.Player, error)
+}
+
+// Resets stores the password reset an approval issues.
+// auth.PasswordResetRepository satisfies it.
+type Resets interface {
+	Create(ctx context.Context, reset *auth.PasswordReset) error
+}
+
+// Redeemer sets a new password with a reset token.
+// *auth.PasswordResetService satisfies it.
+type Redeemer interface {
+	ResetPassword(ctx context.Context, token, newPassword string) error
+}
+
+// FileRequest asks to recover the account named Username.
+type FileRequest struct {
+	Filer    CharacterRef
+	Username string
+	Evidence string
+}
+
+// Service files recovery requests and runs the staff review queue. Every
+// step is written to the structured log as an audit record; evidence and
+// recovery codes never are.
+type Service struct {
+	repo     Repository
+	players  Players
+	resets   Resets
+	redeemer Redeemer
+	engine   types.AccessPolicyEngine
+	logger   *slog.Logger
+	now      func() time.Time
+
+	mu       sync.RWMutex
+	security auth.SecurityRecorder
+}
+
+// NewService creates a Service. Every dependency but logger is required; a
+// nil logger uses slog.Default().
+func NewService(repo Repository, players Players, resets Resets, redeemer Redeemer, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("recovery repository is required")
+	}
+	if players == nil {
+		return nil, oops.Errorf("player repository is required")
+	}
+	if resets == nil {
+		return nil, oops.Errorf("reset repository is required")
+	}
+	if redeemer == nil {
+		return nil, oops.Errorf("password reset service is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{
+		repo: repo, players: players, resets: resets, redeemer: redeemer,
+		engine: engine, logger: logger, now: time.Now,
+	}, nil
+}
+
+// SetSecurityRecorder enables recording filed and approved requests in the
+// account's security event log. Passing nil disables it.
+func (s *Service) SetSecurityRecorder(r auth.SecurityRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.security = r
+}
+
+func (s *Service) record(ctx context.Context, playerID ulid.ULID, eventType auth.SecurityEventType, detail string) {
+	s.mu.RLock()
+	rec := s.security
+	s.mu.RUnlock()
+	if rec != nil {
+		rec.Record(ctx, playerID, eventType, auth.SecurityOrigin{}, detail)
+	}
+}
+
+// File records a recovery request and returns the receipt to show the
+// filer. A request for an unknown or guest account, or for one that already
+// has a pending request or too many recent ones, gets a receipt like any
+// other but never reaches staff. Returns RECOVERY_INVALID for a missing
+// username or bad evidence and RECOVERY_RATE_LIMITED (wrapping
+// ErrRateLimited) when the filer has filed MaxRequestsPerFiler requests in
+// RequestWindow.
+func (s *Service) File(ctx context.Context, req FileRequest) (*Receipt, error) {
+	username := strings.TrimSpace(req.Username)
+	if username == "" {
+		return nil, oops.Code("RECOVERY_INVALID").Errorf("a recovery request must name the account")
+	}
+	evidence, err := ValidateEvidence(req.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	code, hash, err := auth.GenerateResetToken()
+	if err != nil {
+		return nil, oops.Code("RECOVERY_FILE_FAILED").Wrap(err)
+	}
+	now := s.now()
+	r := &Request{
+		ID:        idgen.New(),
+		Username:  username,
+		Filer:     req.Filer,
+		Evidence:  evidence,
+		CodeHash:  hash,
+		Status:    StatusUnmatched,
+		CreatedAt: now.UTC(),
+	}
+	limits := Limits{Since: now.Add(-RequestWindow), PerFiler: MaxRequestsPerFiler, PerAccount: MaxRequestsPerAccount}
+
+	player, err := s.players.GetByUsername(ctx, username)
+	switch {
+	case err == nil && !player.IsGuest:
+		r.PlayerID = player.ID
+		r.Status = StatusPending
+	case err != nil && !errors.Is(err, auth.ErrNotFound):
+		return nil, oops.Code("RECOVERY_FILE_FAILED").With("operation", "lookup_account").Wrap(err)
+	}
+
+	err = s.repo.Create(ctx, r, limits)
+	if errors.Is(err, ErrAccountBusy) {
+		s.logger.WarnContext(ctx, "recovery request for busy account",
+			"event", "recovery_account_busy",
+			"request_id", r.ID.String(),
+			"player_id", r.PlayerID.String(),
+			"filer_id", r.Filer.ID.String(),
+		)
+		r.PlayerID, r.Status = ulid.ULID{}, StatusUnmatched
+		err = s.repo.Create(ctx, r, limits)
+	}
+	if err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			s.logger.WarnContext(ctx, "recovery request rate limited",
+				"event", "recovery_rate_limited",
+				"filer_id", r.Filer.ID.String(),
+			)
+		}
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "recovery request filed",
+		"event", "recovery_requested",
+		"request_id", r.ID.String(),
+		"username", r.Username,
+		"player_id", idString(r.PlayerID),
+		"filer_id", r.Filer.ID.String(),
+		"filer_name", r.Filer.Name,
+		"status", string(r.Status),
+	)
+	if r.Status == StatusPending {
+		s.record(ctx, r.PlayerID, auth.SecurityEventRecoveryRequested, "filed by "+r.Filer.Name)
+	}
+	return &Receipt{ID: r.ID, Code: code}, nil
+}
+
+// Status returns the request a recovery code belongs to, so its filer can
+// see whether staff have decided it. An unmatched request reads as pending,
+// so the code reveals nothing about the account. Returns RECOVERY_NOT_FOUND
+// for an unknown code.
+func (s *Service) Status(ctx context.Context, code string) (*Request, error) {
+	r, err := s.repo.GetByCodeHash(ctx, hashCode(code))
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == StatusUnmatched {
+		r.Status = StatusPending
+	}
+	r.PlayerID, r.Evidence, r.CodeHash, r.Reviewer = ulid.ULID{}, "", "", CharacterRef{}
+	return r, nil
+}
+
+// Redeem sets the account's new password with an approved request's
+// recovery code. Returns RECOVERY_NOT_FOUND for an unknown code,
+// RECOVERY_NOT_APPROVED when staff have not approved the request, and the
+// reset service's RESET_* errors for a spent or expired code or an
+// unacceptable password.
+func (s *Service) Redeem(ctx context.Context, code, newPassword string) error {
+	code = strings.TrimSpace(code)
+	r, err := s.repo.GetByCodeHash(ctx, hashCode(code))
+	if err != nil {
+		return err
+	}
+	if r.Status != StatusApproved {
+		return oops.Code("RECOVERY_NOT_APPROVED").
+			With("request_id", r.ID.String()).
+			Errorf("recovery request %s has not been approved", r.ID)
+	}
+	if err := s.redeemer.ResetPassword(ctx, code, newPassword); err != nil {
+		return err //nolint:wrapcheck // the reset service's RESET_* codes are the player-facing result
+	}
+	s.logger.InfoContext(ctx, "recovery completed",
+		"event", "recovery_completed",
+		"request_id", r.ID.String(),
+		"player_id", r.PlayerID.String(),
+	)
+	return nil
+}
+
+// Queue returns the pending requests, oldest first. Returns
+// RECOVERY_ACCESS_DENIED when subject may not review.
+func (s *Service) Queue(ctx context.Context, subject string) ([]*Request, error) {
+	if err := s.checkReview(ctx, subject); err != nil {
+		return nil, err
+	}
+	return s.repo.ListPending(ctx)
+}
+
+// Show returns request id with its evidence. Returns
+// RECOVERY_ACCESS_DENIED when subject may not review and
+// RECOVERY_NOT_FOUND when there is no such request.
+func (s *Service) Show(ctx context.Context, subject string, id ulid.ULID) (*Request, error) {
+	if err := s.checkReview(ctx, subject); err != nil {
+		return nil, err
+	}
+	r, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.CodeHash = ""
+	return r, nil
+}
+
+// Approve approves pending request id and issues a password reset for its
+// account, valid for ResetExpiry, that the filer redeems with their
+// recovery code. Returns RECOVERY_INVALID for an oversized note,
+// RECOVERY_ACCESS_DENIED when reviewer may not review,
+// RECOVERY_SELF_REVIEW when the request is for reviewer's own account,
+// RECOVERY_NOT_FOUND when there is no such request, and RECOVERY_DECIDED
+// when it is not pending.
+func (s *Service) Approve(ctx context.Context, reviewer Reviewer, id ulid.ULID, note string) (*Request, error) {
+	note, err := ValidateNote(note, false)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.review(ctx, reviewer, id, StatusApproved, note)
+	if err != nil {
+		return nil, err
+	}
+	reset, err := auth.NewPasswordReset(r.PlayerID, r.CodeHash, s.now().Add(ResetExpiry))
+	if err == nil {
+		err = s.resets.Create(ctx, reset)
+	}
+	if err != nil {
+		if reopenErr := s.repo.Reopen(ctx, id); reopenErr != nil {
+			s.logger.ErrorContext(ctx, "approved recovery request left without a reset",
+				"request_id", id.String(),
+				"error", reopenErr,
+			)
+		}
+		return nil, oops.Code("RECOVERY_RESET_FAILED").With("request_id", id.String()).Wrap(err)
+	}
+	s.logger.InfoContext(ctx, "recovery request approved",
+		"event", "recovery_approved",
+		"request_id", id.String(),
+		"player_id", r.PlayerID.String(),
+		"reviewer_id", reviewer.Character.ID.String(),
+		"reviewer_player_id", reviewer.PlayerID.String(),
+		"reset_expires_at", reset.ExpiresAt.UTC().Format(time.RFC3339),
+	)
+	s.record(ctx, r.PlayerID, auth.SecurityEventRecoveryApproved, "approved by "+reviewer.Character.Name)
+	r.CodeHash = ""
+	return r, nil
+}
+
+// Deny turns down pending request id; note is the reason the filer is shown
+// when they check their code. Returns RECOVERY_INVALID for an empty or
+// oversized note and otherwise the same errors as Approve.
+func (s *Service) Deny(ctx context.Context, reviewer Reviewer, id ulid.ULID, note string) (*Request, error) {
+	note, err := ValidateNote(note, true)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.review(ctx, reviewer, id, StatusDenied, note)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "recovery request denied",
+		"event", "recovery_denied",
+		"request_id", id.String(),
+		"player_id", r.PlayerID.String(),
+		"reviewer_id", reviewer.Character.ID.String(),
+		"reviewer_player_id", reviewer.PlayerID.String(),
+	)
+	r.CodeHash = ""
+	return r, nil
+}
+
+// review checks reviewer may decide request id and moves it to status.
+func (s *Service) review(ctx context.Context, reviewer Reviewer, id ulid.ULID, status Status, note string) (*Request, error) {
+	if err := s.checkReview(ctx, access.CharacterSubject(reviewer.Character.ID.String())); err != nil {
+		return nil, err
+	}
+	current, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current.PlayerID == reviewer.PlayerID {
+		s.logger.WarnContext(ctx, "recovery self-review refused",
+			"event", "recovery_self_review",
+			"request_id", id.String(),
+			"reviewer_id", reviewer.Character.ID.String(),
+		)
+		return nil, oops.Code("RECOVERY_SELF_REVIEW").
+			With("request_id", id.String()).
+			Errorf("staff may not review a recovery request for their own account")
+	}
+	r, decided, err := s.repo.Review(ctx, id, status, reviewer.Character, note, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if !decided {
+		return nil, oops.Code("RECOVERY_DECIDED").
+			With("request_id", id.String()).
+			With("status", string(r.Status)).
+			Errorf("recovery request %s is %s", id, r.Status)
+	}
+	return r, nil
+}
+
+func (s *Service) checkReview(ctx context.Context, subject string) error {
+	resource := access.RecoveryResource(AreaQueue)
+	req, err := types.NewAccessRequest(subject, ActionReview, resource, nil)
+	if err != nil {
+		return oops.Code("RECOVERY_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return oops.Code("RECOVERY_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	if !decision.IsAllowed() {
+		s.logger.WarnContext(ctx, "recovery review denied",
+			"event", "recovery_review_denied",
+			"subject", subject,
+		)
+		return oops.Code("RECOVERY_ACCESS_DENIED").Errorf("not permitted to review recovery requests")
+	}
+	return nil
+}
+
+// hashCode hashes a recovery code the way auth hashes reset tokens, so an
+// approved request's code_hash doubles as the reset's token hash.
+func hashCode(code string) string {
+	h := sha256.Sum256([]byte(strings.TrimSpace(code)))
+	return hex.EncodeToString(h[:])
+}
+
+func idString(id ulid.ULID) string {
+	if id == (ulid.ULID{}) {
+		return ""
+	}
+	return id.String()
+}
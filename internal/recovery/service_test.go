@@ -0,0 +1,424 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/auth"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository with the same limit semantics
+// as PostgresStore.
+type memRepository struct {
+	mu       sync.Mutex
+	requests map[ulid.ULID]*Request
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{requests: map[ulid.ULID]*Request{}}
+}
+
+func (m *memRepository) Create(_ context.Context, r *Request, limits Limits) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	filed, forAccount, pending := 0, 0, false
+	for _, existing := range m.requests {
+		recent := !existing.CreatedAt.Before(limits.Since)
+		if existing.Filer.ID == r.Filer.ID && recent {
+			filed++
+		}
+		if r.PlayerID != (ulid.ULID{}) && existing.PlayerID == r.PlayerID {
+			if recent {
+				forAccount++
+			}
+			pending = pending || existing.Status == StatusPending
+		}
+	}
+	if filed >= limits.PerFiler {
+		return oops.Code("RECOVERY_RATE_LIMITED").Wrap(ErrRateLimited)
+	}
+	if r.PlayerID != (ulid.ULID{}) && (pending || forAccount >= limits.PerAccount) {
+		return oops.Code("RECOVERY_ACCOUNT_BUSY").Wrap(ErrAccountBusy)
+	}
+	stored := *r
+	m.requests[r.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) Get(_ context.Context, id ulid.ULID) (*Request, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.requests[id]
+	if !ok {
+		return nil, oops.Code("RECOVERY_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	out := *r
+	return &out, nil
+}
+
+func (m *memRepository) GetByCodeHash(_ context.Context, hash string) (*Request, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, r := range m.requests {
+		if r.CodeHash == hash {
+			out := *r
+			return &out, nil
+		}
+	}
+	return nil, oops.Code("RECOVERY_NOT_FOUND").Wrap(ErrNotFound)
+}
+
+func (m *memRepository) ListPending(_ context.Context) ([]*Request, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Request
+	for _, r := range m.requests {
+		if r.Status == StatusPending {
+			stored := *r
+			out = append(out, &stored)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Compare(out[j].ID) < 0 })
+	return out, nil
+}
+
+func (m *memRepository) Review(_ context.Context, id ulid.ULID, status Status, reviewer CharacterRef, note string, at time.Time) (*Request, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.requests[id]
+	if !ok {
+		return nil, false, oops.Code("RECOVERY_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	if r.Status != StatusPending {
+		out := *r
+		return &out, false, nil
+	}
+	r.Status, r.Reviewer, r.Note, r.ReviewedAt = status, reviewer, note, at
+	out := *r
+	return &out, true, nil
+}
+
+func (m *memRepository) Reopen(_ context.Context, id ulid.ULID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.requests[id]; ok && r.Status == StatusApproved {
+		r.Status, r.Reviewer, r.Note, r.ReviewedAt = StatusPending, CharacterRef{}, "", time.Time{}
+	}
+	return nil
+}
+
+type fakePlayers struct {
+	players map[string]*auth.Player
+}
+
+func (f *fakePlayers) GetByUsername(_ context.Context, username string) (*auth.Player, error) {
+	p, ok := f.players[strings.ToLower(username)]
+	if !ok {
+		return nil, oops.Code("PLAYER_NOT_FOUND").Wrap(auth.ErrNotFound)
+	}
+	return p, nil
+}
+
+type fakeResets struct {
+	mu      sync.Mutex
+	created []*auth.PasswordReset
+	err     error
+}
+
+func (f *fakeResets) Create(_ context.Context, reset *auth.PasswordReset) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.created = append(f.created, reset)
+	return nil
+}
+
+type fakeRedeemer struct {
+	tokens    []string
+	passwords []string
+}
+
+func (f *fakeRedeemer) ResetPassword(_ context.Context, token, newPassword string) error {
+	f.tokens = append(f.tokens, token)
+	f.passwords = append(f.passwords, newPassword)
+	return nil
+}
+
+type fakeSecurity struct {
+	mu     sync.Mutex
+	events []auth.SecurityEventType
+}
+
+func (f *fakeSecurity) Record(_ context.Context, _ ulid.ULID, eventType auth.SecurityEventType, _ auth.SecurityOrigin, _ string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, eventType)
+}
+
+type testService struct {
+	*Service
+	repo     *memRepository
+	resets   *fakeResets
+	redeemer *fakeRedeemer
+	security *fakeSecurity
+	engine   *policytest.Scenario
+	logs     *bytes.Buffer
+	account  *auth.Player
+	guest    *auth.Player
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	account := &auth.Player{ID: idgen.New(), Username: "Alice"}
+	guest := &auth.Player{ID: idgen.New(), Username: "Guest-1", IsGuest: true}
+	players := &fakePlayers{players: map[string]*auth.Player{"alice": account, "guest-1": guest}}
+	ts := &testService{
+		repo:     newMemRepository(),
+		resets:   &fakeResets{},
+		redeemer: &fakeRedeemer{},
+		security: &fakeSecurity{},
+		engine:   policytest.NewScenario(t),
+		logs:     &bytes.Buffer{},
+		account:  account,
+		guest:    guest,
+	}
+	svc, err := NewService(ts.repo, players, ts.resets, ts.redeemer, ts.engine, slog.New(slog.NewJSONHandler(ts.logs, nil)))
+	require.NoError(t, err)
+	svc.now = func() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+	svc.SetSecurityRecorder(ts.security)
+	ts.Service = svc
+	return ts
+}
+
+// staff returns a reviewer granted review on the recovery queue.
+func (ts *testService) staff() Reviewer {
+	r := Reviewer{PlayerID: idgen.New(), Character: CharacterRef{ID: idgen.New(), Name: "Wizard"}}
+	ts.engine.Allow(access.CharacterSubject(r.Character.ID.String())).
+		To(ActionReview).
+		On(access.RecoveryResource(AreaQueue))
+	return r
+}
+
+func filer() CharacterRef {
+	return CharacterRef{ID: idgen.New(), Name: "Guest-2"}
+}
+
+func (ts *testService) file(t *testing.T, by CharacterRef, username string) *Receipt {
+	t.Helper()
+	receipt, err := ts.File(context.Background(), FileRequest{
+		Filer: by, Username: username, Evidence: "I played Alice from 2019 with Bob.",
+	})
+	require.NoError(t, err)
+	return receipt
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	engine := policytest.AllowAllEngine()
+	players, resets, redeemer := &fakePlayers{}, &fakeResets{}, &fakeRedeemer{}
+	for name, build := range map[string]func() (*Service, error){
+		"repo":     func() (*Service, error) { return NewService(nil, players, resets, redeemer, engine, nil) },
+		"players":  func() (*Service, error) { return NewService(newMemRepository(), nil, resets, redeemer, engine, nil) },
+		"resets":   func() (*Service, error) { return NewService(newMemRepository(), players, nil, redeemer, engine, nil) },
+		"redeemer": func() (*Service, error) { return NewService(newMemRepository(), players, resets, nil, engine, nil) },
+		"engine":   func() (*Service, error) { return NewService(newMemRepository(), players, resets, redeemer, nil, nil) },
+	} {
+		_, err := build()
+		assert.Error(t, err, name)
+	}
+}
+
+func TestFileQueuesRequestForRealAccount(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	assert.NotEmpty(t, receipt.Code)
+
+	list, err := ts.Queue(ctx, access.CharacterSubject(ts.staff().Character.ID.String()))
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, receipt.ID, list[0].ID)
+	assert.Equal(t, ts.account.ID, list[0].PlayerID)
+	assert.Equal(t, hashCode(receipt.Code), list[0].CodeHash)
+	assert.Equal(t, []auth.SecurityEventType{auth.SecurityEventRecoveryRequested}, ts.security.events)
+	assert.Contains(t, ts.logs.String(), `"event":"recovery_requested"`)
+	assert.NotContains(t, ts.logs.String(), receipt.Code, "the recovery code is never logged")
+	assert.NotContains(t, ts.logs.String(), "Bob", "evidence is never logged")
+}
+
+func TestFileHidesUnknownGuestAndBusyAccounts(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	first := ts.file(t, filer(), "alice")
+
+	for _, username := range []string{"nobody", "guest-1", "alice"} {
+		receipt := ts.file(t, filer(), username)
+		assert.NotEmpty(t, receipt.Code, username)
+		status, err := ts.Status(ctx, receipt.Code)
+		require.NoError(t, err)
+		assert.Equal(t, StatusPending, status.Status, "%s reads as pending to its filer", username)
+	}
+
+	list, err := ts.Queue(ctx, access.CharacterSubject(ts.staff().Character.ID.String()))
+	require.NoError(t, err)
+	require.Len(t, list, 1, "only the first request reaches staff")
+	assert.Equal(t, first.ID, list[0].ID)
+	assert.Contains(t, ts.logs.String(), `"event":"recovery_account_busy"`)
+}
+
+func TestFileRateLimitsFiler(t *testing.T) {
+	ts := newTestService(t)
+	by := filer()
+	for range MaxRequestsPerFiler {
+		ts.file(t, by, "nobody")
+	}
+	_, err := ts.File(context.Background(), FileRequest{Filer: by, Username: "nobody", Evidence: "Please."})
+	require.ErrorIs(t, err, ErrRateLimited)
+	errutil.AssertErrorCode(t, err, "RECOVERY_RATE_LIMITED")
+	assert.Contains(t, ts.logs.String(), `"event":"recovery_rate_limited"`)
+
+	ts.now = func() time.Time { return time.Date(2026, 5, 2, 12, 0, 1, 0, time.UTC) }
+	ts.file(t, by, "nobody")
+}
+
+func TestFileValidates(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	_, err := ts.File(ctx, FileRequest{Filer: filer(), Username: " ", Evidence: "Mine."})
+	errutil.AssertErrorCode(t, err, "RECOVERY_INVALID")
+	_, err = ts.File(ctx, FileRequest{Filer: filer(), Username: "alice", Evidence: ""})
+	errutil.AssertErrorCode(t, err, "RECOVERY_INVALID")
+	_, err = ts.File(ctx, FileRequest{Filer: filer(), Username: "alice", Evidence: strings.Repeat("x", MaxEvidenceLength+1)})
+	errutil.AssertErrorCode(t, err, "RECOVERY_INVALID")
+}
+
+func TestStatusHidesReviewDetails(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+
+	_, err := ts.Deny(ctx, ts.staff(), receipt.ID, "Evidence does not match.")
+	require.NoError(t, err)
+	status, err := ts.Status(ctx, receipt.Code)
+	require.NoError(t, err)
+	assert.Equal(t, StatusDenied, status.Status)
+	assert.Equal(t, "Evidence does not match.", status.Note)
+	assert.Empty(t, status.Evidence)
+	assert.Empty(t, status.CodeHash)
+	assert.Empty(t, status.Reviewer.Name)
+	assert.Equal(t, ulid.ULID{}, status.PlayerID)
+
+	_, err = ts.Status(ctx, "not-a-code")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestApproveIssuesResetForCode(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+
+	err := ts.Redeem(ctx, receipt.Code, "new-password-1")
+	errutil.AssertErrorCode(t, err, "RECOVERY_NOT_APPROVED")
+
+	r, err := ts.Approve(ctx, ts.staff(), receipt.ID, "Knew the old character names.")
+	require.NoError(t, err)
+	assert.Equal(t, StatusApproved, r.Status)
+	assert.Equal(t, "Wizard", r.Reviewer.Name)
+	require.Len(t, ts.resets.created, 1)
+	assert.Equal(t, ts.account.ID, ts.resets.created[0].PlayerID)
+	assert.Equal(t, hashCode(receipt.Code), ts.resets.created[0].TokenHash)
+	assert.Equal(t, ts.now().Add(ResetExpiry), ts.resets.created[0].ExpiresAt)
+	assert.Contains(t, ts.security.events, auth.SecurityEventRecoveryApproved)
+
+	require.NoError(t, ts.Redeem(ctx, " "+receipt.Code+" ", "new-password-1"))
+	assert.Equal(t, []string{receipt.Code}, ts.redeemer.tokens)
+	assert.Contains(t, ts.logs.String(), `"event":"recovery_completed"`)
+
+	_, err = ts.Deny(ctx, ts.staff(), receipt.ID, "Too late.")
+	errutil.AssertErrorCode(t, err, "RECOVERY_DECIDED")
+}
+
+func TestApproveReopensWhenResetFails(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	ts.resets.err = errors.New("database down")
+
+	_, err := ts.Approve(ctx, ts.staff(), receipt.ID, "")
+	errutil.AssertErrorCode(t, err, "RECOVERY_RESET_FAILED")
+	r, err := ts.repo.Get(ctx, receipt.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, r.Status)
+}
+
+func TestReviewRequiresPermission(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	outsider := Reviewer{PlayerID: idgen.New(), Character: CharacterRef{ID: idgen.New(), Name: "Mallory"}}
+	subject := access.CharacterSubject(outsider.Character.ID.String())
+
+	_, err := ts.Queue(ctx, subject)
+	errutil.AssertErrorCode(t, err, "RECOVERY_ACCESS_DENIED")
+	_, err = ts.Show(ctx, subject, receipt.ID)
+	errutil.AssertErrorCode(t, err, "RECOVERY_ACCESS_DENIED")
+	_, err = ts.Approve(ctx, outsider, receipt.ID, "")
+	errutil.AssertErrorCode(t, err, "RECOVERY_ACCESS_DENIED")
+	assert.Empty(t, ts.resets.created)
+	ts.engine.AssertEvaluated(subject, ActionReview, access.RecoveryResource(AreaQueue))
+	assert.Contains(t, ts.logs.String(), `"event":"recovery_review_denied"`)
+}
+
+func TestReviewRefusesOwnAccount(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	reviewer := ts.staff()
+	reviewer.PlayerID = ts.account.ID
+
+	_, err := ts.Approve(ctx, reviewer, receipt.ID, "")
+	errutil.AssertErrorCode(t, err, "RECOVERY_SELF_REVIEW")
+	assert.Empty(t, ts.resets.created)
+}
+
+func TestDenyRequiresNote(t *testing.T) {
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	_, err := ts.Deny(context.Background(), ts.staff(), receipt.ID, " ")
+	errutil.AssertErrorCode(t, err, "RECOVERY_INVALID")
+}
+
+func TestShowReturnsEvidence(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	receipt := ts.file(t, filer(), "alice")
+	subject := access.CharacterSubject(ts.staff().Character.ID.String())
+
+	r, err := ts.Show(ctx, subject, receipt.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "I played Alice from 2019 with Bob.", r.Evidence)
+	assert.Empty(t, r.CodeHash)
+
+	_, err = ts.Show(ctx, subject, idgen.New())
+	require.ErrorIs(t, err, ErrNotFound)
+}
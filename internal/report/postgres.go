@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package report
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// reportColumns selects a report from reports aliased r joined to
+// locations aliased l.
+const reportColumns = `r.id, COALESCE(r.reporter_id, ''), r.reporter_name, r.category, r.body,
+	COALESCE(r.location_id, ''), COALESCE(l.name, ''), COALESCE(r.scene_id, ''), r.status,
+	COALESCE(r.claimed_by, ''), COALESCE(r.claimed_by_name, ''), r.claimed_at,
+	COALESCE(r.resolution, ''), COALESCE(r.resolved_by, ''), COALESCE(r.resolved_by_name, ''), r.resolved_at,
+	r.created_at`
+
+// PostgresStore implements Repository against the reports and report_notes
+// tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts r unless its reporter already has limit unresolved
+// reports.
+func (s *PostgresStore) Create(ctx context.Context, r *Report, limit int) error {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO reports (id, reporter_id, reporter_name, category, body, location_id, scene_id, status, created_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, 'open', $8
+		 WHERE (SELECT COUNT(*) FROM reports WHERE reporter_id = $2 AND status <> 'resolved') < $9
+	`, r.ID.String(), r.Reporter.ID.String(), r.Reporter.Name, string(r.Category), r.Text,
+		optionalID(r.LocationID), optionalID(r.SceneID), pgnanos.From(r.CreatedAt), limit)
+	if err != nil {
+		return oops.Code("REPORT_STORE_FAILED").
+			With("operation", "create").
+			With("reporter_id", r.Reporter.ID.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("REPORT_LIMIT").
+			With("reporter_id", r.Reporter.ID.String()).
+			With("limit", limit).
+			Wrap(ErrTooManyOpen)
+	}
+	return nil
+}
+
+// Get returns the report with id and its notes.
+func (s *PostgresStore) Get(ctx context.Context, id ulid.ULID) (*Report, error) {
+	r, err := scanReport(s.pool.QueryRow(ctx, `
+		SELECT `+reportColumns+`
+		  FROM reports r
+		  LEFT JOIN locations l ON l.id = r.location_id
+		 WHERE r.id = $1
+	`, id.String()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("REPORT_NOT_FOUND").With("report_id", id.String()).Wrap(ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("REPORT_STORE_FAILED").
+			With("operation", "get").
+			With("report_id", id.String()).
+			Wrap(err)
+	}
+	notes, err := s.notes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	r.Notes = notes
+	return r, nil
+}
+
+// ListByReporter returns reporterID's reports, newest first.
+func (s *PostgresStore) ListByReporter(ctx context.Context, reporterID ulid.ULID) ([]*Report, error) {
+	return s.list(ctx, "list_by_reporter", `
+		SELECT `+reportColumns+`
+		  FROM reports r
+		  LEFT JOIN locations l ON l.id = r.location_id
+		 WHERE r.reporter_id = $1
+		 ORDER BY r.created_at DESC, r.id DESC
+	`, reporterID.String())
+}
+
+// List returns the unresolved reports, or all of them, oldest first.
+func (s *PostgresStore) List(ctx context.Context, includeResolved bool) ([]*Report, error) {
+	return s.list(ctx, "list", `
+		SELECT `+reportColumns+`
+		  FROM reports r
+		  LEFT JOIN locations l ON l.id = r.location_id
+		 WHERE $1 OR r.status <> 'resolved'
+		 ORDER BY r.created_at, r.id
+	`, includeResolved)
+}
+
+// Claim marks the report claimed when it is open.
+func (s *PostgresStore) Claim(ctx context.Context, id ulid.ULID, staff CharacterRef, at time.Time) (*Report, bool, error) {
+	return s.transition(ctx, "claim", id, `
+		WITH r AS (
+			UPDATE reports
+			   SET status = 'claimed', claimed_by = $2, claimed_by_name = $3, claimed_at = $4
+			 WHERE id = $1 AND status = 'open'
+			RETURNING *
+		)
+		SELECT `+reportColumns+`
+		  FROM r
+		  LEFT JOIN locations l ON l.id = r.location_id
+	`, id.String(), staff.ID.String(), staff.Name, pgnanos.From(at))
+}
+
+// AddNote inserts note when its report exists.
+func (s *PostgresStore) AddNote(ctx context.Context, note *Note) error {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO report_notes (id, report_id, author_id, author_name, body, created_at)
+		SELECT $1, $2, $3, $4, $5, $6
+		 WHERE EXISTS (SELECT 1 FROM reports WHERE id = $2)
+	`, note.ID.String(), note.ReportID.String(), note.Author.ID.String(), note.Author.Name, note.Body,
+		pgnanos.From(note.CreatedAt))
+	if err != nil {
+		return oops.Code("REPORT_STORE_FAILED").
+			With("operation", "add_note").
+			With("report_id", note.ReportID.String()).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("REPORT_NOT_FOUND").With("report_id", note.ReportID.String()).Wrap(ErrNotFound)
+	}
+	return nil
+}
+
+// Resolve closes the report when it is unresolved.
+func (s *PostgresStore) Resolve(ctx context.Context, id ulid.ULID, staff CharacterRef, resolution string, at time.Time) (*Report, bool, error) {
+	return s.transition(ctx, "resolve", id, `
+		WITH r AS (
+			UPDATE reports
+			   SET status = 'resolved', resolution = $2, resolved_by = $3, resolved_by_name = $4, resolved_at = $5
+			 WHERE id = $1 AND status <> 'resolved'
+			RETURNING *
+		)
+		SELECT `+reportColumns+`
+		  FROM r
+		  LEFT JOIN locations l ON l.id = r.location_id
+	`, id.String(), resolution, staff.ID.String(), staff.Name, pgnanos.From(at))
+}
+
+// transition runs a conditional status update returning the changed
+// report. When the update matches no row the report is read back so the
+// caller can see why.
+func (s *PostgresStore) transition(ctx context.Context, operation string, id ulid.ULID, query string, args ...any) (*Report, bool, error) {
+	r, err := scanReport(s.pool.QueryRow(ctx, query, args...))
+	if err == nil {
+		return r, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, oops.Code("REPORT_STORE_FAILED").
+			With("operation", operation).
+			With("report_id", id.String()).
+			Wrap(err)
+	}
+	r, err = s.Get(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return r, false, nil
+}
+
+func (s *PostgresStore) list(ctx context.Context, operation, query string, args ...any) ([]*Report, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, oops.Code("REPORT_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*Report
+	for rows.Next() {
+		r, err := scanReport(rows)
+		if err != nil {
+			return nil, oops.Code("REPORT_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		out = append(out, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("REPORT_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return out, nil
+}
+
+func (s *PostgresStore) notes(ctx context.Context, reportID ulid.ULID) ([]*Note, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT id, COALESCE(author_id, ''), author_name, body, created_at
+		  FROM report_notes
+		 WHERE report_id = $1
+		 ORDER BY created_at, id
+	`, reportID.String())
+	if err != nil {
+		return nil, oops.Code("REPORT_STORE_FAILED").
+			With("operation", "notes").
+			With("report_id", reportID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*Note
+	for rows.Next() {
+		var (
+			id, authorID string
+			createdAt    pgnanos.Time
+		)
+		note := &Note{ReportID: reportID}
+		if err := rows.Scan(&id, &authorID, &note.Author.Name, &note.Body, &createdAt); err != nil {
+			return nil, oops.Code("REPORT_STORE_FAILED").With("operation", "notes").Wrap(err)
+		}
+		if note.ID, err = ulid.Parse(id); err != nil {
+			return nil, oops.Code("REPORT_STORE_FAILED").With("note_id", id).Wrap(err)
+		}
+		if note.Author.ID, err = parseOptionalID(authorID); err != nil {
+			return nil, oops.Code("REPORT_STORE_FAILED").With("note_id", id).Wrap(err)
+		}
+		note.CreatedAt = createdAt.Time()
+		out = append(out, note)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("REPORT_STORE_FAILED").With("operation", "notes").Wrap(err)
+	}
+	return out, nil
+}
+
+func scanReport(row pgx.Row) (*Report, error) {
+	var (
+		r                                       Report
+		id, reporterID, locationID, sceneID     string
+		category, status, claimedBy, resolvedBy string
+		createdAt                               pgnanos.Time
+		claimedAt, resolvedAt                   *pgnanos.Time
+	)
+	if err := row.Scan(&id, &reporterID, &r.Reporter.Name, &category, &r.Text,
+		&locationID, &r.LocationName, &sceneID, &status,
+		&claimedBy, &r.ClaimedBy.Name, &claimedAt,
+		&r.Resolution, &resolvedBy, &r.ResolvedBy.Name, &resolvedAt,
+		&createdAt); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	var err error
+	if r.ID, err = ulid.Parse(id); err != nil {
+		return nil, oops.With("report_id", id).Wrap(err)
+	}
+	for _, f := range []struct {
+		dst *ulid.ULID
+		src string
+	}{
+		{&r.Reporter.ID, reporterID},
+		{&r.LocationID, locationID},
+		{&r.SceneID, sceneID},
+		{&r.ClaimedBy.ID, claimedBy},
+		{&r.ResolvedBy.ID, resolvedBy},
+	} {
+		if *f.dst, err = parseOptionalID(f.src); err != nil {
+			return nil, oops.With("report_id", id).Wrap(err)
+		}
+	}
+	r.Category = Category(category)
+	r.Status = Status(status)
+	r.CreatedAt = createdAt.Time()
+	if claimedAt != nil {
+		r.ClaimedAt = claimedAt.Time()
+	}
+	if resolvedAt != nil {
+		r.ResolvedAt = resolvedAt.Time()
+	}
+	return &r, nil
+}
+
+// optionalID returns nil for the zero ULID, so it is stored as NULL.
+func optionalID(id ulid.ULID) *string {
+	if id == (ulid.ULID{}) {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+// parseOptionalID parses a ULID column read through COALESCE, where ""
+// stands for NULL.
+func parseOptionalID(s string) (ulid.ULID, error) {
+	if s == "" {
+		return ulid.ULID{}, nil
+	}
+	id, err := ulid.Parse(s)
+	if err != nil {
+		return ulid.ULID{}, oops.With("id", s).Wrap(err)
+	}
+	return id, nil
+}
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package report_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/report"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createCharacter(t *testing.T, pool *pgxpool.Pool, name string) report.CharacterRef {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO characters (id, name) VALUES ($1, $2)`, id.String(), name)
+	require.NoError(t, err)
+	return report.CharacterRef{ID: id, Name: name}
+}
+
+func createLocation(t *testing.T, pool *pgxpool.Pool, name string) ulid.ULID {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(), `
+		INSERT INTO locations (id, name, description, type, replay_policy, created_at)
+		VALUES ($1, $2, '', 'persistent', 'last:0', (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT)
+	`, id.String(), name)
+	require.NoError(t, err)
+	return id
+}
+
+func newReport(t *testing.T, reporter report.CharacterRef, text string, at time.Time) *report.Report {
+	t.Helper()
+	r, err := report.NewReport(idgen.New(), reporter, report.CategoryBug, text, at)
+	require.NoError(t, err)
+	return r
+}
+
+func TestPostgresStoreCreateAndGet(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	locationID := createLocation(t, pool, "Fountain Square")
+	sceneID := idgen.New()
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	r := newReport(t, alice, "the fountain has no description", at)
+	r.LocationID, r.SceneID = locationID, sceneID
+	require.NoError(t, st.Create(ctx, r, report.MaxOpenReports))
+
+	got, err := st.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, alice, got.Reporter)
+	assert.Equal(t, report.CategoryBug, got.Category)
+	assert.Equal(t, "the fountain has no description", got.Text)
+	assert.Equal(t, locationID, got.LocationID)
+	assert.Equal(t, "Fountain Square", got.LocationName)
+	assert.Equal(t, sceneID, got.SceneID)
+	assert.Equal(t, report.StatusOpen, got.Status)
+	assert.True(t, at.Equal(got.CreatedAt))
+	assert.Empty(t, got.Notes)
+
+	_, err = st.Get(ctx, idgen.New())
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+	assert.ErrorIs(t, err, report.ErrNotFound)
+}
+
+func TestPostgresStoreCreateLimitsOpenReports(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	staff := createCharacter(t, pool, "Sam-"+idgen.New().String())
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	first := newReport(t, alice, "one", at)
+	require.NoError(t, st.Create(ctx, first, 2))
+	require.NoError(t, st.Create(ctx, newReport(t, alice, "two", at.Add(time.Second)), 2))
+
+	err := st.Create(ctx, newReport(t, alice, "three", at.Add(2*time.Second)), 2)
+	errutil.AssertErrorCode(t, err, "REPORT_LIMIT")
+	assert.ErrorIs(t, err, report.ErrTooManyOpen)
+
+	_, resolved, err := st.Resolve(ctx, first.ID, staff, "done", at.Add(3*time.Second))
+	require.NoError(t, err)
+	require.True(t, resolved)
+	require.NoError(t, st.Create(ctx, newReport(t, alice, "three", at.Add(4*time.Second)), 2),
+		"resolved reports do not count")
+}
+
+func TestPostgresStoreClaimAndResolve(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	sam := createCharacter(t, pool, "Sam-"+idgen.New().String())
+	kim := createCharacter(t, pool, "Kim-"+idgen.New().String())
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	r := newReport(t, alice, "the door sticks", at)
+	require.NoError(t, st.Create(ctx, r, report.MaxOpenReports))
+
+	claimed, ok, err := st.Claim(ctx, r.ID, sam, at.Add(time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, report.StatusClaimed, claimed.Status)
+	assert.Equal(t, sam, claimed.ClaimedBy)
+	assert.True(t, at.Add(time.Minute).Equal(claimed.ClaimedAt))
+
+	held, ok, err := st.Claim(ctx, r.ID, kim, at.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, ok, "a claimed report cannot be claimed again")
+	assert.Equal(t, sam, held.ClaimedBy)
+
+	resolved, ok, err := st.Resolve(ctx, r.ID, kim, "planed the door", at.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, report.StatusResolved, resolved.Status)
+	assert.Equal(t, "planed the door", resolved.Resolution)
+	assert.Equal(t, kim, resolved.ResolvedBy)
+	assert.Equal(t, sam, resolved.ClaimedBy)
+
+	_, ok, err = st.Resolve(ctx, r.ID, sam, "again", at.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, err = st.Claim(ctx, r.ID, sam, at.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = st.Claim(ctx, idgen.New(), sam, at)
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+	_, _, err = st.Resolve(ctx, idgen.New(), sam, "x", at)
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+}
+
+func TestPostgresStoreNotes(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	sam := createCharacter(t, pool, "Sam-"+idgen.New().String())
+	at := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	r := newReport(t, alice, "lag", at)
+	require.NoError(t, st.Create(ctx, r, report.MaxOpenReports))
+
+	require.NoError(t, st.AddNote(ctx, &report.Note{ID: idgen.New(), ReportID: r.ID, Author: sam, Body: "second", CreatedAt: at.Add(2 * time.Minute)}))
+	require.NoError(t, st.AddNote(ctx, &report.Note{ID: idgen.New(), ReportID: r.ID, Author: sam, Body: "first", CreatedAt: at.Add(time.Minute)}))
+	err := st.AddNote(ctx, &report.Note{ID: idgen.New(), ReportID: idgen.New(), Author: sam, Body: "x", CreatedAt: at})
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+
+	got, err := st.Get(ctx, r.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Notes, 2)
+	assert.Equal(t, "first", got.Notes[0].Body)
+	assert.Equal(t, sam, got.Notes[0].Author)
+	assert.Equal(t, "second", got.Notes[1].Body)
+}
+
+func TestPostgresStoreLists(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	sam := createCharacter(t, pool, "Sam-"+idgen.New().String())
+	at := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	older := newReport(t, alice, "older", at)
+	newer := newReport(t, alice, "newer", at.Add(time.Minute))
+	require.NoError(t, st.Create(ctx, older, report.MaxOpenReports))
+	require.NoError(t, st.Create(ctx, newer, report.MaxOpenReports))
+	_, _, err := st.Resolve(ctx, older.ID, sam, "done", at.Add(time.Hour))
+	require.NoError(t, err)
+
+	mine, err := st.ListByReporter(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, mine, 2)
+	assert.Equal(t, newer.ID, mine[0].ID, "newest first")
+
+	queue, err := st.List(ctx, false)
+	require.NoError(t, err)
+	assert.Contains(t, ids(queue), newer.ID)
+	assert.NotContains(t, ids(queue), older.ID, "resolved reports leave the queue")
+
+	all, err := st.List(ctx, true)
+	require.NoError(t, err)
+	assert.Contains(t, ids(all), older.ID)
+}
+
+func TestPostgresStoreKeepsReportsOfDeletedCharacters(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := report.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "Alice-"+idgen.New().String())
+	r := newReport(t, alice, "harassment", time.Now())
+	require.NoError(t, st.Create(ctx, r, report.MaxOpenReports))
+
+	_, err := pool.Exec(ctx, `DELETE FROM characters WHERE id = $1`, alice.ID.String())
+	require.NoError(t, err)
+
+	got, err := st.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ulid.ULID{}, got.Reporter.ID)
+	assert.Equal(t, alice.Name, got.Reporter.Name)
+}
+
+func ids(reports []*report.Report) []ulid.ULID {
+	out := make([]ulid.ULID, 0, len(reports))
+	for _, r := range reports {
+		out = append(out, r.ID)
+	}
+	return out
+}
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package report lets players file bug, typo, and harassment reports from
+// inside the game and gives staff a queue to triage them. A report captures
+// where the reporter was when they filed it, the location and any scene they
+// were posing in, so staff do not have to ask. Staff claim a report, keep
+// notes on it, and resolve it; the reporter gets a report_status event when
+// their report is claimed and when it is resolved.
+//
+// Notes are for staff: a reporter sees their report's status and
+// resolution but never the notes.
+package report
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Limits.
+const (
+	// MaxTextLength bounds a report's text, a note, and a resolution in
+	// bytes.
+	MaxTextLength = 4000
+	// MaxOpenReports bounds how many unresolved reports one character may
+	// have filed at a time.
+	MaxOpenReports = 10
+)
+
+// Sentinel errors.
+var (
+	// ErrNotFound is returned when a report does not exist.
+	ErrNotFound = errors.New("report not found")
+	// ErrTooManyOpen is returned when a character already has
+	// MaxOpenReports unresolved reports.
+	ErrTooManyOpen = errors.New("too many open reports")
+)
+
+// Category is what a report is about.
+type Category string
+
+// Report categories.
+const (
+	CategoryBug        Category = "bug"
+	CategoryTypo       Category = "typo"
+	CategoryHarassment Category = "harassment"
+)
+
+// Categories lists every category in the order help text shows them.
+var Categories = []Category{CategoryBug, CategoryTypo, CategoryHarassment}
+
+// ParseCategory parses a category name case-insensitively. Returns
+// REPORT_INVALID for anything else.
+func ParseCategory(s string) (Category, error) {
+	c := Category(strings.ToLower(strings.TrimSpace(s)))
+	for _, known := range Categories {
+		if c == known {
+			return c, nil
+		}
+	}
+	return "", oops.Code("REPORT_INVALID").
+		With("category", s).
+		Errorf("report category %q must be bug, typo, or harassment", s)
+}
+
+// Status is where a report is in triage.
+type Status string
+
+// Triage statuses.
+const (
+	// StatusOpen means no staff member has picked the report up.
+	StatusOpen Status = "open"
+	// StatusClaimed means a staff member is working on the report.
+	StatusClaimed Status = "claimed"
+	// StatusResolved means the report is closed with a resolution.
+	StatusResolved Status = "resolved"
+)
+
+// CharacterRef identifies a character by ID and display name. The name is
+// kept with the report so it still reads correctly after a rename or once
+// the character is deleted.
+type CharacterRef struct {
+	ID   ulid.ULID
+	Name string
+}
+
+// Report is one filed report. LocationID and SceneID are zero when the
+// reporter was in neither; LocationName is empty once the location is gone.
+// ClaimedBy and ResolvedBy are zero until the report is claimed or
+// resolved.
+type Report struct {
+	ID           ulid.ULID
+	Reporter     CharacterRef
+	Category     Category
+	Text         string
+	LocationID   ulid.ULID
+	LocationName string
+	SceneID      ulid.ULID
+	Status       Status
+	ClaimedBy    CharacterRef
+	ClaimedAt    time.Time
+	Resolution   string
+	ResolvedBy   CharacterRef
+	ResolvedAt   time.Time
+	CreatedAt    time.Time
+	// Notes are filled in only by Repository.Get, oldest first.
+	Notes []*Note
+}
+
+// Note is a staff note on a report.
+type Note struct {
+	ID        ulid.ULID
+	ReportID  ulid.ULID
+	Author    CharacterRef
+	Body      string
+	CreatedAt time.Time
+}
+
+// NewReport builds an open report. Returns REPORT_INVALID for an empty
+// text, one that is not UTF-8, or one longer than MaxTextLength.
+func NewReport(id ulid.ULID, reporter CharacterRef, category Category, text string, at time.Time) (*Report, error) {
+	text, err := ValidateText(text, "report")
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		ID:        id,
+		Reporter:  reporter,
+		Category:  category,
+		Text:      text,
+		Status:    StatusOpen,
+		CreatedAt: at.UTC(),
+	}, nil
+}
+
+// ValidateText trims text and checks it is 1 to MaxTextLength bytes of
+// UTF-8. what names the text in the REPORT_INVALID message ("report",
+// "note", "resolution").
+func ValidateText(text, what string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || len(text) > MaxTextLength || !utf8.ValidString(text) {
+		return "", oops.Code("REPORT_INVALID").
+			Errorf("a %s must be text of 1 to %d bytes", what, MaxTextLength)
+	}
+	return text, nil
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Repository persists reports and their notes.
+type Repository interface {
+	// Create stores r. When its reporter already has limit unresolved
+	// reports nothing is written and the error wraps ErrTooManyOpen.
+	Create(ctx context.Context, r *Report, limit int) error
+	// Get returns the report with id and its notes. Returns an error
+	// wrapping ErrNotFound when there is none.
+	Get(ctx context.Context, id ulid.ULID) (*Report, error)
+	// ListByReporter returns the reports reporterID filed, newest first,
+	// without notes.
+	ListByReporter(ctx context.Context, reporterID ulid.ULID) ([]*Report, error)
+	// List returns the unresolved reports, or every report when
+	// includeResolved is set, oldest first and without notes.
+	List(ctx context.Context, includeResolved bool) ([]*Report, error)
+	// Claim marks an open report claimed by staff at at. It reports whether
+	// this call claimed it; when it did not, the returned report shows who
+	// holds it or that it is resolved. Returns an error wrapping
+	// ErrNotFound when there is no report with id.
+	Claim(ctx context.Context, id ulid.ULID, staff CharacterRef, at time.Time) (*Report, bool, error)
+	// AddNote stores note. Returns an error wrapping ErrNotFound when its
+	// report does not exist.
+	AddNote(ctx context.Context, note *Note) error
+	// Resolve closes an unresolved report with resolution. It reports
+	// whether this call resolved it; when it did not, the report was
+	// already resolved. Returns an error wrapping ErrNotFound when there is
+	// no report with id.
+	Resolve(ctx context.Context, id ulid.ULID, staff CharacterRef, resolution string, at time.Time) (*Report, bool, error)
+}
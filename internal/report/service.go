@@ -0,0 +1,379 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+)
+
+// ActionTriage is the ABAC action checked on report:queue before staff read
+// the queue, read another character's report, or claim, annotate, or
+// resolve one. The seed policy seed:staff-report-triage grants it to staff.
+const ActionTriage = "triage"
+
+// AreaQueue names the staff report queue in report:<area> resource
+// references.
+const AreaQueue = "queue"
+
+// Sessions finds the session a character is connected with, to capture the
+// scene they are posing in. session.Access satisfies it.
+type Sessions interface {
+	// FindByCharacter returns the character's active or detached session,
+	// or SESSION_NOT_FOUND when it has none.
+	FindByCharacter(ctx context.Context, characterID ulid.ULID) (*session.Info, error)
+}
+
+// FileRequest asks to file a report. LocationID is where the reporter is
+// standing; zero when they are nowhere.
+type FileRequest struct {
+	Reporter   CharacterRef
+	Category   Category
+	Text       string
+	LocationID ulid.ULID
+}
+
+// Service files reports and runs the staff triage queue. Every change is
+// written to the structured log as an audit record; report text is not, so
+// the log does not carry the details of a harassment report.
+//
+// Status notifications need an event publisher, which is bound after
+// construction with SetPublisher once the event bus is up. Until then
+// claims and resolutions are stored but the reporter is not told.
+type Service struct {
+	repo     Repository
+	sessions Sessions
+	engine   types.AccessPolicyEngine
+	logger   *slog.Logger
+	now      func() time.Time
+
+	mu     sync.RWMutex
+	pub    eventbus.Publisher
+	gameID func() string
+}
+
+// NewService creates a Service. repo and engine are required; a nil
+// sessions files reports without a scene, and a nil logger uses
+// slog.Default().
+func NewService(repo Repository, sessions Sessions, engine types.AccessPolicyEngine, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("report repository is required")
+	}
+	if engine == nil {
+		return nil, oops.Errorf("access policy engine is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, sessions: sessions, engine: engine, logger: logger, now: time.Now}, nil
+}
+
+// SetPublisher binds the publisher used for report_status events. gameID
+// supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// File stores a new report, capturing the scene the reporter is posing in
+// alongside the location they give. Returns REPORT_INVALID for empty or
+// oversized text and REPORT_LIMIT (wrapping ErrTooManyOpen) when the
+// reporter already has MaxOpenReports unresolved reports.
+func (s *Service) File(ctx context.Context, req FileRequest) (*Report, error) {
+	r, err := NewReport(idgen.New(), req.Reporter, req.Category, req.Text, s.now())
+	if err != nil {
+		return nil, err
+	}
+	r.LocationID = req.LocationID
+	r.SceneID = s.currentScene(ctx, req.Reporter.ID)
+	if err := s.repo.Create(ctx, r, MaxOpenReports); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "report filed",
+		"event", "report_filed",
+		"report_id", r.ID.String(),
+		"reporter_id", r.Reporter.ID.String(),
+		"category", string(r.Category),
+		"location_id", idString(r.LocationID),
+		"scene_id", idString(r.SceneID),
+	)
+	return r, nil
+}
+
+// Mine returns the reports reporterID filed, newest first.
+func (s *Service) Mine(ctx context.Context, reporterID ulid.ULID) ([]*Report, error) {
+	return s.repo.ListByReporter(ctx, reporterID)
+}
+
+// Show returns report id for viewerID. A reporter may read their own
+// report, without its staff notes; staff who may triage read any report
+// with its notes. Anyone else gets REPORT_NOT_FOUND, as though the report
+// did not exist.
+func (s *Service) Show(ctx context.Context, viewerID, id ulid.ULID) (*Report, error) {
+	r, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	staff, err := s.canTriage(ctx, access.CharacterSubject(viewerID.String()))
+	if err != nil {
+		return nil, err
+	}
+	if staff {
+		return r, nil
+	}
+	if r.Reporter.ID != viewerID {
+		return nil, oops.Code("REPORT_NOT_FOUND").With("report_id", id.String()).Wrap(ErrNotFound)
+	}
+	r.Notes = nil
+	return r, nil
+}
+
+// Queue returns the unresolved reports, or every report when
+// includeResolved is set, oldest first. Returns REPORT_ACCESS_DENIED when
+// subject may not triage.
+func (s *Service) Queue(ctx context.Context, subject string, includeResolved bool) ([]*Report, error) {
+	if err := s.checkTriage(ctx, subject); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, includeResolved)
+}
+
+// Claim marks report id as being worked on by staff and tells the reporter.
+// Claiming a report staff already holds is a no-op. Returns
+// REPORT_ACCESS_DENIED when staff may not triage, REPORT_NOT_FOUND when
+// there is no such report, REPORT_CLAIMED when someone else holds it, and
+// REPORT_RESOLVED when it is closed.
+func (s *Service) Claim(ctx context.Context, staff CharacterRef, id ulid.ULID) (*Report, error) {
+	if err := s.checkTriage(ctx, access.CharacterSubject(staff.ID.String())); err != nil {
+		return nil, err
+	}
+	r, claimed, err := s.repo.Claim(ctx, id, staff, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		switch {
+		case r.Status == StatusResolved:
+			return nil, oops.Code("REPORT_RESOLVED").
+				With("report_id", id.String()).
+				Errorf("report %s is already resolved", id)
+		case r.ClaimedBy.ID != staff.ID:
+			return nil, oops.Code("REPORT_CLAIMED").
+				With("report_id", id.String()).
+				With("claimed_by", r.ClaimedBy.ID.String()).
+				Errorf("report %s is already claimed by %s", id, r.ClaimedBy.Name)
+		}
+		return r, nil
+	}
+	s.logger.InfoContext(ctx, "report claimed",
+		"event", "report_claimed",
+		"report_id", id.String(),
+		"staff_id", staff.ID.String(),
+	)
+	s.notify(ctx, r)
+	return r, nil
+}
+
+// AddNote adds a staff note to report id. Returns REPORT_INVALID for empty
+// or oversized text, REPORT_ACCESS_DENIED when staff may not triage, and
+// REPORT_NOT_FOUND when there is no such report.
+func (s *Service) AddNote(ctx context.Context, staff CharacterRef, id ulid.ULID, body string) (*Note, error) {
+	body, err := ValidateText(body, "note")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTriage(ctx, access.CharacterSubject(staff.ID.String())); err != nil {
+		return nil, err
+	}
+	note := &Note{ID: idgen.New(), ReportID: id, Author: staff, Body: body, CreatedAt: s.now().UTC()}
+	if err := s.repo.AddNote(ctx, note); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "report note added",
+		"event", "report_note_added",
+		"report_id", id.String(),
+		"note_id", note.ID.String(),
+		"staff_id", staff.ID.String(),
+	)
+	return note, nil
+}
+
+// Resolve closes report id with resolution, which the reporter is shown.
+// A report need not be claimed first. Returns REPORT_INVALID for empty or
+// oversized text, REPORT_ACCESS_DENIED when staff may not triage,
+// REPORT_NOT_FOUND when there is no such report, and REPORT_RESOLVED when
+// it is already closed.
+func (s *Service) Resolve(ctx context.Context, staff CharacterRef, id ulid.ULID, resolution string) (*Report, error) {
+	resolution, err := ValidateText(resolution, "resolution")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTriage(ctx, access.CharacterSubject(staff.ID.String())); err != nil {
+		return nil, err
+	}
+	r, resolved, err := s.repo.Resolve(ctx, id, staff, resolution, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		return nil, oops.Code("REPORT_RESOLVED").
+			With("report_id", id.String()).
+			Errorf("report %s is already resolved", id)
+	}
+	s.logger.InfoContext(ctx, "report resolved",
+		"event", "report_resolved",
+		"report_id", id.String(),
+		"staff_id", staff.ID.String(),
+	)
+	s.notify(ctx, r)
+	return r, nil
+}
+
+// StatusText is the line a reporter is shown when r is claimed or
+// resolved.
+func StatusText(r *Report) string {
+	head := fmt.Sprintf("Your %s report %s", r.Category, r.ID)
+	if r.Status == StatusResolved {
+		return head + " has been resolved: " + r.Resolution
+	}
+	return head + " has been picked up by staff."
+}
+
+// currentScene returns the scene characterID is posing in, or zero. A
+// failed lookup files the report without one rather than refusing it.
+func (s *Service) currentScene(ctx context.Context, characterID ulid.ULID) ulid.ULID {
+	if s.sessions == nil {
+		return ulid.ULID{}
+	}
+	info, err := s.sessions.FindByCharacter(ctx, characterID)
+	if err != nil {
+		s.logger.DebugContext(ctx, "report scene not captured",
+			"character_id", characterID.String(),
+			"error", err,
+		)
+		return ulid.ULID{}
+	}
+	if info == nil || info.PresentingFocus == nil || info.PresentingFocus.Kind != session.FocusKindScene {
+		return ulid.ULID{}
+	}
+	return info.PresentingFocus.TargetID
+}
+
+// notify sends the reporter a report_status event. A failure is logged:
+// the change is already stored and the reporter can see it with the report
+// command.
+func (s *Service) notify(ctx context.Context, r *Report) {
+	pub, gameID := s.publisher()
+	if pub == nil || r.Reporter.ID == (ulid.ULID{}) {
+		return
+	}
+	if err := publishStatus(ctx, pub, gameID, r); err != nil {
+		s.logger.WarnContext(ctx, "report status not published",
+			"report_id", r.ID.String(),
+			"reporter_id", r.Reporter.ID.String(),
+			"error", err,
+		)
+	}
+}
+
+func publishStatus(ctx context.Context, pub eventbus.Publisher, gameID func() string, r *Report) error {
+	data, err := json.Marshal(eventvocab.ReportStatusPayload{
+		ReportID:   r.ID.String(),
+		Category:   string(r.Category),
+		Status:     string(r.Status),
+		Resolution: r.Resolution,
+		Text:       StatusText(r),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_report_status_payload").Wrap(err)
+	}
+	sub, err := eventbus.Qualify(gameIDOrDefault(gameID), "character."+r.Reporter.ID.String())
+	if err != nil {
+		return oops.With("character_id", r.Reporter.ID.String()).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeReportStatus))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeReportStatus)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.SystemActorULID}
+	ev := eventbus.NewEvent(sub, typ, actor, data)
+	ev.Sensitive = true
+	if err := pub.Publish(ctx, ev); err != nil {
+		return oops.Code("REPORT_PUBLISH_FAILED").
+			With("report_id", r.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+func (s *Service) checkTriage(ctx context.Context, subject string) error {
+	allowed, err := s.canTriage(ctx, subject)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		s.logger.WarnContext(ctx, "report triage denied",
+			"event", "report_triage_denied",
+			"subject", subject,
+		)
+		return oops.Code("REPORT_ACCESS_DENIED").Errorf("not permitted to triage reports")
+	}
+	return nil
+}
+
+func (s *Service) canTriage(ctx context.Context, subject string) (bool, error) {
+	resource := access.ReportResource(AreaQueue)
+	req, err := types.NewAccessRequest(subject, ActionTriage, resource, nil)
+	if err != nil {
+		return false, oops.Code("REPORT_ACCESS_EVALUATION_FAILED").Wrap(err)
+	}
+	decision, err := s.engine.Evaluate(ctx, req)
+	if err != nil {
+		return false, oops.Code("REPORT_ACCESS_EVALUATION_FAILED").
+			With("subject", subject).
+			With("resource", resource).
+			Wrap(err)
+	}
+	return decision.IsAllowed(), nil
+}
+
+func idString(id ulid.ULID) string {
+	if id == (ulid.ULID{}) {
+		return ""
+	}
+	return id.String()
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/session"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository.
+type memRepository struct {
+	mu      sync.Mutex
+	reports map[ulid.ULID]*Report
+	notes   map[ulid.ULID][]*Note
+}
+
+func newMemRepository() *memRepository {
+	return &memRepository{reports: map[ulid.ULID]*Report{}, notes: map[ulid.ULID][]*Note{}}
+}
+
+func (m *memRepository) Create(_ context.Context, r *Report, limit int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	open := 0
+	for _, existing := range m.reports {
+		if existing.Reporter.ID == r.Reporter.ID && existing.Status != StatusResolved {
+			open++
+		}
+	}
+	if open >= limit {
+		return oops.Code("REPORT_LIMIT").Wrap(ErrTooManyOpen)
+	}
+	stored := *r
+	m.reports[r.ID] = &stored
+	return nil
+}
+
+func (m *memRepository) Get(_ context.Context, id ulid.ULID) (*Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.reports[id]
+	if !ok {
+		return nil, oops.Code("REPORT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	out := *r
+	out.Notes = append([]*Note(nil), m.notes[id]...)
+	return &out, nil
+}
+
+func (m *memRepository) ListByReporter(_ context.Context, reporterID ulid.ULID) ([]*Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Report
+	for _, r := range m.reports {
+		if r.Reporter.ID == reporterID {
+			copied := *r
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Compare(out[j].ID) > 0 })
+	return out, nil
+}
+
+func (m *memRepository) List(_ context.Context, includeResolved bool) ([]*Report, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []*Report
+	for _, r := range m.reports {
+		if includeResolved || r.Status != StatusResolved {
+			copied := *r
+			out = append(out, &copied)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Compare(out[j].ID) < 0 })
+	return out, nil
+}
+
+func (m *memRepository) Claim(_ context.Context, id ulid.ULID, staff CharacterRef, at time.Time) (*Report, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.reports[id]
+	if !ok {
+		return nil, false, oops.Code("REPORT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	if r.Status != StatusOpen {
+		copied := *r
+		return &copied, false, nil
+	}
+	r.Status, r.ClaimedBy, r.ClaimedAt = StatusClaimed, staff, at
+	copied := *r
+	return &copied, true, nil
+}
+
+func (m *memRepository) AddNote(_ context.Context, note *Note) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.reports[note.ReportID]; !ok {
+		return oops.Code("REPORT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	m.notes[note.ReportID] = append(m.notes[note.ReportID], note)
+	return nil
+}
+
+func (m *memRepository) Resolve(_ context.Context, id ulid.ULID, staff CharacterRef, resolution string, at time.Time) (*Report, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.reports[id]
+	if !ok {
+		return nil, false, oops.Code("REPORT_NOT_FOUND").Wrap(ErrNotFound)
+	}
+	if r.Status == StatusResolved {
+		copied := *r
+		return &copied, false, nil
+	}
+	r.Status, r.Resolution, r.ResolvedBy, r.ResolvedAt = StatusResolved, resolution, staff, at
+	copied := *r
+	return &copied, true, nil
+}
+
+// fakeSessions returns a fixed session per character.
+type fakeSessions struct {
+	sessions map[ulid.ULID]*session.Info
+	err      error
+}
+
+func (f *fakeSessions) FindByCharacter(_ context.Context, characterID ulid.ULID) (*session.Info, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	info, ok := f.sessions[characterID]
+	if !ok {
+		return nil, oops.Code("SESSION_NOT_FOUND").Errorf("no session")
+	}
+	return info, nil
+}
+
+// fakePublisher records every published event.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) statuses(t *testing.T) []eventvocab.ReportStatusPayload {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []eventvocab.ReportStatusPayload
+	for _, ev := range f.published {
+		require.Equal(t, string(eventvocab.EventTypeReportStatus), string(ev.Type))
+		var p eventvocab.ReportStatusPayload
+		require.NoError(t, json.Unmarshal(ev.Payload, &p))
+		out = append(out, p)
+	}
+	return out
+}
+
+func mainGameID() string { return "main" }
+
+type testService struct {
+	*Service
+	repo     *memRepository
+	sessions *fakeSessions
+	engine   *policytest.GrantEngine
+	pub      *fakePublisher
+	logs     *bytes.Buffer
+	alice    CharacterRef
+	staff    CharacterRef
+}
+
+func newTestService(t *testing.T) *testService {
+	t.Helper()
+	repo := newMemRepository()
+	sessions := &fakeSessions{sessions: map[ulid.ULID]*session.Info{}}
+	engine := policytest.NewGrantEngine()
+	var logs bytes.Buffer
+	svc, err := NewService(repo, sessions, engine, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	pub := &fakePublisher{}
+	svc.SetPublisher(pub, mainGameID)
+	staff := CharacterRef{ID: idgen.New(), Name: "Sam"}
+	engine.Grant(access.CharacterSubject(staff.ID.String()), ActionTriage, access.ReportResource(AreaQueue))
+	return &testService{
+		Service:  svc,
+		repo:     repo,
+		sessions: sessions,
+		engine:   engine,
+		pub:      pub,
+		logs:     &logs,
+		alice:    CharacterRef{ID: idgen.New(), Name: "Alice"},
+		staff:    staff,
+	}
+}
+
+func (ts *testService) file(t *testing.T, text string) *Report {
+	t.Helper()
+	r, err := ts.File(context.Background(), FileRequest{Reporter: ts.alice, Category: CategoryBug, Text: text})
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	_, err := NewService(nil, nil, policytest.AllowAllEngine(), nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, nil, nil)
+	require.Error(t, err)
+	_, err = NewService(newMemRepository(), nil, policytest.AllowAllEngine(), nil)
+	require.NoError(t, err, "sessions are optional")
+}
+
+func TestParseCategory(t *testing.T) {
+	for in, want := range map[string]Category{"bug": CategoryBug, " Typo ": CategoryTypo, "HARASSMENT": CategoryHarassment} {
+		got, err := ParseCategory(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseCategory("idea")
+	errutil.AssertErrorCode(t, err, "REPORT_INVALID")
+}
+
+func TestFileCapturesLocationAndScene(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	locationID, sceneID := idgen.New(), idgen.New()
+	ts.sessions.sessions[ts.alice.ID] = &session.Info{
+		CharacterID:     ts.alice.ID,
+		PresentingFocus: &session.FocusKey{Kind: session.FocusKindScene, TargetID: sceneID},
+	}
+
+	r, err := ts.File(ctx, FileRequest{
+		Reporter:   ts.alice,
+		Category:   CategoryHarassment,
+		Text:       "  Bob keeps following me  ",
+		LocationID: locationID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Bob keeps following me", r.Text)
+	assert.Equal(t, StatusOpen, r.Status)
+	assert.Equal(t, locationID, r.LocationID)
+	assert.Equal(t, sceneID, r.SceneID)
+	assert.Contains(t, ts.logs.String(), `"event":"report_filed"`)
+	assert.NotContains(t, ts.logs.String(), "following", "report text stays out of the audit log")
+
+	stored, err := ts.repo.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, sceneID, stored.SceneID)
+}
+
+func TestFileWithoutSceneStillFiles(t *testing.T) {
+	ts := newTestService(t)
+	ts.sessions.err = errors.New("session store down")
+
+	r := ts.file(t, "the fountain has no description")
+	assert.Equal(t, ulid.ULID{}, r.SceneID)
+}
+
+func TestFileValidates(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+
+	_, err := ts.File(ctx, FileRequest{Reporter: ts.alice, Category: CategoryTypo, Text: "   "})
+	errutil.AssertErrorCode(t, err, "REPORT_INVALID")
+	_, err = ts.File(ctx, FileRequest{Reporter: ts.alice, Category: CategoryTypo, Text: strings.Repeat("x", MaxTextLength+1)})
+	errutil.AssertErrorCode(t, err, "REPORT_INVALID")
+}
+
+func TestFileLimitsOpenReports(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	var first *Report
+	for i := range MaxOpenReports {
+		r := ts.file(t, "report")
+		if i == 0 {
+			first = r
+		}
+	}
+
+	_, err := ts.File(ctx, FileRequest{Reporter: ts.alice, Category: CategoryBug, Text: "one more"})
+	errutil.AssertErrorCode(t, err, "REPORT_LIMIT")
+	assert.ErrorIs(t, err, ErrTooManyOpen)
+
+	_, err = ts.Resolve(ctx, ts.staff, first.ID, "fixed")
+	require.NoError(t, err)
+	ts.file(t, "one more")
+}
+
+func TestShowHidesNotesFromReporterAndReportsFromOthers(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	r := ts.file(t, "the bell rings twice")
+	_, err := ts.AddNote(ctx, ts.staff, r.ID, "could not reproduce")
+	require.NoError(t, err)
+
+	mine, err := ts.Show(ctx, ts.alice.ID, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "the bell rings twice", mine.Text)
+	assert.Empty(t, mine.Notes, "reporters do not see staff notes")
+
+	_, err = ts.Show(ctx, idgen.New(), r.ID)
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+	assert.NotContains(t, ts.logs.String(), "report_triage_denied", "a reporter reading their report is not a denial")
+
+	staffView, err := ts.Show(ctx, ts.staff.ID, r.ID)
+	require.NoError(t, err)
+	require.Len(t, staffView.Notes, 1)
+	assert.Equal(t, "could not reproduce", staffView.Notes[0].Body)
+	assert.Equal(t, ts.staff, staffView.Notes[0].Author)
+
+	_, err = ts.Show(ctx, ts.staff.ID, idgen.New())
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+}
+
+func TestMineListsOwnReports(t *testing.T) {
+	ts := newTestService(t)
+	ts.file(t, "first")
+	ts.file(t, "second")
+	_, err := ts.File(context.Background(), FileRequest{Reporter: ts.staff, Category: CategoryBug, Text: "other"})
+	require.NoError(t, err)
+
+	mine, err := ts.Mine(context.Background(), ts.alice.ID)
+	require.NoError(t, err)
+	require.Len(t, mine, 2)
+	assert.Equal(t, "second", mine[0].Text, "newest first")
+}
+
+func TestQueueRequiresTriage(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	open := ts.file(t, "open")
+	done := ts.file(t, "done")
+	_, err := ts.Resolve(ctx, ts.staff, done.ID, "fixed")
+	require.NoError(t, err)
+
+	_, err = ts.Queue(ctx, access.CharacterSubject(ts.alice.ID.String()), false)
+	errutil.AssertErrorCode(t, err, "REPORT_ACCESS_DENIED")
+	assert.Contains(t, ts.logs.String(), `"event":"report_triage_denied"`)
+
+	queue, err := ts.Queue(ctx, access.CharacterSubject(ts.staff.ID.String()), false)
+	require.NoError(t, err)
+	require.Len(t, queue, 1)
+	assert.Equal(t, open.ID, queue[0].ID)
+
+	all, err := ts.Queue(ctx, access.CharacterSubject(ts.staff.ID.String()), true)
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+}
+
+func TestClaimNotifiesReporter(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	r := ts.file(t, "the door sticks")
+
+	_, err := ts.Claim(ctx, ts.alice, r.ID)
+	errutil.AssertErrorCode(t, err, "REPORT_ACCESS_DENIED")
+
+	claimed, err := ts.Claim(ctx, ts.staff, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusClaimed, claimed.Status)
+	assert.Equal(t, ts.staff, claimed.ClaimedBy)
+	assert.Contains(t, ts.logs.String(), `"event":"report_claimed"`)
+
+	statuses := ts.pub.statuses(t)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "claimed", statuses[0].Status)
+	assert.Equal(t, r.ID.String(), statuses[0].ReportID)
+	assert.Equal(t, "Your bug report "+r.ID.String()+" has been picked up by staff.", statuses[0].Text)
+	ev := ts.pub.published[0]
+	assert.Equal(t, "events.main.character."+ts.alice.ID.String(), string(ev.Subject))
+	assert.True(t, ev.Sensitive)
+
+	_, err = ts.Claim(ctx, ts.staff, r.ID)
+	require.NoError(t, err, "claiming your own claim again is a no-op")
+	assert.Len(t, ts.pub.statuses(t), 1)
+
+	other := CharacterRef{ID: idgen.New(), Name: "Kim"}
+	ts.engine.Grant(access.CharacterSubject(other.ID.String()), ActionTriage, access.ReportResource(AreaQueue))
+	_, err = ts.Claim(ctx, other, r.ID)
+	errutil.AssertErrorCode(t, err, "REPORT_CLAIMED")
+	assert.Contains(t, err.Error(), "Sam")
+
+	_, err = ts.Claim(ctx, ts.staff, idgen.New())
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+}
+
+func TestResolveNotifiesReporter(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	r := ts.file(t, "teh")
+
+	_, err := ts.Resolve(ctx, ts.staff, r.ID, " ")
+	errutil.AssertErrorCode(t, err, "REPORT_INVALID")
+
+	resolved, err := ts.Resolve(ctx, ts.staff, r.ID, "Fixed the typo.")
+	require.NoError(t, err)
+	assert.Equal(t, StatusResolved, resolved.Status)
+	assert.Equal(t, "Fixed the typo.", resolved.Resolution)
+	assert.Contains(t, ts.logs.String(), `"event":"report_resolved"`)
+
+	statuses := ts.pub.statuses(t)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "resolved", statuses[0].Status)
+	assert.Equal(t, "Fixed the typo.", statuses[0].Resolution)
+	assert.Equal(t, "Your bug report "+r.ID.String()+" has been resolved: Fixed the typo.", statuses[0].Text)
+
+	_, err = ts.Resolve(ctx, ts.staff, r.ID, "again")
+	errutil.AssertErrorCode(t, err, "REPORT_RESOLVED")
+	_, err = ts.Claim(ctx, ts.staff, r.ID)
+	errutil.AssertErrorCode(t, err, "REPORT_RESOLVED")
+}
+
+func TestAddNote(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestService(t)
+	r := ts.file(t, "lag")
+
+	_, err := ts.AddNote(ctx, ts.alice, r.ID, "me too")
+	errutil.AssertErrorCode(t, err, "REPORT_ACCESS_DENIED")
+	_, err = ts.AddNote(ctx, ts.staff, r.ID, "")
+	errutil.AssertErrorCode(t, err, "REPORT_INVALID")
+	_, err = ts.AddNote(ctx, ts.staff, idgen.New(), "note")
+	errutil.AssertErrorCode(t, err, "REPORT_NOT_FOUND")
+
+	note, err := ts.AddNote(ctx, ts.staff, r.ID, "seen on the west side only")
+	require.NoError(t, err)
+	assert.Equal(t, r.ID, note.ReportID)
+	assert.Contains(t, ts.logs.String(), `"event":"report_note_added"`)
+	assert.Empty(t, ts.pub.statuses(t), "notes are not sent to the reporter")
+}
+
+func TestStatusChangesWithoutPublisherAreStored(t *testing.T) {
+	ctx := context.Background()
+	svc, err := NewService(newMemRepository(), nil, policytest.AllowAllEngine(), nil)
+	require.NoError(t, err)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+	r, err := svc.File(ctx, FileRequest{Reporter: alice, Category: CategoryBug, Text: "x"})
+	require.NoError(t, err)
+
+	_, err = svc.Resolve(ctx, CharacterRef{ID: idgen.New(), Name: "Sam"}, r.ID, "done")
+	require.NoError(t, err)
+}
+
+func TestPublishFailureDoesNotFailResolve(t *testing.T) {
+	ts := newTestService(t)
+	r := ts.file(t, "x")
+	ts.pub.err = errors.New("bus down")
+
+	_, err := ts.Resolve(context.Background(), ts.staff, r.ID, "done")
+	require.NoError(t, err)
+	assert.Contains(t, ts.logs.String(), "report status not published")
+}
+
+func TestTriageEvaluationFailure(t *testing.T) {
+	svc, err := NewService(newMemRepository(), nil, policytest.NewErrorEngine(errors.New("engine down")), nil)
+	require.NoError(t, err)
+
+	_, err = svc.Queue(context.Background(), "character:x", false)
+	errutil.AssertErrorCode(t, err, "REPORT_ACCESS_EVALUATION_FAILED")
+}
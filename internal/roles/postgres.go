@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package roles
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// PostgresStore implements Repository against the character_roles table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// List returns the roles assigned to a character, ordered by role.
+func (s *PostgresStore) List(ctx context.Context, characterID ulid.ULID) ([]Assignment, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT role, granted_by, granted_at FROM character_roles WHERE character_id = $1 ORDER BY role
+	`, characterID.String())
+	if err != nil {
+		return nil, oops.Code("ROLE_STORE_FAILED").
+			With("operation", "list").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	var assigned []Assignment
+	for rows.Next() {
+		a := Assignment{CharacterID: characterID}
+		var grantedAt *pgnanos.Time
+		if err := rows.Scan(&a.Role, &a.GrantedBy, &grantedAt); err != nil {
+			return nil, oops.Code("ROLE_STORE_FAILED").
+				With("operation", "list").
+				With("character_id", characterID.String()).
+				Wrap(err)
+		}
+		if grantedAt != nil {
+			a.GrantedAt = grantedAt.Time()
+		}
+		assigned = append(assigned, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("ROLE_STORE_FAILED").
+			With("operation", "list").
+			With("character_id", characterID.String()).
+			Wrap(err)
+	}
+	return assigned, nil
+}
+
+// Grant stores an assignment, returning ROLE_EXISTS if the character
+// already holds the role. A grant to a character that does not exist fails
+// the foreign key and surfaces as ROLE_STORE_FAILED.
+func (s *PostgresStore) Grant(ctx context.Context, assignment Assignment) error {
+	tag, err := s.pool.Exec(ctx, `
+		INSERT INTO character_roles (character_id, role, granted_by, granted_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (character_id, role) DO NOTHING
+	`, assignment.CharacterID.String(), assignment.Role, assignment.GrantedBy,
+		pgnanos.From(assignment.GrantedAt))
+	if err != nil {
+		return oops.Code("ROLE_STORE_FAILED").
+			With("operation", "grant").
+			With("character_id", assignment.CharacterID.String()).
+			With("role", assignment.Role).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("ROLE_EXISTS").
+			With("character_id", assignment.CharacterID.String()).
+			With("role", assignment.Role).
+			Errorf("character already holds the %s role", assignment.Role)
+	}
+	return nil
+}
+
+// Revoke removes a role from a character. Revoking admin locks every admin
+// assignment first, so two concurrent revocations cannot both pass the
+// last-admin check.
+func (s *PostgresStore) Revoke(ctx context.Context, characterID ulid.ULID, role string) error {
+	dbtx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return oops.Code("ROLE_STORE_FAILED").With("operation", "begin").Wrap(err)
+	}
+	defer dbtx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	if role == access.RoleAdmin {
+		holders, err := lockHolders(ctx, dbtx, role)
+		if err != nil {
+			return err
+		}
+		if len(holders) == 1 && holders[0] == characterID.String() {
+			return oops.Code("ROLE_LAST_ADMIN").
+				With("character_id", characterID.String()).
+				Wrap(ErrLastAdmin)
+		}
+	}
+
+	tag, err := dbtx.Exec(ctx, `DELETE FROM character_roles WHERE character_id = $1 AND role = $2`,
+		characterID.String(), role)
+	if err != nil {
+		return oops.Code("ROLE_STORE_FAILED").
+			With("operation", "revoke").
+			With("character_id", characterID.String()).
+			With("role", role).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("ROLE_NOT_ASSIGNED").
+			With("character_id", characterID.String()).
+			With("role", role).
+			Wrap(ErrNotFound)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.Code("ROLE_STORE_FAILED").With("operation", "commit").Wrap(err)
+	}
+	return nil
+}
+
+// lockHolders returns the IDs of the characters holding role, locking their
+// assignments until the transaction ends.
+func lockHolders(ctx context.Context, dbtx pgx.Tx, role string) ([]string, error) {
+	rows, err := dbtx.Query(ctx, `
+		SELECT character_id FROM character_roles WHERE role = $1 ORDER BY character_id FOR UPDATE
+	`, role)
+	if err != nil {
+		return nil, oops.Code("ROLE_STORE_FAILED").With("operation", "lock_holders").With("role", role).Wrap(err)
+	}
+	holders, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, oops.Code("ROLE_STORE_FAILED").With("operation", "lock_holders").With("role", role).Wrap(err)
+	}
+	return holders, nil
+}
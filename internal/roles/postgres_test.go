@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package roles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/roles"
+	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func createCharacter(t *testing.T, pool *pgxpool.Pool, name string) roles.CharacterRef {
+	t.Helper()
+	id := idgen.New()
+	_, err := pool.Exec(context.Background(),
+		`INSERT INTO characters (id, name) VALUES ($1, $2)`, id.String(), name)
+	require.NoError(t, err)
+	return roles.CharacterRef{ID: id, Name: name}
+}
+
+func TestPostgresStoreGrantListRevoke(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := roles.NewPostgresStore(pool)
+	alice := createCharacter(t, pool, "RoleAlice")
+	grantedAt := time.Now()
+
+	require.NoError(t, st.Grant(ctx, roles.Assignment{
+		CharacterID: alice.ID, Role: access.RoleGM, GrantedBy: "Wizard", GrantedAt: grantedAt,
+	}))
+	require.NoError(t, st.Grant(ctx, roles.Assignment{
+		CharacterID: alice.ID, Role: access.RoleBuilder, GrantedBy: "Wizard", GrantedAt: grantedAt,
+	}))
+	errutil.AssertErrorCode(t, st.Grant(ctx, roles.Assignment{
+		CharacterID: alice.ID, Role: access.RoleGM, GrantedAt: grantedAt,
+	}), "ROLE_EXISTS")
+
+	assigned, err := st.List(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, assigned, 2)
+	assert.Equal(t, access.RoleBuilder, assigned[0].Role)
+	assert.Equal(t, access.RoleGM, assigned[1].Role)
+	assert.Equal(t, "Wizard", assigned[1].GrantedBy)
+	assert.Equal(t, grantedAt.UnixNano(), assigned[1].GrantedAt.UnixNano())
+
+	require.NoError(t, st.Revoke(ctx, alice.ID, access.RoleGM))
+	err = st.Revoke(ctx, alice.ID, access.RoleGM)
+	errutil.AssertErrorCode(t, err, "ROLE_NOT_ASSIGNED")
+	assert.ErrorIs(t, err, roles.ErrNotFound)
+}
+
+func TestPostgresStoreListsLegacyAssignments(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	alice := createCharacter(t, pool, "RoleLegacy")
+	require.NoError(t, store.NewPostgresRoleStore(pool).AddRole(ctx, alice.ID.String(), "staff"))
+
+	assigned, err := roles.NewPostgresStore(pool).List(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, assigned, 1)
+	assert.Equal(t, "staff", assigned[0].Role)
+	assert.Empty(t, assigned[0].GrantedBy)
+	assert.True(t, assigned[0].GrantedAt.IsZero())
+}
+
+func TestPostgresStoreRevokeKeepsLastAdmin(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := roles.NewPostgresStore(pool)
+	_, err := pool.Exec(ctx, `DELETE FROM character_roles WHERE role = $1`, access.RoleAdmin)
+	require.NoError(t, err)
+
+	alice := createCharacter(t, pool, "RoleAdminAlice")
+	bob := createCharacter(t, pool, "RoleAdminBob")
+	require.NoError(t, st.Grant(ctx, roles.Assignment{CharacterID: alice.ID, Role: access.RoleAdmin, GrantedAt: time.Now()}))
+
+	err = st.Revoke(ctx, alice.ID, access.RoleAdmin)
+	errutil.AssertErrorCode(t, err, "ROLE_LAST_ADMIN")
+	assert.ErrorIs(t, err, roles.ErrLastAdmin)
+	errutil.AssertErrorCode(t, st.Revoke(ctx, bob.ID, access.RoleAdmin), "ROLE_NOT_ASSIGNED")
+
+	require.NoError(t, st.Grant(ctx, roles.Assignment{CharacterID: bob.ID, Role: access.RoleAdmin, GrantedAt: time.Now()}))
+	require.NoError(t, st.Revoke(ctx, alice.ID, access.RoleAdmin))
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package roles grants and revokes the roles assigned to characters. Roles
+// form a hierarchy (player < builder < gm < admin, see access.EffectiveRoles)
+// that policies reference with `principal in role "<name>"`; this package
+// only manages assignments and leaves inheritance to the access layer.
+package roles
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+var (
+	// ErrNotFound is returned when a character does not hold a role.
+	ErrNotFound = errors.New("role not assigned")
+	// ErrCharacterNotFound is returned when no character has a given name.
+	ErrCharacterNotFound = errors.New("character not found")
+	// ErrLastAdmin is returned when revoking admin would leave no character
+	// holding it.
+	ErrLastAdmin = errors.New("cannot revoke the last admin")
+)
+
+// CharacterRef identifies a character by ID and display name.
+type CharacterRef struct {
+	ID   ulid.ULID
+	Name string
+}
+
+// Assignment is one role held directly by a character. Inherited roles are
+// not stored. GrantedAt is zero and GrantedBy empty for assignments made
+// before grants were recorded; GrantedBy is also empty for roles assigned
+// by bootstrap.
+type Assignment struct {
+	CharacterID ulid.ULID
+	Role        string
+	GrantedBy   string
+	GrantedAt   time.Time
+}
+
+// Repository persists role assignments.
+type Repository interface {
+	// List returns the roles assigned to a character, ordered by role.
+	List(ctx context.Context, characterID ulid.ULID) ([]Assignment, error)
+
+	// Grant stores an assignment. It returns ROLE_EXISTS when the character
+	// already holds the role.
+	Grant(ctx context.Context, assignment Assignment) error
+
+	// Revoke removes a role from a character. It returns ErrNotFound when
+	// the character does not hold it and ErrLastAdmin when the role is
+	// admin and no other character holds it.
+	Revoke(ctx context.Context, characterID ulid.ULID, role string) error
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package roles
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Characters resolves character names the way the acting character sees
+// the world, so the role command never reveals a character hidden from
+// its caller. *world.Service satisfies it.
+type Characters interface {
+	FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*world.Character, error)
+}
+
+// Service is the role management API: it grants and revokes character roles
+// and writes every change to the structured log as an audit record. Policy
+// decisions pick a change up on the next request, because roles are
+// resolved into the principal's attributes on every evaluation.
+type Service struct {
+	repo       Repository
+	characters Characters
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewService creates a Service. repo and characters are required; a nil
+// logger uses slog.Default().
+func NewService(repo Repository, characters Characters, logger *slog.Logger) (*Service, error) {
+	if repo == nil {
+		return nil, oops.Errorf("role repository is required")
+	}
+	if characters == nil {
+		return nil, oops.Errorf("character lookup is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Service{repo: repo, characters: characters, logger: logger, now: time.Now}, nil
+}
+
+// FindCharacter looks up the character named name, ignoring case, as
+// observerID sees it. Returns ROLE_CHARACTER_NOT_FOUND (wrapping
+// ErrCharacterNotFound) when there is none or observerID cannot see it.
+func (s *Service) FindCharacter(ctx context.Context, observerID ulid.ULID, name string) (CharacterRef, error) {
+	char, err := s.characters.FindCharacterByName(ctx, observerID, name)
+	if errors.Is(err, world.ErrNotFound) {
+		return CharacterRef{}, oops.Code("ROLE_CHARACTER_NOT_FOUND").With("name", name).Wrap(ErrCharacterNotFound)
+	}
+	if err != nil {
+		return CharacterRef{}, oops.Code("ROLE_STORE_FAILED").
+			With("operation", "find_character").
+			With("name", name).
+			Wrap(err)
+	}
+	return CharacterRef{ID: char.ID, Name: char.Name}, nil
+}
+
+// Assignments returns the roles assigned directly to a character. Use
+// access.EffectiveRoles for the roles it holds through the hierarchy.
+func (s *Service) Assignments(ctx context.Context, characterID ulid.ULID) ([]Assignment, error) {
+	assigned, err := s.repo.List(ctx, characterID)
+	if err != nil {
+		return nil, oops.Code("ROLE_LIST_FAILED").With("character_id", characterID.String()).Wrap(err)
+	}
+	return assigned, nil
+}
+
+// Grant assigns role to character. grantedBy is stored with the assignment
+// and recorded in the audit log. Role names are case-insensitive; the
+// implicit player role cannot be granted.
+func (s *Service) Grant(ctx context.Context, character CharacterRef, role, grantedBy string) (*Assignment, error) {
+	role, err := normalizeRole(role)
+	if err != nil {
+		return nil, err
+	}
+	assignment := Assignment{
+		CharacterID: character.ID,
+		Role:        role,
+		GrantedBy:   grantedBy,
+		GrantedAt:   s.now(),
+	}
+	if err := s.repo.Grant(ctx, assignment); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(
+		ctx, "role granted",
+		"event", "role_granted",
+		"character_id", character.ID.String(),
+		"character_name", character.Name,
+		"role", role,
+		"granted_by", grantedBy,
+	)
+	return &assignment, nil
+}
+
+// Revoke removes role from character. revokedBy is recorded in the audit
+// log. Revoking admin from the last character holding it is refused, so the
+// game cannot lock its staff out.
+func (s *Service) Revoke(ctx context.Context, character CharacterRef, role, revokedBy string) error {
+	role, err := normalizeRole(role)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Revoke(ctx, character.ID, role); err != nil {
+		return err
+	}
+	s.logger.InfoContext(
+		ctx, "role revoked",
+		"event", "role_revoked",
+		"character_id", character.ID.String(),
+		"character_name", character.Name,
+		"role", role,
+		"revoked_by", revokedBy,
+	)
+	return nil
+}
+
+// normalizeRole lowercases role and checks it can be assigned.
+func normalizeRole(role string) (string, error) {
+	role = strings.ToLower(strings.TrimSpace(role))
+	if !access.ValidRoleName(role) {
+		return "", oops.Code("ROLE_INVALID").
+			With("role", role).
+			Errorf("%q is not a valid role name; use lowercase letters, digits, '_' or '-'", role)
+	}
+	if role == access.RolePlayer {
+		return "", oops.Code("ROLE_INVALID").
+			Errorf("every character holds the %s role; it cannot be granted or revoked", access.RolePlayer)
+	}
+	return role, nil
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package roles
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memRepository is an in-memory Repository. It also stands in for the
+// world's character lookup, with hidden characters seen only by themselves.
+type memRepository struct {
+	mu          sync.Mutex
+	characters  []CharacterRef
+	hidden      map[ulid.ULID]bool
+	assignments []Assignment
+}
+
+func (m *memRepository) FindCharacterByName(_ context.Context, observerID ulid.ULID, name string) (*world.Character, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.characters {
+		if strings.EqualFold(c.Name, name) && (!m.hidden[c.ID] || c.ID == observerID) {
+			return &world.Character{ID: c.ID, Name: c.Name}, nil
+		}
+	}
+	return nil, oops.Code("CHARACTER_NOT_FOUND").Wrap(world.ErrNotFound)
+}
+
+func (m *memRepository) List(_ context.Context, characterID ulid.ULID) ([]Assignment, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Assignment
+	for _, a := range m.assignments {
+		if a.CharacterID == characterID {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (m *memRepository) Grant(_ context.Context, assignment Assignment) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, a := range m.assignments {
+		if a.CharacterID == assignment.CharacterID && a.Role == assignment.Role {
+			return oops.Code("ROLE_EXISTS").Errorf("already assigned")
+		}
+	}
+	m.assignments = append(m.assignments, assignment)
+	return nil
+}
+
+func (m *memRepository) Revoke(_ context.Context, characterID ulid.ULID, role string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	holders := 0
+	for _, a := range m.assignments {
+		if a.Role == role {
+			holders++
+		}
+	}
+	for i, a := range m.assignments {
+		if a.CharacterID == characterID && a.Role == role {
+			if role == access.RoleAdmin && holders == 1 {
+				return oops.Code("ROLE_LAST_ADMIN").Wrap(ErrLastAdmin)
+			}
+			m.assignments = append(m.assignments[:i], m.assignments[i+1:]...)
+			return nil
+		}
+	}
+	return oops.Code("ROLE_NOT_ASSIGNED").Wrap(ErrNotFound)
+}
+
+func newTestService(t *testing.T) (*Service, *memRepository, *bytes.Buffer) {
+	t.Helper()
+	repo := &memRepository{}
+	var logs bytes.Buffer
+	svc, err := NewService(repo, repo, slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	return svc, repo, &logs
+}
+
+func TestNewServiceRequiresDependencies(t *testing.T) {
+	repo := &memRepository{}
+	_, err := NewService(nil, repo, nil)
+	require.Error(t, err)
+	_, err = NewService(repo, nil, nil)
+	require.Error(t, err)
+}
+
+func TestServiceGrantAndRevokeAudit(t *testing.T) {
+	ctx := context.Background()
+	svc, _, logs := newTestService(t)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+
+	granted, err := svc.Grant(ctx, alice, " GM ", "Wizard")
+	require.NoError(t, err)
+	assert.Equal(t, access.RoleGM, granted.Role)
+	assert.Equal(t, now, granted.GrantedAt)
+	assert.Contains(t, logs.String(), `"event":"role_granted"`)
+	assert.Contains(t, logs.String(), `"granted_by":"Wizard"`)
+
+	_, err = svc.Grant(ctx, alice, access.RoleGM, "Wizard")
+	errutil.AssertErrorCode(t, err, "ROLE_EXISTS")
+
+	assigned, err := svc.Assignments(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, assigned, 1)
+	assert.Equal(t, "Wizard", assigned[0].GrantedBy)
+
+	require.NoError(t, svc.Revoke(ctx, alice, "gm", "Admin"))
+	assert.Contains(t, logs.String(), `"event":"role_revoked"`)
+	assert.Contains(t, logs.String(), `"revoked_by":"Admin"`)
+
+	err = svc.Revoke(ctx, alice, "gm", "Admin")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestServiceRejectsUnassignableRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, logs := newTestService(t)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+
+	for _, role := range []string{"", "game master", "role:admin", access.RolePlayer} {
+		_, err := svc.Grant(ctx, alice, role, "Wizard")
+		errutil.AssertErrorCode(t, err, "ROLE_INVALID")
+	}
+	errutil.AssertErrorCode(t, svc.Revoke(ctx, alice, access.RolePlayer, "Wizard"), "ROLE_INVALID")
+	assert.Empty(t, repo.assignments)
+	assert.Empty(t, logs.String())
+}
+
+func TestServiceAllowsCustomRoles(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _ := newTestService(t)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+
+	granted, err := svc.Grant(ctx, alice, "staff", "Wizard")
+	require.NoError(t, err)
+	assert.Equal(t, "staff", granted.Role)
+}
+
+func TestServiceRevokeKeepsLastAdmin(t *testing.T) {
+	ctx := context.Background()
+	svc, _, logs := newTestService(t)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+	bob := CharacterRef{ID: idgen.New(), Name: "Bob"}
+
+	_, err := svc.Grant(ctx, alice, access.RoleAdmin, "")
+	require.NoError(t, err)
+	err = svc.Revoke(ctx, alice, access.RoleAdmin, "Alice")
+	assert.ErrorIs(t, err, ErrLastAdmin)
+	assert.NotContains(t, logs.String(), `"event":"role_revoked"`)
+
+	_, err = svc.Grant(ctx, bob, access.RoleAdmin, "Alice")
+	require.NoError(t, err)
+	require.NoError(t, svc.Revoke(ctx, alice, access.RoleAdmin, "Bob"))
+}
+
+func TestServiceFindCharacter(t *testing.T) {
+	svc, repo, _ := newTestService(t)
+	alice := CharacterRef{ID: idgen.New(), Name: "Alice"}
+	shade := CharacterRef{ID: idgen.New(), Name: "Shade"}
+	repo.characters = []CharacterRef{alice, shade}
+	repo.hidden = map[ulid.ULID]bool{shade.ID: true}
+
+	found, err := svc.FindCharacter(context.Background(), shade.ID, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, alice, found)
+
+	_, err = svc.FindCharacter(context.Background(), alice.ID, "nobody")
+	assert.ErrorIs(t, err, ErrCharacterNotFound)
+
+	_, err = svc.FindCharacter(context.Background(), alice.ID, "shade")
+	errutil.AssertErrorCode(t, err, "ROLE_CHARACTER_NOT_FOUND")
+	assert.ErrorIs(t, err, ErrCharacterNotFound, "a hidden character reads as missing")
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// MinEvery is the shortest interval an "@every" schedule may use. The
+// scheduler ticks once per minute-scale interval, so anything shorter would
+// only ever fire at tick granularity.
+const MinEvery = time.Minute
+
+// maxSearchYears bounds Next's search so an expression that parses but can
+// never match (e.g. "0 0 31 2 *") terminates. Five years always spans a leap
+// day, so "0 0 29 2 *" is still reachable.
+const maxSearchYears = 5
+
+// Schedule is a parsed cron expression. All matching is done in UTC.
+//
+// The standard five fields are supported — minute, hour, day of month, month,
+// day of week — each accepting "*", "?", single values, ranges ("1-5"),
+// lists ("1,15"), and steps ("*/15", "10-40/10"). Months and weekdays accept
+// three-letter names ("jan", "mon"); weekday 7 is Sunday. As in Vixie cron,
+// when both day of month and day of week are restricted a day matching
+// either fires. The descriptors @yearly, @annually, @monthly, @weekly,
+// @daily, @midnight, @hourly, and "@every <duration>" are also accepted.
+type Schedule struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	// domStar / dowStar record an unrestricted field, which switches the
+	// day-matching rule from OR to AND.
+	domStar bool
+	dowStar bool
+	every   time.Duration
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseSchedule parses a cron expression or descriptor. Errors carry oops
+// code SCHEDULE_INVALID.
+func ParseSchedule(expr string) (Schedule, error) {
+	trimmed := strings.TrimSpace(expr)
+	lower := strings.ToLower(trimmed)
+
+	if rest, ok := strings.CutPrefix(lower, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").With("schedule", expr).Wrap(err)
+		}
+		if d < MinEvery {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").
+				With("schedule", expr).
+				Errorf("@every interval must be at least %s", MinEvery)
+		}
+		return Schedule{expr: trimmed, every: d}, nil
+	}
+	if strings.HasPrefix(lower, "@") {
+		spec, ok := descriptors[lower]
+		if !ok {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").
+				With("schedule", expr).
+				Errorf("unknown schedule descriptor %q", trimmed)
+		}
+		s, err := parseFields(spec)
+		if err != nil {
+			return Schedule{}, err
+		}
+		s.expr = trimmed
+		return s, nil
+	}
+
+	s, err := parseFields(lower)
+	if err != nil {
+		return Schedule{}, oops.With("schedule", expr).Wrap(err)
+	}
+	s.expr = trimmed
+	if s.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("schedule", expr).
+			Errorf("schedule never fires")
+	}
+	return s, nil
+}
+
+func parseFields(spec string) (Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("fields", len(fields)).
+			Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	var s Schedule
+	var err error
+	if s.minute, err = minuteField.parse(fields[0]); err != nil {
+		return Schedule{}, err
+	}
+	if s.hour, err = hourField.parse(fields[1]); err != nil {
+		return Schedule{}, err
+	}
+	if s.dom, err = domField.parse(fields[2]); err != nil {
+		return Schedule{}, err
+	}
+	if s.month, err = monthField.parse(fields[3]); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow, err = dowField.parse(fields[4]); err != nil {
+		return Schedule{}, err
+	}
+	// Fold weekday 7 onto Sunday.
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domStar = isStar(fields[2])
+	s.dowStar = isStar(fields[4])
+	return s, nil
+}
+
+func isStar(field string) bool {
+	return field == "*" || field == "?"
+}
+
+// parse turns one comma-separated cron field into a bitmask of allowed
+// values.
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(field, ",") {
+		b, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+	return bits, nil
+}
+
+func (f cronField) parsePart(part string) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepPart)
+		if err != nil || n <= 0 {
+			return 0, f.invalid(part, "step must be a positive integer")
+		}
+		step = n
+	}
+
+	var lo, hi int
+	switch {
+	case isStar(rangePart):
+		lo, hi = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		loStr, hiStr, _ := strings.Cut(rangePart, "-")
+		var err error
+		if lo, err = f.value(loStr); err != nil {
+			return 0, f.invalid(part, err.Error())
+		}
+		if hi, err = f.value(hiStr); err != nil {
+			return 0, f.invalid(part, err.Error())
+		}
+		if lo > hi {
+			return 0, f.invalid(part, "range start is after range end")
+		}
+	default:
+		v, err := f.value(rangePart)
+		if err != nil {
+			return 0, f.invalid(part, err.Error())
+		}
+		lo, hi = v, v
+		// "5/10" means "from 5 to the end, every 10".
+		if hasStep {
+			hi = f.max
+		}
+	}
+
+	var bits uint64
+	for v := lo; v <= hi; v += step {
+		bits |= 1 << uint(v)
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[s]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, oops.Errorf("%q is not a number", s)
+	}
+	if v < f.min || v > f.max {
+		return 0, oops.Errorf("%d is outside %d-%d", v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (f cronField) invalid(part, reason string) error {
+	return oops.Code("SCHEDULE_INVALID").
+		With("field", f.name).
+		With("value", part).
+		Errorf("invalid %s %q: %s", f.name, part, reason)
+}
+
+// String returns the expression the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first firing time strictly after after, or the zero time
+// when the schedule cannot fire within the search horizon. Cron schedules
+// fire on whole minutes; @every schedules fire at after plus the interval.
+func (s Schedule) Next(after time.Time) time.Time {
+	if s.every > 0 {
+		return after.Add(s.every).Truncate(time.Second)
+	}
+
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + maxSearchYears
+	for t.Year() <= limit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domOK := s.dom&(1<<uint(t.Day())) != 0
+	dowOK := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return domOK && dowOK
+	}
+	return domOK || dowOK
+}
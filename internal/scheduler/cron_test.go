@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/scheduler"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func utc(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestScheduleNextReturnsFirstMatchAfter(t *testing.T) {
+	// 2026-10-17 is a Saturday.
+	from := utc(2026, time.October, 17, 10, 7)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{"every minute advances one minute", "* * * * *", utc(2026, time.October, 17, 10, 8)},
+		{"step minutes snap to the next multiple", "*/15 * * * *", utc(2026, time.October, 17, 10, 15)},
+		{"fixed time later today", "30 14 * * *", utc(2026, time.October, 17, 14, 30)},
+		{"fixed time already passed rolls to tomorrow", "0 9 * * *", utc(2026, time.October, 18, 9, 0)},
+		{"minute list picks the nearest", "5,20,40 * * * *", utc(2026, time.October, 17, 10, 20)},
+		{"hour range with step", "0 9-17/4 * * *", utc(2026, time.October, 17, 13, 0)},
+		{"weekday names", "0 8 * * mon-fri", utc(2026, time.October, 19, 8, 0)},
+		{"weekday 7 is sunday", "0 0 * * 7", utc(2026, time.October, 18, 0, 0)},
+		{"month names", "0 0 1 jan *", utc(2027, time.January, 1, 0, 0)},
+		{"dom and dow restricted fire on either", "0 0 1 * sun", utc(2026, time.October, 18, 0, 0)},
+		{"leap day", "0 0 29 2 *", utc(2028, time.February, 29, 0, 0)},
+		{"hourly descriptor", "@hourly", utc(2026, time.October, 17, 11, 0)},
+		{"daily descriptor", "@daily", utc(2026, time.October, 18, 0, 0)},
+		{"weekly descriptor", "@weekly", utc(2026, time.October, 18, 0, 0)},
+		{"monthly descriptor", "@monthly", utc(2026, time.November, 1, 0, 0)},
+		{"yearly descriptor", "@yearly", utc(2027, time.January, 1, 0, 0)},
+		{"every descriptor adds the interval", "@every 90m", utc(2026, time.October, 17, 11, 37)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := scheduler.ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Next(from))
+		})
+	}
+}
+
+func TestScheduleNextIsStrictlyAfter(t *testing.T) {
+	s, err := scheduler.ParseSchedule("0 12 * * *")
+	require.NoError(t, err)
+
+	at := utc(2026, time.October, 17, 12, 0)
+	assert.Equal(t, utc(2026, time.October, 18, 12, 0), s.Next(at))
+}
+
+func TestScheduleNextConvertsToUTC(t *testing.T) {
+	s, err := scheduler.ParseSchedule("0 12 * * *")
+	require.NoError(t, err)
+
+	// 08:00 in UTC-5 is 13:00 UTC, after today's 12:00 UTC firing.
+	from := time.Date(2026, time.October, 17, 8, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	assert.Equal(t, utc(2026, time.October, 18, 12, 0), s.Next(from))
+}
+
+func TestParseScheduleRejectsInvalidExpressions(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{"empty", ""},
+		{"too few fields", "* * * *"},
+		{"too many fields", "* * * * * *"},
+		{"minute out of range", "60 * * * *"},
+		{"hour out of range", "0 24 * * *"},
+		{"day of month zero", "0 0 0 * *"},
+		{"month out of range", "0 0 1 13 *"},
+		{"unknown name", "0 0 * * funday"},
+		{"reversed range", "0 5-1 * * *"},
+		{"zero step", "*/0 * * * *"},
+		{"non-numeric step", "*/x * * * *"},
+		{"never fires", "0 0 31 2 *"},
+		{"unknown descriptor", "@fortnightly"},
+		{"every too short", "@every 30s"},
+		{"every unparseable", "@every soon"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := scheduler.ParseSchedule(tt.expr)
+			require.Error(t, err)
+			errutil.AssertErrorCode(t, err, "SCHEDULE_INVALID")
+		})
+	}
+}
+
+func TestScheduleStringReturnsTrimmedExpression(t *testing.T) {
+	s, err := scheduler.ParseSchedule("  */5 * * * *  ")
+	require.NoError(t, err)
+	assert.Equal(t, "*/5 * * * *", s.String())
+}
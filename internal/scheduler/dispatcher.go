@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventvocab"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// pluginDeliveryTimeout bounds one scheduled delivery to a plugin, matching
+// the subscriber's per-event delivery budget.
+const pluginDeliveryTimeout = 5 * time.Second
+
+// Broadcaster publishes a system message on a domain-relative subject.
+// *sysbroadcast.Broadcaster satisfies it.
+type Broadcaster interface {
+	Broadcast(ctx context.Context, subject, message string) error
+}
+
+// PluginDeliverer delivers an event to a named plugin and publishes what the
+// plugin emits in response. *plugins.Manager satisfies it.
+type PluginDeliverer interface {
+	DeliverEvent(ctx context.Context, pluginName string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error)
+	EmitPluginEvent(ctx context.Context, pluginName string, event pluginsdk.EmitEvent) error
+}
+
+// ActionDispatcher performs job actions: emit jobs go through a Broadcaster
+// and plugin jobs through a PluginDeliverer. Either MAY be nil, in which
+// case jobs of that action fail with SCHEDULE_ACTION_UNAVAILABLE.
+type ActionDispatcher struct {
+	broadcaster Broadcaster
+	plugins     PluginDeliverer
+	now         func() time.Time
+}
+
+// NewActionDispatcher creates an ActionDispatcher.
+func NewActionDispatcher(broadcaster Broadcaster, plugins PluginDeliverer) *ActionDispatcher {
+	return &ActionDispatcher{broadcaster: broadcaster, plugins: plugins, now: time.Now}
+}
+
+// Dispatch performs job's action.
+func (d *ActionDispatcher) Dispatch(ctx context.Context, job Job) error {
+	switch job.Action {
+	case ActionEmit:
+		if d.broadcaster == nil {
+			return oops.Code("SCHEDULE_ACTION_UNAVAILABLE").
+				With("job", job.Name).
+				Errorf("no broadcaster configured for emit jobs")
+		}
+		if err := d.broadcaster.Broadcast(ctx, job.Subject, job.Message); err != nil {
+			return oops.Code("SCHEDULE_DISPATCH_FAILED").
+				With("job", job.Name).
+				With("subject", job.Subject).
+				Wrap(err)
+		}
+		return nil
+	case ActionPlugin:
+		return d.deliverToPlugin(ctx, job)
+	default:
+		return oops.Code("SCHEDULE_INVALID").
+			With("job", job.Name).
+			With("action", string(job.Action)).
+			Errorf("unknown job action")
+	}
+}
+
+// deliverToPlugin hands the plugin a scheduled event attributed to the
+// system actor, then publishes its emits through the shared plugin emitter
+// so manifest validation applies exactly as on the subscriber path.
+func (d *ActionDispatcher) deliverToPlugin(ctx context.Context, job Job) error {
+	if d.plugins == nil {
+		return oops.Code("SCHEDULE_ACTION_UNAVAILABLE").
+			With("job", job.Name).
+			Errorf("no plugin deliverer configured for plugin jobs")
+	}
+
+	now := d.now()
+	payload, err := json.Marshal(eventvocab.ScheduledPayload{
+		Job:         job.Name,
+		Message:     job.Message,
+		ScheduledAt: now.UnixMilli(),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_scheduled_payload").Wrap(err)
+	}
+	event := pluginsdk.Event{
+		ID:        core.NewULID().String(),
+		Stream:    core.SystemBroadcastSubject,
+		Type:      pluginsdk.HostEventTypeScheduled,
+		Timestamp: now.UnixMilli(),
+		ActorKind: pluginsdk.ActorSystem,
+		ActorID:   core.ActorSystemID,
+		Payload:   string(payload),
+	}
+
+	dctx, cancel := context.WithTimeout(ctx, pluginDeliveryTimeout)
+	defer cancel()
+	dctx = core.WithActor(dctx, core.Actor{Kind: core.ActorSystem, ID: core.ActorSystemID})
+
+	emits, err := d.plugins.DeliverEvent(dctx, job.Plugin, event)
+	if err != nil {
+		return oops.Code("SCHEDULE_DISPATCH_FAILED").
+			With("job", job.Name).
+			With("plugin", job.Plugin).
+			Wrap(err)
+	}
+	for _, emit := range emits {
+		if err := d.plugins.EmitPluginEvent(dctx, job.Plugin, emit); err != nil {
+			return oops.Code("SCHEDULE_DISPATCH_FAILED").
+				With("job", job.Name).
+				With("plugin", job.Plugin).
+				With("stream", emit.Stream).
+				Wrap(err)
+		}
+	}
+	return nil
+}
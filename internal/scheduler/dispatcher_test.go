@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/scheduler"
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+type broadcast struct{ subject, message string }
+
+type fakeBroadcaster struct {
+	sent []broadcast
+	err  error
+}
+
+func (b *fakeBroadcaster) Broadcast(_ context.Context, subject, message string) error {
+	b.sent = append(b.sent, broadcast{subject, message})
+	return b.err
+}
+
+type fakePlugins struct {
+	delivered []pluginsdk.Event
+	actors    []core.Actor
+	emits     []pluginsdk.EmitEvent
+	emitted   []pluginsdk.EmitEvent
+	err       error
+}
+
+func (p *fakePlugins) DeliverEvent(ctx context.Context, _ string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error) {
+	p.delivered = append(p.delivered, event)
+	if actor, ok := core.ActorFromContext(ctx); ok {
+		p.actors = append(p.actors, actor)
+	}
+	return p.emits, p.err
+}
+
+func (p *fakePlugins) EmitPluginEvent(_ context.Context, _ string, event pluginsdk.EmitEvent) error {
+	p.emitted = append(p.emitted, event)
+	return nil
+}
+
+func TestDispatchEmitBroadcastsMessageOnSubject(t *testing.T) {
+	b := &fakeBroadcaster{}
+	d := scheduler.NewActionDispatcher(b, nil)
+
+	job := scheduler.Job{JobSpec: weatherSpec()}
+	require.NoError(t, d.Dispatch(context.Background(), job))
+
+	assert.Equal(t, []broadcast{{"system", "A light rain begins to fall."}}, b.sent)
+}
+
+func TestDispatchEmitWrapsBroadcastFailure(t *testing.T) {
+	d := scheduler.NewActionDispatcher(&fakeBroadcaster{err: errors.New("bus down")}, nil)
+
+	err := d.Dispatch(context.Background(), scheduler.Job{JobSpec: weatherSpec()})
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_DISPATCH_FAILED")
+}
+
+func TestDispatchPluginDeliversScheduledEventAndPublishesEmits(t *testing.T) {
+	p := &fakePlugins{emits: []pluginsdk.EmitEvent{{Stream: "location.01ABC", Type: "shops:restocked"}}}
+	d := scheduler.NewActionDispatcher(nil, p)
+
+	job := scheduler.Job{JobSpec: scheduler.JobSpec{
+		Name: "restock", Action: scheduler.ActionPlugin, Plugin: "shops", Message: `{"zone":"market"}`,
+	}}
+	require.NoError(t, d.Dispatch(context.Background(), job))
+
+	require.Len(t, p.delivered, 1)
+	ev := p.delivered[0]
+	assert.Equal(t, pluginsdk.HostEventTypeScheduled, ev.Type)
+	assert.Equal(t, pluginsdk.ActorSystem, ev.ActorKind)
+	assert.Equal(t, core.ActorSystemID, ev.ActorID)
+
+	var payload eventvocab.ScheduledPayload
+	require.NoError(t, json.Unmarshal([]byte(ev.Payload), &payload))
+	assert.Equal(t, "restock", payload.Job)
+	assert.Equal(t, `{"zone":"market"}`, payload.Message)
+
+	require.Len(t, p.actors, 1)
+	assert.Equal(t, core.ActorSystem, p.actors[0].Kind)
+	assert.Equal(t, p.emits, p.emitted)
+}
+
+func TestDispatchPluginWrapsDeliveryFailure(t *testing.T) {
+	d := scheduler.NewActionDispatcher(nil, &fakePlugins{err: errors.New("plugin not loaded")})
+
+	err := d.Dispatch(context.Background(), scheduler.Job{JobSpec: scheduler.JobSpec{
+		Name: "restock", Action: scheduler.ActionPlugin, Plugin: "shops",
+	}})
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_DISPATCH_FAILED")
+}
+
+func TestDispatchWithoutBackendReturnsUnavailable(t *testing.T) {
+	d := scheduler.NewActionDispatcher(nil, nil)
+
+	for _, job := range []scheduler.Job{
+		{JobSpec: weatherSpec()},
+		{JobSpec: scheduler.JobSpec{Name: "restock", Action: scheduler.ActionPlugin, Plugin: "shops"}},
+	} {
+		err := d.Dispatch(context.Background(), job)
+		errutil.AssertErrorCode(t, err, "SCHEDULE_ACTION_UNAVAILABLE")
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// MaxJitter bounds the random delay added to each firing. Jitter spreads
+// load from jobs that share a schedule; an hour is already far longer than
+// any world event should drift.
+const MaxJitter = time.Hour
+
+// MaxMessageLength bounds the message carried by a job's action.
+const MaxMessageLength = 4096
+
+// jobNamePattern restricts job names to short identifiers staff can type.
+var jobNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Action selects what a job does when it fires.
+type Action string
+
+const (
+	// ActionEmit publishes Message as a system event on Subject.
+	ActionEmit Action = "emit"
+	// ActionPlugin delivers a scheduled event carrying Message to Plugin.
+	ActionPlugin Action = "plugin"
+)
+
+// JobSpec describes a job to register.
+type JobSpec struct {
+	// Name is the unique, staff-facing job name, e.g. "weather".
+	Name string
+	// Schedule is a cron expression or descriptor (see Schedule).
+	Schedule string
+	// Action selects the job's behavior.
+	Action Action
+	// Subject is the domain-relative subject an emit job publishes on, e.g.
+	// "system" or "location.<id>". Ignored for plugin jobs.
+	Subject string
+	// Plugin is the loaded plugin a plugin job is delivered to. Ignored for
+	// emit jobs.
+	Plugin string
+	// Message is the broadcast text for emit jobs, or the payload passed
+	// through to the plugin for plugin jobs.
+	Message string
+	// Jitter is the upper bound of a random delay added to every firing.
+	Jitter time.Duration
+	// CreatedBy identifies the staff member registering the job.
+	CreatedBy string
+}
+
+// Validate checks the spec and returns its parsed schedule. Errors carry
+// oops code SCHEDULE_INVALID.
+func (s JobSpec) Validate() (Schedule, error) {
+	if !jobNamePattern.MatchString(s.Name) {
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("name", s.Name).
+			Errorf("job name must be 1-64 lowercase letters, digits, '-' or '_'")
+	}
+	switch s.Action {
+	case ActionEmit:
+		if s.Subject == "" {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").
+				With("name", s.Name).
+				Errorf("emit job requires a subject")
+		}
+		if s.Message == "" {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").
+				With("name", s.Name).
+				Errorf("emit job requires a message")
+		}
+	case ActionPlugin:
+		if s.Plugin == "" {
+			return Schedule{}, oops.Code("SCHEDULE_INVALID").
+				With("name", s.Name).
+				Errorf("plugin job requires a plugin")
+		}
+	default:
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("name", s.Name).
+			With("action", string(s.Action)).
+			Errorf("action must be %q or %q", ActionEmit, ActionPlugin)
+	}
+	if len(s.Message) > MaxMessageLength {
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("name", s.Name).
+			With("message_length", len(s.Message)).
+			Errorf("message exceeds %d bytes", MaxMessageLength)
+	}
+	if s.Jitter < 0 || s.Jitter > MaxJitter {
+		return Schedule{}, oops.Code("SCHEDULE_INVALID").
+			With("name", s.Name).
+			With("jitter", s.Jitter.String()).
+			Errorf("jitter must be between 0 and %s", MaxJitter)
+	}
+	return ParseSchedule(s.Schedule)
+}
+
+// Job is a registered, persisted job.
+type Job struct {
+	ID ulid.ULID
+	JobSpec
+	// Enabled reports whether the job fires. A disabled job keeps its
+	// NextRunAt so introspection still shows when it would have run.
+	Enabled bool
+	// NextRunAt is the next firing time, jitter included.
+	NextRunAt time.Time
+	// LastRunAt is the last time the job fired; nil when it never has.
+	LastRunAt *time.Time
+	// LastError is the error from the last firing, empty on success.
+	LastError string
+	// CreatedAt is when the job was registered.
+	CreatedAt time.Time
+}
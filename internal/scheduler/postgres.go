@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const jobColumns = `id, name, schedule, action, subject, plugin, message, jitter_ns,
+	enabled, next_run_at, last_run_at, last_error, created_by, created_at`
+
+// PostgresStore implements Store against the scheduled_jobs table.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// Create inserts job.
+func (s *PostgresStore) Create(ctx context.Context, job Job) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO scheduled_jobs (id, name, schedule, action, subject, plugin,
+		                            message, jitter_ns, enabled, next_run_at,
+		                            created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, job.ID[:], job.Name, job.Schedule, string(job.Action), job.Subject, job.Plugin,
+		job.Message, int64(job.Jitter), job.Enabled, pgnanos.From(job.NextRunAt),
+		job.CreatedBy, pgnanos.From(job.CreatedAt))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return oops.Code("SCHEDULE_EXISTS").
+				With("name", job.Name).
+				Errorf("a job named %q already exists", job.Name)
+		}
+		return oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "create").
+			With("name", job.Name).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Get returns the named job.
+func (s *PostgresStore) Get(ctx context.Context, name string) (Job, error) {
+	row := s.pool.QueryRow(ctx, `SELECT `+jobColumns+` FROM scheduled_jobs WHERE name = $1`, name)
+	job, err := scanJob(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Job{}, errNotFound(name)
+	}
+	if err != nil {
+		return Job{}, oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "get").
+			With("name", name).
+			Wrap(err)
+	}
+	return job, nil
+}
+
+// List returns every job ordered by name.
+func (s *PostgresStore) List(ctx context.Context) ([]Job, error) {
+	return s.query(ctx, "list", `SELECT `+jobColumns+` FROM scheduled_jobs ORDER BY name`)
+}
+
+// Due returns enabled jobs whose next run time is at or before now, oldest
+// first.
+func (s *PostgresStore) Due(ctx context.Context, now time.Time) ([]Job, error) {
+	return s.query(ctx, "due", `
+		SELECT `+jobColumns+`
+		  FROM scheduled_jobs
+		 WHERE enabled AND next_run_at <= $1
+		 ORDER BY next_run_at
+	`, pgnanos.From(now))
+}
+
+// SetEnabled toggles the named job and resets its next run time.
+func (s *PostgresStore) SetEnabled(ctx context.Context, name string, enabled bool, nextRunAt time.Time) error {
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE scheduled_jobs SET enabled = $1, next_run_at = $2 WHERE name = $3
+	`, enabled, pgnanos.From(nextRunAt), name)
+	if err != nil {
+		return oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "set_enabled").
+			With("name", name).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errNotFound(name)
+	}
+	return nil
+}
+
+// Delete removes the named job.
+func (s *PostgresStore) Delete(ctx context.Context, name string) error {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM scheduled_jobs WHERE name = $1`, name)
+	if err != nil {
+		return oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "delete").
+			With("name", name).
+			Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errNotFound(name)
+	}
+	return nil
+}
+
+// Claim advances job's next run time with a compare-and-set on the value
+// the caller read, so concurrent replicas racing for the same occurrence
+// see exactly one winner.
+func (s *PostgresStore) Claim(ctx context.Context, job Job, ranAt, nextRunAt time.Time) (bool, error) {
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE scheduled_jobs
+		   SET next_run_at = $1, last_run_at = $2
+		 WHERE id = $3 AND enabled AND next_run_at = $4
+	`, pgnanos.From(nextRunAt), pgnanos.From(ranAt), job.ID[:], pgnanos.From(job.NextRunAt))
+	if err != nil {
+		return false, oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "claim").
+			With("name", job.Name).
+			Wrap(err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// RecordResult stores the outcome of the last firing.
+func (s *PostgresStore) RecordResult(ctx context.Context, job Job, errText string) error {
+	if _, err := s.pool.Exec(ctx, `
+		UPDATE scheduled_jobs SET last_error = $1 WHERE id = $2
+	`, errText, job.ID[:]); err != nil {
+		return oops.Code("SCHEDULE_STORE_FAILED").
+			With("operation", "record_result").
+			With("name", job.Name).
+			Wrap(err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) query(ctx context.Context, operation, sql string, args ...any) ([]Job, error) {
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, oops.Code("SCHEDULE_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+
+	var jobs []Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, oops.Code("SCHEDULE_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("SCHEDULE_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return jobs, nil
+}
+
+func scanJob(row pgx.Row) (Job, error) {
+	var (
+		job       Job
+		idBytes   []byte
+		action    string
+		jitterNS  int64
+		nextRunAt pgnanos.Time
+		lastRunAt *pgnanos.Time
+		createdAt pgnanos.Time
+	)
+	if err := row.Scan(&idBytes, &job.Name, &job.Schedule, &action, &job.Subject,
+		&job.Plugin, &job.Message, &jitterNS, &job.Enabled, &nextRunAt, &lastRunAt,
+		&job.LastError, &job.CreatedBy, &createdAt); err != nil {
+		return Job{}, err //nolint:wrapcheck // callers wrap with operation context
+	}
+	copy(job.ID[:], idBytes)
+	job.Action = Action(action)
+	job.Jitter = time.Duration(jitterNS)
+	job.NextRunAt = nextRunAt.Time()
+	if lastRunAt != nil {
+		t := lastRunAt.Time()
+		job.LastRunAt = &t
+	}
+	job.CreatedAt = createdAt.Time()
+	return job, nil
+}
+
+func errNotFound(name string) error {
+	return oops.Code("SCHEDULE_NOT_FOUND").
+		With("name", name).
+		Errorf("no job named %q", name)
+}
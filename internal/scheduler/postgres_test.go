@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/scheduler"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+func newStoredJob(t *testing.T, st *scheduler.PostgresStore, name string, next time.Time) scheduler.Job {
+	t.Helper()
+	spec := weatherSpec()
+	spec.Name = name
+	spec.Jitter = 30 * time.Second
+	job := scheduler.Job{
+		ID:        idgen.New(),
+		JobSpec:   spec,
+		Enabled:   true,
+		NextRunAt: next,
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, st.Create(context.Background(), job))
+	return job
+}
+
+func TestPostgresStoreRoundTripsJob(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := scheduler.NewPostgresStore(pool)
+	next := time.Unix(0, 1_800_000_000_123_456_789)
+	job := newStoredJob(t, st, "pg-roundtrip", next)
+
+	got, err := st.Get(ctx, "pg-roundtrip")
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, got.ID)
+	assert.Equal(t, job.JobSpec, got.JobSpec)
+	assert.True(t, got.Enabled)
+	assert.True(t, next.Equal(got.NextRunAt))
+	assert.Nil(t, got.LastRunAt)
+
+	err = st.Create(ctx, job)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_EXISTS")
+}
+
+func TestPostgresStoreClaimHasSingleWinner(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := scheduler.NewPostgresStore(pool)
+	now := time.Now().Truncate(time.Second)
+	job := newStoredJob(t, st, "pg-claim", now.Add(-time.Minute))
+
+	due, err := st.Due(ctx, now)
+	require.NoError(t, err)
+	require.Contains(t, jobNames(due), "pg-claim")
+
+	won, err := st.Claim(ctx, job, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, won)
+
+	// A replica holding the stale next_run_at loses.
+	won, err = st.Claim(ctx, job, now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, won)
+
+	require.NoError(t, st.RecordResult(ctx, job, "boom"))
+	got, err := st.Get(ctx, "pg-claim")
+	require.NoError(t, err)
+	require.NotNil(t, got.LastRunAt)
+	assert.True(t, now.Equal(*got.LastRunAt))
+	assert.Equal(t, "boom", got.LastError)
+	assert.True(t, now.Add(time.Hour).Equal(got.NextRunAt))
+}
+
+func TestPostgresStoreDueSkipsDisabledJobs(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := scheduler.NewPostgresStore(pool)
+	now := time.Now()
+	newStoredJob(t, st, "pg-disabled", now.Add(-time.Minute))
+
+	require.NoError(t, st.SetEnabled(ctx, "pg-disabled", false, now.Add(-time.Minute)))
+	due, err := st.Due(ctx, now)
+	require.NoError(t, err)
+	assert.NotContains(t, jobNames(due), "pg-disabled")
+
+	err = st.SetEnabled(ctx, "pg-missing", true, now)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_NOT_FOUND")
+}
+
+func jobNames(jobs []scheduler.Job) []string {
+	names := make([]string, len(jobs))
+	for i, j := range jobs {
+		names[i] = j.Name
+	}
+	return names
+}
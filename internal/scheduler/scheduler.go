@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package scheduler runs staff-registered recurring world events — weather
+// broadcasts, shop restocks, zone resets — on cron schedules. Jobs are
+// persisted in PostgreSQL, so they survive restarts, and each firing either
+// publishes a system event or delivers a scheduled event to a plugin.
+//
+// Every replica may run the tick loop: a due job is claimed with a
+// compare-and-set on its next run time, so each occurrence fires once
+// cluster-wide.
+package scheduler
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Default Scheduler tuning.
+const (
+	defaultTickInterval = 15 * time.Second
+	// MaxNextRuns bounds NextRuns introspection.
+	MaxNextRuns = 20
+)
+
+// Store persists jobs. *PostgresStore satisfies it.
+type Store interface {
+	// Create inserts job. Returns SCHEDULE_EXISTS when the name is taken.
+	Create(ctx context.Context, job Job) error
+	// Get returns the named job. Returns SCHEDULE_NOT_FOUND when absent.
+	Get(ctx context.Context, name string) (Job, error)
+	// List returns every job ordered by name.
+	List(ctx context.Context) ([]Job, error)
+	// SetEnabled toggles the named job and resets its next run time.
+	// Returns SCHEDULE_NOT_FOUND when absent.
+	SetEnabled(ctx context.Context, name string, enabled bool, nextRunAt time.Time) error
+	// Delete removes the named job. Returns SCHEDULE_NOT_FOUND when absent.
+	Delete(ctx context.Context, name string) error
+	// Due returns enabled jobs whose next run time is at or before now.
+	Due(ctx context.Context, now time.Time) ([]Job, error)
+	// Claim advances job's next run time from job.NextRunAt to nextRunAt
+	// and stamps ranAt as its last run. claimed is false when another
+	// replica already advanced it, in which case the caller MUST NOT fire.
+	Claim(ctx context.Context, job Job, ranAt, nextRunAt time.Time) (claimed bool, err error)
+	// RecordResult stores the outcome of the last firing; errText is empty
+	// on success.
+	RecordResult(ctx context.Context, job Job, errText string) error
+}
+
+// Dispatcher performs a job's action. *ActionDispatcher satisfies it.
+type Dispatcher interface {
+	Dispatch(ctx context.Context, job Job) error
+}
+
+// Config configures a Scheduler.
+type Config struct {
+	Interval time.Duration                         // how often Run checks for due jobs (default: 15s)
+	Now      func() time.Time                      // clock override for tests (default: time.Now)
+	Jitter   func(max time.Duration) time.Duration // jitter source override for tests (default: crypto/rand)
+}
+
+// Scheduler registers jobs and fires them when due.
+type Scheduler struct {
+	config Config
+	store  Store
+
+	mu         sync.RWMutex
+	dispatcher Dispatcher
+}
+
+// NewScheduler creates a Scheduler over store. Zero config fields take their
+// defaults. The dispatcher is bound later with SetDispatcher because the
+// event publisher it needs is created after the command registry.
+//
+// Panics when store is nil, mirroring the construction-time failure
+// discipline of the other host services.
+func NewScheduler(config Config, store Store) *Scheduler {
+	if store == nil {
+		panic("scheduler.NewScheduler: nil Store")
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaultTickInterval
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	if config.Jitter == nil {
+		config.Jitter = cryptoJitter
+	}
+	return &Scheduler{config: config, store: store}
+}
+
+// SetDispatcher binds the dispatcher that performs job actions. Until it is
+// called, Tick leaves due jobs in place.
+func (s *Scheduler) SetDispatcher(d Dispatcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatcher = d
+}
+
+// Register validates spec and persists it as an enabled job.
+func (s *Scheduler) Register(ctx context.Context, spec JobSpec) (Job, error) {
+	sched, err := spec.Validate()
+	if err != nil {
+		return Job{}, err
+	}
+	now := s.config.Now()
+	job := Job{
+		ID:        idgen.New(),
+		JobSpec:   spec,
+		Enabled:   true,
+		NextRunAt: s.nextRun(sched, spec.Jitter, now),
+		CreatedAt: now,
+	}
+	if err := s.store.Create(ctx, job); err != nil {
+		return Job{}, err
+	}
+	slog.InfoContext(ctx, "scheduler: job registered",
+		"job", job.Name,
+		"schedule", job.Schedule,
+		"action", string(job.Action),
+		"created_by", job.CreatedBy,
+		"next_run_at", job.NextRunAt)
+	return job, nil
+}
+
+// Get returns the named job.
+func (s *Scheduler) Get(ctx context.Context, name string) (Job, error) {
+	return s.store.Get(ctx, name)
+}
+
+// List returns every registered job ordered by name.
+func (s *Scheduler) List(ctx context.Context) ([]Job, error) {
+	return s.store.List(ctx)
+}
+
+// Enable resumes the named job. Its next run is computed from now, so a job
+// disabled across several occurrences does not fire a backlog.
+func (s *Scheduler) Enable(ctx context.Context, name string) error {
+	return s.setEnabled(ctx, name, true)
+}
+
+// Disable stops the named job from firing until it is enabled again.
+func (s *Scheduler) Disable(ctx context.Context, name string) error {
+	return s.setEnabled(ctx, name, false)
+}
+
+func (s *Scheduler) setEnabled(ctx context.Context, name string, enabled bool) error {
+	job, err := s.store.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	sched, err := ParseSchedule(job.Schedule)
+	if err != nil {
+		return err
+	}
+	next := s.nextRun(sched, job.Jitter, s.config.Now())
+	if err := s.store.SetEnabled(ctx, name, enabled, next); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "scheduler: job toggled", "job", name, "enabled", enabled)
+	return nil
+}
+
+// Remove deletes the named job.
+func (s *Scheduler) Remove(ctx context.Context, name string) error {
+	if err := s.store.Delete(ctx, name); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "scheduler: job removed", "job", name)
+	return nil
+}
+
+// NextRuns returns up to n upcoming firing times for the named job. The
+// first is the stored (jittered) next run; later ones are the unjittered
+// schedule times that follow it, since their jitter is not drawn until the
+// preceding run fires.
+func (s *Scheduler) NextRuns(ctx context.Context, name string, n int) ([]time.Time, error) {
+	if n <= 0 || n > MaxNextRuns {
+		return nil, oops.Code("SCHEDULE_INVALID").
+			With("count", n).
+			Errorf("count must be between 1 and %d", MaxNextRuns)
+	}
+	job, err := s.store.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	sched, err := ParseSchedule(job.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	runs := []time.Time{job.NextRunAt}
+	for t := job.NextRunAt; len(runs) < n; {
+		t = sched.Next(t)
+		if t.IsZero() {
+			break
+		}
+		runs = append(runs, t)
+	}
+	return runs, nil
+}
+
+// Run starts the tick loop. Blocks until context is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Tick(ctx); err != nil {
+				slog.WarnContext(ctx, "scheduler: tick failed", "error", err)
+			}
+		}
+	}
+}
+
+// Tick fires every due job once. Exported so callers and tests can drive a
+// tick without waiting for the ticker. A failing action is recorded on the
+// job and logged; it does not stop the remaining jobs from firing.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	s.mu.RLock()
+	dispatcher := s.dispatcher
+	s.mu.RUnlock()
+	if dispatcher == nil {
+		return nil
+	}
+
+	now := s.config.Now()
+	due, err := s.store.Due(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, job := range due {
+		s.fire(ctx, dispatcher, job, now)
+	}
+	return nil
+}
+
+func (s *Scheduler) fire(ctx context.Context, dispatcher Dispatcher, job Job, now time.Time) {
+	sched, err := ParseSchedule(job.Schedule)
+	if err != nil {
+		// Validated on Register, so only a hand-edited row lands here.
+		slog.WarnContext(ctx, "scheduler: skipping job with invalid schedule",
+			"job", job.Name, "schedule", job.Schedule, "error", err)
+		return
+	}
+
+	claimed, err := s.store.Claim(ctx, job, now, s.nextRun(sched, job.Jitter, now))
+	if err != nil {
+		slog.WarnContext(ctx, "scheduler: failed to claim job", "job", job.Name, "error", err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	var errText string
+	if err := dispatcher.Dispatch(ctx, job); err != nil {
+		errText = err.Error()
+		slog.WarnContext(ctx, "scheduler: job failed", "job", job.Name, "action", string(job.Action), "error", err)
+	} else {
+		slog.DebugContext(ctx, "scheduler: job fired", "job", job.Name, "action", string(job.Action))
+	}
+	if err := s.store.RecordResult(ctx, job, errText); err != nil {
+		slog.WarnContext(ctx, "scheduler: failed to record job result", "job", job.Name, "error", err)
+	}
+}
+
+// nextRun returns sched's next time after now plus a random delay below
+// jitter.
+func (s *Scheduler) nextRun(sched Schedule, jitter time.Duration, now time.Time) time.Time {
+	next := sched.Next(now)
+	if jitter > 0 {
+		next = next.Add(s.config.Jitter(jitter))
+	}
+	return next
+}
+
+// cryptoJitter returns a uniformly random duration in [0, maxJitter).
+func cryptoJitter(maxJitter time.Duration) time.Duration {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(maxJitter)))
+	if err != nil {
+		return 0
+	}
+	return time.Duration(n.Int64())
+}
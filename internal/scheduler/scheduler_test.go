@@ -0,0 +1,387 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/scheduler"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memStore is an in-memory scheduler.Store.
+type memStore struct {
+	mu   sync.Mutex
+	jobs map[string]scheduler.Job
+	// stealClaims makes every Claim lose, as if another replica won.
+	stealClaims bool
+}
+
+func newMemStore() *memStore {
+	return &memStore{jobs: make(map[string]scheduler.Job)}
+}
+
+func (m *memStore) Create(_ context.Context, job scheduler.Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[job.Name]; ok {
+		return oops.Code("SCHEDULE_EXISTS").Errorf("exists")
+	}
+	m.jobs[job.Name] = job
+	return nil
+}
+
+func (m *memStore) Get(_ context.Context, name string) (scheduler.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[name]
+	if !ok {
+		return scheduler.Job{}, oops.Code("SCHEDULE_NOT_FOUND").Errorf("not found")
+	}
+	return job, nil
+}
+
+func (m *memStore) List(_ context.Context) ([]scheduler.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	jobs := make([]scheduler.Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+	return jobs, nil
+}
+
+func (m *memStore) SetEnabled(_ context.Context, name string, enabled bool, next time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[name]
+	if !ok {
+		return oops.Code("SCHEDULE_NOT_FOUND").Errorf("not found")
+	}
+	job.Enabled = enabled
+	job.NextRunAt = next
+	m.jobs[name] = job
+	return nil
+}
+
+func (m *memStore) Delete(_ context.Context, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[name]; !ok {
+		return oops.Code("SCHEDULE_NOT_FOUND").Errorf("not found")
+	}
+	delete(m.jobs, name)
+	return nil
+}
+
+func (m *memStore) Due(_ context.Context, now time.Time) ([]scheduler.Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var due []scheduler.Job
+	for _, job := range m.jobs {
+		if job.Enabled && !job.NextRunAt.After(now) {
+			due = append(due, job)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].Name < due[j].Name })
+	return due, nil
+}
+
+func (m *memStore) Claim(_ context.Context, job scheduler.Job, ranAt, next time.Time) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cur, ok := m.jobs[job.Name]
+	if m.stealClaims || !ok || !cur.NextRunAt.Equal(job.NextRunAt) {
+		return false, nil
+	}
+	cur.NextRunAt = next
+	cur.LastRunAt = &ranAt
+	m.jobs[job.Name] = cur
+	return true, nil
+}
+
+func (m *memStore) RecordResult(_ context.Context, job scheduler.Job, errText string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	cur := m.jobs[job.Name]
+	cur.LastError = errText
+	m.jobs[job.Name] = cur
+	return nil
+}
+
+// recordingDispatcher records dispatched job names and returns err for
+// jobs named in fail.
+type recordingDispatcher struct {
+	mu    sync.Mutex
+	fired []string
+	fail  map[string]error
+}
+
+func (d *recordingDispatcher) Dispatch(_ context.Context, job scheduler.Job) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.fired = append(d.fired, job.Name)
+	return d.fail[job.Name]
+}
+
+// testClock is a settable clock.
+type testClock struct{ now time.Time }
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func newTestScheduler(store scheduler.Store, clock *testClock) *scheduler.Scheduler {
+	return scheduler.NewScheduler(scheduler.Config{
+		Now:    clock.Now,
+		Jitter: func(max time.Duration) time.Duration { return max / 2 },
+	}, store)
+}
+
+func weatherSpec() scheduler.JobSpec {
+	return scheduler.JobSpec{
+		Name:      "weather",
+		Schedule:  "0 * * * *",
+		Action:    scheduler.ActionEmit,
+		Subject:   "system",
+		Message:   "A light rain begins to fall.",
+		CreatedBy: "staff",
+	}
+}
+
+func TestNewSchedulerPanicsOnNilStore(t *testing.T) {
+	assert.Panics(t, func() { scheduler.NewScheduler(scheduler.Config{}, nil) })
+}
+
+func TestRegisterPersistsEnabledJobWithNextRun(t *testing.T) {
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	store := newMemStore()
+	s := newTestScheduler(store, clock)
+
+	job, err := s.Register(context.Background(), weatherSpec())
+	require.NoError(t, err)
+
+	assert.True(t, job.Enabled)
+	assert.Equal(t, utc(2026, time.October, 17, 11, 0), job.NextRunAt)
+	stored, err := s.Get(context.Background(), "weather")
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, stored.ID)
+}
+
+func TestRegisterAppliesJitterToNextRun(t *testing.T) {
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	s := newTestScheduler(newMemStore(), clock)
+
+	spec := weatherSpec()
+	spec.Jitter = 10 * time.Minute
+	job, err := s.Register(context.Background(), spec)
+	require.NoError(t, err)
+
+	// The test jitter source returns half the bound.
+	assert.Equal(t, utc(2026, time.October, 17, 11, 5), job.NextRunAt)
+}
+
+func TestRegisterRejectsInvalidSpecs(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*scheduler.JobSpec)
+	}{
+		{"bad name", func(s *scheduler.JobSpec) { s.Name = "Weather Report" }},
+		{"bad schedule", func(s *scheduler.JobSpec) { s.Schedule = "every hour" }},
+		{"unknown action", func(s *scheduler.JobSpec) { s.Action = "shout" }},
+		{"emit without subject", func(s *scheduler.JobSpec) { s.Subject = "" }},
+		{"emit without message", func(s *scheduler.JobSpec) { s.Message = "" }},
+		{"plugin without plugin", func(s *scheduler.JobSpec) { s.Action = scheduler.ActionPlugin }},
+		{"negative jitter", func(s *scheduler.JobSpec) { s.Jitter = -time.Second }},
+		{"jitter over max", func(s *scheduler.JobSpec) { s.Jitter = scheduler.MaxJitter + time.Second }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestScheduler(newMemStore(), &testClock{now: time.Now()})
+			spec := weatherSpec()
+			tt.mutate(&spec)
+
+			_, err := s.Register(context.Background(), spec)
+			require.Error(t, err)
+			errutil.AssertErrorCode(t, err, "SCHEDULE_INVALID")
+		})
+	}
+}
+
+func TestRegisterDuplicateNameReturnsExists(t *testing.T) {
+	s := newTestScheduler(newMemStore(), &testClock{now: time.Now()})
+	_, err := s.Register(context.Background(), weatherSpec())
+	require.NoError(t, err)
+
+	_, err = s.Register(context.Background(), weatherSpec())
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_EXISTS")
+}
+
+func TestTickFiresDueJobsAndAdvancesNextRun(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	store := newMemStore()
+	s := newTestScheduler(store, clock)
+	d := &recordingDispatcher{}
+	s.SetDispatcher(d)
+
+	_, err := s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	// Not yet due.
+	require.NoError(t, s.Tick(ctx))
+	assert.Empty(t, d.fired)
+
+	clock.now = utc(2026, time.October, 17, 11, 0)
+	require.NoError(t, s.Tick(ctx))
+	assert.Equal(t, []string{"weather"}, d.fired)
+
+	job, err := s.Get(ctx, "weather")
+	require.NoError(t, err)
+	assert.Equal(t, utc(2026, time.October, 17, 12, 0), job.NextRunAt)
+	require.NotNil(t, job.LastRunAt)
+	assert.Equal(t, clock.now, *job.LastRunAt)
+
+	// Already advanced: a second tick at the same instant does not refire.
+	require.NoError(t, s.Tick(ctx))
+	assert.Len(t, d.fired, 1)
+}
+
+func TestTickDoesNotFireLostClaims(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	store := newMemStore()
+	s := newTestScheduler(store, clock)
+	d := &recordingDispatcher{}
+	s.SetDispatcher(d)
+	_, err := s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	store.stealClaims = true
+	clock.now = utc(2026, time.October, 17, 11, 0)
+	require.NoError(t, s.Tick(ctx))
+	assert.Empty(t, d.fired)
+}
+
+func TestTickRecordsDispatchFailureAndContinues(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	s := newTestScheduler(newMemStore(), clock)
+	d := &recordingDispatcher{fail: map[string]error{"restock": errors.New("plugin not loaded")}}
+	s.SetDispatcher(d)
+
+	_, err := s.Register(ctx, scheduler.JobSpec{
+		Name: "restock", Schedule: "@hourly", Action: scheduler.ActionPlugin, Plugin: "shops",
+	})
+	require.NoError(t, err)
+	_, err = s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	clock.now = utc(2026, time.October, 17, 11, 0)
+	require.NoError(t, s.Tick(ctx))
+	assert.ElementsMatch(t, []string{"restock", "weather"}, d.fired)
+
+	restock, err := s.Get(ctx, "restock")
+	require.NoError(t, err)
+	assert.Equal(t, "plugin not loaded", restock.LastError)
+	weather, err := s.Get(ctx, "weather")
+	require.NoError(t, err)
+	assert.Empty(t, weather.LastError)
+}
+
+func TestTickWithoutDispatcherLeavesJobsDue(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	s := newTestScheduler(newMemStore(), clock)
+	job, err := s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	clock.now = utc(2026, time.October, 17, 11, 0)
+	require.NoError(t, s.Tick(ctx))
+
+	after, err := s.Get(ctx, "weather")
+	require.NoError(t, err)
+	assert.Equal(t, job.NextRunAt, after.NextRunAt)
+}
+
+func TestDisabledJobsDoNotFireAndReenableSkipsBacklog(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	s := newTestScheduler(newMemStore(), clock)
+	d := &recordingDispatcher{}
+	s.SetDispatcher(d)
+	_, err := s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	require.NoError(t, s.Disable(ctx, "weather"))
+	clock.now = utc(2026, time.October, 17, 15, 30)
+	require.NoError(t, s.Tick(ctx))
+	assert.Empty(t, d.fired)
+
+	require.NoError(t, s.Enable(ctx, "weather"))
+	require.NoError(t, s.Tick(ctx))
+	assert.Empty(t, d.fired, "re-enabling MUST NOT fire missed occurrences")
+
+	job, err := s.Get(ctx, "weather")
+	require.NoError(t, err)
+	assert.True(t, job.Enabled)
+	assert.Equal(t, utc(2026, time.October, 17, 16, 0), job.NextRunAt)
+}
+
+func TestEnableUnknownJobReturnsNotFound(t *testing.T) {
+	s := newTestScheduler(newMemStore(), &testClock{now: time.Now()})
+	err := s.Enable(context.Background(), "missing")
+	require.Error(t, err)
+	errutil.AssertErrorCode(t, err, "SCHEDULE_NOT_FOUND")
+}
+
+func TestRemoveDeletesJob(t *testing.T) {
+	ctx := context.Background()
+	s := newTestScheduler(newMemStore(), &testClock{now: time.Now()})
+	_, err := s.Register(ctx, weatherSpec())
+	require.NoError(t, err)
+
+	require.NoError(t, s.Remove(ctx, "weather"))
+	jobs, err := s.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	err = s.Remove(ctx, "weather")
+	errutil.AssertErrorCode(t, err, "SCHEDULE_NOT_FOUND")
+}
+
+func TestNextRunsListsUpcomingOccurrences(t *testing.T) {
+	ctx := context.Background()
+	clock := &testClock{now: utc(2026, time.October, 17, 10, 7)}
+	s := newTestScheduler(newMemStore(), clock)
+	spec := weatherSpec()
+	spec.Jitter = 10 * time.Minute
+	_, err := s.Register(ctx, spec)
+	require.NoError(t, err)
+
+	runs, err := s.NextRuns(ctx, "weather", 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		utc(2026, time.October, 17, 11, 5),
+		utc(2026, time.October, 17, 12, 0),
+		utc(2026, time.October, 17, 13, 0),
+	}, runs)
+}
+
+func TestNextRunsRejectsOutOfRangeCount(t *testing.T) {
+	s := newTestScheduler(newMemStore(), &testClock{now: time.Now()})
+	for _, n := range []int{0, scheduler.MaxNextRuns + 1} {
+		_, err := s.NextRuns(context.Background(), "weather", n)
+		errutil.AssertErrorCode(t, err, "SCHEDULE_INVALID")
+	}
+}
@@ -1376,6 +1376,66 @@ func (_c *MockStore_RemoveConnectionAndCount_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// RotateReconnectToken provides a mock function with given fields: ctx, presentedHash, newHash
+func (_m *MockStore) RotateReconnectToken(ctx context.Context, presentedHash string, newHash string) (*session.Info, error) {
+	ret := _m.Called(ctx, presentedHash, newHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateReconnectToken")
+	}
+
+	var r0 *session.Info
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*session.Info, error)); ok {
+		return rf(ctx, presentedHash, newHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *session.Info); ok {
+		r0 = rf(ctx, presentedHash, newHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.Info)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, presentedHash, newHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStore_RotateReconnectToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateReconnectToken'
+type MockStore_RotateReconnectToken_Call struct {
+	*mock.Call
+}
+
+// RotateReconnectToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - presentedHash string
+//   - newHash string
+func (_e *MockStore_Expecter) RotateReconnectToken(ctx interface{}, presentedHash interface{}, newHash interface{}) *MockStore_RotateReconnectToken_Call {
+	return &MockStore_RotateReconnectToken_Call{Call: _e.mock.On("RotateReconnectToken", ctx, presentedHash, newHash)}
+}
+
+func (_c *MockStore_RotateReconnectToken_Call) Run(run func(ctx context.Context, presentedHash string, newHash string)) *MockStore_RotateReconnectToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_RotateReconnectToken_Call) Return(_a0 *session.Info, _a1 error) *MockStore_RotateReconnectToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStore_RotateReconnectToken_Call) RunAndReturn(run func(context.Context, string, string) (*session.Info, error)) *MockStore_RotateReconnectToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function with given fields: ctx, id, info
 func (_m *MockStore) Set(ctx context.Context, id string, info *session.Info) error {
 	ret := _m.Called(ctx, id, info)
@@ -1424,6 +1484,54 @@ func (_c *MockStore_Set_Call) RunAndReturn(run func(context.Context, string, *se
 	return _c
 }
 
+// SetReconnectTokenHash provides a mock function with given fields: ctx, id, tokenHash
+func (_m *MockStore) SetReconnectTokenHash(ctx context.Context, id string, tokenHash string) error {
+	ret := _m.Called(ctx, id, tokenHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReconnectTokenHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, tokenHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockStore_SetReconnectTokenHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReconnectTokenHash'
+type MockStore_SetReconnectTokenHash_Call struct {
+	*mock.Call
+}
+
+// SetReconnectTokenHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tokenHash string
+func (_e *MockStore_Expecter) SetReconnectTokenHash(ctx interface{}, id interface{}, tokenHash interface{}) *MockStore_SetReconnectTokenHash_Call {
+	return &MockStore_SetReconnectTokenHash_Call{Call: _e.mock.On("SetReconnectTokenHash", ctx, id, tokenHash)}
+}
+
+func (_c *MockStore_SetReconnectTokenHash_Call) Run(run func(ctx context.Context, id string, tokenHash string)) *MockStore_SetReconnectTokenHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockStore_SetReconnectTokenHash_Call) Return(_a0 error) *MockStore_SetReconnectTokenHash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStore_SetReconnectTokenHash_Call) RunAndReturn(run func(context.Context, string, string) error) *MockStore_SetReconnectTokenHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateActivity provides a mock function with given fields: ctx, id
 func (_m *MockStore) UpdateActivity(ctx context.Context, id string) error {
 	ret := _m.Called(ctx, id)
@@ -229,6 +229,10 @@ type Info struct {
 	UpdatedAt               time.Time
 	LastPaged               string
 	LastWhispered           string
+	// ErrorVerbosity is how much of a failed command's error this session
+	// is shown ("terse" or "verbose"; empty reads as terse). Read-only
+	// through Set; it changes only via SetErrorVerbosity on the store.
+	ErrorVerbosity string
 
 	// FocusMemberships is the set of focused contexts this session is
 	// actively participating in. Mutated only via FocusCoordinator
@@ -351,6 +355,18 @@ type Store interface {
 	// Returns true if the row was updated, false if another client won the race.
 	ReattachCAS(ctx context.Context, id string) (bool, error)
 
+	// SetReconnectTokenHash stores the hash of the session's reconnect token,
+	// replacing any token issued before. Returns SESSION_NOT_FOUND if no
+	// session matches.
+	SetReconnectTokenHash(ctx context.Context, id, tokenHash string) error
+
+	// RotateReconnectToken atomically replaces the reconnect token hashed
+	// presentedHash with newHash and returns the session holding it, so a
+	// token resumes at most once. Returns SESSION_NOT_FOUND when no live
+	// session holds presentedHash: never issued, already rotated, or the
+	// session ended or expired.
+	RotateReconnectToken(ctx context.Context, presentedHash, newHash string) (*Info, error)
+
 	// AppendCommand adds a command to the session's history, enforcing the cap.
 	AppendCommand(ctx context.Context, id string, command string, maxHistory int) error
 
@@ -4,6 +4,7 @@
 package store
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"fmt"
@@ -16,6 +17,8 @@ import (
 	// Register pgx/v5 database driver for golang-migrate.
 	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/samber/oops"
 )
 
@@ -136,6 +139,53 @@ func (m *Migrator) Version() (version uint, dirty bool, err error) {
 	return version, dirty, nil
 }
 
+// LatestVersion returns the highest migration version embedded in this
+// binary, which is the schema version it expects the database to be at.
+func (m *Migrator) LatestVersion() (uint, error) {
+	return LatestMigrationVersion()
+}
+
+// LatestMigrationVersion returns the highest migration version embedded in
+// this binary without opening a migrator.
+func LatestMigrationVersion() (uint, error) {
+	versions, err := allMigrationVersions()
+	if err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, oops.Code("MIGRATION_LIST_FAILED").Errorf("no migrations embedded")
+	}
+	return versions[len(versions)-1], nil
+}
+
+// pgUndefinedTable is the PostgreSQL error code for a missing relation;
+// schema_migrations does not exist until the first migration runs.
+const pgUndefinedTable = "42P01"
+
+// rowQuerier is the pgx single-row query method; *pgxpool.Pool satisfies it.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// SchemaVersion reads the applied migration version and dirty flag from
+// golang-migrate's schema_migrations table through an existing connection,
+// for callers such as health checks that must not open a migrator per call.
+// Returns version 0 with dirty=false when no migration has been applied.
+func SchemaVersion(ctx context.Context, db rowQuerier) (version uint, dirty bool, err error) {
+	var v int64
+	err = db.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&v, &dirty)
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.As(err, &pgErr) && pgErr.Code == pgUndefinedTable:
+		return 0, false, nil
+	case err != nil:
+		return 0, false, oops.Code("MIGRATION_VERSION_FAILED").Wrap(err)
+	case v < 0:
+		return 0, false, oops.Code("MIGRATION_VERSION_FAILED").Errorf("negative schema version %d", v)
+	}
+	return uint(v), dirty, nil
+}
+
 // Force sets the migration version without running migrations.
 // Use only for recovering from a dirty state after manually fixing the database.
 // Version must be non-negative; negative values are rejected with INVALID_VERSION error.
@@ -26,38 +26,72 @@ import (
 // NOTE: The `events` table was dropped by migration 000010 (F6 schema cutover);
 // it does NOT appear here.
 var expectedTables = []string{
+	"access_audit_chain_heads",
 	"access_audit_log",
 	"access_policies",
 	"access_policy_versions",
 	"admin_approvals",
+	"ambient_lines",
+	"ambient_schedules",
+	"bans",
 	"bootstrap_metadata",
+	"character_connections",
 	"character_roles",
 	"characters",
 	"content_items",
 	"crypto_bootstrap_state",
+	"crypto_encrypted_contexts",
 	"crypto_keys",
 	"crypto_rekey_checkpoints",
+	"economy_balances",
+	"economy_ledger_entries",
+	"economy_transactions",
 	"entity_properties",
 	"events_audit",
+	"events_audit_checkpoints",
 	"events_audit_unpartitioned",
 	"exits",
+	"held_posts",
+	"help_topic_aliases",
+	"help_topics",
 	"holomush_system_info",
+	"jobs",
+	"legacy_imports",
 	"locations",
+	"motd_announcement_acks",
+	"motd_announcements",
+	"motd_seen",
+	"npcs",
 	"objects",
 	"outbox",
+	"page_blocks",
+	"page_mailbox",
 	"password_resets",
 	"player_aliases",
 	"player_character_bindings",
+	"player_identities",
+	"player_impersonations",
+	"player_security_events",
 	"player_sessions",
 	"player_totp",
 	"player_totp_recovery_codes",
 	"players",
+	"plugin_dead_letters",
+	"plugin_kv",
 	"plugins",
+	"posting_rules",
+	"property_schemas",
+	"recovery_requests",
+	"report_notes",
+	"reports",
 	"scene_participants",
+	"scheduled_jobs",
 	"session_connections",
 	"sessions",
 	"setting_bootstrap_state",
 	"system_aliases",
+	"webhook_deliveries",
+	"webhook_endpoints",
 	"world_consumer_receipts",
 	"world_consumer_watermarks",
 	"world_feed_counter",
@@ -154,7 +188,7 @@ var _ = Describe("Migrator", func() {
 
 			version, dirty, err = migrator.Version()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(version).To(Equal(uint(52)))
+			Expect(version).To(Equal(uint(93)))
 			Expect(dirty).To(BeFalse())
 
 			tables = queryTableNames(suiteT, ctx, connStr)
@@ -177,7 +211,7 @@ var _ = Describe("Migrator", func() {
 
 			version, dirty, err = migrator.Version()
 			Expect(err).NotTo(HaveOccurred())
-			Expect(version).To(Equal(uint(52)))
+			Expect(version).To(Equal(uint(93)))
 			Expect(dirty).To(BeFalse())
 
 			tables = queryTableNames(suiteT, ctx, connStr)
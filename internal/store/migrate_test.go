@@ -4,12 +4,15 @@
 package store
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -293,21 +296,82 @@ func TestMigratorPendingMigrationsReturnsMigrationsAboveCurrentVersion(t *testin
 	// world_timestamps_to_bigint + totp_misc_timestamps_to_bigint + pregfo6_gap_timestamps_to_bigint +
 	// character_preferences + session_connection_last_seen + disable_unconditional_scene_write_seed
 	// + disable_unconditional_scene_read_seed + world_version_guard + world_outbox
-	// + player_reaping + events_audit_partition)
+	// + player_reaping + events_audit_partition + scheduled_jobs
+	// + player_security_events + bans + player_identities + object_locks
+	// + character_connections + help_topics + character_visibility + motd
+	// + player_session_refresh_tokens + economy + location_zones
+	// + object_verbs + session_reconnect_tokens + character_role_grants
+	// + description_layers + jobs + exit_traversal + paging + entity_tags
+	// + access_audit_chain + property_schemas + recovery_requests + legacy_imports)
 	m := &Migrator{m: &mockMigrate{versionVal: 0, versionErr: migrate.ErrNilVersion}}
 	pending, err := m.PendingMigrations()
 	require.NoError(t, err)
-	assert.Equal(t, []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52}, pending)
+	assert.Equal(t, []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92}, pending)
 }
 
 func TestMigratorPendingMigrationsReturnsEmptyAtLatestVersion(t *testing.T) {
-	// At version 52 (latest), no migrations should be pending
-	m := &Migrator{m: &mockMigrate{versionVal: 52}}
+	// At version 92 (latest), no migrations should be pending
+	m := &Migrator{m: &mockMigrate{versionVal: 92}}
 	pending, err := m.PendingMigrations()
 	require.NoError(t, err)
 	assert.Empty(t, pending)
 }
 
+func TestMigratorLatestVersionReturnsHighestEmbeddedVersion(t *testing.T) {
+	m := &Migrator{m: &mockMigrate{}}
+	latest, err := m.LatestVersion()
+	require.NoError(t, err)
+
+	versions, err := allMigrationVersions()
+	require.NoError(t, err)
+	assert.Equal(t, versions[len(versions)-1], latest)
+
+	// At the latest version nothing is pending.
+	m = &Migrator{m: &mockMigrate{versionVal: latest}}
+	pending, err := m.PendingMigrations()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+// fakeSchemaRow answers SchemaVersion's schema_migrations query.
+type fakeSchemaRow struct {
+	version int64
+	dirty   bool
+	err     error
+}
+
+func (r fakeSchemaRow) QueryRow(context.Context, string, ...any) pgx.Row { return r }
+
+func (r fakeSchemaRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	*dest[0].(*int64) = r.version
+	*dest[1].(*bool) = r.dirty
+	return nil
+}
+
+func TestSchemaVersionReadsSchemaMigrations(t *testing.T) {
+	version, dirty, err := SchemaVersion(context.Background(), fakeSchemaRow{version: 42, dirty: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint(42), version)
+	assert.True(t, dirty)
+}
+
+func TestSchemaVersionTreatsUnmigratedDatabaseAsZero(t *testing.T) {
+	for _, rowErr := range []error{pgx.ErrNoRows, &pgconn.PgError{Code: pgUndefinedTable}} {
+		version, dirty, err := SchemaVersion(context.Background(), fakeSchemaRow{err: rowErr})
+		require.NoError(t, err)
+		assert.Zero(t, version)
+		assert.False(t, dirty)
+	}
+}
+
+func TestSchemaVersionReturnsWrappedErrorOnFailure(t *testing.T) {
+	_, _, err := SchemaVersion(context.Background(), fakeSchemaRow{err: errors.New("connection refused")})
+	errutil.AssertErrorCode(t, err, "MIGRATION_VERSION_FAILED")
+}
+
 func TestMigratorPendingMigrationsReturnsErrorWhenVersionFails(t *testing.T) {
 	m := &Migrator{m: &mockMigrate{versionErr: errors.New("connection lost")}}
 	_, err := m.PendingMigrations()
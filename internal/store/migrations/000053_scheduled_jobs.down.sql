@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert the scheduled world events table (000053). DROP ... IF EXISTS keeps
+-- the down idempotent.
+DROP TABLE IF EXISTS scheduled_jobs;
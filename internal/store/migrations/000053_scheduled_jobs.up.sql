@@ -0,0 +1,34 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Staff-registered recurring world events (internal/scheduler). Each row is a
+-- cron schedule plus one action: publish a system message on a subject
+-- ("emit") or deliver a scheduled event to a loaded plugin ("plugin").
+--
+-- next_run_at is the jittered epoch-ns of the next firing. A replica claims a
+-- due job with a compare-and-set UPDATE on next_run_at, so exactly one
+-- replica fires each occurrence without a lease table. All timestamps are
+-- BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    id              BYTEA   PRIMARY KEY,
+    name            TEXT    NOT NULL,
+    schedule        TEXT    NOT NULL,
+    action          TEXT    NOT NULL CHECK (action IN ('emit', 'plugin')),
+    subject         TEXT    NOT NULL DEFAULT '',
+    plugin          TEXT    NOT NULL DEFAULT '',
+    message         TEXT    NOT NULL DEFAULT '',
+    jitter_ns       BIGINT  NOT NULL DEFAULT 0 CHECK (jitter_ns >= 0),
+    enabled         BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at     BIGINT  NOT NULL,
+    last_run_at     BIGINT,
+    last_error      TEXT    NOT NULL DEFAULT '',
+    created_by      TEXT    NOT NULL DEFAULT '',
+    created_at      BIGINT  NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS scheduled_jobs_name ON scheduled_jobs(name);
+
+-- The scheduler tick scans enabled jobs whose next_run_at has passed.
+CREATE INDEX IF NOT EXISTS scheduled_jobs_due
+    ON scheduled_jobs(next_run_at)
+    WHERE enabled;
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert the player security event log (000054). DROP ... IF EXISTS keeps the
+-- down idempotent.
+DROP TABLE IF EXISTS player_security_events;
@@ -0,0 +1,30 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Account-level security event log (auth.SecurityEventRepository). One row
+-- per login success/failure, password change, session termination, or 2FA
+-- change, so players and admins can review account activity and the alert
+-- rules can compare a new login against the player's history.
+--
+-- Rows cascade with the owning player. created_at is BIGINT epoch-ns
+-- (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS player_security_events (
+    id          TEXT   PRIMARY KEY,
+    player_id   TEXT   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    event_type  TEXT   NOT NULL CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled'
+    )),
+    ip_address  TEXT   NOT NULL DEFAULT '',
+    user_agent  TEXT   NOT NULL DEFAULT '',
+    detail      TEXT   NOT NULL DEFAULT '',
+    created_at  BIGINT NOT NULL
+);
+
+-- ListByPlayer pages newest-first per player.
+CREATE INDEX IF NOT EXISTS player_security_events_player_created
+    ON player_security_events(player_id, created_at DESC);
+
+-- Alert rules count a player's events by type, optionally per IP.
+CREATE INDEX IF NOT EXISTS player_security_events_player_type_ip
+    ON player_security_events(player_id, event_type, ip_address);
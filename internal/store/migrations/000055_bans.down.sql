@@ -0,0 +1,5 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert the ban list (000055). DROP ... IF EXISTS keeps the down idempotent.
+DROP TABLE IF EXISTS bans;
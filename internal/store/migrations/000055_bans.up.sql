@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Ban list (internal/bans). Each row bans connections or logins matching one
+-- pattern: an exact IP, a CIDR range, a hostname glob, or a player username
+-- glob. Patterns are stored normalized (lowercased, CIDR masked) so equality
+-- lookups and the duplicate guard below are exact.
+--
+-- expires_at is NULL for permanent bans. created_at and expires_at are BIGINT
+-- epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS bans (
+    id          TEXT   PRIMARY KEY,
+    target      TEXT   NOT NULL CHECK (target IN ('ip', 'cidr', 'hostname', 'player')),
+    pattern     TEXT   NOT NULL,
+    reason      TEXT   NOT NULL DEFAULT '',
+    issued_by   TEXT   NOT NULL DEFAULT '',
+    created_at  BIGINT NOT NULL,
+    expires_at  BIGINT,
+    UNIQUE (target, pattern)
+);
+
+-- Enforcement lists unexpired bans on every check.
+CREATE INDEX IF NOT EXISTS bans_expires_at ON bans(expires_at);
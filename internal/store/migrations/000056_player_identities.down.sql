@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert linked OIDC identities (000056). DROP ... IF EXISTS keeps the down
+-- idempotent.
+DROP TABLE IF EXISTS player_identities;
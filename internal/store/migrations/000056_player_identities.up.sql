@@ -0,0 +1,18 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- External identity provider accounts linked to players for OIDC sign-in
+-- (internal/auth). A provider account (provider, subject) links to at most
+-- one player, and a player links at most one account per provider. subject
+-- is the provider's opaque sub claim; email is informational only.
+--
+-- linked_at is BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS player_identities (
+    provider   TEXT   NOT NULL,
+    subject    TEXT   NOT NULL,
+    player_id  TEXT   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    email      TEXT   NOT NULL DEFAULT '',
+    linked_at  BIGINT NOT NULL,
+    PRIMARY KEY (provider, subject),
+    UNIQUE (player_id, provider)
+);
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000057_object_locks.up.sql.
+
+ALTER TABLE objects DROP COLUMN IF EXISTS locked;
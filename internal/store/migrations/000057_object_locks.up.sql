@@ -0,0 +1,12 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Object locks (world.Service.LockObject / UnlockObject). A locked object can
+-- only be written, moved, or deleted by its owner or an admin; the check is
+-- the seed:object-locked-owner-only forbid policy reading resource.object.locked.
+-- Ownership itself already lives in objects.owner_id (000001_baseline).
+--
+-- DEFAULT false backfills every existing row as unlocked; ADD COLUMN IF NOT
+-- EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE objects ADD COLUMN IF NOT EXISTS locked BOOLEAN NOT NULL DEFAULT false;
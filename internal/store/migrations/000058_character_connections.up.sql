@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Character connection history (internal/connhistory). One row per client
+-- connection a character's session attached, keyed by the connection ULID
+-- the gateway supplies on Subscribe. disconnected_at is NULL while the
+-- connection is open, and stays NULL for a connection whose close was never
+-- recorded (server crash); readers treat those as ended at last report.
+--
+-- connected_at and disconnected_at are BIGINT epoch-ns (INV-STORE-1 /
+-- lint:no-timestamptz). Rows go with their character.
+CREATE TABLE IF NOT EXISTS character_connections (
+    id               TEXT   PRIMARY KEY,
+    character_id     TEXT   NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    session_id       TEXT   NOT NULL,
+    client_type      TEXT   NOT NULL,
+    connected_at     BIGINT NOT NULL,
+    disconnected_at  BIGINT
+);
+
+-- Last-seen and history reads are per character, newest first.
+CREATE INDEX IF NOT EXISTS character_connections_character_connected
+    ON character_connections(character_id, connected_at DESC);
@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000059_help_topics.up.sql.
+
+DROP TABLE IF EXISTS help_topic_aliases;
+DROP TABLE IF EXISTS help_topics;
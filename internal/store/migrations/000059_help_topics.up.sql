@@ -0,0 +1,37 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- In-game help topics (internal/help). Staff edit topics through the
+-- helpedit command; players read them with `help <topic>`. name is the
+-- canonical lower-case lookup key; aliases resolve to a topic through
+-- help_topic_aliases, whose primary key keeps an alias pointing at exactly
+-- one topic.
+--
+-- search_vector weights the name and title above the body so `help search`
+-- ranks a topic titled for the term ahead of one that merely mentions it.
+-- created_at and updated_at are BIGINT epoch-ns (INV-STORE-1 /
+-- lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS help_topics (
+    id             TEXT   PRIMARY KEY,
+    name           TEXT   NOT NULL UNIQUE,
+    title          TEXT   NOT NULL,
+    category       TEXT   NOT NULL,
+    body           TEXT   NOT NULL,
+    updated_by     TEXT   NOT NULL,
+    created_at     BIGINT NOT NULL,
+    updated_at     BIGINT NOT NULL,
+    search_vector  TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', name || ' ' || title), 'A') ||
+        setweight(to_tsvector('english', body), 'B')
+    ) STORED
+);
+
+CREATE INDEX IF NOT EXISTS help_topics_category_name ON help_topics(category, name);
+CREATE INDEX IF NOT EXISTS help_topics_search ON help_topics USING GIN (search_vector);
+
+CREATE TABLE IF NOT EXISTS help_topic_aliases (
+    alias     TEXT PRIMARY KEY,
+    topic_id  TEXT NOT NULL REFERENCES help_topics(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS help_topic_aliases_topic ON help_topic_aliases(topic_id);
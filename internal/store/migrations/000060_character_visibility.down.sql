@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000060_character_visibility.up.sql.
+
+ALTER TABLE characters DROP CONSTRAINT IF EXISTS characters_visibility_check;
+ALTER TABLE characters DROP COLUMN IF EXISTS visibility;
@@ -0,0 +1,16 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Character visibility (world.Service.SetCharacterVisibility). A dark or
+-- invisible character is left out of room contents, presence, and
+-- arrive/leave broadcasts for observers without the matching see_dark or
+-- see_invisible permission.
+--
+-- DEFAULT 'visible' backfills every existing row; ADD COLUMN IF NOT EXISTS
+-- keeps the migration safe to re-run.
+
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS visibility TEXT NOT NULL DEFAULT 'visible';
+
+ALTER TABLE characters DROP CONSTRAINT IF EXISTS characters_visibility_check;
+ALTER TABLE characters ADD CONSTRAINT characters_visibility_check
+    CHECK (visibility IN ('visible', 'dark', 'invisible'));
@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000061_motd.up.sql. Screens and the message of the day stay in
+-- content_items.
+
+DROP TABLE IF EXISTS motd_announcements;
+DROP TABLE IF EXISTS motd_seen;
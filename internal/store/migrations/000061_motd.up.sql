@@ -0,0 +1,34 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Login text (internal/motd). The connect screens and the message of the
+-- day live in content_items under the "motd." prefix; these tables hold
+-- what the content store cannot.
+--
+-- motd_seen records when each player last saw the message of the day, so
+-- the login notice can flag a message that changed since. Rows go with
+-- their player.
+--
+-- motd_announcements holds scheduled announcements. announced_at stays NULL
+-- until the start broadcast goes out; replicas claim a due announcement by
+-- setting it, so each is broadcast once cluster-wide.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS motd_seen (
+    player_id  TEXT   PRIMARY KEY REFERENCES players(id) ON DELETE CASCADE,
+    seen_at    BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS motd_announcements (
+    id            TEXT   PRIMARY KEY,
+    body          TEXT   NOT NULL,
+    starts_at     BIGINT NOT NULL,
+    ends_at       BIGINT NOT NULL,
+    created_by    TEXT   NOT NULL,
+    created_at    BIGINT NOT NULL,
+    announced_at  BIGINT,
+    CONSTRAINT motd_announcements_window_check CHECK (ends_at > starts_at)
+);
+
+-- Listing and claiming both scan by end time.
+CREATE INDEX IF NOT EXISTS motd_announcements_ends_at ON motd_announcements(ends_at);
@@ -0,0 +1,14 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000062_player_session_refresh_tokens.up.sql. Sessions keep their
+-- access tokens; refresh tokens and device labels are dropped.
+
+DROP INDEX IF EXISTS idx_player_sessions_previous_refresh_hash;
+DROP INDEX IF EXISTS idx_player_sessions_refresh_hash;
+
+ALTER TABLE player_sessions
+    DROP COLUMN IF EXISTS device_label,
+    DROP COLUMN IF EXISTS access_expires_at,
+    DROP COLUMN IF EXISTS previous_refresh_hash,
+    DROP COLUMN IF EXISTS refresh_token_hash;
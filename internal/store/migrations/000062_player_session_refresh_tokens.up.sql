@@ -0,0 +1,25 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Refresh tokens for web sessions (internal/auth). A session started with a
+-- refresh token holds a short-lived access token (token_hash, bounded by
+-- access_expires_at) and a refresh token; each refresh rotates both.
+--
+-- previous_refresh_hash is the refresh token the last rotation retired.
+-- Presenting it again is reuse, and the session is revoked. Sessions without
+-- a refresh token (telnet, guests) keep empty hashes and a NULL
+-- access_expires_at, so their access token lives as long as the session.
+--
+-- device_label is the player's name for the device holding the session.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+ALTER TABLE player_sessions
+    ADD COLUMN IF NOT EXISTS refresh_token_hash    TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS previous_refresh_hash TEXT   NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS access_expires_at     BIGINT,
+    ADD COLUMN IF NOT EXISTS device_label          TEXT   NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_sessions_refresh_hash
+    ON player_sessions (refresh_token_hash) WHERE refresh_token_hash <> '';
+CREATE INDEX IF NOT EXISTS idx_player_sessions_previous_refresh_hash
+    ON player_sessions (previous_refresh_hash) WHERE previous_refresh_hash <> '';
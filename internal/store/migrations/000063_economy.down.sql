@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000063_economy.up.sql. Balances and the ledger are lost.
+
+DROP TABLE IF EXISTS economy_ledger_entries;
+DROP TABLE IF EXISTS economy_transactions;
+DROP TABLE IF EXISTS economy_balances;
@@ -0,0 +1,50 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Character economy (internal/economy).
+--
+-- economy_balances holds each character's balance per currency. The CHECK
+-- keeps balances non-negative; debits are guarded in the same statement so
+-- concurrent spends cannot overdraw. Rows go with their character.
+--
+-- economy_transactions records every transfer, mint, and burn, and
+-- economy_ledger_entries its two double-entry sides, which sum to zero. An
+-- account is "character:<id>" or "treasury"; balance_after is the
+-- character's balance once the transaction applied and is NULL for the
+-- treasury, which has no balance row. Ledger rows name characters by
+-- account rather than by foreign key, so history outlives the character.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS economy_balances (
+    character_id  TEXT   NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    currency      TEXT   NOT NULL,
+    amount        BIGINT NOT NULL,
+    updated_at    BIGINT NOT NULL,
+    PRIMARY KEY (character_id, currency),
+    CONSTRAINT economy_balances_amount_check CHECK (amount >= 0)
+);
+
+CREATE TABLE IF NOT EXISTS economy_transactions (
+    id            TEXT   PRIMARY KEY,
+    kind          TEXT   NOT NULL,
+    currency      TEXT   NOT NULL,
+    amount        BIGINT NOT NULL,
+    memo          TEXT   NOT NULL DEFAULT '',
+    initiated_by  TEXT   NOT NULL,
+    created_at    BIGINT NOT NULL,
+    CONSTRAINT economy_transactions_kind_check CHECK (kind IN ('transfer', 'mint', 'burn')),
+    CONSTRAINT economy_transactions_amount_check CHECK (amount > 0)
+);
+
+CREATE TABLE IF NOT EXISTS economy_ledger_entries (
+    transaction_id  TEXT   NOT NULL REFERENCES economy_transactions(id) ON DELETE CASCADE,
+    account         TEXT   NOT NULL,
+    currency        TEXT   NOT NULL,
+    delta           BIGINT NOT NULL,
+    balance_after   BIGINT,
+    PRIMARY KEY (transaction_id, account),
+    CONSTRAINT economy_ledger_entries_delta_check CHECK (delta <> 0)
+);
+
+-- History scans one account's entries.
+CREATE INDEX IF NOT EXISTS economy_ledger_entries_account ON economy_ledger_entries(account, transaction_id);
@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000064_location_zones.up.sql.
+
+DROP INDEX IF EXISTS idx_locations_zone_id;
+ALTER TABLE locations DROP COLUMN IF EXISTS zone_id;
@@ -0,0 +1,13 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Location zones (world.Service.BroadcastToZone). A zone is a named group of
+-- locations, e.g. a district or a stretch of coast, that weather, ambience,
+-- and announcements reach in one broadcast. A location belongs to at most one
+-- zone; NULL means none.
+--
+-- ADD COLUMN IF NOT EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS zone_id TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_locations_zone_id ON locations (zone_id) WHERE zone_id IS NOT NULL;
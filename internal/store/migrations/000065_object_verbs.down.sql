@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000065_object_verbs.up.sql.
+
+ALTER TABLE objects DROP COLUMN IF EXISTS verbs;
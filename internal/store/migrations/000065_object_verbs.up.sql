@@ -0,0 +1,13 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Object verbs (world.Service.DefineObjectVerb / RemoveObjectVerb /
+-- TriggerObjectVerb). Each object carries a JSON array of
+-- {"name", "plugin", "description"} entries; triggering a verb hands a
+-- verb:<name> event to the named plugin. Who may define or trigger verbs is
+-- decided by the define_verb and trigger_verb policies, not stored here.
+--
+-- DEFAULT '[]' backfills every existing row with no verbs; ADD COLUMN IF NOT
+-- EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE objects ADD COLUMN IF NOT EXISTS verbs JSONB NOT NULL DEFAULT '[]'::jsonb;
@@ -0,0 +1,9 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000066_session_reconnect_tokens.up.sql. Issued reconnect tokens stop
+-- resuming; clients fall back to logging in.
+
+DROP INDEX IF EXISTS idx_sessions_reconnect_token_hash;
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS reconnect_token_hash;
@@ -0,0 +1,16 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Reconnect tokens for game sessions (CoreServer.ResumeSession). A client
+-- that asked SelectCharacter for a reconnect token can present it after its
+-- connection drops to resume the session, lingering detached for its TTL,
+-- without logging in again.
+--
+-- Only the SHA-256 hash is stored. Each resume rotates it, so a token resumes
+-- at most once; an empty hash means no token was issued. The row, and with it
+-- the token, goes away when the session ends.
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS reconnect_token_hash TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sessions_reconnect_token_hash
+    ON sessions (reconnect_token_hash) WHERE reconnect_token_hash <> '';
@@ -0,0 +1,11 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000067_character_role_grants.up.sql. Role assignments are kept;
+-- only the record of who granted them is lost.
+
+DROP INDEX IF EXISTS idx_character_roles_role;
+
+ALTER TABLE character_roles
+    DROP COLUMN IF EXISTS granted_at,
+    DROP COLUMN IF EXISTS granted_by;
@@ -0,0 +1,15 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Record who granted each character role and when (roles.Service). Grants
+-- and revocations are also written to the audit log; these columns let the
+-- role command show where a current assignment came from.
+--
+-- granted_at is Unix nanoseconds, NULL for assignments made before grants
+-- were recorded. granted_by is the granting character's name, empty for the
+-- same rows and for roles assigned by bootstrap.
+ALTER TABLE character_roles
+    ADD COLUMN IF NOT EXISTS granted_by TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS granted_at BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_character_roles_role ON character_roles (role);
@@ -0,0 +1,10 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000068_description_layers.up.sql.
+
+ALTER TABLE characters DROP COLUMN IF EXISTS description_effects;
+ALTER TABLE objects DROP CONSTRAINT IF EXISTS objects_worn_held;
+ALTER TABLE objects
+    DROP COLUMN IF EXISTS worn_at,
+    DROP COLUMN IF EXISTS wear_description;
@@ -0,0 +1,26 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Layered character descriptions (world.Service.DescribeCharacter). A
+-- character's appearance is its base description, then the wear descriptions
+-- of the objects it is wearing, then its timed description effects.
+--
+-- objects.wear_description is the text an object adds while worn.
+-- objects.worn_at is when the holder put it on (Unix nanoseconds), NULL when
+-- it is not worn; it orders the worn layers and is cleared whenever the
+-- object leaves its holder's hands. The CHECK keeps a worn object held.
+--
+-- characters.description_effects is a JSON array of
+-- {"name", "text", "private", "applied_by", "applied_at", "expires_at"}
+-- entries. Expired entries are skipped when descriptions are assembled and
+-- pruned on the next effect write.
+ALTER TABLE objects
+    ADD COLUMN IF NOT EXISTS wear_description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS worn_at BIGINT;
+
+ALTER TABLE objects DROP CONSTRAINT IF EXISTS objects_worn_held;
+ALTER TABLE objects
+    ADD CONSTRAINT objects_worn_held CHECK (worn_at IS NULL OR held_by_character_id IS NOT NULL);
+
+ALTER TABLE characters
+    ADD COLUMN IF NOT EXISTS description_effects JSONB NOT NULL DEFAULT '[]'::jsonb;
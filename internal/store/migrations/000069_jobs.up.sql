@@ -0,0 +1,43 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Background jobs for long-running admin operations (internal/jobs). Each
+-- row is one submitted run of a registered job kind with its JSON params,
+-- progress and outcome.
+--
+-- Workers on every replica claim the oldest queued job with
+-- FOR UPDATE SKIP LOCKED. A running job's worker stamps heartbeat_at (with
+-- its latest progress) periodically; a job whose heartbeat has gone stale
+-- belongs to a replica that died and is claimed again until attempts
+-- reaches the queue's limit. attempts doubles as the fencing token, so a
+-- worker that lost its claim cannot overwrite the new owner's progress or
+-- outcome. cancel_requested asks the running worker to stop at its next
+-- heartbeat. All timestamps are BIGINT epoch-ns (INV-STORE-1 /
+-- lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS jobs (
+    id               BYTEA   PRIMARY KEY,
+    kind             TEXT    NOT NULL,
+    params           JSONB   NOT NULL DEFAULT '{}'::jsonb,
+    status           TEXT    NOT NULL DEFAULT 'queued'
+                     CHECK (status IN ('queued', 'running', 'succeeded', 'failed', 'cancelled')),
+    progress         INTEGER NOT NULL DEFAULT 0 CHECK (progress BETWEEN 0 AND 100),
+    progress_message TEXT    NOT NULL DEFAULT '',
+    result           TEXT    NOT NULL DEFAULT '',
+    error            TEXT    NOT NULL DEFAULT '',
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    submitted_by     TEXT    NOT NULL DEFAULT '',
+    created_at       BIGINT  NOT NULL,
+    started_at       BIGINT,
+    heartbeat_at     BIGINT,
+    finished_at      BIGINT
+);
+
+-- Workers claim queued jobs oldest first.
+CREATE INDEX IF NOT EXISTS jobs_queued ON jobs(created_at) WHERE status = 'queued';
+
+-- Reclaim and abandonment scan running jobs by heartbeat.
+CREATE INDEX IF NOT EXISTS jobs_running ON jobs(heartbeat_at) WHERE status = 'running';
+
+-- Retention pruning deletes finished jobs by age.
+CREATE INDEX IF NOT EXISTS jobs_finished ON jobs(finished_at) WHERE finished_at IS NOT NULL;
@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000070_exit_traversal.up.sql.
+
+ALTER TABLE exits
+    DROP COLUMN IF EXISTS traversal_cost,
+    DROP COLUMN IF EXISTS traversal_delay;
@@ -0,0 +1,17 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Exit traversal cost (traversal.Service). A traversal delay makes walking
+-- through an exit take time, e.g. two seconds across a courtyard, instead of
+-- moving the character at once; the delay is stored in nanoseconds. The
+-- traversal cost is an abstract charge, such as stamina, that the game's
+-- traversal cost hook collects when the walk begins. Zero means instant and
+-- free, which is what every existing exit keeps.
+--
+-- ADD COLUMN IF NOT EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE exits
+    ADD COLUMN IF NOT EXISTS traversal_delay BIGINT NOT NULL DEFAULT 0
+        CHECK (traversal_delay >= 0),
+    ADD COLUMN IF NOT EXISTS traversal_cost INTEGER NOT NULL DEFAULT 0
+        CHECK (traversal_cost >= 0);
@@ -0,0 +1,10 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000071_paging.up.sql.
+
+DELETE FROM system_aliases WHERE alias = 'p' AND command = 'page' AND source = 'core';
+
+DROP INDEX IF EXISTS page_mailbox_recipient;
+DROP TABLE IF EXISTS page_mailbox;
+DROP TABLE IF EXISTS page_blocks;
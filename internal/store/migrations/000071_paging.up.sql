@@ -0,0 +1,45 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Character paging (internal/paging).
+--
+-- page_blocks holds each character's ignore and gag lists. An ignored
+-- sender is told the page was refused; a gagged sender's pages are dropped
+-- without telling them. Rows go with either character.
+--
+-- page_mailbox holds pages sent to characters that were not connected,
+-- until the recipient next logs in and they are delivered and deleted. The
+-- sender's name is kept alongside the foreign key so a held page still
+-- reads correctly if the sender is renamed or deleted before delivery.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS page_blocks (
+    character_id  TEXT   NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    blocked_id    TEXT   NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    mode          TEXT   NOT NULL,
+    created_at    BIGINT NOT NULL,
+    PRIMARY KEY (character_id, blocked_id),
+    CONSTRAINT page_blocks_mode_check CHECK (mode IN ('ignore', 'gag')),
+    CONSTRAINT page_blocks_self_check CHECK (character_id <> blocked_id)
+);
+
+CREATE TABLE IF NOT EXISTS page_mailbox (
+    id            TEXT    PRIMARY KEY,
+    recipient_id  TEXT    NOT NULL REFERENCES characters(id) ON DELETE CASCADE,
+    sender_id     TEXT    REFERENCES characters(id) ON DELETE SET NULL,
+    sender_name   TEXT    NOT NULL,
+    message       TEXT    NOT NULL,
+    is_pose       BOOLEAN NOT NULL DEFAULT FALSE,
+    no_space      BOOLEAN NOT NULL DEFAULT FALSE,
+    sent_at       BIGINT  NOT NULL
+);
+
+-- Delivery reads one recipient's pages oldest first.
+CREATE INDEX IF NOT EXISTS page_mailbox_recipient ON page_mailbox(recipient_id, sent_at);
+
+-- "p" used to be seeded by the core-communication plugin's manifest; page
+-- is a core command now, so core owns the alias. Existing rows are left as
+-- they are: they already point at page.
+INSERT INTO system_aliases (alias, command, source)
+VALUES ('p', 'page', 'core')
+ON CONFLICT (alias) DO NOTHING;
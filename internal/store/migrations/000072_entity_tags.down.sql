@@ -0,0 +1,11 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000072_entity_tags.up.sql.
+
+DROP INDEX IF EXISTS idx_characters_tags;
+DROP INDEX IF EXISTS idx_objects_tags;
+DROP INDEX IF EXISTS idx_locations_tags;
+ALTER TABLE characters DROP COLUMN IF EXISTS tags;
+ALTER TABLE objects DROP COLUMN IF EXISTS tags;
+ALTER TABLE locations DROP COLUMN IF EXISTS tags;
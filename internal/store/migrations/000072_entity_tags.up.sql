@@ -0,0 +1,19 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- World entity tags (world.Service.TagLocation and friends). A tag is a
+-- free-form lowercase label, e.g. "outdoor" or "newbie-area", that builders
+-- attach to locations, objects, and characters so scripts and zone tooling can
+-- find them with world.Service.FindByTag. Tags are written only by the tag
+-- commands, which bump the row version like any other guarded world write.
+--
+-- The GIN indexes serve the tags @> ARRAY[$1] lookups behind FindByTag.
+-- ADD COLUMN IF NOT EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE objects ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE characters ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_locations_tags ON locations USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_objects_tags ON objects USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_characters_tags ON characters USING GIN (tags);
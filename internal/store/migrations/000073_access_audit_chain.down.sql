@@ -0,0 +1,10 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000073_access_audit_chain.up.sql.
+
+DROP TABLE IF EXISTS access_audit_chain_heads;
+DROP INDEX IF EXISTS idx_audit_log_chain_seq;
+ALTER TABLE access_audit_log DROP COLUMN IF EXISTS entry_hash;
+ALTER TABLE access_audit_log DROP COLUMN IF EXISTS prev_hash;
+ALTER TABLE access_audit_log DROP COLUMN IF EXISTS seq;
@@ -0,0 +1,26 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Hash chaining for access_audit_log (tamper evidence). Each row written from
+-- here on carries its position in its monthly partition's chain (seq, from 1),
+-- the previous row's hash, and its own SHA-256 over its content and position
+-- (audit.PostgresWriter). access_audit_chain_heads holds the newest link per
+-- partition; writers append under its row lock, and `holomush audit verify`
+-- compares it against the rows to catch a deleted tail.
+--
+-- Rows written before this migration keep NULL chain columns and are reported
+-- as unchained. ADD COLUMN IF NOT EXISTS / IF NOT EXISTS keep it re-runnable.
+
+ALTER TABLE access_audit_log ADD COLUMN IF NOT EXISTS seq BIGINT;
+ALTER TABLE access_audit_log ADD COLUMN IF NOT EXISTS prev_hash BYTEA;
+ALTER TABLE access_audit_log ADD COLUMN IF NOT EXISTS entry_hash BYTEA;
+
+-- Serves the verifier's per-partition walk in seq order.
+CREATE INDEX IF NOT EXISTS idx_audit_log_chain_seq
+    ON access_audit_log (seq) WHERE seq IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS access_audit_chain_heads (
+    partition_name TEXT PRIMARY KEY,
+    seq            BIGINT NOT NULL DEFAULT 0,
+    entry_hash     BYTEA
+);
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000074_property_schemas.up.sql.
+
+DROP TABLE IF EXISTS property_schemas;
@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Staff-declared property value types (world.Service.DeclarePropertySchema).
+-- A row declares that entity_properties values stored under name must parse
+-- as value_type: string, int, bool, ref (a ULID), or json, the last
+-- optionally constrained by json_schema. Plugins declare their types in the
+-- manifest on every load and are not stored here.
+--
+-- Timestamps are Unix nanoseconds, matching the other world tables.
+
+CREATE TABLE IF NOT EXISTS property_schemas (
+    name        TEXT PRIMARY KEY,
+    value_type  TEXT NOT NULL
+                CHECK (value_type IN ('string', 'int', 'bool', 'ref', 'json')),
+    json_schema JSONB,
+    description TEXT NOT NULL DEFAULT '',
+    declared_by TEXT NOT NULL,
+    created_at  BIGINT NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    updated_at  BIGINT NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    CONSTRAINT property_schemas_json_only
+        CHECK (json_schema IS NULL OR value_type = 'json')
+);
@@ -0,0 +1,10 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000075_reports.up.sql.
+
+DROP INDEX IF EXISTS report_notes_report;
+DROP TABLE IF EXISTS report_notes;
+DROP INDEX IF EXISTS reports_unresolved;
+DROP INDEX IF EXISTS reports_reporter;
+DROP TABLE IF EXISTS reports;
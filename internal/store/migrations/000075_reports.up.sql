@@ -0,0 +1,53 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Player reports and their triage (internal/report).
+--
+-- reports holds bug, typo, and harassment reports filed with the report
+-- command, with the location and scene the reporter was in when they filed
+-- it. Character names are kept alongside the foreign keys so a report still
+-- reads correctly after a rename, and so a harassment report outlives the
+-- deletion of the character who filed or handled it. scene_id has no
+-- foreign key: scenes belong to the scenes plugin.
+--
+-- report_notes holds staff notes on a report; they go with the report.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS reports (
+    id                TEXT   PRIMARY KEY,
+    reporter_id       TEXT   REFERENCES characters(id) ON DELETE SET NULL,
+    reporter_name     TEXT   NOT NULL,
+    category          TEXT   NOT NULL,
+    body              TEXT   NOT NULL,
+    location_id       TEXT   REFERENCES locations(id) ON DELETE SET NULL,
+    scene_id          TEXT,
+    status            TEXT   NOT NULL DEFAULT 'open',
+    claimed_by        TEXT   REFERENCES characters(id) ON DELETE SET NULL,
+    claimed_by_name   TEXT,
+    claimed_at        BIGINT,
+    resolution        TEXT,
+    resolved_by       TEXT   REFERENCES characters(id) ON DELETE SET NULL,
+    resolved_by_name  TEXT,
+    resolved_at       BIGINT,
+    created_at        BIGINT NOT NULL,
+    CONSTRAINT reports_category_check CHECK (category IN ('bug', 'typo', 'harassment')),
+    CONSTRAINT reports_status_check CHECK (status IN ('open', 'claimed', 'resolved')),
+    CONSTRAINT reports_resolved_check CHECK ((status = 'resolved') = (resolved_at IS NOT NULL))
+);
+
+-- The report command lists a reporter's reports newest first and counts
+-- their unresolved ones.
+CREATE INDEX IF NOT EXISTS reports_reporter ON reports(reporter_id, created_at);
+-- The staff queue reads unresolved reports oldest first.
+CREATE INDEX IF NOT EXISTS reports_unresolved ON reports(created_at) WHERE status <> 'resolved';
+
+CREATE TABLE IF NOT EXISTS report_notes (
+    id           TEXT   PRIMARY KEY,
+    report_id    TEXT   NOT NULL REFERENCES reports(id) ON DELETE CASCADE,
+    author_id    TEXT   REFERENCES characters(id) ON DELETE SET NULL,
+    author_name  TEXT   NOT NULL,
+    body         TEXT   NOT NULL,
+    created_at   BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS report_notes_report ON report_notes(report_id, created_at);
@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000076_events_audit_checkpoints.up.sql.
+
+DROP INDEX IF EXISTS events_audit_checkpoints_last_timestamp;
+DROP INDEX IF EXISTS events_audit_checkpoints_subject_seq;
+DROP TABLE IF EXISTS events_audit_checkpoints;
@@ -0,0 +1,34 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Summary checkpoints left behind by events_audit compaction
+-- (internal/eventbus/audit/compaction.go).
+--
+-- Compaction collapses a long run of consecutive low-value rows on one
+-- subject (an NPC pacing a room emits hundreds of move/arrive/leave events)
+-- into a single row here and deletes the raw rows. A checkpoint records the
+-- js_seq and timestamp span it replaced, how many events it stood for, and a
+-- JSONB summary of those events counted by type and by actor, so history can
+-- still say "Guard moved 412 times between these points" after the raw rows
+-- are gone.
+--
+-- Checkpoints are pruned on the same retain window as events_audit itself.
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS events_audit_checkpoints (
+    id               TEXT    PRIMARY KEY,
+    subject          TEXT    NOT NULL,
+    first_js_seq     BIGINT  NOT NULL,
+    last_js_seq      BIGINT  NOT NULL,
+    first_timestamp  BIGINT  NOT NULL,
+    last_timestamp   BIGINT  NOT NULL,
+    event_count      INTEGER NOT NULL,
+    summary          JSONB   NOT NULL,
+    created_at       BIGINT  NOT NULL,
+    CONSTRAINT events_audit_checkpoints_seq_order CHECK (first_js_seq <= last_js_seq),
+    CONSTRAINT events_audit_checkpoints_count_positive CHECK (event_count > 0)
+);
+
+CREATE INDEX IF NOT EXISTS events_audit_checkpoints_subject_seq
+    ON events_audit_checkpoints (subject, first_js_seq);
+CREATE INDEX IF NOT EXISTS events_audit_checkpoints_last_timestamp
+    ON events_audit_checkpoints (last_timestamp);
@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000077_player_impersonation.up.sql. Impersonation sessions are
+-- deleted rather than left behind as ordinary sessions of the player they
+-- impersonated.
+
+ALTER TABLE events_audit DROP COLUMN IF EXISTS impersonator_id;
+
+DELETE FROM player_security_events
+    WHERE event_type IN ('impersonation_started', 'impersonation_ended');
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled'
+    ));
+
+DROP INDEX IF EXISTS player_impersonations_pending;
+DROP TABLE IF EXISTS player_impersonations;
+
+DELETE FROM player_sessions WHERE impersonator_id IS NOT NULL;
+ALTER TABLE player_sessions DROP COLUMN IF EXISTS impersonator_id;
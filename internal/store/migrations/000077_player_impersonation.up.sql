@@ -0,0 +1,46 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Staff impersonation (auth.Service.Impersonate). A support staff member
+-- holding the support.impersonate grant may open a session as another
+-- player for a bounded time.
+--
+-- player_sessions.impersonator_id flags such a session with the staff
+-- player behind it; NULL for every ordinary session. Its expires_at is a
+-- hard limit: RefreshTTL never extends it.
+--
+-- player_impersonations records every impersonation. notified_at is set
+-- once the impersonated player has been told about it at their next login.
+--
+-- events_audit.impersonator_id carries the App-Impersonator-ID header, so
+-- events published during an impersonation session keep both identities.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+ALTER TABLE player_sessions
+    ADD COLUMN IF NOT EXISTS impersonator_id TEXT REFERENCES players(id) ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS player_impersonations (
+    id                 TEXT   PRIMARY KEY,
+    impersonator_id    TEXT   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    player_id          TEXT   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    player_session_id  TEXT   NOT NULL,
+    reason             TEXT   NOT NULL,
+    started_at         BIGINT NOT NULL,
+    expires_at         BIGINT NOT NULL,
+    notified_at        BIGINT,
+    CONSTRAINT player_impersonations_not_self CHECK (impersonator_id <> player_id)
+);
+
+-- Login delivery reads a player's unannounced impersonations.
+CREATE INDEX IF NOT EXISTS player_impersonations_pending
+    ON player_impersonations (player_id, started_at) WHERE notified_at IS NULL;
+
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended'
+    ));
+
+ALTER TABLE events_audit ADD COLUMN IF NOT EXISTS impersonator_id BYTEA;
@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000078_plugin_dead_letters.up.sql.
+
+DROP INDEX IF EXISTS plugin_dead_letters_plugin;
+DROP TABLE IF EXISTS plugin_dead_letters;
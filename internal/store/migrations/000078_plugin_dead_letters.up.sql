@@ -0,0 +1,33 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Plugin event dead letters (internal/plugin/deadletter). When a plugin's
+-- handle_event keeps failing, or runs past its delivery timeout, the
+-- subscriber stores the event here with the failure reason instead of
+-- dropping it. Staff inspect, requeue, or purge rows with the deadletter
+-- command; a successful requeue deletes the row, a failed one bumps
+-- attempts and records the new reason.
+--
+-- The event is kept whole (event_* columns mirror pluginsdk.Event) so a
+-- requeue delivers exactly what the plugin saw the first time;
+-- event_actor_kind is the pluginsdk.ActorKind value. All times
+-- are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS plugin_dead_letters (
+    id               BYTEA    PRIMARY KEY,
+    plugin           TEXT     NOT NULL,
+    event_id         TEXT     NOT NULL,
+    event_stream     TEXT     NOT NULL,
+    event_type       TEXT     NOT NULL,
+    event_timestamp  BIGINT   NOT NULL,
+    event_actor_kind SMALLINT NOT NULL,
+    event_actor_id   TEXT     NOT NULL DEFAULT '',
+    event_payload    TEXT     NOT NULL DEFAULT '',
+    reason           TEXT     NOT NULL,
+    attempts         INTEGER  NOT NULL CHECK (attempts > 0),
+    created_at       BIGINT   NOT NULL,
+    last_failed_at   BIGINT   NOT NULL
+);
+
+-- Inspection and purge filter by plugin, newest first.
+CREATE INDEX IF NOT EXISTS plugin_dead_letters_plugin
+    ON plugin_dead_letters (plugin, created_at);
@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000079_location_parents.up.sql.
+
+DROP INDEX IF EXISTS idx_locations_parent_id;
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_parent_not_self;
+ALTER TABLE locations DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,21 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Location hierarchy (world.Service.LocationProperties). A location may sit
+-- inside one parent location, e.g. area → building → room, and property
+-- lookups on a child fall back to its ancestors so area-wide settings such
+-- as weather or lighting live on the area alone. NULL means top level.
+--
+-- world.Service rejects a parent that would close a cycle before writing;
+-- the self-reference CHECK is the storage-level backstop. Deleting a parent
+-- leaves its children at top level rather than deleting them.
+--
+-- ADD COLUMN IF NOT EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS parent_id TEXT
+    REFERENCES locations(id) ON DELETE SET NULL;
+
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_parent_not_self;
+ALTER TABLE locations ADD CONSTRAINT locations_parent_not_self CHECK (parent_id IS NULL OR parent_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_locations_parent_id ON locations (parent_id) WHERE parent_id IS NOT NULL;
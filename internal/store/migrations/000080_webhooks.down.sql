@@ -0,0 +1,9 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000080_webhooks.up.sql.
+
+DROP INDEX IF EXISTS webhook_deliveries_endpoint;
+DROP INDEX IF EXISTS webhook_deliveries_due;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
@@ -0,0 +1,49 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Outbound webhooks (internal/webhook). Operators register endpoints with
+-- the webhook command; each subscribes to event type patterns ("scene.*",
+-- "character_created", or "*"). Every published event that matches an
+-- enabled endpoint is queued in webhook_deliveries, and workers on every
+-- replica claim due rows with FOR UPDATE SKIP LOCKED, POST them signed
+-- with the endpoint's secret, and record the outcome.
+--
+-- A pending row's next_attempt_at doubles as its lease: a claim pushes it
+-- past the request timeout, so a worker that dies mid-request leaves the
+-- row to be retried. Delivered and failed rows are the delivery log and
+-- are pruned after the retention period. All times are BIGINT epoch-ns
+-- (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id              BYTEA   PRIMARY KEY,
+    name            TEXT    NOT NULL UNIQUE,
+    url             TEXT    NOT NULL,
+    secret          TEXT    NOT NULL,
+    events          TEXT[]  NOT NULL,
+    rate_per_minute INTEGER NOT NULL CHECK (rate_per_minute > 0),
+    enabled         BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by      TEXT    NOT NULL DEFAULT '',
+    created_at      BIGINT  NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               BYTEA   PRIMARY KEY,
+    endpoint_id      BYTEA   NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_id         TEXT    NOT NULL,
+    event_type       TEXT    NOT NULL,
+    body             BYTEA   NOT NULL,
+    status           TEXT    NOT NULL CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at  BIGINT  NOT NULL,
+    last_status_code INTEGER NOT NULL DEFAULT 0,
+    last_error       TEXT    NOT NULL DEFAULT '',
+    created_at       BIGINT  NOT NULL,
+    delivered_at     BIGINT
+);
+
+-- Workers claim due pending rows in next_attempt_at order.
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due
+    ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+-- The delivery log lists one endpoint's rows, newest first.
+CREATE INDEX IF NOT EXISTS webhook_deliveries_endpoint
+    ON webhook_deliveries (endpoint_id, created_at);
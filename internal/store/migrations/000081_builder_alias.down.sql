@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000081_builder_alias.up.sql.
+
+DELETE FROM system_aliases WHERE alias = '@' AND command = 'build' AND source = 'core';
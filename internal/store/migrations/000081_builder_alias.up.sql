@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- "@" is the classic MUSH builder sigil: with it aliased to build, "@dig"
+-- runs "build dig". Operators who use "@" for something else keep their row.
+INSERT INTO system_aliases (alias, command, source)
+VALUES ('@', 'build', 'core')
+ON CONFLICT (alias) DO NOTHING;
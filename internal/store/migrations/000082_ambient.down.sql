@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000082_ambient.up.sql.
+
+DROP TABLE IF EXISTS ambient_lines;
+DROP TABLE IF EXISTS ambient_schedules;
@@ -0,0 +1,42 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Ambient location messages (internal/ambient).
+--
+-- ambient_schedules holds one row per location with ambience: the gap
+-- between lines (min_interval_ns..max_interval_ns) and an optional daily
+-- active window in minutes after midnight UTC (equal bounds mean always).
+-- next_at is when the location next fires; replicas claim a due row by
+-- moving next_at forward, so each firing happens once cluster-wide.
+--
+-- ambient_lines holds the weighted lines a location picks from. Lines go
+-- with their schedule, and both go with their location.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS ambient_schedules (
+    location_id      TEXT    PRIMARY KEY REFERENCES locations(id) ON DELETE CASCADE,
+    min_interval_ns  BIGINT  NOT NULL,
+    max_interval_ns  BIGINT  NOT NULL,
+    active_start     INTEGER NOT NULL DEFAULT 0,
+    active_end       INTEGER NOT NULL DEFAULT 0,
+    next_at          BIGINT  NOT NULL,
+    updated_by       TEXT    NOT NULL,
+    updated_at       BIGINT  NOT NULL,
+    CONSTRAINT ambient_schedules_interval_check CHECK (min_interval_ns > 0 AND max_interval_ns >= min_interval_ns),
+    CONSTRAINT ambient_schedules_hours_check CHECK (active_start BETWEEN 0 AND 1439 AND active_end BETWEEN 0 AND 1439)
+);
+
+-- The tick claims by next fire time.
+CREATE INDEX IF NOT EXISTS ambient_schedules_next_at ON ambient_schedules(next_at);
+
+CREATE TABLE IF NOT EXISTS ambient_lines (
+    id           TEXT    PRIMARY KEY,
+    location_id  TEXT    NOT NULL REFERENCES ambient_schedules(location_id) ON DELETE CASCADE,
+    text         TEXT    NOT NULL,
+    weight       INTEGER NOT NULL,
+    created_by   TEXT    NOT NULL,
+    created_at   BIGINT  NOT NULL,
+    CONSTRAINT ambient_lines_weight_check CHECK (weight > 0)
+);
+
+CREATE INDEX IF NOT EXISTS ambient_lines_location_id ON ambient_lines(location_id, created_at);
@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000083_session_error_verbosity.up.sql. Every session goes back to
+-- terse error output.
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS error_verbosity;
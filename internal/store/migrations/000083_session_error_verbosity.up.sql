@@ -0,0 +1,10 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Per-session error verbosity. Terse sessions see only the player-facing
+-- message for a failed command; verbose sessions (staff debugging) also see
+-- the error code and the policy decision behind it. The setting lives on the
+-- session, so it survives reconnects and ends with the session.
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS error_verbosity TEXT NOT NULL DEFAULT 'terse'
+        CHECK (error_verbosity IN ('terse', 'verbose'));
@@ -0,0 +1,32 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Non-player characters (internal/npc).
+--
+-- An NPC is placed in a location by staff and goes with it. Names are
+-- unique ignoring case so commands can address an NPC by name. behaviors
+-- is a JSON array of {plugin, triggers} attachments. A wanderer has a
+-- positive wander_min_ns and a next_wander_at; replicas claim a due NPC by
+-- moving next_wander_at forward, so each move happens once cluster-wide.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS npcs (
+    id              TEXT    PRIMARY KEY,
+    name            TEXT    NOT NULL,
+    description     TEXT    NOT NULL DEFAULT '',
+    location_id     TEXT    NOT NULL REFERENCES locations(id) ON DELETE CASCADE,
+    owner           TEXT    NOT NULL,
+    behaviors       JSONB   NOT NULL DEFAULT '[]'::jsonb,
+    wander_min_ns   BIGINT  NOT NULL DEFAULT 0,
+    wander_max_ns   BIGINT  NOT NULL DEFAULT 0,
+    wander_zone     TEXT    NOT NULL DEFAULT '',
+    next_wander_at  BIGINT,
+    created_at      BIGINT  NOT NULL,
+    CONSTRAINT npcs_wander_check CHECK (wander_min_ns >= 0 AND wander_max_ns >= wander_min_ns)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS npcs_name_lower ON npcs(lower(name));
+CREATE INDEX IF NOT EXISTS npcs_location_id ON npcs(location_id);
+
+-- The tick claims wanderers by next move time.
+CREATE INDEX IF NOT EXISTS npcs_next_wander_at ON npcs(next_wander_at) WHERE next_wander_at IS NOT NULL;
@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000085_posting_rules.up.sql.
+
+DROP TABLE IF EXISTS held_posts;
+DROP TABLE IF EXISTS posting_rules;
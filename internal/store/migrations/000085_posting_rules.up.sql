@@ -0,0 +1,42 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Posting rules and the moderation queue (internal/posting).
+--
+-- A rule restricts say and pose in one location or scene. target_id is a
+-- location or scene id depending on target_kind; scenes live in a plugin
+-- schema, so there is no foreign key. participants is a JSON array of
+-- {id, name} for the characters given the floor. A target with no row lets
+-- everyone post.
+--
+-- held_posts keeps each queued event whole so approval publishes it
+-- unchanged. Payloads of sensitive scene events are held in plaintext until
+-- a moderator approves or rejects them; the queue is capped per target.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS posting_rules (
+    target_kind   TEXT    NOT NULL,
+    target_id     TEXT    NOT NULL,
+    mode          TEXT    NOT NULL,
+    participants  JSONB   NOT NULL DEFAULT '[]'::jsonb,
+    set_by        TEXT    NOT NULL,
+    updated_at    BIGINT  NOT NULL,
+    PRIMARY KEY (target_kind, target_id),
+    CONSTRAINT posting_rules_kind_check CHECK (target_kind IN ('location', 'scene')),
+    CONSTRAINT posting_rules_mode_check CHECK (mode IN ('everyone', 'participants', 'moderated'))
+);
+
+CREATE TABLE IF NOT EXISTS held_posts (
+    id            TEXT     PRIMARY KEY,
+    target_kind   TEXT     NOT NULL,
+    target_id     TEXT     NOT NULL,
+    subject       TEXT     NOT NULL,
+    event_type    TEXT     NOT NULL,
+    actor_id      TEXT     NOT NULL,
+    actor_name    TEXT     NOT NULL DEFAULT '',
+    payload       BYTEA    NOT NULL,
+    sensitive     BOOLEAN  NOT NULL DEFAULT FALSE,
+    held_at       BIGINT   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS held_posts_target ON held_posts(target_kind, target_id, held_at);
@@ -0,0 +1,17 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000086_account_recovery.up.sql. Resets already issued by an
+-- approval stay in password_resets and expire on their own.
+
+DELETE FROM player_security_events
+    WHERE event_type IN ('recovery_requested', 'recovery_approved');
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended'
+    ));
+
+DROP TABLE IF EXISTS recovery_requests;
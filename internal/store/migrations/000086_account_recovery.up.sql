@@ -0,0 +1,49 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Staff-approved account recovery (internal/recovery).
+--
+-- A recovery request names an account and carries the filer's evidence
+-- that it is theirs. player_id is NULL for an unmatched request (unknown,
+-- guest, or busy account); those rows only feed the rate limits and the
+-- audit trail. filer_id is the filing character, usually a guest, so it has
+-- no foreign key. code_hash is the SHA-256 of the recovery code the filer
+-- was shown; approval stores the same hash as a password_resets token.
+--
+-- recovery_requests_one_pending keeps an account to one pending request.
+--
+-- player_security_events gains recovery_requested and recovery_approved.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS recovery_requests (
+    id                TEXT    PRIMARY KEY,
+    player_id         TEXT    REFERENCES players(id) ON DELETE CASCADE,
+    username          TEXT    NOT NULL,
+    filer_id          TEXT    NOT NULL,
+    filer_name        TEXT    NOT NULL,
+    evidence          TEXT    NOT NULL,
+    code_hash         TEXT    NOT NULL UNIQUE,
+    status            TEXT    NOT NULL,
+    reviewed_by       TEXT,
+    reviewed_by_name  TEXT,
+    review_note       TEXT,
+    reviewed_at       BIGINT,
+    created_at        BIGINT  NOT NULL,
+    CONSTRAINT recovery_requests_status_check CHECK (status IN ('unmatched', 'pending', 'approved', 'denied')),
+    CONSTRAINT recovery_requests_matched_check CHECK (status = 'unmatched' OR player_id IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS recovery_requests_filer ON recovery_requests(filer_id, created_at);
+CREATE INDEX IF NOT EXISTS recovery_requests_player ON recovery_requests(player_id, created_at);
+CREATE INDEX IF NOT EXISTS recovery_requests_status ON recovery_requests(status, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS recovery_requests_one_pending
+    ON recovery_requests(player_id) WHERE status = 'pending';
+
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended',
+        'recovery_requested', 'recovery_approved'
+    ));
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000087_legacy_imports.up.sql. Imported players and characters stay.
+
+DROP TABLE IF EXISTS legacy_imports;
@@ -0,0 +1,21 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Players brought over by `holomush import-characters` (internal/charimport).
+--
+-- A row maps a legacy account, keyed by the import's source label and the
+-- lower-cased legacy username, to the player it became. Re-running an import
+-- finds its players here even after they have logged in and their password
+-- hash was upgraded. Deleting the player deletes its row, so a later run
+-- imports the account afresh.
+--
+-- All times are BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS legacy_imports (
+    source        TEXT    NOT NULL,
+    legacy_key    TEXT    NOT NULL,
+    player_id     TEXT    NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    imported_at   BIGINT  NOT NULL,
+    PRIMARY KEY (source, legacy_key)
+);
+
+CREATE INDEX IF NOT EXISTS legacy_imports_player ON legacy_imports(player_id);
@@ -0,0 +1,9 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000088_location_capacity.up.sql.
+
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_overflow_not_self;
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_max_occupancy_nonnegative;
+ALTER TABLE locations DROP COLUMN IF EXISTS overflow_id;
+ALTER TABLE locations DROP COLUMN IF EXISTS max_occupancy;
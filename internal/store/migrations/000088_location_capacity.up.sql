@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Location capacity (world.Service.AdmitCharacter). max_occupancy caps how
+-- many characters may be in a location at once; 0 means no cap. A full
+-- location sends arrivals to its overflow location when it names one and
+-- turns them away otherwise. Characters allowed to exceed the cap (staff)
+-- are admitted regardless.
+--
+-- Deleting an overflow location leaves the full location turning arrivals
+-- away rather than deleting it.
+--
+-- ADD COLUMN IF NOT EXISTS keeps the migration safe to re-run.
+
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS max_occupancy INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE locations ADD COLUMN IF NOT EXISTS overflow_id TEXT
+    REFERENCES locations(id) ON DELETE SET NULL;
+
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_max_occupancy_nonnegative;
+ALTER TABLE locations ADD CONSTRAINT locations_max_occupancy_nonnegative CHECK (max_occupancy >= 0);
+
+ALTER TABLE locations DROP CONSTRAINT IF EXISTS locations_overflow_not_self;
+ALTER TABLE locations ADD CONSTRAINT locations_overflow_not_self CHECK (overflow_id IS NULL OR overflow_id <> id);
@@ -0,0 +1,18 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000089_password_reset_reuse.up.sql. Used resets would read as
+-- live again once consumption goes back to deleting, so they are removed.
+
+DELETE FROM password_resets WHERE used_at IS NOT NULL;
+DROP INDEX IF EXISTS password_resets_outstanding;
+
+DELETE FROM player_security_events WHERE event_type = 'password_reset_reused';
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended',
+        'recovery_requested', 'recovery_approved'
+    ));
@@ -0,0 +1,22 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Single-use password reset tokens (internal/auth).
+--
+-- Consuming a reset now sets password_resets.used_at instead of deleting
+-- the row, so a replayed token is recognised until it expires.
+-- password_resets_outstanding serves the per-player count of unused resets.
+--
+-- player_security_events gains password_reset_reused.
+CREATE INDEX IF NOT EXISTS password_resets_outstanding
+    ON password_resets(player_id, expires_at) WHERE used_at IS NULL;
+
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended',
+        'recovery_requested', 'recovery_approved',
+        'password_reset_reused'
+    ));
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000090_plugin_kv.up.sql.
+
+DROP TABLE IF EXISTS plugin_kv;
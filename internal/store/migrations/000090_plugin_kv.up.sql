@@ -0,0 +1,17 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Plugin key-value storage (internal/plugin/kvstore). Each plugin reads and
+-- writes only the rows under its own name, through the holomush.storage_*
+-- host functions; staff inspect and purge them with the pluginstore
+-- command. The per-plugin key and byte quota is enforced by the writer under
+-- an advisory lock, not by a constraint, so it can change without a
+-- migration. value is opaque to the host. updated_at is BIGINT epoch-ns
+-- (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS plugin_kv (
+    plugin     TEXT   NOT NULL,
+    key        TEXT   NOT NULL CHECK (key <> ''),
+    value      BYTEA  NOT NULL,
+    updated_at BIGINT NOT NULL,
+    PRIMARY KEY (plugin, key)
+);
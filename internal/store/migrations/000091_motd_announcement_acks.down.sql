@@ -0,0 +1,7 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000091_motd_announcement_acks.up.sql.
+
+DROP TABLE IF EXISTS motd_announcement_acks;
+ALTER TABLE motd_announcements DROP COLUMN IF EXISTS requires_ack;
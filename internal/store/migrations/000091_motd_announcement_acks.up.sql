@@ -0,0 +1,20 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Announcement acknowledgments (internal/motd). An announcement with
+-- requires_ack set, such as a policy change, stays in a player's login
+-- notice until they acknowledge it with motd ack; each acknowledgment is a
+-- row here. Rows go with their announcement and their player. acked_at is
+-- BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+ALTER TABLE motd_announcements
+    ADD COLUMN IF NOT EXISTS requires_ack BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS motd_announcement_acks (
+    announcement_id TEXT   NOT NULL REFERENCES motd_announcements(id) ON DELETE CASCADE,
+    player_id       TEXT   NOT NULL REFERENCES players(id) ON DELETE CASCADE,
+    acked_at        BIGINT NOT NULL,
+    PRIMARY KEY (announcement_id, player_id)
+);
+
+-- A player's login notice looks up their acknowledgments.
+CREATE INDEX IF NOT EXISTS motd_announcement_acks_player ON motd_announcement_acks(player_id);
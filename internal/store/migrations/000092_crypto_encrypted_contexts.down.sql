@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000092_crypto_encrypted_contexts.up.sql.
+
+DROP TABLE IF EXISTS crypto_encrypted_contexts;
@@ -0,0 +1,14 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Contexts encrypted at rest (internal/eventbus EncryptedContextSet). A
+-- context with a row here has every event on its streams stored encrypted,
+-- whatever the per-event sensitivity; private scenes opt in at creation.
+-- Rows are never deleted: events already stored encrypted stay encrypted.
+-- enabled_at is BIGINT epoch-ns (INV-STORE-1 / lint:no-timestamptz).
+CREATE TABLE IF NOT EXISTS crypto_encrypted_contexts (
+    context_type TEXT   NOT NULL,
+    context_id   TEXT   NOT NULL,
+    enabled_at   BIGINT NOT NULL,
+    PRIMARY KEY (context_type, context_id)
+);
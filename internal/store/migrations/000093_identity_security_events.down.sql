@@ -0,0 +1,15 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Revert 000093_identity_security_events.up.sql.
+
+DELETE FROM player_security_events WHERE event_type IN ('identity_linked', 'identity_unlinked');
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended',
+        'recovery_requested', 'recovery_approved',
+        'password_reset_reused'
+    ));
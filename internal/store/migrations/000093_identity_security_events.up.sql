@@ -0,0 +1,15 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- player_security_events gains identity_linked and identity_unlinked, recorded
+-- when a player links or unlinks an OpenID Connect identity (internal/auth).
+ALTER TABLE player_security_events DROP CONSTRAINT IF EXISTS player_security_events_event_type_check;
+ALTER TABLE player_security_events ADD CONSTRAINT player_security_events_event_type_check
+    CHECK (event_type IN (
+        'login_succeeded', 'login_failed', 'password_changed',
+        'session_terminated', 'two_factor_enabled', 'two_factor_disabled',
+        'impersonation_started', 'impersonation_ended',
+        'recovery_requested', 'recovery_approved',
+        'password_reset_reused',
+        'identity_linked', 'identity_unlinked'
+    ));
@@ -33,15 +33,8 @@ var _ auth.PlayerSessionRepository = (*PostgresPlayerSessionStore)(nil)
 func (s *PostgresPlayerSessionStore) Create(ctx context.Context, session *auth.PlayerSession) error {
 	_, err := s.pool.Exec(
 		ctx,
-		`INSERT INTO player_sessions (id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
-		session.ID.String(),
-		session.PlayerID.String(),
-		session.TokenHash,
-		session.UserAgent,
-		session.IPAddress,
-		pgnanos.From(session.ExpiresAt),
-		pgnanos.From(session.CreatedAt),
-		pgnanos.From(session.UpdatedAt),
+		insertPlayerSessionSQL,
+		playerSessionInsertArgs(session)...,
 	)
 	if err != nil {
 		return oops.With("operation", "create player session").With("player_id", session.PlayerID.String()).Wrap(err)
@@ -83,15 +76,8 @@ func (s *PostgresPlayerSessionStore) CreateWithCap(ctx context.Context, session
 
 	if _, err := tx.Exec(
 		ctx,
-		`INSERT INTO player_sessions (id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`,
-		session.ID.String(),
-		session.PlayerID.String(),
-		session.TokenHash,
-		session.UserAgent,
-		session.IPAddress,
-		pgnanos.From(session.ExpiresAt),
-		pgnanos.From(session.CreatedAt),
-		pgnanos.From(session.UpdatedAt),
+		insertPlayerSessionSQL,
+		playerSessionInsertArgs(session)...,
 	); err != nil {
 		return nil, oops.Code("PLAYER_SESSION_CREATE_FAILED").
 			With("player_id", session.PlayerID.String()).Wrap(err)
@@ -103,7 +89,8 @@ func (s *PostgresPlayerSessionStore) CreateWithCap(ctx context.Context, session
 			DELETE FROM player_sessions
 			WHERE id IN (
 				SELECT id FROM player_sessions
-				WHERE player_id = $1 AND id != $2 AND expires_at > (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+				WHERE player_id = $1 AND id != $2 AND impersonator_id IS NULL
+				  AND expires_at > (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
 				ORDER BY created_at DESC
 				OFFSET $3
 			)
@@ -142,20 +129,14 @@ func (s *PostgresPlayerSessionStore) CreateWithCap(ctx context.Context, session
 
 // GetByTokenHash retrieves a player session by its token hash.
 // If the session exists but is expired, it is deleted and PLAYER_SESSION_EXPIRED is returned.
+// If only its access token has expired, the session is kept for its refresh
+// token and PLAYER_SESSION_EXPIRED is returned.
 func (s *PostgresPlayerSessionStore) GetByTokenHash(ctx context.Context, tokenHash string) (*auth.PlayerSession, error) {
-	var ps auth.PlayerSession
-	var idStr, playerIDStr string
-	var expiresAt, createdAt, updatedAt pgnanos.Time
-
-	err := s.pool.QueryRow(
+	ps, err := scanPlayerSession(s.pool.QueryRow(
 		ctx,
-		`SELECT id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at FROM player_sessions WHERE token_hash = $1`,
+		`SELECT `+playerSessionSelectColumns+` FROM player_sessions WHERE token_hash = $1`,
 		tokenHash,
-	).Scan(&idStr, &playerIDStr, &ps.TokenHash, &ps.UserAgent, &ps.IPAddress, &expiresAt, &createdAt, &updatedAt)
-	ps.ExpiresAt = expiresAt.Time()
-	ps.CreatedAt = createdAt.Time()
-	ps.UpdatedAt = updatedAt.Time()
-
+	))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, oops.Code("PLAYER_SESSION_NOT_FOUND").With("token_hash_prefix", safePrefix(tokenHash)).Wrap(auth.ErrNotFound)
 	}
@@ -163,64 +144,113 @@ func (s *PostgresPlayerSessionStore) GetByTokenHash(ctx context.Context, tokenHa
 		return nil, oops.With("operation", "get player session").With("token_hash_prefix", safePrefix(tokenHash)).Wrap(err)
 	}
 
-	id, err := ulid.Parse(idStr)
-	if err != nil {
-		return nil, oops.With("operation", "parse session id").With("raw_id", idStr).Wrap(err)
-	}
-	ps.ID = id
-
-	playerID, err := ulid.Parse(playerIDStr)
-	if err != nil {
-		return nil, oops.With("operation", "parse player_id").With("raw_id", playerIDStr).Wrap(err)
-	}
-	ps.PlayerID = playerID
-
 	if ps.IsExpired() {
 		// Clean up the expired session and signal expiry to caller.
 		// The conditional WHERE guards against deleting a session that was refreshed by a concurrent request.
 		_, _ = s.pool.Exec(ctx, `DELETE FROM player_sessions WHERE id = $1 AND expires_at < (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT`, ps.ID.String()) //nolint:errcheck // best-effort cleanup; session already expired
 		return nil, oops.Code("PLAYER_SESSION_EXPIRED").With("session_id", ps.ID.String()).Wrap(auth.ErrNotFound)
 	}
+	if ps.AccessExpired() {
+		return nil, oops.Code("PLAYER_SESSION_EXPIRED").With("session_id", ps.ID.String()).Wrap(auth.ErrNotFound)
+	}
 
-	return &ps, nil
+	return ps, nil
 }
 
-// GetByID retrieves a player session by its ULID primary key.
-// Returns auth.ErrNotFound if no row exists.
-func (s *PostgresPlayerSessionStore) GetByID(ctx context.Context, id ulid.ULID) (*auth.PlayerSession, error) {
-	var ps auth.PlayerSession
-	var idStr, playerIDStr string
-	var expiresAt2, createdAt2, updatedAt2 pgnanos.Time
-
-	err := s.pool.QueryRow(
+// GetByRefreshTokenHash retrieves the non-expired player session whose
+// current or previous refresh token has the given hash.
+func (s *PostgresPlayerSessionStore) GetByRefreshTokenHash(ctx context.Context, refreshHash string) (*auth.PlayerSession, error) {
+	if refreshHash == "" {
+		return nil, oops.Code("PLAYER_SESSION_NOT_FOUND").Wrap(auth.ErrNotFound)
+	}
+	ps, err := scanPlayerSession(s.pool.QueryRow(
 		ctx,
-		`SELECT id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at FROM player_sessions WHERE id = $1`,
-		id.String(),
-	).Scan(&idStr, &playerIDStr, &ps.TokenHash, &ps.UserAgent, &ps.IPAddress, &expiresAt2, &createdAt2, &updatedAt2)
-	ps.ExpiresAt = expiresAt2.Time()
-	ps.CreatedAt = createdAt2.Time()
-	ps.UpdatedAt = updatedAt2.Time()
-
+		`SELECT `+playerSessionSelectColumns+` FROM player_sessions
+		 WHERE (refresh_token_hash = $1 OR previous_refresh_hash = $1)
+		   AND expires_at > (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT`,
+		refreshHash,
+	))
 	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, oops.Code("PLAYER_SESSION_NOT_FOUND").With("session_id", id.String()).Wrap(auth.ErrNotFound)
+		return nil, oops.Code("PLAYER_SESSION_NOT_FOUND").With("refresh_hash_prefix", safePrefix(refreshHash)).Wrap(auth.ErrNotFound)
 	}
 	if err != nil {
-		return nil, oops.Code("PLAYER_SESSION_GET_BY_ID_FAILED").With("session_id", id.String()).Wrap(err)
+		return nil, oops.Code("PLAYER_SESSION_GET_BY_REFRESH_FAILED").With("refresh_hash_prefix", safePrefix(refreshHash)).Wrap(err)
 	}
+	return ps, nil
+}
 
-	parsedID, err := ulid.Parse(idStr)
+// RotateTokens stores the session's rotated tokens and expiries. The UPDATE
+// only matches while refresh_token_hash is still presentedRefreshHash, so of
+// two concurrent rotations with the same refresh token exactly one wins; the
+// other gets auth.ErrRefreshTokenConflict.
+func (s *PostgresPlayerSessionStore) RotateTokens(ctx context.Context, session *auth.PlayerSession, presentedRefreshHash string) error {
+	tag, err := s.pool.Exec(
+		ctx,
+		`UPDATE player_sessions
+		 SET token_hash = $1, refresh_token_hash = $2, previous_refresh_hash = $3,
+		     access_expires_at = $4, expires_at = $5, updated_at = $6
+		 WHERE id = $7 AND refresh_token_hash = $8`,
+		session.TokenHash,
+		session.RefreshTokenHash,
+		session.PreviousRefreshHash,
+		nullableNanos(session.AccessExpiresAt),
+		pgnanos.From(session.ExpiresAt),
+		pgnanos.From(session.UpdatedAt),
+		session.ID.String(),
+		presentedRefreshHash,
+	)
 	if err != nil {
-		return nil, oops.With("operation", "parse session id").With("raw_id", idStr).Wrap(err)
+		return oops.Code("PLAYER_SESSION_ROTATE_FAILED").With("session_id", session.ID.String()).Wrap(err)
 	}
-	ps.ID = parsedID
+	if tag.RowsAffected() == 0 {
+		return oops.Code("PLAYER_SESSION_ROTATE_CONFLICT").With("session_id", session.ID.String()).Wrap(auth.ErrRefreshTokenConflict)
+	}
+	return nil
+}
 
-	playerID, err := ulid.Parse(playerIDStr)
+// SetDeviceLabel sets the device label of a session.
+func (s *PostgresPlayerSessionStore) SetDeviceLabel(ctx context.Context, id ulid.ULID, label string) error {
+	tag, err := s.pool.Exec(ctx, `UPDATE player_sessions SET device_label = $1 WHERE id = $2`, label, id.String())
 	if err != nil {
-		return nil, oops.With("operation", "parse player_id").With("raw_id", playerIDStr).Wrap(err)
+		return oops.Code("PLAYER_SESSION_LABEL_FAILED").With("session_id", id.String()).Wrap(err)
 	}
-	ps.PlayerID = playerID
+	if tag.RowsAffected() == 0 {
+		return oops.Code("PLAYER_SESSION_NOT_FOUND").With("session_id", id.String()).Wrap(auth.ErrNotFound)
+	}
+	return nil
+}
 
-	return &ps, nil
+// SetTokenHash replaces the access token hash of a session.
+func (s *PostgresPlayerSessionStore) SetTokenHash(ctx context.Context, id ulid.ULID, tokenHash string) error {
+	tag, err := s.pool.Exec(
+		ctx,
+		`UPDATE player_sessions SET token_hash = $1, updated_at = $2 WHERE id = $3`,
+		tokenHash, pgnanos.From(time.Now()), id.String(),
+	)
+	if err != nil {
+		return oops.Code("PLAYER_SESSION_TOKEN_UPDATE_FAILED").With("session_id", id.String()).Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("PLAYER_SESSION_NOT_FOUND").With("session_id", id.String()).Wrap(auth.ErrNotFound)
+	}
+	return nil
+}
+
+// GetByID retrieves a player session by its ULID primary key.
+// Returns auth.ErrNotFound if no row exists.
+func (s *PostgresPlayerSessionStore) GetByID(ctx context.Context, id ulid.ULID) (*auth.PlayerSession, error) {
+	ps, err := scanPlayerSession(s.pool.QueryRow(
+		ctx,
+		`SELECT `+playerSessionSelectColumns+` FROM player_sessions WHERE id = $1`,
+		id.String(),
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("PLAYER_SESSION_NOT_FOUND").With("session_id", id.String()).Wrap(auth.ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("PLAYER_SESSION_GET_BY_ID_FAILED").With("session_id", id.String()).Wrap(err)
+	}
+	return ps, nil
 }
 
 // CountActiveByPlayer returns the number of non-expired sessions for a player.
@@ -241,7 +271,7 @@ func (s *PostgresPlayerSessionStore) CountActiveByPlayer(ctx context.Context, pl
 func (s *PostgresPlayerSessionStore) ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*auth.PlayerSession, error) {
 	rows, err := s.pool.Query(
 		ctx,
-		`SELECT id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at
+		`SELECT `+playerSessionSelectColumns+`
 		 FROM player_sessions
 		 WHERE player_id = $1 AND expires_at > (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
 		 ORDER BY created_at DESC`,
@@ -254,29 +284,11 @@ func (s *PostgresPlayerSessionStore) ListByPlayer(ctx context.Context, playerID
 
 	var sessions []*auth.PlayerSession
 	for rows.Next() {
-		var ps auth.PlayerSession
-		var idStr, playerIDStr string
-		var listExpiresAt, listCreatedAt, listUpdatedAt pgnanos.Time
-		if scanErr := rows.Scan(
-			&idStr, &playerIDStr, &ps.TokenHash, &ps.UserAgent, &ps.IPAddress,
-			&listExpiresAt, &listCreatedAt, &listUpdatedAt,
-		); scanErr != nil {
+		ps, scanErr := scanPlayerSession(rows)
+		if scanErr != nil {
 			return nil, oops.Code("PLAYER_SESSION_LIST_SCAN_FAILED").With("player_id", playerID.String()).Wrap(scanErr)
 		}
-		ps.ExpiresAt = listExpiresAt.Time()
-		ps.CreatedAt = listCreatedAt.Time()
-		ps.UpdatedAt = listUpdatedAt.Time()
-		parsedID, parseErr := ulid.Parse(idStr)
-		if parseErr != nil {
-			return nil, oops.With("operation", "parse session id").With("raw_id", idStr).Wrap(parseErr)
-		}
-		ps.ID = parsedID
-		parsedPlayerID, parseErr := ulid.Parse(playerIDStr)
-		if parseErr != nil {
-			return nil, oops.With("operation", "parse player_id").With("raw_id", playerIDStr).Wrap(parseErr)
-		}
-		ps.PlayerID = parsedPlayerID
-		sessions = append(sessions, &ps)
+		sessions = append(sessions, ps)
 	}
 	if rows.Err() != nil {
 		return nil, oops.Code("PLAYER_SESSION_LIST_FAILED").With("player_id", playerID.String()).Wrap(rows.Err())
@@ -341,7 +353,9 @@ func (s *PostgresPlayerSessionStore) DeleteExpired(ctx context.Context) (int64,
 	return tag.RowsAffected(), nil
 }
 
-// RefreshTTL extends the expiry of a session by ttl from now.
+// RefreshTTL extends the expiry of a session by ttl from now. An
+// impersonation session keeps its original expiry when that is sooner, so
+// activity never stretches it past its hard limit.
 func (s *PostgresPlayerSessionStore) RefreshTTL(ctx context.Context, id ulid.ULID, ttl time.Duration) error {
 	if ttl <= 0 {
 		return oops.With("operation", "refresh player session ttl").
@@ -352,7 +366,10 @@ func (s *PostgresPlayerSessionStore) RefreshTTL(ctx context.Context, id ulid.ULI
 	now := time.Now()
 	_, err := s.pool.Exec(
 		ctx,
-		`UPDATE player_sessions SET expires_at = $1, updated_at = $2 WHERE id = $3`,
+		`UPDATE player_sessions
+		 SET expires_at = CASE WHEN impersonator_id IS NULL THEN $1 ELSE LEAST(expires_at, $1) END,
+		     updated_at = $2
+		 WHERE id = $3`,
 		pgnanos.From(now.Add(ttl)),
 		pgnanos.From(now),
 		id.String(),
@@ -363,6 +380,95 @@ func (s *PostgresPlayerSessionStore) RefreshTTL(ctx context.Context, id ulid.ULI
 	return nil
 }
 
+// insertPlayerSessionSQL inserts every player_sessions column, in the order
+// of playerSessionInsertArgs.
+const insertPlayerSessionSQL = `INSERT INTO player_sessions (id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at, refresh_token_hash, previous_refresh_hash, access_expires_at, device_label, impersonator_id) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13)`
+
+// playerSessionInsertArgs returns the arguments of insertPlayerSessionSQL.
+func playerSessionInsertArgs(session *auth.PlayerSession) []any {
+	return []any{
+		session.ID.String(),
+		session.PlayerID.String(),
+		session.TokenHash,
+		session.UserAgent,
+		session.IPAddress,
+		pgnanos.From(session.ExpiresAt),
+		pgnanos.From(session.CreatedAt),
+		pgnanos.From(session.UpdatedAt),
+		session.RefreshTokenHash,
+		session.PreviousRefreshHash,
+		nullableNanos(session.AccessExpiresAt),
+		session.DeviceLabel,
+		nullableULID(session.ImpersonatorID),
+	}
+}
+
+// playerSessionSelectColumns is the column list scanPlayerSession reads.
+const playerSessionSelectColumns = `id, player_id, token_hash, user_agent, ip_address, expires_at, created_at, updated_at, refresh_token_hash, previous_refresh_hash, access_expires_at, device_label, impersonator_id`
+
+// scanPlayerSession scans one playerSessionSelectColumns row. Scan errors
+// are returned unwrapped so callers can match pgx.ErrNoRows.
+func scanPlayerSession(row pgx.Row) (*auth.PlayerSession, error) {
+	var ps auth.PlayerSession
+	var idStr, playerIDStr string
+	// access_expires_at is NULL for sessions without a refresh token;
+	// pgnanos.Time scans NULL as the zero time.
+	var expiresAt, createdAt, updatedAt, accessExpiresAt pgnanos.Time
+	// impersonator_id is NULL for every session but an impersonation.
+	var impersonatorStr *string
+	if err := row.Scan(
+		&idStr, &playerIDStr, &ps.TokenHash, &ps.UserAgent, &ps.IPAddress,
+		&expiresAt, &createdAt, &updatedAt,
+		&ps.RefreshTokenHash, &ps.PreviousRefreshHash, &accessExpiresAt, &ps.DeviceLabel,
+		&impersonatorStr,
+	); err != nil {
+		return nil, err //nolint:wrapcheck // callers wrap with their own operation context
+	}
+	ps.ExpiresAt = expiresAt.Time()
+	ps.CreatedAt = createdAt.Time()
+	ps.UpdatedAt = updatedAt.Time()
+	ps.AccessExpiresAt = accessExpiresAt.Time()
+
+	id, err := ulid.Parse(idStr)
+	if err != nil {
+		return nil, oops.With("operation", "parse session id").With("raw_id", idStr).Wrap(err)
+	}
+	ps.ID = id
+
+	playerID, err := ulid.Parse(playerIDStr)
+	if err != nil {
+		return nil, oops.With("operation", "parse player_id").With("raw_id", playerIDStr).Wrap(err)
+	}
+	ps.PlayerID = playerID
+
+	if impersonatorStr != nil {
+		impersonatorID, err := ulid.Parse(*impersonatorStr)
+		if err != nil {
+			return nil, oops.With("operation", "parse impersonator_id").With("raw_id", *impersonatorStr).Wrap(err)
+		}
+		ps.ImpersonatorID = impersonatorID
+	}
+	return &ps, nil
+}
+
+// nullableNanos maps a zero time to SQL NULL.
+func nullableNanos(t time.Time) *pgnanos.Time {
+	if t.IsZero() {
+		return nil
+	}
+	n := pgnanos.From(t)
+	return &n
+}
+
+// nullableULID maps a zero ULID to SQL NULL.
+func nullableULID(id ulid.ULID) *string {
+	if id.IsZero() {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
 // safePrefix returns the first 8 characters of a token for safe logging.
 func safePrefix(token string) string {
 	if len(token) <= 8 {
@@ -37,14 +37,30 @@ func testPlayerSession() *auth.PlayerSession {
 
 // playerSessionColumns returns the column names for player_sessions SELECT queries.
 func playerSessionColumns() []string {
-	return []string{"id", "player_id", "token_hash", "user_agent", "ip_address", "expires_at", "created_at", "updated_at"}
+	return []string{
+		"id", "player_id", "token_hash", "user_agent", "ip_address", "expires_at", "created_at", "updated_at",
+		"refresh_token_hash", "previous_refresh_hash", "access_expires_at", "device_label", "impersonator_id",
+	}
 }
 
 // playerSessionRow creates a pgxmock row from a PlayerSession.
 // Timestamp columns are BIGINT-ns after migration 000040; emit int64 nanoseconds
-// to match what pgx will scan from the database.
+// to match what pgx will scan from the database. A zero AccessExpiresAt is
+// the NULL access_expires_at of a session without a refresh token.
 func playerSessionRow(s *auth.PlayerSession) []any {
-	return []any{s.ID.String(), s.PlayerID.String(), s.TokenHash, s.UserAgent, s.IPAddress, s.ExpiresAt.UnixNano(), s.CreatedAt.UnixNano(), s.UpdatedAt.UnixNano()}
+	var accessExpiresAt any
+	if !s.AccessExpiresAt.IsZero() {
+		accessExpiresAt = s.AccessExpiresAt.UnixNano()
+	}
+	var impersonatorID *string
+	if s.IsImpersonation() {
+		id := s.ImpersonatorID.String()
+		impersonatorID = &id
+	}
+	return []any{
+		s.ID.String(), s.PlayerID.String(), s.TokenHash, s.UserAgent, s.IPAddress, s.ExpiresAt.UnixNano(), s.CreatedAt.UnixNano(), s.UpdatedAt.UnixNano(),
+		s.RefreshTokenHash, s.PreviousRefreshHash, accessExpiresAt, s.DeviceLabel, impersonatorID,
+	}
 }
 
 func TestPostgresPlayerSessionStore_CompileTimeCheck(_ *testing.T) {
@@ -67,7 +83,7 @@ func TestPostgresPlayerSessionStore_Create(t *testing.T) {
 			session: ps,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectExec(`INSERT INTO player_sessions`).
-					WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 			},
 		},
@@ -76,7 +92,7 @@ func TestPostgresPlayerSessionStore_Create(t *testing.T) {
 			session: ps,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
 				mock.ExpectExec(`INSERT INTO player_sessions`).
-					WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 					WillReturnError(errors.New("connection lost"))
 			},
 			wantErr: true,
@@ -124,6 +140,12 @@ func TestPostgresPlayerSessionStore_GetByTokenHash(t *testing.T) {
 		UpdatedAt: time.Now().UTC().Add(-2 * time.Hour),
 	}
 
+	// A live web session whose access token has expired.
+	accessExpiredPS := testPlayerSession()
+	accessExpiredPS.TokenHash = "accessexpiredhash"
+	accessExpiredPS.RefreshTokenHash = "refreshhash"
+	accessExpiredPS.AccessExpiresAt = time.Now().UTC().Add(-time.Minute)
+
 	tests := []struct {
 		name      string
 		tokenHash string
@@ -178,6 +200,19 @@ func TestPostgresPlayerSessionStore_GetByTokenHash(t *testing.T) {
 			wantErr: true,
 			errCode: "PLAYER_SESSION_EXPIRED",
 		},
+		{
+			name:      "expired access token keeps the session",
+			tokenHash: accessExpiredPS.TokenHash,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(playerSessionColumns()).AddRow(playerSessionRow(accessExpiredPS)...)
+				mock.ExpectQuery(`SELECT .+ FROM player_sessions WHERE token_hash = \$1`).
+					WithArgs(accessExpiredPS.TokenHash).
+					WillReturnRows(rows)
+				// No cleanup DELETE: the refresh token is still good.
+			},
+			wantErr: true,
+			errCode: "PLAYER_SESSION_EXPIRED",
+		},
 		{
 			name:      "database error",
 			tokenHash: ps.TokenHash,
@@ -230,7 +265,8 @@ func TestPostgresPlayerSessionStore_GetByTokenHash_InvalidIDFormat(t *testing.T)
 	// Return a row where the "id" column is not a valid ULID.
 	rows := pgxmock.NewRows(playerSessionColumns()).
 		AddRow("not-a-ulid", core.NewULID().String(), "somehash", "agent", "127.0.0.1",
-			time.Now().UTC().Add(time.Hour).UnixNano(), time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano())
+			time.Now().UTC().Add(time.Hour).UnixNano(), time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano(),
+			"", "", nil, "", nil)
 	mock.ExpectQuery(`SELECT .+ FROM player_sessions WHERE token_hash = \$1`).
 		WithArgs("somehash").
 		WillReturnRows(rows)
@@ -251,7 +287,8 @@ func TestPostgresPlayerSessionStore_GetByTokenHash_InvalidPlayerIDFormat(t *test
 	// Return a row where the "player_id" column is not a valid ULID.
 	rows := pgxmock.NewRows(playerSessionColumns()).
 		AddRow(core.NewULID().String(), "not-a-ulid", "somehash", "agent", "127.0.0.1",
-			time.Now().UTC().Add(time.Hour).UnixNano(), time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano())
+			time.Now().UTC().Add(time.Hour).UnixNano(), time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano(),
+			"", "", nil, "", nil)
 	mock.ExpectQuery(`SELECT .+ FROM player_sessions WHERE token_hash = \$1`).
 		WithArgs("somehash").
 		WillReturnRows(rows)
@@ -435,6 +472,8 @@ func TestPostgresPlayerSessionStore_DeleteExpired(t *testing.T) {
 
 func TestPostgresPlayerSessionStore_GetByID(t *testing.T) {
 	ps := testPlayerSession()
+	imp := testPlayerSession()
+	imp.ImpersonatorID = core.NewULID()
 
 	tests := []struct {
 		name      string
@@ -459,6 +498,21 @@ func TestPostgresPlayerSessionStore_GetByID(t *testing.T) {
 				assert.Equal(t, ps.ID, got.ID)
 				assert.Equal(t, ps.PlayerID, got.PlayerID)
 				assert.Equal(t, ps.TokenHash, got.TokenHash)
+				assert.False(t, got.IsImpersonation())
+			},
+		},
+		{
+			name: "impersonation session",
+			id:   imp.ID,
+			setupMock: func(mock pgxmock.PgxPoolIface) {
+				rows := pgxmock.NewRows(playerSessionColumns()).AddRow(playerSessionRow(imp)...)
+				mock.ExpectQuery(`SELECT .+ FROM player_sessions WHERE id = \$1`).
+					WithArgs(imp.ID.String()).
+					WillReturnRows(rows)
+			},
+			check: func(t *testing.T, got *auth.PlayerSession) {
+				t.Helper()
+				assert.Equal(t, imp.ImpersonatorID, got.ImpersonatorID)
 			},
 		},
 		{
@@ -769,7 +823,7 @@ func TestPostgresPlayerSessionStore_RefreshTTL(t *testing.T) {
 			id:   sessionID,
 			ttl:  ttl,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(`UPDATE player_sessions SET expires_at = \$1, updated_at = \$2 WHERE id = \$3`).
+				mock.ExpectExec(`UPDATE player_sessions\s+SET expires_at = CASE WHEN impersonator_id IS NULL THEN \$1 ELSE LEAST\(expires_at, \$1\) END,\s+updated_at = \$2\s+WHERE id = \$3`).
 					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), sessionID.String()).
 					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
 			},
@@ -797,7 +851,7 @@ func TestPostgresPlayerSessionStore_RefreshTTL(t *testing.T) {
 			id:   sessionID,
 			ttl:  ttl,
 			setupMock: func(mock pgxmock.PgxPoolIface) {
-				mock.ExpectExec(`UPDATE player_sessions SET expires_at = \$1, updated_at = \$2 WHERE id = \$3`).
+				mock.ExpectExec(`UPDATE player_sessions\s+SET expires_at = CASE WHEN impersonator_id IS NULL THEN \$1 ELSE LEAST\(expires_at, \$1\) END,\s+updated_at = \$2\s+WHERE id = \$3`).
 					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), sessionID.String()).
 					WillReturnError(errors.New("connection lost"))
 			},
@@ -850,7 +904,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 		mock.ExpectQuery(`DELETE FROM player_sessions`).
 			WithArgs(ps.PlayerID.String(), ps.ID.String(), capN-1).
@@ -879,7 +933,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 		// No DELETE expected when cap <= 0.
 		mock.ExpectCommit()
@@ -902,7 +956,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnError(errors.New("insert failed"))
 		mock.ExpectRollback()
 
@@ -924,7 +978,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 		mock.ExpectQuery(`DELETE FROM player_sessions`).
 			WithArgs(ps.PlayerID.String(), ps.ID.String(), 2).
@@ -964,7 +1018,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 		mock.ExpectQuery(`DELETE FROM player_sessions`).
 			WithArgs(ps.PlayerID.String(), ps.ID.String(), 2).
@@ -991,7 +1045,7 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 			WithArgs(ps.PlayerID.String()).
 			WillReturnResult(pgxmock.NewResult("SELECT", 1))
 		mock.ExpectExec(`INSERT INTO player_sessions`).
-			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WithArgs(ps.ID.String(), ps.PlayerID.String(), ps.TokenHash, ps.UserAgent, ps.IPAddress, pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), "", "", pgxmock.AnyArg(), "", (*string)(nil)).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
 		// Row contains an invalid ULID string.
 		mock.ExpectQuery(`DELETE FROM player_sessions`).
@@ -1007,3 +1061,164 @@ func TestPostgresPlayerSessionStore_CreateWithCap(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestPostgresPlayerSessionStore_GetByRefreshTokenHash(t *testing.T) {
+	ps := testPlayerSession()
+	ps.RefreshTokenHash = "refreshhash"
+	ps.PreviousRefreshHash = "retiredhash"
+	ps.AccessExpiresAt = time.Now().UTC().Add(auth.AccessTokenTTL)
+	ps.DeviceLabel = "Work laptop"
+
+	t.Run("matches current or previous refresh token", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT .+ FROM player_sessions\s+WHERE \(refresh_token_hash = \$1 OR previous_refresh_hash = \$1\)`).
+			WithArgs("retiredhash").
+			WillReturnRows(pgxmock.NewRows(playerSessionColumns()).AddRow(playerSessionRow(ps)...))
+
+		got, err := NewPostgresPlayerSessionStore(mock).GetByRefreshTokenHash(context.Background(), "retiredhash")
+		require.NoError(t, err)
+		assert.Equal(t, ps.ID, got.ID)
+		assert.Equal(t, "refreshhash", got.RefreshTokenHash)
+		assert.Equal(t, "retiredhash", got.PreviousRefreshHash)
+		assert.Equal(t, ps.AccessExpiresAt.UnixNano(), got.AccessExpiresAt.UnixNano())
+		assert.Equal(t, "Work laptop", got.DeviceLabel)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectQuery(`SELECT .+ FROM player_sessions`).
+			WithArgs("nohash").
+			WillReturnError(pgx.ErrNoRows)
+
+		_, err = NewPostgresPlayerSessionStore(mock).GetByRefreshTokenHash(context.Background(), "nohash")
+		errutil.AssertErrorCode(t, err, "PLAYER_SESSION_NOT_FOUND")
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty hash never queries", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		_, err = NewPostgresPlayerSessionStore(mock).GetByRefreshTokenHash(context.Background(), "")
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresPlayerSessionStore_RotateTokens(t *testing.T) {
+	ps := testPlayerSession()
+	ps.RefreshTokenHash = "oldrefresh"
+	require.NoError(t, ps.RotateTokens("newaccess", "newrefresh"))
+
+	expectRotate := func(mock pgxmock.PgxPoolIface) *pgxmock.ExpectedExec {
+		return mock.ExpectExec(`UPDATE player_sessions\s+SET token_hash = \$1, refresh_token_hash = \$2, previous_refresh_hash = \$3,.+WHERE id = \$7 AND refresh_token_hash = \$8`).
+			WithArgs("newaccess", "newrefresh", "oldrefresh", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), ps.ID.String(), "oldrefresh")
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectRotate(mock).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		require.NoError(t, NewPostgresPlayerSessionStore(mock).RotateTokens(context.Background(), ps, "oldrefresh"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("lost race is a conflict", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectRotate(mock).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err = NewPostgresPlayerSessionStore(mock).RotateTokens(context.Background(), ps, "oldrefresh")
+		errutil.AssertErrorCode(t, err, "PLAYER_SESSION_ROTATE_CONFLICT")
+		assert.ErrorIs(t, err, auth.ErrRefreshTokenConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		expectRotate(mock).WillReturnError(errors.New("connection lost"))
+
+		err = NewPostgresPlayerSessionStore(mock).RotateTokens(context.Background(), ps, "oldrefresh")
+		errutil.AssertErrorCode(t, err, "PLAYER_SESSION_ROTATE_FAILED")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresPlayerSessionStore_SetDeviceLabel(t *testing.T) {
+	sessionID := core.NewULID()
+
+	t.Run("happy path", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE player_sessions SET device_label = \$1 WHERE id = \$2`).
+			WithArgs("Phone", sessionID.String()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		require.NoError(t, NewPostgresPlayerSessionStore(mock).SetDeviceLabel(context.Background(), sessionID, "Phone"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing session", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE player_sessions SET device_label`).
+			WithArgs("Phone", sessionID.String()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err = NewPostgresPlayerSessionStore(mock).SetDeviceLabel(context.Background(), sessionID, "Phone")
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPostgresPlayerSessionStore_SetTokenHash(t *testing.T) {
+	sessionID := core.NewULID()
+
+	t.Run("happy path", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE player_sessions SET token_hash = \$1, updated_at = \$2 WHERE id = \$3`).
+			WithArgs("newhash", pgxmock.AnyArg(), sessionID.String()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		require.NoError(t, NewPostgresPlayerSessionStore(mock).SetTokenHash(context.Background(), sessionID, "newhash"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing session", func(t *testing.T) {
+		mock, err := pgxmock.NewPool()
+		require.NoError(t, err)
+		defer mock.Close()
+
+		mock.ExpectExec(`UPDATE player_sessions SET token_hash`).
+			WithArgs("newhash", pgxmock.AnyArg(), sessionID.String()).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err = NewPostgresPlayerSessionStore(mock).SetTokenHash(context.Background(), sessionID, "newhash")
+		assert.ErrorIs(t, err, auth.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
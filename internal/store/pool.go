@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package store
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/exaring/otelpgx"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+)
+
+// Default pool settings applied by DefaultPoolConfig.
+const (
+	DefaultPoolMaxConns                = 20
+	DefaultPoolMinConns                = 2
+	DefaultSlowQueryThreshold          = time.Second
+	DefaultSlowQueryDebugThreshold     = 100 * time.Millisecond
+	maxPoolConns                   int = 1000
+)
+
+// PoolConfig tunes the PostgreSQL connection pool, loaded from the top-level
+// "database" config section. Zero values leave the pgx default in place (or,
+// for the timeouts and thresholds, disable the feature), so a zero PoolConfig
+// behaves like a bare pgxpool.New. Pool settings are read once at startup;
+// changing them needs a restart.
+type PoolConfig struct {
+	// MaxConns caps open connections. Overrides pool_max_conns in the URL.
+	MaxConns int32 `koanf:"max_conns"`
+
+	// MinConns is how many idle connections the pool keeps warm.
+	MinConns int32 `koanf:"min_conns"`
+
+	// StatementTimeout is sent as the PostgreSQL statement_timeout of every
+	// connection. It also bounds lock waits, including the outbox leader's
+	// blocking advisory lock, so it SHOULD stay unset unless every statement
+	// in the process is expected to finish within it.
+	StatementTimeout time.Duration `koanf:"statement_timeout"`
+
+	// QueryTimeouts bounds each query by the repository package issuing it,
+	// keyed by the package path under internal/ ("world/postgres",
+	// "eventbus/history"). A key also covers the packages beneath it, so
+	// "world" bounds every world repository; the longest matching key wins.
+	QueryTimeouts map[string]time.Duration `koanf:"query_timeouts"`
+
+	// SlowQueryThreshold logs queries that take at least this long at WARN.
+	SlowQueryThreshold time.Duration `koanf:"slow_query_threshold"`
+
+	// SlowQueryDebugThreshold logs queries that take at least this long,
+	// but less than SlowQueryThreshold, at DEBUG.
+	SlowQueryDebugThreshold time.Duration `koanf:"slow_query_debug_threshold"`
+}
+
+// DefaultPoolConfig returns a PoolConfig populated with documented defaults.
+// Call sites SHOULD start from this value and overlay YAML so that omitted
+// keys keep their default.
+func DefaultPoolConfig() PoolConfig {
+	return PoolConfig{
+		MaxConns:                DefaultPoolMaxConns,
+		MinConns:                DefaultPoolMinConns,
+		SlowQueryThreshold:      DefaultSlowQueryThreshold,
+		SlowQueryDebugThreshold: DefaultSlowQueryDebugThreshold,
+	}
+}
+
+// Validate reports the first invalid setting.
+func (c PoolConfig) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return oops.Code("CONFIG_INVALID").Errorf("database."+format, args...)
+	}
+	if c.MaxConns < 0 || int(c.MaxConns) > maxPoolConns {
+		return invalid("max_conns must be between 0 and %d, got %d", maxPoolConns, c.MaxConns)
+	}
+	if c.MinConns < 0 {
+		return invalid("min_conns must not be negative, got %d", c.MinConns)
+	}
+	if c.MaxConns > 0 && c.MinConns > c.MaxConns {
+		return invalid("min_conns (%d) must not exceed max_conns (%d)", c.MinConns, c.MaxConns)
+	}
+	if c.StatementTimeout < 0 {
+		return invalid("statement_timeout must not be negative, got %s", c.StatementTimeout)
+	}
+	if c.StatementTimeout > 0 && c.StatementTimeout < time.Millisecond {
+		return invalid("statement_timeout must be at least 1ms, got %s", c.StatementTimeout)
+	}
+	for repo, timeout := range c.QueryTimeouts {
+		if strings.Trim(repo, "/") == "" {
+			return invalid("query_timeouts keys must name a repository package")
+		}
+		if timeout <= 0 {
+			return invalid("query_timeouts.%s must be positive, got %s", repo, timeout)
+		}
+	}
+	if c.SlowQueryThreshold < 0 || c.SlowQueryDebugThreshold < 0 {
+		return invalid("slow query thresholds must not be negative")
+	}
+	if c.SlowQueryThreshold > 0 && c.SlowQueryDebugThreshold > c.SlowQueryThreshold {
+		return invalid("slow_query_debug_threshold (%s) must not exceed slow_query_threshold (%s)",
+			c.SlowQueryDebugThreshold, c.SlowQueryThreshold)
+	}
+	return nil
+}
+
+// NewPool opens a connection pool for dsn tuned by cfg. Every pool carries
+// the OpenTelemetry tracer; the query tracer is added when cfg sets a query
+// timeout or a slow-query threshold.
+func NewPool(ctx context.Context, dsn string, cfg PoolConfig) (*pgxpool.Pool, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	pc, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, oops.With("operation", "parse database config").Wrap(err)
+	}
+	if cfg.MaxConns > 0 {
+		pc.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		pc.MinConns = min(cfg.MinConns, pc.MaxConns)
+	}
+	if cfg.StatementTimeout > 0 {
+		pc.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10)
+	}
+
+	var tracer pgx.QueryTracer = otelpgx.NewTracer()
+	if qt := newQueryTracer(cfg); qt != nil {
+		tracer = multitracer.New(tracer, qt)
+	}
+	pc.ConnConfig.Tracer = tracer
+
+	pool, err := pgxpool.NewWithConfig(ctx, pc)
+	if err != nil {
+		return nil, oops.With("operation", "connect to database").Wrap(err)
+	}
+	return pool, nil
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestDefaultPoolConfigIsValid(t *testing.T) {
+	require.NoError(t, DefaultPoolConfig().Validate())
+	require.NoError(t, PoolConfig{}.Validate(), "the zero value keeps pgx defaults")
+}
+
+func TestPoolConfigValidateRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  PoolConfig
+	}{
+		{"negative max conns", PoolConfig{MaxConns: -1}},
+		{"absurd max conns", PoolConfig{MaxConns: 5000}},
+		{"min above max", PoolConfig{MaxConns: 4, MinConns: 5}},
+		{"negative statement timeout", PoolConfig{StatementTimeout: -time.Second}},
+		{"sub-millisecond statement timeout", PoolConfig{StatementTimeout: time.Microsecond}},
+		{"empty repository key", PoolConfig{QueryTimeouts: map[string]time.Duration{"/": time.Second}}},
+		{"zero repository timeout", PoolConfig{QueryTimeouts: map[string]time.Duration{"world": 0}}},
+		{"negative slow threshold", PoolConfig{SlowQueryThreshold: -time.Second}},
+		{"debug above warn", PoolConfig{SlowQueryThreshold: time.Second, SlowQueryDebugThreshold: 2 * time.Second}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errutil.AssertErrorCode(t, tt.cfg.Validate(), "CONFIG_INVALID")
+		})
+	}
+}
+
+func TestNewPoolAppliesConfig(t *testing.T) {
+	// pgxpool connects lazily, so an unreachable server still yields a pool.
+	pool, err := NewPool(context.Background(), "postgres://holomush@127.0.0.1:1/holomush", PoolConfig{
+		MaxConns:         7,
+		MinConns:         3,
+		StatementTimeout: 1500 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer pool.Close()
+
+	cfg := pool.Config()
+	assert.Equal(t, int32(7), cfg.MaxConns)
+	assert.Equal(t, int32(3), cfg.MinConns)
+	assert.Equal(t, "1500", cfg.ConnConfig.RuntimeParams["statement_timeout"])
+}
+
+func TestNewPoolRejectsInvalidConfig(t *testing.T) {
+	_, err := NewPool(context.Background(), "postgres://holomush@127.0.0.1:1/holomush", PoolConfig{MaxConns: -1})
+	errutil.AssertErrorCode(t, err, "CONFIG_INVALID")
+}
+
+func TestNewQueryTracerOnlyWhenNeeded(t *testing.T) {
+	assert.Nil(t, newQueryTracer(PoolConfig{MaxConns: 4}))
+	assert.NotNil(t, newQueryTracer(PoolConfig{SlowQueryThreshold: time.Second}))
+	assert.NotNil(t, newQueryTracer(PoolConfig{QueryTimeouts: map[string]time.Duration{"world": time.Second}}))
+}
+
+func TestQueryTracerAppliesLongestRepositoryTimeout(t *testing.T) {
+	qt := newQueryTracer(PoolConfig{QueryTimeouts: map[string]time.Duration{
+		"world":           time.Hour,
+		"world/postgres/": time.Minute,
+	}})
+
+	for repo, want := range map[string]time.Duration{
+		"world/postgres": time.Minute,
+		"world/outbox":   time.Hour,
+		"worldcache":     0,
+		"":               0,
+	} {
+		qt.repository = func() string { return repo }
+		ctx := qt.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+		deadline, ok := ctx.Deadline()
+		if want == 0 {
+			assert.False(t, ok, "%q has no timeout", repo)
+		} else {
+			require.True(t, ok, "%q has a timeout", repo)
+			assert.WithinDuration(t, time.Now().Add(want), deadline, time.Minute/2, repo)
+		}
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+		if ok {
+			assert.ErrorIs(t, ctx.Err(), context.Canceled, "the end of the query releases its timeout")
+		}
+	}
+}
+
+func TestQueryTracerLogsSlowQueries(t *testing.T) {
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	qt := newQueryTracer(PoolConfig{SlowQueryThreshold: time.Second, SlowQueryDebugThreshold: 100 * time.Millisecond})
+	qt.repository = func() string { return "world/postgres" }
+	clock := time.Unix(0, 0)
+	qt.now = func() time.Time { return clock }
+
+	run := func(elapsed time.Duration) string {
+		buf.Reset()
+		ctx := qt.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT *\n\t FROM locations"})
+		clock = clock.Add(elapsed)
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+		return buf.String()
+	}
+
+	assert.Empty(t, run(10*time.Millisecond))
+	assert.Contains(t, run(200*time.Millisecond), "level=DEBUG")
+	out := run(2 * time.Second)
+	assert.Contains(t, out, "level=WARN")
+	assert.Contains(t, out, "repository=world/postgres")
+	assert.Contains(t, out, `sql="SELECT * FROM locations"`)
+}
+
+func TestRepositoryPackage(t *testing.T) {
+	tests := []struct {
+		function string
+		want     string
+		ok       bool
+	}{
+		{"github.com/holomush/holomush/internal/world/postgres.(*LocationRepository).Get", "world/postgres", true},
+		{"github.com/holomush/holomush/internal/store.(*PostgresEventStore).GetSystemInfo", "store", true},
+		{"github.com/holomush/holomush/internal/eventbus/history.queryRange.func1", "eventbus/history", true},
+		{"github.com/holomush/holomush/internal/store.(*queryTracer).TraceQueryStart", "", false},
+		{"github.com/holomush/holomush/internal/store.callerRepository", "", false},
+		{"github.com/jackc/pgx/v5.(*Conn).Query", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := repositoryPackage(tt.function)
+		assert.Equal(t, tt.ok, ok, tt.function)
+		assert.Equal(t, tt.want, got, tt.function)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -36,17 +35,18 @@ type PostgresEventStore struct {
 	pool poolIface
 }
 
-// NewPostgresEventStore creates a new PostgreSQL store.
+// NewPostgresEventStore creates a new PostgreSQL store on a pool with pgx
+// defaults.
 func NewPostgresEventStore(ctx context.Context, dsn string) (*PostgresEventStore, error) {
-	cfg, err := pgxpool.ParseConfig(dsn)
-	if err != nil {
-		return nil, oops.With("operation", "parse database config").Wrap(err)
-	}
-	cfg.ConnConfig.Tracer = otelpgx.NewTracer()
+	return NewPostgresEventStoreWithConfig(ctx, dsn, PoolConfig{})
+}
 
-	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+// NewPostgresEventStoreWithConfig creates a new PostgreSQL store on a pool
+// tuned by cfg.
+func NewPostgresEventStoreWithConfig(ctx context.Context, dsn string, cfg PoolConfig) (*PostgresEventStore, error) {
+	pool, err := NewPool(ctx, dsn, cfg)
 	if err != nil {
-		return nil, oops.With("operation", "connect to database").Wrap(err)
+		return nil, err
 	}
 	return &PostgresEventStore{pool: pool}, nil
 }
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package store
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// internalPkgPrefix is the import path prefix of the packages a query
+// timeout key can name.
+const internalPkgPrefix = "github.com/holomush/holomush/internal/"
+
+// maxLoggedSQLLen bounds the statement text carried by a slow-query log.
+const maxLoggedSQLLen = 200
+
+// queryTracer bounds queries by per-repository timeouts and logs the ones
+// that cross a slow-query threshold.
+type queryTracer struct {
+	timeouts  map[string]time.Duration
+	slowWarn  time.Duration
+	slowDebug time.Duration
+	now       func() time.Time
+	// repository resolves the repository package issuing the current
+	// query; tests replace it because their callers live outside internal/.
+	repository func() string
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	start  time.Time
+	sql    string
+	repo   string
+	cancel context.CancelFunc
+}
+
+// newQueryTracer returns a tracer for cfg, or nil when cfg sets neither a
+// query timeout nor a slow-query threshold.
+func newQueryTracer(cfg PoolConfig) *queryTracer {
+	if len(cfg.QueryTimeouts) == 0 && cfg.SlowQueryThreshold <= 0 && cfg.SlowQueryDebugThreshold <= 0 {
+		return nil
+	}
+	timeouts := make(map[string]time.Duration, len(cfg.QueryTimeouts))
+	for repo, d := range cfg.QueryTimeouts {
+		timeouts[strings.Trim(repo, "/")] = d
+	}
+	return &queryTracer{
+		timeouts:   timeouts,
+		slowWarn:   cfg.SlowQueryThreshold,
+		slowDebug:  cfg.SlowQueryDebugThreshold,
+		now:        time.Now,
+		repository: callerRepository,
+	}
+}
+
+// TraceQueryStart implements pgx.QueryTracer. The returned context carries
+// the repository's timeout, and pgx runs the query under it.
+func (t *queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	trace := &queryTrace{start: t.now(), sql: data.SQL}
+	if len(t.timeouts) > 0 {
+		trace.repo = t.repository()
+		if d, ok := t.timeoutFor(trace.repo); ok {
+			ctx, trace.cancel = context.WithTimeout(ctx, d)
+		}
+	}
+	return context.WithValue(ctx, queryTraceKey{}, trace)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (t *queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	if trace.cancel != nil {
+		trace.cancel()
+	}
+
+	elapsed := t.now().Sub(trace.start)
+	level := slog.LevelDebug
+	switch {
+	case t.slowWarn > 0 && elapsed >= t.slowWarn:
+		level = slog.LevelWarn
+	case t.slowDebug > 0 && elapsed >= t.slowDebug:
+	default:
+		return
+	}
+	if trace.repo == "" {
+		trace.repo = t.repository()
+	}
+	attrs := []any{
+		"duration", elapsed,
+		"repository", trace.repo,
+		"sql", compactSQL(trace.sql),
+	}
+	if data.Err != nil {
+		attrs = append(attrs, "error", data.Err)
+	}
+	slog.Log(ctx, level, "slow database query", attrs...)
+}
+
+// timeoutFor returns the timeout of the longest key covering repo.
+func (t *queryTracer) timeoutFor(repo string) (time.Duration, bool) {
+	for key := repo; key != ""; {
+		if d, ok := t.timeouts[key]; ok {
+			return d, true
+		}
+		i := strings.LastIndexByte(key, '/')
+		if i < 0 {
+			break
+		}
+		key = key[:i]
+	}
+	return 0, false
+}
+
+// callerRepository returns the package path under internal/ of the nearest
+// caller outside pgx and this file, or "" when the query came from
+// elsewhere.
+func callerRepository() string {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if pkg, ok := repositoryPackage(frame.Function); ok {
+			return pkg
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// repositoryPackage extracts the internal/ package path from a fully
+// qualified function name, skipping the tracer's own frames.
+func repositoryPackage(function string) (string, bool) {
+	rest, ok := strings.CutPrefix(function, internalPkgPrefix)
+	if !ok {
+		return "", false
+	}
+	slash := strings.LastIndexByte(rest, '/')
+	dot := strings.IndexByte(rest[slash+1:], '.')
+	if dot < 0 {
+		return "", false
+	}
+	pkg, fn := rest[:slash+1+dot], rest[slash+1+dot+1:]
+	if pkg == "store" && (strings.HasPrefix(fn, "(*queryTracer)") || fn == "callerRepository") {
+		return "", false
+	}
+	return pkg, true
+}
+
+// compactSQL collapses whitespace and truncates sql for logging.
+func compactSQL(sql string) string {
+	sql = strings.Join(strings.Fields(sql), " ")
+	if len(sql) > maxLoggedSQLLen {
+		sql = sql[:maxLoggedSQLLen] + "…"
+	}
+	return sql
+}
@@ -43,7 +43,7 @@ const sessionSelectColumns = `id, character_id, player_id,
 	is_guest, status, grid_present,
 	command_history, ttl_seconds, max_history,
 	detached_at, expires_at, created_at, updated_at,
-	last_paged, last_whispered,
+	last_paged, last_whispered, error_verbosity,
 	focus_memberships, presenting_focus`
 
 // parseSessionRow parses the scalar fields scanned from a session row into a
@@ -139,6 +139,7 @@ func scanSession(row pgx.Row) (*session.Info, error) {
 		&updatedAt,
 		&info.LastPaged,
 		&info.LastWhispered,
+		&info.ErrorVerbosity,
 		&focusMembershipsJSON,
 		&presentingFocusJSON,
 	)
@@ -200,6 +201,7 @@ func scanSessions(rows pgx.Rows) ([]*session.Info, error) {
 			&updatedAt,
 			&info.LastPaged,
 			&info.LastWhispered,
+			&info.ErrorVerbosity,
 			&focusMembershipsJSON,
 			&presentingFocusJSON,
 		)
@@ -485,6 +487,45 @@ func (s *PostgresSessionStore) ReattachCAS(ctx context.Context, id string) (bool
 	return tag.RowsAffected() == 1, nil
 }
 
+// SetReconnectTokenHash stores the hash of a session's reconnect token,
+// replacing any token issued before.
+func (s *PostgresSessionStore) SetReconnectTokenHash(ctx context.Context, id, tokenHash string) error {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE sessions SET reconnect_token_hash = $1, updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT WHERE id = $2`,
+		tokenHash, id)
+	if err != nil {
+		return oops.With("operation", "set reconnect token").With("session_id", id).Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("SESSION_NOT_FOUND").With("session_id", id).Errorf("session not found")
+	}
+	return nil
+}
+
+// RotateReconnectToken swaps the reconnect token hashed presentedHash for
+// newHash in one UPDATE, so concurrent resumes with the same token cannot
+// both win. Expired sessions, and detached sessions past their expiry that
+// the reaper has not swept yet, do not match.
+func (s *PostgresSessionStore) RotateReconnectToken(ctx context.Context, presentedHash, newHash string) (*session.Info, error) {
+	if presentedHash == "" || newHash == "" {
+		return nil, oops.Code("INVALID_ARGUMENT").Errorf("reconnect token hashes must not be empty")
+	}
+	row := s.pool.QueryRow(ctx,
+		`UPDATE sessions SET reconnect_token_hash = $1, updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+		WHERE reconnect_token_hash = $2 AND status <> 'expired'
+		  AND (expires_at IS NULL OR expires_at >= (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT)
+		RETURNING `+sessionSelectColumns,
+		newHash, presentedHash)
+	info, err := scanSession(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("SESSION_NOT_FOUND").Wrap(err)
+	}
+	if err != nil {
+		return nil, oops.With("operation", "rotate reconnect token").Wrap(err)
+	}
+	return info, nil
+}
+
 // AppendCommand adds a command to the session's history, enforcing the cap.
 func (s *PostgresSessionStore) AppendCommand(ctx context.Context, id, command string, maxHistory int) error {
 	_, err := s.pool.Exec(ctx,
@@ -877,6 +918,21 @@ func (s *PostgresSessionStore) UpdateLastWhispered(ctx context.Context, sessionI
 	return nil
 }
 
+// SetErrorVerbosity records how much of a failed command's error the
+// session is shown.
+func (s *PostgresSessionStore) SetErrorVerbosity(ctx context.Context, sessionID, verbosity string) error {
+	tag, err := s.pool.Exec(ctx,
+		`UPDATE sessions SET error_verbosity = $1, updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT WHERE id = $2`,
+		verbosity, sessionID)
+	if err != nil {
+		return oops.With("operation", "set error verbosity").With("session_id", sessionID).Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return oops.Code("SESSION_NOT_FOUND").With("session_id", sessionID).Errorf("session not found")
+	}
+	return nil
+}
+
 // UpdateFocusMemberships atomically applies the mutator callback to the
 // session's focus memberships and presenting focus. Uses a transaction
 // to ensure atomicity: reads current state, calls the mutator, and writes
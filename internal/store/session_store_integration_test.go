@@ -18,6 +18,7 @@ import (
 
 	"github.com/holomush/holomush/internal/session"
 	"github.com/holomush/holomush/internal/store"
+	"github.com/holomush/holomush/pkg/errutil"
 )
 
 func TestSessionConnectionsHasFocusKeyColumn(t *testing.T) {
@@ -319,3 +320,73 @@ func TestPostgresRemoveConnectionAndCountConcurrentLastRemoveExactlyOneObservesZ
 			"holomush-cizj: concurrent last-removes MUST serialize so exactly one observes Total==0")
 	}
 }
+
+func TestPostgresRotateReconnectToken(t *testing.T) {
+	t.Parallel()
+	pool := freshMigratedPool(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := store.NewPostgresSessionStore(pool)
+
+	sessionID := "sess-pg-reconnect"
+	require.NoError(t, s.Set(ctx, sessionID, &session.Info{ID: sessionID, CharacterName: "Alice", Status: session.StatusActive}))
+	require.NoError(t, s.SetReconnectTokenHash(ctx, sessionID, "hash-1"))
+
+	info, err := s.RotateReconnectToken(ctx, "hash-1", "hash-2")
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, info.ID)
+	assert.Equal(t, "Alice", info.CharacterName)
+
+	_, err = s.RotateReconnectToken(ctx, "hash-1", "hash-3")
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+
+	past := time.Now().Add(-time.Minute)
+	require.NoError(t, s.UpdateStatus(ctx, sessionID, session.StatusDetached, &past, &past))
+	_, err = s.RotateReconnectToken(ctx, "hash-2", "hash-3")
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, s.UpdateStatus(ctx, sessionID, session.StatusDetached, &past, &future))
+	info, err = s.RotateReconnectToken(ctx, "hash-2", "hash-3")
+	require.NoError(t, err)
+	assert.Equal(t, session.StatusDetached, info.Status)
+
+	err = s.SetReconnectTokenHash(ctx, "sess-pg-missing", "hash-4")
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+}
+
+func TestPostgresSetErrorVerbosity(t *testing.T) {
+	t.Parallel()
+	pool := freshMigratedPool(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	s := store.NewPostgresSessionStore(pool)
+
+	sessionID := "sess-pg-verbosity"
+	require.NoError(t, s.Set(ctx, sessionID, &session.Info{ID: sessionID, CharacterName: "Alice", Status: session.StatusActive}))
+
+	info, err := s.Get(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "terse", info.ErrorVerbosity)
+
+	require.NoError(t, s.SetErrorVerbosity(ctx, sessionID, "verbose"))
+	info, err = s.Get(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "verbose", info.ErrorVerbosity)
+
+	// Set leaves the verbosity alone.
+	info.CharacterName = "Alicia"
+	require.NoError(t, s.Set(ctx, sessionID, info))
+	info, err = s.Get(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "verbose", info.ErrorVerbosity)
+
+	require.Error(t, s.SetErrorVerbosity(ctx, sessionID, "loud"), "check constraint rejects unknown levels")
+
+	err = s.SetErrorVerbosity(ctx, "sess-pg-missing", "verbose")
+	errutil.AssertErrorCode(t, err, "SESSION_NOT_FOUND")
+}
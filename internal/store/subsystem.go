@@ -18,7 +18,11 @@ type SubsystemConfig struct {
 	// DatabaseURL is the PostgreSQL connection string.
 	DatabaseURL string
 
-	// EventStoreFactory creates an event store. If nil, uses NewPostgresEventStore.
+	// Pool tunes the connection pool; the zero value keeps pgx defaults.
+	Pool PoolConfig
+
+	// EventStoreFactory creates an event store. If nil, uses
+	// NewPostgresEventStoreWithConfig with Pool.
 	EventStoreFactory func(ctx context.Context, url string) (*PostgresEventStore, error)
 }
 
@@ -57,7 +61,9 @@ func (s *DatabaseSubsystem) Prepare(ctx context.Context) error {
 
 	factory := s.cfg.EventStoreFactory
 	if factory == nil {
-		factory = NewPostgresEventStore
+		factory = func(ctx context.Context, url string) (*PostgresEventStore, error) {
+			return NewPostgresEventStoreWithConfig(ctx, url, s.cfg.Pool)
+		}
 	}
 
 	es, err := factory(ctx, s.cfg.DatabaseURL)
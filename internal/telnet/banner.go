@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import "sync/atomic"
+
+// DefaultBanner is the greeting sent when a connection opens and no banner
+// is configured.
+const DefaultBanner = "Welcome to HoloMUSH!"
+
+// Banner holds the connect greeting shared by every new connection. The
+// gateway replaces it on a config reload; connections already open keep the
+// greeting they were sent. A nil or zero Banner serves DefaultBanner. Safe
+// for concurrent use.
+type Banner struct {
+	text atomic.Pointer[string]
+}
+
+// NewBanner returns a Banner serving text.
+func NewBanner(text string) *Banner {
+	b := &Banner{}
+	b.Set(text)
+	return b
+}
+
+// Set replaces the greeting. An empty text restores DefaultBanner.
+func (b *Banner) Set(text string) {
+	b.text.Store(&text)
+}
+
+// Text returns the current greeting.
+func (b *Banner) Text() string {
+	if b == nil {
+		return DefaultBanner
+	}
+	if text := b.text.Load(); text != nil && *text != "" {
+		return *text
+	}
+	return DefaultBanner
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	contentv1 "github.com/holomush/holomush/pkg/proto/holomush/content/v1"
+)
+
+func TestBannerText(t *testing.T) {
+	var nilBanner *Banner
+	assert.Equal(t, DefaultBanner, nilBanner.Text())
+	assert.Equal(t, DefaultBanner, (&Banner{}).Text())
+
+	b := NewBanner("Welcome to the Crossroads.")
+	assert.Equal(t, "Welcome to the Crossroads.", b.Text())
+
+	b.Set("")
+	assert.Equal(t, DefaultBanner, b.Text(), "an empty banner restores the default")
+}
+
+func TestGatewayHandler_SendsConfiguredBanner(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	banner := NewBanner("Welcome to the Crossroads.\nMind the fog.")
+	handler := newTestHandler(serverConn, &mockCoreClient{subErr: errors.New("no subscribe in this test")})
+	handler.SetBanner(banner)
+	// A change after the handler is built still reaches the greeting:
+	// Handle reads the banner when the connection opens.
+	banner.Set("Welcome to the Crossroads.\nThe fog has lifted.")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	lines := readLines(t, bufio.NewReader(clientConn), 3)
+	assert.Equal(t, []string{"Welcome to the Crossroads.", "The fog has lifted.", "Use: connect guest"}, lines)
+
+	cancel()
+	<-done
+}
+
+// stubScreens is a ConnectScreenSource returning fixed items.
+type stubScreens struct {
+	items []*contentv1.ContentItem
+	err   error
+	req   *contentv1.ListContentRequest
+}
+
+func (s *stubScreens) ListContent(_ context.Context, req *contentv1.ListContentRequest) (*contentv1.ListContentResponse, error) {
+	s.req = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &contentv1.ListContentResponse{Items: s.items}, nil
+}
+
+func TestGatewayHandler_SendsConnectScreen(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	screens := &stubScreens{items: []*contentv1.ContentItem{{
+		Key:      "motd.screen.winter",
+		Body:     []byte("%xcSnow%xn"),
+		Metadata: map[string]string{"ansi": "\x1b[36mSnow\x1b[0m\x07"},
+	}}}
+	handler := newTestHandler(serverConn, &mockCoreClient{subErr: errors.New("no subscribe in this test")})
+	handler.SetBanner(NewBanner("Unused banner."))
+	handler.SetConnectScreens(screens)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	lines := readLines(t, bufio.NewReader(clientConn), 2)
+	assert.Equal(t, []string{"\x1b[36mSnow\x1b[0m", "Use: connect guest"}, lines,
+		"colors survive while other control characters are stripped")
+	assert.Equal(t, "motd.screen.", screens.req.GetPrefix())
+
+	cancel()
+	<-done
+}
+
+func TestConnectScreen(t *testing.T) {
+	h := &GatewayHandler{}
+	assert.Empty(t, h.connectScreen(context.Background()), "no source")
+
+	h.SetConnectScreens(&stubScreens{})
+	assert.Empty(t, h.connectScreen(context.Background()), "no screens defined")
+
+	h.SetConnectScreens(&stubScreens{err: errors.New("core down")})
+	assert.Empty(t, h.connectScreen(context.Background()), "errors fall back to the banner")
+
+	h.SetConnectScreens(&stubScreens{items: []*contentv1.ContentItem{{Body: []byte("Plain screen")}}})
+	assert.Equal(t, "Plain screen", h.connectScreen(context.Background()), "the body is used without an ANSI rendering")
+}
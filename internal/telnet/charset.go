@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Charset is the byte encoding spoken on a telnet connection. Text inside
+// HoloMUSH is always UTF-8; the gateway transcodes at the wire boundary so
+// legacy clients that only understand a single-byte code page see correct
+// characters instead of mojibake.
+type Charset uint32
+
+// Supported connection charsets. The zero value is UTF-8.
+const (
+	CharsetUTF8 Charset = iota
+	CharsetLatin1
+	CharsetCP437
+)
+
+// replacementByte stands in for characters the connection charset cannot
+// represent. '?' is used rather than the charmap's SUB (0x1A) because SUB
+// is a control character that terminals render inconsistently.
+const replacementByte = '?'
+
+// supportedCharsets lists the charsets offered during CHARSET negotiation,
+// in preference order.
+var supportedCharsets = []Charset{CharsetUTF8, CharsetLatin1, CharsetCP437}
+
+// String returns the IANA name of the charset, as exchanged during
+// negotiation.
+func (c Charset) String() string {
+	switch c {
+	case CharsetLatin1:
+		return "ISO-8859-1"
+	case CharsetCP437:
+		return "IBM437"
+	default:
+		return "UTF-8"
+	}
+}
+
+// ParseCharset resolves a charset name, as sent by a client during
+// negotiation or typed by a player, to a supported Charset. Matching is
+// case-insensitive and accepts the common aliases.
+func ParseCharset(name string) (Charset, bool) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "UTF-8", "UTF8":
+		return CharsetUTF8, true
+	case "ISO-8859-1", "ISO8859-1", "ISO_8859-1", "LATIN1", "LATIN-1", "L1":
+		return CharsetLatin1, true
+	case "IBM437", "CP437", "437", "IBM-437":
+		return CharsetCP437, true
+	default:
+		return CharsetUTF8, false
+	}
+}
+
+func (c Charset) charmap() *charmap.Charmap {
+	switch c {
+	case CharsetLatin1:
+		return charmap.ISO8859_1
+	case CharsetCP437:
+		return charmap.CodePage437
+	default:
+		return nil
+	}
+}
+
+// Encode converts UTF-8 text to the connection charset for writing to the
+// wire. Characters the charset cannot represent become '?', and a data
+// byte equal to IAC (0xFF) is doubled as the telnet protocol requires.
+func (c Charset) Encode(s string) []byte {
+	cm := c.charmap()
+	if cm == nil {
+		return []byte(s)
+	}
+	out := make([]byte, 0, len(s))
+	for _, r := range s {
+		b, ok := cm.EncodeRune(r)
+		if !ok || r == utf8.RuneError {
+			b = replacementByte
+		}
+		out = append(out, b)
+		if b == iac {
+			out = append(out, iac)
+		}
+	}
+	return out
+}
+
+// decodeByte appends the UTF-8 form of a single data byte received in the
+// connection charset. UTF-8 connections pass bytes through unchanged.
+func (c Charset) decodeByte(dst []byte, b byte) []byte {
+	cm := c.charmap()
+	if cm == nil {
+		return append(dst, b)
+	}
+	return utf8.AppendRune(dst, cm.DecodeByte(b))
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCharset(t *testing.T) {
+	tests := []struct {
+		name string
+		want Charset
+		ok   bool
+	}{
+		{"UTF-8", CharsetUTF8, true},
+		{"utf8", CharsetUTF8, true},
+		{"ISO-8859-1", CharsetLatin1, true},
+		{" latin1 ", CharsetLatin1, true},
+		{"IBM437", CharsetCP437, true},
+		{"cp437", CharsetCP437, true},
+		{"KOI8-R", CharsetUTF8, false},
+		{"", CharsetUTF8, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseCharset(tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCharsetEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		charset Charset
+		in      string
+		want    []byte
+	}{
+		{"utf-8 passes through", CharsetUTF8, "café ☕", []byte("café ☕")},
+		{"latin-1 maps accents", CharsetLatin1, "café", []byte{'c', 'a', 'f', 0xE9}},
+		{"latin-1 replaces unmappable", CharsetLatin1, "tea ☕", []byte("tea ?")},
+		{"latin-1 doubles IAC", CharsetLatin1, "ÿ", []byte{0xFF, 0xFF}},
+		{"cp437 maps box drawing", CharsetCP437, "╔═╗", []byte{0xC9, 0xCD, 0xBB}},
+		{"cp437 replaces unmappable", CharsetCP437, "€", []byte("?")},
+		{"invalid utf-8 is replaced", CharsetLatin1, "a\xffb", []byte("a?b")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.charset.Encode(tt.in))
+		})
+	}
+}
+
+func TestCharsetDecodeByte(t *testing.T) {
+	assert.Equal(t, "é", string(CharsetLatin1.decodeByte(nil, 0xE9)))
+	assert.Equal(t, "╔", string(CharsetCP437.decodeByte(nil, 0xC9)))
+	assert.Equal(t, []byte{0xC3}, CharsetUTF8.decodeByte(nil, 0xC3))
+}
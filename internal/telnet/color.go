@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/holomush/holomush/pkg/holo/ansicolor"
+)
+
+// TERMINAL-TYPE option codes (RFC 1091).
+const (
+	optTType byte = 24
+
+	ttypeIs   byte = 0
+	ttypeSend byte = 1
+
+	// maxTTypeReplies bounds how many times the reader asks for the next
+	// terminal type. MTTS clients answer with their name, their terminal,
+	// then "MTTS <bits>"; a client that cycles forever stops here.
+	maxTTypeReplies = 3
+)
+
+// MTTS (MUD Terminal Type Standard) capability bits reported in the
+// third TERMINAL-TYPE reply.
+const (
+	mttsANSI      = 1
+	mtts256Colors = 8
+	mttsTruecolor = 256
+)
+
+// mttsPrefix introduces an MTTS capability reply.
+const mttsPrefix = "MTTS "
+
+// defaultColorDepth is the color depth of a connection whose terminal
+// type is unknown. 256-color codes have always reached telnet clients
+// as-is, so only truecolor is downgraded until a client says otherwise.
+const defaultColorDepth = ansicolor.Depth256
+
+// colorState holds a connection's color depth: the value detected through
+// TERMINAL-TYPE negotiation and an optional player override that takes
+// precedence. Like charsetState it is shared by the read goroutine and
+// the handler goroutine.
+type colorState struct {
+	mu       sync.Mutex
+	detected *ansicolor.Depth
+	override *ansicolor.Depth
+}
+
+// Current returns the color depth in effect for the connection. A nil
+// state, or one with nothing detected or pinned, is defaultColorDepth.
+func (s *colorState) Current() ansicolor.Depth {
+	if s == nil {
+		return defaultColorDepth
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case s.override != nil:
+		return *s.override
+	case s.detected != nil:
+		return *s.detected
+	}
+	return defaultColorDepth
+}
+
+// Overridden reports whether the player pinned the color depth explicitly.
+func (s *colorState) Overridden() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.override != nil
+}
+
+func (s *colorState) setDetected(d ansicolor.Depth) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.detected = &d
+}
+
+// setOverride pins the color depth; nil returns to the detected value.
+func (s *colorState) setOverride(d *ansicolor.Depth) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.override = d
+}
+
+// terminalDepth returns the color depth a TERMINAL-TYPE reply implies.
+// final is true for an MTTS reply, which states the client's
+// capabilities outright and ends the negotiation.
+func terminalDepth(name string) (depth ansicolor.Depth, final bool) {
+	upper := strings.ToUpper(strings.TrimSpace(name))
+	if rest, ok := strings.CutPrefix(upper, mttsPrefix); ok {
+		if bits, err := strconv.Atoi(rest); err == nil {
+			switch {
+			case bits&mttsTruecolor != 0:
+				return ansicolor.DepthTrue, true
+			case bits&mtts256Colors != 0:
+				return ansicolor.Depth256, true
+			case bits&mttsANSI != 0:
+				return ansicolor.Depth16, true
+			}
+			return ansicolor.DepthNone, true
+		}
+	}
+	switch {
+	case strings.Contains(upper, "TRUECOLOR"), strings.Contains(upper, "24BIT"):
+		return ansicolor.DepthTrue, false
+	case strings.Contains(upper, "256COLOR"):
+		return ansicolor.Depth256, false
+	}
+	// Every terminal a MU* client emulates shows the 16 basic colors.
+	return ansicolor.Depth16, false
+}
+
+// offerTerminalType asks the client for its terminal type (IAC DO
+// TERMINAL-TYPE). A client that answers WILL is sent SEND requests until
+// it repeats itself, reports MTTS, or maxTTypeReplies is reached, and the
+// connection's color depth follows its answers. Requires an attached
+// colorState. Call before the read goroutine starts.
+func (r *telnetReader) offerTerminalType() {
+	if r.color == nil {
+		return
+	}
+	r.offeredTType = true
+	r.reply([]byte{iac, do, optTType})
+}
+
+// negotiateTType handles WILL/WONT TERMINAL-TYPE. The server never sends
+// its own terminal type, so a DO is refused by negotiate like any
+// unsupported option.
+func (r *telnetReader) negotiateTType(verb byte) {
+	switch verb {
+	case will:
+		if r.remoteTType {
+			return
+		}
+		r.remoteTType = true
+		if !r.offeredTType {
+			r.reply([]byte{iac, do, optTType})
+		}
+		r.offeredTType = false
+		r.sendTTypeRequest()
+	case wont:
+		if r.remoteTType {
+			r.remoteTType = false
+			r.reply([]byte{iac, dont, optTType})
+		}
+		r.offeredTType = false
+	}
+}
+
+// sendTTypeRequest sends IAC SB TERMINAL-TYPE SEND IAC SE.
+func (r *telnetReader) sendTTypeRequest() {
+	r.ttypeReplies++
+	r.reply([]byte{iac, sb, optTType, ttypeSend, iac, se})
+}
+
+// terminalType takes up one TERMINAL-TYPE IS reply. The deepest color
+// support among the replies wins, except that an MTTS reply is taken as
+// stated. A repeated reply means the client has no more types to report.
+func (r *telnetReader) terminalType(name string) {
+	if !r.remoteTType || r.ttypeDone {
+		return
+	}
+	if r.ttypeDepth != nil && name == r.lastTType {
+		r.ttypeDone = true
+		RecordColorDepthNegotiated(*r.ttypeDepth)
+		return
+	}
+	r.lastTType = name
+	depth, final := terminalDepth(name)
+	if !final && r.ttypeDepth != nil {
+		depth = max(depth, *r.ttypeDepth)
+	}
+	r.ttypeDepth = &depth
+	r.color.setDetected(depth)
+	if final || r.ttypeReplies >= maxTTypeReplies {
+		r.ttypeDone = true
+		RecordColorDepthNegotiated(depth)
+		return
+	}
+	r.sendTTypeRequest()
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/holo/ansicolor"
+)
+
+// newTTypeReader returns a telnetReader over input with TERMINAL-TYPE
+// enabled, recording every negotiation reply.
+func newTTypeReader(input []byte) (*telnetReader, *colorState, *bytes.Buffer) {
+	r, _, replies := newRecordingReader(input)
+	r.color = &colorState{}
+	return r, r.color, replies
+}
+
+func ttypeIsSub(name string) []byte {
+	return append(append([]byte{iac, sb, optTType, ttypeIs}, name...), iac, se)
+}
+
+var ttypeSendSub = []byte{iac, sb, optTType, ttypeSend, iac, se}
+
+func TestTerminalDepth(t *testing.T) {
+	tests := []struct {
+		name      string
+		want      ansicolor.Depth
+		wantFinal bool
+	}{
+		{"MTTS 2317", ansicolor.DepthTrue, true},
+		{"MTTS 9", ansicolor.Depth256, true},
+		{"mtts 1", ansicolor.Depth16, true},
+		{"MTTS 0", ansicolor.DepthNone, true},
+		{"XTERM-256COLOR", ansicolor.Depth256, false},
+		{"xterm-truecolor", ansicolor.DepthTrue, false},
+		{"MUDLET", ansicolor.Depth16, false},
+		{"MTTS junk", ansicolor.Depth16, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, final := terminalDepth(tt.name)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantFinal, final)
+		})
+	}
+}
+
+func TestTelnetReaderTerminalTypeMTTS(t *testing.T) {
+	// Client answers our DO with WILL, then reports its name, terminal and
+	// MTTS bits across three SEND requests.
+	input := []byte{iac, will, optTType}
+	input = append(input, ttypeIsSub("MUDLET")...)
+	input = append(input, ttypeIsSub("ANSI-TRUECOLOR")...)
+	input = append(input, ttypeIsSub("MTTS 9")...)
+
+	r, state, replies := newTTypeReader(input)
+	r.offerTerminalType()
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	want := []byte{iac, do, optTType}
+	want = append(want, ttypeSendSub...)
+	want = append(want, ttypeSendSub...)
+	want = append(want, ttypeSendSub...)
+	assert.Equal(t, want, replies.Bytes(), "a WILL answering our offer must not repeat DO")
+	assert.Equal(t, ansicolor.Depth256, state.Current(), "an MTTS reply is taken as stated")
+}
+
+func TestTelnetReaderTerminalTypeStopsOnRepeat(t *testing.T) {
+	input := []byte{iac, will, optTType}
+	input = append(input, ttypeIsSub("XTERM-256COLOR")...)
+	input = append(input, ttypeIsSub("XTERM-256COLOR")...)
+
+	r, state, replies := newTTypeReader(input)
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	want := []byte{iac, do, optTType}
+	want = append(want, ttypeSendSub...)
+	want = append(want, ttypeSendSub...)
+	assert.Equal(t, want, replies.Bytes())
+	assert.Equal(t, ansicolor.Depth256, state.Current())
+}
+
+func TestTelnetReaderTerminalTypeIgnoresUnrequestedReply(t *testing.T) {
+	r, state, replies := newTTypeReader(ttypeIsSub("MTTS 0"))
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Empty(t, replies.Bytes())
+	assert.Equal(t, defaultColorDepth, state.Current())
+}
+
+func TestTelnetReaderRefusesTerminalTypeWithoutColorState(t *testing.T) {
+	r, _, replies := newRecordingReader([]byte{iac, will, optTType})
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, dont, optTType}, replies.Bytes())
+}
+
+func TestColorOverrideTakesPrecedence(t *testing.T) {
+	state := &colorState{}
+	assert.Equal(t, defaultColorDepth, state.Current())
+
+	state.setDetected(ansicolor.DepthTrue)
+	none := ansicolor.DepthNone
+	state.setOverride(&none)
+	assert.Equal(t, ansicolor.DepthNone, state.Current())
+	assert.True(t, state.Overridden())
+
+	state.setDetected(ansicolor.Depth16)
+	assert.Equal(t, ansicolor.DepthNone, state.Current(), "detection must not undo a player override")
+
+	state.setOverride(nil)
+	assert.Equal(t, ansicolor.Depth16, state.Current())
+}
+
+// TestGatewayHandler_ColorDepthCommand verifies the COLORDEPTH command pins
+// the connection color depth and that styled output is downgraded to it.
+func TestGatewayHandler_ColorDepthCommand(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler := newTestHandler(serverConn, &mockCoreClient{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	r := bufio.NewReader(clientConn)
+	readLines(t, r, 2) // banner
+
+	_, err := clientConn.Write([]byte("colordepth\n"))
+	require.NoError(t, err)
+	assert.Contains(t, readLines(t, r, 1)[0], "Color depth: 256 (detected)")
+
+	// net.Pipe writes block until read, so send from another goroutine.
+	go handler.sendStyled("\x1b[38;2;255;0;0mred")
+	assert.Equal(t, "\x1b[38;5;196mred\x1b[0m", readLines(t, r, 1)[0])
+
+	_, err = clientConn.Write([]byte("colordepth rainbow\n"))
+	require.NoError(t, err)
+	assert.Contains(t, readLines(t, r, 1)[0], `Unknown color depth "rainbow"`)
+
+	_, err = clientConn.Write([]byte("COLORDEPTH 16\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Color depth set to 16.", readLines(t, r, 1)[0])
+
+	go handler.sendStyled("\x1b[38;2;255;0;0mred")
+	assert.Equal(t, "\x1b[91mred\x1b[0m", readLines(t, r, 1)[0])
+
+	_, err = clientConn.Write([]byte("colordepth auto\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Color depth: 256 (detected).", readLines(t, r, 1)[0])
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"compress/zlib"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// mccpStream is a connection's MCCP2 output: one zlib stream that lasts
+// until the client turns compression off or the connection closes. Every
+// write is sync-flushed so a line reaches the client as soon as it is
+// sent rather than when the compressor's window fills.
+type mccpStream struct {
+	zw   *zlib.Writer
+	wire *countingWriter
+	raw  int64
+}
+
+func newMCCPStream(dst io.Writer) *mccpStream {
+	wire := &countingWriter{w: dst}
+	return &mccpStream{zw: zlib.NewWriter(wire), wire: wire}
+}
+
+// Write compresses b and flushes it to the connection.
+func (s *mccpStream) Write(b []byte) (int, error) {
+	wireBefore := s.wire.n
+	n, err := s.zw.Write(b)
+	s.raw += int64(n)
+	if err == nil {
+		err = s.zw.Flush()
+	}
+	RecordCompressedWrite(n, s.wire.n-wireBefore)
+	if err != nil {
+		return n, oops.Code("TELNET_COMPRESS_FAILED").Wrap(err)
+	}
+	return n, nil
+}
+
+// Close ends the zlib stream, so the client returns to reading plain
+// bytes, and records the stream's overall compression ratio.
+func (s *mccpStream) Close() error {
+	wireBefore := s.wire.n
+	err := s.zw.Close()
+	RecordCompressedWrite(0, s.wire.n-wireBefore)
+	if s.wire.n > 0 {
+		RecordCompressionRatio(float64(s.raw) / float64(s.wire.n))
+	}
+	if err != nil {
+		return oops.Code("TELNET_COMPRESS_FAILED").Wrap(err)
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err //nolint:wrapcheck // transparent io.Writer wrapper
+}
+
+// EnableCompression makes Handle offer MCCP2 (TELNET option COMPRESS2)
+// when the connection opens. A client that accepts receives all later
+// output zlib-compressed; one that refuses or ignores the offer keeps
+// plain output. Call before Handle.
+func (h *GatewayHandler) EnableCompression() {
+	h.offerCompress = true
+	h.telnet.compress = h
+}
+
+// startCompression sends the COMPRESS2 start marker and switches the
+// connection's output to a zlib stream. The marker is the last plain byte
+// sequence; everything after it, negotiation replies included, is
+// compressed. Called from the read goroutine.
+func (h *GatewayHandler) startCompression() {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	if h.mccp != nil {
+		return
+	}
+	if err := h.writeLocked([]byte{iac, sb, optCompress2, iac, se}); err != nil {
+		slog.Debug("gateway: failed to start compression", "error", err)
+		return
+	}
+	h.mccp = newMCCPStream(h.conn)
+	RecordCompressionNegotiated(true)
+}
+
+// stopCompression ends the zlib stream and acknowledges the client's DONT
+// COMPRESS2 in plain text. Called from the read goroutine.
+func (h *GatewayHandler) stopCompression() {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	if !h.endCompressionLocked() {
+		return
+	}
+	if err := h.writeLocked([]byte{iac, wont, optCompress2}); err != nil {
+		slog.Debug("gateway: failed to send message", "error", err)
+	}
+}
+
+// endCompression ends an active zlib stream before the connection closes,
+// so the client sees a complete stream and the ratio is recorded.
+func (h *GatewayHandler) endCompression() {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	h.endCompressionLocked()
+}
+
+// endCompressionLocked closes the zlib stream, if any, and reports
+// whether one was active. The caller holds writeMu.
+func (h *GatewayHandler) endCompressionLocked() bool {
+	if h.mccp == nil {
+		return false
+	}
+	s := h.mccp
+	h.mccp = nil
+	if err := h.conn.SetWriteDeadline(time.Now().Add(h.limits.WriteTimeout)); err != nil {
+		slog.Debug("gateway: failed to set write deadline", "error", err)
+	}
+	if err := s.Close(); err != nil {
+		slog.Debug("gateway: failed to end compression", "error", err)
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingCompression is a compressionControl that records its calls.
+type recordingCompression struct {
+	calls []string
+}
+
+func (c *recordingCompression) startCompression() { c.calls = append(c.calls, "start") }
+func (c *recordingCompression) stopCompression()  { c.calls = append(c.calls, "stop") }
+
+func TestTelnetReaderNegotiatesCompression(t *testing.T) {
+	tests := []struct {
+		name    string
+		offer   bool
+		input   []byte
+		replies []byte
+		calls   []string
+	}{
+		{"accepted offer starts compression", true, []byte{iac, do, optCompress2}, []byte{iac, will, optCompress2}, []string{"start"}},
+		{"refused offer keeps plain output", true, []byte{iac, dont, optCompress2}, []byte{iac, will, optCompress2}, nil},
+		{"unsolicited DO is answered WILL", false, []byte{iac, do, optCompress2}, []byte{iac, will, optCompress2}, []string{"start"}},
+		{"repeated DO is ignored", true, []byte{iac, do, optCompress2, iac, do, optCompress2}, []byte{iac, will, optCompress2}, []string{"start"}},
+		{"DONT ends an active stream", true, []byte{iac, do, optCompress2, iac, dont, optCompress2}, []byte{iac, will, optCompress2}, []string{"start", "stop"}},
+		{"client-side compression is refused", false, []byte{iac, will, optCompress2}, []byte{iac, dont, optCompress2}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _, replies := newRecordingReader(tt.input)
+			control := &recordingCompression{}
+			r.compress = control
+			if tt.offer {
+				r.offerCompression()
+			}
+			_, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, tt.replies, replies.Bytes())
+			assert.Equal(t, tt.calls, control.calls)
+		})
+	}
+}
+
+func TestTelnetReaderRefusesCompressionWithoutControl(t *testing.T) {
+	r, _, replies := newRecordingReader([]byte{iac, do, optCompress2})
+	r.offerCompression()
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, wont, optCompress2}, replies.Bytes(), "no offer is made and DO is refused")
+}
+
+func TestMCCPStreamRoundTripsAndRecordsRatio(t *testing.T) {
+	ratiosBefore := ratioSamples(t)
+	rawBefore := testutil.ToFloat64(compressionUncompressedBytes)
+	wireBefore := testutil.ToFloat64(compressionCompressedBytes)
+
+	var wire bytes.Buffer
+	s := newMCCPStream(&wire)
+	line := strings.Repeat("A long room description repeats itself. ", 20) + "\n"
+	for range 3 {
+		_, err := s.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.Close())
+
+	zr, err := zlib.NewReader(&wire)
+	require.NoError(t, err)
+	got, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat(line, 3), string(got))
+
+	assert.Equal(t, rawBefore+float64(3*len(line)), testutil.ToFloat64(compressionUncompressedBytes))
+	assert.Equal(t, wireBefore+float64(s.wire.n), testutil.ToFloat64(compressionCompressedBytes))
+	assert.Less(t, s.wire.n, int64(len(line)), "repetitive output compresses")
+	assert.Equal(t, ratiosBefore+1, ratioSamples(t), "closing the stream observes its ratio")
+}
+
+func ratioSamples(t *testing.T) uint64 {
+	t.Helper()
+	var m dto.Metric
+	require.NoError(t, CompressionRatio.(prometheus.Metric).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+// TestGatewayHandler_Compression verifies an enabled handler offers
+// COMPRESS2, compresses output once the client accepts, and returns to
+// plain output when the client turns it off.
+func TestGatewayHandler_Compression(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	enabledBefore := testutil.ToFloat64(CompressionNegotiatedTotal.WithLabelValues("enabled"))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler := newTestHandler(serverConn, &mockCoreClient{})
+	handler.EnableCompression()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	r := bufio.NewReader(clientConn)
+	offer := make([]byte, 3)
+	_, err := io.ReadFull(r, offer)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, will, optCompress2}, offer)
+	readLines(t, r, 2) // banner
+
+	_, err = clientConn.Write([]byte{iac, do, optCompress2})
+	require.NoError(t, err)
+	marker := make([]byte, 5)
+	_, err = io.ReadFull(r, marker)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, sb, optCompress2, iac, se}, marker)
+
+	// net.Pipe writes block until read, so send from another goroutine.
+	go handler.send("The description is long.")
+	zr, err := zlib.NewReader(r)
+	require.NoError(t, err)
+	zlines := bufio.NewReader(zr)
+	assert.Equal(t, "The description is long.", readLines(t, zlines, 1)[0])
+	assert.Equal(t, enabledBefore+1, testutil.ToFloat64(CompressionNegotiatedTotal.WithLabelValues("enabled")))
+
+	go func() {
+		_, _ = clientConn.Write([]byte{iac, dont, optCompress2})
+	}()
+	rest, err := io.ReadAll(zlines)
+	require.NoError(t, err, "DONT ends the zlib stream cleanly")
+	assert.Empty(t, rest)
+	ack := make([]byte, 3)
+	_, err = io.ReadFull(r, ack)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, wont, optCompress2}, ack)
+
+	go handler.send("Plain again.")
+	assert.Equal(t, "Plain again.", readLines(t, r, 1)[0])
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"context"
+	"crypto/rand"
+	"log/slog"
+	"math/big"
+
+	contentv1 "github.com/holomush/holomush/pkg/proto/holomush/content/v1"
+)
+
+// Connect screens are content items written by the core's motd package.
+// The gateway reads them over the ContentService, so it needs no login and
+// no dependency on the core's packages.
+const (
+	// connectScreenPrefix is the content key prefix of every connect screen
+	// (motd.ScreenKeyPrefix).
+	connectScreenPrefix = "motd.screen."
+	// connectScreenANSIKey is the metadata key holding a screen rendered
+	// with ANSI styles (motd.MetaANSI).
+	connectScreenANSIKey = "ansi"
+	// maxConnectScreens bounds how many screens one connection lists.
+	maxConnectScreens = 100
+)
+
+// ConnectScreenSource lists content items. Satisfied by the gateway's core
+// client.
+type ConnectScreenSource interface {
+	ListContent(ctx context.Context, req *contentv1.ListContentRequest) (*contentv1.ListContentResponse, error)
+}
+
+// connectScreen returns a connect screen picked at random, or "" when no
+// source is set, none are defined, or the core cannot be reached.
+func (h *GatewayHandler) connectScreen(ctx context.Context) string {
+	if h.screens == nil {
+		return ""
+	}
+	callCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+	resp, err := h.screens.ListContent(callCtx, &contentv1.ListContentRequest{
+		Prefix: connectScreenPrefix,
+		Limit:  maxConnectScreens,
+	})
+	if err != nil {
+		slog.DebugContext(ctx, "telnet: listing connect screens failed", "error", err)
+		return ""
+	}
+	items := resp.GetItems()
+	if len(items) == 0 {
+		return ""
+	}
+	item := items[cryptoIntN(len(items))]
+	if text := item.GetMetadata()[connectScreenANSIKey]; text != "" {
+		return text
+	}
+	return string(item.GetBody())
+}
+
+// cryptoIntN returns a cryptographically secure random int in [0, n).
+func cryptoIntN(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		// crypto/rand failure is a system-level problem; panic is appropriate.
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return int(v.Int64())
+}
@@ -16,6 +16,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -31,6 +32,7 @@ import (
 	"github.com/holomush/holomush/internal/telemetry"
 	"github.com/holomush/holomush/internal/telnet/gamenotice"
 	"github.com/holomush/holomush/internal/ulidgen"
+	"github.com/holomush/holomush/pkg/holo/ansicolor"
 	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
 )
 
@@ -62,6 +64,7 @@ type CoreClient interface {
 	// Auth RPCs (two-phase login)
 	AuthenticatePlayer(ctx context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error)
 	SelectCharacter(ctx context.Context, req *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error)
+	ResumeSession(ctx context.Context, req *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error)
 	CreatePlayer(ctx context.Context, req *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error)
 	CreateCharacter(ctx context.Context, req *corev1.CreateCharacterRequest) (*corev1.CreateCharacterResponse, error)
 	ListCharacters(ctx context.Context, req *corev1.ListCharactersRequest) (*corev1.ListCharactersResponse, error)
@@ -71,6 +74,8 @@ type CoreClient interface {
 	CreateGuest(ctx context.Context, req *corev1.CreateGuestRequest) (*corev1.CreateGuestResponse, error)
 	// Liveness RPCs
 	RefreshConnection(ctx context.Context, req *corev1.RefreshConnectionRequest) (*corev1.RefreshConnectionResponse, error)
+	// Moderation RPCs
+	ReportInputFlood(ctx context.Context, req *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error)
 }
 
 // GatewayHandler manages a single telnet connection, using gRPC to communicate
@@ -103,16 +108,60 @@ type GatewayHandler struct {
 
 	limits Limits
 
+	// Telnet protocol state. telnet strips IAC sequences from input and
+	// answers CHARSET, COMPRESS2 and TERMINAL-TYPE negotiation; charset is
+	// the resulting wire encoding and color the terminal's color depth,
+	// both shared by the two goroutines. writeMu serializes writes
+	// from the handler and the negotiation replies sent from the read
+	// goroutine, and guards mccp, the active MCCP2 output stream.
+	telnet        *telnetReader
+	charset       *charsetState
+	color         *colorState
+	offerCharset  bool
+	offerCompress bool
+	offerTType    bool
+	writeMu       sync.Mutex
+	mccp          *mccpStream
+
+	// banner is the connect greeting, read once when Handle starts.
+	banner *Banner
+
+	// screens supplies staff-edited connect screens shown in place of the
+	// banner. Nil shows the banner.
+	screens ConnectScreenSource
+
 	// Two-phase auth state.
 	playerSessionToken string                     // set after AuthenticatePlayer, persists across character selection
 	characters         []*corev1.CharacterSummary // available characters while in selectMode
 	selectMode         bool                       // true when waiting for PLAY/CREATE
 	loggingOut         bool                       // true when LOGOUT initiated (close connection after quit)
 
+	// reconnectToken resumes the game session from a new connection after
+	// this one drops. Issued by SelectCharacter and rotated by each resume.
+	reconnectToken string
+
 	// sceneNudgeLast records the last time a SCENE_ACTIVITY nudge rendered for a
 	// scene id, gating the per-scene debounce (D-02 throttle). Accessed only from
 	// the single-consumer Handle event loop, so no lock is needed.
 	sceneNudgeLast map[string]time.Time
+
+	// input meters command lines against the connection's input budget and
+	// escalates against a flooding client; squelch collapses repeated
+	// identical event lines. Both are accessed only from the Handle loop.
+	input   *inputThrottle
+	squelch outputSquelch
+
+	// pager holds long command output back a page at a time until the
+	// player types MORE. Accessed only from the Handle loop.
+	pager outputPager
+
+	// plainText and bell follow the player's client preferences, from the
+	// preferences_changed events on the character stream: plainText strips
+	// server-rendered colors (preference ansi off) and bell rings the
+	// terminal bell on pages. The pagesize preference sets pager's page
+	// size. Accessed only from the Handle loop.
+	plainText bool
+	bell      bool
 }
 
 // sceneNudgeWindow bounds how often a single scene's SCENE_ACTIVITY nudge
@@ -127,13 +176,49 @@ const sceneNudgeWindow = 45 * time.Second
 // not hold a local VerbRegistry (Phase 1.6 gateway thinness).
 func NewGatewayHandler(conn net.Conn, client CoreClient, limits Limits) *GatewayHandler {
 	dr := &deadlineReader{conn: conn, timeout: limits.IdleReadTimeout}
-	return &GatewayHandler{
+	h := &GatewayHandler{
 		conn:           conn,
-		reader:         bufio.NewReader(dr),
 		client:         client,
 		limits:         limits,
+		charset:        &charsetState{},
+		color:          &colorState{},
 		sceneNudgeLast: make(map[string]time.Time),
+		input:          newInputThrottle(limits),
 	}
+	h.telnet = newTelnetReader(dr, h.writeRaw, h.charset)
+	h.reader = bufio.NewReader(h.telnet)
+	return h
+}
+
+// EnableCharsetNegotiation makes Handle offer the TELNET CHARSET option
+// (RFC 2066) when the connection opens, so capable clients can agree on
+// UTF-8 or a legacy code page. Without it the gateway still answers
+// client-initiated negotiation. Call before Handle.
+func (h *GatewayHandler) EnableCharsetNegotiation() {
+	h.offerCharset = true
+}
+
+// EnableTerminalTypeNegotiation makes Handle ask for the client's
+// TELNET TERMINAL-TYPE (RFC 1091, with MTTS) when the connection opens
+// and downgrade styled output to the color depth it reports. Without it
+// the gateway refuses the option and assumes 256 colors. Call before
+// Handle.
+func (h *GatewayHandler) EnableTerminalTypeNegotiation() {
+	h.offerTType = true
+	h.telnet.color = h.color
+}
+
+// SetBanner makes Handle greet the connection with b's text instead of
+// DefaultBanner. Call before Handle.
+func (h *GatewayHandler) SetBanner(b *Banner) {
+	h.banner = b
+}
+
+// SetConnectScreens makes Handle greet the connection with one of the
+// connect screens src lists, falling back to the banner when there are
+// none. Call before Handle.
+func (h *GatewayHandler) SetConnectScreens(src ConnectScreenSource) {
+	h.screens = src
 }
 
 // sceneActivityLine returns the throttled [>GAME: …] leader for a
@@ -181,12 +266,26 @@ func (h *GatewayHandler) Handle(ctx context.Context) {
 				slog.DebugContext(ctx, "gateway: disconnect RPC failed", "session_id", h.sessionID, "error", err)
 			}
 		}
+		h.endCompression()
 		if err := h.conn.Close(); err != nil {
 			slog.DebugContext(ctx, "gateway: error closing connection", "error", err)
 		}
 	}()
 
-	h.send("Welcome to HoloMUSH!")
+	if h.offerCharset {
+		h.telnet.offerCharset()
+	}
+	if h.offerCompress {
+		h.telnet.offerCompression()
+	}
+	if h.offerTType {
+		h.telnet.offerTerminalType()
+	}
+	if screen := h.connectScreen(ctx); screen != "" {
+		h.sendStyled(screen)
+	} else {
+		h.send(h.banner.Text())
+	}
 	h.send("Use: connect guest")
 
 	preAuth := time.NewTimer(h.limits.PreAuthTimeout)
@@ -259,6 +358,13 @@ func (h *GatewayHandler) Handle(ctx context.Context) {
 			return
 
 		case line := <-lineCh:
+			forward, closeConn := h.admitLine(childCtx, time.Now())
+			if closeConn {
+				return
+			}
+			if !forward {
+				continue
+			}
 			if ch := h.processLine(childCtx, line); ch != nil {
 				eventRecv = ch
 			}
@@ -389,6 +495,17 @@ func (h *GatewayHandler) Handle(ctx context.Context) {
 }
 
 func (h *GatewayHandler) processLine(ctx context.Context, line string) <-chan *corev1.SubscribeResponse {
+	// ENCODING and COLORDEPTH are gateway-local settings, available before
+	// and after login.
+	switch cmd, arg := cmdparse.ParseCommand(line); cmd {
+	case "encoding":
+		h.handleEncoding(arg)
+		return nil
+	case "colordepth":
+		h.handleColorDepth(arg)
+		return nil
+	}
+
 	// In selectMode only PLAY, CREATE, and QUIT are accepted.
 	if h.selectMode {
 		lower := strings.ToLower(line)
@@ -407,6 +524,18 @@ func (h *GatewayHandler) processLine(ctx context.Context, line string) <-chan *c
 
 	cmd, arg := cmdparse.ParseCommand(line)
 
+	// RESUME is only a gateway command before login; once in the game the
+	// word belongs to the command dispatcher.
+	if cmd == "resume" && !h.authed {
+		return h.handleResume(ctx, arg)
+	}
+	// MORE pages through output the gateway is holding back, so it is
+	// only a gateway command once in the game.
+	if cmd == "more" && h.authed {
+		h.handleMore(arg)
+		return nil
+	}
+
 	switch cmd {
 	case "connect":
 		return h.handleConnect(ctx, arg)
@@ -494,6 +623,8 @@ func (h *GatewayHandler) handleConnectGuest(ctx context.Context) <-chan *corev1.
 func (h *GatewayHandler) handleConnectPlayer(ctx context.Context, username, password string) <-chan *corev1.SubscribeResponse {
 	authCtx, authCancel := context.WithTimeout(ctx, rpcTimeout)
 	defer authCancel()
+	// Forward the client's address so core can enforce IP and hostname bans.
+	authCtx = grpcclient.WithClientAddr(authCtx, remoteIP(h.conn.RemoteAddr()))
 
 	resp, err := h.client.AuthenticatePlayer(authCtx, &corev1.AuthenticatePlayerRequest{
 		Username: username,
@@ -615,8 +746,9 @@ func (h *GatewayHandler) selectCharacter(ctx context.Context, ch *corev1.Charact
 	defer selCancel()
 
 	resp, err := h.client.SelectCharacter(selCtx, &corev1.SelectCharacterRequest{
-		PlayerSessionToken: h.playerSessionToken,
-		CharacterId:        ch.GetCharacterId(),
+		PlayerSessionToken:  h.playerSessionToken,
+		CharacterId:         ch.GetCharacterId(),
+		IssueReconnectToken: true,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "gateway: select character RPC failed", "error", err)
@@ -630,6 +762,7 @@ func (h *GatewayHandler) selectCharacter(ctx context.Context, ch *corev1.Charact
 
 	h.sessionID = resp.GetSessionId()
 	h.charName = resp.GetCharacterName()
+	h.reconnectToken = resp.GetReconnectToken()
 	h.authed = true
 	h.selectMode = false
 	h.characters = nil
@@ -644,6 +777,65 @@ func (h *GatewayHandler) selectCharacter(ctx context.Context, ch *corev1.Charact
 		h.send("Reattaching to existing session...")
 	}
 	h.send(fmt.Sprintf("Welcome, %s!", h.charName))
+	h.sendReconnectHint()
+
+	return h.subscribeAndEnter(ctx)
+}
+
+// sendReconnectHint tells the player how to resume the session if the
+// connection drops. Nothing is sent when core issued no reconnect token.
+func (h *GatewayHandler) sendReconnectHint() {
+	if h.reconnectToken == "" {
+		return
+	}
+	h.send("If your connection drops, reconnect and type: resume " + h.reconnectToken)
+}
+
+// handleResume is called when the client sends RESUME <token> before login.
+// It redeems a reconnect token from a dropped connection and re-enters the
+// game session; the Subscribe that follows replays the output missed while
+// the session was detached.
+func (h *GatewayHandler) handleResume(ctx context.Context, token string) <-chan *corev1.SubscribeResponse {
+	if token == "" {
+		h.send("Usage: resume <token>")
+		return nil
+	}
+
+	resumeCtx, resumeCancel := context.WithTimeout(ctx, rpcTimeout)
+	defer resumeCancel()
+
+	resp, err := h.client.ResumeSession(resumeCtx, &corev1.ResumeSessionRequest{
+		ReconnectToken: token,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "gateway: resume session RPC failed", "error", err)
+		h.send("Resume error. Please try again.")
+		return nil
+	}
+	if !resp.GetSuccess() {
+		slog.DebugContext(
+			ctx, "telnet: session resume failed",
+			"remote_addr", h.conn.RemoteAddr().String(),
+		)
+		h.send("Could not resume session: " + resp.GetErrorMessage())
+		return nil
+	}
+
+	h.playerSessionToken = resp.GetPlayerSessionToken()
+	h.reconnectToken = resp.GetReconnectToken()
+	h.sessionID = resp.GetSessionId()
+	h.charName = resp.GetCharacterName()
+	h.authed = true
+	h.selectMode = false
+
+	slog.DebugContext(
+		ctx, "telnet: session resumed",
+		"session_id", h.sessionID,
+		"character_name", h.charName,
+	)
+
+	h.send(fmt.Sprintf("Welcome back, %s! Replaying what you missed...", h.charName))
+	h.sendReconnectHint()
 
 	return h.subscribeAndEnter(ctx)
 }
@@ -654,6 +846,8 @@ func (h *GatewayHandler) selectCharacter(ctx context.Context, ch *corev1.Charact
 // RPC so core can register the connection in the session store (bd-j2xj).
 // The same connection_id is reused for the deferred Disconnect on exit.
 func (h *GatewayHandler) subscribeAndEnter(ctx context.Context) <-chan *corev1.SubscribeResponse {
+	// Output held for a previous character is not this one's to page.
+	h.pager.discard()
 	ch, err := h.trySubscribe(ctx)
 	if err != nil {
 		slog.WarnContext(ctx, "gateway: subscribe RPC failed — no live events", "session_id", h.sessionID, "error", err)
@@ -991,6 +1185,54 @@ func (h *GatewayHandler) refreshOnce(ctx context.Context) {
 	}
 }
 
+// admitLine applies input flood protection to one command line, reporting
+// whether to forward it and whether the connection must be closed. Each
+// escalation notifies the client, is counted, and is reported to core.
+func (h *GatewayHandler) admitLine(ctx context.Context, now time.Time) (forward, closeConn bool) {
+	step := h.input.offer(now)
+	switch step {
+	case floodAllow:
+		return true, false
+	case floodDrop:
+		return false, false
+	case floodWarn:
+		h.send("You are sending commands too fast. Slow down; extra commands are being dropped.")
+	case floodThrottle:
+		h.send(fmt.Sprintf("Input throttled: you are limited to %s.", h.input.throttledRate()))
+	case floodDisconnect:
+		h.send("Disconnected for flooding.")
+	}
+	slog.InfoContext(ctx, "gateway: input flood escalation",
+		"remote_addr", h.conn.RemoteAddr().String(),
+		"session_id", h.sessionID,
+		"action", floodStepActions[step].label,
+		"dropped_commands", h.input.dropped)
+	RecordInputFlood(floodStepActions[step].label)
+	h.reportInputFlood(ctx, step)
+	return false, step == floodDisconnect
+}
+
+// reportInputFlood reports an escalation to core, which publishes a
+// moderation event for staff review. No-op unless authed with a session: a
+// pre-auth flood has no character to attribute and is only logged and
+// counted. Failure is logged at Debug; the gateway has already acted.
+func (h *GatewayHandler) reportInputFlood(ctx context.Context, step floodStep) {
+	if !h.authed || h.sessionID == "" {
+		return
+	}
+	rCtx, rCancel := context.WithTimeout(ctx, rpcTimeout)
+	defer rCancel()
+	if _, err := h.client.ReportInputFlood(rCtx, &corev1.ReportInputFloodRequest{
+		SessionId:          h.sessionID,
+		ConnectionId:       h.connectionID,
+		PlayerSessionToken: h.playerSessionToken,
+		Action:             floodStepActions[step].action,
+		DroppedCommands:    h.input.dropped,
+	}); err != nil {
+		slog.DebugContext(rCtx, "gateway: input flood report failed", "session_id", h.sessionID, "error", err)
+	}
+}
+
 func (h *GatewayHandler) handleQuit(ctx context.Context) {
 	if h.authed {
 		// Forward quit to the server so it can emit events and clean up.
@@ -1122,21 +1364,201 @@ func (h *GatewayHandler) drainUntilClosed(ctx context.Context, eventRecv <-chan
 	}
 }
 
+// handleEncoding implements the ENCODING command: with no argument it
+// reports the connection charset; otherwise it pins the charset for the
+// rest of the connection, overriding negotiation, or with AUTO returns to
+// the negotiated value.
+func (h *GatewayHandler) handleEncoding(arg string) {
+	if arg == "" {
+		source := "negotiated"
+		if h.charset.Overridden() {
+			source = "set by you"
+		}
+		h.send(fmt.Sprintf("Encoding: %s (%s). Use ENCODING <UTF-8|ISO-8859-1|IBM437|AUTO> to change it.",
+			h.charset.Current(), source))
+		return
+	}
+	if strings.EqualFold(arg, "auto") {
+		h.charset.setOverride(nil)
+		h.send(fmt.Sprintf("Encoding: %s (negotiated).", h.charset.Current()))
+		return
+	}
+	c, ok := ParseCharset(arg)
+	if !ok {
+		h.send(fmt.Sprintf("Unknown encoding %q. Supported: UTF-8, ISO-8859-1, IBM437, AUTO.", arg))
+		return
+	}
+	h.charset.setOverride(&c)
+	h.send(fmt.Sprintf("Encoding set to %s.", c))
+}
+
+// handleColorDepth implements the COLORDEPTH command: with no argument it
+// reports the color depth styled output is downgraded to; otherwise it
+// pins the depth for the rest of the connection, overriding detection, or
+// with AUTO returns to the detected value.
+func (h *GatewayHandler) handleColorDepth(arg string) {
+	if arg == "" {
+		source := "detected"
+		if h.color.Overridden() {
+			source = "set by you"
+		}
+		h.send(fmt.Sprintf("Color depth: %s (%s). Use COLORDEPTH <NONE|16|256|TRUECOLOR|AUTO> to change it.",
+			h.color.Current(), source))
+		return
+	}
+	if strings.EqualFold(arg, "auto") {
+		h.color.setOverride(nil)
+		h.send(fmt.Sprintf("Color depth: %s (detected).", h.color.Current()))
+		return
+	}
+	d, ok := ansicolor.ParseDepth(arg)
+	if !ok {
+		h.send(fmt.Sprintf("Unknown color depth %q. Supported: NONE, 16, 256, TRUECOLOR, AUTO.", arg))
+		return
+	}
+	h.color.setOverride(&d)
+	h.send(fmt.Sprintf("Color depth set to %s.", d))
+}
+
 func (h *GatewayHandler) send(msg string) {
-	if err := h.conn.SetWriteDeadline(time.Now().Add(h.limits.WriteTimeout)); err != nil {
-		slog.Debug("gateway: failed to set write deadline", "error", err)
+	line := h.charset.Current().Encode(sanitizeTelnetOutput(msg))
+	h.writeRaw(append(line, '\n'))
+}
+
+// sendStyled is send for server-rendered styled text: colors and text
+// attributes survive sanitizing, downgraded to the connection's color
+// depth, and a trailing reset keeps them from bleeding into the next line.
+func (h *GatewayHandler) sendStyled(msg string) {
+	if h.plainText {
+		h.send(msg)
 		return
 	}
-	if _, err := fmt.Fprintln(h.conn, sanitizeTelnetOutput(msg)); err != nil {
+	msg = ansicolor.Downgrade(sanitizeTelnetStyled(msg), h.color.Current())
+	if strings.Contains(msg, "\x1b[") && !strings.HasSuffix(msg, "\x1b[0m") {
+		msg += "\x1b[0m"
+	}
+	line := h.charset.Current().Encode(msg)
+	h.writeRaw(append(line, '\n'))
+}
+
+// writeRaw writes bytes to the connection under the write deadline,
+// compressed when MCCP2 is active. It is safe to call from the read
+// goroutine for negotiation replies.
+func (h *GatewayHandler) writeRaw(b []byte) {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	if err := h.writeLocked(b); err != nil {
 		slog.Debug("gateway: failed to send message", "error", err)
 	}
 }
 
+// writeLocked is writeRaw for a caller that already holds writeMu.
+func (h *GatewayHandler) writeLocked(b []byte) error {
+	if err := h.conn.SetWriteDeadline(time.Now().Add(h.limits.WriteTimeout)); err != nil {
+		return oops.Code("TELNET_WRITE_FAILED").With("operation", "set write deadline").Wrap(err)
+	}
+	var w io.Writer = h.conn
+	if h.mccp != nil {
+		w = h.mccp
+	}
+	if _, err := w.Write(b); err != nil {
+		return oops.Code("TELNET_WRITE_FAILED").Wrap(err)
+	}
+	return nil
+}
+
+// sendProtoEvent renders and writes one event. Repeats of the previous
+// event line are squelched into a "(previous message repeated N times)"
+// summary shown before the next distinct line.
 func (h *GatewayHandler) sendProtoEvent(ev *corev1.EventFrame) {
+	if ev.GetType() == string(eventvocab.EventTypePreferencesChanged) {
+		h.applyPreferences(ev)
+		return
+	}
 	msg := h.formatEvent(ev)
-	if msg != "" {
+	if msg == "" {
+		return
+	}
+	show, summary := h.squelch.filter(msg, time.Now())
+	if summary != "" {
+		h.send(summary)
+	}
+	if !show {
+		RecordOutputSquelched()
+		return
+	}
+	switch {
+	case ev.GetType() == string(eventvocab.EventTypeMOTD):
+		h.sendStyled(msg)
+	case isPagedOutput(ev):
+		h.sendPaged(msg)
+	default:
 		h.send(msg)
 	}
+	if h.bell && ev.GetType() == string(eventvocab.EventTypePage) {
+		h.writeRaw([]byte{'\a'})
+	}
+}
+
+// applyPreferences takes up the player's client preferences from a
+// preferences_changed event. A payload that does not decode leaves the
+// current behavior in place.
+func (h *GatewayHandler) applyPreferences(ev *corev1.EventFrame) {
+	var prefs eventvocab.PreferencesChangedPayload
+	if err := json.Unmarshal(ev.GetPayload(), &prefs); err != nil {
+		slog.Debug("gateway: failed to unmarshal preferences payload", "error", err)
+		return
+	}
+	h.plainText = !prefs.ANSI
+	h.bell = prefs.NotifyBell
+	h.pager.size = prefs.PageSize
+}
+
+// isPagedOutput reports whether ev is command output the pager holds back
+// when it runs long. Command errors, speech, movement, and notices are
+// always shown as they arrive.
+func isPagedOutput(ev *corev1.EventFrame) bool {
+	rendering := ev.GetRendering()
+	return rendering.GetCategory() == "command" && rendering.GetFormat() != "error"
+}
+
+// sendPaged writes the part of msg that fits on the current page and
+// prompts for MORE when the pager is holding the rest.
+func (h *GatewayHandler) sendPaged(msg string) {
+	if lines := h.pager.add(msg); len(lines) > 0 {
+		h.send(strings.Join(lines, "\n"))
+	}
+	if h.pager.remaining() > 0 {
+		h.send(h.pager.prompt())
+	}
+}
+
+// handleMore implements the MORE command: with no argument it shows the
+// next page of held output, with ALL the rest of it, and with STOP it
+// discards it.
+func (h *GatewayHandler) handleMore(arg string) {
+	if h.pager.remaining() == 0 {
+		h.send("There is no more output.")
+		return
+	}
+	var page []string
+	switch strings.ToLower(arg) {
+	case "":
+		page = h.pager.next(false)
+	case "all":
+		page = h.pager.next(true)
+	case "stop":
+		n := h.pager.discard()
+		h.send(fmt.Sprintf("Discarded %d held %s.", n, pluralLines(n)))
+		return
+	default:
+		h.send("Use MORE, MORE ALL, or MORE STOP.")
+		return
+	}
+	h.send(strings.Join(page, "\n"))
+	if h.pager.remaining() > 0 {
+		h.send(h.pager.prompt())
+	}
 }
 
 // formatEvent dispatches formatting by EventFrame.Rendering category+format.
@@ -1230,7 +1652,7 @@ func (h *GatewayHandler) formatCommunication(ev *corev1.EventFrame, rendering *c
 	}
 }
 
-// formatMovement formats arrive/leave/move notifications.
+// formatMovement formats arrive/leave/move and exit traversal notifications.
 func (h *GatewayHandler) formatMovement(ev *corev1.EventFrame, rendering *corev1.RenderingMetadata) string {
 	_ = rendering // reserved for future format differentiation
 
@@ -1254,6 +1676,14 @@ func (h *GatewayHandler) formatMovement(ev *corev1.EventFrame, rendering *corev1
 			return fmt.Sprintf("%s has left (%s).", actor, reason)
 		}
 		return fmt.Sprintf("%s has left.", actor)
+	case string(eventvocab.EventTypeTraversalDepart):
+		return fmt.Sprintf("%s heads %s.", actor, stringFromPayload(payload, "exit_name"))
+	case string(eventvocab.EventTypeTraversalCancel):
+		return fmt.Sprintf("%s stops.", actor)
+	case string(eventvocab.EventTypeTraversalArrive):
+		return fmt.Sprintf("%s arrives.", actor)
+	case string(eventvocab.EventTypeTraversalLocked):
+		return fmt.Sprintf("%s tries to go %s, but it is locked.", actor, stringFromPayload(payload, "exit_name"))
 	default:
 		return fmt.Sprintf("%s moves.", actor)
 	}
@@ -1303,6 +1733,11 @@ func (h *GatewayHandler) formatSystem(ev *corev1.EventFrame) string {
 		slog.Error("gateway: failed to unmarshal system payload", "type", ev.GetType(), "error", err)
 		return ""
 	}
+	if ev.GetType() == string(eventvocab.EventTypeMOTD) {
+		// The login notice arrives already rendered with ANSI styles;
+		// sendStyled keeps them unless the player turned ANSI off.
+		return stringFromPayload(payload, "ansi", "text")
+	}
 	return stringFromPayload(payload, "text", "message")
 }
 
@@ -1340,3 +1775,16 @@ func truncateActorID(actorID string) string {
 	}
 	return actorID
 }
+
+// remoteIP returns the IP part of a connection's remote address, or "" when
+// it has none (e.g. an in-memory pipe in tests).
+func remoteIP(addr net.Addr) string {
+	if addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return ""
+	}
+	return host
+}
@@ -46,10 +46,18 @@ var testRenderings = map[string]*corev1.RenderingMetadata{
 	// Host-owned builtins (registered by BootstrapVerbRegistry in production).
 	"arrive":           {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
 	"leave":            {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
+	"traversal_depart": {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
+	"traversal_cancel": {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
+	"traversal_arrive": {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
+	"traversal_locked": {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
 	"system":           {Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
+	"motd":             {Category: "system", Format: "motd", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"command_response": {Category: "command", Format: "narrative", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"command_error":    {Category: "command", Format: "error", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"location_state":   {Category: "state", Format: "snapshot", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_STATE, SourcePlugin: "builtin"},
+	"page":             {Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
+
+	"preferences_changed": {Category: "state", Format: "snapshot", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_STATE, SourcePlugin: "builtin"},
 }
 
 // withRendering populates ev.Rendering from testRenderings (if present).
@@ -91,6 +99,10 @@ type mockCoreClient struct {
 	selectCharErr     error
 	lastSelectCharReq *corev1.SelectCharacterRequest
 
+	resumeResp    *corev1.ResumeSessionResponse
+	resumeErr     error
+	lastResumeReq *corev1.ResumeSessionRequest
+
 	createCharResp *corev1.CreateCharacterResponse
 	createCharErr  error
 
@@ -120,6 +132,8 @@ type mockCoreClient struct {
 	refreshErr     error
 	refreshCalls   atomic.Int32
 	lastRefreshReq atomic.Pointer[corev1.RefreshConnectionRequest]
+
+	floodReqs []*corev1.ReportInputFloodRequest
 }
 
 func (m *mockCoreClient) AuthenticatePlayer(_ context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error) {
@@ -132,6 +146,11 @@ func (m *mockCoreClient) SelectCharacter(_ context.Context, req *corev1.SelectCh
 	return m.selectCharResp, m.selectCharErr
 }
 
+func (m *mockCoreClient) ResumeSession(_ context.Context, req *corev1.ResumeSessionRequest) (*corev1.ResumeSessionResponse, error) {
+	m.lastResumeReq = req
+	return m.resumeResp, m.resumeErr
+}
+
 func (m *mockCoreClient) CreatePlayer(_ context.Context, _ *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error) {
 	return &corev1.CreatePlayerResponse{}, nil
 }
@@ -202,6 +221,11 @@ func (m *mockCoreClient) RefreshConnection(_ context.Context, req *corev1.Refres
 	return m.refreshResp, m.refreshErr
 }
 
+func (m *mockCoreClient) ReportInputFlood(_ context.Context, req *corev1.ReportInputFloodRequest) (*corev1.ReportInputFloodResponse, error) {
+	m.floodReqs = append(m.floodReqs, req)
+	return &corev1.ReportInputFloodResponse{}, nil
+}
+
 // readLines reads exactly n lines from r, stripping \r\n.
 //
 //nolint:unparam // n varies in future tests
@@ -1252,6 +1276,30 @@ func TestFormatEvent_Movement(t *testing.T) {
 			`{"character_name":"Bob"}`,
 			"Bob has left.",
 		},
+		{
+			"traversal depart",
+			"traversal_depart",
+			`{"character_name":"Alice","exit_name":"north","delay_ms":2000}`,
+			"Alice heads north.",
+		},
+		{
+			"traversal cancel",
+			"traversal_cancel",
+			`{"character_name":"Alice","exit_name":"north"}`,
+			"Alice stops.",
+		},
+		{
+			"traversal arrive",
+			"traversal_arrive",
+			`{"character_name":"Alice","exit_name":"north"}`,
+			"Alice arrives.",
+		},
+		{
+			"traversal locked",
+			"traversal_locked",
+			`{"character_name":"Alice","exit_name":"gate"}`,
+			"Alice tries to go gate, but it is locked.",
+		},
 	}
 
 	for _, tt := range tests {
@@ -1323,6 +1371,51 @@ func TestFormatEvent_System(t *testing.T) {
 	assert.Equal(t, "Server restarting in 5 minutes.", got)
 }
 
+func TestFormatEvent_MOTD(t *testing.T) {
+	h := &GatewayHandler{}
+
+	ev := &corev1.EventFrame{
+		Type:    "motd",
+		Payload: []byte(`{"text":"Message of the Day\n\nWelcome!","ansi":"\u001b[1mMessage of the Day\u001b[0m\n\nWelcome!"}`),
+	}
+	got := h.formatEvent(withRendering(ev))
+	assert.Equal(t, "\x1b[1mMessage of the Day\x1b[0m\n\nWelcome!", got, "the ANSI rendering is preferred")
+
+	ev = &corev1.EventFrame{Type: "motd", Payload: []byte(`{"text":"Welcome!"}`)}
+	assert.Equal(t, "Welcome!", h.formatEvent(withRendering(ev)))
+}
+
+func TestSendProtoEventAppliesClientPreferences(t *testing.T) {
+	conn := &addrTrackingConn{}
+	h := NewGatewayHandler(conn, &mockCoreClient{}, Limits{WriteTimeout: time.Second})
+	prefs := func(payload string) *corev1.EventFrame {
+		return withRendering(&corev1.EventFrame{Type: "preferences_changed", Payload: []byte(payload)})
+	}
+	motd := func(text string) *corev1.EventFrame {
+		return withRendering(&corev1.EventFrame{
+			Type:    "motd",
+			Payload: []byte(`{"text":"` + text + `","ansi":"\u001b[1m` + text + `\u001b[0m"}`),
+		})
+	}
+	page := withRendering(&corev1.EventFrame{Type: "page", Payload: []byte(`{"text":"Bob pages: hi"}`)})
+
+	h.sendProtoEvent(prefs(`{"ansi":false,"notify_bell":true}`))
+	assert.Empty(t, conn.writeBuf, "preferences are applied, not shown")
+	h.sendProtoEvent(motd("Welcome!"))
+	h.sendProtoEvent(page)
+	assert.Equal(t, "Welcome!\nBob pages: hi\n\a", string(conn.writeBuf))
+
+	conn.writeBuf = nil
+	h.sendProtoEvent(prefs(`{"ansi":true,"notify_bell":false}`))
+	h.sendProtoEvent(motd("Hello!"))
+	h.sendProtoEvent(page)
+	assert.Equal(t, "\x1b[1mHello!\x1b[0m\nBob pages: hi\n", string(conn.writeBuf))
+
+	// A payload that does not decode keeps the current preferences.
+	h.sendProtoEvent(prefs(`not json`))
+	assert.False(t, h.plainText)
+}
+
 func TestFormatEventDropsEventWithNilRenderingAndIncrementsMetric(t *testing.T) {
 	// INV-EVENTBUS-6: events arriving without RenderingMetadata are dropped at
 	// the gateway and counted via gatewaymetrics.DroppedNilRenderingTotal.
@@ -3113,3 +3206,143 @@ func TestSceneActivityLineCarriesOnlySceneID(t *testing.T) {
 	assert.Equal(t, "[>GAME: Scene #01SCENEXYZ has new activity]", got,
 		"the nudge carries only the scene id, no title or pose content")
 }
+
+// TestGatewayHandler_SelectCharacterShowsReconnectHint verifies that character
+// selection asks core for a reconnect token and shows it to the player.
+func TestGatewayHandler_SelectCharacterShowsReconnectHint(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	client := &mockCoreClient{
+		createGuestResp: &corev1.CreateGuestResponse{
+			Success:            true,
+			PlayerSessionToken: "tok-guest-1",
+			Characters:         []*corev1.CharacterSummary{{CharacterId: "char-1", CharacterName: "Guest-7"}},
+		},
+		selectCharResp: &corev1.SelectCharacterResponse{
+			Success:        true,
+			SessionId:      "sess-1",
+			CharacterName:  "Guest-7",
+			ReconnectToken: "rtok-1",
+		},
+		subErr:   errors.New("no subscribe in this test"),
+		discResp: &corev1.DisconnectResponse{Success: true},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newTestHandler(serverConn, client)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	reset := withDeadline(t, clientConn)
+	defer reset()
+
+	r := bufio.NewReader(clientConn)
+	readLines(t, r, 2) // banner
+
+	_, err := clientConn.Write([]byte("connect guest\n"))
+	require.NoError(t, err)
+	lines := readLinesUntil(t, r, "resume rtok-1")
+	assert.Equal(t, "If your connection drops, reconnect and type: resume rtok-1", lines[len(lines)-1])
+
+	cancel()
+	<-done
+	require.NotNil(t, client.lastSelectCharReq)
+	assert.True(t, client.lastSelectCharReq.GetIssueReconnectToken())
+}
+
+// TestGatewayHandler_ResumeSession verifies that RESUME <token> before login
+// redeems the token, re-enters the resumed session with the rotated player
+// session token, and shows the rotated reconnect token.
+func TestGatewayHandler_ResumeSession(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	client := &mockCoreClient{
+		resumeResp: &corev1.ResumeSessionResponse{
+			Success:            true,
+			SessionId:          "sess-resumed",
+			CharacterName:      "Alaric",
+			PlayerSessionToken: "tok-rotated",
+			ReconnectToken:     "rtok-2",
+		},
+		subErr:   errors.New("no subscribe in this test"),
+		discResp: &corev1.DisconnectResponse{Success: true},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newTestHandler(serverConn, client)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	reset := withDeadline(t, clientConn)
+	defer reset()
+
+	r := bufio.NewReader(clientConn)
+	readLines(t, r, 2) // banner
+
+	_, err := clientConn.Write([]byte("resume rtok-1\n"))
+	require.NoError(t, err)
+	lines := readLinesUntil(t, r, "resume rtok-2")
+	assert.Contains(t, lines[0], "Welcome back, Alaric!")
+
+	cancel()
+	<-done
+	require.NotNil(t, client.lastResumeReq)
+	assert.Equal(t, "rtok-1", client.lastResumeReq.GetReconnectToken())
+	require.NotNil(t, client.lastSubscribeReq)
+	assert.Equal(t, "sess-resumed", client.lastSubscribeReq.GetSessionId())
+	assert.Equal(t, "tok-rotated", client.lastSubscribeReq.GetPlayerSessionToken())
+}
+
+// TestGatewayHandler_ResumeSessionRejected verifies that a missing or rejected
+// reconnect token leaves the connection at the login prompt.
+func TestGatewayHandler_ResumeSessionRejected(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	client := &mockCoreClient{
+		resumeResp: &corev1.ResumeSessionResponse{
+			Success:      false,
+			ErrorMessage: "invalid or expired reconnect token",
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler := newTestHandler(serverConn, client)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	reset := withDeadline(t, clientConn)
+	defer reset()
+
+	r := bufio.NewReader(clientConn)
+	readLines(t, r, 2) // banner
+
+	_, err := clientConn.Write([]byte("resume\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Usage: resume <token>"}, readLines(t, r, 1))
+
+	_, err = clientConn.Write([]byte("resume rtok-stale\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Could not resume session: invalid or expired reconnect token"}, readLines(t, r, 1))
+
+	cancel()
+	<-done
+	assert.Nil(t, client.lastSubscribeReq, "a rejected resume never subscribes")
+}
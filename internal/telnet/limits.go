@@ -36,6 +36,16 @@ type Limits struct {
 	// (cmd/holomush) enforces LeaseTTL/BootGrace ≥ 2× this interval. Defaults to
 	// sessionlease.DefaultRefreshInterval (15 s) in DefaultLimits.
 	LeaseRefreshInterval time.Duration
+
+	// InputBurst is how many command lines a connection may send back to
+	// back before input flood protection engages. Zero falls back to
+	// DefaultLimits.InputBurst.
+	InputBurst int
+
+	// InputRate is the sustained command lines per second a connection's
+	// input budget refills at; a throttled connection refills at a quarter
+	// of it. Zero falls back to DefaultLimits.InputRate.
+	InputRate float64
 }
 
 // DefaultLimits are the production-safe defaults for a modest VPS hosting
@@ -45,4 +55,6 @@ var DefaultLimits = Limits{
 	WriteTimeout:         30 * time.Second,
 	PreAuthTimeout:       2 * time.Minute,
 	LeaseRefreshInterval: sessionlease.DefaultRefreshInterval,
+	InputBurst:           20,
+	InputRate:            4,
 }
@@ -6,6 +6,8 @@ package telnet
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/holomush/holomush/pkg/holo/ansicolor"
 )
 
 // ConnectionsActive tracks the current number of live telnet handler
@@ -37,6 +39,63 @@ var IdleTimeoutsTotal = promauto.NewCounter(prometheus.CounterOpts{
 	Help: "Total telnet connections disconnected due to idle read timeout",
 })
 
+// CharsetNegotiatedTotal counts connections that agreed on a charset via
+// TELNET CHARSET negotiation, by charset name.
+var CharsetNegotiatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "holomush_telnet_charset_negotiated_total",
+	Help: "Total telnet CHARSET negotiations by agreed charset",
+}, []string{"charset"})
+
+// ColorDepthNegotiatedTotal counts connections whose color depth was
+// detected via TELNET TERMINAL-TYPE negotiation, by depth.
+var ColorDepthNegotiatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "holomush_telnet_color_depth_negotiated_total",
+	Help: "Total telnet TERMINAL-TYPE negotiations by detected color depth",
+}, []string{"depth"})
+
+// InputFloodActionsTotal counts input flood escalations by action (warn,
+// throttle, disconnect). Sustained disconnects suggest scripted abuse.
+var InputFloodActionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "holomush_telnet_input_flood_actions_total",
+	Help: "Total telnet input flood escalations by action",
+}, []string{"action"})
+
+// OutputSquelchedTotal counts event lines collapsed into a repeat summary
+// instead of being written.
+var OutputSquelchedTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "holomush_telnet_output_squelched_total",
+	Help: "Total repeated telnet event lines squelched",
+})
+
+// CompressionNegotiatedTotal counts MCCP2 offers by outcome: "enabled"
+// when the client accepted, "refused" when it declined and kept plain
+// output. Clients that never answer are counted in neither.
+var CompressionNegotiatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "holomush_telnet_compression_negotiated_total",
+	Help: "Total telnet MCCP2 compression negotiations by outcome",
+}, []string{"result"})
+
+// CompressionBytesTotal counts output bytes on compressed connections,
+// before ("uncompressed") and after ("compressed") zlib. The ratio of the
+// two rates is the bandwidth MCCP2 saves.
+var CompressionBytesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "holomush_telnet_compression_bytes_total",
+	Help: "Total telnet output bytes on MCCP2 connections, before and after compression",
+}, []string{"stage"})
+
+// CompressionRatio observes each finished MCCP2 stream's uncompressed to
+// compressed byte ratio.
+var CompressionRatio = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "holomush_telnet_compression_ratio",
+	Help:    "Uncompressed to compressed output ratio of finished telnet MCCP2 streams",
+	Buckets: []float64{1, 1.5, 2, 3, 4, 6, 8, 12, 16, 24},
+})
+
+var (
+	compressionUncompressedBytes = CompressionBytesTotal.WithLabelValues("uncompressed")
+	compressionCompressedBytes   = CompressionBytesTotal.WithLabelValues("compressed")
+)
+
 // IncConnectionsActive increments the active-connection gauge.
 func IncConnectionsActive() { ConnectionsActive.Inc() }
 
@@ -51,3 +110,35 @@ func RecordPreAuthTimeout() { PreAuthTimeoutsTotal.Inc() }
 
 // RecordIdleTimeout increments the idle timeout counter.
 func RecordIdleTimeout() { IdleTimeoutsTotal.Inc() }
+
+// RecordCharsetNegotiated increments the negotiated-charset counter.
+func RecordCharsetNegotiated(c Charset) { CharsetNegotiatedTotal.WithLabelValues(c.String()).Inc() }
+
+// RecordColorDepthNegotiated increments the detected-color-depth counter.
+func RecordColorDepthNegotiated(d ansicolor.Depth) {
+	ColorDepthNegotiatedTotal.WithLabelValues(d.String()).Inc()
+}
+
+// RecordInputFlood increments the input flood counter for action.
+func RecordInputFlood(action string) { InputFloodActionsTotal.WithLabelValues(action).Inc() }
+
+// RecordOutputSquelched increments the squelched-output counter.
+func RecordOutputSquelched() { OutputSquelchedTotal.Inc() }
+
+// RecordCompressionNegotiated increments the MCCP2 negotiation counter.
+func RecordCompressionNegotiated(enabled bool) {
+	result := "refused"
+	if enabled {
+		result = "enabled"
+	}
+	CompressionNegotiatedTotal.WithLabelValues(result).Inc()
+}
+
+// RecordCompressedWrite adds one compressed write's byte counts.
+func RecordCompressedWrite(uncompressed int, compressed int64) {
+	compressionUncompressedBytes.Add(float64(uncompressed))
+	compressionCompressedBytes.Add(float64(compressed))
+}
+
+// RecordCompressionRatio observes a finished stream's compression ratio.
+func RecordCompressionRatio(ratio float64) { CompressionRatio.Observe(ratio) }
@@ -0,0 +1,418 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/holomush/holomush/pkg/holo/ansicolor"
+)
+
+// Telnet protocol bytes (RFC 854), CHARSET option codes (RFC 2066) and
+// the MCCP2 COMPRESS2 option. TERMINAL-TYPE codes are in color.go.
+const (
+	iac  byte = 255
+	dont byte = 254
+	do   byte = 253
+	wont byte = 252
+	will byte = 251
+	sb   byte = 250
+	se   byte = 240
+
+	optCharset   byte = 42
+	optCompress2 byte = 86
+
+	charsetRequest        byte = 1
+	charsetAccepted       byte = 2
+	charsetRejected       byte = 3
+	charsetTTableIs       byte = 4
+	charsetTTableRejected byte = 5
+
+	// maxSubnegotiation bounds a buffered IAC SB ... IAC SE payload so a
+	// client cannot grow it without limit. Oversized payloads are dropped.
+	maxSubnegotiation = 512
+)
+
+// ttablePrefix introduces the optional translation-table form of a CHARSET
+// REQUEST; it is followed by a one-byte version before the separator.
+var ttablePrefix = []byte("[TTABLE]")
+
+// charsetState holds a connection's charset: the value agreed through
+// CHARSET negotiation and an optional player override that takes
+// precedence. It is shared by the read goroutine (negotiation) and the
+// handler goroutine (output encoding, the ENCODING command).
+type charsetState struct {
+	mu         sync.Mutex
+	negotiated Charset
+	override   *Charset
+}
+
+// Current returns the charset in effect for the connection. A nil state
+// (a handler built without NewGatewayHandler) is UTF-8.
+func (s *charsetState) Current() Charset {
+	if s == nil {
+		return CharsetUTF8
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.override != nil {
+		return *s.override
+	}
+	return s.negotiated
+}
+
+// Overridden reports whether the player pinned the charset explicitly.
+func (s *charsetState) Overridden() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.override != nil
+}
+
+func (s *charsetState) setNegotiated(c Charset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.negotiated = c
+}
+
+// setOverride pins the charset; nil returns to the negotiated value.
+func (s *charsetState) setOverride(c *Charset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.override = c
+}
+
+type telnetParseState int
+
+const (
+	stateData telnetParseState = iota
+	stateIAC
+	stateOption
+	stateSub
+	stateSubIAC
+)
+
+// compressionControl switches the connection's output to an MCCP2
+// compressed stream and back. Implementations send the COMPRESS2 start
+// marker and the WONT that follows the end of the stream themselves, so
+// both land on the correct side of the compression boundary.
+type compressionControl interface {
+	startCompression()
+	stopCompression()
+}
+
+// telnetReader strips telnet protocol sequences from the client byte
+// stream, answers option negotiation, and transcodes data bytes from the
+// connection charset to UTF-8. CHARSET is supported, COMPRESS2 when a
+// compressionControl is attached, and TERMINAL-TYPE when a colorState is;
+// every other option the client offers or requests is refused.
+//
+// The negotiation flags are owned by the goroutine that calls Read, except
+// offerCharset, offerCompression and offerTerminalType, which must be
+// called before that goroutine starts.
+type telnetReader struct {
+	src     io.Reader
+	reply   func([]byte)
+	charset *charsetState
+	// compress starts and stops MCCP2 output compression. Nil refuses
+	// COMPRESS2 like any other unsupported option.
+	compress compressionControl
+	// color receives the color depth implied by the client's terminal
+	// type. Nil refuses TERMINAL-TYPE like any other unsupported option.
+	color *colorState
+
+	buf []byte
+	out []byte
+	off int
+	err error
+
+	state telnetParseState
+	verb  byte
+	sub   []byte
+	// subOverflow marks a subnegotiation that exceeded maxSubnegotiation.
+	subOverflow bool
+
+	// localCharset is true once we agreed to send CHARSET requests (we
+	// sent WILL and the client answered DO); remoteCharset once the client
+	// may send them (it sent WILL and we answered DO). offered records an
+	// unanswered WILL CHARSET from us.
+	localCharset  bool
+	remoteCharset bool
+	offered       bool
+
+	// localCompress is true while output is compressed (the client
+	// answered DO COMPRESS2); offeredCompress records an unanswered WILL
+	// COMPRESS2 from us.
+	localCompress   bool
+	offeredCompress bool
+
+	// remoteTType is true while the client may report its terminal type
+	// (it sent WILL and we answered DO); offeredTType records an
+	// unanswered DO TERMINAL-TYPE from us. ttypeReplies counts SEND
+	// requests, lastTType and ttypeDepth hold the latest reply and the
+	// depth detected so far, and ttypeDone ends the exchange.
+	remoteTType  bool
+	offeredTType bool
+	ttypeReplies int
+	lastTType    string
+	ttypeDepth   *ansicolor.Depth
+	ttypeDone    bool
+}
+
+func newTelnetReader(src io.Reader, reply func([]byte), charset *charsetState) *telnetReader {
+	return &telnetReader{
+		src:     src,
+		reply:   reply,
+		charset: charset,
+		buf:     make([]byte, 4096),
+	}
+}
+
+// offerCharset announces CHARSET support (IAC WILL CHARSET). A client
+// that understands the option answers DO, upon which the reader sends the
+// list of supported charsets. Call before the read goroutine starts.
+func (r *telnetReader) offerCharset() {
+	r.offered = true
+	r.reply([]byte{iac, will, optCharset})
+}
+
+// offerCompression announces MCCP2 support (IAC WILL COMPRESS2). A client
+// that answers DO gets every later byte of output as one zlib stream; a
+// client that answers DONT, or never answers, keeps plain output. Requires
+// an attached compressionControl. Call before the read goroutine starts.
+func (r *telnetReader) offerCompression() {
+	if r.compress == nil {
+		return
+	}
+	r.offeredCompress = true
+	r.reply([]byte{iac, will, optCompress2})
+}
+
+// Read returns decoded application data, never telnet commands.
+func (r *telnetReader) Read(p []byte) (int, error) {
+	for r.off == len(r.out) {
+		if r.err != nil {
+			// io.EOF must pass through unwrapped for the bufio.Scanner contract;
+			// other errors already carry context from deadlineReader.
+			return 0, r.err
+		}
+		r.out, r.off = r.out[:0], 0
+		n, err := r.src.Read(r.buf)
+		r.parse(r.buf[:n])
+		r.err = err
+	}
+	n := copy(p, r.out[r.off:])
+	r.off += n
+	return n, nil
+}
+
+func (r *telnetReader) parse(data []byte) {
+	cs := r.charset.Current()
+	for _, b := range data {
+		switch r.state {
+		case stateData:
+			switch b {
+			case iac:
+				r.state = stateIAC
+			case 0:
+				// NUL is an NVT no-op (it pads a bare CR); never deliver it.
+			default:
+				r.out = cs.decodeByte(r.out, b)
+			}
+		case stateIAC:
+			switch b {
+			case iac:
+				r.out = cs.decodeByte(r.out, iac)
+				r.state = stateData
+			case will, wont, do, dont:
+				r.verb = b
+				r.state = stateOption
+			case sb:
+				r.sub, r.subOverflow = r.sub[:0], false
+				r.state = stateSub
+			default:
+				// NOP, GA, AYT and the other two-byte commands carry no data.
+				r.state = stateData
+			}
+		case stateOption:
+			r.negotiate(r.verb, b)
+			r.state = stateData
+		case stateSub:
+			if b == iac {
+				r.state = stateSubIAC
+				continue
+			}
+			r.appendSub(b)
+		case stateSubIAC:
+			switch b {
+			case se:
+				if !r.subOverflow {
+					r.subnegotiate(r.sub)
+				}
+				cs = r.charset.Current()
+				r.state = stateData
+			case iac:
+				r.appendSub(iac)
+				r.state = stateSub
+			default:
+				// Malformed subnegotiation; abandon it.
+				r.state = stateData
+			}
+		}
+	}
+}
+
+func (r *telnetReader) appendSub(b byte) {
+	if len(r.sub) >= maxSubnegotiation {
+		r.subOverflow = true
+		return
+	}
+	r.sub = append(r.sub, b)
+}
+
+// negotiate answers a WILL/WONT/DO/DONT. Unsupported options are refused;
+// CHARSET, COMPRESS2 and TERMINAL-TYPE replies are only sent when the option state
+// changes, which keeps a misbehaving peer from starting a negotiation loop
+// (RFC 854).
+func (r *telnetReader) negotiate(verb, opt byte) {
+	switch {
+	case opt == optCharset:
+		r.negotiateCharset(verb)
+	case opt == optCompress2 && r.compress != nil && (verb == do || verb == dont):
+		r.negotiateCompress(verb)
+	case opt == optTType && r.color != nil && (verb == will || verb == wont):
+		r.negotiateTType(verb)
+	default:
+		switch verb {
+		case will:
+			r.reply([]byte{iac, dont, opt})
+		case do:
+			r.reply([]byte{iac, wont, opt})
+		}
+	}
+}
+
+// negotiateCompress handles DO/DONT COMPRESS2. MCCP2 only compresses
+// server output, so a client offering to compress its own (WILL) is
+// refused by negotiate like any unsupported option.
+func (r *telnetReader) negotiateCompress(verb byte) {
+	switch verb {
+	case do:
+		if r.localCompress {
+			return
+		}
+		r.localCompress = true
+		if !r.offeredCompress {
+			r.reply([]byte{iac, will, optCompress2})
+		}
+		r.offeredCompress = false
+		r.compress.startCompression()
+	case dont:
+		switch {
+		case r.localCompress:
+			r.localCompress = false
+			r.compress.stopCompression()
+		case r.offeredCompress:
+			// The client declined our offer; output stays uncompressed.
+			RecordCompressionNegotiated(false)
+		}
+		r.offeredCompress = false
+	}
+}
+
+func (r *telnetReader) negotiateCharset(verb byte) {
+	switch verb {
+	case will:
+		if !r.remoteCharset {
+			r.remoteCharset = true
+			r.reply([]byte{iac, do, optCharset})
+		}
+	case wont:
+		if r.remoteCharset {
+			r.remoteCharset = false
+			r.reply([]byte{iac, dont, optCharset})
+		}
+	case do:
+		if r.localCharset {
+			return
+		}
+		r.localCharset = true
+		if !r.offered {
+			r.reply([]byte{iac, will, optCharset})
+		}
+		r.offered = false
+		r.sendCharsetRequest()
+	case dont:
+		if r.localCharset {
+			r.localCharset = false
+			r.reply([]byte{iac, wont, optCharset})
+		}
+		r.offered = false
+	}
+}
+
+// sendCharsetRequest sends IAC SB CHARSET REQUEST ";UTF-8;ISO-8859-1;IBM437" IAC SE.
+func (r *telnetReader) sendCharsetRequest() {
+	msg := []byte{iac, sb, optCharset, charsetRequest}
+	for _, c := range supportedCharsets {
+		msg = append(msg, ';')
+		msg = append(msg, c.String()...)
+	}
+	r.reply(append(msg, iac, se))
+}
+
+// subnegotiate handles a complete IAC SB ... IAC SE payload.
+func (r *telnetReader) subnegotiate(payload []byte) {
+	if len(payload) >= 2 && payload[0] == optTType && payload[1] == ttypeIs && r.color != nil {
+		r.terminalType(string(payload[2:]))
+		return
+	}
+	if len(payload) < 2 || payload[0] != optCharset {
+		return
+	}
+	args := payload[2:]
+	switch payload[1] {
+	case charsetRequest:
+		r.answerCharsetRequest(args)
+	case charsetAccepted:
+		if c, ok := ParseCharset(string(args)); ok {
+			r.charset.setNegotiated(c)
+			RecordCharsetNegotiated(c)
+		}
+	case charsetRejected:
+		// The client keeps its default; so do we (UTF-8).
+	case charsetTTableIs:
+		r.reply([]byte{iac, sb, optCharset, charsetTTableRejected, iac, se})
+	}
+}
+
+// answerCharsetRequest accepts the first charset in the client's list
+// that the gateway supports, or rejects the request.
+func (r *telnetReader) answerCharsetRequest(args []byte) {
+	if bytes.HasPrefix(args, ttablePrefix) {
+		// Skip "[TTABLE]" and its version byte; translation tables are
+		// not supported, but the plain charset list that follows is.
+		args = args[min(len(args), len(ttablePrefix)+1):]
+	}
+	if len(args) < 2 {
+		r.reply([]byte{iac, sb, optCharset, charsetRejected, iac, se})
+		return
+	}
+	for name := range bytes.SplitSeq(args[1:], args[:1]) {
+		c, ok := ParseCharset(string(name))
+		if !ok {
+			continue
+		}
+		r.charset.setNegotiated(c)
+		RecordCharsetNegotiated(c)
+		msg := append([]byte{iac, sb, optCharset, charsetAccepted}, name...)
+		r.reply(append(msg, iac, se))
+		return
+	}
+	r.reply([]byte{iac, sb, optCharset, charsetRejected, iac, se})
+}
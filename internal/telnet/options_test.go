@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRecordingReader returns a telnetReader over input that records every
+// negotiation reply.
+func newRecordingReader(input []byte) (*telnetReader, *charsetState, *bytes.Buffer) {
+	replies := &bytes.Buffer{}
+	state := &charsetState{}
+	r := newTelnetReader(bytes.NewReader(input), func(b []byte) { replies.Write(b) }, state)
+	return r, state, replies
+}
+
+func charsetSub(cmd byte, args string) []byte {
+	return append(append([]byte{iac, sb, optCharset, cmd}, args...), iac, se)
+}
+
+func TestTelnetReaderStripsCommands(t *testing.T) {
+	input := []byte("lo")
+	input = append(input, iac, 241) // NOP
+	input = append(input, "ok\r\x00\n"...)
+	input = append(input, iac, sb, 24, 0, 'x', iac, se) // TERMINAL-TYPE subnegotiation
+	input = append(input, "a"...)
+	input = append(input, iac, iac)
+
+	r, _, _ := newRecordingReader(input)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte("look\r\na"), 0xFF), got)
+}
+
+func TestTelnetReaderRefusesUnsupportedOptions(t *testing.T) {
+	r, _, replies := newRecordingReader([]byte{iac, will, 31, iac, do, 1, iac, wont, 31})
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, dont, 31, iac, wont, 1}, replies.Bytes())
+}
+
+func TestTelnetReaderServerInitiatedCharset(t *testing.T) {
+	// Client answers our WILL CHARSET with DO, then accepts Latin-1 and
+	// types a Latin-1 encoded "café".
+	input := []byte{iac, do, optCharset}
+	input = append(input, charsetSub(charsetAccepted, "ISO-8859-1")...)
+	input = append(input, 'c', 'a', 'f', 0xE9, '\n')
+
+	r, state, replies := newRecordingReader(input)
+	r.offerCharset()
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	want := []byte{iac, will, optCharset}
+	want = append(want, charsetSub(charsetRequest, ";UTF-8;ISO-8859-1;IBM437")...)
+	assert.Equal(t, want, replies.Bytes(), "a DO answering our offer must not repeat WILL")
+	assert.Equal(t, CharsetLatin1, state.Current())
+	assert.Equal(t, "café\n", string(got))
+}
+
+func TestTelnetReaderAnswersClientRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		request string
+		reply   []byte
+		want    Charset
+	}{
+		{"first supported charset wins", ",KOI8-R,CP437,UTF-8", charsetSub(charsetAccepted, "CP437"), CharsetCP437},
+		{"translation-table prefix is skipped", "[TTABLE]\x01;UTF-8", charsetSub(charsetAccepted, "UTF-8"), CharsetUTF8},
+		{"nothing supported is rejected", ";KOI8-R", []byte{iac, sb, optCharset, charsetRejected, iac, se}, CharsetUTF8},
+		{"empty list is rejected", "", []byte{iac, sb, optCharset, charsetRejected, iac, se}, CharsetUTF8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append([]byte{iac, will, optCharset}, charsetSub(charsetRequest, tt.request)...)
+			r, state, replies := newRecordingReader(input)
+			_, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, append([]byte{iac, do, optCharset}, tt.reply...), replies.Bytes())
+			assert.Equal(t, tt.want, state.Current())
+		})
+	}
+}
+
+func TestTelnetReaderDropsOversizedSubnegotiation(t *testing.T) {
+	input := []byte{iac, sb, optCharset, charsetAccepted}
+	input = append(input, strings.Repeat("x", maxSubnegotiation)...)
+	input = append(input, iac, se, 'o', 'k')
+	r, state, _ := newRecordingReader(input)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", string(got))
+	assert.Equal(t, CharsetUTF8, state.Current())
+}
+
+func TestCharsetOverrideTakesPrecedence(t *testing.T) {
+	state := &charsetState{}
+	state.setNegotiated(CharsetLatin1)
+	cp437 := CharsetCP437
+	state.setOverride(&cp437)
+	assert.Equal(t, CharsetCP437, state.Current())
+	assert.True(t, state.Overridden())
+
+	state.setNegotiated(CharsetUTF8)
+	assert.Equal(t, CharsetCP437, state.Current(), "negotiation must not undo a player override")
+
+	state.setOverride(nil)
+	assert.Equal(t, CharsetUTF8, state.Current())
+}
+
+// TestGatewayHandler_EncodingCommand verifies the ENCODING command pins the
+// connection charset and that subsequent output is transcoded.
+func TestGatewayHandler_EncodingCommand(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler := newTestHandler(serverConn, &mockCoreClient{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	r := bufio.NewReader(clientConn)
+	readLines(t, r, 2) // banner
+
+	_, err := clientConn.Write([]byte("encoding\n"))
+	require.NoError(t, err)
+	assert.Contains(t, readLines(t, r, 1)[0], "Encoding: UTF-8 (negotiated)")
+
+	_, err = clientConn.Write([]byte("encoding klingon\n"))
+	require.NoError(t, err)
+	assert.Contains(t, readLines(t, r, 1)[0], `Unknown encoding "klingon"`)
+
+	_, err = clientConn.Write([]byte("ENCODING latin1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Encoding set to ISO-8859-1.", readLines(t, r, 1)[0])
+
+	// net.Pipe writes block until read, so send from another goroutine.
+	go handler.send("café ☕")
+	assert.Equal(t, "caf\xe9 ?", readLines(t, r, 1)[0])
+
+	_, err = clientConn.Write([]byte("encoding auto\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Encoding: UTF-8 (negotiated).", readLines(t, r, 1)[0])
+
+	cancel()
+	<-done
+}
+
+// TestGatewayHandler_OffersCharset verifies an enabled handler opens the
+// connection with IAC WILL CHARSET ahead of the banner.
+func TestGatewayHandler_OffersCharset(t *testing.T) {
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handler := newTestHandler(serverConn, &mockCoreClient{})
+	handler.EnableCharsetNegotiation()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.Handle(ctx)
+	}()
+
+	r := bufio.NewReader(clientConn)
+	offer := make([]byte, 3)
+	_, err := io.ReadFull(r, offer)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{iac, will, optCharset}, offer)
+	assert.Equal(t, "Welcome to HoloMUSH!", readLines(t, r, 1)[0])
+	readLines(t, r, 1)
+
+	_, err = clientConn.Write([]byte{iac, do, optCharset})
+	require.NoError(t, err)
+	request := charsetSub(charsetRequest, ";UTF-8;ISO-8859-1;IBM437")
+	got := make([]byte, len(request))
+	_, err = io.ReadFull(r, got)
+	require.NoError(t, err)
+	assert.Equal(t, request, got)
+
+	cancel()
+	<-done
+}
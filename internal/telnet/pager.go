@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultPageSize is how many lines of command output a connection shows
+// at once before the player's preferences arrive. It matches the default
+// of the pagesize preference.
+const defaultPageSize = 20
+
+// outputPager holds long command output back from a telnet connection a
+// page at a time, so a WHO list, a history query, or a long description
+// does not flood a slow client. Output that fits on a page passes
+// straight through; the rest is held until the player types MORE. While
+// lines are held, later command output queues behind them so it still
+// reads in order. Accessed only from the single-consumer Handle loop, so
+// it needs no lock.
+type outputPager struct {
+	// size is the page size in lines; zero uses defaultPageSize.
+	size int
+	held []string
+}
+
+// pageSize returns the number of lines shown per page.
+func (p *outputPager) pageSize() int {
+	if p.size > 0 {
+		return p.size
+	}
+	return defaultPageSize
+}
+
+// add takes one message of command output and returns the lines to show
+// now. Lines past the first page, or all of them when output is already
+// held, are held for next.
+func (p *outputPager) add(msg string) []string {
+	lines := strings.Split(strings.TrimRight(msg, "\n"), "\n")
+	if len(p.held) > 0 {
+		p.held = append(p.held, lines...)
+		return nil
+	}
+	if len(lines) <= p.pageSize() {
+		return lines
+	}
+	p.held = lines[p.pageSize():]
+	return lines[:p.pageSize()]
+}
+
+// next returns the next page of held lines, or all of them when all is
+// set.
+func (p *outputPager) next(all bool) []string {
+	n := len(p.held)
+	if !all {
+		n = min(n, p.pageSize())
+	}
+	page := p.held[:n]
+	p.held = p.held[n:]
+	if len(p.held) == 0 {
+		p.held = nil
+	}
+	return page
+}
+
+// discard drops the held lines and returns how many there were.
+func (p *outputPager) discard() int {
+	n := len(p.held)
+	p.held = nil
+	return n
+}
+
+// remaining returns the number of held lines.
+func (p *outputPager) remaining() int {
+	return len(p.held)
+}
+
+// prompt returns the line shown below a page when more is held.
+func (p *outputPager) prompt() string {
+	n := p.remaining()
+	return fmt.Sprintf("-- %d more %s: MORE to continue, MORE ALL for the rest, MORE STOP to discard --", n, pluralLines(n))
+}
+
+// pluralLines returns "line" or "lines" to follow the count n.
+func pluralLines(n int) string {
+	if n == 1 {
+		return "line"
+	}
+	return "lines"
+}
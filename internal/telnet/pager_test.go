@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// numberedLines returns "line 1" through "line n", one per line.
+func numberedLines(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestOutputPagerPassesShortOutputThrough(t *testing.T) {
+	var p outputPager
+	assert.Equal(t, []string{"a", "b"}, p.add("a\nb\n"))
+	assert.Zero(t, p.remaining())
+	assert.Len(t, p.add(numberedLines(defaultPageSize)), defaultPageSize, "a full page is not held")
+	assert.Zero(t, p.remaining())
+}
+
+func TestOutputPagerHoldsPastThePage(t *testing.T) {
+	p := outputPager{size: 5}
+
+	assert.Equal(t, strings.Split(numberedLines(5), "\n"), p.add(numberedLines(12)))
+	assert.Equal(t, 7, p.remaining())
+	assert.Equal(t, "-- 7 more lines: MORE to continue, MORE ALL for the rest, MORE STOP to discard --", p.prompt())
+
+	assert.Nil(t, p.add("later"), "output arriving while lines are held queues behind them")
+	assert.Equal(t, 8, p.remaining())
+
+	assert.Equal(t, []string{"line 6", "line 7", "line 8", "line 9", "line 10"}, p.next(false))
+	assert.Equal(t, "-- 3 more lines: MORE to continue, MORE ALL for the rest, MORE STOP to discard --", p.prompt())
+	assert.Equal(t, []string{"line 11", "line 12", "later"}, p.next(true))
+	assert.Zero(t, p.remaining())
+}
+
+func TestOutputPagerDiscard(t *testing.T) {
+	p := outputPager{size: 5}
+	p.add(numberedLines(6))
+	assert.Equal(t, "-- 1 more line: MORE to continue, MORE ALL for the rest, MORE STOP to discard --", p.prompt())
+	assert.Equal(t, 1, p.discard())
+	assert.Zero(t, p.remaining())
+	assert.Equal(t, []string{"next"}, p.add("next"))
+}
+
+func TestGatewayPagesLongCommandOutput(t *testing.T) {
+	conn := &addrTrackingConn{}
+	h := NewGatewayHandler(conn, &mockCoreClient{}, Limits{WriteTimeout: time.Second})
+	h.authed = true
+	output := func(typ, text string) *corev1.EventFrame {
+		return withRendering(&corev1.EventFrame{Type: typ, Payload: []byte(`{"text":"` + text + `"}`)})
+	}
+	long := strings.ReplaceAll(numberedLines(12), "\n", `\n`)
+
+	h.sendProtoEvent(withRendering(&corev1.EventFrame{Type: "preferences_changed", Payload: []byte(`{"ansi":true,"page_size":5}`)}))
+	h.sendProtoEvent(output("command_response", long))
+	assert.Equal(t, numberedLines(5)+"\n-- 7 more lines: MORE to continue, MORE ALL for the rest, MORE STOP to discard --\n",
+		string(conn.writeBuf))
+
+	conn.writeBuf = nil
+	h.sendProtoEvent(output("system", "Bob has connected."))
+	h.sendProtoEvent(output("command_error", "Huh?"))
+	assert.Equal(t, "Bob has connected.\n[ERROR] Huh?\n", string(conn.writeBuf), "notices and errors are not held")
+
+	conn.writeBuf = nil
+	h.processLine(context.Background(), "more")
+	assert.Equal(t, "line 6\nline 7\nline 8\nline 9\nline 10\n-- 2 more lines: MORE to continue, MORE ALL for the rest, MORE STOP to discard --\n",
+		string(conn.writeBuf))
+
+	conn.writeBuf = nil
+	h.processLine(context.Background(), "MORE stop")
+	h.processLine(context.Background(), "more")
+	assert.Equal(t, "Discarded 2 held lines.\nThere is no more output.\n", string(conn.writeBuf))
+
+	conn.writeBuf = nil
+	h.sendProtoEvent(output("command_response", long))
+	conn.writeBuf = nil
+	h.processLine(context.Background(), "more all")
+	assert.Equal(t, strings.Join(strings.Split(numberedLines(12), "\n")[5:], "\n")+"\n", string(conn.writeBuf))
+}
@@ -4,8 +4,10 @@
 package telnet
 
 import (
+	"context"
 	"log/slog"
 	"net"
+	"strings"
 	"time"
 )
 
@@ -13,6 +15,16 @@ import (
 // Terminated with CRLF because telnet clients expect line-ending pairs.
 const refusalMessage = "Server at capacity. Try again later.\r\n"
 
+// bannedMessage is written to clients refused by a ConnectionGate.
+const bannedMessage = "You are banned from this server.\r\n"
+
+// ConnectionGate decides whether a new telnet connection may proceed before
+// any prompt is shown. Implemented by bans.Service; ipAddress is the bare
+// remote IP.
+type ConnectionGate interface {
+	AddressBanned(ctx context.Context, ipAddress string) (reason string, banned bool, err error)
+}
+
 // RefuseOverCapacity writes a refusal line to conn and closes it. Used by
 // the accept loop when the concurrent-connection semaphore is full. Any
 // write error is logged at debug and swallowed — the client has already
@@ -25,11 +37,27 @@ const refusalMessage = "Server at capacity. Try again later.\r\n"
 // show up in operator metrics.
 func RefuseOverCapacity(conn net.Conn, writeTimeout time.Duration) {
 	RecordConnectionRefused()
+	refuse(conn, refusalMessage, writeTimeout)
+}
+
+// RefuseBanned writes a ban notice, including reason when it is non-empty,
+// to conn and closes it. Like RefuseOverCapacity it counts toward
+// ConnectionsRefusedTotal and swallows write errors.
+func RefuseBanned(conn net.Conn, reason string, writeTimeout time.Duration) {
+	RecordConnectionRefused()
+	msg := bannedMessage
+	// Flatten to one line: the reason is admin-entered text.
+	if reason = strings.Join(strings.Fields(sanitizeTelnetOutput(reason)), " "); reason != "" {
+		msg = "You are banned from this server: " + reason + "\r\n"
+	}
+	refuse(conn, msg, writeTimeout)
+}
 
+func refuse(conn net.Conn, msg string, writeTimeout time.Duration) {
 	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
 		slog.Debug("telnet: failed to set refusal write deadline", "error", err)
 	}
-	if _, err := conn.Write([]byte(refusalMessage)); err != nil {
+	if _, err := conn.Write([]byte(msg)); err != nil {
 		slog.Debug("telnet: failed to write refusal", "error", err)
 	}
 	if err := conn.Close(); err != nil {
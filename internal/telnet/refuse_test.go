@@ -62,3 +62,18 @@ func TestRefuseOverCapacityClosesEvenIfWriteFails(t *testing.T) {
 	RefuseOverCapacity(mc, 30*time.Second)
 	assert.True(t, mc.closed)
 }
+
+func TestRefuseBannedWritesReasonOnOneLine(t *testing.T) {
+	before := testutil.ToFloat64(ConnectionsRefusedTotal)
+
+	mc := &mockRefuseConn{}
+	RefuseBanned(mc, "spam\r\n\x1b[2Jand abuse", 30*time.Second)
+
+	assert.True(t, mc.closed, "connection must be closed")
+	assert.Equal(t, "You are banned from this server: spam and abuse\r\n", string(mc.written))
+	assert.Equal(t, before+1, testutil.ToFloat64(ConnectionsRefusedTotal))
+
+	mc = &mockRefuseConn{}
+	RefuseBanned(mc, "", 30*time.Second)
+	assert.Equal(t, bannedMessage, string(mc.written))
+}
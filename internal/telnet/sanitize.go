@@ -27,6 +27,19 @@ import (
 //
 // Valid UTF-8 code points outside the control ranges are preserved.
 func sanitizeTelnetOutput(s string) string {
+	return sanitizeTelnet(s, false)
+}
+
+// sanitizeTelnetStyled is sanitizeTelnetOutput for server-rendered styled
+// text such as connect screens and the message of the day: SGR sequences
+// (ESC '[' digits and ';' ... 'm'), which only set colors and text
+// attributes, are kept; every other sequence and control character is
+// stripped as before.
+func sanitizeTelnetStyled(s string) string {
+	return sanitizeTelnet(s, true)
+}
+
+func sanitizeTelnet(s string, keepSGR bool) string {
 	if s == "" {
 		return s
 	}
@@ -34,6 +47,13 @@ func sanitizeTelnetOutput(s string) string {
 	b.Grow(len(s))
 	for i := 0; i < len(s); {
 		r, size := utf8.DecodeRuneInString(s[i:])
+		if keepSGR && r == 0x1B {
+			if n := sgrLength(s, i); n > 0 { // ESC [ ... m: colors and attributes.
+				b.WriteString(s[i : i+n])
+				i += n
+				continue
+			}
+		}
 		switch {
 		case r == 0x1B: // ESC: start of an ANSI escape sequence.
 			i = skipEscapeSequence(s, i+size)
@@ -57,6 +77,27 @@ func sanitizeTelnetOutput(s string) string {
 	return b.String()
 }
 
+// sgrLength returns the length of the SGR sequence (ESC '[' params 'm')
+// starting at i, or 0 when none starts there. Params are limited to digits
+// and ';', which excludes every CSI that moves the cursor or edits the
+// screen.
+func sgrLength(s string, i int) int {
+	if i+2 >= len(s) || s[i] != 0x1B || s[i+1] != '[' {
+		return 0
+	}
+	for j := i + 2; j < len(s); j++ {
+		c := s[j]
+		switch {
+		case c == 'm':
+			return j + 1 - i
+		case c >= '0' && c <= '9', c == ';':
+		default:
+			return 0
+		}
+	}
+	return 0
+}
+
 // skipEscapeSequence consumes an ANSI escape sequence that starts with
 // ESC at position i-1 and returns the index past the end of the sequence.
 // A bare ESC with no following byte is consumed on its own.
@@ -108,3 +108,26 @@ func TestSanitizeTelnetOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestSanitizeTelnetStyled(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"keeps SGR colors and attributes", "\x1b[1mbold\x1b[0m \x1b[38;5;208morange\x1b[m", "\x1b[1mbold\x1b[0m \x1b[38;5;208morange\x1b[m"},
+		{"strips cursor movement and clear screen", "before\x1b[2J\x1b[Hafter", "beforeafter"},
+		{"strips private-mode CSI ending in m", "a\x1b[?25mb", "ab"},
+		{"strips OSC title change", "a\x1b]0;evil\x07b", "ab"},
+		{"strips bare ESC at end", "text\x1b", "text"},
+		{"strips unterminated SGR", "text\x1b[31", "text"},
+		{"strips C0 controls", "a\x07b\x00c", "abc"},
+		{"preserves newlines", "line1\nline2", "line1\nline2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sanitizeTelnetStyled(tt.input))
+		})
+	}
+	assert.Equal(t, "bold", sanitizeTelnetOutput("\x1b[1mbold\x1b[0m"), "the plain path still strips SGR")
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// floodStep is the outcome of offering one command line to an inputThrottle.
+type floodStep int
+
+const (
+	// floodAllow forwards the line.
+	floodAllow floodStep = iota
+	// floodDrop discards the line without a further escalation.
+	floodDrop
+	// floodWarn discards the line and tells the client to slow down.
+	floodWarn
+	// floodThrottle discards the line and cuts the client's refill rate.
+	floodThrottle
+	// floodDisconnect discards the line and closes the connection.
+	floodDisconnect
+)
+
+// floodStepActions maps each escalation step to the action reported to core
+// and the label recorded in InputFloodActionsTotal.
+var floodStepActions = map[floodStep]struct {
+	action corev1.InputFloodAction
+	label  string
+}{
+	floodWarn:       {corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN, "warn"},
+	floodThrottle:   {corev1.InputFloodAction_INPUT_FLOOD_ACTION_THROTTLE, "throttle"},
+	floodDisconnect: {corev1.InputFloodAction_INPUT_FLOOD_ACTION_DISCONNECT, "disconnect"},
+}
+
+const (
+	// floodThrottleStrikes is the dropped-line count at which a warned
+	// connection is throttled.
+	floodThrottleStrikes = 10
+	// floodDisconnectStrikes is the dropped-line count at which a throttled
+	// connection is disconnected.
+	floodDisconnectStrikes = 30
+	// floodQuietPeriod is how long a connection must go without a dropped
+	// line before its escalation resets to normal.
+	floodQuietPeriod = time.Minute
+	// floodThrottleDivisor divides the refill rate while throttled.
+	floodThrottleDivisor = 4
+)
+
+// inputThrottle is a per-connection token bucket over command lines with
+// escalating responses (warn → throttle → disconnect) for a client that
+// keeps overrunning it. Accessed only from the single-consumer Handle loop,
+// so it needs no lock.
+type inputThrottle struct {
+	burst     float64
+	rate      float64
+	tokens    float64
+	lastCheck time.Time
+
+	// dropped counts lines discarded since the escalation last reset;
+	// lastDrop is when the most recent one was.
+	dropped   int32
+	lastDrop  time.Time
+	throttled bool
+}
+
+// newInputThrottle builds the throttle for limits, falling back to
+// DefaultLimits for a zero burst or rate (e.g. Limits{} literals in tests).
+func newInputThrottle(limits Limits) *inputThrottle {
+	burst, rate := limits.InputBurst, limits.InputRate
+	if burst <= 0 {
+		burst = DefaultLimits.InputBurst
+	}
+	if rate <= 0 {
+		rate = DefaultLimits.InputRate
+	}
+	return &inputThrottle{burst: float64(burst), rate: rate, tokens: float64(burst)}
+}
+
+// offer consumes one line from the budget at now and reports what to do
+// with it. Escalation steps are returned once each, on the drop that
+// crosses them; other over-budget lines return floodDrop.
+func (t *inputThrottle) offer(now time.Time) floodStep {
+	if t.dropped > 0 && now.Sub(t.lastDrop) >= floodQuietPeriod {
+		t.dropped = 0
+		t.throttled = false
+	}
+
+	rate := t.rate
+	if t.throttled {
+		rate /= floodThrottleDivisor
+	}
+	if !t.lastCheck.IsZero() {
+		t.tokens += now.Sub(t.lastCheck).Seconds() * rate
+		if t.tokens > t.burst {
+			t.tokens = t.burst
+		}
+	}
+	t.lastCheck = now
+
+	if t.tokens >= 1.0 {
+		t.tokens -= 1.0
+		return floodAllow
+	}
+
+	t.dropped++
+	t.lastDrop = now
+	switch t.dropped {
+	case 1:
+		return floodWarn
+	case floodThrottleStrikes:
+		t.throttled = true
+		return floodThrottle
+	case floodDisconnectStrikes:
+		return floodDisconnect
+	}
+	return floodDrop
+}
+
+// throttledRate is the refill rate a throttled connection is held to, for
+// the notice shown to the client.
+func (t *inputThrottle) throttledRate() string {
+	perMinute := t.rate / floodThrottleDivisor * 60
+	return fmt.Sprintf("%.0f commands per minute", perMinute)
+}
+
+// outputSquelchWindow bounds how far apart two identical event lines may
+// arrive and still be collapsed into a repeat count.
+const outputSquelchWindow = 10 * time.Second
+
+// outputSquelch collapses runs of identical event lines: the first line is
+// shown, repeats within outputSquelchWindow are counted instead, and the
+// count is summarized before the next distinct line. Accessed only from the
+// single-consumer Handle loop, so it needs no lock.
+type outputSquelch struct {
+	last     string
+	lastSeen time.Time
+	repeats  int
+}
+
+// filter reports whether msg should be shown at now, and the repeat summary
+// (or "") to show before it.
+func (q *outputSquelch) filter(msg string, now time.Time) (show bool, summary string) {
+	if msg == q.last && now.Sub(q.lastSeen) < outputSquelchWindow {
+		q.lastSeen = now
+		q.repeats++
+		return false, ""
+	}
+	summary = q.flush()
+	q.last = msg
+	q.lastSeen = now
+	return true, summary
+}
+
+// flush returns the pending repeat summary, or "" when nothing was
+// collapsed, and clears the count.
+func (q *outputSquelch) flush() string {
+	n := q.repeats
+	q.repeats = 0
+	switch n {
+	case 0:
+		return ""
+	case 1:
+		return "(previous message repeated 1 time)"
+	}
+	return fmt.Sprintf("(previous message repeated %d times)", n)
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package telnet
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// addrTrackingConn is a mockDeadlineTrackingConn that also answers
+// RemoteAddr, which admitLine logs.
+type addrTrackingConn struct {
+	mockDeadlineTrackingConn
+}
+
+func (c *addrTrackingConn) RemoteAddr() net.Addr {
+	return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 4201}
+}
+
+func TestInputThrottleAllowsBurstThenEscalates(t *testing.T) {
+	th := newInputThrottle(Limits{InputBurst: 3, InputRate: 1})
+	now := time.Unix(1_700_000_000, 0)
+
+	for i := range 3 {
+		assert.Equal(t, floodAllow, th.offer(now), "line %d within burst", i)
+	}
+
+	// Every over-budget line is dropped; the escalations fire exactly once
+	// each, on the drop that crosses their strike count.
+	var steps []floodStep
+	for range floodDisconnectStrikes {
+		steps = append(steps, th.offer(now))
+	}
+	assert.Equal(t, floodWarn, steps[0])
+	assert.Equal(t, floodThrottle, steps[floodThrottleStrikes-1])
+	assert.Equal(t, floodDisconnect, steps[floodDisconnectStrikes-1])
+	for i, s := range steps {
+		if i != 0 && i != floodThrottleStrikes-1 && i != floodDisconnectStrikes-1 {
+			assert.Equal(t, floodDrop, s, "drop %d", i+1)
+		}
+	}
+	assert.Equal(t, int32(floodDisconnectStrikes), th.dropped)
+}
+
+func TestInputThrottleRefillsAtRate(t *testing.T) {
+	th := newInputThrottle(Limits{InputBurst: 1, InputRate: 2})
+	now := time.Unix(1_700_000_000, 0)
+
+	assert.Equal(t, floodAllow, th.offer(now))
+	assert.Equal(t, floodWarn, th.offer(now))
+	// Half a second at 2/s refills one token.
+	assert.Equal(t, floodAllow, th.offer(now.Add(500*time.Millisecond)))
+}
+
+func TestInputThrottleSlowsRefillWhileThrottled(t *testing.T) {
+	th := newInputThrottle(Limits{InputBurst: 1, InputRate: 4})
+	now := time.Unix(1_700_000_000, 0)
+
+	assert.Equal(t, floodAllow, th.offer(now))
+	for range floodThrottleStrikes {
+		th.offer(now)
+	}
+	require.True(t, th.throttled)
+
+	// A quarter second refills a token at the full rate but not at the
+	// throttled quarter rate.
+	now = now.Add(250 * time.Millisecond)
+	assert.Equal(t, floodDrop, th.offer(now))
+	now = now.Add(time.Second)
+	assert.Equal(t, floodAllow, th.offer(now))
+}
+
+func TestInputThrottleResetsAfterQuietPeriod(t *testing.T) {
+	th := newInputThrottle(Limits{InputBurst: 1, InputRate: 1})
+	now := time.Unix(1_700_000_000, 0)
+
+	th.offer(now)
+	for range floodThrottleStrikes {
+		th.offer(now)
+	}
+	require.True(t, th.throttled)
+
+	now = now.Add(floodQuietPeriod)
+	assert.Equal(t, floodAllow, th.offer(now))
+	assert.False(t, th.throttled)
+	assert.Equal(t, int32(0), th.dropped)
+	// The next overrun warns again rather than resuming the old count.
+	assert.Equal(t, floodWarn, th.offer(now))
+}
+
+func TestNewInputThrottleFallsBackToDefaults(t *testing.T) {
+	th := newInputThrottle(Limits{})
+	assert.InDelta(t, float64(DefaultLimits.InputBurst), th.burst, 0)
+	assert.InDelta(t, DefaultLimits.InputRate, th.rate, 0)
+	assert.Equal(t, "60 commands per minute", th.throttledRate())
+}
+
+func TestOutputSquelchCollapsesRepeats(t *testing.T) {
+	var q outputSquelch
+	now := time.Unix(1_700_000_000, 0)
+
+	show, summary := q.filter("spam", now)
+	assert.True(t, show)
+	assert.Empty(t, summary)
+
+	for i := range 3 {
+		show, summary = q.filter("spam", now.Add(time.Duration(i+1)*time.Second))
+		assert.False(t, show)
+		assert.Empty(t, summary)
+	}
+
+	show, summary = q.filter("hello", now.Add(5*time.Second))
+	assert.True(t, show)
+	assert.Equal(t, "(previous message repeated 3 times)", summary)
+}
+
+func TestOutputSquelchShowsRepeatAfterWindow(t *testing.T) {
+	var q outputSquelch
+	now := time.Unix(1_700_000_000, 0)
+
+	q.filter("tick", now)
+	show, summary := q.filter("tick", now.Add(outputSquelchWindow))
+	assert.True(t, show, "a repeat outside the window is shown")
+	assert.Empty(t, summary)
+
+	q.filter("tick", now.Add(outputSquelchWindow+time.Second))
+	_, summary = q.filter("tock", now.Add(outputSquelchWindow+2*time.Second))
+	assert.Equal(t, "(previous message repeated 1 time)", summary)
+}
+
+func TestAdmitLineReportsEscalationsWhenAuthed(t *testing.T) {
+	conn := &addrTrackingConn{}
+	mc := &mockCoreClient{}
+	h := NewGatewayHandler(conn, mc, Limits{WriteTimeout: time.Second, InputBurst: 1, InputRate: 1})
+	h.authed = true
+	h.sessionID = "sess-1"
+	h.connectionID = "conn-1"
+	h.playerSessionToken = "tok-1"
+	now := time.Unix(1_700_000_000, 0)
+	warnBefore := testutil.ToFloat64(InputFloodActionsTotal.WithLabelValues("warn"))
+
+	forward, closeConn := h.admitLine(context.Background(), now)
+	assert.True(t, forward)
+	assert.False(t, closeConn)
+
+	forward, closeConn = h.admitLine(context.Background(), now)
+	assert.False(t, forward)
+	assert.False(t, closeConn)
+	assert.Contains(t, string(conn.writeBuf), "sending commands too fast")
+	assert.Equal(t, warnBefore+1, testutil.ToFloat64(InputFloodActionsTotal.WithLabelValues("warn")))
+
+	for range floodDisconnectStrikes - 1 {
+		_, closeConn = h.admitLine(context.Background(), now)
+	}
+	assert.True(t, closeConn, "the disconnect strike closes the connection")
+	assert.Contains(t, string(conn.writeBuf), "Input throttled")
+	assert.Contains(t, string(conn.writeBuf), "Disconnected for flooding.")
+
+	require.Len(t, mc.floodReqs, 3)
+	assert.Equal(t, corev1.InputFloodAction_INPUT_FLOOD_ACTION_WARN, mc.floodReqs[0].GetAction())
+	assert.Equal(t, corev1.InputFloodAction_INPUT_FLOOD_ACTION_THROTTLE, mc.floodReqs[1].GetAction())
+	assert.Equal(t, corev1.InputFloodAction_INPUT_FLOOD_ACTION_DISCONNECT, mc.floodReqs[2].GetAction())
+	assert.Equal(t, int32(floodDisconnectStrikes), mc.floodReqs[2].GetDroppedCommands())
+	assert.Equal(t, "sess-1", mc.floodReqs[2].GetSessionId())
+	assert.Equal(t, "conn-1", mc.floodReqs[2].GetConnectionId())
+	assert.Equal(t, "tok-1", mc.floodReqs[2].GetPlayerSessionToken())
+}
+
+func TestAdmitLineDoesNotReportBeforeAuth(t *testing.T) {
+	conn := &addrTrackingConn{}
+	mc := &mockCoreClient{}
+	h := NewGatewayHandler(conn, mc, Limits{WriteTimeout: time.Second, InputBurst: 1, InputRate: 1})
+	now := time.Unix(1_700_000_000, 0)
+
+	h.admitLine(context.Background(), now)
+	forward, _ := h.admitLine(context.Background(), now)
+	assert.False(t, forward)
+	assert.Contains(t, string(conn.writeBuf), "sending commands too fast")
+	assert.Empty(t, mc.floodReqs)
+}
+
+func TestSendProtoEventSquelchesRepeatedLines(t *testing.T) {
+	conn := &addrTrackingConn{}
+	h := NewGatewayHandler(conn, &mockCoreClient{}, Limits{WriteTimeout: time.Second})
+	before := testutil.ToFloat64(OutputSquelchedTotal)
+
+	say := func(msg string) *corev1.EventFrame {
+		return withRendering(&corev1.EventFrame{
+			Type:    "core-communication:say",
+			Payload: []byte(`{"character_name":"Alice","message":"` + msg + `"}`),
+		})
+	}
+	h.sendProtoEvent(say("buy now"))
+	h.sendProtoEvent(say("buy now"))
+	h.sendProtoEvent(say("buy now"))
+	h.sendProtoEvent(say("bye"))
+
+	out := string(conn.writeBuf)
+	assert.Equal(t, 1, strings.Count(out, "buy now"))
+	assert.Contains(t, out, "(previous message repeated 2 times)")
+	assert.Contains(t, out, "bye")
+	assert.Equal(t, before+2, testutil.ToFloat64(OutputSquelchedTotal))
+}
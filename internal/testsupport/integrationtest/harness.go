@@ -654,6 +654,7 @@ func Start(t *testing.T, opts ...StartOption) *Server {
 	// CoreServer wired with all required subsystems.
 	coreServerOpts := []holoGRPC.CoreServerOption{
 		holoGRPC.WithAuthService(authService),
+		holoGRPC.WithWebSessions(authService),
 		holoGRPC.WithPlayerSessionRepo(playerSessionStore),
 		holoGRPC.WithPlayerRepo(playerRepo),
 		holoGRPC.WithCharacterRepo(charRepo),
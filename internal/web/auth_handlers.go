@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/samber/oops"
@@ -29,10 +30,32 @@ func signalSessionCookie(h http.Header, token string, ttlSeconds int64) {
 	}
 }
 
+// signalWebSessionCookies sets the signal headers for a web session's
+// access and refresh tokens. The session cookie expires accessCookieMargin
+// before the access token so the browser drops it, and
+// SessionRefreshMiddleware refreshes, before core would reject it.
+func signalWebSessionCookies(h http.Header, accessToken string, accessTTLSeconds int64, refreshToken string, refreshTTLSeconds int64) {
+	accessMaxAge := accessTTLSeconds - int64(accessCookieMargin.Seconds())
+	if accessMaxAge < 1 {
+		accessMaxAge = 1
+	}
+	signalSessionCookie(h, accessToken, accessMaxAge)
+	h.Set(headerSetRefreshToken, refreshToken)
+	if refreshTTLSeconds > 0 {
+		h.Set(headerSetRefreshMaxAge, strconv.FormatInt(refreshTTLSeconds, 10))
+	}
+}
+
+// accessCookieMargin is how much sooner than its access token the session
+// cookie of a web session expires, absorbing clock skew and request latency.
+const accessCookieMargin = 30 * time.Second
+
 // Cookie signal headers used to communicate between handlers and CookieMiddleware.
 const (
 	headerSetSessionToken    = "X-Set-Session-Token" //nolint:gosec // not a credential, just a header name
 	headerSetSessionMaxAge   = "X-Set-Session-Max-Age"
+	headerSetRefreshToken    = "X-Set-Refresh-Token" //nolint:gosec // not a credential, just a header name
+	headerSetRefreshMaxAge   = "X-Set-Refresh-Max-Age"
 	headerClearSession       = "X-Clear-Session"
 	headerInjectSessionToken = "X-Session-Token"
 )
@@ -106,7 +129,9 @@ func isPlayerSessionAuthFailure(err error) bool {
 		code == "SESSION_NOT_FOUND"
 }
 
-// WebAuthenticatePlayer validates player credentials and returns a player token with character list.
+// WebAuthenticatePlayer validates player credentials and starts a web session
+// with a refresh token, returning the character list. The session cookie holds
+// the short-lived access token and the refresh cookie its refresh token.
 func (h *Handler) WebAuthenticatePlayer(ctx context.Context, req *connect.Request[webv1.WebAuthenticatePlayerRequest]) (*connect.Response[webv1.WebAuthenticatePlayerResponse], error) {
 	slog.DebugContext(ctx, "web: WebAuthenticatePlayer", "username", req.Msg.GetUsername())
 
@@ -124,10 +149,10 @@ func (h *Handler) WebAuthenticatePlayer(ctx context.Context, req *connect.Reques
 	rpcCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 	defer cancel()
 
-	coreResp, err := h.client.AuthenticatePlayer(rpcCtx, &corev1.AuthenticatePlayerRequest{
-		Username:   req.Msg.GetUsername(),
-		Password:   req.Msg.GetPassword(),
-		RememberMe: req.Msg.GetRememberMe(),
+	coreResp, err := h.client.AuthenticateWebSession(rpcCtx, &corev1.AuthenticateWebSessionRequest{
+		Username:  req.Msg.GetUsername(),
+		Password:  req.Msg.GetPassword(),
+		UserAgent: req.Header().Get("User-Agent"),
 	})
 	if err != nil {
 		errutil.LogErrorContext(ctx, "web: authenticate player RPC failed", err)
@@ -146,7 +171,9 @@ func (h *Handler) WebAuthenticatePlayer(ctx context.Context, req *connect.Reques
 		Characters:         translateCharacterSummaries(coreResp.GetCharacters()),
 		DefaultCharacterId: coreResp.GetDefaultCharacterId(),
 	})
-	signalSessionCookie(resp.Header(), coreResp.GetPlayerSessionToken(), coreResp.GetSessionTtlSeconds())
+	signalWebSessionCookies(resp.Header(),
+		coreResp.GetAccessToken(), coreResp.GetAccessTtlSeconds(),
+		coreResp.GetRefreshToken(), coreResp.GetRefreshTtlSeconds())
 	return resp, nil
 }
 
@@ -66,9 +66,12 @@ func TestPlayerTokenFromHeader_Empty(t *testing.T) {
 
 func TestWebAuthenticatePlayerSetsSessionTokenAndReturnsCharactersOnSuccess(t *testing.T) {
 	client := &mockCoreClient{
-		authPlayerResp: &corev1.AuthenticatePlayerResponse{
-			Success:            true,
-			PlayerSessionToken: "tok-abc",
+		authPlayerResp: &corev1.AuthenticateWebSessionResponse{
+			Success:           true,
+			AccessToken:       "tok-abc",
+			AccessTtlSeconds:  900,
+			RefreshToken:      "ref-abc",
+			RefreshTtlSeconds: 86400,
 			Characters: []*corev1.CharacterSummary{
 				{CharacterId: "c1", CharacterName: "Alice"},
 			},
@@ -77,22 +80,31 @@ func TestWebAuthenticatePlayerSetsSessionTokenAndReturnsCharactersOnSuccess(t *t
 	}
 	h := NewHandler(client)
 
-	resp, err := h.WebAuthenticatePlayer(context.Background(), connect.NewRequest(&webv1.WebAuthenticatePlayerRequest{
+	req := connect.NewRequest(&webv1.WebAuthenticatePlayerRequest{
 		Username:   "user",
 		Password:   "pass",
 		RememberMe: true,
-	}))
+	})
+	req.Header().Set("User-Agent", "Firefox")
+	resp, err := h.WebAuthenticatePlayer(context.Background(), req)
 	require.NoError(t, err)
 	assert.True(t, resp.Msg.GetSuccess())
 	assert.Len(t, resp.Msg.GetCharacters(), 1)
 	assert.Equal(t, "Alice", resp.Msg.GetCharacters()[0].GetCharacterName())
 	assert.Equal(t, "c1", resp.Msg.GetDefaultCharacterId())
+	assert.Equal(t, "Firefox", client.authPlayerReq.GetUserAgent())
+
+	// The session cookie holds the access token and lapses just before it;
+	// the refresh cookie lasts the session.
 	assert.Equal(t, "tok-abc", resp.Header().Get(headerSetSessionToken))
+	assert.Equal(t, "870", resp.Header().Get(headerSetSessionMaxAge))
+	assert.Equal(t, "ref-abc", resp.Header().Get(headerSetRefreshToken))
+	assert.Equal(t, "86400", resp.Header().Get(headerSetRefreshMaxAge))
 }
 
 func TestWebAuthenticatePlayer_CoreFailure(t *testing.T) {
 	client := &mockCoreClient{
-		authPlayerResp: &corev1.AuthenticatePlayerResponse{
+		authPlayerResp: &corev1.AuthenticateWebSessionResponse{
 			Success:      false,
 			ErrorMessage: "bad credentials",
 		},
@@ -127,9 +139,9 @@ func TestWebAuthenticatePlayer_RPCError(t *testing.T) {
 
 func TestWebAuthenticatePlayer_NoRememberMe(t *testing.T) {
 	client := &mockCoreClient{
-		authPlayerResp: &corev1.AuthenticatePlayerResponse{
-			Success:            true,
-			PlayerSessionToken: "tok-short",
+		authPlayerResp: &corev1.AuthenticateWebSessionResponse{
+			Success:     true,
+			AccessToken: "tok-short",
 		},
 	}
 	h := NewHandler(client)
@@ -810,13 +822,38 @@ func TestCookieMiddleware_ClearsSessionCookie(t *testing.T) {
 	handler.ServeHTTP(rr, req)
 
 	cookies := rr.Result().Cookies()
-	require.Len(t, cookies, 1)
+	require.Len(t, cookies, 2)
 	assert.Equal(t, cookieName, cookies[0].Name)
 	assert.Equal(t, -1, cookies[0].MaxAge)
+	assert.Equal(t, refreshCookieName, cookies[1].Name)
+	assert.Equal(t, -1, cookies[1].MaxAge)
 
 	assert.Empty(t, rr.Header().Get(headerClearSession))
 }
 
+func TestCookieMiddleware_SetsRefreshCookie(t *testing.T) {
+	inner := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set(headerSetRefreshToken, "ref-token")
+		w.Header().Set(headerSetRefreshMaxAge, "3600")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := CookieMiddleware(true, inner)
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	cookies := rr.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, refreshCookieName, cookies[0].Name)
+	assert.Equal(t, "ref-token", cookies[0].Value)
+	assert.Equal(t, 3600, cookies[0].MaxAge)
+	assert.True(t, cookies[0].HttpOnly)
+
+	assert.Empty(t, rr.Header().Get(headerSetRefreshToken))
+	assert.Empty(t, rr.Header().Get(headerSetRefreshMaxAge))
+}
+
 func TestCookieMiddleware_NoSignalHeaders_NoCookie(t *testing.T) {
 	inner := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -1070,14 +1107,14 @@ func TestWebAuthenticatePlayerReturnsAlreadyAuthenticatedWhenCookieValid(t *test
 	assert.Equal(t, "ALREADY_AUTHENTICATED", resp.Msg.GetErrorCode())
 	assert.Equal(t, "Real Player", resp.Msg.GetCurrentPlayerName())
 	assert.Contains(t, resp.Msg.GetErrorMessage(), "Real Player")
-	assert.Equal(t, int32(0), client.authPlayerCalls.Load(), "AuthenticatePlayer MUST NOT run; cap eviction stays untouched")
+	assert.Equal(t, int32(0), client.authPlayerCalls.Load(), "AuthenticateWebSession MUST NOT run; cap eviction stays untouched")
 	assert.Empty(t, resp.Header().Get(headerSetSessionToken), "no Set-Cookie on gate hit")
 }
 
 func TestWebAuthenticatePlayerProceedsWhenCookieAbsent(t *testing.T) {
 	client := &mockCoreClient{
-		authPlayerResp: &corev1.AuthenticatePlayerResponse{
-			Success: true, PlayerSessionToken: "fresh-token",
+		authPlayerResp: &corev1.AuthenticateWebSessionResponse{
+			Success: true, AccessToken: "fresh-token",
 		},
 	}
 	h := NewHandler(client)
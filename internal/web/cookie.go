@@ -12,6 +12,12 @@ import (
 const (
 	cookieName   = "holomush_session"
 	cookieMaxAge = 86400 // 24 hours (default, used when caller supplies no TTL)
+
+	// refreshCookieName holds a web session's refresh token. The session
+	// cookie then holds the short-lived access token, and
+	// SessionRefreshMiddleware trades the refresh token for a new pair once
+	// the browser drops the expired access cookie.
+	refreshCookieName = "holomush_refresh"
 )
 
 // SetSessionCookie writes an HTTP session cookie to the response. The cookie's
@@ -29,12 +35,27 @@ func SetSessionCookie(w http.ResponseWriter, token string, secure bool, maxAge i
 	http.SetCookie(w, sessionCookie(token, maxAge, secure))
 }
 
-// ClearSessionCookie expires the session cookie immediately. The Secure flag
-// and SameSite policy MUST match the original SetSessionCookie call so that
-// browsers consistently remove the cookie; mismatched attributes can leave
-// stale cookies in place.
+// SetRefreshCookie writes the refresh token cookie of a web session. Its
+// MaxAge is the session's remaining lifetime, so it outlives the access
+// token held in the session cookie.
+func SetRefreshCookie(w http.ResponseWriter, token string, secure bool, maxAge int) {
+	if maxAge <= 0 {
+		maxAge = cookieMaxAge
+	}
+	c := sessionCookie(token, maxAge, secure)
+	c.Name = refreshCookieName
+	http.SetCookie(w, c)
+}
+
+// ClearSessionCookie expires the session and refresh cookies immediately.
+// The Secure flag and SameSite policy MUST match the original
+// SetSessionCookie call so that browsers consistently remove the cookies;
+// mismatched attributes can leave stale cookies in place.
 func ClearSessionCookie(w http.ResponseWriter, secure bool) {
 	http.SetCookie(w, sessionCookie("", -1, secure))
+	refresh := sessionCookie("", -1, secure)
+	refresh.Name = refreshCookieName
+	http.SetCookie(w, refresh)
 }
 
 // sessionCookie builds the session cookie with Secure and SameSite attributes
@@ -69,6 +90,16 @@ func GetSessionToken(r *http.Request) string {
 	return cookie.Value
 }
 
+// GetRefreshToken extracts the refresh token from the request cookie.
+// Returns an empty string if no cookie is present.
+func GetRefreshToken(r *http.Request) string {
+	cookie, err := r.Cookie(refreshCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
 // CookieMiddleware translates between internal signal headers and HTTP cookies.
 // On inbound requests, it copies the session cookie value into the
 // X-Session-Token header so handlers can read it. On outbound responses, it
@@ -144,6 +175,13 @@ func (cw *cookieWriter) applyCookieHeaders() {
 		h.Del(headerSetSessionMaxAge)
 	}
 
+	if token := h.Get(headerSetRefreshToken); token != "" {
+		maxAge := parseMaxAgeHeader(h.Get(headerSetRefreshMaxAge))
+		SetRefreshCookie(cw.ResponseWriter, token, cw.secure, maxAge)
+		h.Del(headerSetRefreshToken)
+		h.Del(headerSetRefreshMaxAge)
+	}
+
 	if h.Get(headerClearSession) == "true" {
 		ClearSessionCookie(cw.ResponseWriter, cw.secure)
 		h.Del(headerClearSession)
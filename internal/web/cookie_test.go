@@ -56,23 +56,47 @@ func TestClearSessionCookieSecureSetsSecureAndStrictSameSite(t *testing.T) {
 	w := httptest.NewRecorder()
 	ClearSessionCookie(w, true)
 	cookies := w.Result().Cookies()
-	require.Len(t, cookies, 1)
+	require.Len(t, cookies, 2, "session and refresh cookies MUST both be cleared")
 	assert.Equal(t, "holomush_session", cookies[0].Name)
-	assert.Equal(t, -1, cookies[0].MaxAge)
-	assert.True(t, cookies[0].HttpOnly)
-	assert.True(t, cookies[0].Secure, "Secure flag MUST match SetSessionCookie so browsers reliably clear the cookie")
-	assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+	assert.Equal(t, "holomush_refresh", cookies[1].Name)
+	for _, c := range cookies {
+		assert.Equal(t, -1, c.MaxAge)
+		assert.True(t, c.HttpOnly)
+		assert.True(t, c.Secure, "Secure flag MUST match SetSessionCookie so browsers reliably clear the cookie")
+		assert.Equal(t, http.SameSiteStrictMode, c.SameSite)
+	}
 }
 
 func TestClearSessionCookieInsecureSetsLaxSameSiteAndNoSecure(t *testing.T) {
 	w := httptest.NewRecorder()
 	ClearSessionCookie(w, false)
 	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 2)
+	for _, c := range cookies {
+		assert.Equal(t, -1, c.MaxAge)
+		assert.True(t, c.HttpOnly)
+		assert.False(t, c.Secure)
+		assert.Equal(t, http.SameSiteLaxMode, c.SameSite)
+	}
+}
+
+func TestSetRefreshCookieSetsHttpOnlyCookie(t *testing.T) {
+	w := httptest.NewRecorder()
+	SetRefreshCookie(w, "ref-token", true, 7200)
+	cookies := w.Result().Cookies()
 	require.Len(t, cookies, 1)
-	assert.Equal(t, -1, cookies[0].MaxAge)
+	assert.Equal(t, "holomush_refresh", cookies[0].Name)
+	assert.Equal(t, "ref-token", cookies[0].Value)
+	assert.Equal(t, 7200, cookies[0].MaxAge)
 	assert.True(t, cookies[0].HttpOnly)
-	assert.False(t, cookies[0].Secure)
-	assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+	assert.True(t, cookies[0].Secure)
+}
+
+func TestGetRefreshToken(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	assert.Empty(t, GetRefreshToken(req))
+	req.AddCookie(&http.Cookie{Name: "holomush_refresh", Value: "ref-token"})
+	assert.Equal(t, "ref-token", GetRefreshToken(req))
 }
 
 func TestGetSessionToken(t *testing.T) {
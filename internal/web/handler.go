@@ -68,7 +68,8 @@ type CoreClient interface {
 	Disconnect(ctx context.Context, req *corev1.DisconnectRequest) (*corev1.DisconnectResponse, error)
 	GetCommandHistory(ctx context.Context, req *corev1.GetCommandHistoryRequest) (*corev1.GetCommandHistoryResponse, error)
 	// Auth RPCs (two-phase login)
-	AuthenticatePlayer(ctx context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error)
+	AuthenticateWebSession(ctx context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error)
+	RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error)
 	SelectCharacter(ctx context.Context, req *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error)
 	CreatePlayer(ctx context.Context, req *corev1.CreatePlayerRequest) (*corev1.CreatePlayerResponse, error)
 	CreateCharacter(ctx context.Context, req *corev1.CreateCharacterRequest) (*corev1.CreateCharacterResponse, error)
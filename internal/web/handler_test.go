@@ -56,9 +56,13 @@ type mockCoreClient struct {
 	cmdHistoryReq    *corev1.GetCommandHistoryRequest // captured for assertion
 
 	// Auth RPC fields
-	authPlayerResp     *corev1.AuthenticatePlayerResponse
+	authPlayerResp     *corev1.AuthenticateWebSessionResponse
 	authPlayerErr      error
-	authPlayerCalls    atomic.Int32 // call counter; atomic for use under -race in concurrent tests
+	authPlayerCalls    atomic.Int32                          // call counter; atomic for use under -race in concurrent tests
+	authPlayerReq      *corev1.AuthenticateWebSessionRequest // captured for assertion
+	refreshResp        *corev1.RefreshWebSessionResponse
+	refreshErr         error
+	refreshCalls       atomic.Int32
 	selectCharResp     *corev1.SelectCharacterResponse
 	selectCharErr      error
 	createPlayerResp   *corev1.CreatePlayerResponse
@@ -157,11 +161,17 @@ func (m *mockCoreClient) GetCommandHistory(_ context.Context, req *corev1.GetCom
 	}, nil
 }
 
-func (m *mockCoreClient) AuthenticatePlayer(_ context.Context, _ *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error) {
+func (m *mockCoreClient) AuthenticateWebSession(_ context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error) {
 	m.authPlayerCalls.Add(1)
+	m.authPlayerReq = req
 	return m.authPlayerResp, m.authPlayerErr
 }
 
+func (m *mockCoreClient) RefreshWebSession(_ context.Context, _ *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	m.refreshCalls.Add(1)
+	return m.refreshResp, m.refreshErr
+}
+
 func (m *mockCoreClient) SelectCharacter(_ context.Context, _ *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error) {
 	return m.selectCharResp, m.selectCharErr
 }
@@ -85,6 +85,12 @@ func NewServer(cfg Config) (*Server, error) {
 		connect.WithReadMaxBytes(maxRequestBytes),
 		connect.WithInterceptors(statusTranslationInterceptor()),
 	)
+	// Refresh web sessions whose access cookie has lapsed before the RPC
+	// runs. Only ConnectRPC requests refresh; static assets never need the
+	// session.
+	if cfg.Handler != nil {
+		connectHandler = SessionRefreshMiddleware(cfg.Handler.client, connectHandler)
+	}
 	mux.Handle(path, connectHandler)
 
 	// Register Sentry envelope relay if SENTRY_DSN is configured. The
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package web
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// refreshReplayWindow is how long a completed refresh is replayed to later
+// requests presenting the same, now retired, refresh token. A browser fires
+// several requests at once when its access cookie lapses; without the replay
+// all but the first would present a retired token, and core revokes the
+// session on reuse. The window stays short because a replay within it hands
+// the new tokens to whoever presents the retired one.
+const refreshReplayWindow = 30 * time.Second
+
+// WebSessionRefresher trades a web session's refresh token for new tokens.
+// Satisfied by CoreClient.
+type WebSessionRefresher interface {
+	RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error)
+}
+
+// SessionRefreshMiddleware keeps web sessions signed in past their access
+// token's lifetime. A request with no session cookie but a refresh cookie is
+// refreshed before next runs: the new access token is injected as the
+// request's session token and both cookies are replaced on the response. A
+// refresh core refuses clears both cookies, so the client signs in again; a
+// refresh that fails in transit leaves them for the next request to retry.
+//
+// It MUST run inside CookieMiddleware, which injects the session token and
+// turns the signal headers set here into cookies.
+func SessionRefreshMiddleware(refresher WebSessionRefresher, next http.Handler) http.Handler {
+	rf := newSessionRefresher(refresher)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(headerInjectSessionToken) == "" {
+			if token := GetRefreshToken(r); token != "" {
+				rf.apply(w, r, token)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// sessionRefresher deduplicates refreshes of the same refresh token: the
+// first request calls core and every request presenting that token within
+// refreshReplayWindow shares its result.
+type sessionRefresher struct {
+	client WebSessionRefresher
+	now    func() time.Time
+
+	mu    sync.Mutex
+	calls map[[sha256.Size]byte]*refreshCall
+}
+
+// refreshCall is one refresh, in flight until done is closed.
+type refreshCall struct {
+	done    chan struct{}
+	resp    *corev1.RefreshWebSessionResponse
+	err     error
+	expires time.Time
+}
+
+func newSessionRefresher(client WebSessionRefresher) *sessionRefresher {
+	return &sessionRefresher{
+		client: client,
+		now:    time.Now,
+		calls:  make(map[[sha256.Size]byte]*refreshCall),
+	}
+}
+
+// apply refreshes token for r and signals the outcome on w.
+func (rf *sessionRefresher) apply(w http.ResponseWriter, r *http.Request, token string) {
+	ctx := r.Context()
+	resp, err := rf.refresh(ctx, token, r.Header.Get("User-Agent"))
+	if err != nil {
+		errutil.LogErrorContext(ctx, "web: refresh web session RPC failed", err)
+		return
+	}
+	if !resp.GetSuccess() {
+		slog.DebugContext(ctx, "web: web session refresh refused", "reason", resp.GetErrorMessage())
+		w.Header().Set(headerClearSession, "true")
+		return
+	}
+	r.Header.Set(headerInjectSessionToken, resp.GetAccessToken())
+	signalWebSessionCookies(w.Header(),
+		resp.GetAccessToken(), resp.GetAccessTtlSeconds(),
+		resp.GetRefreshToken(), resp.GetRefreshTtlSeconds())
+}
+
+// refresh returns the result of refreshing token, calling core at most once
+// per token within refreshReplayWindow. Transport failures are not replayed.
+func (rf *sessionRefresher) refresh(ctx context.Context, token, userAgent string) (*corev1.RefreshWebSessionResponse, error) {
+	key := sha256.Sum256([]byte(token))
+
+	rf.mu.Lock()
+	now := rf.now()
+	for k, c := range rf.calls {
+		if !c.expires.IsZero() && now.After(c.expires) {
+			delete(rf.calls, k)
+		}
+	}
+	if c, ok := rf.calls[key]; ok {
+		rf.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.resp, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err() //nolint:wrapcheck // caller logs the cancellation as-is
+		}
+	}
+	c := &refreshCall{done: make(chan struct{})}
+	rf.calls[key] = c
+	rf.mu.Unlock()
+
+	// Detached from the request so a client that gives up does not fail the
+	// refresh for the requests waiting on it.
+	rpcCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rpcTimeout)
+	defer cancel()
+	resp, err := rf.client.RefreshWebSession(rpcCtx, &corev1.RefreshWebSessionRequest{
+		RefreshToken: token,
+		UserAgent:    userAgent,
+	})
+
+	rf.mu.Lock()
+	c.resp, c.err = resp, err
+	if err != nil {
+		delete(rf.calls, key)
+	} else {
+		c.expires = rf.now().Add(refreshReplayWindow)
+	}
+	rf.mu.Unlock()
+	close(c.done)
+	return resp, err //nolint:wrapcheck // CoreClient errors are already oops-wrapped
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
+)
+
+// gatedRefresher answers refreshes with resp once release is closed.
+type gatedRefresher struct {
+	resp    *corev1.RefreshWebSessionResponse
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (g *gatedRefresher) RefreshWebSession(_ context.Context, _ *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	g.calls.Add(1)
+	<-g.release
+	return g.resp, nil
+}
+
+// serveWithRefresh runs one request carrying cookies through
+// CookieMiddleware and SessionRefreshMiddleware, returning the recorder and
+// the session token the inner handler saw.
+func serveWithRefresh(t *testing.T, refresher WebSessionRefresher, cookies ...*http.Cookie) (*httptest.ResponseRecorder, string) {
+	t.Helper()
+	var injected string
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		injected = r.Header.Get(headerInjectSessionToken)
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := CookieMiddleware(false, SessionRefreshMiddleware(refresher, inner))
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	return rr, injected
+}
+
+func cookieByName(rr *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, c := range rr.Result().Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSessionRefreshMiddlewareInjectsRefreshedAccessToken(t *testing.T) {
+	client := &mockCoreClient{refreshResp: &corev1.RefreshWebSessionResponse{
+		Success:           true,
+		AccessToken:       "new-access",
+		AccessTtlSeconds:  900,
+		RefreshToken:      "new-refresh",
+		RefreshTtlSeconds: 86400,
+	}}
+
+	rr, injected := serveWithRefresh(t, client, &http.Cookie{Name: refreshCookieName, Value: "old-refresh"})
+
+	assert.Equal(t, "new-access", injected)
+	assert.Equal(t, int32(1), client.refreshCalls.Load())
+	session := cookieByName(rr, cookieName)
+	require.NotNil(t, session)
+	assert.Equal(t, "new-access", session.Value)
+	assert.Equal(t, 870, session.MaxAge)
+	refresh := cookieByName(rr, refreshCookieName)
+	require.NotNil(t, refresh)
+	assert.Equal(t, "new-refresh", refresh.Value)
+	assert.Equal(t, 86400, refresh.MaxAge)
+}
+
+func TestSessionRefreshMiddlewareSkipsRequestsWithSessionCookie(t *testing.T) {
+	client := &mockCoreClient{}
+
+	_, injected := serveWithRefresh(t, client,
+		&http.Cookie{Name: cookieName, Value: "live-access"},
+		&http.Cookie{Name: refreshCookieName, Value: "old-refresh"})
+
+	assert.Equal(t, "live-access", injected)
+	assert.Zero(t, client.refreshCalls.Load())
+}
+
+func TestSessionRefreshMiddlewareClearsCookiesWhenRefused(t *testing.T) {
+	client := &mockCoreClient{refreshResp: &corev1.RefreshWebSessionResponse{
+		Success: false, ErrorMessage: "session was revoked; sign in again",
+	}}
+
+	rr, injected := serveWithRefresh(t, client, &http.Cookie{Name: refreshCookieName, Value: "reused"})
+
+	assert.Empty(t, injected)
+	refresh := cookieByName(rr, refreshCookieName)
+	require.NotNil(t, refresh, "a refused refresh MUST clear the refresh cookie")
+	assert.Equal(t, -1, refresh.MaxAge)
+}
+
+func TestSessionRefreshMiddlewareKeepsCookiesOnRPCFailure(t *testing.T) {
+	client := &mockCoreClient{refreshErr: errors.New("core unavailable")}
+
+	rr, injected := serveWithRefresh(t, client, &http.Cookie{Name: refreshCookieName, Value: "old-refresh"})
+
+	assert.Empty(t, injected)
+	assert.Empty(t, rr.Result().Cookies(), "a transport failure MUST leave cookies for the next request to retry")
+}
+
+func TestSessionRefresherSharesOneRefreshAcrossConcurrentRequests(t *testing.T) {
+	g := &gatedRefresher{
+		resp:    &corev1.RefreshWebSessionResponse{Success: true, AccessToken: "new-access"},
+		release: make(chan struct{}),
+	}
+	rf := newSessionRefresher(g)
+
+	const n = 5
+	var wg sync.WaitGroup
+	results := make([]string, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := rf.refresh(context.Background(), "old-refresh", "")
+			if err == nil {
+				results[i] = resp.GetAccessToken()
+			}
+		}()
+	}
+	require.Eventually(t, func() bool { return g.calls.Load() == 1 }, time.Second, time.Millisecond)
+	close(g.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), g.calls.Load())
+	for _, got := range results {
+		assert.Equal(t, "new-access", got)
+	}
+}
+
+func TestSessionRefresherForgetsRefreshAfterReplayWindow(t *testing.T) {
+	client := &mockCoreClient{refreshResp: &corev1.RefreshWebSessionResponse{Success: true}}
+	rf := newSessionRefresher(client)
+	now := time.Now()
+	rf.now = func() time.Time { return now }
+
+	_, err := rf.refresh(context.Background(), "old-refresh", "")
+	require.NoError(t, err)
+	_, err = rf.refresh(context.Background(), "old-refresh", "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), client.refreshCalls.Load(), "a refresh within the window MUST be replayed")
+
+	now = now.Add(refreshReplayWindow + time.Second)
+	_, err = rf.refresh(context.Background(), "old-refresh", "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), client.refreshCalls.Load())
+}
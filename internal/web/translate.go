@@ -10,6 +10,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/holomush/holomush/internal/eventvocab"
 	"github.com/holomush/holomush/internal/gatewaymetrics"
 	corev1 "github.com/holomush/holomush/pkg/proto/holomush/core/v1"
 	webv1 "github.com/holomush/holomush/pkg/proto/holomush/web/v1"
@@ -135,6 +136,12 @@ func (h *Handler) translateEvent(ev *corev1.EventFrame) *webv1.GameEvent {
 		meta["target_name"] = p.TargetName
 	}
 
+	// The login notice carries its styled rendering for the web client's
+	// ANSI renderer; Text stays the plain form.
+	if eventType == string(eventvocab.EventTypeMOTD) {
+		addMOTDMetadata(meta, ev.GetPayload())
+	}
+
 	// Stamp scene_id from the event subject for scene IC events.
 	// The subject is always cleartext dot-delimited:
 	// events.<game_id>.scene.<scene_id>[.<facet>...]
@@ -170,6 +177,22 @@ func (h *Handler) translateEvent(ev *corev1.EventFrame) *webv1.GameEvent {
 	}
 }
 
+// addMOTDMetadata copies a motd event's styled rendering and unread flag
+// into meta.
+func addMOTDMetadata(meta map[string]any, payload []byte) {
+	var p eventvocab.MOTDPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		slog.Error("web: failed to unmarshal motd payload", "error", err)
+		return
+	}
+	if p.ANSI != "" {
+		meta["ansi"] = p.ANSI
+	}
+	if p.Unread {
+		meta["unread"] = true
+	}
+}
+
 // formatMovementText synthesizes human-readable text for movement events.
 // Mirrors the telnet gateway's formatMovement (gateway_handler.go).
 func formatMovementText(eventType, actor string, p *genericPayload) string {
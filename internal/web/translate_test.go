@@ -59,6 +59,7 @@ var testRenderings = map[string]*corev1.RenderingMetadata{
 	"leave":            {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
 	"move":             {Category: "movement", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_BOTH, SourcePlugin: "builtin"},
 	"system":           {Category: "system", Format: "notification", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
+	"motd":             {Category: "system", Format: "motd", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"command_response": {Category: "command", Format: "narrative", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"command_error":    {Category: "command", Format: "error", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_TERMINAL, SourcePlugin: "builtin"},
 	"location_state":   {Category: "state", Format: "snapshot", DisplayTarget: corev1.EventChannel_EVENT_CHANNEL_STATE, SourcePlugin: "builtin"},
@@ -181,6 +182,27 @@ func TestTranslateEvent_System(t *testing.T) {
 	assert.Equal(t, webv1.EventChannel_EVENT_CHANNEL_TERMINAL, got.GetDisplayTarget())
 }
 
+func TestTranslateEvent_MOTD(t *testing.T) {
+	h := newTestHandler(t)
+	ev := &corev1.EventFrame{
+		Type: "motd",
+		Payload: mustMarshal(t, eventvocab.MOTDPayload{
+			Text:   "Message of the Day\n\nWelcome!",
+			ANSI:   "\x1b[1mMessage of the Day\x1b[0m\n\nWelcome!",
+			Body:   "Welcome!",
+			Unread: true,
+		}),
+	}
+
+	got := h.translateEvent(withRendering(ev))
+	require.NotNil(t, got)
+	assert.Equal(t, "motd", got.GetFormat())
+	assert.Equal(t, "Message of the Day\n\nWelcome!", got.GetText())
+	meta := got.GetMetadata().AsMap()
+	assert.Equal(t, "\x1b[1mMessage of the Day\x1b[0m\n\nWelcome!", meta["ansi"])
+	assert.Equal(t, true, meta["unread"])
+}
+
 func TestTranslateEvent_Move(t *testing.T) {
 	h := newTestHandler(t)
 	ev := &corev1.EventFrame{
@@ -0,0 +1,569 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+)
+
+// Default Dispatcher tuning.
+const (
+	defaultWorkers         = 2
+	defaultBatchSize       = 10
+	defaultPollInterval    = time.Second
+	defaultMaxAttempts     = 8
+	defaultRetryBase       = 10 * time.Second
+	defaultRetryMax        = time.Hour
+	defaultRequestTimeout  = 10 * time.Second
+	defaultRetention       = 7 * 24 * time.Hour
+	defaultRefreshInterval = 30 * time.Second
+	defaultPruneInterval   = time.Hour
+
+	// leaseSlack is added to the request timeout to lease a claimed
+	// delivery, covering the bookkeeping around the request.
+	leaseSlack = 30 * time.Second
+
+	// maxResponseExcerpt bounds how much of a failed response body is kept
+	// in the delivery's error.
+	maxResponseExcerpt = 256
+)
+
+// Config configures a Dispatcher.
+type Config struct {
+	Workers         int              // concurrent delivery loops (default: 2)
+	BatchSize       int              // deliveries one loop claims at a time (default: 10)
+	PollInterval    time.Duration    // idle wait between claims (default: 1s)
+	MaxAttempts     int              // requests before a delivery fails for good (default: 8)
+	RetryBase       time.Duration    // wait before the second attempt, doubling after each (default: 10s)
+	RetryMax        time.Duration    // longest wait between attempts (default: 1h)
+	RequestTimeout  time.Duration    // bound on one request (default: 10s)
+	Retention       time.Duration    // how long finished deliveries stay in the log (default: 7 days)
+	RefreshInterval time.Duration    // how stale the endpoint cache may get (default: 30s)
+	Client          *http.Client     // HTTP client override for tests (default: a client with RequestTimeout)
+	Now             func() time.Time // clock override for tests (default: time.Now)
+}
+
+// Dispatcher registers webhook endpoints, queues matching events for them,
+// and delivers the queue.
+//
+// Registered endpoints are cached in memory and refreshed every
+// RefreshInterval, so an endpoint registered on another replica starts
+// receiving events from this one within that interval. Rate limits are
+// enforced per replica.
+type Dispatcher struct {
+	config Config
+	store  Store
+	wake   chan struct{}
+
+	mu        sync.Mutex
+	endpoints []Endpoint
+	loadedAt  time.Time
+	buckets   map[ulid.ULID]*bucket
+}
+
+// bucket is an endpoint's token bucket: RatePerMinute tokens refilled
+// evenly over a minute.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewDispatcher creates a Dispatcher over store. Panics on a nil store.
+func NewDispatcher(config Config, store Store) *Dispatcher {
+	if store == nil {
+		panic("webhook.NewDispatcher: nil Store")
+	}
+	if config.Workers <= 0 {
+		config.Workers = defaultWorkers
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = defaultMaxAttempts
+	}
+	if config.RetryBase <= 0 {
+		config.RetryBase = defaultRetryBase
+	}
+	if config.RetryMax <= 0 {
+		config.RetryMax = defaultRetryMax
+	}
+	if config.RequestTimeout <= 0 {
+		config.RequestTimeout = defaultRequestTimeout
+	}
+	if config.Retention <= 0 {
+		config.Retention = defaultRetention
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = defaultRefreshInterval
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: config.RequestTimeout}
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	return &Dispatcher{
+		config:  config,
+		store:   store,
+		wake:    make(chan struct{}, 1),
+		buckets: make(map[ulid.ULID]*bucket),
+	}
+}
+
+// Register validates and stores a new endpoint. The ID, signing secret and
+// creation time are generated, a zero RatePerMinute becomes
+// DefaultRatePerMinute, and the endpoint starts enabled. The returned
+// endpoint carries the secret; it is the caller's only chance to show it.
+func (d *Dispatcher) Register(ctx context.Context, endpoint Endpoint) (Endpoint, error) {
+	if endpoint.RatePerMinute == 0 {
+		endpoint.RatePerMinute = DefaultRatePerMinute
+	}
+	endpoint.Events = slices.Compact(slices.Sorted(slices.Values(endpoint.Events)))
+	if err := endpoint.Validate(); err != nil {
+		return Endpoint{}, err
+	}
+	secret := make([]byte, SecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return Endpoint{}, oops.Code("WEBHOOK_STORE_FAILED").Wrapf(err, "generate webhook secret")
+	}
+	endpoint.ID = idgen.New()
+	endpoint.Secret = hex.EncodeToString(secret)
+	endpoint.Enabled = true
+	endpoint.CreatedAt = d.config.Now()
+	if err := d.store.CreateEndpoint(ctx, endpoint); err != nil {
+		return Endpoint{}, err
+	}
+	d.invalidate()
+	slog.InfoContext(ctx, "webhook registered",
+		"event", "webhook_registered",
+		"webhook", endpoint.Name,
+		"events", endpoint.Events,
+		"created_by", endpoint.CreatedBy)
+	return endpoint, nil
+}
+
+// List returns every endpoint ordered by name.
+func (d *Dispatcher) List(ctx context.Context) ([]Endpoint, error) {
+	return d.store.ListEndpoints(ctx)
+}
+
+// SetEnabled turns the named endpoint on or off. Deliveries queued for a
+// disabled endpoint wait until it is enabled again; no new ones are queued
+// meanwhile.
+func (d *Dispatcher) SetEnabled(ctx context.Context, name string, enabled bool) (Endpoint, error) {
+	endpoint, err := d.store.SetEnabled(ctx, name, enabled)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	d.invalidate()
+	return endpoint, nil
+}
+
+// Remove deletes the named endpoint together with its queued deliveries
+// and delivery log.
+func (d *Dispatcher) Remove(ctx context.Context, name string) (Endpoint, error) {
+	endpoint, err := d.store.DeleteEndpoint(ctx, name)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	d.invalidate()
+	slog.InfoContext(ctx, "webhook removed", "event", "webhook_removed", "webhook", endpoint.Name)
+	return endpoint, nil
+}
+
+// Deliveries returns the named endpoint's delivery log, newest first, or
+// every endpoint's when name is empty. Returns WEBHOOK_NOT_FOUND for an
+// unknown name.
+func (d *Dispatcher) Deliveries(ctx context.Context, name string, limit int) ([]Delivery, error) {
+	filter := DeliveryFilter{Limit: limit}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	filter.Limit = min(filter.Limit, MaxListLimit)
+	if name != "" {
+		endpoints, err := d.store.ListEndpoints(ctx)
+		if err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(endpoints, func(e Endpoint) bool { return e.Name == name })
+		if i < 0 {
+			return nil, errNotFound(name)
+		}
+		filter.EndpointID = endpoints[i].ID
+	}
+	return d.store.Deliveries(ctx, filter)
+}
+
+// Notify queues event for every enabled endpoint subscribed to its type
+// and returns how many deliveries it queued. Sensitive events are never
+// queued.
+func (d *Dispatcher) Notify(ctx context.Context, event eventbus.Event) (int, error) {
+	if event.Sensitive {
+		return 0, nil
+	}
+	endpoints := d.cached(ctx)
+	var deliveries []Delivery
+	var body []byte
+	for _, endpoint := range endpoints {
+		if !endpoint.Enabled || !endpoint.Matches(string(event.Type)) {
+			continue
+		}
+		if body == nil {
+			var err error
+			if body, err = eventBody(event); err != nil {
+				return 0, err
+			}
+		}
+		now := d.config.Now()
+		deliveries = append(deliveries, Delivery{
+			ID:            idgen.New(),
+			EndpointID:    endpoint.ID,
+			EventID:       event.ID.String(),
+			EventType:     string(event.Type),
+			Body:          body,
+			Status:        StatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+	if err := d.store.Enqueue(ctx, deliveries); err != nil {
+		return 0, err
+	}
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+	return len(deliveries), nil
+}
+
+// eventJSON is the document POSTed for an event. Payload is the event's
+// own JSON payload, or null when it is not JSON.
+type eventJSON struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Subject   string          `json:"subject"`
+	Timestamp time.Time       `json:"timestamp"`
+	Actor     actorJSON       `json:"actor"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+type actorJSON struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
+
+func eventBody(event eventbus.Event) ([]byte, error) {
+	doc := eventJSON{
+		ID:        event.ID.String(),
+		Type:      string(event.Type),
+		Subject:   string(event.Subject),
+		Timestamp: event.Timestamp.UTC(),
+		Actor:     actorJSON{Kind: event.Actor.Kind.String(), ID: event.Actor.ID.String()},
+	}
+	if len(event.Payload) > 0 && json.Valid(event.Payload) {
+		doc.Payload = event.Payload
+	}
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, oops.Code("WEBHOOK_ENQUEUE_FAILED").With("event_id", event.ID.String()).Wrap(err)
+	}
+	return body, nil
+}
+
+// Tap returns a publisher that publishes through next and then queues each
+// published event for the webhooks subscribed to it. Queueing never fails
+// the publish: an error is logged and the event is not sent to webhooks.
+func (d *Dispatcher) Tap(next eventbus.Publisher) eventbus.Publisher {
+	if next == nil {
+		panic("webhook.Dispatcher.Tap: nil publisher")
+	}
+	return &tapPublisher{next: next, dispatcher: d}
+}
+
+type tapPublisher struct {
+	next       eventbus.Publisher
+	dispatcher *Dispatcher
+}
+
+func (p *tapPublisher) Publish(ctx context.Context, event eventbus.Event) error {
+	if err := p.next.Publish(ctx, event); err != nil {
+		return err //nolint:wrapcheck // transparent decorator: the inner publisher's error is the caller's
+	}
+	if _, err := p.dispatcher.Notify(ctx, event); err != nil {
+		slog.WarnContext(ctx, "webhook: queue event failed",
+			"event_id", event.ID.String(),
+			"event_type", string(event.Type),
+			"error", err)
+	}
+	return nil
+}
+
+// Run delivers queued deliveries until ctx is done, and prunes the
+// delivery log once an hour.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range d.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.work(ctx)
+		}()
+	}
+	d.maintain(ctx)
+	wg.Wait()
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		n, err := d.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "webhook: claim failed", "error", err)
+		}
+		if n > 0 {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+		case <-time.After(d.config.PollInterval):
+		}
+	}
+}
+
+func (d *Dispatcher) maintain(ctx context.Context) {
+	ticker := time.NewTicker(defaultPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := d.store.Prune(ctx, d.config.Now().Add(-d.config.Retention))
+			if err != nil {
+				slog.WarnContext(ctx, "webhook: prune failed", "error", err)
+				continue
+			}
+			if n > 0 {
+				slog.InfoContext(ctx, "webhook: pruned delivery log", "deliveries", n)
+			}
+		}
+	}
+}
+
+// RunOnce claims one batch of due deliveries and attempts each, returning
+// how many it claimed.
+func (d *Dispatcher) RunOnce(ctx context.Context) (int, error) {
+	now := d.config.Now()
+	batch, err := d.store.Claim(ctx, now, now.Add(d.config.RequestTimeout+leaseSlack), d.config.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, delivery := range batch {
+		d.attempt(ctx, delivery)
+	}
+	return len(batch), nil
+}
+
+// attempt sends one delivery, or defers it when its endpoint is over its
+// rate limit, and records the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, delivery Delivery) {
+	endpoint, ok := d.endpoint(ctx, delivery.EndpointID)
+	if !ok {
+		// Removed since the claim; the cascade takes the delivery with it.
+		return
+	}
+	if wait := d.take(endpoint); wait > 0 {
+		delivery.NextAttemptAt = d.config.Now().Add(wait)
+		d.record(ctx, delivery)
+		return
+	}
+
+	delivery.Attempts++
+	code, err := d.send(ctx, endpoint, delivery)
+	now := d.config.Now()
+	delivery.LastStatusCode = code
+	outcome := "delivered"
+	switch {
+	case err == nil:
+		delivery.Status = StatusDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	case delivery.Attempts >= d.config.MaxAttempts:
+		outcome = "failed"
+		delivery.Status = StatusFailed
+		delivery.LastError = truncateError(err.Error())
+		slog.WarnContext(ctx, "webhook: delivery failed",
+			"webhook", endpoint.Name,
+			"delivery_id", delivery.ID.String(),
+			"event_type", delivery.EventType,
+			"attempts", delivery.Attempts,
+			"error", err)
+	default:
+		outcome = "retry"
+		delivery.LastError = truncateError(err.Error())
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+	}
+	deliveriesTotal.WithLabelValues(endpoint.Name, outcome).Inc()
+	d.record(ctx, delivery)
+}
+
+func (d *Dispatcher) record(ctx context.Context, delivery Delivery) {
+	if err := d.store.Record(ctx, delivery); err != nil {
+		// The lease expires and the delivery is retried.
+		slog.WarnContext(ctx, "webhook: record delivery failed",
+			"delivery_id", delivery.ID.String(),
+			"error", err)
+	}
+}
+
+// backoff is the wait after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.config.RetryBase
+	for i := 1; i < attempts && wait < d.config.RetryMax; i++ {
+		wait *= 2
+	}
+	return min(wait, d.config.RetryMax)
+}
+
+// send POSTs the delivery body to the endpoint with its signature headers.
+// It returns the response status, or 0 when there was no response, and an
+// error unless the status was 2xx.
+func (d *Dispatcher) send(ctx context.Context, endpoint Endpoint, delivery Delivery) (int, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, d.config.RequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, oops.Code("WEBHOOK_SEND_FAILED").Wrap(err)
+	}
+	timestamp := strconv.FormatInt(d.config.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, delivery.Body))
+
+	resp, err := d.config.Client.Do(req)
+	if err != nil {
+		return 0, oops.Code("WEBHOOK_SEND_FAILED").Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseExcerpt))
+		return resp.StatusCode, nil
+	}
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseExcerpt))
+	return resp.StatusCode, oops.Code("WEBHOOK_SEND_FAILED").
+		With("status", resp.StatusCode).
+		Errorf("HTTP %d: %s", resp.StatusCode, bytes.TrimSpace(excerpt))
+}
+
+// Sign returns the X-Holomush-Signature value for body sent at timestamp
+// (Unix seconds, as in X-Holomush-Timestamp): "sha256=" followed by the
+// hex HMAC-SHA256 of "<timestamp>.<body>" under secret. Receivers compute
+// the same value and compare in constant time.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = fmt.Fprintf(mac, "%s.", timestamp)
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// take spends one of the endpoint's tokens, returning zero, or how long
+// until a token is available when none is.
+func (d *Dispatcher) take(endpoint Endpoint) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.config.Now()
+	limit := float64(endpoint.RatePerMinute)
+	b, ok := d.buckets[endpoint.ID]
+	if !ok {
+		b = &bucket{tokens: limit, last: now}
+		d.buckets[endpoint.ID] = b
+	}
+	b.tokens = min(limit, b.tokens+now.Sub(b.last).Minutes()*limit)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / limit * float64(time.Minute))
+}
+
+// endpoint returns the cached endpoint with id, reloading the cache once
+// when it is missing.
+func (d *Dispatcher) endpoint(ctx context.Context, id ulid.ULID) (Endpoint, bool) {
+	find := func(endpoints []Endpoint) (Endpoint, bool) {
+		i := slices.IndexFunc(endpoints, func(e Endpoint) bool { return e.ID == id })
+		if i < 0 {
+			return Endpoint{}, false
+		}
+		return endpoints[i], true
+	}
+	if e, ok := find(d.cached(ctx)); ok {
+		return e, true
+	}
+	d.invalidate()
+	return find(d.cached(ctx))
+}
+
+// cached returns the endpoint cache, reloading it when older than
+// RefreshInterval. A failed reload keeps the stale cache.
+func (d *Dispatcher) cached(ctx context.Context) []Endpoint {
+	d.mu.Lock()
+	fresh := !d.loadedAt.IsZero() && d.config.Now().Sub(d.loadedAt) < d.config.RefreshInterval
+	endpoints := d.endpoints
+	d.mu.Unlock()
+	if fresh {
+		return endpoints
+	}
+
+	loaded, err := d.store.ListEndpoints(ctx)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			slog.WarnContext(ctx, "webhook: load endpoints failed", "error", err)
+		}
+		return endpoints
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.endpoints = loaded
+	d.loadedAt = d.config.Now()
+	return loaded
+}
+
+// invalidate forces the next cache read to reload.
+func (d *Dispatcher) invalidate() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.loadedAt = time.Time{}
+}
@@ -0,0 +1,460 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package webhook_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/webhook"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memStore is an in-memory webhook.Store.
+type memStore struct {
+	mu         sync.Mutex
+	endpoints  []webhook.Endpoint
+	deliveries []webhook.Delivery
+}
+
+func (m *memStore) CreateEndpoint(_ context.Context, endpoint webhook.Endpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, e := range m.endpoints {
+		if e.Name == endpoint.Name {
+			return errors.New("duplicate")
+		}
+	}
+	m.endpoints = append(m.endpoints, endpoint)
+	return nil
+}
+
+func (m *memStore) ListEndpoints(_ context.Context) ([]webhook.Endpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.endpoints), nil
+}
+
+func (m *memStore) SetEnabled(_ context.Context, name string, enabled bool) (webhook.Endpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.endpoints {
+		if m.endpoints[i].Name == name {
+			m.endpoints[i].Enabled = enabled
+			return m.endpoints[i], nil
+		}
+	}
+	return webhook.Endpoint{}, errors.New("missing")
+}
+
+func (m *memStore) DeleteEndpoint(_ context.Context, name string) (webhook.Endpoint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, e := range m.endpoints {
+		if e.Name == name {
+			m.endpoints = slices.Delete(m.endpoints, i, i+1)
+			m.deliveries = slices.DeleteFunc(m.deliveries, func(d webhook.Delivery) bool { return d.EndpointID == e.ID })
+			return e, nil
+		}
+	}
+	return webhook.Endpoint{}, errors.New("missing")
+}
+
+func (m *memStore) Enqueue(_ context.Context, deliveries []webhook.Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveries = append(m.deliveries, deliveries...)
+	return nil
+}
+
+func (m *memStore) Claim(_ context.Context, now, leaseUntil time.Time, limit int) ([]webhook.Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []webhook.Delivery
+	for i := range m.deliveries {
+		d := &m.deliveries[i]
+		if len(out) == limit || d.Status != webhook.StatusPending || d.NextAttemptAt.After(now) {
+			continue
+		}
+		d.NextAttemptAt = leaseUntil
+		out = append(out, *d)
+	}
+	return out, nil
+}
+
+func (m *memStore) Record(_ context.Context, delivery webhook.Delivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.deliveries {
+		if m.deliveries[i].ID == delivery.ID {
+			m.deliveries[i] = delivery
+		}
+	}
+	return nil
+}
+
+func (m *memStore) Deliveries(_ context.Context, filter webhook.DeliveryFilter) ([]webhook.Delivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []webhook.Delivery
+	for _, d := range slices.Backward(m.deliveries) {
+		if filter.EndpointID == (ulid.ULID{}) || d.EndpointID == filter.EndpointID {
+			out = append(out, d)
+		}
+	}
+	if len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (m *memStore) Prune(_ context.Context, _ time.Time) (int, error) { return 0, nil }
+
+func (m *memStore) all() []webhook.Delivery {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.deliveries)
+}
+
+// clock is a settable test clock.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// receiver is an httptest server that records requests and answers with
+// the next queued status, 200 once the queue is empty.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	t.Helper()
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func newDispatcher(t *testing.T, config webhook.Config) (*webhook.Dispatcher, *memStore, *clock) {
+	t.Helper()
+	st := &memStore{}
+	c := &clock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+	config.Now = c.Now
+	return webhook.NewDispatcher(config, st), st, c
+}
+
+func sayEvent() eventbus.Event {
+	return eventbus.Event{
+		ID:        idgen.New(),
+		Subject:   "location.01LOC",
+		Type:      "scene.pose",
+		Timestamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Actor:     eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: idgen.New()},
+		Payload:   []byte(`{"text":"waves"}`),
+	}
+}
+
+func TestDispatcherRegisterValidates(t *testing.T) {
+	ctx := context.Background()
+	d, _, _ := newDispatcher(t, webhook.Config{})
+
+	tests := []struct {
+		name     string
+		endpoint webhook.Endpoint
+	}{
+		{"bad name", webhook.Endpoint{Name: "Discord Bridge", URL: "https://example.com", Events: []string{"*"}}},
+		{"bad scheme", webhook.Endpoint{Name: "bridge", URL: "ftp://example.com", Events: []string{"*"}}},
+		{"credentials in URL", webhook.Endpoint{Name: "bridge", URL: "https://u:p@example.com", Events: []string{"*"}}},
+		{"no events", webhook.Endpoint{Name: "bridge", URL: "https://example.com"}},
+		{"bad pattern", webhook.Endpoint{Name: "bridge", URL: "https://example.com", Events: []string{"scene.*.x"}}},
+		{"rate too high", webhook.Endpoint{
+			Name: "bridge", URL: "https://example.com", Events: []string{"*"}, RatePerMinute: webhook.MaxRatePerMinute + 1,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := d.Register(ctx, tt.endpoint)
+			errutil.AssertErrorCode(t, err, "WEBHOOK_INVALID")
+		})
+	}
+
+	registered, err := d.Register(ctx, webhook.Endpoint{
+		Name: "bridge", URL: "https://example.com/hook", Events: []string{"scene.*", "character_created", "scene.*"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, registered.Secret, 2*webhook.SecretBytes)
+	assert.True(t, registered.Enabled)
+	assert.Equal(t, webhook.DefaultRatePerMinute, registered.RatePerMinute)
+	assert.Equal(t, []string{"character_created", "scene.*"}, registered.Events)
+}
+
+func TestEndpointMatches(t *testing.T) {
+	e := webhook.Endpoint{Events: []string{"scene.*", "character_created"}}
+	assert.True(t, e.Matches("scene.pose"))
+	assert.True(t, e.Matches("character_created"))
+	assert.False(t, e.Matches("scene"), "a prefix pattern needs a dot after the prefix")
+	assert.False(t, e.Matches("scenery.pose"))
+	assert.False(t, e.Matches("say"))
+	assert.True(t, webhook.Endpoint{Events: []string{"*"}}.Matches("say"))
+}
+
+func TestDispatcherDeliversSignedEvents(t *testing.T) {
+	ctx := context.Background()
+	recv := newReceiver(t)
+	d, st, c := newDispatcher(t, webhook.Config{})
+	endpoint, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: recv.URL, Events: []string{"scene.*"}})
+	require.NoError(t, err)
+
+	event := sayEvent()
+	n, err := d.Notify(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	other := sayEvent()
+	other.Type = "say"
+	n, err = d.Notify(ctx, other)
+	require.NoError(t, err)
+	assert.Zero(t, n, "an unsubscribed type is not queued")
+
+	claimed, err := d.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+
+	require.Equal(t, 1, recv.count())
+	req, body := recv.requests[0], recv.bodies[0]
+	timestamp := req.Header.Get(webhook.HeaderTimestamp)
+	assert.Equal(t, "1792324800", timestamp)
+	assert.Equal(t, webhook.Sign(endpoint.Secret, timestamp, body), req.Header.Get(webhook.HeaderSignature))
+	assert.Equal(t, "scene.pose", req.Header.Get(webhook.HeaderEvent))
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(body, &doc))
+	assert.Equal(t, event.ID.String(), doc["id"])
+	assert.Equal(t, "scene.pose", doc["type"])
+	assert.Equal(t, map[string]any{"text": "waves"}, doc["payload"])
+	assert.Equal(t, "character", doc["actor"].(map[string]any)["kind"])
+
+	delivered := st.all()[0]
+	assert.Equal(t, webhook.StatusDelivered, delivered.Status)
+	assert.Equal(t, 1, delivered.Attempts)
+	assert.Equal(t, http.StatusOK, delivered.LastStatusCode)
+	require.NotNil(t, delivered.DeliveredAt)
+	assert.Equal(t, c.Now(), *delivered.DeliveredAt)
+}
+
+func TestSign(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(`1700000000.{"type":"say"}`))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, want, webhook.Sign("secret", "1700000000", []byte(`{"type":"say"}`)))
+	assert.NotEqual(t, want, webhook.Sign("secret", "1700000001", []byte(`{"type":"say"}`)),
+		"the timestamp is signed")
+}
+
+func TestDispatcherRetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	recv := newReceiver(t, http.StatusInternalServerError, http.StatusBadGateway)
+	d, st, c := newDispatcher(t, webhook.Config{RetryBase: 10 * time.Second, MaxAttempts: 3})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: recv.URL, Events: []string{"*"}})
+	require.NoError(t, err)
+	_, err = d.Notify(ctx, sayEvent())
+	require.NoError(t, err)
+
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	first := st.all()[0]
+	assert.Equal(t, webhook.StatusPending, first.Status)
+	assert.Equal(t, 1, first.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, first.LastStatusCode)
+	assert.Contains(t, first.LastError, "HTTP 500")
+	assert.Equal(t, c.Now().Add(10*time.Second), first.NextAttemptAt)
+
+	claimed, err := d.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, claimed, "nothing is due before the backoff elapses")
+
+	c.Advance(10 * time.Second)
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	second := st.all()[0]
+	assert.Equal(t, 2, second.Attempts)
+	assert.Equal(t, c.Now().Add(20*time.Second), second.NextAttemptAt, "the wait doubles")
+
+	c.Advance(20 * time.Second)
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	third := st.all()[0]
+	assert.Equal(t, webhook.StatusDelivered, third.Status)
+	assert.Equal(t, 3, third.Attempts)
+	assert.Empty(t, third.LastError)
+}
+
+func TestDispatcherFailsAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	recv := newReceiver(t, http.StatusGone, http.StatusGone)
+	d, st, c := newDispatcher(t, webhook.Config{MaxAttempts: 2})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: recv.URL, Events: []string{"*"}})
+	require.NoError(t, err)
+	_, err = d.Notify(ctx, sayEvent())
+	require.NoError(t, err)
+
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	c.Advance(time.Hour)
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+
+	failed := st.all()[0]
+	assert.Equal(t, webhook.StatusFailed, failed.Status)
+	assert.Equal(t, 2, failed.Attempts)
+	assert.Equal(t, http.StatusGone, failed.LastStatusCode)
+
+	c.Advance(time.Hour)
+	claimed, err := d.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, claimed, "a failed delivery is not retried")
+	assert.Equal(t, 2, recv.count())
+}
+
+func TestDispatcherRateLimitDefersDeliveries(t *testing.T) {
+	ctx := context.Background()
+	recv := newReceiver(t)
+	d, st, c := newDispatcher(t, webhook.Config{})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: recv.URL, Events: []string{"*"}, RatePerMinute: 2})
+	require.NoError(t, err)
+	for range 3 {
+		_, err = d.Notify(ctx, sayEvent())
+		require.NoError(t, err)
+	}
+
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, recv.count(), "the bucket holds RatePerMinute requests")
+	deferred := st.all()[2]
+	assert.Equal(t, webhook.StatusPending, deferred.Status)
+	assert.Zero(t, deferred.Attempts, "a deferral is not an attempt")
+	assert.Equal(t, c.Now().Add(30*time.Second), deferred.NextAttemptAt)
+
+	c.Advance(30 * time.Second)
+	_, err = d.RunOnce(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, recv.count())
+}
+
+func TestDispatcherSkipsSensitiveAndDisabled(t *testing.T) {
+	ctx := context.Background()
+	d, _, _ := newDispatcher(t, webhook.Config{})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: "https://example.com", Events: []string{"*"}})
+	require.NoError(t, err)
+
+	sensitive := sayEvent()
+	sensitive.Sensitive = true
+	n, err := d.Notify(ctx, sensitive)
+	require.NoError(t, err)
+	assert.Zero(t, n, "encrypted events never leave the game")
+
+	_, err = d.SetEnabled(ctx, "bridge", false)
+	require.NoError(t, err)
+	n, err = d.Notify(ctx, sayEvent())
+	require.NoError(t, err)
+	assert.Zero(t, n, "a disabled webhook queues nothing")
+}
+
+func TestDispatcherDeliveriesByName(t *testing.T) {
+	ctx := context.Background()
+	d, _, _ := newDispatcher(t, webhook.Config{})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: "https://example.com", Events: []string{"*"}})
+	require.NoError(t, err)
+	_, err = d.Notify(ctx, sayEvent())
+	require.NoError(t, err)
+
+	got, err := d.Deliveries(ctx, "bridge", 0)
+	require.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	_, err = d.Deliveries(ctx, "nope", 0)
+	errutil.AssertErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+}
+
+// recordingPublisher records published events, or fails them.
+type recordingPublisher struct {
+	err    error
+	events []eventbus.Event
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event eventbus.Event) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, event)
+	return nil
+}
+
+func TestDispatcherTap(t *testing.T) {
+	ctx := context.Background()
+	d, st, _ := newDispatcher(t, webhook.Config{})
+	_, err := d.Register(ctx, webhook.Endpoint{Name: "bridge", URL: "https://example.com", Events: []string{"*"}})
+	require.NoError(t, err)
+
+	inner := &recordingPublisher{}
+	require.NoError(t, d.Tap(inner).Publish(ctx, sayEvent()))
+	assert.Len(t, inner.events, 1)
+	assert.Len(t, st.all(), 1)
+
+	inner.err = errors.New("bus down")
+	require.ErrorIs(t, d.Tap(inner).Publish(ctx, sayEvent()), inner.err)
+	assert.Len(t, st.all(), 1, "an event that was not published is not queued")
+}
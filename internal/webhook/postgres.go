@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+const endpointColumns = `id, name, url, secret, events, rate_per_minute, enabled, created_by, created_at`
+
+const deliveryColumns = `id, endpoint_id, event_id, event_type, body, status, attempts,
+	next_attempt_at, last_status_code, last_error, created_at, delivered_at`
+
+// PostgresStore implements Store against the webhook_endpoints and
+// webhook_deliveries tables.
+type PostgresStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewPostgresStore returns a PostgresStore backed by pool.
+func NewPostgresStore(pool *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{pool: pool}
+}
+
+// CreateEndpoint inserts endpoint.
+func (s *PostgresStore) CreateEndpoint(ctx context.Context, endpoint Endpoint) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO webhook_endpoints (`+endpointColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, endpoint.ID[:], endpoint.Name, endpoint.URL, endpoint.Secret, endpoint.Events,
+		endpoint.RatePerMinute, endpoint.Enabled, endpoint.CreatedBy, pgnanos.From(endpoint.CreatedAt))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return oops.Code("WEBHOOK_EXISTS").
+				With("name", endpoint.Name).
+				Errorf("a webhook named %q already exists", endpoint.Name)
+		}
+		return oops.Code("WEBHOOK_STORE_FAILED").
+			With("operation", "create_endpoint").
+			With("name", endpoint.Name).
+			Wrap(err)
+	}
+	return nil
+}
+
+// ListEndpoints returns every endpoint ordered by name.
+func (s *PostgresStore) ListEndpoints(ctx context.Context) ([]Endpoint, error) {
+	rows, err := s.pool.Query(ctx, `SELECT `+endpointColumns+` FROM webhook_endpoints ORDER BY name`)
+	if err != nil {
+		return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", "list_endpoints").Wrap(err)
+	}
+	defer rows.Close()
+
+	var endpoints []Endpoint
+	for rows.Next() {
+		endpoint, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", "list_endpoints").Wrap(err)
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", "list_endpoints").Wrap(err)
+	}
+	return endpoints, nil
+}
+
+// SetEnabled turns the named endpoint on or off.
+func (s *PostgresStore) SetEnabled(ctx context.Context, name string, enabled bool) (Endpoint, error) {
+	row := s.pool.QueryRow(ctx, `
+		UPDATE webhook_endpoints SET enabled = $2 WHERE name = $1
+		RETURNING `+endpointColumns, name, enabled)
+	return oneEndpoint(row, "set_enabled", name)
+}
+
+// DeleteEndpoint removes the named endpoint; its deliveries go with it by
+// cascade.
+func (s *PostgresStore) DeleteEndpoint(ctx context.Context, name string) (Endpoint, error) {
+	row := s.pool.QueryRow(ctx, `DELETE FROM webhook_endpoints WHERE name = $1 RETURNING `+endpointColumns, name)
+	return oneEndpoint(row, "delete_endpoint", name)
+}
+
+func oneEndpoint(row pgx.Row, operation, name string) (Endpoint, error) {
+	endpoint, err := scanEndpoint(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Endpoint{}, errNotFound(name)
+	}
+	if err != nil {
+		return Endpoint{}, oops.Code("WEBHOOK_STORE_FAILED").
+			With("operation", operation).
+			With("name", name).
+			Wrap(err)
+	}
+	return endpoint, nil
+}
+
+// Enqueue inserts pending deliveries in one batch.
+func (s *PostgresStore) Enqueue(ctx context.Context, deliveries []Delivery) error {
+	batch := &pgx.Batch{}
+	for _, d := range deliveries {
+		batch.Queue(`
+			INSERT INTO webhook_deliveries (id, endpoint_id, event_id, event_type, body, status,
+			                                attempts, next_attempt_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, 0, $7, $8)
+		`, d.ID[:], d.EndpointID[:], d.EventID, d.EventType, d.Body, string(d.Status),
+			pgnanos.From(d.NextAttemptAt), pgnanos.From(d.CreatedAt))
+	}
+	if err := s.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return oops.Code("WEBHOOK_ENQUEUE_FAILED").
+			With("operation", "enqueue").
+			With("deliveries", len(deliveries)).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Claim leases up to limit due pending deliveries of enabled endpoints,
+// oldest first. SKIP LOCKED lets workers on every replica claim distinct
+// deliveries without waiting on each other.
+func (s *PostgresStore) Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Delivery, error) {
+	return s.queryDeliveries(ctx, "claim", `
+		UPDATE webhook_deliveries
+		   SET next_attempt_at = $2
+		 WHERE id IN (
+		       SELECT d.id FROM webhook_deliveries d
+		         JOIN webhook_endpoints e ON e.id = d.endpoint_id
+		        WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND e.enabled
+		        ORDER BY d.next_attempt_at, d.id
+		        LIMIT $3
+		          FOR UPDATE OF d SKIP LOCKED)
+		RETURNING `+deliveryColumns,
+		pgnanos.From(now), pgnanos.From(leaseUntil), limit)
+}
+
+// Record stores the outcome of an attempt on delivery.
+func (s *PostgresStore) Record(ctx context.Context, delivery Delivery) error {
+	var deliveredAt *pgnanos.Time
+	if delivery.DeliveredAt != nil {
+		t := pgnanos.From(*delivery.DeliveredAt)
+		deliveredAt = &t
+	}
+	_, err := s.pool.Exec(ctx, `
+		UPDATE webhook_deliveries
+		   SET status = $2, attempts = $3, next_attempt_at = $4, last_status_code = $5,
+		       last_error = $6, delivered_at = $7
+		 WHERE id = $1
+	`, delivery.ID[:], string(delivery.Status), delivery.Attempts, pgnanos.From(delivery.NextAttemptAt),
+		delivery.LastStatusCode, delivery.LastError, deliveredAt)
+	if err != nil {
+		return oops.Code("WEBHOOK_STORE_FAILED").
+			With("operation", "record").
+			With("delivery_id", delivery.ID.String()).
+			Wrap(err)
+	}
+	return nil
+}
+
+// Deliveries returns deliveries matching filter, newest first.
+func (s *PostgresStore) Deliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error) {
+	var endpointID []byte
+	if filter.EndpointID != (ulid.ULID{}) {
+		endpointID = filter.EndpointID[:]
+	}
+	return s.queryDeliveries(ctx, "deliveries", `
+		SELECT `+deliveryColumns+`
+		  FROM webhook_deliveries
+		 WHERE $1::bytea IS NULL OR endpoint_id = $1
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $2
+	`, endpointID, filter.Limit)
+}
+
+// Prune deletes finished deliveries created before before.
+func (s *PostgresStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	tag, err := s.pool.Exec(ctx, `
+		DELETE FROM webhook_deliveries WHERE status <> 'pending' AND created_at < $1
+	`, pgnanos.From(before))
+	if err != nil {
+		return 0, oops.Code("WEBHOOK_STORE_FAILED").With("operation", "prune").Wrap(err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *PostgresStore) queryDeliveries(ctx context.Context, operation, sql string, args ...any) ([]Delivery, error) {
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+
+	var deliveries []Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", operation).Wrap(err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("WEBHOOK_STORE_FAILED").With("operation", operation).Wrap(err)
+	}
+	return deliveries, nil
+}
+
+func scanEndpoint(row pgx.Row) (Endpoint, error) {
+	var (
+		endpoint  Endpoint
+		id        []byte
+		createdAt pgnanos.Time
+	)
+	err := row.Scan(&id, &endpoint.Name, &endpoint.URL, &endpoint.Secret, &endpoint.Events,
+		&endpoint.RatePerMinute, &endpoint.Enabled, &endpoint.CreatedBy, &createdAt)
+	if err != nil {
+		return Endpoint{}, err //nolint:wrapcheck // callers wrap with the operation
+	}
+	copy(endpoint.ID[:], id)
+	endpoint.CreatedAt = createdAt.Time()
+	return endpoint, nil
+}
+
+func scanDelivery(row pgx.Row) (Delivery, error) {
+	var (
+		delivery       Delivery
+		id, endpointID []byte
+		status         string
+		nextAttemptAt  pgnanos.Time
+		createdAt      pgnanos.Time
+		deliveredAt    *pgnanos.Time
+	)
+	err := row.Scan(&id, &endpointID, &delivery.EventID, &delivery.EventType, &delivery.Body, &status,
+		&delivery.Attempts, &nextAttemptAt, &delivery.LastStatusCode, &delivery.LastError, &createdAt, &deliveredAt)
+	if err != nil {
+		return Delivery{}, err //nolint:wrapcheck // callers wrap with the operation
+	}
+	copy(delivery.ID[:], id)
+	copy(delivery.EndpointID[:], endpointID)
+	delivery.Status = Status(status)
+	delivery.NextAttemptAt = nextAttemptAt.Time()
+	delivery.CreatedAt = createdAt.Time()
+	if deliveredAt != nil {
+		t := deliveredAt.Time()
+		delivery.DeliveredAt = &t
+	}
+	return delivery, nil
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/webhook"
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/testutil"
+)
+
+// newTestPool returns a pool on a fresh, migrated database that is dropped
+// when the test ends.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	shared := testutil.SharedPostgres(t)
+	connStr := testutil.FreshDatabase(t, shared)
+	pool, err := pgxpool.New(context.Background(), connStr)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// newStoredEndpoint inserts an enabled endpoint named name.
+func newStoredEndpoint(t *testing.T, st *webhook.PostgresStore, name string) webhook.Endpoint {
+	t.Helper()
+	endpoint := webhook.Endpoint{
+		ID:            idgen.New(),
+		Name:          name,
+		URL:           "https://example.com/" + name,
+		Secret:        "c0ffee",
+		Events:        []string{"scene.*", "say"},
+		RatePerMinute: 60,
+		Enabled:       true,
+		CreatedBy:     "Admin",
+		CreatedAt:     time.Now(),
+	}
+	require.NoError(t, st.CreateEndpoint(context.Background(), endpoint))
+	return endpoint
+}
+
+func pendingDelivery(endpoint webhook.Endpoint, due time.Time) webhook.Delivery {
+	return webhook.Delivery{
+		ID:            idgen.New(),
+		EndpointID:    endpoint.ID,
+		EventID:       idgen.New().String(),
+		EventType:     "say",
+		Body:          []byte(`{"type":"say"}`),
+		Status:        webhook.StatusPending,
+		NextAttemptAt: due,
+		CreatedAt:     due,
+	}
+}
+
+func TestPostgresStoreEndpoints(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := webhook.NewPostgresStore(pool)
+	want := newStoredEndpoint(t, st, "pg-endpoints")
+
+	err := st.CreateEndpoint(ctx, webhook.Endpoint{
+		ID: idgen.New(), Name: want.Name, URL: want.URL, Secret: "x", Events: want.Events,
+		RatePerMinute: 1, CreatedAt: time.Now(),
+	})
+	errutil.AssertErrorCode(t, err, "WEBHOOK_EXISTS")
+
+	got, err := st.SetEnabled(ctx, want.Name, false)
+	require.NoError(t, err)
+	assert.False(t, got.Enabled)
+	assert.Equal(t, want.Events, got.Events)
+	assert.Equal(t, want.Secret, got.Secret)
+	assert.True(t, want.CreatedAt.Equal(got.CreatedAt))
+
+	_, err = st.SetEnabled(ctx, "pg-missing", true)
+	errutil.AssertErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+
+	_, err = st.DeleteEndpoint(ctx, want.Name)
+	require.NoError(t, err)
+	_, err = st.DeleteEndpoint(ctx, want.Name)
+	errutil.AssertErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+}
+
+func TestPostgresStoreClaimAndRecord(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := webhook.NewPostgresStore(pool)
+	endpoint := newStoredEndpoint(t, st, "pg-claim")
+	now := time.Now()
+	due := pendingDelivery(endpoint, now.Add(-time.Second))
+	later := pendingDelivery(endpoint, now.Add(time.Hour))
+	require.NoError(t, st.Enqueue(ctx, []webhook.Delivery{due, later}))
+
+	lease := now.Add(time.Minute)
+	claimed, err := st.Claim(ctx, now, lease, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, due.ID, claimed[0].ID)
+	assert.Equal(t, due.Body, claimed[0].Body)
+	assert.True(t, lease.Equal(claimed[0].NextAttemptAt), "the claim leases the delivery")
+
+	claimed, err = st.Claim(ctx, now, lease, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "a leased delivery is not claimed again")
+
+	delivered := due
+	delivered.Status = webhook.StatusDelivered
+	delivered.Attempts = 1
+	delivered.LastStatusCode = 204
+	delivered.DeliveredAt = &now
+	require.NoError(t, st.Record(ctx, delivered))
+
+	log, err := st.Deliveries(ctx, webhook.DeliveryFilter{EndpointID: endpoint.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, log, 2)
+	assert.Equal(t, later.ID, log[0].ID, "newest first")
+	assert.Equal(t, webhook.StatusDelivered, log[1].Status)
+	assert.Equal(t, 204, log[1].LastStatusCode)
+	require.NotNil(t, log[1].DeliveredAt)
+	assert.True(t, now.Equal(*log[1].DeliveredAt))
+}
+
+func TestPostgresStoreClaimSkipsDisabledEndpoints(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := webhook.NewPostgresStore(pool)
+	endpoint := newStoredEndpoint(t, st, "pg-disabled")
+	now := time.Now()
+	require.NoError(t, st.Enqueue(ctx, []webhook.Delivery{pendingDelivery(endpoint, now.Add(-time.Second))}))
+	_, err := st.SetEnabled(ctx, endpoint.Name, false)
+	require.NoError(t, err)
+
+	claimed, err := st.Claim(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	for _, d := range claimed {
+		assert.NotEqual(t, endpoint.ID, d.EndpointID)
+	}
+}
+
+func TestPostgresStorePrune(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	st := webhook.NewPostgresStore(pool)
+	endpoint := newStoredEndpoint(t, st, "pg-prune")
+	old := time.Now().Add(-48 * time.Hour)
+	finished := pendingDelivery(endpoint, old)
+	pending := pendingDelivery(endpoint, old)
+	require.NoError(t, st.Enqueue(ctx, []webhook.Delivery{finished, pending}))
+	finished.Status = webhook.StatusFailed
+	require.NoError(t, st.Record(ctx, finished))
+
+	n, err := st.Prune(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, n, 1)
+
+	log, err := st.Deliveries(ctx, webhook.DeliveryFilter{EndpointID: endpoint.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, log, 1)
+	assert.Equal(t, pending.ID, log[0].ID, "pending deliveries are never pruned")
+}
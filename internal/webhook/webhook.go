@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package webhook delivers game events to operator-registered HTTP
+// endpoints, so external tools such as Discord bridges and dashboards can
+// follow the game without database access.
+//
+// Each endpoint subscribes to event types by pattern. Matching events are
+// queued in PostgreSQL as deliveries and sent by background workers as a
+// signed JSON POST: the X-Holomush-Signature header carries an HMAC-SHA256
+// of the timestamp and body under the endpoint's secret. A failed delivery
+// is retried with exponential backoff until MaxAttempts, and each endpoint
+// is held to its own rate limit. Deliveries stay in the table as the
+// delivery log until they are pruned.
+//
+// Sensitive (encrypted) events are never queued.
+package webhook
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/samber/oops"
+)
+
+// Endpoint limits and defaults.
+const (
+	// MaxURLLength bounds an endpoint URL in bytes.
+	MaxURLLength = 2048
+	// MaxEventPatterns bounds how many patterns one endpoint subscribes to.
+	MaxEventPatterns = 32
+	// DefaultRatePerMinute is an endpoint's rate limit when none is set.
+	DefaultRatePerMinute = 60
+	// MaxRatePerMinute bounds Endpoint.RatePerMinute.
+	MaxRatePerMinute = 6000
+	// SecretBytes is the length of a generated signing secret before hex
+	// encoding.
+	SecretBytes = 32
+)
+
+const (
+	// DefaultListLimit is the number of deliveries Deliveries returns when
+	// the filter sets no limit.
+	DefaultListLimit = 20
+	// MaxListLimit bounds DeliveryFilter.Limit.
+	MaxListLimit = 200
+	// MaxErrorLength bounds the stored failure of a delivery attempt.
+	// Longer errors are truncated.
+	MaxErrorLength = 512
+)
+
+// Request headers set on every delivery.
+const (
+	HeaderSignature = "X-Holomush-Signature"
+	HeaderTimestamp = "X-Holomush-Timestamp"
+	HeaderEvent     = "X-Holomush-Event"
+	HeaderDelivery  = "X-Holomush-Delivery"
+)
+
+// namePattern restricts endpoint names to short identifiers staff can type.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// patternRe matches an event type pattern: an event type, a dot-segmented
+// prefix ending in ".*", or "*" alone for every event.
+var patternRe = regexp.MustCompile(`^(\*|[a-z][a-z0-9_-]*(\.[a-z][a-z0-9_-]*)*(\.\*)?|[a-z][a-z0-9_-]*:[a-z][a-z0-9_-]*)$`)
+
+// deliveriesTotal counts finished delivery attempts per endpoint and
+// outcome ("delivered", "retry", "failed").
+var deliveriesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "holomush_webhook_deliveries_total",
+		Help: "Total outbound webhook delivery attempts by endpoint and outcome",
+	},
+	[]string{"endpoint", "outcome"},
+)
+
+// Endpoint is an operator-registered URL that receives game events.
+type Endpoint struct {
+	ID   ulid.ULID
+	Name string
+	URL  string
+	// Secret signs every delivery. It is generated on registration and
+	// shown to the operator once.
+	Secret string
+	// Events are the event type patterns the endpoint receives: an exact
+	// type such as "character_created", a prefix such as "scene.*", or
+	// "*" for every event.
+	Events []string
+	// RatePerMinute caps deliveries to the endpoint; deliveries over the
+	// limit wait in the queue.
+	RatePerMinute int
+	Enabled       bool
+	CreatedBy     string
+	CreatedAt     time.Time
+}
+
+// Matches reports whether the endpoint subscribes to eventType.
+func (e Endpoint) Matches(eventType string) bool {
+	for _, p := range e.Events {
+		if matchPattern(p, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPattern(pattern, eventType string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, ".*"); ok {
+		return strings.HasPrefix(eventType, prefix+".")
+	}
+	return pattern == eventType
+}
+
+// Validate checks the endpoint's name, URL, patterns, and rate limit.
+// Errors carry oops code WEBHOOK_INVALID.
+func (e Endpoint) Validate() error {
+	if !namePattern.MatchString(e.Name) {
+		return oops.Code("WEBHOOK_INVALID").
+			With("name", e.Name).
+			Errorf("webhook name must be 1-32 lowercase letters, digits, '_' or '-', starting with a letter")
+	}
+	if err := ValidateURL(e.URL); err != nil {
+		return err
+	}
+	if len(e.Events) == 0 {
+		return oops.Code("WEBHOOK_INVALID").With("name", e.Name).Errorf("webhook must subscribe to at least one event type")
+	}
+	if len(e.Events) > MaxEventPatterns {
+		return oops.Code("WEBHOOK_INVALID").
+			With("name", e.Name).
+			Errorf("webhook may subscribe to at most %d event types", MaxEventPatterns)
+	}
+	for _, p := range e.Events {
+		if !patternRe.MatchString(p) {
+			return oops.Code("WEBHOOK_INVALID").
+				With("name", e.Name).
+				With("pattern", p).
+				Errorf("invalid event type pattern %q", p)
+		}
+	}
+	if e.RatePerMinute < 1 || e.RatePerMinute > MaxRatePerMinute {
+		return oops.Code("WEBHOOK_INVALID").
+			With("name", e.Name).
+			Errorf("webhook rate limit must be between 1 and %d per minute", MaxRatePerMinute)
+	}
+	return nil
+}
+
+// ValidateURL checks that raw is an absolute http or https URL with a host.
+func ValidateURL(raw string) error {
+	if len(raw) > MaxURLLength {
+		return oops.Code("WEBHOOK_INVALID").Errorf("webhook URL exceeds %d bytes", MaxURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return oops.Code("WEBHOOK_INVALID").With("url", raw).Errorf("webhook URL must be an absolute http or https URL")
+	}
+	if u.User != nil {
+		return oops.Code("WEBHOOK_INVALID").Errorf("webhook URL must not embed credentials")
+	}
+	return nil
+}
+
+// Status is where a delivery is in its lifecycle.
+type Status string
+
+const (
+	// StatusPending deliveries wait for their next attempt.
+	StatusPending Status = "pending"
+	// StatusDelivered deliveries got a 2xx response.
+	StatusDelivered Status = "delivered"
+	// StatusFailed deliveries used up their attempts.
+	StatusFailed Status = "failed"
+)
+
+// Delivery is one event queued for one endpoint. Finished deliveries are
+// the endpoint's delivery log.
+type Delivery struct {
+	ID         ulid.ULID
+	EndpointID ulid.ULID
+	EventID    string
+	EventType  string
+	// Body is the JSON document POSTed to the endpoint.
+	Body   []byte
+	Status Status
+	// Attempts counts requests made so far.
+	Attempts      int
+	NextAttemptAt time.Time
+	// LastStatusCode is the HTTP status of the last attempt, or 0 when it
+	// got no response.
+	LastStatusCode int
+	// LastError explains the last failed attempt.
+	LastError   string
+	CreatedAt   time.Time
+	DeliveredAt *time.Time
+}
+
+// DeliveryFilter narrows Deliveries.
+type DeliveryFilter struct {
+	// EndpointID restricts the result to one endpoint; zero means every
+	// endpoint.
+	EndpointID ulid.ULID
+	// Limit caps the result (default DefaultListLimit, at most
+	// MaxListLimit).
+	Limit int
+}
+
+// Store persists endpoints and deliveries. *PostgresStore satisfies it.
+type Store interface {
+	// CreateEndpoint inserts endpoint. Returns WEBHOOK_EXISTS when the
+	// name is taken.
+	CreateEndpoint(ctx context.Context, endpoint Endpoint) error
+	// ListEndpoints returns every endpoint ordered by name.
+	ListEndpoints(ctx context.Context) ([]Endpoint, error)
+	// SetEnabled turns the named endpoint on or off. Returns
+	// WEBHOOK_NOT_FOUND when absent.
+	SetEnabled(ctx context.Context, name string, enabled bool) (Endpoint, error)
+	// DeleteEndpoint removes the named endpoint and its deliveries.
+	// Returns WEBHOOK_NOT_FOUND when absent.
+	DeleteEndpoint(ctx context.Context, name string) (Endpoint, error)
+
+	// Enqueue inserts pending deliveries.
+	Enqueue(ctx context.Context, deliveries []Delivery) error
+	// Claim leases up to limit deliveries due at now, pushing their next
+	// attempt to leaseUntil so no other worker takes them meanwhile.
+	Claim(ctx context.Context, now, leaseUntil time.Time, limit int) ([]Delivery, error)
+	// Record stores the outcome of an attempt on delivery.
+	Record(ctx context.Context, delivery Delivery) error
+	// Deliveries returns deliveries matching filter, newest first.
+	// filter.Limit is already resolved by the caller.
+	Deliveries(ctx context.Context, filter DeliveryFilter) ([]Delivery, error)
+	// Prune deletes finished deliveries created before before.
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
+
+func errNotFound(name string) error {
+	return oops.Code("WEBHOOK_NOT_FOUND").With("name", name).Errorf("no webhook named %s", name)
+}
+
+// truncateError bounds a failure message to MaxErrorLength bytes.
+func truncateError(msg string) string {
+	if len(msg) <= MaxErrorLength {
+		return msg
+	}
+	cut := MaxErrorLength
+	for cut > 0 && !utf8.RuneStart(msg[cut]) {
+		cut--
+	}
+	return msg[:cut] + "…"
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// AccessInvalidator is notified after a committed world write changes state
+// that feeds ABAC attributes — an object's owner, lock or containment, a
+// character's location or visibility, a location's fields, or an entity's
+// deletion. refs are the entity references (e.g. access.ObjectResource) whose
+// cached access decisions must no longer be served, as subject or resource.
+//
+// Like MovementHook it runs post-commit, so it cannot fail the write; an
+// implementation that cannot invalidate should log and let its cache's TTL
+// bound the staleness.
+type AccessInvalidator interface {
+	InvalidateAccess(ctx context.Context, refs ...string)
+}
+
+// NoopAccessInvalidator is the default when no invalidator is wired.
+type NoopAccessInvalidator struct{}
+
+// InvalidateAccess is a no-op implementation of AccessInvalidator.InvalidateAccess.
+func (NoopAccessInvalidator) InvalidateAccess(context.Context, ...string) {}
+
+// SetAccessInvalidator registers the invalidator notified after writes that
+// change ABAC-relevant state. Passing nil resets to the no-op default.
+func (s *Service) SetAccessInvalidator(inv AccessInvalidator) {
+	if inv == nil {
+		s.accessInvalidator = NoopAccessInvalidator{}
+		return
+	}
+	s.accessInvalidator = inv
+}
+
+// invalidateAccess notifies the access invalidator that refs changed.
+func (s *Service) invalidateAccess(ctx context.Context, refs ...string) {
+	slog.DebugContext(ctx, "world: invalidating cached access decisions", "refs", refs)
+	s.accessInvalidator.InvalidateAccess(ctx, refs...)
+}
+
+func (s *Service) invalidateObjectAccess(ctx context.Context, id ulid.ULID) {
+	s.invalidateAccess(ctx, access.ObjectResource(id.String()))
+}
+
+func (s *Service) invalidateCharacterAccess(ctx context.Context, id ulid.ULID) {
+	s.invalidateAccess(ctx, access.CharacterResource(id.String()))
+}
+
+func (s *Service) invalidateLocationAccess(ctx context.Context, id ulid.ULID) {
+	s.invalidateAccess(ctx, access.LocationResource(id.String()))
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+)
+
+// recordingInvalidator captures every ref it is told to invalidate.
+type recordingInvalidator struct {
+	refs []string
+}
+
+func (r *recordingInvalidator) InvalidateAccess(_ context.Context, refs ...string) {
+	r.refs = append(r.refs, refs...)
+}
+
+func TestTransferOwnership_InvalidatesObjectAccess(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, false)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "transfer", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	inv := &recordingInvalidator{}
+	svc.SetAccessInvalidator(inv)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.TransferOwnership(ctx, subjectID, obj.ID, ulid.Make()))
+	assert.Equal(t, []string{access.ObjectResource(obj.ID.String())}, inv.refs)
+}
+
+func TestMoveCharacter_InvalidatesCharacterAccess(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	fix := newTestServiceWithHook(t, movementHookFn(func(context.Context, ulid.ULID, ulid.ULID, time.Time) error {
+		return nil
+	}))
+	inv := &recordingInvalidator{}
+	fix.svc.SetAccessInvalidator(inv)
+	charID, toLocID := seedCharacterAndTwoLocations(t, fix, subjectID)
+
+	require.NoError(t, fix.svc.MoveCharacter(ctx, subjectID, charID, toLocID))
+	assert.Equal(t, []string{access.CharacterResource(charID.String())}, inv.refs)
+}
+
+func TestLockObject_FailedWriteInvalidatesNothing(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, false)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	inv := &recordingInvalidator{}
+	svc.SetAccessInvalidator(inv)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, errors.New("connection reset")).Once()
+
+	require.Error(t, svc.LockObject(ctx, subjectID, obj.ID))
+	assert.Empty(t, inv.refs, "an uncommitted write leaves cached decisions alone")
+}
+
+func TestSetAccessInvalidator_NilResetsToNoop(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, false)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	svc.SetAccessInvalidator(nil)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.LockObject(ctx, subjectID, obj.ID))
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// ErrLocationFull is returned when a character cannot enter a location
+// because it holds Location.MaxOccupancy characters and has no overflow
+// location with room. Stamped with CodeLocationFull.
+var ErrLocationFull = errors.New("location is full")
+
+// CodeLocationFull is the oops code for a move refused by a full location.
+const CodeLocationFull = "LOCATION_FULL"
+
+// ActionExceedCapacity is the ABAC action, on the destination location, that
+// lets a subject move characters into it while it is full. Staff hold it.
+const ActionExceedCapacity = "exceed_capacity"
+
+// AdmitCharacter returns the location the character with characterID enters
+// when moved to toLocationID on behalf of subjectID. That is toLocationID
+// itself while it has room, or when subjectID may ActionExceedCapacity on
+// it; otherwise its overflow location, if it names one with room. Occupancy
+// counts every character whose location it is, connected or not, except the
+// character being moved. Overflow is followed one step only.
+//
+// MoveCharacter applies the same rule; callers that announce the move before
+// making it (see traversal.Service) call AdmitCharacter first to learn where
+// the character will arrive.
+//
+// Returns LOCATION_NOT_FOUND wrapping ErrNotFound when toLocationID does not
+// exist and LOCATION_FULL wrapping ErrLocationFull when neither it nor its
+// overflow has room.
+func (s *Service) AdmitCharacter(ctx context.Context, subjectID string, characterID, toLocationID ulid.ULID) (ulid.ULID, error) {
+	if s.locationRepo == nil || s.characterRepo == nil {
+		return ulid.ULID{}, oops.Code("LOCATION_CAPACITY_CHECK_FAILED").Errorf("location or character repository not configured")
+	}
+	loc, err := s.locationRepo.Get(ctx, toLocationID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ulid.ULID{}, oops.Code("LOCATION_NOT_FOUND").Wrapf(err, "admit character to location %s", toLocationID)
+		}
+		return ulid.ULID{}, oops.Code("LOCATION_CAPACITY_CHECK_FAILED").Wrapf(err, "get location %s", toLocationID)
+	}
+	return s.admitCharacter(ctx, subjectID, characterID, loc)
+}
+
+// admitCharacter is AdmitCharacter for an already loaded destination.
+func (s *Service) admitCharacter(ctx context.Context, subjectID string, characterID ulid.ULID, loc *Location) (ulid.ULID, error) {
+	full, err := s.locationFull(ctx, characterID, loc)
+	if err != nil || !full {
+		return loc.ID, err
+	}
+	exempt, err := s.exemptFromCapacity(ctx, subjectID, loc.ID)
+	if err != nil || exempt {
+		return loc.ID, err
+	}
+	if loc.OverflowID != nil {
+		overflow, err := s.locationRepo.Get(ctx, *loc.OverflowID)
+		switch {
+		case errors.Is(err, ErrNotFound):
+			// Deleted since it was named; the location simply turns arrivals away.
+		case err != nil:
+			return ulid.ULID{}, oops.Code("LOCATION_CAPACITY_CHECK_FAILED").Wrapf(err, "get overflow location %s", *loc.OverflowID)
+		default:
+			overflowFull, err := s.locationFull(ctx, characterID, overflow)
+			if err != nil {
+				return ulid.ULID{}, err
+			}
+			if !overflowFull {
+				return overflow.ID, nil
+			}
+		}
+	}
+	return ulid.ULID{}, oops.Code(CodeLocationFull).
+		With("location_id", loc.ID.String()).
+		With("max_occupancy", loc.MaxOccupancy).
+		Wrapf(ErrLocationFull, "location %s", loc.ID)
+}
+
+// locationFull reports whether loc already holds MaxOccupancy characters
+// other than characterID. An uncapped location is never full.
+func (s *Service) locationFull(ctx context.Context, characterID ulid.ULID, loc *Location) (bool, error) {
+	if loc.MaxOccupancy <= 0 {
+		return false, nil
+	}
+	opts := ListOptions{Limit: loc.MaxOccupancy + 1}
+	count := 0
+	for {
+		page, err := s.characterRepo.GetByLocation(ctx, loc.ID, opts)
+		if err != nil {
+			return false, oops.Code("LOCATION_CAPACITY_CHECK_FAILED").Wrapf(err, "count characters at location %s", loc.ID)
+		}
+		for _, c := range page.Items {
+			if c.ID != characterID {
+				count++
+			}
+		}
+		if count >= loc.MaxOccupancy {
+			return true, nil
+		}
+		if page.NextCursor == "" {
+			return false, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+// exemptFromCapacity reports whether subjectID may move characters into the
+// location with locationID while it is full. A policy denial is not an
+// error; an evaluation failure is.
+func (s *Service) exemptFromCapacity(ctx context.Context, subjectID string, locationID ulid.ULID) (bool, error) {
+	err := s.checkAccess(ctx, subjectID, ActionExceedCapacity, access.LocationResource(locationID.String()), prefixLocation)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, ErrPermissionDenied):
+		return false, nil
+	}
+	return false, err
+}
+
+// checkLocationOverflow verifies that the overflow location loc names
+// exists. Returns LOCATION_OVERFLOW_NOT_FOUND wrapping ErrNotFound when it
+// does not.
+func (s *Service) checkLocationOverflow(ctx context.Context, loc *Location) error {
+	if loc.OverflowID == nil {
+		return nil
+	}
+	if _, err := s.locationRepo.Get(ctx, *loc.OverflowID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			// Wrap the sentinel, not err: the repository's own code would
+			// mask this one.
+			return oops.Code("LOCATION_OVERFLOW_NOT_FOUND").
+				With("overflow_id", loc.OverflowID.String()).
+				Wrapf(ErrNotFound, "overflow location %s", *loc.OverflowID)
+		}
+		return oops.Code("LOCATION_OVERFLOW_CHECK_FAILED").With("overflow_id", loc.OverflowID.String()).Wrap(err)
+	}
+	return nil
+}
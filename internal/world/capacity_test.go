@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// capacityWorld is a world.Service over memory repositories in which
+// builder may do anything and staff may also exceed capacity.
+type capacityWorld struct {
+	svc        *world.Service
+	characters *memory.CharacterRepository
+	builder    string
+	staff      string
+}
+
+func newCapacityWorld(t *testing.T) *capacityWorld {
+	t.Helper()
+	store := memory.NewStore()
+	w := &capacityWorld{
+		characters: memory.NewCharacterRepository(store),
+		builder:    access.CharacterSubject(ulid.Make().String()),
+		staff:      access.CharacterSubject(ulid.Make().String()),
+	}
+	engine := policytest.NewScenario(t)
+	engine.Allow(w.builder, w.staff).To("read", "write").On("*")
+	engine.Allow(w.staff).To(world.ActionExceedCapacity).On("location:*")
+	w.svc = world.NewService(world.ServiceConfig{
+		LocationRepo:  memory.NewLocationRepository(store),
+		ExitRepo:      memory.NewExitRepository(store),
+		ObjectRepo:    memory.NewObjectRepository(store),
+		SceneRepo:     memory.NewSceneRepository(store),
+		CharacterRepo: w.characters,
+		Engine:        engine,
+		Transactor:    memory.NewTransactor(store),
+		OutboxWriter:  memory.NewOutboxWriter(store),
+		GameID:        "test-game",
+	})
+	return w
+}
+
+func (w *capacityWorld) location(ctx context.Context, t *testing.T, name string, maxOccupancy int, overflow *ulid.ULID) *world.Location {
+	t.Helper()
+	loc, err := world.NewLocation(name, "A room.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	loc.MaxOccupancy = maxOccupancy
+	loc.OverflowID = overflow
+	require.NoError(t, w.svc.CreateLocation(ctx, w.builder, loc))
+	return loc
+}
+
+func (w *capacityWorld) character(ctx context.Context, t *testing.T, name string, locationID ulid.ULID) *world.Character {
+	t.Helper()
+	char, err := world.NewCharacter(ulid.Make(), name)
+	require.NoError(t, err)
+	char.LocationID = &locationID
+	_, err = w.characters.Create(ctx, char)
+	require.NoError(t, err)
+	return char
+}
+
+func (w *capacityWorld) where(ctx context.Context, t *testing.T, characterID ulid.ULID) ulid.ULID {
+	t.Helper()
+	char, err := w.characters.Get(ctx, characterID)
+	require.NoError(t, err)
+	require.NotNil(t, char.LocationID)
+	return *char.LocationID
+}
+
+func TestLocationValidateChecksCapacity(t *testing.T) {
+	loc, err := world.NewLocation("Booth", "", world.LocationTypePersistent)
+	require.NoError(t, err)
+
+	loc.MaxOccupancy = 2
+	other := ulid.Make()
+	loc.OverflowID = &other
+	require.NoError(t, loc.Validate())
+
+	loc.MaxOccupancy = -1
+	var verr *world.ValidationError
+	require.ErrorAs(t, loc.Validate(), &verr)
+	assert.Equal(t, "max_occupancy", verr.Field)
+
+	loc.MaxOccupancy = 2
+	loc.OverflowID = &loc.ID
+	require.ErrorAs(t, loc.Validate(), &verr)
+	assert.Equal(t, "overflow_id", verr.Field)
+}
+
+func TestMoveCharacterIntoFullLocation(t *testing.T) {
+	ctx := context.Background()
+	w := newCapacityWorld(t)
+	hall := w.location(ctx, t, "Hall", 0, nil)
+	booth := w.location(ctx, t, "Booth", 1, nil)
+	w.character(ctx, t, "Alice", booth.ID)
+	bob := w.character(ctx, t, "Bob", hall.ID)
+
+	err := w.svc.MoveCharacter(ctx, w.builder, bob.ID, booth.ID)
+	errutil.AssertErrorCode(t, err, world.CodeLocationFull)
+	assert.ErrorIs(t, err, world.ErrLocationFull)
+	assert.Equal(t, hall.ID, w.where(ctx, t, bob.ID))
+
+	t.Run("staff are exempt", func(t *testing.T) {
+		require.NoError(t, w.svc.MoveCharacter(ctx, w.staff, bob.ID, booth.ID))
+		assert.Equal(t, booth.ID, w.where(ctx, t, bob.ID))
+	})
+
+	t.Run("a character already inside is not counted against itself", func(t *testing.T) {
+		to, err := w.svc.AdmitCharacter(ctx, w.builder, bob.ID, hall.ID)
+		require.NoError(t, err)
+		assert.Equal(t, hall.ID, to)
+	})
+}
+
+func TestMoveCharacterOverflows(t *testing.T) {
+	ctx := context.Background()
+	w := newCapacityWorld(t)
+	hall := w.location(ctx, t, "Hall", 0, nil)
+	lobby := w.location(ctx, t, "Lobby", 1, nil)
+	booth := w.location(ctx, t, "Booth", 1, &lobby.ID)
+	w.character(ctx, t, "Alice", booth.ID)
+	bob := w.character(ctx, t, "Bob", hall.ID)
+	carol := w.character(ctx, t, "Carol", hall.ID)
+
+	to, err := w.svc.AdmitCharacter(ctx, w.builder, bob.ID, booth.ID)
+	require.NoError(t, err)
+	assert.Equal(t, lobby.ID, to)
+
+	require.NoError(t, w.svc.MoveCharacter(ctx, w.builder, bob.ID, booth.ID))
+	assert.Equal(t, lobby.ID, w.where(ctx, t, bob.ID))
+
+	// Overflow is followed one step: with the lobby full too, Carol is refused.
+	err = w.svc.MoveCharacter(ctx, w.builder, carol.ID, booth.ID)
+	errutil.AssertErrorCode(t, err, world.CodeLocationFull)
+	assert.Equal(t, hall.ID, w.where(ctx, t, carol.ID))
+}
+
+func TestUncappedLocationAdmitsEveryone(t *testing.T) {
+	ctx := context.Background()
+	w := newCapacityWorld(t)
+	hall := w.location(ctx, t, "Hall", 0, nil)
+	yard := w.location(ctx, t, "Yard", 0, nil)
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		char := w.character(ctx, t, name, hall.ID)
+		require.NoError(t, w.svc.MoveCharacter(ctx, w.builder, char.ID, yard.ID))
+	}
+}
+
+func TestAdmitCharacterUnknownLocation(t *testing.T) {
+	ctx := context.Background()
+	w := newCapacityWorld(t)
+
+	_, err := w.svc.AdmitCharacter(ctx, w.builder, ulid.Make(), ulid.Make())
+	errutil.AssertErrorCode(t, err, "LOCATION_NOT_FOUND")
+	assert.ErrorIs(t, err, world.ErrNotFound)
+}
+
+func TestLocationOverflowMustExist(t *testing.T) {
+	ctx := context.Background()
+	w := newCapacityWorld(t)
+	missing := ulid.Make()
+
+	loc, err := world.NewLocation("Booth", "", world.LocationTypePersistent)
+	require.NoError(t, err)
+	loc.MaxOccupancy = 2
+	loc.OverflowID = &missing
+	err = w.svc.CreateLocation(ctx, w.builder, loc)
+	errutil.AssertErrorCode(t, err, "LOCATION_OVERFLOW_NOT_FOUND")
+
+	booth := w.location(ctx, t, "Booth", 2, nil)
+	booth.OverflowID = &missing
+	err = w.svc.UpdateLocation(ctx, w.builder, booth)
+	errutil.AssertErrorCode(t, err, "LOCATION_OVERFLOW_NOT_FOUND")
+}
+
+func TestLocationPayloadCarriesCapacity(t *testing.T) {
+	loc, err := world.NewLocation("Booth", "", world.LocationTypePersistent)
+	require.NoError(t, err)
+	overflow := ulid.Make()
+	loc.MaxOccupancy = 4
+	loc.OverflowID = &overflow
+
+	raw, err := world.BuildLocationPayload(loc)
+	require.NoError(t, err)
+	var payload world.LocationChangePayload
+	require.NoError(t, json.Unmarshal(raw, &payload))
+	assert.Equal(t, 4, payload.MaxOccupancy)
+	assert.Equal(t, overflow.String(), payload.OverflowID)
+}
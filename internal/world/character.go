@@ -18,7 +18,18 @@ type Character struct {
 	Name        string
 	Description string
 	LocationID  *ulid.ULID // Current location (nil if not in world)
-	CreatedAt   time.Time
+	// Visibility is who can perceive the character (see CharacterVisibility). The zero
+	// value, from structs built before the field existed, reads as visible.
+	Visibility CharacterVisibility
+	// Effects are the timed layers on the character's description (see
+	// DescriptionEffect). Expired effects may linger until the next effect
+	// write; read them through ActiveEffects.
+	Effects []DescriptionEffect
+	// Tags are the character's free-form labels (see ValidateTag), kept
+	// sorted. Change them with Service.TagCharacter/UntagCharacter; Update
+	// does not write them.
+	Tags      []string
+	CreatedAt time.Time
 	// Version is the optimistic-concurrency version (MODEL-03). It carries the
 	// read version back into a guarded CAS write (... WHERE id=$1 AND version=$2)
 	// and is refreshed by the repo to the committed version after a successful
@@ -39,10 +50,11 @@ func NewCharacter(playerID ulid.ULID, name string) (*Character, error) {
 // The character is validated before being returned.
 func NewCharacterWithID(id, playerID ulid.ULID, name string) (*Character, error) {
 	c := &Character{
-		ID:        id,
-		PlayerID:  playerID,
-		Name:      name,
-		CreatedAt: time.Now(),
+		ID:         id,
+		PlayerID:   playerID,
+		Name:       name,
+		Visibility: CharacterVisible,
+		CreatedAt:  time.Now(),
 	}
 	if err := c.Validate(); err != nil {
 		return nil, err
@@ -105,5 +117,10 @@ func (c *Character) Validate() error {
 	if err := ValidateCharacterName(c.Name); err != nil {
 		return err
 	}
+	if c.Visibility != "" {
+		if err := c.Visibility.Validate(); err != nil {
+			return err
+		}
+	}
 	return ValidateDescription(c.Description)
 }
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// CharacterVisibility controls who can perceive a character: room contents, the
+// presence list, and arrive/leave broadcasts all skip a hidden character for
+// observers not entitled to see it.
+type CharacterVisibility string
+
+const (
+	// CharacterVisible is the default: everyone sees the character.
+	CharacterVisible CharacterVisibility = "visible"
+	// CharacterDark hides the character from everyone but staff (and admins).
+	// It is the GM state: watching a scene without taking part in it.
+	CharacterDark CharacterVisibility = "dark"
+	// CharacterInvisible hides the character from players only; builders
+	// and staff still see it.
+	CharacterInvisible CharacterVisibility = "invisible"
+)
+
+// ABAC actions an observer needs, on the hidden character, to see it.
+const (
+	ActionSeeDark       = "see_dark"
+	ActionSeeInvisible  = "see_invisible"
+	ActionSetVisibility = "set_visibility"
+)
+
+// ParseCharacterVisibility returns the visibility named by s.
+func ParseCharacterVisibility(s string) (CharacterVisibility, error) {
+	v := CharacterVisibility(s)
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// Validate reports whether v is one of the declared states.
+func (v CharacterVisibility) Validate() error {
+	switch v {
+	case CharacterVisible, CharacterDark, CharacterInvisible:
+		return nil
+	}
+	return &ValidationError{Field: "visibility", Message: "must be visible, dark, or invisible"}
+}
+
+// Normalize returns v, or CharacterVisible for the zero value.
+func (v CharacterVisibility) Normalize() CharacterVisibility {
+	if v == "" {
+		return CharacterVisible
+	}
+	return v
+}
+
+// Hidden reports whether some observers cannot see a character in state v.
+// The zero value is treated as visible.
+func (v CharacterVisibility) Hidden() bool {
+	return v == CharacterDark || v == CharacterInvisible
+}
+
+// SeeAction returns the ABAC action an observer needs to see a character in
+// state v, or "" when everyone can.
+func (v CharacterVisibility) SeeAction() string {
+	switch v {
+	case CharacterDark:
+		return ActionSeeDark
+	case CharacterInvisible:
+		return ActionSeeInvisible
+	}
+	return ""
+}
+
+// CanSeeCharacter reports whether the character observerID can perceive
+// target. A character always sees itself and everyone sees a visible
+// character; otherwise the observer needs target's SeeAction. Evaluation
+// failures hide the character: visibility fails closed.
+func (s *Service) CanSeeCharacter(ctx context.Context, observerID ulid.ULID, target *Character) bool {
+	if !target.Visibility.Hidden() || target.ID == observerID {
+		return true
+	}
+	if observerID.IsZero() {
+		return false
+	}
+	subject := access.CharacterSubject(observerID.String())
+	resource := access.CharacterResource(target.ID.String())
+	action := target.Visibility.SeeAction()
+	if err := s.checkAccess(ctx, subject, action, resource, prefixCharacter); err != nil {
+		if !errors.Is(err, ErrPermissionDenied) {
+			slog.WarnContext(ctx, "character visibility check failed, hiding character",
+				"observer_id", observerID.String(), "character_id", target.ID.String(), "error", err)
+		}
+		return false
+	}
+	return true
+}
+
+// CharacterVisibleTo is CanSeeCharacter by ID, for callers that only hold
+// the target's ID (event fan-out). The target is read without an access
+// check: the answer reveals nothing beyond what the observer may perceive.
+func (s *Service) CharacterVisibleTo(ctx context.Context, observerID, targetID ulid.ULID) (bool, error) {
+	if observerID == targetID {
+		return true, nil
+	}
+	if s.characterRepo == nil {
+		return false, oops.Code("CHARACTER_GET_FAILED").Errorf("character repository not configured")
+	}
+	target, err := s.characterRepo.Get(ctx, targetID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "get character %s", targetID)
+		}
+		return false, oops.Code("CHARACTER_GET_FAILED").Wrapf(err, "get character %s", targetID)
+	}
+	return s.CanSeeCharacter(ctx, observerID, target), nil
+}
+
+// FindCharacterByName returns the character named name, ignoring case, as
+// observerID perceives it: a character observerID cannot see is reported
+// as CHARACTER_NOT_FOUND, the same as one that does not exist, so a name
+// lookup never reveals a hidden character. A zero observerID finds only
+// visible characters. Commands that take a character name resolve it here.
+func (s *Service) FindCharacterByName(ctx context.Context, observerID ulid.ULID, name string) (*Character, error) {
+	if s.characterRepo == nil {
+		return nil, oops.Code("CHARACTER_GET_FAILED").Errorf("character repository not configured")
+	}
+	target, err := s.characterRepo.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, oops.Code("CHARACTER_NOT_FOUND").With("name", name).Wrap(err)
+		}
+		return nil, oops.Code("CHARACTER_GET_FAILED").With("name", name).Wrap(err)
+	}
+	if !s.CanSeeCharacter(ctx, observerID, target) {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	return target, nil
+}
+
+// VisibleCharacters returns the characters in chars that observerID can
+// see, in their original order. A zero observerID (no acting character)
+// sees only visible characters.
+func (s *Service) VisibleCharacters(ctx context.Context, observerID ulid.ULID, chars []*Character) []*Character {
+	visible := make([]*Character, 0, len(chars))
+	for _, c := range chars {
+		if s.CanSeeCharacter(ctx, observerID, c) {
+			visible = append(visible, c)
+		}
+	}
+	return visible
+}
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// hiddenTestCharacter returns a character with the given visibility.
+func hiddenTestCharacter(t *testing.T, v world.CharacterVisibility) *world.Character {
+	t.Helper()
+	char, err := world.NewCharacter(ulid.Make(), "Shade")
+	require.NoError(t, err)
+	char.Visibility = v
+	char.Version = 3
+	return char
+}
+
+func TestParseCharacterVisibility(t *testing.T) {
+	for _, s := range []string{"visible", "dark", "invisible"} {
+		v, err := world.ParseCharacterVisibility(s)
+		require.NoError(t, err)
+		assert.Equal(t, world.CharacterVisibility(s), v)
+	}
+
+	_, err := world.ParseCharacterVisibility("hidden")
+	var verr *world.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "visibility", verr.Field)
+}
+
+func TestCharacterVisibility_SeeAction(t *testing.T) {
+	assert.Empty(t, world.CharacterVisible.SeeAction())
+	assert.Empty(t, world.CharacterVisibility("").SeeAction())
+	assert.Equal(t, world.ActionSeeDark, world.CharacterDark.SeeAction())
+	assert.Equal(t, world.ActionSeeInvisible, world.CharacterInvisible.SeeAction())
+	assert.False(t, world.CharacterVisibility("").Hidden())
+	assert.Equal(t, world.CharacterVisible, world.CharacterVisibility("").Normalize())
+}
+
+func TestWorldService_CanSeeCharacter(t *testing.T) {
+	ctx := context.Background()
+	observerID := ulid.Make()
+	observer := access.CharacterSubject(observerID.String())
+
+	t.Run("visible characters are seen without a policy check", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.DenyAllEngine()})
+		assert.True(t, svc.CanSeeCharacter(ctx, observerID, hiddenTestCharacter(t, world.CharacterVisible)))
+	})
+
+	t.Run("a hidden character sees itself", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.DenyAllEngine()})
+		char := hiddenTestCharacter(t, world.CharacterDark)
+		assert.True(t, svc.CanSeeCharacter(ctx, char.ID, char))
+	})
+
+	t.Run("each hidden state needs its own action", func(t *testing.T) {
+		dark := hiddenTestCharacter(t, world.CharacterDark)
+		invisible := hiddenTestCharacter(t, world.CharacterInvisible)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(observer, world.ActionSeeInvisible, access.CharacterResource(invisible.ID.String()))
+		engine.Grant(observer, world.ActionSeeInvisible, access.CharacterResource(dark.ID.String()))
+		svc := world.NewService(world.ServiceConfig{Engine: engine})
+
+		assert.True(t, svc.CanSeeCharacter(ctx, observerID, invisible))
+		assert.False(t, svc.CanSeeCharacter(ctx, observerID, dark))
+	})
+
+	t.Run("no observer sees only visible characters", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.AllowAllEngine()})
+		chars := []*world.Character{
+			hiddenTestCharacter(t, world.CharacterVisible),
+			hiddenTestCharacter(t, world.CharacterInvisible),
+		}
+		assert.Equal(t, chars[:1], svc.VisibleCharacters(ctx, ulid.ULID{}, chars))
+	})
+}
+
+func TestWorldService_CharacterVisibleTo(t *testing.T) {
+	ctx := context.Background()
+	char := hiddenTestCharacter(t, world.CharacterDark)
+	charRepo := worldtest.NewMockCharacterRepository(t)
+	svc := world.NewService(world.ServiceConfig{CharacterRepo: charRepo, Engine: policytest.DenyAllEngine()})
+
+	charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+	visible, err := svc.CharacterVisibleTo(ctx, ulid.Make(), char.ID)
+	require.NoError(t, err)
+	assert.False(t, visible)
+
+	visible, err = svc.CharacterVisibleTo(ctx, char.ID, char.ID)
+	require.NoError(t, err)
+	assert.True(t, visible, "a character is always visible to itself")
+}
+
+func TestWorldService_FindCharacterByName(t *testing.T) {
+	ctx := context.Background()
+	dark := hiddenTestCharacter(t, world.CharacterDark)
+	charRepo := worldtest.NewMockCharacterRepository(t)
+	svc := world.NewService(world.ServiceConfig{CharacterRepo: charRepo, Engine: policytest.DenyAllEngine()})
+
+	charRepo.EXPECT().FindByName(mock.Anything, "shade").Return(dark, nil)
+	_, err := svc.FindCharacterByName(ctx, ulid.Make(), "shade")
+	errutil.AssertErrorCode(t, err, "CHARACTER_NOT_FOUND")
+	require.ErrorIs(t, err, world.ErrNotFound, "a hidden character reads as missing")
+
+	found, err := svc.FindCharacterByName(ctx, dark.ID, "shade")
+	require.NoError(t, err)
+	assert.Equal(t, dark, found)
+
+	charRepo.EXPECT().FindByName(mock.Anything, "nobody").Return(nil, world.ErrNotFound)
+	_, err = svc.FindCharacterByName(ctx, dark.ID, "nobody")
+	errutil.AssertErrorCode(t, err, "CHARACTER_NOT_FOUND")
+}
+
+func TestWorldService_SetCharacterVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("going dark updates the character and emits an envelope", func(t *testing.T) {
+		char := hiddenTestCharacter(t, world.CharacterVisible)
+		subjectID := access.CharacterSubject(char.ID.String())
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionSetVisibility, access.CharacterResource(char.ID.String()))
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{CharacterRepo: charRepo, Engine: engine}, outbox))
+
+		charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+		charRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *world.Character) bool {
+			return c.ID == char.ID && c.Visibility == world.CharacterDark && c.Version == 3
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.SetCharacterVisibility(ctx, subjectID, char.ID, world.CharacterDark))
+		assert.Equal(t, "character_visibility_changed", outbox.lastIntent.Kind)
+		var payload world.CharacterVisibilityChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.CharacterVisibilityChangePayload{CharacterID: char.ID.String(), Visibility: "dark"}, payload)
+	})
+
+	t.Run("setting the current visibility is a no-op", func(t *testing.T) {
+		char := hiddenTestCharacter(t, world.CharacterInvisible)
+		subjectID := access.CharacterSubject(char.ID.String())
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: charRepo, Engine: policytest.AllowAllEngine(),
+		}, outbox))
+		charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+
+		require.NoError(t, svc.SetCharacterVisibility(ctx, subjectID, char.ID, world.CharacterInvisible))
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("an unknown visibility is rejected before the policy check", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t), Engine: policytest.DenyAllEngine(),
+		})
+		err := svc.SetCharacterVisibility(ctx, access.CharacterSubject(ulid.Make().String()), ulid.Make(), "hidden")
+		errutil.AssertErrorCode(t, err, "CHARACTER_INVALID_VISIBILITY")
+	})
+
+	t.Run("without set_visibility the change is denied", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+		err := svc.SetCharacterVisibility(ctx, access.CharacterSubject(ulid.Make().String()), ulid.Make(), world.CharacterDark)
+		errutil.AssertErrorCode(t, err, "CHARACTER_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,330 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// IssueKind classifies a world consistency problem found by the Checker.
+type IssueKind string
+
+// Consistency issue kinds.
+const (
+	// IssueOrphanedExit is an exit whose source or destination location no
+	// longer exists.
+	IssueOrphanedExit IssueKind = "orphaned_exit"
+	// IssueDanglingContainment is an object whose location, holding character,
+	// or container object no longer exists.
+	IssueDanglingContainment IssueKind = "dangling_containment"
+	// IssueCharacterInMissingLocation is a character placed in a location that
+	// no longer exists.
+	IssueCharacterInMissingLocation IssueKind = "character_in_missing_location"
+	// IssueContainmentCycle is a loop of objects each contained in the next.
+	IssueContainmentCycle IssueKind = "containment_cycle"
+	// IssueOrphanedProperty is a property whose parent entity no longer exists.
+	IssueOrphanedProperty IssueKind = "orphaned_property"
+)
+
+// DanglingRef is one row found by a ConsistencyStore scan: the entity that
+// holds a reference, the field holding it, and the referenced ID that does
+// not resolve. For orphaned properties Field is the property's parent type.
+type DanglingRef struct {
+	ID    ulid.ULID
+	Field string
+	RefID ulid.ULID
+}
+
+// ConsistencyStore runs the read-only scans behind the Checker. Scans return
+// rows ordered by entity ID so reports are stable between runs.
+type ConsistencyStore interface {
+	// OrphanedExits returns exits whose from/to location does not exist.
+	OrphanedExits(ctx context.Context) ([]DanglingRef, error)
+	// DanglingObjects returns objects whose containment target does not exist.
+	DanglingObjects(ctx context.Context) ([]DanglingRef, error)
+	// MisplacedCharacters returns characters whose location does not exist.
+	MisplacedCharacters(ctx context.Context) ([]DanglingRef, error)
+	// ObjectContainers maps every object held by another object to its container.
+	ObjectContainers(ctx context.Context) (map[ulid.ULID]ulid.ULID, error)
+	// OrphanedProperties returns properties whose parent entity does not exist.
+	OrphanedProperties(ctx context.Context) ([]DanglingRef, error)
+	// DeleteOrphanedProperties deletes the given properties if they are still
+	// orphaned, joining the ambient transaction, and returns the number removed.
+	DeleteOrphanedProperties(ctx context.Context, ids []ulid.ULID) (int, error)
+}
+
+// Issue is a single consistency problem.
+type Issue struct {
+	Kind     IssueKind `json:"kind"`
+	EntityID ulid.ULID `json:"entity_id"`
+	Detail   string    `json:"detail"`
+	// Repair describes the safe repair for the issue.
+	Repair   string `json:"repair"`
+	Repaired bool   `json:"repaired"`
+}
+
+// CheckResult is the outcome of a consistency check.
+type CheckResult struct {
+	Issues   []Issue `json:"issues"`
+	Repaired int     `json:"repaired"`
+}
+
+// Clean reports whether the check found no issues.
+func (r *CheckResult) Clean() bool {
+	return len(r.Issues) == 0
+}
+
+// Count returns the number of issues of the given kind.
+func (r *CheckResult) Count(kind IssueKind) int {
+	n := 0
+	for i := range r.Issues {
+		if r.Issues[i].Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
+// CheckOptions controls a consistency check.
+type CheckOptions struct {
+	// Repair applies the safe repairs in a single transaction. Any failed
+	// repair rolls back all of them.
+	Repair bool
+	// FallbackLocationID receives objects and characters whose placement is
+	// broken. Required when Repair is set and such issues exist.
+	FallbackLocationID *ulid.ULID
+}
+
+// Checker scans the world for referential damage the schema cannot prevent
+// (restored backups, manual SQL, bugs) and optionally repairs it.
+//
+// Repairs are ordinary world.Service write commands issued by the system
+// subject, so each one carries its version guard and emits its outbox
+// envelope. Orphaned properties are the exception: their parent aggregate is
+// gone, so there is nothing to announce and they are deleted directly.
+type Checker struct {
+	store   ConsistencyStore
+	service *Service
+}
+
+// NewChecker creates a Checker that scans with store and repairs through
+// service.
+func NewChecker(store ConsistencyStore, service *Service) *Checker {
+	return &Checker{store: store, service: service}
+}
+
+// Check scans for consistency issues and, when opts.Repair is set, applies
+// the safe repairs. Issues are reported even when repairs fail.
+func (c *Checker) Check(ctx context.Context, opts CheckOptions) (*CheckResult, error) {
+	result, err := c.scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.Repair || result.Clean() {
+		return result, nil
+	}
+	if err := c.repair(ctx, result, opts.FallbackLocationID); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func (c *Checker) scan(ctx context.Context) (*CheckResult, error) {
+	result := &CheckResult{}
+
+	exits, err := c.store.OrphanedExits(ctx)
+	if err != nil {
+		return nil, oops.Code("WORLD_CHECK_FAILED").With("scan", IssueOrphanedExit).Wrap(err)
+	}
+	for _, ref := range exits {
+		result.Issues = append(result.Issues, Issue{
+			Kind:     IssueOrphanedExit,
+			EntityID: ref.ID,
+			Detail:   fmt.Sprintf("exit %s references missing %s %s", ref.ID, ref.Field, ref.RefID),
+			Repair:   "delete exit",
+		})
+	}
+
+	objects, err := c.store.DanglingObjects(ctx)
+	if err != nil {
+		return nil, oops.Code("WORLD_CHECK_FAILED").With("scan", IssueDanglingContainment).Wrap(err)
+	}
+	for _, ref := range objects {
+		result.Issues = append(result.Issues, Issue{
+			Kind:     IssueDanglingContainment,
+			EntityID: ref.ID,
+			Detail:   fmt.Sprintf("object %s references missing %s %s", ref.ID, ref.Field, ref.RefID),
+			Repair:   "move object to fallback location",
+		})
+	}
+
+	chars, err := c.store.MisplacedCharacters(ctx)
+	if err != nil {
+		return nil, oops.Code("WORLD_CHECK_FAILED").With("scan", IssueCharacterInMissingLocation).Wrap(err)
+	}
+	for _, ref := range chars {
+		result.Issues = append(result.Issues, Issue{
+			Kind:     IssueCharacterInMissingLocation,
+			EntityID: ref.ID,
+			Detail:   fmt.Sprintf("character %s is in missing location %s", ref.ID, ref.RefID),
+			Repair:   "move character to fallback location",
+		})
+	}
+
+	containers, err := c.store.ObjectContainers(ctx)
+	if err != nil {
+		return nil, oops.Code("WORLD_CHECK_FAILED").With("scan", IssueContainmentCycle).Wrap(err)
+	}
+	for _, cycle := range containmentCycles(containers) {
+		members := make([]string, len(cycle))
+		for i, id := range cycle {
+			members[i] = id.String()
+		}
+		result.Issues = append(result.Issues, Issue{
+			Kind:     IssueContainmentCycle,
+			EntityID: cycle[0],
+			Detail:   "objects contain each other: " + strings.Join(members, " -> "),
+			Repair:   "move object to fallback location",
+		})
+	}
+
+	props, err := c.store.OrphanedProperties(ctx)
+	if err != nil {
+		return nil, oops.Code("WORLD_CHECK_FAILED").With("scan", IssueOrphanedProperty).Wrap(err)
+	}
+	for _, ref := range props {
+		result.Issues = append(result.Issues, Issue{
+			Kind:     IssueOrphanedProperty,
+			EntityID: ref.ID,
+			Detail:   fmt.Sprintf("property %s belongs to missing %s %s", ref.ID, ref.Field, ref.RefID),
+			Repair:   "delete property",
+		})
+	}
+
+	return result, nil
+}
+
+// containmentCycles returns each object-in-object loop once, rotated so the
+// smallest ID comes first. Cycles are ordered by that ID.
+func containmentCycles(containers map[ulid.ULID]ulid.ULID) [][]ulid.ULID {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[ulid.ULID]int, len(containers))
+	var cycles [][]ulid.ULID
+
+	starts := make([]ulid.ULID, 0, len(containers))
+	for id := range containers {
+		starts = append(starts, id)
+	}
+	slices.SortFunc(starts, ulid.ULID.Compare)
+
+	for _, start := range starts {
+		var path []ulid.ULID
+		id := start
+		for {
+			if state[id] == done {
+				break
+			}
+			if state[id] == visiting {
+				cycle := slices.Clone(path[slices.Index(path, id):])
+				least := slices.Index(cycle, slices.MinFunc(cycle, ulid.ULID.Compare))
+				cycles = append(cycles, append(cycle[least:], cycle[:least]...))
+				break
+			}
+			state[id] = visiting
+			path = append(path, id)
+			next, ok := containers[id]
+			if !ok {
+				break
+			}
+			id = next
+		}
+		for _, p := range path {
+			state[p] = done
+		}
+	}
+
+	slices.SortFunc(cycles, func(a, b []ulid.ULID) int { return a[0].Compare(b[0]) })
+	return cycles
+}
+
+// repair applies every issue's repair in one transaction and marks the issues
+// repaired once it commits.
+func (c *Checker) repair(ctx context.Context, result *CheckResult, fallback *ulid.ULID) error {
+	if c.service == nil || c.service.transactor == nil {
+		return oops.Code("WORLD_CHECK_REPAIR_FAILED").Errorf("world service with a transactor is required to repair")
+	}
+	if fallback == nil && slices.ContainsFunc(result.Issues, needsFallback) {
+		return oops.Code("WORLD_CHECK_FALLBACK_REQUIRED").
+			Errorf("a fallback location is required to repair object and character placement")
+	}
+
+	ctx = access.WithSystemSubject(ctx)
+	subject := access.SubjectSystem
+	if fallback != nil {
+		if _, err := c.service.GetLocation(ctx, subject, *fallback); err != nil {
+			return oops.Code("WORLD_CHECK_FALLBACK_INVALID").With("location_id", fallback.String()).Wrap(err)
+		}
+	}
+
+	var orphanedProps []ulid.ULID
+	err := c.service.transactor.InTransaction(ctx, func(txCtx context.Context) error {
+		for i := range result.Issues {
+			issue := &result.Issues[i]
+			var err error
+			switch issue.Kind {
+			case IssueOrphanedExit:
+				err = c.service.DeleteExit(txCtx, subject, issue.EntityID)
+				if errors.Is(err, ErrNotFound) {
+					// Removed with its bidirectional partner earlier in this pass.
+					err = nil
+				}
+			case IssueDanglingContainment, IssueContainmentCycle:
+				err = c.service.MoveObject(txCtx, subject, issue.EntityID, InLocation(*fallback))
+			case IssueCharacterInMissingLocation:
+				err = c.service.MoveCharacter(txCtx, subject, issue.EntityID, *fallback)
+			case IssueOrphanedProperty:
+				orphanedProps = append(orphanedProps, issue.EntityID)
+			}
+			if err != nil {
+				return oops.With("kind", issue.Kind).With("entity_id", issue.EntityID.String()).Wrap(err)
+			}
+		}
+		if len(orphanedProps) == 0 {
+			return nil
+		}
+		_, err := c.store.DeleteOrphanedProperties(txCtx, orphanedProps)
+		return err
+	})
+	if err != nil {
+		return oops.Code("WORLD_CHECK_REPAIR_FAILED").Wrap(err)
+	}
+
+	for i := range result.Issues {
+		result.Issues[i].Repaired = true
+	}
+	result.Repaired = len(result.Issues)
+	return nil
+}
+
+func needsFallback(issue Issue) bool {
+	switch issue.Kind {
+	case IssueDanglingContainment, IssueContainmentCycle, IssueCharacterInMissingLocation:
+		return true
+	default:
+		return false
+	}
+}
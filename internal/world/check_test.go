@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeConsistencyStore serves canned scan results and records property deletes.
+type fakeConsistencyStore struct {
+	exits      []world.DanglingRef
+	objects    []world.DanglingRef
+	characters []world.DanglingRef
+	containers map[ulid.ULID]ulid.ULID
+	properties []world.DanglingRef
+	deleted    []ulid.ULID
+}
+
+func (f *fakeConsistencyStore) OrphanedExits(context.Context) ([]world.DanglingRef, error) {
+	return f.exits, nil
+}
+
+func (f *fakeConsistencyStore) DanglingObjects(context.Context) ([]world.DanglingRef, error) {
+	return f.objects, nil
+}
+
+func (f *fakeConsistencyStore) MisplacedCharacters(context.Context) ([]world.DanglingRef, error) {
+	return f.characters, nil
+}
+
+func (f *fakeConsistencyStore) ObjectContainers(context.Context) (map[ulid.ULID]ulid.ULID, error) {
+	return f.containers, nil
+}
+
+func (f *fakeConsistencyStore) OrphanedProperties(context.Context) ([]world.DanglingRef, error) {
+	return f.properties, nil
+}
+
+func (f *fakeConsistencyStore) DeleteOrphanedProperties(_ context.Context, ids []ulid.ULID) (int, error) {
+	f.deleted = append(f.deleted, ids...)
+	return len(ids), nil
+}
+
+// sortedIDs returns n ULIDs in ascending order.
+func sortedIDs(n int) []ulid.ULID {
+	ids := make([]ulid.ULID, n)
+	for i := range ids {
+		ids[i] = ulid.Make()
+	}
+	return ids
+}
+
+func TestCheckerReportsEveryIssueKind(t *testing.T) {
+	ids := sortedIDs(4)
+	a, b, c, d := ids[0], ids[1], ids[2], ids[3]
+	store := &fakeConsistencyStore{
+		exits:      []world.DanglingRef{{ID: ulid.Make(), Field: "destination location", RefID: ulid.Make()}},
+		objects:    []world.DanglingRef{{ID: ulid.Make(), Field: "container", RefID: ulid.Make()}},
+		characters: []world.DanglingRef{{ID: ulid.Make(), Field: "location", RefID: ulid.Make()}},
+		// c -> a -> b -> a is one cycle (a, b); d is nested normally in a.
+		containers: map[ulid.ULID]ulid.ULID{b: a, a: b, c: a, d: c},
+		properties: []world.DanglingRef{{ID: ulid.Make(), Field: "object", RefID: ulid.Make()}},
+	}
+
+	result, err := world.NewChecker(store, nil).Check(context.Background(), world.CheckOptions{})
+	require.NoError(t, err)
+
+	assert.False(t, result.Clean())
+	require.Len(t, result.Issues, 5)
+	for _, kind := range []world.IssueKind{
+		world.IssueOrphanedExit, world.IssueDanglingContainment, world.IssueCharacterInMissingLocation,
+		world.IssueContainmentCycle, world.IssueOrphanedProperty,
+	} {
+		assert.Equal(t, 1, result.Count(kind), kind)
+	}
+	cycle := result.Issues[3]
+	assert.Equal(t, a, cycle.EntityID, "a cycle is reported once, at its smallest member")
+	assert.Equal(t, "objects contain each other: "+a.String()+" -> "+b.String(), cycle.Detail)
+	assert.Zero(t, result.Repaired)
+	assert.Empty(t, store.deleted, "a scan without Repair changes nothing")
+}
+
+func TestCheckerCleanWorld(t *testing.T) {
+	result, err := world.NewChecker(&fakeConsistencyStore{}, nil).Check(context.Background(), world.CheckOptions{Repair: true})
+	require.NoError(t, err)
+	assert.True(t, result.Clean())
+}
+
+func TestCheckerRepairsThroughService(t *testing.T) {
+	ctx := context.Background()
+	fallback := ulid.Make()
+	exitID, propID := ulid.Make(), ulid.Make()
+	obj, err := world.NewObject("lamp", world.InContainer(ulid.Make()))
+	require.NoError(t, err)
+	char := &world.Character{ID: ulid.Make(), Name: "Wanderer", Version: 2}
+
+	store := &fakeConsistencyStore{
+		exits:      []world.DanglingRef{{ID: exitID, Field: "source location", RefID: ulid.Make()}},
+		objects:    []world.DanglingRef{{ID: obj.ID, Field: "container", RefID: ulid.Make()}},
+		characters: []world.DanglingRef{{ID: char.ID, Field: "location", RefID: ulid.Make()}},
+		properties: []world.DanglingRef{{ID: propID, Field: "location", RefID: ulid.Make()}},
+	}
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(access.SubjectSystem, "read", access.LocationResource(fallback.String()))
+	engine.Grant(access.SubjectSystem, "delete", access.ExitResource(exitID.String()))
+	engine.Grant(access.SubjectSystem, "write", access.ObjectResource(obj.ID.String()))
+	engine.Grant(access.SubjectSystem, "write", access.CharacterResource(char.ID.String()))
+
+	locRepo := worldtest.NewMockLocationRepository(t)
+	exitRepo := worldtest.NewMockExitRepository(t)
+	objRepo := worldtest.NewMockObjectRepository(t)
+	charRepo := worldtest.NewMockCharacterRepository(t)
+	outbox := &mockOutboxWriter{}
+	transactor := &mockTransactor{}
+	cfg := withWriteExecutor(world.ServiceConfig{
+		LocationRepo:  locRepo,
+		ExitRepo:      exitRepo,
+		ObjectRepo:    objRepo,
+		CharacterRepo: charRepo,
+		Engine:        engine,
+	}, outbox)
+	cfg.Transactor = transactor
+	svc := world.NewService(cfg)
+
+	locRepo.EXPECT().Get(mock.Anything, fallback).Return(&world.Location{ID: fallback, Name: "Limbo"}, nil)
+	exitRepo.EXPECT().Delete(mock.Anything, exitID, 0).Return(&wmodel.MutationDelta{}, nil).Once()
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Move(mock.Anything, obj.ID, world.InLocation(fallback), 0).Return(&wmodel.MutationDelta{}, nil).Once()
+	charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+	charRepo.EXPECT().UpdateLocation(mock.Anything, char.ID, &fallback, 2).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	result, err := world.NewChecker(store, svc).Check(ctx, world.CheckOptions{Repair: true, FallbackLocationID: &fallback})
+	require.NoError(t, err)
+
+	assert.True(t, transactor.called)
+	assert.Equal(t, 4, result.Repaired)
+	for _, issue := range result.Issues {
+		assert.True(t, issue.Repaired, issue.Kind)
+	}
+	assert.Equal(t, []ulid.ULID{propID}, store.deleted)
+	assert.Equal(t, 3, outbox.calls, "every service repair emits an envelope")
+	assert.Equal(t, access.SubjectSystem, outbox.lastIntent.Actor)
+}
+
+func TestCheckerRepairRequiresFallback(t *testing.T) {
+	store := &fakeConsistencyStore{
+		characters: []world.DanglingRef{{ID: ulid.Make(), Field: "location", RefID: ulid.Make()}},
+	}
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{Engine: policytest.NewGrantEngine()}, &mockOutboxWriter{}))
+
+	result, err := world.NewChecker(store, svc).Check(context.Background(), world.CheckOptions{Repair: true})
+	errutil.AssertErrorCode(t, err, "WORLD_CHECK_FALLBACK_REQUIRED")
+	require.NotNil(t, result, "issues are reported even when repair is refused")
+	assert.Equal(t, 1, result.Count(world.IssueCharacterInMissingLocation))
+	assert.False(t, result.Issues[0].Repaired)
+}
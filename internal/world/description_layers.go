@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// Description layer limits.
+const (
+	// MaxLayerTextLength bounds a wear description or effect text in bytes.
+	MaxLayerTextLength = 1000
+	// MaxDescriptionEffects bounds how many active effects one character
+	// may carry.
+	MaxDescriptionEffects = 16
+	// MaxEffectNameLength bounds an effect name in bytes.
+	MaxEffectNameLength = 32
+)
+
+// Description layer ABAC actions.
+const (
+	// ActionWear guards WearObject and TakeOffObject, checked on the object.
+	ActionWear = "wear"
+	// ActionApplyEffect guards ApplyDescriptionEffect and
+	// RemoveDescriptionEffect, checked on the character.
+	ActionApplyEffect = "apply_effect"
+	// ActionViewPrivateEffects lets an observer see a character's private
+	// effects, checked on the character.
+	ActionViewPrivateEffects = "view_private_effects"
+	// ActionListObjects lists the objects a character holds, checked on the
+	// character (GetObjectsHeldBy). It shares its name with the location
+	// action that lists the objects in a room.
+	ActionListObjects = "list_objects"
+)
+
+// effectNameRegex matches a lowercase letter followed by lowercase letters,
+// digits, or underscores (e.g. "glowing", "soaked_through").
+var effectNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// DescriptionLayerKind identifies where a description layer comes from.
+type DescriptionLayerKind string
+
+// Description layer kinds, in the order DescribeCharacter assembles them.
+const (
+	// LayerBase is the character's own description.
+	LayerBase DescriptionLayerKind = "base"
+	// LayerWorn is the wear description of an object the character wears.
+	LayerWorn DescriptionLayerKind = "worn"
+	// LayerEffect is a timed description effect on the character.
+	LayerEffect DescriptionLayerKind = "effect"
+)
+
+// DescriptionEffect is a temporary layer on a character's description, such
+// as "glowing" from a spell or "soaked" from the rain. A private effect is
+// shown only to observers allowed view_private_effects on the character.
+type DescriptionEffect struct {
+	Name    string `json:"name"`
+	Text    string `json:"text"`
+	Private bool   `json:"private,omitempty"`
+	// AppliedBy is the subject that applied the effect.
+	AppliedBy string    `json:"applied_by,omitempty"`
+	AppliedAt time.Time `json:"applied_at"`
+	// ExpiresAt is when the effect lapses; zero means it lasts until removed.
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// Validate checks the effect's name and text.
+func (e DescriptionEffect) Validate() error {
+	if err := ValidateEffectName(e.Name); err != nil {
+		return err
+	}
+	if e.Text == "" {
+		return &ValidationError{Field: "text", Message: "cannot be empty"}
+	}
+	return validateLayerText("text", e.Text)
+}
+
+// Active reports whether the effect has not yet expired at now.
+func (e DescriptionEffect) Active(now time.Time) bool {
+	return e.ExpiresAt.IsZero() || now.Before(e.ExpiresAt)
+}
+
+// ValidateEffectName checks that name is a well-formed effect name.
+func ValidateEffectName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "effect", Message: "cannot be empty"}
+	}
+	if len(name) > MaxEffectNameLength {
+		return &ValidationError{Field: "effect", Message: fmt.Sprintf("exceeds maximum length of %d", MaxEffectNameLength)}
+	}
+	if !effectNameRegex.MatchString(name) {
+		return &ValidationError{Field: "effect", Message: "must be a lowercase letter followed by letters, digits, or underscores"}
+	}
+	return nil
+}
+
+// ValidateWearDescription checks an object's wear description. It may be
+// empty: a worn object without one adds nothing to its wearer.
+func ValidateWearDescription(text string) error {
+	return validateLayerText("wear_description", text)
+}
+
+func validateLayerText(field, text string) error {
+	if !utf8.ValidString(text) {
+		return &ValidationError{Field: field, Message: "must be valid UTF-8"}
+	}
+	if len(text) > MaxLayerTextLength {
+		return &ValidationError{Field: field, Message: fmt.Sprintf("exceeds maximum length of %d", MaxLayerTextLength)}
+	}
+	if hasControlCharsExceptWhitespace(text) {
+		return &ValidationError{Field: field, Message: "cannot contain control characters (except newline/tab)"}
+	}
+	return nil
+}
+
+// ActiveEffects returns the character's effects that have not expired at
+// now, oldest first.
+func (c *Character) ActiveEffects(now time.Time) []DescriptionEffect {
+	active := make([]DescriptionEffect, 0, len(c.Effects))
+	for _, e := range c.Effects {
+		if e.Active(now) {
+			active = append(active, e)
+		}
+	}
+	slices.SortStableFunc(active, func(a, b DescriptionEffect) int {
+		return cmp.Or(a.AppliedAt.Compare(b.AppliedAt), strings.Compare(a.Name, b.Name))
+	})
+	return active
+}
+
+// DescriptionLayer is one part of a character's assembled description.
+type DescriptionLayer struct {
+	Kind DescriptionLayerKind
+	// Source names where the layer comes from: the worn object's name or the
+	// effect's name. Empty for the base layer.
+	Source string
+	// SourceID is the worn object's ID; zero for other layers.
+	SourceID ulid.ULID
+	Text     string
+	// Private marks an effect only some observers can see.
+	Private bool
+	// ExpiresAt is when an effect layer lapses; zero when it does not.
+	ExpiresAt time.Time
+}
+
+// CharacterDescription is a character's description as one observer sees
+// it: the base description, then worn objects in the order they were put
+// on, then active effects in the order they were applied.
+type CharacterDescription struct {
+	CharacterID ulid.ULID
+	Name        string
+	Layers      []DescriptionLayer
+}
+
+// Text joins the layers into the text shown to the observer, one layer per
+// line.
+func (d *CharacterDescription) Text() string {
+	texts := make([]string, 0, len(d.Layers))
+	for _, l := range d.Layers {
+		texts = append(texts, l.Text)
+	}
+	return strings.Join(texts, "\n")
+}
+
+// Effects returns the description's effect layers.
+func (d *CharacterDescription) Effects() []DescriptionLayer {
+	var effects []DescriptionLayer
+	for _, l := range d.Layers {
+		if l.Kind == LayerEffect {
+			effects = append(effects, l)
+		}
+	}
+	return effects
+}
+
+// DescribeCharacter assembles a character's layered description for
+// subjectID. Reading the character requires "read" on it. Each worn object
+// contributes its wear description only when subjectID may read the object,
+// and private effects appear only with view_private_effects on the
+// character; layers the observer may not see are left out silently, while
+// evaluation failures abort the call rather than show a partial
+// description. Entity references in layer text render as the names
+// subjectID sees (RenderTextRefs).
+func (s *Service) DescribeCharacter(ctx context.Context, subjectID string, characterID ulid.ULID) (*CharacterDescription, error) {
+	char, err := s.GetCharacter(ctx, subjectID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	desc := &CharacterDescription{CharacterID: char.ID, Name: char.Name}
+	if char.Description != "" {
+		desc.Layers = append(desc.Layers, DescriptionLayer{Kind: LayerBase, Text: char.Description})
+	}
+
+	worn, err := s.wornLayers(ctx, subjectID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	desc.Layers = append(desc.Layers, worn...)
+
+	effects, err := s.effectLayers(ctx, subjectID, char)
+	if err != nil {
+		return nil, err
+	}
+	desc.Layers = append(desc.Layers, effects...)
+
+	for i := range desc.Layers {
+		text, err := s.RenderTextRefs(ctx, subjectID, desc.Layers[i].Text)
+		if err != nil {
+			return nil, err
+		}
+		desc.Layers[i].Text = text
+	}
+	return desc, nil
+}
+
+// wornLayers returns the wear descriptions of the objects characterID wears
+// that subjectID may read, in the order they were put on.
+func (s *Service) wornLayers(ctx context.Context, subjectID string, characterID ulid.ULID) ([]DescriptionLayer, error) {
+	if s.objectRepo == nil {
+		return nil, oops.Code("CHARACTER_DESCRIBE_FAILED").Errorf("object repository not configured")
+	}
+	held, err := s.objectRepo.ListHeldBy(ctx, characterID)
+	if err != nil {
+		return nil, oops.Code("CHARACTER_DESCRIBE_FAILED").Wrapf(err, "list objects held by %s", characterID)
+	}
+	worn := slices.DeleteFunc(held, func(o *Object) bool { return !o.Worn() || o.WearDescription == "" })
+	slices.SortFunc(worn, func(a, b *Object) int {
+		return cmp.Or(a.WornAt.Compare(b.WornAt), a.ID.Compare(b.ID))
+	})
+	layers := make([]DescriptionLayer, 0, len(worn))
+	for _, obj := range worn {
+		err := s.checkAccess(ctx, subjectID, "read", access.ObjectResource(obj.ID.String()), prefixObject)
+		if errors.Is(err, ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, DescriptionLayer{
+			Kind: LayerWorn, Source: obj.Name, SourceID: obj.ID, Text: obj.WearDescription,
+		})
+	}
+	return layers, nil
+}
+
+// effectLayers returns char's active effects that subjectID may see. The
+// private-effects check runs at most once, and only when there is a private
+// effect to show.
+func (s *Service) effectLayers(ctx context.Context, subjectID string, char *Character) ([]DescriptionLayer, error) {
+	active := char.ActiveEffects(time.Now())
+	var seePrivate *bool
+	layers := make([]DescriptionLayer, 0, len(active))
+	for _, e := range active {
+		if e.Private {
+			if seePrivate == nil {
+				err := s.checkAccess(ctx, subjectID, ActionViewPrivateEffects, access.CharacterResource(char.ID.String()), prefixCharacter)
+				if err != nil && !errors.Is(err, ErrPermissionDenied) {
+					return nil, err
+				}
+				allowed := err == nil
+				seePrivate = &allowed
+			}
+			if !*seePrivate {
+				continue
+			}
+		}
+		layers = append(layers, DescriptionLayer{
+			Kind: LayerEffect, Source: e.Name, Text: e.Text, Private: e.Private, ExpiresAt: e.ExpiresAt,
+		})
+	}
+	return layers, nil
+}
+
+// GetObjectsHeldBy returns the objects a character is holding, worn or not,
+// after checking list_objects on the character.
+func (s *Service) GetObjectsHeldBy(ctx context.Context, subjectID string, characterID ulid.ULID) ([]*Object, error) {
+	if s.objectRepo == nil {
+		return nil, oops.Code("OBJECT_QUERY_FAILED").Errorf("object repository not configured")
+	}
+	resource := access.CharacterResource(characterID.String())
+	if err := s.checkAccess(ctx, subjectID, ActionListObjects, resource, prefixCharacter); err != nil {
+		return nil, err
+	}
+	objs, err := s.objectRepo.ListHeldBy(ctx, characterID)
+	if err != nil {
+		return nil, oops.Code("OBJECT_QUERY_FAILED").Wrapf(err, "get objects held by %s", characterID)
+	}
+	return objs, nil
+}
@@ -0,0 +1,392 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func wornTestObject(t *testing.T, name string, holderID ulid.ULID, wornAt time.Time) *world.Object {
+	t.Helper()
+	obj, err := world.NewObject(name, world.HeldBy(holderID))
+	require.NoError(t, err)
+	obj.WearDescription = "A " + strings.ToLower(name) + " is worn."
+	obj.WornAt = wornAt
+	obj.Version = 2
+	return obj
+}
+
+func TestDescriptionEffectValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		effect world.DescriptionEffect
+		field  string
+	}{
+		{"valid", world.DescriptionEffect{Name: "glowing", Text: "A faint glow surrounds them."}, ""},
+		{"underscored name", world.DescriptionEffect{Name: "soaked_through", Text: "Dripping wet."}, ""},
+		{"empty name", world.DescriptionEffect{Text: "Dripping wet."}, "effect"},
+		{"capitalised name", world.DescriptionEffect{Name: "Glowing", Text: "Glowing."}, "effect"},
+		{"long name", world.DescriptionEffect{Name: strings.Repeat("g", world.MaxEffectNameLength+1), Text: "Glowing."}, "effect"},
+		{"empty text", world.DescriptionEffect{Name: "glowing"}, "text"},
+		{"long text", world.DescriptionEffect{Name: "glowing", Text: strings.Repeat("g", world.MaxLayerTextLength+1)}, "text"},
+		{"control characters", world.DescriptionEffect{Name: "glowing", Text: "glow\x07"}, "text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.effect.Validate()
+			if tt.field == "" {
+				require.NoError(t, err)
+				return
+			}
+			var verr *world.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestCharacterActiveEffects(t *testing.T) {
+	now := time.Now()
+	char := &world.Character{Effects: []world.DescriptionEffect{
+		{Name: "soaked", Text: "Dripping wet.", AppliedAt: now.Add(-time.Minute)},
+		{Name: "expired", Text: "Gone.", AppliedAt: now.Add(-time.Hour), ExpiresAt: now.Add(-time.Second)},
+		{Name: "glowing", Text: "Glowing.", AppliedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+	}}
+
+	active := char.ActiveEffects(now)
+	require.Len(t, active, 2)
+	assert.Equal(t, "glowing", active[0].Name, "oldest effect first")
+	assert.Equal(t, "soaked", active[1].Name)
+}
+
+func TestObjectSetContainmentClearsWorn(t *testing.T) {
+	holderID := ulid.Make()
+	obj := wornTestObject(t, "Cloak", holderID, time.Now())
+	require.True(t, obj.Worn())
+
+	require.NoError(t, obj.SetContainment(world.HeldBy(holderID)))
+	assert.True(t, obj.Worn(), "staying with the same holder keeps the object worn")
+
+	require.NoError(t, obj.SetContainment(world.InLocation(ulid.Make())))
+	assert.False(t, obj.Worn())
+	assert.True(t, obj.WornAt.IsZero())
+}
+
+func TestWorldService_DescribeCharacter(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	viewerID := ulid.Make()
+	viewer := access.CharacterSubject(viewerID.String())
+	charResource := access.CharacterResource(charID.String())
+	now := time.Now()
+
+	newService := func(t *testing.T, engine *policytest.GrantEngine, char *world.Character, held []*world.Object) *world.Service {
+		t.Helper()
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(char, nil).Once()
+		objRepo := worldtest.NewMockObjectRepository(t)
+		objRepo.EXPECT().ListHeldBy(mock.Anything, charID).Return(held, nil).Once()
+		return world.NewService(world.ServiceConfig{CharacterRepo: charRepo, ObjectRepo: objRepo, Engine: engine})
+	}
+	testCharacter := func() *world.Character {
+		return &world.Character{
+			ID: charID, Name: "Alaric", Description: "A tall figure.",
+			Effects: []world.DescriptionEffect{
+				{Name: "scarred", Text: "A hidden scar.", Private: true, AppliedAt: now.Add(-2 * time.Hour)},
+				{Name: "soaked", Text: "Dripping wet.", AppliedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour)},
+				{Name: "expired", Text: "Long gone.", AppliedAt: now.Add(-time.Hour), ExpiresAt: now.Add(-time.Minute)},
+			},
+		}
+	}
+
+	t.Run("assembles base, worn, and effect layers in order", func(t *testing.T) {
+		cloak := wornTestObject(t, "Cloak", charID, now.Add(-time.Hour))
+		boots := wornTestObject(t, "Boots", charID, now.Add(-2*time.Hour))
+		pack, err := world.NewObject("Pack", world.HeldBy(charID))
+		require.NoError(t, err)
+		pack.WearDescription = "A pack, carried but not worn."
+
+		engine := policytest.NewGrantEngine()
+		engine.Grant(viewer, "read", charResource)
+		engine.Grant(viewer, "read", access.ObjectResource(cloak.ID.String()))
+		engine.Grant(viewer, "read", access.ObjectResource(boots.ID.String()))
+		engine.Grant(viewer, world.ActionViewPrivateEffects, charResource)
+		svc := newService(t, engine, testCharacter(), []*world.Object{cloak, pack, boots})
+
+		desc, err := svc.DescribeCharacter(ctx, viewer, charID)
+		require.NoError(t, err)
+		kinds := make([]world.DescriptionLayerKind, len(desc.Layers))
+		sources := make([]string, len(desc.Layers))
+		for i, l := range desc.Layers {
+			kinds[i] = l.Kind
+			sources[i] = l.Source
+		}
+		assert.Equal(t, []world.DescriptionLayerKind{
+			world.LayerBase, world.LayerWorn, world.LayerWorn, world.LayerEffect, world.LayerEffect,
+		}, kinds)
+		assert.Equal(t, []string{"", "Boots", "Cloak", "scarred", "soaked"}, sources)
+		assert.Equal(t, "A tall figure.\nA boots is worn.\nA cloak is worn.\nA hidden scar.\nDripping wet.", desc.Text())
+		assert.Len(t, desc.Effects(), 2)
+	})
+
+	t.Run("leaves out worn objects and private effects the observer may not see", func(t *testing.T) {
+		cloak := wornTestObject(t, "Cloak", charID, now.Add(-time.Hour))
+		ring := wornTestObject(t, "Ring", charID, now.Add(-time.Hour))
+
+		engine := policytest.NewGrantEngine()
+		engine.Grant(viewer, "read", charResource)
+		engine.Grant(viewer, "read", access.ObjectResource(cloak.ID.String()))
+		svc := newService(t, engine, testCharacter(), []*world.Object{cloak, ring})
+
+		desc, err := svc.DescribeCharacter(ctx, viewer, charID)
+		require.NoError(t, err)
+		assert.Equal(t, "A tall figure.\nA cloak is worn.\nDripping wet.", desc.Text())
+	})
+
+	t.Run("without read on the character the description is denied", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t),
+			ObjectRepo:    worldtest.NewMockObjectRepository(t),
+			Engine:        policytest.NewGrantEngine(),
+		})
+
+		_, err := svc.DescribeCharacter(ctx, viewer, charID)
+		errutil.AssertErrorCode(t, err, "CHARACTER_ACCESS_DENIED")
+	})
+}
+
+func TestWorldService_WearObject(t *testing.T) {
+	ctx := context.Background()
+	holderID := ulid.Make()
+	subjectID := access.CharacterSubject(holderID.String())
+
+	t.Run("wearing a held object stamps it and emits object_worn", func(t *testing.T) {
+		obj := wornTestObject(t, "Cloak", holderID, time.Time{})
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionWear, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return o.ID == obj.ID && o.Worn()
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.WearObject(ctx, subjectID, obj.ID))
+		assert.Equal(t, "object_worn", outbox.lastIntent.Kind)
+		var payload world.ObjectWearChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.ObjectWearChangePayload{ObjectID: obj.ID.String(), CharacterID: holderID.String()}, payload)
+	})
+
+	t.Run("wearing an object already worn is a no-op", func(t *testing.T) {
+		obj := wornTestObject(t, "Cloak", holderID, time.Now())
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionWear, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		require.NoError(t, svc.WearObject(ctx, subjectID, obj.ID))
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("an object on the ground cannot be worn", func(t *testing.T) {
+		obj := ownedTestObject(t, holderID, false)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionWear, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		err := svc.WearObject(ctx, subjectID, obj.ID)
+		errutil.AssertErrorCode(t, err, "OBJECT_NOT_HELD")
+	})
+
+	t.Run("without wear the object cannot be put on", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: worldtest.NewMockObjectRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.WearObject(ctx, subjectID, ulid.Make())
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_DENIED")
+	})
+}
+
+func TestWorldService_TakeOffObject(t *testing.T) {
+	ctx := context.Background()
+	holderID := ulid.Make()
+	subjectID := access.CharacterSubject(holderID.String())
+
+	t.Run("taking off clears the worn stamp and emits object_taken_off", func(t *testing.T) {
+		obj := wornTestObject(t, "Cloak", holderID, time.Now())
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionWear, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return !o.Worn() && o.HeldByCharacterID() != nil
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.TakeOffObject(ctx, subjectID, obj.ID))
+		assert.Equal(t, "object_taken_off", outbox.lastIntent.Kind)
+	})
+
+	t.Run("taking off an object not worn is OBJECT_NOT_WORN", func(t *testing.T) {
+		obj := wornTestObject(t, "Cloak", holderID, time.Time{})
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionWear, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		err := svc.TakeOffObject(ctx, subjectID, obj.ID)
+		errutil.AssertErrorCode(t, err, "OBJECT_NOT_WORN")
+	})
+}
+
+func TestWorldService_ApplyDescriptionEffect(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	gmID := ulid.Make()
+	subjectID := access.CharacterSubject(gmID.String())
+	glowing := world.DescriptionEffect{Name: "glowing", Text: "A faint glow surrounds them.", ExpiresAt: time.Now().Add(time.Hour)}
+
+	newService := func(t *testing.T, char *world.Character) (*world.Service, *worldtest.MockCharacterRepository, *mockOutboxWriter) {
+		t.Helper()
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionApplyEffect, access.CharacterResource(charID.String()))
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(char, nil).Once()
+		outbox := &mockOutboxWriter{}
+		return world.NewService(withWriteExecutor(world.ServiceConfig{CharacterRepo: charRepo, Engine: engine}, outbox)), charRepo, outbox
+	}
+
+	t.Run("applies the effect, prunes expired ones, and emits character_effect_applied", func(t *testing.T) {
+		char := &world.Character{ID: charID, Name: "Alaric", Version: 3, Effects: []world.DescriptionEffect{
+			{Name: "faded", Text: "Faded.", ExpiresAt: time.Now().Add(-time.Minute)},
+		}}
+		svc, charRepo, outbox := newService(t, char)
+		charRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *world.Character) bool {
+			return len(c.Effects) == 1 && c.Effects[0].Name == "glowing" &&
+				c.Effects[0].AppliedBy == subjectID && !c.Effects[0].AppliedAt.IsZero()
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.ApplyDescriptionEffect(ctx, subjectID, charID, glowing))
+		assert.Equal(t, "character_effect_applied", outbox.lastIntent.Kind)
+		var payload world.CharacterEffectChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, charID.String(), payload.CharacterID)
+		assert.Equal(t, "glowing", payload.Effect)
+		assert.NotEmpty(t, payload.ExpiresAt)
+		assert.NotContains(t, string(outbox.lastIntent.Payload), glowing.Text, "the effect text stays out of the envelope")
+	})
+
+	t.Run("reapplying an effect replaces it", func(t *testing.T) {
+		char := &world.Character{ID: charID, Name: "Alaric", Effects: []world.DescriptionEffect{
+			{Name: "glowing", Text: "A bright glow."},
+			{Name: "soaked", Text: "Dripping wet."},
+		}}
+		svc, charRepo, _ := newService(t, char)
+		charRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *world.Character) bool {
+			return len(c.Effects) == 2 && c.Effects[1].Name == "glowing" && c.Effects[1].Text == glowing.Text
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.ApplyDescriptionEffect(ctx, subjectID, charID, glowing))
+	})
+
+	t.Run("a character carries at most MaxDescriptionEffects effects", func(t *testing.T) {
+		char := &world.Character{ID: charID, Name: "Alaric"}
+		for i := range world.MaxDescriptionEffects {
+			char.Effects = append(char.Effects, world.DescriptionEffect{Name: "effect" + strings.Repeat("x", i), Text: "An effect."})
+		}
+		svc, _, _ := newService(t, char)
+
+		err := svc.ApplyDescriptionEffect(ctx, subjectID, charID, glowing)
+		errutil.AssertErrorCode(t, err, "DESCRIPTION_EFFECT_LIMIT")
+	})
+
+	t.Run("an effect that has already expired is rejected", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+		expired := glowing
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+
+		err := svc.ApplyDescriptionEffect(ctx, subjectID, charID, expired)
+		errutil.AssertErrorCode(t, err, "DESCRIPTION_EFFECT_INVALID")
+	})
+
+	t.Run("without apply_effect the effect is denied", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.ApplyDescriptionEffect(ctx, subjectID, charID, glowing)
+		errutil.AssertErrorCode(t, err, "CHARACTER_ACCESS_DENIED")
+	})
+}
+
+func TestWorldService_RemoveDescriptionEffect(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	subjectID := access.CharacterSubject(charID.String())
+
+	newService := func(t *testing.T, char *world.Character) (*world.Service, *worldtest.MockCharacterRepository, *mockOutboxWriter) {
+		t.Helper()
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionApplyEffect, access.CharacterResource(charID.String()))
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(char, nil).Once()
+		outbox := &mockOutboxWriter{}
+		return world.NewService(withWriteExecutor(world.ServiceConfig{CharacterRepo: charRepo, Engine: engine}, outbox)), charRepo, outbox
+	}
+
+	t.Run("removes the effect and emits character_effect_removed", func(t *testing.T) {
+		char := &world.Character{ID: charID, Name: "Alaric", Effects: []world.DescriptionEffect{
+			{Name: "glowing", Text: "Glowing.", Private: true},
+		}}
+		svc, charRepo, outbox := newService(t, char)
+		charRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *world.Character) bool {
+			return len(c.Effects) == 0
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.RemoveDescriptionEffect(ctx, subjectID, charID, "glowing"))
+		assert.Equal(t, "character_effect_removed", outbox.lastIntent.Kind)
+		var payload world.CharacterEffectChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.True(t, payload.Private)
+	})
+
+	t.Run("an expired effect is no longer there to remove", func(t *testing.T) {
+		char := &world.Character{ID: charID, Name: "Alaric", Effects: []world.DescriptionEffect{
+			{Name: "glowing", Text: "Glowing.", ExpiresAt: time.Now().Add(-time.Second)},
+		}}
+		svc, _, _ := newService(t, char)
+
+		err := svc.RemoveDescriptionEffect(ctx, subjectID, charID, "glowing")
+		errutil.AssertErrorCode(t, err, "DESCRIPTION_EFFECT_NOT_FOUND")
+	})
+}
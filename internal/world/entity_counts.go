@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+// EntityCounts is a point-in-time tally of world entities for operational
+// views. Archived locations are excluded.
+type EntityCounts struct {
+	Locations  int64 `json:"locations"`
+	Scenes     int64 `json:"scenes"`
+	Instances  int64 `json:"instances"`
+	Exits      int64 `json:"exits"`
+	Objects    int64 `json:"objects"`
+	Characters int64 `json:"characters"`
+}
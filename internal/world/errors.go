@@ -36,9 +36,65 @@ var ErrFeedLockTimeout = errors.New("world feed counter lock timeout")
 // FOR UPDATE lock acquisition times out. Asserted with errutil.AssertErrorCode.
 const CodeFeedLockTimeout = "WORLD_FEED_LOCK_TIMEOUT"
 
+// ErrObjectLocked is returned (with ErrPermissionDenied) when a write, move, or
+// delete is refused because the object is locked by its owner. Stamped with
+// CodeObjectLocked.
+var ErrObjectLocked = errors.New("object is locked")
+
+// CodeObjectLocked is the oops code for a write refused by an object lock.
+const CodeObjectLocked = "OBJECT_LOCKED"
+
+// ErrCircularContainment is returned when a move would place an object
+// inside itself, directly or through the containers nested in it. Stamped
+// with CodeCircularContainment.
+var ErrCircularContainment = errors.New("circular containment")
+
+// CodeCircularContainment is the oops code for a move refused because it
+// would create a containment cycle.
+const CodeCircularContainment = "CIRCULAR_CONTAINMENT"
+
+// ErrNestingDepthExceeded is returned when a move would nest objects deeper
+// than the repository's maximum nesting depth. Stamped with
+// CodeNestingDepthExceeded.
+var ErrNestingDepthExceeded = errors.New("max nesting depth exceeded")
+
+// CodeNestingDepthExceeded is the oops code for a move refused by the
+// nesting depth limit.
+const CodeNestingDepthExceeded = "NESTING_DEPTH_EXCEEDED"
+
 // ErrSelfReferentialExit is returned when an exit's from and to locations are the same.
 var ErrSelfReferentialExit = errors.New("self-referential exit: from and to locations cannot be the same")
 
+// ErrExitNameConflict is returned when an exit's name or one of its aliases
+// matches, case-insensitively, the name or an alias of another exit leaving the
+// same location. Two exits answering to "north" would make traversal
+// ambiguous. The error is carried by *ExitNameConflictError, which names the
+// conflicting exit, and stamped with CodeExitNameConflict.
+var ErrExitNameConflict = errors.New("exit name conflict")
+
+// CodeExitNameConflict is the oops code for an exit name or alias conflict.
+const CodeExitNameConflict = "EXIT_NAME_CONFLICT"
+
+// ExitNameConflictError reports the existing exit a create or update collided
+// with. It unwraps to ErrExitNameConflict.
+type ExitNameConflictError struct {
+	LocationID          ulid.ULID // the location both exits leave from
+	Name                string    // the name or alias that collided
+	ConflictingExitID   ulid.ULID
+	ConflictingExitName string
+}
+
+// Error implements the error interface.
+func (e *ExitNameConflictError) Error() string {
+	return fmt.Sprintf("exit name %q is already used by exit %q (%s) at location %s",
+		e.Name, e.ConflictingExitName, e.ConflictingExitID, e.LocationID)
+}
+
+// Unwrap returns ErrExitNameConflict.
+func (e *ExitNameConflictError) Unwrap() error {
+	return ErrExitNameConflict
+}
+
 // CleanupIssueType identifies the type of issue during bidirectional exit cleanup.
 type CleanupIssueType string
 
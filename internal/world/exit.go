@@ -72,6 +72,19 @@ func (l LockType) String() string {
 	return string(l)
 }
 
+// Lock data keys of a LockTypeKey lock. LockDataKeyID holds the ULID of the
+// key object as a string; LockDataConsume, when true, makes the key a
+// single-use one that is destroyed as the character walks through.
+const (
+	LockDataKeyID   = "key_id"
+	LockDataConsume = "consume"
+)
+
+// MaxKeyContainerDepth is how many containers deep a key may sit in a
+// character's inventory and still open a key lock: 0 would accept only a
+// key held directly, 1 a key in a bag the character holds, and so on.
+const MaxKeyContainerDepth = 3
+
 // ErrInvalidLockType indicates an unrecognized lock type.
 var ErrInvalidLockType = errors.New("invalid lock type")
 
@@ -99,6 +112,12 @@ type Exit struct {
 	Locked         bool
 	LockType       LockType
 	LockData       map[string]any
+	// TraversalDelay is how long walking through the exit takes; zero moves
+	// the character at once. TraversalCost is an abstract charge, such as
+	// stamina, collected by the game's traversal cost hook when the walk
+	// begins. Both are enforced by traversal.Service.
+	TraversalDelay time.Duration
+	TraversalCost  int
 	CreatedAt      time.Time
 	// Version is the optimistic-concurrency version (MODEL-03). It carries the
 	// read version back into a guarded CAS write (... WHERE id=$1 AND version=$2)
@@ -168,7 +187,7 @@ func (e *Exit) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return ValidateTraversal(e.TraversalDelay, e.TraversalCost)
 }
 
 // SetLocked atomically updates the exit's lock state with validation.
@@ -212,6 +231,22 @@ func (e *Exit) SetLocked(locked bool, lockType LockType, lockData map[string]any
 	return nil
 }
 
+// KeyLock returns the key object of the exit's key lock and whether using
+// the key consumes it. ok is false when the exit is not locked with a key or
+// its lock data does not name a key object.
+func (e *Exit) KeyLock() (keyID ulid.ULID, consume, ok bool) {
+	if !e.Locked || e.LockType != LockTypeKey {
+		return ulid.ULID{}, false, false
+	}
+	raw, _ := e.LockData[LockDataKeyID].(string)
+	keyID, err := ulid.Parse(raw)
+	if err != nil {
+		return ulid.ULID{}, false, false
+	}
+	consume, _ = e.LockData[LockDataConsume].(bool)
+	return keyID, consume, true
+}
+
 // MatchesName returns true if the given input matches the exit name or any alias.
 // Matching is case-insensitive.
 func (e *Exit) MatchesName(input string) bool {
@@ -226,23 +261,68 @@ func (e *Exit) MatchesName(input string) bool {
 	return false
 }
 
-// IsVisibleTo returns true if the given character can see this exit.
-// locationOwnerID is the owner of the location this exit is in (for VisibilityOwner).
+// ConflictingName returns the first of e's name and aliases that other also
+// answers to, compared case-insensitively as MatchesName does. ok is false when
+// the two exits share no name.
+func (e *Exit) ConflictingName(other *Exit) (name string, ok bool) {
+	if other.MatchesName(e.Name) {
+		return e.Name, true
+	}
+	for _, alias := range e.Aliases {
+		if other.MatchesName(alias) {
+			return alias, true
+		}
+	}
+	return "", false
+}
+
+// ExitObserver is the viewpoint exit visibility is evaluated from.
+// CharacterID is the observing character; the zero ULID denotes an observer
+// with no character (e.g. a system query), which sees only VisibilityAll
+// exits. LocationOwnerID is the owner of the location the exits leave from,
+// nil when the location is unowned.
+type ExitObserver struct {
+	CharacterID     ulid.ULID
+	LocationOwnerID *ulid.ULID
+}
+
+// VisibleToSubject reports whether obs can see this exit. It is the single
+// evaluation point for Visibility semantics; callers listing exits for a
+// character use FilterVisibleExits or Service.GetVisibleExits rather than
+// re-implementing the switch.
 // Note: Unknown visibility values default to not visible (fail-closed for security).
-func (e *Exit) IsVisibleTo(charID ulid.ULID, locationOwnerID *ulid.ULID) bool {
+func (e *Exit) VisibleToSubject(obs ExitObserver) bool {
 	switch e.Visibility {
 	case VisibilityAll:
 		return true
 	case VisibilityOwner:
-		return locationOwnerID != nil && *locationOwnerID == charID
+		return !obs.CharacterID.IsZero() && obs.LocationOwnerID != nil && *obs.LocationOwnerID == obs.CharacterID
 	case VisibilityList:
-		return slices.Contains(e.VisibleTo, charID)
+		return !obs.CharacterID.IsZero() && slices.Contains(e.VisibleTo, obs.CharacterID)
 	default:
 		// Security: Unknown visibility should deny access, not grant it
 		return false
 	}
 }
 
+// IsVisibleTo returns true if the given character can see this exit.
+// locationOwnerID is the owner of the location this exit is in (for VisibilityOwner).
+func (e *Exit) IsVisibleTo(charID ulid.ULID, locationOwnerID *ulid.ULID) bool {
+	return e.VisibleToSubject(ExitObserver{CharacterID: charID, LocationOwnerID: locationOwnerID})
+}
+
+// FilterVisibleExits returns the exits obs can see, preserving order. The
+// input slice is not modified.
+func FilterVisibleExits(exits []*Exit, obs ExitObserver) []*Exit {
+	visible := make([]*Exit, 0, len(exits))
+	for _, e := range exits {
+		if e.VisibleToSubject(obs) {
+			visible = append(visible, e)
+		}
+	}
+	return visible
+}
+
 // ReverseExit creates the return exit for a bidirectional exit.
 // Returns (nil, nil) if not bidirectional or no return name is set.
 // Returns (nil, error) if LockData cannot be deep copied (e.g., non-serializable types).
@@ -271,6 +351,8 @@ func (e *Exit) ReverseExit() (*Exit, error) {
 		Locked:         e.Locked,
 		LockType:       e.LockType,
 		LockData:       lockData,
+		TraversalDelay: e.TraversalDelay,
+		TraversalCost:  e.TraversalCost,
 	}, nil
 }
 
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// DanglingReason says why a bidirectional exit's return exit is not linked.
+type DanglingReason string
+
+// Dangling exit reasons.
+const (
+	// DanglingReturnMissing means no exit at the destination answers to the
+	// return name.
+	DanglingReturnMissing DanglingReason = "return_missing"
+	// DanglingReturnMisrouted means an exit at the destination answers to
+	// the return name but leads somewhere other than the origin.
+	DanglingReturnMisrouted DanglingReason = "return_misrouted"
+)
+
+// DanglingExit is one ListDanglingExits finding.
+type DanglingExit struct {
+	Exit   *Exit
+	Reason DanglingReason
+	// ReturnExitID is the exit answering to the return name when Reason is
+	// DanglingReturnMisrouted; zero otherwise.
+	ReturnExitID ulid.ULID
+}
+
+// ListDanglingExits reports the bidirectional exits whose return exit is
+// missing or leads elsewhere, ordered by exit ID. Exits the subject may not
+// read are left out.
+//
+// The exit repository keeps pairs linked when one side is updated, and the
+// foreign keys remove both sides when either location is deleted, so
+// findings come from pairs broken before that rule existed, a side made
+// one-way and then moved, or rows written outside the service.
+func (s *Service) ListDanglingExits(ctx context.Context, subjectID string) ([]DanglingExit, error) {
+	if s.exitRepo == nil {
+		return nil, oops.Code("EXIT_LIST_FAILED").Errorf("exit repository not configured")
+	}
+	exits, err := s.exitRepo.ListDanglingExits(ctx)
+	if err != nil {
+		return nil, oops.Code("EXIT_LIST_FAILED").Wrapf(err, "list dangling exits")
+	}
+	findings := make([]DanglingExit, 0, len(exits))
+	for _, exit := range exits {
+		ok, err := s.canRead(ctx, subjectID, access.ExitResource(exit.ID.String()), prefixExit)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		finding := DanglingExit{Exit: exit, Reason: DanglingReturnMissing}
+		other, err := s.exitRepo.FindByName(ctx, exit.ToLocationID, exit.ReturnName)
+		switch {
+		case err == nil:
+			finding.Reason = DanglingReturnMisrouted
+			finding.ReturnExitID = other.ID
+		case !errors.Is(err, ErrNotFound):
+			return nil, oops.Code("EXIT_LIST_FAILED").Wrapf(err, "find return exit for %s", exit.ID)
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func danglingTestExit(t *testing.T, name, returnName string) *world.Exit {
+	t.Helper()
+	return &world.Exit{
+		ID:             ulid.Make(),
+		FromLocationID: ulid.Make(),
+		ToLocationID:   ulid.Make(),
+		Name:           name,
+		Bidirectional:  true,
+		ReturnName:     returnName,
+		Visibility:     world.VisibilityAll,
+	}
+}
+
+func TestWorldService_ListDanglingExits(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	t.Run("classifies missing and misrouted return exits", func(t *testing.T) {
+		missing := danglingTestExit(t, "north", "south")
+		misrouted := danglingTestExit(t, "east", "west")
+		elsewhere := ulid.Make()
+		exitRepo := worldtest.NewMockExitRepository(t)
+		exitRepo.EXPECT().ListDanglingExits(mock.Anything).Return([]*world.Exit{missing, misrouted}, nil).Once()
+		exitRepo.EXPECT().FindByName(mock.Anything, missing.ToLocationID, "south").
+			Return(nil, oops.Code("EXIT_NOT_FOUND").Wrap(world.ErrNotFound)).Once()
+		exitRepo.EXPECT().FindByName(mock.Anything, misrouted.ToLocationID, "west").
+			Return(&world.Exit{ID: elsewhere}, nil).Once()
+		svc := world.NewService(world.ServiceConfig{ExitRepo: exitRepo, Engine: policytest.AllowAllEngine()})
+
+		got, err := svc.ListDanglingExits(ctx, subjectID)
+		require.NoError(t, err)
+		assert.Equal(t, []world.DanglingExit{
+			{Exit: missing, Reason: world.DanglingReturnMissing},
+			{Exit: misrouted, Reason: world.DanglingReturnMisrouted, ReturnExitID: elsewhere},
+		}, got)
+	})
+
+	t.Run("leaves out exits the subject may not read", func(t *testing.T) {
+		hidden := danglingTestExit(t, "up", "down")
+		exitRepo := worldtest.NewMockExitRepository(t)
+		exitRepo.EXPECT().ListDanglingExits(mock.Anything).Return([]*world.Exit{hidden}, nil).Once()
+		svc := world.NewService(world.ServiceConfig{ExitRepo: exitRepo, Engine: policytest.NewGrantEngine()})
+
+		got, err := svc.ListDanglingExits(ctx, subjectID)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("a failed lookup fails the report", func(t *testing.T) {
+		exit := danglingTestExit(t, "in", "out")
+		exitRepo := worldtest.NewMockExitRepository(t)
+		exitRepo.EXPECT().ListDanglingExits(mock.Anything).Return([]*world.Exit{exit}, nil).Once()
+		exitRepo.EXPECT().FindByName(mock.Anything, exit.ToLocationID, "out").Return(nil, errors.New("db down")).Once()
+		svc := world.NewService(world.ServiceConfig{ExitRepo: exitRepo, Engine: policytest.AllowAllEngine()})
+
+		_, err := svc.ListDanglingExits(ctx, subjectID)
+		errutil.AssertErrorCode(t, err, "EXIT_LIST_FAILED")
+	})
+}
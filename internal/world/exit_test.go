@@ -63,6 +63,30 @@ func TestExit_MatchesName(t *testing.T) {
 	})
 }
 
+func TestExit_ConflictingName(t *testing.T) {
+	exit := &world.Exit{Name: "north", Aliases: []string{"n", "forward"}}
+
+	tests := []struct {
+		name     string
+		other    *world.Exit
+		wantName string
+		wantOK   bool
+	}{
+		{"same name any case", &world.Exit{Name: "NORTH"}, "north", true},
+		{"alias matches other name", &world.Exit{Name: "N"}, "n", true},
+		{"alias matches other alias", &world.Exit{Name: "ahead", Aliases: []string{"Forward"}}, "forward", true},
+		{"name matches other alias", &world.Exit{Name: "up", Aliases: []string{"north"}}, "north", true},
+		{"disjoint names", &world.Exit{Name: "south", Aliases: []string{"s"}}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := exit.ConflictingName(tt.other)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
 func TestVisibility_String(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -141,6 +165,50 @@ func TestExit_IsVisibleTo(t *testing.T) {
 	})
 }
 
+func TestExit_VisibleToSubject(t *testing.T) {
+	ownerID := ulid.Make()
+
+	t.Run("observer without character sees only public exits", func(t *testing.T) {
+		obs := world.ExitObserver{LocationOwnerID: &ownerID}
+		assert.True(t, (&world.Exit{Visibility: world.VisibilityAll}).VisibleToSubject(obs))
+		assert.False(t, (&world.Exit{Visibility: world.VisibilityOwner}).VisibleToSubject(obs))
+		assert.False(t, (&world.Exit{Visibility: world.VisibilityList, VisibleTo: []ulid.ULID{{}}}).VisibleToSubject(obs))
+	})
+
+	t.Run("owner observer sees owner-only exit", func(t *testing.T) {
+		obs := world.ExitObserver{CharacterID: ownerID, LocationOwnerID: &ownerID}
+		assert.True(t, (&world.Exit{Visibility: world.VisibilityOwner}).VisibleToSubject(obs))
+	})
+}
+
+func TestFilterVisibleExits(t *testing.T) {
+	ownerID := ulid.Make()
+	viewerID := ulid.Make()
+	public := &world.Exit{Name: "north", Visibility: world.VisibilityAll}
+	ownerOnly := &world.Exit{Name: "trapdoor", Visibility: world.VisibilityOwner}
+	listed := &world.Exit{Name: "secret", Visibility: world.VisibilityList, VisibleTo: []ulid.ULID{viewerID}}
+	exits := []*world.Exit{public, ownerOnly, listed}
+
+	t.Run("viewer on the list sees public and listed exits in order", func(t *testing.T) {
+		got := world.FilterVisibleExits(exits, world.ExitObserver{CharacterID: viewerID, LocationOwnerID: &ownerID})
+		assert.Equal(t, []*world.Exit{public, listed}, got)
+	})
+
+	t.Run("owner sees public and owner-only exits", func(t *testing.T) {
+		got := world.FilterVisibleExits(exits, world.ExitObserver{CharacterID: ownerID, LocationOwnerID: &ownerID})
+		assert.Equal(t, []*world.Exit{public, ownerOnly}, got)
+	})
+
+	t.Run("does not modify the input slice", func(t *testing.T) {
+		_ = world.FilterVisibleExits(exits, world.ExitObserver{})
+		assert.Equal(t, []*world.Exit{public, ownerOnly, listed}, exits)
+	})
+
+	t.Run("empty input returns empty result", func(t *testing.T) {
+		assert.Empty(t, world.FilterVisibleExits(nil, world.ExitObserver{CharacterID: viewerID}))
+	})
+}
+
 func TestExit_ReverseExit(t *testing.T) {
 	fromID := ulid.Make()
 	toID := ulid.Make()
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// ActionUseExit guards FindUsableExit: walking through an exit.
+const ActionUseExit = "use"
+
+// FindUsableExit resolves name to an exit leaving the current location of the
+// character with characterID, as that character sees the location's exits,
+// and checks that subjectID may use it. Names and aliases match
+// case-insensitively, as in MatchesName; an exit the character cannot see
+// never matches. The caller performs the move itself (see traversal.Service).
+//
+// A key lock opens for a character who holds its key object, directly or in
+// containers they hold up to MaxKeyContainerDepth deep. Other locks never
+// open here.
+//
+// Returns CHARACTER_NOT_IN_LOCATION when the character is nowhere,
+// EXIT_NOT_FOUND wrapping ErrNotFound when no visible exit matches,
+// EXIT_ACCESS_DENIED when subjectID may not use the exit, and EXIT_LOCKED
+// when the exit is locked against the character. With EXIT_LOCKED the exit
+// is returned too, so the caller can report the attempt.
+func (s *Service) FindUsableExit(ctx context.Context, subjectID string, characterID ulid.ULID, name string) (*Exit, error) {
+	char, err := s.GetCharacter(ctx, subjectID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if char.LocationID == nil {
+		return nil, oops.Code("CHARACTER_NOT_IN_LOCATION").
+			With("character_id", characterID.String()).
+			Errorf("character %s is not in a location", characterID)
+	}
+	exits, err := s.GetVisibleExits(ctx, subjectID, *char.LocationID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(exits, func(e *Exit) bool { return e.MatchesName(name) })
+	if i < 0 {
+		return nil, oops.Code("EXIT_NOT_FOUND").
+			With("location_id", char.LocationID.String()).
+			With("name", name).
+			Wrap(ErrNotFound)
+	}
+	exit := exits[i]
+	if err := s.checkAccess(ctx, subjectID, ActionUseExit, access.ExitResource(exit.ID.String()), prefixExit); err != nil {
+		return nil, err
+	}
+	if exit.Locked && !s.holdsExitKey(ctx, characterID, exit) {
+		return exit, oops.Code("EXIT_LOCKED").
+			With("id", exit.ID.String()).
+			With("lock_type", exit.LockType.String()).
+			Errorf("exit %q is locked", exit.Name)
+	}
+	return exit, nil
+}
+
+// holdsExitKey reports whether the character with characterID holds the key
+// of exit's key lock, directly or inside containers they hold, up to
+// MaxKeyContainerDepth deep. The key is looked up without an access check:
+// the exit's lock, set by whoever may write the exit, names it. A key that
+// cannot be found or loaded opens nothing.
+func (s *Service) holdsExitKey(ctx context.Context, characterID ulid.ULID, exit *Exit) bool {
+	keyID, _, ok := exit.KeyLock()
+	if !ok || s.objectRepo == nil {
+		return false
+	}
+	id := keyID
+	for depth := 0; depth <= MaxKeyContainerDepth; depth++ {
+		obj, err := s.objectRepo.Get(ctx, id)
+		if err != nil {
+			if !errors.Is(err, ErrNotFound) {
+				slog.WarnContext(ctx, "exit key lookup failed; treating exit as locked",
+					"exit_id", exit.ID.String(),
+					"key_id", keyID.String(),
+					"error", err)
+			}
+			return false
+		}
+		if holder := obj.HeldByCharacterID(); holder != nil {
+			return *holder == characterID
+		}
+		parent := obj.ContainedInObjectID()
+		if parent == nil {
+			return false
+		}
+		id = *parent
+	}
+	return false
+}
+
+// ConsumeExitKey destroys the key of exit's key lock when the lock is
+// single-use (LockDataConsume) and the character with characterID still
+// holds it. The caller has already let the character through with
+// FindUsableExit; the lock's configuration authorizes the destruction, so
+// it runs as the system. Exits without a consumable key are left alone.
+//
+// The holder is checked again in the same transaction as the delete, and
+// the delete is guarded by the version read then, so a key handed to
+// someone else in the meantime is never destroyed. Returns EXIT_LOCKED when
+// the key is gone or no longer the character's, and the errors of
+// DeleteObject otherwise.
+func (s *Service) ConsumeExitKey(ctx context.Context, characterID ulid.ULID, exit *Exit) error {
+	keyID, consume, ok := exit.KeyLock()
+	if !ok || !consume {
+		return nil
+	}
+	if s.objectRepo == nil {
+		return oops.Code("OBJECT_DELETE_FAILED").Errorf("object repository not configured")
+	}
+	return s.InTransaction(ctx, func(ctx context.Context) error {
+		key, err := s.objectRepo.Get(ctx, keyID)
+		if errors.Is(err, ErrNotFound) {
+			return keyGoneError(exit, keyID)
+		}
+		if err != nil {
+			return oops.With("exit_id", exit.ID.String()).Wrap(err)
+		}
+		if !s.holdsExitKey(ctx, characterID, exit) {
+			return keyGoneError(exit, keyID)
+		}
+		err = s.DeleteObject(access.WithSystemSubject(ctx), access.SubjectSystem, keyID,
+			IfObjectVersion(key.Version))
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrConcurrentEdit) {
+			return keyGoneError(exit, keyID)
+		}
+		if err != nil {
+			return oops.With("exit_id", exit.ID.String()).Wrap(err)
+		}
+		return nil
+	})
+}
+
+// keyGoneError reports that exit's single-use key was used up or changed
+// hands before it could be consumed. It does not wrap the cause: oops
+// resolves the innermost code, which would mask EXIT_LOCKED.
+func keyGoneError(exit *Exit, keyID ulid.ULID) error {
+	return oops.Code("EXIT_LOCKED").
+		With("id", exit.ID.String()).
+		With("lock_type", exit.LockType.String()).
+		With("key_id", keyID.String()).
+		Errorf("key of exit %q is gone", exit.Name)
+}
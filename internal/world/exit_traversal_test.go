@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestValidateTraversal(t *testing.T) {
+	tests := []struct {
+		name  string
+		delay time.Duration
+		cost  int
+		field string
+	}{
+		{"instant and free", 0, 0, ""},
+		{"a walk with a cost", 2 * time.Second, 5, ""},
+		{"the longest walk", world.MaxTraversalDelay, world.MaxTraversalCost, ""},
+		{"negative delay", -time.Second, 0, "traversal_delay"},
+		{"delay too long", world.MaxTraversalDelay + time.Second, 0, "traversal_delay"},
+		{"negative cost", 0, -1, "traversal_cost"},
+		{"cost too high", 0, world.MaxTraversalCost + 1, "traversal_cost"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := world.ValidateTraversal(tt.delay, tt.cost)
+			if tt.field == "" {
+				require.NoError(t, err)
+				return
+			}
+			var verr *world.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestExitTraversalIsValidatedAndMirrored(t *testing.T) {
+	exit, err := world.NewExit(ulid.Make(), ulid.Make(), "north")
+	require.NoError(t, err)
+	exit.TraversalDelay = world.MaxTraversalDelay + time.Second
+	require.Error(t, exit.Validate())
+
+	exit.TraversalDelay = 2 * time.Second
+	exit.TraversalCost = 3
+	exit.Bidirectional = true
+	exit.ReturnName = "south"
+	back, err := exit.ReverseExit()
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, back.TraversalDelay, "the way back takes as long")
+	assert.Equal(t, 3, back.TraversalCost)
+}
+
+func TestExitPayloadCarriesTraversal(t *testing.T) {
+	exit, err := world.NewExit(ulid.Make(), ulid.Make(), "north")
+	require.NoError(t, err)
+
+	raw, err := world.BuildExitPayload(exit)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "traversal", "an instant, free exit omits its traversal")
+
+	exit.TraversalDelay = 1500 * time.Millisecond
+	exit.TraversalCost = 4
+	raw, err = world.BuildExitPayload(exit)
+	require.NoError(t, err)
+	var payload world.ExitChangePayload
+	require.NoError(t, json.Unmarshal(raw, &payload))
+	assert.Equal(t, int64(1500), payload.TraversalDelayMS)
+	assert.Equal(t, 4, payload.TraversalCost)
+}
+
+func TestWorldService_FindUsableExit(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	subjectID := access.CharacterSubject(charID.String())
+	locID := ulid.Make()
+
+	newExit := func(name string, aliases ...string) *world.Exit {
+		exit, err := world.NewExit(locID, ulid.Make(), name)
+		require.NoError(t, err)
+		exit.Aliases = aliases
+		return exit
+	}
+	grantEngine := func(exits ...*world.Exit) *policytest.GrantEngine {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", access.CharacterResource(charID.String()))
+		engine.Grant(subjectID, "read", access.LocationResource(locID.String()))
+		for _, e := range exits {
+			engine.Grant(subjectID, world.ActionUseExit, access.ExitResource(e.ID.String()))
+		}
+		return engine
+	}
+	newService := func(engine *policytest.GrantEngine, exits ...*world.Exit) *world.Service {
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(&world.Character{ID: charID, LocationID: &locID}, nil).Maybe()
+		exitRepo := worldtest.NewMockExitRepository(t)
+		exitRepo.EXPECT().ListFromLocation(mock.Anything, locID, mock.Anything).Return(world.Page[*world.Exit]{Items: exits}, nil).Maybe()
+		return world.NewService(world.ServiceConfig{CharacterRepo: charRepo, ExitRepo: exitRepo, Engine: engine})
+	}
+
+	t.Run("matches a name or alias case-insensitively", func(t *testing.T) {
+		north := newExit("North", "n")
+		east := newExit("east")
+		svc := newService(grantEngine(north, east), north, east)
+
+		got, err := svc.FindUsableExit(ctx, subjectID, charID, "north")
+		require.NoError(t, err)
+		assert.Equal(t, north.ID, got.ID)
+		got, err = svc.FindUsableExit(ctx, subjectID, charID, "N")
+		require.NoError(t, err)
+		assert.Equal(t, north.ID, got.ID)
+	})
+
+	t.Run("an unknown or hidden exit is not found", func(t *testing.T) {
+		hidden := newExit("trapdoor")
+		hidden.Visibility = world.VisibilityOwner
+		svc := newService(grantEngine(hidden), hidden)
+
+		_, err := svc.FindUsableExit(ctx, subjectID, charID, "west")
+		errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+		assert.ErrorIs(t, err, world.ErrNotFound)
+		_, err = svc.FindUsableExit(ctx, subjectID, charID, "trapdoor")
+		errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+	})
+
+	t.Run("without use the exit is denied", func(t *testing.T) {
+		north := newExit("north")
+		svc := newService(grantEngine(), north)
+
+		_, err := svc.FindUsableExit(ctx, subjectID, charID, "north")
+		errutil.AssertErrorCode(t, err, "EXIT_ACCESS_DENIED")
+	})
+
+	t.Run("a locked exit cannot be used", func(t *testing.T) {
+		gate := newExit("gate")
+		require.NoError(t, gate.SetLocked(true, world.LockTypeKey, map[string]any{"key_id": "brass"}))
+		svc := newService(grantEngine(gate), gate)
+
+		got, err := svc.FindUsableExit(ctx, subjectID, charID, "gate")
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+		require.NotNil(t, got, "a locked exit is returned with the error")
+		assert.Equal(t, gate.ID, got.ID)
+	})
+
+	t.Run("a character in no location has no exits", func(t *testing.T) {
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(&world.Character{ID: charID}, nil).Once()
+		svc := world.NewService(world.ServiceConfig{
+			CharacterRepo: charRepo, ExitRepo: worldtest.NewMockExitRepository(t), Engine: grantEngine(),
+		})
+
+		_, err := svc.FindUsableExit(ctx, subjectID, charID, "north")
+		errutil.AssertErrorCode(t, err, "CHARACTER_NOT_IN_LOCATION")
+	})
+}
+
+func TestWorldService_FindUsableExitKeyLocks(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	subjectID := access.CharacterSubject(charID.String())
+	locID := ulid.Make()
+
+	gate, err := world.NewExit(locID, ulid.Make(), "gate")
+	require.NoError(t, err)
+	keyID := ulid.Make()
+	require.NoError(t, gate.SetLocked(true, world.LockTypeKey, map[string]any{world.LockDataKeyID: keyID.String()}))
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "read", access.CharacterResource(charID.String()))
+	engine.Grant(subjectID, "read", access.LocationResource(locID.String()))
+	engine.Grant(subjectID, world.ActionUseExit, access.ExitResource(gate.ID.String()))
+
+	// objects builds an object repository holding the key and, from the key
+	// outward, the chain of containers around it; the outermost sits in
+	// outer.
+	objects := func(containers int, outer world.Containment) *worldtest.MockObjectRepository {
+		repo := worldtest.NewMockObjectRepository(t)
+		id := keyID
+		for i := 0; i <= containers; i++ {
+			where := outer
+			next := ulid.Make()
+			if i < containers {
+				where = world.Containment{ObjectID: &next}
+			}
+			obj, err := world.NewObjectWithID(id, "thing", where)
+			require.NoError(t, err)
+			repo.EXPECT().Get(mock.Anything, id).Return(obj, nil).Maybe()
+			id = next
+		}
+		return repo
+	}
+	newService := func(objectRepo world.ObjectRepository) *world.Service {
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, charID).Return(&world.Character{ID: charID, LocationID: &locID}, nil).Maybe()
+		exitRepo := worldtest.NewMockExitRepository(t)
+		exitRepo.EXPECT().ListFromLocation(mock.Anything, locID, mock.Anything).Return(world.Page[*world.Exit]{Items: []*world.Exit{gate}}, nil).Maybe()
+		return world.NewService(world.ServiceConfig{CharacterRepo: charRepo, ExitRepo: exitRepo, ObjectRepo: objectRepo, Engine: engine})
+	}
+	held := world.Containment{CharacterID: &charID}
+
+	t.Run("the key held directly opens the lock", func(t *testing.T) {
+		got, err := newService(objects(0, held)).FindUsableExit(ctx, subjectID, charID, "gate")
+		require.NoError(t, err)
+		assert.Equal(t, gate.ID, got.ID)
+	})
+
+	t.Run("the key in nested containers opens the lock up to the depth limit", func(t *testing.T) {
+		_, err := newService(objects(world.MaxKeyContainerDepth, held)).FindUsableExit(ctx, subjectID, charID, "gate")
+		require.NoError(t, err)
+		_, err = newService(objects(world.MaxKeyContainerDepth+1, held)).FindUsableExit(ctx, subjectID, charID, "gate")
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	})
+
+	t.Run("a key someone else holds or lying in the room does not", func(t *testing.T) {
+		other := ulid.Make()
+		_, err := newService(objects(1, world.Containment{CharacterID: &other})).FindUsableExit(ctx, subjectID, charID, "gate")
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+		_, err = newService(objects(0, world.Containment{LocationID: &locID})).FindUsableExit(ctx, subjectID, charID, "gate")
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	})
+
+	t.Run("a missing key opens nothing", func(t *testing.T) {
+		repo := worldtest.NewMockObjectRepository(t)
+		repo.EXPECT().Get(mock.Anything, keyID).Return(nil, world.ErrNotFound)
+		_, err := newService(repo).FindUsableExit(ctx, subjectID, charID, "gate")
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	})
+}
+
+func TestExitKeyLock(t *testing.T) {
+	exit, err := world.NewExit(ulid.Make(), ulid.Make(), "gate")
+	require.NoError(t, err)
+	_, _, ok := exit.KeyLock()
+	assert.False(t, ok, "an unlocked exit has no key lock")
+
+	keyID := ulid.Make()
+	require.NoError(t, exit.SetLocked(true, world.LockTypeKey, map[string]any{
+		world.LockDataKeyID: keyID.String(), world.LockDataConsume: true,
+	}))
+	got, consume, ok := exit.KeyLock()
+	require.True(t, ok)
+	assert.Equal(t, keyID, got)
+	assert.True(t, consume)
+
+	require.NoError(t, exit.SetLocked(true, world.LockTypeKey, map[string]any{world.LockDataKeyID: "brass"}))
+	_, _, ok = exit.KeyLock()
+	assert.False(t, ok, "lock data that names no object is no key lock")
+
+	require.NoError(t, exit.SetLocked(true, world.LockTypePassword, map[string]any{world.LockDataKeyID: keyID.String()}))
+	_, _, ok = exit.KeyLock()
+	assert.False(t, ok, "only key locks have keys")
+}
+
+func TestWorldService_ConsumeExitKey(t *testing.T) {
+	ctx := context.Background()
+	keyID := ulid.Make()
+	newGate := func(consume bool) *world.Exit {
+		gate, err := world.NewExit(ulid.Make(), ulid.Make(), "gate")
+		require.NoError(t, err)
+		require.NoError(t, gate.SetLocked(true, world.LockTypeKey, map[string]any{
+			world.LockDataKeyID: keyID.String(), world.LockDataConsume: consume,
+		}))
+		return gate
+	}
+	newService := func(objRepo *worldtest.MockObjectRepository, outbox *mockOutboxWriter) *world.Service {
+		propRepo := worldtest.NewMockPropertyRepository(t)
+		propRepo.EXPECT().DeleteByParent(mock.Anything, "object", keyID).Return(nil).Maybe()
+		return world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: objRepo, PropertyRepo: propRepo, Engine: policytest.AllowAllEngine(),
+		}, outbox))
+	}
+
+	charID := ulid.Make()
+	heldKey := func(holder ulid.ULID) *world.Object {
+		key, err := world.NewObject("key", world.HeldBy(holder))
+		require.NoError(t, err)
+		key.ID = keyID
+		key.Version = 4
+		return key
+	}
+
+	t.Run("a single-use key is destroyed", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		objRepo.EXPECT().Get(mock.Anything, keyID).Return(heldKey(charID), nil)
+		delta := &wmodel.MutationDelta{Primary: wmodel.AffectedAggregate{Type: wmodel.AggregateObject, ID: keyID, Tombstone: true}}
+		objRepo.EXPECT().Delete(mock.Anything, keyID, 4).Return(delta, nil).Once()
+		outbox := &mockOutboxWriter{}
+
+		require.NoError(t, newService(objRepo, outbox).ConsumeExitKey(ctx, charID, newGate(true)))
+		assert.Equal(t, "object_deleted", outbox.lastIntent.Kind)
+	})
+
+	t.Run("a key handed to someone else is not destroyed", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		objRepo.EXPECT().Get(mock.Anything, keyID).Return(heldKey(ulid.Make()), nil)
+
+		err := newService(objRepo, &mockOutboxWriter{}).ConsumeExitKey(ctx, charID, newGate(true))
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+		objRepo.AssertNotCalled(t, "Delete")
+	})
+
+	t.Run("a reusable key is kept", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		require.NoError(t, newService(objRepo, &mockOutboxWriter{}).ConsumeExitKey(ctx, charID, newGate(false)))
+		objRepo.AssertNotCalled(t, "Delete")
+	})
+
+	t.Run("a key already used up leaves the exit locked", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		objRepo.EXPECT().Get(mock.Anything, keyID).Return(nil, world.ErrNotFound).Once()
+
+		err := newService(objRepo, &mockOutboxWriter{}).ConsumeExitKey(ctx, charID, newGate(true))
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+		objRepo.AssertNotCalled(t, "Delete")
+	})
+
+	t.Run("a key moved away mid-delete leaves the exit locked", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		objRepo.EXPECT().Get(mock.Anything, keyID).Return(heldKey(charID), nil)
+		objRepo.EXPECT().Delete(mock.Anything, keyID, 4).Return(nil, world.ErrConcurrentEdit).Once()
+
+		err := newService(objRepo, &mockOutboxWriter{}).ConsumeExitKey(ctx, charID, newGate(true))
+		errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	})
+}
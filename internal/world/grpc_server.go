@@ -77,20 +77,22 @@ func (s *GRPCServer) ListCharactersAtLocation(ctx context.Context, req *worldv1.
 	}
 
 	subjectID := access.CharacterSubject(req.GetSubjectId())
-	chars, err := s.svc.GetCharactersByLocation(ctx, subjectID, locID, ListOptions{})
+	page, err := s.svc.GetCharactersByLocation(ctx, subjectID, locID, ListOptions{})
 	if err != nil {
 		return nil, mapWorldError(err)
 	}
 
-	protoChars := make([]*worldv1.CharacterInfo, len(chars))
-	for i, c := range chars {
+	protoChars := make([]*worldv1.CharacterInfo, len(page.Items))
+	for i, c := range page.Items {
 		protoChars[i] = characterToProto(c)
 	}
 
 	return &worldv1.ListCharactersAtLocationResponse{Characters: protoChars}, nil
 }
 
-// ListExits returns all exits from a location.
+// ListExits returns the exits from a location visible to the requesting
+// character. An unparseable subject id observes as no character and sees
+// only VisibilityAll exits.
 func (s *GRPCServer) ListExits(ctx context.Context, req *worldv1.ListExitsRequest) (*worldv1.ListExitsResponse, error) {
 	locID, err := ulid.ParseStrict(req.GetLocationId())
 	if err != nil {
@@ -98,7 +100,8 @@ func (s *GRPCServer) ListExits(ctx context.Context, req *worldv1.ListExitsReques
 	}
 
 	subjectID := access.CharacterSubject(req.GetSubjectId())
-	exits, err := s.svc.GetExitsByLocation(ctx, subjectID, locID)
+	observerID, _ := ulid.ParseStrict(req.GetSubjectId()) //nolint:errcheck // zero observer is the fail-closed view
+	exits, err := s.svc.GetVisibleExits(ctx, subjectID, locID, observerID)
 	if err != nil {
 		return nil, mapWorldError(err)
 	}
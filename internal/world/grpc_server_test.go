@@ -203,14 +203,14 @@ func TestWorldServiceServer_ListCharactersAtLocation(t *testing.T) {
 		engine := policytest.NewGrantEngine()
 		engine.Grant(subjectID, "list_characters", "location:"+locID.String())
 
-		charRepo.EXPECT().GetByLocation(mock.Anything, locID, world.ListOptions{}).Return([]*world.Character{
+		charRepo.EXPECT().GetByLocation(mock.Anything, locID, world.ListOptions{}).Return(world.Page[*world.Character]{Items: []*world.Character{
 			{
 				ID:         charID,
 				PlayerID:   playerID,
 				Name:       "Hero",
 				LocationID: &locID,
 			},
-		}, nil)
+		}}, nil)
 
 		svc := world.NewService(world.ServiceConfig{
 			CharacterRepo: charRepo,
@@ -255,7 +255,7 @@ func TestWorldServiceServer_ListExits(t *testing.T) {
 		engine := policytest.NewGrantEngine()
 		engine.Grant(subjectID, "read", "location:"+locID.String())
 
-		exitRepo.EXPECT().ListFromLocation(mock.Anything, locID).Return([]*world.Exit{
+		exitRepo.EXPECT().ListFromLocation(mock.Anything, locID, mock.Anything).Return(world.Page[*world.Exit]{Items: []*world.Exit{
 			{
 				ID:             exitID,
 				Name:           "north",
@@ -264,8 +264,9 @@ func TestWorldServiceServer_ListExits(t *testing.T) {
 				Bidirectional:  true,
 				ReturnName:     "south",
 				Locked:         false,
+				Visibility:     world.VisibilityAll,
 			},
-		}, nil)
+		}}, nil)
 
 		svc := world.NewService(world.ServiceConfig{
 			ExitRepo: exitRepo,
@@ -289,6 +290,34 @@ func TestWorldServiceServer_ListExits(t *testing.T) {
 		assert.False(t, e.GetLocked())
 	})
 
+	t.Run("omits exits hidden from the requesting character", func(t *testing.T) {
+		exitRepo := worldtest.NewMockExitRepository(t)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", "location:"+locID.String())
+
+		exitRepo.EXPECT().ListFromLocation(mock.Anything, locID, mock.Anything).Return(world.Page[*world.Exit]{Items: []*world.Exit{
+			{ID: exitID, Name: "north", FromLocationID: locID, ToLocationID: destID, Visibility: world.VisibilityAll},
+			{
+				ID: ulid.MustNew(34, nil), Name: "secret", FromLocationID: locID, ToLocationID: destID,
+				Visibility: world.VisibilityList, VisibleTo: []ulid.ULID{ulid.MustNew(35, nil)},
+			},
+		}}, nil)
+
+		svc := world.NewService(world.ServiceConfig{
+			ExitRepo: exitRepo,
+			Engine:   engine,
+		})
+
+		client := startWorldServer(t, svc)
+		resp, err := client.ListExits(context.Background(), &worldv1.ListExitsRequest{
+			SubjectId:  ulid.MustNew(33, nil).String(),
+			LocationId: locID.String(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetExits(), 1)
+		assert.Equal(t, "north", resp.GetExits()[0].GetName())
+	})
+
 	t.Run("returns InvalidArgument for malformed location ID", func(t *testing.T) {
 		svc := world.NewService(world.ServiceConfig{
 			ExitRepo: worldtest.NewMockExitRepository(t),
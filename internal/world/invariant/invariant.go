@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package invariant wraps the world repositories with post-condition checks
+// that catch storage-layer bugs before they corrupt the world. After each
+// successful write the wrapped repository re-reads what it wrote and checks
+// the world model's contracts:
+//
+//   - an object sits in exactly one place: a location, a character's hands,
+//     or a container object, and that place exists;
+//   - a moved object ended up where it was moved to;
+//   - an exit's endpoints exist, and a new two-way exit has its return exit;
+//   - a character's location exists;
+//   - a deleted location leaves no exits behind.
+//
+// A violation never fails the write. It is counted and handed to the
+// configured Reporter with the operation, entity, and call stack that
+// produced it. Checks read through the repositories they wrap, after the
+// write has committed: a write made inside a transaction opened through the
+// Monitor's Transactor is checked once the outermost transaction commits,
+// and not at all if it rolls back; any other write is checked as soon as it
+// returns. The reads therefore never run on the writer's connection, so a
+// failed check read cannot abort the write's transaction. It is reported as
+// a CheckRead violation instead. Because the checks read committed state, a
+// concurrent write landing between the commit and the check can very rarely
+// be reported as a violation.
+//
+// Checks are off unless a sample rate is configured, since each check costs
+// a few extra reads. The development stack (compose.yaml) checks every
+// write.
+package invariant
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"runtime/debug"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// DefaultSampleRate is the sample rate when none is configured: no writes
+// are checked.
+const DefaultSampleRate = 0.0
+
+// Invariant names a repository contract.
+type Invariant string
+
+// Checked invariants.
+const (
+	// ContainmentExclusive is an object placed in exactly one location,
+	// character, or container.
+	ContainmentExclusive Invariant = "containment_exclusive"
+	// ContainmentTarget is an object's location, holder, or container
+	// existing.
+	ContainmentTarget Invariant = "containment_target"
+	// MoveApplied is a moved object ending up where it was moved to.
+	MoveApplied Invariant = "move_applied"
+	// ExitEndpoint is an exit's source and destination locations existing.
+	ExitEndpoint Invariant = "exit_endpoint"
+	// ExitReturn is a two-way exit's return exit existing.
+	ExitReturn Invariant = "exit_return"
+	// CharacterLocation is a character's location existing.
+	CharacterLocation Invariant = "character_location"
+	// LocationExitsRemoved is a deleted location leaving no exits behind.
+	LocationExitsRemoved Invariant = "location_exits_removed"
+	// CheckRead is a check's own read failing, which leaves the check
+	// inconclusive rather than passed.
+	CheckRead Invariant = "check_read"
+)
+
+// Violation is one broken post-condition.
+type Violation struct {
+	Invariant Invariant
+	// Op is the repository write that broke it, such as "object.move".
+	Op       string
+	EntityID ulid.ULID
+	// RefID is the reference that failed to resolve or did not match, when
+	// there is one.
+	RefID  ulid.ULID
+	Detail string
+	// Stack is the call stack of the write.
+	Stack []byte
+}
+
+// Reporter receives violations. It is called synchronously on the writing
+// goroutine, after the write has committed, and must not block.
+type Reporter func(ctx context.Context, v Violation)
+
+// Config configures a Monitor.
+type Config struct {
+	// SampleRate is the fraction of writes checked, from 0 (none) to 1
+	// (every write).
+	SampleRate float64
+	// Report receives violations. Nil logs them at error level.
+	Report Reporter
+}
+
+// Monitor checks repository writes against the world model's invariants.
+type Monitor struct {
+	rate   float64
+	report Reporter
+	sample func() float64
+	repos  Repositories
+}
+
+// Repositories are the repositories a Monitor wraps. Their read methods
+// also serve the checks.
+type Repositories struct {
+	Locations  world.LocationRepository
+	Exits      world.ExitRepository
+	Objects    world.ObjectRepository
+	Characters world.CharacterRepository
+}
+
+// New creates a Monitor that checks writes through repos.
+func New(cfg Config, repos Repositories) *Monitor {
+	report := cfg.Report
+	if report == nil {
+		report = LogViolation
+	}
+	return &Monitor{rate: cfg.SampleRate, report: report, sample: cryptoFloat64, repos: repos}
+}
+
+// Repositories returns the monitor's repositories wrapped with its checks.
+// Writes to them inside a transaction MUST go through the Monitor's
+// Transactor, or their checks run inside the transaction.
+func (m *Monitor) Repositories() Repositories {
+	return Repositories{
+		Locations:  &locationRepo{LocationRepository: m.repos.Locations, m: m},
+		Exits:      &exitRepo{ExitRepository: m.repos.Exits, m: m},
+		Objects:    &objectRepo{ObjectRepository: m.repos.Objects, m: m},
+		Characters: &characterRepo{CharacterRepository: m.repos.Characters, m: m},
+	}
+}
+
+// LogViolation logs v at error level. It is the default Reporter.
+func LogViolation(ctx context.Context, v Violation) {
+	slog.ErrorContext(ctx, "world repository invariant violated",
+		"invariant", string(v.Invariant),
+		"op", v.Op,
+		"entity_id", v.EntityID.String(),
+		"ref_id", v.RefID.String(),
+		"detail", v.Detail,
+		"stack", string(v.Stack))
+}
+
+// sampled reports whether the current write is checked.
+func (m *Monitor) sampled() bool {
+	return m.rate >= 1 || (m.rate > 0 && m.sample() < m.rate)
+}
+
+// violated counts and reports a violation, with the call stack of the write
+// that was checked.
+func (m *Monitor) violated(ctx context.Context, v Violation) {
+	v.Stack, _ = ctx.Value(stackKey{}).([]byte)
+	if v.Stack == nil {
+		v.Stack = debug.Stack()
+	}
+	violations.WithLabelValues(string(v.Invariant)).Inc()
+	m.report(ctx, v)
+}
+
+// readFailed reports a check whose read failed.
+func (m *Monitor) readFailed(ctx context.Context, op string, id ulid.ULID, err error) {
+	m.violated(ctx, Violation{
+		Invariant: CheckRead, Op: op, EntityID: id,
+		Detail: fmt.Sprintf("check read failed: %v", err),
+	})
+}
+
+// exists runs a point read and reports whether the entity was found. A read
+// failure other than not-found is returned: the check is inconclusive, not
+// violated.
+func exists[T any](ctx context.Context, get func(context.Context, ulid.ULID) (T, error), id ulid.ULID) (bool, error) {
+	_, err := get(ctx, id)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, world.ErrNotFound) {
+		return false, nil
+	}
+	return false, err
+}
+
+// stackKey is the context key for the call stack of the write a deferred
+// check belongs to.
+type stackKey struct{}
+
+// pendingKey is the context key for the checks a wrapped transaction runs
+// after it commits.
+type pendingKey struct{}
+
+// pending holds the checks queued inside one outermost transaction.
+type pending struct {
+	mu     sync.Mutex
+	checks []func(ctx context.Context)
+}
+
+func pendingFromContext(ctx context.Context) *pending {
+	p, _ := ctx.Value(pendingKey{}).(*pending)
+	return p
+}
+
+// check runs fn now or, inside a wrapped transaction, queues it to run after
+// the transaction commits.
+func (m *Monitor) check(ctx context.Context, fn func(ctx context.Context)) {
+	p := pendingFromContext(ctx)
+	if p == nil {
+		fn(ctx)
+		return
+	}
+	stack := debug.Stack()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.checks = append(p.checks, func(ctx context.Context) {
+		fn(context.WithValue(ctx, stackKey{}, stack))
+	})
+}
+
+// Transactor wraps inner so the checks of writes made inside its
+// transactions run once the outermost transaction has committed, outside
+// it. Use the wrapped Transactor everywhere the checked repositories are
+// written in a transaction.
+func (m *Monitor) Transactor(inner world.Transactor) world.Transactor {
+	return &transactor{inner: inner}
+}
+
+type transactor struct {
+	inner world.Transactor
+}
+
+// InTransaction runs fn in inner's transaction. Nested calls join the
+// outermost transaction's queue, mirroring the re-entrant semantics of the
+// postgres Transactor. The queued checks run on ctx, which carries no
+// transaction, and only when the transaction committed.
+func (t *transactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if pendingFromContext(ctx) != nil {
+		return t.inner.InTransaction(ctx, fn)
+	}
+	p := &pending{}
+	if err := t.inner.InTransaction(context.WithValue(ctx, pendingKey{}, p), fn); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	checks := p.checks
+	p.checks = nil
+	p.mu.Unlock()
+	for _, check := range checks {
+		check(ctx)
+	}
+	return nil
+}
+
+// cryptoFloat64 returns a cryptographically secure random float64 in
+// [0, 1).
+func cryptoFloat64() float64 {
+	v, err := rand.Int(rand.Reader, big.NewInt(1<<53))
+	if err != nil {
+		// crypto/rand failure is a system-level problem; panic is appropriate.
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return float64(v.Int64()) / (1 << 53)
+}
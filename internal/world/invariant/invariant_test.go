@@ -0,0 +1,324 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// fixture is a world with two locations joined by a two-way exit, a
+// character in the hall, and a lamp in the hall, checked by a Monitor that
+// records what it reports.
+type fixture struct {
+	base       Repositories
+	checked    Repositories
+	baseTx     world.Transactor
+	tx         world.Transactor
+	monitor    *Monitor
+	violations []Violation
+	hall, yard *world.Location
+	door       *world.Exit
+	alice      *world.Character
+	lamp       *world.Object
+}
+
+func newFixture(t *testing.T, rate float64) *fixture {
+	t.Helper()
+	ctx := context.Background()
+	store := memory.NewStore()
+	f := &fixture{base: Repositories{
+		Locations:  memory.NewLocationRepository(store),
+		Exits:      memory.NewExitRepository(store),
+		Objects:    memory.NewObjectRepository(store),
+		Characters: memory.NewCharacterRepository(store),
+	}, baseTx: memory.NewTransactor(store)}
+	f.rewrap(rate)
+
+	var err error
+	f.hall, err = world.NewLocation("Hall", "A hall.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	f.yard, err = world.NewLocation("Yard", "A yard.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	for _, loc := range []*world.Location{f.hall, f.yard} {
+		_, err = f.checked.Locations.Create(ctx, loc)
+		require.NoError(t, err)
+	}
+	f.door, err = world.NewExit(f.hall.ID, f.yard.ID, "out")
+	require.NoError(t, err)
+	f.door.Bidirectional = true
+	f.door.ReturnName = "in"
+	_, err = f.checked.Exits.Create(ctx, f.door)
+	require.NoError(t, err)
+	f.alice, err = world.NewCharacter(ulid.Make(), "Alice")
+	require.NoError(t, err)
+	f.alice.LocationID = &f.hall.ID
+	_, err = f.checked.Characters.Create(ctx, f.alice)
+	require.NoError(t, err)
+	f.lamp, err = world.NewObject("lamp", world.InLocation(f.hall.ID))
+	require.NoError(t, err)
+	_, err = f.checked.Objects.Create(ctx, f.lamp)
+	require.NoError(t, err)
+	return f
+}
+
+// rewrap rebuilds the monitor over f.base, for tests that swap in a buggy
+// repository.
+func (f *fixture) rewrap(rate float64) {
+	f.monitor = New(Config{SampleRate: rate, Report: func(_ context.Context, v Violation) {
+		f.violations = append(f.violations, v)
+	}}, f.base)
+	f.checked = f.monitor.Repositories()
+	f.tx = f.monitor.Transactor(f.baseTx)
+}
+
+func (f *fixture) invariants() []Invariant {
+	out := make([]Invariant, 0, len(f.violations))
+	for _, v := range f.violations {
+		out = append(out, v.Invariant)
+	}
+	return out
+}
+
+// noopMoves is an object repository whose Move reports success without
+// moving anything.
+type noopMoves struct{ world.ObjectRepository }
+
+func (noopMoves) Move(context.Context, ulid.ULID, world.Containment, int) (*wmodel.MutationDelta, error) {
+	return &wmodel.MutationDelta{}, nil
+}
+
+// rewrittenObjects is an object repository whose reads return obj.
+type rewrittenObjects struct {
+	world.ObjectRepository
+	obj *world.Object
+}
+
+func (r rewrittenObjects) Get(ctx context.Context, id ulid.ULID) (*world.Object, error) {
+	if id == r.obj.ID {
+		return r.obj, nil
+	}
+	return r.ObjectRepository.Get(ctx, id)
+}
+
+// strandedCharacters is a character repository whose reads place every
+// character in a location that does not exist.
+type strandedCharacters struct{ world.CharacterRepository }
+
+func (r strandedCharacters) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
+	char, err := r.CharacterRepository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	missing := ulid.Make()
+	char.LocationID = &missing
+	return char, nil
+}
+
+// unreadableLocations is a location repository whose reads fail.
+type unreadableLocations struct{ world.LocationRepository }
+
+func (unreadableLocations) Get(context.Context, ulid.ULID) (*world.Location, error) {
+	return nil, errors.New("connection reset")
+}
+
+// keptLocations is a location repository whose Delete reports success
+// without deleting anything.
+type keptLocations struct{ world.LocationRepository }
+
+func (keptLocations) Delete(context.Context, ulid.ULID, int) (*wmodel.MutationDelta, error) {
+	return &wmodel.MutationDelta{}, nil
+}
+
+func TestCorrectWritesPassTheChecks(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+
+	_, err := f.checked.Objects.Move(ctx, f.lamp.ID, world.HeldBy(f.alice.ID), f.lamp.Version)
+	require.NoError(t, err)
+	_, err = f.checked.Characters.UpdateLocation(ctx, f.alice.ID, &f.yard.ID, f.alice.Version)
+	require.NoError(t, err)
+	f.door.Name = "outside"
+	_, err = f.checked.Exits.Update(ctx, f.door)
+	require.NoError(t, err)
+	_, err = f.checked.Locations.Delete(ctx, f.hall.ID, f.hall.Version)
+	require.NoError(t, err)
+
+	assert.Empty(t, f.violations)
+}
+
+func TestMoveThatDidNotMoveIsReported(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Objects = noopMoves{f.base.Objects}
+	f.rewrap(1)
+
+	_, err := f.checked.Objects.Move(ctx, f.lamp.ID, world.HeldBy(f.alice.ID), f.lamp.Version)
+	require.NoError(t, err, "a violation never fails the write")
+	require.Equal(t, []Invariant{MoveApplied}, f.invariants())
+	v := f.violations[0]
+	assert.Equal(t, "object.move", v.Op)
+	assert.Equal(t, f.lamp.ID, v.EntityID)
+	assert.Equal(t, f.alice.ID, v.RefID)
+	assert.Contains(t, v.Detail, "is in location "+f.hall.ID.String())
+	assert.NotEmpty(t, v.Stack)
+}
+
+func TestObjectContainmentChecks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nowhere", func(t *testing.T) {
+		f := newFixture(t, 1)
+		f.base.Objects = rewrittenObjects{f.base.Objects, &world.Object{ID: f.lamp.ID, Name: "lamp"}}
+		f.rewrap(1)
+		_, err := f.checked.Objects.Update(ctx, f.lamp)
+		require.NoError(t, err)
+		assert.Equal(t, []Invariant{ContainmentExclusive}, f.invariants())
+	})
+
+	t.Run("missing container", func(t *testing.T) {
+		f := newFixture(t, 1)
+		gone, err := world.NewObjectWithID(f.lamp.ID, "lamp", world.InContainer(ulid.Make()))
+		require.NoError(t, err)
+		f.base.Objects = rewrittenObjects{f.base.Objects, gone}
+		f.rewrap(1)
+		_, err = f.checked.Objects.Update(ctx, f.lamp)
+		require.NoError(t, err)
+		require.Equal(t, []Invariant{ContainmentTarget}, f.invariants())
+		assert.Equal(t, *gone.ContainedInObjectID(), f.violations[0].RefID)
+	})
+}
+
+func TestCharacterInMissingLocationIsReported(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Characters = strandedCharacters{f.base.Characters}
+	f.rewrap(1)
+
+	_, err := f.checked.Characters.UpdateLocation(ctx, f.alice.ID, &f.yard.ID, f.alice.Version)
+	require.NoError(t, err)
+	require.Equal(t, []Invariant{CharacterLocation}, f.invariants())
+	assert.Equal(t, "character.update_location", f.violations[0].Op)
+}
+
+func TestDeletedLocationWithExitsIsReported(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Locations = keptLocations{f.base.Locations}
+	f.rewrap(1)
+
+	_, err := f.checked.Locations.Delete(ctx, f.hall.ID, f.hall.Version)
+	require.NoError(t, err)
+	require.Equal(t, []Invariant{LocationExitsRemoved}, f.invariants())
+	assert.Equal(t, f.door.ID, f.violations[0].RefID)
+}
+
+func TestSamplingAndFailedWrites(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 0.5)
+	f.base.Objects = noopMoves{f.base.Objects}
+	f.rewrap(0.5)
+	move := func() {
+		_, err := f.checked.Objects.Move(ctx, f.lamp.ID, world.HeldBy(f.alice.ID), f.lamp.Version)
+		require.NoError(t, err)
+	}
+
+	f.monitor.sample = func() float64 { return 0.9 }
+	move()
+	assert.Empty(t, f.violations, "an unsampled write is not checked")
+
+	f.monitor.sample = func() float64 { return 0.1 }
+	move()
+	assert.Len(t, f.violations, 1)
+
+	// A failed write is the caller's problem, not a broken invariant.
+	_, err := f.checked.Objects.Create(ctx, f.lamp)
+	require.Error(t, err)
+	assert.Len(t, f.violations, 1)
+
+	f.rewrap(0)
+	move()
+	assert.Len(t, f.violations, 1, "rate 0 checks nothing")
+}
+
+func TestFailedCheckReadsAreReported(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Locations = unreadableLocations{f.base.Locations}
+	f.rewrap(1)
+
+	_, err := f.checked.Characters.UpdateLocation(ctx, f.alice.ID, &f.yard.ID, f.alice.Version)
+	require.NoError(t, err, "a failed check never fails the write")
+	require.Equal(t, []Invariant{CheckRead}, f.invariants())
+	assert.Equal(t, f.alice.ID, f.violations[0].EntityID)
+	assert.Contains(t, f.violations[0].Detail, "connection reset")
+}
+
+func TestChecksInATransactionRunAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Objects = noopMoves{f.base.Objects}
+	f.rewrap(1)
+
+	err := f.tx.InTransaction(ctx, func(ctx context.Context) error {
+		return f.tx.InTransaction(ctx, func(ctx context.Context) error {
+			_, err := f.checked.Objects.Move(ctx, f.lamp.ID, world.HeldBy(f.alice.ID), f.lamp.Version)
+			require.NoError(t, err)
+			assert.Empty(t, f.violations, "checks wait for the outermost commit")
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Invariant{MoveApplied}, f.invariants())
+	assert.NotEmpty(t, f.violations[0].Stack)
+}
+
+func TestChecksInARolledBackTransactionAreDropped(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t, 1)
+	f.base.Objects = noopMoves{f.base.Objects}
+	f.rewrap(1)
+	rollback := errors.New("rollback")
+
+	err := f.tx.InTransaction(ctx, func(ctx context.Context) error {
+		_, err := f.checked.Objects.Move(ctx, f.lamp.ID, world.HeldBy(f.alice.ID), f.lamp.Version)
+		require.NoError(t, err)
+		return rollback
+	})
+	require.ErrorIs(t, err, rollback)
+	assert.Empty(t, f.violations)
+}
+
+func TestExistsReturnsReadFailures(t *testing.T) {
+	ctx := context.Background()
+	get := func(_ context.Context, _ ulid.ULID) (*world.Location, error) {
+		return nil, errors.New("connection reset")
+	}
+	_, err := exists(ctx, get, ulid.Make())
+	require.Error(t, err)
+
+	get = func(_ context.Context, _ ulid.ULID) (*world.Location, error) {
+		return nil, world.ErrNotFound
+	}
+	found, err := exists(ctx, get, ulid.Make())
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestCryptoFloat64IsAFraction(t *testing.T) {
+	for range 100 {
+		v := cryptoFloat64()
+		assert.GreaterOrEqual(t, v, 0.0)
+		assert.Less(t, v, 1.0)
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package invariant
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// violations counts broken repository post-conditions, per invariant.
+// Operators alert on any increase: each one is a storage-layer bug.
+var violations = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "holomush_world_invariant_violations_total",
+		Help: "Total world repository writes found breaking a world model invariant",
+	},
+	[]string{"invariant"},
+)
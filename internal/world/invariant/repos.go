@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// Each wrapper passes the write through unchanged and checks it only when
+// the write succeeded and the monitor samples it, after the write commits.
+
+type locationRepo struct {
+	world.LocationRepository
+	m *Monitor
+}
+
+func (r *locationRepo) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.LocationRepository.Delete(ctx, id, expectedVersion)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkLocationDeleted(ctx, "location.delete", id) })
+	}
+	return delta, err
+}
+
+type exitRepo struct {
+	world.ExitRepository
+	m *Monitor
+}
+
+func (r *exitRepo) Create(ctx context.Context, exit *world.Exit) (*wmodel.MutationDelta, error) {
+	delta, err := r.ExitRepository.Create(ctx, exit)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkExit(ctx, "exit.create", exit.ID, true) })
+	}
+	return delta, err
+}
+
+func (r *exitRepo) Update(ctx context.Context, exit *world.Exit) (*wmodel.MutationDelta, error) {
+	delta, err := r.ExitRepository.Update(ctx, exit)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkExit(ctx, "exit.update", exit.ID, false) })
+	}
+	return delta, err
+}
+
+type objectRepo struct {
+	world.ObjectRepository
+	m *Monitor
+}
+
+func (r *objectRepo) Create(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Create(ctx, obj)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkObject(ctx, "object.create", obj.ID, nil) })
+	}
+	return delta, err
+}
+
+func (r *objectRepo) Update(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Update(ctx, obj)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkObject(ctx, "object.update", obj.ID, nil) })
+	}
+	return delta, err
+}
+
+func (r *objectRepo) Move(ctx context.Context, objectID ulid.ULID, to world.Containment, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Move(ctx, objectID, to, expectedVersion)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkObject(ctx, "object.move", objectID, &to) })
+	}
+	return delta, err
+}
+
+type characterRepo struct {
+	world.CharacterRepository
+	m *Monitor
+}
+
+func (r *characterRepo) Create(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.Create(ctx, char)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkCharacter(ctx, "character.create", char.ID) })
+	}
+	return delta, err
+}
+
+func (r *characterRepo) Update(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.Update(ctx, char)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkCharacter(ctx, "character.update", char.ID) })
+	}
+	return delta, err
+}
+
+func (r *characterRepo) UpdateLocation(ctx context.Context, characterID ulid.ULID, locationID *ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.UpdateLocation(ctx, characterID, locationID, expectedVersion)
+	if err == nil && r.m.sampled() {
+		r.m.check(ctx, func(ctx context.Context) { r.m.checkCharacter(ctx, "character.update_location", characterID) })
+	}
+	return delta, err
+}
+
+// checkObject re-reads an object and checks it sits in exactly one existing
+// place, and in to when the write was a move.
+func (m *Monitor) checkObject(ctx context.Context, op string, id ulid.ULID, to *world.Containment) {
+	obj, ok := reread(ctx, m, op, id, m.repos.Objects.Get)
+	if !ok {
+		return
+	}
+	c := obj.Containment()
+	if err := c.Validate(); err != nil {
+		m.violated(ctx, Violation{
+			Invariant: ContainmentExclusive, Op: op, EntityID: id,
+			Detail: fmt.Sprintf("object %s has invalid containment: %v", id, err),
+		})
+		return
+	}
+	target := *c.ID()
+	if to != nil && (to.Type() != c.Type() || *to.ID() != target) {
+		m.violated(ctx, Violation{
+			Invariant: MoveApplied, Op: op, EntityID: id, RefID: *to.ID(),
+			Detail: fmt.Sprintf("object %s was moved to %s %s but is in %s %s", id, to.Type(), to.ID(), c.Type(), target),
+		})
+	}
+	var found bool
+	var err error
+	switch c.Type() {
+	case world.ContainmentTypeLocation:
+		found, err = exists(ctx, m.repos.Locations.Get, target)
+	case world.ContainmentTypeCharacter:
+		found, err = exists(ctx, m.repos.Characters.Get, target)
+	default:
+		found, err = exists(ctx, m.repos.Objects.Get, target)
+	}
+	if err != nil {
+		m.readFailed(ctx, op, id, err)
+		return
+	}
+	if !found {
+		m.violated(ctx, Violation{
+			Invariant: ContainmentTarget, Op: op, EntityID: id, RefID: target,
+			Detail: fmt.Sprintf("object %s is in %s %s, which does not exist", id, c.Type(), target),
+		})
+	}
+}
+
+// checkExit re-reads an exit and checks both its endpoints exist and, for a
+// newly created two-way exit, that its return exit was created with it.
+func (m *Monitor) checkExit(ctx context.Context, op string, id ulid.ULID, created bool) {
+	exit, ok := reread(ctx, m, op, id, m.repos.Exits.Get)
+	if !ok {
+		return
+	}
+	for _, end := range []struct {
+		name string
+		id   ulid.ULID
+	}{{"source", exit.FromLocationID}, {"destination", exit.ToLocationID}} {
+		found, err := exists(ctx, m.repos.Locations.Get, end.id)
+		if err != nil {
+			m.readFailed(ctx, op, id, err)
+			return
+		}
+		if !found {
+			m.violated(ctx, Violation{
+				Invariant: ExitEndpoint, Op: op, EntityID: id, RefID: end.id,
+				Detail: fmt.Sprintf("exit %s %q has %s location %s, which does not exist", id, exit.Name, end.name, end.id),
+			})
+		}
+	}
+	if !created || !exit.Bidirectional || exit.ReturnName == "" {
+		return
+	}
+	back := func(ctx context.Context, to ulid.ULID) (*world.Exit, error) {
+		return m.repos.Exits.FindByName(ctx, to, exit.ReturnName)
+	}
+	found, err := exists(ctx, back, exit.ToLocationID)
+	if err != nil {
+		m.readFailed(ctx, op, id, err)
+		return
+	}
+	if !found {
+		m.violated(ctx, Violation{
+			Invariant: ExitReturn, Op: op, EntityID: id, RefID: exit.ToLocationID,
+			Detail: fmt.Sprintf("two-way exit %s %q has no return exit %q from %s", id, exit.Name, exit.ReturnName, exit.ToLocationID),
+		})
+	}
+}
+
+// checkCharacter re-reads a character and checks its location, if any,
+// exists.
+func (m *Monitor) checkCharacter(ctx context.Context, op string, id ulid.ULID) {
+	char, ok := reread(ctx, m, op, id, m.repos.Characters.Get)
+	if !ok || char.LocationID == nil {
+		return
+	}
+	found, err := exists(ctx, m.repos.Locations.Get, *char.LocationID)
+	if err != nil {
+		m.readFailed(ctx, op, id, err)
+		return
+	}
+	if !found {
+		m.violated(ctx, Violation{
+			Invariant: CharacterLocation, Op: op, EntityID: id, RefID: *char.LocationID,
+			Detail: fmt.Sprintf("character %s is in location %s, which does not exist", id, *char.LocationID),
+		})
+	}
+}
+
+// checkLocationDeleted checks no exit still leaves a deleted location.
+func (m *Monitor) checkLocationDeleted(ctx context.Context, op string, id ulid.ULID) {
+	page, err := m.repos.Exits.ListFromLocation(ctx, id, world.ListOptions{Limit: 1})
+	if err != nil {
+		m.readFailed(ctx, op, id, err)
+		return
+	}
+	if len(page.Items) > 0 {
+		m.violated(ctx, Violation{
+			Invariant: LocationExitsRemoved, Op: op, EntityID: id, RefID: page.Items[0].ID,
+			Detail: fmt.Sprintf("deleted location %s still has exit %s %q", id, page.Items[0].ID, page.Items[0].Name),
+		})
+	}
+}
+
+// reread loads the entity a write just wrote. An entity a later write in
+// the same transaction deleted is skipped; any other failed read leaves the
+// check inconclusive and is reported.
+func reread[T any](ctx context.Context, m *Monitor, op string, id ulid.ULID, get func(context.Context, ulid.ULID) (*T, error)) (*T, bool) {
+	got, err := get(ctx, id)
+	if errors.Is(err, world.ErrNotFound) {
+		return nil, false
+	}
+	if err != nil {
+		m.readFailed(ctx, op, id, err)
+		return nil, false
+	}
+	return got, true
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// Build journal defaults: how long a builder change stays undoable and how
+// many changes are kept per subject.
+const (
+	DefaultUndoWindow   = 30 * time.Minute
+	DefaultJournalDepth = 50
+)
+
+// JournalOp is the kind of build change a JournalEntry records.
+type JournalOp string
+
+// Recorded build operations.
+const (
+	JournalCreate JournalOp = "create"
+	JournalUpdate JournalOp = "update"
+	JournalDelete JournalOp = "delete"
+	JournalMove   JournalOp = "move"
+)
+
+// JournalEntry is one build change and the state needed to invert it. The
+// before and after snapshots are private copies: *Location, *Exit, or
+// *Object, or a Containment for a move. A nil before means the entity was
+// created; a nil after means it was deleted.
+type JournalEntry struct {
+	Op        JournalOp
+	Aggregate wmodel.AggregateType
+	EntityID  ulid.ULID
+	At        time.Time
+
+	before any
+	after  any
+	// exits holds the outgoing exits a location delete cascaded away, so
+	// undoing the delete can recreate them.
+	exits []*Exit
+}
+
+// BuildJournal records the world changes each subject makes through Service
+// so the most recent ones can be reverted with Service.UndoLast and
+// reapplied with Service.RedoLast. Entries older than the window are not
+// replayed, and each subject keeps at most depth entries. The journal is
+// held in memory: it is a safety net for recent mistakes, not an audit
+// trail, and does not survive a restart.
+type BuildJournal struct {
+	// replayMu serializes UndoLast and RedoLast, which update entry
+	// snapshots in place as they replay them.
+	replayMu sync.Mutex
+
+	mu     sync.Mutex
+	window time.Duration
+	depth  int
+	now    func() time.Time
+	undo   map[string][]*JournalEntry
+	redo   map[string][]*JournalEntry
+}
+
+// NewBuildJournal creates a BuildJournal. Non-positive window or depth use
+// DefaultUndoWindow and DefaultJournalDepth.
+func NewBuildJournal(window time.Duration, depth int) *BuildJournal {
+	if window <= 0 {
+		window = DefaultUndoWindow
+	}
+	if depth <= 0 {
+		depth = DefaultJournalDepth
+	}
+	return &BuildJournal{
+		window: window,
+		depth:  depth,
+		now:    time.Now,
+		undo:   make(map[string][]*JournalEntry),
+		redo:   make(map[string][]*JournalEntry),
+	}
+}
+
+// Entries returns subject's undoable entries within the window, newest
+// first.
+func (j *BuildJournal) Entries(subject string) []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.expireLocked(subject)
+	stack := j.undo[subject]
+	out := make([]JournalEntry, 0, len(stack))
+	for i := len(stack) - 1; i >= 0; i-- {
+		out = append(out, *stack[i])
+	}
+	return out
+}
+
+// record appends a new change for subject. A fresh change invalidates
+// anything that was undone before it, as in any editor.
+func (j *BuildJournal) record(subject string, entry *JournalEntry) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry.At = j.now()
+	stack := append(j.undo[subject], entry)
+	if len(stack) > j.depth {
+		stack = slices.Delete(stack, 0, len(stack)-j.depth)
+	}
+	j.undo[subject] = stack
+	delete(j.redo, subject)
+}
+
+// latest returns the newest entry on subject's undo (or redo) stack that is
+// still within the window, or nil.
+func (j *BuildJournal) latest(subject string, redo bool) *JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.expireLocked(subject)
+	stack := j.undo[subject]
+	if redo {
+		stack = j.redo[subject]
+	}
+	if len(stack) == 0 {
+		return nil
+	}
+	return stack[len(stack)-1]
+}
+
+// settle moves entry from one of subject's stacks to the other once its
+// replay has committed. The entry is located by identity so a change recorded
+// concurrently is left in place.
+func (j *BuildJournal) settle(subject string, entry *JournalEntry, redone bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	from, to := j.undo, j.redo
+	if redone {
+		from, to = j.redo, j.undo
+	}
+	if i := slices.Index(from[subject], entry); i >= 0 {
+		from[subject] = slices.Delete(from[subject], i, i+1)
+	}
+	entry.At = j.now()
+	to[subject] = append(to[subject], entry)
+}
+
+// expireLocked drops subject's entries that have aged out of the window.
+func (j *BuildJournal) expireLocked(subject string) {
+	cutoff := j.now().Add(-j.window)
+	for _, stacks := range []map[string][]*JournalEntry{j.undo, j.redo} {
+		stack := stacks[subject]
+		i := 0
+		for i < len(stack) && !stack[i].At.After(cutoff) {
+			i++
+		}
+		switch {
+		case i == len(stack):
+			delete(stacks, subject)
+		case i > 0:
+			stacks[subject] = slices.Delete(stack, 0, i)
+		}
+	}
+}
+
+// journalReplayKey marks a context whose writes are an undo or redo and so
+// must not themselves be journaled.
+type journalReplayKey struct{}
+
+func withJournalReplay(ctx context.Context) context.Context {
+	return context.WithValue(ctx, journalReplayKey{}, true)
+}
+
+func isJournalReplay(ctx context.Context) bool {
+	replay, _ := ctx.Value(journalReplayKey{}).(bool)
+	return replay
+}
+
+func cloneLocation(loc *Location) *Location {
+	c := *loc
+	c.Tags = slices.Clone(loc.Tags)
+	return &c
+}
+
+func cloneExit(exit *Exit) *Exit {
+	c := *exit
+	c.Aliases = slices.Clone(exit.Aliases)
+	c.VisibleTo = slices.Clone(exit.VisibleTo)
+	c.LockData = maps.Clone(exit.LockData)
+	return &c
+}
+
+func cloneObject(obj *Object) *Object {
+	c := *obj
+	c.Verbs = slices.Clone(obj.Verbs)
+	c.Tags = slices.Clone(obj.Tags)
+	return &c
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+func newTestJournal(window time.Duration, depth int) (*BuildJournal, *time.Time) {
+	j := NewBuildJournal(window, depth)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	j.now = func() time.Time { return now }
+	return j, &now
+}
+
+func journalEntry() *JournalEntry {
+	return &JournalEntry{Op: JournalCreate, Aggregate: wmodel.AggregateObject, EntityID: ulid.Make()}
+}
+
+func TestBuildJournalExpiresEntriesOutsideWindow(t *testing.T) {
+	j, now := newTestJournal(10*time.Minute, 0)
+	j.record("builder", journalEntry())
+	*now = now.Add(5 * time.Minute)
+	j.record("builder", journalEntry())
+
+	assert.Len(t, j.Entries("builder"), 2)
+	*now = now.Add(6 * time.Minute)
+	assert.Len(t, j.Entries("builder"), 1, "the first entry aged out")
+	*now = now.Add(10 * time.Minute)
+	assert.Nil(t, j.latest("builder", false))
+}
+
+func TestBuildJournalKeepsNewestDepthEntries(t *testing.T) {
+	j, _ := newTestJournal(0, 2)
+	first, second, third := journalEntry(), journalEntry(), journalEntry()
+	j.record("builder", first)
+	j.record("builder", second)
+	j.record("builder", third)
+
+	entries := j.Entries("builder")
+	require.Len(t, entries, 2)
+	assert.Equal(t, third.EntityID, entries[0].EntityID, "newest first")
+	assert.Equal(t, second.EntityID, entries[1].EntityID)
+}
+
+func TestBuildJournalNewChangeClearsRedo(t *testing.T) {
+	j, _ := newTestJournal(0, 0)
+	entry := journalEntry()
+	j.record("builder", entry)
+	j.settle("builder", entry, false)
+	require.Same(t, entry, j.latest("builder", true))
+
+	j.record("builder", journalEntry())
+	assert.Nil(t, j.latest("builder", true))
+}
+
+func TestBuildJournalIsPerSubject(t *testing.T) {
+	j, _ := newTestJournal(0, 0)
+	j.record("alice", journalEntry())
+
+	assert.Empty(t, j.Entries("bob"))
+	assert.Len(t, j.Entries("alice"), 1)
+}
@@ -53,8 +53,25 @@ type Location struct {
 	Description  string
 	OwnerID      *ulid.ULID
 	ReplayPolicy string
-	CreatedAt    time.Time
-	ArchivedAt   *time.Time
+	// ZoneID names the zone the location belongs to, or is empty for none.
+	// BroadcastToZone reaches every location sharing a zone.
+	ZoneID string
+	// ParentID is the location this one sits inside in the area hierarchy
+	// (area → building → room), or nil for a top-level location. Property
+	// lookups through Service.LocationProperties fall back to ancestors.
+	ParentID *ulid.ULID
+	// MaxOccupancy caps how many characters may be in the location at once,
+	// or is 0 for no cap. OverflowID names the location characters are sent
+	// to instead while it is full, or is nil to turn them away. See
+	// Service.AdmitCharacter.
+	MaxOccupancy int
+	OverflowID   *ulid.ULID
+	// Tags are the location's free-form labels (see ValidateTag), kept
+	// sorted. Change them with Service.TagLocation/UntagLocation; Update
+	// does not write them.
+	Tags       []string
+	CreatedAt  time.Time
+	ArchivedAt *time.Time
 	// Version is the optimistic-concurrency version (MODEL-03). It carries the
 	// read version back into a guarded CAS write (... WHERE id=$1 AND version=$2)
 	// and is refreshed by the repo to the committed version after a successful
@@ -100,6 +117,20 @@ func (l *Location) Validate() error {
 	if err := ValidateDescription(l.Description); err != nil {
 		return err
 	}
+	if l.ZoneID != "" {
+		if err := ValidateZoneID(l.ZoneID); err != nil {
+			return err
+		}
+	}
+	if l.ParentID != nil && *l.ParentID == l.ID {
+		return &ValidationError{Field: "parent_id", Message: "cannot be the location itself"}
+	}
+	if l.MaxOccupancy < 0 {
+		return &ValidationError{Field: "max_occupancy", Message: "cannot be negative"}
+	}
+	if l.OverflowID != nil && *l.OverflowID == l.ID {
+		return &ValidationError{Field: "overflow_id", Message: "cannot be the location itself"}
+	}
 	return l.Type.Validate()
 }
 
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// MaxLocationDepth bounds how many ancestors a location may have. It keeps
+// the ancestor walk behind every inherited property lookup short and stops a
+// corrupt parent chain from looping forever.
+const MaxLocationDepth = 16
+
+// checkLocationParent verifies loc.ParentID names an existing location and
+// that making it the parent neither closes a cycle nor pushes the chain past
+// MaxLocationDepth. A nil ParentID always passes.
+//
+// Returns LOCATION_PARENT_NOT_FOUND for a missing parent,
+// LOCATION_PARENT_CYCLE when loc is already an ancestor of the parent, and
+// LOCATION_PARENT_TOO_DEEP past MaxLocationDepth.
+func (s *Service) checkLocationParent(ctx context.Context, loc *Location) error {
+	if loc.ParentID == nil {
+		return nil
+	}
+	next := *loc.ParentID
+	for depth := 1; ; depth++ {
+		if next == loc.ID {
+			return oops.Code("LOCATION_PARENT_CYCLE").
+				With("id", loc.ID.String()).
+				With("parent_id", loc.ParentID.String()).
+				Errorf("location %s cannot be placed inside its own descendant", loc.ID)
+		}
+		if depth > MaxLocationDepth {
+			return oops.Code("LOCATION_PARENT_TOO_DEEP").
+				With("id", loc.ID.String()).
+				With("max_depth", MaxLocationDepth).
+				Errorf("location hierarchy exceeds %d levels", MaxLocationDepth)
+		}
+		ancestor, err := s.locationRepo.Get(ctx, next)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) && next == *loc.ParentID {
+				return oops.Code("LOCATION_PARENT_NOT_FOUND").
+					With("parent_id", next.String()).
+					Wrapf(err, "parent location %s", next)
+			}
+			if errors.Is(err, ErrNotFound) {
+				return nil
+			}
+			return oops.Code("LOCATION_PARENT_CHECK_FAILED").With("parent_id", next.String()).Wrap(err)
+		}
+		if ancestor.ParentID == nil {
+			return nil
+		}
+		next = *ancestor.ParentID
+	}
+}
+
+// LocationAncestors returns the chain of locations above id, nearest parent
+// first. A top-level location yields an empty slice. It checks read
+// authorization on id only; the ancestors are returned regardless of the
+// subject's access to them, because a room's place in its area is part of
+// the room.
+func (s *Service) LocationAncestors(ctx context.Context, subjectID string, id ulid.ULID) ([]*Location, error) {
+	loc, err := s.GetLocation(ctx, subjectID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.locationAncestors(ctx, loc)
+}
+
+// locationAncestors walks loc's parent chain, stopping at MaxLocationDepth.
+// A parent deleted mid-walk ends the chain early.
+func (s *Service) locationAncestors(ctx context.Context, loc *Location) ([]*Location, error) {
+	var ancestors []*Location
+	seen := map[ulid.ULID]bool{loc.ID: true}
+	next := loc.ParentID
+	for next != nil && len(ancestors) < MaxLocationDepth && !seen[*next] {
+		seen[*next] = true
+		parent, err := s.locationRepo.Get(ctx, *next)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				break
+			}
+			return nil, oops.Code("LOCATION_GET_FAILED").With("parent_id", next.String()).Wrap(err)
+		}
+		ancestors = append(ancestors, parent)
+		next = parent.ParentID
+	}
+	return ancestors, nil
+}
+
+// ListChildLocations returns the locations directly inside parentID after
+// checking read authorization on each; children the subject may not read
+// are left out.
+func (s *Service) ListChildLocations(ctx context.Context, subjectID string, parentID ulid.ULID) ([]*Location, error) {
+	if s.locationRepo == nil {
+		return nil, oops.Code("LOCATION_LIST_FAILED").Errorf("location repository not configured")
+	}
+	children, err := s.locationRepo.ListChildren(ctx, parentID)
+	if err != nil {
+		return nil, oops.Code("LOCATION_LIST_FAILED").With("parent_id", parentID.String()).Wrap(err)
+	}
+	readable := make([]*Location, 0, len(children))
+	for _, child := range children {
+		err := s.checkAccess(ctx, subjectID, "read", access.LocationResource(child.ID.String()), prefixLocation)
+		if err == nil {
+			readable = append(readable, child)
+			continue
+		}
+		if !errors.Is(err, ErrPermissionDenied) {
+			return nil, err
+		}
+	}
+	return readable, nil
+}
+
+// LocationProperties returns the properties in effect at a location: its
+// own readable properties plus those inherited from its ancestors, where a
+// name set nearer the location hides the same name further up. Each
+// property keeps its ParentID, so callers can tell an inherited value from
+// a local one. Read filtering is per property, as in
+// ListPropertiesByParent; a hidden local property does not unmask an
+// ancestor's value of the same name.
+func (s *Service) LocationProperties(ctx context.Context, subjectID string, id ulid.ULID) ([]*EntityProperty, error) {
+	if s.propertyRepo == nil {
+		return nil, oops.Code("PROPERTY_QUERY_FAILED").Errorf("property repository not configured")
+	}
+	loc, err := s.GetLocation(ctx, subjectID, id)
+	if err != nil {
+		return nil, err
+	}
+	ancestors, err := s.locationAncestors(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var effective []*EntityProperty
+	for _, level := range append([]*Location{loc}, ancestors...) {
+		all, err := s.propertyRepo.ListByParent(ctx, "location", level.ID)
+		if err != nil {
+			return nil, oops.Code("PROPERTY_QUERY_FAILED").Wrapf(err, "list properties for location %s", level.ID)
+		}
+		for _, prop := range all {
+			if seen[prop.Name] {
+				continue
+			}
+			seen[prop.Name] = true
+			checkErr := s.checkAccess(ctx, subjectID, "read", access.PropertyResource(prop.ID.String()), prefixProperty)
+			if checkErr == nil {
+				effective = append(effective, prop)
+				continue
+			}
+			if !errors.Is(checkErr, ErrPermissionDenied) {
+				return nil, checkErr
+			}
+		}
+	}
+	return effective, nil
+}
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// locationChain returns area → building → room, each the parent of the
+// next, and a mock repository serving all three by ID.
+func locationChain(t *testing.T) (area, building, room *world.Location, repo *worldtest.MockLocationRepository) {
+	t.Helper()
+	repo = worldtest.NewMockLocationRepository(t)
+	var locs []*world.Location
+	for _, name := range []string{"Harbor District", "Customs House", "Records Room"} {
+		loc, err := world.NewLocation(name, "", world.LocationTypePersistent)
+		require.NoError(t, err)
+		if len(locs) > 0 {
+			parent := locs[len(locs)-1].ID
+			loc.ParentID = &parent
+		}
+		repo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Maybe()
+		locs = append(locs, loc)
+	}
+	return locs[0], locs[1], locs[2], repo
+}
+
+func TestLocationValidateRejectsSelfParent(t *testing.T) {
+	loc, err := world.NewLocation("Loop", "", world.LocationTypePersistent)
+	require.NoError(t, err)
+	loc.ParentID = &loc.ID
+
+	var verr *world.ValidationError
+	require.ErrorAs(t, loc.Validate(), &verr)
+	assert.Equal(t, "parent_id", verr.Field)
+}
+
+func TestLocationPayloadCarriesParentID(t *testing.T) {
+	_, building, room, _ := locationChain(t)
+
+	raw, err := world.BuildLocationPayload(building)
+	require.NoError(t, err)
+	var payload world.LocationChangePayload
+	require.NoError(t, json.Unmarshal(raw, &payload))
+	assert.Equal(t, *building.ParentID, ulid.MustParse(payload.ParentID))
+
+	room.ParentID = nil
+	raw, err = world.BuildLocationPayload(room)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "parent_id", "a top-level location omits parent_id")
+}
+
+func TestWorldService_LocationParentChecks(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	t.Run("placing an area inside its own room is a cycle", func(t *testing.T) {
+		area, _, room, repo := locationChain(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: repo, Engine: policytest.AllowAllEngine()})
+
+		update := *area
+		update.ParentID = &room.ID
+		err := svc.UpdateLocation(ctx, subjectID, &update)
+		errutil.AssertErrorCode(t, err, "LOCATION_PARENT_CYCLE")
+	})
+
+	t.Run("a missing parent is rejected", func(t *testing.T) {
+		repo := worldtest.NewMockLocationRepository(t)
+		missing := ulid.Make()
+		repo.EXPECT().Get(mock.Anything, missing).Return(nil, world.ErrNotFound).Once()
+		svc := world.NewService(world.ServiceConfig{LocationRepo: repo, Engine: policytest.AllowAllEngine()})
+
+		loc, err := world.NewLocation("Annex", "", world.LocationTypePersistent)
+		require.NoError(t, err)
+		loc.ParentID = &missing
+		err = svc.CreateLocation(ctx, subjectID, loc)
+		errutil.AssertErrorCode(t, err, "LOCATION_PARENT_NOT_FOUND")
+	})
+
+	t.Run("a chain past the depth limit is rejected", func(t *testing.T) {
+		repo := worldtest.NewMockLocationRepository(t)
+		var parent *ulid.ULID
+		for range world.MaxLocationDepth + 1 {
+			loc, err := world.NewLocation("Level", "", world.LocationTypePersistent)
+			require.NoError(t, err)
+			loc.ParentID = parent
+			repo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Maybe()
+			parent = &loc.ID
+		}
+		svc := world.NewService(world.ServiceConfig{LocationRepo: repo, Engine: policytest.AllowAllEngine()})
+
+		loc, err := world.NewLocation("Too Deep", "", world.LocationTypePersistent)
+		require.NoError(t, err)
+		loc.ParentID = parent
+		err = svc.CreateLocation(ctx, subjectID, loc)
+		errutil.AssertErrorCode(t, err, "LOCATION_PARENT_TOO_DEEP")
+	})
+}
+
+func TestWorldService_LocationProperties(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	prop := func(parent *world.Location, name, value string) *world.EntityProperty {
+		return &world.EntityProperty{
+			ID: ulid.Make(), ParentType: "location", ParentID: parent.ID, Name: name, Value: strPtr(value),
+		}
+	}
+
+	t.Run("a room inherits area properties and overrides nearer ones", func(t *testing.T) {
+		area, building, room, repo := locationChain(t)
+		areaWeather := prop(area, "weather", "rain")
+		areaLighting := prop(area, "lighting", "dusk")
+		buildingLighting := prop(building, "lighting", "lamplit")
+		roomSmell := prop(room, "smell", "dust")
+		props := worldtest.NewMockPropertyRepository(t)
+		props.EXPECT().ListByParent(mock.Anything, "location", room.ID).Return([]*world.EntityProperty{roomSmell}, nil)
+		props.EXPECT().ListByParent(mock.Anything, "location", building.ID).Return([]*world.EntityProperty{buildingLighting}, nil)
+		props.EXPECT().ListByParent(mock.Anything, "location", area.ID).Return([]*world.EntityProperty{areaWeather, areaLighting}, nil)
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: repo, PropertyRepo: props, Engine: policytest.AllowAllEngine(),
+		})
+
+		got, err := svc.LocationProperties(ctx, subjectID, room.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.EntityProperty{roomSmell, buildingLighting, areaWeather}, got)
+	})
+
+	t.Run("a hidden local property does not unmask the inherited one", func(t *testing.T) {
+		area, building, room, repo := locationChain(t)
+		secret := prop(room, "lighting", "pitch black")
+		props := worldtest.NewMockPropertyRepository(t)
+		props.EXPECT().ListByParent(mock.Anything, "location", room.ID).Return([]*world.EntityProperty{secret}, nil)
+		props.EXPECT().ListByParent(mock.Anything, "location", building.ID).Return(nil, nil)
+		props.EXPECT().ListByParent(mock.Anything, "location", area.ID).
+			Return([]*world.EntityProperty{prop(area, "lighting", "dusk")}, nil)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", access.LocationResource(room.ID.String()))
+		svc := world.NewService(world.ServiceConfig{LocationRepo: repo, PropertyRepo: props, Engine: engine})
+
+		got, err := svc.LocationProperties(ctx, subjectID, room.ID)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("ancestors lists the chain nearest first", func(t *testing.T) {
+		area, building, room, repo := locationChain(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: repo, Engine: policytest.AllowAllEngine()})
+
+		got, err := svc.LocationAncestors(ctx, subjectID, room.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Location{building, area}, got)
+	})
+}
@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// CharacterRepository implements world.CharacterRepository over a Store.
+type CharacterRepository struct {
+	store *Store
+}
+
+// NewCharacterRepository creates a new CharacterRepository.
+func NewCharacterRepository(store *Store) *CharacterRepository {
+	return &CharacterRepository{store: store}
+}
+
+// Get retrieves a character by ID.
+func (r *CharacterRepository) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
+	var char *world.Character
+	err := r.store.read(ctx, func(t *tables) error {
+		stored, ok := t.characters[id]
+		if !ok {
+			return oops.Code("CHARACTER_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		char = cloneCharacter(stored)
+		return nil
+	})
+	return char, err
+}
+
+// FindByName retrieves the character named name, ignoring case; the lowest
+// ID wins when several match.
+func (r *CharacterRepository) FindByName(ctx context.Context, name string) (*world.Character, error) {
+	chars, err := r.list(ctx, func(c *world.Character) bool { return strings.EqualFold(c.Name, name) }, func(a, b *world.Character) int {
+		return a.ID.Compare(b.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(chars) == 0 {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").With("name", name).Wrap(world.ErrNotFound)
+	}
+	return chars[0], nil
+}
+
+// Create persists a new character. Tags are not written; the character
+// starts untagged at version 1. Callers must validate the character first.
+func (r *CharacterRepository) Create(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.characters[char.ID]; ok {
+			return oops.Code("CHARACTER_CREATE_FAILED").Wrap(duplicateKey("create character", char.ID))
+		}
+		if err := checkCharacterRow(t, "create character", char); err != nil {
+			return oops.Code("CHARACTER_CREATE_FAILED").Wrap(err)
+		}
+		stored := storedCharacter(char)
+		stored.Tags = nil
+		stored.Version = 1
+		t.characters[char.ID] = stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	char.Version = 1
+	return primaryDeltaVersioned(wmodel.AggregateCharacter, char.ID, false, 0, 1), nil
+}
+
+// Update modifies an existing character's name, description, location,
+// visibility, and effects with a version-predicated CAS (MODEL-03). A stale
+// char.Version fails with WORLD_CONCURRENT_EDIT, a missing row with
+// CHARACTER_NOT_FOUND; char.Version 0 is an unversioned write. On success
+// char.Version is refreshed to the committed value.
+func (r *CharacterRepository) Update(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.characters[char.ID]
+		if !ok {
+			return oops.Code("CHARACTER_NOT_FOUND").With("id", char.ID.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(char.ID, char.Version, prior.Version); err != nil {
+			return err
+		}
+		if err := checkCharacterRow(t, "update character", char); err != nil {
+			return oops.Code("CHARACTER_UPDATE_FAILED").Wrap(err)
+		}
+		stored := storedCharacter(char)
+		stored.PlayerID = prior.PlayerID
+		stored.Tags = prior.Tags
+		stored.CreatedAt = prior.CreatedAt
+		stored.Version = prior.Version + 1
+		t.characters[char.ID] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	char.Version = newVersion
+	return primaryDeltaVersioned(wmodel.AggregateCharacter, char.ID, false, newVersion-1, newVersion), nil
+}
+
+// Delete removes a character by ID with a version-predicated CAS
+// (MODEL-03). Its scene participation goes with it. A character that owns
+// locations or objects, or holds objects, cannot be deleted.
+func (r *CharacterRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var delta *wmodel.MutationDelta
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		stored, ok := t.characters[id]
+		if !ok {
+			return oops.Code("CHARACTER_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(id, expectedVersion, stored.Version); err != nil {
+			return err
+		}
+		for _, l := range t.locations {
+			if l.OwnerID != nil && *l.OwnerID == id {
+				return oops.Code("CHARACTER_DELETE_FAILED").Wrap(fkReferenced("delete character", id, "locations", "owner_id"))
+			}
+		}
+		for _, o := range t.objects {
+			if o.OwnerID != nil && *o.OwnerID == id {
+				return oops.Code("CHARACTER_DELETE_FAILED").Wrap(fkReferenced("delete character", id, "objects", "owner_id"))
+			}
+			// ON DELETE SET NULL would leave the object nowhere.
+			if held := o.HeldByCharacterID(); held != nil && *held == id {
+				return oops.Code("CHARACTER_DELETE_FAILED").Wrap(checkViolation("delete character", "chk_exactly_one_containment", o.ID))
+			}
+		}
+		for sceneID, ps := range t.participants {
+			t.participants[sceneID] = slices.DeleteFunc(slices.Clone(ps), func(p participant) bool {
+				return p.characterID == id
+			})
+		}
+		delete(t.preferences, id)
+		delete(t.characters, id)
+		delta = primaryDeltaVersioned(wmodel.AggregateCharacter, id, true, stored.Version, 0)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// GetByLocation returns a page of the characters at a location, ordered by
+// (name, id).
+func (r *CharacterRepository) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	var afterName string
+	var afterID ulid.ULID
+	if opts.Cursor != "" {
+		var err error
+		if afterName, afterID, err = world.DecodeCursor(opts.Cursor); err != nil {
+			return world.Page[*world.Character]{}, err
+		}
+	}
+	chars, err := r.list(ctx, func(c *world.Character) bool {
+		return c.LocationID != nil && *c.LocationID == locationID &&
+			(opts.Cursor == "" || byNameID(c.Name, c.ID, afterName, afterID) > 0)
+	}, byCharacterName)
+	if err != nil {
+		return world.Page[*world.Character]{}, err
+	}
+	size := opts.PageSize()
+	if len(chars) > size+1 {
+		chars = chars[:size+1]
+	}
+	return world.NewPage(chars, size, func(c *world.Character) string {
+		return world.EncodeCursor(c.Name, c.ID)
+	}), nil
+}
+
+// ListByPlayer returns every character owned by the given player, ordered
+// by name.
+func (r *CharacterRepository) ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*world.Character, error) {
+	return r.list(ctx, func(c *world.Character) bool { return c.PlayerID == playerID }, byCharacterName)
+}
+
+// ListByTag returns the characters carrying tag, ordered by ID.
+func (r *CharacterRepository) ListByTag(ctx context.Context, tag string) ([]*world.Character, error) {
+	return r.list(ctx, func(c *world.Character) bool { return hasTag(c.Tags, tag) }, func(a, b *world.Character) int {
+		return a.ID.Compare(b.ID)
+	})
+}
+
+// SetTags replaces a character's tags with a version-predicated CAS
+// (MODEL-03).
+func (r *CharacterRepository) SetTags(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return r.bump(ctx, characterID, expectedVersion, func(_ *tables, c *world.Character) error {
+		c.Tags = storedTags(tags)
+		return nil
+	})
+}
+
+// UpdateLocation moves a character to a new location, or out of the world
+// when locationID is nil, with a version-predicated CAS (MODEL-03).
+func (r *CharacterRepository) UpdateLocation(ctx context.Context, characterID ulid.ULID, locationID *ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return r.bump(ctx, characterID, expectedVersion, func(t *tables, c *world.Character) error {
+		if locationID != nil {
+			if _, ok := t.locations[*locationID]; !ok {
+				return oops.Code("CHARACTER_MOVE_FAILED").Wrap(fkViolation("move character", "location_id", *locationID))
+			}
+		}
+		c.LocationID = cloneID(locationID)
+		return nil
+	})
+}
+
+// UpdatePreferences writes a character's whole preferences bag, which must
+// be JSON, with a version-predicated CAS (MODEL-03).
+func (r *CharacterRepository) UpdatePreferences(ctx context.Context, characterID ulid.ULID, prefs []byte, expectedVersion int) (*wmodel.MutationDelta, error) {
+	if !json.Valid(prefs) {
+		return nil, oops.Code("CHARACTER_PREFERENCES_UPDATE_FAILED").
+			With("character_id", characterID.String()).
+			Errorf("preferences are not valid JSON")
+	}
+	return r.bump(ctx, characterID, expectedVersion, func(t *tables, _ *world.Character) error {
+		t.preferences[characterID] = slices.Clone(prefs)
+		return nil
+	})
+}
+
+// bump applies change to a copy of the character under the version CAS and
+// stores it at the next version. An error from change leaves the character
+// as it was.
+func (r *CharacterRepository) bump(ctx context.Context, characterID ulid.ULID, expectedVersion int, change func(*tables, *world.Character) error) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.characters[characterID]
+		if !ok {
+			return oops.Code("CHARACTER_NOT_FOUND").With("character_id", characterID.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(characterID, expectedVersion, prior.Version); err != nil {
+			return err
+		}
+		stored := cloneCharacter(prior)
+		if err := change(t, stored); err != nil {
+			return err
+		}
+		stored.Version++
+		t.characters[characterID] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primaryDeltaVersioned(wmodel.AggregateCharacter, characterID, false, newVersion-1, newVersion), nil
+}
+
+// IsOwnedByPlayer checks if a character is owned by a specific player.
+// Returns false (not an error) if the character does not exist.
+func (r *CharacterRepository) IsOwnedByPlayer(ctx context.Context, characterID, playerID ulid.ULID) (bool, error) {
+	var owned bool
+	err := r.store.read(ctx, func(t *tables) error {
+		c, ok := t.characters[characterID]
+		owned = ok && c.PlayerID == playerID
+		return nil
+	})
+	return owned, err
+}
+
+// GetNamesByIDs returns a map[id]name for the given character IDs.
+// Missing IDs are absent from the result (not an error).
+func (r *CharacterRepository) GetNamesByIDs(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]string, error) {
+	out := make(map[ulid.ULID]string, len(ids))
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, id := range ids {
+			if c, ok := t.characters[id]; ok {
+				out[id] = c.Name
+			}
+		}
+		return nil
+	})
+	return out, err
+}
+
+// list returns copies of the characters match accepts, sorted by cmp.
+func (r *CharacterRepository) list(ctx context.Context, match func(*world.Character) bool, cmp func(a, b *world.Character) int) ([]*world.Character, error) {
+	out := make([]*world.Character, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, c := range t.characters {
+			if match(c) {
+				out = append(out, cloneCharacter(c))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(out, cmp)
+	return out, nil
+}
+
+// checkCharacterRow enforces the characters location foreign key and
+// visibility check.
+func checkCharacterRow(t *tables, operation string, char *world.Character) error {
+	if err := char.Visibility.Normalize().Validate(); err != nil {
+		return checkViolation(operation, "characters_visibility_check", char.ID)
+	}
+	if char.LocationID != nil {
+		if _, ok := t.locations[*char.LocationID]; !ok {
+			return fkViolation(operation, "location_id", *char.LocationID)
+		}
+	}
+	return nil
+}
+
+// storedCharacter returns the row the postgres repository would store for
+// char: its visibility normalized and an empty effect list read back as nil.
+func storedCharacter(char *world.Character) *world.Character {
+	stored := cloneCharacter(char)
+	stored.Visibility = char.Visibility.Normalize()
+	if len(stored.Effects) == 0 {
+		stored.Effects = nil
+	}
+	return stored
+}
+
+// cloneCharacter returns a copy of c sharing no memory with it.
+func cloneCharacter(c *world.Character) *world.Character {
+	clone := *c
+	clone.LocationID = cloneID(c.LocationID)
+	clone.Effects = slices.Clone(c.Effects)
+	clone.Tags = storedTags(c.Tags)
+	return &clone
+}
+
+func byCharacterName(a, b *world.Character) int {
+	return byNameID(a.Name, a.ID, b.Name, b.ID)
+}
+
+// Compile-time interface check.
+var _ world.CharacterRepository = (*CharacterRepository)(nil)
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestCharacterRepository_CreateUpdate(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	alice := r.character(ctx, t, "Alice", hall.ID)
+
+	got, err := r.characters.Get(ctx, alice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, world.CharacterVisible, got.Visibility, "zero visibility is stored as visible")
+	assert.Equal(t, 1, got.Version)
+
+	got.Visibility = "hazy"
+	_, err = r.characters.Update(ctx, got)
+	assert.ErrorIs(t, err, memory.ErrCheckViolation)
+
+	got.Visibility = world.CharacterDark
+	_, err = r.characters.Update(ctx, got)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+
+	_, err = r.characters.Get(ctx, ulid.Make())
+	errutil.AssertErrorCode(t, err, "CHARACTER_NOT_FOUND")
+}
+
+func TestCharacterRepository_UpdateLocation(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+	alice := r.character(ctx, t, "Alice", hall.ID)
+
+	delta, err := r.characters.UpdateLocation(ctx, alice.ID, &yard.ID, alice.Version)
+	require.NoError(t, err)
+	assert.Equal(t, 2, delta.Primary.AfterVersion)
+
+	_, err = r.characters.UpdateLocation(ctx, alice.ID, &hall.ID, alice.Version)
+	errutil.AssertErrorCode(t, err, world.CodeConcurrentEdit)
+
+	missing := ulid.Make()
+	_, err = r.characters.UpdateLocation(ctx, alice.ID, &missing, 0)
+	assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+
+	page, err := r.characters.GetByLocation(ctx, yard.ID, world.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, alice.ID, page.Items[0].ID)
+}
+
+func TestCharacterRepository_GetByLocationPages(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	for _, name := range []string{"Carol", "Alice", "Bob"} {
+		r.character(ctx, t, name, hall.ID)
+	}
+
+	var names []string
+	opts := world.ListOptions{Limit: 2}
+	for {
+		page, err := r.characters.GetByLocation(ctx, hall.ID, opts)
+		require.NoError(t, err)
+		for _, c := range page.Items {
+			names = append(names, c.Name)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		opts.Cursor = page.NextCursor
+	}
+	assert.Equal(t, []string{"Alice", "Bob", "Carol"}, names)
+}
+
+func TestCharacterRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fails while holding an object", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		alice := r.character(ctx, t, "Alice", hall.ID)
+		r.object(ctx, t, "Lamp", heldBy(alice.ID))
+
+		_, err := r.characters.Delete(ctx, alice.ID, 0)
+		assert.ErrorIs(t, err, memory.ErrCheckViolation)
+	})
+
+	t.Run("removes scene participation", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		scene := r.scene(ctx, t, "Tea", nil)
+		alice := r.character(ctx, t, "Alice", hall.ID)
+		require.NoError(t, r.store.AddParticipant(ctx, scene.ID, alice.ID, world.RoleOwner))
+
+		_, err := r.characters.Delete(ctx, alice.ID, alice.Version)
+		require.NoError(t, err)
+		participants, err := r.scenes.ListParticipants(ctx, scene.ID)
+		require.NoError(t, err)
+		assert.Empty(t, participants)
+	})
+}
+
+func TestCharacterRepository_Lookups(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	alice := r.character(ctx, t, "Alice", hall.ID)
+
+	owned, err := r.characters.IsOwnedByPlayer(ctx, alice.ID, alice.PlayerID)
+	require.NoError(t, err)
+	assert.True(t, owned)
+	owned, err = r.characters.IsOwnedByPlayer(ctx, ulid.Make(), alice.PlayerID)
+	require.NoError(t, err)
+	assert.False(t, owned)
+
+	names, err := r.characters.GetNamesByIDs(ctx, []ulid.ULID{alice.ID, ulid.Make()})
+	require.NoError(t, err)
+	assert.Equal(t, map[ulid.ULID]string{alice.ID: "Alice"}, names)
+
+	found, err := r.characters.FindByName(ctx, "ALICE")
+	require.NoError(t, err)
+	assert.Equal(t, alice.ID, found.ID)
+	_, err = r.characters.FindByName(ctx, "Bob")
+	require.ErrorIs(t, err, world.ErrNotFound)
+
+	mine, err := r.characters.ListByPlayer(ctx, alice.PlayerID)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+
+	_, err = r.characters.UpdatePreferences(ctx, alice.ID, []byte("{"), 0)
+	errutil.AssertErrorCode(t, err, "CHARACTER_PREFERENCES_UPDATE_FAILED")
+	_, err = r.characters.UpdatePreferences(ctx, alice.ID, []byte(`{"pager":"on"}`), 0)
+	require.NoError(t, err)
+}
@@ -0,0 +1,511 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// ExitRepository implements world.ExitRepository over a Store.
+type ExitRepository struct {
+	store *Store
+}
+
+// NewExitRepository creates a new ExitRepository.
+func NewExitRepository(store *Store) *ExitRepository {
+	return &ExitRepository{store: store}
+}
+
+// Get retrieves an exit by ID.
+func (r *ExitRepository) Get(ctx context.Context, id ulid.ULID) (*world.Exit, error) {
+	var exit *world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		stored, ok := t.exits[id]
+		if !ok {
+			return oops.Code("EXIT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		exit = cloneExit(stored)
+		return nil
+	})
+	return exit, err
+}
+
+// Create persists a new exit, assigning its ID and creation time when they
+// are unset. If bidirectional, the return exit is created with it, and
+// listed in the delta's Affected. Either exit's name or aliases clashing
+// with another exit at its location fails with an
+// *world.ExitNameConflictError and creates neither.
+func (r *ExitRepository) Create(ctx context.Context, exit *world.Exit) (*wmodel.MutationDelta, error) {
+	if exit.ID.IsZero() {
+		exit.ID = idgen.New()
+	}
+	if exit.CreatedAt.IsZero() {
+		exit.CreatedAt = time.Now()
+	}
+	stored, err := storedExit(exit)
+	if err != nil {
+		return nil, err
+	}
+	var returnExit *world.Exit
+	if exit.Bidirectional && exit.ReturnName != "" {
+		reverse, err := exit.ReverseExit()
+		if err != nil {
+			return nil, oops.With("operation", "create reverse exit").Wrap(err)
+		}
+		reverse.ID = idgen.New()
+		reverse.CreatedAt = exit.CreatedAt
+		if returnExit, err = storedExit(reverse); err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if err := checkExitInsert(t, "create exit", stored); err != nil {
+			return err
+		}
+		if returnExit != nil {
+			if err := checkExitInsert(t, "create return exit", returnExit); err != nil {
+				return err
+			}
+		}
+		stored.Version = 1
+		t.exits[stored.ID] = stored
+		if returnExit != nil {
+			returnExit.Version = 1
+			t.exits[returnExit.ID] = returnExit
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exit.Version = 1
+	delta := primaryDeltaVersioned(wmodel.AggregateExit, exit.ID, false, 0, 1)
+	if returnExit != nil {
+		delta.Affected = append(delta.Affected, wmodel.AffectedAggregate{
+			Type:         wmodel.AggregateExit,
+			ID:           returnExit.ID,
+			AfterVersion: 1,
+		})
+	}
+	return delta, nil
+}
+
+// Update modifies an existing exit with a version-predicated CAS (MODEL-03),
+// like the postgres repository: a stale exit.Version fails with
+// WORLD_CONCURRENT_EDIT, a missing row with EXIT_NOT_FOUND. When a
+// bidirectional exit's endpoints, name, or return name change, its return
+// exit is re-pointed and listed in the delta's Affected; a name conflict on
+// either side leaves both unchanged. On success exit.Version is refreshed.
+func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.MutationDelta, error) {
+	updated, err := storedExit(exit)
+	if err != nil {
+		return nil, err
+	}
+	var delta *wmodel.MutationDelta
+	err = r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.exits[exit.ID]
+		if !ok {
+			return oops.Code("EXIT_NOT_FOUND").With("id", exit.ID.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(exit.ID, exit.Version, prior.Version); err != nil {
+			return err
+		}
+		if err := checkExitRow(t, "update exit", updated); err != nil {
+			return err
+		}
+		if err := checkExitNameConflict(t, updated); err != nil {
+			return err
+		}
+		updated.CreatedAt = prior.CreatedAt
+		updated.Version = prior.Version + 1
+		t.exits[exit.ID] = updated
+
+		followed, err := followReturnExit(t, prior, updated)
+		if err != nil {
+			t.exits[exit.ID] = prior
+			return err
+		}
+		delta = primaryDeltaVersioned(wmodel.AggregateExit, exit.ID, false, prior.Version, updated.Version)
+		if followed != nil {
+			delta.Affected = append(delta.Affected, *followed)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	exit.Version = delta.Primary.AfterVersion
+	return delta, nil
+}
+
+// followReturnExit re-points prior's return exit to follow exit, as the
+// postgres followReturnExitTx does. exit must already be stored in t.
+// Returns the re-pointed return exit as an affected aggregate, or nil when
+// nothing moved.
+func followReturnExit(t *tables, prior, exit *world.Exit) (*wmodel.AffectedAggregate, error) {
+	if !prior.Bidirectional || prior.ReturnName == "" || !exit.Bidirectional || exit.ReturnName == "" {
+		return nil, nil
+	}
+	if prior.FromLocationID == exit.FromLocationID && prior.ToLocationID == exit.ToLocationID &&
+		prior.Name == exit.Name && prior.ReturnName == exit.ReturnName {
+		return nil, nil
+	}
+	returnExit := findExitByName(t, prior.ToLocationID, prior.ReturnName)
+	if returnExit == nil || returnExit.ID == exit.ID || returnExit.ToLocationID != prior.FromLocationID {
+		return nil, nil
+	}
+
+	moved := cloneExit(returnExit)
+	moved.FromLocationID = exit.ToLocationID
+	moved.ToLocationID = exit.FromLocationID
+	moved.Name = exit.ReturnName
+	moved.ReturnName = exit.Name
+	if err := checkExitNameConflict(t, moved); err != nil {
+		return nil, err
+	}
+	moved.Version++
+	t.exits[moved.ID] = moved
+	return &wmodel.AffectedAggregate{
+		Type:          wmodel.AggregateExit,
+		ID:            moved.ID,
+		BeforeVersion: returnExit.Version,
+		AfterVersion:  moved.Version,
+	}, nil
+}
+
+// Delete removes an exit by ID with a version-predicated CAS (MODEL-03).
+// If bidirectional, the return exit is deleted with it and listed as a
+// tombstone in the delta's Affected. When the return exit is already gone
+// the delete still succeeds, and a non-severe
+// *world.BidirectionalCleanupResult is returned with the delta.
+func (r *ExitRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var delta *wmodel.MutationDelta
+	var infoResult *world.BidirectionalCleanupResult
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		exit, ok := t.exits[id]
+		if !ok {
+			return oops.Code("EXIT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(id, expectedVersion, exit.Version); err != nil {
+			return err
+		}
+		delete(t.exits, id)
+		delta = primaryDeltaVersioned(wmodel.AggregateExit, id, true, exit.Version, 0)
+
+		if !exit.Bidirectional || exit.ReturnName == "" {
+			return nil
+		}
+		returnExit := findExitByName(t, exit.ToLocationID, exit.ReturnName)
+		if returnExit == nil {
+			infoResult = &world.BidirectionalCleanupResult{
+				ExitID:       id,
+				ToLocationID: exit.ToLocationID,
+				ReturnName:   exit.ReturnName,
+				Issue:        &world.CleanupIssue{Type: world.CleanupReturnNotFound},
+			}
+			return nil
+		}
+		if returnExit.ToLocationID == exit.FromLocationID {
+			delete(t.exits, returnExit.ID)
+			delta.Affected = append(delta.Affected, wmodel.AffectedAggregate{
+				Type:          wmodel.AggregateExit,
+				ID:            returnExit.ID,
+				Tombstone:     true,
+				BeforeVersion: returnExit.Version,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if infoResult != nil {
+		return delta, infoResult
+	}
+	return delta, nil
+}
+
+// ListFromLocation returns a page of the exits from a location, ordered by
+// (name, id).
+func (r *ExitRepository) ListFromLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Exit], error) {
+	var afterName string
+	var afterID ulid.ULID
+	if opts.Cursor != "" {
+		var err error
+		if afterName, afterID, err = world.DecodeCursor(opts.Cursor); err != nil {
+			return world.Page[*world.Exit]{}, err
+		}
+	}
+	var exits []*world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		exits = sortedExits(t, func(e *world.Exit) bool {
+			return e.FromLocationID == locationID &&
+				(opts.Cursor == "" || byNameID(e.Name, e.ID, afterName, afterID) > 0)
+		})
+		return nil
+	})
+	if err != nil {
+		return world.Page[*world.Exit]{}, err
+	}
+	slices.SortFunc(exits, func(a, b *world.Exit) int { return byNameID(a.Name, a.ID, b.Name, b.ID) })
+	size := opts.PageSize()
+	if len(exits) > size+1 {
+		exits = exits[:size+1]
+	}
+	return world.NewPage(exits, size, func(e *world.Exit) string {
+		return world.EncodeCursor(e.Name, e.ID)
+	}), nil
+}
+
+// ListDanglingExits returns the bidirectional exits whose return exit is
+// missing: no exit at the destination answers to the return name and leads
+// back to the origin. Ordered by ID.
+func (r *ExitRepository) ListDanglingExits(ctx context.Context) ([]*world.Exit, error) {
+	var exits []*world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		exits = sortedExits(t, func(e *world.Exit) bool {
+			if !e.Bidirectional || e.ReturnName == "" {
+				return false
+			}
+			for _, back := range t.exits {
+				if back.FromLocationID == e.ToLocationID && back.ToLocationID == e.FromLocationID && back.MatchesName(e.ReturnName) {
+					return false
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	return exits, err
+}
+
+// ListVisibleExits returns exits from a location that are visible to a
+// character, ordered by name, with the location's owner read in the same
+// snapshot.
+func (r *ExitRepository) ListVisibleExits(ctx context.Context, locationID, characterID ulid.ULID) ([]*world.Exit, error) {
+	var exits []*world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		loc, ok := t.locations[locationID]
+		if !ok {
+			exits = []*world.Exit{}
+			return nil
+		}
+		obs := world.ExitObserver{CharacterID: characterID, LocationOwnerID: loc.OwnerID}
+		exits = sortedExits(t, func(e *world.Exit) bool {
+			return e.FromLocationID == locationID && e.VisibleToSubject(obs)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(exits, func(a, b *world.Exit) int { return byNameID(a.Name, a.ID, b.Name, b.ID) })
+	return exits, nil
+}
+
+// FindByName finds an exit by name or alias from a location.
+// Matching is case-insensitive for both name and aliases.
+func (r *ExitRepository) FindByName(ctx context.Context, locationID ulid.ULID, name string) (*world.Exit, error) {
+	var exit *world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		found := findExitByName(t, locationID, name)
+		if found == nil {
+			return oops.Code("EXIT_NOT_FOUND").With("location_id", locationID.String()).With("name", name).Wrap(world.ErrNotFound)
+		}
+		exit = cloneExit(found)
+		return nil
+	})
+	return exit, err
+}
+
+// FindBySimilarity finds an exit by name using trigram similarity, computed
+// as pg_trgm's similarity() does. Returns the best match at or above the
+// threshold, or world.ErrNotFound. Threshold must be between 0.0 and 1.0
+// inclusive.
+func (r *ExitRepository) FindBySimilarity(ctx context.Context, locationID ulid.ULID, name string, threshold float64) (*world.Exit, error) {
+	if threshold < 0.0 || threshold > 1.0 {
+		return nil, oops.
+			With("threshold", threshold).
+			Errorf("threshold must be between 0.0 and 1.0")
+	}
+	var best *world.Exit
+	err := r.store.read(ctx, func(t *tables) error {
+		bestScore := -1.0
+		for _, e := range sortedExits(t, func(e *world.Exit) bool { return e.FromLocationID == locationID }) {
+			score := similarity(e.Name, name)
+			for _, alias := range e.Aliases {
+				score = max(score, similarity(alias, name))
+			}
+			if score >= threshold && score > bestScore {
+				best, bestScore = e, score
+			}
+		}
+		if best == nil {
+			return oops.Code("EXIT_NOT_FOUND").With("location_id", locationID.String()).With("name", name).With("threshold", threshold).Wrap(world.ErrNotFound)
+		}
+		return nil
+	})
+	return best, err
+}
+
+// findExitByName returns the exit from locationID answering to name, or
+// nil. When several do, the one with the lowest ID is returned.
+func findExitByName(t *tables, locationID ulid.ULID, name string) *world.Exit {
+	matches := sortedExits(t, func(e *world.Exit) bool {
+		return e.FromLocationID == locationID && e.MatchesName(name)
+	})
+	if len(matches) == 0 {
+		return nil
+	}
+	return t.exits[matches[0].ID]
+}
+
+// checkExitInsert checks a new exit row against the schema and the exit
+// names already at its location.
+func checkExitInsert(t *tables, operation string, exit *world.Exit) error {
+	if _, ok := t.exits[exit.ID]; ok {
+		return duplicateKey(operation, exit.ID)
+	}
+	if err := checkExitRow(t, operation, exit); err != nil {
+		return err
+	}
+	return checkExitNameConflict(t, exit)
+}
+
+// checkExitRow enforces the exits foreign keys and checks.
+func checkExitRow(t *tables, operation string, exit *world.Exit) error {
+	if exit.FromLocationID == exit.ToLocationID {
+		return checkViolation(operation, "chk_not_self_referential", exit.ID)
+	}
+	if exit.TraversalDelay < 0 {
+		return checkViolation(operation, "exits_traversal_delay_check", exit.ID)
+	}
+	if exit.TraversalCost < 0 {
+		return checkViolation(operation, "exits_traversal_cost_check", exit.ID)
+	}
+	if _, ok := t.locations[exit.FromLocationID]; !ok {
+		return fkViolation(operation, "from_location_id", exit.FromLocationID)
+	}
+	if _, ok := t.locations[exit.ToLocationID]; !ok {
+		return fkViolation(operation, "to_location_id", exit.ToLocationID)
+	}
+	return nil
+}
+
+// checkExitNameConflict returns an *world.ExitNameConflictError when
+// another exit leaving exit's location answers to exit's name or one of its
+// aliases, case-insensitively.
+func checkExitNameConflict(t *tables, exit *world.Exit) error {
+	for _, other := range sortedExits(t, func(e *world.Exit) bool {
+		return e.FromLocationID == exit.FromLocationID && e.ID != exit.ID
+	}) {
+		name, ok := exit.ConflictingName(other)
+		if !ok {
+			continue
+		}
+		return oops.Code(world.CodeExitNameConflict).
+			With("location_id", exit.FromLocationID.String()).
+			With("name", name).
+			With("conflicting_exit_id", other.ID.String()).
+			Wrap(&world.ExitNameConflictError{
+				LocationID:          exit.FromLocationID,
+				Name:                name,
+				ConflictingExitID:   other.ID,
+				ConflictingExitName: other.Name,
+			})
+	}
+	return nil
+}
+
+// sortedExits returns copies of the exits match accepts, ordered by ID.
+func sortedExits(t *tables, match func(*world.Exit) bool) []*world.Exit {
+	out := make([]*world.Exit, 0)
+	for _, e := range t.exits {
+		if match(e) {
+			out = append(out, cloneExit(e))
+		}
+	}
+	slices.SortFunc(out, func(a, b *world.Exit) int { return a.ID.Compare(b.ID) })
+	return out
+}
+
+// byNameID orders rows by (name, id).
+func byNameID(aName string, aID ulid.ULID, bName string, bID ulid.ULID) int {
+	return cmp.Or(cmp.Compare(aName, bName), aID.Compare(bID))
+}
+
+// storedExit returns the row the postgres repository would store for exit:
+// a copy whose lock data has been through JSON, so a value that does not
+// survive the jsonb column fails here too.
+func storedExit(exit *world.Exit) (*world.Exit, error) {
+	stored := cloneExit(exit)
+	if len(exit.LockData) == 0 {
+		stored.LockData = nil
+		return stored, nil
+	}
+	data, err := json.Marshal(exit.LockData)
+	if err != nil {
+		return nil, oops.With("operation", "marshal lock data").Wrap(err)
+	}
+	stored.LockData = nil
+	if err := json.Unmarshal(data, &stored.LockData); err != nil {
+		return nil, oops.With("operation", "unmarshal lock data").Wrap(err)
+	}
+	return stored, nil
+}
+
+// cloneExit returns a copy of e sharing no memory with it. e's lock data
+// must hold only JSON values, as a stored exit's does.
+func cloneExit(e *world.Exit) *world.Exit {
+	c := *e
+	c.Aliases = slices.Clone(e.Aliases)
+	c.VisibleTo = slices.Clone(e.VisibleTo)
+	c.LockData = copyJSONObject(e.LockData)
+	return &c
+}
+
+// copyJSONObject deep-copies an object decoded from JSON.
+func copyJSONObject(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]any, len(m))
+	for k, v := range m {
+		c[k] = copyJSONValue(v)
+	}
+	return c
+}
+
+// copyJSONValue deep-copies a value decoded from JSON. Other values are
+// returned as they are.
+func copyJSONValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return copyJSONObject(v)
+	case []any:
+		s := make([]any, len(v))
+		for i, val := range v {
+			s[i] = copyJSONValue(val)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+// Compile-time interface check.
+var _ world.ExitRepository = (*ExitRepository)(nil)
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestExitRepository_Bidirectional(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+
+	exit, err := world.NewExit(hall.ID, yard.ID, "out")
+	require.NoError(t, err)
+	exit.Bidirectional = true
+	exit.ReturnName = "in"
+	delta, err := r.exits.Create(ctx, exit)
+	require.NoError(t, err)
+	require.Len(t, delta.Affected, 1)
+
+	back, err := r.exits.FindByName(ctx, yard.ID, "in")
+	require.NoError(t, err)
+	assert.Equal(t, hall.ID, back.ToLocationID)
+	assert.Equal(t, delta.Affected[0].ID, back.ID)
+
+	delta, err = r.exits.Delete(ctx, exit.ID, exit.Version)
+	require.NoError(t, err)
+	require.Len(t, delta.Affected, 1)
+	assert.True(t, delta.Affected[0].Tombstone)
+	_, err = r.exits.Get(ctx, back.ID)
+	errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+}
+
+func TestExitRepository_DeleteReportsMissingReturn(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+
+	exit, err := world.NewExit(hall.ID, yard.ID, "out")
+	require.NoError(t, err)
+	exit.Bidirectional = true
+	exit.ReturnName = "in"
+	_, err = r.exits.Create(ctx, exit)
+	require.NoError(t, err)
+	back, err := r.exits.FindByName(ctx, yard.ID, "in")
+	require.NoError(t, err)
+	back.Name = "inside"
+	back.Bidirectional = false
+	_, err = r.exits.Update(ctx, back)
+	require.NoError(t, err)
+
+	delta, err := r.exits.Delete(ctx, exit.ID, 0)
+	require.NotNil(t, delta)
+	var cleanup *world.BidirectionalCleanupResult
+	require.ErrorAs(t, err, &cleanup)
+	assert.Equal(t, world.CleanupReturnNotFound, cleanup.Issue.Type)
+}
+
+func TestExitRepository_Constraints(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+	r.exit(ctx, t, hall.ID, yard.ID, "out")
+
+	t.Run("name conflict", func(t *testing.T) {
+		dup, err := world.NewExit(hall.ID, yard.ID, "out")
+		require.NoError(t, err)
+		_, err = r.exits.Create(ctx, dup)
+		var conflict *world.ExitNameConflictError
+		assert.ErrorAs(t, err, &conflict)
+	})
+
+	t.Run("self-referential", func(t *testing.T) {
+		loop := &world.Exit{FromLocationID: hall.ID, ToLocationID: hall.ID, Name: "loop", Visibility: world.VisibilityAll}
+		_, err := r.exits.Create(ctx, loop)
+		assert.ErrorIs(t, err, memory.ErrCheckViolation)
+	})
+
+	t.Run("missing destination", func(t *testing.T) {
+		gone := r.location(ctx, t, "Gone")
+		_, err := r.locations.Delete(ctx, gone.ID, 0)
+		require.NoError(t, err)
+		exit, err := world.NewExit(hall.ID, gone.ID, "void")
+		require.NoError(t, err)
+		_, err = r.exits.Create(ctx, exit)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		exit, err := r.exits.FindByName(ctx, hall.ID, "out")
+		require.NoError(t, err)
+		exit.Version++
+		_, err = r.exits.Update(ctx, exit)
+		assert.True(t, errors.Is(err, world.ErrConcurrentEdit))
+	})
+}
+
+func TestExitRepository_Lookup(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+	north := r.exit(ctx, t, hall.ID, yard.ID, "north")
+	r.exit(ctx, t, hall.ID, yard.ID, "east")
+
+	page, err := r.exits.ListFromLocation(ctx, hall.ID, world.ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "east", page.Items[0].Name)
+	require.NotEmpty(t, page.NextCursor)
+	page, err = r.exits.ListFromLocation(ctx, hall.ID, world.ListOptions{Limit: 1, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, "north", page.Items[0].Name)
+
+	found, err := r.exits.FindBySimilarity(ctx, hall.ID, "nort", 0.3)
+	require.NoError(t, err)
+	assert.Equal(t, north.ID, found.ID)
+	_, err = r.exits.FindBySimilarity(ctx, hall.ID, "zzz", 0.3)
+	errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+)
+
+// repos bundles every repository over one store.
+type repos struct {
+	store      *memory.Store
+	locations  *memory.LocationRepository
+	exits      *memory.ExitRepository
+	objects    *memory.ObjectRepository
+	characters *memory.CharacterRepository
+	scenes     *memory.SceneRepository
+	properties *memory.PropertyRepository
+}
+
+func newRepos() *repos {
+	store := memory.NewStore()
+	return &repos{
+		store:      store,
+		locations:  memory.NewLocationRepository(store),
+		exits:      memory.NewExitRepository(store),
+		objects:    memory.NewObjectRepository(store),
+		characters: memory.NewCharacterRepository(store),
+		scenes:     memory.NewSceneRepository(store),
+		properties: memory.NewPropertyRepository(store),
+	}
+}
+
+func (r *repos) location(ctx context.Context, t *testing.T, name string) *world.Location {
+	t.Helper()
+	loc, err := world.NewLocation(name, "A place.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	_, err = r.locations.Create(ctx, loc)
+	require.NoError(t, err)
+	return loc
+}
+
+func (r *repos) scene(ctx context.Context, t *testing.T, name string, shadows *ulid.ULID) *world.Location {
+	t.Helper()
+	loc, err := world.NewLocation(name, "A scene.", world.LocationTypeScene)
+	require.NoError(t, err)
+	loc.ShadowsID = shadows
+	_, err = r.locations.Create(ctx, loc)
+	require.NoError(t, err)
+	return loc
+}
+
+func (r *repos) character(ctx context.Context, t *testing.T, name string, locationID ulid.ULID) *world.Character {
+	t.Helper()
+	char, err := world.NewCharacter(ulid.Make(), name)
+	require.NoError(t, err)
+	char.LocationID = &locationID
+	_, err = r.characters.Create(ctx, char)
+	require.NoError(t, err)
+	return char
+}
+
+func (r *repos) object(ctx context.Context, t *testing.T, name string, in world.Containment) *world.Object {
+	t.Helper()
+	obj, err := world.NewObject(name, in)
+	require.NoError(t, err)
+	_, err = r.objects.Create(ctx, obj)
+	require.NoError(t, err)
+	return obj
+}
+
+func (r *repos) exit(ctx context.Context, t *testing.T, from, to ulid.ULID, name string) *world.Exit {
+	t.Helper()
+	exit, err := world.NewExit(from, to, name)
+	require.NoError(t, err)
+	_, err = r.exits.Create(ctx, exit)
+	require.NoError(t, err)
+	return exit
+}
+
+func at(id ulid.ULID) world.Containment {
+	return world.Containment{LocationID: &id}
+}
+
+func heldBy(id ulid.ULID) world.Containment {
+	return world.Containment{CharacterID: &id}
+}
+
+func inside(id ulid.ULID) world.Containment {
+	return world.Containment{ObjectID: &id}
+}
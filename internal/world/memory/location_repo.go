@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// LocationRepository implements world.LocationRepository over a Store.
+type LocationRepository struct {
+	store *Store
+}
+
+// NewLocationRepository creates a new LocationRepository.
+func NewLocationRepository(store *Store) *LocationRepository {
+	return &LocationRepository{store: store}
+}
+
+// Get retrieves a location by ID.
+func (r *LocationRepository) Get(ctx context.Context, id ulid.ULID) (*world.Location, error) {
+	var loc *world.Location
+	err := r.store.read(ctx, func(t *tables) error {
+		stored, ok := t.locations[id]
+		if !ok {
+			return locationNotFound(id)
+		}
+		loc = cloneLocation(stored)
+		return nil
+	})
+	return loc, err
+}
+
+// GetMany retrieves the locations with the given IDs. Missing IDs are
+// omitted; the result is ordered by ID.
+func (r *LocationRepository) GetMany(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return slices.Contains(ids, l.ID) }, byID)
+}
+
+// Create persists a new location. Tags are not written; the location
+// starts untagged at version 1.
+func (r *LocationRepository) Create(ctx context.Context, loc *world.Location) (*wmodel.MutationDelta, error) {
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.locations[loc.ID]; ok {
+			return duplicateKey("create location", loc.ID)
+		}
+		if err := checkLocationRefs(t, "create location", loc); err != nil {
+			return err
+		}
+		stored := cloneLocation(loc)
+		stored.Tags = nil
+		stored.Version = 1
+		t.locations[loc.ID] = stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	loc.Version = 1
+	return primaryDeltaVersioned(wmodel.AggregateLocation, loc.ID, false, 0, 1), nil
+}
+
+// Update modifies an existing location with a version-predicated CAS
+// (MODEL-03). A stale loc.Version fails with WORLD_CONCURRENT_EDIT, a
+// missing row with LOCATION_NOT_FOUND; loc.Version 0 is an unversioned
+// write. Tags and the creation time are left as they are. On success
+// loc.Version is refreshed to the committed value.
+func (r *LocationRepository) Update(ctx context.Context, loc *world.Location) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.locations[loc.ID]
+		if !ok {
+			return locationNotFound(loc.ID)
+		}
+		if err := checkVersion(loc.ID, loc.Version, prior.Version); err != nil {
+			return err
+		}
+		if err := checkLocationRefs(t, "update location", loc); err != nil {
+			return err
+		}
+		stored := cloneLocation(loc)
+		stored.Tags = prior.Tags
+		stored.CreatedAt = prior.CreatedAt
+		stored.Version = prior.Version + 1
+		t.locations[loc.ID] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	loc.Version = newVersion
+	return primaryDeltaVersioned(wmodel.AggregateLocation, loc.ID, false, newVersion-1, newVersion), nil
+}
+
+// Delete removes a location by ID with a version-predicated CAS (MODEL-03).
+//
+// The location's exits, in both directions, are deleted with it and listed
+// as tombstones in the delta's Affected, ordered by ID. Its child locations
+// lose their parent, and its scene participants are removed. A location
+// that characters stand in, objects lie in, or scenes shadow cannot be
+// deleted.
+func (r *LocationRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var delta *wmodel.MutationDelta
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		stored, ok := t.locations[id]
+		if !ok {
+			return locationNotFound(id)
+		}
+		if err := checkVersion(id, expectedVersion, stored.Version); err != nil {
+			return err
+		}
+		for _, c := range t.characters {
+			if c.LocationID != nil && *c.LocationID == id {
+				return fkReferenced("delete location", id, "characters", "location_id")
+			}
+		}
+		for _, l := range t.locations {
+			if l.ShadowsID != nil && *l.ShadowsID == id {
+				return fkReferenced("delete location", id, "locations", "shadows_id")
+			}
+		}
+		for _, o := range t.objects {
+			// ON DELETE SET NULL would leave the object nowhere.
+			if loc := o.LocationID(); loc != nil && *loc == id {
+				return checkViolation("delete location", "chk_exactly_one_containment", o.ID)
+			}
+		}
+
+		delta = primaryDeltaVersioned(wmodel.AggregateLocation, id, true, stored.Version, 0)
+		for _, e := range sortedExits(t, func(e *world.Exit) bool {
+			return e.FromLocationID == id || e.ToLocationID == id
+		}) {
+			delta.Affected = append(delta.Affected, wmodel.AffectedAggregate{
+				Type:          wmodel.AggregateExit,
+				ID:            e.ID,
+				Tombstone:     true,
+				BeforeVersion: e.Version,
+			})
+			delete(t.exits, e.ID)
+		}
+		for otherID, l := range t.locations {
+			isChild := l.ParentID != nil && *l.ParentID == id
+			overflows := l.OverflowID != nil && *l.OverflowID == id
+			if !isChild && !overflows {
+				continue
+			}
+			other := cloneLocation(l)
+			if isChild {
+				other.ParentID = nil
+			}
+			if overflows {
+				other.OverflowID = nil
+			}
+			t.locations[otherID] = other
+		}
+		delete(t.participants, id)
+		delete(t.locations, id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// ListByType returns all locations of the given type, newest first.
+func (r *LocationRepository) ListByType(ctx context.Context, locType world.LocationType) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return l.Type == locType }, newestFirst)
+}
+
+// GetShadowedBy returns scenes that shadow the given location, newest
+// first.
+func (r *LocationRepository) GetShadowedBy(ctx context.Context, id ulid.ULID) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return l.ShadowsID != nil && *l.ShadowsID == id }, newestFirst)
+}
+
+// ListByZone returns the locations tagged with zoneID, ordered by ID.
+func (r *LocationRepository) ListByZone(ctx context.Context, zoneID string) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return zoneID != "" && l.ZoneID == zoneID }, byID)
+}
+
+// ListChildren returns the locations whose parent is parentID, ordered by
+// ID.
+func (r *LocationRepository) ListChildren(ctx context.Context, parentID ulid.ULID) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return l.ParentID != nil && *l.ParentID == parentID }, byID)
+}
+
+// ListByTag returns the locations carrying tag, ordered by ID.
+func (r *LocationRepository) ListByTag(ctx context.Context, tag string) ([]*world.Location, error) {
+	return r.list(ctx, func(l *world.Location) bool { return hasTag(l.Tags, tag) }, byID)
+}
+
+// SetTags replaces a location's tags with a version-predicated CAS
+// (MODEL-03).
+func (r *LocationRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.locations[id]
+		if !ok {
+			return locationNotFound(id)
+		}
+		if err := checkVersion(id, expectedVersion, prior.Version); err != nil {
+			return err
+		}
+		stored := cloneLocation(prior)
+		stored.Tags = storedTags(tags)
+		stored.Version++
+		t.locations[id] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primaryDeltaVersioned(wmodel.AggregateLocation, id, false, newVersion-1, newVersion), nil
+}
+
+// FindByName searches for a location by exact name match. When several
+// share the name, the one with the lowest ID is returned.
+func (r *LocationRepository) FindByName(ctx context.Context, name string) (*world.Location, error) {
+	found, err := r.list(ctx, func(l *world.Location) bool { return l.Name == name }, byID)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) == 0 {
+		return nil, oops.Code("LOCATION_NOT_FOUND").With("name", name).Wrap(world.ErrNotFound)
+	}
+	return found[0], nil
+}
+
+// list returns copies of the locations match accepts, sorted by cmp.
+func (r *LocationRepository) list(ctx context.Context, match func(*world.Location) bool, cmp func(a, b *world.Location) int) ([]*world.Location, error) {
+	out := make([]*world.Location, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, l := range t.locations {
+			if match(l) {
+				out = append(out, cloneLocation(l))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(out, cmp)
+	return out, nil
+}
+
+// checkLocationRefs enforces the locations foreign keys and the
+// parent-not-self, overflow-not-self, and occupancy checks.
+func checkLocationRefs(t *tables, operation string, loc *world.Location) error {
+	if loc.ParentID != nil && *loc.ParentID == loc.ID {
+		return checkViolation(operation, "locations_parent_not_self", loc.ID)
+	}
+	if loc.OverflowID != nil && *loc.OverflowID == loc.ID {
+		return checkViolation(operation, "locations_overflow_not_self", loc.ID)
+	}
+	if loc.MaxOccupancy < 0 {
+		return checkViolation(operation, "locations_max_occupancy_nonnegative", loc.ID)
+	}
+	if loc.ShadowsID != nil {
+		if _, ok := t.locations[*loc.ShadowsID]; !ok {
+			return fkViolation(operation, "shadows_id", *loc.ShadowsID)
+		}
+	}
+	if loc.OwnerID != nil {
+		if _, ok := t.characters[*loc.OwnerID]; !ok {
+			return fkViolation(operation, "owner_id", *loc.OwnerID)
+		}
+	}
+	if loc.ParentID != nil {
+		if _, ok := t.locations[*loc.ParentID]; !ok {
+			return fkViolation(operation, "parent_id", *loc.ParentID)
+		}
+	}
+	if loc.OverflowID != nil {
+		if _, ok := t.locations[*loc.OverflowID]; !ok {
+			return fkViolation(operation, "overflow_id", *loc.OverflowID)
+		}
+	}
+	return nil
+}
+
+func locationNotFound(id ulid.ULID) error {
+	return oops.Code("LOCATION_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+}
+
+// cloneLocation returns a copy of l sharing no memory with it.
+func cloneLocation(l *world.Location) *world.Location {
+	c := *l
+	c.ShadowsID = cloneID(l.ShadowsID)
+	c.OwnerID = cloneID(l.OwnerID)
+	c.ParentID = cloneID(l.ParentID)
+	c.OverflowID = cloneID(l.OverflowID)
+	c.Tags = storedTags(l.Tags)
+	if l.ArchivedAt != nil {
+		archived := *l.ArchivedAt
+		c.ArchivedAt = &archived
+	}
+	return &c
+}
+
+func byID(a, b *world.Location) int {
+	return a.ID.Compare(b.ID)
+}
+
+// newestFirst orders locations by (created_at, id) descending, as the
+// postgres lists do.
+func newestFirst(a, b *world.Location) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return b.ID.Compare(a.ID)
+}
+
+// Compile-time interface check.
+var _ world.LocationRepository = (*LocationRepository)(nil)
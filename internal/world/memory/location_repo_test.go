@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestLocationRepository_CreateUpdate(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+
+	loc, err := world.NewLocation("Hall", "A hall.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	loc.Tags = []string{"ignored"}
+	delta, err := r.locations.Create(ctx, loc)
+	require.NoError(t, err)
+	assert.Equal(t, 1, loc.Version)
+	assert.Equal(t, 1, delta.Primary.AfterVersion)
+
+	got, err := r.locations.Get(ctx, loc.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.Tags, "create does not write tags")
+
+	got.Name = "Great Hall"
+	delta, err = r.locations.Update(ctx, got)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+	assert.Equal(t, 1, delta.Primary.BeforeVersion)
+
+	stale := *loc
+	stale.Name = "Stale"
+	_, err = r.locations.Update(ctx, &stale)
+	errutil.AssertErrorCode(t, err, world.CodeConcurrentEdit)
+	assert.ErrorIs(t, err, world.ErrConcurrentEdit)
+
+	t.Run("returned rows do not alias the store", func(t *testing.T) {
+		got, err := r.locations.Get(ctx, loc.ID)
+		require.NoError(t, err)
+		got.Name = "Mutated"
+		again, err := r.locations.Get(ctx, loc.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Great Hall", again.Name)
+	})
+
+	t.Run("rejects a missing parent", func(t *testing.T) {
+		orphan, err := world.NewLocation("Orphan", "Lost.", world.LocationTypePersistent)
+		require.NoError(t, err)
+		missing := ulid.Make()
+		orphan.ParentID = &missing
+		_, err = r.locations.Create(ctx, orphan)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+	})
+
+	t.Run("rejects a missing overflow", func(t *testing.T) {
+		full, err := world.NewLocation("Full", "Packed.", world.LocationTypePersistent)
+		require.NoError(t, err)
+		missing := ulid.Make()
+		full.OverflowID = &missing
+		_, err = r.locations.Create(ctx, full)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+	})
+}
+
+func TestLocationRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("cascades exits and detaches children and overflows", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		yard := r.location(ctx, t, "Yard")
+		child := r.location(ctx, t, "Alcove")
+		child.ParentID = &hall.ID
+		_, err := r.locations.Update(ctx, child)
+		require.NoError(t, err)
+		yard.MaxOccupancy = 2
+		yard.OverflowID = &hall.ID
+		_, err = r.locations.Update(ctx, yard)
+		require.NoError(t, err)
+		out := r.exit(ctx, t, hall.ID, yard.ID, "out")
+		in := r.exit(ctx, t, yard.ID, hall.ID, "in")
+
+		delta, err := r.locations.Delete(ctx, hall.ID, hall.Version)
+		require.NoError(t, err)
+		assert.True(t, delta.Primary.Tombstone)
+		require.Len(t, delta.Affected, 2)
+		for _, a := range delta.Affected {
+			assert.Equal(t, wmodel.AggregateExit, a.Type)
+			assert.True(t, a.Tombstone)
+		}
+
+		_, err = r.exits.Get(ctx, out.ID)
+		errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+		_, err = r.exits.Get(ctx, in.ID)
+		errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+		got, err := r.locations.Get(ctx, child.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.ParentID)
+		got, err = r.locations.Get(ctx, yard.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got.OverflowID)
+		assert.Equal(t, 2, got.MaxOccupancy)
+	})
+
+	t.Run("fails while a character stands in it", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		r.character(ctx, t, "Alice", hall.ID)
+
+		_, err := r.locations.Delete(ctx, hall.ID, 0)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+		_, err = r.locations.Get(ctx, hall.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("fails while an object lies in it", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		r.object(ctx, t, "Rock", at(hall.ID))
+
+		_, err := r.locations.Delete(ctx, hall.ID, 0)
+		assert.ErrorIs(t, err, memory.ErrCheckViolation)
+	})
+
+	t.Run("fails while a scene shadows it", func(t *testing.T) {
+		r := newRepos()
+		hall := r.location(ctx, t, "Hall")
+		r.scene(ctx, t, "Tea", &hall.ID)
+
+		_, err := r.locations.Delete(ctx, hall.ID, 0)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+	})
+
+	t.Run("missing location", func(t *testing.T) {
+		r := newRepos()
+		_, err := r.locations.Delete(ctx, ulid.Make(), 0)
+		errutil.AssertErrorCode(t, err, "LOCATION_NOT_FOUND")
+		assert.ErrorIs(t, err, world.ErrNotFound)
+	})
+}
+
+func TestLocationRepository_Lists(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	yard := r.location(ctx, t, "Yard")
+	scene := r.scene(ctx, t, "Tea", &hall.ID)
+	_, err := r.locations.SetTags(ctx, yard.ID, []string{"outdoor"}, yard.Version)
+	require.NoError(t, err)
+
+	scenes, err := r.locations.ListByType(ctx, world.LocationTypeScene)
+	require.NoError(t, err)
+	require.Len(t, scenes, 1)
+	assert.Equal(t, scene.ID, scenes[0].ID)
+
+	shadows, err := r.locations.GetShadowedBy(ctx, hall.ID)
+	require.NoError(t, err)
+	require.Len(t, shadows, 1)
+
+	tagged, err := r.locations.ListByTag(ctx, "outdoor")
+	require.NoError(t, err)
+	require.Len(t, tagged, 1)
+	assert.Equal(t, []string{"outdoor"}, tagged[0].Tags)
+
+	found, err := r.locations.FindByName(ctx, "Yard")
+	require.NoError(t, err)
+	assert.Equal(t, yard.ID, found.ID)
+	_, err = r.locations.FindByName(ctx, "Nowhere")
+	errutil.AssertErrorCode(t, err, "LOCATION_NOT_FOUND")
+}
@@ -0,0 +1,457 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// DefaultMaxNestingDepth is the maximum allowed nesting depth for object
+// containment, as in the postgres repository.
+const DefaultMaxNestingDepth = 3
+
+// maxChainDepth bounds walks of the containment hierarchy, like the
+// postgres CTE recursion limit, so a cycle written through Update cannot
+// loop forever.
+const maxChainDepth = 100
+
+// ObjectRepository implements world.ObjectRepository over a Store.
+type ObjectRepository struct {
+	store           *Store
+	maxNestingDepth int
+}
+
+// NewObjectRepository creates a new ObjectRepository.
+// Uses DefaultMaxNestingDepth for nesting limit.
+func NewObjectRepository(store *Store) *ObjectRepository {
+	return &ObjectRepository{store: store, maxNestingDepth: DefaultMaxNestingDepth}
+}
+
+// NewObjectRepositoryWithDepth creates a new ObjectRepository with a custom
+// max nesting depth.
+func NewObjectRepositoryWithDepth(store *Store, maxNestingDepth int) *ObjectRepository {
+	return &ObjectRepository{store: store, maxNestingDepth: maxNestingDepth}
+}
+
+// Get retrieves an object by ID.
+func (r *ObjectRepository) Get(ctx context.Context, id ulid.ULID) (*world.Object, error) {
+	var obj *world.Object
+	err := r.store.read(ctx, func(t *tables) error {
+		stored, ok := t.objects[id]
+		if !ok {
+			return oops.Code("OBJECT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		obj = cloneObject(stored)
+		return nil
+	})
+	return obj, err
+}
+
+// Create persists a new object. Tags are not written; the object starts
+// untagged at version 1. Callers must validate the object first.
+func (r *ObjectRepository) Create(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.objects[obj.ID]; ok {
+			return duplicateKey("create object", obj.ID)
+		}
+		if err := checkObjectRow(t, "create object", obj); err != nil {
+			return err
+		}
+		stored := storedObject(obj)
+		stored.Tags = nil
+		stored.Version = 1
+		t.objects[obj.ID] = stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	obj.Version = 1
+	return primaryDeltaVersioned(wmodel.AggregateObject, obj.ID, false, 0, 1), nil
+}
+
+// Update modifies an existing object with a version-predicated CAS
+// (MODEL-03). A stale obj.Version fails with WORLD_CONCURRENT_EDIT, a
+// missing row with OBJECT_NOT_FOUND; obj.Version 0 is an unversioned write.
+// Tags and the creation time are left as they are. On success obj.Version
+// is refreshed to the committed value.
+func (r *ObjectRepository) Update(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.objects[obj.ID]
+		if !ok {
+			return oops.Code("OBJECT_NOT_FOUND").With("id", obj.ID.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(obj.ID, obj.Version, prior.Version); err != nil {
+			return err
+		}
+		if err := checkObjectRow(t, "update object", obj); err != nil {
+			return err
+		}
+		stored := storedObject(obj)
+		stored.Tags = prior.Tags
+		stored.CreatedAt = prior.CreatedAt
+		stored.Version = prior.Version + 1
+		t.objects[obj.ID] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	obj.Version = newVersion
+	return primaryDeltaVersioned(wmodel.AggregateObject, obj.ID, false, newVersion-1, newVersion), nil
+}
+
+// Delete removes an object by ID with a version-predicated CAS (MODEL-03).
+// A container that still holds objects cannot be deleted.
+func (r *ObjectRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var delta *wmodel.MutationDelta
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		stored, ok := t.objects[id]
+		if !ok {
+			return oops.Code("OBJECT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(id, expectedVersion, stored.Version); err != nil {
+			return err
+		}
+		for _, o := range t.objects {
+			// ON DELETE SET NULL would leave the contents nowhere.
+			if in := o.ContainedInObjectID(); in != nil && *in == id {
+				return checkViolation("delete object", "chk_exactly_one_containment", o.ID)
+			}
+		}
+		delete(t.objects, id)
+		delta = primaryDeltaVersioned(wmodel.AggregateObject, id, true, stored.Version, 0)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// ListAtLocation returns all objects at a location, newest first.
+func (r *ObjectRepository) ListAtLocation(ctx context.Context, locationID ulid.ULID) ([]*world.Object, error) {
+	return r.list(ctx, func(o *world.Object) bool {
+		id := o.LocationID()
+		return id != nil && *id == locationID
+	}, newestObjectFirst)
+}
+
+// ListHeldBy returns all objects held by a character, newest first.
+func (r *ObjectRepository) ListHeldBy(ctx context.Context, characterID ulid.ULID) ([]*world.Object, error) {
+	return r.list(ctx, func(o *world.Object) bool {
+		id := o.HeldByCharacterID()
+		return id != nil && *id == characterID
+	}, newestObjectFirst)
+}
+
+// ListContainedIn returns all objects inside a container object, newest
+// first.
+func (r *ObjectRepository) ListContainedIn(ctx context.Context, objectID ulid.ULID) ([]*world.Object, error) {
+	return r.list(ctx, func(o *world.Object) bool {
+		id := o.ContainedInObjectID()
+		return id != nil && *id == objectID
+	}, newestObjectFirst)
+}
+
+// ListByTag returns the objects carrying tag, ordered by ID.
+func (r *ObjectRepository) ListByTag(ctx context.Context, tag string) ([]*world.Object, error) {
+	return r.list(ctx, func(o *world.Object) bool { return hasTag(o.Tags, tag) }, func(a, b *world.Object) int {
+		return a.ID.Compare(b.ID)
+	})
+}
+
+// SetTags replaces an object's tags with a version-predicated CAS
+// (MODEL-03).
+func (r *ObjectRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	var newVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.objects[id]
+		if !ok {
+			return oops.Code("OBJECT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(id, expectedVersion, prior.Version); err != nil {
+			return err
+		}
+		stored := cloneObject(prior)
+		stored.Tags = storedTags(tags)
+		stored.Version++
+		t.objects[id] = stored
+		newVersion = stored.Version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primaryDeltaVersioned(wmodel.AggregateObject, id, false, newVersion-1, newVersion), nil
+}
+
+// Move changes an object's containment with a version-predicated CAS
+// (MODEL-03), enforcing the same rules as the postgres repository: the
+// target must exist and, for an object, be a container; the object cannot
+// end up inside itself (world.ErrCircularContainment); and the nesting
+// depth stays within the limit (world.ErrNestingDepthExceeded). An object
+// that leaves its holder's hands is no longer worn.
+func (r *ObjectRepository) Move(ctx context.Context, objectID ulid.ULID, to world.Containment, expectedVersion int) (*wmodel.MutationDelta, error) {
+	if err := to.Validate(); err != nil {
+		return nil, oops.With("operation", "move object").With("object_id", objectID.String()).Wrap(err)
+	}
+
+	var beforeVersion int
+	err := r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.objects[objectID]
+		if !ok {
+			return oops.Code("OBJECT_NOT_FOUND").With("object_id", objectID.String()).Wrap(world.ErrNotFound)
+		}
+		if err := checkVersion(objectID, expectedVersion, prior.Version); err != nil {
+			return err
+		}
+		beforeVersion = prior.Version
+
+		if to.ObjectID != nil {
+			container, ok := t.objects[*to.ObjectID]
+			if !ok {
+				return oops.
+					Code("CONTAINER_NOT_FOUND").
+					With("operation", "move object").
+					With("object_id", objectID.String()).
+					With("container_id", to.ObjectID.String()).
+					Wrap(world.ErrNotFound)
+			}
+			if !container.IsContainer {
+				return oops.
+					With("operation", "move object").
+					With("object_id", objectID.String()).
+					With("container_id", to.ObjectID.String()).
+					Wrap(world.ErrInvalidContainment)
+			}
+			if err := checkCircularContainment(t, objectID, *to.ObjectID); err != nil {
+				return err
+			}
+			if err := r.checkNestingDepth(t, objectID, *to.ObjectID); err != nil {
+				return err
+			}
+		}
+		if err := checkContainmentRefs(t, "move object", to); err != nil {
+			return err
+		}
+
+		moved := cloneObject(prior)
+		wornAt := moved.WornAt
+		if err := moved.SetContainment(cloneContainment(to)); err != nil {
+			return oops.With("operation", "move object").With("object_id", objectID.String()).Wrap(err)
+		}
+		if sameID(prior.HeldByCharacterID(), to.CharacterID) {
+			moved.WornAt = wornAt
+		}
+		moved.Version++
+		t.objects[objectID] = moved
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primaryDeltaVersioned(wmodel.AggregateObject, objectID, false, beforeVersion, beforeVersion+1), nil
+}
+
+// checkCircularContainment rejects placing objectID into
+// targetContainerID when the target is the object or sits inside it.
+func checkCircularContainment(t *tables, objectID, targetContainerID ulid.ULID) error {
+	if objectID == targetContainerID {
+		return oops.
+			Code(world.CodeCircularContainment).
+			With("operation", "move object").
+			With("object_id", objectID.String()).
+			With("container_id", targetContainerID.String()).
+			Wrapf(world.ErrCircularContainment, "cannot place object inside itself")
+	}
+	id := t.objects[targetContainerID].ContainedInObjectID()
+	for depth := 0; id != nil && depth < maxChainDepth; depth++ {
+		if *id == objectID {
+			return oops.
+				Code(world.CodeCircularContainment).
+				With("operation", "move object").
+				With("object_id", objectID.String()).
+				With("container_id", targetContainerID.String()).
+				Wrapf(world.ErrCircularContainment, "target container is inside this object")
+		}
+		parent, ok := t.objects[*id]
+		if !ok {
+			break
+		}
+		id = parent.ContainedInObjectID()
+	}
+	return nil
+}
+
+// checkNestingDepth rejects a move that would nest objects deeper than the
+// repository's limit: the target container's depth, plus the depth of the
+// object's own contents, plus the object itself.
+func (r *ObjectRepository) checkNestingDepth(t *tables, objectID, targetContainerID ulid.ULID) error {
+	targetDepth := 0
+	for o, ok := t.objects[targetContainerID]; ok && targetDepth < maxChainDepth; {
+		targetDepth++
+		in := o.ContainedInObjectID()
+		if in == nil {
+			break
+		}
+		o, ok = t.objects[*in]
+	}
+	objectSubtreeDepth := subtreeDepth(t, objectID, 0)
+
+	totalDepth := targetDepth + objectSubtreeDepth + 1
+	if totalDepth > r.maxNestingDepth {
+		return oops.
+			Code(world.CodeNestingDepthExceeded).
+			With("operation", "move object").
+			With("object_id", objectID.String()).
+			With("container_id", targetContainerID.String()).
+			With("target_depth", targetDepth).
+			With("object_subtree_depth", objectSubtreeDepth).
+			With("total_depth", totalDepth).
+			With("max_depth", r.maxNestingDepth).
+			Wrap(world.ErrNestingDepthExceeded)
+	}
+	return nil
+}
+
+// subtreeDepth returns how many levels of objects sit inside id: 0 for an
+// object with no contents. level is id's own depth below the moved object.
+func subtreeDepth(t *tables, id ulid.ULID, level int) int {
+	if level >= maxChainDepth {
+		return 0
+	}
+	deepest := 0
+	for _, o := range t.objects {
+		if in := o.ContainedInObjectID(); in != nil && *in == id {
+			deepest = max(deepest, subtreeDepth(t, o.ID, level+1)+1)
+		}
+	}
+	return deepest
+}
+
+// checkObjectRow enforces the objects foreign keys and checks.
+func checkObjectRow(t *tables, operation string, obj *world.Object) error {
+	if in := obj.ContainedInObjectID(); in != nil && *in == obj.ID {
+		return checkViolation(operation, "chk_not_self_contained", obj.ID)
+	}
+	if err := obj.ValidateContainment(); err != nil {
+		return checkViolation(operation, "chk_exactly_one_containment", obj.ID)
+	}
+	if err := checkContainmentRefs(t, operation, obj.Containment()); err != nil {
+		return err
+	}
+	if obj.OwnerID != nil {
+		if _, ok := t.characters[*obj.OwnerID]; !ok {
+			return fkViolation(operation, "owner_id", *obj.OwnerID)
+		}
+	}
+	return nil
+}
+
+// checkContainmentRefs checks that the location, character, or container
+// c names exists.
+func checkContainmentRefs(t *tables, operation string, c world.Containment) error {
+	if c.LocationID != nil {
+		if _, ok := t.locations[*c.LocationID]; !ok {
+			return fkViolation(operation, "location_id", *c.LocationID)
+		}
+	}
+	if c.CharacterID != nil {
+		if _, ok := t.characters[*c.CharacterID]; !ok {
+			return fkViolation(operation, "held_by_character_id", *c.CharacterID)
+		}
+	}
+	if c.ObjectID != nil {
+		if _, ok := t.objects[*c.ObjectID]; !ok {
+			return fkViolation(operation, "contained_in_object_id", *c.ObjectID)
+		}
+	}
+	return nil
+}
+
+// list returns copies of the objects match accepts, sorted by cmp.
+func (r *ObjectRepository) list(ctx context.Context, match func(*world.Object) bool, cmp func(a, b *world.Object) int) ([]*world.Object, error) {
+	out := make([]*world.Object, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, o := range t.objects {
+			if match(o) {
+				out = append(out, cloneObject(o))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(out, cmp)
+	return out, nil
+}
+
+// storedObject returns the row the postgres repository would store for
+// obj: worn_at is cleared unless the holder is wearing it.
+func storedObject(obj *world.Object) *world.Object {
+	stored := cloneObject(obj)
+	if !obj.Worn() {
+		stored.WornAt = time.Time{}
+	}
+	if len(stored.Verbs) == 0 {
+		stored.Verbs = nil
+	}
+	return stored
+}
+
+// cloneObject returns a copy of o sharing no memory with it.
+func cloneObject(o *world.Object) *world.Object {
+	c := *o
+	c.OwnerID = cloneID(o.OwnerID)
+	c.Verbs = slices.Clone(o.Verbs)
+	c.Tags = storedTags(o.Tags)
+	// A stored containment is always valid, so this only fails for a
+	// caller's object the checks are about to reject, which then keeps its
+	// containment pointers shared.
+	if err := c.SetContainment(cloneContainment(o.Containment())); err == nil {
+		c.WornAt = o.WornAt
+	}
+	return &c
+}
+
+func cloneContainment(c world.Containment) world.Containment {
+	return world.Containment{
+		LocationID:  cloneID(c.LocationID),
+		CharacterID: cloneID(c.CharacterID),
+		ObjectID:    cloneID(c.ObjectID),
+	}
+}
+
+// sameID reports whether two optional IDs are equal, treating two nils as
+// equal (SQL IS NOT DISTINCT FROM).
+func sameID(a, b *ulid.ULID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// newestObjectFirst orders objects by (created_at, id) descending, as the
+// postgres lists do.
+func newestObjectFirst(a, b *world.Object) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+	return b.ID.Compare(a.ID)
+}
+
+// Compile-time interface check.
+var _ world.ObjectRepository = (*ObjectRepository)(nil)
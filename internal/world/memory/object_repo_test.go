@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestObjectRepository_Move(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	alice := r.character(ctx, t, "Alice", hall.ID)
+
+	bag := r.object(ctx, t, "Bag", at(hall.ID))
+	bag.IsContainer = true
+	_, err := r.objects.Update(ctx, bag)
+	require.NoError(t, err)
+	coin := r.object(ctx, t, "Coin", at(hall.ID))
+
+	t.Run("into a container", func(t *testing.T) {
+		delta, err := r.objects.Move(ctx, coin.ID, inside(bag.ID), coin.Version)
+		require.NoError(t, err)
+		assert.Equal(t, 2, delta.Primary.AfterVersion)
+
+		contents, err := r.objects.ListContainedIn(ctx, bag.ID)
+		require.NoError(t, err)
+		require.Len(t, contents, 1)
+		assert.Equal(t, coin.ID, contents[0].ID)
+	})
+
+	t.Run("into a non-container", func(t *testing.T) {
+		rock := r.object(ctx, t, "Rock", at(hall.ID))
+		pebble := r.object(ctx, t, "Pebble", at(hall.ID))
+		_, err := r.objects.Move(ctx, pebble.ID, inside(rock.ID), 0)
+		assert.ErrorIs(t, err, world.ErrInvalidContainment)
+	})
+
+	t.Run("into itself", func(t *testing.T) {
+		_, err := r.objects.Move(ctx, bag.ID, inside(bag.ID), 0)
+		assert.ErrorIs(t, err, world.ErrCircularContainment)
+	})
+
+	t.Run("into a missing container", func(t *testing.T) {
+		ghost := r.object(ctx, t, "Ghost", at(hall.ID))
+		_, err := r.objects.Delete(ctx, ghost.ID, 0)
+		require.NoError(t, err)
+		_, err = r.objects.Move(ctx, bag.ID, inside(ghost.ID), 0)
+		errutil.AssertErrorCode(t, err, "CONTAINER_NOT_FOUND")
+	})
+
+	t.Run("to a character", func(t *testing.T) {
+		_, err := r.objects.Move(ctx, bag.ID, heldBy(alice.ID), 0)
+		require.NoError(t, err)
+		held, err := r.objects.ListHeldBy(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, held, 1)
+		assert.Equal(t, bag.ID, held[0].ID)
+	})
+}
+
+func TestObjectRepository_NestingDepth(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	locations := memory.NewLocationRepository(store)
+	objects := memory.NewObjectRepositoryWithDepth(store, 2)
+
+	hall, err := world.NewLocation("Hall", "A hall.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	_, err = locations.Create(ctx, hall)
+	require.NoError(t, err)
+
+	container := func(name string) *world.Object {
+		obj, err := world.NewObject(name, at(hall.ID))
+		require.NoError(t, err)
+		obj.IsContainer = true
+		_, err = objects.Create(ctx, obj)
+		require.NoError(t, err)
+		return obj
+	}
+	outer, middle, inner := container("Outer"), container("Middle"), container("Inner")
+	_, err = objects.Move(ctx, middle.ID, inside(outer.ID), 0)
+	require.NoError(t, err)
+
+	_, err = objects.Move(ctx, inner.ID, inside(middle.ID), 0)
+	assert.ErrorIs(t, err, world.ErrNestingDepthExceeded)
+}
+
+func TestObjectRepository_Delete(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	bag := r.object(ctx, t, "Bag", at(hall.ID))
+	bag.IsContainer = true
+	_, err := r.objects.Update(ctx, bag)
+	require.NoError(t, err)
+	r.object(ctx, t, "Coin", inside(bag.ID))
+
+	_, err = r.objects.Delete(ctx, bag.ID, 0)
+	assert.ErrorIs(t, err, memory.ErrCheckViolation)
+
+	_, err = r.objects.Delete(ctx, bag.ID, 1)
+	errutil.AssertErrorCode(t, err, world.CodeConcurrentEdit)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// outboxEpoch is the epoch every envelope is stamped with. The store never
+// restarts a feed, so it never needs a new one.
+const outboxEpoch = 1
+
+// OutboxWriter implements world.OutboxWriter over a Store. Envelopes commit
+// and roll back with the transaction that wrote them, as outbox rows do.
+// Nothing relays them onto the event bus; tests read them back with
+// Envelopes.
+type OutboxWriter struct {
+	store *Store
+}
+
+// NewOutboxWriter creates a new OutboxWriter.
+func NewOutboxWriter(store *Store) *OutboxWriter {
+	return &OutboxWriter{store: store}
+}
+
+// WriteIntent allocates the next feed position for intent.GameID, finalizes
+// the envelope via wmodel.Finalize, records it, and returns it.
+func (w *OutboxWriter) WriteIntent(ctx context.Context, intent wmodel.EnvelopeIntent, delta *wmodel.MutationDelta) (*wmodel.Envelope, error) {
+	var env *wmodel.Envelope
+	err := w.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		t.feeds[intent.GameID]++
+		env = wmodel.Finalize(intent, delta, outboxEpoch, t.feeds[intent.GameID])
+		t.envelopes = append(t.envelopes, env)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// Envelopes returns the committed envelopes in the order they were written.
+func (w *OutboxWriter) Envelopes(ctx context.Context) ([]*wmodel.Envelope, error) {
+	var envs []*wmodel.Envelope
+	err := w.store.read(ctx, func(t *tables) error {
+		envs = slices.Clone(t.envelopes)
+		return nil
+	})
+	return envs, err
+}
+
+// Compile-time interface check.
+var _ world.OutboxWriter = (*OutboxWriter)(nil)
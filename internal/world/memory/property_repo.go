@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// maxVisibilityListSize is the maximum number of entries in visible_to or excluded_from.
+const maxVisibilityListSize = 100
+
+// PropertyRepository implements world.PropertyRepository over a Store.
+type PropertyRepository struct {
+	store *Store
+}
+
+// NewPropertyRepository creates a new PropertyRepository.
+func NewPropertyRepository(store *Store) *PropertyRepository {
+	return &PropertyRepository{store: store}
+}
+
+// Create persists a new entity property.
+func (r *PropertyRepository) Create(ctx context.Context, p *world.EntityProperty) error {
+	if err := applyVisibilityDefaults(p); err != nil {
+		return err
+	}
+	if err := validateVisibilityLists(p); err != nil {
+		return err
+	}
+	return r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.properties[p.ID]; ok {
+			return oops.Code("PROPERTY_CREATE_FAILED").With("id", p.ID.String()).Wrap(duplicateKey("create property", p.ID))
+		}
+		if err := checkPropertyRow(t, "PROPERTY_CREATE_FAILED", "create property", p); err != nil {
+			return err
+		}
+		stored := storedProperty(p)
+		stored.UpdatedAt = time.Now()
+		t.properties[p.ID] = stored
+		return nil
+	})
+}
+
+// Get retrieves an entity property by ID.
+func (r *PropertyRepository) Get(ctx context.Context, id ulid.ULID) (*world.EntityProperty, error) {
+	var prop *world.EntityProperty
+	err := r.store.read(ctx, func(t *tables) error {
+		stored, ok := t.properties[id]
+		if !ok {
+			return oops.Code("PROPERTY_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		prop = cloneProperty(stored)
+		return nil
+	})
+	return prop, err
+}
+
+// ListByParent returns all properties for the given parent entity, ordered
+// by name.
+func (r *PropertyRepository) ListByParent(ctx context.Context, parentType string, parentID ulid.ULID) ([]*world.EntityProperty, error) {
+	properties := make([]*world.EntityProperty, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, p := range t.properties {
+			if p.ParentType == parentType && p.ParentID == parentID {
+				properties = append(properties, cloneProperty(p))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(properties, func(a, b *world.EntityProperty) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return properties, nil
+}
+
+// Update modifies an existing entity property. Its parent and creation time
+// are left as they are.
+func (r *PropertyRepository) Update(ctx context.Context, p *world.EntityProperty) error {
+	if err := applyVisibilityDefaults(p); err != nil {
+		return err
+	}
+	if err := validateVisibilityLists(p); err != nil {
+		return err
+	}
+	return r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		prior, ok := t.properties[p.ID]
+		if !ok {
+			return oops.Code("PROPERTY_NOT_FOUND").With("id", p.ID.String()).Wrap(world.ErrNotFound)
+		}
+		stored := storedProperty(p)
+		stored.ParentType = prior.ParentType
+		stored.ParentID = prior.ParentID
+		stored.CreatedAt = prior.CreatedAt
+		stored.UpdatedAt = time.Now()
+		if err := checkPropertyRow(t, "PROPERTY_UPDATE_FAILED", "update property", stored); err != nil {
+			return err
+		}
+		t.properties[p.ID] = stored
+		return nil
+	})
+}
+
+// Delete removes an entity property by ID.
+func (r *PropertyRepository) Delete(ctx context.Context, id ulid.ULID) error {
+	return r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.properties[id]; !ok {
+			return oops.Code("PROPERTY_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound)
+		}
+		delete(t.properties, id)
+		return nil
+	})
+}
+
+// DeleteByParent removes all properties for the given parent entity.
+func (r *PropertyRepository) DeleteByParent(ctx context.Context, parentType string, parentID ulid.ULID) error {
+	return r.store.inTx(ctx, func(_ context.Context, t *tables) error {
+		for id, p := range t.properties {
+			if p.ParentType == parentType && p.ParentID == parentID {
+				delete(t.properties, id)
+			}
+		}
+		return nil
+	})
+}
+
+// checkPropertyRow enforces the entity_properties visibility check and the
+// unique (parent_type, parent_id, name) constraint. A bad visibility fails
+// with code, as the postgres write does.
+func checkPropertyRow(t *tables, code, operation string, p *world.EntityProperty) error {
+	switch p.Visibility {
+	case "public", "private", "restricted", "system", "admin":
+	default:
+		return oops.Code(code).
+			With("id", p.ID.String()).
+			Wrap(checkViolation(operation, "entity_properties_visibility_check", p.ID))
+	}
+	for id, other := range t.properties {
+		if id != p.ID && other.ParentType == p.ParentType && other.ParentID == p.ParentID && other.Name == p.Name {
+			return oops.Code("PROPERTY_DUPLICATE_NAME").
+				With("parent_type", p.ParentType).
+				With("parent_id", p.ParentID.String()).
+				With("name", p.Name).
+				Wrapf(ErrUniqueViolation, "property %q already exists for parent %s/%s", p.Name, p.ParentType, p.ParentID.String())
+		}
+	}
+	return nil
+}
+
+// applyVisibilityDefaults sets default visible_to and excluded_from for restricted visibility.
+// Per spec (03-property-model.md), visible_to defaults to [parent_id] to prevent "nobody can see it".
+func applyVisibilityDefaults(p *world.EntityProperty) error {
+	if p.Visibility != "restricted" {
+		return nil
+	}
+	if p.VisibleTo == nil {
+		p.VisibleTo = []string{p.ParentID.String()}
+	}
+	if p.ExcludedFrom == nil {
+		p.ExcludedFrom = []string{}
+	}
+	return nil
+}
+
+// validateVisibilityLists checks visibility list constraints, as the
+// postgres repository does. For non-restricted visibility it normalizes
+// empty slices to nil.
+func validateVisibilityLists(p *world.EntityProperty) error {
+	if p.Visibility != "restricted" {
+		if len(p.VisibleTo) > 0 {
+			return oops.Code("PROPERTY_INVALID_VISIBILITY").
+				With("visibility", p.Visibility).
+				With("field", "visible_to").
+				Errorf("visible_to must be empty for non-restricted visibility")
+		}
+		if len(p.ExcludedFrom) > 0 {
+			return oops.Code("PROPERTY_INVALID_VISIBILITY").
+				With("visibility", p.Visibility).
+				With("field", "excluded_from").
+				Errorf("excluded_from must be empty for non-restricted visibility")
+		}
+		p.VisibleTo = nil
+		p.ExcludedFrom = nil
+		return nil
+	}
+	if len(p.VisibleTo) > maxVisibilityListSize {
+		return oops.Code("PROPERTY_VISIBLE_TO_LIMIT").
+			With("count", len(p.VisibleTo)).
+			With("max", maxVisibilityListSize).
+			Errorf("visible_to exceeds maximum of %d entries", maxVisibilityListSize)
+	}
+	if len(p.ExcludedFrom) > maxVisibilityListSize {
+		return oops.Code("PROPERTY_EXCLUDED_FROM_LIMIT").
+			With("count", len(p.ExcludedFrom)).
+			With("max", maxVisibilityListSize).
+			Errorf("excluded_from exceeds maximum of %d entries", maxVisibilityListSize)
+	}
+	for _, e := range p.ExcludedFrom {
+		if slices.Contains(p.VisibleTo, e) {
+			return oops.Code("PROPERTY_VISIBILITY_OVERLAP").
+				With("overlapping", e).
+				Errorf("visible_to and excluded_from must not overlap")
+		}
+	}
+	return nil
+}
+
+// storedProperty returns the row the postgres repository would store for p:
+// nil flags read back as an empty list.
+func storedProperty(p *world.EntityProperty) *world.EntityProperty {
+	stored := cloneProperty(p)
+	if stored.Flags == nil {
+		stored.Flags = []string{}
+	}
+	return stored
+}
+
+// cloneProperty returns a copy of p sharing no memory with it.
+func cloneProperty(p *world.EntityProperty) *world.EntityProperty {
+	c := *p
+	if p.Value != nil {
+		v := *p.Value
+		c.Value = &v
+	}
+	if p.Owner != nil {
+		o := *p.Owner
+		c.Owner = &o
+	}
+	c.Flags = slices.Clone(p.Flags)
+	c.VisibleTo = slices.Clone(p.VisibleTo)
+	c.ExcludedFrom = slices.Clone(p.ExcludedFrom)
+	return &c
+}
+
+// Compile-time interface check.
+var _ world.PropertyRepository = (*PropertyRepository)(nil)
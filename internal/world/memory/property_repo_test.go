@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func newProperty(parentID ulid.ULID, name, visibility string) *world.EntityProperty {
+	return &world.EntityProperty{
+		ID:         ulid.Make(),
+		ParentType: "location",
+		ParentID:   parentID,
+		Name:       name,
+		Visibility: visibility,
+	}
+}
+
+func TestPropertyRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	parent := ulid.Make()
+
+	t.Run("restricted defaults visible_to to the parent", func(t *testing.T) {
+		p := newProperty(parent, "secret", "restricted")
+		require.NoError(t, r.properties.Create(ctx, p))
+
+		got, err := r.properties.Get(ctx, p.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{parent.String()}, got.VisibleTo)
+		assert.Equal(t, []string{}, got.ExcludedFrom)
+		assert.Equal(t, []string{}, got.Flags)
+	})
+
+	t.Run("duplicate name on one parent", func(t *testing.T) {
+		require.NoError(t, r.properties.Create(ctx, newProperty(parent, "color", "public")))
+		err := r.properties.Create(ctx, newProperty(parent, "color", "public"))
+		errutil.AssertErrorCode(t, err, "PROPERTY_DUPLICATE_NAME")
+	})
+
+	t.Run("lists on a non-restricted property", func(t *testing.T) {
+		p := newProperty(parent, "mood", "public")
+		p.VisibleTo = []string{"someone"}
+		errutil.AssertErrorCode(t, r.properties.Create(ctx, p), "PROPERTY_INVALID_VISIBILITY")
+	})
+
+	t.Run("overlapping lists", func(t *testing.T) {
+		p := newProperty(parent, "plan", "restricted")
+		p.VisibleTo = []string{"a"}
+		p.ExcludedFrom = []string{"a"}
+		errutil.AssertErrorCode(t, r.properties.Create(ctx, p), "PROPERTY_VISIBILITY_OVERLAP")
+	})
+
+	t.Run("unknown visibility", func(t *testing.T) {
+		errutil.AssertErrorCode(t, r.properties.Create(ctx, newProperty(parent, "odd", "everyone")), "PROPERTY_CREATE_FAILED")
+	})
+}
+
+func TestPropertyRepository_UpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	parent := ulid.Make()
+	a := newProperty(parent, "b-prop", "public")
+	b := newProperty(parent, "a-prop", "public")
+	require.NoError(t, r.properties.Create(ctx, a))
+	require.NoError(t, r.properties.Create(ctx, b))
+
+	listed, err := r.properties.ListByParent(ctx, "location", parent)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "a-prop", listed[0].Name, "ordered by name")
+
+	a.Name = "a-prop"
+	errutil.AssertErrorCode(t, r.properties.Update(ctx, a), "PROPERTY_DUPLICATE_NAME")
+	a.Name = "c-prop"
+	require.NoError(t, r.properties.Update(ctx, a))
+
+	errutil.AssertErrorCode(t, r.properties.Update(ctx, newProperty(parent, "ghost", "public")), "PROPERTY_NOT_FOUND")
+	errutil.AssertErrorCode(t, r.properties.Delete(ctx, ulid.Make()), "PROPERTY_NOT_FOUND")
+
+	require.NoError(t, r.properties.DeleteByParent(ctx, "location", parent))
+	require.NoError(t, r.properties.DeleteByParent(ctx, "location", parent))
+	listed, err = r.properties.ListByParent(ctx, "location", parent)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// SceneRepository implements world.SceneRepository over a Store. The world
+// layer has no participant write surface; seed participants with
+// Store.AddParticipant.
+type SceneRepository struct {
+	store *Store
+}
+
+// NewSceneRepository creates a new SceneRepository.
+func NewSceneRepository(store *Store) *SceneRepository {
+	return &SceneRepository{store: store}
+}
+
+// ListParticipants returns all participants in a scene, in the order they
+// joined.
+func (r *SceneRepository) ListParticipants(ctx context.Context, sceneID ulid.ULID) ([]world.SceneParticipant, error) {
+	participants := make([]world.SceneParticipant, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		scene, ok := t.locations[sceneID]
+		if !ok || scene.Type != world.LocationTypeScene {
+			return oops.With("scene_id", sceneID.String()).Wrap(world.ErrNotFound)
+		}
+		ps := slices.Clone(t.participants[sceneID])
+		slices.SortFunc(ps, func(a, b participant) int {
+			return cmp.Or(cmp.Compare(a.seq, b.seq), a.characterID.Compare(b.characterID))
+		})
+		for _, p := range ps {
+			participants = append(participants, world.SceneParticipant{CharacterID: p.characterID, Role: p.role})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return participants, nil
+}
+
+// GetScenesFor returns all scenes a character is participating in, newest
+// first.
+func (r *SceneRepository) GetScenesFor(ctx context.Context, characterID ulid.ULID) ([]*world.Location, error) {
+	scenes := make([]*world.Location, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for sceneID, ps := range t.participants {
+			if !slices.ContainsFunc(ps, func(p participant) bool { return p.characterID == characterID }) {
+				continue
+			}
+			if scene, ok := t.locations[sceneID]; ok {
+				scenes = append(scenes, cloneLocation(scene))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(scenes, newestFirst)
+	return scenes, nil
+}
+
+// ListScenes returns a page of the scenes matching filter, newest first,
+// ordered by (created_at, id) descending. An unrecognized filter.Status
+// matches no scene.
+func (r *SceneRepository) ListScenes(ctx context.Context, filter world.SceneFilter, opts world.ListOptions) (world.Page[*world.Location], error) {
+	var afterCreated time.Time
+	var afterID ulid.ULID
+	if opts.Cursor != "" {
+		key, id, err := world.DecodeCursor(opts.Cursor)
+		if err != nil {
+			return world.Page[*world.Location]{}, err
+		}
+		created, parseErr := time.Parse(time.RFC3339Nano, key)
+		if parseErr != nil {
+			return world.Page[*world.Location]{}, oops.Code(world.CodeInvalidCursor).
+				With("cursor", opts.Cursor).
+				Wrap(errors.Join(world.ErrInvalidCursor, parseErr))
+		}
+		afterCreated, afterID = created, id
+	}
+
+	scenes := make([]*world.Location, 0)
+	err := r.store.read(ctx, func(t *tables) error {
+		for _, l := range t.locations {
+			if l.Type != world.LocationTypeScene || !sceneStatusMatches(filter.Status, l) {
+				continue
+			}
+			if filter.Participant != nil && !slices.ContainsFunc(t.participants[l.ID], func(p participant) bool {
+				return p.characterID == *filter.Participant
+			}) {
+				continue
+			}
+			if filter.Location != nil && (l.ShadowsID == nil || *l.ShadowsID != *filter.Location) {
+				continue
+			}
+			if opts.Cursor != "" {
+				if l.CreatedAt.After(afterCreated) ||
+					(l.CreatedAt.Equal(afterCreated) && l.ID.Compare(afterID) >= 0) {
+					continue
+				}
+			}
+			scenes = append(scenes, cloneLocation(l))
+		}
+		return nil
+	})
+	if err != nil {
+		return world.Page[*world.Location]{}, err
+	}
+	slices.SortFunc(scenes, newestFirst)
+	size := opts.PageSize()
+	if len(scenes) > size+1 {
+		scenes = scenes[:size+1]
+	}
+	return world.NewPage(scenes, size, func(l *world.Location) string {
+		return world.EncodeCursor(l.CreatedAt.UTC().Format(time.RFC3339Nano), l.ID)
+	}), nil
+}
+
+func sceneStatusMatches(status world.SceneStatus, l *world.Location) bool {
+	switch status {
+	case world.SceneStatusAny:
+		return true
+	case world.SceneStatusOpen:
+		return l.ArchivedAt == nil
+	case world.SceneStatusArchived:
+		return l.ArchivedAt != nil
+	}
+	return false
+}
+
+// Compile-time interface check.
+var _ world.SceneRepository = (*SceneRepository)(nil)
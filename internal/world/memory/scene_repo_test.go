@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestSceneRepository_ListParticipants(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	scene := r.scene(ctx, t, "Tea", nil)
+	bob := r.character(ctx, t, "Bob", hall.ID)
+	alice := r.character(ctx, t, "Alice", hall.ID)
+	require.NoError(t, r.store.AddParticipant(ctx, scene.ID, bob.ID, world.RoleOwner))
+	require.NoError(t, r.store.AddParticipant(ctx, scene.ID, alice.ID, world.RoleInvited))
+
+	got, err := r.scenes.ListParticipants(ctx, scene.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []world.SceneParticipant{
+		{CharacterID: bob.ID, Role: world.RoleOwner},
+		{CharacterID: alice.ID, Role: world.RoleInvited},
+	}, got, "participants are listed in the order they joined")
+
+	_, err = r.scenes.ListParticipants(ctx, hall.ID)
+	assert.ErrorIs(t, err, world.ErrNotFound, "a persistent location is not a scene")
+
+	scenes, err := r.scenes.GetScenesFor(ctx, alice.ID)
+	require.NoError(t, err)
+	require.Len(t, scenes, 1)
+	assert.Equal(t, scene.ID, scenes[0].ID)
+}
+
+func TestSceneRepository_ListScenes(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	hall := r.location(ctx, t, "Hall")
+	alice := r.character(ctx, t, "Alice", hall.ID)
+
+	open := r.scene(ctx, t, "Open", &hall.ID)
+	archived := r.scene(ctx, t, "Archived", nil)
+	now := time.Now()
+	archived.ArchivedAt = &now
+	_, err := r.locations.Update(ctx, archived)
+	require.NoError(t, err)
+	require.NoError(t, r.store.AddParticipant(ctx, open.ID, alice.ID, world.RoleMember))
+
+	list := func(filter world.SceneFilter) []ulid.ULID {
+		t.Helper()
+		page, err := r.scenes.ListScenes(ctx, filter, world.ListOptions{})
+		require.NoError(t, err)
+		ids := make([]ulid.ULID, 0, len(page.Items))
+		for _, l := range page.Items {
+			ids = append(ids, l.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []ulid.ULID{archived.ID, open.ID}, list(world.SceneFilter{}), "newest first")
+	assert.Equal(t, []ulid.ULID{open.ID}, list(world.SceneFilter{Status: world.SceneStatusOpen}))
+	assert.Equal(t, []ulid.ULID{archived.ID}, list(world.SceneFilter{Status: world.SceneStatusArchived}))
+	assert.Equal(t, []ulid.ULID{open.ID}, list(world.SceneFilter{Participant: &alice.ID}))
+	assert.Equal(t, []ulid.ULID{open.ID}, list(world.SceneFilter{Location: &hall.ID}))
+
+	page, err := r.scenes.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Limit: 1})
+	require.NoError(t, err)
+	page, err = r.scenes.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Limit: 1, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.Equal(t, open.ID, page.Items[0].ID)
+	assert.Empty(t, page.NextCursor)
+
+	_, err = r.scenes.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Cursor: world.EncodeCursor("soon", open.ID)})
+	errutil.AssertErrorCode(t, err, world.CodeInvalidCursor)
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// TestServiceEndToEnd drives world.Service writes through the memory
+// repositories, transactor, and outbox, so the cascades and envelopes are
+// the store's rather than a mock's.
+func TestServiceEndToEnd(t *testing.T) {
+	ctx := context.Background()
+	const subject = "character:01ABC"
+	r := newRepos()
+	outbox := memory.NewOutboxWriter(r.store)
+	svc := world.NewService(world.ServiceConfig{
+		LocationRepo:  r.locations,
+		ExitRepo:      r.exits,
+		ObjectRepo:    r.objects,
+		SceneRepo:     r.scenes,
+		CharacterRepo: r.characters,
+		PropertyRepo:  r.properties,
+		Engine:        policytest.AllowAllEngine(),
+		Transactor:    memory.NewTransactor(r.store),
+		OutboxWriter:  outbox,
+		GameID:        "test-game",
+	})
+
+	hall := &world.Location{Name: "Hall", Description: "A hall.", Type: world.LocationTypePersistent, ReplayPolicy: "last:0"}
+	yard := &world.Location{Name: "Yard", Description: "A yard.", Type: world.LocationTypePersistent, ReplayPolicy: "last:0"}
+	require.NoError(t, svc.CreateLocation(ctx, subject, hall))
+	require.NoError(t, svc.CreateLocation(ctx, subject, yard))
+	exit := &world.Exit{
+		FromLocationID: hall.ID, ToLocationID: yard.ID, Name: "out",
+		Bidirectional: true, ReturnName: "in", Visibility: world.VisibilityAll,
+	}
+	require.NoError(t, svc.CreateExit(ctx, subject, exit))
+
+	t.Run("a failed write emits no envelope", func(t *testing.T) {
+		alice := r.character(ctx, t, "Alice", yard.ID)
+		before, err := outbox.Envelopes(ctx)
+		require.NoError(t, err)
+
+		require.Error(t, svc.DeleteLocation(ctx, subject, yard.ID))
+
+		after, err := outbox.Envelopes(ctx)
+		require.NoError(t, err)
+		assert.Len(t, after, len(before))
+		_, err = r.characters.Delete(ctx, alice.ID, 0)
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, svc.DeleteLocation(ctx, subject, hall.ID))
+
+	envs, err := outbox.Envelopes(ctx)
+	require.NoError(t, err)
+	require.Len(t, envs, 4)
+	for i, env := range envs {
+		assert.Equal(t, "test-game", env.GameID)
+		assert.Equal(t, int64(i+1), env.FeedPosition)
+	}
+	last := envs[3]
+	assert.Equal(t, hall.ID, last.AggregateID)
+	var exitTombstones int
+	for _, a := range last.Affected {
+		if a.Type == wmodel.AggregateExit && a.Tombstone {
+			exitTombstones++
+		}
+	}
+	assert.Equal(t, 2, exitTombstones, "both directions of the exit cascade with the location")
+
+	_, err = r.exits.FindByName(ctx, yard.ID, "in")
+	assert.ErrorIs(t, err, world.ErrNotFound)
+}
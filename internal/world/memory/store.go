@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package memory provides in-memory implementations of the world
+// repositories, for tests that need the repositories to behave like the
+// database rather than be scripted with mocks.
+//
+// All repositories built on one Store share its tables, so writes through
+// one are seen by the others, and the foreign keys, unique constraints, and
+// checks of the PostgreSQL schema are enforced across them: deleting a
+// location cascades to its exits and fails while characters stand in it, an
+// object cannot be contained in itself, and so on. Versions, not-found
+// codes, and MutationDeltas match the postgres repositories.
+//
+// Transactions are snapshots. Transactor.InTransaction runs fn against a
+// private copy of the tables that replaces them on success and is dropped
+// on error; repository calls outside a transaction run in one of their own.
+// Transactions are serialized, so two never interleave.
+//
+// OutboxWriter records the envelopes the write executor emits, so a
+// world.Service wired with these repositories runs its writes end to end.
+//
+// Player accounts are not modeled: characters.player_id is not checked.
+//
+// This package is for tests only. The server does not use it, and there is
+// no database-free dev mode built on it: the server still requires
+// PostgreSQL for accounts, sessions, access policy, and the event store.
+package memory
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// Errors for writes the PostgreSQL schema would reject. The postgres
+// repositories return the driver's error for these; tests check for them
+// with errors.Is.
+var (
+	// ErrForeignKeyViolation is returned when a write names a row that does
+	// not exist, or a delete would leave rows naming the deleted one.
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+
+	// ErrUniqueViolation is returned when a write duplicates a unique key.
+	ErrUniqueViolation = errors.New("unique violation")
+
+	// ErrCheckViolation is returned when a row fails a check constraint.
+	ErrCheckViolation = errors.New("check constraint violation")
+)
+
+// participant is one scene_participants row.
+type participant struct {
+	characterID ulid.ULID
+	role        world.ParticipantRole
+	seq         int64
+}
+
+// tables holds the rows of every world table the repositories cover.
+// Rows are owned by the tables: they are copied on the way in and out.
+type tables struct {
+	locations    map[ulid.ULID]*world.Location
+	exits        map[ulid.ULID]*world.Exit
+	objects      map[ulid.ULID]*world.Object
+	characters   map[ulid.ULID]*world.Character
+	preferences  map[ulid.ULID][]byte
+	participants map[ulid.ULID][]participant
+	properties   map[ulid.ULID]*world.EntityProperty
+	envelopes    []*wmodel.Envelope
+	feeds        map[string]int64
+	seq          int64
+}
+
+func newTables() *tables {
+	return &tables{
+		locations:    make(map[ulid.ULID]*world.Location),
+		exits:        make(map[ulid.ULID]*world.Exit),
+		objects:      make(map[ulid.ULID]*world.Object),
+		characters:   make(map[ulid.ULID]*world.Character),
+		preferences:  make(map[ulid.ULID][]byte),
+		participants: make(map[ulid.ULID][]participant),
+		properties:   make(map[ulid.ULID]*world.EntityProperty),
+		feeds:        make(map[string]int64),
+	}
+}
+
+// clone copies t for a transaction. Rows are never mutated in place, only
+// replaced, so the maps are copied and the rows shared.
+func (t *tables) clone() *tables {
+	c := &tables{
+		locations:    maps.Clone(t.locations),
+		exits:        maps.Clone(t.exits),
+		objects:      maps.Clone(t.objects),
+		characters:   maps.Clone(t.characters),
+		preferences:  maps.Clone(t.preferences),
+		participants: make(map[ulid.ULID][]participant, len(t.participants)),
+		properties:   maps.Clone(t.properties),
+		envelopes:    slices.Clone(t.envelopes),
+		feeds:        maps.Clone(t.feeds),
+		seq:          t.seq,
+	}
+	for id, ps := range t.participants {
+		c.participants[id] = slices.Clone(ps)
+	}
+	return c
+}
+
+// next returns the next value of the store's sequence, which orders rows
+// inserted in the same instant.
+func (t *tables) next() int64 {
+	t.seq++
+	return t.seq
+}
+
+// Store is an in-memory world database. The zero value is not usable; use
+// NewStore. A Store is safe for concurrent use.
+type Store struct {
+	// writeMu serializes transactions; it is held for the whole of one.
+	writeMu sync.Mutex
+	// mu guards data, the committed tables.
+	mu   sync.RWMutex
+	data *tables
+}
+
+// NewStore creates an empty Store.
+func NewStore() *Store {
+	return &Store{data: newTables()}
+}
+
+// txKey is the context key for an active transaction.
+type txKey struct{}
+
+// tx is an open transaction: the private tables it writes to.
+type tx struct {
+	store *Store
+	data  *tables
+}
+
+// txFromContext returns the transaction on s stored in ctx, or nil.
+func (s *Store) txFromContext(ctx context.Context) *tx {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok || t.store != s {
+		return nil
+	}
+	return t
+}
+
+// inTx runs fn in the ambient transaction on s if ctx has one, else in a
+// new transaction that commits when fn succeeds. Like the postgres withTx,
+// the outermost caller owns the commit.
+func (s *Store) inTx(ctx context.Context, fn func(ctx context.Context, t *tables) error) error {
+	if t := s.txFromContext(ctx); t != nil {
+		return fn(ctx, t.data)
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.mu.RLock()
+	t := &tx{store: s, data: s.data.clone()}
+	s.mu.RUnlock()
+
+	if err := fn(context.WithValue(ctx, txKey{}, t), t.data); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.data = t.data
+	s.mu.Unlock()
+	return nil
+}
+
+// read runs fn against the tables ctx sees: the ambient transaction's, or
+// the committed ones.
+func (s *Store) read(ctx context.Context, fn func(t *tables) error) error {
+	if t := s.txFromContext(ctx); t != nil {
+		return fn(t.data)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(s.data)
+}
+
+// Transactor implements world.Transactor over a Store.
+type Transactor struct {
+	store *Store
+}
+
+// NewTransactor creates a Transactor over store.
+func NewTransactor(store *Store) *Transactor {
+	return &Transactor{store: store}
+}
+
+// InTransaction runs fn in a transaction. Repository calls on the same
+// Store made with the ctx fn receives join it. A nested call joins the
+// outer transaction. If fn returns an error, none of its writes are kept.
+func (t *Transactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return t.store.inTx(ctx, func(txCtx context.Context, _ *tables) error {
+		return fn(txCtx)
+	})
+}
+
+// Compile-time interface check.
+var _ world.Transactor = (*Transactor)(nil)
+
+// AddParticipant adds a character to a scene, or changes its role if it is
+// already a participant. world.SceneRepository is read-only, so tests seed
+// participants here.
+func (s *Store) AddParticipant(ctx context.Context, sceneID, characterID ulid.ULID, role world.ParticipantRole) error {
+	return s.inTx(ctx, func(_ context.Context, t *tables) error {
+		if _, ok := t.locations[sceneID]; !ok {
+			return fkViolation("add participant", "scene_id", sceneID)
+		}
+		if _, ok := t.characters[characterID]; !ok {
+			return fkViolation("add participant", "character_id", characterID)
+		}
+		if role == "" {
+			role = world.RoleMember
+		}
+		ps := t.participants[sceneID]
+		for i := range ps {
+			if ps[i].characterID == characterID {
+				ps[i].role = role
+				return nil
+			}
+		}
+		t.participants[sceneID] = append(ps, participant{characterID: characterID, role: role, seq: t.next()})
+		return nil
+	})
+}
+
+// primaryDeltaVersioned builds a primary-only MutationDelta carrying the
+// before/after versions of the row transition, as the postgres helper does.
+func primaryDeltaVersioned(t wmodel.AggregateType, id ulid.ULID, tombstone bool, before, after int) *wmodel.MutationDelta {
+	return &wmodel.MutationDelta{
+		Primary: wmodel.AffectedAggregate{
+			Type:          t,
+			ID:            id,
+			Tombstone:     tombstone,
+			BeforeVersion: before,
+			AfterVersion:  after,
+		},
+	}
+}
+
+// checkVersion applies the version-predicated CAS (MODEL-03): a non-zero
+// expected version that differs from the row's is WORLD_CONCURRENT_EDIT.
+func checkVersion(id ulid.ULID, expected, current int) error {
+	if expected > 0 && expected != current {
+		return oops.Code(world.CodeConcurrentEdit).
+			With("id", id.String()).
+			With("expected_version", expected).
+			With("current_version", current).
+			Wrap(world.ErrConcurrentEdit)
+	}
+	return nil
+}
+
+func fkViolation(operation, column string, id ulid.ULID) error {
+	return oops.With("operation", operation).With(column, id.String()).
+		Wrapf(ErrForeignKeyViolation, "%s %s does not exist", column, id)
+}
+
+func fkReferenced(operation string, id ulid.ULID, table, column string) error {
+	return oops.With("operation", operation).With("id", id.String()).
+		Wrapf(ErrForeignKeyViolation, "still referenced from %s.%s", table, column)
+}
+
+func checkViolation(operation, constraint string, id ulid.ULID) error {
+	return oops.With("operation", operation).With("id", id.String()).With("constraint", constraint).
+		Wrapf(ErrCheckViolation, "violates check constraint %s", constraint)
+}
+
+func duplicateKey(operation string, id ulid.ULID) error {
+	return oops.With("operation", operation).With("id", id.String()).
+		Wrapf(ErrUniqueViolation, "duplicate key %s", id)
+}
+
+// cloneID copies an optional ID so a stored row shares no pointer with the
+// caller.
+func cloneID(id *ulid.ULID) *ulid.ULID {
+	if id == nil {
+		return nil
+	}
+	c := *id
+	return &c
+}
+
+// storedTags maps an empty tag set to nil, as the postgres repositories
+// read an empty tags array back.
+func storedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return slices.Clone(tags)
+}
+
+// hasTag reports whether tags contains tag.
+func hasTag(tags []string, tag string) bool {
+	return slices.Contains(tags, tag)
+}
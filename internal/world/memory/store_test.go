@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/memory"
+)
+
+func TestTransactor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commits every write on success", func(t *testing.T) {
+		r := newRepos()
+		tx := memory.NewTransactor(r.store)
+		var loc *world.Location
+		err := tx.InTransaction(ctx, func(ctx context.Context) error {
+			loc = r.location(ctx, t, "Hall")
+			r.character(ctx, t, "Alice", loc.ID)
+			return nil
+		})
+		require.NoError(t, err)
+
+		page, err := r.characters.GetByLocation(ctx, loc.ID, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+	})
+
+	t.Run("rolls back every write on error", func(t *testing.T) {
+		r := newRepos()
+		tx := memory.NewTransactor(r.store)
+		boom := errors.New("boom")
+		var loc *world.Location
+		err := tx.InTransaction(ctx, func(ctx context.Context) error {
+			loc = r.location(ctx, t, "Hall")
+			return boom
+		})
+		require.ErrorIs(t, err, boom)
+
+		_, err = r.locations.Get(ctx, loc.ID)
+		assert.ErrorIs(t, err, world.ErrNotFound)
+	})
+
+	t.Run("reads inside see the transaction's writes", func(t *testing.T) {
+		r := newRepos()
+		tx := memory.NewTransactor(r.store)
+		err := tx.InTransaction(ctx, func(ctx context.Context) error {
+			loc := r.location(ctx, t, "Hall")
+			got, err := r.locations.Get(ctx, loc.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Hall", got.Name)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("nested transactions join the outer one", func(t *testing.T) {
+		r := newRepos()
+		tx := memory.NewTransactor(r.store)
+		boom := errors.New("boom")
+		var loc *world.Location
+		err := tx.InTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, tx.InTransaction(ctx, func(ctx context.Context) error {
+				loc = r.location(ctx, t, "Hall")
+				return nil
+			}))
+			return boom
+		})
+		require.ErrorIs(t, err, boom)
+
+		_, err = r.locations.Get(ctx, loc.ID)
+		assert.ErrorIs(t, err, world.ErrNotFound)
+	})
+}
+
+func TestStoreConcurrentWrites(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	loc := r.location(ctx, t, "Hall")
+
+	var wg sync.WaitGroup
+	for range 20 {
+		wg.Go(func() {
+			_, err := r.locations.SetTags(ctx, loc.ID, []string{"busy"}, 0)
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	got, err := r.locations.Get(ctx, loc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 21, got.Version)
+}
+
+func TestStoreAddParticipant(t *testing.T) {
+	ctx := context.Background()
+	r := newRepos()
+	loc := r.location(ctx, t, "Hall")
+	scene := r.scene(ctx, t, "Tea", nil)
+	alice := r.character(ctx, t, "Alice", loc.ID)
+
+	t.Run("defaults the role to member and updates it in place", func(t *testing.T) {
+		require.NoError(t, r.store.AddParticipant(ctx, scene.ID, alice.ID, ""))
+		require.NoError(t, r.store.AddParticipant(ctx, scene.ID, alice.ID, world.RoleOwner))
+
+		got, err := r.scenes.ListParticipants(ctx, scene.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []world.SceneParticipant{{CharacterID: alice.ID, Role: world.RoleOwner}}, got)
+	})
+
+	t.Run("rejects an unknown character", func(t *testing.T) {
+		char, err := world.NewCharacter(alice.PlayerID, "Ghost")
+		require.NoError(t, err)
+		err = r.store.AddParticipant(ctx, scene.ID, char.ID, world.RoleMember)
+		assert.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+	})
+}
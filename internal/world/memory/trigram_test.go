@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"north", "north", 1},
+		{"North", "north", 1},
+		{"north", "", 0},
+		// pg_trgm: "  n"," no","nor","ort","rth","th " vs "  n"," no","nor","ort","rt " → 4/7.
+		{"north", "nort", 4.0 / 7.0},
+		{"east", "west", 1.0 / 9.0},
+	}
+	for _, tt := range tests {
+		assert.InDelta(t, tt.want, similarity(tt.a, tt.b), 1e-9, "%q vs %q", tt.a, tt.b)
+	}
+}
@@ -28,9 +28,9 @@ type Mutator interface {
 	// GetCharacter retrieves a character by ID after checking read authorization.
 	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*Character, error)
 
-	// GetCharactersByLocation retrieves characters at a location with pagination
+	// GetCharactersByLocation returns a page of the characters at a location
 	// after checking read authorization.
-	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts ListOptions) ([]*Character, error)
+	GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts ListOptions) (Page[*Character], error)
 
 	// GetObject retrieves an object by ID after checking read authorization.
 	GetObject(ctx context.Context, subjectID string, id ulid.ULID) (*Object, error)
@@ -93,10 +93,28 @@ var writeCommands = []WriteCommandDescriptor{
 	{Command: "UpdateObject", Kind: kindObjectUpdated},
 	{Command: "DeleteObject", Kind: kindObjectDeleted},
 	{Command: "MoveObject", Kind: kindObjectMoved},
+	{Command: "LockObject", Kind: kindObjectLocked},
+	{Command: "UnlockObject", Kind: kindObjectUnlocked},
+	{Command: "TransferOwnership", Kind: kindObjectOwnershipTransferred},
+	{Command: "DefineObjectVerb", Kind: kindObjectVerbDefined},
+	{Command: "RemoveObjectVerb", Kind: kindObjectVerbRemoved},
+	{Command: "WearObject", Kind: kindObjectWorn},
+	{Command: "TakeOffObject", Kind: kindObjectTakenOff},
 	{Command: "DeleteCharacter", Kind: kindCharacterDeleted},
 	{Command: "UpdateCharacterDescription", Kind: kindCharacterUpdated},
+	{Command: "SetCharacterVisibility", Kind: kindCharacterVisibilityChanged},
+	{Command: "ApplyDescriptionEffect", Kind: kindCharacterEffectApplied},
+	{Command: "RemoveDescriptionEffect", Kind: kindCharacterEffectRemoved},
 	{Command: "MoveCharacter", Kind: kindCharacterMoved},
 	{Command: "UpdateCharacterPreferences", Kind: kindCharacterPreferencesUpdate},
+	{Command: "AnonymizeCharacter", Kind: kindCharacterAnonymized},
+	{Command: "TagLocation", Kind: kindLocationTagged},
+	{Command: "UntagLocation", Kind: kindLocationUntagged},
+	{Command: "TagObject", Kind: kindObjectTagged},
+	{Command: "UntagObject", Kind: kindObjectUntagged},
+	{Command: "TagCharacter", Kind: kindCharacterTagged},
+	{Command: "UntagCharacter", Kind: kindCharacterUntagged},
+	{Command: "SetProperty", Kind: kindPropertySet},
 }
 
 // WriteCommands returns the explicit closed write-command descriptor set (a copy),
@@ -262,6 +280,21 @@ func (m *worldMutator) updateCharacter(ctx context.Context, intent wmodel.Envelo
 	})
 }
 
+// anonymizeCharacter routes an account-deletion anonymization through mutate()
+// (character_anonymized). The closure deletes the character's properties then
+// writes the scrubbed row; the row itself survives so historical events keep
+// resolving their actor, now to the tombstone identity.
+func (m *worldMutator) anonymizeCharacter(ctx context.Context, intent wmodel.EnvelopeIntent, char *Character) (*wmodel.MutationDelta, error) {
+	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
+		if err := m.propertyWriter.DeleteByParent(txCtx, "character", char.ID); err != nil {
+			return nil, oops.Code("CHARACTER_ANONYMIZE_FAILED").
+				With("operation", "delete_character_properties").
+				Wrapf(err, "delete properties for character %s", char.ID)
+		}
+		return m.characterWriter.Update(txCtx, char)
+	})
+}
+
 // deleteCharacter routes a character delete + its property cascade through
 // mutate() (character_deleted tombstone — the SAME kind the guest
 // CharacterReapingService reuses, 05-16/D-06; consumers treat all character
@@ -374,15 +407,59 @@ func (m *worldMutator) updateObject(ctx context.Context, intent wmodel.EnvelopeI
 // deleteObject routes an object delete + its property cascade through mutate()
 // (object_deleted tombstone). The closure deletes the object's properties then the
 // object row; the single tombstone envelope's manifest is finalized from the repo
-// delta.
-func (m *worldMutator) deleteObject(ctx context.Context, intent wmodel.EnvelopeIntent, id ulid.ULID) (*wmodel.MutationDelta, error) {
+// delta. expectedVersion guards the row delete; 0 deletes unconditionally.
+func (m *worldMutator) deleteObject(ctx context.Context, intent wmodel.EnvelopeIntent, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
 	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
 		if err := m.propertyWriter.DeleteByParent(txCtx, "object", id); err != nil {
 			return nil, oops.Code("OBJECT_DELETE_FAILED").
 				With("operation", "delete_object_properties").
 				Wrapf(err, "delete properties for object %s", id)
 		}
-		return m.objectWriter.Delete(txCtx, id, 0)
+		return m.objectWriter.Delete(txCtx, id, expectedVersion)
+	})
+}
+
+// setLocationTags routes a location tag change through mutate()
+// (location_tagged / location_untagged). tags is the whole new tag set;
+// expectedVersion is the location's read version (the CAS guard).
+func (m *worldMutator) setLocationTags(ctx context.Context, intent wmodel.EnvelopeIntent, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
+		return m.locationWriter.SetTags(txCtx, id, tags, expectedVersion)
+	})
+}
+
+// setObjectTags routes an object tag change through mutate() (object_tagged /
+// object_untagged).
+func (m *worldMutator) setObjectTags(ctx context.Context, intent wmodel.EnvelopeIntent, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
+		return m.objectWriter.SetTags(txCtx, id, tags, expectedVersion)
+	})
+}
+
+// setCharacterTags routes a character tag change through mutate()
+// (character_tagged / character_untagged).
+func (m *worldMutator) setCharacterTags(ctx context.Context, intent wmodel.EnvelopeIntent, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
+		return m.characterWriter.SetTags(txCtx, id, tags, expectedVersion)
+	})
+}
+
+// setProperty routes a property write through mutate() (property_set),
+// inserting the row when create is set and updating it otherwise.
+// Properties carry no version guard, so the delta reports the property
+// with zero versions.
+func (m *worldMutator) setProperty(ctx context.Context, intent wmodel.EnvelopeIntent, prop *EntityProperty, create bool) (*wmodel.MutationDelta, error) {
+	return m.mutate(ctx, intent, func(txCtx context.Context) (*wmodel.MutationDelta, error) {
+		write := m.propertyWriter.Update
+		if create {
+			write = m.propertyWriter.Create
+		}
+		if err := write(txCtx, prop); err != nil {
+			return nil, oops.Wrapf(err, "set property %s", prop.ID)
+		}
+		return &wmodel.MutationDelta{
+			Primary: wmodel.AffectedAggregate{Type: wmodel.AggregateProperty, ID: prop.ID},
+		}, nil
 	})
 }
 
@@ -128,7 +128,24 @@ type Object struct {
 	containedInObjectID *ulid.ULID // unexported: use SetContainment/ContainedInObjectID()
 	IsContainer         bool
 	OwnerID             *ulid.ULID
-	CreatedAt           time.Time
+	// Locked protects the object from non-owners: while set, only the owner
+	// (or an admin) may write, move, or delete it. Change it with
+	// Service.LockObject/UnlockObject, which enforce owner rights.
+	Locked bool
+	// Verbs are the named verbs the object carries, each bound to a plugin
+	// handler. Change them with Service.DefineObjectVerb/RemoveObjectVerb.
+	Verbs []ObjectVerb
+	// WearDescription is what the object adds to its wearer's description
+	// while worn, e.g. "A heavy wool cloak hangs from her shoulders."
+	WearDescription string
+	// WornAt is when the holding character put the object on; zero when it
+	// is not worn. Change it with Service.WearObject/RemoveWornObject.
+	WornAt time.Time
+	// Tags are the object's free-form labels (see ValidateTag), kept sorted.
+	// Change them with Service.TagObject/UntagObject; Update does not write
+	// them.
+	Tags      []string
+	CreatedAt time.Time
 	// Version is the optimistic-concurrency version (MODEL-03). It carries the
 	// read version back into a guarded CAS write (... WHERE id=$1 AND version=$2)
 	// and is refreshed by the repo to the committed version after a successful
@@ -186,20 +203,30 @@ func (o *Object) Containment() Containment {
 }
 
 // SetContainment updates the object's location.
-// Clears all previous containment and sets the new one.
+// Clears all previous containment and sets the new one. An object that
+// leaves its holder's hands is no longer worn.
 // Returns ErrInvalidContainment if the containment is invalid (not exactly one field set).
 func (o *Object) SetContainment(c Containment) error {
 	if err := c.Validate(); err != nil {
 		return err
 	}
+	if c.CharacterID == nil || o.heldByCharacterID == nil || *c.CharacterID != *o.heldByCharacterID {
+		o.WornAt = time.Time{}
+	}
 	o.locationID = c.LocationID
 	o.heldByCharacterID = c.CharacterID
 	o.containedInObjectID = c.ObjectID
 	return nil
 }
 
+// Worn reports whether the object's holder is wearing it.
+func (o *Object) Worn() bool {
+	return !o.WornAt.IsZero() && o.heldByCharacterID != nil
+}
+
 // Validate validates the object's fields.
-// Returns a ValidationError if the ID is zero, or if name or description is invalid.
+// Returns a ValidationError if the ID is zero, or if name, description, or wear
+// description is invalid.
 func (o *Object) Validate() error {
 	if o.ID.IsZero() {
 		return &ValidationError{Field: "id", Message: "cannot be zero"}
@@ -207,7 +234,10 @@ func (o *Object) Validate() error {
 	if err := ValidateName(o.Name); err != nil {
 		return err
 	}
-	return ValidateDescription(o.Description)
+	if err := ValidateDescription(o.Description); err != nil {
+		return err
+	}
+	return ValidateWearDescription(o.WearDescription)
 }
 
 // ValidateContainment checks that the object has valid containment (exactly one location).
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// ownedTestObject returns an object in a location owned by ownerID.
+func ownedTestObject(t *testing.T, ownerID ulid.ULID, locked bool) *world.Object {
+	t.Helper()
+	obj, err := world.NewObject("Lantern", world.InLocation(ulid.Make()))
+	require.NoError(t, err)
+	obj.OwnerID = &ownerID
+	obj.Locked = locked
+	obj.Version = 2
+	return obj
+}
+
+func TestWorldService_LockObject(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+
+	t.Run("owner locks the object and an object_locked envelope is emitted", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return o.ID == obj.ID && o.Locked && o.Version == 2
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.LockObject(ctx, subjectID, obj.ID))
+		assert.Equal(t, "object_locked", outbox.lastIntent.Kind)
+		var payload world.ObjectLockChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.ObjectLockChangePayload{ObjectID: obj.ID.String(), OwnerID: ownerID.String()}, payload)
+	})
+
+	t.Run("locking a locked object is a no-op", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, true)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		require.NoError(t, svc.LockObject(ctx, subjectID, obj.ID))
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("unowned objects cannot be locked", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		obj.OwnerID = nil
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		err := svc.LockObject(ctx, subjectID, obj.ID)
+		errutil.AssertErrorCode(t, err, "OBJECT_NO_OWNER")
+	})
+
+	t.Run("without the owner right the lock is denied", func(t *testing.T) {
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: objRepo, Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.LockObject(ctx, subjectID, ulid.Make())
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+}
+
+func TestWorldService_UnlockObject(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, true)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "unlock", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	outbox := &mockOutboxWriter{}
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+		return !o.Locked
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.UnlockObject(ctx, subjectID, obj.ID))
+	assert.Equal(t, "object_unlocked", outbox.lastIntent.Kind)
+}
+
+func TestWorldService_TransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+
+	t.Run("hands the object to another character and keeps the lock", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, true)
+		heirID := ulid.Make()
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "transfer", access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: objRepo, CharacterRepo: charRepo, Engine: engine,
+		}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		charRepo.EXPECT().Get(mock.Anything, heirID).Return(&world.Character{ID: heirID}, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return o.OwnerID != nil && *o.OwnerID == heirID && o.Locked
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.TransferOwnership(ctx, subjectID, obj.ID, heirID))
+		assert.Equal(t, "object_ownership_transferred", outbox.lastIntent.Kind)
+		var payload world.ObjectOwnershipChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, heirID.String(), payload.OwnerID)
+		require.NotNil(t, payload.FromOwnerID)
+		assert.Equal(t, ownerID.String(), *payload.FromOwnerID)
+	})
+
+	t.Run("rejects an unknown new owner", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "transfer", access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: objRepo, CharacterRepo: charRepo, Engine: engine,
+		}, &mockOutboxWriter{}))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		charRepo.EXPECT().Get(mock.Anything, mock.Anything).Return(nil, world.ErrNotFound).Once()
+
+		err := svc.TransferOwnership(ctx, subjectID, obj.ID, ulid.Make())
+		errutil.AssertErrorCode(t, err, "OBJECT_INVALID")
+	})
+}
+
+func TestWorldService_MoveLockedObjectReportsLock(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	takerID := access.CharacterSubject(ulid.Make().String())
+	obj := ownedTestObject(t, ownerID, true)
+
+	// The taker can see the object but the lock forbids writing it.
+	engine := policytest.NewGrantEngine()
+	engine.Grant(takerID, "read", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+	err := svc.MoveObject(ctx, takerID, obj.ID, world.HeldByCharacter(ulid.Make()))
+	errutil.AssertErrorCode(t, err, world.CodeObjectLocked)
+	assert.ErrorIs(t, err, world.ErrObjectLocked)
+	assert.ErrorIs(t, err, world.ErrPermissionDenied)
+
+	t.Run("subjects that cannot see the object get a plain denial", func(t *testing.T) {
+		stranger := access.CharacterSubject(ulid.Make().String())
+		err := svc.MoveObject(ctx, stranger, obj.ID, world.HeldByCharacter(ulid.Make()))
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_DENIED")
+		assert.False(t, errors.Is(err, world.ErrObjectLocked))
+	})
+}
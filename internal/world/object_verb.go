@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// Object verb limits.
+const (
+	// MaxObjectVerbs bounds how many verbs one object may carry.
+	MaxObjectVerbs = 32
+	// MaxVerbNameLength bounds a verb name in bytes.
+	MaxVerbNameLength = 32
+	// MaxVerbDescriptionLength bounds a verb's description in bytes.
+	MaxVerbDescriptionLength = 200
+	// MaxVerbArgsLength bounds the text a character passes to a verb.
+	MaxVerbArgsLength = MaxDescriptionLength
+)
+
+// Object verb ABAC actions, checked on the object.
+const (
+	// ActionDefineVerb guards DefineObjectVerb and RemoveObjectVerb.
+	ActionDefineVerb = "define_verb"
+	// ActionTriggerVerb guards TriggerObjectVerb.
+	ActionTriggerVerb = "trigger_verb"
+)
+
+// verbNameRegex matches a lowercase letter followed by lowercase letters,
+// digits, or underscores (e.g. "push", "ring_bell").
+var verbNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// verbPluginRegex matches a plugin name as plugin manifests declare it.
+var verbPluginRegex = regexp.MustCompile(`^[a-z](-?[a-z0-9])*$`)
+
+// ObjectVerb is a named verb an object carries, e.g. "push" on a button.
+// Triggering it hands a verb:<name> event to the plugin named by Plugin.
+type ObjectVerb struct {
+	Name   string `json:"name"`
+	Plugin string `json:"plugin"`
+	// Description is an optional hint shown when the object's verbs are
+	// listed, e.g. "rings the harbor bell".
+	Description string `json:"description,omitempty"`
+}
+
+// Validate checks the verb's name, plugin, and description.
+func (v ObjectVerb) Validate() error {
+	if err := ValidateVerbName(v.Name); err != nil {
+		return err
+	}
+	if v.Plugin == "" {
+		return &ValidationError{Field: "plugin", Message: "cannot be empty"}
+	}
+	if !verbPluginRegex.MatchString(v.Plugin) {
+		return &ValidationError{Field: "plugin", Message: "must be a plugin name (lowercase letters, digits, and single hyphens)"}
+	}
+	if !utf8.ValidString(v.Description) {
+		return &ValidationError{Field: "description", Message: "must be valid UTF-8"}
+	}
+	if len(v.Description) > MaxVerbDescriptionLength {
+		return &ValidationError{Field: "description", Message: fmt.Sprintf("exceeds maximum length of %d", MaxVerbDescriptionLength)}
+	}
+	return nil
+}
+
+// ValidateVerbName checks that name is a well-formed verb name.
+func ValidateVerbName(name string) error {
+	if name == "" {
+		return &ValidationError{Field: "verb", Message: "cannot be empty"}
+	}
+	if len(name) > MaxVerbNameLength {
+		return &ValidationError{Field: "verb", Message: fmt.Sprintf("exceeds maximum length of %d", MaxVerbNameLength)}
+	}
+	if !verbNameRegex.MatchString(name) {
+		return &ValidationError{Field: "verb", Message: "must be a lowercase letter followed by letters, digits, or underscores"}
+	}
+	return nil
+}
+
+// Verb returns the object's verb named name, if it has one.
+func (o *Object) Verb(name string) (ObjectVerb, bool) {
+	for _, v := range o.Verbs {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return ObjectVerb{}, false
+}
+
+// VerbInvocation is one character triggering a verb on an object.
+type VerbInvocation struct {
+	ObjectID ulid.ULID
+	Verb     string
+	// Args is the rest of the command line, e.g. "twice" in
+	// "push button twice". It is passed to the plugin as is.
+	Args          string
+	CharacterID   ulid.ULID
+	CharacterName string
+	// LocationID is where the character is; the plugin's event is scoped to
+	// that location's stream.
+	LocationID ulid.ULID
+}
+
+// ObjectVerbDispatcher hands a triggered verb to the plugin bound to it.
+// TriggerObjectVerb authorizes the invocation and then calls it.
+type ObjectVerbDispatcher interface {
+	DispatchVerb(ctx context.Context, obj *Object, verb ObjectVerb, inv VerbInvocation) error
+}
+
+// SetObjectVerbDispatcher registers the dispatcher TriggerObjectVerb hands
+// verbs to. Passing nil leaves object verbs untriggerable.
+func (s *Service) SetObjectVerbDispatcher(d ObjectVerbDispatcher) {
+	s.verbDispatcher = d
+}
+
+// TriggerObjectVerb runs a verb on an object on behalf of a character: it
+// checks the trigger_verb action on the object and hands the verb to the
+// plugin bound to it.
+//
+// Returns OBJECT_VERB_INVALID for a malformed verb name or arguments,
+// OBJECT_NOT_FOUND and OBJECT_VERB_NOT_FOUND when the object or verb does
+// not exist, and OBJECT_VERB_FAILED when the plugin could not be reached.
+func (s *Service) TriggerObjectVerb(ctx context.Context, subjectID string, inv VerbInvocation) error {
+	if err := ValidateVerbName(inv.Verb); err != nil {
+		return oops.Code("OBJECT_VERB_INVALID").With("verb", inv.Verb).Wrap(err)
+	}
+	if len(inv.Args) > MaxVerbArgsLength || !utf8.ValidString(inv.Args) {
+		return oops.Code("OBJECT_VERB_INVALID").
+			With("verb", inv.Verb).
+			Wrap(&ValidationError{Field: "args", Message: fmt.Sprintf("must be valid UTF-8 of at most %d bytes", MaxVerbArgsLength)})
+	}
+	if s.verbDispatcher == nil {
+		return oops.Code("OBJECT_VERB_FAILED").Errorf("object verb dispatcher not configured")
+	}
+	obj, err := s.ownedObject(ctx, subjectID, ActionTriggerVerb, inv.ObjectID, "OBJECT_VERB_FAILED")
+	if err != nil {
+		return err
+	}
+	verb, ok := obj.Verb(inv.Verb)
+	if !ok {
+		return oops.Code("OBJECT_VERB_NOT_FOUND").
+			With("id", inv.ObjectID.String()).
+			With("verb", inv.Verb).
+			Errorf("object %s has no verb %q", inv.ObjectID, inv.Verb)
+	}
+	if err := s.verbDispatcher.DispatchVerb(ctx, obj, verb, inv); err != nil {
+		return oops.Code("OBJECT_VERB_FAILED").
+			With("id", inv.ObjectID.String()).
+			With("verb", inv.Verb).
+			With("plugin", verb.Plugin).
+			Wrap(err)
+	}
+	slog.DebugContext(ctx, "object verb triggered",
+		"object_id", inv.ObjectID.String(),
+		"verb", verb.Name,
+		"plugin", verb.Plugin,
+		"subject", subjectID)
+	return nil
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+var pushVerb = world.ObjectVerb{Name: "push", Plugin: "harbor-bell", Description: "rings the bell"}
+
+type verbCall struct {
+	obj  *world.Object
+	verb world.ObjectVerb
+	inv  world.VerbInvocation
+}
+
+type recordingVerbDispatcher struct {
+	calls []verbCall
+	err   error
+}
+
+func (d *recordingVerbDispatcher) DispatchVerb(_ context.Context, obj *world.Object, verb world.ObjectVerb, inv world.VerbInvocation) error {
+	d.calls = append(d.calls, verbCall{obj: obj, verb: verb, inv: inv})
+	return d.err
+}
+
+func TestObjectVerbValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		verb  world.ObjectVerb
+		field string
+	}{
+		{"valid", pushVerb, ""},
+		{"underscored name", world.ObjectVerb{Name: "ring_bell", Plugin: "bells"}, ""},
+		{"empty name", world.ObjectVerb{Plugin: "bells"}, "verb"},
+		{"capitalised name", world.ObjectVerb{Name: "Push", Plugin: "bells"}, "verb"},
+		{"name with a space", world.ObjectVerb{Name: "push hard", Plugin: "bells"}, "verb"},
+		{"long name", world.ObjectVerb{Name: strings.Repeat("p", world.MaxVerbNameLength+1), Plugin: "bells"}, "verb"},
+		{"empty plugin", world.ObjectVerb{Name: "push"}, "plugin"},
+		{"malformed plugin", world.ObjectVerb{Name: "push", Plugin: "harbor--bell"}, "plugin"},
+		{"long description", world.ObjectVerb{Name: "push", Plugin: "bells", Description: strings.Repeat("d", world.MaxVerbDescriptionLength+1)}, "description"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.verb.Validate()
+			if tt.field == "" {
+				require.NoError(t, err)
+				return
+			}
+			var verr *world.ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Field)
+		})
+	}
+}
+
+func TestWorldService_DefineObjectVerb(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+
+	t.Run("owner defines a verb and an object_verb_defined envelope is emitted", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return o.ID == obj.ID && len(o.Verbs) == 1 && o.Verbs[0] == pushVerb
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.DefineObjectVerb(ctx, subjectID, obj.ID, pushVerb))
+		assert.Equal(t, "object_verb_defined", outbox.lastIntent.Kind)
+		var payload world.ObjectVerbChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.ObjectVerbChangePayload{ObjectID: obj.ID.String(), Verb: "push", Plugin: "harbor-bell"}, payload)
+	})
+
+	t.Run("redefining a verb replaces it in place", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		pull := world.ObjectVerb{Name: "pull", Plugin: "harbor-bell"}
+		obj.Verbs = []world.ObjectVerb{pushVerb, pull}
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+
+		rebound := world.ObjectVerb{Name: "push", Plugin: "foghorn"}
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return len(o.Verbs) == 2 && o.Verbs[0] == rebound && o.Verbs[1] == pull
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.DefineObjectVerb(ctx, subjectID, obj.ID, rebound))
+	})
+
+	t.Run("redefining an identical verb is a no-op", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		obj.Verbs = []world.ObjectVerb{pushVerb}
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		require.NoError(t, svc.DefineObjectVerb(ctx, subjectID, obj.ID, pushVerb))
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("an object holds at most MaxObjectVerbs verbs", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		for i := range world.MaxObjectVerbs {
+			obj.Verbs = append(obj.Verbs, world.ObjectVerb{Name: "verb" + strings.Repeat("x", i), Plugin: "bells"})
+		}
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		err := svc.DefineObjectVerb(ctx, subjectID, obj.ID, pushVerb)
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_LIMIT")
+	})
+
+	t.Run("malformed verbs are rejected before any read", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: worldtest.NewMockObjectRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.DefineObjectVerb(ctx, subjectID, ulid.Make(), world.ObjectVerb{Name: "Push", Plugin: "bells"})
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_INVALID")
+	})
+
+	t.Run("without define_verb the definition is denied", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ObjectRepo: worldtest.NewMockObjectRepository(t), Engine: policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.DefineObjectVerb(ctx, subjectID, ulid.Make(), pushVerb)
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+}
+
+func TestWorldService_RemoveObjectVerb(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+
+	t.Run("removes the verb and emits object_verb_removed", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		obj.Verbs = []world.ObjectVerb{pushVerb}
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(o *world.Object) bool {
+			return len(o.Verbs) == 0
+		})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.RemoveObjectVerb(ctx, subjectID, obj.ID, "push"))
+		assert.Equal(t, "object_verb_removed", outbox.lastIntent.Kind)
+	})
+
+	t.Run("removing a missing verb is OBJECT_VERB_NOT_FOUND", func(t *testing.T) {
+		obj := ownedTestObject(t, ownerID, false)
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionDefineVerb, access.ObjectResource(obj.ID.String()))
+		objRepo := worldtest.NewMockObjectRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+
+		err := svc.RemoveObjectVerb(ctx, subjectID, obj.ID, "push")
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_NOT_FOUND")
+	})
+}
+
+func TestWorldService_TriggerObjectVerb(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	subjectID := access.CharacterSubject(charID.String())
+
+	newService := func(t *testing.T, obj *world.Object, granted bool) (*world.Service, *recordingVerbDispatcher) {
+		t.Helper()
+		engine := policytest.NewGrantEngine()
+		if granted {
+			engine.Grant(subjectID, world.ActionTriggerVerb, access.ObjectResource(obj.ID.String()))
+		}
+		objRepo := worldtest.NewMockObjectRepository(t)
+		if granted {
+			objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		}
+		svc := world.NewService(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine})
+		d := &recordingVerbDispatcher{}
+		svc.SetObjectVerbDispatcher(d)
+		return svc, d
+	}
+
+	t.Run("hands the verb to the dispatcher with the invocation", func(t *testing.T) {
+		obj := ownedTestObject(t, ulid.Make(), false)
+		obj.Verbs = []world.ObjectVerb{pushVerb}
+		svc, d := newService(t, obj, true)
+		inv := world.VerbInvocation{ObjectID: obj.ID, Verb: "push", Args: "twice", CharacterID: charID, LocationID: ulid.Make()}
+
+		require.NoError(t, svc.TriggerObjectVerb(ctx, subjectID, inv))
+		require.Len(t, d.calls, 1)
+		assert.Equal(t, pushVerb, d.calls[0].verb)
+		assert.Equal(t, inv, d.calls[0].inv)
+		assert.Equal(t, obj.ID, d.calls[0].obj.ID)
+	})
+
+	t.Run("an unknown verb is OBJECT_VERB_NOT_FOUND", func(t *testing.T) {
+		obj := ownedTestObject(t, ulid.Make(), false)
+		svc, d := newService(t, obj, true)
+
+		err := svc.TriggerObjectVerb(ctx, subjectID, world.VerbInvocation{ObjectID: obj.ID, Verb: "push", CharacterID: charID})
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_NOT_FOUND")
+		assert.Empty(t, d.calls)
+	})
+
+	t.Run("without trigger_verb the invocation is denied", func(t *testing.T) {
+		obj := ownedTestObject(t, ulid.Make(), false)
+		obj.Verbs = []world.ObjectVerb{pushVerb}
+		svc, d := newService(t, obj, false)
+
+		err := svc.TriggerObjectVerb(ctx, subjectID, world.VerbInvocation{ObjectID: obj.ID, Verb: "push", CharacterID: charID})
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_DENIED")
+		assert.Empty(t, d.calls)
+	})
+
+	t.Run("a dispatcher failure is OBJECT_VERB_FAILED", func(t *testing.T) {
+		obj := ownedTestObject(t, ulid.Make(), false)
+		obj.Verbs = []world.ObjectVerb{pushVerb}
+		svc, d := newService(t, obj, true)
+		d.err = errors.New("plugin unavailable")
+
+		err := svc.TriggerObjectVerb(ctx, subjectID, world.VerbInvocation{ObjectID: obj.ID, Verb: "push", CharacterID: charID})
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_FAILED")
+	})
+
+	t.Run("without a dispatcher verbs cannot be triggered", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			ObjectRepo: worldtest.NewMockObjectRepository(t), Engine: policytest.NewGrantEngine(),
+		})
+
+		err := svc.TriggerObjectVerb(ctx, subjectID, world.VerbInvocation{ObjectID: ulid.Make(), Verb: "push"})
+		errutil.AssertErrorCode(t, err, "OBJECT_VERB_FAILED")
+	})
+}
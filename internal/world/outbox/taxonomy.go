@@ -16,7 +16,7 @@ import (
 // declared kinds or any per-type payload schema changes. Each declared KindSchema
 // ALSO carries its own SchemaVersion (the per-type payload schema version), so a
 // single kind's payload can evolve independently of the registry revision.
-const AppSchemaVersion = 1
+const AppSchemaVersion = 11
 
 // The declared world-change envelope kinds. These are the taxonomy VOCABULARY the
 // mechanical emission rollout (05-10/05-11) wires each world write command to; the
@@ -42,18 +42,55 @@ const (
 	KindObjectDeleted = "object_deleted"
 	KindObjectMoved   = "object_moved"
 
+	// Object ownership: locking and ownership transfer (owner rights).
+	KindObjectLocked               = "object_locked"
+	KindObjectUnlocked             = "object_unlocked"
+	KindObjectOwnershipTransferred = "object_ownership_transferred"
+
+	// Object verbs: named verbs bound to plugin handlers.
+	KindObjectVerbDefined = "object_verb_defined"
+	KindObjectVerbRemoved = "object_verb_removed"
+
+	// Worn objects: a held object put on or taken off by its holder.
+	KindObjectWorn     = "object_worn"
+	KindObjectTakenOff = "object_taken_off"
+
 	// Character aggregate. KindCharacterGenesis is the character CREATE kind (Open
 	// Question 3); its sole emitting site is the atomic character-genesis service
 	// (05-15) covering all three production creation paths (registered gRPC, guest,
 	// bootstrap-admin). KindCharacterDeleted is the single tombstone kind REUSED by
 	// world.Service.DeleteCharacter (05-11) and the guest reaper's character-aware
 	// deletion (05-16, D-06). KindCharacterPreferencesUpdate is the folded-in
-	// character-settings write (round-4 C5 / D-05, Task 2).
+	// character-settings write (round-4 C5 / D-05, Task 2). KindCharacterAnonymized
+	// is account deletion's scrub: the row survives under a tombstone name so
+	// historical events keep a resolvable actor.
 	KindCharacterGenesis           = "character_genesis"
 	KindCharacterUpdated           = "character_updated"
 	KindCharacterDeleted           = "character_deleted"
 	KindCharacterMoved             = "character_moved"
 	KindCharacterPreferencesUpdate = "character_preferences_update"
+	KindCharacterAnonymized        = "character_anonymized"
+
+	// Character visibility: dark / invisible staff (set_visibility).
+	KindCharacterVisibilityChanged = "character_visibility_changed"
+
+	// Character description effects: timed layers on a character's
+	// description.
+	KindCharacterEffectApplied = "character_effect_applied"
+	KindCharacterEffectRemoved = "character_effect_removed"
+
+	// Entity tags: free-form labels added to or removed from a location,
+	// object, or character.
+	KindLocationTagged    = "location_tagged"
+	KindLocationUntagged  = "location_untagged"
+	KindObjectTagged      = "object_tagged"
+	KindObjectUntagged    = "object_untagged"
+	KindCharacterTagged   = "character_tagged"
+	KindCharacterUntagged = "character_untagged"
+
+	// Entity properties: a named value set on a location, object, or
+	// character.
+	KindPropertySet = "property_set"
 )
 
 // PayloadField describes one field of a kind's intent-level, new-values-only
@@ -105,12 +142,32 @@ var registry = func() map[string]KindSchema {
 		{Kind: KindObjectUpdated, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectPayload},
 		{Kind: KindObjectDeleted, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Tombstone: true, Payload: tombstonePayload},
 		{Kind: KindObjectMoved, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: movePayload},
+		{Kind: KindObjectLocked, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectLockPayload},
+		{Kind: KindObjectUnlocked, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectLockPayload},
+		{Kind: KindObjectOwnershipTransferred, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectOwnershipPayload},
+		{Kind: KindObjectVerbDefined, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectVerbPayload},
+		{Kind: KindObjectVerbRemoved, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectVerbPayload},
+		{Kind: KindObjectWorn, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectWearPayload},
+		{Kind: KindObjectTakenOff, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: objectWearPayload},
 		// Characters.
 		{Kind: KindCharacterGenesis, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterGenesisPayload},
 		{Kind: KindCharacterUpdated, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterUpdatePayload},
 		{Kind: KindCharacterDeleted, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Tombstone: true, Payload: tombstonePayload},
 		{Kind: KindCharacterMoved, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: movePayload},
 		{Kind: KindCharacterPreferencesUpdate, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterPreferencesPayload},
+		{Kind: KindCharacterAnonymized, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterAnonymizePayload},
+		{Kind: KindCharacterVisibilityChanged, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterVisibilityPayload},
+		{Kind: KindCharacterEffectApplied, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterEffectPayload},
+		{Kind: KindCharacterEffectRemoved, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: characterEffectPayload},
+
+		{Kind: KindLocationTagged, Aggregate: wmodel.AggregateLocation, SchemaVersion: 1, Payload: tagPayload},
+		{Kind: KindLocationUntagged, Aggregate: wmodel.AggregateLocation, SchemaVersion: 1, Payload: tagPayload},
+		{Kind: KindObjectTagged, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: tagPayload},
+		{Kind: KindObjectUntagged, Aggregate: wmodel.AggregateObject, SchemaVersion: 1, Payload: tagPayload},
+		{Kind: KindCharacterTagged, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: tagPayload},
+		{Kind: KindCharacterUntagged, Aggregate: wmodel.AggregateCharacter, SchemaVersion: 1, Payload: tagPayload},
+
+		{Kind: KindPropertySet, Aggregate: wmodel.AggregateProperty, SchemaVersion: 1, Payload: propertySetPayload},
 	}
 	m := make(map[string]KindSchema, len(entries))
 	for _, e := range entries {
@@ -130,18 +187,42 @@ var (
 		{Name: "id", Type: "ulid"},
 		{Name: "name", Type: "string"},
 		{Name: "description", Type: "string"},
+		{Name: "zone_id", Type: "string", Optional: true},
+		{Name: "parent_id", Type: "ulid", Optional: true},
+		{Name: "max_occupancy", Type: "int", Optional: true},
+		{Name: "overflow_id", Type: "ulid", Optional: true},
 	}
 	exitPayload = []PayloadField{
 		{Name: "id", Type: "ulid"},
 		{Name: "name", Type: "string"},
 		{Name: "from_location_id", Type: "ulid"},
 		{Name: "to_location_id", Type: "ulid"},
+		{Name: "traversal_delay_ms", Type: "int", Optional: true},
+		{Name: "traversal_cost", Type: "int", Optional: true},
 	}
 	objectPayload = []PayloadField{
 		{Name: "id", Type: "ulid"},
 		{Name: "name", Type: "string"},
 		{Name: "description", Type: "string"},
 	}
+	objectLockPayload = []PayloadField{
+		{Name: "object_id", Type: "ulid"},
+		{Name: "owner_id", Type: "ulid"},
+	}
+	objectVerbPayload = []PayloadField{
+		{Name: "object_id", Type: "ulid"},
+		{Name: "verb", Type: "string"},
+		{Name: "plugin", Type: "string"},
+	}
+	objectWearPayload = []PayloadField{
+		{Name: "object_id", Type: "ulid"},
+		{Name: "character_id", Type: "ulid"},
+	}
+	objectOwnershipPayload = []PayloadField{
+		{Name: "object_id", Type: "ulid"},
+		{Name: "owner_id", Type: "ulid"},
+		{Name: "from_owner_id", Type: "ulid", Optional: true},
+	}
 	movePayload = []PayloadField{
 		{Name: "character_id", Type: "ulid"},
 		{Name: "to_location_id", Type: "ulid"},
@@ -157,10 +238,35 @@ var (
 		{Name: "character_id", Type: "ulid"},
 		{Name: "description", Type: "string"},
 	}
+	characterAnonymizePayload = []PayloadField{
+		{Name: "character_id", Type: "ulid"},
+		{Name: "name", Type: "string"},
+	}
 	characterPreferencesPayload = []PayloadField{
 		{Name: "character_id", Type: "ulid"},
 		{Name: "preferences", Type: "json"},
 	}
+	characterVisibilityPayload = []PayloadField{
+		{Name: "character_id", Type: "ulid"},
+		{Name: "visibility", Type: "string"},
+	}
+	characterEffectPayload = []PayloadField{
+		{Name: "character_id", Type: "ulid"},
+		{Name: "effect", Type: "string"},
+		{Name: "private", Type: "bool"},
+		{Name: "expires_at", Type: "string", Optional: true},
+	}
+	tagPayload = []PayloadField{
+		{Name: "id", Type: "ulid"},
+		{Name: "tag", Type: "string"},
+	}
+	propertySetPayload = []PayloadField{
+		{Name: "id", Type: "ulid"},
+		{Name: "parent_type", Type: "string"},
+		{Name: "parent_id", Type: "ulid"},
+		{Name: "name", Type: "string"},
+		{Name: "value", Type: "string", Optional: true},
+	}
 )
 
 // Lookup returns the declared schema for a world-change kind, or an error coded
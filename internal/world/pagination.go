@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+)
+
+// DefaultLimit is the default number of results when ListOptions.Limit is 0.
+const DefaultLimit = 100
+
+// MaxLimit caps ListOptions.Limit. Larger requests are clamped, not
+// rejected; callers that need more walk the pages or use StreamPages.
+const MaxLimit = 1000
+
+// ErrInvalidCursor is returned when ListOptions.Cursor is not a cursor a
+// previous page handed out. Stamped with CodeInvalidCursor.
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// CodeInvalidCursor is the oops code for a malformed pagination cursor.
+const CodeInvalidCursor = "WORLD_CURSOR_INVALID"
+
+// ListOptions configures keyset pagination for list operations.
+//
+// Each list has a total order ending in the entity ID, and a cursor names
+// the last row of the previous page in that order. A page starts strictly
+// after its cursor, so rows inserted or deleted while a caller walks the
+// pages never shift the boundary: no row is returned twice, and a row that
+// exists for the whole walk is returned exactly once.
+type ListOptions struct {
+	Limit  int    // Maximum results to return (0 = DefaultLimit; capped at MaxLimit)
+	Cursor string // Page.NextCursor from the previous page; empty starts at the first result
+}
+
+// PageSize returns the effective page size for o.
+func (o ListOptions) PageSize() int {
+	switch {
+	case o.Limit <= 0:
+		return DefaultLimit
+	case o.Limit > MaxLimit:
+		return MaxLimit
+	default:
+		return o.Limit
+	}
+}
+
+// Page is one page of a paginated list.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string // empty when there are no further results
+}
+
+// EncodeCursor returns the opaque cursor naming the row with sort key key
+// and ID id. Repositories encode the last row of a page; callers treat the
+// result as an opaque token.
+func EncodeCursor(key string, id ulid.ULID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "\x00" + id.String()))
+}
+
+// DecodeCursor parses a cursor produced by EncodeCursor. A cursor that does
+// not decode fails with CodeInvalidCursor wrapping ErrInvalidCursor.
+func DecodeCursor(cursor string) (key string, id ulid.ULID, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", ulid.ULID{}, invalidCursor(cursor, err)
+	}
+	// The ID never contains the separator; the key might.
+	sep := strings.LastIndexByte(string(raw), 0)
+	if sep < 0 {
+		return "", ulid.ULID{}, invalidCursor(cursor, ErrInvalidCursor)
+	}
+	key, rawID := string(raw[:sep]), string(raw[sep+1:])
+	id, err = ulid.ParseStrict(rawID)
+	if err != nil {
+		return "", ulid.ULID{}, invalidCursor(cursor, err)
+	}
+	return key, id, nil
+}
+
+func invalidCursor(cursor string, cause error) error {
+	return oops.Code(CodeInvalidCursor).
+		With("cursor", cursor).
+		Wrap(errors.Join(ErrInvalidCursor, cause))
+}
+
+// NewPage builds a page from rows fetched with one row of lookahead: rows
+// holds up to size+1 items, and a row past size means another page follows,
+// whose cursor cursorOf derives from the page's last item.
+func NewPage[T any](rows []T, size int, cursorOf func(T) string) Page[T] {
+	if len(rows) <= size {
+		return Page[T]{Items: rows}
+	}
+	items := rows[:size]
+	return Page[T]{Items: items, NextCursor: cursorOf(items[size-1])}
+}
+
+// StreamPages walks every page list returns, calling fn for each item in
+// order, so a very large result set is visited without holding it all in
+// memory. Pages of pageSize items are fetched on demand (0 uses MaxLimit).
+// An error from list or fn stops the walk and is returned as is.
+func StreamPages[T any](ctx context.Context, pageSize int, list func(ctx context.Context, opts ListOptions) (Page[T], error), fn func(T) error) error {
+	if pageSize <= 0 {
+		pageSize = MaxLimit
+	}
+	opts := ListOptions{Limit: pageSize}
+	for {
+		page, err := list(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, item := range page.Items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if page.NextCursor == "" {
+			return nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+// CollectPages walks every page list returns and returns all items, an
+// empty (non-nil) slice when there are none. Use it only where the result is
+// known to be small; StreamPages visits the items without collecting them.
+func CollectPages[T any](ctx context.Context, list func(ctx context.Context, opts ListOptions) (Page[T], error)) ([]T, error) {
+	all := []T{}
+	err := StreamPages(ctx, MaxLimit, list, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestListOptionsPageSize(t *testing.T) {
+	assert.Equal(t, world.DefaultLimit, world.ListOptions{}.PageSize())
+	assert.Equal(t, world.DefaultLimit, world.ListOptions{Limit: -3}.PageSize())
+	assert.Equal(t, 25, world.ListOptions{Limit: 25}.PageSize())
+	assert.Equal(t, world.MaxLimit, world.ListOptions{Limit: world.MaxLimit + 1}.PageSize())
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	id := ulid.Make()
+	for _, key := range []string{"", "Alice", "name with\x00nul", "1712345678901234567"} {
+		gotKey, gotID, err := world.DecodeCursor(world.EncodeCursor(key, id))
+		require.NoError(t, err)
+		assert.Equal(t, id, gotID)
+		assert.Equal(t, key, gotKey)
+	}
+}
+
+func TestDecodeCursorRejectsMalformedInput(t *testing.T) {
+	for name, cursor := range map[string]string{
+		"not base64":   "%%%",
+		"no separator": "QWxpY2U",
+		"bad id":       "QWxpY2UAbm90LWEtdWxpZA",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := world.DecodeCursor(cursor)
+			assert.ErrorIs(t, err, world.ErrInvalidCursor)
+			errutil.AssertErrorCode(t, err, world.CodeInvalidCursor)
+		})
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	cursorOf := func(n int) string { return strconv.Itoa(n) }
+
+	page := world.NewPage([]int{1, 2, 3}, 2, cursorOf)
+	assert.Equal(t, []int{1, 2}, page.Items)
+	assert.Equal(t, "2", page.NextCursor, "the lookahead row means another page follows")
+
+	page = world.NewPage([]int{1, 2}, 2, cursorOf)
+	assert.Equal(t, []int{1, 2}, page.Items)
+	assert.Empty(t, page.NextCursor, "an exactly full last page has no next page")
+}
+
+// pagedInts serves 1..n in pages keyed by the last value seen.
+func pagedInts(n int, calls *int) func(context.Context, world.ListOptions) (world.Page[int], error) {
+	return func(_ context.Context, opts world.ListOptions) (world.Page[int], error) {
+		*calls++
+		start := 1
+		if opts.Cursor != "" {
+			last, err := strconv.Atoi(opts.Cursor)
+			if err != nil {
+				return world.Page[int]{}, err
+			}
+			start = last + 1
+		}
+		var rows []int
+		for v := start; v <= n && len(rows) <= opts.PageSize(); v++ {
+			rows = append(rows, v)
+		}
+		return world.NewPage(rows, opts.PageSize(), strconv.Itoa), nil
+	}
+}
+
+func TestStreamPages(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("visits every item across pages in order", func(t *testing.T) {
+		var calls int
+		var seen []int
+		err := world.StreamPages(ctx, 3, pagedInts(7, &calls), func(v int) error {
+			seen = append(seen, v)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, seen)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("stops at the first callback error", func(t *testing.T) {
+		var calls int
+		stop := errors.New("stop")
+		err := world.StreamPages(ctx, 2, pagedInts(10, &calls), func(v int) error {
+			if v == 3 {
+				return stop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Equal(t, 2, calls, "no further pages are fetched")
+	})
+
+	t.Run("returns list errors as is", func(t *testing.T) {
+		listErr := errors.New("db down")
+		err := world.StreamPages(ctx, 2, func(context.Context, world.ListOptions) (world.Page[int], error) {
+			return world.Page[int]{}, listErr
+		}, func(int) error { return nil })
+		assert.ErrorIs(t, err, listErr)
+	})
+}
+
+func TestCollectPages(t *testing.T) {
+	ctx := context.Background()
+	var calls int
+
+	all, err := world.CollectPages(ctx, pagedInts(world.MaxLimit+5, &calls))
+	require.NoError(t, err)
+	assert.Len(t, all, world.MaxLimit+5)
+	assert.Equal(t, 2, calls)
+
+	none, err := world.CollectPages(ctx, pagedInts(0, &calls))
+	require.NoError(t, err)
+	assert.NotNil(t, none)
+	assert.Empty(t, none)
+}
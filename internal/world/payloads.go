@@ -5,6 +5,7 @@ package world
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 
@@ -260,20 +261,30 @@ func NewObjectGivePayload(
 // MutationDelta (finding 7), NOT from these payloads.
 
 // LocationChangePayload is the new-values-only payload for a location create or
-// update envelope.
+// update envelope. ZoneID is omitted for a location in no zone, ParentID
+// for a location with no parent, MaxOccupancy for an uncapped location, and
+// OverflowID for a location with no overflow.
 type LocationChangePayload struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	ZoneID       string `json:"zone_id,omitempty"`
+	ParentID     string `json:"parent_id,omitempty"`
+	MaxOccupancy int    `json:"max_occupancy,omitempty"`
+	OverflowID   string `json:"overflow_id,omitempty"`
 }
 
 // ExitChangePayload is the new-values-only payload for an exit create or update
 // envelope. It carries the endpoints (from/to location) that define the exit.
+// The traversal delay (in milliseconds) and cost are omitted for an instant,
+// free exit.
 type ExitChangePayload struct {
-	ID             string `json:"id"`
-	Name           string `json:"name"`
-	FromLocationID string `json:"from_location_id"`
-	ToLocationID   string `json:"to_location_id"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	FromLocationID   string `json:"from_location_id"`
+	ToLocationID     string `json:"to_location_id"`
+	TraversalDelayMS int64  `json:"traversal_delay_ms,omitempty"`
+	TraversalCost    int    `json:"traversal_cost,omitempty"`
 }
 
 // ObjectChangePayload is the new-values-only payload for an object create or
@@ -295,6 +306,38 @@ type ObjectMoveChangePayload struct {
 	FromID   *string `json:"from_id,omitempty"`
 }
 
+// ObjectLockChangePayload is the payload for an object_locked or
+// object_unlocked envelope: the object and the owner holding the lock.
+type ObjectLockChangePayload struct {
+	ObjectID string `json:"object_id"`
+	OwnerID  string `json:"owner_id"`
+}
+
+// ObjectOwnershipChangePayload is the payload for an
+// object_ownership_transferred envelope: the object, its new owner, and the
+// previous owner (omitted when the object had none).
+type ObjectOwnershipChangePayload struct {
+	ObjectID    string  `json:"object_id"`
+	OwnerID     string  `json:"owner_id"`
+	FromOwnerID *string `json:"from_owner_id,omitempty"`
+}
+
+// ObjectVerbChangePayload is the payload for an object_verb_defined or
+// object_verb_removed envelope: the object, the verb, and the plugin that
+// handles it.
+type ObjectVerbChangePayload struct {
+	ObjectID string `json:"object_id"`
+	Verb     string `json:"verb"`
+	Plugin   string `json:"plugin"`
+}
+
+// ObjectWearChangePayload is the payload for an object_worn or
+// object_taken_off envelope: the object and the character wearing it.
+type ObjectWearChangePayload struct {
+	ObjectID    string `json:"object_id"`
+	CharacterID string `json:"character_id"`
+}
+
 // CharacterUpdateChangePayload is the new-values-only payload for a
 // character_updated envelope (the character-description write). It carries the
 // character id and the committed new description.
@@ -303,6 +346,50 @@ type CharacterUpdateChangePayload struct {
 	Description string `json:"description"`
 }
 
+// CharacterAnonymizeChangePayload is the payload for a character_anonymized
+// envelope: the character and its tombstone name. The cleared description,
+// location and properties are implied by the kind.
+type CharacterAnonymizeChangePayload struct {
+	CharacterID string `json:"character_id"`
+	Name        string `json:"name"`
+}
+
+// CharacterVisibilityChangePayload is the payload for a
+// character_visibility_changed envelope: the character and its new visibility.
+type CharacterVisibilityChangePayload struct {
+	CharacterID string `json:"character_id"`
+	Visibility  string `json:"visibility"`
+}
+
+// CharacterEffectChangePayload is the payload for a character_effect_applied
+// or character_effect_removed envelope: the character, the effect's name and
+// privacy, and when it expires (RFC 3339, absent for effects that last until
+// removed). The effect's text is not carried: private effects stay private.
+type CharacterEffectChangePayload struct {
+	CharacterID string `json:"character_id"`
+	Effect      string `json:"effect"`
+	Private     bool   `json:"private"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// TagChangePayload is the payload for a <kind>_tagged or <kind>_untagged
+// envelope: the tagged entity and the tag added or removed.
+type TagChangePayload struct {
+	ID  string `json:"id"`
+	Tag string `json:"tag"`
+}
+
+// PropertySetPayload is the payload for a property_set envelope: the
+// property, its parent, and the new value (absent for a flag-style
+// property).
+type PropertySetPayload struct {
+	ID         string  `json:"id"`
+	ParentType string  `json:"parent_type"`
+	ParentID   string  `json:"parent_id"`
+	Name       string  `json:"name"`
+	Value      *string `json:"value,omitempty"`
+}
+
 // TombstonePayload is the payload for a delete envelope: only the id of the
 // deleted aggregate. Cascaded aggregates (a location's exits, a bidirectional
 // exit's reverse) are represented in the envelope's affected-aggregates manifest
@@ -314,10 +401,21 @@ type TombstonePayload struct {
 // BuildLocationPayload marshals the new-values-only location payload for a
 // create/update envelope.
 func BuildLocationPayload(loc *Location) ([]byte, error) {
+	var parentID, overflowID string
+	if loc.ParentID != nil {
+		parentID = loc.ParentID.String()
+	}
+	if loc.OverflowID != nil {
+		overflowID = loc.OverflowID.String()
+	}
 	payload, err := json.Marshal(LocationChangePayload{
-		ID:          loc.ID.String(),
-		Name:        loc.Name,
-		Description: loc.Description,
+		ID:           loc.ID.String(),
+		Name:         loc.Name,
+		Description:  loc.Description,
+		ZoneID:       loc.ZoneID,
+		ParentID:     parentID,
+		MaxOccupancy: loc.MaxOccupancy,
+		OverflowID:   overflowID,
 	})
 	if err != nil {
 		return nil, oops.Wrapf(err, "marshal location payload")
@@ -329,10 +427,12 @@ func BuildLocationPayload(loc *Location) ([]byte, error) {
 // envelope.
 func BuildExitPayload(exit *Exit) ([]byte, error) {
 	payload, err := json.Marshal(ExitChangePayload{
-		ID:             exit.ID.String(),
-		Name:           exit.Name,
-		FromLocationID: exit.FromLocationID.String(),
-		ToLocationID:   exit.ToLocationID.String(),
+		ID:               exit.ID.String(),
+		Name:             exit.Name,
+		FromLocationID:   exit.FromLocationID.String(),
+		ToLocationID:     exit.ToLocationID.String(),
+		TraversalDelayMS: exit.TraversalDelay.Milliseconds(),
+		TraversalCost:    exit.TraversalCost,
 	})
 	if err != nil {
 		return nil, oops.Wrapf(err, "marshal exit payload")
@@ -378,6 +478,55 @@ func BuildObjectMovePayload(obj *Object, to Containment) ([]byte, error) {
 	return payload, nil
 }
 
+// BuildObjectLockPayload marshals the payload for an object_locked or
+// object_unlocked envelope. obj MUST have an owner.
+func BuildObjectLockPayload(obj *Object) ([]byte, error) {
+	if obj.OwnerID == nil {
+		return nil, oops.Errorf("object %s has no owner", obj.ID)
+	}
+	payload, err := json.Marshal(ObjectLockChangePayload{
+		ObjectID: obj.ID.String(),
+		OwnerID:  obj.OwnerID.String(),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal object lock payload")
+	}
+	return payload, nil
+}
+
+// BuildObjectOwnershipPayload marshals the payload for an
+// object_ownership_transferred envelope from the object's previous owner
+// (nil when it had none) and its new owner.
+func BuildObjectOwnershipPayload(objectID ulid.ULID, from *ulid.ULID, to ulid.ULID) ([]byte, error) {
+	p := ObjectOwnershipChangePayload{
+		ObjectID: objectID.String(),
+		OwnerID:  to.String(),
+	}
+	if from != nil {
+		fromStr := from.String()
+		p.FromOwnerID = &fromStr
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal object ownership payload")
+	}
+	return payload, nil
+}
+
+// BuildObjectVerbPayload marshals the payload for an object_verb_defined or
+// object_verb_removed envelope.
+func BuildObjectVerbPayload(objectID ulid.ULID, verb ObjectVerb) ([]byte, error) {
+	payload, err := json.Marshal(ObjectVerbChangePayload{
+		ObjectID: objectID.String(),
+		Verb:     verb.Name,
+		Plugin:   verb.Plugin,
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal object verb payload")
+	}
+	return payload, nil
+}
+
 // currentContainment returns the object's current containment type and id, or
 // (ContainmentTypeNone, zero) when the object has no prior containment.
 func currentContainment(obj *Object) (ContainmentType, ulid.ULID) {
@@ -406,6 +555,89 @@ func BuildCharacterUpdatePayload(characterID ulid.ULID, description string) ([]b
 	return payload, nil
 }
 
+// BuildCharacterAnonymizePayload marshals the payload for a
+// character_anonymized envelope.
+func BuildCharacterAnonymizePayload(characterID ulid.ULID, name string) ([]byte, error) {
+	payload, err := json.Marshal(CharacterAnonymizeChangePayload{
+		CharacterID: characterID.String(),
+		Name:        name,
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal character anonymize payload")
+	}
+	return payload, nil
+}
+
+// BuildCharacterVisibilityPayload marshals the payload for a
+// character_visibility_changed envelope.
+func BuildCharacterVisibilityPayload(characterID ulid.ULID, v CharacterVisibility) ([]byte, error) {
+	payload, err := json.Marshal(CharacterVisibilityChangePayload{
+		CharacterID: characterID.String(),
+		Visibility:  string(v),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal character visibility payload")
+	}
+	return payload, nil
+}
+
+// BuildObjectWearPayload marshals the payload for an object_worn or
+// object_taken_off envelope.
+func BuildObjectWearPayload(objectID, characterID ulid.ULID) ([]byte, error) {
+	payload, err := json.Marshal(ObjectWearChangePayload{
+		ObjectID:    objectID.String(),
+		CharacterID: characterID.String(),
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal object wear payload")
+	}
+	return payload, nil
+}
+
+// BuildCharacterEffectPayload marshals the payload for a
+// character_effect_applied or character_effect_removed envelope.
+func BuildCharacterEffectPayload(characterID ulid.ULID, effect DescriptionEffect) ([]byte, error) {
+	p := CharacterEffectChangePayload{
+		CharacterID: characterID.String(),
+		Effect:      effect.Name,
+		Private:     effect.Private,
+	}
+	if !effect.ExpiresAt.IsZero() {
+		p.ExpiresAt = effect.ExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal character effect payload")
+	}
+	return payload, nil
+}
+
+// BuildTagPayload marshals the payload for a tag added to or removed from
+// an entity.
+func BuildTagPayload(id ulid.ULID, tag string) ([]byte, error) {
+	payload, err := json.Marshal(TagChangePayload{ID: id.String(), Tag: tag})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal tag payload")
+	}
+	return payload, nil
+}
+
+// BuildPropertySetPayload marshals the payload for a property set on an
+// entity.
+func BuildPropertySetPayload(prop *EntityProperty) ([]byte, error) {
+	payload, err := json.Marshal(PropertySetPayload{
+		ID:         prop.ID.String(),
+		ParentType: prop.ParentType,
+		ParentID:   prop.ParentID.String(),
+		Name:       prop.Name,
+		Value:      prop.Value,
+	})
+	if err != nil {
+		return nil, oops.Wrapf(err, "marshal property set payload")
+	}
+	return payload, nil
+}
+
 // BuildTombstonePayload marshals the tombstone payload (the deleted id) for a
 // delete envelope.
 func BuildTombstonePayload(id ulid.ULID) ([]byte, error) {
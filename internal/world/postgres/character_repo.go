@@ -30,7 +30,7 @@ func NewCharacterRepository(pool *pgxpool.Pool) *CharacterRepository {
 // Get retrieves a character by ID.
 func (r *CharacterRepository) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
 	row := r.pool.QueryRow(ctx, `
-		SELECT id, player_id, name, description, location_id, created_at, version
+		SELECT id, player_id, name, description, location_id, visibility, description_effects, tags, created_at, version
 		FROM characters WHERE id = $1
 	`, id.String())
 	char, err := scanCharacterRow(row)
@@ -43,6 +43,23 @@ func (r *CharacterRepository) Get(ctx context.Context, id ulid.ULID) (*world.Cha
 	return char, nil
 }
 
+// FindByName retrieves the character named name, ignoring case; the lowest
+// ID wins when several match.
+func (r *CharacterRepository) FindByName(ctx context.Context, name string) (*world.Character, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT id, player_id, name, description, location_id, visibility, description_effects, tags, created_at, version
+		FROM characters WHERE LOWER(name) = LOWER($1) ORDER BY id LIMIT 1
+	`, name)
+	char, err := scanCharacterRow(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, oops.Code("CHARACTER_NOT_FOUND").With("name", name).Wrap(world.ErrNotFound)
+	}
+	if err != nil {
+		return nil, oops.Code("CHARACTER_GET_FAILED").With("name", name).Wrap(err)
+	}
+	return char, nil
+}
+
 // Create persists a new character.
 // Callers must validate the character before calling this method.
 // Uses querierFromCtx so callers may compose this within a transaction; the
@@ -50,13 +67,18 @@ func (r *CharacterRepository) Get(ctx context.Context, id ulid.ULID) (*world.Cha
 // reused struct does not later carry a stale version and spuriously conflict
 // (finding 12).
 func (r *CharacterRepository) Create(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	effects, err := marshalDescriptionEffects(char.Effects)
+	if err != nil {
+		return nil, oops.Code("CHARACTER_CREATE_FAILED").With("id", char.ID.String()).Wrap(err)
+	}
 	var newVersion int
-	err := querierFromCtx(ctx, r.pool).QueryRow(ctx, `
-		INSERT INTO characters (id, player_id, name, description, location_id, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+	err = querierFromCtx(ctx, r.pool).QueryRow(ctx, `
+		INSERT INTO characters (id, player_id, name, description, location_id, visibility, description_effects, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING version
 	`, char.ID.String(), char.PlayerID.String(), char.Name, char.Description,
-		ulidToStringPtr(char.LocationID), pgnanos.From(char.CreatedAt)).Scan(&newVersion)
+		ulidToStringPtr(char.LocationID), string(char.Visibility.Normalize()), effects,
+		pgnanos.From(char.CreatedAt)).Scan(&newVersion)
 	if err != nil {
 		return nil, oops.Code("CHARACTER_CREATE_FAILED").With("id", char.ID.String()).Wrap(err)
 	}
@@ -75,12 +97,20 @@ func (r *CharacterRepository) Create(ctx context.Context, char *world.Character)
 // yet threaded a read version. On success char.Version is refreshed to the
 // committed value (finding 12).
 func (r *CharacterRepository) Update(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	effects, err := marshalDescriptionEffects(char.Effects)
+	if err != nil {
+		return nil, oops.Code("CHARACTER_UPDATE_FAILED").With("id", char.ID.String()).Wrap(err)
+	}
 	query := `
-		UPDATE characters SET name = $2, description = $3, location_id = $4, version = version + 1
+		UPDATE characters SET name = $2, description = $3, location_id = $4, visibility = $5,
+		       description_effects = $6, version = version + 1
 		WHERE id = $1`
-	args := []any{char.ID.String(), char.Name, char.Description, ulidToStringPtr(char.LocationID)}
+	args := []any{
+		char.ID.String(), char.Name, char.Description,
+		ulidToStringPtr(char.LocationID), string(char.Visibility.Normalize()), effects,
+	}
 	if char.Version > 0 {
-		query += ` AND version = $5`
+		query += ` AND version = $7`
 		args = append(args, char.Version)
 	}
 	query += ` RETURNING version`
@@ -152,24 +182,34 @@ func (r *CharacterRepository) Delete(ctx context.Context, id ulid.ULID, expected
 	return delta, nil
 }
 
-// GetByLocation retrieves characters at a location with pagination.
-func (r *CharacterRepository) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error) {
-	limit := opts.Limit
-	if limit <= 0 {
-		limit = world.DefaultLimit
+// GetByLocation returns a page of the characters at a location, ordered by
+// (name, id). One row past the page size is fetched to tell whether another
+// page follows.
+func (r *CharacterRepository) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	afterName, afterID, err := pageAfter(opts)
+	if err != nil {
+		return world.Page[*world.Character]{}, err
 	}
+	size := opts.PageSize()
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, player_id, name, description, location_id, created_at, version
-		FROM characters WHERE location_id = $1
-		ORDER BY name
-		LIMIT $2 OFFSET $3
-	`, locationID.String(), limit, opts.Offset)
+		SELECT id, player_id, name, description, location_id, visibility, description_effects, tags, created_at, version
+		FROM characters
+		WHERE location_id = $1 AND ($2::text IS NULL OR (name, id) > ($2, $3))
+		ORDER BY name, id
+		LIMIT $4
+	`, locationID.String(), afterName, afterID, size+1)
 	if err != nil {
-		return nil, oops.Code("CHARACTER_QUERY_FAILED").With("location_id", locationID.String()).Wrap(err)
+		return world.Page[*world.Character]{}, oops.Code("CHARACTER_QUERY_FAILED").With("location_id", locationID.String()).Wrap(err)
 	}
 	defer rows.Close()
 
-	return scanCharacters(rows)
+	chars, err := scanCharacters(rows)
+	if err != nil {
+		return world.Page[*world.Character]{}, err
+	}
+	return world.NewPage(chars, size, func(c *world.Character) string {
+		return world.EncodeCursor(c.Name, c.ID)
+	}), nil
 }
 
 // ListByPlayer returns every character owned by the given player, ordered by name,
@@ -180,7 +220,7 @@ func (r *CharacterRepository) GetByLocation(ctx context.Context, locationID ulid
 // correct — the SQL fence only fences mutations.
 func (r *CharacterRepository) ListByPlayer(ctx context.Context, playerID ulid.ULID) ([]*world.Character, error) {
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, player_id, name, description, location_id, created_at, version
+		SELECT id, player_id, name, description, location_id, visibility, description_effects, tags, created_at, version
 		FROM characters WHERE player_id = $1 ORDER BY name
 	`, playerID.String())
 	if err != nil {
@@ -191,6 +231,28 @@ func (r *CharacterRepository) ListByPlayer(ctx context.Context, playerID ulid.UL
 	return scanCharacters(rows)
 }
 
+// ListByTag returns the characters carrying tag, ordered by ID.
+func (r *CharacterRepository) ListByTag(ctx context.Context, tag string) ([]*world.Character, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, player_id, name, description, location_id, visibility, description_effects, tags, created_at, version
+		FROM characters WHERE tags @> ARRAY[$1::text] ORDER BY id
+	`, tag)
+	if err != nil {
+		return nil, oops.Code("CHARACTER_LIST_FAILED").With("tag", tag).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanCharacters(rows)
+}
+
+// SetTags replaces a character's tags with a version-predicated CAS
+// (MODEL-03). Zero rows classify into WORLD_CONCURRENT_EDIT or
+// CHARACTER_NOT_FOUND like UpdateLocation.
+func (r *CharacterRepository) SetTags(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return setEntityTags(ctx, r.pool, "characters", wmodel.AggregateCharacter, characterID, tags, expectedVersion,
+		oops.Code("CHARACTER_NOT_FOUND").With("character_id", characterID.String()).Wrap(world.ErrNotFound))
+}
+
 // UpdateLocation moves a character to a new location with a version-predicated CAS
 // (MODEL-03). When expectedVersion > 0 the UPDATE matches id + version, so a stale
 // move affects zero rows and the locked follow-up read classifies it into
@@ -294,6 +356,8 @@ type characterScanFields struct {
 	idStr         string
 	playerIDStr   string
 	locationIDStr *string
+	visibility    string
+	effects       []byte
 	createdAt     pgnanos.Time
 }
 
@@ -304,7 +368,7 @@ func scanCharacterRow(row pgx.Row) (*world.Character, error) {
 
 	err := row.Scan(
 		&f.idStr, &f.playerIDStr, &char.Name, &char.Description,
-		&f.locationIDStr, &f.createdAt, &char.Version,
+		&f.locationIDStr, &f.visibility, &f.effects, &char.Tags, &f.createdAt, &char.Version,
 	)
 	if err != nil {
 		return nil, oops.Code("CHARACTER_SCAN_FAILED").Wrap(err)
@@ -332,6 +396,12 @@ func parseCharacterFromFields(f *characterScanFields, char *world.Character) err
 	if err != nil {
 		return err
 	}
+	char.Visibility = world.CharacterVisibility(f.visibility)
+	char.Effects, err = unmarshalDescriptionEffects(f.effects)
+	if err != nil {
+		return oops.Code("CHARACTER_PARSE_FAILED").With("field", "description_effects").With("id", f.idStr).Wrap(err)
+	}
+	char.Tags = scannedTags(char.Tags)
 	char.CreatedAt = f.createdAt.Time()
 	return nil
 }
@@ -344,7 +414,7 @@ func scanCharacters(rows pgx.Rows) ([]*world.Character, error) {
 
 		if err := rows.Scan(
 			&f.idStr, &f.playerIDStr, &char.Name, &char.Description,
-			&f.locationIDStr, &f.createdAt, &char.Version,
+			&f.locationIDStr, &f.visibility, &f.effects, &char.Tags, &f.createdAt, &char.Version,
 		); err != nil {
 			return nil, oops.Code("CHARACTER_SCAN_FAILED").Wrap(err)
 		}
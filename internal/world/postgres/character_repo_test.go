@@ -7,6 +7,7 @@ package postgres_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/bootstrap/setup"
 	"github.com/holomush/holomush/internal/world"
 	"github.com/holomush/holomush/internal/world/postgres"
@@ -125,9 +127,10 @@ func TestCharacterRepository_GetByLocation(t *testing.T) {
 	repo := postgres.NewCharacterRepository(testPool)
 
 	t.Run("returns empty slice for location with no characters", func(t *testing.T) {
-		chars, err := repo.GetByLocation(ctx, ulid.Make(), world.ListOptions{})
+		page, err := repo.GetByLocation(ctx, ulid.Make(), world.ListOptions{})
 		require.NoError(t, err)
-		assert.Empty(t, chars)
+		assert.Empty(t, page.Items)
+		assert.Empty(t, page.NextCursor)
 	})
 
 	t.Run("returns characters at location", func(t *testing.T) {
@@ -159,8 +162,9 @@ func TestCharacterRepository_GetByLocation(t *testing.T) {
 			_ = delErr(repo.Delete(ctx, char2.ID, 0))
 		})
 
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{})
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{})
 		require.NoError(t, err)
+		chars := page.Items
 		assert.Len(t, chars, 2)
 
 		// Check names are sorted alphabetically
@@ -203,48 +207,63 @@ func TestCharacterRepository_GetByLocation_Pagination(t *testing.T) {
 	})
 
 	t.Run("limit restricts results", func(t *testing.T) {
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2})
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2})
 		require.NoError(t, err)
-		assert.Len(t, chars, 2)
+		require.Len(t, page.Items, 2)
 		// Results are ordered by name, so first 2 should be Alice, Bob
-		assert.Equal(t, "Alice", chars[0].Name)
-		assert.Equal(t, "Bob", chars[1].Name)
+		assert.Equal(t, "Alice", page.Items[0].Name)
+		assert.Equal(t, "Bob", page.Items[1].Name)
+		assert.NotEmpty(t, page.NextCursor)
 	})
 
-	t.Run("offset skips results", func(t *testing.T) {
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2, Offset: 2})
+	t.Run("cursor continues after the previous page", func(t *testing.T) {
+		first, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2})
 		require.NoError(t, err)
-		assert.Len(t, chars, 2)
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, page.Items, 2)
 		// Skip Alice, Bob; get Charlie, Diana
-		assert.Equal(t, "Charlie", chars[0].Name)
-		assert.Equal(t, "Diana", chars[1].Name)
+		assert.Equal(t, "Charlie", page.Items[0].Name)
+		assert.Equal(t, "Diana", page.Items[1].Name)
 	})
 
-	t.Run("offset beyond results returns empty", func(t *testing.T) {
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Offset: 100})
+	t.Run("last page has no next cursor", func(t *testing.T) {
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 5})
 		require.NoError(t, err)
-		assert.Empty(t, chars)
+		assert.Len(t, page.Items, 5)
+		assert.Empty(t, page.NextCursor, "an exactly full last page does not promise more")
 	})
 
-	t.Run("partial page returns remaining results", func(t *testing.T) {
-		// Offset 4, limit 10 should return only Eve (1 result)
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 10, Offset: 4})
+	t.Run("walk survives concurrent movement", func(t *testing.T) {
+		first, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 2})
 		require.NoError(t, err)
-		assert.Len(t, chars, 1)
-		assert.Equal(t, "Eve", chars[0].Name)
-	})
 
-	t.Run("zero limit uses default", func(t *testing.T) {
-		// With 5 characters, default limit (100) returns all
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 0})
+		// Aaron sorts before the cursor and must not shift the next page.
+		aaron := &world.Character{
+			ID: ulid.Make(), PlayerID: playerID, Name: "Aaron",
+			LocationID: &locationID, CreatedAt: time.Now().UTC(),
+		}
+		require.NoError(t, delErr(repo.Create(ctx, aaron)))
+		t.Cleanup(func() { _ = delErr(repo.Delete(ctx, aaron.ID, 0)) })
+
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Limit: 10, Cursor: first.NextCursor})
 		require.NoError(t, err)
-		assert.Len(t, chars, 5)
+		names := make([]string, len(page.Items))
+		for i, c := range page.Items {
+			names[i] = c.Name
+		}
+		assert.Equal(t, []string{"Charlie", "Diana", "Eve"}, names)
+	})
+
+	t.Run("malformed cursor is rejected", func(t *testing.T) {
+		_, err := repo.GetByLocation(ctx, locationID, world.ListOptions{Cursor: "not-a-cursor"})
+		require.ErrorIs(t, err, world.ErrInvalidCursor)
 	})
 
 	t.Run("empty ListOptions uses defaults", func(t *testing.T) {
-		chars, err := repo.GetByLocation(ctx, locationID, world.ListOptions{})
+		page, err := repo.GetByLocation(ctx, locationID, world.ListOptions{})
 		require.NoError(t, err)
-		assert.Len(t, chars, 5)
+		assert.GreaterOrEqual(t, len(page.Items), 5)
 	})
 }
 
@@ -335,6 +354,42 @@ func TestCharacterRepository_Update(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "UpdatedName", got.Name)
 		assert.Equal(t, "Updated description.", got.Description)
+		assert.Empty(t, got.Effects, "characters start with no effects")
+	})
+
+	t.Run("round-trips description effects", func(t *testing.T) {
+		playerID := createTestPlayer(ctx, t)
+		locationID := createTestLocation(ctx, t)
+
+		char := &world.Character{
+			ID:         ulid.Make(),
+			PlayerID:   playerID,
+			Name:       "Affected",
+			LocationID: &locationID,
+			CreatedAt:  time.Now().UTC(),
+		}
+		require.NoError(t, delErr(repo.Create(ctx, char)))
+		t.Cleanup(func() {
+			_ = delErr(repo.Delete(ctx, char.ID, 0))
+		})
+
+		char.Effects = []world.DescriptionEffect{{
+			Name:      "glowing",
+			Text:      "A faint glow surrounds them.",
+			Private:   true,
+			AppliedBy: access.CharacterSubject(char.ID.String()),
+			AppliedAt: time.Now().UTC().Truncate(time.Second),
+			ExpiresAt: time.Now().UTC().Add(time.Hour).Truncate(time.Second),
+		}}
+		require.NoError(t, delErr(repo.Update(ctx, char)))
+
+		got, err := repo.Get(ctx, char.ID)
+		require.NoError(t, err)
+		require.Len(t, got.Effects, 1)
+		assert.Equal(t, char.Effects[0].Name, got.Effects[0].Name)
+		assert.Equal(t, char.Effects[0].Text, got.Effects[0].Text)
+		assert.True(t, got.Effects[0].Private)
+		assert.True(t, char.Effects[0].ExpiresAt.Equal(got.Effects[0].ExpiresAt))
 	})
 
 	t.Run("returns ErrNotFound for non-existent character", func(t *testing.T) {
@@ -495,6 +550,27 @@ func TestCharacterRepository_GetNamesByIDs(t *testing.T) {
 	})
 }
 
+func TestCharacterRepository_FindByName(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewCharacterRepository(testPool)
+
+	char := &world.Character{
+		ID:        ulid.Make(),
+		PlayerID:  createTestPlayer(ctx, t),
+		Name:      "Finder" + ulid.Make().String()[20:],
+		CreatedAt: time.Now().UTC(),
+	}
+	require.NoError(t, delErr(repo.Create(ctx, char)))
+	t.Cleanup(func() { _ = delErr(repo.Delete(ctx, char.ID, 0)) })
+
+	found, err := repo.FindByName(ctx, strings.ToUpper(char.Name))
+	require.NoError(t, err)
+	assert.Equal(t, char.ID, found.ID)
+
+	_, err = repo.FindByName(ctx, char.Name+"-missing")
+	require.ErrorIs(t, err, world.ErrNotFound)
+}
+
 func TestCharacterRepository_UpdateLocation(t *testing.T) {
 	ctx := context.Background()
 	repo := postgres.NewCharacterRepository(testPool)
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Consistency scans return (entity id, referencing field, missing id) rows
+// ordered by entity id. The foreign keys make most of these impossible in a
+// healthy database; they catch damage from restores and manual SQL.
+const (
+	orphanedExitsQuery = `
+		SELECT e.id, 'source location', e.from_location_id FROM exits e
+		WHERE NOT EXISTS (SELECT 1 FROM locations l WHERE l.id = e.from_location_id)
+		UNION ALL
+		SELECT e.id, 'destination location', e.to_location_id FROM exits e
+		WHERE NOT EXISTS (SELECT 1 FROM locations l WHERE l.id = e.to_location_id)
+		ORDER BY 1, 2
+	`
+	danglingObjectsQuery = `
+		SELECT o.id, 'location', o.location_id FROM objects o
+		WHERE o.location_id IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM locations l WHERE l.id = o.location_id)
+		UNION ALL
+		SELECT o.id, 'character', o.held_by_character_id FROM objects o
+		WHERE o.held_by_character_id IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM characters c WHERE c.id = o.held_by_character_id)
+		UNION ALL
+		SELECT o.id, 'container', o.contained_in_object_id FROM objects o
+		WHERE o.contained_in_object_id IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM objects c WHERE c.id = o.contained_in_object_id)
+		ORDER BY 1
+	`
+	misplacedCharactersQuery = `
+		SELECT c.id, 'location', c.location_id FROM characters c
+		WHERE c.location_id IS NOT NULL
+		  AND NOT EXISTS (SELECT 1 FROM locations l WHERE l.id = c.location_id)
+		ORDER BY c.id
+	`
+	objectContainersQuery = `
+		SELECT id, contained_in_object_id FROM objects
+		WHERE contained_in_object_id IS NOT NULL
+	`
+	// propertyOrphanedPredicate matches a property (aliased p) whose parent
+	// entity does not exist.
+	propertyOrphanedPredicate = `(
+		(p.parent_type = 'location' AND NOT EXISTS (SELECT 1 FROM locations l WHERE l.id = p.parent_id))
+		OR (p.parent_type = 'object' AND NOT EXISTS (SELECT 1 FROM objects o WHERE o.id = p.parent_id))
+		OR (p.parent_type = 'character' AND NOT EXISTS (SELECT 1 FROM characters c WHERE c.id = p.parent_id))
+	)`
+	orphanedPropertiesQuery = `
+		SELECT p.id, p.parent_type, p.parent_id FROM entity_properties p
+		WHERE ` + propertyOrphanedPredicate + `
+		ORDER BY p.id
+	`
+	deleteOrphanedPropertiesQuery = `
+		DELETE FROM entity_properties p
+		WHERE p.id = ANY($1) AND ` + propertyOrphanedPredicate
+)
+
+// ConsistencyStore implements world.ConsistencyStore using PostgreSQL.
+type ConsistencyStore struct {
+	pool *pgxpool.Pool
+}
+
+// NewConsistencyStore creates a ConsistencyStore backed by the given pool.
+func NewConsistencyStore(pool *pgxpool.Pool) *ConsistencyStore {
+	return &ConsistencyStore{pool: pool}
+}
+
+// OrphanedExits returns exits whose source or destination location is missing.
+func (s *ConsistencyStore) OrphanedExits(ctx context.Context) ([]world.DanglingRef, error) {
+	return s.danglingRefs(ctx, "scan orphaned exits", orphanedExitsQuery)
+}
+
+// DanglingObjects returns objects whose containment target is missing.
+func (s *ConsistencyStore) DanglingObjects(ctx context.Context) ([]world.DanglingRef, error) {
+	return s.danglingRefs(ctx, "scan dangling objects", danglingObjectsQuery)
+}
+
+// MisplacedCharacters returns characters whose location is missing.
+func (s *ConsistencyStore) MisplacedCharacters(ctx context.Context) ([]world.DanglingRef, error) {
+	return s.danglingRefs(ctx, "scan misplaced characters", misplacedCharactersQuery)
+}
+
+// OrphanedProperties returns properties whose parent entity is missing.
+func (s *ConsistencyStore) OrphanedProperties(ctx context.Context) ([]world.DanglingRef, error) {
+	return s.danglingRefs(ctx, "scan orphaned properties", orphanedPropertiesQuery)
+}
+
+// ObjectContainers maps each object held by another object to its container.
+func (s *ConsistencyStore) ObjectContainers(ctx context.Context) (map[ulid.ULID]ulid.ULID, error) {
+	rows, err := s.pool.Query(ctx, objectContainersQuery)
+	if err != nil {
+		return nil, oops.With("operation", "scan object containers").Wrap(err)
+	}
+	defer rows.Close()
+	out := make(map[ulid.ULID]ulid.ULID)
+	for rows.Next() {
+		var idStr, containerStr string
+		if serr := rows.Scan(&idStr, &containerStr); serr != nil {
+			return nil, oops.With("operation", "scan object container row").Wrap(serr)
+		}
+		id, perr := ulid.Parse(idStr)
+		if perr != nil {
+			return nil, oops.With("operation", "parse object id").With("id", idStr).Wrap(perr)
+		}
+		container, perr := ulid.Parse(containerStr)
+		if perr != nil {
+			return nil, oops.With("operation", "parse container id").With("id", containerStr).Wrap(perr)
+		}
+		out[id] = container
+	}
+	if rows.Err() != nil {
+		return nil, oops.With("operation", "iterate object containers").Wrap(rows.Err())
+	}
+	return out, nil
+}
+
+// DeleteOrphanedProperties deletes the given properties that are still
+// orphaned. It joins the ambient transaction when one is present.
+func (s *ConsistencyStore) DeleteOrphanedProperties(ctx context.Context, ids []ulid.ULID) (int, error) {
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = id.String()
+	}
+	tag, err := execerFromCtx(ctx, s.pool).Exec(ctx, deleteOrphanedPropertiesQuery, idStrs)
+	if err != nil {
+		return 0, oops.Code("PROPERTY_DELETE_FAILED").With("operation", "delete orphaned properties").Wrap(err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+func (s *ConsistencyStore) danglingRefs(ctx context.Context, operation, query string) ([]world.DanglingRef, error) {
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, oops.With("operation", operation).Wrap(err)
+	}
+	defer rows.Close()
+	var out []world.DanglingRef
+	for rows.Next() {
+		var idStr, field, refStr string
+		if serr := rows.Scan(&idStr, &field, &refStr); serr != nil {
+			return nil, oops.With("operation", operation).Wrap(serr)
+		}
+		ref := world.DanglingRef{Field: field}
+		var perr error
+		if ref.ID, perr = ulid.Parse(idStr); perr != nil {
+			return nil, oops.With("operation", operation).With("id", idStr).Wrap(perr)
+		}
+		if ref.RefID, perr = ulid.Parse(refStr); perr != nil {
+			return nil, oops.With("operation", operation).With("ref_id", refStr).Wrap(perr)
+		}
+		out = append(out, ref)
+	}
+	if rows.Err() != nil {
+		return nil, oops.With("operation", operation).Wrap(rows.Err())
+	}
+	return out, nil
+}
+
+// Compile-time interface check.
+var _ world.ConsistencyStore = (*ConsistencyStore)(nil)
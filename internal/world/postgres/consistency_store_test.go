@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/postgres"
+)
+
+// TestConsistencyStoreFindsAndDeletesOrphanedProperty proves a property whose
+// parent never existed is reported and removed, while a property on a live
+// location is left alone. entity_properties has no parent foreign key, so
+// this is the one orphan the schema cannot prevent.
+func TestConsistencyStoreFindsAndDeletesOrphanedProperty(t *testing.T) {
+	ctx := context.Background()
+	store := postgres.NewConsistencyStore(testPool)
+	repo := postgres.NewPropertyRepository(testPool)
+
+	live := newTestProperty("location", createTestLocation(ctx, t))
+	require.NoError(t, repo.Create(ctx, live))
+	t.Cleanup(func() { _ = repo.Delete(ctx, live.ID) })
+
+	missingParent := ulid.Make()
+	orphan := newTestProperty("object", missingParent)
+	require.NoError(t, repo.Create(ctx, orphan))
+	t.Cleanup(func() { _ = repo.Delete(ctx, orphan.ID) })
+
+	refs, err := store.OrphanedProperties(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, refs, world.DanglingRef{ID: orphan.ID, Field: "object", RefID: missingParent})
+	assert.False(t, slices.ContainsFunc(refs, func(r world.DanglingRef) bool { return r.ID == live.ID }))
+
+	deleted, err := store.DeleteOrphanedProperties(ctx, []ulid.ULID{orphan.ID, live.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted, "a property with a live parent is never deleted")
+
+	_, err = repo.Get(ctx, live.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// entityCountsQuery counts every entity table in one round trip. The
+// location breakdown uses FILTER so the table is scanned once.
+const entityCountsQuery = `
+	SELECT
+		(SELECT count(*) FILTER (WHERE type = 'persistent') FROM locations WHERE archived_at IS NULL),
+		(SELECT count(*) FILTER (WHERE type = 'scene') FROM locations WHERE archived_at IS NULL),
+		(SELECT count(*) FILTER (WHERE type = 'instance') FROM locations WHERE archived_at IS NULL),
+		(SELECT count(*) FROM exits),
+		(SELECT count(*) FROM objects),
+		(SELECT count(*) FROM characters)
+`
+
+// EntityCounter reports world entity counts for operational views.
+type EntityCounter struct {
+	pool *pgxpool.Pool
+}
+
+// NewEntityCounter creates an EntityCounter backed by the given pool.
+func NewEntityCounter(pool *pgxpool.Pool) *EntityCounter {
+	return &EntityCounter{pool: pool}
+}
+
+// CountEntities returns the current world entity counts.
+func (c *EntityCounter) CountEntities(ctx context.Context) (world.EntityCounts, error) {
+	var counts world.EntityCounts
+	if err := c.pool.QueryRow(ctx, entityCountsQuery).Scan(
+		&counts.Locations, &counts.Scenes, &counts.Instances,
+		&counts.Exits, &counts.Objects, &counts.Characters,
+	); err != nil {
+		return world.EntityCounts{}, oops.With("operation", "count world entities").Wrap(err)
+	}
+	return counts, nil
+}
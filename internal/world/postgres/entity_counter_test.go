@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world/postgres"
+)
+
+// TestEntityCounterCountsNewLocation proves a created persistent location is
+// reflected in the next count. The shared pool carries rows from other tests,
+// so the assertion is on the delta, not absolute values.
+func TestEntityCounterCountsNewLocation(t *testing.T) {
+	ctx := context.Background()
+	counter := postgres.NewEntityCounter(testPool)
+	repo := postgres.NewLocationRepository(testPool)
+
+	before, err := counter.CountEntities(ctx)
+	require.NoError(t, err)
+
+	loc := newTestLocation("Counted Location")
+	require.NoError(t, delErr(repo.Create(ctx, loc)))
+	t.Cleanup(func() { _ = delErr(repo.Delete(ctx, loc.ID, 0)) })
+
+	after, err := counter.CountEntities(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, before.Locations+1, after.Locations)
+	assert.Equal(t, before.Scenes, after.Scenes)
+}
@@ -7,6 +7,8 @@ package postgres
 import (
 	"context"
 	"errors"
+	"hash/fnv"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -34,7 +36,8 @@ func NewExitRepository(pool *pgxpool.Pool) *ExitRepository {
 func (r *ExitRepository) Get(ctx context.Context, id ulid.ULID) (*world.Exit, error) {
 	exit, err := r.scanExit(ctx, `
 		SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
-		       return_name, visibility, visible_to, locked, lock_type, lock_data, created_at, version
+		       return_name, visibility, visible_to, locked, lock_type, lock_data,
+		       traversal_delay, traversal_cost, created_at, version
 		FROM exits WHERE id = $1
 	`, id.String())
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -63,30 +66,50 @@ func (r *ExitRepository) Create(ctx context.Context, exit *world.Exit) (*wmodel.
 	// a successful commit.
 	var reverseID *ulid.ULID
 
+	// Build the return exit up front so both locations' exit names are locked
+	// before either row is checked or written.
+	var returnExit *world.Exit
+	if exit.Bidirectional && exit.ReturnName != "" {
+		var err error
+		returnExit, err = exit.ReverseExit()
+		if err != nil {
+			return nil, oops.With("operation", "create reverse exit").Wrap(err)
+		}
+		if returnExit != nil {
+			returnExit.ID = idgen.New()
+			returnExit.CreatedAt = exit.CreatedAt
+		}
+	}
+
 	// Enroll in the ambient mutation transaction if present (re-entrant seam),
 	// else begin+commit a local one. Preserves atomic creation of the reverse exit.
 	err := withTx(ctx, r.pool, func(txCtx context.Context) error {
 		tx := txFromContext(txCtx)
+		locations := []ulid.ULID{exit.FromLocationID}
+		if returnExit != nil {
+			locations = append(locations, returnExit.FromLocationID)
+		}
+		if err := lockExitNamesTx(txCtx, tx, locations...); err != nil {
+			return err
+		}
+
+		if err := r.checkExitNameConflictTx(txCtx, tx, exit); err != nil {
+			return err
+		}
 		if err := r.insertExitTx(txCtx, tx, exit); err != nil {
 			return err
 		}
 
 		// Create return exit if bidirectional
-		if exit.Bidirectional && exit.ReturnName != "" {
-			returnExit, err := exit.ReverseExit()
-			if err != nil {
-				return oops.With("operation", "create reverse exit").Wrap(err)
+		if returnExit != nil {
+			if err := r.checkExitNameConflictTx(txCtx, tx, returnExit); err != nil {
+				return err
 			}
-			if returnExit != nil {
-				returnExit.ID = idgen.New()
-				returnExit.CreatedAt = exit.CreatedAt
-
-				if err := r.insertExitTx(txCtx, tx, returnExit); err != nil {
-					return oops.With("operation", "create return exit").Wrap(err)
-				}
-				rid := returnExit.ID
-				reverseID = &rid
+			if err := r.insertExitTx(txCtx, tx, returnExit); err != nil {
+				return oops.With("operation", "create return exit").Wrap(err)
 			}
+			rid := returnExit.ID
+			reverseID = &rid
 		}
 
 		return nil
@@ -120,8 +143,9 @@ func (r *ExitRepository) insertExitTx(ctx context.Context, tx pgx.Tx, exit *worl
 	_, err = tx.Exec(
 		ctx, `
 		INSERT INTO exits (id, from_location_id, to_location_id, name, aliases, bidirectional,
-		                   return_name, visibility, visible_to, locked, lock_type, lock_data, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		                   return_name, visibility, visible_to, locked, lock_type, lock_data,
+		                   traversal_delay, traversal_cost, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	`,
 		exit.ID.String(),
 		exit.FromLocationID.String(),
@@ -135,6 +159,8 @@ func (r *ExitRepository) insertExitTx(ctx context.Context, tx pgx.Tx, exit *worl
 		exit.Locked,
 		nullableLockType(exit.LockType),
 		lockDataJSON,
+		int64(exit.TraversalDelay),
+		exit.TraversalCost,
 		pgnanos.From(exit.CreatedAt),
 	)
 	if err != nil {
@@ -144,12 +170,85 @@ func (r *ExitRepository) insertExitTx(ctx context.Context, tx pgx.Tx, exit *worl
 	return nil
 }
 
+// exitNameLockKey derives the transaction-advisory-lock key that serializes
+// exit name checks for one location, namespaced so it cannot collide with other
+// advisory-lock users.
+func exitNameLockKey(locationID ulid.ULID) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("world_exit_names:"))
+	_, _ = h.Write(locationID.Bytes())
+	return int64(h.Sum64()) //nolint:gosec // intentional bit reinterpretation into a signed advisory-lock key
+}
+
+// lockExitNamesTx takes the per-location exit-name locks for the rest of the
+// transaction, so a concurrent create or rename of another exit at the same
+// location cannot slip past checkExitNameConflictTx. Locks are taken in ULID
+// order so two bidirectional creates over the same pair of locations cannot
+// deadlock.
+func lockExitNamesTx(ctx context.Context, tx pgx.Tx, locationIDs ...ulid.ULID) error {
+	slices.SortFunc(locationIDs, ulid.ULID.Compare)
+	for _, id := range slices.Compact(locationIDs) {
+		if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, exitNameLockKey(id)); err != nil {
+			return oops.With("operation", "lock exit names").With("location_id", id.String()).Wrap(err)
+		}
+	}
+	return nil
+}
+
+// checkExitNameConflictTx returns an *world.ExitNameConflictError when another
+// exit leaving exit's location answers to exit's name or one of its aliases.
+// Matching is case-insensitive, as in FindByName. The caller MUST hold the
+// location's lockExitNamesTx lock.
+func (r *ExitRepository) checkExitNameConflictTx(ctx context.Context, tx pgx.Tx, exit *world.Exit) error {
+	names := append([]string{exit.Name}, exit.Aliases...)
+	other, err := r.scanExitTx(ctx, tx, `
+		SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
+		       return_name, visibility, visible_to, locked, lock_type, lock_data,
+		       traversal_delay, traversal_cost, created_at, version
+		FROM exits
+		WHERE from_location_id = $1 AND id <> $2
+		  AND (LOWER(name) IN (SELECT LOWER(n) FROM unnest($3::text[]) AS n) OR EXISTS (
+		    SELECT 1 FROM unnest(aliases) AS a
+		    WHERE LOWER(a) IN (SELECT LOWER(n) FROM unnest($3::text[]) AS n)
+		  ))
+		ORDER BY id
+		LIMIT 1
+	`, exit.FromLocationID.String(), exit.ID.String(), names)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return oops.With("operation", "check exit name conflict").With("id", exit.ID.String()).Wrap(err)
+	}
+	name, ok := exit.ConflictingName(other)
+	if !ok {
+		// LOWER() and Go case folding disagree on this name; report the
+		// exit's own name rather than miss the conflict.
+		name = exit.Name
+	}
+	return oops.Code(world.CodeExitNameConflict).
+		With("location_id", exit.FromLocationID.String()).
+		With("name", name).
+		With("conflicting_exit_id", other.ID.String()).
+		Wrap(&world.ExitNameConflictError{
+			LocationID:          exit.FromLocationID,
+			Name:                name,
+			ConflictingExitID:   other.ID,
+			ConflictingExitName: other.Name,
+		})
+}
+
 // Update modifies an existing exit with a version-predicated CAS (MODEL-03),
 // mirroring the location repo: when exit.Version > 0 the WHERE clause matches
 // id + version and a zero-row result is classified by a locked follow-up read on
 // the same connection into WORLD_CONCURRENT_EDIT (existing row, version moved) or
 // EXIT_NOT_FOUND (absent row); when exit.Version == 0 the write is unversioned.
 // On success exit.Version is refreshed to the committed value (finding 12).
+//
+// When a bidirectional exit's endpoints, name, or return name change, its
+// return exit is re-pointed in the same transaction (see followReturnExitTx)
+// and listed in the delta's Affected; a name conflict at the new destination
+// rolls the whole update back.
 func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.MutationDelta, error) {
 	lockDataJSON, err := marshalLockData(exit.LockData)
 	if err != nil {
@@ -161,7 +260,8 @@ func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.
 	query := `
 		UPDATE exits SET from_location_id = $2, to_location_id = $3, name = $4, aliases = $5,
 		       bidirectional = $6, return_name = $7, visibility = $8, visible_to = $9,
-		       locked = $10, lock_type = $11, lock_data = $12, version = version + 1
+		       locked = $10, lock_type = $11, lock_data = $12, traversal_delay = $13,
+		       traversal_cost = $14, version = version + 1
 		WHERE id = $1`
 	args := []any{
 		exit.ID.String(),
@@ -176,9 +276,11 @@ func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.
 		exit.Locked,
 		nullableLockType(exit.LockType),
 		lockDataJSON,
+		int64(exit.TraversalDelay),
+		exit.TraversalCost,
 	}
 	if exit.Version > 0 {
-		query += ` AND version = $13`
+		query += ` AND version = $15`
 		args = append(args, exit.Version)
 	}
 	query += ` RETURNING version`
@@ -186,6 +288,20 @@ func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.
 	var delta *wmodel.MutationDelta
 	txErr := withTx(ctx, r.pool, func(txCtx context.Context) error {
 		tx := txFromContext(txCtx)
+		// The destination's names are locked too: a re-pointed return exit
+		// is checked there.
+		if err := lockExitNamesTx(txCtx, tx, exit.FromLocationID, exit.ToLocationID); err != nil {
+			return err
+		}
+		prior, err := r.scanExitTx(txCtx, tx, `
+			SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
+			       return_name, visibility, visible_to, locked, lock_type, lock_data,
+			       traversal_delay, traversal_cost, created_at, version
+			FROM exits WHERE id = $1 FOR UPDATE
+		`, exit.ID.String())
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return oops.With("operation", "get exit for update").With("id", exit.ID.String()).Wrap(err)
+		}
 		var newVersion int
 		scanErr := tx.QueryRow(txCtx, query, args...).Scan(&newVersion)
 		if errors.Is(scanErr, pgx.ErrNoRows) {
@@ -197,8 +313,20 @@ func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.
 		if scanErr != nil {
 			return oops.With("operation", "update exit").With("id", exit.ID.String()).Wrap(scanErr)
 		}
+		// Checked after the CAS so a stale or missing row is reported as such;
+		// a conflict rolls the update back.
+		if err := r.checkExitNameConflictTx(txCtx, tx, exit); err != nil {
+			return err
+		}
+		followed, err := r.followReturnExitTx(txCtx, tx, prior, exit)
+		if err != nil {
+			return err
+		}
 		exit.Version = newVersion
 		delta = primaryDeltaVersioned(wmodel.AggregateExit, exit.ID, false, newVersion-1, newVersion)
+		if followed != nil {
+			delta.Affected = append(delta.Affected, *followed)
+		}
 		return nil
 	})
 	if txErr != nil {
@@ -207,6 +335,78 @@ func (r *ExitRepository) Update(ctx context.Context, exit *world.Exit) (*wmodel.
 	return delta, nil
 }
 
+// followReturnExitTx keeps a bidirectional pair linked across an update of
+// one side. prior is the row before the update (nil when it was absent) and
+// exit the row as written. When prior was bidirectional and exit still is,
+// and the endpoints, name, or return name changed, the return exit — the
+// exit named prior.ReturnName at prior's destination that leads back to
+// prior's origin — is moved to run from exit's destination back to its
+// origin under exit.ReturnName, with exit.Name as its own return name.
+//
+// A missing return exit is left alone; ListDanglingExits reports it. Exits
+// into a deleted location need no rule here: the foreign keys cascade, so
+// both sides of a pair disappear with either location.
+//
+// Returns the re-pointed return exit as an affected aggregate, or nil when
+// nothing moved. The caller MUST hold the lockExitNamesTx lock for exit's
+// destination.
+func (r *ExitRepository) followReturnExitTx(ctx context.Context, tx pgx.Tx, prior, exit *world.Exit) (*wmodel.AffectedAggregate, error) {
+	if prior == nil || !prior.Bidirectional || prior.ReturnName == "" {
+		return nil, nil
+	}
+	if !exit.Bidirectional || exit.ReturnName == "" {
+		return nil, nil
+	}
+	if prior.FromLocationID == exit.FromLocationID && prior.ToLocationID == exit.ToLocationID &&
+		prior.Name == exit.Name && prior.ReturnName == exit.ReturnName {
+		return nil, nil
+	}
+
+	returnExit, err := r.findByNameTx(ctx, tx, prior.ToLocationID, prior.ReturnName)
+	if errors.Is(err, world.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, oops.With("operation", "find return exit").With("id", exit.ID.String()).Wrap(err)
+	}
+	if returnExit.ID == exit.ID || returnExit.ToLocationID != prior.FromLocationID {
+		return nil, nil
+	}
+
+	returnExit.FromLocationID = exit.ToLocationID
+	returnExit.ToLocationID = exit.FromLocationID
+	returnExit.Name = exit.ReturnName
+	returnExit.ReturnName = exit.Name
+	if err := r.checkExitNameConflictTx(ctx, tx, returnExit); err != nil {
+		return nil, err
+	}
+	var newVersion int
+	err = tx.QueryRow(ctx, `
+		UPDATE exits SET from_location_id = $2, to_location_id = $3, name = $4,
+		       return_name = $5, version = version + 1
+		WHERE id = $1
+		RETURNING version
+	`,
+		returnExit.ID.String(),
+		returnExit.FromLocationID.String(),
+		returnExit.ToLocationID.String(),
+		returnExit.Name,
+		returnExit.ReturnName,
+	).Scan(&newVersion)
+	if err != nil {
+		return nil, oops.With("operation", "follow return exit").
+			With("id", exit.ID.String()).
+			With("return_exit_id", returnExit.ID.String()).
+			Wrap(err)
+	}
+	return &wmodel.AffectedAggregate{
+		Type:          wmodel.AggregateExit,
+		ID:            returnExit.ID,
+		BeforeVersion: returnExit.Version,
+		AfterVersion:  newVersion,
+	}, nil
+}
+
 // Delete removes an exit by ID with a version-predicated CAS (MODEL-03).
 // If bidirectional, deletes the return exit atomically in a single transaction.
 // For non-severe issues (return exit not found), the primary delete proceeds.
@@ -241,7 +441,8 @@ func (r *ExitRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersi
 		// Lock the row before checking bidirectional flag and deleting
 		exit, err := r.scanExitTx(txCtx, tx, `
 			SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
-			       return_name, visibility, visible_to, locked, lock_type, lock_data, created_at, version
+			       return_name, visibility, visible_to, locked, lock_type, lock_data,
+			       traversal_delay, traversal_cost, created_at, version
 			FROM exits WHERE id = $1 FOR UPDATE
 		`, id.String())
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -330,15 +531,59 @@ func (r *ExitRepository) Delete(ctx context.Context, id ulid.ULID, expectedVersi
 	return delta, nil
 }
 
-// ListFromLocation returns all exits from a location.
-func (r *ExitRepository) ListFromLocation(ctx context.Context, locationID ulid.ULID) ([]*world.Exit, error) {
+// ListFromLocation returns a page of the exits from a location, ordered by
+// (name, id).
+func (r *ExitRepository) ListFromLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Exit], error) {
+	afterName, afterID, err := pageAfter(opts)
+	if err != nil {
+		return world.Page[*world.Exit]{}, err
+	}
+	size := opts.PageSize()
 	rows, err := r.pool.Query(ctx, `
 		SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
-		       return_name, visibility, visible_to, locked, lock_type, lock_data, created_at, version
-		FROM exits WHERE from_location_id = $1 ORDER BY name
-	`, locationID.String())
+		       return_name, visibility, visible_to, locked, lock_type, lock_data,
+		       traversal_delay, traversal_cost, created_at, version
+		FROM exits
+		WHERE from_location_id = $1 AND ($2::text IS NULL OR (name, id) > ($2, $3))
+		ORDER BY name, id
+		LIMIT $4
+	`, locationID.String(), afterName, afterID, size+1)
+	if err != nil {
+		return world.Page[*world.Exit]{}, oops.With("operation", "list exits from location").With("location_id", locationID.String()).Wrap(err)
+	}
+	defer rows.Close()
+
+	exits, err := r.scanExits(rows)
+	if err != nil {
+		return world.Page[*world.Exit]{}, err
+	}
+	return world.NewPage(exits, size, func(e *world.Exit) string {
+		return world.EncodeCursor(e.Name, e.ID)
+	}), nil
+}
+
+// ListDanglingExits returns the bidirectional exits whose return exit is
+// missing: no exit at the destination answers to the return name (by name or
+// alias, case-insensitively) and leads back to the origin. Ordered by ID.
+func (r *ExitRepository) ListDanglingExits(ctx context.Context) ([]*world.Exit, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT e.id, e.from_location_id, e.to_location_id, e.name, e.aliases, e.bidirectional,
+		       e.return_name, e.visibility, e.visible_to, e.locked, e.lock_type, e.lock_data,
+		       e.traversal_delay, e.traversal_cost, e.created_at, e.version
+		FROM exits e
+		WHERE e.bidirectional AND COALESCE(e.return_name, '') <> ''
+		  AND NOT EXISTS (
+		    SELECT 1 FROM exits r
+		    WHERE r.from_location_id = e.to_location_id
+		      AND r.to_location_id = e.from_location_id
+		      AND (LOWER(r.name) = LOWER(e.return_name) OR EXISTS (
+		        SELECT 1 FROM unnest(r.aliases) AS a WHERE LOWER(a) = LOWER(e.return_name)
+		      ))
+		  )
+		ORDER BY e.id
+	`)
 	if err != nil {
-		return nil, oops.With("operation", "list exits from location").With("location_id", locationID.String()).Wrap(err)
+		return nil, oops.With("operation", "list dangling exits").Wrap(err)
 	}
 	defer rows.Close()
 
@@ -355,7 +600,8 @@ func (r *ExitRepository) ListFromLocation(ctx context.Context, locationID ulid.U
 func (r *ExitRepository) ListVisibleExits(ctx context.Context, locationID, characterID ulid.ULID) ([]*world.Exit, error) {
 	rows, err := r.pool.Query(ctx, `
 		SELECT e.id, e.from_location_id, e.to_location_id, e.name, e.aliases, e.bidirectional,
-		       e.return_name, e.visibility, e.visible_to, e.locked, e.lock_type, e.lock_data, e.created_at, e.version
+		       e.return_name, e.visibility, e.visible_to, e.locked, e.lock_type, e.lock_data,
+		       e.traversal_delay, e.traversal_cost, e.created_at, e.version
 		FROM exits e
 		JOIN locations l ON e.from_location_id = l.id
 		WHERE e.from_location_id = $1
@@ -384,7 +630,8 @@ func (r *ExitRepository) FindByName(ctx context.Context, locationID ulid.ULID, n
 	// For aliases, unnest and compare with LOWER() for consistent behavior
 	exit, err := r.scanExit(ctx, `
 		SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
-		       return_name, visibility, visible_to, locked, lock_type, lock_data, created_at, version
+		       return_name, visibility, visible_to, locked, lock_type, lock_data,
+		       traversal_delay, traversal_cost, created_at, version
 		FROM exits
 		WHERE from_location_id = $1
 		  AND (LOWER(name) = LOWER($2) OR EXISTS (
@@ -405,7 +652,8 @@ func (r *ExitRepository) FindByName(ctx context.Context, locationID ulid.ULID, n
 func (r *ExitRepository) findByNameTx(ctx context.Context, tx pgx.Tx, locationID ulid.ULID, name string) (*world.Exit, error) {
 	exit, err := r.scanExitTx(ctx, tx, `
 		SELECT id, from_location_id, to_location_id, name, aliases, bidirectional,
-		       return_name, visibility, visible_to, locked, lock_type, lock_data, created_at, version
+		       return_name, visibility, visible_to, locked, lock_type, lock_data,
+		       traversal_delay, traversal_cost, created_at, version
 		FROM exits
 		WHERE from_location_id = $1
 		  AND (LOWER(name) = LOWER($2) OR EXISTS (
@@ -436,7 +684,8 @@ func (r *ExitRepository) FindBySimilarity(ctx context.Context, locationID ulid.U
 	// Also check aliases using array unnest
 	exit, err := r.scanExit(ctx, `
 		SELECT e.id, e.from_location_id, e.to_location_id, e.name, e.aliases, e.bidirectional,
-		       e.return_name, e.visibility, e.visible_to, e.locked, e.lock_type, e.lock_data, e.created_at, e.version
+		       e.return_name, e.visibility, e.visible_to, e.locked, e.lock_type, e.lock_data,
+		       e.traversal_delay, e.traversal_cost, e.created_at, e.version
 		FROM exits e
 		WHERE e.from_location_id = $1
 		  AND (
@@ -470,6 +719,7 @@ type exitScanFields struct {
 	visibleToStrs               []string
 	lockType                    *string
 	lockDataJSON                []byte
+	traversalDelay              int64
 	createdAt                   pgnanos.Time
 }
 
@@ -504,6 +754,7 @@ func parseExitFromFields(f *exitScanFields, exit *world.Exit) error {
 	if err != nil {
 		return err
 	}
+	exit.TraversalDelay = time.Duration(f.traversalDelay)
 	exit.CreatedAt = f.createdAt.Time()
 	return nil
 }
@@ -527,7 +778,8 @@ func scanExitRow(row pgx.Row) (*world.Exit, error) {
 
 	err := row.Scan(
 		&f.idStr, &f.fromLocStr, &f.toLocStr, &exit.Name, &f.aliases, &exit.Bidirectional,
-		&f.returnName, &f.visibilityStr, &f.visibleToStrs, &exit.Locked, &f.lockType, &f.lockDataJSON, &f.createdAt, &exit.Version,
+		&f.returnName, &f.visibilityStr, &f.visibleToStrs, &exit.Locked, &f.lockType, &f.lockDataJSON,
+		&f.traversalDelay, &exit.TraversalCost, &f.createdAt, &exit.Version,
 	)
 	if err != nil {
 		return nil, oops.With("operation", "scan exit").Wrap(err)
@@ -549,7 +801,8 @@ func (r *ExitRepository) scanExits(rows pgx.Rows) ([]*world.Exit, error) {
 
 		if err := rows.Scan(
 			&f.idStr, &f.fromLocStr, &f.toLocStr, &exit.Name, &f.aliases, &exit.Bidirectional,
-			&f.returnName, &f.visibilityStr, &f.visibleToStrs, &exit.Locked, &f.lockType, &f.lockDataJSON, &f.createdAt, &exit.Version,
+			&f.returnName, &f.visibilityStr, &f.visibleToStrs, &exit.Locked, &f.lockType, &f.lockDataJSON,
+			&f.traversalDelay, &exit.TraversalCost, &f.createdAt, &exit.Version,
 		); err != nil {
 			return nil, oops.With("operation", "scan exit").Wrap(err)
 		}
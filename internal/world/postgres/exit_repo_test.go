@@ -199,6 +199,107 @@ func TestExitRepository_BidirectionalDelta(t *testing.T) {
 	assert.ErrorIs(t, err, world.ErrNotFound)
 }
 
+// TestExitRepository_ReturnExitFollowsUpdate binds the pair-consistency rule:
+// moving or renaming one side of a bidirectional pair re-points the other.
+func TestExitRepository_ReturnExitFollowsUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewExitRepository(testPool)
+
+	newPair := func(t *testing.T, from, to ulid.ULID) *world.Exit {
+		t.Helper()
+		exit := &world.Exit{
+			ID:             ulid.Make(),
+			FromLocationID: from,
+			ToLocationID:   to,
+			Name:           "north",
+			Bidirectional:  true,
+			ReturnName:     "south",
+			Visibility:     world.VisibilityAll,
+		}
+		require.NoError(t, delErr(repo.Create(ctx, exit)))
+		return exit
+	}
+
+	t.Run("retargeting moves the return exit to the new destination", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		loc3ID, _ := createTestLocations(ctx, t)
+		exit := newPair(t, loc1ID, loc2ID)
+		reverse, err := repo.FindByName(ctx, loc2ID, "south")
+		require.NoError(t, err)
+
+		exit.ToLocationID = loc3ID
+		delta, err := repo.Update(ctx, exit)
+		require.NoError(t, err)
+		require.Len(t, delta.Affected, 1)
+		assert.Equal(t, reverse.ID, delta.Affected[0].ID)
+		assert.Equal(t, 1, delta.Affected[0].BeforeVersion)
+		assert.Equal(t, 2, delta.Affected[0].AfterVersion)
+
+		moved, err := repo.Get(ctx, reverse.ID)
+		require.NoError(t, err)
+		assert.Equal(t, loc3ID, moved.FromLocationID)
+		assert.Equal(t, loc1ID, moved.ToLocationID)
+		_, err = repo.FindByName(ctx, loc2ID, "south")
+		assert.ErrorIs(t, err, world.ErrNotFound)
+
+		dangling, err := repo.ListDanglingExits(ctx)
+		require.NoError(t, err)
+		for _, d := range dangling {
+			assert.NotContains(t, []ulid.ULID{exit.ID, reverse.ID}, d.ID)
+		}
+	})
+
+	t.Run("renaming updates the return exit's return name", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		exit := newPair(t, loc1ID, loc2ID)
+
+		exit.Name = "up"
+		exit.ReturnName = "down"
+		require.NoError(t, delErr(repo.Update(ctx, exit)))
+
+		reverse, err := repo.FindByName(ctx, loc2ID, "down")
+		require.NoError(t, err)
+		assert.Equal(t, "up", reverse.ReturnName)
+	})
+
+	t.Run("a name conflict at the new destination rolls the update back", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		loc3ID, loc4ID := createTestLocations(ctx, t)
+		exit := newPair(t, loc1ID, loc2ID)
+		require.NoError(t, delErr(repo.Create(ctx, &world.Exit{
+			ID: ulid.Make(), FromLocationID: loc3ID, ToLocationID: loc4ID,
+			Name: "south", Visibility: world.VisibilityAll,
+		})))
+
+		exit.ToLocationID = loc3ID
+		_, err := repo.Update(ctx, exit)
+		errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+
+		got, err := repo.Get(ctx, exit.ID)
+		require.NoError(t, err)
+		assert.Equal(t, loc2ID, got.ToLocationID)
+	})
+
+	t.Run("a pair whose return exit is gone is reported as dangling", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		exit := newPair(t, loc1ID, loc2ID)
+		_, err := testPool.Exec(ctx, `DELETE FROM exits WHERE from_location_id = $1`, loc2ID.String())
+		require.NoError(t, err)
+
+		exit.ToLocationID = loc2ID
+		exit.Name = "northward"
+		require.NoError(t, delErr(repo.Update(ctx, exit)), "a missing return exit does not block the update")
+
+		dangling, err := repo.ListDanglingExits(ctx)
+		require.NoError(t, err)
+		var ids []ulid.ULID
+		for _, d := range dangling {
+			ids = append(ids, d.ID)
+		}
+		assert.Contains(t, ids, exit.ID)
+	})
+}
+
 // createTestLocations creates two test locations for exit tests.
 func createTestLocations(ctx context.Context, t *testing.T) (ulid.ULID, ulid.ULID) {
 	t.Helper()
@@ -444,6 +545,77 @@ func TestExitRepository_CRUD(t *testing.T) {
 	})
 }
 
+func TestExitRepository_NameConflicts(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewExitRepository(testPool)
+
+	newExit := func(from, to ulid.ULID, name string, aliases ...string) *world.Exit {
+		return &world.Exit{
+			ID:             ulid.Make(),
+			FromLocationID: from,
+			ToLocationID:   to,
+			Name:           name,
+			Aliases:        aliases,
+			Visibility:     world.VisibilityAll,
+		}
+	}
+
+	t.Run("create rejects a name already used as an alias, case-insensitively", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		existing := newExit(loc1ID, loc2ID, "north", "n")
+		require.NoError(t, delErr(repo.Create(ctx, existing)))
+
+		_, err := repo.Create(ctx, newExit(loc1ID, loc2ID, "N"))
+		errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+		var conflict *world.ExitNameConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, existing.ID, conflict.ConflictingExitID)
+		assert.Equal(t, "N", conflict.Name)
+
+		exits, err := repo.ListFromLocation(ctx, loc1ID, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Len(t, exits.Items, 1, "the conflicting exit is not written")
+	})
+
+	t.Run("the same name from different locations is allowed", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		require.NoError(t, delErr(repo.Create(ctx, newExit(loc1ID, loc2ID, "out"))))
+		require.NoError(t, delErr(repo.Create(ctx, newExit(loc2ID, loc1ID, "out"))))
+	})
+
+	t.Run("bidirectional create checks the return name at the destination", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		require.NoError(t, delErr(repo.Create(ctx, newExit(loc2ID, loc1ID, "south"))))
+
+		exit := newExit(loc1ID, loc2ID, "north")
+		exit.Bidirectional = true
+		exit.ReturnName = "South"
+		_, err := repo.Create(ctx, exit)
+		assert.ErrorIs(t, err, world.ErrExitNameConflict)
+
+		_, err = repo.Get(ctx, exit.ID)
+		assert.ErrorIs(t, err, world.ErrNotFound, "the primary exit rolls back with the return exit")
+	})
+
+	t.Run("update rejects an alias taken by a sibling but not by itself", func(t *testing.T) {
+		loc1ID, loc2ID := createTestLocations(ctx, t)
+		require.NoError(t, delErr(repo.Create(ctx, newExit(loc1ID, loc2ID, "east", "e"))))
+		exit := newExit(loc1ID, loc2ID, "west", "w")
+		require.NoError(t, delErr(repo.Create(ctx, exit)))
+
+		exit.Aliases = []string{"W", "west"}
+		require.NoError(t, delErr(repo.Update(ctx, exit)))
+
+		exit.Aliases = []string{"w", "E"}
+		_, err := repo.Update(ctx, exit)
+		errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+
+		got, err := repo.Get(ctx, exit.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"W", "west"}, got.Aliases, "the conflicting update rolls back")
+	})
+}
+
 func TestExitRepository_ListFromLocation(t *testing.T) {
 	ctx := context.Background()
 	repo := postgres.NewExitRepository(testPool)
@@ -475,13 +647,25 @@ func TestExitRepository_ListFromLocation(t *testing.T) {
 	}
 
 	// List exits from loc1
-	got, err := repo.ListFromLocation(ctx, loc1ID)
+	got, err := repo.ListFromLocation(ctx, loc1ID, world.ListOptions{})
 	require.NoError(t, err)
-	assert.Len(t, got, 2)
+	require.Len(t, got.Items, 2)
+	assert.Empty(t, got.NextCursor)
 
 	// Exits should be ordered by name
-	assert.Equal(t, "alpha", got[0].Name)
-	assert.Equal(t, "beta", got[1].Name)
+	assert.Equal(t, "alpha", got.Items[0].Name)
+	assert.Equal(t, "beta", got.Items[1].Name)
+
+	// One exit per page walks the same order
+	first, err := repo.ListFromLocation(ctx, loc1ID, world.ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, first.Items, 1)
+	assert.Equal(t, "alpha", first.Items[0].Name)
+	second, err := repo.ListFromLocation(ctx, loc1ID, world.ListOptions{Limit: 1, Cursor: first.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, second.Items, 1)
+	assert.Equal(t, "beta", second.Items[0].Name)
+	assert.Empty(t, second.NextCursor)
 }
 
 func TestExitRepository_FindByName(t *testing.T) {
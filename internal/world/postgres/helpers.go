@@ -14,6 +14,7 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/pgnanos"
 	"github.com/holomush/holomush/internal/world"
 	"github.com/holomush/holomush/internal/world/wmodel"
 )
@@ -240,3 +241,87 @@ func unmarshalLockData(data []byte) (map[string]any, error) {
 	}
 	return result, nil
 }
+
+// marshalObjectVerbs marshals an object's verbs for the objects.verbs JSONB
+// column. An object with no verbs is stored as an empty array, never NULL.
+func marshalObjectVerbs(verbs []world.ObjectVerb) ([]byte, error) {
+	if len(verbs) == 0 {
+		return []byte("[]"), nil
+	}
+	b, err := json.Marshal(verbs)
+	if err != nil {
+		return nil, oops.With("operation", "marshal object verbs").Wrap(err)
+	}
+	return b, nil
+}
+
+// unmarshalObjectVerbs unmarshals the objects.verbs column. Returns nil for
+// an empty array.
+func unmarshalObjectVerbs(data []byte) ([]world.ObjectVerb, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var verbs []world.ObjectVerb
+	if err := json.Unmarshal(data, &verbs); err != nil {
+		return nil, oops.With("operation", "unmarshal object verbs").Wrap(err)
+	}
+	if len(verbs) == 0 {
+		return nil, nil
+	}
+	return verbs, nil
+}
+
+// wornAtArg returns the objects.worn_at value for obj: NULL unless its holder
+// is wearing it.
+func wornAtArg(obj *world.Object) *pgnanos.Time {
+	if !obj.Worn() {
+		return nil
+	}
+	t := pgnanos.From(obj.WornAt)
+	return &t
+}
+
+// marshalDescriptionEffects marshals a character's description effects for
+// the characters.description_effects JSONB column. A character with no
+// effects is stored as an empty array, never NULL.
+func marshalDescriptionEffects(effects []world.DescriptionEffect) ([]byte, error) {
+	if len(effects) == 0 {
+		return []byte("[]"), nil
+	}
+	b, err := json.Marshal(effects)
+	if err != nil {
+		return nil, oops.With("operation", "marshal description effects").Wrap(err)
+	}
+	return b, nil
+}
+
+// unmarshalDescriptionEffects unmarshals the characters.description_effects
+// column. Returns nil for an empty array.
+func unmarshalDescriptionEffects(data []byte) ([]world.DescriptionEffect, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var effects []world.DescriptionEffect
+	if err := json.Unmarshal(data, &effects); err != nil {
+		return nil, oops.With("operation", "unmarshal description effects").Wrap(err)
+	}
+	if len(effects) == 0 {
+		return nil, nil
+	}
+	return effects, nil
+}
+
+// pageAfter decodes opts.Cursor into the keyset bound a page query starts
+// after. Both are nil for the first page, so queries test `$n::text IS NULL`
+// to skip the bound.
+func pageAfter(opts world.ListOptions) (key, id *string, err error) {
+	if opts.Cursor == "" {
+		return nil, nil, nil
+	}
+	k, cursorID, err := world.DecodeCursor(opts.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	idStr := cursorID.String()
+	return &k, &idStr, nil
+}
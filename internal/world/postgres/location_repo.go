@@ -30,7 +30,7 @@ func NewLocationRepository(pool *pgxpool.Pool) *LocationRepository {
 // Get retrieves a location by ID.
 func (r *LocationRepository) Get(ctx context.Context, id ulid.ULID) (*world.Location, error) {
 	row := r.pool.QueryRow(ctx, `
-		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, created_at, archived_at, version
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
 		FROM locations WHERE id = $1
 	`, id.String())
 	loc, err := scanLocationRow(row)
@@ -43,6 +43,28 @@ func (r *LocationRepository) Get(ctx context.Context, id ulid.ULID) (*world.Loca
 	return loc, nil
 }
 
+// GetMany retrieves the locations with the given IDs in a single query.
+// Missing IDs are omitted; the result order is unspecified.
+func (r *LocationRepository) GetMany(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	if len(ids) == 0 {
+		return []*world.Location{}, nil
+	}
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
+		FROM locations WHERE id = ANY($1)
+	`, strs)
+	if err != nil {
+		return nil, oops.With("operation", "get locations").With("count", len(ids)).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanLocations(rows)
+}
+
 // Create persists a new location.
 // Callers must validate the location before calling this method.
 // The struct's Version is refreshed to the DB-assigned initial version (1) so a
@@ -56,11 +78,12 @@ func (r *LocationRepository) Create(ctx context.Context, loc *world.Location) (*
 	}
 	var newVersion int
 	err := querierFromCtx(ctx, r.pool).QueryRow(ctx, `
-		INSERT INTO locations (id, type, shadows_id, name, description, owner_id, replay_policy, created_at, archived_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO locations (id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, created_at, archived_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING version
 	`, loc.ID.String(), loc.Type, ulidToStringPtr(loc.ShadowsID), loc.Name, loc.Description,
-		ulidToStringPtr(loc.OwnerID), loc.ReplayPolicy, pgnanos.From(loc.CreatedAt), archivedAt).Scan(&newVersion)
+		ulidToStringPtr(loc.OwnerID), loc.ReplayPolicy, zoneIDPtr(loc.ZoneID), ulidToStringPtr(loc.ParentID),
+		loc.MaxOccupancy, ulidToStringPtr(loc.OverflowID), pgnanos.From(loc.CreatedAt), archivedAt).Scan(&newVersion)
 	if err != nil {
 		return nil, oops.With("operation", "create location").With("id", loc.ID.String()).Wrap(err)
 	}
@@ -87,14 +110,16 @@ func (r *LocationRepository) Update(ctx context.Context, loc *world.Location) (*
 
 	query := `
 		UPDATE locations SET type = $2, shadows_id = $3, name = $4, description = $5,
-		owner_id = $6, replay_policy = $7, archived_at = $8, version = version + 1
+		owner_id = $6, replay_policy = $7, archived_at = $8, zone_id = $9, parent_id = $10,
+		max_occupancy = $11, overflow_id = $12, version = version + 1
 		WHERE id = $1`
 	args := []any{
 		loc.ID.String(), loc.Type, ulidToStringPtr(loc.ShadowsID), loc.Name, loc.Description,
-		ulidToStringPtr(loc.OwnerID), loc.ReplayPolicy, archivedAt,
+		ulidToStringPtr(loc.OwnerID), loc.ReplayPolicy, archivedAt, zoneIDPtr(loc.ZoneID), ulidToStringPtr(loc.ParentID),
+		loc.MaxOccupancy, ulidToStringPtr(loc.OverflowID),
 	}
 	if loc.Version > 0 {
-		query += ` AND version = $9`
+		query += ` AND version = $13`
 		args = append(args, loc.Version)
 	}
 	query += ` RETURNING version`
@@ -233,7 +258,7 @@ func preselectCascadedExits(ctx context.Context, tx pgx.Tx, locationID ulid.ULID
 // ListByType returns all locations of the given type.
 func (r *LocationRepository) ListByType(ctx context.Context, locType world.LocationType) ([]*world.Location, error) {
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, created_at, archived_at, version
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
 		FROM locations WHERE type = $1 ORDER BY created_at DESC, id DESC
 	`, string(locType)) // tiebreaker for sub-ns insert collisions across dual-clock writers (holomush-gfo6.33)
 	if err != nil {
@@ -247,7 +272,7 @@ func (r *LocationRepository) ListByType(ctx context.Context, locType world.Locat
 // GetShadowedBy returns scenes that shadow the given location.
 func (r *LocationRepository) GetShadowedBy(ctx context.Context, id ulid.ULID) ([]*world.Location, error) {
 	rows, err := r.pool.Query(ctx, `
-		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, created_at, archived_at, version
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
 		FROM locations WHERE shadows_id = $1 ORDER BY created_at DESC, id DESC
 	`, id.String()) // tiebreaker for sub-ns insert collisions across dual-clock writers (holomush-gfo6.33)
 	if err != nil {
@@ -258,11 +283,62 @@ func (r *LocationRepository) GetShadowedBy(ctx context.Context, id ulid.ULID) ([
 	return scanLocations(rows)
 }
 
+// ListByZone returns the locations tagged with zoneID, ordered by ID.
+func (r *LocationRepository) ListByZone(ctx context.Context, zoneID string) ([]*world.Location, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
+		FROM locations WHERE zone_id = $1 ORDER BY id
+	`, zoneID)
+	if err != nil {
+		return nil, oops.With("operation", "list locations by zone").With("zone_id", zoneID).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanLocations(rows)
+}
+
+// ListChildren returns the locations whose parent is parentID, ordered by
+// ID.
+func (r *LocationRepository) ListChildren(ctx context.Context, parentID ulid.ULID) ([]*world.Location, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
+		FROM locations WHERE parent_id = $1 ORDER BY id
+	`, parentID.String())
+	if err != nil {
+		return nil, oops.With("operation", "list child locations").With("parent_id", parentID.String()).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanLocations(rows)
+}
+
+// ListByTag returns the locations carrying tag, ordered by ID.
+func (r *LocationRepository) ListByTag(ctx context.Context, tag string) ([]*world.Location, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
+		FROM locations WHERE tags @> ARRAY[$1::text] ORDER BY id
+	`, tag)
+	if err != nil {
+		return nil, oops.With("operation", "list locations by tag").With("tag", tag).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanLocations(rows)
+}
+
+// SetTags replaces a location's tags with a version-predicated CAS
+// (MODEL-03). Zero rows classify into WORLD_CONCURRENT_EDIT or
+// LOCATION_NOT_FOUND like Update.
+func (r *LocationRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return setEntityTags(ctx, r.pool, "locations", wmodel.AggregateLocation, id, tags, expectedVersion,
+		oops.Code("LOCATION_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound))
+}
+
 // FindByName searches for a location by exact name match.
 // Returns ErrNotFound if no location matches.
 func (r *LocationRepository) FindByName(ctx context.Context, name string) (*world.Location, error) {
 	row := r.pool.QueryRow(ctx, `
-		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, created_at, archived_at, version
+		SELECT id, type, shadows_id, name, description, owner_id, replay_policy, zone_id, parent_id, max_occupancy, overflow_id, tags, created_at, archived_at, version
 		FROM locations WHERE name = $1
 	`, name)
 	loc, err := scanLocationRow(row)
@@ -277,11 +353,14 @@ func (r *LocationRepository) FindByName(ctx context.Context, name string) (*worl
 
 // locationScanFields holds intermediate scan values for location parsing.
 type locationScanFields struct {
-	idStr        string
-	shadowsIDStr *string
-	ownerIDStr   *string
-	createdAt    pgnanos.Time
-	archivedAt   *pgnanos.Time
+	idStr         string
+	shadowsIDStr  *string
+	ownerIDStr    *string
+	zoneID        *string
+	parentIDStr   *string
+	overflowIDStr *string
+	createdAt     pgnanos.Time
+	archivedAt    *pgnanos.Time
 }
 
 // scanLocationRow scans a single location from a row.
@@ -291,7 +370,7 @@ func scanLocationRow(row pgx.Row) (*world.Location, error) {
 
 	err := row.Scan(
 		&f.idStr, &loc.Type, &f.shadowsIDStr, &loc.Name, &loc.Description,
-		&f.ownerIDStr, &loc.ReplayPolicy, &f.createdAt, &f.archivedAt, &loc.Version,
+		&f.ownerIDStr, &loc.ReplayPolicy, &f.zoneID, &f.parentIDStr, &loc.MaxOccupancy, &f.overflowIDStr, &loc.Tags, &f.createdAt, &f.archivedAt, &loc.Version,
 	)
 	if err != nil {
 		return nil, oops.With("operation", "scan location").Wrap(err)
@@ -319,6 +398,18 @@ func parseLocationFromFields(f *locationScanFields, loc *world.Location) error {
 	if err != nil {
 		return err
 	}
+	if f.zoneID != nil {
+		loc.ZoneID = *f.zoneID
+	}
+	loc.ParentID, err = parseOptionalULID(f.parentIDStr, "parent_id")
+	if err != nil {
+		return err
+	}
+	loc.OverflowID, err = parseOptionalULID(f.overflowIDStr, "overflow_id")
+	if err != nil {
+		return err
+	}
+	loc.Tags = scannedTags(loc.Tags)
 	loc.CreatedAt = f.createdAt.Time()
 	if f.archivedAt != nil {
 		t := f.archivedAt.Time()
@@ -327,6 +418,15 @@ func parseLocationFromFields(f *locationScanFields, loc *world.Location) error {
 	return nil
 }
 
+// zoneIDPtr maps an empty zone to NULL so untagged locations stay out of
+// the zone index.
+func zoneIDPtr(zoneID string) *string {
+	if zoneID == "" {
+		return nil
+	}
+	return &zoneID
+}
+
 func scanLocations(rows pgx.Rows) ([]*world.Location, error) {
 	locations := make([]*world.Location, 0)
 	for rows.Next() {
@@ -335,7 +435,7 @@ func scanLocations(rows pgx.Rows) ([]*world.Location, error) {
 
 		if err := rows.Scan(
 			&f.idStr, &loc.Type, &f.shadowsIDStr, &loc.Name, &loc.Description,
-			&f.ownerIDStr, &loc.ReplayPolicy, &f.createdAt, &f.archivedAt, &loc.Version,
+			&f.ownerIDStr, &loc.ReplayPolicy, &f.zoneID, &f.parentIDStr, &loc.MaxOccupancy, &f.overflowIDStr, &loc.Tags, &f.createdAt, &f.archivedAt, &loc.Version,
 		); err != nil {
 			return nil, oops.With("operation", "scan location").Wrap(err)
 		}
@@ -683,6 +683,39 @@ func TestLocationRepository_GetShadowedBy(t *testing.T) {
 	})
 }
 
+func TestLocationRepository_GetMany(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewLocationRepository(testPool)
+
+	first := newTestLocation("GetMany First")
+	second := newTestLocation("GetMany Second")
+	require.NoError(t, delErr(repo.Create(ctx, first)))
+	require.NoError(t, delErr(repo.Create(ctx, second)))
+	t.Cleanup(func() {
+		_ = delErr(repo.Delete(ctx, first.ID, 0))
+		_ = delErr(repo.Delete(ctx, second.ID, 0))
+	})
+
+	t.Run("returns existing locations and omits missing IDs", func(t *testing.T) {
+		got, err := repo.GetMany(ctx, []ulid.ULID{first.ID, ulid.Make(), second.ID})
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		names := map[ulid.ULID]string{}
+		for _, loc := range got {
+			names[loc.ID] = loc.Name
+		}
+		assert.Equal(t, "GetMany First", names[first.ID])
+		assert.Equal(t, "GetMany Second", names[second.ID])
+	})
+
+	t.Run("empty input returns empty slice", func(t *testing.T) {
+		got, err := repo.GetMany(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
 func TestLocationRepository_FindByName(t *testing.T) {
 	ctx := context.Background()
 	repo := postgres.NewLocationRepository(testPool)
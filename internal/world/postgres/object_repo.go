@@ -40,7 +40,8 @@ func NewObjectRepositoryWithDepth(pool *pgxpool.Pool, maxNestingDepth int) *Obje
 func (r *ObjectRepository) Get(ctx context.Context, id ulid.ULID) (*world.Object, error) {
 	row := r.pool.QueryRow(ctx, `
 		SELECT id, name, description, location_id, held_by_character_id,
-		       contained_in_object_id, is_container, owner_id, created_at, version
+		       contained_in_object_id, is_container, owner_id, locked, verbs,
+		       wear_description, worn_at, tags, created_at, version
 		FROM objects WHERE id = $1
 	`, id.String())
 	obj, err := scanObjectRow(row)
@@ -58,11 +59,16 @@ func (r *ObjectRepository) Get(ctx context.Context, id ulid.ULID) (*world.Object
 // Version is refreshed to the DB-assigned initial version (1) so a reused struct
 // does not later carry a stale version and spuriously conflict (finding 12).
 func (r *ObjectRepository) Create(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	verbs, err := marshalObjectVerbs(obj.Verbs)
+	if err != nil {
+		return nil, oops.With("id", obj.ID.String()).Wrap(err)
+	}
 	var newVersion int
-	err := querierFromCtx(ctx, r.pool).QueryRow(ctx, `
+	err = querierFromCtx(ctx, r.pool).QueryRow(ctx, `
 		INSERT INTO objects (id, name, description, location_id, held_by_character_id,
-		                     contained_in_object_id, is_container, owner_id, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		                     contained_in_object_id, is_container, owner_id, locked, verbs,
+		                     wear_description, worn_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING version
 	`, obj.ID.String(), obj.Name, obj.Description,
 		ulidToStringPtr(obj.LocationID()),
@@ -70,6 +76,10 @@ func (r *ObjectRepository) Create(ctx context.Context, obj *world.Object) (*wmod
 		ulidToStringPtr(obj.ContainedInObjectID()),
 		obj.IsContainer,
 		ulidToStringPtr(obj.OwnerID),
+		obj.Locked,
+		verbs,
+		obj.WearDescription,
+		wornAtArg(obj),
 		pgnanos.From(obj.CreatedAt)).Scan(&newVersion)
 	if err != nil {
 		return nil, oops.With("operation", "create object").With("id", obj.ID.String()).Wrap(err)
@@ -87,10 +97,15 @@ func (r *ObjectRepository) Create(ctx context.Context, obj *world.Object) (*wmod
 // OBJECT_NOT_FOUND (row absent). When obj.Version == 0 the write is unversioned
 // (id-only). On success obj.Version is refreshed to the committed value (finding 12).
 func (r *ObjectRepository) Update(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	verbs, err := marshalObjectVerbs(obj.Verbs)
+	if err != nil {
+		return nil, oops.With("id", obj.ID.String()).Wrap(err)
+	}
 	query := `
 		UPDATE objects SET name = $2, description = $3, location_id = $4,
 		       held_by_character_id = $5, contained_in_object_id = $6,
-		       is_container = $7, owner_id = $8, version = version + 1
+		       is_container = $7, owner_id = $8, locked = $9, verbs = $10,
+		       wear_description = $11, worn_at = $12, version = version + 1
 		WHERE id = $1`
 	args := []any{
 		obj.ID.String(), obj.Name, obj.Description,
@@ -99,9 +114,13 @@ func (r *ObjectRepository) Update(ctx context.Context, obj *world.Object) (*wmod
 		ulidToStringPtr(obj.ContainedInObjectID()),
 		obj.IsContainer,
 		ulidToStringPtr(obj.OwnerID),
+		obj.Locked,
+		verbs,
+		obj.WearDescription,
+		wornAtArg(obj),
 	}
 	if obj.Version > 0 {
-		query += ` AND version = $9`
+		query += ` AND version = $13`
 		args = append(args, obj.Version)
 	}
 	query += ` RETURNING version`
@@ -176,7 +195,8 @@ func (r *ObjectRepository) Delete(ctx context.Context, id ulid.ULID, expectedVer
 func (r *ObjectRepository) ListAtLocation(ctx context.Context, locationID ulid.ULID) ([]*world.Object, error) {
 	rows, err := r.pool.Query(ctx, `
 		SELECT id, name, description, location_id, held_by_character_id,
-		       contained_in_object_id, is_container, owner_id, created_at, version
+		       contained_in_object_id, is_container, owner_id, locked, verbs,
+		       wear_description, worn_at, tags, created_at, version
 		FROM objects WHERE location_id = $1 ORDER BY created_at DESC, id DESC
 	`, locationID.String()) // tiebreaker for sub-ns insert collisions across dual-clock writers (holomush-gfo6.33)
 	if err != nil {
@@ -191,7 +211,8 @@ func (r *ObjectRepository) ListAtLocation(ctx context.Context, locationID ulid.U
 func (r *ObjectRepository) ListHeldBy(ctx context.Context, characterID ulid.ULID) ([]*world.Object, error) {
 	rows, err := r.pool.Query(ctx, `
 		SELECT id, name, description, location_id, held_by_character_id,
-		       contained_in_object_id, is_container, owner_id, created_at, version
+		       contained_in_object_id, is_container, owner_id, locked, verbs,
+		       wear_description, worn_at, tags, created_at, version
 		FROM objects WHERE held_by_character_id = $1 ORDER BY created_at DESC, id DESC
 	`, characterID.String()) // tiebreaker for sub-ns insert collisions across dual-clock writers (holomush-gfo6.33)
 	if err != nil {
@@ -206,7 +227,8 @@ func (r *ObjectRepository) ListHeldBy(ctx context.Context, characterID ulid.ULID
 func (r *ObjectRepository) ListContainedIn(ctx context.Context, objectID ulid.ULID) ([]*world.Object, error) {
 	rows, err := r.pool.Query(ctx, `
 		SELECT id, name, description, location_id, held_by_character_id,
-		       contained_in_object_id, is_container, owner_id, created_at, version
+		       contained_in_object_id, is_container, owner_id, locked, verbs,
+		       wear_description, worn_at, tags, created_at, version
 		FROM objects WHERE contained_in_object_id = $1 ORDER BY created_at DESC, id DESC
 	`, objectID.String()) // tiebreaker for sub-ns insert collisions across dual-clock writers (holomush-gfo6.33)
 	if err != nil {
@@ -217,6 +239,30 @@ func (r *ObjectRepository) ListContainedIn(ctx context.Context, objectID ulid.UL
 	return scanObjects(rows)
 }
 
+// ListByTag returns the objects carrying tag, ordered by ID.
+func (r *ObjectRepository) ListByTag(ctx context.Context, tag string) ([]*world.Object, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, name, description, location_id, held_by_character_id,
+		       contained_in_object_id, is_container, owner_id, locked, verbs,
+		       wear_description, worn_at, tags, created_at, version
+		FROM objects WHERE tags @> ARRAY[$1::text] ORDER BY id
+	`, tag)
+	if err != nil {
+		return nil, oops.With("operation", "list objects by tag").With("tag", tag).Wrap(err)
+	}
+	defer rows.Close()
+
+	return scanObjects(rows)
+}
+
+// SetTags replaces an object's tags with a version-predicated CAS
+// (MODEL-03). Zero rows classify into WORLD_CONCURRENT_EDIT or
+// OBJECT_NOT_FOUND like Update.
+func (r *ObjectRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	return setEntityTags(ctx, r.pool, "objects", wmodel.AggregateObject, id, tags, expectedVersion,
+		oops.Code("OBJECT_NOT_FOUND").With("id", id.String()).Wrap(world.ErrNotFound))
+}
+
 // DefaultMaxNestingDepth is the maximum allowed nesting depth for object containment.
 const DefaultMaxNestingDepth = 3
 
@@ -226,7 +272,9 @@ const DefaultMaxNestingDepth = 3
 // - Max nesting depth is enforced (configurable via NewObjectRepositoryWithDepth)
 // - Circular containment is prevented
 // Uses a transaction with SELECT FOR UPDATE to ensure atomicity and prevent TOCTOU
-// vulnerabilities - both the object and container (if any) are locked for the duration.
+// vulnerabilities - the object, the container (if any), and every container the
+// container sits inside are locked for the duration. Violations wrap
+// world.ErrCircularContainment or world.ErrNestingDepthExceeded.
 func (r *ObjectRepository) Move(ctx context.Context, objectID ulid.ULID, to world.Containment, expectedVersion int) (*wmodel.MutationDelta, error) {
 	// Validate containment
 	if err := to.Validate(); err != nil {
@@ -291,6 +339,13 @@ func (r *ObjectRepository) Move(ctx context.Context, objectID ulid.ULID, to worl
 					Wrap(world.ErrInvalidContainment)
 			}
 
+			// Lock the container's whole containment chain before checking it, so
+			// a concurrent move elsewhere in the chain waits for this one and then
+			// checks against the committed result instead of a stale hierarchy.
+			if err = lockContainmentChainTx(txCtx, tx, *to.ObjectID); err != nil {
+				return err
+			}
+
 			// Check for circular containment: object cannot be placed inside itself
 			// or inside any object that is contained within it
 			err = r.checkCircularContainmentTx(txCtx, tx, objectID, *to.ObjectID)
@@ -308,9 +363,11 @@ func (r *ObjectRepository) Move(ctx context.Context, objectID ulid.ULID, to worl
 
 		// Clear all containment fields, set the new one, and increment version. The
 		// row is already locked FOR UPDATE above with the version verified, so a
-		// bare id-predicated UPDATE is safe here (no zero-row window).
+		// bare id-predicated UPDATE is safe here (no zero-row window). An object
+		// that leaves its holder's hands is no longer worn.
 		result, err := tx.Exec(txCtx, `
 			UPDATE objects SET location_id = $2, held_by_character_id = $3, contained_in_object_id = $4,
+			       worn_at = CASE WHEN held_by_character_id IS NOT DISTINCT FROM $3 THEN worn_at END,
 			       version = version + 1
 			WHERE id = $1
 		`, objectID.String(), ulidToStringPtr(to.LocationID), ulidToStringPtr(to.CharacterID), ulidToStringPtr(to.ObjectID))
@@ -337,11 +394,11 @@ func (r *ObjectRepository) checkCircularContainmentTx(ctx context.Context, q que
 	// Self-containment check
 	if objectID == targetContainerID {
 		return oops.
-			Code("CIRCULAR_CONTAINMENT").
+			Code(world.CodeCircularContainment).
 			With("operation", "move object").
 			With("object_id", objectID.String()).
 			With("container_id", targetContainerID.String()).
-			Errorf("circular containment: cannot place object inside itself")
+			Wrapf(world.ErrCircularContainment, "cannot place object inside itself")
 	}
 
 	// Check if targetContainer is contained (directly or transitively) inside objectID
@@ -366,11 +423,11 @@ func (r *ObjectRepository) checkCircularContainmentTx(ctx context.Context, q que
 
 	if isCircular {
 		return oops.
-			Code("CIRCULAR_CONTAINMENT").
+			Code(world.CodeCircularContainment).
 			With("operation", "move object").
 			With("object_id", objectID.String()).
 			With("container_id", targetContainerID.String()).
-			Errorf("circular containment: target container is inside this object")
+			Wrapf(world.ErrCircularContainment, "target container is inside this object")
 	}
 
 	return nil
@@ -419,7 +476,7 @@ func (r *ObjectRepository) checkNestingDepthTx(ctx context.Context, q querier, o
 	totalDepth := targetDepth + objectSubtreeDepth + 1
 	if totalDepth > r.maxNestingDepth {
 		return oops.
-			Code("NESTING_DEPTH_EXCEEDED").
+			Code(world.CodeNestingDepthExceeded).
 			With("operation", "move object").
 			With("object_id", objectID.String()).
 			With("container_id", targetContainerID.String()).
@@ -427,12 +484,71 @@ func (r *ObjectRepository) checkNestingDepthTx(ctx context.Context, q querier, o
 			With("object_subtree_depth", objectSubtreeDepth).
 			With("total_depth", totalDepth).
 			With("max_depth", r.maxNestingDepth).
-			Errorf("max nesting depth exceeded")
+			Wrap(world.ErrNestingDepthExceeded)
 	}
 
 	return nil
 }
 
+// maxChainLockPasses bounds how often lockContainmentChainTx re-reads a
+// containment chain that keeps changing while it waits for locks.
+const maxChainLockPasses = 8
+
+// lockContainmentChainTx locks targetContainerID and every object it sits
+// inside, up to the outermost container.
+//
+// Locking only the moved object and its new container is not enough: moving
+// X into Y while another transaction moves W (Y's outermost container) into
+// X touches four different rows, neither transaction sees the other's
+// uncommitted edge, and both pass the circular-containment check. With the
+// chain locked the two moves share a row, so the second waits for the first
+// and then checks against the committed hierarchy.
+//
+// The chain is read with a recursive CTE and locked in id order. Under READ
+// COMMITTED each pass sees the latest commits, so a chain that changed while
+// this transaction waited is read again; it is stable once a pass finds no
+// row this transaction has not already locked.
+func lockContainmentChainTx(ctx context.Context, tx pgx.Tx, targetContainerID ulid.ULID) error {
+	locked := make(map[string]bool)
+	for range maxChainLockPasses {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			WITH RECURSIVE ancestors AS (
+				SELECT id, contained_in_object_id, 1 as depth
+				FROM objects WHERE id = $1
+				UNION ALL
+				SELECT o.id, o.contained_in_object_id, a.depth + 1
+				FROM objects o
+				JOIN ancestors a ON o.id = a.contained_in_object_id
+				WHERE a.depth < %d
+			)
+			SELECT id FROM objects WHERE id IN (SELECT id FROM ancestors) ORDER BY id FOR UPDATE
+		`, maxCTERecursionDepth), targetContainerID.String())
+		if err != nil {
+			return oops.With("operation", "lock containment chain").With("container_id", targetContainerID.String()).Wrap(err)
+		}
+		ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return oops.With("operation", "lock containment chain").With("container_id", targetContainerID.String()).Wrap(err)
+		}
+		grew := false
+		for _, id := range ids {
+			if !locked[id] {
+				locked[id] = true
+				grew = true
+			}
+		}
+		if !grew {
+			return nil
+		}
+	}
+	return oops.
+		Code("CONTAINMENT_CHAIN_UNSTABLE").
+		With("operation", "move object").
+		With("container_id", targetContainerID.String()).
+		With("passes", maxChainLockPasses).
+		Errorf("containment chain kept changing while it was being locked")
+}
+
 // objectScanFields holds intermediate scan values for object parsing.
 type objectScanFields struct {
 	idStr         string
@@ -440,6 +556,8 @@ type objectScanFields struct {
 	heldByStr     *string
 	containedIn   *string
 	ownerIDStr    *string
+	verbs         []byte
+	wornAt        *pgnanos.Time
 	createdAt     pgnanos.Time
 }
 
@@ -450,7 +568,8 @@ func scanObjectRow(row pgx.Row) (*world.Object, error) {
 
 	err := row.Scan(
 		&f.idStr, &obj.Name, &obj.Description, &f.locationIDStr, &f.heldByStr,
-		&f.containedIn, &obj.IsContainer, &f.ownerIDStr, &f.createdAt, &obj.Version,
+		&f.containedIn, &obj.IsContainer, &f.ownerIDStr, &obj.Locked, &f.verbs,
+		&obj.WearDescription, &f.wornAt, &obj.Tags, &f.createdAt, &obj.Version,
 	)
 	if err != nil {
 		return nil, oops.With("operation", "scan object").Wrap(err)
@@ -503,6 +622,14 @@ func parseObjectFromFields(f *objectScanFields, obj *world.Object) error {
 	if err != nil {
 		return err
 	}
+	obj.Verbs, err = unmarshalObjectVerbs(f.verbs)
+	if err != nil {
+		return oops.With("id", f.idStr).Wrap(err)
+	}
+	if f.wornAt != nil {
+		obj.WornAt = f.wornAt.Time()
+	}
+	obj.Tags = scannedTags(obj.Tags)
 	obj.CreatedAt = f.createdAt.Time()
 	return nil
 }
@@ -515,7 +642,8 @@ func scanObjects(rows pgx.Rows) ([]*world.Object, error) {
 
 		if err := rows.Scan(
 			&f.idStr, &obj.Name, &obj.Description, &f.locationIDStr, &f.heldByStr,
-			&f.containedIn, &obj.IsContainer, &f.ownerIDStr, &f.createdAt, &obj.Version,
+			&f.containedIn, &obj.IsContainer, &f.ownerIDStr, &obj.Locked, &f.verbs,
+			&obj.WearDescription, &f.wornAt, &obj.Tags, &f.createdAt, &obj.Version,
 		); err != nil {
 			return nil, oops.With("operation", "scan object").Wrap(err)
 		}
@@ -120,6 +120,24 @@ func TestObjectRepository_CRUD(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, got.OwnerID)
 		assert.Equal(t, charID, *got.OwnerID)
+		assert.False(t, got.Locked, "objects start unlocked")
+
+		// Lock it
+		obj.Locked = true
+		err = delErr(repo.Update(ctx, obj))
+		require.NoError(t, err)
+		got, err = repo.Get(ctx, obj.ID)
+		require.NoError(t, err)
+		assert.True(t, got.Locked)
+		assert.Empty(t, got.Verbs, "objects start with no verbs")
+
+		// Give it a verb
+		obj.Verbs = []world.ObjectVerb{{Name: "push", Plugin: "harbor-bell", Description: "rings the bell"}}
+		err = delErr(repo.Update(ctx, obj))
+		require.NoError(t, err)
+		got, err = repo.Get(ctx, obj.ID)
+		require.NoError(t, err)
+		assert.Equal(t, obj.Verbs, got.Verbs)
 
 		// Cleanup
 		_ = delErr(repo.Delete(ctx, obj.ID, 0))
@@ -162,6 +180,27 @@ func TestObjectRepository_CRUD(t *testing.T) {
 		assert.Nil(t, got.LocationID())
 		require.NotNil(t, got.HeldByCharacterID())
 		assert.Equal(t, charID, *got.HeldByCharacterID())
+		assert.False(t, got.Worn(), "held objects start unworn")
+
+		// Wear it
+		obj.WearDescription = "A plain object hangs at their hip."
+		obj.WornAt = time.Now().UTC()
+		err = delErr(repo.Update(ctx, obj))
+		require.NoError(t, err)
+		got, err = repo.Get(ctx, obj.ID)
+		require.NoError(t, err)
+		assert.True(t, got.Worn())
+		assert.Equal(t, obj.WearDescription, got.WearDescription)
+		assert.WithinDuration(t, obj.WornAt, got.WornAt, time.Microsecond)
+
+		// Moving it out of the holder's hands takes it off
+		err = delErr(repo.Move(ctx, obj.ID, world.Containment{LocationID: &locationID}, 0))
+		require.NoError(t, err)
+		got, err = repo.Get(ctx, obj.ID)
+		require.NoError(t, err)
+		assert.False(t, got.Worn())
+		assert.True(t, got.WornAt.IsZero())
+		assert.Equal(t, obj.WearDescription, got.WearDescription, "the wear description stays with the object")
 
 		// Cleanup
 		_ = delErr(repo.Delete(ctx, obj.ID, 0))
@@ -785,6 +824,7 @@ func TestObjectRepository_Move(t *testing.T) {
 		err = delErr(repo.Move(ctx, containerA.ID, world.Containment{ObjectID: &containerB.ID}, 0))
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "circular containment")
+		assert.ErrorIs(t, err, world.ErrCircularContainment)
 		errutil.AssertErrorCode(t, err, "CIRCULAR_CONTAINMENT")
 	})
 
@@ -1014,6 +1054,61 @@ func TestObjectRepository_CustomMaxNestingDepth(t *testing.T) {
 	})
 }
 
+// TestObjectRepository_MoveConcurrentCycle covers two moves that only form a
+// cycle together: X into Y while W, Y's container, goes into V, which sits
+// in X. Neither move touches the other's object or container, so only the
+// containment-chain lock makes the second one see the first.
+func TestObjectRepository_MoveConcurrentCycle(t *testing.T) {
+	ctx := context.Background()
+	repo := postgres.NewObjectRepositoryWithDepth(testPool, 10)
+	transactor := postgres.NewTransactor(testPool)
+	locID := createObjectTestLocation(ctx, t)
+
+	newContainer := func(name string, at world.Containment) *world.Object {
+		obj, err := world.NewObjectWithID(ulid.Make(), name, at)
+		require.NoError(t, err)
+		obj.IsContainer = true
+		obj.CreatedAt = time.Now().UTC()
+		require.NoError(t, delErr(repo.Create(ctx, obj)))
+		return obj
+	}
+	x := newContainer("X", world.InLocation(locID))
+	v := newContainer("V", world.InContainer(x.ID))
+	w := newContainer("W", world.InLocation(locID))
+	y := newContainer("Y", world.InContainer(w.ID))
+	t.Cleanup(func() {
+		_, _ = testPool.Exec(ctx, `DELETE FROM objects WHERE id = ANY($1)`,
+			[]string{x.ID.String(), v.ID.String(), w.ID.String(), y.ID.String()})
+	})
+
+	secondStarted := make(chan struct{})
+	secondErr := make(chan error, 1)
+	err := transactor.InTransaction(ctx, func(txCtx context.Context) error {
+		if err := delErr(repo.Move(txCtx, x.ID, world.Containment{ObjectID: &y.ID}, 0)); err != nil {
+			return err
+		}
+		go func() {
+			close(secondStarted)
+			secondErr <- delErr(repo.Move(ctx, w.ID, world.Containment{ObjectID: &v.ID}, 0))
+		}()
+		<-secondStarted
+		// Give the second move time to block on the chain lock before the
+		// first commits; it must fail either way.
+		time.Sleep(200 * time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = <-secondErr
+	require.Error(t, err)
+	assert.ErrorIs(t, err, world.ErrCircularContainment)
+	errutil.AssertErrorCode(t, err, world.CodeCircularContainment)
+
+	got, err := repo.Get(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, locID, *got.LocationID(), "the refused move must leave W where it was")
+}
+
 // objectDBVersion reads the stored version column directly so a test can assert
 // the guard did/did not advance the row.
 func objectDBVersion(ctx context.Context, t *testing.T, id ulid.ULID) int {
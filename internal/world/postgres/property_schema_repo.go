@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// PropertySchemaRepository implements world.PropertySchemaStore using
+// PostgreSQL.
+type PropertySchemaRepository struct {
+	pool *pgxpool.Pool
+}
+
+// Compile-time check that PropertySchemaRepository implements world.PropertySchemaStore.
+var _ world.PropertySchemaStore = (*PropertySchemaRepository)(nil)
+
+// NewPropertySchemaRepository creates a new PropertySchemaRepository.
+func NewPropertySchemaRepository(pool *pgxpool.Pool) *PropertySchemaRepository {
+	return &PropertySchemaRepository{pool: pool}
+}
+
+// List returns every stored schema, ordered by name.
+func (r *PropertySchemaRepository) List(ctx context.Context) ([]world.PropertySchema, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT name, value_type, json_schema, description
+		FROM property_schemas ORDER BY name
+	`)
+	if err != nil {
+		return nil, oops.Code("PROPERTY_SCHEMA_QUERY_FAILED").Wrap(err)
+	}
+	defer rows.Close()
+
+	var schemas []world.PropertySchema
+	for rows.Next() {
+		var (
+			schema     world.PropertySchema
+			valueType  string
+			jsonSchema []byte
+		)
+		if err := rows.Scan(&schema.Name, &valueType, &jsonSchema, &schema.Description); err != nil {
+			return nil, oops.Code("PROPERTY_SCHEMA_QUERY_FAILED").Wrap(err)
+		}
+		schema.Type = world.PropertyValueType(valueType)
+		if len(jsonSchema) > 0 {
+			schema.JSONSchema = json.RawMessage(jsonSchema)
+		}
+		schemas = append(schemas, schema)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, oops.Code("PROPERTY_SCHEMA_QUERY_FAILED").Wrap(err)
+	}
+	return schemas, nil
+}
+
+// Save inserts or replaces the schema for schema.Name.
+func (r *PropertySchemaRepository) Save(ctx context.Context, schema world.PropertySchema, declaredBy string) error {
+	var jsonSchema []byte
+	if len(schema.JSONSchema) > 0 {
+		jsonSchema = schema.JSONSchema
+	}
+	_, err := execerFromCtx(ctx, r.pool).Exec(ctx, `
+		INSERT INTO property_schemas (name, value_type, json_schema, description, declared_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (name) DO UPDATE
+		SET value_type = EXCLUDED.value_type, json_schema = EXCLUDED.json_schema,
+		    description = EXCLUDED.description, declared_by = EXCLUDED.declared_by,
+		    updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+	`, schema.Name, string(schema.Type), jsonSchema, schema.Description, declaredBy)
+	if err != nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").With("name", schema.Name).Wrap(err)
+	}
+	return nil
+}
+
+// Delete removes the schema for name.
+func (r *PropertySchemaRepository) Delete(ctx context.Context, name string) error {
+	if _, err := execerFromCtx(ctx, r.pool).Exec(ctx, `DELETE FROM property_schemas WHERE name = $1`, name); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").With("name", name).Wrap(err)
+	}
+	return nil
+}
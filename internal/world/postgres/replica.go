@@ -0,0 +1,492 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Defaults applied by NewReplicaRouter when the corresponding ReplicaConfig
+// field is unset.
+const (
+	DefaultReplicaMaxLag        = 5 * time.Second
+	DefaultReplicaCheckInterval = time.Second
+)
+
+// replicaLagQuery reports how far the replica's replay trails its primary, in
+// seconds. A replica that has replayed everything it received is current even
+// when the primary has been idle since its last commit. Run against a primary
+// (not in recovery) it reports zero. NULL means the replica has never replayed
+// a transaction and its lag is unknown.
+const replicaLagQuery = `
+	SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN 0
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())::float8
+	END
+`
+
+// ReplicaConfig configures a ReplicaRouter.
+type ReplicaConfig struct {
+	// MaxLag is the staleness bound: reads go to the replica only while its
+	// replay lag is at most MaxLag. Zero uses DefaultReplicaMaxLag.
+	MaxLag time.Duration
+	// CheckInterval is how often the replica's lag is re-measured. Zero uses
+	// DefaultReplicaCheckInterval.
+	CheckInterval time.Duration
+}
+
+// ReplicaStats reports read routing counters since construction.
+type ReplicaStats struct {
+	// ReplicaReads counts reads served by the replica.
+	ReplicaReads uint64
+	// PrimaryReads counts reads routed to the primary: inside a transaction,
+	// while the replica is lagging or unhealthy, or after a replica failure.
+	PrimaryReads uint64
+	// Fallbacks counts replica reads that failed or missed and were retried
+	// on the primary.
+	Fallbacks uint64
+}
+
+// lagProbe measures the replica's replay lag. ok is false when the lag is
+// unknown.
+type lagProbe func(ctx context.Context) (lag time.Duration, ok bool, err error)
+
+// ReplicaRouter routes world repository reads to a read replica so
+// read-heavy look/examine traffic scales past one database. Wrap each
+// primary repository with the matching method; writes always go to the
+// primary.
+//
+// Routing rules:
+//
+//   - Reads inside a transaction go to the primary, so a transaction always
+//     sees its own writes and locked reads are never served stale.
+//   - Reads go to the replica only while its measured replay lag is within
+//     ReplicaConfig.MaxLag. The lag is re-measured at most once per
+//     CheckInterval, by whichever read finds the measurement expired; other
+//     reads use the last measurement meanwhile.
+//   - A replica read that fails falls back to the primary and takes the
+//     replica out of rotation until the next measurement.
+//   - A replica read that reports world.ErrNotFound is retried on the
+//     primary, because the entity may have been created within the lag bound.
+//
+// Reads outside a transaction can therefore trail writes by up to MaxLag. A
+// command that reads an entity and then writes it under its version guard
+// may report a concurrent edit during that window, as with the world cache.
+type ReplicaRouter struct {
+	pool  *pgxpool.Pool
+	cfg   ReplicaConfig
+	probe lagProbe
+	now   func() time.Time
+
+	mu        sync.Mutex
+	healthy   bool
+	checkedAt time.Time
+	probing   bool
+
+	replicaReads atomic.Uint64
+	primaryReads atomic.Uint64
+	fallbacks    atomic.Uint64
+}
+
+// NewReplicaRouter creates a ReplicaRouter that reads from the replica pool.
+// The replica starts out of rotation until its first lag measurement.
+func NewReplicaRouter(replica *pgxpool.Pool, cfg ReplicaConfig) *ReplicaRouter {
+	r := newReplicaRouter(nil, cfg)
+	r.pool = replica
+	r.probe = r.measureLag
+	return r
+}
+
+func newReplicaRouter(probe lagProbe, cfg ReplicaConfig) *ReplicaRouter {
+	if cfg.MaxLag <= 0 {
+		cfg.MaxLag = DefaultReplicaMaxLag
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = DefaultReplicaCheckInterval
+	}
+	return &ReplicaRouter{cfg: cfg, probe: probe, now: time.Now}
+}
+
+// Stats returns the routing counters.
+func (r *ReplicaRouter) Stats() ReplicaStats {
+	return ReplicaStats{
+		ReplicaReads: r.replicaReads.Load(),
+		PrimaryReads: r.primaryReads.Load(),
+		Fallbacks:    r.fallbacks.Load(),
+	}
+}
+
+func (r *ReplicaRouter) measureLag(ctx context.Context) (time.Duration, bool, error) {
+	var seconds *float64
+	if err := r.pool.QueryRow(ctx, replicaLagQuery).Scan(&seconds); err != nil {
+		return 0, false, oops.With("operation", "measure replica lag").Wrap(err)
+	}
+	if seconds == nil {
+		return 0, false, nil
+	}
+	return time.Duration(*seconds * float64(time.Second)), true, nil
+}
+
+// useReplica reports whether a read outside a transaction may go to the
+// replica, re-measuring the lag when the last measurement has expired.
+func (r *ReplicaRouter) useReplica(ctx context.Context) bool {
+	r.mu.Lock()
+	if r.probing || r.now().Sub(r.checkedAt) < r.cfg.CheckInterval {
+		healthy := r.healthy
+		r.mu.Unlock()
+		return healthy
+	}
+	r.probing = true
+	r.mu.Unlock()
+
+	probeCtx, cancel := context.WithTimeout(ctx, r.cfg.CheckInterval)
+	lag, ok, err := r.probe(probeCtx)
+	cancel()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.probing = false
+	r.checkedAt = r.now()
+	r.healthy = err == nil && ok && lag <= r.cfg.MaxLag
+	return r.healthy
+}
+
+// markUnhealthy takes the replica out of rotation until the next lag
+// measurement.
+func (r *ReplicaRouter) markUnhealthy() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.healthy = false
+	r.checkedAt = r.now()
+}
+
+// routeRead runs a read against the replica when the router allows it and
+// against the primary otherwise, falling back to the primary when the replica
+// read fails or misses.
+func routeRead[T any](ctx context.Context, r *ReplicaRouter, replica, primary func(context.Context) (T, error)) (T, error) {
+	if txFromContext(ctx) != nil || !r.useReplica(ctx) {
+		r.primaryReads.Add(1)
+		return primary(ctx)
+	}
+	v, err := replica(ctx)
+	if err == nil {
+		r.replicaReads.Add(1)
+		return v, nil
+	}
+	if ctx.Err() != nil {
+		return v, err
+	}
+	if !errors.Is(err, world.ErrNotFound) {
+		r.markUnhealthy()
+	}
+	r.fallbacks.Add(1)
+	r.primaryReads.Add(1)
+	return primary(ctx)
+}
+
+// Locations wraps primary so its reads are routed by r.
+func (r *ReplicaRouter) Locations(primary world.LocationRepository) world.LocationRepository {
+	return r.locations(primary, NewLocationRepository(r.pool))
+}
+
+func (r *ReplicaRouter) locations(primary world.LocationRepository, replica world.LocationReader) world.LocationRepository {
+	return &replicaLocationRepo{LocationRepository: primary, replica: replica, router: r}
+}
+
+type replicaLocationRepo struct {
+	world.LocationRepository
+	replica world.LocationReader
+	router  *ReplicaRouter
+}
+
+func (l *replicaLocationRepo) Get(ctx context.Context, id ulid.ULID) (*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) (*world.Location, error) { return l.replica.Get(ctx, id) },
+		func(ctx context.Context) (*world.Location, error) { return l.LocationRepository.Get(ctx, id) })
+}
+
+func (l *replicaLocationRepo) GetMany(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.GetMany(ctx, ids) },
+		func(ctx context.Context) ([]*world.Location, error) { return l.LocationRepository.GetMany(ctx, ids) })
+}
+
+func (l *replicaLocationRepo) ListByType(ctx context.Context, locType world.LocationType) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.ListByType(ctx, locType) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return l.LocationRepository.ListByType(ctx, locType)
+		})
+}
+
+func (l *replicaLocationRepo) GetShadowedBy(ctx context.Context, id ulid.ULID) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.GetShadowedBy(ctx, id) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return l.LocationRepository.GetShadowedBy(ctx, id)
+		})
+}
+
+func (l *replicaLocationRepo) ListByZone(ctx context.Context, zoneID string) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.ListByZone(ctx, zoneID) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return l.LocationRepository.ListByZone(ctx, zoneID)
+		})
+}
+
+func (l *replicaLocationRepo) ListChildren(ctx context.Context, parentID ulid.ULID) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.ListChildren(ctx, parentID) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return l.LocationRepository.ListChildren(ctx, parentID)
+		})
+}
+
+func (l *replicaLocationRepo) ListByTag(ctx context.Context, tag string) ([]*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) ([]*world.Location, error) { return l.replica.ListByTag(ctx, tag) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return l.LocationRepository.ListByTag(ctx, tag)
+		})
+}
+
+func (l *replicaLocationRepo) FindByName(ctx context.Context, name string) (*world.Location, error) {
+	return routeRead(ctx, l.router,
+		func(ctx context.Context) (*world.Location, error) { return l.replica.FindByName(ctx, name) },
+		func(ctx context.Context) (*world.Location, error) { return l.LocationRepository.FindByName(ctx, name) })
+}
+
+// Exits wraps primary so its reads are routed by r.
+func (r *ReplicaRouter) Exits(primary world.ExitRepository) world.ExitRepository {
+	return r.exits(primary, NewExitRepository(r.pool))
+}
+
+func (r *ReplicaRouter) exits(primary world.ExitRepository, replica world.ExitReader) world.ExitRepository {
+	return &replicaExitRepo{ExitRepository: primary, replica: replica, router: r}
+}
+
+type replicaExitRepo struct {
+	world.ExitRepository
+	replica world.ExitReader
+	router  *ReplicaRouter
+}
+
+func (e *replicaExitRepo) Get(ctx context.Context, id ulid.ULID) (*world.Exit, error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) (*world.Exit, error) { return e.replica.Get(ctx, id) },
+		func(ctx context.Context) (*world.Exit, error) { return e.ExitRepository.Get(ctx, id) })
+}
+
+func (e *replicaExitRepo) ListFromLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Exit], error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) (world.Page[*world.Exit], error) {
+			return e.replica.ListFromLocation(ctx, locationID, opts)
+		},
+		func(ctx context.Context) (world.Page[*world.Exit], error) {
+			return e.ExitRepository.ListFromLocation(ctx, locationID, opts)
+		})
+}
+
+func (e *replicaExitRepo) FindByName(ctx context.Context, locationID ulid.ULID, name string) (*world.Exit, error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) (*world.Exit, error) { return e.replica.FindByName(ctx, locationID, name) },
+		func(ctx context.Context) (*world.Exit, error) {
+			return e.ExitRepository.FindByName(ctx, locationID, name)
+		})
+}
+
+func (e *replicaExitRepo) FindBySimilarity(ctx context.Context, locationID ulid.ULID, name string, threshold float64) (*world.Exit, error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) (*world.Exit, error) {
+			return e.replica.FindBySimilarity(ctx, locationID, name, threshold)
+		},
+		func(ctx context.Context) (*world.Exit, error) {
+			return e.ExitRepository.FindBySimilarity(ctx, locationID, name, threshold)
+		})
+}
+
+func (e *replicaExitRepo) ListVisibleExits(ctx context.Context, locationID, characterID ulid.ULID) ([]*world.Exit, error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) ([]*world.Exit, error) {
+			return e.replica.ListVisibleExits(ctx, locationID, characterID)
+		},
+		func(ctx context.Context) ([]*world.Exit, error) {
+			return e.ExitRepository.ListVisibleExits(ctx, locationID, characterID)
+		})
+}
+
+func (e *replicaExitRepo) ListDanglingExits(ctx context.Context) ([]*world.Exit, error) {
+	return routeRead(ctx, e.router,
+		func(ctx context.Context) ([]*world.Exit, error) { return e.replica.ListDanglingExits(ctx) },
+		func(ctx context.Context) ([]*world.Exit, error) { return e.ExitRepository.ListDanglingExits(ctx) })
+}
+
+// Objects wraps primary so its reads are routed by r.
+func (r *ReplicaRouter) Objects(primary world.ObjectRepository) world.ObjectRepository {
+	return r.objects(primary, NewObjectRepository(r.pool))
+}
+
+func (r *ReplicaRouter) objects(primary world.ObjectRepository, replica world.ObjectReader) world.ObjectRepository {
+	return &replicaObjectRepo{ObjectRepository: primary, replica: replica, router: r}
+}
+
+type replicaObjectRepo struct {
+	world.ObjectRepository
+	replica world.ObjectReader
+	router  *ReplicaRouter
+}
+
+func (o *replicaObjectRepo) Get(ctx context.Context, id ulid.ULID) (*world.Object, error) {
+	return routeRead(ctx, o.router,
+		func(ctx context.Context) (*world.Object, error) { return o.replica.Get(ctx, id) },
+		func(ctx context.Context) (*world.Object, error) { return o.ObjectRepository.Get(ctx, id) })
+}
+
+func (o *replicaObjectRepo) ListAtLocation(ctx context.Context, locationID ulid.ULID) ([]*world.Object, error) {
+	return routeRead(ctx, o.router,
+		func(ctx context.Context) ([]*world.Object, error) { return o.replica.ListAtLocation(ctx, locationID) },
+		func(ctx context.Context) ([]*world.Object, error) {
+			return o.ObjectRepository.ListAtLocation(ctx, locationID)
+		})
+}
+
+func (o *replicaObjectRepo) ListHeldBy(ctx context.Context, characterID ulid.ULID) ([]*world.Object, error) {
+	return routeRead(ctx, o.router,
+		func(ctx context.Context) ([]*world.Object, error) { return o.replica.ListHeldBy(ctx, characterID) },
+		func(ctx context.Context) ([]*world.Object, error) {
+			return o.ObjectRepository.ListHeldBy(ctx, characterID)
+		})
+}
+
+func (o *replicaObjectRepo) ListContainedIn(ctx context.Context, objectID ulid.ULID) ([]*world.Object, error) {
+	return routeRead(ctx, o.router,
+		func(ctx context.Context) ([]*world.Object, error) { return o.replica.ListContainedIn(ctx, objectID) },
+		func(ctx context.Context) ([]*world.Object, error) {
+			return o.ObjectRepository.ListContainedIn(ctx, objectID)
+		})
+}
+
+func (o *replicaObjectRepo) ListByTag(ctx context.Context, tag string) ([]*world.Object, error) {
+	return routeRead(ctx, o.router,
+		func(ctx context.Context) ([]*world.Object, error) { return o.replica.ListByTag(ctx, tag) },
+		func(ctx context.Context) ([]*world.Object, error) {
+			return o.ObjectRepository.ListByTag(ctx, tag)
+		})
+}
+
+// Characters wraps primary so its reads are routed by r.
+func (r *ReplicaRouter) Characters(primary world.CharacterRepository) world.CharacterRepository {
+	return r.characters(primary, NewCharacterRepository(r.pool))
+}
+
+func (r *ReplicaRouter) characters(primary world.CharacterRepository, replica world.CharacterReader) world.CharacterRepository {
+	return &replicaCharacterRepo{CharacterRepository: primary, replica: replica, router: r}
+}
+
+type replicaCharacterRepo struct {
+	world.CharacterRepository
+	replica world.CharacterReader
+	router  *ReplicaRouter
+}
+
+func (c *replicaCharacterRepo) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) (*world.Character, error) { return c.replica.Get(ctx, id) },
+		func(ctx context.Context) (*world.Character, error) { return c.CharacterRepository.Get(ctx, id) })
+}
+
+func (c *replicaCharacterRepo) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) (world.Page[*world.Character], error) {
+			return c.replica.GetByLocation(ctx, locationID, opts)
+		},
+		func(ctx context.Context) (world.Page[*world.Character], error) {
+			return c.CharacterRepository.GetByLocation(ctx, locationID, opts)
+		})
+}
+
+func (c *replicaCharacterRepo) FindByName(ctx context.Context, name string) (*world.Character, error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) (*world.Character, error) { return c.replica.FindByName(ctx, name) },
+		func(ctx context.Context) (*world.Character, error) { return c.CharacterRepository.FindByName(ctx, name) })
+}
+
+func (c *replicaCharacterRepo) IsOwnedByPlayer(ctx context.Context, characterID, playerID ulid.ULID) (bool, error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) (bool, error) { return c.replica.IsOwnedByPlayer(ctx, characterID, playerID) },
+		func(ctx context.Context) (bool, error) {
+			return c.CharacterRepository.IsOwnedByPlayer(ctx, characterID, playerID)
+		})
+}
+
+func (c *replicaCharacterRepo) GetNamesByIDs(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]string, error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) (map[ulid.ULID]string, error) { return c.replica.GetNamesByIDs(ctx, ids) },
+		func(ctx context.Context) (map[ulid.ULID]string, error) {
+			return c.CharacterRepository.GetNamesByIDs(ctx, ids)
+		})
+}
+
+func (c *replicaCharacterRepo) ListByTag(ctx context.Context, tag string) ([]*world.Character, error) {
+	return routeRead(ctx, c.router,
+		func(ctx context.Context) ([]*world.Character, error) { return c.replica.ListByTag(ctx, tag) },
+		func(ctx context.Context) ([]*world.Character, error) {
+			return c.CharacterRepository.ListByTag(ctx, tag)
+		})
+}
+
+// Scenes wraps primary so its reads are routed by r.
+func (r *ReplicaRouter) Scenes(primary world.SceneRepository) world.SceneRepository {
+	return r.scenes(primary, NewSceneRepository(r.pool))
+}
+
+func (r *ReplicaRouter) scenes(primary world.SceneRepository, replica world.SceneReader) world.SceneRepository {
+	return &replicaSceneRepo{SceneRepository: primary, replica: replica, router: r}
+}
+
+type replicaSceneRepo struct {
+	world.SceneRepository
+	replica world.SceneReader
+	router  *ReplicaRouter
+}
+
+func (s *replicaSceneRepo) ListParticipants(ctx context.Context, sceneID ulid.ULID) ([]world.SceneParticipant, error) {
+	return routeRead(ctx, s.router,
+		func(ctx context.Context) ([]world.SceneParticipant, error) {
+			return s.replica.ListParticipants(ctx, sceneID)
+		},
+		func(ctx context.Context) ([]world.SceneParticipant, error) {
+			return s.SceneRepository.ListParticipants(ctx, sceneID)
+		})
+}
+
+func (s *replicaSceneRepo) GetScenesFor(ctx context.Context, characterID ulid.ULID) ([]*world.Location, error) {
+	return routeRead(ctx, s.router,
+		func(ctx context.Context) ([]*world.Location, error) { return s.replica.GetScenesFor(ctx, characterID) },
+		func(ctx context.Context) ([]*world.Location, error) {
+			return s.SceneRepository.GetScenesFor(ctx, characterID)
+		})
+}
+
+func (s *replicaSceneRepo) ListScenes(ctx context.Context, filter world.SceneFilter, opts world.ListOptions) (world.Page[*world.Location], error) {
+	return routeRead(ctx, s.router,
+		func(ctx context.Context) (world.Page[*world.Location], error) {
+			return s.replica.ListScenes(ctx, filter, opts)
+		},
+		func(ctx context.Context) (world.Page[*world.Location], error) {
+			return s.SceneRepository.ListScenes(ctx, filter, opts)
+		})
+}
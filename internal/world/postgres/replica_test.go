@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+)
+
+// fakeLag is a lagProbe returning a settable lag and counting calls.
+type fakeLag struct {
+	lag   time.Duration
+	err   error
+	calls int
+}
+
+func (f *fakeLag) probe(context.Context) (time.Duration, bool, error) {
+	f.calls++
+	return f.lag, f.err == nil, f.err
+}
+
+// noopTx marks a context as inside a transaction; its methods are never called.
+type noopTx struct{ pgx.Tx }
+
+// newTestRouter returns a router with a controllable clock.
+func newTestRouter(probe *fakeLag) (*ReplicaRouter, *time.Time) {
+	r := newReplicaRouter(probe.probe, ReplicaConfig{MaxLag: time.Second, CheckInterval: time.Minute})
+	now := time.Unix(1_700_000_000, 0)
+	r.now = func() time.Time { return now }
+	return r, &now
+}
+
+func TestReplicaRouterServesReadsFromHealthyReplica(t *testing.T) {
+	ctx := context.Background()
+	probe := &fakeLag{lag: 100 * time.Millisecond}
+	router, _ := newTestRouter(probe)
+	primary := worldtest.NewMockLocationRepository(t)
+	replica := worldtest.NewMockLocationRepository(t)
+	repo := router.locations(primary, replica)
+
+	loc := &world.Location{ID: ulid.Make(), Name: "Plaza"}
+	replica.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Twice()
+
+	for range 2 {
+		got, err := repo.Get(ctx, loc.ID)
+		require.NoError(t, err)
+		assert.Equal(t, loc, got)
+	}
+	assert.Equal(t, 1, probe.calls, "the lag is measured once per check interval")
+	assert.Equal(t, ReplicaStats{ReplicaReads: 2}, router.Stats())
+}
+
+func TestReplicaRouterUsesPrimaryWhenReplicaLags(t *testing.T) {
+	ctx := context.Background()
+	probe := &fakeLag{lag: 3 * time.Second}
+	router, now := newTestRouter(probe)
+	primary := worldtest.NewMockObjectRepository(t)
+	replica := worldtest.NewMockObjectRepository(t)
+	repo := router.objects(primary, replica)
+
+	locID := ulid.Make()
+	primary.EXPECT().ListAtLocation(mock.Anything, locID).Return(nil, nil).Once()
+	_, err := repo.ListAtLocation(ctx, locID)
+	require.NoError(t, err)
+
+	// Once the replica catches up, the next measurement puts it back.
+	probe.lag = 0
+	*now = now.Add(time.Minute)
+	replica.EXPECT().ListAtLocation(mock.Anything, locID).Return(nil, nil).Once()
+	_, err = repo.ListAtLocation(ctx, locID)
+	require.NoError(t, err)
+
+	assert.Equal(t, ReplicaStats{ReplicaReads: 1, PrimaryReads: 1}, router.Stats())
+}
+
+func TestReplicaRouterUsesPrimaryWhenLagUnknown(t *testing.T) {
+	probe := &fakeLag{err: errors.New("replica unreachable")}
+	router, _ := newTestRouter(probe)
+	primary := worldtest.NewMockExitRepository(t)
+	repo := router.exits(primary, worldtest.NewMockExitRepository(t))
+
+	exitID := ulid.Make()
+	primary.EXPECT().Get(mock.Anything, exitID).Return(&world.Exit{ID: exitID}, nil).Once()
+	_, err := repo.Get(context.Background(), exitID)
+	require.NoError(t, err)
+}
+
+func TestReplicaRouterFallsBackOnReplicaFailure(t *testing.T) {
+	ctx := context.Background()
+	probe := &fakeLag{}
+	router, _ := newTestRouter(probe)
+	primary := worldtest.NewMockCharacterRepository(t)
+	replica := worldtest.NewMockCharacterRepository(t)
+	repo := router.characters(primary, replica)
+
+	char := &world.Character{ID: ulid.Make(), Name: "Wanderer"}
+	replica.EXPECT().Get(mock.Anything, char.ID).Return(nil, errors.New("connection reset")).Once()
+	primary.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Twice()
+
+	got, err := repo.Get(ctx, char.ID)
+	require.NoError(t, err)
+	assert.Equal(t, char, got)
+
+	// The failed replica stays out of rotation until the next measurement.
+	_, err = repo.Get(ctx, char.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ReplicaStats{PrimaryReads: 2, Fallbacks: 1}, router.Stats())
+}
+
+func TestReplicaRouterRetriesNotFoundOnPrimary(t *testing.T) {
+	ctx := context.Background()
+	router, _ := newTestRouter(&fakeLag{})
+	primary := worldtest.NewMockLocationRepository(t)
+	replica := worldtest.NewMockLocationRepository(t)
+	repo := router.locations(primary, replica)
+
+	loc := &world.Location{ID: ulid.Make(), Name: "Fresh Room"}
+	replica.EXPECT().Get(mock.Anything, loc.ID).Return(nil, world.ErrNotFound).Once()
+	primary.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+
+	got, err := repo.Get(ctx, loc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, loc, got, "an entity created within the lag bound is found on the primary")
+
+	// A miss does not take the replica out of rotation.
+	replica.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+	_, err = repo.Get(ctx, loc.ID)
+	require.NoError(t, err)
+}
+
+func TestReplicaRouterKeepsTransactionReadsOnPrimary(t *testing.T) {
+	probe := &fakeLag{}
+	router, _ := newTestRouter(probe)
+	primary := worldtest.NewMockSceneRepository(t)
+	repo := router.scenes(primary, worldtest.NewMockSceneRepository(t))
+
+	txCtx := context.WithValue(context.Background(), txKey{}, &noopTx{})
+	sceneID := ulid.Make()
+	primary.EXPECT().ListParticipants(mock.Anything, sceneID).Return(nil, nil).Once()
+
+	_, err := repo.ListParticipants(txCtx, sceneID)
+	require.NoError(t, err)
+	assert.Zero(t, probe.calls, "transaction reads never consult the replica")
+}
+
+func TestReplicaRouterPassesWritesToPrimary(t *testing.T) {
+	router, _ := newTestRouter(&fakeLag{})
+	primary := worldtest.NewMockLocationRepository(t)
+	repo := router.locations(primary, worldtest.NewMockLocationRepository(t))
+
+	loc := &world.Location{ID: ulid.Make(), Name: "New Room"}
+	primary.EXPECT().Create(mock.Anything, loc).Return(nil, nil).Once()
+	_, err := repo.Create(context.Background(), loc)
+	require.NoError(t, err)
+}
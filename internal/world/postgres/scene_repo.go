@@ -5,11 +5,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/oklog/ulid/v2"
 	"github.com/samber/oops"
 
+	"github.com/holomush/holomush/internal/pgnanos"
 	"github.com/holomush/holomush/internal/world"
 )
 
@@ -84,7 +87,7 @@ func (r *SceneRepository) ListParticipants(ctx context.Context, sceneID ulid.ULI
 // GetScenesFor returns all scenes a character is participating in.
 func (r *SceneRepository) GetScenesFor(ctx context.Context, characterID ulid.ULID) ([]*world.Location, error) {
 	rows, err := r.pool.Query(ctx, `
-		SELECT l.id, l.type, l.shadows_id, l.name, l.description, l.owner_id, l.replay_policy, l.created_at, l.archived_at, l.version
+		SELECT l.id, l.type, l.shadows_id, l.name, l.description, l.owner_id, l.replay_policy, l.zone_id, l.parent_id, l.max_occupancy, l.overflow_id, l.tags, l.created_at, l.archived_at, l.version
 		FROM locations l
 		INNER JOIN scene_participants sp ON l.id = sp.scene_id
 		WHERE sp.character_id = $1
@@ -101,5 +104,62 @@ func (r *SceneRepository) GetScenesFor(ctx context.Context, characterID ulid.ULI
 	return scanLocations(rows)
 }
 
+// ListScenes returns a page of the scenes matching filter, newest first,
+// ordered by (created_at, id) descending.
+func (r *SceneRepository) ListScenes(ctx context.Context, filter world.SceneFilter, opts world.ListOptions) (world.Page[*world.Location], error) {
+	afterKey, afterID, err := pageAfter(opts)
+	if err != nil {
+		return world.Page[*world.Location]{}, err
+	}
+	var afterCreated *pgnanos.Time
+	if afterKey != nil {
+		created, parseErr := time.Parse(time.RFC3339Nano, *afterKey)
+		if parseErr != nil {
+			return world.Page[*world.Location]{}, oops.Code(world.CodeInvalidCursor).
+				With("cursor", opts.Cursor).
+				Wrap(errors.Join(world.ErrInvalidCursor, parseErr))
+		}
+		nanos := pgnanos.From(created)
+		afterCreated = &nanos
+	}
+	size := opts.PageSize()
+	var participant, location *string
+	if filter.Participant != nil {
+		id := filter.Participant.String()
+		participant = &id
+	}
+	if filter.Location != nil {
+		id := filter.Location.String()
+		location = &id
+	}
+	rows, err := r.pool.Query(ctx, `
+		SELECT l.id, l.type, l.shadows_id, l.name, l.description, l.owner_id, l.replay_policy, l.zone_id, l.parent_id, l.max_occupancy, l.overflow_id, l.tags, l.created_at, l.archived_at, l.version
+		FROM locations l
+		WHERE l.type = 'scene'
+		  AND ($1 = '' OR ($1 = 'open' AND l.archived_at IS NULL) OR ($1 = 'archived' AND l.archived_at IS NOT NULL))
+		  AND ($2::text IS NULL OR EXISTS (
+			SELECT 1 FROM scene_participants sp WHERE sp.scene_id = l.id AND sp.character_id = $2))
+		  AND ($3::text IS NULL OR l.shadows_id = $3)
+		  AND ($4::bigint IS NULL OR (l.created_at, l.id) < ($4, $5))
+		ORDER BY l.created_at DESC, l.id DESC
+		LIMIT $6
+	`, string(filter.Status), participant, location, afterCreated, afterID, size+1)
+	if err != nil {
+		return world.Page[*world.Location]{}, oops.
+			With("operation", "list scenes").
+			With("status", string(filter.Status)).
+			Wrap(err)
+	}
+	defer rows.Close()
+
+	scenes, err := scanLocations(rows)
+	if err != nil {
+		return world.Page[*world.Location]{}, err
+	}
+	return world.NewPage(scenes, size, func(l *world.Location) string {
+		return world.EncodeCursor(l.CreatedAt.UTC().Format(time.RFC3339Nano), l.ID)
+	}), nil
+}
+
 // Compile-time interface check.
 var _ world.SceneRepository = (*SceneRepository)(nil)
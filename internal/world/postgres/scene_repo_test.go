@@ -203,3 +203,95 @@ func TestSceneRepository_GetScenesFor(t *testing.T) {
 		assert.Empty(t, scenes)
 	})
 }
+
+func TestSceneRepository_ListScenes(t *testing.T) {
+	ctx := context.Background()
+	sceneRepo := postgres.NewSceneRepository(testPool)
+	locationRepo := postgres.NewLocationRepository(testPool)
+
+	shadowed := &world.Location{
+		ID:           ulid.Make(),
+		Type:         world.LocationTypePersistent,
+		Name:         "Shadowed Square",
+		ReplayPolicy: "last:0",
+		CreatedAt:    time.Now().UTC(),
+	}
+	_, err := locationRepo.Create(ctx, shadowed)
+	require.NoError(t, err)
+
+	openScene := createTestSceneForSceneRepo(ctx, t, locationRepo, "Open Browse Scene")
+	archivedAt := time.Now().UTC()
+	archived := &world.Location{
+		ID:           ulid.Make(),
+		Type:         world.LocationTypeScene,
+		ShadowsID:    &shadowed.ID,
+		Name:         "Archived Browse Scene",
+		ReplayPolicy: "last:-1",
+		CreatedAt:    time.Now().UTC(),
+		ArchivedAt:   &archivedAt,
+	}
+	_, err = locationRepo.Create(ctx, archived)
+	require.NoError(t, err)
+
+	charStr := createTestCharacterForSceneRepo(ctx, t, "BrowseChar")
+	charID, err := ulid.Parse(charStr)
+	require.NoError(t, err)
+	seedParticipant(ctx, t, openScene.ID, charID, world.RoleMember)
+
+	t.Cleanup(func() {
+		_, _ = testPool.Exec(ctx, `DELETE FROM scene_participants WHERE character_id = $1`, charStr)
+		_, _ = locationRepo.Delete(ctx, openScene.ID, 0)
+		_, _ = locationRepo.Delete(ctx, archived.ID, 0)
+		_, _ = locationRepo.Delete(ctx, shadowed.ID, 0)
+	})
+
+	ids := func(page world.Page[*world.Location]) []ulid.ULID {
+		out := make([]ulid.ULID, len(page.Items))
+		for i, s := range page.Items {
+			out[i] = s.ID
+		}
+		return out
+	}
+
+	t.Run("filters by participant", func(t *testing.T) {
+		scenes, err := sceneRepo.ListScenes(ctx, world.SceneFilter{Participant: &charID}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []ulid.ULID{openScene.ID}, ids(scenes))
+	})
+
+	t.Run("filters by shadowed location", func(t *testing.T) {
+		scenes, err := sceneRepo.ListScenes(ctx, world.SceneFilter{Location: &shadowed.ID}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []ulid.ULID{archived.ID}, ids(scenes))
+	})
+
+	t.Run("filters by status", func(t *testing.T) {
+		open, err := sceneRepo.ListScenes(ctx, world.SceneFilter{Status: world.SceneStatusOpen}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, ids(open), openScene.ID)
+		assert.NotContains(t, ids(open), archived.ID)
+
+		gone, err := sceneRepo.ListScenes(ctx, world.SceneFilter{Status: world.SceneStatusArchived}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.Contains(t, ids(gone), archived.ID)
+		assert.NotContains(t, ids(gone), openScene.ID)
+	})
+
+	t.Run("excludes persistent locations and pages", func(t *testing.T) {
+		all, err := sceneRepo.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, ids(all), shadowed.ID)
+
+		first, err := sceneRepo.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first.Items, 1)
+		require.NotEmpty(t, first.NextCursor)
+		page, err := sceneRepo.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Limit: 1, Cursor: first.NextCursor})
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		assert.Equal(t, all.Items[1].ID, page.Items[0].ID)
+
+		_, err = sceneRepo.ListScenes(ctx, world.SceneFilter{}, world.ListOptions{Cursor: world.EncodeCursor("yesterday", openScene.ID)})
+		assert.ErrorIs(t, err, world.ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// setEntityTags replaces the tags column of one locations, objects, or
+// characters row with a version-predicated CAS (MODEL-03), the shared body of
+// the three repositories' SetTags. table is always one of those literal table
+// names, never caller input. When expectedVersion > 0 the UPDATE matches id +
+// version, so a stale writer affects zero rows and the locked follow-up read
+// classifies the result into WORLD_CONCURRENT_EDIT or notFound.
+func setEntityTags(
+	ctx context.Context,
+	pool txBeginner,
+	table string,
+	aggregate wmodel.AggregateType,
+	id ulid.ULID,
+	tags []string,
+	expectedVersion int,
+	notFound error,
+) (*wmodel.MutationDelta, error) {
+	query := `UPDATE ` + table + ` SET tags = $2, version = version + 1 WHERE id = $1`
+	args := []any{id.String(), tagsArg(tags)}
+	if expectedVersion > 0 {
+		query += ` AND version = $3`
+		args = append(args, expectedVersion)
+	}
+	query += ` RETURNING version`
+
+	var delta *wmodel.MutationDelta
+	txErr := withTx(ctx, pool, func(txCtx context.Context) error {
+		tx := txFromContext(txCtx)
+		var newVersion int
+		err := tx.QueryRow(txCtx, query, args...).Scan(&newVersion)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return classifyCASZeroRow(txCtx, tx,
+				`SELECT version FROM `+table+` WHERE id = $1 FOR UPDATE`, id, notFound)
+		}
+		if err != nil {
+			return oops.With("operation", "set tags").With("table", table).With("id", id.String()).Wrap(err)
+		}
+		delta = primaryDeltaVersioned(aggregate, id, false, newVersion-1, newVersion)
+		return nil
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+	return delta, nil
+}
+
+// tagsArg maps a nil tag set to an empty array for the NOT NULL tags column.
+func tagsArg(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// scannedTags maps the empty array an untagged row scans as back to nil, so
+// an untagged entity read from the database equals one built in memory.
+func scannedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
@@ -8,6 +8,10 @@ import (
 	"time"
 
 	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/idgen"
 )
 
 // EntityProperty is a first-class property attached to a world entity.
@@ -64,6 +68,68 @@ type PropertyRepository interface {
 	DeleteByParent(ctx context.Context, parentType string, parentID ulid.ULID) error
 }
 
+// authorizePropertyWrite finds the named property on the parent and checks
+// the subject may write it: "write" on property:<id> when it exists, or on
+// the parent entity when it does not. For a new property it returns a fresh
+// row owned by the subject's character, with create set.
+func (s *Service) authorizePropertyWrite(ctx context.Context, subjectID, parentType string, parentID ulid.ULID, name string) (*EntityProperty, bool, error) {
+	parentResource, parentPrefix, err := propertyParentResource(parentType, parentID)
+	if err != nil {
+		return nil, false, err
+	}
+	all, err := s.propertyRepo.ListByParent(ctx, parentType, parentID)
+	if err != nil {
+		return nil, false, oops.Code("PROPERTY_QUERY_FAILED").Wrapf(err, "list properties for %s %s", parentType, parentID)
+	}
+	for _, prop := range all {
+		if prop.Name != name {
+			continue
+		}
+		if err := s.checkAccess(ctx, subjectID, "write", access.PropertyResource(prop.ID.String()), prefixProperty); err != nil {
+			return nil, false, err
+		}
+		return prop, false, nil
+	}
+	if err := s.checkAccess(ctx, subjectID, "write", parentResource, parentPrefix); err != nil {
+		return nil, false, err
+	}
+	prop := &EntityProperty{
+		ID:         idgen.New(),
+		ParentType: parentType,
+		ParentID:   parentID,
+		Name:       name,
+		Visibility: "public",
+		CreatedAt:  time.Now(),
+	}
+	if kind, id, err := access.ParseEntityRef(subjectID); err == nil && kind == "character" {
+		prop.Owner = &id
+	}
+	return prop, true, nil
+}
+
+// propertyParentResource maps a property's parent to its ABAC resource.
+// Returns PROPERTY_INVALID for a parent type that cannot carry properties.
+func propertyParentResource(parentType string, parentID ulid.ULID) (string, entityPrefix, error) {
+	switch parentType {
+	case "location":
+		return access.LocationResource(parentID.String()), prefixLocation, nil
+	case "object":
+		return access.ObjectResource(parentID.String()), prefixObject, nil
+	case "character":
+		return access.CharacterResource(parentID.String()), prefixCharacter, nil
+	}
+	return "", "", oops.Code("PROPERTY_INVALID").With("parent_type", parentType).
+		Errorf("unknown property parent type %q", parentType)
+}
+
+// equalPropertyValue reports whether two nullable values are the same.
+func equalPropertyValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // Transactor executes a function within a database transaction.
 // Repository methods called within fn that support transaction propagation
 // will participate in the same transaction.
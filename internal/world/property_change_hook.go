@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Property keys reported in a PropertyChange. name and description match the
+// property registry; locked and owner are the object lock and ownership state
+// changed through LockObject/UnlockObject and TransferOwnership.
+const (
+	PropertyKeyName        = "name"
+	PropertyKeyDescription = "description"
+	PropertyKeyLocked      = "locked"
+	PropertyKeyOwner       = "owner"
+)
+
+// PropertyChange describes one property of a world entity changing value.
+// OldValue and NewValue are the rendered values ("true"/"false" for locked,
+// the owner ULID or "" for owner). LocationID is where the entity sits, when
+// the service knows it without an extra read: a location's own ID, the
+// location of an object lying in one, or a character's current location.
+type PropertyChange struct {
+	ParentType string
+	ParentID   ulid.ULID
+	Key        string
+	OldValue   string
+	NewValue   string
+	LocationID *ulid.ULID
+}
+
+// PropertyChangeHook is invoked by Service after a write that changed a
+// property has committed, once per changed key, so reactive consumers (a
+// door plugin watching "locked") learn of the change without polling.
+//
+// Like MovementHook it runs post-commit, and a hook error is operational
+// degradation, not a command failure: the service logs it and returns
+// success. Writes made under WithoutPropertyChangeEvents skip the hook.
+type PropertyChangeHook interface {
+	OnPropertyChanged(ctx context.Context, change PropertyChange) error
+}
+
+// NoopPropertyChangeHook is the default when no hook is wired.
+type NoopPropertyChangeHook struct{}
+
+// OnPropertyChanged is a no-op implementation of PropertyChangeHook.OnPropertyChanged.
+func (NoopPropertyChangeHook) OnPropertyChanged(context.Context, PropertyChange) error {
+	return nil
+}
+
+type suppressPropertyEventsKey struct{}
+
+// WithoutPropertyChangeEvents returns a context under which Service writes do
+// not invoke the PropertyChangeHook. Bulk imports use it so loading a world
+// does not flood plugins with one event per property.
+func WithoutPropertyChangeEvents(ctx context.Context) context.Context {
+	return context.WithValue(ctx, suppressPropertyEventsKey{}, true)
+}
+
+// PropertyChangeEventsSuppressed reports whether ctx was derived from
+// WithoutPropertyChangeEvents.
+func PropertyChangeEventsSuppressed(ctx context.Context) bool {
+	suppressed, _ := ctx.Value(suppressPropertyEventsKey{}).(bool)
+	return suppressed
+}
+
+// SetPropertyChangeHook registers a hook invoked after each committed
+// property change. Passing nil resets to the no-op default.
+func (s *Service) SetPropertyChangeHook(h PropertyChangeHook) {
+	if h == nil {
+		s.propertyHook = NoopPropertyChangeHook{}
+		return
+	}
+	s.propertyHook = h
+}
+
+// propertyEventsEnabled reports whether a write under ctx should notify the
+// property hook. Callers use it to skip the extra read of old values when no
+// one is listening.
+func (s *Service) propertyEventsEnabled(ctx context.Context) bool {
+	if _, noop := s.propertyHook.(NoopPropertyChangeHook); noop || s.propertyHook == nil {
+		return false
+	}
+	return !PropertyChangeEventsSuppressed(ctx)
+}
+
+// notifyPropertyChanges fires the property hook for each change whose value
+// actually differs. Hook failures are logged, never returned.
+func (s *Service) notifyPropertyChanges(ctx context.Context, changes ...PropertyChange) {
+	for _, change := range changes {
+		if change.OldValue == change.NewValue {
+			continue
+		}
+		if err := s.propertyHook.OnPropertyChanged(ctx, change); err != nil {
+			slog.WarnContext(ctx, "property change hook failed after committed write",
+				"parent_type", change.ParentType,
+				"parent_id", change.ParentID.String(),
+				"key", change.Key,
+				"error", err)
+		}
+	}
+}
+
+// locationPropertyChanges diffs the hook-visible properties of a location.
+func locationPropertyChanges(before, after *Location) []PropertyChange {
+	id := after.ID
+	return []PropertyChange{
+		{ParentType: "location", ParentID: id, Key: PropertyKeyName, OldValue: before.Name, NewValue: after.Name, LocationID: &id},
+		{ParentType: "location", ParentID: id, Key: PropertyKeyDescription, OldValue: before.Description, NewValue: after.Description, LocationID: &id},
+	}
+}
+
+// objectPropertyChanges diffs the hook-visible properties of an object.
+func objectPropertyChanges(before, after *Object) []PropertyChange {
+	change := func(key, oldValue, newValue string) PropertyChange {
+		return PropertyChange{
+			ParentType: "object", ParentID: after.ID, Key: key,
+			OldValue: oldValue, NewValue: newValue, LocationID: after.LocationID(),
+		}
+	}
+	return []PropertyChange{
+		change(PropertyKeyName, before.Name, after.Name),
+		change(PropertyKeyDescription, before.Description, after.Description),
+		change(PropertyKeyLocked, formatLocked(before.Locked), formatLocked(after.Locked)),
+		change(PropertyKeyOwner, formatOwner(before.OwnerID), formatOwner(after.OwnerID)),
+	}
+}
+
+func formatLocked(locked bool) string {
+	if locked {
+		return "true"
+	}
+	return "false"
+}
+
+func formatOwner(id *ulid.ULID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
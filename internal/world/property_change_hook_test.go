@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+)
+
+// recordingPropertyHook captures every change it is handed and returns err.
+type recordingPropertyHook struct {
+	changes []world.PropertyChange
+	err     error
+}
+
+func (h *recordingPropertyHook) OnPropertyChanged(_ context.Context, change world.PropertyChange) error {
+	h.changes = append(h.changes, change)
+	return h.err
+}
+
+func TestLockObject_FiresLockedPropertyChange(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, false)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "lock", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{}
+	svc.SetPropertyChangeHook(hook)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.LockObject(ctx, subjectID, obj.ID))
+	require.Len(t, hook.changes, 1, "only the locked key changed")
+	change := hook.changes[0]
+	assert.Equal(t, "object", change.ParentType)
+	assert.Equal(t, obj.ID, change.ParentID)
+	assert.Equal(t, world.PropertyKeyLocked, change.Key)
+	assert.Equal(t, "false", change.OldValue)
+	assert.Equal(t, "true", change.NewValue)
+	assert.Equal(t, obj.LocationID(), change.LocationID)
+}
+
+func TestTransferOwnership_FiresOwnerPropertyChange(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	newOwnerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, true)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "transfer", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{}
+	svc.SetPropertyChangeHook(hook)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.TransferOwnership(ctx, subjectID, obj.ID, newOwnerID))
+	require.Len(t, hook.changes, 1)
+	assert.Equal(t, world.PropertyKeyOwner, hook.changes[0].Key)
+	assert.Equal(t, ownerID.String(), hook.changes[0].OldValue)
+	assert.Equal(t, newOwnerID.String(), hook.changes[0].NewValue)
+}
+
+func TestUpdateLocation_FiresOnlyChangedProperties(t *testing.T) {
+	ctx := context.Background()
+	locID := ulid.Make()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", access.LocationResource(locID.String()))
+	locRepo := worldtest.NewMockLocationRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{}
+	svc.SetPropertyChangeHook(hook)
+
+	current := &world.Location{ID: locID, Name: "Hall", Description: "A long hall.", Type: world.LocationTypePersistent}
+	updated := &world.Location{ID: locID, Name: "Hall", Description: "A ruined hall.", Type: world.LocationTypePersistent}
+	locRepo.EXPECT().Get(mock.Anything, locID).Return(current, nil).Once()
+	locRepo.EXPECT().Update(mock.Anything, updated).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.UpdateLocation(ctx, subjectID, updated))
+	require.Len(t, hook.changes, 1)
+	change := hook.changes[0]
+	assert.Equal(t, "location", change.ParentType)
+	assert.Equal(t, world.PropertyKeyDescription, change.Key)
+	assert.Equal(t, "A long hall.", change.OldValue)
+	assert.Equal(t, "A ruined hall.", change.NewValue)
+	require.NotNil(t, change.LocationID)
+	assert.Equal(t, locID, *change.LocationID)
+}
+
+func TestPropertyChangeHook_SuppressedForBulkImports(t *testing.T) {
+	ctx := world.WithoutPropertyChangeEvents(context.Background())
+	assert.True(t, world.PropertyChangeEventsSuppressed(ctx))
+	assert.False(t, world.PropertyChangeEventsSuppressed(context.Background()))
+
+	locID := ulid.Make()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", access.LocationResource(locID.String()))
+	// No Get expectation: a suppressed write skips the old-value read too.
+	locRepo := worldtest.NewMockLocationRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{}
+	svc.SetPropertyChangeHook(hook)
+
+	loc := &world.Location{ID: locID, Name: "Imported", Type: world.LocationTypePersistent}
+	locRepo.EXPECT().Update(mock.Anything, loc).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.UpdateLocation(ctx, subjectID, loc))
+	assert.Empty(t, hook.changes)
+}
+
+// TestPropertyChangeHook_FailureIsOperationalDegradation mirrors the movement
+// hook guard: the write has committed, so a failing hook is logged and the
+// command still succeeds.
+func TestPropertyChangeHook_FailureIsOperationalDegradation(t *testing.T) {
+	ctx := context.Background()
+	ownerID := ulid.Make()
+	subjectID := access.CharacterSubject(ownerID.String())
+	obj := ownedTestObject(t, ownerID, true)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "unlock", access.ObjectResource(obj.ID.String()))
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{err: errors.New("bus down")}
+	svc.SetPropertyChangeHook(hook)
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+	objRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.UnlockObject(ctx, subjectID, obj.ID))
+	assert.Len(t, hook.changes, 1)
+}
+
+func TestUpdateCharacterDescription_FiresDescriptionChange(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	locID := ulid.Make()
+	subjectID := access.CharacterSubject(charID.String())
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", access.CharacterResource(charID.String()))
+	charRepo := worldtest.NewMockCharacterRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{CharacterRepo: charRepo, Engine: engine}, &mockOutboxWriter{}))
+	hook := &recordingPropertyHook{}
+	svc.SetPropertyChangeHook(hook)
+
+	charRepo.EXPECT().Get(mock.Anything, charID).
+		Return(&world.Character{ID: charID, Name: "Ada", Description: "Tall.", LocationID: &locID}, nil).Once()
+	charRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.UpdateCharacterDescription(ctx, subjectID, charID, "Short."))
+	require.Len(t, hook.changes, 1)
+	assert.Equal(t, "character", hook.changes[0].ParentType)
+	assert.Equal(t, "Tall.", hook.changes[0].OldValue)
+	assert.Equal(t, "Short.", hook.changes[0].NewValue)
+	assert.Equal(t, &locID, hook.changes[0].LocationID)
+}
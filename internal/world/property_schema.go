@@ -0,0 +1,488 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	jschema "github.com/santhosh-tekuri/jsonschema/v6"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// PropertyValueType is the declared type of a property's value. Values are
+// stored as text; the type decides which text is accepted and how it is
+// normalized on write.
+type PropertyValueType string
+
+// Declarable property value types.
+const (
+	// PropertyTypeString accepts any valid UTF-8 text.
+	PropertyTypeString PropertyValueType = "string"
+	// PropertyTypeInt accepts a base-10 64-bit integer.
+	PropertyTypeInt PropertyValueType = "int"
+	// PropertyTypeBool accepts the forms strconv.ParseBool does and stores
+	// "true" or "false".
+	PropertyTypeBool PropertyValueType = "bool"
+	// PropertyTypeRef accepts a ULID referring to another entity.
+	PropertyTypeRef PropertyValueType = "ref"
+	// PropertyTypeJSON accepts a JSON document, optionally constrained by a
+	// JSON Schema.
+	PropertyTypeJSON PropertyValueType = "json"
+)
+
+// IsValid reports whether t is a declarable property value type.
+func (t PropertyValueType) IsValid() bool {
+	switch t {
+	case PropertyTypeString, PropertyTypeInt, PropertyTypeBool, PropertyTypeRef, PropertyTypeJSON:
+		return true
+	}
+	return false
+}
+
+// PropertySchemaSourceStaff is the Source of a schema declared by staff
+// through DeclarePropertySchema. Plugin-declared schemas carry the plugin's
+// subject ("plugin:<name>").
+const PropertySchemaSourceStaff = "staff"
+
+// PropertySchema declares the value type of one property name. Once a name
+// is declared, SetProperty rejects values that do not match, and the
+// PropertyValues accessors read it back typed. Names without a schema stay
+// free-form strings.
+type PropertySchema struct {
+	Name string
+	Type PropertyValueType
+	// JSONSchema optionally constrains a json-typed value. It must be empty
+	// for every other type.
+	JSONSchema json.RawMessage
+	// Description is shown to builders listing declared properties.
+	Description string
+	// Source is who declared the schema: PropertySchemaSourceStaff or a
+	// plugin subject. A name declared by one source cannot be redeclared by
+	// another.
+	Source string
+
+	compiled *jschema.Schema
+}
+
+// PropertySchemaStore persists staff-declared property schemas.
+// Plugin-declared schemas are not stored; plugins declare them again on
+// every load.
+type PropertySchemaStore interface {
+	// List returns every stored schema, ordered by name.
+	List(ctx context.Context) ([]PropertySchema, error)
+
+	// Save inserts or replaces the schema for schema.Name. declaredBy is
+	// the subject that declared it.
+	Save(ctx context.Context, schema PropertySchema, declaredBy string) error
+
+	// Delete removes the schema for name. Deleting an absent name is not
+	// an error.
+	Delete(ctx context.Context, name string) error
+}
+
+// compile checks the declaration and compiles its JSON Schema, if any.
+// Returns PROPERTY_SCHEMA_INVALID.
+func (p *PropertySchema) compile() error {
+	if err := ValidateName(p.Name); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).Wrap(err)
+	}
+	if !p.Type.IsValid() {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).
+			Errorf("unknown property type %q", p.Type)
+	}
+	if p.Source == "" {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).Errorf("schema source is required")
+	}
+	p.compiled = nil
+	if len(p.JSONSchema) == 0 {
+		return nil
+	}
+	if p.Type != PropertyTypeJSON {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).
+			Errorf("a JSON Schema only applies to json properties, not %s", p.Type)
+	}
+	doc, err := jschema.UnmarshalJSON(bytes.NewReader(p.JSONSchema))
+	if err != nil {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).Wrapf(err, "parse JSON Schema")
+	}
+	c := jschema.NewCompiler()
+	if err := c.AddResource("property.json", doc); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).Wrapf(err, "load JSON Schema")
+	}
+	compiled, err := c.Compile("property.json")
+	if err != nil {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", p.Name).Wrapf(err, "compile JSON Schema")
+	}
+	p.compiled = compiled
+	return nil
+}
+
+// Normalize checks value against the declared type and returns the form it
+// is stored in: integers in plain base-10 form, "true" or "false" for
+// booleans, and the canonical upper-case ULID for refs. Returns
+// PROPERTY_VALUE_INVALID.
+func (p PropertySchema) Normalize(value string) (string, error) {
+	invalid := func(err error) error {
+		return oops.Code("PROPERTY_VALUE_INVALID").
+			With("name", p.Name).
+			With("type", string(p.Type)).
+			Wrap(err)
+	}
+	switch p.Type {
+	case PropertyTypeString:
+		if !utf8.ValidString(value) {
+			return "", invalid(errors.New("value must be valid UTF-8"))
+		}
+		return value, nil
+	case PropertyTypeInt:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return "", invalid(errors.New("value must be an integer"))
+		}
+		return strconv.FormatInt(n, 10), nil
+	case PropertyTypeBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", invalid(errors.New("value must be true or false"))
+		}
+		return strconv.FormatBool(b), nil
+	case PropertyTypeRef:
+		id, err := ulid.ParseStrict(strings.TrimSpace(value))
+		if err != nil {
+			return "", invalid(errors.New("value must be a ULID"))
+		}
+		return id.String(), nil
+	case PropertyTypeJSON:
+		doc, err := jschema.UnmarshalJSON(strings.NewReader(value))
+		if err != nil {
+			return "", invalid(errors.New("value must be a JSON document"))
+		}
+		if p.compiled != nil {
+			if err := p.compiled.Validate(doc); err != nil {
+				return "", invalid(err)
+			}
+		}
+		return value, nil
+	default:
+		return "", invalid(errors.New("schema has no known type"))
+	}
+}
+
+// PropertySchemaRegistry holds the declared property schemas by name. It is
+// safe for concurrent use by multiple goroutines.
+type PropertySchemaRegistry struct {
+	mu      sync.RWMutex
+	schemas map[string]PropertySchema
+}
+
+// NewPropertySchemaRegistry creates an empty registry.
+func NewPropertySchemaRegistry() *PropertySchemaRegistry {
+	return &PropertySchemaRegistry{schemas: make(map[string]PropertySchema)}
+}
+
+// Declare adds or replaces the schema for schema.Name. A source may
+// redeclare its own names; a name already declared by another source is
+// rejected with PROPERTY_SCHEMA_CONFLICT. Returns PROPERTY_SCHEMA_INVALID
+// for a malformed declaration.
+func (r *PropertySchemaRegistry) Declare(schema PropertySchema) error {
+	if err := schema.compile(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.schemas[schema.Name]; ok && existing.Source != schema.Source {
+		return oops.Code("PROPERTY_SCHEMA_CONFLICT").
+			With("name", schema.Name).
+			With("declared_by", existing.Source).
+			Errorf("property %q is already declared by %s", schema.Name, existing.Source)
+	}
+	r.schemas[schema.Name] = schema
+	return nil
+}
+
+// DeclareAll declares every schema for one source, or none of them: on the
+// first error the schemas it already declared are removed again.
+func (r *PropertySchemaRegistry) DeclareAll(source string, schemas []PropertySchema) error {
+	declared := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		schema.Source = source
+		if err := r.Declare(schema); err != nil {
+			for _, name := range declared {
+				r.Remove(name, source)
+			}
+			return err
+		}
+		declared = append(declared, schema.Name)
+	}
+	return nil
+}
+
+// Remove deletes the schema for name if source declared it, and reports
+// whether it did.
+func (r *PropertySchemaRegistry) Remove(name, source string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.schemas[name]; ok && existing.Source == source {
+		delete(r.schemas, name)
+		return true
+	}
+	return false
+}
+
+// RemoveSource deletes every schema source declared.
+func (r *PropertySchemaRegistry) RemoveSource(source string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for name, schema := range r.schemas {
+		if schema.Source == source {
+			delete(r.schemas, name)
+		}
+	}
+}
+
+// Lookup returns the schema declared for name.
+func (r *PropertySchemaRegistry) Lookup(name string) (PropertySchema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	schema, ok := r.schemas[name]
+	return schema, ok
+}
+
+// List returns every declared schema, ordered by name.
+func (r *PropertySchemaRegistry) List() []PropertySchema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]PropertySchema, 0, len(r.schemas))
+	for _, schema := range r.schemas {
+		out = append(out, schema)
+	}
+	slices.SortFunc(out, func(a, b PropertySchema) int { return strings.Compare(a.Name, b.Name) })
+	return out
+}
+
+// PropertySchemas returns the registry SetProperty validates against.
+// Plugins declare into it when they load.
+func (s *Service) PropertySchemas() *PropertySchemaRegistry {
+	return s.propertySchemas
+}
+
+// LoadPropertySchemas declares every schema in the configured
+// PropertySchemaStore into the registry; call it once at startup. A stored schema that no longer compiles or collides with a
+// plugin declaration is skipped with an error joined into the result, so
+// one bad row does not hide the rest.
+func (s *Service) LoadPropertySchemas(ctx context.Context) error {
+	if s.propertySchemaStore == nil {
+		return nil
+	}
+	stored, err := s.propertySchemaStore.List(ctx)
+	if err != nil {
+		return oops.Code("PROPERTY_SCHEMA_QUERY_FAILED").Wrapf(err, "list property schemas")
+	}
+	var errs []error
+	for _, schema := range stored {
+		schema.Source = PropertySchemaSourceStaff
+		if err := s.propertySchemas.Declare(schema); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeclarePropertySchema declares, or redeclares, the value type of a
+// property name on behalf of staff and persists it. It requires "write" on
+// property_schema:<name>. Values already stored under the name are not
+// rewritten; the declaration applies to later writes.
+//
+// Returns PROPERTY_SCHEMA_INVALID for a malformed declaration and
+// PROPERTY_SCHEMA_CONFLICT when a plugin owns the name.
+func (s *Service) DeclarePropertySchema(ctx context.Context, subjectID string, schema PropertySchema) error {
+	if s.propertySchemaStore == nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").Errorf("property schema store not configured")
+	}
+	schema.Source = PropertySchemaSourceStaff
+	if err := schema.compile(); err != nil {
+		return err
+	}
+	if err := s.checkAccess(ctx, subjectID, "write", access.PropertySchemaResource(schema.Name), prefixPropertySchema); err != nil {
+		return err
+	}
+	if existing, ok := s.propertySchemas.Lookup(schema.Name); ok && existing.Source != PropertySchemaSourceStaff {
+		return oops.Code("PROPERTY_SCHEMA_CONFLICT").
+			With("name", schema.Name).
+			With("declared_by", existing.Source).
+			Errorf("property %q is already declared by %s", schema.Name, existing.Source)
+	}
+	if err := s.propertySchemaStore.Save(ctx, schema, subjectID); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").With("name", schema.Name).Wrap(err)
+	}
+	return s.propertySchemas.Declare(schema)
+}
+
+// RemovePropertySchema drops a staff declaration, returning the name to a
+// free-form string. It requires "write" on property_schema:<name>.
+// Returns PROPERTY_SCHEMA_NOT_FOUND when staff have not declared the name.
+func (s *Service) RemovePropertySchema(ctx context.Context, subjectID, name string) error {
+	if s.propertySchemaStore == nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").Errorf("property schema store not configured")
+	}
+	if err := ValidateName(name); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_INVALID").With("name", name).Wrap(err)
+	}
+	if err := s.checkAccess(ctx, subjectID, "write", access.PropertySchemaResource(name), prefixPropertySchema); err != nil {
+		return err
+	}
+	existing, ok := s.propertySchemas.Lookup(name)
+	if !ok || existing.Source != PropertySchemaSourceStaff {
+		return oops.Code("PROPERTY_SCHEMA_NOT_FOUND").With("name", name).Errorf("no staff schema declared for %q", name)
+	}
+	if err := s.propertySchemaStore.Delete(ctx, name); err != nil {
+		return oops.Code("PROPERTY_SCHEMA_WRITE_FAILED").With("name", name).Wrap(err)
+	}
+	s.propertySchemas.Remove(name, PropertySchemaSourceStaff)
+	return nil
+}
+
+// normalizePropertyValue validates value against the schema declared for
+// name. Undeclared names and nil (flag-style) values pass unchanged.
+func (s *Service) normalizePropertyValue(name string, value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	schema, ok := s.propertySchemas.Lookup(name)
+	if !ok {
+		return value, nil
+	}
+	normalized, err := schema.Normalize(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &normalized, nil
+}
+
+// PropertyValues is a typed view of the properties one subject can read on
+// one entity, loaded once by Service.PropertyValues.
+//
+// Each accessor returns PROPERTY_NOT_FOUND when the property is absent,
+// unreadable, or has no value; PROPERTY_TYPE_MISMATCH when the name is
+// declared with a different type; and PROPERTY_VALUE_INVALID when a stored
+// value does not parse (an undeclared name, or a row written before its
+// declaration).
+type PropertyValues struct {
+	props   map[string]*EntityProperty
+	schemas *PropertySchemaRegistry
+}
+
+// PropertyValues loads the properties subjectID may read on the given
+// parent, with the same per-property filtering as ListPropertiesByParent.
+func (s *Service) PropertyValues(ctx context.Context, subjectID, parentType string, parentID ulid.ULID) (*PropertyValues, error) {
+	visible, err := s.ListPropertiesByParent(ctx, subjectID, parentType, parentID)
+	if err != nil {
+		return nil, err
+	}
+	props := make(map[string]*EntityProperty, len(visible))
+	for _, prop := range visible {
+		props[prop.Name] = prop
+	}
+	return &PropertyValues{props: props, schemas: s.propertySchemas}, nil
+}
+
+// raw returns the stored value of name after checking it may be read as
+// want.
+func (v *PropertyValues) raw(name string, want PropertyValueType) (string, error) {
+	if schema, ok := v.schemas.Lookup(name); ok && schema.Type != want {
+		return "", oops.Code("PROPERTY_TYPE_MISMATCH").
+			With("name", name).
+			With("declared", string(schema.Type)).
+			With("requested", string(want)).
+			Errorf("property %q is declared %s, not %s", name, schema.Type, want)
+	}
+	prop, ok := v.props[name]
+	if !ok || prop.Value == nil {
+		return "", oops.Code("PROPERTY_NOT_FOUND").With("name", name).Wrap(ErrNotFound)
+	}
+	return *prop.Value, nil
+}
+
+// parse reads name as want and returns its normalized text.
+func (v *PropertyValues) parse(name string, want PropertyValueType) (string, error) {
+	value, err := v.raw(name, want)
+	if err != nil {
+		return "", err
+	}
+	schema, ok := v.schemas.Lookup(name)
+	if !ok {
+		schema = PropertySchema{Name: name, Type: want}
+	}
+	return schema.Normalize(value)
+}
+
+// Has reports whether name is present and readable, with or without a
+// value.
+func (v *PropertyValues) Has(name string) bool {
+	_, ok := v.props[name]
+	return ok
+}
+
+// GetString returns the value of a string property.
+func (v *PropertyValues) GetString(name string) (string, error) {
+	return v.parse(name, PropertyTypeString)
+}
+
+// GetInt returns the value of an int property.
+func (v *PropertyValues) GetInt(name string) (int64, error) {
+	text, err := v.parse(name, PropertyTypeInt)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return 0, oops.Code("PROPERTY_VALUE_INVALID").With("name", name).Wrap(err)
+	}
+	return n, nil
+}
+
+// GetBool returns the value of a bool property.
+func (v *PropertyValues) GetBool(name string) (bool, error) {
+	text, err := v.parse(name, PropertyTypeBool)
+	if err != nil {
+		return false, err
+	}
+	return text == "true", nil
+}
+
+// GetRef returns the entity ID a ref property points at.
+func (v *PropertyValues) GetRef(name string) (ulid.ULID, error) {
+	text, err := v.parse(name, PropertyTypeRef)
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+	id, err := ulid.ParseStrict(text)
+	if err != nil {
+		return ulid.ULID{}, oops.Code("PROPERTY_VALUE_INVALID").With("name", name).Wrap(err)
+	}
+	return id, nil
+}
+
+// GetJSON decodes a json property into dst.
+func (v *PropertyValues) GetJSON(name string, dst any) error {
+	text, err := v.parse(name, PropertyTypeJSON)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(text), dst); err != nil {
+		return oops.Code("PROPERTY_VALUE_INVALID").With("name", name).Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// memSchemaStore is an in-memory world.PropertySchemaStore.
+type memSchemaStore struct {
+	saved map[string]world.PropertySchema
+}
+
+func newMemSchemaStore(schemas ...world.PropertySchema) *memSchemaStore {
+	s := &memSchemaStore{saved: make(map[string]world.PropertySchema)}
+	for _, schema := range schemas {
+		s.saved[schema.Name] = schema
+	}
+	return s
+}
+
+func (s *memSchemaStore) List(context.Context) ([]world.PropertySchema, error) {
+	out := make([]world.PropertySchema, 0, len(s.saved))
+	for _, schema := range s.saved {
+		out = append(out, schema)
+	}
+	return out, nil
+}
+
+func (s *memSchemaStore) Save(_ context.Context, schema world.PropertySchema, _ string) error {
+	s.saved[schema.Name] = schema
+	return nil
+}
+
+func (s *memSchemaStore) Delete(_ context.Context, name string) error {
+	delete(s.saved, name)
+	return nil
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestPropertySchemaNormalize(t *testing.T) {
+	tests := []struct {
+		typ   world.PropertyValueType
+		in    string
+		want  string
+		valid bool
+	}{
+		{world.PropertyTypeString, "anything at all", "anything at all", true},
+		{world.PropertyTypeString, "\xff", "", false},
+		{world.PropertyTypeInt, " 042 ", "42", true},
+		{world.PropertyTypeInt, "-7", "-7", true},
+		{world.PropertyTypeInt, "4.5", "", false},
+		{world.PropertyTypeInt, "ten", "", false},
+		{world.PropertyTypeBool, "T", "true", true},
+		{world.PropertyTypeBool, "0", "false", true},
+		{world.PropertyTypeBool, "yes", "", false},
+		{world.PropertyTypeRef, "01arz3ndektsv4rrffq69g5fav", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{world.PropertyTypeRef, "room-5", "", false},
+		{world.PropertyTypeJSON, `{"hp": 10}`, `{"hp": 10}`, true},
+		{world.PropertyTypeJSON, `{"hp":`, "", false},
+	}
+	for _, tt := range tests {
+		schema := world.PropertySchema{Name: "prop", Type: tt.typ}
+		got, err := schema.Normalize(tt.in)
+		if !tt.valid {
+			errutil.AssertErrorCode(t, err, "PROPERTY_VALUE_INVALID")
+			continue
+		}
+		require.NoError(t, err, "%s %q", tt.typ, tt.in)
+		assert.Equal(t, tt.want, got, "%s %q", tt.typ, tt.in)
+	}
+}
+
+func TestPropertySchemaRegistry(t *testing.T) {
+	plugin := access.PluginSubject("stats")
+
+	t.Run("enforces a declared JSON Schema", func(t *testing.T) {
+		reg := world.NewPropertySchemaRegistry()
+		require.NoError(t, reg.Declare(world.PropertySchema{
+			Name: "stats", Type: world.PropertyTypeJSON, Source: plugin,
+			JSONSchema: json.RawMessage(`{"type":"object","required":["hp"],"properties":{"hp":{"type":"integer"}}}`),
+		}))
+		schema, ok := reg.Lookup("stats")
+		require.True(t, ok)
+		_, err := schema.Normalize(`{"hp": 3}`)
+		require.NoError(t, err)
+		_, err = schema.Normalize(`{"hp": "lots"}`)
+		errutil.AssertErrorCode(t, err, "PROPERTY_VALUE_INVALID")
+	})
+
+	t.Run("rejects malformed declarations", func(t *testing.T) {
+		reg := world.NewPropertySchemaRegistry()
+		for _, bad := range []world.PropertySchema{
+			{Name: "", Type: world.PropertyTypeInt, Source: plugin},
+			{Name: "x", Type: "float", Source: plugin},
+			{Name: "x", Type: world.PropertyTypeInt},
+			{Name: "x", Type: world.PropertyTypeInt, Source: plugin, JSONSchema: json.RawMessage(`{}`)},
+			{Name: "x", Type: world.PropertyTypeJSON, Source: plugin, JSONSchema: json.RawMessage(`{"type": 7}`)},
+		} {
+			errutil.AssertErrorCode(t, reg.Declare(bad), "PROPERTY_SCHEMA_INVALID")
+		}
+		assert.Empty(t, reg.List())
+	})
+
+	t.Run("a name belongs to the source that declared it", func(t *testing.T) {
+		reg := world.NewPropertySchemaRegistry()
+		require.NoError(t, reg.Declare(world.PropertySchema{Name: "hp", Type: world.PropertyTypeInt, Source: plugin}))
+		require.NoError(t, reg.Declare(world.PropertySchema{Name: "hp", Type: world.PropertyTypeString, Source: plugin}),
+			"a source may redeclare its own name")
+
+		err := reg.Declare(world.PropertySchema{Name: "hp", Type: world.PropertyTypeInt, Source: world.PropertySchemaSourceStaff})
+		errutil.AssertErrorCode(t, err, "PROPERTY_SCHEMA_CONFLICT")
+		assert.False(t, reg.Remove("hp", world.PropertySchemaSourceStaff))
+		assert.True(t, reg.Remove("hp", plugin))
+	})
+
+	t.Run("DeclareAll declares all or nothing", func(t *testing.T) {
+		reg := world.NewPropertySchemaRegistry()
+		require.NoError(t, reg.Declare(world.PropertySchema{Name: "taken", Type: world.PropertyTypeInt, Source: world.PropertySchemaSourceStaff}))
+
+		err := reg.DeclareAll(plugin, []world.PropertySchema{
+			{Name: "hp", Type: world.PropertyTypeInt},
+			{Name: "taken", Type: world.PropertyTypeInt},
+		})
+		errutil.AssertErrorCode(t, err, "PROPERTY_SCHEMA_CONFLICT")
+		_, ok := reg.Lookup("hp")
+		assert.False(t, ok, "the declarations before the failure are rolled back")
+
+		require.NoError(t, reg.DeclareAll(plugin, []world.PropertySchema{{Name: "hp", Type: world.PropertyTypeInt}, {Name: "mp", Type: world.PropertyTypeInt}}))
+		reg.RemoveSource(plugin)
+		assert.Len(t, reg.List(), 1)
+	})
+}
+
+func TestWorldService_SetProperty(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make().String()
+	subjectID := access.CharacterSubject(charID)
+	locID := ulid.Make()
+
+	newService := func(t *testing.T, engine *policytest.GrantEngine, outbox *mockOutboxWriter) (*world.Service, *worldtest.MockPropertyRepository) {
+		t.Helper()
+		propRepo := worldtest.NewMockPropertyRepository(t)
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{PropertyRepo: propRepo, Engine: engine}, outbox))
+		require.NoError(t, svc.PropertySchemas().Declare(world.PropertySchema{
+			Name: "strength", Type: world.PropertyTypeInt, Source: world.PropertySchemaSourceStaff,
+		}))
+		return svc, propRepo
+	}
+
+	t.Run("creates a property normalized to its declared type", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "write", access.LocationResource(locID.String()))
+		outbox := &mockOutboxWriter{}
+		svc, propRepo := newService(t, engine, outbox)
+
+		propRepo.EXPECT().ListByParent(mock.Anything, "location", locID).Return(nil, nil).Once()
+		var created *world.EntityProperty
+		propRepo.EXPECT().Create(mock.Anything, mock.Anything).
+			Run(func(_ context.Context, p *world.EntityProperty) { created = p }).
+			Return(nil).Once()
+
+		require.NoError(t, svc.SetProperty(ctx, subjectID, "location", locID, "strength", strPtr("+12")))
+		require.NotNil(t, created)
+		assert.Equal(t, "12", *created.Value)
+		require.NotNil(t, created.Owner)
+		assert.Equal(t, charID, *created.Owner)
+
+		assert.Equal(t, "property_set", outbox.lastIntent.Kind)
+		assert.Equal(t, wmodel.AggregateProperty, outbox.lastDelta.Primary.Type)
+		var payload world.PropertySetPayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, "strength", payload.Name)
+		assert.Equal(t, locID.String(), payload.ParentID)
+	})
+
+	t.Run("rejects a value that does not match the declared type", func(t *testing.T) {
+		outbox := &mockOutboxWriter{}
+		svc, _ := newService(t, policytest.NewGrantEngine(), outbox)
+
+		err := svc.SetProperty(ctx, subjectID, "location", locID, "strength", strPtr("very"))
+		errutil.AssertErrorCode(t, err, "PROPERTY_VALUE_INVALID")
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("updates an existing property with write on the property", func(t *testing.T) {
+		existing := &world.EntityProperty{ID: ulid.Make(), ParentType: "location", ParentID: locID, Name: "mood", Value: strPtr("calm")}
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "write", access.PropertyResource(existing.ID.String()))
+		outbox := &mockOutboxWriter{}
+		svc, propRepo := newService(t, engine, outbox)
+
+		propRepo.EXPECT().ListByParent(mock.Anything, "location", locID).Return([]*world.EntityProperty{existing}, nil).Twice()
+		propRepo.EXPECT().Update(mock.Anything, existing).Return(nil).Once()
+
+		require.NoError(t, svc.SetProperty(ctx, subjectID, "location", locID, "mood", strPtr("stormy")))
+		assert.Equal(t, "stormy", *existing.Value)
+		assert.Equal(t, 1, outbox.calls)
+
+		require.NoError(t, svc.SetProperty(ctx, subjectID, "location", locID, "mood", strPtr("stormy")))
+		assert.Equal(t, 1, outbox.calls, "setting the current value emits nothing")
+	})
+
+	t.Run("creating needs write on the parent", func(t *testing.T) {
+		svc, propRepo := newService(t, policytest.NewGrantEngine(), &mockOutboxWriter{})
+		propRepo.EXPECT().ListByParent(mock.Anything, "location", locID).Return(nil, nil).Once()
+
+		err := svc.SetProperty(ctx, subjectID, "location", locID, "mood", strPtr("calm"))
+		errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_DENIED")
+	})
+
+	t.Run("rejects parents that cannot carry properties", func(t *testing.T) {
+		svc, _ := newService(t, policytest.NewGrantEngine(), &mockOutboxWriter{})
+		err := svc.SetProperty(ctx, subjectID, "exit", locID, "mood", strPtr("calm"))
+		errutil.AssertErrorCode(t, err, "PROPERTY_INVALID")
+	})
+}
+
+func TestWorldService_PropertyValues(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	objID := ulid.Make()
+	target := ulid.Make()
+
+	props := []*world.EntityProperty{
+		{ID: ulid.Make(), Name: "charges", Value: strPtr("3")},
+		{ID: ulid.Make(), Name: "key-for", Value: strPtr(target.String())},
+		{ID: ulid.Make(), Name: "lit", Value: strPtr("true")},
+		{ID: ulid.Make(), Name: "legacy", Value: strPtr("not a number")},
+		{ID: ulid.Make(), Name: "secret", Value: strPtr("9")},
+	}
+	engine := policytest.NewGrantEngine()
+	for _, p := range props[:4] {
+		engine.Grant(subjectID, "read", access.PropertyResource(p.ID.String()))
+	}
+	propRepo := worldtest.NewMockPropertyRepository(t)
+	propRepo.EXPECT().ListByParent(mock.Anything, "object", objID).Return(props, nil).Once()
+	svc := world.NewService(world.ServiceConfig{PropertyRepo: propRepo, Engine: engine})
+	reg := svc.PropertySchemas()
+	require.NoError(t, reg.Declare(world.PropertySchema{Name: "charges", Type: world.PropertyTypeInt, Source: world.PropertySchemaSourceStaff}))
+	require.NoError(t, reg.Declare(world.PropertySchema{Name: "key-for", Type: world.PropertyTypeRef, Source: world.PropertySchemaSourceStaff}))
+
+	values, err := svc.PropertyValues(ctx, subjectID, "object", objID)
+	require.NoError(t, err)
+
+	n, err := values.GetInt("charges")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	ref, err := values.GetRef("key-for")
+	require.NoError(t, err)
+	assert.Equal(t, target, ref)
+
+	lit, err := values.GetBool("lit")
+	require.NoError(t, err)
+	assert.True(t, lit, "undeclared names parse as requested")
+
+	_, err = values.GetString("charges")
+	errutil.AssertErrorCode(t, err, "PROPERTY_TYPE_MISMATCH")
+
+	_, err = values.GetInt("legacy")
+	errutil.AssertErrorCode(t, err, "PROPERTY_VALUE_INVALID")
+
+	_, err = values.GetInt("secret")
+	errutil.AssertErrorCode(t, err, "PROPERTY_NOT_FOUND")
+	assert.False(t, values.Has("secret"), "unreadable properties are absent")
+}
+
+func TestWorldService_DeclarePropertySchema(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	schema := world.PropertySchema{Name: "strength", Type: world.PropertyTypeInt, Description: "Raw muscle"}
+
+	t.Run("persists and declares a staff schema", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "write", access.PropertySchemaResource("strength"))
+		store := newMemSchemaStore()
+		svc := world.NewService(world.ServiceConfig{Engine: engine, PropertySchemaStore: store})
+
+		require.NoError(t, svc.DeclarePropertySchema(ctx, subjectID, schema))
+		assert.Contains(t, store.saved, "strength")
+		got, ok := svc.PropertySchemas().Lookup("strength")
+		require.True(t, ok)
+		assert.Equal(t, world.PropertySchemaSourceStaff, got.Source)
+
+		require.NoError(t, svc.RemovePropertySchema(ctx, subjectID, "strength"))
+		assert.Empty(t, store.saved)
+		_, ok = svc.PropertySchemas().Lookup("strength")
+		assert.False(t, ok)
+	})
+
+	t.Run("requires write on the property schema", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.NewGrantEngine(), PropertySchemaStore: newMemSchemaStore()})
+		err := svc.DeclarePropertySchema(ctx, subjectID, schema)
+		errutil.AssertErrorCode(t, err, "PROPERTY_SCHEMA_ACCESS_DENIED")
+	})
+
+	t.Run("cannot take a name a plugin declared", func(t *testing.T) {
+		store := newMemSchemaStore()
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.AllowAllEngine(), PropertySchemaStore: store})
+		require.NoError(t, svc.PropertySchemas().Declare(world.PropertySchema{
+			Name: "strength", Type: world.PropertyTypeInt, Source: access.PluginSubject("stats"),
+		}))
+
+		err := svc.DeclarePropertySchema(ctx, subjectID, schema)
+		errutil.AssertErrorCode(t, err, "PROPERTY_SCHEMA_CONFLICT")
+		assert.Empty(t, store.saved)
+		errutil.AssertErrorCode(t, svc.RemovePropertySchema(ctx, subjectID, "strength"), "PROPERTY_SCHEMA_NOT_FOUND")
+	})
+
+	t.Run("LoadPropertySchemas restores stored schemas", func(t *testing.T) {
+		store := newMemSchemaStore(schema, world.PropertySchema{Name: "broken", Type: "float"})
+		svc := world.NewService(world.ServiceConfig{Engine: policytest.AllowAllEngine(), PropertySchemaStore: store})
+
+		errutil.AssertErrorCode(t, svc.LoadPropertySchemas(ctx), "PROPERTY_SCHEMA_INVALID")
+		_, ok := svc.PropertySchemas().Lookup("strength")
+		assert.True(t, ok, "one bad row does not stop the rest loading")
+	})
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package propevents publishes world property changes as property_changed
+// events so plugins can react to state (a door watching "locked") without
+// polling. Publisher implements world.PropertyChangeHook.
+package propevents
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Publisher publishes a property_changed event on the changed entity's
+// location stream. Changes with no known location are dropped: there is no
+// stream a reactive plugin could be subscribed to for them.
+type Publisher struct {
+	pub    eventbus.Publisher
+	gameID func() string
+}
+
+var _ world.PropertyChangeHook = (*Publisher)(nil)
+
+// NewPublisher constructs a Publisher over pub, qualifying subjects with the
+// game id returned by gameID.
+//
+// Panics when pub or gameID is nil, mirroring presence.NewEmitter's
+// construction-time failure discipline.
+func NewPublisher(pub eventbus.Publisher, gameID func() string) *Publisher {
+	if pub == nil || eventbus.IsNilPublisher(pub) {
+		panic("propevents.NewPublisher: nil Publisher")
+	}
+	if gameID == nil {
+		panic("propevents.NewPublisher: nil gameID")
+	}
+	return &Publisher{pub: pub, gameID: gameID}
+}
+
+// OnPropertyChanged publishes change as a property_changed event. Values are
+// hashed before they leave the host; see eventvocab.PropertyChangedPayload.
+func (p *Publisher) OnPropertyChanged(ctx context.Context, change world.PropertyChange) error {
+	if change.LocationID == nil {
+		return nil
+	}
+	payload, err := json.Marshal(eventvocab.PropertyChangedPayload{
+		ParentType: change.ParentType,
+		ParentID:   change.ParentID.String(),
+		Key:        change.Key,
+		OldHash:    HashValue(change.OldValue),
+		NewHash:    HashValue(change.NewValue),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_property_changed_payload").Wrap(err)
+	}
+
+	gameID := p.gameID()
+	if gameID == "" {
+		gameID = "main"
+	}
+	stream := "location." + change.LocationID.String()
+	sub, err := eventbus.Qualify(gameID, stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypePropertyChanged))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypePropertyChanged)).Wrap(err)
+	}
+
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}
+	if err := p.pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, payload)); err != nil {
+		return oops.With("operation", "publish_property_changed_event").Wrap(err)
+	}
+	return nil
+}
+
+// HashValue returns the hex SHA-256 digest carried for a property value, or
+// "" for an empty value. Plugins call the same function (or its equivalent)
+// to compare a change against a value they expect.
+func HashValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package propevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// fakePublisher is a hand-rolled eventbus.Publisher recording every
+// published event, modeled on internal/presence's test fake.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func mainGameID() string { return "main" }
+
+func TestNewPublisherPanicsOnNilDependencies(t *testing.T) {
+	var nilPub *fakePublisher
+	assert.Panics(t, func() { NewPublisher(nil, mainGameID) })
+	assert.Panics(t, func() { NewPublisher(nilPub, mainGameID) })
+	assert.Panics(t, func() { NewPublisher(&fakePublisher{}, nil) })
+}
+
+func TestOnPropertyChangedPublishesHashedPayloadOnLocationStream(t *testing.T) {
+	pub := &fakePublisher{}
+	p := NewPublisher(pub, mainGameID)
+	objID, locID := ulid.Make(), ulid.Make()
+
+	require.NoError(t, p.OnPropertyChanged(context.Background(), world.PropertyChange{
+		ParentType: "object", ParentID: objID, Key: world.PropertyKeyLocked,
+		OldValue: "false", NewValue: "true", LocationID: &locID,
+	}))
+
+	require.Len(t, pub.published, 1)
+	ev := pub.published[0]
+	assert.Equal(t, "events.main.location."+locID.String(), string(ev.Subject))
+	assert.Equal(t, string(eventvocab.EventTypePropertyChanged), string(ev.Type))
+	assert.Equal(t, eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}, ev.Actor)
+
+	var payload eventvocab.PropertyChangedPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, eventvocab.PropertyChangedPayload{
+		ParentType: "object",
+		ParentID:   objID.String(),
+		Key:        "locked",
+		OldHash:    HashValue("false"),
+		NewHash:    HashValue("true"),
+	}, payload)
+	assert.NotContains(t, string(ev.Payload), `"true"`, "values never travel in plaintext")
+}
+
+func TestOnPropertyChangedSkipsChangesWithoutLocation(t *testing.T) {
+	pub := &fakePublisher{}
+	p := NewPublisher(pub, mainGameID)
+
+	require.NoError(t, p.OnPropertyChanged(context.Background(), world.PropertyChange{
+		ParentType: "object", ParentID: ulid.Make(), Key: world.PropertyKeyName, OldValue: "a", NewValue: "b",
+	}))
+	assert.Empty(t, pub.published)
+}
+
+func TestOnPropertyChangedReturnsPublishError(t *testing.T) {
+	p := NewPublisher(&fakePublisher{err: errors.New("bus down")}, mainGameID)
+	locID := ulid.Make()
+
+	err := p.OnPropertyChanged(context.Background(), world.PropertyChange{
+		ParentType: "location", ParentID: locID, Key: world.PropertyKeyName, OldValue: "a", NewValue: "b", LocationID: &locID,
+	})
+	require.Error(t, err)
+}
+
+func TestHashValue(t *testing.T) {
+	assert.Empty(t, HashValue(""))
+	assert.Len(t, HashValue("x"), 64)
+	assert.Equal(t, HashValue("x"), HashValue("x"))
+	assert.NotEqual(t, HashValue("x"), HashValue("y"))
+}
@@ -11,15 +11,6 @@ import (
 	"github.com/holomush/holomush/internal/world/wmodel"
 )
 
-// DefaultLimit is the default number of results when ListOptions.Limit is 0.
-const DefaultLimit = 100
-
-// ListOptions configures pagination for list operations.
-type ListOptions struct {
-	Limit  int // Maximum results to return (0 = use DefaultLimit)
-	Offset int // Number of results to skip
-}
-
 // LocationReader is the read-only view of location persistence. The compile-time
 // write fence (05-06/05-11) gives world.Service a reader plus a write executor so
 // an envelope-less direct write does not type-check.
@@ -27,12 +18,30 @@ type LocationReader interface {
 	// Get retrieves a location by ID.
 	Get(ctx context.Context, id ulid.ULID) (*Location, error)
 
+	// GetMany retrieves the locations with the given IDs in one round-trip.
+	// IDs with no matching location are omitted rather than reported as
+	// ErrNotFound; the result order is unspecified.
+	GetMany(ctx context.Context, ids []ulid.ULID) ([]*Location, error)
+
 	// ListByType returns all locations of the given type.
 	ListByType(ctx context.Context, locType LocationType) ([]*Location, error)
 
 	// GetShadowedBy returns scenes that shadow the given location.
 	GetShadowedBy(ctx context.Context, id ulid.ULID) ([]*Location, error)
 
+	// ListByZone returns the locations tagged with the given zone, ordered
+	// by ID. An unused zone yields an empty slice, not ErrNotFound.
+	ListByZone(ctx context.Context, zoneID string) ([]*Location, error)
+
+	// ListChildren returns the locations whose parent is the given
+	// location, ordered by ID. A location with no children yields an empty
+	// slice, not ErrNotFound.
+	ListChildren(ctx context.Context, parentID ulid.ULID) ([]*Location, error)
+
+	// ListByTag returns the locations carrying tag, ordered by ID. An unused
+	// tag yields an empty slice, not ErrNotFound.
+	ListByTag(ctx context.Context, tag string) ([]*Location, error)
+
 	// FindByName searches for a location by exact name match.
 	// Returns ErrNotFound if no location matches.
 	FindByName(ctx context.Context, name string) (*Location, error)
@@ -56,6 +65,10 @@ type LocationRepository interface {
 	// expectedVersion is the optimistic-concurrency guard (accepted and ignored
 	// in 05-14; the version predicate lands in 05-02/05-03).
 	Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error)
+
+	// SetTags replaces a location's tags with a version-predicated CAS.
+	// Update leaves tags untouched; this is their only writer.
+	SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error)
 }
 
 // ExitReader is the read-only view of exit persistence.
@@ -63,8 +76,10 @@ type ExitReader interface {
 	// Get retrieves an exit by ID.
 	Get(ctx context.Context, id ulid.ULID) (*Exit, error)
 
-	// ListFromLocation returns all exits from a location.
-	ListFromLocation(ctx context.Context, locationID ulid.ULID) ([]*Exit, error)
+	// ListFromLocation returns a page of the exits from a location, ordered
+	// by (name, id). Pass empty ListOptions{} for the first DefaultLimit
+	// exits; world.CollectPages or world.StreamPages walks them all.
+	ListFromLocation(ctx context.Context, locationID ulid.ULID, opts ListOptions) (Page[*Exit], error)
 
 	// FindByName finds an exit by name or alias from a location.
 	FindByName(ctx context.Context, locationID ulid.ULID, name string) (*Exit, error)
@@ -77,6 +92,10 @@ type ExitReader interface {
 	// The visibility check is atomic - the location owner is fetched and compared in a single query.
 	// This prevents TOCTOU issues where the owner could change between lookup and check.
 	ListVisibleExits(ctx context.Context, locationID, characterID ulid.ULID) ([]*Exit, error)
+
+	// ListDanglingExits returns the bidirectional exits whose return exit is
+	// missing or no longer leads back, ordered by ID.
+	ListDanglingExits(ctx context.Context) ([]*Exit, error)
 }
 
 // ExitRepository manages exit persistence.
@@ -87,7 +106,9 @@ type ExitRepository interface {
 	// If bidirectional, also creates the return exit.
 	Create(ctx context.Context, exit *Exit) (*wmodel.MutationDelta, error)
 
-	// Update modifies an existing exit.
+	// Update modifies an existing exit. When a bidirectional exit's
+	// endpoints, name, or return name change, its return exit is re-pointed
+	// in the same transaction and listed in the delta's Affected.
 	Update(ctx context.Context, exit *Exit) (*wmodel.MutationDelta, error)
 
 	// Delete removes an exit by ID.
@@ -126,6 +147,10 @@ type ObjectReader interface {
 
 	// ListContainedIn returns all objects inside a container object.
 	ListContainedIn(ctx context.Context, objectID ulid.ULID) ([]*Object, error)
+
+	// ListByTag returns the objects carrying tag, ordered by ID. An unused
+	// tag yields an empty slice, not ErrNotFound.
+	ListByTag(ctx context.Context, tag string) ([]*Object, error)
 }
 
 // ObjectRepository manages object persistence.
@@ -148,6 +173,10 @@ type ObjectRepository interface {
 	// expectedVersion is the move/containment CAS guard (accepted and ignored in
 	// 05-14; the read version is threaded from service.go in 05-03/05-11).
 	Move(ctx context.Context, objectID ulid.ULID, to Containment, expectedVersion int) (*wmodel.MutationDelta, error)
+
+	// SetTags replaces an object's tags with a version-predicated CAS.
+	// Update leaves tags untouched; this is their only writer.
+	SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error)
 }
 
 // SceneReader is the read-only view of scene persistence. The world-layer scene
@@ -160,6 +189,11 @@ type SceneReader interface {
 
 	// GetScenesFor returns all scenes a character is participating in.
 	GetScenesFor(ctx context.Context, characterID ulid.ULID) ([]*Location, error)
+
+	// ListScenes returns a page of the scenes matching filter, newest first,
+	// ordered by (created_at, id) descending. Pass empty ListOptions{} for the
+	// first DefaultLimit scenes.
+	ListScenes(ctx context.Context, filter SceneFilter, opts ListOptions) (Page[*Location], error)
 }
 
 // SceneRepository manages scene-specific read operations. The vestigial world
@@ -179,9 +213,15 @@ type CharacterReader interface {
 	// Get retrieves a character by ID.
 	Get(ctx context.Context, id ulid.ULID) (*Character, error)
 
-	// GetByLocation retrieves characters at a location with pagination.
-	// Pass empty ListOptions{} to use default pagination (limit=100, offset=0).
-	GetByLocation(ctx context.Context, locationID ulid.ULID, opts ListOptions) ([]*Character, error)
+	// FindByName retrieves the character named name, ignoring case. Names
+	// are not unique, so when several match the lowest ID wins. Returns
+	// ErrNotFound when none does.
+	FindByName(ctx context.Context, name string) (*Character, error)
+
+	// GetByLocation returns a page of the characters at a location, ordered
+	// by (name, id). Pass empty ListOptions{} for the first DefaultLimit
+	// characters.
+	GetByLocation(ctx context.Context, locationID ulid.ULID, opts ListOptions) (Page[*Character], error)
 
 	// IsOwnedByPlayer checks if a character is owned by a specific player.
 	// Returns false (not an error) if the character does not exist.
@@ -191,6 +231,10 @@ type CharacterReader interface {
 	// Missing IDs are omitted from the result map (not an error).
 	// Returns empty map (not nil error) for an empty input slice.
 	GetNamesByIDs(ctx context.Context, ids []ulid.ULID) (map[ulid.ULID]string, error)
+
+	// ListByTag returns the characters carrying tag, ordered by ID. An
+	// unused tag yields an empty slice, not ErrNotFound.
+	ListByTag(ctx context.Context, tag string) ([]*Character, error)
 }
 
 // CharacterRepository defines operations for character persistence.
@@ -219,4 +263,8 @@ type CharacterRepository interface {
 	// moves into the sanctioned writer boundary and returns a MutationDelta so the
 	// caller emits one character_preferences_update envelope in the same tx.
 	UpdatePreferences(ctx context.Context, characterID ulid.ULID, prefs []byte, expectedVersion int) (*wmodel.MutationDelta, error)
+
+	// SetTags replaces a character's tags with a version-predicated CAS.
+	// Update leaves tags untouched; this is their only writer.
+	SetTags(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error)
 }
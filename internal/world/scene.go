@@ -3,7 +3,11 @@
 
 package world
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/oklog/ulid/v2"
+)
 
 // ParticipantRole identifies a character's role in a scene.
 type ParticipantRole string
@@ -37,3 +41,36 @@ func (r ParticipantRole) Validate() error {
 func ValidParticipantRoles() []ParticipantRole {
 	return []ParticipantRole{RoleOwner, RoleMember, RoleInvited}
 }
+
+// SceneStatus filters scene listings by lifecycle state.
+type SceneStatus string
+
+// Scene statuses. SceneStatusAny matches every scene.
+const (
+	SceneStatusAny      SceneStatus = ""
+	SceneStatusOpen     SceneStatus = "open"
+	SceneStatusArchived SceneStatus = "archived"
+)
+
+// ErrInvalidSceneStatus indicates an unrecognized scene status filter.
+var ErrInvalidSceneStatus = errors.New("invalid scene status")
+
+// Validate checks that the status is a valid scene status filter.
+func (s SceneStatus) Validate() error {
+	switch s {
+	case SceneStatusAny, SceneStatusOpen, SceneStatusArchived:
+		return nil
+	default:
+		return ErrInvalidSceneStatus
+	}
+}
+
+// SceneFilter narrows a scene listing. Zero-value fields do not filter.
+type SceneFilter struct {
+	// Status selects open (not archived) or archived scenes.
+	Status SceneStatus
+	// Participant selects scenes the character participates in.
+	Participant *ulid.ULID
+	// Location selects scenes that shadow the location.
+	Location *ulid.ULID
+}
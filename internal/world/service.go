@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -48,11 +49,35 @@ const (
 	kindObjectDeleted = "object_deleted"
 	kindObjectMoved   = "object_moved"
 
+	kindObjectLocked               = "object_locked"
+	kindObjectUnlocked             = "object_unlocked"
+	kindObjectOwnershipTransferred = "object_ownership_transferred"
+
+	kindObjectVerbDefined = "object_verb_defined"
+	kindObjectVerbRemoved = "object_verb_removed"
+
+	kindObjectWorn     = "object_worn"
+	kindObjectTakenOff = "object_taken_off"
+
 	kindCharacterUpdated           = "character_updated"
 	kindCharacterDeleted           = "character_deleted"
 	kindCharacterMoved             = "character_moved"
 	kindCharacterPreferencesUpdate = "character_preferences_update"
-	worldSchemaVersion             = 1
+	kindCharacterAnonymized        = "character_anonymized"
+	kindCharacterVisibilityChanged = "character_visibility_changed"
+	kindCharacterEffectApplied     = "character_effect_applied"
+	kindCharacterEffectRemoved     = "character_effect_removed"
+
+	kindLocationTagged    = "location_tagged"
+	kindLocationUntagged  = "location_untagged"
+	kindObjectTagged      = "object_tagged"
+	kindObjectUntagged    = "object_untagged"
+	kindCharacterTagged   = "character_tagged"
+	kindCharacterUntagged = "character_untagged"
+
+	kindPropertySet = "property_set"
+
+	worldSchemaVersion = 1
 )
 
 // ErrPermissionDenied is returned when an operation is not authorized.
@@ -80,6 +105,13 @@ type ServiceConfig struct {
 	// GameID keys the outbox feed counter and the outbox row's game_id. Defaults to
 	// "main" when empty (single-game Phase 5).
 	GameID string
+	// ZoneBroadcastLimit bounds how often one subject may broadcast to one
+	// zone. Zero fields take DefaultZoneBroadcastBurst and
+	// DefaultZoneBroadcastInterval.
+	ZoneBroadcastLimit ZoneBroadcastLimit
+	// PropertySchemaStore persists staff-declared property schemas. Without
+	// it only plugin declarations apply and DeclarePropertySchema fails.
+	PropertySchemaStore PropertySchemaStore
 }
 
 // Service provides authorized access to world model operations.
@@ -103,6 +135,19 @@ type Service struct {
 	engine        types.AccessPolicyEngine
 	transactor    Transactor
 	movementHook  MovementHook
+	propertyHook  PropertyChangeHook
+	// accessInvalidator is told which entities' cached access decisions a
+	// committed write made stale. Defaults to a no-op.
+	accessInvalidator AccessInvalidator
+	broadcaster       LocationBroadcaster
+	verbDispatcher    ObjectVerbDispatcher
+	textTokens        TextTokenExpander
+	zoneLimiter       *zoneBroadcastLimiter
+	journal           *BuildJournal
+	// propertySchemas holds the declared property value types SetProperty
+	// validates against; propertySchemaStore persists the staff ones.
+	propertySchemas     *PropertySchemaRegistry
+	propertySchemaStore PropertySchemaStore
 	// mutator is the write executor + write-requires-envelope seam. It owns the
 	// private write repos + transactor + injected OutboxWriter (05-06). Nil until
 	// an OutboxWriter is configured; MoveCharacter reports a configuration error if
@@ -140,17 +185,22 @@ func NewService(cfg ServiceConfig) *Service {
 		)
 	}
 	return &Service{
-		locationRepo:  cfg.LocationRepo,
-		exitRepo:      cfg.ExitRepo,
-		objectRepo:    cfg.ObjectRepo,
-		sceneRepo:     cfg.SceneRepo,
-		characterRepo: cfg.CharacterRepo,
-		propertyRepo:  cfg.PropertyRepo,
-		engine:        cfg.Engine,
-		transactor:    cfg.Transactor,
-		movementHook:  NoopMovementHook{},
-		mutator:       mutator,
-		gameID:        gameID,
+		locationRepo:        cfg.LocationRepo,
+		exitRepo:            cfg.ExitRepo,
+		objectRepo:          cfg.ObjectRepo,
+		sceneRepo:           cfg.SceneRepo,
+		characterRepo:       cfg.CharacterRepo,
+		propertyRepo:        cfg.PropertyRepo,
+		engine:              cfg.Engine,
+		transactor:          cfg.Transactor,
+		movementHook:        NoopMovementHook{},
+		propertyHook:        NoopPropertyChangeHook{},
+		accessInvalidator:   NoopAccessInvalidator{},
+		zoneLimiter:         newZoneBroadcastLimiter(cfg.ZoneBroadcastLimit),
+		propertySchemas:     NewPropertySchemaRegistry(),
+		propertySchemaStore: cfg.PropertySchemaStore,
+		mutator:             mutator,
+		gameID:              gameID,
 	}
 }
 
@@ -177,6 +227,11 @@ const (
 	prefixCharacter entityPrefix = "CHARACTER"
 	prefixScene     entityPrefix = "SCENE"
 	prefixProperty  entityPrefix = "PROPERTY"
+	prefixZone      entityPrefix = "ZONE"
+	prefixTag       entityPrefix = "TAG"
+	// prefixPropertySchema covers property_schema:<name>, the staff
+	// declaration of a property's value type.
+	prefixPropertySchema entityPrefix = "PROPERTY_SCHEMA"
 )
 
 // KnownEntityPrefixes returns all entity prefix strings.
@@ -189,6 +244,9 @@ func KnownEntityPrefixes() []string {
 		string(prefixCharacter),
 		string(prefixScene),
 		string(prefixProperty),
+		string(prefixZone),
+		string(prefixTag),
+		string(prefixPropertySchema),
 	}
 }
 
@@ -209,7 +267,6 @@ func KnownEntityPrefixes() []string {
 func (s *Service) checkAccess(ctx context.Context, subject, action, resource string, prefix entityPrefix) error {
 	metricKey := strings.ToLower(string(prefix)) + "_access_check"
 	failCode := string(prefix) + "_ACCESS_EVALUATION_FAILED"
-	denyCode := string(prefix) + "_ACCESS_DENIED"
 
 	req, reqErr := types.NewAccessRequest(subject, action, resource, nil)
 	if reqErr != nil {
@@ -224,6 +281,18 @@ func (s *Service) checkAccess(ctx context.Context, subject, action, resource str
 			Wrap(errors.Join(ErrAccessEvaluationFailed, reqErr))
 	}
 	decision, err := s.engine.Evaluate(ctx, req)
+	return checkDecision(ctx, decision, err, subject, action, resource, prefix)
+}
+
+// checkDecision classifies an engine result the way checkAccess reports it:
+// nil when allowed, <PREFIX>_ACCESS_DENIED wrapping ErrPermissionDenied for a
+// policy denial, and <PREFIX>_ACCESS_EVALUATION_FAILED wrapping
+// ErrAccessEvaluationFailed for engine errors and infrastructure failures.
+func checkDecision(ctx context.Context, decision types.Decision, err error, subject, action, resource string, prefix entityPrefix) error {
+	metricKey := strings.ToLower(string(prefix)) + "_access_check"
+	failCode := string(prefix) + "_ACCESS_EVALUATION_FAILED"
+	denyCode := string(prefix) + "_ACCESS_DENIED"
+
 	if err != nil {
 		errutil.LogErrorContext(ctx, "access evaluation failed",
 			err, "subject", subject, "action", action, "resource", resource)
@@ -272,6 +341,70 @@ func (s *Service) GetLocation(ctx context.Context, subjectID string, id ulid.ULI
 	return loc, nil
 }
 
+// GetLocations retrieves the locations with the given IDs that the subject may
+// read, using one batched access evaluation and one repository query instead
+// of a GetLocation round-trip per ID. Denied and missing locations are omitted
+// rather than failing the call; results follow the order of ids with
+// duplicates removed. An evaluation failure on any ID fails the whole call
+// closed with LOCATION_ACCESS_EVALUATION_FAILED.
+func (s *Service) GetLocations(ctx context.Context, subjectID string, ids []ulid.ULID) ([]*Location, error) {
+	if s.locationRepo == nil {
+		return nil, oops.Code("LOCATION_GET_FAILED").Errorf("location repository not configured")
+	}
+	unique := make([]ulid.ULID, 0, len(ids))
+	seen := make(map[ulid.ULID]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 {
+		return []*Location{}, nil
+	}
+
+	reqs := make([]types.AccessRequest, len(unique))
+	for i, id := range unique {
+		resource := access.LocationResource(id.String())
+		req, err := types.NewAccessRequest(subjectID, "read", resource, nil)
+		if err != nil {
+			return nil, checkDecision(ctx, types.Decision{}, err, subjectID, "read", resource, prefixLocation)
+		}
+		reqs[i] = req
+	}
+
+	readable := make([]ulid.ULID, 0, len(unique))
+	for i, result := range types.EvaluateBatch(ctx, s.engine, reqs) {
+		err := checkDecision(ctx, result.Decision, result.Err, subjectID, "read", reqs[i].Resource, prefixLocation)
+		switch {
+		case err == nil:
+			readable = append(readable, unique[i])
+		case !errors.Is(err, ErrPermissionDenied):
+			return nil, err
+		}
+	}
+	if len(readable) == 0 {
+		return []*Location{}, nil
+	}
+
+	found, err := s.locationRepo.GetMany(ctx, readable)
+	if err != nil {
+		return nil, oops.Code("LOCATION_GET_FAILED").With("count", len(readable)).Wrapf(err, "get locations")
+	}
+	byID := make(map[ulid.ULID]*Location, len(found))
+	for _, loc := range found {
+		byID[loc.ID] = loc
+	}
+	locs := make([]*Location, 0, len(found))
+	for _, id := range readable {
+		if loc, ok := byID[id]; ok {
+			locs = append(locs, loc)
+		}
+	}
+	return locs, nil
+}
+
 // CreateLocation creates a new location after checking write authorization.
 // The location ID is generated if not set.
 // Returns a ValidationError if the name or description is invalid.
@@ -292,6 +425,12 @@ func (s *Service) CreateLocation(ctx context.Context, subjectID string, loc *Loc
 	if err := loc.Validate(); err != nil {
 		return oops.Code("LOCATION_INVALID").Wrap(err)
 	}
+	if err := s.checkLocationParent(ctx, loc); err != nil {
+		return err
+	}
+	if err := s.checkLocationOverflow(ctx, loc); err != nil {
+		return err
+	}
 	if s.mutator == nil {
 		return oops.Code("LOCATION_CREATE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
 	}
@@ -303,6 +442,10 @@ func (s *Service) CreateLocation(ctx context.Context, subjectID string, loc *Loc
 	if _, err := s.mutator.createLocation(ctx, intent, loc); err != nil {
 		return oops.Code("LOCATION_CREATE_FAILED").Wrapf(err, "create location %s", loc.ID)
 	}
+	s.journalRecord(ctx, subjectID, &JournalEntry{
+		Op: JournalCreate, Aggregate: wmodel.AggregateLocation, EntityID: loc.ID,
+		after: cloneLocation(loc),
+	})
 	return nil
 }
 
@@ -322,6 +465,12 @@ func (s *Service) UpdateLocation(ctx context.Context, subjectID string, loc *Loc
 	if err := loc.Validate(); err != nil {
 		return oops.Code("LOCATION_INVALID").Wrap(err)
 	}
+	if err := s.checkLocationParent(ctx, loc); err != nil {
+		return err
+	}
+	if err := s.checkLocationOverflow(ctx, loc); err != nil {
+		return err
+	}
 	if s.mutator == nil {
 		return oops.Code("LOCATION_UPDATE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
 	}
@@ -330,6 +479,13 @@ func (s *Service) UpdateLocation(ctx context.Context, subjectID string, loc *Loc
 		return oops.Code("LOCATION_UPDATE_FAILED").Wrapf(err, "build location update payload %s", loc.ID)
 	}
 	intent := s.buildIntent(kindLocationUpdated, wmodel.AggregateLocation, loc.ID, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateLocation, loc.ID)
+	var prior *Location
+	if s.propertyEventsEnabled(ctx) {
+		if current, err := s.locationRepo.Get(ctx, loc.ID); err == nil {
+			prior = cloneLocation(current)
+		}
+	}
 	if _, err := s.mutator.updateLocation(ctx, intent, loc); err != nil {
 		if errors.Is(err, ErrConcurrentEdit) {
 			return oops.Code(CodeConcurrentEdit).With("id", loc.ID.String()).Wrap(err)
@@ -339,6 +495,16 @@ func (s *Service) UpdateLocation(ctx context.Context, subjectID string, loc *Loc
 		}
 		return oops.Code("LOCATION_UPDATE_FAILED").Wrapf(err, "update location %s", loc.ID)
 	}
+	s.invalidateLocationAccess(ctx, loc.ID)
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalUpdate, Aggregate: wmodel.AggregateLocation, EntityID: loc.ID,
+			before: before, after: cloneLocation(loc),
+		})
+	}
+	if prior != nil {
+		s.notifyPropertyChanges(ctx, locationPropertyChanges(prior, loc)...)
+	}
 	return nil
 }
 
@@ -384,6 +550,8 @@ func (s *Service) DeleteLocation(ctx context.Context, subjectID string, id ulid.
 		return oops.Code("LOCATION_DELETE_FAILED").Wrapf(err, "build location tombstone payload %s", id)
 	}
 	intent := s.buildIntent(kindLocationDeleted, wmodel.AggregateLocation, id, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateLocation, id)
+	exits := s.journalLocationExits(ctx, id)
 	// The delete + its property cascade + the tombstone envelope commit in ONE
 	// transaction via the mutate() seam; the envelope manifest carries the
 	// DB-cascaded exits from the repo delta (INV-WORLD-2 parity).
@@ -393,6 +561,13 @@ func (s *Service) DeleteLocation(ctx context.Context, subjectID string, id ulid.
 		}
 		return oops.Code("LOCATION_DELETE_FAILED").Wrapf(err, "delete location %s", id)
 	}
+	s.invalidateLocationAccess(ctx, id)
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalDelete, Aggregate: wmodel.AggregateLocation, EntityID: id,
+			before: before, exits: exits,
+		})
+	}
 	return nil
 }
 
@@ -421,6 +596,9 @@ func (s *Service) GetExit(ctx context.Context, subjectID string, id ulid.ULID) (
 // Returns a ValidationError if the id, name, aliases, visibility, lock type,
 // lock data, or visible_to are invalid.
 // Returns ErrSelfReferentialExit if from and to locations are the same.
+// Returns ErrExitNameConflict (as *ExitNameConflictError) if the name or an
+// alias is already used by another exit from the same location, or, for a
+// bidirectional exit, if the return name is taken at the destination.
 func (s *Service) CreateExit(ctx context.Context, subjectID string, exit *Exit) error {
 	if s.exitRepo == nil {
 		return oops.Code("EXIT_CREATE_FAILED").Errorf("exit repository not configured")
@@ -447,8 +625,15 @@ func (s *Service) CreateExit(ctx context.Context, subjectID string, exit *Exit)
 	}
 	intent := s.buildIntent(kindExitCreated, wmodel.AggregateExit, exit.ID, subjectID, payload)
 	if _, err := s.mutator.createExit(ctx, intent, exit); err != nil {
+		if errors.Is(err, ErrExitNameConflict) {
+			return oops.Code(CodeExitNameConflict).With("id", exit.ID.String()).Wrap(err)
+		}
 		return oops.Code("EXIT_CREATE_FAILED").Wrapf(err, "create exit %s", exit.ID)
 	}
+	s.journalRecord(ctx, subjectID, &JournalEntry{
+		Op: JournalCreate, Aggregate: wmodel.AggregateExit, EntityID: exit.ID,
+		after: cloneExit(exit),
+	})
 	return nil
 }
 
@@ -457,6 +642,8 @@ func (s *Service) CreateExit(ctx context.Context, subjectID string, exit *Exit)
 // Returns a ValidationError if the id, name, aliases, visibility, lock type,
 // lock data, or visible_to are invalid.
 // Returns ErrSelfReferentialExit if from and to locations are the same.
+// Returns ErrExitNameConflict (as *ExitNameConflictError) if the name or an
+// alias is already used by another exit from the same location.
 func (s *Service) UpdateExit(ctx context.Context, subjectID string, exit *Exit) error {
 	if s.exitRepo == nil {
 		return oops.Code("EXIT_UPDATE_FAILED").Errorf("exit repository not configured")
@@ -479,6 +666,7 @@ func (s *Service) UpdateExit(ctx context.Context, subjectID string, exit *Exit)
 		return oops.Code("EXIT_UPDATE_FAILED").Wrapf(err, "build exit update payload %s", exit.ID)
 	}
 	intent := s.buildIntent(kindExitUpdated, wmodel.AggregateExit, exit.ID, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateExit, exit.ID)
 	if _, err := s.mutator.updateExit(ctx, intent, exit); err != nil {
 		if errors.Is(err, ErrConcurrentEdit) {
 			return oops.Code(CodeConcurrentEdit).With("id", exit.ID.String()).Wrap(err)
@@ -486,8 +674,17 @@ func (s *Service) UpdateExit(ctx context.Context, subjectID string, exit *Exit)
 		if errors.Is(err, ErrNotFound) {
 			return oops.Code("EXIT_NOT_FOUND").Wrapf(err, "update exit %s", exit.ID)
 		}
+		if errors.Is(err, ErrExitNameConflict) {
+			return oops.Code(CodeExitNameConflict).With("id", exit.ID.String()).Wrap(err)
+		}
 		return oops.Code("EXIT_UPDATE_FAILED").Wrapf(err, "update exit %s", exit.ID)
 	}
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalUpdate, Aggregate: wmodel.AggregateExit, EntityID: exit.ID,
+			before: before, after: cloneExit(exit),
+		})
+	}
 	return nil
 }
 
@@ -511,6 +708,7 @@ func (s *Service) DeleteExit(ctx context.Context, subjectID string, id ulid.ULID
 		return oops.Code("EXIT_DELETE_FAILED").Wrapf(err, "build exit tombstone payload %s", id)
 	}
 	intent := s.buildIntent(kindExitDeleted, wmodel.AggregateExit, id, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateExit, id)
 	// The delete (incl. the atomic bidirectional reverse-exit cascade) and its
 	// single tombstone envelope commit in ONE transaction via mutate(); the envelope
 	// manifest carries the reverse exit from the repo delta. A non-severe cleanup
@@ -539,6 +737,12 @@ func (s *Service) DeleteExit(ctx context.Context, subjectID string, id ulid.ULID
 			"to_location_id", notice.ToLocationID.String(),
 			"return_name", notice.ReturnName)
 	}
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalDelete, Aggregate: wmodel.AggregateExit, EntityID: id,
+			before: before,
+		})
+	}
 	return nil
 }
 
@@ -551,13 +755,50 @@ func (s *Service) GetExitsByLocation(ctx context.Context, subjectID string, loca
 	if err := s.checkAccess(ctx, subjectID, "read", resource, prefixLocation); err != nil {
 		return nil, err
 	}
-	exits, err := s.exitRepo.ListFromLocation(ctx, locationID)
+	exits, err := CollectPages(ctx, func(ctx context.Context, opts ListOptions) (Page[*Exit], error) {
+		return s.exitRepo.ListFromLocation(ctx, locationID, opts)
+	})
 	if err != nil {
 		return nil, oops.Code("EXIT_LIST_FAILED").Wrapf(err, "list exits from location %s", locationID)
 	}
 	return exits, nil
 }
 
+// GetVisibleExits retrieves the exits from a location that observerCharID can
+// see, after checking read authorization for subjectID. subjectID is who is
+// asking (possibly the system); observerCharID is whose view is rendered, and
+// the zero ULID yields only VisibilityAll exits. The location's owner is
+// loaded only when an owner-only exit is present; failing to load it hides
+// those exits rather than failing the listing.
+func (s *Service) GetVisibleExits(ctx context.Context, subjectID string, locationID, observerCharID ulid.ULID) ([]*Exit, error) {
+	exits, err := s.GetExitsByLocation(ctx, subjectID, locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	obs := ExitObserver{CharacterID: observerCharID}
+	if !observerCharID.IsZero() && slices.ContainsFunc(exits, func(e *Exit) bool { return e.Visibility == VisibilityOwner }) {
+		obs.LocationOwnerID = s.locationOwner(ctx, locationID)
+	}
+	return FilterVisibleExits(exits, obs), nil
+}
+
+// locationOwner returns the owner of locationID, or nil when the location is
+// unowned or cannot be loaded. The read was already authorized by the caller.
+func (s *Service) locationOwner(ctx context.Context, locationID ulid.ULID) *ulid.ULID {
+	if s.locationRepo == nil {
+		return nil
+	}
+	loc, err := s.locationRepo.Get(ctx, locationID)
+	if err != nil {
+		slog.WarnContext(ctx, "exit visibility: location owner lookup failed; hiding owner-only exits",
+			"location_id", locationID.String(),
+			"error", err)
+		return nil
+	}
+	return loc.OwnerID
+}
+
 // GetObject retrieves an object by ID after checking read authorization.
 func (s *Service) GetObject(ctx context.Context, subjectID string, id ulid.ULID) (*Object, error) {
 	if s.objectRepo == nil {
@@ -611,6 +852,10 @@ func (s *Service) CreateObject(ctx context.Context, subjectID string, obj *Objec
 	if _, err := s.mutator.createObject(ctx, intent, obj); err != nil {
 		return oops.Code("OBJECT_CREATE_FAILED").Wrapf(err, "create object %s", obj.ID)
 	}
+	s.journalRecord(ctx, subjectID, &JournalEntry{
+		Op: JournalCreate, Aggregate: wmodel.AggregateObject, EntityID: obj.ID,
+		after: cloneObject(obj),
+	})
 	return nil
 }
 
@@ -625,7 +870,7 @@ func (s *Service) UpdateObject(ctx context.Context, subjectID string, obj *Objec
 	}
 	resource := access.ObjectResource(obj.ID.String())
 	if err := s.checkAccess(ctx, subjectID, "write", resource, prefixObject); err != nil {
-		return err
+		return s.lockedObjectDenial(ctx, subjectID, obj.ID, err)
 	}
 	if err := obj.Validate(); err != nil {
 		return oops.Code("OBJECT_INVALID").Wrap(err)
@@ -641,6 +886,13 @@ func (s *Service) UpdateObject(ctx context.Context, subjectID string, obj *Objec
 		return oops.Code("OBJECT_UPDATE_FAILED").Wrapf(err, "build object update payload %s", obj.ID)
 	}
 	intent := s.buildIntent(kindObjectUpdated, wmodel.AggregateObject, obj.ID, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateObject, obj.ID)
+	var prior *Object
+	if s.propertyEventsEnabled(ctx) {
+		if current, err := s.objectRepo.Get(ctx, obj.ID); err == nil {
+			prior = cloneObject(current)
+		}
+	}
 	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
 		if errors.Is(err, ErrConcurrentEdit) {
 			return oops.Code(CodeConcurrentEdit).With("id", obj.ID.String()).Wrap(err)
@@ -650,13 +902,28 @@ func (s *Service) UpdateObject(ctx context.Context, subjectID string, obj *Objec
 		}
 		return oops.Code("OBJECT_UPDATE_FAILED").Wrapf(err, "update object %s", obj.ID)
 	}
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalUpdate, Aggregate: wmodel.AggregateObject, EntityID: obj.ID,
+			before: before, after: cloneObject(obj),
+		})
+	}
+	if prior != nil {
+		s.notifyPropertyChanges(ctx, objectPropertyChanges(prior, obj)...)
+	}
 	return nil
 }
 
 // DeleteObject deletes an object and its properties after checking delete authorization.
 // Both deletions occur in the same database transaction per spec (05-storage-audit.md §110-119).
-// Returns an error if PropertyRepo or Transactor are not configured.
-func (s *Service) DeleteObject(ctx context.Context, subjectID string, id ulid.ULID) error {
+// Returns an error if PropertyRepo or Transactor are not configured. With
+// IfObjectVersion the delete fails, wrapping ErrConcurrentEdit, when the
+// object changed since it was read.
+func (s *Service) DeleteObject(ctx context.Context, subjectID string, id ulid.ULID, opts ...DeleteObjectOption) error {
+	var req deleteObjectRequest
+	for _, opt := range opts {
+		opt(&req)
+	}
 	if s.objectRepo == nil {
 		return oops.Code("OBJECT_DELETE_FAILED").Errorf("object repository not configured")
 	}
@@ -668,7 +935,7 @@ func (s *Service) DeleteObject(ctx context.Context, subjectID string, id ulid.UL
 	}
 	resource := access.ObjectResource(id.String())
 	if err := s.checkAccess(ctx, subjectID, "delete", resource, prefixObject); err != nil {
-		return err
+		return s.lockedObjectDenial(ctx, subjectID, id, err)
 	}
 	if s.mutator == nil {
 		return oops.Code("OBJECT_DELETE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
@@ -678,17 +945,40 @@ func (s *Service) DeleteObject(ctx context.Context, subjectID string, id ulid.UL
 		return oops.Code("OBJECT_DELETE_FAILED").Wrapf(err, "build object tombstone payload %s", id)
 	}
 	intent := s.buildIntent(kindObjectDeleted, wmodel.AggregateObject, id, subjectID, payload)
+	before := s.journalBefore(ctx, wmodel.AggregateObject, id)
 	// The delete + its property cascade + the tombstone envelope commit in ONE
 	// transaction via the mutate() seam.
-	if _, err := s.mutator.deleteObject(ctx, intent, id); err != nil {
+	if _, err := s.mutator.deleteObject(ctx, intent, id, req.expectedVersion); err != nil {
 		if errors.Is(err, ErrNotFound) {
 			return oops.Code("OBJECT_NOT_FOUND").Wrapf(err, "delete object %s", id)
 		}
 		return oops.Code("OBJECT_DELETE_FAILED").Wrapf(err, "delete object %s", id)
 	}
+	s.invalidateObjectAccess(ctx, id)
+	if before != nil {
+		s.journalRecord(ctx, subjectID, &JournalEntry{
+			Op: JournalDelete, Aggregate: wmodel.AggregateObject, EntityID: id,
+			before: before,
+		})
+	}
 	return nil
 }
 
+// deleteObjectRequest holds the options of one DeleteObject call.
+type deleteObjectRequest struct {
+	expectedVersion int
+}
+
+// DeleteObjectOption configures a single DeleteObject call.
+type DeleteObjectOption func(*deleteObjectRequest)
+
+// IfObjectVersion deletes the object only while it is still at version.
+func IfObjectVersion(version int) DeleteObjectOption {
+	return func(r *deleteObjectRequest) {
+		r.expectedVersion = version
+	}
+}
+
 // MoveObject moves an object to a new containment (location, character inventory,
 // or another object).
 //
@@ -702,7 +992,7 @@ func (s *Service) MoveObject(ctx context.Context, subjectID string, id ulid.ULID
 	}
 	resource := access.ObjectResource(id.String())
 	if err := s.checkAccess(ctx, subjectID, "write", resource, prefixObject); err != nil {
-		return err
+		return s.lockedObjectDenial(ctx, subjectID, id, err)
 	}
 	if err := to.Validate(); err != nil {
 		return oops.Code("OBJECT_INVALID").Wrap(err)
@@ -735,10 +1025,313 @@ func (s *Service) MoveObject(ctx context.Context, subjectID string, id ulid.ULID
 		}
 		return oops.Code("OBJECT_MOVE_FAILED").Wrapf(err, "move object %s", id)
 	}
+	s.invalidateObjectAccess(ctx, id)
+	s.journalRecord(ctx, subjectID, &JournalEntry{
+		Op: JournalMove, Aggregate: wmodel.AggregateObject, EntityID: id,
+		before: obj.Containment(), after: to,
+	})
+	return nil
+}
+
+// LockObject locks an object so only its owner (or an admin) can write, move,
+// or delete it. It requires the "lock" owner right, which location rights
+// (the builder role, co-location) do not grant. Only an owned object can be
+// locked; locking an already-locked object is a no-op and emits nothing.
+func (s *Service) LockObject(ctx context.Context, subjectID string, id ulid.ULID) error {
+	obj, err := s.ownedObject(ctx, subjectID, "lock", id, "OBJECT_LOCK_FAILED")
+	if err != nil {
+		return err
+	}
+	if obj.OwnerID == nil {
+		return oops.Code("OBJECT_NO_OWNER").
+			With("id", id.String()).
+			Errorf("object %s has no owner to hold the lock", id)
+	}
+	if obj.Locked {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_LOCK_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	prior := cloneObject(obj)
+	obj.Locked = true
+	payload, err := BuildObjectLockPayload(obj)
+	if err != nil {
+		return oops.Code("OBJECT_LOCK_FAILED").Wrapf(err, "build object lock payload %s", id)
+	}
+	intent := s.buildIntent(kindObjectLocked, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_LOCK_FAILED", "lock object")
+	}
+	s.invalidateObjectAccess(ctx, id)
+	if s.propertyEventsEnabled(ctx) {
+		s.notifyPropertyChanges(ctx, objectPropertyChanges(prior, obj)...)
+	}
+	return nil
+}
+
+// UnlockObject removes an object's lock. It requires the "unlock" owner
+// right. Unlocking an object that is not locked is a no-op and emits nothing.
+func (s *Service) UnlockObject(ctx context.Context, subjectID string, id ulid.ULID) error {
+	obj, err := s.ownedObject(ctx, subjectID, "unlock", id, "OBJECT_UNLOCK_FAILED")
+	if err != nil {
+		return err
+	}
+	if !obj.Locked {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_UNLOCK_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	prior := cloneObject(obj)
+	obj.Locked = false
+	payload, err := BuildObjectLockPayload(obj)
+	if err != nil {
+		return oops.Code("OBJECT_UNLOCK_FAILED").Wrapf(err, "build object unlock payload %s", id)
+	}
+	intent := s.buildIntent(kindObjectUnlocked, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_UNLOCK_FAILED", "unlock object")
+	}
+	s.invalidateObjectAccess(ctx, id)
+	if s.propertyEventsEnabled(ctx) {
+		s.notifyPropertyChanges(ctx, objectPropertyChanges(prior, obj)...)
+	}
+	return nil
+}
+
+// TransferOwnership makes newOwnerID (a character) the owner of an object. It
+// requires the "transfer" owner right. The lock state is kept, so a locked
+// object passes to its new owner still locked. Transferring to the current
+// owner is a no-op and emits nothing.
+func (s *Service) TransferOwnership(ctx context.Context, subjectID string, id, newOwnerID ulid.ULID) error {
+	if newOwnerID.IsZero() {
+		return oops.Code("OBJECT_INVALID").Errorf("new owner is required")
+	}
+	obj, err := s.ownedObject(ctx, subjectID, "transfer", id, "OBJECT_TRANSFER_FAILED")
+	if err != nil {
+		return err
+	}
+	if obj.OwnerID != nil && *obj.OwnerID == newOwnerID {
+		return nil
+	}
+	if s.characterRepo != nil {
+		if _, err := s.characterRepo.Get(ctx, newOwnerID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return oops.Code("OBJECT_INVALID").
+					With("owner_id", newOwnerID.String()).
+					Wrapf(err, "new owner %s not found", newOwnerID)
+			}
+			return oops.Code("OBJECT_TRANSFER_FAILED").Wrapf(err, "get new owner %s", newOwnerID)
+		}
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_TRANSFER_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildObjectOwnershipPayload(id, obj.OwnerID, newOwnerID)
+	if err != nil {
+		return oops.Code("OBJECT_TRANSFER_FAILED").Wrapf(err, "build object ownership payload %s", id)
+	}
+	prior := cloneObject(obj)
+	obj.OwnerID = &newOwnerID
+	intent := s.buildIntent(kindObjectOwnershipTransferred, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_TRANSFER_FAILED", "transfer object")
+	}
+	s.invalidateObjectAccess(ctx, id)
+	if s.propertyEventsEnabled(ctx) {
+		s.notifyPropertyChanges(ctx, objectPropertyChanges(prior, obj)...)
+	}
+	return nil
+}
+
+// DefineObjectVerb adds verb to an object, or replaces the object's verb of
+// the same name. It requires the "define_verb" action on the object.
+// Returns OBJECT_VERB_INVALID for a malformed verb and OBJECT_VERB_LIMIT
+// when the object already carries MaxObjectVerbs verbs.
+func (s *Service) DefineObjectVerb(ctx context.Context, subjectID string, id ulid.ULID, verb ObjectVerb) error {
+	if err := verb.Validate(); err != nil {
+		return oops.Code("OBJECT_VERB_INVALID").With("id", id.String()).Wrap(err)
+	}
+	obj, err := s.ownedObject(ctx, subjectID, ActionDefineVerb, id, "OBJECT_VERB_DEFINE_FAILED")
+	if err != nil {
+		return err
+	}
+	i := slices.IndexFunc(obj.Verbs, func(v ObjectVerb) bool { return v.Name == verb.Name })
+	if i >= 0 && obj.Verbs[i] == verb {
+		return nil
+	}
+	if i < 0 && len(obj.Verbs) >= MaxObjectVerbs {
+		return oops.Code("OBJECT_VERB_LIMIT").
+			With("id", id.String()).
+			With("max", MaxObjectVerbs).
+			Errorf("object %s already has %d verbs", id, MaxObjectVerbs)
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_VERB_DEFINE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildObjectVerbPayload(id, verb)
+	if err != nil {
+		return oops.Code("OBJECT_VERB_DEFINE_FAILED").Wrapf(err, "build object verb payload %s", id)
+	}
+	verbs := slices.Clone(obj.Verbs)
+	if i >= 0 {
+		verbs[i] = verb
+	} else {
+		verbs = append(verbs, verb)
+	}
+	obj.Verbs = verbs
+	intent := s.buildIntent(kindObjectVerbDefined, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_VERB_DEFINE_FAILED", "define object verb")
+	}
+	return nil
+}
+
+// RemoveObjectVerb removes the verb named name from an object. It requires
+// the "define_verb" action on the object. Returns OBJECT_VERB_NOT_FOUND when
+// the object has no such verb.
+func (s *Service) RemoveObjectVerb(ctx context.Context, subjectID string, id ulid.ULID, name string) error {
+	if err := ValidateVerbName(name); err != nil {
+		return oops.Code("OBJECT_VERB_INVALID").With("id", id.String()).Wrap(err)
+	}
+	obj, err := s.ownedObject(ctx, subjectID, ActionDefineVerb, id, "OBJECT_VERB_REMOVE_FAILED")
+	if err != nil {
+		return err
+	}
+	verb, ok := obj.Verb(name)
+	if !ok {
+		return oops.Code("OBJECT_VERB_NOT_FOUND").
+			With("id", id.String()).
+			With("verb", name).
+			Errorf("object %s has no verb %q", id, name)
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_VERB_REMOVE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildObjectVerbPayload(id, verb)
+	if err != nil {
+		return oops.Code("OBJECT_VERB_REMOVE_FAILED").Wrapf(err, "build object verb payload %s", id)
+	}
+	obj.Verbs = slices.DeleteFunc(slices.Clone(obj.Verbs), func(v ObjectVerb) bool { return v.Name == name })
+	intent := s.buildIntent(kindObjectVerbRemoved, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_VERB_REMOVE_FAILED", "remove object verb")
+	}
+	return nil
+}
+
+// WearObject puts on an object its holder is carrying, so its wear
+// description joins the holder's description. It requires the "wear" action
+// on the object. Wearing an object already worn is a no-op. Returns
+// OBJECT_NOT_HELD when no character holds the object.
+func (s *Service) WearObject(ctx context.Context, subjectID string, id ulid.ULID) error {
+	obj, err := s.ownedObject(ctx, subjectID, ActionWear, id, "OBJECT_WEAR_FAILED")
+	if err != nil {
+		return err
+	}
+	holder := obj.HeldByCharacterID()
+	if holder == nil {
+		return oops.Code("OBJECT_NOT_HELD").
+			With("id", id.String()).
+			Errorf("object %s is not held by a character", id)
+	}
+	if obj.Worn() {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_WEAR_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildObjectWearPayload(id, *holder)
+	if err != nil {
+		return oops.Code("OBJECT_WEAR_FAILED").Wrapf(err, "build object wear payload %s", id)
+	}
+	obj.WornAt = time.Now().UTC()
+	intent := s.buildIntent(kindObjectWorn, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_WEAR_FAILED", "wear object")
+	}
+	return nil
+}
 
+// TakeOffObject takes off a worn object; its holder keeps carrying it. It
+// requires the "wear" action on the object. Returns OBJECT_NOT_WORN when the
+// object is not being worn.
+func (s *Service) TakeOffObject(ctx context.Context, subjectID string, id ulid.ULID) error {
+	obj, err := s.ownedObject(ctx, subjectID, ActionWear, id, "OBJECT_TAKE_OFF_FAILED")
+	if err != nil {
+		return err
+	}
+	if !obj.Worn() {
+		return oops.Code("OBJECT_NOT_WORN").
+			With("id", id.String()).
+			Errorf("object %s is not worn", id)
+	}
+	if s.mutator == nil {
+		return oops.Code("OBJECT_TAKE_OFF_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildObjectWearPayload(id, *obj.HeldByCharacterID())
+	if err != nil {
+		return oops.Code("OBJECT_TAKE_OFF_FAILED").Wrapf(err, "build object wear payload %s", id)
+	}
+	obj.WornAt = time.Time{}
+	intent := s.buildIntent(kindObjectTakenOff, wmodel.AggregateObject, id, subjectID, payload)
+	if _, err := s.mutator.updateObject(ctx, intent, obj); err != nil {
+		return objectWriteError(err, id, "OBJECT_TAKE_OFF_FAILED", "take off object")
+	}
 	return nil
 }
 
+// ownedObject checks an object right (lock, unlock, transfer, or a verb
+// action) on an object and reads it. failCode labels infrastructure failures.
+func (s *Service) ownedObject(ctx context.Context, subjectID, action string, id ulid.ULID, failCode string) (*Object, error) {
+	if s.objectRepo == nil {
+		return nil, oops.Code(failCode).Errorf("object repository not configured")
+	}
+	if err := s.checkAccess(ctx, subjectID, action, access.ObjectResource(id.String()), prefixObject); err != nil {
+		return nil, err
+	}
+	obj, err := s.objectRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, oops.Code("OBJECT_NOT_FOUND").Wrapf(err, "get object %s", id)
+		}
+		return nil, oops.Code(failCode).Wrapf(err, "get object %s", id)
+	}
+	return obj, nil
+}
+
+// lockedObjectDenial reports a write/delete denial as OBJECT_LOCKED when the
+// object's lock is what blocked it, so callers can tell "this is locked" from
+// "you may never touch this". It only does so for subjects allowed to read the
+// object; anything else is returned unchanged.
+func (s *Service) lockedObjectDenial(ctx context.Context, subjectID string, id ulid.ULID, denial error) error {
+	if !errors.Is(denial, ErrPermissionDenied) || s.objectRepo == nil {
+		return denial
+	}
+	if s.checkAccess(ctx, subjectID, "read", access.ObjectResource(id.String()), prefixObject) != nil {
+		return denial
+	}
+	obj, err := s.objectRepo.Get(ctx, id)
+	if err != nil || !obj.Locked {
+		return denial
+	}
+	return oops.Code(CodeObjectLocked).
+		With("id", id.String()).
+		Wrapf(errors.Join(ErrObjectLocked, ErrPermissionDenied), "object %s is locked by its owner", id)
+}
+
+// objectWriteError classifies an owner-right object write failure.
+func objectWriteError(err error, id ulid.ULID, failCode, op string) error {
+	if errors.Is(err, ErrConcurrentEdit) {
+		return oops.Code(CodeConcurrentEdit).With("id", id.String()).Wrap(err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return oops.Code("OBJECT_NOT_FOUND").Wrapf(err, "%s %s", op, id)
+	}
+	return oops.Code(failCode).Wrapf(err, "%s %s", op, id)
+}
+
 // DeleteCharacter deletes a character and its properties after checking delete authorization.
 // Both deletions occur in the same database transaction per spec (05-storage-audit.md §110-119).
 // Returns an error if PropertyRepo or Transactor are not configured.
@@ -773,6 +1366,59 @@ func (s *Service) DeleteCharacter(ctx context.Context, subjectID string, id ulid
 		}
 		return oops.Code("CHARACTER_DELETE_FAILED").Wrapf(err, "delete character %s", id)
 	}
+	s.invalidateCharacterAccess(ctx, id)
+	return nil
+}
+
+// AnonymizeCharacter replaces a character's identity with a tombstone after
+// checking delete authorization: the name becomes tombstoneName, the
+// description and location are cleared, and its properties are removed, all
+// with one character_anonymized envelope in the same transaction. Unlike
+// DeleteCharacter the row survives, so the immutable event history the
+// character authored keeps a resolvable actor that no longer identifies the
+// player. Used by account deletion.
+func (s *Service) AnonymizeCharacter(ctx context.Context, subjectID string, characterID ulid.ULID, tombstoneName string) error {
+	if err := ValidateCharacterName(tombstoneName); err != nil {
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").With("character_id", characterID.String()).Wrap(err)
+	}
+	if s.characterRepo == nil {
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").Errorf("character repository not configured")
+	}
+	if s.propertyRepo == nil {
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").Errorf("property repository required for property cascade")
+	}
+	resource := access.CharacterResource(characterID.String())
+	if err := s.checkAccess(ctx, subjectID, "delete", resource, prefixCharacter); err != nil {
+		return err
+	}
+	char, err := s.characterRepo.Get(ctx, characterID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "get character %s", characterID)
+		}
+		return oops.Code("CHARACTER_GET_FAILED").Wrapf(err, "get character %s", characterID)
+	}
+	if s.mutator == nil {
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	char.Name = tombstoneName
+	char.Description = ""
+	char.LocationID = nil
+	payload, err := BuildCharacterAnonymizePayload(characterID, tombstoneName)
+	if err != nil {
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").Wrapf(err, "build character anonymize payload %s", characterID)
+	}
+	intent := s.buildIntent(kindCharacterAnonymized, wmodel.AggregateCharacter, characterID, subjectID, payload)
+	if _, err := s.mutator.anonymizeCharacter(ctx, intent, char); err != nil {
+		if errors.Is(err, ErrConcurrentEdit) {
+			return oops.Code(CodeConcurrentEdit).With("character_id", characterID.String()).Wrap(err)
+		}
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "anonymize character %s", characterID)
+		}
+		return oops.Code("CHARACTER_ANONYMIZE_FAILED").Wrapf(err, "anonymize character %s", characterID)
+	}
+	s.invalidateCharacterAccess(ctx, characterID)
 	return nil
 }
 
@@ -814,6 +1460,7 @@ func (s *Service) UpdateCharacterDescription(ctx context.Context, subjectID stri
 	if s.mutator == nil {
 		return oops.Code("CHARACTER_UPDATE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
 	}
+	oldDescription := char.Description
 	char.Description = description
 	payload, err := BuildCharacterUpdatePayload(characterID, description)
 	if err != nil {
@@ -832,9 +1479,177 @@ func (s *Service) UpdateCharacterDescription(ctx context.Context, subjectID stri
 		}
 		return oops.Code("CHARACTER_UPDATE_FAILED").Wrapf(err, "update character %s", characterID)
 	}
+	if s.propertyEventsEnabled(ctx) {
+		s.notifyPropertyChanges(ctx, PropertyChange{
+			ParentType: "character", ParentID: characterID, Key: PropertyKeyDescription,
+			OldValue: oldDescription, NewValue: description, LocationID: char.LocationID,
+		})
+	}
+	return nil
+}
+
+// SetCharacterVisibility changes a character's visibility after checking
+// the set_visibility action on the character, and emits one
+// character_visibility_changed envelope in the same transaction. Setting the
+// visibility a character already has is a no-op.
+func (s *Service) SetCharacterVisibility(ctx context.Context, subjectID string, characterID ulid.ULID, v CharacterVisibility) error {
+	if err := v.Validate(); err != nil {
+		return oops.Code("CHARACTER_INVALID_VISIBILITY").With("visibility", string(v)).Wrap(err)
+	}
+	if s.characterRepo == nil {
+		return oops.Code("CHARACTER_UPDATE_FAILED").Errorf("character repository not configured")
+	}
+	resource := access.CharacterResource(characterID.String())
+	if err := s.checkAccess(ctx, subjectID, ActionSetVisibility, resource, prefixCharacter); err != nil {
+		return err
+	}
+	char, err := s.characterRepo.Get(ctx, characterID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "get character %s", characterID)
+		}
+		return oops.Code("CHARACTER_GET_FAILED").Wrapf(err, "get character %s", characterID)
+	}
+	if char.Visibility.Normalize() == v {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("CHARACTER_UPDATE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	char.Visibility = v
+	payload, err := BuildCharacterVisibilityPayload(characterID, v)
+	if err != nil {
+		return oops.Code("CHARACTER_UPDATE_FAILED").Wrapf(err, "build character visibility payload %s", characterID)
+	}
+	intent := s.buildIntent(kindCharacterVisibilityChanged, wmodel.AggregateCharacter, characterID, subjectID, payload)
+	if _, err := s.mutator.updateCharacter(ctx, intent, char); err != nil {
+		if errors.Is(err, ErrConcurrentEdit) {
+			return oops.Code(CodeConcurrentEdit).With("character_id", characterID.String()).Wrap(err)
+		}
+		if errors.Is(err, ErrNotFound) {
+			return oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "update character %s", characterID)
+		}
+		return oops.Code("CHARACTER_UPDATE_FAILED").Wrapf(err, "update character %s", characterID)
+	}
+	s.invalidateCharacterAccess(ctx, characterID)
+	return nil
+}
+
+// ApplyDescriptionEffect adds a timed effect to a character's description,
+// or replaces the character's effect of the same name. It requires the
+// apply_effect action on the character. The effect is stamped with
+// subjectID and the current time, and expired effects are pruned in the same
+// write. Returns DESCRIPTION_EFFECT_INVALID for a malformed or already
+// expired effect and DESCRIPTION_EFFECT_LIMIT when the character already
+// carries MaxDescriptionEffects active effects.
+func (s *Service) ApplyDescriptionEffect(ctx context.Context, subjectID string, characterID ulid.ULID, effect DescriptionEffect) error {
+	now := time.Now().UTC()
+	if err := effect.Validate(); err != nil {
+		return oops.Code("DESCRIPTION_EFFECT_INVALID").With("character_id", characterID.String()).Wrap(err)
+	}
+	if !effect.Active(now) {
+		return oops.Code("DESCRIPTION_EFFECT_INVALID").
+			With("character_id", characterID.String()).
+			Wrap(&ValidationError{Field: "expires_at", Message: "must be in the future"})
+	}
+	char, err := s.effectTarget(ctx, subjectID, characterID, "DESCRIPTION_EFFECT_APPLY_FAILED")
+	if err != nil {
+		return err
+	}
+	effects := char.ActiveEffects(now)
+	i := slices.IndexFunc(effects, func(e DescriptionEffect) bool { return e.Name == effect.Name })
+	if i < 0 && len(effects) >= MaxDescriptionEffects {
+		return oops.Code("DESCRIPTION_EFFECT_LIMIT").
+			With("character_id", characterID.String()).
+			With("max", MaxDescriptionEffects).
+			Errorf("character %s already has %d effects", characterID, MaxDescriptionEffects)
+	}
+	if s.mutator == nil {
+		return oops.Code("DESCRIPTION_EFFECT_APPLY_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	effect.AppliedBy = subjectID
+	effect.AppliedAt = now
+	if i >= 0 {
+		effects = slices.Delete(effects, i, i+1)
+	}
+	char.Effects = append(effects, effect)
+	payload, err := BuildCharacterEffectPayload(characterID, effect)
+	if err != nil {
+		return oops.Code("DESCRIPTION_EFFECT_APPLY_FAILED").Wrapf(err, "build character effect payload %s", characterID)
+	}
+	intent := s.buildIntent(kindCharacterEffectApplied, wmodel.AggregateCharacter, characterID, subjectID, payload)
+	if _, err := s.mutator.updateCharacter(ctx, intent, char); err != nil {
+		return characterEffectWriteError(err, characterID, "DESCRIPTION_EFFECT_APPLY_FAILED", "apply effect")
+	}
+	return nil
+}
+
+// RemoveDescriptionEffect removes the effect named name from a character's
+// description before it expires. It requires the apply_effect action on the
+// character. Returns DESCRIPTION_EFFECT_NOT_FOUND when the character has no
+// such active effect.
+func (s *Service) RemoveDescriptionEffect(ctx context.Context, subjectID string, characterID ulid.ULID, name string) error {
+	if err := ValidateEffectName(name); err != nil {
+		return oops.Code("DESCRIPTION_EFFECT_INVALID").With("character_id", characterID.String()).Wrap(err)
+	}
+	char, err := s.effectTarget(ctx, subjectID, characterID, "DESCRIPTION_EFFECT_REMOVE_FAILED")
+	if err != nil {
+		return err
+	}
+	effects := char.ActiveEffects(time.Now().UTC())
+	i := slices.IndexFunc(effects, func(e DescriptionEffect) bool { return e.Name == name })
+	if i < 0 {
+		return oops.Code("DESCRIPTION_EFFECT_NOT_FOUND").
+			With("character_id", characterID.String()).
+			With("effect", name).
+			Errorf("character %s has no effect %q", characterID, name)
+	}
+	if s.mutator == nil {
+		return oops.Code("DESCRIPTION_EFFECT_REMOVE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildCharacterEffectPayload(characterID, effects[i])
+	if err != nil {
+		return oops.Code("DESCRIPTION_EFFECT_REMOVE_FAILED").Wrapf(err, "build character effect payload %s", characterID)
+	}
+	char.Effects = slices.Delete(effects, i, i+1)
+	intent := s.buildIntent(kindCharacterEffectRemoved, wmodel.AggregateCharacter, characterID, subjectID, payload)
+	if _, err := s.mutator.updateCharacter(ctx, intent, char); err != nil {
+		return characterEffectWriteError(err, characterID, "DESCRIPTION_EFFECT_REMOVE_FAILED", "remove effect")
+	}
 	return nil
 }
 
+// effectTarget checks apply_effect on a character and reads it. failCode
+// labels infrastructure failures.
+func (s *Service) effectTarget(ctx context.Context, subjectID string, characterID ulid.ULID, failCode string) (*Character, error) {
+	if s.characterRepo == nil {
+		return nil, oops.Code(failCode).Errorf("character repository not configured")
+	}
+	resource := access.CharacterResource(characterID.String())
+	if err := s.checkAccess(ctx, subjectID, ActionApplyEffect, resource, prefixCharacter); err != nil {
+		return nil, err
+	}
+	char, err := s.characterRepo.Get(ctx, characterID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "get character %s", characterID)
+		}
+		return nil, oops.Code(failCode).Wrapf(err, "get character %s", characterID)
+	}
+	return char, nil
+}
+
+// characterEffectWriteError classifies a description effect write failure.
+func characterEffectWriteError(err error, characterID ulid.ULID, failCode, op string) error {
+	if errors.Is(err, ErrConcurrentEdit) {
+		return oops.Code(CodeConcurrentEdit).With("character_id", characterID.String()).Wrap(err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return oops.Code("CHARACTER_NOT_FOUND").Wrapf(err, "%s %s", op, characterID)
+	}
+	return oops.Code(failCode).Wrapf(err, "%s %s", op, characterID)
+}
+
 // UpdateCharacterPreferences persists a character's whole preferences bag
 // (pre-marshaled JSONB) through the guarded/versioned/envelope world path — the
 // folded-in character-settings write (round-4 C5 / D-05). The former raw
@@ -925,24 +1740,28 @@ func (s *Service) buildPreferencesIntent(characterID ulid.ULID, prefs []byte) (w
 	}), nil
 }
 
-// GetCharactersByLocation retrieves characters at a location with pagination after checking list_characters authorization.
+// GetCharactersByLocation returns a page of the characters at a location, ordered by (name, id), after checking list_characters authorization.
+// Pass the returned Page.NextCursor as opts.Cursor to fetch the next page; a malformed cursor fails with WORLD_CURSOR_INVALID.
 // Note: This decomposes the legacy compound resource "location:<id>:characters" into
 // resource="location:<id>" with action="list_characters" per ADR #76 (Compound Resource Decomposition,
 // see docs/specs/2026-02-05-full-abac-design.md §7.3).
 // Error codes use LOCATION_* prefix (not CHARACTER_*) because the gated resource is the location.
-func (s *Service) GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts ListOptions) ([]*Character, error) {
+func (s *Service) GetCharactersByLocation(ctx context.Context, subjectID string, locationID ulid.ULID, opts ListOptions) (Page[*Character], error) {
 	if s.characterRepo == nil {
-		return nil, oops.Code("CHARACTER_QUERY_FAILED").Errorf("character repository not configured")
+		return Page[*Character]{}, oops.Code("CHARACTER_QUERY_FAILED").Errorf("character repository not configured")
 	}
 	resource := access.LocationResource(locationID.String())
 	if err := s.checkAccess(ctx, subjectID, "list_characters", resource, prefixLocation); err != nil {
-		return nil, err
+		return Page[*Character]{}, err
 	}
-	chars, err := s.characterRepo.GetByLocation(ctx, locationID, opts)
+	page, err := s.characterRepo.GetByLocation(ctx, locationID, opts)
 	if err != nil {
-		return nil, oops.Code("CHARACTER_QUERY_FAILED").Wrapf(err, "get characters by location %s", locationID)
+		if errors.Is(err, ErrInvalidCursor) {
+			return Page[*Character]{}, err
+		}
+		return Page[*Character]{}, oops.Code("CHARACTER_QUERY_FAILED").Wrapf(err, "get characters by location %s", locationID)
 	}
-	return chars, nil
+	return page, nil
 }
 
 // Round-5 D-07: AddSceneParticipant/RemoveSceneParticipant were removed — the
@@ -968,6 +1787,54 @@ func (s *Service) ListSceneParticipants(ctx context.Context, subjectID string, s
 	return participants, nil
 }
 
+// ListScenes lists scenes matching filter so players can browse scenes to
+// join. The repository pages first and the subject's read access is then
+// checked per scene with one batched evaluation; scenes the subject may not
+// read are omitted, so a page can hold fewer than opts.Limit scenes (even
+// none) while NextCursor still points past them. An evaluation failure on
+// any scene fails the whole call closed with SCENE_ACCESS_EVALUATION_FAILED.
+func (s *Service) ListScenes(ctx context.Context, subjectID string, filter SceneFilter, opts ListOptions) (Page[*Location], error) {
+	if s.sceneRepo == nil {
+		return Page[*Location]{}, oops.Code("SCENE_LIST_FAILED").Errorf("scene repository not configured")
+	}
+	if err := filter.Status.Validate(); err != nil {
+		return Page[*Location]{}, oops.Code("SCENE_INVALID_FILTER").With("status", string(filter.Status)).Wrap(err)
+	}
+	page, err := s.sceneRepo.ListScenes(ctx, filter, opts)
+	if err != nil {
+		if errors.Is(err, ErrInvalidCursor) {
+			return Page[*Location]{}, err
+		}
+		return Page[*Location]{}, oops.Code("SCENE_LIST_FAILED").Wrapf(err, "list scenes")
+	}
+	scenes := page.Items
+	if len(scenes) == 0 {
+		return Page[*Location]{Items: []*Location{}, NextCursor: page.NextCursor}, nil
+	}
+
+	reqs := make([]types.AccessRequest, len(scenes))
+	for i, scene := range scenes {
+		resource := access.SceneResource(scene.ID.String())
+		req, reqErr := types.NewAccessRequest(subjectID, "read", resource, nil)
+		if reqErr != nil {
+			return Page[*Location]{}, checkDecision(ctx, types.Decision{}, reqErr, subjectID, "read", resource, prefixScene)
+		}
+		reqs[i] = req
+	}
+
+	visible := make([]*Location, 0, len(scenes))
+	for i, result := range types.EvaluateBatch(ctx, s.engine, reqs) {
+		err := checkDecision(ctx, result.Decision, result.Err, subjectID, "read", reqs[i].Resource, prefixScene)
+		switch {
+		case err == nil:
+			visible = append(visible, scenes[i])
+		case !errors.Is(err, ErrPermissionDenied):
+			return Page[*Location]{}, err
+		}
+	}
+	return Page[*Location]{Items: visible, NextCursor: page.NextCursor}, nil
+}
+
 // MoveCharacter moves a character to a new location.
 //
 // The character move and its ONE move envelope commit in the SAME transaction via
@@ -982,6 +1849,9 @@ func (s *Service) ListSceneParticipants(ctx context.Context, subjectID string, s
 // command failure: the move and its envelope are already durable, so the hook error
 // is logged + counted and MoveCharacter returns SUCCESS (the session's derived
 // location may lag until re-sync — see MovementHook).
+//
+// A destination at its MaxOccupancy sends the character to its overflow
+// location instead, or fails with LOCATION_FULL; see AdmitCharacter.
 func (s *Service) MoveCharacter(ctx context.Context, subjectID string, characterID, toLocationID ulid.ULID) error {
 	if s.characterRepo == nil {
 		return oops.Code("CHARACTER_MOVE_FAILED").Errorf("character repository not configured")
@@ -1005,12 +1875,17 @@ func (s *Service) MoveCharacter(ctx context.Context, subjectID string, character
 	if s.locationRepo == nil {
 		return oops.Code("CHARACTER_MOVE_FAILED").Errorf("location repository not configured")
 	}
-	if _, locErr := s.locationRepo.Get(ctx, toLocationID); locErr != nil {
+	dest, locErr := s.locationRepo.Get(ctx, toLocationID)
+	if locErr != nil {
 		if errors.Is(locErr, ErrNotFound) {
 			return oops.Code("LOCATION_NOT_FOUND").Wrapf(locErr, "move character to location %s", toLocationID)
 		}
 		return oops.Code("CHARACTER_MOVE_FAILED").Wrapf(locErr, "verify destination location %s", toLocationID)
 	}
+	toLocationID, err = s.admitCharacter(ctx, subjectID, characterID, dest)
+	if err != nil {
+		return err
+	}
 
 	if s.mutator == nil {
 		return oops.Code("CHARACTER_MOVE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
@@ -1031,6 +1906,7 @@ func (s *Service) MoveCharacter(ctx context.Context, subjectID string, character
 		}
 		return oops.Code("CHARACTER_MOVE_FAILED").Wrapf(err, "update character %s location", characterID)
 	}
+	s.invalidateCharacterAccess(ctx, characterID)
 
 	// The state change AND its move envelope have now committed atomically. Fire the
 	// movement hook post-commit; a failure is operational degradation (log + metric,
@@ -1169,3 +2045,238 @@ func (s *Service) ListPropertiesByParent(ctx context.Context, subjectID, parentT
 	}
 	return visible, nil
 }
+
+// SetProperty sets the named property on an entity, creating it when the
+// entity does not carry it yet. Creating requires "write" on the parent
+// entity and records the subject's character as owner; changing an
+// existing property requires "write" on property:<id>. When the name has a
+// declared PropertySchema the value is validated and normalized first, so a
+// typed property never stores text its accessors cannot read. A nil value
+// clears the property to flag style. Setting the value it already holds is
+// a no-op and emits nothing.
+//
+// Returns PROPERTY_VALUE_INVALID for a value that does not match the
+// declared type.
+func (s *Service) SetProperty(ctx context.Context, subjectID, parentType string, parentID ulid.ULID, name string, value *string) error {
+	if s.propertyRepo == nil {
+		return oops.Code("PROPERTY_WRITE_FAILED").Errorf("property repository not configured")
+	}
+	if err := ValidateName(name); err != nil {
+		return oops.Code("PROPERTY_INVALID").With("name", name).Wrap(err)
+	}
+	value, err := s.normalizePropertyValue(name, value)
+	if err != nil {
+		return err
+	}
+	prop, create, err := s.authorizePropertyWrite(ctx, subjectID, parentType, parentID, name)
+	if err != nil {
+		return err
+	}
+	if !create && equalPropertyValue(prop.Value, value) {
+		return nil
+	}
+	prop.Value = value
+	if s.mutator == nil {
+		return oops.Code("PROPERTY_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	payload, err := BuildPropertySetPayload(prop)
+	if err != nil {
+		return oops.Code("PROPERTY_WRITE_FAILED").Wrapf(err, "build property payload %s", prop.ID)
+	}
+	intent := s.buildIntent(kindPropertySet, wmodel.AggregateProperty, prop.ID, subjectID, payload)
+	if _, err := s.mutator.setProperty(ctx, intent, prop, create); err != nil {
+		return oops.Code("PROPERTY_WRITE_FAILED").
+			With("parent_type", parentType).
+			With("parent_id", parentID.String()).
+			With("name", name).
+			Wrap(err)
+	}
+	return nil
+}
+
+// TagLocation adds a tag to a location. It requires "write" on the location
+// and on tag:<name>. Adding a tag the location already carries is a no-op
+// and emits nothing. Returns TAG_INVALID for a malformed tag and
+// TAG_LIMIT_EXCEEDED past MaxTagsPerEntity.
+func (s *Service) TagLocation(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.locationRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("location repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.LocationResource(id.String()), prefixLocation); err != nil {
+		return err
+	}
+	loc, err := s.locationRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "LOCATION_NOT_FOUND", "tag location")
+	}
+	tags, changed, err := withTag(loc.Tags, tag)
+	if err != nil || !changed {
+		return err
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindLocationTagged, wmodel.AggregateLocation, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setLocationTags(ctx, intent, id, tags, loc.Version); err != nil {
+		return tagWriteError(err, id, "LOCATION_NOT_FOUND", "tag location")
+	}
+	return nil
+}
+
+// UntagLocation removes a tag from a location. It requires "write" on the
+// location and on tag:<name>. Removing a tag the location does not carry is
+// a no-op and emits nothing.
+func (s *Service) UntagLocation(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.locationRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("location repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.LocationResource(id.String()), prefixLocation); err != nil {
+		return err
+	}
+	loc, err := s.locationRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "LOCATION_NOT_FOUND", "untag location")
+	}
+	tags, changed := withoutTag(loc.Tags, tag)
+	if !changed {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindLocationUntagged, wmodel.AggregateLocation, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setLocationTags(ctx, intent, id, tags, loc.Version); err != nil {
+		return tagWriteError(err, id, "LOCATION_NOT_FOUND", "untag location")
+	}
+	return nil
+}
+
+// TagObject adds a tag to an object. It requires "write" on the object and
+// on tag:<name>. Adding a tag the object already carries is a no-op and
+// emits nothing.
+func (s *Service) TagObject(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.objectRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("object repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.ObjectResource(id.String()), prefixObject); err != nil {
+		return err
+	}
+	obj, err := s.objectRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "OBJECT_NOT_FOUND", "tag object")
+	}
+	tags, changed, err := withTag(obj.Tags, tag)
+	if err != nil || !changed {
+		return err
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindObjectTagged, wmodel.AggregateObject, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setObjectTags(ctx, intent, id, tags, obj.Version); err != nil {
+		return tagWriteError(err, id, "OBJECT_NOT_FOUND", "tag object")
+	}
+	return nil
+}
+
+// UntagObject removes a tag from an object. It requires "write" on the
+// object and on tag:<name>. Removing a tag the object does not carry is a
+// no-op and emits nothing.
+func (s *Service) UntagObject(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.objectRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("object repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.ObjectResource(id.String()), prefixObject); err != nil {
+		return err
+	}
+	obj, err := s.objectRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "OBJECT_NOT_FOUND", "untag object")
+	}
+	tags, changed := withoutTag(obj.Tags, tag)
+	if !changed {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindObjectUntagged, wmodel.AggregateObject, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setObjectTags(ctx, intent, id, tags, obj.Version); err != nil {
+		return tagWriteError(err, id, "OBJECT_NOT_FOUND", "untag object")
+	}
+	return nil
+}
+
+// TagCharacter adds a tag to a character. It requires "write" on the
+// character and on tag:<name>. Adding a tag the character already carries
+// is a no-op and emits nothing.
+func (s *Service) TagCharacter(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.characterRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("character repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.CharacterResource(id.String()), prefixCharacter); err != nil {
+		return err
+	}
+	char, err := s.characterRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "CHARACTER_NOT_FOUND", "tag character")
+	}
+	tags, changed, err := withTag(char.Tags, tag)
+	if err != nil || !changed {
+		return err
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindCharacterTagged, wmodel.AggregateCharacter, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setCharacterTags(ctx, intent, id, tags, char.Version); err != nil {
+		return tagWriteError(err, id, "CHARACTER_NOT_FOUND", "tag character")
+	}
+	return nil
+}
+
+// UntagCharacter removes a tag from a character. It requires "write" on
+// the character and on tag:<name>. Removing a tag the character does not
+// carry is a no-op and emits nothing.
+func (s *Service) UntagCharacter(ctx context.Context, subjectID string, id ulid.ULID, tag string) error {
+	if s.characterRepo == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("character repository not configured")
+	}
+	if err := s.authorizeTagWrite(ctx, subjectID, tag, access.CharacterResource(id.String()), prefixCharacter); err != nil {
+		return err
+	}
+	char, err := s.characterRepo.Get(ctx, id)
+	if err != nil {
+		return tagWriteError(err, id, "CHARACTER_NOT_FOUND", "untag character")
+	}
+	tags, changed := withoutTag(char.Tags, tag)
+	if !changed {
+		return nil
+	}
+	if s.mutator == nil {
+		return oops.Code("TAG_WRITE_FAILED").Errorf("world write executor not configured (OutboxWriter + Transactor required)")
+	}
+	intent, err := s.tagIntent(kindCharacterUntagged, wmodel.AggregateCharacter, id, subjectID, tag)
+	if err != nil {
+		return err
+	}
+	if _, err := s.mutator.setCharacterTags(ctx, intent, id, tags, char.Version); err != nil {
+		return tagWriteError(err, id, "CHARACTER_NOT_FOUND", "untag character")
+	}
+	return nil
+}
@@ -485,6 +485,60 @@ func TestWorldService_UpdateCharacterDescription(t *testing.T) {
 	})
 }
 
+func TestWorldService_AnonymizeCharacter(t *testing.T) {
+	ctx := context.Background()
+	charID := ulid.Make()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	t.Run("scrubs the character, drops its properties, and emits one character_updated envelope", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockRepo := worldtest.NewMockCharacterRepository(t)
+		mockPropRepo := worldtest.NewMockPropertyRepository(t)
+		outbox := &mockOutboxWriter{}
+
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: mockRepo,
+			PropertyRepo:  mockPropRepo,
+			Engine:        engine,
+		}, outbox))
+
+		locID := ulid.Make()
+		stored := &world.Character{ID: charID, Name: "Alice", Description: "Tall.", LocationID: &locID, Version: 2}
+		engine.Grant(subjectID, "delete", access.CharacterResource(charID.String()))
+		mockRepo.EXPECT().Get(ctx, charID).Return(stored, nil)
+		mockPropRepo.EXPECT().DeleteByParent(mock.Anything, "character", charID).Return(nil)
+		mockRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(c *world.Character) bool {
+			return c.Version == 2 && c.Name == "Deleted Character" && c.Description == "" && c.LocationID == nil
+		})).Return(nil, nil)
+
+		require.NoError(t, svc.AnonymizeCharacter(ctx, subjectID, charID, "Deleted Character"))
+		require.Equal(t, 1, outbox.calls)
+		assert.Equal(t, "character_anonymized", outbox.lastIntent.Kind)
+		var payload world.CharacterAnonymizeChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.CharacterAnonymizeChangePayload{CharacterID: charID.String(), Name: "Deleted Character"}, payload)
+	})
+
+	t.Run("rejects an invalid tombstone name before the policy check", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t), Engine: policytest.DenyAllEngine(),
+		})
+		err := svc.AnonymizeCharacter(ctx, subjectID, charID, "")
+		errutil.AssertErrorCode(t, err, "CHARACTER_ANONYMIZE_FAILED")
+	})
+
+	t.Run("without delete the anonymization is denied", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			CharacterRepo: worldtest.NewMockCharacterRepository(t),
+			PropertyRepo:  worldtest.NewMockPropertyRepository(t),
+			Engine:        policytest.NewGrantEngine(),
+		}, &mockOutboxWriter{}))
+		err := svc.AnonymizeCharacter(ctx, subjectID, charID, "Deleted Character")
+		errutil.AssertErrorCode(t, err, "CHARACTER_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+}
+
 func TestWorldService_DeleteLocation(t *testing.T) {
 	ctx := context.Background()
 	locID := ulid.Make()
@@ -1433,6 +1487,89 @@ func TestWorldService_ListSceneParticipants(t *testing.T) {
 	})
 }
 
+func TestWorldService_ListScenes(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	locationID := ulid.Make()
+	open := &world.Location{ID: ulid.Make(), Type: world.LocationTypeScene, Name: "Open Scene"}
+	private := &world.Location{ID: ulid.Make(), Type: world.LocationTypeScene, Name: "Private Scene"}
+	filter := world.SceneFilter{Status: world.SceneStatusOpen, Location: &locationID}
+	opts := world.ListOptions{Limit: 10}
+
+	t.Run("omits scenes the subject cannot read", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{SceneRepo: mockSceneRepo, Engine: engine})
+
+		engine.Grant(subjectID, "read", access.SceneResource(open.ID.String()))
+		mockSceneRepo.EXPECT().ListScenes(ctx, filter, opts).Return(world.Page[*world.Location]{Items: []*world.Location{open, private}}, nil)
+
+		scenes, err := svc.ListScenes(ctx, subjectID, filter, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Location{open}, scenes.Items)
+	})
+
+	t.Run("keeps the cursor when every scene on the page is hidden", func(t *testing.T) {
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{SceneRepo: mockSceneRepo, Engine: policytest.NewGrantEngine()})
+
+		next := world.EncodeCursor("1", private.ID)
+		mockSceneRepo.EXPECT().ListScenes(ctx, filter, opts).
+			Return(world.Page[*world.Location]{Items: []*world.Location{private}, NextCursor: next}, nil)
+
+		scenes, err := svc.ListScenes(ctx, subjectID, filter, opts)
+		require.NoError(t, err)
+		assert.Empty(t, scenes.Items)
+		assert.Equal(t, next, scenes.NextCursor, "callers keep paging past a hidden page")
+	})
+
+	t.Run("returns empty slice when nothing matches", func(t *testing.T) {
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{SceneRepo: mockSceneRepo, Engine: policytest.NewGrantEngine()})
+
+		mockSceneRepo.EXPECT().ListScenes(ctx, world.SceneFilter{}, world.ListOptions{}).Return(world.Page[*world.Location]{}, nil)
+
+		scenes, err := svc.ListScenes(ctx, subjectID, world.SceneFilter{}, world.ListOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, scenes.Items)
+		assert.Empty(t, scenes.Items)
+	})
+
+	t.Run("rejects unknown status", func(t *testing.T) {
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{SceneRepo: mockSceneRepo, Engine: policytest.NewGrantEngine()})
+
+		_, err := svc.ListScenes(ctx, subjectID, world.SceneFilter{Status: "paused"}, opts)
+		errutil.AssertErrorCode(t, err, "SCENE_INVALID_FILTER")
+		assert.ErrorIs(t, err, world.ErrInvalidSceneStatus)
+		mockSceneRepo.AssertNotCalled(t, "ListScenes")
+	})
+
+	t.Run("fails closed when the engine errors", func(t *testing.T) {
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{
+			SceneRepo: mockSceneRepo,
+			Engine:    policytest.NewErrorEngine(errors.New("policy store unavailable")),
+		})
+
+		mockSceneRepo.EXPECT().ListScenes(ctx, filter, opts).Return(world.Page[*world.Location]{Items: []*world.Location{open}}, nil)
+
+		scenes, err := svc.ListScenes(ctx, subjectID, filter, opts)
+		assert.Empty(t, scenes.Items)
+		assert.ErrorIs(t, err, world.ErrAccessEvaluationFailed)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		mockSceneRepo := worldtest.NewMockSceneRepository(t)
+		svc := world.NewService(world.ServiceConfig{SceneRepo: mockSceneRepo, Engine: policytest.NewGrantEngine()})
+
+		mockSceneRepo.EXPECT().ListScenes(ctx, filter, opts).Return(world.Page[*world.Location]{}, errors.New("db down"))
+
+		_, err := svc.ListScenes(ctx, subjectID, filter, opts)
+		errutil.AssertErrorCode(t, err, "SCENE_LIST_FAILED")
+	})
+}
+
 // --- Input Validation Tests ---
 
 func TestWorldService_CreateLocationValidation(t *testing.T) {
@@ -1680,6 +1817,63 @@ func TestWorldService_CreateExitErrorPropagation(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "db error")
 	})
+
+	t.Run("surfaces a name conflict with the conflicting exit", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockExitRepo := worldtest.NewMockExitRepository(t)
+		outbox := &mockOutboxWriter{}
+
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			ExitRepo: mockExitRepo,
+			Engine:   engine,
+		}, outbox))
+
+		exit := &world.Exit{
+			FromLocationID: fromLocID,
+			ToLocationID:   toLocID,
+			Name:           "north",
+			Visibility:     world.VisibilityAll,
+		}
+		existingID := ulid.Make()
+
+		engine.Grant(subjectID, "write", "exit:*")
+		mockExitRepo.EXPECT().Create(ctx, mock.Anything).Return(nil, &world.ExitNameConflictError{
+			LocationID: fromLocID, Name: "north", ConflictingExitID: existingID, ConflictingExitName: "North",
+		})
+
+		err := svc.CreateExit(ctx, subjectID, exit)
+		errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+		assert.ErrorIs(t, err, world.ErrExitNameConflict)
+		var conflict *world.ExitNameConflictError
+		require.ErrorAs(t, err, &conflict)
+		assert.Equal(t, existingID, conflict.ConflictingExitID)
+		assert.Equal(t, 0, outbox.calls)
+	})
+}
+
+func TestWorldService_UpdateExitNameConflict(t *testing.T) {
+	ctx := context.Background()
+	exitID := ulid.Make()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	engine := policytest.NewGrantEngine()
+	mockExitRepo := worldtest.NewMockExitRepository(t)
+	outbox := &mockOutboxWriter{}
+
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		ExitRepo: mockExitRepo,
+		Engine:   engine,
+	}, outbox))
+
+	exit := &world.Exit{ID: exitID, Name: "north", Aliases: []string{"n"}, Visibility: world.VisibilityAll}
+
+	engine.Grant(subjectID, "write", "exit:"+exitID.String())
+	mockExitRepo.EXPECT().Update(ctx, exit).Return(nil, &world.ExitNameConflictError{Name: "n", ConflictingExitID: ulid.Make()})
+
+	err := svc.UpdateExit(ctx, subjectID, exit)
+	errutil.AssertErrorCode(t, err, world.CodeExitNameConflict)
+	assert.ErrorIs(t, err, world.ErrExitNameConflict)
+	assert.Equal(t, 0, outbox.calls)
 }
 
 func TestWorldService_GetObjectErrorPropagation(t *testing.T) {
@@ -1846,7 +2040,7 @@ func TestWorldService_GetExitsByLocation(t *testing.T) {
 		expectedExits := []*world.Exit{exit1, exit2}
 
 		engine.Grant(subjectID, "read", "location:"+locationID.String())
-		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID).Return(expectedExits, nil)
+		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID, mock.Anything).Return(world.Page[*world.Exit]{Items: expectedExits}, nil)
 
 		exits, err := svc.GetExitsByLocation(ctx, subjectID, locationID)
 		require.NoError(t, err)
@@ -1910,7 +2104,7 @@ func TestWorldService_GetExitsByLocation(t *testing.T) {
 
 		dbErr := errors.New("database connection failed")
 		engine.Grant(subjectID, "read", "location:"+locationID.String())
-		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID).Return(nil, dbErr)
+		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID, mock.Anything).Return(world.Page[*world.Exit]{}, dbErr)
 
 		exits, err := svc.GetExitsByLocation(ctx, subjectID, locationID)
 		assert.Nil(t, exits)
@@ -1928,7 +2122,7 @@ func TestWorldService_GetExitsByLocation(t *testing.T) {
 		})
 
 		engine.Grant(subjectID, "read", "location:"+locationID.String())
-		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID).Return([]*world.Exit{}, nil)
+		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID, mock.Anything).Return(world.Page[*world.Exit]{Items: []*world.Exit{}}, nil)
 
 		exits, err := svc.GetExitsByLocation(ctx, subjectID, locationID)
 		require.NoError(t, err)
@@ -1937,6 +2131,87 @@ func TestWorldService_GetExitsByLocation(t *testing.T) {
 	})
 }
 
+func TestWorldService_GetVisibleExits(t *testing.T) {
+	ctx := context.Background()
+	locationID := ulid.Make()
+	ownerID := ulid.Make()
+	viewerID := ulid.Make()
+	subjectID := access.CharacterSubject(viewerID.String())
+
+	public := &world.Exit{ID: ulid.Make(), Name: "north", Visibility: world.VisibilityAll}
+	ownerOnly := &world.Exit{ID: ulid.Make(), Name: "trapdoor", Visibility: world.VisibilityOwner}
+	listed := &world.Exit{ID: ulid.Make(), Name: "secret", Visibility: world.VisibilityList, VisibleTo: []ulid.ULID{viewerID}}
+
+	newSvc := func(t *testing.T, exits []*world.Exit) (*world.Service, *worldtest.MockLocationRepository) {
+		t.Helper()
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", "location:"+locationID.String())
+		mockExitRepo := worldtest.NewMockExitRepository(t)
+		mockExitRepo.EXPECT().ListFromLocation(ctx, locationID, mock.Anything).Return(world.Page[*world.Exit]{Items: exits}, nil)
+		mockLocRepo := worldtest.NewMockLocationRepository(t)
+		return world.NewService(world.ServiceConfig{
+			ExitRepo:     mockExitRepo,
+			LocationRepo: mockLocRepo,
+			Engine:       engine,
+		}), mockLocRepo
+	}
+
+	t.Run("filters exits to the observer's view", func(t *testing.T) {
+		svc, mockLocRepo := newSvc(t, []*world.Exit{public, ownerOnly, listed})
+		mockLocRepo.EXPECT().Get(ctx, locationID).Return(&world.Location{ID: locationID, OwnerID: &ownerID}, nil)
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, viewerID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Exit{public, listed}, exits)
+	})
+
+	t.Run("location owner sees owner-only exits", func(t *testing.T) {
+		svc, mockLocRepo := newSvc(t, []*world.Exit{public, ownerOnly})
+		mockLocRepo.EXPECT().Get(ctx, locationID).Return(&world.Location{ID: locationID, OwnerID: &ownerID}, nil)
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Exit{public, ownerOnly}, exits)
+	})
+
+	t.Run("skips the owner lookup when no owner-only exit is present", func(t *testing.T) {
+		svc, mockLocRepo := newSvc(t, []*world.Exit{public, listed})
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, viewerID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Exit{public, listed}, exits)
+		mockLocRepo.AssertNotCalled(t, "Get")
+	})
+
+	t.Run("hides owner-only exits when the owner lookup fails", func(t *testing.T) {
+		svc, mockLocRepo := newSvc(t, []*world.Exit{public, ownerOnly})
+		mockLocRepo.EXPECT().Get(ctx, locationID).Return(nil, errors.New("db down"))
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, ownerID)
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Exit{public}, exits)
+	})
+
+	t.Run("zero observer sees only public exits", func(t *testing.T) {
+		svc, _ := newSvc(t, []*world.Exit{public, ownerOnly, listed})
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, ulid.ULID{})
+		require.NoError(t, err)
+		assert.Equal(t, []*world.Exit{public}, exits)
+	})
+
+	t.Run("propagates the read denial", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			ExitRepo: worldtest.NewMockExitRepository(t),
+			Engine:   policytest.NewGrantEngine(),
+		})
+
+		exits, err := svc.GetVisibleExits(ctx, subjectID, locationID, viewerID)
+		assert.Nil(t, exits)
+		errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_DENIED")
+	})
+}
+
 // --- Update Method Validation Tests ---
 
 func TestWorldService_UpdateLocationValidation(t *testing.T) {
@@ -3782,11 +4057,11 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		expectedChars := []*world.Character{char1, char2}
 
 		engine.Grant(subjectID, "list_characters", "location:"+locationID.String())
-		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(expectedChars, nil)
+		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(world.Page[*world.Character]{Items: expectedChars}, nil)
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
 		require.NoError(t, err)
-		assert.Equal(t, expectedChars, chars)
+		assert.Equal(t, expectedChars, chars.Items)
 	})
 
 	t.Run("returns permission denied when not authorized", func(t *testing.T) {
@@ -3799,7 +4074,7 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		})
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		assert.ErrorIs(t, err, world.ErrPermissionDenied)
 		errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_DENIED")
 	})
@@ -3814,7 +4089,7 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		})
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		require.Error(t, err)
 		errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_EVALUATION_FAILED")
 		assert.ErrorIs(t, err, world.ErrAccessEvaluationFailed)
@@ -3830,7 +4105,7 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		})
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, world.ErrAccessEvaluationFailed,
 			"infrastructure failure should return ErrAccessEvaluationFailed")
@@ -3848,7 +4123,7 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		})
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		require.Error(t, err)
 		errutil.AssertErrorCode(t, err, "CHARACTER_QUERY_FAILED")
 	})
@@ -3864,10 +4139,10 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 
 		dbErr := errors.New("database connection failed")
 		engine.Grant(subjectID, "list_characters", "location:"+locationID.String())
-		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(nil, dbErr)
+		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(world.Page[*world.Character]{}, dbErr)
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
-		assert.Nil(t, chars)
+		assert.Empty(t, chars.Items)
 		require.Error(t, err)
 		errutil.AssertErrorCode(t, err, "CHARACTER_QUERY_FAILED")
 	})
@@ -3882,11 +4157,11 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 		})
 
 		engine.Grant(subjectID, "list_characters", "location:"+locationID.String())
-		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return([]*world.Character{}, nil)
+		mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(world.Page[*world.Character]{Items: []*world.Character{}}, nil)
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
 		require.NoError(t, err)
-		assert.Empty(t, chars)
+		assert.Empty(t, chars.Items)
 	})
 
 	t.Run("passes pagination options to repository", func(t *testing.T) {
@@ -3898,15 +4173,37 @@ func TestWorldService_GetCharactersByLocation(t *testing.T) {
 			Engine:        engine,
 		})
 
-		opts := world.ListOptions{Limit: 10, Offset: 5}
+		opts := world.ListOptions{Limit: 10, Cursor: world.EncodeCursor("Alice", ulid.Make())}
 		expectedChars := []*world.Character{{ID: ulid.Make(), Name: "Char1"}}
+		next := world.EncodeCursor("Char1", expectedChars[0].ID)
 
 		engine.Grant(subjectID, "list_characters", "location:"+locationID.String())
-		mockRepo.EXPECT().GetByLocation(ctx, locationID, opts).Return(expectedChars, nil)
+		mockRepo.EXPECT().GetByLocation(ctx, locationID, opts).
+			Return(world.Page[*world.Character]{Items: expectedChars, NextCursor: next}, nil)
 
 		chars, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, opts)
 		require.NoError(t, err)
-		assert.Equal(t, expectedChars, chars)
+		assert.Equal(t, expectedChars, chars.Items)
+		assert.Equal(t, next, chars.NextCursor)
+	})
+
+	t.Run("passes invalid cursor errors through", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockRepo := worldtest.NewMockCharacterRepository(t)
+
+		svc := world.NewService(world.ServiceConfig{
+			CharacterRepo: mockRepo,
+			Engine:        engine,
+		})
+
+		opts := world.ListOptions{Cursor: "garbage"}
+		_, _, cursorErr := world.DecodeCursor(opts.Cursor)
+		engine.Grant(subjectID, "list_characters", "location:"+locationID.String())
+		mockRepo.EXPECT().GetByLocation(ctx, locationID, opts).Return(world.Page[*world.Character]{}, cursorErr)
+
+		_, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, opts)
+		assert.ErrorIs(t, err, world.ErrInvalidCursor)
+		errutil.AssertErrorCode(t, err, world.CodeInvalidCursor)
 	})
 }
 
@@ -3932,7 +4229,7 @@ func TestWorldService_GetCharactersByLocation_UsesDecomposedResource(t *testing.
 		return true
 	})).Return(types.NewDecision(types.EffectAllow, "test", ""), nil)
 
-	mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(expectedChars, nil)
+	mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(world.Page[*world.Character]{Items: expectedChars}, nil)
 
 	_, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
 	require.NoError(t, err)
@@ -3966,7 +4263,7 @@ func TestWorldService_GetCharactersByLocation_VerifiesAccessRequest(t *testing.T
 		return true
 	})).Return(types.NewDecision(types.EffectAllow, "test", ""), nil)
 
-	mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(expectedChars, nil)
+	mockRepo.EXPECT().GetByLocation(ctx, locationID, world.ListOptions{}).Return(world.Page[*world.Character]{Items: expectedChars}, nil)
 
 	_, err := svc.GetCharactersByLocation(ctx, subjectID, locationID, world.ListOptions{})
 	require.NoError(t, err)
@@ -5280,7 +5577,7 @@ func TestWorldService_GetExitsByLocation_VerifiesAccessRequest(t *testing.T) {
 		return true
 	})).Return(types.NewDecision(types.EffectAllow, "test", ""), nil)
 
-	mockRepo.EXPECT().ListFromLocation(ctx, locationID).Return(expectedExits, nil)
+	mockRepo.EXPECT().ListFromLocation(ctx, locationID, mock.Anything).Return(world.Page[*world.Exit]{Items: expectedExits}, nil)
 
 	_, err := svc.GetExitsByLocation(ctx, subjectID, locationID)
 	require.NoError(t, err)
@@ -6536,3 +6833,97 @@ func TestWorldService_UpdateCharacterPreferences(t *testing.T) {
 		errutil.AssertErrorCode(t, err, "CHARACTER_PREFERENCES_UPDATE_FAILED")
 	})
 }
+
+// batchGrantEngine is a GrantEngine that also implements types.BatchEvaluator
+// and counts batch calls.
+type batchGrantEngine struct {
+	*policytest.GrantEngine
+	batches int
+}
+
+func (e *batchGrantEngine) EvaluateBatch(ctx context.Context, reqs []types.AccessRequest) []types.BatchResult {
+	e.batches++
+	results := make([]types.BatchResult, len(reqs))
+	for i, req := range reqs {
+		results[i].Decision, results[i].Err = e.Evaluate(ctx, req)
+	}
+	return results
+}
+
+func TestWorldService_GetLocations(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	readable1, readable2, denied, missing := ulid.Make(), ulid.Make(), ulid.Make(), ulid.Make()
+
+	t.Run("batches access and lookup, omitting denied and missing", func(t *testing.T) {
+		engine := &batchGrantEngine{GrantEngine: policytest.NewGrantEngine()}
+		mockRepo := worldtest.NewMockLocationRepository(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: mockRepo, Engine: engine})
+
+		for _, id := range []ulid.ULID{readable1, readable2, missing} {
+			engine.Grant(subjectID, "read", access.LocationResource(id.String()))
+		}
+		// The repository returns rows in arbitrary order.
+		mockRepo.EXPECT().GetMany(ctx, []ulid.ULID{readable2, readable1, missing}).
+			Return([]*world.Location{{ID: readable1, Name: "One"}, {ID: readable2, Name: "Two"}}, nil).Once()
+
+		locs, err := svc.GetLocations(ctx, subjectID, []ulid.ULID{readable2, denied, readable1, readable2, missing})
+		require.NoError(t, err)
+		require.Len(t, locs, 2)
+		assert.Equal(t, readable2, locs[0].ID)
+		assert.Equal(t, readable1, locs[1].ID)
+		assert.Equal(t, 1, engine.batches)
+		mockRepo.AssertNotCalled(t, "Get")
+	})
+
+	t.Run("falls back to per-request evaluation without a batch API", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockRepo := worldtest.NewMockLocationRepository(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: mockRepo, Engine: engine})
+
+		engine.Grant(subjectID, "read", access.LocationResource(readable1.String()))
+		mockRepo.EXPECT().GetMany(ctx, []ulid.ULID{readable1}).
+			Return([]*world.Location{{ID: readable1}}, nil).Once()
+
+		locs, err := svc.GetLocations(ctx, subjectID, []ulid.ULID{denied, readable1})
+		require.NoError(t, err)
+		require.Len(t, locs, 1)
+		assert.Equal(t, readable1, locs[0].ID)
+	})
+
+	t.Run("skips the query when nothing is readable", func(t *testing.T) {
+		mockRepo := worldtest.NewMockLocationRepository(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: mockRepo, Engine: policytest.DenyAllEngine()})
+
+		locs, err := svc.GetLocations(ctx, subjectID, []ulid.ULID{denied})
+		require.NoError(t, err)
+		assert.Empty(t, locs)
+		mockRepo.AssertNotCalled(t, "GetMany")
+	})
+
+	t.Run("fails closed on evaluation failure", func(t *testing.T) {
+		mockRepo := worldtest.NewMockLocationRepository(t)
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: mockRepo,
+			Engine:       policytest.NewErrorEngine(errors.New("policy store unavailable")),
+		})
+
+		locs, err := svc.GetLocations(ctx, subjectID, []ulid.ULID{readable1})
+		assert.Nil(t, locs)
+		assert.ErrorIs(t, err, world.ErrAccessEvaluationFailed)
+		errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_EVALUATION_FAILED")
+		mockRepo.AssertNotCalled(t, "GetMany")
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		mockRepo := worldtest.NewMockLocationRepository(t)
+		svc := world.NewService(world.ServiceConfig{LocationRepo: mockRepo, Engine: engine})
+
+		engine.Grant(subjectID, "read", access.LocationResource(readable1.String()))
+		mockRepo.EXPECT().GetMany(ctx, []ulid.ULID{readable1}).Return(nil, errors.New("db down"))
+
+		_, err := svc.GetLocations(ctx, subjectID, []ulid.ULID{readable1})
+		errutil.AssertErrorCode(t, err, "LOCATION_GET_FAILED")
+	})
+}
@@ -9,11 +9,15 @@ import (
 	"log/slog"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/oops"
 
 	"github.com/holomush/holomush/internal/access/policy/types"
 	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/internal/store"
 	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/invariant"
 	worldpostgres "github.com/holomush/holomush/internal/world/postgres"
+	"github.com/holomush/holomush/internal/world/worldcache"
 )
 
 // PoolProvider provides a database connection pool. Implemented by the
@@ -39,6 +43,28 @@ type WorldSubsystemConfig struct {
 	// the same value as the OutboxRelaySubsystem's GameID so the writer and
 	// the relay share one feed.
 	GameID func() string
+	// Cache enables the read-through world entity cache when non-nil. Nil
+	// leaves every world read going to Postgres.
+	Cache *worldcache.Config
+	// Replica routes world reads to a read replica when non-nil. Nil leaves
+	// every world read on the primary.
+	Replica *ReplicaConfig
+	// MaxNestingDepth caps how deeply objects nest inside containers. Zero
+	// uses worldpostgres.DefaultMaxNestingDepth.
+	MaxNestingDepth int
+	// Invariants checks world repository writes against the world model's
+	// invariants when non-nil. Nil checks nothing.
+	Invariants *invariant.Config
+}
+
+// ReplicaConfig configures read-replica routing for world repositories.
+type ReplicaConfig struct {
+	// URL is the replica's connection string.
+	URL string
+	// Routing bounds replica staleness; see worldpostgres.ReplicaRouter.
+	Routing worldpostgres.ReplicaConfig
+	// Pool tunes the replica's connection pool like the primary's.
+	Pool store.PoolConfig
 }
 
 // WorldSubsystem manages the WorldService and all world repositories.
@@ -46,6 +72,9 @@ type WorldSubsystem struct {
 	cfg        WorldSubsystemConfig
 	service    *world.Service
 	transactor world.Transactor
+	cache      *worldcache.Cache
+	replica    *pgxpool.Pool
+	router     *worldpostgres.ReplicaRouter
 }
 
 // NewWorldSubsystem creates a WorldSubsystem using the provided WorldSubsystemConfig.
@@ -54,6 +83,15 @@ func NewWorldSubsystem(cfg WorldSubsystemConfig) *WorldSubsystem {
 	return &WorldSubsystem{cfg: cfg}
 }
 
+// maxNestingDepth returns the configured object nesting limit, or
+// worldpostgres.DefaultMaxNestingDepth when none is set.
+func (s *WorldSubsystem) maxNestingDepth() int {
+	if s.cfg.MaxNestingDepth > 0 {
+		return s.cfg.MaxNestingDepth
+	}
+	return worldpostgres.DefaultMaxNestingDepth
+}
+
 // ID returns SubsystemWorld.
 func (s *WorldSubsystem) ID() lifecycle.SubsystemID { return lifecycle.SubsystemWorld }
 
@@ -76,26 +114,95 @@ func (s *WorldSubsystem) Prepare(ctx context.Context) error {
 		gameID = s.cfg.GameID()
 	}
 
-	transactor := worldpostgres.NewTransactor(pool)
+	var transactor world.Transactor = worldpostgres.NewTransactor(pool)
+	var (
+		locationRepo  world.LocationRepository  = worldpostgres.NewLocationRepository(pool)
+		exitRepo      world.ExitRepository      = worldpostgres.NewExitRepository(pool)
+		objectRepo    world.ObjectRepository    = worldpostgres.NewObjectRepositoryWithDepth(pool, s.maxNestingDepth())
+		sceneRepo     world.SceneRepository     = worldpostgres.NewSceneRepository(pool)
+		characterRepo world.CharacterRepository = worldpostgres.NewCharacterRepository(pool)
+	)
+	if s.cfg.Invariants != nil {
+		// Checks sit directly on Postgres so their re-reads run on the
+		// primary, never on a replica or the cache. The wrapped transactor
+		// holds them until the writer's transaction commits, so they read
+		// on their own connection.
+		monitor := invariant.New(*s.cfg.Invariants, invariant.Repositories{
+			Locations:  locationRepo,
+			Exits:      exitRepo,
+			Objects:    objectRepo,
+			Characters: characterRepo,
+		})
+		transactor = monitor.Transactor(transactor)
+		checked := monitor.Repositories()
+		locationRepo, exitRepo = checked.Locations, checked.Exits
+		objectRepo, characterRepo = checked.Objects, checked.Characters
+	}
+	if s.cfg.Replica != nil {
+		if s.replica == nil {
+			// The pool connects lazily; an unreachable replica only takes
+			// itself out of rotation, it does not fail startup.
+			replica, err := store.NewPool(ctx, s.cfg.Replica.URL, s.cfg.Replica.Pool)
+			if err != nil {
+				return oops.Code("WORLD_REPLICA_CONFIG_INVALID").Wrap(err)
+			}
+			s.replica = replica
+			s.router = worldpostgres.NewReplicaRouter(replica, s.cfg.Replica.Routing)
+		}
+		// Routing sits beneath the cache so cache misses are what reach the
+		// replica.
+		locationRepo = s.router.Locations(locationRepo)
+		exitRepo = s.router.Exits(exitRepo)
+		objectRepo = s.router.Objects(objectRepo)
+		sceneRepo = s.router.Scenes(sceneRepo)
+		characterRepo = s.router.Characters(characterRepo)
+	}
+	if s.cfg.Cache != nil {
+		// The cached repos and transactor MUST be used together: the wrapped
+		// transactor is what re-invalidates writes after commit.
+		s.cache = worldcache.New(*s.cfg.Cache)
+		transactor = s.cache.Transactor(transactor)
+		locationRepo = s.cache.Locations(locationRepo)
+		objectRepo = s.cache.Objects(objectRepo)
+		characterRepo = s.cache.Characters(characterRepo)
+	}
 
 	s.service = world.NewService(world.ServiceConfig{
-		LocationRepo:  worldpostgres.NewLocationRepository(pool),
-		ExitRepo:      worldpostgres.NewExitRepository(pool),
-		ObjectRepo:    worldpostgres.NewObjectRepository(pool),
-		SceneRepo:     worldpostgres.NewSceneRepository(pool),
-		CharacterRepo: worldpostgres.NewCharacterRepository(pool),
+		LocationRepo:  locationRepo,
+		ExitRepo:      exitRepo,
+		ObjectRepo:    objectRepo,
+		SceneRepo:     sceneRepo,
+		CharacterRepo: characterRepo,
 		PropertyRepo:  worldpostgres.NewPropertyRepository(pool),
 		Engine:        engine,
 		Transactor:    transactor,
 		// The production world.Service finally gets a real OutboxWriter (05-07):
 		// the postgres outbox store, replacing the dead no-emitter leg. The relay
 		// is a SEPARATE subsystem; the writer only persists the same-tx envelope.
-		OutboxWriter: worldpostgres.NewOutboxStore(pool),
-		GameID:       gameID,
+		OutboxWriter:        worldpostgres.NewOutboxStore(pool),
+		GameID:              gameID,
+		PropertySchemaStore: worldpostgres.NewPropertySchemaRepository(pool),
 	})
+	// Staff property type declarations are restored before plugins load so
+	// a plugin cannot claim a name staff already declared. A bad row only
+	// leaves its own name untyped.
+	if err := s.service.LoadPropertySchemas(ctx); err != nil {
+		slog.WarnContext(ctx, "some property schemas were not loaded", "error", err)
+	}
+	// Writes that change ABAC attributes retire the engine's cached
+	// decisions for the entities they touched. Engines without a decision
+	// cache (test doubles) do not implement the hook.
+	if inv, ok := engine.(world.AccessInvalidator); ok {
+		s.service.SetAccessInvalidator(inv)
+	}
+	// Builder changes are journaled so a mistaken delete or overwrite can be
+	// undone without restoring the database.
+	s.service.SetBuildJournal(world.NewBuildJournal(world.DefaultUndoWindow, world.DefaultJournalDepth))
 	s.transactor = transactor
 
-	slog.InfoContext(ctx, "world subsystem prepared")
+	slog.InfoContext(ctx, "world subsystem prepared",
+		"cache_enabled", s.cache != nil, "replica_enabled", s.router != nil,
+		"invariant_checks", s.cfg.Invariants != nil)
 	return nil
 }
 
@@ -103,9 +210,17 @@ func (s *WorldSubsystem) Prepare(ctx context.Context) error {
 // (D-13.3 row 5).
 func (s *WorldSubsystem) Activate(_ context.Context) error { return nil }
 
-// Stop is a no-op — world services are stateless after init.
+// Stop closes the read-replica pool, if one was opened. World services are
+// otherwise stateless after init.
 // codecov:ignore — tested by integration and E2E tests
-func (s *WorldSubsystem) Stop(_ context.Context) error { return nil }
+func (s *WorldSubsystem) Stop(_ context.Context) error {
+	if s.replica != nil {
+		s.replica.Close()
+		s.replica = nil
+		s.router = nil
+	}
+	return nil
+}
 
 // Service returns the WorldService. Panics if called before Prepare().
 func (s *WorldSubsystem) Service() *world.Service {
@@ -122,3 +237,22 @@ func (s *WorldSubsystem) Transactor() world.Transactor {
 	}
 	return s.transactor
 }
+
+// Checker returns a world consistency Checker that scans the primary
+// database. Panics if called before Prepare().
+func (s *WorldSubsystem) Checker() *world.Checker {
+	return world.NewChecker(worldpostgres.NewConsistencyStore(s.cfg.DB.Pool()), s.Service())
+}
+
+// Cache returns the world entity cache, or nil when caching is disabled.
+// Components that write world entities through their own repositories MUST
+// wrap them (and their transactor) with it so their writes invalidate.
+func (s *WorldSubsystem) Cache() *worldcache.Cache {
+	return s.cache
+}
+
+// ReplicaRouter returns the read-replica router, or nil when replica routing
+// is disabled.
+func (s *WorldSubsystem) ReplicaRouter() *worldpostgres.ReplicaRouter {
+	return s.router
+}
@@ -8,13 +8,19 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/holomush/holomush/internal/access/policy"
 	"github.com/holomush/holomush/internal/lifecycle"
+	"github.com/holomush/holomush/internal/world"
 	"github.com/holomush/holomush/internal/world/setup"
 )
 
 // Compile-time interface check: *setup.WorldSubsystem must satisfy lifecycle.Subsystem.
 var _ lifecycle.Subsystem = (*setup.WorldSubsystem)(nil)
 
+// Compile-time interface check: Prepare wires the engine as the world
+// service's access invalidator only if *policy.Engine satisfies it.
+var _ world.AccessInvalidator = (*policy.Engine)(nil)
+
 func TestWorldSubsystemIDReturnsWorld(t *testing.T) {
 	sub := setup.NewWorldSubsystem(setup.WorldSubsystemConfig{})
 	assert.Equal(t, lifecycle.SubsystemWorld, sub.ID())
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// Tag limits.
+const (
+	// MaxTagLength bounds a tag in bytes.
+	MaxTagLength = 64
+	// MaxTagsPerEntity bounds how many tags one location, object, or
+	// character carries.
+	MaxTagsPerEntity = 32
+)
+
+// ValidateTag checks that tag is well formed. Tags share the zone ID
+// syntax: a lowercase letter followed by lowercase letters, digits,
+// underscores, or hyphens (e.g. "outdoor", "newbie-area").
+func ValidateTag(tag string) error {
+	if tag == "" {
+		return &ValidationError{Field: "tag", Message: "cannot be empty"}
+	}
+	if len(tag) > MaxTagLength {
+		return &ValidationError{Field: "tag", Message: fmt.Sprintf("exceeds maximum length of %d", MaxTagLength)}
+	}
+	if !zoneIDRegex.MatchString(tag) {
+		return &ValidationError{Field: "tag", Message: "must be a lowercase letter followed by letters, digits, underscores, or hyphens"}
+	}
+	return nil
+}
+
+// TagKind is the kind of entity a tag is attached to.
+type TagKind string
+
+// Taggable entity kinds.
+const (
+	TagKindLocation  TagKind = "location"
+	TagKindObject    TagKind = "object"
+	TagKindCharacter TagKind = "character"
+)
+
+// IsValid reports whether k is a taggable entity kind.
+func (k TagKind) IsValid() bool {
+	return k == TagKindLocation || k == TagKindObject || k == TagKindCharacter
+}
+
+// TaggedEntity is one FindByTag match.
+type TaggedEntity struct {
+	Kind TagKind
+	ID   ulid.ULID
+	Name string
+}
+
+// FindByTag returns the entities of kind carrying tag, or of every kind when
+// kind is empty: locations first, then objects, then characters, each
+// ordered by ID. It requires "read" on the tag itself, so operators can
+// hide a tag from players with a forbid on tag:<name>, and leaves out
+// entities the subject may not read.
+//
+// Returns TAG_INVALID for a malformed tag or unknown kind and
+// TAG_ACCESS_DENIED without read access to the tag.
+func (s *Service) FindByTag(ctx context.Context, subjectID, tag string, kind TagKind) ([]TaggedEntity, error) {
+	if err := ValidateTag(tag); err != nil {
+		return nil, oops.Code("TAG_INVALID").With("tag", tag).Wrap(err)
+	}
+	if kind != "" && !kind.IsValid() {
+		return nil, oops.Code("TAG_INVALID").With("kind", string(kind)).Errorf("unknown tag kind %q", kind)
+	}
+	if s.locationRepo == nil || s.objectRepo == nil || s.characterRepo == nil {
+		return nil, oops.Code("TAG_QUERY_FAILED").Errorf("world repositories not configured")
+	}
+	if err := s.checkAccess(ctx, subjectID, "read", access.TagResource(tag), prefixTag); err != nil {
+		return nil, err
+	}
+
+	var matches []TaggedEntity
+	if kind == "" || kind == TagKindLocation {
+		locs, err := s.locationRepo.ListByTag(ctx, tag)
+		if err != nil {
+			return nil, oops.Code("TAG_QUERY_FAILED").With("tag", tag).Wrap(err)
+		}
+		for _, loc := range locs {
+			ok, err := s.canRead(ctx, subjectID, access.LocationResource(loc.ID.String()), prefixLocation)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matches = append(matches, TaggedEntity{Kind: TagKindLocation, ID: loc.ID, Name: loc.Name})
+			}
+		}
+	}
+	if kind == "" || kind == TagKindObject {
+		objs, err := s.objectRepo.ListByTag(ctx, tag)
+		if err != nil {
+			return nil, oops.Code("TAG_QUERY_FAILED").With("tag", tag).Wrap(err)
+		}
+		for _, obj := range objs {
+			ok, err := s.canRead(ctx, subjectID, access.ObjectResource(obj.ID.String()), prefixObject)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matches = append(matches, TaggedEntity{Kind: TagKindObject, ID: obj.ID, Name: obj.Name})
+			}
+		}
+	}
+	if kind == "" || kind == TagKindCharacter {
+		chars, err := s.characterRepo.ListByTag(ctx, tag)
+		if err != nil {
+			return nil, oops.Code("TAG_QUERY_FAILED").With("tag", tag).Wrap(err)
+		}
+		for _, char := range chars {
+			ok, err := s.canRead(ctx, subjectID, access.CharacterResource(char.ID.String()), prefixCharacter)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				matches = append(matches, TaggedEntity{Kind: TagKindCharacter, ID: char.ID, Name: char.Name})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// EntityTags returns the tags on one entity that the subject may read. It
+// requires "read" on the entity; tags the subject may not read are left
+// out rather than failing the call. The Tags field on the entity structs is
+// unfiltered, so anything shown to a player goes through here.
+func (s *Service) EntityTags(ctx context.Context, subjectID string, kind TagKind, id ulid.ULID) ([]string, error) {
+	var tags []string
+	switch kind {
+	case TagKindLocation:
+		loc, err := s.GetLocation(ctx, subjectID, id)
+		if err != nil {
+			return nil, err
+		}
+		tags = loc.Tags
+	case TagKindObject:
+		obj, err := s.GetObject(ctx, subjectID, id)
+		if err != nil {
+			return nil, err
+		}
+		tags = obj.Tags
+	case TagKindCharacter:
+		char, err := s.GetCharacter(ctx, subjectID, id)
+		if err != nil {
+			return nil, err
+		}
+		tags = char.Tags
+	default:
+		return nil, oops.Code("TAG_INVALID").With("kind", string(kind)).Errorf("unknown tag kind %q", kind)
+	}
+
+	visible := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		ok, err := s.canRead(ctx, subjectID, access.TagResource(tag), prefixTag)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			visible = append(visible, tag)
+		}
+	}
+	return visible, nil
+}
+
+// canRead reports whether subjectID may read resource. A policy denial is
+// false; evaluation failures are returned.
+func (s *Service) canRead(ctx context.Context, subjectID, resource string, prefix entityPrefix) (bool, error) {
+	err := s.checkAccess(ctx, subjectID, "read", resource, prefix)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrPermissionDenied) {
+		return false, nil
+	}
+	return false, err
+}
+
+// authorizeTagWrite validates tag and checks the two rights a tag change
+// needs: "write" on the entity and "write" on tag:<name>.
+func (s *Service) authorizeTagWrite(ctx context.Context, subjectID, tag, resource string, prefix entityPrefix) error {
+	if err := ValidateTag(tag); err != nil {
+		return oops.Code("TAG_INVALID").With("tag", tag).Wrap(err)
+	}
+	if err := s.checkAccess(ctx, subjectID, "write", resource, prefix); err != nil {
+		return err
+	}
+	return s.checkAccess(ctx, subjectID, "write", access.TagResource(tag), prefixTag)
+}
+
+// tagIntent builds the envelope intent for a tag added to or removed from
+// an entity.
+func (s *Service) tagIntent(kind string, aggType wmodel.AggregateType, id ulid.ULID, subjectID, tag string) (wmodel.EnvelopeIntent, error) {
+	payload, err := BuildTagPayload(id, tag)
+	if err != nil {
+		return wmodel.EnvelopeIntent{}, oops.Code("TAG_WRITE_FAILED").Wrapf(err, "build tag payload %s", id)
+	}
+	return s.buildIntent(kind, aggType, id, subjectID, payload), nil
+}
+
+// withTag returns a sorted copy of tags with tag added, and false when tag
+// is already present. It never writes to tags, which may be shared with
+// the world cache. Returns TAG_LIMIT_EXCEEDED past MaxTagsPerEntity.
+func withTag(tags []string, tag string) ([]string, bool, error) {
+	i, found := slices.BinarySearch(tags, tag)
+	if found {
+		return tags, false, nil
+	}
+	if len(tags) >= MaxTagsPerEntity {
+		return nil, false, oops.Code("TAG_LIMIT_EXCEEDED").
+			With("tag", tag).
+			With("limit", MaxTagsPerEntity).
+			Errorf("an entity carries at most %d tags", MaxTagsPerEntity)
+	}
+	return slices.Insert(slices.Clone(tags), i, tag), true, nil
+}
+
+// withoutTag returns a copy of tags with tag removed, and false when tag is
+// not present.
+func withoutTag(tags []string, tag string) ([]string, bool) {
+	i, found := slices.BinarySearch(tags, tag)
+	if !found {
+		return tags, false
+	}
+	return slices.Delete(slices.Clone(tags), i, i+1), true
+}
+
+// tagWriteError classifies a failed tag write: a CAS conflict, the entity
+// gone (notFoundCode), or TAG_WRITE_FAILED.
+func tagWriteError(err error, id ulid.ULID, notFoundCode, op string) error {
+	if errors.Is(err, ErrConcurrentEdit) {
+		return oops.Code(CodeConcurrentEdit).With("id", id.String()).Wrap(err)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return oops.Code(notFoundCode).Wrapf(err, "%s %s", op, id)
+	}
+	return oops.Code("TAG_WRITE_FAILED").Wrapf(err, "%s %s", op, id)
+}
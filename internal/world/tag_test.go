@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// taggedLocation returns a location carrying tags at version 3.
+func taggedLocation(t *testing.T, tags ...string) *world.Location {
+	t.Helper()
+	loc, err := world.NewLocation("Meadow", "Tall grass.", world.LocationTypePersistent)
+	require.NoError(t, err)
+	loc.Tags = tags
+	loc.Version = 3
+	return loc
+}
+
+// tagWriteEngine grants subjectID write on resource and on tag:<tag>.
+func tagWriteEngine(subjectID, resource, tag string) *policytest.GrantEngine {
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", resource)
+	engine.Grant(subjectID, "write", access.TagResource(tag))
+	return engine
+}
+
+func TestValidateTag(t *testing.T) {
+	for _, tag := range []string{"outdoor", "newbie-area", "zone_7"} {
+		assert.NoError(t, world.ValidateTag(tag), tag)
+	}
+	for _, tag := range []string{"", "Outdoor", "7th", "has space", "tag:x", strings.Repeat("a", world.MaxTagLength+1)} {
+		var verr *world.ValidationError
+		require.ErrorAs(t, world.ValidateTag(tag), &verr, tag)
+		assert.Equal(t, "tag", verr.Field)
+	}
+}
+
+func TestWorldService_TagLocation(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	t.Run("adds the tag in sorted order and emits location_tagged", func(t *testing.T) {
+		loc := taggedLocation(t, "indoor", "quiet")
+		locRepo := worldtest.NewMockLocationRepository(t)
+		outbox := &mockOutboxWriter{}
+		engine := tagWriteEngine(subjectID, access.LocationResource(loc.ID.String()), "outdoor")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, outbox))
+
+		locRepo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+		locRepo.EXPECT().SetTags(mock.Anything, loc.ID, []string{"indoor", "outdoor", "quiet"}, 3).
+			Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.TagLocation(ctx, subjectID, loc.ID, "outdoor"))
+		assert.Equal(t, []string{"indoor", "quiet"}, loc.Tags, "the fetched entity is not modified")
+		assert.Equal(t, "location_tagged", outbox.lastIntent.Kind)
+		var payload world.TagChangePayload
+		require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+		assert.Equal(t, world.TagChangePayload{ID: loc.ID.String(), Tag: "outdoor"}, payload)
+	})
+
+	t.Run("a tag already present is a no-op", func(t *testing.T) {
+		loc := taggedLocation(t, "outdoor")
+		locRepo := worldtest.NewMockLocationRepository(t)
+		outbox := &mockOutboxWriter{}
+		engine := tagWriteEngine(subjectID, access.LocationResource(loc.ID.String()), "outdoor")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, outbox))
+		locRepo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+
+		require.NoError(t, svc.TagLocation(ctx, subjectID, loc.ID, "outdoor"))
+		assert.Zero(t, outbox.calls)
+	})
+
+	t.Run("an entity carries at most MaxTagsPerEntity tags", func(t *testing.T) {
+		tags := make([]string, world.MaxTagsPerEntity)
+		for i := range tags {
+			tags[i] = fmt.Sprintf("t%02d", i)
+		}
+		loc := taggedLocation(t, tags...)
+		locRepo := worldtest.NewMockLocationRepository(t)
+		engine := tagWriteEngine(subjectID, access.LocationResource(loc.ID.String()), "zzz")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, &mockOutboxWriter{}))
+		locRepo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+
+		err := svc.TagLocation(ctx, subjectID, loc.ID, "zzz")
+		errutil.AssertErrorCode(t, err, "TAG_LIMIT_EXCEEDED")
+	})
+
+	t.Run("write on the location alone is not enough", func(t *testing.T) {
+		locID := ulid.Make()
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "write", access.LocationResource(locID.String()))
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			LocationRepo: worldtest.NewMockLocationRepository(t), Engine: engine,
+		}, &mockOutboxWriter{}))
+
+		err := svc.TagLocation(ctx, subjectID, locID, "outdoor")
+		errutil.AssertErrorCode(t, err, "TAG_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+
+	t.Run("malformed tags are rejected before any lookup", func(t *testing.T) {
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+			LocationRepo: worldtest.NewMockLocationRepository(t), Engine: policytest.AllowAllEngine(),
+		}, &mockOutboxWriter{}))
+
+		err := svc.TagLocation(ctx, subjectID, ulid.Make(), "Not A Tag")
+		errutil.AssertErrorCode(t, err, "TAG_INVALID")
+	})
+
+	t.Run("a CAS conflict surfaces as a concurrent edit", func(t *testing.T) {
+		loc := taggedLocation(t)
+		locRepo := worldtest.NewMockLocationRepository(t)
+		engine := tagWriteEngine(subjectID, access.LocationResource(loc.ID.String()), "outdoor")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{LocationRepo: locRepo, Engine: engine}, &mockOutboxWriter{}))
+		locRepo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+		locRepo.EXPECT().SetTags(mock.Anything, loc.ID, []string{"outdoor"}, 3).
+			Return(nil, world.ErrConcurrentEdit).Once()
+
+		err := svc.TagLocation(ctx, subjectID, loc.ID, "outdoor")
+		errutil.AssertErrorCode(t, err, world.CodeConcurrentEdit)
+	})
+}
+
+func TestWorldService_UntagObjectAndCharacter(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	t.Run("removes the tag from an object and emits object_untagged", func(t *testing.T) {
+		obj, err := world.NewObject("Lantern", world.InLocation(ulid.Make()))
+		require.NoError(t, err)
+		obj.Tags = []string{"light", "outdoor"}
+		obj.Version = 5
+		objRepo := worldtest.NewMockObjectRepository(t)
+		outbox := &mockOutboxWriter{}
+		engine := tagWriteEngine(subjectID, access.ObjectResource(obj.ID.String()), "outdoor")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{ObjectRepo: objRepo, Engine: engine}, outbox))
+
+		objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil).Once()
+		objRepo.EXPECT().SetTags(mock.Anything, obj.ID, []string{"light"}, 5).
+			Return(&wmodel.MutationDelta{}, nil).Once()
+
+		require.NoError(t, svc.UntagObject(ctx, subjectID, obj.ID, "outdoor"))
+		assert.Equal(t, "object_untagged", outbox.lastIntent.Kind)
+	})
+
+	t.Run("removing an absent tag from a character is a no-op", func(t *testing.T) {
+		char, err := world.NewCharacter(ulid.Make(), "Alice")
+		require.NoError(t, err)
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		outbox := &mockOutboxWriter{}
+		engine := tagWriteEngine(subjectID, access.CharacterResource(char.ID.String()), "npc")
+		svc := world.NewService(withWriteExecutor(world.ServiceConfig{CharacterRepo: charRepo, Engine: engine}, outbox))
+		charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+
+		require.NoError(t, svc.UntagCharacter(ctx, subjectID, char.ID, "npc"))
+		assert.Zero(t, outbox.calls)
+	})
+}
+
+func TestWorldService_FindByTag(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	visible := taggedLocation(t, "outdoor")
+	hidden := taggedLocation(t, "outdoor")
+	obj, err := world.NewObject("Kite", world.InLocation(visible.ID))
+	require.NoError(t, err)
+	obj.Tags = []string{"outdoor"}
+
+	newService := func(t *testing.T, engine *policytest.GrantEngine) (*world.Service, *worldtest.MockLocationRepository, *worldtest.MockObjectRepository, *worldtest.MockCharacterRepository) {
+		t.Helper()
+		locRepo := worldtest.NewMockLocationRepository(t)
+		objRepo := worldtest.NewMockObjectRepository(t)
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: locRepo, ObjectRepo: objRepo, CharacterRepo: charRepo, Engine: engine,
+		})
+		return svc, locRepo, objRepo, charRepo
+	}
+
+	t.Run("returns readable entities of every kind", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", access.TagResource("outdoor"))
+		engine.Grant(subjectID, "read", access.LocationResource(visible.ID.String()))
+		engine.Grant(subjectID, "read", access.ObjectResource(obj.ID.String()))
+		svc, locRepo, objRepo, charRepo := newService(t, engine)
+		locRepo.EXPECT().ListByTag(mock.Anything, "outdoor").Return([]*world.Location{visible, hidden}, nil).Once()
+		objRepo.EXPECT().ListByTag(mock.Anything, "outdoor").Return([]*world.Object{obj}, nil).Once()
+		charRepo.EXPECT().ListByTag(mock.Anything, "outdoor").Return(nil, nil).Once()
+
+		got, err := svc.FindByTag(ctx, subjectID, "outdoor", "")
+		require.NoError(t, err)
+		assert.Equal(t, []world.TaggedEntity{
+			{Kind: world.TagKindLocation, ID: visible.ID, Name: visible.Name},
+			{Kind: world.TagKindObject, ID: obj.ID, Name: obj.Name},
+		}, got, "the unreadable location is left out")
+	})
+
+	t.Run("a kind limits the query to that repository", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, "read", access.TagResource("outdoor"))
+		engine.Grant(subjectID, "read", access.ObjectResource(obj.ID.String()))
+		svc, _, objRepo, _ := newService(t, engine)
+		objRepo.EXPECT().ListByTag(mock.Anything, "outdoor").Return([]*world.Object{obj}, nil).Once()
+
+		got, err := svc.FindByTag(ctx, subjectID, "outdoor", world.TagKindObject)
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("requires read on the tag", func(t *testing.T) {
+		svc, _, _, _ := newService(t, policytest.NewGrantEngine())
+
+		_, err := svc.FindByTag(ctx, subjectID, "outdoor", "")
+		errutil.AssertErrorCode(t, err, "TAG_ACCESS_DENIED")
+	})
+
+	t.Run("rejects unknown kinds and malformed tags", func(t *testing.T) {
+		svc, _, _, _ := newService(t, policytest.NewGrantEngine())
+
+		_, err := svc.FindByTag(ctx, subjectID, "outdoor", world.TagKind("exit"))
+		errutil.AssertErrorCode(t, err, "TAG_INVALID")
+		_, err = svc.FindByTag(ctx, subjectID, "", world.TagKindLocation)
+		errutil.AssertErrorCode(t, err, "TAG_INVALID")
+	})
+}
+
+func TestWorldService_EntityTags(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	loc := taggedLocation(t, "haunted", "outdoor")
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "read", access.LocationResource(loc.ID.String()))
+	engine.Grant(subjectID, "read", access.TagResource("outdoor"))
+	locRepo := worldtest.NewMockLocationRepository(t)
+	locRepo.EXPECT().Get(mock.Anything, loc.ID).Return(loc, nil).Once()
+	svc := world.NewService(world.ServiceConfig{LocationRepo: locRepo, Engine: engine})
+
+	got, err := svc.EntityTags(ctx, subjectID, world.TagKindLocation, loc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outdoor"}, got, "tags the subject may not read are left out")
+}
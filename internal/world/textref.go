@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// MaxTextRefs bounds how many distinct references one text resolves. Any
+// further references render as their fallback without a lookup, so a
+// description stuffed with references cannot turn one look into hundreds of
+// reads.
+const MaxTextRefs = 32
+
+// TextRefKind is the kind of entity a text reference names.
+type TextRefKind string
+
+// Text reference kinds.
+const (
+	TextRefObject    TextRefKind = "object"
+	TextRefCharacter TextRefKind = "character"
+	TextRefLocation  TextRefKind = "location"
+)
+
+// textRefFallbacks is what a reference renders as when the viewer may not
+// read the entity or it no longer exists. Both cases read the same, so a
+// reference never reveals whether a hidden entity exists.
+var textRefFallbacks = map[TextRefKind]string{
+	TextRefObject:    "something",
+	TextRefCharacter: "someone",
+	TextRefLocation:  "somewhere",
+}
+
+// textRefPattern matches #[<kind>:<ULID>]. ULIDs are matched case-blind and
+// parsed strictly, so a malformed ID stays literal text.
+var textRefPattern = regexp.MustCompile(`#\[(object|character|location):([0-9A-Za-z]{26})\]`)
+
+// TextRef is a reference to an entity embedded in free text, written
+// #[object:01ABC...]. Descriptions hold the reference rather than the name,
+// so renaming the entity does not leave the old name behind.
+type TextRef struct {
+	Kind TextRefKind
+	ID   ulid.ULID
+}
+
+// String returns the reference in its written form.
+func (r TextRef) String() string {
+	return "#[" + string(r.Kind) + ":" + r.ID.String() + "]"
+}
+
+// ParseTextRefs returns the distinct well-formed references in text, in the
+// order they first appear.
+func ParseTextRefs(text string) []TextRef {
+	var refs []TextRef
+	seen := map[TextRef]bool{}
+	for _, m := range textRefPattern.FindAllStringSubmatch(text, -1) {
+		ref, ok := parseTextRef(m[1], m[2])
+		if !ok || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+func parseTextRef(kind, id string) (TextRef, bool) {
+	parsed, err := ulid.ParseStrict(strings.ToUpper(id))
+	if err != nil {
+		return TextRef{}, false
+	}
+	return TextRef{Kind: TextRefKind(kind), ID: parsed}, true
+}
+
+// TextTokenExpander replaces substitution tokens such as %time% and
+// %season% in description text. *gameclock.Clock satisfies it.
+type TextTokenExpander interface {
+	Expand(text string) string
+}
+
+// SetTextTokens registers the expander RenderTextRefs applies before
+// resolving references. Passing nil leaves tokens as written.
+func (s *Service) SetTextTokens(e TextTokenExpander) {
+	s.textTokens = e
+}
+
+// RenderTextRefs replaces each #[kind:ULID] reference in text with the
+// entity's current name as subjectID sees it, after expanding substitution
+// tokens when a TextTokenExpander is set. A reference to an entity the
+// subject may not read, or that no longer exists, renders as "something",
+// "someone", or "somewhere". Text without references is returned as is. An
+// access evaluation or storage failure fails the call rather than render a
+// name the subject might not be allowed to see.
+func (s *Service) RenderTextRefs(ctx context.Context, subjectID, text string) (string, error) {
+	if s.textTokens != nil {
+		text = s.textTokens.Expand(text)
+	}
+	if !strings.Contains(text, "#[") {
+		return text, nil
+	}
+	names := map[TextRef]string{}
+	for _, ref := range ParseTextRefs(text) {
+		if len(names) == MaxTextRefs {
+			break
+		}
+		name, err := s.textRefName(ctx, subjectID, ref)
+		if err != nil {
+			return "", err
+		}
+		names[ref] = name
+	}
+	return textRefPattern.ReplaceAllStringFunc(text, func(match string) string {
+		m := textRefPattern.FindStringSubmatch(match)
+		ref, ok := parseTextRef(m[1], m[2])
+		if !ok {
+			return match
+		}
+		if name, ok := names[ref]; ok {
+			return name
+		}
+		return textRefFallbacks[ref.Kind]
+	}), nil
+}
+
+// RenderTextRefFallbacks replaces every reference in text with its
+// fallback, for callers that must show text after RenderTextRefs failed.
+func RenderTextRefFallbacks(text string) string {
+	return textRefPattern.ReplaceAllStringFunc(text, func(match string) string {
+		m := textRefPattern.FindStringSubmatch(match)
+		if _, ok := parseTextRef(m[1], m[2]); !ok {
+			return match
+		}
+		return textRefFallbacks[TextRefKind(m[1])]
+	})
+}
+
+// textRefName looks up the name ref renders as for subjectID.
+func (s *Service) textRefName(ctx context.Context, subjectID string, ref TextRef) (string, error) {
+	var (
+		name string
+		err  error
+	)
+	switch ref.Kind {
+	case TextRefObject:
+		var obj *Object
+		if obj, err = s.GetObject(ctx, subjectID, ref.ID); err == nil {
+			name = obj.Name
+		}
+	case TextRefCharacter:
+		var char *Character
+		if char, err = s.GetCharacter(ctx, subjectID, ref.ID); err == nil {
+			name = char.Name
+		}
+	case TextRefLocation:
+		var loc *Location
+		if loc, err = s.GetLocation(ctx, subjectID, ref.ID); err == nil {
+			name = loc.Name
+		}
+	}
+	if errors.Is(err, ErrPermissionDenied) || errors.Is(err, ErrNotFound) {
+		return textRefFallbacks[ref.Kind], nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/access/policy/types"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestParseTextRefs(t *testing.T) {
+	objID, charID := ulid.Make(), ulid.Make()
+	text := "The #[object:" + objID.String() + "] sits by #[character:" + strings.ToLower(charID.String()) + "]," +
+		" and the #[object:" + objID.String() + "] again. #[object:nope] #[exit:" + objID.String() + "]"
+
+	refs := world.ParseTextRefs(text)
+	assert.Equal(t, []world.TextRef{
+		{Kind: world.TextRefObject, ID: objID},
+		{Kind: world.TextRefCharacter, ID: charID},
+	}, refs, "duplicates, malformed IDs and unknown kinds are skipped; IDs are case-blind")
+	assert.Equal(t, "#[object:"+objID.String()+"]", refs[0].String())
+	assert.Empty(t, world.ParseTextRefs("no references here"))
+}
+
+// seasonTokens expands %season% to the season it names.
+type seasonTokens string
+
+func (s seasonTokens) Expand(text string) string {
+	return strings.ReplaceAll(text, "%season%", string(s))
+}
+
+func TestWorldService_RenderTextRefs(t *testing.T) {
+	ctx := context.Background()
+	viewer := access.CharacterSubject(ulid.Make().String())
+	fountain := &world.Object{ID: ulid.Make(), Name: "Old Fountain"}
+	vault := &world.Object{ID: ulid.Make(), Name: "Hidden Vault"}
+	bob := &world.Character{ID: ulid.Make(), Name: "Bob"}
+	goneID := ulid.Make()
+
+	newService := func(t *testing.T, engine types.AccessPolicyEngine) (*world.Service, *worldtest.MockObjectRepository) {
+		t.Helper()
+		objRepo := worldtest.NewMockObjectRepository(t)
+		charRepo := worldtest.NewMockCharacterRepository(t)
+		charRepo.EXPECT().Get(mock.Anything, bob.ID).Return(bob, nil).Maybe()
+		return world.NewService(world.ServiceConfig{ObjectRepo: objRepo, CharacterRepo: charRepo, Engine: engine}), objRepo
+	}
+
+	t.Run("renders current names the viewer may read", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(viewer, "read", access.ObjectResource(fountain.ID.String()))
+		engine.Grant(viewer, "read", access.CharacterResource(bob.ID.String()))
+		svc, objRepo := newService(t, engine)
+		objRepo.EXPECT().Get(mock.Anything, fountain.ID).Return(fountain, nil).Once()
+
+		out, err := svc.RenderTextRefs(ctx, viewer,
+			"Water spills from the #[object:"+fountain.ID.String()+"]; #[character:"+bob.ID.String()+"] watches the #[object:"+fountain.ID.String()+"].")
+		require.NoError(t, err)
+		assert.Equal(t, "Water spills from the Old Fountain; Bob watches the Old Fountain.", out)
+	})
+
+	t.Run("denied and missing entities read the same", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(viewer, "read", access.ObjectResource(goneID.String()))
+		svc, objRepo := newService(t, engine)
+		objRepo.EXPECT().Get(mock.Anything, goneID).Return(nil, world.ErrNotFound).Once()
+
+		out, err := svc.RenderTextRefs(ctx, viewer,
+			"Behind #[object:"+vault.ID.String()+"] lies #[object:"+goneID.String()+"], watched by #[character:"+bob.ID.String()+"].")
+		require.NoError(t, err)
+		assert.Equal(t, "Behind something lies something, watched by someone.", out)
+	})
+
+	t.Run("text without references needs no lookups", func(t *testing.T) {
+		svc, _ := newService(t, policytest.NewErrorEngine(errors.New("engine down")))
+		out, err := svc.RenderTextRefs(ctx, viewer, "A plain #[note] with no entity.")
+		require.NoError(t, err)
+		assert.Equal(t, "A plain #[note] with no entity.", out)
+	})
+
+	t.Run("expands tokens before resolving references", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(viewer, "read", access.ObjectResource(fountain.ID.String()))
+		svc, objRepo := newService(t, engine)
+		objRepo.EXPECT().Get(mock.Anything, fountain.ID).Return(fountain, nil).Once()
+		svc.SetTextTokens(seasonTokens("winter"))
+
+		out, err := svc.RenderTextRefs(ctx, viewer, "The #[object:"+fountain.ID.String()+"] is frozen this %season%.")
+		require.NoError(t, err)
+		assert.Equal(t, "The Old Fountain is frozen this winter.", out)
+	})
+
+	t.Run("evaluation failures fail the render", func(t *testing.T) {
+		svc, _ := newService(t, policytest.NewErrorEngine(errors.New("engine down")))
+		_, err := svc.RenderTextRefs(ctx, viewer, "The #[object:"+fountain.ID.String()+"].")
+		errutil.AssertErrorCode(t, err, "OBJECT_ACCESS_EVALUATION_FAILED")
+	})
+
+	t.Run("references past the limit render as fallbacks without lookups", func(t *testing.T) {
+		engine := policytest.NewGrantEngine()
+		svc, _ := newService(t, engine)
+		var sb strings.Builder
+		for range world.MaxTextRefs + 3 {
+			sb.WriteString("#[object:" + ulid.Make().String() + "] ")
+		}
+		out, err := svc.RenderTextRefs(ctx, viewer, sb.String())
+		require.NoError(t, err)
+		assert.Equal(t, world.MaxTextRefs+3, strings.Count(out, "something"))
+	})
+}
+
+func TestRenderTextRefFallbacks(t *testing.T) {
+	text := "#[location:" + ulid.Make().String() + "] and #[object:bad]"
+	assert.Equal(t, "somewhere and #[object:bad]", world.RenderTextRefFallbacks(text))
+}
+
+func TestWorldService_DescribeCharacterRendersTextRefs(t *testing.T) {
+	ctx := context.Background()
+	viewer := access.CharacterSubject(ulid.Make().String())
+	char := &world.Character{ID: ulid.Make(), Name: "Alaric"}
+	sword := &world.Object{ID: ulid.Make(), Name: "Dawnblade"}
+	char.Description = "A knight carrying #[object:" + sword.ID.String() + "]."
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(viewer, "read", access.CharacterResource(char.ID.String()))
+	engine.Grant(viewer, "read", access.ObjectResource(sword.ID.String()))
+	charRepo := worldtest.NewMockCharacterRepository(t)
+	charRepo.EXPECT().Get(mock.Anything, char.ID).Return(char, nil).Once()
+	objRepo := worldtest.NewMockObjectRepository(t)
+	objRepo.EXPECT().ListHeldBy(mock.Anything, char.ID).Return(nil, nil).Once()
+	objRepo.EXPECT().Get(mock.Anything, sword.ID).Return(sword, nil).Once()
+	svc := world.NewService(world.ServiceConfig{CharacterRepo: charRepo, ObjectRepo: objRepo, Engine: engine})
+
+	desc, err := svc.DescribeCharacter(ctx, viewer, char.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "A knight carrying Dawnblade.", desc.Text())
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+
+	"github.com/samber/oops"
+)
+
+// InTransaction runs fn in one world transaction: every Service write made
+// with the context passed to fn commits or rolls back with the others, so a
+// command that creates several entities never leaves half of them behind.
+// Nested calls join the outer transaction. Build journal entries for the
+// writes are recorded only once the outermost transaction commits.
+//
+// Returns WORLD_TRANSACTION_UNAVAILABLE when no Transactor is configured;
+// otherwise the error fn returned, or the commit failure.
+func (s *Service) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.transactor == nil {
+		return oops.Code("WORLD_TRANSACTION_UNAVAILABLE").Errorf("transactor not configured")
+	}
+	if _, nested := pendingJournalFrom(ctx); nested {
+		return oops.Wrap(s.transactor.InTransaction(ctx, fn))
+	}
+	pending := &pendingJournal{}
+	if err := s.transactor.InTransaction(withPendingJournal(ctx, pending), fn); err != nil {
+		return oops.Wrap(err)
+	}
+	if s.journal != nil {
+		for _, e := range pending.entries {
+			s.journal.record(e.subject, e.entry)
+		}
+	}
+	return nil
+}
+
+// pendingJournal holds the journal entries of an open InTransaction until
+// it commits; a rolled-back transaction drops them.
+type pendingJournal struct {
+	entries []pendingJournalEntry
+}
+
+type pendingJournalEntry struct {
+	subject string
+	entry   *JournalEntry
+}
+
+type pendingJournalKey struct{}
+
+func withPendingJournal(ctx context.Context, p *pendingJournal) context.Context {
+	return context.WithValue(ctx, pendingJournalKey{}, p)
+}
+
+func pendingJournalFrom(ctx context.Context) (*pendingJournal, bool) {
+	p, ok := ctx.Value(pendingJournalKey{}).(*pendingJournal)
+	return p, ok
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestWorldService_InTransactionJournalsOnlyCommittedWrites(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", "location:*")
+	locRepo := worldtest.NewMockLocationRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo,
+		Engine:       engine,
+	}, &mockOutboxWriter{}))
+	journal := world.NewBuildJournal(0, 0)
+	svc.SetBuildJournal(journal)
+	locRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Times(3)
+
+	createTwo := func(txCtx context.Context) error {
+		for _, name := range []string{"Hall", "Closet"} {
+			loc := &world.Location{Name: name, Type: world.LocationTypePersistent}
+			if err := svc.CreateLocation(txCtx, subjectID, loc); err != nil {
+				return err
+			}
+			// A nested call joins the outer transaction.
+			if err := svc.InTransaction(txCtx, func(context.Context) error { return nil }); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	require.NoError(t, svc.InTransaction(ctx, createTwo))
+	assert.Len(t, journal.Entries(subjectID), 2)
+
+	boom := errors.New("boom")
+	err := svc.InTransaction(ctx, func(txCtx context.Context) error {
+		loc := &world.Location{Name: "Attic", Type: world.LocationTypePersistent}
+		require.NoError(t, svc.CreateLocation(txCtx, subjectID, loc))
+		return boom
+	})
+	require.ErrorIs(t, err, boom)
+	assert.Len(t, journal.Entries(subjectID), 2, "a rolled-back write is not journaled")
+}
+
+func TestWorldService_InTransactionRequiresTransactor(t *testing.T) {
+	svc := world.NewService(world.ServiceConfig{Engine: policytest.NewGrantEngine()})
+	err := svc.InTransaction(context.Background(), func(context.Context) error { return nil })
+	errutil.AssertErrorCode(t, err, "WORLD_TRANSACTION_UNAVAILABLE")
+}
@@ -0,0 +1,456 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package traversal walks characters through exits. An exit with a traversal
+// delay takes time to cross: the character departs at once but stays in the
+// location being left while the walk is pending, and arrives when the delay
+// elapses unless they stop first. An exit with a traversal cost charges it
+// through the game's CostHook as the walk begins. Each phase is announced
+// with a traversal_depart, traversal_cancel, or traversal_arrive event. A
+// walk refused by a locked exit is announced with traversal_locked. An exit
+// locked with a consume-on-use key destroys the key as the walk begins.
+package traversal
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// World is the world access the traversal service needs. *world.Service
+// satisfies it.
+type World interface {
+	FindUsableExit(ctx context.Context, subjectID string, characterID ulid.ULID, name string) (*world.Exit, error)
+	AdmitCharacter(ctx context.Context, subjectID string, characterID, toLocationID ulid.ULID) (ulid.ULID, error)
+	GetCharacter(ctx context.Context, subjectID string, id ulid.ULID) (*world.Character, error)
+	MoveCharacter(ctx context.Context, subjectID string, characterID, toLocationID ulid.ULID) error
+	ConsumeExitKey(ctx context.Context, characterID ulid.ULID, exit *world.Exit) error
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// CostHook charges a character for walking through an exit with a traversal
+// cost, for example by spending stamina. It is called once, as the walk
+// begins, and only for exits whose TraversalCost is positive. An error
+// refuses the walk; its message is shown to the player. A cost is not
+// refunded when the walk is stopped.
+type CostHook interface {
+	ChargeTraversal(ctx context.Context, characterID ulid.ULID, exit *world.Exit) error
+}
+
+// Timer is a pending arrival. *time.Timer satisfies it.
+type Timer interface {
+	Stop() bool
+}
+
+// Request asks to walk a character through the exit named Exit.
+// CharacterName labels the traversal events.
+type Request struct {
+	CharacterID   ulid.ULID
+	CharacterName string
+	Exit          string
+}
+
+// Move is a walk through an exit, pending or finished.
+type Move struct {
+	CharacterID    ulid.ULID
+	CharacterName  string
+	ExitID         ulid.ULID
+	ExitName       string
+	FromLocationID ulid.ULID
+	ToLocationID   ulid.ULID
+	Delay          time.Duration
+	StartedAt      time.Time
+}
+
+// Pending reports whether the walk is still under way, i.e. it has a
+// traversal delay and the character has not arrived yet.
+func (m Move) Pending() bool {
+	return m.Delay > 0
+}
+
+// ArrivesAt returns when a pending walk reaches its destination.
+func (m Move) ArrivesAt() time.Time {
+	return m.StartedAt.Add(m.Delay)
+}
+
+// Option configures a Service at construction.
+type Option func(*Service)
+
+// WithCostHook sets the hook that charges traversal costs. Without one,
+// exits with a traversal cost are free to walk.
+func WithCostHook(hook CostHook) Option {
+	return func(s *Service) { s.cost = hook }
+}
+
+// WithClock replaces the clock and the timer that schedules arrivals,
+// for tests.
+func WithClock(now func() time.Time, afterFunc func(time.Duration, func()) Timer) Option {
+	return func(s *Service) {
+		s.now = now
+		s.afterFunc = afterFunc
+	}
+}
+
+// pendingMove is a character's walk in progress. A reservation, held while
+// the walk is being set up, has a nil timer.
+type pendingMove struct {
+	move  Move
+	timer Timer
+}
+
+// Service walks characters through exits, one walk per character at a
+// time.
+type Service struct {
+	world     World
+	cost      CostHook
+	now       func() time.Time
+	afterFunc func(time.Duration, func()) Timer
+
+	mu      sync.Mutex
+	pending map[ulid.ULID]*pendingMove
+	pub     eventbus.Publisher
+	gameID  func() string
+}
+
+// NewService creates a traversal service over w. The publisher for the
+// traversal events is bound later with SetPublisher because the event bus is
+// created after the command registry.
+//
+// Panics when w is nil, mirroring the construction-time failure discipline
+// of the other host services.
+func NewService(w World, opts ...Option) *Service {
+	if w == nil {
+		panic("traversal.NewService: nil World")
+	}
+	s := &Service{
+		world:   w,
+		now:     time.Now,
+		pending: make(map[ulid.ULID]*pendingMove),
+		afterFunc: func(d time.Duration, f func()) Timer {
+			return time.AfterFunc(d, f)
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// SetPublisher binds the publisher used for traversal events. gameID
+// supplies the game id that qualifies event subjects.
+func (s *Service) SetPublisher(pub eventbus.Publisher, gameID func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pub = pub
+	s.gameID = gameID
+}
+
+func (s *Service) publisher() (eventbus.Publisher, func() string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pub == nil || eventbus.IsNilPublisher(s.pub) || s.gameID == nil {
+		return nil, nil
+	}
+	return s.pub, s.gameID
+}
+
+// Go walks the character through the named exit of their location. An
+// instant exit moves them before Go returns; an exit with a traversal delay
+// returns the pending Move and moves them once the delay elapses.
+//
+// A walk toward a full location leads to its overflow location instead, and
+// Move.ToLocationID says so.
+//
+// Returns TRAVERSAL_IN_PROGRESS while the character is already walking,
+// TRAVERSAL_REFUSED when the cost hook refuses the walk, and the errors of
+// world.Service.FindUsableExit, AdmitCharacter and MoveCharacter, among them
+// LOCATION_FULL.
+func (s *Service) Go(ctx context.Context, req Request) (Move, error) {
+	if err := s.reserve(req.CharacterID); err != nil {
+		return Move{}, err
+	}
+	move, err := s.depart(ctx, req)
+	if err != nil || !move.Pending() {
+		s.release(req.CharacterID)
+		return move, err
+	}
+
+	pm := &pendingMove{move: move}
+	bg := context.WithoutCancel(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pm.timer = s.afterFunc(move.Delay, func() { s.complete(bg, pm) })
+	s.pending[req.CharacterID] = pm
+	return move, nil
+}
+
+// Stop calls off the character's pending walk and returns it. Returns
+// TRAVERSAL_NOT_PENDING when the character is not walking anywhere.
+func (s *Service) Stop(ctx context.Context, characterID ulid.ULID) (Move, error) {
+	s.mu.Lock()
+	pm, ok := s.pending[characterID]
+	if !ok || pm.timer == nil {
+		s.mu.Unlock()
+		return Move{}, oops.Code("TRAVERSAL_NOT_PENDING").
+			With("character_id", characterID.String()).
+			Errorf("you are not going anywhere")
+	}
+	delete(s.pending, characterID)
+	s.mu.Unlock()
+
+	// Stop may lose to a timer that has already fired; complete then finds
+	// the walk gone and does nothing.
+	pm.timer.Stop()
+	s.announce(ctx, eventvocab.EventTypeTraversalCancel, pm.move.FromLocationID, pm.move)
+	return pm.move, nil
+}
+
+// Pending returns the character's walk in progress, if any.
+func (s *Service) Pending(characterID ulid.ULID) (Move, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pm, ok := s.pending[characterID]
+	if !ok || pm.timer == nil {
+		return Move{}, false
+	}
+	return pm.move, true
+}
+
+// Close calls off every pending walk without announcing it. Characters stay
+// where they are.
+func (s *Service) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, pm := range s.pending {
+		if pm.timer != nil {
+			pm.timer.Stop()
+		}
+		delete(s.pending, id)
+	}
+}
+
+func (s *Service) reserve(characterID ulid.ULID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pm, ok := s.pending[characterID]; ok {
+		if pm.timer == nil {
+			return oops.Code("TRAVERSAL_IN_PROGRESS").
+				With("character_id", characterID.String()).
+				Errorf("you are already on your way")
+		}
+		return oops.Code("TRAVERSAL_IN_PROGRESS").
+			With("character_id", characterID.String()).
+			Errorf("you are already heading %s; type stop to stay here", pm.move.ExitName)
+	}
+	s.pending[characterID] = &pendingMove{}
+	return nil
+}
+
+func (s *Service) release(characterID ulid.ULID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, characterID)
+}
+
+// depart resolves the exit, checks the destination has room, uses up a
+// consumable key, charges its cost, and announces the walk. An instant walk
+// also arrives. A locked exit is announced to the location being left.
+func (s *Service) depart(ctx context.Context, req Request) (Move, error) {
+	subject := access.CharacterSubject(req.CharacterID.String())
+	exit, err := s.world.FindUsableExit(ctx, subject, req.CharacterID, req.Exit)
+	if err != nil {
+		if oopsErr, ok := oops.AsOops(err); ok && oopsErr.Code() == "EXIT_LOCKED" && exit != nil {
+			s.announce(ctx, eventvocab.EventTypeTraversalLocked, exit.FromLocationID, Move{
+				CharacterID:    req.CharacterID,
+				CharacterName:  req.CharacterName,
+				ExitID:         exit.ID,
+				ExitName:       exit.Name,
+				FromLocationID: exit.FromLocationID,
+				ToLocationID:   exit.ToLocationID,
+			})
+		}
+		return Move{}, oops.With("exit", req.Exit).Wrap(err)
+	}
+	to, err := s.world.AdmitCharacter(ctx, subject, req.CharacterID, exit.ToLocationID)
+	if err != nil {
+		return Move{}, oops.With("exit_id", exit.ID.String()).Wrap(err)
+	}
+	if err := s.pay(ctx, req.CharacterID, exit); err != nil {
+		return Move{}, oops.With("exit_id", exit.ID.String()).Wrap(err)
+	}
+
+	move := Move{
+		CharacterID:    req.CharacterID,
+		CharacterName:  req.CharacterName,
+		ExitID:         exit.ID,
+		ExitName:       exit.Name,
+		FromLocationID: exit.FromLocationID,
+		ToLocationID:   to,
+		Delay:          exit.TraversalDelay,
+		StartedAt:      s.now(),
+	}
+	s.announce(ctx, eventvocab.EventTypeTraversalDepart, move.FromLocationID, move)
+	if move.Pending() {
+		return move, nil
+	}
+	return move, s.arrive(ctx, move)
+}
+
+// pay uses up exit's single-use key, if it has one, then charges its
+// traversal cost. With a cost to charge both run in one world transaction,
+// so a refused charge puts the key back and a key that is already gone
+// charges nothing.
+func (s *Service) pay(ctx context.Context, characterID ulid.ULID, exit *world.Exit) error {
+	if exit.TraversalCost <= 0 || s.cost == nil {
+		return s.world.ConsumeExitKey(ctx, characterID, exit)
+	}
+	return s.world.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.world.ConsumeExitKey(ctx, characterID, exit); err != nil {
+			return err
+		}
+		if err := s.cost.ChargeTraversal(ctx, characterID, exit); err != nil {
+			return oops.Code("TRAVERSAL_REFUSED").
+				With("character_id", characterID.String()).
+				Wrap(err)
+		}
+		return nil
+	})
+}
+
+// complete finishes a delayed walk when its timer fires, unless the walk was
+// stopped in the meantime.
+func (s *Service) complete(ctx context.Context, pm *pendingMove) {
+	s.mu.Lock()
+	if s.pending[pm.move.CharacterID] != pm {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.pending, pm.move.CharacterID)
+	s.mu.Unlock()
+
+	if err := s.arrive(ctx, pm.move); err != nil {
+		slog.WarnContext(ctx, "traversal: delayed move failed",
+			"character_id", pm.move.CharacterID.String(),
+			"exit_id", pm.move.ExitID.String(),
+			"error", err)
+		s.announce(ctx, eventvocab.EventTypeTraversalCancel, pm.move.FromLocationID, pm.move)
+	}
+}
+
+// arrive moves the character to the walk's destination and announces the
+// arrival. A character who was moved elsewhere while walking stays put. A
+// delayed walk checks for room again on arrival, so it may end in the
+// overflow location or be refused after all.
+func (s *Service) arrive(ctx context.Context, move Move) error {
+	subject := access.CharacterSubject(move.CharacterID.String())
+	if move.Pending() {
+		char, err := s.world.GetCharacter(ctx, subject, move.CharacterID)
+		if err != nil {
+			return oops.With("operation", "reload walking character").Wrap(err)
+		}
+		if char.LocationID == nil || *char.LocationID != move.FromLocationID {
+			return oops.Code("TRAVERSAL_INTERRUPTED").
+				With("character_id", move.CharacterID.String()).
+				Errorf("character left %s before arriving", move.FromLocationID)
+		}
+		to, err := s.world.AdmitCharacter(ctx, subject, move.CharacterID, move.ToLocationID)
+		if err != nil {
+			return oops.With("exit_id", move.ExitID.String()).Wrap(err)
+		}
+		move.ToLocationID = to
+	}
+	if err := s.world.MoveCharacter(ctx, subject, move.CharacterID, move.ToLocationID); err != nil {
+		return oops.With("exit_id", move.ExitID.String()).Wrap(err)
+	}
+	s.announce(ctx, eventvocab.EventTypeTraversalArrive, move.ToLocationID, move)
+	s.announceMove(ctx, move)
+	return nil
+}
+
+// announce publishes a traversal event on the stream of the location with
+// locationID. Failures are logged: the walk itself has already happened.
+func (s *Service) announce(ctx context.Context, typ eventvocab.EventType, locationID ulid.ULID, move Move) {
+	data, err := json.Marshal(eventvocab.TraversalPayload{
+		CharacterID:    move.CharacterID.String(),
+		CharacterName:  move.CharacterName,
+		ExitID:         move.ExitID.String(),
+		ExitName:       move.ExitName,
+		FromLocationID: move.FromLocationID.String(),
+		ToLocationID:   move.ToLocationID.String(),
+		DelayMS:        move.Delay.Milliseconds(),
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "traversal: payload not marshalled", "type", string(typ), "error", err)
+		return
+	}
+	s.publish(ctx, typ, world.LocationStream(locationID), move.CharacterID, data)
+}
+
+// announceMove publishes a move event on the character's own stream so
+// their client follows them to the new location.
+func (s *Service) announceMove(ctx context.Context, move Move) {
+	from := move.FromLocationID
+	exitID := move.ExitID
+	data, err := json.Marshal(world.MovePayload{
+		EntityType: world.EntityTypeCharacter,
+		EntityID:   move.CharacterID,
+		FromType:   world.ContainmentTypeLocation,
+		FromID:     &from,
+		ToType:     world.ContainmentTypeLocation,
+		ToID:       move.ToLocationID,
+		ExitID:     &exitID,
+		ExitName:   move.ExitName,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "traversal: move payload not marshalled", "error", err)
+		return
+	}
+	s.publish(ctx, eventvocab.EventTypeMove, world.CharacterStream(move.CharacterID), move.CharacterID, data)
+}
+
+func (s *Service) publish(ctx context.Context, typ eventvocab.EventType, stream string, characterID ulid.ULID, data []byte) {
+	pub, gameID := s.publisher()
+	if pub == nil {
+		return
+	}
+	if err := publishEvent(ctx, pub, gameIDOrDefault(gameID), typ, stream, characterID, data); err != nil {
+		slog.WarnContext(ctx, "traversal: event not published",
+			"type", string(typ),
+			"stream", stream,
+			"character_id", characterID.String(),
+			"error", err)
+	}
+}
+
+func publishEvent(ctx context.Context, pub eventbus.Publisher, gameID string, typ eventvocab.EventType, stream string, characterID ulid.ULID, data []byte) error {
+	sub, err := eventbus.Qualify(gameID, stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	t, err := eventbus.NewType(string(typ))
+	if err != nil {
+		return oops.With("type", string(typ)).Wrap(err)
+	}
+	actor := eventbus.Actor{Kind: eventbus.ActorKindCharacter, ID: characterID}
+	if err := pub.Publish(ctx, eventbus.NewEvent(sub, t, actor, data)); err != nil {
+		return oops.Code("TRAVERSAL_PUBLISH_FAILED").With("type", string(typ)).Wrap(err)
+	}
+	return nil
+}
+
+func gameIDOrDefault(gameID func() string) string {
+	if id := gameID(); id != "" {
+		return id
+	}
+	return "main"
+}
@@ -0,0 +1,550 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package traversal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// fakeWorld is a World with exits leaving a single location.
+type fakeWorld struct {
+	mu       sync.Mutex
+	location map[ulid.ULID]ulid.ULID
+	exits    []*world.Exit
+	moveErr  error
+	moves    []ulid.ULID
+	// full lists locations without room; overflow redirects arrivals at a
+	// full location to another one.
+	full     map[ulid.ULID]bool
+	overflow map[ulid.ULID]ulid.ULID
+	// locked lists exits the character holds no key for; consumed records
+	// exits whose key was used up, and consumeErr fails the consumption.
+	locked     map[ulid.ULID]bool
+	consumed   []ulid.ULID
+	consumeErr error
+}
+
+func newFakeWorld(charID, locID ulid.ULID, exits ...*world.Exit) *fakeWorld {
+	return &fakeWorld{location: map[ulid.ULID]ulid.ULID{charID: locID}, exits: exits}
+}
+
+func (f *fakeWorld) FindUsableExit(_ context.Context, _ string, characterID ulid.ULID, name string) (*world.Exit, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	loc := f.location[characterID]
+	for _, e := range f.exits {
+		if e.FromLocationID == loc && e.MatchesName(name) {
+			if f.locked[e.ID] {
+				return e, oops.Code("EXIT_LOCKED").Errorf("exit is locked")
+			}
+			return e, nil
+		}
+	}
+	return nil, oops.Code("EXIT_NOT_FOUND").Wrap(world.ErrNotFound)
+}
+
+func (f *fakeWorld) AdmitCharacter(_ context.Context, _ string, _, toLocationID ulid.ULID) (ulid.ULID, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.full[toLocationID] {
+		return toLocationID, nil
+	}
+	if to, ok := f.overflow[toLocationID]; ok && !f.full[to] {
+		return to, nil
+	}
+	return ulid.ULID{}, oops.Code(world.CodeLocationFull).Wrap(world.ErrLocationFull)
+}
+
+func (f *fakeWorld) setFull(locationID ulid.ULID, overflow *ulid.ULID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.full == nil {
+		f.full = map[ulid.ULID]bool{}
+		f.overflow = map[ulid.ULID]ulid.ULID{}
+	}
+	f.full[locationID] = true
+	if overflow != nil {
+		f.overflow[locationID] = *overflow
+	}
+}
+
+func (f *fakeWorld) GetCharacter(_ context.Context, _ string, id ulid.ULID) (*world.Character, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	loc := f.location[id]
+	return &world.Character{ID: id, LocationID: &loc}, nil
+}
+
+func (f *fakeWorld) MoveCharacter(_ context.Context, _ string, characterID, toLocationID ulid.ULID) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.moveErr != nil {
+		return f.moveErr
+	}
+	f.location[characterID] = toLocationID
+	f.moves = append(f.moves, toLocationID)
+	return nil
+}
+
+func (f *fakeWorld) ConsumeExitKey(_ context.Context, _ ulid.ULID, exit *world.Exit) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.consumeErr != nil {
+		return f.consumeErr
+	}
+	f.consumed = append(f.consumed, exit.ID)
+	return nil
+}
+
+// InTransaction runs fn and, when it fails, forgets the keys it consumed.
+func (f *fakeWorld) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.mu.Lock()
+	before := len(f.consumed)
+	f.mu.Unlock()
+	if err := fn(ctx); err != nil {
+		f.mu.Lock()
+		f.consumed = f.consumed[:before]
+		f.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (f *fakeWorld) where(characterID ulid.ULID) ulid.ULID {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.location[characterID]
+}
+
+func (f *fakeWorld) teleport(characterID, locationID ulid.ULID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.location[characterID] = locationID
+}
+
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func (f *fakePublisher) types() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	types := make([]string, 0, len(f.published))
+	for _, ev := range f.published {
+		types = append(types, string(ev.Type))
+	}
+	return types
+}
+
+func (f *fakePublisher) events() []eventbus.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.published)
+}
+
+// manualTimer is a Timer fired by the test.
+type manualTimer struct {
+	delay   time.Duration
+	fire    func()
+	stopped bool
+}
+
+func (m *manualTimer) Stop() bool {
+	was := !m.stopped
+	m.stopped = true
+	return was
+}
+
+type manualClock struct {
+	now    time.Time
+	timers []*manualTimer
+}
+
+func (c *manualClock) afterFunc(d time.Duration, f func()) Timer {
+	t := &manualTimer{delay: d, fire: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+type costFunc func(ctx context.Context, characterID ulid.ULID, exit *world.Exit) error
+
+func (f costFunc) ChargeTraversal(ctx context.Context, characterID ulid.ULID, exit *world.Exit) error {
+	return f(ctx, characterID, exit)
+}
+
+type fixture struct {
+	svc     *Service
+	world   *fakeWorld
+	pub     *fakePublisher
+	clock   *manualClock
+	charID  ulid.ULID
+	fromID  ulid.ULID
+	toID    ulid.ULID
+	instant *world.Exit
+	slow    *world.Exit
+}
+
+func newFixture(t *testing.T, opts ...Option) *fixture {
+	t.Helper()
+	f := &fixture{
+		pub:    &fakePublisher{},
+		clock:  &manualClock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)},
+		charID: ulid.Make(),
+		fromID: ulid.Make(),
+		toID:   ulid.Make(),
+	}
+	var err error
+	f.instant, err = world.NewExit(f.fromID, f.toID, "door")
+	require.NoError(t, err)
+	f.slow, err = world.NewExit(f.fromID, f.toID, "trail")
+	require.NoError(t, err)
+	f.slow.TraversalDelay = 2 * time.Second
+	f.world = newFakeWorld(f.charID, f.fromID, f.instant, f.slow)
+
+	opts = append([]Option{WithClock(func() time.Time { return f.clock.now }, f.clock.afterFunc)}, opts...)
+	f.svc = NewService(f.world, opts...)
+	f.svc.SetPublisher(f.pub, func() string { return "main" })
+	return f
+}
+
+func (f *fixture) walk(t *testing.T, exit string) (Move, error) {
+	t.Helper()
+	return f.svc.Go(context.Background(), Request{CharacterID: f.charID, CharacterName: "Alice", Exit: exit})
+}
+
+func TestGoThroughInstantExit(t *testing.T) {
+	f := newFixture(t)
+
+	move, err := f.walk(t, "door")
+	require.NoError(t, err)
+	assert.False(t, move.Pending())
+	assert.Equal(t, f.toID, f.world.where(f.charID))
+	assert.Empty(t, f.clock.timers)
+	assert.Equal(t, []string{"traversal_depart", "traversal_arrive", "move"}, f.pub.types())
+
+	_, pending := f.svc.Pending(f.charID)
+	assert.False(t, pending)
+}
+
+func TestGoThroughSlowExitArrivesWhenTimerFires(t *testing.T) {
+	f := newFixture(t)
+
+	move, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	assert.True(t, move.Pending())
+	assert.Equal(t, f.clock.now.Add(2*time.Second), move.ArrivesAt())
+	assert.Equal(t, f.fromID, f.world.where(f.charID), "the character stays put while walking")
+	require.Len(t, f.clock.timers, 1)
+	assert.Equal(t, 2*time.Second, f.clock.timers[0].delay)
+
+	got, ok := f.svc.Pending(f.charID)
+	require.True(t, ok)
+	assert.Equal(t, "trail", got.ExitName)
+
+	f.clock.timers[0].fire()
+	assert.Equal(t, f.toID, f.world.where(f.charID))
+	assert.Equal(t, []string{"traversal_depart", "traversal_arrive", "move"}, f.pub.types())
+	_, ok = f.svc.Pending(f.charID)
+	assert.False(t, ok)
+}
+
+func TestTraversalEventsNameStreamsAndPayload(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	f.clock.timers[0].fire()
+
+	events := f.pub.events()
+	require.Len(t, events, 3)
+	assert.Equal(t, "events.main."+world.LocationStream(f.fromID), string(events[0].Subject))
+	assert.Equal(t, "events.main."+world.LocationStream(f.toID), string(events[1].Subject))
+	assert.Equal(t, "events.main."+world.CharacterStream(f.charID), string(events[2].Subject))
+	assert.Equal(t, f.charID, events[0].Actor.ID)
+
+	var payload eventvocab.TraversalPayload
+	require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	assert.Equal(t, eventvocab.TraversalPayload{
+		CharacterID:    f.charID.String(),
+		CharacterName:  "Alice",
+		ExitID:         f.slow.ID.String(),
+		ExitName:       "trail",
+		FromLocationID: f.fromID.String(),
+		ToLocationID:   f.toID.String(),
+		DelayMS:        2000,
+	}, payload)
+
+	var move world.MovePayload
+	require.NoError(t, json.Unmarshal(events[2].Payload, &move))
+	assert.Equal(t, f.toID, move.ToID)
+	assert.Equal(t, "trail", move.ExitName)
+}
+
+func TestOneWalkAtATime(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	_, err = f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "TRAVERSAL_IN_PROGRESS")
+	assert.Contains(t, err.Error(), "already heading trail")
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+}
+
+func TestStopCancelsPendingWalk(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	move, err := f.svc.Stop(context.Background(), f.charID)
+	require.NoError(t, err)
+	assert.Equal(t, "trail", move.ExitName)
+	assert.True(t, f.clock.timers[0].stopped)
+	assert.Equal(t, []string{"traversal_depart", "traversal_cancel"}, f.pub.types())
+
+	// A timer that fired anyway finds the walk gone.
+	f.clock.timers[0].fire()
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Equal(t, []string{"traversal_depart", "traversal_cancel"}, f.pub.types())
+
+	// The character may set off again.
+	_, err = f.walk(t, "door")
+	require.NoError(t, err)
+}
+
+func TestStopWithNothingPending(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.svc.Stop(context.Background(), f.charID)
+	errutil.AssertErrorCode(t, err, "TRAVERSAL_NOT_PENDING")
+}
+
+func TestUnknownExitReleasesTheCharacter(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "chimney")
+	errutil.AssertErrorCode(t, err, "EXIT_NOT_FOUND")
+	assert.Empty(t, f.pub.types())
+
+	_, err = f.walk(t, "door")
+	require.NoError(t, err)
+}
+
+func TestCostHookChargesOrRefuses(t *testing.T) {
+	var charged []ulid.ULID
+	refuse := false
+	hook := costFunc(func(_ context.Context, characterID ulid.ULID, _ *world.Exit) error {
+		if refuse {
+			return errors.New("you are too tired to climb")
+		}
+		charged = append(charged, characterID)
+		return nil
+	})
+	f := newFixture(t, WithCostHook(hook))
+	f.instant.TraversalCost = 3
+
+	refuse = true
+	_, err := f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "TRAVERSAL_REFUSED")
+	assert.Contains(t, err.Error(), "too tired")
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Empty(t, f.pub.types())
+
+	refuse = false
+	_, err = f.walk(t, "door")
+	require.NoError(t, err)
+	assert.Equal(t, []ulid.ULID{f.charID}, charged)
+
+	// A free exit never reaches the hook.
+	f.world.teleport(f.charID, f.fromID)
+	_, err = f.walk(t, "trail")
+	require.NoError(t, err)
+	assert.Len(t, charged, 1)
+}
+
+func TestLockedExitAnnouncesAttempt(t *testing.T) {
+	f := newFixture(t)
+	f.world.locked = map[ulid.ULID]bool{f.instant.ID: true}
+
+	_, err := f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Empty(t, f.world.consumed)
+
+	events := f.pub.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, "traversal_locked", string(events[0].Type))
+	assert.Equal(t, "events.main."+world.LocationStream(f.fromID), string(events[0].Subject))
+	var payload eventvocab.TraversalPayload
+	require.NoError(t, json.Unmarshal(events[0].Payload, &payload))
+	assert.Equal(t, "Alice", payload.CharacterName)
+	assert.Equal(t, "door", payload.ExitName)
+
+	// The lock holds nobody up for good: another exit still works.
+	_, err = f.walk(t, "trail")
+	require.NoError(t, err)
+}
+
+func TestConsumableKeyUsedAsWalkBegins(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "door")
+	require.NoError(t, err)
+	assert.Equal(t, []ulid.ULID{f.instant.ID}, f.world.consumed)
+
+	f.world.teleport(f.charID, f.fromID)
+	f.world.consumeErr = oops.Code("EXIT_LOCKED").Errorf("the key is gone")
+	f.pub = &fakePublisher{}
+	f.svc.SetPublisher(f.pub, func() string { return "main" })
+	_, err = f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Empty(t, f.pub.types())
+}
+
+func TestKeyAndCostSettleTogether(t *testing.T) {
+	var charged int
+	refuse := false
+	hook := costFunc(func(context.Context, ulid.ULID, *world.Exit) error {
+		if refuse {
+			return errors.New("you are too tired to climb")
+		}
+		charged++
+		return nil
+	})
+	f := newFixture(t, WithCostHook(hook))
+	f.instant.TraversalCost = 3
+
+	refuse = true
+	_, err := f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "TRAVERSAL_REFUSED")
+	assert.Empty(t, f.world.consumed, "a refused charge puts the key back")
+
+	refuse = false
+	f.world.consumeErr = oops.Code("EXIT_LOCKED").Errorf("the key is gone")
+	_, err = f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, "EXIT_LOCKED")
+	assert.Zero(t, charged, "a missing key charges nothing")
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+}
+
+func TestFullDestinationRefusesWalk(t *testing.T) {
+	f := newFixture(t)
+	f.world.setFull(f.toID, nil)
+
+	_, err := f.walk(t, "door")
+	errutil.AssertErrorCode(t, err, world.CodeLocationFull)
+	assert.ErrorIs(t, err, world.ErrLocationFull)
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Empty(t, f.pub.types())
+}
+
+func TestFullDestinationRedirectsToOverflow(t *testing.T) {
+	f := newFixture(t)
+	overflow := ulid.Make()
+	f.world.setFull(f.toID, &overflow)
+
+	move, err := f.walk(t, "door")
+	require.NoError(t, err)
+	assert.Equal(t, overflow, move.ToLocationID)
+	assert.Equal(t, overflow, f.world.where(f.charID))
+
+	events := f.pub.events()
+	require.Len(t, events, 3)
+	assert.Equal(t, "events.main."+world.LocationStream(overflow), string(events[1].Subject))
+}
+
+func TestDelayedArrivalRechecksCapacity(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	f.world.setFull(f.toID, nil)
+
+	f.clock.timers[0].fire()
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Equal(t, []string{"traversal_depart", "traversal_cancel"}, f.pub.types())
+}
+
+func TestDelayedArrivalAbandonedWhenCharacterMovedElsewhere(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	elsewhere := ulid.Make()
+	f.world.teleport(f.charID, elsewhere)
+
+	f.clock.timers[0].fire()
+	assert.Equal(t, elsewhere, f.world.where(f.charID))
+	assert.Equal(t, []string{"traversal_depart", "traversal_cancel"}, f.pub.types())
+}
+
+func TestDelayedArrivalFailureAnnouncesCancel(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	f.world.moveErr = errors.New("database unavailable")
+
+	f.clock.timers[0].fire()
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+	assert.Equal(t, []string{"traversal_depart", "traversal_cancel"}, f.pub.types())
+}
+
+func TestCloseStopsPendingWalks(t *testing.T) {
+	f := newFixture(t)
+
+	_, err := f.walk(t, "trail")
+	require.NoError(t, err)
+	f.svc.Close()
+
+	assert.True(t, f.clock.timers[0].stopped)
+	_, ok := f.svc.Pending(f.charID)
+	assert.False(t, ok)
+	f.clock.timers[0].fire()
+	assert.Equal(t, f.fromID, f.world.where(f.charID))
+}
+
+func TestWithoutPublisherWalksSilently(t *testing.T) {
+	w := newFakeWorld(ulid.Make(), ulid.Make())
+	charID := ulid.Make()
+	from, to := ulid.Make(), ulid.Make()
+	exit, err := world.NewExit(from, to, "door")
+	require.NoError(t, err)
+	w.exits = []*world.Exit{exit}
+	w.location[charID] = from
+
+	svc := NewService(w)
+	_, err = svc.Go(context.Background(), Request{CharacterID: charID, Exit: "door"})
+	require.NoError(t, err)
+	assert.Equal(t, to, w.where(charID))
+}
+
+func TestNewServicePanicsWithoutWorld(t *testing.T) {
+	assert.Panics(t, func() { NewService(nil) })
+}
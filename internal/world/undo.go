@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// SetBuildJournal attaches a journal that records the location, exit, and
+// object changes made through s, enabling UndoLast and RedoLast. Passing nil
+// stops journaling.
+func (s *Service) SetBuildJournal(j *BuildJournal) {
+	s.journal = j
+}
+
+// UndoLast reverts up to n of subjectID's most recent build changes, newest
+// first, and returns the entries it reverted. Each inverse runs through the
+// same authorized Service method a builder would call, so subjectID must
+// still hold the needed permission and the world-change feed sees an
+// ordinary envelope. An update is reverted with the version it produced: if
+// anyone has edited the entity since, the undo fails with a concurrent-edit
+// error rather than clobbering their change.
+//
+// Deleted entities are recreated under their original IDs, but data the
+// delete cascaded away is only partly journaled: properties are lost, a
+// bidirectional exit's return exit is recreated with a new ID, and a deleted
+// location gets back its outgoing exits (with their return exits) but not
+// one-way exits that led into it.
+//
+// On error UndoLast stops and returns the entries reverted so far; the failed
+// entry stays on the journal.
+func (s *Service) UndoLast(ctx context.Context, subjectID string, n int) ([]JournalEntry, error) {
+	return s.replayJournal(ctx, subjectID, n, false)
+}
+
+// RedoLast reapplies up to n changes that UndoLast reverted for subjectID,
+// most recently undone first. Any new build change by subjectID discards the
+// redo history.
+func (s *Service) RedoLast(ctx context.Context, subjectID string, n int) ([]JournalEntry, error) {
+	return s.replayJournal(ctx, subjectID, n, true)
+}
+
+func (s *Service) replayJournal(ctx context.Context, subjectID string, n int, redo bool) ([]JournalEntry, error) {
+	if s.journal == nil {
+		return nil, oops.Code("WORLD_UNDO_FAILED").Errorf("build journal not configured")
+	}
+	if n <= 0 {
+		return nil, oops.Code("WORLD_UNDO_INVALID").With("count", n).Errorf("undo count must be positive")
+	}
+
+	s.journal.replayMu.Lock()
+	defer s.journal.replayMu.Unlock()
+
+	replayCtx := withJournalReplay(ctx)
+	var done []JournalEntry
+	for len(done) < n {
+		entry := s.journal.latest(subjectID, redo)
+		if entry == nil {
+			break
+		}
+		if err := s.replayEntry(replayCtx, subjectID, entry, redo); err != nil {
+			return done, oops.Code("WORLD_UNDO_FAILED").
+				With("op", string(entry.Op)).
+				With("aggregate", string(entry.Aggregate)).
+				With("id", entry.EntityID.String()).
+				With("redo", redo).
+				Wrapf(err, "replay %s %s %s", entry.Op, entry.Aggregate, entry.EntityID)
+		}
+		s.journal.settle(subjectID, entry, redo)
+		done = append(done, *entry)
+
+		msg := "build change undone"
+		if redo {
+			msg = "build change redone"
+		}
+		slog.InfoContext(ctx, msg,
+			"subject", subjectID,
+			"op", string(entry.Op),
+			"aggregate", string(entry.Aggregate),
+			"id", entry.EntityID.String())
+	}
+	return done, nil
+}
+
+// replayEntry moves entry's entity from one snapshot to the other: to before
+// for an undo, to after for a redo.
+func (s *Service) replayEntry(ctx context.Context, subjectID string, entry *JournalEntry, redo bool) error {
+	target, current := entry.before, entry.after
+	if redo {
+		target, current = entry.after, entry.before
+	}
+
+	switch {
+	case target == nil:
+		return s.journalDelete(ctx, subjectID, entry.Aggregate, entry.EntityID)
+	case entry.Op == JournalMove:
+		to, _ := target.(Containment)
+		return s.MoveObject(ctx, subjectID, entry.EntityID, to)
+	case current == nil:
+		created, err := s.journalCreate(ctx, subjectID, target)
+		if err != nil {
+			return err
+		}
+		s.setJournalSnapshot(entry, redo, created)
+		if entry.Op == JournalDelete && !redo {
+			return s.restoreLocationExits(ctx, subjectID, entry)
+		}
+		return nil
+	default:
+		updated, err := s.journalUpdate(ctx, subjectID, target, current)
+		if err != nil {
+			return err
+		}
+		s.setJournalSnapshot(entry, redo, updated)
+		return nil
+	}
+}
+
+// setJournalSnapshot stores the post-write copy of a replayed snapshot, whose
+// version the repository refreshed, so a later replay in the other direction
+// carries the right version.
+func (s *Service) setJournalSnapshot(entry *JournalEntry, redo bool, snapshot any) {
+	if redo {
+		entry.after = snapshot
+	} else {
+		entry.before = snapshot
+	}
+}
+
+func (s *Service) journalDelete(ctx context.Context, subjectID string, aggregate wmodel.AggregateType, id ulid.ULID) error {
+	switch aggregate {
+	case wmodel.AggregateLocation:
+		return s.DeleteLocation(ctx, subjectID, id)
+	case wmodel.AggregateExit:
+		return s.DeleteExit(ctx, subjectID, id)
+	case wmodel.AggregateObject:
+		return s.DeleteObject(ctx, subjectID, id)
+	}
+	return oops.Code("WORLD_UNDO_FAILED").With("aggregate", string(aggregate)).Errorf("unsupported journal aggregate")
+}
+
+// journalCreate recreates an entity from a snapshot under its original ID.
+func (s *Service) journalCreate(ctx context.Context, subjectID string, snapshot any) (any, error) {
+	switch v := snapshot.(type) {
+	case *Location:
+		loc := cloneLocation(v)
+		loc.Version = 0
+		return loc, s.CreateLocation(ctx, subjectID, loc)
+	case *Exit:
+		exit := cloneExit(v)
+		exit.Version = 0
+		return exit, s.CreateExit(ctx, subjectID, exit)
+	case *Object:
+		obj := cloneObject(v)
+		obj.Version = 0
+		return obj, s.CreateObject(ctx, subjectID, obj)
+	}
+	return nil, oops.Code("WORLD_UNDO_FAILED").Errorf("unsupported journal snapshot %T", snapshot)
+}
+
+// journalUpdate writes snapshot's fields over the entity, guarded by the
+// version current recorded.
+func (s *Service) journalUpdate(ctx context.Context, subjectID string, snapshot, current any) (any, error) {
+	switch v := snapshot.(type) {
+	case *Location:
+		loc := cloneLocation(v)
+		if cur, ok := current.(*Location); ok {
+			loc.Version = cur.Version
+		}
+		return loc, s.UpdateLocation(ctx, subjectID, loc)
+	case *Exit:
+		exit := cloneExit(v)
+		if cur, ok := current.(*Exit); ok {
+			exit.Version = cur.Version
+		}
+		return exit, s.UpdateExit(ctx, subjectID, exit)
+	case *Object:
+		obj := cloneObject(v)
+		if cur, ok := current.(*Object); ok {
+			obj.Version = cur.Version
+		}
+		return obj, s.UpdateObject(ctx, subjectID, obj)
+	}
+	return nil, oops.Code("WORLD_UNDO_FAILED").Errorf("unsupported journal snapshot %T", snapshot)
+}
+
+// restoreLocationExits recreates the outgoing exits a location delete
+// cascaded away. It runs after the location itself is back.
+func (s *Service) restoreLocationExits(ctx context.Context, subjectID string, entry *JournalEntry) error {
+	for _, saved := range entry.exits {
+		exit := cloneExit(saved)
+		exit.Version = 0
+		if err := s.CreateExit(ctx, subjectID, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journaling reports whether writes on ctx should be recorded.
+func (s *Service) journaling(ctx context.Context) bool {
+	return s.journal != nil && !isJournalReplay(ctx)
+}
+
+// journalRecord records entry for subjectID when journaling is enabled.
+func (s *Service) journalRecord(ctx context.Context, subjectID string, entry *JournalEntry) {
+	if !s.journaling(ctx) {
+		return
+	}
+	if pending, ok := pendingJournalFrom(ctx); ok {
+		pending.entries = append(pending.entries, pendingJournalEntry{subject: subjectID, entry: entry})
+		return
+	}
+	s.journal.record(subjectID, entry)
+}
+
+// journalBefore loads the current state of an entity about to be updated or
+// deleted. It returns nil, and the change goes unjournaled, when journaling
+// is off or the read fails: the journal is best effort and never blocks a
+// build command.
+func (s *Service) journalBefore(ctx context.Context, aggregate wmodel.AggregateType, id ulid.ULID) any {
+	if !s.journaling(ctx) {
+		return nil
+	}
+	var (
+		snapshot any
+		err      error
+	)
+	switch aggregate {
+	case wmodel.AggregateLocation:
+		var loc *Location
+		if loc, err = s.locationRepo.Get(ctx, id); err == nil {
+			snapshot = cloneLocation(loc)
+		}
+	case wmodel.AggregateExit:
+		var exit *Exit
+		if exit, err = s.exitRepo.Get(ctx, id); err == nil {
+			snapshot = cloneExit(exit)
+		}
+	case wmodel.AggregateObject:
+		var obj *Object
+		if obj, err = s.objectRepo.Get(ctx, id); err == nil {
+			snapshot = cloneObject(obj)
+		}
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "build journal: snapshot failed; change will not be undoable",
+			"aggregate", string(aggregate),
+			"id", id.String(),
+			"error", err)
+		return nil
+	}
+	return snapshot
+}
+
+// journalLocationExits loads the outgoing exits a location delete will
+// cascade away. A failed read is logged and yields none.
+func (s *Service) journalLocationExits(ctx context.Context, id ulid.ULID) []*Exit {
+	if !s.journaling(ctx) || s.exitRepo == nil {
+		return nil
+	}
+	exits, err := CollectPages(ctx, func(ctx context.Context, opts ListOptions) (Page[*Exit], error) {
+		return s.exitRepo.ListFromLocation(ctx, id, opts)
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "build journal: exit snapshot failed; undo will not restore exits",
+			"location_id", id.String(),
+			"error", err)
+		return nil
+	}
+	saved := make([]*Exit, 0, len(exits))
+	for _, exit := range exits {
+		saved = append(saved, cloneExit(exit))
+	}
+	return saved
+}
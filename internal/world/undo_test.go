@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+func TestWorldService_UndoLastRevertsDescriptionOverwrite(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	locID := ulid.Make()
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", "location:"+locID.String())
+	locRepo := worldtest.NewMockLocationRepository(t)
+	outbox := &mockOutboxWriter{}
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo,
+		Engine:       engine,
+	}, outbox))
+	journal := world.NewBuildJournal(0, 0)
+	svc.SetBuildJournal(journal)
+
+	original := &world.Location{ID: locID, Name: "Hall", Description: "A grand hall.", Type: world.LocationTypePersistent, Version: 3}
+	locRepo.EXPECT().Get(mock.Anything, locID).Return(original, nil).Once()
+	locRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(l *world.Location) bool {
+		return l.Description == "oops"
+	})).Run(func(_ context.Context, l *world.Location) { l.Version = 4 }).Return(&wmodel.MutationDelta{}, nil).Once()
+	locRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(l *world.Location) bool {
+		return l.Description == "A grand hall." && l.Version == 4
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	overwrite := &world.Location{ID: locID, Name: "Hall", Description: "oops", Type: world.LocationTypePersistent, Version: 3}
+	require.NoError(t, svc.UpdateLocation(ctx, subjectID, overwrite))
+	require.Len(t, journal.Entries(subjectID), 1)
+
+	undone, err := svc.UndoLast(ctx, subjectID, 5)
+	require.NoError(t, err)
+	require.Len(t, undone, 1)
+	assert.Equal(t, world.JournalUpdate, undone[0].Op)
+	assert.Equal(t, locID, undone[0].EntityID)
+	assert.Equal(t, "location_updated", outbox.lastIntent.Kind, "the revert emits an ordinary envelope")
+	assert.Equal(t, subjectID, outbox.lastIntent.Actor)
+	assert.Empty(t, journal.Entries(subjectID), "the undone change is not re-journaled")
+}
+
+func TestWorldService_UndoAndRedoLocationCreate(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", "location:*")
+	locRepo := worldtest.NewMockLocationRepository(t)
+	propRepo := worldtest.NewMockPropertyRepository(t)
+	exitRepo := worldtest.NewMockExitRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo,
+		ExitRepo:     exitRepo,
+		PropertyRepo: propRepo,
+		Engine:       engine,
+	}, &mockOutboxWriter{}))
+	svc.SetBuildJournal(world.NewBuildJournal(0, 0))
+
+	loc := &world.Location{Name: "Closet", Description: "Cramped.", Type: world.LocationTypePersistent}
+	locRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+	require.NoError(t, svc.CreateLocation(ctx, subjectID, loc))
+
+	engine.Grant(subjectID, "delete", "location:"+loc.ID.String())
+	propRepo.EXPECT().DeleteByParent(mock.Anything, "location", loc.ID).Return(nil).Once()
+	locRepo.EXPECT().Delete(mock.Anything, loc.ID, 0).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	undone, err := svc.UndoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	require.Len(t, undone, 1)
+	assert.Equal(t, world.JournalCreate, undone[0].Op)
+
+	locRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *world.Location) bool {
+		return l.ID == loc.ID && l.Name == "Closet"
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	redone, err := svc.RedoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	require.Len(t, redone, 1)
+
+	redone, err = svc.RedoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	assert.Empty(t, redone, "nothing left to redo")
+}
+
+func TestWorldService_UndoLastRestoresDeletedLocationExits(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	locID := ulid.Make()
+	otherID := ulid.Make()
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "delete", "location:"+locID.String())
+	engine.Grant(subjectID, "write", "location:*")
+	engine.Grant(subjectID, "write", "exit:*")
+	locRepo := worldtest.NewMockLocationRepository(t)
+	exitRepo := worldtest.NewMockExitRepository(t)
+	propRepo := worldtest.NewMockPropertyRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo,
+		ExitRepo:     exitRepo,
+		PropertyRepo: propRepo,
+		Engine:       engine,
+	}, &mockOutboxWriter{}))
+	svc.SetBuildJournal(world.NewBuildJournal(0, 0))
+
+	loc := &world.Location{ID: locID, Name: "Vault", Description: "Dusty.", Type: world.LocationTypePersistent, Version: 2}
+	exit := &world.Exit{ID: ulid.Make(), FromLocationID: locID, ToLocationID: otherID, Name: "out", Visibility: world.VisibilityAll, Version: 1}
+	locRepo.EXPECT().Get(mock.Anything, locID).Return(loc, nil).Once()
+	exitRepo.EXPECT().ListFromLocation(mock.Anything, locID, mock.Anything).Return(world.Page[*world.Exit]{Items: []*world.Exit{exit}}, nil).Once()
+	propRepo.EXPECT().DeleteByParent(mock.Anything, "location", locID).Return(nil).Once()
+	locRepo.EXPECT().Delete(mock.Anything, locID, 0).Return(&wmodel.MutationDelta{}, nil).Once()
+	require.NoError(t, svc.DeleteLocation(ctx, subjectID, locID))
+
+	locRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(l *world.Location) bool {
+		return l.ID == locID && l.Description == "Dusty." && l.Version == 0
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+	exitRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(e *world.Exit) bool {
+		return e.ID == exit.ID && e.ToLocationID == otherID
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	undone, err := svc.UndoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	require.Len(t, undone, 1)
+	assert.Equal(t, world.JournalDelete, undone[0].Op)
+}
+
+func TestWorldService_UndoAndRedoObjectMove(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	from := ulid.Make()
+	to := ulid.Make()
+
+	obj, err := world.NewObject("lamp", world.InLocation(from))
+	require.NoError(t, err)
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", "object:"+obj.ID.String())
+	objRepo := worldtest.NewMockObjectRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		ObjectRepo: objRepo,
+		Engine:     engine,
+	}, &mockOutboxWriter{}))
+	svc.SetBuildJournal(world.NewBuildJournal(0, 0))
+
+	objRepo.EXPECT().Get(mock.Anything, obj.ID).Return(obj, nil)
+	objRepo.EXPECT().Move(mock.Anything, obj.ID, world.InLocation(to), 0).Return(&wmodel.MutationDelta{}, nil).Twice()
+	objRepo.EXPECT().Move(mock.Anything, obj.ID, world.InLocation(from), 0).Return(&wmodel.MutationDelta{}, nil).Once()
+
+	require.NoError(t, svc.MoveObject(ctx, subjectID, obj.ID, world.InLocation(to)))
+
+	undone, err := svc.UndoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	require.Len(t, undone, 1)
+	assert.Equal(t, world.JournalMove, undone[0].Op)
+
+	redone, err := svc.RedoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	require.Len(t, redone, 1)
+}
+
+func TestWorldService_UndoLastKeepsEntryWhenDenied(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "write", "location:*")
+	locRepo := worldtest.NewMockLocationRepository(t)
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo,
+		PropertyRepo: worldtest.NewMockPropertyRepository(t),
+		Engine:       engine,
+	}, &mockOutboxWriter{}))
+	journal := world.NewBuildJournal(0, 0)
+	svc.SetBuildJournal(journal)
+
+	loc := &world.Location{Name: "Annex", Description: "Bare.", Type: world.LocationTypePersistent}
+	locRepo.EXPECT().Create(mock.Anything, mock.Anything).Return(&wmodel.MutationDelta{}, nil).Once()
+	require.NoError(t, svc.CreateLocation(ctx, subjectID, loc))
+
+	// No delete grant: reverting the create is refused like any other delete.
+	undone, err := svc.UndoLast(ctx, subjectID, 1)
+	assert.Empty(t, undone)
+	errutil.AssertErrorCode(t, err, "LOCATION_ACCESS_DENIED")
+	assert.Len(t, journal.Entries(subjectID), 1, "a failed undo stays on the journal")
+}
+
+func TestWorldService_UndoLastValidation(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	svc := world.NewService(world.ServiceConfig{Engine: policytest.NewGrantEngine()})
+
+	_, err := svc.UndoLast(ctx, subjectID, 1)
+	errutil.AssertErrorCode(t, err, "WORLD_UNDO_FAILED")
+
+	svc.SetBuildJournal(world.NewBuildJournal(0, 0))
+	_, err = svc.UndoLast(ctx, subjectID, 0)
+	errutil.AssertErrorCode(t, err, "WORLD_UNDO_INVALID")
+
+	undone, err := svc.UndoLast(ctx, subjectID, 1)
+	require.NoError(t, err)
+	assert.Empty(t, undone)
+}
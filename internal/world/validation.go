@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -28,6 +29,13 @@ const (
 	MaxCharacterNameLength = 32
 )
 
+// Exit traversal limits. A walk longer than MaxTraversalDelay would leave a
+// character stranded between locations for longer than any game wants.
+const (
+	MaxTraversalDelay = 10 * time.Minute
+	MaxTraversalCost  = 1_000_000
+)
+
 // ValidationError represents an input validation error.
 type ValidationError struct {
 	Field   string
@@ -183,6 +191,19 @@ func ValidateVisibleTo(visibleTo []ulid.ULID) error {
 	return nil
 }
 
+// ValidateTraversal checks an exit's traversal delay and cost.
+// Both may be zero (an instant, free exit); neither may be negative or
+// exceed its limit.
+func ValidateTraversal(delay time.Duration, cost int) error {
+	if delay < 0 || delay > MaxTraversalDelay {
+		return &ValidationError{Field: "traversal_delay", Message: fmt.Sprintf("must be between 0 and %s", MaxTraversalDelay)}
+	}
+	if cost < 0 || cost > MaxTraversalCost {
+		return &ValidationError{Field: "traversal_cost", Message: fmt.Sprintf("must be between 0 and %d", MaxTraversalCost)}
+	}
+	return nil
+}
+
 // ValidateLockData checks that lock data is valid.
 // Must have reasonable number of keys, keys must be valid identifiers,
 // and values must be JSON-serializable (for deep copy in ReverseExit).
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package verbevents delivers triggered object verbs to the plugins bound to
+// them. Dispatcher implements world.ObjectVerbDispatcher.
+package verbevents
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+// deliveryTimeout bounds one verb delivery to a plugin, matching the
+// subscriber's per-event delivery budget.
+const deliveryTimeout = 5 * time.Second
+
+// PluginDeliverer delivers an event to a named plugin and publishes what the
+// plugin emits in response. *plugins.Manager satisfies it.
+type PluginDeliverer interface {
+	DeliverEvent(ctx context.Context, pluginName string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error)
+	EmitPluginEvent(ctx context.Context, pluginName string, event pluginsdk.EmitEvent) error
+}
+
+// Dispatcher hands a verb:<name> event to the plugin bound to a verb,
+// attributed to the character that triggered it.
+type Dispatcher struct {
+	plugins PluginDeliverer
+	now     func() time.Time
+}
+
+var _ world.ObjectVerbDispatcher = (*Dispatcher)(nil)
+
+// NewDispatcher constructs a Dispatcher over plugins.
+//
+// Panics when plugins is nil, mirroring zoneevents.NewPublisher's
+// construction-time failure discipline.
+func NewDispatcher(plugins PluginDeliverer) *Dispatcher {
+	if plugins == nil {
+		panic("verbevents.NewDispatcher: nil PluginDeliverer")
+	}
+	return &Dispatcher{plugins: plugins, now: time.Now}
+}
+
+// DispatchVerb delivers a verb:<name> event scoped to the invoking
+// character's location stream to verb.Plugin, then publishes the plugin's
+// emits through the shared plugin emitter so manifest validation applies
+// exactly as on the subscriber path.
+func (d *Dispatcher) DispatchVerb(ctx context.Context, obj *world.Object, verb world.ObjectVerb, inv world.VerbInvocation) error {
+	payload, err := json.Marshal(eventvocab.ObjectVerbPayload{
+		ObjectID:      obj.ID.String(),
+		ObjectName:    obj.Name,
+		Verb:          verb.Name,
+		Args:          inv.Args,
+		CharacterID:   inv.CharacterID.String(),
+		CharacterName: inv.CharacterName,
+		LocationID:    inv.LocationID.String(),
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_object_verb_payload").Wrap(err)
+	}
+	event := pluginsdk.Event{
+		ID:        core.NewULID().String(),
+		Stream:    world.LocationStream(inv.LocationID),
+		Type:      pluginsdk.EventType(eventvocab.VerbEventType(verb.Name)),
+		Timestamp: d.now().UnixMilli(),
+		ActorKind: pluginsdk.ActorCharacter,
+		ActorID:   inv.CharacterID.String(),
+		Payload:   string(payload),
+	}
+
+	dctx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+	defer cancel()
+	dctx = core.WithActor(dctx, core.Actor{Kind: core.ActorCharacter, ID: inv.CharacterID.String()})
+
+	emits, err := d.plugins.DeliverEvent(dctx, verb.Plugin, event)
+	if err != nil {
+		return oops.With("plugin", verb.Plugin).With("verb", verb.Name).Wrap(err)
+	}
+	for _, emit := range emits {
+		if err := d.plugins.EmitPluginEvent(dctx, verb.Plugin, emit); err != nil {
+			return oops.With("plugin", verb.Plugin).
+				With("verb", verb.Name).
+				With("stream", emit.Stream).
+				Wrap(err)
+		}
+	}
+	return nil
+}
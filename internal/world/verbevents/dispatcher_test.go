@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package verbevents_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/verbevents"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+)
+
+type fakePlugins struct {
+	plugins   []string
+	delivered []pluginsdk.Event
+	actors    []core.Actor
+	emits     []pluginsdk.EmitEvent
+	emitted   []pluginsdk.EmitEvent
+	err       error
+}
+
+func (p *fakePlugins) DeliverEvent(ctx context.Context, pluginName string, event pluginsdk.Event) ([]pluginsdk.EmitEvent, error) {
+	p.plugins = append(p.plugins, pluginName)
+	p.delivered = append(p.delivered, event)
+	if actor, ok := core.ActorFromContext(ctx); ok {
+		p.actors = append(p.actors, actor)
+	}
+	return p.emits, p.err
+}
+
+func (p *fakePlugins) EmitPluginEvent(_ context.Context, _ string, event pluginsdk.EmitEvent) error {
+	p.emitted = append(p.emitted, event)
+	return nil
+}
+
+func TestDispatchVerbDeliversVerbEventToBoundPlugin(t *testing.T) {
+	plugins := &fakePlugins{emits: []pluginsdk.EmitEvent{{Stream: "location:x", Type: "harbor-bell:rung", Payload: "{}"}}}
+	d := verbevents.NewDispatcher(plugins)
+
+	obj, err := world.NewObject("Brass Button", world.InLocation(ulid.Make()))
+	require.NoError(t, err)
+	verb := world.ObjectVerb{Name: "push", Plugin: "harbor-bell"}
+	inv := world.VerbInvocation{
+		ObjectID:      obj.ID,
+		Verb:          "push",
+		Args:          "twice",
+		CharacterID:   ulid.Make(),
+		CharacterName: "Marisol",
+		LocationID:    ulid.Make(),
+	}
+
+	require.NoError(t, d.DispatchVerb(context.Background(), obj, verb, inv))
+
+	require.Len(t, plugins.delivered, 1)
+	assert.Equal(t, []string{"harbor-bell"}, plugins.plugins)
+	event := plugins.delivered[0]
+	assert.Equal(t, pluginsdk.EventType("verb:push"), event.Type)
+	assert.Equal(t, world.LocationStream(inv.LocationID), event.Stream)
+	assert.Equal(t, pluginsdk.ActorCharacter, event.ActorKind)
+	assert.Equal(t, inv.CharacterID.String(), event.ActorID)
+
+	var payload eventvocab.ObjectVerbPayload
+	require.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+	assert.Equal(t, eventvocab.ObjectVerbPayload{
+		ObjectID:      obj.ID.String(),
+		ObjectName:    "Brass Button",
+		Verb:          "push",
+		Args:          "twice",
+		CharacterID:   inv.CharacterID.String(),
+		CharacterName: "Marisol",
+		LocationID:    inv.LocationID.String(),
+	}, payload)
+
+	require.Len(t, plugins.actors, 1)
+	assert.Equal(t, core.Actor{Kind: core.ActorCharacter, ID: inv.CharacterID.String()}, plugins.actors[0])
+	assert.Equal(t, plugins.emits, plugins.emitted, "the plugin's emits are published")
+}
+
+func TestDispatchVerbReturnsDeliveryFailure(t *testing.T) {
+	plugins := &fakePlugins{err: errors.New("plugin not loaded or unknown")}
+	d := verbevents.NewDispatcher(plugins)
+	obj, err := world.NewObject("Brass Button", world.InLocation(ulid.Make()))
+	require.NoError(t, err)
+
+	err = d.DispatchVerb(context.Background(), obj, world.ObjectVerb{Name: "push", Plugin: "harbor-bell"}, world.VerbInvocation{ObjectID: obj.ID, Verb: "push"})
+	require.Error(t, err)
+	assert.Empty(t, plugins.emitted)
+}
+
+func TestNewDispatcherPanicsOnNilDeliverer(t *testing.T) {
+	assert.Panics(t, func() { verbevents.NewDispatcher(nil) })
+}
@@ -104,7 +104,8 @@ type Envelope struct {
 // position). It builds the affected-aggregates manifest from the delta (NOT from
 // command inputs) and carries intent.GameID through to Envelope.GameID unchanged.
 //
-// Its ONLY production caller is the postgres WriteIntent writer (05-05 Task 3):
+// Its ONLY production caller is the postgres WriteIntent writer (05-05 Task 3;
+// the in-memory test writer in internal/world/memory mirrors it):
 // the writer allocates epoch/position from the locked counter and finalizes in
 // one place. Executors (05-06) and commands never construct a finalized Envelope
 // — they pass (intent, delta) to WriteIntent and receive the finalized envelope
@@ -25,6 +25,9 @@ const (
 	AggregateObject AggregateType = "object"
 	// AggregateScene is a world scene aggregate.
 	AggregateScene AggregateType = "scene"
+	// AggregateProperty is an entity property row. Properties carry no
+	// version guard, so their affected entries report zero versions.
+	AggregateProperty AggregateType = "property"
 )
 
 // AffectedAggregate describes a single aggregate row that a write touched,
@@ -34,7 +37,7 @@ const (
 // AffectedAggregate entries so the outbox manifest can be built from the rows the
 // command actually touched rather than from command inputs.
 type AffectedAggregate struct {
-	// Type is the aggregate kind (location/exit/character/object/scene).
+	// Type is the aggregate kind (location/exit/character/object/scene/property).
 	Type AggregateType
 	// ID is the aggregate's primary key.
 	ID ulid.ULID
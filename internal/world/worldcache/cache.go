@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package worldcache provides an optional in-memory read-through cache for
+// the hot point reads of the world model: Location, Object, and Character
+// lookups by ID. `look` and movement re-read the same rooms and occupants
+// constantly; the cache serves those from memory instead of Postgres.
+//
+// Consistency model:
+//
+//   - Every write through a wrapped repository invalidates the written
+//     entity. Deletes also purge every cached object, because the schema's
+//     ON DELETE SET NULL containment FKs rewrite object rows the write never
+//     names. A location delete purges every cached location too: its
+//     children's parent_id and other rooms' overflow_id pointing at it are
+//     cleared the same way.
+//   - A write inside a transaction started by the wrapped Transactor is
+//     invalidated again after the outermost transaction returns, so a read
+//     that raced the uncommitted write cannot leave the old row cached.
+//   - Reads inside such a transaction bypass the cache, so a transaction
+//     always sees its own writes and locked reads are never served stale.
+//   - A per-kind epoch stops a read that started before an invalidation from
+//     storing the value it loaded.
+//   - Entries expire after Config.TTL. That bounds staleness for writes that
+//     bypass the wrapped repositories and for peers in a multi-node
+//     deployment, which this cache does not coordinate with.
+package worldcache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Defaults applied by New when the corresponding Config field is unset.
+const (
+	DefaultMaxEntries = 10000
+	DefaultTTL        = 30 * time.Second
+)
+
+// Config configures a Cache.
+type Config struct {
+	// MaxEntries bounds the number of cached entities across all kinds; the
+	// least recently used entry is evicted first. Zero uses
+	// DefaultMaxEntries.
+	MaxEntries int
+	// TTL is how long an entry is served before it is re-read. Zero uses
+	// DefaultTTL.
+	TTL time.Duration
+}
+
+// kind identifies the entity type of a cache entry.
+type kind uint8
+
+const (
+	kindLocation kind = iota
+	kindObject
+	kindCharacter
+	numKinds
+)
+
+// key identifies one cached entity.
+type key struct {
+	kind kind
+	id   ulid.ULID
+}
+
+// entry is one cached entity. value is a private copy owned by the cache.
+type entry struct {
+	key     key
+	value   any
+	expires time.Time
+}
+
+// Stats reports cache effectiveness counters since construction.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+// Cache is an LRU cache of world entities shared by the repository wrappers
+// it creates. Safe for concurrent use.
+type Cache struct {
+	maxEntries int
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List // front = most recently used; values are *entry
+	entries map[key]*list.Element
+	epochs  [numKinds]uint64
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// New creates a Cache. Unset Config fields take their defaults.
+func New(cfg Config) *Cache {
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultMaxEntries
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+	return &Cache{
+		maxEntries: cfg.MaxEntries,
+		ttl:        cfg.TTL,
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    make(map[key]*list.Element),
+	}
+}
+
+// Stats returns the current counters.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	n := c.lru.Len()
+	c.mu.Unlock()
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   n,
+	}
+}
+
+// lookup returns the cached value for k and, on a miss, the epoch the caller
+// must pass to store so a concurrent invalidation discards the load.
+func (c *Cache) lookup(k key) (value any, epoch uint64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, found := c.entries[k]; found {
+		e, _ := el.Value.(*entry)
+		if c.now().Before(e.expires) {
+			c.lru.MoveToFront(el)
+			c.hits.Add(1)
+			return e.value, 0, true
+		}
+		c.removeLocked(el)
+	}
+	c.misses.Add(1)
+	return nil, c.epochs[k.kind], false
+}
+
+// store caches value under k unless k's kind was invalidated since epoch
+// was read.
+func (c *Cache) store(k key, value any, epoch uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.epochs[k.kind] != epoch {
+		return
+	}
+	if el, found := c.entries[k]; found {
+		c.removeLocked(el)
+	}
+	c.entries[k] = c.lru.PushFront(&entry{key: k, value: value, expires: c.now().Add(c.ttl)})
+	for c.lru.Len() > c.maxEntries {
+		c.removeLocked(c.lru.Back())
+		c.evictions.Add(1)
+	}
+}
+
+// invalidate drops k and advances its kind's epoch.
+func (c *Cache) invalidate(k key) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epochs[k.kind]++
+	if el, found := c.entries[k]; found {
+		c.removeLocked(el)
+	}
+}
+
+// purge drops every entry of kind k and advances its epoch.
+func (c *Cache) purge(k kind) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epochs[k]++
+	for el := c.lru.Front(); el != nil; {
+		next := el.Next()
+		if e, _ := el.Value.(*entry); e.key.kind == k {
+			c.removeLocked(el)
+		}
+		el = next
+	}
+}
+
+func (c *Cache) removeLocked(el *list.Element) {
+	e, _ := el.Value.(*entry)
+	delete(c.entries, e.key)
+	c.lru.Remove(el)
+}
+
+// pendingKey is the context key for the invalidations a wrapped transaction
+// replays after it returns.
+type pendingKey struct{}
+
+// pending records the invalidations made inside one outermost transaction.
+type pending struct {
+	mu     sync.Mutex
+	keys   []key
+	purges []kind
+}
+
+func pendingFromContext(ctx context.Context) *pending {
+	p, _ := ctx.Value(pendingKey{}).(*pending)
+	return p
+}
+
+// written invalidates k now and, inside a wrapped transaction, again after
+// the transaction returns.
+func (c *Cache) written(ctx context.Context, k key) {
+	c.invalidate(k)
+	if p := pendingFromContext(ctx); p != nil {
+		p.mu.Lock()
+		p.keys = append(p.keys, k)
+		p.mu.Unlock()
+	}
+}
+
+// purged purges kind k now and, inside a wrapped transaction, again after the
+// transaction returns.
+func (c *Cache) purged(ctx context.Context, k kind) {
+	c.purge(k)
+	if p := pendingFromContext(ctx); p != nil {
+		p.mu.Lock()
+		p.purges = append(p.purges, k)
+		p.mu.Unlock()
+	}
+}
+
+// bypass reports whether reads on ctx must skip the cache: they run inside a
+// wrapped transaction and must observe its uncommitted writes.
+func bypass(ctx context.Context) bool {
+	return pendingFromContext(ctx) != nil
+}
+
+// Transactor wraps inner so writes made inside its transactions are
+// invalidated again once the outermost transaction has committed or rolled
+// back, and so reads inside them bypass the cache. Use the wrapped
+// Transactor everywhere the wrapped repositories are written in a
+// transaction.
+func (c *Cache) Transactor(inner world.Transactor) world.Transactor {
+	return &transactor{inner: inner, cache: c}
+}
+
+type transactor struct {
+	inner world.Transactor
+	cache *Cache
+}
+
+// InTransaction runs fn in inner's transaction. Nested calls join the
+// outermost transaction's pending set, mirroring the re-entrant semantics of
+// the postgres Transactor.
+func (t *transactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if pendingFromContext(ctx) != nil {
+		return t.inner.InTransaction(ctx, fn)
+	}
+	p := &pending{}
+	err := t.inner.InTransaction(context.WithValue(ctx, pendingKey{}, p), fn)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, k := range p.keys {
+		t.cache.invalidate(k)
+	}
+	for _, k := range p.purges {
+		t.cache.purge(k)
+	}
+	return err
+}
@@ -0,0 +1,398 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package worldcache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/idgen"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/worldtest"
+)
+
+// passTransactor runs fn directly, standing in for the postgres Transactor.
+type passTransactor struct{}
+
+func (passTransactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func testLocation(id ulid.ULID, name string) *world.Location {
+	return &world.Location{ID: id, Name: name, Type: world.LocationTypePersistent, Version: 1}
+}
+
+func TestLocationGetServesRepeatReadsFromCache(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Plaza"), nil).Once()
+
+	c := New(Config{})
+	repo := c.Locations(inner)
+
+	first, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	second, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Plaza", second.Name)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Entries: 1}, c.Stats())
+
+	first.Name = "Mutated"
+	second.Name = "Mutated too"
+	third, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "Plaza", third.Name, "callers must not be able to mutate the cached copy")
+}
+
+func TestGetDoesNotCacheErrors(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(nil, world.ErrNotFound).Twice()
+
+	repo := New(Config{}).Locations(inner)
+
+	_, err := repo.Get(ctx, id)
+	require.ErrorIs(t, err, world.ErrNotFound)
+	_, err = repo.Get(ctx, id)
+	require.ErrorIs(t, err, world.ErrNotFound)
+}
+
+func TestLocationGetManyLoadsOnlyMisses(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	cached, missed, absent := idgen.New(), idgen.New(), idgen.New()
+	inner.EXPECT().Get(mock.Anything, cached).Return(testLocation(cached, "Plaza"), nil).Once()
+	inner.EXPECT().GetMany(mock.Anything, []ulid.ULID{missed, absent}).
+		Return([]*world.Location{testLocation(missed, "Alley")}, nil).Once()
+
+	c := New(Config{})
+	repo := c.Locations(inner)
+
+	_, err := repo.Get(ctx, cached)
+	require.NoError(t, err)
+	got, err := repo.GetMany(ctx, []ulid.ULID{cached, missed, absent})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	// Both found locations are now cached; the absent one is not.
+	again, err := repo.GetMany(ctx, []ulid.ULID{cached, missed})
+	require.NoError(t, err)
+	assert.Len(t, again, 2)
+	assert.Equal(t, 2, c.Stats().Entries)
+}
+
+func TestLocationUpdateInvalidates(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Old"), nil).Once()
+	inner.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "New"), nil).Once()
+
+	repo := New(Config{}).Locations(inner)
+
+	_, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	_, err = repo.Update(ctx, testLocation(id, "New"))
+	require.NoError(t, err)
+	got, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "New", got.Name)
+}
+
+func TestLocationSetTagsInvalidates(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Meadow"), nil).Twice()
+	inner.EXPECT().SetTags(mock.Anything, id, []string{"outdoor"}, 1).Return(nil, nil).Once()
+
+	repo := New(Config{}).Locations(inner)
+
+	_, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	_, err = repo.SetTags(ctx, id, []string{"outdoor"}, 1)
+	require.NoError(t, err)
+	_, err = repo.Get(ctx, id)
+	require.NoError(t, err)
+}
+
+func TestEntriesExpireAfterTTL(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Plaza"), nil).Twice()
+
+	now := time.Unix(1_700_000_000, 0)
+	c := New(Config{TTL: time.Minute})
+	c.now = func() time.Time { return now }
+	repo := c.Locations(inner)
+
+	_, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	now = now.Add(59 * time.Second)
+	_, err = repo.Get(ctx, id)
+	require.NoError(t, err)
+	now = now.Add(time.Second)
+	_, err = repo.Get(ctx, id)
+	require.NoError(t, err)
+}
+
+func TestLeastRecentlyUsedEntryIsEvicted(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockLocationRepository(t)
+	a, b, d := idgen.New(), idgen.New(), idgen.New()
+	inner.EXPECT().Get(mock.Anything, a).Return(testLocation(a, "A"), nil).Once()
+	inner.EXPECT().Get(mock.Anything, b).Return(testLocation(b, "B"), nil).Twice()
+	inner.EXPECT().Get(mock.Anything, d).Return(testLocation(d, "D"), nil).Once()
+
+	c := New(Config{MaxEntries: 2})
+	repo := c.Locations(inner)
+
+	for _, id := range []ulid.ULID{a, b, a, d, a, b} {
+		_, err := repo.Get(ctx, id)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, uint64(2), c.Stats().Evictions)
+}
+
+func TestLoadRacingAnInvalidationIsNotStored(t *testing.T) {
+	c := New(Config{})
+	k := key{kindLocation, idgen.New()}
+
+	_, epoch, ok := c.lookup(k)
+	require.False(t, ok)
+	c.invalidate(key{kindLocation, idgen.New()})
+	c.store(k, testLocation(k.id, "Stale"), epoch)
+
+	_, _, ok = c.lookup(k)
+	assert.False(t, ok, "a value loaded before an invalidation must not be cached")
+}
+
+func TestTransactorReinvalidatesAfterOutermostTransaction(t *testing.T) {
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	// Once by the concurrent reader before commit, once after.
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Old"), nil).Once()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "New"), nil).Once()
+
+	c := New(Config{})
+	repo := c.Locations(inner)
+	tx := c.Transactor(passTransactor{})
+
+	err := tx.InTransaction(context.Background(), func(txCtx context.Context) error {
+		_, err := repo.Update(txCtx, testLocation(id, "New"))
+		require.NoError(t, err)
+		// A reader outside the transaction sees the old committed row and
+		// caches it before the writer commits.
+		_, err = repo.Get(context.Background(), id)
+		return err
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, "New", got.Name)
+}
+
+func TestReadsInsideTransactionBypassCache(t *testing.T) {
+	inner := worldtest.NewMockLocationRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(testLocation(id, "Plaza"), nil).Times(3)
+
+	c := New(Config{})
+	repo := c.Locations(inner)
+	tx := c.Transactor(passTransactor{})
+
+	err := tx.InTransaction(context.Background(), func(txCtx context.Context) error {
+		return tx.InTransaction(txCtx, func(nestedCtx context.Context) error {
+			for range 3 {
+				if _, err := repo.Get(nestedCtx, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, c.Stats().Entries)
+}
+
+func TestDeletesPurgeCachedObjects(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete func(ctx context.Context, c *Cache, t *testing.T)
+	}{
+		{
+			name: "location delete",
+			delete: func(ctx context.Context, c *Cache, t *testing.T) {
+				inner := worldtest.NewMockLocationRepository(t)
+				inner.EXPECT().Delete(mock.Anything, mock.Anything, 1).Return(nil, nil).Once()
+				_, err := c.Locations(inner).Delete(ctx, idgen.New(), 1)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "character delete",
+			delete: func(ctx context.Context, c *Cache, t *testing.T) {
+				inner := worldtest.NewMockCharacterRepository(t)
+				inner.EXPECT().Delete(mock.Anything, mock.Anything, 1).Return(nil, nil).Once()
+				_, err := c.Characters(inner).Delete(ctx, idgen.New(), 1)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "container delete",
+			delete: func(ctx context.Context, c *Cache, t *testing.T) {
+				inner := worldtest.NewMockObjectRepository(t)
+				inner.EXPECT().Delete(mock.Anything, mock.Anything, 1).Return(nil, nil).Once()
+				_, err := c.Objects(inner).Delete(ctx, idgen.New(), 1)
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			objects := worldtest.NewMockObjectRepository(t)
+			objID := idgen.New()
+			objects.EXPECT().Get(mock.Anything, objID).Return(&world.Object{ID: objID, Name: "lamp"}, nil).Twice()
+
+			c := New(Config{})
+			repo := c.Objects(objects)
+			_, err := repo.Get(ctx, objID)
+			require.NoError(t, err)
+
+			tt.delete(ctx, c, t)
+
+			_, err = repo.Get(ctx, objID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLocationDeletePurgesCachedReferrers(t *testing.T) {
+	tests := []struct {
+		name  string
+		refer func(loc *world.Location, deleted ulid.ULID)
+		ref   func(loc *world.Location) *ulid.ULID
+	}{
+		{
+			name:  "parent",
+			refer: func(loc *world.Location, deleted ulid.ULID) { loc.ParentID = &deleted },
+			ref:   func(loc *world.Location) *ulid.ULID { return loc.ParentID },
+		},
+		{
+			name:  "overflow",
+			refer: func(loc *world.Location, deleted ulid.ULID) { loc.OverflowID = &deleted },
+			ref:   func(loc *world.Location) *ulid.ULID { return loc.OverflowID },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			inner := worldtest.NewMockLocationRepository(t)
+			deletedID, otherID := idgen.New(), idgen.New()
+			before := &world.Location{ID: otherID, Name: "Attic"}
+			tt.refer(before, deletedID)
+			inner.EXPECT().Get(mock.Anything, otherID).Return(before, nil).Once()
+			inner.EXPECT().Delete(mock.Anything, deletedID, 1).Return(nil, nil).Once()
+			inner.EXPECT().Get(mock.Anything, otherID).Return(&world.Location{ID: otherID, Name: "Attic"}, nil).Once()
+
+			repo := New(Config{}).Locations(inner)
+			_, err := repo.Get(ctx, otherID)
+			require.NoError(t, err)
+			_, err = repo.Delete(ctx, deletedID, 1)
+			require.NoError(t, err)
+
+			got, err := repo.Get(ctx, otherID)
+			require.NoError(t, err)
+			assert.Nil(t, tt.ref(got), "re-read, not served pointing at the deleted location")
+		})
+	}
+}
+
+func TestObjectMoveInvalidates(t *testing.T) {
+	ctx := context.Background()
+	inner := worldtest.NewMockObjectRepository(t)
+	id := idgen.New()
+	inner.EXPECT().Get(mock.Anything, id).Return(&world.Object{ID: id, Name: "lamp"}, nil).Twice()
+	inner.EXPECT().Move(mock.Anything, id, mock.Anything, 1).Return(nil, nil).Once()
+
+	repo := New(Config{}).Objects(inner)
+	_, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	_, err = repo.Move(ctx, id, world.Containment{}, 1)
+	require.NoError(t, err)
+	_, err = repo.Get(ctx, id)
+	require.NoError(t, err)
+}
+
+func TestCharacterWritesInvalidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(ctx context.Context, repo world.CharacterRepository, inner *worldtest.MockCharacterRepository, id ulid.ULID) error
+	}{
+		{
+			name: "update location",
+			write: func(ctx context.Context, repo world.CharacterRepository, inner *worldtest.MockCharacterRepository, id ulid.ULID) error {
+				inner.EXPECT().UpdateLocation(mock.Anything, id, mock.Anything, 1).Return(nil, nil).Once()
+				_, err := repo.UpdateLocation(ctx, id, nil, 1)
+				return err
+			},
+		},
+		{
+			name: "update preferences",
+			write: func(ctx context.Context, repo world.CharacterRepository, inner *worldtest.MockCharacterRepository, id ulid.ULID) error {
+				inner.EXPECT().UpdatePreferences(mock.Anything, id, mock.Anything, 1).Return(nil, nil).Once()
+				_, err := repo.UpdatePreferences(ctx, id, []byte(`{}`), 1)
+				return err
+			},
+		},
+		{
+			name: "update",
+			write: func(ctx context.Context, repo world.CharacterRepository, inner *worldtest.MockCharacterRepository, id ulid.ULID) error {
+				inner.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				_, err := repo.Update(ctx, &world.Character{ID: id})
+				return err
+			},
+		},
+		{
+			name: "set tags",
+			write: func(ctx context.Context, repo world.CharacterRepository, inner *worldtest.MockCharacterRepository, id ulid.ULID) error {
+				inner.EXPECT().SetTags(mock.Anything, id, []string{"npc"}, 1).Return(nil, nil).Once()
+				_, err := repo.SetTags(ctx, id, []string{"npc"}, 1)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			inner := worldtest.NewMockCharacterRepository(t)
+			id := idgen.New()
+			inner.EXPECT().Get(mock.Anything, id).Return(&world.Character{ID: id, Name: "Ana"}, nil).Twice()
+
+			repo := New(Config{}).Characters(inner)
+			_, err := repo.Get(ctx, id)
+			require.NoError(t, err)
+			require.NoError(t, tt.write(ctx, repo, inner, id))
+			_, err = repo.Get(ctx, id)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package worldcache
+
+import (
+	"context"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+)
+
+// Cached values are stored and returned as shallow copies: callers may set
+// fields on what they get back without affecting the cache. Pointer fields
+// (IDs, timestamps) are shared, which is safe because the world model only
+// ever replaces them, never writes through them.
+
+// getCached serves k from the cache or loads it with load, storing a copy of
+// the result. Errors, including world.ErrNotFound, are never cached.
+func getCached[T any](ctx context.Context, c *Cache, k key, load func(context.Context, ulid.ULID) (*T, error)) (*T, error) {
+	if bypass(ctx) {
+		return load(ctx, k.id)
+	}
+	v, epoch, ok := c.lookup(k)
+	if cached, isT := v.(*T); ok && isT {
+		cp := *cached
+		return &cp, nil
+	}
+	got, err := load(ctx, k.id)
+	if err != nil {
+		return nil, err
+	}
+	cp := *got
+	c.store(k, &cp, epoch)
+	return got, nil
+}
+
+// Locations wraps inner with the cache. Get and GetMany are served from the
+// cache; list and search reads pass through.
+func (c *Cache) Locations(inner world.LocationRepository) world.LocationRepository {
+	return &locationRepo{LocationRepository: inner, cache: c}
+}
+
+type locationRepo struct {
+	world.LocationRepository
+	cache *Cache
+}
+
+func (r *locationRepo) Get(ctx context.Context, id ulid.ULID) (*world.Location, error) {
+	return getCached(ctx, r.cache, key{kindLocation, id}, r.LocationRepository.Get)
+}
+
+// GetMany serves cached locations and loads the misses with a single inner
+// GetMany call.
+func (r *locationRepo) GetMany(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	if bypass(ctx) {
+		return r.LocationRepository.GetMany(ctx, ids)
+	}
+	out := make([]*world.Location, 0, len(ids))
+	var (
+		misses []ulid.ULID
+		epoch  uint64
+	)
+	for _, id := range ids {
+		v, e, ok := r.cache.lookup(key{kindLocation, id})
+		if cached, isLoc := v.(*world.Location); ok && isLoc {
+			cp := *cached
+			out = append(out, &cp)
+			continue
+		}
+		// The first miss's epoch is the oldest; storing against it drops
+		// the batch if any location was invalidated while it was loading.
+		if misses == nil {
+			epoch = e
+		}
+		misses = append(misses, id)
+	}
+	if len(misses) == 0 {
+		return out, nil
+	}
+	loaded, err := r.LocationRepository.GetMany(ctx, misses)
+	if err != nil {
+		return nil, err
+	}
+	for _, loc := range loaded {
+		cp := *loc
+		r.cache.store(key{kindLocation, loc.ID}, &cp, epoch)
+	}
+	return append(out, loaded...), nil
+}
+
+func (r *locationRepo) Update(ctx context.Context, loc *world.Location) (*wmodel.MutationDelta, error) {
+	delta, err := r.LocationRepository.Update(ctx, loc)
+	r.cache.written(ctx, key{kindLocation, loc.ID})
+	return delta, err
+}
+
+func (r *locationRepo) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.LocationRepository.Delete(ctx, id, expectedVersion)
+	// Child locations lose their parent, and locations overflowing into
+	// this one their overflow, via ON DELETE SET NULL, so every cached
+	// location may now be stale, not just the deleted one.
+	r.cache.purged(ctx, kindLocation)
+	r.cache.purged(ctx, kindObject)
+	return delta, err
+}
+
+func (r *locationRepo) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.LocationRepository.SetTags(ctx, id, tags, expectedVersion)
+	r.cache.written(ctx, key{kindLocation, id})
+	return delta, err
+}
+
+// Objects wraps inner with the cache. Get is served from the cache; list
+// reads pass through.
+func (c *Cache) Objects(inner world.ObjectRepository) world.ObjectRepository {
+	return &objectRepo{ObjectRepository: inner, cache: c}
+}
+
+type objectRepo struct {
+	world.ObjectRepository
+	cache *Cache
+}
+
+func (r *objectRepo) Get(ctx context.Context, id ulid.ULID) (*world.Object, error) {
+	return getCached(ctx, r.cache, key{kindObject, id}, r.ObjectRepository.Get)
+}
+
+func (r *objectRepo) Update(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Update(ctx, obj)
+	r.cache.written(ctx, key{kindObject, obj.ID})
+	return delta, err
+}
+
+func (r *objectRepo) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Delete(ctx, id, expectedVersion)
+	// Contained objects lose their container via ON DELETE SET NULL.
+	r.cache.purged(ctx, kindObject)
+	return delta, err
+}
+
+func (r *objectRepo) Move(ctx context.Context, objectID ulid.ULID, to world.Containment, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.Move(ctx, objectID, to, expectedVersion)
+	r.cache.written(ctx, key{kindObject, objectID})
+	return delta, err
+}
+
+func (r *objectRepo) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.ObjectRepository.SetTags(ctx, id, tags, expectedVersion)
+	r.cache.written(ctx, key{kindObject, id})
+	return delta, err
+}
+
+// Characters wraps inner with the cache. Get is served from the cache; list,
+// ownership, and name reads pass through.
+func (c *Cache) Characters(inner world.CharacterRepository) world.CharacterRepository {
+	return &characterRepo{CharacterRepository: inner, cache: c}
+}
+
+type characterRepo struct {
+	world.CharacterRepository
+	cache *Cache
+}
+
+func (r *characterRepo) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
+	return getCached(ctx, r.cache, key{kindCharacter, id}, r.CharacterRepository.Get)
+}
+
+func (r *characterRepo) Update(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.Update(ctx, char)
+	r.cache.written(ctx, key{kindCharacter, char.ID})
+	return delta, err
+}
+
+func (r *characterRepo) Delete(ctx context.Context, id ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.Delete(ctx, id, expectedVersion)
+	r.cache.written(ctx, key{kindCharacter, id})
+	// Held objects lose their holder via ON DELETE SET NULL.
+	r.cache.purged(ctx, kindObject)
+	return delta, err
+}
+
+func (r *characterRepo) UpdateLocation(ctx context.Context, characterID ulid.ULID, locationID *ulid.ULID, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.UpdateLocation(ctx, characterID, locationID, expectedVersion)
+	r.cache.written(ctx, key{kindCharacter, characterID})
+	return delta, err
+}
+
+// UpdatePreferences invalidates even though preferences are not part of the
+// cached Character: the write bumps the row version, and a stale Version
+// would make the caller's next CAS write conflict spuriously.
+func (r *characterRepo) UpdatePreferences(ctx context.Context, characterID ulid.ULID, prefs []byte, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.UpdatePreferences(ctx, characterID, prefs, expectedVersion)
+	r.cache.written(ctx, key{kindCharacter, characterID})
+	return delta, err
+}
+
+func (r *characterRepo) SetTags(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	delta, err := r.CharacterRepository.SetTags(ctx, characterID, tags, expectedVersion)
+	r.cache.written(ctx, key{kindCharacter, characterID})
+	return delta, err
+}
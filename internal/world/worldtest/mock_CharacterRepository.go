@@ -148,6 +148,65 @@ func (_c *MockCharacterRepository_Delete_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// FindByName provides a mock function with given fields: ctx, name
+func (_m *MockCharacterRepository) FindByName(ctx context.Context, name string) (*world.Character, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByName")
+	}
+
+	var r0 *world.Character
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*world.Character, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *world.Character); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*world.Character)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCharacterRepository_FindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByName'
+type MockCharacterRepository_FindByName_Call struct {
+	*mock.Call
+}
+
+// FindByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockCharacterRepository_Expecter) FindByName(ctx interface{}, name interface{}) *MockCharacterRepository_FindByName_Call {
+	return &MockCharacterRepository_FindByName_Call{Call: _e.mock.On("FindByName", ctx, name)}
+}
+
+func (_c *MockCharacterRepository_FindByName_Call) Run(run func(ctx context.Context, name string)) *MockCharacterRepository_FindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_FindByName_Call) Return(_a0 *world.Character, _a1 error) *MockCharacterRepository_FindByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCharacterRepository_FindByName_Call) RunAndReturn(run func(context.Context, string) (*world.Character, error)) *MockCharacterRepository_FindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *MockCharacterRepository) Get(ctx context.Context, id ulid.ULID) (*world.Character, error) {
 	ret := _m.Called(ctx, id)
@@ -208,24 +267,22 @@ func (_c *MockCharacterRepository_Get_Call) RunAndReturn(run func(context.Contex
 }
 
 // GetByLocation provides a mock function with given fields: ctx, locationID, opts
-func (_m *MockCharacterRepository) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) ([]*world.Character, error) {
+func (_m *MockCharacterRepository) GetByLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Character], error) {
 	ret := _m.Called(ctx, locationID, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByLocation")
 	}
 
-	var r0 []*world.Character
+	var r0 world.Page[*world.Character]
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) ([]*world.Character, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) (world.Page[*world.Character], error)); ok {
 		return rf(ctx, locationID, opts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) []*world.Character); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) world.Page[*world.Character]); ok {
 		r0 = rf(ctx, locationID, opts)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*world.Character)
-		}
+		r0 = ret.Get(0).(world.Page[*world.Character])
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID, world.ListOptions) error); ok {
@@ -257,12 +314,12 @@ func (_c *MockCharacterRepository_GetByLocation_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockCharacterRepository_GetByLocation_Call) Return(_a0 []*world.Character, _a1 error) *MockCharacterRepository_GetByLocation_Call {
+func (_c *MockCharacterRepository_GetByLocation_Call) Return(_a0 world.Page[*world.Character], _a1 error) *MockCharacterRepository_GetByLocation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockCharacterRepository_GetByLocation_Call) RunAndReturn(run func(context.Context, ulid.ULID, world.ListOptions) ([]*world.Character, error)) *MockCharacterRepository_GetByLocation_Call {
+func (_c *MockCharacterRepository_GetByLocation_Call) RunAndReturn(run func(context.Context, ulid.ULID, world.ListOptions) (world.Page[*world.Character], error)) *MockCharacterRepository_GetByLocation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -384,6 +441,126 @@ func (_c *MockCharacterRepository_IsOwnedByPlayer_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ListByTag provides a mock function with given fields: ctx, tag
+func (_m *MockCharacterRepository) ListByTag(ctx context.Context, tag string) ([]*world.Character, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTag")
+	}
+
+	var r0 []*world.Character
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*world.Character, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*world.Character); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Character)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCharacterRepository_ListByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTag'
+type MockCharacterRepository_ListByTag_Call struct {
+	*mock.Call
+}
+
+// ListByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *MockCharacterRepository_Expecter) ListByTag(ctx interface{}, tag interface{}) *MockCharacterRepository_ListByTag_Call {
+	return &MockCharacterRepository_ListByTag_Call{Call: _e.mock.On("ListByTag", ctx, tag)}
+}
+
+func (_c *MockCharacterRepository_ListByTag_Call) Run(run func(ctx context.Context, tag string)) *MockCharacterRepository_ListByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_ListByTag_Call) Return(_a0 []*world.Character, _a1 error) *MockCharacterRepository_ListByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCharacterRepository_ListByTag_Call) RunAndReturn(run func(context.Context, string) ([]*world.Character, error)) *MockCharacterRepository_ListByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTags provides a mock function with given fields: ctx, characterID, tags, expectedVersion
+func (_m *MockCharacterRepository) SetTags(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	ret := _m.Called(ctx, characterID, tags, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 *wmodel.MutationDelta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)); ok {
+		return rf(ctx, characterID, tags, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) *wmodel.MutationDelta); ok {
+		r0 = rf(ctx, characterID, tags, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wmodel.MutationDelta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID, []string, int) error); ok {
+		r1 = rf(ctx, characterID, tags, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCharacterRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type MockCharacterRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - characterID ulid.ULID
+//   - tags []string
+//   - expectedVersion int
+func (_e *MockCharacterRepository_Expecter) SetTags(ctx interface{}, characterID interface{}, tags interface{}, expectedVersion interface{}) *MockCharacterRepository_SetTags_Call {
+	return &MockCharacterRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, characterID, tags, expectedVersion)}
+}
+
+func (_c *MockCharacterRepository_SetTags_Call) Run(run func(ctx context.Context, characterID ulid.ULID, tags []string, expectedVersion int)) *MockCharacterRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].([]string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockCharacterRepository_SetTags_Call) Return(_a0 *wmodel.MutationDelta, _a1 error) *MockCharacterRepository_SetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCharacterRepository_SetTags_Call) RunAndReturn(run func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)) *MockCharacterRepository_SetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, char
 func (_m *MockCharacterRepository) Update(ctx context.Context, char *world.Character) (*wmodel.MutationDelta, error) {
 	ret := _m.Called(ctx, char)
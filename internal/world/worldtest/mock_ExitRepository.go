@@ -328,29 +328,85 @@ func (_c *MockExitRepository_Get_Call) RunAndReturn(run func(context.Context, ul
 	return _c
 }
 
-// ListFromLocation provides a mock function with given fields: ctx, locationID
-func (_m *MockExitRepository) ListFromLocation(ctx context.Context, locationID ulid.ULID) ([]*world.Exit, error) {
-	ret := _m.Called(ctx, locationID)
+// ListDanglingExits provides a mock function with given fields: ctx
+func (_m *MockExitRepository) ListDanglingExits(ctx context.Context) ([]*world.Exit, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListFromLocation")
+		panic("no return value specified for ListDanglingExits")
 	}
 
 	var r0 []*world.Exit
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID) ([]*world.Exit, error)); ok {
-		return rf(ctx, locationID)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*world.Exit, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID) []*world.Exit); ok {
-		r0 = rf(ctx, locationID)
+	if rf, ok := ret.Get(0).(func(context.Context) []*world.Exit); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*world.Exit)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID) error); ok {
-		r1 = rf(ctx, locationID)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExitRepository_ListDanglingExits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDanglingExits'
+type MockExitRepository_ListDanglingExits_Call struct {
+	*mock.Call
+}
+
+// ListDanglingExits is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockExitRepository_Expecter) ListDanglingExits(ctx interface{}) *MockExitRepository_ListDanglingExits_Call {
+	return &MockExitRepository_ListDanglingExits_Call{Call: _e.mock.On("ListDanglingExits", ctx)}
+}
+
+func (_c *MockExitRepository_ListDanglingExits_Call) Run(run func(ctx context.Context)) *MockExitRepository_ListDanglingExits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockExitRepository_ListDanglingExits_Call) Return(_a0 []*world.Exit, _a1 error) *MockExitRepository_ListDanglingExits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExitRepository_ListDanglingExits_Call) RunAndReturn(run func(context.Context) ([]*world.Exit, error)) *MockExitRepository_ListDanglingExits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFromLocation provides a mock function with given fields: ctx, locationID, opts
+func (_m *MockExitRepository) ListFromLocation(ctx context.Context, locationID ulid.ULID, opts world.ListOptions) (world.Page[*world.Exit], error) {
+	ret := _m.Called(ctx, locationID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFromLocation")
+	}
+
+	var r0 world.Page[*world.Exit]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) (world.Page[*world.Exit], error)); ok {
+		return rf(ctx, locationID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, world.ListOptions) world.Page[*world.Exit]); ok {
+		r0 = rf(ctx, locationID, opts)
+	} else {
+		r0 = ret.Get(0).(world.Page[*world.Exit])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID, world.ListOptions) error); ok {
+		r1 = rf(ctx, locationID, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -366,23 +422,24 @@ type MockExitRepository_ListFromLocation_Call struct {
 // ListFromLocation is a helper method to define mock.On call
 //   - ctx context.Context
 //   - locationID ulid.ULID
-func (_e *MockExitRepository_Expecter) ListFromLocation(ctx interface{}, locationID interface{}) *MockExitRepository_ListFromLocation_Call {
-	return &MockExitRepository_ListFromLocation_Call{Call: _e.mock.On("ListFromLocation", ctx, locationID)}
+//   - opts world.ListOptions
+func (_e *MockExitRepository_Expecter) ListFromLocation(ctx interface{}, locationID interface{}, opts interface{}) *MockExitRepository_ListFromLocation_Call {
+	return &MockExitRepository_ListFromLocation_Call{Call: _e.mock.On("ListFromLocation", ctx, locationID, opts)}
 }
 
-func (_c *MockExitRepository_ListFromLocation_Call) Run(run func(ctx context.Context, locationID ulid.ULID)) *MockExitRepository_ListFromLocation_Call {
+func (_c *MockExitRepository_ListFromLocation_Call) Run(run func(ctx context.Context, locationID ulid.ULID, opts world.ListOptions)) *MockExitRepository_ListFromLocation_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ulid.ULID))
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].(world.ListOptions))
 	})
 	return _c
 }
 
-func (_c *MockExitRepository_ListFromLocation_Call) Return(_a0 []*world.Exit, _a1 error) *MockExitRepository_ListFromLocation_Call {
+func (_c *MockExitRepository_ListFromLocation_Call) Return(_a0 world.Page[*world.Exit], _a1 error) *MockExitRepository_ListFromLocation_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockExitRepository_ListFromLocation_Call) RunAndReturn(run func(context.Context, ulid.ULID) ([]*world.Exit, error)) *MockExitRepository_ListFromLocation_Call {
+func (_c *MockExitRepository_ListFromLocation_Call) RunAndReturn(run func(context.Context, ulid.ULID, world.ListOptions) (world.Page[*world.Exit], error)) *MockExitRepository_ListFromLocation_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -266,6 +266,65 @@ func (_c *MockLocationRepository_Get_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetMany provides a mock function with given fields: ctx, ids
+func (_m *MockLocationRepository) GetMany(ctx context.Context, ids []ulid.ULID) ([]*world.Location, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMany")
+	}
+
+	var r0 []*world.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []ulid.ULID) ([]*world.Location, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []ulid.ULID) []*world.Location); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []ulid.ULID) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocationRepository_GetMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMany'
+type MockLocationRepository_GetMany_Call struct {
+	*mock.Call
+}
+
+// GetMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []ulid.ULID
+func (_e *MockLocationRepository_Expecter) GetMany(ctx interface{}, ids interface{}) *MockLocationRepository_GetMany_Call {
+	return &MockLocationRepository_GetMany_Call{Call: _e.mock.On("GetMany", ctx, ids)}
+}
+
+func (_c *MockLocationRepository_GetMany_Call) Run(run func(ctx context.Context, ids []ulid.ULID)) *MockLocationRepository_GetMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ulid.ULID))
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_GetMany_Call) Return(_a0 []*world.Location, _a1 error) *MockLocationRepository_GetMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocationRepository_GetMany_Call) RunAndReturn(run func(context.Context, []ulid.ULID) ([]*world.Location, error)) *MockLocationRepository_GetMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShadowedBy provides a mock function with given fields: ctx, id
 func (_m *MockLocationRepository) GetShadowedBy(ctx context.Context, id ulid.ULID) ([]*world.Location, error) {
 	ret := _m.Called(ctx, id)
@@ -325,6 +384,65 @@ func (_c *MockLocationRepository_GetShadowedBy_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListByTag provides a mock function with given fields: ctx, tag
+func (_m *MockLocationRepository) ListByTag(ctx context.Context, tag string) ([]*world.Location, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTag")
+	}
+
+	var r0 []*world.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*world.Location, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*world.Location); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocationRepository_ListByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTag'
+type MockLocationRepository_ListByTag_Call struct {
+	*mock.Call
+}
+
+// ListByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *MockLocationRepository_Expecter) ListByTag(ctx interface{}, tag interface{}) *MockLocationRepository_ListByTag_Call {
+	return &MockLocationRepository_ListByTag_Call{Call: _e.mock.On("ListByTag", ctx, tag)}
+}
+
+func (_c *MockLocationRepository_ListByTag_Call) Run(run func(ctx context.Context, tag string)) *MockLocationRepository_ListByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_ListByTag_Call) Return(_a0 []*world.Location, _a1 error) *MockLocationRepository_ListByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocationRepository_ListByTag_Call) RunAndReturn(run func(context.Context, string) ([]*world.Location, error)) *MockLocationRepository_ListByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByType provides a mock function with given fields: ctx, locType
 func (_m *MockLocationRepository) ListByType(ctx context.Context, locType world.LocationType) ([]*world.Location, error) {
 	ret := _m.Called(ctx, locType)
@@ -384,6 +502,185 @@ func (_c *MockLocationRepository_ListByType_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ListByZone provides a mock function with given fields: ctx, zoneID
+func (_m *MockLocationRepository) ListByZone(ctx context.Context, zoneID string) ([]*world.Location, error) {
+	ret := _m.Called(ctx, zoneID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByZone")
+	}
+
+	var r0 []*world.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*world.Location, error)); ok {
+		return rf(ctx, zoneID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*world.Location); ok {
+		r0 = rf(ctx, zoneID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, zoneID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocationRepository_ListByZone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByZone'
+type MockLocationRepository_ListByZone_Call struct {
+	*mock.Call
+}
+
+// ListByZone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - zoneID string
+func (_e *MockLocationRepository_Expecter) ListByZone(ctx interface{}, zoneID interface{}) *MockLocationRepository_ListByZone_Call {
+	return &MockLocationRepository_ListByZone_Call{Call: _e.mock.On("ListByZone", ctx, zoneID)}
+}
+
+func (_c *MockLocationRepository_ListByZone_Call) Run(run func(ctx context.Context, zoneID string)) *MockLocationRepository_ListByZone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_ListByZone_Call) Return(_a0 []*world.Location, _a1 error) *MockLocationRepository_ListByZone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocationRepository_ListByZone_Call) RunAndReturn(run func(context.Context, string) ([]*world.Location, error)) *MockLocationRepository_ListByZone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChildren provides a mock function with given fields: ctx, parentID
+func (_m *MockLocationRepository) ListChildren(ctx context.Context, parentID ulid.ULID) ([]*world.Location, error) {
+	ret := _m.Called(ctx, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChildren")
+	}
+
+	var r0 []*world.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID) ([]*world.Location, error)); ok {
+		return rf(ctx, parentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID) []*world.Location); ok {
+		r0 = rf(ctx, parentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Location)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID) error); ok {
+		r1 = rf(ctx, parentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocationRepository_ListChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChildren'
+type MockLocationRepository_ListChildren_Call struct {
+	*mock.Call
+}
+
+// ListChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentID ulid.ULID
+func (_e *MockLocationRepository_Expecter) ListChildren(ctx interface{}, parentID interface{}) *MockLocationRepository_ListChildren_Call {
+	return &MockLocationRepository_ListChildren_Call{Call: _e.mock.On("ListChildren", ctx, parentID)}
+}
+
+func (_c *MockLocationRepository_ListChildren_Call) Run(run func(ctx context.Context, parentID ulid.ULID)) *MockLocationRepository_ListChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID))
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_ListChildren_Call) Return(_a0 []*world.Location, _a1 error) *MockLocationRepository_ListChildren_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocationRepository_ListChildren_Call) RunAndReturn(run func(context.Context, ulid.ULID) ([]*world.Location, error)) *MockLocationRepository_ListChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTags provides a mock function with given fields: ctx, id, tags, expectedVersion
+func (_m *MockLocationRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	ret := _m.Called(ctx, id, tags, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 *wmodel.MutationDelta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)); ok {
+		return rf(ctx, id, tags, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) *wmodel.MutationDelta); ok {
+		r0 = rf(ctx, id, tags, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wmodel.MutationDelta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID, []string, int) error); ok {
+		r1 = rf(ctx, id, tags, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLocationRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type MockLocationRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id ulid.ULID
+//   - tags []string
+//   - expectedVersion int
+func (_e *MockLocationRepository_Expecter) SetTags(ctx interface{}, id interface{}, tags interface{}, expectedVersion interface{}) *MockLocationRepository_SetTags_Call {
+	return &MockLocationRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, id, tags, expectedVersion)}
+}
+
+func (_c *MockLocationRepository_SetTags_Call) Run(run func(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int)) *MockLocationRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].([]string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockLocationRepository_SetTags_Call) Return(_a0 *wmodel.MutationDelta, _a1 error) *MockLocationRepository_SetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLocationRepository_SetTags_Call) RunAndReturn(run func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)) *MockLocationRepository_SetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, loc
 func (_m *MockLocationRepository) Update(ctx context.Context, loc *world.Location) (*wmodel.MutationDelta, error) {
 	ret := _m.Called(ctx, loc)
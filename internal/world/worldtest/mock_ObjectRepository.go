@@ -266,6 +266,65 @@ func (_c *MockObjectRepository_ListAtLocation_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ListByTag provides a mock function with given fields: ctx, tag
+func (_m *MockObjectRepository) ListByTag(ctx context.Context, tag string) ([]*world.Object, error) {
+	ret := _m.Called(ctx, tag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTag")
+	}
+
+	var r0 []*world.Object
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*world.Object, error)); ok {
+		return rf(ctx, tag)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*world.Object); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*world.Object)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_ListByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByTag'
+type MockObjectRepository_ListByTag_Call struct {
+	*mock.Call
+}
+
+// ListByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+func (_e *MockObjectRepository_Expecter) ListByTag(ctx interface{}, tag interface{}) *MockObjectRepository_ListByTag_Call {
+	return &MockObjectRepository_ListByTag_Call{Call: _e.mock.On("ListByTag", ctx, tag)}
+}
+
+func (_c *MockObjectRepository_ListByTag_Call) Run(run func(ctx context.Context, tag string)) *MockObjectRepository_ListByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_ListByTag_Call) Return(_a0 []*world.Object, _a1 error) *MockObjectRepository_ListByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_ListByTag_Call) RunAndReturn(run func(context.Context, string) ([]*world.Object, error)) *MockObjectRepository_ListByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListContainedIn provides a mock function with given fields: ctx, objectID
 func (_m *MockObjectRepository) ListContainedIn(ctx context.Context, objectID ulid.ULID) ([]*world.Object, error) {
 	ret := _m.Called(ctx, objectID)
@@ -445,6 +504,67 @@ func (_c *MockObjectRepository_Move_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// SetTags provides a mock function with given fields: ctx, id, tags, expectedVersion
+func (_m *MockObjectRepository) SetTags(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int) (*wmodel.MutationDelta, error) {
+	ret := _m.Called(ctx, id, tags, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 *wmodel.MutationDelta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)); ok {
+		return rf(ctx, id, tags, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ulid.ULID, []string, int) *wmodel.MutationDelta); ok {
+		r0 = rf(ctx, id, tags, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*wmodel.MutationDelta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ulid.ULID, []string, int) error); ok {
+		r1 = rf(ctx, id, tags, expectedVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockObjectRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type MockObjectRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id ulid.ULID
+//   - tags []string
+//   - expectedVersion int
+func (_e *MockObjectRepository_Expecter) SetTags(ctx interface{}, id interface{}, tags interface{}, expectedVersion interface{}) *MockObjectRepository_SetTags_Call {
+	return &MockObjectRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, id, tags, expectedVersion)}
+}
+
+func (_c *MockObjectRepository_SetTags_Call) Run(run func(ctx context.Context, id ulid.ULID, tags []string, expectedVersion int)) *MockObjectRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ulid.ULID), args[2].([]string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockObjectRepository_SetTags_Call) Return(_a0 *wmodel.MutationDelta, _a1 error) *MockObjectRepository_SetTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockObjectRepository_SetTags_Call) RunAndReturn(run func(context.Context, ulid.ULID, []string, int) (*wmodel.MutationDelta, error)) *MockObjectRepository_SetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, obj
 func (_m *MockObjectRepository) Update(ctx context.Context, obj *world.Object) (*wmodel.MutationDelta, error) {
 	ret := _m.Called(ctx, obj)
@@ -145,6 +145,64 @@ func (_c *MockSceneRepository_ListParticipants_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// ListScenes provides a mock function with given fields: ctx, filter, opts
+func (_m *MockSceneRepository) ListScenes(ctx context.Context, filter world.SceneFilter, opts world.ListOptions) (world.Page[*world.Location], error) {
+	ret := _m.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScenes")
+	}
+
+	var r0 world.Page[*world.Location]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, world.SceneFilter, world.ListOptions) (world.Page[*world.Location], error)); ok {
+		return rf(ctx, filter, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, world.SceneFilter, world.ListOptions) world.Page[*world.Location]); ok {
+		r0 = rf(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(world.Page[*world.Location])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, world.SceneFilter, world.ListOptions) error); ok {
+		r1 = rf(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSceneRepository_ListScenes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScenes'
+type MockSceneRepository_ListScenes_Call struct {
+	*mock.Call
+}
+
+// ListScenes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter world.SceneFilter
+//   - opts world.ListOptions
+func (_e *MockSceneRepository_Expecter) ListScenes(ctx interface{}, filter interface{}, opts interface{}) *MockSceneRepository_ListScenes_Call {
+	return &MockSceneRepository_ListScenes_Call{Call: _e.mock.On("ListScenes", ctx, filter, opts)}
+}
+
+func (_c *MockSceneRepository_ListScenes_Call) Run(run func(ctx context.Context, filter world.SceneFilter, opts world.ListOptions)) *MockSceneRepository_ListScenes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(world.SceneFilter), args[2].(world.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockSceneRepository_ListScenes_Call) Return(_a0 world.Page[*world.Location], _a1 error) *MockSceneRepository_ListScenes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSceneRepository_ListScenes_Call) RunAndReturn(run func(context.Context, world.SceneFilter, world.ListOptions) (world.Page[*world.Location], error)) *MockSceneRepository_ListScenes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSceneRepository creates a new instance of MockSceneRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSceneRepository(t interface {
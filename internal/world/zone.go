@@ -0,0 +1,268 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/access"
+)
+
+// Zone limits and defaults.
+const (
+	// MaxZoneIDLength bounds zone IDs in bytes.
+	MaxZoneIDLength = 64
+	// MaxZoneBroadcastKindLength bounds a broadcast's kind label in bytes.
+	MaxZoneBroadcastKindLength = 32
+	// DefaultZoneBroadcastBurst is how many broadcasts one subject may send
+	// to one zone back to back.
+	DefaultZoneBroadcastBurst = 5
+	// DefaultZoneBroadcastInterval is how long a spent broadcast takes to
+	// come back.
+	DefaultZoneBroadcastInterval = 10 * time.Second
+	// zoneLimiterSweepSize is the bucket count above which the limiter drops
+	// buckets that have refilled completely.
+	zoneLimiterSweepSize = 1024
+)
+
+// ActionBroadcast is the ABAC action BroadcastToZone checks on the zone.
+const ActionBroadcast = "broadcast"
+
+// zoneIDRegex matches a lowercase letter followed by lowercase letters,
+// digits, underscores, or hyphens (e.g. "harbor", "north_coast").
+var zoneIDRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// ValidateZoneID checks that id is a well-formed zone ID.
+func ValidateZoneID(id string) error {
+	if id == "" {
+		return &ValidationError{Field: "zone_id", Message: "cannot be empty"}
+	}
+	if len(id) > MaxZoneIDLength {
+		return &ValidationError{Field: "zone_id", Message: fmt.Sprintf("exceeds maximum length of %d", MaxZoneIDLength)}
+	}
+	if !zoneIDRegex.MatchString(id) {
+		return &ValidationError{Field: "zone_id", Message: "must be a lowercase letter followed by letters, digits, underscores, or hyphens"}
+	}
+	return nil
+}
+
+// ZoneBroadcast is the message BroadcastToZone delivers to every location
+// in a zone.
+type ZoneBroadcast struct {
+	// Kind is an optional label clients and plugins can filter on, e.g.
+	// "weather", "ambience", or "announcement".
+	Kind string
+	// Text is the message shown to everyone in the zone.
+	Text string
+}
+
+// Validate checks the broadcast's kind and text.
+func (b ZoneBroadcast) Validate() error {
+	if b.Kind != "" && (len(b.Kind) > MaxZoneBroadcastKindLength || !zoneIDRegex.MatchString(b.Kind)) {
+		return &ValidationError{Field: "kind", Message: fmt.Sprintf("must be a lowercase word of at most %d bytes", MaxZoneBroadcastKindLength)}
+	}
+	if b.Text == "" {
+		return &ValidationError{Field: "text", Message: "cannot be empty"}
+	}
+	if !utf8.ValidString(b.Text) {
+		return &ValidationError{Field: "text", Message: "must be valid UTF-8"}
+	}
+	if len(b.Text) > MaxDescriptionLength {
+		return &ValidationError{Field: "text", Message: fmt.Sprintf("exceeds maximum length of %d", MaxDescriptionLength)}
+	}
+	return nil
+}
+
+// LocationBroadcaster delivers a zone broadcast to one location's stream.
+// BroadcastToZone authorizes once and then calls it for every member
+// location.
+type LocationBroadcaster interface {
+	BroadcastToLocation(ctx context.Context, locationID ulid.ULID, zoneID string, b ZoneBroadcast) error
+}
+
+// SetLocationBroadcaster registers the broadcaster BroadcastToZone delivers
+// through. Passing nil leaves zone broadcasts unavailable.
+func (s *Service) SetLocationBroadcaster(b LocationBroadcaster) {
+	s.broadcaster = b
+}
+
+// ZoneBroadcastLimit bounds zone broadcasts per subject and zone as a token
+// bucket: Burst broadcasts at once, then one more every Interval.
+type ZoneBroadcastLimit struct {
+	Burst    int
+	Interval time.Duration
+}
+
+// ListZoneLocations returns the locations in a zone after checking read
+// authorization on each; locations the subject may not read are left out.
+func (s *Service) ListZoneLocations(ctx context.Context, subjectID, zoneID string) ([]*Location, error) {
+	if err := ValidateZoneID(zoneID); err != nil {
+		return nil, oops.Code("ZONE_INVALID").With("zone_id", zoneID).Wrap(err)
+	}
+	if s.locationRepo == nil {
+		return nil, oops.Code("ZONE_LIST_FAILED").Errorf("location repository not configured")
+	}
+	locs, err := s.locationRepo.ListByZone(ctx, zoneID)
+	if err != nil {
+		return nil, oops.Code("ZONE_LIST_FAILED").With("zone_id", zoneID).Wrap(err)
+	}
+	readable := make([]*Location, 0, len(locs))
+	for _, loc := range locs {
+		err := s.checkAccess(ctx, subjectID, "read", access.LocationResource(loc.ID.String()), prefixLocation)
+		if err == nil {
+			readable = append(readable, loc)
+			continue
+		}
+		if !errors.Is(err, ErrPermissionDenied) {
+			return nil, err
+		}
+	}
+	return readable, nil
+}
+
+// BroadcastToZone delivers b to every location in a zone as one operation:
+// the broadcast action is checked once, on the zone, rather than per
+// location, and one subject may broadcast to one zone at most
+// ZoneBroadcastLimit.Burst times in a row before waiting for the limit to
+// refill. It returns how many locations the broadcast reached.
+//
+// Returns ZONE_INVALID for a malformed zone ID or broadcast,
+// ZONE_ACCESS_DENIED without the broadcast action, ZONE_RATE_LIMITED when
+// the subject has used up its broadcasts, ZONE_NOT_FOUND when no location is
+// in the zone, and ZONE_BROADCAST_FAILED when delivery to any location
+// failed. Delivery continues past a failed location, so the others still
+// receive the broadcast.
+func (s *Service) BroadcastToZone(ctx context.Context, subjectID, zoneID string, b ZoneBroadcast) (int, error) {
+	if err := ValidateZoneID(zoneID); err != nil {
+		return 0, oops.Code("ZONE_INVALID").With("zone_id", zoneID).Wrap(err)
+	}
+	if err := b.Validate(); err != nil {
+		return 0, oops.Code("ZONE_INVALID").With("zone_id", zoneID).Wrap(err)
+	}
+	if s.locationRepo == nil || s.broadcaster == nil {
+		return 0, oops.Code("ZONE_BROADCAST_FAILED").Errorf("zone broadcasts not configured")
+	}
+	if err := s.checkAccess(ctx, subjectID, ActionBroadcast, access.ZoneResource(zoneID), prefixZone); err != nil {
+		return 0, err
+	}
+	if !s.zoneLimiter.allow(subjectID + "|" + zoneID) {
+		return 0, oops.Code("ZONE_RATE_LIMITED").
+			With("zone_id", zoneID).
+			With("subject", subjectID).
+			Errorf("too many broadcasts to zone %s", zoneID)
+	}
+	locs, err := s.locationRepo.ListByZone(ctx, zoneID)
+	if err != nil {
+		return 0, oops.Code("ZONE_BROADCAST_FAILED").With("zone_id", zoneID).Wrap(err)
+	}
+	if len(locs) == 0 {
+		return 0, oops.Code("ZONE_NOT_FOUND").With("zone_id", zoneID).Errorf("no locations in zone %s", zoneID)
+	}
+
+	reached := 0
+	var failures []error
+	for _, loc := range locs {
+		if err := s.broadcaster.BroadcastToLocation(ctx, loc.ID, zoneID, b); err != nil {
+			failures = append(failures, err)
+			continue
+		}
+		reached++
+	}
+	slog.InfoContext(ctx, "zone broadcast",
+		"event", "zone_broadcast",
+		"zone_id", zoneID,
+		"subject", subjectID,
+		"kind", b.Kind,
+		"locations", len(locs),
+		"reached", reached)
+	if len(failures) > 0 {
+		return reached, oops.Code("ZONE_BROADCAST_FAILED").
+			With("zone_id", zoneID).
+			With("failed", len(failures)).
+			Wrap(errors.Join(failures...))
+	}
+	return reached, nil
+}
+
+// zoneBroadcastLimiter is a token bucket per key. It is safe for concurrent
+// use.
+type zoneBroadcastLimiter struct {
+	mu       sync.Mutex
+	burst    float64
+	interval time.Duration
+	buckets  map[string]*zoneBucket
+	now      func() time.Time
+}
+
+type zoneBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+func newZoneBroadcastLimiter(limit ZoneBroadcastLimit) *zoneBroadcastLimiter {
+	if limit.Burst <= 0 {
+		limit.Burst = DefaultZoneBroadcastBurst
+	}
+	if limit.Interval <= 0 {
+		limit.Interval = DefaultZoneBroadcastInterval
+	}
+	return &zoneBroadcastLimiter{
+		burst:    float64(limit.Burst),
+		interval: limit.Interval,
+		buckets:  make(map[string]*zoneBucket),
+		now:      time.Now,
+	}
+}
+
+// allow spends one token from key's bucket, reporting false when it is
+// empty.
+func (l *zoneBroadcastLimiter) allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if len(l.buckets) > zoneLimiterSweepSize {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &zoneBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.refill(b, now)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *zoneBroadcastLimiter) refill(b *zoneBucket, now time.Time) float64 {
+	tokens := b.tokens + float64(now.Sub(b.last))/float64(l.interval)
+	if tokens > l.burst {
+		return l.burst
+	}
+	return tokens
+}
+
+// sweep drops buckets that have refilled completely; they are
+// indistinguishable from a fresh bucket.
+func (l *zoneBroadcastLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package world_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/access"
+	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/world"
+	"github.com/holomush/holomush/internal/world/wmodel"
+	"github.com/holomush/holomush/internal/world/worldtest"
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// recordingBroadcaster is a world.LocationBroadcaster that records each
+// delivery and fails for the locations in fail.
+type recordingBroadcaster struct {
+	mu        sync.Mutex
+	delivered []ulid.ULID
+	fail      map[ulid.ULID]bool
+}
+
+func (b *recordingBroadcaster) BroadcastToLocation(_ context.Context, locationID ulid.ULID, _ string, _ world.ZoneBroadcast) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.fail[locationID] {
+		return errors.New("publish failed")
+	}
+	b.delivered = append(b.delivered, locationID)
+	return nil
+}
+
+func zoneLocations(t *testing.T, n int) []*world.Location {
+	t.Helper()
+	locs := make([]*world.Location, n)
+	for i := range locs {
+		loc, err := world.NewLocation("Harbor Street", "Wet cobbles.", world.LocationTypePersistent)
+		require.NoError(t, err)
+		loc.ZoneID = "harbor"
+		locs[i] = loc
+	}
+	return locs
+}
+
+func TestValidateZoneID(t *testing.T) {
+	for _, id := range []string{"harbor", "north_coast", "zone-7"} {
+		assert.NoError(t, world.ValidateZoneID(id), id)
+	}
+	for _, id := range []string{"", "Harbor", "7th", "north coast", strings.Repeat("a", world.MaxZoneIDLength+1)} {
+		var verr *world.ValidationError
+		require.ErrorAs(t, world.ValidateZoneID(id), &verr, id)
+		assert.Equal(t, "zone_id", verr.Field)
+	}
+}
+
+func TestLocationValidateChecksZoneID(t *testing.T) {
+	loc, err := world.NewLocation("Pier", "", world.LocationTypePersistent)
+	require.NoError(t, err)
+
+	loc.ZoneID = "harbor"
+	require.NoError(t, loc.Validate())
+
+	loc.ZoneID = "The Harbor"
+	var verr *world.ValidationError
+	require.ErrorAs(t, loc.Validate(), &verr)
+	assert.Equal(t, "zone_id", verr.Field)
+}
+
+func TestZoneBroadcastValidate(t *testing.T) {
+	assert.NoError(t, world.ZoneBroadcast{Kind: "weather", Text: "Rain."}.Validate())
+	assert.NoError(t, world.ZoneBroadcast{Text: "Rain."}.Validate())
+	assert.Error(t, world.ZoneBroadcast{Kind: "weather"}.Validate())
+	assert.Error(t, world.ZoneBroadcast{Kind: "Bad Kind", Text: "Rain."}.Validate())
+	assert.Error(t, world.ZoneBroadcast{Text: strings.Repeat("x", world.MaxDescriptionLength+1)}.Validate())
+}
+
+func TestLocationPayloadCarriesZoneID(t *testing.T) {
+	loc, err := world.NewLocation("Pier", "Planks.", world.LocationTypePersistent)
+	require.NoError(t, err)
+
+	raw, err := world.BuildLocationPayload(loc)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "zone_id", "a location in no zone omits zone_id")
+
+	loc.ZoneID = "harbor"
+	raw, err = world.BuildLocationPayload(loc)
+	require.NoError(t, err)
+	var payload world.LocationChangePayload
+	require.NoError(t, json.Unmarshal(raw, &payload))
+	assert.Equal(t, "harbor", payload.ZoneID)
+}
+
+func TestWorldService_BroadcastToZone(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	msg := world.ZoneBroadcast{Kind: "weather", Text: "Rain sweeps in off the bay."}
+
+	grantEngine := func() *policytest.GrantEngine {
+		engine := policytest.NewGrantEngine()
+		engine.Grant(subjectID, world.ActionBroadcast, access.ZoneResource("harbor"))
+		return engine
+	}
+
+	t.Run("delivers to every location in the zone", func(t *testing.T) {
+		locs := zoneLocations(t, 3)
+		locRepo := worldtest.NewMockLocationRepository(t)
+		locRepo.EXPECT().ListByZone(mock.Anything, "harbor").Return(locs, nil).Once()
+		bc := &recordingBroadcaster{}
+		svc := world.NewService(world.ServiceConfig{LocationRepo: locRepo, Engine: grantEngine()})
+		svc.SetLocationBroadcaster(bc)
+
+		reached, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		require.NoError(t, err)
+		assert.Equal(t, 3, reached)
+		assert.Equal(t, []ulid.ULID{locs[0].ID, locs[1].ID, locs[2].ID}, bc.delivered)
+	})
+
+	t.Run("without broadcast the zone is denied", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: worldtest.NewMockLocationRepository(t), Engine: policytest.NewGrantEngine(),
+		})
+		svc.SetLocationBroadcaster(&recordingBroadcaster{})
+
+		_, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_ACCESS_DENIED")
+		assert.ErrorIs(t, err, world.ErrPermissionDenied)
+	})
+
+	t.Run("an invalid zone or message is rejected before the policy check", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: worldtest.NewMockLocationRepository(t), Engine: policytest.DenyAllEngine(),
+		})
+		svc.SetLocationBroadcaster(&recordingBroadcaster{})
+
+		_, err := svc.BroadcastToZone(ctx, subjectID, "The Harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_INVALID")
+		_, err = svc.BroadcastToZone(ctx, subjectID, "harbor", world.ZoneBroadcast{})
+		errutil.AssertErrorCode(t, err, "ZONE_INVALID")
+	})
+
+	t.Run("an empty zone is not found", func(t *testing.T) {
+		locRepo := worldtest.NewMockLocationRepository(t)
+		locRepo.EXPECT().ListByZone(mock.Anything, "harbor").Return([]*world.Location{}, nil).Once()
+		svc := world.NewService(world.ServiceConfig{LocationRepo: locRepo, Engine: grantEngine()})
+		svc.SetLocationBroadcaster(&recordingBroadcaster{})
+
+		_, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_NOT_FOUND")
+	})
+
+	t.Run("without a broadcaster zone broadcasts fail", func(t *testing.T) {
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo: worldtest.NewMockLocationRepository(t), Engine: grantEngine(),
+		})
+
+		_, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_BROADCAST_FAILED")
+	})
+
+	t.Run("a failed location does not stop the others", func(t *testing.T) {
+		locs := zoneLocations(t, 3)
+		locRepo := worldtest.NewMockLocationRepository(t)
+		locRepo.EXPECT().ListByZone(mock.Anything, "harbor").Return(locs, nil).Once()
+		bc := &recordingBroadcaster{fail: map[ulid.ULID]bool{locs[1].ID: true}}
+		svc := world.NewService(world.ServiceConfig{LocationRepo: locRepo, Engine: grantEngine()})
+		svc.SetLocationBroadcaster(bc)
+
+		reached, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_BROADCAST_FAILED")
+		assert.Equal(t, 2, reached)
+		assert.Equal(t, []ulid.ULID{locs[0].ID, locs[2].ID}, bc.delivered)
+	})
+
+	t.Run("broadcasts are rate limited per subject and zone", func(t *testing.T) {
+		locRepo := worldtest.NewMockLocationRepository(t)
+		locRepo.EXPECT().ListByZone(mock.Anything, mock.Anything).Return(zoneLocations(t, 1), nil)
+		engine := grantEngine()
+		other := access.CharacterSubject(ulid.Make().String())
+		engine.Grant(other, world.ActionBroadcast, access.ZoneResource("harbor"))
+		engine.Grant(subjectID, world.ActionBroadcast, access.ZoneResource("docks"))
+		svc := world.NewService(world.ServiceConfig{
+			LocationRepo:       locRepo,
+			Engine:             engine,
+			ZoneBroadcastLimit: world.ZoneBroadcastLimit{Burst: 2, Interval: time.Hour},
+		})
+		svc.SetLocationBroadcaster(&recordingBroadcaster{})
+
+		for range 2 {
+			_, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+			require.NoError(t, err)
+		}
+		_, err := svc.BroadcastToZone(ctx, subjectID, "harbor", msg)
+		errutil.AssertErrorCode(t, err, "ZONE_RATE_LIMITED")
+
+		_, err = svc.BroadcastToZone(ctx, other, "harbor", msg)
+		require.NoError(t, err, "another subject has its own limit")
+		_, err = svc.BroadcastToZone(ctx, subjectID, "docks", msg)
+		require.NoError(t, err, "another zone has its own limit")
+	})
+}
+
+func TestWorldService_ListZoneLocations(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	locs := zoneLocations(t, 2)
+	locRepo := worldtest.NewMockLocationRepository(t)
+	locRepo.EXPECT().ListByZone(mock.Anything, "harbor").Return(locs, nil).Once()
+	engine := policytest.NewGrantEngine()
+	engine.Grant(subjectID, "read", access.LocationResource(locs[1].ID.String()))
+	svc := world.NewService(world.ServiceConfig{LocationRepo: locRepo, Engine: engine})
+
+	got, err := svc.ListZoneLocations(ctx, subjectID, "harbor")
+	require.NoError(t, err)
+	assert.Equal(t, []*world.Location{locs[1]}, got, "unreadable locations are left out")
+}
+
+func TestWorldService_UpdateLocationPersistsZone(t *testing.T) {
+	ctx := context.Background()
+	subjectID := access.CharacterSubject(ulid.Make().String())
+	locs := zoneLocations(t, 1)
+	loc := locs[0]
+	locRepo := worldtest.NewMockLocationRepository(t)
+	locRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(l *world.Location) bool {
+		return l.ID == loc.ID && l.ZoneID == "harbor"
+	})).Return(&wmodel.MutationDelta{}, nil).Once()
+	outbox := &mockOutboxWriter{}
+	svc := world.NewService(withWriteExecutor(world.ServiceConfig{
+		LocationRepo: locRepo, Engine: policytest.AllowAllEngine(),
+	}, outbox))
+
+	require.NoError(t, svc.UpdateLocation(ctx, subjectID, loc))
+	var payload world.LocationChangePayload
+	require.NoError(t, json.Unmarshal(outbox.lastIntent.Payload, &payload))
+	assert.Equal(t, "harbor", payload.ZoneID)
+}
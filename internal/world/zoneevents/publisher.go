@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package zoneevents publishes zone broadcasts as zone_broadcast events, one
+// per member location stream. Publisher implements
+// world.LocationBroadcaster.
+package zoneevents
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// Publisher publishes a zone_broadcast event on a location's stream.
+type Publisher struct {
+	pub    eventbus.Publisher
+	gameID func() string
+}
+
+var _ world.LocationBroadcaster = (*Publisher)(nil)
+
+// NewPublisher constructs a Publisher over pub, qualifying subjects with the
+// game id returned by gameID.
+//
+// Panics when pub or gameID is nil, mirroring presence.NewEmitter's
+// construction-time failure discipline.
+func NewPublisher(pub eventbus.Publisher, gameID func() string) *Publisher {
+	if pub == nil || eventbus.IsNilPublisher(pub) {
+		panic("zoneevents.NewPublisher: nil Publisher")
+	}
+	if gameID == nil {
+		panic("zoneevents.NewPublisher: nil gameID")
+	}
+	return &Publisher{pub: pub, gameID: gameID}
+}
+
+// BroadcastToLocation publishes b as a zone_broadcast event on the stream of
+// the location with locationID.
+func (p *Publisher) BroadcastToLocation(ctx context.Context, locationID ulid.ULID, zoneID string, b world.ZoneBroadcast) error {
+	payload, err := json.Marshal(eventvocab.ZoneBroadcastPayload{
+		ZoneID: zoneID,
+		Kind:   b.Kind,
+		Text:   b.Text,
+	})
+	if err != nil {
+		return oops.With("operation", "marshal_zone_broadcast_payload").Wrap(err)
+	}
+
+	gameID := p.gameID()
+	if gameID == "" {
+		gameID = "main"
+	}
+	stream := world.LocationStream(locationID)
+	sub, err := eventbus.Qualify(gameID, stream)
+	if err != nil {
+		return oops.With("stream", stream).Wrap(err)
+	}
+	typ, err := eventbus.NewType(string(eventvocab.EventTypeZoneBroadcast))
+	if err != nil {
+		return oops.With("type", string(eventvocab.EventTypeZoneBroadcast)).Wrap(err)
+	}
+
+	actor := eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}
+	if err := p.pub.Publish(ctx, eventbus.NewEvent(sub, typ, actor, payload)); err != nil {
+		return oops.With("operation", "publish_zone_broadcast_event").With("location_id", locationID.String()).Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package zoneevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/core"
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventvocab"
+	"github.com/holomush/holomush/internal/world"
+)
+
+// fakePublisher is a hand-rolled eventbus.Publisher recording every
+// published event, modeled on internal/presence's test fake.
+type fakePublisher struct {
+	mu        sync.Mutex
+	published []eventbus.Event
+	err       error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, ev eventbus.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.published = append(f.published, ev)
+	return nil
+}
+
+func mainGameID() string { return "main" }
+
+func TestNewPublisherPanicsOnNilDependencies(t *testing.T) {
+	var nilPub *fakePublisher
+	assert.Panics(t, func() { NewPublisher(nil, mainGameID) })
+	assert.Panics(t, func() { NewPublisher(nilPub, mainGameID) })
+	assert.Panics(t, func() { NewPublisher(&fakePublisher{}, nil) })
+}
+
+func TestBroadcastToLocationPublishesOnLocationStream(t *testing.T) {
+	pub := &fakePublisher{}
+	p := NewPublisher(pub, mainGameID)
+	locID := ulid.Make()
+
+	require.NoError(t, p.BroadcastToLocation(context.Background(), locID, "harbor",
+		world.ZoneBroadcast{Kind: "weather", Text: "Rain sweeps in off the bay."}))
+
+	require.Len(t, pub.published, 1)
+	ev := pub.published[0]
+	assert.Equal(t, "events.main.location."+locID.String(), string(ev.Subject))
+	assert.Equal(t, string(eventvocab.EventTypeZoneBroadcast), string(ev.Type))
+	assert.Equal(t, eventbus.Actor{Kind: eventbus.ActorKindSystem, ID: core.WorldServiceActorULID}, ev.Actor)
+
+	var payload eventvocab.ZoneBroadcastPayload
+	require.NoError(t, json.Unmarshal(ev.Payload, &payload))
+	assert.Equal(t, eventvocab.ZoneBroadcastPayload{ZoneID: "harbor", Kind: "weather", Text: "Rain sweeps in off the bay."}, payload)
+}
+
+func TestBroadcastToLocationReturnsPublishError(t *testing.T) {
+	pub := &fakePublisher{err: errors.New("broker down")}
+	p := NewPublisher(pub, mainGameID)
+
+	err := p.BroadcastToLocation(context.Background(), ulid.Make(), "harbor", world.ZoneBroadcast{Text: "Fog."})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "broker down")
+}
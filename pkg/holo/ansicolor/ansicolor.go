@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package ansicolor downgrades the colors in ANSI SGR sequences to what a
+// terminal can show: 24-bit truecolor to the xterm 256-color palette, that
+// to the 16 basic colors, and those to none.
+//
+// It is a leaf package with no HoloMUSH imports, so gateways can apply a
+// connection's color depth to text rendered by holo.Fmt.Parse without
+// pulling in the plugin standard library.
+package ansicolor
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Depth is how many colors a terminal can show. Depths are ordered: a
+// terminal that shows one depth shows every lower one.
+type Depth uint8
+
+// Color depths, lowest first.
+const (
+	// DepthNone shows no color; text attributes such as bold remain.
+	DepthNone Depth = iota
+	// Depth16 shows the 8 basic colors and their bright variants.
+	Depth16
+	// Depth256 shows the xterm 256-color palette.
+	Depth256
+	// DepthTrue shows 24-bit truecolor.
+	DepthTrue
+)
+
+// String returns the name ParseDepth accepts for d.
+func (d Depth) String() string {
+	switch d {
+	case DepthNone:
+		return "none"
+	case Depth16:
+		return "16"
+	case Depth256:
+		return "256"
+	case DepthTrue:
+		return "truecolor"
+	}
+	return "Depth(" + strconv.Itoa(int(d)) + ")"
+}
+
+// ParseDepth returns the depth named by s, case-insensitively: "none",
+// "16", "256", or "truecolor", with the aliases "off", "ansi", and
+// "24bit".
+func ParseDepth(s string) (Depth, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "none", "off":
+		return DepthNone, true
+	case "16", "ansi":
+		return Depth16, true
+	case "256":
+		return Depth256, true
+	case "truecolor", "24bit":
+		return DepthTrue, true
+	}
+	return 0, false
+}
+
+// SGR parameters that select colors.
+const (
+	sgrFg         = 30  // 30-37: basic foreground
+	sgrFgExtended = 38  // 38;5;n or 38;2;r;g;b
+	sgrFgDefault  = 39  // default foreground
+	sgrBg         = 40  // 40-47: basic background
+	sgrBgExtended = 48  // 48;5;n or 48;2;r;g;b
+	sgrBgDefault  = 49  // default background
+	sgrFgBright   = 90  // 90-97: bright foreground
+	sgrBgBright   = 100 // 100-107: bright background
+
+	extended256  = 5
+	extendedTrue = 2
+)
+
+// Downgrade returns s with the color parameters of its SGR sequences
+// (ESC '[' digits and ';' ... 'm') reduced to depth d. Colors above d are
+// mapped to the nearest color d can show; at DepthNone they are dropped.
+// Other parameters, and every other byte of s, are kept. A sequence left
+// with no parameters is removed.
+func Downgrade(s string, d Depth) string {
+	if d >= DepthTrue || !strings.Contains(s, "\x1b[") {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); {
+		n := sgrLength(s, i)
+		if n == 0 {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		params := s[i+2 : i+n-1]
+		if params == "" {
+			b.WriteString(s[i : i+n]) // ESC [ m is a reset.
+		} else if out := downgradeParams(params, d); out != "" {
+			b.WriteString("\x1b[")
+			b.WriteString(out)
+			b.WriteByte('m')
+		}
+		i += n
+	}
+	return b.String()
+}
+
+// downgradeParams rewrites the ';'-separated parameters of one SGR
+// sequence for depth d.
+func downgradeParams(params string, d Depth) string {
+	fields := strings.Split(params, ";")
+	out := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		p, err := strconv.Atoi(fields[i])
+		if err != nil {
+			// An empty parameter means 0 (reset).
+			out = append(out, fields[i])
+			continue
+		}
+		switch {
+		case p == sgrFgExtended || p == sgrBgExtended:
+			index, used, ok := extendedColor(fields[i+1:])
+			i += used
+			if !ok || d == DepthNone {
+				continue
+			}
+			if d == Depth256 {
+				out = append(out, strconv.Itoa(p), strconv.Itoa(extended256), strconv.Itoa(int(index)))
+				continue
+			}
+			out = append(out, strconv.Itoa(basicParam(Nearest16(index), p == sgrBgExtended)))
+		case isBasicColor(p):
+			if d != DepthNone {
+				out = append(out, fields[i])
+			}
+		default:
+			out = append(out, fields[i])
+		}
+	}
+	return strings.Join(out, ";")
+}
+
+// extendedColor reads the arguments of a 38 or 48 parameter: "5;n" or
+// "2;r;g;b". It returns the 256-color index they name, mapping truecolor to
+// the nearest, and how many fields it consumed. ok is false for malformed
+// arguments, which are consumed up to the point they fail.
+func extendedColor(args []string) (index uint8, used int, ok bool) {
+	if len(args) == 0 {
+		return 0, 0, false
+	}
+	mode, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, 1, false
+	}
+	switch mode {
+	case extended256:
+		if len(args) < 2 {
+			return 0, 1, false
+		}
+		n, ok := channel(args[1])
+		return n, 2, ok
+	case extendedTrue:
+		if len(args) < 4 {
+			return 0, len(args), false
+		}
+		r, rok := channel(args[1])
+		g, gok := channel(args[2])
+		bl, bok := channel(args[3])
+		return Nearest256(r, g, bl), 4, rok && gok && bok
+	}
+	return 0, 1, false
+}
+
+// channel parses a 0-255 SGR argument.
+func channel(s string) (uint8, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 255 {
+		return 0, false
+	}
+	return uint8(n), true
+}
+
+// isBasicColor reports whether p selects one of the 16 basic colors or a
+// default color.
+func isBasicColor(p int) bool {
+	return (p >= sgrFg && p <= sgrBgDefault && p != sgrFgExtended && p != sgrBgExtended) ||
+		(p >= sgrFgBright && p <= sgrFgBright+7) ||
+		(p >= sgrBgBright && p <= sgrBgBright+7)
+}
+
+// basicParam returns the SGR parameter selecting basic color index
+// (0-15) as a foreground or background.
+func basicParam(index uint8, background bool) int {
+	base, bright := sgrFg, sgrFgBright
+	if background {
+		base, bright = sgrBg, sgrBgBright
+	}
+	if index < 8 {
+		return base + int(index)
+	}
+	return bright + int(index) - 8
+}
+
+// sgrLength returns the length of the SGR sequence starting at i, or 0
+// when none starts there.
+func sgrLength(s string, i int) int {
+	if i+2 >= len(s) || s[i] != 0x1b || s[i+1] != '[' {
+		return 0
+	}
+	for j := i + 2; j < len(s); j++ {
+		c := s[j]
+		switch {
+		case c == 'm':
+			return j + 1 - i
+		case c >= '0' && c <= '9', c == ';':
+		default:
+			return 0
+		}
+	}
+	return 0
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ansicolor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDepth(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Depth
+		ok   bool
+	}{
+		{"none", DepthNone, true},
+		{"OFF", DepthNone, true},
+		{"16", Depth16, true},
+		{"ansi", Depth16, true},
+		{"256", Depth256, true},
+		{" TrueColor ", DepthTrue, true},
+		{"24bit", DepthTrue, true},
+		{"88", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := ParseDepth(tt.in)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDepthStringRoundTrips(t *testing.T) {
+	for _, d := range []Depth{DepthNone, Depth16, Depth256, DepthTrue} {
+		got, ok := ParseDepth(d.String())
+		assert.True(t, ok, d.String())
+		assert.Equal(t, d, got)
+	}
+	assert.Equal(t, "Depth(9)", Depth(9).String())
+}
+
+func TestDowngrade(t *testing.T) {
+	const (
+		truecolor = "\x1b[38;2;255;128;0morange\x1b[0m"
+		color256  = "\x1b[38;5;196mred\x1b[0m"
+		basic     = "\x1b[1;31mbold red\x1b[0m"
+	)
+	tests := []struct {
+		name  string
+		in    string
+		depth Depth
+		want  string
+	}{
+		{"truecolor kept at truecolor", truecolor, DepthTrue, truecolor},
+		{"truecolor to 256", truecolor, Depth256, "\x1b[38;5;208morange\x1b[0m"},
+		{"truecolor to 16", truecolor, Depth16, "\x1b[33morange\x1b[0m"},
+		{"truecolor to none", truecolor, DepthNone, "orange\x1b[0m"},
+		{"256 kept at 256", color256, Depth256, color256},
+		{"256 to 16", color256, Depth16, "\x1b[91mred\x1b[0m"},
+		{"256 basic index to 16", "\x1b[38;5;4mblue", Depth16, "\x1b[34mblue"},
+		{"256 background to 16", "\x1b[48;5;9mbg", Depth16, "\x1b[101mbg"},
+		{"basic kept at 16", basic, Depth16, basic},
+		{"basic color dropped at none, bold kept", basic, DepthNone, "\x1b[1mbold red\x1b[0m"},
+		{"attributes kept around extended color", "\x1b[1;38;5;196;4mx", Depth16, "\x1b[1;91;4mx"},
+		{"bare reset kept", "\x1b[mx", DepthNone, "\x1b[mx"},
+		{"malformed extended color dropped", "\x1b[38;5mx", Depth16, "x"},
+		{"out of range channel dropped", "\x1b[38;2;300;0;0mx", Depth256, "x"},
+		{"non-SGR escape untouched", "\x1b[2Jx", Depth16, "\x1b[2Jx"},
+		{"plain text untouched", "no color here", DepthNone, "no color here"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Downgrade(tt.in, tt.depth))
+		})
+	}
+}
+
+func TestNearest256(t *testing.T) {
+	tests := []struct {
+		name    string
+		r, g, b uint8
+		want    uint8
+	}{
+		{"pure red is a cube corner", 255, 0, 0, 196},
+		{"black is the cube origin", 0, 0, 0, 16},
+		{"mid gray uses the gray ramp", 128, 128, 128, 244},
+		{"white is a cube corner", 255, 255, 255, 231},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Nearest256(tt.r, tt.g, tt.b))
+		})
+	}
+}
+
+func TestNearest16(t *testing.T) {
+	assert.Equal(t, uint8(3), Nearest16(3), "basic indexes are unchanged")
+	assert.Equal(t, uint8(9), Nearest16(196), "cube red maps to bright red")
+	assert.Equal(t, uint8(0), Nearest16(232), "darkest gray maps to black")
+	assert.Equal(t, uint8(15), Nearest16(231), "cube white maps to bright white")
+}
+
+func TestPalette256(t *testing.T) {
+	r, g, b := Palette256(196)
+	assert.Equal(t, [3]uint8{255, 0, 0}, [3]uint8{r, g, b})
+	r, g, b = Palette256(255)
+	assert.Equal(t, [3]uint8{238, 238, 238}, [3]uint8{r, g, b})
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package ansicolor
+
+// rgb is a 24-bit color.
+type rgb struct{ r, g, b uint8 }
+
+// basicPalette is xterm's default rendering of the 16 basic colors.
+var basicPalette = [16]rgb{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube at 256-color
+// indexes 16-231.
+var cubeLevels = [6]uint8{0, 95, 135, 175, 215, 255}
+
+// Palette256 returns the color xterm shows for 256-color index n.
+func Palette256(n uint8) (r, g, b uint8) {
+	c := palette256(n)
+	return c.r, c.g, c.b
+}
+
+func palette256(n uint8) rgb {
+	switch {
+	case n < 16:
+		return basicPalette[n]
+	case n < 232:
+		n -= 16
+		return rgb{cubeLevels[n/36], cubeLevels[n/6%6], cubeLevels[n%6]}
+	default:
+		v := 8 + 10*(n-232)
+		return rgb{v, v, v}
+	}
+}
+
+// Nearest256 returns the 256-color index closest to the color (r, g, b),
+// choosing between the color cube and the grayscale ramp. The 16 basic
+// colors are never chosen, since terminals render them differently.
+func Nearest256(r, g, b uint8) uint8 {
+	want := rgb{r, g, b}
+	ri, gi, bi := nearestLevel(r), nearestLevel(g), nearestLevel(b)
+	cube := 16 + 36*ri + 6*gi + bi
+
+	// The gray ramp runs 8, 18, ..., 238 at indexes 232-255.
+	avg := (int(r) + int(g) + int(b)) / 3
+	step := min(max((avg-3)/10, 0), 23)
+	gray := uint8(232 + step)
+
+	if distance(want, palette256(gray)) < distance(want, palette256(cube)) {
+		return gray
+	}
+	return cube
+}
+
+// Nearest16 returns the basic color index (0-15) closest to 256-color
+// index n. Indexes below 16 are returned unchanged.
+func Nearest16(n uint8) uint8 {
+	if n < 16 {
+		return n
+	}
+	want := palette256(n)
+	best, bestDist := uint8(0), -1
+	for i, c := range basicPalette {
+		if d := distance(want, c); bestDist < 0 || d < bestDist {
+			best, bestDist = uint8(i), d
+		}
+	}
+	return best
+}
+
+// nearestLevel returns the index of the cube level closest to v.
+func nearestLevel(v uint8) uint8 {
+	best, bestDist := uint8(0), 256
+	for i, level := range cubeLevels {
+		d := int(v) - int(level)
+		if d < 0 {
+			d = -d
+		}
+		if d < bestDist {
+			best, bestDist = uint8(i), d
+		}
+	}
+	return best
+}
+
+// distance is the squared Euclidean distance between two colors.
+func distance(a, b rgb) int {
+	dr, dg, db := int(a.r)-int(b.r), int(a.g)-int(b.g), int(a.b)-int(b.b)
+	return dr*dr + dg*dg + db*db
+}
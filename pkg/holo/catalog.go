@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package holo
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the catalog fallback locale when none is configured.
+const DefaultLocale = "en"
+
+// localeRegex matches a normalized BCP 47-style tag: a 2-3 letter language
+// subtag followed by optional 2-8 character alphanumeric subtags.
+var localeRegex = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// Args holds named arguments substituted into catalog templates.
+type Args map[string]any
+
+// NormalizeLocale lowercases a locale tag and converts underscores to
+// hyphens, so "pt_BR" and "pt-br" name the same locale. Returns an error for
+// an empty or malformed tag.
+func NormalizeLocale(locale string) (string, error) {
+	norm := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if !localeRegex.MatchString(norm) {
+		return "", fmt.Errorf("invalid locale %q", locale)
+	}
+	return norm, nil
+}
+
+// Catalog is a set of message templates keyed by locale and message ID.
+// Templates use {name} placeholders filled from Args and MAY contain MU* %x
+// format codes; write {{ and }} for literal braces. Safe for concurrent use.
+type Catalog struct {
+	fallback string
+
+	mu       sync.RWMutex
+	messages map[string]map[string]string // locale -> id -> template
+}
+
+// NewCatalog creates an empty catalog whose fallback chains end at
+// fallbackLocale. An invalid or empty fallbackLocale uses DefaultLocale.
+func NewCatalog(fallbackLocale string) *Catalog {
+	fallback, err := NormalizeLocale(fallbackLocale)
+	if err != nil {
+		fallback = DefaultLocale
+	}
+	return &Catalog{
+		fallback: fallback,
+		messages: make(map[string]map[string]string),
+	}
+}
+
+// FallbackLocale returns the locale every fallback chain ends at.
+func (c *Catalog) FallbackLocale() string {
+	return c.fallback
+}
+
+// Add registers messages for locale, replacing any existing template with
+// the same ID. Returns an error if locale is malformed.
+func (c *Catalog) Add(locale string, messages map[string]string) error {
+	norm, err := NormalizeLocale(locale)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m, ok := c.messages[norm]
+	if !ok {
+		m = make(map[string]string, len(messages))
+		c.messages[norm] = m
+	}
+	for id, tmpl := range messages {
+		m[id] = tmpl
+	}
+	return nil
+}
+
+// FallbackChain returns the locales consulted for locale, most specific
+// first: the tag itself, each shorter prefix ("pt-br" -> "pt"), then the
+// catalog fallback. A malformed locale yields only the fallback.
+func (c *Catalog) FallbackChain(locale string) []string {
+	norm, err := NormalizeLocale(locale)
+	if err != nil {
+		return []string{c.fallback}
+	}
+
+	chain := []string{norm}
+	for i := strings.LastIndexByte(norm, '-'); i > 0; i = strings.LastIndexByte(norm, '-') {
+		norm = norm[:i]
+		chain = append(chain, norm)
+	}
+	if chain[len(chain)-1] != c.fallback {
+		chain = append(chain, c.fallback)
+	}
+	return chain
+}
+
+// Lookup returns the template for id in the first locale of locale's
+// fallback chain that defines it. ok is false when no locale in the chain
+// defines id.
+func (c *Catalog) Lookup(locale, id string) (template string, ok bool) {
+	chain := c.FallbackChain(locale)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, l := range chain {
+		if tmpl, found := c.messages[l][id]; found {
+			return tmpl, true
+		}
+	}
+	return "", false
+}
+
+// Msg looks up id in catalog for locale, renders its %x format codes, and
+// substitutes args into its {name} placeholders. Argument values are
+// inserted after format-code parsing, so player-supplied text cannot inject
+// styling. A missing ID renders as the ID itself so untranslated strings are
+// visible rather than silently blank; a placeholder without a matching
+// argument is left as-is.
+func (f formatter) Msg(catalog *Catalog, locale, id string, args Args) StyledText {
+	if catalog == nil {
+		return PlainText(id)
+	}
+	tmpl, ok := catalog.Lookup(locale, id)
+	if !ok {
+		return PlainText(id)
+	}
+
+	var one [1]segment
+	parsed := f.Parse(tmpl).runs(&one)
+	segments := make([]segment, len(parsed))
+	for i, seg := range parsed {
+		segments[i] = segment{text: substitute(seg.text, args), style: seg.style}
+	}
+	return StyledText{segments: segments}
+}
+
+// substitute replaces {name} placeholders in tmpl with the matching args
+// value formatted with %v. {{ and }} produce literal braces.
+func substitute(tmpl string, args Args) string {
+	if !strings.ContainsAny(tmpl, "{}") {
+		return tmpl
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(tmpl); i++ {
+		ch := tmpl[i]
+		switch {
+		case ch == '{' && i+1 < len(tmpl) && tmpl[i+1] == '{':
+			buf.WriteByte('{')
+			i++
+		case ch == '}' && i+1 < len(tmpl) && tmpl[i+1] == '}':
+			buf.WriteByte('}')
+			i++
+		case ch == '{':
+			end := strings.IndexByte(tmpl[i+1:], '}')
+			if end < 0 {
+				buf.WriteString(tmpl[i:])
+				return buf.String()
+			}
+			name := tmpl[i+1 : i+1+end]
+			if v, ok := args[name]; ok {
+				fmt.Fprintf(&buf, "%v", v)
+			} else {
+				buf.WriteString(tmpl[i : i+2+end])
+			}
+			i += end + 1
+		default:
+			buf.WriteByte(ch)
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package holo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	c := NewCatalog("en")
+	require.NoError(t, c.Add("en", map[string]string{
+		"greet":   "Hello, {name}!",
+		"only_en": "English only",
+	}))
+	require.NoError(t, c.Add("pt", map[string]string{"greet": "Olá, {name}!"}))
+	require.NoError(t, c.Add("pt-BR", map[string]string{"greet": "Oi, {name}!"}))
+	return c
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "language only", input: "en", want: "en"},
+		{name: "region uppercase", input: "pt-BR", want: "pt-br"},
+		{name: "underscore separator", input: "pt_BR", want: "pt-br"},
+		{name: "script and region", input: "zh-Hant-TW", want: "zh-hant-tw"},
+		{name: "surrounding whitespace", input: " de ", want: "de"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "single letter language", input: "e", wantErr: true},
+		{name: "punctuation", input: "en;DROP", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeLocale(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCatalogDefaultsInvalidFallback(t *testing.T) {
+	assert.Equal(t, DefaultLocale, NewCatalog("").FallbackLocale())
+	assert.Equal(t, "fr", NewCatalog("FR").FallbackLocale())
+}
+
+func TestCatalogAddRejectsInvalidLocale(t *testing.T) {
+	err := NewCatalog("en").Add("not a locale", map[string]string{"x": "y"})
+	assert.Error(t, err)
+}
+
+func TestCatalogFallbackChain(t *testing.T) {
+	c := NewCatalog("en")
+
+	assert.Equal(t, []string{"pt-br", "pt", "en"}, c.FallbackChain("pt_BR"))
+	assert.Equal(t, []string{"en-gb", "en"}, c.FallbackChain("en-GB"))
+	assert.Equal(t, []string{"en"}, c.FallbackChain("en"))
+	assert.Equal(t, []string{"en"}, c.FallbackChain("???"))
+}
+
+func TestCatalogLookupWalksFallbackChain(t *testing.T) {
+	c := newTestCatalog(t)
+
+	tests := []struct {
+		name   string
+		locale string
+		id     string
+		want   string
+		wantOK bool
+	}{
+		{name: "exact regional match", locale: "pt-BR", id: "greet", want: "Oi, {name}!", wantOK: true},
+		{name: "falls back to language", locale: "pt-PT", id: "greet", want: "Olá, {name}!", wantOK: true},
+		{name: "falls back to catalog locale", locale: "pt-BR", id: "only_en", want: "English only", wantOK: true},
+		{name: "unknown locale uses fallback", locale: "ja", id: "greet", want: "Hello, {name}!", wantOK: true},
+		{name: "unknown id", locale: "en", id: "missing", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := c.Lookup(tt.locale, tt.id)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCatalogAddReplacesExistingTemplate(t *testing.T) {
+	c := newTestCatalog(t)
+	require.NoError(t, c.Add("en", map[string]string{"greet": "Hi, {name}."}))
+
+	got, ok := c.Lookup("en", "greet")
+	require.True(t, ok)
+	assert.Equal(t, "Hi, {name}.", got)
+	_, ok = c.Lookup("en", "only_en")
+	assert.True(t, ok, "Add merges rather than replacing the whole locale")
+}
+
+func TestFmt_Msg(t *testing.T) {
+	c := newTestCatalog(t)
+	require.NoError(t, c.Add("en", map[string]string{
+		"styled":   "%xhWelcome%xn, {name}.",
+		"count":    "{who} has {n} items",
+		"braces":   "use {{name}} literally",
+		"partial":  "{known} and {unknown}",
+		"dangling": "open {brace",
+	}))
+
+	tests := []struct {
+		name   string
+		locale string
+		id     string
+		args   Args
+		want   string
+	}{
+		{name: "substitutes named arg", locale: "pt-BR", id: "greet", args: Args{"name": "Ana"}, want: "Oi, Ana!"},
+		{name: "formats non-string args", locale: "en", id: "count", args: Args{"who": "Bo", "n": 3}, want: "Bo has 3 items"},
+		{name: "renders format codes", locale: "en", id: "styled", args: Args{"name": "Cy"}, want: "\x1b[1mWelcome\x1b[0m, Cy."},
+		{name: "escaped braces are literal", locale: "en", id: "braces", want: "use {name} literally"},
+		{name: "missing arg left as placeholder", locale: "en", id: "partial", args: Args{"known": "a"}, want: "a and {unknown}"},
+		{name: "unterminated placeholder preserved", locale: "en", id: "dangling", want: "open {brace"},
+		{name: "missing id renders id", locale: "en", id: "nope", want: "nope"},
+		{name: "args cannot inject format codes", locale: "en", id: "greet", args: Args{"name": "%xrEvil"}, want: "Hello, %xrEvil!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Fmt.Msg(c, tt.locale, tt.id, tt.args).RenderANSI())
+		})
+	}
+}
+
+func TestFmt_MsgNilCatalogRendersID(t *testing.T) {
+	assert.Equal(t, "greet", Fmt.Msg(nil, "en", "greet", nil).RenderPlain())
+}
@@ -4,9 +4,9 @@
 package holo
 
 import (
-	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // codeToANSI maps MU* format codes to ANSI escape sequences.
@@ -44,13 +44,45 @@ var codeToANSI = map[string]string{
 	"W": ansiBrightWhite,
 }
 
-// whitespaceCodeToOutput maps whitespace codes to their output.
-var whitespaceCodeToOutput = map[rune]string{
+// xCodeANSI is codeToANSI indexed by the code byte, so the parser's hot
+// loop does a bounds-checked array load instead of a map lookup.
+var xCodeANSI = func() (table [256]string) {
+	for code, ansi := range codeToANSI {
+		table[code[0]] = ansi
+	}
+	return table
+}()
+
+// whitespaceCodeOutput maps whitespace code bytes to their output.
+var whitespaceCodeOutput = [256]string{
 	'r': "\n",   // newline
 	'b': " ",    // space
 	't': "    ", // tab (4 spaces)
 }
 
+// ansi256Prefix starts the SGR sequence for a %x### 256-color code.
+const ansi256Prefix = "\x1b[38;5;"
+
+// ansiTruecolorPrefix starts the SGR sequence for a %x<#rrggbb> truecolor
+// code.
+const ansiTruecolorPrefix = "\x1b[38;2;"
+
+// truecolorCodeLen is the length of the <#rrggbb> that follows %x in a
+// truecolor code.
+const truecolorCodeLen = len("<#rrggbb>")
+
+// maxPooledParseBuf caps the buffers Parse returns to parseBufPool so one
+// huge description does not pin its buffer for the life of the process.
+const maxPooledParseBuf = 64 << 10
+
+// parseBufPool holds scratch buffers for Parse's slow path.
+var parseBufPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 512)
+		return &buf
+	},
+}
+
 // Parse converts text containing MU* %x format codes to StyledText.
 //
 // Supported codes:
@@ -58,60 +90,143 @@ var whitespaceCodeToOutput = map[rune]string{
 //   - Colors: %xr/%xR (red), %xg/%xG (green), %xb/%xB (blue), %xc/%xC (cyan),
 //     %xm/%xM (magenta), %xy/%xY (yellow), %xw/%xW (white), %xx (black)
 //   - 256-color: %x### where ### is a 3-digit color number (000-255)
+//   - Truecolor: %x<#rrggbb> where rrggbb is a hex RGB color
 //   - Whitespace: %r (newline), %b (space), %t (tab)
 //
 // Unknown codes are preserved as-is. Percent signs not followed by a valid
 // code are also preserved.
+//
+// 256-color and truecolor codes are emitted at full depth; the gateway
+// downgrades them to what each connection's terminal can show.
+//
+// Text without a percent sign is returned without allocating; anything else
+// is translated in a single pass into a pooled buffer.
 func (f formatter) Parse(text string) StyledText {
-	if text == "" {
-		return StyledText{}
+	if strings.IndexByte(text, '%') < 0 {
+		return StyledText{plain: text}
+	}
+
+	bufp, _ := parseBufPool.Get().(*[]byte)
+	if bufp == nil {
+		bufp = new([]byte)
 	}
+	out := appendParsed((*bufp)[:0], text)
+	result := string(out)
+	if cap(out) <= maxPooledParseBuf {
+		*bufp = out[:0]
+		parseBufPool.Put(bufp)
+	}
+
+	return PlainText(result)
+}
 
-	var result strings.Builder
-	i := 0
+// parseState is the position of appendParsed within a format code.
+type parseState uint8
 
-	for i < len(text) {
-		if text[i] == '%' && i+1 < len(text) {
-			// Check for whitespace codes (%r, %b, %t)
-			nextChar := rune(text[i+1])
-			if ws, ok := whitespaceCodeToOutput[nextChar]; ok {
-				result.WriteString(ws)
-				i += 2
+const (
+	parseText    parseState = iota // copying literal text
+	parsePercent                   // after %
+	parseX                         // after %x
+	parseDigits                    // inside the ### of %x###
+)
+
+// appendParsed appends text to dst with its format codes translated to ANSI.
+// A code that turns out to be invalid is copied through literally from its
+// % and the byte that broke it is scanned again as ordinary input.
+func appendParsed(dst []byte, text string) []byte {
+	state := parseText
+	start := 0 // index of the % opening the code being scanned
+	color := 0
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch state {
+		case parseText:
+			j := strings.IndexByte(text[i:], '%')
+			if j < 0 {
+				return append(dst, text[i:]...)
+			}
+			dst = append(dst, text[i:i+j]...)
+			i += j
+			start = i
+			state = parsePercent
+		case parsePercent:
+			if ws := whitespaceCodeOutput[c]; ws != "" {
+				dst = append(dst, ws...)
+				state = parseText
+			} else if c == 'x' {
+				state = parseX
+			} else {
+				dst = append(dst, '%')
+				state = parseText
+				i--
+			}
+		case parseX:
+			if isDigit(c) {
+				color = int(c - '0')
+				state = parseDigits
+			} else if ansi := xCodeANSI[c]; ansi != "" {
+				dst = append(dst, ansi...)
+				state = parseText
+			} else if r, g, b, ok := parseTruecolor(text[i:]); ok {
+				dst = append(dst, ansiTruecolorPrefix...)
+				dst = strconv.AppendInt(dst, int64(r), 10)
+				dst = append(dst, ';')
+				dst = strconv.AppendInt(dst, int64(g), 10)
+				dst = append(dst, ';')
+				dst = strconv.AppendInt(dst, int64(b), 10)
+				dst = append(dst, 'm')
+				i += truecolorCodeLen - 1
+				state = parseText
+			} else {
+				dst = append(dst, text[start:i]...)
+				state = parseText
+				i--
+			}
+		case parseDigits:
+			if !isDigit(c) {
+				dst = append(dst, text[start:i]...)
+				state = parseText
+				i--
 				continue
 			}
-
-			// Check for %x codes
-			if text[i+1] == 'x' && i+2 < len(text) {
-				// Try to parse 256-color code (%x###)
-				if i+4 < len(text) && isDigit(text[i+2]) && isDigit(text[i+3]) && isDigit(text[i+4]) {
-					colorNum := text[i+2 : i+5]
-					num, err := strconv.Atoi(colorNum)
-					if err == nil && num >= 0 && num <= 255 {
-						fmt.Fprintf(&result, "\x1b[38;5;%dm", num)
-						i += 5
-						continue
-					}
-				}
-
-				// Try single-character code
-				code := string(text[i+2])
-				if ansi, ok := codeToANSI[code]; ok {
-					result.WriteString(ansi)
-					i += 3
-					continue
-				}
+			color = color*10 + int(c-'0')
+			if i-start < len("%x000")-1 {
+				continue
 			}
-
-			// Unknown code, preserve as-is
-			result.WriteByte(text[i])
-			i++
-		} else {
-			result.WriteByte(text[i])
-			i++
+			if color <= 255 {
+				dst = append(dst, ansi256Prefix...)
+				dst = strconv.AppendInt(dst, int64(color), 10)
+				dst = append(dst, 'm')
+			} else {
+				dst = append(dst, text[start:i+1]...)
+			}
+			state = parseText
 		}
 	}
 
-	return PlainText(result.String())
+	// Input ended inside a code, so it was not one.
+	if state != parseText {
+		dst = append(dst, text[start:]...)
+	}
+	return dst
+}
+
+// parseTruecolor reads the <#rrggbb> of a truecolor code from the start
+// of s. Hex digits may be either case.
+func parseTruecolor(s string) (r, g, b uint8, ok bool) {
+	if len(s) < truecolorCodeLen || s[0] != '<' || s[1] != '#' || s[truecolorCodeLen-1] != '>' {
+		return 0, 0, 0, false
+	}
+	var rgb [3]uint8
+	for n := range rgb {
+		v, err := strconv.ParseUint(s[2+2*n:4+2*n], 16, 8)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+		rgb[n] = uint8(v)
+	}
+	return rgb[0], rgb[1], rgb[2], true
 }
 
 // isDigit returns true if the byte is an ASCII digit.
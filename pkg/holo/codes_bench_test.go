@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Benchmarks for Fmt.Parse against the map-driven implementation it replaced.
+//
+// Run from the repository root:
+//
+//	go test -bench=Parse -benchmem ./pkg/holo/ -run=^$
+package holo
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// referenceWhitespaceCodes is the whitespace table of referenceParse.
+var referenceWhitespaceCodes = map[rune]string{
+	'r': "\n",
+	'b': " ",
+	't': "    ",
+}
+
+// referenceParse is the Fmt.Parse implementation preceding the single-pass
+// rewrite, extended with truecolor codes, kept as the oracle for FuzzParse
+// and the baseline for the benchmarks below. It returns the rendered text rather than StyledText.
+func referenceParse(text string) string {
+	var result strings.Builder
+	i := 0
+
+	for i < len(text) {
+		if text[i] == '%' && i+1 < len(text) {
+			if ws, ok := referenceWhitespaceCodes[rune(text[i+1])]; ok {
+				result.WriteString(ws)
+				i += 2
+				continue
+			}
+
+			if text[i+1] == 'x' && i+2 < len(text) {
+				if i+4 < len(text) && isDigit(text[i+2]) && isDigit(text[i+3]) && isDigit(text[i+4]) {
+					num, err := strconv.Atoi(text[i+2 : i+5])
+					if err == nil && num >= 0 && num <= 255 {
+						fmt.Fprintf(&result, "\x1b[38;5;%dm", num)
+						i += 5
+						continue
+					}
+				}
+
+				if ansi, ok := codeToANSI[string(text[i+2])]; ok {
+					result.WriteString(ansi)
+					i += 3
+					continue
+				}
+
+				if rest := text[i+2:]; len(rest) >= 9 && strings.HasPrefix(rest, "<#") && rest[8] == '>' {
+					if rgb, err := hex.DecodeString(rest[2:8]); err == nil {
+						fmt.Fprintf(&result, "\x1b[38;2;%d;%d;%dm", rgb[0], rgb[1], rgb[2])
+						i += 11
+						continue
+					}
+				}
+			}
+
+			result.WriteByte(text[i])
+			i++
+		} else {
+			result.WriteByte(text[i])
+			i++
+		}
+	}
+
+	return result.String()
+}
+
+var parseBenchInputs = []struct {
+	name string
+	text string
+}{
+	{"Plain", strings.Repeat("The harbour lamps gutter in the evening fog. ", 8)},
+	{"Styled", strings.Repeat("%xhThe %xrharbour%xn lamps gutter%r in the %xBevening%xn fog.%t", 8)},
+	{"Color256", strings.Repeat("%x208amber%xn and %x033teal%xn and %x999bogus ", 8)},
+	{"Malformed", strings.Repeat("100% sure %x %q %x25 %%xh ", 8)},
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, in := range parseBenchInputs {
+		b.Run(in.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(in.text)))
+			for b.Loop() {
+				_ = Fmt.Parse(in.text)
+			}
+		})
+	}
+}
+
+func BenchmarkParseReference(b *testing.B) {
+	for _, in := range parseBenchInputs {
+		b.Run(in.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(in.text)))
+			for b.Loop() {
+				_ = PlainText(referenceParse(in.text))
+			}
+		})
+	}
+}
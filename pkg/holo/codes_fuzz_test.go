@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package holo
+
+import (
+	"testing"
+)
+
+// FuzzParse checks that Fmt.Parse never panics on malformed codes and
+// renders exactly what the implementation it replaced did.
+func FuzzParse(f *testing.F) {
+	seeds := []string{
+		"",
+		"plain text",
+		"%",
+		"%%",
+		"%x",
+		"%xh",
+		"%xhbold%xn",
+		"%x000%x255%x256%x999",
+		"%x<#ff8000>%x<#FFFFFF>%x<#ff80zz>%x<#fff>",
+		"%x2",
+		"%x25",
+		"%x25q",
+		"%x1h",
+		"%x%xh",
+		"%%xh",
+		"%r%b%t%R%B%T",
+		"%xz%xN%x-",
+		"100% sure",
+		"trailing %",
+		"trailing %x",
+		"\xff%x\xff%\x00",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		want := referenceParse(text)
+		parsed := Fmt.Parse(text)
+		if got := parsed.RenderANSI(); got != want {
+			t.Fatalf("Parse(%q).RenderANSI() = %q, want %q", text, got, want)
+		}
+		if got := parsed.RenderPlain(); got != want {
+			t.Fatalf("Parse(%q).RenderPlain() = %q, want %q", text, got, want)
+		}
+	})
+}
@@ -238,6 +238,47 @@ func TestFmt_Parse_256Color(t *testing.T) {
 	}
 }
 
+func TestFmt_Parse_Truecolor(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantANSI string
+	}{
+		{
+			name:     "lowercase hex",
+			input:    "%x<#ff8000>orange%xn",
+			wantANSI: "\x1b[38;2;255;128;0morange\x1b[0m",
+		},
+		{
+			name:     "uppercase hex",
+			input:    "%x<#0A0B0C>dark",
+			wantANSI: "\x1b[38;2;10;11;12mdark",
+		},
+		{
+			name:     "bad hex digit preserved",
+			input:    "%x<#ff80zz>text",
+			wantANSI: "%x<#ff80zz>text",
+		},
+		{
+			name:     "short color preserved",
+			input:    "%x<#fff>text",
+			wantANSI: "%x<#fff>text",
+		},
+		{
+			name:     "truncated at end of input preserved",
+			input:    "%x<#ff80",
+			wantANSI: "%x<#ff80",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Fmt.Parse(tt.input)
+			assert.Equal(t, tt.wantANSI, result.RenderANSI())
+		})
+	}
+}
+
 func TestFmt_Parse_UnknownCodes(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -289,6 +330,21 @@ func TestFmt_Parse_Empty(t *testing.T) {
 	assert.Equal(t, "", result.RenderANSI())
 }
 
+func TestFmt_Parse_PlainTextDoesNotAllocate(t *testing.T) {
+	text := "The harbour lamps gutter in the evening fog."
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = Fmt.Parse(text).RenderANSI()
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestFmt_Parse_PlainTextComposes(t *testing.T) {
+	parsed := Fmt.Parse("plain")
+	assert.Equal(t, "plain\x1b[1m!\x1b[0m", parsed.Append(Fmt.Bold("!")).RenderANSI())
+	assert.Equal(t, "\x1b[1m!\x1b[0mplain", Fmt.Bold("!").Append(parsed).RenderANSI())
+	assert.Equal(t, "plain more", parsed.AppendText(" more").RenderPlain())
+}
+
 func TestCodeToANSI_Coverage(t *testing.T) {
 	// Ensure all expected codes are in the map
 	expectedCodes := []string{"n", "h", "u", "i", "d", "r", "g", "b", "c", "m", "y", "w", "x", "R", "G", "B", "C", "M", "Y", "W"}
@@ -8,6 +8,8 @@
 //
 //   - Event emission with stream targeting (location, character, global)
 //   - Formatting primitives with MU*-compatible %x codes (via [Fmt.Parse])
+//   - Localized player-facing strings from a message [Catalog] with locale
+//     fallback chains (via [Fmt.Msg])
 //
 // Go plugins import this package directly. Lua plugins access the same
 // functionality via host function bindings.
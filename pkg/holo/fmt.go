@@ -68,6 +68,20 @@ type formatter struct{}
 // new instances without modifying the original or sharing underlying storage.
 type StyledText struct {
 	segments []segment
+	// plain holds the text of a single unstyled run when segments is nil.
+	// Parse returns code-free input this way so the common case allocates
+	// nothing; read segments through runs rather than directly.
+	plain string
+}
+
+// runs returns st's segments, materialising an inline plain run into one
+// (which must not escape the caller, so it stays on the stack).
+func (st StyledText) runs(one *[1]segment) []segment {
+	if st.segments == nil && st.plain != "" {
+		one[0] = segment{text: st.plain}
+		return one[:]
+	}
+	return st.segments
 }
 
 // segment represents a piece of text with optional styling.
@@ -275,6 +289,10 @@ func PlainText(text string) StyledText {
 
 // RenderANSI renders the styled text to ANSI escape codes for telnet clients.
 func (st StyledText) RenderANSI() string {
+	if st.segments == nil {
+		return st.plain
+	}
+
 	var buf strings.Builder
 
 	for _, seg := range st.segments {
@@ -320,6 +338,10 @@ func (st StyledText) renderSegmentANSI(buf *strings.Builder, seg segment) {
 // where escape codes would be displayed literally or cause problems.
 // Use RenderANSI for terminal clients that support ANSI escape sequences.
 func (st StyledText) RenderPlain() string {
+	if st.segments == nil {
+		return st.plain
+	}
+
 	var buf strings.Builder
 
 	for _, seg := range st.segments {
@@ -333,9 +355,11 @@ func (st StyledText) RenderPlain() string {
 // The returned value has its own backing array and does not share
 // storage with either the receiver or the argument.
 func (st StyledText) Append(other StyledText) StyledText {
-	combined := make([]segment, 0, len(st.segments)+len(other.segments))
-	combined = append(combined, st.segments...)
-	combined = append(combined, other.segments...)
+	var a, b [1]segment
+	left, right := st.runs(&a), other.runs(&b)
+	combined := make([]segment, 0, len(left)+len(right))
+	combined = append(combined, left...)
+	combined = append(combined, right...)
 	return StyledText{segments: combined}
 }
 
@@ -343,8 +367,10 @@ func (st StyledText) Append(other StyledText) StyledText {
 // The returned value has its own backing array and does not share
 // storage with the receiver.
 func (st StyledText) AppendText(text string) StyledText {
-	combined := make([]segment, 0, len(st.segments)+1)
-	combined = append(combined, st.segments...)
+	var one [1]segment
+	left := st.runs(&one)
+	combined := make([]segment, 0, len(left)+1)
+	combined = append(combined, left...)
 	combined = append(combined, segment{text: text, style: style{}})
 	return StyledText{segments: combined}
 }
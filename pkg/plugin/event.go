@@ -32,17 +32,44 @@ type EventType string
 // Plugin-owned event-type constants (e.g., "core-communication:say")
 // stay in their owning plugin's package, NOT here.
 const (
-	HostEventTypeSystem          EventType = "system"
-	HostEventTypeSessionEnded    EventType = "session_ended"
-	HostEventTypeCommandResponse EventType = "command_response"
-	HostEventTypeCommandError    EventType = "command_error"
-	HostEventTypeArrive          EventType = "arrive"
-	HostEventTypeLeave           EventType = "leave"
-	HostEventTypeMove            EventType = "move"
-	HostEventTypeLocationState   EventType = "location_state"
-	HostEventTypeExitUpdate      EventType = "exit_update"
+	HostEventTypeSystem             EventType = "system"
+	HostEventTypeSessionEnded       EventType = "session_ended"
+	HostEventTypeCommandResponse    EventType = "command_response"
+	HostEventTypeCommandError       EventType = "command_error"
+	HostEventTypeArrive             EventType = "arrive"
+	HostEventTypeLeave              EventType = "leave"
+	HostEventTypeMove               EventType = "move"
+	HostEventTypeLocationState      EventType = "location_state"
+	HostEventTypeExitUpdate         EventType = "exit_update"
+	HostEventTypeAFK                EventType = "afk"
+	HostEventTypeBack               EventType = "back"
+	HostEventTypeRoll               EventType = "roll"
+	HostEventTypeCheck              EventType = "check"
+	HostEventTypeScheduled          EventType = "scheduled"
+	HostEventTypePropertyChanged    EventType = "property_changed"
+	HostEventTypeMOTD               EventType = "motd"
+	HostEventTypeCurrencyTransfer   EventType = "currency_transfer"
+	HostEventTypeZoneBroadcast      EventType = "zone_broadcast"
+	HostEventTypeAmbient            EventType = "ambient"
+	HostEventTypeJobFinished        EventType = "job_finished"
+	HostEventTypeTraversalDepart    EventType = "traversal_depart"
+	HostEventTypeTraversalCancel    EventType = "traversal_cancel"
+	HostEventTypeTraversalArrive    EventType = "traversal_arrive"
+	HostEventTypeTraversalLocked    EventType = "traversal_locked"
+	HostEventTypePage               EventType = "page"
+	HostEventTypePageReceipt        EventType = "page_receipt"
+	HostEventTypeReportStatus       EventType = "report_status"
+	HostEventTypePreferencesChanged EventType = "preferences_changed"
+	HostEventTypeNPC                EventType = "npc"
+	HostEventTypeDaylight           EventType = "daylight"
 )
 
+// PayloadKeyEncryptedAtRest is the payload field a plugin sets to true on
+// the first event of a scene — its creation notice — to have the host store
+// that event and every later event on the scene encrypted at rest. The
+// string is owned by internal/eventbus and re-exported here for plugin code.
+const PayloadKeyEncryptedAtRest = "encrypted_at_rest"
+
 // ActorKind identifies what type of entity caused an event.
 type ActorKind uint8
 
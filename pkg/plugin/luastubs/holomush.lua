@@ -127,6 +127,14 @@
 ---@field payload string
 ---@field cursor string
 
+---@class holomush.msg.ExitSummary
+---@field id string
+---@field name string
+---@field aliases string[]
+---@field to_location_id string
+---@field locked boolean
+---@field visibility string
+
 ---@class holomush.msg.FindByNameRequest
 ---@field name string
 
@@ -245,13 +253,21 @@
 ---@field description string
 ---@field location_id string
 
+---@class holomush.msg.QueryExitsRequest
+---@field location_id string
+
+---@class holomush.msg.QueryExitsResponse
+---@field exits holomush.msg.ExitSummary[]
+
 ---@class holomush.msg.QueryLocationCharactersRequest
 ---@field location_id string
 ---@field limit integer
 ---@field offset integer
+---@field cursor string
 
 ---@class holomush.msg.QueryLocationCharactersResponse
 ---@field characters holomush.msg.CharacterSummary[]
+---@field next_cursor string
 
 ---@class holomush.msg.QueryLocationRequest
 ---@field location_id string
@@ -508,6 +524,9 @@ _G["world.query"].QueryLocationCharacters = function(req) end
 ---@param req holomush.msg.QueryObjectRequest
 ---@return holomush.msg.QueryObjectResponse
 _G["world.query"].QueryObject = function(req) end
+---@param req holomush.msg.QueryExitsRequest
+---@return holomush.msg.QueryExitsResponse
+_G["world.query"].QueryExits = function(req) end
 ---@param req holomush.msg.FindLocationRequest
 ---@return holomush.msg.FindLocationResponse
 _G["world.query"].FindLocation = function(req) end
@@ -555,6 +574,53 @@ function holomush.query_stream_history(args) end
 ---@return table
 ---@return string?
 function holomush.decrypt_own_audit_rows(rows) end
+---Roll dice on the server as the acting character and announce the result. Pass a table: {expression=string, stream=string, reason?=string}.
+---@param args table
+---@return table
+---@return string?
+function holomush.roll(args) end
+---Make a stat check for the acting character against a difficulty and announce the result. Pass a table: {attribute=string, difficulty=integer, stream?=string, reason?=string}.
+---@param args table
+---@return table
+---@return string?
+function holomush.check(args) end
+---Make a contested check between the acting character and a defender and announce the result. Pass a table: {attribute=string, defender=string, defender_attribute?=string, stream?=string, reason?=string}.
+---@param args table
+---@return table
+---@return string?
+function holomush.contest(args) end
+---Look up a help topic by name or alias. Returns {name, title, category, aliases, text}; text is the rendered page.
+---@param name string
+---@return table
+---@return string?
+function holomush.help_topic(name) end
+---List help topics, optionally in one category. Returns an array of {name, title, category} ordered by category then name.
+---@param category string?
+---@return table
+---@return string?
+function holomush.help_list(category) end
+---Full-text search help topics. Returns an array of {name, title, category}, best match first.
+---@param query string
+---@return table
+---@return string?
+function holomush.help_search(query) end
+---Read a key from the plugin's own persistent storage. Returns nil (and no error) when the key is not set.
+---@param key string
+---@return string?
+---@return string?
+function holomush.storage_get(key) end
+---Write a key in the plugin's own persistent storage; a nil value deletes it. Fails when the key or value is invalid or the plugin's quota is full.
+---@param key string
+---@param value string?
+---@return boolean?
+---@return string?
+function holomush.storage_set(key, value) end
+---List the keys in the plugin's own persistent storage that start with prefix, in byte order (default 100, at most 1000).
+---@param prefix string?
+---@param limit integer?
+---@return table
+---@return string?
+function holomush.storage_list(prefix, limit) end
 ---Declare a plugin-owned event type (Load-time; INV-PLUGIN-32).
 ---@param event_type string
 ---@return boolean
@@ -375,6 +375,68 @@ func (ControlSignal) EnumDescriptor() ([]byte, []int) {
 	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{4}
 }
 
+// InputFloodAction is the escalation step a gateway took against a connection
+// sending commands faster than its input budget allows.
+type InputFloodAction int32
+
+const (
+	// INPUT_FLOOD_ACTION_UNSPECIFIED is the zero value; rejected by
+	// ReportInputFlood.
+	InputFloodAction_INPUT_FLOOD_ACTION_UNSPECIFIED InputFloodAction = 0
+	// INPUT_FLOOD_ACTION_WARN means the client was told to slow down and the
+	// excess commands were dropped.
+	InputFloodAction_INPUT_FLOOD_ACTION_WARN InputFloodAction = 1
+	// INPUT_FLOOD_ACTION_THROTTLE means the client kept flooding after warnings
+	// and its input budget was reduced.
+	InputFloodAction_INPUT_FLOOD_ACTION_THROTTLE InputFloodAction = 2
+	// INPUT_FLOOD_ACTION_DISCONNECT means the client kept flooding while
+	// throttled and the gateway closed the connection.
+	InputFloodAction_INPUT_FLOOD_ACTION_DISCONNECT InputFloodAction = 3
+)
+
+// Enum value maps for InputFloodAction.
+var (
+	InputFloodAction_name = map[int32]string{
+		0: "INPUT_FLOOD_ACTION_UNSPECIFIED",
+		1: "INPUT_FLOOD_ACTION_WARN",
+		2: "INPUT_FLOOD_ACTION_THROTTLE",
+		3: "INPUT_FLOOD_ACTION_DISCONNECT",
+	}
+	InputFloodAction_value = map[string]int32{
+		"INPUT_FLOOD_ACTION_UNSPECIFIED": 0,
+		"INPUT_FLOOD_ACTION_WARN":        1,
+		"INPUT_FLOOD_ACTION_THROTTLE":    2,
+		"INPUT_FLOOD_ACTION_DISCONNECT":  3,
+	}
+)
+
+func (x InputFloodAction) Enum() *InputFloodAction {
+	p := new(InputFloodAction)
+	*p = x
+	return p
+}
+
+func (x InputFloodAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InputFloodAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_holomush_core_v1_core_proto_enumTypes[5].Descriptor()
+}
+
+func (InputFloodAction) Type() protoreflect.EnumType {
+	return &file_holomush_core_v1_core_proto_enumTypes[5]
+}
+
+func (x InputFloodAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InputFloodAction.Descriptor instead.
+func (InputFloodAction) EnumDescriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{5}
+}
+
 // RequestMeta travels on every request so the server can correlate a single
 // RPC across logs, traces, and audit. The CoreServer handlers read meta.request_id
 // into the slog "request_id" field and emit it as an OTel span attribute.
@@ -1827,6 +1889,264 @@ func (x *RefreshConnectionResponse) GetMeta() *ResponseMeta {
 	return nil
 }
 
+// ReportInputFloodRequest reports one input flood escalation on a connection.
+type ReportInputFloodRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meta carries request correlation data.
+	Meta *RequestMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// session_id names the game session owning the connection.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// connection_id is the connection the escalation applies to.
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// player_session_token proves the caller owns session_id.
+	PlayerSessionToken string `protobuf:"bytes,4,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// action is the escalation step taken.
+	Action InputFloodAction `protobuf:"varint,5,opt,name=action,proto3,enum=holomush.core.v1.InputFloodAction" json:"action,omitempty"`
+	// dropped_commands counts the commands the gateway dropped on this
+	// connection since its flood state last reset.
+	DroppedCommands int32 `protobuf:"varint,6,opt,name=dropped_commands,json=droppedCommands,proto3" json:"dropped_commands,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReportInputFloodRequest) Reset() {
+	*x = ReportInputFloodRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportInputFloodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInputFloodRequest) ProtoMessage() {}
+
+func (x *ReportInputFloodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportInputFloodRequest.ProtoReflect.Descriptor instead.
+func (*ReportInputFloodRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReportInputFloodRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *ReportInputFloodRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReportInputFloodRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ReportInputFloodRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *ReportInputFloodRequest) GetAction() InputFloodAction {
+	if x != nil {
+		return x.Action
+	}
+	return InputFloodAction_INPUT_FLOOD_ACTION_UNSPECIFIED
+}
+
+func (x *ReportInputFloodRequest) GetDroppedCommands() int32 {
+	if x != nil {
+		return x.DroppedCommands
+	}
+	return 0
+}
+
+// ReportInputFloodResponse is empty on success; failures are gRPC status codes.
+type ReportInputFloodResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meta carries response correlation data.
+	Meta          *ResponseMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportInputFloodResponse) Reset() {
+	*x = ReportInputFloodResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportInputFloodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInputFloodResponse) ProtoMessage() {}
+
+func (x *ReportInputFloodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportInputFloodResponse.ProtoReflect.Descriptor instead.
+func (*ReportInputFloodResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReportInputFloodResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+// CheckAddressBanRequest asks whether a client address is banned.
+type CheckAddressBanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meta carries request correlation data.
+	Meta *RequestMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// ip_address is the bare remote IP of the connecting client.
+	IpAddress     string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAddressBanRequest) Reset() {
+	*x = CheckAddressBanRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAddressBanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAddressBanRequest) ProtoMessage() {}
+
+func (x *CheckAddressBanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAddressBanRequest.ProtoReflect.Descriptor instead.
+func (*CheckAddressBanRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckAddressBanRequest) GetMeta() *RequestMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CheckAddressBanRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+// CheckAddressBanResponse answers CheckAddressBan.
+type CheckAddressBanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meta carries response correlation data.
+	Meta *ResponseMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// banned is true when an active ban matches ip_address or its hostname.
+	Banned bool `protobuf:"varint,2,opt,name=banned,proto3" json:"banned,omitempty"`
+	// reason is the ban's stated reason, shown to the refused client. Empty
+	// when not banned or when the ban gives none.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAddressBanResponse) Reset() {
+	*x = CheckAddressBanResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAddressBanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAddressBanResponse) ProtoMessage() {}
+
+func (x *CheckAddressBanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAddressBanResponse.ProtoReflect.Descriptor instead.
+func (*CheckAddressBanResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckAddressBanResponse) GetMeta() *ResponseMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *CheckAddressBanResponse) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *CheckAddressBanResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // GetCommandHistoryRequest asks for the recent command lines recorded for a
 // session (the per-session command ring buffer, not event history).
 type GetCommandHistoryRequest struct {
@@ -1845,7 +2165,7 @@ type GetCommandHistoryRequest struct {
 
 func (x *GetCommandHistoryRequest) Reset() {
 	*x = GetCommandHistoryRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[19]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1857,7 +2177,7 @@ func (x *GetCommandHistoryRequest) String() string {
 func (*GetCommandHistoryRequest) ProtoMessage() {}
 
 func (x *GetCommandHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[19]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +2190,7 @@ func (x *GetCommandHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommandHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetCommandHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{19}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetCommandHistoryRequest) GetMeta() *RequestMeta {
@@ -1911,7 +2231,7 @@ type GetCommandHistoryResponse struct {
 
 func (x *GetCommandHistoryResponse) Reset() {
 	*x = GetCommandHistoryResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[20]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1923,7 +2243,7 @@ func (x *GetCommandHistoryResponse) String() string {
 func (*GetCommandHistoryResponse) ProtoMessage() {}
 
 func (x *GetCommandHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[20]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,7 +2256,7 @@ func (x *GetCommandHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCommandHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetCommandHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{20}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetCommandHistoryResponse) GetMeta() *ResponseMeta {
@@ -1993,7 +2313,7 @@ type CharacterSummary struct {
 
 func (x *CharacterSummary) Reset() {
 	*x = CharacterSummary{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[21]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +2325,7 @@ func (x *CharacterSummary) String() string {
 func (*CharacterSummary) ProtoMessage() {}
 
 func (x *CharacterSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[21]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,7 +2338,7 @@ func (x *CharacterSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CharacterSummary.ProtoReflect.Descriptor instead.
 func (*CharacterSummary) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{21}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *CharacterSummary) GetCharacterId() string {
@@ -2080,7 +2400,7 @@ type AuthenticatePlayerRequest struct {
 
 func (x *AuthenticatePlayerRequest) Reset() {
 	*x = AuthenticatePlayerRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[22]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2092,7 +2412,7 @@ func (x *AuthenticatePlayerRequest) String() string {
 func (*AuthenticatePlayerRequest) ProtoMessage() {}
 
 func (x *AuthenticatePlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[22]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,7 +2425,7 @@ func (x *AuthenticatePlayerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticatePlayerRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticatePlayerRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{22}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AuthenticatePlayerRequest) GetUsername() string {
@@ -2162,7 +2482,7 @@ type AuthenticatePlayerResponse struct {
 
 func (x *AuthenticatePlayerResponse) Reset() {
 	*x = AuthenticatePlayerResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[23]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2174,7 +2494,7 @@ func (x *AuthenticatePlayerResponse) String() string {
 func (*AuthenticatePlayerResponse) ProtoMessage() {}
 
 func (x *AuthenticatePlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[23]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,7 +2507,7 @@ func (x *AuthenticatePlayerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticatePlayerResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticatePlayerResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{23}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AuthenticatePlayerResponse) GetSuccess() bool {
@@ -2232,40 +2552,37 @@ func (x *AuthenticatePlayerResponse) GetSessionTtlSeconds() int64 {
 	return 0
 }
 
-// SelectCharacterRequest carries phase-two character selection.
-type SelectCharacterRequest struct {
+// AuthenticateWebSessionRequest carries credentials and a description of the
+// browser signing in.
+type AuthenticateWebSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token proves the caller's authenticated player identity.
-	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// character_id names the character to enter the game as; it must belong to the
-	// authenticated player.
-	CharacterId string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	// client_type declares the surface establishing the session
-	// (terminal/comms_hub/telnet — the session_connections vocabulary). When
-	// "comms_hub", a FRESH session creation skips the grid arrive emission:
-	// scenes-workspace sessions must not announce the character on the grid
-	// (spec 2026-06-07 §V2). Empty preserves the legacy behavior (arrive).
-	// Reattach paths never re-emit arrive regardless of this field.
-	ClientType    string `protobuf:"bytes,3,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// username is the player's account name.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the plaintext password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// user_agent is the browser's User-Agent, recorded on the session.
+	UserAgent string `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// device_label is the player's optional name for the device.
+	DeviceLabel   string `protobuf:"bytes,4,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SelectCharacterRequest) Reset() {
-	*x = SelectCharacterRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[24]
+func (x *AuthenticateWebSessionRequest) Reset() {
+	*x = AuthenticateWebSessionRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SelectCharacterRequest) String() string {
+func (x *AuthenticateWebSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectCharacterRequest) ProtoMessage() {}
+func (*AuthenticateWebSessionRequest) ProtoMessage() {}
 
-func (x *SelectCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[24]
+func (x *AuthenticateWebSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,66 +2593,80 @@ func (x *SelectCharacterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectCharacterRequest.ProtoReflect.Descriptor instead.
-func (*SelectCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AuthenticateWebSessionRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateWebSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SelectCharacterRequest) GetPlayerSessionToken() string {
+func (x *AuthenticateWebSessionRequest) GetUsername() string {
 	if x != nil {
-		return x.PlayerSessionToken
+		return x.Username
 	}
 	return ""
 }
 
-func (x *SelectCharacterRequest) GetCharacterId() string {
+func (x *AuthenticateWebSessionRequest) GetPassword() string {
 	if x != nil {
-		return x.CharacterId
+		return x.Password
 	}
 	return ""
 }
 
-func (x *SelectCharacterRequest) GetClientType() string {
+func (x *AuthenticateWebSessionRequest) GetUserAgent() string {
 	if x != nil {
-		return x.ClientType
+		return x.UserAgent
 	}
 	return ""
 }
 
-// SelectCharacterResponse returns the game session created or reattached for the
-// chosen character.
-type SelectCharacterResponse struct {
+func (x *AuthenticateWebSessionRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+// AuthenticateWebSessionResponse returns a web session's tokens and the
+// player's character roster.
+type AuthenticateWebSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when a game session was established.
+	// success is true when credentials verified.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// session_id is the game session id to use for Subscribe/HandleCommand.
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// character_name is the selected character's display name.
-	CharacterName string `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
-	// reattached is true when an existing detached session was resumed (preserving
-	// scrollback) rather than a new one created.
-	Reattached bool `protobuf:"varint,4,opt,name=reattached,proto3" json:"reattached,omitempty"`
+	// access_token is the bearer token for subsequent post-auth RPCs; present
+	// only on success.
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// access_ttl_seconds is how long access_token stays valid.
+	AccessTtlSeconds int64 `protobuf:"varint,3,opt,name=access_ttl_seconds,json=accessTtlSeconds,proto3" json:"access_ttl_seconds,omitempty"`
+	// refresh_token is the single-use token for RefreshWebSession.
+	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// refresh_ttl_seconds is how long the session, and so refresh_token, stays
+	// valid without a refresh.
+	RefreshTtlSeconds int64 `protobuf:"varint,5,opt,name=refresh_ttl_seconds,json=refreshTtlSeconds,proto3" json:"refresh_ttl_seconds,omitempty"`
 	// error_message is a sanitized failure message on failure.
-	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// characters is the player's roster for the character-select screen.
+	Characters []*CharacterSummary `protobuf:"bytes,7,rep,name=characters,proto3" json:"characters,omitempty"`
+	// default_character_id is the player's preferred character to pre-select, if set.
+	DefaultCharacterId string `protobuf:"bytes,8,opt,name=default_character_id,json=defaultCharacterId,proto3" json:"default_character_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *SelectCharacterResponse) Reset() {
-	*x = SelectCharacterResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[25]
+func (x *AuthenticateWebSessionResponse) Reset() {
+	*x = AuthenticateWebSessionResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SelectCharacterResponse) String() string {
+func (x *AuthenticateWebSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelectCharacterResponse) ProtoMessage() {}
+func (*AuthenticateWebSessionResponse) ProtoMessage() {}
 
-func (x *SelectCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[25]
+func (x *AuthenticateWebSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,76 +2677,94 @@ func (x *SelectCharacterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelectCharacterResponse.ProtoReflect.Descriptor instead.
-func (*SelectCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AuthenticateWebSessionResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateWebSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *SelectCharacterResponse) GetSuccess() bool {
+func (x *AuthenticateWebSessionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *SelectCharacterResponse) GetSessionId() string {
+func (x *AuthenticateWebSessionResponse) GetAccessToken() string {
 	if x != nil {
-		return x.SessionId
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *SelectCharacterResponse) GetCharacterName() string {
+func (x *AuthenticateWebSessionResponse) GetAccessTtlSeconds() int64 {
 	if x != nil {
-		return x.CharacterName
+		return x.AccessTtlSeconds
+	}
+	return 0
+}
+
+func (x *AuthenticateWebSessionResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *SelectCharacterResponse) GetReattached() bool {
+func (x *AuthenticateWebSessionResponse) GetRefreshTtlSeconds() int64 {
 	if x != nil {
-		return x.Reattached
+		return x.RefreshTtlSeconds
 	}
-	return false
+	return 0
 }
 
-func (x *SelectCharacterResponse) GetErrorMessage() string {
+func (x *AuthenticateWebSessionResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-// CreatePlayerRequest carries new-account registration details.
-type CreatePlayerRequest struct {
+func (x *AuthenticateWebSessionResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *AuthenticateWebSessionResponse) GetDefaultCharacterId() string {
+	if x != nil {
+		return x.DefaultCharacterId
+	}
+	return ""
+}
+
+// RefreshWebSessionRequest carries a web session's refresh token.
+type RefreshWebSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// username is the desired account name.
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	// password is the desired plaintext password.
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// email is the contact email for the account (used by password reset).
-	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	// captcha_token is an optional anti-automation token.
-	CaptchaToken  string `protobuf:"bytes,4,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
+	// refresh_token is the token from the last AuthenticateWebSession or
+	// RefreshWebSession response.
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// user_agent is the browser's User-Agent, recorded with any security event.
+	UserAgent     string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePlayerRequest) Reset() {
-	*x = CreatePlayerRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[26]
+func (x *RefreshWebSessionRequest) Reset() {
+	*x = RefreshWebSessionRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlayerRequest) String() string {
+func (x *RefreshWebSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlayerRequest) ProtoMessage() {}
+func (*RefreshWebSessionRequest) ProtoMessage() {}
 
-func (x *CreatePlayerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[26]
+func (x *RefreshWebSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,74 +2775,61 @@ func (x *CreatePlayerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlayerRequest.ProtoReflect.Descriptor instead.
-func (*CreatePlayerRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *CreatePlayerRequest) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *CreatePlayerRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use RefreshWebSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshWebSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CreatePlayerRequest) GetEmail() string {
+func (x *RefreshWebSessionRequest) GetRefreshToken() string {
 	if x != nil {
-		return x.Email
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *CreatePlayerRequest) GetCaptchaToken() string {
+func (x *RefreshWebSessionRequest) GetUserAgent() string {
 	if x != nil {
-		return x.CaptchaToken
+		return x.UserAgent
 	}
 	return ""
 }
 
-// CreatePlayerResponse returns the new account's session token; the new player
-// is logged in immediately but has an empty character roster.
-type CreatePlayerResponse struct {
+// RefreshWebSessionResponse returns the web session's new tokens.
+type RefreshWebSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when the account was created.
+	// success is true when the tokens were rotated.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// player_session_token is the bearer token for the newly created, logged-in
-	// player.
-	PlayerSessionToken string `protobuf:"bytes,2,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// characters is always empty for a freshly created player.
-	Characters []*CharacterSummary `protobuf:"bytes,3,rep,name=characters,proto3" json:"characters,omitempty"`
-	// error_message is a sanitized failure message on failure.
-	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	// session_ttl_seconds is the session lifetime in seconds (see
-	// AuthenticatePlayerResponse).
-	SessionTtlSeconds int64 `protobuf:"varint,5,opt,name=session_ttl_seconds,json=sessionTtlSeconds,proto3" json:"session_ttl_seconds,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// access_token replaces the session's previous access token.
+	AccessToken string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// access_ttl_seconds is how long access_token stays valid.
+	AccessTtlSeconds int64 `protobuf:"varint,3,opt,name=access_ttl_seconds,json=accessTtlSeconds,proto3" json:"access_ttl_seconds,omitempty"`
+	// refresh_token replaces the presented refresh token, which is now retired.
+	RefreshToken string `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// refresh_ttl_seconds is how long the session stays valid without another
+	// refresh.
+	RefreshTtlSeconds int64 `protobuf:"varint,5,opt,name=refresh_ttl_seconds,json=refreshTtlSeconds,proto3" json:"refresh_ttl_seconds,omitempty"`
+	// error_message is a sanitized failure message on failure. The client must
+	// sign in again.
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePlayerResponse) Reset() {
-	*x = CreatePlayerResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[27]
+func (x *RefreshWebSessionResponse) Reset() {
+	*x = RefreshWebSessionResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePlayerResponse) String() string {
+func (x *RefreshWebSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePlayerResponse) ProtoMessage() {}
+func (*RefreshWebSessionResponse) ProtoMessage() {}
 
-func (x *CreatePlayerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[27]
+func (x *RefreshWebSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2504,68 +2840,78 @@ func (x *CreatePlayerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePlayerResponse.ProtoReflect.Descriptor instead.
-func (*CreatePlayerResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RefreshWebSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshWebSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreatePlayerResponse) GetSuccess() bool {
+func (x *RefreshWebSessionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreatePlayerResponse) GetPlayerSessionToken() string {
+func (x *RefreshWebSessionResponse) GetAccessToken() string {
 	if x != nil {
-		return x.PlayerSessionToken
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *CreatePlayerResponse) GetCharacters() []*CharacterSummary {
+func (x *RefreshWebSessionResponse) GetAccessTtlSeconds() int64 {
 	if x != nil {
-		return x.Characters
+		return x.AccessTtlSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *CreatePlayerResponse) GetErrorMessage() string {
+func (x *RefreshWebSessionResponse) GetRefreshToken() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *CreatePlayerResponse) GetSessionTtlSeconds() int64 {
+func (x *RefreshWebSessionResponse) GetRefreshTtlSeconds() int64 {
 	if x != nil {
-		return x.SessionTtlSeconds
+		return x.RefreshTtlSeconds
 	}
 	return 0
 }
 
-// CreateGuestRequest is empty: a guest provisioning takes no parameters.
-type CreateGuestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RefreshWebSessionResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// AuthenticateWithOIDCRequest carries a provider-issued ID token.
+type AuthenticateWithOIDCRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id_token is the raw OpenID Connect ID token (a signed JWT) the client
+	// obtained from a configured provider.
+	IdToken       string `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGuestRequest) Reset() {
-	*x = CreateGuestRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[28]
+func (x *AuthenticateWithOIDCRequest) Reset() {
+	*x = AuthenticateWithOIDCRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuestRequest) String() string {
+func (x *AuthenticateWithOIDCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuestRequest) ProtoMessage() {}
+func (*AuthenticateWithOIDCRequest) ProtoMessage() {}
 
-func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[28]
+func (x *AuthenticateWithOIDCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,48 +2922,53 @@ func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGuestRequest.ProtoReflect.Descriptor instead.
-func (*CreateGuestRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AuthenticateWithOIDCRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateWithOIDCRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{32}
 }
 
-// CreateGuestResponse returns an ephemeral guest player session plus the starter
-// character that was provisioned alongside it.
-type CreateGuestResponse struct {
+func (x *AuthenticateWithOIDCRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+// AuthenticateWithOIDCResponse mirrors AuthenticatePlayerResponse.
+type AuthenticateWithOIDCResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when the guest was provisioned.
+	// success is true when the ID token verified and names a linked identity.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// error_message is a generic failure message on failure.
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	// player_session_token is the bearer token for the guest session.
-	PlayerSessionToken string `protobuf:"bytes,3,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// characters holds the single starter character provisioned for the guest.
+	// player_session_token is the bearer token for subsequent post-auth RPCs;
+	// present only on success.
+	PlayerSessionToken string `protobuf:"bytes,2,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// characters is the player's roster for the character-select screen.
 	Characters []*CharacterSummary `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
-	// default_character_id is the starter character to pre-select.
+	// default_character_id is the player's preferred character to pre-select, if set.
 	DefaultCharacterId string `protobuf:"bytes,5,opt,name=default_character_id,json=defaultCharacterId,proto3" json:"default_character_id,omitempty"`
-	// session_ttl_seconds is the session lifetime in seconds (see
-	// AuthenticatePlayerResponse). For guest sessions this is the shorter guest TTL,
-	// not the regular-player TTL.
+	// session_ttl_seconds is the session lifetime in seconds.
 	SessionTtlSeconds int64 `protobuf:"varint,6,opt,name=session_ttl_seconds,json=sessionTtlSeconds,proto3" json:"session_ttl_seconds,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CreateGuestResponse) Reset() {
-	*x = CreateGuestResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[29]
+func (x *AuthenticateWithOIDCResponse) Reset() {
+	*x = AuthenticateWithOIDCResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuestResponse) String() string {
+func (x *AuthenticateWithOIDCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuestResponse) ProtoMessage() {}
+func (*AuthenticateWithOIDCResponse) ProtoMessage() {}
 
-func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[29]
+func (x *AuthenticateWithOIDCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,79 +2979,92 @@ func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGuestResponse.ProtoReflect.Descriptor instead.
-func (*CreateGuestResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use AuthenticateWithOIDCResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateWithOIDCResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateGuestResponse) GetSuccess() bool {
+func (x *AuthenticateWithOIDCResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateGuestResponse) GetErrorMessage() string {
+func (x *AuthenticateWithOIDCResponse) GetPlayerSessionToken() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *CreateGuestResponse) GetPlayerSessionToken() string {
+func (x *AuthenticateWithOIDCResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.PlayerSessionToken
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *CreateGuestResponse) GetCharacters() []*CharacterSummary {
+func (x *AuthenticateWithOIDCResponse) GetCharacters() []*CharacterSummary {
 	if x != nil {
 		return x.Characters
 	}
 	return nil
 }
 
-func (x *CreateGuestResponse) GetDefaultCharacterId() string {
+func (x *AuthenticateWithOIDCResponse) GetDefaultCharacterId() string {
 	if x != nil {
 		return x.DefaultCharacterId
 	}
 	return ""
 }
 
-func (x *CreateGuestResponse) GetSessionTtlSeconds() int64 {
+func (x *AuthenticateWithOIDCResponse) GetSessionTtlSeconds() int64 {
 	if x != nil {
 		return x.SessionTtlSeconds
 	}
 	return 0
 }
 
-// CreateCharacterRequest adds a character to the authenticated player's roster.
-type CreateCharacterRequest struct {
+// SelectCharacterRequest carries phase-two character selection.
+type SelectCharacterRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// player_session_token proves the caller's authenticated player identity.
 	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// character_name is the desired name for the new character.
-	CharacterName string `protobuf:"bytes,2,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateCharacterRequest) Reset() {
-	*x = CreateCharacterRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[30]
+	// character_id names the character to enter the game as; it must belong to the
+	// authenticated player.
+	CharacterId string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// client_type declares the surface establishing the session
+	// (terminal/comms_hub/telnet — the session_connections vocabulary). When
+	// "comms_hub", a FRESH session creation skips the grid arrive emission:
+	// scenes-workspace sessions must not announce the character on the grid
+	// (spec 2026-06-07 §V2). Empty preserves the legacy behavior (arrive).
+	// Reattach paths never re-emit arrive regardless of this field.
+	ClientType string `protobuf:"bytes,3,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// issue_reconnect_token asks for a reconnect token in the response, for
+	// clients that can present it to ResumeSession after a dropped connection.
+	// Issuing a token replaces any token previously issued for the session.
+	// Ignored for player sessions that hold a refresh token.
+	IssueReconnectToken bool `protobuf:"varint,4,opt,name=issue_reconnect_token,json=issueReconnectToken,proto3" json:"issue_reconnect_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SelectCharacterRequest) Reset() {
+	*x = SelectCharacterRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCharacterRequest) String() string {
+func (x *SelectCharacterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCharacterRequest) ProtoMessage() {}
+func (*SelectCharacterRequest) ProtoMessage() {}
 
-func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[30]
+func (x *SelectCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2711,55 +3075,76 @@ func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCharacterRequest.ProtoReflect.Descriptor instead.
-func (*CreateCharacterRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SelectCharacterRequest.ProtoReflect.Descriptor instead.
+func (*SelectCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateCharacterRequest) GetPlayerSessionToken() string {
+func (x *SelectCharacterRequest) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *CreateCharacterRequest) GetCharacterName() string {
+func (x *SelectCharacterRequest) GetCharacterId() string {
 	if x != nil {
-		return x.CharacterName
+		return x.CharacterId
 	}
 	return ""
 }
 
-// CreateCharacterResponse returns the newly created character.
-type CreateCharacterResponse struct {
+func (x *SelectCharacterRequest) GetClientType() string {
+	if x != nil {
+		return x.ClientType
+	}
+	return ""
+}
+
+func (x *SelectCharacterRequest) GetIssueReconnectToken() bool {
+	if x != nil {
+		return x.IssueReconnectToken
+	}
+	return false
+}
+
+// SelectCharacterResponse returns the game session created or reattached for the
+// chosen character.
+type SelectCharacterResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when the character was created.
+	// success is true when a game session was established.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// character_id is the new character's ULID.
-	CharacterId string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	// character_name is the new character's name as stored.
+	// session_id is the game session id to use for Subscribe/HandleCommand.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// character_name is the selected character's display name.
 	CharacterName string `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	// reattached is true when an existing detached session was resumed (preserving
+	// scrollback) rather than a new one created.
+	Reattached bool `protobuf:"varint,4,opt,name=reattached,proto3" json:"reattached,omitempty"`
 	// error_message is a sanitized failure message on failure.
-	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// reconnect_token resumes the session through ResumeSession. Set only when
+	// issue_reconnect_token was requested.
+	ReconnectToken string `protobuf:"bytes,6,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateCharacterResponse) Reset() {
-	*x = CreateCharacterResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[31]
+func (x *SelectCharacterResponse) Reset() {
+	*x = SelectCharacterResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCharacterResponse) String() string {
+func (x *SelectCharacterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCharacterResponse) ProtoMessage() {}
+func (*SelectCharacterResponse) ProtoMessage() {}
 
-func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[31]
+func (x *SelectCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2770,63 +3155,80 @@ func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCharacterResponse.ProtoReflect.Descriptor instead.
-func (*CreateCharacterResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SelectCharacterResponse.ProtoReflect.Descriptor instead.
+func (*SelectCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateCharacterResponse) GetSuccess() bool {
+func (x *SelectCharacterResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateCharacterResponse) GetCharacterId() string {
+func (x *SelectCharacterResponse) GetSessionId() string {
 	if x != nil {
-		return x.CharacterId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CreateCharacterResponse) GetCharacterName() string {
+func (x *SelectCharacterResponse) GetCharacterName() string {
 	if x != nil {
 		return x.CharacterName
 	}
 	return ""
 }
 
-func (x *CreateCharacterResponse) GetErrorMessage() string {
+func (x *SelectCharacterResponse) GetReattached() bool {
+	if x != nil {
+		return x.Reattached
+	}
+	return false
+}
+
+func (x *SelectCharacterResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-// ListCharactersRequest asks for the authenticated player's character roster.
-type ListCharactersRequest struct {
+func (x *SelectCharacterResponse) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
+}
+
+// ResumeSessionRequest redeems a reconnect token.
+type ResumeSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token proves the caller's authenticated player identity.
-	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// meta carries request correlation data.
+	Meta *RequestMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// reconnect_token is the token SelectCharacter or a previous ResumeSession
+	// issued.
+	ReconnectToken string `protobuf:"bytes,2,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListCharactersRequest) Reset() {
-	*x = ListCharactersRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[32]
+func (x *ResumeSessionRequest) Reset() {
+	*x = ResumeSessionRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCharactersRequest) String() string {
+func (x *ResumeSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCharactersRequest) ProtoMessage() {}
+func (*ResumeSessionRequest) ProtoMessage() {}
 
-func (x *ListCharactersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[32]
+func (x *ResumeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2837,42 +3239,63 @@ func (x *ListCharactersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCharactersRequest.ProtoReflect.Descriptor instead.
-func (*ListCharactersRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ResumeSessionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListCharactersRequest) GetPlayerSessionToken() string {
+func (x *ResumeSessionRequest) GetMeta() *RequestMeta {
 	if x != nil {
-		return x.PlayerSessionToken
+		return x.Meta
 	}
-	return ""
+	return nil
 }
 
-// ListCharactersResponse returns the player's roster with session-status enrichment.
-type ListCharactersResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// characters is the player's roster.
-	Characters    []*CharacterSummary `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ResumeSessionRequest) GetReconnectToken() string {
+	if x != nil {
+		return x.ReconnectToken
+	}
+	return ""
 }
 
-func (x *ListCharactersResponse) Reset() {
-	*x = ListCharactersResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[33]
+// ResumeSessionResponse returns the resumed game session and the rotated
+// tokens that replace the presented ones.
+type ResumeSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meta carries response correlation data.
+	Meta *ResponseMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// success is true when the session was resumed.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// error_message is a sanitized failure message on failure. Every rejected
+	// token gets the same message.
+	ErrorMessage string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// session_id is the resumed game session id.
+	SessionId string `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// character_name is the session's character display name.
+	CharacterName string `protobuf:"bytes,5,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	// player_session_token replaces the token the dropped connection held.
+	PlayerSessionToken string `protobuf:"bytes,6,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// reconnect_token replaces the presented reconnect token.
+	ReconnectToken string `protobuf:"bytes,7,opt,name=reconnect_token,json=reconnectToken,proto3" json:"reconnect_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResumeSessionResponse) Reset() {
+	*x = ResumeSessionResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCharactersResponse) String() string {
+func (x *ResumeSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCharactersResponse) ProtoMessage() {}
+func (*ResumeSessionResponse) ProtoMessage() {}
 
-func (x *ListCharactersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[33]
+func (x *ResumeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2883,102 +3306,90 @@ func (x *ListCharactersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCharactersResponse.ProtoReflect.Descriptor instead.
-func (*ListCharactersResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ResumeSessionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListCharactersResponse) GetCharacters() []*CharacterSummary {
+func (x *ResumeSessionResponse) GetMeta() *ResponseMeta {
 	if x != nil {
-		return x.Characters
+		return x.Meta
 	}
 	return nil
 }
 
-// ListAllCharactersRequest authenticates the caller and names the acting alt
-// (the ABAC subject). No pagination — the directory is returned in full.
-type ListAllCharactersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token authenticates the caller; any valid session (guest or
-	// registered) is accepted. Required.
-	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// character_id is the acting alt; the handler verifies the session owns it and
-	// uses it as the ABAC subject for the list_character_directory action. Required.
-	CharacterId   string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListAllCharactersRequest) Reset() {
-	*x = ListAllCharactersRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ResumeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *ListAllCharactersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ResumeSessionResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
 }
 
-func (*ListAllCharactersRequest) ProtoMessage() {}
-
-func (x *ListAllCharactersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[34]
+func (x *ResumeSessionResponse) GetSessionId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SessionId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListAllCharactersRequest.ProtoReflect.Descriptor instead.
-func (*ListAllCharactersRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{34}
+func (x *ResumeSessionResponse) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
 }
 
-func (x *ListAllCharactersRequest) GetPlayerSessionToken() string {
+func (x *ResumeSessionResponse) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *ListAllCharactersRequest) GetCharacterId() string {
+func (x *ResumeSessionResponse) GetReconnectToken() string {
 	if x != nil {
-		return x.CharacterId
+		return x.ReconnectToken
 	}
 	return ""
 }
 
-// CharacterDirectoryEntry is one directory row: identity + display name only.
-type CharacterDirectoryEntry struct {
+// CreatePlayerRequest carries new-account registration details.
+type CreatePlayerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// character_id is the character's ULID.
-	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	// name is the character's display name.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// username is the desired account name.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// password is the desired plaintext password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// email is the contact email for the account (used by password reset).
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// captcha_token is an optional anti-automation token.
+	CaptchaToken  string `protobuf:"bytes,4,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CharacterDirectoryEntry) Reset() {
-	*x = CharacterDirectoryEntry{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[35]
+func (x *CreatePlayerRequest) Reset() {
+	*x = CreatePlayerRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CharacterDirectoryEntry) String() string {
+func (x *CreatePlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CharacterDirectoryEntry) ProtoMessage() {}
+func (*CreatePlayerRequest) ProtoMessage() {}
 
-func (x *CharacterDirectoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[35]
+func (x *CreatePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2989,49 +3400,1003 @@ func (x *CharacterDirectoryEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CharacterDirectoryEntry.ProtoReflect.Descriptor instead.
-func (*CharacterDirectoryEntry) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreatePlayerRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreatePlayerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreatePlayerRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreatePlayerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreatePlayerRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+// CreatePlayerResponse returns the new account's session token; the new player
+// is logged in immediately but has an empty character roster.
+type CreatePlayerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is true when the account was created.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// player_session_token is the bearer token for the newly created, logged-in
+	// player.
+	PlayerSessionToken string `protobuf:"bytes,2,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// characters is always empty for a freshly created player.
+	Characters []*CharacterSummary `protobuf:"bytes,3,rep,name=characters,proto3" json:"characters,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// session_ttl_seconds is the session lifetime in seconds (see
+	// AuthenticatePlayerResponse).
+	SessionTtlSeconds int64 `protobuf:"varint,5,opt,name=session_ttl_seconds,json=sessionTtlSeconds,proto3" json:"session_ttl_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreatePlayerResponse) Reset() {
+	*x = CreatePlayerResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlayerResponse) ProtoMessage() {}
+
+func (x *CreatePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlayerResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreatePlayerResponse) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *CreatePlayerResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *CreatePlayerResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CreatePlayerResponse) GetSessionTtlSeconds() int64 {
+	if x != nil {
+		return x.SessionTtlSeconds
+	}
+	return 0
+}
+
+// CreateGuestRequest is empty: a guest provisioning takes no parameters.
+type CreateGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestRequest) Reset() {
+	*x = CreateGuestRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestRequest) ProtoMessage() {}
+
+func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{40}
+}
+
+// CreateGuestResponse returns an ephemeral guest player session plus the starter
+// character that was provisioned alongside it.
+type CreateGuestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is true when the guest was provisioned.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// error_message is a generic failure message on failure.
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// player_session_token is the bearer token for the guest session.
+	PlayerSessionToken string `protobuf:"bytes,3,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// characters holds the single starter character provisioned for the guest.
+	Characters []*CharacterSummary `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
+	// default_character_id is the starter character to pre-select.
+	DefaultCharacterId string `protobuf:"bytes,5,opt,name=default_character_id,json=defaultCharacterId,proto3" json:"default_character_id,omitempty"`
+	// session_ttl_seconds is the session lifetime in seconds (see
+	// AuthenticatePlayerResponse). For guest sessions this is the shorter guest TTL,
+	// not the regular-player TTL.
+	SessionTtlSeconds int64 `protobuf:"varint,6,opt,name=session_ttl_seconds,json=sessionTtlSeconds,proto3" json:"session_ttl_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateGuestResponse) Reset() {
+	*x = CreateGuestResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestResponse) ProtoMessage() {}
+
+func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateGuestResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateGuestResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CreateGuestResponse) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *CreateGuestResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *CreateGuestResponse) GetDefaultCharacterId() string {
+	if x != nil {
+		return x.DefaultCharacterId
+	}
+	return ""
+}
+
+func (x *CreateGuestResponse) GetSessionTtlSeconds() int64 {
+	if x != nil {
+		return x.SessionTtlSeconds
+	}
+	return 0
+}
+
+// CreateCharacterRequest adds a character to the authenticated player's roster.
+type CreateCharacterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player_session_token proves the caller's authenticated player identity.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// character_name is the desired name for the new character.
+	CharacterName string `protobuf:"bytes,2,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCharacterRequest) Reset() {
+	*x = CreateCharacterRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCharacterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCharacterRequest) ProtoMessage() {}
+
+func (x *CreateCharacterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCharacterRequest.ProtoReflect.Descriptor instead.
+func (*CreateCharacterRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateCharacterRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *CreateCharacterRequest) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+// CreateCharacterResponse returns the newly created character.
+type CreateCharacterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is true when the character was created.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// character_id is the new character's ULID.
+	CharacterId string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// character_name is the new character's name as stored.
+	CharacterName string `protobuf:"bytes,3,opt,name=character_name,json=characterName,proto3" json:"character_name,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCharacterResponse) Reset() {
+	*x = CreateCharacterResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCharacterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCharacterResponse) ProtoMessage() {}
+
+func (x *CreateCharacterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCharacterResponse.ProtoReflect.Descriptor instead.
+func (*CreateCharacterResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateCharacterResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateCharacterResponse) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CreateCharacterResponse) GetCharacterName() string {
+	if x != nil {
+		return x.CharacterName
+	}
+	return ""
+}
+
+func (x *CreateCharacterResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ListCharactersRequest asks for the authenticated player's character roster.
+type ListCharactersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player_session_token proves the caller's authenticated player identity.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListCharactersRequest) Reset() {
+	*x = ListCharactersRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharactersRequest) ProtoMessage() {}
+
+func (x *ListCharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharactersRequest.ProtoReflect.Descriptor instead.
+func (*ListCharactersRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListCharactersRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+// ListCharactersResponse returns the player's roster with session-status enrichment.
+type ListCharactersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// characters is the player's roster.
+	Characters    []*CharacterSummary `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCharactersResponse) Reset() {
+	*x = ListCharactersResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCharactersResponse) ProtoMessage() {}
+
+func (x *ListCharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCharactersResponse.ProtoReflect.Descriptor instead.
+func (*ListCharactersResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListCharactersResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+// ListAllCharactersRequest authenticates the caller and names the acting alt
+// (the ABAC subject). No pagination — the directory is returned in full.
+type ListAllCharactersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player_session_token authenticates the caller; any valid session (guest or
+	// registered) is accepted. Required.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// character_id is the acting alt; the handler verifies the session owns it and
+	// uses it as the ABAC subject for the list_character_directory action. Required.
+	CharacterId   string `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllCharactersRequest) Reset() {
+	*x = ListAllCharactersRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllCharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllCharactersRequest) ProtoMessage() {}
+
+func (x *ListAllCharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllCharactersRequest.ProtoReflect.Descriptor instead.
+func (*ListAllCharactersRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListAllCharactersRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *ListAllCharactersRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+// CharacterDirectoryEntry is one directory row: identity + display name only.
+type CharacterDirectoryEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// character_id is the character's ULID.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// name is the character's display name.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CharacterDirectoryEntry) Reset() {
+	*x = CharacterDirectoryEntry{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CharacterDirectoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharacterDirectoryEntry) ProtoMessage() {}
+
+func (x *CharacterDirectoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharacterDirectoryEntry.ProtoReflect.Descriptor instead.
+func (*CharacterDirectoryEntry) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CharacterDirectoryEntry) GetCharacterId() string {
 	if x != nil {
-		return x.CharacterId
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CharacterDirectoryEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListAllCharactersResponse carries the directory page.
+type ListAllCharactersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// characters is the id+name list, name-ascending. No connection state.
+	Characters    []*CharacterDirectoryEntry `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllCharactersResponse) Reset() {
+	*x = ListAllCharactersResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllCharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllCharactersResponse) ProtoMessage() {}
+
+func (x *ListAllCharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllCharactersResponse.ProtoReflect.Descriptor instead.
+func (*ListAllCharactersResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListAllCharactersResponse) GetCharacters() []*CharacterDirectoryEntry {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+// RequestPasswordResetRequest begins a password-reset flow by email.
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email is the account email to send the reset to.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RequestPasswordResetResponse always reports success regardless of whether the
+// email exists — an intentional account-enumeration-prevention measure.
+type RequestPasswordResetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is always true (enumeration-safe; reveals nothing about whether the
+	// email is registered).
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RequestPasswordResetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ConfirmPasswordResetRequest completes a reset using the emailed token.
+type ConfirmPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token is the single-use reset token from the reset email.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// new_password is the plaintext replacement password.
+	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetRequest) Reset() {
+	*x = ConfirmPasswordResetRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConfirmPasswordResetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ConfirmPasswordResetResponse reports the outcome with a sanitized error.
+type ConfirmPasswordResetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is true when the password was reset.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// error_message is a sanitized failure message on failure (never echoes the
+	// token).
+	ErrorMessage  string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPasswordResetResponse) Reset() {
+	*x = ConfirmPasswordResetResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+
+func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ConfirmPasswordResetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfirmPasswordResetResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// LogoutRequest ends the player session identified by the supplied token.
+type LogoutRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player_session_token identifies the PlayerSession to end.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LogoutRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *CharacterDirectoryEntry) GetName() string {
+// LogoutResponse is empty: logout reports success solely by returning without an
+// error status.
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[54]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{54}
+}
+
+// CheckPlayerSessionRequest validates a session token, typically the value from
+// a web auth cookie.
+type CheckPlayerSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// player_session_token is the token to validate.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CheckPlayerSessionRequest) Reset() {
+	*x = CheckPlayerSessionRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPlayerSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPlayerSessionRequest) ProtoMessage() {}
+
+func (x *CheckPlayerSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPlayerSessionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPlayerSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CheckPlayerSessionRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-// ListAllCharactersResponse carries the directory page.
-type ListAllCharactersResponse struct {
+// CheckPlayerSessionResponse returns the player identity behind a valid token.
+// The failure path returns an Unauthenticated status with no body, so these
+// fields are absent for unknown/expired sessions — preserving the enumeration-
+// safety contract documented in internal/auth (session ownership).
+type CheckPlayerSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// characters is the id+name list, name-ascending. No connection state.
-	Characters    []*CharacterDirectoryEntry `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	// player_name is the account username.
+	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	// player_id is the player's ULID.
+	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// is_guest is true when the session belongs to an ephemeral guest player.
+	IsGuest bool `protobuf:"varint,3,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
+	// characters is the player's roster (enriched with session status).
+	Characters    []*CharacterSummary `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllCharactersResponse) Reset() {
-	*x = ListAllCharactersResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[36]
+func (x *CheckPlayerSessionResponse) Reset() {
+	*x = CheckPlayerSessionResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllCharactersResponse) String() string {
+func (x *CheckPlayerSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllCharactersResponse) ProtoMessage() {}
+func (*CheckPlayerSessionResponse) ProtoMessage() {}
 
-func (x *ListAllCharactersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[36]
+func (x *CheckPlayerSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3042,90 +4407,217 @@ func (x *ListAllCharactersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllCharactersResponse.ProtoReflect.Descriptor instead.
-func (*ListAllCharactersResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CheckPlayerSessionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPlayerSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListAllCharactersResponse) GetCharacters() []*CharacterDirectoryEntry {
+func (x *CheckPlayerSessionResponse) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CheckPlayerSessionResponse) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CheckPlayerSessionResponse) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
+func (x *CheckPlayerSessionResponse) GetCharacters() []*CharacterSummary {
 	if x != nil {
 		return x.Characters
 	}
 	return nil
 }
 
-// RequestPasswordResetRequest begins a password-reset flow by email.
-type RequestPasswordResetRequest struct {
+// ListPlayerSessionsRequest asks for the caller's own active PlayerSessions.
+type ListPlayerSessionsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// email is the account email to send the reset to.
-	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// player_session_token identifies the caller; the response lists that player's
+	// sessions.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListPlayerSessionsRequest) Reset() {
+	*x = ListPlayerSessionsRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlayerSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerSessionsRequest) ProtoMessage() {}
+
+func (x *ListPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlayerSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListPlayerSessionsRequest) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+// PlayerSessionInfo describes one of the caller's PlayerSessions for device-
+// management UX. It never carries the session token — only safe-to-display
+// metadata.
+type PlayerSessionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the PlayerSession's ULID. Safe to show the user — this is a resource
+	// handle, not a secret — and is the value passed as target_session_id to
+	// RevokePlayerSession.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is when the session was established.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// last_active is sourced from player_sessions.updated_at, bumped whenever the
+	// session is refreshed.
+	LastActive *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
+	// user_agent is the client user-agent recorded at session creation.
+	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// ip_address is the client IP recorded at session creation.
+	IpAddress string `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// is_current is true for exactly the PlayerSession that made the
+	// ListPlayerSessions request — supports a "this device" indicator.
+	IsCurrent bool `protobuf:"varint,6,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// device_label is the player's name for the device, if they gave one.
+	DeviceLabel   string `protobuf:"bytes,7,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestPasswordResetRequest) Reset() {
-	*x = RequestPasswordResetRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PlayerSessionInfo) Reset() {
+	*x = PlayerSessionInfo{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSessionInfo) ProtoMessage() {}
+
+func (x *PlayerSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSessionInfo.ProtoReflect.Descriptor instead.
+func (*PlayerSessionInfo) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PlayerSessionInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerSessionInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlayerSessionInfo) GetLastActive() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActive
+	}
+	return nil
 }
 
-func (x *RequestPasswordResetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PlayerSessionInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
 }
 
-func (*RequestPasswordResetRequest) ProtoMessage() {}
-
-func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[37]
+func (x *PlayerSessionInfo) GetIpAddress() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IpAddress
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
-func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{37}
+func (x *PlayerSessionInfo) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
 }
 
-func (x *RequestPasswordResetRequest) GetEmail() string {
+func (x *PlayerSessionInfo) GetDeviceLabel() string {
 	if x != nil {
-		return x.Email
+		return x.DeviceLabel
 	}
 	return ""
 }
 
-// RequestPasswordResetResponse always reports success regardless of whether the
-// email exists — an intentional account-enumeration-prevention measure.
-type RequestPasswordResetResponse struct {
+// ListPlayerSessionsResponse returns the caller's PlayerSessions. An empty list
+// is also the enumeration-safe response on any auth failure.
+type ListPlayerSessionsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is always true (enumeration-safe; reveals nothing about whether the
-	// email is registered).
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// sessions is the caller's active PlayerSessions; never includes tokens.
+	Sessions      []*PlayerSessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RequestPasswordResetResponse) Reset() {
-	*x = RequestPasswordResetResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[38]
+func (x *ListPlayerSessionsResponse) Reset() {
+	*x = ListPlayerSessionsResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RequestPasswordResetResponse) String() string {
+func (x *ListPlayerSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestPasswordResetResponse) ProtoMessage() {}
+func (*ListPlayerSessionsResponse) ProtoMessage() {}
 
-func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[38]
+func (x *ListPlayerSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3136,44 +4628,47 @@ func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
-func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ListPlayerSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlayerSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *RequestPasswordResetResponse) GetSuccess() bool {
+func (x *ListPlayerSessionsResponse) GetSessions() []*PlayerSessionInfo {
 	if x != nil {
-		return x.Success
+		return x.Sessions
 	}
-	return false
+	return nil
 }
 
-// ConfirmPasswordResetRequest completes a reset using the emailed token.
-type ConfirmPasswordResetRequest struct {
+// RevokePlayerSessionRequest deletes one of the caller's PlayerSessions.
+type RevokePlayerSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// token is the single-use reset token from the reset email.
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// new_password is the plaintext replacement password.
-	NewPassword   string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// player_session_token identifies the caller; only the caller's own sessions
+	// may be revoked.
+	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// target_session_id is the PlayerSession.id (ULID) to revoke — NOT the game
+	// session_id. A revoke targeting another player's session collapses to "session
+	// not found".
+	TargetSessionId string `protobuf:"bytes,2,opt,name=target_session_id,json=targetSessionId,proto3" json:"target_session_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ConfirmPasswordResetRequest) Reset() {
-	*x = ConfirmPasswordResetRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[39]
+func (x *RevokePlayerSessionRequest) Reset() {
+	*x = RevokePlayerSessionRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmPasswordResetRequest) String() string {
+func (x *RevokePlayerSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmPasswordResetRequest) ProtoMessage() {}
+func (*RevokePlayerSessionRequest) ProtoMessage() {}
 
-func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[39]
+func (x *RevokePlayerSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3184,52 +4679,52 @@ func (x *ConfirmPasswordResetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmPasswordResetRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmPasswordResetRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RevokePlayerSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokePlayerSessionRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ConfirmPasswordResetRequest) GetToken() string {
+func (x *RevokePlayerSessionRequest) GetPlayerSessionToken() string {
 	if x != nil {
-		return x.Token
+		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *ConfirmPasswordResetRequest) GetNewPassword() string {
+func (x *RevokePlayerSessionRequest) GetTargetSessionId() string {
 	if x != nil {
-		return x.NewPassword
+		return x.TargetSessionId
 	}
 	return ""
 }
 
-// ConfirmPasswordResetResponse reports the outcome with a sanitized error.
-type ConfirmPasswordResetResponse struct {
+// RevokePlayerSessionResponse reports the outcome with an enumeration-safe error.
+type RevokePlayerSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when the password was reset.
+	// success is true when the target session was deleted.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// error_message is a sanitized failure message on failure (never echoes the
-	// token).
+	// error_message is "session not found" on any failure, including cross-player
+	// attempts (enumeration-safe).
 	ErrorMessage  string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmPasswordResetResponse) Reset() {
-	*x = ConfirmPasswordResetResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[40]
+func (x *RevokePlayerSessionResponse) Reset() {
+	*x = RevokePlayerSessionResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmPasswordResetResponse) String() string {
+func (x *RevokePlayerSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmPasswordResetResponse) ProtoMessage() {}
+func (*RevokePlayerSessionResponse) ProtoMessage() {}
 
-func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[40]
+func (x *RevokePlayerSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3240,49 +4735,54 @@ func (x *ConfirmPasswordResetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmPasswordResetResponse.ProtoReflect.Descriptor instead.
-func (*ConfirmPasswordResetResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RevokePlayerSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokePlayerSessionResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ConfirmPasswordResetResponse) GetSuccess() bool {
+func (x *RevokePlayerSessionResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ConfirmPasswordResetResponse) GetErrorMessage() string {
+func (x *RevokePlayerSessionResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-// LogoutRequest ends the player session identified by the supplied token.
-type LogoutRequest struct {
+// LinkIdentityRequest links an OpenID Connect identity to the caller's player.
+type LinkIdentityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token identifies the PlayerSession to end.
+	// player_session_token identifies the caller.
 	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// id_token is the raw OpenID Connect ID token asserting the identity.
+	IdToken string `protobuf:"bytes,2,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	// password is the player's current password. Required; an account without
+	// a password must set one before linking.
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[41]
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *LinkIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*LinkIdentityRequest) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[41]
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3293,41 +4793,60 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *LogoutRequest) GetPlayerSessionToken() string {
+func (x *LinkIdentityRequest) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-// LogoutResponse is empty: logout reports success solely by returning without an
-// error status.
-type LogoutResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *LinkIdentityRequest) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LinkIdentityRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// LinkIdentityResponse reports the linked provider.
+type LinkIdentityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// success is true when the identity was linked.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// provider is the configured provider name the identity belongs to.
+	Provider string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[42]
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *LinkIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*LinkIdentityResponse) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[42]
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3338,36 +4857,58 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{63}
 }
 
-// CheckPlayerSessionRequest validates a session token, typically the value from
-// a web auth cookie.
-type CheckPlayerSessionRequest struct {
+func (x *LinkIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LinkIdentityResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkIdentityResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// UnlinkIdentityRequest removes one of the caller's linked identities.
+type UnlinkIdentityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token is the token to validate.
+	// player_session_token identifies the caller.
 	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// provider is the configured provider name of the identity to remove.
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPlayerSessionRequest) Reset() {
-	*x = CheckPlayerSessionRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[43]
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPlayerSessionRequest) String() string {
+func (x *UnlinkIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPlayerSessionRequest) ProtoMessage() {}
+func (*UnlinkIdentityRequest) ProtoMessage() {}
 
-func (x *CheckPlayerSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[43]
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3378,51 +4919,51 @@ func (x *CheckPlayerSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPlayerSessionRequest.ProtoReflect.Descriptor instead.
-func (*CheckPlayerSessionRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *CheckPlayerSessionRequest) GetPlayerSessionToken() string {
+func (x *UnlinkIdentityRequest) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-// CheckPlayerSessionResponse returns the player identity behind a valid token.
-// The failure path returns an Unauthenticated status with no body, so these
-// fields are absent for unknown/expired sessions — preserving the enumeration-
-// safety contract documented in internal/auth (session ownership).
-type CheckPlayerSessionResponse struct {
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// UnlinkIdentityResponse reports whether the identity was removed.
+type UnlinkIdentityResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_name is the account username.
-	PlayerName string `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	// player_id is the player's ULID.
-	PlayerId string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	// is_guest is true when the session belongs to an ephemeral guest player.
-	IsGuest bool `protobuf:"varint,3,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
-	// characters is the player's roster (enriched with session status).
-	Characters    []*CharacterSummary `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
+	// success is true when the identity was unlinked.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage  string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckPlayerSessionResponse) Reset() {
-	*x = CheckPlayerSessionResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[44]
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckPlayerSessionResponse) String() string {
+func (x *UnlinkIdentityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckPlayerSessionResponse) ProtoMessage() {}
+func (*UnlinkIdentityResponse) ProtoMessage() {}
 
-func (x *CheckPlayerSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[44]
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,64 +4974,49 @@ func (x *CheckPlayerSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckPlayerSessionResponse.ProtoReflect.Descriptor instead.
-func (*CheckPlayerSessionResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *CheckPlayerSessionResponse) GetPlayerName() string {
-	if x != nil {
-		return x.PlayerName
-	}
-	return ""
-}
-
-func (x *CheckPlayerSessionResponse) GetPlayerId() string {
-	if x != nil {
-		return x.PlayerId
-	}
-	return ""
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CheckPlayerSessionResponse) GetIsGuest() bool {
+func (x *UnlinkIdentityResponse) GetSuccess() bool {
 	if x != nil {
-		return x.IsGuest
+		return x.Success
 	}
 	return false
 }
 
-func (x *CheckPlayerSessionResponse) GetCharacters() []*CharacterSummary {
+func (x *UnlinkIdentityResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.Characters
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-// ListPlayerSessionsRequest asks for the caller's own active PlayerSessions.
-type ListPlayerSessionsRequest struct {
+// ListIdentitiesRequest asks for the caller's linked identities.
+type ListIdentitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token identifies the caller; the response lists that player's
-	// sessions.
+	// player_session_token identifies the caller.
 	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListPlayerSessionsRequest) Reset() {
-	*x = ListPlayerSessionsRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[45]
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlayerSessionsRequest) String() string {
+func (x *ListIdentitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlayerSessionsRequest) ProtoMessage() {}
+func (*ListIdentitiesRequest) ProtoMessage() {}
 
-func (x *ListPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[45]
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3501,58 +5027,48 @@ func (x *ListPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlayerSessionsRequest.ProtoReflect.Descriptor instead.
-func (*ListPlayerSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ListPlayerSessionsRequest) GetPlayerSessionToken() string {
+func (x *ListIdentitiesRequest) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-// PlayerSessionInfo describes one of the caller's PlayerSessions for device-
-// management UX. It never carries the session token — only safe-to-display
-// metadata.
-type PlayerSessionInfo struct {
+// LinkedIdentityInfo describes one linked OpenID Connect identity. The
+// provider's subject identifier is not included.
+type LinkedIdentityInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the PlayerSession's ULID. Safe to show the user — this is a resource
-	// handle, not a secret — and is the value passed as target_session_id to
-	// RevokePlayerSession.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// created_at is when the session was established.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// last_active is sourced from player_sessions.updated_at, bumped whenever the
-	// session is refreshed.
-	LastActive *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_active,json=lastActive,proto3" json:"last_active,omitempty"`
-	// user_agent is the client user-agent recorded at session creation.
-	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// ip_address is the client IP recorded at session creation.
-	IpAddress string `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	// is_current is true for exactly the PlayerSession that made the
-	// ListPlayerSessions request — supports a "this device" indicator.
-	IsCurrent     bool `protobuf:"varint,6,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	// provider is the configured provider name.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// email is the address the provider asserted when the identity was
+	// linked; empty when it asserted none.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// linked_at is when the identity was linked.
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerSessionInfo) Reset() {
-	*x = PlayerSessionInfo{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[46]
+func (x *LinkedIdentityInfo) Reset() {
+	*x = LinkedIdentityInfo{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerSessionInfo) String() string {
+func (x *LinkedIdentityInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSessionInfo) ProtoMessage() {}
+func (*LinkedIdentityInfo) ProtoMessage() {}
 
-func (x *PlayerSessionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[46]
+func (x *LinkedIdentityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3563,78 +5079,60 @@ func (x *PlayerSessionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSessionInfo.ProtoReflect.Descriptor instead.
-func (*PlayerSessionInfo) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *PlayerSessionInfo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PlayerSessionInfo) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *PlayerSessionInfo) GetLastActive() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastActive
-	}
-	return nil
+// Deprecated: Use LinkedIdentityInfo.ProtoReflect.Descriptor instead.
+func (*LinkedIdentityInfo) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *PlayerSessionInfo) GetUserAgent() string {
+func (x *LinkedIdentityInfo) GetProvider() string {
 	if x != nil {
-		return x.UserAgent
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *PlayerSessionInfo) GetIpAddress() string {
+func (x *LinkedIdentityInfo) GetEmail() string {
 	if x != nil {
-		return x.IpAddress
+		return x.Email
 	}
 	return ""
 }
 
-func (x *PlayerSessionInfo) GetIsCurrent() bool {
+func (x *LinkedIdentityInfo) GetLinkedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsCurrent
+		return x.LinkedAt
 	}
-	return false
+	return nil
 }
 
-// ListPlayerSessionsResponse returns the caller's PlayerSessions. An empty list
-// is also the enumeration-safe response on any auth failure.
-type ListPlayerSessionsResponse struct {
+// ListIdentitiesResponse lists the caller's linked identities.
+type ListIdentitiesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// sessions is the caller's active PlayerSessions; never includes tokens.
-	Sessions      []*PlayerSessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// success is true when the list could be read.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// identities is the caller's linked identities, oldest first.
+	Identities []*LinkedIdentityInfo `protobuf:"bytes,2,rep,name=identities,proto3" json:"identities,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListPlayerSessionsResponse) Reset() {
-	*x = ListPlayerSessionsResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[47]
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPlayerSessionsResponse) String() string {
+func (x *ListIdentitiesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPlayerSessionsResponse) ProtoMessage() {}
+func (*ListIdentitiesResponse) ProtoMessage() {}
 
-func (x *ListPlayerSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[47]
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3645,47 +5143,65 @@ func (x *ListPlayerSessionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPlayerSessionsResponse.ProtoReflect.Descriptor instead.
-func (*ListPlayerSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListPlayerSessionsResponse) GetSessions() []*PlayerSessionInfo {
+func (x *ListIdentitiesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Sessions
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*LinkedIdentityInfo {
+	if x != nil {
+		return x.Identities
 	}
 	return nil
 }
 
-// RevokePlayerSessionRequest deletes one of the caller's PlayerSessions.
-type RevokePlayerSessionRequest struct {
+func (x *ListIdentitiesResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ImpersonatePlayerRequest asks to act as another player.
+type ImpersonatePlayerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// player_session_token identifies the caller; only the caller's own sessions
-	// may be revoked.
+	// player_session_token identifies the staff member; it must not itself be
+	// an impersonation session.
 	PlayerSessionToken string `protobuf:"bytes,1,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
-	// target_session_id is the PlayerSession.id (ULID) to revoke — NOT the game
-	// session_id. A revoke targeting another player's session collapses to "session
-	// not found".
-	TargetSessionId string `protobuf:"bytes,2,opt,name=target_session_id,json=targetSessionId,proto3" json:"target_session_id,omitempty"`
+	// target_player_id is the ULID of the player to impersonate.
+	TargetPlayerId string `protobuf:"bytes,2,opt,name=target_player_id,json=targetPlayerId,proto3" json:"target_player_id,omitempty"`
+	// reason is why the staff member needs to act as the player. Required; it
+	// is recorded and shown to the player.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// duration_seconds is how long the session lasts. Zero uses the server
+	// default; the server caps it at one hour.
+	DurationSeconds int64 `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RevokePlayerSessionRequest) Reset() {
-	*x = RevokePlayerSessionRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[48]
+func (x *ImpersonatePlayerRequest) Reset() {
+	*x = ImpersonatePlayerRequest{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokePlayerSessionRequest) String() string {
+func (x *ImpersonatePlayerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokePlayerSessionRequest) ProtoMessage() {}
+func (*ImpersonatePlayerRequest) ProtoMessage() {}
 
-func (x *RevokePlayerSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[48]
+func (x *ImpersonatePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3696,52 +5212,73 @@ func (x *RevokePlayerSessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokePlayerSessionRequest.ProtoReflect.Descriptor instead.
-func (*RevokePlayerSessionRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ImpersonatePlayerRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonatePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RevokePlayerSessionRequest) GetPlayerSessionToken() string {
+func (x *ImpersonatePlayerRequest) GetPlayerSessionToken() string {
 	if x != nil {
 		return x.PlayerSessionToken
 	}
 	return ""
 }
 
-func (x *RevokePlayerSessionRequest) GetTargetSessionId() string {
+func (x *ImpersonatePlayerRequest) GetTargetPlayerId() string {
 	if x != nil {
-		return x.TargetSessionId
+		return x.TargetPlayerId
 	}
 	return ""
 }
 
-// RevokePlayerSessionResponse reports the outcome with an enumeration-safe error.
-type RevokePlayerSessionResponse struct {
+func (x *ImpersonatePlayerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonatePlayerRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// ImpersonatePlayerResponse carries the impersonation session.
+type ImpersonatePlayerResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// success is true when the target session was deleted.
+	// success is true when the impersonation session was opened.
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// error_message is "session not found" on any failure, including cross-player
-	// attempts (enumeration-safe).
-	ErrorMessage  string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// player_session_token is the raw token of the impersonation session.
+	PlayerSessionToken string `protobuf:"bytes,2,opt,name=player_session_token,json=playerSessionToken,proto3" json:"player_session_token,omitempty"`
+	// session_ttl_seconds is how long the session lasts; it is never extended.
+	SessionTtlSeconds int64 `protobuf:"varint,3,opt,name=session_ttl_seconds,json=sessionTtlSeconds,proto3" json:"session_ttl_seconds,omitempty"`
+	// characters lists the impersonated player's characters.
+	Characters []*CharacterSummary `protobuf:"bytes,4,rep,name=characters,proto3" json:"characters,omitempty"`
+	// default_character_id is the impersonated player's default character.
+	DefaultCharacterId string `protobuf:"bytes,5,opt,name=default_character_id,json=defaultCharacterId,proto3" json:"default_character_id,omitempty"`
+	// error_message is a sanitized failure message on failure.
+	ErrorMessage  string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RevokePlayerSessionResponse) Reset() {
-	*x = RevokePlayerSessionResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[49]
+func (x *ImpersonatePlayerResponse) Reset() {
+	*x = ImpersonatePlayerResponse{}
+	mi := &file_holomush_core_v1_core_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RevokePlayerSessionResponse) String() string {
+func (x *ImpersonatePlayerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokePlayerSessionResponse) ProtoMessage() {}
+func (*ImpersonatePlayerResponse) ProtoMessage() {}
 
-func (x *RevokePlayerSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[49]
+func (x *ImpersonatePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_core_v1_core_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3752,19 +5289,47 @@ func (x *RevokePlayerSessionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokePlayerSessionResponse.ProtoReflect.Descriptor instead.
-func (*RevokePlayerSessionResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ImpersonatePlayerResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonatePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *RevokePlayerSessionResponse) GetSuccess() bool {
+func (x *ImpersonatePlayerResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RevokePlayerSessionResponse) GetErrorMessage() string {
+func (x *ImpersonatePlayerResponse) GetPlayerSessionToken() string {
+	if x != nil {
+		return x.PlayerSessionToken
+	}
+	return ""
+}
+
+func (x *ImpersonatePlayerResponse) GetSessionTtlSeconds() int64 {
+	if x != nil {
+		return x.SessionTtlSeconds
+	}
+	return 0
+}
+
+func (x *ImpersonatePlayerResponse) GetCharacters() []*CharacterSummary {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *ImpersonatePlayerResponse) GetDefaultCharacterId() string {
+	if x != nil {
+		return x.DefaultCharacterId
+	}
+	return ""
+}
+
+func (x *ImpersonatePlayerResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
@@ -3783,7 +5348,7 @@ type RevokeOtherPlayerSessionsRequest struct {
 
 func (x *RevokeOtherPlayerSessionsRequest) Reset() {
 	*x = RevokeOtherPlayerSessionsRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[50]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3795,7 +5360,7 @@ func (x *RevokeOtherPlayerSessionsRequest) String() string {
 func (*RevokeOtherPlayerSessionsRequest) ProtoMessage() {}
 
 func (x *RevokeOtherPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[50]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3808,7 +5373,7 @@ func (x *RevokeOtherPlayerSessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeOtherPlayerSessionsRequest.ProtoReflect.Descriptor instead.
 func (*RevokeOtherPlayerSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{50}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RevokeOtherPlayerSessionsRequest) GetPlayerSessionToken() string {
@@ -3832,7 +5397,7 @@ type RevokeOtherPlayerSessionsResponse struct {
 
 func (x *RevokeOtherPlayerSessionsResponse) Reset() {
 	*x = RevokeOtherPlayerSessionsResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[51]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3844,7 +5409,7 @@ func (x *RevokeOtherPlayerSessionsResponse) String() string {
 func (*RevokeOtherPlayerSessionsResponse) ProtoMessage() {}
 
 func (x *RevokeOtherPlayerSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[51]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3857,7 +5422,7 @@ func (x *RevokeOtherPlayerSessionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RevokeOtherPlayerSessionsResponse.ProtoReflect.Descriptor instead.
 func (*RevokeOtherPlayerSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{51}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *RevokeOtherPlayerSessionsResponse) GetSuccess() bool {
@@ -3906,7 +5471,7 @@ type QueryStreamHistoryRequest struct {
 
 func (x *QueryStreamHistoryRequest) Reset() {
 	*x = QueryStreamHistoryRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[52]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3918,7 +5483,7 @@ func (x *QueryStreamHistoryRequest) String() string {
 func (*QueryStreamHistoryRequest) ProtoMessage() {}
 
 func (x *QueryStreamHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[52]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3931,7 +5496,7 @@ func (x *QueryStreamHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryStreamHistoryRequest.ProtoReflect.Descriptor instead.
 func (*QueryStreamHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{52}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *QueryStreamHistoryRequest) GetMeta() *RequestMeta {
@@ -4001,7 +5566,7 @@ type QueryStreamHistoryResponse struct {
 
 func (x *QueryStreamHistoryResponse) Reset() {
 	*x = QueryStreamHistoryResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[53]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +5578,7 @@ func (x *QueryStreamHistoryResponse) String() string {
 func (*QueryStreamHistoryResponse) ProtoMessage() {}
 
 func (x *QueryStreamHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[53]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +5591,7 @@ func (x *QueryStreamHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryStreamHistoryResponse.ProtoReflect.Descriptor instead.
 func (*QueryStreamHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{53}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *QueryStreamHistoryResponse) GetMeta() *ResponseMeta {
@@ -4074,7 +5639,7 @@ type ListSessionStreamsRequest struct {
 
 func (x *ListSessionStreamsRequest) Reset() {
 	*x = ListSessionStreamsRequest{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[54]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4086,7 +5651,7 @@ func (x *ListSessionStreamsRequest) String() string {
 func (*ListSessionStreamsRequest) ProtoMessage() {}
 
 func (x *ListSessionStreamsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[54]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4099,7 +5664,7 @@ func (x *ListSessionStreamsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSessionStreamsRequest.ProtoReflect.Descriptor instead.
 func (*ListSessionStreamsRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{54}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ListSessionStreamsRequest) GetMeta() *RequestMeta {
@@ -4140,7 +5705,7 @@ type ListSessionStreamsResponse struct {
 
 func (x *ListSessionStreamsResponse) Reset() {
 	*x = ListSessionStreamsResponse{}
-	mi := &file_holomush_core_v1_core_proto_msgTypes[55]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4152,7 +5717,7 @@ func (x *ListSessionStreamsResponse) String() string {
 func (*ListSessionStreamsResponse) ProtoMessage() {}
 
 func (x *ListSessionStreamsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_core_v1_core_proto_msgTypes[55]
+	mi := &file_holomush_core_v1_core_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4165,7 +5730,7 @@ func (x *ListSessionStreamsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSessionStreamsResponse.ProtoReflect.Descriptor instead.
 func (*ListSessionStreamsResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{55}
+	return file_holomush_core_v1_core_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *ListSessionStreamsResponse) GetStreams() []string {
@@ -4298,7 +5863,25 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\rconnection_id\x18\x03 \x01(\tR\fconnectionId\x120\n" +
 	"\x14player_session_token\x18\x04 \x01(\tR\x12playerSessionToken\"O\n" +
 	"\x19RefreshConnectionResponse\x122\n" +
-	"\x04meta\x18\x01 \x01(\v2\x1e.holomush.core.v1.ResponseMetaR\x04meta\"\x9e\x01\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.holomush.core.v1.ResponseMetaR\x04meta\"\xa9\x02\n" +
+	"\x17ReportInputFloodRequest\x121\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1d.holomush.core.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12#\n" +
+	"\rconnection_id\x18\x03 \x01(\tR\fconnectionId\x120\n" +
+	"\x14player_session_token\x18\x04 \x01(\tR\x12playerSessionToken\x12:\n" +
+	"\x06action\x18\x05 \x01(\x0e2\".holomush.core.v1.InputFloodActionR\x06action\x12)\n" +
+	"\x10dropped_commands\x18\x06 \x01(\x05R\x0fdroppedCommands\"N\n" +
+	"\x18ReportInputFloodResponse\x122\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.holomush.core.v1.ResponseMetaR\x04meta\"j\n" +
+	"\x16CheckAddressBanRequest\x121\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1d.holomush.core.v1.RequestMetaR\x04meta\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\"}\n" +
+	"\x17CheckAddressBanResponse\x122\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.holomush.core.v1.ResponseMetaR\x04meta\x12\x16\n" +
+	"\x06banned\x18\x02 \x01(\bR\x06banned\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9e\x01\n" +
 	"\x18GetCommandHistoryRequest\x121\n" +
 	"\x04meta\x18\x01 \x01(\v2\x1d.holomush.core.v1.RequestMetaR\x04meta\x12\x1d\n" +
 	"\n" +
@@ -4330,12 +5913,52 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"characters\x18\x04 \x03(\v2\".holomush.core.v1.CharacterSummaryR\n" +
 	"characters\x120\n" +
 	"\x14default_character_id\x18\x05 \x01(\tR\x12defaultCharacterId\x12.\n" +
-	"\x13session_ttl_seconds\x18\x06 \x01(\x03R\x11sessionTtlSeconds\"\x8e\x01\n" +
+	"\x13session_ttl_seconds\x18\x06 \x01(\x03R\x11sessionTtlSeconds\"\x99\x01\n" +
+	"\x1dAuthenticateWebSessionRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12!\n" +
+	"\fdevice_label\x18\x04 \x01(\tR\vdeviceLabel\"\xfb\x02\n" +
+	"\x1eAuthenticateWebSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12,\n" +
+	"\x12access_ttl_seconds\x18\x03 \x01(\x03R\x10accessTtlSeconds\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12.\n" +
+	"\x13refresh_ttl_seconds\x18\x05 \x01(\x03R\x11refreshTtlSeconds\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\x12B\n" +
+	"\n" +
+	"characters\x18\a \x03(\v2\".holomush.core.v1.CharacterSummaryR\n" +
+	"characters\x120\n" +
+	"\x14default_character_id\x18\b \x01(\tR\x12defaultCharacterId\"^\n" +
+	"\x18RefreshWebSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\"\x80\x02\n" +
+	"\x19RefreshWebSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12,\n" +
+	"\x12access_ttl_seconds\x18\x03 \x01(\x03R\x10accessTtlSeconds\x12#\n" +
+	"\rrefresh_token\x18\x04 \x01(\tR\frefreshToken\x12.\n" +
+	"\x13refresh_ttl_seconds\x18\x05 \x01(\x03R\x11refreshTtlSeconds\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"8\n" +
+	"\x1bAuthenticateWithOIDCRequest\x12\x19\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\"\xb5\x02\n" +
+	"\x1cAuthenticateWithOIDCResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\x14player_session_token\x18\x02 \x01(\tR\x12playerSessionToken\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12B\n" +
+	"\n" +
+	"characters\x18\x04 \x03(\v2\".holomush.core.v1.CharacterSummaryR\n" +
+	"characters\x120\n" +
+	"\x14default_character_id\x18\x05 \x01(\tR\x12defaultCharacterId\x12.\n" +
+	"\x13session_ttl_seconds\x18\x06 \x01(\x03R\x11sessionTtlSeconds\"\xc2\x01\n" +
 	"\x16SelectCharacterRequest\x120\n" +
 	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\x12!\n" +
 	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x1f\n" +
 	"\vclient_type\x18\x03 \x01(\tR\n" +
-	"clientType\"\xbe\x01\n" +
+	"clientType\x122\n" +
+	"\x15issue_reconnect_token\x18\x04 \x01(\bR\x13issueReconnectToken\"\xe7\x01\n" +
 	"\x17SelectCharacterResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
 	"\n" +
@@ -4344,7 +5967,20 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\n" +
 	"reattached\x18\x04 \x01(\bR\n" +
 	"reattached\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x88\x01\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12'\n" +
+	"\x0freconnect_token\x18\x06 \x01(\tR\x0ereconnectToken\"r\n" +
+	"\x14ResumeSessionRequest\x121\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1d.holomush.core.v1.RequestMetaR\x04meta\x12'\n" +
+	"\x0freconnect_token\x18\x02 \x01(\tR\x0ereconnectToken\"\xab\x02\n" +
+	"\x15ResumeSessionResponse\x122\n" +
+	"\x04meta\x18\x01 \x01(\v2\x1e.holomush.core.v1.ResponseMetaR\x04meta\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12%\n" +
+	"\x0echaracter_name\x18\x05 \x01(\tR\rcharacterName\x120\n" +
+	"\x14player_session_token\x18\x06 \x01(\tR\x12playerSessionToken\x12'\n" +
+	"\x0freconnect_token\x18\a \x01(\tR\x0ereconnectToken\"\x88\x01\n" +
 	"\x13CreatePlayerRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
@@ -4416,7 +6052,7 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"characters\x18\x04 \x03(\v2\".holomush.core.v1.CharacterSummaryR\n" +
 	"characters\"M\n" +
 	"\x19ListPlayerSessionsRequest\x120\n" +
-	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\"\xf8\x01\n" +
+	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\"\x9b\x02\n" +
 	"\x11PlayerSessionInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
 	"\n" +
@@ -4428,7 +6064,8 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\n" +
 	"ip_address\x18\x05 \x01(\tR\tipAddress\x12\x1d\n" +
 	"\n" +
-	"is_current\x18\x06 \x01(\bR\tisCurrent\"]\n" +
+	"is_current\x18\x06 \x01(\bR\tisCurrent\x12!\n" +
+	"\fdevice_label\x18\a \x01(\tR\vdeviceLabel\"]\n" +
 	"\x1aListPlayerSessionsResponse\x12?\n" +
 	"\bsessions\x18\x01 \x03(\v2#.holomush.core.v1.PlayerSessionInfoR\bsessions\"z\n" +
 	"\x1aRevokePlayerSessionRequest\x120\n" +
@@ -4436,7 +6073,47 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\x11target_session_id\x18\x02 \x01(\tR\x0ftargetSessionId\"\\\n" +
 	"\x1bRevokePlayerSessionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"T\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"~\n" +
+	"\x13LinkIdentityRequest\x120\n" +
+	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\x12\x19\n" +
+	"\bid_token\x18\x02 \x01(\tR\aidToken\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"q\n" +
+	"\x14LinkIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"e\n" +
+	"\x15UnlinkIdentityRequest\x120\n" +
+	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\"W\n" +
+	"\x16UnlinkIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"I\n" +
+	"\x15ListIdentitiesRequest\x120\n" +
+	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\"\x7f\n" +
+	"\x12LinkedIdentityInfo\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x127\n" +
+	"\tlinked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\"\x9d\x01\n" +
+	"\x16ListIdentitiesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12D\n" +
+	"\n" +
+	"identities\x18\x02 \x03(\v2$.holomush.core.v1.LinkedIdentityInfoR\n" +
+	"identities\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"\xb9\x01\n" +
+	"\x18ImpersonatePlayerRequest\x120\n" +
+	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\x12(\n" +
+	"\x10target_player_id\x18\x02 \x01(\tR\x0etargetPlayerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\"\xb2\x02\n" +
+	"\x19ImpersonatePlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x120\n" +
+	"\x14player_session_token\x18\x02 \x01(\tR\x12playerSessionToken\x12.\n" +
+	"\x13session_ttl_seconds\x18\x03 \x01(\x03R\x11sessionTtlSeconds\x12B\n" +
+	"\n" +
+	"characters\x18\x04 \x03(\v2\".holomush.core.v1.CharacterSummaryR\n" +
+	"characters\x120\n" +
+	"\x14default_character_id\x18\x05 \x01(\tR\x12defaultCharacterId\x12#\n" +
+	"\rerror_message\x18\x06 \x01(\tR\ferrorMessage\"T\n" +
 	" RevokeOtherPlayerSessionsRequest\x120\n" +
 	"\x14player_session_token\x18\x01 \x01(\tR\x12playerSessionToken\"b\n" +
 	"!RevokeOtherPlayerSessionsResponse\x12\x18\n" +
@@ -4494,15 +6171,24 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\x1aCONTROL_SIGNAL_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eCONTROL_SIGNAL_REPLAY_COMPLETE\x10\x01\x12 \n" +
 	"\x1cCONTROL_SIGNAL_STREAM_CLOSED\x10\x02\x12!\n" +
-	"\x1dCONTROL_SIGNAL_SCENE_ACTIVITY\x10\x032\xad\x13\n" +
+	"\x1dCONTROL_SIGNAL_SCENE_ACTIVITY\x10\x03*\x97\x01\n" +
+	"\x10InputFloodAction\x12\"\n" +
+	"\x1eINPUT_FLOOD_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17INPUT_FLOOD_ACTION_WARN\x10\x01\x12\x1f\n" +
+	"\x1bINPUT_FLOOD_ACTION_THROTTLE\x10\x02\x12!\n" +
+	"\x1dINPUT_FLOOD_ACTION_DISCONNECT\x10\x032\xdb\x1b\n" +
 	"\vCoreService\x12`\n" +
 	"\rHandleCommand\x12&.holomush.core.v1.HandleCommandRequest\x1a'.holomush.core.v1.HandleCommandResponse\x12V\n" +
 	"\tSubscribe\x12\".holomush.core.v1.SubscribeRequest\x1a#.holomush.core.v1.SubscribeResponse0\x01\x12W\n" +
 	"\n" +
 	"Disconnect\x12#.holomush.core.v1.DisconnectRequest\x1a$.holomush.core.v1.DisconnectResponse\x12l\n" +
 	"\x11GetCommandHistory\x12*.holomush.core.v1.GetCommandHistoryRequest\x1a+.holomush.core.v1.GetCommandHistoryResponse\x12o\n" +
-	"\x12AuthenticatePlayer\x12+.holomush.core.v1.AuthenticatePlayerRequest\x1a,.holomush.core.v1.AuthenticatePlayerResponse\x12f\n" +
-	"\x0fSelectCharacter\x12(.holomush.core.v1.SelectCharacterRequest\x1a).holomush.core.v1.SelectCharacterResponse\x12]\n" +
+	"\x12AuthenticatePlayer\x12+.holomush.core.v1.AuthenticatePlayerRequest\x1a,.holomush.core.v1.AuthenticatePlayerResponse\x12u\n" +
+	"\x14AuthenticateWithOIDC\x12-.holomush.core.v1.AuthenticateWithOIDCRequest\x1a..holomush.core.v1.AuthenticateWithOIDCResponse\x12{\n" +
+	"\x16AuthenticateWebSession\x12/.holomush.core.v1.AuthenticateWebSessionRequest\x1a0.holomush.core.v1.AuthenticateWebSessionResponse\x12l\n" +
+	"\x11RefreshWebSession\x12*.holomush.core.v1.RefreshWebSessionRequest\x1a+.holomush.core.v1.RefreshWebSessionResponse\x12f\n" +
+	"\x0fSelectCharacter\x12(.holomush.core.v1.SelectCharacterRequest\x1a).holomush.core.v1.SelectCharacterResponse\x12`\n" +
+	"\rResumeSession\x12&.holomush.core.v1.ResumeSessionRequest\x1a'.holomush.core.v1.ResumeSessionResponse\x12]\n" +
 	"\fCreatePlayer\x12%.holomush.core.v1.CreatePlayerRequest\x1a&.holomush.core.v1.CreatePlayerResponse\x12Z\n" +
 	"\vCreateGuest\x12$.holomush.core.v1.CreateGuestRequest\x1a%.holomush.core.v1.CreateGuestResponse\x12f\n" +
 	"\x0fCreateCharacter\x12(.holomush.core.v1.CreateCharacterRequest\x1a).holomush.core.v1.CreateCharacterResponse\x12c\n" +
@@ -4514,12 +6200,18 @@ const file_holomush_core_v1_core_proto_rawDesc = "" +
 	"\x12CheckPlayerSession\x12+.holomush.core.v1.CheckPlayerSessionRequest\x1a,.holomush.core.v1.CheckPlayerSessionResponse\x12o\n" +
 	"\x12ListPlayerSessions\x12+.holomush.core.v1.ListPlayerSessionsRequest\x1a,.holomush.core.v1.ListPlayerSessionsResponse\x12r\n" +
 	"\x13RevokePlayerSession\x12,.holomush.core.v1.RevokePlayerSessionRequest\x1a-.holomush.core.v1.RevokePlayerSessionResponse\x12\x84\x01\n" +
-	"\x19RevokeOtherPlayerSessions\x122.holomush.core.v1.RevokeOtherPlayerSessionsRequest\x1a3.holomush.core.v1.RevokeOtherPlayerSessionsResponse\x12o\n" +
+	"\x19RevokeOtherPlayerSessions\x122.holomush.core.v1.RevokeOtherPlayerSessionsRequest\x1a3.holomush.core.v1.RevokeOtherPlayerSessionsResponse\x12]\n" +
+	"\fLinkIdentity\x12%.holomush.core.v1.LinkIdentityRequest\x1a&.holomush.core.v1.LinkIdentityResponse\x12c\n" +
+	"\x0eUnlinkIdentity\x12'.holomush.core.v1.UnlinkIdentityRequest\x1a(.holomush.core.v1.UnlinkIdentityResponse\x12c\n" +
+	"\x0eListIdentities\x12'.holomush.core.v1.ListIdentitiesRequest\x1a(.holomush.core.v1.ListIdentitiesResponse\x12l\n" +
+	"\x11ImpersonatePlayer\x12*.holomush.core.v1.ImpersonatePlayerRequest\x1a+.holomush.core.v1.ImpersonatePlayerResponse\x12o\n" +
 	"\x12QueryStreamHistory\x12+.holomush.core.v1.QueryStreamHistoryRequest\x1a,.holomush.core.v1.QueryStreamHistoryResponse\x12o\n" +
 	"\x12ListSessionStreams\x12+.holomush.core.v1.ListSessionStreamsRequest\x1a,.holomush.core.v1.ListSessionStreamsResponse\x12l\n" +
 	"\x11ListFocusPresence\x12*.holomush.core.v1.ListFocusPresenceRequest\x1a+.holomush.core.v1.ListFocusPresenceResponse\x12x\n" +
 	"\x15ListAvailableCommands\x12..holomush.core.v1.ListAvailableCommandsRequest\x1a/.holomush.core.v1.ListAvailableCommandsResponse\x12l\n" +
-	"\x11RefreshConnection\x12*.holomush.core.v1.RefreshConnectionRequest\x1a+.holomush.core.v1.RefreshConnectionResponseB\xc3\x01\n" +
+	"\x11RefreshConnection\x12*.holomush.core.v1.RefreshConnectionRequest\x1a+.holomush.core.v1.RefreshConnectionResponse\x12i\n" +
+	"\x10ReportInputFlood\x12).holomush.core.v1.ReportInputFloodRequest\x1a*.holomush.core.v1.ReportInputFloodResponse\x12f\n" +
+	"\x0fCheckAddressBan\x12(.holomush.core.v1.CheckAddressBanRequest\x1a).holomush.core.v1.CheckAddressBanResponseB\xc3\x01\n" +
 	"\x14com.holomush.core.v1B\tCoreProtoP\x01Z>github.com/holomush/holomush/pkg/proto/holomush/core/v1;corev1\xa2\x02\x03HCX\xaa\x02\x10Holomush.Core.V1\xca\x02\x10Holomush\\Core\\V1\xe2\x02\x1cHolomush\\Core\\V1\\GPBMetadata\xea\x02\x12Holomush::Core::V1b\x06proto3"
 
 var (
@@ -4534,166 +6226,220 @@ func file_holomush_core_v1_core_proto_rawDescGZIP() []byte {
 	return file_holomush_core_v1_core_proto_rawDescData
 }
 
-var file_holomush_core_v1_core_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_holomush_core_v1_core_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
+var file_holomush_core_v1_core_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_holomush_core_v1_core_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
 var file_holomush_core_v1_core_proto_goTypes = []any{
 	(NoPlaintextReason)(0),                    // 0: holomush.core.v1.NoPlaintextReason
 	(EventChannel)(0),                         // 1: holomush.core.v1.EventChannel
 	(PresenceContext)(0),                      // 2: holomush.core.v1.PresenceContext
 	(PresenceState)(0),                        // 3: holomush.core.v1.PresenceState
 	(ControlSignal)(0),                        // 4: holomush.core.v1.ControlSignal
-	(*RequestMeta)(nil),                       // 5: holomush.core.v1.RequestMeta
-	(*ResponseMeta)(nil),                      // 6: holomush.core.v1.ResponseMeta
-	(*HandleCommandRequest)(nil),              // 7: holomush.core.v1.HandleCommandRequest
-	(*HandleCommandResponse)(nil),             // 8: holomush.core.v1.HandleCommandResponse
-	(*SubscribeRequest)(nil),                  // 9: holomush.core.v1.SubscribeRequest
-	(*EventFrame)(nil),                        // 10: holomush.core.v1.EventFrame
-	(*PresenceEntry)(nil),                     // 11: holomush.core.v1.PresenceEntry
-	(*ListFocusPresenceRequest)(nil),          // 12: holomush.core.v1.ListFocusPresenceRequest
-	(*ListFocusPresenceResponse)(nil),         // 13: holomush.core.v1.ListFocusPresenceResponse
-	(*AvailableCommand)(nil),                  // 14: holomush.core.v1.AvailableCommand
-	(*ListAvailableCommandsRequest)(nil),      // 15: holomush.core.v1.ListAvailableCommandsRequest
-	(*ListAvailableCommandsResponse)(nil),     // 16: holomush.core.v1.ListAvailableCommandsResponse
-	(*RenderingMetadata)(nil),                 // 17: holomush.core.v1.RenderingMetadata
-	(*ControlFrame)(nil),                      // 18: holomush.core.v1.ControlFrame
-	(*SubscribeResponse)(nil),                 // 19: holomush.core.v1.SubscribeResponse
-	(*DisconnectRequest)(nil),                 // 20: holomush.core.v1.DisconnectRequest
-	(*DisconnectResponse)(nil),                // 21: holomush.core.v1.DisconnectResponse
-	(*RefreshConnectionRequest)(nil),          // 22: holomush.core.v1.RefreshConnectionRequest
-	(*RefreshConnectionResponse)(nil),         // 23: holomush.core.v1.RefreshConnectionResponse
-	(*GetCommandHistoryRequest)(nil),          // 24: holomush.core.v1.GetCommandHistoryRequest
-	(*GetCommandHistoryResponse)(nil),         // 25: holomush.core.v1.GetCommandHistoryResponse
-	(*CharacterSummary)(nil),                  // 26: holomush.core.v1.CharacterSummary
-	(*AuthenticatePlayerRequest)(nil),         // 27: holomush.core.v1.AuthenticatePlayerRequest
-	(*AuthenticatePlayerResponse)(nil),        // 28: holomush.core.v1.AuthenticatePlayerResponse
-	(*SelectCharacterRequest)(nil),            // 29: holomush.core.v1.SelectCharacterRequest
-	(*SelectCharacterResponse)(nil),           // 30: holomush.core.v1.SelectCharacterResponse
-	(*CreatePlayerRequest)(nil),               // 31: holomush.core.v1.CreatePlayerRequest
-	(*CreatePlayerResponse)(nil),              // 32: holomush.core.v1.CreatePlayerResponse
-	(*CreateGuestRequest)(nil),                // 33: holomush.core.v1.CreateGuestRequest
-	(*CreateGuestResponse)(nil),               // 34: holomush.core.v1.CreateGuestResponse
-	(*CreateCharacterRequest)(nil),            // 35: holomush.core.v1.CreateCharacterRequest
-	(*CreateCharacterResponse)(nil),           // 36: holomush.core.v1.CreateCharacterResponse
-	(*ListCharactersRequest)(nil),             // 37: holomush.core.v1.ListCharactersRequest
-	(*ListCharactersResponse)(nil),            // 38: holomush.core.v1.ListCharactersResponse
-	(*ListAllCharactersRequest)(nil),          // 39: holomush.core.v1.ListAllCharactersRequest
-	(*CharacterDirectoryEntry)(nil),           // 40: holomush.core.v1.CharacterDirectoryEntry
-	(*ListAllCharactersResponse)(nil),         // 41: holomush.core.v1.ListAllCharactersResponse
-	(*RequestPasswordResetRequest)(nil),       // 42: holomush.core.v1.RequestPasswordResetRequest
-	(*RequestPasswordResetResponse)(nil),      // 43: holomush.core.v1.RequestPasswordResetResponse
-	(*ConfirmPasswordResetRequest)(nil),       // 44: holomush.core.v1.ConfirmPasswordResetRequest
-	(*ConfirmPasswordResetResponse)(nil),      // 45: holomush.core.v1.ConfirmPasswordResetResponse
-	(*LogoutRequest)(nil),                     // 46: holomush.core.v1.LogoutRequest
-	(*LogoutResponse)(nil),                    // 47: holomush.core.v1.LogoutResponse
-	(*CheckPlayerSessionRequest)(nil),         // 48: holomush.core.v1.CheckPlayerSessionRequest
-	(*CheckPlayerSessionResponse)(nil),        // 49: holomush.core.v1.CheckPlayerSessionResponse
-	(*ListPlayerSessionsRequest)(nil),         // 50: holomush.core.v1.ListPlayerSessionsRequest
-	(*PlayerSessionInfo)(nil),                 // 51: holomush.core.v1.PlayerSessionInfo
-	(*ListPlayerSessionsResponse)(nil),        // 52: holomush.core.v1.ListPlayerSessionsResponse
-	(*RevokePlayerSessionRequest)(nil),        // 53: holomush.core.v1.RevokePlayerSessionRequest
-	(*RevokePlayerSessionResponse)(nil),       // 54: holomush.core.v1.RevokePlayerSessionResponse
-	(*RevokeOtherPlayerSessionsRequest)(nil),  // 55: holomush.core.v1.RevokeOtherPlayerSessionsRequest
-	(*RevokeOtherPlayerSessionsResponse)(nil), // 56: holomush.core.v1.RevokeOtherPlayerSessionsResponse
-	(*QueryStreamHistoryRequest)(nil),         // 57: holomush.core.v1.QueryStreamHistoryRequest
-	(*QueryStreamHistoryResponse)(nil),        // 58: holomush.core.v1.QueryStreamHistoryResponse
-	(*ListSessionStreamsRequest)(nil),         // 59: holomush.core.v1.ListSessionStreamsRequest
-	(*ListSessionStreamsResponse)(nil),        // 60: holomush.core.v1.ListSessionStreamsResponse
-	nil,                                       // 61: holomush.core.v1.ListAvailableCommandsResponse.AliasesEntry
-	(*timestamppb.Timestamp)(nil),             // 62: google.protobuf.Timestamp
+	(InputFloodAction)(0),                     // 5: holomush.core.v1.InputFloodAction
+	(*RequestMeta)(nil),                       // 6: holomush.core.v1.RequestMeta
+	(*ResponseMeta)(nil),                      // 7: holomush.core.v1.ResponseMeta
+	(*HandleCommandRequest)(nil),              // 8: holomush.core.v1.HandleCommandRequest
+	(*HandleCommandResponse)(nil),             // 9: holomush.core.v1.HandleCommandResponse
+	(*SubscribeRequest)(nil),                  // 10: holomush.core.v1.SubscribeRequest
+	(*EventFrame)(nil),                        // 11: holomush.core.v1.EventFrame
+	(*PresenceEntry)(nil),                     // 12: holomush.core.v1.PresenceEntry
+	(*ListFocusPresenceRequest)(nil),          // 13: holomush.core.v1.ListFocusPresenceRequest
+	(*ListFocusPresenceResponse)(nil),         // 14: holomush.core.v1.ListFocusPresenceResponse
+	(*AvailableCommand)(nil),                  // 15: holomush.core.v1.AvailableCommand
+	(*ListAvailableCommandsRequest)(nil),      // 16: holomush.core.v1.ListAvailableCommandsRequest
+	(*ListAvailableCommandsResponse)(nil),     // 17: holomush.core.v1.ListAvailableCommandsResponse
+	(*RenderingMetadata)(nil),                 // 18: holomush.core.v1.RenderingMetadata
+	(*ControlFrame)(nil),                      // 19: holomush.core.v1.ControlFrame
+	(*SubscribeResponse)(nil),                 // 20: holomush.core.v1.SubscribeResponse
+	(*DisconnectRequest)(nil),                 // 21: holomush.core.v1.DisconnectRequest
+	(*DisconnectResponse)(nil),                // 22: holomush.core.v1.DisconnectResponse
+	(*RefreshConnectionRequest)(nil),          // 23: holomush.core.v1.RefreshConnectionRequest
+	(*RefreshConnectionResponse)(nil),         // 24: holomush.core.v1.RefreshConnectionResponse
+	(*ReportInputFloodRequest)(nil),           // 25: holomush.core.v1.ReportInputFloodRequest
+	(*ReportInputFloodResponse)(nil),          // 26: holomush.core.v1.ReportInputFloodResponse
+	(*CheckAddressBanRequest)(nil),            // 27: holomush.core.v1.CheckAddressBanRequest
+	(*CheckAddressBanResponse)(nil),           // 28: holomush.core.v1.CheckAddressBanResponse
+	(*GetCommandHistoryRequest)(nil),          // 29: holomush.core.v1.GetCommandHistoryRequest
+	(*GetCommandHistoryResponse)(nil),         // 30: holomush.core.v1.GetCommandHistoryResponse
+	(*CharacterSummary)(nil),                  // 31: holomush.core.v1.CharacterSummary
+	(*AuthenticatePlayerRequest)(nil),         // 32: holomush.core.v1.AuthenticatePlayerRequest
+	(*AuthenticatePlayerResponse)(nil),        // 33: holomush.core.v1.AuthenticatePlayerResponse
+	(*AuthenticateWebSessionRequest)(nil),     // 34: holomush.core.v1.AuthenticateWebSessionRequest
+	(*AuthenticateWebSessionResponse)(nil),    // 35: holomush.core.v1.AuthenticateWebSessionResponse
+	(*RefreshWebSessionRequest)(nil),          // 36: holomush.core.v1.RefreshWebSessionRequest
+	(*RefreshWebSessionResponse)(nil),         // 37: holomush.core.v1.RefreshWebSessionResponse
+	(*AuthenticateWithOIDCRequest)(nil),       // 38: holomush.core.v1.AuthenticateWithOIDCRequest
+	(*AuthenticateWithOIDCResponse)(nil),      // 39: holomush.core.v1.AuthenticateWithOIDCResponse
+	(*SelectCharacterRequest)(nil),            // 40: holomush.core.v1.SelectCharacterRequest
+	(*SelectCharacterResponse)(nil),           // 41: holomush.core.v1.SelectCharacterResponse
+	(*ResumeSessionRequest)(nil),              // 42: holomush.core.v1.ResumeSessionRequest
+	(*ResumeSessionResponse)(nil),             // 43: holomush.core.v1.ResumeSessionResponse
+	(*CreatePlayerRequest)(nil),               // 44: holomush.core.v1.CreatePlayerRequest
+	(*CreatePlayerResponse)(nil),              // 45: holomush.core.v1.CreatePlayerResponse
+	(*CreateGuestRequest)(nil),                // 46: holomush.core.v1.CreateGuestRequest
+	(*CreateGuestResponse)(nil),               // 47: holomush.core.v1.CreateGuestResponse
+	(*CreateCharacterRequest)(nil),            // 48: holomush.core.v1.CreateCharacterRequest
+	(*CreateCharacterResponse)(nil),           // 49: holomush.core.v1.CreateCharacterResponse
+	(*ListCharactersRequest)(nil),             // 50: holomush.core.v1.ListCharactersRequest
+	(*ListCharactersResponse)(nil),            // 51: holomush.core.v1.ListCharactersResponse
+	(*ListAllCharactersRequest)(nil),          // 52: holomush.core.v1.ListAllCharactersRequest
+	(*CharacterDirectoryEntry)(nil),           // 53: holomush.core.v1.CharacterDirectoryEntry
+	(*ListAllCharactersResponse)(nil),         // 54: holomush.core.v1.ListAllCharactersResponse
+	(*RequestPasswordResetRequest)(nil),       // 55: holomush.core.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),      // 56: holomush.core.v1.RequestPasswordResetResponse
+	(*ConfirmPasswordResetRequest)(nil),       // 57: holomush.core.v1.ConfirmPasswordResetRequest
+	(*ConfirmPasswordResetResponse)(nil),      // 58: holomush.core.v1.ConfirmPasswordResetResponse
+	(*LogoutRequest)(nil),                     // 59: holomush.core.v1.LogoutRequest
+	(*LogoutResponse)(nil),                    // 60: holomush.core.v1.LogoutResponse
+	(*CheckPlayerSessionRequest)(nil),         // 61: holomush.core.v1.CheckPlayerSessionRequest
+	(*CheckPlayerSessionResponse)(nil),        // 62: holomush.core.v1.CheckPlayerSessionResponse
+	(*ListPlayerSessionsRequest)(nil),         // 63: holomush.core.v1.ListPlayerSessionsRequest
+	(*PlayerSessionInfo)(nil),                 // 64: holomush.core.v1.PlayerSessionInfo
+	(*ListPlayerSessionsResponse)(nil),        // 65: holomush.core.v1.ListPlayerSessionsResponse
+	(*RevokePlayerSessionRequest)(nil),        // 66: holomush.core.v1.RevokePlayerSessionRequest
+	(*RevokePlayerSessionResponse)(nil),       // 67: holomush.core.v1.RevokePlayerSessionResponse
+	(*LinkIdentityRequest)(nil),               // 68: holomush.core.v1.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),              // 69: holomush.core.v1.LinkIdentityResponse
+	(*UnlinkIdentityRequest)(nil),             // 70: holomush.core.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),            // 71: holomush.core.v1.UnlinkIdentityResponse
+	(*ListIdentitiesRequest)(nil),             // 72: holomush.core.v1.ListIdentitiesRequest
+	(*LinkedIdentityInfo)(nil),                // 73: holomush.core.v1.LinkedIdentityInfo
+	(*ListIdentitiesResponse)(nil),            // 74: holomush.core.v1.ListIdentitiesResponse
+	(*ImpersonatePlayerRequest)(nil),          // 75: holomush.core.v1.ImpersonatePlayerRequest
+	(*ImpersonatePlayerResponse)(nil),         // 76: holomush.core.v1.ImpersonatePlayerResponse
+	(*RevokeOtherPlayerSessionsRequest)(nil),  // 77: holomush.core.v1.RevokeOtherPlayerSessionsRequest
+	(*RevokeOtherPlayerSessionsResponse)(nil), // 78: holomush.core.v1.RevokeOtherPlayerSessionsResponse
+	(*QueryStreamHistoryRequest)(nil),         // 79: holomush.core.v1.QueryStreamHistoryRequest
+	(*QueryStreamHistoryResponse)(nil),        // 80: holomush.core.v1.QueryStreamHistoryResponse
+	(*ListSessionStreamsRequest)(nil),         // 81: holomush.core.v1.ListSessionStreamsRequest
+	(*ListSessionStreamsResponse)(nil),        // 82: holomush.core.v1.ListSessionStreamsResponse
+	nil,                                       // 83: holomush.core.v1.ListAvailableCommandsResponse.AliasesEntry
+	(*timestamppb.Timestamp)(nil),             // 84: google.protobuf.Timestamp
 }
 var file_holomush_core_v1_core_proto_depIdxs = []int32{
-	62, // 0: holomush.core.v1.RequestMeta.timestamp:type_name -> google.protobuf.Timestamp
-	62, // 1: holomush.core.v1.ResponseMeta.timestamp:type_name -> google.protobuf.Timestamp
-	5,  // 2: holomush.core.v1.HandleCommandRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 3: holomush.core.v1.HandleCommandResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	5,  // 4: holomush.core.v1.SubscribeRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	62, // 5: holomush.core.v1.EventFrame.timestamp:type_name -> google.protobuf.Timestamp
-	17, // 6: holomush.core.v1.EventFrame.rendering:type_name -> holomush.core.v1.RenderingMetadata
+	84, // 0: holomush.core.v1.RequestMeta.timestamp:type_name -> google.protobuf.Timestamp
+	84, // 1: holomush.core.v1.ResponseMeta.timestamp:type_name -> google.protobuf.Timestamp
+	6,  // 2: holomush.core.v1.HandleCommandRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 3: holomush.core.v1.HandleCommandResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 4: holomush.core.v1.SubscribeRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	84, // 5: holomush.core.v1.EventFrame.timestamp:type_name -> google.protobuf.Timestamp
+	18, // 6: holomush.core.v1.EventFrame.rendering:type_name -> holomush.core.v1.RenderingMetadata
 	0,  // 7: holomush.core.v1.EventFrame.no_plaintext_reason:type_name -> holomush.core.v1.NoPlaintextReason
 	3,  // 8: holomush.core.v1.PresenceEntry.state:type_name -> holomush.core.v1.PresenceState
-	5,  // 9: holomush.core.v1.ListFocusPresenceRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 10: holomush.core.v1.ListFocusPresenceResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 9: holomush.core.v1.ListFocusPresenceRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 10: holomush.core.v1.ListFocusPresenceResponse.meta:type_name -> holomush.core.v1.ResponseMeta
 	2,  // 11: holomush.core.v1.ListFocusPresenceResponse.context:type_name -> holomush.core.v1.PresenceContext
-	11, // 12: holomush.core.v1.ListFocusPresenceResponse.entries:type_name -> holomush.core.v1.PresenceEntry
-	5,  // 13: holomush.core.v1.ListAvailableCommandsRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 14: holomush.core.v1.ListAvailableCommandsResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	14, // 15: holomush.core.v1.ListAvailableCommandsResponse.commands:type_name -> holomush.core.v1.AvailableCommand
-	61, // 16: holomush.core.v1.ListAvailableCommandsResponse.aliases:type_name -> holomush.core.v1.ListAvailableCommandsResponse.AliasesEntry
+	12, // 12: holomush.core.v1.ListFocusPresenceResponse.entries:type_name -> holomush.core.v1.PresenceEntry
+	6,  // 13: holomush.core.v1.ListAvailableCommandsRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 14: holomush.core.v1.ListAvailableCommandsResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	15, // 15: holomush.core.v1.ListAvailableCommandsResponse.commands:type_name -> holomush.core.v1.AvailableCommand
+	83, // 16: holomush.core.v1.ListAvailableCommandsResponse.aliases:type_name -> holomush.core.v1.ListAvailableCommandsResponse.AliasesEntry
 	1,  // 17: holomush.core.v1.RenderingMetadata.display_target:type_name -> holomush.core.v1.EventChannel
 	4,  // 18: holomush.core.v1.ControlFrame.signal:type_name -> holomush.core.v1.ControlSignal
-	10, // 19: holomush.core.v1.SubscribeResponse.event:type_name -> holomush.core.v1.EventFrame
-	18, // 20: holomush.core.v1.SubscribeResponse.control:type_name -> holomush.core.v1.ControlFrame
-	5,  // 21: holomush.core.v1.DisconnectRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 22: holomush.core.v1.DisconnectResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	5,  // 23: holomush.core.v1.RefreshConnectionRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 24: holomush.core.v1.RefreshConnectionResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	5,  // 25: holomush.core.v1.GetCommandHistoryRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 26: holomush.core.v1.GetCommandHistoryResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	26, // 27: holomush.core.v1.AuthenticatePlayerResponse.characters:type_name -> holomush.core.v1.CharacterSummary
-	26, // 28: holomush.core.v1.CreatePlayerResponse.characters:type_name -> holomush.core.v1.CharacterSummary
-	26, // 29: holomush.core.v1.CreateGuestResponse.characters:type_name -> holomush.core.v1.CharacterSummary
-	26, // 30: holomush.core.v1.ListCharactersResponse.characters:type_name -> holomush.core.v1.CharacterSummary
-	40, // 31: holomush.core.v1.ListAllCharactersResponse.characters:type_name -> holomush.core.v1.CharacterDirectoryEntry
-	26, // 32: holomush.core.v1.CheckPlayerSessionResponse.characters:type_name -> holomush.core.v1.CharacterSummary
-	62, // 33: holomush.core.v1.PlayerSessionInfo.created_at:type_name -> google.protobuf.Timestamp
-	62, // 34: holomush.core.v1.PlayerSessionInfo.last_active:type_name -> google.protobuf.Timestamp
-	51, // 35: holomush.core.v1.ListPlayerSessionsResponse.sessions:type_name -> holomush.core.v1.PlayerSessionInfo
-	5,  // 36: holomush.core.v1.QueryStreamHistoryRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 37: holomush.core.v1.QueryStreamHistoryResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	10, // 38: holomush.core.v1.QueryStreamHistoryResponse.events:type_name -> holomush.core.v1.EventFrame
-	5,  // 39: holomush.core.v1.ListSessionStreamsRequest.meta:type_name -> holomush.core.v1.RequestMeta
-	6,  // 40: holomush.core.v1.ListSessionStreamsResponse.meta:type_name -> holomush.core.v1.ResponseMeta
-	7,  // 41: holomush.core.v1.CoreService.HandleCommand:input_type -> holomush.core.v1.HandleCommandRequest
-	9,  // 42: holomush.core.v1.CoreService.Subscribe:input_type -> holomush.core.v1.SubscribeRequest
-	20, // 43: holomush.core.v1.CoreService.Disconnect:input_type -> holomush.core.v1.DisconnectRequest
-	24, // 44: holomush.core.v1.CoreService.GetCommandHistory:input_type -> holomush.core.v1.GetCommandHistoryRequest
-	27, // 45: holomush.core.v1.CoreService.AuthenticatePlayer:input_type -> holomush.core.v1.AuthenticatePlayerRequest
-	29, // 46: holomush.core.v1.CoreService.SelectCharacter:input_type -> holomush.core.v1.SelectCharacterRequest
-	31, // 47: holomush.core.v1.CoreService.CreatePlayer:input_type -> holomush.core.v1.CreatePlayerRequest
-	33, // 48: holomush.core.v1.CoreService.CreateGuest:input_type -> holomush.core.v1.CreateGuestRequest
-	35, // 49: holomush.core.v1.CoreService.CreateCharacter:input_type -> holomush.core.v1.CreateCharacterRequest
-	37, // 50: holomush.core.v1.CoreService.ListCharacters:input_type -> holomush.core.v1.ListCharactersRequest
-	39, // 51: holomush.core.v1.CoreService.ListAllCharacters:input_type -> holomush.core.v1.ListAllCharactersRequest
-	42, // 52: holomush.core.v1.CoreService.RequestPasswordReset:input_type -> holomush.core.v1.RequestPasswordResetRequest
-	44, // 53: holomush.core.v1.CoreService.ConfirmPasswordReset:input_type -> holomush.core.v1.ConfirmPasswordResetRequest
-	46, // 54: holomush.core.v1.CoreService.Logout:input_type -> holomush.core.v1.LogoutRequest
-	48, // 55: holomush.core.v1.CoreService.CheckPlayerSession:input_type -> holomush.core.v1.CheckPlayerSessionRequest
-	50, // 56: holomush.core.v1.CoreService.ListPlayerSessions:input_type -> holomush.core.v1.ListPlayerSessionsRequest
-	53, // 57: holomush.core.v1.CoreService.RevokePlayerSession:input_type -> holomush.core.v1.RevokePlayerSessionRequest
-	55, // 58: holomush.core.v1.CoreService.RevokeOtherPlayerSessions:input_type -> holomush.core.v1.RevokeOtherPlayerSessionsRequest
-	57, // 59: holomush.core.v1.CoreService.QueryStreamHistory:input_type -> holomush.core.v1.QueryStreamHistoryRequest
-	59, // 60: holomush.core.v1.CoreService.ListSessionStreams:input_type -> holomush.core.v1.ListSessionStreamsRequest
-	12, // 61: holomush.core.v1.CoreService.ListFocusPresence:input_type -> holomush.core.v1.ListFocusPresenceRequest
-	15, // 62: holomush.core.v1.CoreService.ListAvailableCommands:input_type -> holomush.core.v1.ListAvailableCommandsRequest
-	22, // 63: holomush.core.v1.CoreService.RefreshConnection:input_type -> holomush.core.v1.RefreshConnectionRequest
-	8,  // 64: holomush.core.v1.CoreService.HandleCommand:output_type -> holomush.core.v1.HandleCommandResponse
-	19, // 65: holomush.core.v1.CoreService.Subscribe:output_type -> holomush.core.v1.SubscribeResponse
-	21, // 66: holomush.core.v1.CoreService.Disconnect:output_type -> holomush.core.v1.DisconnectResponse
-	25, // 67: holomush.core.v1.CoreService.GetCommandHistory:output_type -> holomush.core.v1.GetCommandHistoryResponse
-	28, // 68: holomush.core.v1.CoreService.AuthenticatePlayer:output_type -> holomush.core.v1.AuthenticatePlayerResponse
-	30, // 69: holomush.core.v1.CoreService.SelectCharacter:output_type -> holomush.core.v1.SelectCharacterResponse
-	32, // 70: holomush.core.v1.CoreService.CreatePlayer:output_type -> holomush.core.v1.CreatePlayerResponse
-	34, // 71: holomush.core.v1.CoreService.CreateGuest:output_type -> holomush.core.v1.CreateGuestResponse
-	36, // 72: holomush.core.v1.CoreService.CreateCharacter:output_type -> holomush.core.v1.CreateCharacterResponse
-	38, // 73: holomush.core.v1.CoreService.ListCharacters:output_type -> holomush.core.v1.ListCharactersResponse
-	41, // 74: holomush.core.v1.CoreService.ListAllCharacters:output_type -> holomush.core.v1.ListAllCharactersResponse
-	43, // 75: holomush.core.v1.CoreService.RequestPasswordReset:output_type -> holomush.core.v1.RequestPasswordResetResponse
-	45, // 76: holomush.core.v1.CoreService.ConfirmPasswordReset:output_type -> holomush.core.v1.ConfirmPasswordResetResponse
-	47, // 77: holomush.core.v1.CoreService.Logout:output_type -> holomush.core.v1.LogoutResponse
-	49, // 78: holomush.core.v1.CoreService.CheckPlayerSession:output_type -> holomush.core.v1.CheckPlayerSessionResponse
-	52, // 79: holomush.core.v1.CoreService.ListPlayerSessions:output_type -> holomush.core.v1.ListPlayerSessionsResponse
-	54, // 80: holomush.core.v1.CoreService.RevokePlayerSession:output_type -> holomush.core.v1.RevokePlayerSessionResponse
-	56, // 81: holomush.core.v1.CoreService.RevokeOtherPlayerSessions:output_type -> holomush.core.v1.RevokeOtherPlayerSessionsResponse
-	58, // 82: holomush.core.v1.CoreService.QueryStreamHistory:output_type -> holomush.core.v1.QueryStreamHistoryResponse
-	60, // 83: holomush.core.v1.CoreService.ListSessionStreams:output_type -> holomush.core.v1.ListSessionStreamsResponse
-	13, // 84: holomush.core.v1.CoreService.ListFocusPresence:output_type -> holomush.core.v1.ListFocusPresenceResponse
-	16, // 85: holomush.core.v1.CoreService.ListAvailableCommands:output_type -> holomush.core.v1.ListAvailableCommandsResponse
-	23, // 86: holomush.core.v1.CoreService.RefreshConnection:output_type -> holomush.core.v1.RefreshConnectionResponse
-	64, // [64:87] is the sub-list for method output_type
-	41, // [41:64] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	11, // 19: holomush.core.v1.SubscribeResponse.event:type_name -> holomush.core.v1.EventFrame
+	19, // 20: holomush.core.v1.SubscribeResponse.control:type_name -> holomush.core.v1.ControlFrame
+	6,  // 21: holomush.core.v1.DisconnectRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 22: holomush.core.v1.DisconnectResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 23: holomush.core.v1.RefreshConnectionRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 24: holomush.core.v1.RefreshConnectionResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 25: holomush.core.v1.ReportInputFloodRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	5,  // 26: holomush.core.v1.ReportInputFloodRequest.action:type_name -> holomush.core.v1.InputFloodAction
+	7,  // 27: holomush.core.v1.ReportInputFloodResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 28: holomush.core.v1.CheckAddressBanRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 29: holomush.core.v1.CheckAddressBanResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	6,  // 30: holomush.core.v1.GetCommandHistoryRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 31: holomush.core.v1.GetCommandHistoryResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	31, // 32: holomush.core.v1.AuthenticatePlayerResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	31, // 33: holomush.core.v1.AuthenticateWebSessionResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	31, // 34: holomush.core.v1.AuthenticateWithOIDCResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	6,  // 35: holomush.core.v1.ResumeSessionRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 36: holomush.core.v1.ResumeSessionResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	31, // 37: holomush.core.v1.CreatePlayerResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	31, // 38: holomush.core.v1.CreateGuestResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	31, // 39: holomush.core.v1.ListCharactersResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	53, // 40: holomush.core.v1.ListAllCharactersResponse.characters:type_name -> holomush.core.v1.CharacterDirectoryEntry
+	31, // 41: holomush.core.v1.CheckPlayerSessionResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	84, // 42: holomush.core.v1.PlayerSessionInfo.created_at:type_name -> google.protobuf.Timestamp
+	84, // 43: holomush.core.v1.PlayerSessionInfo.last_active:type_name -> google.protobuf.Timestamp
+	64, // 44: holomush.core.v1.ListPlayerSessionsResponse.sessions:type_name -> holomush.core.v1.PlayerSessionInfo
+	84, // 45: holomush.core.v1.LinkedIdentityInfo.linked_at:type_name -> google.protobuf.Timestamp
+	73, // 46: holomush.core.v1.ListIdentitiesResponse.identities:type_name -> holomush.core.v1.LinkedIdentityInfo
+	31, // 47: holomush.core.v1.ImpersonatePlayerResponse.characters:type_name -> holomush.core.v1.CharacterSummary
+	6,  // 48: holomush.core.v1.QueryStreamHistoryRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 49: holomush.core.v1.QueryStreamHistoryResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	11, // 50: holomush.core.v1.QueryStreamHistoryResponse.events:type_name -> holomush.core.v1.EventFrame
+	6,  // 51: holomush.core.v1.ListSessionStreamsRequest.meta:type_name -> holomush.core.v1.RequestMeta
+	7,  // 52: holomush.core.v1.ListSessionStreamsResponse.meta:type_name -> holomush.core.v1.ResponseMeta
+	8,  // 53: holomush.core.v1.CoreService.HandleCommand:input_type -> holomush.core.v1.HandleCommandRequest
+	10, // 54: holomush.core.v1.CoreService.Subscribe:input_type -> holomush.core.v1.SubscribeRequest
+	21, // 55: holomush.core.v1.CoreService.Disconnect:input_type -> holomush.core.v1.DisconnectRequest
+	29, // 56: holomush.core.v1.CoreService.GetCommandHistory:input_type -> holomush.core.v1.GetCommandHistoryRequest
+	32, // 57: holomush.core.v1.CoreService.AuthenticatePlayer:input_type -> holomush.core.v1.AuthenticatePlayerRequest
+	38, // 58: holomush.core.v1.CoreService.AuthenticateWithOIDC:input_type -> holomush.core.v1.AuthenticateWithOIDCRequest
+	34, // 59: holomush.core.v1.CoreService.AuthenticateWebSession:input_type -> holomush.core.v1.AuthenticateWebSessionRequest
+	36, // 60: holomush.core.v1.CoreService.RefreshWebSession:input_type -> holomush.core.v1.RefreshWebSessionRequest
+	40, // 61: holomush.core.v1.CoreService.SelectCharacter:input_type -> holomush.core.v1.SelectCharacterRequest
+	42, // 62: holomush.core.v1.CoreService.ResumeSession:input_type -> holomush.core.v1.ResumeSessionRequest
+	44, // 63: holomush.core.v1.CoreService.CreatePlayer:input_type -> holomush.core.v1.CreatePlayerRequest
+	46, // 64: holomush.core.v1.CoreService.CreateGuest:input_type -> holomush.core.v1.CreateGuestRequest
+	48, // 65: holomush.core.v1.CoreService.CreateCharacter:input_type -> holomush.core.v1.CreateCharacterRequest
+	50, // 66: holomush.core.v1.CoreService.ListCharacters:input_type -> holomush.core.v1.ListCharactersRequest
+	52, // 67: holomush.core.v1.CoreService.ListAllCharacters:input_type -> holomush.core.v1.ListAllCharactersRequest
+	55, // 68: holomush.core.v1.CoreService.RequestPasswordReset:input_type -> holomush.core.v1.RequestPasswordResetRequest
+	57, // 69: holomush.core.v1.CoreService.ConfirmPasswordReset:input_type -> holomush.core.v1.ConfirmPasswordResetRequest
+	59, // 70: holomush.core.v1.CoreService.Logout:input_type -> holomush.core.v1.LogoutRequest
+	61, // 71: holomush.core.v1.CoreService.CheckPlayerSession:input_type -> holomush.core.v1.CheckPlayerSessionRequest
+	63, // 72: holomush.core.v1.CoreService.ListPlayerSessions:input_type -> holomush.core.v1.ListPlayerSessionsRequest
+	66, // 73: holomush.core.v1.CoreService.RevokePlayerSession:input_type -> holomush.core.v1.RevokePlayerSessionRequest
+	77, // 74: holomush.core.v1.CoreService.RevokeOtherPlayerSessions:input_type -> holomush.core.v1.RevokeOtherPlayerSessionsRequest
+	68, // 75: holomush.core.v1.CoreService.LinkIdentity:input_type -> holomush.core.v1.LinkIdentityRequest
+	70, // 76: holomush.core.v1.CoreService.UnlinkIdentity:input_type -> holomush.core.v1.UnlinkIdentityRequest
+	72, // 77: holomush.core.v1.CoreService.ListIdentities:input_type -> holomush.core.v1.ListIdentitiesRequest
+	75, // 78: holomush.core.v1.CoreService.ImpersonatePlayer:input_type -> holomush.core.v1.ImpersonatePlayerRequest
+	79, // 79: holomush.core.v1.CoreService.QueryStreamHistory:input_type -> holomush.core.v1.QueryStreamHistoryRequest
+	81, // 80: holomush.core.v1.CoreService.ListSessionStreams:input_type -> holomush.core.v1.ListSessionStreamsRequest
+	13, // 81: holomush.core.v1.CoreService.ListFocusPresence:input_type -> holomush.core.v1.ListFocusPresenceRequest
+	16, // 82: holomush.core.v1.CoreService.ListAvailableCommands:input_type -> holomush.core.v1.ListAvailableCommandsRequest
+	23, // 83: holomush.core.v1.CoreService.RefreshConnection:input_type -> holomush.core.v1.RefreshConnectionRequest
+	25, // 84: holomush.core.v1.CoreService.ReportInputFlood:input_type -> holomush.core.v1.ReportInputFloodRequest
+	27, // 85: holomush.core.v1.CoreService.CheckAddressBan:input_type -> holomush.core.v1.CheckAddressBanRequest
+	9,  // 86: holomush.core.v1.CoreService.HandleCommand:output_type -> holomush.core.v1.HandleCommandResponse
+	20, // 87: holomush.core.v1.CoreService.Subscribe:output_type -> holomush.core.v1.SubscribeResponse
+	22, // 88: holomush.core.v1.CoreService.Disconnect:output_type -> holomush.core.v1.DisconnectResponse
+	30, // 89: holomush.core.v1.CoreService.GetCommandHistory:output_type -> holomush.core.v1.GetCommandHistoryResponse
+	33, // 90: holomush.core.v1.CoreService.AuthenticatePlayer:output_type -> holomush.core.v1.AuthenticatePlayerResponse
+	39, // 91: holomush.core.v1.CoreService.AuthenticateWithOIDC:output_type -> holomush.core.v1.AuthenticateWithOIDCResponse
+	35, // 92: holomush.core.v1.CoreService.AuthenticateWebSession:output_type -> holomush.core.v1.AuthenticateWebSessionResponse
+	37, // 93: holomush.core.v1.CoreService.RefreshWebSession:output_type -> holomush.core.v1.RefreshWebSessionResponse
+	41, // 94: holomush.core.v1.CoreService.SelectCharacter:output_type -> holomush.core.v1.SelectCharacterResponse
+	43, // 95: holomush.core.v1.CoreService.ResumeSession:output_type -> holomush.core.v1.ResumeSessionResponse
+	45, // 96: holomush.core.v1.CoreService.CreatePlayer:output_type -> holomush.core.v1.CreatePlayerResponse
+	47, // 97: holomush.core.v1.CoreService.CreateGuest:output_type -> holomush.core.v1.CreateGuestResponse
+	49, // 98: holomush.core.v1.CoreService.CreateCharacter:output_type -> holomush.core.v1.CreateCharacterResponse
+	51, // 99: holomush.core.v1.CoreService.ListCharacters:output_type -> holomush.core.v1.ListCharactersResponse
+	54, // 100: holomush.core.v1.CoreService.ListAllCharacters:output_type -> holomush.core.v1.ListAllCharactersResponse
+	56, // 101: holomush.core.v1.CoreService.RequestPasswordReset:output_type -> holomush.core.v1.RequestPasswordResetResponse
+	58, // 102: holomush.core.v1.CoreService.ConfirmPasswordReset:output_type -> holomush.core.v1.ConfirmPasswordResetResponse
+	60, // 103: holomush.core.v1.CoreService.Logout:output_type -> holomush.core.v1.LogoutResponse
+	62, // 104: holomush.core.v1.CoreService.CheckPlayerSession:output_type -> holomush.core.v1.CheckPlayerSessionResponse
+	65, // 105: holomush.core.v1.CoreService.ListPlayerSessions:output_type -> holomush.core.v1.ListPlayerSessionsResponse
+	67, // 106: holomush.core.v1.CoreService.RevokePlayerSession:output_type -> holomush.core.v1.RevokePlayerSessionResponse
+	78, // 107: holomush.core.v1.CoreService.RevokeOtherPlayerSessions:output_type -> holomush.core.v1.RevokeOtherPlayerSessionsResponse
+	69, // 108: holomush.core.v1.CoreService.LinkIdentity:output_type -> holomush.core.v1.LinkIdentityResponse
+	71, // 109: holomush.core.v1.CoreService.UnlinkIdentity:output_type -> holomush.core.v1.UnlinkIdentityResponse
+	74, // 110: holomush.core.v1.CoreService.ListIdentities:output_type -> holomush.core.v1.ListIdentitiesResponse
+	76, // 111: holomush.core.v1.CoreService.ImpersonatePlayer:output_type -> holomush.core.v1.ImpersonatePlayerResponse
+	80, // 112: holomush.core.v1.CoreService.QueryStreamHistory:output_type -> holomush.core.v1.QueryStreamHistoryResponse
+	82, // 113: holomush.core.v1.CoreService.ListSessionStreams:output_type -> holomush.core.v1.ListSessionStreamsResponse
+	14, // 114: holomush.core.v1.CoreService.ListFocusPresence:output_type -> holomush.core.v1.ListFocusPresenceResponse
+	17, // 115: holomush.core.v1.CoreService.ListAvailableCommands:output_type -> holomush.core.v1.ListAvailableCommandsResponse
+	24, // 116: holomush.core.v1.CoreService.RefreshConnection:output_type -> holomush.core.v1.RefreshConnectionResponse
+	26, // 117: holomush.core.v1.CoreService.ReportInputFlood:output_type -> holomush.core.v1.ReportInputFloodResponse
+	28, // 118: holomush.core.v1.CoreService.CheckAddressBan:output_type -> holomush.core.v1.CheckAddressBanResponse
+	86, // [86:119] is the sub-list for method output_type
+	53, // [53:86] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_holomush_core_v1_core_proto_init() }
@@ -4710,8 +6456,8 @@ func file_holomush_core_v1_core_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holomush_core_v1_core_proto_rawDesc), len(file_holomush_core_v1_core_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   57,
+			NumEnums:      6,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
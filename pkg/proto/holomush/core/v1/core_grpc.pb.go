@@ -27,7 +27,11 @@ const (
 	CoreService_Disconnect_FullMethodName                = "/holomush.core.v1.CoreService/Disconnect"
 	CoreService_GetCommandHistory_FullMethodName         = "/holomush.core.v1.CoreService/GetCommandHistory"
 	CoreService_AuthenticatePlayer_FullMethodName        = "/holomush.core.v1.CoreService/AuthenticatePlayer"
+	CoreService_AuthenticateWithOIDC_FullMethodName      = "/holomush.core.v1.CoreService/AuthenticateWithOIDC"
+	CoreService_AuthenticateWebSession_FullMethodName    = "/holomush.core.v1.CoreService/AuthenticateWebSession"
+	CoreService_RefreshWebSession_FullMethodName         = "/holomush.core.v1.CoreService/RefreshWebSession"
 	CoreService_SelectCharacter_FullMethodName           = "/holomush.core.v1.CoreService/SelectCharacter"
+	CoreService_ResumeSession_FullMethodName             = "/holomush.core.v1.CoreService/ResumeSession"
 	CoreService_CreatePlayer_FullMethodName              = "/holomush.core.v1.CoreService/CreatePlayer"
 	CoreService_CreateGuest_FullMethodName               = "/holomush.core.v1.CoreService/CreateGuest"
 	CoreService_CreateCharacter_FullMethodName           = "/holomush.core.v1.CoreService/CreateCharacter"
@@ -40,11 +44,17 @@ const (
 	CoreService_ListPlayerSessions_FullMethodName        = "/holomush.core.v1.CoreService/ListPlayerSessions"
 	CoreService_RevokePlayerSession_FullMethodName       = "/holomush.core.v1.CoreService/RevokePlayerSession"
 	CoreService_RevokeOtherPlayerSessions_FullMethodName = "/holomush.core.v1.CoreService/RevokeOtherPlayerSessions"
+	CoreService_LinkIdentity_FullMethodName              = "/holomush.core.v1.CoreService/LinkIdentity"
+	CoreService_UnlinkIdentity_FullMethodName            = "/holomush.core.v1.CoreService/UnlinkIdentity"
+	CoreService_ListIdentities_FullMethodName            = "/holomush.core.v1.CoreService/ListIdentities"
+	CoreService_ImpersonatePlayer_FullMethodName         = "/holomush.core.v1.CoreService/ImpersonatePlayer"
 	CoreService_QueryStreamHistory_FullMethodName        = "/holomush.core.v1.CoreService/QueryStreamHistory"
 	CoreService_ListSessionStreams_FullMethodName        = "/holomush.core.v1.CoreService/ListSessionStreams"
 	CoreService_ListFocusPresence_FullMethodName         = "/holomush.core.v1.CoreService/ListFocusPresence"
 	CoreService_ListAvailableCommands_FullMethodName     = "/holomush.core.v1.CoreService/ListAvailableCommands"
 	CoreService_RefreshConnection_FullMethodName         = "/holomush.core.v1.CoreService/RefreshConnection"
+	CoreService_ReportInputFlood_FullMethodName          = "/holomush.core.v1.CoreService/ReportInputFlood"
+	CoreService_CheckAddressBan_FullMethodName           = "/holomush.core.v1.CoreService/CheckAddressBan"
 )
 
 // CoreServiceClient is the client API for CoreService service.
@@ -84,11 +94,32 @@ type CoreServiceClient interface {
 	// returns the bearer token plus the player's character roster. No game session
 	// exists yet — that requires a follow-up SelectCharacter call.
 	AuthenticatePlayer(ctx context.Context, in *AuthenticatePlayerRequest, opts ...grpc.CallOption) (*AuthenticatePlayerResponse, error)
+	// AuthenticateWithOIDC is phase one of two-phase login for a player who has
+	// linked an OpenID Connect identity with LinkIdentity. It verifies the
+	// provider-issued ID token in place of a password; bans, lockouts, and the
+	// session cap apply exactly as for AuthenticatePlayer. An identity that is
+	// not linked never creates an account.
+	AuthenticateWithOIDC(ctx context.Context, in *AuthenticateWithOIDCRequest, opts ...grpc.CallOption) (*AuthenticateWithOIDCResponse, error)
+	// AuthenticateWebSession is AuthenticatePlayer for browser clients: the
+	// PlayerSession it mints carries a short-lived access token, used as the
+	// player_session_token of later RPCs, and a single-use refresh token that
+	// RefreshWebSession trades for a new pair.
+	AuthenticateWebSession(ctx context.Context, in *AuthenticateWebSessionRequest, opts ...grpc.CallOption) (*AuthenticateWebSessionResponse, error)
+	// RefreshWebSession trades a web session's refresh token for a new access
+	// and refresh token pair, sliding the session's expiry forward. Presenting a
+	// refresh token that was already traded revokes the session.
+	RefreshWebSession(ctx context.Context, in *RefreshWebSessionRequest, opts ...grpc.CallOption) (*RefreshWebSessionResponse, error)
 	// SelectCharacter is phase two of two-phase login: given a valid player session
 	// token, it reattaches an existing detached game session (preserving scrollback)
 	// or creates a fresh one for the chosen character, emitting an arrive event.
 	// The character must belong to the authenticated player.
 	SelectCharacter(ctx context.Context, in *SelectCharacterRequest, opts ...grpc.CallOption) (*SelectCharacterResponse, error)
+	// ResumeSession redeems a reconnect token issued by SelectCharacter: a
+	// client whose connection dropped resumes its lingering game session without
+	// logging in again, and the next Subscribe replays the output it missed.
+	// Both the reconnect token and the player session token are rotated, so a
+	// token resumes at most once.
+	ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error)
 	// CreatePlayer registers a new player account and immediately returns a player
 	// session token (the new account is logged in). The returned character roster
 	// is empty — a freshly created player has no characters until CreateCharacter.
@@ -143,6 +174,26 @@ type CoreServiceClient interface {
 	// the current one. Convenience bulk operation equivalent to listing and calling
 	// RevokePlayerSession for each — useful after a suspected compromise.
 	RevokeOtherPlayerSessions(ctx context.Context, in *RevokeOtherPlayerSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherPlayerSessionsResponse, error)
+	// LinkIdentity links the OpenID Connect identity an ID token asserts to the
+	// caller's player so it can sign in with AuthenticateWithOIDC. The player's
+	// current password is required, and a wrong one counts toward the account
+	// lockout. Impersonation sessions are refused. The link is recorded in the
+	// player's security log.
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error)
+	// UnlinkIdentity removes the caller's linked identity for a provider. The
+	// only sign-in method of an account without a password is kept.
+	// Impersonation sessions are refused. The removal is recorded in the
+	// player's security log.
+	UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error)
+	// ListIdentities returns the OpenID Connect identities linked to the
+	// caller's player, oldest first.
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	// ImpersonatePlayer opens a session as another player on behalf of a staff
+	// member holding the support.impersonate grant. The session is time-boxed,
+	// recorded in both players' security logs, and announced to the target at
+	// their next login. The caller uses the returned token exactly like one
+	// from AuthenticatePlayer.
+	ImpersonatePlayer(ctx context.Context, in *ImpersonatePlayerRequest, opts ...grpc.CallOption) (*ImpersonatePlayerResponse, error)
 	// QueryStreamHistory reads paginated event history from a single stream. It is
 	// a pure read that does NOT mutate session cursors (invariant I-13). Two-layer
 	// authorization applies: private streams (character / scene) use a hard
@@ -173,6 +224,17 @@ type CoreServiceClient interface {
 	// by the gateway while the client socket is open (holomush-rsoe6). SERVED by
 	// CoreServer.RefreshConnection; ownership-validated and enumeration-safe.
 	RefreshConnection(ctx context.Context, in *RefreshConnectionRequest, opts ...grpc.CallOption) (*RefreshConnectionResponse, error)
+	// ReportInputFlood records that a gateway's input flood protection escalated
+	// against a connection (warned, throttled, or disconnected a client sending
+	// commands too fast), publishing a moderation event for staff review. SERVED
+	// by CoreServer.ReportInputFlood; ownership-validated and enumeration-safe.
+	ReportInputFlood(ctx context.Context, in *ReportInputFloodRequest, opts ...grpc.CallOption) (*ReportInputFloodResponse, error)
+	// CheckAddressBan reports whether a client address is banned, so a gateway
+	// can refuse a connection before showing any prompt. SERVED by
+	// CoreServer.CheckAddressBan, delegating to bans.Service. Login enforces the
+	// same bans, so a client a gateway admits on a failed check still cannot
+	// sign in.
+	CheckAddressBan(ctx context.Context, in *CheckAddressBanRequest, opts ...grpc.CallOption) (*CheckAddressBanResponse, error)
 }
 
 type coreServiceClient struct {
@@ -242,6 +304,36 @@ func (c *coreServiceClient) AuthenticatePlayer(ctx context.Context, in *Authenti
 	return out, nil
 }
 
+func (c *coreServiceClient) AuthenticateWithOIDC(ctx context.Context, in *AuthenticateWithOIDCRequest, opts ...grpc.CallOption) (*AuthenticateWithOIDCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateWithOIDCResponse)
+	err := c.cc.Invoke(ctx, CoreService_AuthenticateWithOIDC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) AuthenticateWebSession(ctx context.Context, in *AuthenticateWebSessionRequest, opts ...grpc.CallOption) (*AuthenticateWebSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateWebSessionResponse)
+	err := c.cc.Invoke(ctx, CoreService_AuthenticateWebSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) RefreshWebSession(ctx context.Context, in *RefreshWebSessionRequest, opts ...grpc.CallOption) (*RefreshWebSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshWebSessionResponse)
+	err := c.cc.Invoke(ctx, CoreService_RefreshWebSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreServiceClient) SelectCharacter(ctx context.Context, in *SelectCharacterRequest, opts ...grpc.CallOption) (*SelectCharacterResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SelectCharacterResponse)
@@ -252,6 +344,16 @@ func (c *coreServiceClient) SelectCharacter(ctx context.Context, in *SelectChara
 	return out, nil
 }
 
+func (c *coreServiceClient) ResumeSession(ctx context.Context, in *ResumeSessionRequest, opts ...grpc.CallOption) (*ResumeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeSessionResponse)
+	err := c.cc.Invoke(ctx, CoreService_ResumeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreServiceClient) CreatePlayer(ctx context.Context, in *CreatePlayerRequest, opts ...grpc.CallOption) (*CreatePlayerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePlayerResponse)
@@ -372,6 +474,46 @@ func (c *coreServiceClient) RevokeOtherPlayerSessions(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *coreServiceClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIdentityResponse)
+	err := c.cc.Invoke(ctx, CoreService_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) UnlinkIdentity(ctx context.Context, in *UnlinkIdentityRequest, opts ...grpc.CallOption) (*UnlinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkIdentityResponse)
+	err := c.cc.Invoke(ctx, CoreService_UnlinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, CoreService_ListIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) ImpersonatePlayer(ctx context.Context, in *ImpersonatePlayerRequest, opts ...grpc.CallOption) (*ImpersonatePlayerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonatePlayerResponse)
+	err := c.cc.Invoke(ctx, CoreService_ImpersonatePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreServiceClient) QueryStreamHistory(ctx context.Context, in *QueryStreamHistoryRequest, opts ...grpc.CallOption) (*QueryStreamHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryStreamHistoryResponse)
@@ -422,6 +564,26 @@ func (c *coreServiceClient) RefreshConnection(ctx context.Context, in *RefreshCo
 	return out, nil
 }
 
+func (c *coreServiceClient) ReportInputFlood(ctx context.Context, in *ReportInputFloodRequest, opts ...grpc.CallOption) (*ReportInputFloodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportInputFloodResponse)
+	err := c.cc.Invoke(ctx, CoreService_ReportInputFlood_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) CheckAddressBan(ctx context.Context, in *CheckAddressBanRequest, opts ...grpc.CallOption) (*CheckAddressBanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAddressBanResponse)
+	err := c.cc.Invoke(ctx, CoreService_CheckAddressBan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServiceServer is the server API for CoreService service.
 // All implementations must embed UnimplementedCoreServiceServer
 // for forward compatibility.
@@ -459,11 +621,32 @@ type CoreServiceServer interface {
 	// returns the bearer token plus the player's character roster. No game session
 	// exists yet — that requires a follow-up SelectCharacter call.
 	AuthenticatePlayer(context.Context, *AuthenticatePlayerRequest) (*AuthenticatePlayerResponse, error)
+	// AuthenticateWithOIDC is phase one of two-phase login for a player who has
+	// linked an OpenID Connect identity with LinkIdentity. It verifies the
+	// provider-issued ID token in place of a password; bans, lockouts, and the
+	// session cap apply exactly as for AuthenticatePlayer. An identity that is
+	// not linked never creates an account.
+	AuthenticateWithOIDC(context.Context, *AuthenticateWithOIDCRequest) (*AuthenticateWithOIDCResponse, error)
+	// AuthenticateWebSession is AuthenticatePlayer for browser clients: the
+	// PlayerSession it mints carries a short-lived access token, used as the
+	// player_session_token of later RPCs, and a single-use refresh token that
+	// RefreshWebSession trades for a new pair.
+	AuthenticateWebSession(context.Context, *AuthenticateWebSessionRequest) (*AuthenticateWebSessionResponse, error)
+	// RefreshWebSession trades a web session's refresh token for a new access
+	// and refresh token pair, sliding the session's expiry forward. Presenting a
+	// refresh token that was already traded revokes the session.
+	RefreshWebSession(context.Context, *RefreshWebSessionRequest) (*RefreshWebSessionResponse, error)
 	// SelectCharacter is phase two of two-phase login: given a valid player session
 	// token, it reattaches an existing detached game session (preserving scrollback)
 	// or creates a fresh one for the chosen character, emitting an arrive event.
 	// The character must belong to the authenticated player.
 	SelectCharacter(context.Context, *SelectCharacterRequest) (*SelectCharacterResponse, error)
+	// ResumeSession redeems a reconnect token issued by SelectCharacter: a
+	// client whose connection dropped resumes its lingering game session without
+	// logging in again, and the next Subscribe replays the output it missed.
+	// Both the reconnect token and the player session token are rotated, so a
+	// token resumes at most once.
+	ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error)
 	// CreatePlayer registers a new player account and immediately returns a player
 	// session token (the new account is logged in). The returned character roster
 	// is empty — a freshly created player has no characters until CreateCharacter.
@@ -518,6 +701,26 @@ type CoreServiceServer interface {
 	// the current one. Convenience bulk operation equivalent to listing and calling
 	// RevokePlayerSession for each — useful after a suspected compromise.
 	RevokeOtherPlayerSessions(context.Context, *RevokeOtherPlayerSessionsRequest) (*RevokeOtherPlayerSessionsResponse, error)
+	// LinkIdentity links the OpenID Connect identity an ID token asserts to the
+	// caller's player so it can sign in with AuthenticateWithOIDC. The player's
+	// current password is required, and a wrong one counts toward the account
+	// lockout. Impersonation sessions are refused. The link is recorded in the
+	// player's security log.
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error)
+	// UnlinkIdentity removes the caller's linked identity for a provider. The
+	// only sign-in method of an account without a password is kept.
+	// Impersonation sessions are refused. The removal is recorded in the
+	// player's security log.
+	UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error)
+	// ListIdentities returns the OpenID Connect identities linked to the
+	// caller's player, oldest first.
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	// ImpersonatePlayer opens a session as another player on behalf of a staff
+	// member holding the support.impersonate grant. The session is time-boxed,
+	// recorded in both players' security logs, and announced to the target at
+	// their next login. The caller uses the returned token exactly like one
+	// from AuthenticatePlayer.
+	ImpersonatePlayer(context.Context, *ImpersonatePlayerRequest) (*ImpersonatePlayerResponse, error)
 	// QueryStreamHistory reads paginated event history from a single stream. It is
 	// a pure read that does NOT mutate session cursors (invariant I-13). Two-layer
 	// authorization applies: private streams (character / scene) use a hard
@@ -548,6 +751,17 @@ type CoreServiceServer interface {
 	// by the gateway while the client socket is open (holomush-rsoe6). SERVED by
 	// CoreServer.RefreshConnection; ownership-validated and enumeration-safe.
 	RefreshConnection(context.Context, *RefreshConnectionRequest) (*RefreshConnectionResponse, error)
+	// ReportInputFlood records that a gateway's input flood protection escalated
+	// against a connection (warned, throttled, or disconnected a client sending
+	// commands too fast), publishing a moderation event for staff review. SERVED
+	// by CoreServer.ReportInputFlood; ownership-validated and enumeration-safe.
+	ReportInputFlood(context.Context, *ReportInputFloodRequest) (*ReportInputFloodResponse, error)
+	// CheckAddressBan reports whether a client address is banned, so a gateway
+	// can refuse a connection before showing any prompt. SERVED by
+	// CoreServer.CheckAddressBan, delegating to bans.Service. Login enforces the
+	// same bans, so a client a gateway admits on a failed check still cannot
+	// sign in.
+	CheckAddressBan(context.Context, *CheckAddressBanRequest) (*CheckAddressBanResponse, error)
 	mustEmbedUnimplementedCoreServiceServer()
 }
 
@@ -573,9 +787,21 @@ func (UnimplementedCoreServiceServer) GetCommandHistory(context.Context, *GetCom
 func (UnimplementedCoreServiceServer) AuthenticatePlayer(context.Context, *AuthenticatePlayerRequest) (*AuthenticatePlayerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AuthenticatePlayer not implemented")
 }
+func (UnimplementedCoreServiceServer) AuthenticateWithOIDC(context.Context, *AuthenticateWithOIDCRequest) (*AuthenticateWithOIDCResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthenticateWithOIDC not implemented")
+}
+func (UnimplementedCoreServiceServer) AuthenticateWebSession(context.Context, *AuthenticateWebSessionRequest) (*AuthenticateWebSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AuthenticateWebSession not implemented")
+}
+func (UnimplementedCoreServiceServer) RefreshWebSession(context.Context, *RefreshWebSessionRequest) (*RefreshWebSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshWebSession not implemented")
+}
 func (UnimplementedCoreServiceServer) SelectCharacter(context.Context, *SelectCharacterRequest) (*SelectCharacterResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SelectCharacter not implemented")
 }
+func (UnimplementedCoreServiceServer) ResumeSession(context.Context, *ResumeSessionRequest) (*ResumeSessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeSession not implemented")
+}
 func (UnimplementedCoreServiceServer) CreatePlayer(context.Context, *CreatePlayerRequest) (*CreatePlayerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreatePlayer not implemented")
 }
@@ -612,6 +838,18 @@ func (UnimplementedCoreServiceServer) RevokePlayerSession(context.Context, *Revo
 func (UnimplementedCoreServiceServer) RevokeOtherPlayerSessions(context.Context, *RevokeOtherPlayerSessionsRequest) (*RevokeOtherPlayerSessionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RevokeOtherPlayerSessions not implemented")
 }
+func (UnimplementedCoreServiceServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedCoreServiceServer) UnlinkIdentity(context.Context, *UnlinkIdentityRequest) (*UnlinkIdentityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlinkIdentity not implemented")
+}
+func (UnimplementedCoreServiceServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedCoreServiceServer) ImpersonatePlayer(context.Context, *ImpersonatePlayerRequest) (*ImpersonatePlayerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImpersonatePlayer not implemented")
+}
 func (UnimplementedCoreServiceServer) QueryStreamHistory(context.Context, *QueryStreamHistoryRequest) (*QueryStreamHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryStreamHistory not implemented")
 }
@@ -627,6 +865,12 @@ func (UnimplementedCoreServiceServer) ListAvailableCommands(context.Context, *Li
 func (UnimplementedCoreServiceServer) RefreshConnection(context.Context, *RefreshConnectionRequest) (*RefreshConnectionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshConnection not implemented")
 }
+func (UnimplementedCoreServiceServer) ReportInputFlood(context.Context, *ReportInputFloodRequest) (*ReportInputFloodResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportInputFlood not implemented")
+}
+func (UnimplementedCoreServiceServer) CheckAddressBan(context.Context, *CheckAddressBanRequest) (*CheckAddressBanResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckAddressBan not implemented")
+}
 func (UnimplementedCoreServiceServer) mustEmbedUnimplementedCoreServiceServer() {}
 func (UnimplementedCoreServiceServer) testEmbeddedByValue()                     {}
 
@@ -731,6 +975,60 @@ func _CoreService_AuthenticatePlayer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_AuthenticateWithOIDC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateWithOIDCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).AuthenticateWithOIDC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_AuthenticateWithOIDC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).AuthenticateWithOIDC(ctx, req.(*AuthenticateWithOIDCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_AuthenticateWebSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateWebSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).AuthenticateWebSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_AuthenticateWebSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).AuthenticateWebSession(ctx, req.(*AuthenticateWebSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_RefreshWebSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshWebSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).RefreshWebSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_RefreshWebSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).RefreshWebSession(ctx, req.(*RefreshWebSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreService_SelectCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SelectCharacterRequest)
 	if err := dec(in); err != nil {
@@ -749,6 +1047,24 @@ func _CoreService_SelectCharacter_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_ResumeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ResumeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_ResumeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ResumeSession(ctx, req.(*ResumeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreService_CreatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePlayerRequest)
 	if err := dec(in); err != nil {
@@ -965,6 +1281,78 @@ func _CoreService_RevokeOtherPlayerSessions_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_UnlinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).UnlinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_UnlinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).UnlinkIdentity(ctx, req.(*UnlinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_ListIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_ImpersonatePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonatePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ImpersonatePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_ImpersonatePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ImpersonatePlayer(ctx, req.(*ImpersonatePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreService_QueryStreamHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryStreamHistoryRequest)
 	if err := dec(in); err != nil {
@@ -1055,6 +1443,42 @@ func _CoreService_RefreshConnection_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_ReportInputFlood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportInputFloodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ReportInputFlood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_ReportInputFlood_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ReportInputFlood(ctx, req.(*ReportInputFloodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_CheckAddressBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAddressBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).CheckAddressBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CoreService_CheckAddressBan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).CheckAddressBan(ctx, req.(*CheckAddressBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CoreService_ServiceDesc is the grpc.ServiceDesc for CoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1078,10 +1502,26 @@ var CoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthenticatePlayer",
 			Handler:    _CoreService_AuthenticatePlayer_Handler,
 		},
+		{
+			MethodName: "AuthenticateWithOIDC",
+			Handler:    _CoreService_AuthenticateWithOIDC_Handler,
+		},
+		{
+			MethodName: "AuthenticateWebSession",
+			Handler:    _CoreService_AuthenticateWebSession_Handler,
+		},
+		{
+			MethodName: "RefreshWebSession",
+			Handler:    _CoreService_RefreshWebSession_Handler,
+		},
 		{
 			MethodName: "SelectCharacter",
 			Handler:    _CoreService_SelectCharacter_Handler,
 		},
+		{
+			MethodName: "ResumeSession",
+			Handler:    _CoreService_ResumeSession_Handler,
+		},
 		{
 			MethodName: "CreatePlayer",
 			Handler:    _CoreService_CreatePlayer_Handler,
@@ -1130,6 +1570,22 @@ var CoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeOtherPlayerSessions",
 			Handler:    _CoreService_RevokeOtherPlayerSessions_Handler,
 		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _CoreService_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkIdentity",
+			Handler:    _CoreService_UnlinkIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _CoreService_ListIdentities_Handler,
+		},
+		{
+			MethodName: "ImpersonatePlayer",
+			Handler:    _CoreService_ImpersonatePlayer_Handler,
+		},
 		{
 			MethodName: "QueryStreamHistory",
 			Handler:    _CoreService_QueryStreamHistory_Handler,
@@ -1150,6 +1606,14 @@ var CoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshConnection",
 			Handler:    _CoreService_RefreshConnection_Handler,
 		},
+		{
+			MethodName: "ReportInputFlood",
+			Handler:    _CoreService_ReportInputFlood_Handler,
+		},
+		{
+			MethodName: "CheckAddressBan",
+			Handler:    _CoreService_CheckAddressBan_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
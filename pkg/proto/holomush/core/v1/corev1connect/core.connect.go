@@ -49,9 +49,21 @@ const (
 	// CoreServiceAuthenticatePlayerProcedure is the fully-qualified name of the CoreService's
 	// AuthenticatePlayer RPC.
 	CoreServiceAuthenticatePlayerProcedure = "/holomush.core.v1.CoreService/AuthenticatePlayer"
+	// CoreServiceAuthenticateWithOIDCProcedure is the fully-qualified name of the CoreService's
+	// AuthenticateWithOIDC RPC.
+	CoreServiceAuthenticateWithOIDCProcedure = "/holomush.core.v1.CoreService/AuthenticateWithOIDC"
+	// CoreServiceAuthenticateWebSessionProcedure is the fully-qualified name of the CoreService's
+	// AuthenticateWebSession RPC.
+	CoreServiceAuthenticateWebSessionProcedure = "/holomush.core.v1.CoreService/AuthenticateWebSession"
+	// CoreServiceRefreshWebSessionProcedure is the fully-qualified name of the CoreService's
+	// RefreshWebSession RPC.
+	CoreServiceRefreshWebSessionProcedure = "/holomush.core.v1.CoreService/RefreshWebSession"
 	// CoreServiceSelectCharacterProcedure is the fully-qualified name of the CoreService's
 	// SelectCharacter RPC.
 	CoreServiceSelectCharacterProcedure = "/holomush.core.v1.CoreService/SelectCharacter"
+	// CoreServiceResumeSessionProcedure is the fully-qualified name of the CoreService's ResumeSession
+	// RPC.
+	CoreServiceResumeSessionProcedure = "/holomush.core.v1.CoreService/ResumeSession"
 	// CoreServiceCreatePlayerProcedure is the fully-qualified name of the CoreService's CreatePlayer
 	// RPC.
 	CoreServiceCreatePlayerProcedure = "/holomush.core.v1.CoreService/CreatePlayer"
@@ -86,6 +98,18 @@ const (
 	// CoreServiceRevokeOtherPlayerSessionsProcedure is the fully-qualified name of the CoreService's
 	// RevokeOtherPlayerSessions RPC.
 	CoreServiceRevokeOtherPlayerSessionsProcedure = "/holomush.core.v1.CoreService/RevokeOtherPlayerSessions"
+	// CoreServiceLinkIdentityProcedure is the fully-qualified name of the CoreService's LinkIdentity
+	// RPC.
+	CoreServiceLinkIdentityProcedure = "/holomush.core.v1.CoreService/LinkIdentity"
+	// CoreServiceUnlinkIdentityProcedure is the fully-qualified name of the CoreService's
+	// UnlinkIdentity RPC.
+	CoreServiceUnlinkIdentityProcedure = "/holomush.core.v1.CoreService/UnlinkIdentity"
+	// CoreServiceListIdentitiesProcedure is the fully-qualified name of the CoreService's
+	// ListIdentities RPC.
+	CoreServiceListIdentitiesProcedure = "/holomush.core.v1.CoreService/ListIdentities"
+	// CoreServiceImpersonatePlayerProcedure is the fully-qualified name of the CoreService's
+	// ImpersonatePlayer RPC.
+	CoreServiceImpersonatePlayerProcedure = "/holomush.core.v1.CoreService/ImpersonatePlayer"
 	// CoreServiceQueryStreamHistoryProcedure is the fully-qualified name of the CoreService's
 	// QueryStreamHistory RPC.
 	CoreServiceQueryStreamHistoryProcedure = "/holomush.core.v1.CoreService/QueryStreamHistory"
@@ -101,6 +125,12 @@ const (
 	// CoreServiceRefreshConnectionProcedure is the fully-qualified name of the CoreService's
 	// RefreshConnection RPC.
 	CoreServiceRefreshConnectionProcedure = "/holomush.core.v1.CoreService/RefreshConnection"
+	// CoreServiceReportInputFloodProcedure is the fully-qualified name of the CoreService's
+	// ReportInputFlood RPC.
+	CoreServiceReportInputFloodProcedure = "/holomush.core.v1.CoreService/ReportInputFlood"
+	// CoreServiceCheckAddressBanProcedure is the fully-qualified name of the CoreService's
+	// CheckAddressBan RPC.
+	CoreServiceCheckAddressBanProcedure = "/holomush.core.v1.CoreService/CheckAddressBan"
 )
 
 // CoreServiceClient is a client for the holomush.core.v1.CoreService service.
@@ -131,11 +161,32 @@ type CoreServiceClient interface {
 	// returns the bearer token plus the player's character roster. No game session
 	// exists yet — that requires a follow-up SelectCharacter call.
 	AuthenticatePlayer(context.Context, *connect.Request[v1.AuthenticatePlayerRequest]) (*connect.Response[v1.AuthenticatePlayerResponse], error)
+	// AuthenticateWithOIDC is phase one of two-phase login for a player who has
+	// linked an OpenID Connect identity with LinkIdentity. It verifies the
+	// provider-issued ID token in place of a password; bans, lockouts, and the
+	// session cap apply exactly as for AuthenticatePlayer. An identity that is
+	// not linked never creates an account.
+	AuthenticateWithOIDC(context.Context, *connect.Request[v1.AuthenticateWithOIDCRequest]) (*connect.Response[v1.AuthenticateWithOIDCResponse], error)
+	// AuthenticateWebSession is AuthenticatePlayer for browser clients: the
+	// PlayerSession it mints carries a short-lived access token, used as the
+	// player_session_token of later RPCs, and a single-use refresh token that
+	// RefreshWebSession trades for a new pair.
+	AuthenticateWebSession(context.Context, *connect.Request[v1.AuthenticateWebSessionRequest]) (*connect.Response[v1.AuthenticateWebSessionResponse], error)
+	// RefreshWebSession trades a web session's refresh token for a new access
+	// and refresh token pair, sliding the session's expiry forward. Presenting a
+	// refresh token that was already traded revokes the session.
+	RefreshWebSession(context.Context, *connect.Request[v1.RefreshWebSessionRequest]) (*connect.Response[v1.RefreshWebSessionResponse], error)
 	// SelectCharacter is phase two of two-phase login: given a valid player session
 	// token, it reattaches an existing detached game session (preserving scrollback)
 	// or creates a fresh one for the chosen character, emitting an arrive event.
 	// The character must belong to the authenticated player.
 	SelectCharacter(context.Context, *connect.Request[v1.SelectCharacterRequest]) (*connect.Response[v1.SelectCharacterResponse], error)
+	// ResumeSession redeems a reconnect token issued by SelectCharacter: a
+	// client whose connection dropped resumes its lingering game session without
+	// logging in again, and the next Subscribe replays the output it missed.
+	// Both the reconnect token and the player session token are rotated, so a
+	// token resumes at most once.
+	ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error)
 	// CreatePlayer registers a new player account and immediately returns a player
 	// session token (the new account is logged in). The returned character roster
 	// is empty — a freshly created player has no characters until CreateCharacter.
@@ -190,6 +241,26 @@ type CoreServiceClient interface {
 	// the current one. Convenience bulk operation equivalent to listing and calling
 	// RevokePlayerSession for each — useful after a suspected compromise.
 	RevokeOtherPlayerSessions(context.Context, *connect.Request[v1.RevokeOtherPlayerSessionsRequest]) (*connect.Response[v1.RevokeOtherPlayerSessionsResponse], error)
+	// LinkIdentity links the OpenID Connect identity an ID token asserts to the
+	// caller's player so it can sign in with AuthenticateWithOIDC. The player's
+	// current password is required, and a wrong one counts toward the account
+	// lockout. Impersonation sessions are refused. The link is recorded in the
+	// player's security log.
+	LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error)
+	// UnlinkIdentity removes the caller's linked identity for a provider. The
+	// only sign-in method of an account without a password is kept.
+	// Impersonation sessions are refused. The removal is recorded in the
+	// player's security log.
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// ListIdentities returns the OpenID Connect identities linked to the
+	// caller's player, oldest first.
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	// ImpersonatePlayer opens a session as another player on behalf of a staff
+	// member holding the support.impersonate grant. The session is time-boxed,
+	// recorded in both players' security logs, and announced to the target at
+	// their next login. The caller uses the returned token exactly like one
+	// from AuthenticatePlayer.
+	ImpersonatePlayer(context.Context, *connect.Request[v1.ImpersonatePlayerRequest]) (*connect.Response[v1.ImpersonatePlayerResponse], error)
 	// QueryStreamHistory reads paginated event history from a single stream. It is
 	// a pure read that does NOT mutate session cursors (invariant I-13). Two-layer
 	// authorization applies: private streams (character / scene) use a hard
@@ -220,6 +291,17 @@ type CoreServiceClient interface {
 	// by the gateway while the client socket is open (holomush-rsoe6). SERVED by
 	// CoreServer.RefreshConnection; ownership-validated and enumeration-safe.
 	RefreshConnection(context.Context, *connect.Request[v1.RefreshConnectionRequest]) (*connect.Response[v1.RefreshConnectionResponse], error)
+	// ReportInputFlood records that a gateway's input flood protection escalated
+	// against a connection (warned, throttled, or disconnected a client sending
+	// commands too fast), publishing a moderation event for staff review. SERVED
+	// by CoreServer.ReportInputFlood; ownership-validated and enumeration-safe.
+	ReportInputFlood(context.Context, *connect.Request[v1.ReportInputFloodRequest]) (*connect.Response[v1.ReportInputFloodResponse], error)
+	// CheckAddressBan reports whether a client address is banned, so a gateway
+	// can refuse a connection before showing any prompt. SERVED by
+	// CoreServer.CheckAddressBan, delegating to bans.Service. Login enforces the
+	// same bans, so a client a gateway admits on a failed check still cannot
+	// sign in.
+	CheckAddressBan(context.Context, *connect.Request[v1.CheckAddressBanRequest]) (*connect.Response[v1.CheckAddressBanResponse], error)
 }
 
 // NewCoreServiceClient constructs a client for the holomush.core.v1.CoreService service. By
@@ -263,12 +345,36 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("AuthenticatePlayer")),
 			connect.WithClientOptions(opts...),
 		),
+		authenticateWithOIDC: connect.NewClient[v1.AuthenticateWithOIDCRequest, v1.AuthenticateWithOIDCResponse](
+			httpClient,
+			baseURL+CoreServiceAuthenticateWithOIDCProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("AuthenticateWithOIDC")),
+			connect.WithClientOptions(opts...),
+		),
+		authenticateWebSession: connect.NewClient[v1.AuthenticateWebSessionRequest, v1.AuthenticateWebSessionResponse](
+			httpClient,
+			baseURL+CoreServiceAuthenticateWebSessionProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("AuthenticateWebSession")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshWebSession: connect.NewClient[v1.RefreshWebSessionRequest, v1.RefreshWebSessionResponse](
+			httpClient,
+			baseURL+CoreServiceRefreshWebSessionProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("RefreshWebSession")),
+			connect.WithClientOptions(opts...),
+		),
 		selectCharacter: connect.NewClient[v1.SelectCharacterRequest, v1.SelectCharacterResponse](
 			httpClient,
 			baseURL+CoreServiceSelectCharacterProcedure,
 			connect.WithSchema(coreServiceMethods.ByName("SelectCharacter")),
 			connect.WithClientOptions(opts...),
 		),
+		resumeSession: connect.NewClient[v1.ResumeSessionRequest, v1.ResumeSessionResponse](
+			httpClient,
+			baseURL+CoreServiceResumeSessionProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("ResumeSession")),
+			connect.WithClientOptions(opts...),
+		),
 		createPlayer: connect.NewClient[v1.CreatePlayerRequest, v1.CreatePlayerResponse](
 			httpClient,
 			baseURL+CoreServiceCreatePlayerProcedure,
@@ -341,6 +447,30 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("RevokeOtherPlayerSessions")),
 			connect.WithClientOptions(opts...),
 		),
+		linkIdentity: connect.NewClient[v1.LinkIdentityRequest, v1.LinkIdentityResponse](
+			httpClient,
+			baseURL+CoreServiceLinkIdentityProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("LinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+CoreServiceUnlinkIdentityProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		listIdentities: connect.NewClient[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse](
+			httpClient,
+			baseURL+CoreServiceListIdentitiesProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("ListIdentities")),
+			connect.WithClientOptions(opts...),
+		),
+		impersonatePlayer: connect.NewClient[v1.ImpersonatePlayerRequest, v1.ImpersonatePlayerResponse](
+			httpClient,
+			baseURL+CoreServiceImpersonatePlayerProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("ImpersonatePlayer")),
+			connect.WithClientOptions(opts...),
+		),
 		queryStreamHistory: connect.NewClient[v1.QueryStreamHistoryRequest, v1.QueryStreamHistoryResponse](
 			httpClient,
 			baseURL+CoreServiceQueryStreamHistoryProcedure,
@@ -371,6 +501,18 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("RefreshConnection")),
 			connect.WithClientOptions(opts...),
 		),
+		reportInputFlood: connect.NewClient[v1.ReportInputFloodRequest, v1.ReportInputFloodResponse](
+			httpClient,
+			baseURL+CoreServiceReportInputFloodProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("ReportInputFlood")),
+			connect.WithClientOptions(opts...),
+		),
+		checkAddressBan: connect.NewClient[v1.CheckAddressBanRequest, v1.CheckAddressBanResponse](
+			httpClient,
+			baseURL+CoreServiceCheckAddressBanProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("CheckAddressBan")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -381,7 +523,11 @@ type coreServiceClient struct {
 	disconnect                *connect.Client[v1.DisconnectRequest, v1.DisconnectResponse]
 	getCommandHistory         *connect.Client[v1.GetCommandHistoryRequest, v1.GetCommandHistoryResponse]
 	authenticatePlayer        *connect.Client[v1.AuthenticatePlayerRequest, v1.AuthenticatePlayerResponse]
+	authenticateWithOIDC      *connect.Client[v1.AuthenticateWithOIDCRequest, v1.AuthenticateWithOIDCResponse]
+	authenticateWebSession    *connect.Client[v1.AuthenticateWebSessionRequest, v1.AuthenticateWebSessionResponse]
+	refreshWebSession         *connect.Client[v1.RefreshWebSessionRequest, v1.RefreshWebSessionResponse]
 	selectCharacter           *connect.Client[v1.SelectCharacterRequest, v1.SelectCharacterResponse]
+	resumeSession             *connect.Client[v1.ResumeSessionRequest, v1.ResumeSessionResponse]
 	createPlayer              *connect.Client[v1.CreatePlayerRequest, v1.CreatePlayerResponse]
 	createGuest               *connect.Client[v1.CreateGuestRequest, v1.CreateGuestResponse]
 	createCharacter           *connect.Client[v1.CreateCharacterRequest, v1.CreateCharacterResponse]
@@ -394,11 +540,17 @@ type coreServiceClient struct {
 	listPlayerSessions        *connect.Client[v1.ListPlayerSessionsRequest, v1.ListPlayerSessionsResponse]
 	revokePlayerSession       *connect.Client[v1.RevokePlayerSessionRequest, v1.RevokePlayerSessionResponse]
 	revokeOtherPlayerSessions *connect.Client[v1.RevokeOtherPlayerSessionsRequest, v1.RevokeOtherPlayerSessionsResponse]
+	linkIdentity              *connect.Client[v1.LinkIdentityRequest, v1.LinkIdentityResponse]
+	unlinkIdentity            *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	listIdentities            *connect.Client[v1.ListIdentitiesRequest, v1.ListIdentitiesResponse]
+	impersonatePlayer         *connect.Client[v1.ImpersonatePlayerRequest, v1.ImpersonatePlayerResponse]
 	queryStreamHistory        *connect.Client[v1.QueryStreamHistoryRequest, v1.QueryStreamHistoryResponse]
 	listSessionStreams        *connect.Client[v1.ListSessionStreamsRequest, v1.ListSessionStreamsResponse]
 	listFocusPresence         *connect.Client[v1.ListFocusPresenceRequest, v1.ListFocusPresenceResponse]
 	listAvailableCommands     *connect.Client[v1.ListAvailableCommandsRequest, v1.ListAvailableCommandsResponse]
 	refreshConnection         *connect.Client[v1.RefreshConnectionRequest, v1.RefreshConnectionResponse]
+	reportInputFlood          *connect.Client[v1.ReportInputFloodRequest, v1.ReportInputFloodResponse]
+	checkAddressBan           *connect.Client[v1.CheckAddressBanRequest, v1.CheckAddressBanResponse]
 }
 
 // HandleCommand calls holomush.core.v1.CoreService.HandleCommand.
@@ -426,11 +578,31 @@ func (c *coreServiceClient) AuthenticatePlayer(ctx context.Context, req *connect
 	return c.authenticatePlayer.CallUnary(ctx, req)
 }
 
+// AuthenticateWithOIDC calls holomush.core.v1.CoreService.AuthenticateWithOIDC.
+func (c *coreServiceClient) AuthenticateWithOIDC(ctx context.Context, req *connect.Request[v1.AuthenticateWithOIDCRequest]) (*connect.Response[v1.AuthenticateWithOIDCResponse], error) {
+	return c.authenticateWithOIDC.CallUnary(ctx, req)
+}
+
+// AuthenticateWebSession calls holomush.core.v1.CoreService.AuthenticateWebSession.
+func (c *coreServiceClient) AuthenticateWebSession(ctx context.Context, req *connect.Request[v1.AuthenticateWebSessionRequest]) (*connect.Response[v1.AuthenticateWebSessionResponse], error) {
+	return c.authenticateWebSession.CallUnary(ctx, req)
+}
+
+// RefreshWebSession calls holomush.core.v1.CoreService.RefreshWebSession.
+func (c *coreServiceClient) RefreshWebSession(ctx context.Context, req *connect.Request[v1.RefreshWebSessionRequest]) (*connect.Response[v1.RefreshWebSessionResponse], error) {
+	return c.refreshWebSession.CallUnary(ctx, req)
+}
+
 // SelectCharacter calls holomush.core.v1.CoreService.SelectCharacter.
 func (c *coreServiceClient) SelectCharacter(ctx context.Context, req *connect.Request[v1.SelectCharacterRequest]) (*connect.Response[v1.SelectCharacterResponse], error) {
 	return c.selectCharacter.CallUnary(ctx, req)
 }
 
+// ResumeSession calls holomush.core.v1.CoreService.ResumeSession.
+func (c *coreServiceClient) ResumeSession(ctx context.Context, req *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error) {
+	return c.resumeSession.CallUnary(ctx, req)
+}
+
 // CreatePlayer calls holomush.core.v1.CoreService.CreatePlayer.
 func (c *coreServiceClient) CreatePlayer(ctx context.Context, req *connect.Request[v1.CreatePlayerRequest]) (*connect.Response[v1.CreatePlayerResponse], error) {
 	return c.createPlayer.CallUnary(ctx, req)
@@ -491,6 +663,26 @@ func (c *coreServiceClient) RevokeOtherPlayerSessions(ctx context.Context, req *
 	return c.revokeOtherPlayerSessions.CallUnary(ctx, req)
 }
 
+// LinkIdentity calls holomush.core.v1.CoreService.LinkIdentity.
+func (c *coreServiceClient) LinkIdentity(ctx context.Context, req *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error) {
+	return c.linkIdentity.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls holomush.core.v1.CoreService.UnlinkIdentity.
+func (c *coreServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// ListIdentities calls holomush.core.v1.CoreService.ListIdentities.
+func (c *coreServiceClient) ListIdentities(ctx context.Context, req *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return c.listIdentities.CallUnary(ctx, req)
+}
+
+// ImpersonatePlayer calls holomush.core.v1.CoreService.ImpersonatePlayer.
+func (c *coreServiceClient) ImpersonatePlayer(ctx context.Context, req *connect.Request[v1.ImpersonatePlayerRequest]) (*connect.Response[v1.ImpersonatePlayerResponse], error) {
+	return c.impersonatePlayer.CallUnary(ctx, req)
+}
+
 // QueryStreamHistory calls holomush.core.v1.CoreService.QueryStreamHistory.
 func (c *coreServiceClient) QueryStreamHistory(ctx context.Context, req *connect.Request[v1.QueryStreamHistoryRequest]) (*connect.Response[v1.QueryStreamHistoryResponse], error) {
 	return c.queryStreamHistory.CallUnary(ctx, req)
@@ -516,6 +708,16 @@ func (c *coreServiceClient) RefreshConnection(ctx context.Context, req *connect.
 	return c.refreshConnection.CallUnary(ctx, req)
 }
 
+// ReportInputFlood calls holomush.core.v1.CoreService.ReportInputFlood.
+func (c *coreServiceClient) ReportInputFlood(ctx context.Context, req *connect.Request[v1.ReportInputFloodRequest]) (*connect.Response[v1.ReportInputFloodResponse], error) {
+	return c.reportInputFlood.CallUnary(ctx, req)
+}
+
+// CheckAddressBan calls holomush.core.v1.CoreService.CheckAddressBan.
+func (c *coreServiceClient) CheckAddressBan(ctx context.Context, req *connect.Request[v1.CheckAddressBanRequest]) (*connect.Response[v1.CheckAddressBanResponse], error) {
+	return c.checkAddressBan.CallUnary(ctx, req)
+}
+
 // CoreServiceHandler is an implementation of the holomush.core.v1.CoreService service.
 type CoreServiceHandler interface {
 	// HandleCommand validates session ownership, records the command in session
@@ -544,11 +746,32 @@ type CoreServiceHandler interface {
 	// returns the bearer token plus the player's character roster. No game session
 	// exists yet — that requires a follow-up SelectCharacter call.
 	AuthenticatePlayer(context.Context, *connect.Request[v1.AuthenticatePlayerRequest]) (*connect.Response[v1.AuthenticatePlayerResponse], error)
+	// AuthenticateWithOIDC is phase one of two-phase login for a player who has
+	// linked an OpenID Connect identity with LinkIdentity. It verifies the
+	// provider-issued ID token in place of a password; bans, lockouts, and the
+	// session cap apply exactly as for AuthenticatePlayer. An identity that is
+	// not linked never creates an account.
+	AuthenticateWithOIDC(context.Context, *connect.Request[v1.AuthenticateWithOIDCRequest]) (*connect.Response[v1.AuthenticateWithOIDCResponse], error)
+	// AuthenticateWebSession is AuthenticatePlayer for browser clients: the
+	// PlayerSession it mints carries a short-lived access token, used as the
+	// player_session_token of later RPCs, and a single-use refresh token that
+	// RefreshWebSession trades for a new pair.
+	AuthenticateWebSession(context.Context, *connect.Request[v1.AuthenticateWebSessionRequest]) (*connect.Response[v1.AuthenticateWebSessionResponse], error)
+	// RefreshWebSession trades a web session's refresh token for a new access
+	// and refresh token pair, sliding the session's expiry forward. Presenting a
+	// refresh token that was already traded revokes the session.
+	RefreshWebSession(context.Context, *connect.Request[v1.RefreshWebSessionRequest]) (*connect.Response[v1.RefreshWebSessionResponse], error)
 	// SelectCharacter is phase two of two-phase login: given a valid player session
 	// token, it reattaches an existing detached game session (preserving scrollback)
 	// or creates a fresh one for the chosen character, emitting an arrive event.
 	// The character must belong to the authenticated player.
 	SelectCharacter(context.Context, *connect.Request[v1.SelectCharacterRequest]) (*connect.Response[v1.SelectCharacterResponse], error)
+	// ResumeSession redeems a reconnect token issued by SelectCharacter: a
+	// client whose connection dropped resumes its lingering game session without
+	// logging in again, and the next Subscribe replays the output it missed.
+	// Both the reconnect token and the player session token are rotated, so a
+	// token resumes at most once.
+	ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error)
 	// CreatePlayer registers a new player account and immediately returns a player
 	// session token (the new account is logged in). The returned character roster
 	// is empty — a freshly created player has no characters until CreateCharacter.
@@ -603,6 +826,26 @@ type CoreServiceHandler interface {
 	// the current one. Convenience bulk operation equivalent to listing and calling
 	// RevokePlayerSession for each — useful after a suspected compromise.
 	RevokeOtherPlayerSessions(context.Context, *connect.Request[v1.RevokeOtherPlayerSessionsRequest]) (*connect.Response[v1.RevokeOtherPlayerSessionsResponse], error)
+	// LinkIdentity links the OpenID Connect identity an ID token asserts to the
+	// caller's player so it can sign in with AuthenticateWithOIDC. The player's
+	// current password is required, and a wrong one counts toward the account
+	// lockout. Impersonation sessions are refused. The link is recorded in the
+	// player's security log.
+	LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error)
+	// UnlinkIdentity removes the caller's linked identity for a provider. The
+	// only sign-in method of an account without a password is kept.
+	// Impersonation sessions are refused. The removal is recorded in the
+	// player's security log.
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// ListIdentities returns the OpenID Connect identities linked to the
+	// caller's player, oldest first.
+	ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error)
+	// ImpersonatePlayer opens a session as another player on behalf of a staff
+	// member holding the support.impersonate grant. The session is time-boxed,
+	// recorded in both players' security logs, and announced to the target at
+	// their next login. The caller uses the returned token exactly like one
+	// from AuthenticatePlayer.
+	ImpersonatePlayer(context.Context, *connect.Request[v1.ImpersonatePlayerRequest]) (*connect.Response[v1.ImpersonatePlayerResponse], error)
 	// QueryStreamHistory reads paginated event history from a single stream. It is
 	// a pure read that does NOT mutate session cursors (invariant I-13). Two-layer
 	// authorization applies: private streams (character / scene) use a hard
@@ -633,6 +876,17 @@ type CoreServiceHandler interface {
 	// by the gateway while the client socket is open (holomush-rsoe6). SERVED by
 	// CoreServer.RefreshConnection; ownership-validated and enumeration-safe.
 	RefreshConnection(context.Context, *connect.Request[v1.RefreshConnectionRequest]) (*connect.Response[v1.RefreshConnectionResponse], error)
+	// ReportInputFlood records that a gateway's input flood protection escalated
+	// against a connection (warned, throttled, or disconnected a client sending
+	// commands too fast), publishing a moderation event for staff review. SERVED
+	// by CoreServer.ReportInputFlood; ownership-validated and enumeration-safe.
+	ReportInputFlood(context.Context, *connect.Request[v1.ReportInputFloodRequest]) (*connect.Response[v1.ReportInputFloodResponse], error)
+	// CheckAddressBan reports whether a client address is banned, so a gateway
+	// can refuse a connection before showing any prompt. SERVED by
+	// CoreServer.CheckAddressBan, delegating to bans.Service. Login enforces the
+	// same bans, so a client a gateway admits on a failed check still cannot
+	// sign in.
+	CheckAddressBan(context.Context, *connect.Request[v1.CheckAddressBanRequest]) (*connect.Response[v1.CheckAddressBanResponse], error)
 }
 
 // NewCoreServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -672,12 +926,36 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("AuthenticatePlayer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceAuthenticateWithOIDCHandler := connect.NewUnaryHandler(
+		CoreServiceAuthenticateWithOIDCProcedure,
+		svc.AuthenticateWithOIDC,
+		connect.WithSchema(coreServiceMethods.ByName("AuthenticateWithOIDC")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceAuthenticateWebSessionHandler := connect.NewUnaryHandler(
+		CoreServiceAuthenticateWebSessionProcedure,
+		svc.AuthenticateWebSession,
+		connect.WithSchema(coreServiceMethods.ByName("AuthenticateWebSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceRefreshWebSessionHandler := connect.NewUnaryHandler(
+		CoreServiceRefreshWebSessionProcedure,
+		svc.RefreshWebSession,
+		connect.WithSchema(coreServiceMethods.ByName("RefreshWebSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceSelectCharacterHandler := connect.NewUnaryHandler(
 		CoreServiceSelectCharacterProcedure,
 		svc.SelectCharacter,
 		connect.WithSchema(coreServiceMethods.ByName("SelectCharacter")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceResumeSessionHandler := connect.NewUnaryHandler(
+		CoreServiceResumeSessionProcedure,
+		svc.ResumeSession,
+		connect.WithSchema(coreServiceMethods.ByName("ResumeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceCreatePlayerHandler := connect.NewUnaryHandler(
 		CoreServiceCreatePlayerProcedure,
 		svc.CreatePlayer,
@@ -750,6 +1028,30 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("RevokeOtherPlayerSessions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceLinkIdentityHandler := connect.NewUnaryHandler(
+		CoreServiceLinkIdentityProcedure,
+		svc.LinkIdentity,
+		connect.WithSchema(coreServiceMethods.ByName("LinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		CoreServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(coreServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceListIdentitiesHandler := connect.NewUnaryHandler(
+		CoreServiceListIdentitiesProcedure,
+		svc.ListIdentities,
+		connect.WithSchema(coreServiceMethods.ByName("ListIdentities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceImpersonatePlayerHandler := connect.NewUnaryHandler(
+		CoreServiceImpersonatePlayerProcedure,
+		svc.ImpersonatePlayer,
+		connect.WithSchema(coreServiceMethods.ByName("ImpersonatePlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceQueryStreamHistoryHandler := connect.NewUnaryHandler(
 		CoreServiceQueryStreamHistoryProcedure,
 		svc.QueryStreamHistory,
@@ -780,6 +1082,18 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("RefreshConnection")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceReportInputFloodHandler := connect.NewUnaryHandler(
+		CoreServiceReportInputFloodProcedure,
+		svc.ReportInputFlood,
+		connect.WithSchema(coreServiceMethods.ByName("ReportInputFlood")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceCheckAddressBanHandler := connect.NewUnaryHandler(
+		CoreServiceCheckAddressBanProcedure,
+		svc.CheckAddressBan,
+		connect.WithSchema(coreServiceMethods.ByName("CheckAddressBan")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/holomush.core.v1.CoreService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CoreServiceHandleCommandProcedure:
@@ -792,8 +1106,16 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceGetCommandHistoryHandler.ServeHTTP(w, r)
 		case CoreServiceAuthenticatePlayerProcedure:
 			coreServiceAuthenticatePlayerHandler.ServeHTTP(w, r)
+		case CoreServiceAuthenticateWithOIDCProcedure:
+			coreServiceAuthenticateWithOIDCHandler.ServeHTTP(w, r)
+		case CoreServiceAuthenticateWebSessionProcedure:
+			coreServiceAuthenticateWebSessionHandler.ServeHTTP(w, r)
+		case CoreServiceRefreshWebSessionProcedure:
+			coreServiceRefreshWebSessionHandler.ServeHTTP(w, r)
 		case CoreServiceSelectCharacterProcedure:
 			coreServiceSelectCharacterHandler.ServeHTTP(w, r)
+		case CoreServiceResumeSessionProcedure:
+			coreServiceResumeSessionHandler.ServeHTTP(w, r)
 		case CoreServiceCreatePlayerProcedure:
 			coreServiceCreatePlayerHandler.ServeHTTP(w, r)
 		case CoreServiceCreateGuestProcedure:
@@ -818,6 +1140,14 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceRevokePlayerSessionHandler.ServeHTTP(w, r)
 		case CoreServiceRevokeOtherPlayerSessionsProcedure:
 			coreServiceRevokeOtherPlayerSessionsHandler.ServeHTTP(w, r)
+		case CoreServiceLinkIdentityProcedure:
+			coreServiceLinkIdentityHandler.ServeHTTP(w, r)
+		case CoreServiceUnlinkIdentityProcedure:
+			coreServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case CoreServiceListIdentitiesProcedure:
+			coreServiceListIdentitiesHandler.ServeHTTP(w, r)
+		case CoreServiceImpersonatePlayerProcedure:
+			coreServiceImpersonatePlayerHandler.ServeHTTP(w, r)
 		case CoreServiceQueryStreamHistoryProcedure:
 			coreServiceQueryStreamHistoryHandler.ServeHTTP(w, r)
 		case CoreServiceListSessionStreamsProcedure:
@@ -828,6 +1158,10 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceListAvailableCommandsHandler.ServeHTTP(w, r)
 		case CoreServiceRefreshConnectionProcedure:
 			coreServiceRefreshConnectionHandler.ServeHTTP(w, r)
+		case CoreServiceReportInputFloodProcedure:
+			coreServiceReportInputFloodHandler.ServeHTTP(w, r)
+		case CoreServiceCheckAddressBanProcedure:
+			coreServiceCheckAddressBanHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -857,10 +1191,26 @@ func (UnimplementedCoreServiceHandler) AuthenticatePlayer(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.AuthenticatePlayer is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) AuthenticateWithOIDC(context.Context, *connect.Request[v1.AuthenticateWithOIDCRequest]) (*connect.Response[v1.AuthenticateWithOIDCResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.AuthenticateWithOIDC is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) AuthenticateWebSession(context.Context, *connect.Request[v1.AuthenticateWebSessionRequest]) (*connect.Response[v1.AuthenticateWebSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.AuthenticateWebSession is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) RefreshWebSession(context.Context, *connect.Request[v1.RefreshWebSessionRequest]) (*connect.Response[v1.RefreshWebSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.RefreshWebSession is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) SelectCharacter(context.Context, *connect.Request[v1.SelectCharacterRequest]) (*connect.Response[v1.SelectCharacterResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.SelectCharacter is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) ResumeSession(context.Context, *connect.Request[v1.ResumeSessionRequest]) (*connect.Response[v1.ResumeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.ResumeSession is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) CreatePlayer(context.Context, *connect.Request[v1.CreatePlayerRequest]) (*connect.Response[v1.CreatePlayerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.CreatePlayer is not implemented"))
 }
@@ -909,6 +1259,22 @@ func (UnimplementedCoreServiceHandler) RevokeOtherPlayerSessions(context.Context
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.RevokeOtherPlayerSessions is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) LinkIdentity(context.Context, *connect.Request[v1.LinkIdentityRequest]) (*connect.Response[v1.LinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.LinkIdentity is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) ListIdentities(context.Context, *connect.Request[v1.ListIdentitiesRequest]) (*connect.Response[v1.ListIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.ListIdentities is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) ImpersonatePlayer(context.Context, *connect.Request[v1.ImpersonatePlayerRequest]) (*connect.Response[v1.ImpersonatePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.ImpersonatePlayer is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) QueryStreamHistory(context.Context, *connect.Request[v1.QueryStreamHistoryRequest]) (*connect.Response[v1.QueryStreamHistoryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.QueryStreamHistory is not implemented"))
 }
@@ -928,3 +1294,11 @@ func (UnimplementedCoreServiceHandler) ListAvailableCommands(context.Context, *c
 func (UnimplementedCoreServiceHandler) RefreshConnection(context.Context, *connect.Request[v1.RefreshConnectionRequest]) (*connect.Response[v1.RefreshConnectionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.RefreshConnection is not implemented"))
 }
+
+func (UnimplementedCoreServiceHandler) ReportInputFlood(context.Context, *connect.Request[v1.ReportInputFloodRequest]) (*connect.Response[v1.ReportInputFloodResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.ReportInputFlood is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) CheckAddressBan(context.Context, *connect.Request[v1.CheckAddressBanRequest]) (*connect.Response[v1.CheckAddressBanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.core.v1.CoreService.CheckAddressBan is not implemented"))
+}
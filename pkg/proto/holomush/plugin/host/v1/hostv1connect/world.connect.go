@@ -50,6 +50,9 @@ const (
 	// WorldQueryServiceQueryObjectProcedure is the fully-qualified name of the WorldQueryService's
 	// QueryObject RPC.
 	WorldQueryServiceQueryObjectProcedure = "/holomush.plugin.host.v1.WorldQueryService/QueryObject"
+	// WorldQueryServiceQueryExitsProcedure is the fully-qualified name of the WorldQueryService's
+	// QueryExits RPC.
+	WorldQueryServiceQueryExitsProcedure = "/holomush.plugin.host.v1.WorldQueryService/QueryExits"
 	// WorldQueryServiceFindLocationProcedure is the fully-qualified name of the WorldQueryService's
 	// FindLocation RPC.
 	WorldQueryServiceFindLocationProcedure = "/holomush.plugin.host.v1.WorldQueryService/FindLocation"
@@ -77,7 +80,7 @@ type WorldQueryServiceClient interface {
 	// (WorldQuerierAdapter.GetCharacter).
 	QueryCharacter(context.Context, *connect.Request[v1.QueryCharacterRequest]) (*connect.Response[v1.QueryCharacterResponse], error)
 	// QueryLocationCharacters returns the lightweight (id, name) set of characters
-	// at a location, with optional limit/offset pagination, mirroring the Lua
+	// at a location, one cursor-paginated page at a time, mirroring the Lua
 	// holomush.query_location_characters(location_id, opts) host function
 	// (WorldQuerierAdapter.GetCharactersByLocation).
 	QueryLocationCharacters(context.Context, *connect.Request[v1.QueryLocationCharactersRequest]) (*connect.Response[v1.QueryLocationCharactersResponse], error)
@@ -86,6 +89,10 @@ type WorldQueryServiceClient interface {
 	// holomush.query_object(object_id) host function
 	// (WorldQuerierAdapter.GetObject).
 	QueryObject(context.Context, *connect.Request[v1.QueryObjectRequest]) (*connect.Response[v1.QueryObjectResponse], error)
+	// QueryExits returns the exits leading out of a location by ULID, filtered to
+	// those the acting character can see (WorldQuerierAdapter.GetExits). Each exit
+	// is the lightweight ExitSummary projection.
+	QueryExits(context.Context, *connect.Request[v1.QueryExitsRequest]) (*connect.Response[v1.QueryExitsResponse], error)
 	// FindLocation resolves a location by name within the calling plugin's
 	// subject scope, mirroring the Lua holomush.find_location(name) host function
 	// (worldMutator.FindLocationByName). Returns the matched location's id and
@@ -128,6 +135,12 @@ func NewWorldQueryServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(worldQueryServiceMethods.ByName("QueryObject")),
 			connect.WithClientOptions(opts...),
 		),
+		queryExits: connect.NewClient[v1.QueryExitsRequest, v1.QueryExitsResponse](
+			httpClient,
+			baseURL+WorldQueryServiceQueryExitsProcedure,
+			connect.WithSchema(worldQueryServiceMethods.ByName("QueryExits")),
+			connect.WithClientOptions(opts...),
+		),
 		findLocation: connect.NewClient[v1.FindLocationRequest, v1.FindLocationResponse](
 			httpClient,
 			baseURL+WorldQueryServiceFindLocationProcedure,
@@ -143,6 +156,7 @@ type worldQueryServiceClient struct {
 	queryCharacter          *connect.Client[v1.QueryCharacterRequest, v1.QueryCharacterResponse]
 	queryLocationCharacters *connect.Client[v1.QueryLocationCharactersRequest, v1.QueryLocationCharactersResponse]
 	queryObject             *connect.Client[v1.QueryObjectRequest, v1.QueryObjectResponse]
+	queryExits              *connect.Client[v1.QueryExitsRequest, v1.QueryExitsResponse]
 	findLocation            *connect.Client[v1.FindLocationRequest, v1.FindLocationResponse]
 }
 
@@ -166,6 +180,11 @@ func (c *worldQueryServiceClient) QueryObject(ctx context.Context, req *connect.
 	return c.queryObject.CallUnary(ctx, req)
 }
 
+// QueryExits calls holomush.plugin.host.v1.WorldQueryService.QueryExits.
+func (c *worldQueryServiceClient) QueryExits(ctx context.Context, req *connect.Request[v1.QueryExitsRequest]) (*connect.Response[v1.QueryExitsResponse], error) {
+	return c.queryExits.CallUnary(ctx, req)
+}
+
 // FindLocation calls holomush.plugin.host.v1.WorldQueryService.FindLocation.
 func (c *worldQueryServiceClient) FindLocation(ctx context.Context, req *connect.Request[v1.FindLocationRequest]) (*connect.Response[v1.FindLocationResponse], error) {
 	return c.findLocation.CallUnary(ctx, req)
@@ -185,7 +204,7 @@ type WorldQueryServiceHandler interface {
 	// (WorldQuerierAdapter.GetCharacter).
 	QueryCharacter(context.Context, *connect.Request[v1.QueryCharacterRequest]) (*connect.Response[v1.QueryCharacterResponse], error)
 	// QueryLocationCharacters returns the lightweight (id, name) set of characters
-	// at a location, with optional limit/offset pagination, mirroring the Lua
+	// at a location, one cursor-paginated page at a time, mirroring the Lua
 	// holomush.query_location_characters(location_id, opts) host function
 	// (WorldQuerierAdapter.GetCharactersByLocation).
 	QueryLocationCharacters(context.Context, *connect.Request[v1.QueryLocationCharactersRequest]) (*connect.Response[v1.QueryLocationCharactersResponse], error)
@@ -194,6 +213,10 @@ type WorldQueryServiceHandler interface {
 	// holomush.query_object(object_id) host function
 	// (WorldQuerierAdapter.GetObject).
 	QueryObject(context.Context, *connect.Request[v1.QueryObjectRequest]) (*connect.Response[v1.QueryObjectResponse], error)
+	// QueryExits returns the exits leading out of a location by ULID, filtered to
+	// those the acting character can see (WorldQuerierAdapter.GetExits). Each exit
+	// is the lightweight ExitSummary projection.
+	QueryExits(context.Context, *connect.Request[v1.QueryExitsRequest]) (*connect.Response[v1.QueryExitsResponse], error)
 	// FindLocation resolves a location by name within the calling plugin's
 	// subject scope, mirroring the Lua holomush.find_location(name) host function
 	// (worldMutator.FindLocationByName). Returns the matched location's id and
@@ -232,6 +255,12 @@ func NewWorldQueryServiceHandler(svc WorldQueryServiceHandler, opts ...connect.H
 		connect.WithSchema(worldQueryServiceMethods.ByName("QueryObject")),
 		connect.WithHandlerOptions(opts...),
 	)
+	worldQueryServiceQueryExitsHandler := connect.NewUnaryHandler(
+		WorldQueryServiceQueryExitsProcedure,
+		svc.QueryExits,
+		connect.WithSchema(worldQueryServiceMethods.ByName("QueryExits")),
+		connect.WithHandlerOptions(opts...),
+	)
 	worldQueryServiceFindLocationHandler := connect.NewUnaryHandler(
 		WorldQueryServiceFindLocationProcedure,
 		svc.FindLocation,
@@ -248,6 +277,8 @@ func NewWorldQueryServiceHandler(svc WorldQueryServiceHandler, opts ...connect.H
 			worldQueryServiceQueryLocationCharactersHandler.ServeHTTP(w, r)
 		case WorldQueryServiceQueryObjectProcedure:
 			worldQueryServiceQueryObjectHandler.ServeHTTP(w, r)
+		case WorldQueryServiceQueryExitsProcedure:
+			worldQueryServiceQueryExitsHandler.ServeHTTP(w, r)
 		case WorldQueryServiceFindLocationProcedure:
 			worldQueryServiceFindLocationHandler.ServeHTTP(w, r)
 		default:
@@ -275,6 +306,10 @@ func (UnimplementedWorldQueryServiceHandler) QueryObject(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.plugin.host.v1.WorldQueryService.QueryObject is not implemented"))
 }
 
+func (UnimplementedWorldQueryServiceHandler) QueryExits(context.Context, *connect.Request[v1.QueryExitsRequest]) (*connect.Response[v1.QueryExitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.plugin.host.v1.WorldQueryService.QueryExits is not implemented"))
+}
+
 func (UnimplementedWorldQueryServiceHandler) FindLocation(context.Context, *connect.Request[v1.FindLocationRequest]) (*connect.Response[v1.FindLocationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.plugin.host.v1.WorldQueryService.FindLocation is not implemented"))
 }
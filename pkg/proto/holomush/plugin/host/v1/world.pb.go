@@ -280,9 +280,15 @@ type QueryLocationCharactersRequest struct {
 	// ULID of the location whose characters are listed.
 	LocationId string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
 	// Maximum number of characters to return; 0 applies the host default (100).
+	// Larger values are capped at 1000.
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Number of leading results to skip for pagination; 0 starts at the first.
-	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Removed: offset pagination shifts under concurrent movement. A non-zero
+	// value is rejected with INVALID_ARGUMENT; use cursor instead.
+	//
+	// Deprecated: Marked as deprecated in holomush/plugin/host/v1/world.proto.
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// next_cursor from the previous response; empty starts at the first page.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -331,6 +337,7 @@ func (x *QueryLocationCharactersRequest) GetLimit() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in holomush/plugin/host/v1/world.proto.
 func (x *QueryLocationCharactersRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
@@ -338,6 +345,13 @@ func (x *QueryLocationCharactersRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *QueryLocationCharactersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 // CharacterSummary is the lightweight (id, name) projection returned for each
 // character at a location. Full detail requires QueryCharacter per id.
 type CharacterSummary struct {
@@ -397,8 +411,10 @@ func (x *CharacterSummary) GetName() string {
 // QueryLocationCharactersResponse returns the lightweight character list.
 type QueryLocationCharactersResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The characters present at the location, in store order.
-	Characters    []*CharacterSummary `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	// The characters present at the location, ordered by name then id.
+	Characters []*CharacterSummary `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	// Cursor for the next page; empty when no characters follow.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -440,6 +456,13 @@ func (x *QueryLocationCharactersResponse) GetCharacters() []*CharacterSummary {
 	return nil
 }
 
+func (x *QueryLocationCharactersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // QueryObjectRequest names the object to query by ULID.
 type QueryObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -605,6 +628,189 @@ func (x *QueryObjectResponse) GetContainmentType() string {
 	return ""
 }
 
+// QueryExitsRequest names the location whose outgoing exits are listed.
+type QueryExitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ULID of the source location.
+	LocationId    string `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExitsRequest) Reset() {
+	*x = QueryExitsRequest{}
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExitsRequest) ProtoMessage() {}
+
+func (x *QueryExitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExitsRequest.ProtoReflect.Descriptor instead.
+func (*QueryExitsRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryExitsRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+// ExitSummary is the read-only projection returned for each exit.
+type ExitSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ULID of the exit.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Primary name of the exit (e.g. "north").
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Alternate names the exit answers to.
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// ULID of the destination location.
+	ToLocationId string `protobuf:"bytes,4,opt,name=to_location_id,json=toLocationId,proto3" json:"to_location_id,omitempty"`
+	// Whether the exit is locked.
+	Locked bool `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`
+	// Visibility string (the world.Visibility value).
+	Visibility    string `protobuf:"bytes,6,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExitSummary) Reset() {
+	*x = ExitSummary{}
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExitSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitSummary) ProtoMessage() {}
+
+func (x *ExitSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitSummary.ProtoReflect.Descriptor instead.
+func (*ExitSummary) Descriptor() ([]byte, []int) {
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExitSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExitSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExitSummary) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *ExitSummary) GetToLocationId() string {
+	if x != nil {
+		return x.ToLocationId
+	}
+	return ""
+}
+
+func (x *ExitSummary) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *ExitSummary) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// QueryExitsResponse returns the exits leaving the location.
+type QueryExitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exits leaving the location, in store order.
+	Exits         []*ExitSummary `protobuf:"bytes,1,rep,name=exits,proto3" json:"exits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryExitsResponse) Reset() {
+	*x = QueryExitsResponse{}
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryExitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryExitsResponse) ProtoMessage() {}
+
+func (x *QueryExitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryExitsResponse.ProtoReflect.Descriptor instead.
+func (*QueryExitsResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryExitsResponse) GetExits() []*ExitSummary {
+	if x != nil {
+		return x.Exits
+	}
+	return nil
+}
+
 // FindLocationRequest names the location to resolve by display name.
 type FindLocationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -616,7 +822,7 @@ type FindLocationRequest struct {
 
 func (x *FindLocationRequest) Reset() {
 	*x = FindLocationRequest{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[9]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -628,7 +834,7 @@ func (x *FindLocationRequest) String() string {
 func (*FindLocationRequest) ProtoMessage() {}
 
 func (x *FindLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[9]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,7 +847,7 @@ func (x *FindLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindLocationRequest.ProtoReflect.Descriptor instead.
 func (*FindLocationRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{9}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FindLocationRequest) GetName() string {
@@ -664,7 +870,7 @@ type FindLocationResponse struct {
 
 func (x *FindLocationResponse) Reset() {
 	*x = FindLocationResponse{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[10]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -676,7 +882,7 @@ func (x *FindLocationResponse) String() string {
 func (*FindLocationResponse) ProtoMessage() {}
 
 func (x *FindLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[10]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +895,7 @@ func (x *FindLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindLocationResponse.ProtoReflect.Descriptor instead.
 func (*FindLocationResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{10}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FindLocationResponse) GetId() string {
@@ -721,7 +927,7 @@ type CreateLocationRequest struct {
 
 func (x *CreateLocationRequest) Reset() {
 	*x = CreateLocationRequest{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[11]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +939,7 @@ func (x *CreateLocationRequest) String() string {
 func (*CreateLocationRequest) ProtoMessage() {}
 
 func (x *CreateLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[11]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +952,7 @@ func (x *CreateLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLocationRequest.ProtoReflect.Descriptor instead.
 func (*CreateLocationRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{11}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateLocationRequest) GetName() string {
@@ -783,7 +989,7 @@ type CreateLocationResponse struct {
 
 func (x *CreateLocationResponse) Reset() {
 	*x = CreateLocationResponse{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[12]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1001,7 @@ func (x *CreateLocationResponse) String() string {
 func (*CreateLocationResponse) ProtoMessage() {}
 
 func (x *CreateLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[12]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1014,7 @@ func (x *CreateLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLocationResponse.ProtoReflect.Descriptor instead.
 func (*CreateLocationResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{12}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CreateLocationResponse) GetId() string {
@@ -845,7 +1051,7 @@ type CreateExitRequest struct {
 
 func (x *CreateExitRequest) Reset() {
 	*x = CreateExitRequest{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[13]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -857,7 +1063,7 @@ func (x *CreateExitRequest) String() string {
 func (*CreateExitRequest) ProtoMessage() {}
 
 func (x *CreateExitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[13]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,7 +1076,7 @@ func (x *CreateExitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExitRequest.ProtoReflect.Descriptor instead.
 func (*CreateExitRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{13}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CreateExitRequest) GetFromId() string {
@@ -921,7 +1127,7 @@ type CreateExitResponse struct {
 
 func (x *CreateExitResponse) Reset() {
 	*x = CreateExitResponse{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[14]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -933,7 +1139,7 @@ func (x *CreateExitResponse) String() string {
 func (*CreateExitResponse) ProtoMessage() {}
 
 func (x *CreateExitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[14]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1152,7 @@ func (x *CreateExitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateExitResponse.ProtoReflect.Descriptor instead.
 func (*CreateExitResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{14}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreateExitResponse) GetId() string {
@@ -988,7 +1194,7 @@ type CreateObjectRequest struct {
 
 func (x *CreateObjectRequest) Reset() {
 	*x = CreateObjectRequest{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[15]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1000,7 +1206,7 @@ func (x *CreateObjectRequest) String() string {
 func (*CreateObjectRequest) ProtoMessage() {}
 
 func (x *CreateObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[15]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +1219,7 @@ func (x *CreateObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateObjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateObjectRequest) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{15}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateObjectRequest) GetName() string {
@@ -1102,7 +1308,7 @@ type CreateObjectResponse struct {
 
 func (x *CreateObjectResponse) Reset() {
 	*x = CreateObjectResponse{}
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[16]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1320,7 @@ func (x *CreateObjectResponse) String() string {
 func (*CreateObjectResponse) ProtoMessage() {}
 
 func (x *CreateObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[16]
+	mi := &file_holomush_plugin_host_v1_world_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1333,7 @@ func (x *CreateObjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateObjectResponse.ProtoReflect.Descriptor instead.
 func (*CreateObjectResponse) Descriptor() ([]byte, []int) {
-	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{16}
+	return file_holomush_plugin_host_v1_world_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateObjectResponse) GetId() string {
@@ -1165,19 +1371,22 @@ const file_holomush_plugin_host_v1_world_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
 	"\vlocation_id\x18\x05 \x01(\tR\n" +
-	"locationId\"x\n" +
+	"locationId\"\x94\x01\n" +
 	"\x1eQueryLocationCharactersRequest\x12(\n" +
 	"\vlocation_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
 	"locationId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"6\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x03 \x01(\x05B\x02\x18\x01R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"6\n" +
 	"\x10CharacterSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"l\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x8d\x01\n" +
 	"\x1fQueryLocationCharactersResponse\x12I\n" +
 	"\n" +
 	"characters\x18\x01 \x03(\v2).holomush.plugin.host.v1.CharacterSummaryR\n" +
-	"characters\":\n" +
+	"characters\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\":\n" +
 	"\x12QueryObjectRequest\x12$\n" +
 	"\tobject_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bobjectId\"\xcb\x02\n" +
 	"\x13QueryObjectResponse\x12\x0e\n" +
@@ -1190,7 +1399,21 @@ const file_holomush_plugin_host_v1_world_proto_rawDesc = "" +
 	"\x14held_by_character_id\x18\x06 \x01(\tR\x11heldByCharacterId\x123\n" +
 	"\x16contained_in_object_id\x18\a \x01(\tR\x13containedInObjectId\x12\x19\n" +
 	"\bowner_id\x18\b \x01(\tR\aownerId\x12)\n" +
-	"\x10containment_type\x18\t \x01(\tR\x0fcontainmentType\"2\n" +
+	"\x10containment_type\x18\t \x01(\tR\x0fcontainmentType\"=\n" +
+	"\x11QueryExitsRequest\x12(\n" +
+	"\vlocation_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"locationId\"\xa9\x01\n" +
+	"\vExitSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12$\n" +
+	"\x0eto_location_id\x18\x04 \x01(\tR\ftoLocationId\x12\x16\n" +
+	"\x06locked\x18\x05 \x01(\bR\x06locked\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\tR\n" +
+	"visibility\"P\n" +
+	"\x12QueryExitsResponse\x12:\n" +
+	"\x05exits\x18\x01 \x03(\v2$.holomush.plugin.host.v1.ExitSummaryR\x05exits\"2\n" +
 	"\x13FindLocationRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\":\n" +
 	"\x14FindLocationResponse\x12\x0e\n" +
@@ -1223,12 +1446,14 @@ const file_holomush_plugin_host_v1_world_proto_rawDesc = "" +
 	"\tplacement\":\n" +
 	"\x14CreateObjectResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name2\xdc\x04\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name2\xc3\x05\n" +
 	"\x11WorldQueryService\x12n\n" +
 	"\rQueryLocation\x12-.holomush.plugin.host.v1.QueryLocationRequest\x1a..holomush.plugin.host.v1.QueryLocationResponse\x12q\n" +
 	"\x0eQueryCharacter\x12..holomush.plugin.host.v1.QueryCharacterRequest\x1a/.holomush.plugin.host.v1.QueryCharacterResponse\x12\x8c\x01\n" +
 	"\x17QueryLocationCharacters\x127.holomush.plugin.host.v1.QueryLocationCharactersRequest\x1a8.holomush.plugin.host.v1.QueryLocationCharactersResponse\x12h\n" +
-	"\vQueryObject\x12+.holomush.plugin.host.v1.QueryObjectRequest\x1a,.holomush.plugin.host.v1.QueryObjectResponse\x12k\n" +
+	"\vQueryObject\x12+.holomush.plugin.host.v1.QueryObjectRequest\x1a,.holomush.plugin.host.v1.QueryObjectResponse\x12e\n" +
+	"\n" +
+	"QueryExits\x12*.holomush.plugin.host.v1.QueryExitsRequest\x1a+.holomush.plugin.host.v1.QueryExitsResponse\x12k\n" +
 	"\fFindLocation\x12,.holomush.plugin.host.v1.FindLocationRequest\x1a-.holomush.plugin.host.v1.FindLocationResponse2\xdd\x02\n" +
 	"\x14WorldMutationService\x12q\n" +
 	"\x0eCreateLocation\x12..holomush.plugin.host.v1.CreateLocationRequest\x1a/.holomush.plugin.host.v1.CreateLocationResponse\x12e\n" +
@@ -1250,7 +1475,7 @@ func file_holomush_plugin_host_v1_world_proto_rawDescGZIP() []byte {
 	return file_holomush_plugin_host_v1_world_proto_rawDescData
 }
 
-var file_holomush_plugin_host_v1_world_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_holomush_plugin_host_v1_world_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_holomush_plugin_host_v1_world_proto_goTypes = []any{
 	(*QueryLocationRequest)(nil),            // 0: holomush.plugin.host.v1.QueryLocationRequest
 	(*QueryLocationResponse)(nil),           // 1: holomush.plugin.host.v1.QueryLocationResponse
@@ -1261,38 +1486,44 @@ var file_holomush_plugin_host_v1_world_proto_goTypes = []any{
 	(*QueryLocationCharactersResponse)(nil), // 6: holomush.plugin.host.v1.QueryLocationCharactersResponse
 	(*QueryObjectRequest)(nil),              // 7: holomush.plugin.host.v1.QueryObjectRequest
 	(*QueryObjectResponse)(nil),             // 8: holomush.plugin.host.v1.QueryObjectResponse
-	(*FindLocationRequest)(nil),             // 9: holomush.plugin.host.v1.FindLocationRequest
-	(*FindLocationResponse)(nil),            // 10: holomush.plugin.host.v1.FindLocationResponse
-	(*CreateLocationRequest)(nil),           // 11: holomush.plugin.host.v1.CreateLocationRequest
-	(*CreateLocationResponse)(nil),          // 12: holomush.plugin.host.v1.CreateLocationResponse
-	(*CreateExitRequest)(nil),               // 13: holomush.plugin.host.v1.CreateExitRequest
-	(*CreateExitResponse)(nil),              // 14: holomush.plugin.host.v1.CreateExitResponse
-	(*CreateObjectRequest)(nil),             // 15: holomush.plugin.host.v1.CreateObjectRequest
-	(*CreateObjectResponse)(nil),            // 16: holomush.plugin.host.v1.CreateObjectResponse
+	(*QueryExitsRequest)(nil),               // 9: holomush.plugin.host.v1.QueryExitsRequest
+	(*ExitSummary)(nil),                     // 10: holomush.plugin.host.v1.ExitSummary
+	(*QueryExitsResponse)(nil),              // 11: holomush.plugin.host.v1.QueryExitsResponse
+	(*FindLocationRequest)(nil),             // 12: holomush.plugin.host.v1.FindLocationRequest
+	(*FindLocationResponse)(nil),            // 13: holomush.plugin.host.v1.FindLocationResponse
+	(*CreateLocationRequest)(nil),           // 14: holomush.plugin.host.v1.CreateLocationRequest
+	(*CreateLocationResponse)(nil),          // 15: holomush.plugin.host.v1.CreateLocationResponse
+	(*CreateExitRequest)(nil),               // 16: holomush.plugin.host.v1.CreateExitRequest
+	(*CreateExitResponse)(nil),              // 17: holomush.plugin.host.v1.CreateExitResponse
+	(*CreateObjectRequest)(nil),             // 18: holomush.plugin.host.v1.CreateObjectRequest
+	(*CreateObjectResponse)(nil),            // 19: holomush.plugin.host.v1.CreateObjectResponse
 }
 var file_holomush_plugin_host_v1_world_proto_depIdxs = []int32{
 	5,  // 0: holomush.plugin.host.v1.QueryLocationCharactersResponse.characters:type_name -> holomush.plugin.host.v1.CharacterSummary
-	0,  // 1: holomush.plugin.host.v1.WorldQueryService.QueryLocation:input_type -> holomush.plugin.host.v1.QueryLocationRequest
-	2,  // 2: holomush.plugin.host.v1.WorldQueryService.QueryCharacter:input_type -> holomush.plugin.host.v1.QueryCharacterRequest
-	4,  // 3: holomush.plugin.host.v1.WorldQueryService.QueryLocationCharacters:input_type -> holomush.plugin.host.v1.QueryLocationCharactersRequest
-	7,  // 4: holomush.plugin.host.v1.WorldQueryService.QueryObject:input_type -> holomush.plugin.host.v1.QueryObjectRequest
-	9,  // 5: holomush.plugin.host.v1.WorldQueryService.FindLocation:input_type -> holomush.plugin.host.v1.FindLocationRequest
-	11, // 6: holomush.plugin.host.v1.WorldMutationService.CreateLocation:input_type -> holomush.plugin.host.v1.CreateLocationRequest
-	13, // 7: holomush.plugin.host.v1.WorldMutationService.CreateExit:input_type -> holomush.plugin.host.v1.CreateExitRequest
-	15, // 8: holomush.plugin.host.v1.WorldMutationService.CreateObject:input_type -> holomush.plugin.host.v1.CreateObjectRequest
-	1,  // 9: holomush.plugin.host.v1.WorldQueryService.QueryLocation:output_type -> holomush.plugin.host.v1.QueryLocationResponse
-	3,  // 10: holomush.plugin.host.v1.WorldQueryService.QueryCharacter:output_type -> holomush.plugin.host.v1.QueryCharacterResponse
-	6,  // 11: holomush.plugin.host.v1.WorldQueryService.QueryLocationCharacters:output_type -> holomush.plugin.host.v1.QueryLocationCharactersResponse
-	8,  // 12: holomush.plugin.host.v1.WorldQueryService.QueryObject:output_type -> holomush.plugin.host.v1.QueryObjectResponse
-	10, // 13: holomush.plugin.host.v1.WorldQueryService.FindLocation:output_type -> holomush.plugin.host.v1.FindLocationResponse
-	12, // 14: holomush.plugin.host.v1.WorldMutationService.CreateLocation:output_type -> holomush.plugin.host.v1.CreateLocationResponse
-	14, // 15: holomush.plugin.host.v1.WorldMutationService.CreateExit:output_type -> holomush.plugin.host.v1.CreateExitResponse
-	16, // 16: holomush.plugin.host.v1.WorldMutationService.CreateObject:output_type -> holomush.plugin.host.v1.CreateObjectResponse
-	9,  // [9:17] is the sub-list for method output_type
-	1,  // [1:9] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	10, // 1: holomush.plugin.host.v1.QueryExitsResponse.exits:type_name -> holomush.plugin.host.v1.ExitSummary
+	0,  // 2: holomush.plugin.host.v1.WorldQueryService.QueryLocation:input_type -> holomush.plugin.host.v1.QueryLocationRequest
+	2,  // 3: holomush.plugin.host.v1.WorldQueryService.QueryCharacter:input_type -> holomush.plugin.host.v1.QueryCharacterRequest
+	4,  // 4: holomush.plugin.host.v1.WorldQueryService.QueryLocationCharacters:input_type -> holomush.plugin.host.v1.QueryLocationCharactersRequest
+	7,  // 5: holomush.plugin.host.v1.WorldQueryService.QueryObject:input_type -> holomush.plugin.host.v1.QueryObjectRequest
+	9,  // 6: holomush.plugin.host.v1.WorldQueryService.QueryExits:input_type -> holomush.plugin.host.v1.QueryExitsRequest
+	12, // 7: holomush.plugin.host.v1.WorldQueryService.FindLocation:input_type -> holomush.plugin.host.v1.FindLocationRequest
+	14, // 8: holomush.plugin.host.v1.WorldMutationService.CreateLocation:input_type -> holomush.plugin.host.v1.CreateLocationRequest
+	16, // 9: holomush.plugin.host.v1.WorldMutationService.CreateExit:input_type -> holomush.plugin.host.v1.CreateExitRequest
+	18, // 10: holomush.plugin.host.v1.WorldMutationService.CreateObject:input_type -> holomush.plugin.host.v1.CreateObjectRequest
+	1,  // 11: holomush.plugin.host.v1.WorldQueryService.QueryLocation:output_type -> holomush.plugin.host.v1.QueryLocationResponse
+	3,  // 12: holomush.plugin.host.v1.WorldQueryService.QueryCharacter:output_type -> holomush.plugin.host.v1.QueryCharacterResponse
+	6,  // 13: holomush.plugin.host.v1.WorldQueryService.QueryLocationCharacters:output_type -> holomush.plugin.host.v1.QueryLocationCharactersResponse
+	8,  // 14: holomush.plugin.host.v1.WorldQueryService.QueryObject:output_type -> holomush.plugin.host.v1.QueryObjectResponse
+	11, // 15: holomush.plugin.host.v1.WorldQueryService.QueryExits:output_type -> holomush.plugin.host.v1.QueryExitsResponse
+	13, // 16: holomush.plugin.host.v1.WorldQueryService.FindLocation:output_type -> holomush.plugin.host.v1.FindLocationResponse
+	15, // 17: holomush.plugin.host.v1.WorldMutationService.CreateLocation:output_type -> holomush.plugin.host.v1.CreateLocationResponse
+	17, // 18: holomush.plugin.host.v1.WorldMutationService.CreateExit:output_type -> holomush.plugin.host.v1.CreateExitResponse
+	19, // 19: holomush.plugin.host.v1.WorldMutationService.CreateObject:output_type -> holomush.plugin.host.v1.CreateObjectResponse
+	11, // [11:20] is the sub-list for method output_type
+	2,  // [2:11] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_holomush_plugin_host_v1_world_proto_init() }
@@ -1300,7 +1531,7 @@ func file_holomush_plugin_host_v1_world_proto_init() {
 	if File_holomush_plugin_host_v1_world_proto != nil {
 		return
 	}
-	file_holomush_plugin_host_v1_world_proto_msgTypes[15].OneofWrappers = []any{
+	file_holomush_plugin_host_v1_world_proto_msgTypes[18].OneofWrappers = []any{
 		(*CreateObjectRequest_LocationId)(nil),
 		(*CreateObjectRequest_CharacterId)(nil),
 		(*CreateObjectRequest_ContainerId)(nil),
@@ -1311,7 +1542,7 @@ func file_holomush_plugin_host_v1_world_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holomush_plugin_host_v1_world_proto_rawDesc), len(file_holomush_plugin_host_v1_world_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -26,6 +26,7 @@ const (
 	WorldQueryService_QueryCharacter_FullMethodName          = "/holomush.plugin.host.v1.WorldQueryService/QueryCharacter"
 	WorldQueryService_QueryLocationCharacters_FullMethodName = "/holomush.plugin.host.v1.WorldQueryService/QueryLocationCharacters"
 	WorldQueryService_QueryObject_FullMethodName             = "/holomush.plugin.host.v1.WorldQueryService/QueryObject"
+	WorldQueryService_QueryExits_FullMethodName              = "/holomush.plugin.host.v1.WorldQueryService/QueryExits"
 	WorldQueryService_FindLocation_FullMethodName            = "/holomush.plugin.host.v1.WorldQueryService/FindLocation"
 )
 
@@ -51,7 +52,7 @@ type WorldQueryServiceClient interface {
 	// (WorldQuerierAdapter.GetCharacter).
 	QueryCharacter(ctx context.Context, in *QueryCharacterRequest, opts ...grpc.CallOption) (*QueryCharacterResponse, error)
 	// QueryLocationCharacters returns the lightweight (id, name) set of characters
-	// at a location, with optional limit/offset pagination, mirroring the Lua
+	// at a location, one cursor-paginated page at a time, mirroring the Lua
 	// holomush.query_location_characters(location_id, opts) host function
 	// (WorldQuerierAdapter.GetCharactersByLocation).
 	QueryLocationCharacters(ctx context.Context, in *QueryLocationCharactersRequest, opts ...grpc.CallOption) (*QueryLocationCharactersResponse, error)
@@ -60,6 +61,10 @@ type WorldQueryServiceClient interface {
 	// holomush.query_object(object_id) host function
 	// (WorldQuerierAdapter.GetObject).
 	QueryObject(ctx context.Context, in *QueryObjectRequest, opts ...grpc.CallOption) (*QueryObjectResponse, error)
+	// QueryExits returns the exits leading out of a location by ULID, filtered to
+	// those the acting character can see (WorldQuerierAdapter.GetExits). Each exit
+	// is the lightweight ExitSummary projection.
+	QueryExits(ctx context.Context, in *QueryExitsRequest, opts ...grpc.CallOption) (*QueryExitsResponse, error)
 	// FindLocation resolves a location by name within the calling plugin's
 	// subject scope, mirroring the Lua holomush.find_location(name) host function
 	// (worldMutator.FindLocationByName). Returns the matched location's id and
@@ -115,6 +120,16 @@ func (c *worldQueryServiceClient) QueryObject(ctx context.Context, in *QueryObje
 	return out, nil
 }
 
+func (c *worldQueryServiceClient) QueryExits(ctx context.Context, in *QueryExitsRequest, opts ...grpc.CallOption) (*QueryExitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExitsResponse)
+	err := c.cc.Invoke(ctx, WorldQueryService_QueryExits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *worldQueryServiceClient) FindLocation(ctx context.Context, in *FindLocationRequest, opts ...grpc.CallOption) (*FindLocationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FindLocationResponse)
@@ -147,7 +162,7 @@ type WorldQueryServiceServer interface {
 	// (WorldQuerierAdapter.GetCharacter).
 	QueryCharacter(context.Context, *QueryCharacterRequest) (*QueryCharacterResponse, error)
 	// QueryLocationCharacters returns the lightweight (id, name) set of characters
-	// at a location, with optional limit/offset pagination, mirroring the Lua
+	// at a location, one cursor-paginated page at a time, mirroring the Lua
 	// holomush.query_location_characters(location_id, opts) host function
 	// (WorldQuerierAdapter.GetCharactersByLocation).
 	QueryLocationCharacters(context.Context, *QueryLocationCharactersRequest) (*QueryLocationCharactersResponse, error)
@@ -156,6 +171,10 @@ type WorldQueryServiceServer interface {
 	// holomush.query_object(object_id) host function
 	// (WorldQuerierAdapter.GetObject).
 	QueryObject(context.Context, *QueryObjectRequest) (*QueryObjectResponse, error)
+	// QueryExits returns the exits leading out of a location by ULID, filtered to
+	// those the acting character can see (WorldQuerierAdapter.GetExits). Each exit
+	// is the lightweight ExitSummary projection.
+	QueryExits(context.Context, *QueryExitsRequest) (*QueryExitsResponse, error)
 	// FindLocation resolves a location by name within the calling plugin's
 	// subject scope, mirroring the Lua holomush.find_location(name) host function
 	// (worldMutator.FindLocationByName). Returns the matched location's id and
@@ -183,6 +202,9 @@ func (UnimplementedWorldQueryServiceServer) QueryLocationCharacters(context.Cont
 func (UnimplementedWorldQueryServiceServer) QueryObject(context.Context, *QueryObjectRequest) (*QueryObjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryObject not implemented")
 }
+func (UnimplementedWorldQueryServiceServer) QueryExits(context.Context, *QueryExitsRequest) (*QueryExitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryExits not implemented")
+}
 func (UnimplementedWorldQueryServiceServer) FindLocation(context.Context, *FindLocationRequest) (*FindLocationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindLocation not implemented")
 }
@@ -279,6 +301,24 @@ func _WorldQueryService_QueryObject_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorldQueryService_QueryExits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorldQueryServiceServer).QueryExits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorldQueryService_QueryExits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorldQueryServiceServer).QueryExits(ctx, req.(*QueryExitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorldQueryService_FindLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindLocationRequest)
 	if err := dec(in); err != nil {
@@ -320,6 +360,10 @@ var WorldQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryObject",
 			Handler:    _WorldQueryService_QueryObject_Handler,
 		},
+		{
+			MethodName: "QueryExits",
+			Handler:    _WorldQueryService_QueryExits_Handler,
+		},
 		{
 			MethodName: "FindLocation",
 			Handler:    _WorldQueryService_FindLocation_Handler,
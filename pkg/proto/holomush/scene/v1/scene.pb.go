@@ -4427,6 +4427,1086 @@ func (x *ExportSceneLogResponse) GetFilename() string {
 	return ""
 }
 
+// ExportTranscriptRequest asks for a scene's IC+OOC transcript rendered to a
+// downloadable document. ABAC-gated; see ExportTranscript.
+type ExportTranscriptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exporting character; required; must be a participant or staff.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The scene to export; required. Works for active, paused, and ended scenes.
+	SceneId string `protobuf:"bytes,2,opt,name=scene_id,json=sceneId,proto3" json:"scene_id,omitempty"`
+	// The render format; required: "text", "html", or "json".
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// When true, OOC lines are omitted from the transcript.
+	RedactOoc     bool `protobuf:"varint,4,opt,name=redact_ooc,json=redactOoc,proto3" json:"redact_ooc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptRequest) Reset() {
+	*x = ExportTranscriptRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptRequest) ProtoMessage() {}
+
+func (x *ExportTranscriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptRequest.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ExportTranscriptRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ExportTranscriptRequest) GetSceneId() string {
+	if x != nil {
+		return x.SceneId
+	}
+	return ""
+}
+
+func (x *ExportTranscriptRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportTranscriptRequest) GetRedactOoc() bool {
+	if x != nil {
+		return x.RedactOoc
+	}
+	return false
+}
+
+// ExportTranscriptResponse carries the rendered transcript and download
+// metadata.
+type ExportTranscriptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rendered document bytes.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// The content's MIME type (text/plain, text/html, or application/json).
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// Suggested download filename (slugified title + "-transcript" + extension).
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTranscriptResponse) Reset() {
+	*x = ExportTranscriptResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTranscriptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTranscriptResponse) ProtoMessage() {}
+
+func (x *ExportTranscriptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTranscriptResponse.ProtoReflect.Descriptor instead.
+func (*ExportTranscriptResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ExportTranscriptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportTranscriptResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExportTranscriptResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// SceneTurns is the wire projection of a scene's turn tracker.
+type SceneTurns struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Character IDs in turn order.
+	Queue []string `protobuf:"bytes,1,rep,name=queue,proto3" json:"queue,omitempty"`
+	// The character whose turn it is; empty when the queue is empty.
+	CurrentCharacterId string `protobuf:"bytes,2,opt,name=current_character_id,json=currentCharacterId,proto3" json:"current_character_id,omitempty"`
+	// The character who goes after the current one; empty when the queue has
+	// fewer than two entries.
+	NextCharacterId string `protobuf:"bytes,3,opt,name=next_character_id,json=nextCharacterId,proto3" json:"next_character_id,omitempty"`
+	// The 1-based round; it increments each time the turn wraps to the head of
+	// the queue.
+	Round uint32 `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	// When the current turn began; unset when the queue is empty.
+	TurnStartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=turn_started_at,json=turnStartedAt,proto3" json:"turn_started_at,omitempty"`
+	// When the current turn times out and passes to the next character; unset
+	// when the queue is empty or turn timeouts are disabled.
+	TurnDeadline  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=turn_deadline,json=turnDeadline,proto3" json:"turn_deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SceneTurns) Reset() {
+	*x = SceneTurns{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SceneTurns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SceneTurns) ProtoMessage() {}
+
+func (x *SceneTurns) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SceneTurns.ProtoReflect.Descriptor instead.
+func (*SceneTurns) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SceneTurns) GetQueue() []string {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *SceneTurns) GetCurrentCharacterId() string {
+	if x != nil {
+		return x.CurrentCharacterId
+	}
+	return ""
+}
+
+func (x *SceneTurns) GetNextCharacterId() string {
+	if x != nil {
+		return x.NextCharacterId
+	}
+	return ""
+}
+
+func (x *SceneTurns) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *SceneTurns) GetTurnStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TurnStartedAt
+	}
+	return nil
+}
+
+func (x *SceneTurns) GetTurnDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TurnDeadline
+	}
+	return nil
+}
+
+// GetSceneTurnsRequest asks for a scene's turn tracker.
+type GetSceneTurnsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requesting character; MUST be an owner or member of the scene.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The scene whose tracker to read; required.
+	SceneId       string `protobuf:"bytes,2,opt,name=scene_id,json=sceneId,proto3" json:"scene_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSceneTurnsRequest) Reset() {
+	*x = GetSceneTurnsRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSceneTurnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSceneTurnsRequest) ProtoMessage() {}
+
+func (x *GetSceneTurnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSceneTurnsRequest.ProtoReflect.Descriptor instead.
+func (*GetSceneTurnsRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetSceneTurnsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GetSceneTurnsRequest) GetSceneId() string {
+	if x != nil {
+		return x.SceneId
+	}
+	return ""
+}
+
+// GetSceneTurnsResponse carries the scene's turn tracker.
+type GetSceneTurnsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tracker state.
+	Turns         *SceneTurns `protobuf:"bytes,1,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSceneTurnsResponse) Reset() {
+	*x = GetSceneTurnsResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSceneTurnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSceneTurnsResponse) ProtoMessage() {}
+
+func (x *GetSceneTurnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSceneTurnsResponse.ProtoReflect.Descriptor instead.
+func (*GetSceneTurnsResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetSceneTurnsResponse) GetTurns() *SceneTurns {
+	if x != nil {
+		return x.Turns
+	}
+	return nil
+}
+
+// UpdateSceneTurnsRequest applies one turn-tracker operation.
+type UpdateSceneTurnsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The acting character; MUST be an owner or member of the scene.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The scene whose tracker to update; required.
+	SceneId string `protobuf:"bytes,2,opt,name=scene_id,json=sceneId,proto3" json:"scene_id,omitempty"`
+	// The operation: "add", "remove", or "skip".
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// The character to add or remove; empty means the acting character.
+	// Ignored by "skip", which always passes the current turn.
+	TargetCharacterId string `protobuf:"bytes,4,opt,name=target_character_id,json=targetCharacterId,proto3" json:"target_character_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateSceneTurnsRequest) Reset() {
+	*x = UpdateSceneTurnsRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSceneTurnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSceneTurnsRequest) ProtoMessage() {}
+
+func (x *UpdateSceneTurnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSceneTurnsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSceneTurnsRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateSceneTurnsRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *UpdateSceneTurnsRequest) GetSceneId() string {
+	if x != nil {
+		return x.SceneId
+	}
+	return ""
+}
+
+func (x *UpdateSceneTurnsRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *UpdateSceneTurnsRequest) GetTargetCharacterId() string {
+	if x != nil {
+		return x.TargetCharacterId
+	}
+	return ""
+}
+
+// UpdateSceneTurnsResponse carries the tracker state after the operation.
+type UpdateSceneTurnsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tracker state.
+	Turns         *SceneTurns `protobuf:"bytes,1,opt,name=turns,proto3" json:"turns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSceneTurnsResponse) Reset() {
+	*x = UpdateSceneTurnsResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSceneTurnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSceneTurnsResponse) ProtoMessage() {}
+
+func (x *UpdateSceneTurnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSceneTurnsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSceneTurnsResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateSceneTurnsResponse) GetTurns() *SceneTurns {
+	if x != nil {
+		return x.Turns
+	}
+	return nil
+}
+
+// SceneRsvp is one character's answer to a scheduled scene.
+type SceneRsvp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The answering character.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// "yes", "maybe", or "no".
+	Response      string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SceneRsvp) Reset() {
+	*x = SceneRsvp{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SceneRsvp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SceneRsvp) ProtoMessage() {}
+
+func (x *SceneRsvp) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SceneRsvp.ProtoReflect.Descriptor instead.
+func (*SceneRsvp) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SceneRsvp) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SceneRsvp) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// ScheduledScene is the wire projection of a scheduled scene.
+type ScheduledScene struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule ID; the opened scene reuses it as its scene ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Scene title.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Optional synopsis.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional world location the scene is anchored to.
+	LocationId string `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// The scheduling character (the GM), who owns the scene once it opens.
+	OwnerId string `protobuf:"bytes,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// Content advisories carried onto the opened scene.
+	ContentWarnings []string `protobuf:"bytes,6,rep,name=content_warnings,json=contentWarnings,proto3" json:"content_warnings,omitempty"`
+	// When the scene opens.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// "scheduled", "opened", or "cancelled".
+	State string `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	// Every RSVP, in the order they were first given.
+	Rsvps         []*SceneRsvp `protobuf:"bytes,9,rep,name=rsvps,proto3" json:"rsvps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledScene) Reset() {
+	*x = ScheduledScene{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledScene) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledScene) ProtoMessage() {}
+
+func (x *ScheduledScene) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledScene.ProtoReflect.Descriptor instead.
+func (*ScheduledScene) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ScheduledScene) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetContentWarnings() []string {
+	if x != nil {
+		return x.ContentWarnings
+	}
+	return nil
+}
+
+func (x *ScheduledScene) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduledScene) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ScheduledScene) GetRsvps() []*SceneRsvp {
+	if x != nil {
+		return x.Rsvps
+	}
+	return nil
+}
+
+// ScheduleSceneRequest is the scheduled-scene definition. The calling
+// character becomes the owner.
+type ScheduleSceneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scheduling character, who becomes the scene owner; required.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Scene title; required, 1-200 chars (whitespace-only also rejected by the
+	// handler's post-trim check).
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Optional synopsis, up to 4096 chars.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional world location to anchor the scene to.
+	LocationId string `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// When the scene opens; required, and MUST be in the future.
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// Content advisories, max 32.
+	ContentWarnings []string `protobuf:"bytes,6,rep,name=content_warnings,json=contentWarnings,proto3" json:"content_warnings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScheduleSceneRequest) Reset() {
+	*x = ScheduleSceneRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSceneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSceneRequest) ProtoMessage() {}
+
+func (x *ScheduleSceneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSceneRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleSceneRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ScheduleSceneRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ScheduleSceneRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ScheduleSceneRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduleSceneRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ScheduleSceneRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduleSceneRequest) GetContentWarnings() []string {
+	if x != nil {
+		return x.ContentWarnings
+	}
+	return nil
+}
+
+// ScheduleSceneResponse carries the new scheduled scene.
+type ScheduleSceneResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scheduled scene.
+	Scene         *ScheduledScene `protobuf:"bytes,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSceneResponse) Reset() {
+	*x = ScheduleSceneResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSceneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSceneResponse) ProtoMessage() {}
+
+func (x *ScheduleSceneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSceneResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleSceneResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ScheduleSceneResponse) GetScene() *ScheduledScene {
+	if x != nil {
+		return x.Scene
+	}
+	return nil
+}
+
+// RsvpScheduledSceneRequest answers a scheduled scene.
+type RsvpScheduledSceneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The answering character; required.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The scheduled scene; required.
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// The answer: "yes", "maybe", or "no".
+	Response      string `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpScheduledSceneRequest) Reset() {
+	*x = RsvpScheduledSceneRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpScheduledSceneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpScheduledSceneRequest) ProtoMessage() {}
+
+func (x *RsvpScheduledSceneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpScheduledSceneRequest.ProtoReflect.Descriptor instead.
+func (*RsvpScheduledSceneRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RsvpScheduledSceneRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *RsvpScheduledSceneRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *RsvpScheduledSceneRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+// RsvpScheduledSceneResponse carries the scheduled scene with the new RSVP.
+type RsvpScheduledSceneResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scheduled scene.
+	Scene         *ScheduledScene `protobuf:"bytes,1,opt,name=scene,proto3" json:"scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsvpScheduledSceneResponse) Reset() {
+	*x = RsvpScheduledSceneResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsvpScheduledSceneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsvpScheduledSceneResponse) ProtoMessage() {}
+
+func (x *RsvpScheduledSceneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsvpScheduledSceneResponse.ProtoReflect.Descriptor instead.
+func (*RsvpScheduledSceneResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RsvpScheduledSceneResponse) GetScene() *ScheduledScene {
+	if x != nil {
+		return x.Scene
+	}
+	return nil
+}
+
+// CancelScheduledSceneRequest calls off a scheduled scene.
+type CancelScheduledSceneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The acting character; MUST be the scheduled scene's owner.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The scheduled scene; required.
+	ScheduleId    string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledSceneRequest) Reset() {
+	*x = CancelScheduledSceneRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledSceneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledSceneRequest) ProtoMessage() {}
+
+func (x *CancelScheduledSceneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledSceneRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledSceneRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CancelScheduledSceneRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *CancelScheduledSceneRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+// CancelScheduledSceneResponse is empty; success means the scene was
+// cancelled.
+type CancelScheduledSceneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledSceneResponse) Reset() {
+	*x = CancelScheduledSceneResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledSceneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledSceneResponse) ProtoMessage() {}
+
+func (x *CancelScheduledSceneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledSceneResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledSceneResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{84}
+}
+
+// ListUpcomingScenesRequest asks for the scheduled scenes that have not
+// opened yet.
+type ListUpcomingScenesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requesting character; required.
+	CharacterId string `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// When true, only scenes the caller owns or answered "yes" or "maybe" to.
+	Attending bool `protobuf:"varint,2,opt,name=attending,proto3" json:"attending,omitempty"`
+	// Maximum scenes to return; 0 means server default, capped at 100.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingScenesRequest) Reset() {
+	*x = ListUpcomingScenesRequest{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingScenesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingScenesRequest) ProtoMessage() {}
+
+func (x *ListUpcomingScenesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingScenesRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingScenesRequest) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListUpcomingScenesRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *ListUpcomingScenesRequest) GetAttending() bool {
+	if x != nil {
+		return x.Attending
+	}
+	return false
+}
+
+func (x *ListUpcomingScenesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListUpcomingScenesResponse carries the upcoming scenes, soonest first.
+type ListUpcomingScenesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The upcoming scheduled scenes.
+	Scenes        []*ScheduledScene `protobuf:"bytes,1,rep,name=scenes,proto3" json:"scenes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingScenesResponse) Reset() {
+	*x = ListUpcomingScenesResponse{}
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingScenesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingScenesResponse) ProtoMessage() {}
+
+func (x *ListUpcomingScenesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingScenesResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingScenesResponse) Descriptor() ([]byte, []int) {
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListUpcomingScenesResponse) GetScenes() []*ScheduledScene {
+	if x != nil {
+		return x.Scenes
+	}
+	return nil
+}
+
 // ScenePublishStartedEvent announces a newly opened publication attempt and its
 // frozen vote roster. Emitted as scene_publish_started.
 type ScenePublishStartedEvent struct {
@@ -4449,7 +5529,7 @@ type ScenePublishStartedEvent struct {
 
 func (x *ScenePublishStartedEvent) Reset() {
 	*x = ScenePublishStartedEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[70]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4461,7 +5541,7 @@ func (x *ScenePublishStartedEvent) String() string {
 func (*ScenePublishStartedEvent) ProtoMessage() {}
 
 func (x *ScenePublishStartedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[70]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4474,7 +5554,7 @@ func (x *ScenePublishStartedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScenePublishStartedEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishStartedEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{70}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ScenePublishStartedEvent) GetAttemptId() string {
@@ -4537,7 +5617,7 @@ type ScenePublishVoteCastEvent struct {
 
 func (x *ScenePublishVoteCastEvent) Reset() {
 	*x = ScenePublishVoteCastEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[71]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4549,7 +5629,7 @@ func (x *ScenePublishVoteCastEvent) String() string {
 func (*ScenePublishVoteCastEvent) ProtoMessage() {}
 
 func (x *ScenePublishVoteCastEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[71]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4562,7 +5642,7 @@ func (x *ScenePublishVoteCastEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScenePublishVoteCastEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishVoteCastEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{71}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ScenePublishVoteCastEvent) GetAttemptId() string {
@@ -4609,7 +5689,7 @@ type ScenePublishCoolOffStartedEvent struct {
 
 func (x *ScenePublishCoolOffStartedEvent) Reset() {
 	*x = ScenePublishCoolOffStartedEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[72]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4621,7 +5701,7 @@ func (x *ScenePublishCoolOffStartedEvent) String() string {
 func (*ScenePublishCoolOffStartedEvent) ProtoMessage() {}
 
 func (x *ScenePublishCoolOffStartedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[72]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4634,7 +5714,7 @@ func (x *ScenePublishCoolOffStartedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScenePublishCoolOffStartedEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishCoolOffStartedEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{72}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ScenePublishCoolOffStartedEvent) GetAttemptId() string {
@@ -4673,7 +5753,7 @@ type ScenePublishResolvedEvent struct {
 
 func (x *ScenePublishResolvedEvent) Reset() {
 	*x = ScenePublishResolvedEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[73]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4685,7 +5765,7 @@ func (x *ScenePublishResolvedEvent) String() string {
 func (*ScenePublishResolvedEvent) ProtoMessage() {}
 
 func (x *ScenePublishResolvedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[73]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4698,7 +5778,7 @@ func (x *ScenePublishResolvedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScenePublishResolvedEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishResolvedEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{73}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ScenePublishResolvedEvent) GetAttemptId() string {
@@ -4758,7 +5838,7 @@ type ScenePublishWithdrawnEvent struct {
 
 func (x *ScenePublishWithdrawnEvent) Reset() {
 	*x = ScenePublishWithdrawnEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[74]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4770,7 +5850,7 @@ func (x *ScenePublishWithdrawnEvent) String() string {
 func (*ScenePublishWithdrawnEvent) ProtoMessage() {}
 
 func (x *ScenePublishWithdrawnEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[74]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4783,7 +5863,7 @@ func (x *ScenePublishWithdrawnEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScenePublishWithdrawnEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishWithdrawnEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{74}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ScenePublishWithdrawnEvent) GetAttemptId() string {
@@ -4818,7 +5898,7 @@ type ScenePublishVoteAttemptsExtendedEvent struct {
 
 func (x *ScenePublishVoteAttemptsExtendedEvent) Reset() {
 	*x = ScenePublishVoteAttemptsExtendedEvent{}
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[75]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4830,7 +5910,7 @@ func (x *ScenePublishVoteAttemptsExtendedEvent) String() string {
 func (*ScenePublishVoteAttemptsExtendedEvent) ProtoMessage() {}
 
 func (x *ScenePublishVoteAttemptsExtendedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_holomush_scene_v1_scene_proto_msgTypes[75]
+	mi := &file_holomush_scene_v1_scene_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4843,7 +5923,7 @@ func (x *ScenePublishVoteAttemptsExtendedEvent) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ScenePublishVoteAttemptsExtendedEvent.ProtoReflect.Descriptor instead.
 func (*ScenePublishVoteAttemptsExtendedEvent) Descriptor() ([]byte, []int) {
-	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{75}
+	return file_holomush_scene_v1_scene_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ScenePublishVoteAttemptsExtendedEvent) GetSceneId() string {
@@ -5154,7 +6234,80 @@ const file_holomush_scene_v1_scene_proto_rawDesc = "" +
 	"\x16ExportSceneLogResponse\x12\x18\n" +
 	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1b\n" +
 	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"\xa9\x01\n" +
+	"\x17ExportTranscriptRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12\"\n" +
+	"\bscene_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\asceneId\x12\x1f\n" +
+	"\x06format\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06format\x12\x1d\n" +
+	"\n" +
+	"redact_ooc\x18\x04 \x01(\bR\tredactOoc\"m\n" +
+	"\x18ExportTranscriptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x1a\n" +
 	"\bfilename\x18\x03 \x01(\tR\bfilename\"\x9b\x02\n" +
+	"\n" +
+	"SceneTurns\x12\x14\n" +
+	"\x05queue\x18\x01 \x03(\tR\x05queue\x120\n" +
+	"\x14current_character_id\x18\x02 \x01(\tR\x12currentCharacterId\x12*\n" +
+	"\x11next_character_id\x18\x03 \x01(\tR\x0fnextCharacterId\x12\x14\n" +
+	"\x05round\x18\x04 \x01(\rR\x05round\x12B\n" +
+	"\x0fturn_started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rturnStartedAt\x12?\n" +
+	"\rturn_deadline\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fturnDeadline\"f\n" +
+	"\x14GetSceneTurnsRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12\"\n" +
+	"\bscene_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\asceneId\"L\n" +
+	"\x15GetSceneTurnsResponse\x123\n" +
+	"\x05turns\x18\x01 \x01(\v2\x1d.holomush.scene.v1.SceneTurnsR\x05turns\"\xcb\x01\n" +
+	"\x17UpdateSceneTurnsRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12\"\n" +
+	"\bscene_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\asceneId\x120\n" +
+	"\x06action\x18\x03 \x01(\tB\x18\xbaH\x15r\x13R\x03addR\x06removeR\x04skipR\x06action\x12.\n" +
+	"\x13target_character_id\x18\x04 \x01(\tR\x11targetCharacterId\"O\n" +
+	"\x18UpdateSceneTurnsResponse\x123\n" +
+	"\x05turns\x18\x01 \x01(\v2\x1d.holomush.scene.v1.SceneTurnsR\x05turns\"J\n" +
+	"\tSceneRsvp\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x1a\n" +
+	"\bresponse\x18\x02 \x01(\tR\bresponse\"\xc2\x02\n" +
+	"\x0eScheduledScene\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\tR\aownerId\x12)\n" +
+	"\x10content_warnings\x18\x06 \x03(\tR\x0fcontentWarnings\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x12\x14\n" +
+	"\x05state\x18\b \x01(\tR\x05state\x122\n" +
+	"\x05rsvps\x18\t \x03(\v2\x1c.holomush.scene.v1.SceneRsvpR\x05rsvps\"\x9f\x02\n" +
+	"\x14ScheduleSceneRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12 \n" +
+	"\x05title\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xc8\x01R\x05title\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\vdescription\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\x10content_warnings\x18\x06 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10 R\x0fcontentWarnings\"P\n" +
+	"\x15ScheduleSceneResponse\x127\n" +
+	"\x05scene\x18\x01 \x01(\v2!.holomush.scene.v1.ScheduledSceneR\x05scene\"\xa4\x01\n" +
+	"\x19RsvpScheduledSceneRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12(\n" +
+	"\vschedule_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"scheduleId\x121\n" +
+	"\bresponse\x18\x03 \x01(\tB\x15\xbaH\x12r\x10R\x03yesR\x05maybeR\x02noR\bresponse\"U\n" +
+	"\x1aRsvpScheduledSceneResponse\x127\n" +
+	"\x05scene\x18\x01 \x01(\v2!.holomush.scene.v1.ScheduledSceneR\x05scene\"s\n" +
+	"\x1bCancelScheduledSceneRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12(\n" +
+	"\vschedule_id\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"scheduleId\"\x1e\n" +
+	"\x1cCancelScheduledSceneResponse\"\x86\x01\n" +
+	"\x19ListUpcomingScenesRequest\x12*\n" +
+	"\fcharacter_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vcharacterId\x12\x1c\n" +
+	"\tattending\x18\x02 \x01(\bR\tattending\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\"W\n" +
+	"\x1aListUpcomingScenesResponse\x129\n" +
+	"\x06scenes\x18\x01 \x03(\v2!.holomush.scene.v1.ScheduledSceneR\x06scenes\"\x9b\x02\n" +
 	"\x18ScenePublishStartedEvent\x12\x1d\n" +
 	"\n" +
 	"attempt_id\x18\x01 \x01(\tR\tattemptId\x12%\n" +
@@ -5191,7 +6344,7 @@ const file_holomush_scene_v1_scene_proto_rawDesc = "" +
 	"additional\x18\x02 \x01(\x05R\n" +
 	"additional\x12\x17\n" +
 	"\anew_max\x18\x03 \x01(\x05R\x06newMax\x12\x19\n" +
-	"\badmin_id\x18\x04 \x01(\tR\aadminId2\x8b\x1a\n" +
+	"\badmin_id\x18\x04 \x01(\tR\aadminId2\x8c \n" +
 	"\fSceneService\x12Y\n" +
 	"\n" +
 	"ListScenes\x12$.holomush.scene.v1.ListScenesRequest\x1a%.holomush.scene.v1.ListScenesResponse\x12S\n" +
@@ -5227,7 +6380,14 @@ const file_holomush_scene_v1_scene_proto_rawDesc = "" +
 	"\x1eExtendScenePublishVoteAttempts\x128.holomush.scene.v1.ExtendScenePublishVoteAttemptsRequest\x1a9.holomush.scene.v1.ExtendScenePublishVoteAttemptsResponse\x12t\n" +
 	"\x13ListCharacterScenes\x12-.holomush.scene.v1.ListCharacterScenesRequest\x1a..holomush.scene.v1.ListCharacterScenesResponse\x12t\n" +
 	"\x13ListPublishedScenes\x12-.holomush.scene.v1.ListPublishedScenesRequest\x1a..holomush.scene.v1.ListPublishedScenesResponse\x12e\n" +
-	"\x0eExportSceneLog\x12(.holomush.scene.v1.ExportSceneLogRequest\x1a).holomush.scene.v1.ExportSceneLogResponseB\xcb\x01\n" +
+	"\x0eExportSceneLog\x12(.holomush.scene.v1.ExportSceneLogRequest\x1a).holomush.scene.v1.ExportSceneLogResponse\x12k\n" +
+	"\x10ExportTranscript\x12*.holomush.scene.v1.ExportTranscriptRequest\x1a+.holomush.scene.v1.ExportTranscriptResponse\x12b\n" +
+	"\rGetSceneTurns\x12'.holomush.scene.v1.GetSceneTurnsRequest\x1a(.holomush.scene.v1.GetSceneTurnsResponse\x12k\n" +
+	"\x10UpdateSceneTurns\x12*.holomush.scene.v1.UpdateSceneTurnsRequest\x1a+.holomush.scene.v1.UpdateSceneTurnsResponse\x12b\n" +
+	"\rScheduleScene\x12'.holomush.scene.v1.ScheduleSceneRequest\x1a(.holomush.scene.v1.ScheduleSceneResponse\x12q\n" +
+	"\x12RsvpScheduledScene\x12,.holomush.scene.v1.RsvpScheduledSceneRequest\x1a-.holomush.scene.v1.RsvpScheduledSceneResponse\x12w\n" +
+	"\x14CancelScheduledScene\x12..holomush.scene.v1.CancelScheduledSceneRequest\x1a/.holomush.scene.v1.CancelScheduledSceneResponse\x12q\n" +
+	"\x12ListUpcomingScenes\x12,.holomush.scene.v1.ListUpcomingScenesRequest\x1a-.holomush.scene.v1.ListUpcomingScenesResponseB\xcb\x01\n" +
 	"\x15com.holomush.scene.v1B\n" +
 	"SceneProtoP\x01Z@github.com/holomush/holomush/pkg/proto/holomush/scene/v1;scenev1\xa2\x02\x03HSX\xaa\x02\x11Holomush.Scene.V1\xca\x02\x11Holomush\\Scene\\V1\xe2\x02\x1dHolomush\\Scene\\V1\\GPBMetadata\xea\x02\x13Holomush::Scene::V1b\x06proto3"
 
@@ -5243,7 +6403,7 @@ func file_holomush_scene_v1_scene_proto_rawDescGZIP() []byte {
 	return file_holomush_scene_v1_scene_proto_rawDescData
 }
 
-var file_holomush_scene_v1_scene_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_holomush_scene_v1_scene_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_holomush_scene_v1_scene_proto_goTypes = []any{
 	(*SceneInfo)(nil),                              // 0: holomush.scene.v1.SceneInfo
 	(*ParticipantInfo)(nil),                        // 1: holomush.scene.v1.ParticipantInfo
@@ -5315,31 +6475,48 @@ var file_holomush_scene_v1_scene_proto_goTypes = []any{
 	(*ListPublishedScenesResponse)(nil),            // 67: holomush.scene.v1.ListPublishedScenesResponse
 	(*ExportSceneLogRequest)(nil),                  // 68: holomush.scene.v1.ExportSceneLogRequest
 	(*ExportSceneLogResponse)(nil),                 // 69: holomush.scene.v1.ExportSceneLogResponse
-	(*ScenePublishStartedEvent)(nil),               // 70: holomush.scene.v1.ScenePublishStartedEvent
-	(*ScenePublishVoteCastEvent)(nil),              // 71: holomush.scene.v1.ScenePublishVoteCastEvent
-	(*ScenePublishCoolOffStartedEvent)(nil),        // 72: holomush.scene.v1.ScenePublishCoolOffStartedEvent
-	(*ScenePublishResolvedEvent)(nil),              // 73: holomush.scene.v1.ScenePublishResolvedEvent
-	(*ScenePublishWithdrawnEvent)(nil),             // 74: holomush.scene.v1.ScenePublishWithdrawnEvent
-	(*ScenePublishVoteAttemptsExtendedEvent)(nil),  // 75: holomush.scene.v1.ScenePublishVoteAttemptsExtendedEvent
-	(*timestamppb.Timestamp)(nil),                  // 76: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                  // 77: google.protobuf.FieldMask
+	(*ExportTranscriptRequest)(nil),                // 70: holomush.scene.v1.ExportTranscriptRequest
+	(*ExportTranscriptResponse)(nil),               // 71: holomush.scene.v1.ExportTranscriptResponse
+	(*SceneTurns)(nil),                             // 72: holomush.scene.v1.SceneTurns
+	(*GetSceneTurnsRequest)(nil),                   // 73: holomush.scene.v1.GetSceneTurnsRequest
+	(*GetSceneTurnsResponse)(nil),                  // 74: holomush.scene.v1.GetSceneTurnsResponse
+	(*UpdateSceneTurnsRequest)(nil),                // 75: holomush.scene.v1.UpdateSceneTurnsRequest
+	(*UpdateSceneTurnsResponse)(nil),               // 76: holomush.scene.v1.UpdateSceneTurnsResponse
+	(*SceneRsvp)(nil),                              // 77: holomush.scene.v1.SceneRsvp
+	(*ScheduledScene)(nil),                         // 78: holomush.scene.v1.ScheduledScene
+	(*ScheduleSceneRequest)(nil),                   // 79: holomush.scene.v1.ScheduleSceneRequest
+	(*ScheduleSceneResponse)(nil),                  // 80: holomush.scene.v1.ScheduleSceneResponse
+	(*RsvpScheduledSceneRequest)(nil),              // 81: holomush.scene.v1.RsvpScheduledSceneRequest
+	(*RsvpScheduledSceneResponse)(nil),             // 82: holomush.scene.v1.RsvpScheduledSceneResponse
+	(*CancelScheduledSceneRequest)(nil),            // 83: holomush.scene.v1.CancelScheduledSceneRequest
+	(*CancelScheduledSceneResponse)(nil),           // 84: holomush.scene.v1.CancelScheduledSceneResponse
+	(*ListUpcomingScenesRequest)(nil),              // 85: holomush.scene.v1.ListUpcomingScenesRequest
+	(*ListUpcomingScenesResponse)(nil),             // 86: holomush.scene.v1.ListUpcomingScenesResponse
+	(*ScenePublishStartedEvent)(nil),               // 87: holomush.scene.v1.ScenePublishStartedEvent
+	(*ScenePublishVoteCastEvent)(nil),              // 88: holomush.scene.v1.ScenePublishVoteCastEvent
+	(*ScenePublishCoolOffStartedEvent)(nil),        // 89: holomush.scene.v1.ScenePublishCoolOffStartedEvent
+	(*ScenePublishResolvedEvent)(nil),              // 90: holomush.scene.v1.ScenePublishResolvedEvent
+	(*ScenePublishWithdrawnEvent)(nil),             // 91: holomush.scene.v1.ScenePublishWithdrawnEvent
+	(*ScenePublishVoteAttemptsExtendedEvent)(nil),  // 92: holomush.scene.v1.ScenePublishVoteAttemptsExtendedEvent
+	(*timestamppb.Timestamp)(nil),                  // 93: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 94: google.protobuf.FieldMask
 }
 var file_holomush_scene_v1_scene_proto_depIdxs = []int32{
-	76, // 0: holomush.scene.v1.SceneInfo.created_at:type_name -> google.protobuf.Timestamp
-	76, // 1: holomush.scene.v1.SceneInfo.ended_at:type_name -> google.protobuf.Timestamp
+	93, // 0: holomush.scene.v1.SceneInfo.created_at:type_name -> google.protobuf.Timestamp
+	93, // 1: holomush.scene.v1.SceneInfo.ended_at:type_name -> google.protobuf.Timestamp
 	1,  // 2: holomush.scene.v1.SceneInfo.participants:type_name -> holomush.scene.v1.ParticipantInfo
 	1,  // 3: holomush.scene.v1.SceneInfo.observers:type_name -> holomush.scene.v1.ParticipantInfo
-	76, // 4: holomush.scene.v1.ParticipantInfo.joined_at:type_name -> google.protobuf.Timestamp
+	93, // 4: holomush.scene.v1.ParticipantInfo.joined_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: holomush.scene.v1.ListScenesResponse.scenes:type_name -> holomush.scene.v1.SceneInfo
 	0,  // 6: holomush.scene.v1.GetSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
 	0,  // 7: holomush.scene.v1.CreateSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
 	0,  // 8: holomush.scene.v1.EndSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
 	0,  // 9: holomush.scene.v1.PauseSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
 	0,  // 10: holomush.scene.v1.ResumeSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
-	77, // 11: holomush.scene.v1.UpdateSceneRequest.update_mask:type_name -> google.protobuf.FieldMask
+	94, // 11: holomush.scene.v1.UpdateSceneRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 12: holomush.scene.v1.UpdateSceneResponse.scene:type_name -> holomush.scene.v1.SceneInfo
 	1,  // 13: holomush.scene.v1.WatchSceneResponse.participant:type_name -> holomush.scene.v1.ParticipantInfo
-	76, // 14: holomush.scene.v1.PoseOrderEntry.last_posed_at:type_name -> google.protobuf.Timestamp
+	93, // 14: holomush.scene.v1.PoseOrderEntry.last_posed_at:type_name -> google.protobuf.Timestamp
 	39, // 15: holomush.scene.v1.GetPoseOrderResponse.entries:type_name -> holomush.scene.v1.PoseOrderEntry
 	48, // 16: holomush.scene.v1.GetPublishedSceneResponse.tally:type_name -> holomush.scene.v1.PublishedSceneVoteSummary
 	47, // 17: holomush.scene.v1.GetPublishedSceneResponse.content_entries:type_name -> holomush.scene.v1.PublishedSceneEntry
@@ -5349,73 +6526,97 @@ var file_holomush_scene_v1_scene_proto_depIdxs = []int32{
 	63, // 21: holomush.scene.v1.ListCharacterScenesResponse.scenes:type_name -> holomush.scene.v1.CharacterSceneInfo
 	47, // 22: holomush.scene.v1.PublicSceneArchive.content_entries:type_name -> holomush.scene.v1.PublishedSceneEntry
 	65, // 23: holomush.scene.v1.ListPublishedScenesResponse.archives:type_name -> holomush.scene.v1.PublicSceneArchive
-	2,  // 24: holomush.scene.v1.SceneService.ListScenes:input_type -> holomush.scene.v1.ListScenesRequest
-	4,  // 25: holomush.scene.v1.SceneService.GetScene:input_type -> holomush.scene.v1.GetSceneRequest
-	6,  // 26: holomush.scene.v1.SceneService.CreateScene:input_type -> holomush.scene.v1.CreateSceneRequest
-	8,  // 27: holomush.scene.v1.SceneService.EndScene:input_type -> holomush.scene.v1.EndSceneRequest
-	10, // 28: holomush.scene.v1.SceneService.PauseScene:input_type -> holomush.scene.v1.PauseSceneRequest
-	12, // 29: holomush.scene.v1.SceneService.ResumeScene:input_type -> holomush.scene.v1.ResumeSceneRequest
-	14, // 30: holomush.scene.v1.SceneService.MuteScene:input_type -> holomush.scene.v1.MuteSceneRequest
-	16, // 31: holomush.scene.v1.SceneService.SetSceneNotifyPref:input_type -> holomush.scene.v1.SetSceneNotifyPrefRequest
-	18, // 32: holomush.scene.v1.SceneService.GetSceneNotifyPref:input_type -> holomush.scene.v1.GetSceneNotifyPrefRequest
-	20, // 33: holomush.scene.v1.SceneService.ListMutedScenes:input_type -> holomush.scene.v1.ListMutedScenesRequest
-	22, // 34: holomush.scene.v1.SceneService.UpdateScene:input_type -> holomush.scene.v1.UpdateSceneRequest
-	24, // 35: holomush.scene.v1.SceneService.JoinScene:input_type -> holomush.scene.v1.JoinSceneRequest
-	26, // 36: holomush.scene.v1.SceneService.WatchScene:input_type -> holomush.scene.v1.WatchSceneRequest
-	28, // 37: holomush.scene.v1.SceneService.LeaveScene:input_type -> holomush.scene.v1.LeaveSceneRequest
-	30, // 38: holomush.scene.v1.SceneService.InviteToScene:input_type -> holomush.scene.v1.InviteToSceneRequest
-	32, // 39: holomush.scene.v1.SceneService.KickFromScene:input_type -> holomush.scene.v1.KickFromSceneRequest
-	34, // 40: holomush.scene.v1.SceneService.TransferOwnership:input_type -> holomush.scene.v1.TransferOwnershipRequest
-	36, // 41: holomush.scene.v1.SceneService.CastPublishVote:input_type -> holomush.scene.v1.CastPublishVoteRequest
-	38, // 42: holomush.scene.v1.SceneService.GetPoseOrder:input_type -> holomush.scene.v1.GetPoseOrderRequest
-	41, // 43: holomush.scene.v1.SceneService.StartScenePublish:input_type -> holomush.scene.v1.StartScenePublishRequest
-	43, // 44: holomush.scene.v1.SceneService.CastPublishSceneVote:input_type -> holomush.scene.v1.CastPublishSceneVoteRequest
-	45, // 45: holomush.scene.v1.SceneService.WithdrawScenePublish:input_type -> holomush.scene.v1.WithdrawScenePublishRequest
-	49, // 46: holomush.scene.v1.SceneService.GetPublishedScene:input_type -> holomush.scene.v1.GetPublishedSceneRequest
-	51, // 47: holomush.scene.v1.SceneService.DownloadPublishedScene:input_type -> holomush.scene.v1.DownloadPublishedSceneRequest
-	53, // 48: holomush.scene.v1.SceneService.ListScenePublishAttempts:input_type -> holomush.scene.v1.ListScenePublishAttemptsRequest
-	56, // 49: holomush.scene.v1.SceneService.GetPublicSceneArchive:input_type -> holomush.scene.v1.GetPublicSceneArchiveRequest
-	58, // 50: holomush.scene.v1.SceneService.DownloadPublicSceneArchive:input_type -> holomush.scene.v1.DownloadPublicSceneArchiveRequest
-	60, // 51: holomush.scene.v1.SceneService.ExtendScenePublishVoteAttempts:input_type -> holomush.scene.v1.ExtendScenePublishVoteAttemptsRequest
-	62, // 52: holomush.scene.v1.SceneService.ListCharacterScenes:input_type -> holomush.scene.v1.ListCharacterScenesRequest
-	66, // 53: holomush.scene.v1.SceneService.ListPublishedScenes:input_type -> holomush.scene.v1.ListPublishedScenesRequest
-	68, // 54: holomush.scene.v1.SceneService.ExportSceneLog:input_type -> holomush.scene.v1.ExportSceneLogRequest
-	3,  // 55: holomush.scene.v1.SceneService.ListScenes:output_type -> holomush.scene.v1.ListScenesResponse
-	5,  // 56: holomush.scene.v1.SceneService.GetScene:output_type -> holomush.scene.v1.GetSceneResponse
-	7,  // 57: holomush.scene.v1.SceneService.CreateScene:output_type -> holomush.scene.v1.CreateSceneResponse
-	9,  // 58: holomush.scene.v1.SceneService.EndScene:output_type -> holomush.scene.v1.EndSceneResponse
-	11, // 59: holomush.scene.v1.SceneService.PauseScene:output_type -> holomush.scene.v1.PauseSceneResponse
-	13, // 60: holomush.scene.v1.SceneService.ResumeScene:output_type -> holomush.scene.v1.ResumeSceneResponse
-	15, // 61: holomush.scene.v1.SceneService.MuteScene:output_type -> holomush.scene.v1.MuteSceneResponse
-	17, // 62: holomush.scene.v1.SceneService.SetSceneNotifyPref:output_type -> holomush.scene.v1.SetSceneNotifyPrefResponse
-	19, // 63: holomush.scene.v1.SceneService.GetSceneNotifyPref:output_type -> holomush.scene.v1.GetSceneNotifyPrefResponse
-	21, // 64: holomush.scene.v1.SceneService.ListMutedScenes:output_type -> holomush.scene.v1.ListMutedScenesResponse
-	23, // 65: holomush.scene.v1.SceneService.UpdateScene:output_type -> holomush.scene.v1.UpdateSceneResponse
-	25, // 66: holomush.scene.v1.SceneService.JoinScene:output_type -> holomush.scene.v1.JoinSceneResponse
-	27, // 67: holomush.scene.v1.SceneService.WatchScene:output_type -> holomush.scene.v1.WatchSceneResponse
-	29, // 68: holomush.scene.v1.SceneService.LeaveScene:output_type -> holomush.scene.v1.LeaveSceneResponse
-	31, // 69: holomush.scene.v1.SceneService.InviteToScene:output_type -> holomush.scene.v1.InviteToSceneResponse
-	33, // 70: holomush.scene.v1.SceneService.KickFromScene:output_type -> holomush.scene.v1.KickFromSceneResponse
-	35, // 71: holomush.scene.v1.SceneService.TransferOwnership:output_type -> holomush.scene.v1.TransferOwnershipResponse
-	37, // 72: holomush.scene.v1.SceneService.CastPublishVote:output_type -> holomush.scene.v1.CastPublishVoteResponse
-	40, // 73: holomush.scene.v1.SceneService.GetPoseOrder:output_type -> holomush.scene.v1.GetPoseOrderResponse
-	42, // 74: holomush.scene.v1.SceneService.StartScenePublish:output_type -> holomush.scene.v1.StartScenePublishResponse
-	44, // 75: holomush.scene.v1.SceneService.CastPublishSceneVote:output_type -> holomush.scene.v1.CastPublishSceneVoteResponse
-	46, // 76: holomush.scene.v1.SceneService.WithdrawScenePublish:output_type -> holomush.scene.v1.WithdrawScenePublishResponse
-	50, // 77: holomush.scene.v1.SceneService.GetPublishedScene:output_type -> holomush.scene.v1.GetPublishedSceneResponse
-	52, // 78: holomush.scene.v1.SceneService.DownloadPublishedScene:output_type -> holomush.scene.v1.DownloadPublishedSceneResponse
-	54, // 79: holomush.scene.v1.SceneService.ListScenePublishAttempts:output_type -> holomush.scene.v1.ListScenePublishAttemptsResponse
-	57, // 80: holomush.scene.v1.SceneService.GetPublicSceneArchive:output_type -> holomush.scene.v1.GetPublicSceneArchiveResponse
-	59, // 81: holomush.scene.v1.SceneService.DownloadPublicSceneArchive:output_type -> holomush.scene.v1.DownloadPublicSceneArchiveResponse
-	61, // 82: holomush.scene.v1.SceneService.ExtendScenePublishVoteAttempts:output_type -> holomush.scene.v1.ExtendScenePublishVoteAttemptsResponse
-	64, // 83: holomush.scene.v1.SceneService.ListCharacterScenes:output_type -> holomush.scene.v1.ListCharacterScenesResponse
-	67, // 84: holomush.scene.v1.SceneService.ListPublishedScenes:output_type -> holomush.scene.v1.ListPublishedScenesResponse
-	69, // 85: holomush.scene.v1.SceneService.ExportSceneLog:output_type -> holomush.scene.v1.ExportSceneLogResponse
-	55, // [55:86] is the sub-list for method output_type
-	24, // [24:55] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	93, // 24: holomush.scene.v1.SceneTurns.turn_started_at:type_name -> google.protobuf.Timestamp
+	93, // 25: holomush.scene.v1.SceneTurns.turn_deadline:type_name -> google.protobuf.Timestamp
+	72, // 26: holomush.scene.v1.GetSceneTurnsResponse.turns:type_name -> holomush.scene.v1.SceneTurns
+	72, // 27: holomush.scene.v1.UpdateSceneTurnsResponse.turns:type_name -> holomush.scene.v1.SceneTurns
+	93, // 28: holomush.scene.v1.ScheduledScene.starts_at:type_name -> google.protobuf.Timestamp
+	77, // 29: holomush.scene.v1.ScheduledScene.rsvps:type_name -> holomush.scene.v1.SceneRsvp
+	93, // 30: holomush.scene.v1.ScheduleSceneRequest.starts_at:type_name -> google.protobuf.Timestamp
+	78, // 31: holomush.scene.v1.ScheduleSceneResponse.scene:type_name -> holomush.scene.v1.ScheduledScene
+	78, // 32: holomush.scene.v1.RsvpScheduledSceneResponse.scene:type_name -> holomush.scene.v1.ScheduledScene
+	78, // 33: holomush.scene.v1.ListUpcomingScenesResponse.scenes:type_name -> holomush.scene.v1.ScheduledScene
+	2,  // 34: holomush.scene.v1.SceneService.ListScenes:input_type -> holomush.scene.v1.ListScenesRequest
+	4,  // 35: holomush.scene.v1.SceneService.GetScene:input_type -> holomush.scene.v1.GetSceneRequest
+	6,  // 36: holomush.scene.v1.SceneService.CreateScene:input_type -> holomush.scene.v1.CreateSceneRequest
+	8,  // 37: holomush.scene.v1.SceneService.EndScene:input_type -> holomush.scene.v1.EndSceneRequest
+	10, // 38: holomush.scene.v1.SceneService.PauseScene:input_type -> holomush.scene.v1.PauseSceneRequest
+	12, // 39: holomush.scene.v1.SceneService.ResumeScene:input_type -> holomush.scene.v1.ResumeSceneRequest
+	14, // 40: holomush.scene.v1.SceneService.MuteScene:input_type -> holomush.scene.v1.MuteSceneRequest
+	16, // 41: holomush.scene.v1.SceneService.SetSceneNotifyPref:input_type -> holomush.scene.v1.SetSceneNotifyPrefRequest
+	18, // 42: holomush.scene.v1.SceneService.GetSceneNotifyPref:input_type -> holomush.scene.v1.GetSceneNotifyPrefRequest
+	20, // 43: holomush.scene.v1.SceneService.ListMutedScenes:input_type -> holomush.scene.v1.ListMutedScenesRequest
+	22, // 44: holomush.scene.v1.SceneService.UpdateScene:input_type -> holomush.scene.v1.UpdateSceneRequest
+	24, // 45: holomush.scene.v1.SceneService.JoinScene:input_type -> holomush.scene.v1.JoinSceneRequest
+	26, // 46: holomush.scene.v1.SceneService.WatchScene:input_type -> holomush.scene.v1.WatchSceneRequest
+	28, // 47: holomush.scene.v1.SceneService.LeaveScene:input_type -> holomush.scene.v1.LeaveSceneRequest
+	30, // 48: holomush.scene.v1.SceneService.InviteToScene:input_type -> holomush.scene.v1.InviteToSceneRequest
+	32, // 49: holomush.scene.v1.SceneService.KickFromScene:input_type -> holomush.scene.v1.KickFromSceneRequest
+	34, // 50: holomush.scene.v1.SceneService.TransferOwnership:input_type -> holomush.scene.v1.TransferOwnershipRequest
+	36, // 51: holomush.scene.v1.SceneService.CastPublishVote:input_type -> holomush.scene.v1.CastPublishVoteRequest
+	38, // 52: holomush.scene.v1.SceneService.GetPoseOrder:input_type -> holomush.scene.v1.GetPoseOrderRequest
+	41, // 53: holomush.scene.v1.SceneService.StartScenePublish:input_type -> holomush.scene.v1.StartScenePublishRequest
+	43, // 54: holomush.scene.v1.SceneService.CastPublishSceneVote:input_type -> holomush.scene.v1.CastPublishSceneVoteRequest
+	45, // 55: holomush.scene.v1.SceneService.WithdrawScenePublish:input_type -> holomush.scene.v1.WithdrawScenePublishRequest
+	49, // 56: holomush.scene.v1.SceneService.GetPublishedScene:input_type -> holomush.scene.v1.GetPublishedSceneRequest
+	51, // 57: holomush.scene.v1.SceneService.DownloadPublishedScene:input_type -> holomush.scene.v1.DownloadPublishedSceneRequest
+	53, // 58: holomush.scene.v1.SceneService.ListScenePublishAttempts:input_type -> holomush.scene.v1.ListScenePublishAttemptsRequest
+	56, // 59: holomush.scene.v1.SceneService.GetPublicSceneArchive:input_type -> holomush.scene.v1.GetPublicSceneArchiveRequest
+	58, // 60: holomush.scene.v1.SceneService.DownloadPublicSceneArchive:input_type -> holomush.scene.v1.DownloadPublicSceneArchiveRequest
+	60, // 61: holomush.scene.v1.SceneService.ExtendScenePublishVoteAttempts:input_type -> holomush.scene.v1.ExtendScenePublishVoteAttemptsRequest
+	62, // 62: holomush.scene.v1.SceneService.ListCharacterScenes:input_type -> holomush.scene.v1.ListCharacterScenesRequest
+	66, // 63: holomush.scene.v1.SceneService.ListPublishedScenes:input_type -> holomush.scene.v1.ListPublishedScenesRequest
+	68, // 64: holomush.scene.v1.SceneService.ExportSceneLog:input_type -> holomush.scene.v1.ExportSceneLogRequest
+	70, // 65: holomush.scene.v1.SceneService.ExportTranscript:input_type -> holomush.scene.v1.ExportTranscriptRequest
+	73, // 66: holomush.scene.v1.SceneService.GetSceneTurns:input_type -> holomush.scene.v1.GetSceneTurnsRequest
+	75, // 67: holomush.scene.v1.SceneService.UpdateSceneTurns:input_type -> holomush.scene.v1.UpdateSceneTurnsRequest
+	79, // 68: holomush.scene.v1.SceneService.ScheduleScene:input_type -> holomush.scene.v1.ScheduleSceneRequest
+	81, // 69: holomush.scene.v1.SceneService.RsvpScheduledScene:input_type -> holomush.scene.v1.RsvpScheduledSceneRequest
+	83, // 70: holomush.scene.v1.SceneService.CancelScheduledScene:input_type -> holomush.scene.v1.CancelScheduledSceneRequest
+	85, // 71: holomush.scene.v1.SceneService.ListUpcomingScenes:input_type -> holomush.scene.v1.ListUpcomingScenesRequest
+	3,  // 72: holomush.scene.v1.SceneService.ListScenes:output_type -> holomush.scene.v1.ListScenesResponse
+	5,  // 73: holomush.scene.v1.SceneService.GetScene:output_type -> holomush.scene.v1.GetSceneResponse
+	7,  // 74: holomush.scene.v1.SceneService.CreateScene:output_type -> holomush.scene.v1.CreateSceneResponse
+	9,  // 75: holomush.scene.v1.SceneService.EndScene:output_type -> holomush.scene.v1.EndSceneResponse
+	11, // 76: holomush.scene.v1.SceneService.PauseScene:output_type -> holomush.scene.v1.PauseSceneResponse
+	13, // 77: holomush.scene.v1.SceneService.ResumeScene:output_type -> holomush.scene.v1.ResumeSceneResponse
+	15, // 78: holomush.scene.v1.SceneService.MuteScene:output_type -> holomush.scene.v1.MuteSceneResponse
+	17, // 79: holomush.scene.v1.SceneService.SetSceneNotifyPref:output_type -> holomush.scene.v1.SetSceneNotifyPrefResponse
+	19, // 80: holomush.scene.v1.SceneService.GetSceneNotifyPref:output_type -> holomush.scene.v1.GetSceneNotifyPrefResponse
+	21, // 81: holomush.scene.v1.SceneService.ListMutedScenes:output_type -> holomush.scene.v1.ListMutedScenesResponse
+	23, // 82: holomush.scene.v1.SceneService.UpdateScene:output_type -> holomush.scene.v1.UpdateSceneResponse
+	25, // 83: holomush.scene.v1.SceneService.JoinScene:output_type -> holomush.scene.v1.JoinSceneResponse
+	27, // 84: holomush.scene.v1.SceneService.WatchScene:output_type -> holomush.scene.v1.WatchSceneResponse
+	29, // 85: holomush.scene.v1.SceneService.LeaveScene:output_type -> holomush.scene.v1.LeaveSceneResponse
+	31, // 86: holomush.scene.v1.SceneService.InviteToScene:output_type -> holomush.scene.v1.InviteToSceneResponse
+	33, // 87: holomush.scene.v1.SceneService.KickFromScene:output_type -> holomush.scene.v1.KickFromSceneResponse
+	35, // 88: holomush.scene.v1.SceneService.TransferOwnership:output_type -> holomush.scene.v1.TransferOwnershipResponse
+	37, // 89: holomush.scene.v1.SceneService.CastPublishVote:output_type -> holomush.scene.v1.CastPublishVoteResponse
+	40, // 90: holomush.scene.v1.SceneService.GetPoseOrder:output_type -> holomush.scene.v1.GetPoseOrderResponse
+	42, // 91: holomush.scene.v1.SceneService.StartScenePublish:output_type -> holomush.scene.v1.StartScenePublishResponse
+	44, // 92: holomush.scene.v1.SceneService.CastPublishSceneVote:output_type -> holomush.scene.v1.CastPublishSceneVoteResponse
+	46, // 93: holomush.scene.v1.SceneService.WithdrawScenePublish:output_type -> holomush.scene.v1.WithdrawScenePublishResponse
+	50, // 94: holomush.scene.v1.SceneService.GetPublishedScene:output_type -> holomush.scene.v1.GetPublishedSceneResponse
+	52, // 95: holomush.scene.v1.SceneService.DownloadPublishedScene:output_type -> holomush.scene.v1.DownloadPublishedSceneResponse
+	54, // 96: holomush.scene.v1.SceneService.ListScenePublishAttempts:output_type -> holomush.scene.v1.ListScenePublishAttemptsResponse
+	57, // 97: holomush.scene.v1.SceneService.GetPublicSceneArchive:output_type -> holomush.scene.v1.GetPublicSceneArchiveResponse
+	59, // 98: holomush.scene.v1.SceneService.DownloadPublicSceneArchive:output_type -> holomush.scene.v1.DownloadPublicSceneArchiveResponse
+	61, // 99: holomush.scene.v1.SceneService.ExtendScenePublishVoteAttempts:output_type -> holomush.scene.v1.ExtendScenePublishVoteAttemptsResponse
+	64, // 100: holomush.scene.v1.SceneService.ListCharacterScenes:output_type -> holomush.scene.v1.ListCharacterScenesResponse
+	67, // 101: holomush.scene.v1.SceneService.ListPublishedScenes:output_type -> holomush.scene.v1.ListPublishedScenesResponse
+	69, // 102: holomush.scene.v1.SceneService.ExportSceneLog:output_type -> holomush.scene.v1.ExportSceneLogResponse
+	71, // 103: holomush.scene.v1.SceneService.ExportTranscript:output_type -> holomush.scene.v1.ExportTranscriptResponse
+	74, // 104: holomush.scene.v1.SceneService.GetSceneTurns:output_type -> holomush.scene.v1.GetSceneTurnsResponse
+	76, // 105: holomush.scene.v1.SceneService.UpdateSceneTurns:output_type -> holomush.scene.v1.UpdateSceneTurnsResponse
+	80, // 106: holomush.scene.v1.SceneService.ScheduleScene:output_type -> holomush.scene.v1.ScheduleSceneResponse
+	82, // 107: holomush.scene.v1.SceneService.RsvpScheduledScene:output_type -> holomush.scene.v1.RsvpScheduledSceneResponse
+	84, // 108: holomush.scene.v1.SceneService.CancelScheduledScene:output_type -> holomush.scene.v1.CancelScheduledSceneResponse
+	86, // 109: holomush.scene.v1.SceneService.ListUpcomingScenes:output_type -> holomush.scene.v1.ListUpcomingScenesResponse
+	72, // [72:110] is the sub-list for method output_type
+	34, // [34:72] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_holomush_scene_v1_scene_proto_init() }
@@ -5430,7 +6631,7 @@ func file_holomush_scene_v1_scene_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_holomush_scene_v1_scene_proto_rawDesc), len(file_holomush_scene_v1_scene_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   76,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -53,6 +53,13 @@ const (
 	SceneService_ListCharacterScenes_FullMethodName            = "/holomush.scene.v1.SceneService/ListCharacterScenes"
 	SceneService_ListPublishedScenes_FullMethodName            = "/holomush.scene.v1.SceneService/ListPublishedScenes"
 	SceneService_ExportSceneLog_FullMethodName                 = "/holomush.scene.v1.SceneService/ExportSceneLog"
+	SceneService_ExportTranscript_FullMethodName               = "/holomush.scene.v1.SceneService/ExportTranscript"
+	SceneService_GetSceneTurns_FullMethodName                  = "/holomush.scene.v1.SceneService/GetSceneTurns"
+	SceneService_UpdateSceneTurns_FullMethodName               = "/holomush.scene.v1.SceneService/UpdateSceneTurns"
+	SceneService_ScheduleScene_FullMethodName                  = "/holomush.scene.v1.SceneService/ScheduleScene"
+	SceneService_RsvpScheduledScene_FullMethodName             = "/holomush.scene.v1.SceneService/RsvpScheduledScene"
+	SceneService_CancelScheduledScene_FullMethodName           = "/holomush.scene.v1.SceneService/CancelScheduledScene"
+	SceneService_ListUpcomingScenes_FullMethodName             = "/holomush.scene.v1.SceneService/ListUpcomingScenes"
 )
 
 // SceneServiceClient is the client API for SceneService service.
@@ -275,6 +282,44 @@ type SceneServiceClient interface {
 	// SCENE_EXPORT_TOO_LARGE rather than silently truncating the document.
 	// See export.go::ExportSceneLog.
 	ExportSceneLog(ctx context.Context, in *ExportSceneLogRequest, opts ...grpc.CallOption) (*ExportSceneLogResponse, error)
+	// ExportTranscript renders a scene's full transcript — IC poses, says, and
+	// emits interleaved with OOC lines in event order — as "text", "html", or
+	// "json". ABAC-gated on action "export_transcript" (participants and staff;
+	// fails closed without an evaluator). redact_ooc drops the OOC lines. The
+	// same exportLogMaxRows ceiling applies per stream. See
+	// transcript.go::ExportTranscript.
+	ExportTranscript(ctx context.Context, in *ExportTranscriptRequest, opts ...grpc.CallOption) (*ExportTranscriptResponse, error)
+	// GetSceneTurns returns the scene's turn tracker: the ordered participant
+	// queue, whose turn it is, and when that turn times out. Same INV-SCENE-60
+	// participant gate as GetPoseOrder (owners and members; the host ABAC
+	// evaluator is not consulted). A scene with no tracker returns an empty
+	// queue. See turns.go::GetSceneTurns.
+	GetSceneTurns(ctx context.Context, in *GetSceneTurnsRequest, opts ...grpc.CallOption) (*GetSceneTurnsResponse, error)
+	// UpdateSceneTurns applies one turn-tracker operation — "add" or "remove"
+	// a participant, or "skip" the current turn — and emits
+	// scene_turn_changed_ic. The scene owner may act on anyone; a participant
+	// may add or remove themselves and skip their own turn. Turns also advance
+	// automatically when the current character poses, and on timeout. See
+	// turns.go::UpdateSceneTurns.
+	UpdateSceneTurns(ctx context.Context, in *UpdateSceneTurnsRequest, opts ...grpc.CallOption) (*UpdateSceneTurnsResponse, error)
+	// ScheduleScene announces a scene with a future start time. The calling
+	// character becomes its owner (the GM). At starts_at the scheduled-scene
+	// sweep opens it as an open, active scene with the schedule's id and adds
+	// every "yes" RSVP as a member. See schedule.go::ScheduleScene.
+	ScheduleScene(ctx context.Context, in *ScheduleSceneRequest, opts ...grpc.CallOption) (*ScheduleSceneResponse, error)
+	// RsvpScheduledScene records the calling character's answer ("yes",
+	// "maybe", or "no") to a scheduled scene, replacing any earlier answer.
+	// Only scenes that have not opened or been cancelled take RSVPs. See
+	// schedule.go::RsvpScheduledScene.
+	RsvpScheduledScene(ctx context.Context, in *RsvpScheduledSceneRequest, opts ...grpc.CallOption) (*RsvpScheduledSceneResponse, error)
+	// CancelScheduledScene calls off a scheduled scene before it opens. Only
+	// its owner may cancel. See schedule.go::CancelScheduledScene.
+	CancelScheduledScene(ctx context.Context, in *CancelScheduledSceneRequest, opts ...grpc.CallOption) (*CancelScheduledSceneResponse, error)
+	// ListUpcomingScenes returns scheduled scenes that have not opened yet,
+	// soonest first, with their RSVPs. attending narrows the list to scenes
+	// the caller owns or answered "yes" or "maybe" to. See
+	// schedule.go::ListUpcomingScenes.
+	ListUpcomingScenes(ctx context.Context, in *ListUpcomingScenesRequest, opts ...grpc.CallOption) (*ListUpcomingScenesResponse, error)
 }
 
 type sceneServiceClient struct {
@@ -595,6 +640,76 @@ func (c *sceneServiceClient) ExportSceneLog(ctx context.Context, in *ExportScene
 	return out, nil
 }
 
+func (c *sceneServiceClient) ExportTranscript(ctx context.Context, in *ExportTranscriptRequest, opts ...grpc.CallOption) (*ExportTranscriptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTranscriptResponse)
+	err := c.cc.Invoke(ctx, SceneService_ExportTranscript_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) GetSceneTurns(ctx context.Context, in *GetSceneTurnsRequest, opts ...grpc.CallOption) (*GetSceneTurnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSceneTurnsResponse)
+	err := c.cc.Invoke(ctx, SceneService_GetSceneTurns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) UpdateSceneTurns(ctx context.Context, in *UpdateSceneTurnsRequest, opts ...grpc.CallOption) (*UpdateSceneTurnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSceneTurnsResponse)
+	err := c.cc.Invoke(ctx, SceneService_UpdateSceneTurns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) ScheduleScene(ctx context.Context, in *ScheduleSceneRequest, opts ...grpc.CallOption) (*ScheduleSceneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleSceneResponse)
+	err := c.cc.Invoke(ctx, SceneService_ScheduleScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) RsvpScheduledScene(ctx context.Context, in *RsvpScheduledSceneRequest, opts ...grpc.CallOption) (*RsvpScheduledSceneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RsvpScheduledSceneResponse)
+	err := c.cc.Invoke(ctx, SceneService_RsvpScheduledScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) CancelScheduledScene(ctx context.Context, in *CancelScheduledSceneRequest, opts ...grpc.CallOption) (*CancelScheduledSceneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledSceneResponse)
+	err := c.cc.Invoke(ctx, SceneService_CancelScheduledScene_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sceneServiceClient) ListUpcomingScenes(ctx context.Context, in *ListUpcomingScenesRequest, opts ...grpc.CallOption) (*ListUpcomingScenesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUpcomingScenesResponse)
+	err := c.cc.Invoke(ctx, SceneService_ListUpcomingScenes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SceneServiceServer is the server API for SceneService service.
 // All implementations must embed UnimplementedSceneServiceServer
 // for forward compatibility.
@@ -815,6 +930,44 @@ type SceneServiceServer interface {
 	// SCENE_EXPORT_TOO_LARGE rather than silently truncating the document.
 	// See export.go::ExportSceneLog.
 	ExportSceneLog(context.Context, *ExportSceneLogRequest) (*ExportSceneLogResponse, error)
+	// ExportTranscript renders a scene's full transcript — IC poses, says, and
+	// emits interleaved with OOC lines in event order — as "text", "html", or
+	// "json". ABAC-gated on action "export_transcript" (participants and staff;
+	// fails closed without an evaluator). redact_ooc drops the OOC lines. The
+	// same exportLogMaxRows ceiling applies per stream. See
+	// transcript.go::ExportTranscript.
+	ExportTranscript(context.Context, *ExportTranscriptRequest) (*ExportTranscriptResponse, error)
+	// GetSceneTurns returns the scene's turn tracker: the ordered participant
+	// queue, whose turn it is, and when that turn times out. Same INV-SCENE-60
+	// participant gate as GetPoseOrder (owners and members; the host ABAC
+	// evaluator is not consulted). A scene with no tracker returns an empty
+	// queue. See turns.go::GetSceneTurns.
+	GetSceneTurns(context.Context, *GetSceneTurnsRequest) (*GetSceneTurnsResponse, error)
+	// UpdateSceneTurns applies one turn-tracker operation — "add" or "remove"
+	// a participant, or "skip" the current turn — and emits
+	// scene_turn_changed_ic. The scene owner may act on anyone; a participant
+	// may add or remove themselves and skip their own turn. Turns also advance
+	// automatically when the current character poses, and on timeout. See
+	// turns.go::UpdateSceneTurns.
+	UpdateSceneTurns(context.Context, *UpdateSceneTurnsRequest) (*UpdateSceneTurnsResponse, error)
+	// ScheduleScene announces a scene with a future start time. The calling
+	// character becomes its owner (the GM). At starts_at the scheduled-scene
+	// sweep opens it as an open, active scene with the schedule's id and adds
+	// every "yes" RSVP as a member. See schedule.go::ScheduleScene.
+	ScheduleScene(context.Context, *ScheduleSceneRequest) (*ScheduleSceneResponse, error)
+	// RsvpScheduledScene records the calling character's answer ("yes",
+	// "maybe", or "no") to a scheduled scene, replacing any earlier answer.
+	// Only scenes that have not opened or been cancelled take RSVPs. See
+	// schedule.go::RsvpScheduledScene.
+	RsvpScheduledScene(context.Context, *RsvpScheduledSceneRequest) (*RsvpScheduledSceneResponse, error)
+	// CancelScheduledScene calls off a scheduled scene before it opens. Only
+	// its owner may cancel. See schedule.go::CancelScheduledScene.
+	CancelScheduledScene(context.Context, *CancelScheduledSceneRequest) (*CancelScheduledSceneResponse, error)
+	// ListUpcomingScenes returns scheduled scenes that have not opened yet,
+	// soonest first, with their RSVPs. attending narrows the list to scenes
+	// the caller owns or answered "yes" or "maybe" to. See
+	// schedule.go::ListUpcomingScenes.
+	ListUpcomingScenes(context.Context, *ListUpcomingScenesRequest) (*ListUpcomingScenesResponse, error)
 	mustEmbedUnimplementedSceneServiceServer()
 }
 
@@ -918,6 +1071,27 @@ func (UnimplementedSceneServiceServer) ListPublishedScenes(context.Context, *Lis
 func (UnimplementedSceneServiceServer) ExportSceneLog(context.Context, *ExportSceneLogRequest) (*ExportSceneLogResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExportSceneLog not implemented")
 }
+func (UnimplementedSceneServiceServer) ExportTranscript(context.Context, *ExportTranscriptRequest) (*ExportTranscriptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportTranscript not implemented")
+}
+func (UnimplementedSceneServiceServer) GetSceneTurns(context.Context, *GetSceneTurnsRequest) (*GetSceneTurnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSceneTurns not implemented")
+}
+func (UnimplementedSceneServiceServer) UpdateSceneTurns(context.Context, *UpdateSceneTurnsRequest) (*UpdateSceneTurnsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSceneTurns not implemented")
+}
+func (UnimplementedSceneServiceServer) ScheduleScene(context.Context, *ScheduleSceneRequest) (*ScheduleSceneResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleScene not implemented")
+}
+func (UnimplementedSceneServiceServer) RsvpScheduledScene(context.Context, *RsvpScheduledSceneRequest) (*RsvpScheduledSceneResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RsvpScheduledScene not implemented")
+}
+func (UnimplementedSceneServiceServer) CancelScheduledScene(context.Context, *CancelScheduledSceneRequest) (*CancelScheduledSceneResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledScene not implemented")
+}
+func (UnimplementedSceneServiceServer) ListUpcomingScenes(context.Context, *ListUpcomingScenesRequest) (*ListUpcomingScenesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUpcomingScenes not implemented")
+}
 func (UnimplementedSceneServiceServer) mustEmbedUnimplementedSceneServiceServer() {}
 func (UnimplementedSceneServiceServer) testEmbeddedByValue()                      {}
 
@@ -1497,6 +1671,132 @@ func _SceneService_ExportSceneLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SceneService_ExportTranscript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTranscriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).ExportTranscript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_ExportTranscript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).ExportTranscript(ctx, req.(*ExportTranscriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_GetSceneTurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSceneTurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).GetSceneTurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_GetSceneTurns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).GetSceneTurns(ctx, req.(*GetSceneTurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_UpdateSceneTurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSceneTurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).UpdateSceneTurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_UpdateSceneTurns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).UpdateSceneTurns(ctx, req.(*UpdateSceneTurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_ScheduleScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSceneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).ScheduleScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_ScheduleScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).ScheduleScene(ctx, req.(*ScheduleSceneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_RsvpScheduledScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RsvpScheduledSceneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).RsvpScheduledScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_RsvpScheduledScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).RsvpScheduledScene(ctx, req.(*RsvpScheduledSceneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_CancelScheduledScene_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledSceneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).CancelScheduledScene(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_CancelScheduledScene_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).CancelScheduledScene(ctx, req.(*CancelScheduledSceneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SceneService_ListUpcomingScenes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUpcomingScenesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SceneServiceServer).ListUpcomingScenes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SceneService_ListUpcomingScenes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SceneServiceServer).ListUpcomingScenes(ctx, req.(*ListUpcomingScenesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SceneService_ServiceDesc is the grpc.ServiceDesc for SceneService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1628,6 +1928,34 @@ var SceneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportSceneLog",
 			Handler:    _SceneService_ExportSceneLog_Handler,
 		},
+		{
+			MethodName: "ExportTranscript",
+			Handler:    _SceneService_ExportTranscript_Handler,
+		},
+		{
+			MethodName: "GetSceneTurns",
+			Handler:    _SceneService_GetSceneTurns_Handler,
+		},
+		{
+			MethodName: "UpdateSceneTurns",
+			Handler:    _SceneService_UpdateSceneTurns_Handler,
+		},
+		{
+			MethodName: "ScheduleScene",
+			Handler:    _SceneService_ScheduleScene_Handler,
+		},
+		{
+			MethodName: "RsvpScheduledScene",
+			Handler:    _SceneService_RsvpScheduledScene_Handler,
+		},
+		{
+			MethodName: "CancelScheduledScene",
+			Handler:    _SceneService_CancelScheduledScene_Handler,
+		},
+		{
+			MethodName: "ListUpcomingScenes",
+			Handler:    _SceneService_ListUpcomingScenes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "holomush/scene/v1/scene.proto",
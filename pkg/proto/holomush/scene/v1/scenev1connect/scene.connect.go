@@ -121,6 +121,27 @@ const (
 	// SceneServiceExportSceneLogProcedure is the fully-qualified name of the SceneService's
 	// ExportSceneLog RPC.
 	SceneServiceExportSceneLogProcedure = "/holomush.scene.v1.SceneService/ExportSceneLog"
+	// SceneServiceExportTranscriptProcedure is the fully-qualified name of the SceneService's
+	// ExportTranscript RPC.
+	SceneServiceExportTranscriptProcedure = "/holomush.scene.v1.SceneService/ExportTranscript"
+	// SceneServiceGetSceneTurnsProcedure is the fully-qualified name of the SceneService's
+	// GetSceneTurns RPC.
+	SceneServiceGetSceneTurnsProcedure = "/holomush.scene.v1.SceneService/GetSceneTurns"
+	// SceneServiceUpdateSceneTurnsProcedure is the fully-qualified name of the SceneService's
+	// UpdateSceneTurns RPC.
+	SceneServiceUpdateSceneTurnsProcedure = "/holomush.scene.v1.SceneService/UpdateSceneTurns"
+	// SceneServiceScheduleSceneProcedure is the fully-qualified name of the SceneService's
+	// ScheduleScene RPC.
+	SceneServiceScheduleSceneProcedure = "/holomush.scene.v1.SceneService/ScheduleScene"
+	// SceneServiceRsvpScheduledSceneProcedure is the fully-qualified name of the SceneService's
+	// RsvpScheduledScene RPC.
+	SceneServiceRsvpScheduledSceneProcedure = "/holomush.scene.v1.SceneService/RsvpScheduledScene"
+	// SceneServiceCancelScheduledSceneProcedure is the fully-qualified name of the SceneService's
+	// CancelScheduledScene RPC.
+	SceneServiceCancelScheduledSceneProcedure = "/holomush.scene.v1.SceneService/CancelScheduledScene"
+	// SceneServiceListUpcomingScenesProcedure is the fully-qualified name of the SceneService's
+	// ListUpcomingScenes RPC.
+	SceneServiceListUpcomingScenesProcedure = "/holomush.scene.v1.SceneService/ListUpcomingScenes"
 )
 
 // SceneServiceClient is a client for the holomush.scene.v1.SceneService service.
@@ -313,6 +334,44 @@ type SceneServiceClient interface {
 	// SCENE_EXPORT_TOO_LARGE rather than silently truncating the document.
 	// See export.go::ExportSceneLog.
 	ExportSceneLog(context.Context, *connect.Request[v1.ExportSceneLogRequest]) (*connect.Response[v1.ExportSceneLogResponse], error)
+	// ExportTranscript renders a scene's full transcript — IC poses, says, and
+	// emits interleaved with OOC lines in event order — as "text", "html", or
+	// "json". ABAC-gated on action "export_transcript" (participants and staff;
+	// fails closed without an evaluator). redact_ooc drops the OOC lines. The
+	// same exportLogMaxRows ceiling applies per stream. See
+	// transcript.go::ExportTranscript.
+	ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error)
+	// GetSceneTurns returns the scene's turn tracker: the ordered participant
+	// queue, whose turn it is, and when that turn times out. Same INV-SCENE-60
+	// participant gate as GetPoseOrder (owners and members; the host ABAC
+	// evaluator is not consulted). A scene with no tracker returns an empty
+	// queue. See turns.go::GetSceneTurns.
+	GetSceneTurns(context.Context, *connect.Request[v1.GetSceneTurnsRequest]) (*connect.Response[v1.GetSceneTurnsResponse], error)
+	// UpdateSceneTurns applies one turn-tracker operation — "add" or "remove"
+	// a participant, or "skip" the current turn — and emits
+	// scene_turn_changed_ic. The scene owner may act on anyone; a participant
+	// may add or remove themselves and skip their own turn. Turns also advance
+	// automatically when the current character poses, and on timeout. See
+	// turns.go::UpdateSceneTurns.
+	UpdateSceneTurns(context.Context, *connect.Request[v1.UpdateSceneTurnsRequest]) (*connect.Response[v1.UpdateSceneTurnsResponse], error)
+	// ScheduleScene announces a scene with a future start time. The calling
+	// character becomes its owner (the GM). At starts_at the scheduled-scene
+	// sweep opens it as an open, active scene with the schedule's id and adds
+	// every "yes" RSVP as a member. See schedule.go::ScheduleScene.
+	ScheduleScene(context.Context, *connect.Request[v1.ScheduleSceneRequest]) (*connect.Response[v1.ScheduleSceneResponse], error)
+	// RsvpScheduledScene records the calling character's answer ("yes",
+	// "maybe", or "no") to a scheduled scene, replacing any earlier answer.
+	// Only scenes that have not opened or been cancelled take RSVPs. See
+	// schedule.go::RsvpScheduledScene.
+	RsvpScheduledScene(context.Context, *connect.Request[v1.RsvpScheduledSceneRequest]) (*connect.Response[v1.RsvpScheduledSceneResponse], error)
+	// CancelScheduledScene calls off a scheduled scene before it opens. Only
+	// its owner may cancel. See schedule.go::CancelScheduledScene.
+	CancelScheduledScene(context.Context, *connect.Request[v1.CancelScheduledSceneRequest]) (*connect.Response[v1.CancelScheduledSceneResponse], error)
+	// ListUpcomingScenes returns scheduled scenes that have not opened yet,
+	// soonest first, with their RSVPs. attending narrows the list to scenes
+	// the caller owns or answered "yes" or "maybe" to. See
+	// schedule.go::ListUpcomingScenes.
+	ListUpcomingScenes(context.Context, *connect.Request[v1.ListUpcomingScenesRequest]) (*connect.Response[v1.ListUpcomingScenesResponse], error)
 }
 
 // NewSceneServiceClient constructs a client for the holomush.scene.v1.SceneService service. By
@@ -512,6 +571,48 @@ func NewSceneServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(sceneServiceMethods.ByName("ExportSceneLog")),
 			connect.WithClientOptions(opts...),
 		),
+		exportTranscript: connect.NewClient[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse](
+			httpClient,
+			baseURL+SceneServiceExportTranscriptProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("ExportTranscript")),
+			connect.WithClientOptions(opts...),
+		),
+		getSceneTurns: connect.NewClient[v1.GetSceneTurnsRequest, v1.GetSceneTurnsResponse](
+			httpClient,
+			baseURL+SceneServiceGetSceneTurnsProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("GetSceneTurns")),
+			connect.WithClientOptions(opts...),
+		),
+		updateSceneTurns: connect.NewClient[v1.UpdateSceneTurnsRequest, v1.UpdateSceneTurnsResponse](
+			httpClient,
+			baseURL+SceneServiceUpdateSceneTurnsProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("UpdateSceneTurns")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleScene: connect.NewClient[v1.ScheduleSceneRequest, v1.ScheduleSceneResponse](
+			httpClient,
+			baseURL+SceneServiceScheduleSceneProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("ScheduleScene")),
+			connect.WithClientOptions(opts...),
+		),
+		rsvpScheduledScene: connect.NewClient[v1.RsvpScheduledSceneRequest, v1.RsvpScheduledSceneResponse](
+			httpClient,
+			baseURL+SceneServiceRsvpScheduledSceneProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("RsvpScheduledScene")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledScene: connect.NewClient[v1.CancelScheduledSceneRequest, v1.CancelScheduledSceneResponse](
+			httpClient,
+			baseURL+SceneServiceCancelScheduledSceneProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("CancelScheduledScene")),
+			connect.WithClientOptions(opts...),
+		),
+		listUpcomingScenes: connect.NewClient[v1.ListUpcomingScenesRequest, v1.ListUpcomingScenesResponse](
+			httpClient,
+			baseURL+SceneServiceListUpcomingScenesProcedure,
+			connect.WithSchema(sceneServiceMethods.ByName("ListUpcomingScenes")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -548,6 +649,13 @@ type sceneServiceClient struct {
 	listCharacterScenes            *connect.Client[v1.ListCharacterScenesRequest, v1.ListCharacterScenesResponse]
 	listPublishedScenes            *connect.Client[v1.ListPublishedScenesRequest, v1.ListPublishedScenesResponse]
 	exportSceneLog                 *connect.Client[v1.ExportSceneLogRequest, v1.ExportSceneLogResponse]
+	exportTranscript               *connect.Client[v1.ExportTranscriptRequest, v1.ExportTranscriptResponse]
+	getSceneTurns                  *connect.Client[v1.GetSceneTurnsRequest, v1.GetSceneTurnsResponse]
+	updateSceneTurns               *connect.Client[v1.UpdateSceneTurnsRequest, v1.UpdateSceneTurnsResponse]
+	scheduleScene                  *connect.Client[v1.ScheduleSceneRequest, v1.ScheduleSceneResponse]
+	rsvpScheduledScene             *connect.Client[v1.RsvpScheduledSceneRequest, v1.RsvpScheduledSceneResponse]
+	cancelScheduledScene           *connect.Client[v1.CancelScheduledSceneRequest, v1.CancelScheduledSceneResponse]
+	listUpcomingScenes             *connect.Client[v1.ListUpcomingScenesRequest, v1.ListUpcomingScenesResponse]
 }
 
 // ListScenes calls holomush.scene.v1.SceneService.ListScenes.
@@ -706,6 +814,41 @@ func (c *sceneServiceClient) ExportSceneLog(ctx context.Context, req *connect.Re
 	return c.exportSceneLog.CallUnary(ctx, req)
 }
 
+// ExportTranscript calls holomush.scene.v1.SceneService.ExportTranscript.
+func (c *sceneServiceClient) ExportTranscript(ctx context.Context, req *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	return c.exportTranscript.CallUnary(ctx, req)
+}
+
+// GetSceneTurns calls holomush.scene.v1.SceneService.GetSceneTurns.
+func (c *sceneServiceClient) GetSceneTurns(ctx context.Context, req *connect.Request[v1.GetSceneTurnsRequest]) (*connect.Response[v1.GetSceneTurnsResponse], error) {
+	return c.getSceneTurns.CallUnary(ctx, req)
+}
+
+// UpdateSceneTurns calls holomush.scene.v1.SceneService.UpdateSceneTurns.
+func (c *sceneServiceClient) UpdateSceneTurns(ctx context.Context, req *connect.Request[v1.UpdateSceneTurnsRequest]) (*connect.Response[v1.UpdateSceneTurnsResponse], error) {
+	return c.updateSceneTurns.CallUnary(ctx, req)
+}
+
+// ScheduleScene calls holomush.scene.v1.SceneService.ScheduleScene.
+func (c *sceneServiceClient) ScheduleScene(ctx context.Context, req *connect.Request[v1.ScheduleSceneRequest]) (*connect.Response[v1.ScheduleSceneResponse], error) {
+	return c.scheduleScene.CallUnary(ctx, req)
+}
+
+// RsvpScheduledScene calls holomush.scene.v1.SceneService.RsvpScheduledScene.
+func (c *sceneServiceClient) RsvpScheduledScene(ctx context.Context, req *connect.Request[v1.RsvpScheduledSceneRequest]) (*connect.Response[v1.RsvpScheduledSceneResponse], error) {
+	return c.rsvpScheduledScene.CallUnary(ctx, req)
+}
+
+// CancelScheduledScene calls holomush.scene.v1.SceneService.CancelScheduledScene.
+func (c *sceneServiceClient) CancelScheduledScene(ctx context.Context, req *connect.Request[v1.CancelScheduledSceneRequest]) (*connect.Response[v1.CancelScheduledSceneResponse], error) {
+	return c.cancelScheduledScene.CallUnary(ctx, req)
+}
+
+// ListUpcomingScenes calls holomush.scene.v1.SceneService.ListUpcomingScenes.
+func (c *sceneServiceClient) ListUpcomingScenes(ctx context.Context, req *connect.Request[v1.ListUpcomingScenesRequest]) (*connect.Response[v1.ListUpcomingScenesResponse], error) {
+	return c.listUpcomingScenes.CallUnary(ctx, req)
+}
+
 // SceneServiceHandler is an implementation of the holomush.scene.v1.SceneService service.
 type SceneServiceHandler interface {
 	// ListScenes returns the public scene board: open scenes in state `active`
@@ -896,6 +1039,44 @@ type SceneServiceHandler interface {
 	// SCENE_EXPORT_TOO_LARGE rather than silently truncating the document.
 	// See export.go::ExportSceneLog.
 	ExportSceneLog(context.Context, *connect.Request[v1.ExportSceneLogRequest]) (*connect.Response[v1.ExportSceneLogResponse], error)
+	// ExportTranscript renders a scene's full transcript — IC poses, says, and
+	// emits interleaved with OOC lines in event order — as "text", "html", or
+	// "json". ABAC-gated on action "export_transcript" (participants and staff;
+	// fails closed without an evaluator). redact_ooc drops the OOC lines. The
+	// same exportLogMaxRows ceiling applies per stream. See
+	// transcript.go::ExportTranscript.
+	ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error)
+	// GetSceneTurns returns the scene's turn tracker: the ordered participant
+	// queue, whose turn it is, and when that turn times out. Same INV-SCENE-60
+	// participant gate as GetPoseOrder (owners and members; the host ABAC
+	// evaluator is not consulted). A scene with no tracker returns an empty
+	// queue. See turns.go::GetSceneTurns.
+	GetSceneTurns(context.Context, *connect.Request[v1.GetSceneTurnsRequest]) (*connect.Response[v1.GetSceneTurnsResponse], error)
+	// UpdateSceneTurns applies one turn-tracker operation — "add" or "remove"
+	// a participant, or "skip" the current turn — and emits
+	// scene_turn_changed_ic. The scene owner may act on anyone; a participant
+	// may add or remove themselves and skip their own turn. Turns also advance
+	// automatically when the current character poses, and on timeout. See
+	// turns.go::UpdateSceneTurns.
+	UpdateSceneTurns(context.Context, *connect.Request[v1.UpdateSceneTurnsRequest]) (*connect.Response[v1.UpdateSceneTurnsResponse], error)
+	// ScheduleScene announces a scene with a future start time. The calling
+	// character becomes its owner (the GM). At starts_at the scheduled-scene
+	// sweep opens it as an open, active scene with the schedule's id and adds
+	// every "yes" RSVP as a member. See schedule.go::ScheduleScene.
+	ScheduleScene(context.Context, *connect.Request[v1.ScheduleSceneRequest]) (*connect.Response[v1.ScheduleSceneResponse], error)
+	// RsvpScheduledScene records the calling character's answer ("yes",
+	// "maybe", or "no") to a scheduled scene, replacing any earlier answer.
+	// Only scenes that have not opened or been cancelled take RSVPs. See
+	// schedule.go::RsvpScheduledScene.
+	RsvpScheduledScene(context.Context, *connect.Request[v1.RsvpScheduledSceneRequest]) (*connect.Response[v1.RsvpScheduledSceneResponse], error)
+	// CancelScheduledScene calls off a scheduled scene before it opens. Only
+	// its owner may cancel. See schedule.go::CancelScheduledScene.
+	CancelScheduledScene(context.Context, *connect.Request[v1.CancelScheduledSceneRequest]) (*connect.Response[v1.CancelScheduledSceneResponse], error)
+	// ListUpcomingScenes returns scheduled scenes that have not opened yet,
+	// soonest first, with their RSVPs. attending narrows the list to scenes
+	// the caller owns or answered "yes" or "maybe" to. See
+	// schedule.go::ListUpcomingScenes.
+	ListUpcomingScenes(context.Context, *connect.Request[v1.ListUpcomingScenesRequest]) (*connect.Response[v1.ListUpcomingScenesResponse], error)
 }
 
 // NewSceneServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1091,6 +1272,48 @@ func NewSceneServiceHandler(svc SceneServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(sceneServiceMethods.ByName("ExportSceneLog")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sceneServiceExportTranscriptHandler := connect.NewUnaryHandler(
+		SceneServiceExportTranscriptProcedure,
+		svc.ExportTranscript,
+		connect.WithSchema(sceneServiceMethods.ByName("ExportTranscript")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceGetSceneTurnsHandler := connect.NewUnaryHandler(
+		SceneServiceGetSceneTurnsProcedure,
+		svc.GetSceneTurns,
+		connect.WithSchema(sceneServiceMethods.ByName("GetSceneTurns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceUpdateSceneTurnsHandler := connect.NewUnaryHandler(
+		SceneServiceUpdateSceneTurnsProcedure,
+		svc.UpdateSceneTurns,
+		connect.WithSchema(sceneServiceMethods.ByName("UpdateSceneTurns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceScheduleSceneHandler := connect.NewUnaryHandler(
+		SceneServiceScheduleSceneProcedure,
+		svc.ScheduleScene,
+		connect.WithSchema(sceneServiceMethods.ByName("ScheduleScene")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceRsvpScheduledSceneHandler := connect.NewUnaryHandler(
+		SceneServiceRsvpScheduledSceneProcedure,
+		svc.RsvpScheduledScene,
+		connect.WithSchema(sceneServiceMethods.ByName("RsvpScheduledScene")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceCancelScheduledSceneHandler := connect.NewUnaryHandler(
+		SceneServiceCancelScheduledSceneProcedure,
+		svc.CancelScheduledScene,
+		connect.WithSchema(sceneServiceMethods.ByName("CancelScheduledScene")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sceneServiceListUpcomingScenesHandler := connect.NewUnaryHandler(
+		SceneServiceListUpcomingScenesProcedure,
+		svc.ListUpcomingScenes,
+		connect.WithSchema(sceneServiceMethods.ByName("ListUpcomingScenes")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/holomush.scene.v1.SceneService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SceneServiceListScenesProcedure:
@@ -1155,6 +1378,20 @@ func NewSceneServiceHandler(svc SceneServiceHandler, opts ...connect.HandlerOpti
 			sceneServiceListPublishedScenesHandler.ServeHTTP(w, r)
 		case SceneServiceExportSceneLogProcedure:
 			sceneServiceExportSceneLogHandler.ServeHTTP(w, r)
+		case SceneServiceExportTranscriptProcedure:
+			sceneServiceExportTranscriptHandler.ServeHTTP(w, r)
+		case SceneServiceGetSceneTurnsProcedure:
+			sceneServiceGetSceneTurnsHandler.ServeHTTP(w, r)
+		case SceneServiceUpdateSceneTurnsProcedure:
+			sceneServiceUpdateSceneTurnsHandler.ServeHTTP(w, r)
+		case SceneServiceScheduleSceneProcedure:
+			sceneServiceScheduleSceneHandler.ServeHTTP(w, r)
+		case SceneServiceRsvpScheduledSceneProcedure:
+			sceneServiceRsvpScheduledSceneHandler.ServeHTTP(w, r)
+		case SceneServiceCancelScheduledSceneProcedure:
+			sceneServiceCancelScheduledSceneHandler.ServeHTTP(w, r)
+		case SceneServiceListUpcomingScenesProcedure:
+			sceneServiceListUpcomingScenesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1287,3 +1524,31 @@ func (UnimplementedSceneServiceHandler) ListPublishedScenes(context.Context, *co
 func (UnimplementedSceneServiceHandler) ExportSceneLog(context.Context, *connect.Request[v1.ExportSceneLogRequest]) (*connect.Response[v1.ExportSceneLogResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.ExportSceneLog is not implemented"))
 }
+
+func (UnimplementedSceneServiceHandler) ExportTranscript(context.Context, *connect.Request[v1.ExportTranscriptRequest]) (*connect.Response[v1.ExportTranscriptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.ExportTranscript is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) GetSceneTurns(context.Context, *connect.Request[v1.GetSceneTurnsRequest]) (*connect.Response[v1.GetSceneTurnsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.GetSceneTurns is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) UpdateSceneTurns(context.Context, *connect.Request[v1.UpdateSceneTurnsRequest]) (*connect.Response[v1.UpdateSceneTurnsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.UpdateSceneTurns is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) ScheduleScene(context.Context, *connect.Request[v1.ScheduleSceneRequest]) (*connect.Response[v1.ScheduleSceneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.ScheduleScene is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) RsvpScheduledScene(context.Context, *connect.Request[v1.RsvpScheduledSceneRequest]) (*connect.Response[v1.RsvpScheduledSceneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.RsvpScheduledScene is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) CancelScheduledScene(context.Context, *connect.Request[v1.CancelScheduledSceneRequest]) (*connect.Response[v1.CancelScheduledSceneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.CancelScheduledScene is not implemented"))
+}
+
+func (UnimplementedSceneServiceHandler) ListUpcomingScenes(context.Context, *connect.Request[v1.ListUpcomingScenesRequest]) (*connect.Response[v1.ListUpcomingScenesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("holomush.scene.v1.SceneService.ListUpcomingScenes is not implemented"))
+}
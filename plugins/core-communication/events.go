@@ -16,12 +16,13 @@ type EventType string
 
 // Event-type constants. All are qualified with the plugin name.
 const (
-	EventTypeEmit          EventType = "core-communication:emit"
-	EventTypeOOC           EventType = "core-communication:ooc"
-	EventTypePage          EventType = "core-communication:page"
-	EventTypePemit         EventType = "core-communication:pemit"
-	EventTypePose          EventType = "core-communication:pose"
-	EventTypeSay           EventType = "core-communication:say"
-	EventTypeWhisper       EventType = "core-communication:whisper"
-	EventTypeWhisperNotice EventType = "core-communication:whisper_notice"
+	EventTypeEmit             EventType = "core-communication:emit"
+	EventTypeOOC              EventType = "core-communication:ooc"
+	EventTypePage             EventType = "core-communication:page" // no longer emitted; pages sent before paging moved to core
+	EventTypePemit            EventType = "core-communication:pemit"
+	EventTypePose             EventType = "core-communication:pose"
+	EventTypeSay              EventType = "core-communication:say"
+	EventTypeWhisper          EventType = "core-communication:whisper"
+	EventTypeWhisperNotice    EventType = "core-communication:whisper_notice"
+	EventTypeWhisperOverheard EventType = "core-communication:whisper_overheard"
 )
@@ -22,6 +22,7 @@ func TestEventTypesAreQualifiedWithPluginName(t *testing.T) {
 		corecomm.EventTypeSay,
 		corecomm.EventTypeWhisper,
 		corecomm.EventTypeWhisperNotice,
+		corecomm.EventTypeWhisperOverheard,
 	} {
 		assert.True(
 			t,
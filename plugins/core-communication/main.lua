@@ -1,7 +1,8 @@
 -- SPDX-License-Identifier: Apache-2.0
 -- Copyright 2026 HoloMUSH Contributors
 
--- core-communication: provides say, pose, ooc, emit, page, whisper, pemit, wall.
+-- core-communication: provides say, pose, ooc, emit, whisper, pemit, wall.
+-- Paging is a core command (internal/paging).
 
 -- Host-brokered capability tables (holomush-eykuh.4). The session lookups live
 -- on the brokered `session` global (PascalCase proto-RPC methods); broadcast is
@@ -20,10 +21,10 @@ holomush.register_emit_type("say")
 holomush.register_emit_type("pose")
 holomush.register_emit_type("ooc")
 holomush.register_emit_type("emit")
-holomush.register_emit_type("page")
 holomush.register_emit_type("whisper")
 holomush.register_emit_type("pemit")
 holomush.register_emit_type("whisper_notice")
+holomush.register_emit_type("whisper_overheard")
 
 -- ---------------------------------------------------------------------------
 -- Helpers
@@ -169,131 +170,134 @@ local function handle_emit(ctx)
 end
 
 -- ---------------------------------------------------------------------------
--- page
+-- whisper
 -- ---------------------------------------------------------------------------
 
-local function handle_page(ctx)
-    local args = trim(ctx.args or "")
-    if args == "" then
-        return error_response("Usage: page <name>=<message>")
-    end
-
-    if not session_caps then
-        return error_response("This command requires session access which is not yet available.")
-    end
-
-    local target_name, raw_message
-    local use_last_paged = false
-
-    local eq = args:find("=", 1, true)
-    if eq and eq > 1 then
-        target_name = trim(args:sub(1, eq - 1))
-        raw_message = args:sub(eq + 1) -- do NOT trim: leading : or ; is meaningful
-    elseif eq == 1 then
-        return error_response("Usage: page <name>=<message>")
-    else
-        raw_message = args
-        use_last_paged = true
-    end
+-- config_int reads an int from the plugin config (plugin.yaml's config block),
+-- falling back to default when the key is absent or the config table is not
+-- installed.
+local function config_int(key, default)
+    local cfg = holomush.config
+    local v = cfg and cfg.int(key)
+    if v == nil then return default end
+    return v
+end
 
-    if trim(raw_message or "") == "" then
-        return error_response("Usage: page <name>=<message>")
-    end
+-- config_bool is config_int for booleans.
+local function config_bool(key, default)
+    local cfg = holomush.config
+    local v = cfg and cfg.bool(key)
+    if v == nil then return default end
+    return v
+end
 
-    -- Resolve target name from last-paged if needed.
-    if use_last_paged then
-        local sender_resp, err = session_caps.FindByName({name = ctx.character_name})
-        if err then
-            holomush.log("error", "page: failed to find sender session: " .. err)
-            return failure_response("Unable to page right now. Please try again.")
-        end
-        local sender_session = sender_resp and sender_resp.session
-        if not sender_session or sender_session.last_whispered == "" then
-            return error_response("You have no last-paged character. Use: page <name>=<message>")
+-- split_names splits a comma-separated target list, dropping blanks.
+-- Character names may contain spaces, so only commas separate targets.
+local function split_names(s)
+    local names = {}
+    for part in s:gmatch("[^,]+") do
+        local name = trim(part)
+        if name ~= "" then
+            names[#names + 1] = name
         end
-        target_name = sender_session.last_whispered
     end
+    return names
+end
 
-    -- Look up target session.
-    local target_resp, target_err = session_caps.FindByName({name = target_name})
-    if target_err then
-        holomush.log("error", "page: failed to find session for " .. target_name .. ": " .. target_err)
-        return failure_response('Unable to reach "' .. target_name .. '" right now. Please try again.')
-    end
-    local target_session = target_resp and target_resp.session
-    if not target_session then
-        return error_response('No one named "' .. target_name .. '" is connected.')
+-- join_names renders a name list as "A", "A and B", or "A, B and C".
+local function join_names(names)
+    if #names <= 1 then
+        return names[1] or ""
     end
+    return table.concat(names, ", ", 1, #names - 1) .. " and " .. names[#names]
+end
 
-    -- Determine pose vs. normal message.
-    local is_pose = false
-    local formatted_for_target, formatted_for_sender
+-- json_string_array encodes a list of strings as a JSON array.
+local function json_string_array(list)
+    local parts = {}
+    for i, s in ipairs(list) do
+        parts[i] = json_string(s)
+    end
+    return "[" .. table.concat(parts, ",") .. "]"
+end
 
-    if raw_message:sub(1, 1) == ":" then
-        local action = trim(raw_message:sub(2))
-        if action == "" then
-            return error_response("Usage: page <name>=:<action>")
+-- overhear_fragment returns the part of message a bystander catches: each
+-- word is heard or lost at random, and each run of lost words becomes "...".
+-- A message of more than one word always loses at least one word and keeps
+-- at least one.
+local function overhear_fragment(message)
+    local words = {}
+    for w in message:gmatch("%S+") do
+        words[#words + 1] = w
+    end
+    local heard = {}
+    local kept = 0
+    for i = 1, #words do
+        heard[i] = math.random(2) == 1
+        if heard[i] then kept = kept + 1 end
+    end
+    if #words > 1 then
+        if kept == #words then
+            heard[math.random(#words)] = false
+        elseif kept == 0 then
+            heard[math.random(#words)] = true
         end
-        is_pose = true
-        formatted_for_target = "From afar, " .. ctx.character_name .. " " .. action
-        formatted_for_sender = "Long distance to " .. target_session.character_name .. ": " .. ctx.character_name .. " " .. action
+    end
 
-    elseif raw_message:sub(1, 1) == ";" then
-        local action = trim(raw_message:sub(2))
-        if action == "" then
-            return error_response("Usage: page <name>=;<action>")
+    local parts = {}
+    local lost = false
+    for i, w in ipairs(words) do
+        if heard[i] then
+            parts[#parts + 1] = w
+            lost = false
+        elseif not lost then
+            parts[#parts + 1] = "..."
+            lost = true
         end
-        is_pose = true
-        formatted_for_target = "From afar, " .. ctx.character_name .. action
-        formatted_for_sender = "Long distance to " .. target_session.character_name .. ": " .. ctx.character_name .. action
-
-    else
-        formatted_for_target = ctx.character_name .. " pages: " .. raw_message
-        formatted_for_sender = "You paged " .. target_session.character_name .. ": " .. raw_message
     end
+    return table.concat(parts, " ")
+end
 
-    -- Build page event payload for target's character stream.
-    local payload = '{"sender_id":' .. json_string(ctx.character_id) ..
-                    ',"sender_name":' .. json_string(ctx.character_name) ..
-                    ',"message":' .. json_string(formatted_for_target) ..
-                    ',"is_pose":' .. json_bool(is_pose) .. '}'
-
-    -- Update last-paged on the sender's session.
-    if ctx.session_id and ctx.session_id ~= "" then
-        local _, set_err = session_caps.SetLastWhispered({session_id = ctx.session_id, name = target_session.character_name})
-        if set_err then
-            holomush.log("warn", "page: failed to update last-whispered: " .. set_err)
+-- whisper_bystanders returns the grid-present characters in loc, one entry
+-- per character, excluding everyone in skip (a set of character IDs). A
+-- session lookup failure is logged and yields no bystanders: nobody
+-- overhears rather than the whisper failing.
+local function whisper_bystanders(loc, skip)
+    local list_resp, list_err = session_caps.ListActive({})
+    if list_err then
+        holomush.log("warn", "whisper: failed to list sessions for overhearing: " .. list_err)
+        return {}
+    end
+    local seen = {}
+    local out = {}
+    for _, s in ipairs((list_resp and list_resp.sessions) or {}) do
+        local id = s.character_id or ""
+        if s.location_id == loc and s.grid_present and id ~= "" and not skip[id] and not seen[id] then
+            seen[id] = true
+            out[#out + 1] = s
         end
     end
-
-    return ok_events(
-        {{subject ="character." .. target_session.character_id, type = "core-communication:page", payload = payload, sensitive = true}},
-        formatted_for_sender
-    )
+    return out
 end
 
--- ---------------------------------------------------------------------------
--- whisper
--- ---------------------------------------------------------------------------
-
 local function handle_whisper(ctx)
     local args = trim(ctx.args or "")
     if args == "" then
-        return error_response("Usage: whisper <name>=<message>")
+        return error_response("Usage: whisper <name>[,<name>...]=<message>")
     end
 
     if not session_caps then
         return error_response("This command requires session access which is not yet available.")
     end
 
-    local target_name, message
+    local target_list, message
 
     local eq = args:find("=", 1, true)
     if eq and eq > 1 then
-        target_name = trim(args:sub(1, eq - 1))
+        target_list = trim(args:sub(1, eq - 1))
         message = args:sub(eq + 1)
     elseif ctx.invoked_as == "w" then
-        -- Short form: use last whispered target.
+        -- Short form: use last whispered targets.
         local sender_resp, err = session_caps.FindByName({name = ctx.character_name})
         if err then
             holomush.log("error", "whisper: failed to find sender session: " .. err)
@@ -303,40 +307,59 @@ local function handle_whisper(ctx)
         if not sender_session or sender_session.last_whispered == "" then
             return error_response("Whisper to whom? Use: whisper <name>=<message>")
         end
-        target_name = sender_session.last_whispered
+        target_list = sender_session.last_whispered
         message = args
     else
-        return error_response("Usage: whisper <name>=<message> or w <message>")
+        return error_response("Usage: whisper <name>[,<name>...]=<message> or w <message>")
     end
 
-    if not target_name or target_name == "" then
+    local target_names = split_names(target_list or "")
+    if #target_names == 0 then
         return error_response("Whisper to whom? Use: whisper <name>=<message>")
     end
     if not message or message == "" then
         return error_response("What do you want to whisper?")
     end
 
-    -- Find target session.
-    local target_resp, target_err = session_caps.FindByName({name = target_name})
-    if target_err then
-        holomush.log("error", "whisper: failed to find session for " .. target_name .. ": " .. target_err)
-        return failure_response('Unable to reach "' .. target_name .. '" right now. Please try again.')
-    end
-    local target = target_resp and target_resp.session
-    if not target then
-        return error_response('No one named "' .. target_name .. '" is connected.')
-    end
-
     -- Reject location-less whispers.
     local loc = ctx.location_id or ""
     if loc == "" or loc == "00000000000000000000000000" then
         return error_response("You must be in a location to whisper.")
     end
 
-    -- Same-location check.
-    if target.location_id ~= loc then
-        return error_response('You don\'t see anyone named "' .. target_name .. '" here.')
+    -- Find each target's session; all must be here. A name given twice is
+    -- whispered to once.
+    local targets = {}
+    local target_ids = {}
+    local is_target = {}
+    for _, target_name in ipairs(target_names) do
+        local target_resp, target_err = session_caps.FindByName({name = target_name})
+        if target_err then
+            holomush.log("error", "whisper: failed to find session for " .. target_name .. ": " .. target_err)
+            return failure_response('Unable to reach "' .. target_name .. '" right now. Please try again.')
+        end
+        local target = target_resp and target_resp.session
+        if not target then
+            return error_response('No one named "' .. target_name .. '" is connected.')
+        end
+        if target.location_id ~= loc then
+            return error_response('You don\'t see anyone named "' .. target_name .. '" here.')
+        end
+        if target.character_id == ctx.character_id then
+            return error_response("You can't whisper to yourself.")
+        end
+        if not is_target[target.character_id] then
+            is_target[target.character_id] = true
+            targets[#targets + 1] = target
+            target_ids[#target_ids + 1] = target.character_id
+        end
+    end
+
+    local names = {}
+    for i, target in ipairs(targets) do
+        names[i] = target.character_name
     end
+    local joined = join_names(names)
 
     -- Detect pose mode.
     local is_pose = false
@@ -367,37 +390,78 @@ local function handle_whisper(ctx)
     -- Build sender confirmation.
     local sender_msg
     if is_pose then
-        sender_msg = "You whisper-pose to " .. target.character_name .. ": " .. message
+        sender_msg = "You whisper-pose to " .. joined .. ": " .. message
     else
-        sender_msg = "You whisper to " .. target.character_name .. ": " .. message
+        sender_msg = "You whisper to " .. joined .. ": " .. message
     end
 
-    -- Build notice payload for location (content not revealed).
-    local notice_payload = '{"sender_name":' .. json_string(ctx.character_name) ..
-                           ',"target_name":' .. json_string(target.character_name) ..
-                           ',"notice":' .. json_string(ctx.character_name .. " whispers to " .. target.character_name .. ".") .. '}'
+    local events = {}
 
-    -- Build whisper payload for target.
-    local whisper_payload = '{"sender_id":' .. json_string(ctx.character_id) ..
-                            ',"sender_name":' .. json_string(ctx.character_name) ..
-                            ',"message":' .. json_string(target_msg) ..
-                            ',"is_pose":' .. json_bool(is_pose) .. '}'
+    -- Bystanders may overhear part of the whisper (plugin config
+    -- overhear_chance, a percentage). Each overhearer gets the fragment on
+    -- their own character stream.
+    local overhear_chance = config_int("overhear_chance", 0)
+    if overhear_chance > 0 then
+        local skip = {[ctx.character_id] = true}
+        for id in pairs(is_target) do
+            skip[id] = true
+        end
+        for _, bystander in ipairs(whisper_bystanders(loc, skip)) do
+            if math.random(100) <= overhear_chance then
+                local fragment = overhear_fragment(message)
+                local overheard_payload = '{"sender_id":' .. json_string(ctx.character_id) ..
+                                          ',"sender_name":' .. json_string(ctx.character_name) ..
+                                          ',"target_names":' .. json_string_array(names) ..
+                                          ',"fragment":' .. json_string(fragment) ..
+                                          ',"is_pose":' .. json_bool(is_pose) ..
+                                          ',"notice":' .. json_string('whispers to ' .. joined .. ', and you catch "' .. fragment .. '".') .. '}'
+                events[#events + 1] = {subject = "character." .. bystander.character_id, type = "core-communication:whisper_overheard", payload = overheard_payload, sensitive = true}
+            end
+        end
+    end
+    -- Location notice (content not revealed). party_ids names the sender
+    -- and recipients, who get the whisper itself; the host fan-out drops the
+    -- notice for them. Overhearers are left off: the notice reaches the
+    -- whole room, and listing them would tell everyone who overheard.
+    local party_ids = {ctx.character_id}
+    for _, id in ipairs(target_ids) do
+        party_ids[#party_ids + 1] = id
+    end
+    if config_bool("whisper_notice", true) then
+        local notice_payload = '{"sender_id":' .. json_string(ctx.character_id) ..
+                               ',"sender_name":' .. json_string(ctx.character_name) ..
+                               ',"target_name":' .. json_string(joined) ..
+                               ',"target_ids":' .. json_string_array(target_ids) ..
+                               ',"party_ids":' .. json_string_array(party_ids) ..
+                               ',"notice":' .. json_string(ctx.character_name .. " whispers to " .. joined .. ".") .. '}'
+        table.insert(events, 1, {subject = "location." .. loc, type = "core-communication:whisper_notice", payload = notice_payload})
+    end
+
+    -- Whisper to each target, naming the other recipients.
+    for _, target in ipairs(targets) do
+        local others = {}
+        for _, name in ipairs(names) do
+            if name ~= target.character_name then
+                others[#others + 1] = name
+            end
+        end
+        local whisper_payload = '{"sender_id":' .. json_string(ctx.character_id) ..
+                                ',"sender_name":' .. json_string(ctx.character_name) ..
+                                ',"message":' .. json_string(target_msg) ..
+                                ',"also_to":' .. json_string_array(others) ..
+                                ',"is_pose":' .. json_bool(is_pose) .. '}'
+        events[#events + 1] = {subject = "character." .. target.character_id, type = "core-communication:whisper", payload = whisper_payload, sensitive = true}
+    end
 
-    -- Record last whispered target.
+    -- Record last whispered targets; "w <message>" reuses the list.
     if ctx.session_id and ctx.session_id ~= "" then
-        local _, set_err = session_caps.SetLastWhispered({session_id = ctx.session_id, name = target.character_name})
+        local _, set_err = session_caps.SetLastWhispered({session_id = ctx.session_id, name = table.concat(names, ", ")})
         if set_err then
             holomush.log("warn", "whisper: failed to update last-whispered: " .. set_err)
         end
     end
 
-    return ok_events(
-        {
-            {subject ="location." .. loc, type = "core-communication:whisper_notice", payload = notice_payload},
-            {subject ="character." .. target.character_id, type = "core-communication:whisper", payload = whisper_payload, sensitive = true},
-        },
-        sender_msg
-    )
+    return ok_events(events, sender_msg)
 end
 
 -- ---------------------------------------------------------------------------
@@ -548,8 +612,6 @@ function on_command(ctx)
         return handle_ooc(ctx)
     elseif cmd == "emit" then
         return handle_emit(ctx)
-    elseif cmd == "page" then
-        return handle_page(ctx)
     elseif cmd == "whisper" then
         return handle_whisper(ctx)
     elseif cmd == "pemit" then
@@ -17,7 +17,7 @@ import (
 // stubHolomush installs a minimal `holomush` global carrying just what
 // main.lua's say/pose/ooc/emit path needs: register_emit_type (called at
 // module-load time for the INV-PLUGIN-32 emit-type registration block) and log
-// (used by handlers outside this harness's scope, e.g. page/whisper/wall) —
+// (used by handlers outside this harness's scope, e.g. whisper/wall) —
 // mirroring plugins/core-help/help_lua_test.go's registerHolomushStub.
 func stubHolomush(L *lua.LState) {
 	holomush := L.NewTable()
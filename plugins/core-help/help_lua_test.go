@@ -38,12 +38,26 @@ type listCommandsResult struct {
 // (error path).
 func runHelp(t *testing.T, args string, stub listCommandsResult) string {
 	t.Helper()
+	return runHelpWithTopics(t, args, stub, nil)
+}
+
+// helpTopic is one stubbed help topic served by the holomush.help_* stubs.
+type helpTopic struct {
+	name, title, category, text string
+}
+
+// runHelpWithTopics is runHelp with the holomush.help_* host functions
+// serving topics. A nil topics slice stubs them as unavailable, the way the
+// host behaves when no help service is configured.
+func runHelpWithTopics(t *testing.T, args string, stub listCommandsResult, topics []helpTopic) string {
+	t.Helper()
 
 	L := lua.NewState()
 	defer L.Close()
 
 	registerFmtStub(L)
 	registerHolomushStub(L, stub)
+	registerHelpTopicStubs(L, topics)
 
 	require.NoError(t, L.DoFile("main.lua"), "load core-help main.lua")
 
@@ -142,6 +156,70 @@ func registerHolomushStub(L *lua.LState, stub listCommandsResult) {
 	L.SetGlobal("holomush", holomush)
 }
 
+// registerHelpTopicStubs installs holomush.get_command_help (always "command
+// not found") and the holomush.help_topic / help_list / help_search stubs on
+// the global holomush table. help_search matches the term as a substring of
+// the topic name or title.
+func registerHelpTopicStubs(L *lua.LState, topics []helpTopic) {
+	holomush := L.GetGlobal("holomush").(*lua.LTable)
+	L.SetField(holomush, "get_command_help", L.NewFunction(func(s *lua.LState) int {
+		s.Push(lua.LNil)
+		s.Push(lua.LString("command not found: " + s.CheckString(1)))
+		return 2
+	}))
+	unavailable := func(s *lua.LState) int {
+		s.Push(lua.LNil)
+		s.Push(lua.LString("help not available"))
+		return 2
+	}
+	summaries := func(s *lua.LState, match func(helpTopic) bool) *lua.LTable {
+		out := s.NewTable()
+		for _, topic := range topics {
+			if match(topic) {
+				row := s.NewTable()
+				s.SetField(row, "name", lua.LString(topic.name))
+				s.SetField(row, "title", lua.LString(topic.title))
+				s.SetField(row, "category", lua.LString(topic.category))
+				out.Append(row)
+			}
+		}
+		return out
+	}
+	if topics == nil {
+		for _, name := range []string{"help_topic", "help_list", "help_search"} {
+			L.SetField(holomush, name, L.NewFunction(unavailable))
+		}
+		return
+	}
+	L.SetField(holomush, "help_topic", L.NewFunction(func(s *lua.LState) int {
+		name := strings.ToLower(s.CheckString(1))
+		for _, topic := range topics {
+			if topic.name == name {
+				result := s.NewTable()
+				s.SetField(result, "name", lua.LString(topic.name))
+				s.SetField(result, "text", lua.LString(topic.text))
+				s.Push(result)
+				return 1
+			}
+		}
+		s.Push(lua.LNil)
+		s.Push(lua.LString("not found"))
+		return 2
+	}))
+	L.SetField(holomush, "help_list", L.NewFunction(func(s *lua.LState) int {
+		category := s.OptString(1, "")
+		s.Push(summaries(s, func(t helpTopic) bool { return category == "" || t.category == category }))
+		return 1
+	}))
+	L.SetField(holomush, "help_search", L.NewFunction(func(s *lua.LState) int {
+		term := strings.ToLower(s.CheckString(1))
+		s.Push(summaries(s, func(t helpTopic) bool {
+			return strings.Contains(t.name, term) || strings.Contains(strings.ToLower(t.title), term)
+		}))
+		return 1
+	}))
+}
+
 func twoCommands() []map[string]string {
 	return []map[string]string{
 		{"name": "help", "help": "Show help", "source": "core-help"},
@@ -224,3 +302,96 @@ func TestHelpHandlerRendersAccordingToListCommandsContract(t *testing.T) {
 		})
 	}
 }
+
+func twoTopics() []helpTopic {
+	return []helpTopic{
+		{name: "combat", title: "Combat Basics", category: "rules", text: "COMBAT PAGE"},
+		{name: "newbie", title: "Getting Started", category: "general", text: "NEWBIE PAGE"},
+	}
+}
+
+// TestHelpHandlerFallsBackToHelpTopics covers the staff-written help topics:
+// "help <name>" shows a topic when no command matches, "help topics" browses
+// them by category, and "help search" appends topic matches to command matches.
+func TestHelpHandlerFallsBackToHelpTopics(t *testing.T) {
+	clean := listCommandsResult{commands: twoCommands()}
+
+	tests := []struct {
+		name            string
+		args            string
+		topics          []helpTopic
+		wantContains    []string
+		wantNotContains []string
+	}{
+		{
+			name:         "unknown command shows matching topic",
+			args:         "Combat",
+			topics:       twoTopics(),
+			wantContains: []string{"COMBAT PAGE"},
+		},
+		{
+			name:         "neither command nor topic",
+			args:         "nothing",
+			topics:       twoTopics(),
+			wantContains: []string{"Unknown command or help topic: nothing", "help topics"},
+		},
+		{
+			name:         "no help service still reports unknown command",
+			args:         "combat",
+			wantContains: []string{"Unknown command or help topic: combat"},
+		},
+		{
+			name:         "topics index groups by category",
+			args:         "topics",
+			topics:       twoTopics(),
+			wantContains: []string{"Help Topics", "Rules", "combat Combat Basics", "General", "newbie Getting Started"},
+		},
+		{
+			name:            "topics in one category",
+			args:            "TOPICS Rules",
+			topics:          twoTopics(),
+			wantContains:    []string{"combat"},
+			wantNotContains: []string{"newbie"},
+		},
+		{
+			name:         "empty category",
+			args:         "topics lore",
+			topics:       twoTopics(),
+			wantContains: []string{"No help topics in category 'lore'."},
+		},
+		{
+			name:         "topics unavailable",
+			args:         "topics",
+			wantContains: []string{"Help topics are temporarily unavailable."},
+		},
+		{
+			name:         "search includes topics",
+			args:         "search combat",
+			topics:       twoTopics(),
+			wantContains: []string{"combat Combat Basics", "Found 0 command(s) and 1 topic(s)."},
+		},
+		{
+			name:         "search without help service lists commands only",
+			args:         "search look",
+			wantContains: []string{"look", "Found 1 command(s) and 0 topic(s)."},
+		},
+		{
+			name:         "search with no matches at all",
+			args:         "search zzz",
+			topics:       twoTopics(),
+			wantContains: []string{"No commands or help topics found matching 'zzz'."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := runHelpWithTopics(t, tt.args, clean, tt.topics)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, out, want)
+			}
+			for _, notWant := range tt.wantNotContains {
+				assert.NotContains(t, out, notWant)
+			}
+		})
+	}
+}
@@ -1,7 +1,8 @@
 -- SPDX-License-Identifier: Apache-2.0
 -- Copyright 2026 HoloMUSH Contributors
 
--- core-help: provides the help command for listing and describing commands.
+-- core-help: provides the help command for listing and describing commands
+-- and for reading the staff-written help topics (internal/help).
 
 -- capitalize upper-cases the first rune of a string.
 local function capitalize(s)
@@ -83,7 +84,7 @@ local function list_all_commands(ctx)
         out = out .. holo.fmt.table({headers = {"Command", "Description"}, rows = rows}) .. "\n\n"
     end
 
-    out = out .. holo.fmt.dim("Type 'help <command>' for detailed help.")
+    out = out .. holo.fmt.dim("Type 'help <command>' for detailed help or 'help topics' to browse help topics.")
     if incomplete then
         out = out .. "\n" .. holo.fmt.dim(
             "⚠ This command list may be incomplete due to a temporary system error. Try 'help' again shortly.")
@@ -91,20 +92,40 @@ local function list_all_commands(ctx)
     return out
 end
 
--- show_command_help handles "help <command>".
+-- unknown_topic is the reply when neither a command nor a help topic matches.
+local function unknown_topic(name)
+    return {status = 1, output = "Unknown command or help topic: " .. name ..
+        "\nType 'help' to see available commands or 'help topics' to browse help topics."}
+end
+
+-- show_topic renders a staff-written help topic, or returns nil when none
+-- matches so the caller can report the miss.
+local function show_topic(name)
+    local topic, err = holomush.help_topic(name)
+    if topic == nil then
+        if err and err ~= "not found" and err ~= "help not available" then
+            holomush.log("warn", "help: failed to look up topic " .. name .. ": " .. err)
+        end
+        return nil
+    end
+    return topic.text
+end
+
+-- show_command_help handles "help <command>", falling back to a help topic
+-- when no command by that name is visible to the character.
 local function show_command_help(ctx, name)
     local info, err = holomush.get_command_help(name, ctx.character_id)
     if err then
         -- Distinguish "not found" from real errors.
         -- get_command_help returns ("command not found: <name>", err) when missing.
         if err:find("command not found") or err:find("access denied") then
-            return {status = 1, output = "Unknown command: " .. name .. "\nType 'help' to see available commands."}
+            return show_topic(name) or unknown_topic(name)
         end
         holomush.log("error", "help: failed to get help for " .. name .. ": " .. err)
         return {status = 2, output = "Help is temporarily unavailable. Please try again later."}
     end
     if info == nil then
-        return {status = 1, output = "Unknown command: " .. name .. "\nType 'help' to see available commands."}
+        return show_topic(name) or unknown_topic(name)
     end
 
     local out = holo.fmt.header(info.name) .. "\n\n"
@@ -162,18 +183,37 @@ local function search_commands(ctx, term)
 
     table_sort_by_key(matches, "name")
 
-    if #matches == 0 then
-        return {status = 1, output = "No commands found matching '" .. term .. "'."}
+    -- Help topics are searched by the server's full-text index; a failed
+    -- topic search still shows the command matches.
+    local topics, topics_err = holomush.help_search(term)
+    if topics == nil then
+        if topics_err and topics_err ~= "help not available" then
+            holomush.log("warn", "help: topic search failed for " .. term .. ": " .. topics_err)
+        end
+        topics = {}
+    end
+
+    if #matches == 0 and #topics == 0 then
+        return {status = 1, output = "No commands or help topics found matching '" .. term .. "'."}
     end
 
     local out = holo.fmt.header("Search Results for '" .. term .. "'") .. "\n\n"
 
-    local rows = {}
-    for _, cmd in ipairs(matches) do
-        table.insert(rows, {cmd.name, cmd.help or ""})
+    if #matches > 0 then
+        local rows = {}
+        for _, cmd in ipairs(matches) do
+            table.insert(rows, {cmd.name, cmd.help or ""})
+        end
+        out = out .. holo.fmt.table({headers = {"Command", "Description"}, rows = rows}) .. "\n\n"
+    end
+    if #topics > 0 then
+        local rows = {}
+        for _, topic in ipairs(topics) do
+            table.insert(rows, {topic.name, topic.title})
+        end
+        out = out .. holo.fmt.table({headers = {"Topic", "Title"}, rows = rows}) .. "\n\n"
     end
-    out = out .. holo.fmt.table({headers = {"Command", "Description"}, rows = rows}) .. "\n\n"
-    out = out .. holo.fmt.dim("Found " .. #matches .. " command(s).")
+    out = out .. holo.fmt.dim("Found " .. #matches .. " command(s) and " .. #topics .. " topic(s).")
     if incomplete then
         out = out .. "\n" .. holo.fmt.dim(
             "⚠ Searchable commands may be incomplete due to a temporary system error. Try again shortly.")
@@ -182,6 +222,45 @@ local function search_commands(ctx, term)
     return out
 end
 
+-- list_topics handles "help topics [category]": every help topic grouped by
+-- category, or the topics of one category.
+local function list_topics(category)
+    local topics, err = holomush.help_list(category)
+    if topics == nil then
+        if err and err ~= "help not available" then
+            holomush.log("error", "help: failed to list topics: " .. err)
+        end
+        return {status = 2, output = "Help topics are temporarily unavailable. Please try again later."}
+    end
+
+    if #topics == 0 then
+        if category ~= "" then
+            return {status = 1, output = "No help topics in category '" .. category .. "'."}
+        end
+        return "No help topics have been written yet."
+    end
+
+    local out = holo.fmt.header("Help Topics") .. "\n\n"
+    local current, rows = nil, {}
+    local function flush()
+        if current then
+            out = out .. holo.fmt.bold(capitalize(current)) .. "\n" ..
+                holo.fmt.table({headers = {"Topic", "Title"}, rows = rows}) .. "\n\n"
+        end
+    end
+    -- help_list orders by category then name, so categories arrive grouped.
+    for _, topic in ipairs(topics) do
+        if topic.category ~= current then
+            flush()
+            current, rows = topic.category, {}
+        end
+        table.insert(rows, {topic.name, topic.title})
+    end
+    flush()
+
+    return out .. holo.fmt.dim("Type 'help <topic>' to read a topic.")
+end
+
 function on_command(ctx)
     local args = trim(ctx.args or "")
 
@@ -198,5 +277,12 @@ function on_command(ctx)
         end
     end
 
+    -- "topics [category]" browses the help topics (case-insensitive).
+    local topics_arg = args:match("^[Tt][Oo][Pp][Ii][Cc][Ss]$") and ""
+        or args:match("^[Tt][Oo][Pp][Ii][Cc][Ss]%s+(.+)$")
+    if topics_arg then
+        return list_topics(trim(topics_arg):lower())
+    end
+
     return show_command_help(ctx, args)
 end
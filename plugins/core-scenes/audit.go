@@ -111,6 +111,13 @@ type sceneMembershipLookup interface {
 	IsMember(ctx context.Context, sceneID, characterID string) (bool, error)
 }
 
+// poseTurnAdvancer is notified after each scene_pose row commits so the
+// scene's turn tracker can pass the turn on. *SceneServiceImpl satisfies
+// this (turns.go::advanceTurnOnPose).
+type poseTurnAdvancer interface {
+	advanceTurnOnPose(ctx context.Context, sceneID, characterID string)
+}
+
 // SceneAuditServer implements PluginAuditService for core-scenes.
 //
 // AuditEvent is invoked by the host per-plugin audit consumer for every
@@ -126,6 +133,9 @@ type SceneAuditServer struct {
 	pluginv1.UnimplementedPluginAuditServiceServer
 	store        sceneAuditLogStore    // queryLog only
 	memberLookup sceneMembershipLookup // IsMember only
+	// turns auto-advances the turn tracker on each committed pose; nil
+	// (e.g. tests that build the server directly) skips the advance.
+	turns poseTurnAdvancer
 }
 
 // SceneAuditStore wraps the pgx pool with audit-specific SQL helpers. Kept
@@ -446,6 +456,11 @@ func (s *SceneAuditServer) AuditEvent(ctx context.Context, req *pluginv1.AuditEv
 			// InsertScenePose already wraps with SCENE_AUDIT_TX_FAILED.
 			return nil, err //nolint:wrapcheck // already wrapped by InsertScenePose with SCENE_AUDIT_TX_FAILED
 		}
+		// Post-commit and best-effort: the advance logs its own failures
+		// and never fails the audit write.
+		if s.turns != nil {
+			s.turns.advanceTurnOnPose(ctx, sceneID, posedCharID)
+		}
 	} else {
 		if err := s.store.Insert(
 			ctx,
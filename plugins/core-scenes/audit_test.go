@@ -468,6 +468,43 @@ func TestAuditEventHappyPathPersistsViaStore(t *testing.T) {
 	assert.NotNil(t, resp, "successful AuditEvent MUST return a non-nil response")
 }
 
+// recordingTurnAdvancer records advanceTurnOnPose calls.
+type recordingTurnAdvancer struct {
+	calls []string // "sceneID|characterID"
+}
+
+func (r *recordingTurnAdvancer) advanceTurnOnPose(_ context.Context, sceneID, characterID string) {
+	r.calls = append(r.calls, sceneID+"|"+characterID)
+}
+
+// TestAuditEventPoseAdvancesTurn verifies a committed scene_pose notifies
+// the turn advancer with the scene and posing character, and that other
+// event types do not.
+func TestAuditEventPoseAdvancesTurn(t *testing.T) {
+	t.Parallel()
+	turns := &recordingTurnAdvancer{}
+	srv := &SceneAuditServer{store: &fakeAuditStore{}, turns: turns}
+	sceneID := "01ABC000000000000000000000"
+	charID := ulid.Make()
+	charBytes := charID.Bytes()
+
+	row := validAuditRow(t)
+	row.Subject = "events.test.scene." + sceneID + ".ic"
+	row.Type = "core-scenes:scene_pose"
+	row.Actor = &eventbusv1.Actor{Kind: eventbusv1.ActorKind_ACTOR_KIND_CHARACTER, Id: charBytes[:]}
+	_, err := srv.AuditEvent(context.Background(), &pluginv1.AuditEventRequest{Row: row})
+	require.NoError(t, err)
+
+	say := validAuditRow(t)
+	say.Subject = row.Subject
+	say.Type = "core-scenes:scene_say"
+	say.Actor = row.Actor
+	_, err = srv.AuditEvent(context.Background(), &pluginv1.AuditEventRequest{Row: say})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{sceneID + "|" + charID.String()}, turns.calls)
+}
+
 // TestActorKindFromStringCoversAllVariants gates the read-side mapping
 // against publisher contract drift: AuditEvent stores enum.String()
 // ("ACTOR_KIND_PLAYER"), while pre-spec writers used the lowercase form
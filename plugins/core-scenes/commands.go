@@ -12,12 +12,14 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/samber/oops"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pluginsdk "github.com/holomush/holomush/pkg/plugin"
 	"github.com/holomush/holomush/pkg/plugin/comm"
@@ -76,12 +78,15 @@ func resolveSceneRef(ctx context.Context, look membershipLookup, characterID, ar
 const sceneLogReplayLimit = 50
 
 // replayEventKinds maps the IC content event types to their render kind. Only
-// these three are replayed by "scene log"; joins, ops, OOC, and publish-
-// lifecycle notices are skipped.
+// these are replayed by "scene log"; joins, ops, OOC, and publish-lifecycle
+// notices are skipped. Host stat checks (game.CheckService) carry the same
+// {actor_id, text} shape and replay as emits, so check outcomes stay in the
+// scene record.
 var replayEventKinds = map[string]EntryKind{
-	"core-scenes:scene_pose": EntryKindPose,
-	"core-scenes:scene_say":  EntryKindSay,
-	"core-scenes:scene_emit": EntryKindEmit,
+	"core-scenes:scene_pose":             EntryKindPose,
+	"core-scenes:scene_say":              EntryKindSay,
+	"core-scenes:scene_emit":             EntryKindEmit,
+	string(pluginsdk.HostEventTypeCheck): EntryKindEmit,
 }
 
 // decodeReplayEntries converts QueryStreamHistory events (oldest→newest) into
@@ -457,7 +462,7 @@ func (p *scenePlugin) dispatchCommand(ctx context.Context, req pluginsdk.Command
 	span.SetAttributes(attribute.String("subcommand", sub))
 
 	if sub == "" {
-		return pluginsdk.Errorf("Usage: scene <subcommand> [args]\nKnown subcommands: create, emit, end, focus, grid, info, invite, join, kick, leave, list, log, mute, ooc, order, pause, pose, publish, resume, say, set, switch, transfer, unmute"), nil
+		return pluginsdk.Errorf("Usage: scene <subcommand> [args]\nKnown subcommands: accept, create, decline, emit, end, focus, grid, info, invite, invites, join, kick, leave, list, log, mute, ooc, order, pause, pose, publish, resume, rsvp, say, schedule, set, switch, transfer, turn, unmute, unschedule, upcoming"), nil
 	}
 
 	// gated dispatches through the ABAC evaluator; fails closed when evaluator is nil.
@@ -500,6 +505,12 @@ func (p *scenePlugin) dispatchCommand(ctx context.Context, req pluginsdk.Command
 		return gated("leave", "leave", sceneResourceRef, p.handleLeave)
 	case "invite":
 		return gated("invite", "invite", sceneResourceRefFirstField, p.handleInvite)
+	case "invites":
+		return p.handleInvites(ctx, req, rest)
+	case "accept":
+		return p.handleAccept(ctx, req, rest)
+	case "decline":
+		return p.handleDecline(ctx, req, rest)
 	case "kick":
 		return gated("kick", "kick", sceneResourceRefFirstField, p.handleKick)
 	case "transfer":
@@ -531,14 +542,24 @@ func (p *scenePlugin) dispatchCommand(ctx context.Context, req pluginsdk.Command
 		return p.handleEmit(ctx, req, rest, "core-scenes:scene_ooc", true)
 	case "order":
 		return p.handleOrder(ctx, req, rest)
+	case "turn":
+		return p.handleTurn(ctx, req, rest)
 	case "publish":
 		return p.handlePublish(ctx, req, rest)
 	case "log":
 		return p.handleLog(ctx, req, rest)
 	case "list":
 		return p.handleSceneList(ctx, req)
+	case "schedule":
+		return p.handleSchedule(ctx, req, rest)
+	case "rsvp":
+		return p.handleRsvp(ctx, req, rest)
+	case "unschedule":
+		return p.handleUnschedule(ctx, req, rest)
+	case "upcoming":
+		return p.handleUpcoming(ctx, req, rest)
 	default:
-		return pluginsdk.Errorf("Unknown scene subcommand %q. Known subcommands: create, emit, end, focus, grid, info, invite, join, kick, leave, list, log, mute, ooc, order, pause, pose, publish, resume, say, set, switch, transfer, unmute.", sub), nil
+		return pluginsdk.Errorf("Unknown scene subcommand %q. Known subcommands: accept, create, decline, emit, end, focus, grid, info, invite, invites, join, kick, leave, list, log, mute, ooc, order, pause, pose, publish, resume, rsvp, say, schedule, set, switch, transfer, turn, unmute, unschedule, upcoming.", sub), nil
 	}
 }
 
@@ -854,7 +875,14 @@ func (p *scenePlugin) handleJoin(ctx context.Context, req pluginsdk.CommandReque
 	}); err != nil {
 		return pluginsdk.Errorf("Failed to join scene: %v", err), nil
 	}
+	return p.focusJoinedScene(ctx, req, sceneID), nil
+}
 
+// focusJoinedScene subscribes the caller's session to a scene it has just
+// joined (JoinFocus) and auto-focuses its terminal connections, rendering
+// the outcome. Shared by `scene join` and `scene accept`; the membership
+// row is already committed, so failures here are reported, not rolled back.
+func (p *scenePlugin) focusJoinedScene(ctx context.Context, req pluginsdk.CommandRequest, sceneID string) *pluginsdk.CommandResponse {
 	if p.focusClient == nil {
 		// Misconfiguration, not a transient error: retries will hit the
 		// same nil guard. Surface the operator-action hint rather than the
@@ -869,7 +897,7 @@ func (p *scenePlugin) handleJoin(ctx context.Context, req pluginsdk.CommandReque
 			"Joined scene in database, but your session could not subscribe " +
 				"(focus client not configured — this is a server configuration error, " +
 				"please contact an administrator).",
-		), nil
+		)
 	}
 
 	if joinErr := p.focusClient.JoinFocus(ctx, req.SessionID, pluginsdk.FocusKey{
@@ -891,7 +919,7 @@ func (p *scenePlugin) handleJoin(ctx context.Context, req pluginsdk.CommandReque
 			return pluginsdk.Errorf(
 				"Joined scene in database, but your session could not subscribe (%v). "+
 					"Please retry `scene join %s`.", joinErr, sceneID,
-			), nil
+			)
 		}
 	}
 
@@ -914,7 +942,7 @@ func (p *scenePlugin) handleJoin(ctx context.Context, req pluginsdk.CommandReque
 		return &pluginsdk.CommandResponse{
 			Status: pluginsdk.CommandOK,
 			Output: fmt.Sprintf("Joined scene #%s. (Auto-focus is unavailable; use 'scene focus #%s' to focus manually.)", sceneID, sceneID),
-		}, nil
+		}
 	}
 
 	if len(afResult.FailedConnectionIDs) > 0 {
@@ -959,7 +987,7 @@ func (p *scenePlugin) handleJoin(ctx context.Context, req pluginsdk.CommandReque
 	return &pluginsdk.CommandResponse{
 		Status: pluginsdk.CommandOK,
 		Output: msg,
-	}, nil
+	}
 }
 
 // handleLeave parses "scene leave <scene-id>", calls LeaveScene, then calls
@@ -1000,30 +1028,127 @@ func (p *scenePlugin) handleLeave(ctx context.Context, req pluginsdk.CommandRequ
 	}, nil
 }
 
-// handleInvite parses "scene invite <scene-id> <character>".
+// handleInvite parses "scene invite <scene-id> <character> [<character>...]"
+// and issues one invitation per named character. Each invitee is notified
+// and must `scene accept` (or `scene decline`) before joining; a failure for
+// one character does not stop the rest. The command fails only when no
+// invitation went out.
 func (p *scenePlugin) handleInvite(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
-	// Strict arity: reject anything other than exactly 2 tokens — see handleJoin.
 	fields := strings.Fields(args)
-	if len(fields) != 2 {
-		return pluginsdk.Errorf("Usage: scene invite #<scene id> <character>"), nil
+	if len(fields) < 2 {
+		return pluginsdk.Errorf("Usage: scene invite #<scene id> <character> [<character>...]"), nil
 	}
-	sceneID, target := normalizeSceneID(fields[0]), fields[1]
+	sceneID := normalizeSceneID(fields[0])
 
-	_, err := p.service.InviteToScene(ctx, &scenev1.InviteToSceneRequest{
-		CharacterId:       req.CharacterID,
-		SceneId:           sceneID,
-		TargetCharacterId: target,
-	})
-	if err != nil {
-		return pluginsdk.Errorf("Failed to invite: %v", err), nil
+	var (
+		b       strings.Builder
+		invited int
+		seen    = make(map[string]bool, len(fields)-1)
+	)
+	for _, target := range fields[1:] {
+		if seen[target] {
+			continue
+		}
+		seen[target] = true
+		_, err := p.service.InviteToScene(ctx, &scenev1.InviteToSceneRequest{
+			CharacterId:       req.CharacterID,
+			SceneId:           sceneID,
+			TargetCharacterId: target,
+		})
+		if err != nil {
+			fmt.Fprintf(&b, "Failed to invite %s: %v\n", target, err)
+			continue
+		}
+		invited++
+		fmt.Fprintf(&b, "Invited %s to scene %s.\n", target, sceneID)
 	}
 
+	out := strings.TrimSuffix(b.String(), "\n")
+	if invited == 0 {
+		return pluginsdk.Errorf("%s", out), nil
+	}
 	return &pluginsdk.CommandResponse{
 		Status: pluginsdk.CommandOK,
-		Output: fmt.Sprintf("Invited %s to scene %s.", target, sceneID),
+		Output: out,
 	}, nil
 }
 
+// handleAccept parses "scene accept #<scene-id>": the invitee accepts a
+// pending invitation, joining the scene as a member, and their session is
+// subscribed exactly as for `scene join`. Not engine-gated — the caller can
+// only accept their own invitation, which the store looks up by the
+// dispatching character.
+//
+//nolint:unparam // plugin SDK Handler contract requires (*CommandResponse, error); errors are conveyed via pluginsdk.Errorf returning a CommandError status response, not via Go error returns
+func (p *scenePlugin) handleAccept(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	fields := strings.Fields(args)
+	if len(fields) != 1 {
+		return pluginsdk.Errorf("Usage: scene accept #<scene id>"), nil
+	}
+	sceneID := normalizeSceneID(fields[0])
+
+	if err := p.service.AcceptSceneInvitation(ctx, sceneID, req.CharacterID); err != nil {
+		return pluginsdk.Errorf("Failed to accept invitation: %v", err), nil
+	}
+	return p.focusJoinedScene(ctx, req, sceneID), nil
+}
+
+// handleDecline parses "scene decline #<scene-id>": the invitee turns down
+// a pending invitation, and whoever issued it is told. Not engine-gated, for
+// the same reason as handleAccept.
+//
+//nolint:unparam // plugin SDK Handler contract requires (*CommandResponse, error); errors are conveyed via pluginsdk.Errorf returning a CommandError status response, not via Go error returns
+func (p *scenePlugin) handleDecline(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	fields := strings.Fields(args)
+	if len(fields) != 1 {
+		return pluginsdk.Errorf("Usage: scene decline #<scene id>"), nil
+	}
+	sceneID := normalizeSceneID(fields[0])
+
+	if err := p.service.DeclineSceneInvitation(ctx, sceneID, req.CharacterID); err != nil {
+		return pluginsdk.Errorf("Failed to decline invitation: %v", err), nil
+	}
+	return pluginsdk.OK(fmt.Sprintf("Declined the invitation to scene %s.", sceneID)), nil
+}
+
+// handleInvites is the scene/invites subcommand handler: it lists the
+// caller's pending invitations.
+//
+//nolint:unparam // plugin SDK Handler contract requires (*CommandResponse, error); errors are conveyed via pluginsdk.Errorf returning a CommandError status response, not via Go error returns
+func (p *scenePlugin) handleInvites(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	if strings.TrimSpace(args) != "" {
+		return pluginsdk.Errorf("Usage: scene invites"), nil
+	}
+	invs, err := p.service.ListSceneInvitations(ctx, req.CharacterID)
+	if err != nil {
+		return pluginsdk.Errorf("Failed to list invitations: %v", err), nil
+	}
+	return pluginsdk.OK(renderInvitations(invs)), nil
+}
+
+// renderInvitations formats a character's pending invitations as plain
+// text. Pure function — testable without a service mock.
+func renderInvitations(invs []*SceneInvitation) string {
+	if len(invs) == 0 {
+		return "You have no pending scene invitations.\n"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Scene invitations (%d):\n", len(invs))
+	for _, inv := range invs {
+		from := ""
+		if inv.InvitedBy != "" {
+			from = " from " + inv.InvitedBy
+		}
+		expiry := ", no expiry"
+		if inv.ExpiresAt != nil {
+			expiry = ", expires " + formatScheduleStart(*inv.ExpiresAt)
+		}
+		fmt.Fprintf(&b, "  %s — %s%s%s\n", inv.SceneID, inv.SceneTitle, from, expiry)
+	}
+	b.WriteString("Use `scene accept #<id>` or `scene decline #<id>`.\n")
+	return b.String()
+}
+
 // handleKick parses "scene kick <scene-id> <character>".
 func (p *scenePlugin) handleKick(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
 	// Strict arity: reject anything other than exactly 2 tokens — see handleJoin.
@@ -1556,6 +1681,291 @@ func poseOrderDisplayName(e *scenev1.PoseOrderEntry) string {
 	return e.GetCharacterId()
 }
 
+// handleTurn is the scene/turn subcommand handler for the caller's scene's
+// turn tracker:
+//
+//	scene turn [nextup]              show whose turn it is and who is next
+//	scene turn skip                  pass the current turn
+//	scene turn add [<character>]     join the queue, or (owner) add someone
+//	scene turn remove [<character>]  leave the queue, or (owner) remove someone
+//
+// Like handleOrder it is not engine-gated: the service enforces the
+// INV-SCENE-60 participant gate and the owner/turn-holder rules.
+func (p *scenePlugin) handleTurn(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	const usage = "Usage: scene turn [nextup | skip | add [<character>] | remove [<character>]]"
+	fields := strings.Fields(args)
+	op, target := "nextup", ""
+	if len(fields) > 0 {
+		op = strings.ToLower(fields[0])
+	}
+	switch {
+	case len(fields) > 2,
+		len(fields) == 2 && op != "add" && op != "remove":
+		return pluginsdk.Errorf(usage), nil
+	case len(fields) == 2:
+		target = fields[1]
+	}
+
+	sceneID, userErr, internalErr := p.resolveSingleSceneMembership(ctx, req.CharacterID)
+	if internalErr != nil {
+		return nil, internalErr
+	}
+	if userErr != "" {
+		return pluginsdk.Errorf("%s", userErr), nil
+	}
+
+	var (
+		turns *scenev1.SceneTurns
+		err   error
+	)
+	switch op {
+	case "nextup":
+		var resp *scenev1.GetSceneTurnsResponse
+		resp, err = p.service.GetSceneTurns(ctx, &scenev1.GetSceneTurnsRequest{
+			SceneId: sceneID, CharacterId: req.CharacterID,
+		})
+		turns = resp.GetTurns()
+	case "skip", "add", "remove":
+		var resp *scenev1.UpdateSceneTurnsResponse
+		resp, err = p.service.UpdateSceneTurns(ctx, &scenev1.UpdateSceneTurnsRequest{
+			SceneId: sceneID, CharacterId: req.CharacterID,
+			Action: op, TargetCharacterId: target,
+		})
+		turns = resp.GetTurns()
+	default:
+		return pluginsdk.Errorf(usage), nil
+	}
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.PermissionDenied, codes.AlreadyExists, codes.NotFound, codes.FailedPrecondition:
+			return pluginsdk.Errorf("Cannot %s: %s.", op, st.Message()), nil
+		default:
+			return nil, oops.Code("SCENE_TURN_COMMAND_FAILED").
+				With("scene_id", sceneID).With("op", op).Wrap(err)
+		}
+	}
+
+	return &pluginsdk.CommandResponse{
+		Status: pluginsdk.CommandOK,
+		Output: renderSceneTurns(sceneID, turns),
+	}, nil
+}
+
+// renderSceneTurns formats a turn tracker as plain text, marking whose turn
+// it is and who is up next. Pure function — testable without a service mock.
+func renderSceneTurns(sceneID string, turns *scenev1.SceneTurns) string {
+	var b strings.Builder
+	queue := turns.GetQueue()
+	if len(queue) == 0 {
+		fmt.Fprintf(&b, "Scene %s has no turn queue. Join it with `scene turn add`.\n", sceneID)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Scene %s — turns, round %d:\n", sceneID, turns.GetRound())
+	for _, id := range queue {
+		switch id {
+		case turns.GetCurrentCharacterId():
+			fmt.Fprintf(&b, "  → %s (up now)\n", id)
+		case turns.GetNextCharacterId():
+			fmt.Fprintf(&b, "    %s (up next)\n", id)
+		default:
+			fmt.Fprintf(&b, "    %s\n", id)
+		}
+	}
+	if d := turns.GetTurnDeadline(); d != nil {
+		fmt.Fprintf(&b, "  The turn passes on at %s.\n", d.AsTime().UTC().Format("15:04 MST"))
+	}
+	return b.String()
+}
+
+// handleSchedule is the scene/schedule subcommand handler:
+//
+//	scene schedule <start>=<title>
+//
+// <start> is either a Go duration from now ("90m", "26h") or an RFC 3339
+// timestamp ("2026-11-01T19:00:00Z"). Like handleTurn it is not
+// engine-gated: any character may schedule a scene, and the service binds
+// the owner to the caller.
+func (p *scenePlugin) handleSchedule(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	const usage = "Usage: scene schedule <start>=<title>  (start: a duration from now like 26h, or an RFC 3339 time)"
+	rawStart, title, ok := strings.Cut(args, "=")
+	title = strings.TrimSpace(title)
+	if !ok || title == "" {
+		return pluginsdk.Errorf(usage), nil
+	}
+	startsAt, err := parseScheduleStart(rawStart, time.Now())
+	if err != nil {
+		return pluginsdk.Errorf(usage), nil
+	}
+
+	resp, err := p.service.ScheduleScene(ctx, &scenev1.ScheduleSceneRequest{
+		CharacterId: req.CharacterID,
+		Title:       title,
+		StartsAt:    timestamppb.New(startsAt),
+	})
+	if err != nil {
+		return scheduleCommandError(err, "schedule", "")
+	}
+	sched := resp.GetScene()
+	return pluginsdk.OK(fmt.Sprintf(
+		"Scene scheduled: %s — %s, starting %s. Players can answer with `scene rsvp %s`.",
+		sched.GetId(), sched.GetTitle(), formatScheduleStart(sched.GetStartsAt().AsTime()), sched.GetId(),
+	)), nil
+}
+
+// parseScheduleStart parses the <start> of `scene schedule`: a positive Go
+// duration added to now, or an RFC 3339 timestamp. Whether the result is
+// in the future is the service's check.
+func parseScheduleStart(raw string, now time.Time) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if d, err := time.ParseDuration(raw); err == nil {
+		if d <= 0 {
+			return time.Time{}, oops.With("start", raw).Errorf("start offset must be positive")
+		}
+		return now.Add(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, oops.With("start", raw).Wrap(err)
+	}
+	return t, nil
+}
+
+// handleRsvp is the scene/rsvp subcommand handler:
+//
+//	scene rsvp <id> [yes | maybe | no]
+//
+// The answer defaults to yes and replaces any earlier one.
+func (p *scenePlugin) handleRsvp(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	const usage = "Usage: scene rsvp <scheduled scene id> [yes | maybe | no]"
+	fields := strings.Fields(args)
+	if len(fields) == 0 || len(fields) > 2 {
+		return pluginsdk.Errorf(usage), nil
+	}
+	scheduleID, response := normalizeSceneID(fields[0]), RsvpYes
+	if len(fields) == 2 {
+		response = RsvpResponse(strings.ToLower(fields[1]))
+	}
+	switch response {
+	case RsvpYes, RsvpMaybe, RsvpNo:
+	default:
+		return pluginsdk.Errorf(usage), nil
+	}
+
+	resp, err := p.service.RsvpScheduledScene(ctx, &scenev1.RsvpScheduledSceneRequest{
+		CharacterId: req.CharacterID,
+		ScheduleId:  scheduleID,
+		Response:    string(response),
+	})
+	if err != nil {
+		return scheduleCommandError(err, "rsvp", scheduleID)
+	}
+	sched := resp.GetScene()
+	return pluginsdk.OK(fmt.Sprintf("RSVP %s for %s — %s, starting %s.",
+		response, sched.GetId(), sched.GetTitle(), formatScheduleStart(sched.GetStartsAt().AsTime()))), nil
+}
+
+// handleUnschedule is the scene/unschedule subcommand handler:
+//
+//	scene unschedule <id>
+//
+// Only the scheduling character may call a scene off; the service enforces
+// it.
+func (p *scenePlugin) handleUnschedule(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	fields := strings.Fields(args)
+	if len(fields) != 1 {
+		return pluginsdk.Errorf("Usage: scene unschedule <scheduled scene id>"), nil
+	}
+	scheduleID := normalizeSceneID(fields[0])
+
+	if _, err := p.service.CancelScheduledScene(ctx, &scenev1.CancelScheduledSceneRequest{
+		CharacterId: req.CharacterID,
+		ScheduleId:  scheduleID,
+	}); err != nil {
+		return scheduleCommandError(err, "unschedule", scheduleID)
+	}
+	return pluginsdk.OK(fmt.Sprintf("Scheduled scene %s cancelled.", scheduleID)), nil
+}
+
+// handleUpcoming is the scene/upcoming subcommand handler:
+//
+//	scene upcoming [mine]
+//
+// "mine" narrows the list to scenes the caller scheduled or answered yes or
+// maybe to.
+func (p *scenePlugin) handleUpcoming(ctx context.Context, req pluginsdk.CommandRequest, args string) (*pluginsdk.CommandResponse, error) {
+	var attending bool
+	switch strings.ToLower(strings.TrimSpace(args)) {
+	case "":
+	case "mine":
+		attending = true
+	default:
+		return pluginsdk.Errorf("Usage: scene upcoming [mine]"), nil
+	}
+
+	resp, err := p.service.ListUpcomingScenes(ctx, &scenev1.ListUpcomingScenesRequest{
+		CharacterId: req.CharacterID,
+		Attending:   attending,
+	})
+	if err != nil {
+		return scheduleCommandError(err, "list upcoming scenes", "")
+	}
+	return pluginsdk.OK(renderUpcomingScenes(req.CharacterID, resp.GetScenes())), nil
+}
+
+// scheduleCommandError turns a scheduled-scene RPC error into the command
+// response: caller-facing status codes become a user message, anything else
+// is an internal failure.
+func scheduleCommandError(err error, op, scheduleID string) (*pluginsdk.CommandResponse, error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument, codes.PermissionDenied, codes.NotFound, codes.FailedPrecondition:
+		return pluginsdk.Errorf("Cannot %s: %s.", op, st.Message()), nil
+	default:
+		return nil, oops.Code("SCENE_SCHEDULE_COMMAND_FAILED").
+			With("schedule_id", scheduleID).With("op", op).Wrap(err)
+	}
+}
+
+// renderUpcomingScenes formats the upcoming-scenes list as plain text,
+// marking the viewer's own scenes and RSVPs. Pure function — testable
+// without a service mock.
+func renderUpcomingScenes(viewerID string, scenes []*scenev1.ScheduledScene) string {
+	if len(scenes) == 0 {
+		return "No upcoming scenes. Schedule one with `scene schedule <start>=<title>`.\n"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Upcoming scenes (%d):\n", len(scenes))
+	for _, sc := range scenes {
+		var going int
+		mark := ""
+		for _, r := range sc.GetRsvps() {
+			if r.GetResponse() == string(RsvpYes) {
+				going++
+			}
+			if r.GetCharacterId() == viewerID {
+				mark = " [you: " + r.GetResponse() + "]"
+			}
+		}
+		if sc.GetOwnerId() == viewerID {
+			mark = " [yours]"
+		}
+		cwLabel := ""
+		if len(sc.GetContentWarnings()) > 0 {
+			cwLabel = fmt.Sprintf(" [CW: %s]", strings.Join(sc.GetContentWarnings(), ", "))
+		}
+		fmt.Fprintf(&b, "  %s — %s, %s (owner: %s, %d going)%s%s\n",
+			sc.GetId(), sc.GetTitle(), formatScheduleStart(sc.GetStartsAt().AsTime()),
+			sc.GetOwnerId(), going, mark, cwLabel)
+	}
+	return b.String()
+}
+
+// formatScheduleStart renders a scheduled start time in UTC.
+func formatScheduleStart(t time.Time) string {
+	return t.UTC().Format("Mon Jan 2 15:04 MST")
+}
+
 // resolveSingleSceneMembership returns the scene_id this character is
 // currently a participant of, if exactly one. Returns a user-facing
 // message in userErr when membership count is ambiguous (zero or >1);
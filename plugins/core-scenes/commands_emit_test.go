@@ -232,7 +232,7 @@ func TestSceneSubcommand_InvitedOnly_TreatedAsNonMember(t *testing.T) {
 		ID: "scene-invited-only", OwnerID: "char-owner",
 		State: string(SceneStateActive), Visibility: string(SceneVisibilityPrivate),
 	}))
-	_, err := store.InviteParticipant(context.Background(), "scene-invited-only", "char-owner", "char-bob")
+	_, err := store.InviteParticipant(context.Background(), "scene-invited-only", "char-owner", "char-bob", nil)
 	require.NoError(t, err)
 	// Sanity check the fake: bob is invited (not member); IsParticipant returns false.
 	isPart, err := store.IsParticipant(context.Background(), "scene-invited-only", "char-bob")
@@ -27,7 +27,7 @@ func newSceneLogFixture(t *testing.T, events ...pluginsdk.Event) (p *scenePlugin
 	return &scenePlugin{service: newTestService(t, store), focusClient: fc}, sceneID, caller
 }
 
-// TestDecodeReplayEntries verifies the IC content kinds (pose/say/emit) are
+// TestDecodeReplayEntries verifies the IC content kinds (pose/say/emit/check) are
 // decoded from their {actor_id, text} payloads and non-content events are
 // skipped.
 func TestDecodeReplayEntries(t *testing.T) {
@@ -37,15 +37,17 @@ func TestDecodeReplayEntries(t *testing.T) {
 		{ID: "2", Type: pluginsdk.EventType("core-scenes:scene_join_ic"), Payload: `{"actor_id":"Bob"}`}, // non-content → skipped
 		{ID: "3", Type: pluginsdk.EventType("core-scenes:scene_say"), Payload: `{"actor_id":"Bob","text":"Hello."}`},
 		{ID: "4", Type: pluginsdk.EventType("core-scenes:scene_emit"), Payload: `{"actor_id":"Cara","text":"A bell rings."}`},
+		{ID: "5", Type: pluginsdk.HostEventTypeCheck, Payload: `{"actor_id":"Alice","text":"Alice rolls strength (1d20+3): 14 vs 12 — success.","outcome":"success"}`},
 	}
 
 	entries, err := decodeReplayEntries(events)
 
 	require.NoError(t, err)
-	require.Len(t, entries, 3, "the non-content join event is skipped")
+	require.Len(t, entries, 4, "the non-content join event is skipped")
 	assert.Equal(t, PublishedSceneEntry{Speaker: "Alice", Kind: EntryKindPose, Content: "smiles warmly."}, entries[0])
 	assert.Equal(t, PublishedSceneEntry{Speaker: "Bob", Kind: EntryKindSay, Content: "Hello."}, entries[1])
 	assert.Equal(t, PublishedSceneEntry{Speaker: "Cara", Kind: EntryKindEmit, Content: "A bell rings."}, entries[2])
+	assert.Equal(t, PublishedSceneEntry{Speaker: "Alice", Kind: EntryKindEmit, Content: "Alice rolls strength (1d20+3): 14 vs 12 — success."}, entries[3], "stat checks replay as emits")
 }
 
 // TestDecodeReplayEntriesRejectsMalformedPayload verifies a payload that won't
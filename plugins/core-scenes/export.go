@@ -175,18 +175,49 @@ func (s *SceneServiceImpl) ExportSceneLog(ctx context.Context, req *scenev1.Expo
 // It is the AAD-canonical field: OwnerMap.Resolve uses it to locate the DEK and
 // the AEAD tag-check binds to it. Passing "" causes both to fail (Blocker 1).
 func (s *SceneServiceImpl) exportDecryptRows(ctx context.Context, sceneID, fullICSubject string, logRows []LogRow) ([]PublishedSceneEntry, error) {
+	plaintexts, err := s.decryptExportRows(ctx, "scene.service.export_scene_log", sceneID, fullICSubject, logRows)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]PublishedSceneEntry, 0, len(logRows))
+	for i := range plaintexts {
+		entry, ok, decodeErr := decodeSnapshotEntry(logRows[i].Type, plaintexts[i])
+		if decodeErr != nil {
+			errutil.LogErrorContext(ctx, "scene.service.export_scene_log entry decode failed", decodeErr,
+				"scene_id", sceneID, "event_type", logRows[i].Type)
+			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
+		}
+		if !ok {
+			// Unknown type slipped past the SQL filter — should be unreachable.
+			slog.ErrorContext(ctx, "scene.service.export_scene_log unknown event type after sql filter",
+				"scene_id", sceneID, "event_type", logRows[i].Type)
+			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// decryptExportRows is the decrypt half shared by the export RPCs: it chunks
+// logRows through the snapshotDecryptor seam and returns each row's plaintext,
+// index-aligned with logRows. op prefixes the log messages. Any batch error,
+// count mismatch, or per-row refusal fails the whole export closed with an
+// opaque Internal. fullSubject is the AAD-canonical subject every row in
+// logRows was encrypted under.
+func (s *SceneServiceImpl) decryptExportRows(ctx context.Context, op, sceneID, fullSubject string, logRows []LogRow) ([][]byte, error) {
 	if len(logRows) == 0 {
 		return nil, nil
 	}
 
 	if s.decryptor == nil {
-		slog.ErrorContext(ctx, "scene.service.export_scene_log decryptor not configured",
+		slog.ErrorContext(ctx, op+" decryptor not configured",
 			"scene_id", sceneID,
 			"code", "SCENE_EXPORT_NO_DECRYPTOR")
 		return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
 	}
 
-	entries := make([]PublishedSceneEntry, 0, len(logRows))
+	plaintexts := make([][]byte, 0, len(logRows))
 	for start := 0; start < len(logRows); start += snapshotDecryptBatch {
 		end := start + snapshotDecryptBatch
 		if end > len(logRows) {
@@ -196,17 +227,17 @@ func (s *SceneServiceImpl) exportDecryptRows(ctx context.Context, sceneID, fullI
 
 		auditRows := make([]*pluginv1.AuditRow, len(chunk))
 		for i := range chunk {
-			auditRows[i] = logRowToAuditRow(fullICSubject, chunk[i])
+			auditRows[i] = logRowToAuditRow(fullSubject, chunk[i])
 		}
 
 		results, err := s.decryptor.DecryptOwnAuditRows(ctx, auditRows)
 		if err != nil {
-			errutil.LogErrorContext(ctx, "scene.service.export_scene_log decrypt batch failed", err,
+			errutil.LogErrorContext(ctx, op+" decrypt batch failed", err,
 				"scene_id", sceneID, "chunk_start", start, "chunk_len", len(chunk))
 			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
 		}
 		if len(results) != len(chunk) {
-			slog.ErrorContext(ctx, "scene.service.export_scene_log decrypt result count mismatch",
+			slog.ErrorContext(ctx, op+" decrypt result count mismatch",
 				"scene_id", sceneID, "want", len(chunk), "got", len(results))
 			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
 		}
@@ -214,24 +245,12 @@ func (s *SceneServiceImpl) exportDecryptRows(ctx context.Context, sceneID, fullI
 		for i := range results {
 			plaintext, refused := decryptedPlaintext(results[i])
 			if refused != "" {
-				slog.ErrorContext(ctx, "scene.service.export_scene_log row decrypt refused",
+				slog.ErrorContext(ctx, op+" row decrypt refused",
 					"scene_id", sceneID, "reason", refused)
 				return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
 			}
-			entry, ok, decodeErr := decodeSnapshotEntry(chunk[i].Type, plaintext)
-			if decodeErr != nil {
-				errutil.LogErrorContext(ctx, "scene.service.export_scene_log entry decode failed", decodeErr,
-					"scene_id", sceneID, "event_type", chunk[i].Type)
-				return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
-			}
-			if !ok {
-				// Unknown type slipped past the SQL filter — should be unreachable.
-				slog.ErrorContext(ctx, "scene.service.export_scene_log unknown event type after sql filter",
-					"scene_id", sceneID, "event_type", chunk[i].Type)
-				return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
-			}
-			entries = append(entries, entry)
+			plaintexts = append(plaintexts, plaintext)
 		}
 	}
-	return entries, nil
+	return plaintexts, nil
 }
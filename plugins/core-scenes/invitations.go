@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// SceneInvitation is a pending or lapsed invitation to a scene: the
+// invitee's role='invited' participant row plus who issued it and when it
+// lapses (migration 000014). Accepting it joins the invitee as a member;
+// declining it deletes the row.
+type SceneInvitation struct {
+	SceneID     string
+	SceneTitle  string
+	CharacterID string
+	// InvitedBy is empty for invitations issued before migration 000014.
+	InvitedBy string
+	InvitedAt time.Time
+	// ExpiresAt is nil when the invitation never lapses (invite_ttl 0s).
+	ExpiresAt *time.Time
+	// Existing reports that InviteParticipant found this invitation already
+	// pending and changed nothing; the invitee has been told about it.
+	Existing bool
+}
+
+// Expired reports whether the invitation has lapsed at now.
+func (i *SceneInvitation) Expired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// inviteExpiry returns when an invitation issued at now lapses under the
+// configured invite_ttl, or nil when invitations never lapse.
+func (s *SceneServiceImpl) inviteExpiry(now time.Time) *time.Time {
+	if s.cfg.InviteTTL <= 0 {
+		return nil
+	}
+	t := now.Add(s.cfg.InviteTTL)
+	return &t
+}
+
+// AcceptSceneInvitation joins characterID to sceneID on the strength of a
+// pending invitation. Unlike a bare JoinScene — which also admits anyone to
+// an open scene — accept insists that the invitation exists and has not
+// lapsed, so a player never lands in a scene they were not asked to.
+// Errors are gRPC statuses, matching JoinScene.
+func (s *SceneServiceImpl) AcceptSceneInvitation(ctx context.Context, sceneID, characterID string) error {
+	ctx, span := startSpan(
+		ctx, "scene.service.accept_scene_invitation",
+		attribute.String("subject_id", characterID),
+		attribute.String("scene_id", sceneID),
+	)
+	defer span.End()
+
+	if _, err := s.store.GetInvitation(ctx, sceneID, characterID); err != nil {
+		recordError(span, err)
+		return invitationStatus(ctx, "accept", sceneID, characterID, err)
+	}
+	_, err := s.JoinScene(ctx, &scenev1.JoinSceneRequest{
+		CharacterId: characterID,
+		SceneId:     sceneID,
+	})
+	return err //nolint:wrapcheck // JoinScene returns the gRPC status wire contract
+}
+
+// DeclineSceneInvitation withdraws characterID's pending invitation to
+// sceneID and tells the inviter with a scene_invitation_declined notice.
+func (s *SceneServiceImpl) DeclineSceneInvitation(ctx context.Context, sceneID, characterID string) error {
+	ctx, span := startSpan(
+		ctx, "scene.service.decline_scene_invitation",
+		attribute.String("subject_id", characterID),
+		attribute.String("scene_id", sceneID),
+	)
+	defer span.End()
+
+	inv, err := s.store.DeclineInvitation(ctx, sceneID, characterID)
+	if err != nil {
+		recordError(span, err)
+		return invitationStatus(ctx, "decline", sceneID, characterID, err)
+	}
+	if inv.InvitedBy != "" {
+		s.emitInvitationNotice(ctx, inv.InvitedBy, "core-scenes:scene_invitation_declined", inv)
+	}
+
+	slog.InfoContext(ctx, "scene.service.decline_scene_invitation ok",
+		"subject_id", characterID, "scene_id", sceneID)
+	return nil
+}
+
+// ListSceneInvitations returns characterID's pending invitations to live
+// scenes, oldest first.
+func (s *SceneServiceImpl) ListSceneInvitations(ctx context.Context, characterID string) ([]*SceneInvitation, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.list_scene_invitations",
+		attribute.String("subject_id", characterID),
+	)
+	defer span.End()
+
+	invs, err := s.store.ListInvitations(ctx, characterID)
+	if err != nil {
+		recordError(span, err)
+		slog.WarnContext(ctx, "scene.service.list_scene_invitations store error",
+			"subject_id", characterID, "error", err)
+		return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // opaque Internal per grpc-errors.md
+	}
+	return invs, nil
+}
+
+// invitationStatus maps an invitation store error to the gRPC status the
+// command layer renders; anything unexpected is logged and made opaque.
+func invitationStatus(ctx context.Context, op, sceneID, characterID string, err error) error {
+	var oe oops.OopsError
+	if errors.As(err, &oe) {
+		switch oe.Code() {
+		case "SCENE_INVITE_NOT_FOUND":
+			return status.Errorf(codes.NotFound, "no invitation to scene %s", sceneID)
+		case "SCENE_INVITE_EXPIRED":
+			return status.Errorf(codes.FailedPrecondition, "your invitation to scene %s has expired", sceneID)
+		}
+	}
+	slog.WarnContext(ctx, "scene.service."+op+"_scene_invitation store error",
+		"subject_id", characterID, "scene_id", sceneID, "error", err)
+	return status.Error(codes.Internal, "internal error") //nolint:wrapcheck // opaque Internal per grpc-errors.md
+}
+
+// emitInvitationNotice sends an invitation notice of eventType to
+// recipientID on that character's own subject: an invitee is not yet on the
+// scene roster, so the scene subject would not reach them. sensitivity:never
+// — IDs, title and expiry only, no RP content. Non-fatal: the invitation is
+// already committed.
+func (s *SceneServiceImpl) emitInvitationNotice(ctx context.Context, recipientID string, eventType pluginsdk.EventType, inv *SceneInvitation) {
+	if s.eventSink == nil {
+		slog.WarnContext(ctx, "scene.service.invitation notice emit skipped: event sink nil",
+			"scene_id", inv.SceneID, "event_type", string(eventType))
+		return
+	}
+	fields := map[string]any{
+		"scene_id":   inv.SceneID,
+		"title":      inv.SceneTitle,
+		"invitee_id": inv.CharacterID,
+		"inviter_id": inv.InvitedBy,
+	}
+	if inv.ExpiresAt != nil {
+		fields["expires_at_ms"] = inv.ExpiresAt.UnixMilli()
+	}
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		slog.WarnContext(ctx, "scene.service.invitation notice payload marshal failed",
+			"scene_id", inv.SceneID, "error", err)
+		return
+	}
+	intent := pluginsdk.EmitIntent{
+		Subject:   dotStyleCharacterSubject(s.gameID, recipientID),
+		Type:      eventType,
+		Payload:   string(payload),
+		Sensitive: false, // sensitivity:never per crypto.emits manifest
+	}
+	if err := s.eventSink.Emit(ctx, intent); err != nil {
+		slog.WarnContext(ctx, "scene.service.invitation notice emit failed",
+			"scene_id", inv.SceneID, "character_id", recipientID,
+			"event_type", string(eventType), "error", err)
+	}
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// newInvitationService returns a service over a fake store holding one
+// active private scene "scene-inv" owned by char-owner, plus its event sink.
+func newInvitationService(t *testing.T) (*SceneServiceImpl, *fakeStore, *recordingEventSink) {
+	t.Helper()
+	store := newFakeStore()
+	require.NoError(t, store.CreateWithOwner(context.Background(), &SceneRow{
+		ID: "scene-inv", Title: "Harbour Ball", OwnerID: "char-owner",
+		State: string(SceneStateActive), Visibility: string(SceneVisibilityPrivate),
+	}))
+	sink := &recordingEventSink{}
+	svc := newTestService(t, store)
+	svc.SetEventSink(sink)
+	svc.SetHostEvaluator(allowEvaluator{})
+	return svc, store, sink
+}
+
+func inviteBob(t *testing.T, svc *SceneServiceImpl) {
+	t.Helper()
+	_, err := svc.InviteToScene(context.Background(), &scenev1.InviteToSceneRequest{
+		CharacterId: "char-owner", SceneId: "scene-inv", TargetCharacterId: "char-bob",
+	})
+	require.NoError(t, err)
+}
+
+func intentsOfType(sink *recordingEventSink, eventType pluginsdk.EventType) []pluginsdk.EmitIntent {
+	var out []pluginsdk.EmitIntent
+	for _, in := range sink.intents {
+		if in.Type == eventType {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+func TestSceneInvitationExpired(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	later := now.Add(time.Hour)
+	assert.False(t, (&SceneInvitation{}).Expired(now), "no expiry never lapses")
+	assert.False(t, (&SceneInvitation{ExpiresAt: &later}).Expired(now))
+	assert.True(t, (&SceneInvitation{ExpiresAt: &later}).Expired(later))
+}
+
+func TestInviteToSceneNotifiesInviteeOnce(t *testing.T) {
+	svc, store, sink := newInvitationService(t)
+
+	inviteBob(t, svc)
+	inviteBob(t, svc) // still pending: no second notice
+
+	notices := intentsOfType(sink, "core-scenes:scene_invitation")
+	require.Len(t, notices, 1)
+	assert.Equal(t, dotStyleCharacterSubject(svc.gameID, "char-bob"), notices[0].Subject)
+	assert.False(t, notices[0].Sensitive)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal([]byte(notices[0].Payload), &payload))
+	assert.Equal(t, "scene-inv", payload["scene_id"])
+	assert.Equal(t, "Harbour Ball", payload["title"])
+	assert.Equal(t, "char-owner", payload["inviter_id"])
+	assert.Contains(t, payload, "expires_at_ms")
+
+	inv := store.invitations["scene-inv"]["char-bob"]
+	require.NotNil(t, inv.ExpiresAt, "manifest invite_ttl sets an expiry")
+	assert.WithinDuration(t, time.Now().Add(svc.cfg.InviteTTL), *inv.ExpiresAt, time.Minute)
+}
+
+func TestInviteToSceneWithoutTTLNeverLapses(t *testing.T) {
+	svc, store, _ := newInvitationService(t)
+	svc.cfg.InviteTTL = 0
+
+	inviteBob(t, svc)
+	assert.Nil(t, store.invitations["scene-inv"]["char-bob"].ExpiresAt)
+}
+
+func TestAcceptSceneInvitationJoinsAsMember(t *testing.T) {
+	svc, store, sink := newInvitationService(t)
+	inviteBob(t, svc)
+
+	require.NoError(t, svc.AcceptSceneInvitation(context.Background(), "scene-inv", "char-bob"))
+	assert.Equal(t, "member", store.participants["scene-inv"]["char-bob"])
+	require.Len(t, intentsOfType(sink, "core-scenes:scene_join_ic"), 1)
+}
+
+func TestAcceptSceneInvitationRequiresAnInvitation(t *testing.T) {
+	svc, store, _ := newInvitationService(t)
+	store.scenes["scene-inv"].Visibility = string(SceneVisibilityOpen)
+
+	// An open scene admits a bare join, but accept still needs an invitation.
+	err := svc.AcceptSceneInvitation(context.Background(), "scene-inv", "char-bob")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NotContains(t, store.participants["scene-inv"], "char-bob")
+}
+
+func TestLapsedInvitationNoLongerAdmits(t *testing.T) {
+	svc, store, _ := newInvitationService(t)
+	inviteBob(t, svc)
+	past := time.Now().Add(-time.Minute)
+	store.invitations["scene-inv"]["char-bob"].ExpiresAt = &past
+
+	err := svc.AcceptSceneInvitation(context.Background(), "scene-inv", "char-bob")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "expired")
+
+	_, err = svc.JoinScene(context.Background(), &scenev1.JoinSceneRequest{
+		CharacterId: "char-bob", SceneId: "scene-inv",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "expired")
+	assert.Equal(t, "invited", store.participants["scene-inv"]["char-bob"])
+
+	// Re-inviting reissues the lapsed invitation.
+	inviteBob(t, svc)
+	require.NoError(t, svc.AcceptSceneInvitation(context.Background(), "scene-inv", "char-bob"))
+}
+
+func TestDeclineSceneInvitationNotifiesInviter(t *testing.T) {
+	svc, store, sink := newInvitationService(t)
+	inviteBob(t, svc)
+
+	require.NoError(t, svc.DeclineSceneInvitation(context.Background(), "scene-inv", "char-bob"))
+	assert.NotContains(t, store.participants["scene-inv"], "char-bob")
+
+	notices := intentsOfType(sink, "core-scenes:scene_invitation_declined")
+	require.Len(t, notices, 1)
+	assert.Equal(t, dotStyleCharacterSubject(svc.gameID, "char-owner"), notices[0].Subject)
+
+	err := svc.DeclineSceneInvitation(context.Background(), "scene-inv", "char-bob")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRenderInvitations(t *testing.T) {
+	assert.Contains(t, renderInvitations(nil), "no pending scene invitations")
+
+	expires := time.Date(2026, 11, 1, 19, 0, 0, 0, time.UTC)
+	out := renderInvitations([]*SceneInvitation{
+		{SceneID: "s1", SceneTitle: "Ball", InvitedBy: "char-owner", ExpiresAt: &expires},
+		{SceneID: "s2", SceneTitle: "Duel"},
+	})
+	assert.Contains(t, out, "Scene invitations (2):")
+	assert.Contains(t, out, "s1 — Ball from char-owner, expires Sun Nov 1 19:00 UTC")
+	assert.Contains(t, out, "s2 — Duel, no expiry")
+}
+
+func TestSceneInvitationCommands(t *testing.T) {
+	p, fc := newTestPluginWithFocus(t)
+	store := p.service.store.(*fakeStore)
+	require.NoError(t, store.CreateWithOwner(context.Background(), &SceneRow{
+		ID: "scene-inv", Title: "Harbour Ball", OwnerID: "char-owner",
+		State: string(SceneStateActive), Visibility: string(SceneVisibilityPrivate),
+	}))
+	run := func(char, args string) *pluginsdk.CommandResponse {
+		t.Helper()
+		resp, err := p.dispatchCommand(context.Background(), pluginsdk.CommandRequest{
+			Command: "scene", Args: args, CharacterID: char, SessionID: "sess-" + char,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		return resp
+	}
+
+	resp := run("char-owner", "invite #scene-inv")
+	assert.Equal(t, pluginsdk.CommandError, resp.Status)
+	assert.Contains(t, resp.Output, "Usage: scene invite")
+
+	resp = run("char-owner", "invite #scene-inv char-bob char-carol char-bob")
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Equal(t, "Invited char-bob to scene scene-inv.\nInvited char-carol to scene scene-inv.", resp.Output)
+
+	resp = run("char-owner", "invite #scene-inv char-owner")
+	assert.Equal(t, pluginsdk.CommandError, resp.Status, "every invitation failed")
+	assert.Contains(t, resp.Output, "Failed to invite char-owner")
+
+	resp = run("char-bob", "invites")
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Contains(t, resp.Output, "scene-inv — Harbour Ball from char-owner")
+
+	resp = run("char-bob", "accept #scene-inv")
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Contains(t, resp.Output, "Joined scene #scene-inv")
+	assert.Equal(t, "member", store.participants["scene-inv"]["char-bob"])
+	require.Len(t, fc.joinCalls, 1, "accept subscribes the session like join")
+
+	resp = run("char-carol", "decline #scene-inv")
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Contains(t, resp.Output, "Declined the invitation to scene scene-inv.")
+
+	resp = run("char-carol", "accept #scene-inv")
+	assert.Equal(t, pluginsdk.CommandError, resp.Status)
+	assert.Contains(t, resp.Output, "no invitation to scene scene-inv")
+}
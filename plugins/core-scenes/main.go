@@ -42,6 +42,10 @@ type scenePlugin struct {
 	// Idle-timeout lifecycle (D-06), decoded from manifest config.
 	idleTimeoutDefault time.Duration // game-wide default before an idle scene auto-pauses
 	idleNudgeEnabled   bool          // OFF by default (spec §4.4): emit scene_idle_nudge on idle
+
+	// Scheduled-scene reminders, decoded from manifest reminder_lead_times
+	// (longest first; empty disables reminders).
+	reminderLeads []time.Duration
 }
 
 // sceneConfig is the mapstructure target for the plugin_config block declared
@@ -53,6 +57,9 @@ type sceneConfig struct {
 	SchedulerInterval  time.Duration `mapstructure:"scheduler_interval"`
 	IdleTimeoutDefault time.Duration `mapstructure:"idle_timeout_default"`
 	IdleNudgeEnabled   bool          `mapstructure:"idle_nudge_enabled"`
+	TurnTimeout        time.Duration `mapstructure:"turn_timeout"`
+	ReminderLeadTimes  string        `mapstructure:"reminder_lead_times"`
+	InviteTTL          time.Duration `mapstructure:"invite_ttl"`
 }
 
 // applyConfig decodes the host-delivered plugin_config into service.cfg and
@@ -80,13 +87,35 @@ func (p *scenePlugin) applyConfig(config *pluginv1.ServiceConfig) error {
 			With("idle_timeout_default", decoded.IdleTimeoutDefault.String()).
 			Errorf("idle_timeout_default must be positive")
 	}
+	// turn_timeout is the one duration where zero is meaningful (timeouts
+	// off), but a negative value is a typo, not a setting.
+	if decoded.TurnTimeout < 0 {
+		return oops.Code("SCENE_INIT_FAILED").
+			With("turn_timeout", decoded.TurnTimeout.String()).
+			Errorf("turn_timeout must not be negative")
+	}
+	// invite_ttl follows turn_timeout: 0s means invitations never lapse.
+	if decoded.InviteTTL < 0 {
+		return oops.Code("SCENE_INIT_FAILED").
+			With("invite_ttl", decoded.InviteTTL.String()).
+			Errorf("invite_ttl must not be negative")
+	}
+	reminderLeads, err := parseReminderLeads(decoded.ReminderLeadTimes)
+	if err != nil {
+		return oops.Code("SCENE_INIT_FAILED").
+			With("reminder_lead_times", decoded.ReminderLeadTimes).
+			Wrap(err)
+	}
 	p.service.cfg = SceneServiceConfig{
 		DefaultVoteWindow:    decoded.VoteWindow,
 		DefaultCoolOffWindow: decoded.CoolOffWindow,
+		TurnTimeout:          decoded.TurnTimeout,
+		InviteTTL:            decoded.InviteTTL,
 	}
 	p.schedInterval = decoded.SchedulerInterval
 	p.idleTimeoutDefault = decoded.IdleTimeoutDefault
 	p.idleNudgeEnabled = decoded.IdleNudgeEnabled
+	p.reminderLeads = reminderLeads
 	return nil
 }
 
@@ -219,6 +248,28 @@ func phase6EmitTypes() []string {
 	}
 }
 
+// turnEmitTypes returns the turn-tracker notice event type declared in
+// crypto.emits (sensitivity:never), registered alongside the phase sets so
+// the EmitTypeRegistrar set still equals the manifest (INV-PLUGIN-32).
+func turnEmitTypes() []string {
+	return []string{"scene_turn_changed_ic"}
+}
+
+// scheduleEmitTypes returns the scheduled-scene notice event types declared
+// in crypto.emits (sensitivity:never), registered alongside the phase sets
+// so the EmitTypeRegistrar set still equals the manifest (INV-PLUGIN-32).
+func scheduleEmitTypes() []string {
+	return []string{"scene_schedule_reminder", "scene_schedule_opened"}
+}
+
+// invitationEmitTypes returns the scene-invitation notice event types
+// declared in crypto.emits (sensitivity:never), registered alongside the
+// phase sets so the EmitTypeRegistrar set still equals the manifest
+// (INV-PLUGIN-32).
+func invitationEmitTypes() []string {
+	return []string{"scene_invitation", "scene_invitation_declined"}
+}
+
 // Init is called by the host after the gRPC connection is established and
 // the Postgres schema/role have been provisioned. It opens the connection
 // pool, runs the embedded migrations, and wires the resulting store into
@@ -246,6 +297,7 @@ func (p *scenePlugin) Init(ctx context.Context, config *pluginv1.ServiceConfig)
 	p.resolver.store = store
 	p.auditSrv.store = NewSceneAuditStore(store.Pool())
 	p.auditSrv.memberLookup = store // *SceneStore satisfies sceneMembershipLookup
+	p.auditSrv.turns = p.service    // poses auto-advance the turn tracker
 
 	// Set the game ID for NATS dot-style emit subjects, from the host-resolved
 	// value goplugin.Host.Init populates onto ServiceConfig.GameId (falls back
@@ -296,6 +348,31 @@ func (p *scenePlugin) Init(ctx context.Context, config *pluginv1.ServiceConfig)
 	}
 	go idleSched.Run(schedCtx)
 
+	// Turn-timeout sweep: passes on turn-tracker turns older than
+	// turn_timeout. Off (not started) when turn_timeout is 0, the default.
+	if p.service.cfg.TurnTimeout > 0 {
+		turnSched := &turnScheduler{
+			store:    store,
+			turns:    p.service,
+			interval: p.schedInterval,
+			timeout:  p.service.cfg.TurnTimeout,
+			now:      time.Now,
+		}
+		go turnSched.Run(schedCtx)
+	}
+
+	// Scheduled-scene sweep: opens scheduled scenes at their start time and
+	// sends reminders at the configured reminder_lead_times. Always started,
+	// since scenes must open even with reminders off.
+	scheduledSched := &scheduledSceneScheduler{
+		store:    store,
+		scenes:   p.service,
+		interval: p.schedInterval,
+		leads:    p.reminderLeads,
+		now:      time.Now,
+	}
+	go scheduledSched.Run(schedCtx)
+
 	slog.InfoContext(
 		ctx, "core-scenes plugin initialised",
 		"storage", "postgres",
@@ -307,6 +384,9 @@ func main() {
 	reg := pluginsdk.NewEmitRegistry()
 	reg.RegisterEmitTypes(phase4EmitTypes())
 	reg.RegisterEmitTypes(phase6EmitTypes())
+	reg.RegisterEmitTypes(turnEmitTypes())
+	reg.RegisterEmitTypes(scheduleEmitTypes())
+	reg.RegisterEmitTypes(invitationEmitTypes())
 
 	plugin := &scenePlugin{
 		service:      &SceneServiceImpl{},
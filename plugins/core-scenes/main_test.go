@@ -61,6 +61,34 @@ func TestApplyConfigRejectsNonPositiveSchedulerInterval(t *testing.T) {
 	errutil.AssertErrorCode(t, err, "SCENE_INIT_FAILED")
 }
 
+func TestApplyConfigParsesReminderLeadTimes(t *testing.T) {
+	t.Parallel()
+	p := &scenePlugin{service: &SceneServiceImpl{}}
+	cfg := &pluginv1.ServiceConfig{PluginConfig: map[string]string{
+		"vote_window": "168h", "cooloff_window": "30m", "scheduler_interval": "30s",
+		"idle_timeout_default": "30m", "reminder_lead_times": "1h, 24h",
+	}}
+	require.NoError(t, p.applyConfig(cfg))
+	require.Equal(t, []time.Duration{24 * time.Hour, time.Hour}, p.reminderLeads)
+
+	cfg.PluginConfig["reminder_lead_times"] = "24h,soon"
+	errutil.AssertErrorCode(t, p.applyConfig(cfg), "SCENE_INIT_FAILED")
+}
+
+func TestApplyConfigRejectsNegativeInviteTTL(t *testing.T) {
+	t.Parallel()
+	p := &scenePlugin{service: &SceneServiceImpl{}}
+	cfg := &pluginv1.ServiceConfig{PluginConfig: map[string]string{
+		"vote_window": "168h", "cooloff_window": "30m", "scheduler_interval": "30s",
+		"idle_timeout_default": "30m", "invite_ttl": "72h",
+	}}
+	require.NoError(t, p.applyConfig(cfg))
+	require.Equal(t, 72*time.Hour, p.service.cfg.InviteTTL)
+
+	cfg.PluginConfig["invite_ttl"] = "-1h"
+	errutil.AssertErrorCode(t, p.applyConfig(cfg), "SCENE_INIT_FAILED")
+}
+
 // TestPlugin_CryptoEmitsMatchesRegistry pins INV-SCENE-2 / INV-PLUGIN-32: the scene
 // event types in crypto.emits (8 Phase 4 + 6 Phase 6 publication notices)
 // MUST equal the set registered via EmitTypeRegistrar.
@@ -88,6 +116,9 @@ func TestPlugin_CryptoEmitsMatchesRegistry(t *testing.T) {
 	reg := pluginsdk.NewEmitRegistry()
 	reg.RegisterEmitTypes(phase4EmitTypes())
 	reg.RegisterEmitTypes(phase6EmitTypes())
+	reg.RegisterEmitTypes(turnEmitTypes())
+	reg.RegisterEmitTypes(scheduleEmitTypes())
+	reg.RegisterEmitTypes(invitationEmitTypes())
 	registrySet := reg.RegisteredEmitTypes()
 	sort.Strings(registrySet)
 
@@ -171,6 +202,11 @@ func TestPlugin_SensitivityMatrix(t *testing.T) {
 		"scene_publish_resolved":               "never",
 		"scene_publish_withdrawn":              "never",
 		"scene_publish_vote_attempts_extended": "never",
+		"scene_turn_changed_ic":                "never",
+		"scene_schedule_reminder":              "never",
+		"scene_schedule_opened":                "never",
+		"scene_invitation":                     "never",
+		"scene_invitation_declined":            "never",
 	}
 	got := make(map[string]string)
 	for _, e := range m.Crypto.Emits {
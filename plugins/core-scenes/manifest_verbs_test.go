@@ -38,6 +38,11 @@ func TestCoreScenesManifestDeclaresQualifiedVerbsForEveryEmitType(t *testing.T)
 		"core-scenes:scene_publish_started", "core-scenes:scene_publish_vote_cast",
 		"core-scenes:scene_publish_cooloff_started", "core-scenes:scene_publish_resolved",
 		"core-scenes:scene_publish_withdrawn", "core-scenes:scene_publish_vote_attempts_extended",
+		"core-scenes:scene_turn_changed_ic",
+		"core-scenes:scene_schedule_reminder",
+		"core-scenes:scene_schedule_opened",
+		"core-scenes:scene_invitation",
+		"core-scenes:scene_invitation_declined",
 	}
 	for _, w := range want {
 		require.Truef(t, got[w], "missing qualified verb entry %q", w)
@@ -0,0 +1,5 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Reverse 000012_scene_turns.up.sql.
+DROP TABLE IF EXISTS scene_turns;
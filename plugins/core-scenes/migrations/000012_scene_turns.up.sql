@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Scene turn tracker: one row per scene that has a turn queue.
+--
+--   * queue              -> character IDs in turn order.
+--   * current_index      -> index into queue of the character whose turn it
+--                           is; 0 when the queue is empty.
+--   * round              -> 1-based round, bumped when the turn wraps.
+--   * turn_started_at    -> when the current turn began; the timeout sweep
+--                           passes the turn on once it is older than the
+--                           configured turn_timeout.
+--
+-- Timestamps are BIGINT epoch-nanoseconds to match the rest of the
+-- plugin_core_scenes schema (migration 000007).
+CREATE TABLE IF NOT EXISTS scene_turns (
+    scene_id        TEXT    PRIMARY KEY REFERENCES scenes(id) ON DELETE CASCADE,
+    queue           TEXT[]  NOT NULL DEFAULT '{}',
+    current_index   INTEGER NOT NULL DEFAULT 0 CHECK (current_index >= 0),
+    round           INTEGER NOT NULL DEFAULT 1 CHECK (round >= 1),
+    turn_started_at BIGINT  NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    updated_at      BIGINT  NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+);
@@ -0,0 +1,6 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Reverse 000013_scene_schedules.up.sql.
+DROP TABLE IF EXISTS scene_schedule_rsvps;
+DROP TABLE IF EXISTS scene_schedules;
@@ -0,0 +1,48 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Scheduled scenes: a scene a GM announces ahead of its start time. The
+-- scheduled-scene sweep opens it at starts_at as a real scenes row with the
+-- SAME id, so the id players RSVP to is the id they later join.
+--
+--   * state             -> 'scheduled' until the scene opens ('opened') or
+--                          its owner calls it off ('cancelled').
+--   * reminded_lead_ns  -> the shortest reminder lead time already sent, so
+--                          each configured lead fires at most once; NULL
+--                          until the first reminder.
+--
+-- Timestamps are BIGINT epoch-nanoseconds to match the rest of the
+-- plugin_core_scenes schema (migration 000007).
+CREATE TABLE IF NOT EXISTS scene_schedules (
+    id               TEXT    PRIMARY KEY,
+    title            TEXT    NOT NULL,
+    description      TEXT    NOT NULL DEFAULT '',
+    location_id      TEXT,
+    owner_id         TEXT    NOT NULL,
+    content_warnings TEXT[]  NOT NULL DEFAULT '{}',
+    starts_at        BIGINT  NOT NULL,
+    state            TEXT    NOT NULL DEFAULT 'scheduled'
+                             CHECK (state IN ('scheduled', 'opened', 'cancelled')),
+    reminded_lead_ns BIGINT,
+    created_at       BIGINT  NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    updated_at       BIGINT  NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS idx_scene_schedules_pending
+    ON scene_schedules(starts_at) WHERE state = 'scheduled';
+
+-- One RSVP per character per schedule; answering again replaces the
+-- response but keeps created_at, which orders the RSVP list. 'yes'
+-- attendees are added as members when the scene opens; 'yes' and 'maybe'
+-- receive reminders.
+CREATE TABLE IF NOT EXISTS scene_schedule_rsvps (
+    schedule_id  TEXT   NOT NULL REFERENCES scene_schedules(id) ON DELETE CASCADE,
+    character_id TEXT   NOT NULL,
+    response     TEXT   NOT NULL CHECK (response IN ('yes', 'maybe', 'no')),
+    created_at   BIGINT NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    updated_at   BIGINT NOT NULL DEFAULT (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT,
+    PRIMARY KEY (schedule_id, character_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scene_schedule_rsvps_character
+    ON scene_schedule_rsvps(character_id);
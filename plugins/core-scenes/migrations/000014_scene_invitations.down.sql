@@ -0,0 +1,8 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Reverse 000014_scene_invitations.up.sql.
+DROP INDEX IF EXISTS idx_participants_invited_character;
+ALTER TABLE scene_participants
+    DROP COLUMN IF EXISTS invite_expires_at,
+    DROP COLUMN IF EXISTS invited_by;
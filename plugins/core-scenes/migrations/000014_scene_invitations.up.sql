@@ -0,0 +1,23 @@
+-- SPDX-License-Identifier: Apache-2.0
+-- Copyright 2026 HoloMUSH Contributors
+
+-- Scene invitations: an invitation stays a role='invited' participant row
+-- (P3.D1), now carrying who issued it and when it lapses.
+--
+--   * invited_by        -> the participant who issued the invitation; NULL
+--                          for rows that are not invitations and for
+--                          invitations issued before this migration.
+--   * invite_expires_at -> BIGINT epoch-nanoseconds (migration 000007)
+--                          after which the invitation no longer admits the
+--                          invitee; NULL means it never lapses.
+--
+-- An expired invitation is inert: it is left out of the resolver's invitees
+-- and does not admit a join. Re-inviting the character reissues it.
+ALTER TABLE scene_participants
+    ADD COLUMN IF NOT EXISTS invited_by        TEXT,
+    ADD COLUMN IF NOT EXISTS invite_expires_at BIGINT;
+
+-- `scene invites` lists a character's pending invitations.
+CREATE INDEX IF NOT EXISTS idx_participants_invited_character
+    ON scene_participants(character_id)
+    WHERE role = 'invited';
@@ -25,6 +25,7 @@ const (
 	OpsKindMembershipJoin                 OpsEventKind = "membership.join"
 	OpsKindMembershipLeave                OpsEventKind = "membership.leave"
 	OpsKindMembershipKick                 OpsEventKind = "membership.kick"
+	OpsKindMembershipDecline              OpsEventKind = "membership.decline"
 	OpsKindMembershipOwnershipTransferred OpsEventKind = "membership.ownership_transferred"
 	OpsKindLifecycleCreated               OpsEventKind = "lifecycle.created"
 	OpsKindLifecycleEnded                 OpsEventKind = "lifecycle.ended"
@@ -37,7 +38,7 @@ const (
 func (k OpsEventKind) IsValid() bool {
 	switch k {
 	case OpsKindMembershipInvite, OpsKindMembershipJoin, OpsKindMembershipLeave,
-		OpsKindMembershipKick, OpsKindMembershipOwnershipTransferred,
+		OpsKindMembershipKick, OpsKindMembershipDecline, OpsKindMembershipOwnershipTransferred,
 		OpsKindLifecycleCreated, OpsKindLifecycleEnded,
 		OpsKindLifecyclePaused, OpsKindLifecycleResumed,
 		OpsKindSettingsUpdated:
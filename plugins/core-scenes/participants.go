@@ -11,7 +11,8 @@ import "github.com/holomush/holomush/internal/pgnanos"
 // on private scenes. An invitation is a row that grants the holder permission
 // to join (and to read scene metadata in a later phase). Calling JoinScene on
 // an invited scene atomically promotes the row to `member`. There is no
-// `invited` row on open scenes.
+// `invited` row on open scenes. An invitation lapses at its
+// invite_expires_at (migration 000014), after which it no longer admits.
 type ParticipantRole string
 
 const (
@@ -113,6 +113,13 @@ func publicArchiveNotFound() error {
 type SceneServiceConfig struct {
 	DefaultVoteWindow    time.Duration
 	DefaultCoolOffWindow time.Duration
+	// TurnTimeout is how long a turn-tracker turn may run before the turn
+	// scheduler passes it on (manifest turn_timeout). Zero disables turn
+	// timeouts; unlike the publish windows, zero is a valid setting.
+	TurnTimeout time.Duration
+	// InviteTTL is how long a scene invitation stays open (manifest
+	// invite_ttl). Zero means invitations never lapse.
+	InviteTTL time.Duration
 }
 
 // publishEventer is the seam SceneServiceImpl uses to emit the six Phase 6
@@ -1017,6 +1017,13 @@ func (s *SceneStore) ReadSceneMetaForSnapshot(ctx context.Context, tx pgx.Tx, sc
 // synchronous cost ceiling before the export warrants offline processing.
 const exportLogMaxRows = 10_000
 
+// exportICEventTypes are the IC content kinds ReadSceneLogForExport returns.
+var exportICEventTypes = []string{"core-scenes:scene_pose", "core-scenes:scene_say", "core-scenes:scene_emit"}
+
+// exportOOCEventTypes are the OOC content kinds ReadSceneOOCLogForExport
+// returns — chat only, never the ops/notice events sharing the subject.
+var exportOOCEventTypes = []string{"core-scenes:scene_ooc"}
+
 // ReadSceneLogForExport reads the IC log rows for a scene in chronological
 // order (ORDER BY id ASC) without a transaction — used by ExportSceneLog.
 // fullSubject is the complete NATS dot-style IC subject
@@ -1034,17 +1041,45 @@ func (s *SceneStore) ReadSceneLogForExport(ctx context.Context, fullSubject stri
 		attribute.String("subject", fullSubject))
 	defer span.End()
 
+	rows, err := s.readExportLog(ctx, fullSubject, exportICEventTypes)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ReadSceneOOCLogForExport reads a scene's scene_ooc rows in chronological
+// order for ExportTranscript. fullSubject is the complete OOC subject
+// (events.<game_id>.scene.<scene_id>.ooc), matched exactly for the same AAD
+// reason as ReadSceneLogForExport, and the same exportLogMaxRows ceiling
+// applies.
+func (s *SceneStore) ReadSceneOOCLogForExport(ctx context.Context, fullSubject string) ([]LogRow, error) {
+	ctx, span := startSpan(ctx, "scene.store.read_scene_ooc_log_for_export",
+		attribute.String("subject", fullSubject))
+	defer span.End()
+
+	rows, err := s.readExportLog(ctx, fullSubject, exportOOCEventTypes)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	return rows, nil
+}
+
+// readExportLog is the shared body of the export reads: rows on fullSubject
+// whose type is in eventTypes, ORDER BY id ASC, capped at exportLogMaxRows.
+func (s *SceneStore) readExportLog(ctx context.Context, fullSubject string, eventTypes []string) ([]LogRow, error) {
 	// Fetch cap+1 so we can detect overflow without a separate COUNT query.
 	rows, err := s.pool.Query(ctx, `
 		SELECT id, type, timestamp, actor_kind, actor_id, payload, schema_ver, codec, dek_ref, dek_version
 		FROM scene_log
 		WHERE subject = $1
-		  AND type IN ('core-scenes:scene_pose', 'core-scenes:scene_say', 'core-scenes:scene_emit')
+		  AND type = ANY($2)
 		ORDER BY id ASC
-		LIMIT $2
-	`, fullSubject, exportLogMaxRows+1)
+		LIMIT $3
+	`, fullSubject, eventTypes, exportLogMaxRows+1)
 	if err != nil {
-		recordError(span, err)
 		return nil, oops.Code("SCENE_EXPORT_LOG_READ_FAILED").Wrap(err)
 	}
 	defer rows.Close()
@@ -1055,22 +1090,18 @@ func (s *SceneStore) ReadSceneLogForExport(ctx context.Context, fullSubject stri
 		if err := rows.Scan(
 			&r.ID, &r.Type, &r.Timestamp, &r.ActorKind, &r.ActorID, &r.Payload, &r.SchemaVer, &r.Codec, &r.DEKRef, &r.DEKVersion,
 		); err != nil {
-			recordError(span, err)
 			return nil, oops.Code("SCENE_EXPORT_LOG_SCAN_FAILED").Wrap(err)
 		}
 		out = append(out, r)
 	}
 	if err := rows.Err(); err != nil {
-		recordError(span, err)
 		return nil, oops.Code("SCENE_EXPORT_LOG_ITER_FAILED").Wrap(err)
 	}
 	if len(out) > exportLogMaxRows {
-		tooLargeErr := oops.Code("SCENE_EXPORT_TOO_LARGE").
+		return nil, oops.Code("SCENE_EXPORT_TOO_LARGE").
 			With("subject", fullSubject).
 			With("limit", exportLogMaxRows).
 			Errorf("scene log exceeds %d-row export ceiling", exportLogMaxRows)
-		recordError(span, tooLargeErr)
-		return nil, tooLargeErr
 	}
 	return out, nil
 }
@@ -0,0 +1,484 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// ScheduleState is the lifecycle state of a scheduled scene
+// (scene_schedules.state, migration 000013).
+type ScheduleState string
+
+// Scheduled-scene states. A schedule leaves "scheduled" exactly once.
+const (
+	ScheduleStateScheduled ScheduleState = "scheduled"
+	ScheduleStateOpened    ScheduleState = "opened"
+	ScheduleStateCancelled ScheduleState = "cancelled"
+)
+
+// RsvpResponse is a character's answer to a scheduled scene.
+type RsvpResponse string
+
+// RSVP answers. "yes" attendees are added to the scene when it opens; "yes"
+// and "maybe" both receive reminders.
+const (
+	RsvpYes   RsvpResponse = "yes"
+	RsvpMaybe RsvpResponse = "maybe"
+	RsvpNo    RsvpResponse = "no"
+)
+
+// defaultUpcomingLimit and maxUpcomingLimit bound ListUpcomingScenes; the
+// proto caps the request at the same maximum.
+const (
+	defaultUpcomingLimit = 25
+	maxUpcomingLimit     = 100
+)
+
+// SceneRsvp is one character's answer to a scheduled scene.
+type SceneRsvp struct {
+	CharacterID string
+	Response    RsvpResponse
+}
+
+// SceneSchedule is a scene announced ahead of its start time. At StartsAt
+// the scheduled-scene sweep opens it as a real scene with the same ID.
+// Methods are pure — no DB, no clock — so the reminder and attendee rules
+// are unit-testable; the store persists the schedule (scene_schedules,
+// migration 000013).
+type SceneSchedule struct {
+	ID              string
+	Title           string
+	Description     string
+	LocationID      *string
+	OwnerID         string
+	ContentWarnings []string
+	StartsAt        time.Time
+	State           ScheduleState
+	// RemindedLead is the shortest reminder lead time already sent; nil
+	// until the first reminder.
+	RemindedLead *time.Duration
+	// Rsvps are ordered by when each character first answered.
+	Rsvps []SceneRsvp
+}
+
+// Attendees returns the characters to add as members when the scene opens:
+// every "yes" RSVP except the owner, who joins as owner.
+func (s *SceneSchedule) Attendees() []string {
+	var out []string
+	for _, r := range s.Rsvps {
+		if r.Response == RsvpYes && r.CharacterID != s.OwnerID {
+			out = append(out, r.CharacterID)
+		}
+	}
+	return out
+}
+
+// Notified returns the characters told about reminders and the opening:
+// the owner first, then every "yes" or "maybe" RSVP.
+func (s *SceneSchedule) Notified() []string {
+	out := []string{s.OwnerID}
+	for _, r := range s.Rsvps {
+		if r.Response != RsvpNo && r.CharacterID != s.OwnerID {
+			out = append(out, r.CharacterID)
+		}
+	}
+	return out
+}
+
+// Response returns characterID's RSVP, or "" when they have not answered.
+func (s *SceneSchedule) Response(characterID string) RsvpResponse {
+	for _, r := range s.Rsvps {
+		if r.CharacterID == characterID {
+			return r.Response
+		}
+	}
+	return ""
+}
+
+// DueReminder reports the reminder lead time to send at now, given the
+// configured leads. Only the shortest lead already reached is considered,
+// so a scene scheduled an hour out with leads of 24h and 1h sends one
+// reminder, not two, and each lead fires at most once. No reminder is due
+// once the scene has started: it opens instead.
+func (s *SceneSchedule) DueReminder(now time.Time, leads []time.Duration) (time.Duration, bool) {
+	if !now.Before(s.StartsAt) {
+		return 0, false
+	}
+	var (
+		due   time.Duration
+		found bool
+	)
+	for _, lead := range leads {
+		if !now.Before(s.StartsAt.Add(-lead)) && (!found || lead < due) {
+			due, found = lead, true
+		}
+	}
+	if !found || (s.RemindedLead != nil && *s.RemindedLead <= due) {
+		return 0, false
+	}
+	return due, true
+}
+
+// sceneRow returns the scene the schedule opens as: open, active, and
+// owned by the scheduling character, with the schedule's ID.
+func (s *SceneSchedule) sceneRow() *SceneRow {
+	contentWarnings := s.ContentWarnings
+	if contentWarnings == nil {
+		contentWarnings = []string{}
+	}
+	return &SceneRow{
+		ID:              s.ID,
+		Title:           s.Title,
+		Description:     s.Description,
+		LocationID:      s.LocationID,
+		OwnerID:         s.OwnerID,
+		State:           string(SceneStateActive),
+		PoseOrder:       string(PoseOrderModeFree),
+		Visibility:      string(SceneVisibilityOpen),
+		ContentWarnings: contentWarnings,
+		Tags:            []string{},
+	}
+}
+
+func (s *SceneSchedule) toProto() *scenev1.ScheduledScene {
+	out := &scenev1.ScheduledScene{
+		Id:              s.ID,
+		Title:           s.Title,
+		Description:     s.Description,
+		OwnerId:         s.OwnerID,
+		ContentWarnings: slices.Clone(s.ContentWarnings),
+		StartsAt:        timestamppb.New(s.StartsAt),
+		State:           string(s.State),
+	}
+	if s.LocationID != nil {
+		out.LocationId = *s.LocationID
+	}
+	for _, r := range s.Rsvps {
+		out.Rsvps = append(out.Rsvps, &scenev1.SceneRsvp{
+			CharacterId: r.CharacterID,
+			Response:    string(r.Response),
+		})
+	}
+	return out
+}
+
+// parseReminderLeads parses the reminder_lead_times config value: a
+// comma-separated list of positive durations, e.g. "24h,1h". The result is
+// sorted longest first with duplicates removed; an empty value disables
+// reminders.
+func parseReminderLeads(raw string) ([]time.Duration, error) {
+	var leads []time.Duration
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		lead, err := time.ParseDuration(field)
+		if err != nil {
+			return nil, oops.With("lead_time", field).Wrap(err)
+		}
+		if lead <= 0 {
+			return nil, oops.With("lead_time", field).Errorf("reminder lead times must be positive")
+		}
+		leads = append(leads, lead)
+	}
+	slices.Sort(leads)
+	slices.Reverse(leads)
+	return slices.Compact(leads), nil
+}
+
+// ScheduleScene stores a scheduled scene owned by the calling character.
+// starts_at MUST be in the future. Only open scenes can be scheduled: the
+// owner may make the scene private once it opens. The same actor-metadata
+// cross-check as CreateScene binds the owner to the authenticated
+// character.
+func (s *SceneServiceImpl) ScheduleScene(ctx context.Context, req *scenev1.ScheduleSceneRequest) (*scenev1.ScheduleSceneResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.schedule_scene",
+		attribute.String("subject_id", req.GetCharacterId()),
+	)
+	defer span.End()
+
+	if mismatchedActingCharacter(ctx, req.GetCharacterId()) {
+		return nil, status.Error(codes.PermissionDenied, "not permitted to schedule for this character") //nolint:wrapcheck // gRPC status is the wire contract; opaque per grpc-errors.md
+	}
+	title := strings.TrimSpace(req.GetTitle())
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title cannot be whitespace-only") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if req.GetStartsAt() == nil {
+		return nil, status.Error(codes.InvalidArgument, "starts_at is required") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	startsAt := req.GetStartsAt().AsTime()
+	if !startsAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "starts_at must be in the future") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if err := s.validateContentWarnings(ctx, req.GetContentWarnings()); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	id, err := newSceneID()
+	if err != nil {
+		recordError(span, err)
+		slog.WarnContext(ctx, "scene.service.schedule_scene id generation error",
+			"subject_id", req.GetCharacterId(), "error", err)
+		return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	span.SetAttributes(attribute.String("schedule_id", id))
+
+	sched := &SceneSchedule{
+		ID:              id,
+		Title:           title,
+		Description:     req.GetDescription(),
+		OwnerID:         req.GetCharacterId(),
+		ContentWarnings: slices.Clone(req.GetContentWarnings()),
+		StartsAt:        startsAt,
+		State:           ScheduleStateScheduled,
+	}
+	if sched.ContentWarnings == nil {
+		sched.ContentWarnings = []string{}
+	}
+	if loc := req.GetLocationId(); loc != "" {
+		sched.LocationID = &loc
+	}
+	if err := s.store.CreateSceneSchedule(ctx, sched); err != nil {
+		recordError(span, err)
+		slog.WarnContext(ctx, "scene.service.schedule_scene store error",
+			"subject_id", req.GetCharacterId(), "schedule_id", id, "error", err)
+		return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+
+	slog.InfoContext(ctx, "scene.service.schedule_scene ok",
+		"subject_id", req.GetCharacterId(), "schedule_id", id,
+		"starts_at", startsAt.UTC().Format(time.RFC3339))
+	return &scenev1.ScheduleSceneResponse{Scene: sched.toProto()}, nil
+}
+
+// RsvpScheduledScene records the calling character's answer to a scheduled
+// scene, replacing any earlier one. A scene that has opened or been
+// cancelled no longer takes RSVPs (FailedPrecondition).
+func (s *SceneServiceImpl) RsvpScheduledScene(ctx context.Context, req *scenev1.RsvpScheduledSceneRequest) (*scenev1.RsvpScheduledSceneResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.rsvp_scheduled_scene",
+		attribute.String("subject_id", req.GetCharacterId()),
+		attribute.String("schedule_id", req.GetScheduleId()),
+	)
+	defer span.End()
+
+	if mismatchedActingCharacter(ctx, req.GetCharacterId()) {
+		return nil, status.Error(codes.PermissionDenied, "not permitted to answer for this character") //nolint:wrapcheck // gRPC status is the wire contract; opaque per grpc-errors.md
+	}
+	response := RsvpResponse(req.GetResponse())
+	switch response {
+	case RsvpYes, RsvpMaybe, RsvpNo:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown rsvp response %q", req.GetResponse())
+	}
+
+	sched, err := s.store.SetSceneRsvp(ctx, req.GetScheduleId(), req.GetCharacterId(), response)
+	if err != nil {
+		recordError(span, err)
+		return nil, mapScheduleError(ctx, err, req.GetScheduleId())
+	}
+
+	slog.InfoContext(ctx, "scene.service.rsvp_scheduled_scene ok",
+		"subject_id", req.GetCharacterId(), "schedule_id", req.GetScheduleId(),
+		"response", string(response))
+	return &scenev1.RsvpScheduledSceneResponse{Scene: sched.toProto()}, nil
+}
+
+// CancelScheduledScene calls off a scheduled scene before it opens. Only
+// its owner may cancel; anyone else is refused as PermissionDenied.
+func (s *SceneServiceImpl) CancelScheduledScene(ctx context.Context, req *scenev1.CancelScheduledSceneRequest) (*scenev1.CancelScheduledSceneResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.cancel_scheduled_scene",
+		attribute.String("subject_id", req.GetCharacterId()),
+		attribute.String("schedule_id", req.GetScheduleId()),
+	)
+	defer span.End()
+
+	if mismatchedActingCharacter(ctx, req.GetCharacterId()) {
+		return nil, status.Error(codes.PermissionDenied, "not permitted to cancel for this character") //nolint:wrapcheck // gRPC status is the wire contract; opaque per grpc-errors.md
+	}
+	sched, err := s.store.GetSceneSchedule(ctx, req.GetScheduleId())
+	if err != nil {
+		recordError(span, err)
+		return nil, mapScheduleError(ctx, err, req.GetScheduleId())
+	}
+	if sched.OwnerID != req.GetCharacterId() {
+		return nil, status.Error(codes.PermissionDenied, "only the scene owner may cancel it") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if err := s.store.CancelSceneSchedule(ctx, req.GetScheduleId()); err != nil {
+		recordError(span, err)
+		return nil, mapScheduleError(ctx, err, req.GetScheduleId())
+	}
+
+	slog.InfoContext(ctx, "scene.service.cancel_scheduled_scene ok",
+		"subject_id", req.GetCharacterId(), "schedule_id", req.GetScheduleId())
+	return &scenev1.CancelScheduledSceneResponse{}, nil
+}
+
+// ListUpcomingScenes returns the scheduled scenes that have not opened,
+// soonest first. Scheduled scenes are always open, so the list is not
+// gated; attending narrows it to the caller's own and "yes"/"maybe" scenes.
+func (s *SceneServiceImpl) ListUpcomingScenes(ctx context.Context, req *scenev1.ListUpcomingScenesRequest) (*scenev1.ListUpcomingScenesResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.list_upcoming_scenes",
+		attribute.String("subject_id", req.GetCharacterId()),
+		attribute.Bool("attending", req.GetAttending()),
+	)
+	defer span.End()
+
+	limit := int(req.GetLimit())
+	switch {
+	case limit <= 0:
+		limit = defaultUpcomingLimit
+	case limit > maxUpcomingLimit:
+		limit = maxUpcomingLimit
+	}
+	scheds, err := s.store.ListUpcomingSceneSchedules(ctx, UpcomingQuery{
+		CharacterID: req.GetCharacterId(),
+		Attending:   req.GetAttending(),
+		Limit:       limit,
+	})
+	if err != nil {
+		recordError(span, err)
+		slog.WarnContext(ctx, "scene.service.list_upcoming_scenes store error",
+			"subject_id", req.GetCharacterId(), "error", err)
+		return nil, status.Error(codes.Internal, "upcoming scene lookup failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	out := make([]*scenev1.ScheduledScene, 0, len(scheds))
+	for _, sched := range scheds {
+		out = append(out, sched.toProto())
+	}
+	return &scenev1.ListUpcomingScenesResponse{Scenes: out}, nil
+}
+
+// mapScheduleError translates schedule store errors into gRPC status
+// errors; anything else is logged and surfaced as Internal.
+func mapScheduleError(ctx context.Context, err error, scheduleID string) error {
+	var oe oops.OopsError
+	if errors.As(err, &oe) {
+		switch oe.Code() {
+		case "SCENE_SCHEDULE_NOT_FOUND":
+			return status.Errorf(codes.NotFound, "scheduled scene not found: %s", scheduleID)
+		case "SCENE_SCHEDULE_NOT_PENDING":
+			return status.Error(codes.FailedPrecondition, "the scene has already opened or been cancelled") //nolint:wrapcheck // gRPC status errors pass through as-is
+		}
+	}
+	slog.WarnContext(ctx, "scene.service.schedule store error",
+		"schedule_id", scheduleID, "error", err)
+	return status.Error(codes.Internal, "scheduled scene update failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+}
+
+// remindScheduledScene sends the reminder for lead to everyone the
+// schedule notifies. The lead is claimed in the store first, so a reminder
+// goes out at most once even if sweeps overlap; a lost claim is a no-op.
+func (s *SceneServiceImpl) remindScheduledScene(ctx context.Context, sched *SceneSchedule, lead time.Duration) error {
+	claimed, err := s.store.MarkSceneScheduleReminded(ctx, sched.ID, lead)
+	if err != nil {
+		return oops.Code("SCENE_SCHEDULE_REMIND_FAILED").With("schedule_id", sched.ID).Wrap(err)
+	}
+	if !claimed {
+		return nil
+	}
+	s.emitScheduleNotice(ctx, sched, "core-scenes:scene_schedule_reminder", map[string]any{
+		"lead_secs": int64(lead.Seconds()),
+	})
+	return nil
+}
+
+// openScheduledScene opens a due scheduled scene: the store creates the
+// scene with the schedule's ID and adds the "yes" attendees in one
+// transaction, then the usual created and join notices go out, followed by
+// a scene_schedule_opened notice to everyone the schedule notifies. A
+// schedule that already opened or was cancelled is a no-op.
+func (s *SceneServiceImpl) openScheduledScene(ctx context.Context, scheduleID string) error {
+	sched, err := s.store.OpenSceneSchedule(ctx, scheduleID)
+	if err != nil {
+		var oe oops.OopsError
+		if errors.As(err, &oe) && oe.Code() == "SCENE_SCHEDULE_NOT_PENDING" {
+			return nil
+		}
+		return oops.Code("SCENE_SCHEDULE_OPEN_FAILED").With("schedule_id", scheduleID).Wrap(err)
+	}
+	metricSceneCreated(string(SceneVisibilityOpen), false)
+
+	if s.eventSink != nil {
+		intent, err := s.sceneCreatedIntent(sched.sceneRow())
+		if err == nil {
+			err = s.eventSink.Emit(ctx, intent)
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "scene.service.open_scheduled_scene created emit failed",
+				"scene_id", sched.ID, "error", err)
+		}
+	}
+	for _, id := range sched.Attendees() {
+		s.emitSceneJoinIC(ctx, sched.ID, id, OpInserted)
+	}
+	s.emitScheduleNotice(ctx, sched, "core-scenes:scene_schedule_opened", nil)
+
+	slog.InfoContext(ctx, "scene.service.open_scheduled_scene ok",
+		"scene_id", sched.ID, "owner_id", sched.OwnerID,
+		"attendees", len(sched.Attendees()))
+	return nil
+}
+
+// emitScheduleNotice sends a scheduled-scene notice of eventType to each
+// character the schedule notifies, on that character's own subject: until
+// the scene opens there is no roster to address it to. sensitivity:never —
+// IDs, title and start time only, no RP content. Non-fatal per recipient.
+func (s *SceneServiceImpl) emitScheduleNotice(ctx context.Context, sched *SceneSchedule, eventType pluginsdk.EventType, extra map[string]any) {
+	if s.eventSink == nil {
+		slog.WarnContext(ctx, "scene.service.schedule notice emit skipped: event sink nil",
+			"schedule_id", sched.ID, "event_type", string(eventType))
+		return
+	}
+	fields := map[string]any{
+		"schedule_id":  sched.ID,
+		"title":        sched.Title,
+		"owner_id":     sched.OwnerID,
+		"starts_at_ms": sched.StartsAt.UnixMilli(),
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		slog.WarnContext(ctx, "scene.service.schedule notice payload marshal failed",
+			"schedule_id", sched.ID, "error", err)
+		return
+	}
+	for _, characterID := range sched.Notified() {
+		intent := pluginsdk.EmitIntent{
+			Subject:   dotStyleCharacterSubject(s.gameID, characterID),
+			Type:      eventType,
+			Payload:   string(payload),
+			Sensitive: false, // sensitivity:never per crypto.emits manifest
+		}
+		if err := s.eventSink.Emit(ctx, intent); err != nil {
+			slog.WarnContext(ctx, "scene.service.schedule notice emit failed",
+				"schedule_id", sched.ID, "character_id", characterID,
+				"event_type", string(eventType), "error", err)
+		}
+	}
+}
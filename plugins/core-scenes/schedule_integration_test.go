@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+// Scheduled-scene store tests: the RSVP upsert and pending-only guards,
+// the upcoming and due listings, the once-per-lead reminder claim, and
+// OpenSceneSchedule's scene creation with pre-added attendees.
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo convention
+	. "github.com/onsi/gomega"    //nolint:revive // gomega convention
+	"github.com/samber/oops"
+)
+
+var _ = Describe("SceneStore scheduled scenes", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		store  *SceneStore
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 60*time.Second)
+		store = newTestStore()
+	})
+	AfterEach(func() { cancel() })
+
+	schedule := func(id, owner string, startsAt time.Time) {
+		GinkgoHelper()
+		Expect(store.CreateSceneSchedule(ctx, &SceneSchedule{
+			ID: id, Title: "Scheduled " + id, OwnerID: owner,
+			ContentWarnings: []string{}, StartsAt: startsAt,
+		})).To(Succeed())
+	}
+	rsvp := func(id, char string, response RsvpResponse) *SceneSchedule {
+		GinkgoHelper()
+		sched, err := store.SetSceneRsvp(ctx, id, char, response)
+		Expect(err).NotTo(HaveOccurred())
+		return sched
+	}
+	errCode := func(err error) any {
+		GinkgoHelper()
+		var oe oops.OopsError
+		Expect(errors.As(err, &oe)).To(BeTrue())
+		return oe.Code()
+	}
+
+	It("round-trips a schedule and upserts RSVPs in first-answer order", func() {
+		startsAt := time.Now().Add(time.Hour)
+		schedule("01SCHED_RSVP000000000000A", "char-owner", startsAt)
+
+		rsvp("01SCHED_RSVP000000000000A", "char-a", RsvpYes)
+		rsvp("01SCHED_RSVP000000000000A", "char-b", RsvpMaybe)
+		sched := rsvp("01SCHED_RSVP000000000000A", "char-a", RsvpNo)
+		Expect(sched.Rsvps).To(Equal([]SceneRsvp{
+			{CharacterID: "char-a", Response: RsvpNo},
+			{CharacterID: "char-b", Response: RsvpMaybe},
+		}))
+
+		got, err := store.GetSceneSchedule(ctx, "01SCHED_RSVP000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.State).To(Equal(ScheduleStateScheduled))
+		Expect(got.StartsAt.UnixNano()).To(Equal(startsAt.UnixNano()))
+		Expect(got.RemindedLead).To(BeNil())
+		Expect(got.Rsvps).To(HaveLen(2))
+
+		_, err = store.GetSceneSchedule(ctx, "01SCHED_MISSING0000000000A")
+		Expect(errCode(err)).To(Equal("SCENE_SCHEDULE_NOT_FOUND"))
+	})
+
+	It("refuses RSVPs and a second cancel once the schedule is cancelled", func() {
+		schedule("01SCHED_CANCEL0000000000A", "char-owner", time.Now().Add(time.Hour))
+		Expect(store.CancelSceneSchedule(ctx, "01SCHED_CANCEL0000000000A")).To(Succeed())
+
+		_, err := store.SetSceneRsvp(ctx, "01SCHED_CANCEL0000000000A", "char-a", RsvpYes)
+		Expect(errCode(err)).To(Equal("SCENE_SCHEDULE_NOT_PENDING"))
+		Expect(errCode(store.CancelSceneSchedule(ctx, "01SCHED_CANCEL0000000000A"))).To(Equal("SCENE_SCHEDULE_NOT_PENDING"))
+		Expect(errCode(store.CancelSceneSchedule(ctx, "01SCHED_MISSING0000000000A"))).To(Equal("SCENE_SCHEDULE_NOT_FOUND"))
+	})
+
+	It("lists upcoming schedules soonest first, narrowed by attending", func() {
+		now := time.Now()
+		schedule("01SCHED_LATER00000000000A", "char-owner", now.Add(2*time.Hour))
+		schedule("01SCHED_SOONER0000000000A", "char-other", now.Add(time.Hour))
+		schedule("01SCHED_DECLINED00000000A", "char-other", now.Add(3*time.Hour))
+		schedule("01SCHED_OFF0000000000000A", "char-owner", now.Add(30*time.Minute))
+		Expect(store.CancelSceneSchedule(ctx, "01SCHED_OFF0000000000000A")).To(Succeed())
+		rsvp("01SCHED_SOONER0000000000A", "char-a", RsvpMaybe)
+		rsvp("01SCHED_DECLINED00000000A", "char-a", RsvpNo)
+
+		all, err := store.ListUpcomingSceneSchedules(ctx, UpcomingQuery{CharacterID: "char-a", Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		ids := make([]string, 0, len(all))
+		for _, s := range all {
+			ids = append(ids, s.ID)
+		}
+		Expect(ids).To(Equal([]string{"01SCHED_SOONER0000000000A", "01SCHED_LATER00000000000A", "01SCHED_DECLINED00000000A"}))
+		Expect(all[0].Rsvps).To(Equal([]SceneRsvp{{CharacterID: "char-a", Response: RsvpMaybe}}))
+
+		mine, err := store.ListUpcomingSceneSchedules(ctx, UpcomingQuery{CharacterID: "char-a", Attending: true, Limit: 10})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mine).To(HaveLen(1))
+		Expect(mine[0].ID).To(Equal("01SCHED_SOONER0000000000A"))
+
+		owned, err := store.ListUpcomingSceneSchedules(ctx, UpcomingQuery{CharacterID: "char-owner", Attending: true, Limit: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owned).To(HaveLen(1))
+		Expect(owned[0].ID).To(Equal("01SCHED_LATER00000000000A"))
+	})
+
+	It("lists due schedules up to the horizon and claims each reminder lead once", func() {
+		now := time.Now()
+		schedule("01SCHED_DUE0000000000000A", "char-owner", now.Add(30*time.Minute))
+		schedule("01SCHED_FAR0000000000000A", "char-owner", now.Add(48*time.Hour))
+
+		due, err := store.ListSceneSchedulesDue(ctx, now.Add(time.Hour).UnixNano())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(due).To(HaveLen(1))
+		Expect(due[0].ID).To(Equal("01SCHED_DUE0000000000000A"))
+
+		claimed, err := store.MarkSceneScheduleReminded(ctx, "01SCHED_DUE0000000000000A", 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(BeTrue())
+		claimed, err = store.MarkSceneScheduleReminded(ctx, "01SCHED_DUE0000000000000A", 24*time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(BeFalse(), "a lead is claimed once")
+		claimed, err = store.MarkSceneScheduleReminded(ctx, "01SCHED_DUE0000000000000A", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(BeTrue(), "a shorter lead is still due")
+
+		got, err := store.GetSceneSchedule(ctx, "01SCHED_DUE0000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*got.RemindedLead).To(Equal(time.Hour))
+	})
+
+	It("opens a schedule as a scene with the yes attendees as members", func() {
+		schedule("01SCHED_OPEN000000000000A", "char-owner", time.Now().Add(time.Minute))
+		rsvp("01SCHED_OPEN000000000000A", "char-a", RsvpYes)
+		rsvp("01SCHED_OPEN000000000000A", "char-b", RsvpMaybe)
+		rsvp("01SCHED_OPEN000000000000A", "char-owner", RsvpYes)
+
+		sched, err := store.OpenSceneSchedule(ctx, "01SCHED_OPEN000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sched.State).To(Equal(ScheduleStateOpened))
+
+		scene, err := store.Get(ctx, "01SCHED_OPEN000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(scene.OwnerID).To(Equal("char-owner"))
+		Expect(scene.State).To(Equal(string(SceneStateActive)))
+		Expect(scene.Visibility).To(Equal(string(SceneVisibilityOpen)))
+
+		parts, err := store.ListParticipants(ctx, "01SCHED_OPEN000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		roles := map[string]string{}
+		for _, p := range parts {
+			roles[p.CharacterID] = p.Role
+		}
+		Expect(roles).To(Equal(map[string]string{"char-owner": "owner", "char-a": "member"}))
+
+		_, err = store.OpenSceneSchedule(ctx, "01SCHED_OPEN000000000000A")
+		Expect(errCode(err)).To(Equal("SCENE_SCHEDULE_NOT_PENDING"))
+		claimed, err := store.MarkSceneScheduleReminded(ctx, "01SCHED_OPEN000000000000A", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claimed).To(BeFalse(), "an opened schedule takes no reminders")
+	})
+})
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// scheduledSceneStore is the narrow persistence interface the scheduled-scene
+// sweep needs, mirroring sceneTurnTimeoutStore for turnScheduler.
+type scheduledSceneStore interface {
+	// ListSceneSchedulesDue returns pending schedules starting at or before
+	// horizonNs (epoch-nanoseconds, Go-clock).
+	ListSceneSchedulesDue(ctx context.Context, horizonNs int64) ([]*SceneSchedule, error)
+}
+
+// scheduledSceneRunner sends reminders for and opens scheduled scenes.
+// *SceneServiceImpl satisfies it (schedule.go), which claims each reminder
+// and re-checks the schedule's state under the row lock before opening.
+type scheduledSceneRunner interface {
+	remindScheduledScene(ctx context.Context, sched *SceneSchedule, lead time.Duration) error
+	openScheduledScene(ctx context.Context, scheduleID string) error
+}
+
+// scheduledSceneScheduler periodically sweeps scheduled scenes: it opens those
+// whose start time has come and sends the reminder for each configured
+// lead time as it is reached. It mirrors turnScheduler: an injected now
+// func makes the sweep deterministically testable, and sweep is
+// package-private so tests can call it directly without waiting for ticks.
+// Unlike the turn scheduler it always runs — scenes must open even when
+// reminder_lead_times is empty.
+type scheduledSceneScheduler struct {
+	store    scheduledSceneStore
+	scenes   scheduledSceneRunner
+	interval time.Duration
+	leads    []time.Duration // longest first (parseReminderLeads)
+	now      func() time.Time
+}
+
+// Run starts the scheduler loop. It ticks at s.interval and calls sweep on each
+// tick. The loop exits when ctx is cancelled (plugin shutdown).
+func (s *scheduledSceneScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sweep(ctx); err != nil {
+				errutil.LogErrorContext(ctx, "scheduled scene sweep failed", err)
+			}
+		}
+	}
+}
+
+// sweep is one pass: open every schedule that has started and send any
+// reminder now due. Per-schedule failures are WARN-logged and the batch
+// continues; one bad row MUST NOT abort the sweep (idleScheduler precedent).
+func (s *scheduledSceneScheduler) sweep(ctx context.Context) error {
+	now := s.now()
+	horizon := now
+	if len(s.leads) > 0 {
+		horizon = now.Add(s.leads[0])
+	}
+	horizonNs := horizon.UnixNano() // pgnanos-exempt: scheduler clock — injected now() returns Go-clock time; result is passed as a parameter to SQL (noremoteclockcompare-compliant)
+
+	scheds, err := s.store.ListSceneSchedulesDue(ctx, horizonNs)
+	if err != nil {
+		return oops.Code("SCENE_SCHEDULE_SCHEDULER_SCAN_FAILED").Wrap(err)
+	}
+	for _, sched := range scheds {
+		if !now.Before(sched.StartsAt) {
+			if err := s.scenes.openScheduledScene(ctx, sched.ID); err != nil {
+				slog.WarnContext(ctx, "scheduled scene sweep: open failed",
+					"schedule_id", sched.ID, "err", err)
+			}
+			continue
+		}
+		lead, ok := sched.DueReminder(now, s.leads)
+		if !ok {
+			continue
+		}
+		if err := s.scenes.remindScheduledScene(ctx, sched, lead); err != nil {
+			slog.WarnContext(ctx, "scheduled scene sweep: reminder failed",
+				"schedule_id", sched.ID, "lead", lead.String(), "err", err)
+		}
+	}
+	return nil
+}
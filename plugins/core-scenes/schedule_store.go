@@ -0,0 +1,395 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+)
+
+// scheduleSelectColumns is the column list every SceneSchedule read shares.
+// The order MUST match scanSceneSchedule.
+const scheduleSelectColumns = `id, title, description, location_id, owner_id,
+    content_warnings, starts_at, state, reminded_lead_ns`
+
+// UpcomingQuery parameterizes ListUpcomingSceneSchedules.
+type UpcomingQuery struct {
+	// CharacterID is the requesting character; used by Attending.
+	CharacterID string
+	// Attending restricts results to schedules CharacterID owns or answered
+	// "yes" or "maybe" to.
+	Attending bool
+	// Limit is the page size; the service clamps it before the store sees it.
+	Limit int
+}
+
+// CreateSceneSchedule inserts a new scheduled scene with no RSVPs.
+func (s *SceneStore) CreateSceneSchedule(ctx context.Context, sched *SceneSchedule) error {
+	ctx, span := startSpan(ctx, "scene.store.create_scene_schedule", attribute.String("schedule_id", sched.ID))
+	defer span.End()
+
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO scene_schedules (
+			id, title, description, location_id, owner_id, content_warnings, starts_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		sched.ID, sched.Title, sched.Description, sched.LocationID, sched.OwnerID,
+		sched.ContentWarnings, pgnanos.From(sched.StartsAt))
+	if err != nil {
+		recordError(span, err)
+		return oops.Code("SCENE_SCHEDULE_CREATE_FAILED").With("schedule_id", sched.ID).Wrap(err)
+	}
+	return nil
+}
+
+// GetSceneSchedule loads a scheduled scene with its RSVPs, in any state.
+// Returns SCENE_SCHEDULE_NOT_FOUND when there is no such schedule.
+func (s *SceneStore) GetSceneSchedule(ctx context.Context, id string) (*SceneSchedule, error) {
+	ctx, span := startSpan(ctx, "scene.store.get_scene_schedule", attribute.String("schedule_id", id))
+	defer span.End()
+
+	sched, err := getSceneScheduleTx(ctx, s.pool, id, "")
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	return sched, nil
+}
+
+// SetSceneRsvp upserts characterID's answer to a scheduled scene and
+// returns the schedule with its RSVPs. The schedule row is locked so an
+// answer cannot slip in while the scene opens. Returns
+// SCENE_SCHEDULE_NOT_FOUND or SCENE_SCHEDULE_NOT_PENDING when the schedule
+// is missing or no longer scheduled.
+func (s *SceneStore) SetSceneRsvp(ctx context.Context, scheduleID, characterID string, response RsvpResponse) (*SceneSchedule, error) {
+	ctx, span := startSpan(
+		ctx, "scene.store.set_scene_rsvp",
+		attribute.String("schedule_id", scheduleID),
+		attribute.String("character_id", characterID),
+	)
+	defer span.End()
+
+	var sched *SceneSchedule
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		var err error
+		sched, err = getSceneScheduleTx(ctx, tx, scheduleID, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if sched.State != ScheduleStateScheduled {
+			return scheduleNotPending(scheduleID, sched.State)
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO scene_schedule_rsvps (schedule_id, character_id, response)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (schedule_id, character_id) DO UPDATE
+			  SET response = EXCLUDED.response,
+			      updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT`,
+			scheduleID, characterID, string(response)); err != nil {
+			return oops.Code("SCENE_SCHEDULE_RSVP_FAILED").With("schedule_id", scheduleID).Wrap(err)
+		}
+		sched.Rsvps, err = listSceneRsvpsTx(ctx, tx, scheduleID)
+		return err
+	})
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_SCHEDULE_RSVP_FAILED").With("schedule_id", scheduleID).Wrap(err)
+	}
+	return sched, nil
+}
+
+// CancelSceneSchedule moves a scheduled scene to 'cancelled'. Returns
+// SCENE_SCHEDULE_NOT_FOUND or SCENE_SCHEDULE_NOT_PENDING when the schedule
+// is missing or no longer scheduled. Ownership is the caller's check.
+func (s *SceneStore) CancelSceneSchedule(ctx context.Context, id string) error {
+	ctx, span := startSpan(ctx, "scene.store.cancel_scene_schedule", attribute.String("schedule_id", id))
+	defer span.End()
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE scene_schedules
+		SET state = 'cancelled', updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+		WHERE id = $1 AND state = 'scheduled'`, id)
+	if err != nil {
+		recordError(span, err)
+		return oops.Code("SCENE_SCHEDULE_CANCEL_FAILED").With("schedule_id", id).Wrap(err)
+	}
+	if tag.RowsAffected() == 0 {
+		// Classify the miss: gone entirely, or already opened/cancelled.
+		sched, err := getSceneScheduleTx(ctx, s.pool, id, "")
+		if err != nil {
+			recordError(span, err)
+			return err
+		}
+		return scheduleNotPending(id, sched.State)
+	}
+	return nil
+}
+
+// ListUpcomingSceneSchedules returns schedules still in 'scheduled',
+// soonest first (ties broken by ID), each with its RSVPs.
+func (s *SceneStore) ListUpcomingSceneSchedules(ctx context.Context, q UpcomingQuery) ([]*SceneSchedule, error) {
+	ctx, span := startSpan(
+		ctx, "scene.store.list_upcoming_scene_schedules",
+		attribute.Bool("attending", q.Attending),
+	)
+	defer span.End()
+
+	scheds, err := s.listSceneSchedules(ctx, `
+		SELECT `+scheduleSelectColumns+`
+		FROM scene_schedules
+		WHERE state = 'scheduled'
+		  AND (NOT $1 OR owner_id = $2 OR EXISTS (
+		    SELECT 1 FROM scene_schedule_rsvps r
+		    WHERE r.schedule_id = scene_schedules.id
+		      AND r.character_id = $2 AND r.response IN ('yes', 'maybe')
+		  ))
+		ORDER BY starts_at ASC, id ASC
+		LIMIT $3`, q.Attending, q.CharacterID, q.Limit)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_SCHEDULE_LIST_FAILED").Wrap(err)
+	}
+	return scheds, nil
+}
+
+// ListSceneSchedulesDue returns the schedules still in 'scheduled' that
+// start at or before horizonNs (epoch-nanoseconds, Go-clock): those due to
+// open, and those within reach of the longest reminder lead. horizonNs is a
+// query parameter (the pgnanos-exempt scheduler-clock seam, as
+// ListScenesIdlePastThreshold).
+func (s *SceneStore) ListSceneSchedulesDue(ctx context.Context, horizonNs int64) ([]*SceneSchedule, error) {
+	ctx, span := startSpan(ctx, "scene.store.list_scene_schedules_due")
+	defer span.End()
+
+	scheds, err := s.listSceneSchedules(ctx, `
+		SELECT `+scheduleSelectColumns+`
+		FROM scene_schedules
+		WHERE state = 'scheduled' AND starts_at <= $1
+		ORDER BY starts_at ASC, id ASC`, horizonNs)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_SCHEDULE_LIST_DUE_FAILED").Wrap(err)
+	}
+	return scheds, nil
+}
+
+// MarkSceneScheduleReminded records that the reminder for lead went out.
+// It claims the lead only if no equal or shorter lead was sent before and
+// the schedule is still pending, and reports whether it did: the caller
+// sends the reminder only on a successful claim.
+func (s *SceneStore) MarkSceneScheduleReminded(ctx context.Context, id string, lead time.Duration) (bool, error) {
+	ctx, span := startSpan(ctx, "scene.store.mark_scene_schedule_reminded", attribute.String("schedule_id", id))
+	defer span.End()
+
+	tag, err := s.pool.Exec(ctx, `
+		UPDATE scene_schedules
+		SET reminded_lead_ns = $2, updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+		WHERE id = $1 AND state = 'scheduled'
+		  AND (reminded_lead_ns IS NULL OR reminded_lead_ns > $2)`,
+		id, lead.Nanoseconds())
+	if err != nil {
+		recordError(span, err)
+		return false, oops.Code("SCENE_SCHEDULE_REMIND_FAILED").With("schedule_id", id).Wrap(err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// OpenSceneSchedule opens a scheduled scene in one transaction: it locks
+// the schedule, creates the scene under the schedule's ID exactly as
+// CreateWithOwner does, adds each "yes" attendee as a member (with a
+// membership.join ops event), and marks the schedule 'opened'. Returns the
+// opened schedule with its RSVPs, or SCENE_SCHEDULE_NOT_FOUND /
+// SCENE_SCHEDULE_NOT_PENDING when there is nothing to open.
+func (s *SceneStore) OpenSceneSchedule(ctx context.Context, id string) (*SceneSchedule, error) {
+	ctx, span := startSpan(ctx, "scene.store.open_scene_schedule", attribute.String("schedule_id", id))
+	defer span.End()
+
+	var sched *SceneSchedule
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		var err error
+		sched, err = getSceneScheduleTx(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if sched.State != ScheduleStateScheduled {
+			return scheduleNotPending(id, sched.State)
+		}
+		if sched.Rsvps, err = listSceneRsvpsTx(ctx, tx, id); err != nil {
+			return err
+		}
+
+		row := sched.sceneRow()
+		if err := insertSceneWithOwnerTx(ctx, tx, row, map[string]any{
+			"visibility":    row.Visibility,
+			"from_template": false,
+			"from_schedule": true,
+		}); err != nil {
+			return err
+		}
+		for _, characterID := range sched.Attendees() {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO scene_participants (scene_id, character_id, role, joined_at)
+				VALUES ($1, $2, 'member', (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT)
+				ON CONFLICT (scene_id, character_id) DO NOTHING`,
+				id, characterID); err != nil {
+				return oops.Code("SCENE_SCHEDULE_OPEN_FAILED").
+					With("schedule_id", id).With("character_id", characterID).Wrap(err)
+			}
+			payload := map[string]any{
+				"visibility":    row.Visibility,
+				"from_invited":  false,
+				"from_observer": false,
+				"from_schedule": true,
+			}
+			if err := recordOpsEventTx(ctx, tx, id, OpsKindMembershipJoin, characterID, characterID, payload); err != nil {
+				return oops.Code("SCENE_SCHEDULE_OPEN_FAILED").With("schedule_id", id).Wrap(err)
+			}
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE scene_schedules
+			SET state = 'opened', updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+			WHERE id = $1`, id); err != nil {
+			return oops.Code("SCENE_SCHEDULE_OPEN_FAILED").With("schedule_id", id).Wrap(err)
+		}
+		sched.State = ScheduleStateOpened
+		return nil
+	})
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_SCHEDULE_OPEN_FAILED").With("schedule_id", id).Wrap(err)
+	}
+	return sched, nil
+}
+
+// listSceneSchedules runs a schedule query and attaches every row's RSVPs
+// with one follow-up query.
+func (s *SceneStore) listSceneSchedules(ctx context.Context, sql string, args ...any) ([]*SceneSchedule, error) {
+	rows, err := s.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	scheds, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*SceneSchedule, error) {
+		return scanSceneSchedule(row)
+	})
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	if len(scheds) == 0 {
+		return scheds, nil
+	}
+
+	byID := make(map[string]*SceneSchedule, len(scheds))
+	ids := make([]string, 0, len(scheds))
+	for _, sched := range scheds {
+		byID[sched.ID] = sched
+		ids = append(ids, sched.ID)
+	}
+	rsvpRows, err := s.pool.Query(ctx, `
+		SELECT schedule_id, character_id, response
+		FROM scene_schedule_rsvps
+		WHERE schedule_id = ANY($1)
+		ORDER BY schedule_id, created_at, character_id`, ids)
+	if err != nil {
+		return nil, oops.Wrap(err)
+	}
+	defer rsvpRows.Close()
+	for rsvpRows.Next() {
+		var (
+			scheduleID string
+			r          SceneRsvp
+		)
+		if err := rsvpRows.Scan(&scheduleID, &r.CharacterID, &r.Response); err != nil {
+			return nil, oops.Wrap(err)
+		}
+		byID[scheduleID].Rsvps = append(byID[scheduleID].Rsvps, r)
+	}
+	if err := rsvpRows.Err(); err != nil {
+		return nil, oops.Wrap(err)
+	}
+	return scheds, nil
+}
+
+// scheduleQuerier is the pgx surface getSceneScheduleTx and
+// listSceneRsvpsTx need, satisfied by both the pool and a transaction.
+type scheduleQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// getSceneScheduleTx loads one schedule with its RSVPs; lock is appended to
+// the schedule SELECT ("" or "FOR UPDATE"). Returns SCENE_SCHEDULE_NOT_FOUND
+// when the row does not exist.
+func getSceneScheduleTx(ctx context.Context, q scheduleQuerier, id, lock string) (*SceneSchedule, error) {
+	sched, err := scanSceneSchedule(q.QueryRow(ctx, `
+		SELECT `+scheduleSelectColumns+`
+		FROM scene_schedules WHERE id = $1 `+lock, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.Code("SCENE_SCHEDULE_NOT_FOUND").With("schedule_id", id).Errorf("scheduled scene not found")
+		}
+		return nil, oops.Code("SCENE_SCHEDULE_GET_FAILED").With("schedule_id", id).Wrap(err)
+	}
+	if sched.Rsvps, err = listSceneRsvpsTx(ctx, q, id); err != nil {
+		return nil, err
+	}
+	return sched, nil
+}
+
+// listSceneRsvpsTx returns a schedule's RSVPs in the order they were first
+// given.
+func listSceneRsvpsTx(ctx context.Context, q scheduleQuerier, scheduleID string) ([]SceneRsvp, error) {
+	rows, err := q.Query(ctx, `
+		SELECT character_id, response
+		FROM scene_schedule_rsvps
+		WHERE schedule_id = $1
+		ORDER BY created_at, character_id`, scheduleID)
+	if err != nil {
+		return nil, oops.Code("SCENE_SCHEDULE_RSVP_LIST_FAILED").With("schedule_id", scheduleID).Wrap(err)
+	}
+	rsvps, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (SceneRsvp, error) {
+		var r SceneRsvp
+		err := row.Scan(&r.CharacterID, &r.Response)
+		return r, err
+	})
+	if err != nil {
+		return nil, oops.Code("SCENE_SCHEDULE_RSVP_LIST_FAILED").With("schedule_id", scheduleID).Wrap(err)
+	}
+	return rsvps, nil
+}
+
+// scheduleNotPending is the SCENE_SCHEDULE_NOT_PENDING error for a schedule
+// that has already opened or been cancelled.
+func scheduleNotPending(id string, state ScheduleState) error {
+	return oops.Code("SCENE_SCHEDULE_NOT_PENDING").
+		With("schedule_id", id).With("state", string(state)).
+		Errorf("scheduled scene is %s", state)
+}
+
+// scanSceneSchedule scans one schedule row in scheduleSelectColumns order.
+// Callers wrap the error with an operation-specific code.
+//
+//nolint:wrapcheck // caller wraps with operation-specific oops code
+func scanSceneSchedule(row pgx.Row) (*SceneSchedule, error) {
+	var (
+		sched    SceneSchedule
+		startsAt pgnanos.Time
+		leadNs   *int64
+	)
+	if err := row.Scan(&sched.ID, &sched.Title, &sched.Description, &sched.LocationID,
+		&sched.OwnerID, &sched.ContentWarnings, &startsAt, &sched.State, &leadNs); err != nil {
+		return nil, err
+	}
+	sched.StartsAt = startsAt.Time()
+	if leadNs != nil {
+		lead := time.Duration(*leadNs)
+		sched.RemindedLead = &lead
+	}
+	return &sched, nil
+}
@@ -0,0 +1,466 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+func scheduleAt(startsAt time.Time, rsvps ...SceneRsvp) *SceneSchedule {
+	return &SceneSchedule{
+		ID: "sched-1", Title: "Harbour Ball", OwnerID: "char-owner",
+		StartsAt: startsAt, State: ScheduleStateScheduled, Rsvps: rsvps,
+	}
+}
+
+func TestSceneScheduleDueReminder(t *testing.T) {
+	start := time.Unix(1_000_000, 0)
+	leads := []time.Duration{24 * time.Hour, time.Hour}
+	hour := time.Hour
+	day := 24 * time.Hour
+	tests := []struct {
+		name     string
+		now      time.Time
+		reminded *time.Duration
+		want     time.Duration
+		wantOK   bool
+	}{
+		{name: "before every lead", now: start.Add(-25 * time.Hour)},
+		{name: "longest lead reached", now: start.Add(-23 * time.Hour), want: day, wantOK: true},
+		{name: "longest lead already sent", now: start.Add(-23 * time.Hour), reminded: &day},
+		{name: "shorter lead after the longer", now: start.Add(-30 * time.Minute), reminded: &day, want: time.Hour, wantOK: true},
+		{name: "only the shortest reached lead fires", now: start.Add(-30 * time.Minute), want: time.Hour, wantOK: true},
+		{name: "shortest lead already sent", now: start.Add(-time.Minute), reminded: &hour},
+		{name: "started scenes open instead", now: start},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched := scheduleAt(start)
+			sched.RemindedLead = tt.reminded
+			got, ok := sched.DueReminder(tt.now, leads)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, ok := scheduleAt(start).DueReminder(start.Add(-time.Minute), nil)
+	assert.False(t, ok, "no leads configured means no reminders")
+}
+
+func TestSceneScheduleAttendeesAndNotified(t *testing.T) {
+	sched := scheduleAt(time.Unix(0, 0),
+		SceneRsvp{CharacterID: "char-bob", Response: RsvpMaybe},
+		SceneRsvp{CharacterID: "char-alice", Response: RsvpYes},
+		SceneRsvp{CharacterID: "char-owner", Response: RsvpYes},
+		SceneRsvp{CharacterID: "char-carol", Response: RsvpNo},
+	)
+
+	assert.Equal(t, []string{"char-alice"}, sched.Attendees(), "only yes answers join; the owner joins as owner")
+	assert.Equal(t, []string{"char-owner", "char-bob", "char-alice"}, sched.Notified())
+	assert.Equal(t, RsvpNo, sched.Response("char-carol"))
+	assert.Empty(t, sched.Response("char-dave"))
+}
+
+func TestParseReminderLeads(t *testing.T) {
+	leads, err := parseReminderLeads(" 1h,24h,,1h, 15m")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{24 * time.Hour, time.Hour, 15 * time.Minute}, leads)
+
+	leads, err = parseReminderLeads("")
+	require.NoError(t, err)
+	assert.Empty(t, leads)
+
+	_, err = parseReminderLeads("1h,tomorrow")
+	require.Error(t, err)
+	_, err = parseReminderLeads("0s")
+	require.Error(t, err)
+}
+
+// newScheduleTestService returns a service over an empty fake store.
+func newScheduleTestService(t *testing.T) (*SceneServiceImpl, *fakeStore, *recordingEventSink) {
+	t.Helper()
+	store := newFakeStore()
+	sink := &recordingEventSink{}
+	svc := newTestService(t, store)
+	svc.SetEventSink(sink)
+	return svc, store, sink
+}
+
+func scheduleScene(t *testing.T, svc *SceneServiceImpl, owner, title string, in time.Duration) *scenev1.ScheduledScene {
+	t.Helper()
+	resp, err := svc.ScheduleScene(context.Background(), &scenev1.ScheduleSceneRequest{
+		CharacterId: owner, Title: title, StartsAt: timestamppb.New(time.Now().Add(in)),
+	})
+	require.NoError(t, err)
+	return resp.GetScene()
+}
+
+func TestScheduleSceneStoresAPendingSchedule(t *testing.T) {
+	svc, store, _ := newScheduleTestService(t)
+
+	resp, err := svc.ScheduleScene(context.Background(), &scenev1.ScheduleSceneRequest{
+		CharacterId: "char-owner", Title: "  Harbour Ball  ", LocationId: "loc-1",
+		StartsAt: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	require.NoError(t, err)
+	sc := resp.GetScene()
+	assert.Equal(t, "Harbour Ball", sc.GetTitle())
+	assert.Equal(t, "loc-1", sc.GetLocationId())
+	assert.Equal(t, string(ScheduleStateScheduled), sc.GetState())
+
+	stored := store.schedules[sc.GetId()]
+	require.NotNil(t, stored)
+	assert.Equal(t, "char-owner", stored.OwnerID)
+	assert.NotNil(t, stored.ContentWarnings, "content_warnings is NOT NULL")
+}
+
+func TestScheduleSceneValidation(t *testing.T) {
+	svc, _, _ := newScheduleTestService(t)
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	tests := []struct {
+		name string
+		req  *scenev1.ScheduleSceneRequest
+	}{
+		{name: "blank title", req: &scenev1.ScheduleSceneRequest{CharacterId: "char-owner", Title: "   ", StartsAt: future}},
+		{name: "no start", req: &scenev1.ScheduleSceneRequest{CharacterId: "char-owner", Title: "Ball"}},
+		{name: "start in the past", req: &scenev1.ScheduleSceneRequest{
+			CharacterId: "char-owner", Title: "Ball", StartsAt: timestamppb.New(time.Now().Add(-time.Minute)),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ScheduleScene(context.Background(), tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestScheduleSceneStoreFailureIsInternal(t *testing.T) {
+	svc, store, _ := newScheduleTestService(t)
+	store.scheduleErr = errors.New("connection reset")
+
+	_, err := svc.ScheduleScene(context.Background(), &scenev1.ScheduleSceneRequest{
+		CharacterId: "char-owner", Title: "Ball", StartsAt: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRsvpScheduledScene(t *testing.T) {
+	svc, store, _ := newScheduleTestService(t)
+	sc := scheduleScene(t, svc, "char-owner", "Ball", time.Hour)
+	rsvp := func(char, response string) (*scenev1.ScheduledScene, error) {
+		t.Helper()
+		resp, err := svc.RsvpScheduledScene(context.Background(), &scenev1.RsvpScheduledSceneRequest{
+			CharacterId: char, ScheduleId: sc.GetId(), Response: response,
+		})
+		return resp.GetScene(), err
+	}
+
+	_, err := rsvp("char-alice", "yes")
+	require.NoError(t, err)
+	got, err := rsvp("char-bob", "maybe")
+	require.NoError(t, err)
+	require.Len(t, got.GetRsvps(), 2)
+	got, err = rsvp("char-alice", "no")
+	require.NoError(t, err)
+	assert.Equal(t, "char-alice", got.GetRsvps()[0].GetCharacterId(), "a changed answer keeps its place")
+	assert.Equal(t, "no", got.GetRsvps()[0].GetResponse())
+
+	_, err = rsvp("char-alice", "perhaps")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	store.schedules[sc.GetId()].State = ScheduleStateOpened
+	_, err = rsvp("char-alice", "yes")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = svc.RsvpScheduledScene(context.Background(), &scenev1.RsvpScheduledSceneRequest{
+		CharacterId: "char-alice", ScheduleId: "missing", Response: "yes",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelScheduledSceneIsOwnerOnly(t *testing.T) {
+	svc, store, _ := newScheduleTestService(t)
+	sc := scheduleScene(t, svc, "char-owner", "Ball", time.Hour)
+	cancel := func(char string) error {
+		t.Helper()
+		_, err := svc.CancelScheduledScene(context.Background(), &scenev1.CancelScheduledSceneRequest{
+			CharacterId: char, ScheduleId: sc.GetId(),
+		})
+		return err
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(cancel("char-alice")))
+	require.NoError(t, cancel("char-owner"))
+	assert.Equal(t, ScheduleStateCancelled, store.schedules[sc.GetId()].State)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(cancel("char-owner")), "already cancelled")
+}
+
+func TestListUpcomingScenes(t *testing.T) {
+	svc, store, _ := newScheduleTestService(t)
+	later := scheduleScene(t, svc, "char-owner", "Later", 2*time.Hour)
+	sooner := scheduleScene(t, svc, "char-other", "Sooner", time.Hour)
+	cancelled := scheduleScene(t, svc, "char-owner", "Off", 30*time.Minute)
+	store.schedules[cancelled.GetId()].State = ScheduleStateCancelled
+
+	resp, err := svc.ListUpcomingScenes(context.Background(), &scenev1.ListUpcomingScenesRequest{CharacterId: "char-alice"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetScenes(), 2)
+	assert.Equal(t, sooner.GetId(), resp.GetScenes()[0].GetId(), "soonest first")
+	assert.Equal(t, later.GetId(), resp.GetScenes()[1].GetId())
+	assert.Equal(t, defaultUpcomingLimit, store.upcomingGot.Limit)
+
+	resp, err = svc.ListUpcomingScenes(context.Background(), &scenev1.ListUpcomingScenesRequest{
+		CharacterId: "char-owner", Attending: true, Limit: 500,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetScenes(), 1)
+	assert.Equal(t, later.GetId(), resp.GetScenes()[0].GetId())
+	assert.Equal(t, maxUpcomingLimit, store.upcomingGot.Limit, "oversized limits are clamped")
+
+	store.scheduleErr = errors.New("connection reset")
+	_, err = svc.ListUpcomingScenes(context.Background(), &scenev1.ListUpcomingScenesRequest{CharacterId: "char-alice"})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestRemindScheduledSceneNotifiesOncePerLead(t *testing.T) {
+	svc, store, sink := newScheduleTestService(t)
+	sched := scheduleAt(time.Now().Add(time.Hour),
+		SceneRsvp{CharacterID: "char-alice", Response: RsvpYes},
+		SceneRsvp{CharacterID: "char-bob", Response: RsvpNo},
+	)
+	store.schedules = map[string]*SceneSchedule{sched.ID: cloneSceneSchedule(sched)}
+
+	require.NoError(t, svc.remindScheduledScene(context.Background(), sched, time.Hour))
+	require.Len(t, sink.intents, 2, "the owner and the yes answer; not the no")
+	assert.Equal(t, dotStyleCharacterSubject("main", "char-owner"), sink.intents[0].Subject)
+	assert.Equal(t, dotStyleCharacterSubject("main", "char-alice"), sink.intents[1].Subject)
+	assert.Equal(t, pluginsdk.EventType("core-scenes:scene_schedule_reminder"), sink.intents[0].Type)
+	assert.False(t, sink.intents[0].Sensitive, "scene_schedule_reminder is sensitivity:never")
+	assert.Contains(t, sink.intents[0].Payload, `"lead_secs":3600`)
+	assert.Contains(t, sink.intents[0].Payload, `"schedule_id":"sched-1"`)
+
+	require.NoError(t, svc.remindScheduledScene(context.Background(), sched, time.Hour))
+	assert.Len(t, sink.intents, 2, "a lead already claimed is not sent again")
+
+	store.scheduleErr = errors.New("connection reset")
+	errutil.AssertErrorCode(t, svc.remindScheduledScene(context.Background(), sched, time.Minute), "SCENE_SCHEDULE_REMIND_FAILED")
+}
+
+func TestOpenScheduledSceneAddsAttendeesAndNotifies(t *testing.T) {
+	svc, store, sink := newScheduleTestService(t)
+	sched := scheduleAt(time.Now(),
+		SceneRsvp{CharacterID: "char-alice", Response: RsvpYes},
+		SceneRsvp{CharacterID: "char-bob", Response: RsvpMaybe},
+	)
+	store.schedules = map[string]*SceneSchedule{sched.ID: sched}
+
+	require.NoError(t, svc.openScheduledScene(context.Background(), sched.ID))
+	require.Contains(t, store.scenes, sched.ID, "the scene opens under the schedule's ID")
+	assert.Equal(t, string(SceneVisibilityOpen), store.scenes[sched.ID].Visibility)
+	assert.Equal(t, map[string]string{"char-owner": "owner", "char-alice": "member"}, store.participants[sched.ID])
+	assert.Equal(t, ScheduleStateOpened, store.schedules[sched.ID].State)
+
+	created := findIntentByType(sink.intents, string(pluginsdk.HostEventTypeSystem))
+	require.NotNil(t, created)
+	assert.Contains(t, created.Payload, "scene.lifecycle.created")
+	join := findIntentByType(sink.intents, "core-scenes:scene_join_ic")
+	require.NotNil(t, join)
+	assert.Contains(t, join.Payload, "char-alice")
+	var opened []string
+	for _, in := range sink.intents {
+		if in.Type == "core-scenes:scene_schedule_opened" {
+			opened = append(opened, in.Subject)
+		}
+	}
+	assert.Equal(t, []string{
+		dotStyleCharacterSubject("main", "char-owner"),
+		dotStyleCharacterSubject("main", "char-alice"),
+		dotStyleCharacterSubject("main", "char-bob"),
+	}, opened)
+
+	sink.intents = nil
+	require.NoError(t, svc.openScheduledScene(context.Background(), sched.ID), "opening twice is a no-op")
+	assert.Empty(t, sink.intents)
+
+	errutil.AssertErrorCode(t, svc.openScheduledScene(context.Background(), "missing"), "SCENE_SCHEDULE_NOT_FOUND")
+}
+
+// recordingScheduledSceneRunner records the sweep's calls and fails the
+// schedule IDs in failFor.
+type recordingScheduledSceneRunner struct {
+	opened   []string
+	reminded map[string]time.Duration
+	failFor  map[string]bool
+}
+
+func (r *recordingScheduledSceneRunner) remindScheduledScene(_ context.Context, sched *SceneSchedule, lead time.Duration) error {
+	if r.reminded == nil {
+		r.reminded = make(map[string]time.Duration)
+	}
+	r.reminded[sched.ID] = lead
+	if r.failFor[sched.ID] {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (r *recordingScheduledSceneRunner) openScheduledScene(_ context.Context, scheduleID string) error {
+	r.opened = append(r.opened, scheduleID)
+	if r.failFor[scheduleID] {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+type fakeScheduledSceneStore struct {
+	scheds       []*SceneSchedule
+	err          error
+	gotHorizonNs int64
+}
+
+func (f *fakeScheduledSceneStore) ListSceneSchedulesDue(_ context.Context, horizonNs int64) ([]*SceneSchedule, error) {
+	f.gotHorizonNs = horizonNs
+	return f.scheds, f.err
+}
+
+func TestScheduledSceneSchedulerSweepOpensAndReminds(t *testing.T) {
+	now := time.Unix(500_000, 0)
+	started := scheduleAt(now.Add(-time.Second))
+	started.ID = "s-started"
+	broken := scheduleAt(now)
+	broken.ID = "s-broken"
+	soon := scheduleAt(now.Add(30 * time.Minute))
+	soon.ID = "s-soon"
+	notYet := scheduleAt(now.Add(3 * time.Hour))
+	notYet.ID = "s-not-yet"
+
+	store := &fakeScheduledSceneStore{scheds: []*SceneSchedule{started, broken, soon, notYet}}
+	runner := &recordingScheduledSceneRunner{failFor: map[string]bool{"s-broken": true}}
+	s := &scheduledSceneScheduler{
+		store: store, scenes: runner,
+		leads: []time.Duration{24 * time.Hour, time.Hour}, now: fixedNow(now),
+	}
+
+	require.NoError(t, s.sweep(context.Background()))
+	assert.Equal(t, []string{"s-started", "s-broken"}, runner.opened, "a failed open does not stop the sweep")
+	assert.Equal(t, map[string]time.Duration{"s-soon": time.Hour, "s-not-yet": 24 * time.Hour}, runner.reminded)
+	assert.Equal(t, now.Add(24*time.Hour).UnixNano(), store.gotHorizonNs, "the horizon is the longest lead")
+}
+
+func TestScheduledSceneSchedulerSweepWithoutLeadsOnlyOpens(t *testing.T) {
+	now := time.Unix(500_000, 0)
+	store := &fakeScheduledSceneStore{scheds: []*SceneSchedule{scheduleAt(now)}}
+	runner := &recordingScheduledSceneRunner{}
+	s := &scheduledSceneScheduler{store: store, scenes: runner, now: fixedNow(now)}
+
+	require.NoError(t, s.sweep(context.Background()))
+	assert.Equal(t, []string{"sched-1"}, runner.opened)
+	assert.Equal(t, now.UnixNano(), store.gotHorizonNs)
+}
+
+func TestScheduledSceneSchedulerSweepScanFailure(t *testing.T) {
+	s := &scheduledSceneScheduler{
+		store:  &fakeScheduledSceneStore{err: errors.New("connection reset")},
+		scenes: &recordingScheduledSceneRunner{},
+		now:    time.Now,
+	}
+	errutil.AssertErrorCode(t, s.sweep(context.Background()), "SCENE_SCHEDULE_SCHEDULER_SCAN_FAILED")
+}
+
+func TestParseScheduleStart(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	got, err := parseScheduleStart(" 26h ", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(26*time.Hour), got)
+
+	got, err = parseScheduleStart("2026-11-01T19:00:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 11, 1, 19, 0, 0, 0, time.UTC), got)
+
+	_, err = parseScheduleStart("-1h", now)
+	require.Error(t, err)
+	_, err = parseScheduleStart("next tuesday", now)
+	require.Error(t, err)
+}
+
+func TestRenderUpcomingScenes(t *testing.T) {
+	assert.Contains(t, renderUpcomingScenes("char-alice", nil), "No upcoming scenes")
+
+	out := renderUpcomingScenes("char-alice", []*scenev1.ScheduledScene{
+		{
+			Id: "s1", Title: "Ball", OwnerId: "char-owner",
+			StartsAt:        timestamppb.New(time.Date(2026, 11, 1, 19, 0, 0, 0, time.UTC)),
+			ContentWarnings: []string{"violence"},
+			Rsvps: []*scenev1.SceneRsvp{
+				{CharacterId: "char-alice", Response: "maybe"},
+				{CharacterId: "char-bob", Response: "yes"},
+			},
+		},
+		{Id: "s2", Title: "Mine", OwnerId: "char-alice", StartsAt: timestamppb.New(time.Date(2026, 11, 2, 19, 0, 0, 0, time.UTC))},
+	})
+	assert.Contains(t, out, "Upcoming scenes (2):")
+	assert.Contains(t, out, "s1 — Ball, Sun Nov 1 19:00 UTC (owner: char-owner, 1 going) [you: maybe] [CW: violence]")
+	assert.Contains(t, out, "s2 — Mine, Mon Nov 2 19:00 UTC (owner: char-alice, 0 going) [yours]")
+}
+
+func TestSceneScheduleCommands(t *testing.T) {
+	p := newTestPlugin(t)
+	ctx := context.Background()
+	run := func(char, args string) *pluginsdk.CommandResponse {
+		t.Helper()
+		resp, err := p.dispatchCommand(ctx, pluginsdk.CommandRequest{Command: "scene", Args: args, CharacterID: char})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		return resp
+	}
+
+	resp := run("char-owner", "schedule 26h")
+	assert.Equal(t, pluginsdk.CommandError, resp.Status)
+	assert.Contains(t, resp.Output, "Usage: scene schedule")
+
+	resp = run("char-owner", "schedule 26h=Harbour Ball")
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Contains(t, resp.Output, "Scene scheduled:")
+	store := p.service.store.(*fakeStore)
+	require.Len(t, store.schedules, 1)
+	var id string
+	for k := range store.schedules {
+		id = k
+	}
+
+	resp = run("char-alice", "rsvp #"+id)
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	assert.Contains(t, resp.Output, "RSVP yes for "+id)
+	resp = run("char-bob", "rsvp "+id+" later")
+	assert.Contains(t, resp.Output, "Usage: scene rsvp")
+
+	resp = run("char-alice", "upcoming mine")
+	assert.Contains(t, resp.Output, "Harbour Ball")
+	assert.Contains(t, resp.Output, "[you: yes]")
+	resp = run("char-bob", "upcoming mine")
+	assert.Contains(t, resp.Output, "No upcoming scenes")
+
+	resp = run("char-alice", "unschedule "+id)
+	assert.Equal(t, pluginsdk.CommandError, resp.Status)
+	assert.Contains(t, resp.Output, "Cannot unschedule")
+	resp = run("char-owner", "unschedule "+id)
+	require.Equal(t, pluginsdk.CommandOK, resp.Status, resp.Output)
+	resp = run("char-alice", "rsvp "+id+" no")
+	assert.Contains(t, resp.Output, "Cannot rsvp")
+}
@@ -55,7 +55,13 @@ type sceneStorer interface {
 	Update(ctx context.Context, id string, update *SceneUpdate) (*SceneRow, error)
 	AddParticipant(ctx context.Context, sceneID, characterID string) (*ParticipantRow, ParticipantOpResult, error)
 	RemoveParticipant(ctx context.Context, sceneID, characterID string) (*ParticipantRow, error)
-	InviteParticipant(ctx context.Context, sceneID, inviterID, targetID string) (*ParticipantRow, error)
+	InviteParticipant(ctx context.Context, sceneID, inviterID, targetID string, expiresAt *time.Time) (*SceneInvitation, error)
+	// Scene invitations (invitations.go). GetInvitation and
+	// DeclineInvitation fail with SCENE_INVITE_NOT_FOUND when there is no
+	// invitation and SCENE_INVITE_EXPIRED when it has lapsed.
+	GetInvitation(ctx context.Context, sceneID, characterID string) (*SceneInvitation, error)
+	ListInvitations(ctx context.Context, characterID string) ([]*SceneInvitation, error)
+	DeclineInvitation(ctx context.Context, sceneID, characterID string) (*SceneInvitation, error)
 	KickParticipant(ctx context.Context, sceneID, kickerID, targetID string) (*ParticipantRow, error)
 	TransferOwnership(ctx context.Context, sceneID, currentOwnerID, newOwnerID string) error
 	ListParticipants(ctx context.Context, sceneID string) ([]ParticipantRow, error)
@@ -136,6 +142,31 @@ type sceneStorer interface {
 	// ListMutedScenes returns the scene ids the character has muted. Backs
 	// ListMutedScenes and the ListCharacterScenes read-back (Plan 03).
 	ListMutedScenes(ctx context.Context, characterID string) ([]string, error)
+
+	// GetSceneTurns returns the scene's turn tracker, or (nil, nil) when it
+	// has none. Backs GetSceneTurns and the pose auto-advance pre-check.
+	GetSceneTurns(ctx context.Context, sceneID string) (*TurnQueue, error)
+	// UpdateSceneTurns locks (or creates) the scene's turn tracker, applies
+	// mutate, and persists the result in one transaction. mutate's error
+	// aborts the write and is returned unwrapped.
+	UpdateSceneTurns(ctx context.Context, sceneID string, now time.Time, mutate func(*TurnQueue) error) (*TurnQueue, error)
+
+	// Scheduled scenes (schedule.go). Implemented by *SceneStore in
+	// schedule_store.go; lookups fail with SCENE_SCHEDULE_NOT_FOUND and
+	// writes to a schedule that has opened or been cancelled with
+	// SCENE_SCHEDULE_NOT_PENDING.
+	CreateSceneSchedule(ctx context.Context, sched *SceneSchedule) error
+	GetSceneSchedule(ctx context.Context, id string) (*SceneSchedule, error)
+	SetSceneRsvp(ctx context.Context, scheduleID, characterID string, response RsvpResponse) (*SceneSchedule, error)
+	CancelSceneSchedule(ctx context.Context, id string) error
+	ListUpcomingSceneSchedules(ctx context.Context, q UpcomingQuery) ([]*SceneSchedule, error)
+	// MarkSceneScheduleReminded claims the reminder for lead; false means
+	// it (or a shorter lead) was already sent.
+	MarkSceneScheduleReminded(ctx context.Context, id string, lead time.Duration) (bool, error)
+	// OpenSceneSchedule creates the scene under the schedule's ID with the
+	// "yes" attendees as members and marks the schedule opened, atomically.
+	OpenSceneSchedule(ctx context.Context, id string) (*SceneSchedule, error)
+
 	// ListPublishedScenes returns PUBLISHED archive summaries newest first,
 	// with optional tag filtering and LIMIT/OFFSET paging.
 	ListPublishedScenes(ctx context.Context, q ListPublishedScenesQuery) ([]PublishedSceneArchiveSummary, error)
@@ -148,6 +179,11 @@ type sceneStorer interface {
 	// exportLogMaxRows rows; returns SCENE_EXPORT_TOO_LARGE (FailedPrecondition)
 	// when the log exceeds that ceiling rather than silently truncating.
 	ReadSceneLogForExport(ctx context.Context, fullSubject string) ([]LogRow, error)
+	// ReadSceneOOCLogForExport reads a scene's scene_ooc rows in
+	// chronological order for ExportTranscript. fullSubject is the complete
+	// OOC subject (events.<game_id>.scene.<scene_id>.ooc); the same
+	// exportLogMaxRows ceiling applies.
+	ReadSceneOOCLogForExport(ctx context.Context, fullSubject string) ([]LogRow, error)
 
 	// ── C7 snapshot pipeline (COOLOFF→PUBLISHED) ──────────────────────────
 	// SnapshotPool exposes the connection pool so runSnapshot can orchestrate
@@ -417,12 +453,19 @@ func (s *SceneServiceImpl) sceneCreatedIntent(row *SceneRow) (pluginsdk.EmitInte
 		return pluginsdk.EmitIntent{}, nil
 	}
 
-	payload, err := json.Marshal(map[string]string{
+	fields := map[string]any{
 		"kind":     "scene.lifecycle.created",
 		"scene_id": row.ID,
 		"owner_id": row.OwnerID,
 		"title":    row.Title,
-	})
+	}
+	// A private scene asks the host to encrypt it at rest. The creation
+	// notice is the scene's first event, so the request precedes every
+	// pose, notice, and roster change on it.
+	if row.Visibility == string(SceneVisibilityPrivate) {
+		fields[pluginsdk.PayloadKeyEncryptedAtRest] = true
+	}
+	payload, err := json.Marshal(fields)
 	if err != nil {
 		return pluginsdk.EmitIntent{}, oops.Code("SCENE_EVENT_PAYLOAD_MARSHAL_FAILED").
 			With("scene_id", row.ID).
@@ -1300,6 +1343,9 @@ func (s *SceneServiceImpl) JoinScene(ctx context.Context, req *scenev1.JoinScene
 			case "SCENE_JOIN_NOT_INVITED":
 				return nil, status.Errorf(codes.PermissionDenied,
 					"character not invited to private scene")
+			case "SCENE_INVITE_EXPIRED":
+				return nil, status.Errorf(codes.PermissionDenied,
+					"invitation to private scene has expired")
 			}
 		}
 		slog.WarnContext(
@@ -1689,6 +1735,7 @@ func (s *SceneServiceImpl) LeaveScene(ctx context.Context, req *scenev1.LeaveSce
 	// Auto-emit scene_leave_ic notice event. Non-fatal: membership is already
 	// removed; the notice is best-effort.
 	s.emitSceneLeaveIC(ctx, req.GetSceneId(), req.GetCharacterId(), "left", "")
+	s.dropFromTurnQueue(ctx, req.GetSceneId(), req.GetCharacterId())
 
 	slog.InfoContext(
 		ctx, "scene.service.leave_scene ok",
@@ -1699,7 +1746,9 @@ func (s *SceneServiceImpl) LeaveScene(ctx context.Context, req *scenev1.LeaveSce
 	return &scenev1.LeaveSceneResponse{}, nil
 }
 
-// InviteToScene adds an 'invited' participant row for the target character.
+// InviteToScene issues an invitation to the target character: an 'invited'
+// participant row that lapses after the configured invite_ttl. The invitee
+// is sent a scene_invitation notice and joins only by accepting it.
 // ABAC enforces participant-wide invite (any owner or member of an
 // active/paused scene) — self-gated at this handler and at the dispatcher.
 func (s *SceneServiceImpl) InviteToScene(ctx context.Context, req *scenev1.InviteToSceneRequest) (*scenev1.InviteToSceneResponse, error) {
@@ -1741,7 +1790,8 @@ func (s *SceneServiceImpl) InviteToScene(ctx context.Context, req *scenev1.Invit
 		return nil, status.Error(codes.PermissionDenied, "not permitted to invite to this scene") //nolint:wrapcheck // gRPC status is the wire contract
 	}
 
-	if _, err := s.store.InviteParticipant(ctx, req.GetSceneId(), req.GetCharacterId(), req.GetTargetCharacterId()); err != nil {
+	inv, err := s.store.InviteParticipant(ctx, req.GetSceneId(), req.GetCharacterId(), req.GetTargetCharacterId(), s.inviteExpiry(time.Now()))
+	if err != nil {
 		recordError(span, err)
 		var oe oops.OopsError
 		if errors.As(err, &oe) && oe.Code() == "SCENE_INVITE_TARGET_ALREADY_MEMBER" {
@@ -1756,6 +1806,11 @@ func (s *SceneServiceImpl) InviteToScene(ctx context.Context, req *scenev1.Invit
 		)
 		return nil, status.Errorf(codes.Internal, "internal error")
 	}
+	// Tell the invitee — once per invitation, so re-inviting a character
+	// whose invitation is still pending does not repeat the notice.
+	if !inv.Existing {
+		s.emitInvitationNotice(ctx, inv.CharacterID, "core-scenes:scene_invitation", inv)
+	}
 
 	slog.InfoContext(
 		ctx, "scene.service.invite_to_scene ok",
@@ -1833,6 +1888,7 @@ func (s *SceneServiceImpl) KickFromScene(ctx context.Context, req *scenev1.KickF
 	// Auto-emit scene_leave_ic notice event (reason=kicked). Non-fatal:
 	// membership is already removed; the notice is best-effort.
 	s.emitSceneLeaveIC(ctx, req.GetSceneId(), req.GetTargetCharacterId(), "kicked", req.GetCharacterId())
+	s.dropFromTurnQueue(ctx, req.GetSceneId(), req.GetTargetCharacterId())
 
 	slog.InfoContext(
 		ctx, "scene.service.kick_from_scene ok",
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -71,8 +72,24 @@ type fakeStore struct {
 	exportLogRows    []LogRow
 	exportLogErr     error
 	exportLogSubject string // records the fullSubject passed by the service
+	// ReadSceneOOCLogForExport control fields (transcript export suite).
+	exportOOCRows    []LogRow
+	exportOOCErr     error
+	exportOOCSubject string // records the fullSubject passed by the service
 	// ListSceneAttempts control field (publish-pointer best-effort path).
 	listSceneAttemptsErr error
+	// Turn tracker state and control field (turns suite).
+	turns    map[string]*TurnQueue // sceneID → tracker; nil map means no trackers
+	turnsErr error                 // forces GetSceneTurns / UpdateSceneTurns to fail
+	// Scheduled-scene state and control fields (schedule suite).
+	schedules     map[string]*SceneSchedule // scheduleID → schedule; nil map means none
+	scheduleErr   error                     // forces every schedule method to fail
+	upcomingGot   *UpcomingQuery            // records the last ListUpcomingSceneSchedules query
+	openedScenes  []string                  // records OpenSceneSchedule calls that opened
+	remindedLeads []time.Duration           // records successful MarkSceneScheduleReminded claims
+	// Invitation metadata (invitations suite), keyed sceneID → characterID;
+	// the role='invited' row itself lives in participants.
+	invitations map[string]map[string]*SceneInvitation
 }
 
 type recordingEventSink struct {
@@ -387,6 +404,11 @@ func (f *fakeStore) AddParticipant(_ context.Context, sceneID, characterID strin
 	existing, exists := f.participants[sceneID][characterID]
 	if exists {
 		if existing == "invited" {
+			if inv := f.invitation(sceneID, characterID); inv.Expired(time.Now()) && scene.Visibility == string(SceneVisibilityPrivate) {
+				return nil, OpNoChange, oops.Code("SCENE_INVITE_EXPIRED").
+					With("scene_id", sceneID).With("character_id", characterID).Errorf("invitation expired")
+			}
+			delete(f.invitations[sceneID], characterID)
 			f.participants[sceneID][characterID] = "member"
 			return &ParticipantRow{SceneID: sceneID, CharacterID: characterID, Role: "member"}, OpPromoted, nil
 		}
@@ -418,19 +440,80 @@ func (f *fakeStore) RemoveParticipant(_ context.Context, sceneID, characterID st
 	return &ParticipantRow{SceneID: sceneID, CharacterID: characterID, Role: role}, nil
 }
 
-func (f *fakeStore) InviteParticipant(_ context.Context, sceneID, _, targetID string) (*ParticipantRow, error) {
+func (f *fakeStore) InviteParticipant(_ context.Context, sceneID, inviterID, targetID string, expiresAt *time.Time) (*SceneInvitation, error) {
 	if f.participants[sceneID] == nil {
 		f.participants[sceneID] = make(map[string]string)
 	}
 	if existing, ok := f.participants[sceneID][targetID]; ok {
-		if existing == "invited" {
-			return &ParticipantRow{SceneID: sceneID, CharacterID: targetID, Role: "invited"}, nil
+		if existing != "invited" {
+			return nil, oops.Code("SCENE_INVITE_TARGET_ALREADY_MEMBER").
+				With("scene_id", sceneID).With("target_id", targetID).Errorf("already %s", existing)
+		}
+		if inv := f.invitations[sceneID][targetID]; inv == nil || !inv.Expired(time.Now()) {
+			cp := f.invitation(sceneID, targetID)
+			cp.Existing = true
+			return &cp, nil
 		}
-		return nil, oops.Code("SCENE_INVITE_TARGET_ALREADY_MEMBER").
-			With("scene_id", sceneID).With("target_id", targetID).Errorf("already %s", existing)
 	}
 	f.participants[sceneID][targetID] = "invited"
-	return &ParticipantRow{SceneID: sceneID, CharacterID: targetID, Role: "invited"}, nil
+	if f.invitations == nil {
+		f.invitations = make(map[string]map[string]*SceneInvitation)
+	}
+	if f.invitations[sceneID] == nil {
+		f.invitations[sceneID] = make(map[string]*SceneInvitation)
+	}
+	inv := &SceneInvitation{
+		SceneID: sceneID, CharacterID: targetID, InvitedBy: inviterID,
+		InvitedAt: time.Now(), ExpiresAt: expiresAt,
+	}
+	if row, ok := f.scenes[sceneID]; ok {
+		inv.SceneTitle = row.Title
+	}
+	f.invitations[sceneID][targetID] = inv
+	cp := *inv
+	return &cp, nil
+}
+
+// invitation returns a copy of the invitation metadata for an invited row,
+// synthesizing a never-lapsing one when the test seeded the row directly.
+func (f *fakeStore) invitation(sceneID, characterID string) SceneInvitation {
+	if inv := f.invitations[sceneID][characterID]; inv != nil {
+		return *inv
+	}
+	return SceneInvitation{SceneID: sceneID, CharacterID: characterID}
+}
+
+func (f *fakeStore) GetInvitation(_ context.Context, sceneID, characterID string) (*SceneInvitation, error) {
+	if f.participants[sceneID][characterID] != "invited" {
+		return nil, oops.Code("SCENE_INVITE_NOT_FOUND").
+			With("scene_id", sceneID).With("character_id", characterID).Errorf("not found")
+	}
+	inv := f.invitation(sceneID, characterID)
+	if inv.Expired(time.Now()) {
+		return nil, oops.Code("SCENE_INVITE_EXPIRED").
+			With("scene_id", sceneID).With("character_id", characterID).Errorf("invitation expired")
+	}
+	return &inv, nil
+}
+
+func (f *fakeStore) ListInvitations(ctx context.Context, characterID string) ([]*SceneInvitation, error) {
+	var out []*SceneInvitation
+	for sceneID := range f.participants {
+		if inv, err := f.GetInvitation(ctx, sceneID, characterID); err == nil {
+			out = append(out, inv)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) DeclineInvitation(ctx context.Context, sceneID, characterID string) (*SceneInvitation, error) {
+	inv, err := f.GetInvitation(ctx, sceneID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	delete(f.participants[sceneID], characterID)
+	delete(f.invitations[sceneID], characterID)
+	return inv, nil
 }
 
 func (f *fakeStore) KickParticipant(_ context.Context, sceneID, _, targetID string) (*ParticipantRow, error) {
@@ -683,6 +766,17 @@ func (f *fakeStore) ReadSceneLogForExport(_ context.Context, fullSubject string)
 	return f.exportLogRows, nil
 }
 
+// ReadSceneOOCLogForExport returns the rows injected via
+// fakeStore.exportOOCRows (or the injected error), recording the OOC subject
+// in exportOOCSubject. Backs the ExportTranscript unit suite.
+func (f *fakeStore) ReadSceneOOCLogForExport(_ context.Context, fullSubject string) ([]LogRow, error) {
+	f.exportOOCSubject = fullSubject
+	if f.exportOOCErr != nil {
+		return nil, f.exportOOCErr
+	}
+	return f.exportOOCRows, nil
+}
+
 func (f *fakeStore) ReadSceneMetaForSnapshot(_ context.Context, _ pgx.Tx, _ string) (SnapshotSceneMeta, error) {
 	return SnapshotSceneMeta{}, nil
 }
@@ -755,6 +849,172 @@ func (f *fakeStore) ListMutedScenes(_ context.Context, _ string) ([]string, erro
 	return f.mutedScenes, nil
 }
 
+// GetSceneTurns returns a copy of the scene's tracker, or (nil, nil) when
+// none is installed.
+func (f *fakeStore) GetSceneTurns(_ context.Context, sceneID string) (*TurnQueue, error) {
+	if f.turnsErr != nil {
+		return nil, f.turnsErr
+	}
+	q, ok := f.turns[sceneID]
+	if !ok {
+		return nil, nil
+	}
+	return cloneTurnQueue(q), nil
+}
+
+// UpdateSceneTurns mirrors the store's transaction: mutate runs on a copy
+// that replaces the stored tracker only when mutate succeeds.
+func (f *fakeStore) UpdateSceneTurns(_ context.Context, sceneID string, now time.Time, mutate func(*TurnQueue) error) (*TurnQueue, error) {
+	if f.turnsErr != nil {
+		return nil, f.turnsErr
+	}
+	q := newTurnQueue(sceneID, now)
+	if cur, ok := f.turns[sceneID]; ok {
+		q = cloneTurnQueue(cur)
+	}
+	if err := mutate(q); err != nil {
+		return nil, err
+	}
+	if f.turns == nil {
+		f.turns = make(map[string]*TurnQueue)
+	}
+	f.turns[sceneID] = q
+	return cloneTurnQueue(q), nil
+}
+
+// CreateSceneSchedule stores a copy of sched.
+func (f *fakeStore) CreateSceneSchedule(_ context.Context, sched *SceneSchedule) error {
+	if f.scheduleErr != nil {
+		return f.scheduleErr
+	}
+	if f.schedules == nil {
+		f.schedules = make(map[string]*SceneSchedule)
+	}
+	f.schedules[sched.ID] = cloneSceneSchedule(sched)
+	return nil
+}
+
+// GetSceneSchedule returns a copy of the schedule, or SCENE_SCHEDULE_NOT_FOUND.
+func (f *fakeStore) GetSceneSchedule(_ context.Context, id string) (*SceneSchedule, error) {
+	if f.scheduleErr != nil {
+		return nil, f.scheduleErr
+	}
+	sched, ok := f.schedules[id]
+	if !ok {
+		return nil, oops.Code("SCENE_SCHEDULE_NOT_FOUND").Errorf("scheduled scene not found")
+	}
+	return cloneSceneSchedule(sched), nil
+}
+
+// SetSceneRsvp mirrors the store: pending schedules only, first answer
+// keeps its position.
+func (f *fakeStore) SetSceneRsvp(ctx context.Context, scheduleID, characterID string, response RsvpResponse) (*SceneSchedule, error) {
+	if _, err := f.GetSceneSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+	sched := f.schedules[scheduleID]
+	if sched.State != ScheduleStateScheduled {
+		return nil, scheduleNotPending(scheduleID, sched.State)
+	}
+	i := slices.IndexFunc(sched.Rsvps, func(r SceneRsvp) bool { return r.CharacterID == characterID })
+	if i >= 0 {
+		sched.Rsvps[i].Response = response
+	} else {
+		sched.Rsvps = append(sched.Rsvps, SceneRsvp{CharacterID: characterID, Response: response})
+	}
+	return cloneSceneSchedule(sched), nil
+}
+
+// CancelSceneSchedule mirrors the store's pending-only transition.
+func (f *fakeStore) CancelSceneSchedule(ctx context.Context, id string) error {
+	if _, err := f.GetSceneSchedule(ctx, id); err != nil {
+		return err
+	}
+	sched := f.schedules[id]
+	if sched.State != ScheduleStateScheduled {
+		return scheduleNotPending(id, sched.State)
+	}
+	sched.State = ScheduleStateCancelled
+	return nil
+}
+
+// ListUpcomingSceneSchedules records q and returns pending schedules
+// soonest first, applying Attending and Limit like the store.
+func (f *fakeStore) ListUpcomingSceneSchedules(_ context.Context, q UpcomingQuery) ([]*SceneSchedule, error) {
+	f.upcomingGot = &q
+	if f.scheduleErr != nil {
+		return nil, f.scheduleErr
+	}
+	var out []*SceneSchedule
+	for _, sched := range f.schedules {
+		if sched.State != ScheduleStateScheduled {
+			continue
+		}
+		if q.Attending && sched.OwnerID != q.CharacterID {
+			if r := sched.Response(q.CharacterID); r != RsvpYes && r != RsvpMaybe {
+				continue
+			}
+		}
+		out = append(out, cloneSceneSchedule(sched))
+	}
+	slices.SortFunc(out, func(a, b *SceneSchedule) int {
+		if c := a.StartsAt.Compare(b.StartsAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	if len(out) > q.Limit {
+		out = out[:q.Limit]
+	}
+	return out, nil
+}
+
+// MarkSceneScheduleReminded mirrors the store's claim condition and
+// records each successful claim.
+func (f *fakeStore) MarkSceneScheduleReminded(_ context.Context, id string, lead time.Duration) (bool, error) {
+	if f.scheduleErr != nil {
+		return false, f.scheduleErr
+	}
+	sched, ok := f.schedules[id]
+	if !ok || sched.State != ScheduleStateScheduled ||
+		(sched.RemindedLead != nil && *sched.RemindedLead <= lead) {
+		return false, nil
+	}
+	sched.RemindedLead = &lead
+	f.remindedLeads = append(f.remindedLeads, lead)
+	return true, nil
+}
+
+// OpenSceneSchedule mirrors the store: the scene is created under the
+// schedule's ID with the "yes" attendees as members.
+func (f *fakeStore) OpenSceneSchedule(ctx context.Context, id string) (*SceneSchedule, error) {
+	if _, err := f.GetSceneSchedule(ctx, id); err != nil {
+		return nil, err
+	}
+	sched := f.schedules[id]
+	if sched.State != ScheduleStateScheduled {
+		return nil, scheduleNotPending(id, sched.State)
+	}
+	f.scenes[id] = sched.sceneRow()
+	f.installRoster(id, sched.OwnerID, sched.Attendees()...)
+	sched.State = ScheduleStateOpened
+	f.openedScenes = append(f.openedScenes, id)
+	return cloneSceneSchedule(sched), nil
+}
+
+func cloneSceneSchedule(sched *SceneSchedule) *SceneSchedule {
+	c := *sched
+	c.ContentWarnings = slices.Clone(sched.ContentWarnings)
+	c.Rsvps = slices.Clone(sched.Rsvps)
+	return &c
+}
+
+func cloneTurnQueue(q *TurnQueue) *TurnQueue {
+	c := *q
+	c.Queue = slices.Clone(q.Queue)
+	return &c
+}
+
 // ListPublishedScenes returns a fixed slice injected via
 // fakeStore.listPublishedScenesRows.
 func (f *fakeStore) ListPublishedScenes(_ context.Context, _ ListPublishedScenesQuery) ([]PublishedSceneArchiveSummary, error) {
@@ -1008,6 +1268,20 @@ func TestSceneServiceSceneCreatedIntentBuildsLifecyclePayload(t *testing.T) {
 	assert.Contains(t, intent.Payload, `"kind":"scene.lifecycle.created"`)
 	assert.Contains(t, intent.Payload, `"scene_id":"scene-123"`)
 	assert.Contains(t, intent.Payload, `"owner_id":"char-alice"`)
+	assert.NotContains(t, intent.Payload, pluginsdk.PayloadKeyEncryptedAtRest)
+}
+
+func TestSceneServiceSceneCreatedIntentRequestsEncryptionForPrivateScene(t *testing.T) {
+	svc := newTestService(t, newFakeStore())
+
+	intent, err := svc.sceneCreatedIntent(&SceneRow{
+		ID:         "scene-123",
+		OwnerID:    "char-alice",
+		Title:      "Tea",
+		Visibility: string(SceneVisibilityPrivate),
+	})
+	require.NoError(t, err)
+	assert.Contains(t, intent.Payload, `"`+pluginsdk.PayloadKeyEncryptedAtRest+`":true`)
 }
 
 func TestSceneServiceGetSceneReturnsSceneWhenItExists(t *testing.T) {
@@ -2255,7 +2529,7 @@ func TestGetPoseOrder_InvitedRole_PermissionDenied(t *testing.T) {
 		PoseOrder:  string(PoseOrderModeStrict),
 	}))
 	// Mark char-bob as invited (not member).
-	_, err := store.InviteParticipant(context.Background(), "scene-gpo-invited", "char-owner", "char-bob")
+	_, err := store.InviteParticipant(context.Background(), "scene-gpo-invited", "char-owner", "char-bob", nil)
 	require.NoError(t, err)
 
 	svc := newTestService(t, store)
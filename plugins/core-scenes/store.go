@@ -178,8 +178,28 @@ func (s *SceneStore) CreateWithOwner(ctx context.Context, row *SceneRow) error {
 	}
 	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
 
+	payload := map[string]any{
+		"visibility":    row.Visibility,
+		"from_template": row.TemplateID != nil,
+	}
+	if err := insertSceneWithOwnerTx(ctx, tx, row, payload); err != nil {
+		recordError(span, err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		recordError(span, err)
+		return oops.Code("SCENE_CREATE_FAILED").With("scene_id", row.ID).Wrap(err)
+	}
+	return nil
+}
+
+// insertSceneWithOwnerTx is the body of CreateWithOwner, shared with
+// OpenSceneSchedule: it inserts the scene row, the owner's participant row,
+// and a lifecycle.created ops event carrying payload, all inside tx.
+func insertSceneWithOwnerTx(ctx context.Context, tx pgx.Tx, row *SceneRow, payload map[string]any) error {
 	// 1. Insert the scene row.
-	_, err = tx.Exec(
+	_, err := tx.Exec(
 		ctx, `
 		INSERT INTO scenes (
 			id, title, description, location_id, owner_id, state, pose_order,
@@ -193,7 +213,6 @@ func (s *SceneStore) CreateWithOwner(ctx context.Context, row *SceneRow) error {
 		row.TemplateID, row.ContentWarnings, row.Tags,
 	)
 	if err != nil {
-		recordError(span, err)
 		return oops.Code("SCENE_CREATE_FAILED").With("scene_id", row.ID).Wrap(err)
 	}
 
@@ -209,7 +228,6 @@ func (s *SceneStore) CreateWithOwner(ctx context.Context, row *SceneRow) error {
 		row.ID, row.OwnerID,
 	)
 	if err != nil {
-		recordError(span, err)
 		return oops.Code("SCENE_CREATE_OWNER_PARTICIPANT_FAILED").
 			With("scene_id", row.ID).
 			With("owner_id", row.OwnerID).
@@ -217,21 +235,11 @@ func (s *SceneStore) CreateWithOwner(ctx context.Context, row *SceneRow) error {
 	}
 
 	// 3. Record the lifecycle.created ops event.
-	payload := map[string]any{
-		"visibility":    row.Visibility,
-		"from_template": row.TemplateID != nil,
-	}
 	if err := recordOpsEventTx(ctx, tx, row.ID, OpsKindLifecycleCreated, row.OwnerID, "", payload); err != nil {
-		recordError(span, err)
 		return oops.Code("SCENE_CREATE_OPS_EVENT_FAILED").
 			With("scene_id", row.ID).
 			Wrap(err)
 	}
-
-	if err := tx.Commit(ctx); err != nil {
-		recordError(span, err)
-		return oops.Code("SCENE_CREATE_FAILED").With("scene_id", row.ID).Wrap(err)
-	}
 	return nil
 }
 
@@ -267,7 +275,8 @@ func (s *SceneStore) Get(ctx context.Context, id string) (*SceneRow, error) {
 // attributes without two separate queries.
 //
 // participants contains all character IDs where role IN ('owner', 'member').
-// invitees contains all character IDs where role = 'invited'.
+// invitees contains all character IDs where role = 'invited' and the
+// invitation has not lapsed.
 //
 // Per design decision P3.D9, this uses two array_agg subselects on the
 // indexed scene_participants(scene_id, role) index. No caching layer in
@@ -294,7 +303,7 @@ func (s *SceneStore) GetWithMembership(ctx context.Context, id string) (scene *S
 			) AS participants,
 			COALESCE(
 				(SELECT array_agg(character_id) FROM scene_participants
-				 WHERE scene_id = s.id AND role = 'invited'),
+				 WHERE scene_id = s.id AND role = 'invited' AND `+invitationPending+`),
 				'{}'::TEXT[]
 			) AS invitees
 		FROM scenes s
@@ -343,7 +352,7 @@ func (s *SceneStore) GetWithMembershipAndObservers(ctx context.Context, id strin
 			) AS participants,
 			COALESCE(
 				(SELECT array_agg(character_id) FROM scene_participants
-				 WHERE scene_id = s.id AND role = 'invited'),
+				 WHERE scene_id = s.id AND role = 'invited' AND `+invitationPending+`),
 				'{}'::TEXT[]
 			) AS invitees,
 			COALESCE(
@@ -741,7 +750,8 @@ func (s *SceneStore) Update(ctx context.Context, id string, update *SceneUpdate)
 // checks at the SQL layer:
 //   - Scene must exist
 //   - Scene must be in active or paused state
-//   - Either the scene is open OR there's an invited row for this character
+//   - Either the scene is open OR this character holds a pending (unexpired)
+//     invitation or is already in the scene
 //
 // If the eligibility check fails, RETURNING is empty and we issue a
 // diagnostic SELECT (classifyJoinMiss) to figure out the precise reason.
@@ -787,7 +797,8 @@ func (s *SceneStore) AddParticipant(ctx context.Context, sceneID, characterID st
 		    visibility = 'open'
 		    OR EXISTS (
 		      SELECT 1 FROM scene_participants
-		      WHERE scene_id = $1 AND character_id = $2 AND role IN ('invited', 'member', 'owner', 'observer')
+		      WHERE scene_id = $1 AND character_id = $2
+		        AND (role IN ('member', 'owner', 'observer') OR (role = 'invited' AND `+invitationPending+`))
 		    )
 		  )
 		ON CONFLICT (scene_id, character_id) DO UPDATE
@@ -798,7 +809,8 @@ func (s *SceneStore) AddParticipant(ctx context.Context, sceneID, characterID st
 		      joined_at = CASE
 		        WHEN scene_participants.role IN ('invited', 'observer') THEN (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT
 		        ELSE scene_participants.joined_at
-		      END
+		      END,
+		      invite_expires_at = NULL
 		RETURNING scene_id, character_id, role, joined_at, (xmax = 0) AS was_inserted`,
 		sceneID, characterID,
 	).Scan(&row.SceneID, &row.CharacterID, &row.Role, &row.JoinedAt, &wasInserted)
@@ -1061,11 +1073,19 @@ func (s *SceneStore) RemoveParticipant(ctx context.Context, sceneID, characterID
 	return row, nil
 }
 
-// InviteParticipant inserts a participant row with role='invited'. Idempotent
-// on identity match (re-inviting an already-invited character is a no-op,
-// no second ops event). Rejected for existing members or owners with
+// invitationPending is the SQL predicate (over scene_participants columns)
+// for an invited row whose invitation has not lapsed. Rows with a NULL
+// invite_expires_at never lapse (migration 000014).
+const invitationPending = `(invite_expires_at IS NULL OR invite_expires_at > (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT)`
+
+// InviteParticipant issues an invitation: a participant row with
+// role='invited' recording the inviter and expiresAt (nil: never lapses).
+// Idempotent on identity match — re-inviting a character whose invitation
+// is still pending is a no-op (Existing=true, no second ops event). An
+// expired invitation is reissued in place as a fresh one. Rejected for
+// existing members, owners, and observers with
 // SCENE_INVITE_TARGET_ALREADY_MEMBER.
-func (s *SceneStore) InviteParticipant(ctx context.Context, sceneID, inviterID, targetID string) (*ParticipantRow, error) {
+func (s *SceneStore) InviteParticipant(ctx context.Context, sceneID, inviterID, targetID string, expiresAt *time.Time) (*SceneInvitation, error) {
 	ctx, span := startSpan(
 		ctx, "scene.store.invite_participant",
 		attribute.String("scene_id", sceneID),
@@ -1081,31 +1101,42 @@ func (s *SceneStore) InviteParticipant(ctx context.Context, sceneID, inviterID,
 	}
 	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
 
-	// Check existing role for target — distinguish "already invited" (no-op),
-	// "already member/owner" (error), and "not present" (insert).
-	var existingRole string
+	// Check existing role for target — distinguish "already invited" (no-op
+	// while pending, reissue once lapsed), "already member/owner/observer"
+	// (error), and "not present" (insert).
+	var (
+		existingRole string
+		pending      bool
+	)
 	err = tx.QueryRow(
 		ctx,
-		`SELECT role FROM scene_participants WHERE scene_id = $1 AND character_id = $2`,
+		`SELECT role, `+invitationPending+` FROM scene_participants WHERE scene_id = $1 AND character_id = $2`,
 		sceneID, targetID,
-	).Scan(&existingRole)
+	).Scan(&existingRole, &pending)
 
 	switch {
 	case err == nil:
-		if existingRole == "invited" {
-			// Idempotent no-op — return the existing row, no new ops event.
-			row := &ParticipantRow{SceneID: sceneID, CharacterID: targetID, Role: "invited"}
+		if existingRole != string(ParticipantRoleInvited) {
+			return nil, oops.Code("SCENE_INVITE_TARGET_ALREADY_MEMBER").
+				With("scene_id", sceneID).
+				With("target_id", targetID).
+				With("current_role", existingRole).
+				Errorf("character is already a %s", existingRole)
+		}
+		if pending {
+			// Idempotent no-op — return the existing invitation, no new ops event.
+			inv, getErr := getInvitationTx(ctx, tx, sceneID, targetID)
+			if getErr != nil {
+				recordError(span, getErr)
+				return nil, oops.Code("SCENE_INVITE_FAILED").Wrap(getErr)
+			}
 			if commitErr := tx.Commit(ctx); commitErr != nil {
 				return nil, oops.Code("SCENE_INVITE_FAILED").Wrap(commitErr)
 			}
-			return row, nil
+			inv.Existing = true
+			return inv, nil
 		}
-		// Already member or owner — reject.
-		return nil, oops.Code("SCENE_INVITE_TARGET_ALREADY_MEMBER").
-			With("scene_id", sceneID).
-			With("target_id", targetID).
-			With("current_role", existingRole).
-			Errorf("character is already a %s", existingRole)
+		// Lapsed — fall through and reissue it.
 	case errors.Is(err, pgx.ErrNoRows):
 		// Not present — fall through to insert.
 	default:
@@ -1113,14 +1144,22 @@ func (s *SceneStore) InviteParticipant(ctx context.Context, sceneID, inviterID,
 		return nil, oops.Code("SCENE_INVITE_FAILED").Wrap(err)
 	}
 
-	row := &ParticipantRow{}
-	err = tx.QueryRow(
+	var expires *pgnanos.Time
+	if expiresAt != nil {
+		e := pgnanos.From(*expiresAt)
+		expires = &e
+	}
+	_, err = tx.Exec(
 		ctx, `
-		INSERT INTO scene_participants (scene_id, character_id, role, joined_at)
-		VALUES ($1, $2, 'invited', $3)
-		RETURNING scene_id, character_id, role, joined_at`,
-		sceneID, targetID, pgnanos.From(time.Now()),
-	).Scan(&row.SceneID, &row.CharacterID, &row.Role, &row.JoinedAt)
+		INSERT INTO scene_participants (scene_id, character_id, role, joined_at, invited_by, invite_expires_at)
+		VALUES ($1, $2, 'invited', $3, $4, $5)
+		ON CONFLICT (scene_id, character_id) DO UPDATE
+		  SET joined_at = EXCLUDED.joined_at,
+		      invited_by = EXCLUDED.invited_by,
+		      invite_expires_at = EXCLUDED.invite_expires_at
+		  WHERE scene_participants.role = 'invited'`,
+		sceneID, targetID, pgnanos.From(time.Now()), inviterID, expires,
+	)
 	if err != nil {
 		recordError(span, err)
 		return nil, oops.Code("SCENE_INVITE_FAILED").
@@ -1132,11 +1171,190 @@ func (s *SceneStore) InviteParticipant(ctx context.Context, sceneID, inviterID,
 		return nil, oops.Code("SCENE_INVITE_OPS_EVENT_FAILED").Wrap(err)
 	}
 
+	inv, err := getInvitationTx(ctx, tx, sceneID, targetID)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_INVITE_FAILED").Wrap(err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		recordError(span, err)
 		return nil, oops.Code("SCENE_INVITE_FAILED").Wrap(err)
 	}
-	return row, nil
+	return inv, nil
+}
+
+// invitationSelect reads a SceneInvitation: scene_participants p joined to
+// its scene s. The column order MUST match scanInvitation.
+const invitationSelect = `
+	SELECT p.scene_id, s.title, p.character_id, COALESCE(p.invited_by, ''),
+	       p.joined_at, p.invite_expires_at
+	FROM scene_participants p
+	JOIN scenes s ON s.id = p.scene_id`
+
+// scanInvitation scans one invitationSelect row. The error is returned
+// unwrapped; callers wrap it with an operation-specific oops code.
+//
+//nolint:wrapcheck // caller wraps with operation-specific oops code
+func scanInvitation(row pgx.Row) (*SceneInvitation, error) {
+	var (
+		inv       SceneInvitation
+		invitedAt pgnanos.Time
+		expires   *pgnanos.Time
+	)
+	if err := row.Scan(&inv.SceneID, &inv.SceneTitle, &inv.CharacterID, &inv.InvitedBy, &invitedAt, &expires); err != nil {
+		return nil, err
+	}
+	inv.InvitedAt = invitedAt.Time()
+	if expires != nil {
+		t := expires.Time()
+		inv.ExpiresAt = &t
+	}
+	return &inv, nil
+}
+
+// getInvitationTx reads the invitation row for (sceneID, characterID)
+// inside tx, whether or not it has lapsed.
+//
+//nolint:wrapcheck // caller wraps with operation-specific oops code
+func getInvitationTx(ctx context.Context, tx pgx.Tx, sceneID, characterID string) (*SceneInvitation, error) {
+	return scanInvitation(tx.QueryRow(
+		ctx, invitationSelect+`
+		WHERE p.scene_id = $1 AND p.character_id = $2 AND p.role = 'invited'`,
+		sceneID, characterID,
+	))
+}
+
+// GetInvitation returns characterID's invitation to sceneID. Fails with
+// SCENE_INVITE_NOT_FOUND when there is none and SCENE_INVITE_EXPIRED when
+// it has lapsed.
+func (s *SceneStore) GetInvitation(ctx context.Context, sceneID, characterID string) (*SceneInvitation, error) {
+	ctx, span := startSpan(
+		ctx, "scene.store.get_invitation",
+		attribute.String("scene_id", sceneID),
+		attribute.String("character_id", characterID),
+	)
+	defer span.End()
+
+	inv, err := scanInvitation(s.pool.QueryRow(
+		ctx, invitationSelect+`
+		WHERE p.scene_id = $1 AND p.character_id = $2 AND p.role = 'invited'`,
+		sceneID, characterID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.Code("SCENE_INVITE_NOT_FOUND").
+				With("scene_id", sceneID).With("character_id", characterID).Wrap(err)
+		}
+		recordError(span, err)
+		return nil, oops.Code("SCENE_GET_INVITATION_FAILED").
+			With("scene_id", sceneID).With("character_id", characterID).Wrap(err)
+	}
+	if inv.Expired(time.Now()) {
+		return nil, oops.Code("SCENE_INVITE_EXPIRED").
+			With("scene_id", sceneID).
+			With("character_id", characterID).
+			Errorf("invitation expired")
+	}
+	return inv, nil
+}
+
+// ListInvitations returns characterID's pending invitations to active or
+// paused scenes, oldest first. Lapsed invitations are left out.
+func (s *SceneStore) ListInvitations(ctx context.Context, characterID string) ([]*SceneInvitation, error) {
+	ctx, span := startSpan(
+		ctx, "scene.store.list_invitations",
+		attribute.String("character_id", characterID),
+	)
+	defer span.End()
+
+	rows, err := s.pool.Query(
+		ctx, invitationSelect+`
+		WHERE p.character_id = $1 AND p.role = 'invited'
+		  AND `+invitationPending+`
+		  AND s.state IN ('active', 'paused')
+		ORDER BY p.joined_at, p.scene_id`,
+		characterID,
+	)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_LIST_INVITATIONS_FAILED").With("character_id", characterID).Wrap(err)
+	}
+	defer rows.Close()
+
+	var out []*SceneInvitation
+	for rows.Next() {
+		inv, scanErr := scanInvitation(rows)
+		if scanErr != nil {
+			recordError(span, scanErr)
+			return nil, oops.Code("SCENE_LIST_INVITATIONS_FAILED").With("character_id", characterID).Wrap(scanErr)
+		}
+		out = append(out, inv)
+	}
+	if err := rows.Err(); err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_LIST_INVITATIONS_FAILED").With("character_id", characterID).Wrap(err)
+	}
+	return out, nil
+}
+
+// DeclineInvitation withdraws characterID's pending invitation to sceneID
+// at the invitee's request and records a membership.decline ops event.
+// Returns the declined invitation so the caller can tell the inviter. Fails
+// with SCENE_INVITE_NOT_FOUND when there is no invitation and
+// SCENE_INVITE_EXPIRED when it has already lapsed.
+func (s *SceneStore) DeclineInvitation(ctx context.Context, sceneID, characterID string) (*SceneInvitation, error) {
+	ctx, span := startSpan(
+		ctx, "scene.store.decline_invitation",
+		attribute.String("scene_id", sceneID),
+		attribute.String("character_id", characterID),
+	)
+	defer span.End()
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_DECLINE_FAILED").Wrap(err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck // rollback after commit is a no-op
+
+	inv, err := getInvitationTx(ctx, tx, sceneID, characterID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.Code("SCENE_INVITE_NOT_FOUND").
+				With("scene_id", sceneID).With("character_id", characterID).Wrap(err)
+		}
+		recordError(span, err)
+		return nil, oops.Code("SCENE_DECLINE_FAILED").Wrap(err)
+	}
+	if inv.Expired(time.Now()) {
+		return nil, oops.Code("SCENE_INVITE_EXPIRED").
+			With("scene_id", sceneID).
+			With("character_id", characterID).
+			Errorf("invitation expired")
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		`DELETE FROM scene_participants WHERE scene_id = $1 AND character_id = $2 AND role = 'invited'`,
+		sceneID, characterID,
+	); err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_DECLINE_FAILED").
+			With("scene_id", sceneID).With("character_id", characterID).Wrap(err)
+	}
+
+	payload := map[string]any{"invited_by": inv.InvitedBy}
+	if err := recordOpsEventTx(ctx, tx, sceneID, OpsKindMembershipDecline, characterID, characterID, payload); err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_DECLINE_OPS_EVENT_FAILED").Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_DECLINE_FAILED").Wrap(err)
+	}
+	return inv, nil
 }
 
 // KickParticipant deletes the target's participant row. The DELETE filter is
@@ -1670,13 +1888,14 @@ func (s *SceneStore) ListParticipantsWithPoseMeta(ctx context.Context, sceneID s
 	return result, nil
 }
 
-// classifyJoinMiss issues one diagnostic SELECT to figure out which
+// classifyJoinMiss issues diagnostic SELECTs to figure out which
 // precondition failed when AddParticipant's RETURNING was empty. Pays the
-// extra round trip ONLY in the error path; the happy path is single-statement.
+// extra round trips ONLY in the error path; the happy path is single-statement.
 //
 // Returns one of:
 //   - SCENE_NOT_FOUND
 //   - SCENE_TRANSITION_FORBIDDEN (with current_state in context)
+//   - SCENE_INVITE_EXPIRED (private scene, invitation lapsed)
 //   - SCENE_JOIN_NOT_INVITED (private scene, no invitation)
 func (s *SceneStore) classifyJoinMiss(ctx context.Context, sceneID, characterID string, span trace.Span) error {
 	var (
@@ -1710,7 +1929,30 @@ func (s *SceneStore) classifyJoinMiss(ctx context.Context, sceneID, characterID
 			Errorf("scene in state %q cannot be joined", state)
 	}
 
-	// State is OK. The remaining reason is private scene without invitation.
+	// State is OK. The remaining reason is private scene without a pending
+	// invitation; say so precisely when the invitation has lapsed.
+	var lapsed bool
+	if err := s.pool.QueryRow(
+		ctx, `
+		SELECT EXISTS (
+		  SELECT 1 FROM scene_participants
+		  WHERE scene_id = $1 AND character_id = $2 AND role = 'invited'
+		    AND NOT `+invitationPending+`
+		)`,
+		sceneID, characterID,
+	).Scan(&lapsed); err != nil {
+		recordError(span, err)
+		return oops.Code("SCENE_JOIN_CLASSIFY_FAILED").
+			With("scene_id", sceneID).
+			With("op", "join").
+			Wrap(err)
+	}
+	if lapsed {
+		return oops.Code("SCENE_INVITE_EXPIRED").
+			With("scene_id", sceneID).
+			With("character_id", characterID).
+			Errorf("invitation expired")
+	}
 	return oops.Code("SCENE_JOIN_NOT_INVITED").
 		With("scene_id", sceneID).
 		With("character_id", characterID).
@@ -2022,6 +2264,136 @@ func (s *SceneStore) ListMutedScenes(ctx context.Context, characterID string) ([
 	return ids, nil
 }
 
+// GetSceneTurns returns the scene's turn tracker, or (nil, nil) when the
+// scene has none (no scene_turns row).
+func (s *SceneStore) GetSceneTurns(ctx context.Context, sceneID string) (*TurnQueue, error) {
+	ctx, span := startSpan(ctx, "scene.store.get_scene_turns", attribute.String("scene_id", sceneID))
+	defer span.End()
+
+	q, err := scanTurnQueue(s.pool.QueryRow(ctx, `
+		SELECT scene_id, queue, current_index, round, turn_started_at
+		FROM scene_turns WHERE scene_id = $1`, sceneID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		recordError(span, err)
+		return nil, oops.Code("SCENE_GET_TURNS_FAILED").With("scene_id", sceneID).Wrap(err)
+	}
+	return q, nil
+}
+
+// UpdateSceneTurns read-modify-writes the scene's turn tracker in one
+// transaction: it locks the scene_turns row (creating an empty tracker,
+// turn clock at now, when the scene has none), applies mutate, and persists
+// the result. An error from mutate rolls everything back and is returned
+// unwrapped, so callers can match the TurnQueue rule codes and
+// errTurnUnchanged.
+func (s *SceneStore) UpdateSceneTurns(ctx context.Context, sceneID string, now time.Time, mutate func(*TurnQueue) error) (*TurnQueue, error) {
+	ctx, span := startSpan(ctx, "scene.store.update_scene_turns", attribute.String("scene_id", sceneID))
+	defer span.End()
+
+	var (
+		q         *TurnQueue
+		mutateErr error
+	)
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO scene_turns (scene_id, turn_started_at) VALUES ($1, $2)
+			ON CONFLICT (scene_id) DO NOTHING`, sceneID, pgnanos.From(now)); err != nil {
+			return oops.Code("SCENE_UPDATE_TURNS_FAILED").With("scene_id", sceneID).Wrap(err)
+		}
+		var err error
+		q, err = scanTurnQueue(tx.QueryRow(ctx, `
+			SELECT scene_id, queue, current_index, round, turn_started_at
+			FROM scene_turns WHERE scene_id = $1 FOR UPDATE`, sceneID))
+		if err != nil {
+			return oops.Code("SCENE_UPDATE_TURNS_FAILED").With("scene_id", sceneID).Wrap(err)
+		}
+		if mutateErr = mutate(q); mutateErr != nil {
+			return mutateErr
+		}
+		queue := q.Queue
+		if queue == nil {
+			queue = []string{} // queue is NOT NULL; pgx encodes a nil slice as NULL
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE scene_turns
+			SET queue = $2, current_index = $3, round = $4, turn_started_at = $5,
+			    updated_at = (EXTRACT(EPOCH FROM now()) * 1e9)::BIGINT
+			WHERE scene_id = $1`,
+			sceneID, queue, q.CurrentIndex, q.Round, pgnanos.From(q.TurnStartedAt)); err != nil {
+			return oops.Code("SCENE_UPDATE_TURNS_FAILED").With("scene_id", sceneID).Wrap(err)
+		}
+		return nil
+	})
+	if mutateErr != nil {
+		return nil, mutateErr
+	}
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_UPDATE_TURNS_FAILED").With("scene_id", sceneID).Wrap(err)
+	}
+	return q, nil
+}
+
+// ListSceneTurnsPastTimeout returns the active scenes whose current turn
+// started at least timeoutSecs before nowNs. Scenes with an empty queue and
+// paused scenes are never returned. nowNs is a Go-clock nanosecond value
+// passed as a query parameter (the pgnanos-exempt scheduler-clock seam, as
+// ListScenesIdlePastThreshold).
+func (s *SceneStore) ListSceneTurnsPastTimeout(ctx context.Context, nowNs int64, timeoutSecs int) ([]string, error) {
+	ctx, span := startSpan(ctx, "scene.store.list_scene_turns_past_timeout")
+	defer span.End()
+
+	const q = `
+		SELECT t.scene_id
+		FROM scene_turns t
+		JOIN scenes s ON s.id = t.scene_id
+		WHERE s.state = 'active'
+		  AND cardinality(t.queue) > 0
+		  AND t.turn_started_at + $2::bigint * 1000000000 <= $1
+		ORDER BY t.scene_id ASC
+	`
+	rows, err := s.pool.Query(ctx, q, nowNs, timeoutSecs)
+	if err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_LIST_TURN_TIMEOUTS_FAILED").Wrap(err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			recordError(span, err)
+			return nil, oops.Code("SCENE_LIST_TURN_TIMEOUTS_SCAN_FAILED").Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		recordError(span, err)
+		return nil, oops.Code("SCENE_LIST_TURN_TIMEOUTS_ITER_FAILED").Wrap(err)
+	}
+	return ids, nil
+}
+
+// scanTurnQueue scans one scene_turns row. Callers wrap the error with an
+// operation-specific code.
+//
+//nolint:wrapcheck // caller wraps with operation-specific oops code
+func scanTurnQueue(row pgx.Row) (*TurnQueue, error) {
+	var (
+		q       TurnQueue
+		started pgnanos.Time
+	)
+	if err := row.Scan(&q.SceneID, &q.Queue, &q.CurrentIndex, &q.Round, &started); err != nil {
+		return nil, err
+	}
+	q.TurnStartedAt = started.Time()
+	return &q, nil
+}
+
 // dotStyleSceneSubject returns the NATS dot-style entity-level subject
 // for a scene per substrate INV-EVENTBUS-28: events.<gameID>.scene.<sceneID>.
 // Used for lifecycle/system events that target the scene itself, not
@@ -2041,3 +2413,10 @@ func dotStyleSceneSubjectIC(gameID, sceneID string) string {
 func dotStyleSceneSubjectOOC(gameID, sceneID string) string {
 	return dotStyleSceneSubject(gameID, sceneID) + ".ooc"
 }
+
+// dotStyleCharacterSubject returns the NATS dot-style subject addressing a
+// single character: events.<gameID>.character.<characterID>. Used for
+// notices that precede any scene roster (scheduled-scene reminders).
+func dotStyleCharacterSubject(gameID, characterID string) string {
+	return "events." + gameID + ".character." + characterID
+}
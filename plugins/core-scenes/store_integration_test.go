@@ -961,6 +961,44 @@ var _ = Describe("SceneStore", func() {
 		})
 	})
 
+	Describe("Scene invitations", func() {
+		It("lists pending invitations and declines one", func() {
+			store := newTestStore()
+			ctx := context.Background()
+			for _, id := range []string{"scene-invl-1", "scene-invl-2"} {
+				Expect(store.CreateWithOwner(ctx, &SceneRow{
+					ID: id, OwnerID: "char-alice", Title: "Title " + id,
+					State: string(SceneStateActive), PoseOrder: string(PoseOrderModeFree),
+					Visibility:      string(SceneVisibilityPrivate),
+					ContentWarnings: []string{}, Tags: []string{},
+				})).NotTo(HaveOccurred())
+			}
+			future := time.Now().Add(time.Hour)
+			_, err := store.InviteParticipant(ctx, "scene-invl-1", "char-alice", "char-bob", &future)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = store.InviteParticipant(ctx, "scene-invl-2", "char-alice", "char-bob", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			invs, err := store.ListInvitations(ctx, "char-bob")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invs).To(HaveLen(2))
+			Expect(invs[0].SceneID).To(Equal("scene-invl-1"))
+			Expect(invs[0].SceneTitle).To(Equal("Title scene-invl-1"))
+			Expect(invs[0].ExpiresAt).NotTo(BeNil())
+
+			declined, err := store.DeclineInvitation(ctx, "scene-invl-1", "char-bob")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(declined.InvitedBy).To(Equal("char-alice"))
+			assertOpsEventRecorded(store, "scene-invl-1", OpsKindMembershipDecline, "char-bob", "char-bob")
+
+			_, err = store.DeclineInvitation(ctx, "scene-invl-1", "char-bob")
+			errutil.AssertErrorCode(suiteT, err, "SCENE_INVITE_NOT_FOUND")
+			invs, err = store.ListInvitations(ctx, "char-bob")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invs).To(HaveLen(1))
+		})
+	})
+
 	Describe("InviteParticipant", func() {
 		It("inserts invited row and emits ops event", func() {
 			store := newTestStore()
@@ -973,9 +1011,12 @@ var _ = Describe("SceneStore", func() {
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
 
-			got, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			got, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(got.Role).To(Equal("invited"))
+			Expect(got.InvitedBy).To(Equal("char-alice"))
+			Expect(got.SceneTitle).To(Equal("T"))
+			Expect(got.ExpiresAt).To(BeNil())
+			Expect(got.Existing).To(BeFalse())
 			assertParticipantRowExists(store, row.ID, "char-bob", "invited")
 			assertOpsEventRecorded(store, row.ID, OpsKindMembershipInvite, "char-alice", "char-bob")
 		})
@@ -991,13 +1032,49 @@ var _ = Describe("SceneStore", func() {
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
 
-			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
-			_, err = store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			again, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(again.Existing).To(BeTrue())
 			Expect(countOpsEvents(store, row.ID, OpsKindMembershipInvite)).To(Equal(1))
 		})
 
+		It("treats a lapsed invitation as absent and reissues it", func() {
+			store := newTestStore()
+			ctx := context.Background()
+			row := &SceneRow{
+				ID: "scene-inv-lapsed", OwnerID: "char-alice", Title: "T",
+				State: string(SceneStateActive), PoseOrder: string(PoseOrderModeFree),
+				Visibility:      string(SceneVisibilityPrivate),
+				ContentWarnings: []string{}, Tags: []string{},
+			}
+			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
+
+			past := time.Now().Add(-time.Minute)
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", &past)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, invitees, err := store.GetWithMembership(ctx, row.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invitees).To(BeEmpty())
+			_, _, err = store.AddParticipant(ctx, row.ID, "char-bob")
+			errutil.AssertErrorCode(suiteT, err, "SCENE_INVITE_EXPIRED")
+			_, err = store.GetInvitation(ctx, row.ID, "char-bob")
+			errutil.AssertErrorCode(suiteT, err, "SCENE_INVITE_EXPIRED")
+
+			future := time.Now().Add(time.Hour)
+			reissued, err := store.InviteParticipant(ctx, row.ID, "char-carol", "char-bob", &future)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reissued.Existing).To(BeFalse())
+			Expect(reissued.InvitedBy).To(Equal("char-carol"))
+			Expect(countOpsEvents(store, row.ID, OpsKindMembershipInvite)).To(Equal(2))
+
+			_, result, err := store.AddParticipant(ctx, row.ID, "char-bob")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(OpPromoted))
+		})
+
 		It("rejects existing member", func() {
 			store := newTestStore()
 			ctx := context.Background()
@@ -1011,7 +1088,7 @@ var _ = Describe("SceneStore", func() {
 			_, _, err := store.AddParticipant(ctx, row.ID, "char-bob")
 			Expect(err).NotTo(HaveOccurred())
 
-			_, err = store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err = store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).To(HaveOccurred())
 			errutil.AssertErrorCode(suiteT, err, "SCENE_INVITE_TARGET_ALREADY_MEMBER")
 		})
@@ -1027,7 +1104,7 @@ var _ = Describe("SceneStore", func() {
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
 
-			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-alice")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-alice", nil)
 			Expect(err).To(HaveOccurred())
 			errutil.AssertErrorCode(suiteT, err, "SCENE_INVITE_TARGET_ALREADY_MEMBER")
 			errutil.AssertErrorContext(suiteT, err, "current_role", "owner")
@@ -1067,7 +1144,7 @@ var _ = Describe("SceneStore", func() {
 				ContentWarnings: []string{}, Tags: []string{},
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
-			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			got, err := store.KickParticipant(ctx, row.ID, "char-alice", "char-bob")
@@ -1435,7 +1512,7 @@ var _ = Describe("SceneStore", func() {
 				ContentWarnings: []string{}, Tags: []string{},
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
-			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			got, result, err := store.AddParticipant(ctx, row.ID, "char-bob")
@@ -1564,11 +1641,11 @@ var _ = Describe("SceneStore", func() {
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
 			Expect(countOpsEvents(store, row.ID, "")).To(Equal(1), "after create")
 
-			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(countOpsEvents(store, row.ID, "")).To(Equal(2), "after invite")
 
-			_, err = store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob")
+			_, err = store.InviteParticipant(ctx, row.ID, "char-alice", "char-bob", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(countOpsEvents(store, row.ID, "")).To(Equal(2), "after redundant invite")
 
@@ -1693,7 +1770,7 @@ var _ = Describe("SceneStore", func() {
 				ContentWarnings: []string{}, Tags: []string{},
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
-			_, err := store.InviteParticipant(ctx, row.ID, "char-owner-3", "char-invitee-3")
+			_, err := store.InviteParticipant(ctx, row.ID, "char-owner-3", "char-invitee-3", nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			ok, err := store.IsParticipant(ctx, row.ID, "char-invitee-3")
@@ -1763,12 +1840,12 @@ var _ = Describe("SceneStore", func() {
 			}
 			Expect(store.CreateWithOwner(ctx, row)).NotTo(HaveOccurred())
 			// Private scene: invite member first so AddParticipant can promote.
-			_, err := store.InviteParticipant(ctx, sceneID, owner, member)
+			_, err := store.InviteParticipant(ctx, sceneID, owner, member, nil)
 			Expect(err).NotTo(HaveOccurred())
 			_, _, err = store.AddParticipant(ctx, sceneID, member)
 			Expect(err).NotTo(HaveOccurred())
 			// invitee stays in invited role (never promoted).
-			_, err = store.InviteParticipant(ctx, sceneID, owner, invitee)
+			_, err = store.InviteParticipant(ctx, sceneID, owner, invitee, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			result, err := store.ListParticipantsWithPoseMeta(ctx, sceneID)
@@ -1885,7 +1962,7 @@ var _ = Describe("SceneStore", func() {
 				"returns false for invited-only",
 				"scene-isM-3", SceneVisibilityPrivate,
 				func(store *SceneStore) {
-					_, err := store.InviteParticipant(context.Background(), "scene-isM-3", "char-alice", "char-bob")
+					_, err := store.InviteParticipant(context.Background(), "scene-isM-3", "char-alice", "char-bob", nil)
 					Expect(err).NotTo(HaveOccurred())
 				},
 				"char-bob", false, "invited-only rows MUST return false — invitation grants join, not read",
@@ -3155,3 +3232,27 @@ var _ = Describe("ReadSceneLogForExport", func() {
 		Expect(rows).To(HaveLen(3))
 	})
 })
+
+var _ = Describe("ReadSceneOOCLogForExport", func() {
+	It("returns only scene_ooc rows in id order", func() {
+		store := newTestStore()
+		ctx := context.Background()
+
+		subject := "events.test.scene.scene-export-ooc.ooc"
+		_, err := store.pool.Exec(ctx, `
+			INSERT INTO scene_log (id, subject, type, timestamp, actor_kind, actor_id, payload, schema_ver, codec)
+			SELECT decode(lpad(to_hex(i), 32, '0'), 'hex'), $1,
+			       CASE WHEN i = 2 THEN 'core-scenes:scene_idle_nudge' ELSE 'core-scenes:scene_ooc' END,
+			       (EXTRACT(EPOCH FROM NOW()) * 1e9)::BIGINT, 'character', NULL, ''::BYTEA, 1, 'identity'
+			FROM generate_series(1, 3) AS i`,
+			subject)
+		Expect(err).NotTo(HaveOccurred())
+
+		rows, err := store.ReadSceneOOCLogForExport(ctx, subject)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows).To(HaveLen(2))
+		for _, r := range rows {
+			Expect(r.Type).To(Equal("core-scenes:scene_ooc"))
+		}
+	})
+})
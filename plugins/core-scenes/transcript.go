@@ -0,0 +1,344 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// entryKindOOC marks an out-of-character line in a transcript. It is
+// transcript-only: published scenes never carry OOC content, so
+// EntryKind.IsValid deliberately rejects it.
+const entryKindOOC EntryKind = "ooc"
+
+// transcriptEventKinds maps the event types a transcript assembles to their
+// entry kind: the three IC content kinds plus scene_ooc.
+var transcriptEventKinds = map[string]EntryKind{
+	"core-scenes:scene_pose": EntryKindPose,
+	"core-scenes:scene_say":  EntryKindSay,
+	"core-scenes:scene_emit": EntryKindEmit,
+	"core-scenes:scene_ooc":  entryKindOOC,
+}
+
+// transcriptRenderMime maps the supported transcript formats to their MIME
+// types. The key set is the authoritative supported-format list.
+var transcriptRenderMime = map[string]string{
+	"text": "text/plain",
+	"html": "text/html",
+	"json": "application/json",
+}
+
+// transcriptFileExt maps the supported transcript formats to file extensions.
+var transcriptFileExt = map[string]string{
+	"text": ".txt",
+	"html": ".html",
+	"json": ".json",
+}
+
+// transcriptTimeLayout is the UTC timestamp prefix on text and HTML lines.
+const transcriptTimeLayout = "2006-01-02 15:04:05"
+
+// TranscriptEntry is one line of an exported scene transcript. Speaker is the
+// actor's display name at emit time (falling back to the actor ID); it is
+// empty for emits, which are actorless.
+type TranscriptEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Speaker   string    `json:"speaker,omitempty"`
+	Kind      EntryKind `json:"kind"`
+	Content   string    `json:"content"`
+	// NoSpace is set for semiposes, which join the speaker and content
+	// without a space ("Aria's tea steams.").
+	NoSpace bool `json:"-"`
+}
+
+// transcriptDocument is the JSON transcript envelope.
+type transcriptDocument struct {
+	SceneID string            `json:"scene_id"`
+	Title   string            `json:"title"`
+	Entries []TranscriptEntry `json:"entries"`
+}
+
+// transcriptRow pairs a decoded entry with its scene_log id so the IC and OOC
+// streams can be merged back into event order.
+type transcriptRow struct {
+	id    []byte
+	entry TranscriptEntry
+}
+
+// ExportTranscript renders a scene's transcript — every pose, say, and emit on
+// the IC stream interleaved with the OOC stream's chat lines in event (ULID)
+// order — as plain text, HTML, or JSON. Capture is automatic: scene_log
+// already holds every event on both streams, so the export only assembles it.
+//
+// Gating is ABAC on action "export_transcript" (participants and staff per
+// the manifest policies), failing closed when no evaluator is configured. The
+// gate runs before the scene is loaded so a denied caller learns nothing
+// about the scene. With redact_ooc the OOC stream is never read.
+//
+// Each stream is decrypted against its own subject (the AAD each row was
+// bound to) through the same seam as ExportSceneLog, and each is held to the
+// exportLogMaxRows ceiling (SCENE_EXPORT_TOO_LARGE).
+func (s *SceneServiceImpl) ExportTranscript(ctx context.Context, req *scenev1.ExportTranscriptRequest) (*scenev1.ExportTranscriptResponse, error) {
+	ctx, span := startSpan(ctx, "scene.service.export_transcript",
+		attribute.String("scene_id", req.GetSceneId()),
+		attribute.String("character_id", req.GetCharacterId()),
+		attribute.String("format", req.GetFormat()),
+		attribute.Bool("redact_ooc", req.GetRedactOoc()))
+	defer span.End()
+
+	// 0. Defense-in-depth identity cross-check: the request's character must
+	// be the authenticated actor the host stamped on the call.
+	if kind, id, ok := pluginsdk.ActorMetadataFromIncomingContext(ctx); ok &&
+		kind == pluginsdk.ActorCharacter && id != req.GetCharacterId() {
+		slog.WarnContext(ctx, "scene.service.export_transcript actor metadata mismatch",
+			"metadata_character_id", id,
+			"request_character_id", req.GetCharacterId(),
+			"scene_id", req.GetSceneId())
+		return nil, status.Error(codes.PermissionDenied, "not permitted to export this transcript") //nolint:wrapcheck // gRPC status is the wire contract; opaque per grpc-errors.md
+	}
+
+	// 1. Format validation — resource-independent, so it leaks nothing.
+	mime, ok := transcriptRenderMime[req.GetFormat()]
+	if !ok {
+		fmtErr := oops.Code("SCENE_EXPORT_BAD_FORMAT").
+			With("format", req.GetFormat()).Errorf("unsupported transcript format")
+		recordError(span, fmtErr)
+		return nil, mapStoreErr(ctx, fmtErr)
+	}
+
+	// 2. ABAC gate — fails closed when no evaluator is configured.
+	if s.evaluator == nil {
+		slog.WarnContext(ctx, "scene.service.export_transcript evaluator not configured",
+			"subject_id", req.GetCharacterId(),
+			"scene_id", req.GetSceneId())
+		return nil, status.Error(codes.Internal, "permission check unavailable") //nolint:wrapcheck // gRPC status is the wire contract; fail-closed opaque error
+	}
+	dec, evalErr := s.evaluator.Evaluate(ctx, "export_transcript", "scene:"+req.GetSceneId())
+	if evalErr != nil {
+		recordError(span, evalErr)
+		errutil.LogErrorContext(ctx, "scene.service.export_transcript evaluation failed", evalErr)
+		return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
+	}
+	if !dec.Allowed {
+		return nil, status.Error(codes.PermissionDenied, "not permitted to export this transcript") //nolint:wrapcheck // gRPC status is the wire contract
+	}
+
+	// 3. Read the scene row for the title (document heading and filename).
+	scene, err := s.store.Get(ctx, req.GetSceneId())
+	if err != nil {
+		recordError(span, err)
+		return nil, mapStoreErr(ctx, err)
+	}
+
+	// 4. Read and decrypt each stream against its own AAD subject.
+	icSubject := dotStyleSceneSubjectIC(s.gameID, req.GetSceneId())
+	icRows, err := s.store.ReadSceneLogForExport(ctx, icSubject)
+	if err != nil {
+		recordError(span, err)
+		return nil, mapStoreErr(ctx, err)
+	}
+	rows, err := s.transcriptRows(ctx, req.GetSceneId(), icSubject, icRows)
+	if err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	if !req.GetRedactOoc() {
+		oocSubject := dotStyleSceneSubjectOOC(s.gameID, req.GetSceneId())
+		oocRows, readErr := s.store.ReadSceneOOCLogForExport(ctx, oocSubject)
+		if readErr != nil {
+			recordError(span, readErr)
+			return nil, mapStoreErr(ctx, readErr)
+		}
+		ooc, decErr := s.transcriptRows(ctx, req.GetSceneId(), oocSubject, oocRows)
+		if decErr != nil {
+			recordError(span, decErr)
+			return nil, decErr
+		}
+		rows = append(rows, ooc...)
+	}
+
+	// 5. Merge the streams back into event order. scene_log ids are ULIDs,
+	// so byte order is emit order.
+	slices.SortStableFunc(rows, func(a, b transcriptRow) int { return bytes.Compare(a.id, b.id) })
+	entries := make([]TranscriptEntry, len(rows))
+	for i := range rows {
+		entries[i] = rows[i].entry
+	}
+
+	// 6. Render.
+	var content []byte
+	switch req.GetFormat() {
+	case "text":
+		content = []byte(renderTranscriptText(scene.Title, entries))
+	case "html":
+		content = []byte(renderTranscriptHTML(scene.Title, entries))
+	case "json":
+		var renderErr error
+		content, renderErr = renderTranscriptJSON(req.GetSceneId(), scene.Title, entries)
+		if renderErr != nil {
+			recordError(span, renderErr)
+			errutil.LogErrorContext(ctx, "scene.service.export_transcript render failed", renderErr)
+			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
+		}
+	}
+
+	slug := slugify(scene.Title)
+	if slug == "" {
+		slug = "scene"
+	}
+	filename := slug + "-transcript" + transcriptFileExt[req.GetFormat()]
+
+	slog.InfoContext(ctx, "scene.service.export_transcript ok",
+		"scene_id", req.GetSceneId(),
+		"character_id", req.GetCharacterId(),
+		"format", req.GetFormat(),
+		"redact_ooc", req.GetRedactOoc(),
+		"entry_count", len(entries),
+		"filename", filename)
+
+	return &scenev1.ExportTranscriptResponse{
+		Content:  content,
+		MimeType: mime,
+		Filename: filename,
+	}, nil
+}
+
+// transcriptRows decrypts one stream's rows against fullSubject and decodes
+// each into a transcriptRow. A decode failure or a type outside
+// transcriptEventKinds fails the export closed.
+func (s *SceneServiceImpl) transcriptRows(ctx context.Context, sceneID, fullSubject string, logRows []LogRow) ([]transcriptRow, error) {
+	plaintexts, err := s.decryptExportRows(ctx, "scene.service.export_transcript", sceneID, fullSubject, logRows)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]transcriptRow, 0, len(plaintexts))
+	for i := range plaintexts {
+		entry, decodeErr := decodeTranscriptEntry(logRows[i], plaintexts[i])
+		if decodeErr != nil {
+			errutil.LogErrorContext(ctx, "scene.service.export_transcript entry decode failed", decodeErr,
+				"scene_id", sceneID, "event_type", logRows[i].Type)
+			return nil, status.Error(codes.Internal, "internal error") //nolint:wrapcheck // gRPC status is the wire contract; opaque Internal per grpc-errors.md
+		}
+		out = append(out, transcriptRow{id: logRows[i].ID, entry: entry})
+	}
+	return out, nil
+}
+
+// decodeTranscriptEntry decodes a decrypted CommunicationContent payload into
+// a TranscriptEntry stamped with the row's timestamp.
+func decodeTranscriptEntry(row LogRow, plaintext []byte) (TranscriptEntry, error) {
+	kind, ok := transcriptEventKinds[row.Type]
+	if !ok {
+		return TranscriptEntry{}, oops.Code("SCENE_TRANSCRIPT_UNKNOWN_EVENT_TYPE").
+			With("event_type", row.Type).Errorf("event type is not transcript content")
+	}
+	var pl struct {
+		ActorID          string `json:"actor_id"`
+		ActorDisplayName string `json:"actor_display_name"`
+		Text             string `json:"text"`
+		NoSpace          bool   `json:"no_space"`
+	}
+	if err := json.Unmarshal(plaintext, &pl); err != nil {
+		return TranscriptEntry{}, oops.Code("SCENE_TRANSCRIPT_ENTRY_DECODE_FAILED").
+			With("event_type", row.Type).Wrap(err)
+	}
+	speaker := pl.ActorDisplayName
+	if speaker == "" {
+		speaker = pl.ActorID
+	}
+	return TranscriptEntry{
+		Timestamp: row.Timestamp.Time().UTC(),
+		Speaker:   speaker,
+		Kind:      kind,
+		Content:   pl.Text,
+		NoSpace:   pl.NoSpace,
+	}, nil
+}
+
+// transcriptLine phrases one entry without markup: poses and says follow
+// renderPlainText, emits are bare content, and OOC lines are tagged <OOC>.
+func transcriptLine(e TranscriptEntry) string {
+	switch e.Kind {
+	case EntryKindPose:
+		if e.NoSpace {
+			return e.Speaker + e.Content
+		}
+		return e.Speaker + " " + e.Content
+	case EntryKindSay:
+		return fmt.Sprintf("%s says, \"%s\"", e.Speaker, e.Content)
+	case entryKindOOC:
+		return fmt.Sprintf("<OOC> %s: %s", e.Speaker, e.Content)
+	default:
+		return e.Content
+	}
+}
+
+// renderTranscriptText renders a transcript as plain text: a title line, then
+// one "[timestamp] line" per entry. Content is emitted verbatim.
+func renderTranscriptText(title string, entries []TranscriptEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\n", title)
+	if len(entries) == 0 {
+		b.WriteString("No content was recorded for this scene.\n")
+		return b.String()
+	}
+	for _, e := range entries {
+		fmt.Fprintf(&b, "[%s] %s\n", e.Timestamp.Format(transcriptTimeLayout), transcriptLine(e))
+	}
+	return b.String()
+}
+
+// renderTranscriptHTML renders a transcript as a standalone HTML document,
+// one list item per entry classed by kind. Every user-authored string is
+// escaped so content cannot inject markup.
+func renderTranscriptHTML(title string, entries []TranscriptEntry) string {
+	t := html.EscapeString(title)
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n<h1>%s</h1>\n", t, t)
+	if len(entries) == 0 {
+		b.WriteString("<p>No content was recorded for this scene.</p>\n")
+	} else {
+		b.WriteString("<ol class=\"transcript\">\n")
+		for _, e := range entries {
+			fmt.Fprintf(&b, "<li class=\"%s\"><time datetime=\"%s\">%s</time> %s</li>\n",
+				html.EscapeString(string(e.Kind)),
+				e.Timestamp.Format(time.RFC3339),
+				e.Timestamp.Format(transcriptTimeLayout),
+				html.EscapeString(transcriptLine(e)))
+		}
+		b.WriteString("</ol>\n")
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// renderTranscriptJSON renders a transcript as one indented JSON document. An
+// empty transcript yields an empty entries array, never null.
+func renderTranscriptJSON(sceneID, title string, entries []TranscriptEntry) ([]byte, error) {
+	if entries == nil {
+		entries = []TranscriptEntry{}
+	}
+	out, err := json.MarshalIndent(transcriptDocument{SceneID: sceneID, Title: title, Entries: entries}, "", "  ")
+	if err != nil {
+		return nil, oops.Code("SCENE_TRANSCRIPT_JSON_MARSHAL_FAILED").Wrap(err)
+	}
+	return append(out, '\n'), nil
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/holomush/holomush/internal/pgnanos"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// transcriptT0 anchors the fixture timestamps.
+var transcriptT0 = time.Date(2026, 3, 14, 20, 0, 0, 0, time.UTC)
+
+// newTranscriptFixture seeds the export fixture with an allowing evaluator
+// and an interleaved IC/OOC log: pose (IC), ooc (OOC), say (IC).
+func newTranscriptFixture(t *testing.T) (*fakeStore, *SceneServiceImpl, *recordingExportDecryptor, *recordingEvaluator, string, string) {
+	t.Helper()
+	store, svc, dec, ownerID, sceneID := newExportFixture(t)
+	ev := &recordingEvaluator{decision: pluginsdk.EvaluateDecision{Allowed: true}}
+	svc.SetHostEvaluator(ev)
+
+	installTranscriptRow(&store.exportLogRows, dec, "01A", "core-scenes:scene_pose", 0,
+		`{"actor_id":"char-aria","actor_display_name":"Aria","text":"pours the tea."}`)
+	installTranscriptRow(&store.exportOOCRows, dec, "01B", "core-scenes:scene_ooc", time.Minute,
+		`{"actor_id":"char-bram","actor_display_name":"Bram","text":"brb, doorbell"}`)
+	installTranscriptRow(&store.exportLogRows, dec, "01C", "core-scenes:scene_say", 2*time.Minute,
+		`{"actor_id":"char-aria","actor_display_name":"Aria","text":"<b>Milk?</b>"}`)
+	return store, svc, dec, ev, ownerID, sceneID
+}
+
+// installTranscriptRow appends a row to rows and registers its plaintext.
+func installTranscriptRow(rows *[]LogRow, dec *recordingExportDecryptor, id, eventType string, offset time.Duration, plaintext string) {
+	*rows = append(*rows, LogRow{
+		ID:        []byte(id),
+		Type:      eventType,
+		Timestamp: pgnanos.From(transcriptT0.Add(offset)),
+		Codec:     "identity",
+	})
+	dec.plaintextByID[id] = []byte(plaintext)
+}
+
+func exportTranscript(t *testing.T, svc *SceneServiceImpl, characterID, sceneID, format string, redact bool) (*scenev1.ExportTranscriptResponse, error) {
+	t.Helper()
+	return svc.ExportTranscript(context.Background(), &scenev1.ExportTranscriptRequest{
+		CharacterId: characterID,
+		SceneId:     sceneID,
+		Format:      format,
+		RedactOoc:   redact,
+	})
+}
+
+func TestExportTranscriptTextInterleavesStreamsInEventOrder(t *testing.T) {
+	t.Parallel()
+	store, svc, dec, ev, ownerID, sceneID := newTranscriptFixture(t)
+
+	resp, err := exportTranscript(t, svc, ownerID, sceneID, "text", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Tea at the Manor\n\n"+
+		"[2026-03-14 20:00:00] Aria pours the tea.\n"+
+		"[2026-03-14 20:01:00] <OOC> Bram: brb, doorbell\n"+
+		"[2026-03-14 20:02:00] Aria says, \"<b>Milk?</b>\"\n",
+		string(resp.GetContent()))
+	assert.Equal(t, "text/plain", resp.GetMimeType())
+	assert.Equal(t, "tea-at-the-manor-transcript.txt", resp.GetFilename())
+
+	require.Len(t, ev.calls, 1)
+	assert.Equal(t, evaluateCall{action: "export_transcript", resource: "scene:" + sceneID}, ev.calls[0])
+	assert.Equal(t, dotStyleSceneSubjectIC(svc.gameID, sceneID), store.exportLogSubject)
+	assert.Equal(t, dotStyleSceneSubjectOOC(svc.gameID, sceneID), store.exportOOCSubject)
+	// Each stream is decrypted against its own AAD subject.
+	assert.Equal(t, []string{
+		dotStyleSceneSubjectIC(svc.gameID, sceneID),
+		dotStyleSceneSubjectIC(svc.gameID, sceneID),
+		dotStyleSceneSubjectOOC(svc.gameID, sceneID),
+	}, dec.subjects)
+}
+
+func TestExportTranscriptRedactOOCSkipsTheOOCStream(t *testing.T) {
+	t.Parallel()
+	store, svc, _, _, ownerID, sceneID := newTranscriptFixture(t)
+	store.exportOOCErr = errors.New("OOC stream must not be read when redacting")
+
+	resp, err := exportTranscript(t, svc, ownerID, sceneID, "text", true)
+	require.NoError(t, err)
+	assert.NotContains(t, string(resp.GetContent()), "OOC")
+	assert.NotContains(t, string(resp.GetContent()), "doorbell")
+	assert.Contains(t, string(resp.GetContent()), "pours the tea.")
+	assert.Empty(t, store.exportOOCSubject)
+}
+
+func TestExportTranscriptHTMLEscapesContent(t *testing.T) {
+	t.Parallel()
+	_, svc, _, _, ownerID, sceneID := newTranscriptFixture(t)
+
+	resp, err := exportTranscript(t, svc, ownerID, sceneID, "html", false)
+	require.NoError(t, err)
+	out := string(resp.GetContent())
+	assert.Equal(t, "text/html", resp.GetMimeType())
+	assert.Equal(t, "tea-at-the-manor-transcript.html", resp.GetFilename())
+	assert.Contains(t, out, "<title>Tea at the Manor</title>")
+	assert.Contains(t, out, `<li class="ooc"><time datetime="2026-03-14T20:01:00Z">2026-03-14 20:01:00</time> &lt;OOC&gt; Bram: brb, doorbell</li>`)
+	assert.Contains(t, out, "&lt;b&gt;Milk?&lt;/b&gt;")
+	assert.NotContains(t, out, "<b>Milk?</b>")
+}
+
+func TestExportTranscriptJSONCarriesKindsAndTimestamps(t *testing.T) {
+	t.Parallel()
+	_, svc, _, _, ownerID, sceneID := newTranscriptFixture(t)
+
+	resp, err := exportTranscript(t, svc, ownerID, sceneID, "json", false)
+	require.NoError(t, err)
+	assert.Equal(t, "application/json", resp.GetMimeType())
+
+	var doc transcriptDocument
+	require.NoError(t, json.Unmarshal(resp.GetContent(), &doc))
+	assert.Equal(t, sceneID, doc.SceneID)
+	assert.Equal(t, "Tea at the Manor", doc.Title)
+	require.Len(t, doc.Entries, 3)
+	assert.Equal(t, []EntryKind{EntryKindPose, entryKindOOC, EntryKindSay},
+		[]EntryKind{doc.Entries[0].Kind, doc.Entries[1].Kind, doc.Entries[2].Kind})
+	assert.Equal(t, "Bram", doc.Entries[1].Speaker)
+	assert.True(t, transcriptT0.Add(time.Minute).Equal(doc.Entries[1].Timestamp))
+}
+
+func TestExportTranscriptJSONEmptySceneHasEmptyEntries(t *testing.T) {
+	t.Parallel()
+	_, svc, _, ownerID, sceneID := newExportFixture(t)
+	svc.SetHostEvaluator(allowEvaluator{})
+
+	resp, err := exportTranscript(t, svc, ownerID, sceneID, "json", false)
+	require.NoError(t, err)
+	assert.Contains(t, string(resp.GetContent()), `"entries": []`)
+}
+
+func TestExportTranscriptRejectsUnknownFormatBeforeABAC(t *testing.T) {
+	t.Parallel()
+	_, svc, _, ev, ownerID, sceneID := newTranscriptFixture(t)
+
+	_, err := exportTranscript(t, svc, ownerID, sceneID, "markdown", false)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, ev.calls)
+}
+
+func TestExportTranscriptDeniedByABACNeverReadsTheLog(t *testing.T) {
+	t.Parallel()
+	store, svc, dec, _, _, sceneID := newTranscriptFixture(t)
+	svc.SetHostEvaluator(denyEvaluator{})
+
+	_, err := exportTranscript(t, svc, "char-outsider", sceneID, "text", false)
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, store.exportLogSubject)
+	assert.Empty(t, dec.calls)
+}
+
+func TestExportTranscriptFailsClosedWithoutEvaluator(t *testing.T) {
+	t.Parallel()
+	_, svc, dec, ownerID, sceneID := newExportFixture(t)
+
+	_, err := exportTranscript(t, svc, ownerID, sceneID, "text", false)
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Empty(t, dec.calls)
+}
+
+func TestExportTranscriptRejectsActorMetadataMismatch(t *testing.T) {
+	t.Parallel()
+	_, svc, _, ev, ownerID, sceneID := newTranscriptFixture(t)
+
+	_, err := svc.ExportTranscript(watchCtxWithActorMetadata(pluginsdk.ActorCharacter, "char-someone-else"),
+		&scenev1.ExportTranscriptRequest{CharacterId: ownerID, SceneId: sceneID, Format: "text"})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, ev.calls)
+}
+
+func TestExportTranscriptTooLargeMapsToFailedPrecondition(t *testing.T) {
+	t.Parallel()
+	store, svc, _, _, ownerID, sceneID := newTranscriptFixture(t)
+	store.exportOOCErr = exportTooLargeErr()
+
+	_, err := exportTranscript(t, svc, ownerID, sceneID, "text", false)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestTranscriptLineJoinsSemiposeWithoutSpace(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Aria's tea steams.",
+		transcriptLine(TranscriptEntry{Kind: EntryKindPose, Speaker: "Aria", Content: "'s tea steams.", NoSpace: true}))
+	assert.Equal(t, "The clock chimes.",
+		transcriptLine(TranscriptEntry{Kind: EntryKindEmit, Content: "The clock chimes."}))
+}
+
+func TestDecodeTranscriptEntryFallsBackToActorID(t *testing.T) {
+	t.Parallel()
+	e, err := decodeTranscriptEntry(LogRow{Type: "core-scenes:scene_ooc"}, []byte(`{"actor_id":"char-x","text":"hi"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "char-x", e.Speaker)
+
+	_, err = decodeTranscriptEntry(LogRow{Type: "core-scenes:scene_idle_nudge"}, []byte(`{}`))
+	require.Error(t, err)
+}
+
+// TestPluginManifestDeclaresExportTranscriptPolicies pins the ABAC policies
+// gating ExportTranscript: participants and staff may export.
+func TestPluginManifestDeclaresExportTranscriptPolicies(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile("plugin.yaml")
+	require.NoError(t, err)
+	manifest := string(data)
+
+	assert.Contains(t, manifest, "export-transcript-as-participant")
+	assert.Contains(t, manifest, "staff-export-transcript")
+	assert.Contains(t, manifest, `action in ["export_transcript"], resource is scene) when { principal.id in resource.scene.participants`)
+	assert.Contains(t, manifest, `action in ["export_transcript"], resource is scene) when { "staff" in principal.character.roles`)
+}
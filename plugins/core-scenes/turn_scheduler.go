@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/samber/oops"
+
+	"github.com/holomush/holomush/pkg/errutil"
+)
+
+// sceneTurnTimeoutStore is the narrow persistence interface the turn
+// scheduler needs, mirroring sceneIdleStore for idleScheduler.
+type sceneTurnTimeoutStore interface {
+	// ListSceneTurnsPastTimeout returns active scenes whose current turn
+	// started at least timeoutSecs before nowNs (epoch-nanoseconds, Go-clock).
+	ListSceneTurnsPastTimeout(ctx context.Context, nowNs int64, timeoutSecs int) ([]string, error)
+}
+
+// turnTimeouter passes a timed-out turn on. *SceneServiceImpl satisfies it
+// (turns.go::timeoutTurn), which re-checks the turn's age under the row
+// lock and emits the scene_turn_changed_ic notice.
+type turnTimeouter interface {
+	timeoutTurn(ctx context.Context, sceneID string, now time.Time) error
+}
+
+// turnScheduler periodically sweeps for turn-tracker turns older than the
+// configured turn_timeout and passes them on. It mirrors idleScheduler: an
+// injected now func makes the sweep deterministically testable, and sweep is
+// package-private so tests can call it directly without waiting for ticks.
+// Init only starts it when turn_timeout is positive.
+type turnScheduler struct {
+	store    sceneTurnTimeoutStore
+	turns    turnTimeouter
+	interval time.Duration
+	timeout  time.Duration
+	now      func() time.Time
+}
+
+// Run starts the scheduler loop. It ticks at s.interval and calls sweep on each
+// tick. The loop exits when ctx is cancelled (plugin shutdown).
+func (s *turnScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sweep(ctx); err != nil {
+				errutil.LogErrorContext(ctx, "turn scheduler sweep failed", err)
+			}
+		}
+	}
+}
+
+// sweep is one pass: time out every turn past the threshold. Per-scene
+// failures are WARN-logged and the batch continues; one bad row MUST NOT
+// abort the sweep (idleScheduler precedent).
+func (s *turnScheduler) sweep(ctx context.Context) error {
+	now := s.now()
+	nowNs := now.UnixNano() // pgnanos-exempt: scheduler clock — injected now() returns Go-clock time; result is passed as a parameter to SQL (noremoteclockcompare-compliant)
+
+	sceneIDs, err := s.store.ListSceneTurnsPastTimeout(ctx, nowNs, int(s.timeout.Seconds()))
+	if err != nil {
+		return oops.Code("SCENE_TURN_SCHEDULER_SCAN_FAILED").Wrap(err)
+	}
+	for _, id := range sceneIDs {
+		if err := s.turns.timeoutTurn(ctx, id, now); err != nil {
+			slog.WarnContext(ctx, "turn scheduler: timeout failed",
+				"scene_id", id, "err", err)
+		}
+	}
+	return nil
+}
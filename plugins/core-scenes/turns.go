@@ -0,0 +1,463 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+// Turn-change reasons carried in the scene_turn_changed_ic payload. The
+// first three mirror UpdateSceneTurns actions; the rest are automatic.
+const (
+	turnReasonAdded   = "added"
+	turnReasonRemoved = "removed"
+	turnReasonSkipped = "skipped"
+	turnReasonPosed   = "posed"   // the current character posed (auto-advance)
+	turnReasonTimeout = "timeout" // the turn outlived turn_timeout
+	turnReasonLeft    = "left"    // the character left or was kicked from the scene
+)
+
+// errTurnUnchanged is returned by an UpdateSceneTurns mutate func that
+// decides, under the row lock, that there is nothing to do. The store rolls
+// the transaction back and passes it through; callers treat it as a no-op.
+var errTurnUnchanged = errors.New("turn tracker unchanged")
+
+// TurnQueue is a scene's turn tracker: an ordered participant queue and the
+// position of the character whose turn it is. Methods are pure — no DB, no
+// clock — so the queue rules are unit-testable; the store persists the
+// result (scene_turns, migration 000012).
+type TurnQueue struct {
+	SceneID       string
+	Queue         []string
+	CurrentIndex  int
+	Round         int
+	TurnStartedAt time.Time
+}
+
+// newTurnQueue returns an empty tracker for a scene with no scene_turns row.
+func newTurnQueue(sceneID string, now time.Time) *TurnQueue {
+	return &TurnQueue{SceneID: sceneID, Round: 1, TurnStartedAt: now}
+}
+
+// Current returns the character whose turn it is, or "" for an empty queue.
+func (q *TurnQueue) Current() string {
+	if len(q.Queue) == 0 {
+		return ""
+	}
+	return q.Queue[q.CurrentIndex]
+}
+
+// Next returns the character who goes after the current one, or "" when
+// the queue has fewer than two entries.
+func (q *TurnQueue) Next() string {
+	if len(q.Queue) < 2 {
+		return ""
+	}
+	return q.Queue[(q.CurrentIndex+1)%len(q.Queue)]
+}
+
+// Contains reports whether characterID is in the queue.
+func (q *TurnQueue) Contains(characterID string) bool {
+	return slices.Contains(q.Queue, characterID)
+}
+
+// Add appends characterID to the end of the queue. Adding to an empty
+// queue starts the first turn at now.
+func (q *TurnQueue) Add(characterID string, now time.Time) error {
+	if q.Contains(characterID) {
+		return oops.Code("SCENE_TURN_ALREADY_QUEUED").
+			With("scene_id", q.SceneID).With("character_id", characterID).
+			Errorf("character is already in the turn queue")
+	}
+	if len(q.Queue) == 0 {
+		q.CurrentIndex = 0
+		q.TurnStartedAt = now
+	}
+	q.Queue = append(q.Queue, characterID)
+	return nil
+}
+
+// Remove drops characterID from the queue. Removing the current character
+// hands the turn to whoever followed them, starting a fresh turn at now;
+// removing anyone else leaves the current turn running.
+func (q *TurnQueue) Remove(characterID string, now time.Time) error {
+	i := slices.Index(q.Queue, characterID)
+	if i < 0 {
+		return oops.Code("SCENE_TURN_NOT_QUEUED").
+			With("scene_id", q.SceneID).With("character_id", characterID).
+			Errorf("character is not in the turn queue")
+	}
+	q.Queue = slices.Delete(q.Queue, i, i+1)
+	switch {
+	case len(q.Queue) == 0:
+		q.CurrentIndex = 0
+	case i < q.CurrentIndex:
+		q.CurrentIndex--
+	case i == q.CurrentIndex:
+		if q.CurrentIndex == len(q.Queue) {
+			q.CurrentIndex = 0
+			q.Round++
+		}
+		q.TurnStartedAt = now
+	}
+	return nil
+}
+
+// Advance passes the turn to the next character, wrapping to the head of
+// the queue (and the next round) after the last, and starts the new turn at
+// now. It returns the character whose turn ended; an empty queue is a
+// no-op returning "".
+func (q *TurnQueue) Advance(now time.Time) string {
+	if len(q.Queue) == 0 {
+		return ""
+	}
+	prev := q.Current()
+	q.CurrentIndex++
+	if q.CurrentIndex == len(q.Queue) {
+		q.CurrentIndex = 0
+		q.Round++
+	}
+	q.TurnStartedAt = now
+	return prev
+}
+
+// toProto maps the tracker to its wire form. timeout is the configured
+// turn_timeout; zero omits turn_deadline.
+func (q *TurnQueue) toProto(timeout time.Duration) *scenev1.SceneTurns {
+	out := &scenev1.SceneTurns{
+		Queue:              slices.Clone(q.Queue),
+		CurrentCharacterId: q.Current(),
+		NextCharacterId:    q.Next(),
+		Round:              uint32(q.Round), //nolint:gosec // round is a positive counter (CHECK round >= 1)
+	}
+	if len(q.Queue) > 0 {
+		out.TurnStartedAt = timestamppb.New(q.TurnStartedAt)
+		if timeout > 0 {
+			out.TurnDeadline = timestamppb.New(q.TurnStartedAt.Add(timeout))
+		}
+	}
+	return out
+}
+
+// GetSceneTurns returns the scene's turn tracker. Same INV-SCENE-60 gate as
+// GetPoseOrder: the caller MUST be an owner or member, checked before any
+// scene lookup so non-participants cannot probe for scene existence, and
+// the ABAC engine is not consulted. A scene without a tracker reads as an
+// empty queue.
+func (s *SceneServiceImpl) GetSceneTurns(ctx context.Context, req *scenev1.GetSceneTurnsRequest) (*scenev1.GetSceneTurnsResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.get_scene_turns",
+		attribute.String("subject_id", req.GetCharacterId()),
+		attribute.String("scene_id", req.GetSceneId()),
+	)
+	defer span.End()
+
+	if err := s.requireTurnParticipant(ctx, req.GetSceneId(), req.GetCharacterId()); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+
+	q, err := s.store.GetSceneTurns(ctx, req.GetSceneId())
+	if err != nil {
+		recordError(span, err)
+		slog.WarnContext(ctx, "scene.service.get_scene_turns lookup error",
+			"scene_id", req.GetSceneId(), "error", err)
+		return nil, status.Error(codes.Internal, "turn tracker lookup failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if q == nil {
+		q = newTurnQueue(req.GetSceneId(), time.Now())
+	}
+	return &scenev1.GetSceneTurnsResponse{Turns: q.toProto(s.cfg.TurnTimeout)}, nil
+}
+
+// UpdateSceneTurns applies one turn-tracker operation. The caller MUST be
+// an owner or member (INV-SCENE-60 plugin-code gate, as GetSceneTurns).
+// Beyond that:
+//   - "add" and "remove" act on target_character_id (default: the caller);
+//     only the scene owner may name someone else, and an added character
+//     MUST be an owner or member too.
+//   - "skip" passes the current turn; only the scene owner or the character
+//     whose turn it is may skip.
+//
+// Ended and archived scenes are read-only (FailedPrecondition). A successful
+// operation emits scene_turn_changed_ic.
+func (s *SceneServiceImpl) UpdateSceneTurns(ctx context.Context, req *scenev1.UpdateSceneTurnsRequest) (*scenev1.UpdateSceneTurnsResponse, error) {
+	ctx, span := startSpan(
+		ctx, "scene.service.update_scene_turns",
+		attribute.String("subject_id", req.GetCharacterId()),
+		attribute.String("scene_id", req.GetSceneId()),
+		attribute.String("action", req.GetAction()),
+	)
+	defer span.End()
+
+	sceneID, actorID := req.GetSceneId(), req.GetCharacterId()
+	if err := s.requireTurnParticipant(ctx, sceneID, actorID); err != nil {
+		recordError(span, err)
+		return nil, err
+	}
+	sceneRow, err := s.store.Get(ctx, sceneID)
+	if err != nil {
+		recordError(span, err)
+		var oe oops.OopsError
+		if errors.As(err, &oe) && oe.Code() == "SCENE_NOT_FOUND" {
+			return nil, status.Errorf(codes.NotFound, "scene not found: %s", sceneID)
+		}
+		return nil, status.Error(codes.Internal, "scene lookup failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if st := SceneState(sceneRow.State); st == SceneStateEnded || st == SceneStateArchived {
+		return nil, status.Errorf(codes.FailedPrecondition, "scene is %s", sceneRow.State)
+	}
+	isOwner := sceneRow.OwnerID == actorID
+
+	target := req.GetTargetCharacterId()
+	if target == "" {
+		target = actorID
+	}
+	var (
+		mutate func(*TurnQueue) error
+		reason string
+	)
+	now := time.Now()
+	switch req.GetAction() {
+	case "add":
+		if target != actorID && !isOwner {
+			return nil, status.Error(codes.PermissionDenied, "only the scene owner may add other characters") //nolint:wrapcheck // gRPC status errors pass through as-is
+		}
+		if target != actorID {
+			if err := s.requireTurnParticipant(ctx, sceneID, target); err != nil {
+				if status.Code(err) == codes.PermissionDenied {
+					return nil, status.Errorf(codes.FailedPrecondition, "%s is not a participant of the scene", target)
+				}
+				return nil, err
+			}
+		}
+		reason = turnReasonAdded
+		mutate = func(q *TurnQueue) error { return q.Add(target, now) }
+	case "remove":
+		if target != actorID && !isOwner {
+			return nil, status.Error(codes.PermissionDenied, "only the scene owner may remove other characters") //nolint:wrapcheck // gRPC status errors pass through as-is
+		}
+		reason = turnReasonRemoved
+		mutate = func(q *TurnQueue) error { return q.Remove(target, now) }
+	case "skip":
+		reason = turnReasonSkipped
+		mutate = func(q *TurnQueue) error {
+			if len(q.Queue) == 0 {
+				return oops.Code("SCENE_TURN_QUEUE_EMPTY").With("scene_id", sceneID).Errorf("turn queue is empty")
+			}
+			if !isOwner && q.Current() != actorID {
+				return oops.Code("SCENE_TURN_NOT_YOURS").With("scene_id", sceneID).Errorf("not your turn")
+			}
+			target = q.Advance(now)
+			return nil
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown turn action %q", req.GetAction())
+	}
+
+	q, err := s.store.UpdateSceneTurns(ctx, sceneID, now, mutate)
+	if err != nil {
+		recordError(span, err)
+		return nil, mapTurnError(ctx, err, sceneID)
+	}
+	s.emitSceneTurnChangedIC(ctx, q, actorID, reason, target)
+
+	slog.InfoContext(ctx, "scene.service.update_scene_turns ok",
+		"subject_id", actorID, "scene_id", sceneID, "action", req.GetAction(),
+		"target_id", target, "current_id", q.Current())
+	return &scenev1.UpdateSceneTurnsResponse{Turns: q.toProto(s.cfg.TurnTimeout)}, nil
+}
+
+// requireTurnParticipant is the INV-SCENE-60 participant gate shared by the
+// turn RPCs: PermissionDenied for non-participants (including a missing
+// scene), Internal on lookup failure.
+func (s *SceneServiceImpl) requireTurnParticipant(ctx context.Context, sceneID, characterID string) error {
+	ok, err := s.store.IsParticipant(ctx, sceneID, characterID)
+	if err != nil {
+		slog.WarnContext(ctx, "scene.service.turns participant check error",
+			"subject_id", characterID, "scene_id", sceneID, "error", err)
+		return status.Error(codes.Internal, "participant check failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	if !ok {
+		return status.Error(codes.PermissionDenied, "not a participant of scene") //nolint:wrapcheck // gRPC status errors pass through as-is
+	}
+	return nil
+}
+
+// mapTurnError translates TurnQueue rule violations into gRPC status
+// errors; anything else is logged and surfaced as Internal.
+func mapTurnError(ctx context.Context, err error, sceneID string) error {
+	var oe oops.OopsError
+	if errors.As(err, &oe) {
+		switch oe.Code() {
+		case "SCENE_TURN_ALREADY_QUEUED":
+			return status.Error(codes.AlreadyExists, "already in the turn queue") //nolint:wrapcheck // gRPC status errors pass through as-is
+		case "SCENE_TURN_NOT_QUEUED":
+			return status.Error(codes.NotFound, "not in the turn queue") //nolint:wrapcheck // gRPC status errors pass through as-is
+		case "SCENE_TURN_QUEUE_EMPTY":
+			return status.Error(codes.FailedPrecondition, "the turn queue is empty") //nolint:wrapcheck // gRPC status errors pass through as-is
+		case "SCENE_TURN_NOT_YOURS":
+			return status.Error(codes.PermissionDenied, "only the scene owner or the character whose turn it is may skip") //nolint:wrapcheck // gRPC status errors pass through as-is
+		}
+	}
+	slog.WarnContext(ctx, "scene.service.update_scene_turns store error",
+		"scene_id", sceneID, "error", err)
+	return status.Error(codes.Internal, "turn tracker update failed") //nolint:wrapcheck // gRPC status errors pass through as-is
+}
+
+// advanceTurnOnPose passes the turn on when characterID — the character
+// whose turn it is — poses. SceneAuditServer calls it after each scene_pose
+// commits, so every pose path (commands, web) advances the tracker. A pose
+// out of turn, or in a scene without a tracker, changes nothing; that also
+// makes an audit redelivery harmless once the turn has moved on.
+func (s *SceneServiceImpl) advanceTurnOnPose(ctx context.Context, sceneID, characterID string) {
+	q, err := s.store.GetSceneTurns(ctx, sceneID)
+	if err != nil {
+		slog.WarnContext(ctx, "scene.service.turns pose advance lookup failed",
+			"scene_id", sceneID, "character_id", characterID, "error", err)
+		return
+	}
+	if q == nil || q.Current() != characterID {
+		return
+	}
+	now := time.Now()
+	q, err = s.store.UpdateSceneTurns(ctx, sceneID, now, func(q *TurnQueue) error {
+		if q.Current() != characterID {
+			return errTurnUnchanged
+		}
+		q.Advance(now)
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, errTurnUnchanged) {
+			slog.WarnContext(ctx, "scene.service.turns pose advance failed",
+				"scene_id", sceneID, "character_id", characterID, "error", err)
+		}
+		return
+	}
+	s.emitSceneTurnChangedIC(ctx, q, characterID, turnReasonPosed, characterID)
+}
+
+// timeoutTurn passes the turn on if the current one is older than
+// cfg.TurnTimeout as of now. The age is re-checked under the row lock, so
+// a pose that advanced the turn between the scheduler's scan and this call
+// wins. Emits scene_turn_changed_ic with reason "timeout" naming the
+// character who timed out.
+func (s *SceneServiceImpl) timeoutTurn(ctx context.Context, sceneID string, now time.Time) error {
+	var timedOut string
+	q, err := s.store.UpdateSceneTurns(ctx, sceneID, now, func(q *TurnQueue) error {
+		if len(q.Queue) == 0 || now.Sub(q.TurnStartedAt) < s.cfg.TurnTimeout {
+			return errTurnUnchanged
+		}
+		timedOut = q.Advance(now)
+		return nil
+	})
+	if errors.Is(err, errTurnUnchanged) {
+		return nil
+	}
+	if err != nil {
+		return oops.Code("SCENE_TURN_TIMEOUT_FAILED").With("scene_id", sceneID).Wrap(err)
+	}
+	s.emitSceneTurnChangedIC(ctx, q, "", turnReasonTimeout, timedOut)
+	return nil
+}
+
+// dropFromTurnQueue removes a character who left or was kicked from the
+// scene's turn queue. Best-effort: membership is already gone, so a failure
+// is logged and the stale entry is cleared by the next skip or remove.
+func (s *SceneServiceImpl) dropFromTurnQueue(ctx context.Context, sceneID, characterID string) {
+	q, err := s.store.GetSceneTurns(ctx, sceneID)
+	if err != nil || q == nil || !q.Contains(characterID) {
+		if err != nil {
+			slog.WarnContext(ctx, "scene.service.turns departure lookup failed",
+				"scene_id", sceneID, "character_id", characterID, "error", err)
+		}
+		return
+	}
+	now := time.Now()
+	q, err = s.store.UpdateSceneTurns(ctx, sceneID, now, func(q *TurnQueue) error {
+		if !q.Contains(characterID) {
+			return errTurnUnchanged
+		}
+		return q.Remove(characterID, now)
+	})
+	if err != nil {
+		if !errors.Is(err, errTurnUnchanged) {
+			slog.WarnContext(ctx, "scene.service.turns departure removal failed",
+				"scene_id", sceneID, "character_id", characterID, "error", err)
+		}
+		return
+	}
+	s.emitSceneTurnChangedIC(ctx, q, characterID, turnReasonLeft, characterID)
+}
+
+// sceneTurnChangedPayload is the scene_turn_changed_ic JSON payload.
+// subject_id is the character the change concerns (added, removed, skipped,
+// posed, timed out, or departed); actor_id is who made it, empty for the
+// timeout sweep.
+type sceneTurnChangedPayload struct {
+	SceneID   string   `json:"scene_id"`
+	ActorID   string   `json:"actor_id,omitempty"`
+	Reason    string   `json:"reason"`
+	SubjectID string   `json:"subject_id,omitempty"`
+	CurrentID string   `json:"current_id,omitempty"`
+	NextID    string   `json:"next_id,omitempty"`
+	Round     int      `json:"round"`
+	Queue     []string `json:"queue"`
+}
+
+// emitSceneTurnChangedIC emits a scene_turn_changed_ic notice so clients can
+// re-render whose turn it is without polling GetSceneTurns.
+// sensitivity:never — character IDs and counters only, no RP content.
+// Non-fatal: the tracker change is already committed.
+func (s *SceneServiceImpl) emitSceneTurnChangedIC(ctx context.Context, q *TurnQueue, actorID, reason, subjectID string) {
+	if s.eventSink == nil {
+		slog.WarnContext(ctx, "scene.service.turns scene_turn_changed_ic emit skipped: event sink nil",
+			"scene_id", q.SceneID, "reason", reason)
+		return
+	}
+	queue := q.Queue
+	if queue == nil {
+		queue = []string{}
+	}
+	payload, err := json.Marshal(sceneTurnChangedPayload{
+		SceneID:   q.SceneID,
+		ActorID:   actorID,
+		Reason:    reason,
+		SubjectID: subjectID,
+		CurrentID: q.Current(),
+		NextID:    q.Next(),
+		Round:     q.Round,
+		Queue:     queue,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "scene.service.turns scene_turn_changed_ic payload marshal failed",
+			"scene_id", q.SceneID, "error", err)
+		return
+	}
+	intent := pluginsdk.EmitIntent{
+		Subject:   dotStyleSceneSubjectIC(s.gameID, q.SceneID),
+		Type:      "core-scenes:scene_turn_changed_ic",
+		Payload:   string(payload),
+		Sensitive: false, // sensitivity:never per crypto.emits manifest
+	}
+	if err := s.eventSink.Emit(ctx, intent); err != nil {
+		slog.WarnContext(ctx, "scene.service.turns scene_turn_changed_ic emit failed",
+			"scene_id", q.SceneID, "reason", reason, "error", err)
+	}
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+//go:build integration
+
+// Turn tracker store tests: UpdateSceneTurns' create-on-first-write and
+// rollback-on-mutate-error contract, and the ListSceneTurnsPastTimeout
+// boundary and paused/empty exclusions.
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2" //nolint:revive // ginkgo convention
+	. "github.com/onsi/gomega"    //nolint:revive // gomega convention
+	"github.com/samber/oops"
+)
+
+var _ = Describe("SceneStore turn tracker", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		store  *SceneStore
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 60*time.Second)
+		store = newTestStore()
+	})
+	AfterEach(func() { cancel() })
+
+	It("reads no tracker until the first write creates one", func() {
+		newIdleScene(ctx, store, "01TURNS_CREATE00000000000A", "01TURNS_OWNER0000000000A", nil)
+
+		q, err := store.GetSceneTurns(ctx, "01TURNS_CREATE00000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q).To(BeNil())
+
+		now := time.Now()
+		_, err = store.UpdateSceneTurns(ctx, "01TURNS_CREATE00000000000A", now, func(q *TurnQueue) error {
+			Expect(q.Add("char-a", now)).To(Succeed())
+			return q.Add("char-b", now)
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		q, err = store.GetSceneTurns(ctx, "01TURNS_CREATE00000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Queue).To(Equal([]string{"char-a", "char-b"}))
+		Expect(q.Current()).To(Equal("char-a"))
+		Expect(q.Round).To(Equal(1))
+		Expect(q.TurnStartedAt.UnixNano()).To(Equal(now.UnixNano()))
+	})
+
+	It("rolls back and returns the mutate error unwrapped", func() {
+		newIdleScene(ctx, store, "01TURNS_ROLLBACK000000000A", "01TURNS_OWNER0000000000A", nil)
+		now := time.Now()
+		_, err := store.UpdateSceneTurns(ctx, "01TURNS_ROLLBACK000000000A", now, func(q *TurnQueue) error {
+			return q.Add("char-a", now)
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = store.UpdateSceneTurns(ctx, "01TURNS_ROLLBACK000000000A", now, func(q *TurnQueue) error {
+			q.Advance(now)
+			return q.Add("char-a", now)
+		})
+		var oe oops.OopsError
+		Expect(errors.As(err, &oe)).To(BeTrue())
+		Expect(oe.Code()).To(Equal("SCENE_TURN_ALREADY_QUEUED"))
+
+		q, err := store.GetSceneTurns(ctx, "01TURNS_ROLLBACK000000000A")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(q.Round).To(Equal(1), "the advance before the failing add was rolled back")
+	})
+
+	It("lists active scenes whose turn is at or past the timeout", func() {
+		const timeoutSecs = 60
+		started := time.Now()
+		add := func(id string, chars ...string) {
+			GinkgoHelper()
+			newIdleScene(ctx, store, id, "01TURNS_OWNER0000000000A", nil)
+			_, err := store.UpdateSceneTurns(ctx, id, started, func(q *TurnQueue) error {
+				for _, c := range chars {
+					if err := q.Add(c, started); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		add("01TURNS_DUE000000000000000A", "char-a")
+		add("01TURNS_EMPTY0000000000000A")
+		add("01TURNS_PAUSED000000000000A", "char-a")
+		_, err := store.Pause(ctx, "01TURNS_PAUSED000000000000A")
+		Expect(err).NotTo(HaveOccurred())
+
+		deadlineNs := started.UnixNano() + int64(timeoutSecs)*int64(time.Second)
+		ids, err := store.ListSceneTurnsPastTimeout(ctx, deadlineNs-1, timeoutSecs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(BeEmpty(), "one nanosecond before the deadline nothing is due")
+
+		ids, err = store.ListSceneTurnsPastTimeout(ctx, deadlineNs, timeoutSecs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids).To(Equal([]string{"01TURNS_DUE000000000000000A"}),
+			"the deadline is inclusive; empty queues and paused scenes are skipped")
+	})
+})
@@ -0,0 +1,394 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	pluginsdk "github.com/holomush/holomush/pkg/plugin"
+	scenev1 "github.com/holomush/holomush/pkg/proto/holomush/scene/v1"
+)
+
+func queueOf(ids ...string) *TurnQueue {
+	q := newTurnQueue("scene-1", time.Unix(0, 0))
+	q.Queue = ids
+	return q
+}
+
+func TestTurnQueueAddStartsTheFirstTurnAndRejectsDuplicates(t *testing.T) {
+	t0, t1 := time.Unix(100, 0), time.Unix(200, 0)
+	q := newTurnQueue("scene-1", t0)
+
+	require.NoError(t, q.Add("a", t1))
+	assert.Equal(t, t1, q.TurnStartedAt, "adding to an empty queue starts the first turn")
+	require.NoError(t, q.Add("b", time.Unix(300, 0)))
+	assert.Equal(t, t1, q.TurnStartedAt, "adding behind a running turn leaves it alone")
+	assert.Equal(t, "a", q.Current())
+	assert.Equal(t, "b", q.Next())
+
+	errutil.AssertErrorCode(t, q.Add("a", t1), "SCENE_TURN_ALREADY_QUEUED")
+}
+
+func TestTurnQueueAdvanceWrapsIntoTheNextRound(t *testing.T) {
+	q := queueOf("a", "b")
+	now := time.Unix(500, 0)
+
+	assert.Equal(t, "a", q.Advance(now))
+	assert.Equal(t, "b", q.Current())
+	assert.Equal(t, 1, q.Round)
+	assert.Equal(t, now, q.TurnStartedAt)
+
+	assert.Equal(t, "b", q.Advance(now))
+	assert.Equal(t, "a", q.Current())
+	assert.Equal(t, 2, q.Round)
+
+	assert.Empty(t, newTurnQueue("scene-1", now).Advance(now), "an empty queue has nothing to advance")
+}
+
+func TestTurnQueueRemove(t *testing.T) {
+	t0, now := time.Unix(0, 0), time.Unix(900, 0)
+	tests := []struct {
+		name        string
+		queue       []string
+		current     int
+		remove      string
+		wantQueue   []string
+		wantCurrent string
+		wantRound   int
+		wantStarted time.Time
+	}{
+		{"before current keeps the holder", []string{"a", "b", "c"}, 1, "a", []string{"b", "c"}, "b", 1, t0},
+		{"after current keeps the holder", []string{"a", "b", "c"}, 0, "c", []string{"a", "b"}, "a", 1, t0},
+		{"current hands over to the follower", []string{"a", "b", "c"}, 1, "b", []string{"a", "c"}, "c", 1, now},
+		{"current at the tail wraps to a new round", []string{"a", "b", "c"}, 2, "c", []string{"a", "b"}, "a", 2, now},
+		{"last entry empties the queue", []string{"a"}, 0, "a", []string{}, "", 1, t0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := queueOf(tt.queue...)
+			q.CurrentIndex = tt.current
+
+			require.NoError(t, q.Remove(tt.remove, now))
+			assert.Equal(t, tt.wantQueue, q.Queue)
+			assert.Equal(t, tt.wantCurrent, q.Current())
+			assert.Equal(t, tt.wantRound, q.Round)
+			assert.Equal(t, tt.wantStarted, q.TurnStartedAt)
+		})
+	}
+
+	errutil.AssertErrorCode(t, queueOf("a").Remove("z", now), "SCENE_TURN_NOT_QUEUED")
+}
+
+func TestTurnQueueToProtoSetsDeadlineOnlyWithTimeout(t *testing.T) {
+	q := queueOf("a", "b")
+	q.TurnStartedAt = time.Unix(1000, 0)
+
+	out := q.toProto(0)
+	assert.Equal(t, "a", out.GetCurrentCharacterId())
+	assert.Equal(t, "b", out.GetNextCharacterId())
+	assert.Equal(t, uint32(1), out.GetRound())
+	assert.Nil(t, out.GetTurnDeadline())
+
+	out = q.toProto(time.Minute)
+	assert.True(t, out.GetTurnDeadline().AsTime().Equal(time.Unix(1060, 0)), "deadline is start + timeout")
+
+	assert.Nil(t, queueOf().toProto(time.Minute).GetTurnStartedAt(), "an empty queue has no running turn")
+}
+
+// newTurnTestService returns a service over an active scene owned by
+// char-owner with members char-alice and char-bob.
+func newTurnTestService(t *testing.T) (*SceneServiceImpl, *fakeStore, *recordingEventSink) {
+	t.Helper()
+	store := newFakeStore()
+	store.scenes["scene-1"] = &SceneRow{ID: "scene-1", OwnerID: "char-owner", State: string(SceneStateActive)}
+	store.installRoster("scene-1", "char-owner", "char-alice", "char-bob")
+	sink := &recordingEventSink{}
+	svc := newTestService(t, store)
+	svc.SetEventSink(sink)
+	return svc, store, sink
+}
+
+func updateTurns(t *testing.T, svc *SceneServiceImpl, actor, action, target string) (*scenev1.SceneTurns, error) {
+	t.Helper()
+	resp, err := svc.UpdateSceneTurns(context.Background(), &scenev1.UpdateSceneTurnsRequest{
+		CharacterId: actor, SceneId: "scene-1", Action: action, TargetCharacterId: target,
+	})
+	return resp.GetTurns(), err
+}
+
+func TestGetSceneTurnsRejectsNonParticipants(t *testing.T) {
+	svc, _, _ := newTurnTestService(t)
+
+	_, err := svc.GetSceneTurns(context.Background(), &scenev1.GetSceneTurnsRequest{
+		CharacterId: "char-stranger", SceneId: "scene-1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetSceneTurnsReturnsAnEmptyQueueWithoutATracker(t *testing.T) {
+	svc, _, _ := newTurnTestService(t)
+
+	resp, err := svc.GetSceneTurns(context.Background(), &scenev1.GetSceneTurnsRequest{
+		CharacterId: "char-alice", SceneId: "scene-1",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetTurns().GetQueue())
+	assert.Equal(t, uint32(1), resp.GetTurns().GetRound())
+}
+
+func TestUpdateSceneTurnsAddSelfEmitsTurnChanged(t *testing.T) {
+	svc, _, sink := newTurnTestService(t)
+
+	turns, err := updateTurns(t, svc, "char-alice", "add", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"char-alice"}, turns.GetQueue())
+	assert.Equal(t, "char-alice", turns.GetCurrentCharacterId())
+
+	found := findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic")
+	require.NotNil(t, found)
+	assert.Equal(t, dotStyleSceneSubjectIC("main", "scene-1"), found.Subject)
+	assert.False(t, found.Sensitive, "scene_turn_changed_ic is sensitivity:never")
+	assert.Contains(t, found.Payload, `"reason":"added"`)
+	assert.Contains(t, found.Payload, `"subject_id":"char-alice"`)
+
+	_, err = updateTurns(t, svc, "char-alice", "add", "")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestUpdateSceneTurnsOwnerGates(t *testing.T) {
+	svc, _, _ := newTurnTestService(t)
+
+	_, err := updateTurns(t, svc, "char-alice", "add", "char-bob")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "members may only add themselves")
+
+	_, err = updateTurns(t, svc, "char-owner", "add", "char-stranger")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "only participants may be queued")
+
+	turns, err := updateTurns(t, svc, "char-owner", "add", "char-bob")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"char-bob"}, turns.GetQueue())
+
+	_, err = updateTurns(t, svc, "char-alice", "remove", "char-bob")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = updateTurns(t, svc, "char-alice", "remove", "")
+	assert.Equal(t, codes.NotFound, status.Code(err), "removing someone not queued")
+}
+
+func TestUpdateSceneTurnsSkip(t *testing.T) {
+	svc, _, sink := newTurnTestService(t)
+
+	_, err := updateTurns(t, svc, "char-alice", "skip", "")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "nothing to skip in an empty queue")
+
+	_, err = updateTurns(t, svc, "char-alice", "add", "")
+	require.NoError(t, err)
+	_, err = updateTurns(t, svc, "char-bob", "add", "")
+	require.NoError(t, err)
+
+	_, err = updateTurns(t, svc, "char-bob", "skip", "")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only the holder or owner may skip")
+
+	sink.intents = nil
+	turns, err := updateTurns(t, svc, "char-alice", "skip", "")
+	require.NoError(t, err)
+	assert.Equal(t, "char-bob", turns.GetCurrentCharacterId())
+
+	found := findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic")
+	require.NotNil(t, found)
+	assert.Contains(t, found.Payload, `"reason":"skipped"`)
+	assert.Contains(t, found.Payload, `"subject_id":"char-alice"`)
+	assert.Contains(t, found.Payload, `"current_id":"char-bob"`)
+
+	turns, err = updateTurns(t, svc, "char-owner", "skip", "")
+	require.NoError(t, err)
+	assert.Equal(t, "char-alice", turns.GetCurrentCharacterId(), "the owner may skip anyone")
+	assert.Equal(t, uint32(2), turns.GetRound())
+}
+
+func TestUpdateSceneTurnsRejectsEndedScenes(t *testing.T) {
+	svc, store, _ := newTurnTestService(t)
+	store.scenes["scene-1"].State = string(SceneStateEnded)
+
+	_, err := updateTurns(t, svc, "char-alice", "add", "")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestUpdateSceneTurnsRejectsUnknownActions(t *testing.T) {
+	svc, _, _ := newTurnTestService(t)
+
+	_, err := updateTurns(t, svc, "char-alice", "shuffle", "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateSceneTurnsStoreFailureIsInternal(t *testing.T) {
+	svc, store, _ := newTurnTestService(t)
+	store.turnsErr = errors.New("connection reset")
+
+	_, err := updateTurns(t, svc, "char-alice", "add", "")
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAdvanceTurnOnPoseOnlyAdvancesForTheHolder(t *testing.T) {
+	svc, store, sink := newTurnTestService(t)
+	store.turns = map[string]*TurnQueue{"scene-1": queueOf("char-alice", "char-bob")}
+
+	svc.advanceTurnOnPose(context.Background(), "scene-1", "char-bob")
+	assert.Equal(t, "char-alice", store.turns["scene-1"].Current(), "a pose out of turn changes nothing")
+	assert.Empty(t, sink.intents)
+
+	svc.advanceTurnOnPose(context.Background(), "scene-1", "char-alice")
+	assert.Equal(t, "char-bob", store.turns["scene-1"].Current())
+	found := findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic")
+	require.NotNil(t, found)
+	assert.Contains(t, found.Payload, `"reason":"posed"`)
+
+	svc.advanceTurnOnPose(context.Background(), "scene-2", "char-alice")
+	assert.Len(t, sink.intents, 1, "a scene without a tracker is left alone")
+}
+
+func TestTimeoutTurnRechecksTheTurnAge(t *testing.T) {
+	svc, store, sink := newTurnTestService(t)
+	svc.cfg.TurnTimeout = time.Hour
+	started := time.Unix(10_000, 0)
+	q := queueOf("char-alice", "char-bob")
+	q.TurnStartedAt = started
+	store.turns = map[string]*TurnQueue{"scene-1": q}
+
+	require.NoError(t, svc.timeoutTurn(context.Background(), "scene-1", started.Add(59*time.Minute)))
+	assert.Equal(t, "char-alice", store.turns["scene-1"].Current(), "a fresh turn is not timed out")
+	assert.Empty(t, sink.intents)
+
+	now := started.Add(time.Hour)
+	require.NoError(t, svc.timeoutTurn(context.Background(), "scene-1", now))
+	assert.Equal(t, "char-bob", store.turns["scene-1"].Current())
+	assert.Equal(t, now, store.turns["scene-1"].TurnStartedAt)
+
+	found := findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic")
+	require.NotNil(t, found)
+	assert.Contains(t, found.Payload, `"reason":"timeout"`)
+	assert.Contains(t, found.Payload, `"subject_id":"char-alice"`)
+	assert.NotContains(t, found.Payload, "actor_id", "the sweep has no actor")
+}
+
+func TestTimeoutTurnWrapsStoreFailures(t *testing.T) {
+	svc, store, _ := newTurnTestService(t)
+	svc.cfg.TurnTimeout = time.Hour
+	store.turnsErr = errors.New("connection reset")
+
+	errutil.AssertErrorCode(t, svc.timeoutTurn(context.Background(), "scene-1", time.Now()), "SCENE_TURN_TIMEOUT_FAILED")
+}
+
+func TestLeaveSceneDropsTheCharacterFromTheTurnQueue(t *testing.T) {
+	svc, store, sink := newTurnTestService(t)
+	svc.SetHostEvaluator(allowEvaluator{})
+	store.turns = map[string]*TurnQueue{"scene-1": queueOf("char-alice", "char-bob")}
+
+	_, err := svc.LeaveScene(context.Background(), &scenev1.LeaveSceneRequest{
+		CharacterId: "char-alice", SceneId: "scene-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"char-bob"}, store.turns["scene-1"].Queue)
+
+	found := findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic")
+	require.NotNil(t, found)
+	assert.Contains(t, found.Payload, `"reason":"left"`)
+}
+
+func TestDropFromTurnQueueIgnoresUnqueuedCharacters(t *testing.T) {
+	svc, store, sink := newTurnTestService(t)
+	store.turns = map[string]*TurnQueue{"scene-1": queueOf("char-bob")}
+
+	svc.dropFromTurnQueue(context.Background(), "scene-1", "char-alice")
+	assert.Equal(t, []string{"char-bob"}, store.turns["scene-1"].Queue)
+	assert.Empty(t, sink.intents)
+}
+
+// recordingTurnTimeouter records timeoutTurn calls and fails for the
+// scene IDs in failFor.
+type recordingTurnTimeouter struct {
+	calls   []string
+	failFor map[string]bool
+}
+
+func (r *recordingTurnTimeouter) timeoutTurn(_ context.Context, sceneID string, _ time.Time) error {
+	r.calls = append(r.calls, sceneID)
+	if r.failFor[sceneID] {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+type fakeTurnTimeoutStore struct {
+	ids         []string
+	err         error
+	gotNowNs    int64
+	gotTimeoutS int
+}
+
+func (f *fakeTurnTimeoutStore) ListSceneTurnsPastTimeout(_ context.Context, nowNs int64, timeoutSecs int) ([]string, error) {
+	f.gotNowNs, f.gotTimeoutS = nowNs, timeoutSecs
+	return f.ids, f.err
+}
+
+func TestTurnSchedulerSweepContinuesPastPerSceneFailures(t *testing.T) {
+	now := time.Unix(50_000, 0)
+	store := &fakeTurnTimeoutStore{ids: []string{"s1", "s2", "s3"}}
+	turns := &recordingTurnTimeouter{failFor: map[string]bool{"s2": true}}
+	s := &turnScheduler{store: store, turns: turns, timeout: 10 * time.Minute, now: fixedNow(now)}
+
+	require.NoError(t, s.sweep(context.Background()))
+	assert.Equal(t, []string{"s1", "s2", "s3"}, turns.calls)
+	assert.Equal(t, now.UnixNano(), store.gotNowNs)
+	assert.Equal(t, 600, store.gotTimeoutS)
+}
+
+func TestTurnSchedulerSweepScanFailure(t *testing.T) {
+	s := &turnScheduler{
+		store: &fakeTurnTimeoutStore{err: errors.New("connection reset")},
+		turns: &recordingTurnTimeouter{},
+		now:   time.Now,
+	}
+	errutil.AssertErrorCode(t, s.sweep(context.Background()), "SCENE_TURN_SCHEDULER_SCAN_FAILED")
+}
+
+func TestSceneTurnCommandJoinsAndRendersTheQueue(t *testing.T) {
+	p, sink := newTestPluginWithMember(t, "scene-turn-test")
+	ctx := context.Background()
+	run := func(char, args string) *pluginsdk.CommandResponse {
+		t.Helper()
+		resp, err := p.dispatchCommand(ctx, pluginsdk.CommandRequest{Command: "scene", Args: args, CharacterID: char})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		return resp
+	}
+
+	resp := run("char-alice", "turn")
+	assert.Contains(t, resp.Output, "has no turn queue")
+
+	run("char-alice", "turn add")
+	run("char-owner", "turn add")
+	resp = run("char-alice", "turn")
+	assert.Equal(t, pluginsdk.CommandOK, resp.Status)
+	assert.Contains(t, resp.Output, "round 1")
+	assert.Contains(t, resp.Output, "→ char-alice (up now)")
+	assert.Contains(t, resp.Output, "char-owner (up next)")
+	require.NotNil(t, findIntentByType(sink.intents, "core-scenes:scene_turn_changed_ic"))
+
+	resp = run("char-alice", "turn add")
+	assert.Contains(t, resp.Output, "Cannot add")
+	resp = run("char-alice", "turn remove char-owner")
+	assert.Contains(t, resp.Output, "Cannot remove")
+
+	resp = run("char-alice", "turn skip")
+	assert.Contains(t, resp.Output, "→ char-owner (up now)")
+}
@@ -4,9 +4,10 @@
 #
 # scripts/validate-plugin.sh
 #
-# Manifest validator for plugin authors. Parses the manifest and runs
-# the same ValidateCrypto + ResolveCryptoRefs pipeline the loader uses,
-# without actually loading the plugin.
+# Pre-deploy validator for plugin authors. Checks the manifest (schema +
+# the same ValidateCrypto + ResolveCryptoRefs pipeline the loader uses)
+# without actually loading the plugin. Given a plugin directory it also
+# checks the entry point and lints Lua host-capability use.
 #
 # Usage: scripts/validate-plugin.sh <plugin-dir-or-yaml-path>
 
@@ -16,8 +17,10 @@ target="${1:?usage: validate-plugin.sh <plugin-dir-or-yaml-path>}"
 
 if [[ -d "$target" ]]; then
     manifest="$target/plugin.yaml"
+    validate_target="$target"
 elif [[ -f "$target" ]]; then
     manifest="$target"
+    validate_target="$target"
 else
     echo "validate-plugin: $target does not exist" >&2
     exit 2
@@ -29,4 +32,4 @@ if [[ ! -f "$manifest" ]]; then
 fi
 
 # Invoke the Go-side validator via the holomush CLI.
-go run ./cmd/holomush plugin validate "$manifest"
+go run ./cmd/holomush plugin validate "$validate_target"
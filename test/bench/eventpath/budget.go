@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventpath
+
+import (
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// Budget caps p99 emit → deliver latency for one subscriber count.
+type Budget struct {
+	Subscribers int
+	P99         time.Duration
+}
+
+// Budgets are the regression budgets TestEventPathWithinBudget enforces.
+// They sit more than ten times above what the embedded bus measures under
+// the race detector on a developer machine, so they catch an
+// order-of-magnitude regression rather than noise on a loaded CI runner.
+// Tighten them when the path gets faster.
+var Budgets = []Budget{
+	{Subscribers: 1, P99: 25 * time.Millisecond},
+	{Subscribers: 10, P99: 50 * time.Millisecond},
+	{Subscribers: 50, P99: 100 * time.Millisecond},
+	{Subscribers: 100, P99: 200 * time.Millisecond},
+}
+
+// Check returns EVENTPATH_BUDGET_EXCEEDED when the p99 of l is over b.
+func (b Budget) Check(l Latencies) error {
+	if len(l) == 0 {
+		return oops.Code("EVENTPATH_NO_SAMPLES").
+			With("subscribers", b.Subscribers).
+			Errorf("no latencies recorded")
+	}
+	if p99 := l.Percentile(99); p99 > b.P99 {
+		return oops.Code("EVENTPATH_BUDGET_EXCEEDED").
+			With("subscribers", b.Subscribers).
+			With("p99", p99.String()).
+			With("budget", b.P99.String()).
+			Errorf("p99 emit to deliver latency %s exceeds budget %s at %d subscribers", p99, b.P99, b.Subscribers)
+	}
+	return nil
+}
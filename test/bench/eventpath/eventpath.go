@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+// Package eventpath benchmarks the event path end to end: an event is
+// emitted through the eventbus Publisher, stored in the JetStream EVENTS
+// stream, fanned out to one durable consumer per session, and delivered by
+// each session stream's Next. The bus is the in-process embedded server
+// from eventbustest, so no Docker or network is needed.
+//
+// Run the benchmarks from the repository root:
+//
+//	go test -bench=BenchmarkEventPath -benchmem -run='^$' ./test/bench/eventpath/
+//
+// Emit and deliver work runs under the pprof label eventpath_stage (emit or
+// deliver) plus subscribers, so a CPU profile can be split by stage:
+//
+//	go test -bench=BenchmarkEventPath -run='^$' -cpuprofile=cpu.pprof ./test/bench/eventpath/
+//	go tool pprof -tagfocus=eventpath_stage=deliver cpu.pprof
+//
+// Work done by the embedded NATS server carries no label; it is the store
+// and fan-out leg.
+//
+// TestEventPathWithinBudget fails when p99 emit → deliver latency exceeds
+// the budgets in Budgets. It is skipped under -short.
+package eventpath
+
+import (
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"math"
+	"runtime/pprof"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/samber/oops"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/internal/eventbus"
+	"github.com/holomush/holomush/internal/eventbus/eventbustest"
+)
+
+// Label keys attached to benchmark goroutines for pprof.
+const (
+	LabelStage       = "eventpath_stage"
+	LabelSubscribers = "subscribers"
+)
+
+// subject is the location stream every session in the harness follows.
+const subject = eventbus.Subject("events.main.location.01BENCHLOCATION0000000000")
+
+// Harness is an embedded event bus with a fixed set of sessions following
+// one location stream.
+type Harness struct {
+	pub         eventbus.Publisher
+	subscribers int
+	emitLabels  pprof.LabelSet
+	delivered   chan time.Duration
+	failed      chan error
+
+	mu        sync.Mutex
+	latencies Latencies
+}
+
+// Open boots an embedded bus and opens subscribers sessions on one location
+// stream, each drained by its own goroutine. Everything is torn down on
+// tb.Cleanup.
+func Open(tb eventbustest.TB, subscribers int) *Harness {
+	tb.Helper()
+	require.Positive(tb, subscribers, "eventpath: at least one subscriber required")
+
+	embedded := eventbustest.New(tb)
+	h := &Harness{
+		pub:         embedded.Bus.Publisher(),
+		subscribers: subscribers,
+		emitLabels:  pprof.Labels(LabelStage, "emit", LabelSubscribers, strconv.Itoa(subscribers)),
+		delivered:   make(chan time.Duration, subscribers),
+		failed:      make(chan error, subscribers),
+	}
+	deliverLabels := pprof.Labels(LabelStage, "deliver", LabelSubscribers, strconv.Itoa(subscribers))
+	sub := embedded.Bus.Subscriber()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	streams := make([]eventbus.SessionStream, 0, subscribers)
+	tb.Cleanup(func() {
+		cancel()
+		for _, s := range streams {
+			_ = s.Close()
+		}
+		wg.Wait()
+	})
+
+	for i := range subscribers {
+		identity := eventbus.SessionIdentity{
+			Kind:        eventbus.IdentityKindCharacter,
+			PlayerID:    ulid.Make().String(),
+			CharacterID: ulid.Make().String(),
+			BindingID:   ulid.Make().String(),
+		}
+		stream, err := sub.OpenSession(ctx, fmt.Sprintf("bench%d_%s", i, ulid.Make()), identity, []eventbus.Subject{subject}, time.Time{})
+		require.NoError(tb, err)
+		streams = append(streams, stream)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pprof.Do(ctx, deliverLabels, func(ctx context.Context) { h.drain(ctx, stream) })
+		}()
+	}
+	return h
+}
+
+// drain delivers events from stream until ctx ends, reporting each
+// delivery's emit → deliver latency. Reports give up when ctx ends so
+// cleanup never waits on a reader that has gone away.
+func (h *Harness) drain(ctx context.Context, stream eventbus.SessionStream) {
+	for {
+		d, err := stream.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				sendOrDone(ctx, h.failed, err)
+			}
+			return
+		}
+		latency := time.Since(d.Event().Timestamp)
+		if err := d.Ack(); err != nil {
+			sendOrDone(ctx, h.failed, err)
+			return
+		}
+		if !sendOrDone(ctx, h.delivered, latency) {
+			return
+		}
+	}
+}
+
+func sendOrDone[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Emit publishes one event and waits until every session has delivered it.
+func (h *Harness) Emit(ctx context.Context) error {
+	event := eventbus.Event{
+		ID:        ulid.MustNew(ulid.Now(), crand.Reader),
+		Subject:   subject,
+		Type:      eventbus.Type("say"),
+		Timestamp: time.Now(),
+		Actor:     eventbus.Actor{Kind: eventbus.ActorKindSystem},
+		Payload:   []byte(`{"message":"The harbor bell rings twice."}`),
+	}
+	var err error
+	pprof.Do(ctx, h.emitLabels, func(ctx context.Context) {
+		err = h.pub.Publish(ctx, event)
+	})
+	if err != nil {
+		return oops.Code("EVENTPATH_EMIT_FAILED").Wrap(err)
+	}
+
+	for range h.subscribers {
+		select {
+		case latency := <-h.delivered:
+			h.mu.Lock()
+			h.latencies = append(h.latencies, latency)
+			h.mu.Unlock()
+		case err := <-h.failed:
+			return oops.Code("EVENTPATH_DELIVER_FAILED").Wrap(err)
+		case <-ctx.Done():
+			return oops.Code("EVENTPATH_DELIVER_TIMEOUT").Wrap(ctx.Err())
+		}
+	}
+	return nil
+}
+
+// Latencies returns a copy of every emit → deliver latency recorded so far,
+// one per delivery.
+func (h *Harness) Latencies() Latencies {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.latencies)
+}
+
+// Reset discards the recorded latencies, e.g. after a warm-up.
+func (h *Harness) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.latencies = nil
+}
+
+// Latencies is a set of emit → deliver latencies.
+type Latencies []time.Duration
+
+// Percentile returns the p-th percentile (0 < p <= 100) using the
+// nearest-rank method, or zero for an empty set.
+func (l Latencies) Percentile(p float64) time.Duration {
+	if len(l) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(l)
+	slices.Sort(sorted)
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright 2026 HoloMUSH Contributors
+
+package eventpath_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/holomush/holomush/pkg/errutil"
+	"github.com/holomush/holomush/test/bench/eventpath"
+)
+
+// budgetEvents is how many events TestEventPathWithinBudget sends per
+// subscriber count, after budgetWarmup events that are not measured.
+const (
+	budgetEvents = 200
+	budgetWarmup = 20
+)
+
+func BenchmarkEventPath(b *testing.B) {
+	for _, budget := range eventpath.Budgets {
+		b.Run(fmt.Sprintf("subscribers=%d", budget.Subscribers), func(b *testing.B) {
+			h := eventpath.Open(b, budget.Subscribers)
+			ctx := context.Background()
+			require.NoError(b, h.Emit(ctx), "warm-up")
+			h.Reset()
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if err := h.Emit(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.StopTimer()
+
+			l := h.Latencies()
+			b.ReportMetric(float64(l.Percentile(50).Nanoseconds()), "p50-ns")
+			b.ReportMetric(float64(l.Percentile(99).Nanoseconds()), "p99-ns")
+			b.ReportMetric(float64(len(l))/b.Elapsed().Seconds(), "deliveries/s")
+		})
+	}
+}
+
+func TestEventPathWithinBudget(t *testing.T) {
+	if testing.Short() {
+		t.Skip("event path budget check makes tens of thousands of deliveries; skipped under -short")
+	}
+	for _, budget := range eventpath.Budgets {
+		t.Run(fmt.Sprintf("subscribers=%d", budget.Subscribers), func(t *testing.T) {
+			h := eventpath.Open(t, budget.Subscribers)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			for range budgetWarmup {
+				require.NoError(t, h.Emit(ctx))
+			}
+			h.Reset()
+			for range budgetEvents {
+				require.NoError(t, h.Emit(ctx))
+			}
+
+			l := h.Latencies()
+			require.Len(t, l, budgetEvents*budget.Subscribers, "every session delivers every event")
+			t.Logf("subscribers=%d p50=%s p99=%s", budget.Subscribers, l.Percentile(50), l.Percentile(99))
+			require.NoError(t, budget.Check(l))
+		})
+	}
+}
+
+func TestLatenciesPercentile(t *testing.T) {
+	assert.Zero(t, eventpath.Latencies{}.Percentile(99))
+
+	l := make(eventpath.Latencies, 100)
+	for i := range l {
+		l[len(l)-1-i] = time.Duration(i+1) * time.Millisecond
+	}
+	assert.Equal(t, 50*time.Millisecond, l.Percentile(50))
+	assert.Equal(t, 99*time.Millisecond, l.Percentile(99))
+	assert.Equal(t, 100*time.Millisecond, l.Percentile(100))
+	assert.Equal(t, 1*time.Millisecond, l.Percentile(0.1))
+}
+
+func TestBudgetCheck(t *testing.T) {
+	b := eventpath.Budget{Subscribers: 10, P99: 10 * time.Millisecond}
+
+	require.NoError(t, b.Check(eventpath.Latencies{time.Millisecond, 10 * time.Millisecond}))
+	errutil.AssertErrorCode(t, b.Check(eventpath.Latencies{time.Millisecond, 11 * time.Millisecond}), "EVENTPATH_BUDGET_EXCEEDED")
+	errutil.AssertErrorCode(t, b.Check(nil), "EVENTPATH_NO_SAMPLES")
+}
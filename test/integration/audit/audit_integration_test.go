@@ -21,6 +21,7 @@ import (
 
 	"github.com/holomush/holomush/internal/access"
 	"github.com/holomush/holomush/internal/access/policy/policytest"
+	"github.com/holomush/holomush/internal/access/policy/types"
 	"github.com/holomush/holomush/internal/audit"
 	"github.com/holomush/holomush/internal/command"
 	pluginsdk "github.com/holomush/holomush/pkg/plugin"
@@ -142,6 +143,95 @@ var _ = Describe("Plugin-emitted audit events reaching access_audit_log", func()
 		})
 	})
 
+	Describe("PostgresReader.RecentDenials", func() {
+		It("returns denials newest first and skips allows", func() {
+			writer := audit.NewPostgresWriter(db)
+			defer func() { _ = writer.Close() }()
+
+			base := time.Now().UTC()
+			for i, effect := range []types.Effect{types.EffectDeny, types.EffectAllow, types.EffectDefaultDeny} {
+				Expect(writer.WriteSync(ctx, audit.Event{
+					Subject:   "character:01ABC",
+					Action:    "read",
+					Resource:  fmt.Sprintf("admin_view:%d", i),
+					Effect:    effect,
+					Source:    audit.SourceEngine,
+					Component: "abac",
+					Timestamp: base.Add(time.Duration(i) * time.Millisecond),
+				})).To(Succeed())
+			}
+
+			denials, err := audit.NewPostgresReader(db).RecentDenials(ctx, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(denials).To(HaveLen(2))
+			Expect(denials[0].Effect).To(Equal(types.EffectDefaultDeny))
+			Expect(denials[0].Resource).To(Equal("admin_view:2"))
+			Expect(denials[1].Effect).To(Equal(types.EffectDeny))
+			Expect(denials[1].Resource).To(Equal("admin_view:0"))
+		})
+	})
+
+	Describe("hash chain", func() {
+		var partition string
+
+		BeforeEach(func() {
+			writer := audit.NewPostgresWriter(db)
+			defer func() { _ = writer.Close() }()
+
+			base := time.Now().UTC()
+			partition = fmt.Sprintf("access_audit_log_%04d_%02d", base.Year(), base.Month())
+			for i := range 4 {
+				Expect(writer.WriteSync(ctx, audit.Event{
+					Subject:    "character:01ABC",
+					Action:     "teleport",
+					Resource:   fmt.Sprintf("character:%d", i),
+					Effect:     types.EffectSystemBypass,
+					Source:     audit.SourceEngine,
+					Component:  "abac",
+					Attributes: map[string]any{"reason": "stuck", "role": "admin"},
+					Timestamp:  base.Add(time.Duration(i) * time.Millisecond),
+				})).To(Succeed())
+			}
+		})
+
+		It("verifies an untouched partition", func() {
+			report, err := audit.NewChainVerifier(db).Verify(ctx, partition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Problems).To(BeEmpty())
+			Expect(report.Entries).To(Equal(int64(4)))
+			Expect(report.HeadSeq).To(Equal(int64(4)))
+
+			partitions, err := audit.NewChainVerifier(db).Partitions(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(partitions).To(ContainElement(partition))
+		})
+
+		It("reports a deleted entry and an edited entry", func() {
+			_, err := db.ExecContext(ctx, `DELETE FROM access_audit_log WHERE seq = 2`)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = db.ExecContext(ctx, `UPDATE access_audit_log SET effect = 'deny' WHERE seq = 3`)
+			Expect(err).NotTo(HaveOccurred())
+
+			report, err := audit.NewChainVerifier(db).Verify(ctx, partition)
+			Expect(err).NotTo(HaveOccurred())
+			kinds := make([]audit.ChainProblemKind, 0, len(report.Problems))
+			for _, p := range report.Problems {
+				kinds = append(kinds, p.Kind)
+			}
+			Expect(kinds).To(ConsistOf(audit.ChainGap, audit.ChainHashMismatch))
+		})
+
+		It("reports a deleted tail", func() {
+			_, err := db.ExecContext(ctx, `DELETE FROM access_audit_log WHERE seq = 4`)
+			Expect(err).NotTo(HaveOccurred())
+
+			report, err := audit.NewChainVerifier(db).Verify(ctx, partition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Problems).To(HaveLen(1))
+			Expect(report.Problems[0].Kind).To(Equal(audit.ChainTruncated))
+		})
+	})
+
 	Describe("Lua plugin calls audit.deny during command handler", func() {
 		It("writes a row to access_audit_log via the hostfunc capability path", func() {
 			// The Lua emit path is verified end-to-end by Task 12's unit
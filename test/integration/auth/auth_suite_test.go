@@ -167,6 +167,7 @@ func setupTestEnv() (*testEnv, error) {
 		dispatcher,
 		cmdServices,
 		holoGRPC.WithAuthService(authService),
+		holoGRPC.WithWebSessions(authService),
 		holoGRPC.WithPlayerSessionRepo(playerSessionStore),
 		holoGRPC.WithPlayerRepo(playerRepo),
 		holoGRPC.WithCharacterRepo(charRepo),
@@ -46,8 +46,12 @@ func (c *coreClientShim) GetCommandHistory(ctx context.Context, req *corev1.GetC
 	return c.s.GetCommandHistory(ctx, req)
 }
 
-func (c *coreClientShim) AuthenticatePlayer(ctx context.Context, req *corev1.AuthenticatePlayerRequest) (*corev1.AuthenticatePlayerResponse, error) {
-	return c.s.AuthenticatePlayer(ctx, req)
+func (c *coreClientShim) AuthenticateWebSession(ctx context.Context, req *corev1.AuthenticateWebSessionRequest) (*corev1.AuthenticateWebSessionResponse, error) {
+	return c.s.AuthenticateWebSession(ctx, req)
+}
+
+func (c *coreClientShim) RefreshWebSession(ctx context.Context, req *corev1.RefreshWebSessionRequest) (*corev1.RefreshWebSessionResponse, error) {
+	return c.s.RefreshWebSession(ctx, req)
 }
 
 func (c *coreClientShim) SelectCharacter(ctx context.Context, req *corev1.SelectCharacterRequest) (*corev1.SelectCharacterResponse, error) {
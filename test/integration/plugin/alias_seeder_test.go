@@ -90,6 +90,7 @@ var _ = Describe("Plugin Alias Seeding Integration", func() {
 			Expect(aliases).To(HaveKeyWithValue(`"`, "say"))
 			Expect(aliases).To(HaveKeyWithValue(":", "pose"))
 			Expect(aliases).To(HaveKeyWithValue(";", "pose"))
+			// p is a core alias for the core page command, seeded by migration.
 			Expect(aliases).To(HaveKeyWithValue("p", "page"))
 			Expect(aliases).To(HaveKeyWithValue("w", "whisper"))
 			Expect(aliases).To(HaveKeyWithValue("desc", "describe"))
@@ -106,7 +107,7 @@ var _ = Describe("Plugin Alias Seeding Integration", func() {
 			rows, err := pool.Query(ctx, `
 				SELECT alias, command, created_by, source
 				  FROM system_aliases
-				 WHERE alias IN ('"', ':', ';', 'p', 'w', 'desc')
+				 WHERE alias IN ('"', ':', ';', 'w', 'desc')
 				 ORDER BY alias`)
 			Expect(err).NotTo(HaveOccurred())
 			defer rows.Close()
@@ -118,7 +119,7 @@ var _ = Describe("Plugin Alias Seeding Integration", func() {
 				got = append(got, r)
 			}
 			Expect(rows.Err()).NotTo(HaveOccurred())
-			Expect(got).To(HaveLen(6))
+			Expect(got).To(HaveLen(5))
 			for _, r := range got {
 				Expect(r.createdBy).To(BeNil(),
 					"manifest-seeded alias %q must have NULL created_by", r.alias)
@@ -234,9 +234,9 @@ var _ = Describe("ExitRepository", func() {
 			Expect(delErr(env.Exits.Create(ctx, createTestExit(room1.ID, room2.ID, "north")))).To(Succeed())
 			Expect(delErr(env.Exits.Create(ctx, createTestExit(room1.ID, room3.ID, "east")))).To(Succeed())
 
-			exits, err := env.Exits.ListFromLocation(ctx, room1.ID)
+			exits, err := env.Exits.ListFromLocation(ctx, room1.ID, world.ListOptions{})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(exits).To(HaveLen(2))
+			Expect(exits.Items).To(HaveLen(2))
 		})
 
 		It("orders by name", func() {
@@ -246,10 +246,10 @@ var _ = Describe("ExitRepository", func() {
 			Expect(delErr(env.Exits.Create(ctx, createTestExit(room1.ID, room2.ID, "zulu")))).To(Succeed())
 			Expect(delErr(env.Exits.Create(ctx, createTestExit(room1.ID, room3.ID, "alpha")))).To(Succeed())
 
-			exits, err := env.Exits.ListFromLocation(ctx, room1.ID)
+			exits, err := env.Exits.ListFromLocation(ctx, room1.ID, world.ListOptions{})
 			Expect(err).NotTo(HaveOccurred())
-			Expect(exits[0].Name).To(Equal("alpha"))
-			Expect(exits[1].Name).To(Equal("zulu"))
+			Expect(exits.Items[0].Name).To(Equal("alpha"))
+			Expect(exits.Items[1].Name).To(Equal("zulu"))
 		})
 	})
 })
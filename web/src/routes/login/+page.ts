@@ -5,11 +5,22 @@ import { createClient } from '@connectrpc/connect';
 import { WebService } from '$lib/connect/holomush/web/v1/web_pb';
 import { transport } from '$lib/transport';
 import { setPlayerProfile, clearAuth } from '$lib/stores/authStore';
+import { listContent } from '$lib/stores/contentStore';
+import type { ContentItem } from '$lib/stores/contentStore';
 import { isStaleSession } from '$lib/util/stale';
 import type { PageLoad } from './$types';
 
 export const ssr = false;
 
+// connectScreen picks one of the staff-edited connect screens (written by
+// the core's motd package under motd.screen.*) at random, or null when
+// none are defined.
+async function connectScreen(): Promise<ContentItem | null> {
+  const screens = await listContent('motd.screen.');
+  if (screens.length === 0) return null;
+  return screens[Math.floor(Math.random() * screens.length)];
+}
+
 export const load: PageLoad = async () => {
   if (typeof window === 'undefined') return { authenticated: false };
 
@@ -32,6 +43,6 @@ export const load: PageLoad = async () => {
     if (isStaleSession(e)) {
       clearAuth();
     }
-    return { authenticated: false };
+    return { authenticated: false, connectScreen: await connectScreen() };
   }
 };